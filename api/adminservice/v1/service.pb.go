@@ -54,63 +54,134 @@ func init() {
 }
 
 var fileDescriptor_cf5ca5e0c737570d = []byte{
-	// 895 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0x4d, 0x6f, 0xd3, 0x48,
-	0x18, 0xc7, 0x33, 0x97, 0xd5, 0x6a, 0xd4, 0x7d, 0xf3, 0xae, 0xf6, 0xa5, 0x07, 0xef, 0x6e, 0xf7,
-	0xb2, 0xa7, 0x84, 0x16, 0x28, 0xf4, 0xbd, 0x69, 0x12, 0x52, 0x89, 0xa4, 0xd0, 0x84, 0x17, 0x89,
-	0x0b, 0x9a, 0xc4, 0x4f, 0x5b, 0xab, 0x4e, 0x6c, 0x66, 0xc6, 0x29, 0x3d, 0xc1, 0x05, 0x09, 0x09,
-	0x09, 0x81, 0x84, 0x84, 0x84, 0xc4, 0x09, 0x09, 0x81, 0xc4, 0x67, 0x40, 0xea, 0xad, 0xc7, 0x1e,
-	0x7b, 0xa4, 0xe9, 0x85, 0x63, 0x3f, 0x02, 0x72, 0x9d, 0x99, 0xda, 0xc9, 0x50, 0xc6, 0x4e, 0x6f,
-	0x4d, 0x3d, 0xbf, 0xff, 0xfc, 0xfc, 0xc4, 0x33, 0xcf, 0x38, 0x78, 0x9c, 0x43, 0xcb, 0x73, 0x29,
-	0x71, 0x72, 0x0c, 0x68, 0x07, 0x68, 0x8e, 0x78, 0x76, 0x8e, 0x58, 0x2d, 0xbb, 0x1d, 0x7c, 0xb6,
-	0x9b, 0x90, 0xeb, 0x8c, 0xe7, 0x7a, 0x7f, 0x66, 0x3d, 0xea, 0x72, 0xd7, 0xf8, 0x4f, 0x20, 0xd9,
-	0x10, 0xc9, 0x12, 0xcf, 0xce, 0x46, 0x91, 0x6c, 0x67, 0x7c, 0x74, 0x5a, 0x27, 0x97, 0xc2, 0x3d,
-	0x1f, 0x18, 0xbf, 0x4b, 0x81, 0x79, 0x6e, 0x9b, 0xf5, 0x26, 0x98, 0xd8, 0x19, 0xc3, 0x23, 0xf9,
-	0x60, 0x68, 0x3d, 0x1c, 0x6a, 0xbc, 0x42, 0xf8, 0xd7, 0x1a, 0x34, 0x7c, 0xdb, 0xb1, 0xaa, 0x3e,
-	0x27, 0x0d, 0x07, 0xea, 0x9c, 0x70, 0x30, 0x16, 0xb2, 0x1a, 0x2a, 0x59, 0x05, 0x59, 0x0b, 0x27,
-	0x1e, 0x5d, 0x4c, 0x1f, 0x10, 0x1a, 0x8f, 0x65, 0x8c, 0xd7, 0x08, 0xff, 0x56, 0x04, 0xd6, 0xa4,
-	0x76, 0x03, 0x62, 0x76, 0x7a, 0xe1, 0x2a, 0x54, 0xe8, 0xe5, 0x87, 0x48, 0x90, 0x7e, 0x41, 0xf1,
-	0xc4, 0x90, 0x65, 0x9b, 0x71, 0x97, 0x6e, 0x2f, 0xbb, 0x8c, 0x6b, 0x16, 0x4f, 0x41, 0x26, 0x2b,
-	0x9e, 0x32, 0x40, 0xca, 0x6d, 0xe3, 0xef, 0xcb, 0xc0, 0xeb, 0x1b, 0x84, 0x5a, 0xc6, 0x05, 0xad,
-	0x3c, 0x31, 0x5c, 0x58, 0x5c, 0x4c, 0x48, 0xc9, 0xa9, 0x1f, 0x60, 0x5c, 0x70, 0x5c, 0x06, 0xe1,
-	0xe4, 0x93, 0x5a, 0x31, 0x27, 0x80, 0x98, 0xfe, 0x52, 0x62, 0x4e, 0x0a, 0x3c, 0x47, 0xf8, 0xe7,
-	0x8a, 0xcd, 0x78, 0xaf, 0x32, 0x37, 0x08, 0xdb, 0x64, 0xc6, 0xac, 0x56, 0x5e, 0x3f, 0x26, 0x6c,
-	0xe6, 0x52, 0xd2, 0xd1, 0xa2, 0xd4, 0xa0, 0xe5, 0x76, 0x20, 0xb8, 0xa0, 0x59, 0x94, 0x13, 0x20,
-	0x59, 0x51, 0xa2, 0x9c, 0x14, 0xd8, 0x41, 0xf8, 0x9f, 0x32, 0xf0, 0xdb, 0x2e, 0xdd, 0x5c, 0x73,
-	0xdc, 0xad, 0xd2, 0x7d, 0x68, 0xfa, 0xdc, 0x76, 0xdb, 0x35, 0xb2, 0xd5, 0x53, 0xbe, 0x35, 0x61,
-	0x54, 0x74, 0xbf, 0xf3, 0x53, 0x63, 0x84, 0x6d, 0xf5, 0x8c, 0xd2, 0xe4, 0x3d, 0xbc, 0x41, 0xf8,
-	0xf7, 0x32, 0xf0, 0x1a, 0x78, 0x8e, 0xdd, 0x24, 0xc1, 0xc0, 0x2a, 0x30, 0x46, 0xd6, 0x81, 0x19,
-	0x4b, 0xba, 0x73, 0x29, 0x60, 0xe1, 0x5b, 0x18, 0x2a, 0x43, 0x5a, 0x7e, 0x44, 0xf8, 0xef, 0x32,
-	0xf0, 0x15, 0xd2, 0x02, 0xe6, 0x91, 0x26, 0xa8, 0x74, 0xaf, 0xea, 0x4e, 0x75, 0x5a, 0x8a, 0xf0,
-	0xae, 0x9c, 0x4d, 0x98, 0xbc, 0x81, 0x0f, 0x08, 0xff, 0x55, 0x06, 0x5e, 0xac, 0xac, 0xaa, 0xd4,
-	0x4b, 0xba, 0xb3, 0xa9, 0x79, 0x21, 0x7d, 0x65, 0xd8, 0x18, 0xa9, 0xfb, 0x18, 0xe1, 0x1f, 0x6a,
-	0x40, 0x3c, 0xcf, 0xd9, 0x2e, 0x75, 0xa0, 0xcd, 0x99, 0x31, 0xa5, 0xb9, 0x4c, 0x22, 0x8c, 0xd0,
-	0x9a, 0x4e, 0x83, 0xc6, 0x5a, 0x42, 0xde, 0xb2, 0xea, 0x40, 0x68, 0x73, 0x23, 0xcf, 0x39, 0xb5,
-	0x1b, 0x3e, 0x07, 0xa6, 0xd9, 0x12, 0x14, 0x64, 0xb2, 0x96, 0xa0, 0x0c, 0x88, 0xad, 0x9e, 0x70,
-	0x6b, 0x18, 0xf0, 0x5b, 0x4a, 0xb0, 0xaf, 0x7c, 0x4d, 0xb1, 0x30, 0x54, 0x46, 0xac, 0x84, 0x41,
-	0x53, 0x49, 0x57, 0x42, 0x05, 0x99, 0xac, 0x84, 0xca, 0x00, 0x29, 0xf7, 0x14, 0xe1, 0x9f, 0x44,
-	0xdf, 0x2d, 0x38, 0x3e, 0xe3, 0x40, 0x8d, 0x99, 0x44, 0xdd, 0xba, 0x47, 0x09, 0xa9, 0xd9, 0x74,
-	0xb0, 0x14, 0x7a, 0x84, 0xf0, 0x48, 0xd0, 0x75, 0x7a, 0x57, 0x98, 0x71, 0x59, 0xbb, 0x51, 0x09,
-	0x44, 0xa8, 0x4c, 0xa5, 0x20, 0xa5, 0xc7, 0x4b, 0x84, 0x8d, 0xc8, 0xa5, 0x2a, 0xb4, 0x1a, 0x81,
-	0xcd, 0x7c, 0xd2, 0xcc, 0x1e, 0x28, 0x9c, 0x16, 0x52, 0xf3, 0xd2, 0xec, 0x3d, 0xc2, 0x7f, 0xe6,
-	0x2d, 0xeb, 0x1a, 0xbd, 0xe9, 0x59, 0xc7, 0xe7, 0xb7, 0x96, 0xcb, 0xe5, 0x77, 0x57, 0xd4, 0x5d,
-	0x56, 0x4a, 0x5c, 0x58, 0x96, 0x86, 0x4c, 0x89, 0x3d, 0xfb, 0xe1, 0x02, 0x89, 0x6b, 0x2e, 0x24,
-	0x58, 0x5a, 0x4a, 0xc3, 0xc5, 0xf4, 0x01, 0x52, 0xee, 0x09, 0xc2, 0x3f, 0x86, 0xdb, 0xb1, 0x6c,
-	0x05, 0xd3, 0x09, 0xf6, 0xf0, 0xfe, 0xfd, 0x7f, 0x26, 0x15, 0x1b, 0x3b, 0xe3, 0x5d, 0xf7, 0xe9,
-	0x3a, 0x44, 0x7d, 0xf4, 0x56, 0x53, 0x3f, 0x96, 0xec, 0x8c, 0x37, 0x48, 0xc7, 0x9c, 0xaa, 0x90,
-	0xca, 0xa9, 0x1f, 0x4b, 0xe6, 0x34, 0x48, 0xc7, 0x5e, 0xa2, 0x6a, 0xb0, 0x46, 0x81, 0x6d, 0x88,
-	0x53, 0x56, 0x78, 0x1e, 0xd6, 0x7d, 0x24, 0x06, 0xd1, 0x64, 0x2f, 0x51, 0xea, 0x84, 0xbe, 0xa6,
-	0xc4, 0xa0, 0x6d, 0x45, 0x9a, 0x7c, 0x68, 0xa8, 0xdb, 0x94, 0x54, 0x70, 0xd2, 0xa6, 0xa4, 0xce,
-	0x90, 0x96, 0x2f, 0x10, 0xfe, 0xa5, 0x0c, 0x3c, 0xf8, 0xf7, 0xaa, 0x0f, 0x3e, 0x84, 0x82, 0x73,
-	0xba, 0x8f, 0x70, 0x9c, 0x13, 0x6e, 0xf3, 0x69, 0x71, 0xa9, 0xf5, 0x16, 0xe1, 0x3f, 0x8a, 0xe0,
-	0x00, 0x87, 0x81, 0x13, 0xb4, 0x51, 0xd0, 0xec, 0x2c, 0x4a, 0x5a, 0x28, 0x16, 0x87, 0x0b, 0x91,
-	0xa2, 0xbb, 0x08, 0xff, 0x5b, 0xe7, 0x14, 0x48, 0x4b, 0x8c, 0x52, 0x9d, 0x2c, 0xf5, 0xde, 0x17,
-	0xbe, 0x99, 0x23, 0xe4, 0x57, 0xce, 0x2a, 0x4e, 0xdc, 0xc6, 0xff, 0xe8, 0x1c, 0x5a, 0x72, 0xf6,
-	0x0e, 0xcc, 0xcc, 0xfe, 0x81, 0x99, 0x39, 0x3a, 0x30, 0xd1, 0xc3, 0xae, 0x89, 0xde, 0x75, 0x4d,
-	0xb4, 0xdb, 0x35, 0xd1, 0x5e, 0xd7, 0x44, 0x9f, 0xba, 0x26, 0xfa, 0xdc, 0x35, 0x33, 0x47, 0x5d,
-	0x13, 0x3d, 0x3b, 0x34, 0x33, 0x7b, 0x87, 0x66, 0x66, 0xff, 0xd0, 0xcc, 0xdc, 0x99, 0x5c, 0x77,
-	0x4f, 0x6c, 0x6c, 0xf7, 0x94, 0xdf, 0x6e, 0x66, 0xa2, 0x9f, 0x1b, 0xdf, 0x1d, 0xff, 0x70, 0x73,
-	0xfe, 0x4b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1f, 0xf7, 0xa0, 0x23, 0x4e, 0x12, 0x00, 0x00,
+	// 2029 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5b, 0xcb, 0x8b, 0x24, 0x49,
+	0x19, 0xef, 0xb8, 0xf8, 0x48, 0xd7, 0x57, 0xba, 0xbe, 0x46, 0x29, 0x75, 0x3d, 0xe8, 0xa9, 0x7b,
+	0x67, 0x1f, 0xd3, 0x33, 0x3d, 0xbb, 0x3b, 0x5b, 0x8f, 0xee, 0xea, 0x61, 0xba, 0xba, 0x7b, 0xaa,
+	0x66, 0x56, 0xf0, 0x22, 0x51, 0x59, 0x5f, 0x57, 0x25, 0x9d, 0x95, 0x91, 0x46, 0x44, 0xd6, 0x6e,
+	0x81, 0xb0, 0x22, 0x08, 0x82, 0x20, 0x0a, 0x82, 0x20, 0x88, 0x82, 0x20, 0x2b, 0x08, 0x82, 0xe0,
+	0x55, 0xf0, 0xe4, 0x1e, 0xc7, 0x8b, 0xec, 0xd1, 0xe9, 0xb9, 0x78, 0xdc, 0x3f, 0x41, 0xb2, 0xb2,
+	0x22, 0x3a, 0xa3, 0x32, 0xb2, 0x36, 0xbe, 0xac, 0xba, 0xcd, 0x4c, 0xc5, 0xef, 0x17, 0xbf, 0x88,
+	0xfc, 0xe2, 0x7b, 0x45, 0x8c, 0x77, 0x53, 0xc2, 0x34, 0x61, 0x9c, 0x46, 0x7b, 0x02, 0xf8, 0x0c,
+	0xf8, 0x1e, 0x4d, 0xc2, 0x3d, 0x3a, 0x9a, 0x86, 0x71, 0xf6, 0xf7, 0x30, 0x80, 0xbd, 0xd9, 0xcd,
+	0xbd, 0xe5, 0x1f, 0x77, 0x13, 0xce, 0x24, 0xf3, 0xbf, 0xad, 0x20, 0xbb, 0x39, 0x64, 0x97, 0x26,
+	0xe1, 0x6e, 0x11, 0xb2, 0x3b, 0xbb, 0x79, 0xe3, 0xc0, 0x85, 0x97, 0xc3, 0x0f, 0x53, 0x10, 0xf2,
+	0x07, 0x1c, 0x44, 0xc2, 0x62, 0xb1, 0x9c, 0xe0, 0xa5, 0x7f, 0x5f, 0x78, 0xcf, 0x35, 0xb3, 0xa1,
+	0x83, 0x7c, 0xa8, 0xff, 0x5b, 0xe2, 0x7d, 0xa1, 0x0f, 0xc3, 0x34, 0x8c, 0x46, 0xbd, 0x54, 0xd2,
+	0x61, 0x04, 0x03, 0x49, 0x25, 0xf8, 0xf7, 0x76, 0x1d, 0xa4, 0xec, 0x5a, 0x90, 0xfd, 0x7c, 0xe2,
+	0x1b, 0x6f, 0xd6, 0x27, 0xc8, 0x15, 0xbf, 0xb0, 0xe3, 0xff, 0x8e, 0x78, 0xcf, 0x77, 0x40, 0x04,
+	0x3c, 0x1c, 0x82, 0xa1, 0xce, 0x8d, 0xdc, 0x06, 0x55, 0xf2, 0x9a, 0x1b, 0x30, 0x68, 0x7d, 0xd9,
+	0xe6, 0xa9, 0x21, 0xc7, 0xa1, 0x90, 0x8c, 0xcf, 0x8f, 0x99, 0x90, 0x8e, 0x9b, 0x67, 0x41, 0xe2,
+	0x36, 0xcf, 0x4a, 0xa0, 0xc5, 0xcd, 0xbd, 0x4f, 0x74, 0x41, 0x0e, 0x26, 0x94, 0x8f, 0xfc, 0x57,
+	0x9c, 0xf8, 0xd4, 0x70, 0xa5, 0xe2, 0x55, 0x24, 0x4a, 0x4f, 0xfd, 0xae, 0xe7, 0xb5, 0x23, 0x26,
+	0x20, 0x9f, 0xfc, 0x96, 0x13, 0xcd, 0x35, 0x40, 0x4d, 0xbf, 0x8f, 0xc6, 0x69, 0x01, 0xbf, 0x22,
+	0xde, 0xe7, 0x4e, 0x42, 0x21, 0x97, 0x3b, 0xf3, 0x88, 0x8a, 0x4b, 0xe1, 0xbf, 0xe6, 0xc4, 0xb7,
+	0x0a, 0x53, 0x6a, 0x5e, 0xaf, 0x89, 0x2e, 0x6e, 0x4a, 0x1f, 0xa6, 0x6c, 0x06, 0xd9, 0x0f, 0x8e,
+	0x9b, 0x72, 0x0d, 0xc0, 0x6d, 0x4a, 0x11, 0xa7, 0x05, 0xfc, 0x93, 0x78, 0xdf, 0xec, 0x82, 0xfc,
+	0x1e, 0xe3, 0x97, 0x17, 0x11, 0x7b, 0xfb, 0xf0, 0x1d, 0x08, 0x52, 0x19, 0xb2, 0xb8, 0x4f, 0xdf,
+	0x5e, 0x4a, 0x7e, 0xeb, 0x25, 0xff, 0xc4, 0xf5, 0x9b, 0xaf, 0xa5, 0x51, 0x6a, 0x7b, 0x5b, 0x62,
+	0xd3, 0x6b, 0xf8, 0x23, 0xf1, 0xbe, 0xd4, 0x05, 0xd9, 0x87, 0x24, 0x0a, 0x03, 0x9a, 0x0d, 0xec,
+	0x81, 0x10, 0x74, 0x0c, 0xc2, 0x6f, 0xb9, 0xce, 0x65, 0x01, 0x2b, 0xbd, 0xed, 0x8d, 0x38, 0xb4,
+	0xca, 0x7f, 0x10, 0xef, 0x1b, 0x5d, 0x90, 0xa7, 0x74, 0x0a, 0x22, 0xa1, 0x01, 0xd8, 0xe4, 0x3e,
+	0x70, 0x9d, 0x6a, 0x1d, 0x8b, 0xd2, 0x7d, 0xb2, 0x1d, 0x32, 0xbd, 0x80, 0xbf, 0x10, 0xef, 0xab,
+	0x5d, 0x90, 0x9d, 0x93, 0x87, 0x36, 0xe9, 0x87, 0xae, 0xb3, 0xd9, 0xf1, 0x4a, 0xf4, 0xd1, 0xa6,
+	0x34, 0x5a, 0xee, 0xcf, 0x88, 0xf7, 0xe9, 0x3e, 0xd0, 0x24, 0x89, 0xe6, 0x87, 0x33, 0x88, 0xa5,
+	0xf0, 0xef, 0x38, 0x1e, 0x93, 0x02, 0x46, 0xc9, 0x3a, 0xa8, 0x03, 0x35, 0x42, 0x42, 0x73, 0x34,
+	0x1a, 0x00, 0xe5, 0xc1, 0xa4, 0x29, 0x25, 0x0f, 0x87, 0xa9, 0x04, 0xe1, 0x18, 0x12, 0x2c, 0x48,
+	0x5c, 0x48, 0xb0, 0x12, 0x18, 0xa7, 0x27, 0x77, 0x0d, 0x25, 0x7d, 0x2d, 0x84, 0x5f, 0xa9, 0x92,
+	0xd8, 0xde, 0x88, 0xc3, 0xd8, 0xc2, 0x2c, 0xa8, 0xd4, 0xdb, 0x42, 0x0b, 0x12, 0xb7, 0x85, 0x56,
+	0x02, 0x2d, 0xee, 0x17, 0xc4, 0xfb, 0xac, 0x8a, 0xbb, 0xed, 0x28, 0x15, 0x12, 0xb8, 0x7f, 0x17,
+	0x15, 0xad, 0x97, 0x28, 0x25, 0xea, 0xb5, 0x7a, 0x60, 0x2d, 0xe8, 0xa7, 0xc4, 0x7b, 0x2e, 0x8b,
+	0x3a, 0xcb, 0x5f, 0x84, 0x7f, 0xdb, 0x39, 0x50, 0x29, 0x88, 0x92, 0x72, 0xa7, 0x06, 0x52, 0xeb,
+	0xf8, 0x0d, 0xf1, 0xfc, 0xc2, 0x4f, 0x3d, 0x98, 0x0e, 0x33, 0x35, 0x6f, 0x60, 0x39, 0x97, 0x40,
+	0xa5, 0xe9, 0x5e, 0x6d, 0xbc, 0x56, 0xf6, 0x67, 0xe2, 0x7d, 0xa5, 0x39, 0x1a, 0x9d, 0xf1, 0xc7,
+	0xc9, 0x68, 0x91, 0xbf, 0x4d, 0x99, 0xd4, 0xdf, 0xae, 0xe3, 0x7a, 0xac, 0xac, 0x70, 0xa5, 0xf2,
+	0x70, 0x43, 0x16, 0xc3, 0xf6, 0xf3, 0x03, 0x62, 0xca, 0xbc, 0x87, 0x38, 0x5a, 0x56, 0x85, 0x6f,
+	0xd6, 0x27, 0xd0, 0xe2, 0x7e, 0x4e, 0xbc, 0xcf, 0xe4, 0xee, 0x58, 0x87, 0x82, 0x03, 0x84, 0x0f,
+	0x5f, 0xf5, 0xff, 0x77, 0x6b, 0x61, 0x8d, 0x1c, 0xef, 0x3c, 0xe5, 0x63, 0x28, 0xea, 0x71, 0x3b,
+	0x4d, 0xab, 0x30, 0x5c, 0x8e, 0x57, 0x46, 0x1b, 0x9a, 0x7a, 0x50, 0x4b, 0x53, 0x0f, 0x36, 0xd1,
+	0xd4, 0x83, 0x4a, 0x4d, 0x59, 0x11, 0xd5, 0x87, 0x0b, 0x0e, 0x62, 0xa2, 0xb2, 0xac, 0x3c, 0x1f,
+	0x76, 0x35, 0x89, 0x32, 0x14, 0x57, 0x44, 0xd9, 0x19, 0x56, 0x82, 0x92, 0x80, 0x78, 0x54, 0x08,
+	0xf2, 0xb9, 0x42, 0xd7, 0xa0, 0x64, 0x03, 0x63, 0x83, 0x92, 0x9d, 0x43, 0xab, 0xfc, 0x35, 0xf1,
+	0x3e, 0xdf, 0x05, 0x99, 0xfd, 0xf3, 0xc3, 0x14, 0x52, 0xc8, 0x05, 0xbe, 0xee, 0x6a, 0xc2, 0x26,
+	0x4e, 0x69, 0x7b, 0xa3, 0x2e, 0x5c, 0xcb, 0xfa, 0x13, 0xf1, 0xbe, 0xdc, 0x81, 0x08, 0x24, 0x94,
+	0x32, 0x68, 0xbf, 0xed, 0x18, 0x59, 0xac, 0x68, 0x25, 0xb1, 0xb3, 0x19, 0x89, 0x16, 0xfa, 0x3e,
+	0xf1, 0xbe, 0x35, 0x90, 0x1c, 0xe8, 0x54, 0x8d, 0xb2, 0x65, 0x96, 0x6e, 0xf5, 0xc2, 0x47, 0xf2,
+	0x28, 0xf1, 0xa7, 0xdb, 0xa2, 0x53, 0xcb, 0xf8, 0x2e, 0x79, 0x91, 0x2c, 0x92, 0x63, 0x15, 0x8f,
+	0xaf, 0x3f, 0x0c, 0x4b, 0x58, 0xc4, 0xc6, 0x73, 0xc7, 0xe4, 0xb8, 0x12, 0x8f, 0x4b, 0x8e, 0xd7,
+	0xd0, 0xe8, 0x9d, 0xff, 0x1b, 0xf1, 0xbe, 0x96, 0x07, 0x9d, 0xd2, 0xf7, 0xe9, 0xc1, 0x94, 0xf9,
+	0x5d, 0xa7, 0x99, 0xd6, 0x30, 0x28, 0xc9, 0xc7, 0x9b, 0x13, 0x69, 0xd1, 0xff, 0x21, 0xde, 0x77,
+	0x1e, 0x27, 0x02, 0x78, 0xb9, 0x32, 0x2c, 0xe5, 0x85, 0x03, 0xc7, 0x79, 0x9d, 0xd8, 0xd4, 0x62,
+	0x1e, 0x6d, 0x97, 0x54, 0x2f, 0xec, 0xf7, 0xc4, 0x7b, 0x3e, 0x37, 0xb8, 0x0e, 0x95, 0x74, 0x48,
+	0x05, 0xb4, 0x68, 0x70, 0x99, 0x26, 0x8e, 0xde, 0xd8, 0x06, 0xc5, 0x79, 0x63, 0x3b, 0x83, 0xd2,
+	0xf7, 0x22, 0xf1, 0xff, 0x45, 0xbc, 0x17, 0x94, 0x5d, 0x9d, 0x03, 0x17, 0xa1, 0x90, 0x10, 0x07,
+	0xd0, 0x0e, 0x79, 0x90, 0x86, 0xb2, 0xc5, 0x81, 0x5e, 0x02, 0x17, 0xfe, 0x29, 0xca, 0x40, 0xab,
+	0x89, 0x94, 0xfa, 0xb3, 0xad, 0xf1, 0xe9, 0xbd, 0xfe, 0x03, 0xf1, 0xbe, 0xd8, 0xe6, 0x40, 0x75,
+	0x2e, 0x33, 0x88, 0x69, 0x22, 0x26, 0x4c, 0xfa, 0x6e, 0x5b, 0x65, 0xc5, 0x2a, 0xbd, 0xad, 0x4d,
+	0x28, 0x56, 0x83, 0x9f, 0x64, 0xbc, 0xa4, 0xd1, 0x39, 0xf8, 0x59, 0xc0, 0xe8, 0xe0, 0x67, 0xe5,
+	0xd0, 0x2a, 0xff, 0x4a, 0xbc, 0x1b, 0xed, 0x09, 0x04, 0x97, 0x6f, 0x85, 0x22, 0x1c, 0x86, 0x51,
+	0x28, 0xe7, 0x6d, 0x16, 0x2f, 0x3f, 0xc0, 0xdc, 0x77, 0xf3, 0x55, 0xd5, 0x04, 0x4a, 0x6d, 0x77,
+	0x63, 0x1e, 0xad, 0xf8, 0xef, 0xc4, 0xfb, 0x7a, 0x56, 0x14, 0x3c, 0x62, 0x49, 0xc1, 0x54, 0x74,
+	0xf7, 0x43, 0xf8, 0xc7, 0xce, 0x75, 0x45, 0x15, 0x85, 0x52, 0x7d, 0x7f, 0x0b, 0x4c, 0x46, 0xe3,
+	0xa5, 0x5c, 0xc3, 0x37, 0xa3, 0x90, 0x0a, 0xe7, 0xc6, 0x4b, 0x25, 0x1e, 0x17, 0x5b, 0xd6, 0xd0,
+	0x18, 0xb1, 0x45, 0x1d, 0xc9, 0xeb, 0x4f, 0x72, 0x3f, 0x1e, 0x83, 0x58, 0xa4, 0x20, 0x5d, 0xd4,
+	0xa1, 0xb6, 0x30, 0xe0, 0x62, 0xcb, 0x5a, 0x22, 0x23, 0x21, 0xce, 0x3e, 0x47, 0x93, 0x07, 0x93,
+	0x70, 0x46, 0xa3, 0xce, 0xc9, 0x43, 0x4c, 0x42, 0x6c, 0x83, 0xe2, 0x5c, 0xb0, 0x9d, 0x61, 0x25,
+	0x61, 0x97, 0x7c, 0xbe, 0x32, 0xc6, 0x39, 0x61, 0x2f, 0x43, 0xb1, 0x09, 0xbb, 0x8d, 0xc1, 0xf0,
+	0x06, 0x7d, 0x98, 0xcc, 0x47, 0xdc, 0x16, 0xc8, 0x1d, 0xbd, 0x41, 0x35, 0x01, 0xce, 0x1b, 0xac,
+	0xe3, 0x31, 0x4e, 0x95, 0xb2, 0x8d, 0x41, 0x30, 0x81, 0x51, 0x1a, 0x2d, 0x22, 0xdf, 0x45, 0x18,
+	0x45, 0x02, 0x99, 0xb1, 0x95, 0xf0, 0xf5, 0x32, 0x36, 0x0b, 0x8d, 0x11, 0x14, 0xda, 0x34, 0x0e,
+	0x20, 0x5a, 0x1d, 0xe5, 0x18, 0x14, 0xec, 0x60, 0x5c, 0x50, 0xa8, 0xe2, 0xd0, 0x2a, 0x8b, 0x79,
+	0x82, 0x1a, 0x76, 0x4e, 0x53, 0x01, 0xcd, 0x0b, 0x09, 0xfc, 0x88, 0x86, 0x51, 0xca, 0x01, 0x9b,
+	0x27, 0x54, 0x13, 0xd5, 0xcb, 0x13, 0xd6, 0xf1, 0x19, 0x17, 0x23, 0x79, 0x5a, 0xba, 0x66, 0x1d,
+	0x27, 0x88, 0xec, 0xf6, 0xa3, 0x57, 0xd1, 0xdb, 0x12, 0x9b, 0x71, 0x28, 0xf3, 0x2a, 0x6c, 0x79,
+	0x6d, 0xd2, 0xe2, 0x34, 0x0e, 0x26, 0x5d, 0xca, 0x87, 0x74, 0x0c, 0xfe, 0x11, 0xa2, 0x8c, 0xb3,
+	0x11, 0xe0, 0x0e, 0xe5, 0x3a, 0x1e, 0xeb, 0xa1, 0xd4, 0xb1, 0x70, 0x81, 0xcc, 0xbc, 0x08, 0xee,
+	0x50, 0x96, 0xf0, 0xf5, 0x0e, 0xa5, 0x85, 0xc6, 0x52, 0x46, 0x95, 0x47, 0x51, 0x09, 0xa8, 0x32,
+	0xca, 0xca, 0x50, 0xa7, 0x8c, 0xaa, 0x20, 0x32, 0x42, 0xc9, 0x40, 0x52, 0x7e, 0x7d, 0xef, 0x73,
+	0xf8, 0x4e, 0xc2, 0xb8, 0x74, 0xae, 0x36, 0xca, 0x50, 0x6c, 0xb5, 0x61, 0x63, 0x30, 0x9a, 0xd7,
+	0x79, 0x8a, 0xdc, 0x3c, 0xbf, 0xff, 0x00, 0xe6, 0x8e, 0xcd, 0xeb, 0x22, 0x04, 0xd7, 0xbc, 0x36,
+	0x91, 0x86, 0x8e, 0x3e, 0x93, 0x58, 0x1d, 0x45, 0x08, 0x4e, 0x87, 0x89, 0x34, 0x75, 0xc0, 0x8c,
+	0x5d, 0x22, 0x75, 0x14, 0x20, 0x48, 0x1d, 0x06, 0x52, 0xeb, 0xf8, 0x09, 0xf1, 0x3e, 0xb5, 0xc8,
+	0x52, 0x16, 0x3f, 0x08, 0x7f, 0xdf, 0x3d, 0xaf, 0xc9, 0x11, 0x4a, 0xc5, 0x6d, 0x3c, 0x50, 0x8b,
+	0x98, 0x79, 0x1f, 0x3f, 0x4f, 0x65, 0x9f, 0x45, 0xe0, 0xbf, 0xec, 0xd8, 0x98, 0x5d, 0x8c, 0x56,
+	0x73, 0xbf, 0x82, 0x03, 0x15, 0x2f, 0xea, 0x73, 0x07, 0xb6, 0x98, 0xfa, 0x16, 0xc2, 0xe3, 0x15,
+	0x67, 0xdf, 0x47, 0xe3, 0xb4, 0x80, 0x1f, 0x79, 0x9f, 0xcc, 0x76, 0x24, 0xfb, 0x57, 0xe1, 0xbf,
+	0xea, 0xbc, 0x83, 0x8b, 0xf1, 0x6a, 0xfa, 0x5b, 0x58, 0x98, 0x71, 0x99, 0x3a, 0x00, 0xd9, 0xe5,
+	0x2c, 0x4d, 0x72, 0x09, 0x6e, 0xa6, 0x64, 0x60, 0x70, 0x97, 0xa9, 0x2b, 0x50, 0x43, 0x4a, 0xb7,
+	0x86, 0x94, 0x6e, 0x7d, 0x29, 0xdd, 0x0a, 0x29, 0xea, 0x46, 0x7c, 0x1e, 0xd3, 0x69, 0x18, 0xb4,
+	0x59, 0x7c, 0x11, 0x8e, 0xcf, 0x66, 0xc0, 0x79, 0x38, 0x42, 0xdd, 0x88, 0x5b, 0xf1, 0xf8, 0x1b,
+	0xf1, 0x0a, 0x1a, 0xe3, 0xce, 0x6b, 0x50, 0x31, 0xce, 0xf1, 0xce, 0xab, 0x0a, 0x8e, 0xbb, 0xf3,
+	0xaa, 0x66, 0x59, 0x29, 0x22, 0x23, 0x90, 0x60, 0x97, 0x8b, 0xc9, 0x39, 0xd6, 0x2a, 0x3e, 0xde,
+	0x9c, 0xc8, 0xd8, 0xe0, 0xec, 0xf4, 0x18, 0xe3, 0xda, 0x13, 0x9a, 0xd5, 0x9b, 0x8e, 0x1b, 0x5c,
+	0x05, 0xc7, 0x6d, 0x70, 0x35, 0xcb, 0xaa, 0xed, 0x1e, 0x5e, 0x5c, 0x40, 0x20, 0xc3, 0x99, 0xb9,
+	0x36, 0x77, 0xdb, 0xb5, 0xe3, 0xd1, 0xb6, 0x5b, 0x45, 0x63, 0xdc, 0x69, 0xac, 0x9a, 0x4d, 0x9f,
+	0x45, 0x11, 0x4b, 0xa5, 0xe3, 0x9d, 0x46, 0x05, 0x1a, 0x77, 0xa7, 0x51, 0x49, 0x62, 0xd8, 0x40,
+	0xf1, 0x4d, 0xcd, 0x11, 0x50, 0x99, 0x72, 0x38, 0x8a, 0xe8, 0xd8, 0xd5, 0x06, 0xaa, 0xe0, 0x38,
+	0x1b, 0xa8, 0x66, 0xa9, 0x7c, 0x92, 0x74, 0xdd, 0x22, 0xe9, 0x83, 0x84, 0x78, 0x91, 0x73, 0xe3,
+	0x9f, 0x24, 0x59, 0x58, 0xea, 0x3f, 0x49, 0xb2, 0x92, 0x19, 0xe5, 0xe6, 0x4a, 0xd2, 0x6b, 0x5b,
+	0xc3, 0x69, 0x9d, 0xec, 0x79, 0xcd, 0x32, 0xce, 0xb6, 0xc6, 0xa7, 0x57, 0xf2, 0x5e, 0x66, 0xdf,
+	0x79, 0x17, 0x3e, 0xa4, 0xe3, 0x98, 0x09, 0x19, 0x06, 0xa2, 0x95, 0xc6, 0xa3, 0x08, 0x5c, 0xed,
+	0xdb, 0x8e, 0x46, 0xda, 0x77, 0x15, 0x49, 0xe1, 0x2e, 0xe0, 0x5d, 0xcf, 0x5b, 0x64, 0xf0, 0x1d,
+	0x4e, 0xc3, 0xd8, 0x31, 0x15, 0xba, 0x06, 0xe0, 0x52, 0xa1, 0x22, 0xce, 0x48, 0x44, 0xf3, 0x4e,
+	0x44, 0x2e, 0x61, 0x1f, 0xd1, 0xbb, 0x30, 0x34, 0xdc, 0xc6, 0x03, 0x8d, 0x34, 0x44, 0x95, 0x88,
+	0xb9, 0x8c, 0x3b, 0xa8, 0xb2, 0xd2, 0x10, 0x72, 0x50, 0x07, 0x6a, 0xbc, 0xb2, 0xe9, 0x82, 0x6c,
+	0xb3, 0x69, 0xc2, 0x62, 0x88, 0xe5, 0x31, 0xd0, 0x48, 0x4e, 0x7c, 0xe7, 0x8b, 0xe4, 0x15, 0x20,
+	0xee, 0x95, 0x8d, 0x0d, 0x5f, 0x2a, 0x19, 0x7a, 0x20, 0x79, 0x18, 0x60, 0x4a, 0x86, 0x25, 0x02,
+	0x5f, 0x32, 0x68, 0xa0, 0xbd, 0xd1, 0x97, 0xbd, 0x09, 0xee, 0x84, 0x22, 0x6f, 0x5e, 0xe3, 0x7b,
+	0x0a, 0x25, 0x7c, 0xcd, 0x46, 0x5f, 0x99, 0xc6, 0x6a, 0x58, 0x8b, 0x71, 0x48, 0xc3, 0x32, 0x9e,
+	0x4b, 0x1f, 0xd4, 0x81, 0x1a, 0x41, 0xf7, 0x7e, 0x06, 0x97, 0x75, 0x1f, 0x12, 0x54, 0xa0, 0x71,
+	0x4e, 0xa9, 0x92, 0xc4, 0x68, 0x8e, 0x96, 0xfa, 0x35, 0x03, 0x49, 0xa5, 0xeb, 0x73, 0x11, 0x3b,
+	0x18, 0xd7, 0x1c, 0xad, 0xe2, 0xb0, 0x36, 0x47, 0x6d, 0x6f, 0x6e, 0xb3, 0xf1, 0x29, 0xb6, 0x39,
+	0x5a, 0x4d, 0x54, 0xaf, 0x39, 0xba, 0x8e, 0xcf, 0x78, 0x91, 0xb6, 0x70, 0xcd, 0x2d, 0x2a, 0x83,
+	0xc9, 0x59, 0x02, 0x7c, 0x31, 0xce, 0xf1, 0x45, 0x9a, 0x05, 0x89, 0x7b, 0x91, 0x66, 0x25, 0x30,
+	0x9c, 0x4e, 0xf6, 0x5e, 0xb3, 0xf3, 0xe0, 0xb1, 0xc8, 0xda, 0x9c, 0xfb, 0xce, 0x2f, 0x3c, 0x97,
+	0x08, 0x9c, 0xd3, 0x31, 0x80, 0x4a, 0x44, 0x2b, 0x7a, 0xf2, 0xb4, 0xb1, 0xf3, 0xc1, 0xd3, 0xc6,
+	0xce, 0x87, 0x4f, 0x1b, 0xe4, 0xc7, 0x57, 0x0d, 0xf2, 0xde, 0x55, 0x83, 0xbc, 0x7f, 0xd5, 0x20,
+	0x4f, 0xae, 0x1a, 0xe4, 0xbf, 0x57, 0x0d, 0xf2, 0xbf, 0xab, 0xc6, 0xce, 0x87, 0x57, 0x0d, 0xf2,
+	0xcb, 0x67, 0x8d, 0x9d, 0x27, 0xcf, 0x1a, 0x3b, 0x1f, 0x3c, 0x6b, 0xec, 0x7c, 0xff, 0xd6, 0x98,
+	0x5d, 0xcf, 0x19, 0xb2, 0x35, 0xff, 0x97, 0xe7, 0x6e, 0xf1, 0xef, 0xc3, 0x8f, 0x2d, 0xfe, 0x23,
+	0xcf, 0xcb, 0xff, 0x1f, 0x00, 0x54, 0xa5, 0xd1, 0xc3, 0x5e, 0x34, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -185,6 +256,145 @@ type AdminServiceClient interface {
 	// DeleteWorkflowExecution force deletes a workflow's visibility record, current & concrete execution record and history if possible
 	DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error)
 	StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (AdminService_StreamWorkflowReplicationMessagesClient, error)
+	// DescribeTaskQueueTopology lists the read and write partitions of a task queue, with the matching host owning
+	// each of them, whether it is loaded there, its backlog and whether it caught up with the user data of the root
+	// partition. Describing a partition doesn't load it.
+	DescribeTaskQueueTopology(ctx context.Context, in *DescribeTaskQueueTopologyRequest, opts ...grpc.CallOption) (*DescribeTaskQueueTopologyResponse, error)
+	// UpdateWorkflowExecutionMemo upserts memo fields of a running or closed workflow and updates its visibility
+	// record, without writing a history event or scheduling a workflow task.
+	// (-- api-linter: core::0134=disabled
+	//     aip.dev/not-precedent: This service does not follow the update method API --)
+	UpdateWorkflowExecutionMemo(ctx context.Context, in *UpdateWorkflowExecutionMemoRequest, opts ...grpc.CallOption) (*UpdateWorkflowExecutionMemoResponse, error)
+	// UpsertWorkflowExecutionSearchAttributes upserts search attributes of a running or closed workflow and
+	// updates its visibility record, without writing a history event or scheduling a workflow task.
+	UpsertWorkflowExecutionSearchAttributes(ctx context.Context, in *UpsertWorkflowExecutionSearchAttributesRequest, opts ...grpc.CallOption) (*UpsertWorkflowExecutionSearchAttributesResponse, error)
+	// StreamDatabaseBackup streams an online backup snapshot of the default persistence store, which is only
+	// supported by the SQLite store. The snapshot is consistent, and writers are not blocked while it is taken.
+	StreamDatabaseBackup(ctx context.Context, in *StreamDatabaseBackupRequest, opts ...grpc.CallOption) (AdminService_StreamDatabaseBackupClient, error)
+	// DescribePersistenceCircuitBreakers returns the state of the circuit breaker of each persistence store of the
+	// frontend host serving the request.
+	DescribePersistenceCircuitBreakers(ctx context.Context, in *DescribePersistenceCircuitBreakersRequest, opts ...grpc.CallOption) (*DescribePersistenceCircuitBreakersResponse, error)
+	// CreateClusterSnapshot takes a logical snapshot of the namespaces and workflow executions of the cluster, and
+	// writes it to an object store. Each shard is fenced from the history service while it is read.
+	CreateClusterSnapshot(ctx context.Context, in *CreateClusterSnapshotRequest, opts ...grpc.CallOption) (*CreateClusterSnapshotResponse, error)
+	// RestoreClusterSnapshot restores a snapshot taken by CreateClusterSnapshot into this cluster, which must be
+	// configured with the same number of history shards.
+	RestoreClusterSnapshot(ctx context.Context, in *RestoreClusterSnapshotRequest, opts ...grpc.CallOption) (*RestoreClusterSnapshotResponse, error)
+	// CheckVisibilityConsistency cross-checks the executions of the requested shards against visibility, and the
+	// visibility records of the requested namespaces against the primary store. The inconsistencies found are only
+	// reported, unless the request asks for them to be repaired.
+	CheckVisibilityConsistency(ctx context.Context, in *CheckVisibilityConsistencyRequest, opts ...grpc.CallOption) (*CheckVisibilityConsistencyResponse, error)
+	// ListTopPersistenceNamespaces lists the namespaces which made the most persistence requests from the frontend host
+	// serving the request over a recent window, busiest first.
+	ListTopPersistenceNamespaces(ctx context.Context, in *ListTopPersistenceNamespacesRequest, opts ...grpc.CallOption) (*ListTopPersistenceNamespacesResponse, error)
+	// AddSearchAttributeAliases defines namespace aliases for custom search attributes registered in the cluster.
+	AddSearchAttributeAliases(ctx context.Context, in *AddSearchAttributeAliasesRequest, opts ...grpc.CallOption) (*AddSearchAttributeAliasesResponse, error)
+	// DescribeVisibilityIngestion returns the visibility queue lag of the shards owned by each history host,
+	// and the state of its Elasticsearch bulk processors.
+	DescribeVisibilityIngestion(ctx context.Context, in *DescribeVisibilityIngestionRequest, opts ...grpc.CallOption) (*DescribeVisibilityIngestionResponse, error)
+	// ListArchivalDLQTasks returns a page of the archival tasks which exhausted their retries, oldest first.
+	ListArchivalDLQTasks(ctx context.Context, in *ListArchivalDLQTasksRequest, opts ...grpc.CallOption) (*ListArchivalDLQTasksResponse, error)
+	// RetryArchivalDLQTask schedules the archival of the workflow of an archival DLQ task again,
+	// and removes the task from the DLQ.
+	RetryArchivalDLQTask(ctx context.Context, in *RetryArchivalDLQTaskRequest, opts ...grpc.CallOption) (*RetryArchivalDLQTaskResponse, error)
+	// RehydrateWorkflowExecution reads the archived history of a closed workflow execution and imports it back
+	// into the persistence store, so that it can be inspected, reset or replayed through the regular APIs.
+	RehydrateWorkflowExecution(ctx context.Context, in *RehydrateWorkflowExecutionRequest, opts ...grpc.CallOption) (*RehydrateWorkflowExecutionResponse, error)
+	// DescribeScheduleBackfills returns the progress of the running and recently finished backfills of a schedule.
+	DescribeScheduleBackfills(ctx context.Context, in *DescribeScheduleBackfillsRequest, opts ...grpc.CallOption) (*DescribeScheduleBackfillsResponse, error)
+	// CancelScheduleBackfill drops the actions of a running backfill of a schedule which were not taken yet.
+	CancelScheduleBackfill(ctx context.Context, in *CancelScheduleBackfillRequest, opts ...grpc.CallOption) (*CancelScheduleBackfillResponse, error)
+	// DescribeSchedulePauseAfterFailures returns the consecutive failure limit of a schedule, the current count
+	// of consecutive failures, and why the schedule paused itself, if it did.
+	DescribeSchedulePauseAfterFailures(ctx context.Context, in *DescribeSchedulePauseAfterFailuresRequest, opts ...grpc.CallOption) (*DescribeSchedulePauseAfterFailuresResponse, error)
+	// UpdateSchedulePauseAfterFailures sets the number of consecutive failed or timed-out actions after which a
+	// schedule pauses itself.
+	UpdateSchedulePauseAfterFailures(ctx context.Context, in *UpdateSchedulePauseAfterFailuresRequest, opts ...grpc.CallOption) (*UpdateSchedulePauseAfterFailuresResponse, error)
+	// DeleteHistoryBranchGarbage deletes the garbage history branches reported by a dry run of the history scanner.
+	// Branches are checked again before they're deleted.
+	DeleteHistoryBranchGarbage(ctx context.Context, in *DeleteHistoryBranchGarbageRequest, opts ...grpc.CallOption) (*DeleteHistoryBranchGarbageResponse, error)
+	// DescribeNamespaceDeletion returns the progress of executions deletion for a namespace being deleted.
+	DescribeNamespaceDeletion(ctx context.Context, in *DescribeNamespaceDeletionRequest, opts ...grpc.CallOption) (*DescribeNamespaceDeletionResponse, error)
+	// UpdateNamespaceDeletionRate adjusts rate limits of executions deletion for a namespace being deleted.
+	UpdateNamespaceDeletionRate(ctx context.Context, in *UpdateNamespaceDeletionRateRequest, opts ...grpc.CallOption) (*UpdateNamespaceDeletionRateResponse, error)
+	// StartNamespaceExport starts exporting every execution of a namespace to an export URI.
+	StartNamespaceExport(ctx context.Context, in *StartNamespaceExportRequest, opts ...grpc.CallOption) (*StartNamespaceExportResponse, error)
+	// CreateAPIKey creates an API key and returns it with its token. The token cannot be retrieved later.
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	// RotateAPIKey replaces the secret of an API key and returns its new token.
+	RotateAPIKey(ctx context.Context, in *RotateAPIKeyRequest, opts ...grpc.CallOption) (*RotateAPIKeyResponse, error)
+	// RevokeAPIKey deletes an API key.
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
+	// ListAPIKeys returns all API keys ordered by creation time.
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	// PutRole creates or replaces a named role.
+	PutRole(ctx context.Context, in *PutRoleRequest, opts ...grpc.CallOption) (*PutRoleResponse, error)
+	// DeleteRole deletes a named role which is not assigned to any group.
+	DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error)
+	// ListRoles returns all named roles ordered by name.
+	ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error)
+	// SetGroupRoles assigns named roles to an identity group in a namespace, or cluster-wide if no namespace is set.
+	SetGroupRoles(ctx context.Context, in *SetGroupRolesRequest, opts ...grpc.CallOption) (*SetGroupRolesResponse, error)
+	// GetGroupRoles returns the named roles assigned to each identity group in a namespace, or cluster-wide if no
+	// namespace is set.
+	GetGroupRoles(ctx context.Context, in *GetGroupRolesRequest, opts ...grpc.CallOption) (*GetGroupRolesResponse, error)
+	// GetDynamicConfigOverrides returns the dynamic config values set at runtime.
+	GetDynamicConfigOverrides(ctx context.Context, in *GetDynamicConfigOverridesRequest, opts ...grpc.CallOption) (*GetDynamicConfigOverridesResponse, error)
+	// SetDynamicConfigOverride sets the value of a dynamic config key for some constraints at runtime.
+	SetDynamicConfigOverride(ctx context.Context, in *SetDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*SetDynamicConfigOverrideResponse, error)
+	// DeleteDynamicConfigOverride deletes the value of a dynamic config key set at runtime for some constraints.
+	DeleteDynamicConfigOverride(ctx context.Context, in *DeleteDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*DeleteDynamicConfigOverrideResponse, error)
+	// ListDynamicConfigChanges returns the most recent changes to the dynamic config values set at runtime.
+	ListDynamicConfigChanges(ctx context.Context, in *ListDynamicConfigChangesRequest, opts ...grpc.CallOption) (*ListDynamicConfigChangesResponse, error)
+	// GetEffectiveDynamicConfig returns every known dynamic config key with its values, as seen by the frontend
+	// serving the call.
+	GetEffectiveDynamicConfig(ctx context.Context, in *GetEffectiveDynamicConfigRequest, opts ...grpc.CallOption) (*GetEffectiveDynamicConfigResponse, error)
+	// SetDynamicConfigRollout changes the percentage of the namespaces, task queues or shards which the value of a
+	// dynamic config key set at runtime for some constraints applies to.
+	SetDynamicConfigRollout(ctx context.Context, in *SetDynamicConfigRolloutRequest, opts ...grpc.CallOption) (*SetDynamicConfigRolloutResponse, error)
+	// GetNamespaceFeatureFlags returns the state of every experimental feature flag for a namespace.
+	GetNamespaceFeatureFlags(ctx context.Context, in *GetNamespaceFeatureFlagsRequest, opts ...grpc.CallOption) (*GetNamespaceFeatureFlagsResponse, error)
+	// GetNamespaceVisibilityRetention returns how long the visibility records of closed workflow executions of a
+	// namespace are kept.
+	GetNamespaceVisibilityRetention(ctx context.Context, in *GetNamespaceVisibilityRetentionRequest, opts ...grpc.CallOption) (*GetNamespaceVisibilityRetentionResponse, error)
+	// UpdateNamespaceVisibilityRetention sets how long the visibility records of closed workflow executions of a
+	// namespace are kept, independently of the workflow execution retention.
+	UpdateNamespaceVisibilityRetention(ctx context.Context, in *UpdateNamespaceVisibilityRetentionRequest, opts ...grpc.CallOption) (*UpdateNamespaceVisibilityRetentionResponse, error)
+	// StreamDiagnosticsBundle streams a gzipped tar archive of the diagnostics of the frontend host serving the
+	// request.
+	StreamDiagnosticsBundle(ctx context.Context, in *StreamDiagnosticsBundleRequest, opts ...grpc.CallOption) (AdminService_StreamDiagnosticsBundleClient, error)
+	// StartDrain puts a host of a service role, or all hosts of the role, into drain mode.
+	StartDrain(ctx context.Context, in *StartDrainRequest, opts ...grpc.CallOption) (*StartDrainResponse, error)
+	// CancelDrain removes a drain request made by StartDrain.
+	CancelDrain(ctx context.Context, in *CancelDrainRequest, opts ...grpc.CallOption) (*CancelDrainResponse, error)
+	// DescribeDrain reports the progress of each drain request.
+	DescribeDrain(ctx context.Context, in *DescribeDrainRequest, opts ...grpc.CallOption) (*DescribeDrainResponse, error)
+	// GetComponentHealth probes each subsystem the cluster depends on, as seen from the frontend host serving the
+	// request. Failing probes are reported in the response rather than failing the call.
+	GetComponentHealth(ctx context.Context, in *GetComponentHealthRequest, opts ...grpc.CallOption) (*GetComponentHealthResponse, error)
+	// ListMetrics returns the metrics the server can emit, sorted by name.
+	ListMetrics(ctx context.Context, in *ListMetricsRequest, opts ...grpc.CallOption) (*ListMetricsResponse, error)
+	// DescribeShardDistribution lists every history shard with the host it is assigned to and the state of its
+	// queues, along with how the shards are distributed across history hosts.
+	DescribeShardDistribution(ctx context.Context, in *DescribeShardDistributionRequest, opts ...grpc.CallOption) (*DescribeShardDistributionResponse, error)
+	// DescribeShard returns the live state of a history shard, as seen by the history host owning it: the tasks
+	// its queues hold in memory and the state of its replication stream senders.
+	DescribeShard(ctx context.Context, in *DescribeShardRequest, opts ...grpc.CallOption) (*DescribeShardResponse, error)
+	// ImportWorkflowExecution creates a run from history events exported from another cluster, or continues the
+	// import of a run started by a previous request.
+	ImportWorkflowExecution(ctx context.Context, in *ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ImportWorkflowExecutionResponse, error)
+	// DescribeNamespaceStats computes capacity statistics of a namespace from visibility, the storage usage
+	// recorded by the storage usage scanner and the actions served by the frontend.
+	DescribeNamespaceStats(ctx context.Context, in *DescribeNamespaceStatsRequest, opts ...grpc.CallOption) (*DescribeNamespaceStatsResponse, error)
+	// DescribeNamespaceReplicationStatus reports the replication lag towards each remote cluster of a namespace,
+	// its pending handover progress and its last failover.
+	DescribeNamespaceReplicationStatus(ctx context.Context, in *DescribeNamespaceReplicationStatusRequest, opts ...grpc.CallOption) (*DescribeNamespaceReplicationStatusResponse, error)
+	// StartBatchOperation starts a batch job sending an update to, or resetting to a worker build ID, the workflows
+	// of a namespace. These operation types aren't supported by the public StartBatchOperation API.
+	StartBatchOperation(ctx context.Context, in *StartBatchOperationRequest, opts ...grpc.CallOption) (*StartBatchOperationResponse, error)
+	// GetSDKUsage returns the number of requests the frontend host serving the request received since it started,
+	// by API method, SDK name and version, and namespace.
+	GetSDKUsage(ctx context.Context, in *GetSDKUsageRequest, opts ...grpc.CallOption) (*GetSDKUsageResponse, error)
 }
 
 type adminServiceClient struct {
@@ -469,6 +679,529 @@ func (x *adminServiceStreamWorkflowReplicationMessagesClient) Recv() (*StreamWor
 	return m, nil
 }
 
+func (c *adminServiceClient) DescribeTaskQueueTopology(ctx context.Context, in *DescribeTaskQueueTopologyRequest, opts ...grpc.CallOption) (*DescribeTaskQueueTopologyResponse, error) {
+	out := new(DescribeTaskQueueTopologyResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueueTopology", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateWorkflowExecutionMemo(ctx context.Context, in *UpdateWorkflowExecutionMemoRequest, opts ...grpc.CallOption) (*UpdateWorkflowExecutionMemoResponse, error) {
+	out := new(UpdateWorkflowExecutionMemoResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateWorkflowExecutionMemo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpsertWorkflowExecutionSearchAttributes(ctx context.Context, in *UpsertWorkflowExecutionSearchAttributesRequest, opts ...grpc.CallOption) (*UpsertWorkflowExecutionSearchAttributesResponse, error) {
+	out := new(UpsertWorkflowExecutionSearchAttributesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpsertWorkflowExecutionSearchAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StreamDatabaseBackup(ctx context.Context, in *StreamDatabaseBackupRequest, opts ...grpc.CallOption) (AdminService_StreamDatabaseBackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AdminService_serviceDesc.Streams[1], "/temporal.server.api.adminservice.v1.AdminService/StreamDatabaseBackup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminServiceStreamDatabaseBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AdminService_StreamDatabaseBackupClient interface {
+	Recv() (*StreamDatabaseBackupResponse, error)
+	grpc.ClientStream
+}
+
+type adminServiceStreamDatabaseBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminServiceStreamDatabaseBackupClient) Recv() (*StreamDatabaseBackupResponse, error) {
+	m := new(StreamDatabaseBackupResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *adminServiceClient) DescribePersistenceCircuitBreakers(ctx context.Context, in *DescribePersistenceCircuitBreakersRequest, opts ...grpc.CallOption) (*DescribePersistenceCircuitBreakersResponse, error) {
+	out := new(DescribePersistenceCircuitBreakersResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribePersistenceCircuitBreakers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateClusterSnapshot(ctx context.Context, in *CreateClusterSnapshotRequest, opts ...grpc.CallOption) (*CreateClusterSnapshotResponse, error) {
+	out := new(CreateClusterSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/CreateClusterSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RestoreClusterSnapshot(ctx context.Context, in *RestoreClusterSnapshotRequest, opts ...grpc.CallOption) (*RestoreClusterSnapshotResponse, error) {
+	out := new(RestoreClusterSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/RestoreClusterSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CheckVisibilityConsistency(ctx context.Context, in *CheckVisibilityConsistencyRequest, opts ...grpc.CallOption) (*CheckVisibilityConsistencyResponse, error) {
+	out := new(CheckVisibilityConsistencyResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/CheckVisibilityConsistency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListTopPersistenceNamespaces(ctx context.Context, in *ListTopPersistenceNamespacesRequest, opts ...grpc.CallOption) (*ListTopPersistenceNamespacesResponse, error) {
+	out := new(ListTopPersistenceNamespacesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListTopPersistenceNamespaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AddSearchAttributeAliases(ctx context.Context, in *AddSearchAttributeAliasesRequest, opts ...grpc.CallOption) (*AddSearchAttributeAliasesResponse, error) {
+	out := new(AddSearchAttributeAliasesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/AddSearchAttributeAliases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeVisibilityIngestion(ctx context.Context, in *DescribeVisibilityIngestionRequest, opts ...grpc.CallOption) (*DescribeVisibilityIngestionResponse, error) {
+	out := new(DescribeVisibilityIngestionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeVisibilityIngestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListArchivalDLQTasks(ctx context.Context, in *ListArchivalDLQTasksRequest, opts ...grpc.CallOption) (*ListArchivalDLQTasksResponse, error) {
+	out := new(ListArchivalDLQTasksResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListArchivalDLQTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RetryArchivalDLQTask(ctx context.Context, in *RetryArchivalDLQTaskRequest, opts ...grpc.CallOption) (*RetryArchivalDLQTaskResponse, error) {
+	out := new(RetryArchivalDLQTaskResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/RetryArchivalDLQTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RehydrateWorkflowExecution(ctx context.Context, in *RehydrateWorkflowExecutionRequest, opts ...grpc.CallOption) (*RehydrateWorkflowExecutionResponse, error) {
+	out := new(RehydrateWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/RehydrateWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeScheduleBackfills(ctx context.Context, in *DescribeScheduleBackfillsRequest, opts ...grpc.CallOption) (*DescribeScheduleBackfillsResponse, error) {
+	out := new(DescribeScheduleBackfillsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeScheduleBackfills", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CancelScheduleBackfill(ctx context.Context, in *CancelScheduleBackfillRequest, opts ...grpc.CallOption) (*CancelScheduleBackfillResponse, error) {
+	out := new(CancelScheduleBackfillResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/CancelScheduleBackfill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeSchedulePauseAfterFailures(ctx context.Context, in *DescribeSchedulePauseAfterFailuresRequest, opts ...grpc.CallOption) (*DescribeSchedulePauseAfterFailuresResponse, error) {
+	out := new(DescribeSchedulePauseAfterFailuresResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeSchedulePauseAfterFailures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateSchedulePauseAfterFailures(ctx context.Context, in *UpdateSchedulePauseAfterFailuresRequest, opts ...grpc.CallOption) (*UpdateSchedulePauseAfterFailuresResponse, error) {
+	out := new(UpdateSchedulePauseAfterFailuresResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateSchedulePauseAfterFailures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteHistoryBranchGarbage(ctx context.Context, in *DeleteHistoryBranchGarbageRequest, opts ...grpc.CallOption) (*DeleteHistoryBranchGarbageResponse, error) {
+	out := new(DeleteHistoryBranchGarbageResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DeleteHistoryBranchGarbage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeNamespaceDeletion(ctx context.Context, in *DescribeNamespaceDeletionRequest, opts ...grpc.CallOption) (*DescribeNamespaceDeletionResponse, error) {
+	out := new(DescribeNamespaceDeletionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeNamespaceDeletion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateNamespaceDeletionRate(ctx context.Context, in *UpdateNamespaceDeletionRateRequest, opts ...grpc.CallOption) (*UpdateNamespaceDeletionRateResponse, error) {
+	out := new(UpdateNamespaceDeletionRateResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateNamespaceDeletionRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartNamespaceExport(ctx context.Context, in *StartNamespaceExportRequest, opts ...grpc.CallOption) (*StartNamespaceExportResponse, error) {
+	out := new(StartNamespaceExportResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/StartNamespaceExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/CreateAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RotateAPIKey(ctx context.Context, in *RotateAPIKeyRequest, opts ...grpc.CallOption) (*RotateAPIKeyResponse, error) {
+	out := new(RotateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/RotateAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/RevokeAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListAPIKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PutRole(ctx context.Context, in *PutRoleRequest, opts ...grpc.CallOption) (*PutRoleResponse, error) {
+	out := new(PutRoleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/PutRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error) {
+	out := new(DeleteRoleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DeleteRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error) {
+	out := new(ListRolesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetGroupRoles(ctx context.Context, in *SetGroupRolesRequest, opts ...grpc.CallOption) (*SetGroupRolesResponse, error) {
+	out := new(SetGroupRolesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/SetGroupRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetGroupRoles(ctx context.Context, in *GetGroupRolesRequest, opts ...grpc.CallOption) (*GetGroupRolesResponse, error) {
+	out := new(GetGroupRolesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetGroupRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetDynamicConfigOverrides(ctx context.Context, in *GetDynamicConfigOverridesRequest, opts ...grpc.CallOption) (*GetDynamicConfigOverridesResponse, error) {
+	out := new(GetDynamicConfigOverridesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetDynamicConfigOverrides", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetDynamicConfigOverride(ctx context.Context, in *SetDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*SetDynamicConfigOverrideResponse, error) {
+	out := new(SetDynamicConfigOverrideResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/SetDynamicConfigOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteDynamicConfigOverride(ctx context.Context, in *DeleteDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*DeleteDynamicConfigOverrideResponse, error) {
+	out := new(DeleteDynamicConfigOverrideResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DeleteDynamicConfigOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDynamicConfigChanges(ctx context.Context, in *ListDynamicConfigChangesRequest, opts ...grpc.CallOption) (*ListDynamicConfigChangesResponse, error) {
+	out := new(ListDynamicConfigChangesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfigChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetEffectiveDynamicConfig(ctx context.Context, in *GetEffectiveDynamicConfigRequest, opts ...grpc.CallOption) (*GetEffectiveDynamicConfigResponse, error) {
+	out := new(GetEffectiveDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetEffectiveDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetDynamicConfigRollout(ctx context.Context, in *SetDynamicConfigRolloutRequest, opts ...grpc.CallOption) (*SetDynamicConfigRolloutResponse, error) {
+	out := new(SetDynamicConfigRolloutResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/SetDynamicConfigRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetNamespaceFeatureFlags(ctx context.Context, in *GetNamespaceFeatureFlagsRequest, opts ...grpc.CallOption) (*GetNamespaceFeatureFlagsResponse, error) {
+	out := new(GetNamespaceFeatureFlagsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetNamespaceFeatureFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetNamespaceVisibilityRetention(ctx context.Context, in *GetNamespaceVisibilityRetentionRequest, opts ...grpc.CallOption) (*GetNamespaceVisibilityRetentionResponse, error) {
+	out := new(GetNamespaceVisibilityRetentionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetNamespaceVisibilityRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateNamespaceVisibilityRetention(ctx context.Context, in *UpdateNamespaceVisibilityRetentionRequest, opts ...grpc.CallOption) (*UpdateNamespaceVisibilityRetentionResponse, error) {
+	out := new(UpdateNamespaceVisibilityRetentionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateNamespaceVisibilityRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StreamDiagnosticsBundle(ctx context.Context, in *StreamDiagnosticsBundleRequest, opts ...grpc.CallOption) (AdminService_StreamDiagnosticsBundleClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AdminService_serviceDesc.Streams[2], "/temporal.server.api.adminservice.v1.AdminService/StreamDiagnosticsBundle", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminServiceStreamDiagnosticsBundleClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AdminService_StreamDiagnosticsBundleClient interface {
+	Recv() (*StreamDiagnosticsBundleResponse, error)
+	grpc.ClientStream
+}
+
+type adminServiceStreamDiagnosticsBundleClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminServiceStreamDiagnosticsBundleClient) Recv() (*StreamDiagnosticsBundleResponse, error) {
+	m := new(StreamDiagnosticsBundleResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *adminServiceClient) StartDrain(ctx context.Context, in *StartDrainRequest, opts ...grpc.CallOption) (*StartDrainResponse, error) {
+	out := new(StartDrainResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/StartDrain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CancelDrain(ctx context.Context, in *CancelDrainRequest, opts ...grpc.CallOption) (*CancelDrainResponse, error) {
+	out := new(CancelDrainResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/CancelDrain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeDrain(ctx context.Context, in *DescribeDrainRequest, opts ...grpc.CallOption) (*DescribeDrainResponse, error) {
+	out := new(DescribeDrainResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeDrain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetComponentHealth(ctx context.Context, in *GetComponentHealthRequest, opts ...grpc.CallOption) (*GetComponentHealthResponse, error) {
+	out := new(GetComponentHealthResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetComponentHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListMetrics(ctx context.Context, in *ListMetricsRequest, opts ...grpc.CallOption) (*ListMetricsResponse, error) {
+	out := new(ListMetricsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeShardDistribution(ctx context.Context, in *DescribeShardDistributionRequest, opts ...grpc.CallOption) (*DescribeShardDistributionResponse, error) {
+	out := new(DescribeShardDistributionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeShardDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeShard(ctx context.Context, in *DescribeShardRequest, opts ...grpc.CallOption) (*DescribeShardResponse, error) {
+	out := new(DescribeShardResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeShard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ImportWorkflowExecution(ctx context.Context, in *ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ImportWorkflowExecutionResponse, error) {
+	out := new(ImportWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ImportWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeNamespaceStats(ctx context.Context, in *DescribeNamespaceStatsRequest, opts ...grpc.CallOption) (*DescribeNamespaceStatsResponse, error) {
+	out := new(DescribeNamespaceStatsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeNamespaceStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeNamespaceReplicationStatus(ctx context.Context, in *DescribeNamespaceReplicationStatusRequest, opts ...grpc.CallOption) (*DescribeNamespaceReplicationStatusResponse, error) {
+	out := new(DescribeNamespaceReplicationStatusResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeNamespaceReplicationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartBatchOperation(ctx context.Context, in *StartBatchOperationRequest, opts ...grpc.CallOption) (*StartBatchOperationResponse, error) {
+	out := new(StartBatchOperationResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/StartBatchOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetSDKUsage(ctx context.Context, in *GetSDKUsageRequest, opts ...grpc.CallOption) (*GetSDKUsageResponse, error) {
+	out := new(GetSDKUsageResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetSDKUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 type AdminServiceServer interface {
 	// RebuildMutableState attempts to rebuild mutable state according to persisted history events.
@@ -531,613 +1264,1871 @@ type AdminServiceServer interface {
 	// DeleteWorkflowExecution force deletes a workflow's visibility record, current & concrete execution record and history if possible
 	DeleteWorkflowExecution(context.Context, *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error)
 	StreamWorkflowReplicationMessages(AdminService_StreamWorkflowReplicationMessagesServer) error
+	// DescribeTaskQueueTopology lists the read and write partitions of a task queue, with the matching host owning
+	// each of them, whether it is loaded there, its backlog and whether it caught up with the user data of the root
+	// partition. Describing a partition doesn't load it.
+	DescribeTaskQueueTopology(context.Context, *DescribeTaskQueueTopologyRequest) (*DescribeTaskQueueTopologyResponse, error)
+	// UpdateWorkflowExecutionMemo upserts memo fields of a running or closed workflow and updates its visibility
+	// record, without writing a history event or scheduling a workflow task.
+	// (-- api-linter: core::0134=disabled
+	//     aip.dev/not-precedent: This service does not follow the update method API --)
+	UpdateWorkflowExecutionMemo(context.Context, *UpdateWorkflowExecutionMemoRequest) (*UpdateWorkflowExecutionMemoResponse, error)
+	// UpsertWorkflowExecutionSearchAttributes upserts search attributes of a running or closed workflow and
+	// updates its visibility record, without writing a history event or scheduling a workflow task.
+	UpsertWorkflowExecutionSearchAttributes(context.Context, *UpsertWorkflowExecutionSearchAttributesRequest) (*UpsertWorkflowExecutionSearchAttributesResponse, error)
+	// StreamDatabaseBackup streams an online backup snapshot of the default persistence store, which is only
+	// supported by the SQLite store. The snapshot is consistent, and writers are not blocked while it is taken.
+	StreamDatabaseBackup(*StreamDatabaseBackupRequest, AdminService_StreamDatabaseBackupServer) error
+	// DescribePersistenceCircuitBreakers returns the state of the circuit breaker of each persistence store of the
+	// frontend host serving the request.
+	DescribePersistenceCircuitBreakers(context.Context, *DescribePersistenceCircuitBreakersRequest) (*DescribePersistenceCircuitBreakersResponse, error)
+	// CreateClusterSnapshot takes a logical snapshot of the namespaces and workflow executions of the cluster, and
+	// writes it to an object store. Each shard is fenced from the history service while it is read.
+	CreateClusterSnapshot(context.Context, *CreateClusterSnapshotRequest) (*CreateClusterSnapshotResponse, error)
+	// RestoreClusterSnapshot restores a snapshot taken by CreateClusterSnapshot into this cluster, which must be
+	// configured with the same number of history shards.
+	RestoreClusterSnapshot(context.Context, *RestoreClusterSnapshotRequest) (*RestoreClusterSnapshotResponse, error)
+	// CheckVisibilityConsistency cross-checks the executions of the requested shards against visibility, and the
+	// visibility records of the requested namespaces against the primary store. The inconsistencies found are only
+	// reported, unless the request asks for them to be repaired.
+	CheckVisibilityConsistency(context.Context, *CheckVisibilityConsistencyRequest) (*CheckVisibilityConsistencyResponse, error)
+	// ListTopPersistenceNamespaces lists the namespaces which made the most persistence requests from the frontend host
+	// serving the request over a recent window, busiest first.
+	ListTopPersistenceNamespaces(context.Context, *ListTopPersistenceNamespacesRequest) (*ListTopPersistenceNamespacesResponse, error)
+	// AddSearchAttributeAliases defines namespace aliases for custom search attributes registered in the cluster.
+	AddSearchAttributeAliases(context.Context, *AddSearchAttributeAliasesRequest) (*AddSearchAttributeAliasesResponse, error)
+	// DescribeVisibilityIngestion returns the visibility queue lag of the shards owned by each history host,
+	// and the state of its Elasticsearch bulk processors.
+	DescribeVisibilityIngestion(context.Context, *DescribeVisibilityIngestionRequest) (*DescribeVisibilityIngestionResponse, error)
+	// ListArchivalDLQTasks returns a page of the archival tasks which exhausted their retries, oldest first.
+	ListArchivalDLQTasks(context.Context, *ListArchivalDLQTasksRequest) (*ListArchivalDLQTasksResponse, error)
+	// RetryArchivalDLQTask schedules the archival of the workflow of an archival DLQ task again,
+	// and removes the task from the DLQ.
+	RetryArchivalDLQTask(context.Context, *RetryArchivalDLQTaskRequest) (*RetryArchivalDLQTaskResponse, error)
+	// RehydrateWorkflowExecution reads the archived history of a closed workflow execution and imports it back
+	// into the persistence store, so that it can be inspected, reset or replayed through the regular APIs.
+	RehydrateWorkflowExecution(context.Context, *RehydrateWorkflowExecutionRequest) (*RehydrateWorkflowExecutionResponse, error)
+	// DescribeScheduleBackfills returns the progress of the running and recently finished backfills of a schedule.
+	DescribeScheduleBackfills(context.Context, *DescribeScheduleBackfillsRequest) (*DescribeScheduleBackfillsResponse, error)
+	// CancelScheduleBackfill drops the actions of a running backfill of a schedule which were not taken yet.
+	CancelScheduleBackfill(context.Context, *CancelScheduleBackfillRequest) (*CancelScheduleBackfillResponse, error)
+	// DescribeSchedulePauseAfterFailures returns the consecutive failure limit of a schedule, the current count
+	// of consecutive failures, and why the schedule paused itself, if it did.
+	DescribeSchedulePauseAfterFailures(context.Context, *DescribeSchedulePauseAfterFailuresRequest) (*DescribeSchedulePauseAfterFailuresResponse, error)
+	// UpdateSchedulePauseAfterFailures sets the number of consecutive failed or timed-out actions after which a
+	// schedule pauses itself.
+	UpdateSchedulePauseAfterFailures(context.Context, *UpdateSchedulePauseAfterFailuresRequest) (*UpdateSchedulePauseAfterFailuresResponse, error)
+	// DeleteHistoryBranchGarbage deletes the garbage history branches reported by a dry run of the history scanner.
+	// Branches are checked again before they're deleted.
+	DeleteHistoryBranchGarbage(context.Context, *DeleteHistoryBranchGarbageRequest) (*DeleteHistoryBranchGarbageResponse, error)
+	// DescribeNamespaceDeletion returns the progress of executions deletion for a namespace being deleted.
+	DescribeNamespaceDeletion(context.Context, *DescribeNamespaceDeletionRequest) (*DescribeNamespaceDeletionResponse, error)
+	// UpdateNamespaceDeletionRate adjusts rate limits of executions deletion for a namespace being deleted.
+	UpdateNamespaceDeletionRate(context.Context, *UpdateNamespaceDeletionRateRequest) (*UpdateNamespaceDeletionRateResponse, error)
+	// StartNamespaceExport starts exporting every execution of a namespace to an export URI.
+	StartNamespaceExport(context.Context, *StartNamespaceExportRequest) (*StartNamespaceExportResponse, error)
+	// CreateAPIKey creates an API key and returns it with its token. The token cannot be retrieved later.
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	// RotateAPIKey replaces the secret of an API key and returns its new token.
+	RotateAPIKey(context.Context, *RotateAPIKeyRequest) (*RotateAPIKeyResponse, error)
+	// RevokeAPIKey deletes an API key.
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
+	// ListAPIKeys returns all API keys ordered by creation time.
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	// PutRole creates or replaces a named role.
+	PutRole(context.Context, *PutRoleRequest) (*PutRoleResponse, error)
+	// DeleteRole deletes a named role which is not assigned to any group.
+	DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error)
+	// ListRoles returns all named roles ordered by name.
+	ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error)
+	// SetGroupRoles assigns named roles to an identity group in a namespace, or cluster-wide if no namespace is set.
+	SetGroupRoles(context.Context, *SetGroupRolesRequest) (*SetGroupRolesResponse, error)
+	// GetGroupRoles returns the named roles assigned to each identity group in a namespace, or cluster-wide if no
+	// namespace is set.
+	GetGroupRoles(context.Context, *GetGroupRolesRequest) (*GetGroupRolesResponse, error)
+	// GetDynamicConfigOverrides returns the dynamic config values set at runtime.
+	GetDynamicConfigOverrides(context.Context, *GetDynamicConfigOverridesRequest) (*GetDynamicConfigOverridesResponse, error)
+	// SetDynamicConfigOverride sets the value of a dynamic config key for some constraints at runtime.
+	SetDynamicConfigOverride(context.Context, *SetDynamicConfigOverrideRequest) (*SetDynamicConfigOverrideResponse, error)
+	// DeleteDynamicConfigOverride deletes the value of a dynamic config key set at runtime for some constraints.
+	DeleteDynamicConfigOverride(context.Context, *DeleteDynamicConfigOverrideRequest) (*DeleteDynamicConfigOverrideResponse, error)
+	// ListDynamicConfigChanges returns the most recent changes to the dynamic config values set at runtime.
+	ListDynamicConfigChanges(context.Context, *ListDynamicConfigChangesRequest) (*ListDynamicConfigChangesResponse, error)
+	// GetEffectiveDynamicConfig returns every known dynamic config key with its values, as seen by the frontend
+	// serving the call.
+	GetEffectiveDynamicConfig(context.Context, *GetEffectiveDynamicConfigRequest) (*GetEffectiveDynamicConfigResponse, error)
+	// SetDynamicConfigRollout changes the percentage of the namespaces, task queues or shards which the value of a
+	// dynamic config key set at runtime for some constraints applies to.
+	SetDynamicConfigRollout(context.Context, *SetDynamicConfigRolloutRequest) (*SetDynamicConfigRolloutResponse, error)
+	// GetNamespaceFeatureFlags returns the state of every experimental feature flag for a namespace.
+	GetNamespaceFeatureFlags(context.Context, *GetNamespaceFeatureFlagsRequest) (*GetNamespaceFeatureFlagsResponse, error)
+	// GetNamespaceVisibilityRetention returns how long the visibility records of closed workflow executions of a
+	// namespace are kept.
+	GetNamespaceVisibilityRetention(context.Context, *GetNamespaceVisibilityRetentionRequest) (*GetNamespaceVisibilityRetentionResponse, error)
+	// UpdateNamespaceVisibilityRetention sets how long the visibility records of closed workflow executions of a
+	// namespace are kept, independently of the workflow execution retention.
+	UpdateNamespaceVisibilityRetention(context.Context, *UpdateNamespaceVisibilityRetentionRequest) (*UpdateNamespaceVisibilityRetentionResponse, error)
+	// StreamDiagnosticsBundle streams a gzipped tar archive of the diagnostics of the frontend host serving the
+	// request.
+	StreamDiagnosticsBundle(*StreamDiagnosticsBundleRequest, AdminService_StreamDiagnosticsBundleServer) error
+	// StartDrain puts a host of a service role, or all hosts of the role, into drain mode.
+	StartDrain(context.Context, *StartDrainRequest) (*StartDrainResponse, error)
+	// CancelDrain removes a drain request made by StartDrain.
+	CancelDrain(context.Context, *CancelDrainRequest) (*CancelDrainResponse, error)
+	// DescribeDrain reports the progress of each drain request.
+	DescribeDrain(context.Context, *DescribeDrainRequest) (*DescribeDrainResponse, error)
+	// GetComponentHealth probes each subsystem the cluster depends on, as seen from the frontend host serving the
+	// request. Failing probes are reported in the response rather than failing the call.
+	GetComponentHealth(context.Context, *GetComponentHealthRequest) (*GetComponentHealthResponse, error)
+	// ListMetrics returns the metrics the server can emit, sorted by name.
+	ListMetrics(context.Context, *ListMetricsRequest) (*ListMetricsResponse, error)
+	// DescribeShardDistribution lists every history shard with the host it is assigned to and the state of its
+	// queues, along with how the shards are distributed across history hosts.
+	DescribeShardDistribution(context.Context, *DescribeShardDistributionRequest) (*DescribeShardDistributionResponse, error)
+	// DescribeShard returns the live state of a history shard, as seen by the history host owning it: the tasks
+	// its queues hold in memory and the state of its replication stream senders.
+	DescribeShard(context.Context, *DescribeShardRequest) (*DescribeShardResponse, error)
+	// ImportWorkflowExecution creates a run from history events exported from another cluster, or continues the
+	// import of a run started by a previous request.
+	ImportWorkflowExecution(context.Context, *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error)
+	// DescribeNamespaceStats computes capacity statistics of a namespace from visibility, the storage usage
+	// recorded by the storage usage scanner and the actions served by the frontend.
+	DescribeNamespaceStats(context.Context, *DescribeNamespaceStatsRequest) (*DescribeNamespaceStatsResponse, error)
+	// DescribeNamespaceReplicationStatus reports the replication lag towards each remote cluster of a namespace,
+	// its pending handover progress and its last failover.
+	DescribeNamespaceReplicationStatus(context.Context, *DescribeNamespaceReplicationStatusRequest) (*DescribeNamespaceReplicationStatusResponse, error)
+	// StartBatchOperation starts a batch job sending an update to, or resetting to a worker build ID, the workflows
+	// of a namespace. These operation types aren't supported by the public StartBatchOperation API.
+	StartBatchOperation(context.Context, *StartBatchOperationRequest) (*StartBatchOperationResponse, error)
+	// GetSDKUsage returns the number of requests the frontend host serving the request received since it started,
+	// by API method, SDK name and version, and namespace.
+	GetSDKUsage(context.Context, *GetSDKUsageRequest) (*GetSDKUsageResponse, error)
+}
+
+// UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAdminServiceServer struct {
+}
+
+func (*UnimplementedAdminServiceServer) RebuildMutableState(ctx context.Context, req *RebuildMutableStateRequest) (*RebuildMutableStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildMutableState not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeMutableState(ctx context.Context, req *DescribeMutableStateRequest) (*DescribeMutableStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeMutableState not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeHistoryHost(ctx context.Context, req *DescribeHistoryHostRequest) (*DescribeHistoryHostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeHistoryHost not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetShard(ctx context.Context, req *GetShardRequest) (*GetShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShard not implemented")
+}
+func (*UnimplementedAdminServiceServer) CloseShard(ctx context.Context, req *CloseShardRequest) (*CloseShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseShard not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListHistoryTasks(ctx context.Context, req *ListHistoryTasksRequest) (*ListHistoryTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHistoryTasks not implemented")
+}
+func (*UnimplementedAdminServiceServer) RemoveTask(ctx context.Context, req *RemoveTaskRequest) (*RemoveTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTask not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetWorkflowExecutionRawHistoryV2(ctx context.Context, req *GetWorkflowExecutionRawHistoryV2Request) (*GetWorkflowExecutionRawHistoryV2Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowExecutionRawHistoryV2 not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetReplicationMessages(ctx context.Context, req *GetReplicationMessagesRequest) (*GetReplicationMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplicationMessages not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetNamespaceReplicationMessages(ctx context.Context, req *GetNamespaceReplicationMessagesRequest) (*GetNamespaceReplicationMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceReplicationMessages not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetDLQReplicationMessages(ctx context.Context, req *GetDLQReplicationMessagesRequest) (*GetDLQReplicationMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDLQReplicationMessages not implemented")
+}
+func (*UnimplementedAdminServiceServer) ReapplyEvents(ctx context.Context, req *ReapplyEventsRequest) (*ReapplyEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReapplyEvents not implemented")
+}
+func (*UnimplementedAdminServiceServer) AddSearchAttributes(ctx context.Context, req *AddSearchAttributesRequest) (*AddSearchAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSearchAttributes not implemented")
+}
+func (*UnimplementedAdminServiceServer) RemoveSearchAttributes(ctx context.Context, req *RemoveSearchAttributesRequest) (*RemoveSearchAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSearchAttributes not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetSearchAttributes(ctx context.Context, req *GetSearchAttributesRequest) (*GetSearchAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSearchAttributes not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeCluster(ctx context.Context, req *DescribeClusterRequest) (*DescribeClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeCluster not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListClusters(ctx context.Context, req *ListClustersRequest) (*ListClustersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClusters not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListClusterMembers(ctx context.Context, req *ListClusterMembersRequest) (*ListClusterMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClusterMembers not implemented")
+}
+func (*UnimplementedAdminServiceServer) AddOrUpdateRemoteCluster(ctx context.Context, req *AddOrUpdateRemoteClusterRequest) (*AddOrUpdateRemoteClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOrUpdateRemoteCluster not implemented")
+}
+func (*UnimplementedAdminServiceServer) RemoveRemoteCluster(ctx context.Context, req *RemoveRemoteClusterRequest) (*RemoveRemoteClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRemoteCluster not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetDLQMessages(ctx context.Context, req *GetDLQMessagesRequest) (*GetDLQMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDLQMessages not implemented")
+}
+func (*UnimplementedAdminServiceServer) PurgeDLQMessages(ctx context.Context, req *PurgeDLQMessagesRequest) (*PurgeDLQMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDLQMessages not implemented")
+}
+func (*UnimplementedAdminServiceServer) MergeDLQMessages(ctx context.Context, req *MergeDLQMessagesRequest) (*MergeDLQMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeDLQMessages not implemented")
+}
+func (*UnimplementedAdminServiceServer) RefreshWorkflowTasks(ctx context.Context, req *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshWorkflowTasks not implemented")
+}
+func (*UnimplementedAdminServiceServer) ResendReplicationTasks(ctx context.Context, req *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendReplicationTasks not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetTaskQueueTasks(ctx context.Context, req *GetTaskQueueTasksRequest) (*GetTaskQueueTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskQueueTasks not implemented")
+}
+func (*UnimplementedAdminServiceServer) DeleteWorkflowExecution(ctx context.Context, req *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflowExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) StreamWorkflowReplicationMessages(srv AdminService_StreamWorkflowReplicationMessagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamWorkflowReplicationMessages not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeTaskQueueTopology(ctx context.Context, req *DescribeTaskQueueTopologyRequest) (*DescribeTaskQueueTopologyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskQueueTopology not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateWorkflowExecutionMemo(ctx context.Context, req *UpdateWorkflowExecutionMemoRequest) (*UpdateWorkflowExecutionMemoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkflowExecutionMemo not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpsertWorkflowExecutionSearchAttributes(ctx context.Context, req *UpsertWorkflowExecutionSearchAttributesRequest) (*UpsertWorkflowExecutionSearchAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertWorkflowExecutionSearchAttributes not implemented")
+}
+func (*UnimplementedAdminServiceServer) StreamDatabaseBackup(req *StreamDatabaseBackupRequest, srv AdminService_StreamDatabaseBackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamDatabaseBackup not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribePersistenceCircuitBreakers(ctx context.Context, req *DescribePersistenceCircuitBreakersRequest) (*DescribePersistenceCircuitBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribePersistenceCircuitBreakers not implemented")
+}
+func (*UnimplementedAdminServiceServer) CreateClusterSnapshot(ctx context.Context, req *CreateClusterSnapshotRequest) (*CreateClusterSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClusterSnapshot not implemented")
+}
+func (*UnimplementedAdminServiceServer) RestoreClusterSnapshot(ctx context.Context, req *RestoreClusterSnapshotRequest) (*RestoreClusterSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreClusterSnapshot not implemented")
+}
+func (*UnimplementedAdminServiceServer) CheckVisibilityConsistency(ctx context.Context, req *CheckVisibilityConsistencyRequest) (*CheckVisibilityConsistencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckVisibilityConsistency not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListTopPersistenceNamespaces(ctx context.Context, req *ListTopPersistenceNamespacesRequest) (*ListTopPersistenceNamespacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTopPersistenceNamespaces not implemented")
+}
+func (*UnimplementedAdminServiceServer) AddSearchAttributeAliases(ctx context.Context, req *AddSearchAttributeAliasesRequest) (*AddSearchAttributeAliasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSearchAttributeAliases not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeVisibilityIngestion(ctx context.Context, req *DescribeVisibilityIngestionRequest) (*DescribeVisibilityIngestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeVisibilityIngestion not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListArchivalDLQTasks(ctx context.Context, req *ListArchivalDLQTasksRequest) (*ListArchivalDLQTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArchivalDLQTasks not implemented")
+}
+func (*UnimplementedAdminServiceServer) RetryArchivalDLQTask(ctx context.Context, req *RetryArchivalDLQTaskRequest) (*RetryArchivalDLQTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryArchivalDLQTask not implemented")
+}
+func (*UnimplementedAdminServiceServer) RehydrateWorkflowExecution(ctx context.Context, req *RehydrateWorkflowExecutionRequest) (*RehydrateWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RehydrateWorkflowExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeScheduleBackfills(ctx context.Context, req *DescribeScheduleBackfillsRequest) (*DescribeScheduleBackfillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeScheduleBackfills not implemented")
+}
+func (*UnimplementedAdminServiceServer) CancelScheduleBackfill(ctx context.Context, req *CancelScheduleBackfillRequest) (*CancelScheduleBackfillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduleBackfill not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeSchedulePauseAfterFailures(ctx context.Context, req *DescribeSchedulePauseAfterFailuresRequest) (*DescribeSchedulePauseAfterFailuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeSchedulePauseAfterFailures not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateSchedulePauseAfterFailures(ctx context.Context, req *UpdateSchedulePauseAfterFailuresRequest) (*UpdateSchedulePauseAfterFailuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSchedulePauseAfterFailures not implemented")
+}
+func (*UnimplementedAdminServiceServer) DeleteHistoryBranchGarbage(ctx context.Context, req *DeleteHistoryBranchGarbageRequest) (*DeleteHistoryBranchGarbageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHistoryBranchGarbage not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeNamespaceDeletion(ctx context.Context, req *DescribeNamespaceDeletionRequest) (*DescribeNamespaceDeletionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeNamespaceDeletion not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateNamespaceDeletionRate(ctx context.Context, req *UpdateNamespaceDeletionRateRequest) (*UpdateNamespaceDeletionRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamespaceDeletionRate not implemented")
+}
+func (*UnimplementedAdminServiceServer) StartNamespaceExport(ctx context.Context, req *StartNamespaceExportRequest) (*StartNamespaceExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartNamespaceExport not implemented")
+}
+func (*UnimplementedAdminServiceServer) CreateAPIKey(ctx context.Context, req *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (*UnimplementedAdminServiceServer) RotateAPIKey(ctx context.Context, req *RotateAPIKeyRequest) (*RotateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateAPIKey not implemented")
+}
+func (*UnimplementedAdminServiceServer) RevokeAPIKey(ctx context.Context, req *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListAPIKeys(ctx context.Context, req *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (*UnimplementedAdminServiceServer) PutRole(ctx context.Context, req *PutRoleRequest) (*PutRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutRole not implemented")
+}
+func (*UnimplementedAdminServiceServer) DeleteRole(ctx context.Context, req *DeleteRoleRequest) (*DeleteRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListRoles(ctx context.Context, req *ListRolesRequest) (*ListRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
+}
+func (*UnimplementedAdminServiceServer) SetGroupRoles(ctx context.Context, req *SetGroupRolesRequest) (*SetGroupRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupRoles not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetGroupRoles(ctx context.Context, req *GetGroupRolesRequest) (*GetGroupRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupRoles not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetDynamicConfigOverrides(ctx context.Context, req *GetDynamicConfigOverridesRequest) (*GetDynamicConfigOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDynamicConfigOverrides not implemented")
+}
+func (*UnimplementedAdminServiceServer) SetDynamicConfigOverride(ctx context.Context, req *SetDynamicConfigOverrideRequest) (*SetDynamicConfigOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDynamicConfigOverride not implemented")
+}
+func (*UnimplementedAdminServiceServer) DeleteDynamicConfigOverride(ctx context.Context, req *DeleteDynamicConfigOverrideRequest) (*DeleteDynamicConfigOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDynamicConfigOverride not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListDynamicConfigChanges(ctx context.Context, req *ListDynamicConfigChangesRequest) (*ListDynamicConfigChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDynamicConfigChanges not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetEffectiveDynamicConfig(ctx context.Context, req *GetEffectiveDynamicConfigRequest) (*GetEffectiveDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEffectiveDynamicConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) SetDynamicConfigRollout(ctx context.Context, req *SetDynamicConfigRolloutRequest) (*SetDynamicConfigRolloutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDynamicConfigRollout not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetNamespaceFeatureFlags(ctx context.Context, req *GetNamespaceFeatureFlagsRequest) (*GetNamespaceFeatureFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceFeatureFlags not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetNamespaceVisibilityRetention(ctx context.Context, req *GetNamespaceVisibilityRetentionRequest) (*GetNamespaceVisibilityRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceVisibilityRetention not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateNamespaceVisibilityRetention(ctx context.Context, req *UpdateNamespaceVisibilityRetentionRequest) (*UpdateNamespaceVisibilityRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamespaceVisibilityRetention not implemented")
+}
+func (*UnimplementedAdminServiceServer) StreamDiagnosticsBundle(req *StreamDiagnosticsBundleRequest, srv AdminService_StreamDiagnosticsBundleServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamDiagnosticsBundle not implemented")
+}
+func (*UnimplementedAdminServiceServer) StartDrain(ctx context.Context, req *StartDrainRequest) (*StartDrainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartDrain not implemented")
+}
+func (*UnimplementedAdminServiceServer) CancelDrain(ctx context.Context, req *CancelDrainRequest) (*CancelDrainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDrain not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeDrain(ctx context.Context, req *DescribeDrainRequest) (*DescribeDrainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeDrain not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetComponentHealth(ctx context.Context, req *GetComponentHealthRequest) (*GetComponentHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComponentHealth not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListMetrics(ctx context.Context, req *ListMetricsRequest) (*ListMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMetrics not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeShardDistribution(ctx context.Context, req *DescribeShardDistributionRequest) (*DescribeShardDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeShardDistribution not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeShard(ctx context.Context, req *DescribeShardRequest) (*DescribeShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeShard not implemented")
+}
+func (*UnimplementedAdminServiceServer) ImportWorkflowExecution(ctx context.Context, req *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportWorkflowExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeNamespaceStats(ctx context.Context, req *DescribeNamespaceStatsRequest) (*DescribeNamespaceStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeNamespaceStats not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeNamespaceReplicationStatus(ctx context.Context, req *DescribeNamespaceReplicationStatusRequest) (*DescribeNamespaceReplicationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeNamespaceReplicationStatus not implemented")
+}
+func (*UnimplementedAdminServiceServer) StartBatchOperation(ctx context.Context, req *StartBatchOperationRequest) (*StartBatchOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartBatchOperation not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetSDKUsage(ctx context.Context, req *GetSDKUsageRequest) (*GetSDKUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSDKUsage not implemented")
+}
+
+func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
+	s.RegisterService(&_AdminService_serviceDesc, srv)
+}
+
+func _AdminService_RebuildMutableState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildMutableStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RebuildMutableState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RebuildMutableState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RebuildMutableState(ctx, req.(*RebuildMutableStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeMutableState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeMutableStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeMutableState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeMutableState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeMutableState(ctx, req.(*DescribeMutableStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeHistoryHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeHistoryHostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeHistoryHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryHost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeHistoryHost(ctx, req.(*DescribeHistoryHostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetShard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetShard(ctx, req.(*GetShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CloseShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CloseShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/CloseShard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CloseShard(ctx, req.(*CloseShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListHistoryTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHistoryTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListHistoryTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListHistoryTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListHistoryTasks(ctx, req.(*ListHistoryTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RemoveTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveTask(ctx, req.(*RemoveTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetWorkflowExecutionRawHistoryV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowExecutionRawHistoryV2Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetWorkflowExecutionRawHistoryV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistoryV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetWorkflowExecutionRawHistoryV2(ctx, req.(*GetWorkflowExecutionRawHistoryV2Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetReplicationMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReplicationMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetReplicationMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetReplicationMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetReplicationMessages(ctx, req.(*GetReplicationMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetNamespaceReplicationMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceReplicationMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetNamespaceReplicationMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetNamespaceReplicationMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetNamespaceReplicationMessages(ctx, req.(*GetNamespaceReplicationMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetDLQReplicationMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDLQReplicationMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDLQReplicationMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetDLQReplicationMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDLQReplicationMessages(ctx, req.(*GetDLQReplicationMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReapplyEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReapplyEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReapplyEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ReapplyEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReapplyEvents(ctx, req.(*ReapplyEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AddSearchAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSearchAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddSearchAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/AddSearchAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddSearchAttributes(ctx, req.(*AddSearchAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveSearchAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSearchAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveSearchAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RemoveSearchAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveSearchAttributes(ctx, req.(*RemoveSearchAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetSearchAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSearchAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetSearchAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetSearchAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetSearchAttributes(ctx, req.(*GetSearchAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeCluster(ctx, req.(*DescribeClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClustersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListClusters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListClusters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListClusters(ctx, req.(*ListClustersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListClusterMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClusterMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListClusterMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListClusterMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListClusterMembers(ctx, req.(*ListClusterMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AddOrUpdateRemoteCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOrUpdateRemoteClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddOrUpdateRemoteCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/AddOrUpdateRemoteCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddOrUpdateRemoteCluster(ctx, req.(*AddOrUpdateRemoteClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveRemoteCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRemoteClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveRemoteCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RemoveRemoteCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveRemoteCluster(ctx, req.(*RemoveRemoteClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetDLQMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDLQMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDLQMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetDLQMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDLQMessages(ctx, req.(*GetDLQMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedAdminServiceServer struct {
+func _AdminService_PurgeDLQMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeDLQMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PurgeDLQMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PurgeDLQMessages(ctx, req.(*PurgeDLQMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (*UnimplementedAdminServiceServer) RebuildMutableState(ctx context.Context, req *RebuildMutableStateRequest) (*RebuildMutableStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RebuildMutableState not implemented")
+func _AdminService_MergeDLQMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeDLQMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).MergeDLQMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/MergeDLQMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).MergeDLQMessages(ctx, req.(*MergeDLQMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) DescribeMutableState(ctx context.Context, req *DescribeMutableStateRequest) (*DescribeMutableStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DescribeMutableState not implemented")
+
+func _AdminService_RefreshWorkflowTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshWorkflowTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RefreshWorkflowTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RefreshWorkflowTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RefreshWorkflowTasks(ctx, req.(*RefreshWorkflowTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) DescribeHistoryHost(ctx context.Context, req *DescribeHistoryHostRequest) (*DescribeHistoryHostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DescribeHistoryHost not implemented")
+
+func _AdminService_ResendReplicationTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResendReplicationTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResendReplicationTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ResendReplicationTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResendReplicationTasks(ctx, req.(*ResendReplicationTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) GetShard(ctx context.Context, req *GetShardRequest) (*GetShardResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetShard not implemented")
+
+func _AdminService_GetTaskQueueTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskQueueTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetTaskQueueTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetTaskQueueTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetTaskQueueTasks(ctx, req.(*GetTaskQueueTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) CloseShard(ctx context.Context, req *CloseShardRequest) (*CloseShardResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CloseShard not implemented")
+
+func _AdminService_DeleteWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DeleteWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteWorkflowExecution(ctx, req.(*DeleteWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) ListHistoryTasks(ctx context.Context, req *ListHistoryTasksRequest) (*ListHistoryTasksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListHistoryTasks not implemented")
+
+func _AdminService_StreamWorkflowReplicationMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AdminServiceServer).StreamWorkflowReplicationMessages(&adminServiceStreamWorkflowReplicationMessagesServer{stream})
 }
-func (*UnimplementedAdminServiceServer) RemoveTask(ctx context.Context, req *RemoveTaskRequest) (*RemoveTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveTask not implemented")
+
+type AdminService_StreamWorkflowReplicationMessagesServer interface {
+	Send(*StreamWorkflowReplicationMessagesResponse) error
+	Recv() (*StreamWorkflowReplicationMessagesRequest, error)
+	grpc.ServerStream
 }
-func (*UnimplementedAdminServiceServer) GetWorkflowExecutionRawHistoryV2(ctx context.Context, req *GetWorkflowExecutionRawHistoryV2Request) (*GetWorkflowExecutionRawHistoryV2Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowExecutionRawHistoryV2 not implemented")
+
+type adminServiceStreamWorkflowReplicationMessagesServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedAdminServiceServer) GetReplicationMessages(ctx context.Context, req *GetReplicationMessagesRequest) (*GetReplicationMessagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReplicationMessages not implemented")
+
+func (x *adminServiceStreamWorkflowReplicationMessagesServer) Send(m *StreamWorkflowReplicationMessagesResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedAdminServiceServer) GetNamespaceReplicationMessages(ctx context.Context, req *GetNamespaceReplicationMessagesRequest) (*GetNamespaceReplicationMessagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceReplicationMessages not implemented")
+
+func (x *adminServiceStreamWorkflowReplicationMessagesServer) Recv() (*StreamWorkflowReplicationMessagesRequest, error) {
+	m := new(StreamWorkflowReplicationMessagesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (*UnimplementedAdminServiceServer) GetDLQReplicationMessages(ctx context.Context, req *GetDLQReplicationMessagesRequest) (*GetDLQReplicationMessagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDLQReplicationMessages not implemented")
+
+func _AdminService_DescribeTaskQueueTopology_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTaskQueueTopologyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeTaskQueueTopology(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueueTopology",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeTaskQueueTopology(ctx, req.(*DescribeTaskQueueTopologyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) ReapplyEvents(ctx context.Context, req *ReapplyEventsRequest) (*ReapplyEventsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReapplyEvents not implemented")
+
+func _AdminService_UpdateWorkflowExecutionMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkflowExecutionMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateWorkflowExecutionMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateWorkflowExecutionMemo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateWorkflowExecutionMemo(ctx, req.(*UpdateWorkflowExecutionMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) AddSearchAttributes(ctx context.Context, req *AddSearchAttributesRequest) (*AddSearchAttributesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddSearchAttributes not implemented")
+
+func _AdminService_UpsertWorkflowExecutionSearchAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertWorkflowExecutionSearchAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpsertWorkflowExecutionSearchAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpsertWorkflowExecutionSearchAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpsertWorkflowExecutionSearchAttributes(ctx, req.(*UpsertWorkflowExecutionSearchAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StreamDatabaseBackup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamDatabaseBackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).StreamDatabaseBackup(m, &adminServiceStreamDatabaseBackupServer{stream})
+}
+
+type AdminService_StreamDatabaseBackupServer interface {
+	Send(*StreamDatabaseBackupResponse) error
+	grpc.ServerStream
+}
+
+type adminServiceStreamDatabaseBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminServiceStreamDatabaseBackupServer) Send(m *StreamDatabaseBackupResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AdminService_DescribePersistenceCircuitBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribePersistenceCircuitBreakersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribePersistenceCircuitBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribePersistenceCircuitBreakers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribePersistenceCircuitBreakers(ctx, req.(*DescribePersistenceCircuitBreakersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateClusterSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClusterSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateClusterSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/CreateClusterSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateClusterSnapshot(ctx, req.(*CreateClusterSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RestoreClusterSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreClusterSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RestoreClusterSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RestoreClusterSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RestoreClusterSnapshot(ctx, req.(*RestoreClusterSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CheckVisibilityConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckVisibilityConsistencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CheckVisibilityConsistency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/CheckVisibilityConsistency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CheckVisibilityConsistency(ctx, req.(*CheckVisibilityConsistencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListTopPersistenceNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopPersistenceNamespacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListTopPersistenceNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListTopPersistenceNamespaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListTopPersistenceNamespaces(ctx, req.(*ListTopPersistenceNamespacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) RemoveSearchAttributes(ctx context.Context, req *RemoveSearchAttributesRequest) (*RemoveSearchAttributesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveSearchAttributes not implemented")
+
+func _AdminService_AddSearchAttributeAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSearchAttributeAliasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddSearchAttributeAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/AddSearchAttributeAliases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddSearchAttributeAliases(ctx, req.(*AddSearchAttributeAliasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) GetSearchAttributes(ctx context.Context, req *GetSearchAttributesRequest) (*GetSearchAttributesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSearchAttributes not implemented")
+
+func _AdminService_DescribeVisibilityIngestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeVisibilityIngestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeVisibilityIngestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeVisibilityIngestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeVisibilityIngestion(ctx, req.(*DescribeVisibilityIngestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) DescribeCluster(ctx context.Context, req *DescribeClusterRequest) (*DescribeClusterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DescribeCluster not implemented")
+
+func _AdminService_ListArchivalDLQTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArchivalDLQTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListArchivalDLQTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListArchivalDLQTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListArchivalDLQTasks(ctx, req.(*ListArchivalDLQTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) ListClusters(ctx context.Context, req *ListClustersRequest) (*ListClustersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListClusters not implemented")
+
+func _AdminService_RetryArchivalDLQTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryArchivalDLQTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RetryArchivalDLQTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RetryArchivalDLQTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RetryArchivalDLQTask(ctx, req.(*RetryArchivalDLQTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) ListClusterMembers(ctx context.Context, req *ListClusterMembersRequest) (*ListClusterMembersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListClusterMembers not implemented")
+
+func _AdminService_RehydrateWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RehydrateWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RehydrateWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RehydrateWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RehydrateWorkflowExecution(ctx, req.(*RehydrateWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) AddOrUpdateRemoteCluster(ctx context.Context, req *AddOrUpdateRemoteClusterRequest) (*AddOrUpdateRemoteClusterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddOrUpdateRemoteCluster not implemented")
+
+func _AdminService_DescribeScheduleBackfills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeScheduleBackfillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeScheduleBackfills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeScheduleBackfills",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeScheduleBackfills(ctx, req.(*DescribeScheduleBackfillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) RemoveRemoteCluster(ctx context.Context, req *RemoveRemoteClusterRequest) (*RemoveRemoteClusterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveRemoteCluster not implemented")
+
+func _AdminService_CancelScheduleBackfill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduleBackfillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CancelScheduleBackfill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/CancelScheduleBackfill",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CancelScheduleBackfill(ctx, req.(*CancelScheduleBackfillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) GetDLQMessages(ctx context.Context, req *GetDLQMessagesRequest) (*GetDLQMessagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDLQMessages not implemented")
+
+func _AdminService_DescribeSchedulePauseAfterFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeSchedulePauseAfterFailuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeSchedulePauseAfterFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeSchedulePauseAfterFailures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeSchedulePauseAfterFailures(ctx, req.(*DescribeSchedulePauseAfterFailuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) PurgeDLQMessages(ctx context.Context, req *PurgeDLQMessagesRequest) (*PurgeDLQMessagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PurgeDLQMessages not implemented")
+
+func _AdminService_UpdateSchedulePauseAfterFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSchedulePauseAfterFailuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateSchedulePauseAfterFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateSchedulePauseAfterFailures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateSchedulePauseAfterFailures(ctx, req.(*UpdateSchedulePauseAfterFailuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) MergeDLQMessages(ctx context.Context, req *MergeDLQMessagesRequest) (*MergeDLQMessagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MergeDLQMessages not implemented")
+
+func _AdminService_DeleteHistoryBranchGarbage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHistoryBranchGarbageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteHistoryBranchGarbage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DeleteHistoryBranchGarbage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteHistoryBranchGarbage(ctx, req.(*DeleteHistoryBranchGarbageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) RefreshWorkflowTasks(ctx context.Context, req *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RefreshWorkflowTasks not implemented")
+
+func _AdminService_DescribeNamespaceDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeNamespaceDeletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeNamespaceDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeNamespaceDeletion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeNamespaceDeletion(ctx, req.(*DescribeNamespaceDeletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) ResendReplicationTasks(ctx context.Context, req *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResendReplicationTasks not implemented")
+
+func _AdminService_UpdateNamespaceDeletionRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNamespaceDeletionRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateNamespaceDeletionRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateNamespaceDeletionRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateNamespaceDeletionRate(ctx, req.(*UpdateNamespaceDeletionRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) GetTaskQueueTasks(ctx context.Context, req *GetTaskQueueTasksRequest) (*GetTaskQueueTasksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTaskQueueTasks not implemented")
+
+func _AdminService_StartNamespaceExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartNamespaceExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartNamespaceExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/StartNamespaceExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartNamespaceExport(ctx, req.(*StartNamespaceExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) DeleteWorkflowExecution(ctx context.Context, req *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflowExecution not implemented")
+
+func _AdminService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/CreateAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) StreamWorkflowReplicationMessages(srv AdminService_StreamWorkflowReplicationMessagesServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamWorkflowReplicationMessages not implemented")
+
+func _AdminService_RotateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RotateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RotateAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RotateAPIKey(ctx, req.(*RotateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
-	s.RegisterService(&_AdminService_serviceDesc, srv)
+func _AdminService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RevokeAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_RebuildMutableState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RebuildMutableStateRequest)
+func _AdminService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).RebuildMutableState(ctx, in)
+		return srv.(AdminServiceServer).ListAPIKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RebuildMutableState",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListAPIKeys",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).RebuildMutableState(ctx, req.(*RebuildMutableStateRequest))
+		return srv.(AdminServiceServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_DescribeMutableState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DescribeMutableStateRequest)
+func _AdminService_PutRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).DescribeMutableState(ctx, in)
+		return srv.(AdminServiceServer).PutRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeMutableState",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/PutRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).DescribeMutableState(ctx, req.(*DescribeMutableStateRequest))
+		return srv.(AdminServiceServer).PutRole(ctx, req.(*PutRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_DescribeHistoryHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DescribeHistoryHostRequest)
+func _AdminService_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).DescribeHistoryHost(ctx, in)
+		return srv.(AdminServiceServer).DeleteRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryHost",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DeleteRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).DescribeHistoryHost(ctx, req.(*DescribeHistoryHostRequest))
+		return srv.(AdminServiceServer).DeleteRole(ctx, req.(*DeleteRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_GetShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetShardRequest)
+func _AdminService_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).GetShard(ctx, in)
+		return srv.(AdminServiceServer).ListRoles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetShard",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListRoles",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetShard(ctx, req.(*GetShardRequest))
+		return srv.(AdminServiceServer).ListRoles(ctx, req.(*ListRolesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_CloseShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CloseShardRequest)
+func _AdminService_SetGroupRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupRolesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).CloseShard(ctx, in)
+		return srv.(AdminServiceServer).SetGroupRoles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/CloseShard",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/SetGroupRoles",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).CloseShard(ctx, req.(*CloseShardRequest))
+		return srv.(AdminServiceServer).SetGroupRoles(ctx, req.(*SetGroupRolesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_ListHistoryTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHistoryTasksRequest)
+func _AdminService_GetGroupRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRolesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).ListHistoryTasks(ctx, in)
+		return srv.(AdminServiceServer).GetGroupRoles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListHistoryTasks",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetGroupRoles",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).ListHistoryTasks(ctx, req.(*ListHistoryTasksRequest))
+		return srv.(AdminServiceServer).GetGroupRoles(ctx, req.(*GetGroupRolesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_RemoveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveTaskRequest)
+func _AdminService_GetDynamicConfigOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDynamicConfigOverridesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).RemoveTask(ctx, in)
+		return srv.(AdminServiceServer).GetDynamicConfigOverrides(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RemoveTask",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetDynamicConfigOverrides",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).RemoveTask(ctx, req.(*RemoveTaskRequest))
+		return srv.(AdminServiceServer).GetDynamicConfigOverrides(ctx, req.(*GetDynamicConfigOverridesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_GetWorkflowExecutionRawHistoryV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWorkflowExecutionRawHistoryV2Request)
+func _AdminService_SetDynamicConfigOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDynamicConfigOverrideRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).GetWorkflowExecutionRawHistoryV2(ctx, in)
+		return srv.(AdminServiceServer).SetDynamicConfigOverride(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistoryV2",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/SetDynamicConfigOverride",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetWorkflowExecutionRawHistoryV2(ctx, req.(*GetWorkflowExecutionRawHistoryV2Request))
+		return srv.(AdminServiceServer).SetDynamicConfigOverride(ctx, req.(*SetDynamicConfigOverrideRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_GetReplicationMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReplicationMessagesRequest)
+func _AdminService_DeleteDynamicConfigOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDynamicConfigOverrideRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).GetReplicationMessages(ctx, in)
+		return srv.(AdminServiceServer).DeleteDynamicConfigOverride(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetReplicationMessages",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DeleteDynamicConfigOverride",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetReplicationMessages(ctx, req.(*GetReplicationMessagesRequest))
+		return srv.(AdminServiceServer).DeleteDynamicConfigOverride(ctx, req.(*DeleteDynamicConfigOverrideRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_GetNamespaceReplicationMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNamespaceReplicationMessagesRequest)
+func _AdminService_ListDynamicConfigChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDynamicConfigChangesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).GetNamespaceReplicationMessages(ctx, in)
+		return srv.(AdminServiceServer).ListDynamicConfigChanges(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetNamespaceReplicationMessages",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfigChanges",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetNamespaceReplicationMessages(ctx, req.(*GetNamespaceReplicationMessagesRequest))
+		return srv.(AdminServiceServer).ListDynamicConfigChanges(ctx, req.(*ListDynamicConfigChangesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_GetDLQReplicationMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDLQReplicationMessagesRequest)
+func _AdminService_GetEffectiveDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEffectiveDynamicConfigRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).GetDLQReplicationMessages(ctx, in)
+		return srv.(AdminServiceServer).GetEffectiveDynamicConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetDLQReplicationMessages",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetEffectiveDynamicConfig",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetDLQReplicationMessages(ctx, req.(*GetDLQReplicationMessagesRequest))
+		return srv.(AdminServiceServer).GetEffectiveDynamicConfig(ctx, req.(*GetEffectiveDynamicConfigRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_ReapplyEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReapplyEventsRequest)
+func _AdminService_SetDynamicConfigRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDynamicConfigRolloutRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).ReapplyEvents(ctx, in)
+		return srv.(AdminServiceServer).SetDynamicConfigRollout(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ReapplyEvents",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/SetDynamicConfigRollout",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).ReapplyEvents(ctx, req.(*ReapplyEventsRequest))
+		return srv.(AdminServiceServer).SetDynamicConfigRollout(ctx, req.(*SetDynamicConfigRolloutRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_AddSearchAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddSearchAttributesRequest)
+func _AdminService_GetNamespaceFeatureFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceFeatureFlagsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).AddSearchAttributes(ctx, in)
+		return srv.(AdminServiceServer).GetNamespaceFeatureFlags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/AddSearchAttributes",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetNamespaceFeatureFlags",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).AddSearchAttributes(ctx, req.(*AddSearchAttributesRequest))
+		return srv.(AdminServiceServer).GetNamespaceFeatureFlags(ctx, req.(*GetNamespaceFeatureFlagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_RemoveSearchAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveSearchAttributesRequest)
+func _AdminService_GetNamespaceVisibilityRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceVisibilityRetentionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).RemoveSearchAttributes(ctx, in)
+		return srv.(AdminServiceServer).GetNamespaceVisibilityRetention(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RemoveSearchAttributes",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetNamespaceVisibilityRetention",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).RemoveSearchAttributes(ctx, req.(*RemoveSearchAttributesRequest))
+		return srv.(AdminServiceServer).GetNamespaceVisibilityRetention(ctx, req.(*GetNamespaceVisibilityRetentionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_GetSearchAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSearchAttributesRequest)
+func _AdminService_UpdateNamespaceVisibilityRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNamespaceVisibilityRetentionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).GetSearchAttributes(ctx, in)
+		return srv.(AdminServiceServer).UpdateNamespaceVisibilityRetention(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetSearchAttributes",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateNamespaceVisibilityRetention",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetSearchAttributes(ctx, req.(*GetSearchAttributesRequest))
+		return srv.(AdminServiceServer).UpdateNamespaceVisibilityRetention(ctx, req.(*UpdateNamespaceVisibilityRetentionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_DescribeCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DescribeClusterRequest)
+func _AdminService_StreamDiagnosticsBundle_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamDiagnosticsBundleRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).StreamDiagnosticsBundle(m, &adminServiceStreamDiagnosticsBundleServer{stream})
+}
+
+type AdminService_StreamDiagnosticsBundleServer interface {
+	Send(*StreamDiagnosticsBundleResponse) error
+	grpc.ServerStream
+}
+
+type adminServiceStreamDiagnosticsBundleServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminServiceStreamDiagnosticsBundleServer) Send(m *StreamDiagnosticsBundleResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AdminService_StartDrain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartDrainRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).DescribeCluster(ctx, in)
+		return srv.(AdminServiceServer).StartDrain(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeCluster",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/StartDrain",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).DescribeCluster(ctx, req.(*DescribeClusterRequest))
+		return srv.(AdminServiceServer).StartDrain(ctx, req.(*StartDrainRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_ListClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListClustersRequest)
+func _AdminService_CancelDrain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDrainRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).ListClusters(ctx, in)
+		return srv.(AdminServiceServer).CancelDrain(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListClusters",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/CancelDrain",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).ListClusters(ctx, req.(*ListClustersRequest))
+		return srv.(AdminServiceServer).CancelDrain(ctx, req.(*CancelDrainRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_ListClusterMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListClusterMembersRequest)
+func _AdminService_DescribeDrain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeDrainRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).ListClusterMembers(ctx, in)
+		return srv.(AdminServiceServer).DescribeDrain(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListClusterMembers",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeDrain",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).ListClusterMembers(ctx, req.(*ListClusterMembersRequest))
+		return srv.(AdminServiceServer).DescribeDrain(ctx, req.(*DescribeDrainRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_AddOrUpdateRemoteCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddOrUpdateRemoteClusterRequest)
+func _AdminService_GetComponentHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetComponentHealthRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).AddOrUpdateRemoteCluster(ctx, in)
+		return srv.(AdminServiceServer).GetComponentHealth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/AddOrUpdateRemoteCluster",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetComponentHealth",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).AddOrUpdateRemoteCluster(ctx, req.(*AddOrUpdateRemoteClusterRequest))
+		return srv.(AdminServiceServer).GetComponentHealth(ctx, req.(*GetComponentHealthRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_RemoveRemoteCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveRemoteClusterRequest)
+func _AdminService_ListMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMetricsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).RemoveRemoteCluster(ctx, in)
+		return srv.(AdminServiceServer).ListMetrics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RemoveRemoteCluster",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListMetrics",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).RemoveRemoteCluster(ctx, req.(*RemoveRemoteClusterRequest))
+		return srv.(AdminServiceServer).ListMetrics(ctx, req.(*ListMetricsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_GetDLQMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDLQMessagesRequest)
+func _AdminService_DescribeShardDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeShardDistributionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).GetDLQMessages(ctx, in)
+		return srv.(AdminServiceServer).DescribeShardDistribution(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetDLQMessages",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeShardDistribution",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetDLQMessages(ctx, req.(*GetDLQMessagesRequest))
+		return srv.(AdminServiceServer).DescribeShardDistribution(ctx, req.(*DescribeShardDistributionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_PurgeDLQMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PurgeDLQMessagesRequest)
+func _AdminService_DescribeShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeShardRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).PurgeDLQMessages(ctx, in)
+		return srv.(AdminServiceServer).DescribeShard(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQMessages",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeShard",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).PurgeDLQMessages(ctx, req.(*PurgeDLQMessagesRequest))
+		return srv.(AdminServiceServer).DescribeShard(ctx, req.(*DescribeShardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_MergeDLQMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MergeDLQMessagesRequest)
+func _AdminService_ImportWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportWorkflowExecutionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).MergeDLQMessages(ctx, in)
+		return srv.(AdminServiceServer).ImportWorkflowExecution(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/MergeDLQMessages",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ImportWorkflowExecution",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).MergeDLQMessages(ctx, req.(*MergeDLQMessagesRequest))
+		return srv.(AdminServiceServer).ImportWorkflowExecution(ctx, req.(*ImportWorkflowExecutionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_RefreshWorkflowTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RefreshWorkflowTasksRequest)
+func _AdminService_DescribeNamespaceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeNamespaceStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).RefreshWorkflowTasks(ctx, in)
+		return srv.(AdminServiceServer).DescribeNamespaceStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RefreshWorkflowTasks",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeNamespaceStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).RefreshWorkflowTasks(ctx, req.(*RefreshWorkflowTasksRequest))
+		return srv.(AdminServiceServer).DescribeNamespaceStats(ctx, req.(*DescribeNamespaceStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_ResendReplicationTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResendReplicationTasksRequest)
+func _AdminService_DescribeNamespaceReplicationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeNamespaceReplicationStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).ResendReplicationTasks(ctx, in)
+		return srv.(AdminServiceServer).DescribeNamespaceReplicationStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ResendReplicationTasks",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeNamespaceReplicationStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).ResendReplicationTasks(ctx, req.(*ResendReplicationTasksRequest))
+		return srv.(AdminServiceServer).DescribeNamespaceReplicationStatus(ctx, req.(*DescribeNamespaceReplicationStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_GetTaskQueueTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTaskQueueTasksRequest)
+func _AdminService_StartBatchOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartBatchOperationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).GetTaskQueueTasks(ctx, in)
+		return srv.(AdminServiceServer).StartBatchOperation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetTaskQueueTasks",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/StartBatchOperation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetTaskQueueTasks(ctx, req.(*GetTaskQueueTasksRequest))
+		return srv.(AdminServiceServer).StartBatchOperation(ctx, req.(*StartBatchOperationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_DeleteWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteWorkflowExecutionRequest)
+func _AdminService_GetSDKUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSDKUsageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).DeleteWorkflowExecution(ctx, in)
+		return srv.(AdminServiceServer).GetSDKUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DeleteWorkflowExecution",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetSDKUsage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).DeleteWorkflowExecution(ctx, req.(*DeleteWorkflowExecutionRequest))
+		return srv.(AdminServiceServer).GetSDKUsage(ctx, req.(*GetSDKUsageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_StreamWorkflowReplicationMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(AdminServiceServer).StreamWorkflowReplicationMessages(&adminServiceStreamWorkflowReplicationMessagesServer{stream})
-}
-
-type AdminService_StreamWorkflowReplicationMessagesServer interface {
-	Send(*StreamWorkflowReplicationMessagesResponse) error
-	Recv() (*StreamWorkflowReplicationMessagesRequest, error)
-	grpc.ServerStream
-}
-
-type adminServiceStreamWorkflowReplicationMessagesServer struct {
-	grpc.ServerStream
-}
-
-func (x *adminServiceStreamWorkflowReplicationMessagesServer) Send(m *StreamWorkflowReplicationMessagesResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *adminServiceStreamWorkflowReplicationMessagesServer) Recv() (*StreamWorkflowReplicationMessagesRequest, error) {
-	m := new(StreamWorkflowReplicationMessagesRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
 var _AdminService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.adminservice.v1.AdminService",
 	HandlerType: (*AdminServiceServer)(nil),
@@ -1250,6 +3241,210 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteWorkflowExecution",
 			Handler:    _AdminService_DeleteWorkflowExecution_Handler,
 		},
+		{
+			MethodName: "DescribeTaskQueueTopology",
+			Handler:    _AdminService_DescribeTaskQueueTopology_Handler,
+		},
+		{
+			MethodName: "UpdateWorkflowExecutionMemo",
+			Handler:    _AdminService_UpdateWorkflowExecutionMemo_Handler,
+		},
+		{
+			MethodName: "UpsertWorkflowExecutionSearchAttributes",
+			Handler:    _AdminService_UpsertWorkflowExecutionSearchAttributes_Handler,
+		},
+		{
+			MethodName: "DescribePersistenceCircuitBreakers",
+			Handler:    _AdminService_DescribePersistenceCircuitBreakers_Handler,
+		},
+		{
+			MethodName: "CreateClusterSnapshot",
+			Handler:    _AdminService_CreateClusterSnapshot_Handler,
+		},
+		{
+			MethodName: "RestoreClusterSnapshot",
+			Handler:    _AdminService_RestoreClusterSnapshot_Handler,
+		},
+		{
+			MethodName: "CheckVisibilityConsistency",
+			Handler:    _AdminService_CheckVisibilityConsistency_Handler,
+		},
+		{
+			MethodName: "ListTopPersistenceNamespaces",
+			Handler:    _AdminService_ListTopPersistenceNamespaces_Handler,
+		},
+		{
+			MethodName: "AddSearchAttributeAliases",
+			Handler:    _AdminService_AddSearchAttributeAliases_Handler,
+		},
+		{
+			MethodName: "DescribeVisibilityIngestion",
+			Handler:    _AdminService_DescribeVisibilityIngestion_Handler,
+		},
+		{
+			MethodName: "ListArchivalDLQTasks",
+			Handler:    _AdminService_ListArchivalDLQTasks_Handler,
+		},
+		{
+			MethodName: "RetryArchivalDLQTask",
+			Handler:    _AdminService_RetryArchivalDLQTask_Handler,
+		},
+		{
+			MethodName: "RehydrateWorkflowExecution",
+			Handler:    _AdminService_RehydrateWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "DescribeScheduleBackfills",
+			Handler:    _AdminService_DescribeScheduleBackfills_Handler,
+		},
+		{
+			MethodName: "CancelScheduleBackfill",
+			Handler:    _AdminService_CancelScheduleBackfill_Handler,
+		},
+		{
+			MethodName: "DescribeSchedulePauseAfterFailures",
+			Handler:    _AdminService_DescribeSchedulePauseAfterFailures_Handler,
+		},
+		{
+			MethodName: "UpdateSchedulePauseAfterFailures",
+			Handler:    _AdminService_UpdateSchedulePauseAfterFailures_Handler,
+		},
+		{
+			MethodName: "DeleteHistoryBranchGarbage",
+			Handler:    _AdminService_DeleteHistoryBranchGarbage_Handler,
+		},
+		{
+			MethodName: "DescribeNamespaceDeletion",
+			Handler:    _AdminService_DescribeNamespaceDeletion_Handler,
+		},
+		{
+			MethodName: "UpdateNamespaceDeletionRate",
+			Handler:    _AdminService_UpdateNamespaceDeletionRate_Handler,
+		},
+		{
+			MethodName: "StartNamespaceExport",
+			Handler:    _AdminService_StartNamespaceExport_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _AdminService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "RotateAPIKey",
+			Handler:    _AdminService_RotateAPIKey_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _AdminService_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _AdminService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "PutRole",
+			Handler:    _AdminService_PutRole_Handler,
+		},
+		{
+			MethodName: "DeleteRole",
+			Handler:    _AdminService_DeleteRole_Handler,
+		},
+		{
+			MethodName: "ListRoles",
+			Handler:    _AdminService_ListRoles_Handler,
+		},
+		{
+			MethodName: "SetGroupRoles",
+			Handler:    _AdminService_SetGroupRoles_Handler,
+		},
+		{
+			MethodName: "GetGroupRoles",
+			Handler:    _AdminService_GetGroupRoles_Handler,
+		},
+		{
+			MethodName: "GetDynamicConfigOverrides",
+			Handler:    _AdminService_GetDynamicConfigOverrides_Handler,
+		},
+		{
+			MethodName: "SetDynamicConfigOverride",
+			Handler:    _AdminService_SetDynamicConfigOverride_Handler,
+		},
+		{
+			MethodName: "DeleteDynamicConfigOverride",
+			Handler:    _AdminService_DeleteDynamicConfigOverride_Handler,
+		},
+		{
+			MethodName: "ListDynamicConfigChanges",
+			Handler:    _AdminService_ListDynamicConfigChanges_Handler,
+		},
+		{
+			MethodName: "GetEffectiveDynamicConfig",
+			Handler:    _AdminService_GetEffectiveDynamicConfig_Handler,
+		},
+		{
+			MethodName: "SetDynamicConfigRollout",
+			Handler:    _AdminService_SetDynamicConfigRollout_Handler,
+		},
+		{
+			MethodName: "GetNamespaceFeatureFlags",
+			Handler:    _AdminService_GetNamespaceFeatureFlags_Handler,
+		},
+		{
+			MethodName: "GetNamespaceVisibilityRetention",
+			Handler:    _AdminService_GetNamespaceVisibilityRetention_Handler,
+		},
+		{
+			MethodName: "UpdateNamespaceVisibilityRetention",
+			Handler:    _AdminService_UpdateNamespaceVisibilityRetention_Handler,
+		},
+		{
+			MethodName: "StartDrain",
+			Handler:    _AdminService_StartDrain_Handler,
+		},
+		{
+			MethodName: "CancelDrain",
+			Handler:    _AdminService_CancelDrain_Handler,
+		},
+		{
+			MethodName: "DescribeDrain",
+			Handler:    _AdminService_DescribeDrain_Handler,
+		},
+		{
+			MethodName: "GetComponentHealth",
+			Handler:    _AdminService_GetComponentHealth_Handler,
+		},
+		{
+			MethodName: "ListMetrics",
+			Handler:    _AdminService_ListMetrics_Handler,
+		},
+		{
+			MethodName: "DescribeShardDistribution",
+			Handler:    _AdminService_DescribeShardDistribution_Handler,
+		},
+		{
+			MethodName: "DescribeShard",
+			Handler:    _AdminService_DescribeShard_Handler,
+		},
+		{
+			MethodName: "ImportWorkflowExecution",
+			Handler:    _AdminService_ImportWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "DescribeNamespaceStats",
+			Handler:    _AdminService_DescribeNamespaceStats_Handler,
+		},
+		{
+			MethodName: "DescribeNamespaceReplicationStatus",
+			Handler:    _AdminService_DescribeNamespaceReplicationStatus_Handler,
+		},
+		{
+			MethodName: "StartBatchOperation",
+			Handler:    _AdminService_StartBatchOperation_Handler,
+		},
+		{
+			MethodName: "GetSDKUsage",
+			Handler:    _AdminService_GetSDKUsage_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1258,6 +3453,16 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "StreamDatabaseBackup",
+			Handler:       _AdminService_StreamDatabaseBackup_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamDiagnosticsBundle",
+			Handler:       _AdminService_StreamDiagnosticsBundle_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "temporal/server/api/adminservice/v1/service.proto",
 }
@@ -81,6 +81,26 @@ func (mr *MockAdminServiceClientMockRecorder) AddOrUpdateRemoteCluster(ctx, in i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrUpdateRemoteCluster", reflect.TypeOf((*MockAdminServiceClient)(nil).AddOrUpdateRemoteCluster), varargs...)
 }
 
+// AddSearchAttributeAliases mocks base method.
+func (m *MockAdminServiceClient) AddSearchAttributeAliases(ctx context.Context, in *adminservice.AddSearchAttributeAliasesRequest, opts ...grpc.CallOption) (*adminservice.AddSearchAttributeAliasesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddSearchAttributeAliases", varargs...)
+	ret0, _ := ret[0].(*adminservice.AddSearchAttributeAliasesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddSearchAttributeAliases indicates an expected call of AddSearchAttributeAliases.
+func (mr *MockAdminServiceClientMockRecorder) AddSearchAttributeAliases(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSearchAttributeAliases", reflect.TypeOf((*MockAdminServiceClient)(nil).AddSearchAttributeAliases), varargs...)
+}
+
 // AddSearchAttributes mocks base method.
 func (m *MockAdminServiceClient) AddSearchAttributes(ctx context.Context, in *adminservice.AddSearchAttributesRequest, opts ...grpc.CallOption) (*adminservice.AddSearchAttributesResponse, error) {
 	m.ctrl.T.Helper()
@@ -101,530 +121,1570 @@ func (mr *MockAdminServiceClientMockRecorder) AddSearchAttributes(ctx, in interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSearchAttributes", reflect.TypeOf((*MockAdminServiceClient)(nil).AddSearchAttributes), varargs...)
 }
 
-// CloseShard mocks base method.
-func (m *MockAdminServiceClient) CloseShard(ctx context.Context, in *adminservice.CloseShardRequest, opts ...grpc.CallOption) (*adminservice.CloseShardResponse, error) {
+// CancelDrain mocks base method.
+func (m *MockAdminServiceClient) CancelDrain(ctx context.Context, in *adminservice.CancelDrainRequest, opts ...grpc.CallOption) (*adminservice.CancelDrainResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "CloseShard", varargs...)
-	ret0, _ := ret[0].(*adminservice.CloseShardResponse)
+	ret := m.ctrl.Call(m, "CancelDrain", varargs...)
+	ret0, _ := ret[0].(*adminservice.CancelDrainResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CloseShard indicates an expected call of CloseShard.
-func (mr *MockAdminServiceClientMockRecorder) CloseShard(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// CancelDrain indicates an expected call of CancelDrain.
+func (mr *MockAdminServiceClientMockRecorder) CancelDrain(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceClient)(nil).CloseShard), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDrain", reflect.TypeOf((*MockAdminServiceClient)(nil).CancelDrain), varargs...)
 }
 
-// DeleteWorkflowExecution mocks base method.
-func (m *MockAdminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *adminservice.DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.DeleteWorkflowExecutionResponse, error) {
+// CancelScheduleBackfill mocks base method.
+func (m *MockAdminServiceClient) CancelScheduleBackfill(ctx context.Context, in *adminservice.CancelScheduleBackfillRequest, opts ...grpc.CallOption) (*adminservice.CancelScheduleBackfillResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", varargs...)
-	ret0, _ := ret[0].(*adminservice.DeleteWorkflowExecutionResponse)
+	ret := m.ctrl.Call(m, "CancelScheduleBackfill", varargs...)
+	ret0, _ := ret[0].(*adminservice.CancelScheduleBackfillResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution.
-func (mr *MockAdminServiceClientMockRecorder) DeleteWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// CancelScheduleBackfill indicates an expected call of CancelScheduleBackfill.
+func (mr *MockAdminServiceClientMockRecorder) CancelScheduleBackfill(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteWorkflowExecution), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduleBackfill", reflect.TypeOf((*MockAdminServiceClient)(nil).CancelScheduleBackfill), varargs...)
 }
 
-// DescribeCluster mocks base method.
-func (m *MockAdminServiceClient) DescribeCluster(ctx context.Context, in *adminservice.DescribeClusterRequest, opts ...grpc.CallOption) (*adminservice.DescribeClusterResponse, error) {
+// CheckVisibilityConsistency mocks base method.
+func (m *MockAdminServiceClient) CheckVisibilityConsistency(ctx context.Context, in *adminservice.CheckVisibilityConsistencyRequest, opts ...grpc.CallOption) (*adminservice.CheckVisibilityConsistencyResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DescribeCluster", varargs...)
-	ret0, _ := ret[0].(*adminservice.DescribeClusterResponse)
+	ret := m.ctrl.Call(m, "CheckVisibilityConsistency", varargs...)
+	ret0, _ := ret[0].(*adminservice.CheckVisibilityConsistencyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeCluster indicates an expected call of DescribeCluster.
-func (mr *MockAdminServiceClientMockRecorder) DescribeCluster(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// CheckVisibilityConsistency indicates an expected call of CheckVisibilityConsistency.
+func (mr *MockAdminServiceClientMockRecorder) CheckVisibilityConsistency(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCluster", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeCluster), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckVisibilityConsistency", reflect.TypeOf((*MockAdminServiceClient)(nil).CheckVisibilityConsistency), varargs...)
 }
 
-// DescribeHistoryHost mocks base method.
-func (m *MockAdminServiceClient) DescribeHistoryHost(ctx context.Context, in *adminservice.DescribeHistoryHostRequest, opts ...grpc.CallOption) (*adminservice.DescribeHistoryHostResponse, error) {
+// CloseShard mocks base method.
+func (m *MockAdminServiceClient) CloseShard(ctx context.Context, in *adminservice.CloseShardRequest, opts ...grpc.CallOption) (*adminservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DescribeHistoryHost", varargs...)
-	ret0, _ := ret[0].(*adminservice.DescribeHistoryHostResponse)
+	ret := m.ctrl.Call(m, "CloseShard", varargs...)
+	ret0, _ := ret[0].(*adminservice.CloseShardResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeHistoryHost indicates an expected call of DescribeHistoryHost.
-func (mr *MockAdminServiceClientMockRecorder) DescribeHistoryHost(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// CloseShard indicates an expected call of CloseShard.
+func (mr *MockAdminServiceClientMockRecorder) CloseShard(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHost", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeHistoryHost), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceClient)(nil).CloseShard), varargs...)
 }
 
-// DescribeMutableState mocks base method.
-func (m *MockAdminServiceClient) DescribeMutableState(ctx context.Context, in *adminservice.DescribeMutableStateRequest, opts ...grpc.CallOption) (*adminservice.DescribeMutableStateResponse, error) {
+// CreateAPIKey mocks base method.
+func (m *MockAdminServiceClient) CreateAPIKey(ctx context.Context, in *adminservice.CreateAPIKeyRequest, opts ...grpc.CallOption) (*adminservice.CreateAPIKeyResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DescribeMutableState", varargs...)
-	ret0, _ := ret[0].(*adminservice.DescribeMutableStateResponse)
+	ret := m.ctrl.Call(m, "CreateAPIKey", varargs...)
+	ret0, _ := ret[0].(*adminservice.CreateAPIKeyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeMutableState indicates an expected call of DescribeMutableState.
-func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockAdminServiceClientMockRecorder) CreateAPIKey(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockAdminServiceClient)(nil).CreateAPIKey), varargs...)
 }
 
-// GetDLQMessages mocks base method.
-func (m *MockAdminServiceClient) GetDLQMessages(ctx context.Context, in *adminservice.GetDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetDLQMessagesResponse, error) {
+// CreateClusterSnapshot mocks base method.
+func (m *MockAdminServiceClient) CreateClusterSnapshot(ctx context.Context, in *adminservice.CreateClusterSnapshotRequest, opts ...grpc.CallOption) (*adminservice.CreateClusterSnapshotResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetDLQMessages", varargs...)
-	ret0, _ := ret[0].(*adminservice.GetDLQMessagesResponse)
+	ret := m.ctrl.Call(m, "CreateClusterSnapshot", varargs...)
+	ret0, _ := ret[0].(*adminservice.CreateClusterSnapshotResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDLQMessages indicates an expected call of GetDLQMessages.
-func (mr *MockAdminServiceClientMockRecorder) GetDLQMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// CreateClusterSnapshot indicates an expected call of CreateClusterSnapshot.
+func (mr *MockAdminServiceClientMockRecorder) CreateClusterSnapshot(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDLQMessages), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterSnapshot", reflect.TypeOf((*MockAdminServiceClient)(nil).CreateClusterSnapshot), varargs...)
 }
 
-// GetDLQReplicationMessages mocks base method.
-func (m *MockAdminServiceClient) GetDLQReplicationMessages(ctx context.Context, in *adminservice.GetDLQReplicationMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetDLQReplicationMessagesResponse, error) {
+// DeleteDynamicConfigOverride mocks base method.
+func (m *MockAdminServiceClient) DeleteDynamicConfigOverride(ctx context.Context, in *adminservice.DeleteDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*adminservice.DeleteDynamicConfigOverrideResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetDLQReplicationMessages", varargs...)
-	ret0, _ := ret[0].(*adminservice.GetDLQReplicationMessagesResponse)
+	ret := m.ctrl.Call(m, "DeleteDynamicConfigOverride", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteDynamicConfigOverrideResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDLQReplicationMessages indicates an expected call of GetDLQReplicationMessages.
-func (mr *MockAdminServiceClientMockRecorder) GetDLQReplicationMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// DeleteDynamicConfigOverride indicates an expected call of DeleteDynamicConfigOverride.
+func (mr *MockAdminServiceClientMockRecorder) DeleteDynamicConfigOverride(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDLQReplicationMessages), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDynamicConfigOverride", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteDynamicConfigOverride), varargs...)
 }
 
-// GetNamespaceReplicationMessages mocks base method.
-func (m *MockAdminServiceClient) GetNamespaceReplicationMessages(ctx context.Context, in *adminservice.GetNamespaceReplicationMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetNamespaceReplicationMessagesResponse, error) {
+// DeleteHistoryBranchGarbage mocks base method.
+func (m *MockAdminServiceClient) DeleteHistoryBranchGarbage(ctx context.Context, in *adminservice.DeleteHistoryBranchGarbageRequest, opts ...grpc.CallOption) (*adminservice.DeleteHistoryBranchGarbageResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetNamespaceReplicationMessages", varargs...)
-	ret0, _ := ret[0].(*adminservice.GetNamespaceReplicationMessagesResponse)
+	ret := m.ctrl.Call(m, "DeleteHistoryBranchGarbage", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteHistoryBranchGarbageResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetNamespaceReplicationMessages indicates an expected call of GetNamespaceReplicationMessages.
-func (mr *MockAdminServiceClientMockRecorder) GetNamespaceReplicationMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// DeleteHistoryBranchGarbage indicates an expected call of DeleteHistoryBranchGarbage.
+func (mr *MockAdminServiceClientMockRecorder) DeleteHistoryBranchGarbage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetNamespaceReplicationMessages), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHistoryBranchGarbage", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteHistoryBranchGarbage), varargs...)
 }
 
-// GetReplicationMessages mocks base method.
-func (m *MockAdminServiceClient) GetReplicationMessages(ctx context.Context, in *adminservice.GetReplicationMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetReplicationMessagesResponse, error) {
+// DeleteRole mocks base method.
+func (m *MockAdminServiceClient) DeleteRole(ctx context.Context, in *adminservice.DeleteRoleRequest, opts ...grpc.CallOption) (*adminservice.DeleteRoleResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetReplicationMessages", varargs...)
-	ret0, _ := ret[0].(*adminservice.GetReplicationMessagesResponse)
+	ret := m.ctrl.Call(m, "DeleteRole", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteRoleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetReplicationMessages indicates an expected call of GetReplicationMessages.
-func (mr *MockAdminServiceClientMockRecorder) GetReplicationMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockAdminServiceClientMockRecorder) DeleteRole(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetReplicationMessages), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteRole), varargs...)
 }
 
-// GetSearchAttributes mocks base method.
-func (m *MockAdminServiceClient) GetSearchAttributes(ctx context.Context, in *adminservice.GetSearchAttributesRequest, opts ...grpc.CallOption) (*adminservice.GetSearchAttributesResponse, error) {
+// DeleteWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *adminservice.DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetSearchAttributes", varargs...)
-	ret0, _ := ret[0].(*adminservice.GetSearchAttributesResponse)
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteWorkflowExecutionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSearchAttributes indicates an expected call of GetSearchAttributes.
-func (mr *MockAdminServiceClientMockRecorder) GetSearchAttributes(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) DeleteWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchAttributes", reflect.TypeOf((*MockAdminServiceClient)(nil).GetSearchAttributes), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteWorkflowExecution), varargs...)
 }
 
-// GetShard mocks base method.
-func (m *MockAdminServiceClient) GetShard(ctx context.Context, in *adminservice.GetShardRequest, opts ...grpc.CallOption) (*adminservice.GetShardResponse, error) {
+// DescribeCluster mocks base method.
+func (m *MockAdminServiceClient) DescribeCluster(ctx context.Context, in *adminservice.DescribeClusterRequest, opts ...grpc.CallOption) (*adminservice.DescribeClusterResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetShard", varargs...)
-	ret0, _ := ret[0].(*adminservice.GetShardResponse)
+	ret := m.ctrl.Call(m, "DescribeCluster", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeClusterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetShard indicates an expected call of GetShard.
-func (mr *MockAdminServiceClientMockRecorder) GetShard(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// DescribeCluster indicates an expected call of DescribeCluster.
+func (mr *MockAdminServiceClientMockRecorder) DescribeCluster(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShard", reflect.TypeOf((*MockAdminServiceClient)(nil).GetShard), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCluster", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeCluster), varargs...)
 }
 
-// GetTaskQueueTasks mocks base method.
-func (m *MockAdminServiceClient) GetTaskQueueTasks(ctx context.Context, in *adminservice.GetTaskQueueTasksRequest, opts ...grpc.CallOption) (*adminservice.GetTaskQueueTasksResponse, error) {
+// DescribeDrain mocks base method.
+func (m *MockAdminServiceClient) DescribeDrain(ctx context.Context, in *adminservice.DescribeDrainRequest, opts ...grpc.CallOption) (*adminservice.DescribeDrainResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetTaskQueueTasks", varargs...)
-	ret0, _ := ret[0].(*adminservice.GetTaskQueueTasksResponse)
+	ret := m.ctrl.Call(m, "DescribeDrain", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeDrainResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTaskQueueTasks indicates an expected call of GetTaskQueueTasks.
-func (mr *MockAdminServiceClientMockRecorder) GetTaskQueueTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// DescribeDrain indicates an expected call of DescribeDrain.
+func (mr *MockAdminServiceClientMockRecorder) DescribeDrain(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskQueueTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).GetTaskQueueTasks), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDrain", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeDrain), varargs...)
 }
 
-// GetWorkflowExecutionRawHistoryV2 mocks base method.
-func (m *MockAdminServiceClient) GetWorkflowExecutionRawHistoryV2(ctx context.Context, in *adminservice.GetWorkflowExecutionRawHistoryV2Request, opts ...grpc.CallOption) (*adminservice.GetWorkflowExecutionRawHistoryV2Response, error) {
+// DescribeHistoryHost mocks base method.
+func (m *MockAdminServiceClient) DescribeHistoryHost(ctx context.Context, in *adminservice.DescribeHistoryHostRequest, opts ...grpc.CallOption) (*adminservice.DescribeHistoryHostResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetWorkflowExecutionRawHistoryV2", varargs...)
-	ret0, _ := ret[0].(*adminservice.GetWorkflowExecutionRawHistoryV2Response)
+	ret := m.ctrl.Call(m, "DescribeHistoryHost", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeHistoryHostResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetWorkflowExecutionRawHistoryV2 indicates an expected call of GetWorkflowExecutionRawHistoryV2.
-func (mr *MockAdminServiceClientMockRecorder) GetWorkflowExecutionRawHistoryV2(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// DescribeHistoryHost indicates an expected call of DescribeHistoryHost.
+func (mr *MockAdminServiceClientMockRecorder) DescribeHistoryHost(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceClient)(nil).GetWorkflowExecutionRawHistoryV2), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHost", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeHistoryHost), varargs...)
 }
 
-// ListClusterMembers mocks base method.
-func (m *MockAdminServiceClient) ListClusterMembers(ctx context.Context, in *adminservice.ListClusterMembersRequest, opts ...grpc.CallOption) (*adminservice.ListClusterMembersResponse, error) {
+// DescribeMutableState mocks base method.
+func (m *MockAdminServiceClient) DescribeMutableState(ctx context.Context, in *adminservice.DescribeMutableStateRequest, opts ...grpc.CallOption) (*adminservice.DescribeMutableStateResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListClusterMembers", varargs...)
-	ret0, _ := ret[0].(*adminservice.ListClusterMembersResponse)
+	ret := m.ctrl.Call(m, "DescribeMutableState", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeMutableStateResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListClusterMembers indicates an expected call of ListClusterMembers.
-func (mr *MockAdminServiceClientMockRecorder) ListClusterMembers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// DescribeMutableState indicates an expected call of DescribeMutableState.
+func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterMembers", reflect.TypeOf((*MockAdminServiceClient)(nil).ListClusterMembers), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
-// ListClusters mocks base method.
-func (m *MockAdminServiceClient) ListClusters(ctx context.Context, in *adminservice.ListClustersRequest, opts ...grpc.CallOption) (*adminservice.ListClustersResponse, error) {
+// DescribeNamespaceDeletion mocks base method.
+func (m *MockAdminServiceClient) DescribeNamespaceDeletion(ctx context.Context, in *adminservice.DescribeNamespaceDeletionRequest, opts ...grpc.CallOption) (*adminservice.DescribeNamespaceDeletionResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListClusters", varargs...)
-	ret0, _ := ret[0].(*adminservice.ListClustersResponse)
+	ret := m.ctrl.Call(m, "DescribeNamespaceDeletion", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeNamespaceDeletionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListClusters indicates an expected call of ListClusters.
-func (mr *MockAdminServiceClientMockRecorder) ListClusters(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// DescribeNamespaceDeletion indicates an expected call of DescribeNamespaceDeletion.
+func (mr *MockAdminServiceClientMockRecorder) DescribeNamespaceDeletion(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockAdminServiceClient)(nil).ListClusters), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespaceDeletion", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeNamespaceDeletion), varargs...)
 }
 
-// ListHistoryTasks mocks base method.
-func (m *MockAdminServiceClient) ListHistoryTasks(ctx context.Context, in *adminservice.ListHistoryTasksRequest, opts ...grpc.CallOption) (*adminservice.ListHistoryTasksResponse, error) {
+// DescribeNamespaceReplicationStatus mocks base method.
+func (m *MockAdminServiceClient) DescribeNamespaceReplicationStatus(ctx context.Context, in *adminservice.DescribeNamespaceReplicationStatusRequest, opts ...grpc.CallOption) (*adminservice.DescribeNamespaceReplicationStatusResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListHistoryTasks", varargs...)
-	ret0, _ := ret[0].(*adminservice.ListHistoryTasksResponse)
+	ret := m.ctrl.Call(m, "DescribeNamespaceReplicationStatus", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeNamespaceReplicationStatusResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListHistoryTasks indicates an expected call of ListHistoryTasks.
-func (mr *MockAdminServiceClientMockRecorder) ListHistoryTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// DescribeNamespaceReplicationStatus indicates an expected call of DescribeNamespaceReplicationStatus.
+func (mr *MockAdminServiceClientMockRecorder) DescribeNamespaceReplicationStatus(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ListHistoryTasks), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespaceReplicationStatus", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeNamespaceReplicationStatus), varargs...)
 }
 
-// MergeDLQMessages mocks base method.
-func (m *MockAdminServiceClient) MergeDLQMessages(ctx context.Context, in *adminservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.MergeDLQMessagesResponse, error) {
+// DescribeNamespaceStats mocks base method.
+func (m *MockAdminServiceClient) DescribeNamespaceStats(ctx context.Context, in *adminservice.DescribeNamespaceStatsRequest, opts ...grpc.CallOption) (*adminservice.DescribeNamespaceStatsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "MergeDLQMessages", varargs...)
-	ret0, _ := ret[0].(*adminservice.MergeDLQMessagesResponse)
+	ret := m.ctrl.Call(m, "DescribeNamespaceStats", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeNamespaceStatsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// MergeDLQMessages indicates an expected call of MergeDLQMessages.
-func (mr *MockAdminServiceClientMockRecorder) MergeDLQMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// DescribeNamespaceStats indicates an expected call of DescribeNamespaceStats.
+func (mr *MockAdminServiceClientMockRecorder) DescribeNamespaceStats(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).MergeDLQMessages), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespaceStats", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeNamespaceStats), varargs...)
 }
 
-// PurgeDLQMessages mocks base method.
-func (m *MockAdminServiceClient) PurgeDLQMessages(ctx context.Context, in *adminservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesResponse, error) {
+// DescribePersistenceCircuitBreakers mocks base method.
+func (m *MockAdminServiceClient) DescribePersistenceCircuitBreakers(ctx context.Context, in *adminservice.DescribePersistenceCircuitBreakersRequest, opts ...grpc.CallOption) (*adminservice.DescribePersistenceCircuitBreakersResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "PurgeDLQMessages", varargs...)
-	ret0, _ := ret[0].(*adminservice.PurgeDLQMessagesResponse)
+	ret := m.ctrl.Call(m, "DescribePersistenceCircuitBreakers", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribePersistenceCircuitBreakersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// PurgeDLQMessages indicates an expected call of PurgeDLQMessages.
-func (mr *MockAdminServiceClientMockRecorder) PurgeDLQMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// DescribePersistenceCircuitBreakers indicates an expected call of DescribePersistenceCircuitBreakers.
+func (mr *MockAdminServiceClientMockRecorder) DescribePersistenceCircuitBreakers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).PurgeDLQMessages), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePersistenceCircuitBreakers", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribePersistenceCircuitBreakers), varargs...)
 }
 
-// ReapplyEvents mocks base method.
-func (m *MockAdminServiceClient) ReapplyEvents(ctx context.Context, in *adminservice.ReapplyEventsRequest, opts ...grpc.CallOption) (*adminservice.ReapplyEventsResponse, error) {
+// DescribeScheduleBackfills mocks base method.
+func (m *MockAdminServiceClient) DescribeScheduleBackfills(ctx context.Context, in *adminservice.DescribeScheduleBackfillsRequest, opts ...grpc.CallOption) (*adminservice.DescribeScheduleBackfillsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ReapplyEvents", varargs...)
-	ret0, _ := ret[0].(*adminservice.ReapplyEventsResponse)
+	ret := m.ctrl.Call(m, "DescribeScheduleBackfills", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeScheduleBackfillsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReapplyEvents indicates an expected call of ReapplyEvents.
-func (mr *MockAdminServiceClientMockRecorder) ReapplyEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// DescribeScheduleBackfills indicates an expected call of DescribeScheduleBackfills.
+func (mr *MockAdminServiceClientMockRecorder) DescribeScheduleBackfills(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockAdminServiceClient)(nil).ReapplyEvents), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeScheduleBackfills", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeScheduleBackfills), varargs...)
 }
 
-// RebuildMutableState mocks base method.
-func (m *MockAdminServiceClient) RebuildMutableState(ctx context.Context, in *adminservice.RebuildMutableStateRequest, opts ...grpc.CallOption) (*adminservice.RebuildMutableStateResponse, error) {
+// DescribeSchedulePauseAfterFailures mocks base method.
+func (m *MockAdminServiceClient) DescribeSchedulePauseAfterFailures(ctx context.Context, in *adminservice.DescribeSchedulePauseAfterFailuresRequest, opts ...grpc.CallOption) (*adminservice.DescribeSchedulePauseAfterFailuresResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RebuildMutableState", varargs...)
-	ret0, _ := ret[0].(*adminservice.RebuildMutableStateResponse)
+	ret := m.ctrl.Call(m, "DescribeSchedulePauseAfterFailures", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeSchedulePauseAfterFailuresResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RebuildMutableState indicates an expected call of RebuildMutableState.
-func (mr *MockAdminServiceClientMockRecorder) RebuildMutableState(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// DescribeSchedulePauseAfterFailures indicates an expected call of DescribeSchedulePauseAfterFailures.
+func (mr *MockAdminServiceClientMockRecorder) DescribeSchedulePauseAfterFailures(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).RebuildMutableState), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSchedulePauseAfterFailures", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeSchedulePauseAfterFailures), varargs...)
 }
 
-// RefreshWorkflowTasks mocks base method.
-func (m *MockAdminServiceClient) RefreshWorkflowTasks(ctx context.Context, in *adminservice.RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*adminservice.RefreshWorkflowTasksResponse, error) {
+// DescribeShard mocks base method.
+func (m *MockAdminServiceClient) DescribeShard(ctx context.Context, in *adminservice.DescribeShardRequest, opts ...grpc.CallOption) (*adminservice.DescribeShardResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RefreshWorkflowTasks", varargs...)
-	ret0, _ := ret[0].(*adminservice.RefreshWorkflowTasksResponse)
+	ret := m.ctrl.Call(m, "DescribeShard", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeShardResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RefreshWorkflowTasks indicates an expected call of RefreshWorkflowTasks.
-func (mr *MockAdminServiceClientMockRecorder) RefreshWorkflowTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// DescribeShard indicates an expected call of DescribeShard.
+func (mr *MockAdminServiceClientMockRecorder) DescribeShard(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).RefreshWorkflowTasks), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeShard", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeShard), varargs...)
 }
 
-// RemoveRemoteCluster mocks base method.
-func (m *MockAdminServiceClient) RemoveRemoteCluster(ctx context.Context, in *adminservice.RemoveRemoteClusterRequest, opts ...grpc.CallOption) (*adminservice.RemoveRemoteClusterResponse, error) {
+// DescribeShardDistribution mocks base method.
+func (m *MockAdminServiceClient) DescribeShardDistribution(ctx context.Context, in *adminservice.DescribeShardDistributionRequest, opts ...grpc.CallOption) (*adminservice.DescribeShardDistributionResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RemoveRemoteCluster", varargs...)
-	ret0, _ := ret[0].(*adminservice.RemoveRemoteClusterResponse)
+	ret := m.ctrl.Call(m, "DescribeShardDistribution", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeShardDistributionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RemoveRemoteCluster indicates an expected call of RemoveRemoteCluster.
-func (mr *MockAdminServiceClientMockRecorder) RemoveRemoteCluster(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// DescribeShardDistribution indicates an expected call of DescribeShardDistribution.
+func (mr *MockAdminServiceClientMockRecorder) DescribeShardDistribution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRemoteCluster", reflect.TypeOf((*MockAdminServiceClient)(nil).RemoveRemoteCluster), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeShardDistribution", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeShardDistribution), varargs...)
 }
 
-// RemoveSearchAttributes mocks base method.
-func (m *MockAdminServiceClient) RemoveSearchAttributes(ctx context.Context, in *adminservice.RemoveSearchAttributesRequest, opts ...grpc.CallOption) (*adminservice.RemoveSearchAttributesResponse, error) {
+// DescribeTaskQueueTopology mocks base method.
+func (m *MockAdminServiceClient) DescribeTaskQueueTopology(ctx context.Context, in *adminservice.DescribeTaskQueueTopologyRequest, opts ...grpc.CallOption) (*adminservice.DescribeTaskQueueTopologyResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RemoveSearchAttributes", varargs...)
-	ret0, _ := ret[0].(*adminservice.RemoveSearchAttributesResponse)
+	ret := m.ctrl.Call(m, "DescribeTaskQueueTopology", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeTaskQueueTopologyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RemoveSearchAttributes indicates an expected call of RemoveSearchAttributes.
-func (mr *MockAdminServiceClientMockRecorder) RemoveSearchAttributes(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// DescribeTaskQueueTopology indicates an expected call of DescribeTaskQueueTopology.
+func (mr *MockAdminServiceClientMockRecorder) DescribeTaskQueueTopology(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSearchAttributes", reflect.TypeOf((*MockAdminServiceClient)(nil).RemoveSearchAttributes), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueueTopology", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeTaskQueueTopology), varargs...)
 }
 
-// RemoveTask mocks base method.
-func (m *MockAdminServiceClient) RemoveTask(ctx context.Context, in *adminservice.RemoveTaskRequest, opts ...grpc.CallOption) (*adminservice.RemoveTaskResponse, error) {
+// DescribeVisibilityIngestion mocks base method.
+func (m *MockAdminServiceClient) DescribeVisibilityIngestion(ctx context.Context, in *adminservice.DescribeVisibilityIngestionRequest, opts ...grpc.CallOption) (*adminservice.DescribeVisibilityIngestionResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RemoveTask", varargs...)
-	ret0, _ := ret[0].(*adminservice.RemoveTaskResponse)
+	ret := m.ctrl.Call(m, "DescribeVisibilityIngestion", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeVisibilityIngestionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RemoveTask indicates an expected call of RemoveTask.
-func (mr *MockAdminServiceClientMockRecorder) RemoveTask(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// DescribeVisibilityIngestion indicates an expected call of DescribeVisibilityIngestion.
+func (mr *MockAdminServiceClientMockRecorder) DescribeVisibilityIngestion(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockAdminServiceClient)(nil).RemoveTask), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVisibilityIngestion", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeVisibilityIngestion), varargs...)
 }
 
-// ResendReplicationTasks mocks base method.
-func (m *MockAdminServiceClient) ResendReplicationTasks(ctx context.Context, in *adminservice.ResendReplicationTasksRequest, opts ...grpc.CallOption) (*adminservice.ResendReplicationTasksResponse, error) {
+// GetComponentHealth mocks base method.
+func (m *MockAdminServiceClient) GetComponentHealth(ctx context.Context, in *adminservice.GetComponentHealthRequest, opts ...grpc.CallOption) (*adminservice.GetComponentHealthResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ResendReplicationTasks", varargs...)
-	ret0, _ := ret[0].(*adminservice.ResendReplicationTasksResponse)
+	ret := m.ctrl.Call(m, "GetComponentHealth", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetComponentHealthResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ResendReplicationTasks indicates an expected call of ResendReplicationTasks.
-func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// GetComponentHealth indicates an expected call of GetComponentHealth.
+func (mr *MockAdminServiceClientMockRecorder) GetComponentHealth(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComponentHealth", reflect.TypeOf((*MockAdminServiceClient)(nil).GetComponentHealth), varargs...)
 }
 
-// StreamWorkflowReplicationMessages mocks base method.
-func (m *MockAdminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowReplicationMessagesClient, error) {
+// GetDLQMessages mocks base method.
+func (m *MockAdminServiceClient) GetDLQMessages(ctx context.Context, in *adminservice.GetDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{ctx}
+	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "StreamWorkflowReplicationMessages", varargs...)
-	ret0, _ := ret[0].(adminservice.AdminService_StreamWorkflowReplicationMessagesClient)
+	ret := m.ctrl.Call(m, "GetDLQMessages", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetDLQMessagesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// StreamWorkflowReplicationMessages indicates an expected call of StreamWorkflowReplicationMessages.
-func (mr *MockAdminServiceClientMockRecorder) StreamWorkflowReplicationMessages(ctx interface{}, opts ...interface{}) *gomock.Call {
+// GetDLQMessages indicates an expected call of GetDLQMessages.
+func (mr *MockAdminServiceClientMockRecorder) GetDLQMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).StreamWorkflowReplicationMessages), varargs...)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDLQMessages), varargs...)
 }
 
-// MockAdminService_StreamWorkflowReplicationMessagesClient is a mock of AdminService_StreamWorkflowReplicationMessagesClient interface.
-type MockAdminService_StreamWorkflowReplicationMessagesClient struct {
-	ctrl     *gomock.Controller
-	recorder *MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder
+// GetDLQReplicationMessages mocks base method.
+func (m *MockAdminServiceClient) GetDLQReplicationMessages(ctx context.Context, in *adminservice.GetDLQReplicationMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetDLQReplicationMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDLQReplicationMessages", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetDLQReplicationMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDLQReplicationMessages indicates an expected call of GetDLQReplicationMessages.
+func (mr *MockAdminServiceClientMockRecorder) GetDLQReplicationMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDLQReplicationMessages), varargs...)
+}
+
+// GetDynamicConfigOverrides mocks base method.
+func (m *MockAdminServiceClient) GetDynamicConfigOverrides(ctx context.Context, in *adminservice.GetDynamicConfigOverridesRequest, opts ...grpc.CallOption) (*adminservice.GetDynamicConfigOverridesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDynamicConfigOverrides", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigOverridesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfigOverrides indicates an expected call of GetDynamicConfigOverrides.
+func (mr *MockAdminServiceClientMockRecorder) GetDynamicConfigOverrides(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfigOverrides", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDynamicConfigOverrides), varargs...)
+}
+
+// GetEffectiveDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) GetEffectiveDynamicConfig(ctx context.Context, in *adminservice.GetEffectiveDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.GetEffectiveDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetEffectiveDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetEffectiveDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEffectiveDynamicConfig indicates an expected call of GetEffectiveDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) GetEffectiveDynamicConfig(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEffectiveDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).GetEffectiveDynamicConfig), varargs...)
+}
+
+// GetGroupRoles mocks base method.
+func (m *MockAdminServiceClient) GetGroupRoles(ctx context.Context, in *adminservice.GetGroupRolesRequest, opts ...grpc.CallOption) (*adminservice.GetGroupRolesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGroupRoles", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetGroupRolesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupRoles indicates an expected call of GetGroupRoles.
+func (mr *MockAdminServiceClientMockRecorder) GetGroupRoles(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupRoles", reflect.TypeOf((*MockAdminServiceClient)(nil).GetGroupRoles), varargs...)
+}
+
+// GetNamespaceFeatureFlags mocks base method.
+func (m *MockAdminServiceClient) GetNamespaceFeatureFlags(ctx context.Context, in *adminservice.GetNamespaceFeatureFlagsRequest, opts ...grpc.CallOption) (*adminservice.GetNamespaceFeatureFlagsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNamespaceFeatureFlags", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetNamespaceFeatureFlagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaceFeatureFlags indicates an expected call of GetNamespaceFeatureFlags.
+func (mr *MockAdminServiceClientMockRecorder) GetNamespaceFeatureFlags(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceFeatureFlags", reflect.TypeOf((*MockAdminServiceClient)(nil).GetNamespaceFeatureFlags), varargs...)
+}
+
+// GetNamespaceReplicationMessages mocks base method.
+func (m *MockAdminServiceClient) GetNamespaceReplicationMessages(ctx context.Context, in *adminservice.GetNamespaceReplicationMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetNamespaceReplicationMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNamespaceReplicationMessages", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetNamespaceReplicationMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaceReplicationMessages indicates an expected call of GetNamespaceReplicationMessages.
+func (mr *MockAdminServiceClientMockRecorder) GetNamespaceReplicationMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetNamespaceReplicationMessages), varargs...)
+}
+
+// GetNamespaceVisibilityRetention mocks base method.
+func (m *MockAdminServiceClient) GetNamespaceVisibilityRetention(ctx context.Context, in *adminservice.GetNamespaceVisibilityRetentionRequest, opts ...grpc.CallOption) (*adminservice.GetNamespaceVisibilityRetentionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNamespaceVisibilityRetention", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetNamespaceVisibilityRetentionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaceVisibilityRetention indicates an expected call of GetNamespaceVisibilityRetention.
+func (mr *MockAdminServiceClientMockRecorder) GetNamespaceVisibilityRetention(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceVisibilityRetention", reflect.TypeOf((*MockAdminServiceClient)(nil).GetNamespaceVisibilityRetention), varargs...)
+}
+
+// GetReplicationMessages mocks base method.
+func (m *MockAdminServiceClient) GetReplicationMessages(ctx context.Context, in *adminservice.GetReplicationMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetReplicationMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReplicationMessages", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetReplicationMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationMessages indicates an expected call of GetReplicationMessages.
+func (mr *MockAdminServiceClientMockRecorder) GetReplicationMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetReplicationMessages), varargs...)
+}
+
+// GetSDKUsage mocks base method.
+func (m *MockAdminServiceClient) GetSDKUsage(ctx context.Context, in *adminservice.GetSDKUsageRequest, opts ...grpc.CallOption) (*adminservice.GetSDKUsageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSDKUsage", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetSDKUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSDKUsage indicates an expected call of GetSDKUsage.
+func (mr *MockAdminServiceClientMockRecorder) GetSDKUsage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSDKUsage", reflect.TypeOf((*MockAdminServiceClient)(nil).GetSDKUsage), varargs...)
+}
+
+// GetSearchAttributes mocks base method.
+func (m *MockAdminServiceClient) GetSearchAttributes(ctx context.Context, in *adminservice.GetSearchAttributesRequest, opts ...grpc.CallOption) (*adminservice.GetSearchAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSearchAttributes", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetSearchAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchAttributes indicates an expected call of GetSearchAttributes.
+func (mr *MockAdminServiceClientMockRecorder) GetSearchAttributes(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchAttributes", reflect.TypeOf((*MockAdminServiceClient)(nil).GetSearchAttributes), varargs...)
+}
+
+// GetShard mocks base method.
+func (m *MockAdminServiceClient) GetShard(ctx context.Context, in *adminservice.GetShardRequest, opts ...grpc.CallOption) (*adminservice.GetShardResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetShard", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShard indicates an expected call of GetShard.
+func (mr *MockAdminServiceClientMockRecorder) GetShard(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShard", reflect.TypeOf((*MockAdminServiceClient)(nil).GetShard), varargs...)
+}
+
+// GetTaskQueueTasks mocks base method.
+func (m *MockAdminServiceClient) GetTaskQueueTasks(ctx context.Context, in *adminservice.GetTaskQueueTasksRequest, opts ...grpc.CallOption) (*adminservice.GetTaskQueueTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTaskQueueTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetTaskQueueTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskQueueTasks indicates an expected call of GetTaskQueueTasks.
+func (mr *MockAdminServiceClientMockRecorder) GetTaskQueueTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskQueueTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).GetTaskQueueTasks), varargs...)
+}
+
+// GetWorkflowExecutionRawHistoryV2 mocks base method.
+func (m *MockAdminServiceClient) GetWorkflowExecutionRawHistoryV2(ctx context.Context, in *adminservice.GetWorkflowExecutionRawHistoryV2Request, opts ...grpc.CallOption) (*adminservice.GetWorkflowExecutionRawHistoryV2Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWorkflowExecutionRawHistoryV2", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetWorkflowExecutionRawHistoryV2Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowExecutionRawHistoryV2 indicates an expected call of GetWorkflowExecutionRawHistoryV2.
+func (mr *MockAdminServiceClientMockRecorder) GetWorkflowExecutionRawHistoryV2(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceClient)(nil).GetWorkflowExecutionRawHistoryV2), varargs...)
+}
+
+// ImportWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) ImportWorkflowExecution(ctx context.Context, in *adminservice.ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.ImportWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.ImportWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportWorkflowExecution indicates an expected call of ImportWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) ImportWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).ImportWorkflowExecution), varargs...)
+}
+
+// ListAPIKeys mocks base method.
+func (m *MockAdminServiceClient) ListAPIKeys(ctx context.Context, in *adminservice.ListAPIKeysRequest, opts ...grpc.CallOption) (*adminservice.ListAPIKeysResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAPIKeys", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListAPIKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockAdminServiceClientMockRecorder) ListAPIKeys(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockAdminServiceClient)(nil).ListAPIKeys), varargs...)
+}
+
+// ListArchivalDLQTasks mocks base method.
+func (m *MockAdminServiceClient) ListArchivalDLQTasks(ctx context.Context, in *adminservice.ListArchivalDLQTasksRequest, opts ...grpc.CallOption) (*adminservice.ListArchivalDLQTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListArchivalDLQTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListArchivalDLQTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListArchivalDLQTasks indicates an expected call of ListArchivalDLQTasks.
+func (mr *MockAdminServiceClientMockRecorder) ListArchivalDLQTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArchivalDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ListArchivalDLQTasks), varargs...)
+}
+
+// ListClusterMembers mocks base method.
+func (m *MockAdminServiceClient) ListClusterMembers(ctx context.Context, in *adminservice.ListClusterMembersRequest, opts ...grpc.CallOption) (*adminservice.ListClusterMembersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClusterMembers", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListClusterMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusterMembers indicates an expected call of ListClusterMembers.
+func (mr *MockAdminServiceClientMockRecorder) ListClusterMembers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterMembers", reflect.TypeOf((*MockAdminServiceClient)(nil).ListClusterMembers), varargs...)
+}
+
+// ListClusters mocks base method.
+func (m *MockAdminServiceClient) ListClusters(ctx context.Context, in *adminservice.ListClustersRequest, opts ...grpc.CallOption) (*adminservice.ListClustersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClusters", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListClustersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusters indicates an expected call of ListClusters.
+func (mr *MockAdminServiceClientMockRecorder) ListClusters(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockAdminServiceClient)(nil).ListClusters), varargs...)
+}
+
+// ListDynamicConfigChanges mocks base method.
+func (m *MockAdminServiceClient) ListDynamicConfigChanges(ctx context.Context, in *adminservice.ListDynamicConfigChangesRequest, opts ...grpc.CallOption) (*adminservice.ListDynamicConfigChangesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDynamicConfigChanges", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigChangesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigChanges indicates an expected call of ListDynamicConfigChanges.
+func (mr *MockAdminServiceClientMockRecorder) ListDynamicConfigChanges(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigChanges", reflect.TypeOf((*MockAdminServiceClient)(nil).ListDynamicConfigChanges), varargs...)
+}
+
+// ListHistoryTasks mocks base method.
+func (m *MockAdminServiceClient) ListHistoryTasks(ctx context.Context, in *adminservice.ListHistoryTasksRequest, opts ...grpc.CallOption) (*adminservice.ListHistoryTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHistoryTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListHistoryTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHistoryTasks indicates an expected call of ListHistoryTasks.
+func (mr *MockAdminServiceClientMockRecorder) ListHistoryTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ListHistoryTasks), varargs...)
+}
+
+// ListMetrics mocks base method.
+func (m *MockAdminServiceClient) ListMetrics(ctx context.Context, in *adminservice.ListMetricsRequest, opts ...grpc.CallOption) (*adminservice.ListMetricsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListMetrics", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListMetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMetrics indicates an expected call of ListMetrics.
+func (mr *MockAdminServiceClientMockRecorder) ListMetrics(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMetrics", reflect.TypeOf((*MockAdminServiceClient)(nil).ListMetrics), varargs...)
+}
+
+// ListRoles mocks base method.
+func (m *MockAdminServiceClient) ListRoles(ctx context.Context, in *adminservice.ListRolesRequest, opts ...grpc.CallOption) (*adminservice.ListRolesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRoles", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListRolesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockAdminServiceClientMockRecorder) ListRoles(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockAdminServiceClient)(nil).ListRoles), varargs...)
+}
+
+// ListTopPersistenceNamespaces mocks base method.
+func (m *MockAdminServiceClient) ListTopPersistenceNamespaces(ctx context.Context, in *adminservice.ListTopPersistenceNamespacesRequest, opts ...grpc.CallOption) (*adminservice.ListTopPersistenceNamespacesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTopPersistenceNamespaces", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListTopPersistenceNamespacesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTopPersistenceNamespaces indicates an expected call of ListTopPersistenceNamespaces.
+func (mr *MockAdminServiceClientMockRecorder) ListTopPersistenceNamespaces(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopPersistenceNamespaces", reflect.TypeOf((*MockAdminServiceClient)(nil).ListTopPersistenceNamespaces), varargs...)
+}
+
+// MergeDLQMessages mocks base method.
+func (m *MockAdminServiceClient) MergeDLQMessages(ctx context.Context, in *adminservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.MergeDLQMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MergeDLQMessages", varargs...)
+	ret0, _ := ret[0].(*adminservice.MergeDLQMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeDLQMessages indicates an expected call of MergeDLQMessages.
+func (mr *MockAdminServiceClientMockRecorder) MergeDLQMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).MergeDLQMessages), varargs...)
+}
+
+// PurgeDLQMessages mocks base method.
+func (m *MockAdminServiceClient) PurgeDLQMessages(ctx context.Context, in *adminservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeDLQMessages", varargs...)
+	ret0, _ := ret[0].(*adminservice.PurgeDLQMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDLQMessages indicates an expected call of PurgeDLQMessages.
+func (mr *MockAdminServiceClientMockRecorder) PurgeDLQMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).PurgeDLQMessages), varargs...)
+}
+
+// PutRole mocks base method.
+func (m *MockAdminServiceClient) PutRole(ctx context.Context, in *adminservice.PutRoleRequest, opts ...grpc.CallOption) (*adminservice.PutRoleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutRole", varargs...)
+	ret0, _ := ret[0].(*adminservice.PutRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutRole indicates an expected call of PutRole.
+func (mr *MockAdminServiceClientMockRecorder) PutRole(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRole", reflect.TypeOf((*MockAdminServiceClient)(nil).PutRole), varargs...)
+}
+
+// ReapplyEvents mocks base method.
+func (m *MockAdminServiceClient) ReapplyEvents(ctx context.Context, in *adminservice.ReapplyEventsRequest, opts ...grpc.CallOption) (*adminservice.ReapplyEventsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReapplyEvents", varargs...)
+	ret0, _ := ret[0].(*adminservice.ReapplyEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReapplyEvents indicates an expected call of ReapplyEvents.
+func (mr *MockAdminServiceClientMockRecorder) ReapplyEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockAdminServiceClient)(nil).ReapplyEvents), varargs...)
+}
+
+// RebuildMutableState mocks base method.
+func (m *MockAdminServiceClient) RebuildMutableState(ctx context.Context, in *adminservice.RebuildMutableStateRequest, opts ...grpc.CallOption) (*adminservice.RebuildMutableStateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RebuildMutableState", varargs...)
+	ret0, _ := ret[0].(*adminservice.RebuildMutableStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebuildMutableState indicates an expected call of RebuildMutableState.
+func (mr *MockAdminServiceClientMockRecorder) RebuildMutableState(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).RebuildMutableState), varargs...)
+}
+
+// RefreshWorkflowTasks mocks base method.
+func (m *MockAdminServiceClient) RefreshWorkflowTasks(ctx context.Context, in *adminservice.RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*adminservice.RefreshWorkflowTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RefreshWorkflowTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.RefreshWorkflowTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshWorkflowTasks indicates an expected call of RefreshWorkflowTasks.
+func (mr *MockAdminServiceClientMockRecorder) RefreshWorkflowTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).RefreshWorkflowTasks), varargs...)
+}
+
+// RehydrateWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) RehydrateWorkflowExecution(ctx context.Context, in *adminservice.RehydrateWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.RehydrateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RehydrateWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.RehydrateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RehydrateWorkflowExecution indicates an expected call of RehydrateWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) RehydrateWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RehydrateWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).RehydrateWorkflowExecution), varargs...)
+}
+
+// RemoveRemoteCluster mocks base method.
+func (m *MockAdminServiceClient) RemoveRemoteCluster(ctx context.Context, in *adminservice.RemoveRemoteClusterRequest, opts ...grpc.CallOption) (*adminservice.RemoveRemoteClusterResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveRemoteCluster", varargs...)
+	ret0, _ := ret[0].(*adminservice.RemoveRemoteClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveRemoteCluster indicates an expected call of RemoveRemoteCluster.
+func (mr *MockAdminServiceClientMockRecorder) RemoveRemoteCluster(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRemoteCluster", reflect.TypeOf((*MockAdminServiceClient)(nil).RemoveRemoteCluster), varargs...)
+}
+
+// RemoveSearchAttributes mocks base method.
+func (m *MockAdminServiceClient) RemoveSearchAttributes(ctx context.Context, in *adminservice.RemoveSearchAttributesRequest, opts ...grpc.CallOption) (*adminservice.RemoveSearchAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveSearchAttributes", varargs...)
+	ret0, _ := ret[0].(*adminservice.RemoveSearchAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveSearchAttributes indicates an expected call of RemoveSearchAttributes.
+func (mr *MockAdminServiceClientMockRecorder) RemoveSearchAttributes(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSearchAttributes", reflect.TypeOf((*MockAdminServiceClient)(nil).RemoveSearchAttributes), varargs...)
+}
+
+// RemoveTask mocks base method.
+func (m *MockAdminServiceClient) RemoveTask(ctx context.Context, in *adminservice.RemoveTaskRequest, opts ...grpc.CallOption) (*adminservice.RemoveTaskResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveTask", varargs...)
+	ret0, _ := ret[0].(*adminservice.RemoveTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTask indicates an expected call of RemoveTask.
+func (mr *MockAdminServiceClientMockRecorder) RemoveTask(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockAdminServiceClient)(nil).RemoveTask), varargs...)
+}
+
+// ResendReplicationTasks mocks base method.
+func (m *MockAdminServiceClient) ResendReplicationTasks(ctx context.Context, in *adminservice.ResendReplicationTasksRequest, opts ...grpc.CallOption) (*adminservice.ResendReplicationTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResendReplicationTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.ResendReplicationTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResendReplicationTasks indicates an expected call of ResendReplicationTasks.
+func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
+}
+
+// RestoreClusterSnapshot mocks base method.
+func (m *MockAdminServiceClient) RestoreClusterSnapshot(ctx context.Context, in *adminservice.RestoreClusterSnapshotRequest, opts ...grpc.CallOption) (*adminservice.RestoreClusterSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreClusterSnapshot", varargs...)
+	ret0, _ := ret[0].(*adminservice.RestoreClusterSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreClusterSnapshot indicates an expected call of RestoreClusterSnapshot.
+func (mr *MockAdminServiceClientMockRecorder) RestoreClusterSnapshot(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreClusterSnapshot", reflect.TypeOf((*MockAdminServiceClient)(nil).RestoreClusterSnapshot), varargs...)
+}
+
+// RetryArchivalDLQTask mocks base method.
+func (m *MockAdminServiceClient) RetryArchivalDLQTask(ctx context.Context, in *adminservice.RetryArchivalDLQTaskRequest, opts ...grpc.CallOption) (*adminservice.RetryArchivalDLQTaskResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RetryArchivalDLQTask", varargs...)
+	ret0, _ := ret[0].(*adminservice.RetryArchivalDLQTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryArchivalDLQTask indicates an expected call of RetryArchivalDLQTask.
+func (mr *MockAdminServiceClientMockRecorder) RetryArchivalDLQTask(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryArchivalDLQTask", reflect.TypeOf((*MockAdminServiceClient)(nil).RetryArchivalDLQTask), varargs...)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockAdminServiceClient) RevokeAPIKey(ctx context.Context, in *adminservice.RevokeAPIKeyRequest, opts ...grpc.CallOption) (*adminservice.RevokeAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeAPIKey", varargs...)
+	ret0, _ := ret[0].(*adminservice.RevokeAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockAdminServiceClientMockRecorder) RevokeAPIKey(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockAdminServiceClient)(nil).RevokeAPIKey), varargs...)
+}
+
+// RotateAPIKey mocks base method.
+func (m *MockAdminServiceClient) RotateAPIKey(ctx context.Context, in *adminservice.RotateAPIKeyRequest, opts ...grpc.CallOption) (*adminservice.RotateAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RotateAPIKey", varargs...)
+	ret0, _ := ret[0].(*adminservice.RotateAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateAPIKey indicates an expected call of RotateAPIKey.
+func (mr *MockAdminServiceClientMockRecorder) RotateAPIKey(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateAPIKey", reflect.TypeOf((*MockAdminServiceClient)(nil).RotateAPIKey), varargs...)
+}
+
+// SetDynamicConfigOverride mocks base method.
+func (m *MockAdminServiceClient) SetDynamicConfigOverride(ctx context.Context, in *adminservice.SetDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*adminservice.SetDynamicConfigOverrideResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetDynamicConfigOverride", varargs...)
+	ret0, _ := ret[0].(*adminservice.SetDynamicConfigOverrideResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDynamicConfigOverride indicates an expected call of SetDynamicConfigOverride.
+func (mr *MockAdminServiceClientMockRecorder) SetDynamicConfigOverride(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDynamicConfigOverride", reflect.TypeOf((*MockAdminServiceClient)(nil).SetDynamicConfigOverride), varargs...)
+}
+
+// SetDynamicConfigRollout mocks base method.
+func (m *MockAdminServiceClient) SetDynamicConfigRollout(ctx context.Context, in *adminservice.SetDynamicConfigRolloutRequest, opts ...grpc.CallOption) (*adminservice.SetDynamicConfigRolloutResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetDynamicConfigRollout", varargs...)
+	ret0, _ := ret[0].(*adminservice.SetDynamicConfigRolloutResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDynamicConfigRollout indicates an expected call of SetDynamicConfigRollout.
+func (mr *MockAdminServiceClientMockRecorder) SetDynamicConfigRollout(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDynamicConfigRollout", reflect.TypeOf((*MockAdminServiceClient)(nil).SetDynamicConfigRollout), varargs...)
+}
+
+// SetGroupRoles mocks base method.
+func (m *MockAdminServiceClient) SetGroupRoles(ctx context.Context, in *adminservice.SetGroupRolesRequest, opts ...grpc.CallOption) (*adminservice.SetGroupRolesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetGroupRoles", varargs...)
+	ret0, _ := ret[0].(*adminservice.SetGroupRolesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetGroupRoles indicates an expected call of SetGroupRoles.
+func (mr *MockAdminServiceClientMockRecorder) SetGroupRoles(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGroupRoles", reflect.TypeOf((*MockAdminServiceClient)(nil).SetGroupRoles), varargs...)
+}
+
+// StartBatchOperation mocks base method.
+func (m *MockAdminServiceClient) StartBatchOperation(ctx context.Context, in *adminservice.StartBatchOperationRequest, opts ...grpc.CallOption) (*adminservice.StartBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartBatchOperation", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartBatchOperation indicates an expected call of StartBatchOperation.
+func (mr *MockAdminServiceClientMockRecorder) StartBatchOperation(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartBatchOperation", reflect.TypeOf((*MockAdminServiceClient)(nil).StartBatchOperation), varargs...)
+}
+
+// StartDrain mocks base method.
+func (m *MockAdminServiceClient) StartDrain(ctx context.Context, in *adminservice.StartDrainRequest, opts ...grpc.CallOption) (*adminservice.StartDrainResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartDrain", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartDrainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartDrain indicates an expected call of StartDrain.
+func (mr *MockAdminServiceClientMockRecorder) StartDrain(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartDrain", reflect.TypeOf((*MockAdminServiceClient)(nil).StartDrain), varargs...)
+}
+
+// StartNamespaceExport mocks base method.
+func (m *MockAdminServiceClient) StartNamespaceExport(ctx context.Context, in *adminservice.StartNamespaceExportRequest, opts ...grpc.CallOption) (*adminservice.StartNamespaceExportResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartNamespaceExport", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartNamespaceExportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartNamespaceExport indicates an expected call of StartNamespaceExport.
+func (mr *MockAdminServiceClientMockRecorder) StartNamespaceExport(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartNamespaceExport", reflect.TypeOf((*MockAdminServiceClient)(nil).StartNamespaceExport), varargs...)
+}
+
+// StreamDatabaseBackup mocks base method.
+func (m *MockAdminServiceClient) StreamDatabaseBackup(ctx context.Context, in *adminservice.StreamDatabaseBackupRequest, opts ...grpc.CallOption) (adminservice.AdminService_StreamDatabaseBackupClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamDatabaseBackup", varargs...)
+	ret0, _ := ret[0].(adminservice.AdminService_StreamDatabaseBackupClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamDatabaseBackup indicates an expected call of StreamDatabaseBackup.
+func (mr *MockAdminServiceClientMockRecorder) StreamDatabaseBackup(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamDatabaseBackup", reflect.TypeOf((*MockAdminServiceClient)(nil).StreamDatabaseBackup), varargs...)
+}
+
+// StreamDiagnosticsBundle mocks base method.
+func (m *MockAdminServiceClient) StreamDiagnosticsBundle(ctx context.Context, in *adminservice.StreamDiagnosticsBundleRequest, opts ...grpc.CallOption) (adminservice.AdminService_StreamDiagnosticsBundleClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamDiagnosticsBundle", varargs...)
+	ret0, _ := ret[0].(adminservice.AdminService_StreamDiagnosticsBundleClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamDiagnosticsBundle indicates an expected call of StreamDiagnosticsBundle.
+func (mr *MockAdminServiceClientMockRecorder) StreamDiagnosticsBundle(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamDiagnosticsBundle", reflect.TypeOf((*MockAdminServiceClient)(nil).StreamDiagnosticsBundle), varargs...)
+}
+
+// StreamWorkflowReplicationMessages mocks base method.
+func (m *MockAdminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowReplicationMessagesClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamWorkflowReplicationMessages", varargs...)
+	ret0, _ := ret[0].(adminservice.AdminService_StreamWorkflowReplicationMessagesClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamWorkflowReplicationMessages indicates an expected call of StreamWorkflowReplicationMessages.
+func (mr *MockAdminServiceClientMockRecorder) StreamWorkflowReplicationMessages(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).StreamWorkflowReplicationMessages), varargs...)
+}
+
+// UpdateNamespaceDeletionRate mocks base method.
+func (m *MockAdminServiceClient) UpdateNamespaceDeletionRate(ctx context.Context, in *adminservice.UpdateNamespaceDeletionRateRequest, opts ...grpc.CallOption) (*adminservice.UpdateNamespaceDeletionRateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateNamespaceDeletionRate", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateNamespaceDeletionRateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNamespaceDeletionRate indicates an expected call of UpdateNamespaceDeletionRate.
+func (mr *MockAdminServiceClientMockRecorder) UpdateNamespaceDeletionRate(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNamespaceDeletionRate", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateNamespaceDeletionRate), varargs...)
+}
+
+// UpdateNamespaceVisibilityRetention mocks base method.
+func (m *MockAdminServiceClient) UpdateNamespaceVisibilityRetention(ctx context.Context, in *adminservice.UpdateNamespaceVisibilityRetentionRequest, opts ...grpc.CallOption) (*adminservice.UpdateNamespaceVisibilityRetentionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateNamespaceVisibilityRetention", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateNamespaceVisibilityRetentionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNamespaceVisibilityRetention indicates an expected call of UpdateNamespaceVisibilityRetention.
+func (mr *MockAdminServiceClientMockRecorder) UpdateNamespaceVisibilityRetention(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNamespaceVisibilityRetention", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateNamespaceVisibilityRetention), varargs...)
+}
+
+// UpdateSchedulePauseAfterFailures mocks base method.
+func (m *MockAdminServiceClient) UpdateSchedulePauseAfterFailures(ctx context.Context, in *adminservice.UpdateSchedulePauseAfterFailuresRequest, opts ...grpc.CallOption) (*adminservice.UpdateSchedulePauseAfterFailuresResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSchedulePauseAfterFailures", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateSchedulePauseAfterFailuresResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSchedulePauseAfterFailures indicates an expected call of UpdateSchedulePauseAfterFailures.
+func (mr *MockAdminServiceClientMockRecorder) UpdateSchedulePauseAfterFailures(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedulePauseAfterFailures", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateSchedulePauseAfterFailures), varargs...)
+}
+
+// UpdateWorkflowExecutionMemo mocks base method.
+func (m *MockAdminServiceClient) UpdateWorkflowExecutionMemo(ctx context.Context, in *adminservice.UpdateWorkflowExecutionMemoRequest, opts ...grpc.CallOption) (*adminservice.UpdateWorkflowExecutionMemoResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecutionMemo", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateWorkflowExecutionMemoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecutionMemo indicates an expected call of UpdateWorkflowExecutionMemo.
+func (mr *MockAdminServiceClientMockRecorder) UpdateWorkflowExecutionMemo(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecutionMemo", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateWorkflowExecutionMemo), varargs...)
+}
+
+// UpsertWorkflowExecutionSearchAttributes mocks base method.
+func (m *MockAdminServiceClient) UpsertWorkflowExecutionSearchAttributes(ctx context.Context, in *adminservice.UpsertWorkflowExecutionSearchAttributesRequest, opts ...grpc.CallOption) (*adminservice.UpsertWorkflowExecutionSearchAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertWorkflowExecutionSearchAttributes", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpsertWorkflowExecutionSearchAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertWorkflowExecutionSearchAttributes indicates an expected call of UpsertWorkflowExecutionSearchAttributes.
+func (mr *MockAdminServiceClientMockRecorder) UpsertWorkflowExecutionSearchAttributes(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowExecutionSearchAttributes", reflect.TypeOf((*MockAdminServiceClient)(nil).UpsertWorkflowExecutionSearchAttributes), varargs...)
+}
+
+// MockAdminService_StreamWorkflowReplicationMessagesClient is a mock of AdminService_StreamWorkflowReplicationMessagesClient interface.
+type MockAdminService_StreamWorkflowReplicationMessagesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder
 }
 
 // MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder is the mock recorder for MockAdminService_StreamWorkflowReplicationMessagesClient.
@@ -632,572 +1692,1611 @@ type MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder struct
 	mock *MockAdminService_StreamWorkflowReplicationMessagesClient
 }
 
-// NewMockAdminService_StreamWorkflowReplicationMessagesClient creates a new mock instance.
-func NewMockAdminService_StreamWorkflowReplicationMessagesClient(ctrl *gomock.Controller) *MockAdminService_StreamWorkflowReplicationMessagesClient {
-	mock := &MockAdminService_StreamWorkflowReplicationMessagesClient{ctrl: ctrl}
-	mock.recorder = &MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder{mock}
-	return mock
+// NewMockAdminService_StreamWorkflowReplicationMessagesClient creates a new mock instance.
+func NewMockAdminService_StreamWorkflowReplicationMessagesClient(ctrl *gomock.Controller) *MockAdminService_StreamWorkflowReplicationMessagesClient {
+	mock := &MockAdminService_StreamWorkflowReplicationMessagesClient{ctrl: ctrl}
+	mock.recorder = &MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService_StreamWorkflowReplicationMessagesClient) EXPECT() *MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockAdminService_StreamWorkflowReplicationMessagesClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockAdminService_StreamWorkflowReplicationMessagesClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockAdminService_StreamWorkflowReplicationMessagesClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAdminService_StreamWorkflowReplicationMessagesClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockAdminService_StreamWorkflowReplicationMessagesClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockAdminService_StreamWorkflowReplicationMessagesClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockAdminService_StreamWorkflowReplicationMessagesClient) Recv() (*adminservice.StreamWorkflowReplicationMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*adminservice.StreamWorkflowReplicationMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockAdminService_StreamWorkflowReplicationMessagesClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockAdminService_StreamWorkflowReplicationMessagesClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAdminService_StreamWorkflowReplicationMessagesClient)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockAdminService_StreamWorkflowReplicationMessagesClient) Send(arg0 *adminservice.StreamWorkflowReplicationMessagesRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockAdminService_StreamWorkflowReplicationMessagesClient)(nil).Send), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockAdminService_StreamWorkflowReplicationMessagesClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAdminService_StreamWorkflowReplicationMessagesClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockAdminService_StreamWorkflowReplicationMessagesClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockAdminService_StreamWorkflowReplicationMessagesClient)(nil).Trailer))
+}
+
+// MockAdminService_StreamDatabaseBackupClient is a mock of AdminService_StreamDatabaseBackupClient interface.
+type MockAdminService_StreamDatabaseBackupClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminService_StreamDatabaseBackupClientMockRecorder
+}
+
+// MockAdminService_StreamDatabaseBackupClientMockRecorder is the mock recorder for MockAdminService_StreamDatabaseBackupClient.
+type MockAdminService_StreamDatabaseBackupClientMockRecorder struct {
+	mock *MockAdminService_StreamDatabaseBackupClient
+}
+
+// NewMockAdminService_StreamDatabaseBackupClient creates a new mock instance.
+func NewMockAdminService_StreamDatabaseBackupClient(ctrl *gomock.Controller) *MockAdminService_StreamDatabaseBackupClient {
+	mock := &MockAdminService_StreamDatabaseBackupClient{ctrl: ctrl}
+	mock.recorder = &MockAdminService_StreamDatabaseBackupClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService_StreamDatabaseBackupClient) EXPECT() *MockAdminService_StreamDatabaseBackupClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockAdminService_StreamDatabaseBackupClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockAdminService_StreamDatabaseBackupClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockAdminService_StreamDatabaseBackupClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockAdminService_StreamDatabaseBackupClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockAdminService_StreamDatabaseBackupClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAdminService_StreamDatabaseBackupClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockAdminService_StreamDatabaseBackupClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockAdminService_StreamDatabaseBackupClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockAdminService_StreamDatabaseBackupClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockAdminService_StreamDatabaseBackupClient) Recv() (*adminservice.StreamDatabaseBackupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*adminservice.StreamDatabaseBackupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockAdminService_StreamDatabaseBackupClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockAdminService_StreamDatabaseBackupClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockAdminService_StreamDatabaseBackupClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockAdminService_StreamDatabaseBackupClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAdminService_StreamDatabaseBackupClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockAdminService_StreamDatabaseBackupClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockAdminService_StreamDatabaseBackupClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAdminService_StreamDatabaseBackupClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockAdminService_StreamDatabaseBackupClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockAdminService_StreamDatabaseBackupClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockAdminService_StreamDatabaseBackupClient)(nil).Trailer))
+}
+
+// MockAdminService_StreamDiagnosticsBundleClient is a mock of AdminService_StreamDiagnosticsBundleClient interface.
+type MockAdminService_StreamDiagnosticsBundleClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminService_StreamDiagnosticsBundleClientMockRecorder
+}
+
+// MockAdminService_StreamDiagnosticsBundleClientMockRecorder is the mock recorder for MockAdminService_StreamDiagnosticsBundleClient.
+type MockAdminService_StreamDiagnosticsBundleClientMockRecorder struct {
+	mock *MockAdminService_StreamDiagnosticsBundleClient
+}
+
+// NewMockAdminService_StreamDiagnosticsBundleClient creates a new mock instance.
+func NewMockAdminService_StreamDiagnosticsBundleClient(ctrl *gomock.Controller) *MockAdminService_StreamDiagnosticsBundleClient {
+	mock := &MockAdminService_StreamDiagnosticsBundleClient{ctrl: ctrl}
+	mock.recorder = &MockAdminService_StreamDiagnosticsBundleClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService_StreamDiagnosticsBundleClient) EXPECT() *MockAdminService_StreamDiagnosticsBundleClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockAdminService_StreamDiagnosticsBundleClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockAdminService_StreamDiagnosticsBundleClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockAdminService_StreamDiagnosticsBundleClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockAdminService_StreamDiagnosticsBundleClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockAdminService_StreamDiagnosticsBundleClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAdminService_StreamDiagnosticsBundleClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockAdminService_StreamDiagnosticsBundleClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockAdminService_StreamDiagnosticsBundleClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockAdminService_StreamDiagnosticsBundleClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockAdminService_StreamDiagnosticsBundleClient) Recv() (*adminservice.StreamDiagnosticsBundleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*adminservice.StreamDiagnosticsBundleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockAdminService_StreamDiagnosticsBundleClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockAdminService_StreamDiagnosticsBundleClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockAdminService_StreamDiagnosticsBundleClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockAdminService_StreamDiagnosticsBundleClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAdminService_StreamDiagnosticsBundleClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockAdminService_StreamDiagnosticsBundleClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockAdminService_StreamDiagnosticsBundleClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAdminService_StreamDiagnosticsBundleClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockAdminService_StreamDiagnosticsBundleClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockAdminService_StreamDiagnosticsBundleClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockAdminService_StreamDiagnosticsBundleClient)(nil).Trailer))
+}
+
+// MockAdminServiceServer is a mock of AdminServiceServer interface.
+type MockAdminServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminServiceServerMockRecorder
+}
+
+// MockAdminServiceServerMockRecorder is the mock recorder for MockAdminServiceServer.
+type MockAdminServiceServerMockRecorder struct {
+	mock *MockAdminServiceServer
+}
+
+// NewMockAdminServiceServer creates a new mock instance.
+func NewMockAdminServiceServer(ctrl *gomock.Controller) *MockAdminServiceServer {
+	mock := &MockAdminServiceServer{ctrl: ctrl}
+	mock.recorder = &MockAdminServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminServiceServer) EXPECT() *MockAdminServiceServerMockRecorder {
+	return m.recorder
+}
+
+// AddOrUpdateRemoteCluster mocks base method.
+func (m *MockAdminServiceServer) AddOrUpdateRemoteCluster(arg0 context.Context, arg1 *adminservice.AddOrUpdateRemoteClusterRequest) (*adminservice.AddOrUpdateRemoteClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOrUpdateRemoteCluster", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.AddOrUpdateRemoteClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddOrUpdateRemoteCluster indicates an expected call of AddOrUpdateRemoteCluster.
+func (mr *MockAdminServiceServerMockRecorder) AddOrUpdateRemoteCluster(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrUpdateRemoteCluster", reflect.TypeOf((*MockAdminServiceServer)(nil).AddOrUpdateRemoteCluster), arg0, arg1)
+}
+
+// AddSearchAttributeAliases mocks base method.
+func (m *MockAdminServiceServer) AddSearchAttributeAliases(arg0 context.Context, arg1 *adminservice.AddSearchAttributeAliasesRequest) (*adminservice.AddSearchAttributeAliasesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSearchAttributeAliases", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.AddSearchAttributeAliasesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddSearchAttributeAliases indicates an expected call of AddSearchAttributeAliases.
+func (mr *MockAdminServiceServerMockRecorder) AddSearchAttributeAliases(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSearchAttributeAliases", reflect.TypeOf((*MockAdminServiceServer)(nil).AddSearchAttributeAliases), arg0, arg1)
+}
+
+// AddSearchAttributes mocks base method.
+func (m *MockAdminServiceServer) AddSearchAttributes(arg0 context.Context, arg1 *adminservice.AddSearchAttributesRequest) (*adminservice.AddSearchAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSearchAttributes", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.AddSearchAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddSearchAttributes indicates an expected call of AddSearchAttributes.
+func (mr *MockAdminServiceServerMockRecorder) AddSearchAttributes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSearchAttributes", reflect.TypeOf((*MockAdminServiceServer)(nil).AddSearchAttributes), arg0, arg1)
+}
+
+// CancelDrain mocks base method.
+func (m *MockAdminServiceServer) CancelDrain(arg0 context.Context, arg1 *adminservice.CancelDrainRequest) (*adminservice.CancelDrainResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelDrain", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CancelDrainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelDrain indicates an expected call of CancelDrain.
+func (mr *MockAdminServiceServerMockRecorder) CancelDrain(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDrain", reflect.TypeOf((*MockAdminServiceServer)(nil).CancelDrain), arg0, arg1)
+}
+
+// CancelScheduleBackfill mocks base method.
+func (m *MockAdminServiceServer) CancelScheduleBackfill(arg0 context.Context, arg1 *adminservice.CancelScheduleBackfillRequest) (*adminservice.CancelScheduleBackfillResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScheduleBackfill", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CancelScheduleBackfillResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelScheduleBackfill indicates an expected call of CancelScheduleBackfill.
+func (mr *MockAdminServiceServerMockRecorder) CancelScheduleBackfill(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduleBackfill", reflect.TypeOf((*MockAdminServiceServer)(nil).CancelScheduleBackfill), arg0, arg1)
+}
+
+// CheckVisibilityConsistency mocks base method.
+func (m *MockAdminServiceServer) CheckVisibilityConsistency(arg0 context.Context, arg1 *adminservice.CheckVisibilityConsistencyRequest) (*adminservice.CheckVisibilityConsistencyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckVisibilityConsistency", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CheckVisibilityConsistencyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckVisibilityConsistency indicates an expected call of CheckVisibilityConsistency.
+func (mr *MockAdminServiceServerMockRecorder) CheckVisibilityConsistency(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckVisibilityConsistency", reflect.TypeOf((*MockAdminServiceServer)(nil).CheckVisibilityConsistency), arg0, arg1)
+}
+
+// CloseShard mocks base method.
+func (m *MockAdminServiceServer) CloseShard(arg0 context.Context, arg1 *adminservice.CloseShardRequest) (*adminservice.CloseShardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseShard", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CloseShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseShard indicates an expected call of CloseShard.
+func (mr *MockAdminServiceServerMockRecorder) CloseShard(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceServer)(nil).CloseShard), arg0, arg1)
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockAdminServiceServer) CreateAPIKey(arg0 context.Context, arg1 *adminservice.CreateAPIKeyRequest) (*adminservice.CreateAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CreateAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockAdminServiceServerMockRecorder) CreateAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockAdminServiceServer)(nil).CreateAPIKey), arg0, arg1)
+}
+
+// CreateClusterSnapshot mocks base method.
+func (m *MockAdminServiceServer) CreateClusterSnapshot(arg0 context.Context, arg1 *adminservice.CreateClusterSnapshotRequest) (*adminservice.CreateClusterSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClusterSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CreateClusterSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClusterSnapshot indicates an expected call of CreateClusterSnapshot.
+func (mr *MockAdminServiceServerMockRecorder) CreateClusterSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterSnapshot", reflect.TypeOf((*MockAdminServiceServer)(nil).CreateClusterSnapshot), arg0, arg1)
+}
+
+// DeleteDynamicConfigOverride mocks base method.
+func (m *MockAdminServiceServer) DeleteDynamicConfigOverride(arg0 context.Context, arg1 *adminservice.DeleteDynamicConfigOverrideRequest) (*adminservice.DeleteDynamicConfigOverrideResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDynamicConfigOverride", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteDynamicConfigOverrideResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDynamicConfigOverride indicates an expected call of DeleteDynamicConfigOverride.
+func (mr *MockAdminServiceServerMockRecorder) DeleteDynamicConfigOverride(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDynamicConfigOverride", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteDynamicConfigOverride), arg0, arg1)
+}
+
+// DeleteHistoryBranchGarbage mocks base method.
+func (m *MockAdminServiceServer) DeleteHistoryBranchGarbage(arg0 context.Context, arg1 *adminservice.DeleteHistoryBranchGarbageRequest) (*adminservice.DeleteHistoryBranchGarbageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHistoryBranchGarbage", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteHistoryBranchGarbageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteHistoryBranchGarbage indicates an expected call of DeleteHistoryBranchGarbage.
+func (mr *MockAdminServiceServerMockRecorder) DeleteHistoryBranchGarbage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHistoryBranchGarbage", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteHistoryBranchGarbage), arg0, arg1)
+}
+
+// DeleteRole mocks base method.
+func (m *MockAdminServiceServer) DeleteRole(arg0 context.Context, arg1 *adminservice.DeleteRoleRequest) (*adminservice.DeleteRoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockAdminServiceServerMockRecorder) DeleteRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteRole), arg0, arg1)
+}
+
+// DeleteWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) DeleteWorkflowExecution(arg0 context.Context, arg1 *adminservice.DeleteWorkflowExecutionRequest) (*adminservice.DeleteWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) DeleteWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteWorkflowExecution), arg0, arg1)
+}
+
+// DescribeCluster mocks base method.
+func (m *MockAdminServiceServer) DescribeCluster(arg0 context.Context, arg1 *adminservice.DescribeClusterRequest) (*adminservice.DescribeClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCluster", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCluster indicates an expected call of DescribeCluster.
+func (mr *MockAdminServiceServerMockRecorder) DescribeCluster(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCluster", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeCluster), arg0, arg1)
+}
+
+// DescribeDrain mocks base method.
+func (m *MockAdminServiceServer) DescribeDrain(arg0 context.Context, arg1 *adminservice.DescribeDrainRequest) (*adminservice.DescribeDrainResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDrain", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeDrainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDrain indicates an expected call of DescribeDrain.
+func (mr *MockAdminServiceServerMockRecorder) DescribeDrain(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDrain", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeDrain), arg0, arg1)
+}
+
+// DescribeHistoryHost mocks base method.
+func (m *MockAdminServiceServer) DescribeHistoryHost(arg0 context.Context, arg1 *adminservice.DescribeHistoryHostRequest) (*adminservice.DescribeHistoryHostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeHistoryHost", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeHistoryHostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeHistoryHost indicates an expected call of DescribeHistoryHost.
+func (mr *MockAdminServiceServerMockRecorder) DescribeHistoryHost(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHost", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeHistoryHost), arg0, arg1)
+}
+
+// DescribeMutableState mocks base method.
+func (m *MockAdminServiceServer) DescribeMutableState(arg0 context.Context, arg1 *adminservice.DescribeMutableStateRequest) (*adminservice.DescribeMutableStateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMutableState", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeMutableStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMutableState indicates an expected call of DescribeMutableState.
+func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
+}
+
+// DescribeNamespaceDeletion mocks base method.
+func (m *MockAdminServiceServer) DescribeNamespaceDeletion(arg0 context.Context, arg1 *adminservice.DescribeNamespaceDeletionRequest) (*adminservice.DescribeNamespaceDeletionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeNamespaceDeletion", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeNamespaceDeletionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNamespaceDeletion indicates an expected call of DescribeNamespaceDeletion.
+func (mr *MockAdminServiceServerMockRecorder) DescribeNamespaceDeletion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespaceDeletion", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeNamespaceDeletion), arg0, arg1)
+}
+
+// DescribeNamespaceReplicationStatus mocks base method.
+func (m *MockAdminServiceServer) DescribeNamespaceReplicationStatus(arg0 context.Context, arg1 *adminservice.DescribeNamespaceReplicationStatusRequest) (*adminservice.DescribeNamespaceReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeNamespaceReplicationStatus", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeNamespaceReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNamespaceReplicationStatus indicates an expected call of DescribeNamespaceReplicationStatus.
+func (mr *MockAdminServiceServerMockRecorder) DescribeNamespaceReplicationStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespaceReplicationStatus", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeNamespaceReplicationStatus), arg0, arg1)
+}
+
+// DescribeNamespaceStats mocks base method.
+func (m *MockAdminServiceServer) DescribeNamespaceStats(arg0 context.Context, arg1 *adminservice.DescribeNamespaceStatsRequest) (*adminservice.DescribeNamespaceStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeNamespaceStats", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeNamespaceStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNamespaceStats indicates an expected call of DescribeNamespaceStats.
+func (mr *MockAdminServiceServerMockRecorder) DescribeNamespaceStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespaceStats", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeNamespaceStats), arg0, arg1)
+}
+
+// DescribePersistenceCircuitBreakers mocks base method.
+func (m *MockAdminServiceServer) DescribePersistenceCircuitBreakers(arg0 context.Context, arg1 *adminservice.DescribePersistenceCircuitBreakersRequest) (*adminservice.DescribePersistenceCircuitBreakersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePersistenceCircuitBreakers", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribePersistenceCircuitBreakersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePersistenceCircuitBreakers indicates an expected call of DescribePersistenceCircuitBreakers.
+func (mr *MockAdminServiceServerMockRecorder) DescribePersistenceCircuitBreakers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePersistenceCircuitBreakers", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribePersistenceCircuitBreakers), arg0, arg1)
+}
+
+// DescribeScheduleBackfills mocks base method.
+func (m *MockAdminServiceServer) DescribeScheduleBackfills(arg0 context.Context, arg1 *adminservice.DescribeScheduleBackfillsRequest) (*adminservice.DescribeScheduleBackfillsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeScheduleBackfills", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeScheduleBackfillsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeScheduleBackfills indicates an expected call of DescribeScheduleBackfills.
+func (mr *MockAdminServiceServerMockRecorder) DescribeScheduleBackfills(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeScheduleBackfills", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeScheduleBackfills), arg0, arg1)
+}
+
+// DescribeSchedulePauseAfterFailures mocks base method.
+func (m *MockAdminServiceServer) DescribeSchedulePauseAfterFailures(arg0 context.Context, arg1 *adminservice.DescribeSchedulePauseAfterFailuresRequest) (*adminservice.DescribeSchedulePauseAfterFailuresResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSchedulePauseAfterFailures", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeSchedulePauseAfterFailuresResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSchedulePauseAfterFailures indicates an expected call of DescribeSchedulePauseAfterFailures.
+func (mr *MockAdminServiceServerMockRecorder) DescribeSchedulePauseAfterFailures(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSchedulePauseAfterFailures", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeSchedulePauseAfterFailures), arg0, arg1)
+}
+
+// DescribeShard mocks base method.
+func (m *MockAdminServiceServer) DescribeShard(arg0 context.Context, arg1 *adminservice.DescribeShardRequest) (*adminservice.DescribeShardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeShard", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeShard indicates an expected call of DescribeShard.
+func (mr *MockAdminServiceServerMockRecorder) DescribeShard(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeShard", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeShard), arg0, arg1)
+}
+
+// DescribeShardDistribution mocks base method.
+func (m *MockAdminServiceServer) DescribeShardDistribution(arg0 context.Context, arg1 *adminservice.DescribeShardDistributionRequest) (*adminservice.DescribeShardDistributionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeShardDistribution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeShardDistributionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeShardDistribution indicates an expected call of DescribeShardDistribution.
+func (mr *MockAdminServiceServerMockRecorder) DescribeShardDistribution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeShardDistribution", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeShardDistribution), arg0, arg1)
+}
+
+// DescribeTaskQueueTopology mocks base method.
+func (m *MockAdminServiceServer) DescribeTaskQueueTopology(arg0 context.Context, arg1 *adminservice.DescribeTaskQueueTopologyRequest) (*adminservice.DescribeTaskQueueTopologyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTaskQueueTopology", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeTaskQueueTopologyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueueTopology indicates an expected call of DescribeTaskQueueTopology.
+func (mr *MockAdminServiceServerMockRecorder) DescribeTaskQueueTopology(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueueTopology", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeTaskQueueTopology), arg0, arg1)
+}
+
+// DescribeVisibilityIngestion mocks base method.
+func (m *MockAdminServiceServer) DescribeVisibilityIngestion(arg0 context.Context, arg1 *adminservice.DescribeVisibilityIngestionRequest) (*adminservice.DescribeVisibilityIngestionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVisibilityIngestion", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeVisibilityIngestionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVisibilityIngestion indicates an expected call of DescribeVisibilityIngestion.
+func (mr *MockAdminServiceServerMockRecorder) DescribeVisibilityIngestion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVisibilityIngestion", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeVisibilityIngestion), arg0, arg1)
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAdminService_StreamWorkflowReplicationMessagesClient) EXPECT() *MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder {
-	return m.recorder
+// GetComponentHealth mocks base method.
+func (m *MockAdminServiceServer) GetComponentHealth(arg0 context.Context, arg1 *adminservice.GetComponentHealthRequest) (*adminservice.GetComponentHealthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComponentHealth", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetComponentHealthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CloseSend mocks base method.
-func (m *MockAdminService_StreamWorkflowReplicationMessagesClient) CloseSend() error {
+// GetComponentHealth indicates an expected call of GetComponentHealth.
+func (mr *MockAdminServiceServerMockRecorder) GetComponentHealth(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComponentHealth", reflect.TypeOf((*MockAdminServiceServer)(nil).GetComponentHealth), arg0, arg1)
+}
+
+// GetDLQMessages mocks base method.
+func (m *MockAdminServiceServer) GetDLQMessages(arg0 context.Context, arg1 *adminservice.GetDLQMessagesRequest) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CloseSend")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetDLQMessages", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetDLQMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CloseSend indicates an expected call of CloseSend.
-func (mr *MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder) CloseSend() *gomock.Call {
+// GetDLQMessages indicates an expected call of GetDLQMessages.
+func (mr *MockAdminServiceServerMockRecorder) GetDLQMessages(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockAdminService_StreamWorkflowReplicationMessagesClient)(nil).CloseSend))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDLQMessages), arg0, arg1)
 }
 
-// Context mocks base method.
-func (m *MockAdminService_StreamWorkflowReplicationMessagesClient) Context() context.Context {
+// GetDLQReplicationMessages mocks base method.
+func (m *MockAdminServiceServer) GetDLQReplicationMessages(arg0 context.Context, arg1 *adminservice.GetDLQReplicationMessagesRequest) (*adminservice.GetDLQReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Context")
-	ret0, _ := ret[0].(context.Context)
-	return ret0
+	ret := m.ctrl.Call(m, "GetDLQReplicationMessages", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetDLQReplicationMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Context indicates an expected call of Context.
-func (mr *MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder) Context() *gomock.Call {
+// GetDLQReplicationMessages indicates an expected call of GetDLQReplicationMessages.
+func (mr *MockAdminServiceServerMockRecorder) GetDLQReplicationMessages(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAdminService_StreamWorkflowReplicationMessagesClient)(nil).Context))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDLQReplicationMessages), arg0, arg1)
 }
 
-// Header mocks base method.
-func (m *MockAdminService_StreamWorkflowReplicationMessagesClient) Header() (metadata.MD, error) {
+// GetDynamicConfigOverrides mocks base method.
+func (m *MockAdminServiceServer) GetDynamicConfigOverrides(arg0 context.Context, arg1 *adminservice.GetDynamicConfigOverridesRequest) (*adminservice.GetDynamicConfigOverridesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Header")
-	ret0, _ := ret[0].(metadata.MD)
+	ret := m.ctrl.Call(m, "GetDynamicConfigOverrides", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigOverridesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Header indicates an expected call of Header.
-func (mr *MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder) Header() *gomock.Call {
+// GetDynamicConfigOverrides indicates an expected call of GetDynamicConfigOverrides.
+func (mr *MockAdminServiceServerMockRecorder) GetDynamicConfigOverrides(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockAdminService_StreamWorkflowReplicationMessagesClient)(nil).Header))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfigOverrides", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDynamicConfigOverrides), arg0, arg1)
 }
 
-// Recv mocks base method.
-func (m *MockAdminService_StreamWorkflowReplicationMessagesClient) Recv() (*adminservice.StreamWorkflowReplicationMessagesResponse, error) {
+// GetEffectiveDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) GetEffectiveDynamicConfig(arg0 context.Context, arg1 *adminservice.GetEffectiveDynamicConfigRequest) (*adminservice.GetEffectiveDynamicConfigResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Recv")
-	ret0, _ := ret[0].(*adminservice.StreamWorkflowReplicationMessagesResponse)
+	ret := m.ctrl.Call(m, "GetEffectiveDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetEffectiveDynamicConfigResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Recv indicates an expected call of Recv.
-func (mr *MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder) Recv() *gomock.Call {
+// GetEffectiveDynamicConfig indicates an expected call of GetEffectiveDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) GetEffectiveDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockAdminService_StreamWorkflowReplicationMessagesClient)(nil).Recv))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEffectiveDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).GetEffectiveDynamicConfig), arg0, arg1)
 }
 
-// RecvMsg mocks base method.
-func (m_2 *MockAdminService_StreamWorkflowReplicationMessagesClient) RecvMsg(m interface{}) error {
-	m_2.ctrl.T.Helper()
-	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
-	ret0, _ := ret[0].(error)
-	return ret0
+// GetGroupRoles mocks base method.
+func (m *MockAdminServiceServer) GetGroupRoles(arg0 context.Context, arg1 *adminservice.GetGroupRolesRequest) (*adminservice.GetGroupRolesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupRoles", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetGroupRolesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// RecvMsg indicates an expected call of RecvMsg.
-func (mr *MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+// GetGroupRoles indicates an expected call of GetGroupRoles.
+func (mr *MockAdminServiceServerMockRecorder) GetGroupRoles(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAdminService_StreamWorkflowReplicationMessagesClient)(nil).RecvMsg), m)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupRoles", reflect.TypeOf((*MockAdminServiceServer)(nil).GetGroupRoles), arg0, arg1)
 }
 
-// Send mocks base method.
-func (m *MockAdminService_StreamWorkflowReplicationMessagesClient) Send(arg0 *adminservice.StreamWorkflowReplicationMessagesRequest) error {
+// GetNamespaceFeatureFlags mocks base method.
+func (m *MockAdminServiceServer) GetNamespaceFeatureFlags(arg0 context.Context, arg1 *adminservice.GetNamespaceFeatureFlagsRequest) (*adminservice.GetNamespaceFeatureFlagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNamespaceFeatureFlags", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetNamespaceFeatureFlagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaceFeatureFlags indicates an expected call of GetNamespaceFeatureFlags.
+func (mr *MockAdminServiceServerMockRecorder) GetNamespaceFeatureFlags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceFeatureFlags", reflect.TypeOf((*MockAdminServiceServer)(nil).GetNamespaceFeatureFlags), arg0, arg1)
+}
+
+// GetNamespaceReplicationMessages mocks base method.
+func (m *MockAdminServiceServer) GetNamespaceReplicationMessages(arg0 context.Context, arg1 *adminservice.GetNamespaceReplicationMessagesRequest) (*adminservice.GetNamespaceReplicationMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNamespaceReplicationMessages", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetNamespaceReplicationMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaceReplicationMessages indicates an expected call of GetNamespaceReplicationMessages.
+func (mr *MockAdminServiceServerMockRecorder) GetNamespaceReplicationMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetNamespaceReplicationMessages), arg0, arg1)
+}
+
+// GetNamespaceVisibilityRetention mocks base method.
+func (m *MockAdminServiceServer) GetNamespaceVisibilityRetention(arg0 context.Context, arg1 *adminservice.GetNamespaceVisibilityRetentionRequest) (*adminservice.GetNamespaceVisibilityRetentionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNamespaceVisibilityRetention", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetNamespaceVisibilityRetentionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaceVisibilityRetention indicates an expected call of GetNamespaceVisibilityRetention.
+func (mr *MockAdminServiceServerMockRecorder) GetNamespaceVisibilityRetention(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceVisibilityRetention", reflect.TypeOf((*MockAdminServiceServer)(nil).GetNamespaceVisibilityRetention), arg0, arg1)
+}
+
+// GetReplicationMessages mocks base method.
+func (m *MockAdminServiceServer) GetReplicationMessages(arg0 context.Context, arg1 *adminservice.GetReplicationMessagesRequest) (*adminservice.GetReplicationMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationMessages", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetReplicationMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationMessages indicates an expected call of GetReplicationMessages.
+func (mr *MockAdminServiceServerMockRecorder) GetReplicationMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetReplicationMessages), arg0, arg1)
+}
+
+// GetSDKUsage mocks base method.
+func (m *MockAdminServiceServer) GetSDKUsage(arg0 context.Context, arg1 *adminservice.GetSDKUsageRequest) (*adminservice.GetSDKUsageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSDKUsage", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetSDKUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSDKUsage indicates an expected call of GetSDKUsage.
+func (mr *MockAdminServiceServerMockRecorder) GetSDKUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSDKUsage", reflect.TypeOf((*MockAdminServiceServer)(nil).GetSDKUsage), arg0, arg1)
+}
+
+// GetSearchAttributes mocks base method.
+func (m *MockAdminServiceServer) GetSearchAttributes(arg0 context.Context, arg1 *adminservice.GetSearchAttributesRequest) (*adminservice.GetSearchAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchAttributes", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetSearchAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchAttributes indicates an expected call of GetSearchAttributes.
+func (mr *MockAdminServiceServerMockRecorder) GetSearchAttributes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchAttributes", reflect.TypeOf((*MockAdminServiceServer)(nil).GetSearchAttributes), arg0, arg1)
+}
+
+// GetShard mocks base method.
+func (m *MockAdminServiceServer) GetShard(arg0 context.Context, arg1 *adminservice.GetShardRequest) (*adminservice.GetShardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShard", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShard indicates an expected call of GetShard.
+func (mr *MockAdminServiceServerMockRecorder) GetShard(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShard", reflect.TypeOf((*MockAdminServiceServer)(nil).GetShard), arg0, arg1)
+}
+
+// GetTaskQueueTasks mocks base method.
+func (m *MockAdminServiceServer) GetTaskQueueTasks(arg0 context.Context, arg1 *adminservice.GetTaskQueueTasksRequest) (*adminservice.GetTaskQueueTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskQueueTasks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetTaskQueueTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskQueueTasks indicates an expected call of GetTaskQueueTasks.
+func (mr *MockAdminServiceServerMockRecorder) GetTaskQueueTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskQueueTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).GetTaskQueueTasks), arg0, arg1)
+}
+
+// GetWorkflowExecutionRawHistoryV2 mocks base method.
+func (m *MockAdminServiceServer) GetWorkflowExecutionRawHistoryV2(arg0 context.Context, arg1 *adminservice.GetWorkflowExecutionRawHistoryV2Request) (*adminservice.GetWorkflowExecutionRawHistoryV2Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowExecutionRawHistoryV2", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetWorkflowExecutionRawHistoryV2Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowExecutionRawHistoryV2 indicates an expected call of GetWorkflowExecutionRawHistoryV2.
+func (mr *MockAdminServiceServerMockRecorder) GetWorkflowExecutionRawHistoryV2(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceServer)(nil).GetWorkflowExecutionRawHistoryV2), arg0, arg1)
+}
+
+// ImportWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) ImportWorkflowExecution(arg0 context.Context, arg1 *adminservice.ImportWorkflowExecutionRequest) (*adminservice.ImportWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ImportWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportWorkflowExecution indicates an expected call of ImportWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) ImportWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).ImportWorkflowExecution), arg0, arg1)
+}
+
+// ListAPIKeys mocks base method.
+func (m *MockAdminServiceServer) ListAPIKeys(arg0 context.Context, arg1 *adminservice.ListAPIKeysRequest) (*adminservice.ListAPIKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListAPIKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockAdminServiceServerMockRecorder) ListAPIKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockAdminServiceServer)(nil).ListAPIKeys), arg0, arg1)
+}
+
+// ListArchivalDLQTasks mocks base method.
+func (m *MockAdminServiceServer) ListArchivalDLQTasks(arg0 context.Context, arg1 *adminservice.ListArchivalDLQTasksRequest) (*adminservice.ListArchivalDLQTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListArchivalDLQTasks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListArchivalDLQTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListArchivalDLQTasks indicates an expected call of ListArchivalDLQTasks.
+func (mr *MockAdminServiceServerMockRecorder) ListArchivalDLQTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArchivalDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ListArchivalDLQTasks), arg0, arg1)
+}
+
+// ListClusterMembers mocks base method.
+func (m *MockAdminServiceServer) ListClusterMembers(arg0 context.Context, arg1 *adminservice.ListClusterMembersRequest) (*adminservice.ListClusterMembersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusterMembers", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListClusterMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusterMembers indicates an expected call of ListClusterMembers.
+func (mr *MockAdminServiceServerMockRecorder) ListClusterMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterMembers", reflect.TypeOf((*MockAdminServiceServer)(nil).ListClusterMembers), arg0, arg1)
+}
+
+// ListClusters mocks base method.
+func (m *MockAdminServiceServer) ListClusters(arg0 context.Context, arg1 *adminservice.ListClustersRequest) (*adminservice.ListClustersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusters", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListClustersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusters indicates an expected call of ListClusters.
+func (mr *MockAdminServiceServerMockRecorder) ListClusters(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockAdminServiceServer)(nil).ListClusters), arg0, arg1)
+}
+
+// ListDynamicConfigChanges mocks base method.
+func (m *MockAdminServiceServer) ListDynamicConfigChanges(arg0 context.Context, arg1 *adminservice.ListDynamicConfigChangesRequest) (*adminservice.ListDynamicConfigChangesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDynamicConfigChanges", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigChangesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigChanges indicates an expected call of ListDynamicConfigChanges.
+func (mr *MockAdminServiceServerMockRecorder) ListDynamicConfigChanges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigChanges", reflect.TypeOf((*MockAdminServiceServer)(nil).ListDynamicConfigChanges), arg0, arg1)
+}
+
+// ListHistoryTasks mocks base method.
+func (m *MockAdminServiceServer) ListHistoryTasks(arg0 context.Context, arg1 *adminservice.ListHistoryTasksRequest) (*adminservice.ListHistoryTasksResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Send", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListHistoryTasks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListHistoryTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Send indicates an expected call of Send.
-func (mr *MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
+// ListHistoryTasks indicates an expected call of ListHistoryTasks.
+func (mr *MockAdminServiceServerMockRecorder) ListHistoryTasks(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockAdminService_StreamWorkflowReplicationMessagesClient)(nil).Send), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ListHistoryTasks), arg0, arg1)
 }
 
-// SendMsg mocks base method.
-func (m_2 *MockAdminService_StreamWorkflowReplicationMessagesClient) SendMsg(m interface{}) error {
-	m_2.ctrl.T.Helper()
-	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
-	ret0, _ := ret[0].(error)
-	return ret0
+// ListMetrics mocks base method.
+func (m *MockAdminServiceServer) ListMetrics(arg0 context.Context, arg1 *adminservice.ListMetricsRequest) (*adminservice.ListMetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMetrics", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListMetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SendMsg indicates an expected call of SendMsg.
-func (mr *MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+// ListMetrics indicates an expected call of ListMetrics.
+func (mr *MockAdminServiceServerMockRecorder) ListMetrics(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAdminService_StreamWorkflowReplicationMessagesClient)(nil).SendMsg), m)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMetrics", reflect.TypeOf((*MockAdminServiceServer)(nil).ListMetrics), arg0, arg1)
 }
 
-// Trailer mocks base method.
-func (m *MockAdminService_StreamWorkflowReplicationMessagesClient) Trailer() metadata.MD {
+// ListRoles mocks base method.
+func (m *MockAdminServiceServer) ListRoles(arg0 context.Context, arg1 *adminservice.ListRolesRequest) (*adminservice.ListRolesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Trailer")
-	ret0, _ := ret[0].(metadata.MD)
-	return ret0
+	ret := m.ctrl.Call(m, "ListRoles", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListRolesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Trailer indicates an expected call of Trailer.
-func (mr *MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder) Trailer() *gomock.Call {
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockAdminServiceServerMockRecorder) ListRoles(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockAdminService_StreamWorkflowReplicationMessagesClient)(nil).Trailer))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockAdminServiceServer)(nil).ListRoles), arg0, arg1)
 }
 
-// MockAdminServiceServer is a mock of AdminServiceServer interface.
-type MockAdminServiceServer struct {
-	ctrl     *gomock.Controller
-	recorder *MockAdminServiceServerMockRecorder
+// ListTopPersistenceNamespaces mocks base method.
+func (m *MockAdminServiceServer) ListTopPersistenceNamespaces(arg0 context.Context, arg1 *adminservice.ListTopPersistenceNamespacesRequest) (*adminservice.ListTopPersistenceNamespacesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTopPersistenceNamespaces", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListTopPersistenceNamespacesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockAdminServiceServerMockRecorder is the mock recorder for MockAdminServiceServer.
-type MockAdminServiceServerMockRecorder struct {
-	mock *MockAdminServiceServer
+// ListTopPersistenceNamespaces indicates an expected call of ListTopPersistenceNamespaces.
+func (mr *MockAdminServiceServerMockRecorder) ListTopPersistenceNamespaces(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopPersistenceNamespaces", reflect.TypeOf((*MockAdminServiceServer)(nil).ListTopPersistenceNamespaces), arg0, arg1)
 }
 
-// NewMockAdminServiceServer creates a new mock instance.
-func NewMockAdminServiceServer(ctrl *gomock.Controller) *MockAdminServiceServer {
-	mock := &MockAdminServiceServer{ctrl: ctrl}
-	mock.recorder = &MockAdminServiceServerMockRecorder{mock}
-	return mock
+// MergeDLQMessages mocks base method.
+func (m *MockAdminServiceServer) MergeDLQMessages(arg0 context.Context, arg1 *adminservice.MergeDLQMessagesRequest) (*adminservice.MergeDLQMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeDLQMessages", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.MergeDLQMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAdminServiceServer) EXPECT() *MockAdminServiceServerMockRecorder {
-	return m.recorder
+// MergeDLQMessages indicates an expected call of MergeDLQMessages.
+func (mr *MockAdminServiceServerMockRecorder) MergeDLQMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).MergeDLQMessages), arg0, arg1)
 }
 
-// AddOrUpdateRemoteCluster mocks base method.
-func (m *MockAdminServiceServer) AddOrUpdateRemoteCluster(arg0 context.Context, arg1 *adminservice.AddOrUpdateRemoteClusterRequest) (*adminservice.AddOrUpdateRemoteClusterResponse, error) {
+// PurgeDLQMessages mocks base method.
+func (m *MockAdminServiceServer) PurgeDLQMessages(arg0 context.Context, arg1 *adminservice.PurgeDLQMessagesRequest) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddOrUpdateRemoteCluster", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.AddOrUpdateRemoteClusterResponse)
+	ret := m.ctrl.Call(m, "PurgeDLQMessages", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PurgeDLQMessagesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddOrUpdateRemoteCluster indicates an expected call of AddOrUpdateRemoteCluster.
-func (mr *MockAdminServiceServerMockRecorder) AddOrUpdateRemoteCluster(arg0, arg1 interface{}) *gomock.Call {
+// PurgeDLQMessages indicates an expected call of PurgeDLQMessages.
+func (mr *MockAdminServiceServerMockRecorder) PurgeDLQMessages(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrUpdateRemoteCluster", reflect.TypeOf((*MockAdminServiceServer)(nil).AddOrUpdateRemoteCluster), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).PurgeDLQMessages), arg0, arg1)
 }
 
-// AddSearchAttributes mocks base method.
-func (m *MockAdminServiceServer) AddSearchAttributes(arg0 context.Context, arg1 *adminservice.AddSearchAttributesRequest) (*adminservice.AddSearchAttributesResponse, error) {
+// PutRole mocks base method.
+func (m *MockAdminServiceServer) PutRole(arg0 context.Context, arg1 *adminservice.PutRoleRequest) (*adminservice.PutRoleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddSearchAttributes", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.AddSearchAttributesResponse)
+	ret := m.ctrl.Call(m, "PutRole", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PutRoleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddSearchAttributes indicates an expected call of AddSearchAttributes.
-func (mr *MockAdminServiceServerMockRecorder) AddSearchAttributes(arg0, arg1 interface{}) *gomock.Call {
+// PutRole indicates an expected call of PutRole.
+func (mr *MockAdminServiceServerMockRecorder) PutRole(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSearchAttributes", reflect.TypeOf((*MockAdminServiceServer)(nil).AddSearchAttributes), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRole", reflect.TypeOf((*MockAdminServiceServer)(nil).PutRole), arg0, arg1)
 }
 
-// CloseShard mocks base method.
-func (m *MockAdminServiceServer) CloseShard(arg0 context.Context, arg1 *adminservice.CloseShardRequest) (*adminservice.CloseShardResponse, error) {
+// ReapplyEvents mocks base method.
+func (m *MockAdminServiceServer) ReapplyEvents(arg0 context.Context, arg1 *adminservice.ReapplyEventsRequest) (*adminservice.ReapplyEventsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CloseShard", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.CloseShardResponse)
+	ret := m.ctrl.Call(m, "ReapplyEvents", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ReapplyEventsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CloseShard indicates an expected call of CloseShard.
-func (mr *MockAdminServiceServerMockRecorder) CloseShard(arg0, arg1 interface{}) *gomock.Call {
+// ReapplyEvents indicates an expected call of ReapplyEvents.
+func (mr *MockAdminServiceServerMockRecorder) ReapplyEvents(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceServer)(nil).CloseShard), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockAdminServiceServer)(nil).ReapplyEvents), arg0, arg1)
 }
 
-// DeleteWorkflowExecution mocks base method.
-func (m *MockAdminServiceServer) DeleteWorkflowExecution(arg0 context.Context, arg1 *adminservice.DeleteWorkflowExecutionRequest) (*adminservice.DeleteWorkflowExecutionResponse, error) {
+// RebuildMutableState mocks base method.
+func (m *MockAdminServiceServer) RebuildMutableState(arg0 context.Context, arg1 *adminservice.RebuildMutableStateRequest) (*adminservice.RebuildMutableStateResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.DeleteWorkflowExecutionResponse)
+	ret := m.ctrl.Call(m, "RebuildMutableState", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RebuildMutableStateResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution.
-func (mr *MockAdminServiceServerMockRecorder) DeleteWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+// RebuildMutableState indicates an expected call of RebuildMutableState.
+func (mr *MockAdminServiceServerMockRecorder) RebuildMutableState(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteWorkflowExecution), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).RebuildMutableState), arg0, arg1)
 }
 
-// DescribeCluster mocks base method.
-func (m *MockAdminServiceServer) DescribeCluster(arg0 context.Context, arg1 *adminservice.DescribeClusterRequest) (*adminservice.DescribeClusterResponse, error) {
+// RefreshWorkflowTasks mocks base method.
+func (m *MockAdminServiceServer) RefreshWorkflowTasks(arg0 context.Context, arg1 *adminservice.RefreshWorkflowTasksRequest) (*adminservice.RefreshWorkflowTasksResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeCluster", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.DescribeClusterResponse)
+	ret := m.ctrl.Call(m, "RefreshWorkflowTasks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RefreshWorkflowTasksResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeCluster indicates an expected call of DescribeCluster.
-func (mr *MockAdminServiceServerMockRecorder) DescribeCluster(arg0, arg1 interface{}) *gomock.Call {
+// RefreshWorkflowTasks indicates an expected call of RefreshWorkflowTasks.
+func (mr *MockAdminServiceServerMockRecorder) RefreshWorkflowTasks(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCluster", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeCluster), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).RefreshWorkflowTasks), arg0, arg1)
 }
 
-// DescribeHistoryHost mocks base method.
-func (m *MockAdminServiceServer) DescribeHistoryHost(arg0 context.Context, arg1 *adminservice.DescribeHistoryHostRequest) (*adminservice.DescribeHistoryHostResponse, error) {
+// RehydrateWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) RehydrateWorkflowExecution(arg0 context.Context, arg1 *adminservice.RehydrateWorkflowExecutionRequest) (*adminservice.RehydrateWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeHistoryHost", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.DescribeHistoryHostResponse)
+	ret := m.ctrl.Call(m, "RehydrateWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RehydrateWorkflowExecutionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeHistoryHost indicates an expected call of DescribeHistoryHost.
-func (mr *MockAdminServiceServerMockRecorder) DescribeHistoryHost(arg0, arg1 interface{}) *gomock.Call {
+// RehydrateWorkflowExecution indicates an expected call of RehydrateWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) RehydrateWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHost", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeHistoryHost), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RehydrateWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).RehydrateWorkflowExecution), arg0, arg1)
 }
 
-// DescribeMutableState mocks base method.
-func (m *MockAdminServiceServer) DescribeMutableState(arg0 context.Context, arg1 *adminservice.DescribeMutableStateRequest) (*adminservice.DescribeMutableStateResponse, error) {
+// RemoveRemoteCluster mocks base method.
+func (m *MockAdminServiceServer) RemoveRemoteCluster(arg0 context.Context, arg1 *adminservice.RemoveRemoteClusterRequest) (*adminservice.RemoveRemoteClusterResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeMutableState", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.DescribeMutableStateResponse)
+	ret := m.ctrl.Call(m, "RemoveRemoteCluster", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RemoveRemoteClusterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeMutableState indicates an expected call of DescribeMutableState.
-func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 interface{}) *gomock.Call {
+// RemoveRemoteCluster indicates an expected call of RemoveRemoteCluster.
+func (mr *MockAdminServiceServerMockRecorder) RemoveRemoteCluster(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRemoteCluster", reflect.TypeOf((*MockAdminServiceServer)(nil).RemoveRemoteCluster), arg0, arg1)
 }
 
-// GetDLQMessages mocks base method.
-func (m *MockAdminServiceServer) GetDLQMessages(arg0 context.Context, arg1 *adminservice.GetDLQMessagesRequest) (*adminservice.GetDLQMessagesResponse, error) {
+// RemoveSearchAttributes mocks base method.
+func (m *MockAdminServiceServer) RemoveSearchAttributes(arg0 context.Context, arg1 *adminservice.RemoveSearchAttributesRequest) (*adminservice.RemoveSearchAttributesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDLQMessages", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.GetDLQMessagesResponse)
+	ret := m.ctrl.Call(m, "RemoveSearchAttributes", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RemoveSearchAttributesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDLQMessages indicates an expected call of GetDLQMessages.
-func (mr *MockAdminServiceServerMockRecorder) GetDLQMessages(arg0, arg1 interface{}) *gomock.Call {
+// RemoveSearchAttributes indicates an expected call of RemoveSearchAttributes.
+func (mr *MockAdminServiceServerMockRecorder) RemoveSearchAttributes(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDLQMessages), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSearchAttributes", reflect.TypeOf((*MockAdminServiceServer)(nil).RemoveSearchAttributes), arg0, arg1)
 }
 
-// GetDLQReplicationMessages mocks base method.
-func (m *MockAdminServiceServer) GetDLQReplicationMessages(arg0 context.Context, arg1 *adminservice.GetDLQReplicationMessagesRequest) (*adminservice.GetDLQReplicationMessagesResponse, error) {
+// RemoveTask mocks base method.
+func (m *MockAdminServiceServer) RemoveTask(arg0 context.Context, arg1 *adminservice.RemoveTaskRequest) (*adminservice.RemoveTaskResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDLQReplicationMessages", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.GetDLQReplicationMessagesResponse)
+	ret := m.ctrl.Call(m, "RemoveTask", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RemoveTaskResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDLQReplicationMessages indicates an expected call of GetDLQReplicationMessages.
-func (mr *MockAdminServiceServerMockRecorder) GetDLQReplicationMessages(arg0, arg1 interface{}) *gomock.Call {
+// RemoveTask indicates an expected call of RemoveTask.
+func (mr *MockAdminServiceServerMockRecorder) RemoveTask(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDLQReplicationMessages), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockAdminServiceServer)(nil).RemoveTask), arg0, arg1)
 }
 
-// GetNamespaceReplicationMessages mocks base method.
-func (m *MockAdminServiceServer) GetNamespaceReplicationMessages(arg0 context.Context, arg1 *adminservice.GetNamespaceReplicationMessagesRequest) (*adminservice.GetNamespaceReplicationMessagesResponse, error) {
+// ResendReplicationTasks mocks base method.
+func (m *MockAdminServiceServer) ResendReplicationTasks(arg0 context.Context, arg1 *adminservice.ResendReplicationTasksRequest) (*adminservice.ResendReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNamespaceReplicationMessages", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.GetNamespaceReplicationMessagesResponse)
+	ret := m.ctrl.Call(m, "ResendReplicationTasks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ResendReplicationTasksResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetNamespaceReplicationMessages indicates an expected call of GetNamespaceReplicationMessages.
-func (mr *MockAdminServiceServerMockRecorder) GetNamespaceReplicationMessages(arg0, arg1 interface{}) *gomock.Call {
+// ResendReplicationTasks indicates an expected call of ResendReplicationTasks.
+func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetNamespaceReplicationMessages), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
 
-// GetReplicationMessages mocks base method.
-func (m *MockAdminServiceServer) GetReplicationMessages(arg0 context.Context, arg1 *adminservice.GetReplicationMessagesRequest) (*adminservice.GetReplicationMessagesResponse, error) {
+// RestoreClusterSnapshot mocks base method.
+func (m *MockAdminServiceServer) RestoreClusterSnapshot(arg0 context.Context, arg1 *adminservice.RestoreClusterSnapshotRequest) (*adminservice.RestoreClusterSnapshotResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReplicationMessages", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.GetReplicationMessagesResponse)
+	ret := m.ctrl.Call(m, "RestoreClusterSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RestoreClusterSnapshotResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetReplicationMessages indicates an expected call of GetReplicationMessages.
-func (mr *MockAdminServiceServerMockRecorder) GetReplicationMessages(arg0, arg1 interface{}) *gomock.Call {
+// RestoreClusterSnapshot indicates an expected call of RestoreClusterSnapshot.
+func (mr *MockAdminServiceServerMockRecorder) RestoreClusterSnapshot(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetReplicationMessages), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreClusterSnapshot", reflect.TypeOf((*MockAdminServiceServer)(nil).RestoreClusterSnapshot), arg0, arg1)
 }
 
-// GetSearchAttributes mocks base method.
-func (m *MockAdminServiceServer) GetSearchAttributes(arg0 context.Context, arg1 *adminservice.GetSearchAttributesRequest) (*adminservice.GetSearchAttributesResponse, error) {
+// RetryArchivalDLQTask mocks base method.
+func (m *MockAdminServiceServer) RetryArchivalDLQTask(arg0 context.Context, arg1 *adminservice.RetryArchivalDLQTaskRequest) (*adminservice.RetryArchivalDLQTaskResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSearchAttributes", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.GetSearchAttributesResponse)
+	ret := m.ctrl.Call(m, "RetryArchivalDLQTask", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RetryArchivalDLQTaskResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSearchAttributes indicates an expected call of GetSearchAttributes.
-func (mr *MockAdminServiceServerMockRecorder) GetSearchAttributes(arg0, arg1 interface{}) *gomock.Call {
+// RetryArchivalDLQTask indicates an expected call of RetryArchivalDLQTask.
+func (mr *MockAdminServiceServerMockRecorder) RetryArchivalDLQTask(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchAttributes", reflect.TypeOf((*MockAdminServiceServer)(nil).GetSearchAttributes), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryArchivalDLQTask", reflect.TypeOf((*MockAdminServiceServer)(nil).RetryArchivalDLQTask), arg0, arg1)
 }
 
-// GetShard mocks base method.
-func (m *MockAdminServiceServer) GetShard(arg0 context.Context, arg1 *adminservice.GetShardRequest) (*adminservice.GetShardResponse, error) {
+// RevokeAPIKey mocks base method.
+func (m *MockAdminServiceServer) RevokeAPIKey(arg0 context.Context, arg1 *adminservice.RevokeAPIKeyRequest) (*adminservice.RevokeAPIKeyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetShard", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.GetShardResponse)
+	ret := m.ctrl.Call(m, "RevokeAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RevokeAPIKeyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetShard indicates an expected call of GetShard.
-func (mr *MockAdminServiceServerMockRecorder) GetShard(arg0, arg1 interface{}) *gomock.Call {
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockAdminServiceServerMockRecorder) RevokeAPIKey(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShard", reflect.TypeOf((*MockAdminServiceServer)(nil).GetShard), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockAdminServiceServer)(nil).RevokeAPIKey), arg0, arg1)
 }
 
-// GetTaskQueueTasks mocks base method.
-func (m *MockAdminServiceServer) GetTaskQueueTasks(arg0 context.Context, arg1 *adminservice.GetTaskQueueTasksRequest) (*adminservice.GetTaskQueueTasksResponse, error) {
+// RotateAPIKey mocks base method.
+func (m *MockAdminServiceServer) RotateAPIKey(arg0 context.Context, arg1 *adminservice.RotateAPIKeyRequest) (*adminservice.RotateAPIKeyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTaskQueueTasks", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.GetTaskQueueTasksResponse)
+	ret := m.ctrl.Call(m, "RotateAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RotateAPIKeyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTaskQueueTasks indicates an expected call of GetTaskQueueTasks.
-func (mr *MockAdminServiceServerMockRecorder) GetTaskQueueTasks(arg0, arg1 interface{}) *gomock.Call {
+// RotateAPIKey indicates an expected call of RotateAPIKey.
+func (mr *MockAdminServiceServerMockRecorder) RotateAPIKey(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskQueueTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).GetTaskQueueTasks), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateAPIKey", reflect.TypeOf((*MockAdminServiceServer)(nil).RotateAPIKey), arg0, arg1)
 }
 
-// GetWorkflowExecutionRawHistoryV2 mocks base method.
-func (m *MockAdminServiceServer) GetWorkflowExecutionRawHistoryV2(arg0 context.Context, arg1 *adminservice.GetWorkflowExecutionRawHistoryV2Request) (*adminservice.GetWorkflowExecutionRawHistoryV2Response, error) {
+// SetDynamicConfigOverride mocks base method.
+func (m *MockAdminServiceServer) SetDynamicConfigOverride(arg0 context.Context, arg1 *adminservice.SetDynamicConfigOverrideRequest) (*adminservice.SetDynamicConfigOverrideResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetWorkflowExecutionRawHistoryV2", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.GetWorkflowExecutionRawHistoryV2Response)
+	ret := m.ctrl.Call(m, "SetDynamicConfigOverride", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SetDynamicConfigOverrideResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetWorkflowExecutionRawHistoryV2 indicates an expected call of GetWorkflowExecutionRawHistoryV2.
-func (mr *MockAdminServiceServerMockRecorder) GetWorkflowExecutionRawHistoryV2(arg0, arg1 interface{}) *gomock.Call {
+// SetDynamicConfigOverride indicates an expected call of SetDynamicConfigOverride.
+func (mr *MockAdminServiceServerMockRecorder) SetDynamicConfigOverride(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceServer)(nil).GetWorkflowExecutionRawHistoryV2), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDynamicConfigOverride", reflect.TypeOf((*MockAdminServiceServer)(nil).SetDynamicConfigOverride), arg0, arg1)
 }
 
-// ListClusterMembers mocks base method.
-func (m *MockAdminServiceServer) ListClusterMembers(arg0 context.Context, arg1 *adminservice.ListClusterMembersRequest) (*adminservice.ListClusterMembersResponse, error) {
+// SetDynamicConfigRollout mocks base method.
+func (m *MockAdminServiceServer) SetDynamicConfigRollout(arg0 context.Context, arg1 *adminservice.SetDynamicConfigRolloutRequest) (*adminservice.SetDynamicConfigRolloutResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListClusterMembers", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.ListClusterMembersResponse)
+	ret := m.ctrl.Call(m, "SetDynamicConfigRollout", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SetDynamicConfigRolloutResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListClusterMembers indicates an expected call of ListClusterMembers.
-func (mr *MockAdminServiceServerMockRecorder) ListClusterMembers(arg0, arg1 interface{}) *gomock.Call {
+// SetDynamicConfigRollout indicates an expected call of SetDynamicConfigRollout.
+func (mr *MockAdminServiceServerMockRecorder) SetDynamicConfigRollout(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterMembers", reflect.TypeOf((*MockAdminServiceServer)(nil).ListClusterMembers), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDynamicConfigRollout", reflect.TypeOf((*MockAdminServiceServer)(nil).SetDynamicConfigRollout), arg0, arg1)
 }
 
-// ListClusters mocks base method.
-func (m *MockAdminServiceServer) ListClusters(arg0 context.Context, arg1 *adminservice.ListClustersRequest) (*adminservice.ListClustersResponse, error) {
+// SetGroupRoles mocks base method.
+func (m *MockAdminServiceServer) SetGroupRoles(arg0 context.Context, arg1 *adminservice.SetGroupRolesRequest) (*adminservice.SetGroupRolesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListClusters", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.ListClustersResponse)
+	ret := m.ctrl.Call(m, "SetGroupRoles", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SetGroupRolesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListClusters indicates an expected call of ListClusters.
-func (mr *MockAdminServiceServerMockRecorder) ListClusters(arg0, arg1 interface{}) *gomock.Call {
+// SetGroupRoles indicates an expected call of SetGroupRoles.
+func (mr *MockAdminServiceServerMockRecorder) SetGroupRoles(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockAdminServiceServer)(nil).ListClusters), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGroupRoles", reflect.TypeOf((*MockAdminServiceServer)(nil).SetGroupRoles), arg0, arg1)
 }
 
-// ListHistoryTasks mocks base method.
-func (m *MockAdminServiceServer) ListHistoryTasks(arg0 context.Context, arg1 *adminservice.ListHistoryTasksRequest) (*adminservice.ListHistoryTasksResponse, error) {
+// StartBatchOperation mocks base method.
+func (m *MockAdminServiceServer) StartBatchOperation(arg0 context.Context, arg1 *adminservice.StartBatchOperationRequest) (*adminservice.StartBatchOperationResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListHistoryTasks", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.ListHistoryTasksResponse)
+	ret := m.ctrl.Call(m, "StartBatchOperation", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartBatchOperationResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListHistoryTasks indicates an expected call of ListHistoryTasks.
-func (mr *MockAdminServiceServerMockRecorder) ListHistoryTasks(arg0, arg1 interface{}) *gomock.Call {
+// StartBatchOperation indicates an expected call of StartBatchOperation.
+func (mr *MockAdminServiceServerMockRecorder) StartBatchOperation(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ListHistoryTasks), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartBatchOperation", reflect.TypeOf((*MockAdminServiceServer)(nil).StartBatchOperation), arg0, arg1)
 }
 
-// MergeDLQMessages mocks base method.
-func (m *MockAdminServiceServer) MergeDLQMessages(arg0 context.Context, arg1 *adminservice.MergeDLQMessagesRequest) (*adminservice.MergeDLQMessagesResponse, error) {
+// StartDrain mocks base method.
+func (m *MockAdminServiceServer) StartDrain(arg0 context.Context, arg1 *adminservice.StartDrainRequest) (*adminservice.StartDrainResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MergeDLQMessages", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.MergeDLQMessagesResponse)
+	ret := m.ctrl.Call(m, "StartDrain", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartDrainResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// MergeDLQMessages indicates an expected call of MergeDLQMessages.
-func (mr *MockAdminServiceServerMockRecorder) MergeDLQMessages(arg0, arg1 interface{}) *gomock.Call {
+// StartDrain indicates an expected call of StartDrain.
+func (mr *MockAdminServiceServerMockRecorder) StartDrain(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).MergeDLQMessages), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartDrain", reflect.TypeOf((*MockAdminServiceServer)(nil).StartDrain), arg0, arg1)
 }
 
-// PurgeDLQMessages mocks base method.
-func (m *MockAdminServiceServer) PurgeDLQMessages(arg0 context.Context, arg1 *adminservice.PurgeDLQMessagesRequest) (*adminservice.PurgeDLQMessagesResponse, error) {
+// StartNamespaceExport mocks base method.
+func (m *MockAdminServiceServer) StartNamespaceExport(arg0 context.Context, arg1 *adminservice.StartNamespaceExportRequest) (*adminservice.StartNamespaceExportResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PurgeDLQMessages", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.PurgeDLQMessagesResponse)
+	ret := m.ctrl.Call(m, "StartNamespaceExport", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartNamespaceExportResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// PurgeDLQMessages indicates an expected call of PurgeDLQMessages.
-func (mr *MockAdminServiceServerMockRecorder) PurgeDLQMessages(arg0, arg1 interface{}) *gomock.Call {
+// StartNamespaceExport indicates an expected call of StartNamespaceExport.
+func (mr *MockAdminServiceServerMockRecorder) StartNamespaceExport(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).PurgeDLQMessages), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartNamespaceExport", reflect.TypeOf((*MockAdminServiceServer)(nil).StartNamespaceExport), arg0, arg1)
 }
 
-// ReapplyEvents mocks base method.
-func (m *MockAdminServiceServer) ReapplyEvents(arg0 context.Context, arg1 *adminservice.ReapplyEventsRequest) (*adminservice.ReapplyEventsResponse, error) {
+// StreamDatabaseBackup mocks base method.
+func (m *MockAdminServiceServer) StreamDatabaseBackup(arg0 *adminservice.StreamDatabaseBackupRequest, arg1 adminservice.AdminService_StreamDatabaseBackupServer) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReapplyEvents", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.ReapplyEventsResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "StreamDatabaseBackup", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ReapplyEvents indicates an expected call of ReapplyEvents.
-func (mr *MockAdminServiceServerMockRecorder) ReapplyEvents(arg0, arg1 interface{}) *gomock.Call {
+// StreamDatabaseBackup indicates an expected call of StreamDatabaseBackup.
+func (mr *MockAdminServiceServerMockRecorder) StreamDatabaseBackup(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockAdminServiceServer)(nil).ReapplyEvents), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamDatabaseBackup", reflect.TypeOf((*MockAdminServiceServer)(nil).StreamDatabaseBackup), arg0, arg1)
 }
 
-// RebuildMutableState mocks base method.
-func (m *MockAdminServiceServer) RebuildMutableState(arg0 context.Context, arg1 *adminservice.RebuildMutableStateRequest) (*adminservice.RebuildMutableStateResponse, error) {
+// StreamDiagnosticsBundle mocks base method.
+func (m *MockAdminServiceServer) StreamDiagnosticsBundle(arg0 *adminservice.StreamDiagnosticsBundleRequest, arg1 adminservice.AdminService_StreamDiagnosticsBundleServer) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RebuildMutableState", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.RebuildMutableStateResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "StreamDiagnosticsBundle", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// RebuildMutableState indicates an expected call of RebuildMutableState.
-func (mr *MockAdminServiceServerMockRecorder) RebuildMutableState(arg0, arg1 interface{}) *gomock.Call {
+// StreamDiagnosticsBundle indicates an expected call of StreamDiagnosticsBundle.
+func (mr *MockAdminServiceServerMockRecorder) StreamDiagnosticsBundle(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).RebuildMutableState), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamDiagnosticsBundle", reflect.TypeOf((*MockAdminServiceServer)(nil).StreamDiagnosticsBundle), arg0, arg1)
 }
 
-// RefreshWorkflowTasks mocks base method.
-func (m *MockAdminServiceServer) RefreshWorkflowTasks(arg0 context.Context, arg1 *adminservice.RefreshWorkflowTasksRequest) (*adminservice.RefreshWorkflowTasksResponse, error) {
+// StreamWorkflowReplicationMessages mocks base method.
+func (m *MockAdminServiceServer) StreamWorkflowReplicationMessages(arg0 adminservice.AdminService_StreamWorkflowReplicationMessagesServer) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RefreshWorkflowTasks", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.RefreshWorkflowTasksResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "StreamWorkflowReplicationMessages", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// RefreshWorkflowTasks indicates an expected call of RefreshWorkflowTasks.
-func (mr *MockAdminServiceServerMockRecorder) RefreshWorkflowTasks(arg0, arg1 interface{}) *gomock.Call {
+// StreamWorkflowReplicationMessages indicates an expected call of StreamWorkflowReplicationMessages.
+func (mr *MockAdminServiceServerMockRecorder) StreamWorkflowReplicationMessages(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).RefreshWorkflowTasks), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).StreamWorkflowReplicationMessages), arg0)
 }
 
-// RemoveRemoteCluster mocks base method.
-func (m *MockAdminServiceServer) RemoveRemoteCluster(arg0 context.Context, arg1 *adminservice.RemoveRemoteClusterRequest) (*adminservice.RemoveRemoteClusterResponse, error) {
+// UpdateNamespaceDeletionRate mocks base method.
+func (m *MockAdminServiceServer) UpdateNamespaceDeletionRate(arg0 context.Context, arg1 *adminservice.UpdateNamespaceDeletionRateRequest) (*adminservice.UpdateNamespaceDeletionRateResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveRemoteCluster", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.RemoveRemoteClusterResponse)
+	ret := m.ctrl.Call(m, "UpdateNamespaceDeletionRate", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateNamespaceDeletionRateResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RemoveRemoteCluster indicates an expected call of RemoveRemoteCluster.
-func (mr *MockAdminServiceServerMockRecorder) RemoveRemoteCluster(arg0, arg1 interface{}) *gomock.Call {
+// UpdateNamespaceDeletionRate indicates an expected call of UpdateNamespaceDeletionRate.
+func (mr *MockAdminServiceServerMockRecorder) UpdateNamespaceDeletionRate(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRemoteCluster", reflect.TypeOf((*MockAdminServiceServer)(nil).RemoveRemoteCluster), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNamespaceDeletionRate", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateNamespaceDeletionRate), arg0, arg1)
 }
 
-// RemoveSearchAttributes mocks base method.
-func (m *MockAdminServiceServer) RemoveSearchAttributes(arg0 context.Context, arg1 *adminservice.RemoveSearchAttributesRequest) (*adminservice.RemoveSearchAttributesResponse, error) {
+// UpdateNamespaceVisibilityRetention mocks base method.
+func (m *MockAdminServiceServer) UpdateNamespaceVisibilityRetention(arg0 context.Context, arg1 *adminservice.UpdateNamespaceVisibilityRetentionRequest) (*adminservice.UpdateNamespaceVisibilityRetentionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveSearchAttributes", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.RemoveSearchAttributesResponse)
+	ret := m.ctrl.Call(m, "UpdateNamespaceVisibilityRetention", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateNamespaceVisibilityRetentionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RemoveSearchAttributes indicates an expected call of RemoveSearchAttributes.
-func (mr *MockAdminServiceServerMockRecorder) RemoveSearchAttributes(arg0, arg1 interface{}) *gomock.Call {
+// UpdateNamespaceVisibilityRetention indicates an expected call of UpdateNamespaceVisibilityRetention.
+func (mr *MockAdminServiceServerMockRecorder) UpdateNamespaceVisibilityRetention(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSearchAttributes", reflect.TypeOf((*MockAdminServiceServer)(nil).RemoveSearchAttributes), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNamespaceVisibilityRetention", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateNamespaceVisibilityRetention), arg0, arg1)
 }
 
-// RemoveTask mocks base method.
-func (m *MockAdminServiceServer) RemoveTask(arg0 context.Context, arg1 *adminservice.RemoveTaskRequest) (*adminservice.RemoveTaskResponse, error) {
+// UpdateSchedulePauseAfterFailures mocks base method.
+func (m *MockAdminServiceServer) UpdateSchedulePauseAfterFailures(arg0 context.Context, arg1 *adminservice.UpdateSchedulePauseAfterFailuresRequest) (*adminservice.UpdateSchedulePauseAfterFailuresResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveTask", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.RemoveTaskResponse)
+	ret := m.ctrl.Call(m, "UpdateSchedulePauseAfterFailures", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateSchedulePauseAfterFailuresResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RemoveTask indicates an expected call of RemoveTask.
-func (mr *MockAdminServiceServerMockRecorder) RemoveTask(arg0, arg1 interface{}) *gomock.Call {
+// UpdateSchedulePauseAfterFailures indicates an expected call of UpdateSchedulePauseAfterFailures.
+func (mr *MockAdminServiceServerMockRecorder) UpdateSchedulePauseAfterFailures(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockAdminServiceServer)(nil).RemoveTask), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedulePauseAfterFailures", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateSchedulePauseAfterFailures), arg0, arg1)
 }
 
-// ResendReplicationTasks mocks base method.
-func (m *MockAdminServiceServer) ResendReplicationTasks(arg0 context.Context, arg1 *adminservice.ResendReplicationTasksRequest) (*adminservice.ResendReplicationTasksResponse, error) {
+// UpdateWorkflowExecutionMemo mocks base method.
+func (m *MockAdminServiceServer) UpdateWorkflowExecutionMemo(arg0 context.Context, arg1 *adminservice.UpdateWorkflowExecutionMemoRequest) (*adminservice.UpdateWorkflowExecutionMemoResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ResendReplicationTasks", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.ResendReplicationTasksResponse)
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecutionMemo", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateWorkflowExecutionMemoResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ResendReplicationTasks indicates an expected call of ResendReplicationTasks.
-func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1 interface{}) *gomock.Call {
+// UpdateWorkflowExecutionMemo indicates an expected call of UpdateWorkflowExecutionMemo.
+func (mr *MockAdminServiceServerMockRecorder) UpdateWorkflowExecutionMemo(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecutionMemo", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateWorkflowExecutionMemo), arg0, arg1)
 }
 
-// StreamWorkflowReplicationMessages mocks base method.
-func (m *MockAdminServiceServer) StreamWorkflowReplicationMessages(arg0 adminservice.AdminService_StreamWorkflowReplicationMessagesServer) error {
+// UpsertWorkflowExecutionSearchAttributes mocks base method.
+func (m *MockAdminServiceServer) UpsertWorkflowExecutionSearchAttributes(arg0 context.Context, arg1 *adminservice.UpsertWorkflowExecutionSearchAttributesRequest) (*adminservice.UpsertWorkflowExecutionSearchAttributesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StreamWorkflowReplicationMessages", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "UpsertWorkflowExecutionSearchAttributes", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpsertWorkflowExecutionSearchAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// StreamWorkflowReplicationMessages indicates an expected call of StreamWorkflowReplicationMessages.
-func (mr *MockAdminServiceServerMockRecorder) StreamWorkflowReplicationMessages(arg0 interface{}) *gomock.Call {
+// UpsertWorkflowExecutionSearchAttributes indicates an expected call of UpsertWorkflowExecutionSearchAttributes.
+func (mr *MockAdminServiceServerMockRecorder) UpsertWorkflowExecutionSearchAttributes(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).StreamWorkflowReplicationMessages), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowExecutionSearchAttributes", reflect.TypeOf((*MockAdminServiceServer)(nil).UpsertWorkflowExecutionSearchAttributes), arg0, arg1)
 }
 
 // MockAdminService_StreamWorkflowReplicationMessagesServer is a mock of AdminService_StreamWorkflowReplicationMessagesServer interface.
@@ -1333,3 +3432,241 @@ func (mr *MockAdminService_StreamWorkflowReplicationMessagesServerMockRecorder)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockAdminService_StreamWorkflowReplicationMessagesServer)(nil).SetTrailer), arg0)
 }
+
+// MockAdminService_StreamDatabaseBackupServer is a mock of AdminService_StreamDatabaseBackupServer interface.
+type MockAdminService_StreamDatabaseBackupServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminService_StreamDatabaseBackupServerMockRecorder
+}
+
+// MockAdminService_StreamDatabaseBackupServerMockRecorder is the mock recorder for MockAdminService_StreamDatabaseBackupServer.
+type MockAdminService_StreamDatabaseBackupServerMockRecorder struct {
+	mock *MockAdminService_StreamDatabaseBackupServer
+}
+
+// NewMockAdminService_StreamDatabaseBackupServer creates a new mock instance.
+func NewMockAdminService_StreamDatabaseBackupServer(ctrl *gomock.Controller) *MockAdminService_StreamDatabaseBackupServer {
+	mock := &MockAdminService_StreamDatabaseBackupServer{ctrl: ctrl}
+	mock.recorder = &MockAdminService_StreamDatabaseBackupServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService_StreamDatabaseBackupServer) EXPECT() *MockAdminService_StreamDatabaseBackupServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockAdminService_StreamDatabaseBackupServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockAdminService_StreamDatabaseBackupServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAdminService_StreamDatabaseBackupServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockAdminService_StreamDatabaseBackupServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockAdminService_StreamDatabaseBackupServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAdminService_StreamDatabaseBackupServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockAdminService_StreamDatabaseBackupServer) Send(arg0 *adminservice.StreamDatabaseBackupResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockAdminService_StreamDatabaseBackupServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockAdminService_StreamDatabaseBackupServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockAdminService_StreamDatabaseBackupServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockAdminService_StreamDatabaseBackupServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockAdminService_StreamDatabaseBackupServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockAdminService_StreamDatabaseBackupServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockAdminService_StreamDatabaseBackupServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAdminService_StreamDatabaseBackupServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockAdminService_StreamDatabaseBackupServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockAdminService_StreamDatabaseBackupServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockAdminService_StreamDatabaseBackupServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockAdminService_StreamDatabaseBackupServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockAdminService_StreamDatabaseBackupServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockAdminService_StreamDatabaseBackupServer)(nil).SetTrailer), arg0)
+}
+
+// MockAdminService_StreamDiagnosticsBundleServer is a mock of AdminService_StreamDiagnosticsBundleServer interface.
+type MockAdminService_StreamDiagnosticsBundleServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminService_StreamDiagnosticsBundleServerMockRecorder
+}
+
+// MockAdminService_StreamDiagnosticsBundleServerMockRecorder is the mock recorder for MockAdminService_StreamDiagnosticsBundleServer.
+type MockAdminService_StreamDiagnosticsBundleServerMockRecorder struct {
+	mock *MockAdminService_StreamDiagnosticsBundleServer
+}
+
+// NewMockAdminService_StreamDiagnosticsBundleServer creates a new mock instance.
+func NewMockAdminService_StreamDiagnosticsBundleServer(ctrl *gomock.Controller) *MockAdminService_StreamDiagnosticsBundleServer {
+	mock := &MockAdminService_StreamDiagnosticsBundleServer{ctrl: ctrl}
+	mock.recorder = &MockAdminService_StreamDiagnosticsBundleServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService_StreamDiagnosticsBundleServer) EXPECT() *MockAdminService_StreamDiagnosticsBundleServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockAdminService_StreamDiagnosticsBundleServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockAdminService_StreamDiagnosticsBundleServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAdminService_StreamDiagnosticsBundleServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockAdminService_StreamDiagnosticsBundleServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockAdminService_StreamDiagnosticsBundleServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAdminService_StreamDiagnosticsBundleServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockAdminService_StreamDiagnosticsBundleServer) Send(arg0 *adminservice.StreamDiagnosticsBundleResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockAdminService_StreamDiagnosticsBundleServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockAdminService_StreamDiagnosticsBundleServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockAdminService_StreamDiagnosticsBundleServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockAdminService_StreamDiagnosticsBundleServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockAdminService_StreamDiagnosticsBundleServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockAdminService_StreamDiagnosticsBundleServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockAdminService_StreamDiagnosticsBundleServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAdminService_StreamDiagnosticsBundleServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockAdminService_StreamDiagnosticsBundleServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockAdminService_StreamDiagnosticsBundleServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockAdminService_StreamDiagnosticsBundleServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockAdminService_StreamDiagnosticsBundleServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockAdminService_StreamDiagnosticsBundleServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockAdminService_StreamDiagnosticsBundleServer)(nil).SetTrailer), arg0)
+}
@@ -142,6 +142,7 @@ const (
 	TASK_TYPE_TRANSFER_DELETE_EXECUTION       TaskType = 24
 	TASK_TYPE_REPLICATION_SYNC_WORKFLOW_STATE TaskType = 25
 	TASK_TYPE_ARCHIVAL_ARCHIVE_EXECUTION      TaskType = 26
+	TASK_TYPE_DELETE_VISIBILITY_RECORD        TaskType = 27
 )
 
 var TaskType_name = map[int32]string{
@@ -169,6 +170,7 @@ var TaskType_name = map[int32]string{
 	24: "TransferDeleteExecution",
 	25: "ReplicationSyncWorkflowState",
 	26: "ArchivalArchiveExecution",
+	27: "DeleteVisibilityRecord",
 }
 
 var TaskType_value = map[string]int32{
@@ -196,6 +198,7 @@ var TaskType_value = map[string]int32{
 	"TransferDeleteExecution":      24,
 	"ReplicationSyncWorkflowState": 25,
 	"ArchivalArchiveExecution":     26,
+	"DeleteVisibilityRecord":       27,
 }
 
 func (TaskType) EnumDescriptor() ([]byte, []int) {
@@ -213,48 +216,48 @@ func init() {
 }
 
 var fileDescriptor_36a3d3674ca3cfa6 = []byte{
-	// 651 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcd, 0x4e, 0xdb, 0x4a,
-	0x14, 0xc7, 0x6d, 0x08, 0x10, 0x0e, 0xdc, 0x7b, 0xe7, 0x0e, 0x1f, 0x01, 0x0a, 0xd3, 0x12, 0xa0,
-	0x7c, 0xa8, 0x4d, 0x84, 0xba, 0xec, 0xca, 0x99, 0x4c, 0x60, 0x84, 0xb1, 0xa3, 0x99, 0x49, 0x68,
-	0xba, 0xc0, 0x4a, 0x2b, 0x0b, 0x21, 0x4a, 0x1d, 0x25, 0x01, 0x89, 0x5d, 0x1f, 0xa1, 0x8f, 0xd1,
-	0x77, 0xe8, 0x0b, 0x74, 0xc9, 0x12, 0xa9, 0x9b, 0x62, 0x36, 0x5d, 0xf2, 0x08, 0x55, 0x4c, 0xe2,
-	0x0f, 0x70, 0xba, 0xb3, 0xf4, 0xff, 0xf9, 0x7f, 0xce, 0xfc, 0xcf, 0x9c, 0x81, 0xcd, 0xae, 0x7b,
-	0xde, 0xf2, 0xda, 0xcd, 0x4f, 0xc5, 0x8e, 0xdb, 0xbe, 0x74, 0xdb, 0xc5, 0x66, 0xeb, 0xb4, 0xe8,
-	0x7e, 0xbe, 0x38, 0xef, 0x14, 0x2f, 0x77, 0x8b, 0xdd, 0x66, 0xe7, 0xac, 0xd0, 0x6a, 0x7b, 0x5d,
-	0x0f, 0x2f, 0x0f, 0xc0, 0xc2, 0x03, 0x58, 0x68, 0xb6, 0x4e, 0x0b, 0x01, 0x58, 0xb8, 0xdc, 0xdd,
-	0x39, 0x06, 0x50, 0xcd, 0xce, 0x99, 0xf4, 0x2e, 0xda, 0x1f, 0x5d, 0xfc, 0x0c, 0x72, 0xca, 0x90,
-	0x07, 0x8e, 0xb4, 0x6b, 0x82, 0x32, 0xa7, 0x66, 0xc9, 0x2a, 0xa3, 0xbc, 0xc2, 0x59, 0x19, 0x69,
-	0x38, 0x07, 0x33, 0x71, 0x71, 0x9f, 0x4b, 0x65, 0x8b, 0x06, 0xd2, 0xf1, 0x12, 0xcc, 0xc7, 0x85,
-	0x72, 0xc9, 0x29, 0x19, 0xf4, 0xc0, 0xb4, 0xf7, 0xd0, 0xc8, 0xce, 0x4f, 0x1d, 0xa6, 0x7b, 0x05,
-	0x68, 0xb3, 0xeb, 0x9e, 0x78, 0xed, 0x2b, 0xbc, 0x02, 0x8b, 0x01, 0x4c, 0x0d, 0xc5, 0xf6, 0x6c,
-	0xd1, 0x78, 0x54, 0x64, 0xe0, 0x15, 0xca, 0x4a, 0x18, 0x96, 0xac, 0x30, 0x81, 0xf4, 0xb0, 0x81,
-	0x48, 0xe3, 0x87, 0x4c, 0xa0, 0x91, 0xa7, 0x9e, 0x82, 0x55, 0x4d, 0x4e, 0x0d, 0xc5, 0x6d, 0x0b,
-	0x8d, 0xe2, 0x65, 0x58, 0x48, 0xca, 0x75, 0x2e, 0x79, 0x89, 0x9b, 0x5c, 0x35, 0x50, 0xe6, 0x69,
-	0x45, 0x43, 0xd0, 0x7d, 0x5e, 0x37, 0x4c, 0x34, 0x86, 0x09, 0x2c, 0x25, 0xb5, 0x43, 0x76, 0x18,
-	0x15, 0x1e, 0xdf, 0xf9, 0x3e, 0x01, 0xd9, 0xde, 0xe9, 0xd4, 0x55, 0xcb, 0xc5, 0x8b, 0x30, 0x17,
-	0xc0, 0xaa, 0x51, 0x7d, 0x1c, 0xdd, 0x2a, 0xac, 0x44, 0x52, 0xac, 0xb9, 0x58, 0x88, 0x9b, 0xb0,
-	0x96, 0x8e, 0xc8, 0x86, 0x45, 0x1d, 0x83, 0x2a, 0x5e, 0xef, 0xf5, 0x3b, 0x82, 0xd7, 0xe1, 0x45,
-	0x04, 0x0e, 0xd2, 0x71, 0x8e, 0x6c, 0x71, 0x50, 0x31, 0xed, 0x23, 0xa7, 0xa7, 0xa1, 0xd1, 0x21,
-	0xd4, 0xc0, 0xe6, 0x81, 0xca, 0xe0, 0x97, 0x90, 0x4f, 0xa1, 0xa8, 0x69, 0x4b, 0xe6, 0xb0, 0x77,
-	0x8c, 0xd6, 0x82, 0x04, 0xc7, 0x92, 0xcd, 0x45, 0x9c, 0x61, 0x51, 0x66, 0xc6, 0xc0, 0x71, 0xfc,
-	0x0a, 0xb6, 0x52, 0x40, 0xa9, 0x0c, 0xa1, 0x1c, 0xba, 0xcf, 0xcd, 0x72, 0x8c, 0x9e, 0x18, 0x62,
-	0x2b, 0xf9, 0x9e, 0x65, 0xc4, 0x6d, 0xb3, 0x78, 0x03, 0x56, 0x53, 0x40, 0xc1, 0x24, 0x53, 0xe1,
-	0xc9, 0x11, 0xe0, 0x35, 0x78, 0x1e, 0x61, 0x89, 0x44, 0x82, 0x89, 0xd9, 0x35, 0x85, 0xa6, 0xc3,
-	0x99, 0x06, 0x50, 0x14, 0x48, 0x5f, 0xff, 0x07, 0x2f, 0xc0, 0x6c, 0x6c, 0x8c, 0x92, 0x89, 0xfe,
-	0xb4, 0xff, 0xc5, 0x79, 0x20, 0x29, 0xf6, 0xa2, 0x66, 0x85, 0x7f, 0xff, 0x97, 0x64, 0xca, 0xcc,
-	0x64, 0x2a, 0xdc, 0x14, 0x87, 0xd5, 0x99, 0xa5, 0x10, 0x4a, 0x32, 0x61, 0x07, 0x82, 0xa9, 0xf0,
-	0x66, 0xfd, 0x9f, 0x9c, 0x5f, 0x58, 0xab, 0xb7, 0x57, 0x76, 0xa5, 0xd2, 0xa7, 0x30, 0xde, 0x82,
-	0xf5, 0x88, 0x8a, 0x6e, 0x75, 0x3f, 0xf0, 0x28, 0xc1, 0x19, 0xbc, 0x0d, 0x1b, 0xa9, 0x64, 0xad,
-	0x2a, 0x59, 0x02, 0x9d, 0x1d, 0x6a, 0xfa, 0xf8, 0x5a, 0xcc, 0x0d, 0x35, 0xed, 0x9f, 0x3b, 0x42,
-	0xe7, 0x87, 0x8c, 0xfa, 0x09, 0xb8, 0x80, 0x5f, 0xc3, 0xf6, 0x5f, 0xf6, 0x20, 0x4c, 0x42, 0x2a,
-	0x43, 0x31, 0xb4, 0x98, 0x6c, 0x76, 0xb0, 0xb9, 0xfd, 0x8f, 0xb8, 0xf1, 0x52, 0x3e, 0x93, 0x9d,
-	0x44, 0x93, 0xf9, 0x4c, 0x76, 0x0a, 0x4d, 0xe5, 0x33, 0xd9, 0x1c, 0xca, 0x95, 0x8e, 0xaf, 0x6f,
-	0x89, 0x76, 0x73, 0x4b, 0xb4, 0xfb, 0x5b, 0xa2, 0x7f, 0xf1, 0x89, 0xfe, 0xcd, 0x27, 0xfa, 0x0f,
-	0x9f, 0xe8, 0xd7, 0x3e, 0xd1, 0x7f, 0xf9, 0x44, 0xff, 0xed, 0x13, 0xed, 0xde, 0x27, 0xfa, 0xd7,
-	0x3b, 0xa2, 0x5d, 0xdf, 0x11, 0xed, 0xe6, 0x8e, 0x68, 0xef, 0xb7, 0x4e, 0xbc, 0x42, 0xf8, 0xa4,
-	0x9e, 0x7a, 0x69, 0xcf, 0xef, 0xdb, 0xe0, 0xe3, 0xc3, 0x78, 0xf0, 0x00, 0xbf, 0xf9, 0x13, 0x00,
-	0x00, 0xff, 0xff, 0x28, 0xe0, 0x87, 0x8e, 0xab, 0x05, 0x00, 0x00,
+	// 656 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcb, 0x4e, 0xdb, 0x40,
+	0x14, 0x86, 0x6d, 0x08, 0x10, 0x06, 0xda, 0x4e, 0x87, 0x4b, 0xb8, 0x4e, 0x4b, 0x80, 0x72, 0x51,
+	0x9b, 0x08, 0x75, 0xd9, 0xd5, 0x64, 0x32, 0x81, 0x11, 0xc6, 0x8e, 0x66, 0x26, 0xa1, 0xe9, 0x02,
+	0x2b, 0xad, 0x2c, 0x84, 0x28, 0x75, 0x94, 0x04, 0x24, 0x76, 0x7d, 0x84, 0x3e, 0x46, 0x1f, 0xa5,
+	0x4b, 0x96, 0x48, 0xdd, 0x14, 0x67, 0xd3, 0x25, 0x8f, 0x50, 0xc5, 0x38, 0xbe, 0x80, 0xd3, 0x9d,
+	0xa5, 0xff, 0xf3, 0x7f, 0xce, 0xfc, 0x67, 0xce, 0x80, 0xad, 0xae, 0x73, 0xd1, 0x72, 0xdb, 0xcd,
+	0xaf, 0xc5, 0x8e, 0xd3, 0xbe, 0x72, 0xda, 0xc5, 0x66, 0xeb, 0xac, 0xe8, 0x7c, 0xbb, 0xbc, 0xe8,
+	0x14, 0xaf, 0xf6, 0x8a, 0xdd, 0x66, 0xe7, 0xbc, 0xd0, 0x6a, 0xbb, 0x5d, 0x17, 0xad, 0x0c, 0xc0,
+	0xc2, 0x03, 0x58, 0x68, 0xb6, 0xce, 0x0a, 0x3e, 0x58, 0xb8, 0xda, 0xdb, 0x3d, 0x01, 0x40, 0x35,
+	0x3b, 0xe7, 0xd2, 0xbd, 0x6c, 0x7f, 0x71, 0xd0, 0x32, 0xc8, 0x29, 0x22, 0x0f, 0x6d, 0x69, 0xd5,
+	0x04, 0x65, 0x76, 0xcd, 0x94, 0x55, 0x46, 0x79, 0x85, 0xb3, 0x32, 0xd4, 0x50, 0x0e, 0xcc, 0xc4,
+	0xc5, 0x03, 0x2e, 0x95, 0x25, 0x1a, 0x50, 0x47, 0x4b, 0x60, 0x3e, 0x2e, 0x94, 0x4b, 0x76, 0x89,
+	0xd0, 0x43, 0xc3, 0xda, 0x87, 0x23, 0xbb, 0xbf, 0x75, 0x30, 0xdd, 0x2f, 0x40, 0x9b, 0x5d, 0xe7,
+	0xd4, 0x6d, 0x5f, 0xa3, 0x55, 0xb0, 0xe8, 0xc3, 0x94, 0x28, 0xb6, 0x6f, 0x89, 0xc6, 0xa3, 0x22,
+	0x03, 0xaf, 0x50, 0x56, 0x82, 0x98, 0xb2, 0xc2, 0x04, 0xd4, 0xc3, 0x06, 0x22, 0x8d, 0x1f, 0x31,
+	0x01, 0x47, 0x9e, 0x7a, 0x0a, 0x56, 0x35, 0x38, 0x25, 0x8a, 0x5b, 0x26, 0x1c, 0x45, 0x2b, 0x60,
+	0x21, 0x29, 0xd7, 0xb9, 0xe4, 0x25, 0x6e, 0x70, 0xd5, 0x80, 0x99, 0xa7, 0x15, 0x89, 0xa0, 0x07,
+	0xbc, 0x4e, 0x0c, 0x38, 0x86, 0x30, 0x58, 0x4a, 0x6a, 0x47, 0xec, 0x28, 0x2a, 0x3c, 0xbe, 0xdb,
+	0x9b, 0x00, 0xd9, 0xfe, 0xe9, 0xd4, 0x75, 0xcb, 0x41, 0x8b, 0x60, 0xce, 0x87, 0x55, 0xa3, 0xfa,
+	0x38, 0xba, 0x35, 0xb0, 0x1a, 0x49, 0xb1, 0xe6, 0x62, 0x21, 0x6e, 0x81, 0xf5, 0x74, 0x44, 0x36,
+	0x4c, 0x6a, 0x13, 0xaa, 0x78, 0xbd, 0xdf, 0xef, 0x08, 0xda, 0x00, 0xaf, 0x23, 0x70, 0x90, 0x8e,
+	0x7d, 0x6c, 0x89, 0xc3, 0x8a, 0x61, 0x1d, 0xdb, 0x7d, 0x0d, 0x8e, 0x0e, 0xa1, 0x06, 0x36, 0x0f,
+	0x54, 0x06, 0xbd, 0x01, 0xf9, 0x14, 0x8a, 0x1a, 0x96, 0x64, 0x36, 0xfb, 0xc8, 0x68, 0xcd, 0x4f,
+	0x70, 0x2c, 0xd9, 0x5c, 0xc4, 0x11, 0x93, 0x32, 0x23, 0x06, 0x8e, 0xa3, 0xb7, 0x60, 0x3b, 0x05,
+	0x94, 0x8a, 0x08, 0x65, 0xd3, 0x03, 0x6e, 0x94, 0x63, 0xf4, 0xc4, 0x10, 0x5b, 0xc9, 0xf7, 0x4d,
+	0x12, 0xb7, 0xcd, 0xa2, 0x4d, 0xb0, 0x96, 0x02, 0x0a, 0x26, 0x99, 0x0a, 0x4f, 0x0e, 0x01, 0x5a,
+	0x07, 0xaf, 0x22, 0x2c, 0x91, 0x88, 0x3f, 0x31, 0xab, 0xa6, 0xe0, 0x74, 0x38, 0x53, 0x1f, 0x8a,
+	0x02, 0x09, 0xf4, 0x67, 0x68, 0x01, 0xcc, 0xc6, 0xc6, 0x28, 0x99, 0x08, 0xa6, 0xfd, 0x1c, 0xe5,
+	0x01, 0x4e, 0xb1, 0x17, 0x35, 0x33, 0xfc, 0xfb, 0x45, 0x92, 0x29, 0x33, 0x83, 0xa9, 0x70, 0x53,
+	0x6c, 0x56, 0x67, 0xa6, 0x82, 0x30, 0xc9, 0x84, 0x1d, 0x08, 0xa6, 0xc2, 0x9b, 0xf5, 0x32, 0x39,
+	0xbf, 0xb0, 0x56, 0x7f, 0xaf, 0xac, 0x4a, 0x25, 0xa0, 0x10, 0xda, 0x06, 0x1b, 0x11, 0x15, 0xdd,
+	0xea, 0x20, 0xf0, 0x28, 0xc1, 0x19, 0xb4, 0x03, 0x36, 0x53, 0xc9, 0x5a, 0x55, 0xb2, 0x04, 0x3a,
+	0x3b, 0xd4, 0xf4, 0xf1, 0xb5, 0x98, 0x1b, 0x6a, 0x1a, 0x9c, 0x3b, 0x42, 0xe7, 0x87, 0x8c, 0xfa,
+	0x09, 0xb8, 0x80, 0xde, 0x81, 0x9d, 0xff, 0xec, 0x41, 0x98, 0x84, 0x54, 0x44, 0x31, 0xb8, 0x98,
+	0x6c, 0x76, 0xb0, 0xb9, 0xc1, 0x47, 0xdc, 0x78, 0x29, 0x79, 0xd7, 0x83, 0xc2, 0xb1, 0x9e, 0x05,
+	0xa3, 0x96, 0x28, 0xc3, 0xe5, 0x7c, 0x26, 0x3b, 0x09, 0x27, 0xf3, 0x99, 0xec, 0x14, 0x9c, 0xca,
+	0x67, 0xb2, 0x39, 0x98, 0x2b, 0x9d, 0xdc, 0xdc, 0x61, 0xed, 0xf6, 0x0e, 0x6b, 0xf7, 0x77, 0x58,
+	0xff, 0xee, 0x61, 0xfd, 0xa7, 0x87, 0xf5, 0x5f, 0x1e, 0xd6, 0x6f, 0x3c, 0xac, 0xff, 0xf1, 0xb0,
+	0xfe, 0xd7, 0xc3, 0xda, 0xbd, 0x87, 0xf5, 0x1f, 0x3d, 0xac, 0xdd, 0xf4, 0xb0, 0x76, 0xdb, 0xc3,
+	0xda, 0xa7, 0xed, 0x53, 0xb7, 0x10, 0x3e, 0xbd, 0x67, 0x6e, 0xda, 0x33, 0xfd, 0xc1, 0xff, 0xf8,
+	0x3c, 0xee, 0x3f, 0xd4, 0xef, 0xff, 0x0d, 0x00, 0x5f, 0x9e, 0x62, 0x56, 0xd3, 0x05, 0x00, 0x00,
 }
 
 func (x TaskSource) String() string {
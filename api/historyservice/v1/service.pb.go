@@ -54,89 +54,97 @@ func init() {
 }
 
 var fileDescriptor_655983da427ae822 = []byte{
-	// 1307 bytes of a gzipped FileDescriptorProto
+	// 1438 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x9a, 0xcd, 0x8b, 0x23, 0x45,
-	0x18, 0xc6, 0x53, 0x17, 0x91, 0x42, 0x57, 0x6d, 0xc5, 0x8f, 0x55, 0x1b, 0x3f, 0x50, 0x3c, 0x65,
-	0xdc, 0x5d, 0xd0, 0xfd, 0x98, 0x75, 0x77, 0x26, 0x33, 0x93, 0x99, 0xdd, 0x19, 0x9d, 0x49, 0x66,
-	0x47, 0xf0, 0x22, 0x95, 0xe4, 0x9d, 0x49, 0x31, 0x3d, 0xe9, 0xb6, 0xaa, 0x12, 0xcd, 0x41, 0x10,
-	0x3c, 0x09, 0x82, 0x1f, 0x20, 0x78, 0x12, 0x3c, 0x29, 0x82, 0x20, 0x08, 0x82, 0x20, 0x78, 0x12,
-	0x3c, 0x88, 0xcc, 0xcd, 0x3d, 0x3a, 0x99, 0x8b, 0xc7, 0xfd, 0x13, 0x24, 0xe9, 0x54, 0x4d, 0xaa,
-	0xbb, 0x3a, 0xa9, 0xea, 0xce, 0x6d, 0x37, 0xa9, 0xe7, 0xd7, 0x4f, 0x7d, 0xe4, 0xad, 0x67, 0xde,
-	0x04, 0x5f, 0x12, 0x70, 0x14, 0x85, 0x8c, 0x04, 0x0b, 0x1c, 0x58, 0x0f, 0xd8, 0x02, 0x89, 0xe8,
-	0x42, 0x9b, 0x72, 0x11, 0xb2, 0xfe, 0xf0, 0x15, 0xda, 0x84, 0x85, 0xde, 0x85, 0x85, 0xf1, 0x3f,
-	0xcb, 0x11, 0x0b, 0x45, 0xe8, 0xbd, 0x24, 0x45, 0xe5, 0x58, 0x54, 0x26, 0x11, 0x2d, 0xeb, 0xa2,
-	0x72, 0xef, 0xc2, 0xf9, 0x45, 0x3b, 0x36, 0x83, 0xf7, 0xba, 0xc0, 0xc5, 0xbb, 0x0c, 0x78, 0x14,
-	0x76, 0xf8, 0xf8, 0x21, 0x17, 0xbf, 0xb8, 0x89, 0xcf, 0xad, 0xc7, 0x83, 0xeb, 0xf1, 0x60, 0xef,
-	0x3b, 0x84, 0x1f, 0xaf, 0x0b, 0xc2, 0xc4, 0xdb, 0x21, 0x3b, 0xdc, 0x0f, 0xc2, 0xf7, 0x57, 0x3f,
-	0x80, 0x66, 0x57, 0xd0, 0xb0, 0xe3, 0xad, 0x94, 0xad, 0x3c, 0x95, 0xcd, 0xf2, 0x5a, 0x6c, 0xe1,
-	0xfc, 0x6a, 0x41, 0x4a, 0x3c, 0x81, 0x17, 0x4a, 0xde, 0x97, 0x08, 0x3f, 0x54, 0x05, 0xb1, 0xd5,
-	0x15, 0xa4, 0x11, 0x40, 0x5d, 0x10, 0x01, 0xde, 0x75, 0x4b, 0x78, 0x42, 0x27, 0xbd, 0xbd, 0x91,
-	0x57, 0xae, 0x4c, 0x7d, 0x85, 0xf0, 0xc3, 0xdb, 0x61, 0x10, 0x68, 0xae, 0x6c, 0xb1, 0x49, 0xa1,
-	0xb4, 0x75, 0x23, 0xb7, 0x5e, 0xf9, 0xfa, 0x16, 0xe1, 0xc7, 0x6a, 0xc0, 0x41, 0xd4, 0x05, 0x6d,
-	0x1e, 0xf6, 0x77, 0x09, 0x3f, 0xdc, 0xe9, 0x42, 0x17, 0xbc, 0x65, 0x4b, 0xb6, 0x49, 0x2c, 0xfd,
-	0x55, 0x0a, 0x31, 0x94, 0xc7, 0x9f, 0x10, 0x7e, 0xaa, 0x06, 0xcd, 0x90, 0xb5, 0xe4, 0xb6, 0x0f,
-	0x47, 0x8d, 0xce, 0x01, 0xb4, 0xbc, 0xaa, 0xf5, 0x43, 0x32, 0x08, 0xd2, 0xed, 0x7a, 0x71, 0x90,
-	0xc1, 0xf2, 0x52, 0x53, 0xd0, 0x1e, 0x15, 0xfd, 0xfc, 0x96, 0x0d, 0x84, 0x7c, 0x96, 0x8d, 0x20,
-	0x65, 0xf9, 0x57, 0x84, 0x9f, 0x89, 0xff, 0xab, 0xcd, 0xad, 0x12, 0x1e, 0x45, 0x01, 0x0c, 0x5d,
-	0xdf, 0xb2, 0xdf, 0xcd, 0x4c, 0x88, 0x34, 0x7e, 0x7b, 0x2e, 0xac, 0xc4, 0x72, 0xa7, 0x86, 0xae,
-	0x11, 0x1a, 0x38, 0x2d, 0x77, 0x06, 0xc1, 0x7d, 0xb9, 0x33, 0x41, 0xca, 0xf2, 0x2f, 0x08, 0x3f,
-	0x9d, 0xde, 0x96, 0x75, 0x20, 0x4c, 0x34, 0x80, 0x08, 0x6f, 0x23, 0xf7, 0xd6, 0x2a, 0x86, 0xb4,
-	0x7d, 0x6b, 0x1e, 0x28, 0xd3, 0x39, 0x99, 0x1c, 0x9a, 0xfb, 0x9c, 0x18, 0x21, 0x39, 0xcf, 0x49,
-	0x06, 0xcb, 0x74, 0x4e, 0x26, 0x87, 0xe6, 0x3b, 0x27, 0x69, 0x42, 0xce, 0x73, 0x62, 0x02, 0x25,
-	0xce, 0x49, 0x7a, 0x76, 0xa4, 0xd3, 0x84, 0xa1, 0xe9, 0x8d, 0x02, 0x2b, 0x34, 0x66, 0xb8, 0x9f,
-	0x93, 0x29, 0x28, 0x65, 0xfc, 0x07, 0x84, 0x9f, 0xa8, 0xd3, 0x83, 0x0e, 0x09, 0xd2, 0x89, 0xc1,
-	0xfa, 0xae, 0x37, 0xeb, 0xa5, 0xe1, 0xb5, 0xa2, 0x18, 0x65, 0xf6, 0x0f, 0x84, 0x9f, 0x1b, 0x8f,
-	0xa2, 0xa2, 0x9d, 0x91, 0x73, 0xde, 0x74, 0x7b, 0x5c, 0x26, 0x48, 0xda, 0x7f, 0x6b, 0x6e, 0x3c,
-	0x35, 0x8f, 0x1f, 0x11, 0x7e, 0xb2, 0x06, 0x47, 0x61, 0x0f, 0x62, 0x91, 0x16, 0x37, 0xd6, 0xac,
-	0xf7, 0xd7, 0x0c, 0x90, 0xbe, 0xab, 0x85, 0x39, 0xca, 0xef, 0xcf, 0x08, 0x9f, 0xdf, 0x05, 0x76,
-	0x44, 0x3b, 0x44, 0x40, 0x7a, 0xc5, 0x6d, 0x3f, 0x48, 0xd9, 0x08, 0xe9, 0x79, 0x63, 0x0e, 0x24,
-	0xed, 0x68, 0xaf, 0xc0, 0xb0, 0xb8, 0xe4, 0x3f, 0xda, 0x19, 0x7a, 0xd7, 0xa3, 0x9d, 0x89, 0x51,
-	0x66, 0x87, 0xc1, 0x7d, 0x14, 0xb0, 0xf2, 0x07, 0x77, 0xb3, 0xdc, 0x35, 0xb8, 0x67, 0x51, 0x94,
-	0xd3, 0xdf, 0x11, 0xf6, 0xc7, 0xd0, 0xb8, 0x9e, 0xa4, 0x1d, 0x6f, 0x5a, 0x3f, 0x6b, 0x1a, 0x46,
-	0x3a, 0xdf, 0x9a, 0x13, 0x4d, 0x4b, 0xd3, 0xf5, 0x66, 0x1b, 0x5a, 0xdd, 0x00, 0x26, 0x6f, 0x7f,
-	0xeb, 0x34, 0x6d, 0x12, 0xbb, 0xa6, 0x69, 0x33, 0x43, 0x2b, 0x75, 0x7b, 0xc0, 0xe8, 0x7e, 0x7f,
-	0x8d, 0x32, 0x2e, 0xb4, 0x1c, 0x3b, 0x56, 0xb6, 0xac, 0x4b, 0xdd, 0x2c, 0x90, 0x6b, 0xa9, 0x9b,
-	0xcd, 0x53, 0xf3, 0xf8, 0x0d, 0xe1, 0x67, 0xe3, 0xc4, 0x52, 0x69, 0xd3, 0xa0, 0xa5, 0xb6, 0xe3,
-	0x2c, 0x88, 0xdc, 0x76, 0xca, 0x3d, 0x19, 0x14, 0x39, 0x83, 0xcd, 0xf9, 0xc0, 0x94, 0xfd, 0x7f,
-	0x10, 0x7e, 0x39, 0x9e, 0xad, 0x71, 0xec, 0xe8, 0x5c, 0x0d, 0x49, 0xd0, 0xf2, 0x76, 0x9d, 0x16,
-	0x6f, 0x16, 0x4e, 0x4e, 0xe8, 0xce, 0x9c, 0xa9, 0x5a, 0xc8, 0x5a, 0x01, 0xde, 0x64, 0xb4, 0x61,
-	0xa8, 0x8f, 0x55, 0xeb, 0xc2, 0x96, 0x41, 0x70, 0x0d, 0x59, 0x53, 0x40, 0xca, 0xf2, 0xd7, 0x08,
-	0x3f, 0x52, 0x83, 0x28, 0xa0, 0x4d, 0x22, 0x60, 0xb5, 0x07, 0x1d, 0xc1, 0xf7, 0x2e, 0x7a, 0x37,
-	0xac, 0xb7, 0x3c, 0xa1, 0x94, 0x16, 0x6f, 0xe6, 0x07, 0x24, 0xca, 0xf7, 0xf8, 0x7d, 0x39, 0x87,
-	0xf8, 0x3e, 0x5f, 0x71, 0xc5, 0x6b, 0x72, 0xf7, 0xf2, 0x6d, 0xa6, 0x68, 0x7d, 0x97, 0x7a, 0xbf,
-	0xd3, 0xac, 0xb7, 0x09, 0x6b, 0x0d, 0xdf, 0xec, 0x72, 0xeb, 0xbe, 0x4b, 0x42, 0xe7, 0xda, 0x77,
-	0x49, 0xc9, 0x95, 0xa9, 0x4f, 0x10, 0x7e, 0x60, 0xf8, 0xae, 0x0c, 0xab, 0xde, 0x55, 0x07, 0xa4,
-	0x14, 0x49, 0x3b, 0xd7, 0x72, 0x69, 0xb5, 0xdb, 0x41, 0x9e, 0x46, 0x2d, 0x98, 0x2d, 0x3b, 0x1e,
-	0x65, 0x53, 0x28, 0xab, 0x14, 0x62, 0x28, 0x8f, 0xdf, 0x20, 0xfc, 0xa8, 0x1c, 0x32, 0xee, 0x00,
-	0xae, 0x87, 0x5c, 0x78, 0x4b, 0x8e, 0xf8, 0x09, 0xad, 0x74, 0xb8, 0x5c, 0x04, 0xa1, 0x0c, 0x7e,
-	0x8c, 0x30, 0xae, 0x04, 0x21, 0x87, 0xd1, 0x7e, 0x7b, 0x97, 0x2d, 0xa1, 0x67, 0x12, 0x69, 0xe7,
-	0x4a, 0x0e, 0xa5, 0x72, 0xf1, 0x21, 0xbe, 0xbf, 0x0a, 0x22, 0xb6, 0xf0, 0x9a, 0x7d, 0x73, 0x50,
-	0x33, 0xf0, 0xba, 0xb3, 0x4e, 0x5b, 0x84, 0x38, 0x5d, 0x8f, 0xd2, 0xc5, 0x65, 0xa7, 0x40, 0x3e,
-	0x99, 0x29, 0xae, 0xe4, 0x50, 0x6a, 0xa5, 0xa9, 0x0a, 0x42, 0x16, 0x06, 0x1a, 0x76, 0xb6, 0x80,
-	0x73, 0x72, 0x00, 0xdc, 0xba, 0x34, 0x99, 0xe5, 0xae, 0xa5, 0x29, 0x8b, 0xa2, 0x5d, 0x49, 0x55,
-	0x10, 0x2b, 0x9b, 0x3b, 0x26, 0xb3, 0x55, 0xfb, 0xc7, 0x98, 0x09, 0xae, 0x57, 0xd2, 0x14, 0x90,
-	0xb2, 0xfc, 0x29, 0xc2, 0x0f, 0xee, 0x74, 0x81, 0xf5, 0x65, 0xb9, 0xf5, 0x6c, 0xab, 0x8f, 0xa6,
-	0x92, 0xd6, 0x16, 0xf3, 0x89, 0x35, 0x3b, 0x35, 0x20, 0x51, 0x14, 0xf4, 0xe3, 0x4b, 0xca, 0xda,
-	0x8e, 0xa6, 0x72, 0xb5, 0x93, 0x10, 0x2b, 0x3b, 0x9f, 0x21, 0x7c, 0x2e, 0x5e, 0x45, 0xb5, 0x8b,
-	0x8b, 0x4e, 0x8b, 0x9f, 0xdc, 0xba, 0xeb, 0x39, 0xd5, 0x7a, 0x83, 0xbf, 0xcb, 0x0e, 0x60, 0xd2,
-	0x93, 0x75, 0x83, 0x3f, 0x21, 0x74, 0x6e, 0xf0, 0xa7, 0xf4, 0x9a, 0xaf, 0x2d, 0xc8, 0xe9, 0x2b,
-	0x29, 0x74, 0xf5, 0x95, 0xd6, 0x27, 0xbe, 0x78, 0xd8, 0x67, 0xc0, 0xdb, 0x93, 0x49, 0x9f, 0x3b,
-	0x7c, 0xf1, 0x90, 0x16, 0xbb, 0x7f, 0xf1, 0x60, 0x62, 0x28, 0x8f, 0x7f, 0x23, 0xfc, 0x62, 0x15,
-	0x3a, 0xc0, 0x88, 0x80, 0x4d, 0xc2, 0xc5, 0xf8, 0x46, 0x9a, 0xf8, 0xe0, 0xc6, 0x96, 0x77, 0xac,
-	0x0f, 0xcf, 0x4c, 0x96, 0x9c, 0x41, 0x6d, 0x9e, 0x48, 0x6d, 0xd1, 0xf5, 0x62, 0x39, 0xce, 0x69,
-	0xcb, 0xb9, 0x2a, 0xad, 0x1e, 0xd6, 0x2a, 0x85, 0x18, 0x5a, 0x02, 0xa9, 0x41, 0xa3, 0x4b, 0x83,
-	0x96, 0x16, 0x92, 0x96, 0xac, 0xf7, 0x34, 0xa5, 0x75, 0x4d, 0x20, 0x46, 0x84, 0xd6, 0xa6, 0xd0,
-	0xdb, 0x2e, 0x7b, 0x94, 0xd3, 0x06, 0x0d, 0x46, 0x69, 0x6f, 0xf8, 0xe7, 0x90, 0x75, 0x9b, 0x62,
-	0x3a, 0xc6, 0xb5, 0x4d, 0x31, 0x8b, 0xa6, 0xf5, 0xaf, 0xee, 0x44, 0x2d, 0x52, 0xa4, 0x7f, 0x95,
-	0xa1, 0x77, 0xed, 0x5f, 0x65, 0x62, 0xb4, 0x06, 0xf8, 0x76, 0x18, 0xa4, 0xfb, 0x2e, 0xb1, 0xd4,
-	0xba, 0x01, 0x3e, 0x85, 0xe1, 0xda, 0x00, 0x9f, 0x8a, 0x52, 0xc6, 0xff, 0x42, 0xf8, 0xf9, 0xba,
-	0x60, 0x40, 0x8e, 0xce, 0xee, 0xd3, 0x74, 0xf8, 0xb0, 0x6e, 0x02, 0xcf, 0x22, 0xc9, 0x49, 0x6c,
-	0xcf, 0x0f, 0x28, 0xa7, 0xf2, 0x0a, 0x7a, 0x15, 0x2d, 0x47, 0xc7, 0x27, 0x7e, 0xe9, 0xee, 0x89,
-	0x5f, 0xba, 0x77, 0xe2, 0xa3, 0x8f, 0x06, 0x3e, 0xfa, 0x7e, 0xe0, 0xa3, 0x3f, 0x07, 0x3e, 0x3a,
-	0x1e, 0xf8, 0xe8, 0xdf, 0x81, 0x8f, 0xfe, 0x1b, 0xf8, 0xa5, 0x7b, 0x03, 0x1f, 0x7d, 0x7e, 0xea,
-	0x97, 0x8e, 0x4f, 0xfd, 0xd2, 0xdd, 0x53, 0xbf, 0xf4, 0xce, 0xd5, 0x83, 0xf0, 0xcc, 0x0f, 0x0d,
-	0xa7, 0xfe, 0x1a, 0xe1, 0x9a, 0xfe, 0x4a, 0xe3, 0xbe, 0xd1, 0x8f, 0x11, 0x2e, 0xfd, 0x1f, 0x00,
-	0x00, 0xff, 0xff, 0xa1, 0x85, 0xab, 0xc0, 0x28, 0x21, 0x00, 0x00,
+	0x18, 0xc6, 0x53, 0x17, 0x91, 0x42, 0x57, 0x6d, 0xc5, 0x8f, 0x55, 0x1b, 0x3f, 0xf0, 0xe3, 0x94,
+	0x71, 0x77, 0x41, 0xf7, 0x63, 0xd6, 0x75, 0x26, 0xb3, 0x93, 0x99, 0xdd, 0x89, 0xee, 0x24, 0x3b,
+	0x23, 0x78, 0x91, 0x4a, 0xf2, 0xee, 0xa4, 0xd8, 0x4e, 0xba, 0xad, 0xaa, 0x64, 0xcd, 0x41, 0x10,
+	0x3c, 0x09, 0x82, 0x22, 0x08, 0x9e, 0x04, 0x4f, 0x8a, 0x20, 0x08, 0x82, 0x20, 0x08, 0x9e, 0x04,
+	0x0f, 0x22, 0x7b, 0x11, 0x17, 0x4f, 0x4e, 0xe6, 0xe2, 0x71, 0xff, 0x04, 0x49, 0x3a, 0x55, 0x93,
+	0x4a, 0x57, 0x77, 0xaa, 0xba, 0x73, 0x9b, 0x49, 0xea, 0xf9, 0xf5, 0x53, 0xf5, 0x56, 0xbf, 0xf5,
+	0xf6, 0xdb, 0xc1, 0x67, 0x04, 0x74, 0xa3, 0x90, 0x91, 0x60, 0x85, 0x03, 0x1b, 0x00, 0x5b, 0x21,
+	0x11, 0x5d, 0xe9, 0x50, 0x2e, 0x42, 0x36, 0x1c, 0x7f, 0x42, 0x5b, 0xb0, 0x32, 0x38, 0xb5, 0x32,
+	0xfd, 0xb3, 0x1c, 0xb1, 0x50, 0x84, 0xde, 0x0b, 0x52, 0x54, 0x8e, 0x45, 0x65, 0x12, 0xd1, 0xb2,
+	0x2e, 0x2a, 0x0f, 0x4e, 0x9d, 0x5c, 0xb5, 0x63, 0x33, 0x78, 0xaf, 0x0f, 0x5c, 0xbc, 0xcb, 0x80,
+	0x47, 0x61, 0x8f, 0x4f, 0x2f, 0x72, 0xfa, 0xaf, 0x2d, 0x7c, 0x62, 0x2b, 0x1e, 0xdc, 0x88, 0x07,
+	0x7b, 0xdf, 0x20, 0xfc, 0x68, 0x43, 0x10, 0x26, 0xde, 0x0e, 0xd9, 0xcd, 0x1b, 0x41, 0x78, 0xeb,
+	0xf2, 0xfb, 0xd0, 0xea, 0x0b, 0x1a, 0xf6, 0xbc, 0x8d, 0xb2, 0x95, 0xa7, 0xb2, 0x59, 0x5e, 0x8f,
+	0x2d, 0x9c, 0xbc, 0x5c, 0x90, 0x12, 0x4f, 0xe0, 0xb9, 0x92, 0xf7, 0x39, 0xc2, 0x0f, 0x54, 0x41,
+	0xd4, 0xfa, 0x82, 0x34, 0x03, 0x68, 0x08, 0x22, 0xc0, 0xbb, 0x68, 0x09, 0x9f, 0xd3, 0x49, 0x6f,
+	0xaf, 0xe7, 0x95, 0x2b, 0x53, 0x5f, 0x20, 0xfc, 0xe0, 0xb5, 0x30, 0x08, 0x34, 0x57, 0xb6, 0xd8,
+	0x79, 0xa1, 0xb4, 0x75, 0x29, 0xb7, 0x5e, 0xf9, 0xfa, 0x1a, 0xe1, 0x47, 0xea, 0xc0, 0x41, 0x34,
+	0x04, 0x6d, 0xdd, 0x1c, 0x5e, 0x27, 0xfc, 0xe6, 0x6e, 0x1f, 0xfa, 0xe0, 0xad, 0x5b, 0xb2, 0x4d,
+	0x62, 0xe9, 0xaf, 0x52, 0x88, 0xa1, 0x3c, 0xfe, 0x80, 0xf0, 0x13, 0x75, 0x68, 0x85, 0xac, 0x2d,
+	0xc3, 0x3e, 0x1e, 0x35, 0xd9, 0x07, 0xd0, 0xf6, 0xaa, 0xd6, 0x17, 0x49, 0x21, 0x48, 0xb7, 0x5b,
+	0xc5, 0x41, 0x06, 0xcb, 0x6b, 0x2d, 0x41, 0x07, 0x54, 0x0c, 0xf3, 0x5b, 0x36, 0x10, 0xf2, 0x59,
+	0x36, 0x82, 0x94, 0xe5, 0x9f, 0x11, 0x7e, 0x2a, 0xfe, 0x57, 0x9b, 0x5b, 0x25, 0xec, 0x46, 0x01,
+	0x8c, 0x5d, 0x5f, 0xb1, 0x8f, 0x66, 0x2a, 0x44, 0x1a, 0xbf, 0xba, 0x14, 0xd6, 0xdc, 0x72, 0x27,
+	0x86, 0x6e, 0x12, 0x1a, 0x38, 0x2d, 0x77, 0x0a, 0xc1, 0x7d, 0xb9, 0x53, 0x41, 0xca, 0xf2, 0x4f,
+	0x08, 0x3f, 0x99, 0x0c, 0xcb, 0x16, 0x10, 0x26, 0x9a, 0x40, 0x84, 0xb7, 0x9d, 0x3b, 0xb4, 0x8a,
+	0x21, 0x6d, 0x5f, 0x59, 0x06, 0xca, 0xb4, 0x4f, 0x66, 0x87, 0xe6, 0xde, 0x27, 0x46, 0x48, 0xce,
+	0x7d, 0x92, 0xc2, 0x32, 0xed, 0x93, 0xd9, 0xa1, 0xf9, 0xf6, 0x49, 0x92, 0x90, 0x73, 0x9f, 0x98,
+	0x40, 0x73, 0xfb, 0x24, 0x39, 0x3b, 0xd2, 0x6b, 0xc1, 0xd8, 0xf4, 0x76, 0x81, 0x15, 0x9a, 0x32,
+	0xdc, 0xf7, 0x49, 0x06, 0x4a, 0x19, 0xff, 0x0e, 0xe1, 0xc7, 0x1a, 0xf4, 0xa0, 0x47, 0x82, 0x64,
+	0xc5, 0x60, 0x7d, 0xd6, 0x9b, 0xf5, 0xd2, 0xf0, 0x66, 0x51, 0x8c, 0x32, 0xfb, 0x1b, 0xc2, 0xcf,
+	0x4c, 0x47, 0x51, 0xd1, 0x49, 0xa9, 0x73, 0xde, 0x74, 0xbb, 0x5c, 0x2a, 0x48, 0xda, 0x7f, 0x6b,
+	0x69, 0x3c, 0x35, 0x8f, 0xef, 0x11, 0x7e, 0xbc, 0x0e, 0xdd, 0x70, 0x00, 0xb1, 0x48, 0x2b, 0x37,
+	0x36, 0xad, 0xe3, 0x6b, 0x06, 0x48, 0xdf, 0xd5, 0xc2, 0x1c, 0xe5, 0xf7, 0x47, 0x84, 0x4f, 0x5e,
+	0x07, 0xd6, 0xa5, 0x3d, 0x22, 0x20, 0xb9, 0xe2, 0xb6, 0x37, 0x52, 0x3a, 0x42, 0x7a, 0xde, 0x5e,
+	0x02, 0x49, 0xdb, 0xda, 0x1b, 0x10, 0x80, 0x80, 0xfc, 0x5b, 0x3b, 0x45, 0xef, 0xba, 0xb5, 0x53,
+	0x31, 0xca, 0xec, 0xb8, 0x70, 0x9f, 0x14, 0x58, 0xf9, 0x0b, 0x77, 0xb3, 0xdc, 0xb5, 0x70, 0x4f,
+	0xa3, 0x28, 0xa7, 0xbf, 0x22, 0xec, 0x4f, 0xa1, 0x71, 0x3e, 0x49, 0x3a, 0xde, 0xb1, 0xbe, 0x56,
+	0x16, 0x46, 0x3a, 0xaf, 0x2d, 0x89, 0xa6, 0x55, 0xd3, 0x8d, 0x56, 0x07, 0xda, 0xfd, 0x00, 0x66,
+	0x4f, 0x7f, 0xeb, 0x6a, 0xda, 0x24, 0x76, 0xad, 0xa6, 0xcd, 0x0c, 0x2d, 0xd5, 0xed, 0x03, 0xa3,
+	0x37, 0x86, 0x9b, 0x94, 0x71, 0xa1, 0xd5, 0xb1, 0x53, 0x65, 0xdb, 0x3a, 0xd5, 0x2d, 0x02, 0xb9,
+	0xa6, 0xba, 0xc5, 0x3c, 0x35, 0x8f, 0x5f, 0x10, 0x7e, 0x3a, 0xae, 0x58, 0x2a, 0x1d, 0x1a, 0xb4,
+	0x55, 0x38, 0x8e, 0x0b, 0x91, 0xab, 0x4e, 0x75, 0x4f, 0x0a, 0x45, 0xce, 0x60, 0x67, 0x39, 0x30,
+	0x65, 0xff, 0x6f, 0x84, 0x5f, 0x8c, 0x67, 0x6b, 0x1c, 0x3b, 0xd9, 0x57, 0x63, 0x12, 0xb4, 0xbd,
+	0xeb, 0x4e, 0x8b, 0xb7, 0x08, 0x27, 0x27, 0xb4, 0xb7, 0x64, 0xaa, 0x56, 0x64, 0x6d, 0x00, 0x6f,
+	0x31, 0xda, 0x34, 0xe4, 0xc7, 0xaa, 0x75, 0x62, 0x4b, 0x21, 0xb8, 0x16, 0x59, 0x19, 0x20, 0x65,
+	0xf9, 0x4b, 0x84, 0x1f, 0xaa, 0x43, 0x14, 0xd0, 0x16, 0x11, 0x70, 0x79, 0x00, 0x3d, 0xc1, 0xf7,
+	0x4f, 0x7b, 0x97, 0xac, 0x43, 0x3e, 0xa7, 0x94, 0x16, 0xdf, 0xc8, 0x0f, 0x98, 0x4b, 0xdf, 0xd3,
+	0xef, 0xe5, 0x1c, 0xe2, 0xf3, 0x7c, 0xc3, 0x15, 0xaf, 0xc9, 0xdd, 0xd3, 0xb7, 0x99, 0xa2, 0xf5,
+	0x5d, 0x1a, 0xc3, 0x5e, 0xab, 0xd1, 0x21, 0xac, 0x3d, 0xfe, 0xb2, 0xcf, 0xad, 0xfb, 0x2e, 0x73,
+	0x3a, 0xd7, 0xbe, 0x4b, 0x42, 0xae, 0x4c, 0x7d, 0x8c, 0xf0, 0x7d, 0xe3, 0x6f, 0x65, 0xb1, 0xea,
+	0x9d, 0x77, 0x40, 0x4a, 0x91, 0xb4, 0x73, 0x21, 0x97, 0x56, 0x3b, 0x1d, 0xe4, 0x6e, 0xd4, 0x0a,
+	0xb3, 0x75, 0xc7, 0xad, 0x6c, 0x2a, 0xca, 0x2a, 0x85, 0x18, 0xca, 0xe3, 0x57, 0x08, 0x3f, 0x2c,
+	0x87, 0x4c, 0x3b, 0x80, 0x5b, 0x21, 0x17, 0xde, 0x9a, 0x23, 0x7e, 0x46, 0x2b, 0x1d, 0xae, 0x17,
+	0x41, 0x28, 0x83, 0x1f, 0x21, 0x8c, 0x2b, 0x41, 0xc8, 0x61, 0x12, 0x6f, 0xef, 0xac, 0x25, 0xf4,
+	0x58, 0x22, 0xed, 0x9c, 0xcb, 0xa1, 0x54, 0x2e, 0x3e, 0xc0, 0xf7, 0x56, 0x41, 0xc4, 0x16, 0x5e,
+	0xb5, 0x6f, 0x0e, 0x6a, 0x06, 0x5e, 0x73, 0xd6, 0x69, 0x8b, 0x10, 0x57, 0xd7, 0x93, 0xea, 0xe2,
+	0xac, 0x53, 0x41, 0x3e, 0x5b, 0x53, 0x9c, 0xcb, 0xa1, 0xd4, 0x52, 0x53, 0x15, 0x84, 0x4c, 0x0c,
+	0x34, 0xec, 0xd5, 0x80, 0x73, 0x72, 0x00, 0xdc, 0x3a, 0x35, 0x99, 0xe5, 0xae, 0xa9, 0x29, 0x8d,
+	0xa2, 0x1d, 0x49, 0x55, 0x10, 0x1b, 0x3b, 0xbb, 0x26, 0xb3, 0x55, 0xfb, 0xcb, 0x98, 0x09, 0xae,
+	0x47, 0x52, 0x06, 0x48, 0x59, 0xfe, 0x04, 0xe1, 0xfb, 0x77, 0xfb, 0xc0, 0x86, 0x32, 0xdd, 0x7a,
+	0xb6, 0xd9, 0x47, 0x53, 0x49, 0x6b, 0xab, 0xf9, 0xc4, 0x9a, 0x9d, 0x3a, 0x90, 0x28, 0x0a, 0x86,
+	0xf1, 0x21, 0x65, 0x6d, 0x47, 0x53, 0xb9, 0xda, 0x99, 0x13, 0x2b, 0x3b, 0x9f, 0x22, 0x7c, 0x22,
+	0x5e, 0x45, 0x15, 0xc5, 0x55, 0xa7, 0xc5, 0x9f, 0x0f, 0xdd, 0xc5, 0x9c, 0x6a, 0xbd, 0xc1, 0xdf,
+	0x67, 0x07, 0x30, 0xeb, 0xc9, 0xba, 0xc1, 0x3f, 0x27, 0x74, 0x6e, 0xf0, 0x27, 0xf4, 0x9a, 0xaf,
+	0x1a, 0xe4, 0xf4, 0x55, 0x83, 0x62, 0xbe, 0x6a, 0x90, 0xea, 0x2b, 0x7e, 0xf1, 0x70, 0x83, 0x01,
+	0xef, 0xcc, 0x56, 0xfa, 0xdc, 0xe1, 0xc5, 0x43, 0x52, 0xec, 0xfe, 0xe2, 0xc1, 0xc4, 0x50, 0x1e,
+	0xff, 0x44, 0xf8, 0xf9, 0x2a, 0xf4, 0x80, 0x11, 0x01, 0x3b, 0x84, 0x8b, 0xe9, 0x89, 0x34, 0x73,
+	0xe3, 0xc6, 0x96, 0x77, 0xad, 0x37, 0xcf, 0x42, 0x96, 0x9c, 0x41, 0x7d, 0x99, 0x48, 0x6d, 0xd1,
+	0xf5, 0x64, 0x39, 0xad, 0xd3, 0xd6, 0x73, 0x65, 0x5a, 0xbd, 0x58, 0xab, 0x14, 0x62, 0x68, 0x15,
+	0x48, 0x1d, 0x9a, 0x7d, 0x1a, 0xb4, 0xb5, 0x22, 0x69, 0xcd, 0x3a, 0xa6, 0x09, 0xad, 0x6b, 0x05,
+	0x62, 0x44, 0x68, 0x6d, 0x0a, 0xbd, 0xed, 0xb2, 0x4f, 0x39, 0x6d, 0xd2, 0x60, 0x52, 0xed, 0x8d,
+	0x1f, 0x87, 0xac, 0xdb, 0x14, 0xd9, 0x18, 0xd7, 0x36, 0xc5, 0x22, 0x9a, 0xd6, 0xbf, 0xda, 0x8b,
+	0xda, 0xa4, 0x48, 0xff, 0x2a, 0x45, 0xef, 0xda, 0xbf, 0x4a, 0xc5, 0x68, 0x0d, 0xf0, 0xf1, 0x0b,
+	0xcc, 0xc4, 0x98, 0x58, 0x6a, 0xdd, 0x00, 0xcf, 0x60, 0xb8, 0x36, 0xc0, 0x33, 0x51, 0xca, 0xf8,
+	0x1f, 0x08, 0x3f, 0xdb, 0x10, 0x0c, 0x48, 0xf7, 0xf8, 0x3c, 0x4d, 0x16, 0x1f, 0xd6, 0x4d, 0xe0,
+	0x45, 0x24, 0x39, 0x89, 0x6b, 0xcb, 0x03, 0xca, 0xa9, 0xbc, 0x8c, 0x5e, 0x41, 0x93, 0x38, 0xa4,
+	0x44, 0xab, 0x06, 0xdd, 0xd0, 0x3a, 0x0e, 0x19, 0x0c, 0xd7, 0x38, 0x64, 0xa2, 0x54, 0x1c, 0xfe,
+	0x41, 0xf8, 0xa5, 0xbd, 0x88, 0x83, 0xa1, 0x73, 0xde, 0x00, 0xc2, 0x5a, 0x9d, 0x35, 0x21, 0x18,
+	0x6d, 0xf6, 0x05, 0x70, 0x6f, 0xcf, 0xfa, 0xca, 0x56, 0x3c, 0x39, 0xa1, 0xfd, 0x65, 0x63, 0xb5,
+	0xbb, 0x43, 0x3e, 0x30, 0x1d, 0xdf, 0xf1, 0xdb, 0xbd, 0x03, 0xe0, 0x93, 0xdb, 0x79, 0xdb, 0xf1,
+	0xa1, 0xcb, 0xc0, 0x70, 0x8d, 0x4a, 0x26, 0x4a, 0xeb, 0xfc, 0xd7, 0xa1, 0x33, 0x6c, 0xb3, 0x42,
+	0x9d, 0xff, 0x74, 0x84, 0x6b, 0xe7, 0x3f, 0x8b, 0xa4, 0xbd, 0x5f, 0x59, 0xbb, 0x45, 0xa8, 0x30,
+	0xad, 0xb5, 0x6d, 0xce, 0x4b, 0x03, 0xb8, 0xbe, 0x5f, 0x49, 0xe7, 0x68, 0x65, 0xbb, 0x8c, 0x47,
+	0xfc, 0xb4, 0x7a, 0xc1, 0x31, 0x8a, 0xda, 0x23, 0xeb, 0x6a, 0x3e, 0xb1, 0xb4, 0xb3, 0x1e, 0xdd,
+	0x3e, 0xf4, 0x4b, 0x77, 0x0e, 0xfd, 0xd2, 0xdd, 0x43, 0x1f, 0x7d, 0x38, 0xf2, 0xd1, 0xb7, 0x23,
+	0x1f, 0xfd, 0x3e, 0xf2, 0xd1, 0xed, 0x91, 0x8f, 0xfe, 0x1d, 0xf9, 0xe8, 0xbf, 0x91, 0x5f, 0xba,
+	0x3b, 0xf2, 0xd1, 0x67, 0x47, 0x7e, 0xe9, 0xf6, 0x91, 0x5f, 0xba, 0x73, 0xe4, 0x97, 0xde, 0x39,
+	0x7f, 0x10, 0x1e, 0x5f, 0x97, 0x86, 0x99, 0xbf, 0x68, 0xba, 0xa0, 0x7f, 0xd2, 0xbc, 0x67, 0xf2,
+	0x83, 0xa6, 0x33, 0xff, 0x0f, 0x00, 0x66, 0x1f, 0x7e, 0xeb, 0x6c, 0x25, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -318,6 +326,26 @@ type HistoryServiceClient interface {
 	//     aip.dev/not-precedent: This service does not follow the update method API --)
 	PollWorkflowExecutionUpdate(ctx context.Context, in *PollWorkflowExecutionUpdateRequest, opts ...grpc.CallOption) (*PollWorkflowExecutionUpdateResponse, error)
 	StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (HistoryService_StreamWorkflowReplicationMessagesClient, error)
+	// UpdateWorkflowExecutionMemo upserts memo fields of a running or closed workflow and updates its visibility
+	// record. No history event is written and no workflow task is scheduled.
+	// (-- api-linter: core::0134=disabled
+	//     aip.dev/not-precedent: This service does not follow the update method API --)
+	UpdateWorkflowExecutionMemo(ctx context.Context, in *UpdateWorkflowExecutionMemoRequest, opts ...grpc.CallOption) (*UpdateWorkflowExecutionMemoResponse, error)
+	// UpsertWorkflowExecutionSearchAttributes upserts search attributes of a running or closed workflow and
+	// updates its visibility record. No history event is written and no workflow task is scheduled.
+	UpsertWorkflowExecutionSearchAttributes(ctx context.Context, in *UpsertWorkflowExecutionSearchAttributesRequest, opts ...grpc.CallOption) (*UpsertWorkflowExecutionSearchAttributesResponse, error)
+	// DescribeVisibilityIngestion returns the visibility queue lag of the shards owned by a history host,
+	// and the state of its Elasticsearch bulk processors.
+	DescribeVisibilityIngestion(ctx context.Context, in *DescribeVisibilityIngestionRequest, opts ...grpc.CallOption) (*DescribeVisibilityIngestionResponse, error)
+	// RehydrateWorkflowExecution reads the archived history of a closed workflow execution and imports it back
+	// into the persistence store.
+	RehydrateWorkflowExecution(ctx context.Context, in *RehydrateWorkflowExecutionRequest, opts ...grpc.CallOption) (*RehydrateWorkflowExecutionResponse, error)
+	// AwaitVisibilityIngestion waits until the visibility queue of a shard has processed all the tasks written
+	// to the shard before the request, or until the request deadline.
+	AwaitVisibilityIngestion(ctx context.Context, in *AwaitVisibilityIngestionRequest, opts ...grpc.CallOption) (*AwaitVisibilityIngestionResponse, error)
+	// DescribeShard returns the tasks the queues of a shard hold in memory and the state of its replication stream
+	// senders.
+	DescribeShard(ctx context.Context, in *DescribeShardRequest, opts ...grpc.CallOption) (*DescribeShardResponse, error)
 }
 
 type historyServiceClient struct {
@@ -782,6 +810,60 @@ func (x *historyServiceStreamWorkflowReplicationMessagesClient) Recv() (*StreamW
 	return m, nil
 }
 
+func (c *historyServiceClient) UpdateWorkflowExecutionMemo(ctx context.Context, in *UpdateWorkflowExecutionMemoRequest, opts ...grpc.CallOption) (*UpdateWorkflowExecutionMemoResponse, error) {
+	out := new(UpdateWorkflowExecutionMemoResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/UpdateWorkflowExecutionMemo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UpsertWorkflowExecutionSearchAttributes(ctx context.Context, in *UpsertWorkflowExecutionSearchAttributesRequest, opts ...grpc.CallOption) (*UpsertWorkflowExecutionSearchAttributesResponse, error) {
+	out := new(UpsertWorkflowExecutionSearchAttributesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/UpsertWorkflowExecutionSearchAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) DescribeVisibilityIngestion(ctx context.Context, in *DescribeVisibilityIngestionRequest, opts ...grpc.CallOption) (*DescribeVisibilityIngestionResponse, error) {
+	out := new(DescribeVisibilityIngestionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/DescribeVisibilityIngestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) RehydrateWorkflowExecution(ctx context.Context, in *RehydrateWorkflowExecutionRequest, opts ...grpc.CallOption) (*RehydrateWorkflowExecutionResponse, error) {
+	out := new(RehydrateWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/RehydrateWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) AwaitVisibilityIngestion(ctx context.Context, in *AwaitVisibilityIngestionRequest, opts ...grpc.CallOption) (*AwaitVisibilityIngestionResponse, error) {
+	out := new(AwaitVisibilityIngestionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/AwaitVisibilityIngestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) DescribeShard(ctx context.Context, in *DescribeShardRequest, opts ...grpc.CallOption) (*DescribeShardResponse, error) {
+	out := new(DescribeShardResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/DescribeShard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 type HistoryServiceServer interface {
 	// StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with
@@ -951,6 +1033,26 @@ type HistoryServiceServer interface {
 	//     aip.dev/not-precedent: This service does not follow the update method API --)
 	PollWorkflowExecutionUpdate(context.Context, *PollWorkflowExecutionUpdateRequest) (*PollWorkflowExecutionUpdateResponse, error)
 	StreamWorkflowReplicationMessages(HistoryService_StreamWorkflowReplicationMessagesServer) error
+	// UpdateWorkflowExecutionMemo upserts memo fields of a running or closed workflow and updates its visibility
+	// record. No history event is written and no workflow task is scheduled.
+	// (-- api-linter: core::0134=disabled
+	//     aip.dev/not-precedent: This service does not follow the update method API --)
+	UpdateWorkflowExecutionMemo(context.Context, *UpdateWorkflowExecutionMemoRequest) (*UpdateWorkflowExecutionMemoResponse, error)
+	// UpsertWorkflowExecutionSearchAttributes upserts search attributes of a running or closed workflow and
+	// updates its visibility record. No history event is written and no workflow task is scheduled.
+	UpsertWorkflowExecutionSearchAttributes(context.Context, *UpsertWorkflowExecutionSearchAttributesRequest) (*UpsertWorkflowExecutionSearchAttributesResponse, error)
+	// DescribeVisibilityIngestion returns the visibility queue lag of the shards owned by a history host,
+	// and the state of its Elasticsearch bulk processors.
+	DescribeVisibilityIngestion(context.Context, *DescribeVisibilityIngestionRequest) (*DescribeVisibilityIngestionResponse, error)
+	// RehydrateWorkflowExecution reads the archived history of a closed workflow execution and imports it back
+	// into the persistence store.
+	RehydrateWorkflowExecution(context.Context, *RehydrateWorkflowExecutionRequest) (*RehydrateWorkflowExecutionResponse, error)
+	// AwaitVisibilityIngestion waits until the visibility queue of a shard has processed all the tasks written
+	// to the shard before the request, or until the request deadline.
+	AwaitVisibilityIngestion(context.Context, *AwaitVisibilityIngestionRequest) (*AwaitVisibilityIngestionResponse, error)
+	// DescribeShard returns the tasks the queues of a shard hold in memory and the state of its replication stream
+	// senders.
+	DescribeShard(context.Context, *DescribeShardRequest) (*DescribeShardResponse, error)
 }
 
 // UnimplementedHistoryServiceServer can be embedded to have forward compatible implementations.
@@ -1101,6 +1203,24 @@ func (*UnimplementedHistoryServiceServer) PollWorkflowExecutionUpdate(ctx contex
 func (*UnimplementedHistoryServiceServer) StreamWorkflowReplicationMessages(srv HistoryService_StreamWorkflowReplicationMessagesServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamWorkflowReplicationMessages not implemented")
 }
+func (*UnimplementedHistoryServiceServer) UpdateWorkflowExecutionMemo(ctx context.Context, req *UpdateWorkflowExecutionMemoRequest) (*UpdateWorkflowExecutionMemoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkflowExecutionMemo not implemented")
+}
+func (*UnimplementedHistoryServiceServer) UpsertWorkflowExecutionSearchAttributes(ctx context.Context, req *UpsertWorkflowExecutionSearchAttributesRequest) (*UpsertWorkflowExecutionSearchAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertWorkflowExecutionSearchAttributes not implemented")
+}
+func (*UnimplementedHistoryServiceServer) DescribeVisibilityIngestion(ctx context.Context, req *DescribeVisibilityIngestionRequest) (*DescribeVisibilityIngestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeVisibilityIngestion not implemented")
+}
+func (*UnimplementedHistoryServiceServer) RehydrateWorkflowExecution(ctx context.Context, req *RehydrateWorkflowExecutionRequest) (*RehydrateWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RehydrateWorkflowExecution not implemented")
+}
+func (*UnimplementedHistoryServiceServer) AwaitVisibilityIngestion(ctx context.Context, req *AwaitVisibilityIngestionRequest) (*AwaitVisibilityIngestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AwaitVisibilityIngestion not implemented")
+}
+func (*UnimplementedHistoryServiceServer) DescribeShard(ctx context.Context, req *DescribeShardRequest) (*DescribeShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeShard not implemented")
+}
 
 func RegisterHistoryServiceServer(s *grpc.Server, srv HistoryServiceServer) {
 	s.RegisterService(&_HistoryService_serviceDesc, srv)
@@ -1978,6 +2098,114 @@ func (x *historyServiceStreamWorkflowReplicationMessagesServer) Recv() (*StreamW
 	return m, nil
 }
 
+func _HistoryService_UpdateWorkflowExecutionMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkflowExecutionMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UpdateWorkflowExecutionMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/UpdateWorkflowExecutionMemo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UpdateWorkflowExecutionMemo(ctx, req.(*UpdateWorkflowExecutionMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UpsertWorkflowExecutionSearchAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertWorkflowExecutionSearchAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UpsertWorkflowExecutionSearchAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/UpsertWorkflowExecutionSearchAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UpsertWorkflowExecutionSearchAttributes(ctx, req.(*UpsertWorkflowExecutionSearchAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_DescribeVisibilityIngestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeVisibilityIngestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DescribeVisibilityIngestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/DescribeVisibilityIngestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DescribeVisibilityIngestion(ctx, req.(*DescribeVisibilityIngestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_RehydrateWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RehydrateWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).RehydrateWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/RehydrateWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).RehydrateWorkflowExecution(ctx, req.(*RehydrateWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_AwaitVisibilityIngestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AwaitVisibilityIngestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).AwaitVisibilityIngestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/AwaitVisibilityIngestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).AwaitVisibilityIngestion(ctx, req.(*AwaitVisibilityIngestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_DescribeShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DescribeShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/DescribeShard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DescribeShard(ctx, req.(*DescribeShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _HistoryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.historyservice.v1.HistoryService",
 	HandlerType: (*HistoryServiceServer)(nil),
@@ -2170,6 +2398,30 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PollWorkflowExecutionUpdate",
 			Handler:    _HistoryService_PollWorkflowExecutionUpdate_Handler,
 		},
+		{
+			MethodName: "UpdateWorkflowExecutionMemo",
+			Handler:    _HistoryService_UpdateWorkflowExecutionMemo_Handler,
+		},
+		{
+			MethodName: "UpsertWorkflowExecutionSearchAttributes",
+			Handler:    _HistoryService_UpsertWorkflowExecutionSearchAttributes_Handler,
+		},
+		{
+			MethodName: "DescribeVisibilityIngestion",
+			Handler:    _HistoryService_DescribeVisibilityIngestion_Handler,
+		},
+		{
+			MethodName: "RehydrateWorkflowExecution",
+			Handler:    _HistoryService_RehydrateWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "AwaitVisibilityIngestion",
+			Handler:    _HistoryService_AwaitVisibilityIngestion_Handler,
+		},
+		{
+			MethodName: "DescribeShard",
+			Handler:    _HistoryService_DescribeShard_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
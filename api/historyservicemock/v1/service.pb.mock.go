@@ -61,6 +61,26 @@ func (m *MockHistoryServiceClient) EXPECT() *MockHistoryServiceClientMockRecorde
 	return m.recorder
 }
 
+// AwaitVisibilityIngestion mocks base method.
+func (m *MockHistoryServiceClient) AwaitVisibilityIngestion(ctx context.Context, in *historyservice.AwaitVisibilityIngestionRequest, opts ...grpc.CallOption) (*historyservice.AwaitVisibilityIngestionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AwaitVisibilityIngestion", varargs...)
+	ret0, _ := ret[0].(*historyservice.AwaitVisibilityIngestionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AwaitVisibilityIngestion indicates an expected call of AwaitVisibilityIngestion.
+func (mr *MockHistoryServiceClientMockRecorder) AwaitVisibilityIngestion(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AwaitVisibilityIngestion", reflect.TypeOf((*MockHistoryServiceClient)(nil).AwaitVisibilityIngestion), varargs...)
+}
+
 // CloseShard mocks base method.
 func (m *MockHistoryServiceClient) CloseShard(ctx context.Context, in *historyservice.CloseShardRequest, opts ...grpc.CallOption) (*historyservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -161,6 +181,46 @@ func (mr *MockHistoryServiceClientMockRecorder) DescribeMutableState(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeShard mocks base method.
+func (m *MockHistoryServiceClient) DescribeShard(ctx context.Context, in *historyservice.DescribeShardRequest, opts ...grpc.CallOption) (*historyservice.DescribeShardResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeShard", varargs...)
+	ret0, _ := ret[0].(*historyservice.DescribeShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeShard indicates an expected call of DescribeShard.
+func (mr *MockHistoryServiceClientMockRecorder) DescribeShard(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeShard", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeShard), varargs...)
+}
+
+// DescribeVisibilityIngestion mocks base method.
+func (m *MockHistoryServiceClient) DescribeVisibilityIngestion(ctx context.Context, in *historyservice.DescribeVisibilityIngestionRequest, opts ...grpc.CallOption) (*historyservice.DescribeVisibilityIngestionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeVisibilityIngestion", varargs...)
+	ret0, _ := ret[0].(*historyservice.DescribeVisibilityIngestionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVisibilityIngestion indicates an expected call of DescribeVisibilityIngestion.
+func (mr *MockHistoryServiceClientMockRecorder) DescribeVisibilityIngestion(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVisibilityIngestion", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeVisibilityIngestion), varargs...)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockHistoryServiceClient) DescribeWorkflowExecution(ctx context.Context, in *historyservice.DescribeWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -561,6 +621,26 @@ func (mr *MockHistoryServiceClientMockRecorder) RefreshWorkflowTasks(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockHistoryServiceClient)(nil).RefreshWorkflowTasks), varargs...)
 }
 
+// RehydrateWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) RehydrateWorkflowExecution(ctx context.Context, in *historyservice.RehydrateWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.RehydrateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RehydrateWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.RehydrateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RehydrateWorkflowExecution indicates an expected call of RehydrateWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) RehydrateWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RehydrateWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).RehydrateWorkflowExecution), varargs...)
+}
+
 // RemoveSignalMutableState mocks base method.
 func (m *MockHistoryServiceClient) RemoveSignalMutableState(ctx context.Context, in *historyservice.RemoveSignalMutableStateRequest, opts ...grpc.CallOption) (*historyservice.RemoveSignalMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -981,6 +1061,46 @@ func (mr *MockHistoryServiceClientMockRecorder) UpdateWorkflowExecution(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).UpdateWorkflowExecution), varargs...)
 }
 
+// UpdateWorkflowExecutionMemo mocks base method.
+func (m *MockHistoryServiceClient) UpdateWorkflowExecutionMemo(ctx context.Context, in *historyservice.UpdateWorkflowExecutionMemoRequest, opts ...grpc.CallOption) (*historyservice.UpdateWorkflowExecutionMemoResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecutionMemo", varargs...)
+	ret0, _ := ret[0].(*historyservice.UpdateWorkflowExecutionMemoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecutionMemo indicates an expected call of UpdateWorkflowExecutionMemo.
+func (mr *MockHistoryServiceClientMockRecorder) UpdateWorkflowExecutionMemo(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecutionMemo", reflect.TypeOf((*MockHistoryServiceClient)(nil).UpdateWorkflowExecutionMemo), varargs...)
+}
+
+// UpsertWorkflowExecutionSearchAttributes mocks base method.
+func (m *MockHistoryServiceClient) UpsertWorkflowExecutionSearchAttributes(ctx context.Context, in *historyservice.UpsertWorkflowExecutionSearchAttributesRequest, opts ...grpc.CallOption) (*historyservice.UpsertWorkflowExecutionSearchAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertWorkflowExecutionSearchAttributes", varargs...)
+	ret0, _ := ret[0].(*historyservice.UpsertWorkflowExecutionSearchAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertWorkflowExecutionSearchAttributes indicates an expected call of UpsertWorkflowExecutionSearchAttributes.
+func (mr *MockHistoryServiceClientMockRecorder) UpsertWorkflowExecutionSearchAttributes(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowExecutionSearchAttributes", reflect.TypeOf((*MockHistoryServiceClient)(nil).UpsertWorkflowExecutionSearchAttributes), varargs...)
+}
+
 // VerifyChildExecutionCompletionRecorded mocks base method.
 func (m *MockHistoryServiceClient) VerifyChildExecutionCompletionRecorded(ctx context.Context, in *historyservice.VerifyChildExecutionCompletionRecordedRequest, opts ...grpc.CallOption) (*historyservice.VerifyChildExecutionCompletionRecordedResponse, error) {
 	m.ctrl.T.Helper()
@@ -1181,6 +1301,21 @@ func (m *MockHistoryServiceServer) EXPECT() *MockHistoryServiceServerMockRecorde
 	return m.recorder
 }
 
+// AwaitVisibilityIngestion mocks base method.
+func (m *MockHistoryServiceServer) AwaitVisibilityIngestion(arg0 context.Context, arg1 *historyservice.AwaitVisibilityIngestionRequest) (*historyservice.AwaitVisibilityIngestionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AwaitVisibilityIngestion", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.AwaitVisibilityIngestionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AwaitVisibilityIngestion indicates an expected call of AwaitVisibilityIngestion.
+func (mr *MockHistoryServiceServerMockRecorder) AwaitVisibilityIngestion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AwaitVisibilityIngestion", reflect.TypeOf((*MockHistoryServiceServer)(nil).AwaitVisibilityIngestion), arg0, arg1)
+}
+
 // CloseShard mocks base method.
 func (m *MockHistoryServiceServer) CloseShard(arg0 context.Context, arg1 *historyservice.CloseShardRequest) (*historyservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -1256,6 +1391,36 @@ func (mr *MockHistoryServiceServerMockRecorder) DescribeMutableState(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeShard mocks base method.
+func (m *MockHistoryServiceServer) DescribeShard(arg0 context.Context, arg1 *historyservice.DescribeShardRequest) (*historyservice.DescribeShardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeShard", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DescribeShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeShard indicates an expected call of DescribeShard.
+func (mr *MockHistoryServiceServerMockRecorder) DescribeShard(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeShard", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeShard), arg0, arg1)
+}
+
+// DescribeVisibilityIngestion mocks base method.
+func (m *MockHistoryServiceServer) DescribeVisibilityIngestion(arg0 context.Context, arg1 *historyservice.DescribeVisibilityIngestionRequest) (*historyservice.DescribeVisibilityIngestionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVisibilityIngestion", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DescribeVisibilityIngestionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVisibilityIngestion indicates an expected call of DescribeVisibilityIngestion.
+func (mr *MockHistoryServiceServerMockRecorder) DescribeVisibilityIngestion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVisibilityIngestion", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeVisibilityIngestion), arg0, arg1)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockHistoryServiceServer) DescribeWorkflowExecution(arg0 context.Context, arg1 *historyservice.DescribeWorkflowExecutionRequest) (*historyservice.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1556,6 +1721,21 @@ func (mr *MockHistoryServiceServerMockRecorder) RefreshWorkflowTasks(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockHistoryServiceServer)(nil).RefreshWorkflowTasks), arg0, arg1)
 }
 
+// RehydrateWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) RehydrateWorkflowExecution(arg0 context.Context, arg1 *historyservice.RehydrateWorkflowExecutionRequest) (*historyservice.RehydrateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RehydrateWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.RehydrateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RehydrateWorkflowExecution indicates an expected call of RehydrateWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) RehydrateWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RehydrateWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).RehydrateWorkflowExecution), arg0, arg1)
+}
+
 // RemoveSignalMutableState mocks base method.
 func (m *MockHistoryServiceServer) RemoveSignalMutableState(arg0 context.Context, arg1 *historyservice.RemoveSignalMutableStateRequest) (*historyservice.RemoveSignalMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -1870,6 +2050,36 @@ func (mr *MockHistoryServiceServerMockRecorder) UpdateWorkflowExecution(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).UpdateWorkflowExecution), arg0, arg1)
 }
 
+// UpdateWorkflowExecutionMemo mocks base method.
+func (m *MockHistoryServiceServer) UpdateWorkflowExecutionMemo(arg0 context.Context, arg1 *historyservice.UpdateWorkflowExecutionMemoRequest) (*historyservice.UpdateWorkflowExecutionMemoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecutionMemo", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.UpdateWorkflowExecutionMemoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecutionMemo indicates an expected call of UpdateWorkflowExecutionMemo.
+func (mr *MockHistoryServiceServerMockRecorder) UpdateWorkflowExecutionMemo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecutionMemo", reflect.TypeOf((*MockHistoryServiceServer)(nil).UpdateWorkflowExecutionMemo), arg0, arg1)
+}
+
+// UpsertWorkflowExecutionSearchAttributes mocks base method.
+func (m *MockHistoryServiceServer) UpsertWorkflowExecutionSearchAttributes(arg0 context.Context, arg1 *historyservice.UpsertWorkflowExecutionSearchAttributesRequest) (*historyservice.UpsertWorkflowExecutionSearchAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWorkflowExecutionSearchAttributes", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.UpsertWorkflowExecutionSearchAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertWorkflowExecutionSearchAttributes indicates an expected call of UpsertWorkflowExecutionSearchAttributes.
+func (mr *MockHistoryServiceServerMockRecorder) UpsertWorkflowExecutionSearchAttributes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowExecutionSearchAttributes", reflect.TypeOf((*MockHistoryServiceServer)(nil).UpsertWorkflowExecutionSearchAttributes), arg0, arg1)
+}
+
 // VerifyChildExecutionCompletionRecorded mocks base method.
 func (m *MockHistoryServiceServer) VerifyChildExecutionCompletionRecorded(arg0 context.Context, arg1 *historyservice.VerifyChildExecutionCompletionRecordedRequest) (*historyservice.VerifyChildExecutionCompletionRecordedResponse, error) {
 	m.ctrl.T.Helper()
@@ -553,6 +553,9 @@ type AddWorkflowTaskRequest struct {
 	// How this task should be directed by matching. (Missing means the default
 	// for TaskVersionDirective, which is unversioned.)
 	VersionDirective *v18.TaskVersionDirective `protobuf:"bytes,10,opt,name=version_directive,json=versionDirective,proto3" json:"version_directive,omitempty"`
+	// Priority tasks are dispatched before the backlog of the task queue, eg. the first workflow task of a
+	// new execution, or a workflow task delivering updates.
+	Priority bool `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
 func (m *AddWorkflowTaskRequest) Reset()      { *m = AddWorkflowTaskRequest{} }
@@ -650,6 +653,13 @@ func (m *AddWorkflowTaskRequest) GetVersionDirective() *v18.TaskVersionDirective
 	return nil
 }
 
+func (m *AddWorkflowTaskRequest) GetPriority() bool {
+	if m != nil {
+		return m.Priority
+	}
+	return false
+}
+
 type AddWorkflowTaskResponse struct {
 }
 
@@ -2412,159 +2422,159 @@ func init() {
 }
 
 var fileDescriptor_a429a3813476c583 = []byte{
-	// 2418 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcf, 0x73, 0x1b, 0x49,
-	0xf5, 0xf7, 0x58, 0xb2, 0x2d, 0x3d, 0xc9, 0xb6, 0x3c, 0xdf, 0x6c, 0x22, 0x3b, 0x8e, 0x6c, 0xcf,
-	0x66, 0x13, 0x27, 0x95, 0x95, 0xbf, 0x31, 0x24, 0xb5, 0xbb, 0x90, 0x5d, 0x1c, 0xc7, 0x1b, 0x7b,
-	0x37, 0x59, 0x9c, 0x89, 0x13, 0xa8, 0x2c, 0xc5, 0x6c, 0x6b, 0xa6, 0x23, 0x0f, 0x1e, 0xcd, 0x4c,
-	0xa6, 0x7b, 0xa4, 0x98, 0x13, 0x67, 0xb8, 0x2c, 0x45, 0x15, 0x05, 0xc5, 0x9d, 0x02, 0xaa, 0x38,
-	0xc1, 0x85, 0x3f, 0x80, 0x2a, 0x0e, 0x1c, 0x72, 0xdc, 0x1b, 0xc4, 0xa9, 0xa2, 0x28, 0xe0, 0xb0,
-	0xfc, 0x03, 0x14, 0xd5, 0x3f, 0x66, 0x46, 0x3f, 0x46, 0x96, 0xec, 0x38, 0x2c, 0xc5, 0xcd, 0x7a,
-	0xfd, 0xde, 0xeb, 0xf7, 0x5e, 0x7f, 0xde, 0xe7, 0x75, 0x4b, 0x86, 0x1b, 0x14, 0x37, 0x7c, 0x2f,
-	0x40, 0xce, 0x0a, 0xc1, 0x41, 0x13, 0x07, 0x2b, 0xc8, 0xb7, 0x57, 0x1a, 0x88, 0x9a, 0xbb, 0xb6,
-	0x5b, 0x67, 0x22, 0xdb, 0xc4, 0x2b, 0xcd, 0xab, 0x2b, 0x01, 0x7e, 0x12, 0x62, 0x42, 0x8d, 0x00,
-	0x13, 0xdf, 0x73, 0x09, 0xae, 0xfa, 0x81, 0x47, 0x3d, 0xf5, 0x42, 0x64, 0x5e, 0x15, 0xe6, 0x55,
-	0xe4, 0xdb, 0xd5, 0x2e, 0xf3, 0x6a, 0xf3, 0xea, 0x5c, 0xa5, 0xee, 0x79, 0x75, 0x07, 0xaf, 0x70,
-	0xab, 0x5a, 0xf8, 0x78, 0xc5, 0x0a, 0x03, 0x44, 0x6d, 0xcf, 0x15, 0x7e, 0xe6, 0x16, 0xba, 0xd7,
-	0xa9, 0xdd, 0xc0, 0x84, 0xa2, 0x86, 0x2f, 0x15, 0x96, 0x2c, 0xec, 0x63, 0xd7, 0xc2, 0xae, 0x69,
-	0x63, 0xb2, 0x52, 0xf7, 0xea, 0x1e, 0x97, 0xf3, 0xbf, 0xa4, 0xca, 0xf9, 0x38, 0x15, 0x96, 0x83,
-	0xe9, 0x35, 0x1a, 0x9e, 0xcb, 0x42, 0x6f, 0x60, 0x42, 0x50, 0x5d, 0x46, 0x3c, 0x77, 0xa1, 0x43,
-	0x0b, 0xbb, 0x61, 0x83, 0x30, 0x25, 0x8a, 0xc8, 0x9e, 0xf1, 0x24, 0xc4, 0x61, 0xa4, 0x77, 0xb1,
-	0x43, 0x8f, 0x2d, 0xf3, 0xd5, 0x5e, 0x87, 0xaf, 0x77, 0x28, 0x3e, 0x09, 0x71, 0xb0, 0x3f, 0x68,
-	0x57, 0x2e, 0x33, 0x3d, 0xa7, 0x57, 0xef, 0x72, 0xda, 0x71, 0x98, 0x8e, 0x67, 0xee, 0xf5, 0xea,
-	0x5e, 0x4c, 0xd3, 0xed, 0x48, 0x48, 0x2a, 0x5e, 0x49, 0x53, 0xdc, 0xb5, 0x09, 0xf5, 0xd2, 0x42,
-	0xfd, 0x72, 0x9a, 0xb6, 0x8f, 0x03, 0x62, 0x13, 0x8a, 0x5d, 0x81, 0x86, 0xa4, 0x5a, 0x44, 0x5a,
-	0x55, 0xd3, 0xac, 0x0e, 0xa9, 0xda, 0xf5, 0x8e, 0x82, 0xb4, 0xbc, 0x60, 0xef, 0xb1, 0xe3, 0xb5,
-	0x06, 0x02, 0x4e, 0xfb, 0xbb, 0x02, 0xf3, 0xdb, 0x9e, 0xe3, 0x7c, 0x43, 0x5a, 0xec, 0x20, 0xb2,
-	0x77, 0x8f, 0x6d, 0xa1, 0x0b, 0x7d, 0x75, 0x09, 0x8a, 0x2e, 0x6a, 0x60, 0xe2, 0x23, 0x13, 0x1b,
-	0xb6, 0x55, 0x56, 0x16, 0x95, 0xe5, 0xbc, 0x5e, 0x88, 0x65, 0x5b, 0x96, 0x7a, 0x16, 0xf2, 0xbe,
-	0xe7, 0x38, 0x38, 0x60, 0xeb, 0xa3, 0x7c, 0x3d, 0x27, 0x04, 0x5b, 0x96, 0xfa, 0x09, 0x14, 0xd9,
-	0xdf, 0x86, 0xdc, 0xbf, 0x9c, 0x59, 0x54, 0x96, 0x0b, 0xab, 0x37, 0xe2, 0xfc, 0x38, 0xc2, 0xbb,
-	0xe2, 0xad, 0x36, 0xaf, 0x56, 0x0f, 0x0b, 0x4a, 0x2f, 0x30, 0x97, 0x51, 0x84, 0x97, 0xa0, 0xf4,
-	0xd8, 0x0b, 0x5a, 0x28, 0xb0, 0xb0, 0x65, 0x10, 0x2f, 0x0c, 0x4c, 0x5c, 0xce, 0xf2, 0x28, 0xa6,
-	0x63, 0xf9, 0x7d, 0x2e, 0xd6, 0xfe, 0x98, 0x87, 0x73, 0x7d, 0x1c, 0x8b, 0xaa, 0xa8, 0xe7, 0x00,
-	0xf8, 0x61, 0x50, 0x6f, 0x0f, 0xbb, 0x3c, 0xd9, 0xa2, 0x9e, 0x67, 0x92, 0x1d, 0x26, 0x50, 0xbf,
-	0x09, 0x6a, 0x14, 0xab, 0x81, 0x9f, 0x62, 0x33, 0x64, 0x3d, 0xc7, 0x73, 0x2e, 0xac, 0x5e, 0xea,
-	0xcc, 0x49, 0x34, 0x0c, 0x4b, 0x25, 0xda, 0x6d, 0x23, 0x32, 0xd0, 0x67, 0x5a, 0xdd, 0x22, 0x75,
-	0x0b, 0x26, 0x63, 0xcf, 0x74, 0xdf, 0xc7, 0xb2, 0x50, 0xe7, 0x07, 0x39, 0xdd, 0xd9, 0xf7, 0xb1,
-	0x5e, 0x6c, 0xb5, 0x7d, 0x52, 0xdf, 0x86, 0x59, 0x3f, 0xc0, 0x4d, 0xdb, 0x0b, 0x89, 0x41, 0x28,
-	0x0a, 0x28, 0xb6, 0x0c, 0xdc, 0xc4, 0x2e, 0x65, 0xe7, 0xc3, 0x2a, 0x93, 0xd1, 0x4f, 0x47, 0x0a,
-	0xf7, 0xc5, 0xfa, 0x06, 0x5b, 0xde, 0xb2, 0xd4, 0x65, 0x28, 0xf5, 0x58, 0x8c, 0x71, 0x8b, 0x29,
-	0xd2, 0xa9, 0x59, 0x86, 0x09, 0x44, 0x59, 0x6c, 0xb4, 0x3c, 0xbe, 0xa8, 0x2c, 0x8f, 0xe9, 0xd1,
-	0x47, 0x55, 0x83, 0x49, 0x17, 0x3f, 0xa5, 0x89, 0x83, 0x09, 0xee, 0xa0, 0xc0, 0x84, 0x91, 0xf5,
-	0x15, 0x50, 0x6b, 0xc8, 0xdc, 0x73, 0xbc, 0xba, 0x61, 0x7a, 0xa1, 0x4b, 0x8d, 0x5d, 0xdb, 0xa5,
-	0xe5, 0x1c, 0x57, 0x2c, 0xc9, 0x95, 0x75, 0xb6, 0xb0, 0x69, 0xbb, 0x54, 0x7d, 0x0b, 0xca, 0x84,
-	0xda, 0xe6, 0xde, 0x7e, 0x52, 0x73, 0x03, 0xbb, 0xa8, 0xe6, 0x60, 0xab, 0x9c, 0x5f, 0x54, 0x96,
-	0x73, 0xfa, 0x69, 0xb1, 0x1e, 0x97, 0x73, 0x43, 0xac, 0xaa, 0xef, 0xc0, 0x18, 0x67, 0x90, 0x32,
-	0xa4, 0x55, 0x93, 0x2f, 0xb5, 0x17, 0xf3, 0x1e, 0x13, 0xe8, 0xc2, 0x44, 0x7d, 0x02, 0x67, 0x68,
-	0x80, 0x5c, 0x62, 0xb3, 0x34, 0x92, 0xb3, 0x41, 0x64, 0xaf, 0x5c, 0xe0, 0xde, 0xde, 0xae, 0xa6,
-	0xb1, 0xb5, 0x24, 0x02, 0xe6, 0x76, 0x27, 0x32, 0x6f, 0xc7, 0xdb, 0x96, 0xfb, 0xd8, 0xd3, 0x5f,
-	0xa3, 0x69, 0x4b, 0x6a, 0x1d, 0xce, 0xf5, 0xc2, 0xcb, 0x48, 0xd8, 0xa1, 0x5c, 0x4c, 0x4b, 0x23,
-	0xa6, 0x05, 0xbe, 0x67, 0x0c, 0xe9, 0xb9, 0x1e, 0x90, 0xc5, 0x6b, 0xac, 0xab, 0x6b, 0x01, 0x72,
-	0xcd, 0x5d, 0x09, 0xf4, 0x29, 0x0e, 0xf4, 0x82, 0x90, 0x09, 0xa8, 0xdf, 0x86, 0x29, 0x62, 0xee,
-	0x62, 0x2b, 0x74, 0xb0, 0x65, 0xb0, 0xf1, 0x51, 0x9e, 0xe6, 0x9b, 0xcf, 0x55, 0xc5, 0x6c, 0xa9,
-	0x46, 0xb3, 0xa5, 0xba, 0x13, 0xcd, 0x96, 0x9b, 0xd9, 0x4f, 0xff, 0xb4, 0xa0, 0xe8, 0x93, 0xb1,
-	0x1d, 0x5b, 0x51, 0xd7, 0xa1, 0x18, 0x61, 0x8a, 0xbb, 0x29, 0x0d, 0xe9, 0xa6, 0x20, 0xad, 0xb8,
-	0x13, 0x07, 0x26, 0xd8, 0xa9, 0xd8, 0x98, 0x94, 0x67, 0x16, 0x33, 0xcb, 0x85, 0x55, 0xbd, 0x3a,
-	0xdc, 0xa8, 0xac, 0x1e, 0xda, 0xef, 0xd5, 0x7b, 0xc2, 0xe9, 0x86, 0x4b, 0x83, 0x7d, 0x3d, 0xda,
-	0x42, 0xbd, 0x01, 0x39, 0x49, 0xaf, 0xa4, 0xac, 0xf2, 0xed, 0x96, 0x3a, 0x4b, 0x1e, 0x4d, 0x1c,
-	0xb6, 0xc1, 0x5d, 0xa1, 0xa9, 0xc7, 0x26, 0x73, 0x9f, 0x40, 0xb1, 0xdd, 0xaf, 0x5a, 0x82, 0xcc,
-	0x1e, 0xde, 0x97, 0xd4, 0xc9, 0xfe, 0x64, 0xb8, 0x6c, 0x22, 0x27, 0xc4, 0x92, 0x3a, 0x86, 0xc4,
-	0x25, 0x37, 0x79, 0x67, 0xf4, 0x2d, 0xe5, 0x83, 0x6c, 0x6e, 0xb2, 0x34, 0x15, 0x93, 0xf7, 0x9a,
-	0x49, 0xed, 0xa6, 0x4d, 0xf7, 0xff, 0xab, 0xc8, 0xbb, 0x5f, 0x50, 0xc7, 0x27, 0xef, 0x9c, 0x20,
-	0xef, 0x14, 0xc7, 0x5f, 0x34, 0x79, 0x2f, 0x40, 0x01, 0xc9, 0xa8, 0x58, 0x19, 0x33, 0x3c, 0x01,
-	0x88, 0x44, 0x5b, 0x16, 0x63, 0xf7, 0x58, 0x81, 0xb3, 0x7b, 0xf6, 0x70, 0x76, 0x8f, 0x73, 0xe4,
-	0xec, 0x8e, 0xda, 0x3e, 0xa9, 0xd7, 0x61, 0xcc, 0x76, 0xfd, 0x90, 0x72, 0x5e, 0x2e, 0xac, 0x2e,
-	0xf6, 0x73, 0xb1, 0x8d, 0xf6, 0x1d, 0x0f, 0x59, 0x44, 0x17, 0xea, 0x29, 0xfd, 0x3c, 0x7e, 0xbc,
-	0x7e, 0x7e, 0x04, 0xb3, 0x91, 0xc0, 0xa0, 0x9e, 0x61, 0x3a, 0x1e, 0xc1, 0xdc, 0xa1, 0x17, 0x52,
-	0xce, 0xf5, 0x85, 0xd5, 0xd9, 0x1e, 0x9f, 0xb7, 0xe4, 0xfd, 0xf4, 0x66, 0xf6, 0x27, 0xcc, 0xe5,
-	0xe9, 0xc8, 0xc3, 0x8e, 0xb7, 0xce, 0xec, 0x77, 0x84, 0x79, 0x0f, 0x57, 0xe4, 0x8e, 0xc3, 0x15,
-	0x3b, 0x70, 0x9a, 0x7f, 0xec, 0x8d, 0x2e, 0x3f, 0x5c, 0x74, 0xff, 0xc7, 0xcd, 0xbb, 0x42, 0xbb,
-	0x03, 0x33, 0xbb, 0x18, 0x05, 0xb4, 0x86, 0x11, 0x8d, 0x1d, 0xc2, 0x70, 0x0e, 0x4b, 0xb1, 0x65,
-	0xe4, 0xad, 0x6d, 0x7c, 0x16, 0x3a, 0xc7, 0x27, 0x86, 0x8a, 0x19, 0x06, 0x01, 0x1b, 0x3a, 0x52,
-	0x64, 0x74, 0x9d, 0x5b, 0x71, 0xc8, 0xa2, 0x9c, 0x95, 0x7e, 0xd6, 0x84, 0x9b, 0xfb, 0x1d, 0xa7,
-	0x78, 0xb7, 0x3d, 0x1d, 0x0b, 0x53, 0x64, 0x3b, 0xa4, 0x3c, 0x39, 0x24, 0xa4, 0x92, 0x7c, 0x6e,
-	0x09, 0xcb, 0xde, 0xeb, 0xcb, 0xd4, 0xb1, 0xaf, 0x2f, 0x6f, 0xb6, 0xb5, 0x69, 0xcc, 0x54, 0x7c,
-	0xf8, 0xe4, 0x93, 0xde, 0xfb, 0x28, 0x5a, 0x50, 0xaf, 0xc3, 0xf8, 0x2e, 0x46, 0x16, 0x0e, 0xe4,
-	0x60, 0xa9, 0xf4, 0xdb, 0x72, 0x93, 0x6b, 0xe9, 0x52, 0x5b, 0xfb, 0x4b, 0x16, 0x4e, 0xaf, 0x59,
-	0x56, 0xfb, 0x68, 0x38, 0x02, 0x6d, 0xde, 0x86, 0xfc, 0x4b, 0x50, 0x48, 0x62, 0xab, 0xae, 0x4b,
-	0xce, 0x12, 0xf3, 0x3d, 0x73, 0x84, 0xf9, 0xce, 0x99, 0x4d, 0x8c, 0xf3, 0x2b, 0xa0, 0x26, 0x18,
-	0xe9, 0xba, 0xea, 0x95, 0xe2, 0x95, 0xe8, 0xf2, 0xd5, 0xd5, 0xc0, 0xb2, 0x57, 0x24, 0xa2, 0xc7,
-	0x8e, 0xdc, 0xc0, 0xfc, 0x0a, 0x19, 0xe1, 0x3a, 0x8d, 0xcf, 0xc7, 0x53, 0xf9, 0x5c, 0xfd, 0x1a,
-	0x8c, 0x4b, 0x05, 0x46, 0x1a, 0x53, 0xab, 0xcb, 0xa9, 0x13, 0x9d, 0x3f, 0xc0, 0xa2, 0xc4, 0x85,
-	0xa5, 0x2e, 0xed, 0xd4, 0xf7, 0x60, 0x8c, 0xbf, 0xe5, 0x64, 0x5f, 0x5f, 0x4a, 0x75, 0xc0, 0x35,
-	0x98, 0x83, 0x87, 0xd8, 0xa4, 0x5e, 0xb0, 0xce, 0x3e, 0xea, 0xc2, 0x4e, 0x35, 0x61, 0xa6, 0xc9,
-	0x5e, 0x62, 0x9e, 0x6b, 0x58, 0x76, 0x80, 0x19, 0xcd, 0x62, 0xd9, 0xd3, 0xd7, 0x53, 0x9d, 0xf5,
-	0x1c, 0xc5, 0x43, 0x61, 0x7e, 0x2b, 0xb2, 0xd6, 0x4b, 0xcd, 0x2e, 0x89, 0x36, 0x0b, 0x67, 0x7a,
-	0x70, 0x26, 0x06, 0x96, 0xf6, 0x0f, 0x81, 0xc1, 0xf6, 0x89, 0xf6, 0xc5, 0x63, 0x30, 0x7b, 0x92,
-	0x18, 0x1c, 0x3b, 0x0e, 0x06, 0xc7, 0x4f, 0x1e, 0x83, 0x13, 0x83, 0x30, 0x98, 0xfb, 0x5f, 0xc6,
-	0xe0, 0x07, 0xd9, 0x5c, 0xa6, 0x94, 0x95, 0x48, 0xec, 0x44, 0x9b, 0x44, 0xe2, 0xdf, 0x46, 0xe1,
-	0x14, 0xbf, 0x65, 0x46, 0x40, 0x39, 0x02, 0x0e, 0x3b, 0xe1, 0x33, 0x7a, 0x3c, 0xf8, 0x3c, 0x82,
-	0x49, 0x7e, 0xed, 0xed, 0xba, 0x6b, 0x5e, 0x1b, 0x78, 0xd7, 0x4c, 0x8b, 0x5a, 0x2f, 0x72, 0x5f,
-	0x47, 0xbf, 0x64, 0xa6, 0x9f, 0xc6, 0xd8, 0x09, 0x33, 0xc2, 0x2f, 0x15, 0x78, 0xad, 0x2b, 0x6c,
-	0x79, 0x83, 0x5d, 0x87, 0x62, 0x54, 0x05, 0x12, 0x3a, 0x94, 0x57, 0x7b, 0x98, 0x81, 0x5c, 0x90,
-	0xf9, 0x32, 0x23, 0xf5, 0x43, 0x98, 0x8a, 0x9c, 0x7c, 0x07, 0x9b, 0x14, 0x5b, 0x03, 0x5e, 0x19,
-	0xe2, 0x75, 0x21, 0x75, 0xf5, 0xc9, 0x27, 0xed, 0x1f, 0xb5, 0x1f, 0x8d, 0xc2, 0xa2, 0x08, 0xcf,
-	0xe2, 0x7a, 0x2c, 0xc5, 0x75, 0xaf, 0xe1, 0x3b, 0x98, 0x29, 0xff, 0x87, 0x41, 0x72, 0x06, 0x26,
-	0xb8, 0x93, 0xf8, 0x8e, 0x3d, 0xce, 0x3e, 0x6e, 0x59, 0xaa, 0x0b, 0x33, 0x66, 0x14, 0x54, 0x8c,
-	0x20, 0x41, 0x64, 0x6b, 0x03, 0x11, 0x34, 0x28, 0x3d, 0xbd, 0x64, 0x76, 0x49, 0xb4, 0xd7, 0x61,
-	0xe9, 0x10, 0x2b, 0xd9, 0x53, 0xff, 0x54, 0x60, 0x7e, 0x1d, 0xb9, 0x26, 0x76, 0xbe, 0x1e, 0x52,
-	0x42, 0x91, 0x6b, 0xd9, 0x6e, 0x7d, 0xbb, 0xed, 0xf1, 0x33, 0x44, 0xd9, 0xee, 0xc0, 0x74, 0x52,
-	0x36, 0x71, 0xb3, 0x1a, 0xe5, 0x4c, 0xd5, 0x55, 0xbb, 0x0e, 0x8a, 0xe2, 0xc5, 0xe2, 0x37, 0xab,
-	0x49, 0xda, 0xfe, 0xf1, 0x64, 0x2e, 0x1b, 0x1d, 0x2f, 0xc6, 0x6c, 0xe7, 0x8b, 0x51, 0x5b, 0x80,
-	0x73, 0x7d, 0x52, 0x96, 0x45, 0xf9, 0x99, 0x02, 0xe5, 0x5b, 0x98, 0x98, 0x81, 0x5d, 0xc3, 0xc7,
-	0x79, 0xaf, 0x7e, 0x0b, 0x8a, 0x16, 0x26, 0x66, 0x7c, 0xc8, 0xa3, 0xdd, 0x5f, 0xc5, 0xf4, 0x39,
-	0xe4, 0x7e, 0x7b, 0xea, 0x05, 0xe6, 0x2e, 0x3a, 0xd7, 0xdf, 0x2a, 0x30, 0x9b, 0xa2, 0x29, 0xbb,
-	0xf3, 0x3d, 0x98, 0x10, 0x89, 0x92, 0xb2, 0xc2, 0xbf, 0x15, 0x78, 0xe3, 0x90, 0xda, 0x6d, 0x8b,
-	0x92, 0xb8, 0x8f, 0x3d, 0x3d, 0xb2, 0x52, 0x1f, 0xc2, 0x4c, 0xdb, 0x69, 0x12, 0x8a, 0x68, 0x48,
-	0x64, 0x06, 0x97, 0x87, 0x39, 0x86, 0xfb, 0xdc, 0x42, 0x9f, 0xa6, 0x9d, 0x02, 0xed, 0xe7, 0x0a,
-	0x54, 0xee, 0xd8, 0x84, 0xc6, 0x8a, 0xdb, 0x28, 0xa0, 0x36, 0x1b, 0x95, 0x24, 0x2a, 0xed, 0x3c,
-	0xe4, 0x93, 0xcb, 0xb4, 0xa8, 0x6b, 0x22, 0xe8, 0x29, 0x7c, 0xe6, 0xd5, 0x34, 0xb0, 0xf6, 0xd3,
-	0x51, 0x58, 0xe8, 0x1b, 0xa8, 0xac, 0xf2, 0x77, 0xa1, 0x92, 0xbc, 0x95, 0x93, 0x6a, 0xf9, 0xb1,
-	0xa6, 0x2c, 0xfe, 0xb5, 0x61, 0x36, 0x8f, 0xfd, 0xdf, 0xc5, 0x14, 0x59, 0x88, 0x22, 0xfd, 0x2c,
-	0xea, 0xfe, 0xfe, 0x20, 0x89, 0x81, 0xed, 0xdd, 0xf1, 0x4d, 0x5f, 0xef, 0xde, 0xa3, 0x2f, 0xb5,
-	0x77, 0xab, 0xfb, 0x8b, 0xa8, 0x64, 0x6f, 0xed, 0x5f, 0x59, 0xb8, 0xf8, 0xc0, 0xb7, 0x10, 0xc5,
-	0x6c, 0x2c, 0xe0, 0xe0, 0x66, 0x68, 0x3b, 0xd6, 0x96, 0xc5, 0x78, 0x05, 0x51, 0xbb, 0x66, 0x3b,
-	0x36, 0xdd, 0x3f, 0x42, 0xa3, 0x9c, 0xeb, 0x39, 0xaf, 0x7c, 0x7b, 0x17, 0xff, 0x58, 0x81, 0x53,
-	0xc8, 0xf7, 0x9d, 0x7d, 0xc3, 0x0f, 0x6b, 0x8e, 0x6d, 0x76, 0xcd, 0xdd, 0xda, 0xb0, 0x5f, 0xaf,
-	0x0d, 0x19, 0x71, 0x75, 0x8d, 0xed, 0xb5, 0xcd, 0xb7, 0x92, 0xa2, 0xcd, 0x11, 0x5d, 0x45, 0x3d,
-	0x52, 0xf5, 0xfb, 0x0a, 0x94, 0x02, 0xdc, 0xf0, 0x9a, 0xd8, 0xa8, 0x31, 0x7f, 0x86, 0x6d, 0x11,
-	0x49, 0xe5, 0xdf, 0x3e, 0xe9, 0xa0, 0x74, 0xbe, 0x8f, 0xd4, 0x20, 0x9b, 0x23, 0xfa, 0x54, 0xd0,
-	0x21, 0x99, 0x7b, 0x0a, 0x6a, 0x6f, 0xe0, 0x6a, 0x0d, 0x26, 0xa2, 0x6a, 0x89, 0x01, 0xbd, 0x39,
-	0x90, 0x7e, 0x86, 0x8c, 0x48, 0x8f, 0x1c, 0xcf, 0x59, 0x30, 0xd5, 0x19, 0x9d, 0x7a, 0x0d, 0xce,
-	0xec, 0xb9, 0x5e, 0xcb, 0x35, 0x42, 0x82, 0x03, 0x83, 0xe1, 0xc9, 0x90, 0x37, 0x0b, 0x1e, 0x45,
-	0x46, 0x3f, 0xc5, 0x97, 0x1f, 0x10, 0x1c, 0xdc, 0x42, 0x14, 0xc9, 0x7b, 0x08, 0xa3, 0xeb, 0xa4,
-	0x8e, 0x0c, 0xbd, 0x79, 0x3d, 0x57, 0x93, 0x3e, 0x6f, 0x16, 0x20, 0xef, 0xf9, 0x58, 0xdc, 0xaa,
-	0xb5, 0xcb, 0xb0, 0x3c, 0x38, 0x4c, 0x49, 0xe3, 0xbf, 0x52, 0xe0, 0xfc, 0x6d, 0x4c, 0x4f, 0x04,
-	0xa9, 0x46, 0x52, 0x4e, 0x41, 0x2b, 0x1b, 0x03, 0xcb, 0x39, 0xcc, 0xd6, 0x71, 0x2d, 0xb5, 0x1f,
-	0x28, 0xf0, 0xc6, 0x00, 0x0b, 0xc9, 0x3d, 0x35, 0xc8, 0x45, 0x3f, 0x90, 0xc9, 0xa3, 0x7d, 0xff,
-	0x65, 0x63, 0x11, 0xde, 0xf4, 0xd8, 0xaf, 0xf6, 0xc3, 0x51, 0x38, 0x7b, 0x1b, 0x27, 0x14, 0x18,
-	0x1d, 0xd8, 0xc9, 0xf5, 0x76, 0xca, 0xa5, 0x61, 0xec, 0xf8, 0x97, 0x86, 0x77, 0x61, 0xde, 0x41,
-	0x84, 0x1a, 0xfd, 0xc0, 0x97, 0xe1, 0xe0, 0x2b, 0x33, 0x9d, 0x0f, 0xd3, 0x00, 0xa8, 0xc1, 0x64,
-	0x0b, 0xd9, 0xd4, 0x70, 0x71, 0x8b, 0x1b, 0xf2, 0x66, 0xce, 0xe9, 0x05, 0x26, 0xfc, 0x08, 0xb7,
-	0x98, 0xaa, 0xf6, 0x1b, 0x05, 0xe6, 0xd3, 0x6b, 0x22, 0x0f, 0xe6, 0x3a, 0x94, 0xdb, 0x52, 0xda,
-	0x45, 0x24, 0x09, 0x84, 0x17, 0x28, 0xa7, 0x9f, 0x8a, 0xa3, 0xde, 0x44, 0x24, 0xb2, 0x57, 0x3f,
-	0x86, 0x7c, 0xa2, 0x28, 0xd0, 0xf5, 0x6e, 0x2a, 0x8b, 0xb4, 0xfd, 0x22, 0x2b, 0x1e, 0x6a, 0x3c,
-	0x78, 0x6c, 0xf5, 0x86, 0x94, 0x0b, 0xe5, 0x5f, 0xda, 0xef, 0x15, 0x78, 0x93, 0xd3, 0x43, 0x4a,
-	0xdc, 0xbe, 0x63, 0x9b, 0xbc, 0xad, 0xf8, 0x8b, 0xf7, 0xe4, 0xce, 0x56, 0x6f, 0x4f, 0xa8, 0xe7,
-	0x8d, 0xd4, 0x3f, 0xa1, 0xc3, 0xf2, 0xf8, 0x7f, 0xa8, 0x0e, 0x9b, 0x86, 0xc4, 0x30, 0x82, 0xa5,
-	0xdb, 0x98, 0x4a, 0xc0, 0xc7, 0x66, 0x77, 0x91, 0xef, 0xdb, 0x6e, 0xfd, 0x08, 0xc9, 0xce, 0x42,
-	0x2e, 0x22, 0x27, 0x99, 0xea, 0x84, 0xe4, 0x26, 0x6d, 0x03, 0xb4, 0xc3, 0xb6, 0x90, 0xb8, 0x58,
-	0x80, 0x42, 0xdb, 0x8f, 0xe7, 0xfc, 0x66, 0x90, 0xd7, 0x21, 0x2e, 0x17, 0xd1, 0x7e, 0xad, 0xc0,
-	0xd9, 0xf7, 0xbd, 0xc0, 0xc4, 0x0f, 0x5c, 0xf6, 0x54, 0x3a, 0xce, 0x95, 0xf3, 0xe8, 0xdd, 0x96,
-	0x39, 0x76, 0xb7, 0x69, 0x37, 0x60, 0x3e, 0x3d, 0xdc, 0xe4, 0x37, 0x8e, 0x16, 0x22, 0x06, 0x5b,
-	0xc4, 0x96, 0x84, 0x7e, 0xbe, 0x85, 0xc8, 0x1d, 0x2e, 0x60, 0xcf, 0xb5, 0x8a, 0x20, 0xf1, 0x57,
-	0xc8, 0x2f, 0x1f, 0xf7, 0x62, 0xf0, 0xc4, 0x9a, 0x4a, 0xbd, 0x00, 0xd3, 0xf1, 0xbc, 0x32, 0x90,
-	0xc5, 0xb2, 0xcc, 0xf2, 0x53, 0x9d, 0x8c, 0xa6, 0xd6, 0x1a, 0x13, 0xaa, 0x97, 0x61, 0x26, 0xd1,
-	0x13, 0x63, 0xdb, 0x2a, 0x8f, 0x71, 0xcd, 0xe9, 0x48, 0x53, 0x4c, 0x50, 0x4b, 0x5b, 0x82, 0x85,
-	0xbe, 0x45, 0x91, 0x88, 0xfe, 0x9d, 0xc2, 0x9e, 0x74, 0x02, 0xee, 0xaf, 0xb2, 0x76, 0xaf, 0xa2,
-	0x7f, 0xcf, 0x83, 0x76, 0x58, 0xe8, 0x22, 0xc3, 0x9b, 0xc1, 0xb3, 0xe7, 0x95, 0x91, 0xcf, 0x9e,
-	0x57, 0x46, 0x3e, 0x7f, 0x5e, 0x51, 0xbe, 0x77, 0x50, 0x51, 0x7e, 0x71, 0x50, 0x51, 0xfe, 0x70,
-	0x50, 0x51, 0x9e, 0x1d, 0x54, 0x94, 0x3f, 0x1f, 0x54, 0x94, 0xbf, 0x1e, 0x54, 0x46, 0x3e, 0x3f,
-	0xa8, 0x28, 0x9f, 0xbe, 0xa8, 0x8c, 0x3c, 0x7b, 0x51, 0x19, 0xf9, 0xec, 0x45, 0x65, 0xe4, 0xd1,
-	0x57, 0xeb, 0x5e, 0x12, 0x9e, 0xed, 0x1d, 0xfe, 0x6f, 0x4d, 0x5f, 0xe9, 0x12, 0xd5, 0xc6, 0xf9,
-	0x77, 0x77, 0x5f, 0xfa, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x07, 0x3f, 0x1a, 0x76, 0x17, 0x25,
-	0x00, 0x00,
+	// 2429 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcf, 0x73, 0xdc, 0x48,
+	0xf5, 0xb7, 0x66, 0xc6, 0xf6, 0xcc, 0x9b, 0xb1, 0x3d, 0xd6, 0x37, 0x9b, 0xc8, 0x8e, 0x3d, 0xb6,
+	0xb5, 0xd9, 0xc4, 0x9b, 0xda, 0x1d, 0x7f, 0x63, 0x48, 0x6a, 0x77, 0x21, 0xbb, 0x38, 0x8e, 0x37,
+	0xf6, 0x6e, 0xb2, 0x38, 0x8a, 0x13, 0xa8, 0x2c, 0x85, 0xb6, 0x47, 0xea, 0x8c, 0x85, 0x35, 0x92,
+	0xa2, 0x6e, 0xcd, 0x64, 0x38, 0x51, 0x1c, 0xe1, 0xb2, 0x14, 0x55, 0x14, 0x14, 0x77, 0x0a, 0xa8,
+	0xe2, 0x04, 0x17, 0xfe, 0x00, 0xaa, 0x38, 0x70, 0xc8, 0x71, 0x6f, 0x10, 0xe7, 0x42, 0x01, 0x87,
+	0xe5, 0x1f, 0xa0, 0xa8, 0x6e, 0xb5, 0xa4, 0xf9, 0xa1, 0xf1, 0x8c, 0x1d, 0x87, 0xa5, 0xb8, 0x8d,
+	0x5e, 0xbf, 0xf7, 0xfa, 0xfd, 0xf8, 0xbc, 0x1f, 0x92, 0x0d, 0xd7, 0x29, 0x6e, 0x78, 0xae, 0x8f,
+	0xec, 0x35, 0x82, 0xfd, 0x26, 0xf6, 0xd7, 0x90, 0x67, 0xad, 0x35, 0x10, 0x35, 0xf6, 0x2d, 0xa7,
+	0xce, 0x48, 0x96, 0x81, 0xd7, 0x9a, 0x57, 0xd6, 0x7c, 0xfc, 0x38, 0xc0, 0x84, 0xea, 0x3e, 0x26,
+	0x9e, 0xeb, 0x10, 0x5c, 0xf5, 0x7c, 0x97, 0xba, 0xf2, 0xc5, 0x48, 0xbc, 0x1a, 0x8a, 0x57, 0x91,
+	0x67, 0x55, 0x7b, 0xc4, 0xab, 0xcd, 0x2b, 0xf3, 0x95, 0xba, 0xeb, 0xd6, 0x6d, 0xbc, 0xc6, 0xa5,
+	0x6a, 0xc1, 0xa3, 0x35, 0x33, 0xf0, 0x11, 0xb5, 0x5c, 0x27, 0xd4, 0x33, 0xbf, 0xd4, 0x7b, 0x4e,
+	0xad, 0x06, 0x26, 0x14, 0x35, 0x3c, 0xc1, 0xb0, 0x62, 0x62, 0x0f, 0x3b, 0x26, 0x76, 0x0c, 0x0b,
+	0x93, 0xb5, 0xba, 0x5b, 0x77, 0x39, 0x9d, 0xff, 0x12, 0x2c, 0x17, 0x62, 0x57, 0x98, 0x0f, 0x86,
+	0xdb, 0x68, 0xb8, 0x0e, 0x33, 0xbd, 0x81, 0x09, 0x41, 0x75, 0x61, 0xf1, 0xfc, 0xc5, 0x2e, 0x2e,
+	0xec, 0x04, 0x0d, 0xc2, 0x98, 0x28, 0x22, 0x07, 0xfa, 0xe3, 0x00, 0x07, 0x11, 0xdf, 0xa5, 0x2e,
+	0x3e, 0x76, 0xcc, 0x4f, 0xfb, 0x15, 0xbe, 0xda, 0xc5, 0xf8, 0x38, 0xc0, 0x7e, 0x7b, 0xd8, 0xad,
+	0x9c, 0x66, 0xb8, 0x76, 0x3f, 0xdf, 0xe5, 0xb4, 0x74, 0x18, 0xb6, 0x6b, 0x1c, 0xf4, 0xf3, 0x5e,
+	0x4a, 0xe3, 0xed, 0x72, 0x48, 0x30, 0xbe, 0x91, 0xc6, 0xb8, 0x6f, 0x11, 0xea, 0xa6, 0x99, 0xfa,
+	0xe5, 0x34, 0x6e, 0x0f, 0xfb, 0xc4, 0x22, 0x14, 0x3b, 0x06, 0x8e, 0x94, 0x87, 0xd1, 0x22, 0x42,
+	0xaa, 0x9a, 0x26, 0x75, 0x44, 0xd4, 0xae, 0x75, 0x05, 0xa4, 0xe5, 0xfa, 0x07, 0x8f, 0x6c, 0xb7,
+	0x35, 0x14, 0x70, 0xea, 0xdf, 0x25, 0x58, 0xd8, 0x75, 0x6d, 0xfb, 0x1b, 0x42, 0x62, 0x0f, 0x91,
+	0x83, 0xbb, 0xec, 0x0a, 0x2d, 0xe4, 0x97, 0x57, 0xa0, 0xe4, 0xa0, 0x06, 0x26, 0x1e, 0x32, 0xb0,
+	0x6e, 0x99, 0x8a, 0xb4, 0x2c, 0xad, 0x16, 0xb4, 0x62, 0x4c, 0xdb, 0x31, 0xe5, 0xf3, 0x50, 0xf0,
+	0x5c, 0xdb, 0xc6, 0x3e, 0x3b, 0xcf, 0xf0, 0xf3, 0x7c, 0x48, 0xd8, 0x31, 0xe5, 0x4f, 0xa0, 0xc4,
+	0x7e, 0xeb, 0xe2, 0x7e, 0x25, 0xbb, 0x2c, 0xad, 0x16, 0xd7, 0xaf, 0xc7, 0xfe, 0x71, 0x84, 0xf7,
+	0xd8, 0x5b, 0x6d, 0x5e, 0xa9, 0x1e, 0x65, 0x94, 0x56, 0x64, 0x2a, 0x23, 0x0b, 0x5f, 0x87, 0xf2,
+	0x23, 0xd7, 0x6f, 0x21, 0xdf, 0xc4, 0xa6, 0x4e, 0xdc, 0xc0, 0x37, 0xb0, 0x92, 0xe3, 0x56, 0xcc,
+	0xc4, 0xf4, 0x7b, 0x9c, 0xac, 0xfe, 0xa9, 0x00, 0x8b, 0x03, 0x14, 0x87, 0x51, 0x91, 0x17, 0x01,
+	0x78, 0x32, 0xa8, 0x7b, 0x80, 0x1d, 0xee, 0x6c, 0x49, 0x2b, 0x30, 0xca, 0x1e, 0x23, 0xc8, 0xdf,
+	0x04, 0x39, 0xb2, 0x55, 0xc7, 0x4f, 0xb0, 0x11, 0xb0, 0x9a, 0xe3, 0x3e, 0x17, 0xd7, 0x5f, 0xef,
+	0xf6, 0x29, 0x2c, 0x18, 0xe6, 0x4a, 0x74, 0xdb, 0x56, 0x24, 0xa0, 0xcd, 0xb6, 0x7a, 0x49, 0xf2,
+	0x0e, 0x4c, 0xc5, 0x9a, 0x69, 0xdb, 0xc3, 0x22, 0x50, 0x17, 0x86, 0x29, 0xdd, 0x6b, 0x7b, 0x58,
+	0x2b, 0xb5, 0x3a, 0x9e, 0xe4, 0xb7, 0x61, 0xce, 0xf3, 0x71, 0xd3, 0x72, 0x03, 0xa2, 0x13, 0x8a,
+	0x7c, 0x8a, 0x4d, 0x1d, 0x37, 0xb1, 0x43, 0x59, 0x7e, 0x58, 0x64, 0xb2, 0xda, 0xd9, 0x88, 0xe1,
+	0x5e, 0x78, 0xbe, 0xc5, 0x8e, 0x77, 0x4c, 0x79, 0x15, 0xca, 0x7d, 0x12, 0xe3, 0x5c, 0x62, 0x9a,
+	0x74, 0x73, 0x2a, 0x30, 0x89, 0x28, 0xb3, 0x8d, 0x2a, 0x13, 0xcb, 0xd2, 0xea, 0xb8, 0x16, 0x3d,
+	0xca, 0x2a, 0x4c, 0x39, 0xf8, 0x09, 0x4d, 0x14, 0x4c, 0x72, 0x05, 0x45, 0x46, 0x8c, 0xa4, 0xdf,
+	0x00, 0xb9, 0x86, 0x8c, 0x03, 0xdb, 0xad, 0xeb, 0x86, 0x1b, 0x38, 0x54, 0xdf, 0xb7, 0x1c, 0xaa,
+	0xe4, 0x39, 0x63, 0x59, 0x9c, 0x6c, 0xb2, 0x83, 0x6d, 0xcb, 0xa1, 0xf2, 0x5b, 0xa0, 0x10, 0x6a,
+	0x19, 0x07, 0xed, 0x24, 0xe6, 0x3a, 0x76, 0x50, 0xcd, 0xc6, 0xa6, 0x52, 0x58, 0x96, 0x56, 0xf3,
+	0xda, 0xd9, 0xf0, 0x3c, 0x0e, 0xe7, 0x56, 0x78, 0x2a, 0xbf, 0x03, 0xe3, 0xbc, 0x83, 0x28, 0x90,
+	0x16, 0x4d, 0x7e, 0xd4, 0x19, 0xcc, 0xbb, 0x8c, 0xa0, 0x85, 0x22, 0xf2, 0x63, 0x38, 0x47, 0x7d,
+	0xe4, 0x10, 0x8b, 0xb9, 0x91, 0xe4, 0x06, 0x91, 0x03, 0xa5, 0xc8, 0xb5, 0xbd, 0x5d, 0x4d, 0xeb,
+	0xd6, 0xa2, 0x11, 0x30, 0xb5, 0x7b, 0x91, 0x78, 0x27, 0xde, 0x76, 0x9c, 0x47, 0xae, 0xf6, 0x0a,
+	0x4d, 0x3b, 0x92, 0xeb, 0xb0, 0xd8, 0x0f, 0x2f, 0x3d, 0xe9, 0x0e, 0x4a, 0x29, 0xcd, 0x8d, 0xb8,
+	0x2d, 0xf0, 0x3b, 0x63, 0x48, 0xcf, 0xf7, 0x81, 0x2c, 0x3e, 0x63, 0x55, 0x5d, 0xf3, 0x91, 0x63,
+	0xec, 0x0b, 0xa0, 0x4f, 0x73, 0xa0, 0x17, 0x43, 0x5a, 0x08, 0xf5, 0x5b, 0x30, 0x4d, 0x8c, 0x7d,
+	0x6c, 0x06, 0x36, 0x36, 0x75, 0x36, 0x3e, 0x94, 0x19, 0x7e, 0xf9, 0x7c, 0x35, 0x9c, 0x2d, 0xd5,
+	0x68, 0xb6, 0x54, 0xf7, 0xa2, 0xd9, 0x72, 0x23, 0xf7, 0xe9, 0x9f, 0x97, 0x24, 0x6d, 0x2a, 0x96,
+	0x63, 0x27, 0xf2, 0x26, 0x94, 0x22, 0x4c, 0x71, 0x35, 0xe5, 0x11, 0xd5, 0x14, 0x85, 0x14, 0x57,
+	0x62, 0xc3, 0x24, 0xcb, 0x8a, 0x85, 0x89, 0x32, 0xbb, 0x9c, 0x5d, 0x2d, 0xae, 0x6b, 0xd5, 0xd1,
+	0x46, 0x65, 0xf5, 0xc8, 0x7a, 0xaf, 0xde, 0x0d, 0x95, 0x6e, 0x39, 0xd4, 0x6f, 0x6b, 0xd1, 0x15,
+	0xf2, 0x75, 0xc8, 0x8b, 0xf6, 0x4a, 0x14, 0x99, 0x5f, 0xb7, 0xd2, 0x1d, 0xf2, 0x68, 0xe2, 0xb0,
+	0x0b, 0xee, 0x84, 0x9c, 0x5a, 0x2c, 0x32, 0xff, 0x09, 0x94, 0x3a, 0xf5, 0xca, 0x65, 0xc8, 0x1e,
+	0xe0, 0xb6, 0x68, 0x9d, 0xec, 0x27, 0xc3, 0x65, 0x13, 0xd9, 0x01, 0x56, 0x32, 0x69, 0x09, 0x1d,
+	0x84, 0x4b, 0x2e, 0xf2, 0x4e, 0xe6, 0x2d, 0xe9, 0x83, 0x5c, 0x7e, 0xaa, 0x3c, 0x1d, 0x37, 0xef,
+	0x0d, 0x83, 0x5a, 0x4d, 0x8b, 0xb6, 0xff, 0xab, 0x9a, 0xf7, 0x20, 0xa3, 0x4e, 0xde, 0xbc, 0xf3,
+	0xb0, 0x38, 0x40, 0xf1, 0x17, 0xdd, 0xbc, 0x97, 0xa0, 0x88, 0x84, 0x55, 0x2c, 0x8c, 0x59, 0xee,
+	0x00, 0x44, 0xa4, 0x1d, 0x93, 0x75, 0xf7, 0x98, 0x81, 0x77, 0xf7, 0xdc, 0xd1, 0xdd, 0x3d, 0xf6,
+	0x91, 0x77, 0x77, 0xd4, 0xf1, 0x24, 0x5f, 0x83, 0x71, 0xcb, 0xf1, 0x02, 0xca, 0xfb, 0x72, 0x71,
+	0x7d, 0x79, 0x90, 0x8a, 0x5d, 0xd4, 0xb6, 0x5d, 0x64, 0x12, 0x2d, 0x64, 0x4f, 0xa9, 0xe7, 0x89,
+	0x93, 0xd5, 0xf3, 0x43, 0x98, 0x8b, 0x08, 0x3a, 0x75, 0x75, 0xc3, 0x76, 0x09, 0xe6, 0x0a, 0xdd,
+	0x80, 0xf2, 0x5e, 0x5f, 0x5c, 0x9f, 0xeb, 0xd3, 0x79, 0x53, 0xec, 0xa7, 0x37, 0x72, 0x3f, 0x65,
+	0x2a, 0xcf, 0x46, 0x1a, 0xf6, 0xdc, 0x4d, 0x26, 0xbf, 0x17, 0x8a, 0xf7, 0xf5, 0x8a, 0xfc, 0x49,
+	0x7a, 0xc5, 0x1e, 0x9c, 0xe5, 0x8f, 0xfd, 0xd6, 0x15, 0x46, 0xb3, 0xee, 0xff, 0xb8, 0x78, 0x8f,
+	0x69, 0xb7, 0x61, 0x76, 0x1f, 0x23, 0x9f, 0xd6, 0x30, 0xa2, 0xb1, 0x42, 0x18, 0x4d, 0x61, 0x39,
+	0x96, 0x8c, 0xb4, 0x75, 0x8c, 0xcf, 0x62, 0xf7, 0xf8, 0xc4, 0x50, 0x31, 0x02, 0xdf, 0x67, 0x43,
+	0x47, 0x90, 0xf4, 0x9e, 0xbc, 0x95, 0x46, 0x0c, 0xca, 0x79, 0xa1, 0x67, 0x23, 0x54, 0x73, 0xaf,
+	0x2b, 0x8b, 0x77, 0x3a, 0xdd, 0x31, 0x31, 0x45, 0x96, 0x4d, 0x94, 0xa9, 0x11, 0x21, 0x95, 0xf8,
+	0x73, 0x33, 0x94, 0xec, 0x5f, 0x5f, 0xa6, 0x4f, 0xbc, 0xbe, 0xbc, 0xd9, 0x51, 0xa6, 0x71, 0xa7,
+	0xe2, 0xc3, 0xa7, 0x90, 0xd4, 0xde, 0x47, 0xd1, 0x81, 0x7c, 0x0d, 0x26, 0xf6, 0x31, 0x32, 0xb1,
+	0x2f, 0x06, 0x4b, 0x65, 0xd0, 0x95, 0xdb, 0x9c, 0x4b, 0x13, 0xdc, 0xea, 0xf7, 0xc7, 0xe1, 0xec,
+	0x86, 0x69, 0x76, 0x8e, 0x86, 0x63, 0xb4, 0xcd, 0x5b, 0x50, 0x78, 0x81, 0x16, 0x92, 0xc8, 0xca,
+	0x9b, 0xa2, 0x67, 0x85, 0xf3, 0x3d, 0x7b, 0x8c, 0xf9, 0x5e, 0xa0, 0xd1, 0x4f, 0xb6, 0x4e, 0x25,
+	0x18, 0xe9, 0x59, 0xf5, 0xca, 0xf1, 0x49, 0xb4, 0x7c, 0xf5, 0x14, 0xb0, 0xa8, 0x15, 0x81, 0xe8,
+	0xf1, 0x63, 0x17, 0x30, 0x5f, 0x21, 0x23, 0x5c, 0xa7, 0xf5, 0xf3, 0x89, 0xd4, 0x7e, 0x2e, 0x7f,
+	0x0d, 0x26, 0x04, 0x03, 0x6b, 0x1a, 0xd3, 0xeb, 0xab, 0xa9, 0x13, 0x9d, 0xbf, 0x80, 0x45, 0x8e,
+	0x87, 0x92, 0x9a, 0x90, 0x93, 0xdf, 0x83, 0x71, 0xfe, 0x2e, 0xa7, 0x14, 0x7a, 0x13, 0xd0, 0xa1,
+	0x80, 0x73, 0x30, 0x05, 0x0f, 0xb0, 0x41, 0x5d, 0x7f, 0x93, 0x3d, 0x6a, 0xa1, 0x9c, 0x6c, 0xc0,
+	0x6c, 0x13, 0xfb, 0x84, 0x2d, 0x59, 0xa6, 0xe5, 0x63, 0xd6, 0x66, 0xb1, 0xa8, 0xe9, 0x6b, 0xa9,
+	0xca, 0xfa, 0x52, 0xf1, 0x20, 0x14, 0xbf, 0x19, 0x49, 0x6b, 0xe5, 0x66, 0x0f, 0x45, 0x9e, 0x87,
+	0xbc, 0xe7, 0x5b, 0xae, 0x6f, 0xd1, 0x36, 0xaf, 0xf5, 0xbc, 0x16, 0x3f, 0xab, 0x73, 0x70, 0xae,
+	0x0f, 0x83, 0xe1, 0x30, 0x53, 0xff, 0x91, 0xe3, 0xf8, 0xec, 0x9c, 0x76, 0x5f, 0x3c, 0x3e, 0x73,
+	0xa7, 0x89, 0xcf, 0xf1, 0x93, 0xe0, 0x73, 0xe2, 0xf4, 0xf1, 0x39, 0x39, 0x0c, 0x9f, 0xf9, 0xff,
+	0x65, 0x7c, 0x7e, 0x90, 0xcb, 0x67, 0xcb, 0x39, 0x81, 0xc4, 0x6e, 0xb4, 0x09, 0x24, 0xfe, 0x2d,
+	0x03, 0x67, 0xf8, 0x06, 0x1a, 0x01, 0xe5, 0x18, 0x38, 0xec, 0x86, 0x4f, 0xe6, 0x64, 0xf0, 0x79,
+	0x08, 0x53, 0x7c, 0x25, 0xee, 0xd9, 0x43, 0xaf, 0x0e, 0xdd, 0x43, 0xd3, 0xac, 0xd6, 0x4a, 0x5c,
+	0xd7, 0xf1, 0x17, 0xd0, 0xf4, 0x6c, 0x8c, 0x9f, 0x6e, 0x36, 0xd4, 0x5f, 0x49, 0xf0, 0x4a, 0x8f,
+	0xd9, 0x62, 0xbb, 0xdd, 0x84, 0x52, 0x14, 0x05, 0x12, 0xd8, 0x54, 0x91, 0x46, 0x1c, 0xd6, 0x45,
+	0xe1, 0x2f, 0x13, 0x92, 0x3f, 0x84, 0xe9, 0x48, 0xc9, 0x77, 0xb0, 0x41, 0xb1, 0x39, 0xe4, 0x0d,
+	0x24, 0x7c, 0xf3, 0x10, 0xbc, 0xda, 0xd4, 0xe3, 0xce, 0x47, 0xf5, 0xc7, 0x19, 0x58, 0x0e, 0xcd,
+	0x33, 0x39, 0x1f, 0x73, 0x71, 0xd3, 0x6d, 0x78, 0x36, 0x66, 0xcc, 0xff, 0x61, 0x90, 0x9c, 0x83,
+	0x49, 0xae, 0x24, 0xde, 0xbf, 0x27, 0xd8, 0xe3, 0x8e, 0x29, 0x3b, 0x30, 0x6b, 0x44, 0x46, 0xc5,
+	0x08, 0x0a, 0x1b, 0xd9, 0xc6, 0x50, 0x04, 0x0d, 0x73, 0x4f, 0x2b, 0x1b, 0x3d, 0x14, 0xf5, 0x55,
+	0x58, 0x39, 0x42, 0x4a, 0xd4, 0xd4, 0x3f, 0x25, 0x58, 0xd8, 0x44, 0x8e, 0x81, 0xed, 0xaf, 0x07,
+	0x94, 0x50, 0xe4, 0x98, 0x96, 0x53, 0xdf, 0xed, 0x78, 0x31, 0x1a, 0x21, 0x6c, 0xb7, 0x61, 0x26,
+	0x09, 0x5b, 0xb8, 0x75, 0x65, 0x78, 0xa7, 0xea, 0x89, 0x5d, 0x57, 0x8b, 0xe2, 0xc1, 0xe2, 0x5b,
+	0xd7, 0x14, 0xed, 0x7c, 0x3c, 0x9d, 0x45, 0xa4, 0xeb, 0x6d, 0x32, 0xd7, 0xfd, 0x36, 0xa9, 0x2e,
+	0xc1, 0xe2, 0x00, 0x97, 0x45, 0x50, 0x7e, 0x2e, 0x81, 0x72, 0x13, 0x13, 0xc3, 0xb7, 0x6a, 0xf8,
+	0x24, 0xef, 0xb2, 0xdf, 0x82, 0x92, 0x89, 0x89, 0x11, 0x27, 0x39, 0xd3, 0xfb, 0x99, 0x66, 0x40,
+	0x92, 0x07, 0xdd, 0xa9, 0x15, 0x99, 0xba, 0x28, 0xaf, 0xbf, 0x93, 0x60, 0x2e, 0x85, 0x53, 0x54,
+	0xe7, 0x7b, 0x30, 0x19, 0x3a, 0x4a, 0x14, 0x89, 0x7f, 0x31, 0x78, 0xed, 0x88, 0xd8, 0xed, 0x86,
+	0x21, 0x61, 0x5f, 0x82, 0x22, 0x29, 0xf9, 0x01, 0xcc, 0x76, 0x64, 0x93, 0x50, 0x44, 0x03, 0x22,
+	0x3c, 0xb8, 0x3c, 0x4a, 0x1a, 0xee, 0x71, 0x09, 0x6d, 0x86, 0x76, 0x13, 0xd4, 0x5f, 0x48, 0x50,
+	0xb9, 0x6d, 0x11, 0x1a, 0x33, 0xee, 0x22, 0x9f, 0x5a, 0x6c, 0x54, 0x92, 0x28, 0xb4, 0x0b, 0x50,
+	0x48, 0x16, 0xed, 0x30, 0xae, 0x09, 0xa1, 0x2f, 0xf0, 0xd9, 0x97, 0x53, 0xc0, 0xea, 0xcf, 0x32,
+	0xb0, 0x34, 0xd0, 0x50, 0x11, 0xe5, 0xef, 0x42, 0x25, 0x79, 0x8f, 0x4e, 0xa2, 0xe5, 0xc5, 0x9c,
+	0x22, 0xf8, 0x57, 0x47, 0xb9, 0x3c, 0xd6, 0x7f, 0x07, 0x53, 0x64, 0x22, 0x8a, 0xb4, 0xf3, 0xa8,
+	0xf7, 0xdb, 0x42, 0x62, 0x03, 0xbb, 0xbb, 0xeb, 0x2b, 0x60, 0xff, 0xdd, 0x99, 0x17, 0xba, 0xbb,
+	0xd5, 0xfb, 0x91, 0x2a, 0xb9, 0x5b, 0xfd, 0x57, 0x0e, 0x2e, 0xdd, 0xf7, 0x4c, 0x44, 0x31, 0x1b,
+	0x0b, 0xd8, 0xbf, 0x11, 0x58, 0xb6, 0xb9, 0x63, 0xb2, 0xbe, 0x82, 0xa8, 0x55, 0xb3, 0x6c, 0x8b,
+	0xb6, 0x8f, 0x51, 0x28, 0x8b, 0x7d, 0xf9, 0x2a, 0x74, 0x56, 0xf1, 0x4f, 0x24, 0x38, 0x83, 0x3c,
+	0xcf, 0x6e, 0xeb, 0x5e, 0x50, 0xb3, 0x2d, 0xa3, 0x67, 0xee, 0xd6, 0x46, 0xfd, 0xf4, 0x36, 0xa2,
+	0xc5, 0xd5, 0x0d, 0x76, 0xd7, 0x2e, 0xbf, 0x4a, 0x90, 0xb6, 0xc7, 0x34, 0x19, 0xf5, 0x51, 0xe5,
+	0x1f, 0x48, 0x50, 0xf6, 0x71, 0xc3, 0x6d, 0x62, 0xbd, 0xc6, 0xf4, 0xe9, 0x96, 0x49, 0x44, 0x2b,
+	0xff, 0xf6, 0x69, 0x1b, 0xa5, 0xf1, 0x7b, 0x04, 0x07, 0xd9, 0x1e, 0xd3, 0xa6, 0xfd, 0x2e, 0xca,
+	0xfc, 0x13, 0x90, 0xfb, 0x0d, 0x97, 0x6b, 0x30, 0x19, 0x45, 0x2b, 0x1c, 0xd0, 0xdb, 0x43, 0xdb,
+	0xcf, 0x88, 0x16, 0x69, 0x91, 0xe2, 0x79, 0x13, 0xa6, 0xbb, 0xad, 0x93, 0xaf, 0xc2, 0xb9, 0x03,
+	0xc7, 0x6d, 0x39, 0x7a, 0x40, 0xb0, 0xaf, 0x33, 0x3c, 0xe9, 0x62, 0xb3, 0xe0, 0x56, 0x64, 0xb5,
+	0x33, 0xfc, 0xf8, 0x3e, 0xc1, 0xfe, 0x4d, 0x44, 0x91, 0xd8, 0x43, 0x58, 0xbb, 0x4e, 0xe2, 0xc8,
+	0xd0, 0x5b, 0xd0, 0xf2, 0x35, 0xa1, 0xf3, 0x46, 0x11, 0x0a, 0xae, 0x87, 0xc3, 0xad, 0x5a, 0xbd,
+	0x0c, 0xab, 0xc3, 0xcd, 0x14, 0x6d, 0xfc, 0xd7, 0x12, 0x5c, 0xb8, 0x85, 0xe9, 0xa9, 0x20, 0x55,
+	0x4f, 0xc2, 0x19, 0xb6, 0x95, 0xad, 0xa1, 0xe1, 0x1c, 0xe5, 0xea, 0x38, 0x96, 0xea, 0x0f, 0x25,
+	0x78, 0x6d, 0x88, 0x84, 0xe8, 0x3d, 0x35, 0xc8, 0x47, 0x7f, 0x3c, 0x13, 0xa9, 0x7d, 0xff, 0x45,
+	0x6d, 0x09, 0xb5, 0x69, 0xb1, 0x5e, 0xf5, 0x47, 0x19, 0x38, 0x7f, 0x0b, 0x27, 0x2d, 0x30, 0x4a,
+	0xd8, 0xe9, 0xd5, 0x76, 0xca, 0xd2, 0x30, 0x7e, 0xf2, 0xa5, 0xe1, 0x5d, 0x58, 0xb0, 0x11, 0xa1,
+	0xfa, 0x20, 0xf0, 0x65, 0x39, 0xf8, 0x14, 0xc6, 0xf3, 0x61, 0x1a, 0x00, 0x55, 0x98, 0x6a, 0x21,
+	0x8b, 0xea, 0x0e, 0x6e, 0x71, 0x41, 0x5e, 0xcc, 0x79, 0xad, 0xc8, 0x88, 0x1f, 0xe1, 0x16, 0x63,
+	0x55, 0x7f, 0x2b, 0xc1, 0x42, 0x7a, 0x4c, 0x44, 0x62, 0xae, 0x81, 0xd2, 0xe1, 0xd2, 0x3e, 0x22,
+	0x89, 0x21, 0x3c, 0x40, 0x79, 0xed, 0x4c, 0x6c, 0xf5, 0x36, 0x22, 0x91, 0xbc, 0xfc, 0x31, 0x14,
+	0x12, 0xc6, 0x10, 0x5d, 0xef, 0xa6, 0x76, 0x91, 0x8e, 0xbf, 0xd6, 0x86, 0x2f, 0x6a, 0xdc, 0x78,
+	0x6c, 0xf6, 0x9b, 0x94, 0x0f, 0xc4, 0x2f, 0xf5, 0x0f, 0x12, 0xbc, 0xc9, 0xdb, 0x43, 0x3f, 0x13,
+	0xf6, 0x6c, 0xcb, 0xe0, 0x65, 0xc5, 0xdf, 0x78, 0x4f, 0x2f, 0xb7, 0x5a, 0xa7, 0x43, 0x7d, 0xef,
+	0x48, 0x83, 0x1d, 0x3a, 0xca, 0x8f, 0xff, 0x87, 0xea, 0xa8, 0x6e, 0x08, 0x0c, 0x23, 0x58, 0xb9,
+	0x85, 0xa9, 0x00, 0x7c, 0x2c, 0x76, 0x07, 0x79, 0x9e, 0xe5, 0xd4, 0x8f, 0xe1, 0xec, 0x1c, 0xe4,
+	0xa3, 0xe6, 0x24, 0x5c, 0x9d, 0x14, 0xbd, 0x49, 0xdd, 0x02, 0xf5, 0xa8, 0x2b, 0x04, 0x2e, 0x96,
+	0xa0, 0x98, 0x44, 0x2b, 0xdc, 0x0c, 0x0a, 0x1a, 0xc4, 0xe1, 0x22, 0xea, 0x6f, 0x24, 0x38, 0xff,
+	0xbe, 0xeb, 0x1b, 0xf8, 0xbe, 0xc3, 0x5e, 0x95, 0x4e, 0xb2, 0x72, 0x1e, 0xbf, 0xda, 0xb2, 0x27,
+	0xae, 0x36, 0xf5, 0x3a, 0x2c, 0xa4, 0x9b, 0x9b, 0xfc, 0xfd, 0xa3, 0x85, 0x88, 0xce, 0x0e, 0xb1,
+	0x29, 0xa0, 0x5f, 0x68, 0x21, 0x72, 0x9b, 0x13, 0xd8, 0xeb, 0x5a, 0x25, 0x6c, 0xe2, 0x2f, 0xb1,
+	0xbf, 0x7c, 0xdc, 0x8f, 0xc1, 0x53, 0x2b, 0x2a, 0xf9, 0x22, 0xcc, 0xc4, 0xf3, 0x4a, 0x47, 0x26,
+	0xf3, 0x32, 0xc7, 0xb3, 0x3a, 0x15, 0x4d, 0xad, 0x0d, 0x46, 0x94, 0x2f, 0xc3, 0x6c, 0xc2, 0x17,
+	0x8e, 0x6d, 0xf6, 0xb9, 0x89, 0x71, 0xce, 0x44, 0x9c, 0xe1, 0x04, 0x35, 0xd5, 0x15, 0x58, 0x1a,
+	0x18, 0x14, 0x81, 0xe8, 0xdf, 0x4b, 0xb0, 0x12, 0xc1, 0xfd, 0x65, 0xc6, 0xee, 0x65, 0xd4, 0xef,
+	0x05, 0x50, 0x8f, 0x32, 0x3d, 0xf4, 0xf0, 0x86, 0xff, 0xf4, 0x59, 0x65, 0xec, 0xb3, 0x67, 0x95,
+	0xb1, 0xcf, 0x9f, 0x55, 0xa4, 0xef, 0x1d, 0x56, 0xa4, 0x5f, 0x1e, 0x56, 0xa4, 0x3f, 0x1e, 0x56,
+	0xa4, 0xa7, 0x87, 0x15, 0xe9, 0x2f, 0x87, 0x15, 0xe9, 0xaf, 0x87, 0x95, 0xb1, 0xcf, 0x0f, 0x2b,
+	0xd2, 0xa7, 0xcf, 0x2b, 0x63, 0x4f, 0x9f, 0x57, 0xc6, 0x3e, 0x7b, 0x5e, 0x19, 0x7b, 0xf8, 0xd5,
+	0xba, 0x9b, 0x98, 0x67, 0xb9, 0x47, 0xff, 0xcb, 0xd3, 0x57, 0x7a, 0x48, 0xb5, 0x09, 0xfe, 0xed,
+	0xee, 0x4b, 0xff, 0x1e, 0x00, 0x40, 0xc6, 0x4f, 0xd3, 0x33, 0x25, 0x00, 0x00,
 }
 
 func (this *PollWorkflowTaskQueueRequest) Equal(that interface{}) bool {
@@ -2874,6 +2884,9 @@ func (this *AddWorkflowTaskRequest) Equal(that interface{}) bool {
 	if !this.VersionDirective.Equal(that1.VersionDirective) {
 		return false
 	}
+	if this.Priority != that1.Priority {
+		return false
+	}
 	return true
 }
 func (this *AddWorkflowTaskResponse) Equal(that interface{}) bool {
@@ -3944,7 +3957,7 @@ func (this *AddWorkflowTaskRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 13)
+	s := make([]string, 0, 14)
 	s = append(s, "&matchingservice.AddWorkflowTaskRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.Execution != nil {
@@ -3963,6 +3976,7 @@ func (this *AddWorkflowTaskRequest) GoString() string {
 	if this.VersionDirective != nil {
 		s = append(s, "VersionDirective: "+fmt.Sprintf("%#v", this.VersionDirective)+",\n")
 	}
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -4901,6 +4915,16 @@ func (m *AddWorkflowTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Priority {
+		i--
+		if m.Priority {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
 	if m.VersionDirective != nil {
 		{
 			size, err := m.VersionDirective.MarshalToSizedBuffer(dAtA[:i])
@@ -6604,6 +6628,9 @@ func (m *AddWorkflowTaskRequest) Size() (n int) {
 		l = m.VersionDirective.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.Priority {
+		n += 2
+	}
 	return n
 }
 
@@ -7293,6 +7320,7 @@ func (this *AddWorkflowTaskRequest) String() string {
 		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
 		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v17.VectorClock", 1) + `,`,
 		`VersionDirective:` + strings.Replace(fmt.Sprintf("%v", this.VersionDirective), "TaskVersionDirective", "v18.TaskVersionDirective", 1) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -9620,6 +9648,26 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Priority = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
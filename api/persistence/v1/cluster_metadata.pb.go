@@ -65,6 +65,7 @@ type ClusterMetadata struct {
 	IsGlobalNamespaceEnabled bool                              `protobuf:"varint,9,opt,name=is_global_namespace_enabled,json=isGlobalNamespaceEnabled,proto3" json:"is_global_namespace_enabled,omitempty"`
 	IsConnectionEnabled      bool                              `protobuf:"varint,10,opt,name=is_connection_enabled,json=isConnectionEnabled,proto3" json:"is_connection_enabled,omitempty"`
 	UseClusterIdMembership   bool                              `protobuf:"varint,11,opt,name=use_cluster_id_membership,json=useClusterIdMembership,proto3" json:"use_cluster_id_membership,omitempty"`
+	RoleBindings             *RoleBindings                     `protobuf:"bytes,12,opt,name=role_bindings,json=roleBindings,proto3" json:"role_bindings,omitempty"`
 }
 
 func (m *ClusterMetadata) Reset()      { *m = ClusterMetadata{} }
@@ -176,6 +177,13 @@ func (m *ClusterMetadata) GetUseClusterIdMembership() bool {
 	return false
 }
 
+func (m *ClusterMetadata) GetRoleBindings() *RoleBindings {
+	if m != nil {
+		return m.RoleBindings
+	}
+	return nil
+}
+
 type IndexSearchAttributes struct {
 	CustomSearchAttributes map[string]v11.IndexedValueType `protobuf:"bytes,1,rep,name=custom_search_attributes,json=customSearchAttributes,proto3" json:"custom_search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=temporal.api.enums.v1.IndexedValueType"`
 }
@@ -219,11 +227,203 @@ func (m *IndexSearchAttributes) GetCustomSearchAttributes() map[string]v11.Index
 	return nil
 }
 
+// Named roles of the RBAC authorizer and the identity groups they are assigned to.
+type RoleBindings struct {
+	// role name -> permissions
+	Roles map[string]*RolePermissions `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// namespace name -> group roles. The empty namespace name holds the roles assigned cluster-wide, which apply
+	// to every namespace and to calls that don't target a namespace.
+	Namespaces map[string]*GroupRoleBindings `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *RoleBindings) Reset()      { *m = RoleBindings{} }
+func (*RoleBindings) ProtoMessage() {}
+func (*RoleBindings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f4771d63f405884, []int{2}
+}
+func (m *RoleBindings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleBindings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleBindings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleBindings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleBindings.Merge(m, src)
+}
+func (m *RoleBindings) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleBindings) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleBindings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleBindings proto.InternalMessageInfo
+
+func (m *RoleBindings) GetRoles() map[string]*RolePermissions {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *RoleBindings) GetNamespaces() map[string]*GroupRoleBindings {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+type RolePermissions struct {
+	Permissions []string `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+}
+
+func (m *RolePermissions) Reset()      { *m = RolePermissions{} }
+func (*RolePermissions) ProtoMessage() {}
+func (*RolePermissions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f4771d63f405884, []int{3}
+}
+func (m *RolePermissions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolePermissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RolePermissions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RolePermissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolePermissions.Merge(m, src)
+}
+func (m *RolePermissions) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolePermissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolePermissions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolePermissions proto.InternalMessageInfo
+
+func (m *RolePermissions) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+type GroupRoleBindings struct {
+	// group -> role names
+	Groups map[string]*RoleNames `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *GroupRoleBindings) Reset()      { *m = GroupRoleBindings{} }
+func (*GroupRoleBindings) ProtoMessage() {}
+func (*GroupRoleBindings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f4771d63f405884, []int{4}
+}
+func (m *GroupRoleBindings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupRoleBindings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupRoleBindings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GroupRoleBindings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupRoleBindings.Merge(m, src)
+}
+func (m *GroupRoleBindings) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupRoleBindings) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupRoleBindings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupRoleBindings proto.InternalMessageInfo
+
+func (m *GroupRoleBindings) GetGroups() map[string]*RoleNames {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+type RoleNames struct {
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (m *RoleNames) Reset()      { *m = RoleNames{} }
+func (*RoleNames) ProtoMessage() {}
+func (*RoleNames) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f4771d63f405884, []int{5}
+}
+func (m *RoleNames) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleNames) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleNames.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleNames) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleNames.Merge(m, src)
+}
+func (m *RoleNames) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleNames) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleNames.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleNames proto.InternalMessageInfo
+
+func (m *RoleNames) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ClusterMetadata)(nil), "temporal.server.api.persistence.v1.ClusterMetadata")
 	proto.RegisterMapType((map[string]*IndexSearchAttributes)(nil), "temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry")
 	proto.RegisterType((*IndexSearchAttributes)(nil), "temporal.server.api.persistence.v1.IndexSearchAttributes")
 	proto.RegisterMapType((map[string]v11.IndexedValueType)(nil), "temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry")
+	proto.RegisterType((*RoleBindings)(nil), "temporal.server.api.persistence.v1.RoleBindings")
+	proto.RegisterMapType((map[string]*GroupRoleBindings)(nil), "temporal.server.api.persistence.v1.RoleBindings.NamespacesEntry")
+	proto.RegisterMapType((map[string]*RolePermissions)(nil), "temporal.server.api.persistence.v1.RoleBindings.RolesEntry")
+	proto.RegisterType((*RolePermissions)(nil), "temporal.server.api.persistence.v1.RolePermissions")
+	proto.RegisterType((*GroupRoleBindings)(nil), "temporal.server.api.persistence.v1.GroupRoleBindings")
+	proto.RegisterMapType((map[string]*RoleNames)(nil), "temporal.server.api.persistence.v1.GroupRoleBindings.GroupsEntry")
+	proto.RegisterType((*RoleNames)(nil), "temporal.server.api.persistence.v1.RoleNames")
 }
 
 func init() {
@@ -231,49 +431,62 @@ func init() {
 }
 
 var fileDescriptor_1f4771d63f405884 = []byte{
-	// 670 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0x13, 0x41,
-	0x14, 0xef, 0x50, 0x41, 0x98, 0x12, 0xd0, 0x25, 0xe0, 0x58, 0xe2, 0xa6, 0x12, 0x0d, 0x3d, 0x6d,
-	0x43, 0xf5, 0x00, 0x2a, 0x07, 0x6c, 0x90, 0x70, 0x00, 0x93, 0xa2, 0x1c, 0xbc, 0x6c, 0xa6, 0xbb,
-	0x0f, 0x3a, 0xba, 0x3b, 0xb3, 0x99, 0x99, 0xdd, 0xd8, 0x9b, 0x89, 0x89, 0x57, 0xfd, 0x02, 0xde,
-	0x8d, 0x9f, 0xc4, 0x23, 0x47, 0x8e, 0x52, 0x2e, 0x1e, 0xf9, 0x08, 0x66, 0x67, 0x77, 0xdb, 0x42,
-	0x8a, 0x1a, 0x6f, 0x3b, 0xef, 0xf7, 0x67, 0xde, 0xfc, 0xe6, 0xed, 0xe0, 0x0d, 0x0d, 0x61, 0x24,
-	0x24, 0x0d, 0x1a, 0x0a, 0x64, 0x02, 0xb2, 0x41, 0x23, 0xd6, 0x88, 0x40, 0x2a, 0xa6, 0x34, 0x70,
-	0x0f, 0x1a, 0xc9, 0x5a, 0xc3, 0x0b, 0x62, 0xa5, 0x41, 0xba, 0x21, 0x68, 0xea, 0x53, 0x4d, 0x9d,
-	0x48, 0x0a, 0x2d, 0xac, 0x95, 0x42, 0xea, 0x64, 0x52, 0x87, 0x46, 0xcc, 0x19, 0x91, 0x3a, 0xc9,
-	0x5a, 0x75, 0xc0, 0x31, 0xbe, 0xc0, 0xe3, 0x50, 0x19, 0x47, 0x11, 0x86, 0x82, 0x67, 0x3e, 0xd5,
-	0x87, 0x97, 0x38, 0x49, 0x6a, 0x20, 0x78, 0xca, 0x0a, 0x41, 0x29, 0x7a, 0x0c, 0x19, 0x6d, 0xe5,
-	0xfb, 0x14, 0x9e, 0x6f, 0x65, 0x9d, 0xec, 0xe5, 0x8d, 0x58, 0xf7, 0xf1, 0x6c, 0xd1, 0x1c, 0xa7,
-	0x21, 0x10, 0x54, 0x43, 0xf5, 0x99, 0x76, 0x25, 0xaf, 0xed, 0xd3, 0x10, 0x2c, 0x07, 0x2f, 0x74,
-	0x99, 0xd2, 0x42, 0xf6, 0x5c, 0xd5, 0xa5, 0xd2, 0x77, 0x3d, 0x11, 0x73, 0x4d, 0x26, 0x6a, 0xa8,
-	0x3e, 0xd9, 0xbe, 0x9d, 0x43, 0x07, 0x29, 0xd2, 0x4a, 0x01, 0xeb, 0x1e, 0xc6, 0x85, 0x25, 0xf3,
-	0x49, 0xd9, 0x18, 0xce, 0xe4, 0x95, 0x5d, 0xdf, 0xda, 0xc1, 0xb3, 0x79, 0x87, 0x2e, 0xe3, 0x47,
-	0x82, 0xdc, 0xa8, 0xa1, 0x7a, 0xa5, 0xf9, 0xc0, 0x19, 0x64, 0x91, 0x86, 0x90, 0x33, 0x9c, 0x64,
-	0xcd, 0x39, 0xcc, 0x3e, 0x77, 0xf9, 0x91, 0x68, 0x57, 0x92, 0xe1, 0xc2, 0xfa, 0x84, 0xf0, 0x1d,
-	0xc6, 0x7d, 0x78, 0xef, 0x2a, 0xa0, 0xd2, 0xeb, 0xba, 0x54, 0x6b, 0xc9, 0x3a, 0xb1, 0x06, 0x45,
-	0x26, 0x6b, 0xe5, 0x7a, 0xa5, 0xb9, 0xef, 0xfc, 0x3d, 0x60, 0xe7, 0x4a, 0x22, 0xce, 0x6e, 0x6a,
-	0x79, 0x60, 0x1c, 0xb7, 0x06, 0x86, 0xdb, 0x5c, 0xcb, 0x5e, 0x7b, 0x91, 0x8d, 0xc3, 0xac, 0x55,
-	0x3c, 0x5f, 0x1c, 0x98, 0xfa, 0xbe, 0x04, 0xa5, 0xc8, 0x94, 0x39, 0xf5, 0x5c, 0x5e, 0xde, 0xca,
-	0xaa, 0xd6, 0x33, 0x5c, 0x3d, 0xa2, 0x2c, 0x10, 0x09, 0x48, 0x77, 0x98, 0x81, 0x27, 0x21, 0x04,
-	0xae, 0xc9, 0xcd, 0x1a, 0xaa, 0x97, 0xdb, 0xa4, 0x60, 0x0c, 0xce, 0x9d, 0xe3, 0xd6, 0x3a, 0x26,
-	0x8c, 0x33, 0xcd, 0x68, 0xe0, 0x5e, 0x75, 0x21, 0xd3, 0x46, 0xbb, 0x94, 0xe3, 0x2f, 0x2e, 0x5b,
-	0x58, 0x9b, 0x78, 0x99, 0x29, 0xf7, 0x38, 0x10, 0x1d, 0x1a, 0x98, 0x6b, 0x56, 0x11, 0xf5, 0xc0,
-	0x05, 0x4e, 0x3b, 0x01, 0xf8, 0x64, 0xa6, 0x86, 0xea, 0xd3, 0x6d, 0xc2, 0xd4, 0x8e, 0x61, 0xec,
-	0x17, 0x84, 0xed, 0x0c, 0xb7, 0x9a, 0x78, 0x91, 0x29, 0xd7, 0x13, 0x9c, 0x83, 0xa7, 0xd3, 0x9e,
-	0x0b, 0x21, 0x36, 0xc2, 0x05, 0xa6, 0x5a, 0x03, 0xac, 0xd0, 0x6c, 0xe0, 0xbb, 0xb1, 0x02, 0x77,
-	0x38, 0x08, 0x6e, 0x08, 0x61, 0x07, 0xa4, 0xea, 0xb2, 0x88, 0x54, 0x8c, 0x6e, 0x29, 0x56, 0xd0,
-	0x2a, 0xc6, 0x62, 0x6f, 0x80, 0x56, 0x3f, 0x22, 0x5c, 0xbd, 0xfe, 0x12, 0xac, 0x5b, 0xb8, 0xfc,
-	0x0e, 0x7a, 0xf9, 0xa0, 0xa6, 0x9f, 0xd6, 0x4b, 0x3c, 0x99, 0xd0, 0x20, 0x06, 0x33, 0x92, 0x95,
-	0xe6, 0xc6, 0xbf, 0xdc, 0xfa, 0xd8, 0x0d, 0xda, 0x99, 0xcf, 0x93, 0x89, 0x75, 0xb4, 0xf2, 0x75,
-	0x02, 0x2f, 0x8e, 0x25, 0x59, 0x9f, 0x11, 0x26, 0x5e, 0xac, 0xb4, 0x08, 0xc7, 0x0c, 0x1e, 0x32,
-	0x83, 0xf7, 0xfa, 0xbf, 0x5b, 0x70, 0x5a, 0xc6, 0x79, 0xfc, 0xfc, 0x2d, 0x79, 0x63, 0xc1, 0xaa,
-	0xc4, 0xcb, 0x7f, 0x90, 0x8d, 0x49, 0x6c, 0x73, 0x34, 0xb1, 0xb9, 0xe6, 0xea, 0xe5, 0x9f, 0xcf,
-	0x3c, 0x32, 0x83, 0x0e, 0xc1, 0x3f, 0x4c, 0xa9, 0xaf, 0x7a, 0x11, 0x8c, 0xe4, 0xf3, 0xfc, 0xed,
-	0xc9, 0x99, 0x5d, 0x3a, 0x3d, 0xb3, 0x4b, 0x17, 0x67, 0x36, 0xfa, 0xd0, 0xb7, 0xd1, 0xb7, 0xbe,
-	0x8d, 0x7e, 0xf4, 0x6d, 0x74, 0xd2, 0xb7, 0xd1, 0xcf, 0xbe, 0x8d, 0x7e, 0xf5, 0xed, 0xd2, 0x45,
-	0xdf, 0x46, 0x5f, 0xce, 0xed, 0xd2, 0xc9, 0xb9, 0x5d, 0x3a, 0x3d, 0xb7, 0x4b, 0x6f, 0x1e, 0x1f,
-	0x8b, 0xe1, 0x5e, 0x4c, 0x5c, 0xff, 0x64, 0x3e, 0x1d, 0x59, 0x76, 0xa6, 0xcc, 0xfb, 0xf5, 0xe8,
-	0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6e, 0x7d, 0x0e, 0x79, 0x6b, 0x05, 0x00, 0x00,
+	// 867 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xc4, 0x38, 0xad, 0x9f, 0x4d, 0x43, 0xa7, 0x24, 0x2c, 0xae, 0x58, 0xb9, 0x11, 0xa8,
+	0xbe, 0xb0, 0x26, 0x0e, 0x48, 0x0d, 0xa5, 0x12, 0xa9, 0x55, 0xa2, 0x08, 0x35, 0xc0, 0x96, 0x56,
+	0x82, 0xcb, 0x32, 0xde, 0x7d, 0xb1, 0x07, 0x76, 0x77, 0x56, 0x33, 0x6b, 0x8b, 0xdc, 0x40, 0x48,
+	0x5c, 0xe1, 0x1f, 0xe0, 0xde, 0x3f, 0x85, 0x63, 0x8e, 0x39, 0x12, 0xe7, 0xc2, 0xb1, 0x7f, 0x02,
+	0xda, 0xd9, 0x1f, 0x5e, 0x87, 0x2d, 0xb8, 0xb9, 0xed, 0xbc, 0x1f, 0xdf, 0xfb, 0xe6, 0x7b, 0xdf,
+	0x24, 0x86, 0xbd, 0x18, 0x83, 0x48, 0x48, 0xe6, 0xf7, 0x15, 0xca, 0x19, 0xca, 0x3e, 0x8b, 0x78,
+	0x3f, 0x42, 0xa9, 0xb8, 0x8a, 0x31, 0x74, 0xb1, 0x3f, 0xdb, 0xe9, 0xbb, 0xfe, 0x54, 0xc5, 0x28,
+	0x9d, 0x00, 0x63, 0xe6, 0xb1, 0x98, 0x59, 0x91, 0x14, 0xb1, 0xa0, 0xdb, 0x79, 0xab, 0x95, 0xb6,
+	0x5a, 0x2c, 0xe2, 0x56, 0xa9, 0xd5, 0x9a, 0xed, 0x74, 0x8a, 0x1a, 0x8d, 0x8b, 0xe1, 0x34, 0x50,
+	0x1a, 0x51, 0x04, 0x81, 0x08, 0x53, 0x9c, 0xce, 0x7b, 0x4b, 0x35, 0xb3, 0x04, 0x40, 0x84, 0x49,
+	0x55, 0x80, 0x4a, 0xb1, 0x31, 0xa6, 0x65, 0xdb, 0x3f, 0x5f, 0x83, 0x8d, 0x61, 0xca, 0xe4, 0x71,
+	0x46, 0x84, 0xde, 0x81, 0x76, 0x4e, 0x2e, 0x64, 0x01, 0x1a, 0xa4, 0x4b, 0x7a, 0x4d, 0xbb, 0x95,
+	0xc5, 0x8e, 0x58, 0x80, 0xd4, 0x82, 0x5b, 0x13, 0xae, 0x62, 0x21, 0x4f, 0x1c, 0x35, 0x61, 0xd2,
+	0x73, 0x5c, 0x31, 0x0d, 0x63, 0x63, 0xad, 0x4b, 0x7a, 0x0d, 0xfb, 0x66, 0x96, 0x7a, 0x92, 0x64,
+	0x86, 0x49, 0x82, 0xbe, 0x03, 0x90, 0x43, 0x72, 0xcf, 0xa8, 0x6b, 0xc0, 0x66, 0x16, 0x39, 0xf4,
+	0xe8, 0x01, 0xb4, 0x33, 0x86, 0x0e, 0x0f, 0x8f, 0x85, 0xf1, 0x5a, 0x97, 0xf4, 0x5a, 0x83, 0x77,
+	0xad, 0x42, 0x8b, 0x44, 0x84, 0xac, 0xc2, 0x9a, 0xed, 0x58, 0xcf, 0xd2, 0xcf, 0xc3, 0xf0, 0x58,
+	0xd8, 0xad, 0xd9, 0xe2, 0x40, 0x7f, 0x25, 0xf0, 0x16, 0x0f, 0x3d, 0xfc, 0xd1, 0x51, 0xc8, 0xa4,
+	0x3b, 0x71, 0x58, 0x1c, 0x4b, 0x3e, 0x9a, 0xc6, 0xa8, 0x8c, 0x46, 0xb7, 0xde, 0x6b, 0x0d, 0x8e,
+	0xac, 0xff, 0x17, 0xd8, 0xba, 0xa4, 0x88, 0x75, 0x98, 0x40, 0x3e, 0xd1, 0x88, 0xfb, 0x05, 0xe0,
+	0xa3, 0x30, 0x96, 0x27, 0xf6, 0x26, 0xaf, 0xca, 0xd1, 0xbb, 0xb0, 0x91, 0x5f, 0x98, 0x79, 0x9e,
+	0x44, 0xa5, 0x8c, 0x75, 0x7d, 0xeb, 0x1b, 0x59, 0x78, 0x3f, 0x8d, 0xd2, 0x4f, 0xa0, 0x73, 0xcc,
+	0xb8, 0x2f, 0x66, 0x28, 0x9d, 0x85, 0x06, 0xae, 0xc4, 0x00, 0xc3, 0xd8, 0xb8, 0xd6, 0x25, 0xbd,
+	0xba, 0x6d, 0xe4, 0x15, 0xc5, 0xbd, 0xb3, 0x3c, 0xbd, 0x07, 0x06, 0x0f, 0x79, 0xcc, 0x99, 0xef,
+	0x5c, 0x46, 0x31, 0xae, 0xeb, 0xde, 0xad, 0x2c, 0xff, 0xd9, 0x32, 0x04, 0x7d, 0x00, 0xb7, 0xb9,
+	0x72, 0xc6, 0xbe, 0x18, 0x31, 0x5f, 0xaf, 0x59, 0x45, 0xcc, 0x45, 0x07, 0x43, 0x36, 0xf2, 0xd1,
+	0x33, 0x9a, 0x5d, 0xd2, 0xbb, 0x6e, 0x1b, 0x5c, 0x1d, 0xe8, 0x8a, 0xa3, 0xbc, 0xe0, 0x51, 0x9a,
+	0xa7, 0x03, 0xd8, 0xe4, 0xca, 0x71, 0x45, 0x18, 0xa2, 0x1b, 0x27, 0x9c, 0xf3, 0x46, 0xd0, 0x8d,
+	0xb7, 0xb8, 0x1a, 0x16, 0xb9, 0xbc, 0x67, 0x0f, 0xde, 0x9e, 0x2a, 0x74, 0x16, 0x46, 0x70, 0x02,
+	0x0c, 0x46, 0x28, 0xd5, 0x84, 0x47, 0x46, 0x4b, 0xf7, 0x6d, 0x4d, 0x15, 0x0e, 0x73, 0x5b, 0x3c,
+	0x2e, 0xb2, 0xf4, 0x29, 0xbc, 0x2e, 0x85, 0x8f, 0xce, 0x88, 0x87, 0x1e, 0x0f, 0xc7, 0xca, 0x68,
+	0x6b, 0x87, 0x7c, 0xb0, 0xca, 0x32, 0x6d, 0xe1, 0xe3, 0xc3, 0xac, 0xcf, 0x6e, 0xcb, 0xd2, 0xa9,
+	0xf3, 0x0b, 0x81, 0xce, 0xcb, 0x77, 0x4b, 0xdf, 0x80, 0xfa, 0x0f, 0x78, 0x92, 0xf9, 0x3f, 0xf9,
+	0xa4, 0x5f, 0x40, 0x63, 0xc6, 0xfc, 0x29, 0x6a, 0xa7, 0xb7, 0x06, 0x7b, 0xab, 0xcc, 0xaf, 0x1c,
+	0x60, 0xa7, 0x38, 0x1f, 0xaf, 0xdd, 0x23, 0xdb, 0x7f, 0xac, 0xc1, 0x66, 0x65, 0x11, 0xfd, 0x8d,
+	0x80, 0xe1, 0x4e, 0x55, 0x2c, 0x82, 0x0a, 0x3f, 0x13, 0xed, 0xe7, 0xa7, 0x57, 0xa6, 0x60, 0x0d,
+	0x35, 0x72, 0xb5, 0xad, 0xb7, 0xdc, 0xca, 0x64, 0x47, 0xc2, 0xed, 0xff, 0x68, 0xab, 0x50, 0xec,
+	0x41, 0x59, 0xb1, 0x1b, 0x83, 0xbb, 0xcb, 0x6f, 0x5a, 0xff, 0xed, 0x2a, 0x18, 0xa2, 0xf7, 0x2c,
+	0x29, 0xfd, 0xfa, 0x24, 0xc2, 0xb2, 0x3e, 0xcf, 0xeb, 0xd0, 0x2e, 0x2f, 0x91, 0x7e, 0x05, 0x8d,
+	0x64, 0x8d, 0xb9, 0x04, 0xf7, 0x5f, 0xd5, 0x05, 0xfa, 0x90, 0x5d, 0x34, 0x45, 0xa2, 0xdf, 0x01,
+	0x14, 0x8f, 0x40, 0x19, 0x6b, 0x1a, 0xf7, 0xd3, 0x57, 0xc6, 0x2d, 0x9e, 0x49, 0x06, 0x5e, 0xc2,
+	0xec, 0x04, 0x00, 0x8b, 0xb1, 0x15, 0x42, 0x1d, 0x2e, 0x5b, 0x6b, 0x77, 0xd5, 0xe1, 0x5f, 0xa2,
+	0x0c, 0xb8, 0x4a, 0xde, 0x74, 0xd9, 0x54, 0x9d, 0x18, 0x36, 0x2e, 0xb1, 0xa9, 0x98, 0xf9, 0xf9,
+	0xf2, 0xcc, 0x8f, 0x56, 0x99, 0x79, 0x20, 0xc5, 0x34, 0x5a, 0x7a, 0x53, 0xa5, 0x55, 0xed, 0xc2,
+	0xc6, 0x25, 0x4e, 0xb4, 0x0b, 0xad, 0x68, 0x71, 0xd4, 0x2b, 0x6b, 0xda, 0xe5, 0xd0, 0xf6, 0x19,
+	0x81, 0x9b, 0xff, 0x42, 0xa5, 0xdf, 0xc0, 0xfa, 0x38, 0x09, 0xe6, 0x5b, 0xde, 0xbf, 0x12, 0xb9,
+	0x34, 0x92, 0xad, 0x23, 0x03, 0xec, 0x4c, 0xa0, 0x55, 0x0a, 0x57, 0xe8, 0x32, 0x5c, 0xd6, 0xe5,
+	0xfd, 0x55, 0x77, 0xa1, 0x15, 0x2f, 0xeb, 0x71, 0x07, 0x9a, 0x45, 0x9c, 0xbe, 0x09, 0x0d, 0xed,
+	0x87, 0x4c, 0x83, 0xf4, 0xf0, 0xf0, 0xfb, 0xd3, 0x73, 0xb3, 0x76, 0x76, 0x6e, 0xd6, 0x5e, 0x9c,
+	0x9b, 0xe4, 0xa7, 0xb9, 0x49, 0x9e, 0xcf, 0x4d, 0xf2, 0xe7, 0xdc, 0x24, 0xa7, 0x73, 0x93, 0xfc,
+	0x35, 0x37, 0xc9, 0xdf, 0x73, 0xb3, 0xf6, 0x62, 0x6e, 0x92, 0xdf, 0x2f, 0xcc, 0xda, 0xe9, 0x85,
+	0x59, 0x3b, 0xbb, 0x30, 0x6b, 0xdf, 0x7e, 0x38, 0x16, 0x0b, 0x52, 0x5c, 0xbc, 0xfc, 0x77, 0xc6,
+	0xfd, 0xd2, 0x71, 0xb4, 0xae, 0xff, 0xe9, 0xef, 0xfe, 0x33, 0x00, 0x2b, 0x1b, 0x22, 0xcc, 0xa0,
+	0x08, 0x00, 0x00,
 }
 
 func (this *ClusterMetadata) Equal(that interface{}) bool {
@@ -333,6 +546,9 @@ func (this *ClusterMetadata) Equal(that interface{}) bool {
 	if this.UseClusterIdMembership != that1.UseClusterIdMembership {
 		return false
 	}
+	if !this.RoleBindings.Equal(that1.RoleBindings) {
+		return false
+	}
 	return true
 }
 func (this *IndexSearchAttributes) Equal(that interface{}) bool {
@@ -364,11 +580,135 @@ func (this *IndexSearchAttributes) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RoleBindings) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RoleBindings)
+	if !ok {
+		that2, ok := that.(RoleBindings)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Roles) != len(that1.Roles) {
+		return false
+	}
+	for i := range this.Roles {
+		if !this.Roles[i].Equal(that1.Roles[i]) {
+			return false
+		}
+	}
+	if len(this.Namespaces) != len(that1.Namespaces) {
+		return false
+	}
+	for i := range this.Namespaces {
+		if !this.Namespaces[i].Equal(that1.Namespaces[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RolePermissions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RolePermissions)
+	if !ok {
+		that2, ok := that.(RolePermissions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Permissions) != len(that1.Permissions) {
+		return false
+	}
+	for i := range this.Permissions {
+		if this.Permissions[i] != that1.Permissions[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *GroupRoleBindings) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GroupRoleBindings)
+	if !ok {
+		that2, ok := that.(GroupRoleBindings)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Groups) != len(that1.Groups) {
+		return false
+	}
+	for i := range this.Groups {
+		if !this.Groups[i].Equal(that1.Groups[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RoleNames) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RoleNames)
+	if !ok {
+		that2, ok := that.(RoleNames)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Names) != len(that1.Names) {
+		return false
+	}
+	for i := range this.Names {
+		if this.Names[i] != that1.Names[i] {
+			return false
+		}
+	}
+	return true
+}
 func (this *ClusterMetadata) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 15)
+	s := make([]string, 0, 16)
 	s = append(s, "&persistence.ClusterMetadata{")
 	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
 	s = append(s, "HistoryShardCount: "+fmt.Sprintf("%#v", this.HistoryShardCount)+",\n")
@@ -395,6 +735,9 @@ func (this *ClusterMetadata) GoString() string {
 	s = append(s, "IsGlobalNamespaceEnabled: "+fmt.Sprintf("%#v", this.IsGlobalNamespaceEnabled)+",\n")
 	s = append(s, "IsConnectionEnabled: "+fmt.Sprintf("%#v", this.IsConnectionEnabled)+",\n")
 	s = append(s, "UseClusterIdMembership: "+fmt.Sprintf("%#v", this.UseClusterIdMembership)+",\n")
+	if this.RoleBindings != nil {
+		s = append(s, "RoleBindings: "+fmt.Sprintf("%#v", this.RoleBindings)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -420,6 +763,83 @@ func (this *IndexSearchAttributes) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *RoleBindings) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&persistence.RoleBindings{")
+	keysForRoles := make([]string, 0, len(this.Roles))
+	for k, _ := range this.Roles {
+		keysForRoles = append(keysForRoles, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRoles)
+	mapStringForRoles := "map[string]*RolePermissions{"
+	for _, k := range keysForRoles {
+		mapStringForRoles += fmt.Sprintf("%#v: %#v,", k, this.Roles[k])
+	}
+	mapStringForRoles += "}"
+	if this.Roles != nil {
+		s = append(s, "Roles: "+mapStringForRoles+",\n")
+	}
+	keysForNamespaces := make([]string, 0, len(this.Namespaces))
+	for k, _ := range this.Namespaces {
+		keysForNamespaces = append(keysForNamespaces, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNamespaces)
+	mapStringForNamespaces := "map[string]*GroupRoleBindings{"
+	for _, k := range keysForNamespaces {
+		mapStringForNamespaces += fmt.Sprintf("%#v: %#v,", k, this.Namespaces[k])
+	}
+	mapStringForNamespaces += "}"
+	if this.Namespaces != nil {
+		s = append(s, "Namespaces: "+mapStringForNamespaces+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RolePermissions) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&persistence.RolePermissions{")
+	s = append(s, "Permissions: "+fmt.Sprintf("%#v", this.Permissions)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GroupRoleBindings) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&persistence.GroupRoleBindings{")
+	keysForGroups := make([]string, 0, len(this.Groups))
+	for k, _ := range this.Groups {
+		keysForGroups = append(keysForGroups, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForGroups)
+	mapStringForGroups := "map[string]*RoleNames{"
+	for _, k := range keysForGroups {
+		mapStringForGroups += fmt.Sprintf("%#v: %#v,", k, this.Groups[k])
+	}
+	mapStringForGroups += "}"
+	if this.Groups != nil {
+		s = append(s, "Groups: "+mapStringForGroups+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RoleNames) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&persistence.RoleNames{")
+	s = append(s, "Names: "+fmt.Sprintf("%#v", this.Names)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringClusterMetadata(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -448,12 +868,24 @@ func (m *ClusterMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.UseClusterIdMembership {
-		i--
-		if m.UseClusterIdMembership {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.RoleBindings != nil {
+		{
+			size, err := m.RoleBindings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.UseClusterIdMembership {
+		i--
+		if m.UseClusterIdMembership {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
 		dAtA[i] = 0x58
@@ -595,6 +1027,194 @@ func (m *IndexSearchAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RoleBindings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleBindings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleBindings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespaces) > 0 {
+		for k := range m.Namespaces {
+			v := m.Namespaces[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintClusterMetadata(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Roles) > 0 {
+		for k := range m.Roles {
+			v := m.Roles[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintClusterMetadata(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RolePermissions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolePermissions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolePermissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permissions[iNdEx])
+			copy(dAtA[i:], m.Permissions[iNdEx])
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.Permissions[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupRoleBindings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupRoleBindings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupRoleBindings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for k := range m.Groups {
+			v := m.Groups[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintClusterMetadata(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoleNames) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleNames) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleNames) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintClusterMetadata(dAtA []byte, offset int, v uint64) int {
 	offset -= sovClusterMetadata(v)
 	base := offset
@@ -659,6 +1279,10 @@ func (m *ClusterMetadata) Size() (n int) {
 	if m.UseClusterIdMembership {
 		n += 2
 	}
+	if m.RoleBindings != nil {
+		l = m.RoleBindings.Size()
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
 	return n
 }
 
@@ -679,6 +1303,93 @@ func (m *IndexSearchAttributes) Size() (n int) {
 	return n
 }
 
+func (m *RoleBindings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for k, v := range m.Roles {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovClusterMetadata(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovClusterMetadata(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovClusterMetadata(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for k, v := range m.Namespaces {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovClusterMetadata(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovClusterMetadata(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovClusterMetadata(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *RolePermissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovClusterMetadata(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GroupRoleBindings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for k, v := range m.Groups {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovClusterMetadata(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovClusterMetadata(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovClusterMetadata(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *RoleNames) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovClusterMetadata(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovClusterMetadata(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -711,6 +1422,7 @@ func (this *ClusterMetadata) String() string {
 		`IsGlobalNamespaceEnabled:` + fmt.Sprintf("%v", this.IsGlobalNamespaceEnabled) + `,`,
 		`IsConnectionEnabled:` + fmt.Sprintf("%v", this.IsConnectionEnabled) + `,`,
 		`UseClusterIdMembership:` + fmt.Sprintf("%v", this.UseClusterIdMembership) + `,`,
+		`RoleBindings:` + strings.Replace(this.RoleBindings.String(), "RoleBindings", "RoleBindings", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -735,19 +1447,90 @@ func (this *IndexSearchAttributes) String() string {
 	}, "")
 	return s
 }
-func valueToStringClusterMetadata(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *RoleBindings) String() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	keysForRoles := make([]string, 0, len(this.Roles))
+	for k, _ := range this.Roles {
+		keysForRoles = append(keysForRoles, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRoles)
+	mapStringForRoles := "map[string]*RolePermissions{"
+	for _, k := range keysForRoles {
+		mapStringForRoles += fmt.Sprintf("%v: %v,", k, this.Roles[k])
+	}
+	mapStringForRoles += "}"
+	keysForNamespaces := make([]string, 0, len(this.Namespaces))
+	for k, _ := range this.Namespaces {
+		keysForNamespaces = append(keysForNamespaces, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNamespaces)
+	mapStringForNamespaces := "map[string]*GroupRoleBindings{"
+	for _, k := range keysForNamespaces {
+		mapStringForNamespaces += fmt.Sprintf("%v: %v,", k, this.Namespaces[k])
+	}
+	mapStringForNamespaces += "}"
+	s := strings.Join([]string{`&RoleBindings{`,
+		`Roles:` + mapStringForRoles + `,`,
+		`Namespaces:` + mapStringForNamespaces + `,`,
+		`}`,
+	}, "")
+	return s
 }
-func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+func (this *RolePermissions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolePermissions{`,
+		`Permissions:` + fmt.Sprintf("%v", this.Permissions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GroupRoleBindings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForGroups := make([]string, 0, len(this.Groups))
+	for k, _ := range this.Groups {
+		keysForGroups = append(keysForGroups, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForGroups)
+	mapStringForGroups := "map[string]*RoleNames{"
+	for _, k := range keysForGroups {
+		mapStringForGroups += fmt.Sprintf("%v: %v,", k, this.Groups[k])
+	}
+	mapStringForGroups += "}"
+	s := strings.Join([]string{`&GroupRoleBindings{`,
+		`Groups:` + mapStringForGroups + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RoleNames) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RoleNames{`,
+		`Names:` + fmt.Sprintf("%v", this.Names) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringClusterMetadata(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -1150,6 +1933,42 @@ func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.UseClusterIdMembership = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleBindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RoleBindings == nil {
+				m.RoleBindings = &RoleBindings{}
+			}
+			if err := m.RoleBindings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
@@ -1340,6 +2159,669 @@ func (m *IndexSearchAttributes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RoleBindings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleBindings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleBindings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Roles == nil {
+				m.Roles = make(map[string]*RolePermissions)
+			}
+			var mapkey string
+			var mapvalue *RolePermissions
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClusterMetadata
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClusterMetadata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClusterMetadata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &RolePermissions{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Roles[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Namespaces == nil {
+				m.Namespaces = make(map[string]*GroupRoleBindings)
+			}
+			var mapkey string
+			var mapvalue *GroupRoleBindings
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClusterMetadata
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClusterMetadata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClusterMetadata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &GroupRoleBindings{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Namespaces[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolePermissions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolePermissions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolePermissions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupRoleBindings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupRoleBindings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupRoleBindings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Groups == nil {
+				m.Groups = make(map[string]*RoleNames)
+			}
+			var mapkey string
+			var mapvalue *RoleNames
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClusterMetadata
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClusterMetadata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClusterMetadata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &RoleNames{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Groups[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleNames) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleNames: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleNames: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipClusterMetadata(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
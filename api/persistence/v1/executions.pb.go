@@ -899,6 +899,7 @@ type TransferTaskInfo struct {
 	//     aip.dev/not-precedent: "after" is used to indicate sequence of actions. --)
 	DeleteAfterClose bool `protobuf:"varint,15,opt,name=delete_after_close,json=deleteAfterClose,proto3" json:"delete_after_close,omitempty"`
 	// Types that are valid to be assigned to TaskDetails:
+	//
 	//	*TransferTaskInfo_CloseExecutionTaskDetails_
 	TaskDetails isTransferTaskInfo_TaskDetails `protobuf_oneof:"task_details"`
 }
@@ -1386,6 +1387,8 @@ type TimerTaskInfo struct {
 	BranchToken         []byte                 `protobuf:"bytes,12,opt,name=branch_token,json=branchToken,proto3" json:"branch_token,omitempty"`
 	// If this is true, we can bypass archival before deleting. Only defined for DeleteHistoryEventTasks.
 	AlreadyArchived bool `protobuf:"varint,13,opt,name=already_archived,json=alreadyArchived,proto3" json:"already_archived,omitempty"`
+	// Only defined for DeleteVisibilityRecordTasks.
+	CloseTime *time.Time `protobuf:"bytes,14,opt,name=close_time,json=closeTime,proto3,stdtime" json:"close_time,omitempty"`
 }
 
 func (m *TimerTaskInfo) Reset()      { *m = TimerTaskInfo{} }
@@ -1511,6 +1514,13 @@ func (m *TimerTaskInfo) GetAlreadyArchived() bool {
 	return false
 }
 
+func (m *TimerTaskInfo) GetCloseTime() *time.Time {
+	if m != nil {
+		return m.CloseTime
+	}
+	return nil
+}
+
 type ArchivalTaskInfo struct {
 	TaskId         int64       `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	NamespaceId    string      `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -2319,242 +2329,242 @@ func init() {
 }
 
 var fileDescriptor_67a714d0e7ba9f37 = []byte{
-	// 3751 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3b, 0xcd, 0x73, 0xdb, 0xc6,
-	0x77, 0xa6, 0x05, 0x49, 0xe0, 0x23, 0x45, 0x41, 0xd0, 0x17, 0xa4, 0xc8, 0x94, 0xcc, 0xd8, 0xfe,
-	0xc9, 0x8e, 0x4d, 0xc5, 0xb2, 0x53, 0xe7, 0x97, 0xb4, 0x71, 0x25, 0x5a, 0xb6, 0xc9, 0x38, 0xb6,
-	0x03, 0x29, 0x1f, 0x93, 0x26, 0xc3, 0x81, 0x80, 0x95, 0x84, 0x8a, 0x04, 0x68, 0x2c, 0x28, 0x99,
-	0x99, 0x1e, 0x72, 0xe8, 0xf4, 0x9c, 0xde, 0xfa, 0x27, 0xf4, 0xd8, 0x4b, 0x6f, 0x3d, 0xf4, 0xd0,
-	0x43, 0x4f, 0x9d, 0xdc, 0x9a, 0x5b, 0x1b, 0xe7, 0xd2, 0x4b, 0x27, 0x99, 0x1e, 0x7a, 0xee, 0xec,
-	0xdb, 0x5d, 0x10, 0x00, 0x21, 0x89, 0x72, 0xe3, 0x43, 0x6e, 0xe2, 0xbe, 0xcf, 0xdd, 0x7d, 0xfb,
-	0x3e, 0x21, 0xb8, 0x13, 0x92, 0x76, 0xc7, 0x0f, 0xac, 0xd6, 0x1a, 0x25, 0xc1, 0x11, 0x09, 0xd6,
-	0xac, 0x8e, 0xbb, 0xd6, 0x21, 0x01, 0x75, 0x69, 0x48, 0x3c, 0x9b, 0xac, 0x1d, 0xdd, 0x5e, 0x23,
-	0x2f, 0x89, 0xdd, 0x0d, 0x5d, 0xdf, 0xa3, 0xd5, 0x4e, 0xe0, 0x87, 0xbe, 0x5e, 0x91, 0x44, 0x55,
-	0x4e, 0x54, 0xb5, 0x3a, 0x6e, 0x35, 0x46, 0x54, 0x3d, 0xba, 0xbd, 0x58, 0xde, 0xf7, 0xfd, 0xfd,
-	0x16, 0x59, 0x43, 0x8a, 0xdd, 0xee, 0xde, 0x9a, 0xd3, 0x0d, 0x2c, 0xc6, 0x84, 0xf3, 0x58, 0x5c,
-	0x4e, 0xc3, 0x43, 0xb7, 0x4d, 0x68, 0x68, 0xb5, 0x3b, 0x02, 0xe1, 0xb2, 0x43, 0x3a, 0xc4, 0x73,
-	0x88, 0x67, 0xbb, 0x84, 0xae, 0xed, 0xfb, 0xfb, 0x3e, 0xae, 0xe3, 0x5f, 0x02, 0xe5, 0x4a, 0xa4,
-	0x3c, 0xd3, 0xda, 0xf6, 0xdb, 0x6d, 0xdf, 0x63, 0x0a, 0xb7, 0x09, 0xa5, 0xd6, 0x3e, 0xc9, 0xc4,
-	0x22, 0x5e, 0xb7, 0x4d, 0x19, 0xd2, 0xb1, 0x1f, 0x1c, 0xee, 0xb5, 0xfc, 0x63, 0x81, 0x75, 0x35,
-	0x81, 0xb5, 0x67, 0xb9, 0xad, 0x6e, 0x40, 0x06, 0x99, 0x5d, 0x4b, 0xa0, 0x49, 0x1e, 0x83, 0x78,
-	0x37, 0xb2, 0xce, 0xd5, 0x6e, 0xf9, 0xf6, 0xe1, 0x20, 0xee, 0xf5, 0x2c, 0xdc, 0x48, 0x4f, 0xbe,
-	0x2d, 0x81, 0xfa, 0xce, 0xa9, 0xa8, 0xa9, 0x2d, 0xfd, 0xe1, 0x54, 0xe4, 0xd0, 0xa2, 0x87, 0x02,
-	0xf1, 0xbd, 0xa1, 0xb8, 0x36, 0x19, 0x45, 0x33, 0xec, 0x75, 0xa4, 0xde, 0x37, 0xb3, 0xc8, 0x0e,
-	0x5c, 0x1a, 0xfa, 0x41, 0x6f, 0x70, 0x97, 0x6b, 0x43, 0x58, 0xda, 0x8b, 0x2e, 0xe9, 0x12, 0x7a,
-	0xda, 0x5e, 0xbb, 0x1d, 0xc7, 0x0a, 0x33, 0xee, 0xe5, 0x56, 0x16, 0xf2, 0x89, 0xd7, 0x53, 0xf9,
-	0x9b, 0x71, 0xc8, 0x6f, 0x1f, 0x58, 0x81, 0x53, 0xf7, 0xf6, 0x7c, 0x7d, 0x01, 0x54, 0xca, 0x7e,
-	0x34, 0x5d, 0xc7, 0xc8, 0xad, 0xe4, 0x56, 0x47, 0xcd, 0x71, 0xfc, 0x5d, 0x77, 0x18, 0x28, 0xb0,
-	0xbc, 0x7d, 0xc2, 0x40, 0x17, 0x57, 0x72, 0xab, 0x23, 0xe6, 0x38, 0xfe, 0xae, 0x3b, 0xfa, 0x0c,
-	0x8c, 0xfa, 0xc7, 0x1e, 0x09, 0x8c, 0x91, 0x95, 0xdc, 0x6a, 0xde, 0xe4, 0x3f, 0xf4, 0x9b, 0xa0,
-	0xd3, 0xd0, 0x6f, 0x11, 0xaf, 0x49, 0x5d, 0xcf, 0x26, 0xcd, 0x80, 0x78, 0xe4, 0xd8, 0x18, 0x43,
-	0xae, 0x1a, 0x87, 0x6c, 0x33, 0x80, 0xc9, 0xd6, 0xf5, 0x0d, 0x28, 0xf0, 0x1d, 0x35, 0x99, 0xf9,
-	0x1b, 0xe3, 0x2b, 0xb9, 0xd5, 0xc2, 0xfa, 0x62, 0x95, 0xbf, 0x8d, 0xaa, 0x7c, 0x1b, 0xd5, 0x1d,
-	0xf9, 0x36, 0x36, 0x95, 0xef, 0xff, 0x63, 0x39, 0x67, 0x02, 0x27, 0x62, 0xcb, 0xfa, 0x5f, 0xe7,
-	0x60, 0x21, 0x20, 0x9d, 0x96, 0x6b, 0xe3, 0xf3, 0x6a, 0x3a, 0xad, 0x17, 0x4d, 0xcb, 0x3e, 0x6c,
-	0xb6, 0xc8, 0x11, 0x69, 0x19, 0x13, 0x2b, 0x23, 0xab, 0x85, 0xf5, 0x7a, 0xf5, 0xec, 0x17, 0x5b,
-	0x8d, 0xce, 0xa3, 0x6a, 0xf6, 0xd9, 0x3d, 0x68, 0xbd, 0xd8, 0xb0, 0x0f, 0x9f, 0x30, 0x5e, 0x5b,
-	0x5e, 0x18, 0xf4, 0xcc, 0xb9, 0x20, 0x13, 0xa8, 0x1f, 0x82, 0x86, 0xb7, 0xd7, 0x97, 0x4d, 0x0d,
-	0x0d, 0x85, 0x6f, 0x9c, 0x4f, 0xf8, 0xa7, 0x8c, 0x8b, 0x64, 0x4b, 0xb9, 0xd0, 0xd2, 0x8b, 0xc4,
-	0xa2, 0x6e, 0x41, 0x91, 0x0b, 0xa3, 0xa1, 0x15, 0x12, 0x6a, 0x4c, 0xa1, 0xa0, 0x8f, 0x5e, 0x43,
-	0xd0, 0x36, 0x32, 0xe0, 0x52, 0x0a, 0x2f, 0xfa, 0x2b, 0x8b, 0x75, 0x78, 0xeb, 0x94, 0x63, 0xd0,
-	0x35, 0x18, 0x39, 0x24, 0x3d, 0xb4, 0x96, 0xbc, 0xc9, 0xfe, 0x64, 0xe6, 0x70, 0x64, 0xb5, 0xba,
-	0x44, 0x98, 0x09, 0xff, 0xf1, 0xc1, 0xc5, 0xf7, 0x73, 0x8b, 0x21, 0x4c, 0x67, 0x6c, 0x2a, 0xce,
-	0x62, 0x94, 0xb3, 0x78, 0x14, 0x67, 0x51, 0x58, 0xbf, 0x3d, 0xcc, 0x7e, 0x12, 0x9c, 0xe3, 0x52,
-	0x3d, 0xd0, 0xd2, 0x3b, 0xcc, 0x10, 0xf9, 0x20, 0x29, 0xb2, 0x3a, 0xb4, 0x48, 0x64, 0x1b, 0x93,
-	0xd7, 0x50, 0x54, 0x45, 0x1b, 0x6d, 0x28, 0xea, 0xa8, 0x36, 0xd6, 0x50, 0x54, 0x55, 0xcb, 0x37,
-	0x14, 0x35, 0xaf, 0x41, 0x43, 0x51, 0x41, 0x2b, 0x34, 0x14, 0xb5, 0xa0, 0x15, 0x1b, 0x8a, 0x5a,
-	0xd4, 0x26, 0x1a, 0x8a, 0x5a, 0xd2, 0x26, 0x1b, 0x8a, 0x3a, 0xa9, 0x69, 0x95, 0xff, 0xbd, 0x06,
-	0xb3, 0x5f, 0x88, 0x67, 0xba, 0x25, 0xe3, 0x0c, 0x3e, 0xca, 0xcb, 0x50, 0xf4, 0xac, 0x36, 0xa1,
-	0x1d, 0xcb, 0x26, 0xf2, 0x61, 0xe6, 0xcd, 0x42, 0xb4, 0x56, 0x77, 0xf4, 0x65, 0x28, 0x44, 0xce,
-	0x49, 0xbc, 0xcf, 0xbc, 0x09, 0x72, 0xa9, 0xee, 0xe8, 0x55, 0x98, 0xee, 0x58, 0x01, 0xf1, 0xc2,
-	0x66, 0x82, 0x15, 0x7f, 0xb0, 0x53, 0x1c, 0xf4, 0x34, 0xc6, 0xf0, 0x26, 0xe8, 0x02, 0x3f, 0xce,
-	0x57, 0x41, 0x74, 0x8d, 0x43, 0xbe, 0xe8, 0x73, 0xaf, 0xc0, 0x84, 0xc0, 0x0e, 0xba, 0x1e, 0x43,
-	0x1c, 0xe5, 0x2a, 0xf2, 0x45, 0xb3, 0xeb, 0x25, 0x34, 0x70, 0x3d, 0x37, 0x74, 0xad, 0x90, 0xa0,
-	0x97, 0x19, 0x43, 0x1b, 0x11, 0x1a, 0xd4, 0x25, 0xa4, 0xee, 0xe8, 0x7f, 0x84, 0x05, 0xdb, 0x6f,
-	0x77, 0x5a, 0x04, 0xdf, 0x32, 0x39, 0x62, 0x94, 0xbb, 0x56, 0x68, 0x1f, 0x30, 0xaa, 0x71, 0xa4,
-	0x9a, 0xeb, 0x23, 0x6c, 0x31, 0xf8, 0x26, 0x03, 0xd7, 0x1d, 0xfd, 0x12, 0x00, 0x7a, 0x68, 0xb4,
-	0x62, 0x23, 0x8f, 0xba, 0xe4, 0xd9, 0x0a, 0xde, 0x17, 0xdb, 0x5b, 0xdf, 0x93, 0xf7, 0x3a, 0x04,
-	0x8f, 0xc4, 0x00, 0xbe, 0x37, 0x09, 0xd9, 0xe9, 0x75, 0x08, 0x3b, 0x10, 0xfd, 0x1b, 0x58, 0x8c,
-	0xb0, 0xa3, 0xf8, 0x8f, 0x4e, 0xca, 0xef, 0x86, 0x46, 0x01, 0x8d, 0x65, 0x61, 0xc0, 0x4f, 0x3d,
-	0x10, 0x31, 0x7e, 0x53, 0xf9, 0x3b, 0xe6, 0xa6, 0x8c, 0xe3, 0xf4, 0xcd, 0xee, 0x70, 0x06, 0xfa,
-	0xa7, 0x30, 0x13, 0xb1, 0x67, 0x87, 0x27, 0x19, 0x17, 0x87, 0x63, 0x1c, 0xed, 0xc4, 0xec, 0x46,
-	0x2c, 0x77, 0xe1, 0x92, 0x43, 0xf6, 0xac, 0x6e, 0x2b, 0x76, 0x79, 0x3c, 0x62, 0x09, 0xde, 0x13,
-	0xc3, 0xf1, 0x5e, 0x14, 0x5c, 0xe4, 0x45, 0xef, 0x58, 0xf4, 0x50, 0xca, 0x78, 0x07, 0xf4, 0x96,
-	0x45, 0x43, 0x71, 0x2f, 0xc8, 0xdd, 0x75, 0x8c, 0x29, 0xbc, 0x96, 0x49, 0x06, 0xc1, 0x0b, 0x61,
-	0x14, 0x75, 0x47, 0xbf, 0x05, 0xd3, 0x88, 0xbc, 0xe7, 0x06, 0x11, 0x89, 0xeb, 0x18, 0x3a, 0x62,
-	0x6b, 0x0c, 0xf4, 0x90, 0x41, 0x90, 0xa4, 0xee, 0xe8, 0x1f, 0xc3, 0xdb, 0x88, 0x9e, 0x54, 0x9e,
-	0x86, 0x56, 0xc0, 0x6c, 0x26, 0x22, 0x9f, 0x46, 0xf2, 0x32, 0x43, 0x8d, 0x6b, 0xb8, 0xcd, 0xf1,
-	0x24, 0xb3, 0xfb, 0x00, 0x48, 0xc9, 0xc3, 0xca, 0xcc, 0x90, 0x61, 0x25, 0x8f, 0x34, 0x18, 0x55,
-	0x1a, 0x80, 0x1a, 0x36, 0xe3, 0xd1, 0x69, 0x76, 0x48, 0x36, 0x25, 0x46, 0xf9, 0x59, 0x3f, 0x42,
-	0xad, 0xc3, 0x6c, 0x72, 0x53, 0x47, 0xcc, 0x9f, 0xf8, 0x9e, 0x31, 0x87, 0x7b, 0x99, 0x3e, 0x8e,
-	0xed, 0xe3, 0x73, 0x0e, 0xd2, 0x1f, 0xc2, 0x4a, 0xea, 0x20, 0xec, 0x03, 0xe2, 0x74, 0x5b, 0xf1,
-	0xa3, 0x98, 0x47, 0xf2, 0xa5, 0x38, 0xf9, 0xb6, 0xc4, 0x92, 0x07, 0xb1, 0x09, 0xe5, 0x33, 0x0e,
-	0xd4, 0x40, 0x2e, 0x8b, 0xc7, 0x27, 0x1f, 0xe6, 0x76, 0x5a, 0x7f, 0x69, 0x51, 0x0b, 0xc3, 0x59,
-	0x54, 0x62, 0x83, 0xd2, 0x94, 0x06, 0x0e, 0xc5, 0x0a, 0x99, 0xeb, 0x0d, 0x8d, 0x45, 0x74, 0xce,
-	0x09, 0x9a, 0x0d, 0x0e, 0x4a, 0x3c, 0xca, 0xc4, 0x66, 0xf0, 0x7a, 0xde, 0x1a, 0xf2, 0x7a, 0xe6,
-	0x33, 0xb6, 0x8a, 0xf7, 0x64, 0xc1, 0xd2, 0x49, 0x67, 0x8e, 0x02, 0x96, 0x86, 0x14, 0xb0, 0x90,
-	0x79, 0x23, 0x28, 0x22, 0x80, 0xab, 0x49, 0x11, 0x7e, 0xe0, 0xee, 0xbb, 0x9e, 0xd5, 0x4a, 0xcb,
-	0x2a, 0x0f, 0x29, 0xeb, 0x72, 0x5c, 0xd6, 0x33, 0xc1, 0x2c, 0x29, 0xf3, 0x1e, 0x18, 0x49, 0x99,
-	0x01, 0x79, 0xd1, 0x25, 0x14, 0x2f, 0x7f, 0x19, 0xdd, 0xdf, 0x6c, 0x9c, 0x89, 0xc9, 0xa1, 0x75,
-	0x47, 0xff, 0x3a, 0xee, 0x31, 0x65, 0xee, 0x6b, 0x3c, 0x58, 0xc9, 0xad, 0x96, 0x4e, 0x08, 0x94,
-	0x98, 0x33, 0xb3, 0x10, 0x99, 0x70, 0x1e, 0xbd, 0x0e, 0x89, 0x79, 0x58, 0xb1, 0xa2, 0x3f, 0x4b,
-	0x1f, 0x05, 0xed, 0xee, 0xef, 0x33, 0xb5, 0x6c, 0xdf, 0x0b, 0x5d, 0x8f, 0x65, 0x52, 0xb4, 0xc9,
-	0x72, 0xc7, 0xad, 0x95, 0xdc, 0xaa, 0x6a, 0xae, 0x24, 0x0e, 0x95, 0xa3, 0xd6, 0x04, 0xe6, 0x06,
-	0x7d, 0x4a, 0x8e, 0x07, 0x9f, 0x8c, 0x48, 0xc5, 0x9b, 0xd4, 0xfd, 0x96, 0x34, 0x77, 0x7b, 0x2c,
-	0x51, 0x7a, 0x38, 0xf8, 0x64, 0x1e, 0x73, 0xac, 0x6d, 0xf7, 0x5b, 0xb2, 0xc9, 0x70, 0xf4, 0xeb,
-	0xa0, 0xd9, 0x96, 0x67, 0x93, 0x96, 0x3c, 0x28, 0xe2, 0x18, 0x97, 0x50, 0x87, 0x49, 0xbe, 0x6e,
-	0xca, 0x65, 0xfd, 0x06, 0x4c, 0x25, 0x51, 0xd9, 0x99, 0xae, 0xe0, 0x99, 0x26, 0x71, 0xeb, 0x88,
-	0x4b, 0x43, 0xd7, 0x3e, 0xec, 0x35, 0x63, 0x51, 0xea, 0x32, 0xc7, 0xe5, 0x80, 0x9d, 0x28, 0x56,
-	0xed, 0xc3, 0x8a, 0xc0, 0x95, 0x66, 0xd1, 0x0c, 0xfd, 0x66, 0xdf, 0xa3, 0xb1, 0xc7, 0x57, 0x19,
-	0xee, 0xf1, 0x2d, 0x71, 0x46, 0xd2, 0x24, 0x76, 0xfc, 0x6d, 0xe9, 0xe3, 0xd8, 0x2b, 0x34, 0x60,
-	0x5c, 0xbe, 0xbb, 0xb7, 0x79, 0xe2, 0x2f, 0x7e, 0xea, 0x9f, 0xc1, 0x5c, 0x40, 0xc2, 0xa0, 0x27,
-	0xe2, 0x76, 0xab, 0xe9, 0x7a, 0x21, 0x09, 0x8e, 0xac, 0x96, 0x71, 0x65, 0x38, 0xc1, 0x33, 0x48,
-	0xce, 0x63, 0x7b, 0xab, 0x2e, 0x88, 0xfb, 0x6c, 0xdb, 0xd6, 0x4b, 0xb7, 0xdd, 0x6d, 0xf7, 0xd9,
-	0x5e, 0x3d, 0x0f, 0xdb, 0x4f, 0x38, 0x75, 0xc4, 0xf6, 0x6e, 0x9a, 0xad, 0xd8, 0x06, 0x35, 0xae,
-	0xe1, 0xb6, 0x12, 0x54, 0xc2, 0x9d, 0x50, 0xfd, 0x03, 0x56, 0x39, 0x30, 0xaa, 0x5d, 0xcb, 0x3e,
-	0xf4, 0xf7, 0xf6, 0x9a, 0xb6, 0x4f, 0xf6, 0xf6, 0x5c, 0xdb, 0x25, 0x5e, 0x68, 0xfc, 0x61, 0x25,
-	0xb7, 0x9a, 0x33, 0xe7, 0x11, 0x61, 0x93, 0xc3, 0x6b, 0x7d, 0xb0, 0xde, 0x86, 0x4a, 0x46, 0x82,
-	0x40, 0x5e, 0x76, 0x5c, 0xae, 0x2e, 0x7f, 0xc6, 0xab, 0x43, 0x3e, 0xe3, 0xe5, 0x81, 0x4c, 0x61,
-	0x2b, 0xe2, 0x84, 0x8f, 0xf8, 0x01, 0x2c, 0x73, 0x55, 0x3d, 0xdf, 0x6b, 0xe2, 0x5f, 0xd6, 0x6e,
-	0x8b, 0x34, 0x49, 0x10, 0xf8, 0x01, 0xbe, 0x4b, 0x6a, 0x5c, 0x5f, 0x19, 0x59, 0xcd, 0x9b, 0x6f,
-	0x21, 0xf0, 0xa9, 0xef, 0x99, 0x12, 0x69, 0x8b, 0xe1, 0xb0, 0x27, 0x47, 0xf5, 0x55, 0xd0, 0x0e,
-	0x2c, 0xca, 0xe9, 0x9b, 0x1d, 0xbf, 0xe5, 0xda, 0x3d, 0xe3, 0x06, 0x9a, 0x76, 0xe9, 0xc0, 0xa2,
-	0x48, 0xf1, 0x1c, 0x57, 0xf5, 0xb7, 0x61, 0xc2, 0x0e, 0x7c, 0x2f, 0xb2, 0x3f, 0xe3, 0x1d, 0xb4,
-	0xd4, 0x22, 0x5b, 0x94, 0xb6, 0xc4, 0x52, 0x54, 0xea, 0xee, 0x33, 0xef, 0x65, 0xfb, 0x5d, 0x2f,
-	0x34, 0xaa, 0xf8, 0xba, 0x0a, 0x7c, 0xad, 0xc6, 0x96, 0xf4, 0xab, 0x50, 0xb2, 0xec, 0xd0, 0x3d,
-	0x72, 0xc3, 0x9e, 0x40, 0x7a, 0x84, 0x48, 0x13, 0x72, 0x95, 0xa3, 0xad, 0xc3, 0xac, 0x7d, 0xe0,
-	0xb6, 0x9c, 0xd8, 0x51, 0x72, 0xec, 0xc7, 0x3c, 0x44, 0x22, 0x30, 0x3a, 0x1b, 0x4e, 0xb3, 0x0a,
-	0x5a, 0x97, 0x92, 0x00, 0x0f, 0x3a, 0x10, 0xe8, 0x75, 0x44, 0x2f, 0xb1, 0x75, 0x76, 0x6c, 0x01,
-	0xc7, 0xdc, 0x80, 0x4b, 0xf2, 0x7d, 0x8a, 0xe7, 0x4a, 0x5e, 0x86, 0x24, 0xe8, 0x2b, 0xde, 0xe0,
-	0x31, 0x50, 0x20, 0xd5, 0x10, 0x67, 0x4b, 0xa0, 0x44, 0x0a, 0x8a, 0xad, 0xa6, 0x48, 0x3f, 0xe6,
-	0x0a, 0x72, 0x60, 0x92, 0xe6, 0x32, 0x14, 0x45, 0xfa, 0xc0, 0x51, 0x3f, 0xe1, 0xc7, 0xc3, 0xd7,
-	0x38, 0xca, 0xa7, 0x30, 0x65, 0x75, 0x43, 0xbf, 0x19, 0x10, 0x4a, 0xc2, 0x66, 0xc7, 0x77, 0xbd,
-	0x90, 0x1a, 0x77, 0xd0, 0x68, 0xae, 0xf6, 0x3d, 0x2c, 0x73, 0xad, 0x51, 0x6f, 0xe3, 0xe8, 0x76,
-	0xd5, 0x64, 0xd8, 0xcf, 0x11, 0xd9, 0x9c, 0x64, 0xf4, 0xb1, 0x05, 0xfd, 0xaf, 0x60, 0x8a, 0x12,
-	0x2b, 0xb0, 0x0f, 0xd8, 0x1b, 0x08, 0xdc, 0xdd, 0x2e, 0xf3, 0x7b, 0x77, 0xb1, 0x40, 0x7c, 0x36,
-	0x4c, 0x75, 0x93, 0x59, 0x8d, 0x54, 0xb7, 0x91, 0xe5, 0x46, 0xc4, 0x91, 0x57, 0x8c, 0x1a, 0x4d,
-	0x2d, 0xeb, 0x5f, 0x80, 0xd2, 0x26, 0x6d, 0xdf, 0x78, 0x0f, 0x05, 0xd6, 0x5e, 0x5f, 0xe0, 0x27,
-	0xa4, 0xed, 0x73, 0x21, 0xc8, 0x50, 0xff, 0x06, 0xa6, 0x44, 0xda, 0x24, 0xfc, 0xba, 0x4b, 0xa8,
-	0xf1, 0x27, 0x78, 0x52, 0xef, 0x66, 0x4a, 0x11, 0xde, 0x9f, 0x49, 0x10, 0x49, 0xd5, 0x63, 0x49,
-	0x67, 0x6a, 0x47, 0xa9, 0x15, 0xfd, 0x0e, 0xcc, 0x89, 0x3c, 0x35, 0x32, 0x40, 0x51, 0xd4, 0xdc,
-	0x43, 0xc3, 0x9f, 0x46, 0x68, 0xa4, 0x22, 0x2f, 0x6e, 0xfe, 0x02, 0x26, 0xfb, 0xe8, 0xac, 0x14,
-	0xa7, 0xc6, 0xfb, 0xa8, 0xd1, 0xfa, 0x30, 0xfb, 0x8e, 0x98, 0xb1, 0x52, 0x92, 0x9a, 0x25, 0x92,
-	0xf8, 0x9d, 0xc8, 0x46, 0x98, 0x2a, 0x69, 0xd7, 0xf2, 0xc7, 0xf3, 0x66, 0x23, 0x66, 0x37, 0xed,
-	0x54, 0xee, 0xc2, 0xfc, 0x40, 0x86, 0x1e, 0xbe, 0xc4, 0x5d, 0x7f, 0xc0, 0xcd, 0x3a, 0x99, 0xa5,
-	0xef, 0xbc, 0x64, 0xbb, 0xbe, 0x0b, 0x73, 0xd8, 0x76, 0x68, 0x86, 0x81, 0xe5, 0x51, 0x37, 0xf6,
-	0x58, 0x3f, 0x44, 0xa2, 0x19, 0x84, 0xee, 0x44, 0x40, 0x6e, 0xe9, 0x8f, 0xa0, 0x94, 0xac, 0xa3,
-	0x8c, 0x3f, 0x1d, 0x72, 0x03, 0x13, 0x24, 0x5e, 0x3d, 0xe9, 0x6b, 0x30, 0xe3, 0x91, 0xe3, 0xc1,
-	0x7b, 0xfa, 0x33, 0x5e, 0xd4, 0x7a, 0xe4, 0x38, 0x75, 0x4b, 0x4f, 0xa0, 0x28, 0x4a, 0x50, 0xec,
-	0x3f, 0x1a, 0x1f, 0xa1, 0xdc, 0xeb, 0x99, 0x57, 0x84, 0x18, 0xdc, 0x64, 0xec, 0xd0, 0x0f, 0x6a,
-	0xec, 0xa7, 0x2c, 0x68, 0xf1, 0x87, 0xfe, 0x3e, 0x18, 0x03, 0x05, 0xad, 0xcc, 0xe7, 0xef, 0xf3,
-	0xfa, 0x34, 0x55, 0xd5, 0xca, 0x94, 0xfe, 0x0e, 0xcc, 0xd9, 0x2d, 0x9f, 0x8a, 0x73, 0xdb, 0x63,
-	0x9e, 0x4b, 0x14, 0x50, 0x7f, 0x2e, 0x9c, 0x1c, 0x83, 0xee, 0x08, 0xa0, 0x28, 0xa2, 0xee, 0x81,
-	0xc1, 0x89, 0x8e, 0x5c, 0xea, 0xee, 0xba, 0x2d, 0xe6, 0x47, 0x25, 0xd9, 0x06, 0x92, 0xcd, 0x22,
-	0xfc, 0xf3, 0x08, 0x2c, 0x08, 0xef, 0x03, 0x08, 0x69, 0xec, 0xac, 0x37, 0x87, 0xad, 0x80, 0xb8,
-	0x0e, 0xec, 0x9c, 0xb7, 0x60, 0x39, 0x5b, 0xb2, 0x28, 0xbf, 0x89, 0x63, 0xd4, 0x30, 0x74, 0x2c,
-	0x65, 0x28, 0x50, 0x93, 0x38, 0xfa, 0x2e, 0x4c, 0xef, 0x5a, 0x94, 0xc4, 0xee, 0xcb, 0xf5, 0xf6,
-	0x7c, 0xe3, 0xc9, 0x29, 0xef, 0x24, 0xee, 0xea, 0x36, 0x2d, 0x4a, 0x12, 0x8e, 0xc1, 0x9c, 0xda,
-	0x4d, 0x2f, 0xe9, 0x5f, 0xf3, 0x6a, 0x9a, 0x04, 0xf2, 0x26, 0x9a, 0xb8, 0x27, 0xe3, 0x29, 0x0a,
-	0xb9, 0x91, 0x74, 0xa4, 0xa2, 0x9f, 0x2c, 0x1c, 0x0f, 0x09, 0xc4, 0xf5, 0x6c, 0x33, 0x0a, 0x5e,
-	0x58, 0x27, 0xd7, 0xf4, 0x76, 0xe4, 0xc6, 0x99, 0xe6, 0xd4, 0x78, 0x86, 0xae, 0xad, 0xf1, 0xfa,
-	0xae, 0x8d, 0x97, 0x86, 0xec, 0x4f, 0xd9, 0x78, 0xeb, 0xf6, 0x57, 0x16, 0x1d, 0x98, 0xcd, 0x74,
-	0xb6, 0x19, 0x2d, 0xb7, 0xf7, 0x92, 0xcd, 0xab, 0xe5, 0x93, 0x36, 0xfa, 0xdc, 0xea, 0xb5, 0x7c,
-	0xcb, 0x89, 0x77, 0xc7, 0xbe, 0x84, 0x7c, 0xe4, 0x61, 0x7f, 0x5b, 0xce, 0x2e, 0x68, 0xe9, 0x0d,
-	0x66, 0x08, 0xb8, 0x9f, 0x14, 0x90, 0xfd, 0x1a, 0xf9, 0xb1, 0x30, 0x39, 0x7d, 0x8e, 0xc9, 0x96,
-	0x1b, 0x6f, 0xb3, 0x45, 0xed, 0xb4, 0x86, 0xa2, 0x6a, 0xda, 0x54, 0x43, 0x51, 0x6f, 0x6a, 0xb7,
-	0x1a, 0x8a, 0x7a, 0x4b, 0xab, 0x36, 0x14, 0x75, 0x4d, 0x7b, 0xb7, 0xa1, 0xa8, 0xef, 0x6a, 0xb7,
-	0x1b, 0x8a, 0x7a, 0x5b, 0x5b, 0x6f, 0x28, 0xea, 0xba, 0x76, 0xa7, 0x72, 0x07, 0x4a, 0x49, 0x07,
-	0xcc, 0xc2, 0x75, 0xbc, 0x62, 0x40, 0x6d, 0x47, 0xcc, 0xc2, 0x41, 0xbf, 0x3e, 0xa8, 0xfc, 0x92,
-	0x83, 0xb9, 0x81, 0x3b, 0xc5, 0x4e, 0x20, 0x96, 0x02, 0x01, 0x61, 0x56, 0x12, 0x2b, 0x05, 0x72,
-	0xa2, 0x14, 0x40, 0x40, 0xbf, 0x14, 0x98, 0x85, 0x31, 0xe1, 0xb4, 0x78, 0xcb, 0x6e, 0x34, 0x40,
-	0x47, 0xd5, 0x80, 0x51, 0x74, 0x9d, 0xd8, 0x9f, 0x2b, 0xad, 0xdf, 0x1d, 0xae, 0xc4, 0x4a, 0xea,
-	0x61, 0x72, 0x16, 0xfa, 0x43, 0x18, 0x63, 0x7f, 0x74, 0x29, 0x76, 0xef, 0x12, 0xf5, 0xda, 0xd9,
-	0x5c, 0xba, 0xd4, 0x14, 0xd4, 0x95, 0xff, 0x19, 0x03, 0x2d, 0xe1, 0x92, 0x7e, 0xab, 0xd6, 0x64,
-	0xff, 0x0c, 0x46, 0xe2, 0x67, 0x50, 0x83, 0x7c, 0xbf, 0xd4, 0xe4, 0xaa, 0x5f, 0x3b, 0xfd, 0x1c,
-	0xa2, 0x12, 0x53, 0x0d, 0x65, 0x69, 0x59, 0x85, 0xe9, 0xd0, 0x0a, 0xf6, 0x49, 0xaa, 0xed, 0xc9,
-	0xdb, 0x93, 0x53, 0x1c, 0x94, 0x6a, 0x7b, 0x0a, 0xfc, 0xb8, 0xce, 0x63, 0xbc, 0x35, 0xc8, 0x21,
-	0xc9, 0xb6, 0xa7, 0xc0, 0x16, 0x1b, 0x18, 0xe7, 0xdb, 0xe7, 0x8b, 0x3c, 0xe6, 0x24, 0x7b, 0x91,
-	0x6a, 0xba, 0x17, 0xf9, 0x21, 0x2c, 0x0a, 0x16, 0x3c, 0xeb, 0x8d, 0xc4, 0xfa, 0x5e, 0xab, 0x87,
-	0xad, 0x4b, 0xd5, 0x9c, 0xe7, 0x18, 0x35, 0x86, 0x20, 0xa5, 0x3f, 0xf3, 0x5a, 0x3d, 0x9c, 0xb0,
-	0x0c, 0x36, 0x83, 0x80, 0xb7, 0xd5, 0x68, 0xba, 0x01, 0x64, 0xc0, 0xb8, 0x0c, 0x4f, 0x05, 0x3e,
-	0xbf, 0x11, 0x3f, 0xf5, 0x79, 0x18, 0x97, 0x91, 0xa4, 0x88, 0x90, 0xb1, 0x90, 0x87, 0x8e, 0x3a,
-	0x4c, 0xc6, 0x7d, 0x3e, 0x8b, 0x1f, 0x13, 0xc3, 0xb6, 0xbe, 0xfa, 0x84, 0x18, 0x44, 0x6e, 0x82,
-	0xee, 0x10, 0x16, 0x08, 0x9a, 0xd6, 0x5e, 0xc8, 0xb2, 0x74, 0x16, 0x2a, 0x8c, 0x49, 0xdc, 0xa0,
-	0xc6, 0x21, 0x1b, 0x0c, 0x50, 0x63, 0xeb, 0xfa, 0xdf, 0xe6, 0x80, 0x07, 0x93, 0x78, 0xcb, 0x95,
-	0xa9, 0xe8, 0x90, 0xd0, 0x72, 0x71, 0xa0, 0xc2, 0xd4, 0x78, 0x3a, 0x8c, 0xeb, 0x4d, 0x1b, 0x6d,
-	0x15, 0x45, 0xf4, 0x1b, 0xb1, 0x16, 0x3d, 0x7c, 0xc0, 0xb9, 0x3e, 0xbe, 0x60, 0x2e, 0xd8, 0x27,
-	0x01, 0x17, 0xbf, 0x86, 0x85, 0x13, 0x29, 0xf5, 0xfb, 0xb0, 0x64, 0x5b, 0x5e, 0x93, 0x1e, 0xba,
-	0x9d, 0x78, 0x98, 0x64, 0xde, 0xdb, 0x65, 0x35, 0x6d, 0x0e, 0x37, 0xba, 0x60, 0x5b, 0xde, 0xf6,
-	0xa1, 0xdb, 0xe9, 0x87, 0xc8, 0x0d, 0x81, 0xb0, 0x59, 0x82, 0x62, 0x7c, 0x83, 0xdc, 0x97, 0x55,
-	0xfe, 0x51, 0x81, 0xe9, 0xd8, 0xf0, 0xe5, 0x77, 0xf3, 0xee, 0x62, 0xb6, 0x36, 0x9a, 0xb4, 0xb5,
-	0x2b, 0x50, 0x4a, 0xb5, 0x81, 0xf9, 0x04, 0xa0, 0xb8, 0x17, 0x6f, 0x01, 0x57, 0x60, 0xc2, 0x23,
-	0x2f, 0x63, 0x48, 0xbc, 0xe1, 0x5f, 0x60, 0x8b, 0x12, 0x27, 0xdb, 0xfa, 0xd5, 0x13, 0xac, 0xff,
-	0x32, 0x14, 0x77, 0x03, 0xcb, 0xb3, 0x0f, 0x9a, 0xa1, 0x7f, 0x48, 0xf8, 0x13, 0x28, 0x9a, 0x05,
-	0xbe, 0xb6, 0xc3, 0x96, 0x64, 0x3e, 0xc9, 0x0e, 0x25, 0x81, 0x3a, 0x81, 0xa8, 0x2c, 0x9f, 0x34,
-	0xbb, 0xde, 0x66, 0x8c, 0x20, 0xf6, 0x6e, 0x26, 0xcf, 0x7a, 0x37, 0xda, 0x6b, 0xbe, 0x9b, 0x25,
-	0x00, 0xa9, 0x94, 0x68, 0xb0, 0xe7, 0x4d, 0x95, 0xab, 0x52, 0x77, 0x52, 0x83, 0xa5, 0x68, 0xa4,
-	0x54, 0xf9, 0xef, 0x11, 0xd0, 0x53, 0x89, 0xe0, 0xef, 0xdb, 0x6c, 0x62, 0x47, 0x3d, 0x76, 0xd6,
-	0x51, 0x8f, 0xbf, 0xe6, 0x51, 0x27, 0x13, 0x65, 0xf5, 0xfc, 0x89, 0x72, 0x72, 0xd6, 0x90, 0x3f,
-	0xff, 0xac, 0xe1, 0xb4, 0x1c, 0x1f, 0x4e, 0xc9, 0xf1, 0x2b, 0xbf, 0x28, 0x30, 0x81, 0x6d, 0x8e,
-	0xdf, 0xcd, 0x55, 0x6f, 0x41, 0x51, 0xf4, 0x2f, 0x39, 0x9f, 0x51, 0xe4, 0x53, 0x39, 0x21, 0x39,
-	0x11, 0x5d, 0x4a, 0xe4, 0x51, 0x08, 0xfb, 0x3f, 0x74, 0x12, 0x1b, 0x1e, 0xc8, 0xde, 0x1d, 0xf2,
-	0x1b, 0x43, 0x7e, 0xb7, 0x87, 0xcb, 0x9c, 0x44, 0x57, 0x0f, 0xd9, 0x47, 0xf3, 0x86, 0xd8, 0x62,
-	0xdc, 0x30, 0xc7, 0x93, 0x86, 0x79, 0x1d, 0x22, 0x5f, 0x13, 0x0d, 0x2e, 0x54, 0xec, 0x34, 0x4e,
-	0xca, 0x75, 0x39, 0xb4, 0x58, 0x00, 0x35, 0x72, 0x53, 0x79, 0xce, 0x85, 0x08, 0xef, 0x14, 0x33,
-	0x6f, 0x38, 0xcb, 0xbc, 0x0b, 0xaf, 0x69, 0xde, 0x69, 0x0f, 0x58, 0x1c, 0xf4, 0x80, 0xd7, 0x41,
-	0xb3, 0x5a, 0x01, 0xb1, 0x1c, 0x19, 0xb9, 0x88, 0x83, 0xde, 0x4f, 0x35, 0x27, 0xc5, 0xfa, 0x86,
-	0x58, 0xae, 0xfc, 0xc3, 0x45, 0xd0, 0x64, 0xf0, 0x8a, 0x8c, 0x2e, 0xb6, 0x8d, 0x5c, 0x62, 0x1b,
-	0x69, 0x6b, 0xbc, 0x78, 0xa6, 0x35, 0x8e, 0x9c, 0x62, 0x8d, 0xca, 0x89, 0xd6, 0x38, 0xfa, 0xff,
-	0x77, 0x3c, 0x63, 0xc9, 0xfb, 0xfd, 0xed, 0xfc, 0x4b, 0xe5, 0x9f, 0x4a, 0x50, 0xdc, 0x10, 0xcd,
-	0x4e, 0x3c, 0xae, 0x98, 0xd4, 0x5c, 0x52, 0xea, 0x3d, 0x30, 0xd2, 0xb1, 0x2d, 0x9a, 0x7d, 0xf3,
-	0xaf, 0x2a, 0x66, 0x93, 0x11, 0x4e, 0x8e, 0xbe, 0x1f, 0x41, 0x29, 0x35, 0x3f, 0x52, 0x86, 0x6d,
-	0xae, 0xd0, 0xc4, 0xac, 0x68, 0x15, 0xb4, 0x81, 0x01, 0x21, 0xf7, 0xc9, 0x25, 0x9a, 0x1c, 0x0a,
-	0xd6, 0xa0, 0x98, 0x98, 0xbe, 0x0d, 0x7b, 0x3c, 0x05, 0x1a, 0x9b, 0xb8, 0x2d, 0x43, 0x21, 0xea,
-	0x0e, 0x8b, 0x28, 0x9e, 0x37, 0x41, 0x2e, 0xf1, 0x3c, 0x3a, 0x56, 0x4e, 0x89, 0x99, 0x7e, 0x10,
-	0x15, 0x52, 0x5f, 0xc1, 0xc2, 0xc9, 0x03, 0x12, 0x18, 0x6e, 0xa0, 0x30, 0x47, 0xb3, 0x47, 0x23,
-	0x29, 0xde, 0xfd, 0x18, 0x71, 0x8e, 0x0f, 0x00, 0x62, 0xbc, 0x6b, 0x32, 0x5e, 0x30, 0xde, 0x3b,
-	0xd8, 0x42, 0x63, 0xba, 0xa6, 0x19, 0x0f, 0xf9, 0x01, 0xc0, 0x34, 0x8f, 0x1e, 0x49, 0xae, 0x4f,
-	0x60, 0xea, 0x80, 0x58, 0x41, 0xb8, 0x4b, 0xac, 0xf0, 0xbc, 0x53, 0x7f, 0x2d, 0xa2, 0x94, 0xdc,
-	0xb2, 0xc6, 0x60, 0xa5, 0x73, 0x8c, 0xc1, 0x78, 0x6e, 0x94, 0x35, 0x06, 0xe3, 0x0d, 0x7b, 0x39,
-	0xc0, 0x65, 0x35, 0xaa, 0xc6, 0x5d, 0x67, 0x28, 0x63, 0x19, 0x2f, 0x42, 0xe3, 0xd3, 0xa9, 0xa9,
-	0xe4, 0x74, 0x2a, 0x59, 0x5f, 0xe9, 0xe9, 0xfa, 0xea, 0x7a, 0xdf, 0x8c, 0x5d, 0x87, 0x78, 0xa1,
-	0x1b, 0xf6, 0xf0, 0xc3, 0x01, 0x1c, 0xb5, 0xe1, 0x7a, 0x5d, 0x2c, 0x67, 0x8e, 0x44, 0x66, 0x32,
-	0x47, 0x22, 0x27, 0x4f, 0xc4, 0x66, 0xdf, 0xcc, 0x44, 0x6c, 0xee, 0xcd, 0x4c, 0xc4, 0xe6, 0x4f,
-	0x99, 0x88, 0xed, 0xc0, 0x2c, 0xa7, 0x4a, 0x77, 0x9b, 0x8d, 0x21, 0x9f, 0xf7, 0x34, 0x92, 0xa7,
-	0xfa, 0xcc, 0xa7, 0xce, 0xd9, 0x16, 0x4e, 0x9f, 0xb3, 0x0d, 0x31, 0xf8, 0x5a, 0x3c, 0x7b, 0xf0,
-	0xf5, 0x14, 0x74, 0xce, 0x85, 0xf7, 0xbb, 0xf9, 0xd7, 0xad, 0xe2, 0x8b, 0x81, 0x95, 0x64, 0xf6,
-	0x21, 0x80, 0x2c, 0x64, 0x3c, 0xe4, 0x7f, 0x9a, 0x1a, 0xd2, 0x3e, 0xb1, 0x68, 0x28, 0x56, 0x58,
-	0x01, 0x1f, 0xe3, 0x27, 0x9a, 0x8f, 0x91, 0xa9, 0x2d, 0xa1, 0xa9, 0xcd, 0x47, 0x54, 0xbc, 0xd1,
-	0x18, 0x99, 0x5c, 0x76, 0x09, 0x53, 0x3e, 0xa1, 0x84, 0xf9, 0x1c, 0xe6, 0x50, 0x48, 0xff, 0x69,
-	0xcb, 0x6a, 0x78, 0x39, 0x4b, 0xfd, 0x81, 0xde, 0x1c, 0x35, 0x67, 0x18, 0xfd, 0x63, 0x49, 0x2e,
-	0x6b, 0xd7, 0x6f, 0x60, 0x31, 0xc5, 0x37, 0xfe, 0xad, 0xcb, 0xca, 0xb0, 0x1f, 0x53, 0x24, 0x78,
-	0xc7, 0x3e, 0x7a, 0xb9, 0x0b, 0x73, 0x5d, 0x4a, 0xb0, 0x59, 0x6c, 0x85, 0x2e, 0xbb, 0x32, 0x19,
-	0xf4, 0x2e, 0xe3, 0xeb, 0x9a, 0xe9, 0x52, 0x52, 0x8b, 0x80, 0xa2, 0xe1, 0xda, 0x50, 0xd4, 0x11,
-	0x4d, 0x69, 0x28, 0xea, 0x98, 0x36, 0xde, 0x50, 0xd4, 0x4b, 0x5a, 0xb9, 0xf2, 0x6f, 0x39, 0xc8,
-	0x63, 0x8e, 0x7b, 0x46, 0xec, 0xcc, 0x8a, 0x5c, 0x17, 0x33, 0x23, 0xd7, 0x06, 0x14, 0xd0, 0xba,
-	0x45, 0x5c, 0x1f, 0x19, 0xf6, 0x9b, 0x53, 0x4e, 0x24, 0xe3, 0x56, 0xdc, 0x7d, 0x29, 0x28, 0x07,
-	0x3d, 0x92, 0xf0, 0x5c, 0x0b, 0xa0, 0x72, 0x2f, 0x17, 0xb5, 0x9d, 0xc6, 0xf1, 0x77, 0xdd, 0xa9,
-	0xfc, 0xbb, 0x02, 0x7a, 0x2d, 0x31, 0xce, 0x3c, 0x3b, 0x2b, 0xe8, 0x8f, 0x1a, 0xb2, 0xb3, 0x82,
-	0x08, 0x9e, 0xc8, 0x0a, 0xb2, 0x8e, 0x64, 0x24, 0xf3, 0x48, 0xaa, 0x30, 0x2d, 0x31, 0xe3, 0xd9,
-	0x98, 0x68, 0x98, 0x09, 0x50, 0xac, 0x05, 0x76, 0x05, 0x24, 0x07, 0x59, 0xa2, 0xf2, 0x66, 0x99,
-	0x4c, 0x09, 0x78, 0x13, 0x2c, 0xb3, 0x25, 0xaa, 0x66, 0xb7, 0x44, 0x97, 0x20, 0x1f, 0xa5, 0x85,
-	0x32, 0xce, 0x47, 0x0b, 0xe7, 0xfc, 0x76, 0xef, 0xcb, 0xe8, 0x9b, 0x43, 0x1e, 0x5b, 0x85, 0x57,
-	0x2f, 0x60, 0x96, 0xb8, 0x7a, 0x42, 0xad, 0xf1, 0x5c, 0xce, 0x78, 0x28, 0xe1, 0xfe, 0x5e, 0x7e,
-	0x9d, 0x18, 0x5b, 0x62, 0x7a, 0xa4, 0xaf, 0x22, 0xea, 0x9e, 0x69, 0xc9, 0x4b, 0xc0, 0x11, 0xcc,
-	0x28, 0x9f, 0x38, 0x4d, 0x9c, 0x77, 0xe2, 0xc4, 0xe9, 0x06, 0xf2, 0xe7, 0xd2, 0x40, 0xfe, 0x1c,
-	0x7d, 0x75, 0x3a, 0xae, 0xa9, 0x95, 0x7f, 0xce, 0xc1, 0x94, 0x19, 0x1f, 0x61, 0xbf, 0x29, 0xc3,
-	0xca, 0x8c, 0xf7, 0x23, 0xd9, 0x9f, 0xbd, 0x64, 0x1f, 0x99, 0x92, 0x7d, 0x64, 0x95, 0x7f, 0xc9,
-	0x01, 0x6c, 0xe3, 0x28, 0xfd, 0x4d, 0xe9, 0x9e, 0xcc, 0x28, 0x47, 0xd2, 0x19, 0x65, 0xb6, 0xba,
-	0xe3, 0xd9, 0xea, 0xa6, 0xbe, 0xf9, 0xe5, 0x4e, 0x4b, 0xd5, 0xf2, 0x95, 0xef, 0x72, 0xa0, 0xd6,
-	0x0e, 0x88, 0x7d, 0x48, 0xbb, 0xed, 0xf4, 0x26, 0x46, 0xfb, 0x9b, 0x78, 0x00, 0x63, 0x7b, 0x2d,
-	0xeb, 0xc8, 0x0f, 0x50, 0xe5, 0xd2, 0xfa, 0xcd, 0xd3, 0x2b, 0x18, 0xc9, 0xf1, 0x21, 0xd2, 0x98,
-	0x82, 0xb6, 0xff, 0xe1, 0xf5, 0x08, 0x96, 0x76, 0xfc, 0xc7, 0xe6, 0x5f, 0xfe, 0xf0, 0x53, 0xf9,
-	0xc2, 0x8f, 0x3f, 0x95, 0x2f, 0xfc, 0xfa, 0x53, 0x39, 0xf7, 0xdd, 0xab, 0x72, 0xee, 0xef, 0x5f,
-	0x95, 0x73, 0xff, 0xfa, 0xaa, 0x9c, 0xfb, 0xe1, 0x55, 0x39, 0xf7, 0x9f, 0xaf, 0xca, 0xb9, 0xff,
-	0x7a, 0x55, 0xbe, 0xf0, 0xeb, 0xab, 0x72, 0xee, 0xfb, 0x9f, 0xcb, 0x17, 0x7e, 0xf8, 0xb9, 0x7c,
-	0xe1, 0xc7, 0x9f, 0xcb, 0x17, 0xbe, 0xba, 0xbb, 0xef, 0xf7, 0x75, 0x70, 0xfd, 0x93, 0xff, 0x55,
-	0xe1, 0xc3, 0xd8, 0xcf, 0xdd, 0x31, 0x74, 0x9a, 0x77, 0xfe, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xb4,
-	0xc4, 0xdc, 0x31, 0x4d, 0x33, 0x00, 0x00,
+	// 3753 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3b, 0x3d, 0x73, 0x1c, 0x47,
+	0x76, 0x5c, 0x62, 0x00, 0xcc, 0xbe, 0x5d, 0x2c, 0x06, 0x83, 0xaf, 0x01, 0x04, 0x2e, 0xc0, 0x95,
+	0xa8, 0x03, 0x25, 0x6a, 0x21, 0x82, 0x94, 0xa5, 0x93, 0xec, 0xa3, 0x01, 0x10, 0x14, 0x77, 0x8f,
+	0x22, 0xa5, 0x01, 0x4e, 0xba, 0x3a, 0x4b, 0xb5, 0x35, 0x98, 0x69, 0x00, 0x63, 0xec, 0xce, 0x2c,
+	0xa7, 0x67, 0x00, 0xee, 0x95, 0x83, 0x0b, 0x5c, 0x8e, 0xcf, 0x99, 0x7f, 0x82, 0xc3, 0x4b, 0x9c,
+	0x39, 0x70, 0xe0, 0xc0, 0x91, 0x4b, 0x99, 0x2f, 0xb3, 0x45, 0x25, 0x4e, 0x5c, 0x56, 0x39, 0x70,
+	0xec, 0xea, 0xd7, 0xdd, 0xf3, 0xb5, 0x03, 0x60, 0x41, 0x8b, 0x81, 0x32, 0x6c, 0xf7, 0xfb, 0xea,
+	0xee, 0xf7, 0xfd, 0x06, 0x70, 0x2f, 0x24, 0xbd, 0xbe, 0x1f, 0x58, 0xdd, 0x0d, 0x4a, 0x82, 0x53,
+	0x12, 0x6c, 0x58, 0x7d, 0x77, 0xa3, 0x4f, 0x02, 0xea, 0xd2, 0x90, 0x78, 0x36, 0xd9, 0x38, 0xbd,
+	0xbb, 0x41, 0x5e, 0x10, 0x3b, 0x0a, 0x5d, 0xdf, 0xa3, 0xcd, 0x7e, 0xe0, 0x87, 0xbe, 0xde, 0x90,
+	0x48, 0x4d, 0x8e, 0xd4, 0xb4, 0xfa, 0x6e, 0x33, 0x85, 0xd4, 0x3c, 0xbd, 0xbb, 0x5c, 0x3f, 0xf2,
+	0xfd, 0xa3, 0x2e, 0xd9, 0x40, 0x8c, 0x83, 0xe8, 0x70, 0xc3, 0x89, 0x02, 0x8b, 0x11, 0xe1, 0x34,
+	0x96, 0x57, 0xf3, 0xfb, 0xa1, 0xdb, 0x23, 0x34, 0xb4, 0x7a, 0x7d, 0x01, 0x70, 0xd3, 0x21, 0x7d,
+	0xe2, 0x39, 0xc4, 0xb3, 0x5d, 0x42, 0x37, 0x8e, 0xfc, 0x23, 0x1f, 0xd7, 0xf1, 0x2f, 0x01, 0xf2,
+	0x56, 0x2c, 0x3c, 0x93, 0xda, 0xf6, 0x7b, 0x3d, 0xdf, 0x63, 0x02, 0xf7, 0x08, 0xa5, 0xd6, 0x11,
+	0x29, 0x84, 0x22, 0x5e, 0xd4, 0xa3, 0x0c, 0xe8, 0xcc, 0x0f, 0x4e, 0x0e, 0xbb, 0xfe, 0x99, 0x80,
+	0xba, 0x95, 0x81, 0x3a, 0xb4, 0xdc, 0x6e, 0x14, 0x90, 0x61, 0x62, 0x6f, 0x67, 0xc0, 0x24, 0x8d,
+	0x61, 0xb8, 0x77, 0x8a, 0xee, 0xd5, 0xee, 0xfa, 0xf6, 0xc9, 0x30, 0xec, 0xed, 0x22, 0xd8, 0x58,
+	0x4e, 0x7e, 0x2c, 0x01, 0xfa, 0xee, 0x85, 0xa0, 0xb9, 0x23, 0xfd, 0xec, 0x42, 0xe0, 0xd0, 0xa2,
+	0x27, 0x02, 0xf0, 0x83, 0x91, 0xa8, 0x76, 0x18, 0x46, 0x27, 0x1c, 0xf4, 0xa5, 0xdc, 0x77, 0x8a,
+	0xd0, 0x8e, 0x5d, 0x1a, 0xfa, 0xc1, 0x60, 0xf8, 0x94, 0x1b, 0x23, 0x68, 0xda, 0xf3, 0x88, 0x44,
+	0x84, 0x5e, 0x74, 0xd6, 0xa8, 0xef, 0x58, 0x61, 0xc1, 0xbb, 0xbc, 0x57, 0x04, 0x7c, 0xee, 0xf3,
+	0x34, 0xfe, 0x66, 0x12, 0xca, 0x7b, 0xc7, 0x56, 0xe0, 0xb4, 0xbc, 0x43, 0x5f, 0x5f, 0x02, 0x95,
+	0xb2, 0x1f, 0x1d, 0xd7, 0x31, 0x4a, 0x6b, 0xa5, 0xf5, 0x71, 0x73, 0x12, 0x7f, 0xb7, 0x1c, 0xb6,
+	0x15, 0x58, 0xde, 0x11, 0x61, 0x5b, 0xd7, 0xd7, 0x4a, 0xeb, 0x63, 0xe6, 0x24, 0xfe, 0x6e, 0x39,
+	0xfa, 0x1c, 0x8c, 0xfb, 0x67, 0x1e, 0x09, 0x8c, 0xb1, 0xb5, 0xd2, 0x7a, 0xd9, 0xe4, 0x3f, 0xf4,
+	0x3b, 0xa0, 0xd3, 0xd0, 0xef, 0x12, 0xaf, 0x43, 0x5d, 0xcf, 0x26, 0x9d, 0x80, 0x78, 0xe4, 0xcc,
+	0x98, 0x40, 0xaa, 0x1a, 0xdf, 0xd9, 0x63, 0x1b, 0x26, 0x5b, 0xd7, 0xb7, 0xa0, 0xc2, 0x4f, 0xd4,
+	0x61, 0xea, 0x6f, 0x4c, 0xae, 0x95, 0xd6, 0x2b, 0x9b, 0xcb, 0x4d, 0x6e, 0x1b, 0x4d, 0x69, 0x1b,
+	0xcd, 0x7d, 0x69, 0x1b, 0xdb, 0xca, 0xef, 0xff, 0x7d, 0xb5, 0x64, 0x02, 0x47, 0x62, 0xcb, 0xfa,
+	0x5f, 0x97, 0x60, 0x29, 0x20, 0xfd, 0xae, 0x6b, 0xa3, 0x79, 0x75, 0x9c, 0xee, 0xf3, 0x8e, 0x65,
+	0x9f, 0x74, 0xba, 0xe4, 0x94, 0x74, 0x8d, 0xa9, 0xb5, 0xb1, 0xf5, 0xca, 0x66, 0xab, 0x79, 0xb9,
+	0xc5, 0x36, 0xe3, 0xfb, 0x68, 0x9a, 0x09, 0xb9, 0x87, 0xdd, 0xe7, 0x5b, 0xf6, 0xc9, 0x13, 0x46,
+	0x6b, 0xd7, 0x0b, 0x83, 0x81, 0xb9, 0x10, 0x14, 0x6e, 0xea, 0x27, 0xa0, 0xe1, 0xeb, 0x25, 0xbc,
+	0xa9, 0xa1, 0x21, 0xf3, 0xad, 0xab, 0x31, 0xff, 0x82, 0x51, 0x91, 0x64, 0x29, 0x67, 0x5a, 0x7b,
+	0x9e, 0x59, 0xd4, 0x2d, 0xa8, 0x72, 0x66, 0x34, 0xb4, 0x42, 0x42, 0x8d, 0x19, 0x64, 0xf4, 0x8b,
+	0x57, 0x60, 0xb4, 0x87, 0x04, 0x38, 0x97, 0xca, 0xf3, 0x64, 0x65, 0xb9, 0x05, 0x6f, 0x5c, 0x70,
+	0x0d, 0xba, 0x06, 0x63, 0x27, 0x64, 0x80, 0xda, 0x52, 0x36, 0xd9, 0x9f, 0x4c, 0x1d, 0x4e, 0xad,
+	0x6e, 0x44, 0x84, 0x9a, 0xf0, 0x1f, 0x1f, 0x5f, 0xff, 0xa8, 0xb4, 0x1c, 0xc2, 0x6c, 0xc1, 0xa1,
+	0xd2, 0x24, 0xc6, 0x39, 0x89, 0x4f, 0xd3, 0x24, 0x2a, 0x9b, 0x77, 0x47, 0x39, 0x4f, 0x86, 0x72,
+	0x9a, 0xab, 0x07, 0x5a, 0xfe, 0x84, 0x05, 0x2c, 0x1f, 0x66, 0x59, 0x36, 0x47, 0x66, 0x89, 0x64,
+	0x53, 0xfc, 0xda, 0x8a, 0xaa, 0x68, 0xe3, 0x6d, 0x45, 0x1d, 0xd7, 0x26, 0xda, 0x8a, 0xaa, 0x6a,
+	0xe5, 0xb6, 0xa2, 0x96, 0x35, 0x68, 0x2b, 0x2a, 0x68, 0x95, 0xb6, 0xa2, 0x56, 0xb4, 0x6a, 0x5b,
+	0x51, 0xab, 0xda, 0x54, 0x5b, 0x51, 0x6b, 0xda, 0x74, 0x5b, 0x51, 0xa7, 0x35, 0xad, 0xf1, 0xbf,
+	0x6f, 0xc3, 0xfc, 0x57, 0xc2, 0x4c, 0x77, 0x65, 0x9c, 0x41, 0xa3, 0xbc, 0x09, 0x55, 0xcf, 0xea,
+	0x11, 0xda, 0xb7, 0x6c, 0x22, 0x0d, 0xb3, 0x6c, 0x56, 0xe2, 0xb5, 0x96, 0xa3, 0xaf, 0x42, 0x25,
+	0x76, 0x4e, 0xc2, 0x3e, 0xcb, 0x26, 0xc8, 0xa5, 0x96, 0xa3, 0x37, 0x61, 0xb6, 0x6f, 0x05, 0xc4,
+	0x0b, 0x3b, 0x19, 0x52, 0xdc, 0x60, 0x67, 0xf8, 0xd6, 0xd3, 0x14, 0xc1, 0x3b, 0xa0, 0x0b, 0xf8,
+	0x34, 0x5d, 0x05, 0xc1, 0x35, 0xbe, 0xf3, 0x55, 0x42, 0xbd, 0x01, 0x53, 0x02, 0x3a, 0x88, 0x3c,
+	0x06, 0x38, 0xce, 0x45, 0xe4, 0x8b, 0x66, 0xe4, 0x65, 0x24, 0x70, 0x3d, 0x37, 0x74, 0xad, 0x90,
+	0xa0, 0x97, 0x99, 0x40, 0x1d, 0x11, 0x12, 0xb4, 0xe4, 0x4e, 0xcb, 0xd1, 0x7f, 0x0e, 0x4b, 0xb6,
+	0xdf, 0xeb, 0x77, 0x09, 0xda, 0x32, 0x39, 0x65, 0x98, 0x07, 0x56, 0x68, 0x1f, 0x33, 0xac, 0x49,
+	0xc4, 0x5a, 0x48, 0x00, 0x76, 0xd9, 0xfe, 0x36, 0xdb, 0x6e, 0x39, 0xfa, 0x0d, 0x00, 0xf4, 0xd0,
+	0xa8, 0xc5, 0x46, 0x19, 0x65, 0x29, 0xb3, 0x15, 0x7c, 0x2f, 0x76, 0xb6, 0xc4, 0x93, 0x0f, 0xfa,
+	0x04, 0xaf, 0xc4, 0x00, 0x7e, 0x36, 0xb9, 0xb3, 0x3f, 0xe8, 0x13, 0x76, 0x21, 0xfa, 0x37, 0xb0,
+	0x1c, 0x43, 0xc7, 0xf1, 0x1f, 0x9d, 0x94, 0x1f, 0x85, 0x46, 0x05, 0x95, 0x65, 0x69, 0xc8, 0x4f,
+	0x3d, 0x14, 0x31, 0x7e, 0x5b, 0xf9, 0x3b, 0xe6, 0xa6, 0x8c, 0xb3, 0xfc, 0xcb, 0xee, 0x73, 0x02,
+	0xfa, 0x17, 0x30, 0x17, 0x93, 0x0f, 0xa2, 0x84, 0x70, 0x75, 0x34, 0xc2, 0xf1, 0x49, 0xcc, 0x28,
+	0x26, 0x79, 0x00, 0x37, 0x1c, 0x72, 0x68, 0x45, 0xdd, 0xd4, 0xe3, 0xf1, 0x88, 0x25, 0x68, 0x4f,
+	0x8d, 0x46, 0x7b, 0x59, 0x50, 0x91, 0x0f, 0xbd, 0x6f, 0xd1, 0x13, 0xc9, 0xe3, 0x5d, 0xd0, 0xbb,
+	0x16, 0x0d, 0xc5, 0xbb, 0x20, 0x75, 0xd7, 0x31, 0x66, 0xf0, 0x59, 0xa6, 0xd9, 0x0e, 0x3e, 0x08,
+	0xc3, 0x68, 0x39, 0xfa, 0x7b, 0x30, 0x8b, 0xc0, 0x87, 0x6e, 0x10, 0xa3, 0xb8, 0x8e, 0xa1, 0x23,
+	0xb4, 0xc6, 0xb6, 0x1e, 0xb9, 0x81, 0x40, 0x69, 0x39, 0xfa, 0x2f, 0xe1, 0x4d, 0x04, 0xcf, 0x0a,
+	0x4f, 0x43, 0x2b, 0x60, 0x3a, 0x13, 0xa3, 0xcf, 0x22, 0x7a, 0x9d, 0x81, 0xa6, 0x25, 0xdc, 0xe3,
+	0x70, 0x92, 0xd8, 0x03, 0x00, 0xc4, 0xe4, 0x61, 0x65, 0x6e, 0xc4, 0xb0, 0x52, 0x46, 0x1c, 0xb6,
+	0xaa, 0xb7, 0x01, 0x25, 0xec, 0xa4, 0xa3, 0xd3, 0xfc, 0x88, 0x64, 0x6a, 0x0c, 0xf3, 0x57, 0x49,
+	0x84, 0xda, 0x84, 0xf9, 0xec, 0xa1, 0x4e, 0x99, 0x3f, 0xf1, 0x3d, 0x63, 0x01, 0xcf, 0x32, 0x7b,
+	0x96, 0x3a, 0xc7, 0x97, 0x7c, 0x4b, 0x7f, 0x04, 0x6b, 0xb9, 0x8b, 0xb0, 0x8f, 0x89, 0x13, 0x75,
+	0xd3, 0x57, 0xb1, 0x88, 0xe8, 0x2b, 0x69, 0xf4, 0x3d, 0x09, 0x25, 0x2f, 0x62, 0x1b, 0xea, 0x97,
+	0x5c, 0xa8, 0x81, 0x54, 0x96, 0xcf, 0xce, 0xbf, 0xcc, 0xbd, 0xbc, 0xfc, 0x52, 0xa3, 0x96, 0x46,
+	0xd3, 0xa8, 0xcc, 0x01, 0xa5, 0x2a, 0x0d, 0x5d, 0x8a, 0x15, 0x32, 0xd7, 0x1b, 0x1a, 0xcb, 0xe8,
+	0x9c, 0x33, 0x38, 0x5b, 0x7c, 0x2b, 0x63, 0x94, 0x99, 0xc3, 0xe0, 0xf3, 0xbc, 0x31, 0xe2, 0xf3,
+	0x2c, 0x16, 0x1c, 0x15, 0xdf, 0xc9, 0x82, 0x95, 0xf3, 0xee, 0x1c, 0x19, 0xac, 0x8c, 0xc8, 0x60,
+	0xa9, 0xf0, 0x45, 0x90, 0x45, 0x00, 0xb7, 0xb2, 0x2c, 0xfc, 0xc0, 0x3d, 0x72, 0x3d, 0xab, 0x9b,
+	0xe7, 0x55, 0x1f, 0x91, 0xd7, 0xcd, 0x34, 0xaf, 0x67, 0x82, 0x58, 0x96, 0xe7, 0x87, 0x60, 0x64,
+	0x79, 0x06, 0xe4, 0x79, 0x44, 0x28, 0x3e, 0xfe, 0x2a, 0xba, 0xbf, 0xf9, 0x34, 0x11, 0x93, 0xef,
+	0xb6, 0x1c, 0xfd, 0x6b, 0xd0, 0xb3, 0x88, 0xcc, 0x6d, 0x1a, 0x0f, 0xd7, 0x4a, 0xeb, 0xb5, 0x73,
+	0x02, 0x25, 0xe6, 0xcc, 0x2c, 0x44, 0x66, 0x9c, 0xc7, 0xa0, 0x4f, 0x52, 0x1e, 0x56, 0xac, 0xe8,
+	0xcf, 0xf2, 0x57, 0x41, 0xa3, 0xa3, 0x23, 0x26, 0x96, 0xed, 0x7b, 0xa1, 0xeb, 0xb1, 0x4c, 0x8a,
+	0x76, 0x58, 0xee, 0xb8, 0xbb, 0x56, 0x5a, 0x57, 0xcd, 0xb5, 0xcc, 0xa5, 0x72, 0xd0, 0x1d, 0x01,
+	0xb9, 0x45, 0x9f, 0x92, 0xb3, 0x61, 0x93, 0x11, 0xa9, 0x78, 0x87, 0xba, 0xbf, 0x25, 0x9d, 0x83,
+	0x01, 0x4b, 0x94, 0x1e, 0x0d, 0x9b, 0xcc, 0x63, 0x0e, 0xb5, 0xe7, 0xfe, 0x96, 0x6c, 0x33, 0x18,
+	0xfd, 0x36, 0x68, 0xb6, 0xe5, 0xd9, 0xa4, 0x2b, 0x2f, 0x8a, 0x38, 0xc6, 0x0d, 0x94, 0x61, 0x9a,
+	0xaf, 0x9b, 0x72, 0x59, 0x7f, 0x07, 0x66, 0xb2, 0xa0, 0xec, 0x4e, 0xd7, 0xf0, 0x4e, 0xb3, 0xb0,
+	0x2d, 0x84, 0xa5, 0xa1, 0x6b, 0x9f, 0x0c, 0x3a, 0xa9, 0x28, 0x75, 0x93, 0xc3, 0xf2, 0x8d, 0xfd,
+	0x38, 0x56, 0x1d, 0xc1, 0x9a, 0x80, 0x95, 0x6a, 0xd1, 0x09, 0xfd, 0x4e, 0xe2, 0xd1, 0x98, 0xf1,
+	0x35, 0x46, 0x33, 0xbe, 0x15, 0x4e, 0x48, 0xaa, 0xc4, 0xbe, 0xbf, 0x27, 0x7d, 0x1c, 0xb3, 0x42,
+	0x03, 0x26, 0xa5, 0xdd, 0xbd, 0xc9, 0x13, 0x7f, 0xf1, 0x53, 0xff, 0x15, 0x2c, 0x04, 0x24, 0x0c,
+	0x06, 0x22, 0x6e, 0x77, 0x3b, 0xae, 0x17, 0x92, 0xe0, 0xd4, 0xea, 0x1a, 0x6f, 0x8d, 0xc6, 0x78,
+	0x0e, 0xd1, 0x79, 0x6c, 0xef, 0xb6, 0x04, 0x72, 0x42, 0xb6, 0x67, 0xbd, 0x70, 0x7b, 0x51, 0x2f,
+	0x21, 0x7b, 0xeb, 0x2a, 0x64, 0x3f, 0xe3, 0xd8, 0x31, 0xd9, 0xfb, 0x79, 0xb2, 0xe2, 0x18, 0xd4,
+	0x78, 0x1b, 0x8f, 0x95, 0xc1, 0x12, 0xee, 0x84, 0xea, 0x1f, 0xc3, 0x12, 0xc7, 0x3a, 0xb0, 0xec,
+	0x13, 0xff, 0xf0, 0xb0, 0x63, 0xfb, 0xe4, 0xf0, 0xd0, 0xb5, 0x5d, 0xe2, 0x85, 0xc6, 0xcf, 0xd6,
+	0x4a, 0xeb, 0x25, 0x73, 0x11, 0x01, 0xb6, 0xf9, 0xfe, 0x4e, 0xb2, 0xad, 0xf7, 0xa0, 0x51, 0x90,
+	0x20, 0x90, 0x17, 0x7d, 0x97, 0x8b, 0xcb, 0xcd, 0x78, 0x7d, 0x44, 0x33, 0x5e, 0x1d, 0xca, 0x14,
+	0x76, 0x63, 0x4a, 0x68, 0xc4, 0x0f, 0x61, 0x95, 0x8b, 0xea, 0xf9, 0x5e, 0x07, 0xff, 0xb2, 0x0e,
+	0xba, 0xa4, 0x43, 0x82, 0xc0, 0x0f, 0xd0, 0x2e, 0xa9, 0x71, 0x7b, 0x6d, 0x6c, 0xbd, 0x6c, 0xbe,
+	0x81, 0x9b, 0x4f, 0x7d, 0xcf, 0x94, 0x40, 0xbb, 0x0c, 0x86, 0x99, 0x1c, 0xd5, 0xd7, 0x41, 0x3b,
+	0xb6, 0x28, 0xc7, 0xef, 0xf4, 0xfd, 0xae, 0x6b, 0x0f, 0x8c, 0x77, 0x50, 0xb5, 0x6b, 0xc7, 0x16,
+	0x45, 0x8c, 0xcf, 0x71, 0x55, 0x7f, 0x13, 0xa6, 0xec, 0xc0, 0xf7, 0x62, 0xfd, 0x33, 0xde, 0x45,
+	0x4d, 0xad, 0xb2, 0x45, 0xa9, 0x4b, 0x2c, 0x45, 0xa5, 0xee, 0x11, 0xf3, 0x5e, 0xb6, 0x1f, 0x79,
+	0xa1, 0xd1, 0x44, 0xeb, 0xaa, 0xf0, 0xb5, 0x1d, 0xb6, 0xa4, 0xdf, 0x82, 0x9a, 0x65, 0x87, 0xee,
+	0xa9, 0x1b, 0x0e, 0x04, 0xd0, 0xa7, 0x08, 0x34, 0x25, 0x57, 0x39, 0xd8, 0x26, 0xcc, 0xdb, 0xc7,
+	0x6e, 0xd7, 0x49, 0x5d, 0x25, 0x87, 0x7e, 0xcc, 0x43, 0x24, 0x6e, 0xc6, 0x77, 0xc3, 0x71, 0xd6,
+	0x41, 0x8b, 0x28, 0x09, 0xf0, 0xa2, 0x03, 0x01, 0xde, 0x42, 0xf0, 0x1a, 0x5b, 0x67, 0xd7, 0x16,
+	0x70, 0xc8, 0x2d, 0xb8, 0x21, 0xed, 0x53, 0x98, 0x2b, 0x79, 0x11, 0x92, 0x20, 0x11, 0xbc, 0xcd,
+	0x63, 0xa0, 0x00, 0xda, 0x41, 0x98, 0x5d, 0x01, 0x12, 0x0b, 0x28, 0x8e, 0x9a, 0x43, 0xfd, 0x25,
+	0x17, 0x90, 0x6f, 0x66, 0x71, 0x6e, 0x42, 0x55, 0xa4, 0x0f, 0x1c, 0xf4, 0x33, 0x7e, 0x3d, 0x7c,
+	0x8d, 0x83, 0x7c, 0x01, 0x33, 0x56, 0x14, 0xfa, 0x9d, 0x80, 0x50, 0x12, 0x76, 0xfa, 0xbe, 0xeb,
+	0x85, 0xd4, 0xb8, 0x87, 0x4a, 0x73, 0x2b, 0xf1, 0xb0, 0xcc, 0xb5, 0xc6, 0xbd, 0x8d, 0xd3, 0xbb,
+	0x4d, 0x93, 0x41, 0x7f, 0x8e, 0xc0, 0xe6, 0x34, 0xc3, 0x4f, 0x2d, 0xe8, 0x7f, 0x05, 0x33, 0x94,
+	0x58, 0x81, 0x7d, 0xcc, 0x6c, 0x20, 0x70, 0x0f, 0x22, 0xe6, 0xf7, 0xee, 0x63, 0x81, 0xf8, 0x6c,
+	0x94, 0xea, 0xa6, 0xb0, 0x1a, 0x69, 0xee, 0x21, 0xc9, 0xad, 0x98, 0x22, 0xaf, 0x18, 0x35, 0x9a,
+	0x5b, 0xd6, 0xbf, 0x02, 0xa5, 0x47, 0x7a, 0xbe, 0xf1, 0x01, 0x32, 0xdc, 0x79, 0x75, 0x86, 0x9f,
+	0x91, 0x9e, 0xcf, 0x99, 0x20, 0x41, 0xfd, 0x1b, 0x98, 0x11, 0x69, 0x93, 0xf0, 0xeb, 0x2e, 0xa1,
+	0xc6, 0x9f, 0xe0, 0x4d, 0xbd, 0x5f, 0xc8, 0x45, 0x78, 0x7f, 0xc6, 0x41, 0x24, 0x55, 0x8f, 0x25,
+	0x9e, 0xa9, 0x9d, 0xe6, 0x56, 0xf4, 0x7b, 0xb0, 0x20, 0xf2, 0xd4, 0x58, 0x01, 0x45, 0x51, 0xf3,
+	0x21, 0x2a, 0xfe, 0x2c, 0xee, 0xc6, 0x22, 0xf2, 0xe2, 0xe6, 0x2f, 0x60, 0x3a, 0x01, 0x67, 0xa5,
+	0x38, 0x35, 0x3e, 0x42, 0x89, 0x36, 0x47, 0x39, 0x77, 0x4c, 0x8c, 0x95, 0x92, 0xd4, 0xac, 0x91,
+	0xcc, 0xef, 0x4c, 0x36, 0x12, 0x44, 0xc3, 0xae, 0xe5, 0xe7, 0x57, 0xcd, 0x46, 0xcc, 0x28, 0xef,
+	0x54, 0xee, 0xc3, 0xe2, 0x50, 0x86, 0x1e, 0xbe, 0xc0, 0x53, 0x7f, 0xcc, 0xd5, 0x3a, 0x9b, 0xa5,
+	0xef, 0xbf, 0x60, 0xa7, 0xbe, 0x0f, 0x0b, 0xec, 0xac, 0xa4, 0x13, 0x06, 0x96, 0x47, 0xdd, 0x94,
+	0xb1, 0x7e, 0x82, 0x48, 0x73, 0xb8, 0xbb, 0x1f, 0x6f, 0x72, 0x4d, 0xff, 0x14, 0x6a, 0xd9, 0x3a,
+	0xca, 0xf8, 0xd3, 0x11, 0x0f, 0x30, 0x45, 0xd2, 0xd5, 0x93, 0xbe, 0x01, 0x73, 0x1e, 0x39, 0x1b,
+	0x7e, 0xa7, 0x3f, 0xe3, 0x45, 0xad, 0x47, 0xce, 0x72, 0xaf, 0xf4, 0x04, 0xaa, 0xa2, 0x04, 0xc5,
+	0xfe, 0xa3, 0xf1, 0x0b, 0xe4, 0x7b, 0xbb, 0xf0, 0x89, 0x10, 0x82, 0xab, 0x8c, 0x1d, 0xfa, 0xc1,
+	0x0e, 0xfb, 0x29, 0x0b, 0x5a, 0xfc, 0xa1, 0x7f, 0x04, 0xc6, 0x50, 0x41, 0x2b, 0xf3, 0xf9, 0x07,
+	0xbc, 0x3e, 0xcd, 0x55, 0xb5, 0x32, 0xa5, 0xbf, 0x07, 0x0b, 0x76, 0xd7, 0xa7, 0xe2, 0xde, 0x0e,
+	0x49, 0xc0, 0x13, 0x01, 0xd7, 0x31, 0xfe, 0x5c, 0x38, 0x39, 0xb6, 0xbb, 0x2f, 0x36, 0x45, 0x11,
+	0xf5, 0x21, 0x18, 0x1c, 0xe9, 0xd4, 0xa5, 0xee, 0x81, 0xdb, 0x65, 0x7e, 0x54, 0xa2, 0x6d, 0x21,
+	0xda, 0x3c, 0xee, 0x7f, 0x19, 0x6f, 0x0b, 0xc4, 0x07, 0x00, 0x82, 0x1b, 0xbb, 0xeb, 0xed, 0x51,
+	0x2b, 0x20, 0x2e, 0x03, 0xbb, 0xe7, 0x5d, 0x58, 0x2d, 0xe6, 0x2c, 0xca, 0x6f, 0xe2, 0x18, 0x3b,
+	0x18, 0x3a, 0x56, 0x0a, 0x04, 0xd8, 0x91, 0x30, 0xfa, 0x01, 0xcc, 0x1e, 0x58, 0x94, 0xa4, 0xde,
+	0xcb, 0xf5, 0x0e, 0x7d, 0xe3, 0xc9, 0x05, 0x76, 0x92, 0x76, 0x75, 0xdb, 0x16, 0x25, 0x19, 0xc7,
+	0x60, 0xce, 0x1c, 0xe4, 0x97, 0xf4, 0xaf, 0x79, 0x35, 0x4d, 0x02, 0xf9, 0x12, 0x1d, 0x3c, 0x93,
+	0xf1, 0x14, 0x99, 0xbc, 0x93, 0x75, 0xa4, 0xa2, 0x9f, 0x2c, 0x1c, 0x0f, 0x09, 0xc4, 0xf3, 0xec,
+	0x31, 0x0c, 0x5e, 0x58, 0x67, 0xd7, 0xf4, 0x5e, 0xec, 0xc6, 0x99, 0xe4, 0xd4, 0x78, 0x86, 0xae,
+	0xad, 0xfd, 0xea, 0xae, 0x8d, 0x97, 0x86, 0xec, 0x4f, 0xd9, 0x78, 0x8b, 0x92, 0x95, 0x65, 0x07,
+	0xe6, 0x0b, 0x9d, 0x6d, 0x41, 0xcb, 0xed, 0x83, 0x6c, 0xf3, 0x6a, 0xf5, 0xbc, 0x83, 0x7e, 0x6e,
+	0x0d, 0xba, 0xbe, 0xe5, 0xa4, 0xbb, 0x63, 0xbf, 0x86, 0x72, 0xec, 0x61, 0x7f, 0x5c, 0xca, 0x2e,
+	0x68, 0xf9, 0x03, 0x16, 0x30, 0x78, 0x90, 0x65, 0x50, 0x6c, 0x8d, 0xfc, 0x5a, 0x18, 0x9f, 0x84,
+	0x62, 0xb6, 0xe5, 0xc6, 0xdb, 0x6c, 0x71, 0x3b, 0xad, 0xad, 0xa8, 0x9a, 0x36, 0xd3, 0x56, 0xd4,
+	0x3b, 0xda, 0x7b, 0x6d, 0x45, 0x7d, 0x4f, 0x6b, 0xb6, 0x15, 0x75, 0x43, 0x7b, 0xbf, 0xad, 0xa8,
+	0xef, 0x6b, 0x77, 0xdb, 0x8a, 0x7a, 0x57, 0xdb, 0x6c, 0x2b, 0xea, 0xa6, 0x76, 0xaf, 0x71, 0x0f,
+	0x6a, 0x59, 0x07, 0xcc, 0xc2, 0x75, 0xba, 0x62, 0x40, 0x69, 0xc7, 0xcc, 0xca, 0x71, 0x52, 0x1f,
+	0x34, 0xfe, 0xbb, 0x04, 0x0b, 0x43, 0x6f, 0xca, 0xb0, 0x09, 0x96, 0x02, 0x01, 0x61, 0x5a, 0x92,
+	0x2a, 0x05, 0x4a, 0xa2, 0x14, 0xc0, 0x8d, 0xa4, 0x14, 0x98, 0x87, 0x09, 0xe1, 0xb4, 0x78, 0xcb,
+	0x6e, 0x3c, 0x40, 0x47, 0xd5, 0x86, 0x71, 0x74, 0x9d, 0xd8, 0x9f, 0xab, 0x6d, 0xde, 0x1f, 0xad,
+	0xc4, 0xca, 0xca, 0x61, 0x72, 0x12, 0xfa, 0x23, 0x98, 0x60, 0x7f, 0x44, 0xd4, 0x50, 0xf2, 0xf5,
+	0xda, 0xe5, 0x54, 0x22, 0x6a, 0x0a, 0xec, 0xc6, 0xff, 0x4c, 0x80, 0x96, 0x71, 0x49, 0x3f, 0x56,
+	0x6b, 0x32, 0xb9, 0x83, 0xb1, 0xf4, 0x1d, 0xec, 0x40, 0x39, 0x29, 0x35, 0xb9, 0xe8, 0x6f, 0x5f,
+	0x7c, 0x0f, 0x71, 0x89, 0xa9, 0x86, 0xe2, 0x2f, 0xd6, 0x74, 0x0c, 0xad, 0xe0, 0x88, 0xe4, 0xda,
+	0x9e, 0xbc, 0x3d, 0x39, 0xc3, 0xb7, 0x72, 0x6d, 0x4f, 0x01, 0x9f, 0x96, 0x79, 0x02, 0xc1, 0x35,
+	0xbe, 0x93, 0x6d, 0x7b, 0x0a, 0x68, 0x71, 0x80, 0x49, 0x7e, 0x7c, 0xbe, 0xc8, 0x63, 0x4e, 0xb6,
+	0x17, 0xa9, 0xe6, 0x7b, 0x91, 0x9f, 0xc0, 0xb2, 0x20, 0xc1, 0xb3, 0xde, 0x98, 0xad, 0xef, 0x75,
+	0x07, 0xd8, 0xba, 0x54, 0xcd, 0x45, 0x0e, 0xb1, 0xc3, 0x00, 0x24, 0xf7, 0x67, 0x5e, 0x77, 0xc0,
+	0xa4, 0x2d, 0x68, 0x06, 0x01, 0x6f, 0xab, 0xd1, 0x7c, 0x03, 0xc8, 0x80, 0x49, 0x19, 0x9e, 0x2a,
+	0x7c, 0x7e, 0x23, 0x7e, 0xea, 0x8b, 0x30, 0x29, 0x23, 0x49, 0x15, 0x77, 0x26, 0x42, 0x1e, 0x3a,
+	0x5a, 0x30, 0x9d, 0xf6, 0xf9, 0x2c, 0x7e, 0x4c, 0x8d, 0xda, 0xfa, 0x4a, 0x10, 0xd9, 0x16, 0x93,
+	0xd5, 0x21, 0x2c, 0x10, 0x74, 0xac, 0xc3, 0x90, 0x65, 0xe9, 0x2c, 0x54, 0x18, 0xd3, 0x78, 0x40,
+	0x8d, 0xef, 0x6c, 0xb1, 0x8d, 0x1d, 0xb6, 0xae, 0xff, 0x6d, 0x09, 0x78, 0x30, 0x49, 0xb7, 0x5c,
+	0x99, 0x88, 0x0e, 0x09, 0x2d, 0x17, 0x07, 0x2a, 0x4c, 0x8c, 0xa7, 0xa3, 0xb8, 0xde, 0xbc, 0xd2,
+	0x36, 0x91, 0x45, 0xd2, 0x88, 0xb5, 0xe8, 0xc9, 0x43, 0x4e, 0xf5, 0xf1, 0x35, 0x73, 0xc9, 0x3e,
+	0x6f, 0x73, 0xf9, 0x6b, 0x58, 0x3a, 0x17, 0x53, 0x7f, 0x00, 0x2b, 0xb6, 0xe5, 0x75, 0xe8, 0x89,
+	0xdb, 0x4f, 0x87, 0x49, 0xe6, 0xbd, 0x5d, 0x56, 0xd3, 0x96, 0xf0, 0xa0, 0x4b, 0xb6, 0xe5, 0xed,
+	0x9d, 0xb8, 0xfd, 0x24, 0x44, 0x6e, 0x09, 0x80, 0xed, 0x1a, 0x54, 0xd3, 0x07, 0xe4, 0xbe, 0xac,
+	0xf1, 0x0f, 0x0a, 0xcc, 0xa6, 0x86, 0x2f, 0x3f, 0x19, 0xbb, 0x4b, 0xe9, 0xda, 0x78, 0x56, 0xd7,
+	0xde, 0x82, 0x5a, 0xae, 0x0d, 0xcc, 0x27, 0x00, 0xd5, 0xc3, 0x74, 0x0b, 0xb8, 0x01, 0x53, 0x1e,
+	0x79, 0x91, 0x02, 0xe2, 0x0d, 0xff, 0x0a, 0x5b, 0x94, 0x30, 0xc5, 0xda, 0xaf, 0x9e, 0xa3, 0xfd,
+	0x37, 0xa1, 0x7a, 0x10, 0x58, 0x9e, 0x7d, 0xdc, 0x09, 0xfd, 0x13, 0xc2, 0x4d, 0xa0, 0x6a, 0x56,
+	0xf8, 0xda, 0x3e, 0x5b, 0x92, 0xf9, 0x24, 0xbb, 0x94, 0x0c, 0xe8, 0x14, 0x82, 0xb2, 0x7c, 0xd2,
+	0x8c, 0xbc, 0xed, 0x14, 0x42, 0xca, 0x6e, 0xa6, 0x2f, 0xb3, 0x1b, 0xed, 0x15, 0xed, 0x66, 0x05,
+	0x40, 0x0a, 0x25, 0x1a, 0xec, 0x65, 0x53, 0xe5, 0xa2, 0xb4, 0x9c, 0xdc, 0x60, 0x29, 0x1e, 0x29,
+	0x35, 0xfe, 0x6b, 0x0c, 0xf4, 0x5c, 0x22, 0xf8, 0xd3, 0x56, 0x9b, 0xd4, 0x55, 0x4f, 0x5c, 0x76,
+	0xd5, 0x93, 0xaf, 0x78, 0xd5, 0xd9, 0x44, 0x59, 0xbd, 0x7a, 0xa2, 0x9c, 0x9d, 0x35, 0x94, 0xaf,
+	0x3e, 0x6b, 0xb8, 0x28, 0xc7, 0x87, 0x0b, 0x72, 0xfc, 0xc6, 0x1f, 0xc6, 0x61, 0x8a, 0x51, 0xf8,
+	0xe9, 0x44, 0xe6, 0x5d, 0xa8, 0x8a, 0xfe, 0x25, 0xa7, 0x33, 0x8e, 0x74, 0x1a, 0xe7, 0x24, 0x27,
+	0xa2, 0x4b, 0x89, 0x34, 0x2a, 0x61, 0xf2, 0x43, 0x27, 0xa9, 0xe1, 0x81, 0xec, 0xdd, 0x21, 0xbd,
+	0x09, 0xa4, 0x77, 0x77, 0xb4, 0xcc, 0x49, 0x74, 0xf5, 0x90, 0xfc, 0xec, 0xd9, 0xf0, 0x62, 0x5a,
+	0x31, 0x27, 0xb3, 0x8a, 0x79, 0x1b, 0x62, 0x5f, 0x13, 0x0f, 0x2e, 0x54, 0xec, 0x34, 0x4e, 0xcb,
+	0x75, 0x39, 0xb4, 0x58, 0x02, 0x35, 0x76, 0x53, 0x65, 0x4e, 0x85, 0x08, 0xef, 0x94, 0x52, 0x6f,
+	0xb8, 0x4c, 0xbd, 0x2b, 0xaf, 0xa8, 0xde, 0x79, 0x0f, 0x58, 0x1d, 0xf6, 0x80, 0xb7, 0x41, 0xb3,
+	0xba, 0x01, 0xb1, 0x1c, 0x19, 0xb9, 0x88, 0x83, 0xde, 0x4f, 0x35, 0xa7, 0xc5, 0xfa, 0x96, 0x58,
+	0xce, 0x19, 0x4b, 0xed, 0xca, 0xc6, 0xd2, 0xf8, 0xc3, 0x75, 0xd0, 0x64, 0xf4, 0x8b, 0xb5, 0x36,
+	0x75, 0x0f, 0xa5, 0xcc, 0x3d, 0xe4, 0xd5, 0xf9, 0xfa, 0xa5, 0xea, 0x3c, 0x76, 0x81, 0x3a, 0x2b,
+	0xe7, 0xaa, 0xf3, 0xf8, 0xff, 0xdf, 0x73, 0x4d, 0x64, 0x15, 0xe4, 0xc7, 0x73, 0x50, 0x8d, 0x7f,
+	0xac, 0x41, 0x75, 0x4b, 0x74, 0x4b, 0xf1, 0xba, 0x52, 0x5c, 0x4b, 0x59, 0xae, 0x1f, 0x82, 0x91,
+	0x0f, 0x8e, 0xf1, 0xf0, 0x9c, 0x7f, 0x96, 0x31, 0x9f, 0x0d, 0x91, 0x72, 0x76, 0xfe, 0x29, 0xd4,
+	0x72, 0x03, 0x28, 0x65, 0xd4, 0xee, 0x0c, 0xcd, 0x0c, 0x9b, 0xd6, 0x41, 0x1b, 0x9a, 0x30, 0x72,
+	0xa7, 0x5e, 0xa3, 0xd9, 0xa9, 0xe2, 0x0e, 0x54, 0x33, 0xe3, 0xbb, 0x51, 0xaf, 0xa7, 0x42, 0x53,
+	0x23, 0xbb, 0x55, 0xa8, 0xc4, 0xed, 0x65, 0x91, 0x06, 0x94, 0x4d, 0x90, 0x4b, 0x3c, 0x11, 0x4f,
+	0xd5, 0x63, 0xe2, 0xa3, 0x80, 0x20, 0xae, 0xc4, 0x7e, 0x03, 0x4b, 0xe7, 0x4f, 0x58, 0x60, 0xb4,
+	0x89, 0xc4, 0x02, 0x2d, 0x9e, 0xad, 0xe4, 0x68, 0x27, 0x76, 0x73, 0x85, 0x2f, 0x08, 0x52, 0xb4,
+	0x77, 0xa4, 0x0d, 0x31, 0xda, 0xfb, 0xb0, 0x20, 0x64, 0xcd, 0x13, 0x1e, 0xf1, 0x0b, 0x82, 0x59,
+	0x1e, 0x7e, 0xb2, 0x54, 0x9f, 0xc0, 0xcc, 0x31, 0xb1, 0x82, 0xf0, 0x80, 0x58, 0xe1, 0x55, 0x3f,
+	0x1b, 0xd0, 0x62, 0x4c, 0x49, 0xad, 0x68, 0x8e, 0x56, 0xbb, 0xc2, 0x1c, 0x8d, 0x27, 0x57, 0x45,
+	0x73, 0x34, 0xde, 0xf1, 0x97, 0x13, 0x60, 0x56, 0xe4, 0x6a, 0xdc, 0xf7, 0x86, 0x32, 0x18, 0xf2,
+	0x2a, 0x36, 0x3d, 0xde, 0x9a, 0xc9, 0x8e, 0xb7, 0xb2, 0x05, 0x9a, 0x9e, 0x2f, 0xd0, 0x6e, 0x27,
+	0x6a, 0xec, 0x3a, 0xc4, 0x0b, 0xdd, 0x70, 0x60, 0xcc, 0xca, 0x59, 0x1d, 0xae, 0xb7, 0xc4, 0x72,
+	0xe1, 0x4c, 0x65, 0xae, 0x70, 0xa6, 0x72, 0xfe, 0x48, 0x6d, 0xfe, 0xf5, 0x8c, 0xd4, 0x16, 0x5e,
+	0xcf, 0x48, 0x6d, 0xf1, 0x82, 0x91, 0xda, 0x3e, 0xcc, 0x73, 0xac, 0x7c, 0xbb, 0xda, 0x18, 0xd1,
+	0xbc, 0x67, 0x11, 0x3d, 0xd7, 0xa8, 0xbe, 0x70, 0x50, 0xb7, 0x74, 0xf1, 0xa0, 0x6e, 0x84, 0xc9,
+	0xd9, 0xf2, 0xe5, 0x93, 0xb3, 0xa7, 0xa0, 0x73, 0x2a, 0xbc, 0x61, 0xce, 0x3f, 0x8f, 0x15, 0x9f,
+	0x1c, 0xac, 0x65, 0xd3, 0x17, 0xb1, 0xc9, 0x42, 0xc6, 0x23, 0xfe, 0xa7, 0xa9, 0x21, 0xee, 0x13,
+	0xd6, 0x4c, 0xe7, 0x2b, 0xac, 0x03, 0x90, 0xa2, 0x27, 0xba, 0x97, 0xb1, 0xaa, 0xad, 0xa0, 0xaa,
+	0x2d, 0xc6, 0x58, 0xbc, 0x53, 0x19, 0xab, 0x5c, 0x71, 0x0d, 0x54, 0x3f, 0xa7, 0x06, 0xfa, 0x12,
+	0x16, 0x90, 0x49, 0x62, 0xda, 0xb2, 0x9c, 0x5e, 0x2d, 0x12, 0x7f, 0xa8, 0xb9, 0x47, 0xcd, 0x39,
+	0x86, 0xff, 0x58, 0xa2, 0xcb, 0xe2, 0xf7, 0x1b, 0x58, 0xce, 0xd1, 0x4d, 0x7f, 0x2c, 0xb3, 0x36,
+	0xea, 0xd7, 0x18, 0x19, 0xda, 0xa9, 0xaf, 0x66, 0xee, 0xc3, 0x42, 0x44, 0x09, 0x76, 0x9b, 0xad,
+	0xd0, 0x65, 0x4f, 0x26, 0x83, 0xde, 0x4d, 0xb4, 0xae, 0xb9, 0x88, 0x92, 0x9d, 0x78, 0x53, 0x74,
+	0x6c, 0xdb, 0x8a, 0x3a, 0xa6, 0x29, 0x6d, 0x45, 0x9d, 0xd0, 0x26, 0xdb, 0x8a, 0x7a, 0x43, 0xab,
+	0x37, 0xfe, 0xb5, 0x04, 0x65, 0x46, 0x30, 0xb8, 0x24, 0x76, 0x16, 0x45, 0xae, 0xeb, 0x85, 0x91,
+	0x6b, 0x0b, 0x2a, 0xa8, 0xdd, 0x22, 0xae, 0x8f, 0x8d, 0x78, 0x52, 0xe0, 0x48, 0x32, 0x6e, 0xa5,
+	0xdd, 0x97, 0x82, 0x7c, 0x20, 0x4c, 0x3c, 0xd7, 0x12, 0xa8, 0xdc, 0xcb, 0xc5, 0x7d, 0xab, 0x49,
+	0xfc, 0xdd, 0x72, 0x1a, 0xff, 0xa6, 0x80, 0xbe, 0x93, 0x99, 0x87, 0x5e, 0x9e, 0x15, 0x24, 0xb3,
+	0x8a, 0xe2, 0xac, 0x20, 0xde, 0xcf, 0x64, 0x05, 0x45, 0x57, 0x32, 0x56, 0x78, 0x25, 0x4d, 0x98,
+	0x95, 0x90, 0xe9, 0x6c, 0x4c, 0x74, 0xdc, 0xc4, 0x56, 0xaa, 0x87, 0xf6, 0x16, 0x48, 0x0a, 0xb2,
+	0xc6, 0xe5, 0xdd, 0x36, 0x99, 0x12, 0xf0, 0x2e, 0x5a, 0x61, 0x4f, 0x55, 0x2d, 0xee, 0xa9, 0xae,
+	0x40, 0x39, 0x4e, 0x0b, 0x65, 0x9c, 0x8f, 0x17, 0xae, 0xf8, 0xf1, 0xdf, 0xaf, 0xe3, 0x8f, 0x16,
+	0x79, 0x6c, 0x15, 0x5e, 0xbd, 0x82, 0x59, 0xe2, 0xfa, 0x39, 0xc5, 0xca, 0xe7, 0x72, 0x48, 0x44,
+	0x09, 0xf7, 0xf7, 0xf2, 0xf3, 0xc6, 0xd4, 0x12, 0x93, 0x23, 0xff, 0x14, 0x71, 0xfb, 0x4d, 0xcb,
+	0x3e, 0x02, 0xce, 0x70, 0xc6, 0xf9, 0xc8, 0x6a, 0xea, 0xaa, 0x23, 0x2b, 0x8e, 0x37, 0x94, 0x3f,
+	0xd7, 0x86, 0xf2, 0xe7, 0xf8, 0xb3, 0xd5, 0x49, 0x4d, 0x6d, 0xfc, 0x53, 0x09, 0x66, 0xcc, 0xf4,
+	0x0c, 0xfc, 0x75, 0x29, 0x56, 0x61, 0xbc, 0x1f, 0x2b, 0xfe, 0x6e, 0xa6, 0xf8, 0xca, 0x94, 0xe2,
+	0x2b, 0x6b, 0xfc, 0x73, 0x09, 0x60, 0x0f, 0x67, 0xf1, 0xaf, 0x4b, 0xf6, 0x6c, 0x46, 0x39, 0x96,
+	0xcf, 0x28, 0x8b, 0xc5, 0x9d, 0x2c, 0x16, 0x37, 0xf7, 0xd1, 0x30, 0x77, 0x5a, 0xaa, 0x56, 0x6e,
+	0xfc, 0xae, 0x04, 0xea, 0xce, 0x31, 0xb1, 0x4f, 0x68, 0xd4, 0xcb, 0x1f, 0x62, 0x3c, 0x39, 0xc4,
+	0x43, 0x98, 0x38, 0xec, 0x5a, 0xa7, 0x7e, 0x80, 0x22, 0xd7, 0x36, 0xef, 0x5c, 0x5c, 0xc1, 0x48,
+	0x8a, 0x8f, 0x10, 0xc7, 0x14, 0xb8, 0xc9, 0x97, 0xdb, 0x63, 0x58, 0x1b, 0xf2, 0x1f, 0xdb, 0x7f,
+	0xf9, 0xed, 0x77, 0xf5, 0x6b, 0x7f, 0xfc, 0xae, 0x7e, 0xed, 0x87, 0xef, 0xea, 0xa5, 0xdf, 0xbd,
+	0xac, 0x97, 0xfe, 0xfe, 0x65, 0xbd, 0xf4, 0x2f, 0x2f, 0xeb, 0xa5, 0x6f, 0x5f, 0xd6, 0x4b, 0xff,
+	0xf1, 0xb2, 0x5e, 0xfa, 0xcf, 0x97, 0xf5, 0x6b, 0x3f, 0xbc, 0xac, 0x97, 0x7e, 0xff, 0x7d, 0xfd,
+	0xda, 0xb7, 0xdf, 0xd7, 0xaf, 0xfd, 0xf1, 0xfb, 0xfa, 0xb5, 0xdf, 0xdc, 0x3f, 0xf2, 0x13, 0x19,
+	0x5c, 0xff, 0xfc, 0xff, 0x75, 0xf8, 0x24, 0xf5, 0xf3, 0x60, 0x02, 0x9d, 0xe6, 0xbd, 0xff, 0x1b,
+	0x00, 0x22, 0xfe, 0x44, 0xed, 0x8e, 0x33, 0x00, 0x00,
 }
 
 func (this *ShardInfo) Equal(that interface{}) bool {
@@ -3317,6 +3327,13 @@ func (this *TimerTaskInfo) Equal(that interface{}) bool {
 	if this.AlreadyArchived != that1.AlreadyArchived {
 		return false
 	}
+	if that1.CloseTime == nil {
+		if this.CloseTime != nil {
+			return false
+		}
+	} else if !this.CloseTime.Equal(*that1.CloseTime) {
+		return false
+	}
 	return true
 }
 func (this *ArchivalTaskInfo) Equal(that interface{}) bool {
@@ -4017,7 +4034,7 @@ func (this *TimerTaskInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 17)
+	s := make([]string, 0, 18)
 	s = append(s, "&persistence.TimerTaskInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -4032,6 +4049,7 @@ func (this *TimerTaskInfo) GoString() string {
 	s = append(s, "VisibilityTime: "+fmt.Sprintf("%#v", this.VisibilityTime)+",\n")
 	s = append(s, "BranchToken: "+fmt.Sprintf("%#v", this.BranchToken)+",\n")
 	s = append(s, "AlreadyArchived: "+fmt.Sprintf("%#v", this.AlreadyArchived)+",\n")
+	s = append(s, "CloseTime: "+fmt.Sprintf("%#v", this.CloseTime)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -5504,6 +5522,16 @@ func (m *TimerTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CloseTime != nil {
+		n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime):])
+		if err35 != nil {
+			return 0, err35
+		}
+		i -= n35
+		i = encodeVarintExecutions(dAtA, i, uint64(n35))
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.AlreadyArchived {
 		i--
 		if m.AlreadyArchived {
@@ -5522,12 +5550,12 @@ func (m *TimerTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x62
 	}
 	if m.VisibilityTime != nil {
-		n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err35 != nil {
-			return 0, err35
+		n36, err36 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err36 != nil {
+			return 0, err36
 		}
-		i -= n35
-		i = encodeVarintExecutions(dAtA, i, uint64(n35))
+		i -= n36
+		i = encodeVarintExecutions(dAtA, i, uint64(n36))
 		i--
 		dAtA[i] = 0x5a
 	}
@@ -5611,12 +5639,12 @@ func (m *ArchivalTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VisibilityTime != nil {
-		n36, err36 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err36 != nil {
-			return 0, err36
+		n37, err37 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err37 != nil {
+			return 0, err37
 		}
-		i -= n36
-		i = encodeVarintExecutions(dAtA, i, uint64(n36))
+		i -= n37
+		i = encodeVarintExecutions(dAtA, i, uint64(n37))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -5692,12 +5720,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x88
 	}
 	if m.LastHeartbeatUpdateTime != nil {
-		n37, err37 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime):])
-		if err37 != nil {
-			return 0, err37
+		n38, err38 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime):])
+		if err38 != nil {
+			return 0, err38
 		}
-		i -= n37
-		i = encodeVarintExecutions(dAtA, i, uint64(n37))
+		i -= n38
+		i = encodeVarintExecutions(dAtA, i, uint64(n38))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -5767,12 +5795,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc9
 	}
 	if m.RetryExpirationTime != nil {
-		n40, err40 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
-		if err40 != nil {
-			return 0, err40
+		n41, err41 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
+		if err41 != nil {
+			return 0, err41
 		}
-		i -= n40
-		i = encodeVarintExecutions(dAtA, i, uint64(n40))
+		i -= n41
+		i = encodeVarintExecutions(dAtA, i, uint64(n41))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -5786,24 +5814,24 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb8
 	}
 	if m.RetryMaximumInterval != nil {
-		n41, err41 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
-		if err41 != nil {
-			return 0, err41
+		n42, err42 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
+		if err42 != nil {
+			return 0, err42
 		}
-		i -= n41
-		i = encodeVarintExecutions(dAtA, i, uint64(n41))
+		i -= n42
+		i = encodeVarintExecutions(dAtA, i, uint64(n42))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xb2
 	}
 	if m.RetryInitialInterval != nil {
-		n42, err42 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
-		if err42 != nil {
-			return 0, err42
+		n43, err43 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
+		if err43 != nil {
+			return 0, err43
 		}
-		i -= n42
-		i = encodeVarintExecutions(dAtA, i, uint64(n42))
+		i -= n43
+		i = encodeVarintExecutions(dAtA, i, uint64(n43))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -5869,43 +5897,43 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x70
 	}
 	if m.HeartbeatTimeout != nil {
-		n43, err43 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
-		if err43 != nil {
-			return 0, err43
-		}
-		i -= n43
-		i = encodeVarintExecutions(dAtA, i, uint64(n43))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if m.StartToCloseTimeout != nil {
-		n44, err44 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
+		n44, err44 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
 		if err44 != nil {
 			return 0, err44
 		}
 		i -= n44
 		i = encodeVarintExecutions(dAtA, i, uint64(n44))
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x6a
 	}
-	if m.ScheduleToCloseTimeout != nil {
-		n45, err45 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
+	if m.StartToCloseTimeout != nil {
+		n45, err45 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
 		if err45 != nil {
 			return 0, err45
 		}
 		i -= n45
 		i = encodeVarintExecutions(dAtA, i, uint64(n45))
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x62
 	}
-	if m.ScheduleToStartTimeout != nil {
-		n46, err46 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
+	if m.ScheduleToCloseTimeout != nil {
+		n46, err46 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
 		if err46 != nil {
 			return 0, err46
 		}
 		i -= n46
 		i = encodeVarintExecutions(dAtA, i, uint64(n46))
 		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ScheduleToStartTimeout != nil {
+		n47, err47 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
+		if err47 != nil {
+			return 0, err47
+		}
+		i -= n47
+		i = encodeVarintExecutions(dAtA, i, uint64(n47))
+		i--
 		dAtA[i] = 0x52
 	}
 	if len(m.RequestId) > 0 {
@@ -5923,12 +5951,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if m.StartedTime != nil {
-		n47, err47 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err47 != nil {
-			return 0, err47
+		n48, err48 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err48 != nil {
+			return 0, err48
 		}
-		i -= n47
-		i = encodeVarintExecutions(dAtA, i, uint64(n47))
+		i -= n48
+		i = encodeVarintExecutions(dAtA, i, uint64(n48))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -5938,12 +5966,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x28
 	}
 	if m.ScheduledTime != nil {
-		n48, err48 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err48 != nil {
-			return 0, err48
+		n49, err49 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err49 != nil {
+			return 0, err49
 		}
-		i -= n48
-		i = encodeVarintExecutions(dAtA, i, uint64(n48))
+		i -= n49
+		i = encodeVarintExecutions(dAtA, i, uint64(n49))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -5993,12 +6021,12 @@ func (m *TimerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x20
 	}
 	if m.ExpiryTime != nil {
-		n49, err49 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
-		if err49 != nil {
-			return 0, err49
+		n50, err50 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err50 != nil {
+			return 0, err50
 		}
-		i -= n49
-		i = encodeVarintExecutions(dAtA, i, uint64(n49))
+		i -= n50
+		i = encodeVarintExecutions(dAtA, i, uint64(n50))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -6873,6 +6901,10 @@ func (m *TimerTaskInfo) Size() (n int) {
 	if m.AlreadyArchived {
 		n += 2
 	}
+	if m.CloseTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime)
+		n += 1 + l + sovExecutions(uint64(l))
+	}
 	return n
 }
 
@@ -7457,6 +7489,7 @@ func (this *TimerTaskInfo) String() string {
 		`VisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.VisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`BranchToken:` + fmt.Sprintf("%v", this.BranchToken) + `,`,
 		`AlreadyArchived:` + fmt.Sprintf("%v", this.AlreadyArchived) + `,`,
+		`CloseTime:` + strings.Replace(fmt.Sprintf("%v", this.CloseTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12208,6 +12241,42 @@ func (m *TimerTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AlreadyArchived = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CloseTime == nil {
+				m.CloseTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CloseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
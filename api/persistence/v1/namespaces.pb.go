@@ -241,6 +241,8 @@ type NamespaceConfig struct {
 	VisibilityArchivalState      v1.ArchivalState  `protobuf:"varint,6,opt,name=visibility_archival_state,json=visibilityArchivalState,proto3,enum=temporal.api.enums.v1.ArchivalState" json:"visibility_archival_state,omitempty"`
 	VisibilityArchivalUri        string            `protobuf:"bytes,7,opt,name=visibility_archival_uri,json=visibilityArchivalUri,proto3" json:"visibility_archival_uri,omitempty"`
 	CustomSearchAttributeAliases map[string]string `protobuf:"bytes,8,rep,name=custom_search_attribute_aliases,json=customSearchAttributeAliases,proto3" json:"custom_search_attribute_aliases,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// How long the visibility records of closed workflow executions are kept, if not for the retention.
+	VisibilityRetention *time.Duration `protobuf:"bytes,9,opt,name=visibility_retention,json=visibilityRetention,proto3,stdduration" json:"visibility_retention,omitempty"`
 }
 
 func (m *NamespaceConfig) Reset()      { *m = NamespaceConfig{} }
@@ -331,6 +333,13 @@ func (m *NamespaceConfig) GetCustomSearchAttributeAliases() map[string]string {
 	return nil
 }
 
+func (m *NamespaceConfig) GetVisibilityRetention() *time.Duration {
+	if m != nil {
+		return m.VisibilityRetention
+	}
+	return nil
+}
+
 type NamespaceReplicationConfig struct {
 	ActiveClusterName string              `protobuf:"bytes,1,opt,name=active_cluster_name,json=activeClusterName,proto3" json:"active_cluster_name,omitempty"`
 	Clusters          []string            `protobuf:"bytes,2,rep,name=clusters,proto3" json:"clusters,omitempty"`
@@ -465,67 +474,68 @@ func init() {
 }
 
 var fileDescriptor_0486d93c2107d6bc = []byte{
-	// 949 bytes of a gzipped FileDescriptorProto
+	// 964 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xda, 0x8e, 0x5b, 0x4f, 0x1a, 0xa7, 0x19, 0x02, 0x75, 0x0d, 0x6c, 0x5c, 0x8b, 0x90,
-	0x70, 0x59, 0x93, 0x04, 0x01, 0x22, 0x2a, 0x52, 0x9c, 0x04, 0x51, 0x81, 0x5a, 0x69, 0x4b, 0x39,
-	0x54, 0x42, 0xcb, 0x78, 0x77, 0xec, 0x0e, 0x5d, 0xef, 0xac, 0x66, 0x66, 0x17, 0xe5, 0x86, 0x38,
-	0x70, 0xee, 0x91, 0x03, 0x7f, 0x00, 0x67, 0xfe, 0x0a, 0x8e, 0x39, 0xf6, 0x80, 0x04, 0x71, 0x2e,
-	0x1c, 0x7b, 0xe4, 0x88, 0xe6, 0xc7, 0xee, 0x7a, 0x93, 0x98, 0xd6, 0xdc, 0x3c, 0xef, 0x7d, 0xdf,
-	0x37, 0x6f, 0xde, 0xf7, 0xf6, 0xc9, 0x60, 0x4f, 0xe0, 0x49, 0x4c, 0x19, 0x0a, 0xfb, 0x1c, 0xb3,
-	0x14, 0xb3, 0x3e, 0x8a, 0x49, 0x3f, 0xc6, 0x8c, 0x13, 0x2e, 0x70, 0xe4, 0xe3, 0x7e, 0xba, 0xd3,
-	0x8f, 0xd0, 0x04, 0xf3, 0x18, 0xf9, 0x98, 0x3b, 0x31, 0xa3, 0x82, 0xc2, 0x5e, 0x46, 0x72, 0x34,
-	0xc9, 0x41, 0x31, 0x71, 0x66, 0x48, 0x4e, 0xba, 0xd3, 0xb1, 0xc7, 0x94, 0x8e, 0x43, 0xdc, 0x57,
-	0x8c, 0x61, 0x32, 0xea, 0x07, 0x09, 0x43, 0x82, 0xd0, 0x48, 0x6b, 0x74, 0x36, 0x2e, 0xe6, 0x05,
-	0x99, 0x60, 0x2e, 0xd0, 0x24, 0x36, 0x80, 0x3b, 0x01, 0x8e, 0x71, 0x14, 0xe0, 0xc8, 0x27, 0x98,
-	0xf7, 0xc7, 0x74, 0x4c, 0x55, 0x5c, 0xfd, 0x32, 0x90, 0xcd, 0xbc, 0x78, 0x59, 0x35, 0x8e, 0x92,
-	0x09, 0x2f, 0xd5, 0x6b, 0x60, 0x5b, 0x25, 0x58, 0x9e, 0x95, 0xd0, 0x09, 0xe6, 0x1c, 0x8d, 0x0d,
-	0xb0, 0xf7, 0x4f, 0x0d, 0xac, 0xde, 0xcf, 0xd2, 0x47, 0x58, 0x20, 0x12, 0xc2, 0x63, 0x50, 0x27,
-	0xd1, 0x88, 0xb6, 0xad, 0xae, 0xb5, 0xbd, 0xbc, 0xbb, 0xe3, 0xbc, 0xfc, 0xe9, 0x4e, 0x2e, 0x71,
-	0x2f, 0x1a, 0x51, 0x57, 0xd1, 0xe1, 0x17, 0xa0, 0xe1, 0xd3, 0x68, 0x44, 0xc6, 0xed, 0xaa, 0x12,
-	0xda, 0x5b, 0x48, 0xe8, 0x50, 0x51, 0x5d, 0x23, 0x01, 0x27, 0x00, 0x32, 0x1c, 0x87, 0xc4, 0x57,
-	0x0d, 0xf5, 0x8c, 0x70, 0x4d, 0x09, 0x7f, 0xba, 0x90, 0xb0, 0x5b, 0xc8, 0x98, 0x3b, 0xd6, 0xd8,
-	0xc5, 0x10, 0xdc, 0x04, 0x2d, 0x7d, 0x85, 0x97, 0x4a, 0x19, 0x1a, 0xb5, 0xeb, 0x5d, 0x6b, 0xbb,
-	0xe6, 0xae, 0xe8, 0xe8, 0xd7, 0x3a, 0x08, 0x07, 0xe0, 0xed, 0x11, 0x22, 0x21, 0x4d, 0x31, 0xf3,
-	0x22, 0x2a, 0xc8, 0x28, 0xab, 0x2f, 0x63, 0x2d, 0x29, 0xd6, 0x9b, 0x19, 0xe8, 0xfe, 0x0c, 0x26,
-	0xd3, 0x78, 0x0f, 0xdc, 0xcc, 0x35, 0x32, 0x5a, 0x43, 0xd1, 0x56, 0xb3, 0x78, 0x06, 0xfd, 0x12,
-	0xac, 0xe5, 0x50, 0x1c, 0x05, 0x9e, 0x9c, 0x9f, 0xf6, 0x35, 0xd5, 0x83, 0x8e, 0xa3, 0x87, 0xcb,
-	0xc9, 0x86, 0xcb, 0xf9, 0x2a, 0x1b, 0xae, 0x41, 0xfd, 0xd9, 0x9f, 0x1b, 0x56, 0xa1, 0x76, 0x1c,
-	0x05, 0x32, 0xd7, 0xfb, 0xad, 0x0a, 0x56, 0x4a, 0xbe, 0xc1, 0x16, 0xa8, 0x92, 0x40, 0xd9, 0xde,
-	0x74, 0xab, 0x24, 0x80, 0xfb, 0x60, 0x89, 0x0b, 0x24, 0xb0, 0x32, 0xb0, 0xb5, 0xbb, 0x59, 0xf4,
-	0x59, 0x36, 0x58, 0x0d, 0x5f, 0xa9, 0xb5, 0x0f, 0x25, 0xd8, 0xd5, 0x1c, 0x08, 0x41, 0x5d, 0xce,
-	0x9d, 0xf2, 0xa8, 0xe9, 0xaa, 0xdf, 0xb0, 0x0b, 0x96, 0x03, 0xcc, 0x7d, 0x46, 0x62, 0x91, 0xf5,
-	0xb4, 0xe9, 0xce, 0x86, 0xe0, 0x3a, 0x58, 0xa2, 0xdf, 0x47, 0x98, 0xa9, 0xce, 0x35, 0x5d, 0x7d,
-	0x80, 0x0f, 0x40, 0x3d, 0x40, 0x02, 0xb5, 0x1b, 0xdd, 0xda, 0xf6, 0xf2, 0xee, 0xfe, 0xc2, 0x13,
-	0xe9, 0x1c, 0x21, 0x81, 0x8e, 0x23, 0xc1, 0x4e, 0x5c, 0x25, 0xd4, 0xf9, 0x08, 0x34, 0xf3, 0x10,
-	0xbc, 0x09, 0x6a, 0x4f, 0xf1, 0x89, 0x79, 0xb7, 0xfc, 0x29, 0xab, 0x48, 0x51, 0x98, 0xe8, 0x87,
-	0x37, 0x5d, 0x7d, 0xf8, 0xa4, 0xfa, 0xb1, 0xd5, 0xfb, 0x63, 0x69, 0xe6, 0x7b, 0x31, 0xc3, 0x72,
-	0x17, 0x34, 0x19, 0x16, 0x38, 0x52, 0x6f, 0xd2, 0x1f, 0xcd, 0xed, 0x4b, 0x76, 0x1c, 0x99, 0x5d,
-	0x30, 0xa8, 0xff, 0x2c, 0xdd, 0x28, 0x18, 0x70, 0x0b, 0xac, 0x22, 0xe6, 0x3f, 0x21, 0x29, 0x0a,
-	0xbd, 0x61, 0xe2, 0x3f, 0xc5, 0xc2, 0x5c, 0xdb, 0xca, 0xc2, 0x03, 0x15, 0x85, 0xf7, 0xc0, 0x8d,
-	0x21, 0x0a, 0xbc, 0x21, 0x89, 0x10, 0x23, 0x98, 0x9b, 0xe9, 0x7f, 0xb7, 0xec, 0x4a, 0xb1, 0x09,
-	0xd2, 0x1d, 0x67, 0x80, 0x82, 0x81, 0x41, 0xbb, 0xcb, 0xc3, 0xe2, 0x00, 0x1f, 0x83, 0x37, 0x9e,
-	0x10, 0x2e, 0x28, 0x3b, 0xf1, 0xf2, 0xbb, 0xb5, 0xd5, 0x75, 0x65, 0xf5, 0x3b, 0x73, 0xac, 0x3e,
-	0x30, 0x60, 0xed, 0xf4, 0xba, 0xd1, 0x28, 0x45, 0xe1, 0xfb, 0x60, 0xfd, 0x92, 0x76, 0xc2, 0x88,
-	0x71, 0x14, 0x5e, 0xe0, 0x3c, 0x62, 0x04, 0x7e, 0x0b, 0x6e, 0xa7, 0x84, 0x93, 0x21, 0x09, 0x89,
-	0xb8, 0x54, 0x50, 0x63, 0x81, 0x82, 0x6e, 0x15, 0x32, 0xe5, 0x9a, 0x3e, 0x04, 0xb7, 0xae, 0xba,
-	0x41, 0x96, 0x75, 0x4d, 0x95, 0xf5, 0xfa, 0x65, 0xa6, 0xac, 0xec, 0x17, 0x0b, 0x6c, 0xf8, 0x09,
-	0x17, 0x74, 0xe2, 0x71, 0x2c, 0x69, 0x1e, 0x12, 0x82, 0x91, 0x61, 0x22, 0xb0, 0x87, 0x42, 0x82,
-	0x38, 0xe6, 0xed, 0xeb, 0x6a, 0x28, 0x1f, 0xfd, 0x8f, 0xed, 0xe6, 0x1c, 0x2a, 0xe9, 0x87, 0x4a,
-	0xf9, 0x20, 0x13, 0x3e, 0xd0, 0xba, 0x7a, 0x5c, 0xdf, 0xf2, 0xff, 0x03, 0xd2, 0x79, 0x00, 0xee,
-	0xbc, 0x54, 0x62, 0xa1, 0xf1, 0xfe, 0xa9, 0x0a, 0x3a, 0xf3, 0x37, 0x25, 0x74, 0xc0, 0x6b, 0xc8,
-	0x17, 0x24, 0xc5, 0x9e, 0x1f, 0x26, 0x5c, 0xc8, 0xad, 0x27, 0x3f, 0x71, 0x2d, 0xbd, 0xa6, 0x53,
-	0x87, 0x3a, 0x23, 0x55, 0x60, 0x07, 0x5c, 0x37, 0x40, 0xde, 0xae, 0x76, 0x6b, 0xdb, 0x4d, 0x37,
-	0x3f, 0xc3, 0xbb, 0xd9, 0x72, 0xa9, 0x29, 0x83, 0xb7, 0xe6, 0x18, 0x3c, 0x53, 0x44, 0x69, 0xbd,
-	0x7c, 0x33, 0xb3, 0x36, 0xcd, 0x48, 0x19, 0x27, 0x76, 0x5f, 0xc5, 0x89, 0xcf, 0x0c, 0x57, 0x6a,
-	0x26, 0xbc, 0x58, 0x8e, 0x9f, 0x6b, 0xa9, 0xde, 0x8f, 0x16, 0x68, 0x95, 0x31, 0xf0, 0x18, 0xac,
-	0xe4, 0x37, 0xaa, 0xcd, 0x6b, 0xbd, 0xe2, 0xe6, 0xbd, 0x91, 0xd1, 0x64, 0xe2, 0xca, 0x7d, 0x5f,
-	0xbd, 0x72, 0xdf, 0x0f, 0xbe, 0x3b, 0x3d, 0xb3, 0x2b, 0xcf, 0xcf, 0xec, 0xca, 0x8b, 0x33, 0xdb,
-	0xfa, 0x61, 0x6a, 0x5b, 0xbf, 0x4e, 0x6d, 0xeb, 0xf7, 0xa9, 0x6d, 0x9d, 0x4e, 0x6d, 0xeb, 0xaf,
-	0xa9, 0x6d, 0xfd, 0x3d, 0xb5, 0x2b, 0x2f, 0xa6, 0xb6, 0xf5, 0xec, 0xdc, 0xae, 0x9c, 0x9e, 0xdb,
-	0x95, 0xe7, 0xe7, 0x76, 0xe5, 0xf1, 0x07, 0x63, 0x5a, 0x74, 0x80, 0xd0, 0xf9, 0xff, 0x72, 0xf6,
-	0x67, 0x8e, 0xc3, 0x86, 0x2a, 0x7f, 0xef, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xee, 0xc8, 0xf5,
-	0xd7, 0x1e, 0x09, 0x00, 0x00,
+	0x14, 0xf6, 0xda, 0x8e, 0x5b, 0x4f, 0x1a, 0xa7, 0x99, 0x06, 0xea, 0x1a, 0xd8, 0xb8, 0x16, 0x21,
+	0xe1, 0xb2, 0x26, 0x09, 0x02, 0x44, 0x54, 0xa4, 0x38, 0x09, 0xa2, 0x02, 0xb5, 0xd2, 0x96, 0x72,
+	0xa8, 0x84, 0x96, 0xf1, 0xee, 0xd8, 0x1d, 0xba, 0xde, 0x59, 0xcd, 0xcc, 0x2e, 0xca, 0x0d, 0x71,
+	0xe0, 0xdc, 0x23, 0x07, 0xfe, 0x00, 0x8e, 0x88, 0xbf, 0x82, 0x63, 0x8e, 0xbd, 0x41, 0x9c, 0x0b,
+	0xc7, 0x1e, 0x39, 0xa2, 0xf9, 0xb1, 0xbb, 0xde, 0xfc, 0xa0, 0x71, 0x6f, 0x3b, 0x6f, 0xbe, 0xef,
+	0x9b, 0x37, 0xef, 0x7d, 0xf3, 0x6c, 0xb0, 0x23, 0xf0, 0x24, 0xa6, 0x0c, 0x85, 0x7d, 0x8e, 0x59,
+	0x8a, 0x59, 0x1f, 0xc5, 0xa4, 0x1f, 0x63, 0xc6, 0x09, 0x17, 0x38, 0xf2, 0x71, 0x3f, 0xdd, 0xea,
+	0x47, 0x68, 0x82, 0x79, 0x8c, 0x7c, 0xcc, 0x9d, 0x98, 0x51, 0x41, 0x61, 0x2f, 0x23, 0x39, 0x9a,
+	0xe4, 0xa0, 0x98, 0x38, 0x33, 0x24, 0x27, 0xdd, 0xea, 0xd8, 0x63, 0x4a, 0xc7, 0x21, 0xee, 0x2b,
+	0xc6, 0x30, 0x19, 0xf5, 0x83, 0x84, 0x21, 0x41, 0x68, 0xa4, 0x35, 0x3a, 0x6b, 0x67, 0xf7, 0x05,
+	0x99, 0x60, 0x2e, 0xd0, 0x24, 0x36, 0x80, 0xbb, 0x01, 0x8e, 0x71, 0x14, 0xe0, 0xc8, 0x27, 0x98,
+	0xf7, 0xc7, 0x74, 0x4c, 0x55, 0x5c, 0x7d, 0x19, 0xc8, 0x7a, 0x9e, 0xbc, 0xcc, 0x1a, 0x47, 0xc9,
+	0x84, 0x97, 0xf2, 0x35, 0xb0, 0x8d, 0x12, 0x2c, 0xdf, 0x95, 0xd0, 0x09, 0xe6, 0x1c, 0x8d, 0x0d,
+	0xb0, 0xf7, 0x6f, 0x0d, 0x2c, 0x3f, 0xc8, 0xb6, 0x0f, 0xb0, 0x40, 0x24, 0x84, 0x87, 0xa0, 0x4e,
+	0xa2, 0x11, 0x6d, 0x5b, 0x5d, 0x6b, 0x73, 0x71, 0x7b, 0xcb, 0x79, 0xf5, 0xd5, 0x9d, 0x5c, 0xe2,
+	0x7e, 0x34, 0xa2, 0xae, 0xa2, 0xc3, 0x2f, 0x41, 0xc3, 0xa7, 0xd1, 0x88, 0x8c, 0xdb, 0x55, 0x25,
+	0xb4, 0x33, 0x97, 0xd0, 0xbe, 0xa2, 0xba, 0x46, 0x02, 0x4e, 0x00, 0x64, 0x38, 0x0e, 0x89, 0xaf,
+	0x0a, 0xea, 0x19, 0xe1, 0x9a, 0x12, 0xfe, 0x6c, 0x2e, 0x61, 0xb7, 0x90, 0x31, 0x67, 0xac, 0xb0,
+	0xb3, 0x21, 0xb8, 0x0e, 0x5a, 0xfa, 0x08, 0x2f, 0x95, 0x32, 0x34, 0x6a, 0xd7, 0xbb, 0xd6, 0x66,
+	0xcd, 0x5d, 0xd2, 0xd1, 0x6f, 0x74, 0x10, 0x0e, 0xc0, 0x3b, 0x23, 0x44, 0x42, 0x9a, 0x62, 0xe6,
+	0x45, 0x54, 0x90, 0x51, 0x96, 0x5f, 0xc6, 0x5a, 0x50, 0xac, 0xb7, 0x32, 0xd0, 0x83, 0x19, 0x4c,
+	0xa6, 0xf1, 0x3e, 0xb8, 0x99, 0x6b, 0x64, 0xb4, 0x86, 0xa2, 0x2d, 0x67, 0xf1, 0x0c, 0xfa, 0x15,
+	0x58, 0xc9, 0xa1, 0x38, 0x0a, 0x3c, 0xe9, 0x9f, 0xf6, 0x35, 0x55, 0x83, 0x8e, 0xa3, 0xcd, 0xe5,
+	0x64, 0xe6, 0x72, 0xbe, 0xce, 0xcc, 0x35, 0xa8, 0x3f, 0xff, 0x6b, 0xcd, 0x2a, 0xd4, 0x0e, 0xa3,
+	0x40, 0xee, 0xf5, 0xfe, 0xa8, 0x82, 0xa5, 0x52, 0xdf, 0x60, 0x0b, 0x54, 0x49, 0xa0, 0xda, 0xde,
+	0x74, 0xab, 0x24, 0x80, 0xbb, 0x60, 0x81, 0x0b, 0x24, 0xb0, 0x6a, 0x60, 0x6b, 0x7b, 0xbd, 0xa8,
+	0xb3, 0x2c, 0xb0, 0x32, 0x5f, 0xa9, 0xb4, 0x8f, 0x24, 0xd8, 0xd5, 0x1c, 0x08, 0x41, 0x5d, 0xfa,
+	0x4e, 0xf5, 0xa8, 0xe9, 0xaa, 0x6f, 0xd8, 0x05, 0x8b, 0x01, 0xe6, 0x3e, 0x23, 0xb1, 0xc8, 0x6a,
+	0xda, 0x74, 0x67, 0x43, 0x70, 0x15, 0x2c, 0xd0, 0x1f, 0x22, 0xcc, 0x54, 0xe5, 0x9a, 0xae, 0x5e,
+	0xc0, 0x87, 0xa0, 0x1e, 0x20, 0x81, 0xda, 0x8d, 0x6e, 0x6d, 0x73, 0x71, 0x7b, 0x77, 0x6e, 0x47,
+	0x3a, 0x07, 0x48, 0xa0, 0xc3, 0x48, 0xb0, 0x23, 0x57, 0x09, 0x75, 0x3e, 0x06, 0xcd, 0x3c, 0x04,
+	0x6f, 0x82, 0xda, 0x33, 0x7c, 0x64, 0xee, 0x2d, 0x3f, 0x65, 0x16, 0x29, 0x0a, 0x13, 0x7d, 0xf1,
+	0xa6, 0xab, 0x17, 0x9f, 0x56, 0x3f, 0xb1, 0x7a, 0xbf, 0x37, 0x66, 0xde, 0x8b, 0x31, 0xcb, 0x3d,
+	0xd0, 0x64, 0x58, 0xe0, 0x48, 0xdd, 0x49, 0x3f, 0x9a, 0x3b, 0xe7, 0xda, 0x71, 0x60, 0x66, 0xc1,
+	0xa0, 0xfe, 0x8b, 0xec, 0x46, 0xc1, 0x80, 0x1b, 0x60, 0x19, 0x31, 0xff, 0x29, 0x49, 0x51, 0xe8,
+	0x0d, 0x13, 0xff, 0x19, 0x16, 0xe6, 0xd8, 0x56, 0x16, 0x1e, 0xa8, 0x28, 0xbc, 0x0f, 0x6e, 0x0c,
+	0x51, 0xe0, 0x0d, 0x49, 0x84, 0x18, 0xc1, 0xdc, 0xb8, 0xff, 0xbd, 0x72, 0x57, 0x8a, 0x49, 0x90,
+	0x6e, 0x39, 0x03, 0x14, 0x0c, 0x0c, 0xda, 0x5d, 0x1c, 0x16, 0x0b, 0xf8, 0x04, 0xbc, 0xf9, 0x94,
+	0x70, 0x41, 0xd9, 0x91, 0x97, 0x9f, 0xad, 0x5b, 0x5d, 0x57, 0xad, 0x7e, 0xf7, 0x92, 0x56, 0xef,
+	0x19, 0xb0, 0xee, 0xf4, 0xaa, 0xd1, 0x28, 0x45, 0xe1, 0x07, 0x60, 0xf5, 0x9c, 0x76, 0xc2, 0x88,
+	0xe9, 0x28, 0x3c, 0xc3, 0x79, 0xcc, 0x08, 0xfc, 0x0e, 0xdc, 0x49, 0x09, 0x27, 0x43, 0x12, 0x12,
+	0x71, 0x2e, 0xa1, 0xc6, 0x1c, 0x09, 0xdd, 0x2e, 0x64, 0xca, 0x39, 0x7d, 0x04, 0x6e, 0x5f, 0x74,
+	0x82, 0x4c, 0xeb, 0x9a, 0x4a, 0xeb, 0x8d, 0xf3, 0x4c, 0x99, 0xd9, 0xaf, 0x16, 0x58, 0xf3, 0x13,
+	0x2e, 0xe8, 0xc4, 0xe3, 0x58, 0xd2, 0x3c, 0x24, 0x04, 0x23, 0xc3, 0x44, 0x60, 0x0f, 0x85, 0x04,
+	0x71, 0xcc, 0xdb, 0xd7, 0x95, 0x29, 0x1f, 0xbf, 0xc6, 0x74, 0x73, 0xf6, 0x95, 0xf4, 0x23, 0xa5,
+	0xbc, 0x97, 0x09, 0xef, 0x69, 0x5d, 0x6d, 0xd7, 0xb7, 0xfd, 0xff, 0x81, 0x40, 0x17, 0xac, 0xce,
+	0x5c, 0xab, 0x30, 0x61, 0xf3, 0x6a, 0x26, 0xbc, 0x55, 0x90, 0xdd, 0x8c, 0xdb, 0x79, 0x08, 0xee,
+	0xbe, 0x32, 0xad, 0xb9, 0x9e, 0xcc, 0xcf, 0x55, 0xd0, 0xb9, 0x7c, 0xfa, 0x42, 0x07, 0xdc, 0x42,
+	0xbe, 0x20, 0x29, 0xf6, 0xfc, 0x30, 0xe1, 0x42, 0x4e, 0x52, 0x39, 0x36, 0xb4, 0xf4, 0x8a, 0xde,
+	0xda, 0xd7, 0x3b, 0x52, 0x05, 0x76, 0xc0, 0x75, 0x03, 0xe4, 0xed, 0x6a, 0xb7, 0xb6, 0xd9, 0x74,
+	0xf3, 0x35, 0xbc, 0x97, 0x0d, 0xac, 0x9a, 0x32, 0xcd, 0xc6, 0x25, 0xa6, 0x99, 0x49, 0xa2, 0x34,
+	0xb2, 0xbe, 0x9d, 0x19, 0xc5, 0xc6, 0xa6, 0xa6, 0xbb, 0xdb, 0x57, 0xe9, 0xee, 0xe7, 0x86, 0x2b,
+	0x35, 0x13, 0x5e, 0x0c, 0xdc, 0x2f, 0xb4, 0x54, 0xef, 0x27, 0x0b, 0xb4, 0xca, 0x18, 0x78, 0x08,
+	0x96, 0xf2, 0x13, 0x05, 0x31, 0xd7, 0xbe, 0xca, 0x34, 0xbf, 0x91, 0xd1, 0xe4, 0xc6, 0x85, 0xbf,
+	0x21, 0xd5, 0x0b, 0x7f, 0x43, 0x06, 0xdf, 0x1f, 0x9f, 0xd8, 0x95, 0x17, 0x27, 0x76, 0xe5, 0xe5,
+	0x89, 0x6d, 0xfd, 0x38, 0xb5, 0xad, 0xdf, 0xa6, 0xb6, 0xf5, 0xe7, 0xd4, 0xb6, 0x8e, 0xa7, 0xb6,
+	0xf5, 0xf7, 0xd4, 0xb6, 0xfe, 0x99, 0xda, 0x95, 0x97, 0x53, 0xdb, 0x7a, 0x7e, 0x6a, 0x57, 0x8e,
+	0x4f, 0xed, 0xca, 0x8b, 0x53, 0xbb, 0xf2, 0xe4, 0xc3, 0x31, 0x2d, 0x2a, 0x40, 0xe8, 0xe5, 0xff,
+	0x9c, 0x76, 0x67, 0x96, 0xc3, 0x86, 0x4a, 0x7f, 0xe7, 0xbf, 0x01, 0x00, 0x4d, 0x8e, 0x3c, 0xab,
+	0x72, 0x09, 0x00, 0x00,
 }
 
 func (this *NamespaceDetail) Equal(that interface{}) bool {
@@ -672,6 +682,15 @@ func (this *NamespaceConfig) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.VisibilityRetention != nil && that1.VisibilityRetention != nil {
+		if *this.VisibilityRetention != *that1.VisibilityRetention {
+			return false
+		}
+	} else if this.VisibilityRetention != nil {
+		return false
+	} else if that1.VisibilityRetention != nil {
+		return false
+	}
 	return true
 }
 func (this *NamespaceReplicationConfig) Equal(that interface{}) bool {
@@ -801,7 +820,7 @@ func (this *NamespaceConfig) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 13)
 	s = append(s, "&persistence.NamespaceConfig{")
 	s = append(s, "Retention: "+fmt.Sprintf("%#v", this.Retention)+",\n")
 	s = append(s, "ArchivalBucket: "+fmt.Sprintf("%#v", this.ArchivalBucket)+",\n")
@@ -825,6 +844,7 @@ func (this *NamespaceConfig) GoString() string {
 	if this.CustomSearchAttributeAliases != nil {
 		s = append(s, "CustomSearchAttributeAliases: "+mapStringForCustomSearchAttributeAliases+",\n")
 	}
+	s = append(s, "VisibilityRetention: "+fmt.Sprintf("%#v", this.VisibilityRetention)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1041,6 +1061,16 @@ func (m *NamespaceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VisibilityRetention != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.VisibilityRetention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VisibilityRetention):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintNamespaces(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.CustomSearchAttributeAliases) > 0 {
 		for k := range m.CustomSearchAttributeAliases {
 			v := m.CustomSearchAttributeAliases[k]
@@ -1104,12 +1134,12 @@ func (m *NamespaceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if m.Retention != nil {
-		n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Retention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Retention):])
-		if err6 != nil {
-			return 0, err6
+		n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Retention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Retention):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n6
-		i = encodeVarintNamespaces(dAtA, i, uint64(n6))
+		i -= n7
+		i = encodeVarintNamespaces(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1200,12 +1230,12 @@ func (m *FailoverStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x10
 	}
 	if m.FailoverTime != nil {
-		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FailoverTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FailoverTime):])
-		if err7 != nil {
-			return 0, err7
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FailoverTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FailoverTime):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n7
-		i = encodeVarintNamespaces(dAtA, i, uint64(n7))
+		i -= n8
+		i = encodeVarintNamespaces(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1333,6 +1363,10 @@ func (m *NamespaceConfig) Size() (n int) {
 			n += mapEntrySize + 1 + sovNamespaces(uint64(mapEntrySize))
 		}
 	}
+	if m.VisibilityRetention != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VisibilityRetention)
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
 	return n
 }
 
@@ -1450,6 +1484,7 @@ func (this *NamespaceConfig) String() string {
 		`VisibilityArchivalState:` + fmt.Sprintf("%v", this.VisibilityArchivalState) + `,`,
 		`VisibilityArchivalUri:` + fmt.Sprintf("%v", this.VisibilityArchivalUri) + `,`,
 		`CustomSearchAttributeAliases:` + mapStringForCustomSearchAttributeAliases + `,`,
+		`VisibilityRetention:` + strings.Replace(fmt.Sprintf("%v", this.VisibilityRetention), "Duration", "types.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2434,6 +2469,42 @@ func (m *NamespaceConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.CustomSearchAttributeAliases[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VisibilityRetention == nil {
+				m.VisibilityRetention = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.VisibilityRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNamespaces(dAtA[iNdEx:])
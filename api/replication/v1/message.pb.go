@@ -66,6 +66,7 @@ type ReplicationTask struct {
 	TaskType     v1.ReplicationTaskType `protobuf:"varint,1,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.ReplicationTaskType" json:"task_type,omitempty"`
 	SourceTaskId int64                  `protobuf:"varint,2,opt,name=source_task_id,json=sourceTaskId,proto3" json:"source_task_id,omitempty"`
 	// Types that are valid to be assigned to Attributes:
+	//
 	//	*ReplicationTask_NamespaceTaskAttributes
 	//	*ReplicationTask_SyncShardStatusTaskAttributes
 	//	*ReplicationTask_SyncActivityTaskAttributes
@@ -625,14 +626,15 @@ func (m *ReplicationTaskInfo) GetScheduledEventId() int64 {
 }
 
 type NamespaceTaskAttributes struct {
-	NamespaceOperation v1.NamespaceOperation           `protobuf:"varint,1,opt,name=namespace_operation,json=namespaceOperation,proto3,enum=temporal.server.api.enums.v1.NamespaceOperation" json:"namespace_operation,omitempty"`
-	Id                 string                          `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Info               *v11.NamespaceInfo              `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
-	Config             *v11.NamespaceConfig            `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
-	ReplicationConfig  *v12.NamespaceReplicationConfig `protobuf:"bytes,5,opt,name=replication_config,json=replicationConfig,proto3" json:"replication_config,omitempty"`
-	ConfigVersion      int64                           `protobuf:"varint,6,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
-	FailoverVersion    int64                           `protobuf:"varint,7,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
-	FailoverHistory    []*v12.FailoverStatus           `protobuf:"bytes,8,rep,name=failover_history,json=failoverHistory,proto3" json:"failover_history,omitempty"`
+	NamespaceOperation  v1.NamespaceOperation           `protobuf:"varint,1,opt,name=namespace_operation,json=namespaceOperation,proto3,enum=temporal.server.api.enums.v1.NamespaceOperation" json:"namespace_operation,omitempty"`
+	Id                  string                          `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Info                *v11.NamespaceInfo              `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	Config              *v11.NamespaceConfig            `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	ReplicationConfig   *v12.NamespaceReplicationConfig `protobuf:"bytes,5,opt,name=replication_config,json=replicationConfig,proto3" json:"replication_config,omitempty"`
+	ConfigVersion       int64                           `protobuf:"varint,6,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
+	FailoverVersion     int64                           `protobuf:"varint,7,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
+	FailoverHistory     []*v12.FailoverStatus           `protobuf:"bytes,8,rep,name=failover_history,json=failoverHistory,proto3" json:"failover_history,omitempty"`
+	VisibilityRetention *time.Duration                  `protobuf:"bytes,9,opt,name=visibility_retention,json=visibilityRetention,proto3,stdduration" json:"visibility_retention,omitempty"`
 }
 
 func (m *NamespaceTaskAttributes) Reset()      { *m = NamespaceTaskAttributes{} }
@@ -723,6 +725,13 @@ func (m *NamespaceTaskAttributes) GetFailoverHistory() []*v12.FailoverStatus {
 	return nil
 }
 
+func (m *NamespaceTaskAttributes) GetVisibilityRetention() *time.Duration {
+	if m != nil {
+		return m.VisibilityRetention
+	}
+	return nil
+}
+
 type SyncShardStatusTaskAttributes struct {
 	SourceCluster string     `protobuf:"bytes,1,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
 	ShardId       int32      `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
@@ -1152,117 +1161,120 @@ func init() {
 }
 
 var fileDescriptor_edd9fae2af6b0532 = []byte{
-	// 1760 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x8f, 0x1b, 0x49,
-	0x15, 0x9f, 0xb6, 0x3d, 0x33, 0x9e, 0xe7, 0x19, 0x8f, 0x53, 0xb3, 0xc3, 0x78, 0x1c, 0xe2, 0x99,
-	0x98, 0xdd, 0x65, 0x16, 0x16, 0x3b, 0x1f, 0x7c, 0xec, 0xb2, 0x88, 0x55, 0x66, 0xc8, 0x12, 0x47,
-	0x64, 0x09, 0x9d, 0x90, 0x48, 0x5c, 0x9a, 0xb2, 0xbb, 0x6c, 0x97, 0xc6, 0xee, 0x36, 0x55, 0xd5,
-	0x9e, 0xf8, 0x86, 0xe0, 0x80, 0x84, 0x84, 0xb4, 0x82, 0x3b, 0xe2, 0xc0, 0x81, 0x13, 0x12, 0x77,
-	0xce, 0x88, 0x63, 0x24, 0x2e, 0x7b, 0x63, 0x33, 0xb9, 0x70, 0xdc, 0x3f, 0x01, 0x55, 0x75, 0x55,
-	0xbb, 0xdb, 0x6e, 0x3b, 0xcd, 0x02, 0xda, 0x9b, 0xfb, 0x7d, 0xfc, 0xde, 0xeb, 0x57, 0xbf, 0xf7,
-	0xfa, 0x95, 0xe1, 0x86, 0x20, 0xa3, 0xb1, 0xcf, 0xf0, 0xb0, 0xc5, 0x09, 0x9b, 0x10, 0xd6, 0xc2,
-	0x63, 0xda, 0x62, 0x64, 0x3c, 0xa4, 0x5d, 0x2c, 0xa8, 0xef, 0xb5, 0x26, 0x37, 0x5b, 0x23, 0xc2,
-	0x39, 0xee, 0x93, 0xe6, 0x98, 0xf9, 0xc2, 0x47, 0x0d, 0xe3, 0xd1, 0x0c, 0x3d, 0x9a, 0x78, 0x4c,
-	0x9b, 0x31, 0x8f, 0xe6, 0xe4, 0x66, 0xed, 0xa8, 0xef, 0xfb, 0xfd, 0x21, 0x69, 0x29, 0x8f, 0x4e,
-	0xd0, 0x6b, 0x09, 0x3a, 0x22, 0x5c, 0xe0, 0xd1, 0x38, 0x04, 0xa9, 0x5d, 0x77, 0xc9, 0x98, 0x78,
-	0x2e, 0xf1, 0xba, 0x94, 0xf0, 0x56, 0xdf, 0xef, 0xfb, 0x4a, 0xae, 0x7e, 0x69, 0x93, 0x66, 0x5a,
-	0x66, 0xc4, 0x0b, 0x46, 0x5c, 0xe6, 0x14, 0x0f, 0x18, 0xda, 0x7f, 0x79, 0xa5, 0xbd, 0xc0, 0xfc,
-	0x5c, 0x1b, 0xbe, 0x9d, 0x66, 0x38, 0xa0, 0x5c, 0xf8, 0x6c, 0xba, 0xf0, 0xba, 0xb5, 0xf7, 0xd3,
-	0xac, 0xc7, 0x84, 0x71, 0xca, 0x05, 0xf1, 0xba, 0x44, 0x7a, 0x5c, 0xf8, 0xec, 0xbc, 0x37, 0xf4,
-	0x2f, 0x9c, 0x51, 0x20, 0x70, 0x67, 0x48, 0x1c, 0x2e, 0xb0, 0x30, 0x00, 0x5f, 0xcf, 0x00, 0x20,
-	0xb3, 0x73, 0x7e, 0x16, 0x90, 0x80, 0x70, 0xed, 0xf5, 0x7a, 0xe4, 0x25, 0xcd, 0xbb, 0xfe, 0x68,
-	0x94, 0x72, 0x16, 0xb1, 0x77, 0x96, 0x56, 0x1e, 0x1e, 0x11, 0x3e, 0xc6, 0x21, 0x64, 0xd2, 0xf0,
-	0xad, 0x84, 0xe1, 0xaa, 0xf3, 0xad, 0xbd, 0x91, 0x30, 0xed, 0x61, 0x3a, 0x0c, 0x58, 0x0a, 0xe2,
-	0xd7, 0xd2, 0x5e, 0xcb, 0x14, 0x62, 0xc1, 0xbc, 0xf1, 0xdb, 0x22, 0xec, 0xda, 0xb3, 0xb0, 0x8f,
-	0x31, 0x3f, 0x47, 0x1f, 0xc2, 0x96, 0x7a, 0x71, 0x31, 0x1d, 0x93, 0xaa, 0x75, 0x6c, 0x9d, 0x94,
-	0x6f, 0xdd, 0x6c, 0xa6, 0xb1, 0x4b, 0x9d, 0x62, 0x73, 0x72, 0xb3, 0x39, 0x87, 0xf0, 0x78, 0x3a,
-	0x26, 0x76, 0x51, 0xe8, 0x5f, 0xe8, 0x75, 0x28, 0x73, 0x3f, 0x60, 0x5d, 0xe2, 0x28, 0x58, 0xea,
-	0x56, 0x73, 0xc7, 0xd6, 0x49, 0xde, 0xde, 0x0e, 0xa5, 0xd2, 0xa3, 0xed, 0xa2, 0x29, 0x1c, 0x46,
-	0x85, 0x0a, 0x0d, 0xb1, 0x10, 0x8c, 0x76, 0x02, 0x41, 0x78, 0x35, 0x7f, 0x6c, 0x9d, 0x94, 0x6e,
-	0xbd, 0xd7, 0x7c, 0x35, 0xc7, 0x9b, 0x1f, 0x1a, 0x10, 0x89, 0x7b, 0x27, 0x82, 0xb8, 0xb7, 0x66,
-	0x1f, 0x78, 0xe9, 0x2a, 0xf4, 0x1b, 0x0b, 0xae, 0xf3, 0xa9, 0xd7, 0x75, 0xf8, 0x00, 0x33, 0x57,
-	0xb1, 0x24, 0xe0, 0x0b, 0x39, 0xac, 0xab, 0x1c, 0xee, 0x64, 0xc9, 0xe1, 0xd1, 0xd4, 0xeb, 0x3e,
-	0x92, 0x58, 0x8f, 0x14, 0xd4, 0x42, 0x26, 0xd7, 0xf8, 0x2a, 0x03, 0xf4, 0x4b, 0x0b, 0x94, 0x85,
-	0x83, 0xbb, 0x82, 0x4e, 0xa8, 0x98, 0x2e, 0xe4, 0xb2, 0xa1, 0x72, 0xf9, 0x6e, 0xd6, 0x5c, 0xee,
-	0x68, 0x9c, 0x85, 0x44, 0x6a, 0x7c, 0xa9, 0x16, 0x71, 0x38, 0xd0, 0xdd, 0xb7, 0x10, 0xbe, 0xa8,
-	0xc2, 0xbf, 0x9b, 0x25, 0xfc, 0xbd, 0x10, 0x62, 0x21, 0xf2, 0xfe, 0x20, 0x4d, 0x81, 0x7e, 0x67,
-	0xc1, 0x97, 0xd4, 0xab, 0x47, 0xbd, 0xab, 0x7a, 0x76, 0x21, 0x03, 0x50, 0x19, 0x9c, 0x65, 0x2d,
-	0xc0, 0x53, 0x8d, 0x26, 0xcb, 0xbd, 0x48, 0x8c, 0x23, 0xbe, 0xda, 0x04, 0xfd, 0xca, 0x82, 0xa3,
-	0xd9, 0x2c, 0x70, 0x02, 0x4e, 0x98, 0xe3, 0x62, 0x81, 0xe3, 0x19, 0x95, 0x54, 0x46, 0xef, 0x67,
-	0xc9, 0x48, 0xa2, 0xff, 0x48, 0x22, 0xfd, 0x98, 0x13, 0xf6, 0x3d, 0x2c, 0x70, 0x22, 0x9b, 0xab,
-	0x62, 0xb9, 0x1a, 0xb5, 0x61, 0x77, 0x42, 0x39, 0xed, 0xd0, 0xa1, 0xa2, 0x05, 0x1d, 0x91, 0xea,
-	0x96, 0x0a, 0x5c, 0x6b, 0x86, 0xb3, 0xbd, 0x69, 0x66, 0x7b, 0xf3, 0xb1, 0x99, 0xed, 0xa7, 0x85,
-	0x8f, 0xfe, 0x79, 0x64, 0xd9, 0xe5, 0x99, 0xa3, 0x54, 0x9d, 0x6e, 0x03, 0xcc, 0xd2, 0xbf, 0x5f,
-	0x28, 0x16, 0x2a, 0xeb, 0xf7, 0x0b, 0xc5, 0xcd, 0x4a, 0xb1, 0xf1, 0xeb, 0x1c, 0x54, 0xe2, 0x2d,
-	0xed, 0x9f, 0x13, 0x0f, 0x1d, 0x42, 0x31, 0x6c, 0x0f, 0xea, 0xaa, 0xa1, 0xb0, 0x6e, 0x6f, 0xaa,
-	0xe7, 0xb6, 0x8b, 0xde, 0x85, 0xc3, 0x21, 0xe6, 0xc2, 0x61, 0x44, 0x30, 0x4a, 0x26, 0xc4, 0x75,
-	0xf4, 0x90, 0x99, 0xf5, 0xfa, 0x17, 0xa4, 0x81, 0x6d, 0xf4, 0x0f, 0x42, 0x75, 0xcc, 0x75, 0xcc,
-	0xfc, 0x2e, 0xe1, 0x3c, 0xe9, 0x9a, 0x9f, 0xb9, 0x3e, 0x34, 0xfa, 0x99, 0x2b, 0x81, 0xfa, 0x9c,
-	0xeb, 0x7c, 0x65, 0x0a, 0x19, 0x2b, 0x73, 0x35, 0x11, 0xe1, 0x49, 0xa2, 0x4c, 0x8d, 0xc7, 0xb0,
-	0x3b, 0xd7, 0xce, 0xe8, 0x0e, 0x94, 0xcc, 0x8c, 0x90, 0x61, 0xac, 0x8c, 0x61, 0x20, 0x74, 0x52,
-	0xa8, 0x7f, 0xb1, 0xe0, 0x35, 0x09, 0x1b, 0x2b, 0xb3, 0x22, 0x1e, 0xfa, 0x26, 0x1c, 0x50, 0xaf,
-	0x3b, 0x0c, 0x38, 0x9d, 0x10, 0x47, 0xf2, 0xff, 0x02, 0x0b, 0xc2, 0x46, 0x98, 0x9d, 0xab, 0x38,
-	0x79, 0x7b, 0x3f, 0x52, 0xff, 0xc0, 0xbf, 0x78, 0x6a, 0x94, 0x08, 0xc3, 0x17, 0x97, 0xf8, 0x85,
-	0x49, 0xe6, 0x32, 0x26, 0x79, 0x98, 0x0a, 0xaf, 0x72, 0xfe, 0x73, 0x0e, 0xf6, 0x62, 0xf9, 0xea,
-	0x93, 0xe0, 0xe8, 0xa7, 0x70, 0x25, 0x46, 0x70, 0xd5, 0xaa, 0xbc, 0x6a, 0x1d, 0xe7, 0x4f, 0x4a,
-	0xb7, 0x6e, 0x67, 0x69, 0x87, 0xb9, 0xaf, 0x87, 0x5d, 0x61, 0x49, 0x01, 0xff, 0x6f, 0x08, 0x76,
-	0x08, 0xc5, 0x01, 0xe6, 0xce, 0xc8, 0x67, 0x44, 0xf1, 0xa9, 0x68, 0x6f, 0x0e, 0x30, 0x7f, 0xe0,
-	0x33, 0x82, 0x1c, 0xb8, 0xb2, 0x30, 0xf5, 0x35, 0x67, 0x6e, 0x7f, 0x86, 0x29, 0x6f, 0xef, 0xce,
-	0x4d, 0xf5, 0xc6, 0xef, 0x73, 0x70, 0xd5, 0x8c, 0x95, 0xcf, 0xa7, 0x70, 0xef, 0x40, 0x95, 0x3c,
-	0x33, 0xac, 0x18, 0xd0, 0xfe, 0x20, 0x46, 0x27, 0x5d, 0xb7, 0x48, 0x7f, 0x8f, 0xf6, 0x07, 0x33,
-	0x3e, 0x75, 0xe1, 0xda, 0x32, 0xcf, 0x90, 0x50, 0xf9, 0x8c, 0x84, 0xaa, 0xa5, 0x07, 0x50, 0x8c,
-	0xfa, 0x24, 0xc9, 0x28, 0xb5, 0x09, 0x78, 0x3d, 0x1f, 0x5d, 0x87, 0xed, 0xd9, 0x2e, 0xa0, 0xe7,
-	0xcd, 0x96, 0x5d, 0x8a, 0x64, 0x6d, 0x17, 0x1d, 0x41, 0x29, 0xfa, 0x44, 0x68, 0x12, 0x6c, 0xd9,
-	0x60, 0x44, 0x6d, 0x17, 0xed, 0xc3, 0x06, 0x0b, 0x3c, 0x33, 0x46, 0xb6, 0xec, 0x75, 0x16, 0x78,
-	0x6d, 0x17, 0x9d, 0xc5, 0x97, 0x9b, 0x82, 0x5a, 0x6e, 0xde, 0x5c, 0xbd, 0xdc, 0xa4, 0x6c, 0x34,
-	0x07, 0xb0, 0x69, 0x56, 0x99, 0x75, 0x55, 0xc5, 0x0d, 0x11, 0x2e, 0x31, 0x55, 0xd8, 0x9c, 0xc8,
-	0x15, 0xd2, 0xf7, 0xd4, 0x27, 0x3a, 0x6f, 0x9b, 0x47, 0xb9, 0x04, 0xf5, 0x28, 0xe3, 0xc2, 0x21,
-	0x13, 0xe2, 0x09, 0xe9, 0xb9, 0x19, 0x2e, 0x41, 0x4a, 0x7a, 0x57, 0x0a, 0xdb, 0x2e, 0x6a, 0xc0,
-	0x8e, 0x47, 0x9e, 0xc5, 0x8c, 0x8a, 0xca, 0xa8, 0x24, 0x85, 0xc6, 0xe6, 0x6d, 0x40, 0xbc, 0x3b,
-	0x20, 0x6e, 0x30, 0x24, 0xee, 0xcc, 0x70, 0x4b, 0x19, 0x56, 0x22, 0x8d, 0xb6, 0x6e, 0xfc, 0xa1,
-	0x00, 0x07, 0x4b, 0x56, 0x22, 0x84, 0x61, 0x6f, 0x56, 0x66, 0x7f, 0x4c, 0x98, 0x3a, 0x05, 0xbd,
-	0xf2, 0xdd, 0x58, 0x5d, 0x95, 0x08, 0xf3, 0x87, 0xc6, 0xcf, 0x46, 0xde, 0x82, 0x0c, 0x95, 0x21,
-	0x17, 0x9d, 0x4e, 0x8e, 0xba, 0xe8, 0x3b, 0x50, 0xa0, 0x5e, 0xcf, 0xd7, 0xec, 0x39, 0x99, 0xc5,
-	0x90, 0xe0, 0x91, 0x7f, 0x22, 0x80, 0x64, 0x84, 0xad, 0xbc, 0xd0, 0x29, 0x6c, 0x74, 0x7d, 0xaf,
-	0x47, 0xfb, 0xba, 0x4d, 0xbf, 0x92, 0xc5, 0xff, 0x4c, 0x79, 0xd8, 0xda, 0x13, 0xf5, 0x00, 0xc5,
-	0x9b, 0x4e, 0xe3, 0x85, 0xcb, 0xdd, 0xb7, 0x92, 0x78, 0xcb, 0x36, 0xcb, 0x18, 0x65, 0x35, 0x78,
-	0xbc, 0x8f, 0x43, 0x11, 0x7a, 0x03, 0xca, 0x21, 0xb6, 0x93, 0x64, 0xc4, 0x4e, 0x28, 0x7d, 0xa2,
-	0x79, 0xf1, 0x16, 0x54, 0xe4, 0x2e, 0xef, 0x4f, 0x08, 0x8b, 0x0c, 0x43, 0x66, 0xec, 0x1a, 0xb9,
-	0x31, 0x7d, 0x12, 0x33, 0xd5, 0xdb, 0x53, 0xb5, 0xa8, 0xa6, 0xc5, 0x57, 0x57, 0xe6, 0xfd, 0x81,
-	0x76, 0x32, 0x63, 0xca, 0x80, 0xe8, 0xd5, 0xac, 0xf1, 0x47, 0x0b, 0xae, 0xad, 0xdc, 0x58, 0xe5,
-	0xbb, 0xe8, 0x0d, 0x5e, 0xb6, 0xb2, 0x20, 0x4c, 0x77, 0xe4, 0x4e, 0x28, 0x3d, 0x0b, 0x85, 0x89,
-	0x15, 0x21, 0x97, 0x5c, 0x11, 0xe6, 0x3e, 0x99, 0xf9, 0xcf, 0xf0, 0xc9, 0xfc, 0xc7, 0x06, 0xd4,
-	0x96, 0x2f, 0xb3, 0xff, 0xcf, 0x99, 0x11, 0xeb, 0xea, 0x42, 0xb2, 0xab, 0xd3, 0x7b, 0x71, 0x3d,
-	0xbd, 0x17, 0xd1, 0xf7, 0xa1, 0x3c, 0xb3, 0x56, 0x75, 0xd8, 0xc8, 0x58, 0x87, 0x9d, 0xc8, 0x4f,
-	0x6a, 0xd0, 0x09, 0x54, 0xb8, 0xc0, 0x4c, 0xc4, 0x83, 0x86, 0xa4, 0x29, 0x6b, 0xb9, 0x09, 0x79,
-	0x06, 0xdb, 0xc6, 0x52, 0x05, 0x2c, 0x66, 0x0c, 0x58, 0xd2, 0x5e, 0x2a, 0xdc, 0x43, 0xd8, 0x53,
-	0x9f, 0xdf, 0x01, 0xc1, 0x4c, 0x74, 0x08, 0x16, 0xff, 0xd9, 0xe2, 0x79, 0x45, 0x3a, 0xdf, 0x33,
-	0xbe, 0x0a, 0xf1, 0xdb, 0xb0, 0xe9, 0x12, 0x81, 0xe9, 0xd0, 0x6c, 0xf2, 0xc7, 0x49, 0x06, 0x87,
-	0x17, 0x6b, 0x49, 0xde, 0x87, 0x78, 0x3a, 0xf4, 0xb1, 0xcb, 0x6d, 0xe3, 0x20, 0x4f, 0x03, 0x0b,
-	0x69, 0x2d, 0xd4, 0xce, 0xbd, 0x6e, 0x9b, 0x47, 0xf9, 0xb2, 0x2a, 0x4f, 0x7d, 0x39, 0xae, 0x6e,
-	0xa7, 0x41, 0x6b, 0xa5, 0x69, 0x8c, 0x80, 0x11, 0xbb, 0x24, 0xbd, 0xf4, 0x03, 0xba, 0x01, 0xaf,
-	0x29, 0x10, 0x49, 0x0b, 0xc2, 0x1c, 0xea, 0x12, 0x4f, 0x50, 0x31, 0xad, 0xee, 0x28, 0x46, 0x20,
-	0xa9, 0x7b, 0xaa, 0x54, 0x6d, 0xad, 0x41, 0x4f, 0x61, 0x57, 0xf3, 0x21, 0x6a, 0xcb, 0xb2, 0x8a,
-	0xdc, 0x4c, 0x1d, 0xa1, 0xda, 0x46, 0x26, 0xa0, 0x3b, 0x5b, 0x37, 0xa2, 0x5d, 0x9e, 0x24, 0x9e,
-	0x51, 0x07, 0xf6, 0x3a, 0x98, 0x13, 0x87, 0x3c, 0x23, 0xdd, 0x40, 0x4d, 0x2b, 0x35, 0x3b, 0x77,
-	0x15, 0xf8, 0xad, 0x54, 0x70, 0x43, 0x66, 0x89, 0x7e, 0x8a, 0x39, 0xb9, 0x6b, 0x5c, 0xd5, 0x14,
-	0xbd, 0xd2, 0x99, 0x17, 0x35, 0xfe, 0x96, 0x87, 0xfd, 0xd4, 0x3b, 0xda, 0x42, 0x43, 0xe5, 0x5e,
-	0xd9, 0x50, 0xf9, 0x15, 0x0d, 0x55, 0x88, 0x37, 0x54, 0x0f, 0xf6, 0xe7, 0x2a, 0xe6, 0x50, 0x41,
-	0x46, 0xf2, 0x8e, 0x9d, 0x5f, 0xfa, 0x6a, 0x4b, 0xeb, 0xd6, 0x16, 0x64, 0x64, 0xef, 0x4d, 0x16,
-	0x64, 0x72, 0xfd, 0xd9, 0x50, 0xfd, 0x61, 0x2e, 0xcc, 0x4b, 0x59, 0x26, 0x6f, 0x59, 0xa7, 0x43,
-	0xbf, 0x63, 0x6b, 0x7b, 0xf4, 0x01, 0x94, 0x3d, 0x72, 0xe1, 0xc8, 0xe4, 0x35, 0xc2, 0x66, 0x46,
-	0x84, 0x6d, 0x8f, 0x5c, 0xd8, 0x81, 0x77, 0x37, 0xc4, 0x59, 0x72, 0x84, 0xc5, 0xff, 0xe1, 0x11,
-	0xde, 0x2f, 0x14, 0xad, 0x4a, 0xae, 0xf1, 0x0b, 0x0b, 0x8e, 0x5e, 0x71, 0xd5, 0x45, 0x0e, 0x94,
-	0x93, 0xf7, 0x6a, 0x7d, 0x77, 0x79, 0x27, 0x35, 0x91, 0xd8, 0x9f, 0x61, 0x32, 0x17, 0x03, 0xfc,
-	0x20, 0xfc, 0x33, 0x4d, 0xe1, 0xdb, 0x3b, 0x17, 0xf1, 0x70, 0x8d, 0xbf, 0x5a, 0x70, 0x75, 0xc5,
-	0xed, 0x36, 0xcb, 0x90, 0x7e, 0x13, 0x76, 0x63, 0x57, 0x6d, 0xa9, 0xd1, 0xcc, 0xdb, 0x89, 0xee,
-	0xc5, 0xf2, 0x43, 0x8c, 0x6c, 0xd8, 0x8a, 0xee, 0xe1, 0xfa, 0x7b, 0xf2, 0x8d, 0x2c, 0xaf, 0xb1,
-	0x90, 0x9e, 0x5d, 0x0c, 0xf4, 0xaf, 0x53, 0xfa, 0xfc, 0x45, 0x7d, 0xed, 0xe3, 0x17, 0xf5, 0xb5,
-	0x4f, 0x5f, 0xd4, 0xad, 0x9f, 0x5f, 0xd6, 0xad, 0x3f, 0x5d, 0xd6, 0xad, 0xbf, 0x5f, 0xd6, 0xad,
-	0xe7, 0x97, 0x75, 0xeb, 0x93, 0xcb, 0xba, 0xf5, 0xaf, 0xcb, 0xfa, 0xda, 0xa7, 0x97, 0x75, 0xeb,
-	0xa3, 0x97, 0xf5, 0xb5, 0xe7, 0x2f, 0xeb, 0x6b, 0x1f, 0xbf, 0xac, 0xaf, 0xfd, 0xe4, 0x76, 0xdf,
-	0x9f, 0x05, 0xa6, 0xfe, 0xf2, 0x7f, 0x6c, 0xdf, 0x63, 0x64, 0xac, 0x9f, 0x3a, 0x1b, 0x6a, 0x5c,
-	0xde, 0xfe, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6e, 0x9d, 0x38, 0x1f, 0xe9, 0x15, 0x00, 0x00,
+	// 1798 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x4f, 0xdb, 0x4e, 0xe2, 0xbc, 0x24, 0x4e, 0x52, 0x99, 0x10, 0xc7, 0xc3, 0x38, 0x19, 0xb3,
+	0x1f, 0x59, 0x58, 0x9c, 0xf9, 0xe0, 0x63, 0x97, 0x45, 0xac, 0x26, 0xd9, 0x59, 0xc6, 0x23, 0x66,
+	0x19, 0x7a, 0x86, 0x19, 0x89, 0x4b, 0x53, 0x76, 0x97, 0xed, 0x52, 0xec, 0x6e, 0x53, 0x55, 0xed,
+	0x8c, 0x6f, 0x08, 0x0e, 0x48, 0x48, 0x48, 0x2b, 0xb8, 0x70, 0xe2, 0xc4, 0x81, 0x13, 0x12, 0x77,
+	0xce, 0x88, 0xe3, 0x48, 0x5c, 0xf6, 0xc6, 0x4e, 0xe6, 0xc2, 0x71, 0x0f, 0xfc, 0x01, 0xa8, 0xaa,
+	0xab, 0xda, 0xdd, 0xee, 0xb6, 0xa7, 0x59, 0x40, 0xdc, 0xdc, 0xef, 0xbd, 0xdf, 0xef, 0xbd, 0x7e,
+	0xfd, 0xde, 0xab, 0x57, 0x86, 0x1b, 0x82, 0x0c, 0x47, 0x3e, 0xc3, 0x83, 0x13, 0x4e, 0xd8, 0x98,
+	0xb0, 0x13, 0x3c, 0xa2, 0x27, 0x8c, 0x8c, 0x06, 0xb4, 0x83, 0x05, 0xf5, 0xbd, 0x93, 0xf1, 0xcd,
+	0x93, 0x21, 0xe1, 0x1c, 0xf7, 0x48, 0x73, 0xc4, 0x7c, 0xe1, 0xa3, 0x86, 0x41, 0x34, 0x43, 0x44,
+	0x13, 0x8f, 0x68, 0x33, 0x86, 0x68, 0x8e, 0x6f, 0xd6, 0xea, 0x3d, 0xdf, 0xef, 0x0d, 0xc8, 0x89,
+	0x42, 0xb4, 0x83, 0xee, 0x89, 0x1b, 0xb0, 0x50, 0xa9, 0x24, 0xb5, 0xc3, 0x59, 0xbd, 0xa0, 0x43,
+	0xc2, 0x05, 0x1e, 0x8e, 0xb4, 0xc1, 0x75, 0x97, 0x8c, 0x88, 0xe7, 0x12, 0xaf, 0x43, 0x09, 0x3f,
+	0xe9, 0xf9, 0x3d, 0x5f, 0xc9, 0xd5, 0x2f, 0x6d, 0xd2, 0xcc, 0x8a, 0x9c, 0x78, 0xc1, 0x90, 0xcb,
+	0x98, 0xe3, 0x01, 0x85, 0xf6, 0x6f, 0x2e, 0xb4, 0x17, 0x98, 0x9f, 0x6b, 0xc3, 0xb7, 0xb3, 0x0c,
+	0xfb, 0x94, 0x0b, 0x9f, 0x4d, 0x52, 0xe9, 0xa8, 0xbd, 0x9f, 0x65, 0x3d, 0x22, 0x8c, 0x53, 0x2e,
+	0x88, 0xd7, 0x21, 0x12, 0x71, 0xe1, 0xb3, 0xf3, 0xee, 0xc0, 0xbf, 0x70, 0x86, 0x81, 0xc0, 0xed,
+	0x01, 0x71, 0xb8, 0xc0, 0xc2, 0x10, 0x7c, 0x2d, 0x07, 0x81, 0x8c, 0xce, 0xf9, 0x49, 0x40, 0x02,
+	0xc2, 0x35, 0xea, 0xb5, 0x08, 0x25, 0xcd, 0x3b, 0xfe, 0x70, 0x98, 0xf1, 0xad, 0x6a, 0x6f, 0x26,
+	0xac, 0x3c, 0x3c, 0x24, 0x7c, 0x84, 0x3b, 0x24, 0x6d, 0xf8, 0x56, 0xc2, 0x70, 0xd1, 0xf7, 0xaf,
+	0xbd, 0x9e, 0x30, 0xed, 0x62, 0x3a, 0x08, 0x58, 0x06, 0xe3, 0x57, 0xb3, 0x5e, 0xcb, 0x24, 0x22,
+	0x65, 0xde, 0xf8, 0x75, 0x19, 0xb6, 0xec, 0xa9, 0xdb, 0xc7, 0x98, 0x9f, 0xa3, 0x8f, 0x60, 0x4d,
+	0xbd, 0xb8, 0x98, 0x8c, 0x48, 0xd5, 0x3a, 0xb2, 0x8e, 0x2b, 0xb7, 0x6e, 0x36, 0xb3, 0xaa, 0x4f,
+	0x7d, 0xc5, 0xe6, 0xf8, 0x66, 0x73, 0x86, 0xe1, 0xf1, 0x64, 0x44, 0xec, 0xb2, 0xd0, 0xbf, 0xd0,
+	0x6b, 0x50, 0xe1, 0x7e, 0xc0, 0x3a, 0xc4, 0x51, 0xb4, 0xd4, 0xad, 0x16, 0x8e, 0xac, 0xe3, 0xa2,
+	0xbd, 0x11, 0x4a, 0x25, 0xa2, 0xe5, 0xa2, 0x09, 0x1c, 0x44, 0x89, 0x0a, 0x0d, 0xb1, 0x10, 0x8c,
+	0xb6, 0x03, 0x41, 0x78, 0xb5, 0x78, 0x64, 0x1d, 0xaf, 0xdf, 0x7a, 0xaf, 0xf9, 0xea, 0x1e, 0x68,
+	0x7e, 0x64, 0x48, 0x24, 0xef, 0x9d, 0x88, 0xe2, 0xde, 0x92, 0xbd, 0xef, 0x65, 0xab, 0xd0, 0xaf,
+	0x2c, 0xb8, 0xce, 0x27, 0x5e, 0xc7, 0xe1, 0x7d, 0xcc, 0x5c, 0x55, 0x25, 0x01, 0x4f, 0xc5, 0xb0,
+	0xac, 0x62, 0xb8, 0x93, 0x27, 0x86, 0x47, 0x13, 0xaf, 0xf3, 0x48, 0x72, 0x3d, 0x52, 0x54, 0xa9,
+	0x48, 0xae, 0xf1, 0x45, 0x06, 0xe8, 0xe7, 0x16, 0x28, 0x0b, 0x07, 0x77, 0x04, 0x1d, 0x53, 0x31,
+	0x49, 0xc5, 0xb2, 0xa2, 0x62, 0xf9, 0x4e, 0xde, 0x58, 0xee, 0x68, 0x9e, 0x54, 0x20, 0x35, 0x3e,
+	0x57, 0x8b, 0x38, 0xec, 0xeb, 0xee, 0x4b, 0xb9, 0x2f, 0x2b, 0xf7, 0xef, 0xe6, 0x71, 0x7f, 0x2f,
+	0xa4, 0x48, 0x79, 0xde, 0xeb, 0x67, 0x29, 0xd0, 0x6f, 0x2c, 0xf8, 0x92, 0x7a, 0xf5, 0xa8, 0x77,
+	0x55, 0xcf, 0xa6, 0x22, 0x00, 0x15, 0xc1, 0x59, 0xde, 0x04, 0x3c, 0xd5, 0x6c, 0x32, 0xdd, 0xe9,
+	0xc2, 0x38, 0xe4, 0x8b, 0x4d, 0xd0, 0x2f, 0x2c, 0x38, 0x9c, 0xce, 0x02, 0x27, 0xe0, 0x84, 0x39,
+	0x2e, 0x16, 0x38, 0x1e, 0xd1, 0xba, 0x8a, 0xe8, 0xfd, 0x3c, 0x11, 0x49, 0xf6, 0x1f, 0x48, 0xa6,
+	0x1f, 0x72, 0xc2, 0x3e, 0xc0, 0x02, 0x27, 0xa2, 0xb9, 0x2a, 0xe6, 0xab, 0x51, 0x0b, 0xb6, 0xc6,
+	0x94, 0xd3, 0x36, 0x1d, 0xa8, 0xb2, 0xa0, 0x43, 0x52, 0x5d, 0x53, 0x8e, 0x6b, 0xcd, 0x70, 0xb6,
+	0x37, 0xcd, 0x6c, 0x6f, 0x3e, 0x36, 0xb3, 0xfd, 0xb4, 0xf4, 0xf1, 0xdf, 0x0f, 0x2d, 0xbb, 0x32,
+	0x05, 0x4a, 0xd5, 0xe9, 0x06, 0xc0, 0x34, 0xfc, 0xfb, 0xa5, 0x72, 0x69, 0x7b, 0xf9, 0x7e, 0xa9,
+	0xbc, 0xba, 0x5d, 0x6e, 0xfc, 0xb2, 0x00, 0xdb, 0xf1, 0x96, 0xf6, 0xcf, 0x89, 0x87, 0x0e, 0xa0,
+	0x1c, 0xb6, 0x07, 0x75, 0xd5, 0x50, 0x58, 0xb6, 0x57, 0xd5, 0x73, 0xcb, 0x45, 0xef, 0xc2, 0xc1,
+	0x00, 0x73, 0xe1, 0x30, 0x22, 0x18, 0x25, 0x63, 0xe2, 0x3a, 0x7a, 0xc8, 0x4c, 0x7b, 0xfd, 0x0b,
+	0xd2, 0xc0, 0x36, 0xfa, 0x07, 0xa1, 0x3a, 0x06, 0x1d, 0x31, 0xbf, 0x43, 0x38, 0x4f, 0x42, 0x8b,
+	0x53, 0xe8, 0x43, 0xa3, 0x9f, 0x42, 0x09, 0xd4, 0x67, 0xa0, 0xb3, 0x99, 0x29, 0xe5, 0xcc, 0xcc,
+	0xd5, 0x84, 0x87, 0x27, 0x89, 0x34, 0x35, 0x1e, 0xc3, 0xd6, 0x4c, 0x3b, 0xa3, 0x3b, 0xb0, 0x6e,
+	0x66, 0x84, 0x74, 0x63, 0xe5, 0x74, 0x03, 0x21, 0x48, 0xb1, 0xfe, 0xc9, 0x82, 0x2b, 0x92, 0x36,
+	0x96, 0x66, 0x55, 0x78, 0xe8, 0x1b, 0xb0, 0x4f, 0xbd, 0xce, 0x20, 0xe0, 0x74, 0x4c, 0x1c, 0x59,
+	0xff, 0x17, 0x58, 0x10, 0x36, 0xc4, 0xec, 0x5c, 0xf9, 0x29, 0xda, 0x7b, 0x91, 0xfa, 0x7b, 0xfe,
+	0xc5, 0x53, 0xa3, 0x44, 0x18, 0xbe, 0x38, 0x07, 0x17, 0x06, 0x59, 0xc8, 0x19, 0xe4, 0x41, 0x26,
+	0xbd, 0x8a, 0xf9, 0x8f, 0x05, 0xd8, 0x8d, 0xc5, 0xab, 0xbf, 0x04, 0x47, 0x3f, 0x86, 0x9d, 0x58,
+	0x81, 0xab, 0x56, 0xe5, 0x55, 0xeb, 0xa8, 0x78, 0xbc, 0x7e, 0xeb, 0x76, 0x9e, 0x76, 0x98, 0x39,
+	0x3d, 0xec, 0x6d, 0x96, 0x14, 0xf0, 0xff, 0xa4, 0xc0, 0x0e, 0xa0, 0xdc, 0xc7, 0xdc, 0x19, 0xfa,
+	0x8c, 0xa8, 0x7a, 0x2a, 0xdb, 0xab, 0x7d, 0xcc, 0x1f, 0xf8, 0x8c, 0x20, 0x07, 0x76, 0x52, 0x53,
+	0x5f, 0xd7, 0xcc, 0xed, 0xcf, 0x31, 0xe5, 0xed, 0xad, 0x99, 0xa9, 0xde, 0xf8, 0x5d, 0x01, 0xae,
+	0x9a, 0xb1, 0xf2, 0xff, 0x49, 0xdc, 0x3b, 0x50, 0x25, 0xcf, 0x4c, 0x55, 0xf4, 0x69, 0xaf, 0x1f,
+	0x2b, 0x27, 0x9d, 0xb7, 0x48, 0x7f, 0x8f, 0xf6, 0xfa, 0xd3, 0x7a, 0xea, 0xc0, 0xb5, 0x79, 0xc8,
+	0xb0, 0xa0, 0x8a, 0x39, 0x0b, 0xaa, 0x96, 0xed, 0x40, 0x55, 0xd4, 0xa7, 0xc9, 0x8a, 0x52, 0x9b,
+	0x80, 0xd7, 0xf5, 0xd1, 0x75, 0xd8, 0x98, 0xee, 0x02, 0x7a, 0xde, 0xac, 0xd9, 0xeb, 0x91, 0xac,
+	0xe5, 0xa2, 0x43, 0x58, 0x8f, 0x8e, 0x08, 0x5d, 0x04, 0x6b, 0x36, 0x18, 0x51, 0xcb, 0x45, 0x7b,
+	0xb0, 0xc2, 0x02, 0xcf, 0x8c, 0x91, 0x35, 0x7b, 0x99, 0x05, 0x5e, 0xcb, 0x45, 0x67, 0xf1, 0xe5,
+	0xa6, 0xa4, 0x96, 0x9b, 0x37, 0x16, 0x2f, 0x37, 0x19, 0x1b, 0xcd, 0x3e, 0xac, 0x9a, 0x55, 0x66,
+	0x59, 0x65, 0x71, 0x45, 0x84, 0x4b, 0x4c, 0x15, 0x56, 0xc7, 0x84, 0x71, 0xea, 0x7b, 0xea, 0x88,
+	0x2e, 0xda, 0xe6, 0x51, 0x2e, 0x41, 0x5d, 0xca, 0xb8, 0x70, 0xc8, 0x98, 0x78, 0x42, 0x22, 0x57,
+	0xc3, 0x25, 0x48, 0x49, 0xef, 0x4a, 0x61, 0xcb, 0x45, 0x0d, 0xd8, 0xf4, 0xc8, 0xb3, 0x98, 0x51,
+	0x59, 0x19, 0xad, 0x4b, 0xa1, 0xb1, 0x79, 0x1b, 0x10, 0xef, 0xf4, 0x89, 0x1b, 0x0c, 0x88, 0x3b,
+	0x35, 0x5c, 0x53, 0x86, 0xdb, 0x91, 0x46, 0x5b, 0x37, 0xfe, 0x59, 0x82, 0xfd, 0x39, 0x2b, 0x11,
+	0xc2, 0xb0, 0x3b, 0x4d, 0xb3, 0x3f, 0x22, 0xe1, 0x5d, 0x41, 0xaf, 0x7c, 0x37, 0x16, 0x67, 0x25,
+	0xe2, 0xfc, 0xbe, 0xc1, 0xd9, 0xc8, 0x4b, 0xc9, 0x50, 0x05, 0x0a, 0xd1, 0xd7, 0x29, 0x50, 0x17,
+	0x7d, 0x1b, 0x4a, 0xd4, 0xeb, 0xfa, 0xba, 0x7a, 0x8e, 0xa7, 0x3e, 0x24, 0x79, 0x84, 0x4f, 0x38,
+	0x90, 0x15, 0x61, 0x2b, 0x14, 0x3a, 0x85, 0x95, 0x8e, 0xef, 0x75, 0x69, 0x4f, 0xb7, 0xe9, 0x97,
+	0xf3, 0xe0, 0xcf, 0x14, 0xc2, 0xd6, 0x48, 0xd4, 0x05, 0x14, 0x6f, 0x3a, 0xcd, 0x17, 0x2e, 0x77,
+	0xdf, 0x4c, 0xf2, 0xcd, 0xdb, 0x2c, 0x63, 0x25, 0xab, 0xc9, 0x77, 0xd8, 0xac, 0x08, 0xbd, 0x0e,
+	0x95, 0x90, 0xdb, 0x49, 0x56, 0xc4, 0x66, 0x28, 0x7d, 0xa2, 0xeb, 0xe2, 0x2d, 0xd8, 0x96, 0xbb,
+	0xbc, 0x3f, 0x26, 0x2c, 0x32, 0x0c, 0x2b, 0x63, 0xcb, 0xc8, 0x8d, 0xe9, 0x93, 0x98, 0xa9, 0xde,
+	0x9e, 0xaa, 0x65, 0x35, 0x2d, 0xbe, 0xb2, 0x30, 0xee, 0x0f, 0x35, 0xc8, 0x8c, 0x29, 0x43, 0xa2,
+	0x57, 0x33, 0x64, 0xc3, 0x95, 0xd8, 0xc9, 0xc9, 0x88, 0x20, 0x9e, 0xaa, 0x83, 0x70, 0xb1, 0x38,
+	0x48, 0x75, 0xf8, 0x07, 0xfa, 0x52, 0x79, 0x5a, 0xfa, 0xad, 0x6c, 0xf0, 0xdd, 0x29, 0xd8, 0x36,
+	0xd8, 0xc6, 0xef, 0x2d, 0xb8, 0xb6, 0x70, 0x0b, 0x96, 0xf9, 0xd1, 0xb7, 0x02, 0x39, 0x1e, 0x04,
+	0x61, 0xba, 0xcb, 0x37, 0x43, 0xe9, 0x59, 0x28, 0x4c, 0xac, 0x1d, 0x85, 0xe4, 0xda, 0x31, 0x73,
+	0x0c, 0x17, 0x3f, 0xc7, 0x31, 0xfc, 0xb7, 0x15, 0xa8, 0xcd, 0x5f, 0x90, 0xff, 0x97, 0x73, 0x28,
+	0x36, 0x29, 0x4a, 0xc9, 0x49, 0x91, 0xdd, 0xdf, 0xcb, 0xd9, 0xfd, 0x8d, 0xbe, 0x0b, 0x95, 0xa9,
+	0xb5, 0xca, 0xc3, 0x4a, 0xce, 0x3c, 0x6c, 0x46, 0x38, 0xa9, 0x41, 0xc7, 0xb0, 0xcd, 0x05, 0x66,
+	0x22, 0xee, 0x34, 0x2c, 0xc4, 0x8a, 0x96, 0x1b, 0x97, 0x67, 0xb0, 0x61, 0x2c, 0x95, 0xc3, 0x72,
+	0x4e, 0x87, 0xeb, 0x1a, 0xa5, 0xdc, 0x3d, 0x84, 0x5d, 0x75, 0xa4, 0xf7, 0x09, 0x66, 0xa2, 0x4d,
+	0xb0, 0xf8, 0xf7, 0x96, 0xd9, 0x1d, 0x09, 0xbe, 0x67, 0xb0, 0x8a, 0xf1, 0x5b, 0xb0, 0xea, 0x12,
+	0x81, 0xe9, 0xc0, 0xdc, 0x0e, 0x8e, 0x92, 0x5d, 0x11, 0x5e, 0xd6, 0x65, 0x43, 0x3c, 0xc4, 0x93,
+	0x81, 0x8f, 0x5d, 0x6e, 0x1b, 0x80, 0xfc, 0x1a, 0x58, 0x48, 0x6b, 0xa1, 0xf6, 0xf8, 0x65, 0xdb,
+	0x3c, 0xca, 0x97, 0x55, 0x71, 0xea, 0x0b, 0x77, 0x75, 0x23, 0x8b, 0x5a, 0x2b, 0x4d, 0xb3, 0x05,
+	0x8c, 0xd8, 0xeb, 0x12, 0xa5, 0x1f, 0xd0, 0x0d, 0xb8, 0xa2, 0x48, 0x64, 0x59, 0x10, 0xe6, 0x50,
+	0x57, 0x76, 0x89, 0x98, 0x54, 0x37, 0x55, 0x45, 0x20, 0xa9, 0x7b, 0xaa, 0x54, 0x2d, 0xad, 0x41,
+	0x4f, 0x61, 0x4b, 0xd7, 0x43, 0xd4, 0xea, 0x15, 0xe5, 0xb9, 0x99, 0x39, 0x96, 0xb5, 0x8d, 0x0c,
+	0x40, 0x4f, 0x0b, 0xdd, 0xdc, 0x76, 0x65, 0x9c, 0x78, 0x46, 0x6d, 0xd8, 0x6d, 0x63, 0x4e, 0x1c,
+	0xf2, 0x8c, 0x74, 0x02, 0x35, 0x01, 0xd5, 0x3c, 0xde, 0x52, 0xe4, 0xb7, 0x32, 0xc9, 0x4d, 0x31,
+	0x4b, 0xf6, 0x53, 0xcc, 0xc9, 0x5d, 0x03, 0x55, 0x93, 0x79, 0xa7, 0x3d, 0x2b, 0x6a, 0xfc, 0xa5,
+	0x08, 0x7b, 0x99, 0xf7, 0xbe, 0x54, 0x43, 0x15, 0x5e, 0xd9, 0x50, 0xc5, 0x05, 0x0d, 0x55, 0x8a,
+	0x37, 0x54, 0x17, 0xf6, 0x66, 0x32, 0xe6, 0x50, 0x41, 0x86, 0xf2, 0xde, 0x5e, 0x9c, 0xfb, 0x6a,
+	0x73, 0xf3, 0xd6, 0x12, 0x64, 0x68, 0xef, 0x8e, 0x53, 0x32, 0xb9, 0x52, 0xad, 0xa8, 0xfe, 0x30,
+	0x97, 0xf0, 0xb9, 0x55, 0x26, 0x6f, 0x6e, 0xa7, 0x03, 0xbf, 0x6d, 0x6b, 0x7b, 0xf4, 0x21, 0x54,
+	0x3c, 0x72, 0xe1, 0xc8, 0xe0, 0x35, 0xc3, 0x6a, 0x4e, 0x86, 0x0d, 0x8f, 0x5c, 0xd8, 0x81, 0x77,
+	0x37, 0xe4, 0x99, 0xf3, 0x09, 0xcb, 0xff, 0xc5, 0x4f, 0x78, 0xbf, 0x54, 0xb6, 0xb6, 0x0b, 0x8d,
+	0x9f, 0x59, 0x70, 0xf8, 0x8a, 0xeb, 0x33, 0x72, 0xa0, 0x92, 0xbc, 0xab, 0xeb, 0xfb, 0xd0, 0x3b,
+	0x99, 0x81, 0xc4, 0xfe, 0x60, 0x93, 0xb1, 0x18, 0xe2, 0x07, 0xe1, 0x1f, 0x74, 0x8a, 0xdf, 0xde,
+	0xbc, 0x88, 0xbb, 0x6b, 0xfc, 0xd9, 0x82, 0xab, 0x0b, 0x6e, 0xcc, 0x79, 0x86, 0xf4, 0x1b, 0xb0,
+	0x15, 0xbb, 0xbe, 0x4b, 0x8d, 0xae, 0xbc, 0xcd, 0xe8, 0xae, 0x2d, 0x0f, 0x77, 0x64, 0xc3, 0x5a,
+	0x74, 0xb7, 0xd7, 0xe7, 0xc9, 0xd7, 0xf3, 0xbc, 0x46, 0x2a, 0x3c, 0xbb, 0x1c, 0xe8, 0x5f, 0xa7,
+	0xf4, 0xf9, 0x8b, 0xfa, 0xd2, 0x27, 0x2f, 0xea, 0x4b, 0x9f, 0xbd, 0xa8, 0x5b, 0x3f, 0xbd, 0xac,
+	0x5b, 0x7f, 0xb8, 0xac, 0x5b, 0x7f, 0xbd, 0xac, 0x5b, 0xcf, 0x2f, 0xeb, 0xd6, 0xa7, 0x97, 0x75,
+	0xeb, 0x1f, 0x97, 0xf5, 0xa5, 0xcf, 0x2e, 0xeb, 0xd6, 0xc7, 0x2f, 0xeb, 0x4b, 0xcf, 0x5f, 0xd6,
+	0x97, 0x3e, 0x79, 0x59, 0x5f, 0xfa, 0xd1, 0xed, 0x9e, 0x3f, 0x75, 0x4c, 0xfd, 0xf9, 0xff, 0x12,
+	0xbf, 0xc7, 0xc8, 0x48, 0x3f, 0xb5, 0x57, 0xd4, 0xb8, 0xbc, 0xfd, 0xaf, 0x01, 0x00, 0x4d, 0x88,
+	0x6f, 0x97, 0x5d, 0x16, 0x00, 0x00,
 }
 
 func (this *ReplicationTask) Equal(that interface{}) bool {
@@ -1721,6 +1733,15 @@ func (this *NamespaceTaskAttributes) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.VisibilityRetention != nil && that1.VisibilityRetention != nil {
+		if *this.VisibilityRetention != *that1.VisibilityRetention {
+			return false
+		}
+	} else if this.VisibilityRetention != nil {
+		return false
+	} else if that1.VisibilityRetention != nil {
+		return false
+	}
 	return true
 }
 func (this *SyncShardStatusTaskAttributes) Equal(that interface{}) bool {
@@ -2086,7 +2107,7 @@ func (this *NamespaceTaskAttributes) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 13)
 	s = append(s, "&repication.NamespaceTaskAttributes{")
 	s = append(s, "NamespaceOperation: "+fmt.Sprintf("%#v", this.NamespaceOperation)+",\n")
 	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
@@ -2104,6 +2125,7 @@ func (this *NamespaceTaskAttributes) GoString() string {
 	if this.FailoverHistory != nil {
 		s = append(s, "FailoverHistory: "+fmt.Sprintf("%#v", this.FailoverHistory)+",\n")
 	}
+	s = append(s, "VisibilityRetention: "+fmt.Sprintf("%#v", this.VisibilityRetention)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2716,6 +2738,16 @@ func (m *NamespaceTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.VisibilityRetention != nil {
+		n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.VisibilityRetention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VisibilityRetention):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintMessage(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.FailoverHistory) > 0 {
 		for iNdEx := len(m.FailoverHistory) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2812,12 +2844,12 @@ func (m *SyncShardStatusTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int,
 	var l int
 	_ = l
 	if m.StatusTime != nil {
-		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
-		if err16 != nil {
-			return 0, err16
+		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
+		if err17 != nil {
+			return 0, err17
 		}
-		i -= n16
-		i = encodeVarintMessage(dAtA, i, uint64(n16))
+		i -= n17
+		i = encodeVarintMessage(dAtA, i, uint64(n17))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -2917,22 +2949,22 @@ func (m *SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, err
 		dAtA[i] = 0x52
 	}
 	if m.LastHeartbeatTime != nil {
-		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
-		if err21 != nil {
-			return 0, err21
+		n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
+		if err22 != nil {
+			return 0, err22
 		}
-		i -= n21
-		i = encodeVarintMessage(dAtA, i, uint64(n21))
+		i -= n22
+		i = encodeVarintMessage(dAtA, i, uint64(n22))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.StartedTime != nil {
-		n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err22 != nil {
-			return 0, err22
+		n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err23 != nil {
+			return 0, err23
 		}
-		i -= n22
-		i = encodeVarintMessage(dAtA, i, uint64(n22))
+		i -= n23
+		i = encodeVarintMessage(dAtA, i, uint64(n23))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -2942,12 +2974,12 @@ func (m *SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, err
 		dAtA[i] = 0x38
 	}
 	if m.ScheduledTime != nil {
-		n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err23 != nil {
-			return 0, err23
+		n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err24 != nil {
+			return 0, err24
 		}
-		i -= n23
-		i = encodeVarintMessage(dAtA, i, uint64(n23))
+		i -= n24
+		i = encodeVarintMessage(dAtA, i, uint64(n24))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -3442,6 +3474,10 @@ func (m *NamespaceTaskAttributes) Size() (n int) {
 			n += 1 + l + sovMessage(uint64(l))
 		}
 	}
+	if m.VisibilityRetention != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VisibilityRetention)
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -3787,6 +3823,7 @@ func (this *NamespaceTaskAttributes) String() string {
 		`ConfigVersion:` + fmt.Sprintf("%v", this.ConfigVersion) + `,`,
 		`FailoverVersion:` + fmt.Sprintf("%v", this.FailoverVersion) + `,`,
 		`FailoverHistory:` + repeatedStringForFailoverHistory + `,`,
+		`VisibilityRetention:` + strings.Replace(fmt.Sprintf("%v", this.VisibilityRetention), "Duration", "types.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5385,6 +5422,42 @@ func (m *NamespaceTaskAttributes) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VisibilityRetention == nil {
+				m.VisibilityRetention = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.VisibilityRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -143,6 +143,12 @@ type InternalState struct {
 	// conflict token is implemented as simple sequence number
 	ConflictToken int64 `protobuf:"varint,7,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
 	NeedRefresh   bool  `protobuf:"varint,9,opt,name=need_refresh,json=needRefresh,proto3" json:"need_refresh,omitempty"`
+	// Pause the schedule after this many consecutive failed or timed-out actions, if positive.
+	PauseAfterFailures int64 `protobuf:"varint,10,opt,name=pause_after_failures,json=pauseAfterFailures,proto3" json:"pause_after_failures,omitempty"`
+	// The number of consecutive failed actions, only counted if pause_after_failures is set.
+	ConsecutiveFailures int64 `protobuf:"varint,11,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// Why the schedule paused itself after consecutive failures. Cleared when it's unpaused.
+	PauseReason string `protobuf:"bytes,12,opt,name=pause_reason,json=pauseReason,proto3" json:"pause_reason,omitempty"`
 }
 
 func (m *InternalState) Reset()      { *m = InternalState{} }
@@ -240,6 +246,27 @@ func (m *InternalState) GetNeedRefresh() bool {
 	return false
 }
 
+func (m *InternalState) GetPauseAfterFailures() int64 {
+	if m != nil {
+		return m.PauseAfterFailures
+	}
+	return 0
+}
+
+func (m *InternalState) GetConsecutiveFailures() int64 {
+	if m != nil {
+		return m.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (m *InternalState) GetPauseReason() string {
+	if m != nil {
+		return m.PauseReason
+	}
+	return ""
+}
+
 type StartScheduleArgs struct {
 	Schedule     *v13.Schedule      `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	Info         *v13.ScheduleInfo  `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
@@ -359,9 +386,12 @@ func (m *FullUpdateRequest) GetConflictToken() int64 {
 }
 
 type DescribeResponse struct {
-	Schedule      *v13.Schedule     `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
-	Info          *v13.ScheduleInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
-	ConflictToken int64             `protobuf:"varint,3,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
+	Schedule            *v13.Schedule     `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Info                *v13.ScheduleInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	ConflictToken       int64             `protobuf:"varint,3,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
+	PauseAfterFailures  int64             `protobuf:"varint,4,opt,name=pause_after_failures,json=pauseAfterFailures,proto3" json:"pause_after_failures,omitempty"`
+	ConsecutiveFailures int64             `protobuf:"varint,5,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	PauseReason         string            `protobuf:"bytes,6,opt,name=pause_reason,json=pauseReason,proto3" json:"pause_reason,omitempty"`
 }
 
 func (m *DescribeResponse) Reset()      { *m = DescribeResponse{} }
@@ -417,6 +447,27 @@ func (m *DescribeResponse) GetConflictToken() int64 {
 	return 0
 }
 
+func (m *DescribeResponse) GetPauseAfterFailures() int64 {
+	if m != nil {
+		return m.PauseAfterFailures
+	}
+	return 0
+}
+
+func (m *DescribeResponse) GetConsecutiveFailures() int64 {
+	if m != nil {
+		return m.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (m *DescribeResponse) GetPauseReason() string {
+	if m != nil {
+		return m.PauseReason
+	}
+	return ""
+}
+
 type WatchWorkflowRequest struct {
 	// Note: this will be sent to the activity with empty execution.run_id, and
 	// the run id that we started in first_execution_run_id.
@@ -481,6 +532,7 @@ func (m *WatchWorkflowRequest) GetLongPoll() bool {
 type WatchWorkflowResponse struct {
 	Status v1.WorkflowExecutionStatus `protobuf:"varint,1,opt,name=status,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"status,omitempty"`
 	// Types that are valid to be assigned to ResultFailure:
+	//
 	//	*WatchWorkflowResponse_Result
 	//	*WatchWorkflowResponse_Failure
 	ResultFailure isWatchWorkflowResponse_ResultFailure `protobuf_oneof:"result_failure"`
@@ -828,79 +880,84 @@ func init() {
 }
 
 var fileDescriptor_6461b6986ba20ee7 = []byte{
-	// 1148 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xf6, 0xc6, 0x7f, 0x6a, 0x8f, 0x6b, 0xd7, 0xd9, 0x36, 0xf9, 0x59, 0xfe, 0xc1, 0xc6, 0xb5,
-	0x68, 0x09, 0x12, 0xac, 0x95, 0x54, 0xe2, 0xd0, 0xa2, 0x4a, 0x4d, 0x4a, 0x88, 0xa3, 0x22, 0xac,
-	0x71, 0x20, 0x88, 0xcb, 0x6a, 0xb2, 0x3b, 0x76, 0x46, 0x9d, 0x9d, 0x59, 0x76, 0x66, 0xd3, 0x86,
-	0x13, 0x07, 0xae, 0x48, 0xfd, 0x0e, 0x5c, 0xb8, 0x22, 0x04, 0x57, 0xae, 0x1c, 0x73, 0xec, 0x0d,
-	0xe2, 0x5c, 0x90, 0xb8, 0xf4, 0x23, 0xa0, 0x99, 0x9d, 0x75, 0x62, 0x27, 0x21, 0x41, 0x3d, 0x20,
-	0x6e, 0x3b, 0xef, 0x3c, 0xcf, 0x33, 0xef, 0xbc, 0xff, 0x66, 0xc1, 0x7b, 0x12, 0x87, 0x11, 0x8f,
-	0x11, 0xed, 0x0a, 0x1c, 0xef, 0xe3, 0xb8, 0x8b, 0x22, 0xd2, 0x15, 0xfe, 0x1e, 0x0e, 0x12, 0x8a,
-	0xbb, 0xfb, 0x2b, 0xdd, 0x10, 0x0b, 0x81, 0x46, 0xd8, 0x8d, 0x62, 0x2e, 0xb9, 0xbd, 0x94, 0xc1,
-	0xdd, 0x14, 0xee, 0xa2, 0x88, 0xb8, 0x19, 0xdc, 0xdd, 0x5f, 0x69, 0xbd, 0x35, 0xd1, 0x53, 0x42,
-	0x3e, 0x0f, 0x43, 0xce, 0xce, 0xc8, 0xb4, 0xee, 0x4c, 0xa1, 0x86, 0x88, 0xd0, 0x24, 0x3e, 0x7b,
-	0xda, 0x8c, 0x18, 0x66, 0x49, 0x28, 0x14, 0x68, 0x72, 0xde, 0xdf, 0xa2, 0x9e, 0xf1, 0xf8, 0xe9,
-	0x90, 0xf2, 0x67, 0x06, 0x75, 0x77, 0x0a, 0x75, 0xe1, 0x0d, 0x5b, 0xef, 0x4f, 0xe1, 0x32, 0x11,
-	0x75, 0x5b, 0xe2, 0x6b, 0x78, 0x8c, 0xbf, 0x4c, 0xb0, 0x90, 0x5e, 0x8c, 0x45, 0xc4, 0x99, 0xc8,
-	0x78, 0x4b, 0x23, 0xce, 0x47, 0x14, 0x77, 0xf5, 0x6a, 0x37, 0x19, 0x76, 0x25, 0x09, 0xb1, 0x90,
-	0x28, 0x8c, 0x0c, 0xe0, 0x76, 0x80, 0x23, 0xcc, 0x02, 0xcc, 0x7c, 0x82, 0x45, 0x77, 0xc4, 0x47,
-	0x5c, 0xdb, 0xf5, 0x57, 0x0a, 0xe9, 0x7c, 0x33, 0x07, 0x6a, 0x6b, 0xc9, 0x70, 0x88, 0x63, 0x1c,
-	0x0c, 0x24, 0x8a, 0xa5, 0xbd, 0x0e, 0xae, 0x33, 0x1e, 0x12, 0x86, 0xa8, 0xa7, 0xf4, 0x9a, 0x56,
-	0xdb, 0x5a, 0xae, 0xae, 0xb6, 0xdc, 0xf4, 0x30, 0x37, 0x3b, 0xcc, 0xdd, 0xce, 0x0e, 0x5b, 0x2b,
-	0xbc, 0xf8, 0x6d, 0xc9, 0x82, 0x55, 0xc3, 0x52, 0x76, 0xfb, 0x11, 0xa8, 0x22, 0x5f, 0x26, 0x99,
-	0xc6, 0xdc, 0x15, 0x35, 0x40, 0x4a, 0xd2, 0x12, 0x03, 0x50, 0xe7, 0xfb, 0x38, 0xa6, 0x28, 0xf2,
-	0x22, 0x4e, 0x89, 0x7f, 0xd0, 0xcc, 0xb7, 0xad, 0xe5, 0xfa, 0xea, 0xbb, 0xee, 0xa4, 0x20, 0x54,
-	0x25, 0xe8, 0xe0, 0xbb, 0xfb, 0x2b, 0xee, 0xc0, 0xc4, 0xf7, 0x93, 0x94, 0xd4, 0xd7, 0x1c, 0x58,
-	0xe3, 0xa7, 0x97, 0xf6, 0x22, 0x28, 0x85, 0x88, 0x25, 0x88, 0x36, 0x0b, 0x6d, 0x6b, 0xb9, 0x0c,
-	0xcd, 0xaa, 0xf3, 0x6d, 0x01, 0xd4, 0x7a, 0x4c, 0xe2, 0x98, 0x21, 0x3a, 0x90, 0x48, 0x62, 0xfb,
-	0x0d, 0x50, 0x61, 0x28, 0xc4, 0x22, 0x42, 0x7e, 0x1a, 0x83, 0x0a, 0x3c, 0x31, 0xd8, 0xb7, 0xc1,
-	0xf5, 0xc9, 0xc2, 0x23, 0x81, 0xbe, 0x60, 0x05, 0x56, 0x27, 0xb6, 0x5e, 0x60, 0x2f, 0x81, 0x6a,
-	0x96, 0x72, 0x85, 0x28, 0x6b, 0x04, 0xc8, 0x4c, 0xbd, 0xc0, 0xee, 0x83, 0x9b, 0x14, 0x09, 0xe9,
-	0x45, 0x31, 0xf7, 0xb1, 0x10, 0x38, 0x48, 0x63, 0x95, 0xbf, 0x62, 0xac, 0xe6, 0x15, 0xb9, 0x9f,
-	0x71, 0x75, 0xc8, 0x76, 0xc0, 0x8d, 0x5d, 0x93, 0x4b, 0x4f, 0xa8, 0x64, 0x8a, 0x66, 0xa1, 0x9d,
-	0x5f, 0xae, 0xae, 0xba, 0xee, 0x25, 0x4d, 0xe4, 0x4e, 0xd5, 0x00, 0xac, 0xef, 0x9e, 0x5e, 0x0a,
-	0xfb, 0x33, 0xb0, 0xa8, 0x5d, 0xf5, 0x79, 0x18, 0x51, 0x2c, 0x09, 0x67, 0xaa, 0x16, 0x13, 0x2a,
-	0x9b, 0x45, 0xed, 0x6d, 0x7b, 0x3a, 0x27, 0x69, 0x0f, 0x2a, 0xd9, 0x3e, 0x3a, 0xa0, 0x1c, 0x05,
-	0x02, 0xde, 0x52, 0xfc, 0xf5, 0x09, 0x1d, 0x6a, 0xb6, 0xfd, 0x31, 0x98, 0xf7, 0x39, 0x93, 0x84,
-	0x25, 0x38, 0xf0, 0x4c, 0x4f, 0x36, 0x4b, 0xe7, 0x49, 0x9a, 0x4d, 0xa5, 0xb9, 0x91, 0x7e, 0xc2,
-	0xc6, 0x84, 0x6a, 0x2c, 0xf6, 0x1d, 0x50, 0xf7, 0x39, 0x1b, 0x52, 0xe2, 0x4b, 0x4f, 0xf2, 0xa7,
-	0x98, 0x35, 0xaf, 0xb5, 0xad, 0xe5, 0x3c, 0xac, 0x65, 0xd6, 0x6d, 0x65, 0xd4, 0xc9, 0xc3, 0x38,
-	0xf0, 0x62, 0x3c, 0x8c, 0xb1, 0xd8, 0x6b, 0x56, 0x74, 0x29, 0x54, 0x95, 0x0d, 0xa6, 0xa6, 0xce,
-	0x77, 0x73, 0x60, 0x5e, 0xdf, 0x3d, 0xab, 0xaa, 0x47, 0xf1, 0x48, 0xd8, 0x0f, 0x41, 0x39, 0x8b,
-	0x99, 0x69, 0x8b, 0xce, 0xb4, 0x97, 0xa7, 0x23, 0x9a, 0x31, 0xe1, 0x84, 0x63, 0xdf, 0x07, 0x05,
-	0xc2, 0x86, 0xdc, 0xb4, 0xc3, 0xdd, 0xcb, 0xb9, 0x3d, 0x36, 0xe4, 0x50, 0x73, 0xec, 0x27, 0xa0,
-	0x46, 0x18, 0x91, 0x04, 0x51, 0x2f, 0x42, 0xd2, 0xdf, 0x33, 0x75, 0xf2, 0xf6, 0xe5, 0x22, 0x7d,
-	0x05, 0x87, 0xd7, 0x0d, 0x5b, 0xaf, 0xec, 0xc7, 0xa0, 0x28, 0x54, 0x99, 0xeb, 0x36, 0xb8, 0x4a,
-	0x7d, 0x4c, 0x35, 0x07, 0x4c, 0xc9, 0x9d, 0xaf, 0xc0, 0xfc, 0x46, 0x42, 0xe9, 0xa7, 0x51, 0xa0,
-	0x8c, 0xe9, 0x90, 0x7a, 0xed, 0x20, 0x9d, 0x4d, 0xe2, 0xdc, 0x39, 0x49, 0xec, 0xfc, 0x64, 0x81,
-	0xc6, 0x63, 0x2c, 0xfc, 0x98, 0xec, 0x62, 0x68, 0xe6, 0xe2, 0xbf, 0x9a, 0xa0, 0xb3, 0x7e, 0xe7,
-	0xcf, 0xf3, 0xfb, 0x07, 0x0b, 0xdc, 0xda, 0x51, 0x39, 0xd8, 0x31, 0x73, 0x3e, 0x8b, 0xdb, 0x47,
-	0xa0, 0x82, 0x9f, 0x63, 0x3f, 0x51, 0xed, 0x61, 0x92, 0xfb, 0xce, 0x45, 0x6d, 0x95, 0x71, 0x3f,
-	0xcc, 0x08, 0xf0, 0x84, 0x6b, 0xdf, 0x03, 0x8b, 0x43, 0x12, 0x0b, 0xe9, 0x4d, 0x4c, 0x5e, 0x9c,
-	0x30, 0x35, 0x83, 0x0a, 0x7a, 0x06, 0xdd, 0xd4, 0xbb, 0x27, 0xd4, 0x84, 0xf5, 0x02, 0xfb, 0xff,
-	0xa0, 0x42, 0x39, 0x1b, 0xa9, 0x51, 0x4b, 0x75, 0x53, 0x97, 0x61, 0x59, 0x19, 0xfa, 0x9c, 0xd2,
-	0xce, 0x9f, 0x16, 0x58, 0x98, 0xf1, 0xd9, 0x04, 0x7c, 0x03, 0x94, 0x54, 0x29, 0x24, 0x42, 0x87,
-	0xbb, 0x7e, 0xba, 0x90, 0xa6, 0x86, 0xf3, 0x19, 0x87, 0x07, 0x9a, 0x05, 0x0d, 0xdb, 0xbe, 0x0f,
-	0x4a, 0x66, 0xa0, 0xcc, 0x5d, 0x6d, 0xa0, 0x6c, 0xe6, 0xa0, 0x61, 0xd8, 0x1f, 0x80, 0x6b, 0xd9,
-	0xe8, 0xc8, 0x5f, 0x6d, 0x74, 0x6c, 0xe6, 0x60, 0x46, 0x59, 0x6b, 0x80, 0x7a, 0xaa, 0x93, 0xcd,
-	0x9f, 0xce, 0x2f, 0x16, 0xb8, 0xa5, 0x7b, 0x7f, 0x36, 0x43, 0x9f, 0x83, 0x6b, 0xe6, 0x25, 0x36,
-	0x5e, 0x3e, 0x9c, 0x3e, 0x68, 0xe6, 0xe5, 0xd6, 0x75, 0x72, 0x5a, 0xe7, 0x24, 0xe4, 0xa9, 0x0a,
-	0xcc, 0xe4, 0xec, 0x07, 0xa0, 0x65, 0x46, 0xab, 0x1a, 0x4b, 0x48, 0x62, 0x8f, 0x92, 0x90, 0x48,
-	0x4f, 0x50, 0x8c, 0x23, 0x3d, 0x10, 0xcb, 0xf0, 0x7f, 0x13, 0x04, 0x44, 0x12, 0x3f, 0x51, 0xfb,
-	0x03, 0xb5, 0xbd, 0x55, 0x28, 0xe7, 0x1b, 0x85, 0xad, 0x42, 0xb9, 0xd0, 0x28, 0x6e, 0x15, 0xca,
-	0xc5, 0x46, 0xa9, 0xf3, 0x1c, 0x2c, 0xcc, 0x5c, 0xc0, 0xa4, 0x6b, 0x01, 0x94, 0x4c, 0x29, 0xa4,
-	0x2f, 0x5a, 0x31, 0xd6, 0xc9, 0xdf, 0x04, 0x37, 0x62, 0x8c, 0x68, 0xfa, 0x66, 0xfc, 0xb3, 0x17,
-	0xbb, 0xa6, 0x88, 0xfa, 0x30, 0xb5, 0xd3, 0xf9, 0xd1, 0x02, 0x0b, 0xeb, 0x88, 0xf9, 0x98, 0xce,
-	0x06, 0xef, 0x4d, 0x00, 0xb2, 0xdf, 0x18, 0x12, 0xe8, 0x44, 0x55, 0x60, 0xc5, 0x58, 0x7a, 0x81,
-	0xdd, 0x02, 0x65, 0x12, 0x60, 0x26, 0x89, 0x3c, 0x30, 0x65, 0x3a, 0x59, 0x4f, 0x77, 0x46, 0xf1,
-	0x35, 0x3a, 0x63, 0x51, 0x55, 0x19, 0x12, 0x9c, 0xe9, 0x90, 0x56, 0xa0, 0x59, 0x75, 0x7e, 0xb6,
-	0x40, 0x73, 0x1b, 0xc7, 0xea, 0xf7, 0x45, 0xe2, 0xff, 0x90, 0xe3, 0x6b, 0xc1, 0xe1, 0x91, 0x93,
-	0x7b, 0x79, 0xe4, 0xe4, 0x5e, 0x1d, 0x39, 0xd6, 0xd7, 0x63, 0xc7, 0xfa, 0x7e, 0xec, 0x58, 0xbf,
-	0x8e, 0x1d, 0xeb, 0x70, 0xec, 0x58, 0xbf, 0x8f, 0x1d, 0xeb, 0x8f, 0xb1, 0x93, 0x7b, 0x35, 0x76,
-	0xac, 0x17, 0xc7, 0x4e, 0xee, 0xf0, 0xd8, 0xc9, 0xbd, 0x3c, 0x76, 0x72, 0x5f, 0xb8, 0x23, 0x7e,
-	0xe2, 0x05, 0xe1, 0x17, 0xfc, 0x86, 0x3f, 0xc8, 0xbe, 0x77, 0x4b, 0x3a, 0xfb, 0xf7, 0xfe, 0x0a,
-	0x00, 0x00, 0xff, 0xff, 0x24, 0x92, 0x56, 0x72, 0xb9, 0x0b, 0x00, 0x00,
+	// 1221 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6f, 0x1c, 0xb5,
+	0x17, 0xdf, 0xc9, 0xfe, 0xe8, 0xae, 0x37, 0x49, 0x93, 0x69, 0x92, 0xef, 0x2a, 0x5f, 0x98, 0xa4,
+	0x2b, 0x5a, 0x82, 0x04, 0xb3, 0x24, 0x95, 0x38, 0xb4, 0xa8, 0x52, 0xd3, 0x52, 0x9a, 0xaa, 0x88,
+	0xc8, 0x29, 0x14, 0x71, 0x19, 0x39, 0x33, 0x6f, 0xb7, 0x56, 0x3d, 0xf6, 0x30, 0xf6, 0xa4, 0x2d,
+	0x27, 0x0e, 0xfc, 0x01, 0xbd, 0xf0, 0x17, 0x70, 0xe1, 0x8a, 0x90, 0xb8, 0x21, 0xae, 0x1c, 0x7b,
+	0xec, 0x0d, 0xba, 0xbd, 0x20, 0x71, 0xe9, 0x9f, 0x80, 0xec, 0xb1, 0x27, 0xd9, 0xa4, 0xa1, 0xa9,
+	0x7a, 0x40, 0xdc, 0xd6, 0xcf, 0xef, 0xf3, 0xf1, 0x9b, 0xf7, 0x3e, 0xef, 0xd9, 0x8b, 0xde, 0x53,
+	0x90, 0x66, 0x22, 0x27, 0x6c, 0x20, 0x21, 0xdf, 0x83, 0x7c, 0x40, 0x32, 0x3a, 0x90, 0xf1, 0x5d,
+	0x48, 0x0a, 0x06, 0x83, 0xbd, 0xf5, 0x41, 0x0a, 0x52, 0x92, 0x11, 0x84, 0x59, 0x2e, 0x94, 0xf0,
+	0x57, 0x9c, 0x7b, 0x58, 0xba, 0x87, 0x24, 0xa3, 0xa1, 0x73, 0x0f, 0xf7, 0xd6, 0x97, 0xdf, 0xaa,
+	0xf8, 0x34, 0x51, 0x2c, 0xd2, 0x54, 0xf0, 0x23, 0x34, 0xcb, 0xe7, 0x26, 0xbc, 0x86, 0x84, 0xb2,
+	0x22, 0x3f, 0x7a, 0xda, 0x21, 0x32, 0xe0, 0x45, 0x2a, 0xb5, 0x53, 0x75, 0xde, 0x3f, 0x7a, 0xdd,
+	0x17, 0xf9, 0xbd, 0x21, 0x13, 0xf7, 0xad, 0xd7, 0xf9, 0x09, 0xaf, 0x63, 0xbf, 0x70, 0xf9, 0x83,
+	0x09, 0x3f, 0x47, 0xa2, 0xbf, 0x96, 0xc6, 0xc6, 0x3d, 0x87, 0xaf, 0x0a, 0x90, 0x2a, 0xca, 0x41,
+	0x66, 0x82, 0x4b, 0x87, 0x5b, 0x19, 0x09, 0x31, 0x62, 0x30, 0x30, 0xab, 0xdd, 0x62, 0x38, 0x50,
+	0x34, 0x05, 0xa9, 0x48, 0x9a, 0x59, 0x87, 0xb3, 0x09, 0x64, 0xc0, 0x13, 0xe0, 0x31, 0x05, 0x39,
+	0x18, 0x89, 0x91, 0x30, 0x76, 0xf3, 0xab, 0x74, 0xe9, 0x7f, 0x3b, 0x85, 0x66, 0x36, 0x8b, 0xe1,
+	0x10, 0x72, 0x48, 0x76, 0x14, 0xc9, 0x95, 0x7f, 0x15, 0x4d, 0x73, 0x91, 0x52, 0x4e, 0x58, 0xa4,
+	0xf9, 0x7a, 0xde, 0xaa, 0xb7, 0xd6, 0xdd, 0x58, 0x0e, 0xcb, 0xc3, 0x42, 0x77, 0x58, 0x78, 0xdb,
+	0x1d, 0xb6, 0xd9, 0x78, 0xf4, 0xfb, 0x8a, 0x87, 0xbb, 0x16, 0xa5, 0xed, 0xfe, 0x15, 0xd4, 0x25,
+	0xb1, 0x2a, 0x1c, 0xc7, 0xd4, 0x09, 0x39, 0x50, 0x09, 0x32, 0x14, 0x3b, 0x68, 0x56, 0xec, 0x41,
+	0xce, 0x48, 0x16, 0x65, 0x82, 0xd1, 0xf8, 0x61, 0xaf, 0xbe, 0xea, 0xad, 0xcd, 0x6e, 0xbc, 0x1b,
+	0x56, 0x82, 0xd0, 0x4a, 0x30, 0xc9, 0x0f, 0xf7, 0xd6, 0xc3, 0x1d, 0x9b, 0xdf, 0x4f, 0x4b, 0xd0,
+	0xb6, 0xc1, 0xe0, 0x19, 0x71, 0x70, 0xe9, 0x2f, 0xa1, 0x56, 0x4a, 0x78, 0x41, 0x58, 0xaf, 0xb1,
+	0xea, 0xad, 0xb5, 0xb1, 0x5d, 0xf5, 0xbf, 0x6b, 0xa2, 0x99, 0x2d, 0xae, 0x20, 0xe7, 0x84, 0xed,
+	0x28, 0xa2, 0xc0, 0x7f, 0x03, 0x75, 0x38, 0x49, 0x41, 0x66, 0x24, 0x2e, 0x73, 0xd0, 0xc1, 0xfb,
+	0x06, 0xff, 0x2c, 0x9a, 0xae, 0x16, 0x11, 0x4d, 0xcc, 0x07, 0x76, 0x70, 0xb7, 0xb2, 0x6d, 0x25,
+	0xfe, 0x0a, 0xea, 0xba, 0x92, 0x6b, 0x8f, 0xb6, 0xf1, 0x40, 0xce, 0xb4, 0x95, 0xf8, 0xdb, 0xe8,
+	0x0c, 0x23, 0x52, 0x45, 0x59, 0x2e, 0x62, 0x90, 0x12, 0x92, 0x32, 0x57, 0xf5, 0x13, 0xe6, 0x6a,
+	0x5e, 0x83, 0xb7, 0x1d, 0xd6, 0xa4, 0xec, 0x0e, 0x3a, 0xbd, 0x6b, 0x6b, 0x19, 0x49, 0x5d, 0x4c,
+	0xd9, 0x6b, 0xac, 0xd6, 0xd7, 0xba, 0x1b, 0x61, 0xf8, 0x92, 0x26, 0x0a, 0x27, 0x34, 0x80, 0x67,
+	0x77, 0x0f, 0x2e, 0xa5, 0xff, 0x39, 0x5a, 0x32, 0xa1, 0xc6, 0x22, 0xcd, 0x18, 0x28, 0x2a, 0xb8,
+	0xd6, 0x62, 0xc1, 0x54, 0xaf, 0x69, 0xa2, 0x5d, 0x9d, 0xac, 0x49, 0xd9, 0x83, 0x9a, 0x76, 0x9b,
+	0x3c, 0x64, 0x82, 0x24, 0x12, 0x2f, 0x68, 0xfc, 0xd5, 0x0a, 0x8e, 0x0d, 0xda, 0xff, 0x04, 0xcd,
+	0xc7, 0x82, 0x2b, 0xca, 0x0b, 0x48, 0x22, 0xdb, 0x93, 0xbd, 0xd6, 0x8b, 0x28, 0xed, 0xa6, 0xe6,
+	0xbc, 0x5e, 0xfe, 0xc4, 0x73, 0x15, 0xd4, 0x5a, 0xfc, 0x73, 0x68, 0x36, 0x16, 0x7c, 0xc8, 0x68,
+	0xac, 0x22, 0x25, 0xee, 0x01, 0xef, 0x9d, 0x5a, 0xf5, 0xd6, 0xea, 0x78, 0xc6, 0x59, 0x6f, 0x6b,
+	0xa3, 0x29, 0x1e, 0x40, 0x12, 0xe5, 0x30, 0xcc, 0x41, 0xde, 0xed, 0x75, 0x8c, 0x14, 0xba, 0xda,
+	0x86, 0x4b, 0x93, 0xff, 0x3e, 0x5a, 0xc8, 0x48, 0x21, 0x21, 0x22, 0x43, 0x05, 0xb9, 0x0b, 0x4d,
+	0xf6, 0x90, 0xe1, 0xf3, 0xcd, 0xde, 0x15, 0xbd, 0x65, 0x8f, 0x96, 0xfe, 0x3a, 0x5a, 0x88, 0x75,
+	0x6f, 0xc6, 0x85, 0xa2, 0x7b, 0xb0, 0x8f, 0xe8, 0x1a, 0xc4, 0x99, 0x03, 0x7b, 0x15, 0xe4, 0x2c,
+	0x9a, 0x2e, 0x0f, 0xc9, 0x81, 0x48, 0xc1, 0x7b, 0xd3, 0xa5, 0x88, 0x8c, 0x0d, 0x1b, 0x53, 0xff,
+	0xfb, 0x29, 0x34, 0x6f, 0x6a, 0xe0, 0xd4, 0x7d, 0x25, 0x1f, 0x49, 0xff, 0x32, 0x6a, 0xbb, 0xda,
+	0xd9, 0xf6, 0xec, 0x4f, 0x66, 0xeb, 0x60, 0x65, 0x1d, 0x12, 0x57, 0x18, 0xff, 0x22, 0x6a, 0x50,
+	0x3e, 0x14, 0xb6, 0x2d, 0xcf, 0xbf, 0x1c, 0xbb, 0xc5, 0x87, 0x02, 0x1b, 0x8c, 0x7f, 0x0b, 0xcd,
+	0x50, 0x4e, 0x15, 0x25, 0x2c, 0xca, 0x88, 0x8a, 0xef, 0x5a, 0xbd, 0xbe, 0xfd, 0x72, 0x92, 0x6d,
+	0xed, 0x8e, 0xa7, 0x2d, 0xda, 0xac, 0xfc, 0x6b, 0xa8, 0x29, 0x15, 0x51, 0x60, 0xda, 0xf1, 0x24,
+	0x3a, 0x9d, 0x68, 0x52, 0x5c, 0x82, 0xfb, 0x5f, 0xa3, 0xf9, 0xeb, 0x05, 0x63, 0x9f, 0x65, 0x89,
+	0x36, 0x96, 0xc3, 0xf2, 0xb5, 0x93, 0x74, 0x54, 0x4c, 0x53, 0x2f, 0x10, 0x53, 0xff, 0x97, 0x29,
+	0x34, 0x77, 0x0d, 0x64, 0x9c, 0xd3, 0x5d, 0xc0, 0x76, 0x3e, 0xff, 0xab, 0x05, 0x3a, 0x1a, 0x77,
+	0xfd, 0x45, 0x4d, 0x70, 0x9c, 0xc2, 0x1b, 0xaf, 0xac, 0xf0, 0xe6, 0xc9, 0x15, 0xde, 0x3a, 0xaa,
+	0xf0, 0x1f, 0x3d, 0xb4, 0x70, 0x47, 0x6b, 0xe1, 0x8e, 0xbd, 0xf7, 0x5c, 0xfd, 0x3e, 0x46, 0x1d,
+	0x78, 0x60, 0x08, 0x05, 0xb7, 0x22, 0x7b, 0xe7, 0xb8, 0x31, 0xe3, 0xb0, 0x1f, 0x39, 0x00, 0xde,
+	0xc7, 0xfa, 0x17, 0xd0, 0xd2, 0x90, 0xe6, 0x52, 0x45, 0x95, 0x29, 0xca, 0x0b, 0xae, 0x67, 0x72,
+	0xc3, 0x84, 0x73, 0xc6, 0xec, 0xee, 0x43, 0x0b, 0xbe, 0x95, 0xf8, 0xff, 0x47, 0x1d, 0x26, 0xf8,
+	0x48, 0x5f, 0x3d, 0xcc, 0x7c, 0x61, 0x1b, 0xb7, 0xb5, 0x61, 0x5b, 0x30, 0xd6, 0xff, 0xcb, 0x43,
+	0x8b, 0x87, 0x62, 0xb6, 0x85, 0xbf, 0x8e, 0x5a, 0x5a, 0x92, 0x85, 0x34, 0x65, 0x9f, 0xdd, 0x08,
+	0x27, 0x23, 0xae, 0x2e, 0xab, 0x23, 0x01, 0xef, 0x18, 0x14, 0xb6, 0x68, 0xff, 0x22, 0x6a, 0xd9,
+	0x01, 0x3b, 0x75, 0xb2, 0x01, 0x7b, 0xa3, 0x86, 0x2d, 0xc2, 0xff, 0x10, 0x9d, 0x72, 0xa3, 0xb4,
+	0x7e, 0xb2, 0x51, 0x7a, 0xa3, 0x86, 0x1d, 0x64, 0x73, 0x0e, 0xcd, 0x96, 0x3c, 0xae, 0xc0, 0xfd,
+	0x5f, 0x3d, 0xb4, 0x60, 0x66, 0xd0, 0xe1, 0x0a, 0x7d, 0x81, 0x4e, 0xd9, 0x97, 0x89, 0x8d, 0xf2,
+	0xf2, 0xe4, 0x41, 0x87, 0x5e, 0x32, 0x46, 0xaf, 0x07, 0x79, 0xf6, 0x53, 0x5e, 0xb2, 0x60, 0x47,
+	0xe7, 0x5f, 0x42, 0xcb, 0xf6, 0xaa, 0xd1, 0x63, 0x9a, 0x28, 0x88, 0x18, 0x4d, 0xa9, 0x8a, 0x24,
+	0x03, 0xc8, 0x8c, 0x8a, 0xda, 0xf8, 0x7f, 0x95, 0x07, 0x26, 0x0a, 0x6e, 0xe9, 0xfd, 0x1d, 0xbd,
+	0x7d, 0xb3, 0xd1, 0xae, 0xcf, 0x35, 0x6e, 0x36, 0xda, 0x8d, 0xb9, 0xe6, 0xcd, 0x46, 0xbb, 0x39,
+	0xd7, 0xea, 0x3f, 0x40, 0x8b, 0x87, 0x3e, 0xc0, 0x96, 0x6b, 0x11, 0xb5, 0xac, 0x14, 0xca, 0x1b,
+	0xbe, 0x99, 0x9b, 0xe2, 0xdf, 0x40, 0xa7, 0x73, 0x20, 0xac, 0xbc, 0x43, 0x5f, 0xed, 0x05, 0x33,
+	0xa3, 0x81, 0xe6, 0x30, 0xbd, 0xd3, 0xff, 0xc9, 0x43, 0x8b, 0x57, 0x09, 0x8f, 0x81, 0x1d, 0x4e,
+	0xde, 0x9b, 0x08, 0xb9, 0x67, 0x1d, 0x4d, 0x4c, 0xa1, 0x3a, 0xb8, 0x63, 0x2d, 0x5b, 0x89, 0xbf,
+	0x8c, 0xda, 0x34, 0x01, 0xae, 0xa8, 0x7a, 0x68, 0x65, 0x5a, 0xad, 0x27, 0x3b, 0xa3, 0xf9, 0x1a,
+	0x9d, 0xb1, 0xa4, 0x55, 0x76, 0xa0, 0x31, 0xed, 0xaa, 0xff, 0xb3, 0x87, 0x7a, 0xb7, 0x21, 0xd7,
+	0xcf, 0x39, 0x05, 0xff, 0xa1, 0xc0, 0x37, 0x93, 0xc7, 0x4f, 0x83, 0xda, 0x93, 0xa7, 0x41, 0xed,
+	0xf9, 0xd3, 0xc0, 0xfb, 0x66, 0x1c, 0x78, 0x3f, 0x8c, 0x03, 0xef, 0xb7, 0x71, 0xe0, 0x3d, 0x1e,
+	0x07, 0xde, 0x1f, 0xe3, 0xc0, 0xfb, 0x73, 0x1c, 0xd4, 0x9e, 0x8f, 0x03, 0xef, 0xd1, 0xb3, 0xa0,
+	0xf6, 0xf8, 0x59, 0x50, 0x7b, 0xf2, 0x2c, 0xa8, 0x7d, 0x19, 0x8e, 0xc4, 0x7e, 0x14, 0x54, 0x1c,
+	0xf3, 0xb7, 0xe4, 0x92, 0xfb, 0xbd, 0xdb, 0x32, 0xd5, 0xbf, 0xf0, 0xf7, 0x00, 0xf9, 0xd8, 0xe1,
+	0x64, 0xc9, 0x0c, 0x00, 0x00,
 }
 
 func (this *BufferedStart) Equal(that interface{}) bool {
@@ -999,6 +1056,15 @@ func (this *InternalState) Equal(that interface{}) bool {
 	if this.NeedRefresh != that1.NeedRefresh {
 		return false
 	}
+	if this.PauseAfterFailures != that1.PauseAfterFailures {
+		return false
+	}
+	if this.ConsecutiveFailures != that1.ConsecutiveFailures {
+		return false
+	}
+	if this.PauseReason != that1.PauseReason {
+		return false
+	}
 	return true
 }
 func (this *StartScheduleArgs) Equal(that interface{}) bool {
@@ -1089,6 +1155,15 @@ func (this *DescribeResponse) Equal(that interface{}) bool {
 	if this.ConflictToken != that1.ConflictToken {
 		return false
 	}
+	if this.PauseAfterFailures != that1.PauseAfterFailures {
+		return false
+	}
+	if this.ConsecutiveFailures != that1.ConsecutiveFailures {
+		return false
+	}
+	if this.PauseReason != that1.PauseReason {
+		return false
+	}
 	return true
 }
 func (this *WatchWorkflowRequest) Equal(that interface{}) bool {
@@ -1343,7 +1418,7 @@ func (this *InternalState) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 13)
+	s := make([]string, 0, 16)
 	s = append(s, "&schedule.InternalState{")
 	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
@@ -1360,6 +1435,9 @@ func (this *InternalState) GoString() string {
 	}
 	s = append(s, "ConflictToken: "+fmt.Sprintf("%#v", this.ConflictToken)+",\n")
 	s = append(s, "NeedRefresh: "+fmt.Sprintf("%#v", this.NeedRefresh)+",\n")
+	s = append(s, "PauseAfterFailures: "+fmt.Sprintf("%#v", this.PauseAfterFailures)+",\n")
+	s = append(s, "ConsecutiveFailures: "+fmt.Sprintf("%#v", this.ConsecutiveFailures)+",\n")
+	s = append(s, "PauseReason: "+fmt.Sprintf("%#v", this.PauseReason)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1401,7 +1479,7 @@ func (this *DescribeResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 10)
 	s = append(s, "&schedule.DescribeResponse{")
 	if this.Schedule != nil {
 		s = append(s, "Schedule: "+fmt.Sprintf("%#v", this.Schedule)+",\n")
@@ -1410,6 +1488,9 @@ func (this *DescribeResponse) GoString() string {
 		s = append(s, "Info: "+fmt.Sprintf("%#v", this.Info)+",\n")
 	}
 	s = append(s, "ConflictToken: "+fmt.Sprintf("%#v", this.ConflictToken)+",\n")
+	s = append(s, "PauseAfterFailures: "+fmt.Sprintf("%#v", this.PauseAfterFailures)+",\n")
+	s = append(s, "ConsecutiveFailures: "+fmt.Sprintf("%#v", this.ConsecutiveFailures)+",\n")
+	s = append(s, "PauseReason: "+fmt.Sprintf("%#v", this.PauseReason)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1596,6 +1677,23 @@ func (m *InternalState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PauseReason) > 0 {
+		i -= len(m.PauseReason)
+		copy(dAtA[i:], m.PauseReason)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.PauseReason)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.ConsecutiveFailures != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ConsecutiveFailures))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.PauseAfterFailures != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.PauseAfterFailures))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.NeedRefresh {
 		i--
 		if m.NeedRefresh {
@@ -1814,6 +1912,23 @@ func (m *DescribeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PauseReason) > 0 {
+		i -= len(m.PauseReason)
+		copy(dAtA[i:], m.PauseReason)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.PauseReason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ConsecutiveFailures != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ConsecutiveFailures))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PauseAfterFailures != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.PauseAfterFailures))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.ConflictToken != 0 {
 		i = encodeVarintMessage(dAtA, i, uint64(m.ConflictToken))
 		i--
@@ -2250,6 +2365,16 @@ func (m *InternalState) Size() (n int) {
 	if m.NeedRefresh {
 		n += 2
 	}
+	if m.PauseAfterFailures != 0 {
+		n += 1 + sovMessage(uint64(m.PauseAfterFailures))
+	}
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovMessage(uint64(m.ConsecutiveFailures))
+	}
+	l = len(m.PauseReason)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -2311,6 +2436,16 @@ func (m *DescribeResponse) Size() (n int) {
 	if m.ConflictToken != 0 {
 		n += 1 + sovMessage(uint64(m.ConflictToken))
 	}
+	if m.PauseAfterFailures != 0 {
+		n += 1 + sovMessage(uint64(m.PauseAfterFailures))
+	}
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovMessage(uint64(m.ConsecutiveFailures))
+	}
+	l = len(m.PauseReason)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -2494,6 +2629,9 @@ func (this *InternalState) String() string {
 		`ConflictToken:` + fmt.Sprintf("%v", this.ConflictToken) + `,`,
 		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
 		`NeedRefresh:` + fmt.Sprintf("%v", this.NeedRefresh) + `,`,
+		`PauseAfterFailures:` + fmt.Sprintf("%v", this.PauseAfterFailures) + `,`,
+		`ConsecutiveFailures:` + fmt.Sprintf("%v", this.ConsecutiveFailures) + `,`,
+		`PauseReason:` + fmt.Sprintf("%v", this.PauseReason) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2530,6 +2668,9 @@ func (this *DescribeResponse) String() string {
 		`Schedule:` + strings.Replace(fmt.Sprintf("%v", this.Schedule), "Schedule", "v13.Schedule", 1) + `,`,
 		`Info:` + strings.Replace(fmt.Sprintf("%v", this.Info), "ScheduleInfo", "v13.ScheduleInfo", 1) + `,`,
 		`ConflictToken:` + fmt.Sprintf("%v", this.ConflictToken) + `,`,
+		`PauseAfterFailures:` + fmt.Sprintf("%v", this.PauseAfterFailures) + `,`,
+		`ConsecutiveFailures:` + fmt.Sprintf("%v", this.ConsecutiveFailures) + `,`,
+		`PauseReason:` + fmt.Sprintf("%v", this.PauseReason) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3103,6 +3244,76 @@ func (m *InternalState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.NeedRefresh = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseAfterFailures", wireType)
+			}
+			m.PauseAfterFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PauseAfterFailures |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauseReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -3552,6 +3763,76 @@ func (m *DescribeResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseAfterFailures", wireType)
+			}
+			m.PauseAfterFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PauseAfterFailures |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauseReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
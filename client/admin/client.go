@@ -83,3 +83,21 @@ func (c *clientImpl) StreamWorkflowReplicationMessages(
 	// do not use createContext function, let caller manage stream API lifecycle
 	return c.client.StreamWorkflowReplicationMessages(ctx, opts...)
 }
+
+func (c *clientImpl) StreamDatabaseBackup(
+	ctx context.Context,
+	request *adminservice.StreamDatabaseBackupRequest,
+	opts ...grpc.CallOption,
+) (adminservice.AdminService_StreamDatabaseBackupClient, error) {
+	// do not use createContext function, let caller manage stream API lifecycle
+	return c.client.StreamDatabaseBackup(ctx, request, opts...)
+}
+
+func (c *clientImpl) StreamDiagnosticsBundle(
+	ctx context.Context,
+	request *adminservice.StreamDiagnosticsBundleRequest,
+	opts ...grpc.CallOption,
+) (adminservice.AdminService_StreamDiagnosticsBundleClient, error) {
+	// do not use createContext function, let caller manage stream API lifecycle
+	return c.client.StreamDiagnosticsBundle(ctx, request, opts...)
+}
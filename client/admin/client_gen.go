@@ -43,6 +43,16 @@ func (c *clientImpl) AddOrUpdateRemoteCluster(
 	return c.client.AddOrUpdateRemoteCluster(ctx, request, opts...)
 }
 
+func (c *clientImpl) AddSearchAttributeAliases(
+	ctx context.Context,
+	request *adminservice.AddSearchAttributeAliasesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.AddSearchAttributeAliasesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.AddSearchAttributeAliases(ctx, request, opts...)
+}
+
 func (c *clientImpl) AddSearchAttributes(
 	ctx context.Context,
 	request *adminservice.AddSearchAttributesRequest,
@@ -53,6 +63,36 @@ func (c *clientImpl) AddSearchAttributes(
 	return c.client.AddSearchAttributes(ctx, request, opts...)
 }
 
+func (c *clientImpl) CancelDrain(
+	ctx context.Context,
+	request *adminservice.CancelDrainRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CancelDrainResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.CancelDrain(ctx, request, opts...)
+}
+
+func (c *clientImpl) CancelScheduleBackfill(
+	ctx context.Context,
+	request *adminservice.CancelScheduleBackfillRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CancelScheduleBackfillResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.CancelScheduleBackfill(ctx, request, opts...)
+}
+
+func (c *clientImpl) CheckVisibilityConsistency(
+	ctx context.Context,
+	request *adminservice.CheckVisibilityConsistencyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CheckVisibilityConsistencyResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.CheckVisibilityConsistency(ctx, request, opts...)
+}
+
 func (c *clientImpl) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -63,6 +103,56 @@ func (c *clientImpl) CloseShard(
 	return c.client.CloseShard(ctx, request, opts...)
 }
 
+func (c *clientImpl) CreateAPIKey(
+	ctx context.Context,
+	request *adminservice.CreateAPIKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CreateAPIKeyResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.CreateAPIKey(ctx, request, opts...)
+}
+
+func (c *clientImpl) CreateClusterSnapshot(
+	ctx context.Context,
+	request *adminservice.CreateClusterSnapshotRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CreateClusterSnapshotResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.CreateClusterSnapshot(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeleteDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.DeleteDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteDynamicConfigOverrideResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DeleteDynamicConfigOverride(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeleteHistoryBranchGarbage(
+	ctx context.Context,
+	request *adminservice.DeleteHistoryBranchGarbageRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteHistoryBranchGarbageResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DeleteHistoryBranchGarbage(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeleteRole(
+	ctx context.Context,
+	request *adminservice.DeleteRoleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteRoleResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DeleteRole(ctx, request, opts...)
+}
+
 func (c *clientImpl) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -83,6 +173,16 @@ func (c *clientImpl) DescribeCluster(
 	return c.client.DescribeCluster(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeDrain(
+	ctx context.Context,
+	request *adminservice.DescribeDrainRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeDrainResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeDrain(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeHistoryHost(
 	ctx context.Context,
 	request *adminservice.DescribeHistoryHostRequest,
@@ -103,6 +203,116 @@ func (c *clientImpl) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeNamespaceDeletion(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceDeletionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNamespaceDeletionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeNamespaceDeletion(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeNamespaceReplicationStatus(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceReplicationStatusRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNamespaceReplicationStatusResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeNamespaceReplicationStatus(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeNamespaceStats(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceStatsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNamespaceStatsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeNamespaceStats(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribePersistenceCircuitBreakers(
+	ctx context.Context,
+	request *adminservice.DescribePersistenceCircuitBreakersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribePersistenceCircuitBreakersResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribePersistenceCircuitBreakers(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeScheduleBackfills(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleBackfillsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeScheduleBackfillsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeScheduleBackfills(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeSchedulePauseAfterFailures(
+	ctx context.Context,
+	request *adminservice.DescribeSchedulePauseAfterFailuresRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeSchedulePauseAfterFailuresResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeSchedulePauseAfterFailures(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeShard(
+	ctx context.Context,
+	request *adminservice.DescribeShardRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeShardResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeShard(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeShardDistribution(
+	ctx context.Context,
+	request *adminservice.DescribeShardDistributionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeShardDistributionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeShardDistribution(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeTaskQueueTopology(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueTopologyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskQueueTopologyResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeTaskQueueTopology(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeVisibilityIngestion(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityIngestionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeVisibilityIngestionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeVisibilityIngestion(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetComponentHealth(
+	ctx context.Context,
+	request *adminservice.GetComponentHealthRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetComponentHealthResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetComponentHealth(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetDLQMessages(
 	ctx context.Context,
 	request *adminservice.GetDLQMessagesRequest,
@@ -123,6 +333,46 @@ func (c *clientImpl) GetDLQReplicationMessages(
 	return c.client.GetDLQReplicationMessages(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetDynamicConfigOverrides(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigOverridesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigOverridesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetDynamicConfigOverrides(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetEffectiveDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetEffectiveDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetEffectiveDynamicConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetEffectiveDynamicConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetGroupRoles(
+	ctx context.Context,
+	request *adminservice.GetGroupRolesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetGroupRolesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetGroupRoles(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetNamespaceFeatureFlags(
+	ctx context.Context,
+	request *adminservice.GetNamespaceFeatureFlagsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetNamespaceFeatureFlagsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetNamespaceFeatureFlags(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetNamespaceReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetNamespaceReplicationMessagesRequest,
@@ -133,6 +383,16 @@ func (c *clientImpl) GetNamespaceReplicationMessages(
 	return c.client.GetNamespaceReplicationMessages(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetNamespaceVisibilityRetention(
+	ctx context.Context,
+	request *adminservice.GetNamespaceVisibilityRetentionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetNamespaceVisibilityRetentionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetNamespaceVisibilityRetention(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetReplicationMessagesRequest,
@@ -143,6 +403,16 @@ func (c *clientImpl) GetReplicationMessages(
 	return c.client.GetReplicationMessages(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetSDKUsage(
+	ctx context.Context,
+	request *adminservice.GetSDKUsageRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetSDKUsageResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetSDKUsage(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetSearchAttributes(
 	ctx context.Context,
 	request *adminservice.GetSearchAttributesRequest,
@@ -183,6 +453,36 @@ func (c *clientImpl) GetWorkflowExecutionRawHistoryV2(
 	return c.client.GetWorkflowExecutionRawHistoryV2(ctx, request, opts...)
 }
 
+func (c *clientImpl) ImportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ImportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ImportWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ImportWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListAPIKeys(
+	ctx context.Context,
+	request *adminservice.ListAPIKeysRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListAPIKeysResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListAPIKeys(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListArchivalDLQTasks(
+	ctx context.Context,
+	request *adminservice.ListArchivalDLQTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListArchivalDLQTasksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListArchivalDLQTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -203,6 +503,16 @@ func (c *clientImpl) ListClusters(
 	return c.client.ListClusters(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListDynamicConfigChanges(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigChangesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigChangesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListDynamicConfigChanges(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -213,6 +523,36 @@ func (c *clientImpl) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListMetrics(
+	ctx context.Context,
+	request *adminservice.ListMetricsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListMetricsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListMetrics(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListRoles(
+	ctx context.Context,
+	request *adminservice.ListRolesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListRolesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListRoles(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListTopPersistenceNamespaces(
+	ctx context.Context,
+	request *adminservice.ListTopPersistenceNamespacesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListTopPersistenceNamespacesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListTopPersistenceNamespaces(ctx, request, opts...)
+}
+
 func (c *clientImpl) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -233,6 +573,16 @@ func (c *clientImpl) PurgeDLQMessages(
 	return c.client.PurgeDLQMessages(ctx, request, opts...)
 }
 
+func (c *clientImpl) PutRole(
+	ctx context.Context,
+	request *adminservice.PutRoleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PutRoleResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.PutRole(ctx, request, opts...)
+}
+
 func (c *clientImpl) ReapplyEvents(
 	ctx context.Context,
 	request *adminservice.ReapplyEventsRequest,
@@ -263,6 +613,16 @@ func (c *clientImpl) RefreshWorkflowTasks(
 	return c.client.RefreshWorkflowTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) RehydrateWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RehydrateWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RehydrateWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RehydrateWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) RemoveRemoteCluster(
 	ctx context.Context,
 	request *adminservice.RemoveRemoteClusterRequest,
@@ -302,3 +662,153 @@ func (c *clientImpl) ResendReplicationTasks(
 	defer cancel()
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
+
+func (c *clientImpl) RestoreClusterSnapshot(
+	ctx context.Context,
+	request *adminservice.RestoreClusterSnapshotRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RestoreClusterSnapshotResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RestoreClusterSnapshot(ctx, request, opts...)
+}
+
+func (c *clientImpl) RetryArchivalDLQTask(
+	ctx context.Context,
+	request *adminservice.RetryArchivalDLQTaskRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RetryArchivalDLQTaskResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RetryArchivalDLQTask(ctx, request, opts...)
+}
+
+func (c *clientImpl) RevokeAPIKey(
+	ctx context.Context,
+	request *adminservice.RevokeAPIKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RevokeAPIKeyResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RevokeAPIKey(ctx, request, opts...)
+}
+
+func (c *clientImpl) RotateAPIKey(
+	ctx context.Context,
+	request *adminservice.RotateAPIKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RotateAPIKeyResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RotateAPIKey(ctx, request, opts...)
+}
+
+func (c *clientImpl) SetDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetDynamicConfigOverrideResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SetDynamicConfigOverride(ctx, request, opts...)
+}
+
+func (c *clientImpl) SetDynamicConfigRollout(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRolloutRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetDynamicConfigRolloutResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SetDynamicConfigRollout(ctx, request, opts...)
+}
+
+func (c *clientImpl) SetGroupRoles(
+	ctx context.Context,
+	request *adminservice.SetGroupRolesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetGroupRolesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SetGroupRoles(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartBatchOperationResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.StartBatchOperation(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartDrain(
+	ctx context.Context,
+	request *adminservice.StartDrainRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartDrainResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.StartDrain(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartNamespaceExport(
+	ctx context.Context,
+	request *adminservice.StartNamespaceExportRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartNamespaceExportResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.StartNamespaceExport(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateNamespaceDeletionRate(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceDeletionRateRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNamespaceDeletionRateResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateNamespaceDeletionRate(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateNamespaceVisibilityRetention(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceVisibilityRetentionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNamespaceVisibilityRetentionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateNamespaceVisibilityRetention(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateSchedulePauseAfterFailures(
+	ctx context.Context,
+	request *adminservice.UpdateSchedulePauseAfterFailuresRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateSchedulePauseAfterFailuresResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateSchedulePauseAfterFailures(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateWorkflowExecutionMemo(
+	ctx context.Context,
+	request *adminservice.UpdateWorkflowExecutionMemoRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkflowExecutionMemoResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateWorkflowExecutionMemo(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpsertWorkflowExecutionSearchAttributes(
+	ctx context.Context,
+	request *adminservice.UpsertWorkflowExecutionSearchAttributesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpsertWorkflowExecutionSearchAttributesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpsertWorkflowExecutionSearchAttributes(ctx, request, opts...)
+}
@@ -104,3 +104,31 @@ func (c *metricClient) StreamWorkflowReplicationMessages(
 
 	return c.client.StreamWorkflowReplicationMessages(ctx, opts...)
 }
+
+func (c *metricClient) StreamDatabaseBackup(
+	ctx context.Context,
+	request *adminservice.StreamDatabaseBackupRequest,
+	opts ...grpc.CallOption,
+) (_ adminservice.AdminService_StreamDatabaseBackupClient, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientStreamDatabaseBackupScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StreamDatabaseBackup(ctx, request, opts...)
+}
+
+func (c *metricClient) StreamDiagnosticsBundle(
+	ctx context.Context,
+	request *adminservice.StreamDiagnosticsBundleRequest,
+	opts ...grpc.CallOption,
+) (_ adminservice.AdminService_StreamDiagnosticsBundleClient, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientStreamDiagnosticsBundleScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StreamDiagnosticsBundle(ctx, request, opts...)
+}
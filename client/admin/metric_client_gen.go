@@ -49,6 +49,20 @@ func (c *metricClient) AddOrUpdateRemoteCluster(
 	return c.client.AddOrUpdateRemoteCluster(ctx, request, opts...)
 }
 
+func (c *metricClient) AddSearchAttributeAliases(
+	ctx context.Context,
+	request *adminservice.AddSearchAttributeAliasesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.AddSearchAttributeAliasesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientAddSearchAttributeAliasesScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.AddSearchAttributeAliases(ctx, request, opts...)
+}
+
 func (c *metricClient) AddSearchAttributes(
 	ctx context.Context,
 	request *adminservice.AddSearchAttributesRequest,
@@ -63,6 +77,48 @@ func (c *metricClient) AddSearchAttributes(
 	return c.client.AddSearchAttributes(ctx, request, opts...)
 }
 
+func (c *metricClient) CancelDrain(
+	ctx context.Context,
+	request *adminservice.CancelDrainRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.CancelDrainResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientCancelDrainScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CancelDrain(ctx, request, opts...)
+}
+
+func (c *metricClient) CancelScheduleBackfill(
+	ctx context.Context,
+	request *adminservice.CancelScheduleBackfillRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.CancelScheduleBackfillResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientCancelScheduleBackfillScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CancelScheduleBackfill(ctx, request, opts...)
+}
+
+func (c *metricClient) CheckVisibilityConsistency(
+	ctx context.Context,
+	request *adminservice.CheckVisibilityConsistencyRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.CheckVisibilityConsistencyResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientCheckVisibilityConsistencyScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CheckVisibilityConsistency(ctx, request, opts...)
+}
+
 func (c *metricClient) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -77,6 +133,76 @@ func (c *metricClient) CloseShard(
 	return c.client.CloseShard(ctx, request, opts...)
 }
 
+func (c *metricClient) CreateAPIKey(
+	ctx context.Context,
+	request *adminservice.CreateAPIKeyRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.CreateAPIKeyResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientCreateAPIKeyScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CreateAPIKey(ctx, request, opts...)
+}
+
+func (c *metricClient) CreateClusterSnapshot(
+	ctx context.Context,
+	request *adminservice.CreateClusterSnapshotRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.CreateClusterSnapshotResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientCreateClusterSnapshotScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CreateClusterSnapshot(ctx, request, opts...)
+}
+
+func (c *metricClient) DeleteDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.DeleteDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DeleteDynamicConfigOverrideResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientDeleteDynamicConfigOverrideScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DeleteDynamicConfigOverride(ctx, request, opts...)
+}
+
+func (c *metricClient) DeleteHistoryBranchGarbage(
+	ctx context.Context,
+	request *adminservice.DeleteHistoryBranchGarbageRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DeleteHistoryBranchGarbageResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientDeleteHistoryBranchGarbageScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DeleteHistoryBranchGarbage(ctx, request, opts...)
+}
+
+func (c *metricClient) DeleteRole(
+	ctx context.Context,
+	request *adminservice.DeleteRoleRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DeleteRoleResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientDeleteRoleScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DeleteRole(ctx, request, opts...)
+}
+
 func (c *metricClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -105,6 +231,20 @@ func (c *metricClient) DescribeCluster(
 	return c.client.DescribeCluster(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeDrain(
+	ctx context.Context,
+	request *adminservice.DescribeDrainRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeDrainResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientDescribeDrainScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeDrain(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeHistoryHost(
 	ctx context.Context,
 	request *adminservice.DescribeHistoryHostRequest,
@@ -133,6 +273,160 @@ func (c *metricClient) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeNamespaceDeletion(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceDeletionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeNamespaceDeletionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientDescribeNamespaceDeletionScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeNamespaceDeletion(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeNamespaceReplicationStatus(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceReplicationStatusRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeNamespaceReplicationStatusResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientDescribeNamespaceReplicationStatusScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeNamespaceReplicationStatus(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeNamespaceStats(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceStatsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeNamespaceStatsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientDescribeNamespaceStatsScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeNamespaceStats(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribePersistenceCircuitBreakers(
+	ctx context.Context,
+	request *adminservice.DescribePersistenceCircuitBreakersRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribePersistenceCircuitBreakersResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientDescribePersistenceCircuitBreakersScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribePersistenceCircuitBreakers(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeScheduleBackfills(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleBackfillsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeScheduleBackfillsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientDescribeScheduleBackfillsScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeScheduleBackfills(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeSchedulePauseAfterFailures(
+	ctx context.Context,
+	request *adminservice.DescribeSchedulePauseAfterFailuresRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeSchedulePauseAfterFailuresResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientDescribeSchedulePauseAfterFailuresScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeSchedulePauseAfterFailures(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeShard(
+	ctx context.Context,
+	request *adminservice.DescribeShardRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeShardResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientDescribeShardScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeShard(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeShardDistribution(
+	ctx context.Context,
+	request *adminservice.DescribeShardDistributionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeShardDistributionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientDescribeShardDistributionScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeShardDistribution(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeTaskQueueTopology(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueTopologyRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeTaskQueueTopologyResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientDescribeTaskQueueTopologyScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeTaskQueueTopology(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeVisibilityIngestion(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityIngestionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeVisibilityIngestionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientDescribeVisibilityIngestionScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeVisibilityIngestion(ctx, request, opts...)
+}
+
+func (c *metricClient) GetComponentHealth(
+	ctx context.Context,
+	request *adminservice.GetComponentHealthRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetComponentHealthResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientGetComponentHealthScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetComponentHealth(ctx, request, opts...)
+}
+
 func (c *metricClient) GetDLQMessages(
 	ctx context.Context,
 	request *adminservice.GetDLQMessagesRequest,
@@ -161,6 +455,62 @@ func (c *metricClient) GetDLQReplicationMessages(
 	return c.client.GetDLQReplicationMessages(ctx, request, opts...)
 }
 
+func (c *metricClient) GetDynamicConfigOverrides(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigOverridesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetDynamicConfigOverridesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientGetDynamicConfigOverridesScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetDynamicConfigOverrides(ctx, request, opts...)
+}
+
+func (c *metricClient) GetEffectiveDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetEffectiveDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetEffectiveDynamicConfigResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientGetEffectiveDynamicConfigScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetEffectiveDynamicConfig(ctx, request, opts...)
+}
+
+func (c *metricClient) GetGroupRoles(
+	ctx context.Context,
+	request *adminservice.GetGroupRolesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetGroupRolesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientGetGroupRolesScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetGroupRoles(ctx, request, opts...)
+}
+
+func (c *metricClient) GetNamespaceFeatureFlags(
+	ctx context.Context,
+	request *adminservice.GetNamespaceFeatureFlagsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetNamespaceFeatureFlagsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientGetNamespaceFeatureFlagsScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetNamespaceFeatureFlags(ctx, request, opts...)
+}
+
 func (c *metricClient) GetNamespaceReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetNamespaceReplicationMessagesRequest,
@@ -175,6 +525,20 @@ func (c *metricClient) GetNamespaceReplicationMessages(
 	return c.client.GetNamespaceReplicationMessages(ctx, request, opts...)
 }
 
+func (c *metricClient) GetNamespaceVisibilityRetention(
+	ctx context.Context,
+	request *adminservice.GetNamespaceVisibilityRetentionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetNamespaceVisibilityRetentionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientGetNamespaceVisibilityRetentionScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetNamespaceVisibilityRetention(ctx, request, opts...)
+}
+
 func (c *metricClient) GetReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetReplicationMessagesRequest,
@@ -189,6 +553,20 @@ func (c *metricClient) GetReplicationMessages(
 	return c.client.GetReplicationMessages(ctx, request, opts...)
 }
 
+func (c *metricClient) GetSDKUsage(
+	ctx context.Context,
+	request *adminservice.GetSDKUsageRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetSDKUsageResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientGetSDKUsageScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetSDKUsage(ctx, request, opts...)
+}
+
 func (c *metricClient) GetSearchAttributes(
 	ctx context.Context,
 	request *adminservice.GetSearchAttributesRequest,
@@ -245,6 +623,48 @@ func (c *metricClient) GetWorkflowExecutionRawHistoryV2(
 	return c.client.GetWorkflowExecutionRawHistoryV2(ctx, request, opts...)
 }
 
+func (c *metricClient) ImportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ImportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ImportWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientImportWorkflowExecutionScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ImportWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *metricClient) ListAPIKeys(
+	ctx context.Context,
+	request *adminservice.ListAPIKeysRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListAPIKeysResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientListAPIKeysScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListAPIKeys(ctx, request, opts...)
+}
+
+func (c *metricClient) ListArchivalDLQTasks(
+	ctx context.Context,
+	request *adminservice.ListArchivalDLQTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListArchivalDLQTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientListArchivalDLQTasksScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListArchivalDLQTasks(ctx, request, opts...)
+}
+
 func (c *metricClient) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -273,6 +693,20 @@ func (c *metricClient) ListClusters(
 	return c.client.ListClusters(ctx, request, opts...)
 }
 
+func (c *metricClient) ListDynamicConfigChanges(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigChangesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListDynamicConfigChangesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientListDynamicConfigChangesScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListDynamicConfigChanges(ctx, request, opts...)
+}
+
 func (c *metricClient) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -287,6 +721,48 @@ func (c *metricClient) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) ListMetrics(
+	ctx context.Context,
+	request *adminservice.ListMetricsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListMetricsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientListMetricsScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListMetrics(ctx, request, opts...)
+}
+
+func (c *metricClient) ListRoles(
+	ctx context.Context,
+	request *adminservice.ListRolesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListRolesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientListRolesScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListRoles(ctx, request, opts...)
+}
+
+func (c *metricClient) ListTopPersistenceNamespaces(
+	ctx context.Context,
+	request *adminservice.ListTopPersistenceNamespacesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListTopPersistenceNamespacesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientListTopPersistenceNamespacesScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListTopPersistenceNamespaces(ctx, request, opts...)
+}
+
 func (c *metricClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -315,6 +791,20 @@ func (c *metricClient) PurgeDLQMessages(
 	return c.client.PurgeDLQMessages(ctx, request, opts...)
 }
 
+func (c *metricClient) PutRole(
+	ctx context.Context,
+	request *adminservice.PutRoleRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.PutRoleResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientPutRoleScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PutRole(ctx, request, opts...)
+}
+
 func (c *metricClient) ReapplyEvents(
 	ctx context.Context,
 	request *adminservice.ReapplyEventsRequest,
@@ -357,6 +847,20 @@ func (c *metricClient) RefreshWorkflowTasks(
 	return c.client.RefreshWorkflowTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) RehydrateWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RehydrateWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RehydrateWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientRehydrateWorkflowExecutionScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RehydrateWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) RemoveRemoteCluster(
 	ctx context.Context,
 	request *adminservice.RemoveRemoteClusterRequest,
@@ -412,3 +916,213 @@ func (c *metricClient) ResendReplicationTasks(
 
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
+
+func (c *metricClient) RestoreClusterSnapshot(
+	ctx context.Context,
+	request *adminservice.RestoreClusterSnapshotRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RestoreClusterSnapshotResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientRestoreClusterSnapshotScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RestoreClusterSnapshot(ctx, request, opts...)
+}
+
+func (c *metricClient) RetryArchivalDLQTask(
+	ctx context.Context,
+	request *adminservice.RetryArchivalDLQTaskRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RetryArchivalDLQTaskResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientRetryArchivalDLQTaskScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RetryArchivalDLQTask(ctx, request, opts...)
+}
+
+func (c *metricClient) RevokeAPIKey(
+	ctx context.Context,
+	request *adminservice.RevokeAPIKeyRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RevokeAPIKeyResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientRevokeAPIKeyScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RevokeAPIKey(ctx, request, opts...)
+}
+
+func (c *metricClient) RotateAPIKey(
+	ctx context.Context,
+	request *adminservice.RotateAPIKeyRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RotateAPIKeyResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientRotateAPIKeyScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RotateAPIKey(ctx, request, opts...)
+}
+
+func (c *metricClient) SetDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.SetDynamicConfigOverrideResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientSetDynamicConfigOverrideScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.SetDynamicConfigOverride(ctx, request, opts...)
+}
+
+func (c *metricClient) SetDynamicConfigRollout(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRolloutRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.SetDynamicConfigRolloutResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientSetDynamicConfigRolloutScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.SetDynamicConfigRollout(ctx, request, opts...)
+}
+
+func (c *metricClient) SetGroupRoles(
+	ctx context.Context,
+	request *adminservice.SetGroupRolesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.SetGroupRolesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientSetGroupRolesScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.SetGroupRoles(ctx, request, opts...)
+}
+
+func (c *metricClient) StartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.StartBatchOperationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientStartBatchOperationScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StartBatchOperation(ctx, request, opts...)
+}
+
+func (c *metricClient) StartDrain(
+	ctx context.Context,
+	request *adminservice.StartDrainRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.StartDrainResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientStartDrainScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StartDrain(ctx, request, opts...)
+}
+
+func (c *metricClient) StartNamespaceExport(
+	ctx context.Context,
+	request *adminservice.StartNamespaceExportRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.StartNamespaceExportResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientStartNamespaceExportScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StartNamespaceExport(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateNamespaceDeletionRate(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceDeletionRateRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateNamespaceDeletionRateResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientUpdateNamespaceDeletionRateScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateNamespaceDeletionRate(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateNamespaceVisibilityRetention(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceVisibilityRetentionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateNamespaceVisibilityRetentionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientUpdateNamespaceVisibilityRetentionScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateNamespaceVisibilityRetention(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateSchedulePauseAfterFailures(
+	ctx context.Context,
+	request *adminservice.UpdateSchedulePauseAfterFailuresRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateSchedulePauseAfterFailuresResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientUpdateSchedulePauseAfterFailuresScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateSchedulePauseAfterFailures(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateWorkflowExecutionMemo(
+	ctx context.Context,
+	request *adminservice.UpdateWorkflowExecutionMemoRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateWorkflowExecutionMemoResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientUpdateWorkflowExecutionMemoScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateWorkflowExecutionMemo(ctx, request, opts...)
+}
+
+func (c *metricClient) UpsertWorkflowExecutionSearchAttributes(
+	ctx context.Context,
+	request *adminservice.UpsertWorkflowExecutionSearchAttributesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpsertWorkflowExecutionSearchAttributesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientUpsertWorkflowExecutionSearchAttributesScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpsertWorkflowExecutionSearchAttributes(ctx, request, opts...)
+}
@@ -63,3 +63,33 @@ func (c *retryableClient) StreamWorkflowReplicationMessages(
 	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) StreamDatabaseBackup(
+	ctx context.Context,
+	request *adminservice.StreamDatabaseBackupRequest,
+	opts ...grpc.CallOption,
+) (adminservice.AdminService_StreamDatabaseBackupClient, error) {
+	var resp adminservice.AdminService_StreamDatabaseBackupClient
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StreamDatabaseBackup(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StreamDiagnosticsBundle(
+	ctx context.Context,
+	request *adminservice.StreamDiagnosticsBundleRequest,
+	opts ...grpc.CallOption,
+) (adminservice.AdminService_StreamDiagnosticsBundleClient, error) {
+	var resp adminservice.AdminService_StreamDiagnosticsBundleClient
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StreamDiagnosticsBundle(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
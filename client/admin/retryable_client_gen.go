@@ -50,6 +50,21 @@ func (c *retryableClient) AddOrUpdateRemoteCluster(
 	return resp, err
 }
 
+func (c *retryableClient) AddSearchAttributeAliases(
+	ctx context.Context,
+	request *adminservice.AddSearchAttributeAliasesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.AddSearchAttributeAliasesResponse, error) {
+	var resp *adminservice.AddSearchAttributeAliasesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.AddSearchAttributeAliases(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) AddSearchAttributes(
 	ctx context.Context,
 	request *adminservice.AddSearchAttributesRequest,
@@ -65,6 +80,51 @@ func (c *retryableClient) AddSearchAttributes(
 	return resp, err
 }
 
+func (c *retryableClient) CancelDrain(
+	ctx context.Context,
+	request *adminservice.CancelDrainRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CancelDrainResponse, error) {
+	var resp *adminservice.CancelDrainResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CancelDrain(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) CancelScheduleBackfill(
+	ctx context.Context,
+	request *adminservice.CancelScheduleBackfillRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CancelScheduleBackfillResponse, error) {
+	var resp *adminservice.CancelScheduleBackfillResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CancelScheduleBackfill(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) CheckVisibilityConsistency(
+	ctx context.Context,
+	request *adminservice.CheckVisibilityConsistencyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CheckVisibilityConsistencyResponse, error) {
+	var resp *adminservice.CheckVisibilityConsistencyResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CheckVisibilityConsistency(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -80,6 +140,81 @@ func (c *retryableClient) CloseShard(
 	return resp, err
 }
 
+func (c *retryableClient) CreateAPIKey(
+	ctx context.Context,
+	request *adminservice.CreateAPIKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CreateAPIKeyResponse, error) {
+	var resp *adminservice.CreateAPIKeyResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CreateAPIKey(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) CreateClusterSnapshot(
+	ctx context.Context,
+	request *adminservice.CreateClusterSnapshotRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CreateClusterSnapshotResponse, error) {
+	var resp *adminservice.CreateClusterSnapshotResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CreateClusterSnapshot(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.DeleteDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteDynamicConfigOverrideResponse, error) {
+	var resp *adminservice.DeleteDynamicConfigOverrideResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteDynamicConfigOverride(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteHistoryBranchGarbage(
+	ctx context.Context,
+	request *adminservice.DeleteHistoryBranchGarbageRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteHistoryBranchGarbageResponse, error) {
+	var resp *adminservice.DeleteHistoryBranchGarbageResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteHistoryBranchGarbage(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteRole(
+	ctx context.Context,
+	request *adminservice.DeleteRoleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteRoleResponse, error) {
+	var resp *adminservice.DeleteRoleResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteRole(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -110,6 +245,21 @@ func (c *retryableClient) DescribeCluster(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeDrain(
+	ctx context.Context,
+	request *adminservice.DescribeDrainRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeDrainResponse, error) {
+	var resp *adminservice.DescribeDrainResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeDrain(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeHistoryHost(
 	ctx context.Context,
 	request *adminservice.DescribeHistoryHostRequest,
@@ -140,6 +290,171 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeNamespaceDeletion(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceDeletionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNamespaceDeletionResponse, error) {
+	var resp *adminservice.DescribeNamespaceDeletionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeNamespaceDeletion(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeNamespaceReplicationStatus(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceReplicationStatusRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNamespaceReplicationStatusResponse, error) {
+	var resp *adminservice.DescribeNamespaceReplicationStatusResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeNamespaceReplicationStatus(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeNamespaceStats(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceStatsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNamespaceStatsResponse, error) {
+	var resp *adminservice.DescribeNamespaceStatsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeNamespaceStats(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribePersistenceCircuitBreakers(
+	ctx context.Context,
+	request *adminservice.DescribePersistenceCircuitBreakersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribePersistenceCircuitBreakersResponse, error) {
+	var resp *adminservice.DescribePersistenceCircuitBreakersResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribePersistenceCircuitBreakers(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeScheduleBackfills(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleBackfillsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeScheduleBackfillsResponse, error) {
+	var resp *adminservice.DescribeScheduleBackfillsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeScheduleBackfills(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeSchedulePauseAfterFailures(
+	ctx context.Context,
+	request *adminservice.DescribeSchedulePauseAfterFailuresRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeSchedulePauseAfterFailuresResponse, error) {
+	var resp *adminservice.DescribeSchedulePauseAfterFailuresResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeSchedulePauseAfterFailures(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeShard(
+	ctx context.Context,
+	request *adminservice.DescribeShardRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeShardResponse, error) {
+	var resp *adminservice.DescribeShardResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeShard(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeShardDistribution(
+	ctx context.Context,
+	request *adminservice.DescribeShardDistributionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeShardDistributionResponse, error) {
+	var resp *adminservice.DescribeShardDistributionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeShardDistribution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeTaskQueueTopology(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueTopologyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskQueueTopologyResponse, error) {
+	var resp *adminservice.DescribeTaskQueueTopologyResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeTaskQueueTopology(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeVisibilityIngestion(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityIngestionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeVisibilityIngestionResponse, error) {
+	var resp *adminservice.DescribeVisibilityIngestionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeVisibilityIngestion(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetComponentHealth(
+	ctx context.Context,
+	request *adminservice.GetComponentHealthRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetComponentHealthResponse, error) {
+	var resp *adminservice.GetComponentHealthResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetComponentHealth(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetDLQMessages(
 	ctx context.Context,
 	request *adminservice.GetDLQMessagesRequest,
@@ -170,6 +485,66 @@ func (c *retryableClient) GetDLQReplicationMessages(
 	return resp, err
 }
 
+func (c *retryableClient) GetDynamicConfigOverrides(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigOverridesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigOverridesResponse, error) {
+	var resp *adminservice.GetDynamicConfigOverridesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetDynamicConfigOverrides(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetEffectiveDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetEffectiveDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetEffectiveDynamicConfigResponse, error) {
+	var resp *adminservice.GetEffectiveDynamicConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetEffectiveDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetGroupRoles(
+	ctx context.Context,
+	request *adminservice.GetGroupRolesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetGroupRolesResponse, error) {
+	var resp *adminservice.GetGroupRolesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetGroupRoles(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetNamespaceFeatureFlags(
+	ctx context.Context,
+	request *adminservice.GetNamespaceFeatureFlagsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetNamespaceFeatureFlagsResponse, error) {
+	var resp *adminservice.GetNamespaceFeatureFlagsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetNamespaceFeatureFlags(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetNamespaceReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetNamespaceReplicationMessagesRequest,
@@ -185,6 +560,21 @@ func (c *retryableClient) GetNamespaceReplicationMessages(
 	return resp, err
 }
 
+func (c *retryableClient) GetNamespaceVisibilityRetention(
+	ctx context.Context,
+	request *adminservice.GetNamespaceVisibilityRetentionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetNamespaceVisibilityRetentionResponse, error) {
+	var resp *adminservice.GetNamespaceVisibilityRetentionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetNamespaceVisibilityRetention(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetReplicationMessagesRequest,
@@ -200,6 +590,21 @@ func (c *retryableClient) GetReplicationMessages(
 	return resp, err
 }
 
+func (c *retryableClient) GetSDKUsage(
+	ctx context.Context,
+	request *adminservice.GetSDKUsageRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetSDKUsageResponse, error) {
+	var resp *adminservice.GetSDKUsageResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetSDKUsage(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetSearchAttributes(
 	ctx context.Context,
 	request *adminservice.GetSearchAttributesRequest,
@@ -260,6 +665,51 @@ func (c *retryableClient) GetWorkflowExecutionRawHistoryV2(
 	return resp, err
 }
 
+func (c *retryableClient) ImportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ImportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ImportWorkflowExecutionResponse, error) {
+	var resp *adminservice.ImportWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ImportWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListAPIKeys(
+	ctx context.Context,
+	request *adminservice.ListAPIKeysRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListAPIKeysResponse, error) {
+	var resp *adminservice.ListAPIKeysResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListAPIKeys(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListArchivalDLQTasks(
+	ctx context.Context,
+	request *adminservice.ListArchivalDLQTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListArchivalDLQTasksResponse, error) {
+	var resp *adminservice.ListArchivalDLQTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListArchivalDLQTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -290,6 +740,21 @@ func (c *retryableClient) ListClusters(
 	return resp, err
 }
 
+func (c *retryableClient) ListDynamicConfigChanges(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigChangesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigChangesResponse, error) {
+	var resp *adminservice.ListDynamicConfigChangesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListDynamicConfigChanges(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -305,6 +770,51 @@ func (c *retryableClient) ListHistoryTasks(
 	return resp, err
 }
 
+func (c *retryableClient) ListMetrics(
+	ctx context.Context,
+	request *adminservice.ListMetricsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListMetricsResponse, error) {
+	var resp *adminservice.ListMetricsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListMetrics(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListRoles(
+	ctx context.Context,
+	request *adminservice.ListRolesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListRolesResponse, error) {
+	var resp *adminservice.ListRolesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListRoles(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListTopPersistenceNamespaces(
+	ctx context.Context,
+	request *adminservice.ListTopPersistenceNamespacesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListTopPersistenceNamespacesResponse, error) {
+	var resp *adminservice.ListTopPersistenceNamespacesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListTopPersistenceNamespaces(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -335,6 +845,21 @@ func (c *retryableClient) PurgeDLQMessages(
 	return resp, err
 }
 
+func (c *retryableClient) PutRole(
+	ctx context.Context,
+	request *adminservice.PutRoleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PutRoleResponse, error) {
+	var resp *adminservice.PutRoleResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PutRole(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ReapplyEvents(
 	ctx context.Context,
 	request *adminservice.ReapplyEventsRequest,
@@ -380,6 +905,21 @@ func (c *retryableClient) RefreshWorkflowTasks(
 	return resp, err
 }
 
+func (c *retryableClient) RehydrateWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RehydrateWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RehydrateWorkflowExecutionResponse, error) {
+	var resp *adminservice.RehydrateWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RehydrateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RemoveRemoteCluster(
 	ctx context.Context,
 	request *adminservice.RemoveRemoteClusterRequest,
@@ -439,3 +979,228 @@ func (c *retryableClient) ResendReplicationTasks(
 	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) RestoreClusterSnapshot(
+	ctx context.Context,
+	request *adminservice.RestoreClusterSnapshotRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RestoreClusterSnapshotResponse, error) {
+	var resp *adminservice.RestoreClusterSnapshotResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RestoreClusterSnapshot(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RetryArchivalDLQTask(
+	ctx context.Context,
+	request *adminservice.RetryArchivalDLQTaskRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RetryArchivalDLQTaskResponse, error) {
+	var resp *adminservice.RetryArchivalDLQTaskResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RetryArchivalDLQTask(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RevokeAPIKey(
+	ctx context.Context,
+	request *adminservice.RevokeAPIKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RevokeAPIKeyResponse, error) {
+	var resp *adminservice.RevokeAPIKeyResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RevokeAPIKey(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RotateAPIKey(
+	ctx context.Context,
+	request *adminservice.RotateAPIKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RotateAPIKeyResponse, error) {
+	var resp *adminservice.RotateAPIKeyResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RotateAPIKey(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) SetDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetDynamicConfigOverrideResponse, error) {
+	var resp *adminservice.SetDynamicConfigOverrideResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SetDynamicConfigOverride(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) SetDynamicConfigRollout(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRolloutRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetDynamicConfigRolloutResponse, error) {
+	var resp *adminservice.SetDynamicConfigRolloutResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SetDynamicConfigRollout(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) SetGroupRoles(
+	ctx context.Context,
+	request *adminservice.SetGroupRolesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetGroupRolesResponse, error) {
+	var resp *adminservice.SetGroupRolesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SetGroupRoles(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartBatchOperationResponse, error) {
+	var resp *adminservice.StartBatchOperationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StartBatchOperation(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartDrain(
+	ctx context.Context,
+	request *adminservice.StartDrainRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartDrainResponse, error) {
+	var resp *adminservice.StartDrainResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StartDrain(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartNamespaceExport(
+	ctx context.Context,
+	request *adminservice.StartNamespaceExportRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartNamespaceExportResponse, error) {
+	var resp *adminservice.StartNamespaceExportResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StartNamespaceExport(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateNamespaceDeletionRate(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceDeletionRateRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNamespaceDeletionRateResponse, error) {
+	var resp *adminservice.UpdateNamespaceDeletionRateResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateNamespaceDeletionRate(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateNamespaceVisibilityRetention(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceVisibilityRetentionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNamespaceVisibilityRetentionResponse, error) {
+	var resp *adminservice.UpdateNamespaceVisibilityRetentionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateNamespaceVisibilityRetention(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateSchedulePauseAfterFailures(
+	ctx context.Context,
+	request *adminservice.UpdateSchedulePauseAfterFailuresRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateSchedulePauseAfterFailuresResponse, error) {
+	var resp *adminservice.UpdateSchedulePauseAfterFailuresResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateSchedulePauseAfterFailures(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateWorkflowExecutionMemo(
+	ctx context.Context,
+	request *adminservice.UpdateWorkflowExecutionMemoRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkflowExecutionMemoResponse, error) {
+	var resp *adminservice.UpdateWorkflowExecutionMemoResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateWorkflowExecutionMemo(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpsertWorkflowExecutionSearchAttributes(
+	ctx context.Context,
+	request *adminservice.UpsertWorkflowExecutionSearchAttributesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpsertWorkflowExecutionSearchAttributesResponse, error) {
+	var resp *adminservice.UpsertWorkflowExecutionSearchAttributesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpsertWorkflowExecutionSearchAttributes(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -116,6 +116,22 @@ func (c *clientImpl) DescribeHistoryHost(
 	return response, nil
 }
 
+func (c *clientImpl) DescribeVisibilityIngestion(
+	ctx context.Context,
+	request *historyservice.DescribeVisibilityIngestionRequest,
+	opts ...grpc.CallOption) (*historyservice.DescribeVisibilityIngestionResponse, error) {
+
+	ret, err := c.clients.GetClientForClientKey(request.GetHostAddress())
+	if err != nil {
+		return nil, err
+	}
+	client := ret.(historyservice.HistoryServiceClient)
+
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeVisibilityIngestion(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetReplicationMessages(
 	ctx context.Context,
 	request *historyservice.GetReplicationMessagesRequest,
@@ -34,6 +34,30 @@ import (
 	"google.golang.org/grpc"
 )
 
+func (c *clientImpl) AwaitVisibilityIngestion(
+	ctx context.Context,
+	request *historyservice.AwaitVisibilityIngestionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.AwaitVisibilityIngestionResponse, error) {
+	client, err := c.getClientForShardID(request.GetShardId())
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.AwaitVisibilityIngestionResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.AwaitVisibilityIngestion(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) CloseShard(
 	ctx context.Context,
 	request *historyservice.CloseShardRequest,
@@ -130,6 +154,30 @@ func (c *clientImpl) DescribeMutableState(
 	return response, nil
 }
 
+func (c *clientImpl) DescribeShard(
+	ctx context.Context,
+	request *historyservice.DescribeShardRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeShardResponse, error) {
+	client, err := c.getClientForShardID(request.GetShardId())
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.DescribeShardResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DescribeShard(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.DescribeWorkflowExecutionRequest,
@@ -571,6 +619,30 @@ func (c *clientImpl) RefreshWorkflowTasks(
 	return response, nil
 }
 
+func (c *clientImpl) RehydrateWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.RehydrateWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.RehydrateWorkflowExecutionResponse, error) {
+	client, err := c.getClientForWorkflowID(request.NamespaceId, request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.RehydrateWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.RehydrateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) RemoveSignalMutableState(
 	ctx context.Context,
 	request *historyservice.RemoveSignalMutableStateRequest,
@@ -1076,6 +1148,54 @@ func (c *clientImpl) UpdateWorkflowExecution(
 	return response, nil
 }
 
+func (c *clientImpl) UpdateWorkflowExecutionMemo(
+	ctx context.Context,
+	request *historyservice.UpdateWorkflowExecutionMemoRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpdateWorkflowExecutionMemoResponse, error) {
+	client, err := c.getClientForWorkflowID(request.NamespaceId, request.GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.UpdateWorkflowExecutionMemoResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.UpdateWorkflowExecutionMemo(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) UpsertWorkflowExecutionSearchAttributes(
+	ctx context.Context,
+	request *historyservice.UpsertWorkflowExecutionSearchAttributesRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpsertWorkflowExecutionSearchAttributesResponse, error) {
+	client, err := c.getClientForWorkflowID(request.NamespaceId, request.GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.UpsertWorkflowExecutionSearchAttributesResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.UpsertWorkflowExecutionSearchAttributes(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) VerifyChildExecutionCompletionRecorded(
 	ctx context.Context,
 	request *historyservice.VerifyChildExecutionCompletionRecordedRequest,
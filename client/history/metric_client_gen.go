@@ -35,6 +35,20 @@ import (
 	"go.temporal.io/server/common/metrics"
 )
 
+func (c *metricClient) AwaitVisibilityIngestion(
+	ctx context.Context,
+	request *historyservice.AwaitVisibilityIngestionRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.AwaitVisibilityIngestionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.HistoryClientAwaitVisibilityIngestionScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.AwaitVisibilityIngestion(ctx, request, opts...)
+}
+
 func (c *metricClient) CloseShard(
 	ctx context.Context,
 	request *historyservice.CloseShardRequest,
@@ -105,6 +119,34 @@ func (c *metricClient) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeShard(
+	ctx context.Context,
+	request *historyservice.DescribeShardRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.DescribeShardResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.HistoryClientDescribeShardScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeShard(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeVisibilityIngestion(
+	ctx context.Context,
+	request *historyservice.DescribeVisibilityIngestionRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.DescribeVisibilityIngestionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.HistoryClientDescribeVisibilityIngestionScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeVisibilityIngestion(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.DescribeWorkflowExecutionRequest,
@@ -385,6 +427,20 @@ func (c *metricClient) RefreshWorkflowTasks(
 	return c.client.RefreshWorkflowTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) RehydrateWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.RehydrateWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.RehydrateWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.HistoryClientRehydrateWorkflowExecutionScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RehydrateWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) RemoveSignalMutableState(
 	ctx context.Context,
 	request *historyservice.RemoveSignalMutableStateRequest,
@@ -665,6 +721,34 @@ func (c *metricClient) UpdateWorkflowExecution(
 	return c.client.UpdateWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *metricClient) UpdateWorkflowExecutionMemo(
+	ctx context.Context,
+	request *historyservice.UpdateWorkflowExecutionMemoRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.UpdateWorkflowExecutionMemoResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.HistoryClientUpdateWorkflowExecutionMemoScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateWorkflowExecutionMemo(ctx, request, opts...)
+}
+
+func (c *metricClient) UpsertWorkflowExecutionSearchAttributes(
+	ctx context.Context,
+	request *historyservice.UpsertWorkflowExecutionSearchAttributesRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.UpsertWorkflowExecutionSearchAttributesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.HistoryClientUpsertWorkflowExecutionSearchAttributesScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpsertWorkflowExecutionSearchAttributes(ctx, request, opts...)
+}
+
 func (c *metricClient) VerifyChildExecutionCompletionRecorded(
 	ctx context.Context,
 	request *historyservice.VerifyChildExecutionCompletionRecordedRequest,
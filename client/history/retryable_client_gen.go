@@ -35,6 +35,21 @@ import (
 	"go.temporal.io/server/common/backoff"
 )
 
+func (c *retryableClient) AwaitVisibilityIngestion(
+	ctx context.Context,
+	request *historyservice.AwaitVisibilityIngestionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.AwaitVisibilityIngestionResponse, error) {
+	var resp *historyservice.AwaitVisibilityIngestionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.AwaitVisibilityIngestion(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) CloseShard(
 	ctx context.Context,
 	request *historyservice.CloseShardRequest,
@@ -110,6 +125,36 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeShard(
+	ctx context.Context,
+	request *historyservice.DescribeShardRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeShardResponse, error) {
+	var resp *historyservice.DescribeShardResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeShard(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeVisibilityIngestion(
+	ctx context.Context,
+	request *historyservice.DescribeVisibilityIngestionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeVisibilityIngestionResponse, error) {
+	var resp *historyservice.DescribeVisibilityIngestionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeVisibilityIngestion(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.DescribeWorkflowExecutionRequest,
@@ -410,6 +455,21 @@ func (c *retryableClient) RefreshWorkflowTasks(
 	return resp, err
 }
 
+func (c *retryableClient) RehydrateWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.RehydrateWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.RehydrateWorkflowExecutionResponse, error) {
+	var resp *historyservice.RehydrateWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RehydrateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RemoveSignalMutableState(
 	ctx context.Context,
 	request *historyservice.RemoveSignalMutableStateRequest,
@@ -710,6 +770,36 @@ func (c *retryableClient) UpdateWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) UpdateWorkflowExecutionMemo(
+	ctx context.Context,
+	request *historyservice.UpdateWorkflowExecutionMemoRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpdateWorkflowExecutionMemoResponse, error) {
+	var resp *historyservice.UpdateWorkflowExecutionMemoResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateWorkflowExecutionMemo(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpsertWorkflowExecutionSearchAttributes(
+	ctx context.Context,
+	request *historyservice.UpsertWorkflowExecutionSearchAttributesRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpsertWorkflowExecutionSearchAttributesResponse, error) {
+	var resp *historyservice.UpsertWorkflowExecutionSearchAttributesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpsertWorkflowExecutionSearchAttributes(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) VerifyChildExecutionCompletionRecorded(
 	ctx context.Context,
 	request *historyservice.VerifyChildExecutionCompletionRecordedRequest,
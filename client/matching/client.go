@@ -190,6 +190,12 @@ func (c *clientImpl) QueryWorkflow(ctx context.Context, request *matchingservice
 	return client.QueryWorkflow(ctx, request, opts...)
 }
 
+// RoutingKey returns the key of the matching host which owns the task queue partition with the given name
+// in the matching membership ring.
+func RoutingKey(namespaceID string, partition string, taskQueueType enumspb.TaskQueueType) string {
+	return fmt.Sprintf("%s:%s:%d", namespaceID, partition, int(taskQueueType))
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	return context.WithTimeout(parent, c.timeout)
 }
@@ -203,8 +209,7 @@ func (c *clientImpl) getClientForTaskqueue(
 	taskQueue *taskqueuepb.TaskQueue,
 	taskQueueType enumspb.TaskQueueType,
 ) (matchingservice.MatchingServiceClient, error) {
-	key := fmt.Sprintf("%s:%s:%d", namespaceID, taskQueue.Name, int(taskQueueType))
-	client, err := c.clients.GetClientForKey(key)
+	client, err := c.clients.GetClientForKey(RoutingKey(namespaceID, taskQueue.Name, taskQueueType))
 	if err != nil {
 		return nil, err
 	}
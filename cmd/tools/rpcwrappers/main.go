@@ -88,14 +88,21 @@ var (
 		"client.admin.StreamWorkflowReplicationMessages":            true,
 		"metricsClient.admin.StreamWorkflowReplicationMessages":     true,
 		"retryableClient.admin.StreamWorkflowReplicationMessages":   true,
+		"client.admin.StreamDatabaseBackup":                         true,
+		"metricsClient.admin.StreamDatabaseBackup":                  true,
+		"retryableClient.admin.StreamDatabaseBackup":                true,
+		"client.admin.StreamDiagnosticsBundle":                      true,
+		"metricsClient.admin.StreamDiagnosticsBundle":               true,
+		"retryableClient.admin.StreamDiagnosticsBundle":             true,
 		"client.history.StreamWorkflowReplicationMessages":          true,
 		"metricsClient.history.StreamWorkflowReplicationMessages":   true,
 		"retryableClient.history.StreamWorkflowReplicationMessages": true,
 
 		// these are non-standard implementations. do not generate.
-		"client.history.DescribeHistoryHost":    true,
-		"client.history.GetReplicationMessages": true,
-		"client.history.GetReplicationStatus":   true,
+		"client.history.DescribeHistoryHost":         true,
+		"client.history.DescribeVisibilityIngestion": true,
+		"client.history.GetReplicationMessages":      true,
+		"client.history.GetReplicationStatus":        true,
 		// these need to pick a partition. too complicated.
 		"client.matching.AddActivityTask":       true,
 		"client.matching.AddWorkflowTask":       true,
@@ -187,7 +194,7 @@ func makeGetMatchingClient(reqType reflect.Type) string {
 	switch t.Name() {
 	case "GetBuildIdTaskQueueMappingRequest":
 		// Pick a random node for this request, it's not associated with a specific task queue.
-		tqPath = "&taskqueuepb.TaskQueue{Name: fmt.Sprintf(\"not-applicable-%s\", rand.Int())}"
+		tqPath = "&taskqueuepb.TaskQueue{Name: fmt.Sprintf(\"not-applicable-%d\", rand.Int())}"
 		tqtPath = "enumspb.TASK_QUEUE_TYPE_UNSPECIFIED"
 		return fmt.Sprintf("client, err := c.getClientForTaskqueue(%s, %s, %s)", nsIDPath, tqPath, tqtPath)
 	case "UpdateTaskQueueUserDataRequest",
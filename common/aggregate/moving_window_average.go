@@ -25,6 +25,8 @@
 package aggregate
 
 import (
+	"math"
+	"sort"
 	"sync"
 	"time"
 )
@@ -33,6 +35,8 @@ type (
 	MovingWindowAverage interface {
 		Record(val int64)
 		Average() float64
+		// Percentile returns the value below which the given ratio (between 0 and 1) of the recorded values fall
+		Percentile(percentile float64) float64
 	}
 
 	timestampedData struct {
@@ -92,6 +96,29 @@ func (a *MovingWindowAvgImpl) Average() float64 {
 	return float64(a.sum) / float64(a.count)
 }
 
+func (a *MovingWindowAvgImpl) Percentile(percentile float64) float64 {
+	a.Lock()
+	a.expireOldValuesLocked()
+	values := make([]int64, 0, a.count)
+	for idx := a.headIdx; idx != a.tailIdx; idx = (idx + 1) % a.maxBufferSize {
+		values = append(values, a.buffer[idx].value)
+	}
+	a.Unlock()
+
+	if len(values) == 0 {
+		return 0
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	rank := int(math.Ceil(percentile*float64(len(values)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= len(values) {
+		rank = len(values) - 1
+	}
+	return float64(values[rank])
+}
+
 func (a *MovingWindowAvgImpl) expireOldValuesLocked() {
 	for ; a.headIdx != a.tailIdx; a.headIdx = (a.headIdx + 1) % a.maxBufferSize {
 		if time.Since(a.buffer[a.headIdx].timestamp) < a.windowSize {
@@ -35,3 +35,5 @@ func newNoopMovingWindowAverage() *noopMovingWindowAverage { return &noopMovingW
 func (a *noopMovingWindowAverage) Record(_ int64) {}
 
 func (a *noopMovingWindowAverage) Average() float64 { return 0 }
+
+func (a *noopMovingWindowAverage) Percentile(_ float64) float64 { return 0 }
@@ -0,0 +1,434 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package azblobstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	// blobServiceVersion is the version of the Blob service REST API used by the client,
+	// it must be 2017-11-09 or later for bearer token authorization.
+	blobServiceVersion = "2021-08-06"
+	storageResource    = "https://storage.azure.com/"
+
+	imdsEndpoint             = "http://169.254.169.254/metadata/identity/oauth2/token"
+	imdsAPIVersion           = "2018-02-01"
+	appServiceAPIVersion     = "2019-08-01"
+	managedIdentityTokenSkew = 5 * time.Minute
+
+	sasTokenEnvVar         = "AZURE_STORAGE_SAS_TOKEN"
+	identityEndpointEnvVar = "IDENTITY_ENDPOINT"
+	identityHeaderEnvVar   = "IDENTITY_HEADER"
+
+	errCodeContainerNotFound = "ContainerNotFound"
+)
+
+var (
+	errNoAccountSpecified = errors.New("no storage account or endpoint specified")
+)
+
+type (
+	// storageClient is the subset of the Blob service used by the archivers.
+	storageClient interface {
+		ContainerExists(ctx context.Context, container string) (bool, error)
+		BlobExists(ctx context.Context, container string, name string) (bool, error)
+		Upload(ctx context.Context, container string, name string, data []byte) error
+		Download(ctx context.Context, container string, name string) ([]byte, error)
+		List(ctx context.Context, container string, options listOptions) (*listResult, error)
+	}
+
+	listOptions struct {
+		prefix     string
+		delimiter  string
+		marker     string
+		maxResults int
+	}
+
+	listResult struct {
+		blobs      []string
+		prefixes   []string
+		nextMarker string
+	}
+
+	// blobClient talks to the Blob service REST API directly.
+	blobClient struct {
+		endpoint   *url.URL
+		httpClient *http.Client
+		credential credential
+	}
+
+	// credential authorizes requests to the Blob service.
+	credential interface {
+		authorize(ctx context.Context, request *http.Request) error
+	}
+
+	sasCredential struct {
+		query url.Values
+	}
+
+	managedIdentityCredential struct {
+		httpClient *http.Client
+		endpoint   string
+		// identityHeader is only set when running on App Service or Functions, whose identity
+		// endpoint expects it instead of the Metadata header of the instance metadata service.
+		identityHeader string
+		clientID       string
+
+		sync.Mutex
+		token     string
+		expiresOn time.Time
+	}
+
+	managedIdentityToken struct {
+		AccessToken string      `json:"access_token"`
+		ExpiresOn   json.Number `json:"expires_on"`
+	}
+
+	// responseError is returned for Blob service responses with an unsuccessful status code.
+	responseError struct {
+		statusCode int
+		code       string
+	}
+
+	enumerationResults struct {
+		XMLName    xml.Name  `xml:"EnumerationResults"`
+		Blobs      blobsList `xml:"Blobs"`
+		NextMarker string    `xml:"NextMarker"`
+	}
+
+	blobsList struct {
+		Blob       []blobItem `xml:"Blob"`
+		BlobPrefix []blobItem `xml:"BlobPrefix"`
+	}
+
+	blobItem struct {
+		Name string `xml:"Name"`
+	}
+)
+
+func newBlobClient(cfg *config.AzblobArchiver) (*blobClient, error) {
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		if cfg.AccountName == "" {
+			return nil, errNoAccountSpecified
+		}
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", cfg.AccountName)
+	}
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := &http.Client{}
+	sasToken := cfg.SASToken
+	if sasToken == "" {
+		sasToken = os.Getenv(sasTokenEnvVar)
+	}
+	var cred credential
+	if sasToken != "" {
+		cred, err = newSASCredential(sasToken)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		cred = newManagedIdentityCredential(httpClient, cfg.ManagedIdentityClientID)
+	}
+
+	return &blobClient{
+		endpoint:   endpointURL,
+		httpClient: httpClient,
+		credential: cred,
+	}, nil
+}
+
+func newSASCredential(token string) (*sasCredential, error) {
+	query, err := url.ParseQuery(strings.TrimPrefix(token, "?"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SAS token: %w", err)
+	}
+	return &sasCredential{query: query}, nil
+}
+
+func (c *sasCredential) authorize(_ context.Context, request *http.Request) error {
+	query := request.URL.Query()
+	for key, values := range c.query {
+		query[key] = values
+	}
+	request.URL.RawQuery = query.Encode()
+	return nil
+}
+
+func newManagedIdentityCredential(httpClient *http.Client, clientID string) *managedIdentityCredential {
+	credential := &managedIdentityCredential{
+		httpClient: httpClient,
+		endpoint:   imdsEndpoint,
+		clientID:   clientID,
+	}
+	if endpoint, header := os.Getenv(identityEndpointEnvVar), os.Getenv(identityHeaderEnvVar); endpoint != "" && header != "" {
+		credential.endpoint = endpoint
+		credential.identityHeader = header
+	}
+	return credential
+}
+
+func (c *managedIdentityCredential) authorize(ctx context.Context, request *http.Request) error {
+	token, err := c.getToken(ctx)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+func (c *managedIdentityCredential) getToken(ctx context.Context) (string, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.token != "" && time.Now().Add(managedIdentityTokenSkew).Before(c.expiresOn) {
+		return c.token, nil
+	}
+
+	endpoint, err := url.Parse(c.endpoint)
+	if err != nil {
+		return "", err
+	}
+	query := endpoint.Query()
+	query.Set("resource", storageResource)
+	if c.clientID != "" {
+		query.Set("client_id", c.clientID)
+	}
+	if c.identityHeader != "" {
+		query.Set("api-version", appServiceAPIVersion)
+	} else {
+		query.Set("api-version", imdsAPIVersion)
+	}
+	endpoint.RawQuery = query.Encode()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if c.identityHeader != "" {
+		request.Header.Set("X-IDENTITY-HEADER", c.identityHeader)
+	} else {
+		request.Header.Set("Metadata", "true")
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = response.Body.Close() }()
+	if response.StatusCode != http.StatusOK {
+		return "", &responseError{statusCode: response.StatusCode}
+	}
+
+	var token managedIdentityToken
+	if err := json.NewDecoder(response.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("failed to decode managed identity token: %w", err)
+	}
+	expiresOn, err := token.ExpiresOn.Int64()
+	if err != nil {
+		return "", fmt.Errorf("failed to decode managed identity token expiry: %w", err)
+	}
+
+	c.token = token.AccessToken
+	c.expiresOn = time.Unix(expiresOn, 0)
+	return c.token, nil
+}
+
+func (c *blobClient) ContainerExists(ctx context.Context, container string) (bool, error) {
+	query := url.Values{"restype": {"container"}}
+	response, err := c.do(ctx, http.MethodHead, c.url(query, container), nil, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, response.Body.Close()
+}
+
+func (c *blobClient) BlobExists(ctx context.Context, container string, name string) (bool, error) {
+	response, err := c.do(ctx, http.MethodHead, c.url(nil, container, name), nil, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, response.Body.Close()
+}
+
+func (c *blobClient) Upload(ctx context.Context, container string, name string, data []byte) error {
+	header := http.Header{"X-Ms-Blob-Type": {"BlockBlob"}}
+	response, err := c.do(ctx, http.MethodPut, c.url(nil, container, name), header, data)
+	if err != nil {
+		return err
+	}
+	return response.Body.Close()
+}
+
+func (c *blobClient) Download(ctx context.Context, container string, name string) (_ []byte, retErr error) {
+	response, err := c.do(ctx, http.MethodGet, c.url(nil, container, name), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := response.Body.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	return io.ReadAll(response.Body)
+}
+
+func (c *blobClient) List(ctx context.Context, container string, options listOptions) (_ *listResult, retErr error) {
+	query := url.Values{
+		"restype": {"container"},
+		"comp":    {"list"},
+	}
+	if options.prefix != "" {
+		query.Set("prefix", options.prefix)
+	}
+	if options.delimiter != "" {
+		query.Set("delimiter", options.delimiter)
+	}
+	if options.marker != "" {
+		query.Set("marker", options.marker)
+	}
+	if options.maxResults > 0 {
+		query.Set("maxresults", strconv.Itoa(options.maxResults))
+	}
+
+	response, err := c.do(ctx, http.MethodGet, c.url(query, container), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := response.Body.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+
+	var results enumerationResults
+	if err := xml.NewDecoder(response.Body).Decode(&results); err != nil {
+		return nil, fmt.Errorf("failed to decode blob list: %w", err)
+	}
+	result := &listResult{nextMarker: results.NextMarker}
+	for _, blob := range results.Blobs.Blob {
+		result.blobs = append(result.blobs, blob.Name)
+	}
+	for _, prefix := range results.Blobs.BlobPrefix {
+		result.prefixes = append(result.prefixes, prefix.Name)
+	}
+	return result, nil
+}
+
+func (c *blobClient) url(query url.Values, elements ...string) *url.URL {
+	u := *c.endpoint
+	u.Path = path.Join(append([]string{"/", u.Path}, elements...)...)
+	u.RawPath = ""
+	u.RawQuery = query.Encode()
+	return &u
+}
+
+// do sends a request to the Blob service. The response body must be closed by the caller
+// unless an error is returned.
+func (c *blobClient) do(ctx context.Context, method string, u *url.URL, header http.Header, body []byte) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range header {
+		request.Header[key] = values
+	}
+	request.Header.Set("X-Ms-Version", blobServiceVersion)
+	request.Header.Set("X-Ms-Date", time.Now().UTC().Format(http.TimeFormat))
+	if err := c.credential.authorize(ctx, request); err != nil {
+		return nil, err
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode >= http.StatusMultipleChoices {
+		_, _ = io.Copy(io.Discard, response.Body)
+		_ = response.Body.Close()
+		return nil, &responseError{
+			statusCode: response.StatusCode,
+			code:       response.Header.Get("X-Ms-Error-Code"),
+		}
+	}
+	return response, nil
+}
+
+func (e *responseError) Error() string {
+	if e.code == "" {
+		return fmt.Sprintf("azure blob storage request failed with status %d", e.statusCode)
+	}
+	return fmt.Sprintf("azure blob storage request failed with status %d: %s", e.statusCode, e.code)
+}
+
+func isNotFoundError(err error) bool {
+	var rerr *responseError
+	return errors.As(err, &rerr) && rerr.statusCode == http.StatusNotFound
+}
+
+func isContainerNotFoundError(err error) bool {
+	var rerr *responseError
+	return errors.As(err, &rerr) && rerr.code == errCodeContainerNotFound
+}
+
+func isRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var rerr *responseError
+	if errors.As(err, &rerr) {
+		return rerr.statusCode == http.StatusTooManyRequests ||
+			(rerr.statusCode >= http.StatusInternalServerError && rerr.statusCode != http.StatusNotImplemented)
+	}
+	// transport failures, including timeouts, are worth retrying unless the caller gave up
+	var uerr *url.Error
+	return errors.As(err, &uerr) && !errors.Is(err, context.Canceled)
+}
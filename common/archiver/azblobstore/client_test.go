@@ -0,0 +1,366 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package azblobstore
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	testSASToken = "sv=2021-08-06&sp=racwl&sig=test-signature"
+)
+
+type (
+	// fakeBlobService emulates the subset of the Blob service REST API used by the client.
+	fakeBlobService struct {
+		sync.Mutex
+		containers map[string]map[string][]byte
+		authorize  func(r *http.Request) bool
+	}
+)
+
+func newFakeBlobService(containers ...string) *fakeBlobService {
+	f := &fakeBlobService{
+		containers: make(map[string]map[string][]byte),
+		authorize: func(r *http.Request) bool {
+			return r.URL.Query().Get("sig") == "test-signature"
+		},
+	}
+	for _, container := range containers {
+		f.containers[container] = make(map[string][]byte)
+	}
+	return f
+}
+
+// newTestClient starts the fake service and returns a client using a SAS token against it.
+func newTestClient(t *testing.T, f *fakeBlobService) *blobClient {
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+	client, err := newBlobClient(&config.AzblobArchiver{
+		Endpoint: server.URL,
+		SASToken: testSASToken,
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func (f *fakeBlobService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	if r.Header.Get("X-Ms-Version") != blobServiceVersion || !f.authorize(r) {
+		f.writeError(w, http.StatusForbidden, "AuthenticationFailed")
+		return
+	}
+
+	containerName, blobName, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	container, ok := f.containers[containerName]
+	if !ok {
+		f.writeError(w, http.StatusNotFound, "ContainerNotFound")
+		return
+	}
+
+	query := r.URL.Query()
+	if query.Get("restype") == "container" {
+		switch {
+		case r.Method == http.MethodHead:
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodGet && query.Get("comp") == "list":
+			f.list(w, container, query)
+		default:
+			f.writeError(w, http.StatusBadRequest, "UnsupportedHttpVerb")
+		}
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		if r.Header.Get("X-Ms-Blob-Type") != "BlockBlob" {
+			f.writeError(w, http.StatusBadRequest, "InvalidHeaderValue")
+			return
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			f.writeError(w, http.StatusBadRequest, "InvalidInput")
+			return
+		}
+		container[blobName] = data
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodHead, http.MethodGet:
+		data, ok := container[blobName]
+		if !ok {
+			f.writeError(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	default:
+		f.writeError(w, http.StatusBadRequest, "UnsupportedHttpVerb")
+	}
+}
+
+func (f *fakeBlobService) list(w http.ResponseWriter, container map[string][]byte, query url.Values) {
+	prefix := query.Get("prefix")
+	delimiter := query.Get("delimiter")
+
+	// entries are blob names, or prefixes ending with the delimiter
+	entries := make(map[string]bool)
+	for name := range container {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if delimiter != "" {
+			if index := strings.Index(name[len(prefix):], delimiter); index >= 0 {
+				entries[name[:len(prefix)+index+len(delimiter)]] = true
+				continue
+			}
+		}
+		entries[name] = false
+	}
+	names := make([]string, 0, len(entries))
+	for name := range entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	start, _ := strconv.Atoi(query.Get("marker"))
+	maxResults := 5000
+	if query.Get("maxresults") != "" {
+		maxResults, _ = strconv.Atoi(query.Get("maxresults"))
+	}
+	var results enumerationResults
+	if start+maxResults < len(names) {
+		results.NextMarker = strconv.Itoa(start + maxResults)
+		names = names[start : start+maxResults]
+	} else if start < len(names) {
+		names = names[start:]
+	} else {
+		names = nil
+	}
+	for _, name := range names {
+		if entries[name] {
+			results.Blobs.BlobPrefix = append(results.Blobs.BlobPrefix, blobItem{Name: name})
+		} else {
+			results.Blobs.Blob = append(results.Blobs.Blob, blobItem{Name: name})
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	_ = xml.NewEncoder(w).Encode(results)
+}
+
+func (f *fakeBlobService) writeError(w http.ResponseWriter, statusCode int, code string) {
+	w.Header().Set("X-Ms-Error-Code", code)
+	w.WriteHeader(statusCode)
+}
+
+func TestBlobClient(t *testing.T) {
+	ctx := context.Background()
+	service := newFakeBlobService("test-container")
+	client := newTestClient(t, service)
+
+	exists, err := client.ContainerExists(ctx, "test-container")
+	require.NoError(t, err)
+	require.True(t, exists)
+	exists, err = client.ContainerExists(ctx, "other-container")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	blobs := []string{"a/1", "a/2", "a/b/1", "a/b/2", "a/c d/1", "b/1"}
+	for _, name := range blobs {
+		require.NoError(t, client.Upload(ctx, "test-container", name, []byte(name)))
+	}
+	err = client.Upload(ctx, "other-container", "a/1", []byte("a/1"))
+	require.True(t, isContainerNotFoundError(err))
+
+	exists, err = client.BlobExists(ctx, "test-container", "a/c d/1")
+	require.NoError(t, err)
+	require.True(t, exists)
+	exists, err = client.BlobExists(ctx, "test-container", "a/3")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	data, err := client.Download(ctx, "test-container", "a/b/1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("a/b/1"), data)
+	_, err = client.Download(ctx, "test-container", "a/3")
+	require.True(t, isNotFoundError(err))
+	require.False(t, isContainerNotFoundError(err))
+	require.False(t, isRetryableError(err))
+
+	result, err := client.List(ctx, "test-container", listOptions{prefix: "a/", delimiter: "/"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a/1", "a/2"}, result.blobs)
+	require.Equal(t, []string{"a/b/", "a/c d/"}, result.prefixes)
+	require.Empty(t, result.nextMarker)
+
+	var listed []string
+	var marker string
+	for {
+		result, err := client.List(ctx, "test-container", listOptions{prefix: "a/", marker: marker, maxResults: 2})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(result.blobs), 2)
+		listed = append(listed, result.blobs...)
+		if result.nextMarker == "" {
+			break
+		}
+		marker = result.nextMarker
+	}
+	require.Equal(t, blobs[:5], listed)
+}
+
+func TestBlobClient_AuthorizationFailure(t *testing.T) {
+	service := newFakeBlobService("test-container")
+	service.authorize = func(*http.Request) bool { return false }
+	client := newTestClient(t, service)
+
+	_, err := client.ContainerExists(context.Background(), "test-container")
+	var rerr *responseError
+	require.ErrorAs(t, err, &rerr)
+	require.Equal(t, http.StatusForbidden, rerr.statusCode)
+	require.False(t, isRetryableError(err))
+}
+
+func TestNewBlobClient(t *testing.T) {
+	t.Setenv(sasTokenEnvVar, "")
+	t.Setenv(identityEndpointEnvVar, "")
+	t.Setenv(identityHeaderEnvVar, "")
+
+	_, err := newBlobClient(&config.AzblobArchiver{})
+	require.Equal(t, errNoAccountSpecified, err)
+
+	client, err := newBlobClient(&config.AzblobArchiver{AccountName: "account", SASToken: "?" + testSASToken})
+	require.NoError(t, err)
+	require.Equal(t, "https://account.blob.core.windows.net", client.endpoint.String())
+	require.IsType(t, &sasCredential{}, client.credential)
+	require.Equal(t, "test-signature", client.credential.(*sasCredential).query.Get("sig"))
+
+	t.Setenv(sasTokenEnvVar, testSASToken)
+	client, err = newBlobClient(&config.AzblobArchiver{AccountName: "account"})
+	require.NoError(t, err)
+	require.IsType(t, &sasCredential{}, client.credential)
+
+	t.Setenv(sasTokenEnvVar, "")
+	client, err = newBlobClient(&config.AzblobArchiver{AccountName: "account", ManagedIdentityClientID: "client-id"})
+	require.NoError(t, err)
+	credential, ok := client.credential.(*managedIdentityCredential)
+	require.True(t, ok)
+	require.Equal(t, imdsEndpoint, credential.endpoint)
+	require.Equal(t, "client-id", credential.clientID)
+
+	t.Setenv(identityEndpointEnvVar, "http://localhost:8081/msi/token")
+	t.Setenv(identityHeaderEnvVar, "identity-header")
+	client, err = newBlobClient(&config.AzblobArchiver{AccountName: "account"})
+	require.NoError(t, err)
+	credential = client.credential.(*managedIdentityCredential)
+	require.Equal(t, "http://localhost:8081/msi/token", credential.endpoint)
+	require.Equal(t, "identity-header", credential.identityHeader)
+}
+
+func TestManagedIdentityCredential(t *testing.T) {
+	var tokenRequests int
+	var expiresOn time.Time
+	identityServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+		if r.Header.Get("Metadata") != "true" ||
+			r.URL.Query().Get("resource") != storageResource ||
+			r.URL.Query().Get("api-version") != imdsAPIVersion ||
+			r.URL.Query().Get("client_id") != "client-id" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"access_token": "token-" + strconv.Itoa(tokenRequests),
+			"expires_on":   strconv.FormatInt(expiresOn.Unix(), 10),
+		})
+	}))
+	defer identityServer.Close()
+
+	service := newFakeBlobService("test-container")
+	service.authorize = func(r *http.Request) bool {
+		return strings.HasPrefix(r.Header.Get("Authorization"), "Bearer token-")
+	}
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	credential := newManagedIdentityCredential(http.DefaultClient, "client-id")
+	credential.endpoint = identityServer.URL
+	endpoint, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	client := &blobClient{endpoint: endpoint, httpClient: http.DefaultClient, credential: credential}
+
+	// the token is cached until it is about to expire
+	expiresOn = time.Now().Add(time.Hour)
+	for i := 0; i < 2; i++ {
+		exists, err := client.ContainerExists(context.Background(), "test-container")
+		require.NoError(t, err)
+		require.True(t, exists)
+	}
+	require.Equal(t, 1, tokenRequests)
+
+	credential.expiresOn = time.Now().Add(managedIdentityTokenSkew / 2)
+	_, err = client.ContainerExists(context.Background(), "test-container")
+	require.NoError(t, err)
+	require.Equal(t, 2, tokenRequests)
+	require.Equal(t, "token-2", credential.token)
+
+	credential.token = ""
+	credential.clientID = "other-client-id"
+	_, err = client.ContainerExists(context.Background(), "test-container")
+	var rerr *responseError
+	require.ErrorAs(t, err, &rerr)
+	require.Equal(t, http.StatusBadRequest, rerr.statusCode)
+}
+
+func TestIsRetryableError(t *testing.T) {
+	require.False(t, isRetryableError(nil))
+	require.False(t, isRetryableError(errors.New("some error")))
+	require.False(t, isRetryableError(&responseError{statusCode: http.StatusNotFound}))
+	require.False(t, isRetryableError(&responseError{statusCode: http.StatusNotImplemented}))
+	require.True(t, isRetryableError(&responseError{statusCode: http.StatusTooManyRequests}))
+	require.True(t, isRetryableError(&responseError{statusCode: http.StatusServiceUnavailable}))
+	require.True(t, isRetryableError(&url.Error{Op: "Get", URL: "http://localhost", Err: errors.New("connection refused")}))
+	require.False(t, isRetryableError(&url.Error{Op: "Get", URL: "http://localhost", Err: context.Canceled}))
+}
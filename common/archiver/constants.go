@@ -65,4 +65,6 @@ var (
 	ErrNextPageTokenCorrupted = errors.New("next page token is corrupted")
 	// ErrHistoryNotExist is the error for non-exist history
 	ErrHistoryNotExist = errors.New("requested workflow history does not exist")
+	// ErrExpiryActionNotSupported is the error for an archived history expiry action the archiver doesn't support
+	ErrExpiryActionNotSupported = errors.New("archived history expiry action is not supported")
 )
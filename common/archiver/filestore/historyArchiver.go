@@ -26,7 +26,9 @@
 
 // Each Archive() request results in a file named in the format of
 // hash(namespaceID, workflowID, runID)_version.history being created in the specified
-// directory. Workflow histories stored in that file are encoded in JSON format.
+// directory. Workflow histories stored in that file are encoded in JSON format. History
+// batches are streamed to a temporary file as they are read, which then replaces the
+// history file, so the size of a history doesn't bound the memory used to archive it.
 
 // The Get() method retrieves the archived histories from the directory specified in the
 // URI. It optionally takes in a NextPageToken which specifies the workflow close failover
@@ -36,16 +38,24 @@
 // of NextPageToken or close failover version is specified, the highest close failover version
 // will be picked.
 
+// The ExpireHistory() method deletes the history files of a namespace last modified before the
+// requested time. Files are examined in name order and the NextPageToken holds the name of the last
+// examined file. Transitioning histories to colder storage isn't supported.
+
 package filestore
 
 import (
+	"bufio"
 	"context"
 	"errors"
+	"io"
+	"io/fs"
 	"os"
 	"path"
+	"sort"
 	"strconv"
+	"strings"
 
-	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common"
@@ -65,6 +75,8 @@ const (
 	errWriteFile     = "failed to write history to file"
 
 	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
+
+	historyFileSuffix = ".history"
 )
 
 var (
@@ -86,6 +98,10 @@ type (
 		CloseFailoverVersion int64
 		NextBatchIdx         int
 	}
+
+	expireHistoryToken struct {
+		LastFilename string
+	}
 )
 
 // NewHistoryArchiver creates a new archiver.HistoryArchiver based on filestore
@@ -147,7 +163,10 @@ func (h *historyArchiver) Archive(
 		historyIterator = archiver.NewHistoryIterator(request, h.container.ExecutionManager, targetHistoryBlobSize)
 	}
 
-	var historyBatches []*historypb.History
+	filename := constructHistoryFilename(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+	historyFile := newHistoryFileWriter(path.Join(URI.Path(), filename), h.dirMode, h.fileMode)
+	defer historyFile.Abort()
+
 	for historyIterator.HasNext() {
 		historyBlob, err := historyIterator.Next(ctx)
 		if err != nil {
@@ -173,24 +192,15 @@ func (h *historyArchiver) Archive(
 			return archiver.ErrHistoryMutated
 		}
 
-		historyBatches = append(historyBatches, historyBlob.Body...)
-	}
-
-	encoder := codec.NewJSONPBEncoder()
-	encodedHistoryBatches, err := encoder.EncodeHistories(historyBatches)
-	if err != nil {
-		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
-		return err
-	}
-
-	dirPath := URI.Path()
-	if err = mkdirAll(dirPath, h.dirMode); err != nil {
-		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
-		return err
+		for _, batch := range historyBlob.Body {
+			if err := historyFile.Write(batch); err != nil {
+				logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+				return err
+			}
+		}
 	}
 
-	filename := constructHistoryFilename(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
-	if err := writeFile(path.Join(dirPath, filename), encodedHistoryBatches, h.fileMode); err != nil {
+	if err := historyFile.Commit(); err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
 		return err
 	}
@@ -252,31 +262,44 @@ func (h *historyArchiver) Get(
 		return nil, serviceerror.NewNotFound(archiver.ErrHistoryNotExist.Error())
 	}
 
-	encodedHistoryBatches, err := readFile(filepath)
+	historyFile, err := openFile(filepath)
 	if err != nil {
 		return nil, serviceerror.NewInternal(err.Error())
 	}
-
-	encoder := codec.NewJSONPBEncoder()
-	historyBatches, err := encoder.DecodeHistories(encodedHistoryBatches)
-	if err != nil {
-		return nil, serviceerror.NewInternal(err.Error())
+	defer func() { _ = historyFile.Close() }()
+
+	// only the requested page is decoded, the batches before it are skipped
+	reader := codec.NewJSONPBEncoder().NewHistoriesReader(bufio.NewReader(historyFile))
+	for i := 0; i < token.NextBatchIdx; i++ {
+		if err := reader.Skip(); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, serviceerror.NewInternal(err.Error())
+		}
 	}
-	historyBatches = historyBatches[token.NextBatchIdx:]
 
 	response := &archiver.GetHistoryResponse{}
 	numOfEvents := 0
 	numOfBatches := 0
-	for _, batch := range historyBatches {
+	for numOfEvents < request.PageSize {
+		batch, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
 		response.HistoryBatches = append(response.HistoryBatches, batch)
 		numOfBatches++
 		numOfEvents += len(batch.Events)
-		if numOfEvents >= request.PageSize {
-			break
-		}
 	}
 
-	if numOfBatches < len(historyBatches) {
+	more, err := reader.More()
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	if more {
 		token.NextBatchIdx += numOfBatches
 		nextToken, err := serializeToken(token)
 		if err != nil {
@@ -288,6 +311,77 @@ func (h *historyArchiver) Get(
 	return response, nil
 }
 
+func (h *historyArchiver) ExpireHistory(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ExpireHistoryRequest,
+) (*archiver.ExpireHistoryResponse, error) {
+	if err := h.ValidateURI(URI); err != nil {
+		return nil, err
+	}
+	if err := archiver.ValidateExpireHistoryRequest(request); err != nil {
+		return nil, err
+	}
+	if request.Transition {
+		return nil, archiver.ErrExpiryActionNotSupported
+	}
+
+	dirPath := URI.Path()
+	exists, err := directoryExists(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return &archiver.ExpireHistoryResponse{}, nil
+	}
+
+	token := &expireHistoryToken{}
+	if request.NextPageToken != nil {
+		if token, err = deserializeExpireHistoryToken(request.NextPageToken); err != nil {
+			return nil, archiver.ErrNextPageTokenCorrupted
+		}
+	}
+
+	// The history files of all the namespaces archived to the same directory share it.
+	filenames, err := listFilesByPrefix(dirPath, hash(request.NamespaceID))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(filenames)
+
+	response := &archiver.ExpireHistoryResponse{}
+	examined := 0
+	for i, filename := range filenames {
+		if filename <= token.LastFilename || !strings.HasSuffix(filename, historyFileSuffix) {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		filepath := path.Join(dirPath, filename)
+		info, err := os.Stat(filepath)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		if err == nil && info.ModTime().Before(request.ArchivedBefore) {
+			if err := os.Remove(filepath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return nil, err
+			}
+			response.ExpiredCount++
+		}
+		examined++
+		if examined == request.PageSize && i < len(filenames)-1 {
+			nextToken, err := serializeToken(&expireHistoryToken{LastFilename: filename})
+			if err != nil {
+				return nil, err
+			}
+			response.NextPageToken = nextToken
+			break
+		}
+	}
+	return response, nil
+}
+
 func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	if URI.Scheme() != URIScheme {
 		return archiver.ErrURISchemeMismatch
@@ -300,6 +300,8 @@ func (s *historyArchiverSuite) TestArchive_Skip() {
 		historyIterator.EXPECT().Next(gomock.Any()).Return(nil, serviceerror.NewNotFound("workflow not found")),
 	)
 
+	dir := testutils.MkdirTemp(s.T(), "", "TestArchiveSkip")
+
 	historyArchiver := s.newTestHistoryArchiver(historyIterator)
 	request := &archiver.ArchiveHistoryRequest{
 		NamespaceID:          testNamespaceID,
@@ -310,8 +312,15 @@ func (s *historyArchiverSuite) TestArchive_Skip() {
 		NextEventID:          testNextEventID,
 		CloseFailoverVersion: testCloseFailoverVersion,
 	}
-	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, request)
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, request)
+	s.NoError(err)
+
+	// the partially written history must not be left behind
+	files, err := listFiles(dir)
 	s.NoError(err)
+	s.Empty(files)
 }
 
 func (s *historyArchiverSuite) TestArchive_Success() {
@@ -571,6 +580,61 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.Equal(s.historyBatchesV100, response.HistoryBatches)
 }
 
+func (s *historyArchiverSuite) TestExpireHistory() {
+	dir := testutils.MkdirTemp(s.T(), "", "TestExpireHistory")
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+
+	now := time.Now()
+	expiredFilenames := []string{
+		constructHistoryFilename(testNamespaceID, testWorkflowID, testRunID, 1),
+		constructHistoryFilename(testNamespaceID, testWorkflowID, "other-run-id", testCloseFailoverVersion),
+	}
+	keptFilenames := []string{
+		constructHistoryFilename(testNamespaceID, "other-workflow-id", testRunID, 1),
+		constructHistoryFilename("other-namespace-id", testWorkflowID, testRunID, 1),
+	}
+	for _, filename := range expiredFilenames {
+		filepath := path.Join(dir, filename)
+		s.NoError(writeFile(filepath, []byte{}, 0666))
+		s.NoError(os.Chtimes(filepath, now.Add(-time.Hour), now.Add(-time.Hour)))
+	}
+	for _, filename := range keptFilenames {
+		s.NoError(writeFile(path.Join(dir, filename), []byte{}, 0666))
+	}
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := &archiver.ExpireHistoryRequest{
+		NamespaceID:    testNamespaceID,
+		ArchivedBefore: now.Add(-time.Minute),
+		PageSize:       1,
+	}
+	expired := 0
+	for {
+		response, err := historyArchiver.ExpireHistory(context.Background(), URI, request)
+		s.NoError(err)
+		expired += response.ExpiredCount
+		if response.NextPageToken == nil {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Equal(len(expiredFilenames), expired)
+	for _, filename := range expiredFilenames {
+		exists, err := fileExists(path.Join(dir, filename))
+		s.NoError(err)
+		s.False(exists)
+	}
+	for _, filename := range keptFilenames {
+		s.assertFileExists(path.Join(dir, filename))
+	}
+
+	request.NextPageToken = nil
+	request.Transition = true
+	_, err = historyArchiver.ExpireHistory(context.Background(), URI, request)
+	s.ErrorIs(err, archiver.ErrExpiryActionNotSupported)
+}
+
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
@@ -36,15 +36,17 @@ import (
 	"github.com/xwb1989/sqlparser"
 	enumspb "go.temporal.io/api/enums/v1"
 
+	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/util"
 )
 
 type (
 	// QueryParser parses a limited SQL where clause into a struct
 	QueryParser interface {
-		Parse(query string) (*parsedQuery, error)
+		Parse(query string, saTypeMap searchattribute.NameTypeMap) (*parsedQuery, error)
 	}
 
 	queryParser struct{}
@@ -56,6 +58,7 @@ type (
 		runID             *string
 		workflowTypeName  *string
 		status            *enumspb.WorkflowExecutionStatus
+		filter            archiver.VisibilityFilter
 		emptyResult       bool
 	}
 )
@@ -66,6 +69,7 @@ const (
 	RunID        = "RunId"
 	WorkflowType = "WorkflowType"
 	CloseTime    = "CloseTime"
+	StartTime    = "StartTime"
 	// Field name can't be just "Status" because it is reserved keyword in MySQL parser.
 	ExecutionStatus = "ExecutionStatus"
 )
@@ -81,7 +85,7 @@ func NewQueryParser() QueryParser {
 	return &queryParser{}
 }
 
-func (p *queryParser) Parse(query string) (*parsedQuery, error) {
+func (p *queryParser) Parse(query string, saTypeMap searchattribute.NameTypeMap) (*parsedQuery, error) {
 	parsedQuery := &parsedQuery{
 		earliestCloseTime: time.Time{},
 		latestCloseTime:   time.Now().UTC(),
@@ -94,41 +98,62 @@ func (p *queryParser) Parse(query string) (*parsedQuery, error) {
 		return nil, err
 	}
 	whereExpr := stmt.(*sqlparser.Select).Where.Expr
-	if err := p.convertWhereExpr(whereExpr, parsedQuery); err != nil {
+	if err := p.convertWhereExpr(whereExpr, parsedQuery, saTypeMap); err != nil {
 		return nil, err
 	}
+	parsedQuery.emptyResult = parsedQuery.emptyResult || parsedQuery.filter.EmptyResult
 	return parsedQuery, nil
 }
 
-func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQuery) error {
+func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQuery, saTypeMap searchattribute.NameTypeMap) error {
 	if expr == nil {
 		return errors.New("where expression is nil")
 	}
 
 	switch expr := expr.(type) {
 	case *sqlparser.ComparisonExpr:
-		return p.convertComparisonExpr(expr, parsedQuery)
+		return p.convertComparisonExpr(expr, parsedQuery, saTypeMap)
+	case *sqlparser.RangeCond:
+		return p.convertRangeCond(expr, parsedQuery, saTypeMap)
 	case *sqlparser.AndExpr:
-		return p.convertAndExpr(expr, parsedQuery)
+		return p.convertAndExpr(expr, parsedQuery, saTypeMap)
 	case *sqlparser.ParenExpr:
-		return p.convertParenExpr(expr, parsedQuery)
+		return p.convertParenExpr(expr, parsedQuery, saTypeMap)
 	default:
-		return errors.New("only comparison and \"and\" expression is supported")
+		return errors.New("only comparison, \"between\" and \"and\" expression is supported")
 	}
 }
 
-func (p *queryParser) convertParenExpr(parenExpr *sqlparser.ParenExpr, parsedQuery *parsedQuery) error {
-	return p.convertWhereExpr(parenExpr.Expr, parsedQuery)
+func (p *queryParser) convertParenExpr(parenExpr *sqlparser.ParenExpr, parsedQuery *parsedQuery, saTypeMap searchattribute.NameTypeMap) error {
+	return p.convertWhereExpr(parenExpr.Expr, parsedQuery, saTypeMap)
 }
 
-func (p *queryParser) convertAndExpr(andExpr *sqlparser.AndExpr, parsedQuery *parsedQuery) error {
-	if err := p.convertWhereExpr(andExpr.Left, parsedQuery); err != nil {
+func (p *queryParser) convertAndExpr(andExpr *sqlparser.AndExpr, parsedQuery *parsedQuery, saTypeMap searchattribute.NameTypeMap) error {
+	if err := p.convertWhereExpr(andExpr.Left, parsedQuery, saTypeMap); err != nil {
 		return err
 	}
-	return p.convertWhereExpr(andExpr.Right, parsedQuery)
+	return p.convertWhereExpr(andExpr.Right, parsedQuery, saTypeMap)
 }
 
-func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr, parsedQuery *parsedQuery) error {
+func (p *queryParser) convertRangeCond(rangeCond *sqlparser.RangeCond, parsedQuery *parsedQuery, saTypeMap searchattribute.NameTypeMap) error {
+	if rangeCond.Operator != sqlparser.BetweenStr {
+		return fmt.Errorf("operator %s is not supported", rangeCond.Operator)
+	}
+	if err := p.convertComparisonExpr(&sqlparser.ComparisonExpr{
+		Operator: sqlparser.GreaterEqualStr,
+		Left:     rangeCond.Left,
+		Right:    rangeCond.From,
+	}, parsedQuery, saTypeMap); err != nil {
+		return err
+	}
+	return p.convertComparisonExpr(&sqlparser.ComparisonExpr{
+		Operator: sqlparser.LessEqualStr,
+		Left:     rangeCond.Left,
+		Right:    rangeCond.To,
+	}, parsedQuery, saTypeMap)
+}
+
+func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr, parsedQuery *parsedQuery, saTypeMap searchattribute.NameTypeMap) error {
 	colName, ok := compExpr.Left.(*sqlparser.ColName)
 	if !ok {
 		return fmt.Errorf("invalid filter name: %s", sqlparser.String(compExpr.Left))
@@ -206,7 +231,8 @@ func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr,
 		}
 		return p.convertCloseTime(timestamp, op, parsedQuery)
 	default:
-		return fmt.Errorf("unknown filter name: %s", colNameStr)
+		// StartTime and custom keyword search attributes
+		return parsedQuery.filter.ConvertComparison(colNameStr, op, valStr, saTypeMap)
 	}
 
 	return nil
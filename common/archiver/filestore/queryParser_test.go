@@ -33,6 +33,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 type queryParserSuite struct {
@@ -131,7 +132,7 @@ func (s *queryParserSuite) TestParseWorkflowID_RunID_WorkflowType() {
 	}
 
 	for _, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
 		if tc.expectErr {
 			s.Error(err)
 			continue
@@ -238,7 +239,7 @@ func (s *queryParserSuite) TestParseCloseStatus() {
 	}
 
 	for _, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
 		if tc.expectErr {
 			s.Error(err)
 			continue
@@ -304,7 +305,7 @@ func (s *queryParserSuite) TestParseCloseTime() {
 	}
 
 	for i, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
 		if tc.expectErr {
 			s.Error(err)
 			continue
@@ -353,7 +354,7 @@ func (s *queryParserSuite) TestParse() {
 	}
 
 	for i, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
 		if tc.expectErr {
 			s.Error(err)
 			continue
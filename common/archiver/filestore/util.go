@@ -25,10 +25,12 @@
 package filestore
 
 import (
+	"bufio"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"path"
 	"strconv"
 	"strings"
 	"time"
@@ -111,6 +113,95 @@ func readFile(filepath string) ([]byte, error) {
 	return os.ReadFile(filepath)
 }
 
+// openFile opens the file specified by filepath for reading
+// WARNING: callers of this method should be extremely careful not to use it in a context where filepath is supplied by
+// the user.
+func openFile(filepath string) (*os.File, error) {
+	// #nosec
+	return os.Open(filepath)
+}
+
+// historyFileWriter streams history batches to a temporary file in the directory of the history file,
+// and replaces the history file with it on commit. Temporary files don't match the history file name
+// format, so they are never picked up by Get. The directory and the temporary file are only created
+// once the first batch is written, so nothing is left behind if reading the history fails early.
+type historyFileWriter struct {
+	filepath string
+	dirMode  os.FileMode
+	fileMode os.FileMode
+	file     *os.File
+	buffer   *bufio.Writer
+	writer   *codec.HistoriesWriter
+}
+
+func newHistoryFileWriter(filepath string, dirMode os.FileMode, fileMode os.FileMode) *historyFileWriter {
+	return &historyFileWriter{
+		filepath: filepath,
+		dirMode:  dirMode,
+		fileMode: fileMode,
+	}
+}
+
+func (w *historyFileWriter) open() error {
+	if w.file != nil {
+		return nil
+	}
+	if err := mkdirAll(path.Dir(w.filepath), w.dirMode); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(path.Dir(w.filepath), path.Base(w.filepath)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if err := f.Chmod(w.fileMode); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return err
+	}
+	w.file = f
+	w.buffer = bufio.NewWriter(f)
+	w.writer = codec.NewJSONPBEncoder().NewHistoriesWriter(w.buffer)
+	return nil
+}
+
+func (w *historyFileWriter) Write(history *historypb.History) error {
+	if err := w.open(); err != nil {
+		return err
+	}
+	return w.writer.Write(history)
+}
+
+// Commit completes the file and atomically replaces the history file with it.
+func (w *historyFileWriter) Commit() error {
+	if err := w.open(); err != nil {
+		return err
+	}
+	if err := w.writer.Close(); err != nil {
+		return err
+	}
+	if err := w.buffer.Flush(); err != nil {
+		return err
+	}
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(w.file.Name(), w.filepath); err != nil {
+		return err
+	}
+	w.file = nil
+	return nil
+}
+
+// Abort removes the temporary file unless it was committed.
+func (w *historyFileWriter) Abort() {
+	if w.file == nil {
+		return
+	}
+	_ = w.file.Close()
+	_ = os.Remove(w.file.Name())
+	w.file = nil
+}
+
 func listFiles(dirPath string) (fileNames []string, err error) {
 	if info, err := os.Stat(dirPath); err != nil {
 		return nil, err
@@ -178,6 +269,12 @@ func deserializeGetHistoryToken(bytes []byte) (*getHistoryToken, error) {
 	return token, err
 }
 
+func deserializeExpireHistoryToken(bytes []byte) (*expireHistoryToken, error) {
+	token := &expireHistoryToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
 func deserializeQueryVisibilityToken(bytes []byte) (*queryVisibilityToken, error) {
 	token := &queryVisibilityToken{}
 	err := json.Unmarshal(bytes, token)
@@ -188,7 +285,7 @@ func deserializeQueryVisibilityToken(bytes []byte) (*queryVisibilityToken, error
 
 func constructHistoryFilename(namespaceID, workflowID, runID string, version int64) string {
 	combinedHash := constructHistoryFilenamePrefix(namespaceID, workflowID, runID)
-	return fmt.Sprintf("%s_%v.history", combinedHash, version)
+	return fmt.Sprintf("%s_%v%s", combinedHash, version, historyFileSuffix)
 }
 
 func constructHistoryFilenamePrefix(namespaceID, workflowID, runID string) string {
@@ -199,6 +296,10 @@ func constructVisibilityFilename(closeTimestamp *time.Time, runID string) string
 	return fmt.Sprintf("%v_%s.visibility", timestamp.TimeValue(closeTimestamp).UnixNano(), hash(runID))
 }
 
+func constructVisibilityManifestDirPath(uriPath string, namespaceID string) string {
+	return path.Join(uriPath, visibilityManifestDir, namespaceID)
+}
+
 func hash(s string) string {
 	return fmt.Sprintf("%v", farm.Fingerprint64([]byte(s)))
 }
@@ -47,7 +47,12 @@ import (
 )
 
 const (
-	errEncodeVisibilityRecord = "failed to encode visibility record"
+	errEncodeVisibilityRecord   = "failed to encode visibility record"
+	errEncodeVisibilityManifest = "failed to encode visibility manifest"
+
+	// visibilityManifestDir is the directory under the URI path which holds the manifests
+	// of the archived visibility records, one sub directory per namespace.
+	visibilityManifestDir = "manifests"
 )
 
 type (
@@ -132,6 +137,24 @@ func (v *visibilityArchiver) Archive(
 	// The filename has the format: closeTimestamp_hash(runID).visibility
 	// This format allows the archiver to sort all records without reading the file contents
 	filename := constructVisibilityFilename(request.CloseTime, request.GetRunId())
+
+	// The manifest is written first so that every record that can be listed has one.
+	// Records archived before manifests were introduced are matched by decoding the record itself.
+	encodedManifest, err := archiver.EncodeVisibilityManifest(archiver.NewVisibilityManifest(request))
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeVisibilityManifest), tag.Error(err))
+		return err
+	}
+	manifestDirPath := constructVisibilityManifestDirPath(URI.Path(), request.GetNamespaceId())
+	if err = mkdirAll(manifestDirPath, v.dirMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
+		return err
+	}
+	if err := writeFile(path.Join(manifestDirPath, filename), encodedManifest, v.fileMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
+		return err
+	}
+
 	if err := writeFile(path.Join(dirPath, filename), encodedVisibilityRecord, v.fileMode); err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
 		return err
@@ -154,7 +177,7 @@ func (v *visibilityArchiver) Query(
 		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidQueryVisibilityRequest.Error())
 	}
 
-	parsedQuery, err := v.queryParser.Parse(request.Query)
+	parsedQuery, err := v.queryParser.Parse(request.Query, saTypeMap)
 	if err != nil {
 		return nil, serviceerror.NewInvalidArgument(err.Error())
 	}
@@ -213,23 +236,24 @@ func (v *visibilityArchiver) query(
 		return &archiver.QueryVisibilityResponse{}, nil
 	}
 
+	manifestDirPath := constructVisibilityManifestDirPath(URI.Path(), request.namespaceID)
 	response := &archiver.QueryVisibilityResponse{}
 	for idx, file := range files {
-		encodedRecord, err := readFile(path.Join(dirPath, file))
-		if err != nil {
-			return nil, serviceerror.NewInternal(err.Error())
-		}
-
-		record, err := decodeVisibilityRecord(encodedRecord)
+		manifest, record, err := readVisibilityManifest(manifestDirPath, dirPath, file)
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
 		}
 
-		if record.CloseTime.Before(request.parsedQuery.earliestCloseTime) {
+		if manifest.CloseTime.Before(request.parsedQuery.earliestCloseTime) {
 			break
 		}
 
-		if matchQuery(record, request.parsedQuery) {
+		if matchQuery(manifest, request.parsedQuery) {
+			if record == nil {
+				if record, err = readVisibilityRecord(path.Join(dirPath, file)); err != nil {
+					return nil, serviceerror.NewInternal(err.Error())
+				}
+			}
 			executionInfo, err := convertToExecutionInfo(record, saTypeMap)
 			if err != nil {
 				return nil, serviceerror.NewInternal(err.Error())
@@ -239,8 +263,8 @@ func (v *visibilityArchiver) query(
 			if len(response.Executions) == request.pageSize {
 				if idx != len(files) {
 					newToken := &queryVisibilityToken{
-						LastCloseTime: timestamp.TimeValue(record.CloseTime),
-						LastRunID:     record.GetRunId(),
+						LastCloseTime: manifest.CloseTime,
+						LastRunID:     manifest.RunID,
 					}
 					encodedToken, err := serializeToken(newToken)
 					if err != nil {
@@ -322,23 +346,55 @@ func sortAndFilterFiles(filenames []string, token *queryVisibilityToken) ([]stri
 	return filteredFilenames, nil
 }
 
-func matchQuery(record *archiverspb.VisibilityRecord, query *parsedQuery) bool {
-	if record.CloseTime.Before(query.earliestCloseTime) || record.CloseTime.After(query.latestCloseTime) {
+// readVisibilityManifest returns the manifest of the given visibility record file. If the record
+// was archived without a manifest, the record is read and returned as well.
+func readVisibilityManifest(manifestDirPath string, dirPath string, file string) (*archiver.VisibilityManifest, *archiverspb.VisibilityRecord, error) {
+	manifestPath := path.Join(manifestDirPath, file)
+	exists, err := fileExists(manifestPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	if exists {
+		encodedManifest, err := readFile(manifestPath)
+		if err != nil {
+			return nil, nil, err
+		}
+		manifest, err := archiver.DecodeVisibilityManifest(encodedManifest)
+		return manifest, nil, err
+	}
+
+	record, err := readVisibilityRecord(path.Join(dirPath, file))
+	if err != nil {
+		return nil, nil, err
+	}
+	return archiver.NewVisibilityManifest(record), record, nil
+}
+
+func readVisibilityRecord(filepath string) (*archiverspb.VisibilityRecord, error) {
+	encodedRecord, err := readFile(filepath)
+	if err != nil {
+		return nil, err
+	}
+	return decodeVisibilityRecord(encodedRecord)
+}
+
+func matchQuery(manifest *archiver.VisibilityManifest, query *parsedQuery) bool {
+	if manifest.CloseTime.Before(query.earliestCloseTime) || manifest.CloseTime.After(query.latestCloseTime) {
 		return false
 	}
-	if query.workflowID != nil && record.GetWorkflowId() != *query.workflowID {
+	if query.workflowID != nil && manifest.WorkflowID != *query.workflowID {
 		return false
 	}
-	if query.runID != nil && record.GetRunId() != *query.runID {
+	if query.runID != nil && manifest.RunID != *query.runID {
 		return false
 	}
-	if query.workflowTypeName != nil && record.WorkflowTypeName != *query.workflowTypeName {
+	if query.workflowTypeName != nil && manifest.WorkflowTypeName != *query.workflowTypeName {
 		return false
 	}
-	if query.status != nil && record.Status != *query.status {
+	if query.status != nil && manifest.Status != *query.status {
 		return false
 	}
-	return true
+	return query.filter.Matches(manifest)
 }
 
 func convertToExecutionInfo(record *archiverspb.VisibilityRecord, saTypeMap searchattribute.NameTypeMap) (*workflowpb.WorkflowExecutionInfo, error) {
@@ -207,6 +207,96 @@ func (s *visibilityArchiverSuite) TestArchive_Success() {
 	err = encoder.Decode(data, archivedRecord)
 	s.NoError(err)
 	s.Equal(request, archivedRecord)
+
+	data, err = readFile(path.Join(constructVisibilityManifestDirPath(dir, testNamespaceID), expectedFilename))
+	s.NoError(err)
+	manifest, err := archiver.DecodeVisibilityManifest(data)
+	s.NoError(err)
+	s.Equal(archiver.NewVisibilityManifest(request).SearchAttributes, manifest.SearchAttributes)
+	s.Equal(request.GetRunId(), manifest.RunID)
+	s.True(request.CloseTime.Equal(manifest.CloseTime))
+}
+
+func (s *visibilityArchiverSuite) TestArchiveAndQuery_Filter() {
+	dir := testutils.MkdirTemp(s.T(), "", "TestArchiveAndQueryFilter")
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	records := []*archiverspb.VisibilityRecord{
+		{
+			NamespaceId:      testNamespaceID,
+			Namespace:        testNamespace,
+			WorkflowId:       testWorkflowID,
+			RunId:            testRunID,
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTime:        timestamp.UnixOrZeroTimePtr(1),
+			CloseTime:        timestamp.UnixOrZeroTimePtr(10),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			SearchAttributes: map[string]string{"CustomKeywordField": "a"},
+		},
+		{
+			NamespaceId:      testNamespaceID,
+			Namespace:        testNamespace,
+			WorkflowId:       testWorkflowID,
+			RunId:            testRunID + "1",
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTime:        timestamp.UnixOrZeroTimePtr(5),
+			CloseTime:        timestamp.UnixOrZeroTimePtr(20),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+			SearchAttributes: map[string]string{"KeywordList01": `["a","b"]`},
+		},
+		{
+			NamespaceId:      testNamespaceID,
+			Namespace:        testNamespace,
+			WorkflowId:       testWorkflowID,
+			RunId:            testRunID + "2",
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTime:        timestamp.UnixOrZeroTimePtr(15),
+			CloseTime:        timestamp.UnixOrZeroTimePtr(30),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			SearchAttributes: map[string]string{"CustomKeywordField": "b"},
+		},
+	}
+	for _, record := range records {
+		s.NoError(visibilityArchiver.Archive(context.Background(), URI, record))
+	}
+
+	testCases := []struct {
+		query          string
+		expectedRunIDs []string
+	}{
+		{
+			query:          "CustomKeywordField = 'a'",
+			expectedRunIDs: []string{testRunID},
+		},
+		{
+			query:          "KeywordList01 = 'b'",
+			expectedRunIDs: []string{testRunID + "1"},
+		},
+		{
+			query:          "ExecutionStatus = 'Completed' and StartTime >= 5",
+			expectedRunIDs: []string{testRunID + "2"},
+		},
+		{
+			query:          "StartTime between 1 and 5",
+			expectedRunIDs: []string{testRunID + "1", testRunID},
+		},
+	}
+	for _, tc := range testCases {
+		request := &archiver.QueryVisibilityRequest{
+			NamespaceID: testNamespaceID,
+			PageSize:    10,
+			Query:       tc.query,
+		}
+		response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+		s.NoError(err, tc.query)
+		var runIDs []string
+		for _, execution := range response.Executions {
+			runIDs = append(runIDs, execution.GetExecution().GetRunId())
+		}
+		s.Equal(tc.expectedRunIDs, runIDs, tc.query)
+	}
 }
 
 func (s *visibilityArchiverSuite) TestMatchQuery() {
@@ -289,7 +379,7 @@ func (s *visibilityArchiverSuite) TestMatchQuery() {
 	}
 
 	for _, tc := range testCases {
-		s.Equal(tc.shouldMatch, matchQuery(tc.record, tc.query))
+		s.Equal(tc.shouldMatch, matchQuery(archiver.NewVisibilityManifest(tc.record), tc.query))
 	}
 }
 
@@ -356,7 +446,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidRequest() {
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(nil, errors.New("invalid query"))
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(nil, errors.New("invalid query"))
 	visibilityArchiver.queryParser = mockParser
 	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
 		NamespaceID: "some random namespaceID",
@@ -370,7 +460,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		earliestCloseTime: time.Unix(0, 1),
 		latestCloseTime:   time.Unix(0, 101),
 	}, nil)
@@ -390,7 +480,7 @@ func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		earliestCloseTime: time.Unix(0, 1),
 		latestCloseTime:   time.Unix(0, 101),
 	}, nil)
@@ -409,7 +499,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
 func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		earliestCloseTime: time.Unix(0, 1),
 		latestCloseTime:   time.Unix(0, 10001),
 		workflowID:        convert.StringPtr(testWorkflowID),
@@ -435,7 +525,7 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		earliestCloseTime: time.Unix(0, 1),
 		latestCloseTime:   time.Unix(0, 10001),
 		status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
@@ -476,7 +566,7 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		earliestCloseTime: time.Unix(0, 10),
 		latestCloseTime:   time.Unix(0, 10001),
 		status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
@@ -516,7 +606,7 @@ func (s *visibilityArchiverSuite) TestQuery_EmptyQuery_InvalidNamespace() {
 
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		earliestCloseTime: time.Unix(0, 10),
 		latestCloseTime:   time.Unix(0, 10001),
 		status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
@@ -33,13 +33,15 @@ import (
 
 	"github.com/xwb1989/sqlparser"
 
+	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 type (
 	// QueryParser parses a limited SQL where clause into a struct
 	QueryParser interface {
-		Parse(query string) (*parsedQuery, error)
+		Parse(query string, saTypeMap searchattribute.NameTypeMap) (*parsedQuery, error)
 	}
 
 	queryParser struct{}
@@ -51,6 +53,7 @@ type (
 		closeTime       time.Time
 		searchPrecision *string
 		runID           *string
+		filter          archiver.VisibilityFilter
 		emptyResult     bool
 	}
 )
@@ -84,57 +87,85 @@ func NewQueryParser() QueryParser {
 	return &queryParser{}
 }
 
-func (p *queryParser) Parse(query string) (*parsedQuery, error) {
+func (p *queryParser) Parse(query string, saTypeMap searchattribute.NameTypeMap) (*parsedQuery, error) {
 	stmt, err := sqlparser.Parse(fmt.Sprintf(queryTemplate, query))
 	if err != nil {
 		return nil, err
 	}
 	whereExpr := stmt.(*sqlparser.Select).Where.Expr
 	parsedQuery := &parsedQuery{}
-	if err := p.convertWhereExpr(whereExpr, parsedQuery); err != nil {
+	if err := p.convertWhereExpr(whereExpr, parsedQuery, saTypeMap); err != nil {
 		return nil, err
 	}
+	parsedQuery.emptyResult = parsedQuery.emptyResult || parsedQuery.filter.EmptyResult
 
-	if (parsedQuery.closeTime.IsZero() && parsedQuery.startTime.IsZero()) || (!parsedQuery.closeTime.IsZero() && !parsedQuery.startTime.IsZero()) {
-		return nil, errors.New("requires a StartTime or CloseTime")
+	if !parsedQuery.closeTime.IsZero() && !parsedQuery.startTime.IsZero() {
+		return nil, errors.New("only one of StartTime or CloseTime can be searched with SearchPrecision")
 	}
 
-	if parsedQuery.searchPrecision == nil {
+	if parsedQuery.closeTime.IsZero() && parsedQuery.startTime.IsZero() {
+		if parsedQuery.searchPrecision != nil {
+			return nil, errors.New("SearchPrecision requires a StartTime or CloseTime")
+		}
+		if !parsedQuery.filter.HasTimeRange() && parsedQuery.filter.IndexValue() == nil {
+			return nil, errors.New("requires a StartTime, CloseTime, ExecutionStatus or a keyword search attribute")
+		}
+	} else if parsedQuery.searchPrecision == nil {
 		return nil, errors.New("SearchPrecision is required when searching for a StartTime or CloseTime")
 	}
 
 	return parsedQuery, nil
 }
 
-func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQuery) error {
+func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQuery, saTypeMap searchattribute.NameTypeMap) error {
 	if expr == nil {
 		return errors.New("where expression is nil")
 	}
 
 	switch expr := expr.(type) {
 	case *sqlparser.ComparisonExpr:
-		return p.convertComparisonExpr(expr, parsedQuery)
+		return p.convertComparisonExpr(expr, parsedQuery, saTypeMap)
+	case *sqlparser.RangeCond:
+		return p.convertRangeCond(expr, parsedQuery, saTypeMap)
 	case *sqlparser.AndExpr:
-		return p.convertAndExpr(expr, parsedQuery)
+		return p.convertAndExpr(expr, parsedQuery, saTypeMap)
 	case *sqlparser.ParenExpr:
-		return p.convertParenExpr(expr, parsedQuery)
+		return p.convertParenExpr(expr, parsedQuery, saTypeMap)
 	default:
-		return errors.New("only comparison and \"and\" expression is supported")
+		return errors.New("only comparison, \"between\" and \"and\" expression is supported")
 	}
 }
 
-func (p *queryParser) convertParenExpr(parenExpr *sqlparser.ParenExpr, parsedQuery *parsedQuery) error {
-	return p.convertWhereExpr(parenExpr.Expr, parsedQuery)
+func (p *queryParser) convertParenExpr(parenExpr *sqlparser.ParenExpr, parsedQuery *parsedQuery, saTypeMap searchattribute.NameTypeMap) error {
+	return p.convertWhereExpr(parenExpr.Expr, parsedQuery, saTypeMap)
+}
+
+func (p *queryParser) convertAndExpr(andExpr *sqlparser.AndExpr, parsedQuery *parsedQuery, saTypeMap searchattribute.NameTypeMap) error {
+	if err := p.convertWhereExpr(andExpr.Left, parsedQuery, saTypeMap); err != nil {
+		return err
+	}
+	return p.convertWhereExpr(andExpr.Right, parsedQuery, saTypeMap)
 }
 
-func (p *queryParser) convertAndExpr(andExpr *sqlparser.AndExpr, parsedQuery *parsedQuery) error {
-	if err := p.convertWhereExpr(andExpr.Left, parsedQuery); err != nil {
+func (p *queryParser) convertRangeCond(rangeCond *sqlparser.RangeCond, parsedQuery *parsedQuery, saTypeMap searchattribute.NameTypeMap) error {
+	if rangeCond.Operator != sqlparser.BetweenStr {
+		return fmt.Errorf("operator %s is not supported", rangeCond.Operator)
+	}
+	if err := p.convertComparisonExpr(&sqlparser.ComparisonExpr{
+		Operator: sqlparser.GreaterEqualStr,
+		Left:     rangeCond.Left,
+		Right:    rangeCond.From,
+	}, parsedQuery, saTypeMap); err != nil {
 		return err
 	}
-	return p.convertWhereExpr(andExpr.Right, parsedQuery)
+	return p.convertComparisonExpr(&sqlparser.ComparisonExpr{
+		Operator: sqlparser.LessEqualStr,
+		Left:     rangeCond.Left,
+		Right:    rangeCond.To,
+	}, parsedQuery, saTypeMap)
 }
 
-func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr, parsedQuery *parsedQuery) error {
+func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr, parsedQuery *parsedQuery, saTypeMap searchattribute.NameTypeMap) error {
 	colName, ok := compExpr.Left.(*sqlparser.ColName)
 	if !ok {
 		return fmt.Errorf("invalid filter name: %s", sqlparser.String(compExpr.Left))
@@ -175,23 +206,24 @@ func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr,
 		}
 		parsedQuery.runID = convert.StringPtr(val)
 	case CloseTime:
+		if op != "=" {
+			// ranges are not indexed, they are applied to the records found by the other predicates
+			return parsedQuery.filter.ConvertComparison(colNameStr, op, valStr, saTypeMap)
+		}
 		closeTime, err := convertToTime(valStr)
 		if err != nil {
 			return err
 		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", CloseTime)
-		}
 		parsedQuery.closeTime = closeTime
 
 	case StartTime:
+		if op != "=" {
+			return parsedQuery.filter.ConvertComparison(colNameStr, op, valStr, saTypeMap)
+		}
 		startTime, err := convertToTime(valStr)
 		if err != nil {
 			return err
 		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", CloseTime)
-		}
 		parsedQuery.startTime = startTime
 	case WorkflowType:
 		val, err := extractStringValue(valStr)
@@ -227,7 +259,8 @@ func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr,
 		}
 		parsedQuery.searchPrecision = convert.StringPtr(val)
 	default:
-		return fmt.Errorf("unknown filter name: %s", colNameStr)
+		// ExecutionStatus and custom keyword search attributes
+		return parsedQuery.filter.ConvertComparison(colNameStr, op, valStr, saTypeMap)
 	}
 
 	return nil
@@ -62,6 +62,12 @@ func constructVisibilityFilenamePrefix(namespaceID, tag string) string {
 	return fmt.Sprintf("%s/%s", namespaceID, tag)
 }
 
+// constructIndexTag returns the tag of the visibility files indexed by the given value. The
+// value is hashed as it may contain characters which are not allowed in file names.
+func constructIndexTag(value archiver.VisibilityIndexValue, indexKey string) string {
+	return fmt.Sprintf("index%s-%s", hash(value.Name+"="+value.Value), indexKey)
+}
+
 func constructTimeBasedSearchKey(namespaceID, tag string, t time.Time, precision string) string {
 	var timeFormat = ""
 	switch precision {
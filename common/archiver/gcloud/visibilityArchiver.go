@@ -147,6 +147,24 @@ func (v *visibilityArchiver) Archive(ctx context.Context, URI archiver.URI, requ
 		return errRetryable
 	}
 
+	// Execution status and search attribute values are indexed as well, so that they can be
+	// queried without scanning all records of the namespace.
+	for _, value := range archiver.NewVisibilityManifest(request).IndexValues() {
+		for _, index := range []struct {
+			key string
+			t   time.Time
+		}{
+			{indexKeyCloseTimeout, timestamp.TimeValue(request.CloseTime)},
+			{indexKeyStartTimeout, timestamp.TimeValue(request.StartTime)},
+		} {
+			filename = constructVisibilityFilename(request.GetNamespaceId(), request.WorkflowTypeName, request.GetWorkflowId(), request.GetRunId(), constructIndexTag(value, index.key), index.t)
+			if err := v.gcloudStorage.Upload(ctx, URI, filename, encodedVisibilityRecord); err != nil {
+				logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
+				return errRetryable
+			}
+		}
+	}
+
 	handler.Counter(metrics.VisibilityArchiveSuccessCount.GetMetricName()).Record(1)
 	return nil
 }
@@ -175,7 +193,7 @@ func (v *visibilityArchiver) Query(
 		return v.queryAll(ctx, URI, request, saTypeMap)
 	}
 
-	parsedQuery, err := v.queryParser.Parse(request.Query)
+	parsedQuery, err := v.queryParser.Parse(request.Query, saTypeMap)
 	if err != nil {
 		return nil, &serviceerror.InvalidArgument{Message: err.Error()}
 	}
@@ -203,11 +221,17 @@ func (v *visibilityArchiver) query(
 	request *queryVisibilityRequest,
 	saTypeMap searchattribute.NameTypeMap,
 ) (*archiver.QueryVisibilityResponse, error) {
-	prefix := constructVisibilityFilenamePrefix(request.namespaceID, indexKeyCloseTimeout)
+	closeTimeoutTag, startTimeoutTag := indexKeyCloseTimeout, indexKeyStartTimeout
+	if indexValue := request.parsedQuery.filter.IndexValue(); indexValue != nil {
+		closeTimeoutTag = constructIndexTag(*indexValue, indexKeyCloseTimeout)
+		startTimeoutTag = constructIndexTag(*indexValue, indexKeyStartTimeout)
+	}
+
+	prefix := constructVisibilityFilenamePrefix(request.namespaceID, closeTimeoutTag) + "_"
 	if !request.parsedQuery.closeTime.IsZero() {
 		prefix = constructTimeBasedSearchKey(
 			request.namespaceID,
-			indexKeyCloseTimeout,
+			closeTimeoutTag,
 			request.parsedQuery.closeTime,
 			*request.parsedQuery.searchPrecision,
 		)
@@ -216,7 +240,7 @@ func (v *visibilityArchiver) query(
 	if !request.parsedQuery.startTime.IsZero() {
 		prefix = constructTimeBasedSearchKey(
 			request.namespaceID,
-			indexKeyStartTimeout,
+			startTimeoutTag,
 			request.parsedQuery.startTime,
 			*request.parsedQuery.searchPrecision,
 		)
@@ -276,6 +300,10 @@ func (v *visibilityArchiver) queryPrefix(ctx context.Context, uri archiver.URI,
 			return nil, &serviceerror.InvalidArgument{Message: err.Error()}
 		}
 
+		if !request.parsedQuery.filter.Matches(archiver.NewVisibilityManifest(record)) {
+			continue
+		}
+
 		executionInfo, err := convertToExecutionInfo(record, saTypeMap)
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
@@ -179,7 +179,8 @@ func (s *visibilityArchiverSuite) TestVisibilityArchive() {
 	s.NoError(err)
 	storageWrapper := connector.NewMockClient(s.controller)
 	storageWrapper.EXPECT().Exist(gomock.Any(), URI, gomock.Any()).Return(false, nil)
-	storageWrapper.EXPECT().Upload(gomock.Any(), URI, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	// start and close time entries for the record itself, its execution status and its search attribute
+	storageWrapper.EXPECT().Upload(gomock.Any(), URI, gomock.Any(), gomock.Any()).Return(nil).Times(6)
 
 	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
 	s.NoError(err)
@@ -195,12 +196,45 @@ func (s *visibilityArchiverSuite) TestVisibilityArchive() {
 		CloseTime:        timestamp.TimeNowPtrUtc(),
 		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
 		HistoryLength:    int64(101),
+		SearchAttributes: map[string]string{"CustomKeywordField": "value"},
 	}
 
 	err = visibilityArchiver.Archive(ctx, URI, request)
 	s.NoError(err)
 }
 
+func (s *visibilityArchiverSuite) TestQuery_Success_IndexedFilter() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/temporal_archival/visibility")
+	s.NoError(err)
+	filename := "index123-closeTimeout_2020-02-05T09:56:15Z_1_2_3.visibility"
+	storageWrapper := connector.NewMockClient(s.controller)
+	storageWrapper.EXPECT().Exist(gomock.Any(), URI, gomock.Any()).Return(false, nil).Times(2)
+	storageWrapper.EXPECT().Get(gomock.Any(), URI, testNamespaceID+"/"+filename).Return([]byte(exampleVisibilityRecord), nil).Times(2)
+	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
+
+	statusPrefix := constructVisibilityFilenamePrefix(testNamespaceID, constructIndexTag(archiver.VisibilityIndexValue{
+		Name:  archiver.VisibilityFieldExecutionStatus,
+		Value: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED.String(),
+	}, indexKeyCloseTimeout)) + "_"
+	storageWrapper.EXPECT().QueryWithFilters(gomock.Any(), URI, statusPrefix, 10, 0, gomock.Any()).Return([]string{filename}, true, 1, nil).Times(2)
+
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    10,
+		Query:       "ExecutionStatus = 'Completed'",
+	}
+	response, err := visibilityArchiver.Query(ctx, URI, request, searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Len(response.Executions, 1)
+
+	// the record is listed from the index but filtered out by the close time range
+	request.Query = "ExecutionStatus = 'Completed' and CloseTime > '2020-02-06T00:00:00Z'"
+	response, err = visibilityArchiver.Query(ctx, URI, request, searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Empty(response.Executions)
+}
+
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 	ctx := context.Background()
 	URI, err := archiver.NewURI("gs://my-bucket-cad/temporal_archival/visibility")
@@ -211,7 +245,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 	s.NoError(err)
 
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(nil, errors.New("invalid query"))
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(nil, errors.New("invalid query"))
 	visibilityArchiver.queryParser = mockParser
 	response, err := visibilityArchiver.Query(ctx, URI, &archiver.QueryVisibilityRequest{
 		NamespaceID: "some random namespaceID",
@@ -234,7 +268,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
 	startTime, _ := time.Parse(time.RFC3339, "2019-10-04T11:00:00+00:00")
 	closeTime := startTime.Add(time.Hour)
 	precision := PrecisionDay
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		closeTime:       closeTime,
 		startTime:       startTime,
 		searchPrecision: &precision,
@@ -266,7 +300,7 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	mockParser := NewMockQueryParser(s.controller)
 	dayPrecision := "Day"
 	closeTime, _ := time.Parse(time.RFC3339, "2019-10-04T11:00:00+00:00")
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		closeTime:       closeTime,
 		searchPrecision: &dayPrecision,
 		workflowType:    convert.StringPtr("MobileOnlyWorkflow::processMobileOnly"),
@@ -310,7 +344,7 @@ func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	mockParser := NewMockQueryParser(s.controller)
 	dayPrecision := "Day"
 	closeTime, _ := time.Parse(time.RFC3339, "2019-10-04T11:00:00+00:00")
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		closeTime:       closeTime,
 		searchPrecision: &dayPrecision,
 		workflowType:    convert.StringPtr("MobileOnlyWorkflow::processMobileOnly"),
@@ -28,6 +28,7 @@ package archiver
 
 import (
 	"context"
+	"time"
 
 	historypb "go.temporal.io/api/history/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
@@ -96,6 +97,33 @@ type (
 		ValidateURI(uri URI) error
 	}
 
+	// ExpireHistoryRequest is the request to expire the archived histories of a namespace
+	ExpireHistoryRequest struct {
+		NamespaceID string
+		// ArchivedBefore is the time before which archived histories are expired
+		ArchivedBefore time.Time
+		// Transition moves expired histories to colder storage instead of deleting them
+		Transition    bool
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// ExpireHistoryResponse is the response of expiring archived histories
+	ExpireHistoryResponse struct {
+		// ExpiredCount is the number of archived history files or objects expired by the request
+		ExpiredCount  int
+		NextPageToken []byte
+	}
+
+	// HistoryExpirer is optionally implemented by HistoryArchivers which support expiring archived histories
+	HistoryExpirer interface {
+		// ExpireHistory expires one page of the histories archived under the URI for a namespace before a given time.
+		// Histories are expired as a whole as long as all of their files or objects are archived at the same time,
+		// which is the case unless a history was archived again after the workflow was reset or replicated.
+		// Implementations return ErrExpiryActionNotSupported if they cannot transition histories to colder storage.
+		ExpireHistory(ctx context.Context, uri URI, request *ExpireHistoryRequest) (*ExpireHistoryResponse, error)
+	}
+
 	// VisibilityBootstrapContainer contains components needed by all visibility Archiver implementations
 	VisibilityBootstrapContainer struct {
 		Logger          log.Logger
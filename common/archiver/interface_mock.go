@@ -108,6 +108,44 @@ func (mr *MockHistoryArchiverMockRecorder) ValidateURI(uri interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateURI", reflect.TypeOf((*MockHistoryArchiver)(nil).ValidateURI), uri)
 }
 
+// MockHistoryExpirer is a mock of HistoryExpirer interface.
+type MockHistoryExpirer struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryExpirerMockRecorder
+}
+
+// MockHistoryExpirerMockRecorder is the mock recorder for MockHistoryExpirer.
+type MockHistoryExpirerMockRecorder struct {
+	mock *MockHistoryExpirer
+}
+
+// NewMockHistoryExpirer creates a new mock instance.
+func NewMockHistoryExpirer(ctrl *gomock.Controller) *MockHistoryExpirer {
+	mock := &MockHistoryExpirer{ctrl: ctrl}
+	mock.recorder = &MockHistoryExpirerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryExpirer) EXPECT() *MockHistoryExpirerMockRecorder {
+	return m.recorder
+}
+
+// ExpireHistory mocks base method.
+func (m *MockHistoryExpirer) ExpireHistory(ctx context.Context, uri URI, request *ExpireHistoryRequest) (*ExpireHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireHistory", ctx, uri, request)
+	ret0, _ := ret[0].(*ExpireHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireHistory indicates an expected call of ExpireHistory.
+func (mr *MockHistoryExpirerMockRecorder) ExpireHistory(ctx, uri, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireHistory", reflect.TypeOf((*MockHistoryExpirer)(nil).ExpireHistory), ctx, uri, request)
+}
+
 // MockVisibilityArchiver is a mock of VisibilityArchiver interface.
 type MockVisibilityArchiver struct {
 	ctrl     *gomock.Controller
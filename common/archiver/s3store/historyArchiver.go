@@ -23,6 +23,9 @@
 // THE SOFTWARE.
 
 // S3 History Archiver will archive workflow histories to amazon s3
+//
+// Expired histories are either deleted or transitioned to the storage class set in the archiver
+// config, GLACIER by default, by copying their objects onto themselves.
 
 package s3store
 
@@ -30,6 +33,8 @@ import (
 	"context"
 	"encoding/binary"
 	"errors"
+	"fmt"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -40,6 +45,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 
 	archiverspb "go.temporal.io/server/api/archiver/v1"
@@ -70,8 +76,10 @@ var (
 
 type (
 	historyArchiver struct {
-		container *archiver.HistoryBootstrapContainer
-		s3cli     s3iface.S3API
+		container          *archiver.HistoryBootstrapContainer
+		s3cli              s3iface.S3API
+		expiryStorageClass string
+		objectOptions      objectOptions
 		// only set in test code
 		historyIterator archiver.HistoryIterator
 	}
@@ -84,8 +92,10 @@ type (
 	uploadProgress struct {
 		BatchIdx      int
 		IteratorState []byte
-		uploadedSize  int64
-		historySize   int64
+		// WorkflowTypeName is read from the first batch, so that it's known when archival resumes
+		WorkflowTypeName string
+		uploadedSize     int64
+		historySize      int64
 	}
 )
 
@@ -114,11 +124,22 @@ func newHistoryArchiver(
 	if err != nil {
 		return nil, err
 	}
+	objectOptions, err := newObjectOptions(config)
+	if err != nil {
+		return nil, err
+	}
+
+	expiryStorageClass := config.ExpiryStorageClass
+	if expiryStorageClass == "" {
+		expiryStorageClass = s3.StorageClassGlacier
+	}
 
 	return &historyArchiver{
-		container:       container,
-		s3cli:           s3.New(sess),
-		historyIterator: historyIterator,
+		container:          container,
+		s3cli:              s3.New(sess),
+		expiryStorageClass: expiryStorageClass,
+		objectOptions:      objectOptions,
+		historyIterator:    historyIterator,
 	}, nil
 }
 func (h *historyArchiver) Archive(
@@ -187,6 +208,10 @@ func (h *historyArchiver) Archive(
 			return archiver.ErrHistoryMutated
 		}
 
+		if progress.BatchIdx == 0 {
+			progress.WorkflowTypeName = workflowTypeName(historyBlob.Body)
+		}
+
 		encoder := codec.NewJSONPBEncoder()
 		encodedHistoryBlob, err := encoder.Encode(historyBlob)
 		if err != nil {
@@ -208,7 +233,7 @@ func (h *historyArchiver) Archive(
 		if exists {
 			handler.Counter(metrics.HistoryArchiverBlobExistsCount.GetMetricName()).Record(1)
 		} else {
-			if err := Upload(ctx, h.s3cli, URI, key, encodedHistoryBlob); err != nil {
+			if err := Upload(ctx, h.s3cli, URI, key, encodedHistoryBlob, h.objectOptions.uploadOptions(request.Namespace, progress.WorkflowTypeName)...); err != nil {
 				if isRetryableError(err) {
 					logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
 				} else {
@@ -243,6 +268,7 @@ func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRe
 			}
 			progress.IteratorState = nil
 			progress.BatchIdx = 0
+			progress.WorkflowTypeName = ""
 			progress.historySize = 0
 			progress.uploadedSize = 0
 		}
@@ -250,6 +276,13 @@ func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRe
 	return archiver.NewHistoryIterator(request, executionManager, targetHistoryBlobSize)
 }
 
+func workflowTypeName(batches []*historypb.History) string {
+	if len(batches) == 0 || len(batches[0].Events) == 0 {
+		return ""
+	}
+	return batches[0].Events[0].GetWorkflowExecutionStartedEventAttributes().GetWorkflowType().GetName()
+}
+
 func saveHistoryIteratorState(ctx context.Context, featureCatalog *archiver.ArchiveFeatureCatalog, historyIterator archiver.HistoryIterator, progress *uploadProgress) {
 	// Saving history state is a best effort operation. Ignore errors and continue
 	if featureCatalog.ProgressManager != nil {
@@ -353,6 +386,110 @@ func (h *historyArchiver) Get(
 	return response, nil
 }
 
+func (h *historyArchiver) ExpireHistory(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ExpireHistoryRequest,
+) (*archiver.ExpireHistoryResponse, error) {
+	if err := SoftValidateURI(URI); err != nil {
+		return nil, err
+	}
+	if err := archiver.ValidateExpireHistoryRequest(request); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	var continuationToken *string
+	if request.NextPageToken != nil {
+		continuationToken = aws.String(string(request.NextPageToken))
+	}
+	results, err := h.s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket:            aws.String(URI.Hostname()),
+		Prefix:            aws.String(constructHistoryNamespacePrefix(URI.Path(), request.NamespaceID)),
+		MaxKeys:           aws.Int64(int64(request.PageSize)),
+		ContinuationToken: continuationToken,
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchBucket {
+			return nil, errBucketNotExists
+		}
+		return nil, err
+	}
+
+	var expired []*s3.Object
+	for _, object := range results.Contents {
+		if object.LastModified == nil || !object.LastModified.Before(request.ArchivedBefore) {
+			continue
+		}
+		if request.Transition && aws.StringValue(object.StorageClass) == h.expiryStorageClass {
+			continue
+		}
+		expired = append(expired, object)
+	}
+
+	if request.Transition {
+		err = h.transitionObjects(ctx, URI, expired)
+	} else {
+		err = h.deleteObjects(ctx, URI, expired)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := &archiver.ExpireHistoryResponse{
+		ExpiredCount: len(expired),
+	}
+	if aws.BoolValue(results.IsTruncated) {
+		response.NextPageToken = []byte(aws.StringValue(results.NextContinuationToken))
+	}
+	return response, nil
+}
+
+func (h *historyArchiver) deleteObjects(ctx context.Context, URI archiver.URI, objects []*s3.Object) error {
+	if len(objects) == 0 {
+		return nil
+	}
+	identifiers := make([]*s3.ObjectIdentifier, 0, len(objects))
+	for _, object := range objects {
+		identifiers = append(identifiers, &s3.ObjectIdentifier{Key: object.Key})
+	}
+	output, err := h.s3cli.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+		Bucket: aws.String(URI.Hostname()),
+		Delete: &s3.Delete{
+			Objects: identifiers,
+			Quiet:   aws.Bool(true),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if len(output.Errors) > 0 {
+		deleteError := output.Errors[0]
+		return fmt.Errorf("failed to delete %d expired objects, first error on %s: %s",
+			len(output.Errors), aws.StringValue(deleteError.Key), aws.StringValue(deleteError.Message))
+	}
+	return nil
+}
+
+func (h *historyArchiver) transitionObjects(ctx context.Context, URI archiver.URI, objects []*s3.Object) error {
+	for _, object := range objects {
+		input := &s3.CopyObjectInput{
+			Bucket:            aws.String(URI.Hostname()),
+			Key:               object.Key,
+			CopySource:        aws.String(url.PathEscape(URI.Hostname() + "/" + aws.StringValue(object.Key))),
+			StorageClass:      aws.String(h.expiryStorageClass),
+			MetadataDirective: aws.String(s3.MetadataDirectiveCopy),
+		}
+		h.objectOptions.applyToCopy(input)
+		_, err := h.s3cli.CopyObjectWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	err := SoftValidateURI(URI)
 	if err != nil {
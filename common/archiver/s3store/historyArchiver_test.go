@@ -30,6 +30,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/url"
 	"sort"
 	"strconv"
 	"strings"
@@ -43,6 +44,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
@@ -52,6 +54,7 @@ import (
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/s3store/mocks"
 	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -500,6 +503,95 @@ func (s *historyArchiverSuite) TestArchive_Success() {
 	s.assertKeyExists(expectedkey)
 }
 
+func (s *historyArchiverSuite) TestArchive_Success_ObjectOptions() {
+	historyIterator := archiver.NewMockHistoryIterator(s.controller)
+	historyBlob := &archiverspb.HistoryBlob{
+		Header: &archiverspb.HistoryBlobHeader{
+			IsLast: true,
+		},
+		Body: []*historypb.History{
+			{
+				Events: []*historypb.HistoryEvent{
+					{
+						EventId:   common.FirstEventID,
+						EventTime: timestamp.TimePtr(time.Now().UTC()),
+						Version:   testCloseFailoverVersion,
+						EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+						Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+							WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+								WorkflowType: &commonpb.WorkflowType{Name: "test-workflow-type"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(historyBlob, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	s3cli := mocks.NewMockS3API(s.controller)
+	s3cli.EXPECT().HeadObjectWithContext(gomock.Any(), gomock.Any()).Return(nil, awserr.New("NotFound", "", nil))
+	var putInput *s3.PutObjectInput
+	s3cli.EXPECT().PutObjectWithContext(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ aws.Context, input *s3.PutObjectInput, _ ...request.Option) (*s3.PutObjectOutput, error) {
+			putInput = input
+			return &s3.PutObjectOutput{}, nil
+		})
+
+	objectOptions, err := newObjectOptions(&config.S3Archiver{
+		SSEKMSKeyID:   "test-key-id",
+		ObjectTagging: true,
+		ObjectLock: &config.S3ObjectLock{
+			Mode:      s3.ObjectLockModeCompliance,
+			Retention: time.Hour,
+		},
+	})
+	s.NoError(err)
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	historyArchiver.s3cli = s3cli
+	historyArchiver.objectOptions = objectOptions
+	request := &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          common.FirstEventID + 1,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	URI, err := archiver.NewURI(testBucketURI + "/TestArchive_Success_ObjectOptions")
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, request)
+	s.NoError(err)
+
+	s.NotNil(putInput)
+	s.Equal(s3.ServerSideEncryptionAwsKms, aws.StringValue(putInput.ServerSideEncryption))
+	s.Equal("test-key-id", aws.StringValue(putInput.SSEKMSKeyId))
+	tags, err := url.ParseQuery(aws.StringValue(putInput.Tagging))
+	s.NoError(err)
+	s.Equal(testNamespace, tags.Get(objectTagNamespace))
+	s.Equal("test-workflow-type", tags.Get(objectTagWorkflowType))
+	s.Equal(s3.ObjectLockModeCompliance, aws.StringValue(putInput.ObjectLockMode))
+	s.WithinDuration(time.Now().Add(time.Hour), aws.TimeValue(putInput.ObjectLockRetainUntilDate), time.Minute)
+	s.NotEmpty(aws.StringValue(putInput.ContentMD5))
+}
+
+func (s *historyArchiverSuite) TestNewObjectOptions_InvalidObjectLock() {
+	_, err := newObjectOptions(&config.S3Archiver{
+		ObjectLock: &config.S3ObjectLock{Mode: "invalid", Retention: time.Hour},
+	})
+	s.ErrorIs(err, errInvalidObjectLockMode)
+
+	_, err = newObjectOptions(&config.S3Archiver{
+		ObjectLock: &config.S3ObjectLock{Mode: s3.ObjectLockModeGovernance},
+	})
+	s.ErrorIs(err, errInvalidObjectLockRetention)
+}
+
 func (s *historyArchiverSuite) TestGet_Fail_InvalidURI() {
 	historyArchiver := s.newTestHistoryArchiver(nil)
 	request := &archiver.GetHistoryRequest{
@@ -691,13 +783,84 @@ func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.H
 	// config := &config.S3Archiver{}
 	// archiver, err := newHistoryArchiver(s.container, config, historyIterator)
 	archiver := &historyArchiver{
-		container:       s.container,
-		s3cli:           s.s3cli,
-		historyIterator: historyIterator,
+		container:          s.container,
+		s3cli:              s.s3cli,
+		expiryStorageClass: s3.StorageClassGlacier,
+		historyIterator:    historyIterator,
 	}
 	return archiver
 }
 
+func (s *historyArchiverSuite) TestExpireHistory_Delete() {
+	s.s3cli = mocks.NewMockS3API(s.controller)
+	now := time.Now().UTC()
+	expiredKey := constructHistoryKey("", testNamespaceID, testWorkflowID, testRunID, 1, 0)
+	keptKey := constructHistoryKey("", testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion, 0)
+	s.s3cli.EXPECT().ListObjectsV2WithContext(gomock.Any(), &s3.ListObjectsV2Input{
+		Bucket:            aws.String(testBucket),
+		Prefix:            aws.String(testNamespaceID + "/history/"),
+		MaxKeys:           aws.Int64(testPageSize),
+		ContinuationToken: aws.String("token"),
+	}).Return(&s3.ListObjectsV2Output{
+		Contents: []*s3.Object{
+			{Key: aws.String(expiredKey), LastModified: aws.Time(now.Add(-time.Hour))},
+			{Key: aws.String(keptKey), LastModified: aws.Time(now)},
+		},
+		IsTruncated:           aws.Bool(true),
+		NextContinuationToken: aws.String("next-token"),
+	}, nil)
+	s.s3cli.EXPECT().DeleteObjectsWithContext(gomock.Any(), &s3.DeleteObjectsInput{
+		Bucket: aws.String(testBucket),
+		Delete: &s3.Delete{
+			Objects: []*s3.ObjectIdentifier{{Key: aws.String(expiredKey)}},
+			Quiet:   aws.Bool(true),
+		},
+	}).Return(&s3.DeleteObjectsOutput{}, nil)
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	response, err := historyArchiver.ExpireHistory(context.Background(), s.testArchivalURI, &archiver.ExpireHistoryRequest{
+		NamespaceID:    testNamespaceID,
+		ArchivedBefore: now.Add(-time.Minute),
+		PageSize:       testPageSize,
+		NextPageToken:  []byte("token"),
+	})
+	s.NoError(err)
+	s.Equal(1, response.ExpiredCount)
+	s.Equal([]byte("next-token"), response.NextPageToken)
+}
+
+func (s *historyArchiverSuite) TestExpireHistory_Transition() {
+	s.s3cli = mocks.NewMockS3API(s.controller)
+	now := time.Now().UTC()
+	expiredKey := constructHistoryKey("", testNamespaceID, testWorkflowID, testRunID, 1, 0)
+	transitionedKey := constructHistoryKey("", testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion, 0)
+	s.s3cli.EXPECT().ListObjectsV2WithContext(gomock.Any(), gomock.Any()).Return(&s3.ListObjectsV2Output{
+		Contents: []*s3.Object{
+			{Key: aws.String(expiredKey), LastModified: aws.Time(now.Add(-time.Hour)), StorageClass: aws.String(s3.StorageClassStandard)},
+			{Key: aws.String(transitionedKey), LastModified: aws.Time(now.Add(-time.Hour)), StorageClass: aws.String(s3.StorageClassGlacier)},
+		},
+		IsTruncated: aws.Bool(false),
+	}, nil)
+	s.s3cli.EXPECT().CopyObjectWithContext(gomock.Any(), &s3.CopyObjectInput{
+		Bucket:            aws.String(testBucket),
+		Key:               aws.String(expiredKey),
+		CopySource:        aws.String(testBucket + "%2F" + strings.ReplaceAll(expiredKey, "/", "%2F")),
+		StorageClass:      aws.String(s3.StorageClassGlacier),
+		MetadataDirective: aws.String(s3.MetadataDirectiveCopy),
+	}).Return(&s3.CopyObjectOutput{}, nil)
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	response, err := historyArchiver.ExpireHistory(context.Background(), s.testArchivalURI, &archiver.ExpireHistoryRequest{
+		NamespaceID:    testNamespaceID,
+		ArchivedBefore: now.Add(-time.Minute),
+		Transition:     true,
+		PageSize:       testPageSize,
+	})
+	s.NoError(err)
+	s.Equal(1, response.ExpiredCount)
+	s.Nil(response.NextPageToken)
+}
+
 func (s *historyArchiverSuite) setupHistoryDirectory() {
 	now := time.Date(2020, 8, 22, 1, 2, 3, 4, time.UTC)
 
@@ -0,0 +1,161 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	objectTagNamespace    = "temporal-namespace"
+	objectTagWorkflowType = "temporal-workflow-type"
+	maxObjectTagValueLen  = 256
+)
+
+var (
+	errInvalidObjectLockMode      = errors.New("object lock mode must be GOVERNANCE or COMPLIANCE")
+	errInvalidObjectLockRetention = errors.New("object lock retention must be positive")
+)
+
+type (
+	// UploadOption applies an optional setting to an object uploaded to s3
+	UploadOption func(*s3.PutObjectInput)
+
+	// objectOptions are the settings from the archiver config applied to every archived object
+	objectOptions struct {
+		sseKMSKeyID         string
+		tagging             bool
+		objectLockMode      string
+		objectLockRetention time.Duration
+	}
+)
+
+// WithSSEKMSKey encrypts the object with the given customer managed KMS key
+func WithSSEKMSKey(keyID string) UploadOption {
+	return func(input *s3.PutObjectInput) {
+		input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+		input.SSEKMSKeyId = aws.String(keyID)
+	}
+}
+
+// WithObjectTags tags the object with the given tags
+func WithObjectTags(tags map[string]string) UploadOption {
+	return func(input *s3.PutObjectInput) {
+		values := url.Values{}
+		for key, value := range tags {
+			values.Set(key, value)
+		}
+		input.Tagging = aws.String(values.Encode())
+	}
+}
+
+// WithObjectLock locks the object in the given mode until the given time
+func WithObjectLock(mode string, retainUntil time.Time) UploadOption {
+	return func(input *s3.PutObjectInput) {
+		input.ObjectLockMode = aws.String(mode)
+		input.ObjectLockRetainUntilDate = aws.Time(retainUntil)
+	}
+}
+
+func newObjectOptions(cfg *config.S3Archiver) (objectOptions, error) {
+	options := objectOptions{
+		sseKMSKeyID: cfg.SSEKMSKeyID,
+		tagging:     cfg.ObjectTagging,
+	}
+	if cfg.ObjectLock != nil {
+		switch cfg.ObjectLock.Mode {
+		case s3.ObjectLockModeGovernance, s3.ObjectLockModeCompliance:
+		default:
+			return objectOptions{}, errInvalidObjectLockMode
+		}
+		if cfg.ObjectLock.Retention <= 0 {
+			return objectOptions{}, errInvalidObjectLockRetention
+		}
+		options.objectLockMode = cfg.ObjectLock.Mode
+		options.objectLockRetention = cfg.ObjectLock.Retention
+	}
+	return options, nil
+}
+
+// uploadOptions returns the upload options for an object of the given namespace and workflow type
+func (o objectOptions) uploadOptions(namespace string, workflowType string) []UploadOption {
+	var options []UploadOption
+	if o.sseKMSKeyID != "" {
+		options = append(options, WithSSEKMSKey(o.sseKMSKeyID))
+	}
+	if o.tagging {
+		tags := map[string]string{objectTagNamespace: sanitizeObjectTagValue(namespace)}
+		if workflowType != "" {
+			tags[objectTagWorkflowType] = sanitizeObjectTagValue(workflowType)
+		}
+		options = append(options, WithObjectTags(tags))
+	}
+	if o.objectLockMode != "" {
+		options = append(options, WithObjectLock(o.objectLockMode, time.Now().UTC().Add(o.objectLockRetention)))
+	}
+	return options
+}
+
+// applyToCopy keeps the encryption of an object which is copied onto itself. Tags are copied by s3.
+func (o objectOptions) applyToCopy(input *s3.CopyObjectInput) {
+	if o.sseKMSKeyID != "" {
+		input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+		input.SSEKMSKeyId = aws.String(o.sseKMSKeyID)
+	}
+}
+
+// sanitizeObjectTagValue replaces the characters s3 doesn't allow in tag values and truncates the value to
+// the maximum length of a tag value
+func sanitizeObjectTagValue(value string) string {
+	value = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case strings.ContainsRune(" +-=._:/@", r):
+			return r
+		default:
+			return '_'
+		}
+	}, value)
+	if len(value) > maxObjectTagValueLen {
+		value = value[:maxObjectTagValueLen]
+	}
+	return value
+}
+
+func contentMD5(data []byte) string {
+	sum := md5.Sum(data)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
@@ -34,14 +34,16 @@ import (
 
 	"github.com/xwb1989/sqlparser"
 
+	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 type (
 	// QueryParser parses a limited SQL where clause into a struct
 	QueryParser interface {
-		Parse(query string) (*parsedQuery, error)
+		Parse(query string, saTypeMap searchattribute.NameTypeMap) (*parsedQuery, error)
 	}
 
 	queryParser struct{}
@@ -52,12 +54,14 @@ type (
 		startTime        *time.Time
 		closeTime        *time.Time
 		searchPrecision  *string
+		filter           archiver.VisibilityFilter
 	}
 )
 
 // All allowed fields for filtering
 const (
 	WorkflowTypeName = "WorkflowTypeName"
+	WorkflowType     = "WorkflowType"
 	WorkflowID       = "WorkflowId"
 	StartTime        = "StartTime"
 	CloseTime        = "CloseTime"
@@ -81,21 +85,18 @@ func NewQueryParser() QueryParser {
 	return &queryParser{}
 }
 
-func (p *queryParser) Parse(query string) (*parsedQuery, error) {
+func (p *queryParser) Parse(query string, saTypeMap searchattribute.NameTypeMap) (*parsedQuery, error) {
 	stmt, err := sqlparser.Parse(fmt.Sprintf(queryTemplate, query))
 	if err != nil {
 		return nil, err
 	}
 	whereExpr := stmt.(*sqlparser.Select).Where.Expr
 	parsedQuery := &parsedQuery{}
-	if err := p.convertWhereExpr(whereExpr, parsedQuery); err != nil {
+	if err := p.convertWhereExpr(whereExpr, parsedQuery, saTypeMap); err != nil {
 		return nil, err
 	}
-	if parsedQuery.workflowID == nil && parsedQuery.workflowTypeName == nil {
-		return nil, errors.New("WorkflowId or WorkflowTypeName is required in query")
-	}
-	if parsedQuery.workflowID != nil && parsedQuery.workflowTypeName != nil {
-		return nil, errors.New("only one of WorkflowId or WorkflowTypeName can be specified in a query")
+	if parsedQuery.workflowID == nil && parsedQuery.workflowTypeName == nil && parsedQuery.filter.IndexValue() == nil {
+		return nil, errors.New("WorkflowId, WorkflowTypeName, ExecutionStatus or a keyword search attribute is required in query")
 	}
 	if parsedQuery.closeTime != nil && parsedQuery.startTime != nil {
 		return nil, errors.New("only one of StartTime or CloseTime can be specified in a query")
@@ -110,35 +111,55 @@ func (p *queryParser) Parse(query string) (*parsedQuery, error) {
 	return parsedQuery, nil
 }
 
-func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQuery) error {
+func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQuery, saTypeMap searchattribute.NameTypeMap) error {
 	if expr == nil {
 		return errors.New("where expression is nil")
 	}
 
 	switch expr := expr.(type) {
 	case *sqlparser.ComparisonExpr:
-		return p.convertComparisonExpr(expr, parsedQuery)
+		return p.convertComparisonExpr(expr, parsedQuery, saTypeMap)
+	case *sqlparser.RangeCond:
+		return p.convertRangeCond(expr, parsedQuery, saTypeMap)
 	case *sqlparser.AndExpr:
-		return p.convertAndExpr(expr, parsedQuery)
+		return p.convertAndExpr(expr, parsedQuery, saTypeMap)
 	case *sqlparser.ParenExpr:
-		return p.convertParenExpr(expr, parsedQuery)
+		return p.convertParenExpr(expr, parsedQuery, saTypeMap)
 	default:
-		return errors.New("only comparison and \"and\" expression is supported")
+		return errors.New("only comparison, \"between\" and \"and\" expression is supported")
 	}
 }
 
-func (p *queryParser) convertParenExpr(parenExpr *sqlparser.ParenExpr, parsedQuery *parsedQuery) error {
-	return p.convertWhereExpr(parenExpr.Expr, parsedQuery)
+func (p *queryParser) convertParenExpr(parenExpr *sqlparser.ParenExpr, parsedQuery *parsedQuery, saTypeMap searchattribute.NameTypeMap) error {
+	return p.convertWhereExpr(parenExpr.Expr, parsedQuery, saTypeMap)
 }
 
-func (p *queryParser) convertAndExpr(andExpr *sqlparser.AndExpr, parsedQuery *parsedQuery) error {
-	if err := p.convertWhereExpr(andExpr.Left, parsedQuery); err != nil {
+func (p *queryParser) convertAndExpr(andExpr *sqlparser.AndExpr, parsedQuery *parsedQuery, saTypeMap searchattribute.NameTypeMap) error {
+	if err := p.convertWhereExpr(andExpr.Left, parsedQuery, saTypeMap); err != nil {
 		return err
 	}
-	return p.convertWhereExpr(andExpr.Right, parsedQuery)
+	return p.convertWhereExpr(andExpr.Right, parsedQuery, saTypeMap)
 }
 
-func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr, parsedQuery *parsedQuery) error {
+func (p *queryParser) convertRangeCond(rangeCond *sqlparser.RangeCond, parsedQuery *parsedQuery, saTypeMap searchattribute.NameTypeMap) error {
+	if rangeCond.Operator != sqlparser.BetweenStr {
+		return fmt.Errorf("operator %s is not supported", rangeCond.Operator)
+	}
+	if err := p.convertComparisonExpr(&sqlparser.ComparisonExpr{
+		Operator: sqlparser.GreaterEqualStr,
+		Left:     rangeCond.Left,
+		Right:    rangeCond.From,
+	}, parsedQuery, saTypeMap); err != nil {
+		return err
+	}
+	return p.convertComparisonExpr(&sqlparser.ComparisonExpr{
+		Operator: sqlparser.LessEqualStr,
+		Left:     rangeCond.Left,
+		Right:    rangeCond.To,
+	}, parsedQuery, saTypeMap)
+}
+
+func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr, parsedQuery *parsedQuery, saTypeMap searchattribute.NameTypeMap) error {
 	colName, ok := compExpr.Left.(*sqlparser.ColName)
 	if !ok {
 		return fmt.Errorf("invalid filter name: %s", sqlparser.String(compExpr.Left))
@@ -152,7 +173,7 @@ func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr,
 	valStr := sqlparser.String(valExpr)
 
 	switch colNameStr {
-	case WorkflowTypeName:
+	case WorkflowTypeName, WorkflowType:
 		val, err := extractStringValue(valStr)
 		if err != nil {
 			return err
@@ -177,22 +198,23 @@ func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr,
 		}
 		parsedQuery.workflowID = convert.StringPtr(val)
 	case CloseTime:
+		if op != "=" {
+			// ranges are not indexed, they are applied to the records found by the other predicates
+			return parsedQuery.filter.ConvertComparison(colNameStr, op, valStr, saTypeMap)
+		}
 		timestamp, err := convertToTime(valStr)
 		if err != nil {
 			return err
 		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", CloseTime)
-		}
 		parsedQuery.closeTime = &timestamp
 	case StartTime:
+		if op != "=" {
+			return parsedQuery.filter.ConvertComparison(colNameStr, op, valStr, saTypeMap)
+		}
 		timestamp, err := convertToTime(valStr)
 		if err != nil {
 			return err
 		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", CloseTime)
-		}
 		parsedQuery.startTime = &timestamp
 	case SearchPrecision:
 		val, err := extractStringValue(valStr)
@@ -216,7 +238,8 @@ func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr,
 		parsedQuery.searchPrecision = convert.StringPtr(val)
 
 	default:
-		return fmt.Errorf("unknown filter name: %s", colNameStr)
+		// ExecutionStatus and custom keyword search attributes
+		return parsedQuery.filter.ConvertComparison(colNameStr, op, valStr, saTypeMap)
 	}
 
 	return nil
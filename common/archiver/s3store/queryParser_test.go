@@ -30,9 +30,12 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
 
+	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 type queryParserSuite struct {
@@ -73,7 +76,11 @@ func (s *queryParserSuite) TestParseWorkflowIDAndWorkflowTypeName() {
 		},
 		{
 			query:     "WorkflowId = \"random workflowID\" and WorkflowTypeName = \"random workflowTypeName\"",
-			expectErr: true,
+			expectErr: false,
+			parsedQuery: &parsedQuery{
+				workflowID:       convert.StringPtr("random workflowID"),
+				workflowTypeName: convert.StringPtr("random workflowTypeName"),
+			},
 		},
 		{
 			query:     "WorkflowId = \"random workflowID\" and WorkflowId = \"random workflowID\"",
@@ -120,9 +127,9 @@ func (s *queryParserSuite) TestParseWorkflowIDAndWorkflowTypeName() {
 	}
 
 	for _, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
 		if tc.expectErr {
-			s.Error(err)
+			s.Error(err, tc.query)
 			continue
 		}
 		s.NoError(err)
@@ -178,7 +185,7 @@ func (s *queryParserSuite) TestParsePrecision() {
 	}
 
 	for _, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
 		if tc.expectErr {
 			s.Error(err)
 			continue
@@ -221,7 +228,7 @@ func (s *queryParserSuite) TestParseCloseTime() {
 	}
 
 	for _, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
 		if tc.expectErr {
 			s.Error(err)
 			continue
@@ -265,7 +272,7 @@ func (s *queryParserSuite) TestParseStartTime() {
 	}
 
 	for _, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
 		if tc.expectErr {
 			s.Error(err)
 			continue
@@ -274,3 +281,67 @@ func (s *queryParserSuite) TestParseStartTime() {
 		s.Equal(tc.parsedQuery.closeTime, parsedQuery.closeTime)
 	}
 }
+
+func (s *queryParserSuite) TestParseFilter() {
+	completed := enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	testCases := []struct {
+		query     string
+		expectErr bool
+		filter    archiver.VisibilityFilter
+	}{
+		{
+			query: "ExecutionStatus = 'Completed'",
+			filter: archiver.VisibilityFilter{
+				Status: &completed,
+			},
+		},
+		{
+			query: "CustomKeywordField = 'value' and CloseTime > 1000 and StartTime <= 2000",
+			filter: archiver.VisibilityFilter{
+				EarliestCloseTime: time.Unix(0, 1001).UTC(),
+				LatestStartTime:   time.Unix(0, 2000).UTC(),
+				SearchAttributes:  map[string]string{"CustomKeywordField": "value"},
+			},
+		},
+		{
+			query: "WorkflowType = 'type' and CloseTime between '2019-01-01T00:00:00Z' and '2019-01-02T00:00:00Z'",
+			filter: archiver.VisibilityFilter{
+				EarliestCloseTime: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+				LatestCloseTime:   time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			query: "ExecutionStatus = 'Completed' and ExecutionStatus = 'Failed'",
+			filter: archiver.VisibilityFilter{
+				Status:      &completed,
+				EmptyResult: true,
+			},
+		},
+		{
+			query:     "CloseTime > 1000",
+			expectErr: true,
+		},
+		{
+			query:     "CustomIntField = '1'",
+			expectErr: true,
+		},
+		{
+			query:     "UnknownField = 'value'",
+			expectErr: true,
+		},
+		{
+			query:     "CustomKeywordField != 'value'",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
+		if tc.expectErr {
+			s.Error(err, tc.query)
+			continue
+		}
+		s.NoError(err, tc.query)
+		s.Equal(tc.filter, parsedQuery.filter, tc.query)
+	}
+}
@@ -150,6 +150,10 @@ func constructHistoryKeyPrefix(path, namespaceID, workflowID, runID string) stri
 	return strings.TrimLeft(strings.Join([]string{path, namespaceID, "history", workflowID, runID}, "/"), "/")
 }
 
+func constructHistoryNamespacePrefix(path, namespaceID string) string {
+	return strings.TrimLeft(strings.Join([]string{path, namespaceID, "history", ""}, "/"), "/")
+}
+
 func constructTimeBasedSearchKey(path, namespaceID, primaryIndexKey, primaryIndexValue, secondaryIndexKey string, t time.Time, precision string) string {
 	var timeFormat = ""
 	switch precision {
@@ -208,15 +212,23 @@ func ensureContextTimeout(ctx context.Context) (context.Context, context.CancelF
 	}
 	return context.WithTimeout(ctx, defaultBlobstoreTimeout)
 }
-func Upload(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key string, data []byte) error {
+func Upload(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key string, data []byte, opts ...UploadOption) error {
 	ctx, cancel := ensureContextTimeout(ctx)
 	defer cancel()
 
-	_, err := s3cli.PutObjectWithContext(ctx, &s3.PutObjectInput{
+	input := &s3.PutObjectInput{
 		Bucket: aws.String(URI.Hostname()),
 		Key:    aws.String(key),
 		Body:   bytes.NewReader(data),
-	})
+	}
+	for _, opt := range opts {
+		opt(input)
+	}
+	if input.ObjectLockMode != nil {
+		// s3 requires a checksum of objects which are uploaded with a retention
+		input.ContentMD5 = aws.String(contentMD5(data))
+	}
+	_, err := s3cli.PutObjectWithContext(ctx, input)
 	if err != nil {
 		if aerr, ok := err.(awserr.Error); ok {
 			if aerr.Code() == s3.ErrCodeNoSuchBucket {
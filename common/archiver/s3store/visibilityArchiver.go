@@ -47,9 +47,10 @@ import (
 
 type (
 	visibilityArchiver struct {
-		container   *archiver.VisibilityBootstrapContainer
-		s3cli       s3iface.S3API
-		queryParser QueryParser
+		container     *archiver.VisibilityBootstrapContainer
+		s3cli         s3iface.S3API
+		queryParser   QueryParser
+		objectOptions objectOptions
 	}
 
 	queryVisibilityRequest struct {
@@ -73,6 +74,9 @@ const (
 	secondaryIndexKeyCloseTimeout   = "closeTimeout"
 	primaryIndexKeyWorkflowTypeName = "workflowTypeName"
 	primaryIndexKeyWorkflowID       = "workflowID"
+	primaryIndexKeyExecutionStatus  = "executionStatus"
+	// primaryIndexKeySearchAttributePrefix is followed by the search attribute name
+	primaryIndexKeySearchAttributePrefix = "searchAttribute."
 )
 
 // NewVisibilityArchiver creates a new archiver.VisibilityArchiver based on s3
@@ -95,10 +99,15 @@ func newVisibilityArchiver(
 	if err != nil {
 		return nil, err
 	}
+	objectOptions, err := newObjectOptions(config)
+	if err != nil {
+		return nil, err
+	}
 	return &visibilityArchiver{
-		container:   container,
-		s3cli:       s3.New(sess),
-		queryParser: NewQueryParser(),
+		container:     container,
+		s3cli:         s3.New(sess),
+		queryParser:   NewQueryParser(),
+		objectOptions: objectOptions,
 	}, nil
 }
 
@@ -145,10 +154,11 @@ func (v *visibilityArchiver) Archive(
 		return err
 	}
 	indexes := createIndexesToArchive(request)
+	uploadOptions := v.objectOptions.uploadOptions(request.Namespace, request.WorkflowTypeName)
 	// Upload archive to all indexes
 	for _, element := range indexes {
 		key := constructTimestampIndex(URI.Path(), request.GetNamespaceId(), element.primaryIndex, element.primaryIndexValue, element.secondaryIndex, element.secondaryIndexTimestamp, request.GetRunId())
-		if err := Upload(ctx, v.s3cli, URI, key, encodedVisibilityRecord); err != nil {
+		if err := Upload(ctx, v.s3cli, URI, key, encodedVisibilityRecord, uploadOptions...); err != nil {
 			archiveFailReason = errWriteKey
 			return err
 		}
@@ -156,13 +166,31 @@ func (v *visibilityArchiver) Archive(
 	handler.Counter(metrics.VisibilityArchiveSuccessCount.GetMetricName()).Record(1)
 	return nil
 }
+
 func createIndexesToArchive(request *archiverspb.VisibilityRecord) []indexToArchive {
-	return []indexToArchive{
+	indexes := []indexToArchive{
 		{primaryIndexKeyWorkflowTypeName, request.WorkflowTypeName, secondaryIndexKeyCloseTimeout, timestamp.TimeValue(request.CloseTime)},
 		{primaryIndexKeyWorkflowTypeName, request.WorkflowTypeName, secondaryIndexKeyStartTimeout, timestamp.TimeValue(request.StartTime)},
 		{primaryIndexKeyWorkflowID, request.GetWorkflowId(), secondaryIndexKeyCloseTimeout, timestamp.TimeValue(request.CloseTime)},
 		{primaryIndexKeyWorkflowID, request.GetWorkflowId(), secondaryIndexKeyStartTimeout, timestamp.TimeValue(request.StartTime)},
 	}
+	// Execution status and search attribute values are indexed as well, so that they can be
+	// queried without a WorkflowId or WorkflowTypeName.
+	for _, value := range archiver.NewVisibilityManifest(request).IndexValues() {
+		primaryIndex := primaryIndexKey(value)
+		indexes = append(indexes,
+			indexToArchive{primaryIndex, value.Value, secondaryIndexKeyCloseTimeout, timestamp.TimeValue(request.CloseTime)},
+			indexToArchive{primaryIndex, value.Value, secondaryIndexKeyStartTimeout, timestamp.TimeValue(request.StartTime)},
+		)
+	}
+	return indexes
+}
+
+func primaryIndexKey(value archiver.VisibilityIndexValue) string {
+	if value.Name == archiver.VisibilityFieldExecutionStatus {
+		return primaryIndexKeyExecutionStatus
+	}
+	return primaryIndexKeySearchAttributePrefix + value.Name
 }
 
 func (v *visibilityArchiver) Query(
@@ -184,11 +212,15 @@ func (v *visibilityArchiver) Query(
 		return v.queryAll(ctx, URI, request, saTypeMap)
 	}
 
-	parsedQuery, err := v.queryParser.Parse(request.Query)
+	parsedQuery, err := v.queryParser.Parse(request.Query, saTypeMap)
 	if err != nil {
 		return nil, serviceerror.NewInvalidArgument(err.Error())
 	}
 
+	if parsedQuery.filter.EmptyResult {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+
 	return v.query(
 		ctx,
 		URI,
@@ -223,11 +255,20 @@ func (v *visibilityArchiver) query(
 	request *queryVisibilityRequest,
 	saTypeMap searchattribute.NameTypeMap,
 ) (*archiver.QueryVisibilityResponse, error) {
-	primaryIndex := primaryIndexKeyWorkflowTypeName
-	primaryIndexValue := request.parsedQuery.workflowTypeName
-	if request.parsedQuery.workflowID != nil {
+	var primaryIndex string
+	var primaryIndexValue *string
+	switch {
+	case request.parsedQuery.workflowID != nil:
 		primaryIndex = primaryIndexKeyWorkflowID
 		primaryIndexValue = request.parsedQuery.workflowID
+	case request.parsedQuery.workflowTypeName != nil:
+		primaryIndex = primaryIndexKeyWorkflowTypeName
+		primaryIndexValue = request.parsedQuery.workflowTypeName
+	default:
+		// the parser guarantees that there is at least one indexed predicate
+		indexValue := request.parsedQuery.filter.IndexValue()
+		primaryIndex = primaryIndexKey(*indexValue)
+		primaryIndexValue = &indexValue.Value
 	}
 
 	prefix := constructIndexedVisibilitySearchPrefix(
@@ -308,6 +349,9 @@ func (v *visibilityArchiver) queryPrefix(
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
 		}
+		if !matchQuery(record, request.parsedQuery) {
+			continue
+		}
 		executionInfo, err := convertToExecutionInfo(record, saTypeMap)
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
@@ -317,6 +361,19 @@ func (v *visibilityArchiver) queryPrefix(
 	return response, nil
 }
 
+// matchQuery applies the predicates of the query which are not covered by the index the
+// record was listed from.
+func matchQuery(record *archiverspb.VisibilityRecord, query *parsedQuery) bool {
+	manifest := archiver.NewVisibilityManifest(record)
+	if query.workflowID != nil && manifest.WorkflowID != *query.workflowID {
+		return false
+	}
+	if query.workflowTypeName != nil && manifest.WorkflowTypeName != *query.workflowTypeName {
+		return false
+	}
+	return query.filter.Matches(manifest)
+}
+
 func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
 	err := SoftValidateURI(URI)
 	if err != nil {
@@ -28,6 +28,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"testing"
 	"time"
 
@@ -258,7 +259,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidRequest() {
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(nil, errors.New("invalid query"))
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(nil, errors.New("invalid query"))
 	visibilityArchiver.queryParser = mockParser
 	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
 		NamespaceID: "some random namespaceID",
@@ -272,7 +273,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		workflowID:      convert.StringPtr(testWorkflowID),
 		closeTime:       &time.Time{},
 		searchPrecision: convert.StringPtr(PrecisionSecond),
@@ -293,7 +294,7 @@ func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		closeTime:       timestamp.TimePtr(time.Unix(0, int64(1*time.Hour)).UTC()),
 		searchPrecision: convert.StringPtr(PrecisionHour),
 		workflowID:      convert.StringPtr(testWorkflowID),
@@ -319,7 +320,7 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		closeTime:       timestamp.TimePtr(time.Unix(0, 0).UTC()),
 		searchPrecision: convert.StringPtr(PrecisionDay),
 		workflowID:      convert.StringPtr(testWorkflowID),
@@ -543,7 +544,7 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 
 	for i, testData := range precisionTests {
 		mockParser := NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 			closeTime:       timestamp.TimePtr(time.Date(2000, 1, testData.day, testData.hour, testData.minute, testData.second, 0, time.UTC)),
 			searchPrecision: convert.StringPtr(testData.precision),
 			workflowID:      convert.StringPtr(testWorkflowID),
@@ -556,7 +557,7 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 		s.Len(response.Executions, 2, "Iteration ", i)
 
 		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 			startTime:       timestamp.TimePtr(time.Date(2000, 1, testData.day, testData.hour, testData.minute, testData.second, 0, time.UTC)),
 			searchPrecision: convert.StringPtr(testData.precision),
 			workflowID:      convert.StringPtr(testWorkflowID),
@@ -569,7 +570,7 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 		s.Len(response.Executions, 2, "Iteration ", i)
 
 		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 			closeTime:        timestamp.TimePtr(time.Date(2000, 1, testData.day, testData.hour, testData.minute, testData.second, 0, time.UTC)),
 			searchPrecision:  convert.StringPtr(testData.precision),
 			workflowTypeName: convert.StringPtr(testWorkflowTypeName),
@@ -582,7 +583,7 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 		s.Len(response.Executions, 2, "Iteration ", i)
 
 		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 			startTime:        timestamp.TimePtr(time.Date(2000, 1, testData.day, testData.hour, testData.minute, testData.second, 0, time.UTC)),
 			searchPrecision:  convert.StringPtr(testData.precision),
 			workflowTypeName: convert.StringPtr(testWorkflowTypeName),
@@ -606,7 +607,7 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	}
 
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		workflowID: convert.StringPtr(testWorkflowID),
 	}, nil).AnyTimes()
 	visibilityArchiver.queryParser = mockParser
@@ -637,7 +638,7 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	s.Equal(ei, executions[2])
 
 	mockParser = NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		workflowTypeName: convert.StringPtr(testWorkflowTypeName),
 	}, nil).AnyTimes()
 	visibilityArchiver.queryParser = mockParser
@@ -668,6 +669,90 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	s.Equal(ei, executions[2])
 }
 
+func (s *visibilityArchiverSuite) TestArchiveAndQuery_StatusAndSearchAttributes() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI(testBucketURI + "/archive-and-query-filter")
+	s.NoError(err)
+	records := []*archiverspb.VisibilityRecord{
+		{
+			NamespaceId:      testNamespaceID,
+			Namespace:        testNamespace,
+			WorkflowId:       testWorkflowID,
+			RunId:            testRunID,
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTime:        timestamp.UnixOrZeroTimePtr(1),
+			CloseTime:        timestamp.UnixOrZeroTimePtr(int64(time.Hour)),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			SearchAttributes: map[string]string{"CustomKeywordField": "a"},
+		},
+		{
+			NamespaceId:      testNamespaceID,
+			Namespace:        testNamespace,
+			WorkflowId:       testWorkflowID,
+			RunId:            testRunID + "1",
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTime:        timestamp.UnixOrZeroTimePtr(1),
+			CloseTime:        timestamp.UnixOrZeroTimePtr(int64(2 * time.Hour)),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+			SearchAttributes: map[string]string{"CustomKeywordField": "a"},
+		},
+		{
+			NamespaceId:      testNamespaceID,
+			Namespace:        testNamespace,
+			WorkflowId:       testWorkflowID,
+			RunId:            testRunID + "2",
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTime:        timestamp.UnixOrZeroTimePtr(1),
+			CloseTime:        timestamp.UnixOrZeroTimePtr(int64(3 * time.Hour)),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			SearchAttributes: map[string]string{"CustomKeywordField": "b"},
+		},
+	}
+	for _, record := range records {
+		s.NoError(visibilityArchiver.Archive(context.Background(), URI, record))
+	}
+
+	testCases := []struct {
+		query          string
+		expectedRunIDs []string
+	}{
+		{
+			query:          "ExecutionStatus = 'Completed'",
+			expectedRunIDs: []string{testRunID, testRunID + "2"},
+		},
+		{
+			query:          "CustomKeywordField = 'a'",
+			expectedRunIDs: []string{testRunID, testRunID + "1"},
+		},
+		{
+			query:          "CustomKeywordField = 'a' and ExecutionStatus = 'Completed'",
+			expectedRunIDs: []string{testRunID},
+		},
+		{
+			query:          "ExecutionStatus = 'Completed' and CloseTime > " + strconv.FormatInt(int64(2*time.Hour), 10),
+			expectedRunIDs: []string{testRunID + "2"},
+		},
+		{
+			query:          "WorkflowType = '" + testWorkflowTypeName + "' and CustomKeywordField = 'b'",
+			expectedRunIDs: []string{testRunID + "2"},
+		},
+	}
+	for _, tc := range testCases {
+		request := &archiver.QueryVisibilityRequest{
+			NamespaceID: testNamespaceID,
+			PageSize:    10,
+			Query:       tc.query,
+		}
+		response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+		s.NoError(err, tc.query)
+		var runIDs []string
+		for _, execution := range response.Executions {
+			runIDs = append(runIDs, execution.GetExecution().GetRunId())
+		}
+		s.ElementsMatch(tc.expectedRunIDs, runIDs, tc.query)
+	}
+}
+
 func (s *visibilityArchiverSuite) setupVisibilityDirectory() {
 	s.visibilityRecords = []*archiverspb.VisibilityRecord{
 		{
@@ -41,6 +41,7 @@ var (
 	errEmptyWorkflowTypeName = errors.New("field WorkflowTypeName is empty")
 	errEmptyStartTime        = errors.New("field StartTime is empty")
 	errEmptyCloseTime        = errors.New("field CloseTime is empty")
+	errEmptyArchivedBefore   = errors.New("field ArchivedBefore is empty")
 )
 
 // TagLoggerWithArchiveHistoryRequestAndURI tags logger with fields in the archive history request and the URI
@@ -91,6 +92,20 @@ func ValidateHistoryArchiveRequest(request *ArchiveHistoryRequest) error {
 	return nil
 }
 
+// ValidateExpireHistoryRequest validates the expire history request
+func ValidateExpireHistoryRequest(request *ExpireHistoryRequest) error {
+	if request.NamespaceID == "" {
+		return errEmptyNamespaceID
+	}
+	if request.ArchivedBefore.IsZero() {
+		return errEmptyArchivedBefore
+	}
+	if request.PageSize <= 0 {
+		return errInvalidPageSize
+	}
+	return nil
+}
+
 // ValidateGetRequest validates the get archived history request
 func ValidateGetRequest(request *GetHistoryRequest) error {
 	if request.NamespaceID == "" {
@@ -0,0 +1,334 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+// Fields of archived visibility records that can be filtered on in addition to the
+// store specific ones.
+const (
+	VisibilityFieldExecutionStatus = "ExecutionStatus"
+	VisibilityFieldStartTime       = "StartTime"
+	VisibilityFieldCloseTime       = "CloseTime"
+)
+
+type (
+	// VisibilityManifest is the secondary index entry of an archived visibility record.
+	// It is written next to the record at archive time so that queries can be evaluated
+	// without decoding the full record.
+	VisibilityManifest struct {
+		WorkflowID       string                          `json:"workflowId"`
+		RunID            string                          `json:"runId"`
+		WorkflowTypeName string                          `json:"workflowTypeName"`
+		Status           enumspb.WorkflowExecutionStatus `json:"status"`
+		StartTime        time.Time                       `json:"startTime"`
+		CloseTime        time.Time                       `json:"closeTime"`
+		SearchAttributes map[string]string               `json:"searchAttributes,omitempty"`
+	}
+
+	// VisibilityIndexValue is a single name/value pair an archived visibility record is indexed by.
+	VisibilityIndexValue struct {
+		Name  string
+		Value string
+	}
+
+	// VisibilityFilter holds the predicates of an archived visibility query which are shared
+	// by all archivers: execution status, start and close time ranges and equality on
+	// custom keyword search attributes. Zero times mean the range is unbounded on that side.
+	VisibilityFilter struct {
+		Status            *enumspb.WorkflowExecutionStatus
+		EarliestStartTime time.Time
+		LatestStartTime   time.Time
+		EarliestCloseTime time.Time
+		LatestCloseTime   time.Time
+		SearchAttributes  map[string]string
+		EmptyResult       bool
+	}
+)
+
+// NewVisibilityManifest creates the manifest of a visibility record.
+func NewVisibilityManifest(record *archiverspb.VisibilityRecord) *VisibilityManifest {
+	return &VisibilityManifest{
+		WorkflowID:       record.GetWorkflowId(),
+		RunID:            record.GetRunId(),
+		WorkflowTypeName: record.GetWorkflowTypeName(),
+		Status:           record.GetStatus(),
+		StartTime:        timestamp.TimeValue(record.GetStartTime()),
+		CloseTime:        timestamp.TimeValue(record.GetCloseTime()),
+		SearchAttributes: record.GetSearchAttributes(),
+	}
+}
+
+// EncodeVisibilityManifest serializes a visibility manifest.
+func EncodeVisibilityManifest(manifest *VisibilityManifest) ([]byte, error) {
+	return json.Marshal(manifest)
+}
+
+// DecodeVisibilityManifest deserializes a visibility manifest.
+func DecodeVisibilityManifest(data []byte) (*VisibilityManifest, error) {
+	manifest := &VisibilityManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// IndexValues returns the execution status and every search attribute value of the record.
+// Search attributes holding a list are expanded into one value per element.
+func (m *VisibilityManifest) IndexValues() []VisibilityIndexValue {
+	values := []VisibilityIndexValue{{Name: VisibilityFieldExecutionStatus, Value: m.Status.String()}}
+	for name, value := range m.SearchAttributes {
+		for _, v := range searchAttributeValues(value) {
+			values = append(values, VisibilityIndexValue{Name: name, Value: v})
+		}
+	}
+	return values
+}
+
+// Matches returns true if the manifest satisfies all predicates of the filter.
+func (f *VisibilityFilter) Matches(m *VisibilityManifest) bool {
+	if f.EmptyResult {
+		return false
+	}
+	if f.Status != nil && m.Status != *f.Status {
+		return false
+	}
+	if !inTimeRange(m.StartTime, f.EarliestStartTime, f.LatestStartTime) ||
+		!inTimeRange(m.CloseTime, f.EarliestCloseTime, f.LatestCloseTime) {
+		return false
+	}
+	for name, expected := range f.SearchAttributes {
+		value, ok := m.SearchAttributes[name]
+		if !ok || !containsString(searchAttributeValues(value), expected) {
+			return false
+		}
+	}
+	return true
+}
+
+// IndexValue returns the most selective predicate of the filter that can be looked up in
+// a visibility index, or nil if there is none.
+func (f *VisibilityFilter) IndexValue() *VisibilityIndexValue {
+	if len(f.SearchAttributes) > 0 {
+		names := make([]string, 0, len(f.SearchAttributes))
+		for name := range f.SearchAttributes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return &VisibilityIndexValue{Name: names[0], Value: f.SearchAttributes[names[0]]}
+	}
+	if f.Status != nil {
+		return &VisibilityIndexValue{Name: VisibilityFieldExecutionStatus, Value: f.Status.String()}
+	}
+	return nil
+}
+
+// HasTimeRange returns true if the filter restricts the start or close time.
+func (f *VisibilityFilter) HasTimeRange() bool {
+	return !f.EarliestStartTime.IsZero() || !f.LatestStartTime.IsZero() ||
+		!f.EarliestCloseTime.IsZero() || !f.LatestCloseTime.IsZero()
+}
+
+// ConvertComparison adds the predicate "name op value" to the filter. Names other than
+// ExecutionStatus, StartTime and CloseTime must be custom Keyword or KeywordList search
+// attributes defined in saTypeMap.
+func (f *VisibilityFilter) ConvertComparison(name string, op string, value string, saTypeMap searchattribute.NameTypeMap) error {
+	switch name {
+	case VisibilityFieldExecutionStatus:
+		if op != "=" {
+			return fmt.Errorf("only operation = is support for %s", VisibilityFieldExecutionStatus)
+		}
+		statusStr, err := extractStringValue(value)
+		if err != nil {
+			// if failed to extract string value, it means user input status as a number
+			statusStr = value
+		}
+		status, err := ParseWorkflowExecutionStatus(statusStr)
+		if err != nil {
+			return err
+		}
+		if f.Status != nil && *f.Status != status {
+			f.EmptyResult = true
+			return nil
+		}
+		f.Status = &status
+	case VisibilityFieldStartTime:
+		t, err := parseTime(value)
+		if err != nil {
+			return err
+		}
+		return convertTimeRange(t, op, &f.EarliestStartTime, &f.LatestStartTime)
+	case VisibilityFieldCloseTime:
+		t, err := parseTime(value)
+		if err != nil {
+			return err
+		}
+		return convertTimeRange(t, op, &f.EarliestCloseTime, &f.LatestCloseTime)
+	default:
+		saType, ok := saTypeMap.Custom()[name]
+		if !ok {
+			return fmt.Errorf("unknown filter name: %s", name)
+		}
+		if saType != enumspb.INDEXED_VALUE_TYPE_KEYWORD && saType != enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST {
+			return fmt.Errorf("search attribute %s of type %s is not supported, only Keyword and KeywordList are", name, saType)
+		}
+		if op != "=" {
+			return fmt.Errorf("only operation = is support for search attribute %s", name)
+		}
+		val, err := extractStringValue(value)
+		if err != nil {
+			return fmt.Errorf("search attribute %s only supports string values", name)
+		}
+		if f.SearchAttributes == nil {
+			f.SearchAttributes = make(map[string]string)
+		}
+		if existing, ok := f.SearchAttributes[name]; ok && existing != val {
+			f.EmptyResult = true
+			return nil
+		}
+		f.SearchAttributes[name] = val
+	}
+	return nil
+}
+
+// ParseWorkflowExecutionStatus parses a workflow execution status from its name, e.g.
+// "Completed", "ContinuedAsNew" or "timed_out", or from its numeric value.
+func ParseWorkflowExecutionStatus(statusStr string) (enumspb.WorkflowExecutionStatus, error) {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(statusStr), "_", ""))
+	if n, err := strconv.ParseInt(normalized, 10, 32); err == nil {
+		if _, ok := enumspb.WorkflowExecutionStatus_name[int32(n)]; ok && n != int64(enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED) {
+			return enumspb.WorkflowExecutionStatus(n), nil
+		}
+	}
+	for value, name := range enumspb.WorkflowExecutionStatus_name {
+		if value == int32(enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED) {
+			continue
+		}
+		name = strings.TrimPrefix(name, "WORKFLOW_EXECUTION_STATUS_")
+		if strings.ToLower(strings.ReplaceAll(name, "_", "")) == normalized {
+			return enumspb.WorkflowExecutionStatus(value), nil
+		}
+	}
+	return enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED, fmt.Errorf("unknown workflow execution status: %s", statusStr)
+}
+
+func convertTimeRange(t time.Time, op string, earliest *time.Time, latest *time.Time) error {
+	switch op {
+	case "=":
+		if err := convertTimeRange(t, ">=", earliest, latest); err != nil {
+			return err
+		}
+		return convertTimeRange(t, "<=", earliest, latest)
+	case "<":
+		*latest = minTime(*latest, t.Add(-1*time.Nanosecond))
+	case "<=":
+		*latest = minTime(*latest, t)
+	case ">":
+		*earliest = maxTime(*earliest, t.Add(1*time.Nanosecond))
+	case ">=":
+		*earliest = maxTime(*earliest, t)
+	default:
+		return fmt.Errorf("operator %s is not supported for time ranges", op)
+	}
+	return nil
+}
+
+func inTimeRange(t time.Time, earliest time.Time, latest time.Time) bool {
+	if !earliest.IsZero() && t.Before(earliest) {
+		return false
+	}
+	if !latest.IsZero() && t.After(latest) {
+		return false
+	}
+	return true
+}
+
+// minTime returns the earlier of the two times, a zero time is treated as unbounded.
+func minTime(a time.Time, b time.Time) time.Time {
+	if a.IsZero() || b.Before(a) {
+		return b
+	}
+	return a
+}
+
+// maxTime returns the later of the two times, a zero time is treated as unbounded.
+func maxTime(a time.Time, b time.Time) time.Time {
+	if a.IsZero() || b.After(a) {
+		return b
+	}
+	return a
+}
+
+func parseTime(value string) (time.Time, error) {
+	if ts, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return timestamp.UnixOrZeroTime(ts), nil
+	}
+	timeStr, err := extractStringValue(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse(time.RFC3339, timeStr)
+}
+
+func extractStringValue(s string) (string, error) {
+	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
+		return s[1 : len(s)-1], nil
+	}
+	return "", fmt.Errorf("value %s is not a string value", s)
+}
+
+// searchAttributeValues returns the values of a stringified search attribute, see
+// searchattribute.Stringify. Lists are stored as JSON arrays.
+func searchAttributeValues(value string) []string {
+	if strings.HasPrefix(value, "[") {
+		var values []string
+		if err := json.Unmarshal([]byte(value), &values); err == nil {
+			return values
+		}
+	}
+	return []string{value}
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	visibilityManifestSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+)
+
+func TestVisibilityManifestSuite(t *testing.T) {
+	suite.Run(t, new(visibilityManifestSuite))
+}
+
+func (s *visibilityManifestSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *visibilityManifestSuite) TestParseWorkflowExecutionStatus() {
+	testCases := []struct {
+		status   string
+		expected enumspb.WorkflowExecutionStatus
+		valid    bool
+	}{
+		{status: "Completed", expected: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, valid: true},
+		{status: "continued_as_new", expected: enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW, valid: true},
+		{status: "TimedOut", expected: enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT, valid: true},
+		{status: "3", expected: enumspb.WORKFLOW_EXECUTION_STATUS_FAILED, valid: true},
+		{status: "0", valid: false},
+		{status: "Unspecified", valid: false},
+		{status: "unknown", valid: false},
+	}
+
+	for _, tc := range testCases {
+		status, err := ParseWorkflowExecutionStatus(tc.status)
+		if !tc.valid {
+			s.Error(err, tc.status)
+			continue
+		}
+		s.NoError(err, tc.status)
+		s.Equal(tc.expected, status, tc.status)
+	}
+}
+
+func (s *visibilityManifestSuite) TestFilterMatches() {
+	manifest := NewVisibilityManifest(&archiverspb.VisibilityRecord{
+		WorkflowId:       "workflow-id",
+		RunId:            "run-id",
+		WorkflowTypeName: "workflow-type",
+		StartTime:        timestamp.UnixOrZeroTimePtr(int64(time.Hour)),
+		CloseTime:        timestamp.UnixOrZeroTimePtr(int64(2 * time.Hour)),
+		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		SearchAttributes: map[string]string{
+			"CustomKeywordField": "keyword",
+			"KeywordList01":      `["a","b"]`,
+		},
+	})
+
+	testCases := []struct {
+		query   [][3]string
+		matches bool
+	}{
+		{query: nil, matches: true},
+		{query: [][3]string{{"ExecutionStatus", "=", "'Completed'"}}, matches: true},
+		{query: [][3]string{{"ExecutionStatus", "=", "'Failed'"}}, matches: false},
+		{query: [][3]string{{"CustomKeywordField", "=", "'keyword'"}}, matches: true},
+		{query: [][3]string{{"CustomKeywordField", "=", "'other'"}}, matches: false},
+		{query: [][3]string{{"KeywordList01", "=", "'b'"}}, matches: true},
+		{query: [][3]string{{"KeywordList01", "=", "'c'"}}, matches: false},
+		{query: [][3]string{{"Keyword02", "=", "'keyword'"}}, matches: false},
+		{query: [][3]string{{"StartTime", ">=", "3600000000000"}, {"CloseTime", "<", "'1970-01-01T03:00:00Z'"}}, matches: true},
+		{query: [][3]string{{"CloseTime", ">", "7200000000000"}}, matches: false},
+		{query: [][3]string{{"ExecutionStatus", "=", "'Completed'"}, {"ExecutionStatus", "=", "'Failed'"}}, matches: false},
+	}
+
+	for _, tc := range testCases {
+		filter := &VisibilityFilter{}
+		for _, predicate := range tc.query {
+			s.NoError(filter.ConvertComparison(predicate[0], predicate[1], predicate[2], searchattribute.TestNameTypeMap))
+		}
+		s.Equal(tc.matches, filter.Matches(manifest), "%v", tc.query)
+	}
+}
+
+func (s *visibilityManifestSuite) TestConvertComparison_Invalid() {
+	testCases := [][3]string{
+		{"ExecutionStatus", ">", "'Completed'"},
+		{"CustomKeywordField", "!=", "'keyword'"},
+		{"CustomKeywordField", "=", "1"},
+		{"CustomIntField", "=", "'1'"},
+		{"UnknownField", "=", "'value'"},
+		{"RunId", "=", "'run-id'"},
+		{"StartTime", "like", "1"},
+	}
+
+	for _, tc := range testCases {
+		filter := &VisibilityFilter{}
+		s.Error(filter.ConvertComparison(tc[0], tc[1], tc[2], searchattribute.TestNameTypeMap), "%v", tc)
+	}
+}
+
+func (s *visibilityManifestSuite) TestIndexValues() {
+	manifest := &VisibilityManifest{
+		Status: enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+		SearchAttributes: map[string]string{
+			"CustomKeywordField": "keyword",
+			"KeywordList01":      `["a","b"]`,
+		},
+	}
+	s.ElementsMatch([]VisibilityIndexValue{
+		{Name: VisibilityFieldExecutionStatus, Value: "Failed"},
+		{Name: "CustomKeywordField", Value: "keyword"},
+		{Name: "KeywordList01", Value: "a"},
+		{Name: "KeywordList01", Value: "b"},
+	}, manifest.IndexValues())
+}
@@ -0,0 +1,366 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package audit records state-changing frontend, operator and admin API calls to pluggable sinks.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	queueSize     = 1000
+	redactedValue = "<redacted>"
+	outcomeOK     = "OK"
+)
+
+type (
+	// Record is a single audited API call.
+	Record struct {
+		Time time.Time `json:"time"`
+		// Caller is the subject of the caller's claims, or the subject of its TLS certificate if it has no claims.
+		Caller string `json:"caller,omitempty"`
+		// Identity is the identity the caller set on the request, if any.
+		Identity string `json:"identity,omitempty"`
+		// Peer is the network address the call was received from.
+		Peer      string `json:"peer,omitempty"`
+		API       string `json:"api"`
+		Namespace string `json:"namespace,omitempty"`
+		// Request is the JSON encoded request with payload data and configured fields redacted.
+		Request json.RawMessage `json:"request,omitempty"`
+		// Outcome is "OK" or the gRPC status code of the error returned to the caller.
+		Outcome string `json:"outcome"`
+		Error   string `json:"error,omitempty"`
+	}
+
+	// Sink receives audit records. Write is called from a single goroutine.
+	Sink interface {
+		Write(record *Record) error
+		Close() error
+	}
+
+	// Interceptor records the state-changing calls it intercepts and writes them to its sinks in the
+	// background. Records are dropped if the sinks fall behind.
+	Interceptor struct {
+		sinks             []Sink
+		enabled           dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		includePayloads   bool
+		includeWorkerAPIs bool
+		redactFields      map[string]struct{}
+		encoder           *codec.JSONPBEncoder
+		logger            log.Logger
+		throttledLogger   log.Logger
+
+		queue  chan *Record
+		stopCh chan struct{}
+		wg     sync.WaitGroup
+	}
+
+	hasNamespace interface {
+		GetNamespace() string
+	}
+
+	hasIdentity interface {
+		GetIdentity() string
+	}
+)
+
+var (
+	auditedServicePrefixes = []string{
+		"/temporal.api.workflowservice.v1.WorkflowService/",
+		"/temporal.api.operatorservice.v1.OperatorService/",
+		"/temporal.server.api.adminservice.v1.AdminService/",
+	}
+	readOnlyAPIPrefixes = []string{"Describe", "Get", "List", "Count", "Scan", "Query", "Poll"}
+	workerAPIPrefixes   = []string{"Respond", "RecordActivityTaskHeartbeat"}
+)
+
+// NewInterceptor creates an audit interceptor writing to the sinks configured in cfg followed by customSinks.
+// Calls to a namespace are audited while enabled returns true for it.
+func NewInterceptor(
+	cfg *config.Audit,
+	customSinks []Sink,
+	enabled dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	logger log.Logger,
+) (*Interceptor, error) {
+	var sinks []Sink
+	if cfg.File != "" {
+		sink, err := NewFileSink(cfg.File)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if cfg.WebhookURL != "" {
+		sinks = append(sinks, NewWebhookSink(cfg.WebhookURL, cfg.WebhookTimeout))
+	}
+	sinks = append(sinks, customSinks...)
+
+	redactFields := make(map[string]struct{}, len(cfg.RedactFields))
+	for _, field := range cfg.RedactFields {
+		redactFields[field] = struct{}{}
+	}
+	return &Interceptor{
+		sinks:             sinks,
+		enabled:           enabled,
+		includePayloads:   cfg.IncludePayloads,
+		includeWorkerAPIs: cfg.IncludeWorkerAPIs,
+		redactFields:      redactFields,
+		encoder:           codec.NewJSONPBEncoder(),
+		logger:            logger,
+		throttledLogger:   log.NewThrottledLogger(logger, func() float64 { return 1 }),
+		queue:             make(chan *Record, queueSize),
+		stopCh:            make(chan struct{}),
+	}, nil
+}
+
+// Start starts writing records to the sinks.
+func (i *Interceptor) Start() {
+	i.wg.Add(1)
+	go i.writeLoop()
+}
+
+// Stop writes the queued records and closes the sinks.
+func (i *Interceptor) Stop() {
+	close(i.stopCh)
+	i.wg.Wait()
+	for _, sink := range i.sinks {
+		if err := sink.Close(); err != nil {
+			i.logger.Warn("Unable to close audit sink", tag.Error(err))
+		}
+	}
+}
+
+func (i *Interceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if !i.isAudited(info.FullMethod) {
+		return resp, err
+	}
+	i.record(ctx, req, info.FullMethod, err)
+	return resp, err
+}
+
+// RecordRejection records a call that was rejected before reaching the handler, regardless of whether
+// the API is state-changing.
+func (i *Interceptor) RecordRejection(
+	ctx context.Context,
+	req interface{},
+	fullMethod string,
+	err error,
+) {
+	i.record(ctx, req, fullMethod, err)
+}
+
+func (i *Interceptor) record(
+	ctx context.Context,
+	req interface{},
+	fullMethod string,
+	err error,
+) {
+	if len(i.sinks) == 0 {
+		return
+	}
+	var namespace string
+	if r, ok := req.(hasNamespace); ok {
+		namespace = r.GetNamespace()
+	}
+	if !i.enabled(namespace) {
+		return
+	}
+
+	record := &Record{
+		Time:      time.Now().UTC(),
+		Caller:    callerOf(ctx),
+		API:       fullMethod,
+		Namespace: namespace,
+		Request:   i.summarize(req),
+		Outcome:   outcomeOK,
+	}
+	if r, ok := req.(hasIdentity); ok {
+		record.Identity = r.GetIdentity()
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		record.Peer = p.Addr.String()
+	}
+	if err != nil {
+		record.Outcome = serviceerror.ToStatus(err).Code().String()
+		record.Error = err.Error()
+	}
+
+	select {
+	case i.queue <- record:
+	default:
+		i.throttledLogger.Warn("Dropping audit record, sinks are falling behind", tag.NewStringTag("api", fullMethod))
+	}
+}
+
+// isAudited returns true for state-changing APIs of the workflow, operator and admin services.
+func (i *Interceptor) isAudited(fullMethod string) bool {
+	audited := false
+	for _, prefix := range auditedServicePrefixes {
+		if strings.HasPrefix(fullMethod, prefix) {
+			audited = true
+			break
+		}
+	}
+	if !audited {
+		return false
+	}
+
+	api := authorization.ApiName(fullMethod)
+	if authorization.IsReadOnlyNamespaceAPI(api) || authorization.IsReadOnlyGlobalAPI(api) {
+		return false
+	}
+	for _, prefix := range readOnlyAPIPrefixes {
+		if strings.HasPrefix(api, prefix) {
+			return false
+		}
+	}
+	if !i.includeWorkerAPIs {
+		for _, prefix := range workerAPIPrefixes {
+			if strings.HasPrefix(api, prefix) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// summarize encodes the request as JSON and redacts payload data and the configured fields.
+func (i *Interceptor) summarize(req interface{}) json.RawMessage {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	data, err := i.encoder.Encode(msg)
+	if err != nil {
+		return nil
+	}
+	if i.includePayloads && len(i.redactFields) == 0 {
+		return data
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil
+	}
+	redacted, err := json.Marshal(i.redact(value))
+	if err != nil {
+		return nil
+	}
+	return redacted
+}
+
+func (i *Interceptor) redact(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if !i.includePayloads && isPayload(v) {
+			v["data"] = redactedValue
+			return v
+		}
+		for key, child := range v {
+			if _, ok := i.redactFields[key]; ok {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = i.redact(child)
+		}
+	case []interface{}:
+		for idx, child := range v {
+			v[idx] = i.redact(child)
+		}
+	}
+	return value
+}
+
+// isPayload returns true for the JSON encoding of a common.v1.Payload with data.
+func isPayload(v map[string]interface{}) bool {
+	if _, ok := v["data"]; !ok {
+		return false
+	}
+	for key := range v {
+		if key != "data" && key != "metadata" {
+			return false
+		}
+	}
+	return true
+}
+
+func callerOf(ctx context.Context) string {
+	if claims, ok := ctx.Value(authorization.MappedClaims).(*authorization.Claims); ok && claims != nil && claims.Subject != "" {
+		return claims.Subject
+	}
+	if cert := authorization.PeerCert(authorization.TLSInfoFormContext(ctx)); cert != nil {
+		return cert.Subject.String()
+	}
+	return ""
+}
+
+func (i *Interceptor) writeLoop() {
+	defer i.wg.Done()
+	for {
+		select {
+		case record := <-i.queue:
+			i.write(record)
+		case <-i.stopCh:
+			for {
+				select {
+				case record := <-i.queue:
+					i.write(record)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (i *Interceptor) write(record *Record) {
+	for _, sink := range i.sinks {
+		if err := sink.Write(record); err != nil {
+			i.throttledLogger.Error("Unable to write audit record", tag.Error(err))
+		}
+	}
+}
@@ -0,0 +1,256 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+const (
+	startWorkflowAPI     = "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"
+	describeNamespaceAPI = "/temporal.api.workflowservice.v1.WorkflowService/DescribeNamespace"
+	respondWorkflowAPI   = "/temporal.api.workflowservice.v1.WorkflowService/RespondWorkflowTaskCompleted"
+)
+
+type (
+	auditSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		sink *memorySink
+	}
+
+	memorySink struct {
+		sync.Mutex
+		records []*Record
+		closed  bool
+	}
+)
+
+func TestAuditSuite(t *testing.T) {
+	s := new(auditSuite)
+	suite.Run(t, s)
+}
+
+func (s *auditSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.sink = &memorySink{}
+}
+
+func (s *auditSuite) newInterceptor(cfg *config.Audit, enabled func(string) bool) *Interceptor {
+	interceptor, err := NewInterceptor(cfg, []Sink{s.sink}, enabled, log.NewNoopLogger())
+	s.NoError(err)
+	interceptor.Start()
+	return interceptor
+}
+
+func (s *auditSuite) call(interceptor *Interceptor, ctx context.Context, api string, req interface{}, err error) {
+	_, callErr := interceptor.Intercept(ctx, req, &grpc.UnaryServerInfo{FullMethod: api},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, err
+		},
+	)
+	s.Equal(err, callErr)
+}
+
+func (s *auditSuite) startRequest() *workflowservice.StartWorkflowExecutionRequest {
+	return &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:  "test-namespace",
+		WorkflowId: "test-workflow-id",
+		Identity:   "test-identity",
+		Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{{
+			Metadata: map[string][]byte{"encoding": []byte("json/plain")},
+			Data:     []byte(`"secret"`),
+		}}},
+	}
+}
+
+func (s *auditSuite) TestStateChangingCall() {
+	interceptor := s.newInterceptor(&config.Audit{}, alwaysEnabled)
+	ctx := context.WithValue(context.Background(), authorization.MappedClaims, &authorization.Claims{Subject: "alice"})
+	s.call(interceptor, ctx, startWorkflowAPI, s.startRequest(), nil)
+	interceptor.Stop()
+
+	s.True(s.sink.closed)
+	s.Len(s.sink.records, 1)
+	record := s.sink.records[0]
+	s.Equal("alice", record.Caller)
+	s.Equal("test-identity", record.Identity)
+	s.Equal(startWorkflowAPI, record.API)
+	s.Equal("test-namespace", record.Namespace)
+	s.Equal(outcomeOK, record.Outcome)
+	s.Empty(record.Error)
+	var request struct {
+		WorkflowID string `json:"workflowId"`
+		Input      struct {
+			Payloads []map[string]interface{} `json:"payloads"`
+		} `json:"input"`
+	}
+	s.NoError(json.Unmarshal(record.Request, &request))
+	s.Equal("test-workflow-id", request.WorkflowID)
+	s.Len(request.Input.Payloads, 1)
+	s.Equal(redactedValue, request.Input.Payloads[0]["data"])
+	s.NotNil(request.Input.Payloads[0]["metadata"])
+}
+
+func (s *auditSuite) TestIncludePayloads() {
+	interceptor := s.newInterceptor(&config.Audit{IncludePayloads: true}, alwaysEnabled)
+	s.call(interceptor, context.Background(), startWorkflowAPI, s.startRequest(), nil)
+	interceptor.Stop()
+
+	s.Len(s.sink.records, 1)
+	s.NotContains(string(s.sink.records[0].Request), "redacted")
+}
+
+func (s *auditSuite) TestRedactFields() {
+	interceptor := s.newInterceptor(&config.Audit{IncludePayloads: true, RedactFields: []string{"workflowId"}}, alwaysEnabled)
+	s.call(interceptor, context.Background(), startWorkflowAPI, s.startRequest(), nil)
+	interceptor.Stop()
+
+	s.Len(s.sink.records, 1)
+	var request map[string]interface{}
+	s.NoError(json.Unmarshal(s.sink.records[0].Request, &request))
+	s.Equal(redactedValue, request["workflowId"])
+	s.Equal("test-namespace", request["namespace"])
+}
+
+func (s *auditSuite) TestErrorOutcome() {
+	interceptor := s.newInterceptor(&config.Audit{}, alwaysEnabled)
+	s.call(interceptor, context.Background(), startWorkflowAPI, s.startRequest(), serviceerror.NewNotFound("not found"))
+	interceptor.Stop()
+
+	s.Len(s.sink.records, 1)
+	s.Equal("NotFound", s.sink.records[0].Outcome)
+	s.Equal("not found", s.sink.records[0].Error)
+}
+
+func (s *auditSuite) TestSkippedCalls() {
+	interceptor := s.newInterceptor(&config.Audit{}, func(namespace string) bool {
+		return namespace != "disabled-namespace"
+	})
+	s.call(interceptor, context.Background(), describeNamespaceAPI, &workflowservice.DescribeNamespaceRequest{Namespace: "test-namespace"}, nil)
+	s.call(interceptor, context.Background(), respondWorkflowAPI, &workflowservice.RespondWorkflowTaskCompletedRequest{Namespace: "test-namespace"}, nil)
+	s.call(interceptor, context.Background(), "/temporal.server.api.historyservice.v1.HistoryService/StartWorkflowExecution", s.startRequest(), nil)
+	s.call(interceptor, context.Background(), startWorkflowAPI, &workflowservice.StartWorkflowExecutionRequest{Namespace: "disabled-namespace"}, nil)
+	interceptor.Stop()
+
+	s.Empty(s.sink.records)
+}
+
+func (s *auditSuite) TestIncludeWorkerAPIs() {
+	interceptor := s.newInterceptor(&config.Audit{IncludeWorkerAPIs: true}, alwaysEnabled)
+	s.call(interceptor, context.Background(), respondWorkflowAPI, &workflowservice.RespondWorkflowTaskCompletedRequest{Namespace: "test-namespace"}, nil)
+	interceptor.Stop()
+
+	s.Len(s.sink.records, 1)
+	s.Equal(respondWorkflowAPI, s.sink.records[0].API)
+}
+
+func (s *auditSuite) TestRecordRejection() {
+	interceptor := s.newInterceptor(&config.Audit{}, alwaysEnabled)
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(192, 0, 2, 1), Port: 7233}})
+	interceptor.RecordRejection(ctx, &workflowservice.DescribeNamespaceRequest{Namespace: "test-namespace"}, describeNamespaceAPI, serviceerror.NewPermissionDenied("denied", ""))
+	interceptor.Stop()
+
+	s.Len(s.sink.records, 1)
+	record := s.sink.records[0]
+	s.Equal(describeNamespaceAPI, record.API)
+	s.Equal("192.0.2.1:7233", record.Peer)
+	s.Equal("PermissionDenied", record.Outcome)
+}
+
+func (s *auditSuite) TestFileSink() {
+	path := filepath.Join(s.T().TempDir(), "audit.log")
+	sink, err := NewFileSink(path)
+	s.NoError(err)
+	s.NoError(sink.Write(&Record{API: startWorkflowAPI, Outcome: outcomeOK}))
+	s.NoError(sink.Write(&Record{API: respondWorkflowAPI, Outcome: outcomeOK}))
+	s.NoError(sink.Close())
+
+	data, err := os.ReadFile(path)
+	s.NoError(err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	s.Len(lines, 2)
+	var record Record
+	s.NoError(json.Unmarshal([]byte(lines[1]), &record))
+	s.Equal(respondWorkflowAPI, record.API)
+}
+
+func (s *auditSuite) TestWebhookSink() {
+	var received []Record
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var record Record
+		s.NoError(json.NewDecoder(r.Body).Decode(&record))
+		received = append(received, record)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, 0)
+	s.NoError(sink.Write(&Record{API: startWorkflowAPI, Outcome: outcomeOK}))
+	s.Len(received, 1)
+	s.Equal(startWorkflowAPI, received[0].API)
+
+	status = http.StatusInternalServerError
+	s.Error(sink.Write(&Record{API: startWorkflowAPI, Outcome: outcomeOK}))
+}
+
+func alwaysEnabled(string) bool {
+	return true
+}
+
+func (s *memorySink) Write(record *Record) error {
+	s.Lock()
+	defer s.Unlock()
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *memorySink) Close() error {
+	s.closed = true
+	return nil
+}
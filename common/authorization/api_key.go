@@ -0,0 +1,243 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	// APIKeyTokenPrefix starts every API key token, which is used as a bearer credential
+	APIKeyTokenPrefix = "tmprl_"
+
+	apiKeyDataKeyPrefix = "temporal.api-key."
+	apiKeySubjectPrefix = "api-key:"
+	apiKeyIDSize        = 12
+	apiKeySecretSize    = 32
+)
+
+type (
+	// APIKey is a server-managed credential bound to a set of roles. API keys are stored in the data of the
+	// system namespace under APIKeyDataKey(ID). Only the hash of the secret is stored.
+	APIKey struct {
+		ID         string
+		Name       string
+		SecretHash string
+		System     Role
+		Namespaces map[string]Role
+		// RPS limits the requests per second made with the key. Zero means no limit.
+		RPS        float64
+		CreateTime time.Time
+		RotateTime time.Time
+	}
+
+	// APIKeyClaims is set as Claims.Extensions for callers authenticated with an API key.
+	APIKeyClaims struct {
+		ID  string
+		RPS float64
+	}
+
+	// apiKeyClaimMapper maps API key tokens to claims and delegates every other credential.
+	apiKeyClaimMapper struct {
+		ClaimMapper
+		namespaceRegistry namespace.Registry
+		enabled           func() bool
+	}
+
+	// APIKeyRateLimitInterceptor enforces the rate limits of API keys. It must run after the
+	// authorization interceptor, which adds the mapped claims to the context.
+	APIKeyRateLimitInterceptor struct {
+		limiters sync.Map // API key ID -> *quotas.RateLimiterImpl
+	}
+)
+
+var (
+	errUnknownAPIKey      = serviceerror.NewPermissionDenied("unknown API key", "")
+	errAPIKeyRateLimitHit = serviceerror.NewResourceExhausted(enumspb.RESOURCE_EXHAUSTED_CAUSE_RPS_LIMIT, "API key rate limit exceeded.")
+)
+
+var _ ClaimMapper = (*apiKeyClaimMapper)(nil)
+var _ ClaimMapperWithAuthInfoRequired = (*apiKeyClaimMapper)(nil)
+
+// APIKeyDataKey returns the system namespace data key an API key is stored under.
+func APIKeyDataKey(id string) string {
+	return apiKeyDataKeyPrefix + id
+}
+
+// IsAPIKeyDataKey reports whether a system namespace data key holds an API key.
+func IsAPIKeyDataKey(key string) bool {
+	return strings.HasPrefix(key, apiKeyDataKeyPrefix)
+}
+
+// NewAPIKeyID generates a random API key ID.
+func NewAPIKeyID() (string, error) {
+	id := make([]byte, apiKeyIDSize)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id), nil
+}
+
+// NewAPIKeyToken generates a token with a new secret for an API key ID and returns it with the hash of the
+// secret. The token is only known to the caller; it cannot be recovered from the hash.
+func NewAPIKeyToken(id string) (token string, secretHash string, err error) {
+	secret := make([]byte, apiKeySecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", err
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(secret)
+	return APIKeyTokenPrefix + id + "_" + encoded, hashAPIKeySecret(encoded), nil
+}
+
+// MarshalAPIKey encodes an API key to be stored as system namespace data.
+func MarshalAPIKey(key *APIKey) (string, error) {
+	data, err := json.Marshal(key)
+	return string(data), err
+}
+
+// UnmarshalAPIKey decodes an API key stored as system namespace data.
+func UnmarshalAPIKey(data string) (*APIKey, error) {
+	var key APIKey
+	if err := json.Unmarshal([]byte(data), &key); err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+func hashAPIKeySecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
+
+// parseAPIKeyToken splits a bearer authorization header holding an API key token into its ID and secret.
+func parseAPIKeyToken(authToken string) (id string, secret string, ok bool) {
+	parts := strings.Split(authToken, " ")
+	if len(parts) != 2 || !strings.EqualFold(parts[0], authorizationBearer) {
+		return "", "", false
+	}
+	token, found := strings.CutPrefix(parts[1], APIKeyTokenPrefix)
+	if !found {
+		return "", "", false
+	}
+	return strings.Cut(token, "_")
+}
+
+// NewAPIKeyClaimMapper wraps a claim mapper so that callers can also authenticate with API keys while
+// enabled returns true.
+func NewAPIKeyClaimMapper(claimMapper ClaimMapper, namespaceRegistry namespace.Registry, enabled func() bool) ClaimMapper {
+	return &apiKeyClaimMapper{
+		ClaimMapper:       claimMapper,
+		namespaceRegistry: namespaceRegistry,
+		enabled:           enabled,
+	}
+}
+
+func (m *apiKeyClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
+	id, secret, ok := parseAPIKeyToken(authInfo.AuthToken)
+	if !ok || !m.enabled() {
+		return m.ClaimMapper.GetClaims(authInfo)
+	}
+
+	systemNamespace, err := m.namespaceRegistry.GetNamespace(primitives.SystemLocalNamespace)
+	if err != nil {
+		return nil, err
+	}
+	data := systemNamespace.GetCustomData(APIKeyDataKey(id))
+	if data == "" {
+		return nil, errUnknownAPIKey
+	}
+	key, err := UnmarshalAPIKey(data)
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(hashAPIKeySecret(secret)), []byte(key.SecretHash)) != 1 {
+		return nil, errUnknownAPIKey
+	}
+
+	return &Claims{
+		Subject:    apiKeySubjectPrefix + key.ID,
+		System:     key.System,
+		Namespaces: key.Namespaces,
+		Extensions: &APIKeyClaims{ID: key.ID, RPS: key.RPS},
+	}, nil
+}
+
+func (m *apiKeyClaimMapper) AuthInfoRequired() bool {
+	if cm, ok := m.ClaimMapper.(ClaimMapperWithAuthInfoRequired); ok {
+		return cm.AuthInfoRequired()
+	}
+	return true
+}
+
+// NewAPIKeyRateLimitInterceptor creates an interceptor enforcing the rate limits of API keys.
+func NewAPIKeyRateLimitInterceptor() *APIKeyRateLimitInterceptor {
+	return &APIKeyRateLimitInterceptor{}
+}
+
+func (i *APIKeyRateLimitInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if claims, ok := ctx.Value(MappedClaims).(*Claims); ok && claims != nil {
+		if keyClaims, ok := claims.Extensions.(*APIKeyClaims); ok && keyClaims.RPS > 0 && !i.allow(keyClaims) {
+			return nil, errAPIKeyRateLimitHit
+		}
+	}
+	return handler(ctx, req)
+}
+
+func (i *APIKeyRateLimitInterceptor) allow(keyClaims *APIKeyClaims) bool {
+	burst := int(math.Max(1, math.Ceil(keyClaims.RPS)))
+	value, ok := i.limiters.Load(keyClaims.ID)
+	if !ok {
+		value, _ = i.limiters.LoadOrStore(keyClaims.ID, quotas.NewRateLimiter(keyClaims.RPS, burst))
+	}
+	limiter := value.(*quotas.RateLimiterImpl)
+	if limiter.Rate() != keyClaims.RPS {
+		// the limit of the key was changed
+		limiter.SetRateBurst(keyClaims.RPS, burst)
+	}
+	return limiter.Allow()
+}
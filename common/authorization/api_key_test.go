@@ -0,0 +1,125 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives"
+)
+
+func newAPIKeyTestMapper(t *testing.T, key *APIKey, enabled bool) (ClaimMapper, *MockClaimMapper) {
+	ctrl := gomock.NewController(t)
+	inner := NewMockClaimMapper(ctrl)
+	registry := namespace.NewMockRegistry(ctrl)
+
+	value, err := MarshalAPIKey(key)
+	require.NoError(t, err)
+	systemNamespace := namespace.NewLocalNamespaceForTest(&persistencespb.NamespaceInfo{
+		Name: primitives.SystemLocalNamespace,
+		Data: map[string]string{APIKeyDataKey(key.ID): value},
+	}, nil, "active")
+	registry.EXPECT().GetNamespace(namespace.Name(primitives.SystemLocalNamespace)).Return(systemNamespace, nil).AnyTimes()
+
+	return NewAPIKeyClaimMapper(inner, registry, func() bool { return enabled }), inner
+}
+
+func TestAPIKeyClaimMapper(t *testing.T) {
+	id, err := NewAPIKeyID()
+	require.NoError(t, err)
+	token, secretHash, err := NewAPIKeyToken(id)
+	require.NoError(t, err)
+	key := &APIKey{
+		ID:         id,
+		Name:       "ci",
+		SecretHash: secretHash,
+		Namespaces: map[string]Role{"ns": RoleWriter},
+		RPS:        5,
+	}
+	claimMapper, inner := newAPIKeyTestMapper(t, key, true)
+
+	claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(token)})
+	require.NoError(t, err)
+	require.Equal(t, &Claims{
+		Subject:    "api-key:" + id,
+		Namespaces: map[string]Role{"ns": RoleWriter},
+		Extensions: &APIKeyClaims{ID: id, RPS: 5},
+	}, claims)
+
+	otherToken, _, err := NewAPIKeyToken(id)
+	require.NoError(t, err)
+	_, err = claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(otherToken)})
+	require.Error(t, err)
+
+	unknownToken, _, err := NewAPIKeyToken("unknown")
+	require.NoError(t, err)
+	_, err = claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(unknownToken)})
+	require.Error(t, err)
+
+	jwtAuthInfo := &AuthInfo{AuthToken: AddBearer("header.payload.signature")}
+	inner.EXPECT().GetClaims(jwtAuthInfo).Return(&Claims{Subject: "jwt"}, nil)
+	claims, err = claimMapper.GetClaims(jwtAuthInfo)
+	require.NoError(t, err)
+	require.Equal(t, "jwt", claims.Subject)
+}
+
+func TestAPIKeyClaimMapper_Disabled(t *testing.T) {
+	token, secretHash, err := NewAPIKeyToken("id")
+	require.NoError(t, err)
+	claimMapper, inner := newAPIKeyTestMapper(t, &APIKey{ID: "id", SecretHash: secretHash}, false)
+
+	authInfo := &AuthInfo{AuthToken: AddBearer(token)}
+	inner.EXPECT().GetClaims(authInfo).Return(&Claims{}, nil)
+	_, err = claimMapper.GetClaims(authInfo)
+	require.NoError(t, err)
+}
+
+func TestAPIKeyRateLimitInterceptor(t *testing.T) {
+	interceptor := NewAPIKeyRateLimitInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	info := &grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"}
+
+	limited := context.WithValue(context.Background(), MappedClaims, &Claims{Extensions: &APIKeyClaims{ID: "id", RPS: 1}})
+	resp, err := interceptor.Intercept(limited, nil, info, handler)
+	require.NoError(t, err)
+	require.Equal(t, "ok", resp)
+	_, err = interceptor.Intercept(limited, nil, info, handler)
+	require.Equal(t, errAPIKeyRateLimitHit, err)
+
+	unlimited := context.WithValue(context.Background(), MappedClaims, &Claims{Extensions: &APIKeyClaims{ID: "other"}})
+	for i := 0; i < 3; i++ {
+		_, err = interceptor.Intercept(unlimited, nil, info, handler)
+		require.NoError(t, err)
+	}
+	_, err = interceptor.Intercept(context.Background(), nil, info, handler)
+	require.NoError(t, err)
+}
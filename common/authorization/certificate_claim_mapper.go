@@ -0,0 +1,226 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto/x509"
+	"fmt"
+	"path"
+	"strings"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	certificateMatchSAN = "san"
+	certificateMatchOU  = "ou"
+	certificateMatchCN  = "cn"
+)
+
+type (
+	// certificateClaimMapper adds the roles bound to the caller's client certificate to the claims of the
+	// wrapped claim mapper.
+	certificateClaimMapper struct {
+		ClaimMapper
+		bindings func() map[string]any
+		logger   log.Logger
+	}
+
+	certificateBinding struct {
+		field      string
+		pattern    string
+		system     Role
+		namespaces map[string]Role
+	}
+)
+
+var _ ClaimMapper = (*certificateClaimMapper)(nil)
+var _ ClaimMapperWithAuthInfoRequired = (*certificateClaimMapper)(nil)
+
+// NewCertificateClaimMapper wraps a claim mapper so that roles can be granted by client certificate. bindings
+// returns a map from a certificate match to the roles it grants, e.g.
+//
+//	"san:*.payments.example.com": {"namespaces": {"payments": ["write", "worker"]}}
+//	"ou:platform": {"system": ["admin"]}
+//
+// A match is "san:", "ou:" or "cn:" followed by a pattern in path.Match syntax, so "*" does not match "/".
+// SANs include DNS names, URIs, email and IP addresses. Roles from all matching bindings are added to the
+// wrapped claims. Bindings are read on every call so they can be changed with dynamic config.
+func NewCertificateClaimMapper(claimMapper ClaimMapper, bindings func() map[string]any, logger log.Logger) ClaimMapper {
+	return &certificateClaimMapper{
+		ClaimMapper: claimMapper,
+		bindings:    bindings,
+		logger:      log.NewThrottledLogger(logger, func() float64 { return 1 }),
+	}
+}
+
+func (m *certificateClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
+	claims, err := m.ClaimMapper.GetClaims(authInfo)
+	if err != nil {
+		return nil, err
+	}
+	cert := PeerCert(authInfo.TLSConnection)
+	if cert == nil {
+		return claims, nil
+	}
+	bindings := m.matchingBindings(cert)
+	if len(bindings) == 0 {
+		return claims, nil
+	}
+
+	// copy the claims so that roles are never added to claims shared by the wrapped claim mapper
+	merged := &Claims{Subject: cert.Subject.String(), Namespaces: make(map[string]Role)}
+	if claims != nil {
+		merged.Subject = claims.Subject
+		merged.System = claims.System
+		merged.Groups = claims.Groups
+		merged.Extensions = claims.Extensions
+		for namespace, role := range claims.Namespaces {
+			merged.Namespaces[namespace] = role
+		}
+	}
+	for _, binding := range bindings {
+		merged.System |= binding.system
+		for namespace, role := range binding.namespaces {
+			merged.Namespaces[namespace] |= role
+		}
+	}
+	return merged, nil
+}
+
+func (m *certificateClaimMapper) AuthInfoRequired() bool {
+	if cm, ok := m.ClaimMapper.(ClaimMapperWithAuthInfoRequired); ok {
+		return cm.AuthInfoRequired()
+	}
+	return true
+}
+
+func (m *certificateClaimMapper) matchingBindings(cert *x509.Certificate) []*certificateBinding {
+	var result []*certificateBinding
+	for match, value := range m.bindings() {
+		binding, err := parseCertificateBinding(match, value)
+		if err != nil {
+			m.logger.Warn("Ignoring invalid certificate binding", tag.NewStringTag("match", match), tag.Error(err))
+			continue
+		}
+		if binding.matches(cert) {
+			result = append(result, binding)
+		}
+	}
+	return result
+}
+
+func (b *certificateBinding) matches(cert *x509.Certificate) bool {
+	var values []string
+	switch b.field {
+	case certificateMatchSAN:
+		values = append(values, cert.DNSNames...)
+		values = append(values, cert.EmailAddresses...)
+		for _, uri := range cert.URIs {
+			values = append(values, uri.String())
+		}
+		for _, ip := range cert.IPAddresses {
+			values = append(values, ip.String())
+		}
+	case certificateMatchOU:
+		values = cert.Subject.OrganizationalUnit
+	case certificateMatchCN:
+		values = []string{cert.Subject.CommonName}
+	}
+	for _, value := range values {
+		if ok, _ := path.Match(b.pattern, value); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func parseCertificateBinding(match string, value any) (*certificateBinding, error) {
+	field, pattern, ok := strings.Cut(match, ":")
+	if !ok || pattern == "" {
+		return nil, fmt.Errorf("match must be <san|ou|cn>:<pattern>")
+	}
+	field = strings.ToLower(field)
+	if field != certificateMatchSAN && field != certificateMatchOU && field != certificateMatchCN {
+		return nil, fmt.Errorf("unknown certificate field %q", field)
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+	roles, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("roles must be a map")
+	}
+
+	binding := &certificateBinding{
+		field:      field,
+		pattern:    pattern,
+		namespaces: make(map[string]Role),
+	}
+	if system, ok := roles["system"]; ok {
+		role, err := parseCertificateBindingRole(system)
+		if err != nil {
+			return nil, err
+		}
+		binding.system = role
+	}
+	if namespaces, ok := roles["namespaces"]; ok {
+		namespaceRoles, ok := namespaces.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("namespaces must be a map")
+		}
+		for namespace, permissions := range namespaceRoles {
+			role, err := parseCertificateBindingRole(permissions)
+			if err != nil {
+				return nil, err
+			}
+			binding.namespaces[namespace] = role
+		}
+	}
+	return binding, nil
+}
+
+// parseCertificateBindingRole parses a permission or list of permissions, as used in JWT permission claims.
+func parseCertificateBindingRole(value any) (Role, error) {
+	var permissions []any
+	switch v := value.(type) {
+	case string:
+		permissions = []any{v}
+	case []any:
+		permissions = v
+	default:
+		return RoleUndefined, fmt.Errorf("permissions must be a string or a list")
+	}
+	role := RoleUndefined
+	for _, permission := range permissions {
+		p, ok := permission.(string)
+		if !ok || permissionToRole(p) == RoleUndefined {
+			return RoleUndefined, fmt.Errorf("invalid permission %v", permission)
+		}
+		role |= permissionToRole(p)
+	}
+	return role, nil
+}
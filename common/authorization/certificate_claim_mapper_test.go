@@ -0,0 +1,172 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/credentials"
+
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	certificateClaimMapperSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller      *gomock.Controller
+		mockClaimMapper *MockClaimMapper
+		bindings        map[string]any
+		claimMapper     ClaimMapper
+	}
+)
+
+func TestCertificateClaimMapperSuite(t *testing.T) {
+	s := new(certificateClaimMapperSuite)
+	suite.Run(t, s)
+}
+
+func (s *certificateClaimMapperSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockClaimMapper = NewMockClaimMapper(s.controller)
+	s.bindings = map[string]any{}
+	s.claimMapper = NewCertificateClaimMapper(s.mockClaimMapper, func() map[string]any { return s.bindings }, log.NewNoopLogger())
+}
+
+func (s *certificateClaimMapperSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *certificateClaimMapperSuite) authInfo(cert *x509.Certificate) *AuthInfo {
+	return &AuthInfo{
+		TLSSubject: &cert.Subject,
+		TLSConnection: &credentials.TLSInfo{
+			State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+		},
+	}
+}
+
+func (s *certificateClaimMapperSuite) TestNoCertificate() {
+	claims := &Claims{Subject: "alice", System: RoleReader}
+	s.mockClaimMapper.EXPECT().GetClaims(gomock.Any()).Return(claims, nil)
+	s.bindings["ou:platform"] = map[string]any{"system": "admin"}
+
+	result, err := s.claimMapper.GetClaims(&AuthInfo{})
+	s.NoError(err)
+	s.Equal(claims, result)
+}
+
+func (s *certificateClaimMapperSuite) TestSANBinding() {
+	uri, err := url.Parse("spiffe://example.com/billing")
+	s.NoError(err)
+	cert := &x509.Certificate{
+		Subject:  pkix.Name{CommonName: "worker"},
+		DNSNames: []string{"api.payments.example.com"},
+		URIs:     []*url.URL{uri},
+	}
+	s.bindings["san:*.payments.example.com"] = map[string]any{
+		"namespaces": map[string]any{"payments": []any{"write", "worker"}},
+	}
+	s.bindings["san:spiffe://example.com/billing"] = map[string]any{
+		"namespaces": map[string]any{"billing": "read", "payments": "admin"},
+	}
+	s.bindings["san:*.orders.example.com"] = map[string]any{
+		"namespaces": map[string]any{"orders": "admin"},
+	}
+	s.mockClaimMapper.EXPECT().GetClaims(gomock.Any()).Return(&Claims{}, nil)
+
+	claims, err := s.claimMapper.GetClaims(s.authInfo(cert))
+	s.NoError(err)
+	s.Equal(RoleUndefined, claims.System)
+	s.Equal(map[string]Role{
+		"payments": RoleWriter | RoleWorker | RoleAdmin,
+		"billing":  RoleReader,
+	}, claims.Namespaces)
+}
+
+func (s *certificateClaimMapperSuite) TestSubjectBindings() {
+	cert := &x509.Certificate{
+		Subject: pkix.Name{CommonName: "ops-1", OrganizationalUnit: []string{"platform"}},
+	}
+	s.bindings["OU:platform"] = map[string]any{"system": []any{"admin"}}
+	s.bindings["cn:ops-*"] = map[string]any{"namespaces": map[string]any{"ops": "write"}}
+	s.mockClaimMapper.EXPECT().GetClaims(gomock.Any()).Return(nil, nil)
+
+	claims, err := s.claimMapper.GetClaims(s.authInfo(cert))
+	s.NoError(err)
+	s.Equal(cert.Subject.String(), claims.Subject)
+	s.Equal(RoleAdmin, claims.System)
+	s.Equal(map[string]Role{"ops": RoleWriter}, claims.Namespaces)
+}
+
+func (s *certificateClaimMapperSuite) TestMergesWithoutModifyingClaims() {
+	cert := &x509.Certificate{DNSNames: []string{"api.payments.example.com"}}
+	s.bindings["san:api.payments.example.com"] = map[string]any{
+		"namespaces": map[string]any{"payments": "write"},
+	}
+	claims := &Claims{Subject: "alice", System: RoleReader, Namespaces: map[string]Role{"payments": RoleReader}}
+	s.mockClaimMapper.EXPECT().GetClaims(gomock.Any()).Return(claims, nil)
+
+	result, err := s.claimMapper.GetClaims(s.authInfo(cert))
+	s.NoError(err)
+	s.Equal("alice", result.Subject)
+	s.Equal(RoleReader, result.System)
+	s.Equal(map[string]Role{"payments": RoleReader | RoleWriter}, result.Namespaces)
+	s.Equal(map[string]Role{"payments": RoleReader}, claims.Namespaces)
+}
+
+func (s *certificateClaimMapperSuite) TestInvalidBindingsIgnored() {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "ops-1"}}
+	s.bindings["cn"] = map[string]any{"system": "admin"}
+	s.bindings["serial:ops-1"] = map[string]any{"system": "admin"}
+	s.bindings["cn:ops-1"] = map[string]any{"system": "superuser"}
+	s.bindings["cn:[ops"] = map[string]any{"system": "admin"}
+	s.bindings["cn:ops-*"] = "admin"
+	claims := &Claims{Subject: "alice"}
+	s.mockClaimMapper.EXPECT().GetClaims(gomock.Any()).Return(claims, nil)
+
+	result, err := s.claimMapper.GetClaims(s.authInfo(cert))
+	s.NoError(err)
+	s.Equal(claims, result)
+}
+
+func (s *certificateClaimMapperSuite) TestClaimMapperError() {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "ops-1"}}
+	s.bindings["cn:ops-1"] = map[string]any{"system": "admin"}
+	s.mockClaimMapper.EXPECT().GetClaims(gomock.Any()).Return(nil, errors.New("invalid token"))
+
+	_, err := s.claimMapper.GetClaims(s.authInfo(cert))
+	s.Error(err)
+}
@@ -41,6 +41,7 @@ const (
 	defaultPermissionsClaimName = "permissions"
 	authorizationBearer         = "bearer"
 	headerSubject               = "sub"
+	headerIssuer                = "iss"
 	permissionScopeSystem       = primitives.SystemLocalNamespace
 	permissionRead              = "read"
 	permissionWrite             = "write"
@@ -53,6 +54,10 @@ type defaultJWTClaimMapper struct {
 	keyProvider          TokenKeyProvider
 	logger               log.Logger
 	permissionsClaimName string
+	// issuers maps each accepted issuer to its accepted audiences; nil accepts any issuer
+	issuers         map[string][]string
+	roleClaims      []config.JWTRoleClaim
+	groupsClaimPath string
 }
 
 func NewDefaultJWTClaimMapper(provider TokenKeyProvider, cfg *config.Authorization, logger log.Logger) ClaimMapper {
@@ -60,7 +65,21 @@ func NewDefaultJWTClaimMapper(provider TokenKeyProvider, cfg *config.Authorizati
 	if claimName == "" {
 		claimName = defaultPermissionsClaimName
 	}
-	return &defaultJWTClaimMapper{keyProvider: provider, logger: logger, permissionsClaimName: claimName}
+	var issuers map[string][]string
+	if len(cfg.Issuers) > 0 {
+		issuers = make(map[string][]string, len(cfg.Issuers))
+		for _, issuer := range cfg.Issuers {
+			issuers[issuer.Issuer] = issuer.Audiences
+		}
+	}
+	return &defaultJWTClaimMapper{
+		keyProvider:          provider,
+		logger:               logger,
+		permissionsClaimName: claimName,
+		issuers:              issuers,
+		roleClaims:           cfg.RoleClaims,
+		groupsClaimPath:      cfg.GroupsClaimPath,
+	}
 }
 
 var _ ClaimMapper = (*defaultJWTClaimMapper)(nil)
@@ -84,6 +103,9 @@ func (a *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := a.verifyIssuer(jwtClaims); err != nil {
+		return nil, err
+	}
 	subject, ok := jwtClaims[headerSubject].(string)
 	if !ok {
 		return nil, serviceerror.NewPermissionDenied("unexpected value type of \"sub\" claim", "")
@@ -96,9 +118,75 @@ func (a *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
 			return nil, err
 		}
 	}
+	if err := a.extractPermissions(a.mapRoleClaims(jwtClaims), &claims); err != nil {
+		return nil, err
+	}
+	if a.groupsClaimPath != "" {
+		claims.Groups = claimValuesAtPath(jwtClaims, a.groupsClaimPath)
+	}
 	return &claims, nil
 }
 
+// verifyIssuer checks the token issuer and the audiences configured for it.
+func (a *defaultJWTClaimMapper) verifyIssuer(jwtClaims jwt.MapClaims) error {
+	if a.issuers == nil {
+		return nil
+	}
+	issuer, _ := jwtClaims[headerIssuer].(string)
+	audiences, ok := a.issuers[issuer]
+	if !ok {
+		return serviceerror.NewPermissionDenied("unexpected token issuer", "")
+	}
+	if len(audiences) == 0 {
+		return nil
+	}
+	for _, audience := range audiences {
+		if jwtClaims.VerifyAudience(audience, true) {
+			return nil
+		}
+	}
+	return serviceerror.NewPermissionDenied("audience mismatch", "")
+}
+
+// mapRoleClaims returns the permissions granted by the configured role claims.
+func (a *defaultJWTClaimMapper) mapRoleClaims(jwtClaims jwt.MapClaims) []interface{} {
+	var permissions []interface{}
+	for _, roleClaim := range a.roleClaims {
+		for _, value := range claimValuesAtPath(jwtClaims, roleClaim.Path) {
+			for _, permission := range roleClaim.Permissions[value] {
+				permissions = append(permissions, permission)
+			}
+		}
+	}
+	return permissions
+}
+
+// claimValuesAtPath returns the string values of the claim at a dot separated path.
+func claimValuesAtPath(jwtClaims jwt.MapClaims, path string) []string {
+	var value interface{} = map[string]interface{}(jwtClaims)
+	for _, key := range strings.Split(path, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = object[key]
+	}
+
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
 func (a *defaultJWTClaimMapper) extractPermissions(permissions []interface{}, claims *Claims) error {
 	for _, permission := range permissions {
 		p, ok := permission.(string)
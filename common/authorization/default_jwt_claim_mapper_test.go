@@ -263,6 +263,91 @@ func (s *defaultClaimMapperSuite) TestIgnoreAudience() {
 	s.NoError(err)
 }
 
+func (s *defaultClaimMapperSuite) TestIssuers() {
+	tokenString, err := s.tokenGenerator.generateRSAToken(testSubject, permissionsAdmin, errorTestOptionNoError)
+	s.NoError(err)
+	authInfo := &AuthInfo{AuthToken: AddBearer(tokenString)}
+
+	testCases := []struct {
+		issuers []config.JWTIssuer
+		valid   bool
+	}{
+		{issuers: []config.JWTIssuer{{Issuer: "other"}, {Issuer: "test"}}, valid: true},
+		{issuers: []config.JWTIssuer{{Issuer: "test", Audiences: []string{"foo", "test-audience"}}}, valid: true},
+		{issuers: []config.JWTIssuer{{Issuer: "other"}}, valid: false},
+		{issuers: []config.JWTIssuer{{Issuer: "test", Audiences: []string{"foo"}}}, valid: false},
+	}
+	for _, tc := range testCases {
+		claimMapper := NewDefaultJWTClaimMapper(s.tokenGenerator, &config.Authorization{Issuers: tc.issuers}, s.logger)
+		claims, err := claimMapper.GetClaims(authInfo)
+		if tc.valid {
+			s.NoError(err)
+			s.Equal(testSubject, claims.Subject)
+		} else {
+			s.Error(err)
+		}
+	}
+}
+
+func (s *defaultClaimMapperSuite) TestRoleClaims() {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"sub":    testSubject,
+		"groups": "temporal-admins",
+		"realm_access": map[string]interface{}{
+			"roles": []string{"team-a", "unknown"},
+		},
+	})
+	token.Header["kid"] = "test-key"
+	tokenString, err := token.SignedString(s.tokenGenerator.rsaPrivateKey)
+	s.NoError(err)
+
+	claimMapper := NewDefaultJWTClaimMapper(s.tokenGenerator, &config.Authorization{
+		RoleClaims: []config.JWTRoleClaim{
+			{
+				Path:        "groups",
+				Permissions: map[string][]string{"temporal-admins": {primitives.SystemLocalNamespace + ":admin"}},
+			},
+			{
+				Path: "realm_access.roles",
+				Permissions: map[string][]string{
+					"team-a": {defaultNamespace + ":write", defaultNamespace + ":worker"},
+					"team-b": {"other:read"},
+				},
+			},
+			{
+				Path:        "groups.missing",
+				Permissions: map[string][]string{"temporal-admins": {"other:read"}},
+			},
+		},
+	}, s.logger)
+	claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(tokenString)})
+	s.NoError(err)
+	s.Equal(RoleAdmin, claims.System)
+	s.Equal(map[string]Role{defaultNamespace: RoleWriter | RoleWorker}, claims.Namespaces)
+	s.Empty(claims.Groups)
+}
+
+func (s *defaultClaimMapperSuite) TestGroupsClaim() {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"sub": testSubject,
+		"realm_access": map[string]interface{}{
+			"groups": []string{"payments-oncall", "payments-dev"},
+		},
+	})
+	token.Header["kid"] = "test-key"
+	tokenString, err := token.SignedString(s.tokenGenerator.rsaPrivateKey)
+	s.NoError(err)
+
+	claimMapper := NewDefaultJWTClaimMapper(s.tokenGenerator, &config.Authorization{
+		GroupsClaimPath: "realm_access.groups",
+	}, s.logger)
+	claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(tokenString)})
+	s.NoError(err)
+	s.Equal([]string{"payments-oncall", "payments-dev"}, claims.Groups)
+	s.Equal(RoleUndefined, claims.System)
+	s.Empty(claims.Namespaces)
+}
+
 func (s *defaultClaimMapperSuite) testGetClaimMapperFromConfig(name string, valid bool, cmType reflect.Type) {
 
 	cfg := config.Authorization{}
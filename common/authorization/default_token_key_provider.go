@@ -43,6 +43,9 @@ import (
 	"go.temporal.io/server/common/log/tag"
 )
 
+// minimumKeyRefreshInterval limits how often an unknown key ID triggers a refresh of the keys
+const minimumKeyRefreshInterval = 30 * time.Second
+
 // Default token key provider
 type defaultTokenKeyProvider struct {
 	config   config.JWTKeyProvider
@@ -52,6 +55,15 @@ type defaultTokenKeyProvider struct {
 	ticker   *time.Ticker
 	logger   log.Logger
 	stop     chan bool
+
+	// updateLock serializes key updates and protects lastUpdate
+	updateLock sync.Mutex
+	lastUpdate time.Time
+}
+
+// oidcDiscoveryDocument holds the fields of OIDC provider metadata used to locate signing keys
+type oidcDiscoveryDocument struct {
+	JWKSURI string `json:"jwks_uri"`
 }
 
 var _ TokenKeyProvider = (*defaultTokenKeyProvider)(nil)
@@ -89,29 +101,63 @@ func (a *defaultTokenKeyProvider) RsaKey(alg string, kid string) (*rsa.PublicKey
 		return nil, fmt.Errorf("unexpected signing algorithm: %s", alg)
 	}
 
-	a.keysLock.RLock()
-	key, found := a.rsaKeys[kid]
-	a.keysLock.RUnlock()
+	key, found := a.getRsaKey(kid)
+	if !found && a.refreshOnUnknownKey() {
+		key, found = a.getRsaKey(kid)
+	}
 	if !found {
 		return nil, fmt.Errorf("RSA key not found for key ID: %s", kid)
 	}
 	return key, nil
 }
 
+func (a *defaultTokenKeyProvider) getRsaKey(kid string) (*rsa.PublicKey, bool) {
+	a.keysLock.RLock()
+	defer a.keysLock.RUnlock()
+	key, found := a.rsaKeys[kid]
+	return key, found
+}
+
 func (a *defaultTokenKeyProvider) EcdsaKey(alg string, kid string) (*ecdsa.PublicKey, error) {
 	if !strings.EqualFold(alg, jwt.SigningMethodES256.Name) {
 		return nil, fmt.Errorf("unexpected signing algorithm: %s", alg)
 	}
 
-	a.keysLock.RLock()
-	key, found := a.ecKeys[kid]
-	a.keysLock.RUnlock()
+	key, found := a.getEcdsaKey(kid)
+	if !found && a.refreshOnUnknownKey() {
+		key, found = a.getEcdsaKey(kid)
+	}
 	if !found {
 		return nil, fmt.Errorf("ECDSA key not found for key ID: %s", kid)
 	}
 	return key, nil
 }
 
+func (a *defaultTokenKeyProvider) getEcdsaKey(kid string) (*ecdsa.PublicKey, bool) {
+	a.keysLock.RLock()
+	defer a.keysLock.RUnlock()
+	key, found := a.ecKeys[kid]
+	return key, found
+}
+
+// refreshOnUnknownKey picks up rotated keys without waiting for the next refresh interval.
+// It returns true if the keys may have changed since the caller looked them up.
+func (a *defaultTokenKeyProvider) refreshOnUnknownKey() bool {
+	if !a.config.HasSourceURIsConfigured() {
+		return false
+	}
+	a.updateLock.Lock()
+	recentlyUpdated := time.Since(a.lastUpdate) < minimumKeyRefreshInterval
+	a.updateLock.Unlock()
+	if recentlyUpdated {
+		return false
+	}
+	if err := a.updateKeys(); err != nil {
+		a.logger.Error("error while refreshing token keys for unknown key ID: ", tag.Error(err))
+	}
+	return true
+}
+
 func (a *defaultTokenKeyProvider) SupportedMethods() []string {
 	return []string{jwt.SigningMethodRS256.Name, jwt.SigningMethodES256.Name}
 }
@@ -137,6 +183,10 @@ func (a *defaultTokenKeyProvider) updateKeys() error {
 		return fmt.Errorf("no URIs configured for retrieving token keys")
 	}
 
+	a.updateLock.Lock()
+	defer a.updateLock.Unlock()
+	a.lastUpdate = time.Now()
+
 	rsaKeys := make(map[string]*rsa.PublicKey)
 	ecKeys := make(map[string]*ecdsa.PublicKey)
 
@@ -149,6 +199,19 @@ func (a *defaultTokenKeyProvider) updateKeys() error {
 			return err
 		}
 	}
+	for _, uri := range a.config.DiscoveryURIs {
+		if strings.TrimSpace(uri) == "" {
+			continue
+		}
+		jwksURI, err := a.discoverJWKSURI(uri)
+		if err != nil {
+			return err
+		}
+		err = a.updateKeysFromURI(jwksURI, rsaKeys, ecKeys)
+		if err != nil {
+			return err
+		}
+	}
 	// swap old keys with the new ones
 	a.keysLock.Lock()
 	a.rsaKeys = rsaKeys
@@ -190,6 +253,28 @@ func (a *defaultTokenKeyProvider) updateKeysFromURI(
 	return nil
 }
 
+func (a *defaultTokenKeyProvider) discoverJWKSURI(uri string) (_ string, err error) {
+	resp, err := http.Get(uri)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		err = multierr.Combine(err, resp.Body.Close())
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("OIDC discovery from %s returned status %d", uri, resp.StatusCode)
+	}
+
+	var doc oidcDiscoveryDocument
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return "", err
+	}
+	if doc.JWKSURI == "" {
+		return "", fmt.Errorf("OIDC discovery document from %s has no jwks_uri", uri)
+	}
+	return doc.JWKSURI, nil
+}
+
 func (a *defaultTokenKeyProvider) HmacKey(alg string, kid string) ([]byte, error) {
 	return nil, fmt.Errorf("unsupported key type HMAC for: %s", alg)
 }
@@ -0,0 +1,92 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/square/go-jose.v2"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+func TestDefaultTokenKeyProvider_DiscoveryAndRotation(t *testing.T) {
+	keyA, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keyB, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwks := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: &keyA.PublicKey, KeyID: "a", Algorithm: "RS256", Use: "sig"}}}
+	jwksRequests := 0
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   server.URL,
+			"jwks_uri": server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		jwksRequests++
+		_ = json.NewEncoder(w).Encode(jwks)
+	})
+
+	provider := NewDefaultTokenKeyProvider(&config.Authorization{
+		JWTKeyProvider: config.JWTKeyProvider{
+			DiscoveryURIs: []string{server.URL + "/.well-known/openid-configuration"},
+		},
+	}, log.NewNoopLogger())
+
+	key, err := provider.RsaKey("RS256", "a")
+	require.NoError(t, err)
+	require.Equal(t, &keyA.PublicKey, key)
+	require.Equal(t, 1, jwksRequests)
+
+	// The provider rotates to key B. An unknown key ID is not refreshed again right after an update.
+	jwks.Keys = []jose.JSONWebKey{{Key: &keyB.PublicKey, KeyID: "b", Algorithm: "RS256", Use: "sig"}}
+	_, err = provider.RsaKey("RS256", "b")
+	require.Error(t, err)
+	require.Equal(t, 1, jwksRequests)
+
+	provider.updateLock.Lock()
+	provider.lastUpdate = time.Now().Add(-minimumKeyRefreshInterval)
+	provider.updateLock.Unlock()
+	key, err = provider.RsaKey("RS256", "b")
+	require.NoError(t, err)
+	require.Equal(t, &keyB.PublicKey, key)
+	require.Equal(t, 2, jwksRequests)
+
+	_, err = provider.RsaKey("RS256", "a")
+	require.Error(t, err)
+}
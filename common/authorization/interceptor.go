@@ -70,7 +70,37 @@ func (a *interceptor) Interceptor(
 	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler,
 ) (interface{}, error) {
+	ctx, err := a.authorizeCall(ctx, req, info)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
 
+// StreamInterceptor authorizes a streaming call once, when the stream is opened. The call has no request
+// since its messages are received by the handler, so it is authorized without a namespace.
+func (a *interceptor) StreamInterceptor(
+	service interface{},
+	serverStream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, err := a.authorizeCall(serverStream.Context(), nil, &grpc.UnaryServerInfo{
+		Server:     service,
+		FullMethod: info.FullMethod,
+	})
+	if err != nil {
+		return err
+	}
+	return handler(service, &authorizedServerStream{ServerStream: serverStream, ctx: ctx})
+}
+
+// authorizeCall maps the claims of the caller and authorizes the call. It returns ctx with the mapped claims.
+func (a *interceptor) authorizeCall(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+) (context.Context, error) {
 	var claims *Claims
 
 	if a.claimMapper != nil && a.authorizer != nil {
@@ -118,7 +148,7 @@ func (a *interceptor) Interceptor(
 			mappedClaims, err := a.claimMapper.GetClaims(&authInfo)
 			if err != nil {
 				a.logAuthError(err)
-				return nil, errUnauthorized // return a generic error to the caller without disclosing details
+				return ctx, errUnauthorized // return a generic error to the caller without disclosing details
 			}
 			claims = mappedClaims
 			ctx = context.WithValue(ctx, MappedClaims, mappedClaims)
@@ -144,18 +174,18 @@ func (a *interceptor) Interceptor(
 		if err != nil {
 			handler.Counter(metrics.ServiceErrAuthorizeFailedCounter.GetMetricName()).Record(1)
 			a.logAuthError(err)
-			return nil, errUnauthorized // return a generic error to the caller without disclosing details
+			return ctx, errUnauthorized // return a generic error to the caller without disclosing details
 		}
 		if result.Decision != DecisionAllow {
 			handler.Counter(metrics.ServiceErrUnauthorizedCounter.GetMetricName()).Record(1)
 			// if a reason is included in the result, include it in the error message
 			if result.Reason != "" {
-				return nil, serviceerror.NewPermissionDenied(RequestUnauthorized, result.Reason)
+				return ctx, serviceerror.NewPermissionDenied(RequestUnauthorized, result.Reason)
 			}
-			return nil, errUnauthorized // return a generic error to the caller without disclosing details
+			return ctx, errUnauthorized // return a generic error to the caller without disclosing details
 		}
 	}
-	return handler(ctx, req)
+	return ctx, nil
 }
 
 func (a *interceptor) authorize(
@@ -182,6 +212,16 @@ type interceptor struct {
 	audienceGetter JWTAudienceMapper
 }
 
+// authorizedServerStream is a server stream whose context carries the mapped claims of the caller.
+type authorizedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedServerStream) Context() context.Context {
+	return s.ctx
+}
+
 // NewAuthorizationInterceptor creates an authorization interceptor and return a func that points to its Interceptor method
 func NewAuthorizationInterceptor(
 	claimMapper ClaimMapper,
@@ -199,6 +239,24 @@ func NewAuthorizationInterceptor(
 	}).Interceptor
 }
 
+// NewAuthorizationStreamInterceptor creates an authorization interceptor and return a func that points to its
+// StreamInterceptor method
+func NewAuthorizationStreamInterceptor(
+	claimMapper ClaimMapper,
+	authorizer Authorizer,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+	audienceGetter JWTAudienceMapper,
+) grpc.StreamServerInterceptor {
+	return (&interceptor{
+		claimMapper:    claimMapper,
+		authorizer:     authorizer,
+		metricsHandler: metricsHandler,
+		logger:         logger,
+		audienceGetter: audienceGetter,
+	}).StreamInterceptor
+}
+
 // getMetricsHandler return metrics handler with namespace tag
 func (a *interceptor) getMetricsHandler(
 	operation string,
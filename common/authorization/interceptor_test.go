@@ -51,6 +51,7 @@ var (
 	startWorkflowExecutionRequest = &workflowservice.StartWorkflowExecutionRequest{Namespace: testNamespace}
 	startWorkflowExecutionTarget  = &CallTarget{Namespace: testNamespace, Request: startWorkflowExecutionRequest, APIName: "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"}
 	startWorkflowExecutionInfo    = &grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"}
+	streamInfo                    = &grpc.StreamServerInfo{FullMethod: "/temporal.server.api.adminservice.v1.AdminService/StreamWorkflowReplicationMessages"}
 )
 
 type (
@@ -153,3 +154,49 @@ func (s *authorizerInterceptorSuite) TestNoopClaimMapperWithoutTLS() {
 	_, err := interceptor(ctx, describeNamespaceRequest, describeNamespaceInfo, s.handler)
 	s.NoError(err)
 }
+
+func (s *authorizerInterceptorSuite) TestStreamIsAuthorized() {
+	admin := &Claims{System: RoleAdmin}
+	streamTarget := &CallTarget{APIName: streamInfo.FullMethod}
+	s.mockAuthorizer.EXPECT().Authorize(gomock.Any(), admin, streamTarget).
+		Return(Result{Decision: DecisionAllow}, nil)
+
+	interceptor := NewAuthorizationStreamInterceptor(
+		NewNoopClaimMapper(),
+		s.mockAuthorizer,
+		s.mockMetricsHandler,
+		log.NewNoopLogger(),
+		nil)
+	err := interceptor(nil, &testServerStream{ctx: ctx}, streamInfo, func(_ interface{}, stream grpc.ServerStream) error {
+		s.Equal(admin, stream.Context().Value(MappedClaims))
+		return nil
+	})
+	s.NoError(err)
+}
+
+func (s *authorizerInterceptorSuite) TestStreamIsUnauthorized() {
+	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, &CallTarget{APIName: streamInfo.FullMethod}).
+		Return(Result{Decision: DecisionDeny}, nil)
+	s.mockMetricsHandler.EXPECT().Counter(metrics.ServiceErrUnauthorizedCounter.GetMetricName()).Return(metrics.NoopCounterMetricFunc)
+
+	interceptor := NewAuthorizationStreamInterceptor(
+		s.mockClaimMapper,
+		s.mockAuthorizer,
+		s.mockMetricsHandler,
+		log.NewNoopLogger(),
+		nil)
+	err := interceptor(nil, &testServerStream{ctx: ctx}, streamInfo, func(interface{}, grpc.ServerStream) error {
+		s.Fail("stream handler called for an unauthorized call")
+		return nil
+	})
+	s.Error(err)
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,249 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	defaultOPATimeout      = 2 * time.Second
+	defaultOPACacheMaxSize = 10000
+)
+
+type (
+	// opaAuthorizer delegates decisions to an Open Policy Agent server through its data API.
+	// The policy decision document must be either a boolean or an object with a boolean
+	// "allow" field and an optional "reason" string. An undefined decision is a deny.
+	opaAuthorizer struct {
+		endpoint   string
+		httpClient *http.Client
+		cache      cache.Cache
+	}
+
+	opaRequest struct {
+		Input *opaInput `json:"input"`
+	}
+
+	opaInput struct {
+		Subject          string              `json:"subject"`
+		System           []string            `json:"system"`
+		Namespaces       map[string][]string `json:"namespaces"`
+		API              string              `json:"api"`
+		APIName          string              `json:"apiName"`
+		Namespace        string              `json:"namespace"`
+		SearchAttributes []string            `json:"searchAttributes"`
+	}
+
+	opaResponse struct {
+		Result json.RawMessage `json:"result"`
+	}
+
+	opaDecision struct {
+		Allow  bool   `json:"allow"`
+		Reason string `json:"reason"`
+	}
+
+	hasSearchAttributes interface {
+		GetSearchAttributes() *commonpb.SearchAttributes
+	}
+)
+
+var _ Authorizer = (*opaAuthorizer)(nil)
+
+// NewOPAAuthorizer creates an authorizer that queries the OPA policy decision at cfg.Endpoint.
+func NewOPAAuthorizer(cfg *config.OPAAuthorizer) (Authorizer, error) {
+	if cfg.Endpoint == "" {
+		return nil, fmt.Errorf("opa authorizer requires an endpoint")
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultOPATimeout
+	}
+	a := &opaAuthorizer{
+		endpoint:   cfg.Endpoint,
+		httpClient: &http.Client{Timeout: timeout},
+	}
+	if cfg.CacheTTL > 0 {
+		maxSize := cfg.CacheMaxSize
+		if maxSize <= 0 {
+			maxSize = defaultOPACacheMaxSize
+		}
+		a.cache = cache.New(maxSize, &cache.Options{TTL: cfg.CacheTTL})
+	}
+	return a, nil
+}
+
+// Authorize sends the caller's claims and the call target to OPA and returns its decision.
+// Health check APIs are always allowed without consulting the policy.
+func (a *opaAuthorizer) Authorize(ctx context.Context, claims *Claims, target *CallTarget) (Result, error) {
+	if IsHealthCheckAPI(target.APIName) {
+		return resultAllow, nil
+	}
+
+	body, err := json.Marshal(opaRequest{Input: newOPAInput(claims, target)})
+	if err != nil {
+		return resultDeny, err
+	}
+
+	cacheKey := string(body)
+	if a.cache != nil {
+		if cached, ok := a.cache.Get(cacheKey).(Result); ok {
+			return cached, nil
+		}
+	}
+
+	result, err := a.query(ctx, body)
+	if err != nil {
+		return resultDeny, err
+	}
+	if a.cache != nil {
+		a.cache.Put(cacheKey, result)
+	}
+	return result, nil
+}
+
+func (a *opaAuthorizer) query(ctx context.Context, body []byte) (Result, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return resultDeny, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return resultDeny, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return resultDeny, fmt.Errorf("opa returned status %d", resp.StatusCode)
+	}
+
+	var opaResp opaResponse
+	if err := json.NewDecoder(resp.Body).Decode(&opaResp); err != nil {
+		return resultDeny, fmt.Errorf("unable to decode opa response: %w", err)
+	}
+	if len(opaResp.Result) == 0 {
+		return Result{Decision: DecisionDeny, Reason: "policy decision is undefined"}, nil
+	}
+
+	var allow bool
+	if err := json.Unmarshal(opaResp.Result, &allow); err == nil {
+		if allow {
+			return resultAllow, nil
+		}
+		return resultDeny, nil
+	}
+	var decision opaDecision
+	if err := json.Unmarshal(opaResp.Result, &decision); err != nil {
+		return resultDeny, fmt.Errorf("unable to decode opa policy decision: %w", err)
+	}
+	if decision.Allow {
+		return Result{Decision: DecisionAllow, Reason: decision.Reason}, nil
+	}
+	return Result{Decision: DecisionDeny, Reason: decision.Reason}, nil
+}
+
+func newOPAInput(claims *Claims, target *CallTarget) *opaInput {
+	input := &opaInput{
+		API:              ApiName(target.APIName),
+		APIName:          target.APIName,
+		Namespace:        target.Namespace,
+		SearchAttributes: searchAttributeNames(target.Request),
+	}
+	if claims != nil {
+		input.Subject = claims.Subject
+		input.System = roleNames(claims.System)
+		input.Namespaces = make(map[string][]string, len(claims.Namespaces))
+		for ns, role := range claims.Namespaces {
+			input.Namespaces[ns] = roleNames(role)
+		}
+	}
+	return input
+}
+
+// searchAttributeNames returns the sorted names of the search attributes that the request sets,
+// including those set by workflow task commands.
+func searchAttributeNames(request interface{}) []string {
+	var searchAttributes []*commonpb.SearchAttributes
+	switch r := request.(type) {
+	case *workflowservice.RespondWorkflowTaskCompletedRequest:
+		for _, command := range r.GetCommands() {
+			searchAttributes = append(searchAttributes,
+				command.GetUpsertWorkflowSearchAttributesCommandAttributes().GetSearchAttributes(),
+				command.GetStartChildWorkflowExecutionCommandAttributes().GetSearchAttributes(),
+				command.GetContinueAsNewWorkflowExecutionCommandAttributes().GetSearchAttributes(),
+			)
+		}
+	case hasSearchAttributes:
+		searchAttributes = append(searchAttributes, r.GetSearchAttributes())
+	}
+
+	names := make(map[string]struct{})
+	for _, sa := range searchAttributes {
+		for name := range sa.GetIndexedFields() {
+			names[name] = struct{}{}
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	result := make([]string, 0, len(names))
+	for name := range names {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func roleNames(role Role) []string {
+	var names []string
+	if role&RoleWorker != 0 {
+		names = append(names, "worker")
+	}
+	if role&RoleReader != 0 {
+		names = append(names, "reader")
+	}
+	if role&RoleWriter != 0 {
+		names = append(names, "writer")
+	}
+	if role&RoleAdmin != 0 {
+		names = append(names, "admin")
+	}
+	return names
+}
@@ -0,0 +1,186 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/common/config"
+)
+
+type (
+	opaAuthorizerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		server    *httptest.Server
+		inputs    []opaInput
+		responses []string
+	}
+)
+
+func TestOPAAuthorizerSuite(t *testing.T) {
+	s := new(opaAuthorizerSuite)
+	suite.Run(t, s)
+}
+
+func (s *opaAuthorizerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.inputs = nil
+	s.responses = nil
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Input opaInput `json:"input"`
+		}
+		s.NoError(json.NewDecoder(r.Body).Decode(&req))
+		s.inputs = append(s.inputs, req.Input)
+		response := s.responses[0]
+		s.responses = s.responses[1:]
+		_, _ = w.Write([]byte(response))
+	}))
+}
+
+func (s *opaAuthorizerSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *opaAuthorizerSuite) newAuthorizer(cacheTTL time.Duration) Authorizer {
+	authorizer, err := GetAuthorizerFromConfig(&config.Authorization{
+		Authorizer: "opa",
+		OPA:        config.OPAAuthorizer{Endpoint: s.server.URL, CacheTTL: cacheTTL},
+	})
+	s.NoError(err)
+	return authorizer
+}
+
+func (s *opaAuthorizerSuite) TestRequiresEndpoint() {
+	authorizer, err := GetAuthorizerFromConfig(&config.Authorization{Authorizer: "opa"})
+	s.Error(err)
+	s.Nil(authorizer)
+}
+
+func (s *opaAuthorizerSuite) TestInput() {
+	s.responses = []string{`{"result": true}`}
+	authorizer := s.newAuthorizer(0)
+
+	claims := &Claims{
+		Subject: "alice",
+		System:  RoleReader,
+		Namespaces: map[string]Role{
+			testNamespace: RoleWriter | RoleWorker,
+		},
+	}
+	target := &CallTarget{
+		APIName:   "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution",
+		Namespace: testNamespace,
+		Request: &workflowservice.StartWorkflowExecutionRequest{
+			SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+				"CustomKeywordField": {},
+				"CustomIntField":     {},
+			}},
+		},
+	}
+	result, err := authorizer.Authorize(context.Background(), claims, target)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	s.Equal([]opaInput{{
+		Subject:          "alice",
+		System:           []string{"reader"},
+		Namespaces:       map[string][]string{testNamespace: {"worker", "writer"}},
+		API:              "StartWorkflowExecution",
+		APIName:          target.APIName,
+		Namespace:        testNamespace,
+		SearchAttributes: []string{"CustomIntField", "CustomKeywordField"},
+	}}, s.inputs)
+}
+
+func (s *opaAuthorizerSuite) TestDecisions() {
+	s.responses = []string{
+		`{"result": false}`,
+		`{"result": {"allow": true, "reason": "owner"}}`,
+		`{"result": {"allow": false, "reason": "not owner"}}`,
+		`{}`,
+	}
+	authorizer := s.newAuthorizer(0)
+
+	expected := []Result{
+		{Decision: DecisionDeny},
+		{Decision: DecisionAllow, Reason: "owner"},
+		{Decision: DecisionDeny, Reason: "not owner"},
+		{Decision: DecisionDeny, Reason: "policy decision is undefined"},
+	}
+	for _, e := range expected {
+		result, err := authorizer.Authorize(context.Background(), &claimsNamespaceAdmin, &targetFooBar)
+		s.NoError(err)
+		s.Equal(e, result)
+	}
+}
+
+func (s *opaAuthorizerSuite) TestHealthCheckSkipsPolicy() {
+	authorizer := s.newAuthorizer(0)
+	result, err := authorizer.Authorize(context.Background(), nil, &CallTarget{APIName: "/grpc.health.v1.Health/Check"})
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+	s.Empty(s.inputs)
+}
+
+func (s *opaAuthorizerSuite) TestCache() {
+	s.responses = []string{`{"result": true}`, `{"result": false}`}
+	authorizer := s.newAuthorizer(time.Minute)
+
+	for i := 0; i < 3; i++ {
+		result, err := authorizer.Authorize(context.Background(), &claimsNamespaceAdmin, &targetFooBar)
+		s.NoError(err)
+		s.Equal(DecisionAllow, result.Decision)
+	}
+	s.Len(s.inputs, 1)
+
+	result, err := authorizer.Authorize(context.Background(), &claimsBarAdmin, &targetFooBar)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+	s.Len(s.inputs, 2)
+}
+
+func (s *opaAuthorizerSuite) TestServerError() {
+	s.server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	authorizer := s.newAuthorizer(time.Minute)
+
+	result, err := authorizer.Authorize(context.Background(), &claimsNamespaceAdmin, &targetFooBar)
+	s.Error(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
@@ -0,0 +1,239 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	// PermissionStart allows starting workflows and creating schedules
+	PermissionStart = Permission("start")
+	// PermissionSignal allows signalling and updating workflows
+	PermissionSignal = Permission("signal")
+	// PermissionQuery allows the read-only APIs, including queries
+	PermissionQuery = Permission("query")
+	// PermissionAdmin allows every workflow service API and implies start, signal and query
+	PermissionAdmin = Permission("admin")
+	// PermissionOperator allows the operator and admin service APIs
+	PermissionOperator = Permission("operator")
+
+	roleBindingsCacheRefreshInterval              = 10 * time.Second
+	roleBindingsCacheRefreshIfUnavailableInterval = 5 * time.Second
+)
+
+type (
+	// Permission is a fine-grained permission granted by a NamedRole
+	Permission string
+
+	// NamedRole is a set of permissions that can be assigned to identity groups per namespace, or cluster-wide.
+	// Named roles and their assignments are stored in the RoleBindings of the current cluster metadata.
+	NamedRole struct {
+		Name        string
+		Permissions []Permission
+	}
+
+	// GroupRoles maps identity groups to the names of the roles they have in a namespace
+	GroupRoles map[string][]string
+
+	// rbacAuthorizer allows calls denied by the wrapped authorizer if a named role of one of the caller's
+	// groups grants the permission required by the API.
+	rbacAuthorizer struct {
+		Authorizer
+		clusterMetadataManager persistence.ClusterMetadataManager
+		timeSource             clock.TimeSource
+		enabled                func() bool
+
+		cacheUpdateMutex sync.Mutex
+		cache            atomic.Value // of type roleBindingsCache
+	}
+
+	roleBindingsCache struct {
+		roleBindings *persistencespb.RoleBindings
+		expireOn     time.Time
+	}
+)
+
+var _ Authorizer = (*rbacAuthorizer)(nil)
+
+var (
+	startAPIs = map[string]struct{}{
+		"StartWorkflowExecution":           {},
+		"SignalWithStartWorkflowExecution": {},
+		"CreateSchedule":                   {},
+	}
+	signalAPIs = map[string]struct{}{
+		"SignalWorkflowExecution":          {},
+		"SignalWithStartWorkflowExecution": {},
+		"UpdateWorkflowExecution":          {},
+	}
+)
+
+// IsValid returns true for the known permissions.
+func (p Permission) IsValid() bool {
+	switch p {
+	case PermissionStart, PermissionSignal, PermissionQuery, PermissionAdmin, PermissionOperator:
+		return true
+	}
+	return false
+}
+
+// RequiredPermissions returns the permissions a named role must grant for a call to the fully qualified API.
+func RequiredPermissions(fullAPIName string) []Permission {
+	if strings.HasPrefix(fullAPIName, adminServicePrefix) || strings.HasPrefix(fullAPIName, operatorServicePrefix) {
+		return []Permission{PermissionOperator}
+	}
+	api := ApiName(fullAPIName)
+	if IsReadOnlyNamespaceAPI(api) || IsReadOnlyGlobalAPI(api) {
+		return []Permission{PermissionQuery}
+	}
+	var permissions []Permission
+	if _, ok := startAPIs[api]; ok {
+		permissions = append(permissions, PermissionStart)
+	}
+	if _, ok := signalAPIs[api]; ok {
+		permissions = append(permissions, PermissionSignal)
+	}
+	if len(permissions) == 0 {
+		permissions = append(permissions, PermissionAdmin)
+	}
+	return permissions
+}
+
+// NewRBACAuthorizer wraps an authorizer so that, while enabled returns true, calls it denies are allowed if a
+// named role assigned to one of the caller's groups grants the required permissions. Roles assigned in the
+// target namespace and roles assigned cluster-wide are considered; calls that don't target a namespace only
+// consider the latter. Role bindings are read from the current cluster metadata and cached for
+// roleBindingsCacheRefreshInterval.
+func NewRBACAuthorizer(
+	authorizer Authorizer,
+	clusterMetadataManager persistence.ClusterMetadataManager,
+	timeSource clock.TimeSource,
+	enabled func() bool,
+) Authorizer {
+	a := &rbacAuthorizer{
+		Authorizer:             authorizer,
+		clusterMetadataManager: clusterMetadataManager,
+		timeSource:             timeSource,
+		enabled:                enabled,
+	}
+	a.cache.Store(roleBindingsCache{})
+	return a
+}
+
+func (a *rbacAuthorizer) Authorize(ctx context.Context, claims *Claims, target *CallTarget) (Result, error) {
+	result, err := a.Authorizer.Authorize(ctx, claims, target)
+	if err != nil || result.Decision == DecisionAllow {
+		return result, err
+	}
+	if !a.enabled() || claims == nil || len(claims.Groups) == 0 {
+		return result, nil
+	}
+
+	roleBindings, err := a.getRoleBindings()
+	if err != nil {
+		return result, err
+	}
+	granted := groupPermissions(roleBindings, claims.Groups, target.Namespace)
+	for _, permission := range RequiredPermissions(target.APIName) {
+		if _, ok := granted[permission]; !ok {
+			return result, nil
+		}
+	}
+	return resultAllow, nil
+}
+
+func (a *rbacAuthorizer) getRoleBindings() (*persistencespb.RoleBindings, error) {
+	cache := a.cache.Load().(roleBindingsCache)
+	if a.timeSource.Now().Before(cache.expireOn) {
+		return cache.roleBindings, nil
+	}
+
+	a.cacheUpdateMutex.Lock()
+	defer a.cacheUpdateMutex.Unlock()
+
+	// check again, the cache may have been refreshed while waiting for the lock
+	cache = a.cache.Load().(roleBindingsCache)
+	now := a.timeSource.Now()
+	if now.Before(cache.expireOn) {
+		return cache.roleBindings, nil
+	}
+
+	ctx := headers.SetCallerInfo(context.TODO(), headers.SystemBackgroundCallerInfo)
+	resp, err := a.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	switch err.(type) {
+	case nil:
+		cache = roleBindingsCache{
+			roleBindings: resp.ClusterMetadata.GetRoleBindings(),
+			expireOn:     now.Add(roleBindingsCacheRefreshInterval),
+		}
+	case *serviceerror.NotFound:
+		cache = roleBindingsCache{expireOn: now.Add(roleBindingsCacheRefreshInterval)}
+	case *serviceerror.Unavailable:
+		// keep the previous role bindings and retry sooner
+		cache.expireOn = now.Add(roleBindingsCacheRefreshIfUnavailableInterval)
+	default:
+		return nil, err
+	}
+	a.cache.Store(cache)
+	return cache.roleBindings, nil
+}
+
+// groupPermissions returns the permissions granted to groups in a namespace, including the ones granted
+// cluster-wide. Roles assigned to groups but no longer defined are ignored.
+func groupPermissions(roleBindings *persistencespb.RoleBindings, groups []string, namespaceName string) map[Permission]struct{} {
+	granted := make(map[Permission]struct{})
+	namespaces := []string{""}
+	if namespaceName != "" {
+		namespaces = append(namespaces, namespaceName)
+	}
+	for _, ns := range namespaces {
+		groupRoles := roleBindings.GetNamespaces()[ns].GetGroups()
+		for _, group := range groups {
+			for _, roleName := range groupRoles[group].GetNames() {
+				for _, permission := range roleBindings.GetRoles()[roleName].GetPermissions() {
+					granted[Permission(permission)] = struct{}{}
+					if Permission(permission) == PermissionAdmin {
+						granted[PermissionStart] = struct{}{}
+						granted[PermissionSignal] = struct{}{}
+						granted[PermissionQuery] = struct{}{}
+					}
+				}
+			}
+		}
+	}
+	return granted
+}
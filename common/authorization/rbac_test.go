@@ -0,0 +1,235 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/persistence"
+)
+
+const workflowServicePrefix = "/temporal.api.workflowservice.v1.WorkflowService/"
+
+func newTestRoleBindings(roles map[string][]Permission, groupRoles map[string]GroupRoles) *persistencespb.RoleBindings {
+	roleBindings := &persistencespb.RoleBindings{
+		Roles:      make(map[string]*persistencespb.RolePermissions),
+		Namespaces: make(map[string]*persistencespb.GroupRoleBindings),
+	}
+	for name, permissions := range roles {
+		rolePermissions := &persistencespb.RolePermissions{}
+		for _, permission := range permissions {
+			rolePermissions.Permissions = append(rolePermissions.Permissions, string(permission))
+		}
+		roleBindings.Roles[name] = rolePermissions
+	}
+	for ns, groups := range groupRoles {
+		bindings := &persistencespb.GroupRoleBindings{Groups: make(map[string]*persistencespb.RoleNames)}
+		for group, names := range groups {
+			bindings.Groups[group] = &persistencespb.RoleNames{Names: names}
+		}
+		roleBindings.Namespaces[ns] = bindings
+	}
+	return roleBindings
+}
+
+func newRBACTestAuthorizer(t *testing.T, roleBindings *persistencespb.RoleBindings, enabled bool) Authorizer {
+	ctrl := gomock.NewController(t)
+	clusterMetadataManager := persistence.NewMockClusterMetadataManager(ctrl)
+	clusterMetadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{RoleBindings: roleBindings},
+	}, nil).AnyTimes()
+
+	return NewRBACAuthorizer(NewDefaultAuthorizer(), clusterMetadataManager, clock.NewRealTimeSource(), func() bool { return enabled })
+}
+
+func TestRequiredPermissions(t *testing.T) {
+	tests := map[string][]Permission{
+		workflowServicePrefix + "StartWorkflowExecution":           {PermissionStart},
+		workflowServicePrefix + "SignalWithStartWorkflowExecution": {PermissionStart, PermissionSignal},
+		workflowServicePrefix + "SignalWorkflowExecution":          {PermissionSignal},
+		workflowServicePrefix + "QueryWorkflow":                    {PermissionQuery},
+		workflowServicePrefix + "DescribeWorkflowExecution":        {PermissionQuery},
+		workflowServicePrefix + "TerminateWorkflowExecution":       {PermissionAdmin},
+		workflowServicePrefix + "PollWorkflowTaskQueue":            {PermissionAdmin},
+		workflowServicePrefix + "ListNamespaces":                   {PermissionQuery},
+		workflowServicePrefix + "RegisterNamespace":                {PermissionAdmin},
+		operatorServicePrefix + "AddSearchAttributes":              {PermissionOperator},
+		adminServicePrefix + "DescribeMutableState":                {PermissionOperator},
+	}
+	for api, expected := range tests {
+		require.Equal(t, expected, RequiredPermissions(api), api)
+	}
+}
+
+func TestRBACAuthorizer(t *testing.T) {
+	authorizer := newRBACTestAuthorizer(t, newTestRoleBindings(
+		map[string][]Permission{
+			"starter":   {PermissionStart, PermissionQuery},
+			"signaller": {PermissionSignal},
+			"owner":     {PermissionAdmin},
+		},
+		map[string]GroupRoles{
+			"payments": {
+				"payments-dev":    {"starter"},
+				"payments-ops":    {"signaller", "deleted-role"},
+				"payments-owners": {"owner"},
+			},
+		},
+	), true)
+	authorize := func(groups []string, ns string, api string) Decision {
+		result, err := authorizer.Authorize(context.Background(), &Claims{Groups: groups}, &CallTarget{
+			Namespace: ns,
+			APIName:   workflowServicePrefix + api,
+		})
+		require.NoError(t, err)
+		return result.Decision
+	}
+
+	require.Equal(t, DecisionAllow, authorize([]string{"payments-dev"}, "payments", "StartWorkflowExecution"))
+	require.Equal(t, DecisionAllow, authorize([]string{"payments-dev"}, "payments", "QueryWorkflow"))
+	require.Equal(t, DecisionDeny, authorize([]string{"payments-dev"}, "payments", "SignalWorkflowExecution"))
+	require.Equal(t, DecisionDeny, authorize([]string{"payments-dev"}, "payments", "SignalWithStartWorkflowExecution"))
+	require.Equal(t, DecisionAllow, authorize([]string{"payments-dev", "payments-ops"}, "payments", "SignalWithStartWorkflowExecution"))
+	require.Equal(t, DecisionDeny, authorize([]string{"payments-ops"}, "payments", "TerminateWorkflowExecution"))
+	require.Equal(t, DecisionAllow, authorize([]string{"payments-owners"}, "payments", "TerminateWorkflowExecution"))
+	require.Equal(t, DecisionAllow, authorize([]string{"payments-owners"}, "payments", "SignalWorkflowExecution"))
+	require.Equal(t, DecisionDeny, authorize([]string{"payments-owners"}, "other", "StartWorkflowExecution"))
+	require.Equal(t, DecisionDeny, authorize([]string{"payments-owners"}, "", "ListNamespaces"))
+	require.Equal(t, DecisionDeny, authorize(nil, "payments", "StartWorkflowExecution"))
+
+	result, err := authorizer.Authorize(context.Background(), &Claims{Groups: []string{"payments-owners"}}, &CallTarget{
+		Namespace: "payments",
+		APIName:   operatorServicePrefix + "AddSearchAttributes",
+	})
+	require.NoError(t, err)
+	require.Equal(t, DecisionDeny, result.Decision)
+
+	// roles of the wrapped authorizer still apply
+	result, err = authorizer.Authorize(context.Background(), &Claims{System: RoleAdmin}, &CallTarget{
+		Namespace: "payments",
+		APIName:   operatorServicePrefix + "AddSearchAttributes",
+	})
+	require.NoError(t, err)
+	require.Equal(t, DecisionAllow, result.Decision)
+}
+
+func TestRBACAuthorizer_Disabled(t *testing.T) {
+	authorizer := newRBACTestAuthorizer(t, newTestRoleBindings(
+		map[string][]Permission{"owner": {PermissionAdmin}},
+		map[string]GroupRoles{"payments": {"payments-owners": {"owner"}}},
+	), false)
+	result, err := authorizer.Authorize(context.Background(), &Claims{Groups: []string{"payments-owners"}}, &CallTarget{
+		Namespace: "payments",
+		APIName:   workflowServicePrefix + "StartWorkflowExecution",
+	})
+	require.NoError(t, err)
+	require.Equal(t, DecisionDeny, result.Decision)
+}
+
+func TestRBACAuthorizer_ClusterWide(t *testing.T) {
+	authorizer := newRBACTestAuthorizer(t, newTestRoleBindings(
+		map[string][]Permission{
+			"viewer":   {PermissionQuery},
+			"owner":    {PermissionAdmin},
+			"operator": {PermissionOperator},
+		},
+		map[string]GroupRoles{
+			"": {
+				"viewers":   {"viewer"},
+				"owners":    {"owner"},
+				"operators": {"operator"},
+			},
+			"payments": {
+				"payments-owners": {"owner"},
+			},
+		},
+	), true)
+	authorize := func(groups []string, ns string, fullAPIName string) Decision {
+		result, err := authorizer.Authorize(context.Background(), &Claims{Groups: groups}, &CallTarget{
+			Namespace: ns,
+			APIName:   fullAPIName,
+		})
+		require.NoError(t, err)
+		return result.Decision
+	}
+
+	// calls that don't target a namespace only use the roles assigned cluster-wide
+	require.Equal(t, DecisionAllow, authorize([]string{"viewers"}, "", workflowServicePrefix+"ListNamespaces"))
+	require.Equal(t, DecisionDeny, authorize([]string{"viewers"}, "", workflowServicePrefix+"RegisterNamespace"))
+	require.Equal(t, DecisionAllow, authorize([]string{"owners"}, "", workflowServicePrefix+"RegisterNamespace"))
+	require.Equal(t, DecisionDeny, authorize([]string{"owners"}, "", operatorServicePrefix+"ListClusters"))
+	require.Equal(t, DecisionAllow, authorize([]string{"operators"}, "", operatorServicePrefix+"ListClusters"))
+	require.Equal(t, DecisionAllow, authorize([]string{"operators"}, "", adminServicePrefix+"DescribeCluster"))
+	require.Equal(t, DecisionDeny, authorize([]string{"payments-owners"}, "", workflowServicePrefix+"ListNamespaces"))
+	require.Equal(t, DecisionDeny, authorize(nil, "", workflowServicePrefix+"ListNamespaces"))
+
+	// roles assigned cluster-wide apply to every namespace
+	require.Equal(t, DecisionAllow, authorize([]string{"viewers"}, "payments", workflowServicePrefix+"QueryWorkflow"))
+	require.Equal(t, DecisionDeny, authorize([]string{"viewers"}, "payments", workflowServicePrefix+"StartWorkflowExecution"))
+	require.Equal(t, DecisionAllow, authorize([]string{"owners"}, "other", workflowServicePrefix+"StartWorkflowExecution"))
+	require.Equal(t, DecisionAllow, authorize([]string{"viewers", "payments-owners"}, "payments", workflowServicePrefix+"TerminateWorkflowExecution"))
+}
+
+func TestRBACAuthorizer_RoleBindingsCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	clusterMetadataManager := persistence.NewMockClusterMetadataManager(ctrl)
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	authorizer := NewRBACAuthorizer(NewDefaultAuthorizer(), clusterMetadataManager, timeSource, func() bool { return true })
+	authorize := func() Decision {
+		result, err := authorizer.Authorize(context.Background(), &Claims{Groups: []string{"viewers"}}, &CallTarget{
+			APIName: workflowServicePrefix + "ListNamespaces",
+		})
+		require.NoError(t, err)
+		return result.Decision
+	}
+
+	clusterMetadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).Return(nil, serviceerror.NewNotFound("")).Times(1)
+	require.Equal(t, DecisionDeny, authorize())
+	require.Equal(t, DecisionDeny, authorize())
+
+	roleBindings := newTestRoleBindings(
+		map[string][]Permission{"viewer": {PermissionQuery}},
+		map[string]GroupRoles{"": {"viewers": {"viewer"}}},
+	)
+	clusterMetadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{RoleBindings: roleBindings},
+	}, nil).Times(1)
+	timeSource.Update(timeSource.Now().Add(roleBindingsCacheRefreshInterval))
+	require.Equal(t, DecisionAllow, authorize())
+
+	// the previous role bindings are kept while cluster metadata is unavailable
+	clusterMetadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).Return(nil, serviceerror.NewUnavailable("")).Times(1)
+	timeSource.Update(timeSource.Now().Add(roleBindingsCacheRefreshInterval))
+	require.Equal(t, DecisionAllow, authorize())
+	require.Equal(t, DecisionAllow, authorize())
+}
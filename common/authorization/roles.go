@@ -53,6 +53,8 @@ type Claims struct {
 	System Role
 	// Roles within specific namespaces
 	Namespaces map[string]Role
+	// Identity groups of the subject, used to look up named roles
+	Groups []string
 	// Free form bucket for extra data
 	Extensions interface{}
 }
@@ -66,6 +66,10 @@ type Options struct {
 
 	// Pin prevents in-use objects from getting evicted.
 	Pin bool
+
+	// ExpireAfterAccess counts the TTL of an entry from its last Get or Put instead
+	// of from its insertion, so that only idle entries expire.
+	ExpireAfterAccess bool
 }
 
 // SimpleOptions provides options that can be used to configure SimpleCache
@@ -45,6 +45,8 @@ type (
 		maxSize  int
 		ttl      time.Duration
 		pin      bool
+		// expireAfterAccess refreshes the createTime of entries on access
+		expireAfterAccess bool
 	}
 
 	iteratorImpl struct {
@@ -140,6 +142,8 @@ func New(maxSize int, opts *Options) Cache {
 		ttl:      opts.TTL,
 		maxSize:  maxSize,
 		pin:      opts.Pin,
+
+		expireAfterAccess: opts.ExpireAfterAccess,
 	}
 }
 
@@ -181,6 +185,7 @@ func (c *lru) Get(key interface{}) interface{} {
 	if c.pin {
 		entry.refCount++
 	}
+	c.touchInternal(entry)
 	c.byAccess.MoveToFront(element)
 	return entry.value
 }
@@ -269,6 +274,8 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 				if c.ttl != 0 {
 					entry.createTime = time.Now().UTC()
 				}
+			} else {
+				c.touchInternal(entry)
 			}
 
 			c.byAccess.MoveToFront(elt)
@@ -324,6 +331,12 @@ func (c *lru) evictOnceInternal() {
 	}
 }
 
+func (c *lru) touchInternal(entry *entryImpl) {
+	if c.expireAfterAccess && c.ttl != 0 {
+		entry.createTime = time.Now().UTC()
+	}
+}
+
 func (c *lru) isEntryExpired(entry *entryImpl, currentTime time.Time) bool {
 	return entry.refCount == 0 && !entry.createTime.IsZero() && currentTime.After(entry.createTime.Add(c.ttl))
 }
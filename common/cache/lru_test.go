@@ -165,6 +165,21 @@ func TestTTL(t *testing.T) {
 	assert.Nil(t, cache.Get("A"))
 }
 
+func TestTTL_ExpireAfterAccess(t *testing.T) {
+	cache := New(5, &Options{
+		TTL:               time.Millisecond * 100,
+		ExpireAfterAccess: true,
+	})
+
+	cache.Put("A", t)
+	for i := 0; i < 4; i++ {
+		time.Sleep(time.Millisecond * 50)
+		assert.Equal(t, t, cache.Get("A"))
+	}
+	time.Sleep(time.Millisecond * 200)
+	assert.Nil(t, cache.Get("A"))
+}
+
 func TestTTLWithPin(t *testing.T) {
 	cache := New(5, &Options{
 		TTL: time.Millisecond * 50,
@@ -0,0 +1,496 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package claimcheck offloads large history event payloads to an object store, leaving in
+// their place a small reference payload which is resolved back when history is returned to clients.
+package claimcheck
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/objectstore"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	// ReferenceEncoding is the encoding of payloads referencing a payload offloaded to the object store
+	ReferenceEncoding = "temporal.io/claim-check"
+
+	referenceKeyMetadata  = "claimCheckKey"
+	referenceSizeMetadata = "claimCheckSize"
+	encodingMetadata      = "encoding"
+
+	keyPrefix = "payloads"
+)
+
+var (
+	payloadType          = reflect.TypeOf(commonpb.Payload{})
+	payloadPtrType       = reflect.PtrTo(payloadType)
+	searchAttributesType = reflect.TypeOf(commonpb.SearchAttributes{})
+	memoType             = reflect.TypeOf(commonpb.Memo{})
+)
+
+type (
+	// Config is the dynamic configuration of payload offloading
+	Config struct {
+		// Enabled determines whether payloads of the namespace are offloaded,
+		// references are resolved regardless
+		Enabled dynamicconfig.BoolPropertyFnWithNamespaceIDFilter
+		// SizeThreshold is the size in bytes above which a payload is offloaded
+		SizeThreshold dynamicconfig.IntPropertyFn
+	}
+
+	// PayloadStore offloads history event payloads to an object store and resolves the references
+	// left in their place. Offloaded payloads are keyed by the history branch of their event, so that
+	// they are garbage collected together with the branch once the workflow retention expires.
+	PayloadStore interface {
+		// Offload returns the events with their payloads above the size threshold replaced by references.
+		// The given events are never modified, events with offloaded payloads are copies.
+		Offload(ctx context.Context, namespaceID string, treeID string, branchID string, transactionID int64, events []*historypb.HistoryEvent) ([]*historypb.HistoryEvent, error)
+		// Resolve replaces, in place, the references found in the payloads reachable from value,
+		// a pointer to or a slice of pointers to API messages, by the offloaded payloads.
+		Resolve(ctx context.Context, value interface{}) error
+		// ResolveHistoryBlobs returns the serialized event batches with the references of their events
+		// resolved. Batches without references are returned as is.
+		ResolveHistoryBlobs(ctx context.Context, blobs []*commonpb.DataBlob) ([]*commonpb.DataBlob, error)
+		// DeleteBranch deletes the payloads offloaded by the events of a history branch with an event ID
+		// greater than or equal to beginEventID
+		DeleteBranch(ctx context.Context, treeID string, branchID string, beginEventID int64) error
+		// DeleteNode deletes the payloads offloaded by the events of the history node appended to a branch
+		// by the given transaction
+		DeleteNode(ctx context.Context, treeID string, branchID string, nodeID int64, transactionID int64) error
+	}
+
+	payloadStoreImpl struct {
+		store          objectstore.Store
+		serializer     serialization.Serializer
+		config         *Config
+		metricsHandler metrics.Handler
+		logger         log.Logger
+	}
+
+	noopPayloadStore struct{}
+)
+
+var (
+	// NoopPayloadStore never offloads payloads. It resolves no references either,
+	// so it must only be used when payload offloading was never configured.
+	NoopPayloadStore PayloadStore = &noopPayloadStore{}
+
+	_ PayloadStore = (*payloadStoreImpl)(nil)
+)
+
+// NewPayloadStore returns a PayloadStore keeping offloaded payloads in the given object store
+func NewPayloadStore(
+	store objectstore.Store,
+	config *Config,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) PayloadStore {
+	return &payloadStoreImpl{
+		store:          store,
+		serializer:     serialization.NewSerializer(),
+		config:         config,
+		metricsHandler: metricsHandler,
+		logger:         logger,
+	}
+}
+
+// IsReference returns whether the payload references an offloaded payload
+func IsReference(payload *commonpb.Payload) bool {
+	return string(payload.GetMetadata()[encodingMetadata]) == ReferenceEncoding
+}
+
+func (s *payloadStoreImpl) Offload(
+	ctx context.Context,
+	namespaceID string,
+	treeID string,
+	branchID string,
+	transactionID int64,
+	events []*historypb.HistoryEvent,
+) ([]*historypb.HistoryEvent, error) {
+	offload := s.config.Enabled(namespaceID)
+	threshold := s.config.SizeThreshold()
+	branchPrefix := branchKeyPrefix(treeID, branchID)
+	needsOffload := func(payload *commonpb.Payload) bool {
+		if IsReference(payload) {
+			// references copied from another branch, e.g. a child workflow result or the input of a
+			// retried run, are copied so that the branch owns all the payloads it references
+			return !strings.HasPrefix(referenceKey(payload), branchPrefix)
+		}
+		return offload && payload.Size() > threshold
+	}
+
+	var result []*historypb.HistoryEvent
+	for i, event := range events {
+		found := false
+		_ = visitPayloads(reflect.ValueOf(event), func(payload *commonpb.Payload) (*commonpb.Payload, error) {
+			found = found || needsOffload(payload)
+			return nil, nil
+		})
+		if !found {
+			if result != nil {
+				result = append(result, event)
+			}
+			continue
+		}
+
+		if result == nil {
+			result = make([]*historypb.HistoryEvent, i, len(events))
+			copy(result, events[:i])
+		}
+		event = common.CloneProto(event)
+		index := 0
+		err := visitPayloads(reflect.ValueOf(event), func(payload *commonpb.Payload) (*commonpb.Payload, error) {
+			if !needsOffload(payload) {
+				return nil, nil
+			}
+			key := fmt.Sprintf("%s%020d-%d-%d", branchPrefix, event.GetEventId(), transactionID, index)
+			index++
+			return s.offloadPayload(ctx, key, payload)
+		})
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, event)
+	}
+
+	if result == nil {
+		return events, nil
+	}
+	return result, nil
+}
+
+func (s *payloadStoreImpl) offloadPayload(
+	ctx context.Context,
+	key string,
+	payload *commonpb.Payload,
+) (*commonpb.Payload, error) {
+	if IsReference(payload) {
+		resolved, err := s.resolvePayload(ctx, payload)
+		if err != nil {
+			return nil, err
+		}
+		payload = resolved
+	}
+
+	data, err := payload.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.Put(ctx, key, bytes.NewReader(data)); err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("unable to offload payload: %v", err))
+	}
+	s.metricsHandler.Counter(metrics.PayloadOffloaded.GetMetricName()).Record(1)
+	s.metricsHandler.Counter(metrics.PayloadOffloadedBytes.GetMetricName()).Record(int64(len(data)))
+
+	return &commonpb.Payload{
+		Metadata: map[string][]byte{
+			encodingMetadata:      []byte(ReferenceEncoding),
+			referenceKeyMetadata:  []byte(key),
+			referenceSizeMetadata: []byte(strconv.Itoa(len(data))),
+		},
+	}, nil
+}
+
+func (s *payloadStoreImpl) Resolve(
+	ctx context.Context,
+	value interface{},
+) error {
+	return visitPayloads(reflect.ValueOf(value), func(payload *commonpb.Payload) (*commonpb.Payload, error) {
+		if !IsReference(payload) {
+			return nil, nil
+		}
+		return s.resolvePayload(ctx, payload)
+	})
+}
+
+func (s *payloadStoreImpl) resolvePayload(
+	ctx context.Context,
+	reference *commonpb.Payload,
+) (*commonpb.Payload, error) {
+	key := referenceKey(reference)
+	reader, err := s.store.Get(ctx, key)
+	if errors.Is(err, objectstore.ErrObjectNotFound) {
+		s.logger.Error("Offloaded payload not found.", tag.Key(key))
+		return nil, serviceerror.NewDataLoss(fmt.Sprintf("offloaded payload %v not found", key))
+	}
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("unable to resolve offloaded payload: %v", err))
+	}
+	defer func() { _ = reader.Close() }()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("unable to resolve offloaded payload: %v", err))
+	}
+	payload := &commonpb.Payload{}
+	if err := payload.Unmarshal(data); err != nil {
+		return nil, serviceerror.NewDataLoss(fmt.Sprintf("offloaded payload %v is corrupted: %v", key, err))
+	}
+	s.metricsHandler.Counter(metrics.PayloadOffloadResolved.GetMetricName()).Record(1)
+	return payload, nil
+}
+
+func (s *payloadStoreImpl) ResolveHistoryBlobs(
+	ctx context.Context,
+	blobs []*commonpb.DataBlob,
+) ([]*commonpb.DataBlob, error) {
+	var result []*commonpb.DataBlob
+	for i, blob := range blobs {
+		events, err := s.serializer.DeserializeEvents(blob)
+		if err != nil {
+			return nil, err
+		}
+		if !containsReference(events) {
+			if result != nil {
+				result = append(result, blob)
+			}
+			continue
+		}
+
+		if result == nil {
+			result = make([]*commonpb.DataBlob, i, len(blobs))
+			copy(result, blobs[:i])
+		}
+		if err := s.Resolve(ctx, events); err != nil {
+			return nil, err
+		}
+		encodingType := blob.GetEncodingType()
+		if encodingType == enumspb.ENCODING_TYPE_UNSPECIFIED {
+			encodingType = enumspb.ENCODING_TYPE_PROTO3
+		}
+		resolved, err := s.serializer.SerializeEvents(events, encodingType)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, resolved)
+	}
+
+	if result == nil {
+		return blobs, nil
+	}
+	return result, nil
+}
+
+func (s *payloadStoreImpl) DeleteBranch(
+	ctx context.Context,
+	treeID string,
+	branchID string,
+	beginEventID int64,
+) error {
+	return s.deleteKeys(ctx, treeID, branchID, func(eventID int64, _ int64) bool {
+		return eventID >= beginEventID
+	})
+}
+
+func (s *payloadStoreImpl) DeleteNode(
+	ctx context.Context,
+	treeID string,
+	branchID string,
+	nodeID int64,
+	transactionID int64,
+) error {
+	// the node ID is the ID of the first event of the node, transaction IDs are unique within a shard
+	return s.deleteKeys(ctx, treeID, branchID, func(eventID int64, keyTransactionID int64) bool {
+		return eventID >= nodeID && keyTransactionID == transactionID
+	})
+}
+
+func (s *payloadStoreImpl) deleteKeys(
+	ctx context.Context,
+	treeID string,
+	branchID string,
+	match func(eventID int64, transactionID int64) bool,
+) error {
+	branchPrefix := branchKeyPrefix(treeID, branchID)
+	keys, err := s.store.List(ctx, branchPrefix)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		eventID, transactionID, ok := parseKey(branchPrefix, key)
+		if !ok {
+			s.logger.Warn("Unexpected offloaded payload key.", tag.Key(key))
+			continue
+		}
+		if !match(eventID, transactionID) {
+			continue
+		}
+		if err := s.store.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *noopPayloadStore) Offload(
+	_ context.Context,
+	_ string,
+	_ string,
+	_ string,
+	_ int64,
+	events []*historypb.HistoryEvent,
+) ([]*historypb.HistoryEvent, error) {
+	return events, nil
+}
+
+func (s *noopPayloadStore) Resolve(
+	_ context.Context,
+	_ interface{},
+) error {
+	return nil
+}
+
+func (s *noopPayloadStore) ResolveHistoryBlobs(
+	_ context.Context,
+	blobs []*commonpb.DataBlob,
+) ([]*commonpb.DataBlob, error) {
+	return blobs, nil
+}
+
+func (s *noopPayloadStore) DeleteBranch(
+	_ context.Context,
+	_ string,
+	_ string,
+	_ int64,
+) error {
+	return nil
+}
+
+func (s *noopPayloadStore) DeleteNode(
+	_ context.Context,
+	_ string,
+	_ string,
+	_ int64,
+	_ int64,
+) error {
+	return nil
+}
+
+func branchKeyPrefix(treeID string, branchID string) string {
+	return keyPrefix + "/" + treeID + "/" + branchID + "/"
+}
+
+func referenceKey(payload *commonpb.Payload) string {
+	return string(payload.GetMetadata()[referenceKeyMetadata])
+}
+
+// parseKey returns the event ID and the transaction ID of the key of a payload offloaded to a branch
+func parseKey(branchPrefix string, key string) (int64, int64, bool) {
+	parts := strings.Split(strings.TrimPrefix(key, branchPrefix), "-")
+	if len(parts) != 3 {
+		return 0, 0, false
+	}
+	eventID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	transactionID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return eventID, transactionID, true
+}
+
+func containsReference(value interface{}) bool {
+	found := false
+	_ = visitPayloads(reflect.ValueOf(value), func(payload *commonpb.Payload) (*commonpb.Payload, error) {
+		found = found || IsReference(payload)
+		return nil, nil
+	})
+	return found
+}
+
+// visitPayloads calls fn for each payload reachable from v, except for search attributes and memos
+// which are read by the server, and replaces the payload by the one returned by fn unless it is nil.
+func visitPayloads(
+	v reflect.Value,
+	fn func(*commonpb.Payload) (*commonpb.Payload, error),
+) error {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		if v.Type() == payloadPtrType {
+			payload, err := fn(v.Interface().(*commonpb.Payload))
+			if err != nil || payload == nil {
+				return err
+			}
+			v.Elem().Set(reflect.ValueOf(payload).Elem())
+			return nil
+		}
+		if elemType := v.Type().Elem(); elemType == searchAttributesType || elemType == memoType {
+			return nil
+		}
+		return visitPayloads(v.Elem(), fn)
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return visitPayloads(v.Elem(), fn)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				if err := visitPayloads(v.Field(i), fn); err != nil {
+					return err
+				}
+			}
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return nil
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := visitPayloads(v.Index(i), fn); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		for _, key := range v.MapKeys() {
+			if err := visitPayloads(v.MapIndex(key), fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package claimcheck
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/objectstore"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+func newTestPayloadStore(t *testing.T) (PayloadStore, objectstore.Store) {
+	store, err := objectstore.NewStore("file://" + filepath.ToSlash(t.TempDir()))
+	require.NoError(t, err)
+	return NewPayloadStore(
+		store,
+		&Config{
+			Enabled:       func(string) bool { return true },
+			SizeThreshold: dynamicconfig.GetIntPropertyFn(1024),
+		},
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	), store
+}
+
+func newTestEvents(input string) []*historypb.HistoryEvent {
+	return []*historypb.HistoryEvent{
+		{
+			EventId:   1,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+				WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+					Input: payloads.EncodeString(input),
+					Memo: &commonpb.Memo{
+						Fields: map[string]*commonpb.Payload{"memo": payloads.EncodeString(input).Payloads[0]},
+					},
+				},
+			},
+		},
+		{
+			EventId:   2,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+				WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+					SignalName: "signal",
+					Input:      payloads.EncodeString("small"),
+				},
+			},
+		},
+	}
+}
+
+func TestOffloadAndResolve(t *testing.T) {
+	payloadStore, _ := newTestPayloadStore(t)
+	ctx := context.Background()
+	input := strings.Repeat("a", 2048)
+	events := newTestEvents(input)
+
+	offloaded, err := payloadStore.Offload(ctx, "namespace-id", "tree", "branch", 10, events)
+	require.NoError(t, err)
+	require.Len(t, offloaded, 2)
+
+	// the given events are left untouched, and events without large payloads are not copied
+	require.False(t, IsReference(events[0].GetWorkflowExecutionStartedEventAttributes().Input.Payloads[0]))
+	require.Same(t, events[1], offloaded[1])
+
+	attributes := offloaded[0].GetWorkflowExecutionStartedEventAttributes()
+	reference := attributes.Input.Payloads[0]
+	require.True(t, IsReference(reference))
+	require.Empty(t, reference.Data)
+	require.True(t, strings.HasPrefix(referenceKey(reference), "payloads/tree/branch/"))
+	require.False(t, IsReference(attributes.Memo.Fields["memo"]))
+
+	require.NoError(t, payloadStore.Resolve(ctx, offloaded))
+	var resolved string
+	require.NoError(t, payloads.Decode(offloaded[0].GetWorkflowExecutionStartedEventAttributes().Input, &resolved))
+	require.Equal(t, input, resolved)
+}
+
+func TestOffload_Disabled(t *testing.T) {
+	store, err := objectstore.NewStore("file://" + filepath.ToSlash(t.TempDir()))
+	require.NoError(t, err)
+	payloadStore := NewPayloadStore(
+		store,
+		&Config{
+			Enabled:       func(string) bool { return false },
+			SizeThreshold: dynamicconfig.GetIntPropertyFn(1024),
+		},
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	)
+	events := newTestEvents(strings.Repeat("a", 2048))
+
+	offloaded, err := payloadStore.Offload(context.Background(), "namespace-id", "tree", "branch", 10, events)
+	require.NoError(t, err)
+	require.Equal(t, events, offloaded)
+}
+
+func TestOffload_CopiesForeignReferences(t *testing.T) {
+	payloadStore, store := newTestPayloadStore(t)
+	ctx := context.Background()
+	input := strings.Repeat("a", 2048)
+
+	offloaded, err := payloadStore.Offload(ctx, "namespace-id", "tree", "branch", 10, newTestEvents(input))
+	require.NoError(t, err)
+	reference := offloaded[0].GetWorkflowExecutionStartedEventAttributes().Input.Payloads[0]
+
+	// a reference carried over to another workflow, e.g. as the input of a retried run, is copied
+	events := newTestEvents("small")
+	events[0].GetWorkflowExecutionStartedEventAttributes().Input.Payloads[0] = reference
+	copied, err := payloadStore.Offload(ctx, "namespace-id", "other-tree", "other-branch", 20, events)
+	require.NoError(t, err)
+	copiedReference := copied[0].GetWorkflowExecutionStartedEventAttributes().Input.Payloads[0]
+	require.True(t, IsReference(copiedReference))
+	require.True(t, strings.HasPrefix(referenceKey(copiedReference), "payloads/other-tree/other-branch/"))
+
+	// the copy outlives the original branch
+	require.NoError(t, payloadStore.DeleteBranch(ctx, "tree", "branch", 1))
+	keys, err := store.List(ctx, "payloads/tree/")
+	require.NoError(t, err)
+	require.Empty(t, keys)
+	require.Error(t, payloadStore.Resolve(ctx, offloaded))
+	require.NoError(t, payloadStore.Resolve(ctx, copied))
+	var resolved string
+	require.NoError(t, payloads.Decode(copied[0].GetWorkflowExecutionStartedEventAttributes().Input, &resolved))
+	require.Equal(t, input, resolved)
+}
+
+func TestDeleteBranch_FromEventID(t *testing.T) {
+	payloadStore, store := newTestPayloadStore(t)
+	ctx := context.Background()
+	input := strings.Repeat("a", 2048)
+
+	for _, eventID := range []int64{1, 5, 12} {
+		events := newTestEvents(input)[:1]
+		events[0].EventId = eventID
+		_, err := payloadStore.Offload(ctx, "namespace-id", "tree", "branch", eventID, events)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, payloadStore.DeleteBranch(ctx, "tree", "branch", 5))
+	keys, err := store.List(ctx, "payloads/tree/branch/")
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	require.True(t, strings.HasPrefix(keys[0], "payloads/tree/branch/00000000000000000001-"))
+}
+
+func TestDeleteNode(t *testing.T) {
+	payloadStore, store := newTestPayloadStore(t)
+	ctx := context.Background()
+	input := strings.Repeat("a", 2048)
+
+	// the same node appended by two transactions, and a later node
+	for _, transactionID := range []int64{10, 11} {
+		_, err := payloadStore.Offload(ctx, "namespace-id", "tree", "branch", transactionID, newTestEvents(input))
+		require.NoError(t, err)
+	}
+	events := newTestEvents(input)[:1]
+	events[0].EventId = 3
+	_, err := payloadStore.Offload(ctx, "namespace-id", "tree", "branch", 12, events)
+	require.NoError(t, err)
+
+	require.NoError(t, payloadStore.DeleteNode(ctx, "tree", "branch", 1, 11))
+	keys, err := store.List(ctx, "payloads/tree/branch/")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{
+		"payloads/tree/branch/00000000000000000001-10-0",
+		"payloads/tree/branch/00000000000000000003-12-0",
+	}, keys)
+}
+
+func TestResolveHistoryBlobs(t *testing.T) {
+	payloadStore, _ := newTestPayloadStore(t)
+	ctx := context.Background()
+	serializer := serialization.NewSerializer()
+	input := strings.Repeat("a", 2048)
+
+	offloaded, err := payloadStore.Offload(ctx, "namespace-id", "tree", "branch", 10, newTestEvents(input))
+	require.NoError(t, err)
+	offloadedBlob, err := serializer.SerializeEvents(offloaded, enumspb.ENCODING_TYPE_PROTO3)
+	require.NoError(t, err)
+	inlineBlob, err := serializer.SerializeEvents(newTestEvents("small"), enumspb.ENCODING_TYPE_PROTO3)
+	require.NoError(t, err)
+
+	blobs, err := payloadStore.ResolveHistoryBlobs(ctx, []*commonpb.DataBlob{inlineBlob, offloadedBlob})
+	require.NoError(t, err)
+	require.Len(t, blobs, 2)
+	require.Same(t, inlineBlob, blobs[0])
+
+	events, err := serializer.DeserializeEvents(blobs[1])
+	require.NoError(t, err)
+	attributes := events[0].GetWorkflowExecutionStartedEventAttributes()
+	require.False(t, IsReference(attributes.Input.Payloads[0]))
+	var resolved string
+	require.NoError(t, payloads.Decode(attributes.Input, &resolved))
+	require.Equal(t, input, resolved)
+}
+
+func TestResolveHistoryBlobs_NoReference(t *testing.T) {
+	payloadStore, _ := newTestPayloadStore(t)
+	blob, err := serialization.NewSerializer().SerializeEvents(newTestEvents("small"), enumspb.ENCODING_TYPE_PROTO3)
+	require.NoError(t, err)
+	blobs := []*commonpb.DataBlob{blob}
+
+	resolved, err := payloadStore.ResolveHistoryBlobs(context.Background(), blobs)
+	require.NoError(t, err)
+	require.Equal(t, blobs, resolved)
+}
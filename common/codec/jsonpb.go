@@ -27,6 +27,7 @@ package codec
 import (
 	"bytes"
 	"encoding/json"
+	"io"
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
@@ -41,6 +42,20 @@ type (
 		marshaler   jsonpb.Marshaler
 		ubmarshaler jsonpb.Unmarshaler
 	}
+
+	// HistoriesWriter writes a History slice in the format of EncodeHistories one item at a time,
+	// so that long histories don't have to be held in memory.
+	HistoriesWriter struct {
+		marshaler jsonpb.Marshaler
+		writer    io.Writer
+		count     int
+	}
+
+	// HistoriesReader reads a History slice in the format of EncodeHistories one item at a time.
+	HistoriesReader struct {
+		decoder *json.Decoder
+		started bool
+	}
 )
 
 // NewJSONPBEncoder creates a new JSONPBEncoder.
@@ -155,3 +170,91 @@ func (e *JSONPBEncoder) decodeSlice(
 
 	return nil
 }
+
+// NewHistoriesWriter creates a HistoriesWriter writing to w. Close must be called after the last History is written.
+func (e *JSONPBEncoder) NewHistoriesWriter(w io.Writer) *HistoriesWriter {
+	return &HistoriesWriter{
+		marshaler: e.marshaler,
+		writer:    w,
+	}
+}
+
+// Write encodes a History and appends it to the slice.
+func (w *HistoriesWriter) Write(history *historypb.History) error {
+	separator := ","
+	if w.count == 0 {
+		separator = "["
+	}
+	if _, err := io.WriteString(w.writer, separator); err != nil {
+		return err
+	}
+	if err := w.marshaler.Marshal(w.writer, history); err != nil {
+		return err
+	}
+	w.count++
+	return nil
+}
+
+// Close terminates the slice. It doesn't close the underlying writer.
+func (w *HistoriesWriter) Close() error {
+	end := "]"
+	if w.count == 0 {
+		end = "[]"
+	}
+	_, err := io.WriteString(w.writer, end)
+	return err
+}
+
+// NewHistoriesReader creates a HistoriesReader reading from r.
+func (e *JSONPBEncoder) NewHistoriesReader(r io.Reader) *HistoriesReader {
+	return &HistoriesReader{
+		decoder: json.NewDecoder(r),
+	}
+}
+
+// More returns whether there is another History to read.
+func (r *HistoriesReader) More() (bool, error) {
+	if err := r.start(); err != nil {
+		return false, err
+	}
+	return r.decoder.More(), nil
+}
+
+// Next decodes the next History, it returns io.EOF when the slice is exhausted.
+func (r *HistoriesReader) Next() (*historypb.History, error) {
+	if more, err := r.More(); err != nil || !more {
+		return nil, eofOrErr(err)
+	}
+	history := &historypb.History{}
+	if err := jsonpb.UnmarshalNext(r.decoder, history); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// Skip moves past the next History without decoding it, it returns io.EOF when the slice is exhausted.
+func (r *HistoriesReader) Skip() error {
+	if more, err := r.More(); err != nil || !more {
+		return eofOrErr(err)
+	}
+	var raw json.RawMessage
+	return r.decoder.Decode(&raw)
+}
+
+func (r *HistoriesReader) start() error {
+	if r.started {
+		return nil
+	}
+	if _, err := r.decoder.Token(); err != nil { // Read leading `[` and ignore it
+		return err
+	}
+	r.started = true
+	return nil
+}
+
+func eofOrErr(err error) error {
+	if err != nil {
+		return err
+	}
+	return io.EOF
+}
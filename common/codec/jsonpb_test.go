@@ -25,7 +25,9 @@
 package codec
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"testing"
 	"time"
 
@@ -109,3 +111,49 @@ func (s *jsonpbEncoderSuite) TestDecodeSlice() {
 	s.Nil(err)
 	s.Equal(historyEvents, decodedHistoryEvents)
 }
+
+func (s *jsonpbEncoderSuite) TestHistoriesWriterAndReader() {
+	histories := []*historypb.History{
+		{Events: []*historypb.HistoryEvent{historyEvent}},
+		{Events: []*historypb.HistoryEvent{historyEvent, historyEvent}},
+		{Events: []*historypb.HistoryEvent{historyEvent}},
+	}
+
+	var buf bytes.Buffer
+	writer := s.encoder.NewHistoriesWriter(&buf)
+	for _, history := range histories {
+		s.NoError(writer.Write(history))
+	}
+	s.NoError(writer.Close())
+	encoded, err := s.encoder.EncodeHistories(histories)
+	s.NoError(err)
+	s.Equal(string(encoded), buf.String())
+
+	reader := s.encoder.NewHistoriesReader(bytes.NewReader(buf.Bytes()))
+	s.NoError(reader.Skip())
+	history, err := reader.Next()
+	s.NoError(err)
+	s.Equal(histories[1], history)
+	more, err := reader.More()
+	s.NoError(err)
+	s.True(more)
+	history, err = reader.Next()
+	s.NoError(err)
+	s.Equal(histories[2], history)
+	_, err = reader.Next()
+	s.Equal(io.EOF, err)
+	s.Equal(io.EOF, reader.Skip())
+}
+
+func (s *jsonpbEncoderSuite) TestHistoriesWriterAndReader_Empty() {
+	var buf bytes.Buffer
+	s.NoError(s.encoder.NewHistoriesWriter(&buf).Close())
+	s.Equal("[]", buf.String())
+
+	decoded, err := s.encoder.DecodeHistories(buf.Bytes())
+	s.NoError(err)
+	s.Empty(decoded)
+	more, err := s.encoder.NewHistoriesReader(&buf).More()
+	s.NoError(err)
+	s.False(more)
+}
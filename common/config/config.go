@@ -26,6 +26,7 @@ package config
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -65,6 +66,9 @@ type (
 		// DynamicConfigClient is the config for setting up the file based dynamic config client
 		// Filepath should be relative to the root directory
 		DynamicConfigClient *dynamicconfig.FileBasedClientConfig `yaml:"dynamicConfigClient"`
+		// DynamicConfigKVClient is the config for reading dynamic config from etcd or Consul. Keys which
+		// are not set there are read from the file of DynamicConfigClient, if it is configured.
+		DynamicConfigKVClient *dynamicconfig.KVClientConfig `yaml:"dynamicConfigKVClient"`
 		// NamespaceDefaults is the default config for every namespace
 		NamespaceDefaults NamespaceDefaults `yaml:"namespaceDefaults"`
 		// ExporterConfig allows the specification of process-wide OTEL exporters
@@ -109,6 +113,25 @@ type (
 		Metrics *metrics.Config `yaml:"metrics"`
 		// Settings for authentication and authorization
 		Authorization Authorization `yaml:"authorization"`
+		// Audit is the configuration for audit logging of state-changing frontend calls
+		Audit Audit `yaml:"audit"`
+	}
+
+	// Audit configures where audit records are written and what they contain. Auditing is turned on per
+	// namespace with the frontend.enableAuditLog dynamic config.
+	Audit struct {
+		// File appends audit records as JSON lines to this path if set
+		File string `yaml:"file"`
+		// WebhookURL receives each audit record as a JSON POST request if set
+		WebhookURL string `yaml:"webhookURL"`
+		// WebhookTimeout bounds each webhook request. Defaults to 5s.
+		WebhookTimeout time.Duration `yaml:"webhookTimeout"`
+		// IncludePayloads keeps payload data in request summaries. It is redacted by default.
+		IncludePayloads bool `yaml:"includePayloads"`
+		// RedactFields lists request fields, by JSON name, whose values are redacted from request summaries
+		RedactFields []string `yaml:"redactFields"`
+		// IncludeWorkerAPIs also audits the task completion and heartbeat calls made by workers
+		IncludeWorkerAPIs bool `yaml:"includeWorkerAPIs"`
 	}
 
 	// RootTLS contains all TLS settings for the Temporal server
@@ -142,6 +165,22 @@ type (
 		// specific hostname. Host names are case insensitive. Optional. If not present,
 		// uses configuration supplied by Server field.
 		PerHostOverrides map[string]ServerTLS `yaml:"hostOverrides"`
+
+		// SPIFFE replaces the certificate files with SPIFFE workload identities fetched from a SPIRE
+		// agent. Only supported for Internode.
+		SPIFFE SPIFFE `yaml:"spiffe"`
+	}
+
+	// SPIFFE configures TLS with X.509 SVIDs from the SPIFFE Workload API, rotated as the agent
+	// issues new ones.
+	SPIFFE struct {
+		// WorkloadAPIAddress is the address of the Workload API, e.g. unix:///run/spire/sockets/agent.sock.
+		// Empty means SPIFFE is not used.
+		WorkloadAPIAddress string `yaml:"workloadAPIAddress"`
+		// ServiceIDs maps service names (frontend, history, matching, worker, internal-frontend) to the
+		// SPIFFE IDs of their workloads. Peers must present one of these IDs. Empty means any ID in the
+		// trust domain of this workload is accepted.
+		ServiceIDs map[string]string `yaml:"serviceIDs"`
 	}
 
 	// ServerTLS contains items to load server TLS configuration
@@ -222,6 +261,9 @@ type (
 		// This is generally used when BindOnIP would be the same across several nodes (ie: 0.0.0.0)
 		// and for nat traversal scenarios. Check net.ParseIP for supported syntax, only IPv4 is supported.
 		BroadcastAddress string `yaml:"broadcastAddress"`
+		// Zone is the availability zone the host runs in. History shards are spread evenly across zones
+		// when history.zoneAwareShardPlacement is enabled.
+		Zone string `yaml:"zone"`
 	}
 
 	// Persistence contains the configuration for data store / persistence layer
@@ -242,6 +284,32 @@ type (
 		DataStores map[string]DataStore `yaml:"datastores"`
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// HistoryNodeChunkSize is the size history event batches are split into when they are larger, 0 disables splitting
+		HistoryNodeChunkSize dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// Encryption contains the keys used to encrypt data blobs before they are written to the datastores
+		Encryption *PersistenceEncryption `yaml:"encryption"`
+		// PayloadOffload contains the config for offloading large history event payloads to an object store
+		PayloadOffload *PayloadOffload `yaml:"payloadOffload"`
+	}
+
+	// PayloadOffload is the configuration for offloading history event payloads above a size threshold to
+	// an object store, keeping only a reference to them in history. Offloading is enabled per namespace
+	// through dynamic config, and offloaded payloads are deleted with the history branch they belong to.
+	PayloadOffload struct {
+		// StoreURI is the URI of the object store, e.g. s3://bucket/prefix?region=us-east-1,
+		// gs://bucket/prefix or file:///path
+		StoreURI string `yaml:"storeURI"`
+	}
+
+	// PersistenceEncryption is the configuration for the static persistence encryption key provider.
+	// Blobs are encrypted with the current key, and can be read back as long as the key they were
+	// encrypted with is listed. To rotate keys, add a new key, make it the current key and keep the
+	// previous key until background re-encryption has rewritten all data encrypted with it.
+	PersistenceEncryption struct {
+		// CurrentKeyID is the ID of the key new blobs are encrypted with
+		CurrentKeyID string `yaml:"currentKeyID"`
+		// Keys maps key IDs to base64 encoded AES keys of 16, 24 or 32 bytes
+		Keys map[string]string `yaml:"keys"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -254,6 +322,8 @@ type (
 		SQL *SQL `yaml:"sql"`
 		// Custom contains the config for custom datastore implementation
 		CustomDataStoreConfig *CustomDatastoreConfig `yaml:"customDatastore"`
+		// Plugin contains the config for a datastore served by an out of process persistence plugin
+		Plugin *PluginDatastoreConfig `yaml:"plugin"`
 		// ElasticSearch contains the config for a ElasticSearch datastore
 		Elasticsearch *client.Config `yaml:"elasticsearch"`
 	}
@@ -335,6 +405,9 @@ type (
 		User string `yaml:"user"`
 		// Password is the cassandra password used for authentication by gocql client
 		Password string `yaml:"password"`
+		// RefreshPassword resolves the password again if it was given as a secret reference. It is set by
+		// Config.ResolveSecrets and called when the gocql session is refreshed, to pick up rotated passwords.
+		RefreshPassword func(ctx context.Context) (string, error) `yaml:"-"`
 		// keyspace is the cassandra keyspace
 		Keyspace string `yaml:"keyspace" validate:"nonzero"`
 		// Datacenter is the data center filter arg for cassandra
@@ -351,6 +424,8 @@ type (
 		DisableInitialHostLookup bool `yaml:"disableInitialHostLookup"`
 		// AddressTranslator translates Cassandra IP addresses, used for cases when IP addresses gocql driver returns are not accessible from the server
 		AddressTranslator *CassandraAddressTranslator `yaml:"addressTranslator"`
+		// TaskBacklog configures how matching backlog tasks are stored
+		TaskBacklog *CassandraTaskBacklog `yaml:"taskBacklog"`
 	}
 
 	// CassandraStoreConsistency enables you to set the consistency settings for each Cassandra Persistence Store for Temporal
@@ -360,6 +435,16 @@ type (
 		Default *CassandraConsistencySettings `yaml:"default"`
 	}
 
+	// CassandraTaskBacklog configures how matching backlog tasks are stored
+	CassandraTaskBacklog struct {
+		// TTLEnabled stores task queues in the task_backlog table instead of the tasks table. Tasks with an expiry time
+		// are written with a TTL aligned to it, so expired tasks are removed by Cassandra and skipped by matching. Tasks
+		// without an expiry time are written without TTL and deleted once completed, they are never dropped before they
+		// are dispatched. Task queues of the tasks table are moved to task_backlog when they are loaded. All matching
+		// hosts must be restarted with the same setting, hosts still using the tasks table don't see moved task queues.
+		TTLEnabled bool `yaml:"ttlEnabled"`
+	}
+
 	CassandraAddressTranslator struct {
 		// Translator defines name of translator implementation to use for Cassandra address translation
 		Translator string `yaml:"translator"`
@@ -381,6 +466,9 @@ type (
 		User string `yaml:"user"`
 		// Password is the password corresponding to the user name
 		Password string `yaml:"password"`
+		// RefreshPassword resolves the password again if it was given as a secret reference. It is set by
+		// Config.ResolveSecrets and called when a new connection is refused, to pick up rotated passwords.
+		RefreshPassword func(ctx context.Context) (string, error) `yaml:"-"`
 		// PluginName is the name of SQL plugin
 		PluginName string `yaml:"pluginName" validate:"nonzero"`
 		// DatabaseName is the name of SQL database to connect to
@@ -413,6 +501,15 @@ type (
 		Options map[string]any `yaml:"options"`
 	}
 
+	// PluginDatastoreConfig is the configuration for a datastore served by a persistence plugin,
+	// see the persistence/plugin package
+	PluginDatastoreConfig struct {
+		// Address is the host:port of the plugin gRPC endpoint
+		Address string `yaml:"address" validate:"nonzero"`
+		// TLS configuration of the connection to the plugin
+		TLS *auth.TLS `yaml:"tls"`
+	}
+
 	// Replicator describes the configuration of replicator
 	Replicator struct{}
 
@@ -451,6 +548,7 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
 		S3store   *S3Archiver        `yaml:"s3store"`
+		Azblob    *AzblobArchiver    `yaml:"azblob"`
 	}
 
 	// VisibilityArchival contains the config for visibility archival
@@ -468,6 +566,7 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		S3store   *S3Archiver        `yaml:"s3store"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
+		Azblob    *AzblobArchiver    `yaml:"azblob"`
 	}
 
 	// FilestoreArchiver contain the config for filestore archiver
@@ -486,6 +585,40 @@ type (
 		Region           string  `yaml:"region"`
 		Endpoint         *string `yaml:"endpoint"`
 		S3ForcePathStyle bool    `yaml:"s3ForcePathStyle"`
+		// ExpiryStorageClass is the storage class expired histories are transitioned to when the expiry action of
+		// their namespace is "transition". It defaults to GLACIER.
+		ExpiryStorageClass string `yaml:"expiryStorageClass"`
+		// SSEKMSKeyID is the ID or ARN of the customer managed KMS key archived objects are encrypted with.
+		// If it is empty, the default encryption of the bucket applies.
+		SSEKMSKeyID string `yaml:"sseKmsKeyId"`
+		// ObjectTagging tags archived objects with their namespace and workflow type, so that bucket
+		// lifecycle policies can filter on them.
+		ObjectTagging bool `yaml:"objectTagging"`
+		// ObjectLock places a retention on archived objects. The bucket must have object lock enabled.
+		ObjectLock *S3ObjectLock `yaml:"objectLock"`
+	}
+
+	// S3ObjectLock contains the object lock settings of the S3 archiver
+	S3ObjectLock struct {
+		// Mode is the object lock mode, either GOVERNANCE or COMPLIANCE
+		Mode string `yaml:"mode"`
+		// Retention is how long archived objects are locked for after they are written
+		Retention time.Duration `yaml:"retention"`
+	}
+
+	// AzblobArchiver contains the config for Azure Blob Storage archiver
+	AzblobArchiver struct {
+		// AccountName is the storage account the archival containers belong to
+		AccountName string `yaml:"accountName"`
+		// Endpoint overrides the blob service endpoint, which defaults to https://<accountName>.blob.core.windows.net
+		Endpoint string `yaml:"endpoint"`
+		// SASToken is a shared access signature used to authorize requests. If it is empty, the
+		// AZURE_STORAGE_SAS_TOKEN environment variable is used, and if that is not set either,
+		// requests are authorized with the managed identity of the host.
+		SASToken string `yaml:"sasToken"`
+		// ManagedIdentityClientID selects a user-assigned managed identity. The system-assigned
+		// identity is used if it is empty.
+		ManagedIdentityClientID string `yaml:"managedIdentityClientID"`
 	}
 
 	// PublicClient is the config for internal nodes (history/matching/worker) connecting to
@@ -548,16 +681,62 @@ type (
 		// Signing key provider for validating JWT tokens
 		JWTKeyProvider       JWTKeyProvider `yaml:"jwtKeyProvider"`
 		PermissionsClaimName string         `yaml:"permissionsClaimName"`
-		// Empty string for noopAuthorizer or "default" for defaultAuthorizer
+		// Empty string for noopAuthorizer, "default" for defaultAuthorizer or "opa" for opaAuthorizer
 		Authorizer string `yaml:"authorizer"`
 		// Empty string for noopClaimMapper or "default" for defaultJWTClaimMapper
 		ClaimMapper string `yaml:"claimMapper"`
+		// OPA is the config for the "opa" authorizer
+		OPA OPAAuthorizer `yaml:"opa"`
+		// Issuers restricts the tokens accepted by defaultJWTClaimMapper to the listed issuers.
+		// Empty means any issuer is accepted.
+		Issuers []JWTIssuer `yaml:"issuers"`
+		// RoleClaims maps values of custom token claims to permissions for defaultJWTClaimMapper
+		RoleClaims []JWTRoleClaim `yaml:"roleClaims"`
+		// GroupsClaimPath is the dot separated path of the token claim listing the identity groups of the
+		// subject, used to look up named roles. Empty means groups are not read from tokens.
+		GroupsClaimPath string `yaml:"groupsClaimPath"`
+	}
+
+	// JWTIssuer is a token issuer accepted by defaultJWTClaimMapper
+	JWTIssuer struct {
+		// Issuer must match the "iss" claim of the token
+		Issuer string `yaml:"issuer"`
+		// Audiences lists the audiences accepted for this issuer. The "aud" claim of the token
+		// must contain at least one of them. Empty means the audience is not checked.
+		Audiences []string `yaml:"audiences"`
+	}
+
+	// JWTRoleClaim maps values of a token claim to Temporal permissions
+	JWTRoleClaim struct {
+		// Path is the dot separated path of the claim, e.g. "realm_access.roles". The claim
+		// can be a string or a list of strings.
+		Path string `yaml:"path"`
+		// Permissions maps a claim value to permissions in the same "<namespace>:<permission>"
+		// format as the permissions claim, e.g. "temporal-system:admin"
+		Permissions map[string][]string `yaml:"permissions"`
+	}
+
+	// OPAAuthorizer is the config for an authorizer that evaluates Open Policy Agent policies
+	OPAAuthorizer struct {
+		// Endpoint is the URL of the OPA policy decision, for example
+		// http://localhost:8181/v1/data/temporal/authz
+		Endpoint string `yaml:"endpoint"`
+		// Timeout bounds each decision request. Defaults to 2s.
+		Timeout time.Duration `yaml:"timeout"`
+		// CacheTTL is how long decisions are cached. Zero disables caching.
+		CacheTTL time.Duration `yaml:"cacheTTL"`
+		// CacheMaxSize is the maximum number of cached decisions. Defaults to 10000.
+		CacheMaxSize int `yaml:"cacheMaxSize"`
 	}
 
 	// @@@SNIPSTART temporal-common-service-config-jwtkeyprovider
 	// Contains the config for signing key provider for validating JWT tokens
 	JWTKeyProvider struct {
-		KeySourceURIs   []string      `yaml:"keySourceURIs"`
+		KeySourceURIs []string `yaml:"keySourceURIs"`
+		// DiscoveryURIs are OIDC discovery documents, e.g.
+		// https://issuer.example.com/.well-known/openid-configuration, whose jwks_uri is used as
+		// an additional key source.
+		DiscoveryURIs   []string      `yaml:"discoveryURIs"`
 		RefreshInterval time.Duration `yaml:"refreshInterval"`
 	}
 	// @@@SNIPEND
@@ -622,13 +801,15 @@ func (r *GroupTLS) IsClientEnabled() bool {
 }
 
 func (p *JWTKeyProvider) HasSourceURIsConfigured() bool {
-	if len(p.KeySourceURIs) == 0 {
-		return false
-	}
 	for _, uri := range p.KeySourceURIs {
 		if strings.TrimSpace(uri) != "" {
 			return true
 		}
 	}
+	for _, uri := range p.DiscoveryURIs {
+		if strings.TrimSpace(uri) != "" {
+			return true
+		}
+	}
 	return false
 }
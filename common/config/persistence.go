@@ -161,6 +161,20 @@ func (c *Persistence) IsSQLVisibilityStore() bool {
 		(c.SecondaryVisibilityConfigExist() && c.DataStores[c.SecondaryVisibilityStore].SQL != nil)
 }
 
+// GetElasticsearchVisibilityIndex returns the visibility index of the first configured Elasticsearch
+// visibility store, or empty string if Elasticsearch isn't used for visibility.
+func (c *Persistence) GetElasticsearchVisibilityIndex() string {
+	for _, storeName := range []string{c.VisibilityStore, c.AdvancedVisibilityStore, c.SecondaryVisibilityStore} {
+		if storeName == "" {
+			continue
+		}
+		if ds := c.DataStores[storeName]; ds.Elasticsearch != nil {
+			return ds.Elasticsearch.GetVisibilityIndex()
+		}
+	}
+	return ""
+}
+
 func (c *Persistence) GetVisibilityStoreConfig() DataStore {
 	if c.VisibilityStore != "" {
 		return c.DataStores[c.VisibilityStore]
@@ -229,13 +243,16 @@ func (ds *DataStore) Validate() error {
 	if ds.CustomDataStoreConfig != nil {
 		storeConfigCount++
 	}
+	if ds.Plugin != nil {
+		storeConfigCount++
+	}
 	if ds.Elasticsearch != nil {
 		storeConfigCount++
 	}
 	if storeConfigCount != 1 {
 		return errors.New(
 			"must provide config for one and only one datastore: " +
-				"elasticsearch, cassandra, sql or custom store",
+				"elasticsearch, cassandra, sql, custom store or plugin",
 		)
 	}
 
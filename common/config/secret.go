@@ -0,0 +1,254 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"go.temporal.io/server/common/auth"
+)
+
+const (
+	// EnvSecretScheme is the scheme of references to secrets held in environment variables, e.g. env://SQL_PASSWORD
+	EnvSecretScheme = "env"
+	// FileSecretScheme is the scheme of references to secrets held in files, e.g. file:///run/secrets/sql-password
+	FileSecretScheme = "file"
+)
+
+type (
+	// SecretProvider resolves references to secrets that are kept outside of the static config,
+	// e.g. vault://secret/data/temporal#password. Providers are registered per URL scheme.
+	SecretProvider interface {
+		GetSecret(ctx context.Context, reference *url.URL) (string, error)
+	}
+
+	secretField struct {
+		name  string
+		value string
+		set   func(string)
+		// setRefresh, if set, hands the field's client a function to resolve the secret again
+		setRefresh func(func(ctx context.Context) (string, error))
+	}
+
+	envSecretProvider  struct{}
+	fileSecretProvider struct{}
+)
+
+// DefaultSecretProviders returns the secret providers which are available without any registration.
+func DefaultSecretProviders() map[string]SecretProvider {
+	return map[string]SecretProvider{
+		EnvSecretScheme:               envSecretProvider{},
+		FileSecretScheme:              fileSecretProvider{},
+		VaultSecretScheme:             newVaultSecretProvider(),
+		AWSSecretsManagerSecretScheme: newAWSSecretsManagerProvider(),
+	}
+}
+
+// ResolveSecrets replaces secret references in the credential fields of the config (datastore
+// passwords, TLS keys, Elasticsearch credentials and persistence encryption keys) with the secrets
+// they point to. A value is a reference if it is a URL whose scheme has a registered provider,
+// any other value is left as is.
+//
+// Secrets are resolved once, except for the Cassandra, SQL and Elasticsearch passwords: their
+// RefreshPassword field is set to resolve the reference again, which the clients do when they
+// reconnect after the password was rotated.
+func (c *Config) ResolveSecrets(ctx context.Context, providers map[string]SecretProvider) error {
+	if len(providers) == 0 {
+		return nil
+	}
+
+	fields := c.secretFields()
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].name < fields[j].name
+	})
+	for _, field := range fields {
+		reference, provider := parseSecretReference(field.value, providers)
+		if provider == nil {
+			continue
+		}
+		secret, err := provider.GetSecret(ctx, reference)
+		if err != nil {
+			return fmt.Errorf("unable to resolve %v secret for %v: %w", reference.Scheme, field.name, err)
+		}
+		field.set(secret)
+		if field.setRefresh != nil {
+			refreshProvider, refreshReference := provider, reference
+			field.setRefresh(func(ctx context.Context) (string, error) {
+				return refreshProvider.GetSecret(ctx, refreshReference)
+			})
+		}
+	}
+	return nil
+}
+
+func parseSecretReference(value string, providers map[string]SecretProvider) (*url.URL, SecretProvider) {
+	if !strings.Contains(value, "://") {
+		return nil, nil
+	}
+	reference, err := url.Parse(value)
+	if err != nil {
+		return nil, nil
+	}
+	provider, ok := providers[reference.Scheme]
+	if !ok {
+		return nil, nil
+	}
+	return reference, provider
+}
+
+// secretFields returns the fields of the config that may hold secrets, named by their path in the config.
+func (c *Config) secretFields() []secretField {
+	var fields []secretField
+	add := func(name string, value string, set func(string)) {
+		if value != "" {
+			fields = append(fields, secretField{name: name, value: value, set: set})
+		}
+	}
+	addString := func(name string, field *string) {
+		add(name, *field, func(secret string) { *field = secret })
+	}
+	addPassword := func(name string, field *string, refresh *func(ctx context.Context) (string, error)) {
+		if *field != "" {
+			fields = append(fields, secretField{
+				name:       name,
+				value:      *field,
+				set:        func(secret string) { *field = secret },
+				setRefresh: func(f func(ctx context.Context) (string, error)) { *refresh = f },
+			})
+		}
+	}
+	addServerTLS := func(name string, tls *ServerTLS) {
+		addString(name+".certData", &tls.CertData)
+		addString(name+".keyData", &tls.KeyData)
+	}
+	addHostOverrides := func(name string, overrides map[string]ServerTLS) {
+		for host, override := range overrides {
+			host := host
+			add(name+"."+host+".certData", override.CertData, func(secret string) {
+				override := overrides[host]
+				override.CertData = secret
+				overrides[host] = override
+			})
+			add(name+"."+host+".keyData", override.KeyData, func(secret string) {
+				override := overrides[host]
+				override.KeyData = secret
+				overrides[host] = override
+			})
+		}
+	}
+	addTLS := func(name string, tls *auth.TLS) {
+		if tls == nil {
+			return
+		}
+		addString(name+".certData", &tls.CertData)
+		addString(name+".keyData", &tls.KeyData)
+		addString(name+".caData", &tls.CaData)
+	}
+
+	addServerTLS("global.tls.internode.server", &c.Global.TLS.Internode.Server)
+	addHostOverrides("global.tls.internode.hostOverrides", c.Global.TLS.Internode.PerHostOverrides)
+	addServerTLS("global.tls.frontend.server", &c.Global.TLS.Frontend.Server)
+	addHostOverrides("global.tls.frontend.hostOverrides", c.Global.TLS.Frontend.PerHostOverrides)
+	remoteClusters := c.Global.TLS.RemoteClusters
+	for cluster, groupTLS := range remoteClusters {
+		cluster := cluster
+		name := "global.tls.remoteClusters." + cluster
+		add(name+".server.certData", groupTLS.Server.CertData, func(secret string) {
+			groupTLS := remoteClusters[cluster]
+			groupTLS.Server.CertData = secret
+			remoteClusters[cluster] = groupTLS
+		})
+		add(name+".server.keyData", groupTLS.Server.KeyData, func(secret string) {
+			groupTLS := remoteClusters[cluster]
+			groupTLS.Server.KeyData = secret
+			remoteClusters[cluster] = groupTLS
+		})
+		addHostOverrides(name+".hostOverrides", groupTLS.PerHostOverrides)
+	}
+	addString("global.tls.systemWorker.certData", &c.Global.TLS.SystemWorker.CertData)
+	addString("global.tls.systemWorker.keyData", &c.Global.TLS.SystemWorker.KeyData)
+
+	for storeName, ds := range c.Persistence.DataStores {
+		name := "persistence.datastores." + storeName
+		if ds.Cassandra != nil {
+			addString(name+".cassandra.user", &ds.Cassandra.User)
+			addPassword(name+".cassandra.password", &ds.Cassandra.Password, &ds.Cassandra.RefreshPassword)
+			addTLS(name+".cassandra.tls", ds.Cassandra.TLS)
+		}
+		if ds.SQL != nil {
+			addString(name+".sql.user", &ds.SQL.User)
+			addPassword(name+".sql.password", &ds.SQL.Password, &ds.SQL.RefreshPassword)
+			addTLS(name+".sql.tls", ds.SQL.TLS)
+		}
+		if ds.Elasticsearch != nil {
+			static := &ds.Elasticsearch.AWSRequestSigning.Static
+			addString(name+".elasticsearch.username", &ds.Elasticsearch.Username)
+			addPassword(name+".elasticsearch.password", &ds.Elasticsearch.Password, &ds.Elasticsearch.RefreshPassword)
+			addString(name+".elasticsearch.aws-request-signing.static.accessKeyID", &static.AccessKeyID)
+			addString(name+".elasticsearch.aws-request-signing.static.secretAccessKey", &static.SecretAccessKey)
+			addString(name+".elasticsearch.aws-request-signing.static.token", &static.Token)
+			addTLS(name+".elasticsearch.tls", ds.Elasticsearch.TLS)
+		}
+	}
+
+	if c.Persistence.Encryption != nil {
+		keys := c.Persistence.Encryption.Keys
+		for keyID, key := range keys {
+			keyID := keyID
+			add("persistence.encryption.keys."+keyID, key, func(secret string) { keys[keyID] = secret })
+		}
+	}
+	return fields
+}
+
+func (envSecretProvider) GetSecret(_ context.Context, reference *url.URL) (string, error) {
+	name := reference.Host
+	if name == "" {
+		return "", fmt.Errorf("environment variable name is missing")
+	}
+	secret, ok := os.LookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("environment variable %v is not set", name)
+	}
+	return secret, nil
+}
+
+func (fileSecretProvider) GetSecret(_ context.Context, reference *url.URL) (string, error) {
+	if reference.Path == "" {
+		return "", fmt.Errorf("file path is missing")
+	}
+	// This is tagged nosec because the file is named by the operator in the static config
+	// #nosec
+	data, err := os.ReadFile(reference.Path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
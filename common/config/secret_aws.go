@@ -0,0 +1,100 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+)
+
+const (
+	// AWSSecretsManagerSecretScheme is the scheme of references to secrets held in AWS Secrets Manager, e.g.
+	// awssm://temporal/sql#password for the password key of the JSON secret temporal/sql. Secrets are
+	// looked up by name, or by ARN with awssm:///<arn>, in the region given by the region query parameter
+	// or the default region, with the default AWS credentials.
+	AWSSecretsManagerSecretScheme = "awssm"
+)
+
+type (
+	awsSecretsManagerProvider struct {
+		newClient func(region string) (secretsmanageriface.SecretsManagerAPI, error)
+	}
+)
+
+func newAWSSecretsManagerProvider() *awsSecretsManagerProvider {
+	return &awsSecretsManagerProvider{
+		newClient: func(region string) (secretsmanageriface.SecretsManagerAPI, error) {
+			awsConfig := aws.NewConfig()
+			if region != "" {
+				awsConfig = awsConfig.WithRegion(region)
+			}
+			sess, err := session.NewSessionWithOptions(session.Options{
+				Config:            *awsConfig,
+				SharedConfigState: session.SharedConfigEnable,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return secretsmanager.New(sess), nil
+		},
+	}
+}
+
+func (p *awsSecretsManagerProvider) GetSecret(ctx context.Context, reference *url.URL) (string, error) {
+	secretID := strings.TrimPrefix(reference.Host+reference.Path, "/")
+	if secretID == "" {
+		return "", fmt.Errorf("secret name is missing")
+	}
+	client, err := p.newClient(reference.Query().Get("region"))
+	if err != nil {
+		return "", err
+	}
+	output, err := client.GetSecretValueWithContext(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(secretID),
+	})
+	if err != nil {
+		return "", err
+	}
+	if output.SecretString == nil {
+		return "", fmt.Errorf("secret %v has no string value", secretID)
+	}
+	if reference.Fragment == "" {
+		return *output.SecretString, nil
+	}
+
+	var values map[string]interface{}
+	if err := json.Unmarshal([]byte(*output.SecretString), &values); err != nil {
+		return "", fmt.Errorf("secret %v is not a JSON object: %w", secretID, err)
+	}
+	return selectSecretKey(values, reference.Fragment)
+}
@@ -0,0 +1,257 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/auth"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+)
+
+type mapSecretProvider map[string]string
+
+func (p mapSecretProvider) GetSecret(_ context.Context, reference *url.URL) (string, error) {
+	secret, ok := p[reference.Host+reference.Path]
+	if !ok {
+		return "", errors.New("secret not found")
+	}
+	return secret, nil
+}
+
+func TestResolveSecrets(t *testing.T) {
+	t.Setenv("TEMPORAL_TEST_SQL_PASSWORD", "sql-password")
+	secretFile := filepath.Join(t.TempDir(), "es-password")
+	require.NoError(t, os.WriteFile(secretFile, []byte("es-password\n"), 0600))
+
+	providers := DefaultSecretProviders()
+	providers["vault"] = mapSecretProvider{
+		"secret/cassandra":  "cassandra-password",
+		"secret/tls-key":    "tls-key",
+		"secret/remote-key": "remote-key",
+		"secret/host-key":   "host-key",
+		"secret/enc-key":    "enc-key",
+	}
+
+	cfg := &Config{
+		Global: Global{
+			TLS: RootTLS{
+				Internode: GroupTLS{
+					Server: ServerTLS{KeyData: "vault://secret/tls-key", CertData: "cert-data"},
+				},
+				Frontend: GroupTLS{
+					PerHostOverrides: map[string]ServerTLS{
+						"frontend.example.com": {KeyData: "vault://secret/host-key"},
+					},
+				},
+				RemoteClusters: map[string]GroupTLS{
+					"remote": {Server: ServerTLS{KeyData: "vault://secret/remote-key"}},
+				},
+			},
+		},
+		Persistence: Persistence{
+			DataStores: map[string]DataStore{
+				"default": {
+					Cassandra: &Cassandra{Password: "vault://secret/cassandra", TLS: &auth.TLS{CaData: "ca-data"}},
+				},
+				"sql": {
+					SQL: &SQL{Password: "env://TEMPORAL_TEST_SQL_PASSWORD", User: "https://not-a-secret"},
+				},
+				"es": {
+					Elasticsearch: &client.Config{Password: "file://" + secretFile},
+				},
+			},
+			Encryption: &PersistenceEncryption{
+				CurrentKeyID: "k1",
+				Keys:         map[string]string{"k1": "vault://secret/enc-key"},
+			},
+		},
+	}
+
+	require.NoError(t, cfg.ResolveSecrets(context.Background(), providers))
+	require.Equal(t, "tls-key", cfg.Global.TLS.Internode.Server.KeyData)
+	require.Equal(t, "cert-data", cfg.Global.TLS.Internode.Server.CertData)
+	require.Equal(t, "host-key", cfg.Global.TLS.Frontend.PerHostOverrides["frontend.example.com"].KeyData)
+	require.Equal(t, "remote-key", cfg.Global.TLS.RemoteClusters["remote"].Server.KeyData)
+	require.Equal(t, "cassandra-password", cfg.Persistence.DataStores["default"].Cassandra.Password)
+	require.Equal(t, "ca-data", cfg.Persistence.DataStores["default"].Cassandra.TLS.CaData)
+	require.Equal(t, "sql-password", cfg.Persistence.DataStores["sql"].SQL.Password)
+	require.Equal(t, "https://not-a-secret", cfg.Persistence.DataStores["sql"].SQL.User)
+	require.Equal(t, "es-password", cfg.Persistence.DataStores["es"].Elasticsearch.Password)
+	require.Equal(t, "enc-key", cfg.Persistence.Encryption.Keys["k1"])
+}
+
+func TestResolveSecrets_RefreshPassword(t *testing.T) {
+	t.Setenv("TEMPORAL_TEST_SQL_PASSWORD", "sql-password")
+	providers := DefaultSecretProviders()
+	vault := mapSecretProvider{
+		"secret/cassandra": "cassandra-password",
+		"secret/es":        "es-password",
+	}
+	providers["vault"] = vault
+
+	cfg := &Config{
+		Persistence: Persistence{
+			DataStores: map[string]DataStore{
+				"default":    {Cassandra: &Cassandra{Password: "vault://secret/cassandra"}},
+				"sql":        {SQL: &SQL{Password: "env://TEMPORAL_TEST_SQL_PASSWORD"}},
+				"es":         {Elasticsearch: &client.Config{Password: "vault://secret/es"}},
+				"plain-text": {SQL: &SQL{Password: "password"}},
+			},
+		},
+	}
+	require.NoError(t, cfg.ResolveSecrets(context.Background(), providers))
+	require.Nil(t, cfg.Persistence.DataStores["plain-text"].SQL.RefreshPassword)
+
+	t.Setenv("TEMPORAL_TEST_SQL_PASSWORD", "rotated-sql-password")
+	vault["secret/cassandra"] = "rotated-cassandra-password"
+	vault["secret/es"] = "rotated-es-password"
+
+	password, err := cfg.Persistence.DataStores["default"].Cassandra.RefreshPassword(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "rotated-cassandra-password", password)
+	password, err = cfg.Persistence.DataStores["sql"].SQL.RefreshPassword(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "rotated-sql-password", password)
+	password, err = cfg.Persistence.DataStores["es"].Elasticsearch.RefreshPassword(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "rotated-es-password", password)
+}
+
+func TestResolveSecrets_Error(t *testing.T) {
+	cfg := &Config{
+		Persistence: Persistence{
+			DataStores: map[string]DataStore{
+				"default": {SQL: &SQL{Password: "env://TEMPORAL_TEST_MISSING_PASSWORD"}},
+			},
+		},
+	}
+
+	err := cfg.ResolveSecrets(context.Background(), DefaultSecretProviders())
+	require.ErrorContains(t, err, "persistence.datastores.default.sql.password")
+	require.Equal(t, "env://TEMPORAL_TEST_MISSING_PASSWORD", cfg.Persistence.DataStores["default"].SQL.Password)
+}
+
+func TestVaultSecretProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "test-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/temporal":
+			_, _ = w.Write([]byte(`{"data": {"data": {"sql-password": "sql-password", "es-password": "es-password"}, "metadata": {"version": 3}}}`))
+		case "/v1/kv/temporal":
+			_, _ = w.Write([]byte(`{"data": {"password": "kv1-password"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	t.Setenv("VAULT_ADDR", server.URL)
+	t.Setenv("VAULT_TOKEN", "test-token")
+
+	provider := newVaultSecretProvider()
+	getSecret := func(reference string) (string, error) {
+		u, err := url.Parse(reference)
+		require.NoError(t, err)
+		return provider.GetSecret(context.Background(), u)
+	}
+
+	secret, err := getSecret("vault://secret/data/temporal#sql-password")
+	require.NoError(t, err)
+	require.Equal(t, "sql-password", secret)
+	secret, err = getSecret("vault://kv/temporal")
+	require.NoError(t, err)
+	require.Equal(t, "kv1-password", secret)
+
+	_, err = getSecret("vault://secret/data/temporal")
+	require.ErrorContains(t, err, "2 keys")
+	_, err = getSecret("vault://secret/data/temporal#missing")
+	require.ErrorContains(t, err, "no key missing")
+	_, err = getSecret("vault://secret/data/missing#password")
+	require.ErrorContains(t, err, "404")
+}
+
+type fakeSecretsManager struct {
+	secretsmanageriface.SecretsManagerAPI
+	secrets map[string]string
+}
+
+func (f *fakeSecretsManager) GetSecretValueWithContext(
+	_ aws.Context,
+	input *secretsmanager.GetSecretValueInput,
+	_ ...request.Option,
+) (*secretsmanager.GetSecretValueOutput, error) {
+	secret, ok := f.secrets[*input.SecretId]
+	if !ok {
+		return nil, errors.New("secret not found")
+	}
+	return &secretsmanager.GetSecretValueOutput{SecretString: aws.String(secret)}, nil
+}
+
+func TestAWSSecretsManagerProvider(t *testing.T) {
+	var regions []string
+	provider := &awsSecretsManagerProvider{
+		newClient: func(region string) (secretsmanageriface.SecretsManagerAPI, error) {
+			regions = append(regions, region)
+			return &fakeSecretsManager{secrets: map[string]string{
+				"temporal/sql": `{"username": "temporal", "password": "sql-password"}`,
+				"arn:aws:secretsmanager:us-west-2:123456789012:secret:tls-key": "tls-key",
+			}}, nil
+		},
+	}
+	getSecret := func(reference string) (string, error) {
+		u, err := url.Parse(reference)
+		require.NoError(t, err)
+		return provider.GetSecret(context.Background(), u)
+	}
+
+	secret, err := getSecret("awssm://temporal/sql?region=eu-west-1#password")
+	require.NoError(t, err)
+	require.Equal(t, "sql-password", secret)
+	secret, err = getSecret("awssm:///arn:aws:secretsmanager:us-west-2:123456789012:secret:tls-key")
+	require.NoError(t, err)
+	require.Equal(t, "tls-key", secret)
+	require.Equal(t, []string{"eu-west-1", ""}, regions)
+
+	_, err = getSecret("awssm:///arn:aws:secretsmanager:us-west-2:123456789012:secret:tls-key#password")
+	require.ErrorContains(t, err, "not a JSON object")
+	_, err = getSecret("awssm://temporal/missing")
+	require.ErrorContains(t, err, "secret not found")
+}
@@ -0,0 +1,135 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// VaultSecretScheme is the scheme of references to secrets held in HashiCorp Vault, e.g.
+	// vault://secret/data/temporal#sql-password for the sql-password key of the secret at secret/data/temporal.
+	// The server is read from VAULT_ADDR and authenticated with VAULT_TOKEN, and VAULT_NAMESPACE is sent if set.
+	VaultSecretScheme = "vault"
+
+	vaultAddrEnv      = "VAULT_ADDR"
+	vaultTokenEnv     = "VAULT_TOKEN"
+	vaultNamespaceEnv = "VAULT_NAMESPACE"
+	vaultTimeout      = 30 * time.Second
+)
+
+type (
+	vaultSecretProvider struct {
+		httpClient *http.Client
+	}
+
+	vaultResponse struct {
+		Data map[string]interface{} `json:"data"`
+	}
+)
+
+func newVaultSecretProvider() *vaultSecretProvider {
+	return &vaultSecretProvider{
+		httpClient: &http.Client{Timeout: vaultTimeout},
+	}
+}
+
+func (p *vaultSecretProvider) GetSecret(ctx context.Context, reference *url.URL) (string, error) {
+	addr := os.Getenv(vaultAddrEnv)
+	if addr == "" {
+		return "", fmt.Errorf("%v is not set", vaultAddrEnv)
+	}
+	secretPath := strings.Trim(reference.Host+reference.Path, "/")
+	if secretPath == "" {
+		return "", fmt.Errorf("secret path is missing")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(addr, "/")+"/v1/"+secretPath, nil)
+	if err != nil {
+		return "", err
+	}
+	if token := os.Getenv(vaultTokenEnv); token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if namespace := os.Getenv(vaultNamespaceEnv); namespace != "" {
+		req.Header.Set("X-Vault-Namespace", namespace)
+	}
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("vault returned %v for %v: %s", resp.Status, secretPath, strings.TrimSpace(string(body)))
+	}
+
+	var vaultResp vaultResponse
+	if err := json.NewDecoder(resp.Body).Decode(&vaultResp); err != nil {
+		return "", fmt.Errorf("unable to decode vault response for %v: %w", secretPath, err)
+	}
+	data := vaultResp.Data
+	// secrets of the KV version 2 engine are nested with their metadata
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		if _, ok := data["metadata"]; ok {
+			data = nested
+		}
+	}
+	return selectSecretKey(data, reference.Fragment)
+}
+
+// selectSecretKey returns the value of key in a secret made of several key/value pairs.
+// The key may be omitted if the secret has a single value.
+func selectSecretKey(values map[string]interface{}, key string) (string, error) {
+	if key == "" {
+		if len(values) != 1 {
+			return "", fmt.Errorf("secret has %v keys, the key must be given as URL fragment", len(values))
+		}
+		for _, value := range values {
+			return secretString(value)
+		}
+	}
+	value, ok := values[key]
+	if !ok {
+		return "", fmt.Errorf("secret has no key %v", key)
+	}
+	return secretString(value)
+}
+
+func secretString(value interface{}) (string, error) {
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("secret value is a %T, not a string", value)
+	}
+	return s, nil
+}
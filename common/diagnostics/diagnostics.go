@@ -0,0 +1,317 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package diagnostics collects the state of a host into a single archive which can be attached to support
+// tickets: runtime profiles, the effective dynamic config, the membership view, shard ownership and a
+// snapshot of the metrics exposed by the host.
+package diagnostics
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"sort"
+	"strings"
+	"time"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/primitives"
+)
+
+const (
+	hostFile          = "host.json"
+	goroutinesFile    = "goroutines.txt"
+	heapFile          = "heap.pb.gz"
+	dynamicConfigFile = "dynamic_config.json"
+	membershipFile    = "membership.json"
+	shardsFile        = "shards.json"
+	metricsFile       = "metrics.txt"
+	errorsFile        = "errors.txt"
+
+	metricsScrapeTimeout = 10 * time.Second
+)
+
+var membershipServices = []primitives.ServiceName{
+	primitives.FrontendService,
+	primitives.InternalFrontendService,
+	primitives.HistoryService,
+	primitives.MatchingService,
+	primitives.WorkerService,
+}
+
+type (
+	// Collector writes diagnostics bundles of the host it runs on
+	Collector struct {
+		numHistoryShards    int32
+		membershipMonitor   membership.Monitor
+		dynamicConfigClient dynamicconfig.Client
+		metricsURL          string
+		httpClient          *http.Client
+		timeSource          clock.TimeSource
+		logger              log.Logger
+	}
+
+	// HostInfo describes the host a bundle was collected from
+	HostInfo struct {
+		Hostname      string
+		Identity      string
+		ServerVersion string
+		GoVersion     string
+		NumGoroutine  int
+		CollectTime   time.Time
+	}
+
+	// ServiceMembers is the view this host has of the members of a service
+	ServiceMembers struct {
+		Service primitives.ServiceName
+		Members []string
+	}
+
+	// ShardOwner is the history host which owns a shard according to this host
+	ShardOwner struct {
+		ShardID int32
+		Owner   string
+	}
+
+	// bundleWriter adds files to a gzipped tar archive, and remembers the sections which could not be collected
+	bundleWriter struct {
+		tarWriter *tar.Writer
+		modTime   time.Time
+		errors    []string
+	}
+)
+
+// NewCollector creates a collector. The metrics snapshot is scraped from the Prometheus listener of
+// metricsConfig, it is left out of bundles if the host doesn't expose one.
+func NewCollector(
+	numHistoryShards int32,
+	membershipMonitor membership.Monitor,
+	dynamicConfigClient dynamicconfig.Client,
+	metricsConfig *metrics.Config,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) *Collector {
+	return &Collector{
+		numHistoryShards:    numHistoryShards,
+		membershipMonitor:   membershipMonitor,
+		dynamicConfigClient: dynamicConfigClient,
+		metricsURL:          prometheusURL(metricsConfig),
+		httpClient:          &http.Client{Timeout: metricsScrapeTimeout},
+		timeSource:          timeSource,
+		logger:              logger,
+	}
+}
+
+// Write writes a gzipped tar archive of the diagnostics of this host to w. Sections which can't be
+// collected don't fail the bundle, they are listed in errors.txt instead.
+func (c *Collector) Write(ctx context.Context, w io.Writer) error {
+	gzipWriter := gzip.NewWriter(w)
+	bundle := &bundleWriter{
+		tarWriter: tar.NewWriter(gzipWriter),
+		modTime:   c.timeSource.Now().UTC(),
+	}
+
+	sections := []struct {
+		name    string
+		collect func(context.Context, *bundleWriter) error
+	}{
+		{hostFile, c.writeHostInfo},
+		{goroutinesFile, writeProfile("goroutine", goroutinesFile, 2)},
+		{heapFile, writeProfile("heap", heapFile, 0)},
+		{dynamicConfigFile, c.writeDynamicConfig},
+		{membershipFile, c.writeMembership},
+		{shardsFile, c.writeShardOwners},
+		{metricsFile, c.writeMetrics},
+	}
+	for _, section := range sections {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := section.collect(ctx, bundle); err != nil {
+			c.logger.Warn("Unable to collect diagnostics section.", tag.NewStringTag("section", section.name), tag.Error(err))
+			bundle.errors = append(bundle.errors, fmt.Sprintf("%s: %v", section.name, err))
+		}
+	}
+	if len(bundle.errors) > 0 {
+		if err := bundle.add(errorsFile, []byte(strings.Join(bundle.errors, "\n")+"\n")); err != nil {
+			return err
+		}
+	}
+
+	if err := bundle.tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+func (c *Collector) writeHostInfo(_ context.Context, bundle *bundleWriter) error {
+	info := HostInfo{
+		ServerVersion: headers.ServerVersion,
+		GoVersion:     runtime.Version(),
+		NumGoroutine:  runtime.NumGoroutine(),
+		CollectTime:   bundle.modTime,
+	}
+	info.Hostname, _ = os.Hostname()
+	if self, err := c.membershipMonitor.WhoAmI(); err == nil {
+		info.Identity = self.Identity()
+	}
+	return bundle.addJSON(hostFile, info)
+}
+
+func writeProfile(name string, file string, debug int) func(context.Context, *bundleWriter) error {
+	return func(_ context.Context, bundle *bundleWriter) error {
+		profile := pprof.Lookup(name)
+		if profile == nil {
+			return fmt.Errorf("unknown profile %q", name)
+		}
+		var buf bytes.Buffer
+		if err := profile.WriteTo(&buf, debug); err != nil {
+			return err
+		}
+		return bundle.add(file, buf.Bytes())
+	}
+}
+
+func (c *Collector) writeDynamicConfig(_ context.Context, bundle *bundleWriter) error {
+	if c.dynamicConfigClient == nil {
+		return nil
+	}
+	return bundle.addJSON(dynamicConfigFile, dynamicconfig.EffectiveConfig(c.dynamicConfigClient))
+}
+
+func (c *Collector) writeMembership(_ context.Context, bundle *bundleWriter) error {
+	var services []ServiceMembers
+	for _, service := range membershipServices {
+		resolver, err := c.membershipMonitor.GetResolver(service)
+		if err != nil {
+			// services which aren't part of this deployment have no resolver
+			continue
+		}
+		members := ServiceMembers{Service: service}
+		for _, host := range resolver.Members() {
+			members.Members = append(members.Members, host.GetAddress())
+		}
+		sort.Strings(members.Members)
+		services = append(services, members)
+	}
+	return bundle.addJSON(membershipFile, services)
+}
+
+func (c *Collector) writeShardOwners(_ context.Context, bundle *bundleWriter) error {
+	resolver, err := c.membershipMonitor.GetResolver(primitives.HistoryService)
+	if err != nil {
+		return err
+	}
+	owners := make([]ShardOwner, 0, c.numHistoryShards)
+	for shardID := int32(1); shardID <= c.numHistoryShards; shardID++ {
+		owner := ShardOwner{ShardID: shardID}
+		if host, err := resolver.Lookup(convert.Int32ToString(shardID)); err == nil {
+			owner.Owner = host.GetAddress()
+		}
+		owners = append(owners, owner)
+	}
+	return bundle.addJSON(shardsFile, owners)
+}
+
+func (c *Collector) writeMetrics(ctx context.Context, bundle *bundleWriter) error {
+	if c.metricsURL == "" {
+		return nil
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, c.metricsURL, nil)
+	if err != nil {
+		return err
+	}
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = response.Body.Close() }()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("metrics endpoint returned %s", response.Status)
+	}
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	return bundle.add(metricsFile, body)
+}
+
+// prometheusURL returns the local URL of the Prometheus listener configured for this host, if any
+func prometheusURL(config *metrics.Config) string {
+	if config == nil || config.Prometheus == nil || config.Prometheus.ListenAddress == "" {
+		return ""
+	}
+	if network := config.Prometheus.ListenNetwork; network != "" && !strings.HasPrefix(network, "tcp") {
+		return ""
+	}
+	host, port, err := net.SplitHostPort(config.Prometheus.ListenAddress)
+	if err != nil {
+		return ""
+	}
+	if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		host = "localhost"
+	}
+	handlerPath := config.Prometheus.HandlerPath
+	if handlerPath == "" {
+		handlerPath = "/metrics"
+	}
+	return "http://" + net.JoinHostPort(host, port) + handlerPath
+}
+
+func (b *bundleWriter) addJSON(name string, value any) error {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+	return b.add(name, data)
+}
+
+func (b *bundleWriter) add(name string, data []byte) error {
+	if err := b.tarWriter.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: b.modTime,
+	}); err != nil {
+		return err
+	}
+	_, err := b.tarWriter.Write(data)
+	return err
+}
@@ -0,0 +1,148 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package diagnostics
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/primitives"
+)
+
+func TestCollectorWrite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	monitor := membership.NewMockMonitor(ctrl)
+	historyResolver := membership.NewMockServiceResolver(ctrl)
+	monitor.EXPECT().WhoAmI().Return(membership.NewHostInfoFromAddress("10.0.0.1:7233"), nil)
+	monitor.EXPECT().GetResolver(primitives.HistoryService).Return(historyResolver, nil).Times(2)
+	monitor.EXPECT().GetResolver(gomock.Any()).Return(nil, errors.New("unknown service")).AnyTimes()
+	historyResolver.EXPECT().Members().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("10.0.0.3:7234"),
+		membership.NewHostInfoFromAddress("10.0.0.2:7234"),
+	})
+	historyResolver.EXPECT().Lookup("1").Return(membership.NewHostInfoFromAddress("10.0.0.2:7234"), nil)
+	historyResolver.EXPECT().Lookup("2").Return(membership.NewHostInfoFromAddress("10.0.0.3:7234"), nil)
+
+	metricsServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("service_requests 1\n"))
+	}))
+	defer metricsServer.Close()
+
+	collector := NewCollector(2, monitor, dynamicconfig.NewNoopClient(), nil, clock.NewRealTimeSource(), log.NewNoopLogger())
+	collector.metricsURL = metricsServer.URL
+
+	var buf bytes.Buffer
+	require.NoError(t, collector.Write(context.Background(), &buf))
+	files := readBundle(t, &buf)
+
+	require.Contains(t, files, goroutinesFile)
+	require.Contains(t, files, heapFile)
+	require.Contains(t, files, dynamicConfigFile)
+	require.NotContains(t, files, errorsFile)
+	require.Equal(t, "service_requests 1\n", string(files[metricsFile]))
+
+	var host HostInfo
+	require.NoError(t, json.Unmarshal(files[hostFile], &host))
+	require.Equal(t, "10.0.0.1:7233", host.Identity)
+
+	var services []ServiceMembers
+	require.NoError(t, json.Unmarshal(files[membershipFile], &services))
+	require.Equal(t, []ServiceMembers{{
+		Service: primitives.HistoryService,
+		Members: []string{"10.0.0.2:7234", "10.0.0.3:7234"},
+	}}, services)
+
+	var owners []ShardOwner
+	require.NoError(t, json.Unmarshal(files[shardsFile], &owners))
+	require.Equal(t, []ShardOwner{{ShardID: 1, Owner: "10.0.0.2:7234"}, {ShardID: 2, Owner: "10.0.0.3:7234"}}, owners)
+}
+
+func TestCollectorWrite_PartialFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	monitor := membership.NewMockMonitor(ctrl)
+	monitor.EXPECT().WhoAmI().Return(nil, errors.New("not initialized"))
+	monitor.EXPECT().GetResolver(gomock.Any()).Return(nil, errors.New("not initialized")).AnyTimes()
+
+	collector := NewCollector(1, monitor, nil, nil, clock.NewRealTimeSource(), log.NewNoopLogger())
+
+	var buf bytes.Buffer
+	require.NoError(t, collector.Write(context.Background(), &buf))
+	files := readBundle(t, &buf)
+
+	require.Contains(t, files, goroutinesFile)
+	require.NotContains(t, files, shardsFile)
+	require.NotContains(t, files, metricsFile)
+	require.Contains(t, string(files[errorsFile]), shardsFile)
+}
+
+func TestPrometheusURL(t *testing.T) {
+	testCases := []struct {
+		config   *metrics.Config
+		expected string
+	}{
+		{nil, ""},
+		{&metrics.Config{}, ""},
+		{&metrics.Config{Prometheus: &metrics.PrometheusConfig{ListenAddress: "0.0.0.0:9090"}}, "http://localhost:9090/metrics"},
+		{&metrics.Config{Prometheus: &metrics.PrometheusConfig{ListenAddress: ":9090", HandlerPath: "/prom"}}, "http://localhost:9090/prom"},
+		{&metrics.Config{Prometheus: &metrics.PrometheusConfig{ListenAddress: "10.0.0.1:9090"}}, "http://10.0.0.1:9090/metrics"},
+		{&metrics.Config{Prometheus: &metrics.PrometheusConfig{ListenAddress: "/tmp/metrics.sock", ListenNetwork: "unix"}}, ""},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, prometheusURL(tc.config))
+	}
+}
+
+func readBundle(t *testing.T, r io.Reader) map[string][]byte {
+	gzipReader, err := gzip.NewReader(r)
+	require.NoError(t, err)
+	tarReader := tar.NewReader(gzipReader)
+	files := make(map[string][]byte)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return files
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tarReader)
+		require.NoError(t, err)
+		files[header.Name] = data
+	}
+}
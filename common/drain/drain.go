@@ -0,0 +1,282 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package drain puts hosts into drain mode ahead of a restart. Drain targets, a whole service role or a
+// single host of a role, are stored in the data of the system namespace. Every host watches them and, once
+// targeted, hands its work over to the other hosts of its role and reports its progress in the same place.
+package drain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+)
+
+// DataKey is the key of the drain document in the data of the system namespace
+const DataKey = "drain"
+
+const (
+	// StateDraining is reported by a host while it hands its work over
+	StateDraining State = "Draining"
+	// StateDrained is reported by a host once it has no work left, it stays drained until it restarts
+	StateDrained State = "Drained"
+	// StateFailed is reported by a host which could not complete its drain
+	StateFailed State = "Failed"
+)
+
+var (
+	// Roles are the service roles which support drain mode
+	Roles = []primitives.ServiceName{
+		primitives.FrontendService,
+		primitives.InternalFrontendService,
+		primitives.HistoryService,
+		primitives.MatchingService,
+	}
+
+	updateRetryPolicy = backoff.NewExponentialRetryPolicy(50 * time.Millisecond).
+				WithMaximumAttempts(5)
+)
+
+type (
+	// State is the drain state reported by a host
+	State string
+
+	// Target selects the hosts to drain: all hosts of Role, or only the host with address Host
+	Target struct {
+		Role        primitives.ServiceName `json:"role"`
+		Host        string                 `json:"host,omitempty"`
+		RequestTime time.Time              `json:"requestTime"`
+	}
+
+	// HostStatus is the drain progress reported by a targeted host
+	HostStatus struct {
+		Role       primitives.ServiceName `json:"role"`
+		Host       string                 `json:"host"`
+		State      State                  `json:"state"`
+		Error      string                 `json:"error,omitempty"`
+		UpdateTime time.Time              `json:"updateTime"`
+	}
+
+	// TargetStatus is the progress of a drain target. It is drained once all hosts it selects are drained.
+	TargetStatus struct {
+		Target  Target
+		Hosts   []HostStatus
+		Pending []string
+		Drained bool
+	}
+
+	// Document is the set of drain targets and the status reported by the hosts they selected
+	Document struct {
+		Targets []Target     `json:"targets,omitempty"`
+		Hosts   []HostStatus `json:"hosts,omitempty"`
+	}
+)
+
+// Parse parses the drain document stored in the data of the system namespace, which may be empty
+func Parse(data string) (*Document, error) {
+	var doc Document
+	if data == "" {
+		return &doc, nil
+	}
+	if err := json.Unmarshal([]byte(data), &doc); err != nil {
+		return nil, fmt.Errorf("invalid drain document: %w", err)
+	}
+	return &doc, nil
+}
+
+// Encode encodes the document to be stored in the data of the system namespace
+func (d *Document) Encode() (string, error) {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// Selects returns whether the target selects the host of the role
+func (t Target) Selects(role primitives.ServiceName, host string) bool {
+	return t.Role == role && (t.Host == "" || t.Host == host)
+}
+
+// Targeted returns whether any target selects the host of the role
+func (d *Document) Targeted(role primitives.ServiceName, host string) bool {
+	return slices.IndexFunc(d.Targets, func(t Target) bool { return t.Selects(role, host) }) >= 0
+}
+
+// AddTarget adds the target, it returns false if the document already has it
+func (d *Document) AddTarget(target Target) bool {
+	if slices.IndexFunc(d.Targets, func(t Target) bool { return t.Role == target.Role && t.Host == target.Host }) >= 0 {
+		return false
+	}
+	d.Targets = append(d.Targets, target)
+	return true
+}
+
+// RemoveTarget removes the target of the role and host, and the status of the hosts no other target selects.
+// It returns false if the document has no such target.
+func (d *Document) RemoveTarget(role primitives.ServiceName, host string) bool {
+	index := slices.IndexFunc(d.Targets, func(t Target) bool { return t.Role == role && t.Host == host })
+	if index < 0 {
+		return false
+	}
+	d.Targets = slices.Delete(d.Targets, index, index+1)
+	hosts := d.Hosts[:0]
+	for _, status := range d.Hosts {
+		if d.Targeted(status.Role, status.Host) {
+			hosts = append(hosts, status)
+		}
+	}
+	d.Hosts = hosts
+	return true
+}
+
+// SetHostStatus records the status reported by a host, replacing its previous status
+func (d *Document) SetHostStatus(status HostStatus) {
+	index := slices.IndexFunc(d.Hosts, func(s HostStatus) bool { return s.Role == status.Role && s.Host == status.Host })
+	if index < 0 {
+		d.Hosts = append(d.Hosts, status)
+		return
+	}
+	d.Hosts[index] = status
+}
+
+// Status returns the progress of every target. members returns the current hosts of a role, which are
+// pending until they report being drained. Drained hosts have left the membership ring.
+func (d *Document) Status(members func(role primitives.ServiceName) []string) []TargetStatus {
+	statuses := make([]TargetStatus, 0, len(d.Targets))
+	for _, target := range d.Targets {
+		status := TargetStatus{Target: target}
+		reported := make(map[string]State)
+		for _, host := range d.Hosts {
+			if target.Selects(host.Role, host.Host) {
+				status.Hosts = append(status.Hosts, host)
+				reported[host.Host] = host.State
+			}
+		}
+		pending := make(map[string]struct{})
+		if target.Host != "" && reported[target.Host] != StateDrained {
+			pending[target.Host] = struct{}{}
+		}
+		for _, member := range members(target.Role) {
+			if target.Selects(target.Role, member) && reported[member] != StateDrained {
+				pending[member] = struct{}{}
+			}
+		}
+		status.Pending = maps.Keys(pending)
+		slices.Sort(status.Pending)
+		status.Drained = len(status.Pending) == 0 && len(status.Hosts) > 0
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// Update applies update to the drain document stored in the data of the system namespace. The update is
+// retried with the latest document if the namespace was concurrently updated.
+func Update(
+	ctx context.Context,
+	metadataManager persistence.MetadataManager,
+	update func(doc *Document) error,
+) error {
+	op := func(ctx context.Context) error {
+		// the notification version must be read before the namespace, so that an update committed in
+		// between fails the version check rather than being overwritten
+		metadata, err := metadataManager.GetMetadata(ctx)
+		if err != nil {
+			return err
+		}
+		resp, err := metadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: primitives.SystemLocalNamespace})
+		if err != nil {
+			return err
+		}
+		detail := resp.Namespace
+		doc, err := Parse(detail.Info.Data[DataKey])
+		if err != nil {
+			return &updateError{err}
+		}
+		if err := update(doc); err != nil {
+			return &updateError{err}
+		}
+		encoded, err := doc.Encode()
+		if err != nil {
+			return err
+		}
+		data := make(map[string]string, len(detail.Info.Data)+1)
+		maps.Copy(data, detail.Info.Data)
+		data[DataKey] = encoded
+		detail.Info.Data = data
+		return metadataManager.UpdateNamespace(ctx, &persistence.UpdateNamespaceRequest{
+			Namespace:           detail,
+			IsGlobalNamespace:   resp.IsGlobalNamespace,
+			NotificationVersion: metadata.NotificationVersion,
+		})
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, updateRetryPolicy, isRetryableUpdateError)
+	if updateErr, ok := err.(*updateError); ok {
+		return updateErr.error
+	}
+	return err
+}
+
+// updateError wraps the errors of the update function, which are not retried unlike the failures to update
+// the namespace
+type updateError struct {
+	error
+}
+
+func isRetryableUpdateError(err error) bool {
+	_, ok := err.(*updateError)
+	return !ok
+}
+
+// Sleep waits for d, it returns early with the error of ctx if ctx is done first
+func Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// WaitUntil calls done every interval until it returns true, it returns early with the error of ctx if ctx
+// is done first
+func WaitUntil(ctx context.Context, interval time.Duration, done func() bool) error {
+	for !done() {
+		if err := Sleep(ctx, interval); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package drain
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+)
+
+func TestDocument(t *testing.T) {
+	doc, err := Parse("")
+	require.NoError(t, err)
+	require.False(t, doc.Targeted(primitives.HistoryService, "10.0.0.1:7234"))
+
+	require.True(t, doc.AddTarget(Target{Role: primitives.HistoryService, Host: "10.0.0.1:7234"}))
+	require.False(t, doc.AddTarget(Target{Role: primitives.HistoryService, Host: "10.0.0.1:7234"}))
+	require.True(t, doc.AddTarget(Target{Role: primitives.MatchingService}))
+	require.True(t, doc.Targeted(primitives.HistoryService, "10.0.0.1:7234"))
+	require.False(t, doc.Targeted(primitives.HistoryService, "10.0.0.2:7234"))
+	require.True(t, doc.Targeted(primitives.MatchingService, "10.0.0.2:7235"))
+
+	doc.SetHostStatus(HostStatus{Role: primitives.HistoryService, Host: "10.0.0.1:7234", State: StateDraining})
+	doc.SetHostStatus(HostStatus{Role: primitives.HistoryService, Host: "10.0.0.1:7234", State: StateDrained})
+	doc.SetHostStatus(HostStatus{Role: primitives.MatchingService, Host: "10.0.0.2:7235", State: StateDrained})
+	require.Len(t, doc.Hosts, 2)
+
+	encoded, err := doc.Encode()
+	require.NoError(t, err)
+	doc, err = Parse(encoded)
+	require.NoError(t, err)
+
+	members := map[primitives.ServiceName][]string{
+		primitives.MatchingService: {"10.0.0.3:7235"},
+	}
+	statuses := doc.Status(func(role primitives.ServiceName) []string { return members[role] })
+	require.Len(t, statuses, 2)
+	require.True(t, statuses[0].Drained)
+	require.Empty(t, statuses[0].Pending)
+	require.False(t, statuses[1].Drained)
+	require.Equal(t, []string{"10.0.0.3:7235"}, statuses[1].Pending)
+
+	require.True(t, doc.RemoveTarget(primitives.MatchingService, ""))
+	require.False(t, doc.RemoveTarget(primitives.MatchingService, ""))
+	require.Len(t, doc.Targets, 1)
+	require.Len(t, doc.Hosts, 1)
+
+	_, err = Parse("{")
+	require.Error(t, err)
+}
+
+// fakeSystemNamespace keeps the data of the system namespace for a mock metadata manager
+type fakeSystemNamespace struct {
+	data    map[string]string
+	version int64
+	// concurrentUpdate, when set, is applied once right after the first read of an update
+	concurrentUpdate map[string]string
+}
+
+func (f *fakeSystemNamespace) read() {
+	if f.concurrentUpdate != nil {
+		f.data = f.concurrentUpdate
+		f.concurrentUpdate = nil
+		f.version++
+	}
+}
+
+func (f *fakeSystemNamespace) expect(metadataManager *persistence.MockMetadataManager) {
+	metadataManager.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(context.Context, *persistence.GetNamespaceRequest) (*persistence.GetNamespaceResponse, error) {
+			defer f.read()
+			return &persistence.GetNamespaceResponse{
+				Namespace: &persistencespb.NamespaceDetail{
+					Info: &persistencespb.NamespaceInfo{Name: primitives.SystemLocalNamespace, Data: f.data},
+				},
+			}, nil
+		}).AnyTimes()
+	metadataManager.EXPECT().GetMetadata(gomock.Any()).DoAndReturn(
+		func(context.Context) (*persistence.GetMetadataResponse, error) {
+			defer f.read()
+			return &persistence.GetMetadataResponse{NotificationVersion: f.version}, nil
+		}).AnyTimes()
+	metadataManager.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			if request.NotificationVersion != f.version {
+				return errors.New("conditional update failed")
+			}
+			f.data = request.Namespace.Info.Data
+			f.version++
+			return nil
+		}).AnyTimes()
+}
+
+func TestUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metadataManager := persistence.NewMockMetadataManager(ctrl)
+	ns := &fakeSystemNamespace{data: map[string]string{"other": "value"}}
+	ns.expect(metadataManager)
+
+	err := Update(context.Background(), metadataManager, func(doc *Document) error {
+		doc.AddTarget(Target{Role: primitives.FrontendService})
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "value", ns.data["other"])
+	doc, err := Parse(ns.data[DataKey])
+	require.NoError(t, err)
+	require.True(t, doc.Targeted(primitives.FrontendService, "10.0.0.1:7233"))
+
+	updateErr := errors.New("invalid update")
+	err = Update(context.Background(), metadataManager, func(doc *Document) error {
+		return updateErr
+	})
+	require.Equal(t, updateErr, err)
+	require.EqualValues(t, 1, ns.version)
+}
+
+func TestUpdate_ConcurrentUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metadataManager := persistence.NewMockMetadataManager(ctrl)
+	ns := &fakeSystemNamespace{
+		data:             map[string]string{"other": "value"},
+		concurrentUpdate: map[string]string{"other": "updated"},
+	}
+	ns.expect(metadataManager)
+
+	err := Update(context.Background(), metadataManager, func(doc *Document) error {
+		doc.AddTarget(Target{Role: primitives.FrontendService})
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "updated", ns.data["other"])
+	require.Contains(t, ns.data, DataKey)
+	require.EqualValues(t, 2, ns.version)
+}
+
+func TestWatcher(t *testing.T) {
+	const host = "10.0.0.1:7234"
+	ctrl := gomock.NewController(t)
+	metadataManager := persistence.NewMockMetadataManager(ctrl)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	membershipMonitor := membership.NewMockMonitor(ctrl)
+	membershipMonitor.EXPECT().WhoAmI().Return(membership.NewHostInfoFromAddress(host), nil).AnyTimes()
+
+	doc := &Document{}
+	doc.AddTarget(Target{Role: primitives.HistoryService, Host: host})
+	encoded, err := doc.Encode()
+	require.NoError(t, err)
+	ns := &fakeSystemNamespace{data: map[string]string{DataKey: encoded}}
+	ns.expect(metadataManager)
+	namespaceRegistry.EXPECT().GetNamespace(namespace.Name(primitives.SystemLocalNamespace)).DoAndReturn(
+		func(namespace.Name) (*namespace.Namespace, error) {
+			return namespace.NewLocalNamespaceForTest(
+				&persistencespb.NamespaceInfo{Name: primitives.SystemLocalNamespace, Data: ns.data}, nil, "active",
+			), nil
+		}).AnyTimes()
+
+	drained := 0
+	watcher := NewWatcher(
+		primitives.HistoryService,
+		DrainerFunc(func(context.Context) error {
+			drained++
+			return nil
+		}),
+		membershipMonitor,
+		namespaceRegistry,
+		metadataManager,
+		clock.NewRealTimeSource(),
+		log.NewNoopLogger(),
+	)
+
+	watcher.check()
+	select {
+	case <-watcher.drainDone:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "host was not drained")
+	}
+	watcher.check()
+	require.Equal(t, 1, drained)
+
+	doc, err = Parse(ns.data[DataKey])
+	require.NoError(t, err)
+	require.Len(t, doc.Hosts, 1)
+	require.Equal(t, StateDrained, doc.Hosts[0].State)
+	require.Equal(t, host, doc.Hosts[0].Host)
+}
+
+func TestWaitUntil(t *testing.T) {
+	calls := 0
+	require.NoError(t, WaitUntil(context.Background(), time.Millisecond, func() bool {
+		calls++
+		return calls == 3
+	}))
+	require.Equal(t, 3, calls)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, WaitUntil(ctx, time.Millisecond, func() bool { return false }), context.Canceled)
+}
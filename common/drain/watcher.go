@@ -0,0 +1,200 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package drain
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+)
+
+const (
+	watchInterval = 5 * time.Second
+	// statusUpdateTimeout bounds the time taken to report the status of the host
+	statusUpdateTimeout = 10 * time.Second
+)
+
+type (
+	// Drainer hands the work of a host over to the other hosts of its role. Drain blocks until the host has
+	// no work left, or ctx is done. The host keeps serving the requests it still receives, but must not take
+	// on new work, until it restarts.
+	Drainer interface {
+		Drain(ctx context.Context) error
+	}
+
+	// DrainerFunc is a function implementing Drainer
+	DrainerFunc func(ctx context.Context) error
+
+	// Watcher starts the drain of its host once the drain document of the system namespace targets it
+	Watcher struct {
+		status            int32
+		role              primitives.ServiceName
+		drainer           Drainer
+		membershipMonitor membership.Monitor
+		namespaceRegistry namespace.Registry
+		metadataManager   persistence.MetadataManager
+		timeSource        clock.TimeSource
+		logger            log.Logger
+
+		once       sync.Once
+		ctx        context.Context
+		cancel     context.CancelFunc
+		shutdownCh chan struct{}
+		drainDone  chan struct{}
+	}
+)
+
+func (f DrainerFunc) Drain(ctx context.Context) error {
+	return f(ctx)
+}
+
+func NewWatcher(
+	role primitives.ServiceName,
+	drainer Drainer,
+	membershipMonitor membership.Monitor,
+	namespaceRegistry namespace.Registry,
+	metadataManager persistence.MetadataManager,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) *Watcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Watcher{
+		status:            common.DaemonStatusInitialized,
+		role:              role,
+		drainer:           drainer,
+		membershipMonitor: membershipMonitor,
+		namespaceRegistry: namespaceRegistry,
+		metadataManager:   metadataManager,
+		timeSource:        timeSource,
+		logger:            log.With(logger, tag.ComponentDrain),
+		ctx:               ctx,
+		cancel:            cancel,
+		shutdownCh:        make(chan struct{}),
+		drainDone:         make(chan struct{}),
+	}
+}
+
+func (w *Watcher) Start() {
+	if !atomic.CompareAndSwapInt32(&w.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	go w.watchLoop()
+}
+
+// Stop stops watching the drain document, and interrupts the drain of the host if it is in progress
+func (w *Watcher) Stop() {
+	if !atomic.CompareAndSwapInt32(&w.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(w.shutdownCh)
+	w.cancel()
+}
+
+func (w *Watcher) watchLoop() {
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.shutdownCh:
+			return
+		case <-w.drainDone:
+			return
+		case <-ticker.C:
+			w.check()
+		}
+	}
+}
+
+// check starts the drain of the host if the drain document targets it
+func (w *Watcher) check() {
+	ns, err := w.namespaceRegistry.GetNamespace(primitives.SystemLocalNamespace)
+	if err != nil {
+		return
+	}
+	doc, err := Parse(ns.GetCustomData(DataKey))
+	if err != nil {
+		w.logger.Error("Unable to parse drain document.", tag.Error(err))
+		return
+	}
+	self, err := w.membershipMonitor.WhoAmI()
+	if err != nil {
+		return
+	}
+	if doc.Targeted(w.role, self.GetAddress()) {
+		w.once.Do(func() { go w.drain(self.GetAddress()) })
+	}
+}
+
+func (w *Watcher) drain(host string) {
+	defer close(w.drainDone)
+
+	logger := log.With(w.logger, tag.Address(host))
+	logger.Info("Draining host.")
+	w.reportStatus(host, StateDraining, nil)
+
+	if err := w.drainer.Drain(w.ctx); err != nil {
+		logger.Error("Unable to drain host.", tag.Error(err))
+		w.reportStatus(host, StateFailed, err)
+		return
+	}
+	logger.Info("Host drained.")
+	w.reportStatus(host, StateDrained, nil)
+}
+
+func (w *Watcher) reportStatus(host string, state State, drainErr error) {
+	ctx, cancel := context.WithTimeout(w.ctx, statusUpdateTimeout)
+	defer cancel()
+
+	status := HostStatus{
+		Role:       w.role,
+		Host:       host,
+		State:      state,
+		UpdateTime: w.timeSource.Now().UTC(),
+	}
+	if drainErr != nil {
+		status.Error = drainErr.Error()
+	}
+	err := Update(ctx, w.metadataManager, func(doc *Document) error {
+		// the target may have been cancelled in the meantime
+		if doc.Targeted(w.role, host) {
+			doc.SetHostStatus(status)
+		}
+		return nil
+	})
+	if err != nil {
+		w.logger.Error("Unable to report drain status.", tag.NewStringTag("state", string(state)), tag.Error(err))
+	}
+}
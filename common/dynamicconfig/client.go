@@ -64,9 +64,14 @@ type (
 	// timestamp.ParseDurationDefaultDays. If float64 is expected, int is also accepted. In
 	// other cases, the exact type must be used. If a Value is returned with an unexpected
 	// type, it will be ignored.
+	//
+	// If Rollout is set, the value only applies to that percentage of the namespaces, task queues
+	// or shards it is looked up for, selected by hashing the filters of the lookup. Others fall
+	// through to the next value with the same constraints.
 	ConstrainedValue struct {
 		Constraints Constraints
 		Value       any
+		Rollout     *int
 	}
 
 	// Constraints describe under what conditions a ConstrainedValue should be used.
@@ -82,6 +87,11 @@ type (
 	//     TaskQueueName
 	//     Namespace
 	//     no constraints
+	//   build id precedence, for workers of a given build polling a versioned task queue:
+	//     Namespace+TaskQueueName+TaskQueueType+BuildID
+	//     Namespace+TaskQueueName+BuildID
+	//     Namespace+BuildID
+	//     then task queue precedence
 	//   shard id precedence:
 	//     ShardID
 	//     no constraints
@@ -98,5 +108,6 @@ type (
 		TaskQueueType enumspb.TaskQueueType
 		ShardID       int32
 		TaskType      enumsspb.TaskType
+		BuildID       string
 	}
 )
@@ -27,6 +27,7 @@ package dynamicconfig
 import (
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"sync/atomic"
 	"time"
 
@@ -62,6 +63,7 @@ type (
 	//   Namespace func(namespace string)
 	//   NamespaceID func(namespaceID string)
 	//   TaskQueueInfo func(namespace string, taskQueue string, taskType enumspb.TaskQueueType)
+	//   BuildID func(namespace string, taskQueue string, taskType enumspb.TaskQueueType, buildID string)
 	//   ShardID func(shardID int32)
 	BoolPropertyFn                             func() bool
 	BoolPropertyFnWithNamespaceFilter          func(namespace string) bool
@@ -77,6 +79,7 @@ type (
 	FloatPropertyFnWithNamespaceFilter         func(namespace string) float64
 	FloatPropertyFnWithShardIDFilter           func(shardID int32) float64
 	FloatPropertyFnWithTaskQueueInfoFilters    func(namespace string, taskQueue string, taskType enumspb.TaskQueueType) float64
+	FloatPropertyFnWithBuildIDFilters          func(namespace string, taskQueue string, taskType enumspb.TaskQueueType, buildID string) float64
 	IntPropertyFn                              func() int
 	IntPropertyFnWithNamespaceFilter           func(namespace string) int
 	IntPropertyFnWithShardIDFilter             func(shardID int32) int
@@ -113,8 +116,17 @@ func (c *Collection) throttleLog() bool {
 	return errCount < errCountLogThreshold || errCount%errCountLogThreshold == 0
 }
 
+// Subscribe calls callback after the values of any of keys change, until cancel is called, so that
+// long-lived components can react to changes without polling. Callbacks are called from the goroutine
+// updating the values and must not block. Clients which do not notify changes never call callback,
+// so components should keep refreshing periodically as well.
+func (c *Collection) Subscribe(callback func(), keys ...Key) (cancel func()) {
+	return subscribeToClient(c.client, callback, keys)
+}
+
 // GetIntProperty gets property and asserts that it's an integer
 func (c *Collection) GetIntProperty(key Key, defaultValue any) IntPropertyFn {
+	registerSchema(key, IntType, nil, defaultValue)
 	return func() int {
 		return matchAndConvert(
 			c,
@@ -128,6 +140,7 @@ func (c *Collection) GetIntProperty(key Key, defaultValue any) IntPropertyFn {
 
 // GetIntPropertyFilteredByNamespace gets property with namespace filter and asserts that it's an integer
 func (c *Collection) GetIntPropertyFilteredByNamespace(key Key, defaultValue any) IntPropertyFnWithNamespaceFilter {
+	registerSchema(key, IntType, namespaceFilters, defaultValue)
 	return func(namespace string) int {
 		return matchAndConvert(
 			c,
@@ -141,6 +154,7 @@ func (c *Collection) GetIntPropertyFilteredByNamespace(key Key, defaultValue any
 
 // GetIntPropertyFilteredByTaskQueueInfo gets property with taskQueueInfo as filters and asserts that it's an integer
 func (c *Collection) GetIntPropertyFilteredByTaskQueueInfo(key Key, defaultValue any) IntPropertyFnWithTaskQueueInfoFilters {
+	registerSchema(key, IntType, taskQueueInfoFilters, defaultValue)
 	return func(namespace string, taskQueue string, taskType enumspb.TaskQueueType) int {
 		return matchAndConvert(
 			c,
@@ -154,6 +168,7 @@ func (c *Collection) GetIntPropertyFilteredByTaskQueueInfo(key Key, defaultValue
 
 // GetIntPropertyFilteredByShardID gets property with shardID as filter and asserts that it's an integer
 func (c *Collection) GetIntPropertyFilteredByShardID(key Key, defaultValue any) IntPropertyFnWithShardIDFilter {
+	registerSchema(key, IntType, shardIDFilters, defaultValue)
 	return func(shardID int32) int {
 		return matchAndConvert(
 			c,
@@ -167,6 +182,7 @@ func (c *Collection) GetIntPropertyFilteredByShardID(key Key, defaultValue any)
 
 // GetFloat64Property gets property and asserts that it's a float64
 func (c *Collection) GetFloat64Property(key Key, defaultValue any) FloatPropertyFn {
+	registerSchema(key, FloatType, nil, defaultValue)
 	return func() float64 {
 		return matchAndConvert(
 			c,
@@ -180,6 +196,7 @@ func (c *Collection) GetFloat64Property(key Key, defaultValue any) FloatProperty
 
 // GetFloat64PropertyFilteredByShardID gets property with shardID filter and asserts that it's a float64
 func (c *Collection) GetFloat64PropertyFilteredByShardID(key Key, defaultValue any) FloatPropertyFnWithShardIDFilter {
+	registerSchema(key, FloatType, shardIDFilters, defaultValue)
 	return func(shardID int32) float64 {
 		return matchAndConvert(
 			c,
@@ -193,6 +210,7 @@ func (c *Collection) GetFloat64PropertyFilteredByShardID(key Key, defaultValue a
 
 // GetFloatPropertyFilteredByNamespace gets property with namespace filter and asserts that it's a float64
 func (c *Collection) GetFloatPropertyFilteredByNamespace(key Key, defaultValue any) FloatPropertyFnWithNamespaceFilter {
+	registerSchema(key, FloatType, namespaceFilters, defaultValue)
 	return func(namespace string) float64 {
 		return matchAndConvert(
 			c,
@@ -206,6 +224,7 @@ func (c *Collection) GetFloatPropertyFilteredByNamespace(key Key, defaultValue a
 
 // GetFloatPropertyFilteredByTaskQueueInfo gets property with taskQueueInfo as filters and asserts that it's a float64
 func (c *Collection) GetFloatPropertyFilteredByTaskQueueInfo(key Key, defaultValue any) FloatPropertyFnWithTaskQueueInfoFilters {
+	registerSchema(key, FloatType, taskQueueInfoFilters, defaultValue)
 	return func(namespace string, taskQueue string, taskType enumspb.TaskQueueType) float64 {
 		return matchAndConvert(
 			c,
@@ -217,8 +236,23 @@ func (c *Collection) GetFloatPropertyFilteredByTaskQueueInfo(key Key, defaultVal
 	}
 }
 
+// GetFloatPropertyFilteredByBuildID gets property with taskQueueInfo and worker build ID as filters and asserts that it's a float64
+func (c *Collection) GetFloatPropertyFilteredByBuildID(key Key, defaultValue any) FloatPropertyFnWithBuildIDFilters {
+	registerSchema(key, FloatType, buildIDFilters, defaultValue)
+	return func(namespace string, taskQueue string, taskType enumspb.TaskQueueType, buildID string) float64 {
+		return matchAndConvert(
+			c,
+			key,
+			defaultValue,
+			buildIDPrecedence(namespace, taskQueue, taskType, buildID),
+			convertFloat,
+		)
+	}
+}
+
 // GetDurationProperty gets property and asserts that it's a duration
 func (c *Collection) GetDurationProperty(key Key, defaultValue any) DurationPropertyFn {
+	registerSchema(key, DurationType, nil, defaultValue)
 	return func() time.Duration {
 		return matchAndConvert(
 			c,
@@ -232,6 +266,7 @@ func (c *Collection) GetDurationProperty(key Key, defaultValue any) DurationProp
 
 // GetDurationPropertyFilteredByNamespace gets property with namespace filter and asserts that it's a duration
 func (c *Collection) GetDurationPropertyFilteredByNamespace(key Key, defaultValue any) DurationPropertyFnWithNamespaceFilter {
+	registerSchema(key, DurationType, namespaceFilters, defaultValue)
 	return func(namespace string) time.Duration {
 		return matchAndConvert(
 			c,
@@ -245,6 +280,7 @@ func (c *Collection) GetDurationPropertyFilteredByNamespace(key Key, defaultValu
 
 // GetDurationPropertyFilteredByNamespaceID gets property with namespaceID filter and asserts that it's a duration
 func (c *Collection) GetDurationPropertyFilteredByNamespaceID(key Key, defaultValue any) DurationPropertyFnWithNamespaceIDFilter {
+	registerSchema(key, DurationType, namespaceIDFilters, defaultValue)
 	return func(namespaceID string) time.Duration {
 		return matchAndConvert(
 			c,
@@ -258,6 +294,7 @@ func (c *Collection) GetDurationPropertyFilteredByNamespaceID(key Key, defaultVa
 
 // GetDurationPropertyFilteredByTaskQueueInfo gets property with taskQueueInfo as filters and asserts that it's a duration
 func (c *Collection) GetDurationPropertyFilteredByTaskQueueInfo(key Key, defaultValue any) DurationPropertyFnWithTaskQueueInfoFilters {
+	registerSchema(key, DurationType, taskQueueInfoFilters, defaultValue)
 	return func(namespace string, taskQueue string, taskType enumspb.TaskQueueType) time.Duration {
 		return matchAndConvert(
 			c,
@@ -271,6 +308,7 @@ func (c *Collection) GetDurationPropertyFilteredByTaskQueueInfo(key Key, default
 
 // GetDurationPropertyFilteredByShardID gets property with shardID id as filter and asserts that it's a duration
 func (c *Collection) GetDurationPropertyFilteredByShardID(key Key, defaultValue any) DurationPropertyFnWithShardIDFilter {
+	registerSchema(key, DurationType, shardIDFilters, defaultValue)
 	return func(shardID int32) time.Duration {
 		return matchAndConvert(
 			c,
@@ -284,6 +322,7 @@ func (c *Collection) GetDurationPropertyFilteredByShardID(key Key, defaultValue
 
 // GetDurationPropertyFilteredByTaskType gets property with task type as filters and asserts that it's a duration
 func (c *Collection) GetDurationPropertyFilteredByTaskType(key Key, defaultValue any) DurationPropertyFnWithTaskTypeFilter {
+	registerSchema(key, DurationType, taskTypeFilters, defaultValue)
 	return func(taskType enumsspb.TaskType) time.Duration {
 		return matchAndConvert(
 			c,
@@ -297,6 +336,7 @@ func (c *Collection) GetDurationPropertyFilteredByTaskType(key Key, defaultValue
 
 // GetBoolProperty gets property and asserts that it's a bool
 func (c *Collection) GetBoolProperty(key Key, defaultValue any) BoolPropertyFn {
+	registerSchema(key, BoolType, nil, defaultValue)
 	return func() bool {
 		return matchAndConvert(
 			c,
@@ -310,6 +350,7 @@ func (c *Collection) GetBoolProperty(key Key, defaultValue any) BoolPropertyFn {
 
 // GetStringProperty gets property and asserts that it's a string
 func (c *Collection) GetStringProperty(key Key, defaultValue any) StringPropertyFn {
+	registerSchema(key, StringType, nil, defaultValue)
 	return func() string {
 		return matchAndConvert(
 			c,
@@ -323,6 +364,7 @@ func (c *Collection) GetStringProperty(key Key, defaultValue any) StringProperty
 
 // GetMapProperty gets property and asserts that it's a map
 func (c *Collection) GetMapProperty(key Key, defaultValue any) MapPropertyFn {
+	registerSchema(key, MapType, nil, defaultValue)
 	return func() map[string]interface{} {
 		return matchAndConvert(
 			c,
@@ -336,6 +378,7 @@ func (c *Collection) GetMapProperty(key Key, defaultValue any) MapPropertyFn {
 
 // GetStringPropertyFnWithNamespaceFilter gets property with namespace filter and asserts that it's a string
 func (c *Collection) GetStringPropertyFnWithNamespaceFilter(key Key, defaultValue any) StringPropertyFnWithNamespaceFilter {
+	registerSchema(key, StringType, namespaceFilters, defaultValue)
 	return func(namespace string) string {
 		return matchAndConvert(
 			c,
@@ -349,6 +392,7 @@ func (c *Collection) GetStringPropertyFnWithNamespaceFilter(key Key, defaultValu
 
 // GetMapPropertyFnWithNamespaceFilter gets property and asserts that it's a map
 func (c *Collection) GetMapPropertyFnWithNamespaceFilter(key Key, defaultValue any) MapPropertyFnWithNamespaceFilter {
+	registerSchema(key, MapType, namespaceFilters, defaultValue)
 	return func(namespace string) map[string]interface{} {
 		return matchAndConvert(
 			c,
@@ -362,6 +406,7 @@ func (c *Collection) GetMapPropertyFnWithNamespaceFilter(key Key, defaultValue a
 
 // GetBoolPropertyFnWithNamespaceFilter gets property with namespace filter and asserts that it's a bool
 func (c *Collection) GetBoolPropertyFnWithNamespaceFilter(key Key, defaultValue any) BoolPropertyFnWithNamespaceFilter {
+	registerSchema(key, BoolType, namespaceFilters, defaultValue)
 	return func(namespace string) bool {
 		return matchAndConvert(
 			c,
@@ -375,6 +420,7 @@ func (c *Collection) GetBoolPropertyFnWithNamespaceFilter(key Key, defaultValue
 
 // GetBoolPropertyFnWithNamespaceIDFilter gets property with namespaceID filter and asserts that it's a bool
 func (c *Collection) GetBoolPropertyFnWithNamespaceIDFilter(key Key, defaultValue any) BoolPropertyFnWithNamespaceIDFilter {
+	registerSchema(key, BoolType, namespaceIDFilters, defaultValue)
 	return func(namespaceID string) bool {
 		return matchAndConvert(
 			c,
@@ -388,6 +434,7 @@ func (c *Collection) GetBoolPropertyFnWithNamespaceIDFilter(key Key, defaultValu
 
 // GetBoolPropertyFilteredByTaskQueueInfo gets property with taskQueueInfo as filters and asserts that it's a bool
 func (c *Collection) GetBoolPropertyFilteredByTaskQueueInfo(key Key, defaultValue any) BoolPropertyFnWithTaskQueueInfoFilters {
+	registerSchema(key, BoolType, taskQueueInfoFilters, defaultValue)
 	return func(namespace string, taskQueue string, taskType enumspb.TaskQueueType) bool {
 		return matchAndConvert(
 			c,
@@ -417,7 +464,7 @@ func findMatch(cvs, defaultCVs []ConstrainedValue, precedence []Constraints) (an
 		// duplicate the code so that we don't have to allocate a new slice to hold the
 		// concatenation of cvs and defaultCVs
 		for _, cv := range cvs {
-			if m == cv.Constraints {
+			if m == cv.Constraints && (cv.Rollout == nil || rolloutBucket(precedence[0]) < *cv.Rollout) {
 				return cv.Value, nil
 			}
 		}
@@ -431,6 +478,15 @@ func findMatch(cvs, defaultCVs []ConstrainedValue, precedence []Constraints) (an
 	return nil, errNoMatchingConstraint
 }
 
+// rolloutBucket maps the filters of a lookup to a bucket in [0, 100). Rollouts of any key at the same
+// percentage select the same namespaces, task queues or shards, and increasing the percentage only adds to them.
+func rolloutBucket(cs Constraints) int {
+	h := fnv.New32a()
+	_, _ = fmt.Fprintf(h, "%s/%s/%s/%d/%d/%d/%s",
+		cs.Namespace, cs.NamespaceID, cs.TaskQueueName, cs.TaskQueueType, cs.ShardID, cs.TaskType, cs.BuildID)
+	return int(h.Sum32() % 100)
+}
+
 // matchAndConvert can't be a method of Collection because methods can't be generic, but we can
 // take a *Collection as an argument.
 func matchAndConvert[T any](
@@ -506,6 +562,17 @@ func taskQueuePrecedence(namespace string, taskQueue string, taskType enumspb.Ta
 	}
 }
 
+func buildIDPrecedence(namespace string, taskQueue string, taskType enumspb.TaskQueueType, buildID string) []Constraints {
+	if buildID == "" {
+		return taskQueuePrecedence(namespace, taskQueue, taskType)
+	}
+	return append([]Constraints{
+		{Namespace: namespace, TaskQueueName: taskQueue, TaskQueueType: taskType, BuildID: buildID},
+		{Namespace: namespace, TaskQueueName: taskQueue, BuildID: buildID},
+		{Namespace: namespace, BuildID: buildID},
+	}, taskQueuePrecedence(namespace, taskQueue, taskType)...)
+}
+
 func shardIDPrecedence(shardID int32) []Constraints {
 	return []Constraints{
 		{ShardID: shardID},
@@ -53,6 +53,7 @@ const (
 	testGetDurationPropertyStructuredDefaults         = "testGetDurationPropertyStructuredDefaults"
 	testGetBoolPropertyFilteredByNamespaceIDKey       = "testGetBoolPropertyFilteredByNamespaceIDKey"
 	testGetBoolPropertyFilteredByTaskQueueInfoKey     = "testGetBoolPropertyFilteredByTaskQueueInfoKey"
+	testGetFloatPropertyFilteredByBuildIDKey          = "testGetFloatPropertyFilteredByBuildIDKey"
 )
 
 // Note: fileBasedClientSuite also heavily tests Collection, since some tests are easier with data
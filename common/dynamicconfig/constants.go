@@ -64,6 +64,12 @@ const (
 	EnableReadFromSecondaryVisibility = "system.enableReadFromSecondaryVisibility"
 	// SecondaryVisibilityWritingMode is key for how to write to secondary visibility
 	SecondaryVisibilityWritingMode = "system.secondaryVisibilityWritingMode"
+	// VisibilityDualWriteVerificationSampleRate is the ratio of the records written to both visibility stores which are
+	// read back from both stores and compared, reporting divergences in metrics and logs
+	VisibilityDualWriteVerificationSampleRate = "system.visibilityDualWriteVerificationSampleRate"
+	// VisibilityDualWriteVerificationDelay is how long after being written to both visibility stores a sampled record
+	// is compared, so that both stores have made the write visible
+	VisibilityDualWriteVerificationDelay = "system.visibilityDualWriteVerificationDelay"
 	// VisibilityDisableOrderByClause is the config to disable ORDERY BY clause for Elasticsearch
 	VisibilityDisableOrderByClause = "system.visibilityDisableOrderByClause"
 	// VisibilityEnableManualPagination is the config to enable manual pagination for Elasticsearch
@@ -82,6 +88,10 @@ const (
 	EnableNamespaceNotActiveAutoForwarding = "system.enableNamespaceNotActiveAutoForwarding"
 	// TransactionSizeLimit is the largest allowed transaction size to persistence
 	TransactionSizeLimit = "system.transactionSizeLimit"
+	// HistoryNodeChunkSize is the size history event batches are split into when their serialized size exceeds it,
+	// so that batches larger than the datastore's blob limit can be persisted. The transaction size limit then applies
+	// to each chunk. 0 disables splitting. Only enable it once all hosts run a version able to read split batches.
+	HistoryNodeChunkSize = "system.historyNodeChunkSize"
 	// DisallowQuery is the key to disallow query for a namespace
 	DisallowQuery = "system.disallowQuery"
 	// EnableAuthorization is the key to enable authorization for a namespace
@@ -94,7 +104,9 @@ const (
 	// get the latest data from DB. This effectively bypasses cache value and is used to facilitate testing of changes in
 	// search attributes. This should not be turned on in production.
 	ForceSearchAttributesCacheRefreshOnRead = "system.forceSearchAttributesCacheRefreshOnRead"
-	EnableRingpopTLS                        = "system.enableRingpopTLS"
+	// EnableRingpopTLS secures the membership traffic with the internode TLS config. It defaults to
+	// whether internode TLS is configured.
+	EnableRingpopTLS = "system.enableRingpopTLS"
 	// EnableParentClosePolicyWorker decides whether or not enable system workers for processing parent close policy task
 	EnableParentClosePolicyWorker = "system.enableParentClosePolicyWorker"
 	// EnableStickyQuery indicates if sticky query should be enabled per namespace
@@ -104,6 +116,14 @@ const (
 	// EnableEagerWorkflowStart toggles "eager workflow start" - returning the first workflow task inline in the
 	// response to a StartWorkflowExecution request and skipping the trip through matching.
 	EnableEagerWorkflowStart = "system.enableEagerWorkflowStart"
+	// TraceSampleRate is the fraction of requests and tasks of a namespace that start a sampled trace.
+	// Spans with a parent follow the sampling decision of the parent. Root spans not started for a
+	// namespace use the unfiltered value.
+	TraceSampleRate = "system.traceSampleRate"
+	// NamespaceDebugTelemetryExpiry is the RFC 3339 time until which debug telemetry is enabled for a namespace.
+	// While enabled, the debug logs of the namespace are emitted at info level, its metrics are not subject to
+	// the task queue cardinality limit and all its requests are traced. Empty or past values disable it.
+	NamespaceDebugTelemetryExpiry = "system.namespaceDebugTelemetryExpiry"
 	// NamespaceCacheRefreshInterval is the key for namespace cache refresh interval dynamic config
 	NamespaceCacheRefreshInterval = "system.namespaceCacheRefreshInterval"
 	// PersistenceHealthSignalMetricsEnabled determines whether persistence shard RPS metrics are emitted
@@ -114,6 +134,68 @@ const (
 	PersistenceHealthSignalWindowSize = "system.persistenceHealthSignalWindowSize"
 	// PersistenceHealthSignalBufferSize is the maximum number of persistence signals to buffer in memory per signal key
 	PersistenceHealthSignalBufferSize = "system.persistenceHealthSignalBufferSize"
+	// PersistenceCircuitBreakerEnabled determines whether each persistence store is wrapped with a circuit breaker
+	// shedding requests when the ratio of failed requests spikes
+	PersistenceCircuitBreakerEnabled = "system.persistenceCircuitBreakerEnabled"
+	// PersistenceCircuitBreakerDegradedErrorRatio is the ratio of failed persistence requests above which
+	// low priority requests, e.g. background scans and visibility writes, are shed
+	PersistenceCircuitBreakerDegradedErrorRatio = "system.persistenceCircuitBreakerDegradedErrorRatio"
+	// PersistenceCircuitBreakerOpenErrorRatio is the ratio of failed persistence requests above which all requests are shed
+	PersistenceCircuitBreakerOpenErrorRatio = "system.persistenceCircuitBreakerOpenErrorRatio"
+	// PersistenceCircuitBreakerMinRequests is the number of requests in a window below which the circuit breaker doesn't trip
+	PersistenceCircuitBreakerMinRequests = "system.persistenceCircuitBreakerMinRequests"
+	// PersistenceCircuitBreakerWindow is the time window over which the ratio of failed persistence requests is computed
+	PersistenceCircuitBreakerWindow = "system.persistenceCircuitBreakerWindow"
+	// PersistenceCircuitBreakerOpenDuration is how long the circuit breaker sheds all requests once open
+	PersistenceCircuitBreakerOpenDuration = "system.persistenceCircuitBreakerOpenDuration"
+	// PersistenceAdaptiveRateLimitingEnabled determines whether the host level persistence QPS limit is adjusted
+	// according to the observed persistence latency and error ratio instead of being a static value
+	PersistenceAdaptiveRateLimitingEnabled = "system.persistenceAdaptiveRateLimitingEnabled"
+	// PersistenceAdaptiveRateLimitingTargetLatency is the p99 persistence latency above which the QPS limit is reduced
+	PersistenceAdaptiveRateLimitingTargetLatency = "system.persistenceAdaptiveRateLimitingTargetLatency"
+	// PersistenceAdaptiveRateLimitingErrorThreshold is the ratio of unhealthy persistence responses above which the QPS limit is reduced
+	PersistenceAdaptiveRateLimitingErrorThreshold = "system.persistenceAdaptiveRateLimitingErrorThreshold"
+	// PersistenceAdaptiveRateLimitingMinQPS is the lower bound of the adaptive persistence QPS limit
+	PersistenceAdaptiveRateLimitingMinQPS = "system.persistenceAdaptiveRateLimitingMinQPS"
+	// PersistenceAdaptiveRateLimitingMaxQPS is the upper bound of the adaptive persistence QPS limit, 0 means unbounded
+	PersistenceAdaptiveRateLimitingMaxQPS = "system.persistenceAdaptiveRateLimitingMaxQPS"
+	// PersistenceAdaptiveRateLimitingAdjustInterval is how often the adaptive persistence QPS limit is adjusted
+	PersistenceAdaptiveRateLimitingAdjustInterval = "system.persistenceAdaptiveRateLimitingAdjustInterval"
+	// PersistenceFaultInjectionEnabled determines whether faults configured by PersistenceFaultInjection are
+	// injected into persistence requests, meant for chaos testing in integration environments only
+	PersistenceFaultInjectionEnabled = "system.persistenceFaultInjectionEnabled"
+	// PersistenceFaultInjection is the per namespace config of the faults injected into persistence requests:
+	// errorRate, errors (error name to weight), latency, latencyRate and apis (per store and/or method overrides
+	// keyed by "Store", "Method" or "Store.Method")
+	PersistenceFaultInjection = "system.persistenceFaultInjection"
+	// PersistenceSchemaCompatibilityCheckInterval is how often a running server re-verifies that the persistence
+	// schema is still compatible with it, shutting down once the schema has been contracted past its version
+	PersistenceSchemaCompatibilityCheckInterval = "system.persistenceSchemaCompatibilityCheckInterval"
+	// PersistenceSchemaBackfillEnabled determines whether registered schema backfills are run by the worker service
+	PersistenceSchemaBackfillEnabled = "system.persistenceSchemaBackfillEnabled"
+	// PersistenceSchemaBackfillStepInterval is the pause between two batches of a schema backfill
+	PersistenceSchemaBackfillStepInterval = "system.persistenceSchemaBackfillStepInterval"
+	// CassandraUnconditionalExecutionUpdatesEnabled determines whether workflow execution updates which don't
+	// create a new run are written to Cassandra without lightweight transactions. Instead of the range ID and
+	// db record version conditions, the shard owner checks its range ID against the shard row at most once per
+	// CassandraShardLeaseCheckInterval, so a stale owner may overwrite a new owner's updates within that
+	// interval after a shard moved. Only meant for deployments where shards rarely move, with synchronized clocks
+	CassandraUnconditionalExecutionUpdatesEnabled = "system.cassandraUnconditionalExecutionUpdatesEnabled"
+	// CassandraShardLeaseCheckInterval is how long a range ID read from the shard row fences unconditional
+	// execution updates of the shard before it is read again
+	CassandraShardLeaseCheckInterval = "system.cassandraShardLeaseCheckInterval"
+	// PersistenceMetricsMaxTaggedNamespaces is the number of namespaces, the busiest ones, persistence metrics of a host
+	// are tagged with, metrics of other namespaces are tagged as _other_. 0 means no limit
+	PersistenceMetricsMaxTaggedNamespaces = "system.persistenceMetricsMaxTaggedNamespaces"
+	// MetricsMaxTaskQueuesPerNamespace is the number of task queues of a namespace each metric is tagged with,
+	// metrics of other task queues are tagged as _other_. Task queues which are not recorded anymore free their
+	// slot after 10 to 20 minutes. 0 means no limit
+	MetricsMaxTaskQueuesPerNamespace = "system.metricsMaxTaskQueuesPerNamespace"
+	// PayloadOffloadEnabled determines whether history event payloads of a namespace above the size threshold
+	// are offloaded to the object store configured by persistence payloadOffload
+	PayloadOffloadEnabled = "system.payloadOffloadEnabled"
+	// PayloadOffloadSizeThreshold is the size in bytes above which a history event payload is offloaded
+	PayloadOffloadSizeThreshold = "system.payloadOffloadSizeThreshold"
 	// ShardRPSWarnLimit is the per-shard RPS limit for warning
 	ShardRPSWarnLimit = "system.shardRPSWarnLimit"
 
@@ -197,6 +279,10 @@ const (
 	ReachabilityQueryBuildIdLimit = "limit.reachabilityQueryBuildIds"
 	// TaskQueuesPerBuildIdLimit limits the number of task queue names that can be mapped to a single build id.
 	TaskQueuesPerBuildIdLimit = "limit.taskQueuesPerBuildId"
+	// NamespaceStorageQuota is the number of bytes a namespace may consume in persistence (history, mutable state and
+	// visibility) before new workflow starts are rejected. Usage is computed by the storage usage scanner.
+	// 0 means unlimited.
+	NamespaceStorageQuota = "limit.namespaceStorageQuota"
 
 	// keys for frontend
 
@@ -241,6 +327,52 @@ const (
 	// namespace replication inducing APIs (e.g. UpdateNamespace, UpdateWorkerBuildIdCompatibility).
 	// This config is EXPERIMENTAL and may be changed or removed in a later release.
 	FrontendMaxNamespaceNamespaceReplicationInducingAPIsBurstPerInstance = "frontend.namespaceBurst.namespaceReplicationInducingAPIs"
+	// FrontendMaxNamespaceSignalRPSPerInstance is a per host/per namespace RPS limit for signals
+	// (SignalWorkflowExecution and SignalWithStartWorkflowExecution). 0 means no limit.
+	FrontendMaxNamespaceSignalRPSPerInstance = "frontend.namespaceRPS.signal"
+	// FrontendMaxNamespaceQueryRPSPerInstance is a per host/per namespace RPS limit for workflow queries. 0 means no limit.
+	FrontendMaxNamespaceQueryRPSPerInstance = "frontend.namespaceRPS.query"
+	// FrontendMaxNamespaceUpdateRPSPerInstance is a per host/per namespace RPS limit for workflow updates. 0 means no limit.
+	FrontendMaxNamespaceUpdateRPSPerInstance = "frontend.namespaceRPS.update"
+	// FrontendMaxExecutionSignalRPSPerInstance is a per host RPS limit for signals sent to a single workflow execution.
+	// Requests over this limit are rejected with BUSY_WORKFLOW. 0 means no limit.
+	FrontendMaxExecutionSignalRPSPerInstance = "frontend.workflowExecutionRPS.signal"
+	// FrontendMaxExecutionQueryRPSPerInstance is a per host RPS limit for queries sent to a single workflow execution.
+	// Requests over this limit are rejected with BUSY_WORKFLOW. 0 means no limit.
+	FrontendMaxExecutionQueryRPSPerInstance = "frontend.workflowExecutionRPS.query"
+	// FrontendMaxExecutionUpdateRPSPerInstance is a per host RPS limit for updates sent to a single workflow execution.
+	// Requests over this limit are rejected with BUSY_WORKFLOW. 0 means no limit.
+	FrontendMaxExecutionUpdateRPSPerInstance = "frontend.workflowExecutionRPS.update"
+	// FrontendGlobalNamespaceSignalRPS is a cluster global, per namespace RPS limit for signals.
+	// The limit is evenly distributed among available frontend service instances.
+	// If this is set, it overwrites the per instance limit "frontend.namespaceRPS.signal".
+	FrontendGlobalNamespaceSignalRPS = "frontend.globalNamespaceRPS.signal"
+	// FrontendGlobalNamespaceQueryRPS is a cluster global, per namespace RPS limit for workflow queries.
+	// The limit is evenly distributed among available frontend service instances.
+	// If this is set, it overwrites the per instance limit "frontend.namespaceRPS.query".
+	FrontendGlobalNamespaceQueryRPS = "frontend.globalNamespaceRPS.query"
+	// FrontendGlobalNamespaceUpdateRPS is a cluster global, per namespace RPS limit for workflow updates.
+	// The limit is evenly distributed among available frontend service instances.
+	// If this is set, it overwrites the per instance limit "frontend.namespaceRPS.update".
+	FrontendGlobalNamespaceUpdateRPS = "frontend.globalNamespaceRPS.update"
+	// FrontendGlobalExecutionSignalRPS is a cluster global RPS limit for signals sent to a single workflow execution.
+	// The limit is evenly distributed among available frontend service instances.
+	// If this is set, it overwrites the per instance limit "frontend.workflowExecutionRPS.signal".
+	FrontendGlobalExecutionSignalRPS = "frontend.globalWorkflowExecutionRPS.signal"
+	// FrontendGlobalExecutionQueryRPS is a cluster global RPS limit for queries sent to a single workflow execution.
+	// The limit is evenly distributed among available frontend service instances.
+	// If this is set, it overwrites the per instance limit "frontend.workflowExecutionRPS.query".
+	FrontendGlobalExecutionQueryRPS = "frontend.globalWorkflowExecutionRPS.query"
+	// FrontendGlobalExecutionUpdateRPS is a cluster global RPS limit for updates sent to a single workflow execution.
+	// The limit is evenly distributed among available frontend service instances.
+	// If this is set, it overwrites the per instance limit "frontend.workflowExecutionRPS.update".
+	FrontendGlobalExecutionUpdateRPS = "frontend.globalWorkflowExecutionRPS.update"
+	// FrontendExecutionRateLimiterCacheSize is the max number of workflow executions tracked by the per execution rate limiter
+	FrontendExecutionRateLimiterCacheSize = "frontend.workflowExecutionRateLimiterCacheSize"
+	// FrontendDeprecatedSDKVersions is a map from SDK name (as sent in the client-name header) to a semver range
+	// of deprecated versions, e.g. {"temporal-go": "<1.20.0"}. Requests from matching SDKs get a
+	// sdk-deprecation-warning response header.
+	FrontendDeprecatedSDKVersions = "frontend.deprecatedSDKVersions"
 	// FrontendGlobalNamespaceRPS is workflow namespace rate limit per second for the whole cluster.
 	// The limit is evenly distributed among available frontend service instances.
 	// If this is set, it overwrites per instance limit "frontend.namespaceRPS".
@@ -270,6 +402,11 @@ const (
 	FrontendShutdownDrainDuration = "frontend.shutdownDrainDuration"
 	// FrontendShutdownFailHealthCheckDuration is the duration of shutdown failure detection
 	FrontendShutdownFailHealthCheckDuration = "frontend.shutdownFailHealthCheckDuration"
+	// FrontendShutdownLongPollDrainDuration is the max duration in-flight long polls are served during shutdown
+	// while new long polls are refused
+	FrontendShutdownLongPollDrainDuration = "frontend.shutdownLongPollDrainDuration"
+	// FrontendShutdownRetryAfter is the retry-after hint sent to clients by a frontend during shutdown
+	FrontendShutdownRetryAfter = "frontend.shutdownRetryAfter"
 	// FrontendMaxBadBinaries is the max number of bad binaries in namespace config
 	FrontendMaxBadBinaries = "frontend.maxBadBinaries"
 	// SendRawWorkflowHistory is whether to enable raw history retrieving
@@ -290,6 +427,39 @@ const (
 	EnableServerVersionCheck = "frontend.enableServerVersionCheck"
 	// EnableTokenNamespaceEnforcement enables enforcement that namespace in completion token matches namespace of the request
 	EnableTokenNamespaceEnforcement = "frontend.enableTokenNamespaceEnforcement"
+	// EnableAPIKeys allows callers to authenticate with server-managed API keys passed as bearer tokens
+	EnableAPIKeys = "frontend.enableAPIKeys"
+	// EnableRBAC allows calls by callers whose identity groups are assigned named roles granting the
+	// permissions required by the call
+	EnableRBAC = "frontend.enableRBAC"
+	// FrontendCertificateBindings grants roles to callers by client certificate. It is a map from
+	// "san:<pattern>", "ou:<pattern>" or "cn:<pattern>" to roles, e.g.
+	// {"san:*.payments.example.com": {"namespaces": {"payments": ["write"]}}, "ou:platform": {"system": ["admin"]}}.
+	// The roles are added to those from the claim mapper.
+	FrontendCertificateBindings = "frontend.certificateBindings"
+	// FrontendRemoteCodecEndpoint is the URL of the remote payload codec used to decode history payloads
+	// of a namespace for callers that ask for it. Empty means payloads are never decoded by the server.
+	FrontendRemoteCodecEndpoint = "frontend.remoteCodecEndpoint"
+	// FrontendRemoteCodecTimeout is the timeout for calls to the remote payload codec
+	FrontendRemoteCodecTimeout = "frontend.remoteCodecTimeout"
+	// EnableAuditLog turns on audit records of state-changing calls to a namespace. Calls that do not target
+	// a namespace use the unfiltered value.
+	EnableAuditLog = "frontend.enableAuditLog"
+	// FrontendNamespaceIPFilter restricts the peer addresses allowed to call a namespace. It is a map with
+	// "allow" and "deny" lists of IP addresses and CIDR blocks, e.g. {"allow": ["10.0.0.0/8"], "deny": ["10.1.0.0/16"]}.
+	// Denied addresses are rejected, and a non-empty allow list rejects all addresses it does not contain.
+	FrontendNamespaceIPFilter = "frontend.namespaceIPFilter"
+	// FrontendAdminIPFilter restricts the peer addresses allowed to call operator and admin APIs, in
+	// the same format as FrontendNamespaceIPFilter
+	FrontendAdminIPFilter = "frontend.adminIPFilter"
+	// FrontendSlowRequestLogThresholds is a map from API name to the latency beyond which a call is logged as a
+	// slow request, e.g. {"StartWorkflowExecution": "500ms", "*": "2s"}. The "*" entry applies to the APIs that
+	// are not listed, except long polls. Slow requests are not logged when the map is empty.
+	FrontendSlowRequestLogThresholds = "frontend.slowRequestLogThresholds"
+	// FrontendSlowRequestSummarySampleRate is the fraction of slow requests logged with a summary of the request
+	FrontendSlowRequestSummarySampleRate = "frontend.slowRequestSummarySampleRate"
+	// FrontendSlowRequestSummaryMaxSize is the max size in bytes of the request summary of a slow request
+	FrontendSlowRequestSummaryMaxSize = "frontend.slowRequestSummaryMaxSize"
 	// DisableListVisibilityByFilter is config to disable list open/close workflow using filter
 	DisableListVisibilityByFilter = "frontend.disableListVisibilityByFilter"
 	// KeepAliveMinTime is the minimum amount of time a client should wait before sending a keepalive ping.
@@ -360,6 +530,22 @@ const (
 	// after all namespace resources (i.e. workflow executions) are deleted.
 	// Default is 0, means, namespace will be deleted immediately.
 	DeleteNamespaceNamespaceDeleteDelay = "frontend.deleteNamespaceNamespaceDeleteDelay"
+	// VisibilityConsistencyCheckRPS is the maximum rate of persistence calls of the CheckVisibilityConsistency admin API
+	// per frontend host.
+	// Default value is 100.
+	VisibilityConsistencyCheckRPS = "frontend.visibilityConsistencyCheckRPS"
+	// HistoryGarbageDeletionRPS is the maximum rate of history branch deletions of the DeleteHistoryBranchGarbage
+	// admin API per frontend host.
+	// Default value is 10.
+	HistoryGarbageDeletionRPS = "frontend.historyGarbageDeletionRPS"
+	// FrontendVisibilityStrongConsistencyEnabled allows ListWorkflowExecutions requests to ask for
+	// read-after-write consistency with the visibility-consistency header.
+	// Default is false, since such requests are more expensive for the visibility store.
+	FrontendVisibilityStrongConsistencyEnabled = "frontend.visibilityStrongConsistencyEnabled"
+	// FrontendVisibilityStrongConsistencyMaxWait is the maximum time a ListWorkflowExecutions request
+	// asking for read-after-write consistency waits for the visibility queues of the history shards to
+	// catch up, before failing with DeadlineExceeded.
+	FrontendVisibilityStrongConsistencyMaxWait = "frontend.visibilityStrongConsistencyMaxWait"
 
 	// keys for matching
 
@@ -384,6 +570,11 @@ const (
 	MatchingLongPollExpirationInterval = "matching.longPollExpirationInterval"
 	// MatchingSyncMatchWaitDuration is to wait time for sync match
 	MatchingSyncMatchWaitDuration = "matching.syncMatchWaitDuration"
+	// MatchingPriorityTaskSyncMatchWaitDuration is the max time a priority workflow task, eg. the first workflow
+	// task of a new execution or a workflow task delivering updates, waits for a poller when the task queue has
+	// a backlog. Pollers pick up such tasks before backlog tasks.
+	// Set to 0 to disable the priority lane.
+	MatchingPriorityTaskSyncMatchWaitDuration = "matching.priorityTaskSyncMatchWaitDuration"
 	// MatchingLoadUserData can be used to entirely disable loading user data from persistence (and the inter node RPCs
 	// that propoagate it). When turned off, features that rely on user data (e.g. worker versioning) will essentially
 	// be disabled. When disabled, matching will drop tasks for versioned workflows and activities to avoid breaking
@@ -464,8 +655,30 @@ const (
 	EventsCacheMaxSize = "history.eventsCacheMaxSize"
 	// EventsCacheTTL is TTL of events cache
 	EventsCacheTTL = "history.eventsCacheTTL"
+	// CurrentExecutionCacheMaxSize is max size of the per-shard current execution cache, 0 disables the cache
+	CurrentExecutionCacheMaxSize = "history.currentExecutionCacheMaxSize"
+	// HistoryAppendBatchingEnabled enables batching history appends of different workflows on the same shard.
+	// Only appends outside of workflow mutations (e.g. rehydration) are batched, and only on SQL stores.
+	HistoryAppendBatchingEnabled = "history.historyAppendBatchingEnabled"
+	// HistoryAppendBatchMaxSize is the max number of history appends written in one batch
+	HistoryAppendBatchMaxSize = "history.historyAppendBatchMaxSize"
+	// HistoryAppendBatchWindow is the max duration a history append waits for other appends to batch with
+	HistoryAppendBatchWindow = "history.historyAppendBatchWindow"
 	// AcquireShardInterval is interval that timer used to acquire shard
 	AcquireShardInterval = "history.acquireShardInterval"
+	// HistoryMembershipRampUpDuration is how long a history host takes to own its full share of shards after it
+	// joins the ring. Its share grows linearly from zero, so shards move to a new host gradually, at the pace of
+	// AcquireShardInterval. Zero disables the ramp up.
+	HistoryMembershipRampUpDuration = "history.membershipRampUpDuration"
+	// HistoryZoneAwareShardPlacement spreads shards evenly across the availability zones of the history hosts,
+	// rather than across hosts, so that the shards of a host which leaves move to hosts of the same zone. It only
+	// applies once every history host advertises its zone, see the zone membership config. Changing it moves shards.
+	HistoryZoneAwareShardPlacement = "history.zoneAwareShardPlacement"
+	// HistoryMembershipLeaseDuration is the lease history hosts hold on their place in the ring by heartbeating
+	// their membership record. Hosts whose lease expires, eg. because they crashed, leave the ring and their
+	// shards move without waiting for gossip to declare them faulty. It must exceed the clock skew between hosts,
+	// and only be set once every host runs a version which renews it. Zero disables leases.
+	HistoryMembershipLeaseDuration = "history.membershipLeaseDuration"
 	// AcquireShardConcurrency is number of goroutines that can be used to acquire shards in the shard controller.
 	AcquireShardConcurrency = "history.acquireShardConcurrency"
 	// StandbyClusterDelay is the artificial delay added to standby cluster's view of active cluster's time
@@ -497,6 +710,12 @@ const (
 	// ContinueAsNewMinInterval is the minimal interval between continue_as_new executions.
 	// This is needed to prevent tight loop continue_as_new spin. Default is 1s.
 	ContinueAsNewMinInterval = "history.continueAsNewMinInterval"
+	// TimerAccelerationFactor divides durable user timer durations, workflow/activity retry backoffs and
+	// activity schedule-to-start timeouts by the given factor. It is intended for non-production namespaces
+	// only, so that long-running workflow logic can be exercised quickly. The active cluster records the
+	// accelerated values, so the factor doesn't need to match across clusters. Values <= 1 disable
+	// acceleration. Default is 1.
+	TimerAccelerationFactor = "history.timerAccelerationFactor"
 
 	// TaskSchedulerEnableRateLimiter indicates if rate limiter should be enabled in task scheduler
 	TaskSchedulerEnableRateLimiter = "history.taskSchedulerEnableRateLimiter"
@@ -615,6 +834,13 @@ const (
 	// close task has been processed. Must use Elasticsearch as visibility store, otherwise workflow
 	// data (eg: search attributes) will be lost after workflow is closed.
 	VisibilityProcessorEnableCloseWorkflowCleanup = "history.visibilityProcessorEnableCloseWorkflowCleanup"
+	// VisibilityProcessorLagSLO is the visibility queue lag above which a shard is counted as violating its lag SLO
+	VisibilityProcessorLagSLO = "history.visibilityProcessorLagSLO"
+	// VisibilityProcessorBackpressureEnabled rejects new workflow starts and signals on shards whose
+	// visibility queue lag is above VisibilityProcessorLagHardLimit
+	VisibilityProcessorBackpressureEnabled = "history.visibilityProcessorBackpressureEnabled"
+	// VisibilityProcessorLagHardLimit is the visibility queue lag above which backpressure is applied
+	VisibilityProcessorLagHardLimit = "history.visibilityProcessorLagHardLimit"
 
 	// ArchivalTaskBatchSize is batch size for archivalQueueProcessor
 	ArchivalTaskBatchSize = "history.archivalTaskBatchSize"
@@ -640,6 +866,15 @@ const (
 	ArchivalProcessorArchiveDelay = "history.archivalProcessorArchiveDelay"
 	// ArchivalBackendMaxRPS is the maximum rate of requests per second to the archival backend
 	ArchivalBackendMaxRPS = "history.archivalBackendMaxRPS"
+	// ArchivalNamespaceMaxRPS is the maximum rate of archival requests per second of a namespace on a host, 0 means
+	// no limit other than ArchivalBackendMaxRPS
+	ArchivalNamespaceMaxRPS = "history.archivalNamespaceMaxRPS"
+	// ArchivalNamespaceMaxConcurrency is the maximum number of archival requests of a namespace in flight on a host,
+	// 0 means no limit other than ArchivalProcessorSchedulerWorkerCount
+	ArchivalNamespaceMaxConcurrency = "history.archivalNamespaceMaxConcurrency"
+	// ArchivalProcessorMaxAttempts is the number of attempts after which a failing archival task is moved to the
+	// archival DLQ, 0 means archival tasks are retried forever
+	ArchivalProcessorMaxAttempts = "history.archivalProcessorMaxAttempts"
 	// DurableArchivalEnabled is the flag to enable durable archival
 	DurableArchivalEnabled = "history.durableArchivalEnabled"
 
@@ -706,6 +941,13 @@ const (
 	NumParentClosePolicySystemWorkflows = "history.numParentClosePolicySystemWorkflows"
 	// HistoryThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger
 	HistoryThrottledLogRPS = "history.throttledLogRPS"
+	// HistorySlowRequestLogThresholds is a map from API name to the latency beyond which a call is logged as a
+	// slow request, in the same format as FrontendSlowRequestLogThresholds
+	HistorySlowRequestLogThresholds = "history.slowRequestLogThresholds"
+	// HistorySlowRequestSummarySampleRate is the fraction of slow requests logged with a summary of the request
+	HistorySlowRequestSummarySampleRate = "history.slowRequestSummarySampleRate"
+	// HistorySlowRequestSummaryMaxSize is the max size in bytes of the request summary of a slow request
+	HistorySlowRequestSummaryMaxSize = "history.slowRequestSummaryMaxSize"
 	// StickyTTL is to expire a sticky taskqueue if no update more than this duration
 	StickyTTL = "history.stickyTTL"
 	// WorkflowTaskHeartbeatTimeout for workflow task heartbeat
@@ -843,11 +1085,49 @@ const (
 	HistoryScannerEnabled = "worker.historyScannerEnabled"
 	// ExecutionsScannerEnabled indicates if executions scanner should be started as part of worker.Scanner
 	ExecutionsScannerEnabled = "worker.executionsScannerEnabled"
+	// StorageUsageScannerEnabled indicates if the storage usage scanner should be started as part of worker.Scanner
+	StorageUsageScannerEnabled = "worker.storageUsageScannerEnabled"
+	// StorageUsageScannerPerHostQPS is the maximum rate of persistence calls per host from the storage usage scanner
+	StorageUsageScannerPerHostQPS = "worker.storageUsageScannerPerHostQPS"
+	// ReencryptionScannerEnabled indicates if the persistence re-encryption scanner should be started as part of worker.Scanner
+	ReencryptionScannerEnabled = "worker.reencryptionScannerEnabled"
+	// ReencryptionScannerPerHostQPS is the maximum rate of persistence calls per host from the persistence re-encryption scanner
+	ReencryptionScannerPerHostQPS = "worker.reencryptionScannerPerHostQPS"
+	// VisibilityConsistencyScannerEnabled indicates if the visibility consistency scanner should be started as part of worker.Scanner
+	VisibilityConsistencyScannerEnabled = "worker.visibilityConsistencyScannerEnabled"
+	// VisibilityConsistencyScannerPerHostQPS is the maximum rate of persistence calls per host from the visibility consistency scanner
+	VisibilityConsistencyScannerPerHostQPS = "worker.visibilityConsistencyScannerPerHostQPS"
+	// VisibilityConsistencyScannerRepairEnabled indicates if the visibility consistency scanner repairs the inconsistencies
+	// it finds, rather than only reporting them
+	VisibilityConsistencyScannerRepairEnabled = "worker.visibilityConsistencyScannerRepairEnabled"
+	// VisibilityExportScannerEnabled indicates if the visibility export scanner should be started as part of worker.Scanner
+	VisibilityExportScannerEnabled = "worker.visibilityExportScannerEnabled"
+	// VisibilityExportScannerPerHostQPS is the maximum rate of visibility calls per host from the visibility export scanner
+	VisibilityExportScannerPerHostQPS = "worker.visibilityExportScannerPerHostQPS"
+	// VisibilityExportDelay is how long after their close visibility records are exported by the visibility export scanner
+	VisibilityExportDelay = "worker.visibilityExportDelay"
+	// ArchiveExpiryScannerEnabled indicates if the archive expiry scanner should be started as part of worker.Scanner
+	ArchiveExpiryScannerEnabled = "worker.archiveExpiryScannerEnabled"
+	// ArchiveExpiryScannerPerHostQPS is the maximum rate of archiver expiry calls per host from the archive expiry scanner
+	ArchiveExpiryScannerPerHostQPS = "worker.archiveExpiryScannerPerHostQPS"
+	// TablePartitionScannerEnabled indicates if the SQL table partition scanner should be started as part of worker.Scanner
+	TablePartitionScannerEnabled = "worker.tablePartitionScannerEnabled"
+	// TablePartitionShardsPerPartition is the number of shards held by each partition created by the SQL table partition scanner
+	TablePartitionShardsPerPartition = "worker.tablePartitionShardsPerPartition"
 	// HistoryScannerDataMinAge indicates the history scanner cleanup minimum age.
 	HistoryScannerDataMinAge = "worker.historyScannerDataMinAge"
 	// HistoryScannerVerifyRetention indicates the history scanner verify data retention.
 	// If the service configures with archival feature enabled, update worker.historyScannerVerifyRetention to be double of the data retention.
 	HistoryScannerVerifyRetention = "worker.historyScannerVerifyRetention"
+	// HistoryScannerDryRun makes the history scanner only report the garbage history branches it finds, with their
+	// sizes, instead of deleting them. The report is the result of the history scanner workflow.
+	HistoryScannerDryRun = "worker.historyScannerDryRun"
+	// ScannerLeaderElectionEnabled indicates if worker.Scanner only runs on the worker host holding the scanner lease
+	ScannerLeaderElectionEnabled = "worker.scannerLeaderElectionEnabled"
+	// ScannerLeaseDuration is how long the scanner lease stays valid without being renewed
+	ScannerLeaseDuration = "worker.scannerLeaseDuration"
+	// ScannerLeaseRenewInterval is how often the scanner lease is renewed, or retried by hosts not holding it
+	ScannerLeaseRenewInterval = "worker.scannerLeaseRenewInterval"
 	// EnableBatcher decides whether start batcher in our worker
 	EnableBatcher = "worker.enableBatcher"
 	// BatcherRPS controls number the rps of batch operations
@@ -866,6 +1146,20 @@ const (
 	WorkerPerNamespaceWorkerCount = "worker.perNamespaceWorkerCount"
 	// WorkerPerNamespaceWorkerOptions are SDK worker options for per-namespace worker
 	WorkerPerNamespaceWorkerOptions = "worker.perNamespaceWorkerOptions"
+	// WorkerPerNamespaceWorkerMaxConcurrentActivities is the quota of concurrent activities of the per-ns worker
+	// of a namespace on each worker host, unless set in WorkerPerNamespaceWorkerOptions. It keeps system workflows
+	// (batcher, scheduler, etc.) of one namespace from starving those of other namespaces. The activity quotas also
+	// apply to the deletion of a namespace, which runs on the default worker.
+	WorkerPerNamespaceWorkerMaxConcurrentActivities = "worker.perNamespaceWorkerMaxConcurrentActivities"
+	// WorkerPerNamespaceWorkerMaxConcurrentLocalActivities is the quota of concurrent local activities of the per-ns
+	// worker of a namespace on each worker host, unless set in WorkerPerNamespaceWorkerOptions
+	WorkerPerNamespaceWorkerMaxConcurrentLocalActivities = "worker.perNamespaceWorkerMaxConcurrentLocalActivities"
+	// WorkerPerNamespaceWorkerMaxConcurrentWorkflowTasks is the quota of concurrent workflow tasks of the per-ns
+	// worker of a namespace on each worker host, unless set in WorkerPerNamespaceWorkerOptions
+	WorkerPerNamespaceWorkerMaxConcurrentWorkflowTasks = "worker.perNamespaceWorkerMaxConcurrentWorkflowTasks"
+	// WorkerPerNamespaceWorkerActivitiesPerSecond is the quota of activities started per second by the per-ns worker
+	// of a namespace on each worker host, unless set in WorkerPerNamespaceWorkerOptions. Zero means no limit.
+	WorkerPerNamespaceWorkerActivitiesPerSecond = "worker.perNamespaceWorkerActivitiesPerSecond"
 	// WorkerEnableScheduler controls whether to start the worker for scheduled workflows
 	WorkerEnableScheduler = "worker.enableScheduler"
 	// WorkerStickyCacheSize controls the sticky cache size for SDK workers on worker nodes
@@ -873,4 +1167,7 @@ const (
 	WorkerStickyCacheSize = "worker.stickyCacheSize"
 	// SchedulerNamespaceStartWorkflowRPS is the per-namespace limit for starting workflows by schedules
 	SchedulerNamespaceStartWorkflowRPS = "worker.schedulerNamespaceStartWorkflowRPS"
+	// SchedulerPauseWebhookURL is the per-namespace URL that a JSON notification is posted to when a schedule
+	// pauses itself after consecutive failures. Nothing is posted if it is empty.
+	SchedulerPauseWebhookURL = "worker.schedulerPauseWebhookURL"
 )
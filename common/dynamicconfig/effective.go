@@ -0,0 +1,154 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"time"
+)
+
+const (
+	SourceOverride Source = "override"
+	SourceKV       Source = "kv"
+	SourceFile     Source = "file"
+	// SourceClient is used for the values of clients which do not report where their values come from,
+	// e.g. a client passed to the server by an application embedding it
+	SourceClient  Source = "client"
+	SourceDefault Source = "default"
+)
+
+type (
+	// Source is where a dynamic config value comes from.
+	Source string
+
+	// EffectiveValue is a value of a key, with where it comes from.
+	EffectiveValue struct {
+		Constraints map[string]any `json:"constraints,omitempty"`
+		Value       any            `json:"value"`
+		Rollout     *int           `json:"rollout,omitempty"`
+		Source      Source         `json:"source"`
+	}
+
+	// EffectiveKey is the schema of a key and all of its values, in the order they are matched for
+	// the same constraints. The defaults come last.
+	EffectiveKey struct {
+		Key     string           `json:"key"`
+		Type    ValueType        `json:"type"`
+		Filters []Filter         `json:"filters,omitempty"`
+		Values  []EffectiveValue `json:"values"`
+	}
+
+	// sourcedClient is implemented by the clients which know where their values come from.
+	sourcedClient interface {
+		getSourcedValues(key Key) []sourcedValue
+	}
+
+	sourcedValue struct {
+		ConstrainedValue
+		source Source
+	}
+)
+
+// EffectiveConfig returns the configuration of all registered keys, as read from client.
+func EffectiveConfig(client Client) []EffectiveKey {
+	var result []EffectiveKey
+	for _, schema := range Schemas() {
+		ek := EffectiveKey{
+			Key:     schema.Key.String(),
+			Type:    schema.Type,
+			Filters: schema.Filters,
+		}
+		for _, sv := range getSourcedValues(client, schema.Key) {
+			ek.Values = append(ek.Values, newEffectiveValue(sv.ConstrainedValue, sv.source))
+		}
+		if defaultCVs, ok := schema.Default.([]ConstrainedValue); ok {
+			for _, cv := range defaultCVs {
+				ek.Values = append(ek.Values, newEffectiveValue(cv, SourceDefault))
+			}
+		} else {
+			ek.Values = append(ek.Values, newEffectiveValue(ConstrainedValue{Value: schema.Default}, SourceDefault))
+		}
+		result = append(result, ek)
+	}
+	return result
+}
+
+func getSourcedValues(client Client, key Key) []sourcedValue {
+	if client, ok := client.(sourcedClient); ok {
+		return client.getSourcedValues(key)
+	}
+	return newSourcedValues(client.GetValue(key), SourceClient)
+}
+
+func newSourcedValues(cvs []ConstrainedValue, source Source) []sourcedValue {
+	result := make([]sourcedValue, len(cvs))
+	for i, cv := range cvs {
+		result[i] = sourcedValue{ConstrainedValue: cv, source: source}
+	}
+	return result
+}
+
+func newEffectiveValue(cv ConstrainedValue, source Source) EffectiveValue {
+	value := cv.Value
+	if d, ok := value.(time.Duration); ok {
+		// in the format of the dynamic config file
+		value = d.String()
+	}
+	return EffectiveValue{
+		Constraints: constraintsToMap(cv.Constraints),
+		Value:       value,
+		Rollout:     cv.Rollout,
+		Source:      source,
+	}
+}
+
+// constraintsToMap is the inverse of convertYamlConstraints.
+func constraintsToMap(cs Constraints) map[string]any {
+	m := make(map[string]any)
+	if cs.Namespace != "" {
+		m[string(NamespaceFilter)] = cs.Namespace
+	}
+	if cs.NamespaceID != "" {
+		m[string(NamespaceIDFilter)] = cs.NamespaceID
+	}
+	if cs.TaskQueueName != "" {
+		m[string(TaskQueueNameFilter)] = cs.TaskQueueName
+	}
+	if cs.TaskQueueType != 0 {
+		m[string(TaskQueueTypeFilter)] = cs.TaskQueueType.String()
+	}
+	if cs.ShardID != 0 {
+		m[string(ShardIDFilter)] = int(cs.ShardID)
+	}
+	if cs.TaskType != 0 {
+		m[string(HistoryTaskTypeFilter)] = cs.TaskType.String()
+	}
+	if cs.BuildID != "" {
+		m[string(BuildIDFilter)] = cs.BuildID
+	}
+	if len(m) == 0 {
+		return nil
+	}
+	return m
+}
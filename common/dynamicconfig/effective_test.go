@@ -0,0 +1,67 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/log"
+)
+
+func TestEffectiveConfig(t *testing.T) {
+	const testEffectiveConfigKey = "testEffectiveConfigKey"
+	client := NewOverrideClient(StaticClient{
+		testEffectiveConfigKey: []ConstrainedValue{
+			{Constraints: Constraints{Namespace: "samples"}, Value: "5s"},
+		},
+	}, log.NewNoopLogger())
+	dc := NewCollection(client, log.NewNoopLogger())
+	dc.GetDurationPropertyFilteredByNamespace(testEffectiveConfigKey, time.Minute)
+	require.NoError(t, client.SetOverrides(`
+testEffectiveConfigKey:
+- value: 10s
+  constraints:
+    namespace: samples
+`))
+
+	var effective *EffectiveKey
+	for _, ek := range EffectiveConfig(client) {
+		if ek.Key == testEffectiveConfigKey {
+			ek := ek
+			effective = &ek
+		}
+	}
+	require.NotNil(t, effective)
+	require.Equal(t, DurationType, effective.Type)
+	require.Equal(t, []Filter{NamespaceFilter}, effective.Filters)
+	require.Equal(t, []EffectiveValue{
+		{Constraints: map[string]any{"namespace": "samples"}, Value: "10s", Source: SourceOverride},
+		{Constraints: map[string]any{"namespace": "samples"}, Value: "5s", Source: SourceClient},
+		{Value: "1m0s", Source: SourceDefault},
+	}, effective.Values)
+}
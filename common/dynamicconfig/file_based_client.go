@@ -43,7 +43,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 )
 
-var _ Client = (*fileBasedClient)(nil)
+var _ NotifyingClient = (*fileBasedClient)(nil)
 
 const (
 	minPollInterval = time.Second * 5
@@ -62,6 +62,9 @@ type (
 	FileBasedClientConfig struct {
 		Filepath     string        `yaml:"filepath"`
 		PollInterval time.Duration `yaml:"pollInterval"`
+		// StrictValidation rejects a config file with values that do not match the type, constraints or
+		// validators of their key, instead of only logging them. Unknown keys are always only logged.
+		StrictValidation bool `yaml:"strictValidation"`
 	}
 
 	configValueMap map[string][]ConstrainedValue
@@ -73,6 +76,7 @@ type (
 		lastUpdatedTime time.Time
 		config          *FileBasedClientConfig
 		doneCh          <-chan interface{}
+		subscriptions   subscriptions
 	}
 
 	osReader struct {
@@ -117,6 +121,14 @@ func (fc *fileBasedClient) GetValue(key Key) []ConstrainedValue {
 	return values[strings.ToLower(key.String())]
 }
 
+func (fc *fileBasedClient) Subscribe(callback func(), keys ...Key) (cancel func()) {
+	return fc.subscriptions.subscribe(callback, keys)
+}
+
+func (fc *fileBasedClient) getSourcedValues(key Key) []sourcedValue {
+	return newSourcedValues(fc.GetValue(key), SourceFile)
+}
+
 func (fc *fileBasedClient) init() error {
 	if err := fc.validateConfig(fc.config); err != nil {
 		return fmt.Errorf("unable to validate dynamic config: %w", err)
@@ -163,38 +175,57 @@ func (fc *fileBasedClient) update() error {
 		return fmt.Errorf("dynamic config file: %s: %w", fc.config.Filepath, err)
 	}
 
+	newValues, err := parseConfigValues(confContent)
+	if err != nil {
+		return err
+	}
+	if err := checkConfigValues(fc.logger, newValues, fc.config.StrictValidation); err != nil {
+		return fmt.Errorf("invalid dynamic config: %w", err)
+	}
+
+	prev := fc.values.Swap(newValues)
+	oldValues, _ := prev.(configValueMap)
+	logDiff(fc.logger, oldValues, newValues)
+	fc.logger.Info("Updated dynamic config")
+	fc.subscriptions.notify(changedKeys(oldValues, newValues))
+
+	return nil
+}
+
+// parseConfigValues decodes dynamic config values from YAML.
+func parseConfigValues(confContent []byte) (configValueMap, error) {
 	var yamlValues map[string][]struct {
 		Constraints map[string]any
 		Value       any
+		Rollout     *int
 	}
-	if err = yaml.Unmarshal(confContent, &yamlValues); err != nil {
-		return fmt.Errorf("unable to decode dynamic config: %w", err)
+	if err := yaml.Unmarshal(confContent, &yamlValues); err != nil {
+		return nil, fmt.Errorf("unable to decode dynamic config: %w", err)
 	}
 
 	newValues := make(configValueMap, len(yamlValues))
 	for key, yamlCV := range yamlValues {
 		cvs := make([]ConstrainedValue, len(yamlCV))
 		for i, cv := range yamlCV {
+			var err error
 			// yaml will unmarshal map into map[interface{}]interface{} instead of map[string]interface{}
 			// manually convert key type to string for all values here
 			cvs[i].Value, err = convertKeyTypeToString(cv.Value)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			cvs[i].Constraints, err = convertYamlConstraints(cv.Constraints)
 			if err != nil {
-				return err
+				return nil, err
+			}
+			if cv.Rollout != nil && (*cv.Rollout < 0 || *cv.Rollout > 100) {
+				return nil, fmt.Errorf("rollout of %s must be a percentage between 0 and 100", key)
 			}
+			cvs[i].Rollout = cv.Rollout
 		}
 		newValues[strings.ToLower(key)] = cvs
 	}
-
-	prev := fc.values.Swap(newValues)
-	oldValues, _ := prev.(configValueMap)
-	fc.logDiff(oldValues, newValues)
-	fc.logger.Info("Updated dynamic config")
-
-	return nil
+	return newValues, nil
 }
 
 func (fc *fileBasedClient) validateConfig(config *FileBasedClientConfig) error {
@@ -210,17 +241,17 @@ func (fc *fileBasedClient) validateConfig(config *FileBasedClientConfig) error {
 	return nil
 }
 
-func (fc *fileBasedClient) logDiff(old configValueMap, new configValueMap) {
+func logDiff(logger log.Logger, old configValueMap, new configValueMap) {
 	for key, newValues := range new {
 		oldValues, ok := old[key]
 		if !ok {
 			for _, newValue := range newValues {
 				// new key added
-				fc.logValueDiff(key, nil, &newValue)
+				logValueDiff(logger, key, nil, &newValue)
 			}
 		} else {
 			// compare existing keys
-			fc.logConstraintsDiff(key, oldValues, newValues)
+			logConstraintsDiff(logger, key, oldValues, newValues)
 		}
 	}
 
@@ -228,25 +259,25 @@ func (fc *fileBasedClient) logDiff(old configValueMap, new configValueMap) {
 	for key, oldValues := range old {
 		if _, ok := new[key]; !ok {
 			for _, oldValue := range oldValues {
-				fc.logValueDiff(key, &oldValue, nil)
+				logValueDiff(logger, key, &oldValue, nil)
 			}
 		}
 	}
 }
 
-func (fc *fileBasedClient) logConstraintsDiff(key string, oldValues []ConstrainedValue, newValues []ConstrainedValue) {
+func logConstraintsDiff(logger log.Logger, key string, oldValues []ConstrainedValue, newValues []ConstrainedValue) {
 	for _, oldValue := range oldValues {
 		matchFound := false
 		for _, newValue := range newValues {
 			if oldValue.Constraints == newValue.Constraints {
 				matchFound = true
-				if !reflect.DeepEqual(oldValue.Value, newValue.Value) {
-					fc.logValueDiff(key, &oldValue, &newValue)
+				if !reflect.DeepEqual(oldValue.Value, newValue.Value) || !reflect.DeepEqual(oldValue.Rollout, newValue.Rollout) {
+					logValueDiff(logger, key, &oldValue, &newValue)
 				}
 			}
 		}
 		if !matchFound {
-			fc.logValueDiff(key, &oldValue, nil)
+			logValueDiff(logger, key, &oldValue, nil)
 		}
 	}
 
@@ -258,24 +289,24 @@ func (fc *fileBasedClient) logConstraintsDiff(key string, oldValues []Constraine
 			}
 		}
 		if !matchFound {
-			fc.logValueDiff(key, nil, &newValue)
+			logValueDiff(logger, key, nil, &newValue)
 		}
 	}
 }
 
-func (fc *fileBasedClient) logValueDiff(key string, oldValue *ConstrainedValue, newValue *ConstrainedValue) {
+func logValueDiff(logger log.Logger, key string, oldValue *ConstrainedValue, newValue *ConstrainedValue) {
 	logLine := &strings.Builder{}
 	logLine.Grow(128)
 	logLine.WriteString("dynamic config changed for the key: ")
 	logLine.WriteString(key)
 	logLine.WriteString(" oldValue: ")
-	fc.appendConstrainedValue(logLine, oldValue)
+	appendConstrainedValue(logLine, oldValue)
 	logLine.WriteString(" newValue: ")
-	fc.appendConstrainedValue(logLine, newValue)
-	fc.logger.Info(logLine.String())
+	appendConstrainedValue(logLine, newValue)
+	logger.Info(logLine.String())
 }
 
-func (fc *fileBasedClient) appendConstrainedValue(logLine *strings.Builder, value *ConstrainedValue) {
+func appendConstrainedValue(logLine *strings.Builder, value *ConstrainedValue) {
 	if value == nil {
 		logLine.WriteString("nil")
 	} else {
@@ -298,7 +329,14 @@ func (fc *fileBasedClient) appendConstrainedValue(logLine *strings.Builder, valu
 		if value.Constraints.TaskType != enumsspb.TASK_TYPE_UNSPECIFIED {
 			logLine.WriteString(fmt.Sprintf("{HistoryTaskType:%s}", value.Constraints.TaskType))
 		}
-		logLine.WriteString(fmt.Sprint("} value: ", value.Value, " }"))
+		if value.Constraints.BuildID != "" {
+			logLine.WriteString(fmt.Sprintf("{BuildID:%s}", value.Constraints.BuildID))
+		}
+		logLine.WriteString(fmt.Sprint("} value: ", value.Value))
+		if value.Rollout != nil {
+			logLine.WriteString(fmt.Sprintf(" rollout: %d%%", *value.Rollout))
+		}
+		logLine.WriteString(" }")
 	}
 }
 
@@ -389,6 +427,12 @@ func convertYamlConstraints(m map[string]any) (Constraints, error) {
 			default:
 				return cs, fmt.Errorf("taskType %T constraint is not supported", v)
 			}
+		case "buildid":
+			if v, ok := v.(string); ok {
+				cs.BuildID = v
+			} else {
+				return cs, fmt.Errorf("buildId constraint must be string")
+			}
 		case "shardid":
 			if v, ok := v.(int); ok {
 				cs.ShardID = int32(v)
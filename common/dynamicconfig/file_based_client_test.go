@@ -244,6 +244,16 @@ func (s *fileBasedClientSuite) TestGetDurationValue_FilteredByTaskTypeQueue() {
 	s.Equal(expectedValue, v)
 }
 
+func (s *fileBasedClientSuite) TestGetFloatValue_FilteredByBuildID() {
+	value := s.collection.GetFloatPropertyFilteredByBuildID(testGetFloatPropertyFilteredByBuildIDKey, 1)
+	s.Equal(10.0, value("global-samples-namespace", "test-tq", enumspb.TASK_QUEUE_TYPE_ACTIVITY, "build-2"))
+	s.Equal(50.0, value("global-samples-namespace", "test-tq", enumspb.TASK_QUEUE_TYPE_WORKFLOW, "build-2"))
+	s.Equal(50.0, value("global-samples-namespace", "other-tq", enumspb.TASK_QUEUE_TYPE_ACTIVITY, "build-2"))
+	s.Equal(100.0, value("global-samples-namespace", "test-tq", enumspb.TASK_QUEUE_TYPE_ACTIVITY, "build-1"))
+	s.Equal(100.0, value("global-samples-namespace", "test-tq", enumspb.TASK_QUEUE_TYPE_ACTIVITY, ""))
+	s.Equal(1.0, value("samples-namespace", "test-tq", enumspb.TASK_QUEUE_TYPE_ACTIVITY, "build-2"))
+}
+
 func (s *fileBasedClientSuite) TestValidateConfig_ConfigNotExist() {
 	_, err := NewFileBasedClient(nil, nil, nil)
 	s.Error(err)
@@ -285,6 +295,8 @@ func (s *fileBasedClientSuite) TestUpdate_ChangedValue() {
 	doneCh := make(chan interface{})
 	reader := NewMockfileReader(ctrl)
 	mockLogger := log.NewMockLogger(ctrl)
+	// the test keys may not match the schemas registered by other tests
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
 
 	updateInterval := time.Minute * 5
 	originFileInfo := &MockFileInfo{ModTimeValue: time.Now()}
@@ -360,6 +372,8 @@ func (s *fileBasedClientSuite) TestUpdate_ChangedMapValue() {
 	doneCh := make(chan interface{})
 	reader := NewMockfileReader(ctrl)
 	mockLogger := log.NewMockLogger(ctrl)
+	// the test keys may not match the schemas registered by other tests
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
 
 	updateInterval := time.Minute * 5
 	originFileInfo := &MockFileInfo{ModTimeValue: time.Now()}
@@ -410,6 +424,8 @@ func (s *fileBasedClientSuite) TestUpdate_NewEntry() {
 	doneCh := make(chan interface{})
 	reader := NewMockfileReader(ctrl)
 	mockLogger := log.NewMockLogger(ctrl)
+	// the test keys may not match the schemas registered by other tests
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
 
 	updateInterval := time.Minute * 5
 	originFileInfo := &MockFileInfo{ModTimeValue: time.Now()}
@@ -463,6 +479,8 @@ func (s *fileBasedClientSuite) TestUpdate_ChangeOrder_ShouldNotWriteLog() {
 	doneCh := make(chan interface{})
 	reader := NewMockfileReader(ctrl)
 	mockLogger := log.NewMockLogger(ctrl)
+	// the test keys may not match the schemas registered by other tests
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
 
 	updateInterval := time.Minute * 5
 	originFileInfo := &MockFileInfo{ModTimeValue: time.Now()}
@@ -0,0 +1,422 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+var _ NotifyingClient = (*kvClient)(nil)
+
+const (
+	// EtcdBackend reads dynamic config from etcd through its gRPC gateway
+	EtcdBackend = "etcd"
+	// ConsulBackend reads dynamic config from the Consul KV store
+	ConsulBackend = "consul"
+
+	defaultKVRetryInterval  = 10 * time.Second
+	defaultKVInitialTimeout = 10 * time.Second
+	consulWaitTime          = 5 * time.Minute
+)
+
+type (
+	// KVClientConfig is the config for the dynamic config client backed by etcd or Consul.
+	// The dynamic config of a cluster is a single YAML document, in the format of the dynamic config
+	// file, stored under <prefix>/<cluster name>. Keys which are not in the document are read from
+	// the dynamic config file, if one is configured.
+	KVClientConfig struct {
+		// Backend is "etcd" or "consul"
+		Backend string `yaml:"backend"`
+		// Endpoint is the URL of the etcd gRPC gateway or Consul HTTP API, e.g. http://127.0.0.1:2379
+		Endpoint string `yaml:"endpoint"`
+		// Prefix is the key prefix under which the dynamic config of each cluster is stored
+		Prefix string `yaml:"prefix"`
+		// Token is the Consul ACL token or etcd auth token, if required
+		Token string `yaml:"token"`
+		// RetryInterval is how long to wait before reconnecting after a failure. Defaults to 10s.
+		RetryInterval time.Duration `yaml:"retryInterval"`
+		// InitialTimeout is how long to wait for the dynamic config at startup before falling back
+		// to the dynamic config file. Defaults to 10s.
+		InitialTimeout time.Duration `yaml:"initialTimeout"`
+		// StrictValidation rejects a document with values that do not match the type, constraints or
+		// validators of their key, instead of only logging them. Unknown keys are always only logged.
+		StrictValidation bool `yaml:"strictValidation"`
+	}
+
+	kvBackend interface {
+		// watch calls update with the value of key, and again each time it changes, until ctx is
+		// done or the connection fails.
+		watch(ctx context.Context, key string, update func(value []byte, exists bool)) error
+	}
+
+	kvClient struct {
+		values   atomic.Value // configValueMap
+		fallback Client
+		backend  kvBackend
+		key      string
+		config   *KVClientConfig
+		logger   log.Logger
+		loaded   chan struct{}
+
+		subscriptions subscriptions
+	}
+
+	etcdBackend struct {
+		endpoint   string
+		token      string
+		httpClient *http.Client
+	}
+
+	consulBackend struct {
+		endpoint   string
+		token      string
+		httpClient *http.Client
+	}
+
+	etcdKeyValue struct {
+		Value string `json:"value"`
+	}
+
+	etcdRangeResponse struct {
+		Header struct {
+			Revision string `json:"revision"`
+		} `json:"header"`
+		Kvs []etcdKeyValue `json:"kvs"`
+	}
+
+	etcdWatchResponse struct {
+		Result struct {
+			Canceled     bool   `json:"canceled"`
+			CancelReason string `json:"cancel_reason"`
+			Events       []struct {
+				Type string       `json:"type"`
+				Kv   etcdKeyValue `json:"kv"`
+			} `json:"events"`
+		} `json:"result"`
+		Error *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+)
+
+// NewKVClient creates a dynamic config client which watches the dynamic config of clusterName in
+// etcd or Consul. Keys which are not set there, or all keys while the store cannot be reached
+// at startup, are read from fallback, which may be nil.
+func NewKVClient(
+	config *KVClientConfig,
+	clusterName string,
+	fallback Client,
+	logger log.Logger,
+	doneCh <-chan interface{},
+) (*kvClient, error) {
+	if config.Endpoint == "" {
+		return nil, errors.New("dynamic config KV client endpoint is not set")
+	}
+	if clusterName == "" {
+		return nil, errors.New("dynamic config KV client requires a cluster name")
+	}
+	endpoint := strings.TrimRight(config.Endpoint, "/")
+	var backend kvBackend
+	switch config.Backend {
+	case EtcdBackend:
+		backend = &etcdBackend{endpoint: endpoint, token: config.Token, httpClient: &http.Client{}}
+	case ConsulBackend:
+		backend = &consulBackend{endpoint: endpoint, token: config.Token, httpClient: &http.Client{}}
+	default:
+		return nil, fmt.Errorf("unknown dynamic config KV backend %q", config.Backend)
+	}
+
+	client := newKVClientWithBackend(backend, config, clusterName, fallback, logger)
+	client.start(doneCh)
+	return client, nil
+}
+
+func newKVClientWithBackend(
+	backend kvBackend,
+	config *KVClientConfig,
+	clusterName string,
+	fallback Client,
+	logger log.Logger,
+) *kvClient {
+	client := &kvClient{
+		fallback: fallback,
+		backend:  backend,
+		key:      strings.TrimRight(config.Prefix, "/") + "/" + clusterName,
+		config:   config,
+		logger:   log.With(logger, tag.NewStringTag("dynamic-config-key", config.Prefix+"/"+clusterName)),
+		loaded:   make(chan struct{}),
+	}
+	client.values.Store(configValueMap(nil))
+	return client
+}
+
+func (c *kvClient) GetValue(key Key) []ConstrainedValue {
+	values := c.values.Load().(configValueMap)
+	if cvs, ok := values[strings.ToLower(key.String())]; ok {
+		return cvs
+	}
+	if c.fallback != nil {
+		return c.fallback.GetValue(key)
+	}
+	return nil
+}
+
+// Subscribe calls callback after the values of any of keys change in the KV store or in the fallback client.
+func (c *kvClient) Subscribe(callback func(), keys ...Key) (cancel func()) {
+	cancelKV := c.subscriptions.subscribe(callback, keys)
+	cancelFallback := func() {}
+	if c.fallback != nil {
+		cancelFallback = subscribeToClient(c.fallback, callback, keys)
+	}
+	return func() {
+		cancelKV()
+		cancelFallback()
+	}
+}
+
+func (c *kvClient) getSourcedValues(key Key) []sourcedValue {
+	values := c.values.Load().(configValueMap)
+	if cvs, ok := values[strings.ToLower(key.String())]; ok {
+		return newSourcedValues(cvs, SourceKV)
+	}
+	if c.fallback != nil {
+		return getSourcedValues(c.fallback, key)
+	}
+	return nil
+}
+
+// start watches the dynamic config until doneCh is closed, and waits for it to be loaded for up to the initial timeout.
+func (c *kvClient) start(doneCh <-chan interface{}) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-doneCh
+		cancel()
+	}()
+	go c.watchLoop(ctx)
+
+	initialTimeout := c.config.InitialTimeout
+	if initialTimeout <= 0 {
+		initialTimeout = defaultKVInitialTimeout
+	}
+	timer := time.NewTimer(initialTimeout)
+	defer timer.Stop()
+	select {
+	case <-c.loaded:
+	case <-timer.C:
+		c.logger.Warn("Dynamic config is not available yet, using the dynamic config file until it is")
+	case <-ctx.Done():
+	}
+}
+
+func (c *kvClient) watchLoop(ctx context.Context) {
+	retryInterval := c.config.RetryInterval
+	if retryInterval <= 0 {
+		retryInterval = defaultKVRetryInterval
+	}
+	for {
+		err := c.backend.watch(ctx, c.key, c.update)
+		if ctx.Err() != nil {
+			return
+		}
+		c.logger.Error("Unable to watch dynamic config, retrying", tag.Error(err))
+		select {
+		case <-time.After(retryInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *kvClient) update(value []byte, exists bool) {
+	var newValues configValueMap
+	if exists {
+		var err error
+		newValues, err = parseConfigValues(value)
+		if err == nil {
+			err = checkConfigValues(c.logger, newValues, c.config.StrictValidation)
+		}
+		if err != nil {
+			c.logger.Error("Unable to update dynamic config, keeping the previous values", tag.Error(err))
+			return
+		}
+	}
+
+	oldValues := c.values.Swap(newValues).(configValueMap)
+	logDiff(c.logger, oldValues, newValues)
+	c.logger.Info("Updated dynamic config")
+	c.subscriptions.notify(changedKeys(oldValues, newValues))
+	select {
+	case <-c.loaded:
+	default:
+		close(c.loaded)
+	}
+}
+
+func (b *etcdBackend) watch(ctx context.Context, key string, update func(value []byte, exists bool)) error {
+	encodedKey := base64.StdEncoding.EncodeToString([]byte(key))
+
+	var rangeResp etcdRangeResponse
+	body, err := b.post(ctx, "/v3/kv/range", map[string]any{"key": encodedKey})
+	if err != nil {
+		return err
+	}
+	err = json.NewDecoder(body).Decode(&rangeResp)
+	_ = body.Close()
+	if err != nil {
+		return fmt.Errorf("unable to decode etcd range response: %w", err)
+	}
+	revision, err := strconv.ParseInt(rangeResp.Header.Revision, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid etcd revision %q: %w", rangeResp.Header.Revision, err)
+	}
+	if len(rangeResp.Kvs) == 0 {
+		update(nil, false)
+	} else {
+		value, err := base64.StdEncoding.DecodeString(rangeResp.Kvs[0].Value)
+		if err != nil {
+			return err
+		}
+		update(value, true)
+	}
+
+	body, err = b.post(ctx, "/v3/watch", map[string]any{
+		"create_request": map[string]any{
+			"key":            encodedKey,
+			"start_revision": strconv.FormatInt(revision+1, 10),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = body.Close() }()
+	decoder := json.NewDecoder(body)
+	for {
+		var watchResp etcdWatchResponse
+		if err := decoder.Decode(&watchResp); err != nil {
+			return fmt.Errorf("etcd watch stream failed: %w", err)
+		}
+		if watchResp.Error != nil {
+			return fmt.Errorf("etcd watch failed: %v", watchResp.Error.Message)
+		}
+		if watchResp.Result.Canceled {
+			return fmt.Errorf("etcd watch canceled: %v", watchResp.Result.CancelReason)
+		}
+		for _, event := range watchResp.Result.Events {
+			if event.Type == "DELETE" {
+				update(nil, false)
+				continue
+			}
+			value, err := base64.StdEncoding.DecodeString(event.Kv.Value)
+			if err != nil {
+				return err
+			}
+			update(value, true)
+		}
+	}
+}
+
+func (b *etcdBackend) post(ctx context.Context, path string, request map[string]any) (io.ReadCloser, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.endpoint+path, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if b.token != "" {
+		req.Header.Set("Authorization", b.token)
+	}
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("etcd returned %v for %v", resp.Status, path)
+	}
+	return resp.Body, nil
+}
+
+func (b *consulBackend) watch(ctx context.Context, key string, update func(value []byte, exists bool)) error {
+	var index uint64
+	for {
+		query := url.Values{"raw": []string{""}}
+		if index > 0 {
+			query.Set("index", strconv.FormatUint(index, 10))
+			query.Set("wait", consulWaitTime.String())
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.endpoint+"/v1/kv/"+key+"?"+query.Encode(), nil)
+		if err != nil {
+			return err
+		}
+		if b.token != "" {
+			req.Header.Set("X-Consul-Token", b.token)
+		}
+		resp, err := b.httpClient.Do(req)
+		if err != nil {
+			return err
+		}
+		value, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("consul returned %v", resp.Status)
+		}
+
+		newIndex, err := strconv.ParseUint(resp.Header.Get("X-Consul-Index"), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid consul index %q: %w", resp.Header.Get("X-Consul-Index"), err)
+		}
+		// the blocking query timed out without changes
+		if newIndex == index {
+			continue
+		}
+		update(value, resp.StatusCode == http.StatusOK)
+		// the index may go backwards, e.g. when the Consul state is restored, in which case it is reset
+		if newIndex < index {
+			newIndex = 0
+		}
+		index = newIndex
+	}
+}
@@ -0,0 +1,249 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/log"
+)
+
+const (
+	testKVKey      = "temporal/dynamicconfig/active"
+	testKVConfigV1 = `
+testGetIntPropertyKey:
+- value: 100
+testGetBoolPropertyKey:
+- value: true
+  constraints:
+    namespace: global-samples-namespace
+`
+	testKVConfigV2 = `
+testGetIntPropertyKey:
+- value: 200
+`
+)
+
+type (
+	fakeConsul struct {
+		sync.Mutex
+		value   []byte
+		index   uint64
+		changed chan struct{}
+	}
+
+	fakeEtcd struct {
+		sync.Mutex
+		value  []byte
+		events chan []byte
+	}
+)
+
+func testKVFallback() Client {
+	return StaticClient{
+		testGetIntPropertyKey:    1,
+		testGetStringPropertyKey: "fallback",
+	}
+}
+
+func newTestKVClient(t *testing.T, backend string, endpoint string) Client {
+	doneCh := make(chan interface{})
+	t.Cleanup(func() { close(doneCh) })
+	client, err := NewKVClient(&KVClientConfig{
+		Backend:        backend,
+		Endpoint:       endpoint,
+		Prefix:         "temporal/dynamicconfig/",
+		RetryInterval:  10 * time.Millisecond,
+		InitialTimeout: time.Second,
+	}, "active", testKVFallback(), log.NewNoopLogger(), doneCh)
+	require.NoError(t, err)
+	return client
+}
+
+func requireIntValue(t *testing.T, client Client, expected int) {
+	require.Eventually(t, func() bool {
+		cvs := client.GetValue(testGetIntPropertyKey)
+		return len(cvs) == 1 && cvs[0].Value == expected
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestKVClient_Consul(t *testing.T) {
+	consul := &fakeConsul{value: []byte(testKVConfigV1), index: 1, changed: make(chan struct{})}
+	server := httptest.NewServer(consul)
+	// closing the server waits for the watch, so it is closed after the client is stopped
+	t.Cleanup(server.Close)
+	client := newTestKVClient(t, ConsulBackend, server.URL)
+
+	requireIntValue(t, client, 100)
+	cvs := client.GetValue(testGetBoolPropertyKey)
+	require.Len(t, cvs, 1)
+	require.Equal(t, "global-samples-namespace", cvs[0].Constraints.Namespace)
+	// keys which are not in the KV store are read from the fallback client
+	require.Equal(t, []ConstrainedValue{{Value: "fallback"}}, client.GetValue(testGetStringPropertyKey))
+
+	consul.set([]byte(testKVConfigV2))
+	requireIntValue(t, client, 200)
+	require.Empty(t, client.GetValue(testGetBoolPropertyKey))
+
+	// invalid values are ignored
+	consul.set([]byte("testGetIntPropertyKey: [["))
+	require.Never(t, func() bool {
+		cvs := client.GetValue(testGetIntPropertyKey)
+		return len(cvs) != 1 || cvs[0].Value != 200
+	}, 200*time.Millisecond, 10*time.Millisecond)
+
+	consul.set(nil)
+	requireIntValue(t, client, 1)
+}
+
+func TestKVClient_Etcd(t *testing.T) {
+	etcd := &fakeEtcd{value: []byte(testKVConfigV1), events: make(chan []byte, 1)}
+	server := httptest.NewServer(etcd)
+	t.Cleanup(server.Close)
+	client := newTestKVClient(t, EtcdBackend, server.URL)
+
+	requireIntValue(t, client, 100)
+	require.Equal(t, []ConstrainedValue{{Value: "fallback"}}, client.GetValue(testGetStringPropertyKey))
+
+	etcd.events <- []byte(testKVConfigV2)
+	requireIntValue(t, client, 200)
+
+	etcd.events <- nil
+	requireIntValue(t, client, 1)
+}
+
+func TestKVClient_Unavailable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	doneCh := make(chan interface{})
+	defer close(doneCh)
+	client, err := NewKVClient(&KVClientConfig{
+		Backend:        ConsulBackend,
+		Endpoint:       server.URL,
+		InitialTimeout: 10 * time.Millisecond,
+	}, "active", testKVFallback(), log.NewNoopLogger(), doneCh)
+	require.NoError(t, err)
+
+	requireIntValue(t, client, 1)
+}
+
+func TestKVClient_InvalidConfig(t *testing.T) {
+	doneCh := make(chan interface{})
+	defer close(doneCh)
+	_, err := NewKVClient(&KVClientConfig{Backend: "zookeeper", Endpoint: "http://localhost"}, "active", nil, log.NewNoopLogger(), doneCh)
+	require.Error(t, err)
+	_, err = NewKVClient(&KVClientConfig{Backend: ConsulBackend, Endpoint: "http://localhost"}, "", nil, log.NewNoopLogger(), doneCh)
+	require.Error(t, err)
+}
+
+func (c *fakeConsul) set(value []byte) {
+	c.Lock()
+	defer c.Unlock()
+	c.value = value
+	c.index++
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+func (c *fakeConsul) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/v1/kv/"+testKVKey {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	index, _ := strconv.ParseUint(r.URL.Query().Get("index"), 10, 64)
+	c.Lock()
+	if index == c.index {
+		changed := c.changed
+		c.Unlock()
+		select {
+		case <-changed:
+		case <-time.After(100 * time.Millisecond):
+		case <-r.Context().Done():
+			return
+		}
+		c.Lock()
+	}
+	value, index := c.value, c.index
+	c.Unlock()
+
+	w.Header().Set("X-Consul-Index", strconv.FormatUint(index, 10))
+	if value == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_, _ = w.Write(value)
+}
+
+func (e *fakeEtcd) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var request map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	switch r.URL.Path {
+	case "/v3/kv/range":
+		if request["key"] != base64.StdEncoding.EncodeToString([]byte(testKVKey)) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		e.Lock()
+		value := e.value
+		e.Unlock()
+		_, _ = fmt.Fprintf(w, `{"header": {"revision": "7"}, "kvs": [{"value": %q}]}`, base64.StdEncoding.EncodeToString(value))
+	case "/v3/watch":
+		createRequest, _ := request["create_request"].(map[string]any)
+		if createRequest["start_revision"] != "8" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"result": {"header": {"revision": "7"}, "created": true}}`+"\n")
+		w.(http.Flusher).Flush()
+		for {
+			select {
+			case value := <-e.events:
+				if value == nil {
+					_, _ = fmt.Fprint(w, `{"result": {"events": [{"type": "DELETE", "kv": {}}]}}`+"\n")
+				} else {
+					_, _ = fmt.Fprintf(w, `{"result": {"events": [{"kv": {"value": %q}}]}}`+"\n", base64.StdEncoding.EncodeToString(value))
+				}
+				w.(http.Flusher).Flush()
+			case <-r.Context().Done():
+				return
+			}
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
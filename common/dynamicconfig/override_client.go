@@ -0,0 +1,187 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"gopkg.in/yaml.v3"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+var _ NotifyingClient = (*OverrideClient)(nil)
+
+// OverridesDataKey is the key of the system namespace data holding the runtime overrides.
+const OverridesDataKey = "temporal.dynamicconfig.overrides"
+
+type (
+	// OverrideValue is a value set at runtime for a key, with the constraints it applies to, in the
+	// format of the dynamic config file.
+	OverrideValue struct {
+		Constraints map[string]any `yaml:"constraints,omitempty" json:"constraints,omitempty"`
+		Value       any            `yaml:"value" json:"value"`
+		// Rollout is the percentage of the namespaces, task queues or shards the value applies to, or
+		// nil for all of them
+		Rollout *int `yaml:"rollout,omitempty" json:"rollout,omitempty"`
+	}
+
+	// Overrides are the values set at runtime, by key.
+	Overrides map[string][]OverrideValue
+
+	// OverrideClient serves values set at runtime on top of another client. The values of a key
+	// which are not overridden for the same constraints are still read from the other client.
+	OverrideClient struct {
+		client    Client
+		logger    log.Logger
+		overrides atomic.Value // configValueMap
+
+		sync.Mutex
+		document string
+
+		subscriptions subscriptions
+	}
+)
+
+// NewOverrideClient creates a client serving runtime overrides on top of client.
+func NewOverrideClient(client Client, logger log.Logger) *OverrideClient {
+	c := &OverrideClient{
+		client: client,
+		logger: logger,
+	}
+	c.overrides.Store(configValueMap(nil))
+	return c
+}
+
+func (c *OverrideClient) GetValue(key Key) []ConstrainedValue {
+	overrides := c.overrides.Load().(configValueMap)
+	cvs, ok := overrides[strings.ToLower(key.String())]
+	if !ok {
+		return c.client.GetValue(key)
+	}
+	// overrides take precedence over the values of the underlying client with the same constraints
+	return append(cvs[:len(cvs):len(cvs)], c.client.GetValue(key)...)
+}
+
+// Subscribe calls callback after the overrides or the values of the underlying client of any of keys change.
+func (c *OverrideClient) Subscribe(callback func(), keys ...Key) (cancel func()) {
+	cancelOverrides := c.subscriptions.subscribe(callback, keys)
+	cancelClient := subscribeToClient(c.client, callback, keys)
+	return func() {
+		cancelOverrides()
+		cancelClient()
+	}
+}
+
+func (c *OverrideClient) getSourcedValues(key Key) []sourcedValue {
+	overrides := c.overrides.Load().(configValueMap)
+	return append(
+		newSourcedValues(overrides[strings.ToLower(key.String())], SourceOverride),
+		getSourcedValues(c.client, key)...,
+	)
+}
+
+// SetOverrides replaces the overrides with those of document, in the format of the dynamic config file.
+func (c *OverrideClient) SetOverrides(document string) error {
+	c.Lock()
+	defer c.Unlock()
+	if document == c.document {
+		return nil
+	}
+
+	newValues, err := parseConfigValues([]byte(document))
+	if err != nil {
+		return err
+	}
+	oldValues := c.overrides.Swap(newValues).(configValueMap)
+	c.document = document
+	logDiff(c.logger, oldValues, newValues)
+	c.logger.Info("Updated dynamic config overrides", tag.NewInt("overridden-keys", len(newValues)))
+	c.subscriptions.notify(changedKeys(oldValues, newValues))
+	return nil
+}
+
+// ParseOverrides decodes overrides from a document in the format of the dynamic config file.
+func ParseOverrides(document string) (Overrides, error) {
+	overrides := make(Overrides)
+	if err := yaml.Unmarshal([]byte(document), &overrides); err != nil {
+		return nil, fmt.Errorf("unable to decode dynamic config overrides: %w", err)
+	}
+	return overrides, nil
+}
+
+// Marshal encodes the overrides in the format of the dynamic config file.
+func (o Overrides) Marshal() (string, error) {
+	if len(o) == 0 {
+		return "", nil
+	}
+	data, err := yaml.Marshal(o)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// ValidateOverride returns an error if key has no registered schema, or if value does not satisfy it.
+func ValidateOverride(key Key, value OverrideValue) error {
+	schema, ok := LookupSchema(key)
+	if !ok {
+		return fmt.Errorf("unknown dynamic config key %v", key)
+	}
+	cv, err := value.toConstrainedValue()
+	if err != nil {
+		return err
+	}
+	return schema.Validate(cv)
+}
+
+// SameConstraints returns whether the value applies to the same constraints as constraints.
+func (v OverrideValue) SameConstraints(constraints map[string]any) (bool, error) {
+	cs, err := convertYamlConstraints(v.Constraints)
+	if err != nil {
+		return false, err
+	}
+	other, err := convertYamlConstraints(constraints)
+	if err != nil {
+		return false, err
+	}
+	return cs == other, nil
+}
+
+func (v OverrideValue) toConstrainedValue() (ConstrainedValue, error) {
+	var cv ConstrainedValue
+	var err error
+	cv.Constraints, err = convertYamlConstraints(v.Constraints)
+	if err != nil {
+		return cv, err
+	}
+	cv.Value, err = convertKeyTypeToString(v.Value)
+	cv.Rollout = v.Rollout
+	return cv, err
+}
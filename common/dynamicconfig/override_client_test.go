@@ -0,0 +1,163 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/log"
+)
+
+func TestValidateOverride(t *testing.T) {
+	dc := NewNoopCollection()
+	dc.GetDurationPropertyFilteredByTaskQueueInfo(testGetDurationPropertyFilteredByTaskQueueInfoKey, 0)
+	dc.GetBoolProperty(testGetBoolPropertyKey, false)
+	dc.GetBoolPropertyFnWithNamespaceFilter(testGetBoolPropertyKey, false)
+
+	require.NoError(t, ValidateOverride(testGetDurationPropertyFilteredByTaskQueueInfoKey, OverrideValue{
+		Constraints: map[string]any{"namespace": "samples", "taskQueueName": "orders", "taskType": "Activity"},
+		Value:       "10s",
+	}))
+	require.ErrorContains(t, ValidateOverride(testGetDurationPropertyFilteredByTaskQueueInfoKey, OverrideValue{
+		Value: true,
+	}), "value must be a duration")
+	require.ErrorContains(t, ValidateOverride(testGetDurationPropertyFilteredByTaskQueueInfoKey, OverrideValue{
+		Constraints: map[string]any{"shardId": 1},
+		Value:       "10s",
+	}), "shardId constraint is not supported")
+	require.ErrorContains(t, ValidateOverride(testGetDurationPropertyFilteredByTaskQueueInfoKey, OverrideValue{
+		Constraints: map[string]any{"cluster": "active"},
+		Value:       "10s",
+	}), "unknown constraint type")
+	require.ErrorContains(t, ValidateOverride(testGetDurationPropertyFilteredByTaskQueueInfoKey, OverrideValue{
+		Constraints: map[string]any{"buildId": "build-2"},
+		Value:       "10s",
+	}), "buildId constraint is not supported")
+
+	dc.GetFloatPropertyFilteredByBuildID(testGetFloatPropertyFilteredByBuildIDKey, 0)
+	require.NoError(t, ValidateOverride(testGetFloatPropertyFilteredByBuildIDKey, OverrideValue{
+		Constraints: map[string]any{"namespace": "samples", "buildId": "build-2"},
+		Value:       10,
+	}))
+
+	// a key read with different filters accepts all of them
+	require.NoError(t, ValidateOverride(testGetBoolPropertyKey, OverrideValue{Value: true}))
+	require.NoError(t, ValidateOverride(testGetBoolPropertyKey, OverrideValue{Constraints: map[string]any{"namespace": "samples"}, Value: true}))
+
+	require.ErrorContains(t, ValidateOverride("testUnregisteredKey", OverrideValue{Value: 1}), "unknown dynamic config key")
+}
+
+func TestOverrideClient(t *testing.T) {
+	client := NewOverrideClient(StaticClient{
+		testGetIntPropertyKey: []ConstrainedValue{
+			{Value: 1},
+			{Constraints: Constraints{Namespace: "samples"}, Value: 2},
+		},
+		testGetStringPropertyKey: "file",
+	}, log.NewNoopLogger())
+	dc := NewCollection(client, log.NewNoopLogger())
+	intProperty := dc.GetIntPropertyFilteredByNamespace(testGetIntPropertyKey, 0)
+	stringProperty := dc.GetStringProperty(testGetStringPropertyKey, "")
+
+	require.Equal(t, 1, intProperty("other"))
+	require.Equal(t, 2, intProperty("samples"))
+
+	require.NoError(t, client.SetOverrides(`
+testGetIntPropertyKey:
+- value: 10
+`))
+	// values of the underlying client for other constraints are kept
+	require.Equal(t, 10, intProperty("other"))
+	require.Equal(t, 2, intProperty("samples"))
+	require.Equal(t, "file", stringProperty())
+
+	require.Error(t, client.SetOverrides("testGetIntPropertyKey: [["))
+	require.Equal(t, 10, intProperty("other"))
+
+	require.NoError(t, client.SetOverrides(""))
+	require.Equal(t, 1, intProperty("other"))
+}
+
+func TestOverridesRoundTrip(t *testing.T) {
+	overrides := Overrides{
+		"testGetMapPropertyKey": {{Value: map[string]any{"allow": []any{"10.0.0.0/8"}}}},
+		"testGetIntPropertyKey": {{Constraints: map[string]any{"shardId": 3}, Value: 7}},
+	}
+	document, err := overrides.Marshal()
+	require.NoError(t, err)
+	parsed, err := ParseOverrides(document)
+	require.NoError(t, err)
+	require.Equal(t, overrides, parsed)
+
+	values, err := parseConfigValues([]byte(document))
+	require.NoError(t, err)
+	require.Equal(t, []ConstrainedValue{{Constraints: Constraints{ShardID: 3}, Value: 7}}, values["testgetintpropertykey"])
+}
+
+func TestRollout(t *testing.T) {
+	client := NewOverrideClient(StaticClient{testGetIntPropertyFilteredByTaskQueueInfoKey: 1}, log.NewNoopLogger())
+	dc := NewCollection(client, log.NewNoopLogger())
+	property := dc.GetIntPropertyFilteredByTaskQueueInfo(testGetIntPropertyFilteredByTaskQueueInfoKey, 0)
+	selected := func() map[string]bool {
+		result := make(map[string]bool)
+		for i := 0; i < 1000; i++ {
+			tq := fmt.Sprintf("tq-%d", i)
+			if property("samples", tq, enumspb.TASK_QUEUE_TYPE_ACTIVITY) == 2 {
+				result[tq] = true
+			}
+		}
+		return result
+	}
+
+	require.NoError(t, client.SetOverrides("testGetIntPropertyFilteredByTaskQueueInfoKey:\n- value: 2\n  rollout: 0\n"))
+	require.Empty(t, selected())
+
+	require.NoError(t, client.SetOverrides("testGetIntPropertyFilteredByTaskQueueInfoKey:\n- value: 2\n  rollout: 20\n"))
+	twenty := selected()
+	require.InDelta(t, 200, len(twenty), 50)
+
+	// advancing the rollout keeps the task queues already selected
+	require.NoError(t, client.SetOverrides("testGetIntPropertyFilteredByTaskQueueInfoKey:\n- value: 2\n  rollout: 60\n"))
+	sixty := selected()
+	require.InDelta(t, 600, len(sixty), 50)
+	for tq := range twenty {
+		require.True(t, sixty[tq])
+	}
+
+	require.NoError(t, client.SetOverrides("testGetIntPropertyFilteredByTaskQueueInfoKey:\n- value: 2\n"))
+	require.Len(t, selected(), 1000)
+
+	require.Error(t, client.SetOverrides("testGetIntPropertyFilteredByTaskQueueInfoKey:\n- value: 2\n  rollout: 101\n"))
+
+	rollout := 10
+	require.NoError(t, ValidateOverride(testGetIntPropertyFilteredByTaskQueueInfoKey, OverrideValue{Value: 2, Rollout: &rollout}))
+	// keys without filters have nothing to select a fraction of
+	dc.GetIntProperty("testRolloutGlobalKey", 0)
+	require.ErrorContains(t, ValidateOverride("testRolloutGlobalKey", OverrideValue{Value: 2, Rollout: &rollout}), "rollout is not supported")
+}
@@ -0,0 +1,296 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.uber.org/multierr"
+	"golang.org/x/exp/slices"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	IntType      ValueType = "int"
+	FloatType    ValueType = "float"
+	DurationType ValueType = "duration"
+	BoolType     ValueType = "bool"
+	StringType   ValueType = "string"
+	MapType      ValueType = "map"
+)
+
+// Filters are named after the constraints of the dynamic config file.
+const (
+	NamespaceFilter       Filter = "namespace"
+	NamespaceIDFilter     Filter = "namespaceId"
+	TaskQueueNameFilter   Filter = "taskQueueName"
+	TaskQueueTypeFilter   Filter = "taskType"
+	ShardIDFilter         Filter = "shardId"
+	HistoryTaskTypeFilter Filter = "historyTaskType"
+	BuildIDFilter         Filter = "buildId"
+)
+
+type (
+	// ValueType is the type of the values of a key.
+	ValueType string
+
+	// Filter is a constraint which the values of a key may be set for.
+	Filter string
+
+	// Schema describes the values accepted for a key. Schemas are registered when the server reads
+	// a key through a Collection, so only keys read by the services running in this process are known.
+	Schema struct {
+		// Key is the name of the key, as it was registered
+		Key     Key
+		Type    ValueType
+		Filters []Filter
+		// Default is the value used when no value of the key matches
+		Default any
+	}
+
+	// Validator checks a value of a key beyond its type. It is called with the converted value,
+	// e.g. an int for IntType or a time.Duration for DurationType.
+	Validator func(value any) error
+)
+
+var (
+	schemasLock sync.RWMutex
+	schemas     = make(map[string]Schema) // by lower case key
+
+	namespaceFilters     = []Filter{NamespaceFilter}
+	namespaceIDFilters   = []Filter{NamespaceIDFilter}
+	taskQueueInfoFilters = []Filter{NamespaceFilter, TaskQueueNameFilter, TaskQueueTypeFilter}
+	buildIDFilters       = []Filter{NamespaceFilter, TaskQueueNameFilter, TaskQueueTypeFilter, BuildIDFilter}
+	shardIDFilters       = []Filter{ShardIDFilter}
+	taskTypeFilters      = []Filter{HistoryTaskTypeFilter}
+
+	validators = map[string][]Validator{ // by lower case key
+		strings.ToLower(FrontendRPS):                                            {minInt(0)},
+		strings.ToLower(HistoryCacheMaxSize):                                    {minInt(1)},
+		strings.ToLower(MatchingNumTaskqueueWritePartitions):                    {minInt(1)},
+		strings.ToLower(MatchingNumTaskqueueReadPartitions):                     {minInt(1)},
+		strings.ToLower(MatchingLongPollExpirationInterval):                     {minDuration(time.Millisecond)},
+		strings.ToLower(AdminMatchingNamespaceTaskqueueToPartitionDispatchRate): {minFloat(0)},
+		strings.ToLower(NamespaceDebugTelemetryExpiry):                          {timeRFC3339()},
+	}
+)
+
+// LookupSchema returns the schema of key, if it is registered.
+func LookupSchema(key Key) (Schema, bool) {
+	schemasLock.RLock()
+	defer schemasLock.RUnlock()
+	schema, ok := schemas[strings.ToLower(key.String())]
+	return schema, ok
+}
+
+// Schemas returns the schemas of all registered keys, sorted by key.
+func Schemas() []Schema {
+	schemasLock.RLock()
+	result := make([]Schema, 0, len(schemas))
+	for _, schema := range schemas {
+		result = append(result, schema)
+	}
+	schemasLock.RUnlock()
+	sort.Slice(result, func(i, j int) bool {
+		return strings.ToLower(result[i].Key.String()) < strings.ToLower(result[j].Key.String())
+	})
+	return result
+}
+
+// RegisterValidator adds a validator for the values of key. Values set in the dynamic config file,
+// the KV store or through runtime overrides are checked against it.
+func RegisterValidator(key Key, validator Validator) {
+	schemasLock.Lock()
+	defer schemasLock.Unlock()
+	name := strings.ToLower(key.String())
+	validators[name] = append(validators[name], validator)
+}
+
+// registerSchema registers the schema of a key. A key read with different filters accepts all of them.
+func registerSchema(key Key, valueType ValueType, filters []Filter, defaultValue any) {
+	schemasLock.Lock()
+	defer schemasLock.Unlock()
+	name := strings.ToLower(key.String())
+	schema, ok := schemas[name]
+	if !ok || schema.Type != valueType {
+		schemas[name] = Schema{Key: key, Type: valueType, Filters: filters, Default: defaultValue}
+		return
+	}
+	for _, filter := range filters {
+		if !slices.Contains(schema.Filters, filter) {
+			schema.Filters = append(slices.Clip(schema.Filters), filter)
+		}
+	}
+	schemas[name] = schema
+}
+
+// Validate returns an error if the value is not of the type of the schema, is rejected by a validator
+// of the key, or is constrained by a filter the schema does not allow.
+func (s Schema) Validate(cv ConstrainedValue) error {
+	var value any
+	var err error
+	switch s.Type {
+	case IntType:
+		value, err = convertInt(cv.Value)
+	case FloatType:
+		value, err = convertFloat(cv.Value)
+	case DurationType:
+		value, err = convertDuration(cv.Value)
+	case BoolType:
+		value, err = convertBool(cv.Value)
+	case StringType:
+		value, err = convertString(cv.Value)
+	case MapType:
+		value, err = convertMap(cv.Value)
+	default:
+		err = fmt.Errorf("unknown value type %v", s.Type)
+	}
+	if err != nil {
+		return fmt.Errorf("value must be a %v: %w", s.Type, err)
+	}
+
+	if cv.Rollout != nil {
+		if *cv.Rollout < 0 || *cv.Rollout > 100 {
+			return fmt.Errorf("rollout must be a percentage between 0 and 100")
+		}
+		if len(s.Filters) == 0 {
+			return fmt.Errorf("rollout is not supported for keys without constraints")
+		}
+	}
+
+	schemasLock.RLock()
+	keyValidators := validators[strings.ToLower(s.Key.String())]
+	schemasLock.RUnlock()
+	for _, validator := range keyValidators {
+		if err := validator(value); err != nil {
+			return fmt.Errorf("invalid value %v: %w", cv.Value, err)
+		}
+	}
+
+	used := map[Filter]bool{
+		NamespaceFilter:       cv.Constraints.Namespace != "",
+		NamespaceIDFilter:     cv.Constraints.NamespaceID != "",
+		TaskQueueNameFilter:   cv.Constraints.TaskQueueName != "",
+		TaskQueueTypeFilter:   cv.Constraints.TaskQueueType != enumspb.TASK_QUEUE_TYPE_UNSPECIFIED,
+		ShardIDFilter:         cv.Constraints.ShardID != 0,
+		HistoryTaskTypeFilter: cv.Constraints.TaskType != enumsspb.TASK_TYPE_UNSPECIFIED,
+		BuildIDFilter:         cv.Constraints.BuildID != "",
+	}
+	for _, filter := range s.Filters {
+		delete(used, filter)
+	}
+	for filter, isUsed := range used {
+		if isUsed {
+			return fmt.Errorf("%v constraint is not supported, allowed constraints: %v", filter, s.Filters)
+		}
+	}
+	return nil
+}
+
+// checkConfigValues checks values loaded by a client against the registered schemas. Values of unknown
+// keys are only logged, since keys read by components which have not started yet are not registered.
+// Values which do not match the schema of their key are logged, or rejected if strict is set.
+func checkConfigValues(logger log.Logger, values configValueMap, strict bool) error {
+	schemasLock.RLock()
+	noSchemas := len(schemas) == 0
+	schemasLock.RUnlock()
+	if noSchemas {
+		// nothing to check against, e.g. the client was created outside of a server
+		return nil
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var errs error
+	for _, key := range keys {
+		schema, ok := LookupSchema(Key(key))
+		if !ok {
+			logger.Warn("Unknown dynamic config key", tag.Key(key))
+			continue
+		}
+		for _, cv := range values[key] {
+			if err := schema.Validate(cv); err != nil {
+				errs = multierr.Append(errs, fmt.Errorf("%v: %w", schema.Key, err))
+			}
+		}
+	}
+	if errs != nil && !strict {
+		for _, err := range multierr.Errors(errs) {
+			logger.Warn("Invalid dynamic config value", tag.Error(err))
+		}
+		return nil
+	}
+	return errs
+}
+
+func minInt(min int) Validator {
+	return func(value any) error {
+		if v, ok := value.(int); ok && v < min {
+			return fmt.Errorf("must be at least %d", min)
+		}
+		return nil
+	}
+}
+
+func minFloat(min float64) Validator {
+	return func(value any) error {
+		if v, ok := value.(float64); ok && v < min {
+			return fmt.Errorf("must be at least %v", min)
+		}
+		return nil
+	}
+}
+
+func timeRFC3339() Validator {
+	return func(value any) error {
+		if v, ok := value.(string); ok && v != "" {
+			if _, err := time.Parse(time.RFC3339, v); err != nil {
+				return fmt.Errorf("must be empty or an RFC 3339 time: %w", err)
+			}
+		}
+		return nil
+	}
+}
+
+func minDuration(min time.Duration) Validator {
+	return func(value any) error {
+		if v, ok := value.(time.Duration); ok && v < min {
+			return fmt.Errorf("must be at least %v", min)
+		}
+		return nil
+	}
+}
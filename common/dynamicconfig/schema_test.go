@@ -0,0 +1,89 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/log"
+)
+
+var errTestEmptyString = errors.New("must not be empty")
+
+func TestSchemaValidators(t *testing.T) {
+	dc := NewNoopCollection()
+	dc.GetIntPropertyFilteredByTaskQueueInfo(MatchingNumTaskqueueWritePartitions, 1)
+	schema, ok := LookupSchema(MatchingNumTaskqueueWritePartitions)
+	require.True(t, ok)
+	require.Equal(t, 1, schema.Default)
+
+	require.NoError(t, schema.Validate(ConstrainedValue{Value: 4}))
+	require.ErrorContains(t, schema.Validate(ConstrainedValue{Value: 0}), "must be at least 1")
+
+	const key = "testSchemaValidatorsKey"
+	dc.GetStringProperty(key, "")
+	RegisterValidator(key, func(value any) error {
+		if value.(string) == "" {
+			return errTestEmptyString
+		}
+		return nil
+	})
+	schema, ok = LookupSchema(key)
+	require.True(t, ok)
+	require.NoError(t, schema.Validate(ConstrainedValue{Value: "set"}))
+	require.ErrorIs(t, schema.Validate(ConstrainedValue{Value: ""}), errTestEmptyString)
+}
+
+func TestCheckConfigValues(t *testing.T) {
+	dc := NewNoopCollection()
+	dc.GetIntPropertyFilteredByNamespace(testGetIntPropertyFilteredByNamespaceKey, 0)
+	values, err := parseConfigValues([]byte(`
+testGetIntPropertyFilteredByNamespaceKey:
+- value: 10
+  constraints:
+    namespace: samples
+testCheckConfigValuesUnknownKey:
+- value: 10
+`))
+	require.NoError(t, err)
+	// unknown keys are only logged
+	require.NoError(t, checkConfigValues(log.NewNoopLogger(), values, true))
+
+	values, err = parseConfigValues([]byte(`
+testGetIntPropertyFilteredByNamespaceKey:
+- value: ten
+- value: 10
+  constraints:
+    taskQueueName: orders
+`))
+	require.NoError(t, err)
+	require.NoError(t, checkConfigValues(log.NewNoopLogger(), values, false))
+	err = checkConfigValues(log.NewNoopLogger(), values, true)
+	require.ErrorContains(t, err, "value must be a int")
+	require.ErrorContains(t, err, "taskQueueName constraint is not supported")
+}
@@ -0,0 +1,114 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+type (
+	// NotifyingClient is a Client which notifies subscribers when the values of keys change.
+	NotifyingClient interface {
+		Client
+		// Subscribe calls callback after the values of any of keys change, until cancel is called.
+		// Callbacks are called from the goroutine updating the values and must not block.
+		Subscribe(callback func(), keys ...Key) (cancel func())
+	}
+
+	subscriptions struct {
+		sync.Mutex
+		nextID    int
+		callbacks map[string]map[int]func() // by lower case key, then subscription id
+	}
+)
+
+func (s *subscriptions) subscribe(callback func(), keys []Key) (cancel func()) {
+	s.Lock()
+	defer s.Unlock()
+	if s.callbacks == nil {
+		s.callbacks = make(map[string]map[int]func())
+	}
+	id := s.nextID
+	s.nextID++
+	names := make([]string, len(keys))
+	for i, key := range keys {
+		names[i] = strings.ToLower(key.String())
+		if s.callbacks[names[i]] == nil {
+			s.callbacks[names[i]] = make(map[int]func())
+		}
+		s.callbacks[names[i]][id] = callback
+	}
+	return func() {
+		s.Lock()
+		defer s.Unlock()
+		for _, name := range names {
+			delete(s.callbacks[name], id)
+			if len(s.callbacks[name]) == 0 {
+				delete(s.callbacks, name)
+			}
+		}
+	}
+}
+
+// notify calls the callbacks subscribed to any of keys, once each.
+func (s *subscriptions) notify(keys []string) {
+	s.Lock()
+	callbacks := make(map[int]func())
+	for _, key := range keys {
+		for id, callback := range s.callbacks[key] {
+			callbacks[id] = callback
+		}
+	}
+	s.Unlock()
+	for _, callback := range callbacks {
+		callback()
+	}
+}
+
+// changedKeys returns the keys whose values differ between old and new.
+func changedKeys(old configValueMap, new configValueMap) []string {
+	var keys []string
+	for key, newValues := range new {
+		if !reflect.DeepEqual(old[key], newValues) {
+			keys = append(keys, key)
+		}
+	}
+	for key := range old {
+		if _, ok := new[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// subscribeToClient subscribes callback to the changes of client, if it notifies them.
+func subscribeToClient(client Client, callback func(), keys []Key) (cancel func()) {
+	if client, ok := client.(NotifyingClient); ok {
+		return client.Subscribe(callback, keys...)
+	}
+	return func() {}
+}
@@ -0,0 +1,71 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/log"
+)
+
+func TestChangedKeys(t *testing.T) {
+	old := configValueMap{
+		"same":    {{Value: 1}},
+		"changed": {{Value: 1}},
+		"removed": {{Value: 1}},
+	}
+	new := configValueMap{
+		"same":    {{Value: 1}},
+		"changed": {{Value: 1}, {Constraints: Constraints{Namespace: "samples"}, Value: 2}},
+		"added":   {{Value: 1}},
+	}
+	require.ElementsMatch(t, []string{"changed", "removed", "added"}, changedKeys(old, new))
+}
+
+func TestSubscribe(t *testing.T) {
+	base := NewOverrideClient(NewNoopClient(), log.NewNoopLogger())
+	client := NewOverrideClient(base, log.NewNoopLogger())
+	dc := NewCollection(client, log.NewNoopLogger())
+
+	var calls int
+	cancel := dc.Subscribe(func() { calls++ }, testGetIntPropertyKey, testGetBoolPropertyKey)
+
+	// a change to several subscribed keys calls the callback once
+	require.NoError(t, client.SetOverrides("testGetIntPropertyKey:\n- value: 1\ntestGetBoolPropertyKey:\n- value: true\n"))
+	require.Equal(t, 1, calls)
+	// keys are case insensitive, and changes of the underlying client are notified too
+	require.NoError(t, base.SetOverrides("testgetintpropertykey:\n- value: 2\n"))
+	require.Equal(t, 2, calls)
+	// other keys and unchanged values are not notified
+	require.NoError(t, client.SetOverrides("testGetIntPropertyKey:\n- value: 1\ntestGetBoolPropertyKey:\n- value: true\ntestGetStringPropertyKey:\n- value: a\n"))
+	require.Equal(t, 2, calls)
+
+	cancel()
+	require.NoError(t, client.SetOverrides(""))
+	require.NoError(t, base.SetOverrides(""))
+	require.Equal(t, 2, calls)
+}
@@ -0,0 +1,183 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package featureflag gates experimental server features per namespace.
+//
+// Every flag is backed by a namespace-filtered boolean dynamic config key, so
+// operators enable a feature for selected namespaces by adding a constrained
+// value for that key. Disabled features are reported to callers as
+// Unimplemented errors which name the namespace and the key to set.
+package featureflag
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+type (
+	// Flag describes an experimental feature which can be enabled per namespace.
+	Flag struct {
+		// Name is the stable identifier reported to clients.
+		Name string
+		// Description is a short human readable summary of the feature.
+		Description string
+		// Key is the dynamic config key controlling the feature.
+		Key dynamicconfig.Key
+		// Default is used when the key has no value for a namespace.
+		Default bool
+	}
+
+	// Gate evaluates feature flags against dynamic config.
+	Gate struct {
+		dc *dynamicconfig.Collection
+
+		sync.Mutex
+		lookups map[string]dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	}
+)
+
+var (
+	registryLock sync.RWMutex
+	registry     = make(map[string]*Flag)
+)
+
+var (
+	// UpdateWorkflowExecution gates the UpdateWorkflowExecution and PollWorkflowExecutionUpdate APIs.
+	UpdateWorkflowExecution = Register(Flag{
+		Name:        "UpdateWorkflowExecution",
+		Description: "Workflow update APIs",
+		Key:         dynamicconfig.FrontendEnableUpdateWorkflowExecution,
+	})
+	// UpdateWorkflowExecutionAsyncAccepted gates updates which only wait for the update to be accepted.
+	UpdateWorkflowExecutionAsyncAccepted = Register(Flag{
+		Name:        "UpdateWorkflowExecutionAsyncAccepted",
+		Description: "Workflow updates waiting for the accepted stage",
+		Key:         dynamicconfig.FrontendEnableUpdateWorkflowExecutionAsyncAccepted,
+	})
+	// WorkerVersioningData gates the worker build ID compatibility APIs.
+	WorkerVersioningData = Register(Flag{
+		Name:        "WorkerVersioningData",
+		Description: "Worker build ID compatibility APIs",
+		Key:         dynamicconfig.FrontendEnableWorkerVersioningDataAPIs,
+	})
+	// WorkerVersioningWorkflow gates the use of worker versioning by workflows.
+	WorkerVersioningWorkflow = Register(Flag{
+		Name:        "WorkerVersioningWorkflow",
+		Description: "Worker versioning in workflow progress APIs",
+		Key:         dynamicconfig.FrontendEnableWorkerVersioningWorkflowAPIs,
+	})
+	// EagerWorkflowStart gates returning the first workflow task inline with StartWorkflowExecution.
+	EagerWorkflowStart = Register(Flag{
+		Name:        "EagerWorkflowStart",
+		Description: "Eager workflow start",
+		Key:         dynamicconfig.EnableEagerWorkflowStart,
+	})
+	// EagerActivityExecution gates dispatching activity tasks inline with workflow task completion.
+	EagerActivityExecution = Register(Flag{
+		Name:        "EagerActivityExecution",
+		Description: "Eager activity execution",
+		Key:         dynamicconfig.EnableActivityEagerExecution,
+	})
+)
+
+// Register adds a flag to the registry and returns it. It panics if a flag
+// with the same name is already registered.
+func Register(flag Flag) *Flag {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	if _, ok := registry[flag.Name]; ok {
+		panic(fmt.Sprintf("feature flag %q is already registered", flag.Name))
+	}
+	f := flag
+	registry[f.Name] = &f
+	return &f
+}
+
+// Flags returns all registered flags sorted by name.
+func Flags() []*Flag {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	flags := make([]*Flag, 0, len(registry))
+	for _, f := range registry {
+		flags = append(flags, f)
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i].Name < flags[j].Name })
+	return flags
+}
+
+// NewGate creates a gate reading flag values from the given dynamic config.
+func NewGate(dc *dynamicconfig.Collection) *Gate {
+	return &Gate{
+		dc:      dc,
+		lookups: make(map[string]dynamicconfig.BoolPropertyFnWithNamespaceFilter),
+	}
+}
+
+// EnabledFn returns a dynamic config property function for the flag, for
+// components which hold per-feature config fields.
+func (g *Gate) EnabledFn(flag *Flag) dynamicconfig.BoolPropertyFnWithNamespaceFilter {
+	g.Lock()
+	defer g.Unlock()
+
+	fn, ok := g.lookups[flag.Name]
+	if !ok {
+		fn = g.dc.GetBoolPropertyFnWithNamespaceFilter(flag.Key, flag.Default)
+		g.lookups[flag.Name] = fn
+	}
+	return fn
+}
+
+// Enabled reports whether the flag is enabled for the namespace.
+func (g *Gate) Enabled(flag *Flag, namespace string) bool {
+	return g.EnabledFn(flag)(namespace)
+}
+
+// Check returns an Unimplemented error if the flag is disabled for the namespace.
+func (g *Gate) Check(flag *Flag, namespace string) error {
+	if g.Enabled(flag, namespace) {
+		return nil
+	}
+	return serviceerror.NewUnimplemented(fmt.Sprintf(
+		"%s is disabled on namespace %q. Set dynamic config %q to enable it.",
+		flag.Description, namespace, flag.Key,
+	))
+}
+
+// EnabledFlags returns the state of every registered flag for the namespace,
+// keyed by flag name.
+func (g *Gate) EnabledFlags(namespace string) map[string]bool {
+	flags := Flags()
+	result := make(map[string]bool, len(flags))
+	for _, f := range flags {
+		result[f.Name] = g.Enabled(f, namespace)
+	}
+	return result
+}
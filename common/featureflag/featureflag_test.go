@@ -0,0 +1,65 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package featureflag
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+)
+
+func TestGate(t *testing.T) {
+	client := dynamicconfig.StaticClient{
+		EagerWorkflowStart.Key: []dynamicconfig.ConstrainedValue{
+			{Constraints: dynamicconfig.Constraints{Namespace: "enabled"}, Value: true},
+		},
+	}
+	gate := NewGate(dynamicconfig.NewCollection(client, log.NewNoopLogger()))
+
+	require.True(t, gate.Enabled(EagerWorkflowStart, "enabled"))
+	require.False(t, gate.Enabled(EagerWorkflowStart, "disabled"))
+	require.NoError(t, gate.Check(EagerWorkflowStart, "enabled"))
+
+	err := gate.Check(EagerWorkflowStart, "disabled")
+	var unimplemented *serviceerror.Unimplemented
+	require.ErrorAs(t, err, &unimplemented)
+	require.Contains(t, err.Error(), `"disabled"`)
+	require.Contains(t, err.Error(), string(EagerWorkflowStart.Key))
+
+	flags := gate.EnabledFlags("enabled")
+	require.Len(t, flags, len(Flags()))
+	require.True(t, flags[EagerWorkflowStart.Name])
+	require.False(t, flags[UpdateWorkflowExecution.Name])
+}
+
+func TestRegisterDuplicate(t *testing.T) {
+	require.Panics(t, func() {
+		Register(Flag{Name: EagerWorkflowStart.Name})
+	})
+}
@@ -36,6 +36,21 @@ const (
 	SupportedServerVersionsHeaderName = "supported-server-versions"
 	SupportedFeaturesHeaderName       = "supported-features"
 	SupportedFeaturesHeaderDelim      = ","
+	SDKDeprecationWarningHeaderName   = "sdk-deprecation-warning"
+	// RetryAfterHeaderName is set by a draining server to the number of milliseconds clients should wait
+	// before retrying refused requests on another host.
+	RetryAfterHeaderName = "retry-after-ms"
+	// VisibilityConsistencyHeaderName set to VisibilityConsistencyStrong asks ListWorkflowExecutions for
+	// read-after-write consistency, if allowed by the server.
+	VisibilityConsistencyHeaderName = "visibility-consistency"
+	VisibilityConsistencyStrong     = "strong"
+	// DecodePayloadsHeaderName set to "true" asks the frontend to decode history payloads with the remote
+	// codec configured for the namespace.
+	DecodePayloadsHeaderName = "decode-payloads"
+	// LoadedTaskQueueOnlyHeaderName set to "true" asks matching to describe a task queue partition or return its
+	// user data only if it is loaded, instead of loading it. Matching returns NotFound for partitions which are not
+	// loaded.
+	LoadedTaskQueueOnlyHeaderName = "loaded-task-queue-only"
 
 	callerNameHeaderName = "caller-name"
 	callerTypeHeaderName = "caller-type"
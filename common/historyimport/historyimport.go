@@ -0,0 +1,245 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package historyimport creates workflow executions from the history of a run exported from another cluster, so
+// that workflows can be migrated into a cluster which isn't connected to their source cluster by replication.
+package historyimport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
+)
+
+type (
+	// Request is a part of the history of a run to import.
+	Request struct {
+		Namespace namespace.Name
+		Execution *commonpb.WorkflowExecution
+		// HistoryBatches are the serialized event batches of the run, in the order they were written in the
+		// source cluster. A history too large for a single request is imported with several requests, each
+		// one continuing where the previous one ended.
+		HistoryBatches []*commonpb.DataBlob
+		// VersionHistory is the version history of the run in the source cluster. It must contain the last
+		// imported event and may go past it.
+		VersionHistory *historyspb.VersionHistory
+	}
+
+	// Importer imports the history of runs through the history replication path, as if the runs were
+	// replicated from their source cluster.
+	Importer struct {
+		historyClient     historyservice.HistoryServiceClient
+		namespaceRegistry namespace.Registry
+		clusterMetadata   cluster.Metadata
+		serializer        serialization.Serializer
+	}
+)
+
+// NewImporter creates an Importer.
+func NewImporter(
+	historyClient historyservice.HistoryServiceClient,
+	namespaceRegistry namespace.Registry,
+	clusterMetadata cluster.Metadata,
+) *Importer {
+	return &Importer{
+		historyClient:     historyClient,
+		namespaceRegistry: namespaceRegistry,
+		clusterMetadata:   clusterMetadata,
+		serializer:        serialization.NewSerializer(),
+	}
+}
+
+// Import applies the history batches of the request to the run, creating it with its first batch. The run is
+// owned by the cluster its last event version maps to: a run which isn't closed is active if that is this
+// cluster, and otherwise stays in standby until its namespace is failed over to this cluster. The events of a
+// global namespace must therefore carry versions of clusters known to this cluster, and the events of a local
+// namespace the empty version; histories from other clusters need their versions rewritten before the import.
+// Batches the run already has are skipped, so a failed import can be retried with the same request.
+func (i *Importer) Import(ctx context.Context, request Request) error {
+	namespaceEntry, err := i.namespaceRegistry.GetNamespace(request.Namespace)
+	if err != nil {
+		return err
+	}
+	if len(request.VersionHistory.GetItems()) == 0 {
+		return serviceerror.NewInvalidArgument("version history is not set on request")
+	}
+	if len(request.HistoryBatches) == 0 {
+		return serviceerror.NewInvalidArgument("history batches are not set on request")
+	}
+	for _, item := range request.VersionHistory.Items {
+		if err := i.validateVersion(namespaceEntry, item.GetVersion()); err != nil {
+			return err
+		}
+	}
+
+	firstEventID, lastEventIDs, err := i.validateBatches(namespaceEntry, request)
+	if err != nil {
+		return err
+	}
+	lastImportedEventID, err := i.lastImportedEventID(ctx, namespaceEntry.ID(), request)
+	if err != nil {
+		return err
+	}
+	if firstEventID > lastImportedEventID+1 {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"history batches start at event %d but the run only has events up to %d", firstEventID, lastImportedEventID,
+		))
+	}
+
+	for index, batch := range request.HistoryBatches {
+		if lastEventIDs[index] <= lastImportedEventID {
+			continue
+		}
+		if _, err := i.historyClient.ReplicateEventsV2(ctx, &historyservice.ReplicateEventsV2Request{
+			NamespaceId:         namespaceEntry.ID().String(),
+			WorkflowExecution:   request.Execution,
+			VersionHistoryItems: request.VersionHistory.Items,
+			Events:              batch,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateBatches checks that the batches hold contiguous events of the version history, so that a request
+// which can't be fully applied is rejected before any of it is. It returns the ID of the first event and the
+// last event ID of every batch.
+func (i *Importer) validateBatches(namespaceEntry *namespace.Namespace, request Request) (int64, []int64, error) {
+	lastEventIDs := make([]int64, 0, len(request.HistoryBatches))
+	var firstEventID int64
+	var lastEvent *historypb.HistoryEvent
+	for index, batch := range request.HistoryBatches {
+		events, err := i.serializer.DeserializeEvents(batch)
+		if err != nil {
+			return 0, nil, serviceerror.NewInvalidArgument(fmt.Sprintf("unable to decode history batch %d: %v", index, err))
+		}
+		if len(events) == 0 {
+			return 0, nil, serviceerror.NewInvalidArgument(fmt.Sprintf("history batch %d is empty", index))
+		}
+		if err := i.validateVersion(namespaceEntry, events[0].GetVersion()); err != nil {
+			return 0, nil, err
+		}
+		if lastEvent == nil {
+			firstEventID = events[0].GetEventId()
+			if firstEventID == common.FirstEventID && events[0].GetEventType() != enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED {
+				return 0, nil, serviceerror.NewInvalidArgument("first event of the history is not a workflow execution started event")
+			}
+		}
+		for _, event := range events {
+			if lastEvent != nil && event.GetEventId() != lastEvent.GetEventId()+1 {
+				return 0, nil, serviceerror.NewInvalidArgument(fmt.Sprintf(
+					"history batch %d is not contiguous: event %d follows event %d", index, event.GetEventId(), lastEvent.GetEventId(),
+				))
+			}
+			if event.GetVersion() != events[0].GetVersion() {
+				return 0, nil, serviceerror.NewInvalidArgument(fmt.Sprintf("history batch %d has events of several versions", index))
+			}
+			lastEvent = event
+		}
+		lastEventIDs = append(lastEventIDs, lastEvent.GetEventId())
+	}
+
+	if !versionhistory.ContainsVersionHistoryItem(
+		request.VersionHistory,
+		versionhistory.NewVersionHistoryItem(lastEvent.GetEventId(), lastEvent.GetVersion()),
+	) {
+		return 0, nil, serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"event %d of version %d is not in the version history", lastEvent.GetEventId(), lastEvent.GetVersion(),
+		))
+	}
+	return firstEventID, lastEventIDs, nil
+}
+
+// validateVersion checks that history replication can attribute version to a cluster: a local namespace only
+// has events of the empty version, and the versions of a global namespace belong to clusters of the cluster
+// metadata.
+func (i *Importer) validateVersion(namespaceEntry *namespace.Namespace, version int64) error {
+	if !namespaceEntry.IsGlobalNamespace() {
+		if version != common.EmptyVersion {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf(
+				"version %d is not the empty version of local namespace %s", version, namespaceEntry.Name(),
+			))
+		}
+		return nil
+	}
+	if version != common.EmptyVersion {
+		for _, clusterInfo := range i.clusterMetadata.GetAllClusterInfo() {
+			if i.clusterMetadata.IsVersionFromSameCluster(version, clusterInfo.InitialFailoverVersion) {
+				return nil
+			}
+		}
+	}
+	return serviceerror.NewInvalidArgument(fmt.Sprintf("version %d doesn't belong to a known cluster", version))
+}
+
+// lastImportedEventID returns the ID of the last event the run has in this cluster, or 0 if the run doesn't
+// exist yet. It fails if the run has events which aren't in the version history of the request.
+func (i *Importer) lastImportedEventID(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	request Request,
+) (int64, error) {
+	resp, err := i.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+	})
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return common.EmptyEventID, nil
+		}
+		return 0, err
+	}
+
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(
+		resp.GetDatabaseMutableState().GetExecutionInfo().GetVersionHistories(),
+	)
+	if err != nil {
+		return 0, err
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(currentVersionHistory)
+	if err != nil {
+		return 0, err
+	}
+	if !versionhistory.ContainsVersionHistoryItem(request.VersionHistory, lastItem) {
+		return 0, serviceerror.NewFailedPrecondition(fmt.Sprintf(
+			"workflow execution %s already exists with a different history", request.Execution.GetRunId(),
+		))
+	}
+	return lastItem.GetEventId(), nil
+}
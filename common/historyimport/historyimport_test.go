@@ -0,0 +1,274 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package historyimport
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
+)
+
+var execution = &commonpb.WorkflowExecution{
+	WorkflowId: "wid",
+	RunId:      "2c8fe36b-d4ef-4ee4-9b67-d8b4ab2d8a42",
+}
+
+var globalNamespace = namespace.NewGlobalNamespaceForTest(
+	&persistencespb.NamespaceInfo{Id: "ns-id", Name: "ns"}, nil, nil, cluster.TestCurrentClusterInitialFailoverVersion,
+)
+
+func newTestImporter(t *testing.T) (*Importer, *historyservicemock.MockHistoryServiceClient) {
+	return newTestImporterWithNamespace(t, globalNamespace)
+}
+
+func newTestImporterWithNamespace(
+	t *testing.T,
+	namespaceEntry *namespace.Namespace,
+) (*Importer, *historyservicemock.MockHistoryServiceClient) {
+	ctrl := gomock.NewController(t)
+	historyClient := historyservicemock.NewMockHistoryServiceClient(ctrl)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	namespaceRegistry.EXPECT().GetNamespace(namespace.Name("ns")).Return(namespaceEntry, nil)
+	clusterMetadata := cluster.NewMetadataForTest(cluster.NewTestClusterMetadataConfig(true, true))
+	return NewImporter(historyClient, namespaceRegistry, clusterMetadata), historyClient
+}
+
+func newBatch(t *testing.T, version int64, firstEventID int64, lastEventID int64) *commonpb.DataBlob {
+	var events []*historypb.HistoryEvent
+	for eventID := firstEventID; eventID <= lastEventID; eventID++ {
+		eventType := enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED
+		if eventID == 1 {
+			eventType = enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED
+		}
+		events = append(events, &historypb.HistoryEvent{EventId: eventID, Version: version, EventType: eventType})
+	}
+	blob, err := serialization.NewSerializer().SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+	require.NoError(t, err)
+	return blob
+}
+
+func newVersionHistory(items ...*historyspb.VersionHistoryItem) *historyspb.VersionHistory {
+	return versionhistory.NewVersionHistory(nil, items)
+}
+
+func TestImport_NewRun(t *testing.T) {
+	importer, historyClient := newTestImporter(t)
+	historyClient.EXPECT().DescribeMutableState(gomock.Any(), &historyservice.DescribeMutableStateRequest{
+		NamespaceId: "ns-id",
+		Execution:   execution,
+	}).Return(nil, serviceerror.NewNotFound("workflow execution not found"))
+
+	request := Request{
+		Namespace:      "ns",
+		Execution:      execution,
+		HistoryBatches: []*commonpb.DataBlob{newBatch(t, 1, 1, 2), newBatch(t, 1, 3, 4), newBatch(t, 11, 5, 5)},
+		VersionHistory: newVersionHistory(
+			versionhistory.NewVersionHistoryItem(4, 1),
+			versionhistory.NewVersionHistoryItem(5, 11),
+		),
+	}
+	var sent []*commonpb.DataBlob
+	historyClient.EXPECT().ReplicateEventsV2(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(
+			_ context.Context,
+			request *historyservice.ReplicateEventsV2Request,
+			_ ...grpc.CallOption,
+		) (*historyservice.ReplicateEventsV2Response, error) {
+			require.Equal(t, "ns-id", request.GetNamespaceId())
+			require.Equal(t, execution, request.GetWorkflowExecution())
+			require.Len(t, request.GetVersionHistoryItems(), 2)
+			sent = append(sent, request.GetEvents())
+			return &historyservice.ReplicateEventsV2Response{}, nil
+		}).Times(3)
+
+	require.NoError(t, importer.Import(context.Background(), request))
+	require.Equal(t, request.HistoryBatches, sent)
+}
+
+func TestImport_SkipsImportedBatches(t *testing.T) {
+	importer, historyClient := newTestImporter(t)
+	historyClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(&historyservice.DescribeMutableStateResponse{
+		DatabaseMutableState: &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				VersionHistories: versionhistory.NewVersionHistories(newVersionHistory(versionhistory.NewVersionHistoryItem(2, 1))),
+			},
+		},
+	}, nil)
+
+	batch := newBatch(t, 1, 3, 4)
+	historyClient.EXPECT().ReplicateEventsV2(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(
+			_ context.Context,
+			request *historyservice.ReplicateEventsV2Request,
+			_ ...grpc.CallOption,
+		) (*historyservice.ReplicateEventsV2Response, error) {
+			require.Equal(t, batch, request.GetEvents())
+			return &historyservice.ReplicateEventsV2Response{}, nil
+		})
+
+	require.NoError(t, importer.Import(context.Background(), Request{
+		Namespace:      "ns",
+		Execution:      execution,
+		HistoryBatches: []*commonpb.DataBlob{newBatch(t, 1, 1, 2), batch},
+		VersionHistory: newVersionHistory(versionhistory.NewVersionHistoryItem(6, 1)),
+	}))
+}
+
+func TestImport_DifferentHistory(t *testing.T) {
+	importer, historyClient := newTestImporter(t)
+	historyClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(&historyservice.DescribeMutableStateResponse{
+		DatabaseMutableState: &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				VersionHistories: versionhistory.NewVersionHistories(newVersionHistory(versionhistory.NewVersionHistoryItem(2, 21))),
+			},
+		},
+	}, nil)
+
+	err := importer.Import(context.Background(), Request{
+		Namespace:      "ns",
+		Execution:      execution,
+		HistoryBatches: []*commonpb.DataBlob{newBatch(t, 1, 1, 2)},
+		VersionHistory: newVersionHistory(versionhistory.NewVersionHistoryItem(2, 1)),
+	})
+	var failedPrecondition *serviceerror.FailedPrecondition
+	require.ErrorAs(t, err, &failedPrecondition)
+}
+
+func TestImport_MissingEvents(t *testing.T) {
+	importer, historyClient := newTestImporter(t)
+	historyClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("workflow execution not found"))
+
+	err := importer.Import(context.Background(), Request{
+		Namespace:      "ns",
+		Execution:      execution,
+		HistoryBatches: []*commonpb.DataBlob{newBatch(t, 1, 3, 4)},
+		VersionHistory: newVersionHistory(versionhistory.NewVersionHistoryItem(4, 1)),
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	require.ErrorAs(t, err, &invalidArgument)
+}
+
+func TestImport_InvalidBatches(t *testing.T) {
+	testCases := []struct {
+		name           string
+		batches        []*commonpb.DataBlob
+		versionHistory *historyspb.VersionHistory
+	}{
+		{
+			name:           "not contiguous",
+			batches:        []*commonpb.DataBlob{newBatch(t, 1, 1, 2), newBatch(t, 1, 4, 5)},
+			versionHistory: newVersionHistory(versionhistory.NewVersionHistoryItem(5, 1)),
+		},
+		{
+			name:           "event not in version history",
+			batches:        []*commonpb.DataBlob{newBatch(t, 1, 1, 2), newBatch(t, 11, 3, 3)},
+			versionHistory: newVersionHistory(versionhistory.NewVersionHistoryItem(3, 1)),
+		},
+		{
+			name:           "version of unknown cluster",
+			batches:        []*commonpb.DataBlob{newBatch(t, 5, 1, 2)},
+			versionHistory: newVersionHistory(versionhistory.NewVersionHistoryItem(2, 5)),
+		},
+		{
+			name:           "version history item of unknown cluster",
+			batches:        []*commonpb.DataBlob{newBatch(t, 1, 1, 2)},
+			versionHistory: newVersionHistory(versionhistory.NewVersionHistoryItem(2, 1), versionhistory.NewVersionHistoryItem(3, 5)),
+		},
+		{
+			name:           "empty version in global namespace",
+			batches:        []*commonpb.DataBlob{newBatch(t, 0, 1, 2)},
+			versionHistory: newVersionHistory(versionhistory.NewVersionHistoryItem(2, 0)),
+		},
+		{
+			name:           "no version history",
+			batches:        []*commonpb.DataBlob{newBatch(t, 1, 1, 2)},
+			versionHistory: nil,
+		},
+		{
+			name:           "no batches",
+			versionHistory: newVersionHistory(versionhistory.NewVersionHistoryItem(2, 1)),
+		},
+		{
+			name:           "empty batch",
+			batches:        []*commonpb.DataBlob{newBatch(t, 1, 1, 0)},
+			versionHistory: newVersionHistory(versionhistory.NewVersionHistoryItem(2, 1)),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			importer, _ := newTestImporter(t)
+			err := importer.Import(context.Background(), Request{
+				Namespace:      "ns",
+				Execution:      execution,
+				HistoryBatches: tc.batches,
+				VersionHistory: tc.versionHistory,
+			})
+			var invalidArgument *serviceerror.InvalidArgument
+			require.ErrorAs(t, err, &invalidArgument)
+		})
+	}
+}
+
+func TestImport_LocalNamespace(t *testing.T) {
+	localNamespace := namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: "ns-id", Name: "ns"}, nil, cluster.TestCurrentClusterName,
+	)
+
+	importer, _ := newTestImporterWithNamespace(t, localNamespace)
+	err := importer.Import(context.Background(), Request{
+		Namespace:      "ns",
+		Execution:      execution,
+		HistoryBatches: []*commonpb.DataBlob{newBatch(t, 1, 1, 2)},
+		VersionHistory: newVersionHistory(versionhistory.NewVersionHistoryItem(2, 1)),
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	require.ErrorAs(t, err, &invalidArgument)
+
+	importer, historyClient := newTestImporterWithNamespace(t, localNamespace)
+	historyClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("workflow execution not found"))
+	historyClient.EXPECT().ReplicateEventsV2(gomock.Any(), gomock.Any()).Return(&historyservice.ReplicateEventsV2Response{}, nil)
+	require.NoError(t, importer.Import(context.Background(), Request{
+		Namespace:      "ns",
+		Execution:      execution,
+		HistoryBatches: []*commonpb.DataBlob{newBatch(t, 0, 1, 2)},
+		VersionHistory: newVersionHistory(versionhistory.NewVersionHistoryItem(2, 0)),
+	}))
+}
@@ -0,0 +1,312 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package leaderelection
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/util"
+)
+
+const (
+	// leaseNamePrefix is prepended to the election name to build the name of the backing task queue record
+	leaseNamePrefix = "temporal-sys-leader-election-"
+
+	initialFencingToken = int64(1)
+)
+
+type (
+	// Config controls the lease timing of an Elector
+	Config struct {
+		// LeaseDuration is how long a lease stays valid after the last successful renewal
+		LeaseDuration dynamicconfig.DurationPropertyFn
+		// RenewInterval is how often the leader renews its lease and followers retry acquiring it.
+		// It must be well below LeaseDuration.
+		RenewInterval dynamicconfig.DurationPropertyFn
+	}
+
+	// Elector campaigns for a named lease stored in persistence. The lease is kept in a task queue record
+	// owned by the system namespace, so it works on every persistence backend without schema changes.
+	// Every change of leader increments the record's range ID, which is handed to the leader as its
+	// fencing token.
+	Elector struct {
+		name        string
+		config      *Config
+		taskManager persistence.TaskManager
+		timeSource  clock.TimeSource
+		logger      log.Logger
+
+		sync.Mutex
+		fencingToken int64
+		// leaseExpiry is this host's view of when the current lease ends. It is kept shorter than the
+		// persisted expiry so a partitioned leader steps down before anyone else can take over.
+		leaseExpiry time.Time
+	}
+)
+
+func NewElector(
+	name string,
+	config *Config,
+	taskManager persistence.TaskManager,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) *Elector {
+	return &Elector{
+		name:        name,
+		config:      config,
+		taskManager: taskManager,
+		timeSource:  timeSource,
+		logger:      log.With(logger, tag.NewStringTag("leader-election", name)),
+	}
+}
+
+// Run campaigns for leadership until ctx is canceled. Every time leadership is acquired, onElected is called
+// with a context that is canceled as soon as leadership is lost, together with the fencing token of the term.
+// onElected must return once its context is done; Run does not campaign again until it has. If onElected
+// returns before that, the term ends early and the lease is released, whether or not it returned an error.
+func (e *Elector) Run(
+	ctx context.Context,
+	onElected func(ctx context.Context, fencingToken int64) error,
+) {
+	for {
+		if fencingToken, elected := e.campaign(ctx); elected {
+			e.logger.Info("Acquired leadership", tag.NewInt64("fencing-token", fencingToken))
+
+			termCtx, termCancel := context.WithCancel(ctx)
+			termDone := make(chan struct{})
+			go func() {
+				defer close(termDone)
+				// stop holding the lease as soon as the leader gives up its term
+				defer termCancel()
+				if err := onElected(termCtx, fencingToken); err != nil {
+					e.logger.Error("Leader failed, releasing leadership", tag.NewInt64("fencing-token", fencingToken), tag.Error(err))
+				}
+			}()
+
+			e.holdLease(termCtx)
+			termCancel()
+			<-termDone
+
+			e.resign()
+			e.logger.Info("Lost leadership", tag.NewInt64("fencing-token", fencingToken))
+		}
+
+		timer := time.NewTimer(backoff.Jitter(e.config.RenewInterval(), 0.2))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// FencingToken returns the fencing token of the current term and whether this host still holds a valid lease.
+// Callers about to perform work that must not overlap with another leader should check it first.
+func (e *Elector) FencingToken() (int64, bool) {
+	e.Lock()
+	defer e.Unlock()
+
+	if e.fencingToken == 0 || !e.timeSource.Now().Before(e.leaseExpiry) {
+		return 0, false
+	}
+	return e.fencingToken, true
+}
+
+// campaign tries to acquire the lease once, either by creating the record or by taking over an expired lease
+func (e *Elector) campaign(
+	ctx context.Context,
+) (int64, bool) {
+	ctx, cancel := context.WithTimeout(ctx, e.config.RenewInterval())
+	defer cancel()
+
+	now := e.timeSource.Now()
+	response, err := e.taskManager.GetTaskQueue(ctx, &persistence.GetTaskQueueRequest{
+		NamespaceID: primitives.SystemNamespaceID,
+		TaskQueue:   e.leaseName(),
+		TaskType:    enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	})
+
+	var fencingToken int64
+	switch err.(type) {
+	case nil:
+		if now.Before(timestamp.TimeValue(response.TaskQueueInfo.ExpiryTime)) {
+			// someone else holds a valid lease
+			return 0, false
+		}
+		fencingToken = response.RangeID + 1
+		_, err = e.taskManager.UpdateTaskQueue(ctx, &persistence.UpdateTaskQueueRequest{
+			RangeID:       fencingToken,
+			TaskQueueInfo: e.leaseInfo(now),
+			PrevRangeID:   response.RangeID,
+		})
+
+	case *serviceerror.NotFound:
+		fencingToken = initialFencingToken
+		_, err = e.taskManager.CreateTaskQueue(ctx, &persistence.CreateTaskQueueRequest{
+			RangeID:       fencingToken,
+			TaskQueueInfo: e.leaseInfo(now),
+		})
+	}
+	if err != nil {
+		if _, ok := err.(*persistence.ConditionFailedError); !ok {
+			e.logger.Warn("Unable to acquire leadership", tag.Error(err))
+		}
+		return 0, false
+	}
+
+	e.setLease(fencingToken, now)
+	return fencingToken, true
+}
+
+// holdLease renews the lease until a renewal is rejected, the local lease runs out or ctx is canceled
+func (e *Elector) holdLease(
+	ctx context.Context,
+) {
+	for {
+		fencingToken, ok := e.FencingToken()
+		if !ok {
+			return
+		}
+
+		// wake up at the local lease expiry at the latest, so that the lease can't run out unnoticed
+		// while waiting for the next renewal, e.g. if RenewInterval is configured above LeaseDuration
+		renewInterval := e.config.RenewInterval()
+		leaseRemaining := e.localLeaseRemaining()
+		timer := time.NewTimer(util.Min(renewInterval, leaseRemaining))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		if leaseRemaining < renewInterval {
+			// woke up to check the lease expiry rather than to renew
+			continue
+		}
+
+		if err := e.renew(ctx, fencingToken); err != nil {
+			if _, ok := err.(*persistence.ConditionFailedError); ok {
+				e.logger.Warn("Lease was taken over by another host", tag.Error(err))
+				return
+			}
+			// transient errors are retried until the local lease runs out
+			e.logger.Warn("Unable to renew lease", tag.Error(err))
+		}
+	}
+}
+
+func (e *Elector) renew(
+	ctx context.Context,
+	fencingToken int64,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, e.localLeaseRemaining())
+	defer cancel()
+
+	now := e.timeSource.Now()
+	if _, err := e.taskManager.UpdateTaskQueue(ctx, &persistence.UpdateTaskQueueRequest{
+		RangeID:       fencingToken,
+		TaskQueueInfo: e.leaseInfo(now),
+		PrevRangeID:   fencingToken,
+	}); err != nil {
+		return err
+	}
+
+	e.setLease(fencingToken, now)
+	return nil
+}
+
+// resign gives up the lease so another host can take over without waiting for it to expire
+func (e *Elector) resign() {
+	e.Lock()
+	fencingToken := e.fencingToken
+	e.fencingToken = 0
+	e.leaseExpiry = time.Time{}
+	e.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), e.config.RenewInterval())
+	defer cancel()
+
+	info := e.leaseInfo(e.timeSource.Now())
+	info.ExpiryTime = info.LastUpdateTime
+	// best effort, a lease that cannot be released simply expires
+	_, _ = e.taskManager.UpdateTaskQueue(ctx, &persistence.UpdateTaskQueueRequest{
+		RangeID:       fencingToken,
+		TaskQueueInfo: info,
+		PrevRangeID:   fencingToken,
+	})
+}
+
+// localLeaseRemaining returns how long this host's view of the lease stays valid
+func (e *Elector) localLeaseRemaining() time.Duration {
+	e.Lock()
+	defer e.Unlock()
+
+	return e.leaseExpiry.Sub(e.timeSource.Now())
+}
+
+func (e *Elector) setLease(
+	fencingToken int64,
+	renewedAt time.Time,
+) {
+	e.Lock()
+	defer e.Unlock()
+
+	leaseDuration := e.config.LeaseDuration()
+	e.fencingToken = fencingToken
+	// step down after three quarters of the lease to leave room for clock skew between hosts
+	e.leaseExpiry = renewedAt.Add(leaseDuration - leaseDuration/4)
+}
+
+func (e *Elector) leaseInfo(
+	now time.Time,
+) *persistencespb.TaskQueueInfo {
+	return &persistencespb.TaskQueueInfo{
+		NamespaceId:    primitives.SystemNamespaceID,
+		Name:           e.leaseName(),
+		TaskType:       enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		Kind:           enumspb.TASK_QUEUE_KIND_NORMAL,
+		ExpiryTime:     timestamp.TimePtr(now.Add(e.config.LeaseDuration())),
+		LastUpdateTime: timestamp.TimePtr(now),
+	}
+}
+
+func (e *Elector) leaseName() string {
+	return leaseNamePrefix + e.name
+}
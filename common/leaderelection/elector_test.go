@@ -0,0 +1,268 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package leaderelection
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	electorSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller      *gomock.Controller
+		mockTaskManager *persistence.MockTaskManager
+		timeSource      *clock.EventTimeSource
+
+		elector *Elector
+	}
+)
+
+const (
+	testLeaseDuration = time.Minute
+	testRenewInterval = 10 * time.Millisecond
+)
+
+func TestElectorSuite(t *testing.T) {
+	suite.Run(t, new(electorSuite))
+}
+
+func (s *electorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockTaskManager = persistence.NewMockTaskManager(s.controller)
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now().UTC())
+
+	s.elector = NewElector(
+		"test",
+		&Config{
+			LeaseDuration: dynamicconfig.GetDurationPropertyFn(testLeaseDuration),
+			RenewInterval: dynamicconfig.GetDurationPropertyFn(testRenewInterval),
+		},
+		s.mockTaskManager,
+		s.timeSource,
+		log.NewNoopLogger(),
+	)
+}
+
+func (s *electorSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *electorSuite) TestCampaign_CreatesLease() {
+	s.mockTaskManager.EXPECT().GetTaskQueue(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound(""))
+	s.mockTaskManager.EXPECT().CreateTaskQueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.CreateTaskQueueRequest) (*persistence.CreateTaskQueueResponse, error) {
+			s.Equal(initialFencingToken, request.RangeID)
+			s.Equal(leaseNamePrefix+"test", request.TaskQueueInfo.Name)
+			s.Equal(s.timeSource.Now().Add(testLeaseDuration), timestamp.TimeValue(request.TaskQueueInfo.ExpiryTime))
+			return &persistence.CreateTaskQueueResponse{}, nil
+		},
+	)
+
+	fencingToken, elected := s.elector.campaign(context.Background())
+	s.True(elected)
+	s.Equal(initialFencingToken, fencingToken)
+
+	fencingToken, ok := s.elector.FencingToken()
+	s.True(ok)
+	s.Equal(initialFencingToken, fencingToken)
+}
+
+func (s *electorSuite) TestCampaign_LeaseHeld() {
+	s.mockTaskManager.EXPECT().GetTaskQueue(gomock.Any(), gomock.Any()).Return(s.leaseResponse(5, time.Second), nil)
+
+	_, elected := s.elector.campaign(context.Background())
+	s.False(elected)
+
+	_, ok := s.elector.FencingToken()
+	s.False(ok)
+}
+
+func (s *electorSuite) TestCampaign_TakesOverExpiredLease() {
+	s.mockTaskManager.EXPECT().GetTaskQueue(gomock.Any(), gomock.Any()).Return(s.leaseResponse(5, -time.Second), nil)
+	s.mockTaskManager.EXPECT().UpdateTaskQueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateTaskQueueRequest) (*persistence.UpdateTaskQueueResponse, error) {
+			s.Equal(int64(5), request.PrevRangeID)
+			s.Equal(int64(6), request.RangeID)
+			return &persistence.UpdateTaskQueueResponse{}, nil
+		},
+	)
+
+	fencingToken, elected := s.elector.campaign(context.Background())
+	s.True(elected)
+	s.Equal(int64(6), fencingToken)
+}
+
+func (s *electorSuite) TestCampaign_LostRace() {
+	s.mockTaskManager.EXPECT().GetTaskQueue(gomock.Any(), gomock.Any()).Return(s.leaseResponse(5, -time.Second), nil)
+	s.mockTaskManager.EXPECT().UpdateTaskQueue(gomock.Any(), gomock.Any()).Return(nil, &persistence.ConditionFailedError{})
+
+	_, elected := s.elector.campaign(context.Background())
+	s.False(elected)
+}
+
+func (s *electorSuite) TestFencingToken_ExpiresBeforePersistedLease() {
+	s.elector.setLease(3, s.timeSource.Now())
+
+	s.timeSource.Update(s.timeSource.Now().Add(testLeaseDuration / 2))
+	_, ok := s.elector.FencingToken()
+	s.True(ok)
+
+	// the local lease ends before the persisted one so another host can never overlap with this one
+	s.timeSource.Update(s.timeSource.Now().Add(testLeaseDuration / 4))
+	_, ok = s.elector.FencingToken()
+	s.False(ok)
+}
+
+func (s *electorSuite) TestRun_StepsDownWhenLeaseIsTakenOver() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	gomock.InOrder(
+		s.mockTaskManager.EXPECT().GetTaskQueue(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("")),
+		s.mockTaskManager.EXPECT().GetTaskQueue(gomock.Any(), gomock.Any()).Return(s.leaseResponse(2, time.Second), nil).AnyTimes(),
+	)
+	s.mockTaskManager.EXPECT().CreateTaskQueue(gomock.Any(), gomock.Any()).Return(&persistence.CreateTaskQueueResponse{}, nil)
+	// both the renewal and the release on step down are rejected
+	s.mockTaskManager.EXPECT().UpdateTaskQueue(gomock.Any(), gomock.Any()).Return(nil, &persistence.ConditionFailedError{}).Times(2)
+
+	terms := 0
+	runDone := make(chan struct{})
+	go func() {
+		defer close(runDone)
+		s.elector.Run(ctx, func(termCtx context.Context, fencingToken int64) error {
+			terms++
+			s.Equal(initialFencingToken, fencingToken)
+			<-termCtx.Done()
+			cancel()
+			return nil
+		})
+	}()
+
+	select {
+	case <-runDone:
+	case <-time.After(10 * time.Second):
+		s.Fail("elector did not step down")
+	}
+	s.Equal(1, terms)
+	_, ok := s.elector.FencingToken()
+	s.False(ok)
+}
+
+func (s *electorSuite) TestRun_ReleasesLeaseWhenLeaderFails() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.mockTaskManager.EXPECT().GetTaskQueue(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound(""))
+	s.mockTaskManager.EXPECT().CreateTaskQueue(gomock.Any(), gomock.Any()).Return(&persistence.CreateTaskQueueResponse{}, nil)
+	var releases int32
+	s.mockTaskManager.EXPECT().UpdateTaskQueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateTaskQueueRequest) (*persistence.UpdateTaskQueueResponse, error) {
+			if timestamp.TimeValue(request.TaskQueueInfo.LastUpdateTime).Equal(timestamp.TimeValue(request.TaskQueueInfo.ExpiryTime)) {
+				atomic.AddInt32(&releases, 1)
+				cancel()
+			}
+			return &persistence.UpdateTaskQueueResponse{}, nil
+		},
+	).AnyTimes()
+
+	runDone := make(chan struct{})
+	go func() {
+		defer close(runDone)
+		s.elector.Run(ctx, func(termCtx context.Context, fencingToken int64) error {
+			return errors.New("unable to start")
+		})
+	}()
+
+	select {
+	case <-runDone:
+	case <-time.After(10 * time.Second):
+		s.Fail("elector did not release the lease")
+	}
+	s.Equal(int32(1), atomic.LoadInt32(&releases))
+	_, ok := s.elector.FencingToken()
+	s.False(ok)
+}
+
+func (s *electorSuite) TestHoldLease_StepsDownWhenRenewIntervalExceedsLease() {
+	elector := NewElector(
+		"test",
+		&Config{
+			LeaseDuration: dynamicconfig.GetDurationPropertyFn(testLeaseDuration),
+			RenewInterval: dynamicconfig.GetDurationPropertyFn(time.Hour),
+		},
+		s.mockTaskManager,
+		clock.NewRealTimeSource(),
+		log.NewNoopLogger(),
+	)
+	// the local lease runs out shortly, long before the next renewal would happen
+	localLease := testLeaseDuration - testLeaseDuration/4
+	elector.setLease(3, time.Now().Add(-localLease+50*time.Millisecond))
+
+	holdDone := make(chan struct{})
+	go func() {
+		defer close(holdDone)
+		elector.holdLease(context.Background())
+	}()
+
+	select {
+	case <-holdDone:
+	case <-time.After(10 * time.Second):
+		s.Fail("elector did not notice the lease ran out")
+	}
+	_, ok := elector.FencingToken()
+	s.False(ok)
+}
+
+func (s *electorSuite) leaseResponse(
+	rangeID int64,
+	expiresIn time.Duration,
+) *persistence.GetTaskQueueResponse {
+	return &persistence.GetTaskQueueResponse{
+		RangeID: rangeID,
+		TaskQueueInfo: &persistencespb.TaskQueueInfo{
+			ExpiryTime: timestamp.TimePtr(s.timeSource.Now().Add(expiresIn)),
+		},
+	}
+}
@@ -0,0 +1,127 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package log
+
+import (
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	extraSkipForNamespaceDebugLogger = 1
+
+	namespaceTagKey      = "wf-namespace"
+	namespaceDebugTagKey = "namespace-debug"
+)
+
+type namespaceDebugLogger struct {
+	logger       Logger
+	debugEnabled func(namespace string) bool
+	// namespace is the namespace tag of the logger, if any
+	namespace string
+}
+
+var _ Logger = (*namespaceDebugLogger)(nil)
+var _ WithLogger = (*namespaceDebugLogger)(nil)
+var _ SkipLogger = (*namespaceDebugLogger)(nil)
+
+// NewNamespaceDebugLogger returns a logger which emits the debug messages of the namespaces debugEnabled
+// returns true for, regardless of the configured level. Those messages are logged at info level with the
+// namespace-debug tag. The namespace of a message is the name set by tag.WorkflowNamespace, either on the
+// message or on the logger.
+func NewNamespaceDebugLogger(logger Logger, debugEnabled func(namespace string) bool) Logger {
+	if sl, ok := logger.(SkipLogger); ok {
+		logger = sl.Skip(extraSkipForNamespaceDebugLogger)
+	}
+	return &namespaceDebugLogger{
+		logger:       logger,
+		debugEnabled: debugEnabled,
+	}
+}
+
+func (l *namespaceDebugLogger) Debug(msg string, tags ...tag.Tag) {
+	namespace := namespaceFromTags(tags, l.namespace)
+	if namespace == "" || !l.debugEnabled(namespace) {
+		l.logger.Debug(msg, tags...)
+		return
+	}
+	debugTags := make([]tag.Tag, len(tags), len(tags)+1)
+	copy(debugTags, tags)
+	l.logger.Info(msg, append(debugTags, tag.NewBoolTag(namespaceDebugTagKey, true))...)
+}
+
+func (l *namespaceDebugLogger) Info(msg string, tags ...tag.Tag) {
+	l.logger.Info(msg, tags...)
+}
+
+func (l *namespaceDebugLogger) Warn(msg string, tags ...tag.Tag) {
+	l.logger.Warn(msg, tags...)
+}
+
+func (l *namespaceDebugLogger) Error(msg string, tags ...tag.Tag) {
+	l.logger.Error(msg, tags...)
+}
+
+func (l *namespaceDebugLogger) DPanic(msg string, tags ...tag.Tag) {
+	l.logger.DPanic(msg, tags...)
+}
+
+func (l *namespaceDebugLogger) Panic(msg string, tags ...tag.Tag) {
+	l.logger.Panic(msg, tags...)
+}
+
+func (l *namespaceDebugLogger) Fatal(msg string, tags ...tag.Tag) {
+	l.logger.Fatal(msg, tags...)
+}
+
+func (l *namespaceDebugLogger) With(tags ...tag.Tag) Logger {
+	return &namespaceDebugLogger{
+		logger:       With(l.logger, tags...),
+		debugEnabled: l.debugEnabled,
+		namespace:    namespaceFromTags(tags, l.namespace),
+	}
+}
+
+func (l *namespaceDebugLogger) Skip(extraSkip int) Logger {
+	logger := l.logger
+	if sl, ok := logger.(SkipLogger); ok {
+		logger = sl.Skip(extraSkip)
+	}
+	return &namespaceDebugLogger{
+		logger:       logger,
+		debugEnabled: l.debugEnabled,
+		namespace:    l.namespace,
+	}
+}
+
+// namespaceFromTags returns the value of the last namespace tag, or defaultNamespace if there is none.
+func namespaceFromTags(tags []tag.Tag, defaultNamespace string) string {
+	namespace := defaultNamespace
+	for _, t := range tags {
+		if zt, ok := t.(tag.ZapTag); ok && zt.Key() == namespaceTagKey {
+			namespace = zt.Field().String
+		}
+	}
+	return namespace
+}
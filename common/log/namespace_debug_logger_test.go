@@ -0,0 +1,62 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package log
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"go.temporal.io/server/common/log/tag"
+)
+
+func TestNamespaceDebugLogger(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	logger := NewNamespaceDebugLogger(NewZapLogger(zap.New(core)), func(namespace string) bool {
+		return namespace == "debug"
+	})
+
+	logger.Debug("no namespace")
+	logger.Debug("other namespace", tag.WorkflowNamespace("other"))
+	logger.Debug("debug namespace", tag.WorkflowNamespace("debug"))
+	With(logger, tag.WorkflowNamespace("debug")).Debug("debug logger")
+	With(logger, tag.WorkflowNamespace("debug")).Debug("other message", tag.WorkflowNamespace("other"))
+	With(logger, tag.WorkflowNamespace("other")).Info("info")
+
+	entries := logs.AllUntimed()
+	require.Len(t, entries, 3)
+	require.Equal(t, "debug namespace", entries[0].Message)
+	require.Equal(t, zap.InfoLevel, entries[0].Level)
+	require.Equal(t, true, entries[0].ContextMap()[namespaceDebugTagKey])
+	require.True(t, strings.HasPrefix(entries[0].ContextMap()[tag.LoggingCallAtKey].(string), "namespace_debug_logger_test.go:"))
+	require.Equal(t, "debug logger", entries[1].Message)
+	require.Equal(t, "debug", entries[1].ContextMap()["wf-namespace"])
+	require.True(t, strings.HasPrefix(entries[1].ContextMap()[tag.LoggingCallAtKey].(string), "namespace_debug_logger_test.go:"))
+	require.Equal(t, "info", entries[2].Message)
+	require.NotContains(t, entries[2].ContextMap(), namespaceDebugTagKey)
+}
@@ -137,6 +137,7 @@ var (
 	ComponentServiceResolver          = component("service-resolver")
 	ComponentMetadataInitializer      = component("metadata-initializer")
 	ComponentAddSearchAttributes      = component("add-search-attributes")
+	ComponentDrain                    = component("drain")
 	VersionChecker                    = component("version-checker")
 )
 
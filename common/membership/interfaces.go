@@ -63,6 +63,10 @@ type (
 		// called, other members will discover that this node is no longer part of the
 		// ring. This primitive is useful to carry out graceful host shutdown during deployments.
 		EvictSelf() error
+		// Join makes this host a member of the ring of its service, for services whose hosts wait until
+		// they are ready to serve before they join. It does nothing for the hosts of other services,
+		// which join when the monitor starts, and when this host already joined.
+		Join() error
 		GetResolver(service primitives.ServiceName) (ServiceResolver, error)
 		// GetReachableMembers returns addresses of all members of the ring
 		GetReachableMembers() ([]string, error)
@@ -103,6 +103,20 @@ func (mr *MockMonitorMockRecorder) GetResolver(service interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResolver", reflect.TypeOf((*MockMonitor)(nil).GetResolver), service)
 }
 
+// Join mocks base method.
+func (m *MockMonitor) Join() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Join")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Join indicates an expected call of Join.
+func (mr *MockMonitorMockRecorder) Join() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Join", reflect.TypeOf((*MockMonitor)(nil).Join))
+}
+
 // WaitUntilInitialized mocks base method.
 func (m *MockMonitor) WaitUntilInitialized(arg0 context.Context) error {
 	m.ctrl.T.Helper()
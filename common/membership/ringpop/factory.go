@@ -122,6 +122,12 @@ func (factory *factory) getMonitor() (*monitor, error) {
 				factory.Logger,
 				factory.MetadataManager,
 				factory.broadcastAddressResolver,
+				factory.Config.Zone,
+				placement{
+					rampUpDuration: factory.DC.GetDurationProperty(dynamicconfig.HistoryMembershipRampUpDuration, 0),
+					zoneAware:      factory.DC.GetBoolProperty(dynamicconfig.HistoryZoneAwareShardPlacement, false),
+				},
+				factory.DC.GetDurationProperty(dynamicconfig.HistoryMembershipLeaseDuration, 0),
 			)
 		}
 	})
@@ -137,11 +143,27 @@ func (factory *factory) getTChannel() *tchannel.Channel {
 	factory.chOnce.Do(func() {
 		ringpopServiceName := fmt.Sprintf("%v-ringpop", factory.ServiceName)
 		ringpopHostAddress := net.JoinHostPort(factory.getListenIP().String(), convert.IntToString(factory.RPCConfig.MembershipPort))
-		enableTLS := factory.DC.GetBoolProperty(dynamicconfig.EnableRingpopTLS, false)()
+
+		clientTLSConfig, err := factory.TLSFactory.GetInternodeClientConfig()
+		if err != nil {
+			factory.Logger.Fatal("Failed to get internode TLS client config", tag.Error(err))
+		}
+
+		serverTLSConfig, err := factory.TLSFactory.GetInternodeServerConfig()
+		if err != nil {
+			factory.Logger.Fatal("Failed to get internode TLS server config", tag.Error(err))
+		}
+
+		// Membership traffic is secured like the rest of the internode traffic, unless turned off
+		// explicitly, eg. while rolling out internode TLS to hosts which still talk in cleartext.
+		enableTLS := factory.DC.GetBoolProperty(dynamicconfig.EnableRingpopTLS, serverTLSConfig != nil)()
 
 		var tChannel *tchannel.Channel
 		if enableTLS {
-			tChannel = factory.getTLSChannel(ringpopHostAddress, ringpopServiceName)
+			if clientTLSConfig == nil || serverTLSConfig == nil {
+				factory.Logger.Fatal("Ringpop TLS is enabled but internode TLS is not configured")
+			}
+			tChannel = factory.getTLSChannel(ringpopHostAddress, ringpopServiceName, clientTLSConfig, serverTLSConfig)
 		} else {
 			tChannel = factory.getTCPChannel(ringpopHostAddress, ringpopServiceName)
 		}
@@ -168,22 +190,19 @@ func (factory *factory) getTCPChannel(ringpopHostAddress string, ringpopServiceN
 	return tChannel
 }
 
-func (factory *factory) getTLSChannel(ringpopHostAddress string, ringpopServiceName string) *tchannel.Channel {
-	clientTLSConfig, err := factory.TLSFactory.GetInternodeClientConfig()
-	if err != nil {
-		factory.Logger.Fatal("Failed to get internode TLS client config", tag.Error(err))
-	}
-
-	serverTLSConfig, err := factory.TLSFactory.GetInternodeServerConfig()
-	if err != nil {
-		factory.Logger.Fatal("Failed to get internode TLS server config", tag.Error(err))
-	}
-
+func (factory *factory) getTLSChannel(
+	ringpopHostAddress string,
+	ringpopServiceName string,
+	clientTLSConfig *tls.Config,
+	serverTLSConfig *tls.Config,
+) *tchannel.Channel {
 	listener, err := tls.Listen("tcp", ringpopHostAddress, serverTLSConfig)
 	if err != nil {
 		factory.Logger.Fatal("Failed to start ringpop TLS listener", tag.Error(err), tag.Address(ringpopHostAddress))
 	}
 
+	// The server name is taken from the address of the peer, unless set in the client config, so that
+	// peers are verified against their host name like gRPC internode connections.
 	dialer := tls.Dialer{Config: clientTLSConfig}
 	tChannel, err := tchannel.NewChannel(ringpopServiceName, &tchannel.ChannelOptions{Dialer: dialer.DialContext})
 	if err != nil {
@@ -201,6 +201,24 @@ func (s *RingpopSuite) TestRingpopInvalidTLS() {
 	s.Error(runRingpopTLSTest(&s.Suite, s.insecureFactory, s.serverTLSFactoryB))
 }
 
+func (s *RingpopSuite) TestRingpopTLSByDefault() {
+	provider, err := encryption.NewTLSConfigProviderFromConfig(config.RootTLS{Internode: s.internodeConfigServerTLS}, metrics.NoopMetricsHandler, s.logger, nil)
+	s.NoError(err)
+	dc := dynamicconfig.NewNoopCollection()
+	factoryA := newTestRingpopFactory("tester-A", s.logger, &config.RPC{MembershipPort: 7600, BindOnIP: localhostIPv4}, provider, dc)
+	factoryB := newTestRingpopFactory("tester-B", s.logger, &config.RPC{MembershipPort: 7601, BindOnIP: localhostIPv4}, provider, dc)
+	s.NoError(runRingpopTLSTest(&s.Suite, factoryA, factoryB))
+}
+
+func (s *RingpopSuite) TestRingpopTLSHostVerification() {
+	wrongServerName := s.internodeConfigServerTLS
+	wrongServerName.Client.ServerName = "not-the-host"
+	provider, err := encryption.NewTLSConfigProviderFromConfig(config.RootTLS{Internode: wrongServerName}, metrics.NoopMetricsHandler, s.logger, nil)
+	s.NoError(err)
+	factoryB := newTestRingpopFactory("tester-B", s.logger, &config.RPC{MembershipPort: 7601, BindOnIP: localhostIPv4}, provider, dynamicconfig.NewNoopCollection())
+	s.Error(runRingpopTLSTest(&s.Suite, s.serverTLSFactoryA, factoryB))
+}
+
 func runRingpopTLSTest(s *suite.Suite, serverA *factory, serverB *factory) error {
 	// Start two ringpop nodes
 	chA := serverA.getTChannel()
@@ -0,0 +1,132 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ringpop
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	// leaseRenewalsPerDuration is how many times a lease is renewed, and checked by peers, per lease duration
+	leaseRenewalsPerDuration = 3
+	leasePageSize            = 1000
+)
+
+type (
+	// leases tracks the membership leases of the members of a ring. Members hold a lease as long as they
+	// heartbeat their cluster membership record, so a member which crashed loses it within the lease duration,
+	// well before gossip declares it faulty, and is left out of the ring.
+	leases struct {
+		duration        dynamicconfig.DurationPropertyFn
+		metadataManager persistence.ClusterMetadataManager
+		role            persistence.ServiceType
+
+		value atomic.Value // this stores the current leaseHolders
+	}
+
+	// leaseHolders are the ringpop addresses of the members holding a lease when they were fetched
+	leaseHolders struct {
+		addrs     map[string]struct{}
+		fetchTime time.Time
+	}
+)
+
+func newLeases(
+	duration dynamicconfig.DurationPropertyFn,
+	metadataManager persistence.ClusterMetadataManager,
+	role persistence.ServiceType,
+) *leases {
+	l := &leases{
+		duration:        duration,
+		metadataManager: metadataManager,
+		role:            role,
+	}
+	l.value.Store(&leaseHolders{})
+	return l
+}
+
+// renewInterval is how often leases are renewed and checked, zero if leases are disabled
+func (l *leases) renewInterval() time.Duration {
+	return l.duration() / leaseRenewalsPerDuration
+}
+
+// refresh fetches the members holding a lease, and returns whether they changed
+func (l *leases) refresh(ctx context.Context, now time.Time) (bool, error) {
+	duration := l.duration()
+	if duration <= 0 {
+		return false, nil
+	}
+	holders := &leaseHolders{addrs: make(map[string]struct{}), fetchTime: now}
+	var pageToken []byte
+	for {
+		resp, err := l.metadataManager.GetClusterMembers(ctx, &persistence.GetClusterMembersRequest{
+			LastHeartbeatWithin: duration,
+			RoleEquals:          l.role,
+			PageSize:            leasePageSize,
+			NextPageToken:       pageToken,
+		})
+		if err != nil {
+			return false, err
+		}
+		for _, member := range resp.ActiveMembers {
+			holders.addrs[net.JoinHostPort(member.RPCAddress.String(), convert.Uint16ToString(member.RPCPort))] = struct{}{}
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+
+	previous := l.value.Swap(holders).(*leaseHolders)
+	if len(previous.addrs) != len(holders.addrs) {
+		return true, nil
+	}
+	for addr := range holders.addrs {
+		if _, ok := previous.addrs[addr]; !ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// expired returns whether the member with the ringpop address lost its lease. Leases are only trusted while
+// they were fetched within the lease duration and some member holds one, so that members aren't left out of
+// the ring because persistence is unavailable.
+func (l *leases) expired(addr string, now time.Time) bool {
+	duration := l.duration()
+	holders := l.value.Load().(*leaseHolders)
+	if duration <= 0 || len(holders.addrs) == 0 || now.Sub(holders.fetchTime) > duration {
+		return false
+	}
+	_, ok := holders.addrs[addr]
+	return !ok
+}
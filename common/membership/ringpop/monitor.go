@@ -26,6 +26,7 @@ package ringpop
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"math/rand"
@@ -37,6 +38,7 @@ import (
 
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/future"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/membership"
@@ -51,6 +53,8 @@ import (
 	"go.temporal.io/server/common/log/tag"
 )
 
+var errMonitorNotStarted = errors.New("membership monitor is not started")
+
 const (
 	upsertMembershipRecordExpiryDefault = time.Hour * 48
 
@@ -71,8 +75,13 @@ type monitor struct {
 	logger                    log.Logger
 	metadataManager           persistence.ClusterMetadataManager
 	broadcastHostPortResolver func() (string, error)
-	hostID                    uuid.UUID
-	initialized               *future.FutureImpl[struct{}]
+	zone                      string
+	historyLeaseDuration      dynamicconfig.DurationPropertyFn
+	// joinOnReady holds this host back from the ring of its service until Join is called
+	joinOnReady bool
+	joined      int32
+	hostID      uuid.UUID
+	initialized *future.FutureImpl[struct{}]
 }
 
 var _ membership.Monitor = (*monitor)(nil)
@@ -85,6 +94,9 @@ func newMonitor(
 	logger log.Logger,
 	metadataManager persistence.ClusterMetadataManager,
 	broadcastHostPortResolver func() (string, error),
+	zone string,
+	historyPlacement placement,
+	historyLeaseDuration dynamicconfig.DurationPropertyFn,
 ) *monitor {
 	lifecycleCtx, lifecycleCancel := context.WithCancel(context.Background())
 	lifecycleCtx = headers.SetCallerInfo(
@@ -105,11 +117,24 @@ func newMonitor(
 		logger:                    logger,
 		metadataManager:           metadataManager,
 		broadcastHostPortResolver: broadcastHostPortResolver,
+		zone:                      zone,
+		historyLeaseDuration:      historyLeaseDuration,
+		joinOnReady:               serviceName == primitives.HistoryService,
 		hostID:                    uuid.NewUUID(),
 		initialized:               future.NewFuture[struct{}](),
 	}
 	for service, port := range services {
-		rpo.rings[service] = newServiceResolver(service, port, rp, logger)
+		// Only history hosts own shards, which are costly to move, to reach across zones and to
+		// leave unowned while a crashed host is detected.
+		var servicePlacement placement
+		var serviceLeases *leases
+		if service == primitives.HistoryService {
+			servicePlacement = historyPlacement
+			if historyLeaseDuration != nil {
+				serviceLeases = newLeases(historyLeaseDuration, metadataManager, persistence.History)
+			}
+		}
+		rpo.rings[service] = newServiceResolver(service, port, rp, servicePlacement, serviceLeases, logger)
 	}
 	return rpo
 }
@@ -140,24 +165,70 @@ func (rpo *monitor) Start() {
 		func() ([]string, error) { return rpo.fetchCurrentBootstrapHostports() },
 		healthyHostLastHeartbeatCutoff/2)
 
+	if !rpo.joinOnReady {
+		if err = rpo.setRoleLabels(); err != nil {
+			rpo.logger.Fatal("unable to join membership ring", tag.Error(err))
+		}
+	}
+
+	for _, ring := range rpo.rings {
+		ring.Start()
+	}
+
+	rpo.initialized.Set(struct{}{}, nil)
+}
+
+// Join adds a history host to the history ring once it is ready to own shards. The hosts of other
+// services are added to their ring by Start.
+func (rpo *monitor) Join() error {
+	if !rpo.joinOnReady {
+		return nil
+	}
+	if atomic.LoadInt32(&rpo.status) != common.DaemonStatusStarted {
+		return errMonitorNotStarted
+	}
+	if !atomic.CompareAndSwapInt32(&rpo.joined, 0, 1) {
+		return nil
+	}
+	if err := rpo.setRoleLabels(); err != nil {
+		atomic.StoreInt32(&rpo.joined, 0)
+		return err
+	}
+
+	rpo.logger.Info("joined membership ring", tag.Service(rpo.serviceName))
+	if ring, ok := rpo.rings[rpo.serviceName]; ok {
+		ring.RequestRefresh()
+	}
+	return nil
+}
+
+// setRoleLabels advertises the service of this host, which makes it a member of the ring of that service.
+func (rpo *monitor) setRoleLabels() error {
 	labels, err := rpo.rp.Labels()
 	if err != nil {
-		rpo.logger.Fatal("unable to get ring pop labels", tag.Error(err))
+		return fmt.Errorf("unable to get ring pop labels: %w", err)
 	}
 
-	if err = labels.Set(rolePort, strconv.Itoa(rpo.services[rpo.serviceName])); err != nil {
-		rpo.logger.Fatal("unable to set ring pop ServicePort label", tag.Error(err))
+	// The start time and zone are set before the role, so that the service never shows up in the ring
+	// without them.
+	if err = labels.Set(startTimeKey, strconv.FormatInt(time.Now().UnixMilli(), 10)); err != nil {
+		return fmt.Errorf("unable to set ring pop StartTime label: %w", err)
 	}
 
-	if err = labels.Set(roleKey, string(rpo.serviceName)); err != nil {
-		rpo.logger.Fatal("unable to set ring pop ServiceRole label", tag.Error(err))
+	if rpo.zone != "" {
+		if err = labels.Set(zoneKey, rpo.zone); err != nil {
+			return fmt.Errorf("unable to set ring pop Zone label: %w", err)
+		}
 	}
 
-	for _, ring := range rpo.rings {
-		ring.Start()
+	if err = labels.Set(rolePort, strconv.Itoa(rpo.services[rpo.serviceName])); err != nil {
+		return fmt.Errorf("unable to set ring pop ServicePort label: %w", err)
 	}
 
-	rpo.initialized.Set(struct{}{}, nil)
+	if err = labels.Set(roleKey, string(rpo.serviceName)); err != nil {
+		return fmt.Errorf("unable to set ring pop ServiceRole label: %w", err)
+	}
+	return nil
 }
 
 func (rpo *monitor) WaitUntilInitialized(ctx context.Context) error {
@@ -310,14 +381,25 @@ func (rpo *monitor) startHeartbeatUpsertLoop(request *persistence.UpsertClusterM
 				rpo.logger.Error("Membership upsert failed.", tag.Error(err))
 			}
 
-			jitter := math.Round(rand.Float64() * 5)
-			time.Sleep(time.Second * time.Duration(10+jitter))
+			time.Sleep(rpo.heartbeatInterval())
 		}
 	}
 
 	go loopUpsertMembership()
 }
 
+// heartbeatInterval is how often the membership record is upserted. The record of a history host is its
+// lease when leases are enabled, so it is renewed several times per lease duration.
+func (rpo *monitor) heartbeatInterval() time.Duration {
+	if rpo.serviceName == primitives.HistoryService && rpo.historyLeaseDuration != nil {
+		if interval := rpo.historyLeaseDuration() / leaseRenewalsPerDuration; interval > 0 {
+			return interval
+		}
+	}
+	jitter := math.Round(rand.Float64() * 5)
+	return time.Second * time.Duration(10+jitter)
+}
+
 func (rpo *monitor) Stop() {
 	if !atomic.CompareAndSwapInt32(
 		&rpo.status,
@@ -25,14 +25,19 @@
 package ringpop
 
 import (
+	"context"
+	"strconv"
 	"testing"
 	"time"
 
 	"golang.org/x/exp/maps"
 
+	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -109,6 +114,188 @@ func (s *RpoSuite) TestCompareMembers() {
 	s.verifyMemberDiff([]string{"a", "b", "c"}, []string{"b", "d", "e"}, []string{"+d", "+e", "-a", "-c"})
 }
 
+func (s *RpoSuite) TestWeightedLookup() {
+	oldHosts := []string{"10.0.0.1:7234", "10.0.0.2:7234", "10.0.0.3:7234"}
+	newHost := "10.0.0.4:7234"
+	oldRing := newHashRing()
+	ring := newHashRing()
+	for _, addr := range oldHosts {
+		oldRing.AddMembers(newHostInfo(addr, nil))
+		ring.AddMembers(newHostInfo(addr, nil))
+	}
+	ring.AddMembers(newHostInfo(newHost, nil))
+
+	keys := make([]string, 1000)
+	for i := range keys {
+		keys[i] = strconv.Itoa(i)
+	}
+	ownedAt := func(weight float64) map[string]struct{} {
+		owned := make(map[string]struct{})
+		for _, key := range keys {
+			addr := weightedLookup(ring, key, map[string]float64{newHost: weight})
+			if addr == newHost {
+				owned[key] = struct{}{}
+				continue
+			}
+			// keys not owned by the new host stay where they were before it joined
+			oldAddr, _ := oldRing.Lookup(key)
+			s.Equal(oldAddr, addr)
+		}
+		return owned
+	}
+
+	s.Empty(ownedAt(0))
+	half := ownedAt(0.5)
+	all := ownedAt(1)
+	for key := range half {
+		s.Contains(all, key)
+	}
+	for _, key := range keys {
+		addr, _ := ring.Lookup(key)
+		_, owned := all[key]
+		s.Equal(addr == newHost, owned)
+	}
+	s.InDelta(len(all)/2, len(half), float64(len(all))/10)
+}
+
+func (s *RpoSuite) TestRampUpWeights() {
+	now := time.Now()
+	rampUpDuration := time.Minute
+	resolver := &serviceResolver{placement: placement{rampUpDuration: func() time.Duration { return rampUpDuration }}}
+	state := newPlacementState([]string{"a", "b"}, map[string]time.Time{
+		"a": now.Add(-time.Hour),
+		"b": now.Add(-15 * time.Second),
+	}, nil)
+
+	s.Equal(map[string]float64{"b": 0.25}, resolver.rampUpWeights(state, now))
+	s.Empty(resolver.rampUpWeights(state, now.Add(time.Minute)))
+	rampUpDuration = 0
+	s.Empty(resolver.rampUpWeights(state, now))
+}
+
+func (s *RpoSuite) TestZonePlacement() {
+	zones := map[string]string{
+		"10.0.1.1:7234": "zone-1",
+		"10.0.1.2:7234": "zone-1",
+		"10.0.1.3:7234": "zone-1",
+		"10.0.2.1:7234": "zone-2",
+		"10.0.3.1:7234": "zone-3",
+	}
+	zoneAware := true
+	resolver := newServiceResolver(primitives.HistoryService, 7234, nil, placement{
+		zoneAware: func() bool { return zoneAware },
+	}, nil, log.NewNoopLogger())
+	lookupAll := func(addrs []string) map[string]string {
+		ring := newHashRing()
+		for _, addr := range addrs {
+			ring.AddMembers(newHostInfo(addr, nil))
+		}
+		resolver.ringValue.Store(ring)
+		resolver.placementValue.Store(newPlacementState(addrs, nil, zones))
+		owners := make(map[string]string)
+		for i := 0; i < 3000; i++ {
+			host, err := resolver.Lookup(strconv.Itoa(i))
+			s.NoError(err)
+			owners[strconv.Itoa(i)] = host.GetAddress()
+		}
+		return owners
+	}
+
+	owners := lookupAll(maps.Keys(zones))
+	keysPerZone := make(map[string]int)
+	for _, addr := range owners {
+		keysPerZone[zones[addr]]++
+	}
+	for _, zone := range []string{"zone-1", "zone-2", "zone-3"} {
+		s.InDelta(1000, keysPerZone[zone], 150, zone)
+	}
+
+	// the keys of a host which leaves stay in its zone, other keys don't move
+	failedHost := "10.0.1.1:7234"
+	var remaining []string
+	for addr := range zones {
+		if addr != failedHost {
+			remaining = append(remaining, addr)
+		}
+	}
+	for key, addr := range lookupAll(remaining) {
+		if owners[key] == failedHost {
+			s.Equal("zone-1", zones[addr])
+		} else {
+			s.Equal(owners[key], addr)
+		}
+	}
+
+	// keys are placed across hosts once a host doesn't advertise its zone
+	s.Empty(newPlacementState(append(maps.Keys(zones), "10.0.4.1:7234"), nil, zones).zones)
+
+	zoneAware = false
+	ring := newHashRing()
+	for _, addr := range remaining {
+		ring.AddMembers(newHostInfo(addr, nil))
+	}
+	resolver.ringValue.Store(ring)
+	host, err := resolver.Lookup("key")
+	s.NoError(err)
+	expected, _ := ring.Lookup("key")
+	s.Equal(expected, host.GetAddress())
+}
+
+func (s *RpoSuite) TestLeases() {
+	controller := gomock.NewController(s.T())
+	metadataManager := persistence.NewMockClusterMetadataManager(controller)
+	leaseDuration := 3 * time.Second
+	l := newLeases(func() time.Duration { return leaseDuration }, metadataManager, persistence.History)
+	s.Equal(time.Second, l.renewInterval())
+
+	expectHolders := func(addrs ...string) {
+		var members []*persistence.ClusterMember
+		for _, addr := range addrs {
+			ip, port, err := splitHostPortTyped(addr)
+			s.NoError(err)
+			members = append(members, &persistence.ClusterMember{RPCAddress: ip, RPCPort: port})
+		}
+		metadataManager.EXPECT().GetClusterMembers(gomock.Any(), &persistence.GetClusterMembersRequest{
+			LastHeartbeatWithin: leaseDuration,
+			RoleEquals:          persistence.History,
+			PageSize:            leasePageSize,
+		}).Return(&persistence.GetClusterMembersResponse{ActiveMembers: members}, nil)
+	}
+
+	// nothing expires before leases are fetched
+	now := time.Now()
+	s.False(l.expired("10.0.0.1:6934", now))
+
+	expectHolders("10.0.0.1:6934", "10.0.0.2:6934")
+	changed, err := l.refresh(context.Background(), now)
+	s.NoError(err)
+	s.True(changed)
+	s.False(l.expired("10.0.0.1:6934", now))
+	s.False(l.expired("10.0.0.2:6934", now))
+
+	expectHolders("10.0.0.2:6934")
+	changed, err = l.refresh(context.Background(), now)
+	s.NoError(err)
+	s.True(changed)
+	s.True(l.expired("10.0.0.1:6934", now))
+	s.False(l.expired("10.0.0.2:6934", now))
+
+	expectHolders("10.0.0.2:6934")
+	changed, err = l.refresh(context.Background(), now)
+	s.NoError(err)
+	s.False(changed)
+
+	// leases which couldn't be fetched for a whole lease duration are not trusted
+	s.False(l.expired("10.0.0.1:6934", now.Add(leaseDuration+time.Second)))
+
+	// nor are leases once disabled
+	leaseDuration = 0
+	s.False(l.expired("10.0.0.1:6934", now))
+	changed, err = l.refresh(context.Background(), now)
+	s.NoError(err)
+	s.False(changed)
+}
+
 func (s *RpoSuite) verifyMemberDiff(curr []string, new []string, expectedDiff []string) {
 	resolver := &serviceResolver{}
 	currMembers := make(map[string]struct{}, len(curr))
@@ -25,8 +25,11 @@
 package ringpop
 
 import (
+	"context"
 	"errors"
+	"math"
 	"net"
+	"sort"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -41,6 +44,8 @@ import (
 	"github.com/temporalio/ringpop-go/swim"
 
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
@@ -57,6 +62,16 @@ const (
 	// the service can be accessed.
 	rolePort = "servicePort"
 
+	// startTimeKey label is set by every single service before it joins the ring. The data
+	// for this key is the time, in Unix milliseconds, at which the service started. It is
+	// used to ramp up the share of keys of new hosts.
+	startTimeKey = "startTime"
+
+	// zoneKey label is set, before it joins the ring, by every single service configured
+	// with the availability zone it runs in. The data for this key is the zone. It is used
+	// to spread keys across zones.
+	zoneKey = "zone"
+
 	minRefreshInternal     = time.Second * 4
 	defaultRefreshInterval = time.Second * 10
 	replicaPoints          = 100
@@ -67,12 +82,15 @@ type serviceResolver struct {
 	service     primitives.ServiceName
 	port        int
 	rp          *service
+	placement   placement
+	leases      *leases // nil if members are not left out when their lease expires
 	refreshChan chan struct{}
 	shutdownCh  chan struct{}
 	shutdownWG  sync.WaitGroup
 	logger      log.Logger
 
-	ringValue atomic.Value // this stores the current hashring
+	ringValue      atomic.Value // this stores the current hashring
+	placementValue atomic.Value // this stores the placementState of the members of the current hashring
 
 	refreshLock     sync.Mutex
 	lastRefreshTime time.Time
@@ -82,12 +100,31 @@ type serviceResolver struct {
 	listeners    map[string]chan<- *membership.ChangedEvent
 }
 
+// placement controls how a ring places keys on its members
+type placement struct {
+	// rampUpDuration is how long new members take to own their full share of keys, nil if they own it at once
+	rampUpDuration dynamicconfig.DurationPropertyFn
+	// zoneAware spreads keys evenly across the zones of the members rather than across members, nil if it doesn't
+	zoneAware dynamicconfig.BoolPropertyFn
+}
+
+// placementState holds the labels of the members of the ring used to place keys
+type placementState struct {
+	startTimes  map[string]time.Time // of the members which advertise it
+	latestStart time.Time
+	zones       []string // sorted, empty unless every member advertises its zone
+	zoneMembers map[string][]string
+	zoneRings   map[string]*hashring.HashRing
+}
+
 var _ membership.ServiceResolver = (*serviceResolver)(nil)
 
 func newServiceResolver(
 	service primitives.ServiceName,
 	port int,
 	rp *service,
+	placement placement,
+	leases *leases,
 	logger log.Logger,
 ) *serviceResolver {
 	resolver := &serviceResolver{
@@ -95,6 +132,8 @@ func newServiceResolver(
 		service:     service,
 		port:        port,
 		rp:          rp,
+		placement:   placement,
+		leases:      leases,
 		refreshChan: make(chan struct{}),
 		shutdownCh:  make(chan struct{}),
 		logger:      log.With(logger, tag.ComponentServiceResolver, tag.Service(service)),
@@ -102,6 +141,7 @@ func newServiceResolver(
 		listeners:   make(map[string]chan<- *membership.ChangedEvent),
 	}
 	resolver.ringValue.Store(newHashRing())
+	resolver.placementValue.Store(newPlacementState(nil, nil, nil))
 	return resolver
 }
 
@@ -109,6 +149,45 @@ func newHashRing() *hashring.HashRing {
 	return hashring.New(farm.Fingerprint32, replicaPoints)
 }
 
+// newPlacementState builds the placement state of the members from the start times and zones they advertise.
+func newPlacementState(
+	addrs []string,
+	startTimes map[string]time.Time,
+	zones map[string]string,
+) *placementState {
+	state := &placementState{
+		startTimes:  startTimes,
+		zoneMembers: make(map[string][]string),
+		zoneRings:   make(map[string]*hashring.HashRing),
+	}
+	for _, start := range startTimes {
+		if start.After(state.latestStart) {
+			state.latestStart = start
+		}
+	}
+	for _, addr := range addrs {
+		zone, ok := zones[addr]
+		if !ok {
+			// Members which don't advertise their zone, eg. running an older version, would otherwise
+			// get the share of a whole zone.
+			state.zoneMembers = nil
+			state.zoneRings = nil
+			return state
+		}
+		state.zoneMembers[zone] = append(state.zoneMembers[zone], addr)
+	}
+	for zone, members := range state.zoneMembers {
+		ring := newHashRing()
+		for _, addr := range members {
+			ring.AddMembers(newHostInfo(addr, nil))
+		}
+		state.zones = append(state.zones, zone)
+		state.zoneRings[zone] = ring
+	}
+	sort.Strings(state.zones)
+	return state
+}
+
 // Start starts the oracle
 func (r *serviceResolver) Start() {
 	if !atomic.CompareAndSwapInt32(
@@ -159,15 +238,116 @@ func (r *serviceResolver) RequestRefresh() {
 
 // Lookup finds the host in the ring responsible for serving the given key
 func (r *serviceResolver) Lookup(key string) (membership.HostInfo, error) {
-	addr, found := r.ring().Lookup(key)
+	state := r.placementValue.Load().(*placementState)
+	weights := r.rampUpWeights(state, time.Now())
+	ring := r.ring()
+	if zoneRing := r.zoneRing(state, key, weights); zoneRing != nil {
+		ring = zoneRing
+	}
+	addr, found := ring.Lookup(key)
 	if !found {
 		r.RequestRefresh()
 		return nil, membership.ErrInsufficientHosts
 	}
+	if len(weights) > 0 {
+		addr = weightedLookup(ring, key, weights)
+	}
 
 	return newHostInfo(addr, r.getLabelsMap()), nil
 }
 
+// rampUpWeights returns the weight, from 0 to 1, of the members which started less than the ramp up
+// duration ago. Other members have a weight of 1.
+func (r *serviceResolver) rampUpWeights(state *placementState, now time.Time) map[string]float64 {
+	if r.placement.rampUpDuration == nil {
+		return nil
+	}
+	duration := r.placement.rampUpDuration()
+	if duration <= 0 || now.Sub(state.latestStart) >= duration {
+		return nil
+	}
+	weights := make(map[string]float64)
+	for addr, start := range state.startTimes {
+		if elapsed := now.Sub(start); elapsed < duration {
+			weights[addr] = math.Max(0, float64(elapsed)/float64(duration))
+		}
+	}
+	return weights
+}
+
+// weightedLookup finds the host for the key when the members in weights own only that fraction of
+// the keys they would own otherwise. The other keys go to the next member in the ring, as if the
+// member was not in it. Whether a member owns a key depends only on the key and its weight, so keys
+// only move towards a member as its weight grows.
+func weightedLookup(ring *hashring.HashRing, key string, weights map[string]float64) string {
+	candidates := ring.LookupN(key, len(weights)+1)
+	for _, addr := range candidates {
+		weight, ok := weights[addr]
+		if !ok || keyFraction(key, addr) < weight {
+			return addr
+		}
+	}
+	// only members ramping up are left, eg. when the whole cluster starts
+	return candidates[0]
+}
+
+// keyFraction maps the key, for the member or zone, to a point uniformly distributed in [0, 1)
+func keyFraction(key string, addr string) float64 {
+	return float64(farm.Fingerprint32([]byte(key+"_"+addr))) / (1 << 32)
+}
+
+// zoneRing returns the ring of the members of the zone the key is placed in, or nil if keys are
+// placed across all the members.
+func (r *serviceResolver) zoneRing(state *placementState, key string, weights map[string]float64) *hashring.HashRing {
+	if r.placement.zoneAware == nil || !r.placement.zoneAware() || len(state.zones) < 2 {
+		return nil
+	}
+	// a zone whose members all ramp up ramps up like its most advanced member
+	var zoneWeights map[string]float64
+	for zone, members := range state.zoneMembers {
+		weight := 0.0
+		for _, addr := range members {
+			memberWeight, ok := weights[addr]
+			if !ok {
+				weight = 1
+				break
+			}
+			weight = math.Max(weight, memberWeight)
+		}
+		if weight < 1 {
+			if zoneWeights == nil {
+				zoneWeights = make(map[string]float64)
+			}
+			zoneWeights[zone] = weight
+		}
+	}
+	return state.zoneRings[placeZone(key, state.zones, zoneWeights)]
+}
+
+// placeZone picks the zone of the key by rendezvous hashing: every zone gets an even share of the
+// keys, whatever its number of members, so the keys of a member which leaves stay in its zone. Only
+// the keys of a zone move when the zone comes or goes. Like members, zones in weights only take that
+// fraction of their keys, the others go to the next zone.
+func placeZone(key string, zones []string, weights map[string]float64) string {
+	type rankedZone struct {
+		zone  string
+		score uint32
+	}
+	ranked := make([]rankedZone, len(zones))
+	for i, zone := range zones {
+		ranked[i] = rankedZone{zone: zone, score: farm.Fingerprint32([]byte(zone + "_" + key))}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool { return ranked[i].score > ranked[j].score })
+	for _, candidate := range ranked {
+		weight, ok := weights[candidate.zone]
+		if !ok || keyFraction(key, candidate.zone) < weight {
+			return candidate.zone
+		}
+	}
+	// only zones ramping up are left, eg. when the whole cluster starts
+	return ranked[0].zone
+}
+
 func (r *serviceResolver) AddListener(
 	name string,
 	notifyChannel chan<- *membership.ChangedEvent,
@@ -257,10 +437,11 @@ func (r *serviceResolver) refreshWithBackoff() error {
 }
 
 func (r *serviceResolver) refreshNoLock() (*membership.ChangedEvent, error) {
-	addrs, err := r.getReachableMembers()
+	addrs, state, err := r.getReachableMembers()
 	if err != nil {
 		return nil, err
 	}
+	r.placementValue.Store(state)
 
 	newMembersMap, changedEvent := r.compareMembers(addrs)
 	if changedEvent == nil {
@@ -281,14 +462,21 @@ func (r *serviceResolver) refreshNoLock() (*membership.ChangedEvent, error) {
 	return changedEvent, nil
 }
 
-func (r *serviceResolver) getReachableMembers() ([]string, error) {
+func (r *serviceResolver) getReachableMembers() ([]string, *placementState, error) {
 	members, err := r.rp.GetReachableMemberObjects(swim.MemberWithLabelAndValue(roleKey, string(r.service)))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var hostPorts []string
+	startTimes := make(map[string]time.Time)
+	zones := make(map[string]string)
+	now := time.Now()
 	for _, member := range members {
+		if r.leases != nil && r.leases.expired(member.Address, now) {
+			r.logger.Debug("leaving out ring member whose lease expired", tag.Address(member.Address))
+			continue
+		}
 		servicePort := r.port
 
 		// Each temporal service in the ring should advertise which port it has its gRPC listener
@@ -298,7 +486,7 @@ func (r *serviceResolver) getReachableMembers() ([]string, error) {
 		if ok {
 			servicePort, err = strconv.Atoi(servicePortLabel)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 		} else {
 			r.logger.Debug("unable to find roleport label for ringpop member. using local service's port", tag.Service(r.service))
@@ -306,13 +494,26 @@ func (r *serviceResolver) getReachableMembers() ([]string, error) {
 
 		hostPort, err := replaceServicePort(member.Address, servicePort)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		hostPorts = append(hostPorts, hostPort)
+
+		// Members which don't advertise their start time, eg. running an older version, are
+		// not ramped up.
+		if startTimeLabel, ok := member.Label(startTimeKey); ok {
+			startTimeMillis, err := strconv.ParseInt(startTimeLabel, 10, 64)
+			if err != nil {
+				return nil, nil, err
+			}
+			startTimes[hostPort] = time.UnixMilli(startTimeMillis)
+		}
+		if zone, ok := member.Label(zoneKey); ok {
+			zones[hostPort] = zone
+		}
 	}
 
-	return hostPorts, nil
+	return hostPorts, newPlacementState(hostPorts, startTimes, zones), nil
 }
 
 func (r *serviceResolver) emitEvent(event *membership.ChangedEvent) {
@@ -335,10 +536,23 @@ func (r *serviceResolver) refreshRingWorker() {
 	refreshTicker := time.NewTicker(defaultRefreshInterval)
 	defer refreshTicker.Stop()
 
+	var leaseTimer *time.Timer
+	var leaseTimerCh <-chan time.Time
+	if r.leases != nil {
+		leaseTimer = time.NewTimer(r.leaseRefreshInterval())
+		defer leaseTimer.Stop()
+		leaseTimerCh = leaseTimer.C
+	}
+
 	for {
 		select {
 		case <-r.shutdownCh:
 			return
+		case <-leaseTimerCh:
+			if err := r.refreshLeases(); err != nil {
+				r.logger.Error("error refreshing ring member leases", tag.Error(err))
+			}
+			leaseTimer.Reset(r.leaseRefreshInterval())
 		case <-r.refreshChan:
 			if err := r.refreshWithBackoff(); err != nil {
 				r.logger.Error("error refreshing ring by request", tag.Error(err))
@@ -351,6 +565,27 @@ func (r *serviceResolver) refreshRingWorker() {
 	}
 }
 
+// leaseRefreshInterval is how often leases are checked, leases being enabled or not
+func (r *serviceResolver) leaseRefreshInterval() time.Duration {
+	if interval := r.leases.renewInterval(); interval > 0 {
+		return interval
+	}
+	return defaultRefreshInterval
+}
+
+// refreshLeases checks the leases of the members, and refreshes the ring right away if they changed
+// rather than waiting for gossip.
+func (r *serviceResolver) refreshLeases() error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.leaseRefreshInterval())
+	defer cancel()
+	ctx = headers.SetCallerInfo(ctx, headers.SystemBackgroundCallerInfo)
+	changed, err := r.leases.refresh(ctx, time.Now())
+	if err != nil || !changed {
+		return err
+	}
+	return r.refresh()
+}
+
 func (r *serviceResolver) ring() *hashring.HashRing {
 	return r.ringValue.Load().(*hashring.HashRing)
 }
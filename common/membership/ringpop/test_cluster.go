@@ -164,8 +164,15 @@ func newTestCluster(
 			logger,
 			mockMgr,
 			resolver,
+			"",
+			placement{},
+			nil,
 		)
 		cluster.rings[i].Start()
+		if err := cluster.rings[i].Join(); err != nil {
+			logger.Error("failed to join ring", tag.Error(err))
+			return nil
+		}
 	}
 	return cluster
 }
@@ -0,0 +1,255 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+)
+
+const (
+	// CardinalityWindow is how long a task queue stays tagged without being recorded. A task queue which
+	// was recorded during the current or the previous window counts towards the limit.
+	CardinalityWindow = 10 * time.Minute
+
+	// taskQueueTagOverflowValue replaces the task queue tag of metrics for task queues which don't fit in
+	// the tag cardinality limit
+	taskQueueTagOverflowValue = "_other_"
+)
+
+type (
+	// CardinalityConfig is the configuration of the tag cardinality limits of metrics
+	CardinalityConfig struct {
+		// MaxTaskQueuesPerNamespace is the number of task queues of a namespace each metric is tagged with,
+		// metrics of other task queues are tagged as _other_. Zero removes the limit.
+		MaxTaskQueuesPerNamespace dynamicconfig.IntPropertyFnWithNamespaceFilter
+		// Unlimited, if set, returns true for namespaces whose metrics are not limited, eg. while debugging them.
+		Unlimited func(namespace string) bool
+	}
+
+	// CardinalityLimiter bounds the number of task queues of each namespace every metric is tagged with,
+	// so that namespaces creating task queues with random names don't blow up the number of series.
+	CardinalityLimiter struct {
+		config     *CardinalityConfig
+		timeSource clock.TimeSource
+
+		sync.Mutex
+		taskQueues map[cardinalityKey]*taskQueueSet
+	}
+
+	cardinalityKey struct {
+		metric    string
+		namespace string
+	}
+
+	// taskQueueSet holds the task queues a metric of a namespace is tagged with over the current and the
+	// previous window.
+	taskQueueSet struct {
+		current   map[string]struct{}
+		previous  map[string]struct{}
+		rotatedAt time.Time
+		// size is the number of task queues in current or previous
+		size int
+	}
+
+	cardinalityLimitingHandler struct {
+		handler Handler
+		limiter *CardinalityLimiter
+		// namespace and taskQueueTag are the tags of the handler. The task queue tag is not set on the
+		// wrapped handler, it is added to each record instead.
+		namespace    string
+		taskQueueTag Tag
+	}
+)
+
+var _ Handler = (*cardinalityLimitingHandler)(nil)
+
+// NewCardinalityConfig reads the tag cardinality limits of metrics from dynamic config
+func NewCardinalityConfig(dc *dynamicconfig.Collection) *CardinalityConfig {
+	return &CardinalityConfig{
+		MaxTaskQueuesPerNamespace: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MetricsMaxTaskQueuesPerNamespace, 1000),
+	}
+}
+
+func NewCardinalityLimiter(
+	config *CardinalityConfig,
+	timeSource clock.TimeSource,
+) *CardinalityLimiter {
+	return &CardinalityLimiter{
+		config:     config,
+		timeSource: timeSource,
+		taskQueues: make(map[cardinalityKey]*taskQueueSet),
+	}
+}
+
+// NewCardinalityLimitingHandler returns a handler which tags the metrics of the task queues over the limit
+// of their namespace as _other_.
+func NewCardinalityLimitingHandler(handler Handler, limiter *CardinalityLimiter) Handler {
+	return &cardinalityLimitingHandler{
+		handler: handler,
+		limiter: limiter,
+	}
+}
+
+// TaskQueueTagValue returns the task queue tag value the metric should be recorded with for the task queue.
+func (l *CardinalityLimiter) TaskQueueTagValue(
+	metric string,
+	namespace string,
+	taskQueue string,
+) string {
+	if taskQueue == unknownValue || taskQueue == StickyTaskQueueTag.Value() || taskQueue == taskQueueTagOverflowValue {
+		return taskQueue
+	}
+	limit := l.config.MaxTaskQueuesPerNamespace(namespace)
+	if limit <= 0 || (l.config.Unlimited != nil && l.config.Unlimited(namespace)) {
+		return taskQueue
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	key := cardinalityKey{metric: metric, namespace: namespace}
+	set, ok := l.taskQueues[key]
+	if !ok {
+		set = &taskQueueSet{current: make(map[string]struct{})}
+		l.taskQueues[key] = set
+	}
+	if set.admit(taskQueue, limit, l.timeSource.Now()) {
+		return taskQueue
+	}
+	return taskQueueTagOverflowValue
+}
+
+func (s *taskQueueSet) admit(taskQueue string, limit int, now time.Time) bool {
+	if now.Sub(s.rotatedAt) >= CardinalityWindow {
+		s.previous = s.current
+		s.current = make(map[string]struct{}, len(s.previous))
+		s.rotatedAt = now
+		s.size = len(s.previous)
+	}
+	if _, ok := s.current[taskQueue]; ok {
+		return true
+	}
+	if _, ok := s.previous[taskQueue]; ok {
+		s.current[taskQueue] = struct{}{}
+		return true
+	}
+	if s.size < limit {
+		s.current[taskQueue] = struct{}{}
+		s.size++
+		return true
+	}
+	return false
+}
+
+func (h *cardinalityLimitingHandler) WithTags(tags ...Tag) Handler {
+	limited := *h
+	forwarded := make([]Tag, 0, len(tags))
+	for _, tag := range tags {
+		switch tag.Key() {
+		case taskQueue:
+			limited.taskQueueTag = tag
+			continue
+		case namespace:
+			limited.namespace = tag.Value()
+		}
+		forwarded = append(forwarded, tag)
+	}
+	if len(forwarded) > 0 {
+		limited.handler = h.handler.WithTags(forwarded...)
+	}
+	return &limited
+}
+
+func (h *cardinalityLimitingHandler) Counter(name string) CounterIface {
+	counter := h.handler.Counter(name)
+	return CounterFunc(func(v int64, tags ...Tag) {
+		counter.Record(v, h.limitTags(name, tags)...)
+	})
+}
+
+func (h *cardinalityLimitingHandler) Gauge(name string) GaugeIface {
+	gauge := h.handler.Gauge(name)
+	return GaugeFunc(func(v float64, tags ...Tag) {
+		gauge.Record(v, h.limitTags(name, tags)...)
+	})
+}
+
+func (h *cardinalityLimitingHandler) Timer(name string) TimerIface {
+	timer := h.handler.Timer(name)
+	return TimerFunc(func(v time.Duration, tags ...Tag) {
+		timer.Record(v, h.limitTags(name, tags)...)
+	})
+}
+
+func (h *cardinalityLimitingHandler) Histogram(name string, unit MetricUnit) HistogramIface {
+	histogram := h.handler.Histogram(name, unit)
+	return HistogramFunc(func(v int64, tags ...Tag) {
+		histogram.Record(v, h.limitTags(name, tags)...)
+	})
+}
+
+func (h *cardinalityLimitingHandler) Stop(logger log.Logger) {
+	h.handler.Stop(logger)
+}
+
+// limitTags returns the tags of a record with the task queue tag, either of the record or of the handler,
+// replaced by the overflow value when the task queue is over the limit.
+func (h *cardinalityLimitingHandler) limitTags(metric string, tags []Tag) []Tag {
+	ns := h.namespace
+	taskQueueTag := h.taskQueueTag
+	taskQueueIndex := -1
+	for i, tag := range tags {
+		switch tag.Key() {
+		case namespace:
+			ns = tag.Value()
+		case taskQueue:
+			taskQueueTag = tag
+			taskQueueIndex = i
+		}
+	}
+	if taskQueueTag == nil {
+		return tags
+	}
+
+	value := h.limiter.TaskQueueTagValue(metric, ns, taskQueueTag.Value())
+	if value != taskQueueTag.Value() {
+		taskQueueTag = &tagImpl{key: taskQueue, value: value}
+	} else if taskQueueIndex >= 0 {
+		return tags
+	}
+
+	limited := make([]Tag, len(tags), len(tags)+1)
+	copy(limited, tags)
+	if taskQueueIndex >= 0 {
+		limited[taskQueueIndex] = taskQueueTag
+		return limited
+	}
+	return append(limited, taskQueueTag)
+}
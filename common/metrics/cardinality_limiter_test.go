@@ -0,0 +1,132 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+)
+
+type recordingHandler struct {
+	tags    []Tag
+	records *[][]Tag
+}
+
+func (h *recordingHandler) WithTags(tags ...Tag) Handler {
+	return &recordingHandler{tags: append(append([]Tag{}, h.tags...), tags...), records: h.records}
+}
+
+func (h *recordingHandler) Counter(string) CounterIface {
+	return CounterFunc(func(_ int64, tags ...Tag) { h.record(tags) })
+}
+
+func (h *recordingHandler) Gauge(string) GaugeIface {
+	return GaugeFunc(func(_ float64, tags ...Tag) { h.record(tags) })
+}
+
+func (h *recordingHandler) Timer(string) TimerIface {
+	return TimerFunc(func(_ time.Duration, tags ...Tag) { h.record(tags) })
+}
+
+func (h *recordingHandler) Histogram(string, MetricUnit) HistogramIface {
+	return HistogramFunc(func(_ int64, tags ...Tag) { h.record(tags) })
+}
+
+func (h *recordingHandler) Stop(log.Logger) {}
+
+func (h *recordingHandler) record(tags []Tag) {
+	*h.records = append(*h.records, append(append([]Tag{}, h.tags...), tags...))
+}
+
+func newTestCardinalityLimiter(limit int, timeSource clock.TimeSource) *CardinalityLimiter {
+	return NewCardinalityLimiter(
+		&CardinalityConfig{
+			MaxTaskQueuesPerNamespace: func(namespace string) int {
+				if namespace == "unlimited" {
+					return 0
+				}
+				return limit
+			},
+			Unlimited: func(namespace string) bool {
+				return namespace == "debug"
+			},
+		},
+		timeSource,
+	)
+}
+
+func TestCardinalityLimiter_TaskQueueTagValue(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	limiter := newTestCardinalityLimiter(2, timeSource)
+
+	require.Equal(t, "a", limiter.TaskQueueTagValue("m", "ns", "a"))
+	require.Equal(t, "b", limiter.TaskQueueTagValue("m", "ns", "b"))
+	require.Equal(t, taskQueueTagOverflowValue, limiter.TaskQueueTagValue("m", "ns", "c"))
+	require.Equal(t, "a", limiter.TaskQueueTagValue("m", "ns", "a"))
+
+	// limits are per metric and namespace
+	require.Equal(t, "c", limiter.TaskQueueTagValue("other", "ns", "c"))
+	require.Equal(t, "c", limiter.TaskQueueTagValue("m", "ns2", "c"))
+	require.Equal(t, "c", limiter.TaskQueueTagValue("m", "unlimited", "c"))
+	require.Equal(t, "c", limiter.TaskQueueTagValue("m", "debug", "c"))
+	require.Equal(t, StickyTaskQueueTag.Value(), limiter.TaskQueueTagValue("m", "ns", StickyTaskQueueTag.Value()))
+
+	// a is still recorded, b is idle for a whole window
+	timeSource.Update(timeSource.Now().Add(CardinalityWindow))
+	require.Equal(t, "a", limiter.TaskQueueTagValue("m", "ns", "a"))
+	require.Equal(t, taskQueueTagOverflowValue, limiter.TaskQueueTagValue("m", "ns", "c"))
+	timeSource.Update(timeSource.Now().Add(CardinalityWindow))
+	require.Equal(t, "c", limiter.TaskQueueTagValue("m", "ns", "c"))
+	require.Equal(t, "a", limiter.TaskQueueTagValue("m", "ns", "a"))
+	require.Equal(t, taskQueueTagOverflowValue, limiter.TaskQueueTagValue("m", "ns", "b"))
+}
+
+func TestCardinalityLimitingHandler(t *testing.T) {
+	var records [][]Tag
+	handler := NewCardinalityLimitingHandler(
+		&recordingHandler{records: &records},
+		newTestCardinalityLimiter(1, clock.NewRealTimeSource()),
+	)
+
+	nsHandler := handler.WithTags(NamespaceTag("ns"), OperationTag("op"))
+	nsHandler.WithTags(TaskQueueTag("a")).Counter("m").Record(1)
+	nsHandler.WithTags(TaskQueueTag("b")).Timer("m").Record(time.Second)
+	nsHandler.Counter("m").Record(1, TaskQueueTag("c"))
+	nsHandler.Counter("m").Record(1, TaskQueueTag("a"))
+	nsHandler.Counter("m").Record(1)
+
+	require.Equal(t, [][]Tag{
+		{NamespaceTag("ns"), OperationTag("op"), TaskQueueTag("a")},
+		{NamespaceTag("ns"), OperationTag("op"), TaskQueueTag(taskQueueTagOverflowValue)},
+		{NamespaceTag("ns"), OperationTag("op"), TaskQueueTag(taskQueueTagOverflowValue)},
+		{NamespaceTag("ns"), OperationTag("op"), TaskQueueTag("a")},
+		{NamespaceTag("ns"), OperationTag("op")},
+	}, records)
+}
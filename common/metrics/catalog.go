@@ -0,0 +1,153 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+)
+
+// CatalogHandlerPath is the path the metrics catalog is served at next to the Prometheus metrics.
+const CatalogHandlerPath = "/metrics-catalog"
+
+// MetricType supported values
+const (
+	MetricTypeCounter   MetricType = "counter"
+	MetricTypeGauge     MetricType = "gauge"
+	MetricTypeTimer     MetricType = "timer"
+	MetricTypeHistogram MetricType = "histogram"
+)
+
+type (
+	MetricType string
+
+	// MetricDefinitionOption documents a metric definition in the catalog.
+	MetricDefinitionOption func(*metricDefinition)
+
+	// CatalogEntry describes a metric the server can emit.
+	CatalogEntry struct {
+		Name        string     `json:"name"`
+		Type        MetricType `json:"type"`
+		Unit        MetricUnit `json:"unit,omitempty"`
+		Description string     `json:"description,omitempty"`
+		// Tags are the keys of the tags the metric is emitted with, in addition to the global tags of the
+		// server. They are only known for the metrics declaring them.
+		Tags []string `json:"tags,omitempty"`
+	}
+)
+
+var catalog = struct {
+	sync.Mutex
+	definitions map[string]metricDefinition
+}{
+	definitions: make(map[string]metricDefinition),
+}
+
+// WithDescription sets the description of the metric in the catalog.
+func WithDescription(description string) MetricDefinitionOption {
+	return func(md *metricDefinition) {
+		md.description = description
+	}
+}
+
+// WithTagKeys sets the keys of the tags the metric is emitted with in the catalog.
+func WithTagKeys(keys ...string) MetricDefinitionOption {
+	return func(md *metricDefinition) {
+		md.tagKeys = keys
+	}
+}
+
+// register adds the definition to the catalog. Definitions with the same name must have the same type and unit,
+// as the metrics backends can't tell them apart.
+func register(md metricDefinition, opts []MetricDefinitionOption) metricDefinition {
+	for _, opt := range opts {
+		opt(&md)
+	}
+
+	catalog.Lock()
+	defer catalog.Unlock()
+	if existing, ok := catalog.definitions[md.name]; ok {
+		if existing.metricType != md.metricType || existing.unit != md.unit {
+			panic(fmt.Sprintf("metric %q is defined as both a %s and a %s", md.name, existing.metricType, md.metricType))
+		}
+		if md.description == "" {
+			md.description = existing.description
+		}
+		if len(md.tagKeys) == 0 {
+			md.tagKeys = existing.tagKeys
+		}
+	}
+	catalog.definitions[md.name] = md
+	return md
+}
+
+func (md metricDefinition) GetMetricType() MetricType {
+	return md.metricType
+}
+
+func (md metricDefinition) GetDescription() string {
+	return md.description
+}
+
+// Catalog returns the metrics the server can emit, sorted by name.
+func Catalog() []CatalogEntry {
+	catalog.Lock()
+	defer catalog.Unlock()
+	entries := make([]CatalogEntry, 0, len(catalog.definitions))
+	for _, md := range catalog.definitions {
+		entries = append(entries, CatalogEntry{
+			Name:        md.name,
+			Type:        md.metricType,
+			Unit:        md.unit,
+			Description: md.description,
+			Tags:        append([]string(nil), md.tagKeys...),
+		})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+	return entries
+}
+
+// NewCatalogHandler returns an HTTP handler serving the metrics catalog as JSON.
+func NewCatalogHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodHead {
+			return
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		_ = encoder.Encode(Catalog())
+	})
+}
@@ -0,0 +1,85 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalog(t *testing.T) {
+	entries := Catalog()
+	require.True(t, sort.SliceIsSorted(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	}))
+
+	byName := make(map[string]CatalogEntry, len(entries))
+	for _, entry := range entries {
+		require.NotEmpty(t, entry.Type, entry.Name)
+		byName[entry.Name] = entry
+	}
+	require.Equal(t, CatalogEntry{
+		Name:        "persistence_latency",
+		Type:        MetricTypeTimer,
+		Unit:        Milliseconds,
+		Description: "Latency of persistence requests.",
+		Tags:        []string{OperationTagName, namespace},
+	}, byName[PersistenceLatency.GetMetricName()])
+	require.Equal(t, MetricTypeCounter, byName[ServiceRequests.GetMetricName()].Type)
+	require.Equal(t, MetricTypeGauge, byName[ServicePendingRequests.GetMetricName()].Type)
+	require.Equal(t, MetricUnit(Bytes), byName[HistorySize.GetMetricName()].Unit)
+	require.Equal(t, MetricTypeHistogram, byName[HistorySize.GetMetricName()].Type)
+}
+
+func TestCatalog_ConflictingDefinitions(t *testing.T) {
+	NewCounterDef("catalog_test_conflicting_definitions", WithDescription("first"))
+	NewCounterDef("catalog_test_conflicting_definitions")
+	require.Equal(t, "first", catalog.definitions["catalog_test_conflicting_definitions"].description)
+
+	require.Panics(t, func() {
+		NewGaugeDef("catalog_test_conflicting_definitions")
+	})
+}
+
+func TestCatalogHandler(t *testing.T) {
+	handler := NewCatalogHandler()
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, CatalogHandlerPath, nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+	var entries []CatalogEntry
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &entries))
+	require.Equal(t, Catalog(), entries)
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, CatalogHandlerPath, nil))
+	require.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
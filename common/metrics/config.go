@@ -52,6 +52,8 @@ type (
 		Statsd *StatsdConfig `yaml:"statsd"`
 		// Prometheus is the configuration for prometheus reporter
 		Prometheus *PrometheusConfig `yaml:"prometheus"`
+		// OTLP is the configuration for exporting metrics to an OpenTelemetry collector over OTLP/gRPC
+		OTLP *OTLPConfig `yaml:"otlp"`
 	}
 
 	ClientConfig struct {
@@ -98,6 +100,28 @@ type (
 		TagSeparator string `yaml:"tagSeparator"`
 	}
 
+	// OTLPConfig contains the config items for the OTLP/gRPC metrics exporter. Metrics exported over OTLP
+	// are recorded with the OpenTelemetry framework, which the Prometheus reporter shares if it is configured
+	// with the opentelemetry framework.
+	OTLPConfig struct {
+		// Endpoint is the host and port of the OTLP/gRPC receiver, e.g. an OpenTelemetry collector
+		Endpoint string `yaml:"endpoint" validate:"nonzero"`
+		// Insecure disables transport security
+		Insecure bool `yaml:"insecure"`
+		// Headers are sent with every export request, e.g. for authentication
+		Headers map[string]string `yaml:"headers"`
+		// Timeout of each export request. Defaults to 10 seconds.
+		Timeout time.Duration `yaml:"timeout"`
+		// Interval between exports. Defaults to 1 minute.
+		Interval time.Duration `yaml:"interval"`
+		// Temporality of counters and histograms, either "cumulative" or "delta". Up-down counters and
+		// gauges are always cumulative. Defaults to cumulative.
+		Temporality string `yaml:"temporality"`
+		// ResourceAttributes are set on the resource of the exported metrics, e.g. service.name or
+		// deployment.environment. Unlike Tags, they are not added to each data point.
+		ResourceAttributes map[string]string `yaml:"resourceAttributes"`
+	}
+
 	// PrometheusConfig is a new format for config for prometheus metrics.
 	PrometheusConfig struct {
 		// Metric framework: Tally/OpenTelemetry
@@ -186,6 +210,12 @@ const (
 	FrameworkOpentelemetry = "opentelemetry"
 )
 
+// Supported OTLP temporalities
+const (
+	TemporalityCumulative = "cumulative"
+	TemporalityDelta      = "delta"
+)
+
 // Valid unit name for PerUnitHistogramBoundaries config field
 const (
 	UnitNameDimensionless = "dimensionless"
@@ -455,8 +485,15 @@ func MetricsHandlerFromConfig(logger log.Logger, c *Config) Handler {
 
 	setDefaultPerUnitHistogramBoundaries(&c.ClientConfig)
 
-	if c.Prometheus != nil && c.Prometheus.Framework == FrameworkOpentelemetry {
-		otelProvider, err := NewOpenTelemetryProvider(logger, c.Prometheus, &c.ClientConfig)
+	otelPrometheus := c.Prometheus != nil && c.Prometheus.Framework == FrameworkOpentelemetry
+	if otelPrometheus || c.OTLP != nil {
+		var prometheusConfig *PrometheusConfig
+		if otelPrometheus {
+			prometheusConfig = c.Prometheus
+		} else if c.Prometheus != nil || c.Statsd != nil || c.M3 != nil {
+			logger.Warn("OTLP metrics exporter is configured, tally reporters are ignored. Use the opentelemetry framework for prometheus to keep it.")
+		}
+		otelProvider, err := NewOpenTelemetryProvider(logger, prometheusConfig, c.OTLP, &c.ClientConfig)
 		if err != nil {
 			logger.Fatal(err.Error())
 		}
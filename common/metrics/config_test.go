@@ -32,6 +32,8 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally/v4"
 	"github.com/uber-go/tally/v4/m3"
+	sdkmetrics "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 
 	"go.temporal.io/server/common/log"
 )
@@ -123,6 +125,41 @@ func (s *MetricsSuite) TestPrometheusWithSanitizeOptions() {
 	s.NotNil(scope)
 }
 
+func (s *MetricsSuite) TestOTLP() {
+	config := &Config{
+		OTLP: &OTLPConfig{
+			Endpoint:    "127.0.0.1:4317",
+			Insecure:    true,
+			Temporality: TemporalityDelta,
+			ResourceAttributes: map[string]string{
+				"service.name": "temporal",
+			},
+		},
+	}
+	handler := MetricsHandlerFromConfig(log.NewNoopLogger(), config)
+	s.IsType(&otelMetricsHandler{}, handler)
+
+	res := newOTLPResource(config.OTLP)
+	serviceName, ok := res.Set().Value("service.name")
+	s.True(ok)
+	s.Equal("temporal", serviceName.AsString())
+}
+
+func (s *MetricsSuite) TestOTLPTemporality() {
+	selector, err := otlpTemporalitySelector("")
+	s.NoError(err)
+	s.Equal(metricdata.CumulativeTemporality, selector(sdkmetrics.InstrumentKindCounter))
+
+	selector, err = otlpTemporalitySelector(TemporalityDelta)
+	s.NoError(err)
+	s.Equal(metricdata.DeltaTemporality, selector(sdkmetrics.InstrumentKindCounter))
+	s.Equal(metricdata.DeltaTemporality, selector(sdkmetrics.InstrumentKindHistogram))
+	s.Equal(metricdata.CumulativeTemporality, selector(sdkmetrics.InstrumentKindUpDownCounter))
+
+	_, err = otlpTemporalitySelector("monthly")
+	s.Error(err)
+}
+
 func (s *MetricsSuite) TestNoop() {
 	config := &Config{}
 	scope := NewScope(log.NewNoopLogger(), config)
@@ -31,8 +31,11 @@ type (
 
 	// metricDefinition contains the definition for a metric
 	metricDefinition struct {
-		name string
-		unit MetricUnit
+		name        string
+		metricType  MetricType
+		unit        MetricUnit
+		description string
+		tagKeys     []string
 	}
 )
 
@@ -52,22 +55,22 @@ func (md metricDefinition) GetMetricUnit() MetricUnit {
 	return md.unit
 }
 
-func NewTimerDef(name string) metricDefinition {
-	return metricDefinition{name: name, unit: Milliseconds}
+func NewTimerDef(name string, opts ...MetricDefinitionOption) metricDefinition {
+	return register(metricDefinition{name: name, metricType: MetricTypeTimer, unit: Milliseconds}, opts)
 }
 
-func NewBytesHistogramDef(name string) metricDefinition {
-	return metricDefinition{name: name, unit: Bytes}
+func NewBytesHistogramDef(name string, opts ...MetricDefinitionOption) metricDefinition {
+	return register(metricDefinition{name: name, metricType: MetricTypeHistogram, unit: Bytes}, opts)
 }
 
-func NewDimensionlessHistogramDef(name string) metricDefinition {
-	return metricDefinition{name: name, unit: Dimensionless}
+func NewDimensionlessHistogramDef(name string, opts ...MetricDefinitionOption) metricDefinition {
+	return register(metricDefinition{name: name, metricType: MetricTypeHistogram, unit: Dimensionless}, opts)
 }
 
-func NewCounterDef(name string) metricDefinition {
-	return metricDefinition{name: name}
+func NewCounterDef(name string, opts ...MetricDefinitionOption) metricDefinition {
+	return register(metricDefinition{name: name, metricType: MetricTypeCounter}, opts)
 }
 
-func NewGaugeDef(name string) metricDefinition {
-	return metricDefinition{name: name}
+func NewGaugeDef(name string, opts ...MetricDefinitionOption) metricDefinition {
+	return register(metricDefinition{name: name, metricType: MetricTypeGauge}, opts)
 }
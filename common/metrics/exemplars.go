@@ -0,0 +1,246 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// exemplarTagKey is the key of the tag carrying the trace a measurement is recorded for. It is never
+	// reported as a tag.
+	exemplarTagKey = "__exemplar__"
+
+	exemplarTraceIDLabel = "trace_id"
+	exemplarSpanIDLabel  = "span_id"
+)
+
+type (
+	exemplarTag struct {
+		spanContext trace.SpanContext
+	}
+
+	// exemplarStore holds the latest exemplar of each bucket of the histograms exposed to Prometheus.
+	exemplarStore struct {
+		boundaries map[string][]float64
+
+		sync.Mutex
+		// series of each metric family, by the distinct attributes of the series
+		series map[string]map[attribute.Distinct]*exemplarSeries
+	}
+
+	exemplarSeries struct {
+		attributes attribute.Set
+		// exemplars by bucket upper bound
+		exemplars map[float64]*dto.Exemplar
+	}
+
+	// exemplarGatherer attaches the exemplars of the store to the histogram buckets gathered from Prometheus.
+	exemplarGatherer struct {
+		prometheus.Gatherer
+		store *exemplarStore
+	}
+)
+
+// prometheus exporter unit suffixes, see go.opentelemetry.io/otel/exporters/prometheus
+var exemplarUnitSuffixes = map[string]string{
+	Dimensionless: "_ratio",
+	Bytes:         "_bytes",
+	Milliseconds:  "_milliseconds",
+}
+
+// WithExemplar returns the tags with one attaching the sampled trace of the context, if any, as an exemplar of
+// the latency recorded with them. Only timers and histograms of the OpenTelemetry framework report exemplars,
+// and only to Prometheus scrapes using the OpenMetrics format. Other handlers ignore it.
+func WithExemplar(ctx context.Context, tags ...Tag) []Tag {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() || !spanContext.IsSampled() {
+		return tags
+	}
+	return append(tags[:len(tags):len(tags)], exemplarTag{spanContext: spanContext})
+}
+
+func (t exemplarTag) Key() string {
+	return exemplarTagKey
+}
+
+func (t exemplarTag) Value() string {
+	return t.spanContext.TraceID().String()
+}
+
+// splitExemplar returns the tags without the exemplar tag, and the span context of the exemplar tag.
+func splitExemplar(tags []Tag) ([]Tag, trace.SpanContext) {
+	for i, tag := range tags {
+		if exemplar, ok := tag.(exemplarTag); ok {
+			rest := make([]Tag, 0, len(tags)-1)
+			rest = append(rest, tags[:i]...)
+			rest = append(rest, tags[i+1:]...)
+			return rest, exemplar.spanContext
+		}
+	}
+	return tags, trace.SpanContext{}
+}
+
+func newExemplarStore(boundaries map[string][]float64) *exemplarStore {
+	return &exemplarStore{
+		boundaries: boundaries,
+		series:     make(map[string]map[attribute.Distinct]*exemplarSeries),
+	}
+}
+
+func (s *exemplarStore) record(
+	name string,
+	unit string,
+	attributes []attribute.KeyValue,
+	value float64,
+	spanContext trace.SpanContext,
+	now time.Time,
+) {
+	boundaries := s.boundaries[unit]
+	i := sort.SearchFloat64s(boundaries, value)
+	if i == len(boundaries) {
+		// the +Inf bucket isn't part of the gathered buckets
+		return
+	}
+	upperBound := boundaries[i]
+	exemplar := &dto.Exemplar{
+		Label: []*dto.LabelPair{
+			{Name: stringPtr(exemplarTraceIDLabel), Value: stringPtr(spanContext.TraceID().String())},
+			{Name: stringPtr(exemplarSpanIDLabel), Value: stringPtr(spanContext.SpanID().String())},
+		},
+		Value:     &value,
+		Timestamp: timestamppb.New(now),
+	}
+	attributeSet := attribute.NewSet(attributes...)
+	familyName := sanitizePrometheusName(name) + exemplarUnitSuffixes[unit]
+
+	s.Lock()
+	defer s.Unlock()
+	familySeries, ok := s.series[familyName]
+	if !ok {
+		familySeries = make(map[attribute.Distinct]*exemplarSeries)
+		s.series[familyName] = familySeries
+	}
+	series, ok := familySeries[attributeSet.Equivalent()]
+	if !ok {
+		series = &exemplarSeries{attributes: attributeSet, exemplars: make(map[float64]*dto.Exemplar)}
+		familySeries[attributeSet.Equivalent()] = series
+	}
+	series.exemplars[upperBound] = exemplar
+}
+
+func (s *exemplarStore) attach(families []*dto.MetricFamily) {
+	s.Lock()
+	defer s.Unlock()
+	for _, family := range families {
+		if family.GetType() != dto.MetricType_HISTOGRAM {
+			continue
+		}
+		familySeries, ok := s.series[family.GetName()]
+		if !ok {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, series := range familySeries {
+				if !series.matches(metric.GetLabel()) {
+					continue
+				}
+				for _, bucket := range metric.GetHistogram().GetBucket() {
+					if exemplar, ok := series.exemplars[bucket.GetUpperBound()]; ok {
+						bucket.Exemplar = exemplar
+					}
+				}
+			}
+		}
+	}
+}
+
+func (s *exemplarSeries) matches(labels []*dto.LabelPair) bool {
+	values := make(map[string]string, len(labels))
+	for _, label := range labels {
+		if label.GetName() == "otel_scope_name" || label.GetName() == "otel_scope_version" {
+			// added by the exporter to every metric
+			continue
+		}
+		values[label.GetName()] = label.GetValue()
+	}
+	if len(values) != s.attributes.Len() {
+		return false
+	}
+	iter := s.attributes.Iter()
+	for iter.Next() {
+		kv := iter.Attribute()
+		if value, ok := values[sanitizePrometheusLabel(string(kv.Key))]; !ok || value != kv.Value.Emit() {
+			return false
+		}
+	}
+	return true
+}
+
+func (g exemplarGatherer) Gather() ([]*dto.MetricFamily, error) {
+	families, err := g.Gatherer.Gather()
+	g.store.attach(families)
+	return families, err
+}
+
+// sanitizePrometheusName replaces the characters which are not valid in Prometheus metric names the way the
+// OpenTelemetry Prometheus exporter does.
+func sanitizePrometheusName(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || r == '_' || r == ':' || (r >= '0' && r <= '9' && i > 0) {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+	return b.String()
+}
+
+// sanitizePrometheusLabel replaces the characters which are not valid in Prometheus label names the way the
+// OpenTelemetry Prometheus exporter does.
+func sanitizePrometheusLabel(label string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == ':' || r == '_' {
+			return r
+		}
+		return '_'
+	}, label)
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,140 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+	exporters "go.opentelemetry.io/otel/exporters/prometheus"
+	sdkmetrics "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/trace"
+
+	"go.temporal.io/server/common/log"
+)
+
+func newSampledContext(t *testing.T, sampled bool) (context.Context, trace.SpanContext) {
+	t.Helper()
+	config := trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x01, 0x02, 0x03},
+		SpanID:  trace.SpanID{0x04, 0x05},
+	}
+	if sampled {
+		config.TraceFlags = trace.FlagsSampled
+	}
+	spanContext := trace.NewSpanContext(config)
+	return trace.ContextWithSpanContext(context.Background(), spanContext), spanContext
+}
+
+func TestWithExemplar(t *testing.T) {
+	tags := []Tag{OperationTag("op")}
+
+	require.Equal(t, tags, WithExemplar(context.Background(), tags...))
+
+	ctx, _ := newSampledContext(t, false)
+	require.Equal(t, tags, WithExemplar(ctx, tags...))
+
+	ctx, spanContext := newSampledContext(t, true)
+	withExemplar := WithExemplar(ctx, tags...)
+	require.Len(t, withExemplar, 2)
+	require.Len(t, tags, 1)
+	rest, exemplarSpanContext := splitExemplar(withExemplar)
+	require.Equal(t, tags, rest)
+	require.Equal(t, spanContext, exemplarSpanContext)
+
+	require.Equal(t, map[string]string{"operation": "op"}, tagsToMap(withExemplar, nil))
+	require.Len(t, tagsToAttributes(nil, withExemplar, nil), 1)
+}
+
+func TestExemplars(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	exporter, err := exporters.New(exporters.WithRegisterer(reg))
+	require.NoError(t, err)
+	boundaries := defaultConfig.PerUnitHistogramBoundaries[Milliseconds]
+	meterProvider := sdkmetrics.NewMeterProvider(
+		sdkmetrics.WithReader(exporter),
+		sdkmetrics.WithView(sdkmetrics.NewView(
+			sdkmetrics.Instrument{Kind: sdkmetrics.InstrumentKindHistogram, Unit: Milliseconds},
+			sdkmetrics.Stream{Aggregation: aggregation.ExplicitBucketHistogram{Boundaries: boundaries}},
+		)),
+	)
+	store := newExemplarStore(defaultConfig.PerUnitHistogramBoundaries)
+	provider := &openTelemetryProviderImpl{
+		meter:     meterProvider.Meter("test"),
+		provider:  meterProvider,
+		exemplars: store,
+	}
+	handler := NewOtelMetricsHandler(log.NewTestLogger(), provider, defaultConfig)
+
+	sampledCtx, spanContext := newSampledContext(t, true)
+	timer := handler.WithTags(OperationTag("op")).Timer("latency")
+	timer.Record(3*time.Millisecond, WithExemplar(sampledCtx)...)
+	// measurements without a sampled trace don't replace the exemplar
+	timer.Record(3*time.Millisecond, WithExemplar(context.Background())...)
+	// measurements above the last boundary have no exemplar
+	timer.Record(time.Duration(boundaries[len(boundaries)-1]+1)*time.Millisecond, WithExemplar(sampledCtx)...)
+	handler.WithTags(OperationTag("other")).Timer("latency").Record(time.Millisecond)
+
+	families, err := exemplarGatherer{Gatherer: reg, store: store}.Gather()
+	require.NoError(t, err)
+	var family *dto.MetricFamily
+	for _, f := range families {
+		if f.GetName() == "latency_milliseconds" {
+			family = f
+		}
+	}
+	require.NotNil(t, family)
+	require.Len(t, family.GetMetric(), 2)
+
+	exemplarBound := boundaries[sort.SearchFloat64s(boundaries, 3)]
+	for _, m := range family.GetMetric() {
+		var operation string
+		for _, label := range m.GetLabel() {
+			require.NotEqual(t, exemplarTagKey, label.GetName())
+			if label.GetName() == "operation" {
+				operation = label.GetValue()
+			}
+		}
+		for _, bucket := range m.GetHistogram().GetBucket() {
+			if operation != "op" || bucket.GetUpperBound() != exemplarBound {
+				require.Nil(t, bucket.GetExemplar())
+				continue
+			}
+			exemplar := bucket.GetExemplar()
+			require.NotNil(t, exemplar)
+			require.Equal(t, float64(3), exemplar.GetValue())
+			require.Equal(t, []*dto.LabelPair{
+				{Name: stringPtr(exemplarTraceIDLabel), Value: stringPtr(spanContext.TraceID().String())},
+				{Name: stringPtr(exemplarSpanIDLabel), Value: stringPtr(spanContext.SpanID().String())},
+			}, exemplar.GetLabel())
+		}
+	}
+}
@@ -31,6 +31,9 @@ const (
 	ServiceRoleTagName         = "service_role"
 	CacheTypeTagName           = "cache_type"
 	FailureTagName             = "failure"
+	FailureCauseTagName        = "failure_cause"
+	StorageTypeTagName         = "storage_type"
+	PersistenceStoreTagName    = "persistence_store"
 	TaskCategoryTagName        = "task_category"
 	TaskTypeTagName            = "task_type"
 	TaskPriorityTagName        = "task_priority"
@@ -39,8 +42,13 @@ const (
 	QueueTypeTagName           = "queue_type"
 	visibilityTypeTagName      = "visibility_type"
 	ErrorTypeTagName           = "error_type"
+	WorkflowStatusTagName      = "workflow_status"
+	FailureSourceTagName       = "failure_source"
+	TimeoutTypeTagName         = "timeout_type"
+	TerminateReasonTagName     = "terminate_reason"
 	httpStatusTagName          = "http_status"
 	resourceExhaustedTag       = "resource_exhausted_cause"
+	BuildIdTagName             = "build_id"
 	standardVisibilityTagValue = "standard_visibility"
 	advancedVisibilityTagValue = "advanced_visibility"
 )
@@ -57,6 +65,8 @@ const (
 	MutableStateCacheTypeTagValue = "mutablestate"
 	EventsCacheTypeTagValue       = "events"
 
+	CurrentExecutionCacheTypeTagValue = "currentexecution"
+
 	InvalidHistoryURITagValue    = "invalid_history_uri"
 	InvalidVisibilityURITagValue = "invalid_visibility_uri"
 )
@@ -131,6 +141,112 @@ const (
 	AdminClientGetTaskQueueTasksScope = "AdminClientGetTaskQueueTasks"
 	// AdminClientDeleteWorkflowExecutionScope tracks RPC calls to admin service
 	AdminClientDeleteWorkflowExecutionScope = "AdminClientDeleteWorkflowExecution"
+	// AdminClientDescribeTaskQueueTopologyScope tracks RPC calls to admin service
+	AdminClientDescribeTaskQueueTopologyScope = "AdminClientDescribeTaskQueueTopology"
+	// AdminClientUpdateWorkflowExecutionMemoScope tracks RPC calls to admin service
+	AdminClientUpdateWorkflowExecutionMemoScope = "AdminClientUpdateWorkflowExecutionMemo"
+	// AdminClientUpsertWorkflowExecutionSearchAttributesScope tracks RPC calls to admin service
+	AdminClientUpsertWorkflowExecutionSearchAttributesScope = "AdminClientUpsertWorkflowExecutionSearchAttributes"
+	// AdminClientStreamDatabaseBackupScope tracks RPC calls to admin service
+	AdminClientStreamDatabaseBackupScope = "AdminClientStreamDatabaseBackup"
+	// AdminClientDescribePersistenceCircuitBreakersScope tracks RPC calls to admin service
+	AdminClientDescribePersistenceCircuitBreakersScope = "AdminClientDescribePersistenceCircuitBreakers"
+	// AdminClientCreateClusterSnapshotScope tracks RPC calls to admin service
+	AdminClientCreateClusterSnapshotScope = "AdminClientCreateClusterSnapshot"
+	// AdminClientRestoreClusterSnapshotScope tracks RPC calls to admin service
+	AdminClientRestoreClusterSnapshotScope = "AdminClientRestoreClusterSnapshot"
+	// AdminClientCheckVisibilityConsistencyScope tracks RPC calls to admin service
+	AdminClientCheckVisibilityConsistencyScope = "AdminClientCheckVisibilityConsistency"
+	// AdminClientListTopPersistenceNamespacesScope tracks RPC calls to admin service
+	AdminClientListTopPersistenceNamespacesScope = "AdminClientListTopPersistenceNamespaces"
+	// AdminClientAddSearchAttributeAliasesScope tracks RPC calls to admin service
+	AdminClientAddSearchAttributeAliasesScope = "AdminClientAddSearchAttributeAliases"
+	// AdminClientDescribeVisibilityIngestionScope tracks RPC calls to admin service
+	AdminClientDescribeVisibilityIngestionScope = "AdminClientDescribeVisibilityIngestion"
+	// AdminClientListArchivalDLQTasksScope tracks RPC calls to admin service
+	AdminClientListArchivalDLQTasksScope = "AdminClientListArchivalDLQTasks"
+	// AdminClientRetryArchivalDLQTaskScope tracks RPC calls to admin service
+	AdminClientRetryArchivalDLQTaskScope = "AdminClientRetryArchivalDLQTask"
+	// AdminClientRehydrateWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientRehydrateWorkflowExecutionScope = "AdminClientRehydrateWorkflowExecution"
+	// AdminClientDescribeScheduleBackfillsScope tracks RPC calls to admin service
+	AdminClientDescribeScheduleBackfillsScope = "AdminClientDescribeScheduleBackfills"
+	// AdminClientCancelScheduleBackfillScope tracks RPC calls to admin service
+	AdminClientCancelScheduleBackfillScope = "AdminClientCancelScheduleBackfill"
+	// AdminClientDescribeSchedulePauseAfterFailuresScope tracks RPC calls to admin service
+	AdminClientDescribeSchedulePauseAfterFailuresScope = "AdminClientDescribeSchedulePauseAfterFailures"
+	// AdminClientUpdateSchedulePauseAfterFailuresScope tracks RPC calls to admin service
+	AdminClientUpdateSchedulePauseAfterFailuresScope = "AdminClientUpdateSchedulePauseAfterFailures"
+	// AdminClientDeleteHistoryBranchGarbageScope tracks RPC calls to admin service
+	AdminClientDeleteHistoryBranchGarbageScope = "AdminClientDeleteHistoryBranchGarbage"
+	// AdminClientDescribeNamespaceDeletionScope tracks RPC calls to admin service
+	AdminClientDescribeNamespaceDeletionScope = "AdminClientDescribeNamespaceDeletion"
+	// AdminClientUpdateNamespaceDeletionRateScope tracks RPC calls to admin service
+	AdminClientUpdateNamespaceDeletionRateScope = "AdminClientUpdateNamespaceDeletionRate"
+	// AdminClientStartNamespaceExportScope tracks RPC calls to admin service
+	AdminClientStartNamespaceExportScope = "AdminClientStartNamespaceExport"
+	// AdminClientCreateAPIKeyScope tracks RPC calls to admin service
+	AdminClientCreateAPIKeyScope = "AdminClientCreateAPIKey"
+	// AdminClientRotateAPIKeyScope tracks RPC calls to admin service
+	AdminClientRotateAPIKeyScope = "AdminClientRotateAPIKey"
+	// AdminClientRevokeAPIKeyScope tracks RPC calls to admin service
+	AdminClientRevokeAPIKeyScope = "AdminClientRevokeAPIKey"
+	// AdminClientListAPIKeysScope tracks RPC calls to admin service
+	AdminClientListAPIKeysScope = "AdminClientListAPIKeys"
+	// AdminClientPutRoleScope tracks RPC calls to admin service
+	AdminClientPutRoleScope = "AdminClientPutRole"
+	// AdminClientDeleteRoleScope tracks RPC calls to admin service
+	AdminClientDeleteRoleScope = "AdminClientDeleteRole"
+	// AdminClientListRolesScope tracks RPC calls to admin service
+	AdminClientListRolesScope = "AdminClientListRoles"
+	// AdminClientSetGroupRolesScope tracks RPC calls to admin service
+	AdminClientSetGroupRolesScope = "AdminClientSetGroupRoles"
+	// AdminClientGetGroupRolesScope tracks RPC calls to admin service
+	AdminClientGetGroupRolesScope = "AdminClientGetGroupRoles"
+	// AdminClientGetDynamicConfigOverridesScope tracks RPC calls to admin service
+	AdminClientGetDynamicConfigOverridesScope = "AdminClientGetDynamicConfigOverrides"
+	// AdminClientSetDynamicConfigOverrideScope tracks RPC calls to admin service
+	AdminClientSetDynamicConfigOverrideScope = "AdminClientSetDynamicConfigOverride"
+	// AdminClientDeleteDynamicConfigOverrideScope tracks RPC calls to admin service
+	AdminClientDeleteDynamicConfigOverrideScope = "AdminClientDeleteDynamicConfigOverride"
+	// AdminClientListDynamicConfigChangesScope tracks RPC calls to admin service
+	AdminClientListDynamicConfigChangesScope = "AdminClientListDynamicConfigChanges"
+	// AdminClientGetEffectiveDynamicConfigScope tracks RPC calls to admin service
+	AdminClientGetEffectiveDynamicConfigScope = "AdminClientGetEffectiveDynamicConfig"
+	// AdminClientSetDynamicConfigRolloutScope tracks RPC calls to admin service
+	AdminClientSetDynamicConfigRolloutScope = "AdminClientSetDynamicConfigRollout"
+	// AdminClientGetNamespaceFeatureFlagsScope tracks RPC calls to admin service
+	AdminClientGetNamespaceFeatureFlagsScope = "AdminClientGetNamespaceFeatureFlags"
+	// AdminClientGetNamespaceVisibilityRetentionScope tracks RPC calls to admin service
+	AdminClientGetNamespaceVisibilityRetentionScope = "AdminClientGetNamespaceVisibilityRetention"
+	// AdminClientUpdateNamespaceVisibilityRetentionScope tracks RPC calls to admin service
+	AdminClientUpdateNamespaceVisibilityRetentionScope = "AdminClientUpdateNamespaceVisibilityRetention"
+	// AdminClientStreamDiagnosticsBundleScope tracks RPC calls to admin service
+	AdminClientStreamDiagnosticsBundleScope = "AdminClientStreamDiagnosticsBundle"
+	// AdminClientStartDrainScope tracks RPC calls to admin service
+	AdminClientStartDrainScope = "AdminClientStartDrain"
+	// AdminClientCancelDrainScope tracks RPC calls to admin service
+	AdminClientCancelDrainScope = "AdminClientCancelDrain"
+	// AdminClientDescribeDrainScope tracks RPC calls to admin service
+	AdminClientDescribeDrainScope = "AdminClientDescribeDrain"
+	// AdminClientGetComponentHealthScope tracks RPC calls to admin service
+	AdminClientGetComponentHealthScope = "AdminClientGetComponentHealth"
+	// AdminClientListMetricsScope tracks RPC calls to admin service
+	AdminClientListMetricsScope = "AdminClientListMetrics"
+	// AdminClientDescribeShardDistributionScope tracks RPC calls to admin service
+	AdminClientDescribeShardDistributionScope = "AdminClientDescribeShardDistribution"
+	// AdminClientDescribeShardScope tracks RPC calls to admin service
+	AdminClientDescribeShardScope = "AdminClientDescribeShard"
+	// AdminClientImportWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientImportWorkflowExecutionScope = "AdminClientImportWorkflowExecution"
+	// AdminClientDescribeNamespaceStatsScope tracks RPC calls to admin service
+	AdminClientDescribeNamespaceStatsScope = "AdminClientDescribeNamespaceStats"
+	// AdminClientDescribeNamespaceReplicationStatusScope tracks RPC calls to admin service
+	AdminClientDescribeNamespaceReplicationStatusScope = "AdminClientDescribeNamespaceReplicationStatus"
+	// AdminClientStartBatchOperationScope tracks RPC calls to admin service
+	AdminClientStartBatchOperationScope = "AdminClientStartBatchOperation"
+	// AdminClientGetSDKUsageScope tracks RPC calls to admin service
+	AdminClientGetSDKUsageScope = "AdminClientGetSDKUsage"
 
 	// AdminDescribeHistoryHostScope is the metric scope for admin.AdminDescribeHistoryHost
 	AdminDescribeHistoryHostScope = "AdminDescribeHistoryHost"
@@ -188,6 +304,48 @@ const (
 	AdminDeleteWorkflowExecutionScope = "AdminDeleteWorkflowExecution"
 	// AdminStreamWorkflowReplicationMessagesScope is the metric scope for admin.AdminStreamReplicationMessages
 	AdminStreamWorkflowReplicationMessagesScope = "AdminStreamWorkflowReplicationMessages"
+	// AdminStreamDatabaseBackupScope is the metric scope for admin.StreamDatabaseBackup
+	AdminStreamDatabaseBackupScope = "AdminStreamDatabaseBackup"
+	// AdminCreateClusterSnapshotScope is the metric scope for admin.CreateClusterSnapshot
+	AdminCreateClusterSnapshotScope = "AdminCreateClusterSnapshot"
+	// AdminRestoreClusterSnapshotScope is the metric scope for admin.RestoreClusterSnapshot
+	AdminRestoreClusterSnapshotScope = "AdminRestoreClusterSnapshot"
+	// AdminCheckVisibilityConsistencyScope is the metric scope for admin.CheckVisibilityConsistency
+	AdminCheckVisibilityConsistencyScope = "AdminCheckVisibilityConsistency"
+	// AdminListTopPersistenceNamespacesScope is the metric scope for admin.ListTopPersistenceNamespaces
+	AdminListTopPersistenceNamespacesScope = "AdminListTopPersistenceNamespaces"
+	// AdminAddSearchAttributeAliasesScope is the metric scope for admin.AddSearchAttributeAliases
+	AdminAddSearchAttributeAliasesScope = "AdminAddSearchAttributeAliases"
+	// AdminDescribePersistenceCircuitBreakersScope is the metric scope for admin.DescribePersistenceCircuitBreakers
+	AdminDescribePersistenceCircuitBreakersScope = "AdminDescribePersistenceCircuitBreakers"
+	// AdminListArchivalDLQTasksScope is the metric scope for admin.ListArchivalDLQTasks
+	AdminListArchivalDLQTasksScope = "AdminListArchivalDLQTasks"
+	// AdminRetryArchivalDLQTaskScope is the metric scope for admin.RetryArchivalDLQTask
+	AdminRetryArchivalDLQTaskScope = "AdminRetryArchivalDLQTask"
+	// AdminStreamDiagnosticsBundleScope is the metric scope for admin.StreamDiagnosticsBundle
+	AdminStreamDiagnosticsBundleScope = "AdminStreamDiagnosticsBundle"
+	// AdminStartDrainScope is the metric scope for admin.StartDrain
+	AdminStartDrainScope = "AdminStartDrain"
+	// AdminCancelDrainScope is the metric scope for admin.CancelDrain
+	AdminCancelDrainScope = "AdminCancelDrain"
+	// AdminDescribeDrainScope is the metric scope for admin.DescribeDrain
+	AdminDescribeDrainScope = "AdminDescribeDrain"
+	// AdminDeleteHistoryBranchGarbageScope is the metric scope for admin.DeleteHistoryBranchGarbage
+	AdminDeleteHistoryBranchGarbageScope = "AdminDeleteHistoryBranchGarbage"
+	// AdminDescribeShardDistributionScope is the metric scope for admin.DescribeShardDistribution
+	AdminDescribeShardDistributionScope = "AdminDescribeShardDistribution"
+	// AdminDescribeTaskQueueTopologyScope is the metric scope for admin.DescribeTaskQueueTopology
+	AdminDescribeTaskQueueTopologyScope = "AdminDescribeTaskQueueTopology"
+	// AdminImportWorkflowExecutionScope is the metric scope for admin.ImportWorkflowExecution
+	AdminImportWorkflowExecutionScope = "AdminImportWorkflowExecution"
+	// AdminDescribeNamespaceStatsScope is the metric scope for admin.DescribeNamespaceStats
+	AdminDescribeNamespaceStatsScope = "AdminDescribeNamespaceStats"
+	// AdminDescribeNamespaceReplicationStatusScope is the metric scope for admin.DescribeNamespaceReplicationStatus
+	AdminDescribeNamespaceReplicationStatusScope = "AdminDescribeNamespaceReplicationStatus"
+	// AdminStartBatchOperationScope is the metric scope for admin.StartBatchOperation
+	AdminStartBatchOperationScope = "AdminStartBatchOperation"
+	// AdminGetSDKUsageScope is the metric scope for admin.GetSDKUsage
+	AdminGetSDKUsageScope = "AdminGetSDKUsage"
 
 	// OperatorAddSearchAttributesScope is the metric scope for operator.AddSearchAttributes
 	OperatorAddSearchAttributesScope
@@ -196,6 +354,42 @@ const (
 	// OperatorListSearchAttributesScope is the metric scope for operator.ListSearchAttributes
 	OperatorListSearchAttributesScope = "OperatorListSearchAttributes"
 	OperatorDeleteNamespaceScope      = "OperatorDeleteNamespace"
+	// OperatorDescribeNamespaceDeletionScope is the metric scope for operator.DescribeNamespaceDeletion
+	OperatorDescribeNamespaceDeletionScope = "OperatorDescribeNamespaceDeletion"
+	// OperatorUpdateNamespaceDeletionRateScope is the metric scope for operator.UpdateNamespaceDeletionRate
+	OperatorUpdateNamespaceDeletionRateScope = "OperatorUpdateNamespaceDeletionRate"
+	// OperatorStartNamespaceExportScope is the metric scope for operator.StartNamespaceExport
+	OperatorStartNamespaceExportScope = "OperatorStartNamespaceExport"
+	// OperatorCreateAPIKeyScope is the metric scope for operator.CreateAPIKey
+	OperatorCreateAPIKeyScope = "OperatorCreateAPIKey"
+	// OperatorRotateAPIKeyScope is the metric scope for operator.RotateAPIKey
+	OperatorRotateAPIKeyScope = "OperatorRotateAPIKey"
+	// OperatorRevokeAPIKeyScope is the metric scope for operator.RevokeAPIKey
+	OperatorRevokeAPIKeyScope = "OperatorRevokeAPIKey"
+	// OperatorListAPIKeysScope is the metric scope for operator.ListAPIKeys
+	OperatorListAPIKeysScope = "OperatorListAPIKeys"
+	// OperatorPutRoleScope is the metric scope for operator.PutRole
+	OperatorPutRoleScope = "OperatorPutRole"
+	// OperatorDeleteRoleScope is the metric scope for operator.DeleteRole
+	OperatorDeleteRoleScope = "OperatorDeleteRole"
+	// OperatorListRolesScope is the metric scope for operator.ListRoles
+	OperatorListRolesScope = "OperatorListRoles"
+	// OperatorSetGroupRolesScope is the metric scope for operator.SetGroupRoles
+	OperatorSetGroupRolesScope = "OperatorSetGroupRoles"
+	// OperatorGetGroupRolesScope is the metric scope for operator.GetGroupRoles
+	OperatorGetGroupRolesScope = "OperatorGetGroupRoles"
+	// OperatorGetDynamicConfigOverridesScope is the metric scope for operator.GetDynamicConfigOverrides
+	OperatorGetDynamicConfigOverridesScope = "OperatorGetDynamicConfigOverrides"
+	// OperatorSetDynamicConfigOverrideScope is the metric scope for operator.SetDynamicConfigOverride
+	OperatorSetDynamicConfigOverrideScope = "OperatorSetDynamicConfigOverride"
+	// OperatorDeleteDynamicConfigOverrideScope is the metric scope for operator.DeleteDynamicConfigOverride
+	OperatorDeleteDynamicConfigOverrideScope = "OperatorDeleteDynamicConfigOverride"
+	// OperatorSetDynamicConfigRolloutScope is the metric scope for operator.SetDynamicConfigRollout
+	OperatorSetDynamicConfigRolloutScope = "OperatorSetDynamicConfigRollout"
+	// OperatorListDynamicConfigChangesScope is the metric scope for operator.ListDynamicConfigChanges
+	OperatorListDynamicConfigChangesScope = "OperatorListDynamicConfigChanges"
+	// OperatorGetEffectiveDynamicConfigScope is the metric scope for operator.GetEffectiveDynamicConfig
+	OperatorGetEffectiveDynamicConfigScope = "OperatorGetEffectiveDynamicConfig"
 	// OperatorAddOrUpdateRemoteClusterScope is the metric scope for operator.AddOrUpdateRemoteCluster
 	OperatorAddOrUpdateRemoteClusterScope = "OperatorAddOrUpdateRemoteCluster"
 	// OperatorRemoveRemoteClusterScope is the metric scope for operator.RemoveRemoteCluster
@@ -204,6 +398,10 @@ const (
 	OperatorListClustersScope = "OperatorListClusters"
 	// OperatorDeleteWorkflowExecutionScope is the metric scope for operator.DeleteWorkflowExecution
 	OperatorDeleteWorkflowExecutionScope = "OperatorDeleteWorkflowExecution"
+	// OperatorGetComponentHealthScope is the metric scope for operator.GetComponentHealth
+	OperatorGetComponentHealthScope = "OperatorGetComponentHealth"
+	// OperatorListMetricsScope is the metric scope for operator.ListMetrics
+	OperatorListMetricsScope = "OperatorListMetrics"
 )
 
 // Frontend Client Operations
@@ -432,6 +630,18 @@ const (
 	HistoryClientGetReplicationMessagesScope = "HistoryClientGetReplicationMessages"
 	// HistoryClientStreamWorkflowReplicationMessagesScope tracks RPC calls to history service
 	HistoryClientStreamWorkflowReplicationMessagesScope = "HistoryClientStreamWorkflowReplicationMessages"
+	// HistoryClientUpdateWorkflowExecutionMemoScope tracks RPC calls to history service
+	HistoryClientUpdateWorkflowExecutionMemoScope = "HistoryClientUpdateWorkflowExecutionMemo"
+	// HistoryClientUpsertWorkflowExecutionSearchAttributesScope tracks RPC calls to history service
+	HistoryClientUpsertWorkflowExecutionSearchAttributesScope = "HistoryClientUpsertWorkflowExecutionSearchAttributes"
+	// HistoryClientDescribeVisibilityIngestionScope tracks RPC calls to history service
+	HistoryClientDescribeVisibilityIngestionScope = "HistoryClientDescribeVisibilityIngestion"
+	// HistoryClientRehydrateWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientRehydrateWorkflowExecutionScope = "HistoryClientRehydrateWorkflowExecution"
+	// HistoryClientAwaitVisibilityIngestionScope tracks RPC calls to history service
+	HistoryClientAwaitVisibilityIngestionScope = "HistoryClientAwaitVisibilityIngestion"
+	// HistoryClientDescribeShardScope tracks RPC calls to history service
+	HistoryClientDescribeShardScope = "HistoryClientDescribeShard"
 )
 
 // Matching Client Operations
@@ -478,11 +688,25 @@ const (
 	TaskQueueScavengerScope = "TaskQueueScavenger"
 	// ExecutionsScavengerScope is scope used by all metrics emitted by worker.executions.Scavenger module
 	ExecutionsScavengerScope = "ExecutionsScavenger"
+	// StorageUsageScannerScope is scope used by all metrics emitted by worker.storageusage.Scanner module
+	StorageUsageScannerScope = "StorageUsageScanner"
+	// ReencryptionScannerScope is scope used by all metrics emitted by worker.reencryption.Scanner module
+	ReencryptionScannerScope = "ReencryptionScanner"
+	// TablePartitionScannerScope is scope used by all metrics emitted by worker.tablepartition.Scanner module
+	TablePartitionScannerScope = "TablePartitionScanner"
+	// VisibilityConsistencyScannerScope is scope used by all metrics emitted by worker.visibilityconsistency.Scanner module
+	VisibilityConsistencyScannerScope = "VisibilityConsistencyScanner"
+	// VisibilityExportScannerScope is scope used by all metrics emitted by worker.visibilityexport.Scanner module
+	VisibilityExportScannerScope = "VisibilityExportScanner"
+	// ArchiveExpiryScannerScope is scope used by all metrics emitted by worker.archiveexpiry.Scanner module
+	ArchiveExpiryScannerScope = "ArchiveExpiryScanner"
 )
 
 const (
 	// PersistenceAppendHistoryNodesScope tracks AppendHistoryNodes calls made by service to persistence layer
 	PersistenceAppendHistoryNodesScope = "AppendHistoryNodes"
+	// PersistenceAppendHistoryNodesBatchScope tracks AppendHistoryNodesBatch calls made by service to persistence layer
+	PersistenceAppendHistoryNodesBatchScope = "AppendHistoryNodesBatch"
 	// PersistenceAppendRawHistoryNodesScope tracks AppendRawHistoryNodes calls made by service to persistence layer
 	PersistenceAppendRawHistoryNodesScope = "AppendRawHistoryNodes"
 	// PersistenceDeleteHistoryNodesScope tracks DeleteHistoryNodes calls made by service to persistence layer
@@ -863,6 +1087,10 @@ const (
 	HistoryRemoveSignalMutableStateScope = "RemoveSignalMutableState"
 	// HistoryTerminateWorkflowExecutionScope tracks TerminateWorkflowExecution API calls received by service
 	HistoryTerminateWorkflowExecutionScope = "TerminateWorkflowExecution"
+	// HistoryUpdateWorkflowExecutionMemoScope tracks UpdateWorkflowExecutionMemo API calls received by service
+	HistoryUpdateWorkflowExecutionMemoScope = "UpdateWorkflowExecutionMemo"
+	// HistoryUpsertWorkflowExecutionSearchAttributesScope tracks UpsertWorkflowExecutionSearchAttributes API calls received by service
+	HistoryUpsertWorkflowExecutionSearchAttributesScope = "UpsertWorkflowExecutionSearchAttributes"
 	// HistoryScheduleWorkflowTaskScope tracks ScheduleWorkflowTask API calls received by service
 	HistoryScheduleWorkflowTaskScope = "ScheduleWorkflowTask"
 	// HistoryVerifyFirstWorkflowTaskScheduled tracks VerifyFirstWorkflowTaskScheduled API calls received by service
@@ -1047,6 +1275,10 @@ const (
 	ReplicationDLQStatsScope = "ReplicationDLQStats"
 	// EventsCacheGetEventScope is the scope used by events cache
 	EventsCacheGetEventScope = "EventsCacheGetEvent"
+	// CurrentExecutionCacheGetScope is the scope used by the current execution cache
+	CurrentExecutionCacheGetScope = "CurrentExecutionCacheGet"
+	// HistoryAppendBatchScope is the scope used by the shard history append batcher
+	HistoryAppendBatchScope = "HistoryAppendBatch"
 	// EventsCachePutEventScope is the scope used by events cache
 	EventsCachePutEventScope = "EventsCachePutEvent"
 	// EventsCacheDeleteEventScope is the scope used by events cache
@@ -1168,6 +1400,7 @@ const (
 	DeleteNamespaceWorkflowScope    = "DeleteNamespaceWorkflow"
 	ReclaimResourcesWorkflowScope   = "ReclaimResourcesWorkflow"
 	DeleteExecutionsWorkflowScope   = "DeleteExecutionsWorkflow"
+	NamespaceExportWorkflowScope    = "NamespaceExportWorkflow"
 )
 
 // History task type
@@ -1200,6 +1433,7 @@ const (
 	TaskTypeTimerActiveTaskActivityRetryTimer      = "TimerActiveTaskActivityRetryTimer"
 	TaskTypeTimerActiveTaskWorkflowBackoffTimer    = "TimerActiveTaskWorkflowBackoffTimer"
 	TaskTypeTimerActiveTaskDeleteHistoryEvent      = "TimerActiveTaskDeleteHistoryEvent"
+	TaskTypeTimerActiveTaskDeleteVisibilityRecord  = "TimerActiveTaskDeleteVisibilityRecord"
 	TaskTypeTimerStandbyTaskActivityTimeout        = "TimerStandbyTaskActivityTimeout"
 	TaskTypeTimerStandbyTaskWorkflowTaskTimeout    = "TimerStandbyTaskWorkflowTaskTimeout"
 	TaskTypeTimerStandbyTaskUserTimer              = "TimerStandbyTaskUserTimer"
@@ -1207,6 +1441,7 @@ const (
 	TaskTypeTimerStandbyTaskActivityRetryTimer     = "TimerStandbyTaskActivityRetryTimer"
 	TaskTypeTimerStandbyTaskWorkflowBackoffTimer   = "TimerStandbyTaskWorkflowBackoffTimer"
 	TaskTypeTimerStandbyTaskDeleteHistoryEvent     = "TimerStandbyTaskDeleteHistoryEvent"
+	TaskTypeTimerStandbyTaskDeleteVisibilityRecord = "TimerStandbyTaskDeleteVisibilityRecord"
 	TaskTypeMemoryScheduledTaskWorkflowTaskTimeout = "MemoryScheduledTaskWorkflowTaskTimeout"
 )
 
@@ -1217,14 +1452,14 @@ const (
 )
 
 var (
-	ServiceRequests                               = NewCounterDef("service_requests")
+	ServiceRequests                               = NewCounterDef("service_requests", WithDescription("Number of gRPC requests received by the service."), WithTagKeys(OperationTagName, namespace))
 	ServicePendingRequests                        = NewGaugeDef("service_pending_requests")
-	ServiceFailures                               = NewCounterDef("service_errors")
-	ServiceErrorWithType                          = NewCounterDef("service_error_with_type")
+	ServiceFailures                               = NewCounterDef("service_errors", WithDescription("Number of gRPC requests which failed."), WithTagKeys(OperationTagName, namespace))
+	ServiceErrorWithType                          = NewCounterDef("service_error_with_type", WithDescription("Number of gRPC requests which failed, by error type."), WithTagKeys(OperationTagName, namespace, ErrorTypeTagName))
 	ServiceCriticalFailures                       = NewCounterDef("service_errors_critical")
-	ServiceLatency                                = NewTimerDef("service_latency")
-	ServiceLatencyNoUserLatency                   = NewTimerDef("service_latency_nouserlatency")
-	ServiceLatencyUserLatency                     = NewTimerDef("service_latency_userlatency")
+	ServiceLatency                                = NewTimerDef("service_latency", WithDescription("Latency of gRPC requests handled by the service."), WithTagKeys(OperationTagName, namespace))
+	ServiceLatencyNoUserLatency                   = NewTimerDef("service_latency_nouserlatency", WithDescription("Latency of gRPC requests excluding the time spent waiting on workflow locks."), WithTagKeys(OperationTagName, namespace))
+	ServiceLatencyUserLatency                     = NewTimerDef("service_latency_userlatency", WithDescription("Time gRPC requests spent waiting on workflow locks."), WithTagKeys(OperationTagName, namespace))
 	ServiceErrInvalidArgumentCounter              = NewCounterDef("service_errors_invalid_argument")
 	ServiceErrNamespaceNotActiveCounter           = NewCounterDef("service_errors_namespace_not_active")
 	ServiceErrResourceExhaustedCounter            = NewCounterDef("service_errors_resource_exhausted")
@@ -1243,6 +1478,9 @@ var (
 	ServiceErrUnauthorizedCounter                 = NewCounterDef("service_errors_unauthorized")
 	ServiceErrAuthorizeFailedCounter              = NewCounterDef("service_errors_authorize_failed")
 	ActionCounter                                 = NewCounterDef("action")
+	SDKRequests                                   = NewCounterDef("sdk_requests")
+	SDKDeprecatedRequests                         = NewCounterDef("sdk_deprecated_requests")
+	ExecutionRateLimitExceeded                    = NewCounterDef("execution_rate_limit_exceeded")
 	TlsCertsExpired                               = NewGaugeDef("certificates_expired")
 	TlsCertsExpiring                              = NewGaugeDef("certificates_expiring")
 	ServiceAuthorizationLatency                   = NewTimerDef("service_authorization_latency")
@@ -1261,8 +1499,10 @@ var (
 	StateTransitionCount                          = NewDimensionlessHistogramDef("state_transition_count")
 	HistorySize                                   = NewBytesHistogramDef("history_size")
 	HistoryCount                                  = NewDimensionlessHistogramDef("history_count")
+	HistoryAppendBatchSize                        = NewDimensionlessHistogramDef("history_append_batch_size")
 	SearchAttributesSize                          = NewBytesHistogramDef("search_attributes_size")
 	MemoSize                                      = NewBytesHistogramDef("memo_size")
+	NamespaceStorageBytesWritten                  = NewCounterDef("namespace_storage_bytes_written")
 	TooManyPendingChildWorkflows                  = NewCounterDef("wf_too_many_pending_child_workflows")
 	TooManyPendingActivities                      = NewCounterDef("wf_too_many_pending_activities")
 	TooManyPendingCancelRequests                  = NewCounterDef("wf_too_many_pending_cancel_requests")
@@ -1277,6 +1517,7 @@ var (
 	VersionCheckFailedCount                  = NewCounterDef("version_check_failed")
 	VersionCheckRequestFailedCount           = NewCounterDef("version_check_request_failed")
 	VersionCheckLatency                      = NewTimerDef("version_check_latency")
+	ReachabilityLatency                      = NewTimerDef("reachability_latency", WithDescription("Latency of computing the task reachability of build IDs."), WithTagKeys(namespace))
 
 	// History
 	CacheRequests                                = NewCounterDef("cache_requests")
@@ -1287,6 +1528,9 @@ var (
 	HistoryEventNotificationFanoutLatency        = NewTimerDef("history_event_notification_fanout_latency")
 	HistoryEventNotificationInFlightMessageGauge = NewGaugeDef("history_event_notification_inflight_message_gauge")
 	HistoryEventNotificationFailDeliveryCount    = NewCounterDef("history_event_notification_fail_delivery_count")
+	// ArchivalTaskDeadLettered is emitted by the archival queue task executor when an archival task exhausted its
+	// retries and was moved to the archival DLQ.
+	ArchivalTaskDeadLettered = NewCounterDef("archival_task_dead_lettered")
 	// ArchivalTaskInvalidURI is emitted by the archival queue task executor when the history or visibility URI for an
 	// archival task is not a valid URI.
 	// We may emit this metric several times for a single task if the task is retried.
@@ -1320,11 +1564,11 @@ var (
 	SyncShardFromRemoteCounter                        = NewCounterDef("syncshard_remote_count")
 	SyncShardFromRemoteFailure                        = NewCounterDef("syncshard_remote_failed")
 	TaskRequests                                      = NewCounterDef("task_requests")
-	TaskLoadLatency                                   = NewTimerDef("task_latency_load")       // latency from task generation to task loading (persistence scheduleToStart)
-	TaskScheduleLatency                               = NewTimerDef("task_latency_schedule")   // latency from task submission to in-memory queue to processing (in-memory scheduleToStart)
-	TaskProcessingLatency                             = NewTimerDef("task_latency_processing") // latency for processing task one time
-	TaskLatency                                       = NewTimerDef("task_latency")            // task in-memory latency across multiple attempts
-	TaskQueueLatency                                  = NewTimerDef("task_latency_queue")      // task e2e latency
+	TaskLoadLatency                                   = NewTimerDef("task_latency_load", WithDescription("Latency from task generation to task loading (persistence scheduleToStart)."))
+	TaskScheduleLatency                               = NewTimerDef("task_latency_schedule", WithDescription("Latency from task submission to in-memory queue to processing (in-memory scheduleToStart)."))
+	TaskProcessingLatency                             = NewTimerDef("task_latency_processing", WithDescription("Latency for processing task one time."))
+	TaskLatency                                       = NewTimerDef("task_latency", WithDescription("Task in-memory latency across multiple attempts."))
+	TaskQueueLatency                                  = NewTimerDef("task_latency_queue", WithDescription("Task e2e latency."))
 	TaskAttempt                                       = NewDimensionlessHistogramDef("task_attempt")
 	TaskFailures                                      = NewCounterDef("task_errors")
 	TaskDiscarded                                     = NewCounterDef("task_errors_discarded")
@@ -1338,17 +1582,20 @@ var (
 	TaskNamespaceHandoverCounter                      = NewCounterDef("task_errors_namespace_handover")
 	TaskThrottledCounter                              = NewCounterDef("task_errors_throttled")
 	TaskCorruptionCounter                             = NewCounterDef("task_errors_corruption")
-	TaskScheduleToStartLatency                        = NewTimerDef("task_schedule_to_start_latency")
+	TaskScheduleToStartLatency                        = NewTimerDef("task_schedule_to_start_latency", WithDescription("Time tasks waited in their task queue before being started by a worker."), WithTagKeys(OperationTagName, namespace, taskQueue, TaskTypeTagName))
 	TransferTaskMissingEventCounter                   = NewCounterDef("transfer_task_missing_event_counter")
 	TaskBatchCompleteCounter                          = NewCounterDef("task_batch_complete_counter")
 	TaskReschedulerPendingTasks                       = NewDimensionlessHistogramDef("task_rescheduler_pending_tasks")
 	PendingTasksCounter                               = NewDimensionlessHistogramDef("pending_tasks")
 	TaskSchedulerThrottled                            = NewCounterDef("task_scheduler_throttled")
-	QueueScheduleLatency                              = NewTimerDef("queue_latency_schedule") // latency for scheduling 100 tasks in one task channel
+	QueueScheduleLatency                              = NewTimerDef("queue_latency_schedule", WithDescription("Latency for scheduling 100 tasks in one task channel."))
 	QueueReaderCountHistogram                         = NewDimensionlessHistogramDef("queue_reader_count")
 	QueueSliceCountHistogram                          = NewDimensionlessHistogramDef("queue_slice_count")
 	QueueActionCounter                                = NewCounterDef("queue_actions")
 	QueueActionFailures                               = NewCounterDef("queue_action_errors")
+	VisibilityQueueLag                                = NewTimerDef("visibility_queue_lag", WithDescription("Age of the oldest pending visibility task of a shard."))
+	VisibilityQueueLagSLOViolations                   = NewCounterDef("visibility_queue_lag_slo_violations")
+	VisibilityBackpressureRequests                    = NewCounterDef("visibility_backpressure_requests")
 	ActivityE2ELatency                                = NewTimerDef("activity_end_to_end_latency")
 	AckLevelUpdateCounter                             = NewCounterDef("ack_level_update")
 	AckLevelUpdateFailedCounter                       = NewCounterDef("ack_level_update_failed")
@@ -1383,6 +1630,7 @@ var (
 	EmptyCompletionCommandsCounter                 = NewCounterDef("empty_completion_commands")
 	MultipleCompletionCommandsCounter              = NewCounterDef("multiple_completion_commands")
 	FailedWorkflowTasksCounter                     = NewCounterDef("failed_workflow_tasks")
+	TaskDispatchFailures                           = NewCounterDef("task_dispatch_failures")
 	WorkflowTaskAttempt                            = NewDimensionlessHistogramDef("workflow_task_attempt")
 	StaleMutableStateCounter                       = NewCounterDef("stale_mutable_state")
 	AutoResetPointsLimitExceededCounter            = NewCounterDef("auto_reset_points_exceed_limit")
@@ -1452,6 +1700,7 @@ var (
 	WorkflowTimeoutCount                           = NewCounterDef("workflow_timeout")
 	WorkflowTerminateCount                         = NewCounterDef("workflow_terminate")
 	WorkflowContinuedAsNewCount                    = NewCounterDef("workflow_continued_as_new")
+	WorkflowClosedCount                            = NewCounterDef("workflow_closed", WithDescription("Number of workflow closures, by status and, for failures, timeouts and terminations, by cause."), WithTagKeys(OperationTagName, namespace, WorkflowStatusTagName, FailureSourceTagName, activityType, TimeoutTypeTagName, TerminateReasonTagName))
 	LastRetrievedMessageID                         = NewGaugeDef("last_retrieved_message_id")
 	LastProcessedMessageID                         = NewGaugeDef("last_processed_message_id")
 	ReplicationTasksSend                           = NewCounterDef("replication_tasks_send")
@@ -1507,6 +1756,7 @@ var (
 	PollSuccessPerTaskQueueCounter            = NewCounterDef("poll_success")
 	PollTimeoutPerTaskQueueCounter            = NewCounterDef("poll_timeouts")
 	PollSuccessWithSyncPerTaskQueueCounter    = NewCounterDef("poll_success_sync")
+	PrioritySyncMatchPerTaskQueueCounter      = NewCounterDef("priority_sync_match")
 	LeaseRequestPerTaskQueueCounter           = NewCounterDef("lease_requests")
 	LeaseFailurePerTaskQueueCounter           = NewCounterDef("lease_failures")
 	ConditionFailedErrorPerTaskQueueCounter   = NewCounterDef("condition_failed_errors")
@@ -1534,7 +1784,13 @@ var (
 	TaskWriteThrottlePerTaskQueueCounter      = NewCounterDef("task_write_throttle_count")
 	TaskWriteLatencyPerTaskQueue              = NewTimerDef("task_write_latency")
 	TaskLagPerTaskQueueGauge                  = NewGaugeDef("task_lag_per_tl")
+	ApproximateBacklogCount                   = NewGaugeDef("approximate_backlog_count", WithDescription("Number of tasks loaded from the backlog of a task queue partition and not completed yet."), WithTagKeys(OperationTagName, namespace, taskQueue, TaskTypeTagName))
+	ApproximateBacklogAgeSeconds              = NewGaugeDef("approximate_backlog_age_seconds", WithDescription("Age of the oldest task loaded from the backlog of a task queue partition and not completed yet."), WithTagKeys(OperationTagName, namespace, taskQueue, TaskTypeTagName))
 	NoRecentPollerTasksPerTaskQueueCounter    = NewCounterDef("no_poller_tasks")
+	VersionedDispatchesPerTaskQueueCounter    = NewCounterDef("versioned_dispatches", WithDescription("Tasks dispatched to versioned pollers of a task queue partition."), WithTagKeys(OperationTagName, namespace, taskQueue, TaskTypeTagName, BuildIdTagName))
+	UnknownBuildPollsPerTaskQueueCounter      = NewCounterDef("unknown_build_polls", WithDescription("Versioned polls with a build ID missing from the versioning data of the task queue."), WithTagKeys(OperationTagName, namespace, taskQueue, TaskTypeTagName))
+	UnknownBuildTasksPerTaskQueueCounter      = NewCounterDef("unknown_build_tasks", WithDescription("Tasks rejected because their build ID is missing from the versioning data of the task queue."), WithTagKeys(OperationTagName, namespace, taskQueue, TaskTypeTagName))
+	UserDataPropagationLatency                = NewTimerDef("user_data_propagation_latency", WithDescription("Time from an update of the versioning data on the root partition of a task queue to its receipt by another partition."), WithTagKeys(OperationTagName, namespace, taskQueue, TaskTypeTagName))
 
 	// Worker
 	ExecutorTasksDoneCount                                    = NewCounterDef("executor_done")
@@ -1570,6 +1826,22 @@ var (
 	HistoryScavengerErrorCount                                = NewCounterDef("scavenger_errors")
 	HistoryScavengerSkipCount                                 = NewCounterDef("scavenger_skips")
 	ExecutionsOutstandingCount                                = NewGaugeDef("executions_outstanding")
+	NamespaceStorageUsage                                     = NewGaugeDef("namespace_storage_usage_bytes")
+	ExecutionsReencrypted                                     = NewCounterDef("executions_reencrypted")
+	ExecutionsReencryptionSkipped                             = NewCounterDef("executions_reencryption_skipped")
+	TablePartitionsCreated                                    = NewCounterDef("table_partitions_created")
+	TablePartitionsDropped                                    = NewCounterDef("table_partitions_dropped")
+	VisibilityMissingRecords                                  = NewCounterDef("visibility_missing_records")
+	VisibilityStaleRecords                                    = NewCounterDef("visibility_stale_records")
+	VisibilityGhostRecords                                    = NewCounterDef("visibility_ghost_records")
+	VisibilityRecordsRepaired                                 = NewCounterDef("visibility_records_repaired")
+	VisibilityExpiredRecordsDeleted                           = NewCounterDef("visibility_expired_records_deleted")
+	VisibilityExportedRecords                                 = NewCounterDef("visibility_exported_records")
+	VisibilityExportFailures                                  = NewCounterDef("visibility_export_failures")
+	NamespaceExportedExecutions                               = NewCounterDef("namespace_exported_executions")
+	NamespaceExportFailures                                   = NewCounterDef("namespace_export_failures")
+	ArchiveExpiredHistoryObjects                              = NewCounterDef("archive_expired_history_objects")
+	ArchiveExpiryFailures                                     = NewCounterDef("archive_expiry_failures")
 	ArchiverNonRetryableErrorCount                            = NewCounterDef("archiver_non_retryable_error")
 	ArchiverStartedCount                                      = NewCounterDef("archiver_started")
 	ArchiverStoppedCount                                      = NewCounterDef("archiver_stopped")
@@ -1626,6 +1898,8 @@ var (
 	ElasticsearchBulkProcessorWaitStartLatency                = NewTimerDef("elasticsearch_bulk_processor_wait_start_latency")
 	ElasticsearchBulkProcessorBulkSize                        = NewDimensionlessHistogramDef("elasticsearch_bulk_processor_bulk_size")
 	ElasticsearchBulkProcessorBulkResquestTookLatency         = NewTimerDef("elasticsearch_bulk_processor_bulk_request_took_latency")
+	ElasticsearchBulkProcessorSaturation                      = NewGaugeDef("elasticsearch_bulk_processor_saturation")
+	ElasticsearchBulkProcessorDroppedDocuments                = NewCounterDef("elasticsearch_bulk_processor_dropped_documents")
 	ElasticsearchDocumentParseFailuresCount                   = NewCounterDef("elasticsearch_document_parse_failures_counter")
 	ElasticsearchDocumentGenerateFailuresCount                = NewCounterDef("elasticsearch_document_generate_failures_counter")
 	ElasticsearchCustomOrderByClauseCount                     = NewCounterDef("elasticsearch_custom_order_by_clause_counter")
@@ -1645,6 +1919,7 @@ var (
 	ScheduleActionErrors                                      = NewCounterDef("schedule_action_errors")
 	ScheduleCancelWorkflowErrors                              = NewCounterDef("schedule_cancel_workflow_errors")
 	ScheduleTerminateWorkflowErrors                           = NewCounterDef("schedule_terminate_workflow_errors")
+	SchedulePausedAfterFailures                               = NewCounterDef("schedule_paused_after_failures")
 
 	// Replication
 	NamespaceReplicationTaskAckLevelGauge = NewGaugeDef("namespace_replication_task_ack_level")
@@ -1652,10 +1927,10 @@ var (
 	NamespaceReplicationDLQMaxLevelGauge  = NewGaugeDef("namespace_dlq_max_level")
 
 	// Persistence
-	PersistenceRequests                                 = NewCounterDef("persistence_requests")
-	PersistenceFailures                                 = NewCounterDef("persistence_errors")
-	PersistenceErrorWithType                            = NewCounterDef("persistence_error_with_type")
-	PersistenceLatency                                  = NewTimerDef("persistence_latency")
+	PersistenceRequests                                 = NewCounterDef("persistence_requests", WithDescription("Number of persistence requests."), WithTagKeys(OperationTagName, namespace))
+	PersistenceFailures                                 = NewCounterDef("persistence_errors", WithDescription("Number of persistence requests which failed."), WithTagKeys(OperationTagName, namespace))
+	PersistenceErrorWithType                            = NewCounterDef("persistence_error_with_type", WithDescription("Number of persistence requests which failed, by error type."), WithTagKeys(OperationTagName, namespace, ErrorTypeTagName))
+	PersistenceLatency                                  = NewTimerDef("persistence_latency", WithDescription("Latency of persistence requests."), WithTagKeys(OperationTagName, namespace))
 	PersistenceShardRPS                                 = NewDimensionlessHistogramDef("persistence_shard_rps")
 	PersistenceErrShardExistsCounter                    = NewCounterDef("persistence_errors_shard_exists")
 	PersistenceErrShardOwnershipLostCounter             = NewCounterDef("persistence_errors_shard_ownership_lost")
@@ -1668,7 +1943,22 @@ var (
 	PersistenceErrNamespaceAlreadyExistsCounter         = NewCounterDef("persistence_errors_namespace_already_exists")
 	PersistenceErrBadRequestCounter                     = NewCounterDef("persistence_errors_bad_request")
 	PersistenceErrResourceExhaustedCounter              = NewCounterDef("persistence_errors_resource_exhausted")
+	PersistenceCircuitBreakerState                      = NewGaugeDef("persistence_circuit_breaker_state")
+	PersistenceCircuitBreakerRejectedRequests           = NewCounterDef("persistence_circuit_breaker_rejected_requests")
+	PersistenceFaultsInjected                           = NewCounterDef("persistence_faults_injected")
+	PersistenceAdaptiveRateLimit                        = NewGaugeDef("persistence_adaptive_rate_limit")
+	PersistenceSchemaIncompatible                       = NewGaugeDef("persistence_schema_incompatible")
+	PersistenceSchemaBackfillSteps                      = NewCounterDef("persistence_schema_backfill_steps")
+	PersistenceSchemaBackfillErrors                     = NewCounterDef("persistence_schema_backfill_errors")
+	PersistenceSchemaBackfillCompleted                  = NewCounterDef("persistence_schema_backfill_completed")
+	PayloadOffloaded                                    = NewCounterDef("payload_offloaded")
+	PayloadOffloadedBytes                               = NewCounterDef("payload_offloaded_bytes")
+	PayloadOffloadResolved                              = NewCounterDef("payload_offload_resolved")
 	VisibilityPersistenceRequests                       = NewCounterDef("visibility_persistence_requests")
+	VisibilityDualWriteVerified                         = NewCounterDef("visibility_dual_write_verified")
+	VisibilityDualWriteDivergence                       = NewCounterDef("visibility_dual_write_divergence")
+	VisibilityDualWriteVerificationErrors               = NewCounterDef("visibility_dual_write_verification_errors")
+	VisibilityDualWriteVerificationDropped              = NewCounterDef("visibility_dual_write_verification_dropped")
 	VisibilityPersistenceErrorWithType                  = NewCounterDef("visibility_persistence_error_with_type")
 	VisibilityPersistenceFailures                       = NewCounterDef("visibility_persistence_errors")
 	VisibilityPersistenceResourceExhausted              = NewCounterDef("visibility_persistence_resource_exhausted")
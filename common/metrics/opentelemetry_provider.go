@@ -26,15 +26,21 @@ package metrics
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
 	exporters "go.opentelemetry.io/otel/exporters/prometheus"
 	"go.opentelemetry.io/otel/metric"
 	sdkmetrics "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
 
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -49,22 +55,53 @@ type (
 	}
 
 	openTelemetryProviderImpl struct {
-		meter  metric.Meter
-		config *PrometheusConfig
-		server *http.Server
+		meter    metric.Meter
+		provider *sdkmetrics.MeterProvider
+		config   *PrometheusConfig
+		server   *http.Server
+		// exemplars of the histograms served to Prometheus, nil if metrics aren't served to Prometheus
+		exemplars *exemplarStore
+	}
+
+	exemplarRecorder interface {
+		recordExemplar(name string, unit string, attrs []attribute.KeyValue, value float64, spanContext trace.SpanContext)
 	}
 )
 
+const (
+	defaultOTLPTimeout  = 10 * time.Second
+	defaultOTLPInterval = time.Minute
+)
+
+// NewOpenTelemetryProvider creates a meter provider which serves metrics to Prometheus and/or exports them
+// over OTLP, depending on which of prometheusConfig and otlpConfig are set.
 func NewOpenTelemetryProvider(
 	logger log.Logger,
 	prometheusConfig *PrometheusConfig,
+	otlpConfig *OTLPConfig,
 	clientConfig *ClientConfig,
 ) (*openTelemetryProviderImpl, error) {
-	reg := prometheus.NewRegistry()
-	exporter, err := exporters.New(exporters.WithRegisterer(reg))
-	if err != nil {
-		logger.Error("Failed to initialize prometheus exporter.", tag.Error(err))
-		return nil, err
+	var options []sdkmetrics.Option
+	var reg *prometheus.Registry
+	if prometheusConfig != nil {
+		reg = prometheus.NewRegistry()
+		exporter, err := exporters.New(exporters.WithRegisterer(reg))
+		if err != nil {
+			logger.Error("Failed to initialize prometheus exporter.", tag.Error(err))
+			return nil, err
+		}
+		options = append(options, sdkmetrics.WithReader(exporter))
+	}
+	if otlpConfig != nil {
+		reader, err := newOTLPReader(otlpConfig)
+		if err != nil {
+			logger.Error("Failed to initialize OTLP exporter.", tag.Error(err))
+			return nil, err
+		}
+		options = append(options,
+			sdkmetrics.WithReader(reader),
+			sdkmetrics.WithResource(newOTLPResource(otlpConfig)),
+		)
 	}
 
 	var views []sdkmetrics.View
@@ -81,29 +118,105 @@ func NewOpenTelemetryProvider(
 			},
 		))
 	}
-	provider := sdkmetrics.NewMeterProvider(
-		sdkmetrics.WithReader(exporter),
-		sdkmetrics.WithView(views...),
-	)
-	metricServer := initPrometheusListener(prometheusConfig, reg, logger)
+	provider := sdkmetrics.NewMeterProvider(append(options, sdkmetrics.WithView(views...))...)
+	var metricServer *http.Server
+	var exemplars *exemplarStore
+	if prometheusConfig != nil {
+		exemplars = newExemplarStore(clientConfig.PerUnitHistogramBoundaries)
+		metricServer = initPrometheusListener(prometheusConfig, reg, exemplars, logger)
+	}
 	meter := provider.Meter("temporal")
 	reporter := &openTelemetryProviderImpl{
-		meter:  meter,
-		config: prometheusConfig,
-		server: metricServer,
+		meter:     meter,
+		provider:  provider,
+		config:    prometheusConfig,
+		server:    metricServer,
+		exemplars: exemplars,
 	}
 
 	return reporter, nil
 }
 
-func initPrometheusListener(config *PrometheusConfig, reg *prometheus.Registry, logger log.Logger) *http.Server {
+func newOTLPReader(config *OTLPConfig) (sdkmetrics.Reader, error) {
+	temporalitySelector, err := otlpTemporalitySelector(config.Temporality)
+	if err != nil {
+		return nil, err
+	}
+	timeout := defaultOTLPTimeout
+	if config.Timeout > 0 {
+		timeout = config.Timeout
+	}
+	opts := []otlpmetricgrpc.Option{
+		otlpmetricgrpc.WithEndpoint(config.Endpoint),
+		otlpmetricgrpc.WithHeaders(config.Headers),
+		otlpmetricgrpc.WithTimeout(timeout),
+		otlpmetricgrpc.WithTemporalitySelector(temporalitySelector),
+	}
+	if config.Insecure {
+		opts = append(opts, otlpmetricgrpc.WithInsecure())
+	}
+	// the exporter connects lazily, so this doesn't block on the collector being reachable
+	exporter, err := otlpmetricgrpc.New(context.Background(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	interval := defaultOTLPInterval
+	if config.Interval > 0 {
+		interval = config.Interval
+	}
+	return sdkmetrics.NewPeriodicReader(exporter, sdkmetrics.WithInterval(interval)), nil
+}
+
+// otlpTemporalitySelector returns the temporality selector for the configured temporality. With delta
+// temporality, up-down counters stay cumulative as their deltas are meaningless to most backends.
+func otlpTemporalitySelector(temporality string) (sdkmetrics.TemporalitySelector, error) {
+	switch temporality {
+	case "", TemporalityCumulative:
+		return sdkmetrics.DefaultTemporalitySelector, nil
+	case TemporalityDelta:
+		return func(kind sdkmetrics.InstrumentKind) metricdata.Temporality {
+			switch kind {
+			case sdkmetrics.InstrumentKindUpDownCounter, sdkmetrics.InstrumentKindObservableUpDownCounter:
+				return metricdata.CumulativeTemporality
+			default:
+				return metricdata.DeltaTemporality
+			}
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported OTLP temporality %q, must be %q or %q", temporality, TemporalityCumulative, TemporalityDelta)
+	}
+}
+
+func newOTLPResource(config *OTLPConfig) *resource.Resource {
+	attrs := make([]attribute.KeyValue, 0, len(config.ResourceAttributes))
+	for k, v := range config.ResourceAttributes {
+		attrs = append(attrs, attribute.String(k, v))
+	}
+	// Merge only fails on conflicting schema URLs, which a schemaless resource can't have.
+	res, _ := resource.Merge(resource.Default(), resource.NewSchemaless(attrs...))
+	return res
+}
+
+func initPrometheusListener(
+	config *PrometheusConfig,
+	reg *prometheus.Registry,
+	exemplars *exemplarStore,
+	logger log.Logger,
+) *http.Server {
 	handlerPath := config.HandlerPath
 	if handlerPath == "" {
 		handlerPath = "/metrics"
 	}
 
 	handler := http.NewServeMux()
-	handler.HandleFunc(handlerPath, promhttp.HandlerFor(reg, promhttp.HandlerOpts{Registry: reg}).ServeHTTP)
+	handler.HandleFunc(handlerPath, promhttp.HandlerFor(exemplarGatherer{Gatherer: reg, store: exemplars}, promhttp.HandlerOpts{
+		Registry: reg,
+		// exemplars are only exposed in the OpenMetrics format
+		EnableOpenMetrics: true,
+	}).ServeHTTP)
+	if handlerPath != CatalogHandlerPath {
+		handler.Handle(CatalogHandlerPath, NewCatalogHandler())
+	}
 
 	if config.ListenAddress == "" {
 		logger.Fatal("Listen address must be specified.", tag.Address(config.ListenAddress))
@@ -124,10 +237,29 @@ func (r *openTelemetryProviderImpl) GetMeter() metric.Meter {
 	return r.meter
 }
 
+func (r *openTelemetryProviderImpl) recordExemplar(
+	name string,
+	unit string,
+	attrs []attribute.KeyValue,
+	value float64,
+	spanContext trace.SpanContext,
+) {
+	if r.exemplars == nil {
+		return
+	}
+	r.exemplars.record(name, unit, attrs, value, spanContext, time.Now().UTC())
+}
+
 func (r *openTelemetryProviderImpl) Stop(logger log.Logger) {
 	ctx, closeCtx := context.WithTimeout(context.Background(), time.Second)
 	defer closeCtx()
-	if err := r.server.Shutdown(ctx); !(err == nil || err == http.ErrServerClosed) {
-		logger.Error("Prometheus metrics server shutdown failure.", tag.Address(r.config.ListenAddress), tag.Error(err))
+	if r.server != nil {
+		if err := r.server.Shutdown(ctx); !(err == nil || err == http.ErrServerClosed) {
+			logger.Error("Prometheus metrics server shutdown failure.", tag.Address(r.config.ListenAddress), tag.Error(err))
+		}
+	}
+	// flushes the metrics not exported over OTLP yet
+	if err := r.provider.Shutdown(ctx); err != nil {
+		logger.Error("OpenTelemetry meter provider shutdown failure.", tag.Error(err))
 	}
 }
@@ -30,6 +30,7 @@ import (
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -104,8 +105,10 @@ func (omp *otelMetricsHandler) Timer(timer string) TimerIface {
 	}
 
 	return TimerFunc(func(i time.Duration, t ...Tag) {
-		option := metric.WithAttributes(tagsToAttributes(omp.tags, t, omp.excludeTags)...)
-		c.Record(context.Background(), i.Milliseconds(), option)
+		t, spanContext := splitExemplar(t)
+		attrs := tagsToAttributes(omp.tags, t, omp.excludeTags)
+		c.Record(context.Background(), i.Milliseconds(), metric.WithAttributes(attrs...))
+		omp.recordExemplar(timer, Milliseconds, attrs, float64(i.Milliseconds()), spanContext)
 	})
 }
 
@@ -117,11 +120,30 @@ func (omp *otelMetricsHandler) Histogram(histogram string, unit MetricUnit) Hist
 	}
 
 	return CounterFunc(func(i int64, t ...Tag) {
-		option := metric.WithAttributes(tagsToAttributes(omp.tags, t, omp.excludeTags)...)
-		c.Record(context.Background(), i, option)
+		t, spanContext := splitExemplar(t)
+		attrs := tagsToAttributes(omp.tags, t, omp.excludeTags)
+		c.Record(context.Background(), i, metric.WithAttributes(attrs...))
+		omp.recordExemplar(histogram, string(unit), attrs, float64(i), spanContext)
 	})
 }
 
+// recordExemplar records the sampled trace a histogram measurement was recorded for, if the provider keeps
+// exemplars.
+func (omp *otelMetricsHandler) recordExemplar(
+	name string,
+	unit string,
+	attrs []attribute.KeyValue,
+	value float64,
+	spanContext trace.SpanContext,
+) {
+	if !spanContext.IsValid() {
+		return
+	}
+	if recorder, ok := omp.provider.(exemplarRecorder); ok {
+		recorder.recordExemplar(name, unit, attrs, value, spanContext)
+	}
+}
+
 func (omp *otelMetricsHandler) Stop(l log.Logger) {
 	omp.provider.Stop(l)
 }
@@ -141,10 +163,16 @@ func tagsToAttributes(t1 []Tag, t2 []Tag, e excludeTags) []attribute.KeyValue {
 	}
 
 	for i := range t1 {
+		if t1[i].Key() == exemplarTagKey {
+			continue
+		}
 		attrs = append(attrs, convert(t1[i]))
 	}
 
 	for i := range t2 {
+		if t2[i].Key() == exemplarTagKey {
+			continue
+		}
 		attrs = append(attrs, convert(t2[i]))
 	}
 
@@ -54,6 +54,10 @@ const (
 	commandType    = "commandType"
 	serviceName    = "service_name"
 	actionType     = "action_type"
+	sdkName        = "sdk_name"
+	sdkVersion     = "sdk_version"
+	rateLimitOp    = "rate_limit_operation"
+	rateLimitScope = "rate_limit_scope"
 	// Generic reason tag can be used anywhere a reason is needed.
 	reason = "reason"
 	// See server.api.enums.v1.ReplicationTaskType
@@ -204,6 +208,59 @@ func FailureTag(value string) Tag {
 	return &tagImpl{key: FailureTagName, value: value}
 }
 
+// FailureCauseTag returns a new task failure cause tag, see taskfailure.Cause
+func FailureCauseTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return &tagImpl{key: FailureCauseTagName, value: value}
+}
+
+// WorkflowStatusTag returns a new workflow execution status tag.
+func WorkflowStatusTag(status enumspb.WorkflowExecutionStatus) Tag {
+	return &tagImpl{key: WorkflowStatusTagName, value: status.String()}
+}
+
+// FailureSourceTag returns a new tag for what caused a workflow to fail.
+func FailureSourceTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return &tagImpl{key: FailureSourceTagName, value: value}
+}
+
+// TimeoutTypeTag returns a new timeout type tag.
+func TimeoutTypeTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return &tagImpl{key: TimeoutTypeTagName, value: value}
+}
+
+// TerminateReasonTag returns a new tag for the category of the reason a workflow was terminated for.
+func TerminateReasonTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return &tagImpl{key: TerminateReasonTagName, value: value}
+}
+
+// StorageTypeTag returns a new storage type tag, see namespace.StorageUsage
+func StorageTypeTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return &tagImpl{key: StorageTypeTagName, value: value}
+}
+
+// PersistenceStoreTag returns a new persistence store tag, e.g. ExecutionStore
+func PersistenceStoreTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return &tagImpl{key: PersistenceStoreTagName, value: value}
+}
+
 func TaskCategoryTag(value string) Tag {
 	if len(value) == 0 {
 		value = unknownValue
@@ -211,6 +268,14 @@ func TaskCategoryTag(value string) Tag {
 	return &tagImpl{key: TaskCategoryTagName, value: value}
 }
 
+// BuildIdTag returns a new worker build ID tag.
+func BuildIdTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return &tagImpl{key: BuildIdTagName, value: value}
+}
+
 func TaskTypeTag(value string) Tag {
 	if len(value) == 0 {
 		value = unknownValue
@@ -284,6 +349,22 @@ func ActionType(value string) Tag {
 	return &tagImpl{key: actionType, value: value}
 }
 
+func SDKNameTag(value string) Tag {
+	return &tagImpl{key: sdkName, value: value}
+}
+
+func SDKVersionTag(value string) Tag {
+	return &tagImpl{key: sdkVersion, value: value}
+}
+
+func RateLimitOperationTag(value string) Tag {
+	return &tagImpl{key: rateLimitOp, value: value}
+}
+
+func RateLimitScopeTag(value string) Tag {
+	return &tagImpl{key: rateLimitScope, value: value}
+}
+
 func OperationTag(value string) Tag {
 	return &tagImpl{key: OperationTagName, value: value}
 }
@@ -129,6 +129,10 @@ func tagsToMap(t1 []Tag, e excludeTags) map[string]string {
 	m := make(map[string]string, len(t1))
 
 	convert := func(tag Tag) {
+		if tag.Key() == exemplarTagKey {
+			// tally reporters have no notion of exemplars
+			return
+		}
 		if vals, ok := e[tag.Key()]; ok {
 			if _, ok := vals[tag.Value()]; !ok {
 				m[tag.Key()] = tagExcludedValue
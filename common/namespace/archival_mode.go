@@ -0,0 +1,43 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+const (
+	// ArchivalModeDataKey is the namespace data key holding how histories are archived before they are deleted,
+	// either ArchivalModeAsync (the default) or ArchivalModeSync.
+	ArchivalModeDataKey = "temporal.archival-mode"
+
+	// ArchivalModeAsync hands histories which can't be archived inline to the archival workflow, which deletes
+	// them once they are archived.
+	ArchivalModeAsync = "async"
+	// ArchivalModeSync archives histories inline, and keeps retrying the deletion of a history until its archive
+	// write is confirmed. It's meant for namespaces which must never lose a history that wasn't archived.
+	ArchivalModeSync = "sync"
+)
+
+// SynchronousArchival returns true if histories of the namespace are only deleted after they are archived inline.
+func (ns *Namespace) SynchronousArchival() bool {
+	return ns.GetCustomData(ArchivalModeDataKey) == ArchivalModeSync
+}
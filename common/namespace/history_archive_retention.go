@@ -0,0 +1,73 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"time"
+
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	// HistoryArchiveRetentionDataKey is the namespace data key holding how long archived histories are kept,
+	// e.g. "3650d". Unit-less values are interpreted as days. Archived histories are kept forever if it is unset.
+	HistoryArchiveRetentionDataKey = "temporal.history-archive-retention"
+	// HistoryArchiveExpiryActionDataKey is the namespace data key holding what happens to archived histories once
+	// they expire, either HistoryArchiveExpiryActionDelete (the default) or HistoryArchiveExpiryActionTransition.
+	HistoryArchiveExpiryActionDataKey = "temporal.history-archive-expiry-action"
+
+	// HistoryArchiveExpiryActionDelete deletes expired archived histories.
+	HistoryArchiveExpiryActionDelete = "delete"
+	// HistoryArchiveExpiryActionTransition moves expired archived histories to a colder storage class, for the
+	// archivers which support it.
+	HistoryArchiveExpiryActionTransition = "transition"
+)
+
+// ParseHistoryArchiveRetention parses an archived history retention as stored under HistoryArchiveRetentionDataKey.
+func ParseHistoryArchiveRetention(value string) (time.Duration, error) {
+	return timestamp.ParseDurationDefaultDays(value)
+}
+
+// HistoryArchiveRetention returns how long archived histories are kept, and false if they are kept forever,
+// which is also the case if the retention set under HistoryArchiveRetentionDataKey is invalid.
+func (ns *Namespace) HistoryArchiveRetention() (time.Duration, bool) {
+	value := ns.GetCustomData(HistoryArchiveRetentionDataKey)
+	if value == "" {
+		return 0, false
+	}
+	retention, err := ParseHistoryArchiveRetention(value)
+	if err != nil || retention <= 0 {
+		return 0, false
+	}
+	return retention, true
+}
+
+// HistoryArchiveExpiryAction returns what happens to archived histories once they expire.
+func (ns *Namespace) HistoryArchiveExpiryAction() string {
+	if ns.GetCustomData(HistoryArchiveExpiryActionDataKey) == HistoryArchiveExpiryActionTransition {
+		return HistoryArchiveExpiryActionTransition
+	}
+	return HistoryArchiveExpiryActionDelete
+}
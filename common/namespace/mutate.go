@@ -82,6 +82,14 @@ func WithRetention(dur *time.Duration) Mutation {
 		})
 }
 
+// WithVisibilityRetention assigns the visibility retention duration to a Namespace during a Clone operation.
+func WithVisibilityRetention(dur *time.Duration) Mutation {
+	return mutationFunc(
+		func(ns *persistence.GetNamespaceResponse) {
+			ns.Namespace.Config.VisibilityRetention = dur
+		})
+}
+
 // WithData adds a key-value pair to a Namespace during a Clone operation.
 func WithData(key, value string) Mutation {
 	return mutationFunc(
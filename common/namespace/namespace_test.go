@@ -142,3 +142,90 @@ func TestNamespace_GetCustomData(t *testing.T) {
 	data2 := ns.GetCustomData("fake")
 	assert.Equal(t, "", data2)
 }
+
+func TestNamespace_StorageUsage(t *testing.T) {
+	base := base(t)
+	assert.Equal(t, namespace.StorageUsage{}, base.StorageUsage())
+
+	usage := namespace.StorageUsage{
+		HistoryBytes:      100,
+		MutableStateBytes: 20,
+		VisibilityBytes:   3,
+	}
+	data := make(map[string]string)
+	usage.ToData(data)
+	var mutations []namespace.Mutation
+	for key, value := range data {
+		mutations = append(mutations, namespace.WithData(key, value))
+	}
+	ns := base.Clone(mutations...)
+	assert.Equal(t, usage, ns.StorageUsage())
+	assert.Equal(t, int64(123), ns.StorageUsage().TotalBytes())
+
+	ns = ns.Clone(namespace.WithData(namespace.StorageUsageDataKey(namespace.StorageTypeHistory), "invalid"))
+	assert.Equal(t, int64(23), ns.StorageUsage().TotalBytes())
+}
+
+func TestNamespace_VisibilityRetention(t *testing.T) {
+	base := base(t).Clone(namespace.WithRetention(timestamp.DurationFromDays(30)))
+	assert.False(t, base.HasSeparateVisibilityRetention())
+	assert.Equal(t, 30*24*time.Hour, base.VisibilityRetention())
+
+	ns := base.Clone(namespace.WithVisibilityRetention(timestamp.DurationFromDays(365)))
+	assert.True(t, ns.HasSeparateVisibilityRetention())
+	assert.Equal(t, 365*24*time.Hour, ns.VisibilityRetention())
+
+	ns = base.Clone(namespace.WithVisibilityRetention(timestamp.DurationFromDays(7)))
+	assert.True(t, ns.HasSeparateVisibilityRetention())
+	assert.Equal(t, 7*24*time.Hour, ns.VisibilityRetention())
+
+	ns = base.Clone(namespace.WithVisibilityRetention(timestamp.DurationPtr(0)))
+	assert.False(t, ns.HasSeparateVisibilityRetention())
+	assert.Equal(t, 30*24*time.Hour, ns.VisibilityRetention())
+}
+
+func TestNamespace_HistoryArchiveRetention(t *testing.T) {
+	base := base(t)
+	_, ok := base.HistoryArchiveRetention()
+	assert.False(t, ok)
+	assert.Equal(t, namespace.HistoryArchiveExpiryActionDelete, base.HistoryArchiveExpiryAction())
+
+	ns := base.Clone(
+		namespace.WithData(namespace.HistoryArchiveRetentionDataKey, "3650"),
+		namespace.WithData(namespace.HistoryArchiveExpiryActionDataKey, namespace.HistoryArchiveExpiryActionTransition),
+	)
+	retention, ok := ns.HistoryArchiveRetention()
+	assert.True(t, ok)
+	assert.Equal(t, 3650*24*time.Hour, retention)
+	assert.Equal(t, namespace.HistoryArchiveExpiryActionTransition, ns.HistoryArchiveExpiryAction())
+
+	for _, value := range []string{"invalid", "0", "-1h"} {
+		ns = base.Clone(namespace.WithData(namespace.HistoryArchiveRetentionDataKey, value))
+		_, ok = ns.HistoryArchiveRetention()
+		assert.False(t, ok, value)
+	}
+}
+
+func TestNamespace_SynchronousArchival(t *testing.T) {
+	base := base(t)
+	assert.False(t, base.SynchronousArchival())
+	assert.True(t, base.Clone(namespace.WithData(namespace.ArchivalModeDataKey, namespace.ArchivalModeSync)).SynchronousArchival())
+	assert.False(t, base.Clone(namespace.WithData(namespace.ArchivalModeDataKey, namespace.ArchivalModeAsync)).SynchronousArchival())
+}
+
+func TestNamespace_VisibilityExport(t *testing.T) {
+	base := base(t)
+	assert.Equal(t, "", base.VisibilityExportURI())
+	assert.True(t, base.VisibilityExportWatermark().IsZero())
+
+	watermark := time.Date(2023, 4, 5, 6, 7, 8, 9, time.UTC)
+	ns := base.Clone(
+		namespace.WithData(namespace.VisibilityExportURIDataKey, "s3://bucket/prefix"),
+		namespace.WithData(namespace.VisibilityExportWatermarkDataKey, namespace.FormatVisibilityExportWatermark(watermark)),
+	)
+	assert.Equal(t, "s3://bucket/prefix", ns.VisibilityExportURI())
+	assert.Equal(t, watermark, ns.VisibilityExportWatermark())
+
+	ns = ns.Clone(namespace.WithData(namespace.VisibilityExportWatermarkDataKey, "invalid"))
+	assert.True(t, ns.VisibilityExportWatermark().IsZero())
+}
@@ -170,6 +170,7 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceCreationReplicatio
 				VisibilityArchivalState:      task.Config.GetVisibilityArchivalState(),
 				VisibilityArchivalUri:        task.Config.GetVisibilityArchivalUri(),
 				CustomSearchAttributeAliases: task.Config.GetCustomSearchAttributeAliases(),
+				VisibilityRetention:          task.GetVisibilityRetention(),
 			},
 			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
 				ActiveClusterName: task.ReplicationConfig.GetActiveClusterName(),
@@ -286,6 +287,7 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceUpdateReplicationT
 			VisibilityArchivalState:      task.Config.GetVisibilityArchivalState(),
 			VisibilityArchivalUri:        task.Config.GetVisibilityArchivalUri(),
 			CustomSearchAttributeAliases: task.Config.GetCustomSearchAttributeAliases(),
+			VisibilityRetention:          task.GetVisibilityRetention(),
 		}
 		if task.Config.GetBadBinaries() != nil {
 			request.Namespace.Config.BadBinaries = task.Config.GetBadBinaries()
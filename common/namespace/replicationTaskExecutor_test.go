@@ -43,6 +43,7 @@ import (
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
 )
 
 type (
@@ -435,9 +436,10 @@ func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_
 			ActiveClusterName: updateClusterActive,
 			Clusters:          updateClusters,
 		},
-		ConfigVersion:   updateConfigVersion,
-		FailoverVersion: updateFailoverVersion,
-		FailoverHistory: failoverHistory,
+		ConfigVersion:       updateConfigVersion,
+		FailoverVersion:     updateFailoverVersion,
+		FailoverHistory:     failoverHistory,
+		VisibilityRetention: timestamp.DurationFromDays(365),
 	}
 
 	s.namespaceReplicator.currentCluster = updateClusterStandby
@@ -468,6 +470,7 @@ func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_
 				HistoryArchivalUri:      updateTask.Config.HistoryArchivalUri,
 				VisibilityArchivalState: updateTask.Config.VisibilityArchivalState,
 				VisibilityArchivalUri:   updateTask.Config.VisibilityArchivalUri,
+				VisibilityRetention:     updateTask.VisibilityRetention,
 			},
 			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
 				ActiveClusterName: updateTask.ReplicationConfig.ActiveClusterName,
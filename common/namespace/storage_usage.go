@@ -0,0 +1,101 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	// StorageTypeHistory is the storage consumed by workflow history events
+	StorageTypeHistory = "history"
+	// StorageTypeMutableState is the storage consumed by workflow mutable state
+	StorageTypeMutableState = "mutable_state"
+	// StorageTypeVisibility is the storage consumed by visibility records (memo and search attributes)
+	StorageTypeVisibility = "visibility"
+
+	storageUsageDataKeyPrefix = "temporal.storage-usage."
+)
+
+type (
+	// StorageUsage is the approximate number of bytes a namespace consumes in persistence.
+	// It is computed by the storage usage scanner and stored in the namespace data, so it is
+	// returned by DescribeNamespace and is available to every service through the registry.
+	StorageUsage struct {
+		HistoryBytes      int64
+		MutableStateBytes int64
+		VisibilityBytes   int64
+	}
+)
+
+// StorageUsageDataKey returns the namespace data key holding usage of the given storage type.
+func StorageUsageDataKey(storageType string) string {
+	return storageUsageDataKeyPrefix + storageType
+}
+
+// IsStorageUsageDataKey reports whether a namespace data key holds storage usage.
+func IsStorageUsageDataKey(key string) bool {
+	return strings.HasPrefix(key, storageUsageDataKeyPrefix)
+}
+
+// TotalBytes returns the number of bytes consumed across all storage types.
+func (u StorageUsage) TotalBytes() int64 {
+	return u.HistoryBytes + u.MutableStateBytes + u.VisibilityBytes
+}
+
+// ByStorageType returns the usage keyed by storage type.
+func (u StorageUsage) ByStorageType() map[string]int64 {
+	return map[string]int64{
+		StorageTypeHistory:      u.HistoryBytes,
+		StorageTypeMutableState: u.MutableStateBytes,
+		StorageTypeVisibility:   u.VisibilityBytes,
+	}
+}
+
+// ToData writes the usage into the given namespace data map.
+func (u StorageUsage) ToData(data map[string]string) {
+	for storageType, bytes := range u.ByStorageType() {
+		data[StorageUsageDataKey(storageType)] = strconv.FormatInt(bytes, 10)
+	}
+}
+
+// StorageUsage returns the storage usage last recorded for this namespace. Usage that was never
+// recorded, or that cannot be parsed, is reported as zero.
+func (ns *Namespace) StorageUsage() StorageUsage {
+	return StorageUsage{
+		HistoryBytes:      ns.storageUsageBytes(StorageTypeHistory),
+		MutableStateBytes: ns.storageUsageBytes(StorageTypeMutableState),
+		VisibilityBytes:   ns.storageUsageBytes(StorageTypeVisibility),
+	}
+}
+
+func (ns *Namespace) storageUsageBytes(storageType string) int64 {
+	bytes, err := strconv.ParseInt(ns.GetCustomData(StorageUsageDataKey(storageType)), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return bytes
+}
@@ -124,9 +124,10 @@ func (namespaceReplicator *namespaceReplicatorImpl) HandleTransmissionTask(
 				ActiveClusterName: replicationConfig.ActiveClusterName,
 				Clusters:          convertClusterReplicationConfigToProto(replicationConfig.Clusters),
 			},
-			ConfigVersion:   configVersion,
-			FailoverVersion: failoverVersion,
-			FailoverHistory: convertFailoverHistoryToReplicationProto(failoverHistoy),
+			ConfigVersion:       configVersion,
+			FailoverVersion:     failoverVersion,
+			FailoverHistory:     convertFailoverHistoryToReplicationProto(failoverHistoy),
+			VisibilityRetention: config.VisibilityRetention,
 		},
 	}
 
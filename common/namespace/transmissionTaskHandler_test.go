@@ -231,6 +231,7 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_UpdateNamespaceTask_I
 	ownerEmail := "some random test owner"
 	data := map[string]string{"k": "v"}
 	retention := 10 * time.Hour * 24
+	visibilityRetention := 365 * time.Hour * 24
 	historyArchivalState := enumspb.ARCHIVAL_STATE_ENABLED
 	historyArchivalURI := "some random history archival uri"
 	visibilityArchivalState := enumspb.ARCHIVAL_STATE_ENABLED
@@ -257,6 +258,7 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_UpdateNamespaceTask_I
 		VisibilityArchivalState: visibilityArchivalState,
 		VisibilityArchivalUri:   visibilityArchivalURI,
 		BadBinaries:             &namespacepb.BadBinaries{Binaries: map[string]*namespacepb.BadBinaryInfo{}},
+		VisibilityRetention:     &visibilityRetention,
 	}
 	replicationConfig := &persistencespb.NamespaceReplicationConfig{
 		ActiveClusterName: clusterActive,
@@ -289,8 +291,10 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_UpdateNamespaceTask_I
 					ActiveClusterName: clusterActive,
 					Clusters:          convertClusterReplicationConfigToProto(clusters),
 				},
-				ConfigVersion:   configVersion,
-				FailoverVersion: failoverVersion},
+				ConfigVersion:       configVersion,
+				FailoverVersion:     failoverVersion,
+				VisibilityRetention: &visibilityRetention,
+			},
 		},
 	}).Return(nil)
 
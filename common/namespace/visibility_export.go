@@ -0,0 +1,58 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"time"
+)
+
+const (
+	// VisibilityExportURIDataKey is the namespace data key holding the URI the visibility records of closed
+	// workflow executions are periodically exported to, e.g. "s3://bucket/prefix".
+	VisibilityExportURIDataKey = "temporal.visibility-export-uri"
+	// VisibilityExportWatermarkDataKey is the namespace data key holding the close time up to which visibility
+	// records have been exported. It is written by the visibility export scanner.
+	VisibilityExportWatermarkDataKey = "temporal.visibility-export-watermark"
+)
+
+// VisibilityExportURI returns the URI visibility records are exported to, or an empty string if they aren't.
+func (ns *Namespace) VisibilityExportURI() string {
+	return ns.GetCustomData(VisibilityExportURIDataKey)
+}
+
+// VisibilityExportWatermark returns the close time up to which visibility records have been exported. It is
+// zero if nothing has been exported yet, or if the watermark cannot be parsed.
+func (ns *Namespace) VisibilityExportWatermark() time.Time {
+	watermark, err := time.Parse(time.RFC3339Nano, ns.GetCustomData(VisibilityExportWatermarkDataKey))
+	if err != nil {
+		return time.Time{}
+	}
+	return watermark
+}
+
+// FormatVisibilityExportWatermark formats a watermark to be stored under VisibilityExportWatermarkDataKey.
+func FormatVisibilityExportWatermark(watermark time.Time) string {
+	return watermark.UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,53 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"time"
+)
+
+// HasSeparateVisibilityRetention returns true if the visibility records of closed workflow executions are
+// retained independently of their history.
+func (ns *Namespace) HasSeparateVisibilityRetention() bool {
+	_, ok := ns.separateVisibilityRetention()
+	return ok
+}
+
+// VisibilityRetention returns how long the visibility records of closed workflow executions are kept.
+// It is the workflow execution retention unless the namespace config sets a visibility retention.
+func (ns *Namespace) VisibilityRetention() time.Duration {
+	if retention, ok := ns.separateVisibilityRetention(); ok {
+		return retention
+	}
+	return ns.Retention()
+}
+
+func (ns *Namespace) separateVisibilityRetention() (time.Duration, bool) {
+	retention := ns.config.VisibilityRetention
+	if retention == nil || *retention <= 0 {
+		return 0, false
+	}
+	return *retention, true
+}
@@ -0,0 +1,81 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStore(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewStore("file://" + filepath.ToSlash(dir))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = store.Get(ctx, "snapshot/manifest")
+	require.ErrorIs(t, err, ErrObjectNotFound)
+
+	require.NoError(t, store.Put(ctx, "snapshot/manifest", bytes.NewReader([]byte("v1"))))
+	require.NoError(t, store.Put(ctx, "snapshot/manifest", bytes.NewReader([]byte("v2"))))
+	reader, err := store.Get(ctx, "snapshot/manifest")
+	require.NoError(t, err)
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Equal(t, "v2", string(content))
+
+	require.NoError(t, store.Delete(ctx, "snapshot/manifest"))
+	require.NoError(t, store.Delete(ctx, "snapshot/manifest"))
+	_, err = store.Get(ctx, "snapshot/manifest")
+	require.ErrorIs(t, err, ErrObjectNotFound)
+
+	require.Error(t, store.Put(ctx, "../outside", bytes.NewReader(nil)))
+
+	keys, err := store.List(ctx, "payloads/")
+	require.NoError(t, err)
+	require.Empty(t, keys)
+	for _, key := range []string{"payloads/tree/branch-1/1", "payloads/tree/branch-1/2", "payloads/tree/branch-2/1"} {
+		require.NoError(t, store.Put(ctx, key, bytes.NewReader(nil)))
+	}
+	keys, err = store.List(ctx, "payloads/tree/branch-1")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"payloads/tree/branch-1/1", "payloads/tree/branch-1/2"}, keys)
+	keys, err = store.List(ctx, "payloads/")
+	require.NoError(t, err)
+	require.Len(t, keys, 3)
+}
+
+func TestNewStore_InvalidURI(t *testing.T) {
+	_, err := NewStore("azure://bucket/prefix")
+	require.Error(t, err)
+	_, err = NewStore("s3://bucket/prefix")
+	require.Error(t, err)
+}
@@ -0,0 +1,179 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+const (
+	gcsEndpoint = "https://storage.googleapis.com"
+	gcsRegion   = "auto"
+)
+
+type (
+	s3Store struct {
+		client   *s3.S3
+		uploader *s3manager.Uploader
+		bucket   string
+		prefix   string
+	}
+)
+
+var _ Store = (*s3Store)(nil)
+
+// NewS3Store creates a Store which keeps objects in the bucket named by the URI host, under the URI path.
+// The region, endpoint and s3ForcePathStyle query parameters configure the S3 client.
+func NewS3Store(
+	uri *url.URL,
+) (Store, error) {
+	if len(uri.Host) == 0 {
+		return nil, errors.New("S3 object store URI requires a bucket")
+	}
+	query := uri.Query()
+	if len(query.Get("region")) == 0 {
+		return nil, errors.New("S3 object store URI requires a region query parameter")
+	}
+
+	s3Config := &aws.Config{
+		Region: aws.String(query.Get("region")),
+	}
+	if endpoint := query.Get("endpoint"); len(endpoint) != 0 {
+		s3Config.Endpoint = aws.String(endpoint)
+	}
+	if forcePathStyle, err := strconv.ParseBool(query.Get("s3ForcePathStyle")); err == nil {
+		s3Config.S3ForcePathStyle = aws.Bool(forcePathStyle)
+	}
+	sess, err := session.NewSession(s3Config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3Store{
+		client:   s3.New(sess),
+		uploader: s3manager.NewUploader(sess),
+		bucket:   uri.Host,
+		prefix:   strings.Trim(uri.Path, "/"),
+	}, nil
+}
+
+// NewGCSStore creates a Store which keeps objects in the GCS bucket named by the URI host, under the URI path.
+// It goes through the S3 compatible XML API of GCS, authenticating with HMAC keys passed as AWS credentials.
+// The endpoint query parameter overrides the GCS endpoint.
+func NewGCSStore(
+	uri *url.URL,
+) (Store, error) {
+	s3URI := *uri
+	query := s3URI.Query()
+	if len(query.Get("endpoint")) == 0 {
+		query.Set("endpoint", gcsEndpoint)
+	}
+	if len(query.Get("region")) == 0 {
+		query.Set("region", gcsRegion)
+	}
+	s3URI.RawQuery = query.Encode()
+	return NewS3Store(&s3URI)
+}
+
+func (s *s3Store) Put(
+	ctx context.Context,
+	key string,
+	body io.Reader,
+) error {
+	_, err := s.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(key)),
+		Body:   body,
+	})
+	return err
+}
+
+func (s *s3Store) Get(
+	ctx context.Context,
+	key string,
+) (io.ReadCloser, error) {
+	output, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(key)),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, ErrObjectNotFound
+		}
+		return nil, err
+	}
+	return output.Body, nil
+}
+
+func (s *s3Store) Delete(
+	ctx context.Context,
+	key string,
+) error {
+	_, err := s.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(key)),
+	})
+	return err
+}
+
+func (s *s3Store) List(
+	ctx context.Context,
+	prefix string,
+) ([]string, error) {
+	storePrefix := ""
+	if len(s.prefix) != 0 {
+		storePrefix = s.prefix + "/"
+	}
+
+	var keys []string
+	err := s.client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(storePrefix + prefix),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, object := range page.Contents {
+			keys = append(keys, strings.TrimPrefix(aws.StringValue(object.Key), storePrefix))
+		}
+		return true
+	})
+	return keys, err
+}
+
+func (s *s3Store) key(
+	key string,
+) string {
+	return path.Join(s.prefix, key)
+}
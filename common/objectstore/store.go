@@ -0,0 +1,84 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package objectstore provides a minimal blob storage abstraction over the local filesystem, S3 and GCS,
+// used to keep large server-side artifacts such as cluster snapshots and offloaded payloads out of the datastore.
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+)
+
+const (
+	// SchemeFile is the URI scheme of stores backed by a local directory, e.g. file:///var/temporal/snapshots
+	SchemeFile = "file"
+	// SchemeS3 is the URI scheme of stores backed by a S3 bucket, e.g. s3://bucket/prefix?region=us-east-1
+	SchemeS3 = "s3"
+	// SchemeGCS is the URI scheme of stores backed by a GCS bucket through its S3 compatible API, e.g. gs://bucket/prefix
+	SchemeGCS = "gs"
+)
+
+var (
+	// ErrObjectNotFound is returned when reading an object which doesn't exist
+	ErrObjectNotFound = errors.New("object not found")
+)
+
+type (
+	// Store is a flat key value store of objects, keys may contain slashes
+	Store interface {
+		// Put writes the content read from body to the object with the given key, replacing any existing object
+		Put(ctx context.Context, key string, body io.Reader) error
+		// Get returns a reader of the object with the given key, which must be closed by the caller
+		Get(ctx context.Context, key string) (io.ReadCloser, error)
+		// Delete deletes the object with the given key, deleting a missing object is not an error
+		Delete(ctx context.Context, key string) error
+		// List returns the keys of all objects whose key starts with the given prefix
+		List(ctx context.Context, prefix string) ([]string, error)
+	}
+)
+
+// NewStore creates a Store from its URI
+func NewStore(
+	uri string,
+) (Store, error) {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("invalid object store URI %q: %w", uri, err)
+	}
+
+	switch parsed.Scheme {
+	case SchemeFile:
+		return NewFileStore(parsed.Path)
+	case SchemeS3:
+		return NewS3Store(parsed)
+	case SchemeGCS:
+		return NewGCSStore(parsed)
+	default:
+		return nil, fmt.Errorf("unsupported object store URI scheme %q", parsed.Scheme)
+	}
+}
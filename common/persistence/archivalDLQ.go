@@ -0,0 +1,156 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination archivalDLQ_mock.go
+
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+var _ ArchivalDLQ = (*archivalDLQImpl)(nil)
+
+type (
+	// ArchivalDLQ stores the archival tasks which exhausted their retries, so that operators can list them and
+	// retry them once the archival backend is fixed. The history of a dead lettered workflow is kept in the
+	// primary store until its archival task is retried successfully.
+	ArchivalDLQ interface {
+		Enqueue(ctx context.Context, task *ArchivalDLQTask) (int64, error)
+		List(ctx context.Context, pageSize int, pageToken []byte) ([]*ArchivalDLQTask, []byte, error)
+		Get(ctx context.Context, messageID int64) (*ArchivalDLQTask, error)
+		Delete(ctx context.Context, messageID int64) error
+	}
+
+	// ArchivalDLQTask is an archival task which exhausted its retries.
+	ArchivalDLQTask struct {
+		// MessageID is the ID of the task in the DLQ, it is set when the task is read.
+		MessageID    int64     `json:"-"`
+		ShardID      int32     `json:"shard_id"`
+		NamespaceID  string    `json:"namespace_id"`
+		WorkflowID   string    `json:"workflow_id"`
+		RunID        string    `json:"run_id"`
+		TaskID       int64     `json:"task_id"`
+		Version      int64     `json:"version"`
+		Attempt      int       `json:"attempt"`
+		LastError    string    `json:"last_error"`
+		EnqueuedTime time.Time `json:"enqueued_time"`
+	}
+
+	archivalDLQImpl struct {
+		queue Queue
+	}
+)
+
+// NewArchivalDLQ creates a new ArchivalDLQ backed by the DLQ of the given queue
+func NewArchivalDLQ(
+	queue Queue,
+	serializer serialization.Serializer,
+) (ArchivalDLQ, error) {
+	blob, err := serializer.QueueMetadataToBlob(
+		&persistence.QueueMetadata{
+			ClusterAckLevels: make(map[string]int64),
+		}, enumspb.ENCODING_TYPE_PROTO3)
+	if err != nil {
+		return nil, err
+	}
+	if err := queue.Init(context.TODO(), blob); err != nil {
+		return nil, err
+	}
+
+	return &archivalDLQImpl{
+		queue: queue,
+	}, nil
+}
+
+func (q *archivalDLQImpl) Enqueue(
+	ctx context.Context,
+	task *ArchivalDLQTask,
+) (int64, error) {
+	data, err := json.Marshal(task)
+	if err != nil {
+		return EmptyQueueMessageID, err
+	}
+	return q.queue.EnqueueMessageToDLQ(ctx, *NewDataBlob(data, enumspb.ENCODING_TYPE_JSON.String()))
+}
+
+func (q *archivalDLQImpl) List(
+	ctx context.Context,
+	pageSize int,
+	pageToken []byte,
+) ([]*ArchivalDLQTask, []byte, error) {
+	messages, token, err := q.queue.ReadMessagesFromDLQ(ctx, EmptyQueueMessageID, MaxQueueMessageID, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tasks := make([]*ArchivalDLQTask, 0, len(messages))
+	for _, message := range messages {
+		task, err := archivalDLQTaskFromMessage(message)
+		if err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, token, nil
+}
+
+func (q *archivalDLQImpl) Get(
+	ctx context.Context,
+	messageID int64,
+) (*ArchivalDLQTask, error) {
+	messages, _, err := q.queue.ReadMessagesFromDLQ(ctx, messageID-1, messageID, 1, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) == 0 || messages[0].ID != messageID {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("archival task %v not found in DLQ", messageID))
+	}
+	return archivalDLQTaskFromMessage(messages[0])
+}
+
+func (q *archivalDLQImpl) Delete(
+	ctx context.Context,
+	messageID int64,
+) error {
+	return q.queue.DeleteMessageFromDLQ(ctx, messageID)
+}
+
+func archivalDLQTaskFromMessage(message *QueueMessage) (*ArchivalDLQTask, error) {
+	task := &ArchivalDLQTask{}
+	if err := json.Unmarshal(message.Data, task); err != nil {
+		return nil, fmt.Errorf("failed to decode archival dlq task: %v", err)
+	}
+	task.MessageID = message.ID
+	return task, nil
+}
@@ -0,0 +1,119 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: archivalDLQ.go
+
+// Package persistence is a generated GoMock package.
+package persistence
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockArchivalDLQ is a mock of ArchivalDLQ interface.
+type MockArchivalDLQ struct {
+	ctrl     *gomock.Controller
+	recorder *MockArchivalDLQMockRecorder
+}
+
+// MockArchivalDLQMockRecorder is the mock recorder for MockArchivalDLQ.
+type MockArchivalDLQMockRecorder struct {
+	mock *MockArchivalDLQ
+}
+
+// NewMockArchivalDLQ creates a new mock instance.
+func NewMockArchivalDLQ(ctrl *gomock.Controller) *MockArchivalDLQ {
+	mock := &MockArchivalDLQ{ctrl: ctrl}
+	mock.recorder = &MockArchivalDLQMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArchivalDLQ) EXPECT() *MockArchivalDLQMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockArchivalDLQ) Delete(ctx context.Context, messageID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockArchivalDLQMockRecorder) Delete(ctx, messageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockArchivalDLQ)(nil).Delete), ctx, messageID)
+}
+
+// Enqueue mocks base method.
+func (m *MockArchivalDLQ) Enqueue(ctx context.Context, task *ArchivalDLQTask) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", ctx, task)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockArchivalDLQMockRecorder) Enqueue(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockArchivalDLQ)(nil).Enqueue), ctx, task)
+}
+
+// Get mocks base method.
+func (m *MockArchivalDLQ) Get(ctx context.Context, messageID int64) (*ArchivalDLQTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, messageID)
+	ret0, _ := ret[0].(*ArchivalDLQTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockArchivalDLQMockRecorder) Get(ctx, messageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockArchivalDLQ)(nil).Get), ctx, messageID)
+}
+
+// List mocks base method.
+func (m *MockArchivalDLQ) List(ctx context.Context, pageSize int, pageToken []byte) ([]*ArchivalDLQTask, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, pageSize, pageToken)
+	ret0, _ := ret[0].([]*ArchivalDLQTask)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockArchivalDLQMockRecorder) List(ctx, pageSize, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockArchivalDLQ)(nil).List), ctx, pageSize, pageToken)
+}
@@ -117,11 +117,12 @@ var _ p.ExecutionStore = (*ExecutionStore)(nil)
 
 func NewExecutionStore(
 	session gocql.Session,
+	unconditionalUpdates *UnconditionalUpdateConfig,
 	logger log.Logger,
 ) *ExecutionStore {
 	return &ExecutionStore{
 		HistoryStore:          NewHistoryStore(session, logger),
-		MutableStateStore:     NewMutableStateStore(session, logger),
+		MutableStateStore:     NewMutableStateStore(session, unconditionalUpdates, logger),
 		MutableStateTaskStore: NewMutableStateTaskStore(session, logger),
 	}
 }
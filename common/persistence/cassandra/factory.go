@@ -41,20 +41,23 @@ type (
 	// Factory vends datastore implementations backed by cassandra
 	Factory struct {
 		sync.RWMutex
-		cfg         config.Cassandra
-		clusterName string
-		logger      log.Logger
-		session     commongocql.Session
+		cfg                  config.Cassandra
+		clusterName          string
+		logger               log.Logger
+		session              commongocql.Session
+		unconditionalUpdates *UnconditionalUpdateConfig
 	}
 )
 
 // NewFactory returns an instance of a factory object which can be used to create
-// data stores that are backed by cassandra
+// data stores that are backed by cassandra. Execution updates are written without lightweight
+// transactions according to unconditionalUpdates, nil keeps them conditional.
 func NewFactory(
 	cfg config.Cassandra,
 	r resolver.ServiceResolver,
 	clusterName string,
 	logger log.Logger,
+	unconditionalUpdates *UnconditionalUpdateConfig,
 ) *Factory {
 	session, err := commongocql.NewSession(
 		func() (*gocql.ClusterConfig, error) {
@@ -65,7 +68,9 @@ func NewFactory(
 	if err != nil {
 		logger.Fatal("unable to initialize cassandra session", tag.Error(err))
 	}
-	return NewFactoryFromSession(cfg, clusterName, logger, session)
+	factory := NewFactoryFromSession(cfg, clusterName, logger, session)
+	factory.unconditionalUpdates = unconditionalUpdates
+	return factory
 }
 
 // NewFactoryFromSession returns an instance of a factory object from the given session.
@@ -85,7 +90,7 @@ func NewFactoryFromSession(
 
 // NewTaskStore returns a new task store
 func (f *Factory) NewTaskStore() (p.TaskStore, error) {
-	return NewMatchingTaskStore(f.session, f.logger), nil
+	return NewMatchingTaskStore(f.session, f.cfg.TaskBacklog, f.logger), nil
 }
 
 // NewShardStore returns a new shard store
@@ -105,7 +110,7 @@ func (f *Factory) NewClusterMetadataStore() (p.ClusterMetadataStore, error) {
 
 // NewExecutionStore returns a new ExecutionStore.
 func (f *Factory) NewExecutionStore() (p.ExecutionStore, error) {
-	return NewExecutionStore(f.session, f.logger), nil
+	return NewExecutionStore(f.session, f.unconditionalUpdates, f.logger), nil
 }
 
 // NewQueue returns a new queue backed by cassandra
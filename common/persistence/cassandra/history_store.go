@@ -133,6 +133,15 @@ func (h *HistoryStore) AppendHistoryNodes(
 	return nil
 }
 
+// AppendHistoryNodesBatch is not supported. History nodes of different trees live in different
+// partitions, which cannot be written atomically, so callers must append them one at a time.
+func (h *HistoryStore) AppendHistoryNodesBatch(
+	_ context.Context,
+	_ *p.InternalAppendHistoryNodesBatchRequest,
+) error {
+	return serviceerror.NewUnimplemented("AppendHistoryNodesBatch is not supported by cassandra")
+}
+
 // DeleteHistoryNodes delete a history node
 func (h *HistoryStore) DeleteHistoryNodes(
 	ctx context.Context,
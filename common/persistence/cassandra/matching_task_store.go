@@ -27,14 +27,17 @@ package cassandra
 import (
 	"context"
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 
+	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/nosql/nosqlplugin/cassandra/gocql"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -58,6 +61,13 @@ const (
 		`and task_id >= ? ` +
 		`and task_id < ?`
 
+	templateGetTasksWithTTLQuery = `SELECT task_id, task, task_encoding, TTL(task) ` +
+		`FROM tasks ` +
+		`WHERE namespace_id = ? ` +
+		`and task_queue_name = ? ` +
+		`and task_queue_type = ? ` +
+		`and type = ?`
+
 	templateCompleteTaskQuery = `DELETE FROM tasks ` +
 		`WHERE namespace_id = ? ` +
 		`and task_queue_name = ? ` +
@@ -161,22 +171,29 @@ const (
 
 	// Not much of a need to make this configurable, we're just reading some strings
 	listTaskQueueNamesByBuildIdPageSize = 100
+
+	taskBacklogCopyBatchSize = 100
 )
 
 type (
 	MatchingTaskStore struct {
 		Session gocql.Session
 		Logger  log.Logger
+
+		// ttlEnabled stores task queues in the task_backlog table, where tasks with an expiry time expire through a TTL
+		ttlEnabled bool
 	}
 )
 
 func NewMatchingTaskStore(
 	session gocql.Session,
+	taskBacklog *config.CassandraTaskBacklog,
 	logger log.Logger,
 ) *MatchingTaskStore {
 	return &MatchingTaskStore{
-		Session: session,
-		Logger:  logger,
+		Session:    session,
+		Logger:     logger,
+		ttlEnabled: taskBacklog != nil && taskBacklog.TTLEnabled,
 	}
 }
 
@@ -184,7 +201,7 @@ func (d *MatchingTaskStore) CreateTaskQueue(
 	ctx context.Context,
 	request *p.InternalCreateTaskQueueRequest,
 ) error {
-	query := d.Session.Query(templateInsertTaskQueueQuery,
+	query := d.Session.Query(d.table(templateInsertTaskQueueQuery),
 		request.NamespaceID,
 		request.TaskQueue,
 		request.TaskType,
@@ -216,7 +233,7 @@ func (d *MatchingTaskStore) GetTaskQueue(
 	ctx context.Context,
 	request *p.InternalGetTaskQueueRequest,
 ) (*p.InternalGetTaskQueueResponse, error) {
-	query := d.Session.Query(templateGetTaskQueueQuery,
+	query := d.Session.Query(d.table(templateGetTaskQueueQuery),
 		request.NamespaceID,
 		request.TaskQueue,
 		request.TaskType,
@@ -228,6 +245,9 @@ func (d *MatchingTaskStore) GetTaskQueue(
 	var tlBytes []byte
 	var tlEncoding string
 	if err := query.Scan(&rangeID, &tlBytes, &tlEncoding); err != nil {
+		if d.ttlEnabled && gocql.IsNotFoundError(err) {
+			return d.moveTaskQueue(ctx, request)
+		}
 		return nil, gocql.ConvertError("GetTaskQueue", err)
 	}
 
@@ -254,7 +274,7 @@ func (d *MatchingTaskStore) UpdateTaskQueue(
 			expiryTTL = maxCassandraTTL
 		}
 		batch := d.Session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
-		batch.Query(templateUpdateTaskQueueQueryWithTTLPart1,
+		batch.Query(d.table(templateUpdateTaskQueueQueryWithTTLPart1),
 			request.NamespaceID,
 			request.TaskQueue,
 			request.TaskType,
@@ -262,7 +282,7 @@ func (d *MatchingTaskStore) UpdateTaskQueue(
 			taskQueueTaskID,
 			expiryTTL,
 		)
-		batch.Query(templateUpdateTaskQueueQueryWithTTLPart2,
+		batch.Query(d.table(templateUpdateTaskQueueQueryWithTTLPart2),
 			expiryTTL,
 			request.RangeID,
 			request.TaskQueueInfo.Data,
@@ -276,7 +296,7 @@ func (d *MatchingTaskStore) UpdateTaskQueue(
 		)
 		applied, _, err = d.Session.MapExecuteBatchCAS(batch, previous)
 	} else {
-		query := d.Session.Query(templateUpdateTaskQueueQuery,
+		query := d.Session.Query(d.table(templateUpdateTaskQueueQuery),
 			request.RangeID,
 			request.TaskQueueInfo.Data,
 			request.TaskQueueInfo.EncodingType.String(),
@@ -321,7 +341,7 @@ func (d *MatchingTaskStore) DeleteTaskQueue(
 	request *p.DeleteTaskQueueRequest,
 ) error {
 	query := d.Session.Query(
-		templateDeleteTaskQueueQuery,
+		d.table(templateDeleteTaskQueueQuery),
 		request.TaskQueue.NamespaceID,
 		request.TaskQueue.TaskQueueName,
 		request.TaskQueue.TaskQueueType,
@@ -356,7 +376,7 @@ func (d *MatchingTaskStore) CreateTasks(
 		ttl := GetTaskTTL(task.ExpiryTime)
 
 		if ttl <= 0 || ttl > maxCassandraTTL {
-			batch.Query(templateCreateTaskQuery,
+			batch.Query(d.table(templateCreateTaskQuery),
 				namespaceID,
 				taskQueue,
 				taskQueueType,
@@ -365,7 +385,7 @@ func (d *MatchingTaskStore) CreateTasks(
 				task.Task.Data,
 				task.Task.EncodingType.String())
 		} else {
-			batch.Query(templateCreateTaskWithTTLQuery,
+			batch.Query(d.table(templateCreateTaskWithTTLQuery),
 				namespaceID,
 				taskQueue,
 				taskQueueType,
@@ -378,7 +398,7 @@ func (d *MatchingTaskStore) CreateTasks(
 	}
 
 	// The following query is used to ensure that range_id didn't change
-	batch.Query(templateUpdateTaskQueueQuery,
+	batch.Query(d.table(templateUpdateTaskQueueQuery),
 		request.RangeID,
 		request.TaskQueueInfo.Data,
 		request.TaskQueueInfo.EncodingType.String(),
@@ -428,7 +448,7 @@ func (d *MatchingTaskStore) GetTasks(
 	request *p.GetTasksRequest,
 ) (*p.InternalGetTasksResponse, error) {
 	// Reading taskqueue tasks need to be quorum level consistent, otherwise we could lose tasks
-	query := d.Session.Query(templateGetTasksQuery,
+	query := d.Session.Query(d.table(templateGetTasksQuery),
 		request.NamespaceID,
 		request.TaskQueue,
 		request.TaskType,
@@ -486,7 +506,7 @@ func (d *MatchingTaskStore) CompleteTask(
 	request *p.CompleteTaskRequest,
 ) error {
 	tli := request.TaskQueue
-	query := d.Session.Query(templateCompleteTaskQuery,
+	query := d.Session.Query(d.table(templateCompleteTaskQuery),
 		tli.NamespaceID,
 		tli.TaskQueueName,
 		tli.TaskQueueType,
@@ -510,7 +530,7 @@ func (d *MatchingTaskStore) CompleteTasksLessThan(
 	request *p.CompleteTasksLessThanRequest,
 ) (int, error) {
 	query := d.Session.Query(
-		templateCompleteTasksLessThanQuery,
+		d.table(templateCompleteTasksLessThanQuery),
 		request.NamespaceID,
 		request.TaskQueueName,
 		request.TaskType,
@@ -524,6 +544,178 @@ func (d *MatchingTaskStore) CompleteTasksLessThan(
 	return p.UnknownNumRowsAffected, nil
 }
 
+// moveTaskQueue moves a task queue and its backlog from the tasks table to the task_backlog table. The task queue row
+// of the tasks table is fenced first, so the previous owner can't add tasks while the backlog is copied. Every step is
+// idempotent, a move interrupted half way is redone by the next load of the task queue.
+func (d *MatchingTaskStore) moveTaskQueue(
+	ctx context.Context,
+	request *p.InternalGetTaskQueueRequest,
+) (*p.InternalGetTaskQueueResponse, error) {
+	query := d.Session.Query(templateGetTaskQueueQuery,
+		request.NamespaceID,
+		request.TaskQueue,
+		request.TaskType,
+		rowTypeTaskQueue,
+		taskQueueTaskID,
+	).WithContext(ctx)
+
+	var rangeID int64
+	var tlBytes []byte
+	var tlEncoding string
+	if err := query.Scan(&rangeID, &tlBytes, &tlEncoding); err != nil {
+		return nil, gocql.ConvertError("GetTaskQueue", err)
+	}
+
+	query = d.Session.Query(templateUpdateTaskQueueQuery,
+		rangeID+1,
+		tlBytes,
+		tlEncoding,
+		request.NamespaceID,
+		request.TaskQueue,
+		request.TaskType,
+		rowTypeTaskQueue,
+		taskQueueTaskID,
+		rangeID,
+	).WithContext(ctx)
+	applied, err := query.MapScanCAS(make(map[string]interface{}))
+	if err != nil {
+		return nil, gocql.ConvertError("GetTaskQueue", err)
+	}
+	if !applied {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetTaskQueue: TaskQueue:%v, TaskQueueType:%v is being moved to task_backlog",
+			request.TaskQueue, request.TaskType))
+	}
+	rangeID++
+
+	if err := d.copyBacklog(ctx, request); err != nil {
+		return nil, err
+	}
+
+	query = d.Session.Query(d.table(templateInsertTaskQueueQuery),
+		request.NamespaceID,
+		request.TaskQueue,
+		request.TaskType,
+		rowTypeTaskQueue,
+		taskQueueTaskID,
+		rangeID,
+		tlBytes,
+		tlEncoding,
+	).WithContext(ctx)
+	applied, err = query.MapScanCAS(make(map[string]interface{}))
+	if err != nil {
+		return nil, gocql.ConvertError("GetTaskQueue", err)
+	}
+	if !applied {
+		// moved by another host in the meantime
+		return d.GetTaskQueue(ctx, request)
+	}
+
+	// the tasks table isn't read anymore, the backlog and task queue row left there are dropped once
+	query = d.Session.Query(templateCompleteTasksLessThanQuery,
+		request.NamespaceID,
+		request.TaskQueue,
+		request.TaskType,
+		rowTypeTask,
+		int64(math.MaxInt64),
+	).WithContext(ctx)
+	if err := query.Exec(); err != nil {
+		d.Logger.Warn("Failed to delete tasks of moved task queue.", tag.WorkflowTaskQueueName(request.TaskQueue), tag.Error(err))
+	}
+	query = d.Session.Query(templateCompleteTaskQuery,
+		request.NamespaceID,
+		request.TaskQueue,
+		request.TaskType,
+		rowTypeTaskQueue,
+		taskQueueTaskID,
+	).WithContext(ctx)
+	if err := query.Exec(); err != nil {
+		d.Logger.Warn("Failed to delete moved task queue.", tag.WorkflowTaskQueueName(request.TaskQueue), tag.Error(err))
+	}
+
+	d.Logger.Info("Moved task queue to task_backlog.", tag.WorkflowTaskQueueName(request.TaskQueue))
+	return &p.InternalGetTaskQueueResponse{
+		RangeID:       rangeID,
+		TaskQueueInfo: p.NewDataBlob(tlBytes, tlEncoding),
+	}, nil
+}
+
+// copyBacklog copies the tasks of a task queue from the tasks table to the task_backlog table, keeping their
+// remaining TTL. Tasks without TTL are copied without TTL.
+func (d *MatchingTaskStore) copyBacklog(
+	ctx context.Context,
+	request *p.InternalGetTaskQueueRequest,
+) error {
+	iter := d.Session.Query(templateGetTasksWithTTLQuery,
+		request.NamespaceID,
+		request.TaskQueue,
+		request.TaskType,
+		rowTypeTask,
+	).WithContext(ctx).PageSize(taskBacklogCopyBatchSize).Iter()
+
+	batch := d.Session.NewBatch(gocql.UnloggedBatch).WithContext(ctx)
+	size := 0
+	flush := func() error {
+		if size == 0 {
+			return nil
+		}
+		if err := d.Session.ExecuteBatch(batch); err != nil {
+			return gocql.ConvertError("GetTaskQueue", err)
+		}
+		batch = d.Session.NewBatch(gocql.UnloggedBatch).WithContext(ctx)
+		size = 0
+		return nil
+	}
+
+	var taskID int64
+	var task []byte
+	var encoding string
+	var ttl int64
+	for iter.Scan(&taskID, &task, &encoding, &ttl) {
+		if ttl <= 0 {
+			// tasks without an expiry time are stored without TTL
+			batch.Query(d.table(templateCreateTaskQuery),
+				request.NamespaceID,
+				request.TaskQueue,
+				request.TaskType,
+				rowTypeTask,
+				taskID,
+				task,
+				encoding,
+			)
+		} else {
+			batch.Query(d.table(templateCreateTaskWithTTLQuery),
+				request.NamespaceID,
+				request.TaskQueue,
+				request.TaskType,
+				rowTypeTask,
+				taskID,
+				task,
+				encoding,
+				ttl,
+			)
+		}
+		size++
+		if size >= taskBacklogCopyBatchSize {
+			if err := flush(); err != nil {
+				_ = iter.Close()
+				return err
+			}
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return gocql.ConvertError("GetTaskQueue", err)
+	}
+	return flush()
+}
+
+// table returns the query against the table task queues are stored in.
+func (d *MatchingTaskStore) table(query string) string {
+	if !d.ttlEnabled {
+		return query
+	}
+	return strings.Replace(query, " tasks ", " task_backlog ", 1)
+}
+
 func (d *MatchingTaskStore) GetTaskQueueUserData(
 	ctx context.Context,
 	request *p.GetTaskQueueUserDataRequest,
@@ -34,6 +34,7 @@ import (
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/nosql/nosqlplugin/cassandra/gocql"
@@ -52,7 +53,7 @@ const (
 		`and task_id = ? ` +
 		`IF range_id = ?`
 
-	templateUpdateCurrentWorkflowExecutionQuery = `UPDATE executions USING TTL 0 ` +
+	templateUpdateCurrentWorkflowExecutionUnconditionalQuery = `UPDATE executions USING TTL 0 ` +
 		`SET current_run_id = ?, execution_state = ?, execution_state_encoding = ?, workflow_last_write_version = ?, workflow_state = ? ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
@@ -60,7 +61,9 @@ const (
 		`and workflow_id = ? ` +
 		`and run_id = ? ` +
 		`and visibility_ts = ? ` +
-		`and task_id = ? ` +
+		`and task_id = ? `
+
+	templateUpdateCurrentWorkflowExecutionQuery = templateUpdateCurrentWorkflowExecutionUnconditionalQuery +
 		`IF current_run_id = ? `
 
 	templateUpdateCurrentWorkflowExecutionForNewQuery = templateUpdateCurrentWorkflowExecutionQuery +
@@ -125,7 +128,7 @@ const (
 		`and visibility_ts = ? ` +
 		`and task_id = ? ` +
 		`IF next_event_id = ? `
-	templateUpdateWorkflowExecutionQuery = `UPDATE executions ` +
+	templateUpdateWorkflowExecutionUnconditionalQuery = `UPDATE executions ` +
 		`SET execution = ? ` +
 		`, execution_encoding = ? ` +
 		`, execution_state = ? ` +
@@ -140,7 +143,8 @@ const (
 		`and workflow_id = ? ` +
 		`and run_id = ? ` +
 		`and visibility_ts = ? ` +
-		`and task_id = ? ` +
+		`and task_id = ? `
+	templateUpdateWorkflowExecutionQuery = templateUpdateWorkflowExecutionUnconditionalQuery +
 		`IF db_record_version = ? `
 
 	templateUpdateActivityInfoQuery = `UPDATE executions ` +
@@ -359,17 +363,26 @@ type (
 	MutableStateStore struct {
 		Session gocql.Session
 		Logger  log.Logger
+
+		unconditionalUpdates *UnconditionalUpdateConfig
+		shardLeases          *shardLeaseChecker
 	}
 )
 
 func NewMutableStateStore(
 	session gocql.Session,
+	unconditionalUpdates *UnconditionalUpdateConfig,
 	logger log.Logger,
 ) *MutableStateStore {
-	return &MutableStateStore{
-		Session: session,
-		Logger:  logger,
+	store := &MutableStateStore{
+		Session:              session,
+		Logger:               logger,
+		unconditionalUpdates: unconditionalUpdates,
 	}
+	if unconditionalUpdates != nil {
+		store.shardLeases = newShardLeaseChecker(session, clock.NewRealTimeSource(), unconditionalUpdates.ShardLeaseCheckInterval)
+	}
+	return store
 }
 
 func (d *MutableStateStore) CreateWorkflowExecution(
@@ -584,6 +597,13 @@ func (d *MutableStateStore) UpdateWorkflowExecution(
 	runID := updateWorkflow.RunID
 	shardID := request.ShardID
 
+	unconditional := d.isUnconditionalUpdate(request)
+	if unconditional {
+		if err := d.shardLeases.verify(ctx, shardID, request.RangeID); err != nil {
+			return err
+		}
+	}
+
 	switch request.Mode {
 	case p.UpdateWorkflowModeBypassCurrent:
 		if err := d.assertNotCurrentExecution(
@@ -631,7 +651,7 @@ func (d *MutableStateStore) UpdateWorkflowExecution(
 				return err
 			}
 
-			batch.Query(templateUpdateCurrentWorkflowExecutionQuery,
+			args := []interface{}{
 				runID,
 				executionStateDatablob.Data,
 				executionStateDatablob.EncodingType.String(),
@@ -644,15 +664,19 @@ func (d *MutableStateStore) UpdateWorkflowExecution(
 				permanentRunID,
 				defaultVisibilityTimestamp,
 				rowTypeExecutionTaskID,
-				runID,
-			)
+			}
+			if unconditional {
+				batch.Query(templateUpdateCurrentWorkflowExecutionUnconditionalQuery, args...)
+			} else {
+				batch.Query(templateUpdateCurrentWorkflowExecutionQuery, append(args, runID)...)
+			}
 		}
 
 	default:
 		return serviceerror.NewInternal(fmt.Sprintf("UpdateWorkflowExecution: unknown mode: %v", request.Mode))
 	}
 
-	if err := applyWorkflowMutationBatch(batch, shardID, &updateWorkflow); err != nil {
+	if err := applyWorkflowMutationBatch(batch, shardID, &updateWorkflow, !unconditional); err != nil {
 		return err
 	}
 	if newWorkflow != nil {
@@ -664,6 +688,14 @@ func (d *MutableStateStore) UpdateWorkflowExecution(
 		}
 	}
 
+	if unconditional {
+		// the shard lease was verified above instead of the RangeID condition
+		if err := d.Session.ExecuteBatch(batch); err != nil {
+			return gocql.ConvertError("UpdateWorkflowExecution", err)
+		}
+		return nil
+	}
+
 	// Verifies that the RangeID has not changed
 	batch.Query(templateUpdateLeaseQuery,
 		request.RangeID,
@@ -705,6 +737,16 @@ func (d *MutableStateStore) UpdateWorkflowExecution(
 	return nil
 }
 
+// isUnconditionalUpdate returns whether the update is written without lightweight transactions. Updates
+// creating a new run keep them, the new run's current row must not overwrite a concurrent start.
+func (d *MutableStateStore) isUnconditionalUpdate(
+	request *p.InternalUpdateWorkflowExecutionRequest,
+) bool {
+	return d.unconditionalUpdates != nil &&
+		request.NewWorkflowSnapshot == nil &&
+		d.unconditionalUpdates.Enabled()
+}
+
 func (d *MutableStateStore) ConflictResolveWorkflowExecution(
 	ctx context.Context,
 	request *p.InternalConflictResolveWorkflowExecutionRequest,
@@ -805,7 +847,7 @@ func (d *MutableStateStore) ConflictResolveWorkflowExecution(
 	}
 
 	if currentWorkflow != nil {
-		if err := applyWorkflowMutationBatch(batch, shardID, currentWorkflow); err != nil {
+		if err := applyWorkflowMutationBatch(batch, shardID, currentWorkflow, true); err != nil {
 			return err
 		}
 	}
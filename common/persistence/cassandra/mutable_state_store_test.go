@@ -0,0 +1,257 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	gogocql "github.com/gocql/gocql"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/nosql/nosqlplugin/cassandra/gocql"
+)
+
+type (
+	mutableStateStoreSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller *gomock.Controller
+		session    *gocql.MockSession
+		batch      *gocql.MockBatch
+		timeSource *clock.EventTimeSource
+
+		unconditionalEnabled bool
+		store                *MutableStateStore
+		batchQueries         []string
+	}
+)
+
+func TestMutableStateStoreSuite(t *testing.T) {
+	s := new(mutableStateStoreSuite)
+	suite.Run(t, s)
+}
+
+func (s *mutableStateStoreSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.session = gocql.NewMockSession(s.controller)
+	s.batch = gocql.NewMockBatch(s.controller)
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now())
+
+	s.unconditionalEnabled = true
+	unconditionalUpdates := &UnconditionalUpdateConfig{
+		Enabled:                 func() bool { return s.unconditionalEnabled },
+		ShardLeaseCheckInterval: dynamicconfig.GetDurationPropertyFn(time.Second),
+	}
+	s.store = NewMutableStateStore(s.session, unconditionalUpdates, log.NewTestLogger())
+	s.store.shardLeases = newShardLeaseChecker(s.session, s.timeSource, unconditionalUpdates.ShardLeaseCheckInterval)
+
+	s.batchQueries = nil
+	s.session.EXPECT().NewBatch(gocql.LoggedBatch).Return(s.batch).AnyTimes()
+	s.batch.EXPECT().WithContext(gomock.Any()).Return(s.batch).AnyTimes()
+	s.batch.EXPECT().Query(gomock.Any(), gomock.Any()).Do(func(stmt string, _ ...interface{}) {
+		s.batchQueries = append(s.batchQueries, stmt)
+	}).AnyTimes()
+}
+
+func (s *mutableStateStoreSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *mutableStateStoreSuite) TestUpdate_Disabled() {
+	s.unconditionalEnabled = false
+	s.expectConditionalBatch()
+
+	err := s.store.UpdateWorkflowExecution(context.Background(), s.newUpdateRequest(1))
+	s.NoError(err)
+	s.True(s.batchConditional())
+}
+
+func (s *mutableStateStoreSuite) TestUpdate_Unconditional() {
+	s.expectShardRangeID(1)
+	s.session.EXPECT().ExecuteBatch(s.batch).Return(nil)
+
+	err := s.store.UpdateWorkflowExecution(context.Background(), s.newUpdateRequest(1))
+	s.NoError(err)
+	s.NotEmpty(s.batchQueries)
+	s.False(s.batchConditional())
+}
+
+func (s *mutableStateStoreSuite) TestUpdate_Unconditional_ShardOwnershipLost() {
+	s.expectShardRangeID(2)
+
+	err := s.store.UpdateWorkflowExecution(context.Background(), s.newUpdateRequest(1))
+	s.IsType(&p.ShardOwnershipLostError{}, err)
+}
+
+func (s *mutableStateStoreSuite) TestUpdate_Unconditional_LeaseCheckInterval() {
+	s.expectShardRangeID(1)
+	s.session.EXPECT().ExecuteBatch(s.batch).Return(nil).Times(3)
+
+	// the range ID read from the shard row fences updates until the lease check interval passed
+	s.NoError(s.store.UpdateWorkflowExecution(context.Background(), s.newUpdateRequest(1)))
+	s.timeSource.Update(s.timeSource.Now().Add(500 * time.Millisecond))
+	s.NoError(s.store.UpdateWorkflowExecution(context.Background(), s.newUpdateRequest(1)))
+
+	s.timeSource.Update(s.timeSource.Now().Add(time.Second))
+	s.expectShardRangeID(1)
+	s.NoError(s.store.UpdateWorkflowExecution(context.Background(), s.newUpdateRequest(1)))
+
+	// a steal is noticed on the next lease check
+	s.timeSource.Update(s.timeSource.Now().Add(time.Second))
+	s.expectShardRangeID(2)
+	err := s.store.UpdateWorkflowExecution(context.Background(), s.newUpdateRequest(1))
+	s.IsType(&p.ShardOwnershipLostError{}, err)
+}
+
+func (s *mutableStateStoreSuite) TestUpdate_Unconditional_NewRangeID() {
+	s.expectShardRangeID(1)
+	s.session.EXPECT().ExecuteBatch(s.batch).Return(nil).Times(2)
+	s.NoError(s.store.UpdateWorkflowExecution(context.Background(), s.newUpdateRequest(1)))
+
+	// a new range ID of the owner is read from the shard row even within the lease check interval
+	s.expectShardRangeID(2)
+	s.NoError(s.store.UpdateWorkflowExecution(context.Background(), s.newUpdateRequest(2)))
+}
+
+func (s *mutableStateStoreSuite) TestUpdate_WithNew_Conditional() {
+	s.expectConditionalBatch()
+
+	request := s.newUpdateRequest(1)
+	request.NewWorkflowSnapshot = s.newSnapshot(request.UpdateWorkflowMutation.NamespaceID, request.UpdateWorkflowMutation.WorkflowID)
+	err := s.store.UpdateWorkflowExecution(context.Background(), request)
+	s.NoError(err)
+	s.True(s.batchConditional())
+}
+
+func (s *mutableStateStoreSuite) TestConflictResolve_Conditional() {
+	s.expectConditionalBatch()
+
+	update := s.newUpdateRequest(1)
+	err := s.store.ConflictResolveWorkflowExecution(context.Background(), &p.InternalConflictResolveWorkflowExecutionRequest{
+		ShardID:               update.ShardID,
+		RangeID:               update.RangeID,
+		Mode:                  p.ConflictResolveWorkflowModeBypassCurrent,
+		ResetWorkflowSnapshot: *s.newSnapshot(update.UpdateWorkflowMutation.NamespaceID, update.UpdateWorkflowMutation.WorkflowID),
+	})
+	s.NoError(err)
+	s.True(s.batchConditional())
+}
+
+func (s *mutableStateStoreSuite) expectShardRangeID(rangeID int64) {
+	query := gocql.NewMockQuery(s.controller)
+	s.session.EXPECT().Query(templateGetShardRangeIDQuery, gomock.Any()).Return(query)
+	query.EXPECT().WithContext(gomock.Any()).Return(query)
+	query.EXPECT().Scan(gomock.Any()).DoAndReturn(func(dest ...interface{}) error {
+		*dest[0].(*int64) = rangeID
+		return nil
+	})
+}
+
+func (s *mutableStateStoreSuite) expectConditionalBatch() {
+	iter := gocql.NewMockIter(s.controller)
+	iter.EXPECT().Close().Return(nil)
+	s.session.EXPECT().MapExecuteBatchCAS(s.batch, gomock.Any()).Return(true, iter, nil)
+	// the current run of a bypassed workflow is looked up
+	currentQuery := gocql.NewMockQuery(s.controller)
+	s.session.EXPECT().Query(templateGetCurrentExecutionQuery, gomock.Any()).Return(currentQuery).AnyTimes()
+	currentQuery.EXPECT().WithContext(gomock.Any()).Return(currentQuery).AnyTimes()
+	currentQuery.EXPECT().MapScan(gomock.Any()).Return(gogocql.ErrNotFound).AnyTimes()
+}
+
+// batchConditional returns whether the batch was written with lightweight transactions
+func (s *mutableStateStoreSuite) batchConditional() bool {
+	for _, stmt := range s.batchQueries {
+		if strings.Contains(strings.ToUpper(stmt), " IF ") {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *mutableStateStoreSuite) newUpdateRequest(rangeID int64) *p.InternalUpdateWorkflowExecutionRequest {
+	namespaceID := uuid.New().String()
+	workflowID := uuid.New().String()
+	runID := uuid.New().String()
+	return &p.InternalUpdateWorkflowExecutionRequest{
+		ShardID: 1,
+		RangeID: rangeID,
+		Mode:    p.UpdateWorkflowModeUpdateCurrent,
+		UpdateWorkflowMutation: p.InternalWorkflowMutation{
+			NamespaceID:        namespaceID,
+			WorkflowID:         workflowID,
+			RunID:              runID,
+			ExecutionInfoBlob:  s.newBlob(),
+			ExecutionState:     s.newExecutionState(runID),
+			ExecutionStateBlob: s.newBlob(),
+			NextEventID:        10,
+			DBRecordVersion:    5,
+			Condition:          9,
+			Checksum:           s.newBlob(),
+		},
+	}
+}
+
+func (s *mutableStateStoreSuite) newSnapshot(namespaceID string, workflowID string) *p.InternalWorkflowSnapshot {
+	runID := uuid.New().String()
+	return &p.InternalWorkflowSnapshot{
+		NamespaceID:        namespaceID,
+		WorkflowID:         workflowID,
+		RunID:              runID,
+		ExecutionInfoBlob:  s.newBlob(),
+		ExecutionState:     s.newExecutionState(runID),
+		ExecutionStateBlob: s.newBlob(),
+		NextEventID:        2,
+		DBRecordVersion:    1,
+		Checksum:           s.newBlob(),
+	}
+}
+
+func (s *mutableStateStoreSuite) newExecutionState(runID string) *persistencespb.WorkflowExecutionState {
+	return &persistencespb.WorkflowExecutionState{
+		RunId:  runID,
+		State:  enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+	}
+}
+
+func (s *mutableStateStoreSuite) newBlob() *commonpb.DataBlob {
+	return &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("data")}
+}
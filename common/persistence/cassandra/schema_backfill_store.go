@@ -0,0 +1,105 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"time"
+
+	"github.com/gocql/gocql"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	commongocql "go.temporal.io/server/common/persistence/nosql/nosqlplugin/cassandra/gocql"
+	"go.temporal.io/server/common/persistence/schema"
+	"go.temporal.io/server/common/resolver"
+)
+
+const (
+	readSchemaBackfillCQL = `SELECT backfill_cursor, completed from schema_backfill where keyspace_name=? and name=?`
+
+	createSchemaBackfillCQL = `INSERT INTO schema_backfill(keyspace_name, name, backfill_cursor, completed, update_time) ` +
+		`VALUES(?,?,?,?,?) IF NOT EXISTS`
+
+	updateSchemaBackfillCQL = `UPDATE schema_backfill SET backfill_cursor=?, completed=?, update_time=? ` +
+		`WHERE keyspace_name=? and name=? IF completed=false`
+)
+
+type (
+	// SchemaBackfillStore persists the progress of schema backfills in the schema_backfill
+	// table of the main keyspace
+	SchemaBackfillStore struct {
+		session  commongocql.Session
+		keyspace string
+	}
+)
+
+var _ schema.BackfillStore = (*SchemaBackfillStore)(nil)
+
+// NewSchemaBackfillStore returns a SchemaBackfillStore for the given keyspace
+func NewSchemaBackfillStore(
+	cfg config.Cassandra,
+	r resolver.ServiceResolver,
+	logger log.Logger,
+) (*SchemaBackfillStore, error) {
+	session, err := commongocql.NewSession(
+		func() (*gocql.ClusterConfig, error) {
+			return commongocql.NewCassandraCluster(cfg, r)
+		},
+		logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &SchemaBackfillStore{
+		session:  session,
+		keyspace: cfg.Keyspace,
+	}, nil
+}
+
+func (s *SchemaBackfillStore) ReadBackfill(name string) ([]byte, bool, error) {
+	var cursor []byte
+	var completed bool
+	err := s.session.Query(readSchemaBackfillCQL, s.keyspace, name).Scan(&cursor, &completed)
+	if commongocql.IsNotFoundError(err) {
+		return nil, false, nil
+	}
+	return cursor, completed, err
+}
+
+func (s *SchemaBackfillStore) UpdateBackfill(name string, cursor []byte, completed bool) error {
+	now := time.Now().UTC()
+	previous := make(map[string]interface{})
+	applied, err := s.session.Query(createSchemaBackfillCQL, s.keyspace, name, cursor, completed, now).MapScanCAS(previous)
+	if err != nil || applied {
+		return err
+	}
+	// a backfill which already completed is never moved back, so a failed condition is not an error
+	_, err = s.session.Query(updateSchemaBackfillCQL, cursor, completed, now, s.keyspace, name).MapScanCAS(make(map[string]interface{}))
+	return err
+}
+
+func (s *SchemaBackfillStore) Close() {
+	s.session.Close()
+}
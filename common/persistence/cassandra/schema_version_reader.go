@@ -31,7 +31,8 @@ import (
 )
 
 const (
-	readSchemaVersionCQL = `SELECT curr_version from schema_version where keyspace_name=?`
+	readSchemaVersionCQL              = `SELECT curr_version from schema_version where keyspace_name=?`
+	readSchemaMinCompatibleVersionCQL = `SELECT min_compatible_version from schema_version where keyspace_name=?`
 )
 
 type (
@@ -63,3 +64,21 @@ func (svr *SchemaVersionReader) ReadSchemaVersion(keyspace string) (string, erro
 
 	return version, nil
 }
+
+// ReadSchemaMinCompatibleVersion returns the min compatible schema version for the Keyspace
+func (svr *SchemaVersionReader) ReadSchemaMinCompatibleVersion(keyspace string) (string, error) {
+	query := svr.session.Query(readSchemaMinCompatibleVersionCQL, keyspace)
+
+	iter := query.Iter()
+	var version string
+	success := iter.Scan(&version)
+	err := iter.Close()
+	if err == nil && !success {
+		err = fmt.Errorf("no schema version found for keyspace %q", keyspace)
+	}
+	if err != nil {
+		return "", fmt.Errorf("unable to get min compatible schema version from Cassandra: %w", err)
+	}
+
+	return version, nil
+}
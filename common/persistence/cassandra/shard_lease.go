@@ -0,0 +1,131 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/nosql/nosqlplugin/cassandra/gocql"
+)
+
+const (
+	templateGetShardRangeIDQuery = `SELECT range_id ` +
+		`FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and namespace_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id = ?`
+)
+
+type (
+	// UnconditionalUpdateConfig is the config of workflow execution updates written without lightweight
+	// transactions, see dynamicconfig.CassandraUnconditionalExecutionUpdatesEnabled.
+	UnconditionalUpdateConfig struct {
+		Enabled                 dynamicconfig.BoolPropertyFn
+		ShardLeaseCheckInterval dynamicconfig.DurationPropertyFn
+	}
+
+	// shardLeaseChecker fences unconditional updates by the range ID of the shard row. A range ID read
+	// from the shard row is trusted for the lease check interval, the shard row is read again afterwards.
+	shardLeaseChecker struct {
+		session    gocql.Session
+		timeSource clock.TimeSource
+		interval   dynamicconfig.DurationPropertyFn
+
+		sync.Mutex
+		leases map[int32]shardLease
+	}
+
+	shardLease struct {
+		rangeID   int64
+		checkedAt time.Time
+	}
+)
+
+func newShardLeaseChecker(
+	session gocql.Session,
+	timeSource clock.TimeSource,
+	interval dynamicconfig.DurationPropertyFn,
+) *shardLeaseChecker {
+	return &shardLeaseChecker{
+		session:    session,
+		timeSource: timeSource,
+		interval:   interval,
+		leases:     make(map[int32]shardLease),
+	}
+}
+
+// verify returns a ShardOwnershipLostError if the range ID of the shard row is not rangeID.
+func (c *shardLeaseChecker) verify(
+	ctx context.Context,
+	shardID int32,
+	rangeID int64,
+) error {
+	now := c.timeSource.Now()
+	c.Lock()
+	lease, ok := c.leases[shardID]
+	c.Unlock()
+	if ok && lease.rangeID == rangeID && now.Sub(lease.checkedAt) < c.interval() {
+		return nil
+	}
+
+	var actualRangeID int64
+	query := c.session.Query(templateGetShardRangeIDQuery,
+		shardID,
+		rowTypeShard,
+		rowTypeShardNamespaceID,
+		rowTypeShardWorkflowID,
+		rowTypeShardRunID,
+		defaultVisibilityTimestamp,
+		rowTypeShardTaskID,
+	).WithContext(ctx)
+	if err := query.Scan(&actualRangeID); err != nil {
+		return gocql.ConvertError("VerifyShardLease", err)
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	if actualRangeID != rangeID {
+		delete(c.leases, shardID)
+		return &p.ShardOwnershipLostError{
+			ShardID: shardID,
+			Msg: fmt.Sprintf("Encounter shard ownership lost, request range ID: %v, actual range ID: %v",
+				rangeID,
+				actualRangeID,
+			),
+		}
+	}
+	c.leases[shardID] = shardLease{rangeID: rangeID, checkedAt: now}
+	return nil
+}
@@ -39,6 +39,7 @@ func applyWorkflowMutationBatch(
 	batch gocql.Batch,
 	shardID int32,
 	workflowMutation *p.InternalWorkflowMutation,
+	conditional bool,
 ) error {
 
 	// TODO update all call sites to update LastUpdatetime
@@ -61,6 +62,7 @@ func applyWorkflowMutationBatch(
 		workflowMutation.Condition,
 		workflowMutation.DBRecordVersion,
 		workflowMutation.Checksum,
+		conditional,
 	); err != nil {
 		return err
 	}
@@ -179,6 +181,7 @@ func applyWorkflowSnapshotBatchAsReset(
 		workflowSnapshot.Condition,
 		workflowSnapshot.DBRecordVersion,
 		workflowSnapshot.Checksum,
+		true,
 	); err != nil {
 		return err
 	}
@@ -405,6 +408,7 @@ func updateExecution(
 	condition int64,
 	dbRecordVersion int64,
 	checksumBlob *commonpb.DataBlob,
+	conditional bool,
 ) error {
 
 	// validate workflow state & close status
@@ -414,7 +418,25 @@ func updateExecution(
 		return err
 	}
 
-	if dbRecordVersion == 0 {
+	if !conditional {
+		batch.Query(templateUpdateWorkflowExecutionUnconditionalQuery,
+			executionInfoBlob.Data,
+			executionInfoBlob.EncodingType.String(),
+			executionStateBlob.Data,
+			executionStateBlob.EncodingType.String(),
+			nextEventID,
+			dbRecordVersion,
+			checksumBlob.Data,
+			checksumBlob.EncodingType.String(),
+			shardID,
+			rowTypeExecution,
+			namespaceID,
+			workflowID,
+			runID,
+			defaultVisibilityTimestamp,
+			rowTypeExecutionTaskID,
+		)
+	} else if dbRecordVersion == 0 {
 		batch.Query(templateUpdateWorkflowExecutionQueryDeprecated,
 			executionInfoBlob.Data,
 			executionInfoBlob.EncodingType.String(),
@@ -0,0 +1,301 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	// CircuitBreakerStateClosed admits all requests
+	CircuitBreakerStateClosed CircuitBreakerState = iota
+	// CircuitBreakerStateDegraded sheds low priority requests
+	CircuitBreakerStateDegraded
+	// CircuitBreakerStateOpen sheds all requests
+	CircuitBreakerStateOpen
+)
+
+var (
+	// ErrPersistenceCircuitOpen is returned for requests shed while the circuit breaker of a store is open
+	ErrPersistenceCircuitOpen = serviceerror.NewUnavailable("Persistence circuit breaker is open.")
+	// ErrPersistenceDegraded is returned for low priority requests shed while a store is degraded
+	ErrPersistenceDegraded = serviceerror.NewUnavailable("Persistence is degraded, low priority request shed.")
+)
+
+type (
+	CircuitBreakerState int
+
+	// CircuitBreakerConfig is shared by the circuit breakers of all stores
+	CircuitBreakerConfig struct {
+		Enabled dynamicconfig.BoolPropertyFn
+		// DegradedErrorRatio is the ratio of failed requests in a window above which low priority requests are shed
+		DegradedErrorRatio dynamicconfig.FloatPropertyFn
+		// OpenErrorRatio is the ratio of failed requests in a window above which all requests are shed
+		OpenErrorRatio dynamicconfig.FloatPropertyFn
+		// MinRequests is the number of requests in a window below which the error ratio is not acted on
+		MinRequests dynamicconfig.IntPropertyFn
+		// Window is the duration over which the error ratio is computed
+		Window dynamicconfig.DurationPropertyFn
+		// OpenDuration is how long the breaker stays open before letting high priority requests through again
+		OpenDuration dynamicconfig.DurationPropertyFn
+	}
+
+	// CircuitBreaker tracks the ratio of failed requests to a store and sheds requests when it spikes,
+	// low priority requests first, so that the store can recover instead of being hammered by retries.
+	CircuitBreaker struct {
+		name           string
+		config         *CircuitBreakerConfig
+		timeSource     clock.TimeSource
+		metricsHandler metrics.Handler
+		logger         log.Logger
+
+		sync.Mutex
+		state          CircuitBreakerState
+		stateChangedAt time.Time
+		windowStart    time.Time
+		requests       int
+		failures       int
+	}
+
+	// CircuitBreakers holds the circuit breaker of each store of a host
+	CircuitBreakers struct {
+		config         *CircuitBreakerConfig
+		timeSource     clock.TimeSource
+		metricsHandler metrics.Handler
+		logger         log.Logger
+
+		sync.Mutex
+		breakers map[string]*CircuitBreaker
+	}
+)
+
+func NewCircuitBreakers(
+	config *CircuitBreakerConfig,
+	timeSource clock.TimeSource,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *CircuitBreakers {
+	return &CircuitBreakers{
+		config:         config,
+		timeSource:     timeSource,
+		metricsHandler: metricsHandler,
+		logger:         logger,
+		breakers:       make(map[string]*CircuitBreaker),
+	}
+}
+
+// Get returns the circuit breaker of the store, creating it on first use
+func (c *CircuitBreakers) Get(
+	store string,
+) *CircuitBreaker {
+	c.Lock()
+	defer c.Unlock()
+
+	breaker, ok := c.breakers[store]
+	if !ok {
+		now := c.timeSource.Now()
+		breaker = &CircuitBreaker{
+			name:           store,
+			config:         c.config,
+			timeSource:     c.timeSource,
+			metricsHandler: c.metricsHandler.WithTags(metrics.PersistenceStoreTag(store)),
+			logger:         log.With(c.logger, tag.NewStringTag("store", store)),
+			state:          CircuitBreakerStateClosed,
+			stateChangedAt: now,
+			windowStart:    now,
+		}
+		c.breakers[store] = breaker
+	}
+	return breaker
+}
+
+// States returns the current state of the circuit breaker of each store
+func (c *CircuitBreakers) States() map[string]CircuitBreakerState {
+	c.Lock()
+	breakers := make([]*CircuitBreaker, 0, len(c.breakers))
+	for _, breaker := range c.breakers {
+		breakers = append(breakers, breaker)
+	}
+	c.Unlock()
+
+	states := make(map[string]CircuitBreakerState, len(breakers))
+	for _, breaker := range breakers {
+		states[breaker.name] = breaker.State()
+	}
+	return states
+}
+
+// Allow returns ErrPersistenceCircuitOpen or ErrPersistenceDegraded if the request must be shed
+func (b *CircuitBreaker) Allow(
+	lowPriority bool,
+) error {
+	if !b.config.Enabled() {
+		return nil
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	b.advanceLocked(b.timeSource.Now())
+	switch {
+	case b.state == CircuitBreakerStateOpen:
+		b.metricsHandler.Counter(metrics.PersistenceCircuitBreakerRejectedRequests.GetMetricName()).Record(1)
+		return ErrPersistenceCircuitOpen
+	case b.state == CircuitBreakerStateDegraded && lowPriority:
+		b.metricsHandler.Counter(metrics.PersistenceCircuitBreakerRejectedRequests.GetMetricName()).Record(1)
+		return ErrPersistenceDegraded
+	default:
+		return nil
+	}
+}
+
+// Record records the outcome of a request admitted by Allow
+func (b *CircuitBreaker) Record(
+	err error,
+) {
+	if !b.config.Enabled() {
+		return
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	now := b.timeSource.Now()
+	b.advanceLocked(now)
+	b.requests++
+	if isCircuitBreakerFailure(err) {
+		b.failures++
+	}
+	if b.requests < b.config.MinRequests() {
+		return
+	}
+
+	errorRatio := float64(b.failures) / float64(b.requests)
+	switch {
+	case b.state != CircuitBreakerStateOpen && errorRatio >= b.config.OpenErrorRatio():
+		b.transitionLocked(CircuitBreakerStateOpen, now)
+		b.resetWindowLocked(now)
+	case b.state == CircuitBreakerStateClosed && errorRatio >= b.config.DegradedErrorRatio():
+		// keep counting the current window, so that the breaker opens if the error ratio keeps rising
+		b.transitionLocked(CircuitBreakerStateDegraded, now)
+	}
+}
+
+// State returns the current state of the circuit breaker
+func (b *CircuitBreaker) State() CircuitBreakerState {
+	b.Lock()
+	defer b.Unlock()
+
+	b.advanceLocked(b.timeSource.Now())
+	return b.state
+}
+
+// advanceLocked moves an open breaker to degraded once it has been open for long enough, so that high priority
+// requests probe the store, and closes a degraded breaker after a full window with a low error ratio.
+func (b *CircuitBreaker) advanceLocked(
+	now time.Time,
+) {
+	if b.state == CircuitBreakerStateOpen && now.Sub(b.stateChangedAt) >= b.config.OpenDuration() {
+		b.transitionLocked(CircuitBreakerStateDegraded, now)
+		b.resetWindowLocked(now)
+		return
+	}
+	if now.Sub(b.windowStart) < b.config.Window() {
+		return
+	}
+
+	recovered := b.requests == 0 || float64(b.failures)/float64(b.requests) < b.config.DegradedErrorRatio()
+	if b.state == CircuitBreakerStateDegraded && recovered {
+		b.transitionLocked(CircuitBreakerStateClosed, now)
+	}
+	b.resetWindowLocked(now)
+}
+
+func (b *CircuitBreaker) transitionLocked(
+	state CircuitBreakerState,
+	now time.Time,
+) {
+	b.logger.Warn("Persistence circuit breaker state changed",
+		tag.NewStringTag("from", b.state.String()),
+		tag.NewStringTag("to", state.String()),
+		tag.NewInt("requests", b.requests),
+		tag.NewInt("failures", b.failures),
+	)
+	b.state = state
+	b.stateChangedAt = now
+	b.metricsHandler.Gauge(metrics.PersistenceCircuitBreakerState.GetMetricName()).Record(float64(state))
+}
+
+func (b *CircuitBreaker) resetWindowLocked(
+	now time.Time,
+) {
+	b.windowStart = now
+	b.requests = 0
+	b.failures = 0
+}
+
+func (s CircuitBreakerState) String() string {
+	switch s {
+	case CircuitBreakerStateClosed:
+		return "closed"
+	case CircuitBreakerStateDegraded:
+		return "degraded"
+	case CircuitBreakerStateOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+// isCircuitBreakerFailure returns whether the error indicates the store is unhealthy, as opposed to
+// errors caused by the request itself, e.g. condition failures, or by the caller giving up
+func isCircuitBreakerFailure(err error) bool {
+	if err == nil || err == ErrPersistenceCircuitOpen || err == ErrPersistenceDegraded {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	switch err.(type) {
+	case *serviceerror.Unavailable,
+		*AppendHistoryTimeoutError,
+		*TimeoutError:
+		return true
+	default:
+		return false
+	}
+}
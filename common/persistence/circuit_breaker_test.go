@@ -0,0 +1,166 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	circuitBreakerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		now        time.Time
+		timeSource *clock.EventTimeSource
+		enabled    bool
+		breaker    *CircuitBreaker
+	}
+)
+
+func TestCircuitBreakerSuite(t *testing.T) {
+	s := new(circuitBreakerSuite)
+	suite.Run(t, s)
+}
+
+func (s *circuitBreakerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.now = time.Now()
+	s.timeSource = clock.NewEventTimeSource().Update(s.now)
+	s.enabled = true
+	breakers := NewCircuitBreakers(
+		&CircuitBreakerConfig{
+			Enabled:            func() bool { return s.enabled },
+			DegradedErrorRatio: dynamicconfig.GetFloatPropertyFn(0.2),
+			OpenErrorRatio:     dynamicconfig.GetFloatPropertyFn(0.5),
+			MinRequests:        dynamicconfig.GetIntPropertyFn(10),
+			Window:             dynamicconfig.GetDurationPropertyFn(10 * time.Second),
+			OpenDuration:       dynamicconfig.GetDurationPropertyFn(5 * time.Second),
+		},
+		s.timeSource,
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	)
+	s.breaker = breakers.Get("ExecutionStore")
+}
+
+func (s *circuitBreakerSuite) record(requests int, failures int) {
+	for i := 0; i < requests; i++ {
+		if i < requests-failures {
+			s.breaker.Record(nil)
+		} else {
+			s.breaker.Record(serviceerror.NewUnavailable("unavailable"))
+		}
+	}
+}
+
+func (s *circuitBreakerSuite) advance(d time.Duration) {
+	s.now = s.now.Add(d)
+	s.timeSource.Update(s.now)
+}
+
+func (s *circuitBreakerSuite) TestClosed() {
+	s.record(100, 10)
+	s.Equal(CircuitBreakerStateClosed, s.breaker.State())
+	s.NoError(s.breaker.Allow(true))
+	s.NoError(s.breaker.Allow(false))
+}
+
+func (s *circuitBreakerSuite) TestBelowMinRequests() {
+	s.record(9, 9)
+	s.Equal(CircuitBreakerStateClosed, s.breaker.State())
+}
+
+func (s *circuitBreakerSuite) TestDegraded_ShedsLowPriority() {
+	s.record(10, 3)
+	s.Equal(CircuitBreakerStateDegraded, s.breaker.State())
+	s.Equal(ErrPersistenceDegraded, s.breaker.Allow(true))
+	s.NoError(s.breaker.Allow(false))
+
+	// a full window with a low error ratio closes the breaker
+	s.record(30, 1)
+	s.advance(10 * time.Second)
+	s.Equal(CircuitBreakerStateClosed, s.breaker.State())
+	s.NoError(s.breaker.Allow(true))
+}
+
+func (s *circuitBreakerSuite) TestDegraded_StaysDegradedWhileFailing() {
+	s.record(10, 3)
+	s.record(10, 3)
+	s.advance(10 * time.Second)
+	s.Equal(CircuitBreakerStateDegraded, s.breaker.State())
+}
+
+func (s *circuitBreakerSuite) TestOpen_ShedsAll() {
+	s.record(10, 5)
+	s.Equal(CircuitBreakerStateOpen, s.breaker.State())
+	s.Equal(ErrPersistenceCircuitOpen, s.breaker.Allow(true))
+	s.Equal(ErrPersistenceCircuitOpen, s.breaker.Allow(false))
+
+	// once open for long enough, high priority requests probe the store
+	s.advance(5 * time.Second)
+	s.Equal(CircuitBreakerStateDegraded, s.breaker.State())
+	s.Equal(ErrPersistenceDegraded, s.breaker.Allow(true))
+	s.NoError(s.breaker.Allow(false))
+
+	s.record(10, 5)
+	s.Equal(CircuitBreakerStateOpen, s.breaker.State())
+}
+
+func (s *circuitBreakerSuite) TestOwnErrorsAreNotFailures() {
+	for i := 0; i < 10; i++ {
+		s.breaker.Record(ErrPersistenceDegraded)
+		s.breaker.Record(&ConditionFailedError{Msg: "condition failed"})
+		s.breaker.Record(context.Canceled)
+	}
+	s.Equal(CircuitBreakerStateClosed, s.breaker.State())
+
+	s.record(10, 0)
+	for i := 0; i < 10; i++ {
+		s.breaker.Record(context.DeadlineExceeded)
+	}
+	s.Equal(CircuitBreakerStateDegraded, s.breaker.State())
+}
+
+func (s *circuitBreakerSuite) TestDisabled() {
+	s.enabled = false
+	s.record(10, 10)
+	s.NoError(s.breaker.Allow(false))
+
+	s.enabled = true
+	s.Equal(CircuitBreakerStateClosed, s.breaker.State())
+}
@@ -0,0 +1,226 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	adaptiveRateRefreshInterval   = time.Second
+	adaptiveRateLatencyPercentile = 0.99
+
+	// the QPS limit is reduced in proportion to how far the observed latency is above the target,
+	// but never by more than adaptiveRateMinBackoffFactor or less than adaptiveRateMaxBackoffFactor
+	adaptiveRateMinBackoffFactor   = 0.5
+	adaptiveRateMaxBackoffFactor   = 0.9
+	adaptiveRateErrorBackoffFactor = 0.5
+	adaptiveRateIncreaseFactor     = 1.1
+)
+
+type (
+	AdaptiveRateLimitingConfig struct {
+		Enabled        dynamicconfig.BoolPropertyFn
+		TargetLatency  dynamicconfig.DurationPropertyFn
+		ErrorThreshold dynamicconfig.FloatPropertyFn
+		MinQPS         dynamicconfig.IntPropertyFn
+		MaxQPS         dynamicconfig.IntPropertyFn
+		AdjustInterval dynamicconfig.DurationPropertyFn
+	}
+
+	// AdaptiveRateController computes the host level persistence QPS limit. When enabled, the limit starts at
+	// the static limit and is adjusted every interval: it is reduced while the p99 latency or the error ratio
+	// of the datastore is above target, and increased while the datastore is healthy and requests are throttled,
+	// so that it converges toward the maximum throughput the datastore can sustain.
+	AdaptiveRateController struct {
+		config         *AdaptiveRateLimitingConfig
+		staticRateFn   quotas.RateFn
+		healthSignals  persistence.HealthSignalAggregator
+		timeSource     clock.TimeSource
+		metricsHandler metrics.Handler
+		logger         log.Logger
+
+		throttled atomic.Int64
+
+		sync.Mutex
+		enabled        bool
+		rate           float64
+		lastAdjustTime time.Time
+	}
+
+	adaptiveRequestRateLimiter struct {
+		controller  *AdaptiveRateController
+		rateLimiter quotas.RequestRateLimiter
+	}
+)
+
+var _ quotas.RequestRateLimiter = (*adaptiveRequestRateLimiter)(nil)
+
+// NewAdaptiveRateController creates a new AdaptiveRateController, config can be nil in which case the
+// static rate is always used
+func NewAdaptiveRateController(
+	config *AdaptiveRateLimitingConfig,
+	staticRateFn quotas.RateFn,
+	healthSignals persistence.HealthSignalAggregator,
+	timeSource clock.TimeSource,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *AdaptiveRateController {
+	return &AdaptiveRateController{
+		config:         config,
+		staticRateFn:   staticRateFn,
+		healthSignals:  healthSignals,
+		timeSource:     timeSource,
+		metricsHandler: metricsHandler,
+		logger:         logger,
+	}
+}
+
+// Rate returns the current host level QPS limit
+func (c *AdaptiveRateController) Rate() float64 {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.config == nil || !c.config.Enabled() {
+		c.enabled = false
+		return c.staticRateFn()
+	}
+
+	now := c.timeSource.Now()
+	if !c.enabled {
+		c.enabled = true
+		c.rate = c.staticRateFn()
+		c.lastAdjustTime = now
+		c.throttled.Store(0)
+	} else if now.Sub(c.lastAdjustTime) >= c.config.AdjustInterval() {
+		c.adjustLocked(now)
+	}
+	return c.rate
+}
+
+// Multiplier returns the ratio between the current and the static host level QPS limit,
+// which is applied to the more specific namespace and shard level limits
+func (c *AdaptiveRateController) Multiplier() float64 {
+	staticRate := c.staticRateFn()
+	if staticRate <= 0 {
+		return 1
+	}
+	return c.Rate() / staticRate
+}
+
+func (c *AdaptiveRateController) recordThrottled() {
+	c.throttled.Add(1)
+}
+
+func (c *AdaptiveRateController) adjustLocked(
+	now time.Time,
+) {
+	latency := time.Duration(c.healthSignals.LatencyPercentile(adaptiveRateLatencyPercentile) * float64(time.Millisecond))
+	errorRatio := c.healthSignals.ErrorRatio()
+	throttled := c.throttled.Swap(0)
+	targetLatency := c.config.TargetLatency()
+	errorThreshold := c.config.ErrorThreshold()
+
+	prevRate := c.rate
+	switch {
+	case errorThreshold > 0 && errorRatio > errorThreshold:
+		c.rate *= adaptiveRateErrorBackoffFactor
+	case targetLatency > 0 && latency > targetLatency:
+		c.rate *= math.Max(adaptiveRateMinBackoffFactor, math.Min(adaptiveRateMaxBackoffFactor, float64(targetLatency)/float64(latency)))
+	case throttled > 0:
+		// the datastore is healthy and the limit is what holds back requests
+		c.rate *= adaptiveRateIncreaseFactor
+	}
+
+	if maxQPS := float64(c.config.MaxQPS()); maxQPS > 0 {
+		c.rate = math.Min(c.rate, maxQPS)
+	}
+	c.rate = math.Max(c.rate, float64(c.config.MinQPS()))
+	c.lastAdjustTime = now
+
+	if c.rate != prevRate {
+		c.logger.Debug("Adjusted adaptive persistence rate limit",
+			tag.NewAnyTag("rate", c.rate),
+			tag.NewAnyTag("previous-rate", prevRate),
+			tag.NewDurationTag("p99-latency", latency),
+			tag.NewAnyTag("error-ratio", errorRatio),
+		)
+	}
+	c.metricsHandler.Gauge(metrics.PersistenceAdaptiveRateLimit.GetMetricName()).Record(c.rate)
+}
+
+func newPriorityAdaptiveRateLimiter(
+	controller *AdaptiveRateController,
+	requestPriorityFn quotas.RequestPriorityFn,
+) quotas.RequestRateLimiter {
+	rateLimiters := make(map[int]quotas.RequestRateLimiter)
+	for priority := range RequestPrioritiesOrdered {
+		rateLimiters[priority] = quotas.NewRequestRateLimiterAdapter(quotas.NewDynamicRateLimiter(
+			quotas.NewDefaultOutgoingRateBurst(controller.Rate),
+			adaptiveRateRefreshInterval,
+		))
+	}
+
+	return &adaptiveRequestRateLimiter{
+		controller:  controller,
+		rateLimiter: quotas.NewPriorityRateLimiter(requestPriorityFn, rateLimiters),
+	}
+}
+
+func (rl *adaptiveRequestRateLimiter) Allow(now time.Time, request quotas.Request) bool {
+	if rl.rateLimiter.Allow(now, request) {
+		return true
+	}
+	rl.controller.recordThrottled()
+	return false
+}
+
+func (rl *adaptiveRequestRateLimiter) Reserve(now time.Time, request quotas.Request) quotas.Reservation {
+	reservation := rl.rateLimiter.Reserve(now, request)
+	if !reservation.OK() || reservation.DelayFrom(now) > 0 {
+		rl.controller.recordThrottled()
+	}
+	return reservation
+}
+
+func (rl *adaptiveRequestRateLimiter) Wait(ctx context.Context, request quotas.Request) error {
+	if rl.rateLimiter.Allow(time.Now(), request) {
+		return nil
+	}
+	rl.controller.recordThrottled()
+	return rl.rateLimiter.Wait(ctx, request)
+}
@@ -0,0 +1,165 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/quotas"
+)
+
+type (
+	adaptiveRateLimiterSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		now           time.Time
+		timeSource    *clock.EventTimeSource
+		enabled       bool
+		healthSignals *testHealthSignals
+		controller    *AdaptiveRateController
+	}
+
+	testHealthSignals struct {
+		persistence.HealthSignalAggregator
+
+		latencyMs  float64
+		errorRatio float64
+	}
+)
+
+func TestAdaptiveRateLimiterSuite(t *testing.T) {
+	s := new(adaptiveRateLimiterSuite)
+	suite.Run(t, s)
+}
+
+func (s *adaptiveRateLimiterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.now = time.Now()
+	s.timeSource = clock.NewEventTimeSource().Update(s.now)
+	s.enabled = true
+	s.healthSignals = &testHealthSignals{HealthSignalAggregator: persistence.NoopHealthSignalAggregator}
+	s.controller = NewAdaptiveRateController(
+		&AdaptiveRateLimitingConfig{
+			Enabled:        func() bool { return s.enabled },
+			TargetLatency:  dynamicconfig.GetDurationPropertyFn(100 * time.Millisecond),
+			ErrorThreshold: dynamicconfig.GetFloatPropertyFn(0.05),
+			MinQPS:         dynamicconfig.GetIntPropertyFn(100),
+			MaxQPS:         dynamicconfig.GetIntPropertyFn(2000),
+			AdjustInterval: dynamicconfig.GetDurationPropertyFn(10 * time.Second),
+		},
+		func() float64 { return 1000 },
+		s.healthSignals,
+		s.timeSource,
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	)
+}
+
+func (s *adaptiveRateLimiterSuite) adjust() float64 {
+	s.now = s.now.Add(10 * time.Second)
+	s.timeSource.Update(s.now)
+	return s.controller.Rate()
+}
+
+func (s *adaptiveRateLimiterSuite) TestDisabled() {
+	s.enabled = false
+	s.healthSignals.latencyMs = 1000
+	s.Equal(float64(1000), s.controller.Rate())
+	s.Equal(float64(1000), s.adjust())
+	s.Equal(float64(1), s.controller.Multiplier())
+}
+
+func (s *adaptiveRateLimiterSuite) TestHighLatency_Backoff() {
+	s.Equal(float64(1000), s.controller.Rate())
+
+	s.healthSignals.latencyMs = 125
+	s.InDelta(800, s.adjust(), 0.001)
+
+	// backoff is bounded when latency is far above target
+	s.healthSignals.latencyMs = 1000
+	s.InDelta(400, s.adjust(), 0.001)
+	s.InDelta(0.4, s.controller.Multiplier(), 0.001)
+
+	s.InDelta(200, s.adjust(), 0.001)
+	s.InDelta(100, s.adjust(), 0.001)
+	s.InDelta(100, s.adjust(), 0.001)
+}
+
+func (s *adaptiveRateLimiterSuite) TestHighErrorRatio_Backoff() {
+	s.Equal(float64(1000), s.controller.Rate())
+
+	s.healthSignals.errorRatio = 0.1
+	s.InDelta(500, s.adjust(), 0.001)
+}
+
+func (s *adaptiveRateLimiterSuite) TestHealthy_IncreaseOnlyWhenThrottled() {
+	s.Equal(float64(1000), s.controller.Rate())
+
+	s.healthSignals.latencyMs = 50
+	s.Equal(float64(1000), s.adjust())
+
+	s.controller.recordThrottled()
+	s.InDelta(1100, s.adjust(), 0.001)
+	s.Equal(float64(1100), s.adjust())
+
+	for i := 0; i < 10; i++ {
+		s.controller.recordThrottled()
+		s.adjust()
+	}
+	s.Equal(float64(2000), s.controller.Rate())
+}
+
+func (s *adaptiveRateLimiterSuite) TestRateLimiter_RecordsThrottled() {
+	rateLimiter := newPriorityAdaptiveRateLimiter(s.controller, RequestPriorityFn)
+	request := quotas.NewRequest("test-api", 1, "test-namespace", "api", 0, "frontend")
+
+	allowed := 0
+	for i := 0; i < 2000; i++ {
+		if rateLimiter.Allow(s.now, request) {
+			allowed++
+		}
+	}
+	s.Equal(1000, allowed)
+	s.InDelta(1100, s.adjust(), 0.001)
+}
+
+func (s *testHealthSignals) LatencyPercentile(_ float64) float64 {
+	return s.latencyMs
+}
+
+func (s *testHealthSignals) ErrorRatio() float64 {
+	return s.errorRatio
+}
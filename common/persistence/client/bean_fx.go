@@ -82,6 +82,9 @@ func TaskManagerProvider(factory Factory) (persistence.TaskManager, error) {
 func NamespaceReplicationQueueProvider(factory Factory) (persistence.NamespaceReplicationQueue, error) {
 	return factory.NewNamespaceReplicationQueue()
 }
+func ArchivalDLQProvider(factory Factory) (persistence.ArchivalDLQ, error) {
+	return factory.NewArchivalDLQ()
+}
 func ShardManagerProvider(factory Factory) (persistence.ShardManager, error) {
 	return factory.NewShardManager()
 }
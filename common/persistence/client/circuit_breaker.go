@@ -0,0 +1,1096 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/quotas"
+)
+
+type (
+	// CircuitBreakerDataStoreFactory wraps each store with the circuit breaker of the store,
+	// which sheds requests when the error rate of the store spikes, low priority requests first.
+	CircuitBreakerDataStoreFactory struct {
+		baseFactory     DataStoreFactory
+		circuitBreakers *persistence.CircuitBreakers
+	}
+
+	storeCircuitBreaker struct {
+		breaker *persistence.CircuitBreaker
+	}
+
+	circuitBreakerShardStore struct {
+		baseShardStore persistence.ShardStore
+		breaker        storeCircuitBreaker
+	}
+
+	circuitBreakerTaskStore struct {
+		baseTaskStore persistence.TaskStore
+		breaker       storeCircuitBreaker
+	}
+
+	circuitBreakerMetadataStore struct {
+		baseMetadataStore persistence.MetadataStore
+		breaker           storeCircuitBreaker
+	}
+
+	circuitBreakerClusterMetadataStore struct {
+		baseCMStore persistence.ClusterMetadataStore
+		breaker     storeCircuitBreaker
+	}
+
+	circuitBreakerExecutionStore struct {
+		persistence.HistoryBranchUtilImpl
+		baseExecutionStore persistence.ExecutionStore
+		breaker            storeCircuitBreaker
+	}
+
+	circuitBreakerQueue struct {
+		baseQueue persistence.Queue
+		breaker   storeCircuitBreaker
+	}
+)
+
+var _ DataStoreFactory = (*CircuitBreakerDataStoreFactory)(nil)
+
+func NewCircuitBreakerDataStoreFactory(
+	baseFactory DataStoreFactory,
+	circuitBreakers *persistence.CircuitBreakers,
+) *CircuitBreakerDataStoreFactory {
+	return &CircuitBreakerDataStoreFactory{
+		baseFactory:     baseFactory,
+		circuitBreakers: circuitBreakers,
+	}
+}
+
+func (d *CircuitBreakerDataStoreFactory) Close() {
+	d.baseFactory.Close()
+}
+
+func (d *CircuitBreakerDataStoreFactory) NewTaskStore() (persistence.TaskStore, error) {
+	store, err := d.baseFactory.NewTaskStore()
+	if err != nil {
+		return nil, err
+	}
+	return &circuitBreakerTaskStore{
+		baseTaskStore: store,
+		breaker:       d.newStoreCircuitBreaker(config.TaskStoreName),
+	}, nil
+}
+
+func (d *CircuitBreakerDataStoreFactory) NewShardStore() (persistence.ShardStore, error) {
+	store, err := d.baseFactory.NewShardStore()
+	if err != nil {
+		return nil, err
+	}
+	return &circuitBreakerShardStore{
+		baseShardStore: store,
+		breaker:        d.newStoreCircuitBreaker(config.ShardStoreName),
+	}, nil
+}
+
+func (d *CircuitBreakerDataStoreFactory) NewMetadataStore() (persistence.MetadataStore, error) {
+	store, err := d.baseFactory.NewMetadataStore()
+	if err != nil {
+		return nil, err
+	}
+	return &circuitBreakerMetadataStore{
+		baseMetadataStore: store,
+		breaker:           d.newStoreCircuitBreaker(config.MetadataStoreName),
+	}, nil
+}
+
+func (d *CircuitBreakerDataStoreFactory) NewExecutionStore() (persistence.ExecutionStore, error) {
+	store, err := d.baseFactory.NewExecutionStore()
+	if err != nil {
+		return nil, err
+	}
+	return &circuitBreakerExecutionStore{
+		baseExecutionStore: store,
+		breaker:            d.newStoreCircuitBreaker(config.ExecutionStoreName),
+	}, nil
+}
+
+func (d *CircuitBreakerDataStoreFactory) NewQueue(queueType persistence.QueueType) (persistence.Queue, error) {
+	queue, err := d.baseFactory.NewQueue(queueType)
+	if err != nil {
+		return nil, err
+	}
+	return &circuitBreakerQueue{
+		baseQueue: queue,
+		breaker:   d.newStoreCircuitBreaker(config.QueueName),
+	}, nil
+}
+
+func (d *CircuitBreakerDataStoreFactory) NewClusterMetadataStore() (persistence.ClusterMetadataStore, error) {
+	store, err := d.baseFactory.NewClusterMetadataStore()
+	if err != nil {
+		return nil, err
+	}
+	return &circuitBreakerClusterMetadataStore{
+		baseCMStore: store,
+		breaker:     d.newStoreCircuitBreaker(config.ClusterMDStoreName),
+	}, nil
+}
+
+func (d *CircuitBreakerDataStoreFactory) newStoreCircuitBreaker(
+	storeName config.DataStoreName,
+) storeCircuitBreaker {
+	return storeCircuitBreaker{
+		breaker: d.circuitBreakers.Get(string(storeName)),
+	}
+}
+
+// allow classifies the request with the same priorities as the persistence rate limiter, background and
+// preemptable requests which don't have a priority override are the low priority requests shed first.
+func (b storeCircuitBreaker) allow(
+	ctx context.Context,
+	api string,
+) error {
+	callerInfo := headers.GetCallerInfo(ctx)
+	priority := RequestPriorityFn(quotas.NewRequest(
+		api,
+		persistence.RateLimitDefaultToken,
+		callerInfo.CallerName,
+		callerInfo.CallerType,
+		persistence.CallerSegmentMissing,
+		callerInfo.CallOrigin,
+	))
+	return b.breaker.Allow(priority >= CallerTypeDefaultPriority[headers.CallerTypeBackground])
+}
+
+func (b storeCircuitBreaker) record(
+	err error,
+) {
+	b.breaker.Record(err)
+}
+
+func (s *circuitBreakerShardStore) Close() {
+	s.baseShardStore.Close()
+}
+
+func (s *circuitBreakerShardStore) GetName() string {
+	return s.baseShardStore.GetName()
+}
+
+func (s *circuitBreakerShardStore) GetClusterName() string {
+	return s.baseShardStore.GetClusterName()
+}
+
+func (s *circuitBreakerShardStore) GetOrCreateShard(
+	ctx context.Context,
+	request *persistence.InternalGetOrCreateShardRequest,
+) (*persistence.InternalGetOrCreateShardResponse, error) {
+	if err := s.breaker.allow(ctx, "GetOrCreateShard"); err != nil {
+		return nil, err
+	}
+	resp, err := s.baseShardStore.GetOrCreateShard(ctx, request)
+	s.breaker.record(err)
+	return resp, err
+}
+
+func (s *circuitBreakerShardStore) UpdateShard(
+	ctx context.Context,
+	request *persistence.InternalUpdateShardRequest,
+) error {
+	if err := s.breaker.allow(ctx, "UpdateShard"); err != nil {
+		return err
+	}
+	err := s.baseShardStore.UpdateShard(ctx, request)
+	s.breaker.record(err)
+	return err
+}
+
+func (s *circuitBreakerShardStore) AssertShardOwnership(
+	ctx context.Context,
+	request *persistence.AssertShardOwnershipRequest,
+) error {
+	if err := s.breaker.allow(ctx, "AssertShardOwnership"); err != nil {
+		return err
+	}
+	err := s.baseShardStore.AssertShardOwnership(ctx, request)
+	s.breaker.record(err)
+	return err
+}
+
+func (t *circuitBreakerTaskStore) Close() {
+	t.baseTaskStore.Close()
+}
+
+func (t *circuitBreakerTaskStore) GetName() string {
+	return t.baseTaskStore.GetName()
+}
+
+func (t *circuitBreakerTaskStore) CreateTaskQueue(
+	ctx context.Context,
+	request *persistence.InternalCreateTaskQueueRequest,
+) error {
+	if err := t.breaker.allow(ctx, "CreateTaskQueue"); err != nil {
+		return err
+	}
+	err := t.baseTaskStore.CreateTaskQueue(ctx, request)
+	t.breaker.record(err)
+	return err
+}
+
+func (t *circuitBreakerTaskStore) GetTaskQueue(
+	ctx context.Context,
+	request *persistence.InternalGetTaskQueueRequest,
+) (*persistence.InternalGetTaskQueueResponse, error) {
+	if err := t.breaker.allow(ctx, "GetTaskQueue"); err != nil {
+		return nil, err
+	}
+	resp, err := t.baseTaskStore.GetTaskQueue(ctx, request)
+	t.breaker.record(err)
+	return resp, err
+}
+
+func (t *circuitBreakerTaskStore) UpdateTaskQueue(
+	ctx context.Context,
+	request *persistence.InternalUpdateTaskQueueRequest,
+) (*persistence.UpdateTaskQueueResponse, error) {
+	if err := t.breaker.allow(ctx, "UpdateTaskQueue"); err != nil {
+		return nil, err
+	}
+	resp, err := t.baseTaskStore.UpdateTaskQueue(ctx, request)
+	t.breaker.record(err)
+	return resp, err
+}
+
+func (t *circuitBreakerTaskStore) ListTaskQueue(
+	ctx context.Context,
+	request *persistence.ListTaskQueueRequest,
+) (*persistence.InternalListTaskQueueResponse, error) {
+	if err := t.breaker.allow(ctx, "ListTaskQueue"); err != nil {
+		return nil, err
+	}
+	resp, err := t.baseTaskStore.ListTaskQueue(ctx, request)
+	t.breaker.record(err)
+	return resp, err
+}
+
+func (t *circuitBreakerTaskStore) DeleteTaskQueue(
+	ctx context.Context,
+	request *persistence.DeleteTaskQueueRequest,
+) error {
+	if err := t.breaker.allow(ctx, "DeleteTaskQueue"); err != nil {
+		return err
+	}
+	err := t.baseTaskStore.DeleteTaskQueue(ctx, request)
+	t.breaker.record(err)
+	return err
+}
+
+func (t *circuitBreakerTaskStore) CreateTasks(
+	ctx context.Context,
+	request *persistence.InternalCreateTasksRequest,
+) (*persistence.CreateTasksResponse, error) {
+	if err := t.breaker.allow(ctx, "CreateTasks"); err != nil {
+		return nil, err
+	}
+	resp, err := t.baseTaskStore.CreateTasks(ctx, request)
+	t.breaker.record(err)
+	return resp, err
+}
+
+func (t *circuitBreakerTaskStore) GetTasks(
+	ctx context.Context,
+	request *persistence.GetTasksRequest,
+) (*persistence.InternalGetTasksResponse, error) {
+	if err := t.breaker.allow(ctx, "GetTasks"); err != nil {
+		return nil, err
+	}
+	resp, err := t.baseTaskStore.GetTasks(ctx, request)
+	t.breaker.record(err)
+	return resp, err
+}
+
+func (t *circuitBreakerTaskStore) CompleteTask(
+	ctx context.Context,
+	request *persistence.CompleteTaskRequest,
+) error {
+	if err := t.breaker.allow(ctx, "CompleteTask"); err != nil {
+		return err
+	}
+	err := t.baseTaskStore.CompleteTask(ctx, request)
+	t.breaker.record(err)
+	return err
+}
+
+func (t *circuitBreakerTaskStore) CompleteTasksLessThan(
+	ctx context.Context,
+	request *persistence.CompleteTasksLessThanRequest,
+) (int, error) {
+	if err := t.breaker.allow(ctx, "CompleteTasksLessThan"); err != nil {
+		return 0, err
+	}
+	resp, err := t.baseTaskStore.CompleteTasksLessThan(ctx, request)
+	t.breaker.record(err)
+	return resp, err
+}
+
+func (t *circuitBreakerTaskStore) GetTaskQueueUserData(ctx context.Context, request *persistence.GetTaskQueueUserDataRequest) (*persistence.InternalGetTaskQueueUserDataResponse, error) {
+	if err := t.breaker.allow(ctx, "GetTaskQueueUserData"); err != nil {
+		return nil, err
+	}
+	resp, err := t.baseTaskStore.GetTaskQueueUserData(ctx, request)
+	t.breaker.record(err)
+	return resp, err
+}
+
+func (t *circuitBreakerTaskStore) UpdateTaskQueueUserData(ctx context.Context, request *persistence.InternalUpdateTaskQueueUserDataRequest) error {
+	if err := t.breaker.allow(ctx, "UpdateTaskQueueUserData"); err != nil {
+		return err
+	}
+	err := t.baseTaskStore.UpdateTaskQueueUserData(ctx, request)
+	t.breaker.record(err)
+	return err
+}
+
+func (t *circuitBreakerTaskStore) ListTaskQueueUserDataEntries(ctx context.Context, request *persistence.ListTaskQueueUserDataEntriesRequest) (*persistence.InternalListTaskQueueUserDataEntriesResponse, error) {
+	if err := t.breaker.allow(ctx, "ListTaskQueueUserDataEntries"); err != nil {
+		return nil, err
+	}
+	resp, err := t.baseTaskStore.ListTaskQueueUserDataEntries(ctx, request)
+	t.breaker.record(err)
+	return resp, err
+}
+
+func (t *circuitBreakerTaskStore) GetTaskQueuesByBuildId(ctx context.Context, request *persistence.GetTaskQueuesByBuildIdRequest) ([]string, error) {
+	if err := t.breaker.allow(ctx, "GetTaskQueuesByBuildId"); err != nil {
+		return nil, err
+	}
+	resp, err := t.baseTaskStore.GetTaskQueuesByBuildId(ctx, request)
+	t.breaker.record(err)
+	return resp, err
+}
+
+func (t *circuitBreakerTaskStore) CountTaskQueuesByBuildId(ctx context.Context, request *persistence.CountTaskQueuesByBuildIdRequest) (int, error) {
+	if err := t.breaker.allow(ctx, "CountTaskQueuesByBuildId"); err != nil {
+		return 0, err
+	}
+	resp, err := t.baseTaskStore.CountTaskQueuesByBuildId(ctx, request)
+	t.breaker.record(err)
+	return resp, err
+}
+
+func (m *circuitBreakerMetadataStore) Close() {
+	m.baseMetadataStore.Close()
+}
+
+func (m *circuitBreakerMetadataStore) GetName() string {
+	return m.baseMetadataStore.GetName()
+}
+
+func (m *circuitBreakerMetadataStore) CreateNamespace(
+	ctx context.Context,
+	request *persistence.InternalCreateNamespaceRequest,
+) (*persistence.CreateNamespaceResponse, error) {
+	if err := m.breaker.allow(ctx, "CreateNamespace"); err != nil {
+		return nil, err
+	}
+	resp, err := m.baseMetadataStore.CreateNamespace(ctx, request)
+	m.breaker.record(err)
+	return resp, err
+}
+
+func (m *circuitBreakerMetadataStore) GetNamespace(
+	ctx context.Context,
+	request *persistence.GetNamespaceRequest,
+) (*persistence.InternalGetNamespaceResponse, error) {
+	if err := m.breaker.allow(ctx, "GetNamespace"); err != nil {
+		return nil, err
+	}
+	resp, err := m.baseMetadataStore.GetNamespace(ctx, request)
+	m.breaker.record(err)
+	return resp, err
+}
+
+func (m *circuitBreakerMetadataStore) UpdateNamespace(
+	ctx context.Context,
+	request *persistence.InternalUpdateNamespaceRequest,
+) error {
+	if err := m.breaker.allow(ctx, "UpdateNamespace"); err != nil {
+		return err
+	}
+	err := m.baseMetadataStore.UpdateNamespace(ctx, request)
+	m.breaker.record(err)
+	return err
+}
+
+func (m *circuitBreakerMetadataStore) RenameNamespace(
+	ctx context.Context,
+	request *persistence.InternalRenameNamespaceRequest,
+) error {
+	if err := m.breaker.allow(ctx, "RenameNamespace"); err != nil {
+		return err
+	}
+	err := m.baseMetadataStore.RenameNamespace(ctx, request)
+	m.breaker.record(err)
+	return err
+}
+
+func (m *circuitBreakerMetadataStore) DeleteNamespace(
+	ctx context.Context,
+	request *persistence.DeleteNamespaceRequest,
+) error {
+	if err := m.breaker.allow(ctx, "DeleteNamespace"); err != nil {
+		return err
+	}
+	err := m.baseMetadataStore.DeleteNamespace(ctx, request)
+	m.breaker.record(err)
+	return err
+}
+
+func (m *circuitBreakerMetadataStore) DeleteNamespaceByName(
+	ctx context.Context,
+	request *persistence.DeleteNamespaceByNameRequest,
+) error {
+	if err := m.breaker.allow(ctx, "DeleteNamespaceByName"); err != nil {
+		return err
+	}
+	err := m.baseMetadataStore.DeleteNamespaceByName(ctx, request)
+	m.breaker.record(err)
+	return err
+}
+
+func (m *circuitBreakerMetadataStore) ListNamespaces(
+	ctx context.Context,
+	request *persistence.InternalListNamespacesRequest,
+) (*persistence.InternalListNamespacesResponse, error) {
+	if err := m.breaker.allow(ctx, "ListNamespaces"); err != nil {
+		return nil, err
+	}
+	resp, err := m.baseMetadataStore.ListNamespaces(ctx, request)
+	m.breaker.record(err)
+	return resp, err
+}
+
+func (m *circuitBreakerMetadataStore) GetMetadata(
+	ctx context.Context,
+) (*persistence.GetMetadataResponse, error) {
+	if err := m.breaker.allow(ctx, "GetMetadata"); err != nil {
+		return nil, err
+	}
+	resp, err := m.baseMetadataStore.GetMetadata(ctx)
+	m.breaker.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClusterMetadataStore) Close() {
+	c.baseCMStore.Close()
+}
+
+func (c *circuitBreakerClusterMetadataStore) GetName() string {
+	return c.baseCMStore.GetName()
+}
+
+func (c *circuitBreakerClusterMetadataStore) ListClusterMetadata(
+	ctx context.Context,
+	request *persistence.InternalListClusterMetadataRequest,
+) (*persistence.InternalListClusterMetadataResponse, error) {
+	if err := c.breaker.allow(ctx, "ListClusterMetadata"); err != nil {
+		return nil, err
+	}
+	resp, err := c.baseCMStore.ListClusterMetadata(ctx, request)
+	c.breaker.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClusterMetadataStore) GetClusterMetadata(
+	ctx context.Context,
+	request *persistence.InternalGetClusterMetadataRequest,
+) (*persistence.InternalGetClusterMetadataResponse, error) {
+	if err := c.breaker.allow(ctx, "GetClusterMetadata"); err != nil {
+		return nil, err
+	}
+	resp, err := c.baseCMStore.GetClusterMetadata(ctx, request)
+	c.breaker.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClusterMetadataStore) SaveClusterMetadata(
+	ctx context.Context,
+	request *persistence.InternalSaveClusterMetadataRequest,
+) (bool, error) {
+	if err := c.breaker.allow(ctx, "SaveClusterMetadata"); err != nil {
+		return false, err
+	}
+	resp, err := c.baseCMStore.SaveClusterMetadata(ctx, request)
+	c.breaker.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClusterMetadataStore) DeleteClusterMetadata(
+	ctx context.Context,
+	request *persistence.InternalDeleteClusterMetadataRequest,
+) error {
+	if err := c.breaker.allow(ctx, "DeleteClusterMetadata"); err != nil {
+		return err
+	}
+	err := c.baseCMStore.DeleteClusterMetadata(ctx, request)
+	c.breaker.record(err)
+	return err
+}
+
+func (c *circuitBreakerClusterMetadataStore) GetClusterMembers(
+	ctx context.Context,
+	request *persistence.GetClusterMembersRequest,
+) (*persistence.GetClusterMembersResponse, error) {
+	if err := c.breaker.allow(ctx, "GetClusterMembers"); err != nil {
+		return nil, err
+	}
+	resp, err := c.baseCMStore.GetClusterMembers(ctx, request)
+	c.breaker.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClusterMetadataStore) UpsertClusterMembership(
+	ctx context.Context,
+	request *persistence.UpsertClusterMembershipRequest,
+) error {
+	if err := c.breaker.allow(ctx, "UpsertClusterMembership"); err != nil {
+		return err
+	}
+	err := c.baseCMStore.UpsertClusterMembership(ctx, request)
+	c.breaker.record(err)
+	return err
+}
+
+func (c *circuitBreakerClusterMetadataStore) PruneClusterMembership(
+	ctx context.Context,
+	request *persistence.PruneClusterMembershipRequest,
+) error {
+	if err := c.breaker.allow(ctx, "PruneClusterMembership"); err != nil {
+		return err
+	}
+	err := c.baseCMStore.PruneClusterMembership(ctx, request)
+	c.breaker.record(err)
+	return err
+}
+
+func (e *circuitBreakerExecutionStore) Close() {
+	e.baseExecutionStore.Close()
+}
+
+func (e *circuitBreakerExecutionStore) GetName() string {
+	return e.baseExecutionStore.GetName()
+}
+
+func (e *circuitBreakerExecutionStore) GetWorkflowExecution(
+	ctx context.Context,
+	request *persistence.GetWorkflowExecutionRequest,
+) (*persistence.InternalGetWorkflowExecutionResponse, error) {
+	if err := e.breaker.allow(ctx, "GetWorkflowExecution"); err != nil {
+		return nil, err
+	}
+	resp, err := e.baseExecutionStore.GetWorkflowExecution(ctx, request)
+	e.breaker.record(err)
+	return resp, err
+}
+
+func (e *circuitBreakerExecutionStore) SetWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalSetWorkflowExecutionRequest,
+) error {
+	if err := e.breaker.allow(ctx, "SetWorkflowExecution"); err != nil {
+		return err
+	}
+	err := e.baseExecutionStore.SetWorkflowExecution(ctx, request)
+	e.breaker.record(err)
+	return err
+}
+
+func (e *circuitBreakerExecutionStore) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalUpdateWorkflowExecutionRequest,
+) error {
+	if err := e.breaker.allow(ctx, "UpdateWorkflowExecution"); err != nil {
+		return err
+	}
+	err := e.baseExecutionStore.UpdateWorkflowExecution(ctx, request)
+	e.breaker.record(err)
+	return err
+}
+
+func (e *circuitBreakerExecutionStore) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalConflictResolveWorkflowExecutionRequest,
+) error {
+	if err := e.breaker.allow(ctx, "ConflictResolveWorkflowExecution"); err != nil {
+		return err
+	}
+	err := e.baseExecutionStore.ConflictResolveWorkflowExecution(ctx, request)
+	e.breaker.record(err)
+	return err
+}
+
+func (e *circuitBreakerExecutionStore) CreateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalCreateWorkflowExecutionRequest,
+) (*persistence.InternalCreateWorkflowExecutionResponse, error) {
+	if err := e.breaker.allow(ctx, "CreateWorkflowExecution"); err != nil {
+		return nil, err
+	}
+	resp, err := e.baseExecutionStore.CreateWorkflowExecution(ctx, request)
+	e.breaker.record(err)
+	return resp, err
+}
+
+func (e *circuitBreakerExecutionStore) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *persistence.DeleteWorkflowExecutionRequest,
+) error {
+	if err := e.breaker.allow(ctx, "DeleteWorkflowExecution"); err != nil {
+		return err
+	}
+	err := e.baseExecutionStore.DeleteWorkflowExecution(ctx, request)
+	e.breaker.record(err)
+	return err
+}
+
+func (e *circuitBreakerExecutionStore) DeleteCurrentWorkflowExecution(
+	ctx context.Context,
+	request *persistence.DeleteCurrentWorkflowExecutionRequest,
+) error {
+	if err := e.breaker.allow(ctx, "DeleteCurrentWorkflowExecution"); err != nil {
+		return err
+	}
+	err := e.baseExecutionStore.DeleteCurrentWorkflowExecution(ctx, request)
+	e.breaker.record(err)
+	return err
+}
+
+func (e *circuitBreakerExecutionStore) GetCurrentExecution(
+	ctx context.Context,
+	request *persistence.GetCurrentExecutionRequest,
+) (*persistence.InternalGetCurrentExecutionResponse, error) {
+	if err := e.breaker.allow(ctx, "GetCurrentExecution"); err != nil {
+		return nil, err
+	}
+	resp, err := e.baseExecutionStore.GetCurrentExecution(ctx, request)
+	e.breaker.record(err)
+	return resp, err
+}
+
+func (e *circuitBreakerExecutionStore) ListConcreteExecutions(
+	ctx context.Context,
+	request *persistence.ListConcreteExecutionsRequest,
+) (*persistence.InternalListConcreteExecutionsResponse, error) {
+	if err := e.breaker.allow(ctx, "ListConcreteExecutions"); err != nil {
+		return nil, err
+	}
+	resp, err := e.baseExecutionStore.ListConcreteExecutions(ctx, request)
+	e.breaker.record(err)
+	return resp, err
+}
+
+func (e *circuitBreakerExecutionStore) RegisterHistoryTaskReader(
+	ctx context.Context,
+	request *persistence.RegisterHistoryTaskReaderRequest,
+) error {
+	// hint methods don't actually hit DB, so they are neither shed nor recorded
+	return e.baseExecutionStore.RegisterHistoryTaskReader(ctx, request)
+}
+
+func (e *circuitBreakerExecutionStore) UnregisterHistoryTaskReader(
+	ctx context.Context,
+	request *persistence.UnregisterHistoryTaskReaderRequest,
+) {
+	// hint methods don't actually hit DB, so they are neither shed nor recorded
+	e.baseExecutionStore.UnregisterHistoryTaskReader(ctx, request)
+}
+
+func (e *circuitBreakerExecutionStore) UpdateHistoryTaskReaderProgress(
+	ctx context.Context,
+	request *persistence.UpdateHistoryTaskReaderProgressRequest,
+) {
+	// hint methods don't actually hit DB, so they are neither shed nor recorded
+	e.baseExecutionStore.UpdateHistoryTaskReaderProgress(ctx, request)
+}
+
+func (e *circuitBreakerExecutionStore) AddHistoryTasks(
+	ctx context.Context,
+	request *persistence.InternalAddHistoryTasksRequest,
+) error {
+	if err := e.breaker.allow(ctx, "AddHistoryTasks"); err != nil {
+		return err
+	}
+	err := e.baseExecutionStore.AddHistoryTasks(ctx, request)
+	e.breaker.record(err)
+	return err
+}
+
+func (e *circuitBreakerExecutionStore) GetHistoryTasks(
+	ctx context.Context,
+	request *persistence.GetHistoryTasksRequest,
+) (*persistence.InternalGetHistoryTasksResponse, error) {
+	if err := e.breaker.allow(ctx, persistence.ConstructHistoryTaskAPI("GetHistoryTasks", request.TaskCategory)); err != nil {
+		return nil, err
+	}
+	resp, err := e.baseExecutionStore.GetHistoryTasks(ctx, request)
+	e.breaker.record(err)
+	return resp, err
+}
+
+func (e *circuitBreakerExecutionStore) CompleteHistoryTask(
+	ctx context.Context,
+	request *persistence.CompleteHistoryTaskRequest,
+) error {
+	if err := e.breaker.allow(ctx, "CompleteHistoryTask"); err != nil {
+		return err
+	}
+	err := e.baseExecutionStore.CompleteHistoryTask(ctx, request)
+	e.breaker.record(err)
+	return err
+}
+
+func (e *circuitBreakerExecutionStore) RangeCompleteHistoryTasks(
+	ctx context.Context,
+	request *persistence.RangeCompleteHistoryTasksRequest,
+) error {
+	if err := e.breaker.allow(ctx, persistence.ConstructHistoryTaskAPI("RangeCompleteHistoryTasks", request.TaskCategory)); err != nil {
+		return err
+	}
+	err := e.baseExecutionStore.RangeCompleteHistoryTasks(ctx, request)
+	e.breaker.record(err)
+	return err
+}
+
+func (e *circuitBreakerExecutionStore) PutReplicationTaskToDLQ(
+	ctx context.Context,
+	request *persistence.PutReplicationTaskToDLQRequest,
+) error {
+	if err := e.breaker.allow(ctx, "PutReplicationTaskToDLQ"); err != nil {
+		return err
+	}
+	err := e.baseExecutionStore.PutReplicationTaskToDLQ(ctx, request)
+	e.breaker.record(err)
+	return err
+}
+
+func (e *circuitBreakerExecutionStore) GetReplicationTasksFromDLQ(
+	ctx context.Context,
+	request *persistence.GetReplicationTasksFromDLQRequest,
+) (
+	*persistence.InternalGetHistoryTasksResponse,
+	error,
+) {
+	if err := e.breaker.allow(ctx, "GetReplicationTasksFromDLQ"); err != nil {
+		return nil, err
+	}
+	resp, err := e.baseExecutionStore.GetReplicationTasksFromDLQ(ctx, request)
+	e.breaker.record(err)
+	return resp, err
+}
+
+func (e *circuitBreakerExecutionStore) DeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *persistence.DeleteReplicationTaskFromDLQRequest,
+) error {
+	if err := e.breaker.allow(ctx, "DeleteReplicationTaskFromDLQ"); err != nil {
+		return err
+	}
+	err := e.baseExecutionStore.DeleteReplicationTaskFromDLQ(ctx, request)
+	e.breaker.record(err)
+	return err
+}
+
+func (e *circuitBreakerExecutionStore) RangeDeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *persistence.RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+	if err := e.breaker.allow(ctx, "RangeDeleteReplicationTaskFromDLQ"); err != nil {
+		return err
+	}
+	err := e.baseExecutionStore.RangeDeleteReplicationTaskFromDLQ(ctx, request)
+	e.breaker.record(err)
+	return err
+}
+
+func (e *circuitBreakerExecutionStore) IsReplicationDLQEmpty(
+	ctx context.Context,
+	request *persistence.GetReplicationTasksFromDLQRequest,
+) (bool, error) {
+	if err := e.breaker.allow(ctx, "IsReplicationDLQEmpty"); err != nil {
+		return true, err
+	}
+	resp, err := e.baseExecutionStore.IsReplicationDLQEmpty(ctx, request)
+	e.breaker.record(err)
+	return resp, err
+}
+
+func (e *circuitBreakerExecutionStore) AppendHistoryNodes(
+	ctx context.Context,
+	request *persistence.InternalAppendHistoryNodesRequest,
+) error {
+	if err := e.breaker.allow(ctx, "AppendHistoryNodes"); err != nil {
+		return err
+	}
+	err := e.baseExecutionStore.AppendHistoryNodes(ctx, request)
+	e.breaker.record(err)
+	return err
+}
+
+func (e *circuitBreakerExecutionStore) AppendHistoryNodesBatch(
+	ctx context.Context,
+	request *persistence.InternalAppendHistoryNodesBatchRequest,
+) error {
+	if err := e.breaker.allow(ctx, "AppendHistoryNodesBatch"); err != nil {
+		return err
+	}
+	err := e.baseExecutionStore.AppendHistoryNodesBatch(ctx, request)
+	e.breaker.record(err)
+	return err
+}
+
+func (e *circuitBreakerExecutionStore) DeleteHistoryNodes(
+	ctx context.Context,
+	request *persistence.InternalDeleteHistoryNodesRequest,
+) error {
+	if err := e.breaker.allow(ctx, "DeleteHistoryNodes"); err != nil {
+		return err
+	}
+	err := e.baseExecutionStore.DeleteHistoryNodes(ctx, request)
+	e.breaker.record(err)
+	return err
+}
+
+func (e *circuitBreakerExecutionStore) ReadHistoryBranch(
+	ctx context.Context,
+	request *persistence.InternalReadHistoryBranchRequest,
+) (*persistence.InternalReadHistoryBranchResponse, error) {
+	if err := e.breaker.allow(ctx, "ReadHistoryBranch"); err != nil {
+		return nil, err
+	}
+	resp, err := e.baseExecutionStore.ReadHistoryBranch(ctx, request)
+	e.breaker.record(err)
+	return resp, err
+}
+
+func (e *circuitBreakerExecutionStore) ForkHistoryBranch(
+	ctx context.Context,
+	request *persistence.InternalForkHistoryBranchRequest,
+) error {
+	if err := e.breaker.allow(ctx, "ForkHistoryBranch"); err != nil {
+		return err
+	}
+	err := e.baseExecutionStore.ForkHistoryBranch(ctx, request)
+	e.breaker.record(err)
+	return err
+}
+
+func (e *circuitBreakerExecutionStore) DeleteHistoryBranch(
+	ctx context.Context,
+	request *persistence.InternalDeleteHistoryBranchRequest,
+) error {
+	if err := e.breaker.allow(ctx, "DeleteHistoryBranch"); err != nil {
+		return err
+	}
+	err := e.baseExecutionStore.DeleteHistoryBranch(ctx, request)
+	e.breaker.record(err)
+	return err
+}
+
+func (e *circuitBreakerExecutionStore) GetHistoryTree(
+	ctx context.Context,
+	request *persistence.GetHistoryTreeRequest,
+) (*persistence.InternalGetHistoryTreeResponse, error) {
+	if err := e.breaker.allow(ctx, "GetHistoryTree"); err != nil {
+		return nil, err
+	}
+	resp, err := e.baseExecutionStore.GetHistoryTree(ctx, request)
+	e.breaker.record(err)
+	return resp, err
+}
+
+func (e *circuitBreakerExecutionStore) GetAllHistoryTreeBranches(
+	ctx context.Context,
+	request *persistence.GetAllHistoryTreeBranchesRequest,
+) (*persistence.InternalGetAllHistoryTreeBranchesResponse, error) {
+	if err := e.breaker.allow(ctx, "GetAllHistoryTreeBranches"); err != nil {
+		return nil, err
+	}
+	resp, err := e.baseExecutionStore.GetAllHistoryTreeBranches(ctx, request)
+	e.breaker.record(err)
+	return resp, err
+}
+
+func (q *circuitBreakerQueue) Close() {
+	q.baseQueue.Close()
+}
+
+func (q *circuitBreakerQueue) Init(
+	ctx context.Context,
+	blob *commonpb.DataBlob,
+) error {
+	if err := q.breaker.allow(ctx, "Init"); err != nil {
+		return err
+	}
+	err := q.baseQueue.Init(ctx, blob)
+	q.breaker.record(err)
+	return err
+}
+
+func (q *circuitBreakerQueue) EnqueueMessage(
+	ctx context.Context,
+	blob commonpb.DataBlob,
+) error {
+	if err := q.breaker.allow(ctx, "EnqueueMessage"); err != nil {
+		return err
+	}
+	err := q.baseQueue.EnqueueMessage(ctx, blob)
+	q.breaker.record(err)
+	return err
+}
+
+func (q *circuitBreakerQueue) ReadMessages(
+	ctx context.Context,
+	lastMessageID int64,
+	maxCount int,
+) ([]*persistence.QueueMessage, error) {
+	if err := q.breaker.allow(ctx, "ReadMessages"); err != nil {
+		return nil, err
+	}
+	resp, err := q.baseQueue.ReadMessages(ctx, lastMessageID, maxCount)
+	q.breaker.record(err)
+	return resp, err
+}
+
+func (q *circuitBreakerQueue) DeleteMessagesBefore(
+	ctx context.Context,
+	messageID int64,
+) error {
+	if err := q.breaker.allow(ctx, "DeleteMessagesBefore"); err != nil {
+		return err
+	}
+	err := q.baseQueue.DeleteMessagesBefore(ctx, messageID)
+	q.breaker.record(err)
+	return err
+}
+
+func (q *circuitBreakerQueue) UpdateAckLevel(
+	ctx context.Context,
+	metadata *persistence.InternalQueueMetadata,
+) error {
+	if err := q.breaker.allow(ctx, "UpdateAckLevel"); err != nil {
+		return err
+	}
+	err := q.baseQueue.UpdateAckLevel(ctx, metadata)
+	q.breaker.record(err)
+	return err
+}
+
+func (q *circuitBreakerQueue) GetAckLevels(
+	ctx context.Context,
+) (*persistence.InternalQueueMetadata, error) {
+	if err := q.breaker.allow(ctx, "GetAckLevels"); err != nil {
+		return nil, err
+	}
+	resp, err := q.baseQueue.GetAckLevels(ctx)
+	q.breaker.record(err)
+	return resp, err
+}
+
+func (q *circuitBreakerQueue) EnqueueMessageToDLQ(
+	ctx context.Context,
+	blob commonpb.DataBlob,
+) (int64, error) {
+	if err := q.breaker.allow(ctx, "EnqueueMessageToDLQ"); err != nil {
+		return 0, err
+	}
+	resp, err := q.baseQueue.EnqueueMessageToDLQ(ctx, blob)
+	q.breaker.record(err)
+	return resp, err
+}
+
+func (q *circuitBreakerQueue) ReadMessagesFromDLQ(
+	ctx context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*persistence.QueueMessage, []byte, error) {
+	if err := q.breaker.allow(ctx, "ReadMessagesFromDLQ"); err != nil {
+		return nil, nil, err
+	}
+	messages, nextPageToken, err := q.baseQueue.ReadMessagesFromDLQ(ctx, firstMessageID, lastMessageID, pageSize, pageToken)
+	q.breaker.record(err)
+	return messages, nextPageToken, err
+}
+
+func (q *circuitBreakerQueue) DeleteMessageFromDLQ(
+	ctx context.Context,
+	messageID int64,
+) error {
+	if err := q.breaker.allow(ctx, "DeleteMessageFromDLQ"); err != nil {
+		return err
+	}
+	err := q.baseQueue.DeleteMessageFromDLQ(ctx, messageID)
+	q.breaker.record(err)
+	return err
+}
+
+func (q *circuitBreakerQueue) RangeDeleteMessagesFromDLQ(
+	ctx context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+) error {
+	if err := q.breaker.allow(ctx, "RangeDeleteMessagesFromDLQ"); err != nil {
+		return err
+	}
+	err := q.baseQueue.RangeDeleteMessagesFromDLQ(ctx, firstMessageID, lastMessageID)
+	q.breaker.record(err)
+	return err
+}
+
+func (q *circuitBreakerQueue) UpdateDLQAckLevel(
+	ctx context.Context,
+	metadata *persistence.InternalQueueMetadata,
+) error {
+	if err := q.breaker.allow(ctx, "UpdateDLQAckLevel"); err != nil {
+		return err
+	}
+	err := q.baseQueue.UpdateDLQAckLevel(ctx, metadata)
+	q.breaker.record(err)
+	return err
+}
+
+func (q *circuitBreakerQueue) GetDLQAckLevels(
+	ctx context.Context,
+) (*persistence.InternalQueueMetadata, error) {
+	if err := q.breaker.allow(ctx, "GetDLQAckLevels"); err != nil {
+		return nil, err
+	}
+	resp, err := q.baseQueue.GetDLQAckLevels(ctx)
+	q.breaker.record(err)
+	return resp, err
+}
@@ -0,0 +1,1086 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/number"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	faultErrorRateKey   = "errorRate"
+	faultErrorsKey      = "errors"
+	faultLatencyKey     = "latency"
+	faultLatencyRateKey = "latencyRate"
+	faultAPIsKey        = "apis"
+)
+
+type (
+	// DynamicFaultInjectionConfig is the config of the faults injected into persistence requests. Unlike the
+	// static fault injection configured in the persistence config, faults can be toggled and targeted at
+	// namespaces, stores and APIs at runtime, which allows chaos testing a running cluster.
+	//
+	// Faults returns a map of the caller namespace with the keys:
+	//   - errorRate: the ratio of requests failed with an injected error
+	//   - errors: the name of each injected error to its weight, Unavailable if empty
+	//   - latency: the delay added to requests
+	//   - latencyRate: the ratio of requests delayed, 1 if not set
+	//   - apis: overrides of the above keyed by "Store", "Method" or "Store.Method", the most specific one wins
+	DynamicFaultInjectionConfig struct {
+		Enabled dynamicconfig.BoolPropertyFn
+		Faults  dynamicconfig.MapPropertyFnWithNamespaceFilter
+	}
+
+	// DynamicFaultInjectionDataStoreFactory wraps each store with a fault injector which fails and delays
+	// requests according to DynamicFaultInjectionConfig.
+	DynamicFaultInjectionDataStoreFactory struct {
+		baseFactory    DataStoreFactory
+		config         *DynamicFaultInjectionConfig
+		metricsHandler metrics.Handler
+		logger         log.Logger
+	}
+
+	storeFaultInjector struct {
+		storeName      string
+		config         *DynamicFaultInjectionConfig
+		metricsHandler metrics.Handler
+		logger         log.Logger
+	}
+
+	faultSpec struct {
+		errorRate   float64
+		errors      map[string]any
+		latency     time.Duration
+		latencyRate float64
+	}
+
+	dynamicFaultInjectionShardStore struct {
+		baseShardStore persistence.ShardStore
+		faults         storeFaultInjector
+	}
+
+	dynamicFaultInjectionTaskStore struct {
+		baseTaskStore persistence.TaskStore
+		faults        storeFaultInjector
+	}
+
+	dynamicFaultInjectionMetadataStore struct {
+		baseMetadataStore persistence.MetadataStore
+		faults            storeFaultInjector
+	}
+
+	dynamicFaultInjectionClusterMetadataStore struct {
+		baseCMStore persistence.ClusterMetadataStore
+		faults      storeFaultInjector
+	}
+
+	dynamicFaultInjectionExecutionStore struct {
+		persistence.HistoryBranchUtilImpl
+		baseExecutionStore persistence.ExecutionStore
+		faults             storeFaultInjector
+	}
+
+	dynamicFaultInjectionQueue struct {
+		baseQueue persistence.Queue
+		faults    storeFaultInjector
+	}
+)
+
+var _ DataStoreFactory = (*DynamicFaultInjectionDataStoreFactory)(nil)
+
+func NewDynamicFaultInjectionDataStoreFactory(
+	baseFactory DataStoreFactory,
+	config *DynamicFaultInjectionConfig,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *DynamicFaultInjectionDataStoreFactory {
+	return &DynamicFaultInjectionDataStoreFactory{
+		baseFactory:    baseFactory,
+		config:         config,
+		metricsHandler: metricsHandler,
+		logger:         logger,
+	}
+}
+
+func (d *DynamicFaultInjectionDataStoreFactory) Close() {
+	d.baseFactory.Close()
+}
+
+func (d *DynamicFaultInjectionDataStoreFactory) NewTaskStore() (persistence.TaskStore, error) {
+	store, err := d.baseFactory.NewTaskStore()
+	if err != nil {
+		return nil, err
+	}
+	return &dynamicFaultInjectionTaskStore{
+		baseTaskStore: store,
+		faults:        d.newStoreFaultInjector(config.TaskStoreName),
+	}, nil
+}
+
+func (d *DynamicFaultInjectionDataStoreFactory) NewShardStore() (persistence.ShardStore, error) {
+	store, err := d.baseFactory.NewShardStore()
+	if err != nil {
+		return nil, err
+	}
+	return &dynamicFaultInjectionShardStore{
+		baseShardStore: store,
+		faults:         d.newStoreFaultInjector(config.ShardStoreName),
+	}, nil
+}
+
+func (d *DynamicFaultInjectionDataStoreFactory) NewMetadataStore() (persistence.MetadataStore, error) {
+	store, err := d.baseFactory.NewMetadataStore()
+	if err != nil {
+		return nil, err
+	}
+	return &dynamicFaultInjectionMetadataStore{
+		baseMetadataStore: store,
+		faults:            d.newStoreFaultInjector(config.MetadataStoreName),
+	}, nil
+}
+
+func (d *DynamicFaultInjectionDataStoreFactory) NewExecutionStore() (persistence.ExecutionStore, error) {
+	store, err := d.baseFactory.NewExecutionStore()
+	if err != nil {
+		return nil, err
+	}
+	return &dynamicFaultInjectionExecutionStore{
+		baseExecutionStore: store,
+		faults:             d.newStoreFaultInjector(config.ExecutionStoreName),
+	}, nil
+}
+
+func (d *DynamicFaultInjectionDataStoreFactory) NewQueue(queueType persistence.QueueType) (persistence.Queue, error) {
+	queue, err := d.baseFactory.NewQueue(queueType)
+	if err != nil {
+		return nil, err
+	}
+	return &dynamicFaultInjectionQueue{
+		baseQueue: queue,
+		faults:    d.newStoreFaultInjector(config.QueueName),
+	}, nil
+}
+
+func (d *DynamicFaultInjectionDataStoreFactory) NewClusterMetadataStore() (persistence.ClusterMetadataStore, error) {
+	store, err := d.baseFactory.NewClusterMetadataStore()
+	if err != nil {
+		return nil, err
+	}
+	return &dynamicFaultInjectionClusterMetadataStore{
+		baseCMStore: store,
+		faults:      d.newStoreFaultInjector(config.ClusterMDStoreName),
+	}, nil
+}
+
+func (d *DynamicFaultInjectionDataStoreFactory) newStoreFaultInjector(
+	storeName config.DataStoreName,
+) storeFaultInjector {
+	return storeFaultInjector{
+		storeName:      string(storeName),
+		config:         d.config,
+		metricsHandler: d.metricsHandler.WithTags(metrics.PersistenceStoreTag(string(storeName))),
+		logger:         log.With(d.logger, tag.NewStringTag("store", string(storeName))),
+	}
+}
+
+// inject delays and/or fails the request according to the faults configured for the caller namespace and the api.
+func (f storeFaultInjector) inject(
+	ctx context.Context,
+	api string,
+) error {
+	if !f.config.Enabled() {
+		return nil
+	}
+	faults := f.config.Faults(headers.GetCallerInfo(ctx).CallerName)
+	if len(faults) == 0 {
+		return nil
+	}
+	spec := resolveFaultSpec(faults, f.storeName, api)
+
+	if spec.latency > 0 && rand.Float64() < spec.latencyRate {
+		f.metricsHandler.Counter(metrics.PersistenceFaultsInjected.GetMetricName()).Record(
+			1,
+			metrics.OperationTag(api),
+			metrics.StringTag("fault", faultLatencyKey),
+		)
+		timer := time.NewTimer(spec.latency)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+
+	if spec.errorRate > 0 && rand.Float64() < spec.errorRate {
+		err := f.pickError(spec.errors)
+		f.metricsHandler.Counter(metrics.PersistenceFaultsInjected.GetMetricName()).Record(
+			1,
+			metrics.OperationTag(api),
+			metrics.StringTag("fault", fmt.Sprintf("%T", err)),
+		)
+		return err
+	}
+	return nil
+}
+
+func (f storeFaultInjector) pickError(
+	errors map[string]any,
+) error {
+	total := 0.0
+	for _, weight := range errors {
+		total += number.NewNumber(weight).GetFloatOrDefault(0)
+	}
+	if total > 0 {
+		target := rand.Float64() * total
+		for name, weight := range errors {
+			target -= number.NewNumber(weight).GetFloatOrDefault(0)
+			if target >= 0 {
+				continue
+			}
+			if err, ok := errorFromName(name); ok {
+				return err
+			}
+			f.logger.Warn("Unknown persistence fault injection error, injecting Unavailable instead.", tag.Value(name))
+			break
+		}
+	}
+	return serviceerror.NewUnavailable("fault injection")
+}
+
+// resolveFaultSpec merges the namespace level faults with the overrides of the store, the api and the store api,
+// in that order, so the most specific override wins.
+func resolveFaultSpec(
+	faults map[string]any,
+	storeName string,
+	api string,
+) faultSpec {
+	spec := faultSpec{latencyRate: 1}
+	spec.merge(faults)
+	if apis, ok := faults[faultAPIsKey].(map[string]any); ok {
+		for _, key := range []string{storeName, api, storeName + "." + api} {
+			if override, ok := apis[key].(map[string]any); ok {
+				spec.merge(override)
+			}
+		}
+	}
+	return spec
+}
+
+func (s *faultSpec) merge(
+	faults map[string]any,
+) {
+	if v, ok := faults[faultErrorRateKey]; ok {
+		s.errorRate = number.NewNumber(v).GetFloatOrDefault(0)
+	}
+	if v, ok := faults[faultErrorsKey].(map[string]any); ok {
+		s.errors = v
+	}
+	if v, ok := faults[faultLatencyKey]; ok {
+		if latency, err := timestamp.ParseDurationDefaultSeconds(fmt.Sprint(v)); err == nil {
+			s.latency = latency
+		}
+	}
+	if v, ok := faults[faultLatencyRateKey]; ok {
+		s.latencyRate = number.NewNumber(v).GetFloatOrDefault(1)
+	}
+}
+
+func (s *dynamicFaultInjectionShardStore) Close() {
+	s.baseShardStore.Close()
+}
+
+func (s *dynamicFaultInjectionShardStore) GetName() string {
+	return s.baseShardStore.GetName()
+}
+
+func (s *dynamicFaultInjectionShardStore) GetClusterName() string {
+	return s.baseShardStore.GetClusterName()
+}
+
+func (s *dynamicFaultInjectionShardStore) GetOrCreateShard(
+	ctx context.Context,
+	request *persistence.InternalGetOrCreateShardRequest,
+) (*persistence.InternalGetOrCreateShardResponse, error) {
+	if err := s.faults.inject(ctx, "GetOrCreateShard"); err != nil {
+		return nil, err
+	}
+	return s.baseShardStore.GetOrCreateShard(ctx, request)
+}
+
+func (s *dynamicFaultInjectionShardStore) UpdateShard(
+	ctx context.Context,
+	request *persistence.InternalUpdateShardRequest,
+) error {
+	if err := s.faults.inject(ctx, "UpdateShard"); err != nil {
+		return err
+	}
+	return s.baseShardStore.UpdateShard(ctx, request)
+}
+
+func (s *dynamicFaultInjectionShardStore) AssertShardOwnership(
+	ctx context.Context,
+	request *persistence.AssertShardOwnershipRequest,
+) error {
+	if err := s.faults.inject(ctx, "AssertShardOwnership"); err != nil {
+		return err
+	}
+	return s.baseShardStore.AssertShardOwnership(ctx, request)
+}
+
+func (t *dynamicFaultInjectionTaskStore) Close() {
+	t.baseTaskStore.Close()
+}
+
+func (t *dynamicFaultInjectionTaskStore) GetName() string {
+	return t.baseTaskStore.GetName()
+}
+
+func (t *dynamicFaultInjectionTaskStore) CreateTaskQueue(
+	ctx context.Context,
+	request *persistence.InternalCreateTaskQueueRequest,
+) error {
+	if err := t.faults.inject(ctx, "CreateTaskQueue"); err != nil {
+		return err
+	}
+	return t.baseTaskStore.CreateTaskQueue(ctx, request)
+}
+
+func (t *dynamicFaultInjectionTaskStore) GetTaskQueue(
+	ctx context.Context,
+	request *persistence.InternalGetTaskQueueRequest,
+) (*persistence.InternalGetTaskQueueResponse, error) {
+	if err := t.faults.inject(ctx, "GetTaskQueue"); err != nil {
+		return nil, err
+	}
+	return t.baseTaskStore.GetTaskQueue(ctx, request)
+}
+
+func (t *dynamicFaultInjectionTaskStore) UpdateTaskQueue(
+	ctx context.Context,
+	request *persistence.InternalUpdateTaskQueueRequest,
+) (*persistence.UpdateTaskQueueResponse, error) {
+	if err := t.faults.inject(ctx, "UpdateTaskQueue"); err != nil {
+		return nil, err
+	}
+	return t.baseTaskStore.UpdateTaskQueue(ctx, request)
+}
+
+func (t *dynamicFaultInjectionTaskStore) ListTaskQueue(
+	ctx context.Context,
+	request *persistence.ListTaskQueueRequest,
+) (*persistence.InternalListTaskQueueResponse, error) {
+	if err := t.faults.inject(ctx, "ListTaskQueue"); err != nil {
+		return nil, err
+	}
+	return t.baseTaskStore.ListTaskQueue(ctx, request)
+}
+
+func (t *dynamicFaultInjectionTaskStore) DeleteTaskQueue(
+	ctx context.Context,
+	request *persistence.DeleteTaskQueueRequest,
+) error {
+	if err := t.faults.inject(ctx, "DeleteTaskQueue"); err != nil {
+		return err
+	}
+	return t.baseTaskStore.DeleteTaskQueue(ctx, request)
+}
+
+func (t *dynamicFaultInjectionTaskStore) CreateTasks(
+	ctx context.Context,
+	request *persistence.InternalCreateTasksRequest,
+) (*persistence.CreateTasksResponse, error) {
+	if err := t.faults.inject(ctx, "CreateTasks"); err != nil {
+		return nil, err
+	}
+	return t.baseTaskStore.CreateTasks(ctx, request)
+}
+
+func (t *dynamicFaultInjectionTaskStore) GetTasks(
+	ctx context.Context,
+	request *persistence.GetTasksRequest,
+) (*persistence.InternalGetTasksResponse, error) {
+	if err := t.faults.inject(ctx, "GetTasks"); err != nil {
+		return nil, err
+	}
+	return t.baseTaskStore.GetTasks(ctx, request)
+}
+
+func (t *dynamicFaultInjectionTaskStore) CompleteTask(
+	ctx context.Context,
+	request *persistence.CompleteTaskRequest,
+) error {
+	if err := t.faults.inject(ctx, "CompleteTask"); err != nil {
+		return err
+	}
+	return t.baseTaskStore.CompleteTask(ctx, request)
+}
+
+func (t *dynamicFaultInjectionTaskStore) CompleteTasksLessThan(
+	ctx context.Context,
+	request *persistence.CompleteTasksLessThanRequest,
+) (int, error) {
+	if err := t.faults.inject(ctx, "CompleteTasksLessThan"); err != nil {
+		return 0, err
+	}
+	return t.baseTaskStore.CompleteTasksLessThan(ctx, request)
+}
+
+func (t *dynamicFaultInjectionTaskStore) GetTaskQueueUserData(ctx context.Context, request *persistence.GetTaskQueueUserDataRequest) (*persistence.InternalGetTaskQueueUserDataResponse, error) {
+	if err := t.faults.inject(ctx, "GetTaskQueueUserData"); err != nil {
+		return nil, err
+	}
+	return t.baseTaskStore.GetTaskQueueUserData(ctx, request)
+}
+
+func (t *dynamicFaultInjectionTaskStore) UpdateTaskQueueUserData(ctx context.Context, request *persistence.InternalUpdateTaskQueueUserDataRequest) error {
+	if err := t.faults.inject(ctx, "UpdateTaskQueueUserData"); err != nil {
+		return err
+	}
+	return t.baseTaskStore.UpdateTaskQueueUserData(ctx, request)
+}
+
+func (t *dynamicFaultInjectionTaskStore) ListTaskQueueUserDataEntries(ctx context.Context, request *persistence.ListTaskQueueUserDataEntriesRequest) (*persistence.InternalListTaskQueueUserDataEntriesResponse, error) {
+	if err := t.faults.inject(ctx, "ListTaskQueueUserDataEntries"); err != nil {
+		return nil, err
+	}
+	return t.baseTaskStore.ListTaskQueueUserDataEntries(ctx, request)
+}
+
+func (t *dynamicFaultInjectionTaskStore) GetTaskQueuesByBuildId(ctx context.Context, request *persistence.GetTaskQueuesByBuildIdRequest) ([]string, error) {
+	if err := t.faults.inject(ctx, "GetTaskQueuesByBuildId"); err != nil {
+		return nil, err
+	}
+	return t.baseTaskStore.GetTaskQueuesByBuildId(ctx, request)
+}
+
+func (t *dynamicFaultInjectionTaskStore) CountTaskQueuesByBuildId(ctx context.Context, request *persistence.CountTaskQueuesByBuildIdRequest) (int, error) {
+	if err := t.faults.inject(ctx, "CountTaskQueuesByBuildId"); err != nil {
+		return 0, err
+	}
+	return t.baseTaskStore.CountTaskQueuesByBuildId(ctx, request)
+}
+
+func (m *dynamicFaultInjectionMetadataStore) Close() {
+	m.baseMetadataStore.Close()
+}
+
+func (m *dynamicFaultInjectionMetadataStore) GetName() string {
+	return m.baseMetadataStore.GetName()
+}
+
+func (m *dynamicFaultInjectionMetadataStore) CreateNamespace(
+	ctx context.Context,
+	request *persistence.InternalCreateNamespaceRequest,
+) (*persistence.CreateNamespaceResponse, error) {
+	if err := m.faults.inject(ctx, "CreateNamespace"); err != nil {
+		return nil, err
+	}
+	return m.baseMetadataStore.CreateNamespace(ctx, request)
+}
+
+func (m *dynamicFaultInjectionMetadataStore) GetNamespace(
+	ctx context.Context,
+	request *persistence.GetNamespaceRequest,
+) (*persistence.InternalGetNamespaceResponse, error) {
+	if err := m.faults.inject(ctx, "GetNamespace"); err != nil {
+		return nil, err
+	}
+	return m.baseMetadataStore.GetNamespace(ctx, request)
+}
+
+func (m *dynamicFaultInjectionMetadataStore) UpdateNamespace(
+	ctx context.Context,
+	request *persistence.InternalUpdateNamespaceRequest,
+) error {
+	if err := m.faults.inject(ctx, "UpdateNamespace"); err != nil {
+		return err
+	}
+	return m.baseMetadataStore.UpdateNamespace(ctx, request)
+}
+
+func (m *dynamicFaultInjectionMetadataStore) RenameNamespace(
+	ctx context.Context,
+	request *persistence.InternalRenameNamespaceRequest,
+) error {
+	if err := m.faults.inject(ctx, "RenameNamespace"); err != nil {
+		return err
+	}
+	return m.baseMetadataStore.RenameNamespace(ctx, request)
+}
+
+func (m *dynamicFaultInjectionMetadataStore) DeleteNamespace(
+	ctx context.Context,
+	request *persistence.DeleteNamespaceRequest,
+) error {
+	if err := m.faults.inject(ctx, "DeleteNamespace"); err != nil {
+		return err
+	}
+	return m.baseMetadataStore.DeleteNamespace(ctx, request)
+}
+
+func (m *dynamicFaultInjectionMetadataStore) DeleteNamespaceByName(
+	ctx context.Context,
+	request *persistence.DeleteNamespaceByNameRequest,
+) error {
+	if err := m.faults.inject(ctx, "DeleteNamespaceByName"); err != nil {
+		return err
+	}
+	return m.baseMetadataStore.DeleteNamespaceByName(ctx, request)
+}
+
+func (m *dynamicFaultInjectionMetadataStore) ListNamespaces(
+	ctx context.Context,
+	request *persistence.InternalListNamespacesRequest,
+) (*persistence.InternalListNamespacesResponse, error) {
+	if err := m.faults.inject(ctx, "ListNamespaces"); err != nil {
+		return nil, err
+	}
+	return m.baseMetadataStore.ListNamespaces(ctx, request)
+}
+
+func (m *dynamicFaultInjectionMetadataStore) GetMetadata(
+	ctx context.Context,
+) (*persistence.GetMetadataResponse, error) {
+	if err := m.faults.inject(ctx, "GetMetadata"); err != nil {
+		return nil, err
+	}
+	return m.baseMetadataStore.GetMetadata(ctx)
+}
+
+func (c *dynamicFaultInjectionClusterMetadataStore) Close() {
+	c.baseCMStore.Close()
+}
+
+func (c *dynamicFaultInjectionClusterMetadataStore) GetName() string {
+	return c.baseCMStore.GetName()
+}
+
+func (c *dynamicFaultInjectionClusterMetadataStore) ListClusterMetadata(
+	ctx context.Context,
+	request *persistence.InternalListClusterMetadataRequest,
+) (*persistence.InternalListClusterMetadataResponse, error) {
+	if err := c.faults.inject(ctx, "ListClusterMetadata"); err != nil {
+		return nil, err
+	}
+	return c.baseCMStore.ListClusterMetadata(ctx, request)
+}
+
+func (c *dynamicFaultInjectionClusterMetadataStore) GetClusterMetadata(
+	ctx context.Context,
+	request *persistence.InternalGetClusterMetadataRequest,
+) (*persistence.InternalGetClusterMetadataResponse, error) {
+	if err := c.faults.inject(ctx, "GetClusterMetadata"); err != nil {
+		return nil, err
+	}
+	return c.baseCMStore.GetClusterMetadata(ctx, request)
+}
+
+func (c *dynamicFaultInjectionClusterMetadataStore) SaveClusterMetadata(
+	ctx context.Context,
+	request *persistence.InternalSaveClusterMetadataRequest,
+) (bool, error) {
+	if err := c.faults.inject(ctx, "SaveClusterMetadata"); err != nil {
+		return false, err
+	}
+	return c.baseCMStore.SaveClusterMetadata(ctx, request)
+}
+
+func (c *dynamicFaultInjectionClusterMetadataStore) DeleteClusterMetadata(
+	ctx context.Context,
+	request *persistence.InternalDeleteClusterMetadataRequest,
+) error {
+	if err := c.faults.inject(ctx, "DeleteClusterMetadata"); err != nil {
+		return err
+	}
+	return c.baseCMStore.DeleteClusterMetadata(ctx, request)
+}
+
+func (c *dynamicFaultInjectionClusterMetadataStore) GetClusterMembers(
+	ctx context.Context,
+	request *persistence.GetClusterMembersRequest,
+) (*persistence.GetClusterMembersResponse, error) {
+	if err := c.faults.inject(ctx, "GetClusterMembers"); err != nil {
+		return nil, err
+	}
+	return c.baseCMStore.GetClusterMembers(ctx, request)
+}
+
+func (c *dynamicFaultInjectionClusterMetadataStore) UpsertClusterMembership(
+	ctx context.Context,
+	request *persistence.UpsertClusterMembershipRequest,
+) error {
+	if err := c.faults.inject(ctx, "UpsertClusterMembership"); err != nil {
+		return err
+	}
+	return c.baseCMStore.UpsertClusterMembership(ctx, request)
+}
+
+func (c *dynamicFaultInjectionClusterMetadataStore) PruneClusterMembership(
+	ctx context.Context,
+	request *persistence.PruneClusterMembershipRequest,
+) error {
+	if err := c.faults.inject(ctx, "PruneClusterMembership"); err != nil {
+		return err
+	}
+	return c.baseCMStore.PruneClusterMembership(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) Close() {
+	e.baseExecutionStore.Close()
+}
+
+func (e *dynamicFaultInjectionExecutionStore) GetName() string {
+	return e.baseExecutionStore.GetName()
+}
+
+func (e *dynamicFaultInjectionExecutionStore) GetWorkflowExecution(
+	ctx context.Context,
+	request *persistence.GetWorkflowExecutionRequest,
+) (*persistence.InternalGetWorkflowExecutionResponse, error) {
+	if err := e.faults.inject(ctx, "GetWorkflowExecution"); err != nil {
+		return nil, err
+	}
+	return e.baseExecutionStore.GetWorkflowExecution(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) SetWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalSetWorkflowExecutionRequest,
+) error {
+	if err := e.faults.inject(ctx, "SetWorkflowExecution"); err != nil {
+		return err
+	}
+	return e.baseExecutionStore.SetWorkflowExecution(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalUpdateWorkflowExecutionRequest,
+) error {
+	if err := e.faults.inject(ctx, "UpdateWorkflowExecution"); err != nil {
+		return err
+	}
+	return e.baseExecutionStore.UpdateWorkflowExecution(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalConflictResolveWorkflowExecutionRequest,
+) error {
+	if err := e.faults.inject(ctx, "ConflictResolveWorkflowExecution"); err != nil {
+		return err
+	}
+	return e.baseExecutionStore.ConflictResolveWorkflowExecution(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) CreateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalCreateWorkflowExecutionRequest,
+) (*persistence.InternalCreateWorkflowExecutionResponse, error) {
+	if err := e.faults.inject(ctx, "CreateWorkflowExecution"); err != nil {
+		return nil, err
+	}
+	return e.baseExecutionStore.CreateWorkflowExecution(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *persistence.DeleteWorkflowExecutionRequest,
+) error {
+	if err := e.faults.inject(ctx, "DeleteWorkflowExecution"); err != nil {
+		return err
+	}
+	return e.baseExecutionStore.DeleteWorkflowExecution(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) DeleteCurrentWorkflowExecution(
+	ctx context.Context,
+	request *persistence.DeleteCurrentWorkflowExecutionRequest,
+) error {
+	if err := e.faults.inject(ctx, "DeleteCurrentWorkflowExecution"); err != nil {
+		return err
+	}
+	return e.baseExecutionStore.DeleteCurrentWorkflowExecution(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) GetCurrentExecution(
+	ctx context.Context,
+	request *persistence.GetCurrentExecutionRequest,
+) (*persistence.InternalGetCurrentExecutionResponse, error) {
+	if err := e.faults.inject(ctx, "GetCurrentExecution"); err != nil {
+		return nil, err
+	}
+	return e.baseExecutionStore.GetCurrentExecution(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) ListConcreteExecutions(
+	ctx context.Context,
+	request *persistence.ListConcreteExecutionsRequest,
+) (*persistence.InternalListConcreteExecutionsResponse, error) {
+	if err := e.faults.inject(ctx, "ListConcreteExecutions"); err != nil {
+		return nil, err
+	}
+	return e.baseExecutionStore.ListConcreteExecutions(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) RegisterHistoryTaskReader(
+	ctx context.Context,
+	request *persistence.RegisterHistoryTaskReaderRequest,
+) error {
+	// hint methods don't actually hit DB, so no faults are injected
+	return e.baseExecutionStore.RegisterHistoryTaskReader(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) UnregisterHistoryTaskReader(
+	ctx context.Context,
+	request *persistence.UnregisterHistoryTaskReaderRequest,
+) {
+	// hint methods don't actually hit DB, so no faults are injected
+	e.baseExecutionStore.UnregisterHistoryTaskReader(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) UpdateHistoryTaskReaderProgress(
+	ctx context.Context,
+	request *persistence.UpdateHistoryTaskReaderProgressRequest,
+) {
+	// hint methods don't actually hit DB, so no faults are injected
+	e.baseExecutionStore.UpdateHistoryTaskReaderProgress(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) AddHistoryTasks(
+	ctx context.Context,
+	request *persistence.InternalAddHistoryTasksRequest,
+) error {
+	if err := e.faults.inject(ctx, "AddHistoryTasks"); err != nil {
+		return err
+	}
+	return e.baseExecutionStore.AddHistoryTasks(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) GetHistoryTasks(
+	ctx context.Context,
+	request *persistence.GetHistoryTasksRequest,
+) (*persistence.InternalGetHistoryTasksResponse, error) {
+	if err := e.faults.inject(ctx, "GetHistoryTasks"); err != nil {
+		return nil, err
+	}
+	return e.baseExecutionStore.GetHistoryTasks(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) CompleteHistoryTask(
+	ctx context.Context,
+	request *persistence.CompleteHistoryTaskRequest,
+) error {
+	if err := e.faults.inject(ctx, "CompleteHistoryTask"); err != nil {
+		return err
+	}
+	return e.baseExecutionStore.CompleteHistoryTask(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) RangeCompleteHistoryTasks(
+	ctx context.Context,
+	request *persistence.RangeCompleteHistoryTasksRequest,
+) error {
+	if err := e.faults.inject(ctx, "RangeCompleteHistoryTasks"); err != nil {
+		return err
+	}
+	return e.baseExecutionStore.RangeCompleteHistoryTasks(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) PutReplicationTaskToDLQ(
+	ctx context.Context,
+	request *persistence.PutReplicationTaskToDLQRequest,
+) error {
+	if err := e.faults.inject(ctx, "PutReplicationTaskToDLQ"); err != nil {
+		return err
+	}
+	return e.baseExecutionStore.PutReplicationTaskToDLQ(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) GetReplicationTasksFromDLQ(
+	ctx context.Context,
+	request *persistence.GetReplicationTasksFromDLQRequest,
+) (
+	*persistence.InternalGetHistoryTasksResponse,
+	error,
+) {
+	if err := e.faults.inject(ctx, "GetReplicationTasksFromDLQ"); err != nil {
+		return nil, err
+	}
+	return e.baseExecutionStore.GetReplicationTasksFromDLQ(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) DeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *persistence.DeleteReplicationTaskFromDLQRequest,
+) error {
+	if err := e.faults.inject(ctx, "DeleteReplicationTaskFromDLQ"); err != nil {
+		return err
+	}
+	return e.baseExecutionStore.DeleteReplicationTaskFromDLQ(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) RangeDeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *persistence.RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+	if err := e.faults.inject(ctx, "RangeDeleteReplicationTaskFromDLQ"); err != nil {
+		return err
+	}
+	return e.baseExecutionStore.RangeDeleteReplicationTaskFromDLQ(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) IsReplicationDLQEmpty(
+	ctx context.Context,
+	request *persistence.GetReplicationTasksFromDLQRequest,
+) (bool, error) {
+	if err := e.faults.inject(ctx, "IsReplicationDLQEmpty"); err != nil {
+		return true, err
+	}
+	return e.baseExecutionStore.IsReplicationDLQEmpty(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) AppendHistoryNodes(
+	ctx context.Context,
+	request *persistence.InternalAppendHistoryNodesRequest,
+) error {
+	if err := e.faults.inject(ctx, "AppendHistoryNodes"); err != nil {
+		return err
+	}
+	return e.baseExecutionStore.AppendHistoryNodes(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) AppendHistoryNodesBatch(
+	ctx context.Context,
+	request *persistence.InternalAppendHistoryNodesBatchRequest,
+) error {
+	if err := e.faults.inject(ctx, "AppendHistoryNodesBatch"); err != nil {
+		return err
+	}
+	return e.baseExecutionStore.AppendHistoryNodesBatch(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) DeleteHistoryNodes(
+	ctx context.Context,
+	request *persistence.InternalDeleteHistoryNodesRequest,
+) error {
+	if err := e.faults.inject(ctx, "DeleteHistoryNodes"); err != nil {
+		return err
+	}
+	return e.baseExecutionStore.DeleteHistoryNodes(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) ReadHistoryBranch(
+	ctx context.Context,
+	request *persistence.InternalReadHistoryBranchRequest,
+) (*persistence.InternalReadHistoryBranchResponse, error) {
+	if err := e.faults.inject(ctx, "ReadHistoryBranch"); err != nil {
+		return nil, err
+	}
+	return e.baseExecutionStore.ReadHistoryBranch(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) ForkHistoryBranch(
+	ctx context.Context,
+	request *persistence.InternalForkHistoryBranchRequest,
+) error {
+	if err := e.faults.inject(ctx, "ForkHistoryBranch"); err != nil {
+		return err
+	}
+	return e.baseExecutionStore.ForkHistoryBranch(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) DeleteHistoryBranch(
+	ctx context.Context,
+	request *persistence.InternalDeleteHistoryBranchRequest,
+) error {
+	if err := e.faults.inject(ctx, "DeleteHistoryBranch"); err != nil {
+		return err
+	}
+	return e.baseExecutionStore.DeleteHistoryBranch(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) GetHistoryTree(
+	ctx context.Context,
+	request *persistence.GetHistoryTreeRequest,
+) (*persistence.InternalGetHistoryTreeResponse, error) {
+	if err := e.faults.inject(ctx, "GetHistoryTree"); err != nil {
+		return nil, err
+	}
+	return e.baseExecutionStore.GetHistoryTree(ctx, request)
+}
+
+func (e *dynamicFaultInjectionExecutionStore) GetAllHistoryTreeBranches(
+	ctx context.Context,
+	request *persistence.GetAllHistoryTreeBranchesRequest,
+) (*persistence.InternalGetAllHistoryTreeBranchesResponse, error) {
+	if err := e.faults.inject(ctx, "GetAllHistoryTreeBranches"); err != nil {
+		return nil, err
+	}
+	return e.baseExecutionStore.GetAllHistoryTreeBranches(ctx, request)
+}
+
+func (q *dynamicFaultInjectionQueue) Close() {
+	q.baseQueue.Close()
+}
+
+func (q *dynamicFaultInjectionQueue) Init(
+	ctx context.Context,
+	blob *commonpb.DataBlob,
+) error {
+	if err := q.faults.inject(ctx, "Init"); err != nil {
+		return err
+	}
+	return q.baseQueue.Init(ctx, blob)
+}
+
+func (q *dynamicFaultInjectionQueue) EnqueueMessage(
+	ctx context.Context,
+	blob commonpb.DataBlob,
+) error {
+	if err := q.faults.inject(ctx, "EnqueueMessage"); err != nil {
+		return err
+	}
+	return q.baseQueue.EnqueueMessage(ctx, blob)
+}
+
+func (q *dynamicFaultInjectionQueue) ReadMessages(
+	ctx context.Context,
+	lastMessageID int64,
+	maxCount int,
+) ([]*persistence.QueueMessage, error) {
+	if err := q.faults.inject(ctx, "ReadMessages"); err != nil {
+		return nil, err
+	}
+	return q.baseQueue.ReadMessages(ctx, lastMessageID, maxCount)
+}
+
+func (q *dynamicFaultInjectionQueue) DeleteMessagesBefore(
+	ctx context.Context,
+	messageID int64,
+) error {
+	if err := q.faults.inject(ctx, "DeleteMessagesBefore"); err != nil {
+		return err
+	}
+	return q.baseQueue.DeleteMessagesBefore(ctx, messageID)
+}
+
+func (q *dynamicFaultInjectionQueue) UpdateAckLevel(
+	ctx context.Context,
+	metadata *persistence.InternalQueueMetadata,
+) error {
+	if err := q.faults.inject(ctx, "UpdateAckLevel"); err != nil {
+		return err
+	}
+	return q.baseQueue.UpdateAckLevel(ctx, metadata)
+}
+
+func (q *dynamicFaultInjectionQueue) GetAckLevels(
+	ctx context.Context,
+) (*persistence.InternalQueueMetadata, error) {
+	if err := q.faults.inject(ctx, "GetAckLevels"); err != nil {
+		return nil, err
+	}
+	return q.baseQueue.GetAckLevels(ctx)
+}
+
+func (q *dynamicFaultInjectionQueue) EnqueueMessageToDLQ(
+	ctx context.Context,
+	blob commonpb.DataBlob,
+) (int64, error) {
+	if err := q.faults.inject(ctx, "EnqueueMessageToDLQ"); err != nil {
+		return 0, err
+	}
+	return q.baseQueue.EnqueueMessageToDLQ(ctx, blob)
+}
+
+func (q *dynamicFaultInjectionQueue) ReadMessagesFromDLQ(
+	ctx context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*persistence.QueueMessage, []byte, error) {
+	if err := q.faults.inject(ctx, "ReadMessagesFromDLQ"); err != nil {
+		return nil, nil, err
+	}
+	return q.baseQueue.ReadMessagesFromDLQ(ctx, firstMessageID, lastMessageID, pageSize, pageToken)
+}
+
+func (q *dynamicFaultInjectionQueue) DeleteMessageFromDLQ(
+	ctx context.Context,
+	messageID int64,
+) error {
+	if err := q.faults.inject(ctx, "DeleteMessageFromDLQ"); err != nil {
+		return err
+	}
+	return q.baseQueue.DeleteMessageFromDLQ(ctx, messageID)
+}
+
+func (q *dynamicFaultInjectionQueue) RangeDeleteMessagesFromDLQ(
+	ctx context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+) error {
+	if err := q.faults.inject(ctx, "RangeDeleteMessagesFromDLQ"); err != nil {
+		return err
+	}
+	return q.baseQueue.RangeDeleteMessagesFromDLQ(ctx, firstMessageID, lastMessageID)
+}
+
+func (q *dynamicFaultInjectionQueue) UpdateDLQAckLevel(
+	ctx context.Context,
+	metadata *persistence.InternalQueueMetadata,
+) error {
+	if err := q.faults.inject(ctx, "UpdateDLQAckLevel"); err != nil {
+		return err
+	}
+	return q.baseQueue.UpdateDLQAckLevel(ctx, metadata)
+}
+
+func (q *dynamicFaultInjectionQueue) GetDLQAckLevels(
+	ctx context.Context,
+) (*persistence.InternalQueueMetadata, error) {
+	if err := q.faults.inject(ctx, "GetDLQAckLevels"); err != nil {
+		return nil, err
+	}
+	return q.baseQueue.GetDLQAckLevels(ctx)
+}
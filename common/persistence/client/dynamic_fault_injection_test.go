@@ -0,0 +1,147 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	dynamicFaultInjectionSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		enabled bool
+		faults  map[string]map[string]any
+		ctx     context.Context
+	}
+)
+
+func TestDynamicFaultInjectionSuite(t *testing.T) {
+	s := new(dynamicFaultInjectionSuite)
+	suite.Run(t, s)
+}
+
+func (s *dynamicFaultInjectionSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.enabled = true
+	s.faults = map[string]map[string]any{}
+	s.ctx = headers.SetCallerInfo(context.Background(), headers.NewBackgroundCallerInfo("target-namespace"))
+}
+
+func (s *dynamicFaultInjectionSuite) injector(
+	storeName config.DataStoreName,
+) storeFaultInjector {
+	factory := NewDynamicFaultInjectionDataStoreFactory(
+		nil,
+		&DynamicFaultInjectionConfig{
+			Enabled: func() bool { return s.enabled },
+			Faults:  func(namespace string) map[string]any { return s.faults[namespace] },
+		},
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	)
+	return factory.newStoreFaultInjector(storeName)
+}
+
+func (s *dynamicFaultInjectionSuite) TestNoFaults() {
+	s.NoError(s.injector(config.ExecutionStoreName).inject(s.ctx, "GetWorkflowExecution"))
+}
+
+func (s *dynamicFaultInjectionSuite) TestDisabled() {
+	s.enabled = false
+	s.faults["target-namespace"] = map[string]any{"errorRate": 1}
+	s.NoError(s.injector(config.ExecutionStoreName).inject(s.ctx, "GetWorkflowExecution"))
+}
+
+func (s *dynamicFaultInjectionSuite) TestDefaultError() {
+	s.faults["target-namespace"] = map[string]any{"errorRate": 1}
+	err := s.injector(config.ExecutionStoreName).inject(s.ctx, "GetWorkflowExecution")
+	s.IsType(&serviceerror.Unavailable{}, err)
+}
+
+func (s *dynamicFaultInjectionSuite) TestErrorTypes() {
+	s.faults["target-namespace"] = map[string]any{
+		"errorRate": 1,
+		"errors":    map[string]any{"ShardOwnershipLostError": 1},
+	}
+	err := s.injector(config.ShardStoreName).inject(s.ctx, "UpdateShard")
+	s.IsType(&persistence.ShardOwnershipLostError{}, err)
+
+	s.faults["target-namespace"]["errors"] = map[string]any{"UnknownError": 1}
+	err = s.injector(config.ShardStoreName).inject(s.ctx, "UpdateShard")
+	s.IsType(&serviceerror.Unavailable{}, err)
+}
+
+func (s *dynamicFaultInjectionSuite) TestNamespace() {
+	s.faults["target-namespace"] = map[string]any{"errorRate": 1}
+	ctx := headers.SetCallerInfo(context.Background(), headers.NewBackgroundCallerInfo("other-namespace"))
+	s.NoError(s.injector(config.ExecutionStoreName).inject(ctx, "GetWorkflowExecution"))
+	s.Error(s.injector(config.ExecutionStoreName).inject(s.ctx, "GetWorkflowExecution"))
+}
+
+func (s *dynamicFaultInjectionSuite) TestAPIOverrides() {
+	s.faults["target-namespace"] = map[string]any{
+		"apis": map[string]any{
+			"TaskStore":                           map[string]any{"errorRate": 1},
+			"UpdateWorkflowExecution":             map[string]any{"errorRate": 1, "errors": map[string]any{"TimeoutError": 1}},
+			"ExecutionStore.GetWorkflowExecution": map[string]any{"errorRate": 1, "errors": map[string]any{"ResourceExhausted": 1}},
+			"TaskStore.GetTasks":                  map[string]any{"errorRate": 0},
+		},
+	}
+	s.Error(s.injector(config.TaskStoreName).inject(s.ctx, "CreateTasks"))
+	s.NoError(s.injector(config.TaskStoreName).inject(s.ctx, "GetTasks"))
+	s.NoError(s.injector(config.ExecutionStoreName).inject(s.ctx, "DeleteWorkflowExecution"))
+	s.IsType(&persistence.TimeoutError{}, s.injector(config.ExecutionStoreName).inject(s.ctx, "UpdateWorkflowExecution"))
+	s.IsType(&serviceerror.ResourceExhausted{}, s.injector(config.ExecutionStoreName).inject(s.ctx, "GetWorkflowExecution"))
+}
+
+func (s *dynamicFaultInjectionSuite) TestLatency() {
+	s.faults["target-namespace"] = map[string]any{"latency": "50ms"}
+	start := time.Now()
+	s.NoError(s.injector(config.ExecutionStoreName).inject(s.ctx, "GetWorkflowExecution"))
+	s.GreaterOrEqual(time.Since(start), 50*time.Millisecond)
+
+	s.faults["target-namespace"] = map[string]any{"latency": "1h"}
+	ctx, cancel := context.WithTimeout(s.ctx, 10*time.Millisecond)
+	defer cancel()
+	s.ErrorIs(s.injector(config.ExecutionStoreName).inject(ctx, "GetWorkflowExecution"), context.DeadlineExceeded)
+
+	s.faults["target-namespace"] = map[string]any{"latency": "1h", "latencyRate": 0}
+	s.NoError(s.injector(config.ExecutionStoreName).inject(s.ctx, "GetWorkflowExecution"))
+}
@@ -0,0 +1,87 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/encryption"
+)
+
+type (
+	// EncryptionDataStoreFactory wraps the execution and task stores created by the base factory
+	// to encrypt data blobs before they are written to the datastore.
+	EncryptionDataStoreFactory struct {
+		baseFactory DataStoreFactory
+		encryptor   *encryption.Encryptor
+	}
+)
+
+var _ DataStoreFactory = (*EncryptionDataStoreFactory)(nil)
+
+func NewEncryptionDataStoreFactory(
+	baseFactory DataStoreFactory,
+	keyProvider encryption.KeyProvider,
+) *EncryptionDataStoreFactory {
+	return &EncryptionDataStoreFactory{
+		baseFactory: baseFactory,
+		encryptor:   encryption.NewEncryptor(keyProvider),
+	}
+}
+
+func (d *EncryptionDataStoreFactory) Close() {
+	d.baseFactory.Close()
+}
+
+func (d *EncryptionDataStoreFactory) NewTaskStore() (persistence.TaskStore, error) {
+	store, err := d.baseFactory.NewTaskStore()
+	if err != nil {
+		return nil, err
+	}
+	return encryption.NewTaskStore(store, d.encryptor), nil
+}
+
+func (d *EncryptionDataStoreFactory) NewShardStore() (persistence.ShardStore, error) {
+	return d.baseFactory.NewShardStore()
+}
+
+func (d *EncryptionDataStoreFactory) NewMetadataStore() (persistence.MetadataStore, error) {
+	return d.baseFactory.NewMetadataStore()
+}
+
+func (d *EncryptionDataStoreFactory) NewExecutionStore() (persistence.ExecutionStore, error) {
+	store, err := d.baseFactory.NewExecutionStore()
+	if err != nil {
+		return nil, err
+	}
+	return encryption.NewExecutionStore(store, d.encryptor), nil
+}
+
+func (d *EncryptionDataStoreFactory) NewQueue(queueType persistence.QueueType) (persistence.Queue, error) {
+	return d.baseFactory.NewQueue(queueType)
+}
+
+func (d *EncryptionDataStoreFactory) NewClusterMetadataStore() (persistence.ClusterMetadataStore, error) {
+	return d.baseFactory.NewClusterMetadataStore()
+}
@@ -27,6 +27,7 @@ package client
 import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/claimcheck"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -56,6 +57,8 @@ type (
 		NewExecutionManager() (p.ExecutionManager, error)
 		// NewNamespaceReplicationQueue returns a new queue for namespace replication
 		NewNamespaceReplicationQueue() (p.NamespaceReplicationQueue, error)
+		// NewArchivalDLQ returns a new queue for archival tasks which exhausted their retries
+		NewArchivalDLQ() (p.ArchivalDLQ, error)
 		// NewClusterMetadataManager returns a new manager for cluster specific metadata
 		NewClusterMetadataManager() (p.ClusterMetadataManager, error)
 	}
@@ -69,6 +72,8 @@ type (
 		clusterName      string
 		ratelimiter      quotas.RequestRateLimiter
 		healthSignals    p.HealthSignalAggregator
+		payloadStore     claimcheck.PayloadStore
+		namespaceUsage   *p.NamespaceUsageTracker
 	}
 )
 
@@ -88,6 +93,8 @@ func NewFactory(
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 	healthSignals p.HealthSignalAggregator,
+	payloadStore claimcheck.PayloadStore,
+	namespaceUsage *p.NamespaceUsageTracker,
 ) Factory {
 	factory := &factoryImpl{
 		dataStoreFactory: dataStoreFactory,
@@ -98,6 +105,8 @@ func NewFactory(
 		clusterName:      clusterName,
 		ratelimiter:      ratelimiter,
 		healthSignals:    healthSignals,
+		payloadStore:     payloadStore,
+		namespaceUsage:   namespaceUsage,
 	}
 	factory.initDependencies()
 	return factory
@@ -115,7 +124,7 @@ func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 		result = p.NewTaskPersistenceRateLimitedClient(result, f.ratelimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
-		result = p.NewTaskPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger)
+		result = p.NewTaskPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.namespaceUsage, f.logger)
 	}
 	result = p.NewTaskPersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
 	return result, nil
@@ -133,7 +142,7 @@ func (f *factoryImpl) NewShardManager() (p.ShardManager, error) {
 		result = p.NewShardPersistenceRateLimitedClient(result, f.ratelimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
-		result = p.NewShardPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger)
+		result = p.NewShardPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.namespaceUsage, f.logger)
 	}
 	result = p.NewShardPersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
 	return result, nil
@@ -151,7 +160,7 @@ func (f *factoryImpl) NewMetadataManager() (p.MetadataManager, error) {
 		result = p.NewMetadataPersistenceRateLimitedClient(result, f.ratelimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
-		result = p.NewMetadataPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger)
+		result = p.NewMetadataPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.namespaceUsage, f.logger)
 	}
 	result = p.NewMetadataPersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
 	return result, nil
@@ -169,7 +178,7 @@ func (f *factoryImpl) NewClusterMetadataManager() (p.ClusterMetadataManager, err
 		result = p.NewClusterMetadataPersistenceRateLimitedClient(result, f.ratelimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
-		result = p.NewClusterMetadataPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger)
+		result = p.NewClusterMetadataPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.namespaceUsage, f.logger)
 	}
 	result = p.NewClusterMetadataPersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
 	return result, nil
@@ -182,12 +191,12 @@ func (f *factoryImpl) NewExecutionManager() (p.ExecutionManager, error) {
 		return nil, err
 	}
 
-	result := p.NewExecutionManager(store, f.serializer, f.logger, f.config.TransactionSizeLimit)
+	result := p.NewExecutionManager(store, f.serializer, f.payloadStore, f.logger, f.config.TransactionSizeLimit, f.config.HistoryNodeChunkSize)
 	if f.ratelimiter != nil {
 		result = p.NewExecutionPersistenceRateLimitedClient(result, f.ratelimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
-		result = p.NewExecutionPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger)
+		result = p.NewExecutionPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.namespaceUsage, f.logger)
 	}
 	result = p.NewExecutionPersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
 	return result, nil
@@ -203,12 +212,28 @@ func (f *factoryImpl) NewNamespaceReplicationQueue() (p.NamespaceReplicationQueu
 		result = p.NewQueuePersistenceRateLimitedClient(result, f.ratelimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
-		result = p.NewQueuePersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger)
+		result = p.NewQueuePersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.namespaceUsage, f.logger)
 	}
 	result = p.NewQueuePersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
 	return p.NewNamespaceReplicationQueue(result, f.serializer, f.clusterName, f.metricsHandler, f.logger)
 }
 
+func (f *factoryImpl) NewArchivalDLQ() (p.ArchivalDLQ, error) {
+	result, err := f.dataStoreFactory.NewQueue(p.ArchivalDLQQueueType)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.ratelimiter != nil {
+		result = p.NewQueuePersistenceRateLimitedClient(result, f.ratelimiter, f.logger)
+	}
+	if f.metricsHandler != nil && f.healthSignals != nil {
+		result = p.NewQueuePersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.namespaceUsage, f.logger)
+	}
+	result = p.NewQueuePersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
+	return p.NewArchivalDLQ(result, f.serializer)
+}
+
 // Close closes this factory
 func (f *factoryImpl) Close() {
 	f.dataStoreFactory.Close()
@@ -218,6 +243,11 @@ func (f *factoryImpl) Close() {
 }
 
 func IsPersistenceTransientError(err error) bool {
+	// requests shed by the circuit breaker are not retried, retrying would only delay failing the caller
+	if err == p.ErrPersistenceCircuitOpen || err == p.ErrPersistenceDegraded {
+		return false
+	}
+
 	switch err.(type) {
 	case *serviceerror.Unavailable:
 		return true
@@ -665,6 +665,16 @@ func (e *FaultInjectionExecutionStore) AppendHistoryNodes(
 	return e.baseExecutionStore.AppendHistoryNodes(ctx, request)
 }
 
+func (e *FaultInjectionExecutionStore) AppendHistoryNodesBatch(
+	ctx context.Context,
+	request *persistence.InternalAppendHistoryNodesBatchRequest,
+) error {
+	if err := e.ErrorGenerator.Generate(); err != nil {
+		return err
+	}
+	return e.baseExecutionStore.AppendHistoryNodesBatch(ctx, request)
+}
+
 func (e *FaultInjectionExecutionStore) DeleteHistoryNodes(
 	ctx context.Context,
 	request *persistence.InternalDeleteHistoryNodesRequest,
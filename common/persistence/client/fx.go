@@ -29,12 +29,16 @@ import (
 
 	"go.uber.org/fx"
 
+	"go.temporal.io/server/common/claimcheck"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/objectstore"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/cassandra"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/quotas"
@@ -65,6 +69,11 @@ type (
 		Logger                             log.Logger
 		HealthSignals                      persistence.HealthSignalAggregator
 		DynamicRateLimitingParams          DynamicRateLimitingParams
+		CircuitBreakers                    *persistence.CircuitBreakers       `optional:"true"`
+		AdaptiveRateLimiting               *AdaptiveRateLimitingConfig        `optional:"true"`
+		PayloadStore                       claimcheck.PayloadStore            `optional:"true"`
+		FaultInjection                     *DynamicFaultInjectionConfig       `optional:"true"`
+		NamespaceUsage                     *persistence.NamespaceUsageTracker `optional:"true"`
 	}
 
 	FactoryProviderFn func(NewFactoryParams) Factory
@@ -75,6 +84,12 @@ var Module = fx.Options(
 	fx.Provide(ClusterNameProvider),
 	fx.Provide(DataStoreFactoryProvider),
 	fx.Provide(HealthSignalAggregatorProvider),
+	fx.Provide(CircuitBreakersProvider),
+	fx.Provide(AdaptiveRateLimitingConfigProvider),
+	fx.Provide(PayloadStoreProvider),
+	fx.Provide(DynamicFaultInjectionConfigProvider),
+	fx.Provide(CassandraUnconditionalUpdateConfigProvider),
+	fx.Provide(NamespaceUsageTrackerProvider),
 )
 
 func ClusterNameProvider(config *cluster.Config) ClusterName {
@@ -94,6 +109,8 @@ func FactoryProvider(
 				RequestPriorityFn,
 				params.HealthSignals,
 				params.DynamicRateLimitingParams,
+				params.AdaptiveRateLimiting,
+				params.MetricsHandler,
 				params.Logger,
 			)
 		} else {
@@ -101,8 +118,27 @@ func FactoryProvider(
 		}
 	}
 
+	dataStoreFactory := params.DataStoreFactory
+	if params.FaultInjection != nil {
+		// injected faults go through the circuit breaker like real ones do
+		dataStoreFactory = NewDynamicFaultInjectionDataStoreFactory(
+			dataStoreFactory,
+			params.FaultInjection,
+			params.MetricsHandler,
+			params.Logger,
+		)
+	}
+	if params.CircuitBreakers != nil {
+		dataStoreFactory = NewCircuitBreakerDataStoreFactory(dataStoreFactory, params.CircuitBreakers)
+	}
+
+	payloadStore := params.PayloadStore
+	if payloadStore == nil {
+		payloadStore = claimcheck.NoopPayloadStore
+	}
+
 	return NewFactory(
-		params.DataStoreFactory,
+		dataStoreFactory,
 		params.Cfg,
 		requestRatelimiter,
 		serialization.NewSerializer(),
@@ -110,6 +146,8 @@ func FactoryProvider(
 		params.MetricsHandler,
 		params.Logger,
 		params.HealthSignals,
+		payloadStore,
+		params.NamespaceUsage,
 	)
 }
 
@@ -131,3 +169,89 @@ func HealthSignalAggregatorProvider(
 
 	return persistence.NoopHealthSignalAggregator
 }
+
+func CircuitBreakersProvider(
+	dynamicCollection *dynamicconfig.Collection,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *persistence.CircuitBreakers {
+	return persistence.NewCircuitBreakers(
+		&persistence.CircuitBreakerConfig{
+			Enabled:            dynamicCollection.GetBoolProperty(dynamicconfig.PersistenceCircuitBreakerEnabled, false),
+			DegradedErrorRatio: dynamicCollection.GetFloat64Property(dynamicconfig.PersistenceCircuitBreakerDegradedErrorRatio, 0.2),
+			OpenErrorRatio:     dynamicCollection.GetFloat64Property(dynamicconfig.PersistenceCircuitBreakerOpenErrorRatio, 0.5),
+			MinRequests:        dynamicCollection.GetIntProperty(dynamicconfig.PersistenceCircuitBreakerMinRequests, 50),
+			Window:             dynamicCollection.GetDurationProperty(dynamicconfig.PersistenceCircuitBreakerWindow, 10*time.Second),
+			OpenDuration:       dynamicCollection.GetDurationProperty(dynamicconfig.PersistenceCircuitBreakerOpenDuration, 5*time.Second),
+		},
+		clock.NewRealTimeSource(),
+		metricsHandler,
+		logger,
+	)
+}
+
+func AdaptiveRateLimitingConfigProvider(
+	dynamicCollection *dynamicconfig.Collection,
+) *AdaptiveRateLimitingConfig {
+	return &AdaptiveRateLimitingConfig{
+		Enabled:        dynamicCollection.GetBoolProperty(dynamicconfig.PersistenceAdaptiveRateLimitingEnabled, false),
+		TargetLatency:  dynamicCollection.GetDurationProperty(dynamicconfig.PersistenceAdaptiveRateLimitingTargetLatency, 100*time.Millisecond),
+		ErrorThreshold: dynamicCollection.GetFloat64Property(dynamicconfig.PersistenceAdaptiveRateLimitingErrorThreshold, 0.05),
+		MinQPS:         dynamicCollection.GetIntProperty(dynamicconfig.PersistenceAdaptiveRateLimitingMinQPS, 100),
+		MaxQPS:         dynamicCollection.GetIntProperty(dynamicconfig.PersistenceAdaptiveRateLimitingMaxQPS, 0),
+		AdjustInterval: dynamicCollection.GetDurationProperty(dynamicconfig.PersistenceAdaptiveRateLimitingAdjustInterval, 10*time.Second),
+	}
+}
+
+func DynamicFaultInjectionConfigProvider(
+	dynamicCollection *dynamicconfig.Collection,
+) *DynamicFaultInjectionConfig {
+	return &DynamicFaultInjectionConfig{
+		Enabled: dynamicCollection.GetBoolProperty(dynamicconfig.PersistenceFaultInjectionEnabled, false),
+		Faults:  dynamicCollection.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.PersistenceFaultInjection, map[string]any{}),
+	}
+}
+
+func CassandraUnconditionalUpdateConfigProvider(
+	dynamicCollection *dynamicconfig.Collection,
+) *cassandra.UnconditionalUpdateConfig {
+	return &cassandra.UnconditionalUpdateConfig{
+		Enabled:                 dynamicCollection.GetBoolProperty(dynamicconfig.CassandraUnconditionalExecutionUpdatesEnabled, false),
+		ShardLeaseCheckInterval: dynamicCollection.GetDurationProperty(dynamicconfig.CassandraShardLeaseCheckInterval, time.Second),
+	}
+}
+
+func NamespaceUsageTrackerProvider(
+	dynamicCollection *dynamicconfig.Collection,
+) *persistence.NamespaceUsageTracker {
+	return persistence.NewNamespaceUsageTracker(
+		&persistence.NamespaceUsageConfig{
+			MaxTaggedNamespaces: dynamicCollection.GetIntProperty(dynamicconfig.PersistenceMetricsMaxTaggedNamespaces, 200),
+		},
+		clock.NewRealTimeSource(),
+	)
+}
+
+func PayloadStoreProvider(
+	cfg *config.Persistence,
+	dynamicCollection *dynamicconfig.Collection,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) (claimcheck.PayloadStore, error) {
+	if cfg.PayloadOffload == nil || cfg.PayloadOffload.StoreURI == "" {
+		return claimcheck.NoopPayloadStore, nil
+	}
+	store, err := objectstore.NewStore(cfg.PayloadOffload.StoreURI)
+	if err != nil {
+		return nil, err
+	}
+	return claimcheck.NewPayloadStore(
+		store,
+		&claimcheck.Config{
+			Enabled:       dynamicCollection.GetBoolPropertyFnWithNamespaceIDFilter(dynamicconfig.PayloadOffloadEnabled, false),
+			SizeThreshold: dynamicCollection.GetIntProperty(dynamicconfig.PayloadOffloadSizeThreshold, 128*1024),
+		},
+		metricsHandler,
+		logger,
+	), nil
+}
@@ -25,8 +25,10 @@
 package client
 
 import (
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/service/history/tasks"
@@ -88,34 +90,44 @@ func NewPriorityRateLimiter(
 	requestPriorityFn quotas.RequestPriorityFn,
 	healthSignals p.HealthSignalAggregator,
 	dynamicParams DynamicRateLimitingParams,
+	adaptiveConfig *AdaptiveRateLimitingConfig,
+	metricsHandler metrics.Handler,
 	logger log.Logger,
 ) quotas.RequestRateLimiter {
 	hostRateFn := func() float64 { return float64(hostMaxQPS()) }
+	adaptiveController := NewAdaptiveRateController(
+		adaptiveConfig,
+		hostRateFn,
+		healthSignals,
+		clock.NewRealTimeSource(),
+		metricsHandler,
+		logger,
+	)
 
 	return quotas.NewMultiRequestRateLimiter(
 		// per shardID+namespaceID rate limiters
-		newPerShardPerNamespacePriorityRateLimiter(perShardNamespaceMaxQPS, hostMaxQPS, requestPriorityFn),
+		newPerShardPerNamespacePriorityRateLimiter(perShardNamespaceMaxQPS, adaptiveController, requestPriorityFn),
 		// per namespaceID rate limiters
-		newPriorityNamespaceRateLimiter(namespaceMaxQPS, hostMaxQPS, requestPriorityFn),
+		newPriorityNamespaceRateLimiter(namespaceMaxQPS, adaptiveController, requestPriorityFn),
 		// host-level dynamic rate limiter
 		newPriorityDynamicRateLimiter(hostRateFn, requestPriorityFn, healthSignals, dynamicParams, logger),
-		// basic host-level rate limiter
-		newPriorityRateLimiter(hostRateFn, requestPriorityFn),
+		// basic host-level rate limiter, adjusted by observed latency and errors when adaptive rate limiting is enabled
+		newPriorityAdaptiveRateLimiter(adaptiveController, requestPriorityFn),
 	)
 }
 
 func newPerShardPerNamespacePriorityRateLimiter(
 	perShardNamespaceMaxQPS PersistencePerShardNamespaceMaxQPS,
-	hostMaxQPS PersistenceMaxQps,
+	adaptiveController *AdaptiveRateController,
 	requestPriorityFn quotas.RequestPriorityFn,
 ) quotas.RequestRateLimiter {
 	return quotas.NewMapRequestRateLimiter(func(req quotas.Request) quotas.RequestRateLimiter {
 		if hasCaller(req) && hasCallerSegment(req) {
 			return newPriorityRateLimiter(func() float64 {
 				if perShardNamespaceMaxQPS == nil || perShardNamespaceMaxQPS(req.Caller) <= 0 {
-					return float64(hostMaxQPS())
+					return adaptiveController.Rate()
 				}
-				return float64(perShardNamespaceMaxQPS(req.Caller))
+				return float64(perShardNamespaceMaxQPS(req.Caller)) * adaptiveController.Multiplier()
 			},
 				requestPriorityFn,
 			)
@@ -135,7 +147,7 @@ func perShardPerNamespaceKeyFn(req quotas.Request) perShardPerNamespaceKey {
 
 func newPriorityNamespaceRateLimiter(
 	namespaceMaxQPS PersistenceNamespaceMaxQps,
-	hostMaxQPS PersistenceMaxQps,
+	adaptiveController *AdaptiveRateController,
 	requestPriorityFn quotas.RequestPriorityFn,
 ) quotas.RequestRateLimiter {
 	return quotas.NewNamespaceRequestRateLimiter(func(req quotas.Request) quotas.RequestRateLimiter {
@@ -143,15 +155,15 @@ func newPriorityNamespaceRateLimiter(
 			return newPriorityRateLimiter(
 				func() float64 {
 					if namespaceMaxQPS == nil {
-						return float64(hostMaxQPS())
+						return adaptiveController.Rate()
 					}
 
 					namespaceQPS := float64(namespaceMaxQPS(req.Caller))
 					if namespaceQPS <= 0 {
-						return float64(hostMaxQPS())
+						return adaptiveController.Rate()
 					}
 
-					return namespaceQPS
+					return namespaceQPS * adaptiveController.Multiplier()
 				},
 				requestPriorityFn,
 			)
@@ -31,6 +31,10 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/quotas"
 	"golang.org/x/exp/slices"
 
@@ -107,7 +111,7 @@ func (s *quotasSuite) TestPriorityNamespaceRateLimiter_DoesLimit() {
 	var namespaceMaxRPS = func(namespace string) int { return 1 }
 	var hostMaxRPS = func() int { return 1 }
 
-	var limiter = newPriorityNamespaceRateLimiter(namespaceMaxRPS, hostMaxRPS, RequestPriorityFn)
+	var limiter = newPriorityNamespaceRateLimiter(namespaceMaxRPS, newStaticRateController(hostMaxRPS), RequestPriorityFn)
 
 	var request = quotas.NewRequest(
 		"test-api",
@@ -134,7 +138,7 @@ func (s *quotasSuite) TestPerShardNamespaceRateLimiter_DoesLimit() {
 	var perShardNamespaceMaxRPS = func(namespace string) int { return 1 }
 	var hostMaxRPS = func() int { return 1 }
 
-	var limiter = newPerShardPerNamespacePriorityRateLimiter(perShardNamespaceMaxRPS, hostMaxRPS, RequestPriorityFn)
+	var limiter = newPerShardPerNamespacePriorityRateLimiter(perShardNamespaceMaxRPS, newStaticRateController(hostMaxRPS), RequestPriorityFn)
 
 	var request = quotas.NewRequest(
 		"test-api",
@@ -156,3 +160,14 @@ func (s *quotasSuite) TestPerShardNamespaceRateLimiter_DoesLimit() {
 
 	s.True(wasLimited)
 }
+
+func newStaticRateController(hostMaxRPS func() int) *AdaptiveRateController {
+	return NewAdaptiveRateController(
+		nil,
+		func() float64 { return float64(hostMaxRPS()) },
+		persistence.NoopHealthSignalAggregator,
+		clock.NewRealTimeSource(),
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	)
+}
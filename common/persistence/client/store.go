@@ -27,9 +27,12 @@ package client
 import (
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/cassandra"
+	"go.temporal.io/server/common/persistence/encryption"
+	"go.temporal.io/server/common/persistence/plugin"
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/resolver"
 )
@@ -71,6 +74,8 @@ func DataStoreFactoryProvider(
 	r resolver.ServiceResolver,
 	config *config.Persistence,
 	abstractDataStoreFactory AbstractDataStoreFactory,
+	keyProvider encryption.KeyProvider,
+	cassandraUnconditionalUpdates *cassandra.UnconditionalUpdateConfig,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 ) (DataStoreFactory, *FaultInjectionDataStoreFactory) {
@@ -79,15 +84,25 @@ func DataStoreFactoryProvider(
 	defaultCfg := config.DataStores[config.DefaultStore]
 	switch {
 	case defaultCfg.Cassandra != nil:
-		dataStoreFactory = cassandra.NewFactory(*defaultCfg.Cassandra, r, string(clusterName), logger)
+		dataStoreFactory = cassandra.NewFactory(*defaultCfg.Cassandra, r, string(clusterName), logger, cassandraUnconditionalUpdates)
 	case defaultCfg.SQL != nil:
 		dataStoreFactory = sql.NewFactory(*defaultCfg.SQL, r, string(clusterName), logger)
 	case defaultCfg.CustomDataStoreConfig != nil:
 		dataStoreFactory = abstractDataStoreFactory.NewFactory(*defaultCfg.CustomDataStoreConfig, r, string(clusterName), logger, metricsHandler)
+	case defaultCfg.Plugin != nil:
+		pluginFactory, err := plugin.NewFactory(*defaultCfg.Plugin, logger)
+		if err != nil {
+			logger.Fatal("unable to connect to persistence plugin", tag.Error(err))
+		}
+		dataStoreFactory = pluginFactory
 	default:
 		logger.Fatal("invalid config: one of cassandra or sql params must be specified for default data store")
 	}
 
+	if keyProvider != nil {
+		dataStoreFactory = NewEncryptionDataStoreFactory(dataStoreFactory, keyProvider)
+	}
+
 	var faultInjection *FaultInjectionDataStoreFactory
 	if defaultCfg.FaultInjection != nil {
 		faultInjection = NewFaultInjectionDatastoreFactory(defaultCfg.FaultInjection, dataStoreFactory)
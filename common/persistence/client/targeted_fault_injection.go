@@ -32,6 +32,9 @@ import (
 	"strings"
 	"time"
 
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/persistence"
 )
@@ -98,13 +101,30 @@ func (d *dataStoreErrorGenerator) Generate() error {
 // getErrorFromName returns an error based on the provided name. If the name is not recognized, then this method will
 // panic.
 func getErrorFromName(name string) error {
+	err, ok := errorFromName(name)
+	if !ok {
+		panic(fmt.Sprintf("unknown error type: %v", name))
+	}
+	return err
+}
+
+// errorFromName returns an error based on the provided name, and false if the name is not recognized.
+func errorFromName(name string) (error, bool) {
 	switch name {
 	case "ShardOwnershipLostError":
-		return &persistence.ShardOwnershipLostError{}
+		return &persistence.ShardOwnershipLostError{}, true
 	case "DeadlineExceededError":
-		return context.DeadlineExceeded
+		return context.DeadlineExceeded, true
+	case "TimeoutError":
+		return &persistence.TimeoutError{Msg: "fault injection"}, true
+	case "ConditionFailedError":
+		return &persistence.ConditionFailedError{Msg: "fault injection"}, true
+	case "Unavailable":
+		return serviceerror.NewUnavailable("fault injection"), true
+	case "ResourceExhausted":
+		return serviceerror.NewResourceExhausted(enumspb.RESOURCE_EXHAUSTED_CAUSE_SYSTEM_OVERLOADED, "fault injection"), true
 	default:
-		panic(fmt.Sprintf("unknown error type: %v", name))
+		return nil, false
 	}
 }
 
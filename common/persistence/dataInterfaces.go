@@ -55,6 +55,7 @@ type QueueType int32
 
 const (
 	NamespaceReplicationQueueType QueueType = iota + 1
+	ArchivalDLQQueueType
 )
 
 // Create Workflow Execution Mode
@@ -780,6 +781,20 @@ type (
 		Size int
 	}
 
+	// AppendHistoryNodesBatchRequest is used to append nodes to multiple history branches of a shard in one write
+	AppendHistoryNodesBatchRequest struct {
+		// The shard to get history node data
+		ShardID int32
+		// The appends to apply, each targeting its own branch
+		Requests []*AppendHistoryNodesRequest
+	}
+
+	// AppendHistoryNodesBatchResponse is a response to AppendHistoryNodesBatchRequest
+	AppendHistoryNodesBatchResponse struct {
+		// Responses are in the same order as the requests
+		Responses []*AppendHistoryNodesResponse
+	}
+
 	// AppendRawHistoryNodesRequest is used to append a batch of raw history nodes
 	AppendRawHistoryNodesRequest struct {
 		// The shard to get history node data
@@ -1115,6 +1130,8 @@ type (
 
 		// AppendHistoryNodes add a node to history node table
 		AppendHistoryNodes(ctx context.Context, request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error)
+		// AppendHistoryNodesBatch adds nodes to multiple branches of a shard, either all of them or none
+		AppendHistoryNodesBatch(ctx context.Context, request *AppendHistoryNodesBatchRequest) (*AppendHistoryNodesBatchResponse, error)
 		// AppendRawHistoryNodes add a node of raw histories to history node table
 		AppendRawHistoryNodes(ctx context.Context, request *AppendRawHistoryNodesRequest) (*AppendHistoryNodesResponse, error)
 		// ReadHistoryBranch returns history node data for a branch
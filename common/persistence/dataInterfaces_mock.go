@@ -214,6 +214,21 @@ func (mr *MockExecutionManagerMockRecorder) AppendHistoryNodes(ctx, request inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendHistoryNodes", reflect.TypeOf((*MockExecutionManager)(nil).AppendHistoryNodes), ctx, request)
 }
 
+// AppendHistoryNodesBatch mocks base method.
+func (m *MockExecutionManager) AppendHistoryNodesBatch(ctx context.Context, request *AppendHistoryNodesBatchRequest) (*AppendHistoryNodesBatchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendHistoryNodesBatch", ctx, request)
+	ret0, _ := ret[0].(*AppendHistoryNodesBatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendHistoryNodesBatch indicates an expected call of AppendHistoryNodesBatch.
+func (mr *MockExecutionManagerMockRecorder) AppendHistoryNodesBatch(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendHistoryNodesBatch", reflect.TypeOf((*MockExecutionManager)(nil).AppendHistoryNodesBatch), ctx, request)
+}
+
 // AppendRawHistoryNodes mocks base method.
 func (m *MockExecutionManager) AppendRawHistoryNodes(ctx context.Context, request *AppendRawHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,216 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+)
+
+const (
+	// blobFormatVersion is the version of the encrypted blob layout:
+	// magic | version | key ID length | key ID | nonce | ciphertext.
+	blobFormatVersion byte = 1
+	maxKeyIDLength         = 255
+	nonceSize              = 12
+)
+
+// blobMagic prefixes every encrypted blob. A leading zero byte is never valid in a
+// proto3 (field number 0) or JSON encoded blob, so plaintext blobs can't be mistaken
+// for encrypted ones.
+var blobMagic = []byte{0x00, 't', 'e', 'b'}
+
+type (
+	// Encryptor encrypts and decrypts data blobs with AES-GCM using keys from a KeyProvider.
+	// Encrypted blobs are tagged with the ID of the key used so that keys can be rotated
+	// without rewriting existing data, and blobs written before encryption was enabled are
+	// returned as is.
+	Encryptor struct {
+		keyProvider KeyProvider
+		ciphers     sync.Map // key ID -> cipher.AEAD
+	}
+
+	// KeyUsage counts the blobs decrypted with a context returned by WithKeyUsage
+	// which are not encrypted with the current key.
+	KeyUsage struct {
+		staleBlobs atomic.Int64
+	}
+
+	keyUsageContextKey struct{}
+)
+
+// NewEncryptor returns an Encryptor for the given KeyProvider, or nil if keyProvider is nil.
+func NewEncryptor(keyProvider KeyProvider) *Encryptor {
+	if keyProvider == nil {
+		return nil
+	}
+	return &Encryptor{
+		keyProvider: keyProvider,
+	}
+}
+
+// WithKeyUsage returns a context that records the usage of encryption keys by reads made with it.
+func WithKeyUsage(ctx context.Context) (context.Context, *KeyUsage) {
+	usage := &KeyUsage{}
+	return context.WithValue(ctx, keyUsageContextKey{}, usage), usage
+}
+
+// StaleBlobs returns the number of blobs read that are either not encrypted or encrypted with a retired key.
+func (u *KeyUsage) StaleBlobs() int64 {
+	return u.staleBlobs.Load()
+}
+
+// Encrypt encrypts the blob with the current key. Empty and already encrypted blobs are returned as is.
+// A nil Encryptor returns the blob unchanged.
+func (e *Encryptor) Encrypt(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if e == nil || blob == nil || len(blob.Data) == 0 || isEncrypted(blob.Data) {
+		return blob, nil
+	}
+
+	keyID := e.keyProvider.CurrentKeyID()
+	aead, err := e.getCipher(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 0, len(blobMagic)+2+len(keyID)+nonceSize)
+	header = append(header, blobMagic...)
+	header = append(header, blobFormatVersion, byte(len(keyID)))
+	header = append(header, keyID...)
+
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("unable to generate encryption nonce: %v", err))
+	}
+
+	data := make([]byte, 0, len(header)+nonceSize+len(blob.Data)+aead.Overhead())
+	data = append(data, header...)
+	data = append(data, nonce...)
+	data = aead.Seal(data, nonce, blob.Data, header)
+	return &commonpb.DataBlob{
+		EncodingType: blob.EncodingType,
+		Data:         data,
+	}, nil
+}
+
+// Decrypt decrypts the blob with the key it was encrypted with. Blobs which are not encrypted
+// are returned as is. If ctx carries a KeyUsage, blobs not encrypted with the current key are counted.
+// A nil Encryptor returns the blob unchanged.
+func (e *Encryptor) Decrypt(ctx context.Context, blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if e == nil || blob == nil || len(blob.Data) == 0 {
+		return blob, nil
+	}
+
+	keyID, headerLen, encrypted, err := parseHeader(blob.Data)
+	if err != nil {
+		return nil, err
+	}
+	if keyID != e.keyProvider.CurrentKeyID() {
+		if usage, ok := ctx.Value(keyUsageContextKey{}).(*KeyUsage); ok {
+			usage.staleBlobs.Add(1)
+		}
+	}
+	if !encrypted {
+		return blob, nil
+	}
+
+	aead, err := e.getCipher(keyID)
+	if err != nil {
+		return nil, err
+	}
+	if len(blob.Data) < headerLen+nonceSize {
+		return nil, serviceerror.NewDataLoss("encrypted blob is truncated")
+	}
+	nonce := blob.Data[headerLen : headerLen+nonceSize]
+	data, err := aead.Open(nil, nonce, blob.Data[headerLen+nonceSize:], blob.Data[:headerLen])
+	if err != nil {
+		return nil, serviceerror.NewDataLoss(fmt.Sprintf("unable to decrypt blob with key %q: %v", keyID, err))
+	}
+	return &commonpb.DataBlob{
+		EncodingType: blob.EncodingType,
+		Data:         data,
+	}, nil
+}
+
+// KeyID returns the ID of the key the blob is encrypted with, or false if the blob is not encrypted.
+func KeyID(blob *commonpb.DataBlob) (string, bool) {
+	keyID, _, encrypted, err := parseHeader(blob.GetData())
+	if err != nil || !encrypted {
+		return "", false
+	}
+	return keyID, true
+}
+
+func (e *Encryptor) getCipher(keyID string) (cipher.AEAD, error) {
+	if aead, ok := e.ciphers.Load(keyID); ok {
+		return aead.(cipher.AEAD), nil
+	}
+
+	key, err := e.keyProvider.GetKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("invalid persistence encryption key %q: %v", keyID, err))
+	}
+	aead, err := cipher.NewGCMWithNonceSize(block, nonceSize)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("invalid persistence encryption key %q: %v", keyID, err))
+	}
+	e.ciphers.Store(keyID, aead)
+	return aead, nil
+}
+
+func isEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, blobMagic)
+}
+
+func parseHeader(data []byte) (keyID string, headerLen int, encrypted bool, err error) {
+	if !isEncrypted(data) {
+		return "", 0, false, nil
+	}
+	if len(data) < len(blobMagic)+2 {
+		return "", 0, false, serviceerror.NewDataLoss("encrypted blob header is truncated")
+	}
+	if version := data[len(blobMagic)]; version != blobFormatVersion {
+		return "", 0, false, serviceerror.NewDataLoss(fmt.Sprintf("unknown encrypted blob version %d", version))
+	}
+	keyIDLen := int(data[len(blobMagic)+1])
+	headerLen = len(blobMagic) + 2 + keyIDLen
+	if len(data) < headerLen {
+		return "", 0, false, serviceerror.NewDataLoss("encrypted blob header is truncated")
+	}
+	return string(data[len(blobMagic)+2 : headerLen]), headerLen, true, nil
+}
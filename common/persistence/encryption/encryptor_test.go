@@ -0,0 +1,172 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/config"
+)
+
+func newTestKeyProvider(t *testing.T, currentKeyID string, keyIDs ...string) KeyProvider {
+	keys := make(map[string]string, len(keyIDs))
+	for i, keyID := range keyIDs {
+		key := make([]byte, 32)
+		key[0] = byte(i + 1)
+		keys[keyID] = base64.StdEncoding.EncodeToString(key)
+	}
+	provider, err := NewStaticKeyProvider(&config.PersistenceEncryption{
+		CurrentKeyID: currentKeyID,
+		Keys:         keys,
+	})
+	require.NoError(t, err)
+	return provider
+}
+
+func newTestBlob() *commonpb.DataBlob {
+	return &commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+		Data:         []byte("some serialized proto"),
+	}
+}
+
+func TestNewStaticKeyProvider(t *testing.T) {
+	provider, err := NewStaticKeyProvider(nil)
+	require.NoError(t, err)
+	require.Nil(t, provider)
+
+	_, err = NewStaticKeyProvider(&config.PersistenceEncryption{
+		CurrentKeyID: "missing",
+		Keys:         map[string]string{"key": base64.StdEncoding.EncodeToString(make([]byte, 16))},
+	})
+	require.Error(t, err)
+
+	_, err = NewStaticKeyProvider(&config.PersistenceEncryption{
+		CurrentKeyID: "key",
+		Keys:         map[string]string{"key": base64.StdEncoding.EncodeToString(make([]byte, 10))},
+	})
+	require.Error(t, err)
+
+	provider = newTestKeyProvider(t, "key-2", "key-1", "key-2")
+	require.Equal(t, "key-2", provider.CurrentKeyID())
+	_, err = provider.GetKey("key-3")
+	require.Error(t, err)
+}
+
+func TestEncryptor_RoundTrip(t *testing.T) {
+	encryptor := NewEncryptor(newTestKeyProvider(t, "key-1", "key-1"))
+	blob := newTestBlob()
+
+	encrypted, err := encryptor.Encrypt(blob)
+	require.NoError(t, err)
+	require.Equal(t, newTestBlob(), blob, "input blob must not be modified")
+	require.Equal(t, blob.EncodingType, encrypted.EncodingType)
+	require.NotContains(t, string(encrypted.Data), string(blob.Data))
+	keyID, ok := KeyID(encrypted)
+	require.True(t, ok)
+	require.Equal(t, "key-1", keyID)
+
+	reencrypted, err := encryptor.Encrypt(encrypted)
+	require.NoError(t, err)
+	require.Equal(t, encrypted, reencrypted, "encrypted blobs must not be encrypted twice")
+
+	ctx, usage := WithKeyUsage(context.Background())
+	decrypted, err := encryptor.Decrypt(ctx, encrypted)
+	require.NoError(t, err)
+	require.Equal(t, blob, decrypted)
+	require.Zero(t, usage.StaleBlobs())
+}
+
+func TestEncryptor_PlaintextBlob(t *testing.T) {
+	encryptor := NewEncryptor(newTestKeyProvider(t, "key-1", "key-1"))
+	blob := newTestBlob()
+
+	_, ok := KeyID(blob)
+	require.False(t, ok)
+
+	ctx, usage := WithKeyUsage(context.Background())
+	decrypted, err := encryptor.Decrypt(ctx, blob)
+	require.NoError(t, err)
+	require.Equal(t, blob, decrypted)
+	require.Equal(t, int64(1), usage.StaleBlobs())
+
+	empty := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3}
+	encrypted, err := encryptor.Encrypt(empty)
+	require.NoError(t, err)
+	require.Equal(t, empty, encrypted)
+}
+
+func TestEncryptor_KeyRotation(t *testing.T) {
+	encrypted, err := NewEncryptor(newTestKeyProvider(t, "key-1", "key-1")).Encrypt(newTestBlob())
+	require.NoError(t, err)
+
+	encryptor := NewEncryptor(newTestKeyProvider(t, "key-2", "key-1", "key-2"))
+	ctx, usage := WithKeyUsage(context.Background())
+	decrypted, err := encryptor.Decrypt(ctx, encrypted)
+	require.NoError(t, err)
+	require.Equal(t, newTestBlob(), decrypted)
+	require.Equal(t, int64(1), usage.StaleBlobs())
+
+	reencrypted, err := encryptor.Encrypt(decrypted)
+	require.NoError(t, err)
+	keyID, _ := KeyID(reencrypted)
+	require.Equal(t, "key-2", keyID)
+
+	_, err = NewEncryptor(newTestKeyProvider(t, "key-2", "key-2")).Decrypt(context.Background(), encrypted)
+	require.Error(t, err)
+}
+
+func TestEncryptor_TamperedBlob(t *testing.T) {
+	encryptor := NewEncryptor(newTestKeyProvider(t, "key-1", "key-1"))
+	encrypted, err := encryptor.Encrypt(newTestBlob())
+	require.NoError(t, err)
+
+	encrypted.Data[len(encrypted.Data)-1] ^= 0xff
+	_, err = encryptor.Decrypt(context.Background(), encrypted)
+	require.IsType(t, &serviceerror.DataLoss{}, err)
+
+	_, err = encryptor.Decrypt(context.Background(), &commonpb.DataBlob{Data: blobMagic})
+	require.IsType(t, &serviceerror.DataLoss{}, err)
+}
+
+func TestEncryptor_Nil(t *testing.T) {
+	var encryptor *Encryptor
+	require.Nil(t, NewEncryptor(nil))
+
+	blob := newTestBlob()
+	encrypted, err := encryptor.Encrypt(blob)
+	require.NoError(t, err)
+	require.Equal(t, blob, encrypted)
+	decrypted, err := encryptor.Decrypt(context.Background(), blob)
+	require.NoError(t, err)
+	require.Equal(t, blob, decrypted)
+}
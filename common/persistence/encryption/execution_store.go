@@ -0,0 +1,357 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	executionStore struct {
+		persistence.ExecutionStore
+		encryptor *Encryptor
+	}
+)
+
+var _ persistence.ExecutionStore = (*executionStore)(nil)
+
+// NewExecutionStore wraps an ExecutionStore to encrypt history events and mutable state records
+// before they are written and decrypt them after they are read. Execution state, tree info and
+// checksums are left in plaintext since the datastores need to read them.
+func NewExecutionStore(
+	store persistence.ExecutionStore,
+	encryptor *Encryptor,
+) persistence.ExecutionStore {
+	return &executionStore{
+		ExecutionStore: store,
+		encryptor:      encryptor,
+	}
+}
+
+func (s *executionStore) CreateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalCreateWorkflowExecutionRequest,
+) (*persistence.InternalCreateWorkflowExecutionResponse, error) {
+	encrypted := *request
+	snapshot, err := s.encryptSnapshot(&request.NewWorkflowSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	encrypted.NewWorkflowSnapshot = *snapshot
+	if encrypted.NewWorkflowNewEvents, err = s.encryptAppends(request.NewWorkflowNewEvents); err != nil {
+		return nil, err
+	}
+	return s.ExecutionStore.CreateWorkflowExecution(ctx, &encrypted)
+}
+
+func (s *executionStore) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalUpdateWorkflowExecutionRequest,
+) error {
+	encrypted := *request
+	mutation, err := s.encryptMutation(&request.UpdateWorkflowMutation)
+	if err != nil {
+		return err
+	}
+	encrypted.UpdateWorkflowMutation = *mutation
+	if encrypted.UpdateWorkflowNewEvents, err = s.encryptAppends(request.UpdateWorkflowNewEvents); err != nil {
+		return err
+	}
+	if encrypted.NewWorkflowSnapshot, err = s.encryptSnapshot(request.NewWorkflowSnapshot); err != nil {
+		return err
+	}
+	if encrypted.NewWorkflowNewEvents, err = s.encryptAppends(request.NewWorkflowNewEvents); err != nil {
+		return err
+	}
+	return s.ExecutionStore.UpdateWorkflowExecution(ctx, &encrypted)
+}
+
+func (s *executionStore) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalConflictResolveWorkflowExecutionRequest,
+) error {
+	encrypted := *request
+	snapshot, err := s.encryptSnapshot(&request.ResetWorkflowSnapshot)
+	if err != nil {
+		return err
+	}
+	encrypted.ResetWorkflowSnapshot = *snapshot
+	if encrypted.ResetWorkflowEventsNewEvents, err = s.encryptAppends(request.ResetWorkflowEventsNewEvents); err != nil {
+		return err
+	}
+	if encrypted.NewWorkflowSnapshot, err = s.encryptSnapshot(request.NewWorkflowSnapshot); err != nil {
+		return err
+	}
+	if encrypted.NewWorkflowEventsNewEvents, err = s.encryptAppends(request.NewWorkflowEventsNewEvents); err != nil {
+		return err
+	}
+	if encrypted.CurrentWorkflowMutation, err = s.encryptMutation(request.CurrentWorkflowMutation); err != nil {
+		return err
+	}
+	if encrypted.CurrentWorkflowEventsNewEvents, err = s.encryptAppends(request.CurrentWorkflowEventsNewEvents); err != nil {
+		return err
+	}
+	return s.ExecutionStore.ConflictResolveWorkflowExecution(ctx, &encrypted)
+}
+
+func (s *executionStore) SetWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalSetWorkflowExecutionRequest,
+) error {
+	encrypted := *request
+	snapshot, err := s.encryptSnapshot(&request.SetWorkflowSnapshot)
+	if err != nil {
+		return err
+	}
+	encrypted.SetWorkflowSnapshot = *snapshot
+	return s.ExecutionStore.SetWorkflowExecution(ctx, &encrypted)
+}
+
+func (s *executionStore) GetWorkflowExecution(
+	ctx context.Context,
+	request *persistence.GetWorkflowExecutionRequest,
+) (*persistence.InternalGetWorkflowExecutionResponse, error) {
+	response, err := s.ExecutionStore.GetWorkflowExecution(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.decryptMutableState(ctx, response.State); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (s *executionStore) ListConcreteExecutions(
+	ctx context.Context,
+	request *persistence.ListConcreteExecutionsRequest,
+) (*persistence.InternalListConcreteExecutionsResponse, error) {
+	response, err := s.ExecutionStore.ListConcreteExecutions(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	for _, state := range response.States {
+		if err := s.decryptMutableState(ctx, state); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+func (s *executionStore) AppendHistoryNodes(
+	ctx context.Context,
+	request *persistence.InternalAppendHistoryNodesRequest,
+) error {
+	encrypted, err := s.encryptAppend(request)
+	if err != nil {
+		return err
+	}
+	return s.ExecutionStore.AppendHistoryNodes(ctx, encrypted)
+}
+
+func (s *executionStore) AppendHistoryNodesBatch(
+	ctx context.Context,
+	request *persistence.InternalAppendHistoryNodesBatchRequest,
+) error {
+	encrypted := *request
+	var err error
+	if encrypted.Requests, err = s.encryptAppends(request.Requests); err != nil {
+		return err
+	}
+	return s.ExecutionStore.AppendHistoryNodesBatch(ctx, &encrypted)
+}
+
+func (s *executionStore) ReadHistoryBranch(
+	ctx context.Context,
+	request *persistence.InternalReadHistoryBranchRequest,
+) (*persistence.InternalReadHistoryBranchResponse, error) {
+	response, err := s.ExecutionStore.ReadHistoryBranch(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	for i := range response.Nodes {
+		if response.Nodes[i].Events, err = s.encryptor.Decrypt(ctx, response.Nodes[i].Events); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+func (s *executionStore) encryptAppend(
+	request *persistence.InternalAppendHistoryNodesRequest,
+) (*persistence.InternalAppendHistoryNodesRequest, error) {
+	encrypted := *request
+	var err error
+	if encrypted.Node.Events, err = s.encryptor.Encrypt(request.Node.Events); err != nil {
+		return nil, err
+	}
+	return &encrypted, nil
+}
+
+func (s *executionStore) encryptAppends(
+	requests []*persistence.InternalAppendHistoryNodesRequest,
+) ([]*persistence.InternalAppendHistoryNodesRequest, error) {
+	if requests == nil {
+		return nil, nil
+	}
+	encrypted := make([]*persistence.InternalAppendHistoryNodesRequest, len(requests))
+	for i, request := range requests {
+		var err error
+		if encrypted[i], err = s.encryptAppend(request); err != nil {
+			return nil, err
+		}
+	}
+	return encrypted, nil
+}
+
+func (s *executionStore) encryptSnapshot(
+	snapshot *persistence.InternalWorkflowSnapshot,
+) (*persistence.InternalWorkflowSnapshot, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+	encrypted := *snapshot
+	var err error
+	if encrypted.ExecutionInfoBlob, err = s.encryptor.Encrypt(snapshot.ExecutionInfoBlob); err != nil {
+		return nil, err
+	}
+	if encrypted.ActivityInfos, err = encryptBlobs(s.encryptor, snapshot.ActivityInfos); err != nil {
+		return nil, err
+	}
+	if encrypted.TimerInfos, err = encryptBlobs(s.encryptor, snapshot.TimerInfos); err != nil {
+		return nil, err
+	}
+	if encrypted.ChildExecutionInfos, err = encryptBlobs(s.encryptor, snapshot.ChildExecutionInfos); err != nil {
+		return nil, err
+	}
+	if encrypted.RequestCancelInfos, err = encryptBlobs(s.encryptor, snapshot.RequestCancelInfos); err != nil {
+		return nil, err
+	}
+	if encrypted.SignalInfos, err = encryptBlobs(s.encryptor, snapshot.SignalInfos); err != nil {
+		return nil, err
+	}
+	return &encrypted, nil
+}
+
+func (s *executionStore) encryptMutation(
+	mutation *persistence.InternalWorkflowMutation,
+) (*persistence.InternalWorkflowMutation, error) {
+	if mutation == nil {
+		return nil, nil
+	}
+	encrypted := *mutation
+	var err error
+	if encrypted.ExecutionInfoBlob, err = s.encryptor.Encrypt(mutation.ExecutionInfoBlob); err != nil {
+		return nil, err
+	}
+	if encrypted.UpsertActivityInfos, err = encryptBlobs(s.encryptor, mutation.UpsertActivityInfos); err != nil {
+		return nil, err
+	}
+	if encrypted.UpsertTimerInfos, err = encryptBlobs(s.encryptor, mutation.UpsertTimerInfos); err != nil {
+		return nil, err
+	}
+	if encrypted.UpsertChildExecutionInfos, err = encryptBlobs(s.encryptor, mutation.UpsertChildExecutionInfos); err != nil {
+		return nil, err
+	}
+	if encrypted.UpsertRequestCancelInfos, err = encryptBlobs(s.encryptor, mutation.UpsertRequestCancelInfos); err != nil {
+		return nil, err
+	}
+	if encrypted.UpsertSignalInfos, err = encryptBlobs(s.encryptor, mutation.UpsertSignalInfos); err != nil {
+		return nil, err
+	}
+	if encrypted.NewBufferedEvents, err = s.encryptor.Encrypt(mutation.NewBufferedEvents); err != nil {
+		return nil, err
+	}
+	return &encrypted, nil
+}
+
+func (s *executionStore) decryptMutableState(
+	ctx context.Context,
+	state *persistence.InternalWorkflowMutableState,
+) error {
+	if state == nil {
+		return nil
+	}
+	var err error
+	if state.ExecutionInfo, err = s.encryptor.Decrypt(ctx, state.ExecutionInfo); err != nil {
+		return err
+	}
+	if state.ActivityInfos, err = decryptBlobs(ctx, s.encryptor, state.ActivityInfos); err != nil {
+		return err
+	}
+	if state.TimerInfos, err = decryptBlobs(ctx, s.encryptor, state.TimerInfos); err != nil {
+		return err
+	}
+	if state.ChildExecutionInfos, err = decryptBlobs(ctx, s.encryptor, state.ChildExecutionInfos); err != nil {
+		return err
+	}
+	if state.RequestCancelInfos, err = decryptBlobs(ctx, s.encryptor, state.RequestCancelInfos); err != nil {
+		return err
+	}
+	if state.SignalInfos, err = decryptBlobs(ctx, s.encryptor, state.SignalInfos); err != nil {
+		return err
+	}
+	for i, event := range state.BufferedEvents {
+		if state.BufferedEvents[i], err = s.encryptor.Decrypt(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func encryptBlobs[K comparable](
+	encryptor *Encryptor,
+	blobs map[K]*commonpb.DataBlob,
+) (map[K]*commonpb.DataBlob, error) {
+	if blobs == nil {
+		return nil, nil
+	}
+	encrypted := make(map[K]*commonpb.DataBlob, len(blobs))
+	for key, blob := range blobs {
+		var err error
+		if encrypted[key], err = encryptor.Encrypt(blob); err != nil {
+			return nil, err
+		}
+	}
+	return encrypted, nil
+}
+
+func decryptBlobs[K comparable](
+	ctx context.Context,
+	encryptor *Encryptor,
+	blobs map[K]*commonpb.DataBlob,
+) (map[K]*commonpb.DataBlob, error) {
+	for key, blob := range blobs {
+		decrypted, err := encryptor.Decrypt(ctx, blob)
+		if err != nil {
+			return nil, err
+		}
+		blobs[key] = decrypted
+	}
+	return blobs, nil
+}
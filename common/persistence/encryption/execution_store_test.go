@@ -0,0 +1,150 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	testExecutionStore struct {
+		persistence.ExecutionStore
+
+		appendRequest *persistence.InternalAppendHistoryNodesRequest
+		updateRequest *persistence.InternalUpdateWorkflowExecutionRequest
+		mutableState  *persistence.InternalWorkflowMutableState
+		nodes         []persistence.InternalHistoryNode
+	}
+)
+
+func (s *testExecutionStore) AppendHistoryNodes(
+	_ context.Context,
+	request *persistence.InternalAppendHistoryNodesRequest,
+) error {
+	s.appendRequest = request
+	return nil
+}
+
+func (s *testExecutionStore) ReadHistoryBranch(
+	_ context.Context,
+	_ *persistence.InternalReadHistoryBranchRequest,
+) (*persistence.InternalReadHistoryBranchResponse, error) {
+	return &persistence.InternalReadHistoryBranchResponse{Nodes: s.nodes}, nil
+}
+
+func (s *testExecutionStore) UpdateWorkflowExecution(
+	_ context.Context,
+	request *persistence.InternalUpdateWorkflowExecutionRequest,
+) error {
+	s.updateRequest = request
+	return nil
+}
+
+func (s *testExecutionStore) GetWorkflowExecution(
+	_ context.Context,
+	_ *persistence.GetWorkflowExecutionRequest,
+) (*persistence.InternalGetWorkflowExecutionResponse, error) {
+	return &persistence.InternalGetWorkflowExecutionResponse{State: s.mutableState}, nil
+}
+
+func TestExecutionStore_HistoryNodes(t *testing.T) {
+	encryptor := NewEncryptor(newTestKeyProvider(t, "key-1", "key-1"))
+	base := &testExecutionStore{}
+	store := NewExecutionStore(base, encryptor)
+
+	request := &persistence.InternalAppendHistoryNodesRequest{
+		TreeInfo: newTestBlob(),
+		Node: persistence.InternalHistoryNode{
+			NodeID: 1,
+			Events: newTestBlob(),
+		},
+	}
+	require.NoError(t, store.AppendHistoryNodes(context.Background(), request))
+	require.Equal(t, newTestBlob(), request.Node.Events, "request must not be modified")
+	require.Equal(t, newTestBlob(), base.appendRequest.TreeInfo)
+	keyID, ok := KeyID(base.appendRequest.Node.Events)
+	require.True(t, ok)
+	require.Equal(t, "key-1", keyID)
+
+	base.nodes = []persistence.InternalHistoryNode{base.appendRequest.Node}
+	resp, err := store.ReadHistoryBranch(context.Background(), &persistence.InternalReadHistoryBranchRequest{})
+	require.NoError(t, err)
+	require.Equal(t, newTestBlob(), resp.Nodes[0].Events)
+}
+
+func TestExecutionStore_MutableState(t *testing.T) {
+	encryptor := NewEncryptor(newTestKeyProvider(t, "key-1", "key-1"))
+	base := &testExecutionStore{}
+	store := NewExecutionStore(base, encryptor)
+
+	request := &persistence.InternalUpdateWorkflowExecutionRequest{
+		UpdateWorkflowMutation: persistence.InternalWorkflowMutation{
+			ExecutionInfoBlob:   newTestBlob(),
+			ExecutionStateBlob:  newTestBlob(),
+			UpsertActivityInfos: map[int64]*commonpb.DataBlob{5: newTestBlob()},
+			UpsertTimerInfos:    map[string]*commonpb.DataBlob{"timer": newTestBlob()},
+			NewBufferedEvents:   newTestBlob(),
+			Checksum:            newTestBlob(),
+		},
+	}
+	require.NoError(t, store.UpdateWorkflowExecution(context.Background(), request))
+	require.Equal(t, newTestBlob(), request.UpdateWorkflowMutation.UpsertActivityInfos[5], "request must not be modified")
+
+	mutation := base.updateRequest.UpdateWorkflowMutation
+	for _, blob := range []*commonpb.DataBlob{
+		mutation.ExecutionInfoBlob,
+		mutation.UpsertActivityInfos[5],
+		mutation.UpsertTimerInfos["timer"],
+		mutation.NewBufferedEvents,
+	} {
+		_, ok := KeyID(blob)
+		require.True(t, ok)
+	}
+	require.Equal(t, newTestBlob(), mutation.ExecutionStateBlob)
+	require.Equal(t, newTestBlob(), mutation.Checksum)
+	require.Nil(t, base.updateRequest.NewWorkflowSnapshot)
+
+	base.mutableState = &persistence.InternalWorkflowMutableState{
+		ExecutionInfo:  mutation.ExecutionInfoBlob,
+		ExecutionState: mutation.ExecutionStateBlob,
+		ActivityInfos:  mutation.UpsertActivityInfos,
+		TimerInfos:     mutation.UpsertTimerInfos,
+		BufferedEvents: []*commonpb.DataBlob{mutation.NewBufferedEvents, newTestBlob()},
+	}
+	ctx, usage := WithKeyUsage(context.Background())
+	resp, err := store.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{})
+	require.NoError(t, err)
+	require.Equal(t, newTestBlob(), resp.State.ExecutionInfo)
+	require.Equal(t, newTestBlob(), resp.State.ActivityInfos[5])
+	require.Equal(t, newTestBlob(), resp.State.TimerInfos["timer"])
+	require.Equal(t, []*commonpb.DataBlob{newTestBlob(), newTestBlob()}, resp.State.BufferedEvents)
+	require.Equal(t, int64(1), usage.StaleBlobs(), "only the plaintext buffered event is stale")
+}
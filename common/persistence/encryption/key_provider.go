@@ -0,0 +1,98 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/config"
+)
+
+type (
+	// KeyProvider supplies the keys used to encrypt persisted data blobs.
+	// Implementations must keep returning retired keys from GetKey for as long as
+	// data encrypted with them may still be read.
+	KeyProvider interface {
+		// CurrentKeyID returns the ID of the key new blobs are encrypted with.
+		CurrentKeyID() string
+		// GetKey returns the raw AES key (16, 24 or 32 bytes) for the given key ID.
+		GetKey(keyID string) ([]byte, error)
+	}
+
+	staticKeyProvider struct {
+		currentKeyID string
+		keys         map[string][]byte
+	}
+)
+
+var _ KeyProvider = (*staticKeyProvider)(nil)
+
+// NewStaticKeyProvider returns a KeyProvider serving the keys from the static persistence config.
+// It returns nil if encryption is not configured.
+func NewStaticKeyProvider(cfg *config.PersistenceEncryption) (KeyProvider, error) {
+	if cfg == nil || cfg.CurrentKeyID == "" {
+		return nil, nil
+	}
+
+	keys := make(map[string][]byte, len(cfg.Keys))
+	for keyID, encodedKey := range cfg.Keys {
+		if len(keyID) > maxKeyIDLength {
+			return nil, fmt.Errorf("persistence encryption key ID %q is longer than %d bytes", keyID, maxKeyIDLength)
+		}
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode persistence encryption key %q: %w", keyID, err)
+		}
+		switch len(key) {
+		case 16, 24, 32:
+		default:
+			return nil, fmt.Errorf("persistence encryption key %q must be 16, 24 or 32 bytes, got %d", keyID, len(key))
+		}
+		keys[keyID] = key
+	}
+	if _, ok := keys[cfg.CurrentKeyID]; !ok {
+		return nil, fmt.Errorf("persistence encryption current key %q is not defined", cfg.CurrentKeyID)
+	}
+
+	return &staticKeyProvider{
+		currentKeyID: cfg.CurrentKeyID,
+		keys:         keys,
+	}, nil
+}
+
+func (p *staticKeyProvider) CurrentKeyID() string {
+	return p.currentKeyID
+}
+
+func (p *staticKeyProvider) GetKey(keyID string) ([]byte, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("unknown persistence encryption key %q", keyID))
+	}
+	return key, nil
+}
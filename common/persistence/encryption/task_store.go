@@ -0,0 +1,127 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"context"
+
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	taskStore struct {
+		persistence.TaskStore
+		encryptor *Encryptor
+	}
+)
+
+var _ persistence.TaskStore = (*taskStore)(nil)
+
+// NewTaskStore wraps a TaskStore to encrypt tasks and task queue user data before they are
+// written and decrypt them after they are read.
+func NewTaskStore(
+	store persistence.TaskStore,
+	encryptor *Encryptor,
+) persistence.TaskStore {
+	return &taskStore{
+		TaskStore: store,
+		encryptor: encryptor,
+	}
+}
+
+func (s *taskStore) CreateTasks(
+	ctx context.Context,
+	request *persistence.InternalCreateTasksRequest,
+) (*persistence.CreateTasksResponse, error) {
+	encrypted := *request
+	encrypted.Tasks = make([]*persistence.InternalCreateTask, len(request.Tasks))
+	for i, task := range request.Tasks {
+		encryptedTask := *task
+		var err error
+		if encryptedTask.Task, err = s.encryptor.Encrypt(task.Task); err != nil {
+			return nil, err
+		}
+		encrypted.Tasks[i] = &encryptedTask
+	}
+	return s.TaskStore.CreateTasks(ctx, &encrypted)
+}
+
+func (s *taskStore) GetTasks(
+	ctx context.Context,
+	request *persistence.GetTasksRequest,
+) (*persistence.InternalGetTasksResponse, error) {
+	response, err := s.TaskStore.GetTasks(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	for i, task := range response.Tasks {
+		if response.Tasks[i], err = s.encryptor.Decrypt(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+func (s *taskStore) GetTaskQueueUserData(
+	ctx context.Context,
+	request *persistence.GetTaskQueueUserDataRequest,
+) (*persistence.InternalGetTaskQueueUserDataResponse, error) {
+	response, err := s.TaskStore.GetTaskQueueUserData(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if response.UserData, err = s.encryptor.Decrypt(ctx, response.UserData); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (s *taskStore) UpdateTaskQueueUserData(
+	ctx context.Context,
+	request *persistence.InternalUpdateTaskQueueUserDataRequest,
+) error {
+	encrypted := *request
+	var err error
+	if encrypted.UserData, err = s.encryptor.Encrypt(request.UserData); err != nil {
+		return err
+	}
+	return s.TaskStore.UpdateTaskQueueUserData(ctx, &encrypted)
+}
+
+func (s *taskStore) ListTaskQueueUserDataEntries(
+	ctx context.Context,
+	request *persistence.ListTaskQueueUserDataEntriesRequest,
+) (*persistence.InternalListTaskQueueUserDataEntriesResponse, error) {
+	response, err := s.TaskStore.ListTaskQueueUserDataEntries(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	for i := range response.Entries {
+		if response.Entries[i].Data, err = s.encryptor.Decrypt(ctx, response.Entries[i].Data); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
@@ -37,6 +37,7 @@ import (
 	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/claimcheck"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -50,9 +51,11 @@ type (
 	executionManagerImpl struct {
 		serializer            serialization.Serializer
 		persistence           ExecutionStore
+		payloadStore          claimcheck.PayloadStore
 		logger                log.Logger
 		pagingTokenSerializer *jsonHistoryTokenSerializer
 		transactionSizeLimit  dynamicconfig.IntPropertyFn
+		historyNodeChunkSize  dynamicconfig.IntPropertyFn
 	}
 )
 
@@ -62,16 +65,20 @@ var _ ExecutionManager = (*executionManagerImpl)(nil)
 func NewExecutionManager(
 	persistence ExecutionStore,
 	serializer serialization.Serializer,
+	payloadStore claimcheck.PayloadStore,
 	logger log.Logger,
 	transactionSizeLimit dynamicconfig.IntPropertyFn,
+	historyNodeChunkSize dynamicconfig.IntPropertyFn,
 ) ExecutionManager {
 
 	return &executionManagerImpl{
 		serializer:            serializer,
 		persistence:           persistence,
+		payloadStore:          payloadStore,
 		logger:                logger,
 		pagingTokenSerializer: newJSONHistoryTokenSerializer(),
 		transactionSizeLimit:  transactionSizeLimit,
+		historyNodeChunkSize:  historyNodeChunkSize,
 	}
 }
 
@@ -408,7 +415,7 @@ func (m *executionManagerImpl) serializeWorkflowEventBatches(
 			return nil, nil, err
 		}
 		newEvents.ShardID = shardID
-		workflowNewEvents = append(workflowNewEvents, newEvents)
+		workflowNewEvents = append(workflowNewEvents, m.chunkHistoryNode(newEvents)...)
 		historyStatistics.SizeDiff += len(newEvents.Node.Events.Data)
 		historyStatistics.CountDiff += len(workflowEvents.Events)
 	}
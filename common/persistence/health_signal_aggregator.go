@@ -46,6 +46,7 @@ type (
 		common.Daemon
 		Record(callerSegment int32, latency time.Duration, err error)
 		AverageLatency() float64
+		LatencyPercentile(percentile float64) float64
 		ErrorRatio() float64
 	}
 
@@ -133,6 +134,10 @@ func (s *HealthSignalAggregatorImpl) AverageLatency() float64 {
 	return s.latencyAverage.Average()
 }
 
+func (s *HealthSignalAggregatorImpl) LatencyPercentile(percentile float64) float64 {
+	return s.latencyAverage.Percentile(percentile)
+}
+
 func (s *HealthSignalAggregatorImpl) ErrorRatio() float64 {
 	return s.errorRatio.Average()
 }
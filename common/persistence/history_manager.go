@@ -26,7 +26,6 @@ package persistence
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
@@ -217,7 +216,18 @@ findDeleteRanges:
 		ShardID:      request.ShardID,
 		BranchRanges: deleteRanges,
 	}
-	return m.persistence.DeleteHistoryBranch(ctx, req)
+	if err := m.persistence.DeleteHistoryBranch(ctx, req); err != nil {
+		return err
+	}
+
+	// offloaded payloads are deleted after the history nodes referencing them, failing the
+	// request so that the deletion is retried
+	for _, deleteRange := range deleteRanges {
+		if err := m.payloadStore.DeleteBranch(ctx, branch.TreeId, deleteRange.BranchId, deleteRange.BeginNodeId); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // TrimHistoryBranch trims a branch
@@ -275,6 +285,10 @@ func (m *executionManagerImpl) TrimHistoryBranch(
 
 		branchID := branchAncestors[token.CurrentRangeIndex].BranchId
 		for _, node := range nodes {
+			if isHistoryNodeChunk(node) {
+				// chunks are not part of the transaction chain, they belong to the node with their node ID
+				continue
+			}
 			transactionIDToNode[node.TransactionID] = historyNodeMetadata{
 				branchInfo: &persistencespb.HistoryBranch{
 					TreeId:    treeID,
@@ -313,6 +327,9 @@ func (m *executionManagerImpl) TrimHistoryBranch(
 		}); err != nil {
 			return nil, err
 		}
+		if err := m.payloadStore.DeleteNode(ctx, treeID, node.branchInfo.BranchId, node.nodeID, node.transactionID); err != nil {
+			return nil, err
+		}
 	}
 
 	return &TrimHistoryBranchResponse{}, nil
@@ -407,17 +424,21 @@ func (m *executionManagerImpl) serializeAppendHistoryNodesRequest(
 		lastID++
 	}
 
+	// the namespace is only known for offloading when the info is set, and payloads are
+	// not offloaded for an unknown namespace
+	namespaceID, _, _, _ := SplitHistoryGarbageCleanupInfo(request.Info)
+	events, err := m.payloadStore.Offload(ctx, namespaceID, branch.TreeId, branch.BranchId, request.TransactionID, request.Events)
+	if err != nil {
+		return nil, err
+	}
+
 	// nodeID will be the first eventID
-	blob, err := m.serializer.SerializeEvents(request.Events, enumspb.ENCODING_TYPE_PROTO3)
+	blob, err := m.serializer.SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
 	if err != nil {
 		return nil, err
 	}
-	size := len(blob.Data)
-	sizeLimit := m.transactionSizeLimit()
-	if size > sizeLimit {
-		return nil, &TransactionSizeLimitError{
-			Msg: fmt.Sprintf("transaction size of %v bytes exceeds limit of %v bytes", size, sizeLimit),
-		}
+	if err := m.validateHistoryNodeSize(len(blob.Data), request.TransactionID); err != nil {
+		return nil, err
 	}
 
 	req := &InternalAppendHistoryNodesRequest{
@@ -480,12 +501,8 @@ func (m *executionManagerImpl) serializeAppendRawHistoryNodesRequest(
 		}
 	}
 	// nodeID will be the first eventID
-	size := len(request.History.Data)
-	sizeLimit := m.transactionSizeLimit()
-	if size > sizeLimit {
-		return nil, &TransactionSizeLimitError{
-			Msg: fmt.Sprintf("transaction size of %v bytes exceeds limit of %v bytes", size, sizeLimit),
-		}
+	if err := m.validateHistoryNodeSize(len(request.History.Data), request.TransactionID); err != nil {
+		return nil, err
 	}
 
 	req := &InternalAppendHistoryNodesRequest{
@@ -537,13 +554,46 @@ func (m *executionManagerImpl) AppendHistoryNodes(
 		return nil, err
 	}
 
-	err = m.persistence.AppendHistoryNodes(ctx, req)
+	err = m.appendHistoryNodeChunks(ctx, req)
 
 	return &AppendHistoryNodesResponse{
 		Size: len(req.Node.Events.Data),
 	}, err
 }
 
+// AppendHistoryNodesBatch adds nodes to multiple history branches of a shard in one write
+func (m *executionManagerImpl) AppendHistoryNodesBatch(
+	ctx context.Context,
+	request *AppendHistoryNodesBatchRequest,
+) (*AppendHistoryNodesBatchResponse, error) {
+
+	reqs := make([]*InternalAppendHistoryNodesRequest, 0, len(request.Requests))
+	resps := make([]*AppendHistoryNodesResponse, 0, len(request.Requests))
+	for _, appendRequest := range request.Requests {
+		if appendRequest.ShardID != request.ShardID {
+			return nil, &InvalidPersistenceRequestError{
+				Msg: "cannot batch history appends of different shards",
+			}
+		}
+		req, err := m.serializeAppendHistoryNodesRequest(ctx, appendRequest)
+		if err != nil {
+			return nil, err
+		}
+		reqs = append(reqs, req)
+		resps = append(resps, &AppendHistoryNodesResponse{
+			Size: len(req.Node.Events.Data),
+		})
+	}
+
+	err := m.persistence.AppendHistoryNodesBatch(ctx, &InternalAppendHistoryNodesBatchRequest{
+		ShardID:  request.ShardID,
+		Requests: m.chunkHistoryNodes(reqs),
+	})
+	return &AppendHistoryNodesBatchResponse{
+		Responses: resps,
+	}, err
+}
+
 // AppendRawHistoryNodes add raw history nodes to history node table
 func (m *executionManagerImpl) AppendRawHistoryNodes(
 	ctx context.Context,
@@ -555,7 +605,7 @@ func (m *executionManagerImpl) AppendRawHistoryNodes(
 		return nil, err
 	}
 
-	err = m.persistence.AppendHistoryNodes(ctx, req)
+	err = m.appendHistoryNodeChunks(ctx, req)
 	return &AppendHistoryNodesResponse{
 		Size: len(request.History.Data),
 	}, err
@@ -600,6 +650,12 @@ func (m *executionManagerImpl) ReadRawHistoryBranch(
 		return nil, err
 	}
 
+	// raw history is replicated to other clusters, which can't read offloaded payloads
+	dataBlobs, err = m.payloadStore.ResolveHistoryBlobs(ctx, dataBlobs)
+	if err != nil {
+		return nil, err
+	}
+
 	nextPageToken, err := m.serializeToken(token, false)
 	if err != nil {
 		return nil, err
@@ -707,6 +763,11 @@ func (m *executionManagerImpl) readRawHistoryBranch(
 	if err != nil {
 		return nil, nil, err
 	}
+	if !metadataOnly {
+		if err := m.readHistoryNodeChunks(ctx, branchToken, shardID, branchID, resp.Nodes); err != nil {
+			return nil, nil, err
+		}
+	}
 	token.StoreToken = resp.NextPageToken
 	return resp.Nodes, token, nil
 }
@@ -768,6 +829,11 @@ func (m *executionManagerImpl) readRawHistoryBranchReverse(
 	if err != nil {
 		return nil, nil, err
 	}
+	if !metadataOnly {
+		if err := m.readHistoryNodeChunks(ctx, branchToken, shardID, branchID, resp.Nodes); err != nil {
+			return nil, nil, err
+		}
+	}
 	token.StoreToken = resp.NextPageToken
 	return resp.Nodes, token, nil
 }
@@ -0,0 +1,256 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+)
+
+// History event batches larger than the history node chunk size are stored as a manifest node plus chunk nodes.
+// The manifest is stored in place of the batch, with the batch's node ID and transaction ID, so the chain of
+// transactions is unchanged. Chunks are stored under the same node ID with negative transaction IDs derived from
+// the manifest's transaction ID: readers always pick the node with the highest transaction ID and skip the chunks,
+// and deleting a branch deletes its chunks along with the nodes.
+
+const (
+	// maxHistoryNodeChunks is the maximum number of chunks a single history node can be split into.
+	maxHistoryNodeChunks = 1024
+
+	// maxChunkedTransactionID is the largest transaction ID whose chunk transaction IDs don't overflow.
+	maxChunkedTransactionID = math.MaxInt64/maxHistoryNodeChunks - 1
+
+	historyNodeManifestVersion byte = 1
+)
+
+// historyNodeManifestMagic prefixes the manifest blob. A leading zero byte is never valid
+// in a proto3 or JSON encoded event batch.
+var historyNodeManifestMagic = []byte{0x00, 'c', 'h', 'k'}
+
+type (
+	historyNodeManifest struct {
+		chunkCount int
+		size       int
+	}
+)
+
+func (m *executionManagerImpl) getHistoryNodeChunkSize(transactionID int64) int {
+	if m.historyNodeChunkSize == nil || transactionID <= 0 || transactionID > maxChunkedTransactionID {
+		return 0
+	}
+	chunkSize := m.historyNodeChunkSize()
+	if sizeLimit := m.transactionSizeLimit(); chunkSize > sizeLimit {
+		chunkSize = sizeLimit
+	}
+	return chunkSize
+}
+
+// validateHistoryNodeSize checks the size of a serialized event batch against the transaction size limit,
+// which applies to each chunk when chunking is enabled.
+func (m *executionManagerImpl) validateHistoryNodeSize(size int, transactionID int64) error {
+	sizeLimit := m.transactionSizeLimit()
+	if chunkSize := m.getHistoryNodeChunkSize(transactionID); chunkSize > 0 {
+		sizeLimit = chunkSize * maxHistoryNodeChunks
+	}
+	if size > sizeLimit {
+		return &TransactionSizeLimitError{
+			Msg: fmt.Sprintf("transaction size of %v bytes exceeds limit of %v bytes", size, sizeLimit),
+		}
+	}
+	return nil
+}
+
+// chunkHistoryNode splits the events of an append request exceeding the chunk size into chunk requests followed
+// by the manifest request. Requests within the chunk size are returned as is.
+func (m *executionManagerImpl) chunkHistoryNode(
+	request *InternalAppendHistoryNodesRequest,
+) []*InternalAppendHistoryNodesRequest {
+	chunkSize := m.getHistoryNodeChunkSize(request.Node.TransactionID)
+	events := request.Node.Events
+	if chunkSize <= 0 || len(events.Data) <= chunkSize {
+		return []*InternalAppendHistoryNodesRequest{request}
+	}
+
+	chunkCount := (len(events.Data) + chunkSize - 1) / chunkSize
+	requests := make([]*InternalAppendHistoryNodesRequest, 0, chunkCount+1)
+	for i := 0; i < chunkCount; i++ {
+		end := (i + 1) * chunkSize
+		if end > len(events.Data) {
+			end = len(events.Data)
+		}
+		requests = append(requests, &InternalAppendHistoryNodesRequest{
+			BranchToken: request.BranchToken,
+			BranchInfo:  request.BranchInfo,
+			Node: InternalHistoryNode{
+				NodeID:            request.Node.NodeID,
+				Events:            NewDataBlob(events.Data[i*chunkSize:end], events.EncodingType.String()),
+				PrevTransactionID: request.Node.PrevTransactionID,
+				TransactionID:     chunkTransactionID(request.Node.TransactionID, i),
+			},
+			ShardID: request.ShardID,
+		})
+	}
+
+	// The manifest goes last, so the batch only becomes visible once all chunks are written.
+	manifestRequest := *request
+	manifestRequest.Node.Events = &commonpb.DataBlob{
+		EncodingType: events.EncodingType,
+		Data:         encodeHistoryNodeManifest(historyNodeManifest{chunkCount: chunkCount, size: len(events.Data)}),
+	}
+	return append(requests, &manifestRequest)
+}
+
+func (m *executionManagerImpl) chunkHistoryNodes(
+	requests []*InternalAppendHistoryNodesRequest,
+) []*InternalAppendHistoryNodesRequest {
+	chunked := make([]*InternalAppendHistoryNodesRequest, 0, len(requests))
+	for _, request := range requests {
+		chunked = append(chunked, m.chunkHistoryNode(request)...)
+	}
+	return chunked
+}
+
+func (m *executionManagerImpl) appendHistoryNodeChunks(
+	ctx context.Context,
+	request *InternalAppendHistoryNodesRequest,
+) error {
+	for _, req := range m.chunkHistoryNode(request) {
+		if err := m.persistence.AppendHistoryNodes(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readHistoryNodeChunks replaces the manifests among the given nodes of a branch with the events they point to.
+func (m *executionManagerImpl) readHistoryNodeChunks(
+	ctx context.Context,
+	branchToken []byte,
+	shardID int32,
+	branchID string,
+	nodes []InternalHistoryNode,
+) error {
+	for i, node := range nodes {
+		if isHistoryNodeChunk(node) {
+			continue
+		}
+		manifest, ok, err := decodeHistoryNodeManifest(node.Events)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		chunks := make([][]byte, manifest.chunkCount)
+		var pageToken []byte
+		for doContinue := true; doContinue; doContinue = len(pageToken) > 0 {
+			resp, err := m.persistence.ReadHistoryBranch(ctx, &InternalReadHistoryBranchRequest{
+				BranchToken:   branchToken,
+				ShardID:       shardID,
+				BranchID:      branchID,
+				MinNodeID:     node.NodeID,
+				MaxNodeID:     node.NodeID + 1,
+				NextPageToken: pageToken,
+				PageSize:      manifest.chunkCount + 1,
+			})
+			if err != nil {
+				return err
+			}
+			for _, chunk := range resp.Nodes {
+				index := chunkIndex(node.TransactionID, chunk.TransactionID)
+				if chunk.NodeID == node.NodeID && index >= 0 && index < manifest.chunkCount {
+					chunks[index] = chunk.Events.GetData()
+				}
+			}
+			pageToken = resp.NextPageToken
+		}
+
+		data := make([]byte, 0, manifest.size)
+		for index, chunk := range chunks {
+			if chunk == nil {
+				return serviceerror.NewDataLoss(fmt.Sprintf("corrupted data, history node %v is missing chunk %v", node.NodeID, index))
+			}
+			data = append(data, chunk...)
+		}
+		if len(data) != manifest.size {
+			return serviceerror.NewDataLoss(fmt.Sprintf("corrupted data, history node %v has %v bytes instead of %v", node.NodeID, len(data), manifest.size))
+		}
+		nodes[i].Events = &commonpb.DataBlob{
+			EncodingType: node.Events.EncodingType,
+			Data:         data,
+		}
+	}
+	return nil
+}
+
+// chunkTransactionID returns the transaction ID of a chunk of the node written by the given transaction.
+func chunkTransactionID(transactionID int64, index int) int64 {
+	return -(transactionID*maxHistoryNodeChunks + int64(index) + 1)
+}
+
+func chunkIndex(transactionID int64, chunkTransactionID int64) int {
+	return int(-chunkTransactionID - transactionID*maxHistoryNodeChunks - 1)
+}
+
+func isHistoryNodeChunk(node InternalHistoryNode) bool {
+	return node.TransactionID < 0
+}
+
+func encodeHistoryNodeManifest(manifest historyNodeManifest) []byte {
+	data := make([]byte, 0, len(historyNodeManifestMagic)+1+2*binary.MaxVarintLen64)
+	data = append(data, historyNodeManifestMagic...)
+	data = append(data, historyNodeManifestVersion)
+	data = binary.AppendUvarint(data, uint64(manifest.chunkCount))
+	data = binary.AppendUvarint(data, uint64(manifest.size))
+	return data
+}
+
+func decodeHistoryNodeManifest(blob *commonpb.DataBlob) (historyNodeManifest, bool, error) {
+	data := blob.GetData()
+	if !bytes.HasPrefix(data, historyNodeManifestMagic) {
+		return historyNodeManifest{}, false, nil
+	}
+	data = data[len(historyNodeManifestMagic):]
+	if len(data) == 0 || data[0] != historyNodeManifestVersion {
+		return historyNodeManifest{}, false, serviceerror.NewDataLoss("corrupted data, unknown history node manifest version")
+	}
+	data = data[1:]
+	chunkCount, n := binary.Uvarint(data)
+	if n <= 0 {
+		return historyNodeManifest{}, false, serviceerror.NewDataLoss("corrupted data, invalid history node manifest")
+	}
+	size, m := binary.Uvarint(data[n:])
+	if m <= 0 || chunkCount == 0 || chunkCount > maxHistoryNodeChunks {
+		return historyNodeManifest{}, false, serviceerror.NewDataLoss("corrupted data, invalid history node manifest")
+	}
+	return historyNodeManifest{chunkCount: int(chunkCount), size: int(size)}, true, nil
+}
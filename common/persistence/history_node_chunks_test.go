@@ -0,0 +1,99 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+type (
+	historyNodeChunksSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestHistoryNodeChunksSuite(t *testing.T) {
+	s := new(historyNodeChunksSuite)
+	suite.Run(t, s)
+}
+
+func (s *historyNodeChunksSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *historyNodeChunksSuite) TestChunkTransactionID() {
+	transactionID := rand.Int63n(maxChunkedTransactionID) + 1
+	seen := map[int64]struct{}{}
+	for index := 0; index < maxHistoryNodeChunks; index++ {
+		chunkTxnID := chunkTransactionID(transactionID, index)
+		s.True(chunkTxnID < 0)
+		s.Equal(index, chunkIndex(transactionID, chunkTxnID))
+		seen[chunkTxnID] = struct{}{}
+	}
+	s.Len(seen, maxHistoryNodeChunks)
+
+	// chunks of the next transaction never collide with this one
+	s.NotEqual(chunkTransactionID(transactionID, maxHistoryNodeChunks-1), chunkTransactionID(transactionID+1, 0))
+	s.True(isHistoryNodeChunk(InternalHistoryNode{TransactionID: chunkTransactionID(maxChunkedTransactionID, maxHistoryNodeChunks-1)}))
+}
+
+func (s *historyNodeChunksSuite) TestManifest_RoundTrip() {
+	manifest := historyNodeManifest{chunkCount: 7, size: 1 << 20}
+	blob := &commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+		Data:         encodeHistoryNodeManifest(manifest),
+	}
+
+	decoded, ok, err := decodeHistoryNodeManifest(blob)
+	s.NoError(err)
+	s.True(ok)
+	s.Equal(manifest, decoded)
+}
+
+func (s *historyNodeChunksSuite) TestManifest_NotManifest() {
+	_, ok, err := decodeHistoryNodeManifest(&commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+		Data:         []byte{0x0a, 0x02, 0x08, 0x01},
+	})
+	s.NoError(err)
+	s.False(ok)
+}
+
+func (s *historyNodeChunksSuite) TestManifest_Corrupted() {
+	data := encodeHistoryNodeManifest(historyNodeManifest{chunkCount: 2, size: 10})
+	_, _, err := decodeHistoryNodeManifest(&commonpb.DataBlob{Data: data[:len(historyNodeManifestMagic)+1]})
+	s.Error(err)
+
+	data[len(historyNodeManifestMagic)] = historyNodeManifestVersion + 1
+	_, _, err = decodeHistoryNodeManifest(&commonpb.DataBlob{Data: data})
+	s.Error(err)
+}
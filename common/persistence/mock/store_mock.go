@@ -765,6 +765,20 @@ func (mr *MockExecutionStoreMockRecorder) AppendHistoryNodes(ctx, request interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendHistoryNodes", reflect.TypeOf((*MockExecutionStore)(nil).AppendHistoryNodes), ctx, request)
 }
 
+// AppendHistoryNodesBatch mocks base method.
+func (m *MockExecutionStore) AppendHistoryNodesBatch(ctx context.Context, request *persistence.InternalAppendHistoryNodesBatchRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendHistoryNodesBatch", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendHistoryNodesBatch indicates an expected call of AppendHistoryNodesBatch.
+func (mr *MockExecutionStoreMockRecorder) AppendHistoryNodesBatch(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendHistoryNodesBatch", reflect.TypeOf((*MockExecutionStore)(nil).AppendHistoryNodesBatch), ctx, request)
+}
+
 // Close mocks base method.
 func (m *MockExecutionStore) Close() {
 	m.ctrl.T.Helper()
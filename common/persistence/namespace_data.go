@@ -0,0 +1,120 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"golang.org/x/exp/maps"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/backoff"
+)
+
+var (
+	namespaceDataUpdateRetryPolicy = backoff.NewExponentialRetryPolicy(50 * time.Millisecond).
+		WithMaximumAttempts(5)
+)
+
+// namespaceDataUpdateError wraps the errors of the update function, which are not retried unlike the failures
+// to update the namespace
+type namespaceDataUpdateError struct {
+	error
+}
+
+// UpdateNamespaceData applies update to a copy of the data of the namespace selected by request and persists
+// it, leaving the rest of the namespace as it is stored. The update is retried with the latest namespace if the
+// namespace was concurrently updated, so update may be called several times.
+func UpdateNamespaceData(
+	ctx context.Context,
+	metadataManager MetadataManager,
+	request *GetNamespaceRequest,
+	update func(data map[string]string) error,
+) error {
+	_, err := UpdateNamespaceDetail(ctx, metadataManager, request, func(detail *persistencespb.NamespaceDetail) error {
+		data := make(map[string]string, len(detail.Info.Data)+1)
+		maps.Copy(data, detail.Info.Data)
+		if err := update(data); err != nil {
+			return err
+		}
+		detail.Info.Data = data
+		return nil
+	})
+	return err
+}
+
+// UpdateNamespaceDetail applies update to the namespace selected by request, as it is currently stored, and
+// persists it. The update is retried with the latest namespace if the namespace was concurrently updated, so
+// update may be called several times. The namespace as it was persisted is returned.
+func UpdateNamespaceDetail(
+	ctx context.Context,
+	metadataManager MetadataManager,
+	request *GetNamespaceRequest,
+	update func(detail *persistencespb.NamespaceDetail) error,
+) (*GetNamespaceResponse, error) {
+	var updated *GetNamespaceResponse
+	op := func(ctx context.Context) error {
+		// must get the metadata (notificationVersion) first, so that an update committed between the two
+		// reads fails the version check of UpdateNamespace instead of being overwritten
+		metadata, err := metadataManager.GetMetadata(ctx)
+		if err != nil {
+			return err
+		}
+		resp, err := metadataManager.GetNamespace(ctx, request)
+		if err != nil {
+			return err
+		}
+		if err := update(resp.Namespace); err != nil {
+			return &namespaceDataUpdateError{err}
+		}
+		if err := metadataManager.UpdateNamespace(ctx, &UpdateNamespaceRequest{
+			Namespace:           resp.Namespace,
+			IsGlobalNamespace:   resp.IsGlobalNamespace,
+			NotificationVersion: metadata.NotificationVersion,
+		}); err != nil {
+			return err
+		}
+		updated = resp
+		return nil
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, namespaceDataUpdateRetryPolicy, isRetryableNamespaceDataUpdateError)
+	if updateErr, ok := err.(*namespaceDataUpdateError); ok {
+		return nil, updateErr.error
+	}
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func isRetryableNamespaceDataUpdateError(err error) bool {
+	switch err.(type) {
+	case *namespaceDataUpdateError, *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,132 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+// fakeNamespaceStore keeps the data of a namespace for a mock metadata manager. A concurrent update, when
+// set, is committed once right after the first read of the namespace or the metadata.
+type fakeNamespaceStore struct {
+	data             map[string]string
+	version          int64
+	concurrentUpdate map[string]string
+}
+
+func (f *fakeNamespaceStore) read() {
+	if f.concurrentUpdate != nil {
+		f.data = f.concurrentUpdate
+		f.concurrentUpdate = nil
+		f.version++
+	}
+}
+
+func (f *fakeNamespaceStore) expect(metadataManager *MockMetadataManager) {
+	metadataManager.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *GetNamespaceRequest) (*GetNamespaceResponse, error) {
+			defer f.read()
+			if request.Name != "ns" {
+				return nil, serviceerror.NewNamespaceNotFound(request.Name)
+			}
+			return &GetNamespaceResponse{
+				Namespace: &persistencespb.NamespaceDetail{
+					Info:   &persistencespb.NamespaceInfo{Name: "ns", Data: f.data},
+					Config: &persistencespb.NamespaceConfig{},
+				},
+				IsGlobalNamespace: true,
+			}, nil
+		}).AnyTimes()
+	metadataManager.EXPECT().GetMetadata(gomock.Any()).DoAndReturn(
+		func(context.Context) (*GetMetadataResponse, error) {
+			defer f.read()
+			return &GetMetadataResponse{NotificationVersion: f.version}, nil
+		}).AnyTimes()
+	metadataManager.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *UpdateNamespaceRequest) error {
+			if request.NotificationVersion != f.version {
+				return serviceerror.NewUnavailable("UpdateNamespace operation failed because of conditional failure.")
+			}
+			if !request.IsGlobalNamespace || request.Namespace.Config == nil {
+				return errors.New("namespace not preserved")
+			}
+			f.data = request.Namespace.Info.Data
+			f.version++
+			return nil
+		}).AnyTimes()
+}
+
+func TestUpdateNamespaceData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metadataManager := NewMockMetadataManager(ctrl)
+	store := &fakeNamespaceStore{data: map[string]string{"other": "value"}}
+	store.expect(metadataManager)
+
+	err := UpdateNamespaceData(context.Background(), metadataManager, &GetNamespaceRequest{Name: "ns"}, func(data map[string]string) error {
+		data["key"] = "value"
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"other": "value", "key": "value"}, store.data)
+
+	updateErr := errors.New("invalid update")
+	err = UpdateNamespaceData(context.Background(), metadataManager, &GetNamespaceRequest{Name: "ns"}, func(data map[string]string) error {
+		return updateErr
+	})
+	require.Equal(t, updateErr, err)
+	require.EqualValues(t, 1, store.version)
+
+	err = UpdateNamespaceData(context.Background(), metadataManager, &GetNamespaceRequest{Name: "missing"}, func(data map[string]string) error {
+		return nil
+	})
+	var notFound *serviceerror.NamespaceNotFound
+	require.ErrorAs(t, err, &notFound)
+}
+
+func TestUpdateNamespaceData_ConcurrentUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metadataManager := NewMockMetadataManager(ctrl)
+	store := &fakeNamespaceStore{
+		data:             map[string]string{"other": "value"},
+		concurrentUpdate: map[string]string{"other": "updated"},
+	}
+	store.expect(metadataManager)
+
+	err := UpdateNamespaceData(context.Background(), metadataManager, &GetNamespaceRequest{Name: "ns"}, func(data map[string]string) error {
+		data["key"] = "value"
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"other": "updated", "key": "value"}, store.data)
+	require.EqualValues(t, 2, store.version)
+}
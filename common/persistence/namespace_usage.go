@@ -0,0 +1,245 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+const (
+	// NamespaceUsageBucketSize is the granularity at which namespace usage is tracked
+	NamespaceUsageBucketSize = time.Minute
+	// NamespaceUsageMaxWindow is the longest window over which namespace usage can be queried
+	NamespaceUsageMaxWindow = time.Hour
+
+	namespaceUsageBuckets = int(NamespaceUsageMaxWindow / NamespaceUsageBucketSize)
+
+	// namespaceTagOverflowValue replaces the namespace tag of persistence metrics for namespaces
+	// which don't fit in the tag cardinality limit
+	namespaceTagOverflowValue = "_other_"
+)
+
+type (
+	// NamespaceUsageConfig is the configuration of the per-namespace persistence metrics
+	NamespaceUsageConfig struct {
+		// MaxTaggedNamespaces is the number of namespaces persistence metrics are tagged with, the busiest
+		// ones over the last minute, metrics of other namespaces are tagged as _other_. Zero removes the limit.
+		MaxTaggedNamespaces dynamicconfig.IntPropertyFn
+	}
+
+	// NamespaceUsage is the persistence usage of a namespace over a window
+	NamespaceUsage struct {
+		Namespace    string
+		Requests     int64
+		Errors       int64
+		TotalLatency time.Duration
+	}
+
+	namespaceUsageCounters struct {
+		requests     atomic.Int64
+		errors       atomic.Int64
+		totalLatency atomic.Int64
+	}
+
+	namespaceUsageBucket struct {
+		start time.Time
+		usage sync.Map // namespace -> *namespaceUsageCounters
+	}
+
+	namespaceTagSet struct {
+		// start is the start of the bucket the namespaces were chosen at
+		start      time.Time
+		namespaces sync.Map // namespace -> struct{}
+		size       atomic.Int64
+	}
+
+	// NamespaceUsageTracker attributes the persistence requests of a host to the namespaces they are made for,
+	// and bounds the number of namespaces the persistence metrics of the host are tagged with.
+	// Record is on the path of every persistence request, so it only takes a lock once per bucket
+	// to choose the tagged namespaces.
+	NamespaceUsageTracker struct {
+		config     *NamespaceUsageConfig
+		timeSource clock.TimeSource
+
+		buckets []atomic.Pointer[namespaceUsageBucket]
+		tagged  atomic.Pointer[namespaceTagSet]
+		tagLock sync.Mutex
+	}
+)
+
+func NewNamespaceUsageTracker(
+	config *NamespaceUsageConfig,
+	timeSource clock.TimeSource,
+) *NamespaceUsageTracker {
+	return &NamespaceUsageTracker{
+		config:     config,
+		timeSource: timeSource,
+		buckets:    make([]atomic.Pointer[namespaceUsageBucket], namespaceUsageBuckets),
+	}
+}
+
+// Record records a persistence request made for the namespace, and returns the namespace tag value its metrics
+// should be emitted with.
+func (t *NamespaceUsageTracker) Record(
+	namespace string,
+	latency time.Duration,
+	err error,
+) string {
+	if namespace == "" {
+		return namespace
+	}
+
+	start := t.timeSource.Now().Truncate(NamespaceUsageBucketSize)
+	bucket := t.bucket(start)
+	counters, ok := bucket.usage.Load(namespace)
+	if !ok {
+		counters, _ = bucket.usage.LoadOrStore(namespace, &namespaceUsageCounters{})
+	}
+	usage := counters.(*namespaceUsageCounters)
+	usage.requests.Add(1)
+	usage.totalLatency.Add(int64(latency))
+	if err != nil {
+		usage.errors.Add(1)
+	}
+
+	return t.tagValue(namespace, start)
+}
+
+// bucket returns the bucket starting at start, replacing the bucket of the same slot an hour earlier.
+func (t *NamespaceUsageTracker) bucket(start time.Time) *namespaceUsageBucket {
+	slot := &t.buckets[int(start.Unix()/int64(NamespaceUsageBucketSize/time.Second))%namespaceUsageBuckets]
+	for {
+		bucket := slot.Load()
+		if bucket != nil && !bucket.start.Before(start) {
+			return bucket
+		}
+		if slot.CompareAndSwap(bucket, &namespaceUsageBucket{start: start}) {
+			return slot.Load()
+		}
+	}
+}
+
+func (t *NamespaceUsageTracker) tagValue(namespace string, start time.Time) string {
+	maxTagged := t.config.MaxTaggedNamespaces()
+	if maxTagged <= 0 {
+		return namespace
+	}
+	tagged := t.tagged.Load()
+	if tagged == nil || tagged.start.Before(start) {
+		tagged = t.chooseTagged(maxTagged, start)
+	}
+	if _, ok := tagged.namespaces.Load(namespace); ok {
+		return namespace
+	}
+	if tagged.size.Add(1) <= int64(maxTagged) {
+		tagged.namespaces.Store(namespace, struct{}{})
+		return namespace
+	}
+	return namespaceTagOverflowValue
+}
+
+// chooseTagged keeps tagging the namespaces which were the busiest over the previous bucket, once per bucket.
+func (t *NamespaceUsageTracker) chooseTagged(maxTagged int, start time.Time) *namespaceTagSet {
+	t.tagLock.Lock()
+	defer t.tagLock.Unlock()
+
+	if tagged := t.tagged.Load(); tagged != nil && !tagged.start.Before(start) {
+		return tagged
+	}
+	tagged := &namespaceTagSet{start: start}
+	for _, usage := range t.top(maxTagged, start.Add(-NamespaceUsageBucketSize), start) {
+		tagged.namespaces.Store(usage.Namespace, struct{}{})
+		tagged.size.Add(1)
+	}
+	t.tagged.Store(tagged)
+	return tagged
+}
+
+// Top returns the n namespaces which made the most persistence requests over the window, busiest first.
+// The window is rounded up to a whole number of minutes, and is at most an hour.
+func (t *NamespaceUsageTracker) Top(
+	n int,
+	window time.Duration,
+) []NamespaceUsage {
+	if window > NamespaceUsageMaxWindow {
+		window = NamespaceUsageMaxWindow
+	}
+
+	now := t.timeSource.Now().Truncate(NamespaceUsageBucketSize).Add(NamespaceUsageBucketSize)
+	from := now.Add(-window).Truncate(NamespaceUsageBucketSize)
+	if !from.Before(now) {
+		from = now.Add(-NamespaceUsageBucketSize)
+	}
+	return t.top(n, from, now)
+}
+
+// top returns the n busiest namespaces over the buckets starting in [from, to).
+func (t *NamespaceUsageTracker) top(
+	n int,
+	from time.Time,
+	to time.Time,
+) []NamespaceUsage {
+	total := make(map[string]*NamespaceUsage)
+	for i := range t.buckets {
+		bucket := t.buckets[i].Load()
+		if bucket == nil || bucket.start.Before(from) || !bucket.start.Before(to) {
+			continue
+		}
+		bucket.usage.Range(func(key, value any) bool {
+			namespace := key.(string)
+			usage := value.(*namespaceUsageCounters)
+			sum, ok := total[namespace]
+			if !ok {
+				sum = &NamespaceUsage{Namespace: namespace}
+				total[namespace] = sum
+			}
+			sum.Requests += usage.requests.Load()
+			sum.Errors += usage.errors.Load()
+			sum.TotalLatency += time.Duration(usage.totalLatency.Load())
+			return true
+		})
+	}
+
+	result := make([]NamespaceUsage, 0, len(total))
+	for _, usage := range total {
+		result = append(result, *usage)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Requests != result[j].Requests {
+			return result[i].Requests > result[j].Requests
+		}
+		return result[i].Namespace < result[j].Namespace
+	})
+	if len(result) > n {
+		result = result[:n]
+	}
+	return result
+}
@@ -0,0 +1,168 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/clock"
+)
+
+type (
+	namespaceUsageSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		now           time.Time
+		timeSource    *clock.EventTimeSource
+		maxNamespaces int
+		tracker       *NamespaceUsageTracker
+	}
+)
+
+func TestNamespaceUsageSuite(t *testing.T) {
+	s := new(namespaceUsageSuite)
+	suite.Run(t, s)
+}
+
+func (s *namespaceUsageSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.now = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.timeSource = clock.NewEventTimeSource().Update(s.now)
+	s.maxNamespaces = 0
+	s.tracker = NewNamespaceUsageTracker(
+		&NamespaceUsageConfig{
+			MaxTaggedNamespaces: func() int { return s.maxNamespaces },
+		},
+		s.timeSource,
+	)
+}
+
+func (s *namespaceUsageSuite) advance(d time.Duration) {
+	s.now = s.now.Add(d)
+	s.timeSource.Update(s.now)
+}
+
+func (s *namespaceUsageSuite) record(namespace string, requests int) []string {
+	var tags []string
+	for i := 0; i < requests; i++ {
+		tags = append(tags, s.tracker.Record(namespace, time.Millisecond, nil))
+	}
+	return tags
+}
+
+func (s *namespaceUsageSuite) TestTop() {
+	s.record("ns-a", 3)
+	s.record("ns-b", 1)
+	s.tracker.Record("ns-b", time.Millisecond, errors.New("failed"))
+
+	s.Equal([]NamespaceUsage{
+		{Namespace: "ns-a", Requests: 3, TotalLatency: 3 * time.Millisecond},
+	}, s.tracker.Top(1, time.Minute))
+	s.Equal([]NamespaceUsage{
+		{Namespace: "ns-a", Requests: 3, TotalLatency: 3 * time.Millisecond},
+		{Namespace: "ns-b", Requests: 2, Errors: 1, TotalLatency: 2 * time.Millisecond},
+	}, s.tracker.Top(10, time.Minute))
+}
+
+func (s *namespaceUsageSuite) TestTop_Window() {
+	s.record("ns-a", 1)
+	s.advance(10 * time.Minute)
+	s.record("ns-b", 1)
+
+	top := s.tracker.Top(10, 5*time.Minute)
+	s.Len(top, 1)
+	s.Equal("ns-b", top[0].Namespace)
+	s.Len(s.tracker.Top(10, time.Hour), 2)
+
+	s.advance(2 * time.Hour)
+	s.Empty(s.tracker.Top(10, time.Hour))
+}
+
+func (s *namespaceUsageSuite) TestTagCardinality() {
+	s.maxNamespaces = 2
+	s.Equal([]string{"ns-a", "ns-a", "ns-a"}, s.record("ns-a", 3))
+	s.Equal([]string{"ns-b", "ns-b"}, s.record("ns-b", 2))
+	s.Equal([]string{namespaceTagOverflowValue}, s.record("ns-c", 1))
+	s.Equal("", s.tracker.Record("", time.Millisecond, nil))
+
+	// the busiest namespaces of the previous minute keep being tagged
+	s.advance(time.Minute)
+	s.Equal([]string{namespaceTagOverflowValue, namespaceTagOverflowValue}, s.record("ns-c", 2))
+	s.Equal([]string{"ns-a"}, s.record("ns-a", 1))
+
+	s.advance(time.Minute)
+	s.Equal([]string{"ns-c"}, s.record("ns-c", 1))
+	s.Equal([]string{"ns-a"}, s.record("ns-a", 1))
+	s.Equal([]string{namespaceTagOverflowValue}, s.record("ns-b", 1))
+}
+
+func (s *namespaceUsageSuite) TestTagCardinality_Unlimited() {
+	for _, namespace := range []string{"ns-a", "ns-b", "ns-c"} {
+		s.Equal([]string{namespace}, s.record(namespace, 1))
+	}
+}
+
+func (s *namespaceUsageSuite) TestRecord_Concurrent() {
+	s.maxNamespaces = 3
+
+	var wg sync.WaitGroup
+	tags := make([][]string, 10)
+	for i := range tags {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				tags[i] = append(tags[i], s.tracker.Record(fmt.Sprintf("ns-%d", i), time.Millisecond, nil))
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	tagged := make(map[string]struct{})
+	for _, namespaceTags := range tags {
+		for _, tag := range namespaceTags {
+			if tag != namespaceTagOverflowValue {
+				tagged[tag] = struct{}{}
+			}
+		}
+	}
+	s.Len(tagged, s.maxNamespaces)
+
+	top := s.tracker.Top(20, time.Minute)
+	s.Len(top, len(tags))
+	for _, usage := range top {
+		s.Equal(int64(100), usage.Requests)
+		s.Equal(100*time.Millisecond, usage.TotalLatency)
+	}
+}
@@ -46,6 +46,10 @@ func (a *noopSignalAggregator) AverageLatency() float64 {
 	return 0
 }
 
+func (a *noopSignalAggregator) LatencyPercentile(_ float64) float64 {
+	return 0
+}
+
 func (*noopSignalAggregator) ErrorRatio() float64 {
 	return 0
 }
@@ -25,6 +25,7 @@
 package gocql
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
@@ -43,10 +44,25 @@ import (
 	"go.temporal.io/server/common/resolver"
 )
 
+const (
+	refreshPasswordTimeout = 10 * time.Second
+)
+
 func NewCassandraCluster(
 	cfg config.Cassandra,
 	resolver resolver.ServiceResolver,
 ) (*gocql.ClusterConfig, error) {
+	// the cluster config is created again when the session is refreshed, a rotated password is picked up here
+	if cfg.RefreshPassword != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), refreshPasswordTimeout)
+		password, err := cfg.RefreshPassword(ctx)
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("unable to refresh cassandra password: %w", err)
+		}
+		cfg.Password = password
+	}
+
 	var resolvedHosts []string
 	for _, host := range parseHosts(cfg.Hosts) {
 		resolvedHosts = append(resolvedHosts, resolver.Resolve(host)...)
@@ -37,6 +37,7 @@ import (
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/claimcheck"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
@@ -142,6 +143,8 @@ func NewTestBaseWithSQL(options *TestBaseOptions) TestBase {
 			options.DBPort = environment.GetMySQLPort()
 		case postgresql.PluginName, postgresql.PluginNameV12:
 			options.DBPort = environment.GetPostgreSQLPort()
+		case postgresql.PluginNameCockroachDB:
+			options.DBPort = environment.GetCockroachDBPort()
 		case sqlite.PluginName:
 			options.DBPort = 0
 		default:
@@ -154,6 +157,8 @@ func NewTestBaseWithSQL(options *TestBaseOptions) TestBase {
 			options.DBHost = environment.GetMySQLAddress()
 		case postgresql.PluginName:
 			options.DBHost = environment.GetPostgreSQLAddress()
+		case postgresql.PluginNameCockroachDB:
+			options.DBHost = environment.GetCockroachDBAddress()
 		case sqlite.PluginName:
 			options.DBHost = environment.Localhost
 		default:
@@ -204,10 +209,12 @@ func (s *TestBase) Setup(clusterMetadataConfig *cluster.Config) {
 		resolver.NewNoopResolver(),
 		&cfg,
 		s.AbstractDataStoreFactory,
+		nil,
+		nil,
 		s.Logger,
 		metrics.NoopMetricsHandler,
 	)
-	factory := client.NewFactory(dataStoreFactory, &cfg, s.PersistenceRateLimiter, serialization.NewSerializer(), clusterName, metrics.NoopMetricsHandler, s.Logger, s.PersistenceHealthSignals)
+	factory := client.NewFactory(dataStoreFactory, &cfg, s.PersistenceRateLimiter, serialization.NewSerializer(), clusterName, metrics.NoopMetricsHandler, s.Logger, s.PersistenceHealthSignals, claimcheck.NoopPayloadStore, nil)
 
 	s.TaskMgr, err = factory.NewTaskManager()
 	s.fatalOnError("NewTaskManager", err)
@@ -43,6 +43,10 @@ const (
 	testPostgreSQLSchemaDir   = "schema/postgresql/v96"
 	testPostgreSQL12SchemaDir = "schema/postgresql/v12"
 
+	testCockroachDBUser      = "temporal"
+	testCockroachDBPassword  = "temporal"
+	testCockroachDBSchemaDir = "schema/cockroachdb/v23"
+
 	testSQLiteUser      = ""
 	testSQLitePassword  = ""
 	testSQLiteMode      = "memory"
@@ -102,6 +106,19 @@ func GetPostgreSQL12TestClusterOption() *TestBaseOptions {
 	}
 }
 
+// GetCockroachDBTestClusterOption return test options
+func GetCockroachDBTestClusterOption() *TestBaseOptions {
+	return &TestBaseOptions{
+		SQLDBPluginName: postgresql.PluginNameCockroachDB,
+		DBUsername:      testCockroachDBUser,
+		DBPassword:      testCockroachDBPassword,
+		DBHost:          environment.GetCockroachDBAddress(),
+		DBPort:          environment.GetCockroachDBPort(),
+		SchemaDir:       testCockroachDBSchemaDir,
+		StoreType:       config.StoreTypeSQL,
+	}
+}
+
 // GetSQLiteTestClusterOption return test options
 func GetSQLiteFileTestClusterOption() *TestBaseOptions {
 	return &TestBaseOptions{
@@ -151,6 +151,9 @@ type (
 
 		// AppendHistoryNodes add a node to history node table
 		AppendHistoryNodes(ctx context.Context, request *InternalAppendHistoryNodesRequest) error
+		// AppendHistoryNodesBatch adds nodes to multiple branches of a shard in a single transaction.
+		// Stores that cannot write them atomically return serviceerror.Unimplemented.
+		AppendHistoryNodesBatch(ctx context.Context, request *InternalAppendHistoryNodesBatchRequest) error
 		// DeleteHistoryNodes delete a node from history node table
 		DeleteHistoryNodes(ctx context.Context, request *InternalDeleteHistoryNodesRequest) error
 		// ReadHistoryBranch returns history node data for a branch
@@ -508,6 +511,13 @@ type (
 		ShardID int32
 	}
 
+	// InternalAppendHistoryNodesBatchRequest is used to append nodes to multiple history branches of a shard
+	InternalAppendHistoryNodesBatchRequest struct {
+		// Used in sharded data stores to identify which shard to use
+		ShardID  int32
+		Requests []*InternalAppendHistoryNodesRequest
+	}
+
 	// InternalGetWorkflowExecutionResponse is the response to GetworkflowExecution for Persistence Interface
 	InternalGetWorkflowExecutionResponse struct {
 		State           *InternalWorkflowMutableState
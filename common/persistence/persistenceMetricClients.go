@@ -41,6 +41,7 @@ import (
 type (
 	metricEmitter struct {
 		metricsHandler metrics.Handler
+		namespaceUsage *NamespaceUsageTracker
 		logger         log.Logger
 	}
 
@@ -89,10 +90,11 @@ var _ ClusterMetadataManager = (*clusterMetadataPersistenceClient)(nil)
 var _ Queue = (*queuePersistenceClient)(nil)
 
 // NewShardPersistenceMetricsClient creates a client to manage shards
-func NewShardPersistenceMetricsClient(persistence ShardManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, logger log.Logger) ShardManager {
+func NewShardPersistenceMetricsClient(persistence ShardManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, namespaceUsage *NamespaceUsageTracker, logger log.Logger) ShardManager {
 	return &shardPersistenceClient{
 		metricEmitter: metricEmitter{
 			metricsHandler: metricsHandler,
+			namespaceUsage: namespaceUsage,
 			logger:         logger,
 		},
 		healthSignals: healthSignals,
@@ -101,10 +103,11 @@ func NewShardPersistenceMetricsClient(persistence ShardManager, metricsHandler m
 }
 
 // NewExecutionPersistenceMetricsClient creates a client to manage executions
-func NewExecutionPersistenceMetricsClient(persistence ExecutionManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, logger log.Logger) ExecutionManager {
+func NewExecutionPersistenceMetricsClient(persistence ExecutionManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, namespaceUsage *NamespaceUsageTracker, logger log.Logger) ExecutionManager {
 	return &executionPersistenceClient{
 		metricEmitter: metricEmitter{
 			metricsHandler: metricsHandler,
+			namespaceUsage: namespaceUsage,
 			logger:         logger,
 		},
 		healthSignals: healthSignals,
@@ -113,10 +116,11 @@ func NewExecutionPersistenceMetricsClient(persistence ExecutionManager, metricsH
 }
 
 // NewTaskPersistenceMetricsClient creates a client to manage tasks
-func NewTaskPersistenceMetricsClient(persistence TaskManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, logger log.Logger) TaskManager {
+func NewTaskPersistenceMetricsClient(persistence TaskManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, namespaceUsage *NamespaceUsageTracker, logger log.Logger) TaskManager {
 	return &taskPersistenceClient{
 		metricEmitter: metricEmitter{
 			metricsHandler: metricsHandler,
+			namespaceUsage: namespaceUsage,
 			logger:         logger,
 		},
 		healthSignals: healthSignals,
@@ -125,10 +129,11 @@ func NewTaskPersistenceMetricsClient(persistence TaskManager, metricsHandler met
 }
 
 // NewMetadataPersistenceMetricsClient creates a MetadataManager client to manage metadata
-func NewMetadataPersistenceMetricsClient(persistence MetadataManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, logger log.Logger) MetadataManager {
+func NewMetadataPersistenceMetricsClient(persistence MetadataManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, namespaceUsage *NamespaceUsageTracker, logger log.Logger) MetadataManager {
 	return &metadataPersistenceClient{
 		metricEmitter: metricEmitter{
 			metricsHandler: metricsHandler,
+			namespaceUsage: namespaceUsage,
 			logger:         logger,
 		},
 		healthSignals: healthSignals,
@@ -137,10 +142,11 @@ func NewMetadataPersistenceMetricsClient(persistence MetadataManager, metricsHan
 }
 
 // NewClusterMetadataPersistenceMetricsClient creates a ClusterMetadataManager client to manage cluster metadata
-func NewClusterMetadataPersistenceMetricsClient(persistence ClusterMetadataManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, logger log.Logger) ClusterMetadataManager {
+func NewClusterMetadataPersistenceMetricsClient(persistence ClusterMetadataManager, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, namespaceUsage *NamespaceUsageTracker, logger log.Logger) ClusterMetadataManager {
 	return &clusterMetadataPersistenceClient{
 		metricEmitter: metricEmitter{
 			metricsHandler: metricsHandler,
+			namespaceUsage: namespaceUsage,
 			logger:         logger,
 		},
 		healthSignals: healthSignals,
@@ -149,10 +155,11 @@ func NewClusterMetadataPersistenceMetricsClient(persistence ClusterMetadataManag
 }
 
 // NewQueuePersistenceMetricsClient creates a client to manage queue
-func NewQueuePersistenceMetricsClient(persistence Queue, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, logger log.Logger) Queue {
+func NewQueuePersistenceMetricsClient(persistence Queue, metricsHandler metrics.Handler, healthSignals HealthSignalAggregator, namespaceUsage *NamespaceUsageTracker, logger log.Logger) Queue {
 	return &queuePersistenceClient{
 		metricEmitter: metricEmitter{
 			metricsHandler: metricsHandler,
+			namespaceUsage: namespaceUsage,
 			logger:         logger,
 		},
 		healthSignals: healthSignals,
@@ -173,7 +180,7 @@ func (p *shardPersistenceClient) GetOrCreateShard(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetOrCreateShardScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetOrCreateShardScope, caller, latency, retErr)
 	}()
 	return p.persistence.GetOrCreateShard(ctx, request)
 }
@@ -187,7 +194,7 @@ func (p *shardPersistenceClient) UpdateShard(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardInfo.GetShardId(), latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpdateShardScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpdateShardScope, caller, latency, retErr)
 	}()
 	return p.persistence.UpdateShard(ctx, request)
 }
@@ -201,7 +208,7 @@ func (p *shardPersistenceClient) AssertShardOwnership(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceAssertShardOwnershipScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceAssertShardOwnershipScope, caller, latency, retErr)
 	}()
 	return p.persistence.AssertShardOwnership(ctx, request)
 }
@@ -227,7 +234,7 @@ func (p *executionPersistenceClient) CreateWorkflowExecution(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceCreateWorkflowExecutionScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceCreateWorkflowExecutionScope, caller, latency, retErr)
 	}()
 	return p.persistence.CreateWorkflowExecution(ctx, request)
 }
@@ -241,7 +248,7 @@ func (p *executionPersistenceClient) GetWorkflowExecution(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetWorkflowExecutionScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetWorkflowExecutionScope, caller, latency, retErr)
 	}()
 	return p.persistence.GetWorkflowExecution(ctx, request)
 }
@@ -255,7 +262,7 @@ func (p *executionPersistenceClient) SetWorkflowExecution(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceSetWorkflowExecutionScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceSetWorkflowExecutionScope, caller, latency, retErr)
 	}()
 	return p.persistence.SetWorkflowExecution(ctx, request)
 }
@@ -269,7 +276,7 @@ func (p *executionPersistenceClient) UpdateWorkflowExecution(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpdateWorkflowExecutionScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpdateWorkflowExecutionScope, caller, latency, retErr)
 	}()
 	return p.persistence.UpdateWorkflowExecution(ctx, request)
 }
@@ -283,7 +290,7 @@ func (p *executionPersistenceClient) ConflictResolveWorkflowExecution(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceConflictResolveWorkflowExecutionScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceConflictResolveWorkflowExecutionScope, caller, latency, retErr)
 	}()
 	return p.persistence.ConflictResolveWorkflowExecution(ctx, request)
 }
@@ -297,7 +304,7 @@ func (p *executionPersistenceClient) DeleteWorkflowExecution(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteWorkflowExecutionScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteWorkflowExecutionScope, caller, latency, retErr)
 	}()
 	return p.persistence.DeleteWorkflowExecution(ctx, request)
 }
@@ -311,7 +318,7 @@ func (p *executionPersistenceClient) DeleteCurrentWorkflowExecution(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteCurrentWorkflowExecutionScope, caller, latency, retErr)
 	}()
 	return p.persistence.DeleteCurrentWorkflowExecution(ctx, request)
 }
@@ -325,7 +332,7 @@ func (p *executionPersistenceClient) GetCurrentExecution(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetCurrentExecutionScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetCurrentExecutionScope, caller, latency, retErr)
 	}()
 	return p.persistence.GetCurrentExecution(ctx, request)
 }
@@ -339,7 +346,7 @@ func (p *executionPersistenceClient) ListConcreteExecutions(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceListConcreteExecutionsScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceListConcreteExecutionsScope, caller, latency, retErr)
 	}()
 	return p.persistence.ListConcreteExecutions(ctx, request)
 }
@@ -380,7 +387,7 @@ func (p *executionPersistenceClient) AddHistoryTasks(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceAddTasksScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceAddTasksScope, caller, latency, retErr)
 	}()
 	return p.persistence.AddHistoryTasks(ctx, request)
 }
@@ -410,7 +417,7 @@ func (p *executionPersistenceClient) GetHistoryTasks(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
-		p.recordRequestMetrics(operation, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, operation, caller, latency, retErr)
 	}()
 	return p.persistence.GetHistoryTasks(ctx, request)
 }
@@ -440,7 +447,7 @@ func (p *executionPersistenceClient) CompleteHistoryTask(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
-		p.recordRequestMetrics(operation, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, operation, caller, latency, retErr)
 	}()
 	return p.persistence.CompleteHistoryTask(ctx, request)
 }
@@ -470,7 +477,7 @@ func (p *executionPersistenceClient) RangeCompleteHistoryTasks(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
-		p.recordRequestMetrics(operation, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, operation, caller, latency, retErr)
 	}()
 	return p.persistence.RangeCompleteHistoryTasks(ctx, request)
 }
@@ -484,7 +491,7 @@ func (p *executionPersistenceClient) PutReplicationTaskToDLQ(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistencePutReplicationTaskToDLQScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistencePutReplicationTaskToDLQScope, caller, latency, retErr)
 	}()
 	return p.persistence.PutReplicationTaskToDLQ(ctx, request)
 }
@@ -498,7 +505,7 @@ func (p *executionPersistenceClient) GetReplicationTasksFromDLQ(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetReplicationTasksFromDLQScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetReplicationTasksFromDLQScope, caller, latency, retErr)
 	}()
 	return p.persistence.GetReplicationTasksFromDLQ(ctx, request)
 }
@@ -512,7 +519,7 @@ func (p *executionPersistenceClient) DeleteReplicationTaskFromDLQ(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteReplicationTaskFromDLQScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteReplicationTaskFromDLQScope, caller, latency, retErr)
 	}()
 	return p.persistence.DeleteReplicationTaskFromDLQ(ctx, request)
 }
@@ -526,7 +533,7 @@ func (p *executionPersistenceClient) RangeDeleteReplicationTaskFromDLQ(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceRangeDeleteReplicationTaskFromDLQScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceRangeDeleteReplicationTaskFromDLQScope, caller, latency, retErr)
 	}()
 	return p.persistence.RangeDeleteReplicationTaskFromDLQ(ctx, request)
 }
@@ -540,7 +547,7 @@ func (p *executionPersistenceClient) IsReplicationDLQEmpty(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetReplicationTasksFromDLQScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetReplicationTasksFromDLQScope, caller, latency, retErr)
 	}()
 	return p.persistence.IsReplicationDLQEmpty(ctx, request)
 }
@@ -562,7 +569,7 @@ func (p *taskPersistenceClient) CreateTasks(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceCreateTasksScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceCreateTasksScope, caller, latency, retErr)
 	}()
 	return p.persistence.CreateTasks(ctx, request)
 }
@@ -576,7 +583,7 @@ func (p *taskPersistenceClient) GetTasks(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetTasksScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetTasksScope, caller, latency, retErr)
 	}()
 	return p.persistence.GetTasks(ctx, request)
 }
@@ -590,7 +597,7 @@ func (p *taskPersistenceClient) CompleteTask(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceCompleteTaskScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceCompleteTaskScope, caller, latency, retErr)
 	}()
 	return p.persistence.CompleteTask(ctx, request)
 }
@@ -604,7 +611,7 @@ func (p *taskPersistenceClient) CompleteTasksLessThan(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceCompleteTasksLessThanScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceCompleteTasksLessThanScope, caller, latency, retErr)
 	}()
 	return p.persistence.CompleteTasksLessThan(ctx, request)
 }
@@ -618,7 +625,7 @@ func (p *taskPersistenceClient) CreateTaskQueue(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceCreateTaskQueueScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceCreateTaskQueueScope, caller, latency, retErr)
 	}()
 	return p.persistence.CreateTaskQueue(ctx, request)
 }
@@ -632,7 +639,7 @@ func (p *taskPersistenceClient) UpdateTaskQueue(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpdateTaskQueueScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpdateTaskQueueScope, caller, latency, retErr)
 	}()
 	return p.persistence.UpdateTaskQueue(ctx, request)
 }
@@ -646,7 +653,7 @@ func (p *taskPersistenceClient) GetTaskQueue(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetTaskQueueScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetTaskQueueScope, caller, latency, retErr)
 	}()
 	return p.persistence.GetTaskQueue(ctx, request)
 }
@@ -660,7 +667,7 @@ func (p *taskPersistenceClient) ListTaskQueue(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceListTaskQueueScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceListTaskQueueScope, caller, latency, retErr)
 	}()
 	return p.persistence.ListTaskQueue(ctx, request)
 }
@@ -674,7 +681,7 @@ func (p *taskPersistenceClient) DeleteTaskQueue(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteTaskQueueScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteTaskQueueScope, caller, latency, retErr)
 	}()
 	return p.persistence.DeleteTaskQueue(ctx, request)
 }
@@ -688,7 +695,7 @@ func (p *taskPersistenceClient) GetTaskQueueUserData(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetTaskQueueUserDataScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetTaskQueueUserDataScope, caller, latency, retErr)
 	}()
 	return p.persistence.GetTaskQueueUserData(ctx, request)
 }
@@ -702,7 +709,7 @@ func (p *taskPersistenceClient) UpdateTaskQueueUserData(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpdateTaskQueueUserDataScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpdateTaskQueueUserDataScope, caller, latency, retErr)
 	}()
 	return p.persistence.UpdateTaskQueueUserData(ctx, request)
 }
@@ -716,7 +723,7 @@ func (p *taskPersistenceClient) ListTaskQueueUserDataEntries(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceListTaskQueueUserDataEntriesScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceListTaskQueueUserDataEntriesScope, caller, latency, retErr)
 	}()
 	return p.persistence.ListTaskQueueUserDataEntries(ctx, request)
 }
@@ -727,7 +734,7 @@ func (p *taskPersistenceClient) GetTaskQueuesByBuildId(ctx context.Context, requ
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetTaskQueuesByBuildIdScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetTaskQueuesByBuildIdScope, caller, latency, retErr)
 	}()
 	return p.persistence.GetTaskQueuesByBuildId(ctx, request)
 }
@@ -738,7 +745,7 @@ func (p *taskPersistenceClient) CountTaskQueuesByBuildId(ctx context.Context, re
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceCountTaskQueuesByBuildIdScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceCountTaskQueuesByBuildIdScope, caller, latency, retErr)
 	}()
 	return p.persistence.CountTaskQueuesByBuildId(ctx, request)
 }
@@ -760,7 +767,7 @@ func (p *metadataPersistenceClient) CreateNamespace(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceCreateNamespaceScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceCreateNamespaceScope, caller, latency, retErr)
 	}()
 	return p.persistence.CreateNamespace(ctx, request)
 }
@@ -774,7 +781,7 @@ func (p *metadataPersistenceClient) GetNamespace(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetNamespaceScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetNamespaceScope, caller, latency, retErr)
 	}()
 	return p.persistence.GetNamespace(ctx, request)
 }
@@ -788,7 +795,7 @@ func (p *metadataPersistenceClient) UpdateNamespace(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpdateNamespaceScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpdateNamespaceScope, caller, latency, retErr)
 	}()
 	return p.persistence.UpdateNamespace(ctx, request)
 }
@@ -802,7 +809,7 @@ func (p *metadataPersistenceClient) RenameNamespace(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceRenameNamespaceScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceRenameNamespaceScope, caller, latency, retErr)
 	}()
 	return p.persistence.RenameNamespace(ctx, request)
 }
@@ -816,7 +823,7 @@ func (p *metadataPersistenceClient) DeleteNamespace(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteNamespaceScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteNamespaceScope, caller, latency, retErr)
 	}()
 	return p.persistence.DeleteNamespace(ctx, request)
 }
@@ -830,7 +837,7 @@ func (p *metadataPersistenceClient) DeleteNamespaceByName(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteNamespaceByNameScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteNamespaceByNameScope, caller, latency, retErr)
 	}()
 	return p.persistence.DeleteNamespaceByName(ctx, request)
 }
@@ -844,7 +851,7 @@ func (p *metadataPersistenceClient) ListNamespaces(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceListNamespacesScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceListNamespacesScope, caller, latency, retErr)
 	}()
 	return p.persistence.ListNamespaces(ctx, request)
 }
@@ -857,7 +864,7 @@ func (p *metadataPersistenceClient) GetMetadata(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetMetadataScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetMetadataScope, caller, latency, retErr)
 	}()
 	return p.persistence.GetMetadata(ctx)
 }
@@ -876,11 +883,26 @@ func (p *executionPersistenceClient) AppendHistoryNodes(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceAppendHistoryNodesScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceAppendHistoryNodesScope, caller, latency, retErr)
 	}()
 	return p.persistence.AppendHistoryNodes(ctx, request)
 }
 
+// AppendHistoryNodesBatch add nodes to multiple branches of history node table
+func (p *executionPersistenceClient) AppendHistoryNodesBatch(
+	ctx context.Context,
+	request *AppendHistoryNodesBatchRequest,
+) (_ *AppendHistoryNodesBatchResponse, retErr error) {
+	caller := headers.GetCallerInfo(ctx).CallerName
+	startTime := time.Now().UTC()
+	defer func() {
+		latency := time.Since(startTime)
+		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceAppendHistoryNodesBatchScope, caller, latency, retErr)
+	}()
+	return p.persistence.AppendHistoryNodesBatch(ctx, request)
+}
+
 // AppendRawHistoryNodes add a node to history node table
 func (p *executionPersistenceClient) AppendRawHistoryNodes(
 	ctx context.Context,
@@ -891,7 +913,7 @@ func (p *executionPersistenceClient) AppendRawHistoryNodes(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceAppendRawHistoryNodesScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceAppendRawHistoryNodesScope, caller, latency, retErr)
 	}()
 	return p.persistence.AppendRawHistoryNodes(ctx, request)
 }
@@ -906,7 +928,7 @@ func (p *executionPersistenceClient) ReadHistoryBranch(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceReadHistoryBranchScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceReadHistoryBranchScope, caller, latency, retErr)
 	}()
 	return p.persistence.ReadHistoryBranch(ctx, request)
 }
@@ -920,7 +942,7 @@ func (p *executionPersistenceClient) ReadHistoryBranchReverse(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceReadHistoryBranchReverseScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceReadHistoryBranchReverseScope, caller, latency, retErr)
 	}()
 	return p.persistence.ReadHistoryBranchReverse(ctx, request)
 }
@@ -935,7 +957,7 @@ func (p *executionPersistenceClient) ReadHistoryBranchByBatch(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceReadHistoryBranchScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceReadHistoryBranchScope, caller, latency, retErr)
 	}()
 	return p.persistence.ReadHistoryBranchByBatch(ctx, request)
 }
@@ -950,7 +972,7 @@ func (p *executionPersistenceClient) ReadRawHistoryBranch(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceReadRawHistoryBranchScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceReadRawHistoryBranchScope, caller, latency, retErr)
 	}()
 	return p.persistence.ReadRawHistoryBranch(ctx, request)
 }
@@ -965,7 +987,7 @@ func (p *executionPersistenceClient) ForkHistoryBranch(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceForkHistoryBranchScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceForkHistoryBranchScope, caller, latency, retErr)
 	}()
 	return p.persistence.ForkHistoryBranch(ctx, request)
 }
@@ -980,7 +1002,7 @@ func (p *executionPersistenceClient) DeleteHistoryBranch(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteHistoryBranchScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteHistoryBranchScope, caller, latency, retErr)
 	}()
 	return p.persistence.DeleteHistoryBranch(ctx, request)
 }
@@ -995,7 +1017,7 @@ func (p *executionPersistenceClient) TrimHistoryBranch(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceTrimHistoryBranchScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceTrimHistoryBranchScope, caller, latency, retErr)
 	}()
 	return p.persistence.TrimHistoryBranch(ctx, request)
 }
@@ -1009,7 +1031,7 @@ func (p *executionPersistenceClient) GetAllHistoryTreeBranches(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetAllHistoryTreeBranchesScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetAllHistoryTreeBranchesScope, caller, latency, retErr)
 	}()
 	return p.persistence.GetAllHistoryTreeBranches(ctx, request)
 }
@@ -1024,7 +1046,7 @@ func (p *executionPersistenceClient) GetHistoryTree(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetHistoryTreeScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetHistoryTreeScope, caller, latency, retErr)
 	}()
 	return p.persistence.GetHistoryTree(ctx, request)
 }
@@ -1045,7 +1067,7 @@ func (p *queuePersistenceClient) EnqueueMessage(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceEnqueueMessageScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceEnqueueMessageScope, caller, latency, retErr)
 	}()
 	return p.persistence.EnqueueMessage(ctx, blob)
 }
@@ -1060,7 +1082,7 @@ func (p *queuePersistenceClient) ReadMessages(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceReadQueueMessagesScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceReadQueueMessagesScope, caller, latency, retErr)
 	}()
 	return p.persistence.ReadMessages(ctx, lastMessageID, maxCount)
 }
@@ -1074,7 +1096,7 @@ func (p *queuePersistenceClient) UpdateAckLevel(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpdateAckLevelScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpdateAckLevelScope, caller, latency, retErr)
 	}()
 	return p.persistence.UpdateAckLevel(ctx, metadata)
 }
@@ -1087,7 +1109,7 @@ func (p *queuePersistenceClient) GetAckLevels(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetAckLevelScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetAckLevelScope, caller, latency, retErr)
 	}()
 	return p.persistence.GetAckLevels(ctx)
 }
@@ -1101,7 +1123,7 @@ func (p *queuePersistenceClient) DeleteMessagesBefore(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteMessagesBeforeScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteMessagesBeforeScope, caller, latency, retErr)
 	}()
 	return p.persistence.DeleteMessagesBefore(ctx, messageID)
 }
@@ -1115,7 +1137,7 @@ func (p *queuePersistenceClient) EnqueueMessageToDLQ(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceEnqueueMessageToDLQScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceEnqueueMessageToDLQScope, caller, latency, retErr)
 	}()
 	return p.persistence.EnqueueMessageToDLQ(ctx, blob)
 }
@@ -1132,7 +1154,7 @@ func (p *queuePersistenceClient) ReadMessagesFromDLQ(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceReadMessagesFromDLQScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceReadMessagesFromDLQScope, caller, latency, retErr)
 	}()
 	return p.persistence.ReadMessagesFromDLQ(ctx, firstMessageID, lastMessageID, pageSize, pageToken)
 }
@@ -1146,7 +1168,7 @@ func (p *queuePersistenceClient) DeleteMessageFromDLQ(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteMessageFromDLQScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteMessageFromDLQScope, caller, latency, retErr)
 	}()
 	return p.persistence.DeleteMessageFromDLQ(ctx, messageID)
 }
@@ -1161,7 +1183,7 @@ func (p *queuePersistenceClient) RangeDeleteMessagesFromDLQ(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceRangeDeleteMessagesFromDLQScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceRangeDeleteMessagesFromDLQScope, caller, latency, retErr)
 	}()
 	return p.persistence.RangeDeleteMessagesFromDLQ(ctx, firstMessageID, lastMessageID)
 }
@@ -1175,7 +1197,7 @@ func (p *queuePersistenceClient) UpdateDLQAckLevel(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpdateDLQAckLevelScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpdateDLQAckLevelScope, caller, latency, retErr)
 	}()
 	return p.persistence.UpdateDLQAckLevel(ctx, metadata)
 }
@@ -1188,7 +1210,7 @@ func (p *queuePersistenceClient) GetDLQAckLevels(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetDLQAckLevelScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetDLQAckLevelScope, caller, latency, retErr)
 	}()
 	return p.persistence.GetDLQAckLevels(ctx)
 }
@@ -1210,7 +1232,7 @@ func (p *clusterMetadataPersistenceClient) ListClusterMetadata(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceListClusterMetadataScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceListClusterMetadataScope, caller, latency, retErr)
 	}()
 	return p.persistence.ListClusterMetadata(ctx, request)
 }
@@ -1223,7 +1245,7 @@ func (p *clusterMetadataPersistenceClient) GetCurrentClusterMetadata(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetCurrentClusterMetadataScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetCurrentClusterMetadataScope, caller, latency, retErr)
 	}()
 	return p.persistence.GetCurrentClusterMetadata(ctx)
 }
@@ -1237,7 +1259,7 @@ func (p *clusterMetadataPersistenceClient) GetClusterMetadata(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetClusterMetadataScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetClusterMetadataScope, caller, latency, retErr)
 	}()
 	return p.persistence.GetClusterMetadata(ctx, request)
 }
@@ -1251,7 +1273,7 @@ func (p *clusterMetadataPersistenceClient) SaveClusterMetadata(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceSaveClusterMetadataScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceSaveClusterMetadataScope, caller, latency, retErr)
 	}()
 	return p.persistence.SaveClusterMetadata(ctx, request)
 }
@@ -1265,7 +1287,7 @@ func (p *clusterMetadataPersistenceClient) DeleteClusterMetadata(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteClusterMetadataScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteClusterMetadataScope, caller, latency, retErr)
 	}()
 	return p.persistence.DeleteClusterMetadata(ctx, request)
 }
@@ -1283,7 +1305,7 @@ func (p *clusterMetadataPersistenceClient) GetClusterMembers(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetClusterMembersScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetClusterMembersScope, caller, latency, retErr)
 	}()
 	return p.persistence.GetClusterMembers(ctx, request)
 }
@@ -1297,7 +1319,7 @@ func (p *clusterMetadataPersistenceClient) UpsertClusterMembership(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpsertClusterMembershipScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpsertClusterMembershipScope, caller, latency, retErr)
 	}()
 	return p.persistence.UpsertClusterMembership(ctx, request)
 }
@@ -1311,7 +1333,7 @@ func (p *clusterMetadataPersistenceClient) PruneClusterMembership(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistencePruneClusterMembershipScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistencePruneClusterMembershipScope, caller, latency, retErr)
 	}()
 	return p.persistence.PruneClusterMembership(ctx, request)
 }
@@ -1325,15 +1347,24 @@ func (p *metadataPersistenceClient) InitializeSystemNamespaces(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceInitializeSystemNamespaceScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceInitializeSystemNamespaceScope, caller, latency, retErr)
 	}()
 	return p.persistence.InitializeSystemNamespaces(ctx, currentClusterName)
 }
 
-func (p *metricEmitter) recordRequestMetrics(operation string, caller string, latency time.Duration, err error) {
+func (p *metricEmitter) recordRequestMetrics(
+	ctx context.Context,
+	operation string,
+	caller string,
+	latency time.Duration,
+	err error,
+) {
+	if p.namespaceUsage != nil {
+		caller = p.namespaceUsage.Record(caller, latency, err)
+	}
 	handler := p.metricsHandler.WithTags(metrics.OperationTag(operation), metrics.NamespaceTag(caller))
 	handler.Counter(metrics.PersistenceRequests.GetMetricName()).Record(1)
-	handler.Timer(metrics.PersistenceLatency.GetMetricName()).Record(latency)
+	handler.Timer(metrics.PersistenceLatency.GetMetricName()).Record(latency, metrics.WithExemplar(ctx)...)
 	updateErrorMetric(handler, p.logger, operation, err)
 }
 
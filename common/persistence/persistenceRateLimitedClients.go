@@ -712,6 +712,17 @@ func (p *executionRateLimitedPersistenceClient) AppendHistoryNodes(
 	return p.persistence.AppendHistoryNodes(ctx, request)
 }
 
+// AppendHistoryNodesBatch add nodes to multiple branches of history node table
+func (p *executionRateLimitedPersistenceClient) AppendHistoryNodesBatch(
+	ctx context.Context,
+	request *AppendHistoryNodesBatchRequest,
+) (*AppendHistoryNodesBatchResponse, error) {
+	if ok := allow(ctx, "AppendHistoryNodesBatch", request.ShardID, p.rateLimiter); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	return p.persistence.AppendHistoryNodesBatch(ctx, request)
+}
+
 // AppendRawHistoryNodes add a node to history node table
 func (p *executionRateLimitedPersistenceClient) AppendRawHistoryNodes(
 	ctx context.Context,
@@ -486,6 +486,22 @@ func (p *executionRetryablePersistenceClient) AppendHistoryNodes(
 	return response, err
 }
 
+// AppendHistoryNodesBatch add nodes to multiple branches of history node table
+func (p *executionRetryablePersistenceClient) AppendHistoryNodesBatch(
+	ctx context.Context,
+	request *AppendHistoryNodesBatchRequest,
+) (*AppendHistoryNodesBatchResponse, error) {
+	var response *AppendHistoryNodesBatchResponse
+	op := func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.AppendHistoryNodesBatch(ctx, request)
+		return err
+	}
+
+	err := backoff.ThrottleRetryContext(ctx, op, p.policy, p.isRetryable)
+	return response, err
+}
+
 // AppendRawHistoryNodes add a node to history node table
 func (p *executionRetryablePersistenceClient) AppendRawHistoryNodes(
 	ctx context.Context,
@@ -0,0 +1,274 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package plugin
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/gogo/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"go.temporal.io/server/common/auth"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	p "go.temporal.io/server/common/persistence"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
+)
+
+const (
+	// storeInfoTimeout bounds the calls made when a store is created
+	storeInfoTimeout = 10 * time.Second
+)
+
+type (
+	// Factory vends stores served by a persistence plugin
+	Factory struct {
+		conn   *grpc.ClientConn
+		logger log.Logger
+	}
+
+	client struct {
+		conn      *grpc.ClientConn
+		store     string
+		queueType p.QueueType
+		name      string
+		logger    log.Logger
+	}
+)
+
+// NewFactory returns a factory of the stores served by the plugin at the configured address.
+// The connection is established in the background, stores fail to be created until it is.
+func NewFactory(
+	cfg config.PluginDatastoreConfig,
+	logger log.Logger,
+) (*Factory, error) {
+	credentialsOption := grpc.WithTransportCredentials(insecure.NewCredentials())
+	if cfg.TLS != nil && cfg.TLS.Enabled {
+		tlsConfig, err := newTLSConfig(cfg.TLS)
+		if err != nil {
+			return nil, err
+		}
+		credentialsOption = grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))
+	}
+
+	conn, err := grpc.Dial(
+		cfg.Address,
+		credentialsOption,
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec{})),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &Factory{
+		conn:   conn,
+		logger: logger,
+	}, nil
+}
+
+// Close closes the connection to the plugin
+func (f *Factory) Close() {
+	if err := f.conn.Close(); err != nil {
+		f.logger.Warn("Unable to close persistence plugin connection.", tag.Error(err))
+	}
+}
+
+// NewTaskStore returns a task store served by the plugin
+func (f *Factory) NewTaskStore() (p.TaskStore, error) {
+	c, err := f.newClient(taskStoreName, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &taskStore{client: c}, nil
+}
+
+// NewShardStore returns a shard store served by the plugin
+func (f *Factory) NewShardStore() (p.ShardStore, error) {
+	c, err := f.newClient(shardStoreName, 0)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), storeInfoTimeout)
+	defer cancel()
+	var clusterName string
+	if err := c.invoke(ctx, "GetClusterName", nil, &clusterName); err != nil {
+		return nil, err
+	}
+	return &shardStore{client: c, clusterName: clusterName}, nil
+}
+
+// NewMetadataStore returns a metadata store served by the plugin
+func (f *Factory) NewMetadataStore() (p.MetadataStore, error) {
+	c, err := f.newClient(metadataStoreName, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &metadataStore{client: c}, nil
+}
+
+// NewExecutionStore returns an execution store served by the plugin
+func (f *Factory) NewExecutionStore() (p.ExecutionStore, error) {
+	c, err := f.newClient(executionStoreName, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &executionStore{client: c, HistoryBranchUtilImpl: &p.HistoryBranchUtilImpl{}}, nil
+}
+
+// NewQueue returns a queue served by the plugin
+func (f *Factory) NewQueue(queueType p.QueueType) (p.Queue, error) {
+	c, err := f.newClient(queueName, queueType)
+	if err != nil {
+		return nil, err
+	}
+	return &queue{client: c}, nil
+}
+
+// NewClusterMetadataStore returns a cluster metadata store served by the plugin
+func (f *Factory) NewClusterMetadataStore() (p.ClusterMetadataStore, error) {
+	c, err := f.newClient(clusterMetadataStoreName, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &clusterMetadataStore{client: c}, nil
+}
+
+func (f *Factory) newClient(
+	store string,
+	queueType p.QueueType,
+) (*client, error) {
+	c := &client{
+		conn:      f.conn,
+		store:     store,
+		queueType: queueType,
+		logger:    f.logger,
+	}
+	if store == queueName {
+		// queues have no name
+		return c, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), storeInfoTimeout)
+	defer cancel()
+	if err := c.invoke(ctx, "GetName", nil, &c.name); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *client) GetName() string {
+	return c.name
+}
+
+// Close is a noop, stores are closed by the plugin when it stops
+func (c *client) Close() {
+}
+
+// invoke calls the method of the remote store with the args, and decodes its results other
+// than the error into the pointers given as results
+func (c *client) invoke(
+	ctx context.Context,
+	method string,
+	args []interface{},
+	results ...interface{},
+) error {
+	argValues := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		argValues[i] = reflect.ValueOf(arg)
+	}
+	data, err := encodeValues(argValues)
+	if err != nil {
+		return err
+	}
+
+	response := &invokeResponse{}
+	if err := c.conn.Invoke(ctx, invokeMethod, &invokeRequest{
+		Version:   ProtocolVersion,
+		Store:     c.store,
+		QueueType: c.queueType,
+		Method:    method,
+		Args:      data,
+	}, response); err != nil {
+		return serviceerrors.FromStatus(status.Convert(err))
+	}
+	if response.Error != nil {
+		return decodeError(response.Error)
+	}
+
+	resultTypes := make([]reflect.Type, len(results))
+	for i, result := range results {
+		resultTypes[i] = reflect.TypeOf(result).Elem()
+	}
+	values, err := decodeValues(response.Results, resultTypes)
+	if err != nil {
+		return fmt.Errorf("unable to decode results of %v.%v: %w", c.store, method, err)
+	}
+	for i, result := range results {
+		reflect.ValueOf(result).Elem().Set(values[i])
+	}
+	return nil
+}
+
+// invokeHint calls a method of the remote store which can't fail
+func (c *client) invokeHint(
+	ctx context.Context,
+	method string,
+	args []interface{},
+) {
+	if err := c.invoke(ctx, method, args); err != nil {
+		c.logger.Warn("Persistence plugin call failed.", tag.Name(c.store+"."+method), tag.Error(err))
+	}
+}
+
+func newTLSConfig(cfg *auth.TLS) (*tls.Config, error) {
+	tlsConfig := auth.NewTLSConfigForServer(cfg.ServerName, cfg.EnableHostVerification)
+	if cfg.CaFile != "" {
+		rootCertPool := x509.NewCertPool()
+		pem, err := os.ReadFile(cfg.CaFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load CA files: %v", err)
+		}
+		if ok := rootCertPool.AppendCertsFromPEM(pem); !ok {
+			return nil, fmt.Errorf("failed to append CA file")
+		}
+		tlsConfig.RootCAs = rootCertPool
+	}
+	if cfg.CertFile != "" && cfg.KeyFile != "" {
+		certs, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load tls x509 key pair: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certs}
+	}
+	return tlsConfig, nil
+}
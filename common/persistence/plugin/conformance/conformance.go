@@ -0,0 +1,194 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package conformance verifies that a persistence plugin behaves as the server expects, by running
+// the persistence test suites against the stores of its DataStoreFactory. Plugins should run it
+// from their own tests, against an empty database:
+//
+//	func TestConformance(t *testing.T) {
+//		conformance.Run(t, newFactory(t), log.NewTestLogger())
+//	}
+package conformance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence/client"
+	persistencetests "go.temporal.io/server/common/persistence/persistence-tests"
+	"go.temporal.io/server/common/persistence/plugin"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/tests"
+	"go.temporal.io/server/common/resolver"
+)
+
+const dataStoreName = "conformance"
+
+type (
+	// testCluster is the persistence test cluster of the factory, whose database is managed by the plugin
+	testCluster struct{}
+
+	// abstractDataStoreFactory returns the factory to the persistence test base
+	abstractDataStoreFactory struct {
+		factory plugin.DataStoreFactory
+	}
+
+	// sharedDataStoreFactory is not closed with the test base, the factory is shared by all suites
+	sharedDataStoreFactory struct {
+		plugin.DataStoreFactory
+	}
+)
+
+// Run runs the shard, execution, history, task queue, metadata, cluster metadata and queue store
+// suites against the stores created by the factory. The factory can either be the plugin implementation itself, or the plugin.Factory
+// connected to a running plugin, which also covers the encoding of requests and responses.
+func Run(
+	t *testing.T,
+	factory plugin.DataStoreFactory,
+	logger log.Logger,
+) {
+	serializer := serialization.NewSerializer()
+
+	t.Run("Shard", func(t *testing.T) {
+		shardStore, err := factory.NewShardStore()
+		if err != nil {
+			t.Fatalf("unable to create shard store: %v", err)
+		}
+		suite.Run(t, tests.NewShardSuite(t, shardStore, serializer, logger))
+	})
+
+	t.Run("ExecutionMutableState", func(t *testing.T) {
+		shardStore, err := factory.NewShardStore()
+		if err != nil {
+			t.Fatalf("unable to create shard store: %v", err)
+		}
+		executionStore, err := factory.NewExecutionStore()
+		if err != nil {
+			t.Fatalf("unable to create execution store: %v", err)
+		}
+		suite.Run(t, tests.NewExecutionMutableStateSuite(t, shardStore, executionStore, serializer, logger))
+	})
+
+	t.Run("ExecutionMutableStateTask", func(t *testing.T) {
+		shardStore, err := factory.NewShardStore()
+		if err != nil {
+			t.Fatalf("unable to create shard store: %v", err)
+		}
+		executionStore, err := factory.NewExecutionStore()
+		if err != nil {
+			t.Fatalf("unable to create execution store: %v", err)
+		}
+		suite.Run(t, tests.NewExecutionMutableStateTaskSuite(t, shardStore, executionStore, serializer, logger))
+	})
+
+	t.Run("HistoryEvents", func(t *testing.T) {
+		executionStore, err := factory.NewExecutionStore()
+		if err != nil {
+			t.Fatalf("unable to create execution store: %v", err)
+		}
+		suite.Run(t, tests.NewHistoryEventsSuite(t, executionStore, logger))
+	})
+
+	t.Run("TaskQueue", func(t *testing.T) {
+		taskStore, err := factory.NewTaskStore()
+		if err != nil {
+			t.Fatalf("unable to create task store: %v", err)
+		}
+		suite.Run(t, tests.NewTaskQueueSuite(t, taskStore, logger))
+	})
+
+	t.Run("TaskQueueTask", func(t *testing.T) {
+		taskStore, err := factory.NewTaskStore()
+		if err != nil {
+			t.Fatalf("unable to create task store: %v", err)
+		}
+		suite.Run(t, tests.NewTaskQueueTaskSuite(t, taskStore, logger))
+	})
+
+	t.Run("Metadata", func(t *testing.T) {
+		s := new(persistencetests.MetadataPersistenceSuiteV2)
+		setupTestBase(&s.TestBase, factory, logger)
+		suite.Run(t, s)
+	})
+
+	t.Run("ClusterMetadata", func(t *testing.T) {
+		s := new(persistencetests.ClusterMetadataManagerSuite)
+		setupTestBase(&s.TestBase, factory, logger)
+		suite.Run(t, s)
+	})
+
+	t.Run("Queue", func(t *testing.T) {
+		s := new(persistencetests.QueuePersistenceSuite)
+		setupTestBase(&s.TestBase, factory, logger)
+		suite.Run(t, s)
+	})
+}
+
+// setupTestBase sets up a persistence test base using the stores created by the factory
+func setupTestBase(
+	testBase *persistencetests.TestBase,
+	factory plugin.DataStoreFactory,
+	logger log.Logger,
+) {
+	*testBase = persistencetests.NewTestBaseForCluster(&testCluster{}, logger)
+	testBase.AbstractDataStoreFactory = &abstractDataStoreFactory{factory: factory}
+	testBase.Setup(nil)
+}
+
+// SetupTestDatabase implements persistencetests.PersistenceTestCluster
+func (c *testCluster) SetupTestDatabase() {}
+
+// TearDownTestDatabase implements persistencetests.PersistenceTestCluster
+func (c *testCluster) TearDownTestDatabase() {}
+
+// Config implements persistencetests.PersistenceTestCluster
+func (c *testCluster) Config() config.Persistence {
+	return config.Persistence{
+		DefaultStore: dataStoreName,
+		DataStores: map[string]config.DataStore{
+			dataStoreName: {CustomDataStoreConfig: &config.CustomDatastoreConfig{Name: dataStoreName}},
+		},
+		TransactionSizeLimit: dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit),
+	}
+}
+
+// NewFactory implements client.AbstractDataStoreFactory
+func (f *abstractDataStoreFactory) NewFactory(
+	_ config.CustomDatastoreConfig,
+	_ resolver.ServiceResolver,
+	_ string,
+	_ log.Logger,
+	_ metrics.Handler,
+) client.DataStoreFactory {
+	return &sharedDataStoreFactory{DataStoreFactory: f.factory}
+}
+
+// Close does not close the shared factory
+func (f *sharedDataStoreFactory) Close() {}
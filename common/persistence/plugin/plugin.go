@@ -0,0 +1,320 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package plugin serves persistence stores out of process, so that datastores other than the ones
+// shipped with the server can be supported without forking it. A plugin is a gRPC sidecar built
+// against this package: it implements DataStoreFactory and exposes it with Server, and the server
+// connects to it through a datastore configured with a plugin section.
+//
+// The protocol is the store interfaces of the persistence package (ShardStore, TaskStore, MetadataStore,
+// ClusterMetadataStore, ExecutionStore and Queue) called by name, with gob encoded arguments and results.
+// Changes to these interfaces are breaking changes for plugins and bump ProtocolVersion. Plugins are
+// expected to use the default history branch token format of persistence.HistoryBranchUtilImpl.
+//
+// The conformance sub-package runs the persistence test suites against a DataStoreFactory and should be
+// run by plugins against their implementation.
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"reflect"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"google.golang.org/grpc"
+
+	p "go.temporal.io/server/common/persistence"
+)
+
+const (
+	// ProtocolVersion is the version of the plugin protocol, a server only talks to plugins of the same version
+	ProtocolVersion = 1
+
+	serviceName  = "temporal.server.persistence.plugin.v1.DataStoreService"
+	invokeMethod = "/" + serviceName + "/Invoke"
+
+	shardStoreName           = "ShardStore"
+	taskStoreName            = "TaskStore"
+	metadataStoreName        = "MetadataStore"
+	clusterMetadataStoreName = "ClusterMetadataStore"
+	executionStoreName       = "ExecutionStore"
+	queueName                = "Queue"
+
+	// getOrCreateShardWithInfoMethod is ShardStore.GetOrCreateShard with the results of the
+	// CreateShardInfo function of the request as additional arguments
+	getOrCreateShardWithInfoMethod = "GetOrCreateShardWithInfo"
+)
+
+type (
+	// DataStoreFactory is implemented by plugins to create the stores they serve.
+	// It is the same interface as the DataStoreFactory of the persistence client package.
+	DataStoreFactory interface {
+		Close()
+		NewTaskStore() (p.TaskStore, error)
+		NewShardStore() (p.ShardStore, error)
+		NewMetadataStore() (p.MetadataStore, error)
+		NewExecutionStore() (p.ExecutionStore, error)
+		NewQueue(queueType p.QueueType) (p.Queue, error)
+		NewClusterMetadataStore() (p.ClusterMetadataStore, error)
+	}
+
+	invokeRequest struct {
+		Version   int32
+		Store     string
+		QueueType p.QueueType
+		Method    string
+		Args      []byte
+	}
+
+	invokeResponse struct {
+		Results []byte
+		Error   *remoteError
+	}
+
+	// remoteError carries the persistence errors the server checks the type of, other errors
+	// are returned as gRPC status errors
+	remoteError struct {
+		Type string
+		Data []byte
+	}
+
+	invoker interface {
+		invoke(ctx context.Context, request *invokeRequest) (*invokeResponse, error)
+	}
+
+	codec struct{}
+)
+
+var (
+	storeTypes = map[string]reflect.Type{
+		shardStoreName:           reflect.TypeOf((*p.ShardStore)(nil)).Elem(),
+		taskStoreName:            reflect.TypeOf((*p.TaskStore)(nil)).Elem(),
+		metadataStoreName:        reflect.TypeOf((*p.MetadataStore)(nil)).Elem(),
+		clusterMetadataStoreName: reflect.TypeOf((*p.ClusterMetadataStore)(nil)).Elem(),
+		executionStoreName:       reflect.TypeOf((*p.ExecutionStore)(nil)).Elem(),
+		queueName:                reflect.TypeOf((*p.Queue)(nil)).Elem(),
+	}
+
+	// localMethods are never invoked on the plugin
+	localMethods = map[string]struct{}{
+		"Close":                {},
+		"GetHistoryBranchUtil": {},
+	}
+
+	errorTypes = map[string]reflect.Type{}
+
+	dataBlobType       = reflect.TypeOf(commonpb.DataBlob{})
+	contextType        = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorInterfaceType = reflect.TypeOf((*error)(nil)).Elem()
+
+	serviceDesc = grpc.ServiceDesc{
+		ServiceName: serviceName,
+		HandlerType: (*invoker)(nil),
+		Methods: []grpc.MethodDesc{
+			{
+				MethodName: "Invoke",
+				Handler:    invokeHandler,
+			},
+		},
+		Streams: []grpc.StreamDesc{},
+	}
+)
+
+func init() {
+	for _, err := range []error{
+		&p.InvalidPersistenceRequestError{},
+		&p.AppendHistoryTimeoutError{},
+		&p.CurrentWorkflowConditionFailedError{},
+		&p.WorkflowConditionFailedError{},
+		&p.ConditionFailedError{},
+		&p.ShardAlreadyExistError{},
+		&p.ShardOwnershipLostError{},
+		&p.TimeoutError{},
+		&p.TransactionSizeLimitError{},
+	} {
+		errorType := reflect.TypeOf(err).Elem()
+		errorTypes[errorType.Name()] = errorType
+	}
+}
+
+func invokeHandler(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	request := &invokeRequest{}
+	if err := dec(request); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(invoker).invoke(ctx, request)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: invokeMethod,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(invoker).invoke(ctx, req.(*invokeRequest))
+	}
+	return interceptor(ctx, request, info, handler)
+}
+
+// Marshal implements grpc encoding.Codec
+func (codec) Marshal(v interface{}) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(v); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// Unmarshal implements grpc encoding.Codec
+func (codec) Unmarshal(data []byte, v interface{}) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// Name implements grpc encoding.Codec
+func (codec) Name() string {
+	return "gob"
+}
+
+// encodeValues encodes the values in order, nil pointers included
+func encodeValues(values []reflect.Value) ([]byte, error) {
+	var buffer bytes.Buffer
+	encoder := gob.NewEncoder(&buffer)
+	for _, value := range values {
+		if value.Kind() == reflect.Ptr {
+			if err := encoder.Encode(value.IsNil()); err != nil {
+				return nil, err
+			}
+			if value.IsNil() {
+				continue
+			}
+		}
+		if err := encoder.EncodeValue(value); err != nil {
+			return nil, fmt.Errorf("unable to encode %v: %w", value.Type(), err)
+		}
+	}
+	return buffer.Bytes(), nil
+}
+
+// decodeValues decodes values of the given types encoded by encodeValues
+func decodeValues(data []byte, types []reflect.Type) ([]reflect.Value, error) {
+	decoder := gob.NewDecoder(bytes.NewReader(data))
+	values := make([]reflect.Value, len(types))
+	for i, valueType := range types {
+		if valueType.Kind() == reflect.Ptr {
+			var isNil bool
+			if err := decoder.Decode(&isNil); err != nil {
+				return nil, err
+			}
+			if isNil {
+				values[i] = reflect.Zero(valueType)
+				continue
+			}
+		}
+		value := reflect.New(valueType)
+		if err := decoder.DecodeValue(value); err != nil {
+			return nil, fmt.Errorf("unable to decode %v: %w", valueType, err)
+		}
+		restoreEmptyBlobs(value)
+		values[i] = value.Elem()
+	}
+	return values, nil
+}
+
+// restoreEmptyBlobs sets the data of the blobs reachable from v which gob decoded as nil back to
+// an empty slice, since gob does not tell them apart and stores write nil data as NULL
+func restoreEmptyBlobs(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			restoreEmptyBlobs(v.Elem())
+		}
+	case reflect.Struct:
+		if v.Type() == dataBlobType {
+			if !v.CanAddr() {
+				return
+			}
+			if blob := v.Addr().Interface().(*commonpb.DataBlob); blob.Data == nil {
+				blob.Data = []byte{}
+			}
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				restoreEmptyBlobs(v.Field(i))
+			}
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			restoreEmptyBlobs(v.Index(i))
+		}
+	case reflect.Map:
+		for _, key := range v.MapKeys() {
+			restoreEmptyBlobs(v.MapIndex(key))
+		}
+	}
+}
+
+func encodeError(err error) (*remoteError, bool) {
+	errorValue := reflect.ValueOf(err)
+	if errorValue.Kind() != reflect.Ptr {
+		return nil, false
+	}
+	errorType := errorValue.Type().Elem()
+	if errorTypes[errorType.Name()] != errorType {
+		return nil, false
+	}
+	data, encodeErr := encodeValues([]reflect.Value{errorValue})
+	if encodeErr != nil {
+		return nil, false
+	}
+	return &remoteError{
+		Type: errorType.Name(),
+		Data: data,
+	}, true
+}
+
+func decodeError(remote *remoteError) error {
+	errorType, ok := errorTypes[remote.Type]
+	if !ok {
+		return fmt.Errorf("unknown plugin error type %v", remote.Type)
+	}
+	values, err := decodeValues(remote.Data, []reflect.Type{reflect.PtrTo(errorType)})
+	if err != nil {
+		return err
+	}
+	if values[0].IsNil() {
+		return errors.New("plugin returned a nil error")
+	}
+	return values[0].Interface().(error)
+}
@@ -0,0 +1,114 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package plugin_test
+
+import (
+	"context"
+	"math/rand"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/plugin"
+	"go.temporal.io/server/common/persistence/plugin/conformance"
+	"go.temporal.io/server/common/persistence/sql"
+	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
+	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/environment"
+)
+
+func newPluginFactory(t *testing.T) *plugin.Factory {
+	logger := log.NewNoopLogger()
+	sqliteFactory := sql.NewFactory(
+		config.SQL{
+			ConnectAddr:       environment.Localhost,
+			ConnectProtocol:   "tcp",
+			PluginName:        "sqlite",
+			DatabaseName:      "default",
+			ConnectAttributes: map[string]string{"mode": "memory", "cache": "private"},
+		},
+		resolver.NewNoopResolver(),
+		"plugin_cluster",
+		logger,
+	)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := plugin.NewServer(sqliteFactory, logger)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	factory, err := plugin.NewFactory(config.PluginDatastoreConfig{Address: lis.Addr().String()}, logger)
+	require.NoError(t, err)
+	t.Cleanup(factory.Close)
+	return factory
+}
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, newPluginFactory(t), log.NewNoopLogger())
+}
+
+func TestErrors(t *testing.T) {
+	factory := newPluginFactory(t)
+	ctx := context.Background()
+
+	shardStore, err := factory.NewShardStore()
+	require.NoError(t, err)
+	require.Equal(t, "sqlite", shardStore.GetName())
+	require.Equal(t, "plugin_cluster", shardStore.GetClusterName())
+
+	// the shard info to create is computed by the caller of the plugin
+	shardID := rand.Int31()
+	response, err := shardStore.GetOrCreateShard(ctx, &p.InternalGetOrCreateShardRequest{
+		ShardID: shardID,
+		CreateShardInfo: func() (int64, *commonpb.DataBlob, error) {
+			return 1, p.NewDataBlob([]byte("info"), "Proto3"), nil
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte("info"), response.ShardInfo.Data)
+
+	// persistence errors keep their type
+	err = shardStore.UpdateShard(ctx, &p.InternalUpdateShardRequest{
+		ShardID:         shardID,
+		RangeID:         3,
+		PreviousRangeID: 2,
+		ShardInfo:       p.NewDataBlob([]byte("info"), "Proto3"),
+	})
+	require.IsType(t, &p.ShardOwnershipLostError{}, err)
+	require.Equal(t, shardID, err.(*p.ShardOwnershipLostError).ShardID)
+
+	// service errors are returned as such
+	metadataStore, err := factory.NewMetadataStore()
+	require.NoError(t, err)
+	_, err = metadataStore.GetNamespace(ctx, &p.GetNamespaceRequest{Name: "missing"})
+	require.IsType(t, &serviceerror.NamespaceNotFound{}, err)
+}
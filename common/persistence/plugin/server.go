@@ -0,0 +1,235 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"sync"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	p "go.temporal.io/server/common/persistence"
+)
+
+type (
+	// Server serves the stores of a DataStoreFactory to the Temporal server
+	Server struct {
+		factory    DataStoreFactory
+		logger     log.Logger
+		grpcServer *grpc.Server
+
+		sync.Mutex
+		stores map[storeKey]interface{}
+	}
+
+	storeKey struct {
+		name      string
+		queueType p.QueueType
+	}
+)
+
+var _ invoker = (*Server)(nil)
+
+// NewServer creates a plugin server for the stores of the factory. The server options
+// are passed to the gRPC server, e.g. to configure TLS.
+func NewServer(
+	factory DataStoreFactory,
+	logger log.Logger,
+	opts ...grpc.ServerOption,
+) *Server {
+	s := &Server{
+		factory: factory,
+		logger:  logger,
+		stores:  make(map[storeKey]interface{}),
+	}
+	s.grpcServer = grpc.NewServer(append(opts, grpc.ForceServerCodec(codec{}))...)
+	s.grpcServer.RegisterService(&serviceDesc, s)
+	return s
+}
+
+// Serve serves the stores on the listener until Stop is called
+func (s *Server) Serve(lis net.Listener) error {
+	return s.grpcServer.Serve(lis)
+}
+
+// Stop stops the server and closes the stores and their factory
+func (s *Server) Stop() {
+	s.grpcServer.GracefulStop()
+
+	s.Lock()
+	defer s.Unlock()
+	for _, store := range s.stores {
+		store.(p.Closeable).Close()
+	}
+	s.stores = make(map[storeKey]interface{})
+	s.factory.Close()
+}
+
+func (s *Server) invoke(
+	ctx context.Context,
+	request *invokeRequest,
+) (*invokeResponse, error) {
+	response, err := s.invokeStore(ctx, request)
+	return response, serviceerror.ToStatus(err).Err()
+}
+
+func (s *Server) invokeStore(
+	ctx context.Context,
+	request *invokeRequest,
+) (*invokeResponse, error) {
+	if request.Version != ProtocolVersion {
+		return nil, serviceerror.NewFailedPrecondition(fmt.Sprintf(
+			"persistence plugin protocol version %v is not supported, expected version %v", request.Version, ProtocolVersion,
+		))
+	}
+	storeType, ok := storeTypes[request.Store]
+	if !ok {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("unknown store %v", request.Store))
+	}
+	store, err := s.getStore(storeKey{name: request.Store, queueType: request.QueueType})
+	if err != nil {
+		return nil, err
+	}
+	if request.Store == shardStoreName && request.Method == getOrCreateShardWithInfoMethod {
+		return s.getOrCreateShardWithInfo(ctx, store.(p.ShardStore), request.Args)
+	}
+
+	method, ok := storeType.MethodByName(request.Method)
+	if _, local := localMethods[request.Method]; !ok || local {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("unknown method %v.%v", request.Store, request.Method))
+	}
+
+	var argTypes []reflect.Type
+	for i := 0; i < method.Type.NumIn(); i++ {
+		if method.Type.In(i) != contextType {
+			argTypes = append(argTypes, method.Type.In(i))
+		}
+	}
+	args, err := decodeValues(request.Args, argTypes)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid arguments for %v.%v: %v", request.Store, request.Method, err))
+	}
+	in := make([]reflect.Value, 0, method.Type.NumIn())
+	for i := 0; i < method.Type.NumIn(); i++ {
+		if method.Type.In(i) == contextType {
+			in = append(in, reflect.ValueOf(ctx))
+		} else {
+			in, args = append(in, args[0]), args[1:]
+		}
+	}
+
+	out := reflect.ValueOf(store).MethodByName(request.Method).Call(in)
+	if numOut := len(out); numOut > 0 && method.Type.Out(numOut-1) == errorInterfaceType {
+		if err, _ := out[numOut-1].Interface().(error); err != nil {
+			return s.errorResponse(err)
+		}
+		out = out[:numOut-1]
+	}
+	return s.resultsResponse(request, out)
+}
+
+func (s *Server) getOrCreateShardWithInfo(
+	ctx context.Context,
+	store p.ShardStore,
+	data []byte,
+) (*invokeResponse, error) {
+	args, err := decodeValues(data, []reflect.Type{
+		reflect.TypeOf((*p.InternalGetOrCreateShardRequest)(nil)),
+		reflect.TypeOf(int64(0)),
+		reflect.TypeOf((*commonpb.DataBlob)(nil)),
+	})
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid arguments for %v: %v", getOrCreateShardWithInfoMethod, err))
+	}
+	request := args[0].Interface().(*p.InternalGetOrCreateShardRequest)
+	rangeID := args[1].Int()
+	shardInfo := args[2].Interface().(*commonpb.DataBlob)
+	request.CreateShardInfo = func() (int64, *commonpb.DataBlob, error) {
+		return rangeID, shardInfo, nil
+	}
+
+	response, err := store.GetOrCreateShard(ctx, request)
+	if err != nil {
+		return s.errorResponse(err)
+	}
+	return s.resultsResponse(&invokeRequest{Store: shardStoreName, Method: getOrCreateShardWithInfoMethod}, []reflect.Value{reflect.ValueOf(response)})
+}
+
+func (s *Server) errorResponse(err error) (*invokeResponse, error) {
+	if remote, ok := encodeError(err); ok {
+		return &invokeResponse{Error: remote}, nil
+	}
+	return nil, err
+}
+
+func (s *Server) resultsResponse(
+	request *invokeRequest,
+	out []reflect.Value,
+) (*invokeResponse, error) {
+	results, err := encodeValues(out)
+	if err != nil {
+		s.logger.Error("Unable to encode persistence plugin results.", tag.Name(request.Store+"."+request.Method), tag.Error(err))
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	return &invokeResponse{Results: results}, nil
+}
+
+func (s *Server) getStore(key storeKey) (interface{}, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if store, ok := s.stores[key]; ok {
+		return store, nil
+	}
+
+	var store interface{}
+	var err error
+	switch key.name {
+	case shardStoreName:
+		store, err = s.factory.NewShardStore()
+	case taskStoreName:
+		store, err = s.factory.NewTaskStore()
+	case metadataStoreName:
+		store, err = s.factory.NewMetadataStore()
+	case clusterMetadataStoreName:
+		store, err = s.factory.NewClusterMetadataStore()
+	case executionStoreName:
+		store, err = s.factory.NewExecutionStore()
+	case queueName:
+		store, err = s.factory.NewQueue(key.queueType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.stores[key] = store
+	return store, nil
+}
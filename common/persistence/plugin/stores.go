@@ -0,0 +1,668 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	p "go.temporal.io/server/common/persistence"
+)
+
+type (
+	shardStore struct {
+		*client
+		clusterName string
+	}
+
+	taskStore struct {
+		*client
+	}
+
+	metadataStore struct {
+		*client
+	}
+
+	clusterMetadataStore struct {
+		*client
+	}
+
+	executionStore struct {
+		*client
+		*p.HistoryBranchUtilImpl
+	}
+
+	queue struct {
+		*client
+	}
+)
+
+var (
+	_ p.ShardStore           = (*shardStore)(nil)
+	_ p.TaskStore            = (*taskStore)(nil)
+	_ p.MetadataStore        = (*metadataStore)(nil)
+	_ p.ClusterMetadataStore = (*clusterMetadataStore)(nil)
+	_ p.ExecutionStore       = (*executionStore)(nil)
+	_ p.Queue                = (*queue)(nil)
+)
+
+func (s *shardStore) GetClusterName() string {
+	return s.clusterName
+}
+
+func (s *shardStore) GetOrCreateShard(
+	ctx context.Context,
+	request *p.InternalGetOrCreateShardRequest,
+) (*p.InternalGetOrCreateShardResponse, error) {
+	var response *p.InternalGetOrCreateShardResponse
+	err := s.invoke(ctx, "GetOrCreateShard", []interface{}{request}, &response)
+	if _, notFound := err.(*serviceerror.NotFound); !notFound || request.CreateShardInfo == nil {
+		return response, err
+	}
+
+	// functions can't be sent to the plugin, so the info of the shard to create is sent instead
+	rangeID, shardInfo, err := request.CreateShardInfo()
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetOrCreateShard: failed to encode shard info for ShardID %v. Error: %v", request.ShardID, err))
+	}
+	err = s.invoke(ctx, getOrCreateShardWithInfoMethod, []interface{}{request, rangeID, shardInfo}, &response)
+	return response, err
+}
+
+func (s *shardStore) UpdateShard(
+	ctx context.Context,
+	request *p.InternalUpdateShardRequest,
+) error {
+	return s.invoke(ctx, "UpdateShard", []interface{}{request})
+}
+
+func (s *shardStore) AssertShardOwnership(
+	ctx context.Context,
+	request *p.AssertShardOwnershipRequest,
+) error {
+	return s.invoke(ctx, "AssertShardOwnership", []interface{}{request})
+}
+
+func (s *taskStore) CreateTaskQueue(
+	ctx context.Context,
+	request *p.InternalCreateTaskQueueRequest,
+) error {
+	return s.invoke(ctx, "CreateTaskQueue", []interface{}{request})
+}
+
+func (s *taskStore) GetTaskQueue(
+	ctx context.Context,
+	request *p.InternalGetTaskQueueRequest,
+) (*p.InternalGetTaskQueueResponse, error) {
+	var response *p.InternalGetTaskQueueResponse
+	err := s.invoke(ctx, "GetTaskQueue", []interface{}{request}, &response)
+	return response, err
+}
+
+func (s *taskStore) UpdateTaskQueue(
+	ctx context.Context,
+	request *p.InternalUpdateTaskQueueRequest,
+) (*p.UpdateTaskQueueResponse, error) {
+	var response *p.UpdateTaskQueueResponse
+	err := s.invoke(ctx, "UpdateTaskQueue", []interface{}{request}, &response)
+	return response, err
+}
+
+func (s *taskStore) ListTaskQueue(
+	ctx context.Context,
+	request *p.ListTaskQueueRequest,
+) (*p.InternalListTaskQueueResponse, error) {
+	var response *p.InternalListTaskQueueResponse
+	err := s.invoke(ctx, "ListTaskQueue", []interface{}{request}, &response)
+	return response, err
+}
+
+func (s *taskStore) DeleteTaskQueue(
+	ctx context.Context,
+	request *p.DeleteTaskQueueRequest,
+) error {
+	return s.invoke(ctx, "DeleteTaskQueue", []interface{}{request})
+}
+
+func (s *taskStore) CreateTasks(
+	ctx context.Context,
+	request *p.InternalCreateTasksRequest,
+) (*p.CreateTasksResponse, error) {
+	var response *p.CreateTasksResponse
+	err := s.invoke(ctx, "CreateTasks", []interface{}{request}, &response)
+	return response, err
+}
+
+func (s *taskStore) GetTasks(
+	ctx context.Context,
+	request *p.GetTasksRequest,
+) (*p.InternalGetTasksResponse, error) {
+	var response *p.InternalGetTasksResponse
+	err := s.invoke(ctx, "GetTasks", []interface{}{request}, &response)
+	return response, err
+}
+
+func (s *taskStore) CompleteTask(
+	ctx context.Context,
+	request *p.CompleteTaskRequest,
+) error {
+	return s.invoke(ctx, "CompleteTask", []interface{}{request})
+}
+
+func (s *taskStore) CompleteTasksLessThan(
+	ctx context.Context,
+	request *p.CompleteTasksLessThanRequest,
+) (int, error) {
+	var count int
+	err := s.invoke(ctx, "CompleteTasksLessThan", []interface{}{request}, &count)
+	return count, err
+}
+
+func (s *taskStore) GetTaskQueueUserData(
+	ctx context.Context,
+	request *p.GetTaskQueueUserDataRequest,
+) (*p.InternalGetTaskQueueUserDataResponse, error) {
+	var response *p.InternalGetTaskQueueUserDataResponse
+	err := s.invoke(ctx, "GetTaskQueueUserData", []interface{}{request}, &response)
+	return response, err
+}
+
+func (s *taskStore) UpdateTaskQueueUserData(
+	ctx context.Context,
+	request *p.InternalUpdateTaskQueueUserDataRequest,
+) error {
+	return s.invoke(ctx, "UpdateTaskQueueUserData", []interface{}{request})
+}
+
+func (s *taskStore) ListTaskQueueUserDataEntries(
+	ctx context.Context,
+	request *p.ListTaskQueueUserDataEntriesRequest,
+) (*p.InternalListTaskQueueUserDataEntriesResponse, error) {
+	var response *p.InternalListTaskQueueUserDataEntriesResponse
+	err := s.invoke(ctx, "ListTaskQueueUserDataEntries", []interface{}{request}, &response)
+	return response, err
+}
+
+func (s *taskStore) GetTaskQueuesByBuildId(
+	ctx context.Context,
+	request *p.GetTaskQueuesByBuildIdRequest,
+) ([]string, error) {
+	var result []string
+	err := s.invoke(ctx, "GetTaskQueuesByBuildId", []interface{}{request}, &result)
+	return result, err
+}
+
+func (s *taskStore) CountTaskQueuesByBuildId(
+	ctx context.Context,
+	request *p.CountTaskQueuesByBuildIdRequest,
+) (int, error) {
+	var count int
+	err := s.invoke(ctx, "CountTaskQueuesByBuildId", []interface{}{request}, &count)
+	return count, err
+}
+
+func (s *metadataStore) CreateNamespace(
+	ctx context.Context,
+	request *p.InternalCreateNamespaceRequest,
+) (*p.CreateNamespaceResponse, error) {
+	var response *p.CreateNamespaceResponse
+	err := s.invoke(ctx, "CreateNamespace", []interface{}{request}, &response)
+	return response, err
+}
+
+func (s *metadataStore) GetNamespace(
+	ctx context.Context,
+	request *p.GetNamespaceRequest,
+) (*p.InternalGetNamespaceResponse, error) {
+	var response *p.InternalGetNamespaceResponse
+	err := s.invoke(ctx, "GetNamespace", []interface{}{request}, &response)
+	return response, err
+}
+
+func (s *metadataStore) UpdateNamespace(
+	ctx context.Context,
+	request *p.InternalUpdateNamespaceRequest,
+) error {
+	return s.invoke(ctx, "UpdateNamespace", []interface{}{request})
+}
+
+func (s *metadataStore) RenameNamespace(
+	ctx context.Context,
+	request *p.InternalRenameNamespaceRequest,
+) error {
+	return s.invoke(ctx, "RenameNamespace", []interface{}{request})
+}
+
+func (s *metadataStore) DeleteNamespace(
+	ctx context.Context,
+	request *p.DeleteNamespaceRequest,
+) error {
+	return s.invoke(ctx, "DeleteNamespace", []interface{}{request})
+}
+
+func (s *metadataStore) DeleteNamespaceByName(
+	ctx context.Context,
+	request *p.DeleteNamespaceByNameRequest,
+) error {
+	return s.invoke(ctx, "DeleteNamespaceByName", []interface{}{request})
+}
+
+func (s *metadataStore) ListNamespaces(
+	ctx context.Context,
+	request *p.InternalListNamespacesRequest,
+) (*p.InternalListNamespacesResponse, error) {
+	var response *p.InternalListNamespacesResponse
+	err := s.invoke(ctx, "ListNamespaces", []interface{}{request}, &response)
+	return response, err
+}
+
+func (s *metadataStore) GetMetadata(
+	ctx context.Context,
+) (*p.GetMetadataResponse, error) {
+	var response *p.GetMetadataResponse
+	err := s.invoke(ctx, "GetMetadata", []interface{}{}, &response)
+	return response, err
+}
+
+func (s *clusterMetadataStore) ListClusterMetadata(
+	ctx context.Context,
+	request *p.InternalListClusterMetadataRequest,
+) (*p.InternalListClusterMetadataResponse, error) {
+	var response *p.InternalListClusterMetadataResponse
+	err := s.invoke(ctx, "ListClusterMetadata", []interface{}{request}, &response)
+	return response, err
+}
+
+func (s *clusterMetadataStore) GetClusterMetadata(
+	ctx context.Context,
+	request *p.InternalGetClusterMetadataRequest,
+) (*p.InternalGetClusterMetadataResponse, error) {
+	var response *p.InternalGetClusterMetadataResponse
+	err := s.invoke(ctx, "GetClusterMetadata", []interface{}{request}, &response)
+	return response, err
+}
+
+func (s *clusterMetadataStore) SaveClusterMetadata(
+	ctx context.Context,
+	request *p.InternalSaveClusterMetadataRequest,
+) (bool, error) {
+	var result bool
+	err := s.invoke(ctx, "SaveClusterMetadata", []interface{}{request}, &result)
+	return result, err
+}
+
+func (s *clusterMetadataStore) DeleteClusterMetadata(
+	ctx context.Context,
+	request *p.InternalDeleteClusterMetadataRequest,
+) error {
+	return s.invoke(ctx, "DeleteClusterMetadata", []interface{}{request})
+}
+
+func (s *clusterMetadataStore) GetClusterMembers(
+	ctx context.Context,
+	request *p.GetClusterMembersRequest,
+) (*p.GetClusterMembersResponse, error) {
+	var response *p.GetClusterMembersResponse
+	err := s.invoke(ctx, "GetClusterMembers", []interface{}{request}, &response)
+	return response, err
+}
+
+func (s *clusterMetadataStore) UpsertClusterMembership(
+	ctx context.Context,
+	request *p.UpsertClusterMembershipRequest,
+) error {
+	return s.invoke(ctx, "UpsertClusterMembership", []interface{}{request})
+}
+
+func (s *clusterMetadataStore) PruneClusterMembership(
+	ctx context.Context,
+	request *p.PruneClusterMembershipRequest,
+) error {
+	return s.invoke(ctx, "PruneClusterMembership", []interface{}{request})
+}
+
+func (s *executionStore) CreateWorkflowExecution(
+	ctx context.Context,
+	request *p.InternalCreateWorkflowExecutionRequest,
+) (*p.InternalCreateWorkflowExecutionResponse, error) {
+	var response *p.InternalCreateWorkflowExecutionResponse
+	err := s.invoke(ctx, "CreateWorkflowExecution", []interface{}{request}, &response)
+	return response, err
+}
+
+func (s *executionStore) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *p.InternalUpdateWorkflowExecutionRequest,
+) error {
+	return s.invoke(ctx, "UpdateWorkflowExecution", []interface{}{request})
+}
+
+func (s *executionStore) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *p.InternalConflictResolveWorkflowExecutionRequest,
+) error {
+	return s.invoke(ctx, "ConflictResolveWorkflowExecution", []interface{}{request})
+}
+
+func (s *executionStore) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *p.DeleteWorkflowExecutionRequest,
+) error {
+	return s.invoke(ctx, "DeleteWorkflowExecution", []interface{}{request})
+}
+
+func (s *executionStore) DeleteCurrentWorkflowExecution(
+	ctx context.Context,
+	request *p.DeleteCurrentWorkflowExecutionRequest,
+) error {
+	return s.invoke(ctx, "DeleteCurrentWorkflowExecution", []interface{}{request})
+}
+
+func (s *executionStore) GetCurrentExecution(
+	ctx context.Context,
+	request *p.GetCurrentExecutionRequest,
+) (*p.InternalGetCurrentExecutionResponse, error) {
+	var response *p.InternalGetCurrentExecutionResponse
+	err := s.invoke(ctx, "GetCurrentExecution", []interface{}{request}, &response)
+	return response, err
+}
+
+func (s *executionStore) GetWorkflowExecution(
+	ctx context.Context,
+	request *p.GetWorkflowExecutionRequest,
+) (*p.InternalGetWorkflowExecutionResponse, error) {
+	var response *p.InternalGetWorkflowExecutionResponse
+	err := s.invoke(ctx, "GetWorkflowExecution", []interface{}{request}, &response)
+	return response, err
+}
+
+func (s *executionStore) SetWorkflowExecution(
+	ctx context.Context,
+	request *p.InternalSetWorkflowExecutionRequest,
+) error {
+	return s.invoke(ctx, "SetWorkflowExecution", []interface{}{request})
+}
+
+func (s *executionStore) ListConcreteExecutions(
+	ctx context.Context,
+	request *p.ListConcreteExecutionsRequest,
+) (*p.InternalListConcreteExecutionsResponse, error) {
+	var response *p.InternalListConcreteExecutionsResponse
+	err := s.invoke(ctx, "ListConcreteExecutions", []interface{}{request}, &response)
+	return response, err
+}
+
+func (s *executionStore) RegisterHistoryTaskReader(
+	ctx context.Context,
+	request *p.RegisterHistoryTaskReaderRequest,
+) error {
+	return s.invoke(ctx, "RegisterHistoryTaskReader", []interface{}{request})
+}
+
+func (s *executionStore) UnregisterHistoryTaskReader(
+	ctx context.Context,
+	request *p.UnregisterHistoryTaskReaderRequest,
+) {
+	s.invokeHint(ctx, "UnregisterHistoryTaskReader", []interface{}{request})
+}
+
+func (s *executionStore) UpdateHistoryTaskReaderProgress(
+	ctx context.Context,
+	request *p.UpdateHistoryTaskReaderProgressRequest,
+) {
+	s.invokeHint(ctx, "UpdateHistoryTaskReaderProgress", []interface{}{request})
+}
+
+func (s *executionStore) AddHistoryTasks(
+	ctx context.Context,
+	request *p.InternalAddHistoryTasksRequest,
+) error {
+	return s.invoke(ctx, "AddHistoryTasks", []interface{}{request})
+}
+
+func (s *executionStore) GetHistoryTasks(
+	ctx context.Context,
+	request *p.GetHistoryTasksRequest,
+) (*p.InternalGetHistoryTasksResponse, error) {
+	var response *p.InternalGetHistoryTasksResponse
+	err := s.invoke(ctx, "GetHistoryTasks", []interface{}{request}, &response)
+	return response, err
+}
+
+func (s *executionStore) CompleteHistoryTask(
+	ctx context.Context,
+	request *p.CompleteHistoryTaskRequest,
+) error {
+	return s.invoke(ctx, "CompleteHistoryTask", []interface{}{request})
+}
+
+func (s *executionStore) RangeCompleteHistoryTasks(
+	ctx context.Context,
+	request *p.RangeCompleteHistoryTasksRequest,
+) error {
+	return s.invoke(ctx, "RangeCompleteHistoryTasks", []interface{}{request})
+}
+
+func (s *executionStore) PutReplicationTaskToDLQ(
+	ctx context.Context,
+	request *p.PutReplicationTaskToDLQRequest,
+) error {
+	return s.invoke(ctx, "PutReplicationTaskToDLQ", []interface{}{request})
+}
+
+func (s *executionStore) GetReplicationTasksFromDLQ(
+	ctx context.Context,
+	request *p.GetReplicationTasksFromDLQRequest,
+) (*p.InternalGetReplicationTasksFromDLQResponse, error) {
+	var response *p.InternalGetReplicationTasksFromDLQResponse
+	err := s.invoke(ctx, "GetReplicationTasksFromDLQ", []interface{}{request}, &response)
+	return response, err
+}
+
+func (s *executionStore) DeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *p.DeleteReplicationTaskFromDLQRequest,
+) error {
+	return s.invoke(ctx, "DeleteReplicationTaskFromDLQ", []interface{}{request})
+}
+
+func (s *executionStore) RangeDeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *p.RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+	return s.invoke(ctx, "RangeDeleteReplicationTaskFromDLQ", []interface{}{request})
+}
+
+func (s *executionStore) IsReplicationDLQEmpty(
+	ctx context.Context,
+	request *p.GetReplicationTasksFromDLQRequest,
+) (bool, error) {
+	var result bool
+	err := s.invoke(ctx, "IsReplicationDLQEmpty", []interface{}{request}, &result)
+	return result, err
+}
+
+func (s *executionStore) AppendHistoryNodes(
+	ctx context.Context,
+	request *p.InternalAppendHistoryNodesRequest,
+) error {
+	return s.invoke(ctx, "AppendHistoryNodes", []interface{}{request})
+}
+
+func (s *executionStore) AppendHistoryNodesBatch(
+	ctx context.Context,
+	request *p.InternalAppendHistoryNodesBatchRequest,
+) error {
+	return s.invoke(ctx, "AppendHistoryNodesBatch", []interface{}{request})
+}
+
+func (s *executionStore) DeleteHistoryNodes(
+	ctx context.Context,
+	request *p.InternalDeleteHistoryNodesRequest,
+) error {
+	return s.invoke(ctx, "DeleteHistoryNodes", []interface{}{request})
+}
+
+func (s *executionStore) ReadHistoryBranch(
+	ctx context.Context,
+	request *p.InternalReadHistoryBranchRequest,
+) (*p.InternalReadHistoryBranchResponse, error) {
+	var response *p.InternalReadHistoryBranchResponse
+	err := s.invoke(ctx, "ReadHistoryBranch", []interface{}{request}, &response)
+	return response, err
+}
+
+func (s *executionStore) ForkHistoryBranch(
+	ctx context.Context,
+	request *p.InternalForkHistoryBranchRequest,
+) error {
+	return s.invoke(ctx, "ForkHistoryBranch", []interface{}{request})
+}
+
+func (s *executionStore) DeleteHistoryBranch(
+	ctx context.Context,
+	request *p.InternalDeleteHistoryBranchRequest,
+) error {
+	return s.invoke(ctx, "DeleteHistoryBranch", []interface{}{request})
+}
+
+func (s *executionStore) GetHistoryTree(
+	ctx context.Context,
+	request *p.GetHistoryTreeRequest,
+) (*p.InternalGetHistoryTreeResponse, error) {
+	var response *p.InternalGetHistoryTreeResponse
+	err := s.invoke(ctx, "GetHistoryTree", []interface{}{request}, &response)
+	return response, err
+}
+
+func (s *executionStore) GetAllHistoryTreeBranches(
+	ctx context.Context,
+	request *p.GetAllHistoryTreeBranchesRequest,
+) (*p.InternalGetAllHistoryTreeBranchesResponse, error) {
+	var response *p.InternalGetAllHistoryTreeBranchesResponse
+	err := s.invoke(ctx, "GetAllHistoryTreeBranches", []interface{}{request}, &response)
+	return response, err
+}
+
+func (s *queue) Init(
+	ctx context.Context,
+	blob *commonpb.DataBlob,
+) error {
+	return s.invoke(ctx, "Init", []interface{}{blob})
+}
+
+func (s *queue) EnqueueMessage(
+	ctx context.Context,
+	blob commonpb.DataBlob,
+) error {
+	return s.invoke(ctx, "EnqueueMessage", []interface{}{blob})
+}
+
+func (s *queue) ReadMessages(
+	ctx context.Context,
+	lastMessageID int64,
+	maxCount int,
+) ([]*p.QueueMessage, error) {
+	var messages []*p.QueueMessage
+	err := s.invoke(ctx, "ReadMessages", []interface{}{lastMessageID, maxCount}, &messages)
+	return messages, err
+}
+
+func (s *queue) DeleteMessagesBefore(
+	ctx context.Context,
+	messageID int64,
+) error {
+	return s.invoke(ctx, "DeleteMessagesBefore", []interface{}{messageID})
+}
+
+func (s *queue) UpdateAckLevel(
+	ctx context.Context,
+	metadata *p.InternalQueueMetadata,
+) error {
+	return s.invoke(ctx, "UpdateAckLevel", []interface{}{metadata})
+}
+
+func (s *queue) GetAckLevels(
+	ctx context.Context,
+) (*p.InternalQueueMetadata, error) {
+	var response *p.InternalQueueMetadata
+	err := s.invoke(ctx, "GetAckLevels", []interface{}{}, &response)
+	return response, err
+}
+
+func (s *queue) EnqueueMessageToDLQ(
+	ctx context.Context,
+	blob commonpb.DataBlob,
+) (int64, error) {
+	var messageID int64
+	err := s.invoke(ctx, "EnqueueMessageToDLQ", []interface{}{blob}, &messageID)
+	return messageID, err
+}
+
+func (s *queue) ReadMessagesFromDLQ(
+	ctx context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*p.QueueMessage, []byte, error) {
+	var messages []*p.QueueMessage
+	var nextPageToken []byte
+	err := s.invoke(ctx, "ReadMessagesFromDLQ", []interface{}{firstMessageID, lastMessageID, pageSize, pageToken}, &messages, &nextPageToken)
+	return messages, nextPageToken, err
+}
+
+func (s *queue) DeleteMessageFromDLQ(
+	ctx context.Context,
+	messageID int64,
+) error {
+	return s.invoke(ctx, "DeleteMessageFromDLQ", []interface{}{messageID})
+}
+
+func (s *queue) RangeDeleteMessagesFromDLQ(
+	ctx context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+) error {
+	return s.invoke(ctx, "RangeDeleteMessagesFromDLQ", []interface{}{firstMessageID, lastMessageID})
+}
+
+func (s *queue) UpdateDLQAckLevel(
+	ctx context.Context,
+	metadata *p.InternalQueueMetadata,
+) error {
+	return s.invoke(ctx, "UpdateDLQAckLevel", []interface{}{metadata})
+}
+
+func (s *queue) GetDLQAckLevels(
+	ctx context.Context,
+) (*p.InternalQueueMetadata, error) {
+	var response *p.InternalQueueMetadata
+	err := s.invoke(ctx, "GetDLQAckLevels", []interface{}{}, &response)
+	return response, err
+}
@@ -0,0 +1,206 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	backfillStepTimeout = time.Minute
+)
+
+type (
+	// Backfill is a resumable data migration run between the expand and the contract phase of
+	// a schema change. Schema version manifests list the backfills which must have completed
+	// before the contract phase of that version is allowed to run.
+	Backfill interface {
+		// Name uniquely identifies the backfill, it is referenced by the Backfills of a manifest
+		Name() string
+		// Step migrates one batch of data starting at cursor, which is nil for the first batch,
+		// and returns the cursor of the next batch. A step can be retried and must be idempotent.
+		Step(ctx context.Context, cursor []byte) (next []byte, done bool, err error)
+	}
+
+	// BackfillStore persists the progress of backfills next to the schema version
+	BackfillStore interface {
+		// ReadBackfill returns the cursor to resume a backfill from and whether it has completed.
+		// The cursor of a backfill which never ran is nil.
+		ReadBackfill(name string) (cursor []byte, completed bool, err error)
+		// UpdateBackfill records the progress of a backfill. Updates of a backfill which has
+		// been recorded as completed are ignored.
+		UpdateBackfill(name string, cursor []byte, completed bool) error
+		// Close releases the resources held by the store
+		Close()
+	}
+
+	// BackfillRunner runs backfills one after another in the background, persisting the
+	// cursor after every step so that a restarted runner resumes where it left off.
+	BackfillRunner struct {
+		status     int32
+		ctx        context.Context
+		cancel     context.CancelFunc
+		shutdownWG sync.WaitGroup
+
+		backfills      []Backfill
+		store          BackfillStore
+		stepInterval   dynamicconfig.DurationPropertyFn
+		retryPolicy    backoff.RetryPolicy
+		metricsHandler metrics.Handler
+		logger         log.Logger
+	}
+)
+
+var _ common.Daemon = (*BackfillRunner)(nil)
+
+// NewBackfillRunner returns a BackfillRunner for the given backfills
+func NewBackfillRunner(
+	backfills []Backfill,
+	store BackfillStore,
+	stepInterval dynamicconfig.DurationPropertyFn,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *BackfillRunner {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &BackfillRunner{
+		status:         common.DaemonStatusInitialized,
+		ctx:            ctx,
+		cancel:         cancel,
+		backfills:      backfills,
+		store:          store,
+		stepInterval:   stepInterval,
+		retryPolicy:    backoff.NewExponentialRetryPolicy(time.Second).WithMaximumInterval(time.Minute).WithExpirationInterval(backoff.NoInterval),
+		metricsHandler: metricsHandler,
+		logger:         logger,
+	}
+}
+
+func (r *BackfillRunner) Start() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	r.shutdownWG.Add(1)
+	go r.runLoop()
+}
+
+func (r *BackfillRunner) Stop() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	r.cancel()
+	r.shutdownWG.Wait()
+}
+
+func (r *BackfillRunner) runLoop() {
+	defer r.shutdownWG.Done()
+
+	for _, b := range r.backfills {
+		if !r.runBackfill(b) {
+			return
+		}
+	}
+	r.logger.Info("All schema backfills completed.")
+}
+
+// runBackfill returns false if the runner was stopped before the backfill completed
+func (r *BackfillRunner) runBackfill(b Backfill) bool {
+	logger := log.With(r.logger, tag.NewStringTag("backfill", b.Name()))
+	handler := r.metricsHandler.WithTags(metrics.StringTag("backfill", b.Name()))
+	retrier := backoff.NewRetrier(r.retryPolicy, backoff.SystemClock)
+
+	var cursor []byte
+	for {
+		var completed bool
+		var err error
+		cursor, completed, err = r.store.ReadBackfill(b.Name())
+		if err == nil {
+			if completed {
+				return true
+			}
+			break
+		}
+		logger.Warn("Unable to read schema backfill progress.", tag.Error(err))
+		if !r.sleep(retrier.NextBackOff()) {
+			return false
+		}
+	}
+
+	logger.Info("Schema backfill started.")
+	retrier.Reset()
+	for {
+		next, done, err := r.step(b, cursor)
+		if err == nil {
+			err = r.store.UpdateBackfill(b.Name(), next, done)
+		}
+		if err != nil {
+			handler.Counter(metrics.PersistenceSchemaBackfillErrors.GetMetricName()).Record(1)
+			logger.Warn("Schema backfill step failed.", tag.Error(err))
+			if !r.sleep(retrier.NextBackOff()) {
+				return false
+			}
+			continue
+		}
+
+		handler.Counter(metrics.PersistenceSchemaBackfillSteps.GetMetricName()).Record(1)
+		retrier.Reset()
+		if done {
+			handler.Counter(metrics.PersistenceSchemaBackfillCompleted.GetMetricName()).Record(1)
+			logger.Info("Schema backfill completed.")
+			return true
+		}
+		cursor = next
+		if !r.sleep(r.stepInterval()) {
+			return false
+		}
+	}
+}
+
+func (r *BackfillRunner) step(b Backfill, cursor []byte) ([]byte, bool, error) {
+	ctx, cancel := context.WithTimeout(r.ctx, backfillStepTimeout)
+	defer cancel()
+	return b.Step(ctx, cursor)
+}
+
+// sleep returns false if the runner was stopped while sleeping
+func (r *BackfillRunner) sleep(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-r.ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,155 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	testBackfillStore struct {
+		sync.Mutex
+		cursors   map[string][]byte
+		completed map[string]bool
+	}
+
+	testBackfill struct {
+		name     string
+		batches  int
+		failures int
+		cursors  chan []byte
+	}
+)
+
+func newTestBackfillStore() *testBackfillStore {
+	return &testBackfillStore{
+		cursors:   make(map[string][]byte),
+		completed: make(map[string]bool),
+	}
+}
+
+func (s *testBackfillStore) ReadBackfill(name string) ([]byte, bool, error) {
+	s.Lock()
+	defer s.Unlock()
+	return s.cursors[name], s.completed[name], nil
+}
+
+func (s *testBackfillStore) UpdateBackfill(name string, cursor []byte, completed bool) error {
+	s.Lock()
+	defer s.Unlock()
+	if s.completed[name] {
+		return nil
+	}
+	s.cursors[name] = cursor
+	s.completed[name] = completed
+	return nil
+}
+
+func (s *testBackfillStore) Close() {}
+
+func (s *testBackfillStore) isCompleted(name string) bool {
+	s.Lock()
+	defer s.Unlock()
+	return s.completed[name]
+}
+
+func (b *testBackfill) Name() string {
+	return b.name
+}
+
+func (b *testBackfill) Step(_ context.Context, cursor []byte) ([]byte, bool, error) {
+	if b.failures > 0 {
+		b.failures--
+		return nil, false, errors.New("step failed")
+	}
+	b.cursors <- cursor
+
+	batch := 0
+	if cursor != nil {
+		batch, _ = strconv.Atoi(string(cursor))
+	}
+	batch++
+	return []byte(strconv.Itoa(batch)), batch == b.batches, nil
+}
+
+func newTestBackfillRunner(store BackfillStore, backfills ...Backfill) *BackfillRunner {
+	return NewBackfillRunner(
+		backfills,
+		store,
+		dynamicconfig.GetDurationPropertyFn(time.Millisecond),
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	)
+}
+
+func TestBackfillRunner_RunsToCompletion(t *testing.T) {
+	store := newTestBackfillStore()
+	first := &testBackfill{name: "first", batches: 3, failures: 1, cursors: make(chan []byte, 3)}
+	second := &testBackfill{name: "second", batches: 1, cursors: make(chan []byte, 1)}
+
+	runner := newTestBackfillRunner(store, first, second)
+	runner.Start()
+	defer runner.Stop()
+
+	require.Eventually(t, func() bool {
+		return store.isCompleted("first") && store.isCompleted("second")
+	}, 10*time.Second, 10*time.Millisecond)
+
+	require.Nil(t, <-first.cursors)
+	require.Equal(t, []byte("1"), <-first.cursors)
+	require.Equal(t, []byte("2"), <-first.cursors)
+	require.Nil(t, <-second.cursors)
+}
+
+func TestBackfillRunner_Resumes(t *testing.T) {
+	store := newTestBackfillStore()
+	require.NoError(t, store.UpdateBackfill("first", []byte("2"), false))
+	require.NoError(t, store.UpdateBackfill("second", []byte("1"), true))
+	first := &testBackfill{name: "first", batches: 3, cursors: make(chan []byte, 3)}
+	second := &testBackfill{name: "second", batches: 1, cursors: make(chan []byte, 1)}
+
+	runner := newTestBackfillRunner(store, first, second)
+	runner.Start()
+	defer runner.Stop()
+
+	require.Eventually(t, func() bool {
+		return store.isCompleted("first")
+	}, 10*time.Second, 10*time.Millisecond)
+
+	require.Equal(t, []byte("2"), <-first.cursors)
+	require.Len(t, first.cursors, 0)
+	require.Len(t, second.cursors, 0)
+}
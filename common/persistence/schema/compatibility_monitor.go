@@ -0,0 +1,133 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	// CompatibilityMonitor periodically re-verifies the persistence schema version while the
+	// server is running, so that a schema contracted underneath a running server is detected
+	// instead of surfacing as persistence errors.
+	CompatibilityMonitor struct {
+		status     int32
+		shutdownCh chan struct{}
+		shutdownWG sync.WaitGroup
+
+		verifyFn       func() error
+		onIncompatible func(error)
+		checkInterval  dynamicconfig.DurationPropertyFn
+		metricsHandler metrics.Handler
+		logger         log.Logger
+	}
+)
+
+var _ common.Daemon = (*CompatibilityMonitor)(nil)
+
+// NewCompatibilityMonitor returns a CompatibilityMonitor calling verifyFn every checkInterval.
+// onIncompatible is invoked once verifyFn returns an IncompatibleVersionError; any other error is
+// treated as transient and only logged.
+func NewCompatibilityMonitor(
+	verifyFn func() error,
+	onIncompatible func(error),
+	checkInterval dynamicconfig.DurationPropertyFn,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *CompatibilityMonitor {
+	return &CompatibilityMonitor{
+		status:         common.DaemonStatusInitialized,
+		shutdownCh:     make(chan struct{}),
+		verifyFn:       verifyFn,
+		onIncompatible: onIncompatible,
+		checkInterval:  checkInterval,
+		metricsHandler: metricsHandler,
+		logger:         logger,
+	}
+}
+
+func (m *CompatibilityMonitor) Start() {
+	if !atomic.CompareAndSwapInt32(&m.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	m.shutdownWG.Add(1)
+	go m.checkLoop()
+}
+
+func (m *CompatibilityMonitor) Stop() {
+	if !atomic.CompareAndSwapInt32(&m.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(m.shutdownCh)
+	m.shutdownWG.Wait()
+}
+
+func (m *CompatibilityMonitor) checkLoop() {
+	defer m.shutdownWG.Done()
+
+	timer := time.NewTimer(backoff.Jitter(m.checkInterval(), 0.1))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-m.shutdownCh:
+			return
+		case <-timer.C:
+			if !m.check() {
+				return
+			}
+			timer.Reset(backoff.Jitter(m.checkInterval(), 0.1))
+		}
+	}
+}
+
+// check returns false once the schema has been found incompatible
+func (m *CompatibilityMonitor) check() bool {
+	err := m.verifyFn()
+
+	var incompatibleErr *IncompatibleVersionError
+	if errors.As(err, &incompatibleErr) {
+		m.metricsHandler.Gauge(metrics.PersistenceSchemaIncompatible.GetMetricName()).Record(1)
+		m.logger.Error("Persistence schema is no longer compatible with this server.", tag.Error(err))
+		m.onIncompatible(err)
+		return false
+	}
+
+	m.metricsHandler.Gauge(metrics.PersistenceSchemaIncompatible.GetMetricName()).Record(0)
+	if err != nil {
+		m.logger.Warn("Unable to verify persistence schema version.", tag.Error(err))
+	}
+	return true
+}
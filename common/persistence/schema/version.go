@@ -30,7 +30,25 @@ import (
 	"github.com/blang/semver/v4"
 )
 
-// VerifyCompatibleVersion ensures that the installed version is greater than or equal to the expected version.
+type (
+	// IncompatibleVersionError is returned when the schema version of a keyspace/database
+	// doesn't allow a server expecting a given version to run against it.
+	IncompatibleVersionError struct {
+		Message string
+	}
+)
+
+// NewIncompatibleVersionError returns a new IncompatibleVersionError
+func NewIncompatibleVersionError(msg string) error {
+	return &IncompatibleVersionError{Message: msg}
+}
+
+func (e *IncompatibleVersionError) Error() string {
+	return e.Message
+}
+
+// VerifyCompatibleVersion ensures that the installed version is greater than or equal to the expected version,
+// and that the schema has not been contracted past the expected version.
 func VerifyCompatibleVersion(
 	versionReader VersionReader,
 	dbName string,
@@ -48,7 +66,25 @@ func VerifyCompatibleVersion(
 	expectedVersionParsed, _ := semver.ParseTolerant(expectedVersion)
 
 	if versionParsed.LT(expectedVersionParsed) {
-		return fmt.Errorf("version mismatch for keyspace/database: %q. Expected version: %s cannot be greater than Actual version: %s", dbName, expectedVersion, version)
+		return NewIncompatibleVersionError(fmt.Sprintf("version mismatch for keyspace/database: %q. Expected version: %s cannot be greater than Actual version: %s", dbName, expectedVersion, version))
+	}
+
+	// Rollbacks are only safe until the contract phase of a schema change has run. Once it has,
+	// the schema no longer carries what older servers depend on and they must not start.
+	minCompatibleReader, ok := versionReader.(MinCompatibleVersionReader)
+	if !ok {
+		return nil
+	}
+	minCompatibleVersion, err := minCompatibleReader.ReadSchemaMinCompatibleVersion(dbName)
+	if err != nil {
+		return fmt.Errorf("unable to read DB schema min compatible version keyspace/database: %s error: %v", dbName, err.Error())
+	}
+	if len(minCompatibleVersion) == 0 {
+		return nil
+	}
+	minCompatibleVersionParsed, _ := semver.ParseTolerant(minCompatibleVersion)
+	if minCompatibleVersionParsed.GT(expectedVersionParsed) {
+		return NewIncompatibleVersionError(fmt.Sprintf("version mismatch for keyspace/database: %q. Expected version: %s is lower than Min compatible version: %s", dbName, expectedVersion, minCompatibleVersion))
 	}
 	return nil
 }
@@ -29,4 +29,13 @@ type (
 		// ReadSchemaVersion returns the current schema version for the keyspace
 		ReadSchemaVersion(dbName string) (string, error)
 	}
+
+	// MinCompatibleVersionReader is implemented by version readers that can also read the
+	// minimum server version the schema is still compatible with. The minimum compatible
+	// version is raised when the contract phase of a schema change removes what older
+	// servers depend on.
+	MinCompatibleVersionReader interface {
+		// ReadSchemaMinCompatibleVersion returns the min compatible schema version for the keyspace
+		ReadSchemaMinCompatibleVersion(dbName string) (string, error)
+	}
 )
@@ -0,0 +1,67 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	testVersionReader struct {
+		version              string
+		minCompatibleVersion string
+	}
+)
+
+func (r *testVersionReader) ReadSchemaVersion(string) (string, error) {
+	return r.version, nil
+}
+
+func (r *testVersionReader) ReadSchemaMinCompatibleVersion(string) (string, error) {
+	return r.minCompatibleVersion, nil
+}
+
+func TestVerifyCompatibleVersion(t *testing.T) {
+	var incompatibleErr *IncompatibleVersionError
+
+	reader := &testVersionReader{version: "1.9", minCompatibleVersion: "1.0"}
+	require.NoError(t, VerifyCompatibleVersion(reader, "temporal", "1.9"))
+	require.NoError(t, VerifyCompatibleVersion(reader, "temporal", "1.8"))
+
+	err := VerifyCompatibleVersion(reader, "temporal", "1.10")
+	require.True(t, errors.As(err, &incompatibleErr))
+
+	// rolling back is no longer allowed once the schema has been contracted
+	reader.minCompatibleVersion = "1.9"
+	require.NoError(t, VerifyCompatibleVersion(reader, "temporal", "1.9"))
+	err = VerifyCompatibleVersion(reader, "temporal", "1.8")
+	require.True(t, errors.As(err, &incompatibleErr))
+
+	reader.minCompatibleVersion = ""
+	require.NoError(t, VerifyCompatibleVersion(reader, "temporal", "1.8"))
+}
@@ -171,6 +171,8 @@ func (s *TaskSerializer) serializeTimerTask(
 		timerTask = s.timerWorkflowRunToProto(task)
 	case *tasks.DeleteHistoryEventTask:
 		timerTask = s.timerWorkflowCleanupTaskToProto(task)
+	case *tasks.DeleteVisibilityRecordTask:
+		timerTask = s.timerVisibilityCleanupTaskToProto(task)
 	default:
 		return commonpb.DataBlob{}, serviceerror.NewInternal(fmt.Sprintf("Unknown timer task type: %v", task))
 	}
@@ -206,6 +208,8 @@ func (s *TaskSerializer) deserializeTimerTasks(
 		timer = s.timerWorkflowRunFromProto(timerTask)
 	case enumsspb.TASK_TYPE_DELETE_HISTORY_EVENT:
 		timer = s.timerWorkflowCleanupTaskFromProto(timerTask)
+	case enumsspb.TASK_TYPE_DELETE_VISIBILITY_RECORD:
+		timer = s.timerVisibilityCleanupTaskFromProto(timerTask)
 	default:
 		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown timer task type: %v", timerTask.TaskType))
 	}
@@ -895,6 +899,41 @@ func (s *TaskSerializer) timerWorkflowCleanupTaskFromProto(
 	}
 }
 
+func (s *TaskSerializer) timerVisibilityCleanupTaskToProto(
+	visibilityCleanupTimer *tasks.DeleteVisibilityRecordTask,
+) *persistencespb.TimerTaskInfo {
+	return &persistencespb.TimerTaskInfo{
+		NamespaceId:         visibilityCleanupTimer.WorkflowKey.NamespaceID,
+		WorkflowId:          visibilityCleanupTimer.WorkflowKey.WorkflowID,
+		RunId:               visibilityCleanupTimer.WorkflowKey.RunID,
+		TaskType:            enumsspb.TASK_TYPE_DELETE_VISIBILITY_RECORD,
+		TimeoutType:         enumspb.TIMEOUT_TYPE_UNSPECIFIED,
+		WorkflowBackoffType: enumsspb.WORKFLOW_BACKOFF_TYPE_UNSPECIFIED,
+		Version:             visibilityCleanupTimer.Version,
+		ScheduleAttempt:     0,
+		EventId:             0,
+		TaskId:              visibilityCleanupTimer.TaskID,
+		VisibilityTime:      &visibilityCleanupTimer.VisibilityTimestamp,
+		CloseTime:           visibilityCleanupTimer.CloseTime,
+	}
+}
+
+func (s *TaskSerializer) timerVisibilityCleanupTaskFromProto(
+	visibilityCleanupTimer *persistencespb.TimerTaskInfo,
+) *tasks.DeleteVisibilityRecordTask {
+	return &tasks.DeleteVisibilityRecordTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			visibilityCleanupTimer.NamespaceId,
+			visibilityCleanupTimer.WorkflowId,
+			visibilityCleanupTimer.RunId,
+		),
+		VisibilityTimestamp: *visibilityCleanupTimer.VisibilityTime,
+		TaskID:              visibilityCleanupTimer.TaskId,
+		Version:             visibilityCleanupTimer.Version,
+		CloseTime:           visibilityCleanupTimer.CloseTime,
+	}
+}
+
 func (s *TaskSerializer) visibilityStartTaskToProto(
 	startVisibilityTask *tasks.StartExecutionVisibilityTask,
 ) *persistencespb.VisibilityTaskInfo {
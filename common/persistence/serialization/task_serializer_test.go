@@ -36,6 +36,7 @@ import (
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/shuffle"
 	"go.temporal.io/server/service/history/tasks"
 )
@@ -262,6 +263,17 @@ func (s *taskSerializerSuite) TestTimerWorkflowCleanupTask() {
 	s.assertEqualTasks(workflowCleanupTimer)
 }
 
+func (s *taskSerializerSuite) TestTimerVisibilityCleanupTask() {
+	visibilityCleanupTimer := &tasks.DeleteVisibilityRecordTask{
+		WorkflowKey:         s.workflowKey,
+		VisibilityTimestamp: time.Unix(0, rand.Int63()).UTC(),
+		TaskID:              rand.Int63(),
+		Version:             rand.Int63(),
+		CloseTime:           timestamp.TimePtr(time.Unix(0, rand.Int63()).UTC()),
+	}
+	s.assertEqualTasks(visibilityCleanupTimer)
+}
+
 func (s *taskSerializerSuite) TestVisibilityStartTask() {
 	visibilityStart := &tasks.StartExecutionVisibilityTask{
 		WorkflowKey:         s.workflowKey,
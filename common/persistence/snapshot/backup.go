@@ -0,0 +1,282 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+
+	"go.temporal.io/api/serviceerror"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/objectstore"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
+)
+
+// Backup takes a snapshot of the cluster and writes it to the store under the snapshot ID
+func (m *Manager) Backup(
+	ctx context.Context,
+	store objectstore.Store,
+	snapshotID string,
+) (*Manifest, error) {
+	if err := validateSnapshotID(snapshotID); err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{
+		SnapshotID:       snapshotID,
+		CreateTime:       m.timeSource.Now().UTC(),
+		NumHistoryShards: m.numHistoryShards,
+	}
+
+	namespaces, err := m.backupNamespaces(ctx, store, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	manifest.Namespaces = namespaces
+
+	for shardID := int32(1); shardID <= m.numHistoryShards; shardID++ {
+		executions, err := m.backupShard(ctx, store, snapshotID, shardID)
+		if err != nil {
+			return nil, err
+		}
+		manifest.Shards = append(manifest.Shards, ShardManifest{
+			ShardID:    shardID,
+			Executions: executions,
+		})
+	}
+
+	// the manifest is written last, so that an incomplete snapshot can't be restored
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+	if err := store.Put(ctx, manifestKey(snapshotID), bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+	m.logger.Info("Cluster snapshot completed.",
+		tag.NewStringTag("snapshot-id", snapshotID),
+		tag.NewInt("namespaces", namespaces),
+	)
+	return manifest, nil
+}
+
+func (m *Manager) backupNamespaces(
+	ctx context.Context,
+	store objectstore.Store,
+	snapshotID string,
+) (int, error) {
+	count := 0
+	err := writeRecords(ctx, store, namespacesKey(snapshotID), func(encoder *json.Encoder) error {
+		var pageToken []byte
+		for {
+			resp, err := m.metadataManager.ListNamespaces(ctx, &persistence.ListNamespacesRequest{
+				PageSize:       listPageSize,
+				NextPageToken:  pageToken,
+				IncludeDeleted: true,
+			})
+			if err != nil {
+				return err
+			}
+			for _, ns := range resp.Namespaces {
+				data, err := ns.Namespace.Marshal()
+				if err != nil {
+					return err
+				}
+				if err := encoder.Encode(&namespaceRecord{
+					Namespace:         data,
+					IsGlobalNamespace: ns.IsGlobalNamespace,
+				}); err != nil {
+					return err
+				}
+				count++
+			}
+			if len(resp.NextPageToken) == 0 {
+				return nil
+			}
+			pageToken = resp.NextPageToken
+		}
+	})
+	return count, err
+}
+
+func (m *Manager) backupShard(
+	ctx context.Context,
+	store objectstore.Store,
+	snapshotID string,
+	shardID int32,
+) (int, error) {
+	for attempt := 1; ; attempt++ {
+		executions, err := m.backupShardOnce(ctx, store, snapshotID, shardID)
+		if errors.Is(err, errShardFenceLost) {
+			if attempt < fenceAttempts {
+				m.logger.Warn("Shard was reacquired during cluster snapshot, retrying.", tag.ShardID(shardID), tag.Attempt(int32(attempt)))
+				continue
+			}
+			return 0, serviceerror.NewUnavailable(err.Error())
+		}
+		return executions, err
+	}
+}
+
+func (m *Manager) backupShardOnce(
+	ctx context.Context,
+	store objectstore.Store,
+	snapshotID string,
+	shardID int32,
+) (int, error) {
+	rangeID, err := m.fenceShard(ctx, shardID)
+	if err != nil {
+		return 0, err
+	}
+
+	executions := 0
+	err = writeRecords(ctx, store, shardKey(snapshotID, shardID), func(encoder *json.Encoder) error {
+		var pageToken []byte
+		for {
+			resp, err := m.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+				ShardID:   shardID,
+				PageSize:  listPageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return err
+			}
+			for _, state := range resp.States {
+				record, err := m.backupExecution(ctx, shardID, state)
+				if err != nil {
+					return err
+				}
+				if err := encoder.Encode(record); err != nil {
+					return err
+				}
+				executions++
+			}
+			if len(resp.PageToken) == 0 {
+				return nil
+			}
+			pageToken = resp.PageToken
+		}
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// the snapshot of the shard is consistent only if no history host took the shard back meanwhile
+	if err := m.verifyShardFence(ctx, shardID, rangeID); err != nil {
+		return 0, err
+	}
+	return executions, nil
+}
+
+func (m *Manager) backupExecution(
+	ctx context.Context,
+	shardID int32,
+	state *persistencespb.WorkflowMutableState,
+) (*executionRecord, error) {
+	executionInfo := state.ExecutionInfo
+	data, err := state.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := m.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: executionInfo.NamespaceId,
+		WorkflowID:  executionInfo.WorkflowId,
+	})
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		current = &persistence.GetCurrentExecutionResponse{}
+	default:
+		return nil, err
+	}
+
+	record := &executionRecord{
+		MutableState: data,
+		IsCurrent:    current.RunID == state.ExecutionState.RunId,
+	}
+	for _, versionHistory := range executionInfo.GetVersionHistories().GetHistories() {
+		branch, err := m.backupHistoryBranch(ctx, shardID, versionHistory)
+		if err != nil {
+			return nil, err
+		}
+		record.Branches = append(record.Branches, branch)
+	}
+	return record, nil
+}
+
+func (m *Manager) backupHistoryBranch(
+	ctx context.Context,
+	shardID int32,
+	versionHistory *historyspb.VersionHistory,
+) (historyBranchRecord, error) {
+	branch := historyBranchRecord{
+		BranchToken: versionHistory.BranchToken,
+	}
+	if len(versionHistory.Items) == 0 {
+		return branch, nil
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(versionHistory)
+	if err != nil {
+		return branch, err
+	}
+
+	var pageToken []byte
+	for {
+		resp, err := m.executionManager.ReadHistoryBranchByBatch(ctx, &persistence.ReadHistoryBranchRequest{
+			ShardID:       shardID,
+			BranchToken:   versionHistory.BranchToken,
+			MinEventID:    common.FirstEventID,
+			MaxEventID:    lastItem.GetEventId() + 1,
+			PageSize:      historyPageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return branch, err
+		}
+		for i, history := range resp.History {
+			data, err := history.Marshal()
+			if err != nil {
+				return branch, err
+			}
+			branch.Batches = append(branch.Batches, historyBatchRecord{
+				TransactionID: resp.TransactionIDs[i],
+				History:       data,
+			})
+		}
+		if len(resp.NextPageToken) == 0 {
+			return branch, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
@@ -0,0 +1,361 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/objectstore"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	restoredExecution struct {
+		namespaceID string
+		workflowID  string
+		runID       string
+	}
+)
+
+// Restore writes the snapshot with the given ID from the store into this cluster, which must have the same
+// number of history shards. Executions which already exist are skipped, so that a failed restore can be
+// retried. The tasks of the restored executions are regenerated by the history service.
+func (m *Manager) Restore(
+	ctx context.Context,
+	store objectstore.Store,
+	snapshotID string,
+) (*Manifest, error) {
+	if err := validateSnapshotID(snapshotID); err != nil {
+		return nil, err
+	}
+
+	manifest, err := m.readManifest(ctx, store, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.NumHistoryShards != m.numHistoryShards {
+		return nil, serviceerror.NewFailedPrecondition(fmt.Sprintf(
+			"snapshot has %d history shards but the cluster has %d", manifest.NumHistoryShards, m.numHistoryShards,
+		))
+	}
+
+	if err := m.restoreNamespaces(ctx, store, snapshotID); err != nil {
+		return nil, err
+	}
+	for _, shard := range manifest.Shards {
+		if err := m.restoreShard(ctx, store, snapshotID, shard.ShardID); err != nil {
+			return nil, err
+		}
+	}
+
+	m.logger.Info("Cluster snapshot restored.", tag.NewStringTag("snapshot-id", snapshotID))
+	return manifest, nil
+}
+
+func (m *Manager) readManifest(
+	ctx context.Context,
+	store objectstore.Store,
+	snapshotID string,
+) (*Manifest, error) {
+	reader, err := store.Get(ctx, manifestKey(snapshotID))
+	if errors.Is(err, objectstore.ErrObjectNotFound) {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("snapshot %s not found or incomplete", snapshotID))
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = reader.Close() }()
+
+	var manifest Manifest
+	if err := json.NewDecoder(reader).Decode(&manifest); err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+func (m *Manager) restoreNamespaces(
+	ctx context.Context,
+	store objectstore.Store,
+	snapshotID string,
+) error {
+	return readRecords(ctx, store, namespacesKey(snapshotID), func(decoder *json.Decoder) error {
+		for {
+			var record namespaceRecord
+			if err := decoder.Decode(&record); err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+
+			detail := &persistencespb.NamespaceDetail{}
+			if err := detail.Unmarshal(record.Namespace); err != nil {
+				return err
+			}
+			_, err := m.metadataManager.CreateNamespace(ctx, &persistence.CreateNamespaceRequest{
+				Namespace:         detail,
+				IsGlobalNamespace: record.IsGlobalNamespace,
+			})
+			if _, ok := err.(*serviceerror.NamespaceAlreadyExists); ok {
+				// the namespace is restored by a previous attempt, unless another namespace has the same name
+				if _, err := m.metadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{ID: detail.Info.Id}); err != nil {
+					return serviceerror.NewFailedPrecondition(fmt.Sprintf(
+						"namespace %s already exists with a different ID", detail.Info.Name,
+					))
+				}
+				continue
+			}
+			if err != nil {
+				return err
+			}
+		}
+	})
+}
+
+func (m *Manager) restoreShard(
+	ctx context.Context,
+	store objectstore.Store,
+	snapshotID string,
+	shardID int32,
+) error {
+	var executions []restoredExecution
+	for attempt := 1; ; attempt++ {
+		var err error
+		executions, err = m.restoreShardOnce(ctx, store, snapshotID, shardID)
+		var ownershipLost *persistence.ShardOwnershipLostError
+		if errors.Is(err, errShardFenceLost) || errors.As(err, &ownershipLost) {
+			if attempt < fenceAttempts {
+				m.logger.Warn("Shard was reacquired during cluster snapshot restore, retrying.", tag.ShardID(shardID), tag.Attempt(int32(attempt)))
+				continue
+			}
+			return serviceerror.NewUnavailable(errShardFenceLost.Error())
+		}
+		if err != nil {
+			return err
+		}
+		break
+	}
+
+	// tasks are regenerated once the shard is released, as the history service takes the shard back to do so
+	for _, execution := range executions {
+		if _, err := m.historyClient.RefreshWorkflowTasks(ctx, &historyservice.RefreshWorkflowTasksRequest{
+			NamespaceId: execution.namespaceID,
+			Request: &adminservice.RefreshWorkflowTasksRequest{
+				NamespaceId: execution.namespaceID,
+				Execution: &commonpb.WorkflowExecution{
+					WorkflowId: execution.workflowID,
+					RunId:      execution.runID,
+				},
+			},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Manager) restoreShardOnce(
+	ctx context.Context,
+	store objectstore.Store,
+	snapshotID string,
+	shardID int32,
+) ([]restoredExecution, error) {
+	rangeID, err := m.fenceShard(ctx, shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	var executions []restoredExecution
+	err = readRecords(ctx, store, shardKey(snapshotID, shardID), func(decoder *json.Decoder) error {
+		for {
+			var record executionRecord
+			if err := decoder.Decode(&record); err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+
+			execution, err := m.restoreExecution(ctx, shardID, rangeID, &record)
+			if err != nil {
+				return err
+			}
+			executions = append(executions, execution)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return executions, m.verifyShardFence(ctx, shardID, rangeID)
+}
+
+func (m *Manager) restoreExecution(
+	ctx context.Context,
+	shardID int32,
+	rangeID int64,
+	record *executionRecord,
+) (restoredExecution, error) {
+	state := &persistencespb.WorkflowMutableState{}
+	if err := state.Unmarshal(record.MutableState); err != nil {
+		return restoredExecution{}, err
+	}
+	executionInfo := state.ExecutionInfo
+	execution := restoredExecution{
+		namespaceID: executionInfo.NamespaceId,
+		workflowID:  executionInfo.WorkflowId,
+		runID:       state.ExecutionState.RunId,
+	}
+
+	_, err := m.executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: execution.namespaceID,
+		WorkflowID:  execution.workflowID,
+		RunID:       execution.runID,
+	})
+	switch err.(type) {
+	case nil:
+		return execution, nil
+	case *serviceerror.NotFound:
+	default:
+		return restoredExecution{}, err
+	}
+
+	histories := executionInfo.GetVersionHistories().GetHistories()
+	if len(histories) != len(record.Branches) {
+		return restoredExecution{}, serviceerror.NewInternal(fmt.Sprintf(
+			"snapshot of workflow %s run %s has %d history branches but %d version histories",
+			execution.workflowID, execution.runID, len(record.Branches), len(histories),
+		))
+	}
+	for i, branch := range record.Branches {
+		branchToken, err := m.restoreHistoryBranch(ctx, shardID, execution, branch)
+		if err != nil {
+			return restoredExecution{}, err
+		}
+		histories[i].BranchToken = branchToken
+	}
+
+	mode := persistence.CreateWorkflowModeBypassCurrent
+	if record.IsCurrent {
+		mode = persistence.CreateWorkflowModeBrandNew
+	}
+	if _, err := m.executionManager.CreateWorkflowExecution(ctx, &persistence.CreateWorkflowExecutionRequest{
+		ShardID: shardID,
+		RangeID: rangeID,
+		Mode:    mode,
+		NewWorkflowSnapshot: persistence.WorkflowSnapshot{
+			ExecutionInfo:       executionInfo,
+			ExecutionState:      state.ExecutionState,
+			NextEventID:         state.NextEventId,
+			ActivityInfos:       state.ActivityInfos,
+			TimerInfos:          state.TimerInfos,
+			ChildExecutionInfos: state.ChildExecutionInfos,
+			RequestCancelInfos:  state.RequestCancelInfos,
+			SignalInfos:         state.SignalInfos,
+			SignalRequestedIDs:  convert.StringSliceToSet(state.SignalRequestedIds),
+			Condition:           state.NextEventId,
+			DBRecordVersion:     1,
+			// the checksum is not restored as the branch tokens are rewritten, it is recomputed on the next update
+		},
+	}); err != nil {
+		return restoredExecution{}, err
+	}
+
+	if len(state.BufferedEvents) == 0 {
+		return execution, nil
+	}
+	updateMode := persistence.UpdateWorkflowModeBypassCurrent
+	if record.IsCurrent {
+		updateMode = persistence.UpdateWorkflowModeUpdateCurrent
+	}
+	_, err = m.executionManager.UpdateWorkflowExecution(ctx, &persistence.UpdateWorkflowExecutionRequest{
+		ShardID: shardID,
+		RangeID: rangeID,
+		Mode:    updateMode,
+		UpdateWorkflowMutation: persistence.WorkflowMutation{
+			ExecutionInfo:     executionInfo,
+			ExecutionState:    state.ExecutionState,
+			NextEventID:       state.NextEventId,
+			NewBufferedEvents: state.BufferedEvents,
+			Condition:         state.NextEventId,
+			DBRecordVersion:   2,
+		},
+	})
+	return execution, err
+}
+
+// restoreHistoryBranch writes the history of the branch and returns the token of the restored branch,
+// which has no ancestors as the snapshot of a branch includes the events inherited from its ancestors
+func (m *Manager) restoreHistoryBranch(
+	ctx context.Context,
+	shardID int32,
+	execution restoredExecution,
+	branch historyBranchRecord,
+) ([]byte, error) {
+	branchUtil := m.executionManager.GetHistoryBranchUtil()
+	branchInfo, err := branchUtil.ParseHistoryBranchInfo(branch.BranchToken)
+	if err != nil {
+		return nil, err
+	}
+	branchToken, err := branchUtil.UpdateHistoryBranchInfo(branch.BranchToken, &persistencespb.HistoryBranch{
+		TreeId:   branchInfo.TreeId,
+		BranchId: branchInfo.BranchId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var prevTransactionID int64
+	for i, batch := range branch.Batches {
+		history := &historypb.History{}
+		if err := history.Unmarshal(batch.History); err != nil {
+			return nil, err
+		}
+		if _, err := m.executionManager.AppendHistoryNodes(ctx, &persistence.AppendHistoryNodesRequest{
+			ShardID:           shardID,
+			IsNewBranch:       i == 0,
+			Info:              persistence.BuildHistoryGarbageCleanupInfo(execution.namespaceID, execution.workflowID, execution.runID),
+			BranchToken:       branchToken,
+			Events:            history.Events,
+			PrevTransactionID: prevTransactionID,
+			TransactionID:     batch.TransactionID,
+		}); err != nil {
+			return nil, err
+		}
+		prevTransactionID = batch.TransactionID
+	}
+	return branchToken, nil
+}
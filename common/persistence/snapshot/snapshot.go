@@ -0,0 +1,246 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package snapshot takes logical snapshots of a cluster, made of its namespace metadata and the
+// mutable state and history of every workflow execution, and restores them into another cluster.
+package snapshot
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/objectstore"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	manifestObject    = "manifest.json"
+	namespacesObject  = "namespaces.jsonl.gz"
+	shardObjectFormat = "shards/%d.jsonl.gz"
+
+	// snapshotShardOwner is the owner of a shard while it is fenced by a snapshot or a restore
+	snapshotShardOwner = "cluster-snapshot"
+	fenceAttempts      = 3
+
+	listPageSize    = 100
+	historyPageSize = 100
+)
+
+var (
+	errShardFenceLost = errors.New("shard was reacquired by the history service while fenced")
+)
+
+type (
+	// Manifest describes a complete snapshot, it is written after all other objects of the snapshot
+	Manifest struct {
+		SnapshotID       string
+		CreateTime       time.Time
+		NumHistoryShards int32
+		Namespaces       int
+		Shards           []ShardManifest
+	}
+
+	ShardManifest struct {
+		ShardID    int32
+		Executions int
+	}
+
+	// Manager takes and restores cluster snapshots. Each shard is fenced while it is read or written by
+	// taking over its range ID, so that the history service can't update it concurrently.
+	Manager struct {
+		numHistoryShards int32
+		shardManager     persistence.ShardManager
+		executionManager persistence.ExecutionManager
+		metadataManager  persistence.MetadataManager
+		historyClient    historyservice.HistoryServiceClient
+		timeSource       clock.TimeSource
+		logger           log.Logger
+	}
+
+	namespaceRecord struct {
+		Namespace         []byte
+		IsGlobalNamespace bool
+	}
+
+	executionRecord struct {
+		MutableState []byte
+		IsCurrent    bool
+		// Branches are in the order of the version histories of the mutable state
+		Branches []historyBranchRecord
+	}
+
+	historyBranchRecord struct {
+		BranchToken []byte
+		Batches     []historyBatchRecord
+	}
+
+	historyBatchRecord struct {
+		TransactionID int64
+		History       []byte
+	}
+)
+
+func NewManager(
+	numHistoryShards int32,
+	shardManager persistence.ShardManager,
+	executionManager persistence.ExecutionManager,
+	metadataManager persistence.MetadataManager,
+	historyClient historyservice.HistoryServiceClient,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) *Manager {
+	return &Manager{
+		numHistoryShards: numHistoryShards,
+		shardManager:     shardManager,
+		executionManager: executionManager,
+		metadataManager:  metadataManager,
+		historyClient:    historyClient,
+		timeSource:       timeSource,
+		logger:           logger,
+	}
+}
+
+func (m *Manager) fenceShard(
+	ctx context.Context,
+	shardID int32,
+) (int64, error) {
+	resp, err := m.shardManager.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{
+		ShardID: shardID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	shardInfo := resp.ShardInfo
+	previousRangeID := shardInfo.GetRangeId()
+	shardInfo.ShardId = shardID
+	shardInfo.RangeId = previousRangeID + 1
+	shardInfo.Owner = snapshotShardOwner
+	if err := m.shardManager.UpdateShard(ctx, &persistence.UpdateShardRequest{
+		ShardInfo:       shardInfo,
+		PreviousRangeID: previousRangeID,
+	}); err != nil {
+		return 0, err
+	}
+	return shardInfo.RangeId, nil
+}
+
+func (m *Manager) verifyShardFence(
+	ctx context.Context,
+	shardID int32,
+	rangeID int64,
+) error {
+	resp, err := m.shardManager.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{
+		ShardID: shardID,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.ShardInfo.GetRangeId() != rangeID {
+		return errShardFenceLost
+	}
+	return nil
+}
+
+func manifestKey(snapshotID string) string {
+	return path.Join(snapshotID, manifestObject)
+}
+
+func namespacesKey(snapshotID string) string {
+	return path.Join(snapshotID, namespacesObject)
+}
+
+func shardKey(snapshotID string, shardID int32) string {
+	return path.Join(snapshotID, fmt.Sprintf(shardObjectFormat, shardID))
+}
+
+// writeRecords streams the records encoded by write to the object as gzipped JSON lines
+func writeRecords(
+	ctx context.Context,
+	store objectstore.Store,
+	key string,
+	write func(encoder *json.Encoder) error,
+) error {
+	reader, writer := io.Pipe()
+	writeErrCh := make(chan error, 1)
+	go func() {
+		gzipWriter := gzip.NewWriter(writer)
+		err := write(json.NewEncoder(gzipWriter))
+		if err == nil {
+			err = gzipWriter.Close()
+		}
+		_ = writer.CloseWithError(err)
+		writeErrCh <- err
+	}()
+
+	putErr := store.Put(ctx, key, reader)
+	// unblock the writer in case the store stopped reading early
+	_ = reader.CloseWithError(putErr)
+	writeErr := <-writeErrCh
+	if putErr != nil {
+		return putErr
+	}
+	return writeErr
+}
+
+// readRecords calls read with a decoder of the gzipped JSON lines of the object
+func readRecords(
+	ctx context.Context,
+	store objectstore.Store,
+	key string,
+	read func(decoder *json.Decoder) error,
+) error {
+	reader, err := store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = reader.Close() }()
+
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return err
+	}
+	return read(json.NewDecoder(gzipReader))
+}
+
+func validateSnapshotID(
+	snapshotID string,
+) error {
+	if len(snapshotID) == 0 || strings.ContainsAny(snapshotID, "/\\") || snapshotID == "." || snapshotID == ".." {
+		return serviceerror.NewInvalidArgument("snapshot ID must be a non empty name without path separators")
+	}
+	return nil
+}
@@ -0,0 +1,253 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package snapshot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/objectstore"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	snapshotSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller       *gomock.Controller
+		shardManager     *persistence.MockShardManager
+		executionManager *persistence.MockExecutionManager
+		metadataManager  *persistence.MockMetadataManager
+		historyClient    *historyservicemock.MockHistoryServiceClient
+		store            objectstore.Store
+		manager          *Manager
+	}
+)
+
+const (
+	testNamespaceID = "9c4f32e8-0d6f-4cb8-9a1a-2d8f5e5d3b10"
+	testWorkflowID  = "test-workflow"
+	testRunID       = "3b9f5bb4-6c5d-4f57-93d5-6d4e5b1d7c21"
+	testTreeID      = "0d5b2a3e-7f4a-4a43-8f3d-0c8b9e3f2a11"
+	testBranchID    = "7e4c1d2b-1a3f-4c5e-9b8d-2f6a7c8d9e01"
+)
+
+func TestSnapshotSuite(t *testing.T) {
+	s := new(snapshotSuite)
+	suite.Run(t, s)
+}
+
+func (s *snapshotSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.shardManager = persistence.NewMockShardManager(s.controller)
+	s.executionManager = persistence.NewMockExecutionManager(s.controller)
+	s.metadataManager = persistence.NewMockMetadataManager(s.controller)
+	s.historyClient = historyservicemock.NewMockHistoryServiceClient(s.controller)
+
+	store, err := objectstore.NewFileStore(s.T().TempDir())
+	s.NoError(err)
+	s.store = store
+	s.manager = NewManager(
+		1,
+		s.shardManager,
+		s.executionManager,
+		s.metadataManager,
+		s.historyClient,
+		clock.NewRealTimeSource(),
+		log.NewNoopLogger(),
+	)
+}
+
+func (s *snapshotSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *snapshotSuite) expectShardFence(rangeID int64, reacquired bool) {
+	s.shardManager.EXPECT().GetOrCreateShard(gomock.Any(), &persistence.GetOrCreateShardRequest{ShardID: 1}).Return(
+		&persistence.GetOrCreateShardResponse{ShardInfo: &persistencespb.ShardInfo{ShardId: 1, RangeId: rangeID, Owner: "history-host"}}, nil,
+	)
+	s.shardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateShardRequest) error {
+			s.Equal(rangeID, request.PreviousRangeID)
+			s.Equal(rangeID+1, request.ShardInfo.RangeId)
+			s.Equal(snapshotShardOwner, request.ShardInfo.Owner)
+			return nil
+		},
+	)
+	verifiedRangeID := rangeID + 1
+	if reacquired {
+		verifiedRangeID++
+	}
+	s.shardManager.EXPECT().GetOrCreateShard(gomock.Any(), &persistence.GetOrCreateShardRequest{ShardID: 1}).Return(
+		&persistence.GetOrCreateShardResponse{ShardInfo: &persistencespb.ShardInfo{ShardId: 1, RangeId: verifiedRangeID}}, nil,
+	)
+}
+
+func (s *snapshotSuite) backup() {
+	branchToken, err := persistence.NewHistoryBranch(testTreeID, convert.StringPtr(testBranchID), []*persistencespb.HistoryBranchRange{
+		{BranchId: "ancestor", BeginNodeId: 1, EndNodeId: 2},
+	})
+	s.NoError(err)
+
+	s.metadataManager.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).Return(&persistence.ListNamespacesResponse{
+		Namespaces: []*persistence.GetNamespaceResponse{{
+			Namespace: &persistencespb.NamespaceDetail{
+				Info: &persistencespb.NamespaceInfo{Id: testNamespaceID, Name: "test-namespace"},
+			},
+			IsGlobalNamespace: true,
+		}},
+	}, nil)
+
+	s.expectShardFence(5, false)
+	s.executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				NamespaceId: testNamespaceID,
+				WorkflowId:  testWorkflowID,
+				VersionHistories: &historyspb.VersionHistories{
+					Histories: []*historyspb.VersionHistory{{
+						BranchToken: branchToken,
+						Items:       []*historyspb.VersionHistoryItem{{EventId: 2, Version: 1}},
+					}},
+				},
+			},
+			ExecutionState: &persistencespb.WorkflowExecutionState{RunId: testRunID},
+			NextEventId:    3,
+		}},
+	}, nil)
+	s.executionManager.EXPECT().GetCurrentExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetCurrentExecutionResponse{RunID: testRunID}, nil)
+	s.executionManager.EXPECT().ReadHistoryBranchByBatch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.ReadHistoryBranchRequest) (*persistence.ReadHistoryBranchByBatchResponse, error) {
+			s.Equal(int64(3), request.MaxEventID)
+			return &persistence.ReadHistoryBranchByBatchResponse{
+				History: []*historypb.History{{Events: []*historypb.HistoryEvent{
+					{EventId: 1, Version: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
+					{EventId: 2, Version: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+				}}},
+				TransactionIDs: []int64{7},
+			}, nil
+		},
+	)
+
+	manifest, err := s.manager.Backup(context.Background(), s.store, "snapshot-1")
+	s.NoError(err)
+	s.Equal(1, manifest.Namespaces)
+	s.Equal([]ShardManifest{{ShardID: 1, Executions: 1}}, manifest.Shards)
+}
+
+func (s *snapshotSuite) TestBackupAndRestore() {
+	s.backup()
+
+	s.expectShardFence(1, false)
+	s.metadataManager.EXPECT().CreateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.CreateNamespaceRequest) (*persistence.CreateNamespaceResponse, error) {
+			s.Equal(testNamespaceID, request.Namespace.Info.Id)
+			s.True(request.IsGlobalNamespace)
+			return &persistence.CreateNamespaceResponse{ID: testNamespaceID}, nil
+		},
+	)
+	s.executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("not found"))
+	s.executionManager.EXPECT().GetHistoryBranchUtil().Return(&persistence.HistoryBranchUtilImpl{})
+	var restoredBranchToken []byte
+	s.executionManager.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.AppendHistoryNodesRequest) (*persistence.AppendHistoryNodesResponse, error) {
+			s.True(request.IsNewBranch)
+			s.Equal(int64(7), request.TransactionID)
+			s.Len(request.Events, 2)
+			branchInfo, err := (&persistence.HistoryBranchUtilImpl{}).ParseHistoryBranchInfo(request.BranchToken)
+			s.NoError(err)
+			s.Equal(testTreeID, branchInfo.TreeId)
+			s.Equal(testBranchID, branchInfo.BranchId)
+			s.Empty(branchInfo.Ancestors)
+			restoredBranchToken = request.BranchToken
+			return &persistence.AppendHistoryNodesResponse{}, nil
+		},
+	)
+	s.executionManager.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.CreateWorkflowExecutionRequest) (*persistence.CreateWorkflowExecutionResponse, error) {
+			s.Equal(int64(2), request.RangeID)
+			s.Equal(persistence.CreateWorkflowModeBrandNew, request.Mode)
+			s.Equal(testRunID, request.NewWorkflowSnapshot.ExecutionState.RunId)
+			s.Equal(restoredBranchToken, request.NewWorkflowSnapshot.ExecutionInfo.VersionHistories.Histories[0].BranchToken)
+			return &persistence.CreateWorkflowExecutionResponse{}, nil
+		},
+	)
+	s.historyClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.RefreshWorkflowTasksRequest, _ ...interface{}) (*historyservice.RefreshWorkflowTasksResponse, error) {
+			s.Equal(testNamespaceID, request.NamespaceId)
+			s.Equal(&commonpb.WorkflowExecution{WorkflowId: testWorkflowID, RunId: testRunID}, request.Request.Execution)
+			return &historyservice.RefreshWorkflowTasksResponse{}, nil
+		},
+	)
+
+	manifest, err := s.manager.Restore(context.Background(), s.store, "snapshot-1")
+	s.NoError(err)
+	s.Equal("snapshot-1", manifest.SnapshotID)
+}
+
+func (s *snapshotSuite) TestBackup_ShardReacquired() {
+	s.metadataManager.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).Return(&persistence.ListNamespacesResponse{}, nil)
+	for attempt := 0; attempt < fenceAttempts; attempt++ {
+		s.expectShardFence(5, true)
+		s.executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{}, nil)
+	}
+
+	_, err := s.manager.Backup(context.Background(), s.store, "snapshot-1")
+	s.IsType(&serviceerror.Unavailable{}, err)
+
+	_, err = s.manager.Restore(context.Background(), s.store, "snapshot-1")
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
+func (s *snapshotSuite) TestRestore_ShardCountMismatch() {
+	s.backup()
+
+	s.manager.numHistoryShards = 4
+	_, err := s.manager.Restore(context.Background(), s.store, "snapshot-1")
+	s.IsType(&serviceerror.FailedPrecondition{}, err)
+}
+
+func (s *snapshotSuite) TestInvalidSnapshotID() {
+	_, err := s.manager.Backup(context.Background(), s.store, "../snapshot")
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
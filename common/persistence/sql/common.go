@@ -70,10 +70,27 @@ func (m *SqlStore) Close() {
 	}
 }
 
+// maxTxAttempts bounds how many times a transaction aborted by the database is retried, see sqlplugin.DB.IsRetryableTxError
+const maxTxAttempts = 5
+
 func (m *SqlStore) txExecute(ctx context.Context, operation string, f func(tx sqlplugin.Tx) error) error {
+	var err error
+	for attempt := int32(1); attempt <= maxTxAttempts; attempt++ {
+		var retryable bool
+		if retryable, err = m.txExecuteOnce(ctx, operation, f); !retryable {
+			return err
+		}
+		m.logger.Debug("transaction aborted by database, retrying", tag.Operation(operation), tag.Attempt(attempt), tag.Error(err))
+	}
+	return err
+}
+
+// txExecuteOnce runs f in a single transaction. The returned bool reports whether the database aborted
+// the transaction in a way that allows running it again.
+func (m *SqlStore) txExecuteOnce(ctx context.Context, operation string, f func(tx sqlplugin.Tx) error) (bool, error) {
 	tx, err := m.Db.BeginTx(ctx)
 	if err != nil {
-		return serviceerror.NewUnavailable(fmt.Sprintf("%s failed. Failed to start transaction. Error: %v", operation, err))
+		return false, serviceerror.NewUnavailable(fmt.Sprintf("%s failed. Failed to start transaction. Error: %v", operation, err))
 	}
 	err = f(tx)
 	if err != nil {
@@ -89,15 +106,15 @@ func (m *SqlStore) txExecute(ctx context.Context, operation string, f func(tx sq
 			*serviceerror.NamespaceAlreadyExists,
 			*persistence.ShardOwnershipLostError,
 			*serviceerror.Unavailable:
-			return err
+			return false, err
 		default:
-			return serviceerror.NewUnavailable(fmt.Sprintf("%v: %v", operation, err))
+			return m.Db.IsRetryableTxError(err), serviceerror.NewUnavailable(fmt.Sprintf("%v: %v", operation, err))
 		}
 	}
 	if err := tx.Commit(); err != nil {
-		return serviceerror.NewUnavailable(fmt.Sprintf("%s operation failed. Failed to commit transaction. Error: %v", operation, err))
+		return m.Db.IsRetryableTxError(err), serviceerror.NewUnavailable(fmt.Sprintf("%s operation failed. Failed to commit transaction. Error: %v", operation, err))
 	}
-	return nil
+	return false, nil
 }
 
 func gobSerialize(x interface{}) ([]byte, error) {
@@ -50,29 +50,11 @@ func (m *sqlExecutionStore) AppendHistoryNodes(
 	ctx context.Context,
 	request *p.InternalAppendHistoryNodesRequest,
 ) error {
-	branchInfo := request.BranchInfo
-	node := request.Node
-
-	treeIDBytes, err := primitives.ParseUUID(branchInfo.GetTreeId())
-	if err != nil {
-		return err
-	}
-	branchIDBytes, err := primitives.ParseUUID(branchInfo.GetBranchId())
+	nodeRow, treeRow, err := newHistoryNodeRows(request)
 	if err != nil {
 		return err
 	}
 
-	nodeRow := &sqlplugin.HistoryNodeRow{
-		TreeID:       treeIDBytes,
-		BranchID:     branchIDBytes,
-		NodeID:       node.NodeID,
-		PrevTxnID:    node.PrevTransactionID,
-		TxnID:        node.TransactionID,
-		Data:         node.Events.Data,
-		DataEncoding: node.Events.EncodingType.String(),
-		ShardID:      request.ShardID,
-	}
-
 	if !request.IsNewBranch {
 		_, err = m.Db.InsertIntoHistoryNode(ctx, nodeRow)
 		switch err {
@@ -90,15 +72,6 @@ func (m *sqlExecutionStore) AppendHistoryNodes(
 		}
 	}
 
-	treeInfoBlob := request.TreeInfo
-	treeRow := &sqlplugin.HistoryTreeRow{
-		ShardID:      request.ShardID,
-		TreeID:       treeIDBytes,
-		BranchID:     branchIDBytes,
-		Data:         treeInfoBlob.Data,
-		DataEncoding: treeInfoBlob.EncodingType.String(),
-	}
-
 	return m.txExecute(ctx, "AppendHistoryNodes", func(tx sqlplugin.Tx) error {
 		result, err := tx.InsertIntoHistoryNode(ctx, nodeRow)
 		if err != nil {
@@ -133,6 +106,96 @@ func (m *sqlExecutionStore) AppendHistoryNodes(
 	})
 }
 
+// AppendHistoryNodesBatch adds nodes to multiple history branches of a shard in a single transaction
+func (m *sqlExecutionStore) AppendHistoryNodesBatch(
+	ctx context.Context,
+	request *p.InternalAppendHistoryNodesBatchRequest,
+) error {
+	nodeRows := make([]*sqlplugin.HistoryNodeRow, 0, len(request.Requests))
+	var treeRows []*sqlplugin.HistoryTreeRow
+	for _, appendRequest := range request.Requests {
+		nodeRow, treeRow, err := newHistoryNodeRows(appendRequest)
+		if err != nil {
+			return err
+		}
+		nodeRows = append(nodeRows, nodeRow)
+		if appendRequest.IsNewBranch {
+			treeRows = append(treeRows, treeRow)
+		}
+	}
+
+	return m.txExecute(ctx, "AppendHistoryNodesBatch", func(tx sqlplugin.Tx) error {
+		for _, nodeRow := range nodeRows {
+			if _, err := tx.InsertIntoHistoryNode(ctx, nodeRow); err != nil {
+				return convertAppendHistoryNodesBatchError(m.Db, err)
+			}
+		}
+		for _, treeRow := range treeRows {
+			if _, err := tx.InsertIntoHistoryTree(ctx, treeRow); err != nil {
+				return convertAppendHistoryNodesBatchError(m.Db, err)
+			}
+		}
+		return nil
+	})
+}
+
+func convertAppendHistoryNodesBatchError(
+	db sqlplugin.DB,
+	err error,
+) error {
+	switch err {
+	case context.DeadlineExceeded, context.Canceled:
+		return &p.AppendHistoryTimeoutError{
+			Msg: err.Error(),
+		}
+	default:
+		if db.IsDupEntryError(err) {
+			return &p.ConditionFailedError{Msg: fmt.Sprintf("AppendHistoryNodesBatch: row already exist: %v", err)}
+		}
+		return serviceerror.NewUnavailable(fmt.Sprintf("AppendHistoryNodesBatch: %v", err))
+	}
+}
+
+func newHistoryNodeRows(
+	request *p.InternalAppendHistoryNodesRequest,
+) (*sqlplugin.HistoryNodeRow, *sqlplugin.HistoryTreeRow, error) {
+	branchInfo := request.BranchInfo
+	node := request.Node
+
+	treeIDBytes, err := primitives.ParseUUID(branchInfo.GetTreeId())
+	if err != nil {
+		return nil, nil, err
+	}
+	branchIDBytes, err := primitives.ParseUUID(branchInfo.GetBranchId())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nodeRow := &sqlplugin.HistoryNodeRow{
+		TreeID:       treeIDBytes,
+		BranchID:     branchIDBytes,
+		NodeID:       node.NodeID,
+		PrevTxnID:    node.PrevTransactionID,
+		TxnID:        node.TransactionID,
+		Data:         node.Events.Data,
+		DataEncoding: node.Events.EncodingType.String(),
+		ShardID:      request.ShardID,
+	}
+
+	var treeRow *sqlplugin.HistoryTreeRow
+	if request.IsNewBranch {
+		treeInfoBlob := request.TreeInfo
+		treeRow = &sqlplugin.HistoryTreeRow{
+			ShardID:      request.ShardID,
+			TreeID:       treeIDBytes,
+			BranchID:     branchIDBytes,
+			Data:         treeInfoBlob.Data,
+			DataEncoding: treeInfoBlob.EncodingType.String(),
+		}
+	}
+	return nodeRow, treeRow, nil
+}
+
 func (m *sqlExecutionStore) DeleteHistoryNodes(
 	ctx context.Context,
 	request *p.InternalDeleteHistoryNodesRequest,
@@ -193,7 +256,8 @@ func (m *sqlExecutionStore) ReadHistoryBranch(
 	var token historyNodePaginationToken
 	if len(request.NextPageToken) == 0 {
 		if request.ReverseOrder {
-			token = newHistoryNodePaginationToken(request.MaxNodeID, MaxTxnID)
+			// MaxNodeID is exclusive, so no transaction of that node is read
+			token = newHistoryNodePaginationToken(request.MaxNodeID, MinTxnID)
 		} else {
 			token = newHistoryNodePaginationToken(request.MinNodeID, MinTxnID)
 		}
@@ -198,8 +198,9 @@ func (q *sqlQueue) EnqueueMessageToDLQ(
 			})
 			return err
 		case sql.ErrNoRows:
+			lastMessageID = persistence.EmptyQueueMessageID
 			_, err = tx.InsertIntoMessages(ctx, []sqlplugin.QueueMessageRow{
-				newQueueRow(q.getDLQTypeFromQueueType(), persistence.EmptyQueueMessageID+1, blob),
+				newQueueRow(q.getDLQTypeFromQueueType(), lastMessageID+1, blob),
 			})
 			return err
 		default:
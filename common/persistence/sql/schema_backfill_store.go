@@ -0,0 +1,76 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"database/sql"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/persistence/schema"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/resolver"
+)
+
+type (
+	// SchemaBackfillStore persists the progress of schema backfills in the schema_backfill
+	// table of the main database
+	SchemaBackfillStore struct {
+		db     sqlplugin.AdminDB
+		dbName string
+	}
+)
+
+var _ schema.BackfillStore = (*SchemaBackfillStore)(nil)
+
+// NewSchemaBackfillStore returns a SchemaBackfillStore for the given database
+func NewSchemaBackfillStore(
+	cfg *config.SQL,
+	r resolver.ServiceResolver,
+) (*SchemaBackfillStore, error) {
+	db, err := NewSQLAdminDB(sqlplugin.DbKindMain, cfg, r)
+	if err != nil {
+		return nil, err
+	}
+	return &SchemaBackfillStore{
+		db:     db,
+		dbName: cfg.DatabaseName,
+	}, nil
+}
+
+func (s *SchemaBackfillStore) ReadBackfill(name string) ([]byte, bool, error) {
+	cursor, completed, err := s.db.ReadSchemaBackfill(s.dbName, name)
+	if err == sql.ErrNoRows {
+		return nil, false, nil
+	}
+	return cursor, completed, err
+}
+
+func (s *SchemaBackfillStore) UpdateBackfill(name string, cursor []byte, completed bool) error {
+	return s.db.UpdateSchemaBackfill(s.dbName, name, cursor, completed)
+}
+
+func (s *SchemaBackfillStore) Close() {
+	_ = s.db.Close()
+}
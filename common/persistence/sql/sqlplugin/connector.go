@@ -0,0 +1,121 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"context"
+	"database/sql/driver"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	passwordRefreshMinInterval = 5 * time.Second
+)
+
+type (
+	// connector opens connections to the database of a SQL config. If the password of the config
+	// is a secret reference, it is resolved again when a connection is refused, so that connections
+	// opened after the password was rotated use the new one.
+	connector struct {
+		driver   driver.Driver
+		cfg      config.SQL
+		buildDSN func(cfg *config.SQL) string
+
+		sync.Mutex
+		dsn             string
+		passwordRefresh time.Time
+	}
+)
+
+var _ driver.Connector = (*connector)(nil)
+
+// NewConnector returns a connector for the database of cfg, to be opened with sql.OpenDB.
+// buildDSN builds the plugin's data source name for a config.
+func NewConnector(
+	drv driver.Driver,
+	cfg *config.SQL,
+	buildDSN func(cfg *config.SQL) string,
+) driver.Connector {
+	return &connector{
+		driver:   drv,
+		cfg:      *cfg,
+		buildDSN: buildDSN,
+		dsn:      buildDSN(cfg),
+	}
+}
+
+func (c *connector) Connect(ctx context.Context) (driver.Conn, error) {
+	c.Lock()
+	dsn := c.dsn
+	c.Unlock()
+
+	conn, err := c.open(ctx, dsn)
+	if err == nil || c.cfg.RefreshPassword == nil {
+		return conn, err
+	}
+	refreshedDSN, ok := c.refreshPassword(ctx)
+	if !ok || refreshedDSN == dsn {
+		return nil, err
+	}
+	return c.open(ctx, refreshedDSN)
+}
+
+func (c *connector) Driver() driver.Driver {
+	return c.driver
+}
+
+func (c *connector) open(ctx context.Context, dsn string) (driver.Conn, error) {
+	if driverCtx, ok := c.driver.(driver.DriverContext); ok {
+		connector, err := driverCtx.OpenConnector(dsn)
+		if err != nil {
+			return nil, err
+		}
+		return connector.Connect(ctx)
+	}
+	return c.driver.Open(dsn)
+}
+
+// refreshPassword resolves the password again and returns the data source name using it. Refreshes
+// are spaced by passwordRefreshMinInterval so that an unreachable database doesn't flood the secret
+// provider, a caller within the interval gets the data source name of the last refresh.
+func (c *connector) refreshPassword(ctx context.Context) (string, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	if time.Since(c.passwordRefresh) < passwordRefreshMinInterval {
+		return c.dsn, true
+	}
+	c.passwordRefresh = time.Now()
+	password, err := c.cfg.RefreshPassword(ctx)
+	if err != nil {
+		return "", false
+	}
+	c.cfg.Password = password
+	c.dsn = c.buildDSN(&c.cfg)
+	return c.dsn, true
+}
@@ -90,6 +90,10 @@ type (
 		ReadSchemaVersion(database string) (string, error)
 		UpdateSchemaVersion(database string, newVersion string, minCompatibleVersion string) error
 		WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error
+		ReadSchemaMinCompatibleVersion(database string) (string, error)
+		CreateSchemaBackfillTable() error
+		ReadSchemaBackfill(database string, name string) ([]byte, bool, error)
+		UpdateSchemaBackfill(database string, name string, cursor []byte, completed bool) error
 		ListTables(database string) ([]string, error)
 		DropTable(table string) error
 		DropAllTables(database string) error
@@ -113,6 +117,9 @@ type (
 		PluginName() string
 		DbName() string
 		IsDupEntryError(err error) bool
+		// IsRetryableTxError returns true if the database aborted the transaction and expects the client
+		// to retry it from the beginning, e.g. on serialization conflicts.
+		IsRetryableTxError(err error) bool
 		Close() error
 	}
 
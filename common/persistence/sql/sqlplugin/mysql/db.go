@@ -60,6 +60,10 @@ func (mdb *db) IsDupEntryError(err error) bool {
 	return ok && sqlErr.Number == ErrDupEntryCode
 }
 
+func (mdb *db) IsRetryableTxError(_ error) bool {
+	return false
+}
+
 // newDB returns an instance of DB, which is a logical
 // connection to the underlying mysql database
 func newDB(
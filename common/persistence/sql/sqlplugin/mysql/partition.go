@@ -0,0 +1,123 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+)
+
+const (
+	listTablePartitionsQuery = `SELECT partition_name FROM information_schema.partitions ` +
+		`WHERE table_schema = DATABASE() AND table_name = ? AND partition_name IS NOT NULL`
+
+	overflowPartitionName = "overflow"
+
+	createTablePartitionQuery = "ALTER TABLE %v REORGANIZE PARTITION %v INTO " +
+		"(PARTITION %v VALUES LESS THAN (%d), PARTITION %v VALUES LESS THAN MAXVALUE)"
+	dropTablePartitionQuery = "ALTER TABLE %v DROP PARTITION %v"
+)
+
+var _ sqlplugin.TablePartitionAdmin = (*db)(nil)
+
+// ListTablePartitions returns the partitions of the table ordered by shard ID, excluding the overflow partition
+func (mdb *db) ListTablePartitions(
+	ctx context.Context,
+	table string,
+) ([]sqlplugin.TablePartition, error) {
+	if err := sqlplugin.ValidatePartitionedTableName(table); err != nil {
+		return nil, err
+	}
+
+	var names []string
+	if err := mdb.conn.SelectContext(ctx, &names, listTablePartitionsQuery, table); err != nil {
+		return nil, err
+	}
+	overflow := false
+	var partitions []sqlplugin.TablePartition
+	for _, name := range names {
+		if name == overflowPartitionName {
+			overflow = true
+			continue
+		}
+		if partition, ok := sqlplugin.ParseTablePartitionName(name); ok {
+			partitions = append(partitions, partition)
+		}
+	}
+	if !overflow {
+		return nil, sqlplugin.ErrTableNotPartitioned
+	}
+	sort.Slice(partitions, func(i, j int) bool {
+		return partitions[i].MinShardID < partitions[j].MinShardID
+	})
+	return partitions, nil
+}
+
+// CreateTablePartition splits the partition off the overflow partition, moving the rows of its shards.
+// MySQL range partitions are contiguous, so the partition must start where the last partition ends.
+func (mdb *db) CreateTablePartition(
+	ctx context.Context,
+	table string,
+	partition sqlplugin.TablePartition,
+) error {
+	partitions, err := mdb.ListTablePartitions(ctx, table)
+	if err != nil {
+		return err
+	}
+	if len(partitions) > 0 && partitions[len(partitions)-1].MaxShardID != partition.MinShardID {
+		return fmt.Errorf(
+			"partition %v of table %v does not start at the end of the last partition %v",
+			partition.Name(),
+			table,
+			partitions[len(partitions)-1].Name(),
+		)
+	}
+
+	_, err = mdb.conn.ExecContext(ctx, fmt.Sprintf(
+		createTablePartitionQuery,
+		table,
+		overflowPartitionName,
+		partition.Name(),
+		partition.MaxShardID,
+		overflowPartitionName,
+	))
+	return err
+}
+
+// DropTablePartition drops the partition along with the rows of its shards
+func (mdb *db) DropTablePartition(
+	ctx context.Context,
+	table string,
+	partition sqlplugin.TablePartition,
+) error {
+	if err := sqlplugin.ValidatePartitionedTableName(table); err != nil {
+		return err
+	}
+	_, err := mdb.conn.ExecContext(ctx, fmt.Sprintf(dropTablePartitionQuery, table, partition.Name()))
+	return err
+}
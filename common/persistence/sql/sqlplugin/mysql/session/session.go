@@ -27,6 +27,7 @@ package session
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"database/sql"
 	"fmt"
 	"os"
 	"strings"
@@ -37,6 +38,7 @@ import (
 
 	"go.temporal.io/server/common/auth"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/resolver"
 )
 
@@ -85,8 +87,13 @@ func createConnection(
 		return nil, err
 	}
 
-	db, err := sqlx.Connect(driverName, buildDSN(cfg, resolver))
-	if err != nil {
+	db := sqlx.NewDb(sql.OpenDB(sqlplugin.NewConnector(
+		mysql.MySQLDriver{},
+		cfg,
+		func(cfg *config.SQL) string { return buildDSN(cfg, resolver) },
+	)), driverName)
+	if err := db.Ping(); err != nil {
+		_ = db.Close()
 		return nil, err
 	}
 	if cfg.MaxConns > 0 {
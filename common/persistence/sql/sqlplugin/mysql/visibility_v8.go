@@ -246,6 +246,18 @@ func (mdb *dbV8) CountFromVisibility(
 	return count, nil
 }
 
+func (mdb *dbV8) CountGroupByFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) ([]sqlplugin.VisibilityCountRow, error) {
+	var rows []sqlplugin.VisibilityCountRow
+	err := mdb.conn.SelectContext(ctx, &rows, filter.Query, filter.QueryArgs...)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
 func (mdb *dbV8) prepareRowForDB(row *sqlplugin.VisibilityRow) *sqlplugin.VisibilityRow {
 	if row == nil {
 		return nil
@@ -25,6 +25,7 @@
 package postgresql
 
 import (
+	"database/sql"
 	"fmt"
 	"time"
 
@@ -40,6 +41,23 @@ const (
 										   	  curr_version = excluded.curr_version,
 										      min_compatible_version = excluded.min_compatible_version;`
 
+	readSchemaMinCompatibleVersionQuery = `SELECT min_compatible_version from schema_version where version_partition=0 and db_name=$1`
+
+	createSchemaBackfillTableQuery = `CREATE TABLE IF NOT EXISTS schema_backfill(` +
+		`db_name VARCHAR(255) not null, ` +
+		`name VARCHAR(255) not null, ` +
+		`backfill_cursor BYTEA, ` +
+		`completed BOOLEAN not null, ` +
+		`update_time TIMESTAMP, ` +
+		`PRIMARY KEY (db_name, name));`
+
+	readSchemaBackfillQuery = `SELECT backfill_cursor, completed from schema_backfill where db_name=$1 and name=$2`
+
+	writeSchemaBackfillQuery = `INSERT INTO schema_backfill(db_name, name, backfill_cursor, completed, update_time) VALUES($1,$2,$3,$4,$5) ` +
+		`ON CONFLICT (db_name, name) DO UPDATE ` +
+		`SET backfill_cursor = excluded.backfill_cursor, completed = excluded.completed, update_time = excluded.update_time ` +
+		`WHERE NOT schema_backfill.completed`
+
 	writeSchemaUpdateHistoryQuery = `INSERT into schema_update_history(version_partition, year, month, update_time, old_version, new_version, manifest_md5, description) VALUES(0,$1,$2,$3,$4,$5,$6,$7)`
 
 	createSchemaVersionTableQuery = `CREATE TABLE IF NOT EXISTS schema_version(` +
@@ -99,6 +117,33 @@ func (pdb *db) WriteSchemaUpdateLog(oldVersion string, newVersion string, manife
 	return pdb.Exec(writeSchemaUpdateHistoryQuery, now.Year(), int(now.Month()), now, oldVersion, newVersion, manifestMD5, desc)
 }
 
+// CreateSchemaBackfillTable sets up the table tracking the progress of schema backfills
+func (pdb *db) CreateSchemaBackfillTable() error {
+	return pdb.Exec(createSchemaBackfillTableQuery)
+}
+
+// ReadSchemaMinCompatibleVersion returns the min compatible schema version for the keyspace
+func (pdb *db) ReadSchemaMinCompatibleVersion(database string) (string, error) {
+	var version sql.NullString
+	err := pdb.db.Get(&version, readSchemaMinCompatibleVersionQuery, database)
+	return version.String, err
+}
+
+// ReadSchemaBackfill returns the progress of a schema backfill
+func (pdb *db) ReadSchemaBackfill(database string, name string) ([]byte, bool, error) {
+	var row struct {
+		BackfillCursor []byte `db:"backfill_cursor"`
+		Completed      bool   `db:"completed"`
+	}
+	err := pdb.db.Get(&row, readSchemaBackfillQuery, database, name)
+	return row.BackfillCursor, row.Completed, err
+}
+
+// UpdateSchemaBackfill records the progress of a schema backfill, unless it already completed
+func (pdb *db) UpdateSchemaBackfill(database string, name string, cursor []byte, completed bool) error {
+	return pdb.Exec(writeSchemaBackfillQuery, database, name, cursor, completed, time.Now().UTC())
+}
+
 // Exec executes a sql statement
 func (pdb *db) Exec(stmt string, args ...interface{}) error {
 	_, err := pdb.db.Exec(stmt, args...)
@@ -26,6 +26,7 @@ package postgresql
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/jmoiron/sqlx"
@@ -36,15 +37,26 @@ import (
 	postgresqlschemaV96 "go.temporal.io/server/schema/postgresql/v96"
 )
 
-// ErrDupEntryCode indicates a duplicate primary key i.e. the row already exists,
-// check http://www.postgresql.org/docs/9.3/static/errcodes-appendix.html
-const ErrDupEntryCode = pq.ErrorCode("23505")
+const (
+	// ErrDupEntryCode indicates a duplicate primary key i.e. the row already exists,
+	// check http://www.postgresql.org/docs/9.3/static/errcodes-appendix.html
+	ErrDupEntryCode = pq.ErrorCode("23505")
+	// ErrSerializationFailureCode indicates the transaction was aborted due to a conflict with
+	// a concurrent transaction and can be retried. PostgreSQL only raises it under SERIALIZABLE or
+	// REPEATABLE READ isolation, CockroachDB raises it for any contended transaction.
+	ErrSerializationFailureCode = pq.ErrorCode("40001")
+)
 
 func (pdb *db) IsDupEntryError(err error) bool {
 	sqlErr, ok := err.(*pq.Error)
 	return ok && sqlErr.Code == ErrDupEntryCode
 }
 
+func (pdb *db) IsRetryableTxError(err error) bool {
+	var sqlErr *pq.Error
+	return errors.As(err, &sqlErr) && sqlErr.Code == ErrSerializationFailureCode
+}
+
 // db represents a logical connection to mysql database
 type db struct {
 	dbKind sqlplugin.DbKind
@@ -0,0 +1,91 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"go.temporal.io/server/common/persistence/schema"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	cockroachdbschemaV23 "go.temporal.io/server/schema/cockroachdb/v23"
+)
+
+// dbCockroachDB represents a logical connection to cockroachdb database
+type dbCockroachDB struct {
+	dbV12
+}
+
+var _ sqlplugin.DB = (*dbCockroachDB)(nil)
+var _ sqlplugin.Tx = (*dbCockroachDB)(nil)
+
+// newDBCockroachDB returns an instance of DB, which is a logical
+// connection to the underlying cockroachdb database
+func newDBCockroachDB(
+	dbKind sqlplugin.DbKind,
+	dbName string,
+	xdb *sqlx.DB,
+	tx *sqlx.Tx,
+) *dbCockroachDB {
+	return &dbCockroachDB{
+		dbV12: *newDBV12(dbKind, dbName, xdb, tx),
+	}
+}
+
+// BeginTx starts a new transaction and returns a reference to the Tx object.
+// CockroachDB always runs transactions with SERIALIZABLE isolation and aborts them with
+// ErrSerializationFailureCode on contention, see IsRetryableTxError.
+func (pdb *dbCockroachDB) BeginTx(ctx context.Context) (sqlplugin.Tx, error) {
+	xtx, err := pdb.db.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return newDBCockroachDB(pdb.dbKind, pdb.dbName, pdb.db.db, xtx), nil
+}
+
+// PluginName returns the name of the cockroachdb plugin
+func (pdb *dbCockroachDB) PluginName() string {
+	return PluginNameCockroachDB
+}
+
+// ExpectedVersion returns expected version.
+func (pdb *dbCockroachDB) ExpectedVersion() string {
+	switch pdb.dbKind {
+	case sqlplugin.DbKindMain:
+		return cockroachdbschemaV23.Version
+	case sqlplugin.DbKindVisibility:
+		return cockroachdbschemaV23.VisibilityVersion
+	default:
+		panic(fmt.Sprintf("unknown db kind %v", pdb.dbKind))
+	}
+}
+
+// VerifyVersion verify schema version is up to date
+func (pdb *dbCockroachDB) VerifyVersion() error {
+	expectedVersion := pdb.ExpectedVersion()
+	return schema.VerifyCompatibleVersion(pdb, pdb.dbName, expectedVersion)
+}
@@ -0,0 +1,155 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+)
+
+const (
+	listTablePartitionsQuery = `SELECT child.relname FROM pg_inherits ` +
+		`JOIN pg_class child ON pg_inherits.inhrelid = child.oid ` +
+		`WHERE pg_inherits.inhparent = $1::regclass`
+
+	overflowPartitionSuffix = "overflow"
+
+	detachTablePartitionQuery    = "ALTER TABLE %v DETACH PARTITION %v"
+	attachOverflowPartitionQuery = "ALTER TABLE %v ATTACH PARTITION %v DEFAULT"
+	createTablePartitionQuery    = "CREATE TABLE %v PARTITION OF %v FOR VALUES FROM (%d) TO (%d)"
+	moveTablePartitionRowsQuery  = `WITH moved AS (DELETE FROM %v WHERE shard_id >= $1 AND shard_id < $2 RETURNING *) ` +
+		`INSERT INTO %v SELECT * FROM moved`
+	dropTablePartitionQuery = "DROP TABLE %v"
+)
+
+var _ sqlplugin.TablePartitionAdmin = (*db)(nil)
+
+// ListTablePartitions returns the partitions of the table ordered by shard ID, excluding the overflow partition
+func (pdb *db) ListTablePartitions(
+	ctx context.Context,
+	table string,
+) ([]sqlplugin.TablePartition, error) {
+	if err := sqlplugin.ValidatePartitionedTableName(table); err != nil {
+		return nil, err
+	}
+
+	var names []string
+	if err := pdb.conn.SelectContext(ctx, &names, listTablePartitionsQuery, table); err != nil {
+		return nil, err
+	}
+	overflow := false
+	var partitions []sqlplugin.TablePartition
+	for _, name := range names {
+		name = strings.TrimPrefix(name, table+"_")
+		if name == overflowPartitionSuffix {
+			overflow = true
+			continue
+		}
+		if partition, ok := sqlplugin.ParseTablePartitionName(name); ok {
+			partitions = append(partitions, partition)
+		}
+	}
+	if !overflow {
+		return nil, sqlplugin.ErrTableNotPartitioned
+	}
+	sort.Slice(partitions, func(i, j int) bool {
+		return partitions[i].MinShardID < partitions[j].MinShardID
+	})
+	return partitions, nil
+}
+
+// CreateTablePartition splits the partition off the overflow partition, moving the rows of its shards.
+// PostgreSQL refuses to create a partition while the default partition holds rows in its range, so the
+// overflow partition is detached for the duration of the transaction.
+func (pdb *db) CreateTablePartition(
+	ctx context.Context,
+	table string,
+	partition sqlplugin.TablePartition,
+) (retError error) {
+	if err := sqlplugin.ValidatePartitionedTableName(table); err != nil {
+		return err
+	}
+	overflowTable := partitionTableName(table, overflowPartitionSuffix)
+	partitionTable := partitionTableName(table, partition.Name())
+
+	tx, err := pdb.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retError != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(detachTablePartitionQuery, table, overflowTable)); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(
+		createTablePartitionQuery,
+		partitionTable,
+		table,
+		partition.MinShardID,
+		partition.MaxShardID,
+	)); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(
+		ctx,
+		fmt.Sprintf(moveTablePartitionRowsQuery, overflowTable, partitionTable),
+		partition.MinShardID,
+		partition.MaxShardID,
+	); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(attachOverflowPartitionQuery, table, overflowTable)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// DropTablePartition drops the partition along with the rows of its shards
+func (pdb *db) DropTablePartition(
+	ctx context.Context,
+	table string,
+	partition sqlplugin.TablePartition,
+) error {
+	if err := sqlplugin.ValidatePartitionedTableName(table); err != nil {
+		return err
+	}
+	_, err := pdb.conn.ExecContext(ctx, fmt.Sprintf(dropTablePartitionQuery, partitionTableName(table, partition.Name())))
+	return err
+}
+
+func partitionTableName(
+	table string,
+	partitionName string,
+) string {
+	return table + "_" + partitionName
+}
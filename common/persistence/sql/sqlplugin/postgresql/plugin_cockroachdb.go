@@ -0,0 +1,76 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package postgresql
+
+import (
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/persistence/sql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/resolver"
+)
+
+const (
+	// PluginNameCockroachDB is the name of the CockroachDB plugin. CockroachDB speaks the PostgreSQL
+	// wire protocol, so it shares the connection handling and queries of the postgres12 plugin.
+	PluginNameCockroachDB = "cockroachdb"
+)
+
+type pluginCockroachDB struct {
+	plugin
+}
+
+var _ sqlplugin.Plugin = (*pluginCockroachDB)(nil)
+
+func init() {
+	sql.RegisterPlugin(PluginNameCockroachDB, &pluginCockroachDB{})
+}
+
+// CreateDB initialize the db object
+func (d *pluginCockroachDB) CreateDB(
+	dbKind sqlplugin.DbKind,
+	cfg *config.SQL,
+	r resolver.ServiceResolver,
+) (sqlplugin.DB, error) {
+	conn, err := d.createDBConnection(cfg, r)
+	if err != nil {
+		return nil, err
+	}
+	db := newDBCockroachDB(dbKind, cfg.DatabaseName, conn, nil)
+	return db, nil
+}
+
+// CreateAdminDB initialize the adminDB object
+func (d *pluginCockroachDB) CreateAdminDB(
+	dbKind sqlplugin.DbKind,
+	cfg *config.SQL,
+	r resolver.ServiceResolver,
+) (sqlplugin.AdminDB, error) {
+	conn, err := d.createDBConnection(cfg, r)
+	if err != nil {
+		return nil, err
+	}
+	db := newDBCockroachDB(dbKind, cfg.DatabaseName, conn, nil)
+	return db, nil
+}
@@ -25,14 +25,17 @@
 package session
 
 import (
+	"database/sql"
 	"fmt"
 	"net/url"
 	"strings"
 
 	"github.com/iancoleman/strcase"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/resolver"
 )
 
@@ -79,8 +82,13 @@ func createConnection(
 	cfg *config.SQL,
 	resolver resolver.ServiceResolver,
 ) (*sqlx.DB, error) {
-	db, err := sqlx.Connect(driverName, buildDSN(cfg, resolver))
-	if err != nil {
+	db := sqlx.NewDb(sql.OpenDB(sqlplugin.NewConnector(
+		pq.Driver{},
+		cfg,
+		func(cfg *config.SQL) string { return buildDSN(cfg, resolver) },
+	)), driverName)
+	if err := db.Ping(); err != nil {
+		_ = db.Close()
 		return nil, err
 	}
 	if cfg.MaxConns > 0 {
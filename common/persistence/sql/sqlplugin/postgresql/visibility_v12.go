@@ -163,6 +163,19 @@ func (pdb *dbV12) CountFromVisibility(
 	return count, nil
 }
 
+func (pdb *dbV12) CountGroupByFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) ([]sqlplugin.VisibilityCountRow, error) {
+	var rows []sqlplugin.VisibilityCountRow
+	filter.Query = pdb.db.db.Rebind(filter.Query)
+	err := pdb.conn.SelectContext(ctx, &rows, filter.Query, filter.QueryArgs...)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
 func (pdb *dbV12) prepareRowForDB(row *sqlplugin.VisibilityRow) *sqlplugin.VisibilityRow {
 	if row == nil {
 		return nil
@@ -27,6 +27,7 @@
 package sqlite
 
 import (
+	"database/sql"
 	"fmt"
 	"time"
 )
@@ -36,6 +37,23 @@ const (
 
 	writeSchemaVersionQuery = `REPLACE into schema_version(version_partition, db_name, creation_time, curr_version, min_compatible_version) VALUES (0,?,?,?,?)`
 
+	readSchemaMinCompatibleVersionQuery = `SELECT min_compatible_version from schema_version where version_partition=0 and db_name=?`
+
+	createSchemaBackfillTableQuery = `CREATE TABLE IF NOT EXISTS schema_backfill(` +
+		`db_name VARCHAR(255) not null, ` +
+		`name VARCHAR(255) not null, ` +
+		`backfill_cursor BLOB, ` +
+		`completed BOOLEAN not null, ` +
+		`update_time DATETIME(6), ` +
+		`PRIMARY KEY (db_name, name));`
+
+	readSchemaBackfillQuery = `SELECT backfill_cursor, completed from schema_backfill where db_name=? and name=?`
+
+	writeSchemaBackfillQuery = `INSERT INTO schema_backfill(db_name, name, backfill_cursor, completed, update_time) VALUES(?,?,?,?,?) ` +
+		`ON CONFLICT (db_name, name) DO UPDATE ` +
+		`SET backfill_cursor = excluded.backfill_cursor, completed = excluded.completed, update_time = excluded.update_time ` +
+		`WHERE NOT schema_backfill.completed`
+
 	writeSchemaUpdateHistoryQuery = `INSERT into schema_update_history(version_partition, year, month, update_time, old_version, new_version, manifest_md5, description) VALUES(0,?,?,?,?,?,?,?)`
 
 	createSchemaVersionTableQuery = `CREATE TABLE schema_version(version_partition INT not null, ` +
@@ -87,6 +105,33 @@ func (mdb *db) WriteSchemaUpdateLog(oldVersion string, newVersion string, manife
 	return mdb.Exec(writeSchemaUpdateHistoryQuery, now.Year(), int(now.Month()), now, oldVersion, newVersion, manifestMD5, desc)
 }
 
+// CreateSchemaBackfillTable sets up the table tracking the progress of schema backfills
+func (mdb *db) CreateSchemaBackfillTable() error {
+	return mdb.Exec(createSchemaBackfillTableQuery)
+}
+
+// ReadSchemaMinCompatibleVersion returns the min compatible schema version for the keyspace
+func (mdb *db) ReadSchemaMinCompatibleVersion(database string) (string, error) {
+	var version sql.NullString
+	err := mdb.db.Get(&version, readSchemaMinCompatibleVersionQuery, database)
+	return version.String, err
+}
+
+// ReadSchemaBackfill returns the progress of a schema backfill
+func (mdb *db) ReadSchemaBackfill(database string, name string) ([]byte, bool, error) {
+	var row struct {
+		BackfillCursor []byte `db:"backfill_cursor"`
+		Completed      bool   `db:"completed"`
+	}
+	err := mdb.db.Get(&row, readSchemaBackfillQuery, database, name)
+	return row.BackfillCursor, row.Completed, err
+}
+
+// UpdateSchemaBackfill records the progress of a schema backfill, unless it already completed
+func (mdb *db) UpdateSchemaBackfill(database string, name string, cursor []byte, completed bool) error {
+	return mdb.Exec(writeSchemaBackfillQuery, database, name, cursor, completed, time.Now().UTC())
+}
+
 // Exec executes a sql statement
 func (mdb *db) Exec(stmt string, args ...interface{}) error {
 	_, err := mdb.db.Exec(stmt, args...)
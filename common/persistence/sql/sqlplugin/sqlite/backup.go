@@ -0,0 +1,78 @@
+// The MIT License
+//
+// Copyright (c) 2021 Datadog, Inc.
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/resolver"
+)
+
+const (
+	backupFileName = "backup.db"
+)
+
+// Backup writes an online snapshot of the database to w. The snapshot is taken with VACUUM INTO,
+// which copies the database within a single read transaction: it is consistent, and with the WAL
+// journal writers are not blocked while it is taken.
+func Backup(
+	ctx context.Context,
+	cfg *config.SQL,
+	r resolver.ServiceResolver,
+	w io.Writer,
+) error {
+	conn, err := sqlitePlugin.connPool.Allocate(cfg, r, sqlitePlugin.createDBConnection)
+	if err != nil {
+		return err
+	}
+	defer sqlitePlugin.connPool.Close(cfg)
+
+	dir, err := os.MkdirTemp("", "temporal-sqlite-backup")
+	if err != nil {
+		return fmt.Errorf("unable to create backup directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	path := filepath.Join(dir, backupFileName)
+	if _, err := conn.ExecContext(ctx, "VACUUM INTO ?", path); err != nil {
+		return fmt.Errorf("unable to snapshot database: %w", err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("unable to open database snapshot: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	if _, err := io.Copy(w, f); err != nil {
+		return fmt.Errorf("unable to stream database snapshot: %w", err)
+	}
+	return nil
+}
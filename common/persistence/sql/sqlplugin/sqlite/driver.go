@@ -51,6 +51,17 @@ func (*db) IsDupEntryError(err error) bool {
 	return false
 }
 
+// IsRetryableTxError returns true if the transaction failed because another connection held the database lock
+// for longer than busy_timeout
+func (*db) IsRetryableTxError(err error) bool {
+	var sqlErr *sqlite.Error
+	if errors.As(err, &sqlErr) {
+		return sqlErr.Code()&0xff == sqlite3.SQLITE_BUSY
+	}
+
+	return false
+}
+
 func isTableExistsError(err error) bool {
 	var sqlErr *sqlite.Error
 	if errors.As(err, &sqlErr) {
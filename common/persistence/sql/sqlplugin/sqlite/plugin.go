@@ -44,6 +44,10 @@ import (
 const (
 	// PluginName is the name of the plugin
 	PluginName = "sqlite"
+
+	// defaultFileModeMaxConns is the default size of the connection pool of a file database.
+	// Writes are serialized by SQLite, more connections allow reads of different shards to run concurrently.
+	defaultFileModeMaxConns = 8
 )
 
 // List of non-pragma parameters
@@ -57,6 +61,18 @@ var queryParameters = map[string]struct{}{
 	"psow":      {},
 	"setup":     {},
 	"vfs":       {},
+	"_txlock":   {}, // modernc.org/sqlite driver parameter
+}
+
+// Defaults for file databases, applied unless set in the connect attributes.
+// The WAL journal lets readers proceed while another connection writes, immediate transactions
+// take the write lock when they begin, so that concurrent writers wait for up to busy_timeout
+// milliseconds for each other instead of failing when upgrading a read lock.
+// It is a slice rather than a map to keep the DSN, and so the connection pool key, stable.
+var fileModeDefaultAttributes = []struct{ key, value string }{
+	{"journal_mode", "wal"},
+	{"busy_timeout", "10000"},
+	{"_txlock", "immediate"},
 }
 
 type plugin struct {
@@ -122,16 +138,33 @@ func (p *plugin) createDBConnection(
 		return nil, err
 	}
 
-	// The following options are set based on advice from https://github.com/mattn/go-sqlite3#faq
-	//
-	// Dealing with the error `database is locked`
-	// > ... set the database connections of the SQL package to 1.
-	db.SetMaxOpenConns(1)
-	// Settings for in-memory database (should be fine for file mode as well)
-	// > Note that if the last database connection in the pool closes, the in-memory database is deleted.
-	// > Make sure the max idle connection limit is > 0, and the connection lifetime is infinite.
-	db.SetMaxIdleConns(1)
-	db.SetConnMaxIdleTime(0)
+	if cfg.ConnectAttributes["mode"] == "memory" {
+		// The following options are set based on advice from https://github.com/mattn/go-sqlite3#faq
+		//
+		// Dealing with the error `database is locked`
+		// > ... set the database connections of the SQL package to 1.
+		db.SetMaxOpenConns(1)
+		// Settings for in-memory database
+		// > Note that if the last database connection in the pool closes, the in-memory database is deleted.
+		// > Make sure the max idle connection limit is > 0, and the connection lifetime is infinite.
+		db.SetMaxIdleConns(1)
+		db.SetConnMaxIdleTime(0)
+	} else {
+		// file databases use the WAL journal and busy timeout, see fileModeDefaultAttributes
+		maxConns := defaultFileModeMaxConns
+		if cfg.MaxConns > 0 {
+			maxConns = cfg.MaxConns
+		}
+		maxIdleConns := maxConns
+		if cfg.MaxIdleConns > 0 {
+			maxIdleConns = cfg.MaxIdleConns
+		}
+		db.SetMaxOpenConns(maxConns)
+		db.SetMaxIdleConns(maxIdleConns)
+		if cfg.MaxConnLifetime > 0 {
+			db.SetConnMaxLifetime(cfg.MaxConnLifetime)
+		}
+	}
 
 	// Maps struct names in CamelCase to snake without need for db struct tags.
 	db.MapperFunc(strcase.ToSnake)
@@ -204,6 +237,18 @@ func buildDSNAttr(cfg *config.SQL) (url.Values, error) {
 		// assume pragma
 		parameters.Add("_pragma", fmt.Sprintf("%s=%s", key, value))
 	}
+	if cfg.ConnectAttributes["mode"] != "memory" {
+		for _, attr := range fileModeDefaultAttributes {
+			if _, ok := cfg.ConnectAttributes[attr.key]; ok {
+				continue
+			}
+			if _, isValidQueryParameter := queryParameters[attr.key]; isValidQueryParameter {
+				parameters.Set(attr.key, attr.value)
+				continue
+			}
+			parameters.Add("_pragma", fmt.Sprintf("%s=%s", attr.key, attr.value))
+		}
+	}
 	// set time format
 	parameters.Add("_time_format", "sqlite")
 	return parameters, nil
@@ -164,6 +164,18 @@ func (mdb *db) CountFromVisibility(
 	return count, nil
 }
 
+func (mdb *db) CountGroupByFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) ([]sqlplugin.VisibilityCountRow, error) {
+	var rows []sqlplugin.VisibilityCountRow
+	err := mdb.conn.SelectContext(ctx, &rows, filter.Query, filter.QueryArgs...)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
 func (mdb *db) prepareRowForDB(row *sqlplugin.VisibilityRow) *sqlplugin.VisibilityRow {
 	if row == nil {
 		return nil
@@ -0,0 +1,107 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// HistoryNodeTableName and ExecutionsTableName are the tables which can be partitioned by shard ID ranges
+	HistoryNodeTableName = "history_node"
+	ExecutionsTableName  = "executions"
+
+	tablePartitionNamePrefix = "shards_"
+)
+
+var (
+	// ErrTableNotPartitioned is returned by TablePartitionAdmin when the table has no overflow partition,
+	// i.e. the optional partitioned.sql schema file has not been applied.
+	ErrTableNotPartitioned = errors.New("table is not partitioned")
+
+	// PartitionedTableNames are the tables partitioned by the optional partitioned.sql schema files
+	PartitionedTableNames = []string{
+		HistoryNodeTableName,
+		ExecutionsTableName,
+	}
+)
+
+type (
+	// TablePartition is a partition of a table holding the rows of a range of shard IDs.
+	// MinShardID is inclusive and MaxShardID is exclusive.
+	TablePartition struct {
+		MinShardID int32
+		MaxShardID int32
+	}
+
+	// TablePartitionAdmin manages the partitions of tables partitioned by ranges of shard IDs. Rows of shards
+	// without a partition are kept in an overflow partition of the table, until a partition for them is created.
+	TablePartitionAdmin interface {
+		// ListTablePartitions returns the partitions of the table ordered by shard ID, excluding the overflow
+		// partition. It returns ErrTableNotPartitioned if the table is not partitioned.
+		ListTablePartitions(ctx context.Context, table string) ([]TablePartition, error)
+		// CreateTablePartition splits the partition off the overflow partition, moving the rows of its shards
+		CreateTablePartition(ctx context.Context, table string, partition TablePartition) error
+		// DropTablePartition drops the partition along with the rows of its shards
+		DropTablePartition(ctx context.Context, table string, partition TablePartition) error
+	}
+)
+
+// Name returns the name of the partition within its table
+func (p TablePartition) Name() string {
+	return fmt.Sprintf("%s%d_%d", tablePartitionNamePrefix, p.MinShardID, p.MaxShardID)
+}
+
+// ParseTablePartitionName parses a partition name returned by TablePartition.Name,
+// it returns false for other names, e.g. the name of the overflow partition.
+func ParseTablePartitionName(name string) (TablePartition, bool) {
+	if !strings.HasPrefix(name, tablePartitionNamePrefix) {
+		return TablePartition{}, false
+	}
+	var partition TablePartition
+	if _, err := fmt.Sscanf(
+		strings.TrimPrefix(name, tablePartitionNamePrefix),
+		"%d_%d",
+		&partition.MinShardID,
+		&partition.MaxShardID,
+	); err != nil || partition.Name() != name {
+		return TablePartition{}, false
+	}
+	return partition, true
+}
+
+// ValidatePartitionedTableName returns an error if the table is not one of PartitionedTableNames.
+// Table names are not bind parameters in DDL statements, so they must be validated before use.
+func ValidatePartitionedTableName(table string) error {
+	for _, name := range PartitionedTableNames {
+		if name == table {
+			return nil
+		}
+	}
+	return fmt.Errorf("table %v cannot be partitioned", table)
+}
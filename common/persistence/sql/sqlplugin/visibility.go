@@ -63,6 +63,13 @@ type (
 		SearchAttributes *VisibilitySearchAttributes
 	}
 
+	// VisibilityCountRow represents the count of rows in executions_visibility table
+	// which have the same value of the grouped by column
+	VisibilityCountRow struct {
+		GroupValue interface{}
+		Count      int64
+	}
+
 	// VisibilitySelectFilter contains the column names within executions_visibility table that
 	// can be used to filter results through a WHERE clause
 	VisibilitySelectFilter struct {
@@ -107,6 +114,9 @@ type (
 		GetFromVisibility(ctx context.Context, filter VisibilityGetFilter) (*VisibilityRow, error)
 		DeleteFromVisibility(ctx context.Context, filter VisibilityDeleteFilter) (sql.Result, error)
 		CountFromVisibility(ctx context.Context, filter VisibilitySelectFilter) (int64, error)
+		// CountGroupByFromVisibility returns the number of rows for each value of the column
+		// in the GROUP BY clause of the filter query
+		CountGroupByFromVisibility(ctx context.Context, filter VisibilitySelectFilter) ([]VisibilityCountRow, error)
 	}
 )
 
@@ -0,0 +1,124 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/debug"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	ArchivalDLQSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		ArchivalDLQ p.ArchivalDLQ
+		Logger      log.Logger
+
+		Ctx    context.Context
+		Cancel context.CancelFunc
+	}
+)
+
+func NewArchivalDLQSuite(
+	t *testing.T,
+	queue p.Queue,
+	serializer serialization.Serializer,
+	logger log.Logger,
+) *ArchivalDLQSuite {
+	archivalDLQ, err := p.NewArchivalDLQ(queue, serializer)
+	require.NoError(t, err)
+	return &ArchivalDLQSuite{
+		Assertions:  require.New(t),
+		ArchivalDLQ: archivalDLQ,
+		Logger:      logger,
+	}
+}
+
+func (s *ArchivalDLQSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.Ctx, s.Cancel = context.WithTimeout(context.Background(), 30*time.Second*debug.TimeoutMultiplier)
+}
+
+func (s *ArchivalDLQSuite) TearDownTest() {
+	s.Cancel()
+}
+
+func (s *ArchivalDLQSuite) TestEnqueueListGetDelete() {
+	enqueuedTime := time.Unix(0, 0).UTC()
+	var tasks []*p.ArchivalDLQTask
+	for _, workflowID := range []string{"workflow-1", "workflow-2", "workflow-3"} {
+		task := &p.ArchivalDLQTask{
+			ShardID:      1,
+			NamespaceID:  "namespace-id",
+			WorkflowID:   workflowID,
+			RunID:        "run-id",
+			TaskID:       100,
+			Version:      2,
+			Attempt:      10,
+			LastError:    "archival backend unavailable",
+			EnqueuedTime: enqueuedTime,
+		}
+		messageID, err := s.ArchivalDLQ.Enqueue(s.Ctx, task)
+		s.NoError(err)
+		task.MessageID = messageID
+		tasks = append(tasks, task)
+	}
+
+	var listed []*p.ArchivalDLQTask
+	var pageToken []byte
+	for {
+		page, nextPageToken, err := s.ArchivalDLQ.List(s.Ctx, 2, pageToken)
+		s.NoError(err)
+		listed = append(listed, page...)
+		if len(nextPageToken) == 0 {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	s.Equal(tasks, listed)
+
+	task, err := s.ArchivalDLQ.Get(s.Ctx, tasks[1].MessageID)
+	s.NoError(err)
+	s.Equal(tasks[1], task)
+
+	s.NoError(s.ArchivalDLQ.Delete(s.Ctx, tasks[1].MessageID))
+	_, err = s.ArchivalDLQ.Get(s.Ctx, tasks[1].MessageID)
+	s.IsType(&serviceerror.NotFound{}, err)
+
+	listed, _, err = s.ArchivalDLQ.List(s.Ctx, 10, nil)
+	s.NoError(err)
+	s.Equal([]*p.ArchivalDLQTask{tasks[0], tasks[2]}, listed)
+}
@@ -26,11 +26,17 @@ package tests
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/persistence/cassandra"
 	persistencetests "go.temporal.io/server/common/persistence/persistence-tests"
 	"go.temporal.io/server/common/persistence/serialization"
 	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
+	"go.temporal.io/server/common/resolver"
 )
 
 func TestCassandraShardStoreSuite(t *testing.T) {
@@ -74,6 +80,42 @@ func TestCassandraExecutionMutableStateStoreSuite(t *testing.T) {
 	suite.Run(t, s)
 }
 
+func TestCassandraExecutionMutableStateUnconditionalSuite(t *testing.T) {
+	testData, tearDown := setUpCassandraTest(t)
+	defer tearDown()
+
+	shardStore, err := testData.Factory.NewShardStore()
+	if err != nil {
+		t.Fatalf("unable to create Cassandra DB: %v", err)
+	}
+
+	var s *ExecutionMutableStateUnconditionalSuite
+	unconditionalFactory := cassandra.NewFactory(
+		*testData.Cfg,
+		resolver.NewNoopResolver(),
+		testCassandraClusterName,
+		testData.Logger,
+		&cassandra.UnconditionalUpdateConfig{
+			Enabled:                 dynamicconfig.GetBoolPropertyFn(true),
+			ShardLeaseCheckInterval: func() time.Duration { return s.LeaseCheckInterval },
+		},
+	)
+	defer unconditionalFactory.Close()
+	executionStore, err := unconditionalFactory.NewExecutionStore()
+	if err != nil {
+		t.Fatalf("unable to create Cassandra DB: %v", err)
+	}
+
+	s = NewExecutionMutableStateUnconditionalSuite(
+		t,
+		shardStore,
+		executionStore,
+		serialization.NewSerializer(),
+		testData.Logger,
+	)
+	suite.Run(t, s)
+}
+
 func TestCassandraExecutionMutableStateTaskStoreSuite(t *testing.T) {
 	testData, tearDown := setUpCassandraTest(t)
 	defer tearDown()
@@ -136,6 +178,34 @@ func TestCassandraTaskQueueTaskSuite(t *testing.T) {
 	suite.Run(t, s)
 }
 
+func TestCassandraTaskQueueBacklogSuite(t *testing.T) {
+	testData, tearDown := setUpCassandraTest(t)
+	defer tearDown()
+
+	taskQueueStore, err := testData.Factory.NewTaskStore()
+	if err != nil {
+		t.Fatalf("unable to create Cassandra DB: %v", err)
+	}
+
+	backlogCfg := *testData.Cfg
+	backlogCfg.TaskBacklog = &config.CassandraTaskBacklog{TTLEnabled: true}
+	backlogFactory := cassandra.NewFactory(
+		backlogCfg,
+		resolver.NewNoopResolver(),
+		testCassandraClusterName,
+		testData.Logger,
+		nil,
+	)
+	defer backlogFactory.Close()
+	backlogTaskQueueStore, err := backlogFactory.NewTaskStore()
+	if err != nil {
+		t.Fatalf("unable to create Cassandra DB: %v", err)
+	}
+
+	s := NewTaskQueueBacklogSuite(t, taskQueueStore, backlogTaskQueueStore, testData.Logger)
+	suite.Run(t, s)
+}
+
 func TestCassandraVisibilityPersistence(t *testing.T) {
 	s := &VisibilityPersistenceSuite{
 		TestBase: persistencetests.NewTestBaseWithCassandra(&persistencetests.TestBaseOptions{}),
@@ -0,0 +1,573 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	persistencetests "go.temporal.io/server/common/persistence/persistence-tests"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/sql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
+	sqltests "go.temporal.io/server/common/persistence/sql/sqlplugin/tests"
+	"go.temporal.io/server/common/resolver"
+)
+
+func TestCockroachDBShardStoreSuite(t *testing.T) {
+	testData, tearDown := setUpCockroachDBTest(t)
+	defer tearDown()
+
+	shardStore, err := testData.Factory.NewShardStore()
+	if err != nil {
+		t.Fatalf("unable to create CockroachDB DB: %v", err)
+	}
+
+	s := NewShardSuite(
+		t,
+		shardStore,
+		serialization.NewSerializer(),
+		testData.Logger,
+	)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBExecutionMutableStateStoreSuite(t *testing.T) {
+	testData, tearDown := setUpCockroachDBTest(t)
+	defer tearDown()
+
+	shardStore, err := testData.Factory.NewShardStore()
+	if err != nil {
+		t.Fatalf("unable to create CockroachDB DB: %v", err)
+	}
+	executionStore, err := testData.Factory.NewExecutionStore()
+	if err != nil {
+		t.Fatalf("unable to create CockroachDB DB: %v", err)
+	}
+
+	s := NewExecutionMutableStateSuite(
+		t,
+		shardStore,
+		executionStore,
+		serialization.NewSerializer(),
+		testData.Logger,
+	)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBExecutionMutableStateTaskStoreSuite(t *testing.T) {
+	testData, tearDown := setUpCockroachDBTest(t)
+	defer tearDown()
+
+	shardStore, err := testData.Factory.NewShardStore()
+	if err != nil {
+		t.Fatalf("unable to create CockroachDB DB: %v", err)
+	}
+	executionStore, err := testData.Factory.NewExecutionStore()
+	if err != nil {
+		t.Fatalf("unable to create CockroachDB DB: %v", err)
+	}
+
+	s := NewExecutionMutableStateTaskSuite(
+		t,
+		shardStore,
+		executionStore,
+		serialization.NewSerializer(),
+		testData.Logger,
+	)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBHistoryStoreSuite(t *testing.T) {
+	testData, tearDown := setUpCockroachDBTest(t)
+	defer tearDown()
+
+	store, err := testData.Factory.NewExecutionStore()
+	if err != nil {
+		t.Fatalf("unable to create CockroachDB DB: %v", err)
+	}
+
+	s := NewHistoryEventsSuite(t, store, testData.Logger)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBTaskQueueSuite(t *testing.T) {
+	testData, tearDown := setUpCockroachDBTest(t)
+	defer tearDown()
+
+	taskQueueStore, err := testData.Factory.NewTaskStore()
+	if err != nil {
+		t.Fatalf("unable to create CockroachDB DB: %v", err)
+	}
+
+	s := NewTaskQueueSuite(t, taskQueueStore, testData.Logger)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBTaskQueueTaskSuite(t *testing.T) {
+	testData, tearDown := setUpCockroachDBTest(t)
+	defer tearDown()
+
+	taskQueueStore, err := testData.Factory.NewTaskStore()
+	if err != nil {
+		t.Fatalf("unable to create CockroachDB DB: %v", err)
+	}
+
+	s := NewTaskQueueTaskSuite(t, taskQueueStore, testData.Logger)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBVisibilityPersistenceSuite(t *testing.T) {
+	s := &VisibilityPersistenceSuite{
+		TestBase: persistencetests.NewTestBaseWithSQL(persistencetests.GetCockroachDBTestClusterOption()),
+	}
+	suite.Run(t, s)
+}
+
+// TODO: Merge persistence-tests into the tests directory.
+
+func TestCockroachDBHistoryV2PersistenceSuite(t *testing.T) {
+	s := new(persistencetests.HistoryV2PersistenceSuite)
+	s.TestBase = persistencetests.NewTestBaseWithSQL(persistencetests.GetCockroachDBTestClusterOption())
+	s.TestBase.Setup(nil)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBMetadataPersistenceSuiteV2(t *testing.T) {
+	s := new(persistencetests.MetadataPersistenceSuiteV2)
+	s.TestBase = persistencetests.NewTestBaseWithSQL(persistencetests.GetCockroachDBTestClusterOption())
+	s.TestBase.Setup(nil)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBClusterMetadataPersistence(t *testing.T) {
+	s := new(persistencetests.ClusterMetadataManagerSuite)
+	s.TestBase = persistencetests.NewTestBaseWithSQL(persistencetests.GetCockroachDBTestClusterOption())
+	s.TestBase.Setup(nil)
+	suite.Run(t, s)
+}
+
+// SQL store tests
+
+func TestCockroachDBNamespaceSuite(t *testing.T) {
+	cfg := NewCockroachDBConfig()
+	SetupCockroachDBDatabase(cfg)
+	SetupCockroachDBSchema(cfg)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create CockroachDB DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		TearDownCockroachDBDatabase(cfg)
+	}()
+
+	s := sqltests.NewNamespaceSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBQueueMessageSuite(t *testing.T) {
+	cfg := NewCockroachDBConfig()
+	SetupCockroachDBDatabase(cfg)
+	SetupCockroachDBSchema(cfg)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create CockroachDB DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		TearDownCockroachDBDatabase(cfg)
+	}()
+
+	s := sqltests.NewQueueMessageSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBQueueMetadataSuite(t *testing.T) {
+	cfg := NewCockroachDBConfig()
+	SetupCockroachDBDatabase(cfg)
+	SetupCockroachDBSchema(cfg)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create CockroachDB DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		TearDownCockroachDBDatabase(cfg)
+	}()
+
+	s := sqltests.NewQueueMetadataSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBMatchingTaskSuite(t *testing.T) {
+	cfg := NewCockroachDBConfig()
+	SetupCockroachDBDatabase(cfg)
+	SetupCockroachDBSchema(cfg)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create CockroachDB DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		TearDownCockroachDBDatabase(cfg)
+	}()
+
+	s := sqltests.NewMatchingTaskSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBMatchingTaskQueueSuite(t *testing.T) {
+	cfg := NewCockroachDBConfig()
+	SetupCockroachDBDatabase(cfg)
+	SetupCockroachDBSchema(cfg)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create CockroachDB DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		TearDownCockroachDBDatabase(cfg)
+	}()
+
+	s := sqltests.NewMatchingTaskQueueSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBHistoryShardSuite(t *testing.T) {
+	cfg := NewCockroachDBConfig()
+	SetupCockroachDBDatabase(cfg)
+	SetupCockroachDBSchema(cfg)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create MySQL DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		TearDownCockroachDBDatabase(cfg)
+	}()
+
+	s := sqltests.NewHistoryShardSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBHistoryNodeSuite(t *testing.T) {
+	cfg := NewCockroachDBConfig()
+	SetupCockroachDBDatabase(cfg)
+	SetupCockroachDBSchema(cfg)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create MySQL DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		TearDownCockroachDBDatabase(cfg)
+	}()
+
+	s := sqltests.NewHistoryNodeSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBHistoryTreeSuite(t *testing.T) {
+	cfg := NewCockroachDBConfig()
+	SetupCockroachDBDatabase(cfg)
+	SetupCockroachDBSchema(cfg)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create MySQL DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		TearDownCockroachDBDatabase(cfg)
+	}()
+
+	s := sqltests.NewHistoryTreeSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBHistoryCurrentExecutionSuite(t *testing.T) {
+	cfg := NewCockroachDBConfig()
+	SetupCockroachDBDatabase(cfg)
+	SetupCockroachDBSchema(cfg)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create MySQL DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		TearDownCockroachDBDatabase(cfg)
+	}()
+
+	s := sqltests.NewHistoryCurrentExecutionSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBHistoryExecutionSuite(t *testing.T) {
+	cfg := NewCockroachDBConfig()
+	SetupCockroachDBDatabase(cfg)
+	SetupCockroachDBSchema(cfg)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create MySQL DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		TearDownCockroachDBDatabase(cfg)
+	}()
+
+	s := sqltests.NewHistoryExecutionSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBHistoryTransferTaskSuite(t *testing.T) {
+	cfg := NewCockroachDBConfig()
+	SetupCockroachDBDatabase(cfg)
+	SetupCockroachDBSchema(cfg)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create MySQL DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		TearDownCockroachDBDatabase(cfg)
+	}()
+
+	s := sqltests.NewHistoryTransferTaskSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBHistoryTimerTaskSuite(t *testing.T) {
+	cfg := NewCockroachDBConfig()
+	SetupCockroachDBDatabase(cfg)
+	SetupCockroachDBSchema(cfg)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create MySQL DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		TearDownCockroachDBDatabase(cfg)
+	}()
+
+	s := sqltests.NewHistoryTimerTaskSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBHistoryReplicationTaskSuite(t *testing.T) {
+	cfg := NewCockroachDBConfig()
+	SetupCockroachDBDatabase(cfg)
+	SetupCockroachDBSchema(cfg)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create MySQL DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		TearDownCockroachDBDatabase(cfg)
+	}()
+
+	s := sqltests.NewHistoryReplicationTaskSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBHistoryVisibilityTaskSuite(t *testing.T) {
+	cfg := NewCockroachDBConfig()
+	SetupCockroachDBDatabase(cfg)
+	SetupCockroachDBSchema(cfg)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create MySQL DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		TearDownCockroachDBDatabase(cfg)
+	}()
+
+	s := sqltests.NewHistoryVisibilityTaskSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBHistoryReplicationDLQTaskSuite(t *testing.T) {
+	cfg := NewCockroachDBConfig()
+	SetupCockroachDBDatabase(cfg)
+	SetupCockroachDBSchema(cfg)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create MySQL DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		TearDownCockroachDBDatabase(cfg)
+	}()
+
+	s := sqltests.NewHistoryReplicationDLQTaskSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBHistoryExecutionBufferSuite(t *testing.T) {
+	cfg := NewCockroachDBConfig()
+	SetupCockroachDBDatabase(cfg)
+	SetupCockroachDBSchema(cfg)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create MySQL DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		TearDownCockroachDBDatabase(cfg)
+	}()
+
+	s := sqltests.NewHistoryExecutionBufferSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBHistoryExecutionActivitySuite(t *testing.T) {
+	cfg := NewCockroachDBConfig()
+	SetupCockroachDBDatabase(cfg)
+	SetupCockroachDBSchema(cfg)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create MySQL DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		TearDownCockroachDBDatabase(cfg)
+	}()
+
+	s := sqltests.NewHistoryExecutionActivitySuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBHistoryExecutionChildWorkflowSuite(t *testing.T) {
+	cfg := NewCockroachDBConfig()
+	SetupCockroachDBDatabase(cfg)
+	SetupCockroachDBSchema(cfg)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create MySQL DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		TearDownCockroachDBDatabase(cfg)
+	}()
+
+	s := sqltests.NewHistoryExecutionChildWorkflowSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBHistoryExecutionTimerSuite(t *testing.T) {
+	cfg := NewCockroachDBConfig()
+	SetupCockroachDBDatabase(cfg)
+	SetupCockroachDBSchema(cfg)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create MySQL DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		TearDownCockroachDBDatabase(cfg)
+	}()
+
+	s := sqltests.NewHistoryExecutionTimerSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBHistoryExecutionRequestCancelSuite(t *testing.T) {
+	cfg := NewCockroachDBConfig()
+	SetupCockroachDBDatabase(cfg)
+	SetupCockroachDBSchema(cfg)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create MySQL DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		TearDownCockroachDBDatabase(cfg)
+	}()
+
+	s := sqltests.NewHistoryExecutionRequestCancelSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBHistoryExecutionSignalSuite(t *testing.T) {
+	cfg := NewCockroachDBConfig()
+	SetupCockroachDBDatabase(cfg)
+	SetupCockroachDBSchema(cfg)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create MySQL DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		TearDownCockroachDBDatabase(cfg)
+	}()
+
+	s := sqltests.NewHistoryExecutionSignalSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBHistoryExecutionSignalRequestSuite(t *testing.T) {
+	cfg := NewCockroachDBConfig()
+	SetupCockroachDBDatabase(cfg)
+	SetupCockroachDBSchema(cfg)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create MySQL DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		TearDownCockroachDBDatabase(cfg)
+	}()
+
+	s := sqltests.NewHistoryExecutionSignalRequestSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBVisibilitySuite(t *testing.T) {
+	cfg := NewCockroachDBConfig()
+	SetupCockroachDBDatabase(cfg)
+	SetupCockroachDBSchema(cfg)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindVisibility, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create MySQL DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		TearDownCockroachDBDatabase(cfg)
+	}()
+
+	s := sqltests.NewVisibilitySuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestCockroachDBClosedConnectionError(t *testing.T) {
+	testData, tearDown := setUpCockroachDBTest(t)
+	defer tearDown()
+
+	s := newConnectionSuite(t, testData.Factory)
+	suite.Run(t, s)
+}
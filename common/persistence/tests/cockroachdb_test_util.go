@@ -0,0 +1,180 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tests
+
+import (
+	"fmt"
+	"net"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"go.uber.org/zap/zaptest"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/sql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
+	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/common/shuffle"
+	"go.temporal.io/server/environment"
+)
+
+// TODO merge the initialization with existing persistence setup
+const (
+	testCockroachDBClusterName = "temporal_cockroachdb_cluster"
+
+	testCockroachDBUser               = "temporal"
+	testCockroachDBPassword           = "temporal"
+	testCockroachDBConnectionProtocol = "tcp"
+	testCockroachDBDatabaseNamePrefix = "test_"
+	testCockroachDBDatabaseNameSuffix = "temporal_persistence"
+
+	// TODO hard code this dir for now
+	//  need to merge persistence test config / initialization in one place
+	testCockroachDBExecutionSchema  = "../../../schema/cockroachdb/v23/temporal/schema.sql"
+	testCockroachDBVisibilitySchema = "../../../schema/cockroachdb/v23/visibility/schema.sql"
+)
+
+type (
+	CockroachDBTestData struct {
+		Cfg     *config.SQL
+		Factory *sql.Factory
+		Logger  log.Logger
+	}
+)
+
+func setUpCockroachDBTest(t *testing.T) (CockroachDBTestData, func()) {
+	var testData CockroachDBTestData
+	testData.Cfg = NewCockroachDBConfig()
+	testData.Logger = log.NewZapLogger(zaptest.NewLogger(t))
+	SetupCockroachDBDatabase(testData.Cfg)
+	SetupCockroachDBSchema(testData.Cfg)
+
+	testData.Factory = sql.NewFactory(
+		*testData.Cfg,
+		resolver.NewNoopResolver(),
+		testCockroachDBClusterName,
+		testData.Logger,
+	)
+
+	tearDown := func() {
+		testData.Factory.Close()
+		TearDownCockroachDBDatabase(testData.Cfg)
+	}
+
+	return testData, tearDown
+}
+
+// NewCockroachDBConfig returns a new CockroachDB config for test
+func NewCockroachDBConfig() *config.SQL {
+	return &config.SQL{
+		User:     testCockroachDBUser,
+		Password: testCockroachDBPassword,
+		ConnectAddr: net.JoinHostPort(
+			environment.GetCockroachDBAddress(),
+			strconv.Itoa(environment.GetCockroachDBPort()),
+		),
+		ConnectProtocol: testCockroachDBConnectionProtocol,
+		PluginName:      postgresql.PluginNameCockroachDB,
+		DatabaseName:    testCockroachDBDatabaseNamePrefix + shuffle.String(testCockroachDBDatabaseNameSuffix),
+	}
+}
+
+func SetupCockroachDBDatabase(cfg *config.SQL) {
+	adminCfg := *cfg
+	// NOTE need to connect with empty name to create new database
+	adminCfg.DatabaseName = ""
+
+	db, err := sql.NewSQLAdminDB(sqlplugin.DbKindUnknown, &adminCfg, resolver.NewNoopResolver())
+	if err != nil {
+		panic(fmt.Sprintf("unable to create CockroachDB admin DB: %v", err))
+	}
+	defer func() { _ = db.Close() }()
+
+	err = db.CreateDatabase(cfg.DatabaseName)
+	if err != nil {
+		panic(fmt.Sprintf("unable to create CockroachDB database: %v", err))
+	}
+}
+
+func SetupCockroachDBSchema(cfg *config.SQL) {
+	db, err := sql.NewSQLAdminDB(sqlplugin.DbKindUnknown, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		panic(fmt.Sprintf("unable to create CockroachDB admin DB: %v", err))
+	}
+	defer func() { _ = db.Close() }()
+
+	schemaPath, err := filepath.Abs(testCockroachDBExecutionSchema)
+	if err != nil {
+		panic(err)
+	}
+
+	statements, err := p.LoadAndSplitQuery([]string{schemaPath})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, stmt := range statements {
+		if err = db.Exec(stmt); err != nil {
+			panic(err)
+		}
+	}
+
+	schemaPath, err = filepath.Abs(testCockroachDBVisibilitySchema)
+	if err != nil {
+		panic(err)
+	}
+
+	statements, err = p.LoadAndSplitQuery([]string{schemaPath})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, stmt := range statements {
+		if err = db.Exec(stmt); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func TearDownCockroachDBDatabase(cfg *config.SQL) {
+	adminCfg := *cfg
+	// NOTE need to connect with empty name to create new database
+	adminCfg.DatabaseName = ""
+
+	db, err := sql.NewSQLAdminDB(sqlplugin.DbKindUnknown, &adminCfg, resolver.NewNoopResolver())
+	if err != nil {
+		panic(fmt.Sprintf("unable to create CockroachDB admin DB: %v", err))
+	}
+	defer func() { _ = db.Close() }()
+
+	err = db.DropDatabase(cfg.DatabaseName)
+	if err != nil {
+		panic(fmt.Sprintf("unable to drop CockroachDB database: %v", err))
+	}
+}
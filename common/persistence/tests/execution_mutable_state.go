@@ -40,6 +40,7 @@ import (
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/claimcheck"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -84,8 +85,10 @@ func NewExecutionMutableStateSuite(
 		ExecutionManager: p.NewExecutionManager(
 			executionStore,
 			serializer,
+			claimcheck.NoopPayloadStore,
 			logger,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			dynamicconfig.GetIntPropertyFn(0),
 		),
 		Logger: logger,
 	}
@@ -418,6 +421,62 @@ func (s *ExecutionMutableStateSuite) TestUpdate_NotZombie() {
 	s.AssertEqualWithDB(currentSnapshot, currentMutation)
 }
 
+func (s *ExecutionMutableStateSuite) TestUpdate_FencedAfterShardSteal() {
+	currentSnapshot := s.CreateWorkflow(
+		rand.Int63(),
+		enumsspb.WORKFLOW_EXECUTION_STATE_CREATED,
+		enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		rand.Int63(),
+	)
+
+	// another host takes the shard over, eg. after the lease of the owner expired
+	resp, err := s.ShardManager.GetOrCreateShard(s.Ctx, &p.GetOrCreateShardRequest{ShardID: s.ShardID})
+	s.NoError(err)
+	resp.ShardInfo.RangeId = s.RangeID + 1
+	err = s.ShardManager.UpdateShard(s.Ctx, &p.UpdateShardRequest{
+		ShardInfo:       resp.ShardInfo,
+		PreviousRangeID: s.RangeID,
+	})
+	s.NoError(err)
+
+	// the previous owner can neither update the shard nor its workflows
+	err = s.ShardManager.UpdateShard(s.Ctx, &p.UpdateShardRequest{
+		ShardInfo:       resp.ShardInfo,
+		PreviousRangeID: s.RangeID,
+	})
+	s.IsType(&p.ShardOwnershipLostError{}, err)
+
+	currentMutation := RandomMutation(
+		s.NamespaceID,
+		s.WorkflowID,
+		s.RunID,
+		rand.Int63(),
+		enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		currentSnapshot.DBRecordVersion+1,
+	)
+	_, err = s.ExecutionManager.UpdateWorkflowExecution(s.Ctx, &p.UpdateWorkflowExecutionRequest{
+		ShardID: s.ShardID,
+		RangeID: s.RangeID,
+		Mode:    p.UpdateWorkflowModeUpdateCurrent,
+
+		UpdateWorkflowMutation: *currentMutation,
+	})
+	s.IsType(&p.ShardOwnershipLostError{}, err)
+	s.AssertEqualWithDB(currentSnapshot)
+
+	// while the new owner can
+	_, err = s.ExecutionManager.UpdateWorkflowExecution(s.Ctx, &p.UpdateWorkflowExecutionRequest{
+		ShardID: s.ShardID,
+		RangeID: s.RangeID + 1,
+		Mode:    p.UpdateWorkflowModeUpdateCurrent,
+
+		UpdateWorkflowMutation: *currentMutation,
+	})
+	s.NoError(err)
+	s.AssertEqualWithDB(currentSnapshot, currentMutation)
+}
+
 func (s *ExecutionMutableStateSuite) TestUpdate_NotZombie_CurrentConflict() {
 	_ = s.CreateWorkflow(
 		rand.Int63(),
@@ -40,6 +40,7 @@ import (
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/claimcheck"
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -105,8 +106,10 @@ func NewExecutionMutableStateTaskSuite(
 		ExecutionManager: p.NewExecutionManager(
 			executionStore,
 			serializer,
+			claimcheck.NoopPayloadStore,
 			logger,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			dynamicconfig.GetIntPropertyFn(0),
 		),
 		Logger: logger,
 	}
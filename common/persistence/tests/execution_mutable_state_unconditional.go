@@ -0,0 +1,243 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tests
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	// ExecutionMutableStateUnconditionalSuite covers execution updates written without lightweight
+	// transactions, which are fenced by the shard lease check only.
+	ExecutionMutableStateUnconditionalSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		// the conflict tests of ExecutionMutableStateSuite rely on lightweight transactions,
+		// it is only used for its helpers
+		mutableState *ExecutionMutableStateSuite
+		// LeaseCheckInterval is the shard lease check interval of the execution store
+		LeaseCheckInterval time.Duration
+	}
+)
+
+func NewExecutionMutableStateUnconditionalSuite(
+	t *testing.T,
+	shardStore p.ShardStore,
+	executionStore p.ExecutionStore,
+	serializer serialization.Serializer,
+	logger log.Logger,
+) *ExecutionMutableStateUnconditionalSuite {
+	return &ExecutionMutableStateUnconditionalSuite{
+		Assertions:   require.New(t),
+		mutableState: NewExecutionMutableStateSuite(t, shardStore, executionStore, serializer, logger),
+	}
+}
+
+func (s *ExecutionMutableStateUnconditionalSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.LeaseCheckInterval = 0
+	s.mutableState.SetT(s.T())
+	s.mutableState.SetupTest()
+}
+
+func (s *ExecutionMutableStateUnconditionalSuite) TearDownTest() {
+	s.mutableState.TearDownTest()
+}
+
+func (s *ExecutionMutableStateUnconditionalSuite) TestUpdate() {
+	ms := s.mutableState
+	currentSnapshot := s.createWorkflow()
+
+	currentMutation := s.randomMutation(currentSnapshot.DBRecordVersion + 1)
+	_, err := ms.ExecutionManager.UpdateWorkflowExecution(ms.Ctx, &p.UpdateWorkflowExecutionRequest{
+		ShardID: ms.ShardID,
+		RangeID: ms.RangeID,
+		Mode:    p.UpdateWorkflowModeUpdateCurrent,
+
+		UpdateWorkflowMutation: *currentMutation,
+	})
+	s.NoError(err)
+	ms.AssertEqualWithDB(currentSnapshot, currentMutation)
+
+	nextMutation := s.randomMutation(currentMutation.DBRecordVersion + 1)
+	_, err = ms.ExecutionManager.UpdateWorkflowExecution(ms.Ctx, &p.UpdateWorkflowExecutionRequest{
+		ShardID: ms.ShardID,
+		RangeID: ms.RangeID,
+		Mode:    p.UpdateWorkflowModeBypassCurrent,
+
+		UpdateWorkflowMutation: *nextMutation,
+	})
+	// the workflow is current, bypassing it is still rejected without lightweight transactions
+	s.IsType(&p.CurrentWorkflowConditionFailedError{}, err)
+	ms.AssertEqualWithDB(currentSnapshot, currentMutation)
+}
+
+func (s *ExecutionMutableStateUnconditionalSuite) TestUpdate_FencedAfterShardSteal() {
+	ms := s.mutableState
+	currentSnapshot := s.createWorkflow()
+	s.stealShard()
+
+	currentMutation := s.randomMutation(currentSnapshot.DBRecordVersion + 1)
+	_, err := ms.ExecutionManager.UpdateWorkflowExecution(ms.Ctx, &p.UpdateWorkflowExecutionRequest{
+		ShardID: ms.ShardID,
+		RangeID: ms.RangeID,
+		Mode:    p.UpdateWorkflowModeUpdateCurrent,
+
+		UpdateWorkflowMutation: *currentMutation,
+	})
+	s.IsType(&p.ShardOwnershipLostError{}, err)
+	ms.AssertEqualWithDB(currentSnapshot)
+
+	_, err = ms.ExecutionManager.UpdateWorkflowExecution(ms.Ctx, &p.UpdateWorkflowExecutionRequest{
+		ShardID: ms.ShardID,
+		RangeID: ms.RangeID + 1,
+		Mode:    p.UpdateWorkflowModeUpdateCurrent,
+
+		UpdateWorkflowMutation: *currentMutation,
+	})
+	s.NoError(err)
+	ms.AssertEqualWithDB(currentSnapshot, currentMutation)
+}
+
+func (s *ExecutionMutableStateUnconditionalSuite) TestUpdate_StaleOwnerWithinLeaseCheckInterval() {
+	ms := s.mutableState
+	s.LeaseCheckInterval = time.Hour
+	currentSnapshot := s.createWorkflow()
+
+	currentMutation := s.randomMutation(currentSnapshot.DBRecordVersion + 1)
+	_, err := ms.ExecutionManager.UpdateWorkflowExecution(ms.Ctx, &p.UpdateWorkflowExecutionRequest{
+		ShardID: ms.ShardID,
+		RangeID: ms.RangeID,
+		Mode:    p.UpdateWorkflowModeUpdateCurrent,
+
+		UpdateWorkflowMutation: *currentMutation,
+	})
+	s.NoError(err)
+	s.stealShard()
+
+	// the range ID read before the steal is trusted until the next lease check, so the stale owner's
+	// update is applied, which is the trade-off made by writing without lightweight transactions
+	staleMutation := s.randomMutation(currentMutation.DBRecordVersion + 1)
+	_, err = ms.ExecutionManager.UpdateWorkflowExecution(ms.Ctx, &p.UpdateWorkflowExecutionRequest{
+		ShardID: ms.ShardID,
+		RangeID: ms.RangeID,
+		Mode:    p.UpdateWorkflowModeUpdateCurrent,
+
+		UpdateWorkflowMutation: *staleMutation,
+	})
+	s.NoError(err)
+	ms.AssertEqualWithDB(currentSnapshot, currentMutation, staleMutation)
+
+	// the new owner's range ID is read from the shard row
+	newOwnerMutation := s.randomMutation(staleMutation.DBRecordVersion + 1)
+	_, err = ms.ExecutionManager.UpdateWorkflowExecution(ms.Ctx, &p.UpdateWorkflowExecutionRequest{
+		ShardID: ms.ShardID,
+		RangeID: ms.RangeID + 1,
+		Mode:    p.UpdateWorkflowModeUpdateCurrent,
+
+		UpdateWorkflowMutation: *newOwnerMutation,
+	})
+	s.NoError(err)
+	ms.AssertEqualWithDB(currentSnapshot, currentMutation, staleMutation, newOwnerMutation)
+}
+
+func (s *ExecutionMutableStateUnconditionalSuite) TestUpdate_WithNew_Conflict() {
+	ms := s.mutableState
+	currentSnapshot := s.createWorkflow()
+
+	currentMutation := s.randomMutation(currentSnapshot.DBRecordVersion + 1)
+	currentMutation.ExecutionState.State = enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED
+	currentMutation.ExecutionState.Status = enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	// the db record version doesn't match, updates creating a new run keep their conditions
+	currentMutation.DBRecordVersion = currentSnapshot.DBRecordVersion + 2
+	newSnapshot := RandomSnapshot(
+		ms.NamespaceID,
+		ms.WorkflowID,
+		uuid.New().String(),
+		rand.Int63(),
+		enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		rand.Int63(),
+	)
+	_, err := ms.ExecutionManager.UpdateWorkflowExecution(ms.Ctx, &p.UpdateWorkflowExecutionRequest{
+		ShardID: ms.ShardID,
+		RangeID: ms.RangeID,
+		Mode:    p.UpdateWorkflowModeUpdateCurrent,
+
+		UpdateWorkflowMutation: *currentMutation,
+		NewWorkflowSnapshot:    newSnapshot,
+	})
+	s.IsType(&p.WorkflowConditionFailedError{}, err)
+	ms.AssertEqualWithDB(currentSnapshot)
+	ms.AssertMissingFromDB(newSnapshot.ExecutionInfo.NamespaceId, newSnapshot.ExecutionInfo.WorkflowId, newSnapshot.ExecutionState.RunId)
+}
+
+func (s *ExecutionMutableStateUnconditionalSuite) createWorkflow() *p.WorkflowSnapshot {
+	return s.mutableState.CreateWorkflow(
+		rand.Int63(),
+		enumsspb.WORKFLOW_EXECUTION_STATE_CREATED,
+		enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		rand.Int63(),
+	)
+}
+
+func (s *ExecutionMutableStateUnconditionalSuite) randomMutation(dbRecordVersion int64) *p.WorkflowMutation {
+	ms := s.mutableState
+	return RandomMutation(
+		ms.NamespaceID,
+		ms.WorkflowID,
+		ms.RunID,
+		rand.Int63(),
+		enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		dbRecordVersion,
+	)
+}
+
+// stealShard moves the shard to another owner, whose range ID is one above the suite's
+func (s *ExecutionMutableStateUnconditionalSuite) stealShard() {
+	ms := s.mutableState
+	resp, err := ms.ShardManager.GetOrCreateShard(ms.Ctx, &p.GetOrCreateShardRequest{ShardID: ms.ShardID})
+	s.NoError(err)
+	resp.ShardInfo.RangeId = ms.RangeID + 1
+	err = ms.ShardManager.UpdateShard(ms.Ctx, &p.UpdateShardRequest{
+		ShardInfo:       resp.ShardInfo,
+		PreviousRangeID: ms.RangeID,
+	})
+	s.NoError(err)
+}
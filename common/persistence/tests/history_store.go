@@ -26,7 +26,10 @@ package tests
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -36,12 +39,17 @@ import (
 
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/claimcheck"
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/objectstore"
+	"go.temporal.io/server/common/payloads"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -52,6 +60,12 @@ import (
 //  * GetHistoryTree
 //  * GetAllHistoryTreeBranches
 
+const (
+	testHistoryNodeChunkSize = 64
+
+	testPayloadOffloadSizeThreshold = 1024
+)
+
 type (
 	HistoryEventsPacket struct {
 		nodeID            int64
@@ -66,7 +80,12 @@ type (
 
 		store      p.ExecutionManager
 		serializer serialization.Serializer
-		logger     log.Logger
+		// chunkedStore splits event batches larger than testHistoryNodeChunkSize
+		chunkedStore p.ExecutionManager
+		// offloadingStore offloads payloads larger than testPayloadOffloadSizeThreshold to payloadObjects
+		offloadingStore p.ExecutionManager
+		payloadObjects  objectstore.Store
+		logger          log.Logger
 
 		Ctx    context.Context
 		Cancel context.CancelFunc
@@ -79,16 +98,46 @@ func NewHistoryEventsSuite(
 	logger log.Logger,
 ) *HistoryEventsSuite {
 	eventSerializer := serialization.NewSerializer()
+	payloadObjects, err := objectstore.NewStore("file://" + filepath.ToSlash(t.TempDir()))
+	require.NoError(t, err)
+	payloadStore := claimcheck.NewPayloadStore(
+		payloadObjects,
+		&claimcheck.Config{
+			Enabled:       dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
+			SizeThreshold: dynamicconfig.GetIntPropertyFn(testPayloadOffloadSizeThreshold),
+		},
+		metrics.NoopMetricsHandler,
+		logger,
+	)
 	return &HistoryEventsSuite{
 		Assertions: require.New(t),
 		store: p.NewExecutionManager(
 			store,
 			eventSerializer,
+			claimcheck.NoopPayloadStore,
+			logger,
+			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			dynamicconfig.GetIntPropertyFn(0),
+		),
+		chunkedStore: p.NewExecutionManager(
+			store,
+			eventSerializer,
+			claimcheck.NoopPayloadStore,
+			logger,
+			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			dynamicconfig.GetIntPropertyFn(testHistoryNodeChunkSize),
+		),
+		offloadingStore: p.NewExecutionManager(
+			store,
+			eventSerializer,
+			payloadStore,
 			logger,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			dynamicconfig.GetIntPropertyFn(0),
 		),
-		serializer: eventSerializer,
-		logger:     logger,
+		payloadObjects: payloadObjects,
+		serializer:     eventSerializer,
+		logger:         logger,
 	}
 }
 
@@ -599,6 +648,57 @@ func (s *HistoryEventsSuite) TestAppendBatches() {
 	s.Equal(expectedEvents, events)
 }
 
+func (s *HistoryEventsSuite) TestAppendMultipleBranches() {
+	shardID := rand.Int31()
+	var branchTokens [][]byte
+	var packets []HistoryEventsPacket
+	var requests []*p.AppendHistoryNodesRequest
+	for i := 0; i < 3; i++ {
+		branchID := uuid.New()
+		branchToken, err := s.store.GetHistoryBranchUtil().NewHistoryBranch(
+			uuid.New(),
+			uuid.New(),
+			&branchID,
+			[]*persistencespb.HistoryBranchRange{},
+			nil,
+			nil,
+			nil,
+		)
+		s.NoError(err)
+
+		eventsPacket := s.newHistoryEvents(
+			[]int64{1, 2, 3},
+			rand.Int63(),
+			0,
+		)
+		branchTokens = append(branchTokens, branchToken)
+		packets = append(packets, eventsPacket)
+		requests = append(requests, &p.AppendHistoryNodesRequest{
+			ShardID:           shardID,
+			BranchToken:       branchToken,
+			Events:            eventsPacket.events,
+			TransactionID:     eventsPacket.transactionID,
+			PrevTransactionID: eventsPacket.prevTransactionID,
+			IsNewBranch:       true,
+		})
+	}
+
+	resp, err := s.store.AppendHistoryNodesBatch(s.Ctx, &p.AppendHistoryNodesBatchRequest{
+		ShardID:  shardID,
+		Requests: requests,
+	})
+	if _, ok := err.(*serviceerror.Unimplemented); ok {
+		// store cannot write multiple branches atomically
+		return
+	}
+	s.NoError(err)
+	s.Len(resp.Responses, len(requests))
+
+	for i, branchToken := range branchTokens {
+		s.Equal(packets[i].events, s.listAllHistoryEvents(shardID, branchToken))
+	}
+}
+
 func (s *HistoryEventsSuite) TestForkDeleteBranch_DeleteBaseBranchFirst() {
 	shardID := rand.Int31()
 	treeID := uuid.New()
@@ -731,6 +831,231 @@ func (s *HistoryEventsSuite) TestForkDeleteBranch_DeleteForkedBranchFirst() {
 	s.Error(err, "Workflow execution history not found.")
 }
 
+func (s *HistoryEventsSuite) TestAppendSelect_Chunked() {
+	shardID := rand.Int31()
+	treeID := uuid.New()
+	branchID := uuid.New()
+	branchToken, err := s.store.GetHistoryBranchUtil().NewHistoryBranch(
+		uuid.New(),
+		treeID,
+		&branchID,
+		[]*persistencespb.HistoryBranchRange{},
+		nil,
+		nil,
+		nil,
+	)
+	s.NoError(err)
+	var events []*historypb.HistoryEvent
+
+	eventsPacket0 := s.newLargeHistoryEvents(
+		[]int64{1, 2, 3},
+		rand.Int63n(1<<40)+1,
+		0,
+	)
+	s.appendChunkedHistoryEvents(shardID, branchToken, eventsPacket0)
+	events = append(events, eventsPacket0.events...)
+
+	eventsPacket1 := s.newHistoryEvents(
+		[]int64{4},
+		eventsPacket0.transactionID+1,
+		eventsPacket0.transactionID,
+	)
+	s.appendChunkedHistoryEvents(shardID, branchToken, eventsPacket1)
+	events = append(events, eventsPacket1.events...)
+
+	eventsPacket2 := s.newLargeHistoryEvents(
+		[]int64{5, 6},
+		eventsPacket1.transactionID+1,
+		eventsPacket1.transactionID,
+	)
+	s.appendChunkedHistoryEvents(shardID, branchToken, eventsPacket2)
+	events = append(events, eventsPacket2.events...)
+
+	s.Equal(events, s.listAllHistoryEvents(shardID, branchToken))
+	s.Equal(eventsPacket2.events, s.listHistoryEvents(shardID, branchToken, 5, 7))
+
+	var reverseEvents []*historypb.HistoryEvent
+	var token []byte
+	for doContinue := true; doContinue; doContinue = len(token) > 0 {
+		resp, err := s.store.ReadHistoryBranchReverse(s.Ctx, &p.ReadHistoryBranchReverseRequest{
+			ShardID:                shardID,
+			BranchToken:            branchToken,
+			MaxEventID:             7,
+			LastFirstTransactionID: eventsPacket2.transactionID,
+			PageSize:               1,
+			NextPageToken:          token,
+		})
+		s.NoError(err)
+		token = resp.NextPageToken
+		reverseEvents = append(reverseEvents, resp.HistoryEvents...)
+	}
+	s.Len(reverseEvents, len(events))
+	for i, event := range reverseEvents {
+		s.Equal(events[len(events)-1-i], event)
+	}
+}
+
+func (s *HistoryEventsSuite) TestAppendSelectTrim_Chunked() {
+	shardID := rand.Int31()
+	treeID := uuid.New()
+	branchID := uuid.New()
+	branchToken, err := s.store.GetHistoryBranchUtil().NewHistoryBranch(
+		uuid.New(),
+		treeID,
+		&branchID,
+		[]*persistencespb.HistoryBranchRange{},
+		nil,
+		nil,
+		nil,
+	)
+	s.NoError(err)
+	var events []*historypb.HistoryEvent
+
+	eventsPacket0 := s.newLargeHistoryEvents(
+		[]int64{1, 2, 3},
+		rand.Int63n(1<<40)+1,
+		0,
+	)
+	s.appendChunkedHistoryEvents(shardID, branchToken, eventsPacket0)
+	events = append(events, eventsPacket0.events...)
+
+	eventsPacket1 := s.newLargeHistoryEvents(
+		[]int64{4, 5},
+		eventsPacket0.transactionID+1,
+		eventsPacket0.transactionID,
+	)
+	s.appendChunkedHistoryEvents(shardID, branchToken, eventsPacket1)
+	events = append(events, eventsPacket1.events...)
+
+	s.appendChunkedHistoryEvents(shardID, branchToken, s.newLargeHistoryEvents(
+		[]int64{4, 5},
+		eventsPacket0.transactionID+2,
+		eventsPacket0.transactionID,
+	))
+
+	s.trimHistoryBranch(shardID, branchToken, eventsPacket1.nodeID, eventsPacket1.transactionID)
+
+	s.Equal(events, s.listAllHistoryEvents(shardID, branchToken))
+}
+
+func (s *HistoryEventsSuite) TestAppendReadRaw_Offloaded() {
+	shardID := rand.Int31()
+	treeID := uuid.New()
+	branchID := uuid.New()
+	branchToken, err := s.store.GetHistoryBranchUtil().NewHistoryBranch(
+		uuid.New(),
+		treeID,
+		&branchID,
+		[]*persistencespb.HistoryBranchRange{},
+		nil,
+		nil,
+		nil,
+	)
+	s.NoError(err)
+	var events []*historypb.HistoryEvent
+
+	eventsPacket0 := s.newOffloadedHistoryEvents(
+		[]int64{1, 2, 3},
+		rand.Int63(),
+		0,
+	)
+	s.appendOffloadedHistoryEvents(shardID, branchToken, eventsPacket0)
+	events = append(events, eventsPacket0.events...)
+
+	eventsPacket1 := s.newHistoryEvents(
+		[]int64{4, 5},
+		eventsPacket0.transactionID+1,
+		eventsPacket0.transactionID,
+	)
+	s.appendOffloadedHistoryEvents(shardID, branchToken, eventsPacket1)
+	events = append(events, eventsPacket1.events...)
+
+	// the stored events only keep references, which raw history resolves
+	stored := s.listAllHistoryEvents(shardID, branchToken)
+	s.True(claimcheck.IsReference(stored[0].GetWorkflowExecutionSignaledEventAttributes().Input.Payloads[0]))
+	s.Equal(events, s.listAllRawHistoryEvents(shardID, branchToken))
+}
+
+func (s *HistoryEventsSuite) TestAppendSelectTrimDelete_Offloaded() {
+	shardID := rand.Int31()
+	treeID := uuid.New()
+	branchID := uuid.New()
+	branchToken, err := s.store.GetHistoryBranchUtil().NewHistoryBranch(
+		uuid.New(),
+		treeID,
+		&branchID,
+		[]*persistencespb.HistoryBranchRange{},
+		nil,
+		nil,
+		nil,
+	)
+	s.NoError(err)
+	var events []*historypb.HistoryEvent
+
+	eventsPacket0 := s.newOffloadedHistoryEvents(
+		[]int64{1, 2, 3},
+		rand.Int63(),
+		0,
+	)
+	s.appendOffloadedHistoryEvents(shardID, branchToken, eventsPacket0)
+	events = append(events, eventsPacket0.events...)
+
+	eventsPacket1 := s.newOffloadedHistoryEvents(
+		[]int64{4, 5},
+		eventsPacket0.transactionID+1,
+		eventsPacket0.transactionID,
+	)
+	s.appendOffloadedHistoryEvents(shardID, branchToken, eventsPacket1)
+	events = append(events, eventsPacket1.events...)
+
+	staleTransactionID := eventsPacket0.transactionID + 2
+	s.appendOffloadedHistoryEvents(shardID, branchToken, s.newOffloadedHistoryEvents(
+		[]int64{4, 5},
+		staleTransactionID,
+		eventsPacket0.transactionID,
+	))
+	s.Len(s.listOffloadedPayloads(treeID), 7)
+
+	_, err = s.offloadingStore.TrimHistoryBranch(s.Ctx, &p.TrimHistoryBranchRequest{
+		ShardID:       shardID,
+		BranchToken:   branchToken,
+		NodeID:        eventsPacket1.nodeID,
+		TransactionID: eventsPacket1.transactionID,
+	})
+	s.NoError(err)
+
+	s.Equal(events, s.listAllRawHistoryEvents(shardID, branchToken))
+	keys := s.listOffloadedPayloads(treeID)
+	s.Len(keys, 5)
+	for _, key := range keys {
+		s.NotContains(key, fmt.Sprintf("-%d-", staleTransactionID))
+	}
+
+	err = s.offloadingStore.DeleteHistoryBranch(s.Ctx, &p.DeleteHistoryBranchRequest{
+		ShardID:     shardID,
+		BranchToken: branchToken,
+	})
+	s.NoError(err)
+	s.Empty(s.listOffloadedPayloads(treeID))
+}
+
+func (s *HistoryEventsSuite) appendChunkedHistoryEvents(
+	shardID int32,
+	branchToken []byte,
+	packet HistoryEventsPacket,
+) {
+	_, err := s.chunkedStore.AppendHistoryNodes(s.Ctx, &p.AppendHistoryNodesRequest{
+		ShardID:           shardID,
+		BranchToken:       branchToken,
+		Events:            packet.events,
+		TransactionID:     packet.transactionID,
+		PrevTransactionID: packet.prevTransactionID,
+		IsNewBranch:       packet.nodeID == common.FirstEventID,
+		Info:              "",
+	})
+	s.NoError(err)
+}
+
 func (s *HistoryEventsSuite) appendHistoryEvents(
 	shardID int32,
 	branchToken []byte,
@@ -748,6 +1073,23 @@ func (s *HistoryEventsSuite) appendHistoryEvents(
 	s.NoError(err)
 }
 
+func (s *HistoryEventsSuite) appendOffloadedHistoryEvents(
+	shardID int32,
+	branchToken []byte,
+	packet HistoryEventsPacket,
+) {
+	_, err := s.offloadingStore.AppendHistoryNodes(s.Ctx, &p.AppendHistoryNodesRequest{
+		ShardID:           shardID,
+		BranchToken:       branchToken,
+		Events:            packet.events,
+		TransactionID:     packet.transactionID,
+		PrevTransactionID: packet.prevTransactionID,
+		IsNewBranch:       packet.nodeID == common.FirstEventID,
+		Info:              "",
+	})
+	s.NoError(err)
+}
+
 func (s *HistoryEventsSuite) appendRawHistoryBatches(
 	shardID int32,
 	branchToken []byte,
@@ -856,6 +1198,40 @@ func (s *HistoryEventsSuite) listAllHistoryEvents(
 	return events
 }
 
+func (s *HistoryEventsSuite) listAllRawHistoryEvents(
+	shardID int32,
+	branchToken []byte,
+) []*historypb.HistoryEvent {
+	var token []byte
+	var events []*historypb.HistoryEvent
+	for doContinue := true; doContinue; doContinue = len(token) > 0 {
+		resp, err := s.offloadingStore.ReadRawHistoryBranch(s.Ctx, &p.ReadHistoryBranchRequest{
+			ShardID:       shardID,
+			BranchToken:   branchToken,
+			MinEventID:    common.FirstEventID,
+			MaxEventID:    common.LastEventID,
+			PageSize:      1,
+			NextPageToken: token,
+		})
+		s.NoError(err)
+		token = resp.NextPageToken
+		for _, blob := range resp.HistoryEventBlobs {
+			batch, err := s.serializer.DeserializeEvents(blob)
+			s.NoError(err)
+			events = append(events, batch...)
+		}
+	}
+	return events
+}
+
+func (s *HistoryEventsSuite) listOffloadedPayloads(
+	treeID string,
+) []string {
+	keys, err := s.payloadObjects.List(s.Ctx, "payloads/"+treeID+"/")
+	s.NoError(err)
+	return keys
+}
+
 func (s *HistoryEventsSuite) newHistoryEvents(
 	eventIDs []int64,
 	transactionID int64,
@@ -877,3 +1253,39 @@ func (s *HistoryEventsSuite) newHistoryEvents(
 		events:            events,
 	}
 }
+
+// newLargeHistoryEvents returns events which serialize to several times testHistoryNodeChunkSize
+func (s *HistoryEventsSuite) newLargeHistoryEvents(
+	eventIDs []int64,
+	transactionID int64,
+	prevTransactionID int64,
+) HistoryEventsPacket {
+	packet := s.newHistoryEvents(eventIDs, transactionID, prevTransactionID)
+	for _, event := range packet.events {
+		event.Attributes = &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+			WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+				SignalName: "signal",
+				Identity:   strings.Repeat(uuid.New(), testHistoryNodeChunkSize/16),
+			},
+		}
+	}
+	return packet
+}
+
+// newOffloadedHistoryEvents returns events with an input larger than testPayloadOffloadSizeThreshold
+func (s *HistoryEventsSuite) newOffloadedHistoryEvents(
+	eventIDs []int64,
+	transactionID int64,
+	prevTransactionID int64,
+) HistoryEventsPacket {
+	packet := s.newHistoryEvents(eventIDs, transactionID, prevTransactionID)
+	for _, event := range packet.events {
+		event.Attributes = &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+			WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+				SignalName: "signal",
+				Input:      payloads.EncodeString(strings.Repeat(uuid.New(), testPayloadOffloadSizeThreshold/16)),
+			},
+		}
+	}
+	return packet
+}
@@ -25,7 +25,11 @@
 package tests
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path"
 	"testing"
@@ -41,7 +45,7 @@ import (
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
-	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
 	sqltests "go.temporal.io/server/common/persistence/sql/sqlplugin/tests"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/environment"
@@ -95,6 +99,35 @@ func SetupSQLiteDatabase(cfg *config.SQL) {
 	LoadSchema(db, path.Join(testSQLiteSchemaDir, "visibility", "schema.sql"))
 }
 
+// removeSQLiteFiles removes a SQLite file database along with its WAL journal files
+func removeSQLiteFiles(databaseName string) error {
+	if err := os.Remove(databaseName); err != nil {
+		return err
+	}
+	for _, suffix := range []string{"-wal", "-shm"} {
+		if err := os.Remove(databaseName + suffix); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+func copySQLiteFile(t *testing.T, src string, dst string) {
+	in, err := os.Open(src)
+	if err != nil {
+		t.Fatalf("unable to open %v: %v", src, err)
+	}
+	defer func() { _ = in.Close() }()
+	out, err := os.Create(dst)
+	if err != nil {
+		t.Fatalf("unable to create %v: %v", dst, err)
+	}
+	defer func() { _ = out.Close() }()
+	if _, err := io.Copy(out, in); err != nil {
+		t.Fatalf("unable to copy %v: %v", src, err)
+	}
+}
+
 func LoadSchema(db sqlplugin.AdminDB, schemaFile string) {
 	statements, err := persistence.LoadAndSplitQuery([]string{schemaFile})
 	if err != nil {
@@ -170,6 +203,27 @@ func TestSQLiteExecutionMutableStateTaskStoreSuite(t *testing.T) {
 	suite.Run(t, s)
 }
 
+func TestSQLiteArchivalDLQSuite(t *testing.T) {
+	cfg := NewSQLiteMemoryConfig()
+	logger := log.NewNoopLogger()
+	factory := sql.NewFactory(
+		*cfg,
+		resolver.NewNoopResolver(),
+		testSQLiteClusterName,
+		logger,
+	)
+	queue, err := factory.NewQueue(persistence.ArchivalDLQQueueType)
+	if err != nil {
+		t.Fatalf("unable to create SQLite DB: %v", err)
+	}
+	defer func() {
+		factory.Close()
+	}()
+
+	s := NewArchivalDLQSuite(t, queue, serialization.NewSerializer(), logger)
+	suite.Run(t, s)
+}
+
 func TestSQLiteHistoryStoreSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
 	logger := log.NewNoopLogger()
@@ -237,7 +291,7 @@ func TestSQLiteFileExecutionMutableStateStoreSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
 	defer func() {
-		assert.NoError(t, os.Remove(cfg.DatabaseName))
+		assert.NoError(t, removeSQLiteFiles(cfg.DatabaseName))
 	}()
 	logger := log.NewNoopLogger()
 	factory := sql.NewFactory(
@@ -272,7 +326,7 @@ func TestSQLiteFileExecutionMutableStateTaskStoreSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
 	defer func() {
-		assert.NoError(t, os.Remove(cfg.DatabaseName))
+		assert.NoError(t, removeSQLiteFiles(cfg.DatabaseName))
 	}()
 	logger := log.NewNoopLogger()
 	factory := sql.NewFactory(
@@ -307,7 +361,7 @@ func TestSQLiteFileHistoryStoreSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
 	defer func() {
-		assert.NoError(t, os.Remove(cfg.DatabaseName))
+		assert.NoError(t, removeSQLiteFiles(cfg.DatabaseName))
 	}()
 	logger := log.NewNoopLogger()
 	factory := sql.NewFactory(
@@ -332,7 +386,7 @@ func TestSQLiteFileTaskQueueSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
 	defer func() {
-		assert.NoError(t, os.Remove(cfg.DatabaseName))
+		assert.NoError(t, removeSQLiteFiles(cfg.DatabaseName))
 	}()
 	logger := log.NewNoopLogger()
 	factory := sql.NewFactory(
@@ -357,7 +411,7 @@ func TestSQLiteFileTaskQueueTaskSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
 	defer func() {
-		assert.NoError(t, os.Remove(cfg.DatabaseName))
+		assert.NoError(t, removeSQLiteFiles(cfg.DatabaseName))
 	}()
 	logger := log.NewNoopLogger()
 	factory := sql.NewFactory(
@@ -773,7 +827,7 @@ func TestSQLiteFileNamespaceSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer removeSQLiteFiles(cfg.DatabaseName)
 
 	s := sqltests.NewNamespaceSuite(t, store)
 	suite.Run(t, s)
@@ -786,7 +840,7 @@ func TestSQLiteFileQueueMessageSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer removeSQLiteFiles(cfg.DatabaseName)
 
 	s := sqltests.NewQueueMessageSuite(t, store)
 	suite.Run(t, s)
@@ -799,7 +853,7 @@ func TestSQLiteFileQueueMetadataSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer removeSQLiteFiles(cfg.DatabaseName)
 
 	s := sqltests.NewQueueMetadataSuite(t, store)
 	suite.Run(t, s)
@@ -812,7 +866,7 @@ func TestSQLiteFileMatchingTaskSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer removeSQLiteFiles(cfg.DatabaseName)
 
 	s := sqltests.NewMatchingTaskSuite(t, store)
 	suite.Run(t, s)
@@ -825,7 +879,7 @@ func TestSQLiteFileMatchingTaskQueueSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer removeSQLiteFiles(cfg.DatabaseName)
 
 	s := sqltests.NewMatchingTaskQueueSuite(t, store)
 	suite.Run(t, s)
@@ -838,7 +892,7 @@ func TestSQLiteFileHistoryShardSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer removeSQLiteFiles(cfg.DatabaseName)
 
 	s := sqltests.NewHistoryShardSuite(t, store)
 	suite.Run(t, s)
@@ -851,7 +905,7 @@ func TestSQLiteFileHistoryNodeSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer removeSQLiteFiles(cfg.DatabaseName)
 
 	s := sqltests.NewHistoryNodeSuite(t, store)
 	suite.Run(t, s)
@@ -864,7 +918,7 @@ func TestSQLiteFileHistoryTreeSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer removeSQLiteFiles(cfg.DatabaseName)
 
 	s := sqltests.NewHistoryTreeSuite(t, store)
 	suite.Run(t, s)
@@ -877,7 +931,7 @@ func TestSQLiteFileHistoryCurrentExecutionSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer removeSQLiteFiles(cfg.DatabaseName)
 
 	s := sqltests.NewHistoryCurrentExecutionSuite(t, store)
 	suite.Run(t, s)
@@ -890,7 +944,7 @@ func TestSQLiteFileHistoryExecutionSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer removeSQLiteFiles(cfg.DatabaseName)
 
 	s := sqltests.NewHistoryExecutionSuite(t, store)
 	suite.Run(t, s)
@@ -903,7 +957,7 @@ func TestSQLiteFileHistoryTransferTaskSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer removeSQLiteFiles(cfg.DatabaseName)
 
 	s := sqltests.NewHistoryTransferTaskSuite(t, store)
 	suite.Run(t, s)
@@ -916,7 +970,7 @@ func TestSQLiteFileHistoryTimerTaskSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer removeSQLiteFiles(cfg.DatabaseName)
 
 	s := sqltests.NewHistoryTimerTaskSuite(t, store)
 	suite.Run(t, s)
@@ -929,7 +983,7 @@ func TestSQLiteFileHistoryReplicationTaskSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer removeSQLiteFiles(cfg.DatabaseName)
 
 	s := sqltests.NewHistoryReplicationTaskSuite(t, store)
 	suite.Run(t, s)
@@ -942,7 +996,7 @@ func TestSQLiteFileHistoryVisibilityTaskSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer removeSQLiteFiles(cfg.DatabaseName)
 
 	s := sqltests.NewHistoryVisibilityTaskSuite(t, store)
 	suite.Run(t, s)
@@ -955,7 +1009,7 @@ func TestSQLiteFileHistoryReplicationDLQTaskSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer removeSQLiteFiles(cfg.DatabaseName)
 
 	s := sqltests.NewHistoryReplicationDLQTaskSuite(t, store)
 	suite.Run(t, s)
@@ -968,7 +1022,7 @@ func TestSQLiteFileHistoryExecutionBufferSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer removeSQLiteFiles(cfg.DatabaseName)
 
 	s := sqltests.NewHistoryExecutionBufferSuite(t, store)
 	suite.Run(t, s)
@@ -981,7 +1035,7 @@ func TestSQLiteFileHistoryExecutionActivitySuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer removeSQLiteFiles(cfg.DatabaseName)
 
 	s := sqltests.NewHistoryExecutionActivitySuite(t, store)
 	suite.Run(t, s)
@@ -994,7 +1048,7 @@ func TestSQLiteFileHistoryExecutionChildWorkflowSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer removeSQLiteFiles(cfg.DatabaseName)
 
 	s := sqltests.NewHistoryExecutionChildWorkflowSuite(t, store)
 	suite.Run(t, s)
@@ -1007,7 +1061,7 @@ func TestSQLiteFileHistoryExecutionTimerSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer removeSQLiteFiles(cfg.DatabaseName)
 
 	s := sqltests.NewHistoryExecutionTimerSuite(t, store)
 	suite.Run(t, s)
@@ -1020,7 +1074,7 @@ func TestSQLiteFileHistoryExecutionRequestCancelSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer removeSQLiteFiles(cfg.DatabaseName)
 
 	s := sqltests.NewHistoryExecutionRequestCancelSuite(t, store)
 	suite.Run(t, s)
@@ -1033,7 +1087,7 @@ func TestSQLiteFileHistoryExecutionSignalSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer removeSQLiteFiles(cfg.DatabaseName)
 
 	s := sqltests.NewHistoryExecutionSignalSuite(t, store)
 	suite.Run(t, s)
@@ -1046,7 +1100,7 @@ func TestSQLiteFileHistoryExecutionSignalRequestSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer removeSQLiteFiles(cfg.DatabaseName)
 
 	s := sqltests.NewHistoryExecutionSignalRequestSuite(t, store)
 	suite.Run(t, s)
@@ -1059,8 +1113,95 @@ func TestSQLiteFileVisibilitySuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer removeSQLiteFiles(cfg.DatabaseName)
 
 	s := sqltests.NewVisibilitySuite(t, store)
 	suite.Run(t, s)
 }
+
+func TestSQLiteFileCrashRecovery(t *testing.T) {
+	cfg := NewSQLiteFileConfig()
+	SetupSQLiteDatabase(cfg)
+	defer func() {
+		assert.NoError(t, removeSQLiteFiles(cfg.DatabaseName))
+	}()
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create SQLite DB: %v", err)
+	}
+
+	row := &sqlplugin.ShardsRow{
+		ShardID:      1,
+		RangeID:      10,
+		Data:         []byte("shard info"),
+		DataEncoding: "Proto3",
+	}
+	_, err = store.InsertIntoShards(context.Background(), row)
+	assert.NoError(t, err)
+
+	// copying the database files while the connection is open, without a WAL checkpoint,
+	// leaves them in the state they would be in had the process crashed
+	crashedCfg := NewSQLiteFileConfig()
+	copySQLiteFile(t, cfg.DatabaseName, crashedCfg.DatabaseName)
+	copySQLiteFile(t, cfg.DatabaseName+"-wal", crashedCfg.DatabaseName+"-wal")
+	defer func() {
+		assert.NoError(t, removeSQLiteFiles(crashedCfg.DatabaseName))
+	}()
+
+	recovered, err := sql.NewSQLDB(sqlplugin.DbKindMain, crashedCfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create SQLite DB: %v", err)
+	}
+	recoveredRow, err := recovered.SelectFromShards(context.Background(), sqlplugin.ShardsFilter{ShardID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, row, recoveredRow)
+}
+
+func TestSQLiteFileBackup(t *testing.T) {
+	cfg := NewSQLiteFileConfig()
+	SetupSQLiteDatabase(cfg)
+	defer func() {
+		assert.NoError(t, removeSQLiteFiles(cfg.DatabaseName))
+	}()
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create SQLite DB: %v", err)
+	}
+
+	row := &sqlplugin.ShardsRow{
+		ShardID:      1,
+		RangeID:      10,
+		Data:         []byte("shard info"),
+		DataEncoding: "Proto3",
+	}
+	_, err = store.InsertIntoShards(context.Background(), row)
+	assert.NoError(t, err)
+
+	var backup bytes.Buffer
+	err = sqlite.Backup(context.Background(), cfg, resolver.NewNoopResolver(), &backup)
+	assert.NoError(t, err)
+
+	// writes after the backup are not part of it
+	_, err = store.UpdateShards(context.Background(), &sqlplugin.ShardsRow{
+		ShardID:      1,
+		RangeID:      11,
+		Data:         []byte("shard info"),
+		DataEncoding: "Proto3",
+	})
+	assert.NoError(t, err)
+
+	restoredCfg := NewSQLiteFileConfig()
+	err = os.WriteFile(restoredCfg.DatabaseName, backup.Bytes(), 0644)
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, removeSQLiteFiles(restoredCfg.DatabaseName))
+	}()
+
+	restored, err := sql.NewSQLDB(sqlplugin.DbKindMain, restoredCfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create SQLite DB: %v", err)
+	}
+	restoredRow, err := restored.SelectFromShards(context.Background(), sqlplugin.ShardsFilter{ShardID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, row, restoredRow)
+}
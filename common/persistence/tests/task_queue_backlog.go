@@ -0,0 +1,271 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tests
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	clockspb "go.temporal.io/server/api/clock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/debug"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	// TaskQueueBacklogSuite covers moving task queues from the tasks table to the task_backlog table.
+	TaskQueueBacklogSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		taskTTL       time.Duration
+		namespaceID   string
+		taskQueueName string
+		taskQueueType enumspb.TaskQueueType
+
+		taskManager        p.TaskManager
+		backlogTaskManager p.TaskManager
+		logger             log.Logger
+
+		ctx    context.Context
+		cancel context.CancelFunc
+	}
+)
+
+func NewTaskQueueBacklogSuite(
+	t *testing.T,
+	taskStore p.TaskStore,
+	backlogTaskStore p.TaskStore,
+	logger log.Logger,
+) *TaskQueueBacklogSuite {
+	return &TaskQueueBacklogSuite{
+		Assertions: require.New(t),
+		taskManager: p.NewTaskManager(
+			taskStore,
+			serialization.NewSerializer(),
+		),
+		backlogTaskManager: p.NewTaskManager(
+			backlogTaskStore,
+			serialization.NewSerializer(),
+		),
+		logger: logger,
+	}
+}
+
+func (s *TaskQueueBacklogSuite) SetupSuite() {
+	rand.Seed(time.Now().UnixNano())
+}
+
+func (s *TaskQueueBacklogSuite) TearDownSuite() {
+
+}
+
+func (s *TaskQueueBacklogSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.ctx, s.cancel = context.WithTimeout(context.Background(), 30*time.Second*debug.TimeoutMultiplier)
+
+	s.taskTTL = time.Minute
+	s.namespaceID = uuid.New().String()
+	s.taskQueueName = uuid.New().String()
+	s.taskQueueType = enumspb.TaskQueueType(rand.Int31n(
+		int32(len(enumspb.TaskQueueType_name)) + 1),
+	)
+}
+
+func (s *TaskQueueBacklogSuite) TearDownTest() {
+	s.cancel()
+}
+
+func (s *TaskQueueBacklogSuite) TestMove() {
+	rangeID := rand.Int63n(1 << 32)
+	taskQueue := s.createTaskQueue(rangeID)
+
+	minTaskID := rand.Int63n(1 << 32)
+	tasks := []*persistencespb.AllocatedTaskInfo{
+		s.randomTask(minTaskID, true),
+		s.randomTask(minTaskID+1, false),
+		s.randomTask(minTaskID+2, true),
+	}
+	_, err := s.taskManager.CreateTasks(s.ctx, &p.CreateTasksRequest{
+		TaskQueueInfo: &p.PersistedTaskQueueInfo{
+			RangeID: rangeID,
+			Data:    taskQueue,
+		},
+		Tasks: tasks,
+	})
+	s.NoError(err)
+
+	resp, err := s.backlogTaskManager.GetTaskQueue(s.ctx, &p.GetTaskQueueRequest{
+		NamespaceID: s.namespaceID,
+		TaskQueue:   s.taskQueueName,
+		TaskType:    s.taskQueueType,
+	})
+	s.NoError(err)
+	s.Equal(rangeID+1, resp.RangeID)
+	s.Equal(taskQueue, resp.TaskQueueInfo)
+
+	s.Equal(tasks, s.getTasks(s.backlogTaskManager, minTaskID, minTaskID+3))
+	s.Equal([]*persistencespb.AllocatedTaskInfo{}, s.getTasks(s.taskManager, minTaskID, minTaskID+3))
+
+	// the previous owner is fenced out
+	_, err = s.taskManager.CreateTasks(s.ctx, &p.CreateTasksRequest{
+		TaskQueueInfo: &p.PersistedTaskQueueInfo{
+			RangeID: rangeID,
+			Data:    taskQueue,
+		},
+		Tasks: []*persistencespb.AllocatedTaskInfo{s.randomTask(minTaskID+3, true)},
+	})
+	s.IsType(&p.ConditionFailedError{}, err)
+
+	// loading the task queue again doesn't move it again
+	resp, err = s.backlogTaskManager.GetTaskQueue(s.ctx, &p.GetTaskQueueRequest{
+		NamespaceID: s.namespaceID,
+		TaskQueue:   s.taskQueueName,
+		TaskType:    s.taskQueueType,
+	})
+	s.NoError(err)
+	s.Equal(rangeID+1, resp.RangeID)
+}
+
+func (s *TaskQueueBacklogSuite) TestMove_NotFound() {
+	_, err := s.backlogTaskManager.GetTaskQueue(s.ctx, &p.GetTaskQueueRequest{
+		NamespaceID: s.namespaceID,
+		TaskQueue:   s.taskQueueName,
+		TaskType:    s.taskQueueType,
+	})
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
+func (s *TaskQueueBacklogSuite) TestCompleteTasks_WithoutExpiry() {
+	rangeID := rand.Int63n(1 << 32)
+	taskQueue := s.createTaskQueue(rangeID)
+	_, err := s.backlogTaskManager.GetTaskQueue(s.ctx, &p.GetTaskQueueRequest{
+		NamespaceID: s.namespaceID,
+		TaskQueue:   s.taskQueueName,
+		TaskType:    s.taskQueueType,
+	})
+	s.NoError(err)
+	rangeID++
+
+	minTaskID := rand.Int63n(1 << 32)
+	tasks := []*persistencespb.AllocatedTaskInfo{
+		s.randomTask(minTaskID, false),
+		s.randomTask(minTaskID+1, false),
+	}
+	_, err = s.backlogTaskManager.CreateTasks(s.ctx, &p.CreateTasksRequest{
+		TaskQueueInfo: &p.PersistedTaskQueueInfo{
+			RangeID: rangeID,
+			Data:    taskQueue,
+		},
+		Tasks: tasks,
+	})
+	s.NoError(err)
+	s.Equal(tasks, s.getTasks(s.backlogTaskManager, minTaskID, minTaskID+2))
+
+	_, err = s.backlogTaskManager.CompleteTasksLessThan(s.ctx, &p.CompleteTasksLessThanRequest{
+		NamespaceID:        s.namespaceID,
+		TaskQueueName:      s.taskQueueName,
+		TaskType:           s.taskQueueType,
+		ExclusiveMaxTaskID: minTaskID + 2,
+		Limit:              len(tasks),
+	})
+	s.NoError(err)
+	s.Equal([]*persistencespb.AllocatedTaskInfo{}, s.getTasks(s.backlogTaskManager, minTaskID, minTaskID+2))
+}
+
+func (s *TaskQueueBacklogSuite) createTaskQueue(
+	rangeID int64,
+) *persistencespb.TaskQueueInfo {
+	taskQueue := &persistencespb.TaskQueueInfo{
+		NamespaceId:    s.namespaceID,
+		Name:           s.taskQueueName,
+		TaskType:       s.taskQueueType,
+		Kind:           enumspb.TASK_QUEUE_KIND_NORMAL,
+		AckLevel:       rand.Int63(),
+		LastUpdateTime: timestamp.TimeNowPtrUtc(),
+	}
+	_, err := s.taskManager.CreateTaskQueue(s.ctx, &p.CreateTaskQueueRequest{
+		RangeID:       rangeID,
+		TaskQueueInfo: taskQueue,
+	})
+	s.NoError(err)
+	return taskQueue
+}
+
+func (s *TaskQueueBacklogSuite) getTasks(
+	taskManager p.TaskManager,
+	inclusiveMinTaskID int64,
+	exclusiveMaxTaskID int64,
+) []*persistencespb.AllocatedTaskInfo {
+	resp, err := taskManager.GetTasks(s.ctx, &p.GetTasksRequest{
+		NamespaceID:        s.namespaceID,
+		TaskQueue:          s.taskQueueName,
+		TaskType:           s.taskQueueType,
+		InclusiveMinTaskID: inclusiveMinTaskID,
+		ExclusiveMaxTaskID: exclusiveMaxTaskID,
+		PageSize:           100,
+	})
+	s.NoError(err)
+	s.Nil(resp.NextPageToken)
+	return resp.Tasks
+}
+
+func (s *TaskQueueBacklogSuite) randomTask(
+	taskID int64,
+	withExpiry bool,
+) *persistencespb.AllocatedTaskInfo {
+	now := timestamp.TimeNowPtrUtc()
+	var expiryTime *time.Time
+	if withExpiry {
+		expiryTime = timestamp.TimePtr(now.Add(s.taskTTL))
+	}
+	return &persistencespb.AllocatedTaskInfo{
+		TaskId: taskID,
+		Data: &persistencespb.TaskInfo{
+			NamespaceId:      s.namespaceID,
+			WorkflowId:       uuid.New().String(),
+			RunId:            uuid.New().String(),
+			ScheduledEventId: rand.Int63(),
+			CreateTime:       now,
+			ExpiryTime:       expiryTime,
+			Clock: &clockspb.VectorClock{
+				ClusterId: rand.Int63(),
+				ShardId:   rand.Int31(),
+				Clock:     rand.Int63(),
+			},
+		},
+	}
+}
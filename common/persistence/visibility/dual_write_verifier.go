@@ -0,0 +1,291 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+)
+
+const (
+	dualWriteVerificationQueueSize = 1000
+	dualWriteVerificationTimeout   = 10 * time.Second
+)
+
+type (
+	// DualWriteVerificationConfig configures the verification of the records written to both visibility stores.
+	// Sampled records are read back from both stores after a delay and compared field by field.
+	DualWriteVerificationConfig struct {
+		SampleRate dynamicconfig.FloatPropertyFnWithNamespaceFilter
+		Delay      dynamicconfig.DurationPropertyFn
+	}
+
+	dualWriteVerifier struct {
+		status         int32
+		config         *DualWriteVerificationConfig
+		primary        manager.VisibilityManager
+		secondary      manager.VisibilityManager
+		metricsHandler metrics.Handler
+		logger         log.Logger
+
+		verificationCh chan *dualWriteVerification
+		shutdownCh     chan struct{}
+		shutdownWG     sync.WaitGroup
+	}
+
+	dualWriteVerification struct {
+		request *manager.GetWorkflowExecutionRequest
+		dueTime time.Time
+		retried bool
+	}
+)
+
+func newDualWriteVerifier(
+	config *DualWriteVerificationConfig,
+	primary manager.VisibilityManager,
+	secondary manager.VisibilityManager,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *dualWriteVerifier {
+	return &dualWriteVerifier{
+		status:         common.DaemonStatusInitialized,
+		config:         config,
+		primary:        primary,
+		secondary:      secondary,
+		metricsHandler: metricsHandler,
+		logger:         logger,
+		verificationCh: make(chan *dualWriteVerification, dualWriteVerificationQueueSize),
+		shutdownCh:     make(chan struct{}),
+	}
+}
+
+func (v *dualWriteVerifier) Start() {
+	if !atomic.CompareAndSwapInt32(&v.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	v.shutdownWG.Add(1)
+	go v.verifyLoop()
+}
+
+func (v *dualWriteVerifier) Stop() {
+	if !atomic.CompareAndSwapInt32(&v.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(v.shutdownCh)
+	v.shutdownWG.Wait()
+}
+
+// sample queues the verification of a record just written to both stores, if it is sampled.
+func (v *dualWriteVerifier) sample(
+	request *manager.VisibilityRequestBase,
+	closeTime *time.Time,
+) {
+	if rand.Float64() >= v.config.SampleRate(request.Namespace.String()) {
+		return
+	}
+	getRequest := &manager.GetWorkflowExecutionRequest{
+		NamespaceID: request.NamespaceID,
+		Namespace:   request.Namespace,
+		RunID:       request.Execution.GetRunId(),
+		WorkflowID:  request.Execution.GetWorkflowId(),
+		CloseTime:   closeTime,
+	}
+	if closeTime == nil {
+		startTime := request.StartTime
+		getRequest.StartTime = &startTime
+	}
+	v.enqueue(&dualWriteVerification{
+		request: getRequest,
+		dueTime: time.Now().Add(v.config.Delay()),
+	})
+}
+
+func (v *dualWriteVerifier) enqueue(verification *dualWriteVerification) {
+	select {
+	case v.verificationCh <- verification:
+	default:
+		v.metricsHandler.Counter(metrics.VisibilityDualWriteVerificationDropped.GetMetricName()).Record(1)
+	}
+}
+
+func (v *dualWriteVerifier) verifyLoop() {
+	defer v.shutdownWG.Done()
+
+	for {
+		select {
+		case <-v.shutdownCh:
+			return
+		case verification := <-v.verificationCh:
+			if wait := time.Until(verification.dueTime); wait > 0 {
+				timer := time.NewTimer(wait)
+				select {
+				case <-timer.C:
+				case <-v.shutdownCh:
+					timer.Stop()
+					return
+				}
+			}
+			v.verify(verification)
+		}
+	}
+}
+
+func (v *dualWriteVerifier) verify(verification *dualWriteVerification) {
+	request := verification.request
+	ctx, cancel := context.WithTimeout(context.Background(), dualWriteVerificationTimeout)
+	defer cancel()
+	ctx = headers.SetCallerInfo(ctx, headers.NewBackgroundCallerInfo(request.Namespace.String()))
+	metricsHandler := v.metricsHandler.WithTags(metrics.NamespaceTag(request.Namespace.String()))
+
+	primary, err := v.getExecution(ctx, v.primary, request)
+	if err != nil {
+		metricsHandler.Counter(metrics.VisibilityDualWriteVerificationErrors.GetMetricName()).Record(1)
+		return
+	}
+	secondary, err := v.getExecution(ctx, v.secondary, request)
+	if err != nil {
+		metricsHandler.Counter(metrics.VisibilityDualWriteVerificationErrors.GetMetricName()).Record(1)
+		return
+	}
+
+	divergences := diffExecutionInfo(primary, secondary)
+	if len(divergences) > 0 && !verification.retried {
+		// the record may have been updated in between the two reads, or not be visible in one of the stores yet
+		verification.retried = true
+		verification.dueTime = time.Now().Add(v.config.Delay())
+		v.enqueue(verification)
+		return
+	}
+
+	metricsHandler.Counter(metrics.VisibilityDualWriteVerified.GetMetricName()).Record(1)
+	for _, field := range divergences {
+		metricsHandler.Counter(metrics.VisibilityDualWriteDivergence.GetMetricName()).Record(1, metrics.StringTag("field", field))
+	}
+	if len(divergences) > 0 {
+		v.logger.Warn("Visibility record diverges between primary and secondary visibility stores.",
+			tag.WorkflowNamespace(request.Namespace.String()),
+			tag.WorkflowID(request.WorkflowID),
+			tag.WorkflowRunID(request.RunID),
+			tag.Value(divergences),
+		)
+	}
+}
+
+// getExecution returns the record of the store, nil if it doesn't exist.
+func (v *dualWriteVerifier) getExecution(
+	ctx context.Context,
+	visibilityManager manager.VisibilityManager,
+	request *manager.GetWorkflowExecutionRequest,
+) (*workflowpb.WorkflowExecutionInfo, error) {
+	resp, err := visibilityManager.GetWorkflowExecution(ctx, request)
+	switch err.(type) {
+	case nil:
+		return resp.Execution, nil
+	case *serviceerror.NotFound:
+		return nil, nil
+	default:
+		return nil, err
+	}
+}
+
+// diffExecutionInfo returns the fields which differ between the two records. Timestamps are compared with millisecond
+// precision, which is the precision of Elasticsearch.
+func diffExecutionInfo(
+	a *workflowpb.WorkflowExecutionInfo,
+	b *workflowpb.WorkflowExecutionInfo,
+) []string {
+	if a == nil || b == nil {
+		if a == nil && b == nil {
+			return nil
+		}
+		return []string{"record"}
+	}
+
+	var fields []string
+	if a.GetType().GetName() != b.GetType().GetName() {
+		fields = append(fields, "type")
+	}
+	if a.GetStatus() != b.GetStatus() {
+		fields = append(fields, "status")
+	}
+	if !equalTime(a.GetStartTime(), b.GetStartTime()) {
+		fields = append(fields, "start_time")
+	}
+	if !equalTime(a.GetExecutionTime(), b.GetExecutionTime()) {
+		fields = append(fields, "execution_time")
+	}
+	if !equalTime(a.GetCloseTime(), b.GetCloseTime()) {
+		fields = append(fields, "close_time")
+	}
+	if a.GetHistoryLength() != b.GetHistoryLength() {
+		fields = append(fields, "history_length")
+	}
+	if a.GetTaskQueue() != b.GetTaskQueue() {
+		fields = append(fields, "task_queue")
+	}
+	if !equalPayloads(a.GetMemo().GetFields(), b.GetMemo().GetFields()) {
+		fields = append(fields, "memo")
+	}
+	if !equalPayloads(a.GetSearchAttributes().GetIndexedFields(), b.GetSearchAttributes().GetIndexedFields()) {
+		fields = append(fields, "search_attributes")
+	}
+	return fields
+}
+
+func equalTime(a *time.Time, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Truncate(time.Millisecond).Equal(b.Truncate(time.Millisecond))
+}
+
+func equalPayloads(a map[string]*commonpb.Payload, b map[string]*commonpb.Payload) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, payload := range a {
+		other, ok := b[key]
+		if !ok || !bytes.Equal(payload.GetData(), other.GetData()) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,180 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+)
+
+type dualWriteVerifierSuite struct {
+	*require.Assertions
+	suite.Suite
+	controller *gomock.Controller
+
+	primary        *manager.MockVisibilityManager
+	secondary      *manager.MockVisibilityManager
+	metricsHandler *metrics.MockHandler
+	verifier       *dualWriteVerifier
+}
+
+func TestDualWriteVerifierSuite(t *testing.T) {
+	suite.Run(t, new(dualWriteVerifierSuite))
+}
+
+func (s *dualWriteVerifierSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.primary = manager.NewMockVisibilityManager(s.controller)
+	s.secondary = manager.NewMockVisibilityManager(s.controller)
+	s.metricsHandler = metrics.NewMockHandler(s.controller)
+	s.metricsHandler.EXPECT().WithTags(gomock.Any()).Return(s.metricsHandler).AnyTimes()
+	s.verifier = newDualWriteVerifier(
+		&DualWriteVerificationConfig{
+			SampleRate: func(string) float64 { return 1 },
+			Delay:      dynamicconfig.GetDurationPropertyFn(0),
+		},
+		s.primary,
+		s.secondary,
+		s.metricsHandler,
+		log.NewNoopLogger(),
+	)
+}
+
+func (s *dualWriteVerifierSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *dualWriteVerifierSuite) executionInfo() *workflowpb.WorkflowExecutionInfo {
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 123456789, time.UTC)
+	return &workflowpb.WorkflowExecutionInfo{
+		Execution: &testWorkflowExecution,
+		Type:      &commonpb.WorkflowType{Name: testWorkflowTypeName},
+		StartTime: &startTime,
+		Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		TaskQueue: "test-task-queue",
+		SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+			"CustomKeywordField": {Data: []byte(`"value"`)},
+		}},
+	}
+}
+
+func (s *dualWriteVerifierSuite) sample() {
+	s.verifier.sample(&manager.VisibilityRequestBase{
+		NamespaceID: testNamespaceUUID,
+		Namespace:   testNamespace,
+		Execution:   testWorkflowExecution,
+		StartTime:   time.Now().UTC(),
+	}, nil)
+}
+
+func (s *dualWriteVerifierSuite) verifyNext() {
+	select {
+	case verification := <-s.verifier.verificationCh:
+		s.verifier.verify(verification)
+	default:
+		s.Fail("no verification queued")
+	}
+}
+
+func (s *dualWriteVerifierSuite) TestDiffExecutionInfo() {
+	a := s.executionInfo()
+	b := s.executionInfo()
+	// Elasticsearch only keeps milliseconds
+	truncated := b.StartTime.Truncate(time.Millisecond)
+	b.StartTime = &truncated
+	s.Empty(diffExecutionInfo(a, b))
+
+	b.Status = enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	b.SearchAttributes.IndexedFields["CustomKeywordField"] = &commonpb.Payload{Data: []byte(`"other"`)}
+	s.Equal([]string{"status", "search_attributes"}, diffExecutionInfo(a, b))
+
+	s.Equal([]string{"record"}, diffExecutionInfo(a, nil))
+	s.Empty(diffExecutionInfo(nil, nil))
+}
+
+func (s *dualWriteVerifierSuite) TestVerify_Consistent() {
+	s.primary.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		&manager.GetWorkflowExecutionResponse{Execution: s.executionInfo()}, nil)
+	s.secondary.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		&manager.GetWorkflowExecutionResponse{Execution: s.executionInfo()}, nil)
+	counter := metrics.NewMockCounterIface(s.controller)
+	s.metricsHandler.EXPECT().Counter(metrics.VisibilityDualWriteVerified.GetMetricName()).Return(counter)
+	counter.EXPECT().Record(int64(1))
+
+	s.sample()
+	s.verifyNext()
+}
+
+func (s *dualWriteVerifierSuite) TestVerify_Divergence() {
+	secondaryInfo := s.executionInfo()
+	secondaryInfo.Status = enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	s.primary.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		&manager.GetWorkflowExecutionResponse{Execution: s.executionInfo()}, nil).Times(2)
+	s.secondary.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		&manager.GetWorkflowExecutionResponse{Execution: secondaryInfo}, nil).Times(2)
+	counter := metrics.NewMockCounterIface(s.controller)
+	s.metricsHandler.EXPECT().Counter(metrics.VisibilityDualWriteVerified.GetMetricName()).Return(counter)
+	counter.EXPECT().Record(int64(1))
+	divergence := metrics.NewMockCounterIface(s.controller)
+	s.metricsHandler.EXPECT().Counter(metrics.VisibilityDualWriteDivergence.GetMetricName()).Return(divergence)
+	divergence.EXPECT().Record(int64(1), metrics.StringTag("field", "status"))
+
+	s.sample()
+	// the first mismatch is checked again before being reported
+	s.verifyNext()
+	s.verifyNext()
+}
+
+func (s *dualWriteVerifierSuite) TestVerify_Missing() {
+	s.primary.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		&manager.GetWorkflowExecutionResponse{Execution: s.executionInfo()}, nil).Times(2)
+	s.secondary.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		nil, serviceerror.NewNotFound("not found")).Times(2)
+	counter := metrics.NewMockCounterIface(s.controller)
+	s.metricsHandler.EXPECT().Counter(metrics.VisibilityDualWriteVerified.GetMetricName()).Return(counter)
+	counter.EXPECT().Record(int64(1))
+	divergence := metrics.NewMockCounterIface(s.controller)
+	s.metricsHandler.EXPECT().Counter(metrics.VisibilityDualWriteDivergence.GetMetricName()).Return(divergence)
+	divergence.EXPECT().Record(int64(1), metrics.StringTag("field", "record"))
+
+	s.sample()
+	s.verifyNext()
+	s.verifyNext()
+}
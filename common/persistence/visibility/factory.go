@@ -29,6 +29,8 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/encryption"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
@@ -44,6 +46,12 @@ import (
 	"go.temporal.io/server/common/searchattribute"
 )
 
+const (
+	// visibilityStoreCircuitBreakerPrefix prefixes the store name of the visibility circuit breakers,
+	// to tell them apart from the circuit breakers of the default store
+	visibilityStoreCircuitBreakerPrefix = "VisibilityStore/"
+)
+
 func NewManager(
 	persistenceCfg config.Persistence,
 	persistenceResolver resolver.ServiceResolver,
@@ -52,6 +60,7 @@ func NewManager(
 	esProcessorConfig *elasticsearch.ProcessorConfig,
 	searchAttributesProvider searchattribute.Provider,
 	searchAttributesMapperProvider searchattribute.MapperProvider,
+	keyProvider encryption.KeyProvider,
 
 	maxReadQPS dynamicconfig.IntPropertyFn,
 	maxWriteQPS dynamicconfig.IntPropertyFn,
@@ -59,6 +68,8 @@ func NewManager(
 	secondaryVisibilityWritingMode dynamicconfig.StringPropertyFn,
 	visibilityDisableOrderByClause dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	visibilityEnableManualPagination dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	circuitBreakers *persistence.CircuitBreakers,
+	dualWriteVerification *DualWriteVerificationConfig,
 
 	metricsHandler metrics.Handler,
 	logger log.Logger,
@@ -70,10 +81,12 @@ func NewManager(
 		esProcessorConfig,
 		searchAttributesProvider,
 		searchAttributesMapperProvider,
+		keyProvider,
 		maxReadQPS,
 		maxWriteQPS,
 		visibilityDisableOrderByClause,
 		visibilityEnableManualPagination,
+		circuitBreakers,
 		metricsHandler,
 		logger,
 	)
@@ -92,10 +105,12 @@ func NewManager(
 		esProcessorConfig,
 		searchAttributesProvider,
 		searchAttributesMapperProvider,
+		keyProvider,
 		maxReadQPS,
 		maxWriteQPS,
 		visibilityDisableOrderByClause,
 		visibilityEnableManualPagination,
+		circuitBreakers,
 		metricsHandler,
 		logger,
 	)
@@ -107,11 +122,11 @@ func NewManager(
 		isPrimaryAdvancedSQL := false
 		isSecondaryAdvancedSQL := false
 		switch visibilityManager.GetStoreNames()[0] {
-		case mysql.PluginNameV8, postgresql.PluginNameV12, sqlite.PluginName:
+		case mysql.PluginNameV8, postgresql.PluginNameV12, postgresql.PluginNameCockroachDB, sqlite.PluginName:
 			isPrimaryAdvancedSQL = true
 		}
 		switch secondaryVisibilityManager.GetStoreNames()[0] {
-		case mysql.PluginNameV8, postgresql.PluginNameV12, sqlite.PluginName:
+		case mysql.PluginNameV8, postgresql.PluginNameV12, postgresql.PluginNameCockroachDB, sqlite.PluginName:
 			isSecondaryAdvancedSQL = true
 		}
 		if isPrimaryAdvancedSQL && !isSecondaryAdvancedSQL {
@@ -125,10 +140,21 @@ func NewManager(
 			enableReadFromSecondaryVisibility,
 			secondaryVisibilityWritingMode,
 		)
+		var verifier *dualWriteVerifier
+		if dualWriteVerification != nil {
+			verifier = newDualWriteVerifier(
+				dualWriteVerification,
+				visibilityManager,
+				secondaryVisibilityManager,
+				metricsHandler,
+				logger,
+			)
+		}
 		return NewVisibilityManagerDual(
 			visibilityManager,
 			secondaryVisibilityManager,
 			managerSelector,
+			verifier,
 		), nil
 	}
 
@@ -137,8 +163,10 @@ func NewManager(
 
 func newVisibilityManager(
 	visStore store.VisibilityStore,
+	keyProvider encryption.KeyProvider,
 	maxReadQPS dynamicconfig.IntPropertyFn,
 	maxWriteQPS dynamicconfig.IntPropertyFn,
+	circuitBreakers *persistence.CircuitBreakers,
 	metricsHandler metrics.Handler,
 	tag metrics.Tag,
 	logger log.Logger,
@@ -146,13 +174,20 @@ func newVisibilityManager(
 	if visStore == nil {
 		return nil
 	}
-	var visManager manager.VisibilityManager = newVisibilityManagerImpl(visStore, logger)
+	var visManager manager.VisibilityManager = newVisibilityManagerImpl(visStore, encryption.NewEncryptor(keyProvider), logger)
 
 	// wrap with rate limiter
 	visManager = NewVisibilityManagerRateLimited(
 		visManager,
 		maxReadQPS,
 		maxWriteQPS)
+	// wrap with circuit breaker
+	if circuitBreakers != nil {
+		visManager = NewVisibilityManagerCircuitBreaker(
+			visManager,
+			circuitBreakers.Get(visibilityStoreCircuitBreakerPrefix+visStore.GetName()),
+		)
+	}
 	// wrap with metrics client
 	visManager = NewVisibilityManagerMetrics(
 		visManager,
@@ -172,11 +207,13 @@ func newVisibilityManagerFromDataStoreConfig(
 	esProcessorConfig *elasticsearch.ProcessorConfig,
 	searchAttributesProvider searchattribute.Provider,
 	searchAttributesMapperProvider searchattribute.MapperProvider,
+	keyProvider encryption.KeyProvider,
 
 	maxReadQPS dynamicconfig.IntPropertyFn,
 	maxWriteQPS dynamicconfig.IntPropertyFn,
 	visibilityDisableOrderByClause dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	visibilityEnableManualPagination dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	circuitBreakers *persistence.CircuitBreakers,
 
 	metricsHandler metrics.Handler,
 	logger log.Logger,
@@ -201,8 +238,10 @@ func newVisibilityManagerFromDataStoreConfig(
 	}
 	return newVisibilityManager(
 		visStore,
+		keyProvider,
 		maxReadQPS,
 		maxWriteQPS,
+		circuitBreakers,
 		metricsHandler,
 		metrics.AdvancedVisibilityTypeTag(),
 		logger,
@@ -229,7 +268,7 @@ func newVisibilityStoreFromDataStoreConfig(
 	)
 	if dsConfig.SQL != nil {
 		switch dsConfig.SQL.PluginName {
-		case mysql.PluginNameV8, postgresql.PluginNameV12, sqlite.PluginName:
+		case mysql.PluginNameV8, postgresql.PluginNameV12, postgresql.PluginNameCockroachDB, sqlite.PluginName:
 			visStore, err = sql.NewSQLVisibilityStore(
 				*dsConfig.SQL,
 				persistenceResolver,
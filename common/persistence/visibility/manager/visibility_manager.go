@@ -125,6 +125,9 @@ type (
 		// Pass in empty slice for first page.
 		NextPageToken []byte
 		Query         string
+		// StrongConsistency requests the first page to reflect all visibility writes completed
+		// before the request. It's more expensive and only honored by ListWorkflowExecutions.
+		StrongConsistency bool
 	}
 
 	// ListWorkflowExecutionsResponse is the response to ListWorkflowExecutionsRequest
@@ -145,6 +148,15 @@ type (
 	// CountWorkflowExecutionsResponse is response to CountWorkflowExecutions
 	CountWorkflowExecutionsResponse struct {
 		Count int64
+		// Groups holds the count of executions for each value of the field in the GROUP BY clause of the query.
+		// It is empty if the query doesn't have a GROUP BY clause.
+		Groups []CountWorkflowExecutionsGroup
+	}
+
+	// CountWorkflowExecutionsGroup is the count of executions which have the same value of the GROUP BY field
+	CountWorkflowExecutionsGroup struct {
+		Value string
+		Count int64
 	}
 
 	// ListWorkflowExecutionsByTypeRequest is used to list executions of
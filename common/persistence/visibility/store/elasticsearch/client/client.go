@@ -45,6 +45,9 @@ type (
 		Get(ctx context.Context, index string, docID string) (*elastic.GetResult, error)
 		Search(ctx context.Context, p *SearchParameters) (*elastic.SearchResult, error)
 		Count(ctx context.Context, index string, query elastic.Query) (int64, error)
+		CountGroupBy(ctx context.Context, index string, query elastic.Query, aggName string, agg elastic.Aggregation) (*elastic.SearchResult, error)
+		// RefreshIndex makes all operations performed on the index so far visible to search.
+		RefreshIndex(ctx context.Context, index string) error
 		RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (BulkProcessor, error)
 
 		// TODO (alex): move this to some admin client (and join with IntegrationTestsClient)
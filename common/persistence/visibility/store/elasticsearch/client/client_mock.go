@@ -90,6 +90,21 @@ func (mr *MockClientMockRecorder) Count(ctx, index, query interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockClient)(nil).Count), ctx, index, query)
 }
 
+// CountGroupBy mocks base method.
+func (m *MockClient) CountGroupBy(ctx context.Context, index string, query v7.Query, aggName string, agg v7.Aggregation) (*v7.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountGroupBy", ctx, index, query, aggName, agg)
+	ret0, _ := ret[0].(*v7.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountGroupBy indicates an expected call of CountGroupBy.
+func (mr *MockClientMockRecorder) CountGroupBy(ctx, index, query, aggName, agg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountGroupBy", reflect.TypeOf((*MockClient)(nil).CountGroupBy), ctx, index, query, aggName, agg)
+}
+
 // Get mocks base method.
 func (m *MockClient) Get(ctx context.Context, index, docID string) (*v7.GetResult, error) {
 	m.ctrl.T.Helper()
@@ -150,6 +165,20 @@ func (mr *MockClientMockRecorder) PutMapping(ctx, index, mapping interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutMapping", reflect.TypeOf((*MockClient)(nil).PutMapping), ctx, index, mapping)
 }
 
+// RefreshIndex mocks base method.
+func (m *MockClient) RefreshIndex(ctx context.Context, index string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshIndex", ctx, index)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshIndex indicates an expected call of RefreshIndex.
+func (mr *MockClientMockRecorder) RefreshIndex(ctx, index interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshIndex", reflect.TypeOf((*MockClient)(nil).RefreshIndex), ctx, index)
+}
+
 // RunBulkProcessor mocks base method.
 func (m *MockClient) RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (BulkProcessor, error) {
 	m.ctrl.T.Helper()
@@ -248,6 +277,21 @@ func (mr *MockCLIClientMockRecorder) Count(ctx, index, query interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockCLIClient)(nil).Count), ctx, index, query)
 }
 
+// CountGroupBy mocks base method.
+func (m *MockCLIClient) CountGroupBy(ctx context.Context, index string, query v7.Query, aggName string, agg v7.Aggregation) (*v7.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountGroupBy", ctx, index, query, aggName, agg)
+	ret0, _ := ret[0].(*v7.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountGroupBy indicates an expected call of CountGroupBy.
+func (mr *MockCLIClientMockRecorder) CountGroupBy(ctx, index, query, aggName, agg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountGroupBy", reflect.TypeOf((*MockCLIClient)(nil).CountGroupBy), ctx, index, query, aggName, agg)
+}
+
 // Delete mocks base method.
 func (m *MockCLIClient) Delete(ctx context.Context, indexName, docID string, version int64) error {
 	m.ctrl.T.Helper()
@@ -322,6 +366,20 @@ func (mr *MockCLIClientMockRecorder) PutMapping(ctx, index, mapping interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutMapping", reflect.TypeOf((*MockCLIClient)(nil).PutMapping), ctx, index, mapping)
 }
 
+// RefreshIndex mocks base method.
+func (m *MockCLIClient) RefreshIndex(ctx context.Context, index string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshIndex", ctx, index)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshIndex indicates an expected call of RefreshIndex.
+func (mr *MockCLIClientMockRecorder) RefreshIndex(ctx, index interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshIndex", reflect.TypeOf((*MockCLIClient)(nil).RefreshIndex), ctx, index)
+}
+
 // RunBulkProcessor mocks base method.
 func (m *MockCLIClient) RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (BulkProcessor, error) {
 	m.ctrl.T.Helper()
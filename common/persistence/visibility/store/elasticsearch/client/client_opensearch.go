@@ -0,0 +1,315 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/olivere/elastic/v7"
+	"github.com/olivere/elastic/v7/uritemplates"
+
+	"go.temporal.io/server/common/auth"
+	"go.temporal.io/server/common/log"
+)
+
+const (
+	// OpenSearchVersion selects the OpenSearch client with the version property of the config
+	OpenSearchVersion = "opensearch"
+
+	ismPolicyIDSetting = "index.plugins.index_state_management.policy_id"
+)
+
+type (
+	// OpenSearchClient is a Client for OpenSearch, which also manages index state management (ISM) policies.
+	OpenSearchClient interface {
+		CLIClient
+		IntegrationTestsClient
+
+		// PutISMPolicy creates or replaces the ISM policy with the given ID and JSON body.
+		PutISMPolicy(ctx context.Context, policyID string, policy string) error
+		// GetISMPolicyID returns the ID of the ISM policy managing the index, or empty string if it isn't managed.
+		GetISMPolicyID(ctx context.Context, index string) (string, error)
+		// AttachISMPolicy makes the ISM policy with the given ID manage the index, replacing its current policy.
+		AttachISMPolicy(ctx context.Context, index string, policyID string) error
+	}
+
+	// openSearchClient implements OpenSearchClient. OpenSearch serves the document, search and index APIs
+	// of Elasticsearch 7.10, so they go through the Elasticsearch client, and only the APIs OpenSearch
+	// implements differently are overridden.
+	openSearchClient struct {
+		*clientImpl
+	}
+
+	ismUpdateResponse struct {
+		UpdatedIndices int  `json:"updated_indices"`
+		Failures       bool `json:"failures"`
+		FailedIndices  []struct {
+			IndexName string `json:"index_name"`
+			Reason    string `json:"reason"`
+		} `json:"failed_indices"`
+	}
+)
+
+var _ OpenSearchClient = (*openSearchClient)(nil)
+
+func newOpenSearchClient(cfg *Config, httpClient *http.Client, logger log.Logger) (*openSearchClient, error) {
+	if cfg.TLS != nil && cfg.TLS.Enabled {
+		if httpClient != nil {
+			return nil, errors.New("opensearch config: tls can't be combined with AWS request signing")
+		}
+		tlsConfig, err := newTLSConfig(cfg.TLS)
+		if err != nil {
+			return nil, err
+		}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = tlsConfig
+		httpClient = &http.Client{Transport: transport}
+	}
+
+	client, err := newClient(cfg, httpClient, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &openSearchClient{clientImpl: client}, nil
+}
+
+func (c *openSearchClient) OpenPointInTime(ctx context.Context, index string, keepAliveInterval string) (string, error) {
+	path, err := uritemplates.Expand("/{index}/_search/point_in_time", map[string]string{
+		"index": index,
+	})
+	if err != nil {
+		return "", err
+	}
+	res, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: "POST",
+		Path:   path,
+		Params: url.Values{"keep_alive": []string{keepAliveInterval}},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var body struct {
+		PitID string `json:"pit_id"`
+	}
+	if err := json.Unmarshal(res.Body, &body); err != nil {
+		return "", err
+	}
+	return body.PitID, nil
+}
+
+func (c *openSearchClient) ClosePointInTime(ctx context.Context, id string) (bool, error) {
+	res, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: "DELETE",
+		Path:   "/_search/point_in_time",
+		Body:   map[string][]string{"pit_id": {id}},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	var body struct {
+		Pits []struct {
+			PitID      string `json:"pit_id"`
+			Successful bool   `json:"successful"`
+		} `json:"pits"`
+	}
+	if err := json.Unmarshal(res.Body, &body); err != nil {
+		return false, err
+	}
+	for _, pit := range body.Pits {
+		if pit.PitID == id {
+			return pit.Successful, nil
+		}
+	}
+	return false, nil
+}
+
+func (c *openSearchClient) PutISMPolicy(ctx context.Context, policyID string, policy string) error {
+	path, err := uritemplates.Expand("/_plugins/_ism/policies/{policy}", map[string]string{
+		"policy": policyID,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: "PUT",
+		Path:   path,
+		Body:   policy,
+	})
+	return err
+}
+
+func (c *openSearchClient) GetISMPolicyID(ctx context.Context, index string) (string, error) {
+	path, err := uritemplates.Expand("/_plugins/_ism/explain/{index}", map[string]string{
+		"index": index,
+	})
+	if err != nil {
+		return "", err
+	}
+	res, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: "GET",
+		Path:   path,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal(res.Body, &body); err != nil {
+		return "", err
+	}
+	explanation, ok := body[index]
+	if !ok {
+		return "", nil
+	}
+	var settings map[string]interface{}
+	if err := json.Unmarshal(explanation, &settings); err != nil {
+		return "", err
+	}
+	// The policy ID is null for indices which aren't managed.
+	policyID, _ := settings[ismPolicyIDSetting].(string)
+	return policyID, nil
+}
+
+func (c *openSearchClient) AttachISMPolicy(ctx context.Context, index string, policyID string) error {
+	currentPolicyID, err := c.GetISMPolicyID(ctx, index)
+	if err != nil {
+		return err
+	}
+	var api string
+	switch currentPolicyID {
+	case policyID:
+		return nil
+	case "":
+		api = "add"
+	default:
+		api = "change_policy"
+	}
+
+	path, err := uritemplates.Expand("/_plugins/_ism/{api}/{index}", map[string]string{
+		"api":   api,
+		"index": index,
+	})
+	if err != nil {
+		return err
+	}
+	res, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: "POST",
+		Path:   path,
+		Body:   map[string]string{"policy_id": policyID},
+	})
+	if err != nil {
+		return err
+	}
+
+	var body ismUpdateResponse
+	if err := json.Unmarshal(res.Body, &body); err != nil {
+		return err
+	}
+	if body.Failures {
+		if len(body.FailedIndices) > 0 {
+			failedIndex := body.FailedIndices[0]
+			return fmt.Errorf("unable to attach ISM policy %q to index %q: %s", policyID, failedIndex.IndexName, failedIndex.Reason)
+		}
+		return fmt.Errorf("unable to attach ISM policy %q to index %q", policyID, index)
+	}
+	return nil
+}
+
+// AttachISMPolicies makes the ISM policy configured for OpenSearch manage all the configured visibility indices.
+// It does nothing for Elasticsearch or if no policy is configured.
+func AttachISMPolicies(ctx context.Context, client Client, cfg *Config) error {
+	openSearchClient, ok := client.(OpenSearchClient)
+	if !ok || cfg.ISMPolicyID == "" {
+		return nil
+	}
+	for _, index := range cfg.Indices {
+		if err := openSearchClient.AttachISMPolicy(ctx, index, cfg.ISMPolicyID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newTLSConfig(cfg *auth.TLS) (*tls.Config, error) {
+	if cfg.CertData != "" && cfg.CertFile != "" {
+		return nil, errors.New("only one of certData or certFile properties should be specified")
+	}
+	if cfg.KeyData != "" && cfg.KeyFile != "" {
+		return nil, errors.New("only one of keyData or keyFile properties should be specified")
+	}
+	if cfg.CaData != "" && cfg.CaFile != "" {
+		return nil, errors.New("only one of caData or caFile properties should be specified")
+	}
+
+	tlsConfig := auth.NewTLSConfigForServer(cfg.ServerName, cfg.EnableHostVerification)
+	certBytes, err := readTLSArtifact(cfg.CertFile, cfg.CertData)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read client certificate: %w", err)
+	}
+	keyBytes, err := readTLSArtifact(cfg.KeyFile, cfg.KeyData)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read client certificate private key: %w", err)
+	}
+	if len(certBytes) > 0 {
+		clientCert, err := tls.X509KeyPair(certBytes, keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("unable to generate x509 key pair: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+
+	caBytes, err := readTLSArtifact(cfg.CaFile, cfg.CaData)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read CA certificate: %w", err)
+	}
+	if len(caBytes) > 0 {
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caBytes) {
+			return nil, errors.New("failed to load CA certificate as PEM")
+		}
+	}
+	return tlsConfig, nil
+}
+
+func readTLSArtifact(file string, data string) ([]byte, error) {
+	if file != "" {
+		return os.ReadFile(file)
+	}
+	if data != "" {
+		return base64.StdEncoding.DecodeString(data)
+	}
+	return nil, nil
+}
@@ -0,0 +1,167 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/auth"
+	"go.temporal.io/server/common/log"
+)
+
+type openSearchRequest struct {
+	method string
+	path   string
+	query  url.Values
+	body   map[string]interface{}
+}
+
+func newTestOpenSearchClient(t *testing.T, responses map[string]string) (*openSearchClient, *[]openSearchRequest) {
+	var requests []openSearchRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := openSearchRequest{method: r.Method, path: r.URL.Path, query: r.URL.Query()}
+		var reader io.Reader = r.Body
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			gzipReader, err := gzip.NewReader(r.Body)
+			require.NoError(t, err)
+			reader = gzipReader
+		}
+		body, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		if len(body) > 0 {
+			require.NoError(t, json.Unmarshal(body, &request.body))
+		}
+		requests = append(requests, request)
+
+		response, ok := responses[r.Method+" "+r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			response = `{"error":"not found"}`
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	client, err := newOpenSearchClient(&Config{Version: OpenSearchVersion, URL: *serverURL}, nil, log.NewNoopLogger())
+	require.NoError(t, err)
+	return client, &requests
+}
+
+func Test_OpenSearchPointInTime(t *testing.T) {
+	client, requests := newTestOpenSearchClient(t, map[string]string{
+		"POST /visibility/_search/point_in_time": `{"pit_id":"pit-1","creation_time":1}`,
+		"DELETE /_search/point_in_time":          `{"pits":[{"pit_id":"pit-1","successful":true}]}`,
+	})
+
+	pitID, err := client.OpenPointInTime(context.Background(), "visibility", "1m")
+	require.NoError(t, err)
+	assert.Equal(t, "pit-1", pitID)
+	assert.Equal(t, "1m", (*requests)[0].query.Get("keep_alive"))
+
+	closed, err := client.ClosePointInTime(context.Background(), pitID)
+	require.NoError(t, err)
+	assert.True(t, closed)
+	assert.Equal(t, []interface{}{"pit-1"}, (*requests)[1].body["pit_id"])
+}
+
+func Test_OpenSearchAttachISMPolicy(t *testing.T) {
+	tests := []struct {
+		name          string
+		explain       string
+		expectedPaths []string
+	}{
+		{
+			name:          "unmanaged index",
+			explain:       `{"visibility":{"index.plugins.index_state_management.policy_id":null},"total_managed_indices":0}`,
+			expectedPaths: []string{"/_plugins/_ism/explain/visibility", "/_plugins/_ism/add/visibility"},
+		},
+		{
+			name:          "index managed by another policy",
+			explain:       `{"visibility":{"index.plugins.index_state_management.policy_id":"old-policy"},"total_managed_indices":1}`,
+			expectedPaths: []string{"/_plugins/_ism/explain/visibility", "/_plugins/_ism/change_policy/visibility"},
+		},
+		{
+			name:          "index managed by the policy",
+			explain:       `{"visibility":{"index.plugins.index_state_management.policy_id":"retention"},"total_managed_indices":1}`,
+			expectedPaths: []string{"/_plugins/_ism/explain/visibility"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, requests := newTestOpenSearchClient(t, map[string]string{
+				"GET /_plugins/_ism/explain/visibility":        test.explain,
+				"POST /_plugins/_ism/add/visibility":           `{"updated_indices":1,"failures":false,"failed_indices":[]}`,
+				"POST /_plugins/_ism/change_policy/visibility": `{"updated_indices":1,"failures":false,"failed_indices":[]}`,
+			})
+
+			err := AttachISMPolicies(context.Background(), client, &Config{
+				Indices:     map[string]string{VisibilityAppName: "visibility"},
+				ISMPolicyID: "retention",
+			})
+			require.NoError(t, err)
+
+			var paths []string
+			for _, request := range *requests {
+				paths = append(paths, request.path)
+			}
+			assert.Equal(t, test.expectedPaths, paths)
+			if len(*requests) > 1 {
+				assert.Equal(t, "retention", (*requests)[1].body["policy_id"])
+			}
+		})
+	}
+}
+
+func Test_OpenSearchAttachISMPolicy_Failure(t *testing.T) {
+	client, _ := newTestOpenSearchClient(t, map[string]string{
+		"GET /_plugins/_ism/explain/visibility": `{"visibility":{"index.plugins.index_state_management.policy_id":null}}`,
+		"POST /_plugins/_ism/add/visibility":    `{"updated_indices":0,"failures":true,"failed_indices":[{"index_name":"visibility","reason":"policy not found"}]}`,
+	})
+
+	err := client.AttachISMPolicy(context.Background(), "visibility", "retention")
+	assert.ErrorContains(t, err, "policy not found")
+}
+
+func Test_OpenSearchTLSWithAWSRequestSigning(t *testing.T) {
+	_, err := newOpenSearchClient(&Config{
+		Version: OpenSearchVersion,
+		TLS:     &auth.TLS{Enabled: true},
+	}, &http.Client{}, log.NewNoopLogger())
+	assert.Error(t, err)
+}
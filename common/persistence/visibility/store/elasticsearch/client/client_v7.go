@@ -53,7 +53,6 @@ var _ Client = (*clientImpl)(nil)
 func newClient(cfg *Config, httpClient *http.Client, logger log.Logger) (*clientImpl, error) {
 	options := []elastic.ClientOptionFunc{
 		elastic.SetURL(cfg.URL.String()),
-		elastic.SetBasicAuth(cfg.Username, cfg.Password),
 		// Disable healthcheck to prevent blocking client creation (and thus Temporal server startup) if the Elasticsearch is down.
 		elastic.SetHealthcheck(false),
 		elastic.SetSniff(cfg.EnableSniff),
@@ -68,6 +67,11 @@ func newClient(cfg *Config, httpClient *http.Client, logger log.Logger) (*client
 	if httpClient == nil {
 		httpClient = http.DefaultClient
 	}
+	if cfg.RefreshPassword != nil {
+		httpClient = withBasicAuthTransport(cfg, httpClient)
+	} else {
+		options = append(options, elastic.SetBasicAuth(cfg.Username, cfg.Password))
+	}
 
 	// TODO (alex): Remove this when https://github.com/olivere/elastic/pull/1507 is merged.
 	if cfg.CloseIdleConnectionsInterval != time.Duration(0) {
@@ -158,6 +162,26 @@ func (c *clientImpl) Count(ctx context.Context, index string, query elastic.Quer
 	return c.esClient.Count(index).Query(query).Do(ctx)
 }
 
+func (c *clientImpl) CountGroupBy(
+	ctx context.Context,
+	index string,
+	query elastic.Query,
+	aggName string,
+	agg elastic.Aggregation,
+) (*elastic.SearchResult, error) {
+	searchSource := elastic.NewSearchSource().
+		Query(query).
+		Size(0).
+		TrackTotalHits(true).
+		Aggregation(aggName, agg)
+	return c.esClient.Search(index).SearchSource(searchSource).Do(ctx)
+}
+
+func (c *clientImpl) RefreshIndex(ctx context.Context, index string) error {
+	_, err := c.esClient.Refresh(index).Do(ctx)
+	return err
+}
+
 func (c *clientImpl) RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (BulkProcessor, error) {
 	esBulkProcessor, err := c.esClient.BulkProcessor().
 		Name(p.Name).
@@ -25,10 +25,13 @@
 package client
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/url"
 	"time"
+
+	"go.temporal.io/server/common/auth"
 )
 
 const (
@@ -50,6 +53,14 @@ type (
 		CloseIdleConnectionsInterval time.Duration             `yaml:"closeIdleConnectionsInterval"`
 		EnableSniff                  bool                      `yaml:"enableSniff"`
 		EnableHealthcheck            bool                      `yaml:"enableHealthcheck"`
+		// TLS is used to authenticate with the OpenSearch security plugin using client certificates.
+		// It is only supported by the OpenSearch client.
+		TLS *auth.TLS `yaml:"tls"`
+		// ISMPolicyID is the ID of the OpenSearch index state management policy attached to the visibility indices.
+		ISMPolicyID string `yaml:"ismPolicyID"`
+		// RefreshPassword resolves the password again if it was given as a secret reference. It is set by
+		// config.Config.ResolveSecrets and called when a request is rejected as unauthorized, to pick up rotated passwords.
+		RefreshPassword func(ctx context.Context) (string, error) `yaml:"-"`
 	}
 
 	// ESAWSRequestSigningConfig represents configuration for signing ES requests to AWS
@@ -0,0 +1,126 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	passwordRefreshMinInterval = 5 * time.Second
+)
+
+type (
+	// basicAuthTransport sets the basic auth credentials of the config on requests. If the password
+	// is a secret reference, it is resolved again when a request is rejected as unauthorized, and the
+	// request is sent again with the rotated password.
+	basicAuthTransport struct {
+		base http.RoundTripper
+		cfg  *Config
+
+		sync.Mutex
+		password        string
+		passwordRefresh time.Time
+	}
+
+	idleConnectionsCloser interface {
+		CloseIdleConnections()
+	}
+)
+
+var _ http.RoundTripper = (*basicAuthTransport)(nil)
+
+// withBasicAuthTransport returns a copy of httpClient which authenticates requests with the
+// credentials of the config, resolving the password again after it was rotated.
+func withBasicAuthTransport(cfg *Config, httpClient *http.Client) *http.Client {
+	base := httpClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	client := *httpClient
+	client.Transport = &basicAuthTransport{
+		base:     base,
+		cfg:      cfg,
+		password: cfg.Password,
+	}
+	return &client
+}
+
+func (t *basicAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.Lock()
+	password := t.password
+	t.Unlock()
+
+	resp, err := t.base.RoundTrip(t.withBasicAuth(req, password))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	refreshedPassword, ok := t.refreshPassword(req)
+	if !ok || refreshedPassword == password || (req.Body != nil && req.GetBody == nil) {
+		return resp, nil
+	}
+	retryReq := t.withBasicAuth(req, refreshedPassword)
+	if req.Body != nil {
+		if retryReq.Body, err = req.GetBody(); err != nil {
+			return resp, nil
+		}
+	}
+	_ = resp.Body.Close()
+	return t.base.RoundTrip(retryReq)
+}
+
+func (t *basicAuthTransport) CloseIdleConnections() {
+	if closer, ok := t.base.(idleConnectionsCloser); ok {
+		closer.CloseIdleConnections()
+	}
+}
+
+func (t *basicAuthTransport) withBasicAuth(req *http.Request, password string) *http.Request {
+	// a RoundTripper must not modify the request it was given
+	req = req.Clone(req.Context())
+	req.SetBasicAuth(t.cfg.Username, password)
+	return req
+}
+
+// refreshPassword resolves the password again. Refreshes are spaced by passwordRefreshMinInterval
+// so that a misconfigured user doesn't flood the secret provider, a caller within the interval gets
+// the password of the last refresh.
+func (t *basicAuthTransport) refreshPassword(req *http.Request) (string, bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	if time.Since(t.passwordRefresh) < passwordRefreshMinInterval {
+		return t.password, true
+	}
+	t.passwordRefresh = time.Now()
+	password, err := t.cfg.RefreshPassword(req.Context())
+	if err != nil {
+		return "", false
+	}
+	t.password = password
+	return t.password, true
+}
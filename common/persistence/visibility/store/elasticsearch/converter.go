@@ -43,6 +43,8 @@ var allowedComparisonOperators = map[string]struct{}{
 	sqlparser.NotLikeStr:      {},
 	sqlparser.InStr:           {},
 	sqlparser.NotInStr:        {},
+	query.StartsWithOp:        {},
+	query.NotStartsWithOp:     {},
 }
 
 func newQueryConverter(
@@ -37,18 +37,21 @@ import (
 )
 
 var errorCases = map[string]string{
-	"delete":                          query.MalformedSqlQueryErrMessage,
-	"update x":                        query.MalformedSqlQueryErrMessage,
-	"insert ":                         query.MalformedSqlQueryErrMessage,
-	"insert into a values(1,2)":       query.NotSupportedErrMessage,
-	"update a set id = 1":             query.NotSupportedErrMessage,
-	"delete from a where id=1":        query.NotSupportedErrMessage,
-	"select * from a where NOT(id=1)": query.NotSupportedErrMessage,
-	"select * from a where 1 = 1":     query.InvalidExpressionErrMessage,
-	"select * from a where 1=a":       query.InvalidExpressionErrMessage,
-	"select * from a where zz(k=2)":   query.NotSupportedErrMessage,
-	"select * from a group by k":      query.NotSupportedErrMessage,
-	"invalid query":                   query.MalformedSqlQueryErrMessage,
+	"delete":                                  query.MalformedSqlQueryErrMessage,
+	"update x":                                query.MalformedSqlQueryErrMessage,
+	"insert ":                                 query.MalformedSqlQueryErrMessage,
+	"insert into a values(1,2)":               query.NotSupportedErrMessage,
+	"update a set id = 1":                     query.NotSupportedErrMessage,
+	"delete from a where id=1":                query.NotSupportedErrMessage,
+	"select * from a where NOT(id=1)":         query.NotSupportedErrMessage,
+	"select * from a where 1 = 1":             query.InvalidExpressionErrMessage,
+	"select * from a where 1=a":               query.InvalidExpressionErrMessage,
+	"select * from a where zz(k=2)":           query.NotSupportedErrMessage,
+	"select * from a group by k, j":           query.NotSupportedErrMessage,
+	"select * from a where k regexp 'a'":      query.NotSupportedErrMessage,
+	"select * from a where k starts_with 1":   query.InvalidExpressionErrMessage,
+	"select * from a where k starts_with (1)": query.InvalidExpressionErrMessage,
+	"invalid query":                           query.MalformedSqlQueryErrMessage,
 	"select * from a where  a= 1 and multi_match(zz=1, query='this is a test', fields=(title,title.origin), type=phrase)": query.NotSupportedErrMessage,
 }
 
@@ -88,6 +91,8 @@ var supportedWhereCases = map[string]string{
 	"value = 1528358645.1234567":                   `{"bool":{"filter":{"match":{"value":{"query":1528358645.1234567}}}}}`,
 	// Long float is truncated.
 	"value = 1528358645.123456790":                                            `{"bool":{"filter":{"match":{"value":{"query":1528358645.1234567}}}}}`,
+	"id STARTS_WITH 'order-' and content = 'starts_with'":                     `{"bool":{"filter":[{"prefix":{"id":"order-"}},{"match":{"content":{"query":"starts_with"}}}]}}`,
+	"id not starts_with 'order-'":                                             `{"bool":{"must_not":{"prefix":{"id":"order-"}}}}`,
 	"id in (\"text1\",'text2') and content = 'aaaa'":                          `{"bool":{"filter":[{"terms":{"id":["text1","text2"]}},{"match":{"content":{"query":"aaaa"}}}]}}`,
 	"create_time BETWEEN '2015-01-01 00:00:00' and '2016-02-02 00:00:00'":     `{"bool":{"filter":{"range":{"create_time":{"from":"2015-01-01 00:00:00","include_lower":true,"include_upper":true,"to":"2016-02-02 00:00:00"}}}}}`,
 	"create_time nOt between '2015-01-01 00:00:00' and '2016-02-02 00:00:00'": `{"bool":{"must_not":{"range":{"create_time":{"from":"2015-01-01 00:00:00","include_lower":true,"include_upper":true,"to":"2016-02-02 00:00:00"}}}}}`,
@@ -164,6 +169,22 @@ func TestSupportedSelectWhereOrder(t *testing.T) {
 	}
 }
 
+func TestSelectWhereGroupBy(t *testing.T) {
+	c := newQueryConverter(nil, nil)
+
+	queryParams, err := c.ConvertWhereOrderBy("group by ExecutionStatus")
+	assert.NoError(t, err)
+	assert.Nil(t, queryParams.Query)
+	assert.Equal(t, []string{"ExecutionStatus"}, queryParams.GroupBy)
+
+	queryParams, err = c.ConvertWhereOrderBy("WorkflowType = 'foo' GROUP BY ExecutionStatus")
+	assert.NoError(t, err)
+	actualQueryMap, _ := queryParams.Query.Source()
+	actualQueryJson, _ := json.Marshal(actualQueryMap)
+	assert.Equal(t, `{"bool":{"filter":{"match":{"WorkflowType":{"query":"foo"}}}}}`, string(actualQueryJson))
+	assert.Equal(t, []string{"ExecutionStatus"}, queryParams.GroupBy)
+}
+
 func TestErrors(t *testing.T) {
 	c := newQueryConverter(nil, nil)
 	for sql, expectedErrMessage := range errorCases {
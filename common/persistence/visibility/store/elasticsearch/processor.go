@@ -71,6 +71,7 @@ type (
 		metricsHandler          metrics.Handler
 		indexerConcurrency      uint32
 		shutdownLock            sync.RWMutex
+		stats                   *ProcessorStats
 	}
 
 	// ProcessorConfig contains all configs for processor
@@ -83,6 +84,30 @@ type (
 		ESProcessorFlushInterval dynamicconfig.DurationPropertyFn
 
 		ESProcessorAckTimeout dynamicconfig.DurationPropertyFn
+
+		// Stats, if set, is updated by every processor created with this config,
+		// so that bulk ingestion can be observed outside of the visibility store.
+		Stats *ProcessorStats
+	}
+
+	// ProcessorStats aggregates the bulk ingestion state of the processors sharing it.
+	ProcessorStats struct {
+		inFlightBulks    atomic.Int64
+		workers          atomic.Int64
+		droppedDocuments atomic.Int64
+	}
+
+	// ProcessorStatsSnapshot is a point in time copy of ProcessorStats.
+	ProcessorStatsSnapshot struct {
+		// InFlightBulks is the number of bulk requests sent to Elasticsearch and not answered yet.
+		InFlightBulks int64
+		// Workers is the number of bulk requests which can be in flight at the same time.
+		Workers int64
+		// Saturation is InFlightBulks / Workers. Requests wait for a worker when it reaches 1.
+		Saturation float64
+		// DroppedDocuments is the number of documents rejected by Elasticsearch as invalid,
+		// which won't be indexed however often they are retried.
+		DroppedDocuments int64
 	}
 
 	ackFuture struct { // value of processorImpl.mapToAckFuture
@@ -117,6 +142,7 @@ func NewProcessor(
 		logger:             log.With(logger, tag.ComponentIndexerESProcessor),
 		metricsHandler:     metricsHandler.WithTags(metrics.OperationTag(metrics.ElasticsearchBulkProcessor)),
 		indexerConcurrency: uint32(cfg.IndexerConcurrency()),
+		stats:              cfg.Stats,
 		bulkProcessorParameters: &client.BulkProcessorParameters{
 			Name:          visibilityProcessorName,
 			NumOfWorkers:  cfg.ESProcessorNumOfWorkers(),
@@ -125,6 +151,9 @@ func NewProcessor(
 			FlushInterval: cfg.ESProcessorFlushInterval(),
 		},
 	}
+	if p.stats == nil {
+		p.stats = NewProcessorStats()
+	}
 	p.bulkProcessorParameters.AfterFunc = p.bulkAfterAction
 	p.bulkProcessorParameters.BeforeFunc = p.bulkBeforeAction
 	return p
@@ -145,6 +174,7 @@ func (p *processorImpl) Start() {
 	if err != nil {
 		p.logger.Fatal("Unable to start Elasticsearch processor.", tag.LifeCycleStartFailed, tag.Error(err))
 	}
+	p.stats.workers.Add(int64(p.bulkProcessorParameters.NumOfWorkers))
 }
 
 func (p *processorImpl) Stop() {
@@ -159,6 +189,7 @@ func (p *processorImpl) Stop() {
 	p.shutdownLock.Lock()
 	defer p.shutdownLock.Unlock()
 
+	p.stats.workers.Add(-int64(p.bulkProcessorParameters.NumOfWorkers))
 	err := p.bulkProcessor.Stop()
 	if err != nil {
 		// This could happen if ES is down when we're trying to shut down the server.
@@ -210,6 +241,8 @@ func (p *processorImpl) Add(request *client.BulkableRequest, visibilityTaskKey s
 
 // bulkBeforeAction is triggered before bulk processor commit
 func (p *processorImpl) bulkBeforeAction(_ int64, requests []elastic.BulkableRequest) {
+	p.stats.inFlightBulks.Add(1)
+	p.recordSaturation()
 	p.metricsHandler.Counter(metrics.ElasticsearchBulkProcessorRequests.GetMetricName()).Record(int64(len(requests)))
 	p.metricsHandler.Histogram(metrics.ElasticsearchBulkProcessorBulkSize.GetMetricName(), metrics.ElasticsearchBulkProcessorBulkSize.GetMetricUnit()).
 		Record(int64(len(requests)))
@@ -232,6 +265,9 @@ func (p *processorImpl) bulkBeforeAction(_ int64, requests []elastic.BulkableReq
 
 // bulkAfterAction is triggered after bulk processor commit
 func (p *processorImpl) bulkAfterAction(_ int64, requests []elastic.BulkableRequest, response *elastic.BulkResponse, err error) {
+	p.stats.inFlightBulks.Add(-1)
+	p.recordSaturation()
+
 	// Record how long the Elasticsearch took to process the bulk request.
 	p.metricsHandler.Timer(metrics.ElasticsearchBulkProcessorBulkResquestTookLatency.GetMetricName()).
 		Record(time.Duration(response.Took) * time.Millisecond)
@@ -289,6 +325,10 @@ func (p *processorImpl) bulkAfterAction(_ int64, requests []elastic.BulkableRequ
 				tag.ESDocID(docID),
 				tag.ESRequest(request.String()))
 			p.metricsHandler.Counter(metrics.ElasticsearchBulkProcessorFailures.GetMetricName()).Record(1, metrics.HttpStatusTag(responseItem.Status))
+			if isRejectedDocument(responseItem) {
+				p.stats.droppedDocuments.Add(1)
+				p.metricsHandler.Counter(metrics.ElasticsearchBulkProcessorDroppedDocuments.GetMetricName()).Record(1)
+			}
 			p.notifyResult(visibilityTaskKey, false)
 			continue
 		}
@@ -301,6 +341,10 @@ func (p *processorImpl) bulkAfterAction(_ int64, requests []elastic.BulkableRequ
 		Record(int64(p.mapToAckFuture.Len()))
 }
 
+func (p *processorImpl) recordSaturation() {
+	p.metricsHandler.Gauge(metrics.ElasticsearchBulkProcessorSaturation.GetMetricName()).Record(p.stats.Snapshot().Saturation)
+}
+
 func (p *processorImpl) buildResponseIndex(response *elastic.BulkResponse) map[string]*elastic.BulkResponseItem {
 	result := make(map[string]*elastic.BulkResponseItem)
 	for _, operationResponseItemMap := range response.Items {
@@ -409,6 +453,12 @@ func isSuccess(item *elastic.BulkResponseItem) bool {
 	return false
 }
 
+// isRejectedDocument returns true if a failed item was rejected because of the document itself
+// (e.g. a mapping error), as opposed to a transient failure of the cluster.
+func isRejectedDocument(item *elastic.BulkResponseItem) bool {
+	return item.Status >= 400 && item.Status < 500 && item.Status != 429 && item.Status != 404
+}
+
 func extractErrorReason(resp *elastic.BulkResponseItem) string {
 	if resp.Error != nil {
 		return resp.Error.Reason
@@ -450,3 +500,21 @@ func (a *ackFuture) done(ack bool, metricsHandler metrics.Handler) {
 		metricsHandler.Timer(metrics.ElasticsearchBulkProcessorCommitLatency.GetMetricName()).Record(doneAt.Sub(a.startedAt))
 	}
 }
+
+// NewProcessorStats creates stats to be shared by processors through ProcessorConfig.
+func NewProcessorStats() *ProcessorStats {
+	return &ProcessorStats{}
+}
+
+// Snapshot returns the current stats.
+func (s *ProcessorStats) Snapshot() ProcessorStatsSnapshot {
+	snapshot := ProcessorStatsSnapshot{
+		InFlightBulks:    s.inFlightBulks.Load(),
+		Workers:          s.workers.Load(),
+		DroppedDocuments: s.droppedDocuments.Load(),
+	}
+	if snapshot.Workers > 0 {
+		snapshot.Saturation = float64(snapshot.InFlightBulks) / float64(snapshot.Workers)
+	}
+	return snapshot
+}
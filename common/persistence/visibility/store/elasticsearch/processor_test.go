@@ -83,6 +83,8 @@ func (s *processorSuite) SetupTest() {
 	s.mockMetricHandler = metrics.NewMockHandler(s.controller)
 	s.mockMetricHandler.EXPECT().WithTags(metrics.OperationTag(metrics.ElasticsearchBulkProcessor)).
 		Return(s.mockMetricHandler).AnyTimes()
+	s.mockMetricHandler.EXPECT().Gauge(metrics.ElasticsearchBulkProcessorSaturation.GetMetricName()).
+		Return(metrics.NoopGaugeMetricFunc).AnyTimes()
 	s.mockBulkProcessor = client.NewMockBulkProcessor(s.controller)
 	s.mockESClient = client.NewMockClient(s.controller)
 	s.esProcessor = NewProcessor(cfg, s.mockESClient, logger, s.mockMetricHandler)
@@ -345,11 +347,43 @@ func (s *processorSuite) TestBulkAfterAction_Nack() {
 	counterMetric := metrics.NewMockCounterIface(s.controller)
 	s.mockMetricHandler.EXPECT().Counter(metrics.ElasticsearchBulkProcessorFailures.GetMetricName()).Return(counterMetric)
 	counterMetric.EXPECT().Record(int64(1), metrics.HttpStatusTag(400))
+	s.mockMetricHandler.EXPECT().Counter(metrics.ElasticsearchBulkProcessorDroppedDocuments.GetMetricName()).Return(metrics.NoopCounterMetricFunc)
 
 	s.esProcessor.bulkAfterAction(0, requests, response, nil)
 	result, err := mapVal.future.Get(context.Background())
 	s.NoError(err)
 	s.False(result)
+	s.Equal(int64(1), s.esProcessor.stats.Snapshot().DroppedDocuments)
+}
+
+func (s *processorSuite) TestStats() {
+	stats := NewProcessorStats()
+	config := &ProcessorConfig{
+		IndexerConcurrency:       dynamicconfig.GetIntPropertyFn(32),
+		ESProcessorNumOfWorkers:  dynamicconfig.GetIntPropertyFn(2),
+		ESProcessorBulkActions:   dynamicconfig.GetIntPropertyFn(10),
+		ESProcessorBulkSize:      dynamicconfig.GetIntPropertyFn(2 << 20),
+		ESProcessorFlushInterval: dynamicconfig.GetDurationPropertyFn(1 * time.Minute),
+		Stats:                    stats,
+	}
+	p := NewProcessor(config, s.mockESClient, s.esProcessor.logger, s.mockMetricHandler)
+
+	bulkProcessor := client.NewMockBulkProcessor(s.controller)
+	bulkProcessor.EXPECT().Stop()
+	s.mockESClient.EXPECT().RunBulkProcessor(gomock.Any(), gomock.Any()).Return(bulkProcessor, nil)
+	p.Start()
+	s.Equal(ProcessorStatsSnapshot{Workers: 2}, stats.Snapshot())
+
+	s.mockMetricHandler.EXPECT().Counter(metrics.ElasticsearchBulkProcessorRequests.GetMetricName()).Return(metrics.NoopCounterMetricFunc)
+	s.mockMetricHandler.EXPECT().Histogram(
+		metrics.ElasticsearchBulkProcessorBulkSize.GetMetricName(),
+		metrics.ElasticsearchBulkProcessorBulkSize.GetMetricUnit(),
+	).Return(metrics.NoopHistogramMetricFunc)
+	p.bulkBeforeAction(0, nil)
+	s.Equal(ProcessorStatsSnapshot{InFlightBulks: 1, Workers: 2, Saturation: 0.5}, stats.Snapshot())
+
+	p.Stop()
+	s.Equal(int64(0), stats.Snapshot().Workers)
 }
 
 func (s *processorSuite) TestBulkAfterAction_Error() {
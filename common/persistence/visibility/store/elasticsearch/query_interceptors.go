@@ -86,13 +86,28 @@ func (ni *nameInterceptor) Name(name string, usage query.FieldNameUsage) (string
 		return "", query.NewConverterError("invalid search attribute: %s", name)
 	}
 
-	if usage == query.FieldNameSorter {
-		if fieldType == enumspb.INDEXED_VALUE_TYPE_TEXT {
-			return "", query.NewConverterError("unable to sort by field of %s type, use field of type %s", enumspb.INDEXED_VALUE_TYPE_TEXT.String(), enumspb.INDEXED_VALUE_TYPE_KEYWORD.String())
+	switch usage {
+	case query.FieldNameSorter:
+		if err := query.ValidateSortType(fieldType); err != nil {
+			return "", err
+		}
+	case query.FieldNameRangeFilter:
+		if err := query.ValidateRangeCondType(name, fieldType); err != nil {
+			return "", err
+		}
+	case query.FieldNamePrefixFilter:
+		if err := query.ValidateStartsWithType(name, fieldName, fieldType); err != nil {
+			return "", err
+		}
+	}
+
+	if usage == query.FieldNameGroupBy {
+		if fieldType != enumspb.INDEXED_VALUE_TYPE_KEYWORD {
+			return "", query.NewConverterError("unable to group by field of %s type, use field of type %s", fieldType.String(), enumspb.INDEXED_VALUE_TYPE_KEYWORD.String())
 		}
 	}
 
-	if fieldName == searchattribute.TemporalNamespaceDivision && usage == query.FieldNameFilter {
+	if fieldName == searchattribute.TemporalNamespaceDivision && usage != query.FieldNameSorter && usage != query.FieldNameGroupBy {
 		ni.seenNamespaceDivision = true
 	}
 
@@ -56,6 +56,10 @@ const (
 
 	delimiter                    = "~"
 	pointInTimeKeepAliveInterval = "1m"
+	// countGroupByAggName is the name of the terms aggregation which counts executions by the GROUP BY field.
+	countGroupByAggName = "group_by"
+	// countGroupByMaxGroups is the maximum number of groups returned by a count query with a GROUP BY clause.
+	countGroupByMaxGroups = 1000
 )
 
 type (
@@ -74,6 +78,13 @@ type (
 	visibilityPageToken struct {
 		SearchAfter   []interface{}
 		PointInTimeID string
+
+		// SnapshotTime is set for pages listed in the default order, and is the time the first page
+		// was read. Executions open at SnapshotTime are listed first by StartTime and RunId
+		// (OpenPhase), and then executions closed at or before SnapshotTime in the default order.
+		// This way executions closing in between pages are neither skipped nor returned twice.
+		SnapshotTime *time.Time `json:",omitempty"`
+		OpenPhase    bool       `json:",omitempty"`
 	}
 
 	fieldSort struct {
@@ -96,26 +107,42 @@ var (
 		{searchattribute.StartTime, true, true},
 	}
 
-	defaultSorter = func() []elastic.Sorter {
-		ret := make([]elastic.Sorter, 0, len(defaultSorterFields))
-		for _, item := range defaultSorterFields {
-			fs := elastic.NewFieldSort(item.name)
-			if item.desc {
-				fs.Desc()
-			}
-			if item.missing_first {
-				fs.Missing("_first")
-			}
-			ret = append(ret, fs)
-		}
-		return ret
-	}()
+	defaultSorter = buildSorter(defaultSorterFields)
+
+	// Sorters used by snapshot pagination. Both end with RunId as explicit tiebreaker.
+	snapshotSorterFields = []fieldSort{
+		{searchattribute.CloseTime, true, true},
+		{searchattribute.StartTime, true, true},
+		{searchattribute.RunID, true, false},
+	}
+	snapshotSorter = buildSorter(snapshotSorterFields)
+
+	openSnapshotSorterFields = []fieldSort{
+		{searchattribute.StartTime, true, true},
+		{searchattribute.RunID, true, false},
+	}
+	openSnapshotSorter = buildSorter(openSnapshotSorterFields)
 
 	docSorter = []elastic.Sorter{
 		elastic.SortByDoc{},
 	}
 )
 
+func buildSorter(fields []fieldSort) []elastic.Sorter {
+	ret := make([]elastic.Sorter, 0, len(fields))
+	for _, item := range fields {
+		fs := elastic.NewFieldSort(item.name)
+		if item.desc {
+			fs.Desc()
+		}
+		if item.missing_first {
+			fs.Missing("_first")
+		}
+		ret = append(ret, fs)
+	}
+	return ret
+}
+
 // NewVisibilityStore create a visibility store connecting to ElasticSearch
 func NewVisibilityStore(
 	esClient client.Client,
@@ -467,12 +494,78 @@ func (s *visibilityStore) ListWorkflowExecutions(
 		return nil, err
 	}
 
+	if request.StrongConsistency && len(request.NextPageToken) == 0 {
+		// Documents acknowledged by the bulk processor are only searchable after the next refresh.
+		if err := s.esClient.RefreshIndex(ctx, s.index); err != nil {
+			return nil, convertElasticsearchClientError("ListWorkflowExecutions failed to refresh index", err)
+		}
+	}
+	pageToken, err := s.deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// First page in the default order starts a snapshot pagination.
+	var snapshotTime *time.Time
+	if pageToken != nil && pageToken.SnapshotTime != nil {
+		snapshotTime = pageToken.SnapshotTime
+	} else if pageToken == nil && isDefaultSorter(p.Sorter) {
+		now := time.Now().UTC()
+		snapshotTime = &now
+		p.Sorter = snapshotSorter
+	}
+
 	searchResult, err := s.esClient.Search(ctx, p)
 	if err != nil {
 		return nil, convertElasticsearchClientError("ListWorkflowExecutions failed", err)
 	}
 
-	return s.getListWorkflowExecutionsResponse(searchResult, request.Namespace, request.PageSize)
+	response, err := s.getListWorkflowExecutionsResponse(searchResult, request.Namespace, request.PageSize)
+	if err != nil || snapshotTime == nil {
+		return response, err
+	}
+	response.NextPageToken, err = s.serializePageToken(
+		getNextSnapshotPageToken(pageToken, searchResult, response, request.PageSize, *snapshotTime),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// getNextSnapshotPageToken returns the token for the page following response when
+// paginating over a snapshot, or nil if there are no more pages.
+func getNextSnapshotPageToken(
+	pageToken *visibilityPageToken,
+	searchResult *elastic.SearchResult,
+	response *store.InternalListWorkflowExecutionsResponse,
+	pageSize int,
+	snapshotTime time.Time,
+) *visibilityPageToken {
+	openPhase := pageToken != nil && pageToken.OpenPhase
+	if len(response.Executions) < pageSize {
+		if !openPhase {
+			return nil
+		}
+		// Open phase is done, start listing executions closed at or before the snapshot.
+		return &visibilityPageToken{SnapshotTime: &snapshotTime}
+	}
+
+	lastHitSort := searchResult.Hits.Hits[len(searchResult.Hits.Hits)-1].Sort
+	if pageToken == nil && response.Executions[len(response.Executions)-1].CloseTime.IsZero() {
+		// First page is read with snapshotSorter which lists open executions first by StartTime and
+		// RunId. Continue with the open phase from the last StartTime and RunId.
+		return &visibilityPageToken{
+			SearchAfter:  lastHitSort[1:],
+			SnapshotTime: &snapshotTime,
+			OpenPhase:    true,
+		}
+	}
+	return &visibilityPageToken{
+		SearchAfter:  lastHitSort,
+		SnapshotTime: &snapshotTime,
+		OpenPhase:    openPhase,
+	}
 }
 
 func (s *visibilityStore) ScanWorkflowExecutions(
@@ -518,6 +611,10 @@ func (s *visibilityStore) CountWorkflowExecutions(
 		return nil, err
 	}
 
+	if len(queryParams.GroupBy) > 0 {
+		return s.countGroupByWorkflowExecutions(ctx, queryParams)
+	}
+
 	count, err := s.esClient.Count(ctx, s.index, queryParams.Query)
 	if err != nil {
 		return nil, convertElasticsearchClientError("CountWorkflowExecutions failed", err)
@@ -527,6 +624,33 @@ func (s *visibilityStore) CountWorkflowExecutions(
 	return response, nil
 }
 
+func (s *visibilityStore) countGroupByWorkflowExecutions(
+	ctx context.Context,
+	queryParams *query.QueryParams,
+) (*manager.CountWorkflowExecutionsResponse, error) {
+	agg := elastic.NewTermsAggregation().Field(queryParams.GroupBy[0]).Size(countGroupByMaxGroups)
+	searchResult, err := s.esClient.CountGroupBy(ctx, s.index, queryParams.Query, countGroupByAggName, agg)
+	if err != nil {
+		return nil, convertElasticsearchClientError("CountWorkflowExecutions failed", err)
+	}
+
+	terms, ok := searchResult.Aggregations.Terms(countGroupByAggName)
+	if !ok {
+		return nil, serviceerror.NewInternal("CountWorkflowExecutions failed: group by aggregation is missing in the response")
+	}
+	response := &manager.CountWorkflowExecutionsResponse{
+		Count:  searchResult.TotalHits(),
+		Groups: make([]manager.CountWorkflowExecutionsGroup, 0, len(terms.Buckets)),
+	}
+	for _, bucket := range terms.Buckets {
+		response.Groups = append(response.Groups, manager.CountWorkflowExecutionsGroup{
+			Value: fmt.Sprintf("%v", bucket.Key),
+			Count: bucket.DocCount,
+		})
+	}
+	return response, nil
+}
+
 func (s *visibilityStore) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -624,6 +748,10 @@ func (s *visibilityStore) buildSearchParametersV2(
 		return nil, err
 	}
 
+	if len(queryParams.GroupBy) > 0 {
+		return nil, serviceerror.NewInvalidArgument("GROUP BY clause is only supported by count queries")
+	}
+
 	searchParams := &client.SearchParameters{
 		Index:    s.index,
 		PageSize: request.PageSize,
@@ -667,6 +795,9 @@ func (s *visibilityStore) processPageToken(
 	pageToken *visibilityPageToken,
 	namespaceName namespace.Name,
 ) error {
+	if pageToken != nil && pageToken.SnapshotTime != nil {
+		return s.processSnapshotPageToken(params, pageToken)
+	}
 	if pageToken == nil || len(pageToken.SearchAfter) == 0 {
 		return nil
 	}
@@ -716,6 +847,52 @@ func (s *visibilityStore) processPageToken(
 	return nil
 }
 
+func (s *visibilityStore) processSnapshotPageToken(
+	params *client.SearchParameters,
+	pageToken *visibilityPageToken,
+) error {
+	if !isDefaultSorter(params.Sorter) {
+		return serviceerror.NewInvalidArgument("Invalid page token for given sort fields")
+	}
+	boolQuery, ok := params.Query.(*elastic.BoolQuery)
+	if !ok {
+		return serviceerror.NewInternal(fmt.Sprintf(
+			"Unexpected query type: expected *elastic.BoolQuery, got %T",
+			params.Query,
+		))
+	}
+
+	snapshotTime := pageToken.SnapshotTime.Format(time.RFC3339Nano)
+	if pageToken.OpenPhase {
+		params.Sorter = openSnapshotSorter
+		boolQuery.Filter(
+			elastic.NewRangeQuery(searchattribute.StartTime).Lte(snapshotTime),
+			elastic.NewBoolQuery().
+				Should(
+					elastic.NewBoolQuery().MustNot(elastic.NewExistsQuery(searchattribute.CloseTime)),
+					elastic.NewRangeQuery(searchattribute.CloseTime).Gt(snapshotTime),
+				).
+				MinimumNumberShouldMatch(1),
+		)
+	} else {
+		params.Sorter = snapshotSorter
+		boolQuery.Filter(elastic.NewRangeQuery(searchattribute.CloseTime).Lte(snapshotTime))
+	}
+
+	if len(pageToken.SearchAfter) == 0 {
+		return nil
+	}
+	if len(pageToken.SearchAfter) != len(params.Sorter) {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"Invalid page token for given sort fields: expected %d fields, got %d",
+			len(params.Sorter),
+			len(pageToken.SearchAfter),
+		))
+	}
+	params.SearchAfter = pageToken.SearchAfter
+	return nil
+}
+
 func (s *visibilityStore) convertQuery(
 	namespace namespace.Name,
 	namespaceID namespace.ID,
@@ -46,6 +46,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
@@ -695,6 +696,24 @@ func (s *ESVisibilitySuite) Test_convertQuery() {
 	s.Equal(`{"bool":{"filter":{"term":{"NamespaceId":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},"must_not":{"exists":{"field":"TemporalNamespaceDivision"}}}}`, s.queryToJSON(queryParams.Query))
 	s.Equal(`[{"CustomIntField":{"order":"asc"}}]`, s.sorterToJSON(queryParams.Sorter))
 
+	query = `CustomKeywordField starts_with 'order-' order by CustomKeywordField, StartTime desc`
+	queryParams, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	s.NoError(err)
+	s.Equal(`{"bool":{"filter":[{"term":{"NamespaceId":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},{"bool":{"filter":{"prefix":{"CustomKeywordField":"order-"}}}}],"must_not":{"exists":{"field":"TemporalNamespaceDivision"}}}}`, s.queryToJSON(queryParams.Query))
+	s.Equal(`[{"CustomKeywordField":{"order":"asc"}},{"StartTime":{"order":"desc"}}]`, s.sorterToJSON(queryParams.Sorter))
+
+	query = `CustomIntField starts_with '1'`
+	_, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	s.Error(err)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	s.Contains(err.Error(), "cannot do prefix match on search attribute 'CustomIntField' of type Int, use search attribute of type Keyword")
+
+	query = `CustomTextField between 'a' and 'b'`
+	_, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	s.Error(err)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	s.Contains(err.Error(), "cannot do range condition on search attribute 'CustomTextField' of type Text")
+
 	query = `ExecutionTime < "unable to parse"`
 	queryParams, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
 	// Wrong dates goes directly to Elasticsearch, and it returns an error.
@@ -1081,6 +1100,35 @@ func (s *ESVisibilitySuite) TestListWorkflowExecutions() {
 	s.True(strings.HasPrefix(err.Error(), "invalid query"))
 }
 
+func (s *ESVisibilitySuite) TestListWorkflowExecutions_StrongConsistency() {
+	request := &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:       testNamespaceID,
+		Namespace:         testNamespace,
+		PageSize:          10,
+		Query:             `WorkflowId = "wid"`,
+		StrongConsistency: true,
+	}
+
+	gomock.InOrder(
+		s.mockESClient.EXPECT().RefreshIndex(gomock.Any(), testIndex).Return(nil),
+		s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(testSearchResult, nil),
+	)
+	_, err := s.visibilityStore.ListWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+
+	s.mockESClient.EXPECT().RefreshIndex(gomock.Any(), testIndex).Return(errTestESSearch)
+	_, err = s.visibilityStore.ListWorkflowExecutions(context.Background(), request)
+	s.Error(err)
+	s.Contains(err.Error(), "failed to refresh index")
+
+	// Next pages are read from the same snapshot, no refresh is needed.
+	request.NextPageToken, err = s.visibilityStore.serializePageToken(&visibilityPageToken{SearchAfter: []interface{}{1528358645123456789, 1528358645123456789}})
+	s.NoError(err)
+	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(testSearchResult, nil)
+	_, err = s.visibilityStore.ListWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+}
+
 func (s *ESVisibilitySuite) TestListWorkflowExecutions_Error() {
 	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).DoAndReturn(
 		func(ctx context.Context, p *client.SearchParameters) (*elastic.SearchResult, error) {
@@ -1309,6 +1357,57 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions() {
 	s.True(strings.HasPrefix(err.Error(), "invalid query"), err.Error())
 }
 
+func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy() {
+	s.mockESClient.EXPECT().CountGroupBy(gomock.Any(), testIndex, gomock.Any(), countGroupByAggName, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, index string, query elastic.Query, aggName string, agg elastic.Aggregation) (*elastic.SearchResult, error) {
+			s.Equal(
+				elastic.NewBoolQuery().Filter(
+					elastic.NewTermQuery(searchattribute.NamespaceID, testNamespaceID.String()),
+					elastic.NewBoolQuery().Filter(elastic.NewMatchQuery("WorkflowType", "test-wf-type")),
+				).MustNot(namespaceDivisionExists),
+				query,
+			)
+			s.Equal(elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).Size(countGroupByMaxGroups), agg)
+			return &elastic.SearchResult{
+				Hits: &elastic.SearchHits{TotalHits: &elastic.TotalHits{Value: 5}},
+				Aggregations: elastic.Aggregations{
+					countGroupByAggName: json.RawMessage(`{"buckets":[{"key":"Running","doc_count":3},{"key":"Completed","doc_count":2}]}`),
+				},
+			}, nil
+		})
+
+	request := &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       `WorkflowType = "test-wf-type" GROUP BY ExecutionStatus`,
+	}
+	resp, err := s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.Equal(int64(5), resp.Count)
+	s.Equal([]manager.CountWorkflowExecutionsGroup{
+		{Value: "Running", Count: 3},
+		{Value: "Completed", Count: 2},
+	}, resp.Groups)
+
+	// test group by field which isn't a keyword
+	request.Query = `GROUP BY StartTime`
+	_, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
+	s.Error(err)
+	_, ok := err.(*serviceerror.InvalidArgument)
+	s.True(ok)
+
+	// test group by in list query
+	_, err = s.visibilityStore.ListWorkflowExecutions(context.Background(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		PageSize:    10,
+		Query:       `GROUP BY ExecutionStatus`,
+	})
+	s.Error(err)
+	_, ok = err.(*serviceerror.InvalidArgument)
+	s.True(ok)
+}
+
 func (s *ESVisibilitySuite) TestGetWorkflowExecution() {
 	now := timestamp.TimePtr(time.Now())
 	s.mockESClient.EXPECT().Get(gomock.Any(), testIndex, gomock.Any()).DoAndReturn(
@@ -1813,3 +1912,91 @@ func (s *ESVisibilitySuite) Test_parsePageTokenValue() {
 		})
 	}
 }
+
+func (s *ESVisibilitySuite) TestSnapshotPagination() {
+	snapshotTime := time.Now().UTC()
+	baseQuery := func() *elastic.BoolQuery {
+		return elastic.NewBoolQuery().Filter(elastic.NewTermQuery(searchattribute.NamespaceID, testNamespace.String()))
+	}
+	openExecution := &store.InternalWorkflowExecutionInfo{}
+	closedExecution := &store.InternalWorkflowExecutionInfo{CloseTime: snapshotTime.Add(-time.Minute)}
+	searchResult := &elastic.SearchResult{
+		Hits: &elastic.SearchHits{
+			Hits: []*elastic.SearchHit{{Sort: []any{json.Number("1"), json.Number("2"), "run-id"}}},
+		},
+	}
+
+	// first page ending with an open execution continues with the open phase
+	token := getNextSnapshotPageToken(
+		nil,
+		searchResult,
+		&store.InternalListWorkflowExecutionsResponse{Executions: []*store.InternalWorkflowExecutionInfo{openExecution}},
+		1,
+		snapshotTime,
+	)
+	s.Equal(&visibilityPageToken{
+		SearchAfter:  []any{json.Number("2"), "run-id"},
+		SnapshotTime: &snapshotTime,
+		OpenPhase:    true,
+	}, token)
+
+	params := &client.SearchParameters{Query: baseQuery(), Sorter: defaultSorter}
+	s.NoError(s.visibilityStore.processPageToken(params, token, testNamespace))
+	s.Equal(openSnapshotSorter, params.Sorter)
+	s.Equal(token.SearchAfter, params.SearchAfter)
+	s.Equal(
+		baseQuery().Filter(
+			elastic.NewRangeQuery(searchattribute.StartTime).Lte(snapshotTime.Format(time.RFC3339Nano)),
+			elastic.NewBoolQuery().
+				Should(
+					elastic.NewBoolQuery().MustNot(elastic.NewExistsQuery(searchattribute.CloseTime)),
+					elastic.NewRangeQuery(searchattribute.CloseTime).Gt(snapshotTime.Format(time.RFC3339Nano)),
+				).
+				MinimumNumberShouldMatch(1),
+		),
+		params.Query,
+	)
+
+	// last page of the open phase switches to the closed phase
+	token = getNextSnapshotPageToken(
+		token,
+		&elastic.SearchResult{},
+		&store.InternalListWorkflowExecutionsResponse{},
+		1,
+		snapshotTime,
+	)
+	s.Equal(&visibilityPageToken{SnapshotTime: &snapshotTime}, token)
+
+	params = &client.SearchParameters{Query: baseQuery(), Sorter: defaultSorter}
+	s.NoError(s.visibilityStore.processPageToken(params, token, testNamespace))
+	s.Equal(snapshotSorter, params.Sorter)
+	s.Nil(params.SearchAfter)
+	s.Equal(
+		baseQuery().Filter(elastic.NewRangeQuery(searchattribute.CloseTime).Lte(snapshotTime.Format(time.RFC3339Nano))),
+		params.Query,
+	)
+
+	// closed phase
+	token = getNextSnapshotPageToken(
+		token,
+		searchResult,
+		&store.InternalListWorkflowExecutionsResponse{Executions: []*store.InternalWorkflowExecutionInfo{closedExecution}},
+		1,
+		snapshotTime,
+	)
+	s.Equal(&visibilityPageToken{
+		SearchAfter:  []any{json.Number("1"), json.Number("2"), "run-id"},
+		SnapshotTime: &snapshotTime,
+	}, token)
+	s.Nil(getNextSnapshotPageToken(
+		token,
+		searchResult,
+		&store.InternalListWorkflowExecutionsResponse{Executions: []*store.InternalWorkflowExecutionInfo{closedExecution}},
+		2,
+		snapshotTime,
+	))
+
+	// snapshot token can't be used with custom order
+	params = &client.SearchParameters{Query: baseQuery(), Sorter: docSorter}
+	s.Error(s.visibilityStore.processPageToken(params, token, testNamespace))
+}
@@ -35,6 +35,8 @@ import (
 
 	"github.com/olivere/elastic/v7"
 	"github.com/xwb1989/sqlparser"
+
+	"go.temporal.io/server/common/searchattribute"
 )
 
 type (
@@ -82,8 +84,9 @@ type (
 	notSupportedExprConverter struct{}
 
 	QueryParams struct {
-		Query  elastic.Query
-		Sorter []elastic.Sorter
+		Query   elastic.Query
+		Sorter  []elastic.Sorter
+		GroupBy []string
 	}
 )
 
@@ -191,11 +194,11 @@ func NewNotSupportedExprConverter() ExprConverter {
 }
 
 // ConvertWhereOrderBy transforms WHERE SQL statement to Elasticsearch query.
-// It also supports ORDER BY clause.
+// It also supports ORDER BY and GROUP BY clauses.
 func (c *Converter) ConvertWhereOrderBy(whereOrderBy string) (*QueryParams, error) {
 	whereOrderBy = strings.TrimSpace(whereOrderBy)
 
-	if whereOrderBy != "" && !strings.HasPrefix(strings.ToLower(whereOrderBy), "order by ") {
+	if whereOrderBy != "" && !HasClausePrefix(whereOrderBy) {
 		whereOrderBy = "where " + whereOrderBy
 	}
 	// sqlparser can't parse just WHERE clause but instead accepts only valid SQL statement.
@@ -205,9 +208,9 @@ func (c *Converter) ConvertWhereOrderBy(whereOrderBy string) (*QueryParams, erro
 
 // ConvertSql transforms SQL to Elasticsearch query.
 func (c *Converter) ConvertSql(sql string) (*QueryParams, error) {
-	stmt, err := sqlparser.Parse(sql)
+	stmt, err := Parse(sql)
 	if err != nil {
-		return nil, NewConverterError("%s: %v", MalformedSqlQueryErrMessage, err)
+		return nil, err
 	}
 
 	selectStmt, isSelect := stmt.(*sqlparser.Select)
@@ -219,8 +222,8 @@ func (c *Converter) ConvertSql(sql string) (*QueryParams, error) {
 }
 
 func (c *Converter) convertSelect(sel *sqlparser.Select) (*QueryParams, error) {
-	if sel.GroupBy != nil {
-		return nil, NewConverterError("%s: 'group by' clause", NotSupportedErrMessage)
+	if len(sel.GroupBy) > 1 {
+		return nil, NewConverterError("%s: 'group by' clause with more than one field", NotSupportedErrMessage)
 	}
 
 	if sel.Limit != nil {
@@ -252,9 +255,67 @@ func (c *Converter) convertSelect(sel *sqlparser.Select) (*QueryParams, error) {
 		queryParams.Sorter = append(queryParams.Sorter, fieldSort)
 	}
 
+	for _, groupByExpr := range sel.GroupBy {
+		colName, err := convertColName(c.fnInterceptor, groupByExpr, FieldNameGroupBy)
+		if err != nil {
+			return nil, wrapConverterError("unable to convert 'group by' column name", err)
+		}
+		queryParams.GroupBy = append(queryParams.GroupBy, colName)
+	}
+
 	return queryParams, nil
 }
 
+// HasClausePrefix returns true if the query string starts with an ORDER BY or GROUP BY clause,
+// i.e. it doesn't have a WHERE clause.
+func HasClausePrefix(queryString string) bool {
+	queryString = strings.ToLower(queryString)
+	return strings.HasPrefix(queryString, "order by ") || strings.HasPrefix(queryString, "group by ")
+}
+
+// WorkflowIDFilter returns the workflow ID if the query only matches executions of a single
+// workflow, ie. its where clause requires WorkflowId to be equal to a value.
+func WorkflowIDFilter(queryString string) (string, bool) {
+	queryString = strings.TrimSpace(queryString)
+	if queryString == "" || HasClausePrefix(queryString) {
+		return "", false
+	}
+	stmt, err := Parse("select * from table1 where " + queryString)
+	if err != nil {
+		return "", false
+	}
+	sel, isSelect := stmt.(*sqlparser.Select)
+	if !isSelect || sel.Where == nil {
+		return "", false
+	}
+	return workflowIDFilter(sel.Where.Expr)
+}
+
+func workflowIDFilter(expr sqlparser.Expr) (string, bool) {
+	switch e := expr.(type) {
+	case *sqlparser.ParenExpr:
+		return workflowIDFilter(e.Expr)
+	case *sqlparser.AndExpr:
+		if workflowID, ok := workflowIDFilter(e.Left); ok {
+			return workflowID, true
+		}
+		return workflowIDFilter(e.Right)
+	case *sqlparser.ComparisonExpr:
+		colName, isColName := e.Left.(*sqlparser.ColName)
+		if !isColName || e.Operator != sqlparser.EqualStr ||
+			strings.ReplaceAll(sqlparser.String(colName), "`", "") != searchattribute.WorkflowID {
+			return "", false
+		}
+		value, isValue := e.Right.(*sqlparser.SQLVal)
+		if !isValue || value.Type != sqlparser.StrVal {
+			return "", false
+		}
+		return string(value.Val), true
+	default:
+		return "", false
+	}
+}
+
 func (w *WhereConverter) Convert(expr sqlparser.Expr) (elastic.Query, error) {
 	if expr == nil {
 		return nil, errors.New("cannot be nil")
@@ -356,7 +417,7 @@ func (r *rangeCondConverter) Convert(expr sqlparser.Expr) (elastic.Query, error)
 		return nil, NewConverterError("%v is not a range condition", sqlparser.String(expr))
 	}
 
-	colName, err := convertColName(r.fnInterceptor, rangeCond.Left, FieldNameFilter)
+	colName, err := convertColName(r.fnInterceptor, rangeCond.Left, FieldNameRangeFilter)
 	if err != nil {
 		return nil, wrapConverterError("unable to convert left part of 'between' expression", err)
 	}
@@ -422,7 +483,11 @@ func (c *comparisonExprConverter) Convert(expr sqlparser.Expr) (elastic.Query, e
 		return nil, NewConverterError("%v is not a comparison expression", sqlparser.String(expr))
 	}
 
-	colName, err := convertColName(c.fnInterceptor, comparisonExpr.Left, FieldNameFilter)
+	usage := FieldNameFilter
+	if IsStartsWithOperator(comparisonExpr.Operator) {
+		usage = FieldNamePrefixFilter
+	}
+	colName, err := convertColName(c.fnInterceptor, comparisonExpr.Left, usage)
 	if err != nil {
 		return nil, wrapConverterError(
 			fmt.Sprintf("unable to convert left side of %q", sqlparser.String(expr)),
@@ -445,6 +510,14 @@ func (c *comparisonExprConverter) Convert(expr sqlparser.Expr) (elastic.Query, e
 		}
 	}
 
+	var prefix string
+	if IsStartsWithOperator(comparisonExpr.Operator) {
+		prefix, err = StartsWithValue(colValue)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	colValues, isArray := colValue.([]interface{})
 	// colValue should be an array only for "in (1,2,3)" queries.
 	if !isArray {
@@ -480,6 +553,10 @@ func (c *comparisonExprConverter) Convert(expr sqlparser.Expr) (elastic.Query, e
 		query = elastic.NewTermsQuery(colName, colValues...)
 	case "not in":
 		query = elastic.NewBoolQuery().MustNot(elastic.NewTermsQuery(colName, colValues...))
+	case StartsWithOp:
+		query = elastic.NewPrefixQuery(colName, prefix)
+	case NotStartsWithOp:
+		query = elastic.NewBoolQuery().MustNot(elastic.NewPrefixQuery(colName, prefix))
 	}
 
 	return query, nil
@@ -0,0 +1,55 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkflowIDFilter(t *testing.T) {
+	testCases := []struct {
+		query      string
+		workflowID string
+		ok         bool
+	}{
+		{query: "WorkflowId = 'wid'", workflowID: "wid", ok: true},
+		{query: "`WorkflowId` = \"wid\" order by StartTime", workflowID: "wid", ok: true},
+		{query: "ExecutionStatus = 'Running' AND (WorkflowType = 'wt' AND WorkflowId = 'wid')", workflowID: "wid", ok: true},
+		{query: ""},
+		{query: "order by StartTime"},
+		{query: "WorkflowId != 'wid'"},
+		{query: "WorkflowId = 'wid' OR WorkflowId = 'wid2'"},
+		{query: "WorkflowId STARTS_WITH 'wid'"},
+		{query: "WorkflowId = 1"},
+		{query: "invalid query"},
+	}
+	for _, tc := range testCases {
+		workflowID, ok := WorkflowIDFilter(tc.query)
+		assert.Equal(t, tc.ok, ok, tc.query)
+		assert.Equal(t, tc.workflowID, workflowID, tc.query)
+	}
+}
@@ -42,6 +42,11 @@ type (
 const (
 	FieldNameFilter FieldNameUsage = iota
 	FieldNameSorter
+	FieldNameGroupBy
+	// FieldNameRangeFilter and FieldNamePrefixFilter are filters used in BETWEEN and STARTS_WITH
+	// expressions respectively, which don't support all search attribute types.
+	FieldNameRangeFilter
+	FieldNamePrefixFilter
 )
 
 func (n *NopFieldNameInterceptor) Name(name string, _ FieldNameUsage) (string, error) {
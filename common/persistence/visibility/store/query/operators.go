@@ -0,0 +1,190 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package query
+
+import (
+	"strings"
+
+	"github.com/xwb1989/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	// StartsWithOp and NotStartsWithOp are the comparison operators used for prefix matching,
+	// ie. "WorkflowType STARTS_WITH 'order-'".
+	StartsWithOp    = "starts_with"
+	NotStartsWithOp = "not starts_with"
+)
+
+// sqlparser doesn't know about STARTS_WITH: Parse rewrites it as REGEXP before parsing, and back after.
+const startsWithPlaceholderOp = "regexp"
+
+var (
+	rangeCondSupportedTypes = []enumspb.IndexedValueType{
+		enumspb.INDEXED_VALUE_TYPE_DATETIME,
+		enumspb.INDEXED_VALUE_TYPE_DOUBLE,
+		enumspb.INDEXED_VALUE_TYPE_INT,
+		enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	}
+)
+
+// Parse parses the SQL statement with support for the STARTS_WITH operator.
+// All returned errors are ConverterError.
+func Parse(sql string) (sqlparser.Statement, error) {
+	sql, err := rewriteStartsWith(sql)
+	if err != nil {
+		return nil, err
+	}
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, NewConverterError("%s: %v", MalformedSqlQueryErrMessage, err)
+	}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if expr, ok := node.(*sqlparser.ComparisonExpr); ok {
+			switch expr.Operator {
+			case sqlparser.RegexpStr:
+				expr.Operator = StartsWithOp
+			case sqlparser.NotRegexpStr:
+				expr.Operator = NotStartsWithOp
+			}
+		}
+		return true, nil
+	}, stmt)
+	return stmt, nil
+}
+
+// rewriteStartsWith replaces the STARTS_WITH keyword outside of quotes with REGEXP, which isn't
+// supported by any visibility store, and therefore is rejected if used explicitly.
+func rewriteStartsWith(sql string) (string, error) {
+	var sb strings.Builder
+	var quote byte
+	for i := 0; i < len(sql); {
+		ch := sql[i]
+		if quote != 0 {
+			sb.WriteByte(ch)
+			i++
+			switch {
+			case ch == '\\' && quote != '`' && i < len(sql):
+				sb.WriteByte(sql[i])
+				i++
+			case ch == quote:
+				quote = 0
+			}
+			continue
+		}
+		if ch == '\'' || ch == '"' || ch == '`' {
+			quote = ch
+			sb.WriteByte(ch)
+			i++
+			continue
+		}
+		if !isWordChar(ch) {
+			sb.WriteByte(ch)
+			i++
+			continue
+		}
+		j := i
+		for j < len(sql) && isWordChar(sql[j]) {
+			j++
+		}
+		word := sql[i:j]
+		switch strings.ToLower(word) {
+		case StartsWithOp:
+			word = startsWithPlaceholderOp
+		case "regexp", "rlike":
+			return "", NewConverterError("%s: '%s' operator", NotSupportedErrMessage, strings.ToLower(word))
+		}
+		sb.WriteString(word)
+		i = j
+	}
+	return sb.String(), nil
+}
+
+func isWordChar(ch byte) bool {
+	return ch == '_' || ch == '.' || ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z' || ch >= '0' && ch <= '9'
+}
+
+// IsStartsWithOperator returns true if operator is STARTS_WITH or NOT STARTS_WITH.
+func IsStartsWithOperator(operator string) bool {
+	return operator == StartsWithOp || operator == NotStartsWithOp
+}
+
+// ValidateRangeCondType returns an error if the search attribute can't be used in a BETWEEN expression.
+func ValidateRangeCondType(saName string, saType enumspb.IndexedValueType) error {
+	for _, tp := range rangeCondSupportedTypes {
+		if saType == tp {
+			return nil
+		}
+	}
+	return NewConverterError(
+		"%s: cannot do range condition on search attribute '%s' of type %s",
+		InvalidExpressionErrMessage,
+		saName,
+		saType.String(),
+	)
+}
+
+// ValidateStartsWithType returns an error if the search attribute can't be used with STARTS_WITH.
+// Only Keyword search attributes support prefix matching. ExecutionStatus is excluded because
+// it is stored as a number by SQL visibility.
+func ValidateStartsWithType(saName string, saFieldName string, saType enumspb.IndexedValueType) error {
+	if saType != enumspb.INDEXED_VALUE_TYPE_KEYWORD || saFieldName == searchattribute.ExecutionStatus {
+		return NewConverterError(
+			"%s: cannot do prefix match on search attribute '%s' of type %s, use search attribute of type %s",
+			InvalidExpressionErrMessage,
+			saName,
+			saType.String(),
+			enumspb.INDEXED_VALUE_TYPE_KEYWORD.String(),
+		)
+	}
+	return nil
+}
+
+// ValidateSortType returns an error if the search attribute can't be used in an ORDER BY clause.
+func ValidateSortType(saType enumspb.IndexedValueType) error {
+	switch saType {
+	case enumspb.INDEXED_VALUE_TYPE_TEXT, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST:
+		return NewConverterError(
+			"unable to sort by field of %s type, use field of type %s",
+			saType.String(),
+			enumspb.INDEXED_VALUE_TYPE_KEYWORD.String(),
+		)
+	}
+	return nil
+}
+
+// StartsWithValue returns the prefix of a STARTS_WITH expression, which must be a string.
+func StartsWithValue(value interface{}) (string, error) {
+	prefix, isString := value.(string)
+	if !isString {
+		return "", NewConverterError(
+			"%s: 'starts_with' operator value must be a string",
+			InvalidExpressionErrMessage,
+		)
+	}
+	return prefix, nil
+}
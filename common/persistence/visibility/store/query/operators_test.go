@@ -0,0 +1,51 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xwb1989/sqlparser"
+)
+
+func TestParse_StartsWith(t *testing.T) {
+	stmt, err := Parse(`select * from t where a STARTS_WITH 'x' and b not starts_with "starts_with" and ` + "`starts_with`" + ` = 'it''s starts_with'`)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"select * from t where a starts_with 'x' and b not starts_with 'starts_with' and starts_with = 'it\\'s starts_with'",
+		sqlparser.String(stmt),
+	)
+
+	_, err = Parse("select * from t where a regexp 'x'")
+	assert.Equal(t, NewConverterError("%s: 'regexp' operator", NotSupportedErrMessage), err)
+	_, err = Parse("select * from t where a RLIKE 'x'")
+	assert.Equal(t, NewConverterError("%s: 'rlike' operator", NotSupportedErrMessage), err)
+
+	_, err = Parse("select * from t where a starts_with")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), MalformedSqlQueryErrMessage)
+}
@@ -34,9 +34,24 @@ type (
 		CloseTime time.Time
 		StartTime time.Time
 		RunID     string
+		// SnapshotTime is the time the first page was read. When set, pagination is done in two phases
+		// so that executions closing in between pages are neither skipped nor returned twice:
+		//   - executions open at SnapshotTime (ie. not closed or closed after it) ordered by
+		//     StartTime and RunID, while CloseTime is set to the max datetime value;
+		//   - executions closed at or before SnapshotTime in the default order.
+		// Executions started after SnapshotTime are not returned.
+		SnapshotTime *time.Time `json:",omitempty"`
+		// Offset is the number of executions already returned when the query has an order by
+		// clause, since such pages can't be read from the last returned execution.
+		Offset int `json:",omitempty"`
 	}
 )
 
+// inOpenPhase returns true if the next page is still listing the executions open at SnapshotTime.
+func (t *pageToken) inOpenPhase() bool {
+	return t != nil && t.SnapshotTime != nil && !t.CloseTime.Before(maxDatetimeValue)
+}
+
 func deserializePageToken(data []byte) (*pageToken, error) {
 	if len(data) == 0 {
 		return nil, nil
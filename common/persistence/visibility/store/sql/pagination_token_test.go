@@ -29,6 +29,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
 
 func TestSerializePageToken(t *testing.T) {
@@ -72,3 +74,87 @@ func TestDeserializePageToken(t *testing.T) {
 		*token,
 	)
 }
+
+func TestNextPageToken(t *testing.T) {
+	s := assert.New(t)
+
+	snapshotTime := time.Date(2023, 3, 21, 15, 0, 0, 0, time.UTC)
+	startTime := time.Date(2023, 3, 21, 14, 10, 32, 0, time.UTC)
+	closeTime := time.Date(2023, 3, 21, 14, 20, 32, 0, time.UTC)
+	openRow := sqlplugin.VisibilityRow{RunID: "open-run-id", StartTime: startTime}
+	closedRow := sqlplugin.VisibilityRow{RunID: "closed-run-id", StartTime: startTime, CloseTime: &closeTime}
+
+	// first page
+	s.Nil(nextPageToken(nil, []sqlplugin.VisibilityRow{openRow}, 2, snapshotTime))
+	s.Equal(
+		&pageToken{CloseTime: maxTime, StartTime: startTime, RunID: "open-run-id", SnapshotTime: &snapshotTime},
+		nextPageToken(nil, []sqlplugin.VisibilityRow{openRow}, 1, snapshotTime),
+	)
+	s.Equal(
+		&pageToken{CloseTime: closeTime, StartTime: startTime, RunID: "closed-run-id", SnapshotTime: &snapshotTime},
+		nextPageToken(nil, []sqlplugin.VisibilityRow{closedRow}, 1, snapshotTime),
+	)
+
+	// open phase lists executions closed after the snapshot as open
+	openToken := &pageToken{CloseTime: maxTime, SnapshotTime: &snapshotTime}
+	s.True(openToken.inOpenPhase())
+	s.Equal(
+		&pageToken{CloseTime: maxTime, StartTime: startTime, RunID: "closed-run-id", SnapshotTime: &snapshotTime},
+		nextPageToken(openToken, []sqlplugin.VisibilityRow{closedRow}, 1, snapshotTime),
+	)
+	s.Equal(
+		&pageToken{CloseTime: snapshotTime, StartTime: maxTime, SnapshotTime: &snapshotTime},
+		nextPageToken(openToken, nil, 1, snapshotTime),
+	)
+
+	// closed phase
+	closedToken := &pageToken{CloseTime: snapshotTime, StartTime: maxTime, SnapshotTime: &snapshotTime}
+	s.False(closedToken.inOpenPhase())
+	s.Nil(nextPageToken(closedToken, []sqlplugin.VisibilityRow{closedRow}, 2, snapshotTime))
+
+	// token without snapshot
+	legacyToken := &pageToken{CloseTime: maxTime}
+	s.False(legacyToken.inOpenPhase())
+	s.Equal(
+		&pageToken{CloseTime: maxTime, StartTime: startTime, RunID: "open-run-id"},
+		nextPageToken(legacyToken, []sqlplugin.VisibilityRow{openRow}, 1, snapshotTime),
+	)
+}
+
+func TestBuildPaginationClauses(t *testing.T) {
+	s := assert.New(t)
+
+	snapshotTime := time.Date(2023, 3, 21, 15, 0, 0, 0, time.UTC)
+	startTime := time.Date(2023, 3, 21, 14, 10, 32, 0, time.UTC)
+
+	where, args, orderBy := buildPaginationClauses("coalesce_close_time", nil)
+	s.Equal("", where)
+	s.Nil(args)
+	s.Equal("coalesce_close_time DESC, start_time DESC, run_id", orderBy)
+
+	where, args, orderBy = buildPaginationClauses(
+		"coalesce_close_time",
+		&pageToken{CloseTime: maxTime, StartTime: startTime, RunID: "run-id", SnapshotTime: &snapshotTime},
+	)
+	s.Equal("coalesce_close_time > ? AND start_time <= ? AND ((start_time = ? AND run_id > ?) OR start_time < ?)", where)
+	s.Equal([]any{snapshotTime, snapshotTime, startTime, "run-id", startTime}, args)
+	s.Equal("start_time DESC, run_id", orderBy)
+}
+
+func TestNextOffsetPageToken(t *testing.T) {
+	s := assert.New(t)
+
+	rows := make([]sqlplugin.VisibilityRow, 2)
+	s.Nil(nextOffsetPageToken(nil, rows, 3))
+	s.Equal(&pageToken{Offset: 2}, nextOffsetPageToken(nil, rows, 2))
+	s.Equal(&pageToken{Offset: 4}, nextOffsetPageToken(&pageToken{Offset: 2}, rows, 2))
+
+	orderBy, limit, args := buildCustomOrderByClauses([]string{"Keyword01 DESC", "start_time"}, 2, nil)
+	s.Equal("Keyword01 DESC, start_time, run_id", orderBy)
+	s.Equal("LIMIT ?", limit)
+	s.Equal([]any{2}, args)
+
+	_, limit, args = buildCustomOrderByClauses([]string{"Keyword01"}, 2, &pageToken{Offset: 4})
+	s.Equal("LIMIT ? OFFSET ?", limit)
+	s.Equal([]any{2, 4}, args)
+}
@@ -49,11 +49,12 @@ type (
 		buildSelectStmt(
 			namespaceID namespace.ID,
 			queryString string,
+			orderBy []string,
 			pageSize int,
 			token *pageToken,
 		) (string, []any)
 
-		buildCountStmt(namespaceID namespace.ID, queryString string) (string, []any)
+		buildCountStmt(namespaceID namespace.ID, queryString string, groupBy string) (string, []any)
 
 		getDatetimeFormat() string
 
@@ -69,6 +70,8 @@ type (
 		queryString   string
 
 		seenNamespaceDivision bool
+		groupBy               *saColName
+		orderBy               []string
 	}
 )
 
@@ -84,6 +87,15 @@ var (
 		"\\", "\\\\",
 	}
 
+	// likeEscapeChar escapes the LIKE wildcards of STARTS_WITH prefixes. It's not backslash
+	// because SQLite has no default escape character and backslash is already escaped.
+	likeEscapeChar     = "!"
+	likeEscapeReplacer = strings.NewReplacer(
+		likeEscapeChar, likeEscapeChar+likeEscapeChar,
+		"%", likeEscapeChar+"%",
+		"_", likeEscapeChar+"_",
+	)
+
 	supportedComparisonOperators = []string{
 		sqlparser.EqualStr,
 		sqlparser.NotEqualStr,
@@ -93,6 +105,8 @@ var (
 		sqlparser.GreaterEqualStr,
 		sqlparser.InStr,
 		sqlparser.NotInStr,
+		query.StartsWithOp,
+		query.NotStartsWithOp,
 	}
 
 	supportedKeyworkListOperators = []string{
@@ -106,13 +120,6 @@ var (
 		sqlparser.EqualStr,
 		sqlparser.NotEqualStr,
 	}
-
-	supportedTypesRangeCond = []enumspb.IndexedValueType{
-		enumspb.INDEXED_VALUE_TYPE_DATETIME,
-		enumspb.INDEXED_VALUE_TYPE_DOUBLE,
-		enumspb.INDEXED_VALUE_TYPE_INT,
-		enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-	}
 )
 
 func newQueryConverterInternal(
@@ -147,35 +154,50 @@ func (c *QueryConverter) BuildSelectStmt(
 	if err != nil {
 		return nil, err
 	}
+	if c.groupBy != nil {
+		return nil, query.NewConverterError("%s: 'group by' clause in list queries", query.NotSupportedErrMessage)
+	}
 	queryString, queryArgs := c.buildSelectStmt(
 		c.namespaceID,
 		queryString,
+		c.orderBy,
 		pageSize,
 		token,
 	)
 	return &sqlplugin.VisibilitySelectFilter{Query: queryString, QueryArgs: queryArgs}, nil
 }
 
+// HasOrderBy returns true if the query has an order by clause, in which case pages are read by
+// offset. It must be called after BuildSelectStmt.
+func (c *QueryConverter) HasOrderBy() bool {
+	return len(c.orderBy) > 0
+}
+
 func (c *QueryConverter) BuildCountStmt() (*sqlplugin.VisibilitySelectFilter, error) {
 	queryString, err := c.convertWhereString(c.queryString)
 	if err != nil {
 		return nil, err
 	}
+	groupBy := ""
+	if c.groupBy != nil {
+		groupBy = c.groupBy.dbColName.Name
+	}
 	queryString, queryArgs := c.buildCountStmt(
 		c.namespaceID,
 		queryString,
+		groupBy,
 	)
 	return &sqlplugin.VisibilitySelectFilter{Query: queryString, QueryArgs: queryArgs}, nil
 }
 
 func (c *QueryConverter) convertWhereString(queryString string) (string, error) {
 	where := strings.TrimSpace(queryString)
-	if where != "" && !strings.HasPrefix(strings.ToLower(where), "order by") {
+	if where != "" && !query.HasClausePrefix(where) {
 		where = "where " + where
 	}
 	// sqlparser can't parse just WHERE clause but instead accepts only valid SQL statement.
 	sql := "select * from table1 " + where
-	stmt, err := sqlparser.Parse(sql)
+	stmt, err := query.Parse(sql)
 	if err != nil {
 		return "", err
 	}
@@ -194,12 +216,22 @@ func (c *QueryConverter) convertWhereString(queryString string) (string, error)
 }
 
 func (c *QueryConverter) convertSelectStmt(sel *sqlparser.Select) error {
-	if sel.GroupBy != nil {
-		return query.NewConverterError("%s: 'group by' clause", query.NotSupportedErrMessage)
+	if len(sel.GroupBy) > 1 {
+		return query.NewConverterError("%s: 'group by' clause with more than one field", query.NotSupportedErrMessage)
 	}
 
-	if sel.OrderBy != nil {
-		return query.NewConverterError("%s: 'order by' clause", query.NotSupportedErrMessage)
+	for i := range sel.GroupBy {
+		err := c.convertGroupByExpr(&sel.GroupBy[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, orderByExpr := range sel.OrderBy {
+		err := c.convertOrderByExpr(orderByExpr)
+		if err != nil {
+			return err
+		}
 	}
 
 	if sel.Limit != nil {
@@ -254,6 +286,43 @@ func (c *QueryConverter) convertSelectStmt(sel *sqlparser.Select) error {
 	return nil
 }
 
+func (c *QueryConverter) convertGroupByExpr(exprRef *sqlparser.Expr) error {
+	saColNameExpr, err := c.convertColName(exprRef)
+	if err != nil {
+		return err
+	}
+	if saColNameExpr.valueType != enumspb.INDEXED_VALUE_TYPE_KEYWORD {
+		return query.NewConverterError(
+			"%s: unable to group by search attribute '%s' of type %s, use search attribute of type %s",
+			query.InvalidExpressionErrMessage,
+			saColNameExpr.alias,
+			saColNameExpr.valueType.String(),
+			enumspb.INDEXED_VALUE_TYPE_KEYWORD.String(),
+		)
+	}
+	c.groupBy = saColNameExpr
+	return nil
+}
+
+func (c *QueryConverter) convertOrderByExpr(orderByExpr *sqlparser.Order) error {
+	// Sorting by TemporalNamespaceDivision doesn't filter on it.
+	seenNamespaceDivision := c.seenNamespaceDivision
+	saColNameExpr, err := c.convertColName(&orderByExpr.Expr)
+	c.seenNamespaceDivision = seenNamespaceDivision
+	if err != nil {
+		return err
+	}
+	if err := query.ValidateSortType(saColNameExpr.valueType); err != nil {
+		return err
+	}
+	orderBy := sqlparser.String(orderByExpr.Expr)
+	if orderByExpr.Direction == sqlparser.DescScr {
+		orderBy += " DESC"
+	}
+	c.orderBy = append(c.orderBy, orderBy)
+	return nil
+}
+
 func (c *QueryConverter) convertWhereExpr(expr *sqlparser.Expr) error {
 	if expr == nil || *expr == nil {
 		return errors.New("cannot be nil")
@@ -330,6 +399,10 @@ func (c *QueryConverter) convertComparisonExpr(exprRef *sqlparser.Expr) error {
 		return err
 	}
 
+	if query.IsStartsWithOperator(expr.Operator) {
+		return c.convertStartsWithExpr(expr, saColNameExpr)
+	}
+
 	err = c.convertValueExpr(&expr.Right, saColNameExpr.alias, saColNameExpr.valueType)
 	if err != nil {
 		return err
@@ -351,6 +424,36 @@ func (c *QueryConverter) convertComparisonExpr(exprRef *sqlparser.Expr) error {
 	return nil
 }
 
+// convertStartsWithExpr converts "col STARTS_WITH 'prefix'" to "col LIKE 'prefix%' ESCAPE '!'".
+func (c *QueryConverter) convertStartsWithExpr(expr *sqlparser.ComparisonExpr, saColNameExpr *saColName) error {
+	err := query.ValidateStartsWithType(saColNameExpr.alias, saColNameExpr.fieldName, saColNameExpr.valueType)
+	if err != nil {
+		return err
+	}
+	err = c.convertValueExpr(&expr.Right, saColNameExpr.alias, saColNameExpr.valueType)
+	if err != nil {
+		return err
+	}
+	var value any = expr.Right
+	if v, ok := expr.Right.(*unsafeSQLString); ok {
+		value = v.Val
+	}
+	prefix, err := query.StartsWithValue(value)
+	if err != nil {
+		return err
+	}
+	// Value was already escaped for safety, now escape LIKE wildcards.
+	prefix = likeEscapeReplacer.Replace(prefix)
+	expr.Right = newUnsafeSQLString(prefix + "%")
+	expr.Escape = newUnsafeSQLString(likeEscapeChar)
+	if expr.Operator == query.StartsWithOp {
+		expr.Operator = sqlparser.LikeStr
+	} else {
+		expr.Operator = sqlparser.NotLikeStr
+	}
+	return nil
+}
+
 func (c *QueryConverter) convertRangeCond(exprRef *sqlparser.Expr) error {
 	expr, ok := (*exprRef).(*sqlparser.RangeCond)
 	if !ok {
@@ -363,13 +466,9 @@ func (c *QueryConverter) convertRangeCond(exprRef *sqlparser.Expr) error {
 	if err != nil {
 		return err
 	}
-	if !isSupportedTypeRangeCond(saColNameExpr.valueType) {
-		return query.NewConverterError(
-			"%s: cannot do range condition on search attribute '%s' of type %s",
-			query.InvalidExpressionErrMessage,
-			saColNameExpr.alias,
-			saColNameExpr.valueType.String(),
-		)
+	err = query.ValidateRangeCondType(saColNameExpr.alias, saColNameExpr.valueType)
+	if err != nil {
+		return err
 	}
 	err = c.convertValueExpr(&expr.From, saColNameExpr.alias, saColNameExpr.valueType)
 	if err != nil {
@@ -611,12 +710,3 @@ func isSupportedKeywordListOperator(operator string) bool {
 func isSupportedTextOperator(operator string) bool {
 	return isSupportedOperator(supportedTextOperators, operator)
 }
-
-func isSupportedTypeRangeCond(saType enumspb.IndexedValueType) bool {
-	for _, tp := range supportedTypesRangeCond {
-		if saType == tp {
-			return true
-		}
-	}
-	return false
-}
@@ -212,6 +212,7 @@ func (c *mysqlQueryConverter) convertTextComparisonExpr(
 func (c *mysqlQueryConverter) buildSelectStmt(
 	namespaceID namespace.ID,
 	queryString string,
+	orderBy []string,
 	pageSize int,
 	token *pageToken,
 ) (string, []any) {
@@ -228,31 +229,24 @@ func (c *mysqlQueryConverter) buildSelectStmt(
 		whereClauses = append(whereClauses, queryString)
 	}
 
-	if token != nil {
-		whereClauses = append(
-			whereClauses,
-			fmt.Sprintf(
-				"((%s = ? AND %s = ? AND %s > ?) OR (%s = ? AND %s < ?) OR %s < ?)",
-				sqlparser.String(c.getCoalesceCloseTimeExpr()),
-				searchattribute.GetSqlDbColName(searchattribute.StartTime),
-				searchattribute.GetSqlDbColName(searchattribute.RunID),
-				sqlparser.String(c.getCoalesceCloseTimeExpr()),
-				searchattribute.GetSqlDbColName(searchattribute.StartTime),
-				sqlparser.String(c.getCoalesceCloseTimeExpr()),
-			),
-		)
-		queryArgs = append(
-			queryArgs,
-			token.CloseTime,
-			token.StartTime,
-			token.RunID,
-			token.CloseTime,
-			token.StartTime,
-			token.CloseTime,
+	var orderByClause, limitClause string
+	var limitArgs []any
+	if len(orderBy) > 0 {
+		orderByClause, limitClause, limitArgs = buildCustomOrderByClauses(orderBy, pageSize, token)
+	} else {
+		var paginationClause string
+		var paginationArgs []any
+		paginationClause, paginationArgs, orderByClause = buildPaginationClauses(
+			sqlparser.String(c.getCoalesceCloseTimeExpr()),
+			token,
 		)
+		if len(paginationClause) > 0 {
+			whereClauses = append(whereClauses, paginationClause)
+			queryArgs = append(queryArgs, paginationArgs...)
+		}
+		limitClause, limitArgs = "LIMIT ?", []any{pageSize}
 	}
-
-	queryArgs = append(queryArgs, pageSize)
+	queryArgs = append(queryArgs, limitArgs...)
 
 	return fmt.Sprintf(
 		`SELECT %s
@@ -260,21 +254,21 @@ func (c *mysqlQueryConverter) buildSelectStmt(
 		LEFT JOIN custom_search_attributes
 		USING (%s, %s)
 		WHERE %s
-		ORDER BY %s DESC, %s DESC, %s
-		LIMIT ?`,
+		ORDER BY %s
+		%s`,
 		strings.Join(addPrefix("ev.", sqlplugin.DbFields), ", "),
 		searchattribute.GetSqlDbColName(searchattribute.NamespaceID),
 		searchattribute.GetSqlDbColName(searchattribute.RunID),
 		strings.Join(whereClauses, " AND "),
-		sqlparser.String(c.getCoalesceCloseTimeExpr()),
-		searchattribute.GetSqlDbColName(searchattribute.StartTime),
-		searchattribute.GetSqlDbColName(searchattribute.RunID),
+		orderByClause,
+		limitClause,
 	), queryArgs
 }
 
 func (c *mysqlQueryConverter) buildCountStmt(
 	namespaceID namespace.ID,
 	queryString string,
+	groupBy string,
 ) (string, []any) {
 	var whereClauses []string
 	var queryArgs []any
@@ -289,14 +283,17 @@ func (c *mysqlQueryConverter) buildCountStmt(
 		whereClauses = append(whereClauses, queryString)
 	}
 
+	selectExpr, groupByClause := buildCountClauses(groupBy)
 	return fmt.Sprintf(
-		`SELECT COUNT(1)
+		`SELECT %s
 		FROM executions_visibility ev
 		LEFT JOIN custom_search_attributes
 		USING (%s, %s)
-		WHERE %s`,
+		WHERE %s%s`,
+		selectExpr,
 		searchattribute.GetSqlDbColName(searchattribute.NamespaceID),
 		searchattribute.GetSqlDbColName(searchattribute.RunID),
 		strings.Join(whereClauses, " AND "),
+		groupByClause,
 	), queryArgs
 }
@@ -232,6 +232,7 @@ func (c *sqliteQueryConverter) convertTextComparisonExpr(
 func (c *sqliteQueryConverter) buildSelectStmt(
 	namespaceID namespace.ID,
 	queryString string,
+	orderBy []string,
 	pageSize int,
 	token *pageToken,
 ) (string, []any) {
@@ -248,43 +249,35 @@ func (c *sqliteQueryConverter) buildSelectStmt(
 		whereClauses = append(whereClauses, queryString)
 	}
 
-	if token != nil {
-		whereClauses = append(
-			whereClauses,
-			fmt.Sprintf(
-				"((%s = ? AND %s = ? AND %s > ?) OR (%s = ? AND %s < ?) OR %s < ?)",
-				sqlparser.String(c.getCoalesceCloseTimeExpr()),
-				searchattribute.GetSqlDbColName(searchattribute.StartTime),
-				searchattribute.GetSqlDbColName(searchattribute.RunID),
-				sqlparser.String(c.getCoalesceCloseTimeExpr()),
-				searchattribute.GetSqlDbColName(searchattribute.StartTime),
-				sqlparser.String(c.getCoalesceCloseTimeExpr()),
-			),
-		)
-		queryArgs = append(
-			queryArgs,
-			token.CloseTime,
-			token.StartTime,
-			token.RunID,
-			token.CloseTime,
-			token.StartTime,
-			token.CloseTime,
+	var orderByClause, limitClause string
+	var limitArgs []any
+	if len(orderBy) > 0 {
+		orderByClause, limitClause, limitArgs = buildCustomOrderByClauses(orderBy, pageSize, token)
+	} else {
+		var paginationClause string
+		var paginationArgs []any
+		paginationClause, paginationArgs, orderByClause = buildPaginationClauses(
+			sqlparser.String(c.getCoalesceCloseTimeExpr()),
+			token,
 		)
+		if len(paginationClause) > 0 {
+			whereClauses = append(whereClauses, paginationClause)
+			queryArgs = append(queryArgs, paginationArgs...)
+		}
+		limitClause, limitArgs = "LIMIT ?", []any{pageSize}
 	}
-
-	queryArgs = append(queryArgs, pageSize)
+	queryArgs = append(queryArgs, limitArgs...)
 
 	return fmt.Sprintf(
 		`SELECT %s
 		FROM executions_visibility
 		WHERE %s
-		ORDER BY %s DESC, %s DESC, %s
-		LIMIT ?`,
+		ORDER BY %s
+		%s`,
 		strings.Join(sqlplugin.DbFields, ", "),
 		strings.Join(whereClauses, " AND "),
-		sqlparser.String(c.getCoalesceCloseTimeExpr()),
-		searchattribute.GetSqlDbColName(searchattribute.StartTime),
-		searchattribute.GetSqlDbColName(searchattribute.RunID),
+		orderByClause,
+		limitClause,
 	), queryArgs
 }
 
@@ -322,6 +315,7 @@ func (c *sqliteQueryConverter) buildFtsSelectStmt(
 func (c *sqliteQueryConverter) buildCountStmt(
 	namespaceID namespace.ID,
 	queryString string,
+	groupBy string,
 ) (string, []any) {
 	var whereClauses []string
 	var queryArgs []any
@@ -336,8 +330,11 @@ func (c *sqliteQueryConverter) buildCountStmt(
 		whereClauses = append(whereClauses, queryString)
 	}
 
+	selectExpr, groupByClause := buildCountClauses(groupBy)
 	return fmt.Sprintf(
-		"SELECT COUNT(1) FROM executions_visibility WHERE %s",
+		"SELECT %s FROM executions_visibility WHERE %s%s",
+		selectExpr,
 		strings.Join(whereClauses, " AND "),
+		groupByClause,
 	), queryArgs
 }
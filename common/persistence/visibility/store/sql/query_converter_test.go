@@ -110,6 +110,30 @@ func (s *queryConverterSuite) TestConvertWhereString() {
 			output: "(Int01 = 1 or Keyword01 = 1) and TemporalNamespaceDivision is null",
 			err:    nil,
 		},
+		{
+			name:   "group by",
+			input:  "GROUP BY ExecutionStatus",
+			output: "TemporalNamespaceDivision is null",
+			err:    nil,
+		},
+		{
+			name:   "single condition group by",
+			input:  "AliasForInt01 = 1 GROUP BY AliasForKeyword01",
+			output: "(Int01 = 1) and TemporalNamespaceDivision is null",
+			err:    nil,
+		},
+		{
+			name:   "starts with",
+			input:  "AliasForKeyword01 STARTS_WITH 'foo' AND AliasForKeyword02 NOT starts_with 'starts_with'",
+			output: "(Keyword01 like 'foo%' escape '!' and Keyword02 not like 'starts!_with%' escape '!') and TemporalNamespaceDivision is null",
+			err:    nil,
+		},
+		{
+			name:   "regexp not supported",
+			input:  "AliasForKeyword01 REGEXP 'foo'",
+			output: "",
+			err:    query.NewConverterError("%s: 'regexp' operator", query.NotSupportedErrMessage),
+		},
 		{
 			name:   "has namespace division",
 			input:  "(AliasForInt01 = 1 OR AliasForKeyword01 = 1) AND TemporalNamespaceDivision = 'foo'",
@@ -117,10 +141,10 @@ func (s *queryConverterSuite) TestConvertWhereString() {
 			err:    nil,
 		},
 		{
-			name:   "order by not supported",
-			input:  "ORDER BY StartTime",
+			name:   "group by more than one field not supported",
+			input:  "GROUP BY ExecutionStatus, AliasForKeyword01",
 			output: "",
-			err:    query.NewConverterError("%s: 'order by' clause", query.NotSupportedErrMessage),
+			err:    query.NewConverterError("%s: 'group by' clause with more than one field", query.NotSupportedErrMessage),
 		},
 	}
 
@@ -138,6 +162,112 @@ func (s *queryConverterSuite) TestConvertWhereString() {
 	}
 }
 
+func (s *queryConverterSuite) TestConvertGroupBy() {
+	var tests = []struct {
+		name    string
+		input   string
+		groupBy string
+		err     error
+	}{
+		{
+			name:    "execution status",
+			input:   "GROUP BY ExecutionStatus",
+			groupBy: searchattribute.GetSqlDbColName(searchattribute.ExecutionStatus),
+		},
+		{
+			name:    "custom keyword",
+			input:   "AliasForInt01 = 1 GROUP BY AliasForKeyword01",
+			groupBy: "Keyword01",
+		},
+		{
+			name:  "not keyword",
+			input: "GROUP BY AliasForInt01",
+			err: query.NewConverterError(
+				"%s: unable to group by search attribute 'AliasForInt01' of type Int, use search attribute of type Keyword",
+				query.InvalidExpressionErrMessage,
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.queryConverter.groupBy = nil
+			_, err := s.queryConverter.convertWhereString(tc.input)
+			if tc.err == nil {
+				s.NoError(err)
+				s.Equal(tc.groupBy, s.queryConverter.groupBy.dbColName.Name)
+			} else {
+				s.Error(err)
+				s.Equal(tc.err, err)
+			}
+		})
+	}
+
+	s.queryConverter.queryString = "GROUP BY ExecutionStatus"
+	_, err := s.queryConverter.BuildSelectStmt(10, nil)
+	s.Equal(query.NewConverterError("%s: 'group by' clause in list queries", query.NotSupportedErrMessage), err)
+}
+
+func (s *queryConverterSuite) TestConvertOrderBy() {
+	var tests = []struct {
+		name    string
+		input   string
+		orderBy []string
+		err     error
+	}{
+		{
+			name:    "single field",
+			input:   "ORDER BY StartTime",
+			orderBy: []string{searchattribute.GetSqlDbColName(searchattribute.StartTime)},
+		},
+		{
+			name:  "multiple fields",
+			input: "AliasForInt01 = 1 ORDER BY AliasForKeyword01 DESC, AliasForInt01 ASC, CloseTime DESC",
+			orderBy: []string{
+				"Keyword01 DESC",
+				"Int01",
+				sqlparser.String(s.queryConverter.getCoalesceCloseTimeExpr()) + " DESC",
+			},
+		},
+		{
+			name:  "text",
+			input: "ORDER BY AliasForText01",
+			err:   query.NewConverterError("unable to sort by field of Text type, use field of type Keyword"),
+		},
+		{
+			name:  "keyword list",
+			input: "ORDER BY AliasForKeywordList01",
+			err:   query.NewConverterError("unable to sort by field of KeywordList type, use field of type Keyword"),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.queryConverter.orderBy = nil
+			queryString, err := s.queryConverter.convertWhereString(tc.input)
+			if tc.err == nil {
+				s.NoError(err)
+				s.Equal(tc.orderBy, s.queryConverter.orderBy)
+				s.Contains(queryString, "TemporalNamespaceDivision is null")
+			} else {
+				s.Error(err)
+				s.Equal(tc.err, err)
+			}
+		})
+	}
+
+	s.queryConverter.orderBy = nil
+	s.queryConverter.queryString = "ORDER BY AliasForKeyword01"
+	token, err := serializePageToken(&pageToken{Offset: 20})
+	s.NoError(err)
+	filter, err := s.queryConverter.BuildSelectStmt(10, token)
+	s.NoError(err)
+	s.True(s.queryConverter.HasOrderBy())
+	s.Contains(filter.Query, "ORDER BY Keyword01, run_id")
+	s.Contains(filter.Query, "LIMIT ? OFFSET ?")
+	s.Equal([]any{10, 20}, filter.QueryArgs[len(filter.QueryArgs)-2:])
+}
+
 func (s *queryConverterSuite) TestConvertAndExpr() {
 	var tests = []testCase{
 		{
@@ -306,12 +436,51 @@ func (s *queryConverterSuite) TestConvertComparisonExpr() {
 				"AliasForKeyword01 not like 'foo%'",
 			),
 		},
+		{
+			name:   "starts with expression",
+			input:  "AliasForKeyword01 STARTS_WITH 'foo_50%!'",
+			output: "Keyword01 like 'foo!_50!%!!%' escape '!'",
+			err:    nil,
+		},
+		{
+			name:   "not starts with expression",
+			input:  "AliasForKeyword01 NOT STARTS_WITH 'foo'",
+			output: "Keyword01 not like 'foo%' escape '!'",
+			err:    nil,
+		},
+		{
+			name:   "starts with expression on int",
+			input:  "AliasForInt01 STARTS_WITH '1'",
+			output: "",
+			err: query.NewConverterError(
+				"%s: cannot do prefix match on search attribute 'AliasForInt01' of type Int, use search attribute of type Keyword",
+				query.InvalidExpressionErrMessage,
+			),
+		},
+		{
+			name:   "starts with expression on execution status",
+			input:  "ExecutionStatus STARTS_WITH 'Run'",
+			output: "",
+			err: query.NewConverterError(
+				"%s: cannot do prefix match on search attribute 'ExecutionStatus' of type Keyword, use search attribute of type Keyword",
+				query.InvalidExpressionErrMessage,
+			),
+		},
+		{
+			name:   "starts with expression with number",
+			input:  "AliasForKeyword01 STARTS_WITH 1",
+			output: "",
+			err: query.NewConverterError(
+				"%s: 'starts_with' operator value must be a string",
+				query.InvalidExpressionErrMessage,
+			),
+		},
 	}
 
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
 			sql := fmt.Sprintf("select * from table1 where %s", tc.input)
-			stmt, err := sqlparser.Parse(sql)
+			stmt, err := query.Parse(sql)
 			s.NoError(err)
 			expr := stmt.(*sqlparser.Select).Where.Expr
 			err = s.queryConverter.convertComparisonExpr(&expr)
@@ -842,12 +1011,6 @@ func TestSupportedTypeRangeCond(t *testing.T) {
 	s := assert.New(t)
 	msg := "If you're changing the supported types for range condition, " +
 		"remember to check they work correctly with MySQL, PostgreSQL and SQLite."
-	supportedTypesRangeCond = []enumspb.IndexedValueType{
-		enumspb.INDEXED_VALUE_TYPE_DATETIME,
-		enumspb.INDEXED_VALUE_TYPE_DOUBLE,
-		enumspb.INDEXED_VALUE_TYPE_INT,
-		enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-	}
 	for tpCode := range enumspb.IndexedValueType_name {
 		tp := enumspb.IndexedValueType(tpCode)
 		switch tp {
@@ -855,9 +1018,9 @@ func TestSupportedTypeRangeCond(t *testing.T) {
 			enumspb.INDEXED_VALUE_TYPE_DOUBLE,
 			enumspb.INDEXED_VALUE_TYPE_INT,
 			enumspb.INDEXED_VALUE_TYPE_KEYWORD:
-			s.True(isSupportedTypeRangeCond(tp), msg)
+			s.NoError(query.ValidateRangeCondType("", tp), msg)
 		default:
-			s.False(isSupportedTypeRangeCond(tp), msg)
+			s.Error(query.ValidateRangeCondType("", tp), msg)
 		}
 	}
 }
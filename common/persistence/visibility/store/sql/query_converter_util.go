@@ -25,6 +25,7 @@
 package sql
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
@@ -121,6 +122,16 @@ func newFuncExpr(name string, exprs ...sqlparser.Expr) *sqlparser.FuncExpr {
 	}
 }
 
+// buildCountClauses returns the select expression and the group by clause (empty if none) of a count query.
+// If groupBy column is specified, the query returns the count for each value of the column,
+// which is read into sqlplugin.VisibilityCountRow.
+func buildCountClauses(groupBy string) (string, string) {
+	if groupBy == "" {
+		return "COUNT(1)", ""
+	}
+	return fmt.Sprintf("%s AS group_value, COUNT(1) AS count", groupBy), " GROUP BY " + groupBy
+}
+
 func addPrefix(prefix string, fields []string) []string {
 	out := make([]string, len(fields))
 	for i, field := range fields {
@@ -129,6 +140,75 @@ func addPrefix(prefix string, fields []string) []string {
 	return out
 }
 
+// buildPaginationClauses returns the where clause (empty if none), its arguments and
+// the order by clause to read the page following token.
+func buildPaginationClauses(
+	coalesceCloseTimeExpr string,
+	token *pageToken,
+) (string, []any, string) {
+	startTimeCol := searchattribute.GetSqlDbColName(searchattribute.StartTime)
+	runIDCol := searchattribute.GetSqlDbColName(searchattribute.RunID)
+
+	if token.inOpenPhase() {
+		return fmt.Sprintf(
+				"%s > ? AND %s <= ? AND ((%s = ? AND %s > ?) OR %s < ?)",
+				coalesceCloseTimeExpr,
+				startTimeCol,
+				startTimeCol,
+				runIDCol,
+				startTimeCol,
+			),
+			[]any{
+				*token.SnapshotTime,
+				*token.SnapshotTime,
+				token.StartTime,
+				token.RunID,
+				token.StartTime,
+			},
+			fmt.Sprintf("%s DESC, %s", startTimeCol, runIDCol)
+	}
+
+	orderBy := fmt.Sprintf("%s DESC, %s DESC, %s", coalesceCloseTimeExpr, startTimeCol, runIDCol)
+	if token == nil {
+		return "", nil, orderBy
+	}
+	// Executions closed after the snapshot were already returned in the open phase, and are
+	// excluded here because the cursor starts at CloseTime = SnapshotTime.
+	return fmt.Sprintf(
+			"((%s = ? AND %s = ? AND %s > ?) OR (%s = ? AND %s < ?) OR %s < ?)",
+			coalesceCloseTimeExpr,
+			startTimeCol,
+			runIDCol,
+			coalesceCloseTimeExpr,
+			startTimeCol,
+			coalesceCloseTimeExpr,
+		),
+		[]any{
+			token.CloseTime,
+			token.StartTime,
+			token.RunID,
+			token.CloseTime,
+			token.StartTime,
+			token.CloseTime,
+		},
+		orderBy
+}
+
+// buildCustomOrderByClauses returns the order by clause, the limit clause and its arguments to read
+// the page following token when the query has an order by clause. Such pages are read by offset,
+// with RunId as tiebreaker to keep the order stable.
+func buildCustomOrderByClauses(orderBy []string, pageSize int, token *pageToken) (string, string, []any) {
+	orderByClause := fmt.Sprintf(
+		"%s, %s",
+		strings.Join(orderBy, ", "),
+		searchattribute.GetSqlDbColName(searchattribute.RunID),
+	)
+	if token == nil || token.Offset == 0 {
+		return orderByClause, "LIMIT ?", []any{pageSize}
+	}
+	return orderByClause, "LIMIT ? OFFSET ?", []any{pageSize, token.Offset}
+}
+
 func getMaxDatetimeValue() time.Time {
 	t, _ := time.Parse(time.RFC3339, "9999-12-31T23:59:59Z")
 	return t
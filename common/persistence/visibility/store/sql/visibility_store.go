@@ -28,6 +28,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -375,23 +376,40 @@ func (s *VisibilityStore) ListWorkflowExecutions(
 		saMapper,
 		request.Query,
 	)
-	selectFilter, err := converter.BuildSelectStmt(request.PageSize, request.NextPageToken)
+	token, err := deserializePageToken(request.NextPageToken)
 	if err != nil {
-		// Convert ConverterError to InvalidArgument and pass through all other errors (which should be only mapper errors).
-		var converterErr *query.ConverterError
-		if errors.As(err, &converterErr) {
-			return nil, converterErr.ToInvalidArgument()
-		}
-		return nil, err
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Unable to deserialize page token: %v", err))
+	}
+	snapshotTime := time.Now().UTC()
+	if token != nil && token.SnapshotTime != nil {
+		snapshotTime = *token.SnapshotTime
 	}
 
-	rows, err := s.sqlStore.Db.SelectFromVisibility(ctx, *selectFilter)
+	rows, err := s.selectWorkflowExecutions(ctx, converter, request.PageSize, request.NextPageToken)
 	if err != nil {
-		return nil, serviceerror.NewUnavailable(
-			fmt.Sprintf("ListWorkflowExecutions operation failed. Select failed: %v", err))
+		return nil, err
 	}
-	if len(rows) == 0 {
-		return &store.InternalListWorkflowExecutionsResponse{}, nil
+
+	var nextToken *pageToken
+	if converter.HasOrderBy() {
+		nextToken = nextOffsetPageToken(token, rows, request.PageSize)
+	} else {
+		nextToken = nextPageToken(token, rows, request.PageSize, snapshotTime)
+		if token.inOpenPhase() && len(rows) < request.PageSize {
+			// Open phase is done, fill the rest of the page with executions closed at or before
+			// the snapshot so that a short page always means there are no more pages.
+			closedPageSize := request.PageSize - len(rows)
+			closedPageToken, err := serializePageToken(nextToken)
+			if err != nil {
+				return nil, err
+			}
+			closedRows, err := s.selectWorkflowExecutions(ctx, converter, closedPageSize, closedPageToken)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, closedRows...)
+			nextToken = nextPageToken(nextToken, closedRows, closedPageSize, snapshotTime)
+		}
 	}
 
 	var infos = make([]*store.InternalWorkflowExecutionInfo, len(rows))
@@ -403,25 +421,109 @@ func (s *VisibilityStore) ListWorkflowExecutions(
 	}
 
 	var nextPageToken []byte
-	if len(rows) == request.PageSize {
+	if nextToken != nil {
+		nextPageToken, err = serializePageToken(nextToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &store.InternalListWorkflowExecutionsResponse{
+		Executions:    infos,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *VisibilityStore) selectWorkflowExecutions(
+	ctx context.Context,
+	converter *QueryConverter,
+	pageSize int,
+	nextPageToken []byte,
+) ([]sqlplugin.VisibilityRow, error) {
+	selectFilter, err := converter.BuildSelectStmt(pageSize, nextPageToken)
+	if err != nil {
+		// Convert ConverterError to InvalidArgument and pass through all other errors (which should be only mapper errors).
+		var converterErr *query.ConverterError
+		if errors.As(err, &converterErr) {
+			return nil, converterErr.ToInvalidArgument()
+		}
+		return nil, err
+	}
+
+	rows, err := s.sqlStore.Db.SelectFromVisibility(ctx, *selectFilter)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(
+			fmt.Sprintf("ListWorkflowExecutions operation failed. Select failed: %v", err))
+	}
+	return rows, nil
+}
+
+// nextPageToken returns the token for the page following rows, or nil if there are no more pages.
+func nextPageToken(
+	token *pageToken,
+	rows []sqlplugin.VisibilityRow,
+	pageSize int,
+	snapshotTime time.Time,
+) *pageToken {
+	if token != nil && token.SnapshotTime == nil {
+		// Token issued without snapshot, keep paginating in the default order only.
+		if len(rows) < pageSize {
+			return nil
+		}
 		lastRow := rows[len(rows)-1]
 		closeTime := maxTime
 		if lastRow.CloseTime != nil {
 			closeTime = *lastRow.CloseTime
 		}
-		nextPageToken, err = serializePageToken(&pageToken{
+		return &pageToken{
 			CloseTime: closeTime,
 			StartTime: lastRow.StartTime,
 			RunID:     lastRow.RunID,
-		})
-		if err != nil {
-			return nil, err
 		}
 	}
-	return &store.InternalListWorkflowExecutionsResponse{
-		Executions:    infos,
-		NextPageToken: nextPageToken,
-	}, nil
+
+	// First page is read in the default order, which lists open executions first ordered by
+	// StartTime and RunID, same as the open phase.
+	inOpenPhase := token.inOpenPhase() || (token == nil && len(rows) > 0 && rows[len(rows)-1].CloseTime == nil)
+	if len(rows) < pageSize {
+		if !token.inOpenPhase() {
+			return nil
+		}
+		// Open phase is done, start listing executions closed at or before the snapshot.
+		return &pageToken{
+			CloseTime:    snapshotTime,
+			StartTime:    maxTime,
+			SnapshotTime: &snapshotTime,
+		}
+	}
+
+	lastRow := rows[len(rows)-1]
+	closeTime := maxTime
+	if !inOpenPhase && lastRow.CloseTime != nil {
+		closeTime = *lastRow.CloseTime
+	}
+	return &pageToken{
+		CloseTime:    closeTime,
+		StartTime:    lastRow.StartTime,
+		RunID:        lastRow.RunID,
+		SnapshotTime: &snapshotTime,
+	}
+}
+
+// nextOffsetPageToken returns the token for the page following rows of a query with an order by
+// clause, or nil if there are no more pages.
+func nextOffsetPageToken(
+	token *pageToken,
+	rows []sqlplugin.VisibilityRow,
+	pageSize int,
+) *pageToken {
+	if len(rows) < pageSize {
+		return nil
+	}
+	offset := len(rows)
+	if token != nil {
+		offset += token.Offset
+	}
+	return &pageToken{Offset: offset}
 }
 
 func (s *VisibilityStore) ScanWorkflowExecutions(
@@ -463,6 +565,10 @@ func (s *VisibilityStore) CountWorkflowExecutions(
 		return nil, err
 	}
 
+	if converter.groupBy != nil {
+		return s.countGroupByWorkflowExecutions(ctx, selectFilter, converter.groupBy)
+	}
+
 	count, err := s.sqlStore.Db.CountFromVisibility(ctx, *selectFilter)
 	if err != nil {
 		return nil, serviceerror.NewUnavailable(
@@ -472,6 +578,64 @@ func (s *VisibilityStore) CountWorkflowExecutions(
 	return &manager.CountWorkflowExecutionsResponse{Count: count}, nil
 }
 
+func (s *VisibilityStore) countGroupByWorkflowExecutions(
+	ctx context.Context,
+	selectFilter *sqlplugin.VisibilitySelectFilter,
+	groupBy *saColName,
+) (*manager.CountWorkflowExecutionsResponse, error) {
+	rows, err := s.sqlStore.Db.CountGroupByFromVisibility(ctx, *selectFilter)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(
+			fmt.Sprintf("CountWorkflowExecutions operation failed. Query failed: %v", err))
+	}
+
+	response := &manager.CountWorkflowExecutionsResponse{
+		Groups: make([]manager.CountWorkflowExecutionsGroup, 0, len(rows)),
+	}
+	for _, row := range rows {
+		response.Count += row.Count
+		// Executions which don't have a value for the field are counted, but aren't grouped,
+		// same as in Elasticsearch.
+		if row.GroupValue == nil {
+			continue
+		}
+		value, err := s.parseCountGroupValue(row.GroupValue, groupBy.fieldName)
+		if err != nil {
+			return nil, err
+		}
+		response.Groups = append(response.Groups, manager.CountWorkflowExecutionsGroup{
+			Value: value,
+			Count: row.Count,
+		})
+	}
+	return response, nil
+}
+
+func (s *VisibilityStore) parseCountGroupValue(value interface{}, fieldName string) (string, error) {
+	if bytes, ok := value.([]byte); ok {
+		value = string(bytes)
+	}
+	if fieldName != searchattribute.ExecutionStatus {
+		return fmt.Sprintf("%v", value), nil
+	}
+
+	// Execution status is stored as a number, but is grouped by its name.
+	var status int64
+	switch v := value.(type) {
+	case int64:
+		status = v
+	case string:
+		var err error
+		status, err = strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return "", serviceerror.NewInternal(fmt.Sprintf("Unable to parse execution status %q: %v", v, err))
+		}
+	default:
+		return "", serviceerror.NewInternal(fmt.Sprintf("Unexpected execution status type %T", v))
+	}
+	return enumspb.WorkflowExecutionStatus(status).String(), nil
+}
+
 func (s *VisibilityStore) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -67,6 +67,12 @@ func (ni *nameInterceptor) Name(name string, usage query.FieldNameUsage) (string
 	if usage == query.FieldNameSorter {
 		return "", query.NewConverterError("order by not allowed for standard visibility")
 	}
+	if usage == query.FieldNameGroupBy {
+		return "", query.NewConverterError("group by not allowed for standard visibility")
+	}
+	if usage == query.FieldNamePrefixFilter {
+		return "", query.NewConverterError("starts_with not allowed for standard visibility")
+	}
 
 	for _, filter := range allowedFilters {
 		if filter == name {
@@ -0,0 +1,263 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"context"
+
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+)
+
+var _ manager.VisibilityManager = (*visibilityManagerCircuitBreaker)(nil)
+
+// visibilityManagerCircuitBreaker sheds requests when the error rate of the visibility store spikes.
+// Writes are always low priority, they are retried by the visibility task queue and shed first.
+type visibilityManagerCircuitBreaker struct {
+	delegate manager.VisibilityManager
+	breaker  *persistence.CircuitBreaker
+}
+
+func NewVisibilityManagerCircuitBreaker(
+	delegate manager.VisibilityManager,
+	breaker *persistence.CircuitBreaker,
+) *visibilityManagerCircuitBreaker {
+	return &visibilityManagerCircuitBreaker{
+		delegate: delegate,
+		breaker:  breaker,
+	}
+}
+
+func (m *visibilityManagerCircuitBreaker) Close() {
+	m.delegate.Close()
+}
+
+func (m *visibilityManagerCircuitBreaker) GetReadStoreName(nsName namespace.Name) string {
+	return m.delegate.GetReadStoreName(nsName)
+}
+
+func (m *visibilityManagerCircuitBreaker) GetStoreNames() []string {
+	return m.delegate.GetStoreNames()
+}
+
+func (m *visibilityManagerCircuitBreaker) HasStoreName(stName string) bool {
+	return m.delegate.HasStoreName(stName)
+}
+
+func (m *visibilityManagerCircuitBreaker) GetIndexName() string {
+	return m.delegate.GetIndexName()
+}
+
+// Below are write APIs.
+
+func (m *visibilityManagerCircuitBreaker) RecordWorkflowExecutionStarted(
+	ctx context.Context,
+	request *manager.RecordWorkflowExecutionStartedRequest,
+) error {
+	if err := m.breaker.Allow(true); err != nil {
+		return err
+	}
+	err := m.delegate.RecordWorkflowExecutionStarted(ctx, request)
+	m.breaker.Record(err)
+	return err
+}
+
+func (m *visibilityManagerCircuitBreaker) RecordWorkflowExecutionClosed(
+	ctx context.Context,
+	request *manager.RecordWorkflowExecutionClosedRequest,
+) error {
+	if err := m.breaker.Allow(true); err != nil {
+		return err
+	}
+	err := m.delegate.RecordWorkflowExecutionClosed(ctx, request)
+	m.breaker.Record(err)
+	return err
+}
+
+func (m *visibilityManagerCircuitBreaker) UpsertWorkflowExecution(
+	ctx context.Context,
+	request *manager.UpsertWorkflowExecutionRequest,
+) error {
+	if err := m.breaker.Allow(true); err != nil {
+		return err
+	}
+	err := m.delegate.UpsertWorkflowExecution(ctx, request)
+	m.breaker.Record(err)
+	return err
+}
+
+func (m *visibilityManagerCircuitBreaker) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *manager.VisibilityDeleteWorkflowExecutionRequest,
+) error {
+	if err := m.breaker.Allow(true); err != nil {
+		return err
+	}
+	err := m.delegate.DeleteWorkflowExecution(ctx, request)
+	m.breaker.Record(err)
+	return err
+}
+
+// Below are read APIs.
+
+func (m *visibilityManagerCircuitBreaker) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsRequest,
+) (*manager.ListWorkflowExecutionsResponse, error) {
+	if err := m.breaker.Allow(isLowPriorityCaller(ctx)); err != nil {
+		return nil, err
+	}
+	resp, err := m.delegate.ListOpenWorkflowExecutions(ctx, request)
+	m.breaker.Record(err)
+	return resp, err
+}
+
+func (m *visibilityManagerCircuitBreaker) ListClosedWorkflowExecutions(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsRequest,
+) (*manager.ListWorkflowExecutionsResponse, error) {
+	if err := m.breaker.Allow(isLowPriorityCaller(ctx)); err != nil {
+		return nil, err
+	}
+	resp, err := m.delegate.ListClosedWorkflowExecutions(ctx, request)
+	m.breaker.Record(err)
+	return resp, err
+}
+
+func (m *visibilityManagerCircuitBreaker) ListOpenWorkflowExecutionsByType(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsByTypeRequest,
+) (*manager.ListWorkflowExecutionsResponse, error) {
+	if err := m.breaker.Allow(isLowPriorityCaller(ctx)); err != nil {
+		return nil, err
+	}
+	resp, err := m.delegate.ListOpenWorkflowExecutionsByType(ctx, request)
+	m.breaker.Record(err)
+	return resp, err
+}
+
+func (m *visibilityManagerCircuitBreaker) ListClosedWorkflowExecutionsByType(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsByTypeRequest,
+) (*manager.ListWorkflowExecutionsResponse, error) {
+	if err := m.breaker.Allow(isLowPriorityCaller(ctx)); err != nil {
+		return nil, err
+	}
+	resp, err := m.delegate.ListClosedWorkflowExecutionsByType(ctx, request)
+	m.breaker.Record(err)
+	return resp, err
+}
+
+func (m *visibilityManagerCircuitBreaker) ListOpenWorkflowExecutionsByWorkflowID(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsByWorkflowIDRequest,
+) (*manager.ListWorkflowExecutionsResponse, error) {
+	if err := m.breaker.Allow(isLowPriorityCaller(ctx)); err != nil {
+		return nil, err
+	}
+	resp, err := m.delegate.ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
+	m.breaker.Record(err)
+	return resp, err
+}
+
+func (m *visibilityManagerCircuitBreaker) ListClosedWorkflowExecutionsByWorkflowID(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsByWorkflowIDRequest,
+) (*manager.ListWorkflowExecutionsResponse, error) {
+	if err := m.breaker.Allow(isLowPriorityCaller(ctx)); err != nil {
+		return nil, err
+	}
+	resp, err := m.delegate.ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
+	m.breaker.Record(err)
+	return resp, err
+}
+
+func (m *visibilityManagerCircuitBreaker) ListClosedWorkflowExecutionsByStatus(
+	ctx context.Context,
+	request *manager.ListClosedWorkflowExecutionsByStatusRequest,
+) (*manager.ListWorkflowExecutionsResponse, error) {
+	if err := m.breaker.Allow(isLowPriorityCaller(ctx)); err != nil {
+		return nil, err
+	}
+	resp, err := m.delegate.ListClosedWorkflowExecutionsByStatus(ctx, request)
+	m.breaker.Record(err)
+	return resp, err
+}
+
+func (m *visibilityManagerCircuitBreaker) ListWorkflowExecutions(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsRequestV2,
+) (*manager.ListWorkflowExecutionsResponse, error) {
+	if err := m.breaker.Allow(isLowPriorityCaller(ctx)); err != nil {
+		return nil, err
+	}
+	resp, err := m.delegate.ListWorkflowExecutions(ctx, request)
+	m.breaker.Record(err)
+	return resp, err
+}
+
+func (m *visibilityManagerCircuitBreaker) ScanWorkflowExecutions(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsRequestV2,
+) (*manager.ListWorkflowExecutionsResponse, error) {
+	if err := m.breaker.Allow(isLowPriorityCaller(ctx)); err != nil {
+		return nil, err
+	}
+	resp, err := m.delegate.ScanWorkflowExecutions(ctx, request)
+	m.breaker.Record(err)
+	return resp, err
+}
+
+func (m *visibilityManagerCircuitBreaker) CountWorkflowExecutions(
+	ctx context.Context,
+	request *manager.CountWorkflowExecutionsRequest,
+) (*manager.CountWorkflowExecutionsResponse, error) {
+	if err := m.breaker.Allow(isLowPriorityCaller(ctx)); err != nil {
+		return nil, err
+	}
+	resp, err := m.delegate.CountWorkflowExecutions(ctx, request)
+	m.breaker.Record(err)
+	return resp, err
+}
+
+func (m *visibilityManagerCircuitBreaker) GetWorkflowExecution(
+	ctx context.Context,
+	request *manager.GetWorkflowExecutionRequest,
+) (*manager.GetWorkflowExecutionResponse, error) {
+	if err := m.breaker.Allow(isLowPriorityCaller(ctx)); err != nil {
+		return nil, err
+	}
+	resp, err := m.delegate.GetWorkflowExecution(ctx, request)
+	m.breaker.Record(err)
+	return resp, err
+}
+
+// isLowPriorityCaller returns whether the read comes from a background or preemptable caller, e.g. a scanner
+func isLowPriorityCaller(ctx context.Context) bool {
+	callerType := headers.GetCallerInfo(ctx).CallerType
+	return callerType == headers.CallerTypeBackground || callerType == headers.CallerTypePreemptable
+}
@@ -26,6 +26,7 @@ package visibility
 
 import (
 	"context"
+	"time"
 
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -36,26 +37,36 @@ type (
 		visibilityManager          manager.VisibilityManager
 		secondaryVisibilityManager manager.VisibilityManager
 		managerSelector            managerSelector
+		verifier                   *dualWriteVerifier
 	}
 )
 
 var _ manager.VisibilityManager = (*visibilityManagerDual)(nil)
 
 // NewVisibilityManagerDual create a visibility manager that operate on multiple manager
-// implementations based on dynamic config.
+// implementations based on dynamic config. If verifier is not nil, records written to
+// both managers are sampled and compared in the background.
 func NewVisibilityManagerDual(
 	visibilityManager manager.VisibilityManager,
 	secondaryVisibilityManager manager.VisibilityManager,
 	managerSelector managerSelector,
+	verifier *dualWriteVerifier,
 ) *visibilityManagerDual {
+	if verifier != nil {
+		verifier.Start()
+	}
 	return &visibilityManagerDual{
 		visibilityManager:          visibilityManager,
 		secondaryVisibilityManager: secondaryVisibilityManager,
 		managerSelector:            managerSelector,
+		verifier:                   verifier,
 	}
 }
 
 func (v *visibilityManagerDual) Close() {
+	if v.verifier != nil {
+		v.verifier.Stop()
+	}
 	v.visibilityManager.Close()
 	v.secondaryVisibilityManager.Close()
 }
@@ -95,6 +106,7 @@ func (v *visibilityManagerDual) RecordWorkflowExecutionStarted(
 			return err
 		}
 	}
+	v.sample(ms, request.VisibilityRequestBase, nil)
 	return nil
 }
 
@@ -112,6 +124,7 @@ func (v *visibilityManagerDual) RecordWorkflowExecutionClosed(
 			return err
 		}
 	}
+	v.sample(ms, request.VisibilityRequestBase, &request.CloseTime)
 	return nil
 }
 
@@ -129,6 +142,7 @@ func (v *visibilityManagerDual) UpsertWorkflowExecution(
 			return err
 		}
 	}
+	v.sample(ms, request.VisibilityRequestBase, nil)
 	return nil
 }
 
@@ -149,6 +163,17 @@ func (v *visibilityManagerDual) DeleteWorkflowExecution(
 	return nil
 }
 
+// sample hands records written to both managers to the verifier.
+func (v *visibilityManagerDual) sample(
+	ms []manager.VisibilityManager,
+	request *manager.VisibilityRequestBase,
+	closeTime *time.Time,
+) {
+	if v.verifier != nil && len(ms) > 1 {
+		v.verifier.sample(request, closeTime)
+	}
+}
+
 func (v *visibilityManagerDual) ListOpenWorkflowExecutions(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsRequest,
@@ -37,6 +37,7 @@ import (
 
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/encryption"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store"
 )
@@ -47,8 +48,9 @@ type (
 	//  - call underlying store (standard or advanced),
 	//  - convert response.
 	visibilityManagerImpl struct {
-		store  store.VisibilityStore
-		logger log.Logger
+		store     store.VisibilityStore
+		encryptor *encryption.Encryptor
+		logger    log.Logger
 	}
 )
 
@@ -61,11 +63,13 @@ var _ manager.VisibilityManager = (*visibilityManagerImpl)(nil)
 
 func newVisibilityManagerImpl(
 	store store.VisibilityStore,
+	encryptor *encryption.Encryptor,
 	logger log.Logger,
 ) *visibilityManagerImpl {
 	return &visibilityManagerImpl{
-		store:  store,
-		logger: logger,
+		store:     store,
+		encryptor: encryptor,
+		logger:    logger,
 	}
 }
 
@@ -149,7 +153,7 @@ func (p *visibilityManagerImpl) ListOpenWorkflowExecutions(
 	if err != nil {
 		return nil, err
 	}
-	return p.convertInternalListResponse(response)
+	return p.convertInternalListResponse(ctx, response)
 }
 
 func (p *visibilityManagerImpl) ListClosedWorkflowExecutions(
@@ -161,7 +165,7 @@ func (p *visibilityManagerImpl) ListClosedWorkflowExecutions(
 		return nil, err
 	}
 
-	return p.convertInternalListResponse(response)
+	return p.convertInternalListResponse(ctx, response)
 }
 
 func (p *visibilityManagerImpl) ListOpenWorkflowExecutionsByType(
@@ -173,7 +177,7 @@ func (p *visibilityManagerImpl) ListOpenWorkflowExecutionsByType(
 		return nil, err
 	}
 
-	return p.convertInternalListResponse(response)
+	return p.convertInternalListResponse(ctx, response)
 }
 
 func (p *visibilityManagerImpl) ListClosedWorkflowExecutionsByType(
@@ -185,7 +189,7 @@ func (p *visibilityManagerImpl) ListClosedWorkflowExecutionsByType(
 		return nil, err
 	}
 
-	return p.convertInternalListResponse(response)
+	return p.convertInternalListResponse(ctx, response)
 }
 
 func (p *visibilityManagerImpl) ListOpenWorkflowExecutionsByWorkflowID(
@@ -197,7 +201,7 @@ func (p *visibilityManagerImpl) ListOpenWorkflowExecutionsByWorkflowID(
 		return nil, err
 	}
 
-	return p.convertInternalListResponse(response)
+	return p.convertInternalListResponse(ctx, response)
 }
 
 func (p *visibilityManagerImpl) ListClosedWorkflowExecutionsByWorkflowID(
@@ -209,7 +213,7 @@ func (p *visibilityManagerImpl) ListClosedWorkflowExecutionsByWorkflowID(
 		return nil, err
 	}
 
-	return p.convertInternalListResponse(response)
+	return p.convertInternalListResponse(ctx, response)
 }
 
 func (p *visibilityManagerImpl) ListClosedWorkflowExecutionsByStatus(
@@ -221,7 +225,7 @@ func (p *visibilityManagerImpl) ListClosedWorkflowExecutionsByStatus(
 		return nil, err
 	}
 
-	return p.convertInternalListResponse(response)
+	return p.convertInternalListResponse(ctx, response)
 }
 
 func (p *visibilityManagerImpl) ListWorkflowExecutions(
@@ -233,7 +237,7 @@ func (p *visibilityManagerImpl) ListWorkflowExecutions(
 		return nil, err
 	}
 
-	return p.convertInternalListResponse(response)
+	return p.convertInternalListResponse(ctx, response)
 }
 
 func (p *visibilityManagerImpl) ScanWorkflowExecutions(
@@ -245,7 +249,7 @@ func (p *visibilityManagerImpl) ScanWorkflowExecutions(
 		return nil, err
 	}
 
-	return p.convertInternalListResponse(response)
+	return p.convertInternalListResponse(ctx, response)
 }
 
 func (p *visibilityManagerImpl) CountWorkflowExecutions(
@@ -268,7 +272,7 @@ func (p *visibilityManagerImpl) GetWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
-	execution, err := p.convertInternalWorkflowExecutionInfo(response.Execution)
+	execution, err := p.convertInternalWorkflowExecutionInfo(ctx, response.Execution)
 	if err != nil {
 		return nil, err
 	}
@@ -301,7 +305,7 @@ func (p *visibilityManagerImpl) newInternalVisibilityRequestBase(request *manage
 	}, nil
 }
 
-func (p *visibilityManagerImpl) convertInternalListResponse(internalResponse *store.InternalListWorkflowExecutionsResponse) (*manager.ListWorkflowExecutionsResponse, error) {
+func (p *visibilityManagerImpl) convertInternalListResponse(ctx context.Context, internalResponse *store.InternalListWorkflowExecutionsResponse) (*manager.ListWorkflowExecutionsResponse, error) {
 	if internalResponse == nil {
 		return nil, nil
 	}
@@ -310,7 +314,7 @@ func (p *visibilityManagerImpl) convertInternalListResponse(internalResponse *st
 	resp.Executions = make([]*workflowpb.WorkflowExecutionInfo, len(internalResponse.Executions))
 	for i, execution := range internalResponse.Executions {
 		var err error
-		resp.Executions[i], err = p.convertInternalWorkflowExecutionInfo(execution)
+		resp.Executions[i], err = p.convertInternalWorkflowExecutionInfo(ctx, execution)
 		if err != nil {
 			return nil, err
 		}
@@ -320,11 +324,11 @@ func (p *visibilityManagerImpl) convertInternalListResponse(internalResponse *st
 	return resp, nil
 }
 
-func (p *visibilityManagerImpl) convertInternalWorkflowExecutionInfo(internalExecution *store.InternalWorkflowExecutionInfo) (*workflowpb.WorkflowExecutionInfo, error) {
+func (p *visibilityManagerImpl) convertInternalWorkflowExecutionInfo(ctx context.Context, internalExecution *store.InternalWorkflowExecutionInfo) (*workflowpb.WorkflowExecutionInfo, error) {
 	if internalExecution == nil {
 		return nil, nil
 	}
-	memo, err := p.deserializeMemo(internalExecution.Memo)
+	memo, err := p.deserializeMemo(ctx, internalExecution.Memo)
 	if err != nil {
 		return nil, err
 	}
@@ -363,10 +367,14 @@ func (p *visibilityManagerImpl) convertInternalWorkflowExecutionInfo(internalExe
 
 	return executionInfo, nil
 }
-func (p *visibilityManagerImpl) deserializeMemo(data *commonpb.DataBlob) (*commonpb.Memo, error) {
+func (p *visibilityManagerImpl) deserializeMemo(ctx context.Context, data *commonpb.DataBlob) (*commonpb.Memo, error) {
 	if data == nil || len(data.Data) == 0 {
 		return &commonpb.Memo{}, nil
 	}
+	data, err := p.encryptor.Decrypt(ctx, data)
+	if err != nil {
+		return nil, err
+	}
 
 	var ()
 	switch data.EncodingType {
@@ -392,8 +400,8 @@ func (p *visibilityManagerImpl) serializeMemo(memo *commonpb.Memo) (*commonpb.Da
 		return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to serialize memo to data blob: %v", err))
 	}
 
-	return &commonpb.DataBlob{
+	return p.encryptor.Encrypt(&commonpb.DataBlob{
 		Data:         data,
 		EncodingType: MemoEncoding,
-	}, nil
+	})
 }
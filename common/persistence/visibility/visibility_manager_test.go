@@ -78,8 +78,10 @@ func (s *VisibilityManagerSuite) SetupTest() {
 	s.metricsHandler = metrics.NewMockHandler(s.controller)
 	s.visibilityManager = newVisibilityManager(
 		s.visibilityStore,
+		nil,
 		dynamicconfig.GetIntPropertyFn(1),
 		dynamicconfig.GetIntPropertyFn(1),
+		nil,
 		s.metricsHandler,
 		metrics.StandardVisibilityTypeTag(),
 		log.NewNoopLogger())
@@ -97,6 +99,9 @@ func (s *VisibilityManagerSuite) TestRecordWorkflowExecutionStarted() {
 			Execution:        testWorkflowExecution,
 			WorkflowTypeName: testWorkflowTypeName,
 			StartTime:        time.Now().UTC(),
+			Memo: &commonpb.Memo{
+				Fields: map[string]*commonpb.Payload{"key": {Data: []byte("value")}},
+			},
 		},
 	}
 	s.visibilityStore.EXPECT().RecordWorkflowExecutionStarted(gomock.Any(), gomock.Any()).Return(nil)
@@ -106,6 +111,13 @@ func (s *VisibilityManagerSuite) TestRecordWorkflowExecutionStarted() {
 			metrics.StandardVisibilityTypeTag(),
 		).
 		Return(metrics.NoopMetricsHandler).Times(2)
+	s.metricsHandler.EXPECT().Counter(metrics.NamespaceStorageBytesWritten.GetMetricName()).Return(
+		metrics.CounterFunc(func(i int64, tags ...metrics.Tag) {
+			s.Equal(int64(request.Memo.Size()), i)
+			s.Contains(tags, metrics.NamespaceTag(testNamespace.String()))
+			s.Contains(tags, metrics.StorageTypeTag(namespace.StorageTypeVisibility))
+		}),
+	)
 	s.NoError(s.visibilityManager.RecordWorkflowExecutionStarted(context.Background(), request))
 
 	// no remaining tokens
@@ -132,6 +144,7 @@ func (s *VisibilityManagerSuite) TestRecordWorkflowExecutionClosed() {
 			metrics.StandardVisibilityTypeTag(),
 		).
 		Return(metrics.NoopMetricsHandler).Times(2)
+	s.metricsHandler.EXPECT().Counter(metrics.NamespaceStorageBytesWritten.GetMetricName()).Return(metrics.NoopCounterMetricFunc)
 	s.NoError(s.visibilityManager.RecordWorkflowExecutionClosed(context.Background(), request))
 
 	err := s.visibilityManager.RecordWorkflowExecutionClosed(context.Background(), request)
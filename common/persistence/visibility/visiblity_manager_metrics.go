@@ -89,6 +89,9 @@ func (m *visibilityManagerMetrics) RecordWorkflowExecutionStarted(
 	handler, startTime := m.tagScope(metrics.VisibilityPersistenceRecordWorkflowExecutionStartedScope)
 	err := m.delegate.RecordWorkflowExecutionStarted(ctx, request)
 	handler.Timer(metrics.VisibilityPersistenceLatency.GetMetricName()).Record(time.Since(startTime))
+	if err == nil {
+		m.recordStorageBytesWritten(request.VisibilityRequestBase)
+	}
 	return m.updateErrorMetric(handler, err)
 }
 
@@ -99,6 +102,9 @@ func (m *visibilityManagerMetrics) RecordWorkflowExecutionClosed(
 	handler, startTime := m.tagScope(metrics.VisibilityPersistenceRecordWorkflowExecutionClosedScope)
 	err := m.delegate.RecordWorkflowExecutionClosed(ctx, request)
 	handler.Timer(metrics.VisibilityPersistenceLatency.GetMetricName()).Record(time.Since(startTime))
+	if err == nil {
+		m.recordStorageBytesWritten(request.VisibilityRequestBase)
+	}
 	return m.updateErrorMetric(handler, err)
 }
 
@@ -109,6 +115,9 @@ func (m *visibilityManagerMetrics) UpsertWorkflowExecution(
 	handler, startTime := m.tagScope(metrics.VisibilityPersistenceUpsertWorkflowExecutionScope)
 	err := m.delegate.UpsertWorkflowExecution(ctx, request)
 	handler.Timer(metrics.VisibilityPersistenceLatency.GetMetricName()).Record(time.Since(startTime))
+	if err == nil {
+		m.recordStorageBytesWritten(request.VisibilityRequestBase)
+	}
 	return m.updateErrorMetric(handler, err)
 }
 
@@ -238,6 +247,19 @@ func (m *visibilityManagerMetrics) tagScope(operation string) (metrics.Handler,
 	return taggedHandler, time.Now().UTC()
 }
 
+// recordStorageBytesWritten counts the memo and search attributes bytes written for the namespace.
+func (m *visibilityManagerMetrics) recordStorageBytesWritten(request *manager.VisibilityRequestBase) {
+	if request == nil {
+		return
+	}
+	m.metricHandler.Counter(metrics.NamespaceStorageBytesWritten.GetMetricName()).Record(
+		int64(request.Memo.Size()+request.SearchAttributes.Size()),
+		metrics.NamespaceTag(request.Namespace.String()),
+		metrics.StorageTypeTag(namespace.StorageTypeVisibility),
+		m.visibilityTypeMetricsTag,
+	)
+}
+
 func (m *visibilityManagerMetrics) updateErrorMetric(handler metrics.Handler, err error) error {
 	if err == nil {
 		return nil
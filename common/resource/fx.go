@@ -25,6 +25,7 @@
 package resource
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"net"
@@ -50,6 +51,7 @@ import (
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/deadlock"
+	"go.temporal.io/server/common/drain"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -69,6 +71,12 @@ import (
 	"go.temporal.io/server/common/telemetry"
 )
 
+const (
+	// dynamicConfigOverridesRefreshInterval is how often runtime dynamic config overrides are read
+	// from the namespace registry, which refreshes the system namespace on its own interval.
+	dynamicConfigOverridesRefreshInterval = 10 * time.Second
+)
+
 type (
 	ThrottledLoggerRpsFn quotas.RateFn
 	NamespaceLogger      log.Logger
@@ -120,6 +128,7 @@ var Module = fx.Options(
 	membership.HostInfoProviderModule,
 	membership.GRPCResolverModule,
 	fx.Invoke(RegisterBootstrapContainer),
+	fx.Invoke(DynamicConfigOverridesLifetimeHooks),
 	fx.Provide(PersistenceConfigProvider),
 	fx.Provide(health.NewServer),
 	deadlock.Module,
@@ -169,11 +178,21 @@ func SearchAttributeMapperProviderProvider(
 	searchAttributeProvider searchattribute.Provider,
 	persistenceConfig *config.Persistence,
 ) searchattribute.MapperProvider {
+	// Namespace aliases are mapped onto the custom search attributes pre-allocated in SQL databases.
+	// With Elasticsearch, they are mapped onto the custom search attributes registered in the cluster,
+	// which remain usable by their field names.
+	enableMapperFromNamespace := persistenceConfig.IsSQLVisibilityStore()
+	fallbackIndexName := ""
+	if esIndexName := persistenceConfig.GetElasticsearchVisibilityIndex(); !enableMapperFromNamespace && esIndexName != "" {
+		enableMapperFromNamespace = true
+		fallbackIndexName = esIndexName
+	}
 	return searchattribute.NewMapperProvider(
 		saMapper,
 		namespaceRegistry,
 		searchAttributeProvider,
-		persistenceConfig.IsSQLVisibilityStore(),
+		enableMapperFromNamespace,
+		fallbackIndexName,
 	)
 }
 
@@ -306,6 +325,77 @@ func RegisterBootstrapContainer(
 	)
 }
 
+// DynamicConfigOverridesLifetimeHooks keeps the runtime dynamic config overrides, which are stored in
+// the system namespace data, up to date when the dynamic config client serves overrides.
+func DynamicConfigOverridesLifetimeHooks(
+	lc fx.Lifecycle,
+	dcClient dynamicconfig.Client,
+	namespaceRegistry namespace.Registry,
+	logger log.SnTaggedLogger,
+) {
+	overrideClient, ok := dcClient.(*dynamicconfig.OverrideClient)
+	if !ok {
+		return
+	}
+	refresh := func() {
+		ns, err := namespaceRegistry.GetNamespace(primitives.SystemLocalNamespace)
+		if err != nil {
+			return
+		}
+		if err := overrideClient.SetOverrides(ns.GetCustomData(dynamicconfig.OverridesDataKey)); err != nil {
+			logger.Error("Unable to update dynamic config overrides", tag.Error(err))
+		}
+	}
+
+	stopCh := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			refresh()
+			go func() {
+				ticker := time.NewTicker(dynamicConfigOverridesRefreshInterval)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-ticker.C:
+						refresh()
+					case <-stopCh:
+						return
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			close(stopCh)
+			return nil
+		},
+	})
+}
+
+// DrainWatcherLifetimeHooks drains the host with drainer once the drain document of the system namespace
+// targets it. It is invoked by the services which support drain mode.
+func DrainWatcherLifetimeHooks(
+	lc fx.Lifecycle,
+	serviceName primitives.ServiceName,
+	drainer drain.Drainer,
+	membershipMonitor membership.Monitor,
+	namespaceRegistry namespace.Registry,
+	metadataManager persistence.MetadataManager,
+	timeSource clock.TimeSource,
+	logger log.SnTaggedLogger,
+) {
+	watcher := drain.NewWatcher(
+		serviceName,
+		drainer,
+		membershipMonitor,
+		namespaceRegistry,
+		metadataManager,
+		timeSource,
+		logger,
+	)
+	lc.Append(fx.StartStopHook(watcher.Start, watcher.Stop))
+}
+
 func HistoryClientProvider(clientBean client.Bean) historyservice.HistoryServiceClient {
 	historyRawClient := clientBean.GetHistoryClient()
 	historyClient := history.NewRetryableClient(
@@ -333,6 +423,7 @@ func MatchingClientProvider(matchingRawClient MatchingRawClient) MatchingClient
 
 func PersistenceConfigProvider(persistenceConfig config.Persistence, dc *dynamicconfig.Collection) *config.Persistence {
 	persistenceConfig.TransactionSizeLimit = dc.GetIntProperty(dynamicconfig.TransactionSizeLimit, common.DefaultTransactionSizeLimit)
+	persistenceConfig.HistoryNodeChunkSize = dc.GetIntProperty(dynamicconfig.HistoryNodeChunkSize, 0)
 	return &persistenceConfig
 }
 
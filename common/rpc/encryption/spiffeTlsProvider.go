@@ -0,0 +1,402 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/common/auth"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	spiffeFetchX509SVIDMethod = "/SpiffeWorkloadAPI/FetchX509SVID"
+	spiffeWorkloadHeader      = "workload.spiffe.io"
+	spiffeScheme              = "spiffe"
+
+	// X509SVIDResponse.svids, X509SVID.spiffe_id, X509SVID.x509_svid, X509SVID.x509_svid_key and
+	// X509SVID.bundle in the Workload API protobuf definition
+	spiffeResponseSVIDsField = 1
+	spiffeSVIDIDField        = 1
+	spiffeSVIDCertsField     = 2
+	spiffeSVIDKeyField       = 3
+	spiffeSVIDBundleField    = 4
+
+	spiffeInitialFetchTimeout = 30 * time.Second
+)
+
+type (
+	// spiffeTlsProvider serves internode TLS configs from the SVIDs of the SPIFFE Workload API and delegates
+	// every other config to the wrapped provider.
+	spiffeTlsProvider struct {
+		TLSConfigProvider
+
+		serviceIDs map[string]string
+		logger     log.Logger
+		conn       *grpc.ClientConn
+		cancel     context.CancelFunc
+		firstSVID  chan struct{}
+
+		sync.RWMutex
+		svid *spiffeSVID
+	}
+
+	spiffeSVID struct {
+		id          *url.URL
+		certificate *tls.Certificate
+		bundle      *x509.CertPool
+	}
+
+	// spiffeRawCodec passes the Workload API messages through as bytes, since the generated protobuf types
+	// are not a dependency of the server.
+	spiffeRawCodec struct{}
+)
+
+var _ TLSConfigProvider = (*spiffeTlsProvider)(nil)
+
+var errMalformedSVIDResponse = errors.New("malformed X509SVIDResponse")
+
+// newSPIFFETlsProvider wraps provider to serve internode TLS with SPIFFE identities. It blocks until the first
+// SVID is received from the Workload API.
+func newSPIFFETlsProvider(
+	provider TLSConfigProvider,
+	cfg *config.SPIFFE,
+	logger log.Logger,
+) (*spiffeTlsProvider, error) {
+	conn, err := grpc.Dial(cfg.WorkloadAPIAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to the SPIFFE Workload API: %w", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &spiffeTlsProvider{
+		TLSConfigProvider: provider,
+		serviceIDs:        cfg.ServiceIDs,
+		logger:            logger,
+		conn:              conn,
+		cancel:            cancel,
+		firstSVID:         make(chan struct{}),
+	}
+	go s.watchSVIDs(ctx)
+
+	select {
+	case <-s.firstSVID:
+		return s, nil
+	case <-time.After(spiffeInitialFetchTimeout):
+		s.Close()
+		return nil, fmt.Errorf("no X.509 SVID received from the SPIFFE Workload API at %s", cfg.WorkloadAPIAddress)
+	}
+}
+
+func (s *spiffeTlsProvider) Close() {
+	s.cancel()
+	_ = s.conn.Close()
+}
+
+func (s *spiffeTlsProvider) GetInternodeServerConfig() (*tls.Config, error) {
+	c := auth.NewEmptyTLSConfig()
+	c.ClientAuth = tls.RequireAnyClientCert
+	c.GetCertificate = func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+		return s.currentSVID().certificate, nil
+	}
+	c.VerifyPeerCertificate = s.verifyPeerCertificate
+	return c, nil
+}
+
+func (s *spiffeTlsProvider) GetInternodeClientConfig() (*tls.Config, error) {
+	c := auth.NewEmptyTLSConfig()
+	// SVIDs carry SPIFFE IDs rather than host names, so the server certificate is verified against the trust
+	// bundle and the expected IDs by verifyPeerCertificate instead.
+	c.InsecureSkipVerify = true
+	c.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		return s.currentSVID().certificate, nil
+	}
+	c.VerifyPeerCertificate = s.verifyPeerCertificate
+	return c, nil
+}
+
+func (s *spiffeTlsProvider) GetExpiringCerts(timeWindow time.Duration,
+) (expiring CertExpirationMap, expired CertExpirationMap, err error) {
+	expiring, expired, err = s.TLSConfigProvider.GetExpiringCerts(timeWindow)
+	if expiring == nil {
+		expiring = make(CertExpirationMap)
+	}
+	if expired == nil {
+		expired = make(CertExpirationMap)
+	}
+	// SVIDs are rotated by the agent well before they expire, so these only show up if rotation stops
+	checkCertForExpiration(s.currentSVID().certificate.Leaf, time.Now().UTC().Add(timeWindow), expiring, expired)
+	return expiring, expired, err
+}
+
+func (s *spiffeTlsProvider) currentSVID() *spiffeSVID {
+	s.RLock()
+	defer s.RUnlock()
+	return s.svid
+}
+
+// verifyPeerCertificate checks that the peer presents an SVID issued by the trust bundle with one of the
+// expected SPIFFE IDs.
+func (s *spiffeTlsProvider) verifyPeerCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) == 0 {
+		return errors.New("peer did not present an X.509 SVID")
+	}
+	certs := make([]*x509.Certificate, 0, len(rawCerts))
+	for _, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return err
+		}
+		certs = append(certs, cert)
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	svid := s.currentSVID()
+	if _, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         svid.bundle,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		return fmt.Errorf("peer X.509 SVID verification failed: %w", err)
+	}
+	id, err := spiffeID(certs[0])
+	if err != nil {
+		return err
+	}
+	if !s.isExpectedID(id, svid.id) {
+		return fmt.Errorf("unexpected peer SPIFFE ID %s", id)
+	}
+	return nil
+}
+
+func (s *spiffeTlsProvider) isExpectedID(id *url.URL, localID *url.URL) bool {
+	if len(s.serviceIDs) == 0 {
+		return id.Host == localID.Host
+	}
+	for _, expected := range s.serviceIDs {
+		if id.String() == expected {
+			return true
+		}
+	}
+	return false
+}
+
+// watchSVIDs streams SVID updates from the Workload API and reconnects until ctx is cancelled.
+func (s *spiffeTlsProvider) watchSVIDs(ctx context.Context) {
+	retryPolicy := backoff.NewExponentialRetryPolicy(time.Second).
+		WithMaximumInterval(30 * time.Second).
+		WithExpirationInterval(backoff.NoInterval)
+	attempt := 0
+	for {
+		err := s.streamSVIDs(ctx, func() { attempt = 0 })
+		if ctx.Err() != nil {
+			return
+		}
+		attempt++
+		s.logger.Warn("SPIFFE Workload API stream failed", tag.Error(err), tag.Attempt(int32(attempt)))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryPolicy.ComputeNextDelay(0, attempt)):
+		}
+	}
+}
+
+func (s *spiffeTlsProvider) streamSVIDs(ctx context.Context, onUpdate func()) error {
+	ctx, cancel := context.WithCancel(metadata.AppendToOutgoingContext(ctx, spiffeWorkloadHeader, "true"))
+	defer cancel()
+	stream, err := s.conn.NewStream(
+		ctx,
+		&grpc.StreamDesc{ServerStreams: true},
+		spiffeFetchX509SVIDMethod,
+		grpc.ForceCodec(spiffeRawCodec{}),
+	)
+	if err != nil {
+		return err
+	}
+	request := []byte{}
+	if err := stream.SendMsg(&request); err != nil {
+		return err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return err
+	}
+	for {
+		var response []byte
+		if err := stream.RecvMsg(&response); err != nil {
+			return err
+		}
+		svid, err := parseX509SVIDResponse(response)
+		if err != nil {
+			return err
+		}
+
+		s.Lock()
+		first := s.svid == nil
+		s.svid = svid
+		s.Unlock()
+		s.logger.Info("Received X.509 SVID", tag.NewStringTag("spiffe-id", svid.id.String()),
+			tag.NewTimeTag("expiration", svid.certificate.Leaf.NotAfter))
+		if first {
+			close(s.firstSVID)
+		}
+		onUpdate()
+	}
+}
+
+// parseX509SVIDResponse returns the first, i.e. default, SVID of an X509SVIDResponse.
+func parseX509SVIDResponse(response []byte) (*spiffeSVID, error) {
+	var svidMessage []byte
+	err := decodeProtoFields(response, func(field uint64, value []byte) {
+		if field == spiffeResponseSVIDsField && svidMessage == nil {
+			svidMessage = value
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if svidMessage == nil {
+		return nil, errors.New("X509SVIDResponse contains no SVID")
+	}
+
+	var certsDER, keyDER, bundleDER []byte
+	err = decodeProtoFields(svidMessage, func(field uint64, value []byte) {
+		switch field {
+		case spiffeSVIDCertsField:
+			certsDER = value
+		case spiffeSVIDKeyField:
+			keyDER = value
+		case spiffeSVIDBundleField:
+			bundleDER = value
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	certs, err := x509.ParseCertificates(certsDER)
+	if err != nil || len(certs) == 0 {
+		return nil, fmt.Errorf("invalid X.509 SVID certificates: %v", err)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(keyDER)
+	if err != nil {
+		return nil, fmt.Errorf("invalid X.509 SVID key: %w", err)
+	}
+	bundleCerts, err := x509.ParseCertificates(bundleDER)
+	if err != nil || len(bundleCerts) == 0 {
+		return nil, fmt.Errorf("invalid X.509 trust bundle: %v", err)
+	}
+	id, err := spiffeID(certs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	certificate := &tls.Certificate{PrivateKey: key, Leaf: certs[0]}
+	for _, cert := range certs {
+		certificate.Certificate = append(certificate.Certificate, cert.Raw)
+	}
+	bundle := x509.NewCertPool()
+	for _, cert := range bundleCerts {
+		bundle.AddCert(cert)
+	}
+	return &spiffeSVID{id: id, certificate: certificate, bundle: bundle}, nil
+}
+
+// decodeProtoFields calls fn with the number and value of each length-delimited field of a protobuf message
+// and skips all other fields.
+func decodeProtoFields(msg []byte, fn func(field uint64, value []byte)) error {
+	for len(msg) > 0 {
+		key, n := proto.DecodeVarint(msg)
+		if n == 0 {
+			return errMalformedSVIDResponse
+		}
+		msg = msg[n:]
+		switch key & 7 {
+		case proto.WireVarint:
+			if _, n = proto.DecodeVarint(msg); n == 0 {
+				return errMalformedSVIDResponse
+			}
+			msg = msg[n:]
+		case proto.WireFixed64:
+			if len(msg) < 8 {
+				return errMalformedSVIDResponse
+			}
+			msg = msg[8:]
+		case proto.WireBytes:
+			length, n := proto.DecodeVarint(msg)
+			if n == 0 || uint64(len(msg)-n) < length {
+				return errMalformedSVIDResponse
+			}
+			fn(key>>3, msg[n:n+int(length)])
+			msg = msg[n+int(length):]
+		case proto.WireFixed32:
+			if len(msg) < 4 {
+				return errMalformedSVIDResponse
+			}
+			msg = msg[4:]
+		default:
+			return errMalformedSVIDResponse
+		}
+	}
+	return nil
+}
+
+// spiffeID returns the SPIFFE ID in the URI SAN of an X.509 SVID.
+func spiffeID(cert *x509.Certificate) (*url.URL, error) {
+	if len(cert.URIs) != 1 || cert.URIs[0].Scheme != spiffeScheme || cert.URIs[0].Host == "" {
+		return nil, errors.New("certificate is not an X.509 SVID: it must have exactly one spiffe:// URI SAN")
+	}
+	return cert.URIs[0], nil
+}
+
+func (spiffeRawCodec) Marshal(v interface{}) ([]byte, error) {
+	return *(v.(*[]byte)), nil
+}
+
+func (spiffeRawCodec) Unmarshal(data []byte, v interface{}) error {
+	*(v.(*[]byte)) = append([]byte(nil), data...)
+	return nil
+}
+
+func (spiffeRawCodec) Name() string {
+	return "proto"
+}
@@ -0,0 +1,306 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	spiffeTlsProviderSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		socketDir string
+		server    *grpc.Server
+		// responses has the first response of each stream and updates the later responses of all streams
+		responses chan []byte
+		updates   chan []byte
+		ca        *spiffeTestCA
+	}
+
+	spiffeTestCA struct {
+		cert *x509.Certificate
+		key  *ecdsa.PrivateKey
+	}
+)
+
+func TestSPIFFETlsProviderSuite(t *testing.T) {
+	s := new(spiffeTlsProviderSuite)
+	suite.Run(t, s)
+}
+
+func (s *spiffeTlsProviderSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.ca = s.newCA()
+	initial, updates := make(chan []byte, 10), make(chan []byte, 10)
+	s.responses, s.updates = initial, updates
+
+	// unix socket paths are limited to ~100 characters, which test temp dirs can exceed
+	socketDir, err := os.MkdirTemp("", "spiffe")
+	s.NoError(err)
+	s.socketDir = socketDir
+	listener, err := net.Listen("unix", filepath.Join(socketDir, "agent.sock"))
+	s.NoError(err)
+	server := grpc.NewServer(
+		grpc.ForceServerCodec(spiffeRawCodec{}),
+		grpc.UnknownServiceHandler(func(_ interface{}, stream grpc.ServerStream) error {
+			method, _ := grpc.MethodFromServerStream(stream)
+			if method != spiffeFetchX509SVIDMethod {
+				return errors.New("unexpected method")
+			}
+			md, _ := metadata.FromIncomingContext(stream.Context())
+			if len(md.Get(spiffeWorkloadHeader)) != 1 || md.Get(spiffeWorkloadHeader)[0] != "true" {
+				return errors.New("missing security header")
+			}
+			var request []byte
+			if err := stream.RecvMsg(&request); err != nil {
+				return err
+			}
+			responses := initial
+			for {
+				select {
+				case response := <-responses:
+					if err := stream.SendMsg(&response); err != nil {
+						return err
+					}
+					responses = updates
+				case <-stream.Context().Done():
+					return nil
+				}
+			}
+		}),
+	)
+	s.server = server
+	go func() { _ = server.Serve(listener) }()
+}
+
+func (s *spiffeTlsProviderSuite) TearDownTest() {
+	s.server.Stop()
+	_ = os.RemoveAll(s.socketDir)
+}
+
+func (s *spiffeTlsProviderSuite) newProvider(serviceIDs map[string]string) *spiffeTlsProvider {
+	localProvider, err := NewLocalStoreTlsProvider(&config.RootTLS{}, metrics.NoopMetricsHandler, log.NewNoopLogger(), NewLocalStoreCertProvider)
+	s.NoError(err)
+	provider, err := newSPIFFETlsProvider(localProvider, &config.SPIFFE{
+		WorkloadAPIAddress: "unix://" + filepath.Join(s.socketDir, "agent.sock"),
+		ServiceIDs:         serviceIDs,
+	}, log.NewNoopLogger())
+	s.NoError(err)
+	s.T().Cleanup(provider.Close)
+	return provider
+}
+
+func (s *spiffeTlsProviderSuite) TestHandshake() {
+	s.responses <- s.ca.svidResponse(s, "spiffe://example.org/temporal/history")
+	history := s.newProvider(map[string]string{
+		"history":  "spiffe://example.org/temporal/history",
+		"matching": "spiffe://example.org/temporal/matching",
+	})
+	s.responses <- s.ca.svidResponse(s, "spiffe://example.org/temporal/matching")
+	matching := s.newProvider(nil)
+	s.Equal("spiffe://example.org/temporal/history", history.currentSVID().id.String())
+	s.Equal("spiffe://example.org/temporal/matching", matching.currentSVID().id.String())
+
+	s.NoError(s.handshake(history, matching))
+	s.NoError(s.handshake(matching, history))
+
+	s.responses <- s.ca.svidResponse(s, "spiffe://example.org/temporal/other")
+	other := s.newProvider(nil)
+	s.Error(s.handshake(history, other))
+	s.Error(s.handshake(other, history))
+	// any ID in the trust domain is accepted without service IDs
+	s.NoError(s.handshake(matching, other))
+
+	s.responses <- s.newCA().svidResponse(s, "spiffe://example.org/temporal/matching")
+	untrusted := s.newProvider(nil)
+	s.Error(s.handshake(history, untrusted))
+	s.Error(s.handshake(untrusted, history))
+}
+
+func (s *spiffeTlsProviderSuite) TestRotation() {
+	s.responses <- s.ca.svidResponse(s, "spiffe://example.org/temporal/history")
+	provider := s.newProvider(nil)
+	first := provider.currentSVID()
+
+	s.updates <- s.ca.svidResponse(s, "spiffe://example.org/temporal/history")
+	s.Eventually(func() bool {
+		return provider.currentSVID() != first
+	}, 5*time.Second, 10*time.Millisecond)
+	s.NotEqual(first.certificate.Leaf.SerialNumber, provider.currentSVID().certificate.Leaf.SerialNumber)
+
+	expiring, expired, err := provider.GetExpiringCerts(2 * time.Hour)
+	s.NoError(err)
+	s.Len(expiring, 1)
+	s.Empty(expired)
+}
+
+func (s *spiffeTlsProviderSuite) TestParseX509SVIDResponse() {
+	_, err := parseX509SVIDResponse([]byte{0x0a, 0x05, 0x01})
+	s.Error(err)
+	_, err = parseX509SVIDResponse(nil)
+	s.Error(err)
+	_, err = parseX509SVIDResponse(protoBytesField(spiffeResponseSVIDsField, protoBytesField(spiffeSVIDIDField, []byte("spiffe://example.org/x"))))
+	s.Error(err)
+}
+
+func (s *spiffeTlsProviderSuite) TestValidateConfig() {
+	s.NoError(validateRootTLS(&config.RootTLS{Internode: config.GroupTLS{SPIFFE: config.SPIFFE{
+		WorkloadAPIAddress: "unix:///run/spire/sockets/agent.sock",
+		ServiceIDs:         map[string]string{"history": "spiffe://example.org/history"},
+	}}}))
+	s.Error(validateRootTLS(&config.RootTLS{Internode: config.GroupTLS{SPIFFE: config.SPIFFE{
+		WorkloadAPIAddress: "unix:///run/spire/sockets/agent.sock",
+		ServiceIDs:         map[string]string{"history": "https://example.org/history"},
+	}}}))
+	s.Error(validateRootTLS(&config.RootTLS{Internode: config.GroupTLS{
+		Server: config.ServerTLS{CertFile: "cert.pem", KeyFile: "key.pem"},
+		SPIFFE: config.SPIFFE{WorkloadAPIAddress: "unix:///run/spire/sockets/agent.sock"},
+	}}))
+	s.Error(validateRootTLS(&config.RootTLS{Frontend: config.GroupTLS{SPIFFE: config.SPIFFE{
+		WorkloadAPIAddress: "unix:///run/spire/sockets/agent.sock",
+	}}}))
+}
+
+// handshake runs a TLS handshake between the internode client config of client and the internode server
+// config of server.
+func (s *spiffeTlsProviderSuite) handshake(client *spiffeTlsProvider, server *spiffeTlsProvider) error {
+	clientConfig, err := client.GetInternodeClientConfig()
+	s.NoError(err)
+	serverConfig, err := server.GetInternodeServerConfig()
+	s.NoError(err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.NoError(err)
+	defer func() { _ = listener.Close() }()
+	serverErr := make(chan error, 1)
+	go func() {
+		serverConn, err := listener.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		tlsConn := tls.Server(serverConn, serverConfig)
+		err = tlsConn.Handshake()
+		if err == nil {
+			// read the client's acknowledgement so that the client sees a rejected certificate
+			_, err = tlsConn.Read(make([]byte, 1))
+		}
+		serverErr <- err
+		_ = serverConn.Close()
+	}()
+	clientConn, err := net.Dial("tcp", listener.Addr().String())
+	s.NoError(err)
+	defer func() { _ = clientConn.Close() }()
+	tlsConn := tls.Client(clientConn, clientConfig)
+	err = tlsConn.Handshake()
+	if err == nil {
+		_, err = tlsConn.Write([]byte{1})
+	}
+	if err != nil {
+		_ = clientConn.Close()
+		<-serverErr
+		return err
+	}
+	return <-serverErr
+}
+
+func (s *spiffeTlsProviderSuite) newCA() *spiffeTestCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{Organization: []string{"SPIRE"}},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	s.NoError(err)
+	cert, err := x509.ParseCertificate(der)
+	s.NoError(err)
+	return &spiffeTestCA{cert: cert, key: key}
+}
+
+// svidResponse returns an X509SVIDResponse with an SVID for id issued by the CA, expiring in an hour.
+func (ca *spiffeTestCA) svidResponse(s *spiffeTlsProviderSuite, id string) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+	uri, err := url.Parse(id)
+	s.NoError(err)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	s.NoError(err)
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		URIs:         []*url.URL{uri},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	s.NoError(err)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	s.NoError(err)
+
+	var svid []byte
+	svid = append(svid, protoBytesField(spiffeSVIDIDField, []byte(id))...)
+	svid = append(svid, protoBytesField(spiffeSVIDCertsField, der)...)
+	svid = append(svid, protoBytesField(spiffeSVIDKeyField, keyDER)...)
+	svid = append(svid, protoBytesField(spiffeSVIDBundleField, ca.cert.Raw)...)
+	return protoBytesField(spiffeResponseSVIDsField, svid)
+}
+
+func protoBytesField(field uint64, value []byte) []byte {
+	result := proto.EncodeVarint(field<<3 | proto.WireBytes)
+	result = append(result, proto.EncodeVarint(uint64(len(value)))...)
+	return append(result, value...)
+}
@@ -28,6 +28,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
+	"net/url"
 	"strings"
 	"time"
 
@@ -97,19 +98,56 @@ func NewTLSConfigProviderFromConfig(
 	if certProviderFactory == nil {
 		certProviderFactory = NewLocalStoreCertProvider
 	}
-	return NewLocalStoreTlsProvider(&encryptionSettings, metricsHandler.WithTags(metrics.OperationTag(metrics.ServerTlsScope)), logger, certProviderFactory)
+	provider, err := NewLocalStoreTlsProvider(&encryptionSettings, metricsHandler.WithTags(metrics.OperationTag(metrics.ServerTlsScope)), logger, certProviderFactory)
+	if err != nil {
+		return nil, err
+	}
+	if encryptionSettings.Internode.SPIFFE.WorkloadAPIAddress != "" {
+		return newSPIFFETlsProvider(provider, &encryptionSettings.Internode.SPIFFE, logger)
+	}
+	return provider, nil
 }
 
 func validateRootTLS(cfg *config.RootTLS) error {
 	if err := validateGroupTLS(&cfg.Internode); err != nil {
 		return err
 	}
+	if err := validateSPIFFE(&cfg.Internode); err != nil {
+		return err
+	}
 	if err := validateGroupTLS(&cfg.Frontend); err != nil {
 		return err
 	}
+	if cfg.Frontend.SPIFFE.WorkloadAPIAddress != "" {
+		return fmt.Errorf("SPIFFE is only supported for internode TLS")
+	}
+	for _, remoteCluster := range cfg.RemoteClusters {
+		if remoteCluster.SPIFFE.WorkloadAPIAddress != "" {
+			return fmt.Errorf("SPIFFE is only supported for internode TLS")
+		}
+	}
 	return validateWorkerTLS(&cfg.SystemWorker)
 }
 
+func validateSPIFFE(cfg *config.GroupTLS) error {
+	if cfg.SPIFFE.WorkloadAPIAddress == "" {
+		if len(cfg.SPIFFE.ServiceIDs) > 0 {
+			return fmt.Errorf("SPIFFE service IDs require a Workload API address")
+		}
+		return nil
+	}
+	if cfg.IsServerEnabled() || cfg.IsClientEnabled() || cfg.Server.CertFile != "" || cfg.Server.CertData != "" {
+		return fmt.Errorf("cannot specify SPIFFE and certificates for internode TLS at the same time")
+	}
+	for service, id := range cfg.SPIFFE.ServiceIDs {
+		u, err := url.Parse(id)
+		if err != nil || u.Scheme != "spiffe" || u.Host == "" {
+			return fmt.Errorf("invalid SPIFFE ID %q for service %s", id, service)
+		}
+	}
+	return nil
+}
+
 func validateGroupTLS(cfg *config.GroupTLS) error {
 	if err := validateServerTLS(&cfg.Server); err != nil {
 		return err
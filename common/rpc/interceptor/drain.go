@@ -0,0 +1,172 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/common/headers"
+)
+
+var (
+	ErrServerDraining = serviceerror.NewUnavailable("frontend is shutting down, retry on another host")
+)
+
+type (
+	// DrainInterceptor refuses new long polls once the host starts draining, so that in-flight long polls
+	// can complete before the server stops, and hints clients when to retry through a retry-after header.
+	DrainInterceptor struct {
+		longPollMethods map[string]struct{}
+
+		sync.Mutex
+		draining      bool
+		retryAfter    time.Duration
+		longPollCount int
+		drained       chan struct{}
+	}
+)
+
+var _ grpc.UnaryServerInterceptor = (*DrainInterceptor)(nil).Intercept
+
+func NewDrainInterceptor(
+	longPollMethods map[string]struct{},
+) *DrainInterceptor {
+	return &DrainInterceptor{
+		longPollMethods: longPollMethods,
+		drained:         make(chan struct{}),
+	}
+}
+
+func (di *DrainInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	_, methodName := SplitMethodName(info.FullMethod)
+	longPoll := di.isLongPoll(methodName, req)
+
+	admitted, draining, retryAfter := di.admit(longPoll)
+	if draining {
+		// SetHeader fails only when the response headers have already been sent, in which case the
+		// hint is dropped and the client falls back to its own retry policy.
+		_ = grpc.SetHeader(ctx, metadata.Pairs(headers.RetryAfterHeaderName, strconv.FormatInt(retryAfter.Milliseconds(), 10)))
+	}
+	if !admitted {
+		return nil, ErrServerDraining
+	}
+	if longPoll {
+		defer di.release()
+	}
+
+	return handler(ctx, req)
+}
+
+// StartDraining makes the interceptor refuse new long polls and attach the retry-after hint to all responses
+func (di *DrainInterceptor) StartDraining(
+	retryAfter time.Duration,
+) {
+	di.Lock()
+	defer di.Unlock()
+
+	if di.draining {
+		return
+	}
+	di.draining = true
+	di.retryAfter = retryAfter
+	if di.longPollCount == 0 {
+		close(di.drained)
+	}
+}
+
+// WaitLongPolls blocks until all in-flight long polls are completed or the timeout expires,
+// and returns whether all long polls are completed. It must be called after StartDraining.
+func (di *DrainInterceptor) WaitLongPolls(
+	timeout time.Duration,
+) bool {
+	select {
+	case <-di.drained:
+		return true
+	default:
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-di.drained:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
+
+func (di *DrainInterceptor) admit(
+	longPoll bool,
+) (admitted bool, draining bool, retryAfter time.Duration) {
+	di.Lock()
+	defer di.Unlock()
+
+	if !longPoll {
+		return true, di.draining, di.retryAfter
+	}
+	if di.draining {
+		return false, true, di.retryAfter
+	}
+	di.longPollCount++
+	return true, false, 0
+}
+
+func (di *DrainInterceptor) release() {
+	di.Lock()
+	defer di.Unlock()
+
+	di.longPollCount--
+	if di.draining && di.longPollCount == 0 {
+		close(di.drained)
+	}
+}
+
+func (di *DrainInterceptor) isLongPoll(
+	methodName string,
+	req interface{},
+) bool {
+	if _, ok := di.longPollMethods[methodName]; !ok {
+		return false
+	}
+	// for GetWorkflowExecutionHistoryRequest, only requests waiting for new events are long polls
+	if historyReq, ok := req.(*workflowservice.GetWorkflowExecutionHistoryRequest); ok {
+		return historyReq.WaitNewEvent
+	}
+	return true
+}
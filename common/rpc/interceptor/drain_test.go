@@ -0,0 +1,96 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+)
+
+func TestDrainInterceptor(t *testing.T) {
+	interceptor := NewDrainInterceptor(map[string]struct{}{
+		"PollWorkflowTaskQueue":       {},
+		"GetWorkflowExecutionHistory": {},
+	})
+
+	pollStarted := make(chan struct{})
+	pollRelease := make(chan struct{})
+	pollDone := make(chan error)
+	go func() {
+		_, err := interceptor.Intercept(
+			context.Background(),
+			&workflowservice.PollWorkflowTaskQueueRequest{},
+			&grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/PollWorkflowTaskQueue"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				close(pollStarted)
+				<-pollRelease
+				return nil, nil
+			},
+		)
+		pollDone <- err
+	}()
+	<-pollStarted
+
+	interceptor.StartDraining(time.Second)
+
+	noopHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	intercept := func(method string, req interface{}) error {
+		_, err := interceptor.Intercept(
+			context.Background(),
+			req,
+			&grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/" + method},
+			noopHandler,
+		)
+		return err
+	}
+
+	// new long polls are refused
+	require.ErrorIs(t, intercept("PollWorkflowTaskQueue", &workflowservice.PollWorkflowTaskQueueRequest{}), ErrServerDraining)
+	require.ErrorIs(t, intercept("GetWorkflowExecutionHistory", &workflowservice.GetWorkflowExecutionHistoryRequest{WaitNewEvent: true}), ErrServerDraining)
+	// other requests are still served
+	require.NoError(t, intercept("GetWorkflowExecutionHistory", &workflowservice.GetWorkflowExecutionHistoryRequest{}))
+	require.NoError(t, intercept("StartWorkflowExecution", &workflowservice.StartWorkflowExecutionRequest{}))
+
+	// in-flight long poll is waited for
+	require.False(t, interceptor.WaitLongPolls(10*time.Millisecond))
+	close(pollRelease)
+	require.NoError(t, <-pollDone)
+	require.True(t, interceptor.WaitLongPolls(time.Second))
+}
+
+func TestDrainInterceptor_NoLongPolls(t *testing.T) {
+	interceptor := NewDrainInterceptor(map[string]struct{}{})
+
+	interceptor.StartDraining(time.Second)
+	interceptor.StartDraining(time.Second)
+	require.True(t, interceptor.WaitLongPolls(0))
+}
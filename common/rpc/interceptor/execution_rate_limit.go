@@ -0,0 +1,206 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	ExecutionRateLimitOperationSignal ExecutionRateLimitOperation = "signal"
+	ExecutionRateLimitOperationQuery  ExecutionRateLimitOperation = "query"
+	ExecutionRateLimitOperationUpdate ExecutionRateLimitOperation = "update"
+
+	executionRateLimiterTTL = time.Minute
+
+	executionRateLimitScopeNamespace = "namespace"
+	executionRateLimitScopeExecution = "workflow_execution"
+)
+
+var (
+	// executionRateLimitOperations maps API method names to the operation they are rate limited as
+	executionRateLimitOperations = map[string]ExecutionRateLimitOperation{
+		"SignalWorkflowExecution":          ExecutionRateLimitOperationSignal,
+		"SignalWithStartWorkflowExecution": ExecutionRateLimitOperationSignal,
+		"QueryWorkflow":                    ExecutionRateLimitOperationQuery,
+		"UpdateWorkflowExecution":          ExecutionRateLimitOperationUpdate,
+	}
+)
+
+type (
+	// ExecutionRateLimitOperation is the kind of workflow interaction limited by ExecutionRateLimitInterceptor
+	ExecutionRateLimitOperation string
+
+	// ExecutionRateFn returns the rate this frontend host allows for a namespace
+	ExecutionRateFn func(namespace string) float64
+
+	// ExecutionRateLimitInterceptor enforces per namespace and per workflow execution rate limits
+	// for signals, queries and updates. A rate of 0 disables the corresponding limit.
+	// Limits are enforced by each frontend host on its own, rate functions are expected to
+	// split cluster global limits among frontend hosts.
+	// Rejections carry a message and metric of their own. The API has no resource exhausted
+	// cause dedicated to these limits, so the namespace limit reports RPS_LIMIT and the
+	// workflow execution limit reports BUSY_WORKFLOW.
+	ExecutionRateLimitInterceptor struct {
+		namespaceRegistry namespace.Registry
+		metricsHandler    metrics.Handler
+		namespaceRPSFns   map[ExecutionRateLimitOperation]ExecutionRateFn
+		executionRPSFns   map[ExecutionRateLimitOperation]ExecutionRateFn
+
+		namespaceLimiters sync.Map // executionRateLimitKey -> quotas.RateLimiter
+		executionLimiters cache.Cache
+	}
+
+	executionRateLimitKey struct {
+		namespace  namespace.Name
+		workflowID string
+		operation  ExecutionRateLimitOperation
+	}
+)
+
+var _ grpc.UnaryServerInterceptor = (*ExecutionRateLimitInterceptor)(nil).Intercept
+
+func NewExecutionRateLimitInterceptor(
+	namespaceRegistry namespace.Registry,
+	metricsHandler metrics.Handler,
+	namespaceRPSFns map[ExecutionRateLimitOperation]ExecutionRateFn,
+	executionRPSFns map[ExecutionRateLimitOperation]ExecutionRateFn,
+	executionLimiterCacheSize int,
+) *ExecutionRateLimitInterceptor {
+	return &ExecutionRateLimitInterceptor{
+		namespaceRegistry: namespaceRegistry,
+		metricsHandler:    metricsHandler,
+		namespaceRPSFns:   namespaceRPSFns,
+		executionRPSFns:   executionRPSFns,
+		// Limiters of busy executions are kept, recreating them would grant a full burst.
+		executionLimiters: cache.New(executionLimiterCacheSize, &cache.Options{
+			TTL:               executionRateLimiterTTL,
+			ExpireAfterAccess: true,
+		}),
+	}
+}
+
+func (ei *ExecutionRateLimitInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	_, methodName := SplitMethodName(info.FullMethod)
+	operation, ok := executionRateLimitOperations[methodName]
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	nsName := MustGetNamespaceName(ei.namespaceRegistry, req)
+	now := time.Now().UTC()
+
+	if rateFn, ok := ei.namespaceRPSFns[operation]; ok && rateFn(nsName.String()) > 0 {
+		key := executionRateLimitKey{namespace: nsName, operation: operation}
+		limiter, ok := ei.namespaceLimiters.Load(key)
+		if !ok {
+			limiter, _ = ei.namespaceLimiters.LoadOrStore(key, newExecutionRateLimiter(rateFn, nsName))
+		}
+		if !limiter.(quotas.RateLimiter).AllowN(now, 1) {
+			ei.recordRejection(nsName, operation, executionRateLimitScopeNamespace)
+			return nil, serviceerror.NewResourceExhausted(
+				enumspb.RESOURCE_EXHAUSTED_CAUSE_RPS_LIMIT,
+				fmt.Sprintf("namespace workflow %v rate limit exceeded", operation),
+			)
+		}
+	}
+
+	workflowID := getWorkflowID(req)
+	if rateFn, ok := ei.executionRPSFns[operation]; ok && workflowID != "" && rateFn(nsName.String()) > 0 {
+		key := executionRateLimitKey{namespace: nsName, workflowID: workflowID, operation: operation}
+		limiter := ei.executionLimiters.Get(key)
+		if limiter == nil {
+			var err error
+			limiter, err = ei.executionLimiters.PutIfNotExist(key, newExecutionRateLimiter(rateFn, nsName))
+			if err != nil {
+				return nil, err
+			}
+		}
+		if !limiter.(quotas.RateLimiter).AllowN(now, 1) {
+			ei.recordRejection(nsName, operation, executionRateLimitScopeExecution)
+			return nil, serviceerror.NewResourceExhausted(
+				enumspb.RESOURCE_EXHAUSTED_CAUSE_BUSY_WORKFLOW,
+				fmt.Sprintf("workflow execution %v rate limit exceeded", operation),
+			)
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+func (ei *ExecutionRateLimitInterceptor) recordRejection(
+	nsName namespace.Name,
+	operation ExecutionRateLimitOperation,
+	scope string,
+) {
+	ei.metricsHandler.Counter(metrics.ExecutionRateLimitExceeded.GetMetricName()).Record(
+		1,
+		metrics.NamespaceTag(nsName.String()),
+		metrics.RateLimitOperationTag(string(operation)),
+		metrics.RateLimitScopeTag(scope),
+	)
+}
+
+func newExecutionRateLimiter(
+	rateFn ExecutionRateFn,
+	nsName namespace.Name,
+) quotas.RateLimiter {
+	return quotas.NewDefaultIncomingRateLimiter(func() float64 {
+		return rateFn(nsName.String())
+	})
+}
+
+func getWorkflowID(req interface{}) string {
+	switch request := req.(type) {
+	case *workflowservice.SignalWorkflowExecutionRequest:
+		return request.GetWorkflowExecution().GetWorkflowId()
+	case *workflowservice.SignalWithStartWorkflowExecutionRequest:
+		return request.GetWorkflowId()
+	case *workflowservice.QueryWorkflowRequest:
+		return request.GetExecution().GetWorkflowId()
+	case *workflowservice.UpdateWorkflowExecutionRequest:
+		return request.GetWorkflowExecution().GetWorkflowId()
+	default:
+		return ""
+	}
+}
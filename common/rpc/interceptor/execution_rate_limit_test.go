@@ -0,0 +1,144 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+)
+
+type (
+	executionRateLimitSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller   *gomock.Controller
+		mockRegistry *namespace.MockRegistry
+	}
+)
+
+func TestExecutionRateLimitSuite(t *testing.T) {
+	suite.Run(t, &executionRateLimitSuite{})
+}
+
+func (s *executionRateLimitSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockRegistry = namespace.NewMockRegistry(s.controller)
+	s.mockRegistry.EXPECT().GetNamespace(gomock.Any()).Return(nil, nil).AnyTimes()
+}
+
+func (s *executionRateLimitSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *executionRateLimitSuite) newInterceptor(namespaceRPS float64, executionRPS float64) *ExecutionRateLimitInterceptor {
+	return NewExecutionRateLimitInterceptor(
+		s.mockRegistry,
+		metrics.NoopMetricsHandler,
+		map[ExecutionRateLimitOperation]ExecutionRateFn{
+			ExecutionRateLimitOperationSignal: func(string) float64 { return namespaceRPS },
+		},
+		map[ExecutionRateLimitOperation]ExecutionRateFn{
+			ExecutionRateLimitOperationSignal: func(string) float64 { return executionRPS },
+		},
+		100,
+	)
+}
+
+func (s *executionRateLimitSuite) signal(ei *ExecutionRateLimitInterceptor, workflowID string) error {
+	_, err := ei.Intercept(
+		context.Background(),
+		&workflowservice.SignalWorkflowExecutionRequest{
+			Namespace:         "test-namespace",
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: workflowID},
+		},
+		&grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		},
+	)
+	return err
+}
+
+func (s *executionRateLimitSuite) firstRejection(ei *ExecutionRateLimitInterceptor, workflowID string) *serviceerror.ResourceExhausted {
+	for i := 0; i < 100; i++ {
+		if err := s.signal(ei, workflowID); err != nil {
+			s.IsType(&serviceerror.ResourceExhausted{}, err)
+			return err.(*serviceerror.ResourceExhausted)
+		}
+	}
+	return nil
+}
+
+func (s *executionRateLimitSuite) TestNoLimit() {
+	ei := s.newInterceptor(0, 0)
+	s.Nil(s.firstRejection(ei, "wid"))
+}
+
+func (s *executionRateLimitSuite) TestNamespaceLimit() {
+	ei := s.newInterceptor(1, 0)
+	err := s.firstRejection(ei, "wid")
+	s.NotNil(err)
+	s.Equal(enumspb.RESOURCE_EXHAUSTED_CAUSE_RPS_LIMIT, err.Cause)
+}
+
+func (s *executionRateLimitSuite) TestExecutionLimit() {
+	ei := s.newInterceptor(0, 1)
+	err := s.firstRejection(ei, "wid-1")
+	s.NotNil(err)
+	s.Equal(enumspb.RESOURCE_EXHAUSTED_CAUSE_BUSY_WORKFLOW, err.Cause)
+
+	// other executions are not affected
+	s.NoError(s.signal(ei, "wid-2"))
+}
+
+func (s *executionRateLimitSuite) TestUnlimitedOperation() {
+	ei := s.newInterceptor(1, 1)
+	for i := 0; i < 100; i++ {
+		_, err := ei.Intercept(
+			context.Background(),
+			&workflowservice.StartWorkflowExecutionRequest{Namespace: "test-namespace", WorkflowId: "wid"},
+			&grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, nil
+			},
+		)
+		s.NoError(err)
+	}
+}
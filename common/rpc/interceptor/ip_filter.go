@@ -0,0 +1,230 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	ipFilterAllowKey = "allow"
+	ipFilterDenyKey  = "deny"
+)
+
+var (
+	ErrPeerAddressNotAllowed = serviceerror.NewPermissionDenied("peer address is not allowed", "")
+
+	adminServicePrefixes = []string{
+		"/temporal.api.operatorservice.v1.OperatorService/",
+		"/temporal.server.api.adminservice.v1.AdminService/",
+	}
+)
+
+type (
+	// RejectionRecorder records calls rejected by an interceptor, e.g. to the audit log.
+	RejectionRecorder interface {
+		RecordRejection(ctx context.Context, req interface{}, fullMethod string, err error)
+	}
+
+	// IPFilterInterceptor rejects calls from peers whose address is not allowed by the filter of the
+	// namespace of the request, or by the cluster-wide filter for operator and admin APIs.
+	// A filter is a map with "allow" and "deny" lists of IP addresses and CIDR blocks. Denied addresses
+	// are rejected, and if the allow list is not empty, only the addresses it contains are accepted.
+	// The address is the one of the connection, so peers behind a proxy share the address of the proxy.
+	IPFilterInterceptor struct {
+		namespaceFilter dynamicconfig.MapPropertyFnWithNamespaceFilter
+		adminFilter     dynamicconfig.MapPropertyFn
+		recorder        RejectionRecorder
+		throttledLogger log.Logger
+	}
+
+	ipFilter struct {
+		allow []*net.IPNet
+		deny  []*net.IPNet
+	}
+)
+
+var _ grpc.UnaryServerInterceptor = (*IPFilterInterceptor)(nil).Intercept
+
+func NewIPFilterInterceptor(
+	namespaceFilter dynamicconfig.MapPropertyFnWithNamespaceFilter,
+	adminFilter dynamicconfig.MapPropertyFn,
+	recorder RejectionRecorder,
+	logger log.Logger,
+) *IPFilterInterceptor {
+	return &IPFilterInterceptor{
+		namespaceFilter: namespaceFilter,
+		adminFilter:     adminFilter,
+		recorder:        recorder,
+		throttledLogger: log.NewThrottledLogger(logger, func() float64 { return 1 }),
+	}
+}
+
+func (i *IPFilterInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	var namespaceName string
+	if r, ok := req.(NamespaceNameGetter); ok {
+		namespaceName = r.GetNamespace()
+	}
+
+	if namespaceName != "" && !i.allowed(ctx, i.namespaceFilter(namespaceName)) ||
+		isAdminMethod(info.FullMethod) && !i.allowed(ctx, i.adminFilter()) {
+		if i.recorder != nil {
+			i.recorder.RecordRejection(ctx, req, info.FullMethod, ErrPeerAddressNotAllowed)
+		}
+		return nil, ErrPeerAddressNotAllowed
+	}
+	return handler(ctx, req)
+}
+
+// allowed returns whether the peer of ctx is allowed by the filter value. Invalid filters reject all peers.
+func (i *IPFilterInterceptor) allowed(
+	ctx context.Context,
+	value map[string]any,
+) bool {
+	if len(value) == 0 {
+		return true
+	}
+	filter, err := parseIPFilter(value)
+	if err != nil {
+		i.throttledLogger.Error("Invalid IP filter, rejecting all peers", tag.Error(err))
+		return false
+	}
+	ip := peerIP(ctx)
+	if ip == nil {
+		return false
+	}
+	return filter.allows(ip)
+}
+
+func (f *ipFilter) allows(ip net.IP) bool {
+	for _, network := range f.deny {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	if len(f.allow) == 0 {
+		return true
+	}
+	for _, network := range f.allow {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseIPFilter(value map[string]any) (*ipFilter, error) {
+	filter := &ipFilter{}
+	for key, entries := range value {
+		networks, err := parseNetworks(entries)
+		if err != nil {
+			return nil, err
+		}
+		switch key {
+		case ipFilterAllowKey:
+			filter.allow = networks
+		case ipFilterDenyKey:
+			filter.deny = networks
+		default:
+			return nil, fmt.Errorf("unknown IP filter key %q", key)
+		}
+	}
+	return filter, nil
+}
+
+func parseNetworks(entries any) ([]*net.IPNet, error) {
+	list, ok := entries.([]any)
+	if !ok {
+		return nil, fmt.Errorf("IP filter entries must be a list, got %T", entries)
+	}
+	networks := make([]*net.IPNet, 0, len(list))
+	for _, entry := range list {
+		s, ok := entry.(string)
+		if !ok {
+			return nil, fmt.Errorf("IP filter entry must be a string, got %T", entry)
+		}
+		if !strings.Contains(s, "/") {
+			ip := net.ParseIP(s)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid IP address %q", s)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 8 * net.IPv4len
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, err
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+func peerIP(ctx context.Context) net.IP {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return nil
+	}
+	switch addr := p.Addr.(type) {
+	case *net.TCPAddr:
+		return addr.IP
+	default:
+		host, _, err := net.SplitHostPort(addr.String())
+		if err != nil {
+			return nil
+		}
+		return net.ParseIP(host)
+	}
+}
+
+func isAdminMethod(fullMethod string) bool {
+	for _, prefix := range adminServicePrefixes {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return true
+		}
+	}
+	return false
+}
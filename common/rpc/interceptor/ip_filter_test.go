@@ -0,0 +1,113 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"go.temporal.io/server/common/log"
+)
+
+type recordedRejections struct {
+	methods []string
+}
+
+func (r *recordedRejections) RecordRejection(_ context.Context, _ interface{}, fullMethod string, _ error) {
+	r.methods = append(r.methods, fullMethod)
+}
+
+func TestIPFilterInterceptor(t *testing.T) {
+	namespaceFilters := map[string]map[string]any{
+		"allow-list": {"allow": []any{"10.0.0.0/8", "192.0.2.7"}},
+		"deny-list":  {"deny": []any{"10.1.0.0/16"}},
+		"both":       {"allow": []any{"10.0.0.0/8"}, "deny": []any{"10.1.0.0/16"}},
+		"invalid":    {"allow": []any{"not-an-ip"}},
+	}
+	adminFilter := map[string]any{"allow": []any{"172.16.0.0/12", "2001:db8::/32"}}
+	recorder := &recordedRejections{}
+	interceptor := NewIPFilterInterceptor(
+		func(namespace string) map[string]any { return namespaceFilters[namespace] },
+		func() map[string]any { return adminFilter },
+		recorder,
+		log.NewNoopLogger(),
+	)
+
+	intercept := func(addr string, fullMethod string, req interface{}) error {
+		ctx := context.Background()
+		if addr != "" {
+			tcpAddr, err := net.ResolveTCPAddr("tcp", addr)
+			require.NoError(t, err)
+			ctx = peer.NewContext(ctx, &peer.Peer{Addr: tcpAddr})
+		}
+		_, err := interceptor.Intercept(ctx, req, &grpc.UnaryServerInfo{FullMethod: fullMethod},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, nil
+			},
+		)
+		return err
+	}
+	startWorkflow := func(namespace string) *workflowservice.StartWorkflowExecutionRequest {
+		return &workflowservice.StartWorkflowExecutionRequest{Namespace: namespace}
+	}
+	const startWorkflowAPI = "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"
+	const addSearchAttributesAPI = "/temporal.api.operatorservice.v1.OperatorService/AddSearchAttributes"
+
+	// namespaces without filter accept all peers
+	require.NoError(t, intercept("203.0.113.1:1234", startWorkflowAPI, startWorkflow("unfiltered")))
+	require.NoError(t, intercept("", startWorkflowAPI, startWorkflow("unfiltered")))
+
+	require.NoError(t, intercept("10.2.3.4:1234", startWorkflowAPI, startWorkflow("allow-list")))
+	require.NoError(t, intercept("192.0.2.7:1234", startWorkflowAPI, startWorkflow("allow-list")))
+	require.ErrorIs(t, intercept("192.0.2.8:1234", startWorkflowAPI, startWorkflow("allow-list")), ErrPeerAddressNotAllowed)
+	require.ErrorIs(t, intercept("", startWorkflowAPI, startWorkflow("allow-list")), ErrPeerAddressNotAllowed)
+
+	require.NoError(t, intercept("203.0.113.1:1234", startWorkflowAPI, startWorkflow("deny-list")))
+	require.ErrorIs(t, intercept("10.1.2.3:1234", startWorkflowAPI, startWorkflow("deny-list")), ErrPeerAddressNotAllowed)
+
+	// deny takes precedence over allow
+	require.NoError(t, intercept("10.2.3.4:1234", startWorkflowAPI, startWorkflow("both")))
+	require.ErrorIs(t, intercept("10.1.2.3:1234", startWorkflowAPI, startWorkflow("both")), ErrPeerAddressNotAllowed)
+
+	// invalid filters reject all peers
+	require.ErrorIs(t, intercept("10.2.3.4:1234", startWorkflowAPI, startWorkflow("invalid")), ErrPeerAddressNotAllowed)
+
+	// admin APIs are filtered cluster-wide, in addition to the namespace filter
+	require.NoError(t, intercept("172.16.0.1:1234", addSearchAttributesAPI, &operatorservice.AddSearchAttributesRequest{}))
+	require.NoError(t, intercept("[2001:db8::1]:1234", addSearchAttributesAPI, &operatorservice.AddSearchAttributesRequest{}))
+	require.ErrorIs(t, intercept("10.2.3.4:1234", addSearchAttributesAPI, &operatorservice.AddSearchAttributesRequest{}), ErrPeerAddressNotAllowed)
+	require.ErrorIs(t, intercept("172.16.0.1:1234", addSearchAttributesAPI, &operatorservice.AddSearchAttributesRequest{Namespace: "allow-list"}), ErrPeerAddressNotAllowed)
+	require.NoError(t, intercept("10.2.3.4:1234", startWorkflowAPI, startWorkflow("unfiltered")))
+
+	require.Len(t, recorder.methods, 7)
+	require.Equal(t, addSearchAttributesAPI, recorder.methods[6])
+}
@@ -0,0 +1,128 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common/clock"
+)
+
+const (
+	// NamespaceActionWindow is the window over which the action rate of a namespace is computed
+	NamespaceActionWindow = time.Hour
+
+	namespaceActionBucketSize = time.Minute
+	namespaceActionBuckets    = int64(NamespaceActionWindow / namespaceActionBucketSize)
+)
+
+type (
+	// NamespaceActionTracker counts the actions a frontend host serves for each namespace over the last hour,
+	// in one minute buckets. Recording takes no lock, so it is cheap enough to do on every request.
+	NamespaceActionTracker struct {
+		timeSource clock.TimeSource
+		startTime  time.Time
+
+		// namespaces maps a namespace name to its *namespaceActions
+		namespaces sync.Map
+	}
+
+	namespaceActions [namespaceActionBuckets]namespaceActionBucket
+
+	namespaceActionBucket struct {
+		// bucket is the index since the unix epoch of the bucket the count belongs to
+		bucket atomic.Int64
+		count  atomic.Int64
+	}
+)
+
+func NewNamespaceActionTracker(
+	timeSource clock.TimeSource,
+) *NamespaceActionTracker {
+	return &NamespaceActionTracker{
+		timeSource: timeSource,
+		startTime:  timeSource.Now(),
+	}
+}
+
+// Record records an action served for the namespace.
+func (t *NamespaceActionTracker) Record(namespace string) {
+	if namespace == "" {
+		return
+	}
+
+	value, ok := t.namespaces.Load(namespace)
+	if !ok {
+		value, _ = t.namespaces.LoadOrStore(namespace, &namespaceActions{})
+	}
+	current := t.currentBucket()
+	bucket := &value.(*namespaceActions)[current%namespaceActionBuckets]
+	for {
+		previous := bucket.bucket.Load()
+		if previous >= current {
+			break
+		}
+		if bucket.bucket.CompareAndSwap(previous, current) {
+			// Actions recorded by concurrent callers between the swap and the reset are dropped,
+			// which is acceptable for a rate estimate.
+			bucket.count.Store(0)
+			break
+		}
+	}
+	bucket.count.Add(1)
+}
+
+// Rate returns the average number of actions per second served for the namespace over the last hour,
+// or since the tracker was created if that is more recent.
+func (t *NamespaceActionTracker) Rate(namespace string) float64 {
+	value, ok := t.namespaces.Load(namespace)
+	if !ok {
+		return 0
+	}
+
+	current := t.currentBucket()
+	var count int64
+	for i := range value.(*namespaceActions) {
+		bucket := &value.(*namespaceActions)[i]
+		if current-bucket.bucket.Load() < namespaceActionBuckets {
+			count += bucket.count.Load()
+		}
+	}
+
+	elapsed := t.timeSource.Now().Sub(t.startTime)
+	if elapsed > NamespaceActionWindow {
+		elapsed = NamespaceActionWindow
+	}
+	if elapsed < time.Second {
+		elapsed = time.Second
+	}
+	return float64(count) / elapsed.Seconds()
+}
+
+func (t *NamespaceActionTracker) currentBucket() int64 {
+	return t.timeSource.Now().UnixNano() / int64(namespaceActionBucketSize)
+}
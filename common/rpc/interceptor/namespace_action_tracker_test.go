@@ -0,0 +1,63 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.temporal.io/server/common/clock"
+)
+
+func TestNamespaceActionTracker_Rate(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Unix(0, 0))
+	tracker := NewNamespaceActionTracker(timeSource)
+
+	assert.Zero(t, tracker.Rate("ns-1"))
+
+	// rates are averaged over the time since the tracker was created until it covers a full window
+	for i := 0; i < 30; i++ {
+		tracker.Record("ns-1")
+	}
+	tracker.Record("ns-2")
+	tracker.Record("")
+	timeSource.Update(time.Unix(30, 0))
+	assert.Equal(t, 1.0, tracker.Rate("ns-1"))
+	assert.Equal(t, 1.0/30, tracker.Rate("ns-2"))
+
+	timeSource.Update(time.Unix(0, 0).Add(NamespaceActionWindow - time.Minute))
+	for i := 0; i < 36; i++ {
+		tracker.Record("ns-1")
+	}
+	assert.Equal(t, 66.0/(NamespaceActionWindow-time.Minute).Seconds(), tracker.Rate("ns-1"))
+
+	// actions recorded more than an hour ago age out, and their bucket is reused
+	timeSource.Update(time.Unix(0, 0).Add(NamespaceActionWindow))
+	tracker.Record("ns-1")
+	assert.Equal(t, 37.0/3600, tracker.Rate("ns-1"))
+	assert.Zero(t, tracker.Rate("ns-2"))
+}
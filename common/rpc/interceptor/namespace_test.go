@@ -60,16 +60,19 @@ var (
 	}
 
 	historyAPIExcluded = map[string]struct{}{
-		"CloseShard":                {},
-		"GetShard":                  {},
-		"GetDLQMessages":            {},
-		"GetDLQReplicationMessages": {},
-		"GetReplicationMessages":    {},
-		"MergeDLQMessages":          {},
-		"PurgeDLQMessages":          {},
-		"RemoveTask":                {},
-		"SyncShardStatus":           {},
-		"GetReplicationStatus":      {},
+		"CloseShard":                  {},
+		"GetShard":                    {},
+		"DescribeVisibilityIngestion": {},
+		"AwaitVisibilityIngestion":    {},
+		"DescribeShard":               {},
+		"GetDLQMessages":              {},
+		"GetDLQReplicationMessages":   {},
+		"GetReplicationMessages":      {},
+		"MergeDLQMessages":            {},
+		"PurgeDLQMessages":            {},
+		"RemoveTask":                  {},
+		"SyncShardStatus":             {},
+		"GetReplicationStatus":        {},
 	}
 )
 
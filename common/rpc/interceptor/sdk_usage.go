@@ -0,0 +1,213 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/blang/semver/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+)
+
+const (
+	defaultMaxSDKUsageKeys = 10000
+
+	// sdkUsageOtherTagValue replaces SDK names and versions the metric tags can't hold
+	sdkUsageOtherTagValue = "other"
+)
+
+// knownSDKNames are the SDK names reported as-is in metric tags, the others are reported as "other"
+var knownSDKNames = map[string]struct{}{
+	headers.ClientNameGoSDK:         {},
+	headers.ClientNameJavaSDK:       {},
+	headers.ClientNamePHPSDK:        {},
+	headers.ClientNameTypeScriptSDK: {},
+	headers.ClientNamePythonSDK:     {},
+	headers.ClientNameCLI:           {},
+	headers.ClientNameUI:            {},
+	headers.ClientNameServer:        {},
+}
+
+type (
+	// SDKUsageKey identifies a single bucket of SDK usage counts
+	SDKUsageKey struct {
+		Method     string
+		SDKName    string
+		SDKVersion string
+		Namespace  string
+	}
+
+	// SDKUsageInterceptor counts frontend requests by API method, SDK name/version and namespace,
+	// and attaches a warning header to responses sent to deprecated SDK versions.
+	SDKUsageInterceptor struct {
+		namespaceRegistry  namespace.Registry
+		logger             log.Logger
+		deprecatedVersions dynamicconfig.MapPropertyFn
+		maxKeys            int
+
+		sync.Mutex
+		usage map[SDKUsageKey]int64
+
+		// parsedRanges caches parsed deprecated version ranges, keyed by their raw string value
+		parsedRanges sync.Map
+	}
+)
+
+var _ grpc.UnaryServerInterceptor = (*SDKUsageInterceptor)(nil).Intercept
+
+func NewSDKUsageInterceptor(
+	namespaceRegistry namespace.Registry,
+	logger log.Logger,
+	deprecatedVersions dynamicconfig.MapPropertyFn,
+) *SDKUsageInterceptor {
+	return &SDKUsageInterceptor{
+		namespaceRegistry:  namespaceRegistry,
+		logger:             logger,
+		deprecatedVersions: deprecatedVersions,
+		maxKeys:            defaultMaxSDKUsageKeys,
+		usage:              make(map[SDKUsageKey]int64),
+	}
+}
+
+func (si *SDKUsageInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	sdkName, sdkVersion := headers.GetClientNameAndVersion(ctx)
+	if sdkName == "" || sdkVersion == "" {
+		return handler(ctx, req)
+	}
+
+	_, methodName := SplitMethodName(info.FullMethod)
+	nsName := MustGetNamespaceName(si.namespaceRegistry, req)
+	si.record(SDKUsageKey{
+		Method:     methodName,
+		SDKName:    sdkName,
+		SDKVersion: sdkVersion,
+		Namespace:  nsName.String(),
+	})
+
+	metricsHandler := GetMetricsHandlerFromContext(ctx, si.logger).WithTags(
+		metrics.SDKNameTag(sdkNameTagValue(sdkName)),
+		metrics.SDKVersionTag(sdkVersionTagValue(sdkVersion)),
+	)
+	metricsHandler.Counter(metrics.SDKRequests.GetMetricName()).Record(1)
+
+	if deprecatedRange, ok := si.deprecatedRange(sdkName, sdkVersion); ok {
+		metricsHandler.Counter(metrics.SDKDeprecatedRequests.GetMetricName()).Record(1)
+		warning := fmt.Sprintf("%s version %s is deprecated (deprecated versions: %s), please upgrade", sdkName, sdkVersion, deprecatedRange)
+		// SetHeader fails only when the response headers have already been sent, in which case the
+		// warning is dropped but the request still goes through.
+		_ = grpc.SetHeader(ctx, metadata.Pairs(headers.SDKDeprecationWarningHeaderName, warning))
+	}
+
+	return handler(ctx, req)
+}
+
+// GetSDKUsage returns a snapshot of request counts recorded since startup
+func (si *SDKUsageInterceptor) GetSDKUsage() map[SDKUsageKey]int64 {
+	si.Lock()
+	defer si.Unlock()
+
+	usage := make(map[SDKUsageKey]int64, len(si.usage))
+	for k, v := range si.usage {
+		usage[k] = v
+	}
+	return usage
+}
+
+func (si *SDKUsageInterceptor) record(key SDKUsageKey) {
+	si.Lock()
+	defer si.Unlock()
+
+	if _, ok := si.usage[key]; !ok && len(si.usage) >= si.maxKeys {
+		return
+	}
+	si.usage[key]++
+}
+
+// sdkNameTagValue bounds the SDK names metrics are tagged with to the known SDKs
+func sdkNameTagValue(sdkName string) string {
+	if _, ok := knownSDKNames[sdkName]; ok {
+		return sdkName
+	}
+	return sdkUsageOtherTagValue
+}
+
+// sdkVersionTagValue bounds the SDK versions metrics are tagged with to their major and minor version
+func sdkVersionTagValue(sdkVersion string) string {
+	version, err := semver.ParseTolerant(sdkVersion)
+	if err != nil {
+		return sdkUsageOtherTagValue
+	}
+	return fmt.Sprintf("%d.%d", version.Major, version.Minor)
+}
+
+// deprecatedRange returns the configured deprecated version range for the given SDK, if the version falls into it
+func (si *SDKUsageInterceptor) deprecatedRange(sdkName, sdkVersion string) (string, bool) {
+	rawRange, ok := si.deprecatedVersions()[sdkName].(string)
+	if !ok || rawRange == "" {
+		return "", false
+	}
+
+	versionRange, ok := si.parseRange(rawRange)
+	if !ok {
+		return "", false
+	}
+	version, err := semver.Parse(sdkVersion)
+	if err != nil {
+		return "", false
+	}
+	return rawRange, versionRange(version)
+}
+
+func (si *SDKUsageInterceptor) parseRange(rawRange string) (semver.Range, bool) {
+	if cached, ok := si.parsedRanges.Load(rawRange); ok {
+		versionRange, ok := cached.(semver.Range)
+		return versionRange, ok
+	}
+
+	versionRange, err := semver.ParseRange(rawRange)
+	if err != nil {
+		si.logger.Warn("Unable to parse deprecated SDK version range", tag.Value(rawRange), tag.Error(err))
+		// cache the failure as well so the warning is only logged once per value
+		si.parsedRanges.Store(rawRange, err)
+		return nil, false
+	}
+	si.parsedRanges.Store(rawRange, versionRange)
+	return versionRange, true
+}
@@ -0,0 +1,113 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+)
+
+func TestSDKUsageRecorder(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	mockRegistry := namespace.NewMockRegistry(controller)
+	mockRegistry.EXPECT().GetNamespace(gomock.Any()).Return(nil, nil).AnyTimes()
+
+	interceptor := NewSDKUsageInterceptor(
+		mockRegistry,
+		log.NewNoopLogger(),
+		dynamicconfig.GetMapPropertyFn(map[string]any{}),
+	)
+	interceptor.maxKeys = 2
+
+	noopHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	intercept := func(sdkName, sdkVersion, method string) {
+		ctx := headers.SetVersionsForTests(context.Background(), sdkVersion, sdkName, headers.SupportedServerVersions, headers.AllFeatures)
+		_, err := interceptor.Intercept(
+			ctx,
+			&workflowservice.StartWorkflowExecutionRequest{Namespace: "test-namespace"},
+			&grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/" + method},
+			noopHandler,
+		)
+		require.NoError(t, err)
+	}
+
+	intercept(headers.ClientNameGoSDK, "1.10.1", "StartWorkflowExecution")
+	intercept(headers.ClientNameGoSDK, "1.10.1", "StartWorkflowExecution")
+	intercept(headers.ClientNameJavaSDK, "1.17.0", "StartWorkflowExecution")
+	// over capacity, not recorded
+	intercept(headers.ClientNameGoSDK, "1.10.1", "SignalWorkflowExecution")
+	// missing SDK version, not recorded
+	intercept(headers.ClientNameGoSDK, "", "StartWorkflowExecution")
+
+	require.Equal(t, map[SDKUsageKey]int64{
+		{Method: "StartWorkflowExecution", SDKName: headers.ClientNameGoSDK, SDKVersion: "1.10.1", Namespace: "test-namespace"}:   2,
+		{Method: "StartWorkflowExecution", SDKName: headers.ClientNameJavaSDK, SDKVersion: "1.17.0", Namespace: "test-namespace"}: 1,
+	}, interceptor.GetSDKUsage())
+}
+
+func TestSDKUsageDeprecatedRange(t *testing.T) {
+	interceptor := NewSDKUsageInterceptor(
+		nil,
+		log.NewNoopLogger(),
+		dynamicconfig.GetMapPropertyFn(map[string]any{
+			headers.ClientNameGoSDK:   "<1.20.0",
+			headers.ClientNameJavaSDK: "not a range",
+		}),
+	)
+
+	_, deprecated := interceptor.deprecatedRange(headers.ClientNameGoSDK, "1.19.3")
+	require.True(t, deprecated)
+	_, deprecated = interceptor.deprecatedRange(headers.ClientNameGoSDK, "1.20.0")
+	require.False(t, deprecated)
+	_, deprecated = interceptor.deprecatedRange(headers.ClientNameGoSDK, "not a version")
+	require.False(t, deprecated)
+	_, deprecated = interceptor.deprecatedRange(headers.ClientNameJavaSDK, "1.0.0")
+	require.False(t, deprecated)
+	_, deprecated = interceptor.deprecatedRange(headers.ClientNameTypeScriptSDK, "1.0.0")
+	require.False(t, deprecated)
+}
+
+func TestSDKUsageTagValues(t *testing.T) {
+	require.Equal(t, headers.ClientNameGoSDK, sdkNameTagValue(headers.ClientNameGoSDK))
+	require.Equal(t, sdkUsageOtherTagValue, sdkNameTagValue("my-custom-client"))
+
+	require.Equal(t, "1.22", sdkVersionTagValue("1.22.1"))
+	require.Equal(t, "1.22", sdkVersionTagValue("1.22.1-rc.2+build.7"))
+	require.Equal(t, "0.9", sdkVersionTagValue("v0.9"))
+	require.Equal(t, sdkUsageOtherTagValue, sdkVersionTagValue("not a version"))
+}
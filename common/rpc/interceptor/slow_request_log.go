@@ -0,0 +1,288 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	slowRequestDefaultThresholdKey = "*"
+	slowRequestRedactedValue       = "<redacted>"
+	slowRequestTruncatedSuffix     = "...<truncated>"
+)
+
+type (
+	// SlowRequestLogInterceptor logs a structured entry for calls slower than the latency threshold of
+	// their API. Thresholds are a map from API name to duration, and the "*" entry applies to the APIs
+	// that are not listed, except long polls which only use their own entry. A non-positive threshold
+	// disables the log for the API. A sample of the slow requests is also logged with a summary of the
+	// request, with payload data redacted and truncated to a max size.
+	SlowRequestLogInterceptor struct {
+		thresholds        dynamicconfig.MapPropertyFn
+		summarySampleRate dynamicconfig.FloatPropertyFn
+		summaryMaxSize    dynamicconfig.IntPropertyFn
+		longPollMethods   map[string]struct{}
+		encoder           *codec.JSONPBEncoder
+		logger            log.Logger
+		throttledLogger   log.Logger
+	}
+
+	workflowIDGetter interface {
+		GetWorkflowId() string
+	}
+
+	executionGetter interface {
+		GetExecution() *commonpb.WorkflowExecution
+	}
+
+	workflowExecutionGetter interface {
+		GetWorkflowExecution() *commonpb.WorkflowExecution
+	}
+)
+
+var _ grpc.UnaryServerInterceptor = (*SlowRequestLogInterceptor)(nil).Intercept
+
+func NewSlowRequestLogInterceptor(
+	thresholds dynamicconfig.MapPropertyFn,
+	summarySampleRate dynamicconfig.FloatPropertyFn,
+	summaryMaxSize dynamicconfig.IntPropertyFn,
+	longPollMethods map[string]struct{},
+	logger log.Logger,
+) *SlowRequestLogInterceptor {
+	return &SlowRequestLogInterceptor{
+		thresholds:        thresholds,
+		summarySampleRate: summarySampleRate,
+		summaryMaxSize:    summaryMaxSize,
+		longPollMethods:   longPollMethods,
+		encoder:           codec.NewJSONPBEncoder(),
+		logger:            logger,
+		throttledLogger:   log.NewThrottledLogger(logger, func() float64 { return 1 }),
+	}
+}
+
+func (i *SlowRequestLogInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	thresholds := i.thresholds()
+	if len(thresholds) == 0 {
+		return handler(ctx, req)
+	}
+
+	startTime := time.Now()
+	resp, err := handler(ctx, req)
+	latency := time.Since(startTime)
+
+	_, methodName := SplitMethodName(info.FullMethod)
+	threshold := i.threshold(thresholds, methodName, req)
+	if threshold <= 0 || latency < threshold {
+		return resp, err
+	}
+	i.logger.Warn("Slow request", i.logTags(ctx, req, methodName, latency, threshold, err)...)
+	return resp, err
+}
+
+// threshold returns the latency threshold of the API, or 0 if slow calls to the API are not logged.
+func (i *SlowRequestLogInterceptor) threshold(
+	thresholds map[string]any,
+	methodName string,
+	req interface{},
+) time.Duration {
+	value, ok := thresholds[methodName]
+	if !ok {
+		if i.isLongPoll(methodName, req) {
+			return 0
+		}
+		if value, ok = thresholds[slowRequestDefaultThresholdKey]; !ok {
+			return 0
+		}
+	}
+	threshold, err := parseSlowRequestThreshold(value)
+	if err != nil {
+		i.throttledLogger.Error("Invalid slow request log threshold", tag.Operation(methodName), tag.Error(err))
+		return 0
+	}
+	return threshold
+}
+
+func (i *SlowRequestLogInterceptor) isLongPoll(
+	methodName string,
+	req interface{},
+) bool {
+	if _, ok := i.longPollMethods[methodName]; !ok {
+		return false
+	}
+	// for GetWorkflowExecutionHistoryRequest, only requests waiting for new events are long polls
+	if historyReq, ok := req.(*workflowservice.GetWorkflowExecutionHistoryRequest); ok {
+		return historyReq.WaitNewEvent
+	}
+	return true
+}
+
+func (i *SlowRequestLogInterceptor) logTags(
+	ctx context.Context,
+	req interface{},
+	methodName string,
+	latency time.Duration,
+	threshold time.Duration,
+	err error,
+) []tag.Tag {
+	tags := []tag.Tag{
+		tag.Operation(methodName),
+		tag.NewDurationTag("latency", latency),
+		tag.NewDurationTag("threshold", threshold),
+	}
+	if r, ok := req.(NamespaceNameGetter); ok && r.GetNamespace() != "" {
+		tags = append(tags, tag.WorkflowNamespace(r.GetNamespace()))
+	}
+	if r, ok := req.(NamespaceIDGetter); ok && r.GetNamespaceId() != "" {
+		tags = append(tags, tag.WorkflowNamespaceID(r.GetNamespaceId()))
+	}
+	var execution *commonpb.WorkflowExecution
+	switch r := req.(type) {
+	case executionGetter:
+		execution = r.GetExecution()
+	case workflowExecutionGetter:
+		execution = r.GetWorkflowExecution()
+	case workflowIDGetter:
+		execution = &commonpb.WorkflowExecution{WorkflowId: r.GetWorkflowId()}
+	}
+	if execution.GetWorkflowId() != "" {
+		tags = append(tags, tag.WorkflowID(execution.GetWorkflowId()))
+	}
+	if execution.GetRunId() != "" {
+		tags = append(tags, tag.WorkflowRunID(execution.GetRunId()))
+	}
+	if callerName := headers.GetCallerInfo(ctx).CallerName; callerName != "" {
+		tags = append(tags, tag.NewStringTag("caller-name", callerName))
+	}
+	if err != nil {
+		tags = append(tags, tag.NewStringTag("status-code", serviceerror.ToStatus(err).Code().String()), tag.Error(err))
+	}
+	if rand.Float64() < i.summarySampleRate() {
+		if summary := i.summarize(req, i.summaryMaxSize()); summary != "" {
+			tags = append(tags, tag.NewStringTag("request-summary", summary))
+		}
+	}
+	return tags
+}
+
+// summarize encodes the request as JSON with payload data redacted, truncated to maxSize bytes.
+func (i *SlowRequestLogInterceptor) summarize(
+	req interface{},
+	maxSize int,
+) string {
+	msg, ok := req.(proto.Message)
+	if !ok || maxSize <= 0 {
+		return ""
+	}
+	data, err := i.encoder.Encode(msg)
+	if err != nil {
+		return ""
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return ""
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(redactPayloadData(value)); err != nil {
+		return ""
+	}
+	data = bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+	if len(data) > maxSize {
+		return string(data[:maxSize]) + slowRequestTruncatedSuffix
+	}
+	return string(data)
+}
+
+// redactPayloadData replaces the data of the JSON encoded common.v1.Payload values.
+func redactPayloadData(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if isPayloadJSON(v) {
+			v["data"] = slowRequestRedactedValue
+			return v
+		}
+		for key, child := range v {
+			v[key] = redactPayloadData(child)
+		}
+	case []interface{}:
+		for idx, child := range v {
+			v[idx] = redactPayloadData(child)
+		}
+	}
+	return value
+}
+
+func isPayloadJSON(v map[string]interface{}) bool {
+	if _, ok := v["data"]; !ok {
+		return false
+	}
+	for key := range v {
+		if key != "data" && key != "metadata" {
+			return false
+		}
+	}
+	return true
+}
+
+// parseSlowRequestThreshold parses a duration string, or a number of seconds.
+func parseSlowRequestThreshold(value any) (time.Duration, error) {
+	switch v := value.(type) {
+	case time.Duration:
+		return v, nil
+	case int:
+		return time.Duration(v) * time.Second, nil
+	case float64:
+		return time.Duration(v * float64(time.Second)), nil
+	case string:
+		return timestamp.ParseDurationDefaultSeconds(v)
+	default:
+		return 0, fmt.Errorf("slow request log threshold must be a duration, got %T", value)
+	}
+}
@@ -0,0 +1,131 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+)
+
+func TestSlowRequestLogInterceptor_Threshold(t *testing.T) {
+	interceptor := NewSlowRequestLogInterceptor(
+		dynamicconfig.GetMapPropertyFn(nil),
+		dynamicconfig.GetFloatPropertyFn(0),
+		dynamicconfig.GetIntPropertyFn(0),
+		map[string]struct{}{"PollWorkflowTaskQueue": {}, "GetWorkflowExecutionHistory": {}},
+		log.NewNoopLogger(),
+	)
+	thresholds := map[string]any{
+		"StartWorkflowExecution":  "500ms",
+		"SignalWorkflowExecution": 0,
+		"QueryWorkflow":           "invalid",
+		"PollWorkflowTaskQueue":   30,
+		"*":                       "2s",
+	}
+
+	require.Equal(t, 500*time.Millisecond, interceptor.threshold(thresholds, "StartWorkflowExecution", nil))
+	require.Equal(t, 2*time.Second, interceptor.threshold(thresholds, "DescribeWorkflowExecution", nil))
+	require.Equal(t, time.Duration(0), interceptor.threshold(thresholds, "SignalWorkflowExecution", nil))
+	require.Equal(t, time.Duration(0), interceptor.threshold(thresholds, "QueryWorkflow", nil))
+	require.Equal(t, 30*time.Second, interceptor.threshold(thresholds, "PollWorkflowTaskQueue", nil))
+
+	// long polls don't use the default threshold
+	require.Equal(t, time.Duration(0), interceptor.threshold(thresholds, "GetWorkflowExecutionHistory",
+		&workflowservice.GetWorkflowExecutionHistoryRequest{WaitNewEvent: true}))
+	require.Equal(t, 2*time.Second, interceptor.threshold(thresholds, "GetWorkflowExecutionHistory",
+		&workflowservice.GetWorkflowExecutionHistoryRequest{}))
+
+	delete(thresholds, "*")
+	require.Equal(t, time.Duration(0), interceptor.threshold(thresholds, "DescribeWorkflowExecution", nil))
+}
+
+func TestSlowRequestLogInterceptor_Intercept(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := log.NewMockLogger(ctrl)
+	thresholds := map[string]any{"StartWorkflowExecution": "1ns", "*": "1h"}
+	interceptor := NewSlowRequestLogInterceptor(
+		func() map[string]any { return thresholds },
+		dynamicconfig.GetFloatPropertyFn(1),
+		dynamicconfig.GetIntPropertyFn(1024),
+		nil,
+		logger,
+	)
+	intercept := func(fullMethod string) {
+		_, err := interceptor.Intercept(
+			context.Background(),
+			&workflowservice.StartWorkflowExecutionRequest{Namespace: "test-namespace", WorkflowId: "test-workflow-id"},
+			&grpc.UnaryServerInfo{FullMethod: fullMethod},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				time.Sleep(time.Millisecond)
+				return nil, nil
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	logger.EXPECT().Warn("Slow request", gomock.Any()).Times(1)
+	intercept("/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution")
+	intercept("/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution")
+}
+
+func TestSlowRequestLogInterceptor_Summarize(t *testing.T) {
+	interceptor := NewSlowRequestLogInterceptor(
+		dynamicconfig.GetMapPropertyFn(nil),
+		dynamicconfig.GetFloatPropertyFn(1),
+		dynamicconfig.GetIntPropertyFn(0),
+		nil,
+		log.NewNoopLogger(),
+	)
+	req := &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:  "test-namespace",
+		WorkflowId: "test-workflow-id",
+		Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{
+			{Metadata: map[string][]byte{"encoding": []byte("json/plain")}, Data: []byte(`"secret"`)},
+		}},
+	}
+
+	summary := interceptor.summarize(req, 1024)
+	require.Contains(t, summary, `"workflowId":"test-workflow-id"`)
+	require.Contains(t, summary, `"data":"<redacted>"`)
+	require.NotContains(t, summary, "secret")
+	require.NotContains(t, summary, "c2VjcmV0")
+
+	summary = interceptor.summarize(req, 10)
+	require.Len(t, summary, 10+len(slowRequestTruncatedSuffix))
+	require.True(t, strings.HasSuffix(summary, slowRequestTruncatedSuffix))
+
+	require.Empty(t, interceptor.summarize(req, 0))
+}
@@ -49,6 +49,8 @@ type (
 		namespaceRegistry namespace.Registry
 		metricsHandler    metrics.Handler
 		logger            log.Logger
+		// actionTracker, if set, counts the actions emitted as metrics by namespace
+		actionTracker *NamespaceActionTracker
 	}
 )
 
@@ -95,11 +97,13 @@ func NewTelemetryInterceptor(
 	namespaceRegistry namespace.Registry,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
+	actionTracker *NamespaceActionTracker,
 ) *TelemetryInterceptor {
 	return &TelemetryInterceptor{
 		namespaceRegistry: namespaceRegistry,
 		metricsHandler:    metricsHandler,
 		logger:            logger,
+		actionTracker:     actionTracker,
 	}
 }
 
@@ -151,7 +155,7 @@ func (ti *TelemetryInterceptor) UnaryIntercept(
 	userLatencyDuration := time.Duration(0)
 	defer func() {
 		latency := time.Since(startTime)
-		metricsHandler.Timer(metrics.ServiceLatency.GetMetricName()).Record(latency)
+		metricsHandler.Timer(metrics.ServiceLatency.GetMetricName()).Record(latency, metrics.WithExemplar(ctx)...)
 		noUserLatency := latency - userLatencyDuration
 		if noUserLatency < 0 {
 			noUserLatency = 0
@@ -225,10 +229,10 @@ func (ti *TelemetryInterceptor) emitActionMetric(
 				case enums.COMMAND_TYPE_RECORD_MARKER:
 					// handle RecordMarker command, they are used for localActivity, sideEffect, versioning etc.
 					markerName := command.GetRecordMarkerCommandAttributes().GetMarkerName()
-					metricsHandler.Counter(metrics.ActionCounter.GetMetricName()).Record(1, metrics.ActionType("command_RecordMarker_"+markerName))
+					ti.recordAction(metricsHandler, req, "command_RecordMarker_"+markerName)
 				default:
 					// handle all other command action
-					metricsHandler.Counter(metrics.ActionCounter.GetMetricName()).Record(1, metrics.ActionType("command_"+command.CommandType.String()))
+					ti.recordAction(metricsHandler, req, "command_"+command.CommandType.String())
 				}
 			}
 		}
@@ -244,12 +248,23 @@ func (ti *TelemetryInterceptor) emitActionMetric(
 			return
 		}
 		if activityPollResponse.Attempt > 1 {
-			metricsHandler.Counter(metrics.ActionCounter.GetMetricName()).Record(1, metrics.ActionType("activity_retry"))
+			ti.recordAction(metricsHandler, req, "activity_retry")
 		}
 
 	default:
 		// grpc action
-		metricsHandler.Counter(metrics.ActionCounter.GetMetricName()).Record(1, metrics.ActionType("grpc_"+methodName))
+		ti.recordAction(metricsHandler, req, "grpc_"+methodName)
+	}
+}
+
+func (ti *TelemetryInterceptor) recordAction(
+	metricsHandler metrics.Handler,
+	req interface{},
+	actionType string,
+) {
+	metricsHandler.Counter(metrics.ActionCounter.GetMetricName()).Record(1, metrics.ActionType(actionType))
+	if ti.actionTracker != nil {
+		ti.actionTracker.Record(MustGetNamespaceName(ti.namespaceRegistry, req).String())
 	}
 }
 
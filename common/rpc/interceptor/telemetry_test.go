@@ -26,10 +26,13 @@ package interceptor
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"go.temporal.io/api/workflowservice/v1"
 
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
@@ -39,7 +42,7 @@ func TestEmitActionMetric(t *testing.T) {
 	controller := gomock.NewController(t)
 	register := namespace.NewMockRegistry(controller)
 	metricsHandler := metrics.NewMockHandler(controller)
-	telemetry := NewTelemetryInterceptor(register, metricsHandler, log.NewNoopLogger())
+	telemetry := NewTelemetryInterceptor(register, metricsHandler, log.NewNoopLogger(), nil)
 
 	testCases := []struct {
 		methodName        string
@@ -75,11 +78,36 @@ func TestEmitActionMetric(t *testing.T) {
 	}
 }
 
+func TestEmitActionMetric_TracksNamespaceActions(t *testing.T) {
+	controller := gomock.NewController(t)
+	register := namespace.NewMockRegistry(controller)
+	metricsHandler := metrics.NewMockHandler(controller)
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	actionTracker := NewNamespaceActionTracker(timeSource)
+	telemetry := NewTelemetryInterceptor(register, metricsHandler, log.NewNoopLogger(), actionTracker)
+
+	register.EXPECT().GetNamespace(namespace.Name("test-namespace")).Return(nil, nil).Times(2)
+	metricsHandler.EXPECT().Counter(gomock.Any()).Return(metrics.NoopCounterMetricFunc).Times(2)
+	request := &workflowservice.SignalWorkflowExecutionRequest{Namespace: "test-namespace"}
+	for i := 0; i < 2; i++ {
+		telemetry.emitActionMetric(
+			metrics.FrontendSignalWorkflowExecutionScope,
+			frontendPackagePrefix+metrics.FrontendSignalWorkflowExecutionScope,
+			request,
+			metricsHandler,
+			nil,
+		)
+	}
+
+	timeSource.Update(timeSource.Now().Add(time.Minute))
+	assert.Equal(t, 2.0/60, actionTracker.Rate("test-namespace"))
+}
+
 func TestOperationOverwrite(t *testing.T) {
 	controller := gomock.NewController(t)
 	register := namespace.NewMockRegistry(controller)
 	metricsHandler := metrics.NewMockHandler(controller)
-	telemetry := NewTelemetryInterceptor(register, metricsHandler, log.NewNoopLogger())
+	telemetry := NewTelemetryInterceptor(register, metricsHandler, log.NewNoopLogger(), nil)
 
 	testCases := []struct {
 		methodName        string
@@ -47,9 +47,11 @@ type (
 	// Users using standard visibility might have registered custom search attributes.
 	// Those search attributes won't be searchable, as they weren't before version v1.20.
 	// Thus, this mapper will allow those search attributes to be used without being alised.
+	// With Elasticsearch, the fallback search attributes are the ones registered in the cluster for the
+	// visibility index, so they can be used by their field names in addition to namespace aliases.
 	backCompMapper_v1_20 struct {
-		mapper                 Mapper
-		emptyStringNameTypeMap NameTypeMap
+		mapper              Mapper
+		fallbackNameTypeMap NameTypeMap
 	}
 
 	MapperProvider interface {
@@ -61,6 +63,7 @@ type (
 		namespaceRegistry         namespace.Registry
 		searchAttributesProvider  Provider
 		enableMapperFromNamespace bool
+		fallbackIndexName         string
 	}
 )
 
@@ -80,7 +83,7 @@ func (m *noopMapper) GetFieldName(alias string, _ string) (string, error) {
 func (m *backCompMapper_v1_20) GetAlias(fieldName string, namespaceName string) (string, error) {
 	alias, firstErr := m.mapper.GetAlias(fieldName, namespaceName)
 	if firstErr != nil {
-		_, err := m.emptyStringNameTypeMap.getType(fieldName, customCategory)
+		_, err := m.fallbackNameTypeMap.getType(fieldName, customCategory)
 		if err != nil {
 			return "", firstErr
 		}
@@ -93,7 +96,7 @@ func (m *backCompMapper_v1_20) GetAlias(fieldName string, namespaceName string)
 func (m *backCompMapper_v1_20) GetFieldName(alias string, namespaceName string) (string, error) {
 	fieldName, firstErr := m.mapper.GetFieldName(alias, namespaceName)
 	if firstErr != nil {
-		_, err := m.emptyStringNameTypeMap.getType(alias, customCategory)
+		_, err := m.fallbackNameTypeMap.getType(alias, customCategory)
 		if err != nil {
 			return "", firstErr
 		}
@@ -103,17 +106,22 @@ func (m *backCompMapper_v1_20) GetFieldName(alias string, namespaceName string)
 	return fieldName, nil
 }
 
+// NewMapperProvider returns a MapperProvider which uses customMapper if it is set.
+// Otherwise, if enableMapperFromNamespace is true, it maps the custom search attribute aliases defined in namespaces,
+// and the custom search attributes registered for fallbackIndexName can be used by their field names.
 func NewMapperProvider(
 	customMapper Mapper,
 	namespaceRegistry namespace.Registry,
 	searchAttributesProvider Provider,
 	enableMapperFromNamespace bool,
+	fallbackIndexName string,
 ) MapperProvider {
 	return &mapperProviderImpl{
 		customMapper:              customMapper,
 		namespaceRegistry:         namespaceRegistry,
 		searchAttributesProvider:  searchAttributesProvider,
 		enableMapperFromNamespace: enableMapperFromNamespace,
+		fallbackIndexName:         fallbackIndexName,
 	}
 }
 
@@ -129,10 +137,10 @@ func (m *mapperProviderImpl) GetMapper(nsName namespace.Name) (Mapper, error) {
 		return nil, err
 	}
 	// if there's an error, it returns an empty object, which is expected here
-	emptyStringNameTypeMap, _ := m.searchAttributesProvider.GetSearchAttributes("", false)
+	fallbackNameTypeMap, _ := m.searchAttributesProvider.GetSearchAttributes(m.fallbackIndexName, false)
 	return &backCompMapper_v1_20{
-		mapper:                 &saMapper,
-		emptyStringNameTypeMap: emptyStringNameTypeMap,
+		mapper:              &saMapper,
+		fallbackNameTypeMap: fallbackNameTypeMap,
 	}, nil
 }
 
@@ -27,11 +27,56 @@ package searchattribute
 import (
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 )
 
+func Test_MapperProvider_FallbackIndex(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ns := namespace.FromPersistentState(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{Name: "test-namespace"},
+			Config: &persistencespb.NamespaceConfig{
+				CustomSearchAttributeAliases: map[string]string{"CustomKeywordField": "Customer"},
+			},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+		},
+	})
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	namespaceRegistry.EXPECT().GetCustomSearchAttributesMapper(ns.Name()).Return(ns.CustomSearchAttributesMapper(), nil)
+	saProvider := NewMockProvider(ctrl)
+	saProvider.EXPECT().GetSearchAttributes("test-index", false).Return(TestNameTypeMap, nil)
+
+	mapper, err := NewMapperProvider(nil, namespaceRegistry, saProvider, true, "test-index").GetMapper(ns.Name())
+	assert.NoError(t, err)
+
+	// Aliased search attribute is mapped.
+	fieldName, err := mapper.GetFieldName("Customer", "test-namespace")
+	assert.NoError(t, err)
+	assert.Equal(t, "CustomKeywordField", fieldName)
+	alias, err := mapper.GetAlias("CustomKeywordField", "test-namespace")
+	assert.NoError(t, err)
+	assert.Equal(t, "Customer", alias)
+
+	// Search attribute registered for the fallback index is usable by its field name.
+	fieldName, err = mapper.GetFieldName("CustomIntField", "test-namespace")
+	assert.NoError(t, err)
+	assert.Equal(t, "CustomIntField", fieldName)
+	alias, err = mapper.GetAlias("CustomIntField", "test-namespace")
+	assert.NoError(t, err)
+	assert.Equal(t, "CustomIntField", alias)
+
+	_, err = mapper.GetFieldName("UnknownField", "test-namespace")
+	var invalidArgumentErr *serviceerror.InvalidArgument
+	assert.ErrorAs(t, err, &invalidArgumentErr)
+}
+
 func Test_AliasFields(t *testing.T) {
 	mapperProvider := NewTestMapperProvider(&TestMapper{})
 
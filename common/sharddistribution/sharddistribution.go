@@ -0,0 +1,311 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package sharddistribution reports the state of every history shard and how the shards are spread across the
+// history hosts, so that hot or stuck shards can be spotted without going through the logs of every host.
+package sharddistribution
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/util"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+const (
+	defaultPageSize = 1000
+)
+
+type (
+	// Request controls what a report includes. The zero value reports the shards without their pending tasks.
+	Request struct {
+		// MaxPendingTasks caps the number of pending tasks counted per queue of a shard. Counting reads the
+		// tasks from persistence, zero skips it.
+		MaxPendingTasks int
+	}
+
+	// QueueStatus is the state of a history task queue of a shard, as last persisted by its owner.
+	QueueStatus struct {
+		Category string
+		// AckLevel is the key of the oldest task of the queue which may not be processed yet.
+		AckLevel tasks.Key
+		// PendingTasks is the number of tasks at or above the ack level, up to Request.MaxPendingTasks.
+		PendingTasks int
+		// PendingTasksCapped is true when the queue has more pending tasks than counted.
+		PendingTasksCapped bool
+	}
+
+	// ShardStatus is the state of a history shard.
+	ShardStatus struct {
+		ShardID int32
+		// Host is the history host membership assigns the shard to.
+		Host string
+		// Owner identifies the shard context which last acquired the shard.
+		Owner string
+		// Moving is true when the shard was last acquired by another host than Host, eg. while the shard
+		// moves after a membership change. A shard which stays moving is stuck.
+		Moving           bool
+		RangeID          int64
+		StolenSinceRenew int32
+		// UpdateTime is when the shard was last persisted. Shards are persisted when they're acquired and
+		// then periodically, so it is the last acquire time at most history.shardUpdateMinInterval after it.
+		UpdateTime time.Time
+		Queues     []QueueStatus
+		// Error is set when the state of the shard couldn't be read.
+		Error string
+	}
+
+	// HostStats aggregates the shards membership assigns to a history host.
+	HostStats struct {
+		Host         string
+		NumShards    int
+		MovingShards int
+		PendingTasks int
+	}
+
+	// Report is the state of every history shard, by shard ID, and how they're distributed across hosts.
+	Report struct {
+		Shards            []ShardStatus
+		Hosts             []HostStats
+		MinShardsPerHost  int
+		MaxShardsPerHost  int
+		MeanShardsPerHost float64
+	}
+
+	// Reporter reports the state and distribution of the history shards.
+	Reporter struct {
+		numShards         int32
+		shardManager      persistence.ShardManager
+		executionManager  persistence.ExecutionManager
+		membershipMonitor membership.Monitor
+		logger            log.Logger
+	}
+)
+
+// NewReporter creates a Reporter for a cluster with numShards history shards.
+func NewReporter(
+	numShards int32,
+	shardManager persistence.ShardManager,
+	executionManager persistence.ExecutionManager,
+	membershipMonitor membership.Monitor,
+	logger log.Logger,
+) *Reporter {
+	return &Reporter{
+		numShards:         numShards,
+		shardManager:      shardManager,
+		executionManager:  executionManager,
+		membershipMonitor: membershipMonitor,
+		logger:            logger,
+	}
+}
+
+// Report reads the state of every shard. A shard whose state can't be read is reported with an error, the
+// report only fails if ctx is done.
+func (r *Reporter) Report(ctx context.Context, request Request) (*Report, error) {
+	resolver, err := r.membershipMonitor.GetResolver(primitives.HistoryService)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{Shards: make([]ShardStatus, 0, r.numShards)}
+	for shardID := int32(1); shardID <= r.numShards; shardID++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		status := ShardStatus{ShardID: shardID}
+		if host, err := resolver.Lookup(convert.Int32ToString(shardID)); err == nil {
+			status.Host = host.GetAddress()
+		}
+		if err := r.readShard(ctx, request, &status); err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			r.logger.Warn("Unable to read shard state.", tag.ShardID(shardID), tag.Error(err))
+			status.Error = err.Error()
+		}
+		report.Shards = append(report.Shards, status)
+	}
+	report.aggregate(resolver.Members())
+	return report, nil
+}
+
+func (r *Reporter) readShard(ctx context.Context, request Request, status *ShardStatus) error {
+	resp, err := r.shardManager.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{
+		ShardID: status.ShardID,
+	})
+	if err != nil {
+		return err
+	}
+	shardInfo := resp.ShardInfo
+	status.Owner = shardInfo.GetOwner()
+	// the owner of a shard context starts with the identity of its host, which is its membership address
+	status.Moving = status.Owner != "" && !strings.HasPrefix(status.Owner, status.Host+"-")
+	status.RangeID = shardInfo.GetRangeId()
+	status.StolenSinceRenew = shardInfo.GetStolenSinceRenew()
+	status.UpdateTime = timestamp.TimeValue(shardInfo.GetUpdateTime())
+
+	categoryIDs := make([]int32, 0, len(shardInfo.QueueStates))
+	for categoryID := range shardInfo.QueueStates {
+		categoryIDs = append(categoryIDs, categoryID)
+	}
+	sort.Slice(categoryIDs, func(i, j int) bool { return categoryIDs[i] < categoryIDs[j] })
+	for _, categoryID := range categoryIDs {
+		category, ok := tasks.GetCategoryByID(categoryID)
+		// replication tasks are read by each remote cluster at its own pace, they don't have a single ack level
+		if !ok || categoryID == tasks.CategoryIDReplication {
+			continue
+		}
+		queue := QueueStatus{
+			Category: category.Name(),
+			AckLevel: ackLevel(shardInfo.QueueStates[categoryID]),
+		}
+		if request.MaxPendingTasks > 0 {
+			queue.PendingTasks, queue.PendingTasksCapped, err = r.countPendingTasks(ctx, status.ShardID, category, queue.AckLevel, request.MaxPendingTasks)
+			if err != nil {
+				return err
+			}
+		}
+		status.Queues = append(status.Queues, queue)
+	}
+	return nil
+}
+
+func (r *Reporter) countPendingTasks(
+	ctx context.Context,
+	shardID int32,
+	category tasks.Category,
+	ackLevel tasks.Key,
+	maxCount int,
+) (int, bool, error) {
+	minKey := tasks.NewImmediateKey(ackLevel.TaskID)
+	maxKey := tasks.NewImmediateKey(math.MaxInt64)
+	if category.Type() == tasks.CategoryTypeScheduled {
+		minKey = tasks.NewKey(ackLevel.FireTime, 0)
+		maxKey = tasks.NewKey(tasks.MaximumKey.FireTime, 0)
+	}
+
+	count := 0
+	var pageToken []byte
+	for {
+		resp, err := r.executionManager.GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
+			ShardID:             shardID,
+			TaskCategory:        category,
+			InclusiveMinTaskKey: minKey,
+			ExclusiveMaxTaskKey: maxKey,
+			BatchSize:           util.Min(defaultPageSize, maxCount-count+1),
+			NextPageToken:       pageToken,
+		})
+		if err != nil {
+			return 0, false, err
+		}
+		count += len(resp.Tasks)
+		if count > maxCount {
+			return maxCount, true, nil
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return count, false, nil
+		}
+	}
+}
+
+// ackLevel returns the key of the oldest task of the queue which may not be processed yet: the start of the
+// oldest range of tasks held by a reader, or the high watermark of the readers if they hold none.
+func ackLevel(queueState *persistencespb.QueueState) tasks.Key {
+	var levels []tasks.Key
+	if watermark := queueState.GetExclusiveReaderHighWatermark(); watermark != nil {
+		levels = append(levels, fromPersistenceTaskKey(watermark))
+	}
+	for _, readerState := range queueState.GetReaderStates() {
+		if len(readerState.Scopes) != 0 {
+			levels = append(levels, fromPersistenceTaskKey(readerState.Scopes[0].Range.InclusiveMin))
+		}
+	}
+	if len(levels) == 0 {
+		return tasks.MinimumKey
+	}
+	level := levels[0]
+	for _, key := range levels[1:] {
+		level = tasks.MinKey(level, key)
+	}
+	return level
+}
+
+func fromPersistenceTaskKey(key *persistencespb.TaskKey) tasks.Key {
+	return tasks.NewKey(timestamp.TimeValue(key.FireTime), key.TaskId)
+}
+
+func (r *Report) aggregate(members []membership.HostInfo) {
+	hosts := make(map[string]*HostStats, len(members))
+	// hosts without shards are reported too, they're the ones a skewed distribution leaves out
+	for _, member := range members {
+		hosts[member.GetAddress()] = &HostStats{Host: member.GetAddress()}
+	}
+	for _, shard := range r.Shards {
+		if shard.Host == "" {
+			continue
+		}
+		stats, ok := hosts[shard.Host]
+		if !ok {
+			stats = &HostStats{Host: shard.Host}
+			hosts[shard.Host] = stats
+		}
+		stats.NumShards++
+		if shard.Moving {
+			stats.MovingShards++
+		}
+		for _, queue := range shard.Queues {
+			stats.PendingTasks += queue.PendingTasks
+		}
+	}
+
+	r.Hosts = make([]HostStats, 0, len(hosts))
+	for _, stats := range hosts {
+		r.Hosts = append(r.Hosts, *stats)
+	}
+	sort.Slice(r.Hosts, func(i, j int) bool { return r.Hosts[i].Host < r.Hosts[j].Host })
+	if len(r.Hosts) == 0 {
+		return
+	}
+	r.MinShardsPerHost = math.MaxInt
+	total := 0
+	for _, stats := range r.Hosts {
+		r.MinShardsPerHost = util.Min(r.MinShardsPerHost, stats.NumShards)
+		r.MaxShardsPerHost = util.Max(r.MaxShardsPerHost, stats.NumShards)
+		total += stats.NumShards
+	}
+	r.MeanShardsPerHost = float64(total) / float64(len(r.Hosts))
+}
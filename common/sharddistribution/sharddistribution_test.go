@@ -0,0 +1,152 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sharddistribution
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+func TestReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	shardManager := persistence.NewMockShardManager(ctrl)
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	monitor := membership.NewMockMonitor(ctrl)
+	resolver := membership.NewMockServiceResolver(ctrl)
+
+	hostA := membership.NewHostInfoFromAddress("10.0.0.1:7234")
+	hostB := membership.NewHostInfoFromAddress("10.0.0.2:7234")
+	hostC := membership.NewHostInfoFromAddress("10.0.0.3:7234")
+	monitor.EXPECT().GetResolver(primitives.HistoryService).Return(resolver, nil)
+	resolver.EXPECT().Members().Return([]membership.HostInfo{hostA, hostB, hostC})
+	resolver.EXPECT().Lookup("1").Return(hostA, nil)
+	resolver.EXPECT().Lookup("2").Return(hostB, nil)
+	resolver.EXPECT().Lookup("3").Return(hostB, nil)
+
+	updateTime := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	timerAckLevel := updateTime.Add(-time.Minute)
+	shardManager.EXPECT().GetOrCreateShard(gomock.Any(), &persistence.GetOrCreateShardRequest{ShardID: 1}).Return(&persistence.GetOrCreateShardResponse{
+		ShardInfo: &persistencespb.ShardInfo{
+			ShardId:    1,
+			Owner:      "10.0.0.1:7234-1-uuid",
+			RangeId:    7,
+			UpdateTime: &updateTime,
+			QueueStates: map[int32]*persistencespb.QueueState{
+				tasks.CategoryIDTransfer: {
+					ExclusiveReaderHighWatermark: &persistencespb.TaskKey{FireTime: timestamp.TimePtr(tasks.DefaultFireTime), TaskId: 100},
+					ReaderStates: map[int64]*persistencespb.QueueReaderState{
+						0: {Scopes: []*persistencespb.QueueSliceScope{{
+							Range: &persistencespb.QueueSliceRange{
+								InclusiveMin: &persistencespb.TaskKey{FireTime: timestamp.TimePtr(tasks.DefaultFireTime), TaskId: 50},
+								ExclusiveMax: &persistencespb.TaskKey{FireTime: timestamp.TimePtr(tasks.DefaultFireTime), TaskId: 100},
+							},
+						}}},
+					},
+				},
+				tasks.CategoryIDTimer: {
+					ExclusiveReaderHighWatermark: &persistencespb.TaskKey{FireTime: &timerAckLevel},
+				},
+				tasks.CategoryIDReplication: {},
+			},
+		},
+	}, nil)
+	shardManager.EXPECT().GetOrCreateShard(gomock.Any(), &persistence.GetOrCreateShardRequest{ShardID: 2}).Return(&persistence.GetOrCreateShardResponse{
+		ShardInfo: &persistencespb.ShardInfo{
+			ShardId: 2,
+			Owner:   "10.0.0.1:7234-2-uuid",
+		},
+	}, nil)
+	shardManager.EXPECT().GetOrCreateShard(gomock.Any(), &persistence.GetOrCreateShardRequest{ShardID: 3}).Return(nil, errors.New("unavailable"))
+
+	executionManager.EXPECT().GetHistoryTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.GetHistoryTasksRequest) (*persistence.GetHistoryTasksResponse, error) {
+			require.Equal(t, int32(1), request.ShardID)
+			switch request.TaskCategory {
+			case tasks.CategoryTransfer:
+				require.Equal(t, tasks.NewImmediateKey(50), request.InclusiveMinTaskKey)
+				require.Equal(t, 3, request.BatchSize)
+				return &persistence.GetHistoryTasksResponse{
+					Tasks:         []tasks.Task{&tasks.ActivityTask{}, &tasks.ActivityTask{}, &tasks.ActivityTask{}},
+					NextPageToken: []byte("next"),
+				}, nil
+			case tasks.CategoryTimer:
+				require.Equal(t, tasks.NewKey(timerAckLevel, 0), request.InclusiveMinTaskKey)
+				return &persistence.GetHistoryTasksResponse{
+					Tasks: []tasks.Task{&tasks.UserTimerTask{}},
+				}, nil
+			}
+			return nil, errors.New("unexpected category")
+		}).Times(2)
+
+	reporter := NewReporter(3, shardManager, executionManager, monitor, log.NewNoopLogger())
+	report, err := reporter.Report(context.Background(), Request{MaxPendingTasks: 2})
+	require.NoError(t, err)
+
+	require.Equal(t, []ShardStatus{
+		{
+			ShardID:    1,
+			Host:       hostA.GetAddress(),
+			Owner:      "10.0.0.1:7234-1-uuid",
+			RangeID:    7,
+			UpdateTime: updateTime,
+			Queues: []QueueStatus{
+				{Category: "transfer", AckLevel: tasks.NewImmediateKey(50), PendingTasks: 2, PendingTasksCapped: true},
+				{Category: "timer", AckLevel: tasks.NewKey(timerAckLevel, 0), PendingTasks: 1},
+			},
+		},
+		{
+			ShardID: 2,
+			Host:    hostB.GetAddress(),
+			Owner:   "10.0.0.1:7234-2-uuid",
+			Moving:  true,
+		},
+		{
+			ShardID: 3,
+			Host:    hostB.GetAddress(),
+			Error:   "unavailable",
+		},
+	}, report.Shards)
+	require.Equal(t, []HostStats{
+		{Host: hostA.GetAddress(), NumShards: 1, PendingTasks: 3},
+		{Host: hostB.GetAddress(), NumShards: 2, MovingShards: 1},
+		{Host: hostC.GetAddress()},
+	}, report.Hosts)
+	require.Equal(t, 0, report.MinShardsPerHost)
+	require.Equal(t, 2, report.MaxShardsPerHost)
+	require.Equal(t, 1.0, report.MeanShardsPerHost)
+}
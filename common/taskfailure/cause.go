@@ -0,0 +1,124 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package taskfailure classifies workflow and activity task dispatch and completion failures
+// into a small, stable set of causes. The causes are used as a metric dimension, so they must
+// never be renamed.
+package taskfailure
+
+import (
+	"errors"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	serviceerrors "go.temporal.io/server/common/serviceerror"
+)
+
+type (
+	// Cause is the classification of a task failure.
+	Cause string
+
+	// PayloadTooLargeError is returned when a task cannot be completed because
+	// one of its payloads exceeds the configured size limit.
+	PayloadTooLargeError struct {
+		Message string
+	}
+)
+
+const (
+	CauseWorkerTimeout      Cause = "worker_timeout"
+	CauseNonDeterminism     Cause = "non_determinism"
+	CausePayloadTooLarge    Cause = "payload_too_large"
+	CauseRateLimited        Cause = "rate_limited"
+	CauseVersioningMismatch Cause = "versioning_mismatch"
+	CauseOther              Cause = "other"
+)
+
+func (c Cause) String() string {
+	return string(c)
+}
+
+func (e *PayloadTooLargeError) Error() string {
+	return e.Message
+}
+
+// FromWorkflowTaskFailedCause classifies a workflow task failure.
+// causeErr is the error that made the server fail the workflow task, it is nil for failures reported by workers.
+func FromWorkflowTaskFailedCause(
+	cause enumspb.WorkflowTaskFailedCause,
+	causeErr error,
+) Cause {
+	var payloadErr *PayloadTooLargeError
+	if errors.As(causeErr, &payloadErr) {
+		return CausePayloadTooLarge
+	}
+
+	switch cause {
+	case enumspb.WORKFLOW_TASK_FAILED_CAUSE_NON_DETERMINISTIC_ERROR:
+		return CauseNonDeterminism
+	case enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SIGNAL_INPUT_SIZE:
+		return CausePayloadTooLarge
+	case enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_BINARY:
+		return CauseVersioningMismatch
+	default:
+		return CauseOther
+	}
+}
+
+// FromTimeoutType classifies a workflow or activity task timeout.
+func FromTimeoutType(
+	timeoutType enumspb.TimeoutType,
+) Cause {
+	switch timeoutType {
+	case enumspb.TIMEOUT_TYPE_START_TO_CLOSE,
+		enumspb.TIMEOUT_TYPE_SCHEDULE_TO_START,
+		enumspb.TIMEOUT_TYPE_SCHEDULE_TO_CLOSE,
+		enumspb.TIMEOUT_TYPE_HEARTBEAT:
+		return CauseWorkerTimeout
+	default:
+		return CauseOther
+	}
+}
+
+// FromDispatchError classifies an error returned by matching when a task is dispatched to a task queue.
+func FromDispatchError(
+	err error,
+) Cause {
+	switch err.(type) {
+	case *serviceerror.ResourceExhausted:
+		return CauseRateLimited
+	case *serviceerrors.StickyWorkerUnavailable:
+		return CauseWorkerTimeout
+	case *serviceerror.FailedPrecondition:
+		// matching rejects tasks whose version directive can't be satisfied by the task queue versioning data
+		return CauseVersioningMismatch
+	default:
+		var payloadErr *PayloadTooLargeError
+		if errors.As(err, &payloadErr) {
+			return CausePayloadTooLarge
+		}
+		return CauseOther
+	}
+}
@@ -0,0 +1,64 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package taskfailure
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	serviceerrors "go.temporal.io/server/common/serviceerror"
+)
+
+func TestFromWorkflowTaskFailedCause(t *testing.T) {
+	payloadErr := &PayloadTooLargeError{Message: "ScheduleActivityTaskCommandAttributes.Input exceeds size limit."}
+
+	assert.Equal(t, CauseNonDeterminism, FromWorkflowTaskFailedCause(enumspb.WORKFLOW_TASK_FAILED_CAUSE_NON_DETERMINISTIC_ERROR, nil))
+	assert.Equal(t, CausePayloadTooLarge, FromWorkflowTaskFailedCause(enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SIGNAL_INPUT_SIZE, nil))
+	assert.Equal(t, CausePayloadTooLarge, FromWorkflowTaskFailedCause(enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SCHEDULE_ACTIVITY_ATTRIBUTES, payloadErr))
+	assert.Equal(t, CausePayloadTooLarge, FromWorkflowTaskFailedCause(enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SCHEDULE_ACTIVITY_ATTRIBUTES, fmt.Errorf("wrapped: %w", payloadErr)))
+	assert.Equal(t, CauseVersioningMismatch, FromWorkflowTaskFailedCause(enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_BINARY, nil))
+	assert.Equal(t, CauseOther, FromWorkflowTaskFailedCause(enumspb.WORKFLOW_TASK_FAILED_CAUSE_WORKFLOW_WORKER_UNHANDLED_FAILURE, nil))
+	assert.Equal(t, CauseOther, FromWorkflowTaskFailedCause(enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SCHEDULE_ACTIVITY_ATTRIBUTES, serviceerror.NewInvalidArgument("bad")))
+}
+
+func TestFromTimeoutType(t *testing.T) {
+	assert.Equal(t, CauseWorkerTimeout, FromTimeoutType(enumspb.TIMEOUT_TYPE_START_TO_CLOSE))
+	assert.Equal(t, CauseWorkerTimeout, FromTimeoutType(enumspb.TIMEOUT_TYPE_SCHEDULE_TO_START))
+	assert.Equal(t, CauseWorkerTimeout, FromTimeoutType(enumspb.TIMEOUT_TYPE_HEARTBEAT))
+	assert.Equal(t, CauseOther, FromTimeoutType(enumspb.TIMEOUT_TYPE_UNSPECIFIED))
+}
+
+func TestFromDispatchError(t *testing.T) {
+	assert.Equal(t, CauseRateLimited, FromDispatchError(serviceerror.NewResourceExhausted(enumspb.RESOURCE_EXHAUSTED_CAUSE_RPS_LIMIT, "limit")))
+	assert.Equal(t, CauseWorkerTimeout, FromDispatchError(serviceerrors.NewStickyWorkerUnavailable()))
+	assert.Equal(t, CauseVersioningMismatch, FromDispatchError(serviceerror.NewFailedPrecondition("unknown build id")))
+	assert.Equal(t, CausePayloadTooLarge, FromDispatchError(&PayloadTooLargeError{Message: "too large"}))
+	assert.Equal(t, CauseOther, FromDispatchError(errors.New("boom")))
+}
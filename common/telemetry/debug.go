@@ -0,0 +1,63 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package telemetry
+
+import (
+	"time"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+// DebugMode tells which namespaces have debug telemetry enabled. Debug telemetry is enabled by setting
+// the namespace's system.namespaceDebugTelemetryExpiry to a time in the future. It turns itself off at
+// that time, so that it cannot be forgotten.
+type DebugMode struct {
+	expiry     dynamicconfig.StringPropertyFnWithNamespaceFilter
+	timeSource clock.TimeSource
+}
+
+func NewDebugMode(
+	dc *dynamicconfig.Collection,
+	timeSource clock.TimeSource,
+) *DebugMode {
+	return &DebugMode{
+		expiry:     dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.NamespaceDebugTelemetryExpiry, ""),
+		timeSource: timeSource,
+	}
+}
+
+// Enabled returns true if debug telemetry is enabled for the namespace.
+func (d *DebugMode) Enabled(namespace string) bool {
+	value := d.expiry(namespace)
+	if value == "" {
+		return false
+	}
+	expiry, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return false
+	}
+	return d.timeSource.Now().Before(expiry)
+}
@@ -0,0 +1,58 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package telemetry_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/telemetry"
+)
+
+func TestDebugMode(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	client := dynamicconfig.StaticClient{
+		dynamicconfig.NamespaceDebugTelemetryExpiry: []dynamicconfig.ConstrainedValue{
+			{Constraints: dynamicconfig.Constraints{Namespace: "active"}, Value: now.Add(time.Hour).Format(time.RFC3339)},
+			{Constraints: dynamicconfig.Constraints{Namespace: "expired"}, Value: now.Add(-time.Hour).Format(time.RFC3339)},
+			{Constraints: dynamicconfig.Constraints{Namespace: "invalid"}, Value: "tomorrow"},
+		},
+	}
+	timeSource := clock.NewEventTimeSource().Update(now)
+	debugMode := telemetry.NewDebugMode(dynamicconfig.NewCollection(client, log.NewNoopLogger()), timeSource)
+
+	require.True(t, debugMode.Enabled("active"))
+	require.False(t, debugMode.Enabled("expired"))
+	require.False(t, debugMode.Enabled("invalid"))
+	require.False(t, debugMode.Enabled("other"))
+
+	timeSource.Update(now.Add(time.Hour))
+	require.False(t, debugMode.Enabled("active"))
+}
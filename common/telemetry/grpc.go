@@ -25,6 +25,8 @@
 package telemetry
 
 import (
+	"context"
+
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/trace"
@@ -39,21 +41,35 @@ type (
 	// ClientTraceInterceptor gives a named type to the
 	// grpc.UnaryClientInterceptor implementation provided by otelgrpc
 	ClientTraceInterceptor grpc.UnaryClientInterceptor
+
+	namespaceNameGetter interface {
+		GetNamespace() string
+	}
 )
 
 // NewServerTraceInterceptor creates a new gRPC server interceptor that tracks
 // each request with an encapsulating span using the provided TracerProvider and
-// TextMapPropagator.
+// TextMapPropagator. The namespace of the request is set on the context so that
+// requests without a parent span are sampled at the rate of their namespace.
 func NewServerTraceInterceptor(
 	tp trace.TracerProvider,
 	tmp propagation.TextMapPropagator,
 ) ServerTraceInterceptor {
-	return ServerTraceInterceptor(
-		otelgrpc.UnaryServerInterceptor(
-			otelgrpc.WithPropagators(tmp),
-			otelgrpc.WithTracerProvider(tp),
-		),
+	traceInterceptor := otelgrpc.UnaryServerInterceptor(
+		otelgrpc.WithPropagators(tmp),
+		otelgrpc.WithTracerProvider(tp),
 	)
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if r, ok := req.(namespaceNameGetter); ok {
+			ctx = ContextWithNamespace(ctx, r.GetNamespace())
+		}
+		return traceInterceptor(ctx, req, info, handler)
+	}
 }
 
 // NewClientTraceInterceptor creates a new gRPC client interceptor that tracks
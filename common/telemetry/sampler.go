@@ -0,0 +1,84 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package telemetry
+
+import (
+	"context"
+
+	otelsdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	namespaceContextKey struct{}
+
+	// namespaceSampler samples the spans of a sampled parent, and the root spans of a namespace at the
+	// sample rate of the namespace.
+	namespaceSampler struct {
+		sampleRate func(namespace string) float64
+	}
+)
+
+var namespaceCtxKey = namespaceContextKey{}
+
+// NewNamespaceSampler creates a sampler that follows the sampling decision of the parent span, and samples
+// root spans at the rate sampleRate returns for the namespace of their context. The namespace is set on the
+// context with ContextWithNamespace, and is empty for root spans not started for a namespace.
+func NewNamespaceSampler(
+	sampleRate func(namespace string) float64,
+) otelsdktrace.Sampler {
+	return &namespaceSampler{sampleRate: sampleRate}
+}
+
+// ContextWithNamespace returns a copy of ctx carrying the namespace used to sample the spans started with it.
+func ContextWithNamespace(
+	ctx context.Context,
+	namespace string,
+) context.Context {
+	return context.WithValue(ctx, namespaceCtxKey, namespace)
+}
+
+func namespaceFromContext(ctx context.Context) string {
+	namespace, _ := ctx.Value(namespaceCtxKey).(string)
+	return namespace
+}
+
+func (s *namespaceSampler) ShouldSample(
+	p otelsdktrace.SamplingParameters,
+) otelsdktrace.SamplingResult {
+	parent := trace.SpanContextFromContext(p.ParentContext)
+	if parent.IsValid() {
+		decision := otelsdktrace.Drop
+		if parent.IsSampled() {
+			decision = otelsdktrace.RecordAndSample
+		}
+		return otelsdktrace.SamplingResult{Decision: decision, Tracestate: parent.TraceState()}
+	}
+	return otelsdktrace.TraceIDRatioBased(s.sampleRate(namespaceFromContext(p.ParentContext))).ShouldSample(p)
+}
+
+func (s *namespaceSampler) Description() string {
+	return "NamespaceSampler"
+}
@@ -0,0 +1,57 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package telemetry_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	otelsdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"go.temporal.io/server/common/telemetry"
+)
+
+func TestNamespaceSampler(t *testing.T) {
+	sampleRates := map[string]float64{"sampled": 1.0, "dropped": 0.0}
+	tp := otelsdktrace.NewTracerProvider(otelsdktrace.WithSampler(
+		telemetry.NewNamespaceSampler(func(namespace string) float64 { return sampleRates[namespace] }),
+	))
+	tracer := tp.Tracer("test")
+
+	ctx, sampledSpan := tracer.Start(telemetry.ContextWithNamespace(context.Background(), "sampled"), "sampled")
+	require.True(t, sampledSpan.SpanContext().IsSampled())
+
+	_, droppedSpan := tracer.Start(telemetry.ContextWithNamespace(context.Background(), "dropped"), "dropped")
+	require.False(t, droppedSpan.SpanContext().IsSampled())
+
+	_, noNamespaceSpan := tracer.Start(context.Background(), "no namespace")
+	require.False(t, noNamespaceSpan.SpanContext().IsSampled())
+
+	// children follow the decision of their parent regardless of their namespace
+	_, childSpan := tracer.Start(telemetry.ContextWithNamespace(ctx, "dropped"), "child")
+	require.True(t, childSpan.SpanContext().IsSampled())
+	require.Equal(t, sampledSpan.SpanContext().TraceID(), childSpan.SpanContext().TraceID())
+}
@@ -65,6 +65,13 @@ const (
 	PostgresPort = "POSTGRES_PORT"
 	// PostgresDefaultPort Postgres default port
 	PostgresDefaultPort = 5432
+
+	// CockroachDBSeeds env
+	CockroachDBSeeds = "COCKROACHDB_SEEDS"
+	// CockroachDBPort env
+	CockroachDBPort = "COCKROACHDB_PORT"
+	// CockroachDBDefaultPort CockroachDB default port
+	CockroachDBDefaultPort = 26257
 )
 
 // SetupEnv setup the necessary env
@@ -111,6 +118,20 @@ func SetupEnv() {
 		}
 	}
 
+	if os.Getenv(CockroachDBSeeds) == "" {
+		err := os.Setenv(CockroachDBSeeds, Localhost)
+		if err != nil {
+			panic(fmt.Sprintf("error setting env %v", CockroachDBSeeds))
+		}
+	}
+
+	if os.Getenv(CockroachDBPort) == "" {
+		err := os.Setenv(CockroachDBPort, strconv.Itoa(CockroachDBDefaultPort))
+		if err != nil {
+			panic(fmt.Sprintf("error setting env %v", CockroachDBPort))
+		}
+	}
+
 	if os.Getenv(ESSeeds) == "" {
 		err := os.Setenv(ESSeeds, Localhost)
 		if err != nil {
@@ -198,3 +219,25 @@ func GetPostgreSQLPort() int {
 	}
 	return p
 }
+
+// GetCockroachDBAddress return the CockroachDB address
+func GetCockroachDBAddress() string {
+	addr := os.Getenv(CockroachDBSeeds)
+	if addr == "" {
+		addr = Localhost
+	}
+	return addr
+}
+
+// GetCockroachDBPort return the CockroachDB port
+func GetCockroachDBPort() int {
+	port := os.Getenv(CockroachDBPort)
+	if port == "" {
+		return CockroachDBDefaultPort
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		panic(fmt.Sprintf("error getting env %v", CockroachDBPort))
+	}
+	return p
+}
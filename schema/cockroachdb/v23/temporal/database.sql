@@ -0,0 +1 @@
+CREATE DATABASE temporal;
\ No newline at end of file
@@ -0,0 +1,46 @@
+-- Optional table locality hints for running the temporal database on a multi-region CockroachDB cluster.
+-- This file is not part of the versioned schema. Apply it once the database has been made multi-region, e.g.
+--
+--   ALTER DATABASE temporal SET PRIMARY REGION "us-east1";
+--   ALTER DATABASE temporal ADD REGION "us-west1";
+--
+-- and then
+--
+--   temporal-sql-tool --pl cockroachdb --db temporal setup-schema -d -f ./schema/cockroachdb/v23/temporal/multiregion.sql
+
+-- Namespace and cluster metadata is read by every host on every request but rarely written.
+-- GLOBAL tables serve consistent reads from any region at the cost of slower writes.
+ALTER TABLE namespaces SET LOCALITY GLOBAL;
+ALTER TABLE namespace_metadata SET LOCALITY GLOBAL;
+ALTER TABLE cluster_metadata_info SET LOCALITY GLOBAL;
+
+-- Execution, history, task and queue data is only written by the active cluster, which is expected to run
+-- next to the primary region. Keep its leaseholders there so writes don't cross regions.
+ALTER TABLE shards SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE executions SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE current_executions SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE buffered_events SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE tasks SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE task_queues SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE task_queue_user_data SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE build_id_to_task_queue SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE history_immediate_tasks SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE history_scheduled_tasks SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE transfer_tasks SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE timer_tasks SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE replication_tasks SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE replication_tasks_dlq SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE visibility_tasks SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE activity_info_maps SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE timer_info_maps SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE child_execution_info_maps SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE request_cancel_info_maps SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE signal_info_maps SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE signals_requested_sets SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE history_node SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE history_tree SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE queue SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+ALTER TABLE queue_metadata SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION;
+
+-- Membership rows are written by every host in every region, home each row in the region that wrote it.
+ALTER TABLE cluster_membership SET LOCALITY REGIONAL BY ROW;
@@ -0,0 +1,11 @@
+-- Optional native partitioning of the largest tables by ranges of shard IDs, which keeps per-partition indexes
+-- small at scale. This file is not part of the versioned schema. Apply it to an existing database, ideally before
+-- it holds much data since the tables are rebuilt, with
+--
+--   temporal-sql-tool --pl mysql8 --db temporal setup-schema -d -f ./schema/mysql/v8/temporal/partitioned.sql
+--
+-- Rows are initially kept in the overflow partition of each table. Enable the table partition scanner
+-- of the worker service (worker.tablePartitionScannerEnabled) to split them into partitions of shard ID ranges.
+
+ALTER TABLE executions PARTITION BY RANGE (shard_id) (PARTITION overflow VALUES LESS THAN MAXVALUE);
+ALTER TABLE history_node PARTITION BY RANGE (shard_id) (PARTITION overflow VALUES LESS THAN MAXVALUE);
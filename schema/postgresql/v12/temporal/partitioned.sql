@@ -0,0 +1,22 @@
+-- Optional native partitioning of the largest tables by ranges of shard IDs, which keeps per-partition indexes
+-- small at scale. This file is not part of the versioned schema. Apply it to an existing database, ideally before
+-- it holds much data since the rows are copied into the partitioned tables, with
+--
+--   temporal-sql-tool --pl postgres12 --db temporal setup-schema -d -f ./schema/postgresql/v12/temporal/partitioned.sql
+--
+-- Rows are initially kept in the overflow (DEFAULT) partition of each table. Enable the table partition scanner
+-- of the worker service (worker.tablePartitionScannerEnabled) to split them into partitions of shard ID ranges.
+
+ALTER TABLE executions RENAME TO executions_unpartitioned;
+ALTER INDEX executions_pkey RENAME TO executions_unpartitioned_pkey;
+CREATE TABLE executions (LIKE executions_unpartitioned INCLUDING ALL) PARTITION BY RANGE (shard_id);
+CREATE TABLE executions_overflow PARTITION OF executions DEFAULT;
+INSERT INTO executions SELECT * FROM executions_unpartitioned;
+DROP TABLE executions_unpartitioned;
+
+ALTER TABLE history_node RENAME TO history_node_unpartitioned;
+ALTER INDEX history_node_pkey RENAME TO history_node_unpartitioned_pkey;
+CREATE TABLE history_node (LIKE history_node_unpartitioned INCLUDING ALL) PARTITION BY RANGE (shard_id);
+CREATE TABLE history_node_overflow PARTITION OF history_node DEFAULT;
+INSERT INTO history_node SELECT * FROM history_node_unpartitioned;
+DROP TABLE history_node_unpartitioned;
@@ -29,6 +29,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"sort"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -44,11 +45,14 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
+	querypb "go.temporal.io/api/query/v1"
+	replicationpb "go.temporal.io/api/replication/v1"
 	"go.temporal.io/api/serviceerror"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	sdkclient "go.temporal.io/sdk/client"
 	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
@@ -57,8 +61,10 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	schedspb "go.temporal.io/server/api/schedule/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
 	serverClient "go.temporal.io/server/client"
 	"go.temporal.io/server/client/admin"
@@ -69,26 +75,42 @@ import (
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/diagnostics"
+	"go.temporal.io/server/common/drain"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/historyimport"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/objectstore"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/snapshot"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/persistence/visibility/store/standard/cassandra"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/sharddistribution"
 	"go.temporal.io/server/common/xdc"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker"
 	"go.temporal.io/server/service/worker/addsearchattributes"
+	"go.temporal.io/server/service/worker/batcher"
+	historyscanner "go.temporal.io/server/service/worker/scanner/history"
+	"go.temporal.io/server/service/worker/scanner/visibilityconsistency"
+	"go.temporal.io/server/service/worker/scheduler"
 )
 
 const (
@@ -111,6 +133,8 @@ type (
 		visibilityMgr               manager.VisibilityManager
 		persistenceExecutionManager persistence.ExecutionManager
 		namespaceReplicationQueue   persistence.NamespaceReplicationQueue
+		namespaceReplicator         namespace.Replicator
+		archivalDLQ                 persistence.ArchivalDLQ
 		taskManager                 persistence.TaskManager
 		clusterMetadataManager      persistence.ClusterMetadataManager
 		persistenceMetadataManager  persistence.MetadataManager
@@ -125,6 +149,20 @@ type (
 		saManager                   searchattribute.Manager
 		clusterMetadata             cluster.Metadata
 		healthServer                *health.Server
+		persistenceConfig           *config.Persistence
+		circuitBreakers             *persistence.CircuitBreakers
+		namespaceUsage              *persistence.NamespaceUsageTracker
+		namespaceActions            *interceptor.NamespaceActionTracker
+		sdkUsage                    *interceptor.SDKUsageInterceptor
+		snapshotManager             *snapshot.Manager
+		visibilityChecker           *visibilityconsistency.Checker
+		historyGarbageCollector     *historyscanner.GarbageCollector
+		diagnosticsCollector        *diagnostics.Collector
+		shardDistributionReporter   *sharddistribution.Reporter
+		taskQueueTopologyDescriber  *taskQueueTopologyDescriber
+		historyImporter             *historyimport.Importer
+		batchOperations             *batchOperationStarter
+		operatorHandler             *OperatorHandlerImpl
 	}
 
 	NewAdminHandlerArgs struct {
@@ -154,6 +192,16 @@ type (
 		HealthServer                        *health.Server
 		EventSerializer                     serialization.Serializer
 		TimeSource                          clock.TimeSource
+		CircuitBreakers                     *persistence.CircuitBreakers
+		ShardManager                        persistence.ShardManager
+		NamespaceUsage                      *persistence.NamespaceUsageTracker
+		ArchivalDLQ                         persistence.ArchivalDLQ
+		DynamicConfigClient                 dynamicconfig.Client
+		MetricsConfig                       *metrics.Config
+		MatchingClient                      matchingservice.MatchingServiceClient
+		OperatorHandler                     *OperatorHandlerImpl
+		NamespaceActions                    *interceptor.NamespaceActionTracker
+		SDKUsage                            *interceptor.SDKUsageInterceptor
 	}
 )
 
@@ -161,6 +209,15 @@ var (
 	_ adminservice.AdminServiceServer = (*AdminHandler)(nil)
 
 	resendStartEventID = int64(0)
+
+	openWorkflowsQuery = fmt.Sprintf("%s = '%s'",
+		searchattribute.ExecutionStatus,
+		enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String(),
+	)
+	closedWorkflowsQuery = fmt.Sprintf("%s != '%s'",
+		searchattribute.ExecutionStatus,
+		enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String(),
+	)
 )
 
 // NewAdminHandler creates a gRPC handler for the adminservice
@@ -188,6 +245,8 @@ func NewAdminHandler(
 		ESClient:                    args.EsClient,
 		persistenceExecutionManager: args.PersistenceExecutionManager,
 		namespaceReplicationQueue:   args.NamespaceReplicationQueue,
+		namespaceReplicator:         namespace.NewNamespaceReplicator(args.ReplicatorNamespaceReplicationQueue, args.Logger),
+		archivalDLQ:                 args.ArchivalDLQ,
 		taskManager:                 args.TaskManager,
 		clusterMetadataManager:      args.ClusterMetadataManager,
 		persistenceMetadataManager:  args.PersistenceMetadataManager,
@@ -202,6 +261,75 @@ func NewAdminHandler(
 		saManager:                   args.SaManager,
 		clusterMetadata:             args.ClusterMetadata,
 		healthServer:                args.HealthServer,
+		persistenceConfig:           args.PersistenceConfig,
+		circuitBreakers:             args.CircuitBreakers,
+		namespaceUsage:              args.NamespaceUsage,
+		namespaceActions:            args.NamespaceActions,
+		sdkUsage:                    args.SDKUsage,
+		snapshotManager: snapshot.NewManager(
+			args.PersistenceConfig.NumHistoryShards,
+			args.ShardManager,
+			args.PersistenceExecutionManager,
+			args.PersistenceMetadataManager,
+			args.HistoryClient,
+			args.TimeSource,
+			args.Logger,
+		),
+		visibilityChecker: visibilityconsistency.NewChecker(
+			args.PersistenceConfig.NumHistoryShards,
+			args.PersistenceExecutionManager,
+			args.VisibilityMrg,
+			args.NamespaceRegistry,
+			args.HistoryClient,
+			quotas.NewDefaultOutgoingRateLimiter(func() float64 {
+				return float64(args.Config.VisibilityConsistencyCheckRPS())
+			}),
+			args.TimeSource,
+			args.MetricsHandler.WithTags(metrics.OperationTag(metrics.AdminCheckVisibilityConsistencyScope)),
+			args.Logger,
+		),
+		historyGarbageCollector: historyscanner.NewGarbageCollector(
+			args.PersistenceConfig.NumHistoryShards,
+			args.PersistenceExecutionManager,
+			args.HistoryClient,
+			quotas.NewDefaultOutgoingRateLimiter(func() float64 {
+				return float64(args.Config.HistoryGarbageDeletionRPS())
+			}),
+			args.Logger,
+		),
+		diagnosticsCollector: diagnostics.NewCollector(
+			args.PersistenceConfig.NumHistoryShards,
+			args.MembershipMonitor,
+			args.DynamicConfigClient,
+			args.MetricsConfig,
+			args.TimeSource,
+			args.Logger,
+		),
+		shardDistributionReporter: sharddistribution.NewReporter(
+			args.PersistenceConfig.NumHistoryShards,
+			args.ShardManager,
+			args.PersistenceExecutionManager,
+			args.MembershipMonitor,
+			args.Logger,
+		),
+		taskQueueTopologyDescriber: newTaskQueueTopologyDescriber(
+			args.MatchingClient,
+			args.MembershipMonitor,
+			args.NamespaceRegistry,
+			args.Config,
+		),
+		historyImporter: historyimport.NewImporter(
+			args.HistoryClient,
+			args.NamespaceRegistry,
+			args.ClusterMetadata,
+		),
+		batchOperations: &batchOperationStarter{
+			config:            args.Config,
+			namespaceRegistry: args.NamespaceRegistry,
+			visibilityMgr:     args.VisibilityMrg,
+			historyClient:     args.HistoryClient,
+		},
+		operatorHandler: args.OperatorHandler,
 	}
 }
 
@@ -404,6 +532,95 @@ func (adh *AdminHandler) addSearchAttributesSQL(
 	return err
 }
 
+// AddSearchAttributeAliases defines namespace aliases for custom search attributes registered in the cluster,
+// which are used instead of the field names in queries, upserts and responses of the namespace.
+// The aliases map each alias to the field name of the custom search attribute. An alias is removed with
+// UpdateNamespace by setting the alias of its field to empty string.
+func (adh *AdminHandler) AddSearchAttributeAliases(
+	ctx context.Context,
+	request *adminservice.AddSearchAttributeAliasesRequest,
+) (_ *adminservice.AddSearchAttributeAliasesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	taggedMetricsHandler, startTime := adh.startRequestProfile(metrics.AdminAddSearchAttributeAliasesScope)
+	defer func() {
+		taggedMetricsHandler.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime))
+	}()
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	nsName := request.GetNamespace()
+	if nsName == "" {
+		return nil, errNamespaceNotSet
+	}
+	aliases := request.GetAliases()
+	if len(aliases) == 0 {
+		return nil, errSearchAttributesNotSet
+	}
+
+	currentSearchAttributes, err := adh.saProvider.GetSearchAttributes(adh.visibilityMgr.GetIndexName(), true)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf(errUnableToGetSearchAttributesMessage, err))
+	}
+
+	_, client, err := adh.clientFactory.NewLocalFrontendClientWithTimeout(
+		frontend.DefaultTimeout,
+		frontend.DefaultLongPollTimeout,
+	)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf(errUnableToCreateFrontendClientMessage, err))
+	}
+	resp, err := client.DescribeNamespace(
+		ctx,
+		&workflowservice.DescribeNamespaceRequest{Namespace: nsName},
+	)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf(errUnableToGetNamespaceInfoMessage, nsName))
+	}
+
+	cmCustomSearchAttributes := currentSearchAttributes.Custom()
+	upsertFieldToAliasMap := make(map[string]string, len(aliases))
+	fieldToAliasMap := resp.Config.CustomSearchAttributeAliases
+	aliasToFieldMap := util.InverseMap(fieldToAliasMap)
+	for alias, fieldName := range aliases {
+		if searchattribute.IsReserved(alias) {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(errSearchAttributeIsReservedMessage, alias))
+		}
+		// alias can't shadow a search attribute registered in the cluster
+		if currentSearchAttributes.IsDefined(alias) {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(errSearchAttributeAlreadyExistsMessage, alias))
+		}
+		if _, ok := aliasToFieldMap[alias]; ok {
+			return nil, serviceerror.NewAlreadyExist(fmt.Sprintf(errSearchAttributeAlreadyExistsMessage, alias))
+		}
+		if _, ok := cmCustomSearchAttributes[fieldName]; !ok {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(errSearchAttributeDoesntExistMessage, fieldName))
+		}
+		if _, ok := fieldToAliasMap[fieldName]; ok {
+			return nil, serviceerror.NewAlreadyExist(fmt.Sprintf(errSearchAttributeAlreadyAliasedMessage, fieldName))
+		}
+		if _, ok := upsertFieldToAliasMap[fieldName]; ok {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(errSearchAttributeAlreadyAliasedMessage, fieldName))
+		}
+		upsertFieldToAliasMap[fieldName] = alias
+	}
+
+	_, err = client.UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+		Namespace: nsName,
+		Config: &namespacepb.NamespaceConfig{
+			CustomSearchAttributeAliases: upsertFieldToAliasMap,
+		},
+	})
+	if err != nil {
+		if err.Error() == errCustomSearchAttributeFieldAlreadyAllocated.Error() {
+			return nil, errRaceConditionAddingSearchAttributes
+		}
+		return nil, err
+	}
+	return &adminservice.AddSearchAttributeAliasesResponse{}, nil
+}
+
 // RemoveSearchAttributes remove search attribute from the cluster.
 func (adh *AdminHandler) RemoveSearchAttributes(
 	ctx context.Context,
@@ -703,6 +920,43 @@ func (adh *AdminHandler) DescribeMutableState(ctx context.Context, request *admi
 	}, nil
 }
 
+// DescribeVisibilityIngestion returns the visibility queue lag of the requested shards, and the state of the
+// Elasticsearch bulk processors, of each history host.
+func (adh *AdminHandler) DescribeVisibilityIngestion(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityIngestionRequest,
+) (_ *adminservice.DescribeVisibilityIngestionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
+	if err != nil {
+		return nil, err
+	}
+	members := resolver.Members()
+	response := &adminservice.DescribeVisibilityIngestionResponse{
+		Hosts: make([]*adminservice.HistoryHostVisibilityIngestion, 0, len(members)),
+	}
+	for _, member := range members {
+		resp, err := adh.historyClient.DescribeVisibilityIngestion(ctx, &historyservice.DescribeVisibilityIngestionRequest{
+			HostAddress: member.GetAddress(),
+			ShardIds:    request.GetShardIds(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		response.Hosts = append(response.Hosts, &adminservice.HistoryHostVisibilityIngestion{
+			Address:                    member.GetAddress(),
+			Shards:                     resp.GetShards(),
+			ElasticsearchBulkProcessor: resp.GetElasticsearchBulkProcessor(),
+		})
+	}
+	return response, nil
+}
+
 // RemoveTask returns information about the internal states of a history host
 func (adh *AdminHandler) RemoveTask(ctx context.Context, request *adminservice.RemoveTaskRequest) (_ *adminservice.RemoveTaskResponse, retError error) {
 	defer log.CapturePanic(adh.logger, &retError)
@@ -732,6 +986,23 @@ func (adh *AdminHandler) GetShard(ctx context.Context, request *adminservice.Get
 	return &adminservice.GetShardResponse{ShardInfo: resp.ShardInfo}, nil
 }
 
+// DescribeShard returns the live state of a history shard from the history host owning it
+func (adh *AdminHandler) DescribeShard(ctx context.Context, request *adminservice.DescribeShardRequest) (_ *adminservice.DescribeShardResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	resp, err := adh.historyClient.DescribeShard(ctx, &historyservice.DescribeShardRequest{ShardId: request.GetShardId()})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeShardResponse{
+		Owner:                    resp.GetOwner(),
+		Queues:                   resp.GetQueues(),
+		ReplicationStreamSenders: resp.GetReplicationStreamSenders(),
+	}, nil
+}
+
 // CloseShard returns information about the internal states of a history host
 func (adh *AdminHandler) CloseShard(ctx context.Context, request *adminservice.CloseShardRequest) (_ *adminservice.CloseShardResponse, retError error) {
 	defer log.CapturePanic(adh.logger, &retError)
@@ -1463,6 +1734,7 @@ func (adh *AdminHandler) PurgeDLQMessages(
 			ShardId:               request.GetShardId(),
 			SourceCluster:         request.GetSourceCluster(),
 			InclusiveEndMessageId: request.GetInclusiveEndMessageId(),
+			NamespaceId:           request.GetNamespaceId(),
 		})
 
 		if resp == nil {
@@ -1471,6 +1743,9 @@ func (adh *AdminHandler) PurgeDLQMessages(
 
 		return &adminservice.PurgeDLQMessagesResponse{}, err
 	case enumsspb.DEAD_LETTER_QUEUE_TYPE_NAMESPACE:
+		if request.GetNamespaceId() != "" {
+			return nil, errDLQNamespaceFilterIsNotSupported
+		}
 		err := adh.namespaceDLQHandler.Purge(ctx, request.GetInclusiveEndMessageId())
 		if err != nil {
 			return nil, err
@@ -1505,15 +1780,19 @@ func (adh *AdminHandler) MergeDLQMessages(
 			InclusiveEndMessageId: request.GetInclusiveEndMessageId(),
 			MaximumPageSize:       request.GetMaximumPageSize(),
 			NextPageToken:         request.GetNextPageToken(),
+			NamespaceId:           request.GetNamespaceId(),
 		})
 		if resp == nil {
 			return nil, err
 		}
 
 		return &adminservice.MergeDLQMessagesResponse{
-			NextPageToken: request.GetNextPageToken(),
+			NextPageToken: resp.GetNextPageToken(),
 		}, nil
 	case enumsspb.DEAD_LETTER_QUEUE_TYPE_NAMESPACE:
+		if request.GetNamespaceId() != "" {
+			return nil, errDLQNamespaceFilterIsNotSupported
+		}
 		token, err := adh.namespaceDLQHandler.Merge(
 			ctx,
 			request.GetInclusiveEndMessageId(),
@@ -1560,144 +1839,539 @@ func (adh *AdminHandler) RefreshWorkflowTasks(
 	return &adminservice.RefreshWorkflowTasksResponse{}, nil
 }
 
-// ResendReplicationTasks requests replication task from remote cluster
-func (adh *AdminHandler) ResendReplicationTasks(
+// UpdateWorkflowExecutionMemo upserts memo fields of a running or closed workflow and updates its visibility
+// record, without writing a history event or scheduling a workflow task.
+func (adh *AdminHandler) UpdateWorkflowExecutionMemo(
 	ctx context.Context,
-	request *adminservice.ResendReplicationTasksRequest,
-) (_ *adminservice.ResendReplicationTasksResponse, err error) {
+	request *adminservice.UpdateWorkflowExecutionMemoRequest,
+) (_ *adminservice.UpdateWorkflowExecutionMemoResponse, err error) {
 	defer log.CapturePanic(adh.logger, &err)
 
 	if request == nil {
 		return nil, errRequestNotSet
 	}
-	resender := xdc.NewNDCHistoryResender(
-		adh.namespaceRegistry,
-		adh.clientBean,
-		func(ctx context.Context, request *historyservice.ReplicateEventsV2Request) error {
-			_, err1 := adh.historyClient.ReplicateEventsV2(ctx, request)
-			return err1
-		},
-		adh.eventSerializer,
-		nil,
-		adh.logger,
-	)
-	if err := resender.SendSingleWorkflowHistory(
-		ctx,
-		request.GetRemoteCluster(),
-		namespace.ID(request.GetNamespaceId()),
-		request.GetWorkflowId(),
-		request.GetRunId(),
-		resendStartEventID,
-		request.StartVersion,
-		common.EmptyEventID,
-		common.EmptyVersion,
-	); err != nil {
+	if err := validateExecution(request.Execution); err != nil {
 		return nil, err
 	}
-	return &adminservice.ResendReplicationTasksResponse{}, nil
+	if request.GetMemo() == nil {
+		return nil, errMemoNotSet
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.historyClient.UpdateWorkflowExecutionMemo(ctx, &historyservice.UpdateWorkflowExecutionMemoRequest{
+		NamespaceId:       namespaceID.String(),
+		WorkflowExecution: request.Execution,
+		Memo:              request.Memo,
+		Identity:          request.Identity,
+		Reason:            request.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateWorkflowExecutionMemoResponse{Memo: resp.GetMemo()}, nil
 }
 
-// GetTaskQueueTasks returns tasks from task queue
-func (adh *AdminHandler) GetTaskQueueTasks(
+// UpsertWorkflowExecutionSearchAttributes upserts search attributes of a running or closed workflow and updates
+// its visibility record, without writing a history event or scheduling a workflow task.
+func (adh *AdminHandler) UpsertWorkflowExecutionSearchAttributes(
 	ctx context.Context,
-	request *adminservice.GetTaskQueueTasksRequest,
-) (_ *adminservice.GetTaskQueueTasksResponse, err error) {
+	request *adminservice.UpsertWorkflowExecutionSearchAttributesRequest,
+) (_ *adminservice.UpsertWorkflowExecutionSearchAttributesResponse, err error) {
 	defer log.CapturePanic(adh.logger, &err)
 
 	if request == nil {
 		return nil, errRequestNotSet
 	}
-
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	if request.GetSearchAttributes() == nil {
+		return nil, errSearchAttributesNotSet
+	}
 	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := adh.taskManager.GetTasks(ctx, &persistence.GetTasksRequest{
-		NamespaceID:        namespaceID.String(),
-		TaskQueue:          request.GetTaskQueue(),
-		TaskType:           request.GetTaskQueueType(),
-		InclusiveMinTaskID: request.GetMinTaskId(),
-		ExclusiveMaxTaskID: request.GetMaxTaskId(),
-		PageSize:           int(request.GetBatchSize()),
-		NextPageToken:      request.NextPageToken,
+	_, err = adh.historyClient.UpsertWorkflowExecutionSearchAttributes(ctx, &historyservice.UpsertWorkflowExecutionSearchAttributesRequest{
+		NamespaceId:       namespaceID.String(),
+		WorkflowExecution: request.Execution,
+		SearchAttributes:  request.SearchAttributes,
+		Identity:          request.Identity,
+		Reason:            request.Reason,
 	})
 	if err != nil {
 		return nil, err
 	}
-
-	return &adminservice.GetTaskQueueTasksResponse{
-		Tasks:         resp.Tasks,
-		NextPageToken: resp.NextPageToken,
-	}, nil
+	return &adminservice.UpsertWorkflowExecutionSearchAttributesResponse{}, nil
 }
 
-func (adh *AdminHandler) DeleteWorkflowExecution(
+// RehydrateWorkflowExecution imports the archived history of a closed workflow execution back into the
+// persistence store.
+func (adh *AdminHandler) RehydrateWorkflowExecution(
 	ctx context.Context,
-	request *adminservice.DeleteWorkflowExecutionRequest,
-) (_ *adminservice.DeleteWorkflowExecutionResponse, err error) {
+	request *adminservice.RehydrateWorkflowExecutionRequest,
+) (_ *adminservice.RehydrateWorkflowExecutionResponse, err error) {
 	defer log.CapturePanic(adh.logger, &err)
 
 	if request == nil {
 		return nil, errRequestNotSet
 	}
-
 	if err := validateExecution(request.Execution); err != nil {
 		return nil, err
 	}
-
 	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
 	if err != nil {
 		return nil, err
 	}
-	execution := request.Execution
 
-	shardID := common.WorkflowIDToHistoryShard(
-		namespaceID.String(),
-		execution.GetWorkflowId(),
-		adh.numberOfHistoryShards,
-	)
-	logger := log.With(adh.logger,
-		tag.WorkflowNamespace(request.Namespace),
-		tag.WorkflowID(execution.WorkflowId),
-		tag.WorkflowRunID(execution.RunId),
-	)
+	resp, err := adh.historyClient.RehydrateWorkflowExecution(ctx, &historyservice.RehydrateWorkflowExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+		NewRunId:    request.NewRunId,
+		Uri:         request.Uri,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.RehydrateWorkflowExecutionResponse{RunId: resp.GetRunId()}, nil
+}
 
-	if execution.RunId == "" {
-		resp, err := adh.persistenceExecutionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
-			ShardID:     shardID,
-			NamespaceID: namespaceID.String(),
-			WorkflowID:  execution.WorkflowId,
-		})
-		if err != nil {
-			return nil, err
-		}
-		execution.RunId = resp.RunID
+// GetNamespaceFeatureFlags returns the state of every experimental feature flag for a namespace. It's not part
+// of GetSystemInfo since that request carries no namespace.
+func (adh *AdminHandler) GetNamespaceFeatureFlags(
+	ctx context.Context,
+	request *adminservice.GetNamespaceFeatureFlagsRequest,
+) (_ *adminservice.GetNamespaceFeatureFlagsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if _, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace())); err != nil {
+		return nil, err
 	}
+	return &adminservice.GetNamespaceFeatureFlagsResponse{
+		Flags: adh.config.FeatureFlags.EnabledFlags(request.GetNamespace()),
+	}, nil
+}
 
-	var warnings []string
-	var branchTokens [][]byte
-	var startTime, closeTime *time.Time
-	cassVisBackend := adh.visibilityMgr.HasStoreName(cassandra.CassandraPersistenceName)
+// GetNamespaceVisibilityRetention returns how long the visibility records of closed workflow executions of a
+// namespace are kept.
+func (adh *AdminHandler) GetNamespaceVisibilityRetention(
+	ctx context.Context,
+	request *adminservice.GetNamespaceVisibilityRetentionRequest,
+) (_ *adminservice.GetNamespaceVisibilityRetentionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
 
-	resp, err := adh.persistenceExecutionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
-		ShardID:     shardID,
-		NamespaceID: namespaceID.String(),
-		WorkflowID:  execution.WorkflowId,
-		RunID:       execution.RunId,
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	resp, err := adh.persistenceMetadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{
+		Name: request.GetNamespace(),
 	})
 	if err != nil {
-		if common.IsContextCanceledErr(err) || common.IsContextDeadlineExceededErr(err) {
+		return nil, err
+	}
+	return &adminservice.GetNamespaceVisibilityRetentionResponse{
+		VisibilityRetention:        resp.Namespace.Config.VisibilityRetention,
+		WorkflowExecutionRetention: resp.Namespace.Config.Retention,
+	}, nil
+}
+
+// UpdateNamespaceVisibilityRetention sets how long the visibility records of closed workflow executions of a
+// namespace are kept. It's not part of UpdateNamespace since the namespace config of the API has no such field.
+// Like any other namespace update, it's replicated to the other clusters of a global namespace.
+func (adh *AdminHandler) UpdateNamespaceVisibilityRetention(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceVisibilityRetentionRequest,
+) (_ *adminservice.UpdateNamespaceVisibilityRetentionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	ns, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	if ns.IsGlobalNamespace() && !adh.clusterMetadata.IsMasterCluster() {
+		return nil, errNotMasterCluster
+	}
+	retention := request.GetVisibilityRetention()
+	if retention != nil && *retention == 0 {
+		retention = nil
+	}
+	if retention != nil {
+		if err := validateRetentionDuration(*retention, ns.IsGlobalNamespace()); err != nil {
 			return nil, err
 		}
-		// continue to deletion
-		warnMsg := "Unable to load mutable state when deleting workflow execution, " +
-			"will skip deleting workflow history and cassandra visibility record"
-		logger.Warn(warnMsg, tag.Error(err))
-		warnings = append(warnings, fmt.Sprintf("%s. Error: %v", warnMsg, err.Error()))
-	} else {
-		// load necessary information from mutable state
-		executionInfo := resp.State.GetExecutionInfo()
+	}
+
+	resp, err := persistence.UpdateNamespaceDetail(
+		ctx,
+		adh.persistenceMetadataManager,
+		&persistence.GetNamespaceRequest{ID: ns.ID().String()},
+		func(detail *persistencespb.NamespaceDetail) error {
+			detail.Config.VisibilityRetention = retention
+			detail.ConfigVersion++
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	detail := resp.Namespace
+	if err := adh.namespaceReplicator.HandleTransmissionTask(
+		ctx,
+		enumsspb.NAMESPACE_OPERATION_UPDATE,
+		detail.Info,
+		detail.Config,
+		detail.ReplicationConfig,
+		false,
+		detail.ConfigVersion,
+		detail.FailoverVersion,
+		resp.IsGlobalNamespace,
+		detail.ReplicationConfig.FailoverHistory,
+	); err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateNamespaceVisibilityRetentionResponse{}, nil
+}
+
+// DescribeScheduleBackfills returns the progress of the running and recently finished backfills of a schedule.
+// It's not part of DescribeScheduleResponse since that would need an API change.
+func (adh *AdminHandler) DescribeScheduleBackfills(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleBackfillsRequest,
+) (_ *adminservice.DescribeScheduleBackfillsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if !adh.config.EnableSchedules(request.GetNamespace()) {
+		return nil, errSchedulesNotAllowed
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := adh.historyClient.QueryWorkflow(ctx, &historyservice.QueryWorkflowRequest{
+		NamespaceId: namespaceID.String(),
+		Request: &workflowservice.QueryWorkflowRequest{
+			Namespace: request.GetNamespace(),
+			Execution: &commonpb.WorkflowExecution{WorkflowId: scheduler.WorkflowIDPrefix + request.GetScheduleId()},
+			Query:     &querypb.WorkflowQuery{QueryType: scheduler.QueryNameDescribeBackfills},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var backfills []*scheduler.BackfillProgress
+	if err := payloads.Decode(res.GetResponse().GetQueryResult(), &backfills); err != nil {
+		return nil, err
+	}
+	response := &adminservice.DescribeScheduleBackfillsResponse{
+		Backfills: make([]*adminservice.ScheduleBackfill, 0, len(backfills)),
+	}
+	for _, backfill := range backfills {
+		response.Backfills = append(response.Backfills, &adminservice.ScheduleBackfill{
+			Id:             backfill.ID,
+			StartTime:      timestamp.TimePtr(backfill.StartTime),
+			EndTime:        timestamp.TimePtr(backfill.EndTime),
+			RequestTime:    timestamp.TimePtr(backfill.RequestTime),
+			Total:          int64(backfill.Total),
+			Started:        int64(backfill.Started),
+			Remaining:      int64(backfill.Remaining),
+			CompletedUntil: timestamp.TimePtr(backfill.CompletedUntil),
+			Canceled:       backfill.Canceled,
+		})
+	}
+	return response, nil
+}
+
+// CancelScheduleBackfill drops the actions of a running backfill which were not taken yet.
+func (adh *AdminHandler) CancelScheduleBackfill(
+	ctx context.Context,
+	request *adminservice.CancelScheduleBackfillRequest,
+) (_ *adminservice.CancelScheduleBackfillResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if !adh.config.EnableSchedules(request.GetNamespace()) {
+		return nil, errSchedulesNotAllowed
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	inputPayloads, err := sdk.PreferProtoDataConverter.ToPayloads(request.GetBackfillId())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.historyClient.SignalWorkflowExecution(ctx, &historyservice.SignalWorkflowExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+			Namespace:         request.GetNamespace(),
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: scheduler.WorkflowIDPrefix + request.GetScheduleId()},
+			SignalName:        scheduler.SignalNameCancelBackfill,
+			Input:             inputPayloads,
+			Identity:          request.GetIdentity(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.CancelScheduleBackfillResponse{}, nil
+}
+
+// DescribeSchedulePauseAfterFailures returns the consecutive failure limit of a schedule, the current count of
+// consecutive failures, and why the schedule paused itself, if it did. It's not part of DescribeScheduleResponse
+// since that would need an API change.
+func (adh *AdminHandler) DescribeSchedulePauseAfterFailures(
+	ctx context.Context,
+	request *adminservice.DescribeSchedulePauseAfterFailuresRequest,
+) (_ *adminservice.DescribeSchedulePauseAfterFailuresResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if !adh.config.EnableSchedules(request.GetNamespace()) {
+		return nil, errSchedulesNotAllowed
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := adh.historyClient.QueryWorkflow(ctx, &historyservice.QueryWorkflowRequest{
+		NamespaceId: namespaceID.String(),
+		Request: &workflowservice.QueryWorkflowRequest{
+			Namespace: request.GetNamespace(),
+			Execution: &commonpb.WorkflowExecution{WorkflowId: scheduler.WorkflowIDPrefix + request.GetScheduleId()},
+			Query:     &querypb.WorkflowQuery{QueryType: scheduler.QueryNameDescribe},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var describe schedspb.DescribeResponse
+	if err := payloads.Decode(res.GetResponse().GetQueryResult(), &describe); err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeSchedulePauseAfterFailuresResponse{
+		PauseAfterFailures:  describe.GetPauseAfterFailures(),
+		ConsecutiveFailures: describe.GetConsecutiveFailures(),
+		PauseReason:         describe.GetPauseReason(),
+	}, nil
+}
+
+// UpdateSchedulePauseAfterFailures sets the number of consecutive failed or timed-out actions after which a schedule
+// pauses itself.
+func (adh *AdminHandler) UpdateSchedulePauseAfterFailures(
+	ctx context.Context,
+	request *adminservice.UpdateSchedulePauseAfterFailuresRequest,
+) (_ *adminservice.UpdateSchedulePauseAfterFailuresResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetPauseAfterFailures() < 0 {
+		return nil, serviceerror.NewInvalidArgument("pause after failures must not be negative")
+	}
+	if !adh.config.EnableSchedules(request.GetNamespace()) {
+		return nil, errSchedulesNotAllowed
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	inputPayloads, err := sdk.PreferProtoDataConverter.ToPayloads(request.GetPauseAfterFailures())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.historyClient.SignalWorkflowExecution(ctx, &historyservice.SignalWorkflowExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+			Namespace:         request.GetNamespace(),
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: scheduler.WorkflowIDPrefix + request.GetScheduleId()},
+			SignalName:        scheduler.SignalNameSetPauseAfterFailures,
+			Input:             inputPayloads,
+			Identity:          request.GetIdentity(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateSchedulePauseAfterFailuresResponse{}, nil
+}
+
+// ResendReplicationTasks requests replication task from remote cluster
+func (adh *AdminHandler) ResendReplicationTasks(
+	ctx context.Context,
+	request *adminservice.ResendReplicationTasksRequest,
+) (_ *adminservice.ResendReplicationTasksResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	resender := xdc.NewNDCHistoryResender(
+		adh.namespaceRegistry,
+		adh.clientBean,
+		func(ctx context.Context, request *historyservice.ReplicateEventsV2Request) error {
+			_, err1 := adh.historyClient.ReplicateEventsV2(ctx, request)
+			return err1
+		},
+		adh.eventSerializer,
+		nil,
+		adh.logger,
+	)
+	if err := resender.SendSingleWorkflowHistory(
+		ctx,
+		request.GetRemoteCluster(),
+		namespace.ID(request.GetNamespaceId()),
+		request.GetWorkflowId(),
+		request.GetRunId(),
+		resendStartEventID,
+		request.StartVersion,
+		common.EmptyEventID,
+		common.EmptyVersion,
+	); err != nil {
+		return nil, err
+	}
+	return &adminservice.ResendReplicationTasksResponse{}, nil
+}
+
+// GetTaskQueueTasks returns tasks from task queue
+func (adh *AdminHandler) GetTaskQueueTasks(
+	ctx context.Context,
+	request *adminservice.GetTaskQueueTasksRequest,
+) (_ *adminservice.GetTaskQueueTasksResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.taskManager.GetTasks(ctx, &persistence.GetTasksRequest{
+		NamespaceID:        namespaceID.String(),
+		TaskQueue:          request.GetTaskQueue(),
+		TaskType:           request.GetTaskQueueType(),
+		InclusiveMinTaskID: request.GetMinTaskId(),
+		ExclusiveMaxTaskID: request.GetMaxTaskId(),
+		PageSize:           int(request.GetBatchSize()),
+		NextPageToken:      request.NextPageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.GetTaskQueueTasksResponse{
+		Tasks:         resp.Tasks,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+func (adh *AdminHandler) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.DeleteWorkflowExecutionRequest,
+) (_ *adminservice.DeleteWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	execution := request.Execution
+
+	shardID := common.WorkflowIDToHistoryShard(
+		namespaceID.String(),
+		execution.GetWorkflowId(),
+		adh.numberOfHistoryShards,
+	)
+	logger := log.With(adh.logger,
+		tag.WorkflowNamespace(request.Namespace),
+		tag.WorkflowID(execution.WorkflowId),
+		tag.WorkflowRunID(execution.RunId),
+	)
+
+	if execution.RunId == "" {
+		resp, err := adh.persistenceExecutionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+			ShardID:     shardID,
+			NamespaceID: namespaceID.String(),
+			WorkflowID:  execution.WorkflowId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		execution.RunId = resp.RunID
+	}
+
+	var warnings []string
+	var branchTokens [][]byte
+	var startTime, closeTime *time.Time
+	cassVisBackend := adh.visibilityMgr.HasStoreName(cassandra.CassandraPersistenceName)
+
+	resp, err := adh.persistenceExecutionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: namespaceID.String(),
+		WorkflowID:  execution.WorkflowId,
+		RunID:       execution.RunId,
+	})
+	if err != nil {
+		if common.IsContextCanceledErr(err) || common.IsContextDeadlineExceededErr(err) {
+			return nil, err
+		}
+		// continue to deletion
+		warnMsg := "Unable to load mutable state when deleting workflow execution, " +
+			"will skip deleting workflow history and cassandra visibility record"
+		logger.Warn(warnMsg, tag.Error(err))
+		warnings = append(warnings, fmt.Sprintf("%s. Error: %v", warnMsg, err.Error()))
+	} else {
+		// load necessary information from mutable state
+		executionInfo := resp.State.GetExecutionInfo()
 		histories := executionInfo.GetVersionHistories().GetHistories()
 		branchTokens = make([][]byte, 0, len(histories))
 		for _, historyItem := range histories {
@@ -1963,6 +2637,980 @@ func (adh *AdminHandler) getWorkflowCompletionEvent(
 	return nil, serviceerror.NewInternal("Unable to find closed event for workflow")
 }
 
+// StreamDatabaseBackup streams an online backup snapshot of the default persistence store to the caller,
+// which is only supported by the SQLite store.
+func (adh *AdminHandler) StreamDatabaseBackup(
+	_ *adminservice.StreamDatabaseBackupRequest,
+	server adminservice.AdminService_StreamDatabaseBackupServer,
+) (retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	taggedMetricsHandler, startTime := adh.startRequestProfile(metrics.AdminStreamDatabaseBackupScope)
+	defer func() {
+		taggedMetricsHandler.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime))
+	}()
+
+	storeCfg := adh.persistenceConfig.DataStores[adh.persistenceConfig.DefaultStore]
+	if storeCfg.SQL == nil || storeCfg.SQL.PluginName != sqlite.PluginName {
+		return errDatabaseBackupNotSupported
+	}
+
+	adh.logger.Info("Streaming online database backup.")
+	if err := sqlite.Backup(server.Context(), storeCfg.SQL, resolver.NewNoopResolver(), databaseBackupWriter{server}); err != nil {
+		return serviceerror.NewUnavailable(err.Error())
+	}
+	return nil
+}
+
+// databaseBackupWriter sends each chunk of a database backup as a StreamDatabaseBackup response.
+type databaseBackupWriter struct {
+	server adminservice.AdminService_StreamDatabaseBackupServer
+}
+
+func (w databaseBackupWriter) Write(data []byte) (int, error) {
+	if err := w.server.Send(&adminservice.StreamDatabaseBackupResponse{Data: data}); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+// CreateClusterSnapshot takes a logical snapshot of the namespaces and workflow executions of the cluster,
+// and writes it with the given ID to the object store at storeURI. Each shard is fenced from the history
+// service while it is read, so workflows of a shard are not progressing during its snapshot.
+func (adh *AdminHandler) CreateClusterSnapshot(
+	ctx context.Context,
+	request *adminservice.CreateClusterSnapshotRequest,
+) (_ *adminservice.CreateClusterSnapshotResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	taggedMetricsHandler, startTime := adh.startRequestProfile(metrics.AdminCreateClusterSnapshotScope)
+	defer func() {
+		taggedMetricsHandler.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime))
+	}()
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetSnapshotId() == "" {
+		return nil, errSnapshotIDNotSet
+	}
+	store, err := objectstore.NewStore(request.GetStoreUri())
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+	adh.logger.Info("Taking cluster snapshot.", tag.NewStringTag("snapshot-id", request.GetSnapshotId()))
+	manifest, err := adh.snapshotManager.Backup(ctx, store, request.GetSnapshotId())
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.CreateClusterSnapshotResponse{Manifest: clusterSnapshotManifestToProto(manifest)}, nil
+}
+
+// RestoreClusterSnapshot restores the snapshot with the given ID from the object store at storeURI into this
+// cluster, which must be configured with the same number of history shards.
+func (adh *AdminHandler) RestoreClusterSnapshot(
+	ctx context.Context,
+	request *adminservice.RestoreClusterSnapshotRequest,
+) (_ *adminservice.RestoreClusterSnapshotResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	taggedMetricsHandler, startTime := adh.startRequestProfile(metrics.AdminRestoreClusterSnapshotScope)
+	defer func() {
+		taggedMetricsHandler.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime))
+	}()
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetSnapshotId() == "" {
+		return nil, errSnapshotIDNotSet
+	}
+	store, err := objectstore.NewStore(request.GetStoreUri())
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+	adh.logger.Info("Restoring cluster snapshot.", tag.NewStringTag("snapshot-id", request.GetSnapshotId()))
+	manifest, err := adh.snapshotManager.Restore(ctx, store, request.GetSnapshotId())
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.RestoreClusterSnapshotResponse{Manifest: clusterSnapshotManifestToProto(manifest)}, nil
+}
+
+func clusterSnapshotManifestToProto(manifest *snapshot.Manifest) *adminservice.ClusterSnapshotManifest {
+	shards := make([]*adminservice.ClusterSnapshotShard, 0, len(manifest.Shards))
+	for _, shard := range manifest.Shards {
+		shards = append(shards, &adminservice.ClusterSnapshotShard{
+			ShardId:    shard.ShardID,
+			Executions: int64(shard.Executions),
+		})
+	}
+	return &adminservice.ClusterSnapshotManifest{
+		SnapshotId:       manifest.SnapshotID,
+		CreateTime:       timestamp.TimePtr(manifest.CreateTime),
+		NumHistoryShards: manifest.NumHistoryShards,
+		Namespaces:       int32(manifest.Namespaces),
+		Shards:           shards,
+	}
+}
+
+// CheckVisibilityConsistency cross-checks the executions of the requested shards against visibility, and the
+// visibility records of the requested namespaces against the primary store. The inconsistencies found are only
+// reported, unless the request asks for them to be repaired.
+func (adh *AdminHandler) CheckVisibilityConsistency(
+	ctx context.Context,
+	request *adminservice.CheckVisibilityConsistencyRequest,
+) (_ *adminservice.CheckVisibilityConsistencyResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	taggedMetricsHandler, startTime := adh.startRequestProfile(metrics.AdminCheckVisibilityConsistencyScope)
+	defer func() {
+		taggedMetricsHandler.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime))
+	}()
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetShardIds()) == 0 && len(request.GetNamespaces()) == 0 {
+		return nil, serviceerror.NewInvalidArgument("at least one shard or namespace must be specified")
+	}
+	adh.logger.Info("Checking visibility consistency.", tag.NewBoolTag("repair", request.GetRepair()))
+	report, err := adh.visibilityChecker.Check(ctx, visibilityconsistency.CheckRequest{
+		CheckOptions: visibilityconsistency.CheckOptions{
+			Repair:                     request.GetRepair(),
+			GracePeriod:                timestamp.DurationValue(request.GetGracePeriod()),
+			PageSize:                   int(request.GetPageSize()),
+			MaxReportedInconsistencies: int(request.GetMaxReportedInconsistencies()),
+			DeleteExpiredRecords:       request.GetDeleteExpiredRecords(),
+		},
+		ShardIDs:   request.GetShardIds(),
+		Namespaces: request.GetNamespaces(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	inconsistencies := make([]*adminservice.VisibilityInconsistency, 0, len(report.Inconsistencies))
+	for _, inconsistency := range report.Inconsistencies {
+		inconsistencies = append(inconsistencies, &adminservice.VisibilityInconsistency{
+			Type:             string(inconsistency.Type),
+			NamespaceId:      inconsistency.NamespaceID,
+			WorkflowId:       inconsistency.WorkflowID,
+			RunId:            inconsistency.RunID,
+			PrimaryStatus:    inconsistency.PrimaryStatus,
+			VisibilityStatus: inconsistency.VisibilityStatus,
+			Repaired:         inconsistency.Repaired,
+		})
+	}
+	return &adminservice.CheckVisibilityConsistencyResponse{
+		ExecutionsChecked: int64(report.ExecutionsChecked),
+		RecordsChecked:    int64(report.RecordsChecked),
+		MissingRecords:    int64(report.MissingRecords),
+		StaleRecords:      int64(report.StaleRecords),
+		GhostRecords:      int64(report.GhostRecords),
+		Repaired:          int64(report.Repaired),
+		ExpiredRecords:    int64(report.ExpiredRecords),
+		Inconsistencies:   inconsistencies,
+	}, nil
+}
+
+// DeleteHistoryBranchGarbage deletes the garbage history branches reported by a dry run of the history scanner,
+// once an operator confirmed them. Branches are checked again before they're deleted, at the rate of
+// frontend.historyGarbageDeletionRPS.
+func (adh *AdminHandler) DeleteHistoryBranchGarbage(
+	ctx context.Context,
+	request *adminservice.DeleteHistoryBranchGarbageRequest,
+) (_ *adminservice.DeleteHistoryBranchGarbageResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	taggedMetricsHandler, startTime := adh.startRequestProfile(metrics.AdminDeleteHistoryBranchGarbageScope)
+	defer func() {
+		taggedMetricsHandler.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime))
+	}()
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetCandidates()) == 0 {
+		return nil, serviceerror.NewInvalidArgument("no history branches to delete")
+	}
+
+	candidates := make([]historyscanner.BranchCandidate, 0, len(request.GetCandidates()))
+	for _, candidate := range request.GetCandidates() {
+		candidates = append(candidates, historyscanner.BranchCandidate{
+			ShardID:     candidate.GetShardId(),
+			NamespaceID: candidate.GetNamespaceId(),
+			WorkflowID:  candidate.GetWorkflowId(),
+			RunID:       candidate.GetRunId(),
+			BranchToken: candidate.GetBranchToken(),
+			Reason:      candidate.GetReason(),
+			SizeBytes:   candidate.GetSizeBytes(),
+		})
+	}
+	adh.logger.Info("Deleting history branch garbage.", tag.NewInt("count", len(candidates)))
+	result, err := adh.historyGarbageCollector.Delete(ctx, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DeleteHistoryBranchGarbageResponse{
+		Deleted: int64(result.Deleted),
+		Skipped: int64(result.Skipped),
+		Failed:  int64(result.Failed),
+	}, nil
+}
+
+// ListTopPersistenceNamespaces lists the namespaces which made the most persistence requests from this host
+// over the window, busiest first. The window is at most an hour.
+func (adh *AdminHandler) ListTopPersistenceNamespaces(
+	_ context.Context,
+	request *adminservice.ListTopPersistenceNamespacesRequest,
+) (_ *adminservice.ListTopPersistenceNamespacesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	taggedMetricsHandler, startTime := adh.startRequestProfile(metrics.AdminListTopPersistenceNamespacesScope)
+	defer func() {
+		taggedMetricsHandler.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime))
+	}()
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	window := timestamp.DurationValue(request.GetWindow())
+	if request.GetCount() <= 0 || window <= 0 {
+		return nil, serviceerror.NewInvalidArgument("the number of namespaces and the window must be positive")
+	}
+	if adh.namespaceUsage == nil {
+		return &adminservice.ListTopPersistenceNamespacesResponse{}, nil
+	}
+
+	var namespaces []*adminservice.PersistenceNamespaceUsage
+	for _, usage := range adh.namespaceUsage.Top(int(request.GetCount()), window) {
+		namespaces = append(namespaces, &adminservice.PersistenceNamespaceUsage{
+			Namespace:    usage.Namespace,
+			Requests:     usage.Requests,
+			Errors:       usage.Errors,
+			TotalLatency: timestamp.DurationPtr(usage.TotalLatency),
+		})
+	}
+	return &adminservice.ListTopPersistenceNamespacesResponse{Namespaces: namespaces}, nil
+}
+
+// DescribeNamespaceStats computes capacity statistics of a namespace. Workflow counts come from visibility, where
+// closed workflows are kept for the namespace retention. The storage estimate is the usage last recorded by the
+// storage usage scanner. The action rate is the one served by this host over the last hour, multiplied by the
+// number of frontend hosts, which assumes requests are balanced across them.
+func (adh *AdminHandler) DescribeNamespaceStats(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceStatsRequest,
+) (_ *adminservice.DescribeNamespaceStatsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	taggedMetricsHandler, startTime := adh.startRequestProfile(metrics.AdminDescribeNamespaceStatsScope)
+	defer func() {
+		taggedMetricsHandler.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime))
+	}()
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	nsEntry, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	openCount, err := adh.visibilityMgr.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: nsEntry.ID(),
+		Namespace:   nsEntry.Name(),
+		Query:       openWorkflowsQuery,
+	})
+	if err != nil {
+		return nil, err
+	}
+	closedCount, err := adh.visibilityMgr.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: nsEntry.ID(),
+		Namespace:   nsEntry.Name(),
+		Query:       closedWorkflowsQuery,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var actionsPerSecond float64
+	if adh.namespaceActions != nil {
+		resolver, err := adh.membershipMonitor.GetResolver(primitives.FrontendService)
+		if err != nil {
+			return nil, err
+		}
+		actionsPerSecond = adh.namespaceActions.Rate(nsEntry.Name().String()) * float64(util.Max(resolver.MemberCount(), 1))
+	}
+
+	storageUsage := nsEntry.StorageUsage()
+	return &adminservice.DescribeNamespaceStatsResponse{
+		NamespaceId:         nsEntry.ID().String(),
+		OpenWorkflowCount:   openCount.Count,
+		ClosedWorkflowCount: closedCount.Count,
+		ActionsPerSecond:    actionsPerSecond,
+		StorageBytes:        storageUsage.TotalBytes(),
+		StorageBytesByType:  storageUsage.ByStorageType(),
+	}, nil
+}
+
+// StartBatchOperation starts a batch job of an operation type the public StartBatchOperation API can't express:
+// sending an update to workflows, or resetting the workflows processed by a bad worker build.
+func (adh *AdminHandler) StartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartBatchOperationRequest,
+) (_ *adminservice.StartBatchOperationResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	taggedMetricsHandler, startTime := adh.startRequestProfile(metrics.AdminStartBatchOperationScope)
+	defer func() {
+		taggedMetricsHandler.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime))
+	}()
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	input := &batcher.BatchParams{
+		Namespace:  request.GetNamespace(),
+		Query:      request.GetVisibilityQuery(),
+		Executions: request.GetExecutions(),
+		Reason:     request.GetReason(),
+	}
+	switch op := request.Operation.(type) {
+	case *adminservice.StartBatchOperationRequest_UpdateOperation:
+		if op.UpdateOperation.GetUpdateName() == "" {
+			return nil, errUpdateNameNotSet
+		}
+		input.BatchType = batcher.BatchTypeUpdate
+		input.UpdateParams = batcher.UpdateParams{
+			UpdateName: op.UpdateOperation.GetUpdateName(),
+			Input:      op.UpdateOperation.GetInput(),
+		}
+	case *adminservice.StartBatchOperationRequest_ResetToBuildIdOperation:
+		if op.ResetToBuildIdOperation.GetBuildId() == "" {
+			return nil, errBuildIDNotSet
+		}
+		input.BatchType = batcher.BatchTypeReset
+		input.ResetParams = batcher.ResetParams{
+			BuildID:           op.ResetToBuildIdOperation.GetBuildId(),
+			ResetReapplytType: op.ResetToBuildIdOperation.GetResetReapplyType(),
+		}
+	case nil:
+		return nil, errBatchOperationNotSet
+	default:
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("The operation type %T is not supported", op))
+	}
+
+	if err := adh.batchOperations.validate(request.GetJobId(), input); err != nil {
+		return nil, err
+	}
+	if err := adh.batchOperations.start(ctx, request.GetJobId(), request.GetIdentity(), input); err != nil {
+		return nil, err
+	}
+	return &adminservice.StartBatchOperationResponse{}, nil
+}
+
+// DescribeNamespaceReplicationStatus reports the replication lag towards each remote cluster of a namespace, how
+// many history shards still hold back its pending handover, and its last failover.
+func (adh *AdminHandler) DescribeNamespaceReplicationStatus(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceReplicationStatusRequest,
+) (_ *adminservice.DescribeNamespaceReplicationStatusResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	taggedMetricsHandler, startTime := adh.startRequestProfile(metrics.AdminDescribeNamespaceReplicationStatusScope)
+	defer func() {
+		taggedMetricsHandler.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime))
+	}()
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	// read the namespace from persistence, the registry may not have picked up a handover yet
+	nsResp, err := adh.persistenceMetadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{
+		Name: request.GetNamespace(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	nsDetail := nsResp.Namespace
+	replicationConfig := nsDetail.GetReplicationConfig()
+
+	resp := &adminservice.DescribeNamespaceReplicationStatusResponse{
+		NamespaceId:       nsDetail.GetInfo().GetId(),
+		ActiveClusterName: replicationConfig.GetActiveClusterName(),
+		State:             replicationConfig.GetState(),
+	}
+	if failoverHistory := replicationConfig.GetFailoverHistory(); len(failoverHistory) > 0 {
+		lastFailover := failoverHistory[len(failoverHistory)-1]
+		resp.LastFailover = &replicationpb.FailoverStatus{
+			FailoverTime:    lastFailover.GetFailoverTime(),
+			FailoverVersion: lastFailover.GetFailoverVersion(),
+		}
+	}
+
+	currentClusterName := adh.clusterMetadata.GetCurrentClusterName()
+	var remoteClusters []string
+	for _, clusterName := range replicationConfig.GetClusters() {
+		if clusterName != currentClusterName {
+			remoteClusters = append(remoteClusters, clusterName)
+		}
+	}
+	if len(remoteClusters) == 0 {
+		return resp, nil
+	}
+
+	statusResp, err := adh.historyClient.GetReplicationStatus(ctx, &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: remoteClusters,
+	})
+	if err != nil {
+		return nil, err
+	}
+	inHandover := replicationConfig.GetState() == enumspb.REPLICATION_STATE_HANDOVER
+	for _, clusterName := range remoteClusters {
+		lag, backlog := replicationLag(statusResp.GetShards(), clusterName)
+		clusterStatus := &adminservice.NamespaceRemoteClusterReplicationStatus{
+			ClusterName:      clusterName,
+			Lag:              &lag,
+			BacklogTaskCount: backlog,
+		}
+		if inHandover {
+			clusterStatus.HandoverPendingShardCount = handoverPendingShardCount(
+				statusResp.GetShards(),
+				request.GetNamespace(),
+				clusterName,
+			)
+		}
+		resp.RemoteClusters = append(resp.RemoteClusters, clusterStatus)
+	}
+	return resp, nil
+}
+
+// handoverPendingShardCount returns the number of shards on which the remote cluster has not yet acknowledged every
+// replication task created before the namespace entered handover. A shard which hasn't registered the handover yet
+// is pending as well.
+func handoverPendingShardCount(shards []*historyservice.ShardReplicationStatus, nsName string, clusterName string) int32 {
+	var pending int32
+	for _, shard := range shards {
+		remote, hasRemote := shard.GetRemoteClusters()[clusterName]
+		handover, hasHandover := shard.GetHandoverNamespaces()[nsName]
+		if !hasRemote || !hasHandover || remote.GetAckedTaskId() < handover.GetHandoverReplicationTaskId() {
+			pending++
+		}
+	}
+	return pending
+}
+
+// DescribePersistenceCircuitBreakers returns the state of the circuit breaker of each persistence store of this host,
+// keyed by store name. Stores which haven't served any request yet are not listed.
+func (adh *AdminHandler) DescribePersistenceCircuitBreakers(
+	_ context.Context,
+	_ *adminservice.DescribePersistenceCircuitBreakersRequest,
+) (_ *adminservice.DescribePersistenceCircuitBreakersResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	taggedMetricsHandler, startTime := adh.startRequestProfile(metrics.AdminDescribePersistenceCircuitBreakersScope)
+	defer func() {
+		taggedMetricsHandler.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime))
+	}()
+
+	states := make(map[string]string)
+	if adh.circuitBreakers != nil {
+		for store, state := range adh.circuitBreakers.States() {
+			states[store] = state.String()
+		}
+	}
+	return &adminservice.DescribePersistenceCircuitBreakersResponse{States: states}, nil
+}
+
+// ListMetrics serves OperatorHandlerImpl.ListMetrics, which operatorservice doesn't define.
+func (adh *AdminHandler) ListMetrics(
+	ctx context.Context,
+	request *adminservice.ListMetricsRequest,
+) (_ *adminservice.ListMetricsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	entries, err := adh.operatorHandler.ListMetrics(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &adminservice.ListMetricsResponse{}
+	for _, entry := range entries {
+		resp.Metrics = append(resp.Metrics, &adminservice.MetricDescription{
+			Name:        entry.Name,
+			Type:        string(entry.Type),
+			Unit:        string(entry.Unit),
+			Description: entry.Description,
+			Tags:        entry.Tags,
+		})
+	}
+	return resp, nil
+}
+
+// ListArchivalDLQTasks returns a page of the archival tasks which exhausted their retries, oldest first.
+func (adh *AdminHandler) ListArchivalDLQTasks(
+	ctx context.Context,
+	request *adminservice.ListArchivalDLQTasksRequest,
+) (_ *adminservice.ListArchivalDLQTasksResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	taggedMetricsHandler, startTime := adh.startRequestProfile(metrics.AdminListArchivalDLQTasksScope)
+	defer func() {
+		taggedMetricsHandler.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime))
+	}()
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetPageSize() <= 0 {
+		return nil, serviceerror.NewInvalidArgument("page size must be positive")
+	}
+	tasks, nextPageToken, err := adh.archivalDLQ.List(ctx, int(request.GetPageSize()), request.GetNextPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &adminservice.ListArchivalDLQTasksResponse{
+		Tasks:         make([]*adminservice.ArchivalDLQTask, 0, len(tasks)),
+		NextPageToken: nextPageToken,
+	}
+	for _, task := range tasks {
+		response.Tasks = append(response.Tasks, &adminservice.ArchivalDLQTask{
+			MessageId:    task.MessageID,
+			ShardId:      task.ShardID,
+			NamespaceId:  task.NamespaceID,
+			WorkflowId:   task.WorkflowID,
+			RunId:        task.RunID,
+			TaskId:       task.TaskID,
+			Version:      task.Version,
+			Attempt:      int32(task.Attempt),
+			LastError:    task.LastError,
+			EnqueuedTime: timestamp.TimePtr(task.EnqueuedTime),
+		})
+	}
+	return response, nil
+}
+
+// RetryArchivalDLQTask retries the archival task with the given DLQ message ID by refreshing the tasks of its
+// workflow, which schedules a new archival task, and removes it from the DLQ. The workflow is deleted once it is
+// archived, as usual. Tasks of workflows which don't exist anymore are removed from the DLQ.
+func (adh *AdminHandler) RetryArchivalDLQTask(
+	ctx context.Context,
+	request *adminservice.RetryArchivalDLQTaskRequest,
+) (_ *adminservice.RetryArchivalDLQTaskResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	taggedMetricsHandler, startTime := adh.startRequestProfile(metrics.AdminRetryArchivalDLQTaskScope)
+	defer func() {
+		taggedMetricsHandler.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime))
+	}()
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	messageID := request.GetMessageId()
+	task, err := adh.archivalDLQ.Get(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := log.With(
+		adh.logger,
+		tag.TaskID(messageID),
+		tag.WorkflowNamespaceID(task.NamespaceID),
+		tag.WorkflowID(task.WorkflowID),
+		tag.WorkflowRunID(task.RunID),
+	)
+	_, err = adh.historyClient.RefreshWorkflowTasks(ctx, &historyservice.RefreshWorkflowTasksRequest{
+		NamespaceId: task.NamespaceID,
+		Request: &adminservice.RefreshWorkflowTasksRequest{
+			NamespaceId: task.NamespaceID,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: task.WorkflowID,
+				RunId:      task.RunID,
+			},
+		},
+	})
+	switch err.(type) {
+	case nil:
+		logger.Info("Retrying archival DLQ task.")
+	case *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
+		logger.Info("Removing archival DLQ task of deleted workflow.")
+	default:
+		return nil, err
+	}
+	if err := adh.archivalDLQ.Delete(ctx, messageID); err != nil {
+		return nil, err
+	}
+	return &adminservice.RetryArchivalDLQTaskResponse{}, nil
+}
+
+// StreamDiagnosticsBundle streams a gzipped tar archive of the diagnostics of this host to the caller: goroutine
+// and heap profiles, the effective dynamic config, the membership view, shard ownership and a snapshot of the
+// Prometheus metrics of the host. Sections which can't be collected are listed in the errors.txt file of the
+// archive.
+func (adh *AdminHandler) StreamDiagnosticsBundle(
+	_ *adminservice.StreamDiagnosticsBundleRequest,
+	server adminservice.AdminService_StreamDiagnosticsBundleServer,
+) (retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	taggedMetricsHandler, startTime := adh.startRequestProfile(metrics.AdminStreamDiagnosticsBundleScope)
+	defer func() {
+		taggedMetricsHandler.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime))
+	}()
+
+	adh.logger.Info("Collecting diagnostics bundle.")
+	return adh.diagnosticsCollector.Write(server.Context(), diagnosticsBundleWriter{server})
+}
+
+// diagnosticsBundleWriter sends each chunk of a diagnostics bundle as a StreamDiagnosticsBundle response.
+type diagnosticsBundleWriter struct {
+	server adminservice.AdminService_StreamDiagnosticsBundleServer
+}
+
+func (w diagnosticsBundleWriter) Write(data []byte) (int, error) {
+	if err := w.server.Send(&adminservice.StreamDiagnosticsBundleResponse{Data: data}); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+// DescribeShardDistribution lists every history shard with the host it is assigned to, the owner which last
+// acquired it, the ack levels of its queues and, if requested, their pending tasks, along with the number of
+// shards and pending tasks of each history host. Shard states are read from persistence, so they lag behind
+// their owners by up to history.shardUpdateMinInterval.
+func (adh *AdminHandler) DescribeShardDistribution(
+	ctx context.Context,
+	request *adminservice.DescribeShardDistributionRequest,
+) (_ *adminservice.DescribeShardDistributionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	taggedMetricsHandler, startTime := adh.startRequestProfile(metrics.AdminDescribeShardDistributionScope)
+	defer func() {
+		taggedMetricsHandler.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime))
+	}()
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetMaxPendingTasks() < 0 {
+		return nil, serviceerror.NewInvalidArgument("max pending tasks must not be negative")
+	}
+	report, err := adh.shardDistributionReporter.Report(ctx, sharddistribution.Request{
+		MaxPendingTasks: int(request.GetMaxPendingTasks()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &adminservice.DescribeShardDistributionResponse{
+		MinShardsPerHost:  int32(report.MinShardsPerHost),
+		MaxShardsPerHost:  int32(report.MaxShardsPerHost),
+		MeanShardsPerHost: report.MeanShardsPerHost,
+	}
+	for _, shard := range report.Shards {
+		shardResp := &adminservice.ShardDistributionShard{
+			ShardId:          shard.ShardID,
+			Host:             shard.Host,
+			Owner:            shard.Owner,
+			Moving:           shard.Moving,
+			RangeId:          shard.RangeID,
+			StolenSinceRenew: shard.StolenSinceRenew,
+			UpdateTime:       timestamp.TimePtr(shard.UpdateTime),
+			Error:            shard.Error,
+		}
+		for _, queue := range shard.Queues {
+			shardResp.Queues = append(shardResp.Queues, &adminservice.ShardDistributionQueue{
+				Category: queue.Category,
+				AckLevel: &historyspb.TaskKey{
+					TaskId:   queue.AckLevel.TaskID,
+					FireTime: timestamp.TimePtr(queue.AckLevel.FireTime),
+				},
+				PendingTasks:       int32(queue.PendingTasks),
+				PendingTasksCapped: queue.PendingTasksCapped,
+			})
+		}
+		resp.Shards = append(resp.Shards, shardResp)
+	}
+	for _, host := range report.Hosts {
+		resp.Hosts = append(resp.Hosts, &adminservice.ShardDistributionHost{
+			Host:         host.Host,
+			NumShards:    int32(host.NumShards),
+			MovingShards: int32(host.MovingShards),
+			PendingTasks: int32(host.PendingTasks),
+		})
+	}
+	return resp, nil
+}
+
+// DescribeTaskQueueTopology lists the read and write partitions of a task queue, with the matching host owning
+// each of them, whether it is loaded there, its backlog and whether it caught up with the user data of the root
+// partition. Describing a partition doesn't load it. Both task queue types are described if the task queue type is
+// unspecified.
+func (adh *AdminHandler) DescribeTaskQueueTopology(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueTopologyRequest,
+) (_ *adminservice.DescribeTaskQueueTopologyResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	taggedMetricsHandler, startTime := adh.startRequestProfile(metrics.AdminDescribeTaskQueueTopologyScope)
+	defer func() {
+		taggedMetricsHandler.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime))
+	}()
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	return adh.taskQueueTopologyDescriber.describe(ctx, request.GetNamespace(), request.GetTaskQueue(), request.GetTaskQueueType())
+}
+
+// ImportWorkflowExecution creates a run from history events exported from another cluster, or continues the
+// import of a run started by a previous request. The run is applied as a replicated one, so a closed run is
+// imported closed and a running run is owned by the cluster of its last event version. Event versions must
+// belong to clusters known to this cluster, see historyimport.Importer.Import.
+func (adh *AdminHandler) ImportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ImportWorkflowExecutionRequest,
+) (_ *adminservice.ImportWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	taggedMetricsHandler, startTime := adh.startRequestProfile(metrics.AdminImportWorkflowExecutionScope)
+	defer func() {
+		taggedMetricsHandler.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime))
+	}()
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetExecution() == nil {
+		return nil, errExecutionNotSet
+	}
+	if request.GetExecution().GetWorkflowId() == "" {
+		return nil, errWorkflowIDNotSet
+	}
+	if request.GetExecution().GetRunId() == "" || uuid.Parse(request.GetExecution().GetRunId()) == nil {
+		return nil, errInvalidRunID
+	}
+	if err := adh.historyImporter.Import(ctx, historyimport.Request{
+		Namespace:      namespace.Name(request.GetNamespace()),
+		Execution:      request.GetExecution(),
+		HistoryBatches: request.GetHistoryBatches(),
+		VersionHistory: request.GetVersionHistory(),
+	}); err != nil {
+		return nil, err
+	}
+	return &adminservice.ImportWorkflowExecutionResponse{}, nil
+}
+
+// StartDrain puts the host with the given address of a service role, or all hosts of the role if host is empty,
+// into drain mode: frontend hosts refuse new long polls, matching hosts unload their task queues once other
+// hosts took them over and history hosts release their shards. Hosts pick the request up within seconds, and
+// stay drained until they restart. DescribeDrain reports when they are drained.
+func (adh *AdminHandler) StartDrain(
+	ctx context.Context,
+	request *adminservice.StartDrainRequest,
+) (_ *adminservice.StartDrainResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	taggedMetricsHandler, startTime := adh.startRequestProfile(metrics.AdminStartDrainScope)
+	defer func() {
+		taggedMetricsHandler.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime))
+	}()
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	role := primitives.ServiceName(request.GetRole())
+	host := request.GetHost()
+	if !slices.Contains(drain.Roles, role) {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("service role %q does not support drain mode", role))
+	}
+	if host != "" {
+		members, err := adh.roleMembers(role)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(members, host) {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("host %q is not a member of service role %q", host, role))
+		}
+	}
+
+	adh.logger.Info("Starting drain.", tag.Service(role), tag.Address(host))
+	err := drain.Update(ctx, adh.persistenceMetadataManager, func(doc *drain.Document) error {
+		if !doc.AddTarget(drain.Target{Role: role, Host: host, RequestTime: time.Now().UTC()}) {
+			return serviceerror.NewAlreadyExist("drain is already requested")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.StartDrainResponse{}, nil
+}
+
+// CancelDrain removes a drain request made by StartDrain. Hosts which started draining are not restored,
+// they stay drained until they restart.
+func (adh *AdminHandler) CancelDrain(
+	ctx context.Context,
+	request *adminservice.CancelDrainRequest,
+) (_ *adminservice.CancelDrainResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	taggedMetricsHandler, startTime := adh.startRequestProfile(metrics.AdminCancelDrainScope)
+	defer func() {
+		taggedMetricsHandler.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime))
+	}()
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	role := primitives.ServiceName(request.GetRole())
+	host := request.GetHost()
+	adh.logger.Info("Cancelling drain.", tag.Service(role), tag.Address(host))
+	err := drain.Update(ctx, adh.persistenceMetadataManager, func(doc *drain.Document) error {
+		if !doc.RemoveTarget(role, host) {
+			return serviceerror.NewNotFound("drain is not requested")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.CancelDrainResponse{}, nil
+}
+
+// DescribeDrain returns the progress of every drain request. A request is drained once all hosts it selects
+// reported being drained, drained hosts have left the membership ring and can be restarted.
+func (adh *AdminHandler) DescribeDrain(
+	ctx context.Context,
+	request *adminservice.DescribeDrainRequest,
+) (_ *adminservice.DescribeDrainResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	taggedMetricsHandler, startTime := adh.startRequestProfile(metrics.AdminDescribeDrainScope)
+	defer func() {
+		taggedMetricsHandler.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime))
+	}()
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	resp, err := adh.persistenceMetadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: primitives.SystemLocalNamespace})
+	if err != nil {
+		return nil, err
+	}
+	doc, err := drain.Parse(resp.Namespace.Info.Data[drain.DataKey])
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	statuses := doc.Status(func(role primitives.ServiceName) []string {
+		// a role without resolver has no members left to drain
+		members, _ := adh.roleMembers(role)
+		return members
+	})
+
+	result := &adminservice.DescribeDrainResponse{}
+	for _, status := range statuses {
+		target := &adminservice.DrainTargetStatus{
+			Role:         string(status.Target.Role),
+			Host:         status.Target.Host,
+			RequestTime:  timestamp.TimePtr(status.Target.RequestTime),
+			PendingHosts: status.Pending,
+			Drained:      status.Drained,
+		}
+		for _, host := range status.Hosts {
+			target.Hosts = append(target.Hosts, &adminservice.DrainHostStatus{
+				Host:       host.Host,
+				State:      string(host.State),
+				Error:      host.Error,
+				UpdateTime: timestamp.TimePtr(host.UpdateTime),
+			})
+		}
+		result.Targets = append(result.Targets, target)
+	}
+	return result, nil
+}
+
+// GetSDKUsage returns the requests counted by this frontend host since it started, by API method, SDK name and
+// version, and namespace. Each frontend host counts the requests it serves, so every host must be asked for the
+// counts of the whole cluster.
+func (adh *AdminHandler) GetSDKUsage(
+	ctx context.Context,
+	request *adminservice.GetSDKUsageRequest,
+) (_ *adminservice.GetSDKUsageResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	taggedMetricsHandler, startTime := adh.startRequestProfile(metrics.AdminGetSDKUsageScope)
+	defer func() {
+		taggedMetricsHandler.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime))
+	}()
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if adh.sdkUsage == nil {
+		return nil, serviceerror.NewUnimplemented("SDK usage is not recorded by this host")
+	}
+
+	resp := &adminservice.GetSDKUsageResponse{}
+	if self, err := adh.membershipMonitor.WhoAmI(); err == nil {
+		resp.Host = self.GetAddress()
+	}
+	for key, count := range adh.sdkUsage.GetSDKUsage() {
+		if request.GetNamespace() != "" && key.Namespace != request.GetNamespace() {
+			continue
+		}
+		resp.Usage = append(resp.Usage, &adminservice.SDKUsage{
+			Method:     key.Method,
+			SdkName:    key.SDKName,
+			SdkVersion: key.SDKVersion,
+			Namespace:  key.Namespace,
+			Count:      count,
+		})
+	}
+	sort.Slice(resp.Usage, func(i, j int) bool {
+		a, b := resp.Usage[i], resp.Usage[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Method != b.Method {
+			return a.Method < b.Method
+		}
+		if a.SdkName != b.SdkName {
+			return a.SdkName < b.SdkName
+		}
+		return a.SdkVersion < b.SdkVersion
+	})
+	return resp, nil
+}
+
+func (adh *AdminHandler) roleMembers(role primitives.ServiceName) ([]string, error) {
+	resolver, err := adh.membershipMonitor.GetResolver(role)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("unknown service role %q", role))
+	}
+	var members []string
+	for _, host := range resolver.Members() {
+		members = append(members, host.GetAddress())
+	}
+	return members, nil
+}
+
 func (adh *AdminHandler) StreamWorkflowReplicationMessages(
 	targetCluster adminservice.AdminService_StreamWorkflowReplicationMessagesServer,
 ) (retError error) {
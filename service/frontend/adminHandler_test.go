@@ -25,6 +25,7 @@
 package frontend
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -32,20 +33,25 @@ import (
 	"time"
 
 	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
 	"go.temporal.io/server/common/persistence/visibility/store/standard/cassandra"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resourcetest"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 
 	"go.temporal.io/server/api/adminservicemock/v1"
 	"go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/testing/mocksdk"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/require"
@@ -53,23 +59,44 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
+	namespacepb "go.temporal.io/api/namespace/v1"
+	querypb "go.temporal.io/api/query/v1"
 	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"golang.org/x/exp/slices"
 
 	"go.temporal.io/server/api/adminservice/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	schedspb "go.temporal.io/server/api/schedule/v1"
 	clientmocks "go.temporal.io/server/client"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/featureflag"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	"go.temporal.io/server/common/rpc/interceptor"
+	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/service/worker/batcher"
+	"go.temporal.io/server/service/worker/deletenamespace/deleteexecutions"
+	"go.temporal.io/server/service/worker/namespaceexport"
+	historyscanner "go.temporal.io/server/service/worker/scanner/history"
+	"go.temporal.io/server/service/worker/scanner/visibilityconsistency"
+	"go.temporal.io/server/service/worker/scheduler"
 )
 
 type (
@@ -89,6 +116,7 @@ type (
 		mockAdminClient            *adminservicemock.MockAdminServiceClient
 		mockMetadata               *cluster.MockMetadata
 		mockProducer               *persistence.MockNamespaceReplicationQueue
+		mockArchivalDLQ            *persistence.MockArchivalDLQ
 
 		namespace      namespace.Name
 		namespaceID    namespace.ID
@@ -130,13 +158,25 @@ func (s *adminHandlerSuite) SetupTest() {
 	s.mockMetadata = s.mockResource.ClusterMetadata
 	s.mockVisibilityMgr = s.mockResource.VisibilityManager
 	s.mockProducer = persistence.NewMockNamespaceReplicationQueue(s.controller)
+	s.mockArchivalDLQ = persistence.NewMockArchivalDLQ(s.controller)
 
 	persistenceConfig := &config.Persistence{
 		NumHistoryShards: 1,
 	}
 
 	cfg := &Config{
-		NumHistoryShards: 4,
+		NumHistoryShards:                4,
+		VisibilityConsistencyCheckRPS:   dynamicconfig.GetIntPropertyFn(100),
+		HistoryGarbageDeletionRPS:       dynamicconfig.GetIntPropertyFn(10),
+		NumTaskQueueReadPartitions:      dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(4),
+		NumTaskQueueWritePartitions:     dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(4),
+		EnableSchedules:                 dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
+		EnableBatcher:                   dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
+		MaxConcurrentBatchOperation:     dynamicconfig.GetIntPropertyFilteredByNamespace(1),
+		MaxExecutionCountBatchOperation: dynamicconfig.GetIntPropertyFilteredByNamespace(10),
+		FeatureFlags: featureflag.NewGate(dynamicconfig.NewCollection(dynamicconfig.StaticClient{
+			featureflag.EagerWorkflowStart.Key: true,
+		}, log.NewNoopLogger())),
 	}
 	args := NewAdminHandlerArgs{
 		persistenceConfig,
@@ -165,6 +205,38 @@ func (s *adminHandlerSuite) SetupTest() {
 		health.NewServer(),
 		serialization.NewSerializer(),
 		clock.NewRealTimeSource(),
+		nil,
+		s.mockResource.GetShardManager(),
+		nil,
+		s.mockArchivalDLQ,
+		dynamicconfig.NewNoopClient(),
+		nil,
+		s.mockResource.GetMatchingClient(),
+		NewOperatorHandlerImpl(NewOperatorHandlerImplArgs{
+			cfg,
+			s.mockResource.ESClient,
+			s.mockResource.GetLogger(),
+			s.mockResource.GetSDKClientFactory(),
+			s.mockResource.GetMetricsHandler(),
+			s.mockResource.GetVisibilityManager(),
+			s.mockResource.GetSearchAttributesProvider(),
+			s.mockResource.GetSearchAttributesManager(),
+			health.NewServer(),
+			s.mockResource.GetHistoryClient(),
+			s.mockResource.GetClusterMetadataManager(),
+			s.mockMetadata,
+			s.mockResource.GetClientFactory(),
+			s.mockResource.GetNamespaceRegistry(),
+			s.mockResource.GetMetadataManager(),
+			dynamicconfig.NewNoopClient(),
+			persistence.NoopHealthSignalAggregator,
+			nil,
+			s.mockResource.GetMembershipMonitor(),
+			s.mockResource.GetArchivalMetadata(),
+			s.mockResource.GetArchiverProvider(),
+		}),
+		nil,
+		nil,
 	}
 	s.mockMetadata.EXPECT().GetCurrentClusterName().Return(uuid.New()).AnyTimes()
 	s.handler = NewAdminHandler(args)
@@ -176,6 +248,44 @@ func (s *adminHandlerSuite) TearDownTest() {
 	s.handler.Stop()
 }
 
+func (s *adminHandlerSuite) Test_MergeDLQMessages_NamespaceFilter() {
+	ctx := context.Background()
+	s.mockHistoryClient.EXPECT().MergeDLQMessages(gomock.Any(), &historyservice.MergeDLQMessagesRequest{
+		Type:                  enums.DEAD_LETTER_QUEUE_TYPE_REPLICATION,
+		ShardId:               1,
+		SourceCluster:         "remote",
+		InclusiveEndMessageId: 10,
+		MaximumPageSize:       100,
+		NamespaceId:           s.namespaceID.String(),
+	}).Return(&historyservice.MergeDLQMessagesResponse{NextPageToken: []byte("token")}, nil)
+
+	resp, err := s.handler.MergeDLQMessages(ctx, &adminservice.MergeDLQMessagesRequest{
+		Type:                  enums.DEAD_LETTER_QUEUE_TYPE_REPLICATION,
+		ShardId:               1,
+		SourceCluster:         "remote",
+		InclusiveEndMessageId: 10,
+		MaximumPageSize:       100,
+		NamespaceId:           s.namespaceID.String(),
+	})
+	s.NoError(err)
+	s.Equal([]byte("token"), resp.GetNextPageToken())
+
+	_, err = s.handler.MergeDLQMessages(ctx, &adminservice.MergeDLQMessagesRequest{
+		Type:        enums.DEAD_LETTER_QUEUE_TYPE_NAMESPACE,
+		NamespaceId: s.namespaceID.String(),
+	})
+	s.Equal(errDLQNamespaceFilterIsNotSupported, err)
+}
+
+func (s *adminHandlerSuite) Test_PurgeDLQMessages_NamespaceFilter() {
+	ctx := context.Background()
+	_, err := s.handler.PurgeDLQMessages(ctx, &adminservice.PurgeDLQMessagesRequest{
+		Type:        enums.DEAD_LETTER_QUEUE_TYPE_NAMESPACE,
+		NamespaceId: s.namespaceID.String(),
+	})
+	s.Equal(errDLQNamespaceFilterIsNotSupported, err)
+}
+
 func (s *adminHandlerSuite) Test_GetWorkflowExecutionRawHistoryV2_FailedOnInvalidWorkflowID() {
 	ctx := context.Background()
 	_, err := s.handler.GetWorkflowExecutionRawHistoryV2(ctx,
@@ -593,6 +703,106 @@ func (s *adminHandlerSuite) Test_AddSearchAttributes() {
 	s.NotNil(resp)
 }
 
+func (s *adminHandlerSuite) Test_AddSearchAttributeAliases() {
+	handler := s.handler
+	ctx := context.Background()
+
+	s.mockVisibilityMgr.EXPECT().GetIndexName().Return("random-index-name").AnyTimes()
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("random-index-name", true).Return(searchattribute.TestNameTypeMap, nil).AnyTimes()
+	s.mockResource.ClientFactory.EXPECT().
+		NewLocalFrontendClientWithTimeout(gomock.Any(), gomock.Any()).
+		Return(nil, s.mockResource.GetFrontendClient(), nil).
+		AnyTimes()
+	s.mockResource.FrontendClient.EXPECT().
+		DescribeNamespace(gomock.Any(), &workflowservice.DescribeNamespaceRequest{Namespace: s.namespace.String()}).
+		Return(
+			&workflowservice.DescribeNamespaceResponse{
+				Config: &namespacepb.NamespaceConfig{
+					CustomSearchAttributeAliases: map[string]string{"CustomKeywordField": "Customer"},
+				},
+			},
+			nil,
+		).
+		AnyTimes()
+
+	type test struct {
+		Name      string
+		Namespace string
+		Aliases   map[string]string
+		Expected  error
+	}
+	testCases := []test{
+		{
+			Name:     "namespace not set",
+			Aliases:  map[string]string{"Priority": "CustomIntField"},
+			Expected: &serviceerror.InvalidArgument{Message: "Namespace is not set on request."},
+		},
+		{
+			Name:      "aliases not set",
+			Namespace: s.namespace.String(),
+			Expected:  &serviceerror.InvalidArgument{Message: "SearchAttributes are not set on request."},
+		},
+		{
+			Name:      "reserved alias",
+			Namespace: s.namespace.String(),
+			Aliases:   map[string]string{"WorkflowId": "CustomKeywordField"},
+			Expected:  &serviceerror.InvalidArgument{Message: "Search attribute WorkflowId is reserved by system."},
+		},
+		{
+			Name:      "alias is search attribute",
+			Namespace: s.namespace.String(),
+			Aliases:   map[string]string{"CustomTextField": "CustomIntField"},
+			Expected:  &serviceerror.InvalidArgument{Message: "Search attribute CustomTextField already exists."},
+		},
+		{
+			Name:      "alias already exists",
+			Namespace: s.namespace.String(),
+			Aliases:   map[string]string{"Customer": "CustomIntField"},
+			Expected:  &serviceerror.AlreadyExists{Message: "Search attribute Customer already exists."},
+		},
+		{
+			Name:      "field doesn't exist",
+			Namespace: s.namespace.String(),
+			Aliases:   map[string]string{"Priority": "CustomUnknownField"},
+			Expected:  &serviceerror.InvalidArgument{Message: "Search attribute CustomUnknownField doesn't exist."},
+		},
+		{
+			Name:      "field already aliased",
+			Namespace: s.namespace.String(),
+			Aliases:   map[string]string{"Tenant": "CustomKeywordField"},
+			Expected:  &serviceerror.AlreadyExists{Message: "Search attribute CustomKeywordField already has an alias in the namespace."},
+		},
+	}
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			resp, err := handler.AddSearchAttributeAliases(ctx, &adminservice.AddSearchAttributeAliasesRequest{
+				Namespace: testCase.Namespace,
+				Aliases:   testCase.Aliases,
+			})
+			s.Equal(testCase.Expected, err)
+			s.Nil(resp)
+		})
+	}
+
+	s.mockResource.FrontendClient.EXPECT().
+		UpdateNamespace(
+			gomock.Any(),
+			&workflowservice.UpdateNamespaceRequest{
+				Namespace: s.namespace.String(),
+				Config: &namespacepb.NamespaceConfig{
+					CustomSearchAttributeAliases: map[string]string{"CustomIntField": "Priority"},
+				},
+			},
+		).
+		Return(&workflowservice.UpdateNamespaceResponse{}, nil)
+	resp, err := handler.AddSearchAttributeAliases(ctx, &adminservice.AddSearchAttributeAliasesRequest{
+		Namespace: s.namespace.String(),
+		Aliases:   map[string]string{"Priority": "CustomIntField"},
+	})
+	s.NoError(err)
+	s.NotNil(resp)
+}
+
 func (s *adminHandlerSuite) Test_GetSearchAttributes_EmptyIndexName() {
 	handler := s.handler
 	ctx := context.Background()
@@ -1444,3 +1654,1297 @@ func (s *adminHandlerSuite) TestDeleteWorkflowExecution_CassandraVisibilityBacke
 	_, err = s.handler.DeleteWorkflowExecution(context.Background(), request)
 	s.NoError(err)
 }
+
+func (s *adminHandlerSuite) TestStreamDatabaseBackup_NotSupported() {
+	server := adminservicemock.NewMockAdminService_StreamDatabaseBackupServer(s.controller)
+	err := s.handler.StreamDatabaseBackup(&adminservice.StreamDatabaseBackupRequest{}, server)
+	s.Equal(errDatabaseBackupNotSupported, err)
+}
+
+func (s *adminHandlerSuite) TestStreamDatabaseBackup_SQLite() {
+	s.handler.persistenceConfig = &config.Persistence{
+		DefaultStore: "default",
+		DataStores: map[string]config.DataStore{
+			"default": {
+				SQL: &config.SQL{
+					PluginName:        sqlite.PluginName,
+					DatabaseName:      "admin_handler_backup",
+					ConnectAttributes: map[string]string{"mode": "memory", "cache": "private"},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	server := adminservicemock.NewMockAdminService_StreamDatabaseBackupServer(s.controller)
+	server.EXPECT().Context().Return(context.Background()).AnyTimes()
+	server.EXPECT().Send(gomock.Any()).DoAndReturn(func(response *adminservice.StreamDatabaseBackupResponse) error {
+		_, err := buf.Write(response.GetData())
+		return err
+	}).MinTimes(1)
+	err := s.handler.StreamDatabaseBackup(&adminservice.StreamDatabaseBackupRequest{}, server)
+	s.NoError(err)
+	s.True(bytes.HasPrefix(buf.Bytes(), []byte("SQLite format 3\x00")))
+}
+
+func (s *adminHandlerSuite) TestStreamDiagnosticsBundle() {
+	host := membership.NewHostInfoFromAddress("127.0.0.1:7234")
+	s.mockResource.MembershipMonitor.EXPECT().WhoAmI().Return(host, nil)
+	for _, resolver := range []*membership.MockServiceResolver{
+		s.mockResource.FrontendServiceResolver,
+		s.mockResource.MatchingServiceResolver,
+		s.mockResource.HistoryServiceResolver,
+		s.mockResource.WorkerServiceResolver,
+	} {
+		resolver.EXPECT().Members().Return([]membership.HostInfo{host})
+	}
+	s.mockResource.HistoryServiceResolver.EXPECT().Lookup(gomock.Any()).Return(host, nil).AnyTimes()
+
+	var buf bytes.Buffer
+	server := adminservicemock.NewMockAdminService_StreamDiagnosticsBundleServer(s.controller)
+	server.EXPECT().Context().Return(context.Background()).AnyTimes()
+	server.EXPECT().Send(gomock.Any()).DoAndReturn(func(response *adminservice.StreamDiagnosticsBundleResponse) error {
+		_, err := buf.Write(response.GetData())
+		return err
+	}).MinTimes(1)
+	err := s.handler.StreamDiagnosticsBundle(&adminservice.StreamDiagnosticsBundleRequest{}, server)
+	s.NoError(err)
+	s.True(bytes.HasPrefix(buf.Bytes(), []byte{0x1f, 0x8b}))
+}
+
+func (s *adminHandlerSuite) TestDescribeShard() {
+	_, err := s.handler.DescribeShard(context.Background(), nil)
+	s.Equal(errRequestNotSet, err)
+
+	queues := []*adminservice.ShardQueueState{{Category: "transfer", PendingTaskCount: 3, SliceCount: 1}}
+	senders := []*adminservice.ReplicationStreamSenderState{{ClientCluster: "standby", ClientShardId: 2}}
+	s.mockHistoryClient.EXPECT().DescribeShard(gomock.Any(), &historyservice.DescribeShardRequest{ShardId: 2}).
+		Return(&historyservice.DescribeShardResponse{
+			Owner:                    "owner",
+			Queues:                   queues,
+			ReplicationStreamSenders: senders,
+		}, nil)
+	resp, err := s.handler.DescribeShard(context.Background(), &adminservice.DescribeShardRequest{ShardId: 2})
+	s.NoError(err)
+	s.Equal(&adminservice.DescribeShardResponse{
+		Owner:                    "owner",
+		Queues:                   queues,
+		ReplicationStreamSenders: senders,
+	}, resp)
+}
+
+func (s *adminHandlerSuite) TestDescribeShardDistribution() {
+	_, err := s.handler.DescribeShardDistribution(context.Background(), nil)
+	s.Equal(errRequestNotSet, err)
+	_, err = s.handler.DescribeShardDistribution(context.Background(), &adminservice.DescribeShardDistributionRequest{MaxPendingTasks: -1})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	host := membership.NewHostInfoFromAddress("127.0.0.1:7234")
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]membership.HostInfo{host})
+	s.mockResource.HistoryServiceResolver.EXPECT().Lookup(gomock.Any()).Return(host, nil).AnyTimes()
+	s.mockResource.ShardMgr.EXPECT().GetOrCreateShard(gomock.Any(), gomock.Any()).Return(&persistence.GetOrCreateShardResponse{
+		ShardInfo: &persistencespb.ShardInfo{Owner: "127.0.0.1:7234-1-uuid"},
+	}, nil)
+
+	resp, err := s.handler.DescribeShardDistribution(context.Background(), &adminservice.DescribeShardDistributionRequest{})
+	s.NoError(err)
+	s.Len(resp.GetShards(), 1)
+	s.Equal("127.0.0.1:7234-1-uuid", resp.GetShards()[0].GetOwner())
+	s.Equal([]*adminservice.ShardDistributionHost{{Host: host.GetAddress(), NumShards: 1}}, resp.GetHosts())
+}
+
+func (s *adminHandlerSuite) TestDescribeTaskQueueTopology() {
+	_, err := s.handler.DescribeTaskQueueTopology(context.Background(), nil)
+	s.Equal(errRequestNotSet, err)
+	_, err = s.handler.DescribeTaskQueueTopology(context.Background(), &adminservice.DescribeTaskQueueTopologyRequest{
+		TaskQueue:     "tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	_, err = s.handler.DescribeTaskQueueTopology(context.Background(), &adminservice.DescribeTaskQueueTopologyRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	host := membership.NewHostInfoFromAddress("127.0.0.1:7235")
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(s.namespaceEntry, nil)
+	s.mockResource.MatchingServiceResolver.EXPECT().Lookup(gomock.Any()).Return(host, nil).AnyTimes()
+	s.mockResource.MatchingClient.EXPECT().DescribeTaskQueue(gomock.Any(), gomock.Any()).
+		Return(&matchingservice.DescribeTaskQueueResponse{}, nil).AnyTimes()
+	s.mockResource.MatchingClient.EXPECT().GetTaskQueueUserData(gomock.Any(), gomock.Any()).
+		Return(&matchingservice.GetTaskQueueUserDataResponse{}, nil).AnyTimes()
+
+	topology, err := s.handler.DescribeTaskQueueTopology(context.Background(), &adminservice.DescribeTaskQueueTopologyRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	})
+	s.NoError(err)
+	s.NotEmpty(topology.Partitions)
+	for _, partition := range topology.Partitions {
+		s.Equal(host.GetAddress(), partition.OwnerHostName)
+		s.True(partition.Loaded)
+	}
+}
+
+func (s *adminHandlerSuite) TestUpdateWorkflowExecutionMemo() {
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflowID", RunId: uuid.New()}
+	_, err := s.handler.UpdateWorkflowExecutionMemo(context.Background(), &adminservice.UpdateWorkflowExecutionMemoRequest{
+		Namespace: s.namespace.String(),
+		Execution: execution,
+	})
+	s.Equal(errMemoNotSet, err)
+
+	memo := &commonpb.Memo{Fields: map[string]*commonpb.Payload{"owner": payload.EncodeString("team-a")}}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().UpdateWorkflowExecutionMemo(gomock.Any(), &historyservice.UpdateWorkflowExecutionMemoRequest{
+		NamespaceId:       s.namespaceID.String(),
+		WorkflowExecution: execution,
+		Memo:              memo,
+		Identity:          "operator",
+		Reason:            "triage",
+	}).Return(&historyservice.UpdateWorkflowExecutionMemoResponse{Memo: memo}, nil)
+
+	resp, err := s.handler.UpdateWorkflowExecutionMemo(context.Background(), &adminservice.UpdateWorkflowExecutionMemoRequest{
+		Namespace: s.namespace.String(),
+		Execution: execution,
+		Memo:      memo,
+		Identity:  "operator",
+		Reason:    "triage",
+	})
+	s.NoError(err)
+	s.Equal(memo, resp.GetMemo())
+}
+
+func (s *adminHandlerSuite) TestUpsertWorkflowExecutionSearchAttributes() {
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflowID", RunId: uuid.New()}
+	_, err := s.handler.UpsertWorkflowExecutionSearchAttributes(context.Background(), &adminservice.UpsertWorkflowExecutionSearchAttributesRequest{
+		Namespace: s.namespace.String(),
+		Execution: execution,
+	})
+	s.Equal(errSearchAttributesNotSet, err)
+
+	searchAttributes := &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{"CustomKeywordField": payload.EncodeString("team-a")}}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().UpsertWorkflowExecutionSearchAttributes(gomock.Any(), &historyservice.UpsertWorkflowExecutionSearchAttributesRequest{
+		NamespaceId:       s.namespaceID.String(),
+		WorkflowExecution: execution,
+		SearchAttributes:  searchAttributes,
+		Identity:          "operator",
+		Reason:            "backfill",
+	}).Return(&historyservice.UpsertWorkflowExecutionSearchAttributesResponse{}, nil)
+
+	_, err = s.handler.UpsertWorkflowExecutionSearchAttributes(context.Background(), &adminservice.UpsertWorkflowExecutionSearchAttributesRequest{
+		Namespace:        s.namespace.String(),
+		Execution:        execution,
+		SearchAttributes: searchAttributes,
+		Identity:         "operator",
+		Reason:           "backfill",
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestDescribeNamespaceStats() {
+	_, err := s.handler.DescribeNamespaceStats(context.Background(), &adminservice.DescribeNamespaceStatsRequest{})
+	s.Equal(errNamespaceNotSet, err)
+
+	data := make(map[string]string)
+	namespace.StorageUsage{HistoryBytes: 100, MutableStateBytes: 20, VisibilityBytes: 3}.ToData(data)
+	nsEntry := namespace.NewNamespaceForTest(
+		&persistencespb.NamespaceInfo{Name: s.namespace.String(), Id: s.namespaceID.String(), Data: data},
+		nil,
+		false,
+		nil,
+		int64(100),
+	)
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	s.handler.namespaceActions = interceptor.NewNamespaceActionTracker(timeSource)
+	for i := 0; i < 60; i++ {
+		s.handler.namespaceActions.Record(s.namespace.String())
+	}
+	timeSource.Update(timeSource.Now().Add(time.Minute))
+
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(nsEntry, nil)
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: s.namespaceID,
+		Namespace:   s.namespace,
+		Query:       "ExecutionStatus = 'Running'",
+	}).Return(&manager.CountWorkflowExecutionsResponse{Count: 7}, nil)
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: s.namespaceID,
+		Namespace:   s.namespace,
+		Query:       "ExecutionStatus != 'Running'",
+	}).Return(&manager.CountWorkflowExecutionsResponse{Count: 42}, nil)
+	s.mockResource.FrontendServiceResolver.EXPECT().MemberCount().Return(3)
+
+	resp, err := s.handler.DescribeNamespaceStats(context.Background(), &adminservice.DescribeNamespaceStatsRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.NoError(err)
+	s.Equal(&adminservice.DescribeNamespaceStatsResponse{
+		NamespaceId:         s.namespaceID.String(),
+		OpenWorkflowCount:   7,
+		ClosedWorkflowCount: 42,
+		ActionsPerSecond:    3,
+		StorageBytes:        123,
+		StorageBytesByType: map[string]int64{
+			namespace.StorageTypeHistory:      100,
+			namespace.StorageTypeMutableState: 20,
+			namespace.StorageTypeVisibility:   3,
+		},
+	}, resp)
+}
+
+func (s *adminHandlerSuite) TestDescribeNamespaceReplicationStatus() {
+	_, err := s.handler.DescribeNamespaceReplicationStatus(context.Background(), &adminservice.DescribeNamespaceReplicationStatusRequest{})
+	s.Equal(errNamespaceNotSet, err)
+
+	failoverTime := time.Date(2011, 12, 27, 23, 44, 55, 0, time.UTC)
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		Name: s.namespace.String(),
+	}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+				ActiveClusterName: "cluster-a",
+				Clusters:          []string{"cluster-a", "cluster-b"},
+				State:             enumspb.REPLICATION_STATE_HANDOVER,
+				FailoverHistory: []*persistencespb.FailoverStatus{
+					{FailoverTime: timestamp.TimePtr(failoverTime.Add(-time.Hour)), FailoverVersion: 1},
+					{FailoverTime: timestamp.TimePtr(failoverTime), FailoverVersion: 2},
+				},
+			},
+		},
+		IsGlobalNamespace: true,
+	}, nil)
+
+	now := time.Now().UTC()
+	s.mockHistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: []string{"cluster-a", "cluster-b"},
+	}).Return(&historyservice.GetReplicationStatusResponse{
+		Shards: []*historyservice.ShardReplicationStatus{
+			{
+				ShardId:                          1,
+				MaxReplicationTaskId:             100,
+				MaxReplicationTaskVisibilityTime: timestamp.TimePtr(now.Add(10 * time.Second)),
+				RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+					"cluster-a": {AckedTaskId: 100, AckedTaskVisibilityTime: timestamp.TimePtr(now.Add(10 * time.Second))},
+					"cluster-b": {AckedTaskId: 80, AckedTaskVisibilityTime: timestamp.TimePtr(now)},
+				},
+				HandoverNamespaces: map[string]*historyservice.HandoverNamespaceInfo{
+					s.namespace.String(): {HandoverReplicationTaskId: 90},
+				},
+			},
+			{
+				ShardId:                          2,
+				MaxReplicationTaskId:             50,
+				MaxReplicationTaskVisibilityTime: timestamp.TimePtr(now.Add(5 * time.Second)),
+				RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+					"cluster-a": {AckedTaskId: 40, AckedTaskVisibilityTime: timestamp.TimePtr(now.Add(2 * time.Second))},
+					"cluster-b": {AckedTaskId: 45, AckedTaskVisibilityTime: timestamp.TimePtr(now.Add(5 * time.Second))},
+				},
+				HandoverNamespaces: map[string]*historyservice.HandoverNamespaceInfo{
+					s.namespace.String(): {HandoverReplicationTaskId: 40},
+				},
+			},
+		},
+	}, nil)
+
+	resp, err := s.handler.DescribeNamespaceReplicationStatus(context.Background(), &adminservice.DescribeNamespaceReplicationStatusRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.NoError(err)
+	s.Equal(s.namespaceID.String(), resp.GetNamespaceId())
+	s.Equal("cluster-a", resp.GetActiveClusterName())
+	s.Equal(enumspb.REPLICATION_STATE_HANDOVER, resp.GetState())
+	s.Equal(failoverTime, *resp.GetLastFailover().GetFailoverTime())
+	s.Equal(int64(2), resp.GetLastFailover().GetFailoverVersion())
+	s.Len(resp.GetRemoteClusters(), 2)
+
+	clusterA := resp.GetRemoteClusters()[0]
+	s.Equal("cluster-a", clusterA.GetClusterName())
+	s.Equal(3*time.Second, *clusterA.GetLag())
+	s.Equal(int64(10), clusterA.GetBacklogTaskCount())
+	s.Equal(int32(0), clusterA.GetHandoverPendingShardCount())
+
+	clusterB := resp.GetRemoteClusters()[1]
+	s.Equal("cluster-b", clusterB.GetClusterName())
+	s.Equal(10*time.Second, *clusterB.GetLag())
+	s.Equal(int64(25), clusterB.GetBacklogTaskCount())
+	s.Equal(int32(1), clusterB.GetHandoverPendingShardCount())
+}
+
+func (s *adminHandlerSuite) TestStartBatchOperation_Update() {
+	jobID := uuid.New()
+	executions := []*commonpb.WorkflowExecution{{WorkflowId: "wid-1"}, {WorkflowId: "wid-2"}}
+	input := payloads.EncodeString("fix")
+	expectedParams := &batcher.BatchParams{
+		Namespace:  s.namespace.String(),
+		Executions: executions,
+		Reason:     "repair",
+		BatchType:  batcher.BatchTypeUpdate,
+		UpdateParams: batcher.UpdateParams{
+			UpdateName: "repair-state",
+			Input:      input,
+		},
+	}
+	expectedInput, err := sdk.PreferProtoDataConverter.ToPayloads(expectedParams)
+	s.NoError(err)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: s.namespaceID,
+		Namespace:   s.namespace,
+		Query:       batcher.OpenBatchOperationQuery,
+	}).Return(&manager.CountWorkflowExecutionsResponse{Count: 0}, nil)
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.StartWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.StartWorkflowExecutionResponse, error) {
+			s.Equal(s.namespaceID.String(), request.NamespaceId)
+			s.Equal(jobID, request.StartRequest.WorkflowId)
+			s.Equal(batcher.BatchWFTypeName, request.StartRequest.WorkflowType.Name)
+			s.Equal(primitives.PerNSWorkerTaskQueue, request.StartRequest.TaskQueue.Name)
+			s.Equal("operator", request.StartRequest.Identity)
+			s.Equal(payload.EncodeString(batcher.BatchTypeUpdate), request.StartRequest.Memo.Fields[batcher.BatchOperationTypeMemo])
+			s.Equal(expectedInput, request.StartRequest.Input)
+			return &historyservice.StartWorkflowExecutionResponse{}, nil
+		},
+	)
+
+	_, err = s.handler.StartBatchOperation(context.Background(), &adminservice.StartBatchOperationRequest{
+		Namespace:  s.namespace.String(),
+		JobId:      jobID,
+		Executions: executions,
+		Reason:     "repair",
+		Identity:   "operator",
+		Operation: &adminservice.StartBatchOperationRequest_UpdateOperation{
+			UpdateOperation: &adminservice.BatchOperationUpdate{
+				UpdateName: "repair-state",
+				Input:      input,
+			},
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestStartBatchOperation_ResetToBuildID() {
+	expectedParams := &batcher.BatchParams{
+		Namespace: s.namespace.String(),
+		Query:     "BuildIds = 'bad-build'",
+		Reason:    "bad build",
+		BatchType: batcher.BatchTypeReset,
+		ResetParams: batcher.ResetParams{
+			BuildID:           "bad-build",
+			ResetReapplytType: enumspb.RESET_REAPPLY_TYPE_SIGNAL,
+		},
+	}
+	expectedInput, err := sdk.PreferProtoDataConverter.ToPayloads(expectedParams)
+	s.NoError(err)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.CountWorkflowExecutionsResponse{Count: 0}, nil)
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.StartWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.StartWorkflowExecutionResponse, error) {
+			s.Equal(payload.EncodeString(batcher.BatchTypeReset), request.StartRequest.Memo.Fields[batcher.BatchOperationTypeMemo])
+			s.Equal(expectedInput, request.StartRequest.Input)
+			return &historyservice.StartWorkflowExecutionResponse{}, nil
+		},
+	)
+
+	_, err = s.handler.StartBatchOperation(context.Background(), &adminservice.StartBatchOperationRequest{
+		Namespace:       s.namespace.String(),
+		JobId:           uuid.New(),
+		VisibilityQuery: "BuildIds = 'bad-build'",
+		Reason:          "bad build",
+		Operation: &adminservice.StartBatchOperationRequest_ResetToBuildIdOperation{
+			ResetToBuildIdOperation: &adminservice.BatchOperationResetToBuildId{
+				BuildId:          "bad-build",
+				ResetReapplyType: enumspb.RESET_REAPPLY_TYPE_SIGNAL,
+			},
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestStartBatchOperation_InvalidRequest() {
+	request := &adminservice.StartBatchOperationRequest{
+		Namespace:       s.namespace.String(),
+		JobId:           uuid.New(),
+		VisibilityQuery: "WorkflowType = 'order'",
+		Reason:          "repair",
+	}
+	_, err := s.handler.StartBatchOperation(context.Background(), request)
+	s.Equal(errBatchOperationNotSet, err)
+
+	request.Operation = &adminservice.StartBatchOperationRequest_UpdateOperation{
+		UpdateOperation: &adminservice.BatchOperationUpdate{},
+	}
+	_, err = s.handler.StartBatchOperation(context.Background(), request)
+	s.Equal(errUpdateNameNotSet, err)
+
+	request.Operation = &adminservice.StartBatchOperationRequest_ResetToBuildIdOperation{
+		ResetToBuildIdOperation: &adminservice.BatchOperationResetToBuildId{},
+	}
+	_, err = s.handler.StartBatchOperation(context.Background(), request)
+	s.Equal(errBuildIDNotSet, err)
+
+	request.Operation = &adminservice.StartBatchOperationRequest_UpdateOperation{
+		UpdateOperation: &adminservice.BatchOperationUpdate{UpdateName: "repair-state"},
+	}
+	request.Executions = []*commonpb.WorkflowExecution{{WorkflowId: "wid-1"}}
+	_, err = s.handler.StartBatchOperation(context.Background(), request)
+	s.Equal(errBatchOpsWorkflowFiltersNotAllowed, err)
+
+	request.VisibilityQuery = ""
+	request.Reason = ""
+	_, err = s.handler.StartBatchOperation(context.Background(), request)
+	s.Equal(errReasonNotSet, err)
+}
+
+func (s *adminHandlerSuite) TestRehydrateWorkflowExecution() {
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflowID", RunId: uuid.New()}
+	newRunID := uuid.New()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().RehydrateWorkflowExecution(gomock.Any(), &historyservice.RehydrateWorkflowExecutionRequest{
+		NamespaceId: s.namespaceID.String(),
+		Execution:   execution,
+		NewRunId:    newRunID,
+		Uri:         "file:///tmp/archival",
+	}).Return(&historyservice.RehydrateWorkflowExecutionResponse{RunId: newRunID}, nil)
+
+	resp, err := s.handler.RehydrateWorkflowExecution(context.Background(), &adminservice.RehydrateWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+		Execution: execution,
+		NewRunId:  newRunID,
+		Uri:       "file:///tmp/archival",
+	})
+	s.NoError(err)
+	s.Equal(newRunID, resp.GetRunId())
+}
+
+func (s *adminHandlerSuite) TestDescribeScheduleBackfills() {
+	requestTime := time.Unix(1000, 0).UTC()
+	queryResult, err := payloads.Encode([]*scheduler.BackfillProgress{{
+		ID:          "1",
+		RequestTime: requestTime,
+		Total:       10,
+		Started:     4,
+		Remaining:   6,
+	}})
+	s.NoError(err)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().QueryWorkflow(gomock.Any(), &historyservice.QueryWorkflowRequest{
+		NamespaceId: s.namespaceID.String(),
+		Request: &workflowservice.QueryWorkflowRequest{
+			Namespace: s.namespace.String(),
+			Execution: &commonpb.WorkflowExecution{WorkflowId: scheduler.WorkflowIDPrefix + "schedule-id"},
+			Query:     &querypb.WorkflowQuery{QueryType: scheduler.QueryNameDescribeBackfills},
+		},
+	}).Return(&historyservice.QueryWorkflowResponse{
+		Response: &workflowservice.QueryWorkflowResponse{QueryResult: queryResult},
+	}, nil)
+
+	resp, err := s.handler.DescribeScheduleBackfills(context.Background(), &adminservice.DescribeScheduleBackfillsRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "schedule-id",
+	})
+	s.NoError(err)
+	s.Len(resp.Backfills, 1)
+	s.Equal("1", resp.Backfills[0].GetId())
+	s.Equal(requestTime, timestamp.TimeValue(resp.Backfills[0].GetRequestTime()))
+	s.Equal(int64(10), resp.Backfills[0].GetTotal())
+	s.Equal(int64(4), resp.Backfills[0].GetStarted())
+	s.Equal(int64(6), resp.Backfills[0].GetRemaining())
+}
+
+func (s *adminHandlerSuite) TestCancelScheduleBackfill() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.SignalWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.SignalWorkflowExecutionResponse, error) {
+			s.Equal(s.namespaceID.String(), request.GetNamespaceId())
+			s.Equal(scheduler.WorkflowIDPrefix+"schedule-id", request.GetSignalRequest().GetWorkflowExecution().GetWorkflowId())
+			s.Equal(scheduler.SignalNameCancelBackfill, request.GetSignalRequest().GetSignalName())
+			var backfillID string
+			s.NoError(payloads.Decode(request.GetSignalRequest().GetInput(), &backfillID))
+			s.Equal("2", backfillID)
+			return &historyservice.SignalWorkflowExecutionResponse{}, nil
+		})
+
+	_, err := s.handler.CancelScheduleBackfill(context.Background(), &adminservice.CancelScheduleBackfillRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "schedule-id",
+		BackfillId: "2",
+		Identity:   "operator",
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestDescribeSchedulePauseAfterFailures() {
+	queryResult, err := payloads.Encode(&schedspb.DescribeResponse{
+		PauseAfterFailures:  3,
+		ConsecutiveFailures: 1,
+		PauseReason:         "paused after 3 consecutive failures",
+	})
+	s.NoError(err)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().QueryWorkflow(gomock.Any(), &historyservice.QueryWorkflowRequest{
+		NamespaceId: s.namespaceID.String(),
+		Request: &workflowservice.QueryWorkflowRequest{
+			Namespace: s.namespace.String(),
+			Execution: &commonpb.WorkflowExecution{WorkflowId: scheduler.WorkflowIDPrefix + "schedule-id"},
+			Query:     &querypb.WorkflowQuery{QueryType: scheduler.QueryNameDescribe},
+		},
+	}).Return(&historyservice.QueryWorkflowResponse{
+		Response: &workflowservice.QueryWorkflowResponse{QueryResult: queryResult},
+	}, nil)
+
+	resp, err := s.handler.DescribeSchedulePauseAfterFailures(context.Background(), &adminservice.DescribeSchedulePauseAfterFailuresRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "schedule-id",
+	})
+	s.NoError(err)
+	s.Equal(int64(3), resp.GetPauseAfterFailures())
+	s.Equal(int64(1), resp.GetConsecutiveFailures())
+	s.Equal("paused after 3 consecutive failures", resp.GetPauseReason())
+}
+
+func (s *adminHandlerSuite) TestUpdateSchedulePauseAfterFailures() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.SignalWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.SignalWorkflowExecutionResponse, error) {
+			s.Equal(s.namespaceID.String(), request.GetNamespaceId())
+			s.Equal(scheduler.WorkflowIDPrefix+"schedule-id", request.GetSignalRequest().GetWorkflowExecution().GetWorkflowId())
+			s.Equal(scheduler.SignalNameSetPauseAfterFailures, request.GetSignalRequest().GetSignalName())
+			var limit int64
+			s.NoError(payloads.Decode(request.GetSignalRequest().GetInput(), &limit))
+			s.Equal(int64(5), limit)
+			return &historyservice.SignalWorkflowExecutionResponse{}, nil
+		})
+
+	_, err := s.handler.UpdateSchedulePauseAfterFailures(context.Background(), &adminservice.UpdateSchedulePauseAfterFailuresRequest{
+		Namespace:          s.namespace.String(),
+		ScheduleId:         "schedule-id",
+		PauseAfterFailures: 5,
+		Identity:           "operator",
+	})
+	s.NoError(err)
+
+	_, err = s.handler.UpdateSchedulePauseAfterFailures(context.Background(), &adminservice.UpdateSchedulePauseAfterFailuresRequest{
+		Namespace:          s.namespace.String(),
+		ScheduleId:         "schedule-id",
+		PauseAfterFailures: -1,
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+}
+
+func (s *adminHandlerSuite) TestUpdateNamespaceDeletionRate() {
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockSdkClient.EXPECT().SignalWorkflow(
+		gomock.Any(),
+		"temporal-sys-delete-executions-workflow/test-namespace-deleted-ka2te",
+		"",
+		deleteexecutions.SignalNameUpdateRate,
+		deleteexecutions.DeleteExecutionsRateUpdate{ConcurrentDeleteExecutionsActivities: 8},
+	).Return(nil)
+
+	_, err := s.handler.UpdateNamespaceDeletionRate(context.Background(), &adminservice.UpdateNamespaceDeletionRateRequest{
+		DeletedNamespace:                     "test-namespace-deleted-ka2te",
+		ConcurrentDeleteExecutionsActivities: 8,
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestStartNamespaceExport() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockRun := mocksdk.NewMockWorkflowRun(s.controller)
+	mockRun.EXPECT().GetRunID().Return("run-id")
+	mockSdkClient.EXPECT().ExecuteWorkflow(
+		gomock.Any(),
+		gomock.Any(),
+		namespaceexport.WorkflowName,
+		namespaceexport.NamespaceExportParams{
+			Namespace:     s.namespace,
+			NamespaceID:   s.namespaceID,
+			ExportURI:     "s3://bucket/export",
+			NextPageToken: []byte("token"),
+			ExportedCount: 100,
+		},
+	).Return(mockRun, nil)
+
+	resp, err := s.handler.StartNamespaceExport(context.Background(), &adminservice.StartNamespaceExportRequest{
+		Namespace:     s.namespace.String(),
+		ExportUri:     "s3://bucket/export",
+		NextPageToken: []byte("token"),
+		ExportedCount: 100,
+	})
+	s.NoError(err)
+	s.Equal("run-id", resp.GetRunId())
+}
+
+func (s *adminHandlerSuite) TestAPIKeys() {
+	_, err := s.handler.CreateAPIKey(context.Background(), &adminservice.CreateAPIKeyRequest{
+		Name:       "ci",
+		SystemRole: 1 << 16,
+	})
+	s.Equal(errInvalidAPIKeyRole, err)
+
+	data := map[string]string{}
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{Name: primitives.SystemLocalNamespace}).DoAndReturn(
+		func(_ context.Context, _ *persistence.GetNamespaceRequest) (*persistence.GetNamespaceResponse, error) {
+			return &persistence.GetNamespaceResponse{
+				Namespace: &persistencespb.NamespaceDetail{Info: &persistencespb.NamespaceInfo{Name: primitives.SystemLocalNamespace, Data: data}},
+			}, nil
+		}).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{}, nil).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			data = request.Namespace.Info.Data
+			return nil
+		}).AnyTimes()
+
+	created, err := s.handler.CreateAPIKey(context.Background(), &adminservice.CreateAPIKeyRequest{
+		Name:           "ci",
+		NamespaceRoles: map[string]int32{"test-namespace": int32(authorization.RoleWriter)},
+		Rps:            10,
+	})
+	s.NoError(err)
+	s.NotEmpty(created.GetToken())
+	s.Equal("ci", created.GetApiKey().GetName())
+	s.Equal(map[string]int32{"test-namespace": int32(authorization.RoleWriter)}, created.GetApiKey().GetNamespaceRoles())
+	s.Nil(created.GetApiKey().GetRotateTime())
+
+	listed, err := s.handler.ListAPIKeys(context.Background(), &adminservice.ListAPIKeysRequest{})
+	s.NoError(err)
+	s.Equal([]*adminservice.APIKey{created.GetApiKey()}, listed.GetApiKeys())
+
+	rotated, err := s.handler.RotateAPIKey(context.Background(), &adminservice.RotateAPIKeyRequest{Id: created.GetApiKey().GetId()})
+	s.NoError(err)
+	s.NotEqual(created.GetToken(), rotated.GetToken())
+
+	_, err = s.handler.RevokeAPIKey(context.Background(), &adminservice.RevokeAPIKeyRequest{Id: created.GetApiKey().GetId()})
+	s.NoError(err)
+	listed, err = s.handler.ListAPIKeys(context.Background(), &adminservice.ListAPIKeysRequest{})
+	s.NoError(err)
+	s.Empty(listed.GetApiKeys())
+}
+
+func (s *adminHandlerSuite) TestRBAC() {
+	clusterMetadata := &persistence.GetClusterMetadataResponse{}
+	s.mockClusterMetadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).DoAndReturn(
+		func(_ context.Context) (*persistence.GetClusterMetadataResponse, error) {
+			resp := *clusterMetadata
+			resp.ClusterMetadata = *proto.Clone(&clusterMetadata.ClusterMetadata).(*persistencespb.ClusterMetadata)
+			return &resp, nil
+		}).AnyTimes()
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.SaveClusterMetadataRequest) (bool, error) {
+			clusterMetadata = &persistence.GetClusterMetadataResponse{ClusterMetadata: request.ClusterMetadata, Version: request.Version + 1}
+			return true, nil
+		}).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(s.namespaceEntry, nil).AnyTimes()
+
+	_, err := s.handler.PutRole(context.Background(), &adminservice.PutRoleRequest{
+		Role: &adminservice.NamedRole{Name: "starter", Permissions: []string{"write"}},
+	})
+	s.Equal(errInvalidRolePermission, err)
+	starter := &adminservice.NamedRole{Name: "starter", Permissions: []string{"start", "query"}}
+	_, err = s.handler.PutRole(context.Background(), &adminservice.PutRoleRequest{Role: starter})
+	s.NoError(err)
+	roles, err := s.handler.ListRoles(context.Background(), &adminservice.ListRolesRequest{})
+	s.NoError(err)
+	s.Equal([]*adminservice.NamedRole{starter}, roles.GetRoles())
+
+	for _, group := range []string{"payments-owners", "payments-dev"} {
+		_, err = s.handler.SetGroupRoles(context.Background(), &adminservice.SetGroupRolesRequest{
+			Namespace: s.namespace.String(),
+			Group:     group,
+			Roles:     []string{"starter"},
+		})
+		s.NoError(err)
+	}
+	groupRoles, err := s.handler.GetGroupRoles(context.Background(), &adminservice.GetGroupRolesRequest{Namespace: s.namespace.String()})
+	s.NoError(err)
+	s.Equal([]*adminservice.GroupRoles{
+		{Group: "payments-dev", Roles: []string{"starter"}},
+		{Group: "payments-owners", Roles: []string{"starter"}},
+	}, groupRoles.GetGroups())
+
+	_, err = s.handler.DeleteRole(context.Background(), &adminservice.DeleteRoleRequest{Name: "starter"})
+	s.IsType(&serviceerror.FailedPrecondition{}, err)
+
+	_, err = s.handler.SetGroupRoles(context.Background(), &adminservice.SetGroupRolesRequest{
+		Group: "operators",
+		Roles: []string{"starter"},
+	})
+	s.NoError(err)
+	groupRoles, err = s.handler.GetGroupRoles(context.Background(), &adminservice.GetGroupRolesRequest{})
+	s.NoError(err)
+	s.Equal([]*adminservice.GroupRoles{{Group: "operators", Roles: []string{"starter"}}}, groupRoles.GetGroups())
+}
+
+func (s *adminHandlerSuite) TestDynamicConfigOverrides() {
+	key := dynamicconfig.Key(dynamicconfig.FrontendMaxNamespaceRPSPerInstance)
+	dynamicconfig.NewNoopCollection().GetIntPropertyFilteredByNamespace(key, 0)
+
+	_, err := s.handler.SetDynamicConfigOverride(context.Background(), &adminservice.SetDynamicConfigOverrideRequest{
+		Key:   key.String(),
+		Value: &adminservice.DynamicConfigValue{Constraints: "{", Value: "100"},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	data := map[string]string{}
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{Name: primitives.SystemLocalNamespace}).DoAndReturn(
+		func(_ context.Context, _ *persistence.GetNamespaceRequest) (*persistence.GetNamespaceResponse, error) {
+			return &persistence.GetNamespaceResponse{
+				Namespace: &persistencespb.NamespaceDetail{Info: &persistencespb.NamespaceInfo{Name: primitives.SystemLocalNamespace, Data: data}},
+			}, nil
+		}).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{}, nil).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			data = request.Namespace.Info.Data
+			return nil
+		}).AnyTimes()
+
+	// integers must not be decoded as floats, which the schema of the key rejects
+	value := &adminservice.DynamicConfigValue{
+		Constraints: `{"namespace":"payments"}`,
+		Value:       "100",
+		Rollout:     &adminservice.DynamicConfigRollout{Percentage: 10},
+	}
+	_, err = s.handler.SetDynamicConfigOverride(context.Background(), &adminservice.SetDynamicConfigOverrideRequest{
+		Key:      key.String(),
+		Value:    value,
+		Identity: "cli",
+	})
+	s.NoError(err)
+	overrides, err := s.handler.GetDynamicConfigOverrides(context.Background(), &adminservice.GetDynamicConfigOverridesRequest{})
+	s.NoError(err)
+	s.Equal([]*adminservice.DynamicConfigOverride{{Key: key.String(), Values: []*adminservice.DynamicConfigValue{value}}}, overrides.GetOverrides())
+
+	_, err = s.handler.SetDynamicConfigRollout(context.Background(), &adminservice.SetDynamicConfigRolloutRequest{
+		Key:         key.String(),
+		Constraints: `{"namespace":"payments"}`,
+		Percentage:  50,
+	})
+	s.NoError(err)
+	overrides, err = s.handler.GetDynamicConfigOverrides(context.Background(), &adminservice.GetDynamicConfigOverridesRequest{})
+	s.NoError(err)
+	s.Equal(int32(50), overrides.GetOverrides()[0].GetValues()[0].GetRollout().GetPercentage())
+
+	_, err = s.handler.DeleteDynamicConfigOverride(context.Background(), &adminservice.DeleteDynamicConfigOverrideRequest{
+		Key:         key.String(),
+		Constraints: `{"namespace":"payments"}`,
+		Identity:    "cli",
+	})
+	s.NoError(err)
+	changes, err := s.handler.ListDynamicConfigChanges(context.Background(), &adminservice.ListDynamicConfigChangesRequest{})
+	s.NoError(err)
+	s.Len(changes.GetChanges(), 3)
+	s.Equal("100", changes.GetChanges()[0].GetOldValue())
+	s.Empty(changes.GetChanges()[0].GetNewValue())
+	s.Equal(int32(10), changes.GetChanges()[1].GetOldRollout().GetPercentage())
+	s.Equal(int32(50), changes.GetChanges()[1].GetNewRollout().GetPercentage())
+	s.Equal(value.Constraints, changes.GetChanges()[2].GetConstraints())
+	s.Equal(int32(10), changes.GetChanges()[2].GetNewRollout().GetPercentage())
+	s.Equal("cli", changes.GetChanges()[2].GetIdentity())
+}
+
+func (s *adminHandlerSuite) TestGetEffectiveDynamicConfig() {
+	key := dynamicconfig.Key(dynamicconfig.FrontendMaxNamespaceVisibilityRPSPerInstance)
+	dynamicconfig.NewNoopCollection().GetIntPropertyFilteredByNamespace(key, 10)
+	client := dynamicconfig.NewOverrideClient(dynamicconfig.NewNoopClient(), s.mockResource.Logger)
+	s.NoError(client.SetOverrides(key.String() + ":\n- value: 30\n  constraints:\n    namespace: payments\n"))
+	s.handler.operatorHandler.dynamicConfigClient = client
+
+	resp, err := s.handler.GetEffectiveDynamicConfig(context.Background(), &adminservice.GetEffectiveDynamicConfigRequest{})
+	s.NoError(err)
+	idx := slices.IndexFunc(resp.GetKeys(), func(ek *adminservice.EffectiveDynamicConfigKey) bool { return ek.GetKey() == key.String() })
+	s.GreaterOrEqual(idx, 0)
+	s.Equal(&adminservice.EffectiveDynamicConfigKey{
+		Key:     key.String(),
+		Type:    string(dynamicconfig.IntType),
+		Filters: []string{"namespace"},
+		Values: []*adminservice.EffectiveDynamicConfigValue{
+			{Value: &adminservice.DynamicConfigValue{Constraints: `{"namespace":"payments"}`, Value: "30"}, Source: "override"},
+			{Value: &adminservice.DynamicConfigValue{Value: "10"}, Source: "default"},
+		},
+	}, resp.GetKeys()[idx])
+}
+
+func (s *adminHandlerSuite) TestGetNamespaceFeatureFlags() {
+	_, err := s.handler.GetNamespaceFeatureFlags(context.Background(), &adminservice.GetNamespaceFeatureFlagsRequest{})
+	s.Equal(errNamespaceNotSet, err)
+
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(s.namespaceEntry, nil)
+	resp, err := s.handler.GetNamespaceFeatureFlags(context.Background(), &adminservice.GetNamespaceFeatureFlagsRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.NoError(err)
+	s.Len(resp.GetFlags(), len(featureflag.Flags()))
+	s.True(resp.GetFlags()[featureflag.EagerWorkflowStart.Name])
+	s.False(resp.GetFlags()[featureflag.UpdateWorkflowExecution.Name])
+}
+
+func (s *adminHandlerSuite) TestGetNamespaceVisibilityRetention() {
+	_, err := s.handler.GetNamespaceVisibilityRetention(context.Background(), &adminservice.GetNamespaceVisibilityRetentionRequest{})
+	s.Equal(errNamespaceNotSet, err)
+
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		Name: s.namespace.String(),
+	}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+			Config: &persistencespb.NamespaceConfig{
+				Retention:           timestamp.DurationFromDays(30),
+				VisibilityRetention: timestamp.DurationFromDays(365),
+			},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+		},
+	}, nil)
+	resp, err := s.handler.GetNamespaceVisibilityRetention(context.Background(), &adminservice.GetNamespaceVisibilityRetentionRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.NoError(err)
+	s.Equal(365*24*time.Hour, *resp.GetVisibilityRetention())
+	s.Equal(30*24*time.Hour, *resp.GetWorkflowExecutionRetention())
+}
+
+func (s *adminHandlerSuite) TestUpdateNamespaceVisibilityRetention() {
+	_, err := s.handler.UpdateNamespaceVisibilityRetention(context.Background(), &adminservice.UpdateNamespaceVisibilityRetentionRequest{})
+	s.Equal(errNamespaceNotSet, err)
+
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(s.namespaceEntry, nil).Times(2)
+	_, err = s.handler.UpdateNamespaceVisibilityRetention(context.Background(), &adminservice.UpdateNamespaceVisibilityRetentionRequest{
+		Namespace:           s.namespace.String(),
+		VisibilityRetention: timestamp.DurationPtr(time.Minute),
+	})
+	s.Equal(errInvalidRetentionPeriod, err)
+
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{
+		NotificationVersion: 7,
+	}, nil)
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		ID: s.namespaceID.String(),
+	}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:              &persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+			Config:            &persistencespb.NamespaceConfig{Retention: timestamp.DurationFromDays(30)},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+			ConfigVersion:     3,
+		},
+	}, nil)
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), &persistence.UpdateNamespaceRequest{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+			Config: &persistencespb.NamespaceConfig{
+				Retention:           timestamp.DurationFromDays(30),
+				VisibilityRetention: timestamp.DurationFromDays(365),
+			},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+			ConfigVersion:     4,
+		},
+		NotificationVersion: 7,
+	}).Return(nil)
+	_, err = s.handler.UpdateNamespaceVisibilityRetention(context.Background(), &adminservice.UpdateNamespaceVisibilityRetentionRequest{
+		Namespace:           s.namespace.String(),
+		VisibilityRetention: timestamp.DurationFromDays(365),
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestGetSDKUsage() {
+	s.handler.sdkUsage = interceptor.NewSDKUsageInterceptor(
+		s.mockNamespaceCache,
+		log.NewNoopLogger(),
+		dynamicconfig.GetMapPropertyFn(map[string]any{}),
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(gomock.Any()).Return(nil, nil).AnyTimes()
+	for _, req := range []*workflowservice.StartWorkflowExecutionRequest{
+		{Namespace: "ns-b"},
+		{Namespace: "ns-a"},
+		{Namespace: "ns-a"},
+	} {
+		ctx := headers.SetVersionsForTests(context.Background(), "1.22.1", headers.ClientNameGoSDK, headers.SupportedServerVersions, headers.AllFeatures)
+		_, err := s.handler.sdkUsage.Intercept(
+			ctx,
+			req,
+			&grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"},
+			func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil },
+		)
+		s.NoError(err)
+	}
+	s.mockResource.MembershipMonitor.EXPECT().WhoAmI().Return(membership.NewHostInfoFromAddress("test"), nil).Times(2)
+
+	resp, err := s.handler.GetSDKUsage(context.Background(), &adminservice.GetSDKUsageRequest{})
+	s.NoError(err)
+	s.Equal("test", resp.GetHost())
+	s.Equal([]*adminservice.SDKUsage{
+		{Method: "StartWorkflowExecution", SdkName: headers.ClientNameGoSDK, SdkVersion: "1.22.1", Namespace: "ns-a", Count: 2},
+		{Method: "StartWorkflowExecution", SdkName: headers.ClientNameGoSDK, SdkVersion: "1.22.1", Namespace: "ns-b", Count: 1},
+	}, resp.GetUsage())
+
+	resp, err = s.handler.GetSDKUsage(context.Background(), &adminservice.GetSDKUsageRequest{Namespace: "ns-b"})
+	s.NoError(err)
+	s.Equal([]*adminservice.SDKUsage{
+		{Method: "StartWorkflowExecution", SdkName: headers.ClientNameGoSDK, SdkVersion: "1.22.1", Namespace: "ns-b", Count: 1},
+	}, resp.GetUsage())
+}
+
+func (s *adminHandlerSuite) TestListMetrics() {
+	resp, err := s.handler.ListMetrics(context.Background(), &adminservice.ListMetricsRequest{})
+	s.NoError(err)
+	s.Contains(resp.GetMetrics(), &adminservice.MetricDescription{
+		Name:        metrics.ServiceLatency.GetMetricName(),
+		Type:        string(metrics.MetricTypeTimer),
+		Unit:        string(metrics.Milliseconds),
+		Description: metrics.ServiceLatency.GetDescription(),
+		Tags:        []string{metrics.OperationTagName, "namespace"},
+	})
+}
+
+func (s *adminHandlerSuite) TestGetComponentHealth() {
+	s.mockResource.ClusterMetadataMgr.EXPECT().GetCurrentClusterMetadata(gomock.Any()).Return(nil, errors.New("no connection"))
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(namespace.Name(primitives.SystemLocalNamespace)).Return(namespace.ID("system-id"), nil)
+	s.mockVisibilityMgr.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.ListWorkflowExecutionsResponse{}, nil)
+	s.mockVisibilityMgr.EXPECT().GetStoreNames().Return([]string{"sqlite"})
+	s.mockMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{
+		s.mockMetadata.GetCurrentClusterName(): {Enabled: true},
+	}).AnyTimes()
+	for _, resolver := range []*membership.MockServiceResolver{
+		s.mockResource.FrontendServiceResolver,
+		s.mockResource.MatchingServiceResolver,
+		s.mockResource.HistoryServiceResolver,
+		s.mockResource.WorkerServiceResolver,
+	} {
+		resolver.EXPECT().MemberCount().Return(1)
+	}
+	s.mockResource.ArchivalMetadata.EXPECT().GetHistoryConfig().Return(archiver.NewDisabledArchvialConfig())
+	s.mockResource.ArchivalMetadata.EXPECT().GetVisibilityConfig().Return(archiver.NewDisabledArchvialConfig())
+
+	resp, err := s.handler.GetComponentHealth(context.Background(), &adminservice.GetComponentHealthRequest{})
+	s.NoError(err)
+	s.Equal(string(ComponentUnhealthy), resp.GetStatus())
+	s.Len(resp.GetComponents(), 6)
+	s.Equal("persistence", resp.GetComponents()[0].GetName())
+	s.Equal(string(ComponentUnhealthy), resp.GetComponents()[0].GetStatus())
+	s.Equal("no connection", resp.GetComponents()[0].GetError())
+	s.NotNil(resp.GetComponents()[0].GetLatency())
+	s.Equal("[sqlite]", resp.GetComponents()[1].GetDetails()["stores"])
+}
+
+func (s *adminHandlerSuite) TestImportWorkflowExecution() {
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflowID", RunId: uuid.New()}
+	events, err := serialization.NewSerializer().SerializeEvents([]*historypb.HistoryEvent{{
+		EventId:   1,
+		Version:   common.EmptyVersion,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+	}}, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	request := &adminservice.ImportWorkflowExecutionRequest{
+		Namespace:      s.namespace.String(),
+		Execution:      execution,
+		HistoryBatches: []*commonpb.DataBlob{events},
+		VersionHistory: versionhistory.NewVersionHistory(nil, []*historyspb.VersionHistoryItem{
+			versionhistory.NewVersionHistoryItem(1, common.EmptyVersion),
+		}),
+	}
+
+	_, err = s.handler.ImportWorkflowExecution(context.Background(), nil)
+	s.Equal(errRequestNotSet, err)
+	_, err = s.handler.ImportWorkflowExecution(context.Background(), &adminservice.ImportWorkflowExecutionRequest{Execution: execution})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	_, err = s.handler.ImportWorkflowExecution(context.Background(), &adminservice.ImportWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "workflowID", RunId: "run"},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(s.namespaceEntry, nil)
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewNotFound("workflow execution not found"))
+	s.mockHistoryClient.EXPECT().ReplicateEventsV2(gomock.Any(), &historyservice.ReplicateEventsV2Request{
+		NamespaceId:         s.namespaceID.String(),
+		WorkflowExecution:   execution,
+		VersionHistoryItems: request.VersionHistory.Items,
+		Events:              events,
+	}).Return(&historyservice.ReplicateEventsV2Response{}, nil)
+	_, err = s.handler.ImportWorkflowExecution(context.Background(), request)
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestDrain() {
+	_, err := s.handler.StartDrain(context.Background(), &adminservice.StartDrainRequest{Role: string(primitives.WorkerService)})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("10.0.0.1:7234"),
+	}).AnyTimes()
+	_, err = s.handler.StartDrain(context.Background(), &adminservice.StartDrainRequest{Role: string(primitives.HistoryService), Host: "10.0.0.2:7234"})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	data := map[string]string{}
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(context.Context, *persistence.GetNamespaceRequest) (*persistence.GetNamespaceResponse, error) {
+			return &persistence.GetNamespaceResponse{
+				Namespace: &persistencespb.NamespaceDetail{
+					Info: &persistencespb.NamespaceInfo{Name: primitives.SystemLocalNamespace, Data: data},
+				},
+			}, nil
+		}).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{}, nil).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			data = request.Namespace.Info.Data
+			return nil
+		}).AnyTimes()
+
+	_, err = s.handler.StartDrain(context.Background(), &adminservice.StartDrainRequest{Role: string(primitives.HistoryService), Host: "10.0.0.1:7234"})
+	s.NoError(err)
+	_, err = s.handler.StartDrain(context.Background(), &adminservice.StartDrainRequest{Role: string(primitives.HistoryService), Host: "10.0.0.1:7234"})
+	s.IsType(&serviceerror.AlreadyExists{}, err)
+
+	resp, err := s.handler.DescribeDrain(context.Background(), &adminservice.DescribeDrainRequest{})
+	s.NoError(err)
+	s.Len(resp.GetTargets(), 1)
+	s.Equal(string(primitives.HistoryService), resp.GetTargets()[0].GetRole())
+	s.False(resp.GetTargets()[0].GetDrained())
+	s.Equal([]string{"10.0.0.1:7234"}, resp.GetTargets()[0].GetPendingHosts())
+
+	cancelRequest := &adminservice.CancelDrainRequest{Role: string(primitives.HistoryService), Host: "10.0.0.1:7234"}
+	_, err = s.handler.CancelDrain(context.Background(), cancelRequest)
+	s.NoError(err)
+	_, err = s.handler.CancelDrain(context.Background(), cancelRequest)
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
+func (s *adminHandlerSuite) TestDescribePersistenceCircuitBreakers() {
+	resp, err := s.handler.DescribePersistenceCircuitBreakers(context.Background(), &adminservice.DescribePersistenceCircuitBreakersRequest{})
+	s.NoError(err)
+	s.Empty(resp.States)
+
+	s.handler.circuitBreakers = persistence.NewCircuitBreakers(
+		&persistence.CircuitBreakerConfig{
+			Enabled:            dynamicconfig.GetBoolPropertyFn(true),
+			DegradedErrorRatio: dynamicconfig.GetFloatPropertyFn(0.2),
+			OpenErrorRatio:     dynamicconfig.GetFloatPropertyFn(0.5),
+			MinRequests:        dynamicconfig.GetIntPropertyFn(1),
+			Window:             dynamicconfig.GetDurationPropertyFn(time.Minute),
+			OpenDuration:       dynamicconfig.GetDurationPropertyFn(time.Minute),
+		},
+		clock.NewRealTimeSource(),
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	)
+	s.handler.circuitBreakers.Get("ExecutionStore").Record(nil)
+	s.handler.circuitBreakers.Get("TaskStore").Record(serviceerror.NewUnavailable("unavailable"))
+
+	resp, err = s.handler.DescribePersistenceCircuitBreakers(context.Background(), &adminservice.DescribePersistenceCircuitBreakersRequest{})
+	s.NoError(err)
+	s.Equal(map[string]string{
+		"ExecutionStore": "closed",
+		"TaskStore":      "open",
+	}, resp.States)
+}
+
+func (s *adminHandlerSuite) TestCheckVisibilityConsistency_NothingToCheck() {
+	_, err := s.handler.CheckVisibilityConsistency(context.Background(), &adminservice.CheckVisibilityConsistencyRequest{})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *adminHandlerSuite) TestCheckVisibilityConsistency_DryRun() {
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(s.namespaceEntry, nil)
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.namespaceID).Return(s.namespaceEntry, nil).Times(2)
+	s.mockVisibilityMgr.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.ListWorkflowExecutionsResponse{}, nil)
+	s.mockVisibilityMgr.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{{
+			Execution: &commonpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: "run-id"},
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			CloseTime: timestamp.TimePtr(time.Now().Add(-24 * time.Hour)),
+		}},
+	}, nil)
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("not found"))
+
+	report, err := s.handler.CheckVisibilityConsistency(context.Background(), &adminservice.CheckVisibilityConsistencyRequest{
+		Namespaces: []string{s.namespace.String()},
+	})
+	s.NoError(err)
+	s.Equal(int64(1), report.GhostRecords)
+	s.Equal(int64(0), report.Repaired)
+	s.Equal([]*adminservice.VisibilityInconsistency{{
+		Type:             string(visibilityconsistency.InconsistencyGhostRecord),
+		NamespaceId:      s.namespaceID.String(),
+		WorkflowId:       "workflow-id",
+		RunId:            "run-id",
+		VisibilityStatus: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+	}}, report.Inconsistencies)
+}
+
+func (s *adminHandlerSuite) TestDeleteHistoryBranchGarbage() {
+	_, err := s.handler.DeleteHistoryBranchGarbage(context.Background(), &adminservice.DeleteHistoryBranchGarbageRequest{})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound(""))
+	s.mockExecutionMgr.EXPECT().DeleteHistoryBranch(gomock.Any(), &persistence.DeleteHistoryBranchRequest{
+		ShardID:     common.WorkflowIDToHistoryShard(s.namespaceID.String(), "workflowID", 1),
+		BranchToken: []byte("branch"),
+	}).Return(nil)
+	resp, err := s.handler.DeleteHistoryBranchGarbage(context.Background(), &adminservice.DeleteHistoryBranchGarbageRequest{
+		Candidates: []*adminservice.HistoryBranchCandidate{
+			{
+				NamespaceId: s.namespaceID.String(),
+				WorkflowId:  "workflowID",
+				RunId:       "runID",
+				BranchToken: []byte("branch"),
+				Reason:      historyscanner.CandidateReasonMutableStateNotFound,
+			},
+			{
+				BranchToken: []byte("corrupted"),
+				Reason:      historyscanner.CandidateReasonCorrupted,
+			},
+		},
+	})
+	s.NoError(err)
+	s.Equal(&adminservice.DeleteHistoryBranchGarbageResponse{Deleted: 1, Skipped: 1}, resp)
+}
+
+func (s *adminHandlerSuite) TestDescribeVisibilityIngestion() {
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("history-1"),
+		membership.NewHostInfoFromAddress("history-2"),
+	})
+	shards := []*adminservice.ShardVisibilityIngestion{{ShardId: 1, Lag: timestamp.DurationPtr(time.Minute)}}
+	s.mockHistoryClient.EXPECT().DescribeVisibilityIngestion(gomock.Any(), &historyservice.DescribeVisibilityIngestionRequest{
+		HostAddress: "history-1",
+		ShardIds:    []int32{1, 2},
+	}).Return(&historyservice.DescribeVisibilityIngestionResponse{Shards: shards}, nil)
+	s.mockHistoryClient.EXPECT().DescribeVisibilityIngestion(gomock.Any(), &historyservice.DescribeVisibilityIngestionRequest{
+		HostAddress: "history-2",
+		ShardIds:    []int32{1, 2},
+	}).Return(&historyservice.DescribeVisibilityIngestionResponse{}, nil)
+
+	resp, err := s.handler.DescribeVisibilityIngestion(context.Background(), &adminservice.DescribeVisibilityIngestionRequest{
+		ShardIds: []int32{1, 2},
+	})
+	s.NoError(err)
+	s.Equal([]*adminservice.HistoryHostVisibilityIngestion{
+		{Address: "history-1", Shards: shards},
+		{Address: "history-2"},
+	}, resp.Hosts)
+}
+
+func (s *adminHandlerSuite) TestListTopPersistenceNamespaces() {
+	_, err := s.handler.ListTopPersistenceNamespaces(context.Background(), &adminservice.ListTopPersistenceNamespacesRequest{
+		Window: timestamp.DurationPtr(time.Minute),
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	s.handler.namespaceUsage = persistence.NewNamespaceUsageTracker(
+		&persistence.NamespaceUsageConfig{MaxTaggedNamespaces: dynamicconfig.GetIntPropertyFn(0)},
+		clock.NewRealTimeSource(),
+	)
+	s.handler.namespaceUsage.Record(s.namespace.String(), time.Millisecond, nil)
+	s.handler.namespaceUsage.Record("other", time.Millisecond, nil)
+	s.handler.namespaceUsage.Record(s.namespace.String(), time.Millisecond, nil)
+
+	top, err := s.handler.ListTopPersistenceNamespaces(context.Background(), &adminservice.ListTopPersistenceNamespacesRequest{
+		Count:  1,
+		Window: timestamp.DurationPtr(time.Hour),
+	})
+	s.NoError(err)
+	s.Equal([]*adminservice.PersistenceNamespaceUsage{
+		{Namespace: s.namespace.String(), Requests: 2, TotalLatency: timestamp.DurationPtr(2 * time.Millisecond)},
+	}, top.Namespaces)
+}
+
+func (s *adminHandlerSuite) TestListArchivalDLQTasks() {
+	_, err := s.handler.ListArchivalDLQTasks(context.Background(), &adminservice.ListArchivalDLQTasksRequest{})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	enqueuedTime := time.Unix(1000, 0).UTC()
+	tasks := []*persistence.ArchivalDLQTask{{MessageID: 1, WorkflowID: "workflow-id", Attempt: 3, EnqueuedTime: enqueuedTime}}
+	s.mockArchivalDLQ.EXPECT().List(gomock.Any(), 10, []byte("token")).Return(tasks, []byte("next-token"), nil)
+	resp, err := s.handler.ListArchivalDLQTasks(context.Background(), &adminservice.ListArchivalDLQTasksRequest{
+		PageSize:      10,
+		NextPageToken: []byte("token"),
+	})
+	s.NoError(err)
+	s.Equal([]*adminservice.ArchivalDLQTask{
+		{MessageId: 1, WorkflowId: "workflow-id", Attempt: 3, EnqueuedTime: timestamp.TimePtr(enqueuedTime)},
+	}, resp.Tasks)
+	s.Equal([]byte("next-token"), resp.NextPageToken)
+}
+
+func (s *adminHandlerSuite) TestRetryArchivalDLQTask() {
+	task := &persistence.ArchivalDLQTask{
+		MessageID:   5,
+		NamespaceID: s.namespaceID.String(),
+		WorkflowID:  "workflow-id",
+		RunID:       "run-id",
+	}
+	s.mockArchivalDLQ.EXPECT().Get(gomock.Any(), int64(5)).Return(task, nil)
+	s.mockHistoryClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), &historyservice.RefreshWorkflowTasksRequest{
+		NamespaceId: s.namespaceID.String(),
+		Request: &adminservice.RefreshWorkflowTasksRequest{
+			NamespaceId: s.namespaceID.String(),
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: "workflow-id",
+				RunId:      "run-id",
+			},
+		},
+	}).Return(&historyservice.RefreshWorkflowTasksResponse{}, nil)
+	s.mockArchivalDLQ.EXPECT().Delete(gomock.Any(), int64(5)).Return(nil)
+
+	_, err := s.handler.RetryArchivalDLQTask(context.Background(), &adminservice.RetryArchivalDLQTaskRequest{MessageId: 5})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestRetryArchivalDLQTask_WorkflowNotFound() {
+	task := &persistence.ArchivalDLQTask{MessageID: 5, NamespaceID: s.namespaceID.String()}
+	s.mockArchivalDLQ.EXPECT().Get(gomock.Any(), int64(5)).Return(task, nil)
+	s.mockHistoryClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewNotFound("workflow not found"))
+	s.mockArchivalDLQ.EXPECT().Delete(gomock.Any(), int64(5)).Return(nil)
+
+	_, err := s.handler.RetryArchivalDLQTask(context.Background(), &adminservice.RetryArchivalDLQTaskRequest{MessageId: 5})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestRetryArchivalDLQTask_RefreshError() {
+	task := &persistence.ArchivalDLQTask{MessageID: 5, NamespaceID: s.namespaceID.String()}
+	s.mockArchivalDLQ.EXPECT().Get(gomock.Any(), int64(5)).Return(task, nil)
+	s.mockHistoryClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewUnavailable("unavailable"))
+
+	_, err := s.handler.RetryArchivalDLQTask(context.Background(), &adminservice.RetryArchivalDLQTaskRequest{MessageId: 5})
+	s.IsType(&serviceerror.Unavailable{}, err)
+}
+
+func (s *adminHandlerSuite) TestClusterSnapshot_InvalidStoreURI() {
+	_, err := s.handler.CreateClusterSnapshot(context.Background(), &adminservice.CreateClusterSnapshotRequest{
+		StoreUri:   "azure://bucket/snapshots",
+		SnapshotId: "snapshot-1",
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = s.handler.RestoreClusterSnapshot(context.Background(), &adminservice.RestoreClusterSnapshotRequest{
+		StoreUri:   "azure://bucket/snapshots",
+		SnapshotId: "snapshot-1",
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *adminHandlerSuite) TestClusterSnapshot_SnapshotIDNotSet() {
+	_, err := s.handler.CreateClusterSnapshot(context.Background(), &adminservice.CreateClusterSnapshotRequest{
+		StoreUri: "file:///tmp/snapshots",
+	})
+	s.Equal(errSnapshotIDNotSet, err)
+
+	_, err = s.handler.RestoreClusterSnapshot(context.Background(), &adminservice.RestoreClusterSnapshotRequest{
+		StoreUri: "file:///tmp/snapshots",
+	})
+	s.Equal(errSnapshotIDNotSet, err)
+}
@@ -0,0 +1,337 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+// CreateAPIKey creates an API key with the name, roles and rate limit of spec. It returns the stored key and
+// its token, which is the bearer credential for the key. The token is not stored and cannot be retrieved later.
+func (h *OperatorHandlerImpl) CreateAPIKey(
+	ctx context.Context,
+	spec authorization.APIKey,
+) (_ *authorization.APIKey, _ string, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	scope, startTime := h.startRequestProfile(metrics.OperatorCreateAPIKeyScope)
+	defer func() { scope.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime)) }()
+
+	if err := validateAPIKeySpec(&spec); err != nil {
+		return nil, "", err
+	}
+
+	id, err := authorization.NewAPIKeyID()
+	if err != nil {
+		return nil, "", err
+	}
+	token, secretHash, err := authorization.NewAPIKeyToken(id)
+	if err != nil {
+		return nil, "", err
+	}
+	key := &authorization.APIKey{
+		ID:         id,
+		Name:       spec.Name,
+		SecretHash: secretHash,
+		System:     spec.System,
+		Namespaces: spec.Namespaces,
+		RPS:        spec.RPS,
+		CreateTime: time.Now().UTC(),
+	}
+	err = h.updateSystemNamespaceData(ctx, func(data map[string]string) error {
+		return putAPIKey(data, key)
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	key.SecretHash = ""
+	return key, token, nil
+}
+
+// RotateAPIKey replaces the secret of an API key and returns its new token. The previous token stops working
+// once frontends refresh their namespace cache.
+func (h *OperatorHandlerImpl) RotateAPIKey(ctx context.Context, id string) (_ string, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	scope, startTime := h.startRequestProfile(metrics.OperatorRotateAPIKeyScope)
+	defer func() { scope.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime)) }()
+
+	if id == "" {
+		return "", errAPIKeyIDNotSet
+	}
+	token, secretHash, err := authorization.NewAPIKeyToken(id)
+	if err != nil {
+		return "", err
+	}
+	err = h.updateSystemNamespaceData(ctx, func(data map[string]string) error {
+		key, err := getAPIKey(data, id)
+		if err != nil {
+			return err
+		}
+		key.SecretHash = secretHash
+		key.RotateTime = time.Now().UTC()
+		return putAPIKey(data, key)
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// RevokeAPIKey deletes an API key. Its token stops working once frontends refresh their namespace cache.
+func (h *OperatorHandlerImpl) RevokeAPIKey(ctx context.Context, id string) (retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	scope, startTime := h.startRequestProfile(metrics.OperatorRevokeAPIKeyScope)
+	defer func() { scope.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime)) }()
+
+	if id == "" {
+		return errAPIKeyIDNotSet
+	}
+	return h.updateSystemNamespaceData(ctx, func(data map[string]string) error {
+		if _, err := getAPIKey(data, id); err != nil {
+			return err
+		}
+		delete(data, authorization.APIKeyDataKey(id))
+		return nil
+	})
+}
+
+// ListAPIKeys returns all API keys ordered by creation time. Secret hashes are omitted.
+func (h *OperatorHandlerImpl) ListAPIKeys(ctx context.Context) (_ []*authorization.APIKey, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	scope, startTime := h.startRequestProfile(metrics.OperatorListAPIKeysScope)
+	defer func() { scope.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime)) }()
+
+	resp, err := h.metadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: primitives.SystemLocalNamespace})
+	if err != nil {
+		return nil, err
+	}
+	var keys []*authorization.APIKey
+	for dataKey, value := range resp.Namespace.Info.Data {
+		if !authorization.IsAPIKeyDataKey(dataKey) {
+			continue
+		}
+		key, err := authorization.UnmarshalAPIKey(value)
+		if err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to decode API key %s: %v.", dataKey, err))
+		}
+		key.SecretHash = ""
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].CreateTime.Before(keys[j].CreateTime)
+	})
+	return keys, nil
+}
+
+// CreateAPIKey serves OperatorHandlerImpl.CreateAPIKey, which operatorservice doesn't define.
+func (adh *AdminHandler) CreateAPIKey(
+	ctx context.Context,
+	request *adminservice.CreateAPIKeyRequest,
+) (_ *adminservice.CreateAPIKeyResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	key, token, err := adh.operatorHandler.CreateAPIKey(ctx, authorization.APIKey{
+		Name:       request.GetName(),
+		System:     roleFromProto(request.GetSystemRole()),
+		Namespaces: rolesFromProto(request.GetNamespaceRoles()),
+		RPS:        request.GetRps(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.CreateAPIKeyResponse{
+		ApiKey: apiKeyToProto(key),
+		Token:  token,
+	}, nil
+}
+
+// RotateAPIKey serves OperatorHandlerImpl.RotateAPIKey, which operatorservice doesn't define.
+func (adh *AdminHandler) RotateAPIKey(
+	ctx context.Context,
+	request *adminservice.RotateAPIKeyRequest,
+) (_ *adminservice.RotateAPIKeyResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	token, err := adh.operatorHandler.RotateAPIKey(ctx, request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.RotateAPIKeyResponse{Token: token}, nil
+}
+
+// RevokeAPIKey serves OperatorHandlerImpl.RevokeAPIKey, which operatorservice doesn't define.
+func (adh *AdminHandler) RevokeAPIKey(
+	ctx context.Context,
+	request *adminservice.RevokeAPIKeyRequest,
+) (_ *adminservice.RevokeAPIKeyResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := adh.operatorHandler.RevokeAPIKey(ctx, request.GetId()); err != nil {
+		return nil, err
+	}
+	return &adminservice.RevokeAPIKeyResponse{}, nil
+}
+
+// ListAPIKeys serves OperatorHandlerImpl.ListAPIKeys, which operatorservice doesn't define.
+func (adh *AdminHandler) ListAPIKeys(
+	ctx context.Context,
+	request *adminservice.ListAPIKeysRequest,
+) (_ *adminservice.ListAPIKeysResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	keys, err := adh.operatorHandler.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &adminservice.ListAPIKeysResponse{}
+	for _, key := range keys {
+		resp.ApiKeys = append(resp.ApiKeys, apiKeyToProto(key))
+	}
+	return resp, nil
+}
+
+func apiKeyToProto(key *authorization.APIKey) *adminservice.APIKey {
+	result := &adminservice.APIKey{
+		Id:             key.ID,
+		Name:           key.Name,
+		SystemRole:     int32(key.System),
+		NamespaceRoles: rolesToProto(key.Namespaces),
+		Rps:            key.RPS,
+		CreateTime:     timestamp.TimePtr(key.CreateTime),
+	}
+	if !key.RotateTime.IsZero() {
+		result.RotateTime = timestamp.TimePtr(key.RotateTime)
+	}
+	return result
+}
+
+func rolesFromProto(roles map[string]int32) map[string]authorization.Role {
+	if len(roles) == 0 {
+		return nil
+	}
+	result := make(map[string]authorization.Role, len(roles))
+	for name, role := range roles {
+		result[name] = roleFromProto(role)
+	}
+	return result
+}
+
+// roleFromProto converts a role bitmask received over the API. A bitmask which doesn't fit authorization.Role is
+// converted to an invalid role rather than truncated.
+func roleFromProto(role int32) authorization.Role {
+	if role != int32(authorization.Role(role)) {
+		return authorization.Role(-1)
+	}
+	return authorization.Role(role)
+}
+
+func rolesToProto(roles map[string]authorization.Role) map[string]int32 {
+	if len(roles) == 0 {
+		return nil
+	}
+	result := make(map[string]int32, len(roles))
+	for name, role := range roles {
+		result[name] = int32(role)
+	}
+	return result
+}
+
+func validateAPIKeySpec(spec *authorization.APIKey) error {
+	if spec.Name == "" {
+		return errAPIKeyNameNotSet
+	}
+	if !spec.System.IsValid() {
+		return errInvalidAPIKeyRole
+	}
+	for ns, role := range spec.Namespaces {
+		if ns == "" || !role.IsValid() {
+			return errInvalidAPIKeyRole
+		}
+	}
+	if spec.RPS < 0 {
+		return errInvalidAPIKeyRPS
+	}
+	return nil
+}
+
+func getAPIKey(data map[string]string, id string) (*authorization.APIKey, error) {
+	value, ok := data[authorization.APIKeyDataKey(id)]
+	if !ok {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("API key %s not found.", id))
+	}
+	key, err := authorization.UnmarshalAPIKey(value)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to decode API key %s: %v.", id, err))
+	}
+	return key, nil
+}
+
+func putAPIKey(data map[string]string, key *authorization.APIKey) error {
+	value, err := authorization.MarshalAPIKey(key)
+	if err != nil {
+		return err
+	}
+	data[authorization.APIKeyDataKey(key.ID)] = value
+	return nil
+}
+
+// updateSystemNamespaceData applies update to a copy of the data of the system namespace and persists it. The
+// update is retried with the latest data if the system namespace was concurrently updated.
+func (h *OperatorHandlerImpl) updateSystemNamespaceData(ctx context.Context, update func(data map[string]string) error) error {
+	return persistence.UpdateNamespaceData(
+		ctx,
+		h.metadataManager,
+		&persistence.GetNamespaceRequest{Name: primitives.SystemLocalNamespace},
+		update,
+	)
+}
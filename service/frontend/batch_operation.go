@@ -0,0 +1,191 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pborman/uuid"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/sdk"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/service/worker/batcher"
+)
+
+type (
+	// batchOperationStarter starts batcher workflows. It is shared by the workflow handler, which serves the batch
+	// operation types of the public API, and the admin handler, which serves the types the public API can't express.
+	batchOperationStarter struct {
+		config            *Config
+		namespaceRegistry namespace.Registry
+		visibilityMgr     manager.VisibilityManager
+		historyClient     historyservice.HistoryServiceClient
+	}
+)
+
+// validate checks the fields common to every batch operation type.
+func (b *batchOperationStarter) validate(jobID string, input *batcher.BatchParams) error {
+	if len(jobID) == 0 {
+		return errBatchJobIDNotSet
+	}
+	if len(input.Namespace) == 0 {
+		return errNamespaceNotSet
+	}
+	if len(input.Query) == 0 && len(input.Executions) == 0 {
+		return errBatchOpsWorkflowFilterNotSet
+	}
+	if len(input.Query) != 0 && len(input.Executions) != 0 {
+		return errBatchOpsWorkflowFiltersNotAllowed
+	}
+	if len(input.Executions) > b.config.MaxExecutionCountBatchOperation(input.Namespace) {
+		return errBatchOpsMaxWorkflowExecutionCount
+	}
+	if len(input.Reason) == 0 {
+		return errReasonNotSet
+	}
+	return nil
+}
+
+// start starts the batcher workflow running input as the batch job jobID, once the namespace allows another
+// concurrent batch operation. input must have been validated.
+func (b *batchOperationStarter) start(
+	ctx context.Context,
+	jobID string,
+	identity string,
+	input *batcher.BatchParams,
+) error {
+	if !b.config.EnableBatcher(input.Namespace) {
+		return errBatchAPINotAllowed
+	}
+
+	namespaceName := namespace.Name(input.Namespace)
+	namespaceID, err := b.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		return err
+	}
+
+	// Validate concurrent batch operation
+	maxConcurrentBatchOperation := b.config.MaxConcurrentBatchOperation(input.Namespace)
+	openBatchOperationCount, err := b.countOpenBatchOperations(ctx, namespaceID, namespaceName, maxConcurrentBatchOperation)
+	if err != nil {
+		return err
+	}
+	if openBatchOperationCount >= maxConcurrentBatchOperation {
+		return serviceerror.NewResourceExhausted(enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT, "Max concurrent batch operations is reached")
+	}
+
+	inputPayload, err := sdk.PreferProtoDataConverter.ToPayloads(input)
+	if err != nil {
+		return err
+	}
+
+	memo := &commonpb.Memo{
+		Fields: map[string]*commonpb.Payload{
+			batcher.BatchOperationTypeMemo: payload.EncodeString(input.BatchType),
+			batcher.BatchReasonMemo:        payload.EncodeString(input.Reason),
+		},
+	}
+
+	// Add pre-define search attributes
+	var searchAttributes *commonpb.SearchAttributes
+	searchattribute.AddSearchAttribute(&searchAttributes, searchattribute.BatcherUser, payload.EncodeString(identity))
+	searchattribute.AddSearchAttribute(&searchAttributes, searchattribute.TemporalNamespaceDivision, payload.EncodeString(batcher.NamespaceDivision))
+
+	startReq := &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:             input.Namespace,
+		WorkflowId:            jobID,
+		WorkflowType:          &commonpb.WorkflowType{Name: batcher.BatchWFTypeName},
+		TaskQueue:             &taskqueuepb.TaskQueue{Name: primitives.PerNSWorkerTaskQueue},
+		Input:                 inputPayload,
+		Identity:              identity,
+		RequestId:             uuid.New(),
+		WorkflowIdReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+		Memo:                  memo,
+		SearchAttributes:      searchAttributes,
+	}
+
+	_, err = b.historyClient.StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(namespaceID.String(), startReq, nil, time.Now().UTC()))
+	return err
+}
+
+// countOpenBatchOperations returns the number of running batch jobs of the namespace, counting at most limit jobs
+// on visibility stores which don't support CountWorkflowExecutions.
+func (b *batchOperationStarter) countOpenBatchOperations(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	namespaceName namespace.Name,
+	limit int,
+) (int, error) {
+	countResp, err := b.visibilityMgr.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: namespaceID,
+		Namespace:   namespaceName,
+		Query:       batcher.OpenBatchOperationQuery,
+	})
+	if err == nil {
+		return int(countResp.Count), nil
+	}
+	if !errors.Is(err, store.OperationNotSupportedErr) {
+		return 0, err
+	}
+	// Some std visibility stores don't yet support CountWorkflowExecutions, even though some
+	// batch operations are still possible on those store (eg. by specyfing a list of Executions
+	// rather than a VisibilityQuery). Fallback to ListOpenWorkflowExecutions in these cases.
+	// TODO: Remove this once all std visibility stores support CountWorkflowExecutions.
+	openBatchOperationCount := 0
+	nextPageToken := []byte{}
+	for nextPageToken != nil && openBatchOperationCount < limit {
+		listResp, err := b.visibilityMgr.ListOpenWorkflowExecutionsByType(ctx, &manager.ListWorkflowExecutionsByTypeRequest{
+			ListWorkflowExecutionsRequest: &manager.ListWorkflowExecutionsRequest{
+				NamespaceID:       namespaceID,
+				Namespace:         namespaceName,
+				PageSize:          limit - openBatchOperationCount,
+				NextPageToken:     nextPageToken,
+				EarliestStartTime: minTime,
+				LatestStartTime:   maxTime,
+			},
+			WorkflowTypeName: batcher.BatchWFTypeName,
+		})
+		if err != nil {
+			return 0, err
+		}
+		openBatchOperationCount += len(listResp.Executions)
+		nextPageToken = listResp.NextPageToken
+	}
+	return openBatchOperationCount, nil
+}
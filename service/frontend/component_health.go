@@ -0,0 +1,368 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/client/admin"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	ComponentHealthy   ComponentHealthStatus = "healthy"
+	ComponentDegraded  ComponentHealthStatus = "degraded"
+	ComponentUnhealthy ComponentHealthStatus = "unhealthy"
+
+	componentHealthProbeTimeout = 5 * time.Second
+	// persistence is reported as degraded above this ratio of failed requests
+	componentHealthDegradedErrorRatio = 0.05
+	// a replication stream is reported as degraded when the oldest unacknowledged task is older than this
+	componentHealthDegradedReplicationLag = 5 * time.Minute
+)
+
+type (
+	// ComponentHealthStatus is the health of a component, ordered from healthy to unhealthy
+	ComponentHealthStatus string
+
+	// ComponentHealth is the health of a single subsystem as seen from this frontend host.
+	ComponentHealth struct {
+		Name   string
+		Status ComponentHealthStatus
+		// Latency is the time taken by the probe of the component.
+		Latency time.Duration
+		// Error is the error returned by the probe, if any.
+		Error   string
+		Details map[string]string
+	}
+
+	uriValidator interface {
+		ValidateURI(uri archiver.URI) error
+	}
+
+	// ComponentHealthReport is the health of every subsystem. Status is the worst status of the components.
+	ComponentHealthReport struct {
+		Status     ComponentHealthStatus
+		Components []*ComponentHealth
+	}
+)
+
+var componentHealthRoles = []primitives.ServiceName{
+	primitives.FrontendService,
+	primitives.HistoryService,
+	primitives.MatchingService,
+	primitives.WorkerService,
+}
+
+// GetComponentHealth probes each subsystem the cluster depends on: primary persistence, visibility, the
+// replication stream of each remote cluster, the membership ring of each service and the archival sinks.
+// Failing probes are reported in the result rather than failing the call, so it can back load balancer
+// checks and dashboards.
+func (h *OperatorHandlerImpl) GetComponentHealth(
+	ctx context.Context,
+) (_ *ComponentHealthReport, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	scope, startTime := h.startRequestProfile(metrics.OperatorGetComponentHealthScope)
+	defer func() { scope.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime)) }()
+
+	probes := []func(context.Context) []*ComponentHealth{
+		h.persistenceHealth,
+		h.visibilityHealth,
+		h.replicationHealth,
+		h.membershipHealth,
+		h.archivalHealth,
+	}
+	results := make([][]*ComponentHealth, len(probes))
+	var wg sync.WaitGroup
+	for i, probe := range probes {
+		i, probe := i, probe
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			probeCtx, cancel := context.WithTimeout(ctx, componentHealthProbeTimeout)
+			defer cancel()
+			results[i] = probe(probeCtx)
+		}()
+	}
+	wg.Wait()
+
+	report := &ComponentHealthReport{Status: ComponentHealthy}
+	for _, components := range results {
+		for _, component := range components {
+			report.Components = append(report.Components, component)
+			report.Status = worseComponentHealthStatus(report.Status, component.Status)
+		}
+	}
+	return report, nil
+}
+
+// GetComponentHealth serves OperatorHandlerImpl.GetComponentHealth, which operatorservice doesn't define.
+func (adh *AdminHandler) GetComponentHealth(
+	ctx context.Context,
+	request *adminservice.GetComponentHealthRequest,
+) (_ *adminservice.GetComponentHealthResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	report, err := adh.operatorHandler.GetComponentHealth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &adminservice.GetComponentHealthResponse{Status: string(report.Status)}
+	for _, component := range report.Components {
+		resp.Components = append(resp.Components, &adminservice.ComponentHealth{
+			Name:    component.Name,
+			Status:  string(component.Status),
+			Latency: timestamp.DurationPtr(component.Latency),
+			Error:   component.Error,
+			Details: component.Details,
+		})
+	}
+	return resp, nil
+}
+
+func (h *OperatorHandlerImpl) persistenceHealth(ctx context.Context) []*ComponentHealth {
+	component := probeComponent("persistence", func() error {
+		_, err := h.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+		return err
+	})
+	if h.healthSignals != nil {
+		errorRatio := h.healthSignals.ErrorRatio()
+		component.Details["average_latency_ms"] = strconv.FormatFloat(h.healthSignals.AverageLatency(), 'f', 2, 64)
+		component.Details["p99_latency_ms"] = strconv.FormatFloat(h.healthSignals.LatencyPercentile(99), 'f', 2, 64)
+		component.Details["error_ratio"] = strconv.FormatFloat(errorRatio, 'f', 4, 64)
+		if errorRatio > componentHealthDegradedErrorRatio {
+			component.Status = worseComponentHealthStatus(component.Status, ComponentDegraded)
+		}
+	}
+	if h.circuitBreakers != nil {
+		for store, state := range h.circuitBreakers.States() {
+			component.Details["circuit_breaker_"+store] = state.String()
+			switch state {
+			case persistence.CircuitBreakerStateDegraded:
+				component.Status = worseComponentHealthStatus(component.Status, ComponentDegraded)
+			case persistence.CircuitBreakerStateOpen:
+				component.Status = ComponentUnhealthy
+			}
+		}
+	}
+	return []*ComponentHealth{component}
+}
+
+func (h *OperatorHandlerImpl) visibilityHealth(ctx context.Context) []*ComponentHealth {
+	component := probeComponent("visibility", func() error {
+		namespaceID, err := h.namespaceRegistry.GetNamespaceID(primitives.SystemLocalNamespace)
+		if err != nil {
+			return err
+		}
+		_, err = h.visibilityMgr.ListWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+			NamespaceID: namespaceID,
+			Namespace:   primitives.SystemLocalNamespace,
+			PageSize:    1,
+		})
+		return err
+	})
+	component.Details["stores"] = fmt.Sprint(h.visibilityMgr.GetStoreNames())
+	return []*ComponentHealth{component}
+}
+
+func (h *OperatorHandlerImpl) replicationHealth(ctx context.Context) []*ComponentHealth {
+	currentClusterName := h.clusterMetadata.GetCurrentClusterName()
+	var remoteClusters []string
+	for clusterName, clusterInfo := range h.clusterMetadata.GetAllClusterInfo() {
+		if clusterName != currentClusterName && clusterInfo.Enabled {
+			remoteClusters = append(remoteClusters, clusterName)
+		}
+	}
+	if len(remoteClusters) == 0 {
+		return nil
+	}
+
+	statusResp, statusErr := h.historyClient.GetReplicationStatus(ctx, &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: remoteClusters,
+	})
+
+	components := make([]*ComponentHealth, 0, len(remoteClusters))
+	for _, clusterName := range remoteClusters {
+		clusterInfo := h.clusterMetadata.GetAllClusterInfo()[clusterName]
+		component := probeComponent("replication/"+clusterName, func() error {
+			adminClient := h.clientFactory.NewRemoteAdminClientWithTimeout(
+				clusterInfo.RPCAddress,
+				admin.DefaultTimeout,
+				admin.DefaultLargeTimeout,
+			)
+			_, err := adminClient.DescribeCluster(ctx, &adminservice.DescribeClusterRequest{})
+			return err
+		})
+		component.Details["address"] = clusterInfo.RPCAddress
+		if statusErr != nil {
+			component.Status = worseComponentHealthStatus(component.Status, ComponentDegraded)
+			component.Details["replication_status_error"] = statusErr.Error()
+		} else {
+			lag, backlog := replicationLag(statusResp.GetShards(), clusterName)
+			component.Details["max_lag"] = lag.String()
+			component.Details["backlog_tasks"] = strconv.FormatInt(backlog, 10)
+			if lag > componentHealthDegradedReplicationLag {
+				component.Status = worseComponentHealthStatus(component.Status, ComponentDegraded)
+			}
+		}
+		components = append(components, component)
+	}
+	return components
+}
+
+// replicationLag returns the largest delay between the newest replication task and the last task
+// acknowledged by the remote cluster across shards, and the total number of unacknowledged tasks.
+func replicationLag(shards []*historyservice.ShardReplicationStatus, clusterName string) (time.Duration, int64) {
+	var maxLag time.Duration
+	var backlog int64
+	for _, shard := range shards {
+		remote, ok := shard.GetRemoteClusters()[clusterName]
+		if !ok {
+			continue
+		}
+		if pending := shard.GetMaxReplicationTaskId() - remote.GetAckedTaskId(); pending > 0 {
+			backlog += pending
+		}
+		lag := timestamp.TimeValue(shard.GetMaxReplicationTaskVisibilityTime()).Sub(
+			timestamp.TimeValue(remote.GetAckedTaskVisibilityTime()),
+		)
+		if lag > maxLag {
+			maxLag = lag
+		}
+	}
+	return maxLag, backlog
+}
+
+func (h *OperatorHandlerImpl) membershipHealth(_ context.Context) []*ComponentHealth {
+	components := make([]*ComponentHealth, 0, len(componentHealthRoles))
+	for _, role := range componentHealthRoles {
+		var members int
+		component := probeComponent("membership/"+string(role), func() error {
+			resolver, err := h.membershipMonitor.GetResolver(role)
+			if err != nil {
+				return err
+			}
+			members = resolver.MemberCount()
+			return nil
+		})
+		component.Details["members"] = strconv.Itoa(members)
+		if component.Error == "" && members == 0 {
+			component.Status = ComponentUnhealthy
+		}
+		components = append(components, component)
+	}
+	return components
+}
+
+func (h *OperatorHandlerImpl) archivalHealth(_ context.Context) []*ComponentHealth {
+	var components []*ComponentHealth
+	if config := h.archivalMetadata.GetHistoryConfig(); config.ClusterConfiguredForArchival() {
+		components = append(components, h.archivalSinkHealth("archival/history", config, func(scheme string) (uriValidator, error) {
+			return h.archiverProvider.GetHistoryArchiver(scheme, string(primitives.FrontendService))
+		}))
+	}
+	if config := h.archivalMetadata.GetVisibilityConfig(); config.ClusterConfiguredForArchival() {
+		components = append(components, h.archivalSinkHealth("archival/visibility", config, func(scheme string) (uriValidator, error) {
+			return h.archiverProvider.GetVisibilityArchiver(scheme, string(primitives.FrontendService))
+		}))
+	}
+	return components
+}
+
+// archivalSinkHealth checks that the archiver of the namespace default URI can be created and accepts the URI.
+// Archivers don't expose a way to check the sink without writing to it.
+func (h *OperatorHandlerImpl) archivalSinkHealth(
+	name string,
+	config archiver.ArchivalConfig,
+	getArchiver func(scheme string) (uriValidator, error),
+) *ComponentHealth {
+	defaultURI := config.GetNamespaceDefaultURI()
+	component := probeComponent(name, func() error {
+		if defaultURI == "" {
+			return nil
+		}
+		uri, err := archiver.NewURI(defaultURI)
+		if err != nil {
+			return err
+		}
+		a, err := getArchiver(uri.Scheme())
+		if err != nil {
+			return err
+		}
+		return a.ValidateURI(uri)
+	})
+	component.Details["read_enabled"] = strconv.FormatBool(config.ReadEnabled())
+	component.Details["default_uri"] = defaultURI
+	return component
+}
+
+func probeComponent(name string, probe func() error) *ComponentHealth {
+	startTime := time.Now()
+	err := probe()
+	component := &ComponentHealth{
+		Name:    name,
+		Status:  ComponentHealthy,
+		Latency: time.Since(startTime),
+		Details: make(map[string]string),
+	}
+	if err != nil {
+		component.Status = ComponentUnhealthy
+		component.Error = err.Error()
+	}
+	return component
+}
+
+func worseComponentHealthStatus(a, b ComponentHealthStatus) ComponentHealthStatus {
+	rank := func(s ComponentHealthStatus) int {
+		switch s {
+		case ComponentUnhealthy:
+			return 2
+		case ComponentDegraded:
+			return 1
+		default:
+			return 0
+		}
+	}
+	if rank(b) > rank(a) {
+		return b
+	}
+	return a
+}
@@ -40,6 +40,14 @@ var (
 		"GetWorkflowExecutionHistory": 1,
 	}
 
+	// LongPollAPIs are the APIs whose in-flight requests are waited for when the frontend drains
+	LongPollAPIs = map[string]struct{}{
+		"PollActivityTaskQueue":       {},
+		"PollWorkflowTaskQueue":       {},
+		"PollWorkflowExecutionUpdate": {},
+		"GetWorkflowExecutionHistory": {},
+	}
+
 	ExecutionAPIToPriority = map[string]int{
 		// priority 0
 		"StartWorkflowExecution":           0,
@@ -0,0 +1,582 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"gopkg.in/yaml.v3"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	// dynamicConfigChangesDataKey is the key of the system namespace data holding the most recent
+	// changes to the runtime dynamic config overrides
+	dynamicConfigChangesDataKey = "temporal.dynamicconfig.changes"
+	maxDynamicConfigChanges     = 100
+)
+
+type (
+	// DynamicConfigChange is a change to the runtime dynamic config overrides.
+	DynamicConfigChange struct {
+		Time time.Time `json:"time"`
+		// Caller is the subject of the caller's claims.
+		Caller      string         `json:"caller,omitempty"`
+		Identity    string         `json:"identity,omitempty"`
+		Key         string         `json:"key"`
+		Constraints map[string]any `json:"constraints,omitempty"`
+		// OldValue is nil if the value was added, and NewValue is nil if the value was deleted.
+		OldValue any `json:"oldValue,omitempty"`
+		NewValue any `json:"newValue,omitempty"`
+		// OldRollout and NewRollout are the rollout percentages of the value, nil if it applies to all.
+		OldRollout *int `json:"oldRollout,omitempty"`
+		NewRollout *int `json:"newRollout,omitempty"`
+	}
+)
+
+// GetDynamicConfigOverrides returns the dynamic config values set at runtime.
+func (h *OperatorHandlerImpl) GetDynamicConfigOverrides(ctx context.Context) (_ dynamicconfig.Overrides, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	scope, startTime := h.startRequestProfile(metrics.OperatorGetDynamicConfigOverridesScope)
+	defer func() { scope.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime)) }()
+
+	resp, err := h.metadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: primitives.SystemLocalNamespace})
+	if err != nil {
+		return nil, err
+	}
+	overrides, err := dynamicconfig.ParseOverrides(resp.Namespace.Info.Data[dynamicconfig.OverridesDataKey])
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	return overrides, nil
+}
+
+// SetDynamicConfigOverride sets the value of a dynamic config key for the constraints of value at runtime,
+// replacing the value previously set for the same constraints. The value must satisfy the schema of the key.
+// Services apply it once they refresh their namespace cache, in preference to the dynamic config file.
+func (h *OperatorHandlerImpl) SetDynamicConfigOverride(
+	ctx context.Context,
+	key string,
+	value dynamicconfig.OverrideValue,
+	identity string,
+) (retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	scope, startTime := h.startRequestProfile(metrics.OperatorSetDynamicConfigOverrideScope)
+	defer func() { scope.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime)) }()
+
+	if key == "" {
+		return errDynamicConfigKeyNotSet
+	}
+	if err := dynamicconfig.ValidateOverride(dynamicconfig.Key(key), value); err != nil {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid value for dynamic config key %s: %v.", key, err))
+	}
+
+	return h.updateDynamicConfigOverrides(ctx, func(overrides dynamicconfig.Overrides) (*DynamicConfigChange, error) {
+		key = overridesKey(overrides, key)
+		change := &DynamicConfigChange{Key: key, Constraints: value.Constraints, NewValue: value.Value, NewRollout: value.Rollout}
+		values := overrides[key]
+		for i, existing := range values {
+			same, err := existing.SameConstraints(value.Constraints)
+			if err != nil {
+				return nil, serviceerror.NewInternal(err.Error())
+			}
+			if same {
+				change.OldValue = existing.Value
+				change.OldRollout = existing.Rollout
+				values[i] = value
+				return change, nil
+			}
+		}
+		overrides[key] = append(values, value)
+		return change, nil
+	}, identity)
+}
+
+// DeleteDynamicConfigOverride deletes the value of a dynamic config key set at runtime for constraints.
+func (h *OperatorHandlerImpl) DeleteDynamicConfigOverride(
+	ctx context.Context,
+	key string,
+	constraints map[string]any,
+	identity string,
+) (retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	scope, startTime := h.startRequestProfile(metrics.OperatorDeleteDynamicConfigOverrideScope)
+	defer func() { scope.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime)) }()
+
+	if key == "" {
+		return errDynamicConfigKeyNotSet
+	}
+
+	return h.updateDynamicConfigOverrides(ctx, func(overrides dynamicconfig.Overrides) (*DynamicConfigChange, error) {
+		key = overridesKey(overrides, key)
+		values := overrides[key]
+		for i, existing := range values {
+			same, err := existing.SameConstraints(constraints)
+			if err != nil {
+				return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid constraints: %v.", err))
+			}
+			if !same {
+				continue
+			}
+			values = append(values[:i], values[i+1:]...)
+			if len(values) == 0 {
+				delete(overrides, key)
+			} else {
+				overrides[key] = values
+			}
+			return &DynamicConfigChange{Key: key, Constraints: constraints, OldValue: existing.Value, OldRollout: existing.Rollout}, nil
+		}
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("Dynamic config key %s has no value set for constraints %v.", key, constraints))
+	}, identity)
+}
+
+// SetDynamicConfigRollout changes the percentage of the namespaces, task queues or shards which the value of a
+// dynamic config key set at runtime for constraints applies to. The others keep their previous value. Advancing
+// the rollout keeps the value for those already selected, 100 applies it to all, and 0 rolls it back while
+// keeping it set, so that the rollout can be resumed.
+func (h *OperatorHandlerImpl) SetDynamicConfigRollout(
+	ctx context.Context,
+	key string,
+	constraints map[string]any,
+	percentage int,
+	identity string,
+) (retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	scope, startTime := h.startRequestProfile(metrics.OperatorSetDynamicConfigRolloutScope)
+	defer func() { scope.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime)) }()
+
+	if key == "" {
+		return errDynamicConfigKeyNotSet
+	}
+	if percentage < 0 || percentage > 100 {
+		return errInvalidDynamicConfigRollout
+	}
+	var rollout *int
+	if percentage < 100 {
+		rollout = &percentage
+	}
+
+	return h.updateDynamicConfigOverrides(ctx, func(overrides dynamicconfig.Overrides) (*DynamicConfigChange, error) {
+		key = overridesKey(overrides, key)
+		for i, existing := range overrides[key] {
+			same, err := existing.SameConstraints(constraints)
+			if err != nil {
+				return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid constraints: %v.", err))
+			}
+			if !same {
+				continue
+			}
+			updated := existing
+			updated.Rollout = rollout
+			if err := dynamicconfig.ValidateOverride(dynamicconfig.Key(key), updated); err != nil {
+				return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid rollout for dynamic config key %s: %v.", key, err))
+			}
+			overrides[key][i] = updated
+			return &DynamicConfigChange{
+				Key:         key,
+				Constraints: constraints,
+				OldValue:    existing.Value,
+				NewValue:    existing.Value,
+				OldRollout:  existing.Rollout,
+				NewRollout:  rollout,
+			}, nil
+		}
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("Dynamic config key %s has no value set for constraints %v.", key, constraints))
+	}, identity)
+}
+
+// ListDynamicConfigChanges returns the most recent changes to the runtime dynamic config overrides, most recent first.
+func (h *OperatorHandlerImpl) ListDynamicConfigChanges(ctx context.Context) (_ []*DynamicConfigChange, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	scope, startTime := h.startRequestProfile(metrics.OperatorListDynamicConfigChangesScope)
+	defer func() { scope.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime)) }()
+
+	resp, err := h.metadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: primitives.SystemLocalNamespace})
+	if err != nil {
+		return nil, err
+	}
+	changes, err := unmarshalDynamicConfigChanges(resp.Namespace.Info.Data[dynamicConfigChangesDataKey])
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	return changes, nil
+}
+
+// GetEffectiveDynamicConfig returns the type, constraints and values of every known dynamic config key, with
+// whether each value comes from a runtime override, the KV store, the dynamic config file or the defaults.
+// Values are those seen by this frontend, so recent overrides may be missing until its next refresh.
+func (h *OperatorHandlerImpl) GetEffectiveDynamicConfig(_ context.Context) (_ []dynamicconfig.EffectiveKey, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	scope, startTime := h.startRequestProfile(metrics.OperatorGetEffectiveDynamicConfigScope)
+	defer func() { scope.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime)) }()
+
+	return dynamicconfig.EffectiveConfig(h.dynamicConfigClient), nil
+}
+
+// GetDynamicConfigOverrides serves OperatorHandlerImpl.GetDynamicConfigOverrides, which operatorservice doesn't
+// define.
+func (adh *AdminHandler) GetDynamicConfigOverrides(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigOverridesRequest,
+) (_ *adminservice.GetDynamicConfigOverridesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	overrides, err := adh.operatorHandler.GetDynamicConfigOverrides(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &adminservice.GetDynamicConfigOverridesResponse{}
+	for key, values := range overrides {
+		override := &adminservice.DynamicConfigOverride{Key: key}
+		for _, value := range values {
+			encoded, err := dynamicConfigValueToProto(value.Constraints, value.Value, value.Rollout)
+			if err != nil {
+				return nil, err
+			}
+			override.Values = append(override.Values, encoded)
+		}
+		resp.Overrides = append(resp.Overrides, override)
+	}
+	sort.Slice(resp.Overrides, func(i, j int) bool {
+		return resp.Overrides[i].Key < resp.Overrides[j].Key
+	})
+	return resp, nil
+}
+
+// SetDynamicConfigOverride serves OperatorHandlerImpl.SetDynamicConfigOverride, which operatorservice doesn't
+// define.
+func (adh *AdminHandler) SetDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigOverrideRequest,
+) (_ *adminservice.SetDynamicConfigOverrideResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	value := dynamicconfig.OverrideValue{}
+	if err := decodeDynamicConfigJSON(request.GetValue().GetConstraints(), &value.Constraints); err != nil {
+		return nil, err
+	}
+	if err := decodeDynamicConfigJSON(request.GetValue().GetValue(), &value.Value); err != nil {
+		return nil, err
+	}
+	if rollout := request.GetValue().GetRollout(); rollout != nil {
+		percentage := int(rollout.GetPercentage())
+		value.Rollout = &percentage
+	}
+	if err := adh.operatorHandler.SetDynamicConfigOverride(ctx, request.GetKey(), value, request.GetIdentity()); err != nil {
+		return nil, err
+	}
+	return &adminservice.SetDynamicConfigOverrideResponse{}, nil
+}
+
+// DeleteDynamicConfigOverride serves OperatorHandlerImpl.DeleteDynamicConfigOverride, which operatorservice
+// doesn't define.
+func (adh *AdminHandler) DeleteDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.DeleteDynamicConfigOverrideRequest,
+) (_ *adminservice.DeleteDynamicConfigOverrideResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	var constraints map[string]any
+	if err := decodeDynamicConfigJSON(request.GetConstraints(), &constraints); err != nil {
+		return nil, err
+	}
+	if err := adh.operatorHandler.DeleteDynamicConfigOverride(ctx, request.GetKey(), constraints, request.GetIdentity()); err != nil {
+		return nil, err
+	}
+	return &adminservice.DeleteDynamicConfigOverrideResponse{}, nil
+}
+
+// SetDynamicConfigRollout serves OperatorHandlerImpl.SetDynamicConfigRollout, which operatorservice doesn't
+// define.
+func (adh *AdminHandler) SetDynamicConfigRollout(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRolloutRequest,
+) (_ *adminservice.SetDynamicConfigRolloutResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	var constraints map[string]any
+	if err := decodeDynamicConfigJSON(request.GetConstraints(), &constraints); err != nil {
+		return nil, err
+	}
+	err := adh.operatorHandler.SetDynamicConfigRollout(
+		ctx,
+		request.GetKey(),
+		constraints,
+		int(request.GetPercentage()),
+		request.GetIdentity(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.SetDynamicConfigRolloutResponse{}, nil
+}
+
+// ListDynamicConfigChanges serves OperatorHandlerImpl.ListDynamicConfigChanges, which operatorservice doesn't
+// define.
+func (adh *AdminHandler) ListDynamicConfigChanges(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigChangesRequest,
+) (_ *adminservice.ListDynamicConfigChangesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	changes, err := adh.operatorHandler.ListDynamicConfigChanges(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &adminservice.ListDynamicConfigChangesResponse{}
+	for _, change := range changes {
+		encoded := &adminservice.DynamicConfigChange{
+			Time:       timestamp.TimePtr(change.Time),
+			Caller:     change.Caller,
+			Identity:   change.Identity,
+			Key:        change.Key,
+			OldRollout: dynamicConfigRolloutToProto(change.OldRollout),
+			NewRollout: dynamicConfigRolloutToProto(change.NewRollout),
+		}
+		if encoded.Constraints, err = encodeDynamicConfigJSON(change.Constraints); err != nil {
+			return nil, err
+		}
+		if encoded.OldValue, err = encodeDynamicConfigJSON(change.OldValue); err != nil {
+			return nil, err
+		}
+		if encoded.NewValue, err = encodeDynamicConfigJSON(change.NewValue); err != nil {
+			return nil, err
+		}
+		resp.Changes = append(resp.Changes, encoded)
+	}
+	return resp, nil
+}
+
+// GetEffectiveDynamicConfig serves OperatorHandlerImpl.GetEffectiveDynamicConfig, which operatorservice doesn't
+// define.
+func (adh *AdminHandler) GetEffectiveDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetEffectiveDynamicConfigRequest,
+) (_ *adminservice.GetEffectiveDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	effective, err := adh.operatorHandler.GetEffectiveDynamicConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &adminservice.GetEffectiveDynamicConfigResponse{}
+	for _, ek := range effective {
+		key := &adminservice.EffectiveDynamicConfigKey{
+			Key:  ek.Key,
+			Type: string(ek.Type),
+		}
+		for _, filter := range ek.Filters {
+			key.Filters = append(key.Filters, string(filter))
+		}
+		for _, ev := range ek.Values {
+			value, err := dynamicConfigValueToProto(ev.Constraints, ev.Value, ev.Rollout)
+			if err != nil {
+				return nil, err
+			}
+			key.Values = append(key.Values, &adminservice.EffectiveDynamicConfigValue{
+				Value:  value,
+				Source: string(ev.Source),
+			})
+		}
+		resp.Keys = append(resp.Keys, key)
+	}
+	return resp, nil
+}
+
+// updateDynamicConfigOverrides applies update to the overrides and records the change it returns.
+func (h *OperatorHandlerImpl) updateDynamicConfigOverrides(
+	ctx context.Context,
+	update func(overrides dynamicconfig.Overrides) (*DynamicConfigChange, error),
+	identity string,
+) error {
+	var change *DynamicConfigChange
+	err := h.updateSystemNamespaceData(ctx, func(data map[string]string) error {
+		overrides, err := dynamicconfig.ParseOverrides(data[dynamicconfig.OverridesDataKey])
+		if err != nil {
+			return serviceerror.NewInternal(err.Error())
+		}
+		change, err = update(overrides)
+		if err != nil {
+			return err
+		}
+		document, err := overrides.Marshal()
+		if err != nil {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("Unable to encode dynamic config overrides: %v.", err))
+		}
+		if document == "" {
+			delete(data, dynamicconfig.OverridesDataKey)
+		} else {
+			data[dynamicconfig.OverridesDataKey] = document
+		}
+
+		change.Time = time.Now().UTC()
+		change.Identity = identity
+		if claims, ok := ctx.Value(authorization.MappedClaims).(*authorization.Claims); ok && claims != nil {
+			change.Caller = claims.Subject
+		}
+		changes, err := unmarshalDynamicConfigChanges(data[dynamicConfigChangesDataKey])
+		if err != nil {
+			// a corrupted trail must not prevent fixing the config, it is restarted
+			h.logger.Warn("Unable to decode dynamic config changes, discarding them", tag.Error(err))
+			changes = nil
+		}
+		changes = append([]*DynamicConfigChange{change}, changes...)
+		if len(changes) > maxDynamicConfigChanges {
+			changes = changes[:maxDynamicConfigChanges]
+		}
+		encoded, err := json.Marshal(changes)
+		if err != nil {
+			return serviceerror.NewInternal(fmt.Sprintf("Unable to encode dynamic config changes: %v.", err))
+		}
+		data[dynamicConfigChangesDataKey] = string(encoded)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	h.logger.Info("Dynamic config override changed",
+		tag.Key(change.Key),
+		tag.NewAnyTag("constraints", change.Constraints),
+		tag.NewAnyTag("old-value", change.OldValue),
+		tag.NewAnyTag("new-value", change.NewValue),
+		tag.NewAnyTag("old-rollout", change.OldRollout),
+		tag.NewAnyTag("new-rollout", change.NewRollout),
+		tag.NewStringTag("caller", change.Caller),
+		tag.NewStringTag("identity", change.Identity),
+	)
+	return nil
+}
+
+// overridesKey returns the key of overrides matching key, which is case-insensitive, or key if there is none.
+func overridesKey(overrides dynamicconfig.Overrides, key string) string {
+	for existing := range overrides {
+		if strings.EqualFold(existing, key) {
+			return existing
+		}
+	}
+	return key
+}
+
+func unmarshalDynamicConfigChanges(value string) ([]*DynamicConfigChange, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var changes []*DynamicConfigChange
+	if err := json.Unmarshal([]byte(value), &changes); err != nil {
+		return nil, fmt.Errorf("unable to decode dynamic config changes: %w", err)
+	}
+	return changes, nil
+}
+
+func dynamicConfigValueToProto(constraints map[string]any, value any, rollout *int) (*adminservice.DynamicConfigValue, error) {
+	encodedConstraints, err := encodeDynamicConfigJSON(constraints)
+	if err != nil {
+		return nil, err
+	}
+	encodedValue, err := encodeDynamicConfigJSON(value)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DynamicConfigValue{
+		Constraints: encodedConstraints,
+		Value:       encodedValue,
+		Rollout:     dynamicConfigRolloutToProto(rollout),
+	}, nil
+}
+
+func dynamicConfigRolloutToProto(rollout *int) *adminservice.DynamicConfigRollout {
+	if rollout == nil {
+		return nil
+	}
+	return &adminservice.DynamicConfigRollout{Percentage: int32(*rollout)}
+}
+
+// encodeDynamicConfigJSON encodes constraints or a value of dynamic config as JSON, or as an empty string if
+// there is none.
+func encodeDynamicConfigJSON(v any) (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	if constraints, ok := v.(map[string]any); ok && len(constraints) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", serviceerror.NewInternal(fmt.Sprintf("Unable to encode dynamic config value: %v.", err))
+	}
+	return string(data), nil
+}
+
+// decodeDynamicConfigJSON decodes JSON constraints or a value of dynamic config the way the dynamic config file
+// is decoded, so that e.g. integers are decoded as int rather than float64.
+func decodeDynamicConfigJSON(data string, v any) error {
+	if data == "" {
+		return nil
+	}
+	if err := yaml.Unmarshal([]byte(data), v); err != nil {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid dynamic config JSON %q: %v.", data, err))
+	}
+	return nil
+}
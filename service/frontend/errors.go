@@ -68,15 +68,30 @@ var (
 	errInvalidVersionHistories                            = serviceerror.NewInvalidArgument("Invalid version histories.")
 	errInvalidEventQueryRange                             = serviceerror.NewInvalidArgument("Invalid event query range.")
 	errDLQTypeIsNotSupported                              = serviceerror.NewInvalidArgument("The DLQ type is not supported.")
+	errDLQNamespaceFilterIsNotSupported                   = serviceerror.NewInvalidArgument("Filtering by namespace is only supported by the replication DLQ.")
 	errFailureMustHaveApplicationFailureInfo              = serviceerror.NewInvalidArgument("Failure must have ApplicationFailureInfo.")
 	errStatusFilterMustBeNotRunning                       = serviceerror.NewInvalidArgument("StatusFilter must be specified and must be not Running.")
 	errCronNotAllowed                                     = serviceerror.NewInvalidArgument("Scheduled workflow must not contain CronSchedule")
 	errIDReusePolicyNotAllowed                            = serviceerror.NewInvalidArgument("Scheduled workflow must not contain WorkflowIDReusePolicy")
 	errUnableDeleteSystemNamespace                        = serviceerror.NewInvalidArgument("Unable to delete system namespace.")
+	errExportURINotSet                                    = serviceerror.NewInvalidArgument("ExportURI is not set on request.")
+	errInvalidDeletionRateUpdate                          = serviceerror.NewInvalidArgument("Deletion rate update must set a positive DeleteActivityRPS or ConcurrentDeleteExecutionsActivities and no negative values.")
+	errAPIKeyNameNotSet                                   = serviceerror.NewInvalidArgument("API key Name is not set on request.")
+	errAPIKeyIDNotSet                                     = serviceerror.NewInvalidArgument("API key ID is not set on request.")
+	errInvalidAPIKeyRole                                  = serviceerror.NewInvalidArgument("API key roles must be valid and bound to non-empty namespace names.")
+	errInvalidAPIKeyRPS                                   = serviceerror.NewInvalidArgument("API key RPS must not be negative.")
+	errRoleNameNotSet                                     = serviceerror.NewInvalidArgument("Role name is not set on request.")
+	errInvalidRolePermission                              = serviceerror.NewInvalidArgument("Role permissions must be one of start, signal, query, admin or operator.")
+	errGroupNotSet                                        = serviceerror.NewInvalidArgument("Group is not set on request.")
+	errDynamicConfigKeyNotSet                             = serviceerror.NewInvalidArgument("Dynamic config key is not set on request.")
+	errInvalidDynamicConfigRollout                        = serviceerror.NewInvalidArgument("Dynamic config rollout must be a percentage between 0 and 100.")
 	errBatchJobIDNotSet                                   = serviceerror.NewInvalidArgument("JobId is not set on request.")
 	errNamespaceNotSet                                    = serviceerror.NewInvalidArgument("Namespace is not set on request.")
 	errReasonNotSet                                       = serviceerror.NewInvalidArgument("Reason is not set on request.")
 	errBatchOperationNotSet                               = serviceerror.NewInvalidArgument("Batch operation is not set on request.")
+	errBuildIDNotSet                                      = serviceerror.NewInvalidArgument("Build ID is not set on request.")
+	errMemoNotSet                                         = serviceerror.NewInvalidArgument("Memo is not set on request.")
+	errSnapshotIDNotSet                                   = serviceerror.NewInvalidArgument("SnapshotId is not set on request.")
 	errCronAndStartDelaySet                               = serviceerror.NewInvalidArgument("CronSchedule and WorkflowStartDelay may not be used together.")
 	errInvalidWorkflowStartDelaySeconds                   = serviceerror.NewInvalidArgument("An invalid WorkflowStartDelaySeconds is set on request.")
 	errRaceConditionAddingSearchAttributes                = serviceerror.NewUnavailable("Generated search attributes mapping unavailble.")
@@ -96,6 +111,7 @@ var (
 	errSearchAttributeIsReservedMessage               = "Search attribute %s is reserved by system."
 	errSearchAttributeAlreadyExistsMessage            = "Search attribute %s already exists."
 	errSearchAttributeDoesntExistMessage              = "Search attribute %s doesn't exist."
+	errSearchAttributeAlreadyAliasedMessage           = "Search attribute %s already has an alias in the namespace."
 	errUnknownSearchAttributeTypeMessage              = "Unknown search attribute type: %v."
 	errUnableToGetSearchAttributesMessage             = "Unable to get search attributes: %v."
 	errUnableToRemoveNonCustomSearchAttributesMessage = "Unable to remove non-custom search attributes: %v."
@@ -118,9 +134,7 @@ var (
 	errBatchOpsWorkflowFiltersNotAllowed = serviceerror.NewInvalidArgument("Workflow executions and visibility filter are both set on request. Only one of them is allowed.")
 	errBatchOpsMaxWorkflowExecutionCount = serviceerror.NewInvalidArgument("Workflow executions count exceeded.")
 
-	errUpdateWorkflowExecutionAPINotAllowed           = serviceerror.NewPermissionDenied("UpdateWorkflowExecution operation is disabled on this namespace.", "")
-	errUpdateWorkflowExecutionAsyncAcceptedNotAllowed = serviceerror.NewPermissionDenied("UpdateWorkflowExecution issued asynchronously and waiting on update accepted is disabled on this namespace", "")
 	errUpdateWorkflowExecutionAsyncAdmittedNotAllowed = serviceerror.NewPermissionDenied("UpdateWorkflowExecution issued asynchronously and waiting on update admitted is disabled on this namespace", "")
 
-	errWorkerVersioningNotAllowed = serviceerror.NewPermissionDenied("Worker versioning is disabled on this namespace.", "")
+	errDatabaseBackupNotSupported = serviceerror.NewUnimplemented("Online database backup is only supported by the SQLite persistence store.")
 )
@@ -39,10 +39,13 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/audit"
 	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/claimcheck"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/drain"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -51,6 +54,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	persistenceEncryption "go.temporal.io/server/common/persistence/encryption"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -82,13 +86,22 @@ var Module = fx.Options(
 	fx.Provide(NamespaceCountLimitInterceptorProvider),
 	fx.Provide(NamespaceValidatorInterceptorProvider),
 	fx.Provide(NamespaceRateLimitInterceptorProvider),
+	fx.Provide(ExecutionRateLimitInterceptorProvider),
 	fx.Provide(SDKVersionInterceptorProvider),
+	fx.Provide(SDKUsageInterceptorProvider),
+	fx.Provide(NamespaceActionTrackerProvider),
 	fx.Provide(CallerInfoInterceptorProvider),
+	fx.Provide(DrainInterceptorProvider),
+	fx.Provide(AuditInterceptorProvider),
+	fx.Provide(PayloadCodecInterceptorProvider),
+	fx.Provide(IPFilterInterceptorProvider),
+	fx.Provide(SlowRequestLogInterceptorProvider),
 	fx.Provide(GrpcServerOptionsProvider),
 	fx.Provide(VisibilityManagerProvider),
 	fx.Provide(ThrottledLoggerRpsFnProvider),
 	fx.Provide(PersistenceRateLimitingParamsProvider),
 	fx.Provide(FEReplicatorNamespaceReplicationQueueProvider),
+	fx.Provide(persistenceClient.ArchivalDLQProvider),
 	fx.Provide(func(so []grpc.ServerOption) *grpc.Server { return grpc.NewServer(so...) }),
 	fx.Provide(HandlerProvider),
 	fx.Provide(AdminHandlerProvider),
@@ -97,6 +110,8 @@ var Module = fx.Options(
 	fx.Provide(ServiceResolverProvider),
 	fx.Provide(NewServiceProvider),
 	fx.Invoke(ServiceLifetimeHooks),
+	fx.Provide(DrainerProvider),
+	fx.Invoke(resource.DrainWatcherLifetimeHooks),
 )
 
 func NewServiceProvider(
@@ -108,6 +123,8 @@ func NewServiceProvider(
 	operatorHandler *OperatorHandlerImpl,
 	versionChecker *VersionChecker,
 	visibilityMgr manager.VisibilityManager,
+	drainInterceptor *interceptor.DrainInterceptor,
+	membershipMonitor membership.Monitor,
 	logger log.SnTaggedLogger,
 	grpcListener net.Listener,
 	metricsHandler metrics.Handler,
@@ -122,6 +139,8 @@ func NewServiceProvider(
 		operatorHandler,
 		versionChecker,
 		visibilityMgr,
+		drainInterceptor,
+		membershipMonitor,
 		logger,
 		grpcListener,
 		metricsHandler,
@@ -136,6 +155,7 @@ func GrpcServerOptionsProvider(
 	rpcFactory common.RPCFactory,
 	namespaceLogInterceptor *interceptor.NamespaceLogInterceptor,
 	namespaceRateLimiterInterceptor *interceptor.NamespaceRateLimitInterceptor,
+	executionRateLimiterInterceptor *interceptor.ExecutionRateLimitInterceptor,
 	namespaceCountLimiterInterceptor *interceptor.NamespaceCountLimitInterceptor,
 	namespaceValidatorInterceptor *interceptor.NamespaceValidatorInterceptor,
 	redirectionInterceptor *RedirectionInterceptor,
@@ -144,12 +164,21 @@ func GrpcServerOptionsProvider(
 	rateLimitInterceptor *interceptor.RateLimitInterceptor,
 	traceInterceptor telemetry.ServerTraceInterceptor,
 	sdkVersionInterceptor *interceptor.SDKVersionInterceptor,
+	sdkUsageInterceptor *interceptor.SDKUsageInterceptor,
 	callerInfoInterceptor *interceptor.CallerInfoInterceptor,
+	drainInterceptor *interceptor.DrainInterceptor,
+	auditInterceptor *audit.Interceptor,
+	payloadCodecInterceptor *PayloadCodecInterceptor,
+	ipFilterInterceptor *interceptor.IPFilterInterceptor,
+	slowRequestLogInterceptor *interceptor.SlowRequestLogInterceptor,
 	authorizer authorization.Authorizer,
 	claimMapper authorization.ClaimMapper,
 	audienceGetter authorization.JWTAudienceMapper,
 	customInterceptors []grpc.UnaryServerInterceptor,
 	metricsHandler metrics.Handler,
+	namespaceRegistry namespace.Registry,
+	clusterMetadataManager persistence.ClusterMetadataManager,
+	timeSource clock.TimeSource,
 ) []grpc.ServerOption {
 	kep := keepalive.EnforcementPolicy{
 		MinTime:             serviceConfig.KeepAliveMinTime(),
@@ -167,6 +196,14 @@ func GrpcServerOptionsProvider(
 	switch serviceName {
 	case primitives.FrontendService:
 		grpcServerOptions, err = rpcFactory.GetFrontendGRPCServerOptions()
+		// a nil claim mapper or authorizer disables authorization altogether, they're only wrapped when set
+		if claimMapper != nil {
+			claimMapper = authorization.NewAPIKeyClaimMapper(claimMapper, namespaceRegistry, serviceConfig.EnableAPIKeys)
+			claimMapper = authorization.NewCertificateClaimMapper(claimMapper, serviceConfig.CertificateBindings, logger)
+		}
+		if authorizer != nil {
+			authorizer = authorization.NewRBACAuthorizer(authorizer, clusterMetadataManager, timeSource, serviceConfig.EnableRBAC)
+		}
 	case primitives.InternalFrontendService:
 		grpcServerOptions, err = rpcFactory.GetInternodeGRPCServerOptions()
 	default:
@@ -182,8 +219,11 @@ func GrpcServerOptionsProvider(
 		namespaceLogInterceptor.Intercept, // TODO: Deprecate this with a outer custom interceptor
 		grpc.UnaryServerInterceptor(traceInterceptor),
 		metrics.NewServerMetricsContextInjectorInterceptor(),
+		ipFilterInterceptor.Intercept,
 		redirectionInterceptor.Intercept,
 		telemetryInterceptor.UnaryIntercept,
+		slowRequestLogInterceptor.Intercept,
+		drainInterceptor.Intercept,
 		authorization.NewAuthorizationInterceptor(
 			claimMapper,
 			authorizer,
@@ -191,11 +231,16 @@ func GrpcServerOptionsProvider(
 			logger,
 			audienceGetter,
 		),
+		authorization.NewAPIKeyRateLimitInterceptor().Intercept,
+		auditInterceptor.Intercept,
+		payloadCodecInterceptor.Intercept,
 		namespaceValidatorInterceptor.StateValidationIntercept,
 		namespaceCountLimiterInterceptor.Intercept,
 		namespaceRateLimiterInterceptor.Intercept,
+		executionRateLimiterInterceptor.Intercept,
 		rateLimitInterceptor.Intercept,
 		sdkVersionInterceptor.Intercept,
+		sdkUsageInterceptor.Intercept,
 		callerInfoInterceptor.Intercept,
 	}
 	if len(customInterceptors) > 0 {
@@ -207,6 +252,13 @@ func GrpcServerOptionsProvider(
 
 	streamInterceptor := []grpc.StreamServerInterceptor{
 		telemetryInterceptor.StreamIntercept,
+		authorization.NewAuthorizationStreamInterceptor(
+			claimMapper,
+			authorizer,
+			metricsHandler,
+			logger,
+			audienceGetter,
+		),
 	}
 
 	return append(
@@ -230,6 +282,63 @@ func ConfigProvider(
 	)
 }
 
+func AuditInterceptorProvider(
+	lc fx.Lifecycle,
+	cfg *config.Config,
+	serviceConfig *Config,
+	auditSinks []audit.Sink,
+	logger log.Logger,
+) (*audit.Interceptor, error) {
+	auditInterceptor, err := audit.NewInterceptor(&cfg.Global.Audit, auditSinks, serviceConfig.EnableAuditLog, logger)
+	if err != nil {
+		return nil, err
+	}
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			auditInterceptor.Start()
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			auditInterceptor.Stop()
+			return nil
+		},
+	})
+	return auditInterceptor, nil
+}
+
+func IPFilterInterceptorProvider(
+	serviceConfig *Config,
+	auditInterceptor *audit.Interceptor,
+	logger log.Logger,
+) *interceptor.IPFilterInterceptor {
+	return interceptor.NewIPFilterInterceptor(
+		serviceConfig.NamespaceIPFilter,
+		serviceConfig.AdminIPFilter,
+		auditInterceptor,
+		logger,
+	)
+}
+
+func SlowRequestLogInterceptorProvider(
+	serviceConfig *Config,
+	logger log.Logger,
+) *interceptor.SlowRequestLogInterceptor {
+	return interceptor.NewSlowRequestLogInterceptor(
+		serviceConfig.SlowRequestLogThresholds,
+		serviceConfig.SlowRequestSummarySampleRate,
+		serviceConfig.SlowRequestSummaryMaxSize,
+		configs.LongPollAPIs,
+		logger,
+	)
+}
+
+func PayloadCodecInterceptorProvider(
+	serviceConfig *Config,
+	logger log.Logger,
+) *PayloadCodecInterceptor {
+	return NewPayloadCodecInterceptor(serviceConfig, logger)
+}
+
 func ThrottledLoggerRpsFnProvider(serviceConfig *Config) resource.ThrottledLoggerRpsFn {
 	return func() float64 { return float64(serviceConfig.ThrottledLogRPS()) }
 }
@@ -276,26 +385,52 @@ func TelemetryInterceptorProvider(
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 	namespaceRegistry namespace.Registry,
+	actionTracker *interceptor.NamespaceActionTracker,
 ) *interceptor.TelemetryInterceptor {
 	return interceptor.NewTelemetryInterceptor(
 		namespaceRegistry,
 		metricsHandler,
 		logger,
+		actionTracker,
 	)
 }
 
+func NamespaceActionTrackerProvider(
+	timeSource clock.TimeSource,
+) *interceptor.NamespaceActionTracker {
+	return interceptor.NewNamespaceActionTracker(timeSource)
+}
+
 func RateLimitInterceptorProvider(
 	serviceConfig *Config,
+	dc *dynamicconfig.Collection,
 ) *interceptor.RateLimitInterceptor {
 	rateFn := func() float64 { return float64(serviceConfig.RPS()) }
 	namespaceReplicationInducingRateFn := func() float64 { return float64(serviceConfig.NamespaceReplicationInducingAPIsRPS()) }
 
+	rateLimiters := []*quotas.DynamicRateLimiterImpl{
+		quotas.NewDefaultIncomingRateLimiter(rateFn),
+		quotas.NewDefaultIncomingRateLimiter(rateFn),
+		quotas.NewDefaultIncomingRateLimiter(namespaceReplicationInducingRateFn),
+		quotas.NewDefaultIncomingRateLimiter(rateFn),
+	}
+	// apply rate changes immediately, e.g. when throttling during an incident
+	dc.Subscribe(
+		func() {
+			for _, rateLimiter := range rateLimiters {
+				rateLimiter.Refresh()
+			}
+		},
+		dynamicconfig.FrontendRPS,
+		dynamicconfig.FrontendNamespaceReplicationInducingAPIsRPS,
+	)
+
 	return interceptor.NewRateLimitInterceptor(
 		configs.NewRequestToRateLimiter(
-			quotas.NewDefaultIncomingRateLimiter(rateFn),
-			quotas.NewDefaultIncomingRateLimiter(rateFn),
-			quotas.NewDefaultIncomingRateLimiter(namespaceReplicationInducingRateFn),
-			quotas.NewDefaultIncomingRateLimiter(rateFn),
+			rateLimiters[0],
+			rateLimiters[1],
+			rateLimiters[2],
+			rateLimiters[3],
 		),
 		map[string]int{},
 	)
@@ -361,6 +496,37 @@ func NamespaceRateLimitInterceptorProvider(
 	return interceptor.NewNamespaceRateLimitInterceptor(namespaceRegistry, namespaceRateLimiter, map[string]int{})
 }
 
+func ExecutionRateLimitInterceptorProvider(
+	serviceConfig *Config,
+	namespaceRegistry namespace.Registry,
+	frontendServiceResolver membership.ServiceResolver,
+	metricsHandler metrics.Handler,
+) *interceptor.ExecutionRateLimitInterceptor {
+	rateFn := func(
+		perInstanceRPSFn dynamicconfig.IntPropertyFnWithNamespaceFilter,
+		globalRPSFn dynamicconfig.IntPropertyFnWithNamespaceFilter,
+	) interceptor.ExecutionRateFn {
+		return func(namespace string) float64 {
+			return namespaceRPS(perInstanceRPSFn, globalRPSFn, frontendServiceResolver, namespace)
+		}
+	}
+	return interceptor.NewExecutionRateLimitInterceptor(
+		namespaceRegistry,
+		metricsHandler,
+		map[interceptor.ExecutionRateLimitOperation]interceptor.ExecutionRateFn{
+			interceptor.ExecutionRateLimitOperationSignal: rateFn(serviceConfig.MaxNamespaceSignalRPSPerInstance, serviceConfig.GlobalNamespaceSignalRPS),
+			interceptor.ExecutionRateLimitOperationQuery:  rateFn(serviceConfig.MaxNamespaceQueryRPSPerInstance, serviceConfig.GlobalNamespaceQueryRPS),
+			interceptor.ExecutionRateLimitOperationUpdate: rateFn(serviceConfig.MaxNamespaceUpdateRPSPerInstance, serviceConfig.GlobalNamespaceUpdateRPS),
+		},
+		map[interceptor.ExecutionRateLimitOperation]interceptor.ExecutionRateFn{
+			interceptor.ExecutionRateLimitOperationSignal: rateFn(serviceConfig.MaxExecutionSignalRPSPerInstance, serviceConfig.GlobalExecutionSignalRPS),
+			interceptor.ExecutionRateLimitOperationQuery:  rateFn(serviceConfig.MaxExecutionQueryRPSPerInstance, serviceConfig.GlobalExecutionQueryRPS),
+			interceptor.ExecutionRateLimitOperationUpdate: rateFn(serviceConfig.MaxExecutionUpdateRPSPerInstance, serviceConfig.GlobalExecutionUpdateRPS),
+		},
+		serviceConfig.ExecutionRateLimiterCacheSize(),
+	)
+}
+
 func NamespaceCountLimitInterceptorProvider(
 	serviceConfig *Config,
 	namespaceRegistry namespace.Registry,
@@ -389,12 +555,28 @@ func SDKVersionInterceptorProvider() *interceptor.SDKVersionInterceptor {
 	return interceptor.NewSDKVersionInterceptor()
 }
 
+func SDKUsageInterceptorProvider(
+	serviceConfig *Config,
+	namespaceRegistry namespace.Registry,
+	logger log.Logger,
+) *interceptor.SDKUsageInterceptor {
+	return interceptor.NewSDKUsageInterceptor(
+		namespaceRegistry,
+		logger,
+		serviceConfig.DeprecatedSDKVersions,
+	)
+}
+
 func CallerInfoInterceptorProvider(
 	namespaceRegistry namespace.Registry,
 ) *interceptor.CallerInfoInterceptor {
 	return interceptor.NewCallerInfoInterceptor(namespaceRegistry)
 }
 
+func DrainInterceptorProvider() *interceptor.DrainInterceptor {
+	return interceptor.NewDrainInterceptor(configs.LongPollAPIs)
+}
+
 func PersistenceRateLimitingParamsProvider(
 	serviceConfig *Config,
 ) service.PersistenceRateLimitingParams {
@@ -416,7 +598,9 @@ func VisibilityManagerProvider(
 	esClient esclient.Client,
 	persistenceServiceResolver resolver.ServiceResolver,
 	searchAttributesMapperProvider searchattribute.MapperProvider,
+	persistenceKeyProvider persistenceEncryption.KeyProvider,
 	saProvider searchattribute.Provider,
+	circuitBreakers *persistence.CircuitBreakers,
 ) (manager.VisibilityManager, error) {
 	return visibility.NewManager(
 		*persistenceConfig,
@@ -425,12 +609,15 @@ func VisibilityManagerProvider(
 		nil, // frontend visibility never write
 		saProvider,
 		searchAttributesMapperProvider,
+		persistenceKeyProvider,
 		serviceConfig.VisibilityPersistenceMaxReadQPS,
 		serviceConfig.VisibilityPersistenceMaxWriteQPS,
 		serviceConfig.EnableReadFromSecondaryVisibility,
 		dynamicconfig.GetStringPropertyFn(visibility.SecondaryVisibilityWritingModeOff), // frontend visibility never write
 		serviceConfig.VisibilityDisableOrderByClause,
 		serviceConfig.VisibilityEnableManualPagination,
+		circuitBreakers,
+		nil, // frontend visibility never write
 		metricsHandler,
 		logger,
 	)
@@ -481,6 +668,16 @@ func AdminHandlerProvider(
 	healthServer *health.Server,
 	eventSerializer serialization.Serializer,
 	timeSource clock.TimeSource,
+	circuitBreakers *persistence.CircuitBreakers,
+	shardManager persistence.ShardManager,
+	namespaceUsage *persistence.NamespaceUsageTracker,
+	archivalDLQ persistence.ArchivalDLQ,
+	dynamicConfigClient dynamicconfig.Client,
+	cfg *config.Config,
+	matchingClient resource.MatchingClient,
+	operatorHandler *OperatorHandlerImpl,
+	namespaceActions *interceptor.NamespaceActionTracker,
+	sdkUsage *interceptor.SDKUsageInterceptor,
 ) *AdminHandler {
 	args := NewAdminHandlerArgs{
 		persistenceConfig,
@@ -509,6 +706,16 @@ func AdminHandlerProvider(
 		healthServer,
 		eventSerializer,
 		timeSource,
+		circuitBreakers,
+		shardManager,
+		namespaceUsage,
+		archivalDLQ,
+		dynamicConfigClient,
+		cfg.Global.Metrics,
+		matchingClient,
+		operatorHandler,
+		namespaceActions,
+		sdkUsage,
 	}
 	return NewAdminHandler(args)
 }
@@ -527,6 +734,14 @@ func OperatorHandlerProvider(
 	clusterMetadataManager persistence.ClusterMetadataManager,
 	clusterMetadata cluster.Metadata,
 	clientFactory client.Factory,
+	namespaceRegistry namespace.Registry,
+	metadataManager persistence.MetadataManager,
+	dynamicConfigClient dynamicconfig.Client,
+	healthSignals persistence.HealthSignalAggregator,
+	circuitBreakers *persistence.CircuitBreakers,
+	membershipMonitor membership.Monitor,
+	archivalMetadata archiver.ArchivalMetadata,
+	archiverProvider provider.ArchiverProvider,
 ) *OperatorHandlerImpl {
 	args := NewOperatorHandlerImplArgs{
 		configuration,
@@ -542,6 +757,14 @@ func OperatorHandlerProvider(
 		clusterMetadataManager,
 		clusterMetadata,
 		clientFactory,
+		namespaceRegistry,
+		metadataManager,
+		dynamicConfigClient,
+		healthSignals,
+		circuitBreakers,
+		membershipMonitor,
+		archivalMetadata,
+		archiverProvider,
 	}
 	return NewOperatorHandlerImpl(args)
 }
@@ -571,6 +794,7 @@ func HandlerProvider(
 	archivalMetadata archiver.ArchivalMetadata,
 	healthServer *health.Server,
 	membershipMonitor membership.Monitor,
+	payloadStore claimcheck.PayloadStore,
 ) Handler {
 	wfHandler := NewWorkflowHandler(
 		serviceConfig,
@@ -593,10 +817,16 @@ func HandlerProvider(
 		healthServer,
 		timeSource,
 		membershipMonitor,
+		payloadStore,
 	)
 	return wfHandler
 }
 
+// DrainerProvider drains the host when the drain document of the system namespace targets it
+func DrainerProvider(svc *Service) drain.Drainer {
+	return svc
+}
+
 func ServiceLifetimeHooks(
 	lc fx.Lifecycle,
 	svcStoppedCh chan struct{},
@@ -0,0 +1,138 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/service/worker/deletenamespace/deleteexecutions"
+)
+
+// DescribeNamespaceDeletion returns the progress of executions deletion for a namespace being deleted.
+// deletedNamespace is the name returned by DeleteNamespace, i.e. the name the namespace was renamed to.
+func (h *OperatorHandlerImpl) DescribeNamespaceDeletion(
+	ctx context.Context,
+	deletedNamespace string,
+) (_ *deleteexecutions.DeleteExecutionsProgress, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	scope, startTime := h.startRequestProfile(metrics.OperatorDescribeNamespaceDeletionScope)
+	defer func() { scope.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime)) }()
+
+	if deletedNamespace == "" {
+		return nil, errNamespaceNotSet
+	}
+
+	sdkClient := h.sdkClientFactory.GetSystemClient()
+	encodedProgress, err := sdkClient.QueryWorkflow(ctx, deleteExecutionsWorkflowID(deletedNamespace), "", deleteexecutions.QueryNameProgress)
+	if err != nil {
+		return nil, err
+	}
+	var progress deleteexecutions.DeleteExecutionsProgress
+	if err := encodedProgress.Get(&progress); err != nil {
+		return nil, err
+	}
+	return &progress, nil
+}
+
+// UpdateNamespaceDeletionRate adjusts rate limits of executions deletion for a namespace being deleted.
+// New limits take effect for pages of executions which are not being deleted yet.
+func (h *OperatorHandlerImpl) UpdateNamespaceDeletionRate(
+	ctx context.Context,
+	deletedNamespace string,
+	update deleteexecutions.DeleteExecutionsRateUpdate,
+) (retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	scope, startTime := h.startRequestProfile(metrics.OperatorUpdateNamespaceDeletionRateScope)
+	defer func() { scope.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime)) }()
+
+	if deletedNamespace == "" {
+		return errNamespaceNotSet
+	}
+	if update.DeleteActivityRPS < 0 || update.ConcurrentDeleteExecutionsActivities < 0 ||
+		(update.DeleteActivityRPS == 0 && update.ConcurrentDeleteExecutionsActivities == 0) {
+		return errInvalidDeletionRateUpdate
+	}
+
+	sdkClient := h.sdkClientFactory.GetSystemClient()
+	return sdkClient.SignalWorkflow(ctx, deleteExecutionsWorkflowID(deletedNamespace), "", deleteexecutions.SignalNameUpdateRate, update)
+}
+
+// DescribeNamespaceDeletion serves OperatorHandlerImpl.DescribeNamespaceDeletion, which operatorservice doesn't define.
+func (adh *AdminHandler) DescribeNamespaceDeletion(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceDeletionRequest,
+) (_ *adminservice.DescribeNamespaceDeletionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	progress, err := adh.operatorHandler.DescribeNamespaceDeletion(ctx, request.GetDeletedNamespace())
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeNamespaceDeletionResponse{
+		SuccessCount:                         int64(progress.SuccessCount),
+		ErrorCount:                           int64(progress.ErrorCount),
+		RemainingEstimate:                    int64(progress.RemainingEstimate),
+		DeleteActivityRps:                    int32(progress.DeleteActivityRPS),
+		ConcurrentDeleteExecutionsActivities: int32(progress.ConcurrentDeleteExecutionsActivities),
+		CurrentRps:                           progress.CurrentRPS,
+		ContinueAsNewCount:                   int32(progress.ContinueAsNewCount),
+	}, nil
+}
+
+// UpdateNamespaceDeletionRate serves OperatorHandlerImpl.UpdateNamespaceDeletionRate, which operatorservice
+// doesn't define.
+func (adh *AdminHandler) UpdateNamespaceDeletionRate(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceDeletionRateRequest,
+) (_ *adminservice.UpdateNamespaceDeletionRateResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	err := adh.operatorHandler.UpdateNamespaceDeletionRate(ctx, request.GetDeletedNamespace(), deleteexecutions.DeleteExecutionsRateUpdate{
+		DeleteActivityRPS:                    int(request.GetDeleteActivityRps()),
+		ConcurrentDeleteExecutionsActivities: int(request.GetConcurrentDeleteExecutionsActivities()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateNamespaceDeletionRateResponse{}, nil
+}
+
+// deleteExecutionsWorkflowID must match the ID ReclaimResourcesWorkflow starts DeleteExecutionsWorkflow with.
+func deleteExecutionsWorkflowID(deletedNamespace string) string {
+	return fmt.Sprintf("%s/%s", deleteexecutions.WorkflowName, deletedNamespace)
+}
@@ -0,0 +1,112 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	sdkclient "go.temporal.io/sdk/client"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/service/worker"
+	"go.temporal.io/server/service/worker/namespaceexport"
+)
+
+// StartNamespaceExport starts the system workflow exporting every execution of a namespace to params.ExportURI
+// and returns its run ID without waiting for the export to complete. An interrupted export is resumed by
+// setting NextPageToken and ExportedCount of params from the checkpoint it left under the export URI.
+func (h *OperatorHandlerImpl) StartNamespaceExport(
+	ctx context.Context,
+	params namespaceexport.NamespaceExportParams,
+) (_ string, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	scope, startTime := h.startRequestProfile(metrics.OperatorStartNamespaceExportScope)
+	defer func() { scope.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime)) }()
+
+	if params.Namespace.IsEmpty() {
+		return "", errNamespaceNotSet
+	}
+	if params.Namespace.String() == primitives.SystemLocalNamespace {
+		return "", serviceerror.NewInvalidArgument("Unable to export system namespace.")
+	}
+	if params.ExportURI == "" {
+		return "", errExportURINotSet
+	}
+	if _, err := archiver.NewURI(params.ExportURI); err != nil {
+		return "", serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid ExportURI: %v.", err))
+	}
+
+	nsID, err := h.namespaceRegistry.GetNamespaceID(params.Namespace)
+	if err != nil {
+		return "", err
+	}
+	params.NamespaceID = nsID
+
+	sdkClient := h.sdkClientFactory.GetSystemClient()
+	run, err := sdkClient.ExecuteWorkflow(
+		ctx,
+		sdkclient.StartWorkflowOptions{
+			TaskQueue: worker.DefaultWorkerTaskQueue,
+			ID:        fmt.Sprintf("%s/%s", namespaceexport.WorkflowName, params.Namespace),
+		},
+		namespaceexport.WorkflowName,
+		params,
+	)
+	if err != nil {
+		return "", serviceerror.NewUnavailable(fmt.Sprintf(errUnableToStartWorkflowMessage, namespaceexport.WorkflowName, err))
+	}
+	return run.GetRunID(), nil
+}
+
+// StartNamespaceExport serves OperatorHandlerImpl.StartNamespaceExport, which operatorservice doesn't define.
+func (adh *AdminHandler) StartNamespaceExport(
+	ctx context.Context,
+	request *adminservice.StartNamespaceExportRequest,
+) (_ *adminservice.StartNamespaceExportResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	runID, err := adh.operatorHandler.StartNamespaceExport(ctx, namespaceexport.NamespaceExportParams{
+		Namespace:     namespace.Name(request.GetNamespace()),
+		ExportURI:     request.GetExportUri(),
+		NextPageToken: request.GetNextPageToken(),
+		ExportedCount: int(request.GetExportedCount()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.StartNamespaceExportResponse{RunId: runID}, nil
+}
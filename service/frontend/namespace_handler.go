@@ -43,6 +43,7 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -107,7 +108,11 @@ var (
 	errNotMasterCluster                   = serviceerror.NewInvalidArgument("Cluster is not master cluster, cannot do namespace registration or namespace update.")
 	errCannotDoNamespaceFailoverAndUpdate = serviceerror.NewInvalidArgument("Cannot set active cluster to current cluster when other parameters are set.")
 	errInvalidRetentionPeriod             = serviceerror.NewInvalidArgument("A valid retention period is not set on request.")
+	errInvalidHistoryArchiveRetention     = serviceerror.NewInvalidArgument("A valid history archive retention period is not set on request.")
+	errInvalidHistoryArchiveExpiryAction  = serviceerror.NewInvalidArgument("A valid history archive expiry action is not set on request.")
+	errInvalidArchivalMode                = serviceerror.NewInvalidArgument("A valid archival mode is not set on request.")
 	errInvalidNamespaceStateUpdate        = serviceerror.NewInvalidArgument("Invalid namespace state update.")
+	errReservedNamespaceData              = serviceerror.NewInvalidArgument("Namespace data contains a key reserved for the server.")
 
 	errCustomSearchAttributeFieldAlreadyAllocated = serviceerror.NewInvalidArgument("Custom search attribute field name already allocated.")
 )
@@ -163,6 +168,15 @@ func (d *namespaceHandlerImpl) RegisterNamespace(
 	); err != nil {
 		return nil, err
 	}
+	if err := validateHistoryArchiveRetention(registerRequest.Data); err != nil {
+		return nil, err
+	}
+	if err := validateArchivalMode(registerRequest.Data); err != nil {
+		return nil, err
+	}
+	if err := validateReservedData(registerRequest.Data); err != nil {
+		return nil, err
+	}
 
 	// first check if the name is already registered as the local namespace
 	_, err := d.metadataMgr.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: registerRequest.GetNamespace()})
@@ -472,6 +486,15 @@ func (d *namespaceHandlerImpl) UpdateNamespace(
 		}
 		if updatedInfo.Data != nil {
 			configurationChanged = true
+			if err := validateHistoryArchiveRetention(updatedInfo.Data); err != nil {
+				return nil, err
+			}
+			if err := validateArchivalMode(updatedInfo.Data); err != nil {
+				return nil, err
+			}
+			if err := validateReservedData(updatedInfo.Data); err != nil {
+				return nil, err
+			}
 			// only do merging
 			info.Data = d.mergeNamespaceData(info.Data, updatedInfo.Data)
 		}
@@ -718,7 +741,7 @@ func (d *namespaceHandlerImpl) createResponse(
 		State:       info.State,
 		Description: info.Description,
 		OwnerEmail:  info.Owner,
-		Data:        info.Data,
+		Data:        filterSecretData(info.Data),
 		Id:          info.Id,
 
 		SupportsSchedules: d.supportsSchedules(info.Name),
@@ -743,6 +766,7 @@ func (d *namespaceHandlerImpl) createResponse(
 	replicationConfigResult := &replicationpb.NamespaceReplicationConfig{
 		ActiveClusterName: replicationConfig.ActiveClusterName,
 		Clusters:          clusters,
+		State:             replicationConfig.State,
 	}
 
 	var failoverHistory []*replicationpb.FailoverStatus
@@ -920,6 +944,74 @@ func validateRetentionDuration(retention time.Duration, isGlobalNamespace bool)
 	return nil
 }
 
+// validateHistoryArchiveRetention ensures that the archived history retention and expiry action set in namespace
+// data, if any, are valid.
+func validateHistoryArchiveRetention(data map[string]string) error {
+	if value, ok := data[namespace.HistoryArchiveRetentionDataKey]; ok {
+		retention, err := namespace.ParseHistoryArchiveRetention(value)
+		if err != nil || retention <= 0 {
+			return errInvalidHistoryArchiveRetention
+		}
+	}
+	if value, ok := data[namespace.HistoryArchiveExpiryActionDataKey]; ok {
+		if value != namespace.HistoryArchiveExpiryActionDelete && value != namespace.HistoryArchiveExpiryActionTransition {
+			return errInvalidHistoryArchiveExpiryAction
+		}
+	}
+	return nil
+}
+
+// validateArchivalMode ensures that the archival mode set in namespace data, if any, is valid.
+func validateArchivalMode(data map[string]string) error {
+	value, ok := data[namespace.ArchivalModeDataKey]
+	if !ok {
+		return nil
+	}
+	if value != namespace.ArchivalModeAsync && value != namespace.ArchivalModeSync {
+		return errInvalidArchivalMode
+	}
+	return nil
+}
+
+// validateReservedData ensures that namespace data doesn't set any key the server manages itself.
+func validateReservedData(data map[string]string) error {
+	for key := range data {
+		if isReservedDataKey(key) {
+			return errReservedNamespaceData
+		}
+	}
+	return nil
+}
+
+// filterSecretData returns the namespace data without the keys holding credentials and access bindings, so that
+// they are never returned by the namespace APIs.
+func filterSecretData(data map[string]string) map[string]string {
+	for key := range data {
+		if isSecretDataKey(key) {
+			filtered := make(map[string]string, len(data))
+			for k, v := range data {
+				if !isSecretDataKey(k) {
+					filtered[k] = v
+				}
+			}
+			return filtered
+		}
+	}
+	return data
+}
+
+// isReservedDataKey reports whether a namespace data key is managed by the server and can't be written through the
+// namespace APIs.
+func isReservedDataKey(key string) bool {
+	return isSecretDataKey(key) || namespace.IsStorageUsageDataKey(key)
+}
+
+// isSecretDataKey reports whether a namespace data key is managed by the server and can't be read through the
+// namespace APIs either.
+func isSecretDataKey(key string) bool {
+	return authorization.IsAPIKeyDataKey(key)
+}
+
 func validateReplicationStateUpdate(existingNamespace *persistence.GetNamespaceResponse, nsUpdateRequest *workflowservice.UpdateNamespaceRequest) error {
 	if nsUpdateRequest.ReplicationConfig == nil ||
 		nsUpdateRequest.ReplicationConfig.State == enumspb.REPLICATION_STATE_UNSPECIFIED ||
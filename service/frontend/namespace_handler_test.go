@@ -47,6 +47,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
@@ -383,6 +384,46 @@ func (s *namespaceHandlerCommonSuite) TestListNamespace() {
 	}
 }
 
+func (s *namespaceHandlerCommonSuite) TestDescribeNamespace_ReplicationStatus() {
+	namespace := "global-ns-in-handover"
+	failoverTime := time.Date(2011, 12, 27, 23, 44, 55, 999999, time.UTC)
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		Name: namespace,
+	}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:    uuid.New(),
+				Name:  namespace,
+				State: enumspb.NAMESPACE_STATE_REGISTERED,
+			},
+			Config: &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+				ActiveClusterName: "cluster1",
+				Clusters:          []string{"cluster1", "cluster2"},
+				State:             enumspb.REPLICATION_STATE_HANDOVER,
+				FailoverHistory: []*persistencespb.FailoverStatus{
+					{
+						FailoverTime:    timestamp.TimePtr(failoverTime),
+						FailoverVersion: 2,
+					},
+				},
+			},
+			FailoverVersion: 2,
+		},
+		IsGlobalNamespace: true,
+	}, nil)
+
+	resp, err := s.handler.DescribeNamespace(context.Background(), &workflowservice.DescribeNamespaceRequest{
+		Namespace: namespace,
+	})
+	s.NoError(err)
+	s.Equal(enumspb.REPLICATION_STATE_HANDOVER, resp.GetReplicationConfig().GetState())
+	s.Equal("cluster1", resp.GetReplicationConfig().GetActiveClusterName())
+	s.Len(resp.GetFailoverHistory(), 1)
+	s.Equal(failoverTime, *resp.GetFailoverHistory()[0].GetFailoverTime())
+	s.Equal(int64(2), resp.GetFailoverHistory()[0].GetFailoverVersion())
+}
+
 func (s *namespaceHandlerCommonSuite) TestRegisterNamespace() {
 	const namespace = "namespace-to-register"
 	clusterName := "cluster1"
@@ -498,6 +539,121 @@ func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_InvalidRetentionPeriod
 	}
 }
 
+func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_InvalidHistoryArchiveRetention() {
+	nsName := uuid.New()
+	version := int64(1)
+	s.mockMetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{
+		NotificationVersion: version,
+	}, nil).AnyTimes()
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   uuid.New(),
+				Name: nsName,
+			},
+			Config:            &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+		},
+	}, nil).AnyTimes()
+	for _, tc := range []struct {
+		data map[string]string
+		err  error
+	}{
+		{map[string]string{namespace.HistoryArchiveRetentionDataKey: "invalid"}, errInvalidHistoryArchiveRetention},
+		{map[string]string{namespace.HistoryArchiveRetentionDataKey: "0"}, errInvalidHistoryArchiveRetention},
+		{map[string]string{namespace.HistoryArchiveExpiryActionDataKey: "archive"}, errInvalidHistoryArchiveExpiryAction},
+		{map[string]string{namespace.ArchivalModeDataKey: "inline"}, errInvalidArchivalMode},
+	} {
+		updateRequest := &workflowservice.UpdateNamespaceRequest{
+			Namespace: nsName,
+			UpdateInfo: &namespacepb.UpdateNamespaceInfo{
+				Data: tc.data,
+			},
+		}
+		resp, err := s.handler.UpdateNamespace(context.Background(), updateRequest)
+		s.Equal(tc.err, err)
+		s.Nil(resp)
+	}
+}
+
+func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_ReservedData() {
+	nsName := uuid.New()
+	s.mockMetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{
+		NotificationVersion: 1,
+	}, nil).AnyTimes()
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   uuid.New(),
+				Name: nsName,
+			},
+			Config:            &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+		},
+	}, nil).AnyTimes()
+	for _, key := range []string{
+		authorization.APIKeyDataKey("key-id"),
+		namespace.StorageUsageDataKey(namespace.StorageTypeHistory),
+	} {
+		resp, err := s.handler.UpdateNamespace(context.Background(), &workflowservice.UpdateNamespaceRequest{
+			Namespace: nsName,
+			UpdateInfo: &namespacepb.UpdateNamespaceInfo{
+				Data: map[string]string{key: "value"},
+			},
+		})
+		s.Equal(errReservedNamespaceData, err)
+		s.Nil(resp)
+	}
+}
+
+func (s *namespaceHandlerCommonSuite) TestRegisterNamespace_ReservedData() {
+	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(false).AnyTimes()
+	for _, key := range []string{
+		authorization.APIKeyDataKey("key-id"),
+		namespace.StorageUsageDataKey(namespace.StorageTypeHistory),
+	} {
+		resp, err := s.handler.RegisterNamespace(context.Background(), &workflowservice.RegisterNamespaceRequest{
+			Namespace:                        "random namespace name",
+			WorkflowExecutionRetentionPeriod: timestamp.DurationPtr(24 * time.Hour),
+			Data:                             map[string]string{key: "value"},
+		})
+		s.Equal(errReservedNamespaceData, err)
+		s.Nil(resp)
+	}
+}
+
+func (s *namespaceHandlerCommonSuite) TestDescribeNamespace_ReservedData() {
+	nsName := "namespace-with-reserved-data"
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		Name: nsName,
+	}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:    uuid.New(),
+				Name:  nsName,
+				State: enumspb.NAMESPACE_STATE_REGISTERED,
+				Data: map[string]string{
+					"k1":                                  "v1",
+					authorization.APIKeyDataKey("key-id"): "hash",
+					namespace.StorageUsageDataKey(namespace.StorageTypeHistory): "100",
+				},
+			},
+			Config:            &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+		},
+	}, nil)
+
+	resp, err := s.handler.DescribeNamespace(context.Background(), &workflowservice.DescribeNamespaceRequest{
+		Namespace: nsName,
+	})
+	s.NoError(err)
+	// storage usage can't be written but is still returned
+	s.Equal(map[string]string{
+		"k1": "v1",
+		namespace.StorageUsageDataKey(namespace.StorageTypeHistory): "100",
+	}, resp.GetNamespaceInfo().GetData())
+}
+
 func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_PromoteLocalNamespace() {
 	namespace := "local-ns-to-be-promoted"
 	clusterName := "cluster1"
@@ -49,8 +49,12 @@ import (
 	"go.temporal.io/server/client/admin"
 	"go.temporal.io/server/client/frontend"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
 	clustermetadata "go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
@@ -87,6 +91,17 @@ type (
 		clusterMetadataManager persistence.ClusterMetadataManager
 		clusterMetadata        clustermetadata.Metadata
 		clientFactory          svc.Factory
+
+		namespaceRegistry namespace.Registry
+		metadataManager   persistence.MetadataManager
+
+		dynamicConfigClient dynamicconfig.Client
+
+		healthSignals     persistence.HealthSignalAggregator
+		circuitBreakers   *persistence.CircuitBreakers
+		membershipMonitor membership.Monitor
+		archivalMetadata  archiver.ArchivalMetadata
+		archiverProvider  provider.ArchiverProvider
 	}
 
 	NewOperatorHandlerImplArgs struct {
@@ -103,6 +118,14 @@ type (
 		clusterMetadataManager persistence.ClusterMetadataManager
 		clusterMetadata        clustermetadata.Metadata
 		clientFactory          svc.Factory
+		namespaceRegistry      namespace.Registry
+		metadataManager        persistence.MetadataManager
+		dynamicConfigClient    dynamicconfig.Client
+		healthSignals          persistence.HealthSignalAggregator
+		circuitBreakers        *persistence.CircuitBreakers
+		membershipMonitor      membership.Monitor
+		archivalMetadata       archiver.ArchivalMetadata
+		archiverProvider       provider.ArchiverProvider
 	}
 )
 
@@ -126,6 +149,14 @@ func NewOperatorHandlerImpl(
 		clusterMetadataManager: args.clusterMetadataManager,
 		clusterMetadata:        args.clusterMetadata,
 		clientFactory:          args.clientFactory,
+		namespaceRegistry:      args.namespaceRegistry,
+		metadataManager:        args.metadataManager,
+		dynamicConfigClient:    args.dynamicConfigClient,
+		healthSignals:          args.healthSignals,
+		circuitBreakers:        args.circuitBreakers,
+		membershipMonitor:      args.membershipMonitor,
+		archivalMetadata:       args.archivalMetadata,
+		archiverProvider:       args.archiverProvider,
 	}
 
 	return handler
@@ -742,6 +773,18 @@ func (h *OperatorHandlerImpl) ListClusters(
 	}, nil
 }
 
+// ListMetrics returns the metrics the server can emit, generated from their definitions. The same catalog is
+// served over HTTP next to the Prometheus metrics.
+func (h *OperatorHandlerImpl) ListMetrics(
+	_ context.Context,
+) (_ []metrics.CatalogEntry, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	scope, startTime := h.startRequestProfile(metrics.OperatorListMetricsScope)
+	defer func() { scope.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime)) }()
+
+	return metrics.Catalog(), nil
+}
+
 func (h *OperatorHandlerImpl) validateRemoteClusterMetadata(metadata *adminservice.DescribeClusterResponse) error {
 	// Verify remote cluster config
 	currentClusterInfo := h.clusterMetadata
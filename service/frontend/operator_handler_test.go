@@ -32,6 +32,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/require"
@@ -41,20 +42,32 @@ import (
 	"go.temporal.io/api/operatorservice/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
+	sdkclient "go.temporal.io/sdk/client"
 	"go.temporal.io/server/common/primitives"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/health"
 
 	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resourcetest"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/testing/mocksdk"
 	"go.temporal.io/server/service/worker/addsearchattributes"
 	"go.temporal.io/server/service/worker/deletenamespace"
+	"go.temporal.io/server/service/worker/deletenamespace/deleteexecutions"
+	"go.temporal.io/server/service/worker/namespaceexport"
 )
 
 var (
@@ -100,6 +113,14 @@ func (s *operatorHandlerSuite) SetupTest() {
 		s.mockResource.GetClusterMetadataManager(),
 		s.mockResource.GetClusterMetadata(),
 		s.mockResource.GetClientFactory(),
+		s.mockResource.GetNamespaceRegistry(),
+		s.mockResource.GetMetadataManager(),
+		dynamicconfig.NewNoopClient(),
+		persistence.NoopHealthSignalAggregator,
+		nil,
+		s.mockResource.GetMembershipMonitor(),
+		s.mockResource.GetArchivalMetadata(),
+		s.mockResource.GetArchiverProvider(),
 	}
 	s.handler = NewOperatorHandlerImpl(args)
 	s.handler.Start()
@@ -780,6 +801,334 @@ func (s *operatorHandlerSuite) Test_DeleteNamespace() {
 	s.Equal("test-namespace-deleted-ka2te", resp.DeletedNamespace)
 }
 
+func (s *operatorHandlerSuite) Test_UpdateNamespaceDeletionRate() {
+	ctx := context.Background()
+
+	err := s.handler.UpdateNamespaceDeletionRate(ctx, "", deleteexecutions.DeleteExecutionsRateUpdate{DeleteActivityRPS: 10})
+	s.Equal(errNamespaceNotSet, err)
+	err = s.handler.UpdateNamespaceDeletionRate(ctx, "test-namespace-deleted-ka2te", deleteexecutions.DeleteExecutionsRateUpdate{})
+	s.Equal(errInvalidDeletionRateUpdate, err)
+	err = s.handler.UpdateNamespaceDeletionRate(ctx, "test-namespace-deleted-ka2te", deleteexecutions.DeleteExecutionsRateUpdate{DeleteActivityRPS: 10, ConcurrentDeleteExecutionsActivities: -1})
+	s.Equal(errInvalidDeletionRateUpdate, err)
+
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockSdkClient.EXPECT().SignalWorkflow(
+		gomock.Any(),
+		"temporal-sys-delete-executions-workflow/test-namespace-deleted-ka2te",
+		"",
+		deleteexecutions.SignalNameUpdateRate,
+		deleteexecutions.DeleteExecutionsRateUpdate{DeleteActivityRPS: 10},
+	).Return(nil)
+	err = s.handler.UpdateNamespaceDeletionRate(ctx, "test-namespace-deleted-ka2te", deleteexecutions.DeleteExecutionsRateUpdate{DeleteActivityRPS: 10})
+	s.NoError(err)
+}
+
+func (s *operatorHandlerSuite) Test_StartNamespaceExport() {
+	ctx := context.Background()
+
+	_, err := s.handler.StartNamespaceExport(ctx, namespaceexport.NamespaceExportParams{ExportURI: "s3://bucket/export"})
+	s.Equal(errNamespaceNotSet, err)
+	_, err = s.handler.StartNamespaceExport(ctx, namespaceexport.NamespaceExportParams{Namespace: "test-namespace"})
+	s.Equal(errExportURINotSet, err)
+	_, err = s.handler.StartNamespaceExport(ctx, namespaceexport.NamespaceExportParams{Namespace: "temporal-system", ExportURI: "s3://bucket/export"})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	s.mockResource.NamespaceCache.EXPECT().GetNamespaceID(namespace.Name("test-namespace")).Return(namespace.ID("test-namespace-id"), nil)
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockRun := mocksdk.NewMockWorkflowRun(s.controller)
+	mockRun.EXPECT().GetRunID().Return("run-id")
+	mockSdkClient.EXPECT().ExecuteWorkflow(
+		gomock.Any(),
+		sdkclient.StartWorkflowOptions{
+			TaskQueue: "default-worker-tq",
+			ID:        "temporal-sys-namespace-export-workflow/test-namespace",
+		},
+		"temporal-sys-namespace-export-workflow",
+		namespaceexport.NamespaceExportParams{
+			Namespace:   "test-namespace",
+			NamespaceID: "test-namespace-id",
+			ExportURI:   "s3://bucket/export",
+		},
+	).Return(mockRun, nil)
+
+	runID, err := s.handler.StartNamespaceExport(ctx, namespaceexport.NamespaceExportParams{Namespace: "test-namespace", ExportURI: "s3://bucket/export"})
+	s.NoError(err)
+	s.Equal("run-id", runID)
+}
+
+func (s *operatorHandlerSuite) Test_APIKeys() {
+	ctx := context.Background()
+
+	_, _, err := s.handler.CreateAPIKey(ctx, authorization.APIKey{})
+	s.Equal(errAPIKeyNameNotSet, err)
+	_, _, err = s.handler.CreateAPIKey(ctx, authorization.APIKey{Name: "ci", Namespaces: map[string]authorization.Role{"": authorization.RoleWriter}})
+	s.Equal(errInvalidAPIKeyRole, err)
+	_, _, err = s.handler.CreateAPIKey(ctx, authorization.APIKey{Name: "ci", RPS: -1})
+	s.Equal(errInvalidAPIKeyRPS, err)
+	err = s.handler.RevokeAPIKey(ctx, "")
+	s.Equal(errAPIKeyIDNotSet, err)
+
+	data := map[string]string{"other": "value"}
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{Name: primitives.SystemLocalNamespace}).DoAndReturn(
+		func(_ context.Context, _ *persistence.GetNamespaceRequest) (*persistence.GetNamespaceResponse, error) {
+			return &persistence.GetNamespaceResponse{
+				Namespace: &persistencespb.NamespaceDetail{Info: &persistencespb.NamespaceInfo{Name: primitives.SystemLocalNamespace, Data: data}},
+			}, nil
+		}).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			s.Equal(int64(7), request.NotificationVersion)
+			data = request.Namespace.Info.Data
+			return nil
+		}).Times(3)
+
+	key, token, err := s.handler.CreateAPIKey(ctx, authorization.APIKey{
+		Name:       "ci",
+		Namespaces: map[string]authorization.Role{"test-namespace": authorization.RoleWriter},
+		RPS:        10,
+	})
+	s.NoError(err)
+	s.NotEmpty(key.ID)
+	s.Empty(key.SecretHash)
+	s.True(strings.HasPrefix(token, authorization.APIKeyTokenPrefix+key.ID+"_"))
+	stored, err := authorization.UnmarshalAPIKey(data[authorization.APIKeyDataKey(key.ID)])
+	s.NoError(err)
+	s.NotEmpty(stored.SecretHash)
+	s.NotContains(data[authorization.APIKeyDataKey(key.ID)], token)
+	s.Equal("value", data["other"])
+
+	keys, err := s.handler.ListAPIKeys(ctx)
+	s.NoError(err)
+	s.Equal([]*authorization.APIKey{key}, keys)
+
+	rotatedToken, err := s.handler.RotateAPIKey(ctx, key.ID)
+	s.NoError(err)
+	s.NotEqual(token, rotatedToken)
+	rotated, err := authorization.UnmarshalAPIKey(data[authorization.APIKeyDataKey(key.ID)])
+	s.NoError(err)
+	s.NotEqual(stored.SecretHash, rotated.SecretHash)
+	s.False(rotated.RotateTime.IsZero())
+
+	err = s.handler.RevokeAPIKey(ctx, key.ID)
+	s.NoError(err)
+	s.NotContains(data, authorization.APIKeyDataKey(key.ID))
+
+	_, err = s.handler.RotateAPIKey(ctx, key.ID)
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
+func (s *operatorHandlerSuite) Test_RBAC() {
+	ctx := context.Background()
+
+	err := s.handler.PutRole(ctx, authorization.NamedRole{Permissions: []authorization.Permission{authorization.PermissionStart}})
+	s.Equal(errRoleNameNotSet, err)
+	err = s.handler.PutRole(ctx, authorization.NamedRole{Name: "starter", Permissions: []authorization.Permission{"write"}})
+	s.Equal(errInvalidRolePermission, err)
+	err = s.handler.SetGroupRoles(ctx, "payments", "", nil)
+	s.Equal(errGroupNotSet, err)
+
+	clusterMetadata := &persistence.GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{ClusterName: "active", HistoryShardCount: 4},
+		Version:         1,
+	}
+	s.mockResource.ClusterMetadataMgr.EXPECT().GetCurrentClusterMetadata(gomock.Any()).DoAndReturn(
+		func(_ context.Context) (*persistence.GetClusterMetadataResponse, error) {
+			resp := *clusterMetadata
+			resp.ClusterMetadata = *proto.Clone(&clusterMetadata.ClusterMetadata).(*persistencespb.ClusterMetadata)
+			return &resp, nil
+		}).AnyTimes()
+	s.mockResource.ClusterMetadataMgr.EXPECT().SaveClusterMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.SaveClusterMetadataRequest) (bool, error) {
+			if request.Version != clusterMetadata.Version {
+				return false, nil
+			}
+			clusterMetadata = &persistence.GetClusterMetadataResponse{ClusterMetadata: request.ClusterMetadata, Version: request.Version + 1}
+			return true, nil
+		}).AnyTimes()
+	s.mockResource.NamespaceCache.EXPECT().GetNamespace(namespace.Name("payments")).Return(nil, nil).AnyTimes()
+	s.mockResource.NamespaceCache.EXPECT().GetNamespace(namespace.Name("missing")).Return(nil, serviceerror.NewNamespaceNotFound("missing")).AnyTimes()
+
+	starter := authorization.NamedRole{Name: "starter", Permissions: []authorization.Permission{authorization.PermissionStart, authorization.PermissionQuery}}
+	owner := authorization.NamedRole{Name: "owner", Permissions: []authorization.Permission{authorization.PermissionAdmin}}
+	s.NoError(s.handler.PutRole(ctx, starter))
+	s.NoError(s.handler.PutRole(ctx, owner))
+	roles, err := s.handler.ListRoles(ctx)
+	s.NoError(err)
+	s.Equal([]*authorization.NamedRole{&owner, &starter}, roles)
+	s.Equal("active", clusterMetadata.ClusterName)
+	s.Equal(int32(4), clusterMetadata.HistoryShardCount)
+
+	err = s.handler.SetGroupRoles(ctx, "missing", "payments-dev", []string{"starter"})
+	s.IsType(&serviceerror.NamespaceNotFound{}, err)
+	err = s.handler.SetGroupRoles(ctx, "payments", "payments-dev", []string{"unknown"})
+	s.IsType(&serviceerror.NotFound{}, err)
+	s.NoError(s.handler.SetGroupRoles(ctx, "payments", "payments-dev", []string{"starter"}))
+	s.NoError(s.handler.SetGroupRoles(ctx, "payments", "payments-owners", []string{"owner", "starter"}))
+	groupRoles, err := s.handler.GetGroupRoles(ctx, "payments")
+	s.NoError(err)
+	s.Equal(authorization.GroupRoles{"payments-dev": {"starter"}, "payments-owners": {"owner", "starter"}}, groupRoles)
+
+	err = s.handler.DeleteRole(ctx, "starter")
+	s.IsType(&serviceerror.FailedPrecondition{}, err)
+	err = s.handler.DeleteRole(ctx, "unknown")
+	s.IsType(&serviceerror.NotFound{}, err)
+
+	s.NoError(s.handler.SetGroupRoles(ctx, "payments", "payments-dev", nil))
+	s.NoError(s.handler.SetGroupRoles(ctx, "payments", "payments-owners", []string{"owner"}))
+	s.NoError(s.handler.DeleteRole(ctx, "starter"))
+	roles, err = s.handler.ListRoles(ctx)
+	s.NoError(err)
+	s.Equal([]*authorization.NamedRole{&owner}, roles)
+
+	s.NoError(s.handler.SetGroupRoles(ctx, "payments", "payments-owners", nil))
+	s.NotContains(clusterMetadata.RoleBindings.GetNamespaces(), "payments")
+	groupRoles, err = s.handler.GetGroupRoles(ctx, "payments")
+	s.NoError(err)
+	s.Empty(groupRoles)
+
+	// an empty namespace name assigns roles cluster-wide
+	s.NoError(s.handler.SetGroupRoles(ctx, "", "operators", []string{"owner"}))
+	groupRoles, err = s.handler.GetGroupRoles(ctx, "")
+	s.NoError(err)
+	s.Equal(authorization.GroupRoles{"operators": {"owner"}}, groupRoles)
+	err = s.handler.DeleteRole(ctx, "owner")
+	s.IsType(&serviceerror.FailedPrecondition{}, err)
+	s.NoError(s.handler.SetGroupRoles(ctx, "", "operators", nil))
+	s.NoError(s.handler.DeleteRole(ctx, "owner"))
+	s.Empty(clusterMetadata.RoleBindings.GetNamespaces())
+	s.Empty(clusterMetadata.RoleBindings.GetRoles())
+}
+
+func (s *operatorHandlerSuite) Test_DynamicConfigOverrides() {
+	ctx := context.WithValue(context.Background(), authorization.MappedClaims, &authorization.Claims{Subject: "alice"})
+	key := dynamicconfig.Key(dynamicconfig.FrontendMaxNamespaceRPSPerInstance)
+	dynamicconfig.NewNoopCollection().GetIntPropertyFilteredByNamespace(key, 0)
+	nsValue := dynamicconfig.OverrideValue{Constraints: map[string]any{"namespace": "payments"}, Value: 100}
+
+	err := s.handler.SetDynamicConfigOverride(ctx, "", nsValue, "")
+	s.Equal(errDynamicConfigKeyNotSet, err)
+	err = s.handler.SetDynamicConfigOverride(ctx, "frontend.unknownKey", nsValue, "")
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	err = s.handler.SetDynamicConfigOverride(ctx, key.String(), dynamicconfig.OverrideValue{Value: "fast"}, "")
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	err = s.handler.SetDynamicConfigOverride(ctx, key.String(), dynamicconfig.OverrideValue{Constraints: map[string]any{"shardId": 1}, Value: 100}, "")
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	data := map[string]string{"other": "value"}
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{Name: primitives.SystemLocalNamespace}).DoAndReturn(
+		func(_ context.Context, _ *persistence.GetNamespaceRequest) (*persistence.GetNamespaceResponse, error) {
+			return &persistence.GetNamespaceResponse{
+				Namespace: &persistencespb.NamespaceDetail{Info: &persistencespb.NamespaceInfo{Name: primitives.SystemLocalNamespace, Data: data}},
+			}, nil
+		}).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			data = request.Namespace.Info.Data
+			return nil
+		}).AnyTimes()
+
+	s.NoError(s.handler.SetDynamicConfigOverride(ctx, key.String(), nsValue, "cli"))
+	s.NoError(s.handler.SetDynamicConfigOverride(ctx, key.String(), dynamicconfig.OverrideValue{Value: 10}, "cli"))
+	nsValue.Value = 200
+	s.NoError(s.handler.SetDynamicConfigOverride(ctx, strings.ToLower(key.String()), nsValue, "cli"))
+	overrides, err := s.handler.GetDynamicConfigOverrides(ctx)
+	s.NoError(err)
+	s.Equal(dynamicconfig.Overrides{key.String(): {nsValue, {Value: 10}}}, overrides)
+	s.Equal("value", data["other"])
+
+	// the stored overrides are served by the override client
+	client := dynamicconfig.NewOverrideClient(dynamicconfig.NewNoopClient(), log.NewNoopLogger())
+	s.NoError(client.SetOverrides(data[dynamicconfig.OverridesDataKey]))
+	s.Equal(200, dynamicconfig.NewCollection(client, log.NewNoopLogger()).GetIntPropertyFilteredByNamespace(key, 0)("payments"))
+
+	err = s.handler.DeleteDynamicConfigOverride(ctx, key.String(), map[string]any{"namespace": "other"}, "cli")
+	s.IsType(&serviceerror.NotFound{}, err)
+	s.NoError(s.handler.DeleteDynamicConfigOverride(ctx, key.String(), map[string]any{"namespace": "payments"}, "cli"))
+	s.NoError(s.handler.DeleteDynamicConfigOverride(ctx, key.String(), nil, "cli"))
+	s.NotContains(data, dynamicconfig.OverridesDataKey)
+
+	changes, err := s.handler.ListDynamicConfigChanges(ctx)
+	s.NoError(err)
+	s.Len(changes, 5)
+	s.Nil(changes[0].NewValue)
+	s.EqualValues(10, changes[0].OldValue)
+	s.EqualValues(200, changes[2].NewValue)
+	s.EqualValues(100, changes[2].OldValue)
+	s.EqualValues(100, changes[4].NewValue)
+	s.Nil(changes[4].OldValue)
+	s.Equal("alice", changes[4].Caller)
+	s.Equal("cli", changes[4].Identity)
+	s.Equal(key.String(), changes[4].Key)
+}
+
+func (s *operatorHandlerSuite) Test_DynamicConfigRollout() {
+	ctx := context.Background()
+	key := dynamicconfig.Key(dynamicconfig.FrontendMaxNamespaceRPSPerInstance)
+	dynamicconfig.NewNoopCollection().GetIntPropertyFilteredByNamespace(key, 0)
+
+	data := map[string]string{}
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{Name: primitives.SystemLocalNamespace}).DoAndReturn(
+		func(_ context.Context, _ *persistence.GetNamespaceRequest) (*persistence.GetNamespaceResponse, error) {
+			return &persistence.GetNamespaceResponse{
+				Namespace: &persistencespb.NamespaceDetail{Info: &persistencespb.NamespaceInfo{Name: primitives.SystemLocalNamespace, Data: data}},
+			}, nil
+		}).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			data = request.Namespace.Info.Data
+			return nil
+		}).AnyTimes()
+
+	rollout := 10
+	s.NoError(s.handler.SetDynamicConfigOverride(ctx, key.String(), dynamicconfig.OverrideValue{Value: 50, Rollout: &rollout}, "cli"))
+
+	s.Equal(errInvalidDynamicConfigRollout, s.handler.SetDynamicConfigRollout(ctx, key.String(), nil, 101, "cli"))
+	err := s.handler.SetDynamicConfigRollout(ctx, key.String(), map[string]any{"namespace": "payments"}, 50, "cli")
+	s.IsType(&serviceerror.NotFound{}, err)
+
+	s.NoError(s.handler.SetDynamicConfigRollout(ctx, key.String(), nil, 50, "cli"))
+	overrides, err := s.handler.GetDynamicConfigOverrides(ctx)
+	s.NoError(err)
+	s.Equal(50, *overrides[key.String()][0].Rollout)
+
+	s.NoError(s.handler.SetDynamicConfigRollout(ctx, key.String(), nil, 100, "cli"))
+	overrides, err = s.handler.GetDynamicConfigOverrides(ctx)
+	s.NoError(err)
+	s.Equal(dynamicconfig.Overrides{key.String(): {{Value: 50}}}, overrides)
+
+	changes, err := s.handler.ListDynamicConfigChanges(ctx)
+	s.NoError(err)
+	s.Len(changes, 3)
+	s.Equal(50, *changes[0].OldRollout)
+	s.Nil(changes[0].NewRollout)
+	s.Equal(10, *changes[1].OldRollout)
+	s.Equal(50, *changes[1].NewRollout)
+}
+
+func (s *operatorHandlerSuite) Test_GetEffectiveDynamicConfig() {
+	key := dynamicconfig.Key(dynamicconfig.FrontendMaxNamespaceVisibilityRPSPerInstance)
+	dynamicconfig.NewNoopCollection().GetIntPropertyFilteredByNamespace(key, 10)
+	client := dynamicconfig.NewOverrideClient(dynamicconfig.StaticClient{key: 20}, s.mockResource.Logger)
+	s.NoError(client.SetOverrides(key.String() + ":\n- value: 30\n  constraints:\n    namespace: payments\n"))
+	s.handler.dynamicConfigClient = client
+
+	effective, err := s.handler.GetEffectiveDynamicConfig(context.Background())
+	s.NoError(err)
+	idx := slices.IndexFunc(effective, func(ek dynamicconfig.EffectiveKey) bool { return ek.Key == key.String() })
+	s.GreaterOrEqual(idx, 0)
+	s.Equal(dynamicconfig.IntType, effective[idx].Type)
+	s.Equal([]dynamicconfig.EffectiveValue{
+		{Constraints: map[string]any{"namespace": "payments"}, Value: 30, Source: dynamicconfig.SourceOverride},
+		{Value: 20, Source: dynamicconfig.SourceClient},
+		{Value: 10, Source: dynamicconfig.SourceDefault},
+	}, effective[idx].Values)
+}
+
 func (s *operatorHandlerSuite) Test_RemoveRemoteCluster_Success() {
 	var clusterName = "cluster"
 	s.mockResource.ClusterMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{clusterName: {}})
@@ -1159,6 +1508,87 @@ func (s *operatorHandlerSuite) Test_AddOrUpdateRemoteCluster_SaveClusterMetadata
 	s.IsType(&serviceerror.InvalidArgument{}, err)
 }
 
+func (s *operatorHandlerSuite) Test_GetComponentHealth() {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	currentClusterName := s.mockResource.ClusterMetadata.GetCurrentClusterName()
+
+	s.mockResource.ClusterMetadataMgr.EXPECT().GetCurrentClusterMetadata(gomock.Any()).Return(nil, errors.New("no connection"))
+
+	s.mockResource.NamespaceCache.EXPECT().GetNamespaceID(namespace.Name(primitives.SystemLocalNamespace)).Return(namespace.ID("system-id"), nil)
+	s.mockResource.VisibilityManager.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: "system-id",
+		Namespace:   primitives.SystemLocalNamespace,
+		PageSize:    1,
+	}).Return(&manager.ListWorkflowExecutionsResponse{}, nil)
+	s.mockResource.VisibilityManager.EXPECT().GetStoreNames().Return([]string{"sqlite"})
+
+	s.mockResource.ClusterMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{
+		currentClusterName: {Enabled: true},
+		"remote":           {Enabled: true, RPCAddress: "remote:7233"},
+		"disabled":         {Enabled: false, RPCAddress: "disabled:7233"},
+	}).AnyTimes()
+	s.mockResource.HistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: []string{"remote"},
+	}).Return(&historyservice.GetReplicationStatusResponse{
+		Shards: []*historyservice.ShardReplicationStatus{{
+			ShardId:                          1,
+			MaxReplicationTaskId:             10,
+			MaxReplicationTaskVisibilityTime: &now,
+			RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+				"remote": {AckedTaskId: 4, AckedTaskVisibilityTime: timestamp.TimePtr(now.Add(-10 * time.Minute))},
+			},
+		}},
+	}, nil)
+	s.mockResource.ClientFactory.EXPECT().NewRemoteAdminClientWithTimeout("remote:7233", gomock.Any(), gomock.Any()).
+		Return(s.mockResource.RemoteAdminClient)
+	s.mockResource.RemoteAdminClient.EXPECT().DescribeCluster(gomock.Any(), gomock.Any()).Return(&adminservice.DescribeClusterResponse{}, nil)
+
+	s.mockResource.FrontendServiceResolver.EXPECT().MemberCount().Return(2)
+	s.mockResource.HistoryServiceResolver.EXPECT().MemberCount().Return(3)
+	s.mockResource.MatchingServiceResolver.EXPECT().MemberCount().Return(1)
+	s.mockResource.WorkerServiceResolver.EXPECT().MemberCount().Return(0)
+
+	s.mockResource.ArchivalMetadata.EXPECT().GetHistoryConfig().Return(archiver.NewArchivalConfig(
+		"enabled",
+		dynamicconfig.GetStringPropertyFn("enabled"),
+		dynamicconfig.GetBoolPropertyFn(true),
+		"enabled",
+		"file:///tmp/archival",
+	)).AnyTimes()
+	historyArchiver := archiver.NewMockHistoryArchiver(s.controller)
+	historyArchiver.EXPECT().ValidateURI(gomock.Any()).Return(nil)
+	s.mockResource.ArchiverProvider.EXPECT().GetHistoryArchiver("file", string(primitives.FrontendService)).Return(historyArchiver, nil)
+
+	report, err := s.handler.GetComponentHealth(ctx)
+	s.NoError(err)
+	s.Equal(ComponentUnhealthy, report.Status)
+
+	components := make(map[string]*ComponentHealth)
+	for _, component := range report.Components {
+		components[component.Name] = component
+	}
+	s.Len(components, 8)
+
+	s.Equal(ComponentUnhealthy, components["persistence"].Status)
+	s.Equal("no connection", components["persistence"].Error)
+
+	s.Equal(ComponentHealthy, components["visibility"].Status)
+	s.Equal("[sqlite]", components["visibility"].Details["stores"])
+
+	s.Equal(ComponentDegraded, components["replication/remote"].Status)
+	s.Equal("10m0s", components["replication/remote"].Details["max_lag"])
+	s.Equal("6", components["replication/remote"].Details["backlog_tasks"])
+	s.NotContains(components, "replication/disabled")
+
+	s.Equal(ComponentHealthy, components["membership/history"].Status)
+	s.Equal("3", components["membership/history"].Details["members"])
+	s.Equal(ComponentUnhealthy, components["membership/worker"].Status)
+
+	s.Equal(ComponentHealthy, components["archival/history"].Status)
+	s.NotContains(components, "archival/visibility")
+}
+
 type updateNamespaceRequestMatcher struct {
 	f func(request *workflowservice.UpdateNamespaceRequest) bool
 }
@@ -1180,3 +1610,15 @@ func (m *updateNamespaceRequestMatcher) Matches(x interface{}) bool {
 func (m *updateNamespaceRequestMatcher) String() string {
 	return "UpdateNamespaceRequest match condition"
 }
+
+func (s *operatorHandlerSuite) Test_ListMetrics() {
+	entries, err := s.handler.ListMetrics(context.Background())
+	s.NoError(err)
+	s.Contains(entries, metrics.CatalogEntry{
+		Name:        metrics.ServiceLatency.GetMetricName(),
+		Type:        metrics.MetricTypeTimer,
+		Unit:        metrics.Milliseconds,
+		Description: metrics.ServiceLatency.GetDescription(),
+		Tags:        []string{metrics.OperationTagName, "namespace"},
+	})
+}
@@ -0,0 +1,206 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/proxy"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	remoteCodecDecodePath      = "/decode"
+	remoteCodecNamespaceHeader = "X-Namespace"
+)
+
+var (
+	// decodedPayloadAPIs are the read APIs whose response payloads can be decoded by the remote codec.
+	decodedPayloadAPIs = map[string]struct{}{
+		"GetWorkflowExecutionHistory":        {},
+		"GetWorkflowExecutionHistoryReverse": {},
+	}
+
+	errDecodePayloadsPermissionDenied = serviceerror.NewPermissionDenied("Decoding payloads requires write access to the namespace.", "")
+	errDecodePayloadsUnavailable      = serviceerror.NewUnavailable("Unable to decode payloads with the remote codec.")
+)
+
+type (
+	// PayloadCodecInterceptor decodes the payloads of history read responses with the remote codec configured
+	// for the namespace, when the caller asks for it with the decode-payloads header. Decoded payloads are
+	// only returned to callers with write access to the namespace.
+	PayloadCodecInterceptor struct {
+		config     *Config
+		httpClient *http.Client
+		logger     log.Logger
+	}
+)
+
+// NewPayloadCodecInterceptor creates a new PayloadCodecInterceptor
+func NewPayloadCodecInterceptor(
+	config *Config,
+	logger log.Logger,
+) *PayloadCodecInterceptor {
+	return &PayloadCodecInterceptor{
+		config:     config,
+		httpClient: &http.Client{},
+		logger:     logger,
+	}
+}
+
+func (i *PayloadCodecInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if _, ok := decodedPayloadAPIs[authorization.ApiName(info.FullMethod)]; !ok {
+		return handler(ctx, req)
+	}
+	if headers.GetValues(ctx, headers.DecodePayloadsHeaderName)[0] != "true" {
+		return handler(ctx, req)
+	}
+	var namespace string
+	if r, ok := req.(interface{ GetNamespace() string }); ok {
+		namespace = r.GetNamespace()
+	}
+	endpoint := strings.TrimSuffix(i.config.RemoteCodecEndpoint(namespace), "/")
+	if endpoint == "" {
+		return handler(ctx, req)
+	}
+	if !canDecodePayloads(ctx, namespace) {
+		return nil, errDecodePayloadsPermissionDenied
+	}
+
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	if msg, ok := resp.(proto.Message); ok {
+		if err := i.decodePayloads(ctx, namespace, endpoint, msg); err != nil {
+			i.logger.Warn("Unable to decode payloads", tag.WorkflowNamespace(namespace), tag.Error(err))
+			return nil, errDecodePayloadsUnavailable
+		}
+	}
+	return resp, nil
+}
+
+// canDecodePayloads returns true if the caller's claims allow writes to the namespace. Callers with no claims,
+// i.e. when no claim mapper is configured, are never allowed.
+func canDecodePayloads(ctx context.Context, namespace string) bool {
+	claims, ok := ctx.Value(authorization.MappedClaims).(*authorization.Claims)
+	if !ok || claims == nil {
+		return false
+	}
+	return claims.System >= authorization.RoleWriter || claims.Namespaces[namespace] >= authorization.RoleWriter
+}
+
+// decodePayloads replaces the payloads in msg, except search attributes, with the ones decoded by a single
+// call to the remote codec.
+func (i *PayloadCodecInterceptor) decodePayloads(
+	ctx context.Context,
+	namespace string,
+	endpoint string,
+	msg proto.Message,
+) error {
+	var payloads []*commonpb.Payload
+	if err := proxy.VisitPayloads(ctx, msg, proxy.VisitPayloadsOptions{
+		Visitor: func(_ *proxy.VisitPayloadsContext, p []*commonpb.Payload) ([]*commonpb.Payload, error) {
+			payloads = append(payloads, p...)
+			return p, nil
+		},
+		SkipSearchAttributes: true,
+	}); err != nil {
+		return err
+	}
+	if len(payloads) == 0 {
+		return nil
+	}
+
+	decoded, err := i.decode(ctx, namespace, endpoint, payloads)
+	if err != nil {
+		return err
+	}
+	if len(decoded) != len(payloads) {
+		return fmt.Errorf("remote codec returned %d payloads, expected %d", len(decoded), len(payloads))
+	}
+	return proxy.VisitPayloads(ctx, msg, proxy.VisitPayloadsOptions{
+		Visitor: func(_ *proxy.VisitPayloadsContext, p []*commonpb.Payload) ([]*commonpb.Payload, error) {
+			result := decoded[:len(p)]
+			decoded = decoded[len(p):]
+			return result, nil
+		},
+		SkipSearchAttributes: true,
+	})
+}
+
+// decode posts payloads to the decode endpoint of a remote codec, see converter.NewPayloadCodecHTTPHandler in
+// the SDK.
+func (i *PayloadCodecInterceptor) decode(
+	ctx context.Context,
+	namespace string,
+	endpoint string,
+	payloads []*commonpb.Payload,
+) ([]*commonpb.Payload, error) {
+	body, err := json.Marshal(commonpb.Payloads{Payloads: payloads})
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, i.config.RemoteCodecTimeout())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint+remoteCodecDecodePath, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(remoteCodecNamespaceHeader, namespace)
+
+	resp, err := i.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("remote codec returned status %d", resp.StatusCode)
+	}
+	var decoded commonpb.Payloads
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return nil, err
+	}
+	return decoded.Payloads, nil
+}
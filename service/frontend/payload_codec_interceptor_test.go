@@ -0,0 +1,225 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+)
+
+const (
+	getHistoryAPI = "/temporal.api.workflowservice.v1.WorkflowService/GetWorkflowExecutionHistory"
+	codecTestNS   = "codec-namespace"
+)
+
+type (
+	payloadCodecInterceptorSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		server      *httptest.Server
+		status      int
+		namespaces  []string
+		interceptor *PayloadCodecInterceptor
+	}
+)
+
+func TestPayloadCodecInterceptorSuite(t *testing.T) {
+	s := new(payloadCodecInterceptorSuite)
+	suite.Run(t, s)
+}
+
+func (s *payloadCodecInterceptorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.status = http.StatusOK
+	s.namespaces = nil
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Equal(remoteCodecDecodePath, r.URL.Path)
+		s.namespaces = append(s.namespaces, r.Header.Get(remoteCodecNamespaceHeader))
+		if s.status != http.StatusOK {
+			w.WriteHeader(s.status)
+			return
+		}
+		var payloads commonpb.Payloads
+		s.NoError(json.NewDecoder(r.Body).Decode(&payloads))
+		for _, p := range payloads.Payloads {
+			p.Data = bytes.TrimPrefix(p.Data, []byte("encrypted:"))
+		}
+		s.NoError(json.NewEncoder(w).Encode(payloads))
+	}))
+	s.interceptor = NewPayloadCodecInterceptor(&Config{
+		RemoteCodecEndpoint: func(namespace string) string {
+			if namespace == codecTestNS {
+				return s.server.URL + "/"
+			}
+			return ""
+		},
+		RemoteCodecTimeout: dynamicconfig.GetDurationPropertyFn(time.Second),
+	}, log.NewNoopLogger())
+}
+
+func (s *payloadCodecInterceptorSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *payloadCodecInterceptorSuite) context(decode bool, claims *authorization.Claims) context.Context {
+	ctx := context.Background()
+	if decode {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(headers.DecodePayloadsHeaderName, "true"))
+	}
+	if claims != nil {
+		ctx = context.WithValue(ctx, authorization.MappedClaims, claims)
+	}
+	return ctx
+}
+
+func (s *payloadCodecInterceptorSuite) call(ctx context.Context, api string, namespace string) (*workflowservice.GetWorkflowExecutionHistoryResponse, error) {
+	resp, err := s.interceptor.Intercept(
+		ctx,
+		&workflowservice.GetWorkflowExecutionHistoryRequest{Namespace: namespace},
+		&grpc.UnaryServerInfo{FullMethod: api},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return encryptedHistoryResponse(), nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*workflowservice.GetWorkflowExecutionHistoryResponse), nil
+}
+
+func (s *payloadCodecInterceptorSuite) TestDecode() {
+	claims := &authorization.Claims{Namespaces: map[string]authorization.Role{codecTestNS: authorization.RoleWriter}}
+	resp, err := s.call(s.context(true, claims), getHistoryAPI, codecTestNS)
+	s.NoError(err)
+
+	events := resp.History.Events
+	started := events[0].GetWorkflowExecutionStartedEventAttributes()
+	s.Equal("input-1", string(started.Input.Payloads[0].Data))
+	s.Equal("input-2", string(started.Input.Payloads[1].Data))
+	s.Equal("encrypted:search-attribute", string(started.SearchAttributes.IndexedFields["CustomField"].Data))
+	s.Equal("result", string(events[1].GetActivityTaskCompletedEventAttributes().Result.Payloads[0].Data))
+	s.Equal([]string{codecTestNS}, s.namespaces)
+}
+
+func (s *payloadCodecInterceptorSuite) TestSystemWriterDecode() {
+	resp, err := s.call(s.context(true, &authorization.Claims{System: authorization.RoleAdmin}), getHistoryAPI, codecTestNS)
+	s.NoError(err)
+	s.Equal("input-1", string(resp.History.Events[0].GetWorkflowExecutionStartedEventAttributes().Input.Payloads[0].Data))
+}
+
+func (s *payloadCodecInterceptorSuite) TestNotDecoded() {
+	writer := &authorization.Claims{System: authorization.RoleWriter}
+	for _, ctx := range []context.Context{s.context(false, writer), s.context(true, writer)} {
+		resp, err := s.call(ctx, getHistoryAPI, "other-namespace")
+		s.NoError(err)
+		s.Equal(encryptedHistoryResponse(), resp)
+	}
+	resp, err := s.call(s.context(false, writer), getHistoryAPI, codecTestNS)
+	s.NoError(err)
+	s.Equal(encryptedHistoryResponse(), resp)
+
+	_, err = s.interceptor.Intercept(
+		s.context(true, writer),
+		&workflowservice.DescribeWorkflowExecutionRequest{Namespace: codecTestNS},
+		&grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/DescribeWorkflowExecution"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &workflowservice.DescribeWorkflowExecutionResponse{}, nil
+		},
+	)
+	s.NoError(err)
+	s.Empty(s.namespaces)
+}
+
+func (s *payloadCodecInterceptorSuite) TestPermissionDenied() {
+	reader := &authorization.Claims{
+		System:     authorization.RoleReader,
+		Namespaces: map[string]authorization.Role{codecTestNS: authorization.RoleReader | authorization.RoleWorker},
+	}
+	for _, ctx := range []context.Context{s.context(true, nil), s.context(true, reader)} {
+		_, err := s.call(ctx, getHistoryAPI, codecTestNS)
+		var permissionDenied *serviceerror.PermissionDenied
+		s.ErrorAs(err, &permissionDenied)
+	}
+	s.Empty(s.namespaces)
+}
+
+func (s *payloadCodecInterceptorSuite) TestRemoteCodecError() {
+	s.status = http.StatusInternalServerError
+	_, err := s.call(s.context(true, &authorization.Claims{System: authorization.RoleAdmin}), getHistoryAPI, codecTestNS)
+	var unavailable *serviceerror.Unavailable
+	s.ErrorAs(err, &unavailable)
+}
+
+func encryptedHistoryResponse() *workflowservice.GetWorkflowExecutionHistoryResponse {
+	encrypted := func(data string) *commonpb.Payload {
+		return &commonpb.Payload{
+			Metadata: map[string][]byte{"encoding": []byte("binary/encrypted")},
+			Data:     []byte("encrypted:" + data),
+		}
+	}
+	return &workflowservice.GetWorkflowExecutionHistoryResponse{
+		History: &historypb.History{Events: []*historypb.HistoryEvent{
+			{
+				EventId: 1,
+				Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+					WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+						Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{encrypted("input-1"), encrypted("input-2")}},
+						SearchAttributes: &commonpb.SearchAttributes{
+							IndexedFields: map[string]*commonpb.Payload{"CustomField": encrypted("search-attribute")},
+						},
+					},
+				},
+			},
+			{
+				EventId: 2,
+				Attributes: &historypb.HistoryEvent_ActivityTaskCompletedEventAttributes{
+					ActivityTaskCompletedEventAttributes: &historypb.ActivityTaskCompletedEventAttributes{
+						Result: &commonpb.Payloads{Payloads: []*commonpb.Payload{encrypted("result")}},
+					},
+				},
+			},
+		}},
+	}
+}
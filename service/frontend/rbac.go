@@ -0,0 +1,336 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+)
+
+// PutRole creates or replaces a named role. Changes apply once frontends refresh their role bindings cache.
+func (h *OperatorHandlerImpl) PutRole(ctx context.Context, role authorization.NamedRole) (retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	scope, startTime := h.startRequestProfile(metrics.OperatorPutRoleScope)
+	defer func() { scope.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime)) }()
+
+	if role.Name == "" {
+		return errRoleNameNotSet
+	}
+	if len(role.Permissions) == 0 {
+		return errInvalidRolePermission
+	}
+	permissions := make([]string, 0, len(role.Permissions))
+	for _, permission := range role.Permissions {
+		if !permission.IsValid() {
+			return errInvalidRolePermission
+		}
+		permissions = append(permissions, string(permission))
+	}
+	return h.updateRoleBindings(ctx, func(roleBindings *persistencespb.RoleBindings) error {
+		if roleBindings.Roles == nil {
+			roleBindings.Roles = make(map[string]*persistencespb.RolePermissions)
+		}
+		roleBindings.Roles[role.Name] = &persistencespb.RolePermissions{Permissions: permissions}
+		return nil
+	})
+}
+
+// DeleteRole deletes a named role. A role still assigned to a group in any namespace, or cluster-wide, cannot
+// be deleted.
+func (h *OperatorHandlerImpl) DeleteRole(ctx context.Context, name string) (retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	scope, startTime := h.startRequestProfile(metrics.OperatorDeleteRoleScope)
+	defer func() { scope.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime)) }()
+
+	if name == "" {
+		return errRoleNameNotSet
+	}
+	return h.updateRoleBindings(ctx, func(roleBindings *persistencespb.RoleBindings) error {
+		if _, ok := roleBindings.GetRoles()[name]; !ok {
+			return serviceerror.NewNotFound(fmt.Sprintf("Role %s not found.", name))
+		}
+		for namespaceName, groupRoles := range roleBindings.GetNamespaces() {
+			for group, roles := range groupRoles.GetGroups() {
+				for _, role := range roles.GetNames() {
+					if role != name {
+						continue
+					}
+					if namespaceName == "" {
+						return serviceerror.NewFailedPrecondition(fmt.Sprintf(
+							"Role %s is assigned to group %s cluster-wide.", name, group,
+						))
+					}
+					return serviceerror.NewFailedPrecondition(fmt.Sprintf(
+						"Role %s is assigned to group %s in namespace %s.", name, group, namespaceName,
+					))
+				}
+			}
+		}
+		delete(roleBindings.Roles, name)
+		return nil
+	})
+}
+
+// ListRoles returns all named roles ordered by name.
+func (h *OperatorHandlerImpl) ListRoles(ctx context.Context) (_ []*authorization.NamedRole, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	scope, startTime := h.startRequestProfile(metrics.OperatorListRolesScope)
+	defer func() { scope.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime)) }()
+
+	resp, err := h.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var roles []*authorization.NamedRole
+	for name, permissions := range resp.ClusterMetadata.GetRoleBindings().GetRoles() {
+		role := &authorization.NamedRole{Name: name}
+		for _, permission := range permissions.GetPermissions() {
+			role.Permissions = append(role.Permissions, authorization.Permission(permission))
+		}
+		roles = append(roles, role)
+	}
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].Name < roles[j].Name
+	})
+	return roles, nil
+}
+
+// SetGroupRoles assigns named roles to an identity group in a namespace, replacing its previous roles. An empty
+// namespace name assigns the roles cluster-wide. An empty list removes the group from the namespace.
+func (h *OperatorHandlerImpl) SetGroupRoles(
+	ctx context.Context,
+	namespaceName string,
+	group string,
+	roles []string,
+) (retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	scope, startTime := h.startRequestProfile(metrics.OperatorSetGroupRolesScope)
+	defer func() { scope.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime)) }()
+
+	if group == "" {
+		return errGroupNotSet
+	}
+	if namespaceName != "" {
+		if _, err := h.namespaceRegistry.GetNamespace(namespace.Name(namespaceName)); err != nil {
+			return err
+		}
+	}
+	return h.updateRoleBindings(ctx, func(roleBindings *persistencespb.RoleBindings) error {
+		for _, role := range roles {
+			if _, ok := roleBindings.GetRoles()[role]; !ok {
+				return serviceerror.NewNotFound(fmt.Sprintf("Role %s not found.", role))
+			}
+		}
+		if roleBindings.Namespaces == nil {
+			roleBindings.Namespaces = make(map[string]*persistencespb.GroupRoleBindings)
+		}
+		groupRoles := roleBindings.Namespaces[namespaceName]
+		if groupRoles == nil {
+			groupRoles = &persistencespb.GroupRoleBindings{}
+			roleBindings.Namespaces[namespaceName] = groupRoles
+		}
+		if groupRoles.Groups == nil {
+			groupRoles.Groups = make(map[string]*persistencespb.RoleNames)
+		}
+		if len(roles) == 0 {
+			delete(groupRoles.Groups, group)
+		} else {
+			groupRoles.Groups[group] = &persistencespb.RoleNames{Names: roles}
+		}
+		if len(groupRoles.Groups) == 0 {
+			delete(roleBindings.Namespaces, namespaceName)
+		}
+		return nil
+	})
+}
+
+// GetGroupRoles returns the named roles assigned to each identity group in a namespace. An empty namespace name
+// returns the roles assigned cluster-wide.
+func (h *OperatorHandlerImpl) GetGroupRoles(
+	ctx context.Context,
+	namespaceName string,
+) (_ authorization.GroupRoles, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	scope, startTime := h.startRequestProfile(metrics.OperatorGetGroupRolesScope)
+	defer func() { scope.Timer(metrics.ServiceLatency.GetMetricName()).Record(time.Since(startTime)) }()
+
+	resp, err := h.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groupRoles := authorization.GroupRoles{}
+	for group, roles := range resp.ClusterMetadata.GetRoleBindings().GetNamespaces()[namespaceName].GetGroups() {
+		groupRoles[group] = roles.GetNames()
+	}
+	return groupRoles, nil
+}
+
+// updateRoleBindings applies update to the role bindings of the current cluster metadata and persists them. The
+// save is conditional on the version read, so concurrent updates fail instead of overwriting each other.
+func (h *OperatorHandlerImpl) updateRoleBindings(
+	ctx context.Context,
+	update func(roleBindings *persistencespb.RoleBindings) error,
+) error {
+	resp, err := h.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	clusterMetadata := resp.ClusterMetadata
+	if clusterMetadata.RoleBindings == nil {
+		clusterMetadata.RoleBindings = &persistencespb.RoleBindings{}
+	}
+	if err := update(clusterMetadata.RoleBindings); err != nil {
+		return err
+	}
+	applied, err := h.clusterMetadataManager.SaveClusterMetadata(ctx, &persistence.SaveClusterMetadataRequest{
+		ClusterMetadata: clusterMetadata,
+		Version:         resp.Version,
+	})
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return serviceerror.NewUnavailable("Role bindings update hasn't been applied.")
+	}
+	return nil
+}
+
+// PutRole serves OperatorHandlerImpl.PutRole, which operatorservice doesn't define.
+func (adh *AdminHandler) PutRole(
+	ctx context.Context,
+	request *adminservice.PutRoleRequest,
+) (_ *adminservice.PutRoleResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	role := authorization.NamedRole{Name: request.GetRole().GetName()}
+	for _, permission := range request.GetRole().GetPermissions() {
+		role.Permissions = append(role.Permissions, authorization.Permission(permission))
+	}
+	if err := adh.operatorHandler.PutRole(ctx, role); err != nil {
+		return nil, err
+	}
+	return &adminservice.PutRoleResponse{}, nil
+}
+
+// DeleteRole serves OperatorHandlerImpl.DeleteRole, which operatorservice doesn't define.
+func (adh *AdminHandler) DeleteRole(
+	ctx context.Context,
+	request *adminservice.DeleteRoleRequest,
+) (_ *adminservice.DeleteRoleResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := adh.operatorHandler.DeleteRole(ctx, request.GetName()); err != nil {
+		return nil, err
+	}
+	return &adminservice.DeleteRoleResponse{}, nil
+}
+
+// ListRoles serves OperatorHandlerImpl.ListRoles, which operatorservice doesn't define.
+func (adh *AdminHandler) ListRoles(
+	ctx context.Context,
+	request *adminservice.ListRolesRequest,
+) (_ *adminservice.ListRolesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	roles, err := adh.operatorHandler.ListRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &adminservice.ListRolesResponse{}
+	for _, role := range roles {
+		namedRole := &adminservice.NamedRole{Name: role.Name}
+		for _, permission := range role.Permissions {
+			namedRole.Permissions = append(namedRole.Permissions, string(permission))
+		}
+		resp.Roles = append(resp.Roles, namedRole)
+	}
+	return resp, nil
+}
+
+// SetGroupRoles serves OperatorHandlerImpl.SetGroupRoles, which operatorservice doesn't define.
+func (adh *AdminHandler) SetGroupRoles(
+	ctx context.Context,
+	request *adminservice.SetGroupRolesRequest,
+) (_ *adminservice.SetGroupRolesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := adh.operatorHandler.SetGroupRoles(ctx, request.GetNamespace(), request.GetGroup(), request.GetRoles()); err != nil {
+		return nil, err
+	}
+	return &adminservice.SetGroupRolesResponse{}, nil
+}
+
+// GetGroupRoles serves OperatorHandlerImpl.GetGroupRoles, which operatorservice doesn't define.
+func (adh *AdminHandler) GetGroupRoles(
+	ctx context.Context,
+	request *adminservice.GetGroupRolesRequest,
+) (_ *adminservice.GetGroupRolesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	groupRoles, err := adh.operatorHandler.GetGroupRoles(ctx, request.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	resp := &adminservice.GetGroupRolesResponse{}
+	for group, roles := range groupRoles {
+		resp.Groups = append(resp.Groups, &adminservice.GroupRoles{Group: group, Roles: roles})
+	}
+	sort.Slice(resp.Groups, func(i, j int) bool {
+		return resp.Groups[i].Group < resp.Groups[j].Group
+	})
+	return resp, nil
+}
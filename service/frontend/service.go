@@ -25,6 +25,7 @@
 package frontend
 
 import (
+	"context"
 	"math/rand"
 	"net"
 	"os"
@@ -40,7 +41,9 @@ import (
 
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/drain"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/featureflag"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
@@ -49,6 +52,7 @@ import (
 	"go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/util"
 )
 
@@ -91,6 +95,25 @@ type Config struct {
 	DisallowQuery                                                dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	ShutdownDrainDuration                                        dynamicconfig.DurationPropertyFn
 	ShutdownFailHealthCheckDuration                              dynamicconfig.DurationPropertyFn
+	ShutdownLongPollDrainDuration                                dynamicconfig.DurationPropertyFn
+	ShutdownRetryAfter                                           dynamicconfig.DurationPropertyFn
+
+	// Signal, query and update rate limits per namespace and per workflow execution
+	MaxNamespaceSignalRPSPerInstance dynamicconfig.IntPropertyFnWithNamespaceFilter
+	MaxNamespaceQueryRPSPerInstance  dynamicconfig.IntPropertyFnWithNamespaceFilter
+	MaxNamespaceUpdateRPSPerInstance dynamicconfig.IntPropertyFnWithNamespaceFilter
+	MaxExecutionSignalRPSPerInstance dynamicconfig.IntPropertyFnWithNamespaceFilter
+	MaxExecutionQueryRPSPerInstance  dynamicconfig.IntPropertyFnWithNamespaceFilter
+	MaxExecutionUpdateRPSPerInstance dynamicconfig.IntPropertyFnWithNamespaceFilter
+	GlobalNamespaceSignalRPS         dynamicconfig.IntPropertyFnWithNamespaceFilter
+	GlobalNamespaceQueryRPS          dynamicconfig.IntPropertyFnWithNamespaceFilter
+	GlobalNamespaceUpdateRPS         dynamicconfig.IntPropertyFnWithNamespaceFilter
+	GlobalExecutionSignalRPS         dynamicconfig.IntPropertyFnWithNamespaceFilter
+	GlobalExecutionQueryRPS          dynamicconfig.IntPropertyFnWithNamespaceFilter
+	GlobalExecutionUpdateRPS         dynamicconfig.IntPropertyFnWithNamespaceFilter
+	ExecutionRateLimiterCacheSize    dynamicconfig.IntPropertyFn
+
+	DeprecatedSDKVersions dynamicconfig.MapPropertyFn
 
 	MaxBadBinaries dynamicconfig.IntPropertyFnWithNamespaceFilter
 
@@ -101,6 +124,9 @@ type Config struct {
 	BlobSizeLimitError dynamicconfig.IntPropertyFnWithNamespaceFilter
 	BlobSizeLimitWarn  dynamicconfig.IntPropertyFnWithNamespaceFilter
 
+	// storage quota system protection
+	NamespaceStorageQuota dynamicconfig.IntPropertyFnWithNamespaceFilter
+
 	ThrottledLogRPS dynamicconfig.IntPropertyFn
 
 	// Namespace specific config
@@ -128,6 +154,32 @@ type Config struct {
 	// EnableTokenNamespaceEnforcement enables enforcement that namespace in completion token matches namespace of the request
 	EnableTokenNamespaceEnforcement dynamicconfig.BoolPropertyFn
 
+	// EnableAPIKeys allows callers to authenticate with server-managed API keys passed as bearer tokens
+	EnableAPIKeys dynamicconfig.BoolPropertyFn
+
+	// EnableRBAC allows calls granted by the named roles of the caller's groups
+	EnableRBAC dynamicconfig.BoolPropertyFn
+
+	// CertificateBindings grants roles to callers by client certificate
+	CertificateBindings dynamicconfig.MapPropertyFn
+
+	// RemoteCodecEndpoint is the remote payload codec used to decode history payloads of a namespace
+	RemoteCodecEndpoint dynamicconfig.StringPropertyFnWithNamespaceFilter
+	RemoteCodecTimeout  dynamicconfig.DurationPropertyFn
+
+	// EnableAuditLog turns on audit records of state-changing calls to a namespace
+	EnableAuditLog dynamicconfig.BoolPropertyFnWithNamespaceFilter
+
+	// NamespaceIPFilter and AdminIPFilter restrict the peer addresses allowed to call a namespace and operator and admin APIs
+	NamespaceIPFilter dynamicconfig.MapPropertyFnWithNamespaceFilter
+	AdminIPFilter     dynamicconfig.MapPropertyFn
+
+	// SlowRequestLogThresholds is the latency per API beyond which calls are logged as slow requests, and a
+	// sample of them is logged with a request summary of at most SlowRequestSummaryMaxSize bytes
+	SlowRequestLogThresholds     dynamicconfig.MapPropertyFn
+	SlowRequestSummarySampleRate dynamicconfig.FloatPropertyFn
+	SlowRequestSummaryMaxSize    dynamicconfig.IntPropertyFn
+
 	// gRPC keep alive options
 	// If a client pings too frequently, terminate the connection.
 	KeepAliveMinTime dynamicconfig.DurationPropertyFn
@@ -163,6 +215,20 @@ type Config struct {
 	// Default is 0, means, namespace will be deleted immediately.
 	DeleteNamespaceNamespaceDeleteDelay dynamicconfig.DurationPropertyFn
 
+	// Max rate of persistence calls of the CheckVisibilityConsistency admin API.
+	VisibilityConsistencyCheckRPS dynamicconfig.IntPropertyFn
+
+	// Max rate of history branch deletions of the DeleteHistoryBranchGarbage admin API.
+	HistoryGarbageDeletionRPS dynamicconfig.IntPropertyFn
+
+	// Task queue partition counts reported by the DescribeTaskQueueTopology admin API.
+	NumTaskQueueReadPartitions  dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+	NumTaskQueueWritePartitions dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+
+	// Read-after-write consistency of ListWorkflowExecutions.
+	VisibilityStrongConsistencyEnabled dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	VisibilityStrongConsistencyMaxWait dynamicconfig.DurationPropertyFnWithNamespaceFilter
+
 	// Enable schedule-related RPCs
 	EnableSchedules dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
@@ -172,11 +238,8 @@ type Config struct {
 	MaxConcurrentBatchOperation     dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxExecutionCountBatchOperation dynamicconfig.IntPropertyFnWithNamespaceFilter
 
-	EnableUpdateWorkflowExecution              dynamicconfig.BoolPropertyFnWithNamespaceFilter
-	EnableUpdateWorkflowExecutionAsyncAccepted dynamicconfig.BoolPropertyFnWithNamespaceFilter
-
-	EnableWorkerVersioningData     dynamicconfig.BoolPropertyFnWithNamespaceFilter
-	EnableWorkerVersioningWorkflow dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	// FeatureFlags gates experimental APIs per namespace
+	FeatureFlags *featureflag.Gate
 }
 
 // NewConfig returns new service config with default values
@@ -214,6 +277,22 @@ func NewConfig(
 		MaxNamespaceNamespaceReplicationInducingAPIsRPSPerInstance:   dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxNamespaceNamespaceReplicationInducingAPIsRPSPerInstance, 1),
 		MaxNamespaceNamespaceReplicationInducingAPIsBurstPerInstance: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxNamespaceNamespaceReplicationInducingAPIsBurstPerInstance, 10),
 
+		MaxNamespaceSignalRPSPerInstance: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxNamespaceSignalRPSPerInstance, 0),
+		MaxNamespaceQueryRPSPerInstance:  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxNamespaceQueryRPSPerInstance, 0),
+		MaxNamespaceUpdateRPSPerInstance: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxNamespaceUpdateRPSPerInstance, 0),
+		MaxExecutionSignalRPSPerInstance: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxExecutionSignalRPSPerInstance, 0),
+		MaxExecutionQueryRPSPerInstance:  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxExecutionQueryRPSPerInstance, 0),
+		MaxExecutionUpdateRPSPerInstance: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxExecutionUpdateRPSPerInstance, 0),
+		GlobalNamespaceSignalRPS:         dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendGlobalNamespaceSignalRPS, 0),
+		GlobalNamespaceQueryRPS:          dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendGlobalNamespaceQueryRPS, 0),
+		GlobalNamespaceUpdateRPS:         dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendGlobalNamespaceUpdateRPS, 0),
+		GlobalExecutionSignalRPS:         dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendGlobalExecutionSignalRPS, 0),
+		GlobalExecutionQueryRPS:          dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendGlobalExecutionQueryRPS, 0),
+		GlobalExecutionUpdateRPS:         dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendGlobalExecutionUpdateRPS, 0),
+		ExecutionRateLimiterCacheSize:    dc.GetIntProperty(dynamicconfig.FrontendExecutionRateLimiterCacheSize, 10000),
+
+		DeprecatedSDKVersions: dc.GetMapProperty(dynamicconfig.FrontendDeprecatedSDKVersions, map[string]any{}),
+
 		GlobalNamespaceRPS:                     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendGlobalNamespaceRPS, 0),
 		InternalFEGlobalNamespaceRPS:           dc.GetIntPropertyFilteredByNamespace(dynamicconfig.InternalFrontendGlobalNamespaceRPS, 0),
 		GlobalNamespaceVisibilityRPS:           dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendGlobalNamespaceVisibilityRPS, 0),
@@ -228,9 +307,12 @@ func NewConfig(
 		DisableListVisibilityByFilter:          dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.DisableListVisibilityByFilter, false),
 		BlobSizeLimitError:                     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
 		BlobSizeLimitWarn:                      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitWarn, 256*1024),
+		NamespaceStorageQuota:                  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.NamespaceStorageQuota, 0),
 		ThrottledLogRPS:                        dc.GetIntProperty(dynamicconfig.FrontendThrottledLogRPS, 20),
 		ShutdownDrainDuration:                  dc.GetDurationProperty(dynamicconfig.FrontendShutdownDrainDuration, 0*time.Second),
 		ShutdownFailHealthCheckDuration:        dc.GetDurationProperty(dynamicconfig.FrontendShutdownFailHealthCheckDuration, 0*time.Second),
+		ShutdownLongPollDrainDuration:          dc.GetDurationProperty(dynamicconfig.FrontendShutdownLongPollDrainDuration, 0*time.Second),
+		ShutdownRetryAfter:                     dc.GetDurationProperty(dynamicconfig.FrontendShutdownRetryAfter, time.Second),
 		EnableNamespaceNotActiveAutoForwarding: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableNamespaceNotActiveAutoForwarding, true),
 		SearchAttributesNumberOfKeysLimit:      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.SearchAttributesNumberOfKeysLimit, 100),
 		SearchAttributesSizeOfValueLimit:       dc.GetIntPropertyFilteredByNamespace(dynamicconfig.SearchAttributesSizeOfValueLimit, 2*1024),
@@ -242,6 +324,17 @@ func NewConfig(
 		DefaultWorkflowTaskTimeout:             dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.DefaultWorkflowTaskTimeout, common.DefaultWorkflowTaskTimeout),
 		EnableServerVersionCheck:               dc.GetBoolProperty(dynamicconfig.EnableServerVersionCheck, os.Getenv("TEMPORAL_VERSION_CHECK_DISABLED") == ""),
 		EnableTokenNamespaceEnforcement:        dc.GetBoolProperty(dynamicconfig.EnableTokenNamespaceEnforcement, true),
+		EnableAPIKeys:                          dc.GetBoolProperty(dynamicconfig.EnableAPIKeys, false),
+		EnableAuditLog:                         dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableAuditLog, false),
+		EnableRBAC:                             dc.GetBoolProperty(dynamicconfig.EnableRBAC, false),
+		CertificateBindings:                    dc.GetMapProperty(dynamicconfig.FrontendCertificateBindings, map[string]any{}),
+		RemoteCodecEndpoint:                    dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.FrontendRemoteCodecEndpoint, ""),
+		RemoteCodecTimeout:                     dc.GetDurationProperty(dynamicconfig.FrontendRemoteCodecTimeout, 10*time.Second),
+		NamespaceIPFilter:                      dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.FrontendNamespaceIPFilter, map[string]any{}),
+		AdminIPFilter:                          dc.GetMapProperty(dynamicconfig.FrontendAdminIPFilter, map[string]any{}),
+		SlowRequestLogThresholds:               dc.GetMapProperty(dynamicconfig.FrontendSlowRequestLogThresholds, map[string]any{}),
+		SlowRequestSummarySampleRate:           dc.GetFloat64Property(dynamicconfig.FrontendSlowRequestSummarySampleRate, 0.0),
+		SlowRequestSummaryMaxSize:              dc.GetIntProperty(dynamicconfig.FrontendSlowRequestSummaryMaxSize, 4*1024),
 		KeepAliveMinTime:                       dc.GetDurationProperty(dynamicconfig.KeepAliveMinTime, 10*time.Second),
 		KeepAlivePermitWithoutStream:           dc.GetBoolProperty(dynamicconfig.KeepAlivePermitWithoutStream, true),
 		KeepAliveMaxConnectionIdle:             dc.GetDurationProperty(dynamicconfig.KeepAliveMaxConnectionIdle, 2*time.Minute),
@@ -256,17 +349,23 @@ func NewConfig(
 		DeleteNamespaceConcurrentDeleteExecutionsActivities: dc.GetIntProperty(dynamicconfig.DeleteNamespaceConcurrentDeleteExecutionsActivities, 4),
 		DeleteNamespaceNamespaceDeleteDelay:                 dc.GetDurationProperty(dynamicconfig.DeleteNamespaceNamespaceDeleteDelay, 0*time.Hour),
 
+		VisibilityConsistencyCheckRPS: dc.GetIntProperty(dynamicconfig.VisibilityConsistencyCheckRPS, 100),
+
+		HistoryGarbageDeletionRPS: dc.GetIntProperty(dynamicconfig.HistoryGarbageDeletionRPS, 10),
+
+		NumTaskQueueReadPartitions:  dc.GetTaskQueuePartitionsProperty(dynamicconfig.MatchingNumTaskqueueReadPartitions),
+		NumTaskQueueWritePartitions: dc.GetTaskQueuePartitionsProperty(dynamicconfig.MatchingNumTaskqueueWritePartitions),
+
+		VisibilityStrongConsistencyEnabled: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.FrontendVisibilityStrongConsistencyEnabled, false),
+		VisibilityStrongConsistencyMaxWait: dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.FrontendVisibilityStrongConsistencyMaxWait, 5*time.Second),
+
 		EnableSchedules: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.FrontendEnableSchedules, true),
 
 		EnableBatcher:                   dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.FrontendEnableBatcher, true),
 		MaxConcurrentBatchOperation:     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxConcurrentBatchOperationPerNamespace, 1),
 		MaxExecutionCountBatchOperation: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxExecutionCountBatchOperationPerNamespace, 1000),
 
-		EnableUpdateWorkflowExecution:              dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.FrontendEnableUpdateWorkflowExecution, false),
-		EnableUpdateWorkflowExecutionAsyncAccepted: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.FrontendEnableUpdateWorkflowExecutionAsyncAccepted, false),
-
-		EnableWorkerVersioningData:     dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.FrontendEnableWorkerVersioningDataAPIs, false),
-		EnableWorkerVersioningWorkflow: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.FrontendEnableWorkerVersioningWorkflowAPIs, false),
+		FeatureFlags: featureflag.NewGate(dc),
 	}
 }
 
@@ -282,6 +381,8 @@ type Service struct {
 	versionChecker    *VersionChecker
 	visibilityManager manager.VisibilityManager
 	server            *grpc.Server
+	drainInterceptor  *interceptor.DrainInterceptor
+	membershipMonitor membership.Monitor
 
 	logger                         log.Logger
 	grpcListener                   net.Listener
@@ -298,6 +399,8 @@ func NewService(
 	operatorHandler *OperatorHandlerImpl,
 	versionChecker *VersionChecker,
 	visibilityMgr manager.VisibilityManager,
+	drainInterceptor *interceptor.DrainInterceptor,
+	membershipMonitor membership.Monitor,
 	logger log.Logger,
 	grpcListener net.Listener,
 	metricsHandler metrics.Handler,
@@ -313,6 +416,8 @@ func NewService(
 		operatorHandler:                operatorHandler,
 		versionChecker:                 versionChecker,
 		visibilityManager:              visibilityMgr,
+		drainInterceptor:               drainInterceptor,
+		membershipMonitor:              membershipMonitor,
 		logger:                         logger,
 		grpcListener:                   grpcListener,
 		metricsHandler:                 metricsHandler,
@@ -361,21 +466,35 @@ func (s *Service) Stop() {
 	}
 
 	// initiate graceful shutdown:
-	// 1. Fail rpc health check, this will cause client side load balancer to stop forwarding requests to this node
+	// 1. Fail rpc health check and leave the membership ring, this will cause client side load balancer
+	//    and other frontends to stop forwarding requests to this node
 	// 2. wait for failure detection time
-	// 3. stop taking new requests by returning InternalServiceError
-	// 4. Wait for X second
-	// 5. Stop everything forcefully and return
+	// 3. refuse new long polls with a retry-after hint and wait for in-flight long polls up to a budget
+	// 4. stop taking new requests by sending GOAWAY
+	// 5. Wait for X second
+	// 6. Stop everything forcefully and return
 
 	requestDrainTime := util.Max(time.Second, s.config.ShutdownDrainDuration())
 	failureDetectionTime := util.Max(0, s.config.ShutdownFailHealthCheckDuration())
+	longPollDrainTime := util.Max(0, s.config.ShutdownLongPollDrainDuration())
 
 	logger.Info("ShutdownHandler: Updating gRPC health status to ShuttingDown")
 	s.healthServer.Shutdown()
 
+	logger.Info("ShutdownHandler: Evicting self from membership ring")
+	if err := s.membershipMonitor.EvictSelf(); err != nil {
+		logger.Error("ShutdownHandler: Failed to evict self from membership ring", tag.Error(err))
+	}
+
 	logger.Info("ShutdownHandler: Waiting for others to discover I am unhealthy")
 	time.Sleep(failureDetectionTime)
 
+	logger.Info("ShutdownHandler: Draining long polls")
+	s.drainInterceptor.StartDraining(s.config.ShutdownRetryAfter())
+	if !s.drainInterceptor.WaitLongPolls(longPollDrainTime) {
+		logger.Info("ShutdownHandler: Long poll drain time expired")
+	}
+
 	s.handler.Stop()
 	s.operatorHandler.Stop()
 	s.adminHandler.Stop()
@@ -397,6 +516,30 @@ func (s *Service) Stop() {
 	logger.Info("frontend stopped")
 }
 
+// Drain moves the traffic of this host over to the other frontend hosts. The host fails its health check and
+// leaves the membership ring, then refuses new long polls with a retry-after hint until in-flight long polls
+// are completed. Other requests are still served with the hint, until the host restarts.
+func (s *Service) Drain(ctx context.Context) error {
+	s.logger.Info("DrainHandler: Updating gRPC health status to ShuttingDown")
+	s.healthServer.Shutdown()
+
+	s.logger.Info("DrainHandler: Evicting self from membership ring")
+	if err := s.membershipMonitor.EvictSelf(); err != nil {
+		return err
+	}
+
+	s.logger.Info("DrainHandler: Waiting for others to discover I am unhealthy")
+	if err := drain.Sleep(ctx, util.Max(0, s.config.ShutdownFailHealthCheckDuration())); err != nil {
+		return err
+	}
+
+	s.logger.Info("DrainHandler: Draining long polls")
+	s.drainInterceptor.StartDraining(s.config.ShutdownRetryAfter())
+	return drain.WaitUntil(ctx, 0, func() bool {
+		return s.drainInterceptor.WaitLongPolls(time.Second)
+	})
+}
+
 func namespaceRPS(
 	perInstanceRPSFn dynamicconfig.IntPropertyFnWithNamespaceFilter,
 	globalRPSFn dynamicconfig.IntPropertyFnWithNamespaceFilter,
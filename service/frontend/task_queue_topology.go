@@ -0,0 +1,212 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"errors"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/client/matching"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/tqname"
+	"go.temporal.io/server/common/util"
+)
+
+type (
+	// taskQueueTopologyDescriber describes how a task queue is spread across matching hosts.
+	taskQueueTopologyDescriber struct {
+		matchingClient     matchingservice.MatchingServiceClient
+		membershipMonitor  membership.Monitor
+		namespaceRegistry  namespace.Registry
+		numReadPartitions  dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		numWritePartitions dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+	}
+)
+
+func newTaskQueueTopologyDescriber(
+	matchingClient matchingservice.MatchingServiceClient,
+	membershipMonitor membership.Monitor,
+	namespaceRegistry namespace.Registry,
+	config *Config,
+) *taskQueueTopologyDescriber {
+	return &taskQueueTopologyDescriber{
+		matchingClient:     matchingClient,
+		membershipMonitor:  membershipMonitor,
+		namespaceRegistry:  namespaceRegistry,
+		numReadPartitions:  config.NumTaskQueueReadPartitions,
+		numWritePartitions: config.NumTaskQueueWritePartitions,
+	}
+}
+
+// describe describes every read or write partition of the task queue, with the same partition counts as the
+// matching load balancer. Partitions are described without being loaded. A partition which can't be described is
+// reported with an error, describe only fails if the task queue is invalid or ctx is done.
+func (d *taskQueueTopologyDescriber) describe(
+	ctx context.Context,
+	namespaceName string,
+	taskQueue string,
+	taskQueueType enumspb.TaskQueueType,
+) (*adminservice.DescribeTaskQueueTopologyResponse, error) {
+	if namespaceName == "" {
+		return nil, errNamespaceNotSet
+	}
+	if taskQueue == "" {
+		return nil, errTaskQueueNotSet
+	}
+	ns, err := d.namespaceRegistry.GetNamespace(namespace.Name(namespaceName))
+	if err != nil {
+		return nil, err
+	}
+	root, err := tqname.FromBaseName(taskQueue)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+	resolver, err := d.membershipMonitor.GetResolver(primitives.MatchingService)
+	if err != nil {
+		return nil, err
+	}
+	taskQueueTypes := []enumspb.TaskQueueType{taskQueueType}
+	if taskQueueType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		taskQueueTypes = []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY}
+	}
+
+	// Matching only describes the partitions it already loaded, instead of loading them
+	ctx = metadata.AppendToOutgoingContext(ctx, headers.LoadedTaskQueueOnlyHeaderName, "true")
+
+	// User data is owned by the root workflow partition regardless of the type being described. Its version is
+	// left unknown if the root can't be described, the root partition reports why in that case.
+	rootVersion, _ := d.getUserDataVersion(ctx, ns, taskQueue, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+
+	var partitions []*adminservice.TaskQueuePartitionTopology
+	for _, partitionType := range taskQueueTypes {
+		numRead := util.Max(1, d.numReadPartitions(ns.Name().String(), taskQueue, partitionType))
+		numWrite := util.Max(1, d.numWritePartitions(ns.Name().String(), taskQueue, partitionType))
+		for id := 0; id < util.Max(numRead, numWrite); id++ {
+			partition := &adminservice.TaskQueuePartitionTopology{
+				TaskQueueType: partitionType,
+				Id:            int32(id),
+				Key:           root.WithPartition(id).FullName(),
+				Read:          id < numRead,
+				Write:         id < numWrite,
+			}
+			if host, err := resolver.Lookup(matching.RoutingKey(ns.ID().String(), partition.Key, partitionType)); err == nil {
+				partition.OwnerHostName = host.GetAddress()
+			}
+			partitions = append(partitions, partition)
+		}
+	}
+	_, err = util.MapConcurrent(partitions, func(partition *adminservice.TaskQueuePartitionTopology) (struct{}, error) {
+		if err := d.describePartition(ctx, ns, partition, rootVersion); err != nil {
+			partition.Error = err.Error()
+		}
+		return struct{}{}, ctx.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.DescribeTaskQueueTopologyResponse{
+		RootUserDataVersion: rootVersion,
+		Partitions:          partitions,
+	}, nil
+}
+
+func (d *taskQueueTopologyDescriber) describePartition(
+	ctx context.Context,
+	ns *namespace.Namespace,
+	partition *adminservice.TaskQueuePartitionTopology,
+	rootVersion int64,
+) error {
+	describeResp, err := d.matchingClient.DescribeTaskQueue(ctx, &matchingservice.DescribeTaskQueueRequest{
+		NamespaceId: ns.ID().String(),
+		DescRequest: &workflowservice.DescribeTaskQueueRequest{
+			Namespace: ns.Name().String(),
+			TaskQueue: &taskqueuepb.TaskQueue{
+				Name: partition.Key,
+				Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+			},
+			TaskQueueType:          partition.TaskQueueType,
+			IncludeTaskQueueStatus: true,
+		},
+	})
+	if isNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	partition.Loaded = true
+	partition.Pollers = int32(len(describeResp.GetPollers()))
+	partition.BacklogCountHint = describeResp.GetTaskQueueStatus().GetBacklogCountHint()
+	partition.ReadLevel = describeResp.GetTaskQueueStatus().GetReadLevel()
+	partition.AckLevel = describeResp.GetTaskQueueStatus().GetAckLevel()
+
+	version, err := d.getUserDataVersion(ctx, ns, partition.Key, partition.TaskQueueType)
+	if isNotFound(err) {
+		// unloaded since it was described
+		partition.Loaded = false
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	partition.UserDataVersion = version
+	partition.UserDataPropagated = rootVersion != 0 && version == rootVersion
+	return nil
+}
+
+func (d *taskQueueTopologyDescriber) getUserDataVersion(
+	ctx context.Context,
+	ns *namespace.Namespace,
+	taskQueue string,
+	taskQueueType enumspb.TaskQueueType,
+) (int64, error) {
+	resp, err := d.matchingClient.GetTaskQueueUserData(ctx, &matchingservice.GetTaskQueueUserDataRequest{
+		NamespaceId:   ns.ID().String(),
+		TaskQueue:     taskQueue,
+		TaskQueueType: taskQueueType,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.GetUserData().GetVersion(), nil
+}
+
+func isNotFound(err error) bool {
+	var notFound *serviceerror.NotFound
+	return errors.As(err, &notFound)
+}
@@ -0,0 +1,194 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/api/matchingservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/client/matching"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives"
+)
+
+func TestTaskQueueTopologyDescribe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	matchingClient := matchingservicemock.NewMockMatchingServiceClient(ctrl)
+	monitor := membership.NewMockMonitor(ctrl)
+	resolver := membership.NewMockServiceResolver(ctrl)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+
+	ns := namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: "ns-id", Name: "ns"},
+		&persistencespb.NamespaceConfig{},
+		"active",
+	)
+	namespaceRegistry.EXPECT().GetNamespace(namespace.Name("ns")).Return(ns, nil)
+	monitor.EXPECT().GetResolver(primitives.MatchingService).Return(resolver, nil)
+	hostA := membership.NewHostInfoFromAddress("10.0.0.1:7235")
+	hostB := membership.NewHostInfoFromAddress("10.0.0.2:7235")
+	resolver.EXPECT().Lookup(matching.RoutingKey("ns-id", "tq", enumspb.TASK_QUEUE_TYPE_ACTIVITY)).Return(hostA, nil)
+	resolver.EXPECT().Lookup(matching.RoutingKey("ns-id", "/_sys/tq/1", enumspb.TASK_QUEUE_TYPE_ACTIVITY)).Return(hostB, nil)
+	resolver.EXPECT().Lookup(matching.RoutingKey("ns-id", "/_sys/tq/2", enumspb.TASK_QUEUE_TYPE_ACTIVITY)).Return(hostA, nil)
+
+	matchingClient.EXPECT().DescribeTaskQueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(
+			ctx context.Context,
+			request *matchingservice.DescribeTaskQueueRequest,
+			_ ...grpc.CallOption,
+		) (*matchingservice.DescribeTaskQueueResponse, error) {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			require.Equal(t, []string{"true"}, md.Get(headers.LoadedTaskQueueOnlyHeaderName))
+			require.Equal(t, "ns-id", request.GetNamespaceId())
+			require.True(t, request.GetDescRequest().GetIncludeTaskQueueStatus())
+			switch request.GetDescRequest().GetTaskQueue().GetName() {
+			case "tq":
+				return &matchingservice.DescribeTaskQueueResponse{
+					Pollers: []*taskqueuepb.PollerInfo{{Identity: "worker"}},
+					TaskQueueStatus: &taskqueuepb.TaskQueueStatus{
+						BacklogCountHint: 12,
+						ReadLevel:        40,
+						AckLevel:         28,
+					},
+				}, nil
+			case "/_sys/tq/1":
+				return nil, serviceerror.NewNotFound("task queue is not loaded")
+			default:
+				return nil, errors.New("connection refused")
+			}
+		}).Times(3)
+	matchingClient.EXPECT().GetTaskQueueUserData(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(
+			ctx context.Context,
+			request *matchingservice.GetTaskQueueUserDataRequest,
+			_ ...grpc.CallOption,
+		) (*matchingservice.GetTaskQueueUserDataResponse, error) {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			require.Equal(t, []string{"true"}, md.Get(headers.LoadedTaskQueueOnlyHeaderName))
+			version := int64(3)
+			if request.GetTaskQueueType() == enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+				version = 2
+			}
+			return &matchingservice.GetTaskQueueUserDataResponse{
+				TaskQueueHasUserData: true,
+				UserData:             &persistencespb.VersionedTaskQueueUserData{Version: version},
+			}, nil
+		}).Times(2)
+
+	describer := &taskQueueTopologyDescriber{
+		matchingClient:     matchingClient,
+		membershipMonitor:  monitor,
+		namespaceRegistry:  namespaceRegistry,
+		numReadPartitions:  dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(3),
+		numWritePartitions: dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(2),
+	}
+	topology, err := describer.describe(context.Background(), "ns", "tq", enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), topology.RootUserDataVersion)
+	require.Equal(t, []*adminservice.TaskQueuePartitionTopology{
+		{
+			TaskQueueType:    enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+			Id:               0,
+			Key:              "tq",
+			Read:             true,
+			Write:            true,
+			OwnerHostName:    hostA.GetAddress(),
+			Loaded:           true,
+			Pollers:          1,
+			BacklogCountHint: 12,
+			ReadLevel:        40,
+			AckLevel:         28,
+			UserDataVersion:  2,
+		},
+		{
+			TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+			Id:            1,
+			Key:           "/_sys/tq/1",
+			Read:          true,
+			Write:         true,
+			OwnerHostName: hostB.GetAddress(),
+		},
+		{
+			TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+			Id:            2,
+			Key:           "/_sys/tq/2",
+			Read:          true,
+			OwnerHostName: hostA.GetAddress(),
+			Error:         "connection refused",
+		},
+	}, topology.Partitions)
+}
+
+func TestTaskQueueTopologyDescribe_BothTypes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	matchingClient := matchingservicemock.NewMockMatchingServiceClient(ctrl)
+	monitor := membership.NewMockMonitor(ctrl)
+	resolver := membership.NewMockServiceResolver(ctrl)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+
+	ns := namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: "ns-id", Name: "ns"},
+		&persistencespb.NamespaceConfig{},
+		"active",
+	)
+	namespaceRegistry.EXPECT().GetNamespace(namespace.Name("ns")).Return(ns, nil)
+	monitor.EXPECT().GetResolver(primitives.MatchingService).Return(resolver, nil)
+	resolver.EXPECT().Lookup(gomock.Any()).Return(membership.NewHostInfoFromAddress("10.0.0.1:7235"), nil).Times(2)
+	matchingClient.EXPECT().GetTaskQueueUserData(gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewNotFound("task queue is not loaded"))
+	matchingClient.EXPECT().DescribeTaskQueue(gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewNotFound("task queue is not loaded")).Times(2)
+
+	describer := &taskQueueTopologyDescriber{
+		matchingClient:     matchingClient,
+		membershipMonitor:  monitor,
+		namespaceRegistry:  namespaceRegistry,
+		numReadPartitions:  dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(1),
+		numWritePartitions: dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(1),
+	}
+	topology, err := describer.describe(context.Background(), "ns", "tq", enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
+	require.NoError(t, err)
+	require.Zero(t, topology.RootUserDataVersion)
+	require.Len(t, topology.Partitions, 2)
+	require.Equal(t, enumspb.TASK_QUEUE_TYPE_WORKFLOW, topology.Partitions[0].TaskQueueType)
+	require.Equal(t, enumspb.TASK_QUEUE_TYPE_ACTIVITY, topology.Partitions[1].TaskQueueType)
+	require.False(t, topology.Partitions[0].Loaded)
+}
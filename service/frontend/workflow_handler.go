@@ -47,6 +47,7 @@ import (
 	updatepb "go.temporal.io/api/update/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
@@ -60,11 +61,13 @@ import (
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/claimcheck"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/enums"
 	"go.temporal.io/server/common/failure"
+	"go.temporal.io/server/common/featureflag"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -77,7 +80,7 @@ import (
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
-	"go.temporal.io/server/common/persistence/visibility/store"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/rpc"
@@ -98,7 +101,12 @@ var (
 	// Tail room for context deadline to bail out from retry for long poll.
 	longPollTailRoom = time.Second
 
+	// Maximum number of history shards waited for concurrently by a list asking for read-after-write consistency.
+	visibilityStrongConsistencyMaxConcurrentShards = 64
+
 	errWaitForRefresh = serviceerror.NewDeadlineExceeded("waiting for schedule to refresh status of completed workflows")
+
+	errVisibilityStrongConsistencyDeadline = serviceerror.NewDeadlineExceeded("Visibility did not catch up with the workflows before the deadline.")
 )
 
 type (
@@ -128,6 +136,8 @@ type (
 		healthServer                    *health.Server
 		overrides                       *Overrides
 		membershipMonitor               membership.Monitor
+		payloadStore                    claimcheck.PayloadStore
+		batchOperations                 *batchOperationStarter
 	}
 )
 
@@ -153,6 +163,7 @@ func NewWorkflowHandler(
 	healthServer *health.Server,
 	timeSource clock.TimeSource,
 	membershipMonitor membership.Monitor,
+	payloadStore claimcheck.PayloadStore,
 ) *WorkflowHandler {
 
 	handler := &WorkflowHandler{
@@ -197,6 +208,13 @@ func NewWorkflowHandler(
 		healthServer:      healthServer,
 		overrides:         NewOverrides(),
 		membershipMonitor: membershipMonitor,
+		payloadStore:      payloadStore,
+		batchOperations: &batchOperationStarter{
+			config:            config,
+			namespaceRegistry: namespaceRegistry,
+			visibilityMgr:     visibilityMrg,
+			historyClient:     historyClient,
+		},
 	}
 
 	return handler
@@ -393,6 +411,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(ctx context.Context, request *
 	}
 	wh.logger.Debug("Start workflow execution request namespaceID.", tag.WorkflowNamespaceID(namespaceID.String()))
 
+	if err := wh.checkNamespaceStorageQuota(namespaceName); err != nil {
+		return nil, err
+	}
+
 	resp, err := wh.historyClient.StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(namespaceID.String(), request, nil, time.Now().UTC()))
 
 	if err != nil {
@@ -1134,6 +1156,11 @@ func (wh *WorkflowHandler) PollActivityTaskQueue(ctx context.Context, request *w
 		return nil, err
 	}
 
+	// the input of the activity is read from its scheduled event, where it may have been offloaded
+	if err := wh.payloadStore.Resolve(ctx, matchingResponse); err != nil {
+		return nil, err
+	}
+
 	return &workflowservice.PollActivityTaskQueueResponse{
 		TaskToken:                   matchingResponse.TaskToken,
 		WorkflowExecution:           matchingResponse.WorkflowExecution,
@@ -1989,6 +2016,10 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, err
 	}
 
+	if err := wh.checkNamespaceStorageQuota(namespaceName); err != nil {
+		return nil, err
+	}
+
 	resp, err := wh.historyClient.SignalWithStartWorkflowExecution(ctx, &historyservice.SignalWithStartWorkflowExecutionRequest{
 		NamespaceId:            namespaceID.String(),
 		SignalWithStartRequest: request,
@@ -2308,7 +2339,13 @@ func (wh *WorkflowHandler) ListWorkflowExecutions(ctx context.Context, request *
 		NextPageToken: request.NextPageToken,
 		Query:         request.GetQuery(),
 	}
-	persistenceResp, err := wh.visibilityMrg.ListWorkflowExecutions(ctx, req)
+	var persistenceResp *manager.ListWorkflowExecutionsResponse
+	if wh.isVisibilityStrongConsistencyRequested(ctx, req) {
+		req.StrongConsistency = true
+		persistenceResp, err = wh.listWorkflowExecutionsConsistent(ctx, req)
+	} else {
+		persistenceResp, err = wh.visibilityMrg.ListWorkflowExecutions(ctx, req)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -2319,6 +2356,63 @@ func (wh *WorkflowHandler) ListWorkflowExecutions(ctx context.Context, request *
 	}, nil
 }
 
+// isVisibilityStrongConsistencyRequested returns true if the first page of a list request asks for
+// read-after-write consistency with the visibility-consistency header, and it's allowed.
+func (wh *WorkflowHandler) isVisibilityStrongConsistencyRequested(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsRequestV2,
+) bool {
+	if len(request.NextPageToken) > 0 {
+		return false
+	}
+	consistency := headers.GetValues(ctx, headers.VisibilityConsistencyHeaderName)[0]
+	return consistency == headers.VisibilityConsistencyStrong &&
+		wh.config.VisibilityStrongConsistencyEnabled(request.Namespace.String())
+}
+
+// listWorkflowExecutionsConsistent lists workflow executions with read-after-write consistency.
+// Visibility stores are updated asynchronously by the visibility queues of the history shards, so
+// the list waits until the visibility queues of the shards it reads from have completed the tasks
+// written before the request: the workflow's shard when the query filters on a single workflow ID,
+// all shards otherwise. It fails with DeadlineExceeded instead of returning a stale list if they
+// don't catch up within VisibilityStrongConsistencyMaxWait.
+func (wh *WorkflowHandler) listWorkflowExecutionsConsistent(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsRequestV2,
+) (*manager.ListWorkflowExecutionsResponse, error) {
+	var shardIDs []int32
+	if workflowID, ok := query.WorkflowIDFilter(request.Query); ok {
+		shardIDs = []int32{common.WorkflowIDToHistoryShard(request.NamespaceID.String(), workflowID, wh.config.NumHistoryShards)}
+	} else {
+		shardIDs = make([]int32, 0, wh.config.NumHistoryShards)
+		for shardID := int32(1); shardID <= wh.config.NumHistoryShards; shardID++ {
+			shardIDs = append(shardIDs, shardID)
+		}
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, wh.config.VisibilityStrongConsistencyMaxWait(request.Namespace.String()))
+	defer cancel()
+	errGroup, waitCtx := errgroup.WithContext(waitCtx)
+	errGroup.SetLimit(visibilityStrongConsistencyMaxConcurrentShards)
+	for _, shardID := range shardIDs {
+		shardID := shardID
+		errGroup.Go(func() error {
+			_, err := wh.historyClient.AwaitVisibilityIngestion(waitCtx, &historyservice.AwaitVisibilityIngestionRequest{
+				ShardId: shardID,
+			})
+			return err
+		})
+	}
+	if err := errGroup.Wait(); err != nil {
+		if common.IsContextDeadlineExceededErr(err) {
+			return nil, errVisibilityStrongConsistencyDeadline
+		}
+		return nil, err
+	}
+
+	return wh.visibilityMrg.ListWorkflowExecutions(ctx, request)
+}
+
 // ListArchivedWorkflowExecutions is a visibility API to list archived workflow executions in a specific namespace.
 func (wh *WorkflowHandler) ListArchivedWorkflowExecutions(ctx context.Context, request *workflowservice.ListArchivedWorkflowExecutionsRequest) (_ *workflowservice.ListArchivedWorkflowExecutionsResponse, retError error) {
 	defer log.CapturePanic(wh.logger, &retError)
@@ -2747,6 +2841,8 @@ func (wh *WorkflowHandler) GetSystemInfo(ctx context.Context, request *workflows
 		// Capabilities should be added as needed. In many cases, capabilities are
 		// hardcoded boolean true values since older servers will respond with a
 		// form of this message without the field which is implied false.
+		// Feature flagged capabilities report the cluster-wide setting since the
+		// request carries no namespace.
 		Capabilities: &workflowservice.GetSystemInfoResponse_Capabilities{
 			SignalAndQueryHeader:            true,
 			InternalErrorDifferentiation:    true,
@@ -2754,7 +2850,7 @@ func (wh *WorkflowHandler) GetSystemInfo(ctx context.Context, request *workflows
 			SupportsSchedules:               true,
 			EncodedFailureAttributes:        true,
 			UpsertMemo:                      true,
-			EagerWorkflowStart:              true,
+			EagerWorkflowStart:              wh.config.FeatureFlags.Enabled(featureflag.EagerWorkflowStart, ""),
 			SdkMetadata:                     true,
 			BuildIdBasedVersioning:          true,
 		},
@@ -3486,13 +3582,14 @@ func (wh *WorkflowHandler) UpdateWorkflowExecution(
 		return nil, err
 	}
 
-	if !wh.config.EnableUpdateWorkflowExecution(request.Namespace) {
-		return nil, errUpdateWorkflowExecutionAPINotAllowed
+	if err := wh.config.FeatureFlags.Check(featureflag.UpdateWorkflowExecution, request.GetNamespace()); err != nil {
+		return nil, err
 	}
 
-	if request.WaitPolicy.LifecycleStage == enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_ACCEPTED &&
-		!wh.config.EnableUpdateWorkflowExecutionAsyncAccepted(request.Namespace) {
-		return nil, errUpdateWorkflowExecutionAsyncAcceptedNotAllowed
+	if request.WaitPolicy.LifecycleStage == enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_ACCEPTED {
+		if err := wh.config.FeatureFlags.Check(featureflag.UpdateWorkflowExecutionAsyncAccepted, request.GetNamespace()); err != nil {
+			return nil, err
+		}
 	}
 
 	histResp, err := wh.historyClient.UpdateWorkflowExecution(ctx, &historyservice.UpdateWorkflowExecutionRequest{
@@ -3525,8 +3622,8 @@ func (wh *WorkflowHandler) PollWorkflowExecutionUpdate(
 		return nil, err
 	}
 
-	if !wh.config.EnableUpdateWorkflowExecution(request.Namespace) {
-		return nil, errUpdateWorkflowExecutionAPINotAllowed
+	if err := wh.config.FeatureFlags.Check(featureflag.UpdateWorkflowExecution, request.GetNamespace()); err != nil {
+		return nil, err
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, frontend.DefaultLongPollTimeout)
@@ -3552,8 +3649,8 @@ func (wh *WorkflowHandler) UpdateWorkerBuildIdCompatibility(ctx context.Context,
 		return nil, errRequestNotSet
 	}
 
-	if !wh.config.EnableWorkerVersioningData(request.Namespace) {
-		return nil, errWorkerVersioningNotAllowed
+	if err := wh.config.FeatureFlags.Check(featureflag.WorkerVersioningData, request.GetNamespace()); err != nil {
+		return nil, err
 	}
 
 	if err := wh.validateBuildIdCompatibilityUpdate(request); err != nil {
@@ -3593,8 +3690,8 @@ func (wh *WorkflowHandler) GetWorkerBuildIdCompatibility(ctx context.Context, re
 		return nil, errRequestNotSet
 	}
 
-	if !wh.config.EnableWorkerVersioningData(request.Namespace) {
-		return nil, errWorkerVersioningNotAllowed
+	if err := wh.config.FeatureFlags.Check(featureflag.WorkerVersioningData, request.GetNamespace()); err != nil {
+		return nil, err
 	}
 
 	if err := wh.validateTaskQueue(&taskqueuepb.TaskQueue{Name: request.GetTaskQueue(), Kind: enumspb.TASK_QUEUE_KIND_NORMAL}); err != nil {
@@ -3625,8 +3722,8 @@ func (wh *WorkflowHandler) GetWorkerTaskReachability(ctx context.Context, reques
 		return nil, errRequestNotSet
 	}
 
-	if !wh.config.EnableWorkerVersioningData(request.Namespace) {
-		return nil, errWorkerVersioningNotAllowed
+	if err := wh.config.FeatureFlags.Check(featureflag.WorkerVersioningData, request.GetNamespace()); err != nil {
+		return nil, err
 	}
 
 	if len(request.GetBuildIds()) == 0 {
@@ -3659,7 +3756,9 @@ func (wh *WorkflowHandler) GetWorkerTaskReachability(ctx context.Context, reques
 		return nil, err
 	}
 
+	startTime := time.Now().UTC()
 	response, err := wh.getWorkerTaskReachabilityValidated(ctx, ns, request)
+	wh.metricsScope(ctx).Timer(metrics.ReachabilityLatency.GetMetricName()).Record(time.Since(startTime))
 	if err != nil {
 		var invalidArgument *serviceerror.InvalidArgument
 		if errors.As(err, &invalidArgument) {
@@ -3686,79 +3785,14 @@ func (wh *WorkflowHandler) StartBatchOperation(
 		return nil, errRequestNotSet
 	}
 
-	if len(request.GetJobId()) == 0 {
-		return nil, errBatchJobIDNotSet
-	}
-	if len(request.Namespace) == 0 {
-		return nil, errNamespaceNotSet
-	}
-	if len(request.VisibilityQuery) == 0 && len(request.Executions) == 0 {
-		return nil, errBatchOpsWorkflowFilterNotSet
-	}
-	if len(request.VisibilityQuery) != 0 && len(request.Executions) != 0 {
-		return nil, errBatchOpsWorkflowFiltersNotAllowed
-	}
-	if len(request.Executions) > wh.config.MaxExecutionCountBatchOperation(request.Namespace) {
-		return nil, errBatchOpsMaxWorkflowExecutionCount
-	}
-	if len(request.Reason) == 0 {
-		return nil, errReasonNotSet
-	}
 	if request.Operation == nil {
 		return nil, errBatchOperationNotSet
 	}
 
-	if !wh.config.EnableBatcher(request.Namespace) {
-		return nil, errBatchAPINotAllowed
-	}
-
-	// Validate concurrent batch operation
-	maxConcurrentBatchOperation := wh.config.MaxConcurrentBatchOperation(request.GetNamespace())
-	countResp, err := wh.CountWorkflowExecutions(ctx, &workflowservice.CountWorkflowExecutionsRequest{
-		Namespace: request.GetNamespace(),
-		Query:     batcher.OpenBatchOperationQuery,
-	})
-	openBatchOperationCount := 0
-	if err == nil {
-		openBatchOperationCount = int(countResp.GetCount())
-	} else {
-		if !errors.Is(err, store.OperationNotSupportedErr) {
-			return nil, err
-		}
-		// Some std visibility stores don't yet support CountWorkflowExecutions, even though some
-		// batch operations are still possible on those store (eg. by specyfing a list of Executions
-		// rather than a VisibilityQuery). Fallback to ListOpenWorkflowExecutions in these cases.
-		// TODO: Remove this once all std visibility stores support CountWorkflowExecutions.
-		nextPageToken := []byte{}
-		for nextPageToken != nil && openBatchOperationCount < maxConcurrentBatchOperation {
-			listResp, err := wh.ListOpenWorkflowExecutions(ctx, &workflowservice.ListOpenWorkflowExecutionsRequest{
-				Namespace: request.GetNamespace(),
-				Filters: &workflowservice.ListOpenWorkflowExecutionsRequest_TypeFilter{
-					TypeFilter: &filterpb.WorkflowTypeFilter{
-						Name: batcher.BatchWFTypeName,
-					},
-				},
-				MaximumPageSize: int32(maxConcurrentBatchOperation - openBatchOperationCount),
-				NextPageToken:   nextPageToken,
-			})
-			if err != nil {
-				return nil, err
-			}
-			openBatchOperationCount += len(listResp.Executions)
-			nextPageToken = listResp.NextPageToken
-		}
-	}
-	if openBatchOperationCount >= maxConcurrentBatchOperation {
-		return nil, serviceerror.NewResourceExhausted(enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT, "Max concurrent batch operations is reached")
-	}
-
-	namespaceID, err := wh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
-	if err != nil {
-		return nil, err
-	}
 	var identity string
 	var operationType string
 	var signalParams batcher.SignalParams
+	var resetParams batcher.ResetParams
 	switch op := request.Operation.(type) {
 	case *workflowservice.StartBatchOperationRequest_TerminationOperation:
 		identity = op.TerminationOperation.GetIdentity()
@@ -3777,6 +3811,8 @@ func (wh *WorkflowHandler) StartBatchOperation(
 	case *workflowservice.StartBatchOperationRequest_ResetOperation:
 		identity = op.ResetOperation.GetIdentity()
 		operationType = batcher.BatchTypeReset
+		resetParams.ResetType = op.ResetOperation.GetResetType()
+		resetParams.ResetReapplytType = op.ResetOperation.GetResetReapplyType()
 	default:
 		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("The operation type %T is not supported", op))
 	}
@@ -3791,40 +3827,12 @@ func (wh *WorkflowHandler) StartBatchOperation(
 		CancelParams:    batcher.CancelParams{},
 		SignalParams:    signalParams,
 		DeleteParams:    batcher.DeleteParams{},
-		ResetParams:     batcher.ResetParams{},
+		ResetParams:     resetParams,
 	}
-	inputPayload, err := sdk.PreferProtoDataConverter.ToPayloads(input)
-	if err != nil {
+	if err := wh.batchOperations.validate(request.GetJobId(), input); err != nil {
 		return nil, err
 	}
-
-	memo := &commonpb.Memo{
-		Fields: map[string]*commonpb.Payload{
-			batcher.BatchOperationTypeMemo: payload.EncodeString(operationType),
-			batcher.BatchReasonMemo:        payload.EncodeString(request.GetReason()),
-		},
-	}
-
-	// Add pre-define search attributes
-	var searchAttributes *commonpb.SearchAttributes
-	searchattribute.AddSearchAttribute(&searchAttributes, searchattribute.BatcherUser, payload.EncodeString(identity))
-	searchattribute.AddSearchAttribute(&searchAttributes, searchattribute.TemporalNamespaceDivision, payload.EncodeString(batcher.NamespaceDivision))
-
-	startReq := &workflowservice.StartWorkflowExecutionRequest{
-		Namespace:             request.Namespace,
-		WorkflowId:            request.GetJobId(),
-		WorkflowType:          &commonpb.WorkflowType{Name: batcher.BatchWFTypeName},
-		TaskQueue:             &taskqueuepb.TaskQueue{Name: primitives.PerNSWorkerTaskQueue},
-		Input:                 inputPayload,
-		Identity:              identity,
-		RequestId:             uuid.New(),
-		WorkflowIdReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
-		Memo:                  memo,
-		SearchAttributes:      searchAttributes,
-	}
-
-	_, err = wh.historyClient.StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(namespaceID.String(), startReq, nil, time.Now().UTC()))
-	if err != nil {
+	if err := wh.batchOperations.start(ctx, request.GetJobId(), identity, input); err != nil {
 		return nil, err
 	}
 	return &workflowservice.StartBatchOperationResponse{}, nil
@@ -4172,6 +4180,9 @@ func (wh *WorkflowHandler) getHistory(
 	if err := wh.processOutgoingSearchAttributes(historyEvents, namespace); err != nil {
 		return nil, nil, err
 	}
+	if err := wh.payloadStore.Resolve(ctx, historyEvents); err != nil {
+		return nil, nil, err
+	}
 
 	executionHistory := &historypb.History{
 		Events: historyEvents,
@@ -4221,6 +4232,9 @@ func (wh *WorkflowHandler) getHistoryReverse(
 	if err := wh.processOutgoingSearchAttributes(historyEvents, namespace); err != nil {
 		return nil, nil, 0, err
 	}
+	if err := wh.payloadStore.Resolve(ctx, historyEvents); err != nil {
+		return nil, nil, 0, err
+	}
 
 	executionHistory := &historypb.History{
 		Events: historyEvents,
@@ -4312,8 +4326,10 @@ type buildIdAndFlag interface {
 }
 
 func (wh *WorkflowHandler) validateVersioningInfo(namespace string, id buildIdAndFlag, tq *taskqueuepb.TaskQueue) error {
-	if id.GetUseVersioning() && !wh.config.EnableWorkerVersioningWorkflow(namespace) {
-		return errWorkerVersioningNotAllowed
+	if id.GetUseVersioning() {
+		if err := wh.config.FeatureFlags.Check(featureflag.WorkerVersioningWorkflow, namespace); err != nil {
+			return err
+		}
 	}
 	if id.GetUseVersioning() && tq.GetKind() == enumspb.TASK_QUEUE_KIND_STICKY && len(tq.GetNormalName()) == 0 {
 		return errUseVersioningWithoutNormalName
@@ -4659,6 +4675,27 @@ func (wh *WorkflowHandler) validateRetryPolicy(namespaceName namespace.Name, ret
 	return common.ValidateRetryPolicy(retryPolicy)
 }
 
+// checkNamespaceStorageQuota rejects new workflow starts once the storage usage of the namespace, as last recorded
+// by the storage usage scanner, has reached the configured quota.
+func (wh *WorkflowHandler) checkNamespaceStorageQuota(namespaceName namespace.Name) error {
+	quota := int64(wh.config.NamespaceStorageQuota(namespaceName.String()))
+	if quota <= 0 {
+		return nil
+	}
+
+	namespaceEntry, err := wh.namespaceRegistry.GetNamespace(namespaceName)
+	if err != nil {
+		return err
+	}
+	if usage := namespaceEntry.StorageUsage().TotalBytes(); usage >= quota {
+		return serviceerror.NewResourceExhausted(
+			enumspb.RESOURCE_EXHAUSTED_CAUSE_PERSISTENCE_LIMIT,
+			fmt.Sprintf("Namespace storage usage of %d bytes exceeds the quota of %d bytes.", usage, quota),
+		)
+	}
+	return nil
+}
+
 func (wh *WorkflowHandler) validateStartWorkflowTimeouts(
 	request *workflowservice.StartWorkflowExecutionRequest,
 ) error {
@@ -4894,6 +4931,8 @@ func (wh *WorkflowHandler) cleanScheduleMemo(memo *commonpb.Memo) *commonpb.Memo
 		return nil
 	}
 	delete(fields, scheduler.MemoFieldInfo)
+	delete(fields, scheduler.MemoFieldBackfills)
+	delete(fields, scheduler.MemoFieldLastCompletionTime)
 	if len(fields) == 0 {
 		return nil
 	}
@@ -50,6 +50,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/metadata"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
@@ -62,6 +63,7 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/claimcheck"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	dc "go.temporal.io/server/common/dynamicconfig"
@@ -190,6 +192,7 @@ func (s *workflowHandlerSuite) getWorkflowHandler(config *Config) *WorkflowHandl
 		health.NewServer(),
 		clock.NewRealTimeSource(),
 		s.mockResource.GetMembershipMonitor(),
+		claimcheck.NoopPayloadStore,
 	)
 }
 
@@ -718,6 +721,52 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidStartDel
 	s.ErrorIs(err, errInvalidWorkflowStartDelaySeconds)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_NamespaceStorageQuota() {
+	config := s.newConfig()
+	config.NamespaceStorageQuota = dc.GetIntPropertyFilteredByNamespace(1000)
+	wh := s.getWorkflowHandler(config)
+
+	startWorkflowExecutionRequest := &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:  s.testNamespace.String(),
+		WorkflowId: "workflow-id",
+		WorkflowType: &commonpb.WorkflowType{
+			Name: "workflow-type",
+		},
+		TaskQueue: &taskqueuepb.TaskQueue{
+			Name: "task-queue",
+		},
+		RequestId: uuid.New(),
+	}
+	newNamespaceEntry := func(usage namespace.StorageUsage) *namespace.Namespace {
+		info := &persistencespb.NamespaceInfo{
+			Id:   s.testNamespaceID.String(),
+			Name: s.testNamespace.String(),
+			Data: make(map[string]string),
+		}
+		usage.ToData(info.Data)
+		return namespace.NewLocalNamespaceForTest(info, &persistencespb.NamespaceConfig{}, "")
+	}
+	s.mockSearchAttributesMapperProvider.EXPECT().GetMapper(s.testNamespace).Return(nil, nil).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil).AnyTimes()
+
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.testNamespace).Return(newNamespaceEntry(namespace.StorageUsage{
+		HistoryBytes:      600,
+		MutableStateBytes: 300,
+		VisibilityBytes:   100,
+	}), nil)
+	_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	var resourceExhausted *serviceerror.ResourceExhausted
+	s.ErrorAs(err, &resourceExhausted)
+	s.Equal(enumspb.RESOURCE_EXHAUSTED_CAUSE_PERSISTENCE_LIMIT, resourceExhausted.Cause)
+
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.testNamespace).Return(newNamespaceEntry(namespace.StorageUsage{
+		HistoryBytes: 999,
+	}), nil)
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(&historyservice.StartWorkflowExecutionResponse{}, nil)
+	_, err = wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	s.NoError(err)
+}
+
 func (s *workflowHandlerSuite) TestRegisterNamespace_Failure_InvalidArchivalURI() {
 	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(false)
 	s.mockArchivalMetadata.EXPECT().GetHistoryConfig().Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI"))
@@ -1916,6 +1965,66 @@ func (s *workflowHandlerSuite) TestListWorkflowExecutions() {
 	s.Equal(query, listRequest.GetQuery())
 }
 
+func (s *workflowHandlerSuite) TestListWorkflowExecutions_StrongConsistency() {
+	config := s.newConfig()
+	config.VisibilityStrongConsistencyEnabled = dc.GetBoolPropertyFnFilteredByNamespace(true)
+	wh := s.getWorkflowHandler(config)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil).AnyTimes()
+
+	ctx := metadata.NewIncomingContext(
+		context.Background(),
+		metadata.Pairs(headers.VisibilityConsistencyHeaderName, headers.VisibilityConsistencyStrong),
+	)
+	listRequest := &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace: s.testNamespace.String(),
+		PageSize:  10,
+		Query:     "WorkflowId = 'wid'",
+	}
+	expectedRequest := &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:       s.testNamespaceID,
+		Namespace:         s.testNamespace,
+		PageSize:          10,
+		Query:             "WorkflowId = 'wid'",
+		StrongConsistency: true,
+	}
+	// With a workflow ID, only the visibility queue of the workflow's shard is waited for.
+	shardID := common.WorkflowIDToHistoryShard(s.testNamespaceID.String(), "wid", numHistoryShards)
+	gomock.InOrder(
+		s.mockHistoryClient.EXPECT().AwaitVisibilityIngestion(gomock.Any(), &historyservice.AwaitVisibilityIngestionRequest{
+			ShardId: shardID,
+		}).Return(&historyservice.AwaitVisibilityIngestionResponse{}, nil),
+		s.mockVisibilityMgr.EXPECT().ListWorkflowExecutions(gomock.Any(), expectedRequest).Return(&manager.ListWorkflowExecutionsResponse{
+			Executions: []*workflowpb.WorkflowExecutionInfo{{
+				Execution: &commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "rid"},
+				Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			}},
+		}, nil),
+	)
+	resp, err := wh.ListWorkflowExecutions(ctx, listRequest)
+	s.NoError(err)
+	s.Len(resp.Executions, 1)
+
+	// Without a workflow ID, the visibility queues of all shards are waited for.
+	listRequest.Query = "ExecutionStatus = 'Running'"
+	expectedRequest.Query = listRequest.Query
+	s.mockHistoryClient.EXPECT().AwaitVisibilityIngestion(gomock.Any(), gomock.Any()).Return(&historyservice.AwaitVisibilityIngestionResponse{}, nil).Times(numHistoryShards)
+	s.mockVisibilityMgr.EXPECT().ListWorkflowExecutions(gomock.Any(), expectedRequest).Return(&manager.ListWorkflowExecutionsResponse{}, nil)
+	_, err = wh.ListWorkflowExecutions(ctx, listRequest)
+	s.NoError(err)
+
+	// A stale list isn't returned when visibility doesn't catch up in time.
+	s.mockHistoryClient.EXPECT().AwaitVisibilityIngestion(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewDeadlineExceeded("")).MinTimes(1)
+	_, err = wh.ListWorkflowExecutions(ctx, listRequest)
+	s.ErrorAs(err, new(*serviceerror.DeadlineExceeded))
+
+	// The header is ignored when strong consistency isn't enabled.
+	config.VisibilityStrongConsistencyEnabled = dc.GetBoolPropertyFnFilteredByNamespace(false)
+	expectedRequest.StrongConsistency = false
+	s.mockVisibilityMgr.EXPECT().ListWorkflowExecutions(gomock.Any(), expectedRequest).Return(&manager.ListWorkflowExecutionsResponse{}, nil)
+	_, err = wh.ListWorkflowExecutions(ctx, listRequest)
+	s.NoError(err)
+}
+
 func (s *workflowHandlerSuite) TestScanWorkflowExecutions() {
 	config := s.newConfig()
 	wh := s.getWorkflowHandler(config)
@@ -2063,6 +2172,18 @@ func (s *workflowHandlerSuite) TestGetSystemInfo() {
 	s.True(resp.Capabilities.SupportsSchedules)
 	s.True(resp.Capabilities.EncodedFailureAttributes)
 	s.True(resp.Capabilities.UpsertMemo)
+	s.False(resp.Capabilities.EagerWorkflowStart)
+}
+
+func (s *workflowHandlerSuite) TestGetWorkerBuildIdCompatibility_FeatureDisabled() {
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	_, err := wh.GetWorkerBuildIdCompatibility(context.Background(), &workflowservice.GetWorkerBuildIdCompatibilityRequest{
+		Namespace: s.testNamespace.String(),
+		TaskQueue: "test-task-queue",
+	})
+	var unimplemented *serviceerror.Unimplemented
+	s.ErrorAs(err, &unimplemented)
 }
 
 func (s *workflowHandlerSuite) TestStartBatchOperation_Terminate() {
@@ -92,6 +92,7 @@ func GrpcServerOptionsProvider(
 	rpcFactory common.RPCFactory,
 	retryableInterceptor *interceptor.RetryableInterceptor,
 	telemetryInterceptor *interceptor.TelemetryInterceptor,
+	slowRequestLogInterceptor *interceptor.SlowRequestLogInterceptor,
 	rateLimitInterceptor *interceptor.RateLimitInterceptor,
 	tracingInterceptor telemetry.ServerTraceInterceptor,
 ) []grpc.ServerOption {
@@ -109,6 +110,7 @@ func GrpcServerOptionsProvider(
 			metrics.NewServerMetricsContextInjectorInterceptor(),
 			metrics.NewServerMetricsTrailerPropagatorInterceptor(logger),
 			telemetryInterceptor.UnaryIntercept,
+			slowRequestLogInterceptor.Intercept,
 			rateLimitInterceptor.Intercept,
 			retryableInterceptor.Intercept,
 		),
@@ -0,0 +1,78 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package awaitvisibilityingestion
+
+import (
+	"context"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/util"
+	"go.temporal.io/server/service/history/queues"
+	"go.temporal.io/server/service/history/shard"
+)
+
+const (
+	minPollInterval = 50 * time.Millisecond
+	maxPollInterval = time.Second
+)
+
+var errVisibilityIngestionDeadline = serviceerror.NewDeadlineExceeded("Visibility ingestion of the shard did not catch up before the deadline.")
+
+// Invoke waits until the visibility queue has completed all tasks written to the shard before the call,
+// so that the visibility records of all workflow updates acknowledged before the call are written.
+func Invoke(
+	ctx context.Context,
+	shardContext shard.Context,
+	visibilityQueue queues.AckLevelQueue,
+) (*historyservice.AwaitVisibilityIngestionResponse, error) {
+	// All tasks below the read watermark are persisted, including the tasks of every acknowledged update.
+	target := shardContext.GetImmediateQueueExclusiveHighReadWatermark()
+
+	interval := minPollInterval
+	for {
+		ackLevel, err := visibilityQueue.ExclusiveAckLevel(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, errVisibilityIngestionDeadline
+			}
+			return nil, err
+		}
+		if ackLevel.CompareTo(target) >= 0 {
+			return &historyservice.AwaitVisibilityIngestionResponse{}, nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, errVisibilityIngestionDeadline
+		case <-timer.C:
+		}
+		interval = util.Min(2*interval, maxPollInterval)
+	}
+}
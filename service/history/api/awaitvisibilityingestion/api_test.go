@@ -0,0 +1,62 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package awaitvisibilityingestion
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/service/history/queues"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+func TestInvoke(t *testing.T) {
+	controller := gomock.NewController(t)
+	shardContext := shard.NewMockContext(controller)
+	visibilityQueue := queues.NewMockAckLevelQueue(controller)
+
+	shardContext.EXPECT().GetImmediateQueueExclusiveHighReadWatermark().Return(tasks.NewImmediateKey(100)).AnyTimes()
+
+	// The queue catches up with the tasks written before the call on the second poll.
+	gomock.InOrder(
+		visibilityQueue.EXPECT().ExclusiveAckLevel(gomock.Any()).Return(tasks.NewImmediateKey(90), nil),
+		visibilityQueue.EXPECT().ExclusiveAckLevel(gomock.Any()).Return(tasks.NewImmediateKey(100), nil),
+	)
+	_, err := Invoke(context.Background(), shardContext, visibilityQueue)
+	require.NoError(t, err)
+
+	// The queue doesn't catch up before the deadline.
+	visibilityQueue.EXPECT().ExclusiveAckLevel(gomock.Any()).Return(tasks.NewImmediateKey(90), nil).AnyTimes()
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	_, err = Invoke(ctx, shardContext, visibilityQueue)
+	require.ErrorAs(t, err, new(*serviceerror.DeadlineExceeded))
+}
@@ -92,7 +92,7 @@ func Invoke(
 
 					return api.UpdateWorkflowWithoutWorkflowTask, workflow.TerminateWorkflow(
 						mutableState,
-						"Delete workflow execution",
+						consts.TerminateReasonDeleteWorkflow,
 						nil,
 						consts.IdentityHistoryService,
 						true,
@@ -0,0 +1,64 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package describeshard
+
+import (
+	"sort"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/service/history/queues"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+// Invoke reports the tasks the queues of the shard hold in memory. Unlike the persisted shard info, the counts
+// are live as of the call.
+func Invoke(
+	shardContext shard.Context,
+	queueProcessors map[tasks.Category]queues.Queue,
+) (*historyservice.DescribeShardResponse, error) {
+	categories := make([]tasks.Category, 0, len(queueProcessors))
+	for category := range queueProcessors {
+		categories = append(categories, category)
+	}
+	sort.Slice(categories, func(i, j int) bool { return categories[i].ID() < categories[j].ID() })
+
+	resp := &historyservice.DescribeShardResponse{
+		Owner: shardContext.GetOwner(),
+	}
+	for _, category := range categories {
+		queue, ok := queueProcessors[category].(queues.PendingTaskQueue)
+		if !ok {
+			continue
+		}
+		resp.Queues = append(resp.Queues, &adminservice.ShardQueueState{
+			Category:         category.Name(),
+			PendingTaskCount: int32(queue.PendingTaskCount()),
+			SliceCount:       int32(queue.SliceCount()),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,64 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package describeshard
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/service/history/queues"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+func TestInvoke(t *testing.T) {
+	controller := gomock.NewController(t)
+	shardContext := shard.NewMockContext(controller)
+	shardContext.EXPECT().GetOwner().Return("host").AnyTimes()
+
+	transferQueue := queues.NewMockPendingTaskQueue(controller)
+	transferQueue.EXPECT().PendingTaskCount().Return(10)
+	transferQueue.EXPECT().SliceCount().Return(2)
+	timerQueue := queues.NewMockPendingTaskQueue(controller)
+	timerQueue.EXPECT().PendingTaskCount().Return(5)
+	timerQueue.EXPECT().SliceCount().Return(1)
+	// Queues which don't report their pending tasks are skipped.
+	visibilityQueue := queues.NewMockQueue(controller)
+
+	resp, err := Invoke(shardContext, map[tasks.Category]queues.Queue{
+		tasks.CategoryTimer:      timerQueue,
+		tasks.CategoryTransfer:   transferQueue,
+		tasks.CategoryVisibility: visibilityQueue,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "host", resp.GetOwner())
+	require.Equal(t, []*adminservice.ShardQueueState{
+		{Category: tasks.CategoryTransfer.Name(), PendingTaskCount: 10, SliceCount: 2},
+		{Category: tasks.CategoryTimer.Name(), PendingTaskCount: 5, SliceCount: 1},
+	}, resp.GetQueues())
+}
@@ -0,0 +1,269 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package rehydrateworkflow imports the archived history of a closed workflow execution back into
+// the persistence store, so that it can be described, queried, reset or replayed through the
+// regular APIs. It is served by the history Handler.RehydrateWorkflowExecution method, whose
+// gRPC and admin counterparts require RehydrateWorkflowExecution to be defined in the
+// historyservice and adminservice APIs.
+package rehydrateworkflow
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/ndc"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/history/workflow"
+)
+
+var (
+	errHistoryNotSet        = serviceerror.NewInvalidArgument("History is not set on request.")
+	errHistoryNotStarted    = serviceerror.NewInvalidArgument("History does not start with a WorkflowExecutionStarted event.")
+	errHistoryNotClosed     = serviceerror.NewInvalidArgument("History does not end with a workflow execution close event.")
+	errHistoryNotContiguous = serviceerror.NewInvalidArgument("History event IDs are not contiguous.")
+)
+
+// Invoke writes the history batches of a closed workflow execution under the given run ID and rebuilds its
+// mutable state from them. The run becomes the current run of the workflow if the workflow has no current
+// run, otherwise the current run is left untouched. Rehydrated executions are neither archived again nor
+// deleted before the namespace retention elapses from the time they are rehydrated.
+func Invoke(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	workflowID string,
+	runID string,
+	historyBatches []*historypb.History,
+	shardContext shard.Context,
+) (retError error) {
+	namespaceEntry, err := api.GetActiveNamespace(shardContext, namespaceID)
+	if err != nil {
+		return err
+	}
+	if err := validateHistory(historyBatches); err != nil {
+		return err
+	}
+
+	workflowKey := definition.NewWorkflowKey(namespaceID.String(), workflowID, runID)
+	_, err = shardContext.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shardContext.GetShardID(),
+		NamespaceID: workflowKey.NamespaceID,
+		WorkflowID:  workflowKey.WorkflowID,
+		RunID:       workflowKey.RunID,
+	})
+	switch err.(type) {
+	case nil:
+		return serviceerror.NewAlreadyExist(fmt.Sprintf("workflow %s run %s already exists", workflowID, runID))
+	case *serviceerror.NotFound:
+	default:
+		return err
+	}
+	createMode := persistence.CreateWorkflowModeBypassCurrent
+	_, err = shardContext.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     shardContext.GetShardID(),
+		NamespaceID: workflowKey.NamespaceID,
+		WorkflowID:  workflowKey.WorkflowID,
+	})
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		createMode = persistence.CreateWorkflowModeBrandNew
+	default:
+		return err
+	}
+
+	var retention *time.Duration
+	if duration := namespaceEntry.Retention(); duration > 0 {
+		retention = &duration
+	}
+	branchToken, err := shardContext.GetExecutionManager().GetHistoryBranchUtil().NewHistoryBranch(
+		workflowKey.NamespaceID,
+		runID,
+		nil,
+		[]*persistencespb.HistoryBranchRange{},
+		nil,
+		nil,
+		retention,
+	)
+	if err != nil {
+		return err
+	}
+	historySize, err := appendHistory(ctx, shardContext, workflowKey, branchToken, historyBatches)
+	defer func() {
+		if retError == nil {
+			return
+		}
+		// the branch of a failed rehydration is never referenced by a mutable state
+		if err := shardContext.GetExecutionManager().DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
+			ShardID:     shardContext.GetShardID(),
+			BranchToken: branchToken,
+		}); err != nil {
+			shardContext.GetLogger().Warn("Failed to delete history branch of failed rehydration.",
+				tag.WorkflowNamespaceID(workflowKey.NamespaceID),
+				tag.WorkflowID(workflowKey.WorkflowID),
+				tag.WorkflowRunID(workflowKey.RunID),
+				tag.Error(err))
+		}
+	}()
+	if err != nil {
+		return err
+	}
+
+	lastBatch := historyBatches[len(historyBatches)-1]
+	lastEvent := lastBatch.Events[len(lastBatch.Events)-1]
+	now := shardContext.GetTimeSource().Now()
+	mutableState, _, err := ndc.NewStateRebuilder(shardContext, shardContext.GetLogger()).Rebuild(
+		ctx,
+		now,
+		workflowKey,
+		branchToken,
+		lastEvent.GetEventId(),
+		convert.Int64Ptr(lastEvent.GetVersion()),
+		workflowKey,
+		branchToken,
+		primitives.NewUUID().String(),
+	)
+	if err != nil {
+		return err
+	}
+	mutableState.AddHistorySize(historySize)
+
+	// The tasks regenerated for the close of the execution would archive it again and delete it right away, as
+	// it closed longer than the retention ago. Its visibility record is rewritten and its retention restarted.
+	mutableState.PopTasks()
+	mutableState.AddTasks(&tasks.CloseExecutionVisibilityTask{
+		// TaskID, VisibilityTimestamp is set by shard
+		WorkflowKey: workflowKey,
+		Version:     mutableState.GetCurrentVersion(),
+	})
+	taskGenerator := workflow.NewTaskGenerator(
+		shardContext.GetNamespaceRegistry(),
+		mutableState,
+		shardContext.GetConfig(),
+		shardContext.GetArchivalMetadata(),
+	)
+	if err := taskGenerator.GenerateDeleteHistoryEventTask(now, true); err != nil {
+		return err
+	}
+
+	snapshot, eventsSeq, err := mutableState.CloseTransactionAsSnapshot(workflow.TransactionPolicyPassive)
+	if err != nil {
+		return err
+	}
+	if len(eventsSeq) != 0 {
+		return serviceerror.NewInternal("rehydrated mutable state has unpersisted events")
+	}
+	return workflow.NewContext(shardContext, workflowKey, shardContext.GetLogger()).CreateWorkflowExecution(
+		ctx,
+		createMode,
+		"",
+		0,
+		mutableState,
+		snapshot,
+		nil,
+	)
+}
+
+func validateHistory(historyBatches []*historypb.History) error {
+	if len(historyBatches) == 0 {
+		return errHistoryNotSet
+	}
+	expectedEventID := int64(1)
+	for _, batch := range historyBatches {
+		if len(batch.Events) == 0 {
+			return errHistoryNotSet
+		}
+		for _, event := range batch.Events {
+			if event.GetEventId() != expectedEventID {
+				return errHistoryNotContiguous
+			}
+			expectedEventID++
+		}
+	}
+	if historyBatches[0].Events[0].GetEventType() != enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED {
+		return errHistoryNotStarted
+	}
+	lastBatch := historyBatches[len(historyBatches)-1]
+	switch lastBatch.Events[len(lastBatch.Events)-1].GetEventType() {
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+		return nil
+	default:
+		return errHistoryNotClosed
+	}
+}
+
+// appendHistory writes each history batch as a transaction of a new branch and returns the size of the history.
+func appendHistory(
+	ctx context.Context,
+	shardContext shard.Context,
+	workflowKey definition.WorkflowKey,
+	branchToken []byte,
+	historyBatches []*historypb.History,
+) (int64, error) {
+	transactionIDs, err := shardContext.GenerateTaskIDs(len(historyBatches))
+	if err != nil {
+		return 0, err
+	}
+	var historySize int64
+	var prevTransactionID int64
+	for i, batch := range historyBatches {
+		size, err := shardContext.AppendHistoryEventsBatched(ctx, &persistence.AppendHistoryNodesRequest{
+			IsNewBranch:       i == 0,
+			Info:              persistence.BuildHistoryGarbageCleanupInfo(workflowKey.NamespaceID, workflowKey.WorkflowID, workflowKey.RunID),
+			BranchToken:       branchToken,
+			Events:            batch.Events,
+			PrevTransactionID: prevTransactionID,
+			TransactionID:     transactionIDs[i],
+		}, namespace.ID(workflowKey.NamespaceID), commonpb.WorkflowExecution{
+			WorkflowId: workflowKey.WorkflowID,
+			RunId:      workflowKey.RunID,
+		})
+		if err != nil {
+			return 0, err
+		}
+		historySize += int64(size)
+		prevTransactionID = transactionIDs[i]
+	}
+	return historySize, nil
+}
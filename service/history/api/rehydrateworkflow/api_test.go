@@ -0,0 +1,66 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rehydrateworkflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+)
+
+func newTestHistory(eventTypes ...[]enumspb.EventType) []*historypb.History {
+	var historyBatches []*historypb.History
+	eventID := int64(1)
+	for _, batchEventTypes := range eventTypes {
+		batch := &historypb.History{}
+		for _, eventType := range batchEventTypes {
+			batch.Events = append(batch.Events, &historypb.HistoryEvent{EventId: eventID, EventType: eventType})
+			eventID++
+		}
+		historyBatches = append(historyBatches, batch)
+	}
+	return historyBatches
+}
+
+func TestValidateHistory(t *testing.T) {
+	started := enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED
+	scheduled := enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED
+	completed := enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED
+	continuedAsNew := enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW
+
+	require.NoError(t, validateHistory(newTestHistory([]enumspb.EventType{started, scheduled}, []enumspb.EventType{completed})))
+	require.NoError(t, validateHistory(newTestHistory([]enumspb.EventType{started, continuedAsNew})))
+
+	require.Equal(t, errHistoryNotSet, validateHistory(nil))
+	require.Equal(t, errHistoryNotSet, validateHistory(newTestHistory([]enumspb.EventType{started}, nil)))
+	require.Equal(t, errHistoryNotStarted, validateHistory(newTestHistory([]enumspb.EventType{scheduled, completed})))
+	require.Equal(t, errHistoryNotClosed, validateHistory(newTestHistory([]enumspb.EventType{started, scheduled})))
+
+	historyBatches := newTestHistory([]enumspb.EventType{started}, []enumspb.EventType{scheduled, completed})
+	historyBatches[1].Events[0].EventId = 3
+	require.Equal(t, errHistoryNotContiguous, validateHistory(historyBatches))
+}
@@ -47,6 +47,7 @@ func MergeDLQ(
 	token, err := replicationDLQHandler.MergeMessages(
 		ctx,
 		request.GetSourceCluster(),
+		request.GetNamespaceId(),
 		request.GetInclusiveEndMessageId(),
 		int(request.GetMaximumPageSize()),
 		request.GetNextPageToken(),
@@ -47,6 +47,7 @@ func PurgeDLQ(
 	if err := replicationDLQHandler.PurgeMessages(
 		ctx,
 		request.GetSourceCluster(),
+		request.GetNamespaceId(),
 		request.GetInclusiveEndMessageId(),
 	); err != nil {
 		return nil, err
@@ -283,6 +283,8 @@ func addWorkflowTaskToMatching(
 		ScheduleToStartTimeout: wtScheduleToStartTimeout,
 		Clock:                  clock,
 		VersionDirective:       directive,
+		// The speculative workflow task delivers the update, which the caller is waiting for.
+		Priority: true,
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,147 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package updateworkflowmemo modifies the memo of a workflow execution without scheduling a
+// workflow task. It serves the UpdateWorkflowExecutionMemo history API, which operators call
+// through the admin service.
+package updateworkflowmemo
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"golang.org/x/exp/maps"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/rpc/interceptor"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/shard"
+)
+
+var (
+	errMemoNotSet = serviceerror.NewInvalidArgument("Memo is not set on request.")
+)
+
+// Invoke merges upsertMemo into the memo of the given execution and returns the resulting memo.
+// Fields set to a nil or empty payload are removed. Running and closed-but-retained executions
+// are both supported. The identity and reason of the caller are logged with the update, so that
+// operational annotations can be audited.
+func Invoke(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	execution *commonpb.WorkflowExecution,
+	upsertMemo *commonpb.Memo,
+	identity string,
+	reason string,
+	shard shard.Context,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (*commonpb.Memo, error) {
+	namespaceEntry, err := api.GetActiveNamespace(shard, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	if len(upsertMemo.GetFields()) == 0 {
+		return nil, errMemoNotSet
+	}
+
+	var merged *commonpb.Memo
+	var runID string
+	err = api.GetAndUpdateWorkflowWithNew(
+		ctx,
+		nil,
+		api.BypassMutableStateConsistencyPredicate,
+		definition.NewWorkflowKey(
+			namespaceEntry.ID().String(),
+			execution.GetWorkflowId(),
+			execution.GetRunId(),
+		),
+		func(workflowContext api.WorkflowContext) (*api.UpdateWorkflowAction, error) {
+			mutableState := workflowContext.GetMutableState()
+
+			merged = &commonpb.Memo{
+				Fields: payload.MergeMapOfPayload(mutableState.GetExecutionInfo().GetMemo(), upsertMemo.GetFields()),
+			}
+			runID = mutableState.GetExecutionState().GetRunId()
+			if err := validateMemoSize(ctx, shard, namespaceEntry.Name(), execution.GetWorkflowId(), merged); err != nil {
+				return nil, err
+			}
+
+			if err := mutableState.UpsertMemo(upsertMemo); err != nil {
+				return nil, err
+			}
+			return api.UpdateWorkflowWithoutWorkflowTask, nil
+		},
+		nil,
+		shard,
+		workflowConsistencyChecker,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	shard.GetLogger().Info("Workflow memo updated",
+		tag.WorkflowNamespaceID(namespaceID.String()),
+		tag.WorkflowID(execution.GetWorkflowId()),
+		tag.WorkflowRunID(runID),
+		tag.NewStringTag("identity", identity),
+		tag.NewStringTag("reason", reason),
+		tag.NewStringsTag("memo-fields", maps.Keys(upsertMemo.GetFields())),
+	)
+	return merged, nil
+}
+
+func validateMemoSize(
+	ctx context.Context,
+	shard shard.Context,
+	namespaceName namespace.Name,
+	workflowID string,
+	memo *commonpb.Memo,
+) error {
+	config := shard.GetConfig()
+	handler := interceptor.GetMetricsHandlerFromContext(ctx, shard.GetLogger()).
+		WithTags(metrics.OperationTag(metrics.HistoryUpdateWorkflowExecutionMemoScope))
+	memoSize := memo.Size()
+	handler.Histogram(metrics.MemoSize.GetMetricName(), metrics.MemoSize.GetMetricUnit()).Record(int64(memoSize))
+
+	if err := common.CheckEventBlobSizeLimit(
+		memoSize,
+		config.MemoSizeLimitWarn(namespaceName.String()),
+		config.MemoSizeLimitError(namespaceName.String()),
+		namespaceName.String(),
+		workflowID,
+		"",
+		handler,
+		shard.GetThrottledLogger(),
+		tag.BlobSizeViolationOperation(metrics.HistoryUpdateWorkflowExecutionMemoScope),
+	); err != nil {
+		return common.ErrMemoSizeExceedsLimit
+	}
+	return nil
+}
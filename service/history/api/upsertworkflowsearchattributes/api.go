@@ -0,0 +1,136 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package upsertworkflowsearchattributes modifies the search attributes of a workflow execution
+// without scheduling a workflow task. It serves the UpsertWorkflowExecutionSearchAttributes history
+// API, which operators and the batcher call through the admin service to backfill attributes.
+package upsertworkflowsearchattributes
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"golang.org/x/exp/maps"
+
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/shard"
+)
+
+var (
+	errSearchAttributesNotSet = serviceerror.NewInvalidArgument("SearchAttributes is not set on request.")
+)
+
+// Invoke merges upsertSearchAttributes into the search attributes of the given execution. Fields
+// set to a nil or empty payload are removed. Aliased names are resolved and the values are
+// validated the same way as for an UpsertWorkflowSearchAttributes command. Running and
+// closed-but-retained executions are both supported. The identity and reason of the caller are
+// logged with the update.
+func Invoke(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	execution *commonpb.WorkflowExecution,
+	upsertSearchAttributes *commonpb.SearchAttributes,
+	identity string,
+	reason string,
+	shard shard.Context,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	searchAttributesValidator *searchattribute.Validator,
+) error {
+	namespaceEntry, err := api.GetActiveNamespace(shard, namespaceID)
+	if err != nil {
+		return err
+	}
+	if len(upsertSearchAttributes.GetIndexedFields()) == 0 {
+		return errSearchAttributesNotSet
+	}
+
+	namespaceName := namespaceEntry.Name().String()
+	unaliasedSearchAttributes, err := searchattribute.UnaliasFields(
+		shard.GetSearchAttributesMapperProvider(),
+		upsertSearchAttributes,
+		namespaceName,
+	)
+	if err != nil {
+		return err
+	}
+	if unaliasedSearchAttributes != nil {
+		upsertSearchAttributes = unaliasedSearchAttributes
+	}
+	if err := searchAttributesValidator.Validate(upsertSearchAttributes, namespaceName); err != nil {
+		return err
+	}
+
+	var runID string
+	err = api.GetAndUpdateWorkflowWithNew(
+		ctx,
+		nil,
+		api.BypassMutableStateConsistencyPredicate,
+		definition.NewWorkflowKey(
+			namespaceEntry.ID().String(),
+			execution.GetWorkflowId(),
+			execution.GetRunId(),
+		),
+		func(workflowContext api.WorkflowContext) (*api.UpdateWorkflowAction, error) {
+			mutableState := workflowContext.GetMutableState()
+			runID = mutableState.GetExecutionState().GetRunId()
+
+			merged := &commonpb.SearchAttributes{
+				IndexedFields: payload.MergeMapOfPayload(
+					mutableState.GetExecutionInfo().GetSearchAttributes(),
+					upsertSearchAttributes.GetIndexedFields(),
+				),
+			}
+			if err := searchAttributesValidator.ValidateSize(merged, namespaceName); err != nil {
+				return nil, err
+			}
+
+			if err := mutableState.UpsertSearchAttributes(upsertSearchAttributes); err != nil {
+				return nil, err
+			}
+			return api.UpdateWorkflowWithoutWorkflowTask, nil
+		},
+		nil,
+		shard,
+		workflowConsistencyChecker,
+	)
+	if err != nil {
+		return err
+	}
+
+	shard.GetLogger().Info("Workflow search attributes updated",
+		tag.WorkflowNamespaceID(namespaceID.String()),
+		tag.WorkflowID(execution.GetWorkflowId()),
+		tag.WorkflowRunID(runID),
+		tag.NewStringTag("identity", identity),
+		tag.NewStringTag("reason", reason),
+		tag.NewStringsTag("search-attributes", maps.Keys(upsertSearchAttributes.GetIndexedFields())),
+	)
+	return nil
+}
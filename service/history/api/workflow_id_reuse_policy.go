@@ -65,7 +65,7 @@ func ApplyWorkflowIDReusePolicy(
 
 				return UpdateWorkflowWithoutWorkflowTask, workflow.TerminateWorkflow(
 					mutableState,
-					"TerminateIfRunning WorkflowIdReusePolicy Policy",
+					consts.TerminateReasonIDReusePolicy,
 					payloads.EncodeString(
 						fmt.Sprintf("terminated by new runID: %s", runID),
 					),
@@ -97,6 +97,7 @@ type (
 		metricsHandler          metrics.Handler
 		logger                  log.Logger
 		rateLimiter             quotas.RateLimiter
+		namespaceLimiter        NamespaceLimiter
 		searchAttributeProvider searchattribute.Provider
 		visibilityManager       manager.VisibilityManager
 	}
@@ -113,6 +114,7 @@ func NewArchiver(
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 	rateLimiter quotas.RateLimiter,
+	namespaceLimiter NamespaceLimiter,
 	searchAttributeProvider searchattribute.Provider,
 	visibilityManger manager.VisibilityManager,
 ) Archiver {
@@ -121,6 +123,7 @@ func NewArchiver(
 		metricsHandler:          metricsHandler.WithTags(metrics.OperationTag(metrics.ArchiverClientScope)),
 		logger:                  logger,
 		rateLimiter:             rateLimiter,
+		namespaceLimiter:        namespaceLimiter,
 		searchAttributeProvider: searchAttributeProvider,
 		visibilityManager:       visibilityManger,
 	}
@@ -157,6 +160,12 @@ func (a *archiver) Archive(ctx context.Context, request *Request) (res *Response
 			Record(time.Since(start), metrics.StringTag("status", status))
 	}(time.Now())
 
+	release, err := a.namespaceLimiter.Acquire(request.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	numTargets := len(request.Targets)
 	if err := a.rateLimiter.WaitN(ctx, numTargets); err != nil {
 		return nil, &serviceerror.ResourceExhausted{
@@ -38,6 +38,7 @@ import (
 	"go.temporal.io/api/common/v1"
 	carchiver "go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/payload"
@@ -223,6 +224,8 @@ func TestArchiver(t *testing.T) {
 					ArchivalBackendMaxRPS: func() float64 {
 						return 42.0
 					},
+					ArchivalNamespaceMaxRPS:         dynamicconfig.GetFloatPropertyFilteredByNamespace(0),
+					ArchivalNamespaceMaxConcurrency: dynamicconfig.GetIntPropertyFilteredByNamespace(0),
 				}),
 				Module,
 				fx.Decorate(func(rl quotas.RateLimiter) quotas.RateLimiter {
@@ -36,4 +36,7 @@ var Module = fx.Options(
 	fx.Provide(func(config *configs.Config) quotas.RateLimiter {
 		return quotas.NewDefaultOutgoingRateLimiter(quotas.RateFn(config.ArchivalBackendMaxRPS))
 	}),
+	fx.Provide(func(config *configs.Config) NamespaceLimiter {
+		return NewNamespaceLimiter(config.ArchivalNamespaceMaxRPS, config.ArchivalNamespaceMaxConcurrency)
+	}),
 )
@@ -0,0 +1,110 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archival
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/quotas"
+)
+
+type (
+	// NamespaceLimiter limits the rate and the number of in flight archival requests of each namespace, so that a
+	// namespace with a slow or failing archival backend can't use all the archival capacity of a host.
+	NamespaceLimiter interface {
+		// Acquire admits an archival request of the namespace, and returns a function which must be called once the
+		// request is done. It returns a serviceerror.ResourceExhausted error if the request exceeds the limits of the
+		// namespace.
+		Acquire(namespace string) (release func(), err error)
+	}
+
+	namespaceLimiter struct {
+		maxRPS         dynamicconfig.FloatPropertyFnWithNamespaceFilter
+		maxConcurrency dynamicconfig.IntPropertyFnWithNamespaceFilter
+		rateLimiter    quotas.RequestRateLimiter
+
+		sync.Mutex
+		inFlight map[string]int
+	}
+)
+
+const namespaceLimiterAPI = "Archive"
+
+// NewNamespaceLimiter creates a NamespaceLimiter with the given per-namespace limits, a limit of 0 means no limit.
+func NewNamespaceLimiter(
+	maxRPS dynamicconfig.FloatPropertyFnWithNamespaceFilter,
+	maxConcurrency dynamicconfig.IntPropertyFnWithNamespaceFilter,
+) NamespaceLimiter {
+	return &namespaceLimiter{
+		maxRPS:         maxRPS,
+		maxConcurrency: maxConcurrency,
+		rateLimiter: quotas.NewNamespaceRequestRateLimiter(func(req quotas.Request) quotas.RequestRateLimiter {
+			return quotas.NewRequestRateLimiterAdapter(quotas.NewDefaultOutgoingRateLimiter(func() float64 {
+				return maxRPS(req.Caller)
+			}))
+		}),
+		inFlight: make(map[string]int),
+	}
+}
+
+func (l *namespaceLimiter) Acquire(namespace string) (func(), error) {
+	if l.maxRPS(namespace) > 0 &&
+		!l.rateLimiter.Allow(time.Now(), quotas.NewRequest(namespaceLimiterAPI, 1, namespace, "", 0, "")) {
+		return nil, &serviceerror.ResourceExhausted{
+			Cause:   enumspb.RESOURCE_EXHAUSTED_CAUSE_RPS_LIMIT,
+			Message: fmt.Sprintf("archival rate limit of namespace %s exceeded", namespace),
+		}
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	if maxConcurrency := l.maxConcurrency(namespace); maxConcurrency > 0 && l.inFlight[namespace] >= maxConcurrency {
+		return nil, &serviceerror.ResourceExhausted{
+			Cause:   enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT,
+			Message: fmt.Sprintf("archival concurrency limit of namespace %s exceeded", namespace),
+		}
+	}
+	l.inFlight[namespace]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.Lock()
+			defer l.Unlock()
+
+			l.inFlight[namespace]--
+			if l.inFlight[namespace] == 0 {
+				delete(l.inFlight, namespace)
+			}
+		})
+	}, nil
+}
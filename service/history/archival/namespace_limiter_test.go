@@ -0,0 +1,107 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archival
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+func TestNamespaceLimiter_NoLimits(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewNamespaceLimiter(
+		dynamicconfig.GetFloatPropertyFilteredByNamespace(0),
+		dynamicconfig.GetIntPropertyFilteredByNamespace(0),
+	)
+	for i := 0; i < 100; i++ {
+		_, err := limiter.Acquire("namespace")
+		require.NoError(t, err)
+	}
+}
+
+func TestNamespaceLimiter_Concurrency(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewNamespaceLimiter(
+		dynamicconfig.GetFloatPropertyFilteredByNamespace(0),
+		func(namespace string) int {
+			if namespace == "limited" {
+				return 2
+			}
+			return 0
+		},
+	)
+
+	release1, err := limiter.Acquire("limited")
+	require.NoError(t, err)
+	release2, err := limiter.Acquire("limited")
+	require.NoError(t, err)
+
+	_, err = limiter.Acquire("limited")
+	var resourceExhausted *serviceerror.ResourceExhausted
+	require.ErrorAs(t, err, &resourceExhausted)
+	assert.Equal(t, enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT, resourceExhausted.Cause)
+
+	// other namespaces are not affected
+	_, err = limiter.Acquire("other")
+	require.NoError(t, err)
+
+	// releasing twice only frees one request
+	release1()
+	release1()
+	_, err = limiter.Acquire("limited")
+	require.NoError(t, err)
+	_, err = limiter.Acquire("limited")
+	require.Error(t, err)
+
+	release2()
+	_, err = limiter.Acquire("limited")
+	require.NoError(t, err)
+}
+
+func TestNamespaceLimiter_Rate(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewNamespaceLimiter(
+		dynamicconfig.GetFloatPropertyFilteredByNamespace(1),
+		dynamicconfig.GetIntPropertyFilteredByNamespace(0),
+	)
+
+	release, err := limiter.Acquire("namespace")
+	require.NoError(t, err)
+	release()
+
+	_, err = limiter.Acquire("namespace")
+	var resourceExhausted *serviceerror.ResourceExhausted
+	require.ErrorAs(t, err, &resourceExhausted)
+	assert.Equal(t, enumspb.RESOURCE_EXHAUSTED_CAUSE_RPS_LIMIT, resourceExhausted.Cause)
+}
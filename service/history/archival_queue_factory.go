@@ -31,6 +31,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
 	ctasks "go.temporal.io/server/common/tasks"
 	"go.temporal.io/server/service/history/archival"
 	"go.temporal.io/server/service/history/configs"
@@ -67,6 +68,8 @@ type (
 		QueueFactoryBaseParams
 		// Archiver is the archival client used to archive history events and visibility records.
 		Archiver archival.Archiver
+		// ArchivalDLQ is the queue of the archival tasks which exhausted their retries.
+		ArchivalDLQ persistence.ArchivalDLQ
 		// RelocatableAttributesFetcher is the client used to fetch the memo and search attributes of a workflow.
 		RelocatableAttributesFetcher workflow.RelocatableAttributesFetcher
 	}
@@ -137,6 +140,7 @@ func (f *archivalQueueFactory) CreateQueue(
 func (f *archivalQueueFactory) newArchivalTaskExecutor(shard shard.Context, workflowCache wcache.Cache) queues.Executor {
 	return NewArchivalQueueTaskExecutor(
 		f.Archiver,
+		f.ArchivalDLQ,
 		shard,
 		workflowCache,
 		f.RelocatableAttributesFetcher,
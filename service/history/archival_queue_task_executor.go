@@ -31,6 +31,7 @@ import (
 	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 
 	carchiver "go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/log"
@@ -39,6 +40,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/service/history/archival"
+	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/queues"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
@@ -49,9 +51,12 @@ import (
 // NewArchivalQueueTaskExecutor creates a new queue task executor for the archival queue.
 // If you use this executor, you must monitor for any metrics.ArchivalTaskInvalidURI errors.
 // If this metric is emitted, it means that an archival URI is invalid and the task will never succeed, which is a
-// serious problem because the archival queue retries tasks forever.
+// serious problem because the archival queue retries tasks forever, unless ArchivalProcessorMaxAttempts is set.
+// Tasks which fail ArchivalProcessorMaxAttempts times are moved to the archival DLQ, and the history of their workflow
+// is kept until they are retried by an operator.
 func NewArchivalQueueTaskExecutor(
 	archiver archival.Archiver,
+	archivalDLQ persistence.ArchivalDLQ,
 	shardContext shard.Context,
 	workflowCache cache.Cache,
 	relocatableAttributesFetcher workflow.RelocatableAttributesFetcher,
@@ -60,6 +65,7 @@ func NewArchivalQueueTaskExecutor(
 ) queues.Executor {
 	return &archivalQueueTaskExecutor{
 		archiver:                     archiver,
+		archivalDLQ:                  archivalDLQ,
 		shardContext:                 shardContext,
 		workflowCache:                workflowCache,
 		relocatableAttributesFetcher: relocatableAttributesFetcher,
@@ -71,6 +77,7 @@ func NewArchivalQueueTaskExecutor(
 // archivalQueueTaskExecutor is an implementation of queues.Executor for the archival queue.
 type archivalQueueTaskExecutor struct {
 	archiver                     archival.Archiver
+	archivalDLQ                  persistence.ArchivalDLQ
 	shardContext                 shard.Context
 	workflowCache                cache.Cache
 	metricsHandler               metrics.Handler
@@ -99,12 +106,63 @@ func (e *archivalQueueTaskExecutor) Execute(
 			// If either of these errors are returned, it means that we can just drop the task.
 			err = nil
 		}
+		if err != nil && e.shouldDeadLetter(executable, err) {
+			err = e.deadLetter(ctx, task, executable.Attempt(), err)
+		}
 	default:
 		err = fmt.Errorf("task with invalid type sent to archival queue: %+v", task)
 	}
 	return tags, true, err
 }
 
+// shouldDeadLetter returns true if the task failed with the given error in its last allowed attempt. Throttling
+// errors are expected to go away on their own, so they never cause a task to be dead lettered.
+func (e *archivalQueueTaskExecutor) shouldDeadLetter(executable queues.Executable, err error) bool {
+	maxAttempts := e.shardContext.GetConfig().ArchivalProcessorMaxAttempts()
+	if maxAttempts <= 0 || executable.Attempt() < maxAttempts {
+		return false
+	}
+	var resourceExhausted *serviceerror.ResourceExhausted
+	return !errors.As(err, &resourceExhausted) && !errors.Is(err, consts.ErrResourceExhaustedBusyWorkflow)
+}
+
+// deadLetter moves the task to the archival DLQ, so that the task is completed without deleting the workflow. If the
+// task can't be moved, the error of the task is returned, so that it is retried.
+func (e *archivalQueueTaskExecutor) deadLetter(
+	ctx context.Context,
+	task *tasks.ArchiveExecutionTask,
+	attempt int,
+	taskErr error,
+) error {
+	logger := log.With(e.logger, tag.Task(task), tag.Attempt(int32(attempt)))
+	messageID, err := e.archivalDLQ.Enqueue(ctx, &persistence.ArchivalDLQTask{
+		ShardID:      e.shardContext.GetShardID(),
+		NamespaceID:  task.NamespaceID,
+		WorkflowID:   task.WorkflowID,
+		RunID:        task.RunID,
+		TaskID:       task.TaskID,
+		Version:      task.Version,
+		Attempt:      attempt,
+		LastError:    taskErr.Error(),
+		EnqueuedTime: e.shardContext.GetTimeSource().Now(),
+	})
+	if err != nil {
+		logger.Error("Failed to move archival task to DLQ.", tag.Error(err))
+		return taskErr
+	}
+
+	e.metricsHandler.Counter(metrics.ArchivalTaskDeadLettered.GetMetricName()).Record(
+		1,
+		getNamespaceTagByID(e.shardContext.GetNamespaceRegistry(), task.NamespaceID),
+	)
+	logger.Error(
+		"Moved archival task to DLQ after exhausting its retries.",
+		tag.TaskID(messageID),
+		tag.Error(taskErr),
+	)
+	return nil
+}
+
 // processArchiveExecutionTask processes a tasks.ArchiveExecutionTask
 // First, we load the mutable state to populate an archival.Request.
 // Second, we unlock the mutable state and send the archival request to the archival.Archiver.
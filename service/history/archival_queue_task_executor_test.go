@@ -42,6 +42,7 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
@@ -207,6 +208,47 @@ func TestArchivalQueueTaskExecutor(t *testing.T) {
 				p.ExpectAddTask = false
 			},
 		},
+		{
+			Name: "archiver error after max attempts",
+			Configure: func(p *params) {
+				p.MaxAttempts = 1
+				p.ArchiveError = errors.New("archiver error")
+				p.ExpectDeadLetter = true
+				p.ExpectAddTask = false
+				mockCounter := metrics.NewMockCounterIface(p.Controller)
+				mockCounter.EXPECT().Record(int64(1), metrics.NamespaceTag(tests.Namespace.String()))
+				p.MetricsHandler.EXPECT().Counter("archival_task_dead_lettered").Return(mockCounter)
+			},
+		},
+		{
+			Name: "archiver error before max attempts",
+			Configure: func(p *params) {
+				p.MaxAttempts = 2
+				p.ArchiveError = errors.New("archiver error")
+				p.ExpectedErrorSubstrings = []string{"archiver error"}
+				p.ExpectAddTask = false
+			},
+		},
+		{
+			Name: "archiver throttled after max attempts",
+			Configure: func(p *params) {
+				p.MaxAttempts = 1
+				p.ArchiveError = serviceerror.NewResourceExhausted(enumspb.RESOURCE_EXHAUSTED_CAUSE_RPS_LIMIT, "throttled")
+				p.ExpectedErrorSubstrings = []string{"throttled"}
+				p.ExpectAddTask = false
+			},
+		},
+		{
+			Name: "dead letter error",
+			Configure: func(p *params) {
+				p.MaxAttempts = 1
+				p.ArchiveError = errors.New("archiver error")
+				p.ExpectDeadLetter = true
+				p.DeadLetterError = errors.New("dead letter error")
+				p.ExpectedErrorSubstrings = []string{"archiver error"}
+				p.ExpectAddTask = false
+			},
+		},
 		{
 			Name: "get workflow close time error",
 			Configure: func(p *params) {
@@ -351,6 +393,7 @@ func TestArchivalQueueTaskExecutor(t *testing.T) {
 			cfg.RetentionTimerJitterDuration = func() time.Duration {
 				return 0
 			}
+			cfg.ArchivalProcessorMaxAttempts = dynamicconfig.GetIntPropertyFn(p.MaxAttempts)
 			shardContext.EXPECT().GetConfig().Return(cfg).AnyTimes()
 			mockMetadata := cluster.NewMockMetadata(p.Controller)
 			mockMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(true).AnyTimes()
@@ -477,6 +520,23 @@ func TestArchivalQueueTaskExecutor(t *testing.T) {
 			archivalMetadata.EXPECT().GetVisibilityConfig().Return(visibilityConfig).AnyTimes()
 			shardContext.EXPECT().GetArchivalMetadata().Return(archivalMetadata).AnyTimes()
 			shardContext.EXPECT().GetShardID().Return(shardID).AnyTimes()
+			shardContext.EXPECT().GetTimeSource().Return(timeSource).AnyTimes()
+
+			archivalDLQ := cpersistence.NewMockArchivalDLQ(p.Controller)
+			if p.ExpectDeadLetter {
+				archivalDLQ.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, dlqTask *cpersistence.ArchivalDLQTask) (int64, error) {
+						assert.Equal(t, shardID, dlqTask.ShardID)
+						assert.Equal(t, p.WorkflowKey.NamespaceID, dlqTask.NamespaceID)
+						assert.Equal(t, p.WorkflowKey.WorkflowID, dlqTask.WorkflowID)
+						assert.Equal(t, p.WorkflowKey.RunID, dlqTask.RunID)
+						assert.Equal(t, p.Task.GetVersion(), dlqTask.Version)
+						assert.Equal(t, 1, dlqTask.Attempt)
+						assert.Equal(t, p.ArchiveError.Error(), dlqTask.LastError)
+						return 1, p.DeadLetterError
+					},
+				)
+			}
 
 			if p.ExpectArchive {
 				a.EXPECT().Archive(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context,
@@ -503,6 +563,7 @@ func TestArchivalQueueTaskExecutor(t *testing.T) {
 
 			executor := NewArchivalQueueTaskExecutor(
 				a,
+				archivalDLQ,
 				shardContext,
 				workflowCache,
 				workflow.RelocatableAttributesFetcherProvider(visibilityManager),
@@ -566,6 +627,9 @@ type params struct {
 	MetricsHandler                         *metrics.MockHandler
 	MutableStateExists                     bool
 	ArchiveError                           error
+	MaxAttempts                            int
+	ExpectDeadLetter                       bool
+	DeadLetterError                        error
 	GetWorkflowCloseTimeError              error
 	GetCurrentBranchTokenError             error
 	CloseVisibilityTaskCompleted           bool
@@ -46,6 +46,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/taskfailure"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/workflow"
 )
@@ -138,7 +139,7 @@ func (c *workflowSizeChecker) checkIfPayloadSizeExceedsLimit(
 		tag.BlobSizeViolationOperation(commandTypeTag.Value()),
 	)
 	if err != nil {
-		return fmt.Errorf(message)
+		return &taskfailure.PayloadTooLargeError{Message: message}
 	}
 	return nil
 }
@@ -166,7 +167,7 @@ func (c *workflowSizeChecker) checkIfMemoSizeExceedsLimit(
 		tag.BlobSizeViolationOperation(commandTypeTag.Value()),
 	)
 	if err != nil {
-		return fmt.Errorf(message)
+		return &taskfailure.PayloadTooLargeError{Message: message}
 	}
 	return nil
 }
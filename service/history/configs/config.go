@@ -31,6 +31,7 @@ import (
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/featureflag"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/visibility"
 )
@@ -57,6 +58,9 @@ type Config struct {
 	VisibilityDisableOrderByClause    dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	VisibilityEnableManualPagination  dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
+	VisibilityDualWriteVerificationSampleRate dynamicconfig.FloatPropertyFnWithNamespaceFilter
+	VisibilityDualWriteVerificationDelay      dynamicconfig.DurationPropertyFn
+
 	EmitShardLagLog       dynamicconfig.BoolPropertyFn
 	MaxAutoResetPoints    dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxTrackedBuildIds    dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -64,6 +68,10 @@ type Config struct {
 	EnableStickyQuery     dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	ShutdownDrainDuration dynamicconfig.DurationPropertyFn
 
+	SlowRequestLogThresholds     dynamicconfig.MapPropertyFn
+	SlowRequestSummarySampleRate dynamicconfig.FloatPropertyFn
+	SlowRequestSummaryMaxSize    dynamicconfig.IntPropertyFn
+
 	// HistoryCache settings
 	// Change of these configs require shard restart
 	HistoryCacheInitialSize dynamicconfig.IntPropertyFn
@@ -76,6 +84,15 @@ type Config struct {
 	EventsCacheMaxSize     dynamicconfig.IntPropertyFn
 	EventsCacheTTL         dynamicconfig.DurationPropertyFn
 
+	// CurrentExecutionCache settings
+	// Change of this config requires shard restart
+	CurrentExecutionCacheMaxSize dynamicconfig.IntPropertyFn
+
+	// HistoryAppendBatch settings
+	HistoryAppendBatchingEnabled dynamicconfig.BoolPropertyFn
+	HistoryAppendBatchMaxSize    dynamicconfig.IntPropertyFn
+	HistoryAppendBatchWindow     dynamicconfig.DurationPropertyFn
+
 	// ShardController settings
 	RangeSizeBits           uint
 	AcquireShardInterval    dynamicconfig.DurationPropertyFn
@@ -223,6 +240,9 @@ type Config struct {
 
 	// ContinueAsNewMinInterval is the minimal interval between continue_as_new to prevent tight continue_as_new loop.
 	ContinueAsNewMinInterval dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	// TimerAccelerationFactor scales down durable timers, retry backoffs and activity schedule-to-start
+	// timeouts for test namespaces.
+	TimerAccelerationFactor dynamicconfig.FloatPropertyFnWithNamespaceFilter
 
 	// The following is used by the new RPC replication stack
 	ReplicationTaskFetcherParallelism                    dynamicconfig.IntPropertyFn
@@ -277,6 +297,9 @@ type Config struct {
 	VisibilityProcessorVisibilityArchivalTimeLimit        dynamicconfig.DurationPropertyFn
 	VisibilityProcessorEnsureCloseBeforeDelete            dynamicconfig.BoolPropertyFn
 	VisibilityProcessorEnableCloseWorkflowCleanup         dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	VisibilityProcessorLagSLO                             dynamicconfig.DurationPropertyFn
+	VisibilityProcessorBackpressureEnabled                dynamicconfig.BoolPropertyFn
+	VisibilityProcessorLagHardLimit                       dynamicconfig.DurationPropertyFn
 
 	SearchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithNamespaceFilter
 	SearchAttributesSizeOfValueLimit  dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -305,6 +328,9 @@ type Config struct {
 	ArchivalProcessorUpdateAckIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
 	ArchivalProcessorArchiveDelay                       dynamicconfig.DurationPropertyFn
 	ArchivalBackendMaxRPS                               dynamicconfig.FloatPropertyFn
+	ArchivalNamespaceMaxRPS                             dynamicconfig.FloatPropertyFnWithNamespaceFilter
+	ArchivalNamespaceMaxConcurrency                     dynamicconfig.IntPropertyFnWithNamespaceFilter
+	ArchivalProcessorMaxAttempts                        dynamicconfig.IntPropertyFn
 
 	WorkflowExecutionMaxInFlightUpdates dynamicconfig.IntPropertyFnWithNamespaceFilter
 	WorkflowExecutionMaxTotalUpdates    dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -322,6 +348,7 @@ func NewConfig(
 	visibilityStoreConfigExist bool,
 	advancedVisibilityStoreConfigExist bool,
 ) *Config {
+	featureFlags := featureflag.NewGate(dc)
 	cfg := &Config{
 		NumberOfShards: numberOfShards,
 
@@ -340,6 +367,7 @@ func NewConfig(
 		MaxTrackedBuildIds:                    dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistoryMaxTrackedBuildIds, DefaultHistoryMaxTrackedBuildIds),
 		DefaultWorkflowTaskTimeout:            dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.DefaultWorkflowTaskTimeout, common.DefaultWorkflowTaskTimeout),
 		ContinueAsNewMinInterval:              dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.ContinueAsNewMinInterval, time.Second),
+		TimerAccelerationFactor:               dc.GetFloatPropertyFilteredByNamespace(dynamicconfig.TimerAccelerationFactor, 1.0),
 
 		VisibilityPersistenceMaxReadQPS:   visibility.GetVisibilityPersistenceMaxReadQPS(dc, advancedVisibilityStoreConfigExist),
 		VisibilityPersistenceMaxWriteQPS:  visibility.GetVisibilityPersistenceMaxWriteQPS(dc, advancedVisibilityStoreConfigExist),
@@ -348,6 +376,9 @@ func NewConfig(
 		VisibilityDisableOrderByClause:    dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.VisibilityDisableOrderByClause, true),
 		VisibilityEnableManualPagination:  dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.VisibilityEnableManualPagination, true),
 
+		VisibilityDualWriteVerificationSampleRate: dc.GetFloatPropertyFilteredByNamespace(dynamicconfig.VisibilityDualWriteVerificationSampleRate, 0.0),
+		VisibilityDualWriteVerificationDelay:      dc.GetDurationProperty(dynamicconfig.VisibilityDualWriteVerificationDelay, 5*time.Second),
+
 		EmitShardLagLog:                      dc.GetBoolProperty(dynamicconfig.EmitShardLagLog, false),
 		HistoryCacheInitialSize:              dc.GetIntProperty(dynamicconfig.HistoryCacheInitialSize, 128),
 		HistoryCacheMaxSize:                  dc.GetIntProperty(dynamicconfig.HistoryCacheMaxSize, 512),
@@ -355,6 +386,10 @@ func NewConfig(
 		EventsCacheInitialSize:               dc.GetIntProperty(dynamicconfig.EventsCacheInitialSize, 128),
 		EventsCacheMaxSize:                   dc.GetIntProperty(dynamicconfig.EventsCacheMaxSize, 512),
 		EventsCacheTTL:                       dc.GetDurationProperty(dynamicconfig.EventsCacheTTL, time.Hour),
+		CurrentExecutionCacheMaxSize:         dc.GetIntProperty(dynamicconfig.CurrentExecutionCacheMaxSize, 512),
+		HistoryAppendBatchingEnabled:         dc.GetBoolProperty(dynamicconfig.HistoryAppendBatchingEnabled, false),
+		HistoryAppendBatchMaxSize:            dc.GetIntProperty(dynamicconfig.HistoryAppendBatchMaxSize, 64),
+		HistoryAppendBatchWindow:             dc.GetDurationProperty(dynamicconfig.HistoryAppendBatchWindow, 5*time.Millisecond),
 		RangeSizeBits:                        20, // 20 bits for sequencer, 2^20 sequence number for any range
 		AcquireShardInterval:                 dc.GetDurationProperty(dynamicconfig.AcquireShardInterval, time.Minute),
 		AcquireShardConcurrency:              dc.GetIntProperty(dynamicconfig.AcquireShardConcurrency, 10),
@@ -472,6 +507,10 @@ func NewConfig(
 		ThrottledLogRPS:   dc.GetIntProperty(dynamicconfig.HistoryThrottledLogRPS, 4),
 		EnableStickyQuery: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableStickyQuery, true),
 
+		SlowRequestLogThresholds:     dc.GetMapProperty(dynamicconfig.HistorySlowRequestLogThresholds, map[string]any{}),
+		SlowRequestSummarySampleRate: dc.GetFloat64Property(dynamicconfig.HistorySlowRequestSummarySampleRate, 0.0),
+		SlowRequestSummaryMaxSize:    dc.GetIntProperty(dynamicconfig.HistorySlowRequestSummaryMaxSize, 4*1024),
+
 		DefaultActivityRetryPolicy:   dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.DefaultActivityRetryPolicy, common.GetDefaultRetryPolicyConfigOptions()),
 		DefaultWorkflowRetryPolicy:   dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.DefaultWorkflowRetryPolicy, common.GetDefaultRetryPolicyConfigOptions()),
 		WorkflowTaskHeartbeatTimeout: dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.WorkflowTaskHeartbeatTimeout, time.Minute*30),
@@ -517,6 +556,9 @@ func NewConfig(
 		VisibilityProcessorVisibilityArchivalTimeLimit:        dc.GetDurationProperty(dynamicconfig.VisibilityProcessorVisibilityArchivalTimeLimit, 200*time.Millisecond),
 		VisibilityProcessorEnsureCloseBeforeDelete:            dc.GetBoolProperty(dynamicconfig.VisibilityProcessorEnsureCloseBeforeDelete, false),
 		VisibilityProcessorEnableCloseWorkflowCleanup:         dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.VisibilityProcessorEnableCloseWorkflowCleanup, false),
+		VisibilityProcessorLagSLO:                             dc.GetDurationProperty(dynamicconfig.VisibilityProcessorLagSLO, 1*time.Minute),
+		VisibilityProcessorBackpressureEnabled:                dc.GetBoolProperty(dynamicconfig.VisibilityProcessorBackpressureEnabled, false),
+		VisibilityProcessorLagHardLimit:                       dc.GetDurationProperty(dynamicconfig.VisibilityProcessorLagHardLimit, 10*time.Minute),
 
 		SearchAttributesNumberOfKeysLimit: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.SearchAttributesNumberOfKeysLimit, 100),
 		SearchAttributesSizeOfValueLimit:  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.SearchAttributesSizeOfValueLimit, 2*1024),
@@ -533,8 +575,8 @@ func NewConfig(
 		ESProcessorAckTimeout:    dc.GetDurationProperty(dynamicconfig.WorkerESProcessorAckTimeout, 30*time.Second),
 
 		EnableCrossNamespaceCommands:  dc.GetBoolProperty(dynamicconfig.EnableCrossNamespaceCommands, true),
-		EnableActivityEagerExecution:  dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableActivityEagerExecution, false),
-		EnableEagerWorkflowStart:      dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableEagerWorkflowStart, false),
+		EnableActivityEagerExecution:  featureFlags.EnabledFn(featureflag.EagerActivityExecution),
+		EnableEagerWorkflowStart:      featureFlags.EnabledFn(featureflag.EagerWorkflowStart),
 		NamespaceCacheRefreshInterval: dc.GetDurationProperty(dynamicconfig.NamespaceCacheRefreshInterval, 10*time.Second),
 
 		// Archival related
@@ -551,6 +593,9 @@ func NewConfig(
 		ArchivalProcessorPollBackoffInterval: dc.GetDurationProperty(dynamicconfig.ArchivalProcessorPollBackoffInterval, 5*time.Second),
 		ArchivalProcessorArchiveDelay:        dc.GetDurationProperty(dynamicconfig.ArchivalProcessorArchiveDelay, 5*time.Minute),
 		ArchivalBackendMaxRPS:                dc.GetFloat64Property(dynamicconfig.ArchivalBackendMaxRPS, 10000.0),
+		ArchivalNamespaceMaxRPS:              dc.GetFloatPropertyFilteredByNamespace(dynamicconfig.ArchivalNamespaceMaxRPS, 0.0),
+		ArchivalNamespaceMaxConcurrency:      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.ArchivalNamespaceMaxConcurrency, 0),
+		ArchivalProcessorMaxAttempts:         dc.GetIntProperty(dynamicconfig.ArchivalProcessorMaxAttempts, 0),
 
 		// workflow update related
 		WorkflowExecutionMaxInFlightUpdates: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.WorkflowExecutionMaxInFlightUpdates, 10),
@@ -30,57 +30,70 @@ import (
 
 var (
 	APIToPriority = map[string]int{
-		"CloseShard":                             0,
-		"GetShard":                               0,
-		"DeleteWorkflowExecution":                0,
-		"DescribeHistoryHost":                    0,
-		"DescribeMutableState":                   0,
-		"DescribeWorkflowExecution":              0,
-		"GetDLQMessages":                         0,
-		"GetDLQReplicationMessages":              0,
-		"GetMutableState":                        0,
-		"GetReplicationMessages":                 0,
-		"MergeDLQMessages":                       0,
-		"PollMutableState":                       0,
-		"PurgeDLQMessages":                       0,
-		"QueryWorkflow":                          0,
-		"ReapplyEvents":                          0,
-		"RebuildMutableState":                    0,
-		"RecordActivityTaskHeartbeat":            0,
-		"RecordActivityTaskStarted":              0,
-		"RecordChildExecutionCompleted":          0,
-		"VerifyChildExecutionCompletionRecorded": 0,
-		"RecordWorkflowTaskStarted":              0,
-		"RefreshWorkflowTasks":                   0,
-		"RemoveSignalMutableState":               0,
-		"RemoveTask":                             0,
-		"ReplicateEventsV2":                      0,
-		"ReplicateWorkflowState":                 0,
-		"RequestCancelWorkflowExecution":         0,
-		"ResetStickyTaskQueue":                   0,
-		"ResetWorkflowExecution":                 0,
-		"RespondActivityTaskCanceled":            0,
-		"RespondActivityTaskCompleted":           0,
-		"RespondActivityTaskFailed":              0,
-		"RespondWorkflowTaskCompleted":           0,
-		"RespondWorkflowTaskFailed":              0,
-		"ScheduleWorkflowTask":                   0,
-		"VerifyFirstWorkflowTaskScheduled":       0,
-		"SignalWithStartWorkflowExecution":       0,
-		"SignalWorkflowExecution":                0,
-		"StartWorkflowExecution":                 0,
-		"SyncActivity":                           0,
-		"SyncShardStatus":                        0,
-		"TerminateWorkflowExecution":             0,
-		"GenerateLastHistoryReplicationTasks":    0,
-		"GetReplicationStatus":                   0,
-		"DeleteWorkflowVisibilityRecord":         0,
-		"UpdateWorkflowExecution":                0,
-		"PollWorkflowExecutionUpdate":            0,
-		"StreamWorkflowReplicationMessages":      0,
+		"CloseShard":                              0,
+		"GetShard":                                0,
+		"DeleteWorkflowExecution":                 0,
+		"DescribeHistoryHost":                     0,
+		"DescribeMutableState":                    0,
+		"DescribeWorkflowExecution":               0,
+		"GetDLQMessages":                          0,
+		"GetDLQReplicationMessages":               0,
+		"GetMutableState":                         0,
+		"GetReplicationMessages":                  0,
+		"MergeDLQMessages":                        0,
+		"PollMutableState":                        0,
+		"PurgeDLQMessages":                        0,
+		"QueryWorkflow":                           0,
+		"ReapplyEvents":                           0,
+		"RebuildMutableState":                     0,
+		"RecordActivityTaskHeartbeat":             0,
+		"RecordActivityTaskStarted":               0,
+		"RecordChildExecutionCompleted":           0,
+		"VerifyChildExecutionCompletionRecorded":  0,
+		"RecordWorkflowTaskStarted":               0,
+		"RefreshWorkflowTasks":                    0,
+		"RehydrateWorkflowExecution":              0,
+		"RemoveSignalMutableState":                0,
+		"RemoveTask":                              0,
+		"ReplicateEventsV2":                       0,
+		"ReplicateWorkflowState":                  0,
+		"RequestCancelWorkflowExecution":          0,
+		"ResetStickyTaskQueue":                    0,
+		"ResetWorkflowExecution":                  0,
+		"RespondActivityTaskCanceled":             0,
+		"RespondActivityTaskCompleted":            0,
+		"RespondActivityTaskFailed":               0,
+		"RespondWorkflowTaskCompleted":            0,
+		"RespondWorkflowTaskFailed":               0,
+		"ScheduleWorkflowTask":                    0,
+		"VerifyFirstWorkflowTaskScheduled":        0,
+		"SignalWithStartWorkflowExecution":        0,
+		"SignalWorkflowExecution":                 0,
+		"StartWorkflowExecution":                  0,
+		"SyncActivity":                            0,
+		"SyncShardStatus":                         0,
+		"TerminateWorkflowExecution":              0,
+		"GenerateLastHistoryReplicationTasks":     0,
+		"GetReplicationStatus":                    0,
+		"DeleteWorkflowVisibilityRecord":          0,
+		"UpdateWorkflowExecution":                 0,
+		"UpdateWorkflowExecutionMemo":             0,
+		"UpsertWorkflowExecutionSearchAttributes": 0,
+		"PollWorkflowExecutionUpdate":             0,
+		"StreamWorkflowReplicationMessages":       0,
+		"DescribeVisibilityIngestion":             0,
+		"AwaitVisibilityIngestion":                0,
+		"DescribeShard":                           0,
 	}
 
 	APIPrioritiesOrdered = []int{0}
+
+	// LongPollAPIs are the APIs whose requests wait for the workflow or its shard to make progress
+	LongPollAPIs = map[string]struct{}{
+		"PollMutableState":            {},
+		"PollWorkflowExecutionUpdate": {},
+		"AwaitVisibilityIngestion":    {},
+	}
 )
 
 func NewPriorityRateLimiter(
@@ -38,6 +38,11 @@ const (
 	IdentityHistoryService = "history-service"
 	IdentityResetter       = "history-resetter"
 	LibraryName            = "go.temporal.io/service/history"
+
+	// Reasons of the terminations done by the history service
+	TerminateReasonIDReusePolicy   = "TerminateIfRunning WorkflowIdReusePolicy Policy"
+	TerminateReasonDeleteWorkflow  = "Delete workflow execution"
+	TerminateReasonVersionConflict = "Terminate Workflow Due To Version Conflict."
 )
 
 var (
@@ -176,6 +176,13 @@ func (m *DeleteManagerImpl) DeleteWorkflowExecutionByRetention(
 	stage *tasks.DeleteWorkflowExecutionStage,
 ) error {
 
+	if stage == nil {
+		stage = new(tasks.DeleteWorkflowExecutionStage)
+	}
+	if err := m.deferVisibilityRecordDeletion(ctx, nsID, we, ms, stage); err != nil {
+		return err
+	}
+
 	return m.deleteWorkflowExecutionInternal(
 		ctx,
 		nsID,
@@ -189,6 +196,48 @@ func (m *DeleteManagerImpl) DeleteWorkflowExecutionByRetention(
 	)
 }
 
+// deferVisibilityRecordDeletion leaves the visibility record out of the deletion if the visibility retention of the
+// namespace expires after the history retention, and schedules its deletion when it expires instead.
+func (m *DeleteManagerImpl) deferVisibilityRecordDeletion(
+	ctx context.Context,
+	nsID namespace.ID,
+	we commonpb.WorkflowExecution,
+	ms workflow.MutableState,
+	stage *tasks.DeleteWorkflowExecutionStage,
+) error {
+	namespaceEntry := ms.GetNamespaceEntry()
+	if !namespaceEntry.HasSeparateVisibilityRetention() || stage.IsProcessed(tasks.DeleteWorkflowExecutionStageVisibility) {
+		return nil
+	}
+	closeTime, err := ms.GetWorkflowCloseTime(ctx)
+	if err != nil {
+		return err
+	}
+	if closeTime == nil || !closeTime.Add(namespaceEntry.VisibilityRetention()).After(m.timeSource.Now()) {
+		return nil
+	}
+
+	taskGenerator := workflow.NewTaskGeneratorProvider().NewTaskGenerator(m.shard, ms)
+	deleteTask, err := taskGenerator.GenerateDeleteVisibilityRecordTask(*closeTime, namespaceEntry.VisibilityRetention())
+	if err != nil {
+		return err
+	}
+	if err := m.shard.AddTasks(ctx, &persistence.AddHistoryTasksRequest{
+		ShardID: m.shard.GetShardID(),
+		// RangeID is set by shard
+		NamespaceID: nsID.String(),
+		WorkflowID:  we.GetWorkflowId(),
+		RunID:       we.GetRunId(),
+		Tasks: map[tasks.Category][]tasks.Task{
+			tasks.CategoryTimer: {deleteTask},
+		},
+	}); err != nil {
+		return err
+	}
+	stage.MarkProcessed(tasks.DeleteWorkflowExecutionStageVisibility)
+	return nil
+}
+
 func (m *DeleteManagerImpl) deleteWorkflowExecutionInternal(
 	ctx context.Context,
 	namespaceID namespace.ID,
@@ -307,9 +356,16 @@ func (m *DeleteManagerImpl) archiveWorkflowIfEnabled(
 		CallerService:        string(primitives.HistoryService),
 		AttemptArchiveInline: false, // archive in workflow by default
 	}
-	executionStats, err := weCtx.LoadExecutionStats(ctx)
-	if err == nil && executionStats.HistorySize < int64(m.config.TimerProcessorHistoryArchivalSizeLimit()) {
+	if namespaceRegistryEntry.SynchronousArchival() {
+		// The history is archived inline regardless of its size, and isn't deleted until that succeeds,
+		// so that it's never deleted before its archive write is confirmed.
 		req.AttemptArchiveInline = true
+		req.ArchiveInlineOnly = true
+	} else {
+		executionStats, err := weCtx.LoadExecutionStats(ctx)
+		if err == nil && executionStats.HistorySize < int64(m.config.TimerProcessorHistoryArchivalSizeLimit()) {
+			req.AttemptArchiveInline = true
+		}
 	}
 
 	saTypeMap, err := m.shard.GetSearchAttributesProvider().GetSearchAttributes(m.visibilityManager.GetIndexName(), false)
@@ -46,8 +46,10 @@ import (
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
@@ -276,7 +278,7 @@ func (s *deleteManagerWorkflowSuite) TestDeleteWorkflowExecutionRetention_Archiv
 						HistoryArchivalState: enums.ARCHIVAL_STATE_ENABLED,
 					},
 					"target-cluster",
-				))
+				)).Times(2)
 				mockClusterArchivalMetadata := carchiver.NewMockArchivalMetadata(s.controller)
 				mockClusterArchivalConfig := carchiver.NewMockArchivalConfig(s.controller)
 				s.mockShardContext.EXPECT().GetArchivalMetadata().Return(mockClusterArchivalMetadata)
@@ -295,6 +297,7 @@ func (s *deleteManagerWorkflowSuite) TestDeleteWorkflowExecutionRetention_Archiv
 					HistoryArchivedInline: false,
 				}, nil)
 			} else {
+				mockMutableState.EXPECT().GetNamespaceEntry().Return(tests.LocalNamespaceEntry)
 				mockMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
 					CloseVisibilityTaskId: 42,
 				}).AnyTimes()
@@ -352,7 +355,7 @@ func (s *deleteManagerWorkflowSuite) TestDeleteWorkflowExecutionRetention_Archiv
 						HistoryArchivalState: enums.ARCHIVAL_STATE_ENABLED,
 					},
 					"target-cluster",
-				))
+				)).Times(2)
 				mockClusterArchivalMetadata := carchiver.NewMockArchivalMetadata(s.controller)
 				mockClusterArchivalConfig := carchiver.NewMockArchivalConfig(s.controller)
 				s.mockShardContext.EXPECT().GetArchivalMetadata().Return(mockClusterArchivalMetadata)
@@ -369,6 +372,7 @@ func (s *deleteManagerWorkflowSuite) TestDeleteWorkflowExecutionRetention_Archiv
 				s.mockShardContext.EXPECT().GetSearchAttributesProvider().Return(mockSearchAttributesProvider)
 				s.mockArchivalClient.EXPECT().Archive(gomock.Any(), archiverClientRequestMatcher{inline: false}).Return(nil, errors.New("failed to send signal"))
 			} else {
+				mockMutableState.EXPECT().GetNamespaceEntry().Return(tests.LocalNamespaceEntry)
 				const closeExecutionVisibilityTaskID int64 = 42
 				mockMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
 					CloseVisibilityTaskId: closeExecutionVisibilityTaskID,
@@ -408,9 +412,184 @@ func (s *deleteManagerWorkflowSuite) TestDeleteWorkflowExecutionRetention_Archiv
 	}
 }
 
+func (s *deleteManagerWorkflowSuite) TestDeleteWorkflowExecutionRetention_SynchronousArchivalErr() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+
+	mockWeCtx := workflow.NewMockContext(s.controller)
+	mockMutableState := workflow.NewMockMutableState(s.controller)
+
+	mockMutableState.EXPECT().GetCurrentBranchToken().Return([]byte{22, 8, 78}, nil)
+	mockMutableState.EXPECT().GetExecutionState().Return(&persistencespb.WorkflowExecutionState{State: enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED})
+	closeTime := time.Date(1978, 8, 22, 1, 2, 3, 4, time.UTC)
+	mockMutableState.EXPECT().GetWorkflowCloseTime(gomock.Any()).Return(&closeTime, nil)
+	mockMutableState.EXPECT().GetNamespaceEntry().Return(namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{
+			Name: tests.Namespace.String(),
+			Data: map[string]string{namespace.ArchivalModeDataKey: namespace.ArchivalModeSync},
+		},
+		&persistencespb.NamespaceConfig{
+			HistoryArchivalState: enums.ARCHIVAL_STATE_ENABLED,
+		},
+		"target-cluster",
+	)).Times(2)
+	mockClusterArchivalMetadata := carchiver.NewMockArchivalMetadata(s.controller)
+	mockClusterArchivalConfig := carchiver.NewMockArchivalConfig(s.controller)
+	s.mockShardContext.EXPECT().GetArchivalMetadata().Return(mockClusterArchivalMetadata)
+	mockClusterArchivalMetadata.EXPECT().GetHistoryConfig().Return(mockClusterArchivalConfig)
+	mockClusterArchivalConfig.EXPECT().ClusterConfiguredForArchival().Return(true)
+	mockMutableState.EXPECT().GetLastWriteVersion().Return(int64(1), nil)
+	s.mockShardContext.EXPECT().GetShardID().Return(int32(1))
+	mockMutableState.EXPECT().GetNextEventID().Return(int64(1))
+	mockSearchAttributesProvider := searchattribute.NewMockProvider(s.controller)
+	mockSearchAttributesProvider.EXPECT().GetSearchAttributes(gomock.Any(), gomock.Any()).Return(searchattribute.TestNameTypeMap, nil)
+	s.mockShardContext.EXPECT().GetSearchAttributesProvider().Return(mockSearchAttributesProvider)
+	// The history isn't deleted when it fails to be archived inline, and the archive isn't handed to the
+	// archival workflow either.
+	s.mockArchivalClient.EXPECT().Archive(gomock.Any(), archiverClientRequestMatcher{inline: true, inlineOnly: true}).Return(nil, errors.New("failed to archive inline"))
+
+	stage := tasks.DeleteWorkflowExecutionStageNone
+	err := s.deleteManager.DeleteWorkflowExecutionByRetention(
+		context.Background(),
+		tests.NamespaceID,
+		we,
+		mockWeCtx,
+		mockMutableState,
+		true,
+		&stage,
+	)
+	s.Error(err)
+}
+
+func (s *deleteManagerWorkflowSuite) TestDeleteWorkflowExecutionRetention_LongerVisibilityRetention() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+
+	mockWeCtx := workflow.NewMockContext(s.controller)
+	mockMutableState := workflow.NewMockMutableState(s.controller)
+	branchToken := []byte{22, 8, 78}
+	closeTime := time.Date(1978, 8, 22, 1, 2, 3, 4, time.UTC)
+	s.mockClock.Update(closeTime.Add(30 * 24 * time.Hour))
+	s.expectSeparateVisibilityRetention(mockMutableState, 365)
+	mockMutableState.EXPECT().GetCurrentBranchToken().Return(branchToken, nil)
+	mockMutableState.EXPECT().GetExecutionState().
+		Return(&persistencespb.WorkflowExecutionState{State: enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED})
+	mockMutableState.EXPECT().GetWorkflowCloseTime(gomock.Any()).Return(&closeTime, nil).Times(2)
+	mockMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
+		CloseVisibilityTaskId: 42,
+	})
+	mockMutableState.EXPECT().GetWorkflowKey().Return(definition.NewWorkflowKey(tests.NamespaceID.String(), tests.WorkflowID, tests.RunID))
+	mockMutableState.EXPECT().GetCurrentVersion().Return(int64(7))
+	s.mockShardContext.EXPECT().GetShardID().Return(int32(1))
+	s.mockShardContext.EXPECT().AddTasks(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request *persistence.AddHistoryTasksRequest) error {
+		// The visibility record is deleted by a timer once the visibility retention expires.
+		s.Len(request.Tasks[tasks.CategoryTimer], 1)
+		task := request.Tasks[tasks.CategoryTimer][0].(*tasks.DeleteVisibilityRecordTask)
+		s.Equal(&closeTime, task.CloseTime)
+		s.Equal(int64(7), task.Version)
+		s.False(task.VisibilityTimestamp.Before(closeTime.Add(365 * 24 * time.Hour)))
+		return nil
+	})
+
+	stage := tasks.DeleteWorkflowExecutionStageNone
+	s.mockShardContext.EXPECT().DeleteWorkflowExecution(
+		gomock.Any(),
+		definition.NewWorkflowKey(tests.NamespaceID.String(), tests.WorkflowID, tests.RunID),
+		branchToken,
+		nil,
+		&closeTime,
+		int64(42),
+		&stage,
+	).DoAndReturn(func(_ context.Context, _ definition.WorkflowKey, _ []byte, _ *time.Time, _ *time.Time, _ int64, stage *tasks.DeleteWorkflowExecutionStage) error {
+		s.True(stage.IsProcessed(tasks.DeleteWorkflowExecutionStageVisibility))
+		return nil
+	})
+	mockWeCtx.EXPECT().Clear()
+
+	err := s.deleteManager.DeleteWorkflowExecutionByRetention(
+		context.Background(),
+		tests.NamespaceID,
+		we,
+		mockWeCtx,
+		mockMutableState,
+		false,
+		&stage,
+	)
+	s.NoError(err)
+}
+
+func (s *deleteManagerWorkflowSuite) TestDeleteWorkflowExecutionRetention_ExpiredVisibilityRetention() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+
+	mockWeCtx := workflow.NewMockContext(s.controller)
+	mockMutableState := workflow.NewMockMutableState(s.controller)
+	branchToken := []byte{22, 8, 78}
+	closeTime := time.Date(1978, 8, 22, 1, 2, 3, 4, time.UTC)
+	s.mockClock.Update(closeTime.Add(30 * 24 * time.Hour))
+	s.expectSeparateVisibilityRetention(mockMutableState, 7)
+	mockMutableState.EXPECT().GetCurrentBranchToken().Return(branchToken, nil)
+	mockMutableState.EXPECT().GetExecutionState().
+		Return(&persistencespb.WorkflowExecutionState{State: enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED})
+	mockMutableState.EXPECT().GetWorkflowCloseTime(gomock.Any()).Return(&closeTime, nil).Times(2)
+	mockMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
+		CloseVisibilityTaskId: 42,
+	})
+
+	stage := tasks.DeleteWorkflowExecutionStageNone
+	s.mockShardContext.EXPECT().DeleteWorkflowExecution(
+		gomock.Any(),
+		definition.NewWorkflowKey(tests.NamespaceID.String(), tests.WorkflowID, tests.RunID),
+		branchToken,
+		nil,
+		&closeTime,
+		int64(42),
+		&stage,
+	).DoAndReturn(func(_ context.Context, _ definition.WorkflowKey, _ []byte, _ *time.Time, _ *time.Time, _ int64, stage *tasks.DeleteWorkflowExecutionStage) error {
+		// The visibility retention already expired, the visibility record is deleted with the history.
+		s.False(stage.IsProcessed(tasks.DeleteWorkflowExecutionStageVisibility))
+		return nil
+	})
+	mockWeCtx.EXPECT().Clear()
+
+	err := s.deleteManager.DeleteWorkflowExecutionByRetention(
+		context.Background(),
+		tests.NamespaceID,
+		we,
+		mockWeCtx,
+		mockMutableState,
+		false,
+		&stage,
+	)
+	s.NoError(err)
+}
+
+func (s *deleteManagerWorkflowSuite) expectSeparateVisibilityRetention(
+	mockMutableState *workflow.MockMutableState,
+	visibilityRetentionDays int32,
+) {
+	mockMutableState.EXPECT().GetNamespaceEntry().Return(namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Name: tests.Namespace.String()},
+		&persistencespb.NamespaceConfig{
+			Retention:           timestamp.DurationFromDays(30),
+			VisibilityRetention: timestamp.DurationFromDays(visibilityRetentionDays),
+		},
+		"target-cluster",
+	)).AnyTimes()
+	s.mockShardContext.EXPECT().GetConfig().Return(tests.NewDynamicConfig()).AnyTimes()
+	s.mockShardContext.EXPECT().GetArchivalMetadata().Return(nil).AnyTimes()
+}
+
 type (
 	archiverClientRequestMatcher struct {
-		inline bool
+		inline     bool
+		inlineOnly bool
 	}
 )
 
@@ -418,6 +597,7 @@ func (m archiverClientRequestMatcher) Matches(x interface{}) bool {
 	req := x.(*archiver.ClientRequest)
 	return req.CallerService == string(primitives.HistoryService) &&
 		req.AttemptArchiveInline == m.inline &&
+		req.ArchiveInlineOnly == m.inlineOnly &&
 		req.ArchiveRequest.Targets[0] == archiver.ArchiveTargetHistory
 }
 
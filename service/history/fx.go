@@ -35,13 +35,17 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/drain"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	persistenceEncryption "go.temporal.io/server/common/persistence/encryption"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
@@ -59,11 +63,16 @@ import (
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/events"
 	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/workflow"
 	"go.temporal.io/server/service/history/workflow/cache"
 	warchiver "go.temporal.io/server/service/worker/archiver"
 )
 
+const (
+	traceContextsMaxSize = 100000
+)
+
 var Module = fx.Options(
 	resource.Module,
 	workflow.Module,
@@ -74,6 +83,8 @@ var Module = fx.Options(
 	fx.Provide(ConfigProvider), // might be worth just using provider for configs.Config directly
 	fx.Provide(RetryableInterceptorProvider),
 	fx.Provide(TelemetryInterceptorProvider),
+	fx.Provide(SlowRequestLogInterceptorProvider),
+	fx.Provide(TraceContextsProvider),
 	fx.Provide(RateLimitInterceptorProvider),
 	fx.Provide(service.GrpcServerOptionsProvider),
 	fx.Provide(ESProcessorConfigProvider),
@@ -83,10 +94,13 @@ var Module = fx.Options(
 	fx.Provide(ServiceResolverProvider),
 	fx.Provide(EventNotifierProvider),
 	fx.Provide(ArchivalClientProvider),
+	fx.Provide(persistenceClient.ArchivalDLQProvider),
 	fx.Provide(HistoryEngineFactoryProvider),
 	fx.Provide(HandlerProvider),
 	fx.Provide(ServiceProvider),
 	fx.Invoke(ServiceLifetimeHooks),
+	fx.Provide(DrainerProvider),
+	fx.Invoke(resource.DrainWatcherLifetimeHooks),
 )
 
 func ServiceProvider(
@@ -100,6 +114,9 @@ func ServiceProvider(
 	metricsHandler metrics.Handler,
 	faultInjectionDataStoreFactory *persistenceClient.FaultInjectionDataStoreFactory,
 	healthServer *health.Server,
+	namespaceRegistry namespace.Registry,
+	clusterMetadata cluster.Metadata,
+	clusterMetadataManager persistence.ClusterMetadataManager,
 ) *Service {
 	return NewService(
 		grpcServerOptions,
@@ -112,6 +129,9 @@ func ServiceProvider(
 		metricsHandler,
 		faultInjectionDataStoreFactory,
 		healthServer,
+		namespaceRegistry,
+		clusterMetadata,
+		clusterMetadataManager,
 	)
 }
 
@@ -137,6 +157,7 @@ func HandlerProvider(args NewHandlerArgs) *Handler {
 		saProvider:                   args.SaProvider,
 		clusterMetadata:              args.ClusterMetadata,
 		archivalMetadata:             args.ArchivalMetadata,
+		archiverProvider:             args.ArchiverProvider,
 		hostInfoProvider:             args.HostInfoProvider,
 		controller:                   args.ShardController,
 		eventNotifier:                args.EventNotifier,
@@ -144,6 +165,7 @@ func HandlerProvider(args NewHandlerArgs) *Handler {
 
 		replicationTaskFetcherFactory: args.ReplicationTaskFetcherFactory,
 		streamReceiverMonitor:         args.StreamReceiverMonitor,
+		visibilityIngestionMonitor:    args.VisibilityIngestionMonitor,
 	}
 
 	// prevent us from trying to serve requests before shard controller is started and ready
@@ -192,9 +214,29 @@ func TelemetryInterceptorProvider(
 		namespaceRegistry,
 		metricsHandler,
 		logger,
+		nil, // actions are only emitted by frontend
 	)
 }
 
+func SlowRequestLogInterceptorProvider(
+	serviceConfig *configs.Config,
+	logger log.Logger,
+) *interceptor.SlowRequestLogInterceptor {
+	return interceptor.NewSlowRequestLogInterceptor(
+		serviceConfig.SlowRequestLogThresholds,
+		serviceConfig.SlowRequestSummarySampleRate,
+		serviceConfig.SlowRequestSummaryMaxSize,
+		configs.LongPollAPIs,
+		logger,
+	)
+}
+
+// TraceContextsProvider keeps the span context of the requests that created recent transfer tasks,
+// so that processing those tasks on this host is part of the request trace.
+func TraceContextsProvider() *tasks.TraceContexts {
+	return tasks.NewTraceContexts(traceContextsMaxSize)
+}
+
 func RateLimitInterceptorProvider(
 	serviceConfig *configs.Config,
 ) *interceptor.RateLimitInterceptor {
@@ -214,6 +256,7 @@ func ESProcessorConfigProvider(
 		ESProcessorBulkSize:      serviceConfig.ESProcessorBulkSize,
 		ESProcessorFlushInterval: serviceConfig.ESProcessorFlushInterval,
 		ESProcessorAckTimeout:    serviceConfig.ESProcessorAckTimeout,
+		Stats:                    elasticsearch.NewProcessorStats(),
 	}
 }
 
@@ -239,7 +282,9 @@ func VisibilityManagerProvider(
 	esClient esclient.Client,
 	persistenceServiceResolver resolver.ServiceResolver,
 	searchAttributesMapperProvider searchattribute.MapperProvider,
+	persistenceKeyProvider persistenceEncryption.KeyProvider,
 	saProvider searchattribute.Provider,
+	circuitBreakers *persistence.CircuitBreakers,
 ) (manager.VisibilityManager, error) {
 	return visibility.NewManager(
 		*persistenceConfig,
@@ -248,12 +293,18 @@ func VisibilityManagerProvider(
 		esProcessorConfig,
 		saProvider,
 		searchAttributesMapperProvider,
+		persistenceKeyProvider,
 		serviceConfig.VisibilityPersistenceMaxReadQPS,
 		serviceConfig.VisibilityPersistenceMaxWriteQPS,
 		serviceConfig.EnableReadFromSecondaryVisibility,
 		serviceConfig.SecondaryVisibilityWritingMode,
 		serviceConfig.VisibilityDisableOrderByClause,
 		serviceConfig.VisibilityEnableManualPagination,
+		circuitBreakers,
+		&visibility.DualWriteVerificationConfig{
+			SampleRate: serviceConfig.VisibilityDualWriteVerificationSampleRate,
+			Delay:      serviceConfig.VisibilityDualWriteVerificationDelay,
+		},
 		metricsHandler,
 		logger,
 	)
@@ -289,6 +340,11 @@ func ArchivalClientProvider(
 	)
 }
 
+// DrainerProvider drains the host when the drain document of the system namespace targets it
+func DrainerProvider(svc *Service) drain.Drainer {
+	return svc
+}
+
 func ServiceLifetimeHooks(
 	lc fx.Lifecycle,
 	svcStoppedCh chan struct{},
@@ -35,8 +35,10 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.uber.org/fx"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/metadata"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
@@ -47,6 +49,7 @@ import (
 	"go.temporal.io/server/client/history"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/convert"
@@ -59,6 +62,7 @@ import (
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/standard/cassandra"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
@@ -93,12 +97,14 @@ type (
 		saProvider                   searchattribute.Provider
 		clusterMetadata              cluster.Metadata
 		archivalMetadata             archiver.ArchivalMetadata
+		archiverProvider             provider.ArchiverProvider
 		hostInfoProvider             membership.HostInfoProvider
 		controller                   shard.Controller
 		tracer                       trace.Tracer
 
 		replicationTaskFetcherFactory replication.TaskFetcherFactory
 		streamReceiverMonitor         replication.StreamReceiverMonitor
+		visibilityIngestionMonitor    *VisibilityIngestionMonitor
 	}
 
 	NewHandlerArgs struct {
@@ -118,6 +124,7 @@ type (
 		SaProvider                   searchattribute.Provider
 		ClusterMetadata              cluster.Metadata
 		ArchivalMetadata             archiver.ArchivalMetadata
+		ArchiverProvider             provider.ArchiverProvider
 		HostInfoProvider             membership.HostInfoProvider
 		ShardController              shard.Controller
 		EventNotifier                events.Notifier
@@ -125,11 +132,14 @@ type (
 
 		ReplicationTaskFetcherFactory replication.TaskFetcherFactory
 		StreamReceiverMonitor         replication.StreamReceiverMonitor
+		VisibilityIngestionMonitor    *VisibilityIngestionMonitor
 	}
 )
 
 const (
 	serviceName = "temporal.api.workflowservice.v1.HistoryService"
+
+	rehydrateHistoryPageSize = 1000
 )
 
 var (
@@ -524,6 +534,9 @@ func (h *Handler) StartWorkflowExecution(ctx context.Context, request *historyse
 	if err != nil {
 		return nil, h.convertError(err)
 	}
+	if err := h.visibilityIngestionMonitor.checkBackpressure(shardContext.GetShardID()); err != nil {
+		return nil, h.convertError(err)
+	}
 
 	engine, err := shardContext.GetEngine(ctx)
 	if err != nil {
@@ -563,6 +576,77 @@ func (h *Handler) DescribeHistoryHost(_ context.Context, _ *historyservice.Descr
 	return resp, nil
 }
 
+// DescribeVisibilityIngestion returns the visibility queue lag of the shards owned by the host, and the state
+// of its Elasticsearch bulk processors. Requested shards not owned by the host are skipped.
+func (h *Handler) DescribeVisibilityIngestion(_ context.Context, request *historyservice.DescribeVisibilityIngestionRequest) (_ *historyservice.DescribeVisibilityIngestionResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	ownedShardIDs := h.controller.ShardIDs()
+	shardIDs := ownedShardIDs
+	if len(request.GetShardIds()) != 0 {
+		shardIDs = make([]int32, 0, len(request.GetShardIds()))
+		for _, shardID := range request.GetShardIds() {
+			if slices.Contains(ownedShardIDs, shardID) {
+				shardIDs = append(shardIDs, shardID)
+			}
+		}
+	}
+	return h.visibilityIngestionMonitor.describe(shardIDs), nil
+}
+
+// AwaitVisibilityIngestion waits until the visibility queue of a shard has completed all the tasks written to
+// the shard before the request.
+func (h *Handler) AwaitVisibilityIngestion(ctx context.Context, request *historyservice.AwaitVisibilityIngestionRequest) (_ *historyservice.AwaitVisibilityIngestionResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	shardContext, err := h.controller.GetShardByID(request.GetShardId())
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.AwaitVisibilityIngestion(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return resp, nil
+}
+
+// DescribeShard returns the tasks the queues of a shard hold in memory and the state of its replication stream senders
+func (h *Handler) DescribeShard(ctx context.Context, request *historyservice.DescribeShardRequest) (_ *historyservice.DescribeShardResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	shardContext, err := h.controller.GetShardByID(request.GetShardId())
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.DescribeShard(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	resp.ReplicationStreamSenders = h.streamReceiverMonitor.DescribeInboundStreams(request.GetShardId())
+	return resp, nil
+}
+
 // RemoveTask returns information about the internal states of a history host
 func (h *Handler) RemoveTask(ctx context.Context, request *historyservice.RemoveTaskRequest) (_ *historyservice.RemoveTaskResponse, retError error) {
 	var err error
@@ -842,6 +926,9 @@ func (h *Handler) SignalWorkflowExecution(ctx context.Context, request *historys
 	if err != nil {
 		return nil, h.convertError(err)
 	}
+	if err := h.visibilityIngestionMonitor.checkBackpressure(shardContext.GetShardID()); err != nil {
+		return nil, h.convertError(err)
+	}
 	engine, err := shardContext.GetEngine(ctx)
 	if err != nil {
 		return nil, h.convertError(err)
@@ -879,6 +966,9 @@ func (h *Handler) SignalWithStartWorkflowExecution(ctx context.Context, request
 	if err != nil {
 		return nil, h.convertError(err)
 	}
+	if err := h.visibilityIngestionMonitor.checkBackpressure(shardContext.GetShardID()); err != nil {
+		return nil, h.convertError(err)
+	}
 	engine, err := shardContext.GetEngine(ctx)
 	if err != nil {
 		return nil, h.convertError(err)
@@ -1853,6 +1943,209 @@ func (h *Handler) UpdateWorkflowExecution(
 	return engine.UpdateWorkflowExecution(ctx, request)
 }
 
+// UpdateWorkflowExecutionMemo upserts memo fields of a running or closed workflow and updates its visibility record.
+func (h *Handler) UpdateWorkflowExecutionMemo(
+	ctx context.Context,
+	request *historyservice.UpdateWorkflowExecutionMemoRequest,
+) (_ *historyservice.UpdateWorkflowExecutionMemoResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+	if request.GetWorkflowExecution() == nil {
+		return nil, h.convertError(errWorkflowExecutionNotSet)
+	}
+	if request.GetWorkflowExecution().GetWorkflowId() == "" {
+		return nil, h.convertError(errWorkflowIDNotSet)
+	}
+
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, request.GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	memo, err := engine.UpdateWorkflowExecutionMemo(
+		ctx,
+		namespaceID,
+		request.GetWorkflowExecution(),
+		request.GetMemo(),
+		request.GetIdentity(),
+		request.GetReason(),
+	)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return &historyservice.UpdateWorkflowExecutionMemoResponse{Memo: memo}, nil
+}
+
+// UpsertWorkflowExecutionSearchAttributes upserts search attributes of a running or closed workflow and updates its
+// visibility record.
+func (h *Handler) UpsertWorkflowExecutionSearchAttributes(
+	ctx context.Context,
+	request *historyservice.UpsertWorkflowExecutionSearchAttributesRequest,
+) (_ *historyservice.UpsertWorkflowExecutionSearchAttributesResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+	if request.GetWorkflowExecution() == nil {
+		return nil, h.convertError(errWorkflowExecutionNotSet)
+	}
+	if request.GetWorkflowExecution().GetWorkflowId() == "" {
+		return nil, h.convertError(errWorkflowIDNotSet)
+	}
+
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, request.GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	err = engine.UpsertWorkflowExecutionSearchAttributes(
+		ctx,
+		namespaceID,
+		request.GetWorkflowExecution(),
+		request.GetSearchAttributes(),
+		request.GetIdentity(),
+		request.GetReason(),
+	)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return &historyservice.UpsertWorkflowExecutionSearchAttributesResponse{}, nil
+}
+
+// RehydrateWorkflowExecution reads the archived history of a closed workflow execution and imports it back into
+// the persistence store, so that it can be inspected, reset or replayed through the regular APIs.
+func (h *Handler) RehydrateWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.RehydrateWorkflowExecutionRequest,
+) (_ *historyservice.RehydrateWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+	if request.Execution == nil {
+		return nil, h.convertError(errWorkflowExecutionNotSet)
+	}
+	if request.Execution.GetWorkflowId() == "" {
+		return nil, h.convertError(errWorkflowIDNotSet)
+	}
+	if uuid.Parse(request.Execution.GetRunId()) == nil {
+		return nil, h.convertError(errRunIDNotValid)
+	}
+	runID := request.Execution.GetRunId()
+	if request.GetNewRunId() != "" {
+		if uuid.Parse(request.GetNewRunId()) == nil {
+			return nil, h.convertError(errRunIDNotValid)
+		}
+		runID = request.GetNewRunId()
+	}
+
+	historyBatches, err := h.readArchivedHistory(ctx, namespaceID, request.Execution, request.GetUri())
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, request.Execution.GetWorkflowId())
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	if err := engine.RehydrateWorkflowExecution(
+		ctx,
+		namespaceID,
+		commonpb.WorkflowExecution{
+			WorkflowId: request.Execution.GetWorkflowId(),
+			RunId:      runID,
+		},
+		historyBatches,
+	); err != nil {
+		return nil, h.convertError(err)
+	}
+	return &historyservice.RehydrateWorkflowExecutionResponse{RunId: runID}, nil
+}
+
+// readArchivedHistory reads the whole archived history of the highest close failover version of an execution.
+func (h *Handler) readArchivedHistory(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	execution *commonpb.WorkflowExecution,
+	archivalURI string,
+) ([]*historypb.History, error) {
+	if archivalURI == "" {
+		namespaceEntry, err := h.namespaceRegistry.GetNamespaceByID(namespaceID)
+		if err != nil {
+			return nil, err
+		}
+		archivalURI = namespaceEntry.HistoryArchivalState().URI
+		if archivalURI == "" {
+			return nil, serviceerror.NewFailedPrecondition("Namespace has no history archival URI.")
+		}
+	}
+	uri, err := archiver.NewURI(archivalURI)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+	historyArchiver, err := h.archiverProvider.GetHistoryArchiver(uri.Scheme(), string(primitives.HistoryService))
+	if err != nil {
+		return nil, err
+	}
+
+	var historyBatches []*historypb.History
+	request := &archiver.GetHistoryRequest{
+		NamespaceID: namespaceID.String(),
+		WorkflowID:  execution.GetWorkflowId(),
+		RunID:       execution.GetRunId(),
+		PageSize:    rehydrateHistoryPageSize,
+	}
+	for {
+		resp, err := historyArchiver.Get(ctx, uri, request)
+		if err != nil {
+			return nil, err
+		}
+		historyBatches = append(historyBatches, resp.HistoryBatches...)
+		if len(resp.NextPageToken) == 0 {
+			return historyBatches, nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
 func (h *Handler) PollWorkflowExecutionUpdate(
 	ctx context.Context,
 	request *historyservice.PollWorkflowExecutionUpdateRequest,
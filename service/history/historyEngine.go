@@ -32,6 +32,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	commonpb "go.temporal.io/api/common/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
@@ -54,8 +55,10 @@ import (
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/api/awaitvisibilityingestion"
 	"go.temporal.io/server/service/history/api/deleteworkflow"
 	"go.temporal.io/server/service/history/api/describemutablestate"
+	"go.temporal.io/server/service/history/api/describeshard"
 	"go.temporal.io/server/service/history/api/describeworkflow"
 	"go.temporal.io/server/service/history/api/pollupdate"
 	"go.temporal.io/server/service/history/api/queryworkflow"
@@ -64,6 +67,7 @@ import (
 	"go.temporal.io/server/service/history/api/recordactivitytaskstarted"
 	"go.temporal.io/server/service/history/api/recordchildworkflowcompleted"
 	"go.temporal.io/server/service/history/api/refreshworkflow"
+	"go.temporal.io/server/service/history/api/rehydrateworkflow"
 	"go.temporal.io/server/service/history/api/removesignalmutablestate"
 	replicationapi "go.temporal.io/server/service/history/api/replication"
 	"go.temporal.io/server/service/history/api/replicationadmin"
@@ -78,6 +82,8 @@ import (
 	"go.temporal.io/server/service/history/api/startworkflow"
 	"go.temporal.io/server/service/history/api/terminateworkflow"
 	"go.temporal.io/server/service/history/api/updateworkflow"
+	"go.temporal.io/server/service/history/api/updateworkflowmemo"
+	"go.temporal.io/server/service/history/api/upsertworkflowsearchattributes"
 	"go.temporal.io/server/service/history/api/verifychildworkflowcompletionrecorded"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
@@ -545,6 +551,55 @@ func (e *historyEngineImpl) UpdateWorkflowExecution(
 	return updateworkflow.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker, e.matchingClient)
 }
 
+func (e *historyEngineImpl) UpdateWorkflowExecutionMemo(
+	ctx context.Context,
+	namespaceUUID namespace.ID,
+	execution *commonpb.WorkflowExecution,
+	memo *commonpb.Memo,
+	identity string,
+	reason string,
+) (*commonpb.Memo, error) {
+	return updateworkflowmemo.Invoke(ctx, namespaceUUID, execution, memo, identity, reason, e.shard, e.workflowConsistencyChecker)
+}
+
+func (e *historyEngineImpl) UpsertWorkflowExecutionSearchAttributes(
+	ctx context.Context,
+	namespaceUUID namespace.ID,
+	execution *commonpb.WorkflowExecution,
+	searchAttributes *commonpb.SearchAttributes,
+	identity string,
+	reason string,
+) error {
+	return upsertworkflowsearchattributes.Invoke(ctx, namespaceUUID, execution, searchAttributes, identity, reason, e.shard, e.workflowConsistencyChecker, e.searchAttributesValidator)
+}
+
+func (e *historyEngineImpl) RehydrateWorkflowExecution(
+	ctx context.Context,
+	namespaceUUID namespace.ID,
+	execution commonpb.WorkflowExecution,
+	historyBatches []*historypb.History,
+) error {
+	return rehydrateworkflow.Invoke(ctx, namespaceUUID, execution.GetWorkflowId(), execution.GetRunId(), historyBatches, e.shard)
+}
+
+func (e *historyEngineImpl) AwaitVisibilityIngestion(
+	ctx context.Context,
+	_ *historyservice.AwaitVisibilityIngestionRequest,
+) (*historyservice.AwaitVisibilityIngestionResponse, error) {
+	visibilityQueue, ok := e.queueProcessors[tasks.CategoryVisibility].(queues.AckLevelQueue)
+	if !ok {
+		return nil, serviceerror.NewUnimplemented("Visibility queue of the shard does not report its ack level.")
+	}
+	return awaitvisibilityingestion.Invoke(ctx, e.shard, visibilityQueue)
+}
+
+func (e *historyEngineImpl) DescribeShard(
+	_ context.Context,
+	_ *historyservice.DescribeShardRequest,
+) (*historyservice.DescribeShardResponse, error) {
+	return describeshard.Invoke(e.shard, e.queueProcessors)
+}
+
 func (e *historyEngineImpl) PollWorkflowExecutionUpdate(
 	ctx context.Context,
 	req *historyservice.PollWorkflowExecutionUpdateRequest,
@@ -44,7 +44,6 @@ import (
 )
 
 var (
-	workflowTerminationReason   = "Terminate Workflow Due To Version Conflict."
 	workflowTerminationIdentity = "worker-service"
 )
 
@@ -279,7 +278,7 @@ func (r *WorkflowImpl) terminateWorkflow(
 
 	_, err = r.mutableState.AddWorkflowExecutionTerminatedEvent(
 		eventBatchFirstEventID,
-		workflowTerminationReason,
+		consts.TerminateReasonVersionConflict,
 		payloads.EncodeString(fmt.Sprintf("terminated by version: %v", incomingLastWriteVersion)),
 		workflowTerminationIdentity,
 		false,
@@ -89,6 +89,7 @@ type (
 
 var QueueModule = fx.Options(
 	fx.Provide(QueueSchedulerRateLimiterProvider),
+	fx.Provide(NewVisibilityIngestionMonitor),
 	fx.Provide(
 		fx.Annotated{
 			Group:  QueueFactoryFxGroup,
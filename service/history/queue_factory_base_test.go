@@ -41,7 +41,9 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/service/history/archival"
@@ -178,8 +180,10 @@ type compileTimeDependencies struct {
 	resource.MatchingClient
 	historyservice.HistoryServiceClient
 	manager.VisibilityManager
+	*elasticsearch.ProcessorConfig
 	archival.Archiver
 	workflow.RelocatableAttributesFetcher
+	persistence.ArchivalDLQ
 }
 
 // getArchivalMetadata returns a mock ArchivalMetadata that contains the static archival config specified in the given
@@ -106,6 +106,8 @@ func GetActiveTimerTaskTypeTagValue(
 		}
 	case *tasks.DeleteHistoryEventTask:
 		return metrics.TaskTypeTimerActiveTaskDeleteHistoryEvent
+	case *tasks.DeleteVisibilityRecordTask:
+		return metrics.TaskTypeTimerActiveTaskDeleteVisibilityRecord
 	case *tasks.ActivityRetryTimerTask:
 		return metrics.TaskTypeTimerActiveTaskActivityRetryTimer
 	case *tasks.WorkflowBackoffTimerTask:
@@ -129,6 +131,8 @@ func GetStandbyTimerTaskTypeTagValue(
 		return metrics.TaskTypeTimerStandbyTaskWorkflowTimeout
 	case *tasks.DeleteHistoryEventTask:
 		return metrics.TaskTypeTimerStandbyTaskDeleteHistoryEvent
+	case *tasks.DeleteVisibilityRecordTask:
+		return metrics.TaskTypeTimerStandbyTaskDeleteVisibilityRecord
 	case *tasks.ActivityRetryTimerTask:
 		return metrics.TaskTypeTimerStandbyTaskActivityRetryTimer
 	case *tasks.WorkflowBackoffTimerTask:
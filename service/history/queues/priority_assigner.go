@@ -50,6 +50,7 @@ func (a *priorityAssignerImpl) Assign(executable Executable) tasks.Priority {
 	taskType := executable.GetType()
 	switch taskType {
 	case enumsspb.TASK_TYPE_DELETE_HISTORY_EVENT,
+		enumsspb.TASK_TYPE_DELETE_VISIBILITY_RECORD,
 		enumsspb.TASK_TYPE_TRANSFER_DELETE_EXECUTION,
 		enumsspb.TASK_TYPE_VISIBILITY_DELETE_EXECUTION,
 		enumsspb.TASK_TYPE_ARCHIVAL_ARCHIVE_EXECUTION,
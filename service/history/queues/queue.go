@@ -25,6 +25,8 @@
 package queues
 
 import (
+	"context"
+
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/service/history/tasks"
 )
@@ -38,4 +40,21 @@ type (
 		NotifyNewTasks(tasks []tasks.Task)
 		FailoverNamespace(namespaceID string)
 	}
+
+	// AckLevelQueue is a Queue that reports how far its tasks are completed.
+	AckLevelQueue interface {
+		Queue
+		// ExclusiveAckLevel loads the tasks written to the shard so far, and returns the key
+		// below which all tasks of the queue are completed.
+		ExclusiveAckLevel(ctx context.Context) (tasks.Key, error)
+	}
+
+	// PendingTaskQueue is a Queue that reports the tasks it holds in memory.
+	PendingTaskQueue interface {
+		Queue
+		// PendingTaskCount returns the number of tasks loaded into memory and not completed yet.
+		PendingTaskCount() int
+		// SliceCount returns the number of slices the loaded tasks are tracked in.
+		SliceCount() int
+	}
 )
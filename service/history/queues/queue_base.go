@@ -119,7 +119,14 @@ type (
 		CheckpointInterval                  dynamicconfig.DurationPropertyFn
 		CheckpointIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
 		MaxReaderCount                      dynamicconfig.IntPropertyFn
+
+		// LagReporter, if set, is called on every checkpoint with the queue lag.
+		LagReporter LagReporter
 	}
+
+	// LagReporter receives the lag of a queue, which is how long its oldest
+	// pending task has been waiting since the task's visibility time.
+	LagReporter func(lag time.Duration)
 )
 
 func newQueueBase(
@@ -303,6 +310,14 @@ func (p *queueBase) FailoverNamespace(
 	p.rescheduler.Reschedule(namespaceID)
 }
 
+func (p *queueBase) PendingTaskCount() int {
+	return p.monitor.GetTotalPendingTaskCount()
+}
+
+func (p *queueBase) SliceCount() int {
+	return p.monitor.GetTotalSliceCount()
+}
+
 func (p *queueBase) processNewRange() {
 	var newMaxKey tasks.Key
 	switch categoryType := p.category.Type(); categoryType {
@@ -348,6 +363,9 @@ func (p *queueBase) checkpoint() {
 	p.readerGroup.ForEach(func(_ int64, r Reader) {
 		r.ShrinkSlices()
 	})
+	if p.options.LagReporter != nil {
+		p.options.LagReporter(p.lag())
+	}
 
 	// Run slicePredicateAction to move slices with non-universal predicate to non-default reader
 	// so that upon shard reload, task loading for those slices won't block other slices in the default reader.
@@ -403,6 +421,43 @@ func (p *queueBase) checkpoint() {
 	p.resetCheckpointTimer(err)
 }
 
+// exclusiveAckLevel shrinks the slices of all readers and returns the key below which all tasks are
+// completed, which is the minimum of the readers' pending ranges and of the range not loaded yet.
+func (p *queueBase) exclusiveAckLevel() tasks.Key {
+	ackLevel := p.nonReadableScope.Range.InclusiveMin
+	p.readerGroup.ForEach(func(_ int64, r Reader) {
+		r.ShrinkSlices()
+		if scopes := r.Scopes(); len(scopes) != 0 {
+			ackLevel = tasks.MinKey(ackLevel, scopes[0].Range.InclusiveMin)
+		}
+	})
+	return ackLevel
+}
+
+// lag must be called after slices are shrunk, so that acked tasks are not counted.
+// Tasks are loaded in key order, so the oldest pending task is
+// also the oldest task not completed by the queue, unless no task is loaded.
+func (p *queueBase) lag() time.Duration {
+	var oldest time.Time
+	p.readerGroup.ForEach(func(_ int64, r Reader) {
+		r.WalkSlices(func(s Slice) {
+			if taskTime := s.OldestPendingTaskTime(); !taskTime.IsZero() && (oldest.IsZero() || taskTime.Before(oldest)) {
+				oldest = taskTime
+			}
+		})
+	})
+	if oldest.IsZero() {
+		return 0
+	}
+
+	lag := p.timeSource.Now().Sub(oldest)
+	if lag < 0 {
+		// scheduled tasks may be loaded ahead of their fire time
+		return 0
+	}
+	return lag
+}
+
 func (p *queueBase) updateReaderProgress(
 	readerScopes map[int64][]Scope,
 ) {
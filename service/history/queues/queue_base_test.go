@@ -667,6 +667,56 @@ func (s *queueBaseSuite) TestCheckPoint_MoveSlices() {
 	s.True(scopes[0].Range.InclusiveMin.CompareTo(base.exclusiveDeletionHighWatermark) == 0)
 }
 
+func (s *queueBaseSuite) TestExclusiveAckLevel() {
+	exclusiveReaderHighWatermark := tasks.MaximumKey
+	scopeMinKey := exclusiveReaderHighWatermark
+	readerScopes := map[int64][]Scope{}
+	readerIDs := []int64{DefaultReaderId, 2, 3}
+	for _, readerID := range readerIDs {
+		scopes := NewRandomScopes(10)
+		readerScopes[readerID] = scopes
+		if len(scopes) != 0 {
+			scopeMinKey = tasks.MinKey(scopeMinKey, scopes[0].Range.InclusiveMin)
+		}
+	}
+	persistenceState := ToPersistenceQueueState(&queueState{
+		readerScopes:                 readerScopes,
+		exclusiveReaderHighWatermark: exclusiveReaderHighWatermark,
+	})
+
+	mockShard := shard.NewTestContext(
+		s.controller,
+		&persistencespb.ShardInfo{
+			ShardId: 0,
+			RangeId: 10,
+			QueueStates: map[int32]*persistencespb.QueueState{
+				tasks.CategoryIDTimer: persistenceState,
+			},
+		},
+		s.config,
+	)
+	mockShard.Resource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	mockShard.Resource.ClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+	mockShard.Resource.ExecutionMgr.EXPECT().RegisterHistoryTaskReader(gomock.Any(), gomock.Any()).Return(nil).Times(len(readerIDs))
+
+	base := newQueueBase(
+		mockShard,
+		tasks.CategoryTimer,
+		nil,
+		s.mockScheduler,
+		s.mockRescheduler,
+		NewNoopPriorityAssigner(),
+		nil,
+		s.options,
+		s.rateLimiter,
+		NoopReaderCompletionFn,
+		s.logger,
+		s.metricsHandler,
+	)
+
+	s.True(scopeMinKey.CompareTo(base.exclusiveAckLevel()) == 0)
+}
+
 func (s *queueBaseSuite) TestUpdateReaderProgress() {
 	queueState := &queueState{
 		readerScopes: map[int64][]Scope{
@@ -25,9 +25,12 @@
 package queues
 
 import (
+	"context"
 	"sync/atomic"
 	"time"
 
+	"go.temporal.io/api/serviceerror"
+
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/collection"
@@ -40,13 +43,17 @@ import (
 	"go.temporal.io/server/service/history/tasks"
 )
 
-var _ Queue = (*immediateQueue)(nil)
+var _ AckLevelQueue = (*immediateQueue)(nil)
+var _ PendingTaskQueue = (*immediateQueue)(nil)
+
+var errQueueStopped = serviceerror.NewUnavailable("queue already stopped")
 
 type (
 	immediateQueue struct {
 		*queueBase
 
-		notifyCh chan struct{}
+		notifyCh   chan struct{}
+		ackLevelCh chan chan tasks.Key
 	}
 )
 
@@ -102,7 +109,8 @@ func NewImmediateQueue(
 			metricsHandler,
 		),
 
-		notifyCh: make(chan struct{}, 1),
+		notifyCh:   make(chan struct{}, 1),
+		ackLevelCh: make(chan chan tasks.Key),
 	}
 }
 
@@ -147,6 +155,27 @@ func (p *immediateQueue) NotifyNewTasks(tasks []tasks.Task) {
 	p.notify()
 }
 
+// ExclusiveAckLevel is served by the event loop of the queue, which owns its reader state.
+func (p *immediateQueue) ExclusiveAckLevel(ctx context.Context) (tasks.Key, error) {
+	ackLevelCh := make(chan tasks.Key, 1)
+	select {
+	case p.ackLevelCh <- ackLevelCh:
+	case <-p.shutdownCh:
+		return tasks.Key{}, errQueueStopped
+	case <-ctx.Done():
+		return tasks.Key{}, ctx.Err()
+	}
+
+	select {
+	case ackLevel := <-ackLevelCh:
+		return ackLevel, nil
+	case <-p.shutdownCh:
+		return tasks.Key{}, errQueueStopped
+	case <-ctx.Done():
+		return tasks.Key{}, ctx.Err()
+	}
+}
+
 func (p *immediateQueue) processEventLoop() {
 	defer p.shutdownWG.Done()
 
@@ -174,6 +203,9 @@ func (p *immediateQueue) processEventLoop() {
 			p.checkpoint()
 		case alert := <-p.alertCh:
 			p.handleAlert(alert)
+		case ackLevelCh := <-p.ackLevelCh:
+			p.processNewRange()
+			ackLevelCh <- p.exclusiveAckLevel()
 		}
 	}
 }
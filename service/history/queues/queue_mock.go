@@ -29,6 +29,7 @@
 package queues
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -119,3 +120,216 @@ func (mr *MockQueueMockRecorder) Stop() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockQueue)(nil).Stop))
 }
+
+// MockAckLevelQueue is a mock of AckLevelQueue interface.
+type MockAckLevelQueue struct {
+	ctrl     *gomock.Controller
+	recorder *MockAckLevelQueueMockRecorder
+}
+
+// MockAckLevelQueueMockRecorder is the mock recorder for MockAckLevelQueue.
+type MockAckLevelQueueMockRecorder struct {
+	mock *MockAckLevelQueue
+}
+
+// NewMockAckLevelQueue creates a new mock instance.
+func NewMockAckLevelQueue(ctrl *gomock.Controller) *MockAckLevelQueue {
+	mock := &MockAckLevelQueue{ctrl: ctrl}
+	mock.recorder = &MockAckLevelQueueMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAckLevelQueue) EXPECT() *MockAckLevelQueueMockRecorder {
+	return m.recorder
+}
+
+// Category mocks base method.
+func (m *MockAckLevelQueue) Category() tasks.Category {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Category")
+	ret0, _ := ret[0].(tasks.Category)
+	return ret0
+}
+
+// Category indicates an expected call of Category.
+func (mr *MockAckLevelQueueMockRecorder) Category() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Category", reflect.TypeOf((*MockAckLevelQueue)(nil).Category))
+}
+
+// ExclusiveAckLevel mocks base method.
+func (m *MockAckLevelQueue) ExclusiveAckLevel(ctx context.Context) (tasks.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExclusiveAckLevel", ctx)
+	ret0, _ := ret[0].(tasks.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExclusiveAckLevel indicates an expected call of ExclusiveAckLevel.
+func (mr *MockAckLevelQueueMockRecorder) ExclusiveAckLevel(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExclusiveAckLevel", reflect.TypeOf((*MockAckLevelQueue)(nil).ExclusiveAckLevel), ctx)
+}
+
+// FailoverNamespace mocks base method.
+func (m *MockAckLevelQueue) FailoverNamespace(namespaceID string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FailoverNamespace", namespaceID)
+}
+
+// FailoverNamespace indicates an expected call of FailoverNamespace.
+func (mr *MockAckLevelQueueMockRecorder) FailoverNamespace(namespaceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailoverNamespace", reflect.TypeOf((*MockAckLevelQueue)(nil).FailoverNamespace), namespaceID)
+}
+
+// NotifyNewTasks mocks base method.
+func (m *MockAckLevelQueue) NotifyNewTasks(tasks []tasks.Task) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotifyNewTasks", tasks)
+}
+
+// NotifyNewTasks indicates an expected call of NotifyNewTasks.
+func (mr *MockAckLevelQueueMockRecorder) NotifyNewTasks(tasks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyNewTasks", reflect.TypeOf((*MockAckLevelQueue)(nil).NotifyNewTasks), tasks)
+}
+
+// Start mocks base method.
+func (m *MockAckLevelQueue) Start() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start")
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockAckLevelQueueMockRecorder) Start() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockAckLevelQueue)(nil).Start))
+}
+
+// Stop mocks base method.
+func (m *MockAckLevelQueue) Stop() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Stop")
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockAckLevelQueueMockRecorder) Stop() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockAckLevelQueue)(nil).Stop))
+}
+
+// MockPendingTaskQueue is a mock of PendingTaskQueue interface.
+type MockPendingTaskQueue struct {
+	ctrl     *gomock.Controller
+	recorder *MockPendingTaskQueueMockRecorder
+}
+
+// MockPendingTaskQueueMockRecorder is the mock recorder for MockPendingTaskQueue.
+type MockPendingTaskQueueMockRecorder struct {
+	mock *MockPendingTaskQueue
+}
+
+// NewMockPendingTaskQueue creates a new mock instance.
+func NewMockPendingTaskQueue(ctrl *gomock.Controller) *MockPendingTaskQueue {
+	mock := &MockPendingTaskQueue{ctrl: ctrl}
+	mock.recorder = &MockPendingTaskQueueMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPendingTaskQueue) EXPECT() *MockPendingTaskQueueMockRecorder {
+	return m.recorder
+}
+
+// Category mocks base method.
+func (m *MockPendingTaskQueue) Category() tasks.Category {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Category")
+	ret0, _ := ret[0].(tasks.Category)
+	return ret0
+}
+
+// Category indicates an expected call of Category.
+func (mr *MockPendingTaskQueueMockRecorder) Category() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Category", reflect.TypeOf((*MockPendingTaskQueue)(nil).Category))
+}
+
+// FailoverNamespace mocks base method.
+func (m *MockPendingTaskQueue) FailoverNamespace(namespaceID string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FailoverNamespace", namespaceID)
+}
+
+// FailoverNamespace indicates an expected call of FailoverNamespace.
+func (mr *MockPendingTaskQueueMockRecorder) FailoverNamespace(namespaceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailoverNamespace", reflect.TypeOf((*MockPendingTaskQueue)(nil).FailoverNamespace), namespaceID)
+}
+
+// NotifyNewTasks mocks base method.
+func (m *MockPendingTaskQueue) NotifyNewTasks(tasks []tasks.Task) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotifyNewTasks", tasks)
+}
+
+// NotifyNewTasks indicates an expected call of NotifyNewTasks.
+func (mr *MockPendingTaskQueueMockRecorder) NotifyNewTasks(tasks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyNewTasks", reflect.TypeOf((*MockPendingTaskQueue)(nil).NotifyNewTasks), tasks)
+}
+
+// PendingTaskCount mocks base method.
+func (m *MockPendingTaskQueue) PendingTaskCount() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PendingTaskCount")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// PendingTaskCount indicates an expected call of PendingTaskCount.
+func (mr *MockPendingTaskQueueMockRecorder) PendingTaskCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingTaskCount", reflect.TypeOf((*MockPendingTaskQueue)(nil).PendingTaskCount))
+}
+
+// SliceCount mocks base method.
+func (m *MockPendingTaskQueue) SliceCount() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SliceCount")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// SliceCount indicates an expected call of SliceCount.
+func (mr *MockPendingTaskQueueMockRecorder) SliceCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SliceCount", reflect.TypeOf((*MockPendingTaskQueue)(nil).SliceCount))
+}
+
+// Start mocks base method.
+func (m *MockPendingTaskQueue) Start() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start")
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockPendingTaskQueueMockRecorder) Start() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockPendingTaskQueue)(nil).Start))
+}
+
+// Stop mocks base method.
+func (m *MockPendingTaskQueue) Stop() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Stop")
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockPendingTaskQueueMockRecorder) Stop() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockPendingTaskQueue)(nil).Stop))
+}
@@ -43,7 +43,7 @@ import (
 	"go.temporal.io/server/service/history/tasks"
 )
 
-var _ Queue = (*scheduledQueue)(nil)
+var _ PendingTaskQueue = (*scheduledQueue)(nil)
 
 type (
 	scheduledQueue struct {
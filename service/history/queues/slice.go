@@ -26,6 +26,7 @@ package queues
 
 import (
 	"fmt"
+	"time"
 
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/service/history/tasks"
@@ -53,6 +54,7 @@ type (
 		SelectTasks(readerID int64, batchSize int) ([]Executable, error)
 		MoreTasks() bool
 		TaskStats() TaskStats
+		OldestPendingTaskTime() time.Time
 		Clear()
 	}
 
@@ -419,6 +421,14 @@ func (s *SliceImpl) TaskStats() TaskStats {
 	}
 }
 
+// OldestPendingTaskTime returns the visibility time of the oldest task loaded
+// but not yet acked by this slice, or zero if there's no such task.
+func (s *SliceImpl) OldestPendingTaskTime() time.Time {
+	s.stateSanityCheck()
+
+	return s.executableTracker.oldestPendingTaskTime()
+}
+
 func (s *SliceImpl) Clear() {
 	s.stateSanityCheck()
 
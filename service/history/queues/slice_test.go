@@ -574,6 +574,27 @@ func (s *sliceSuite) TestClear() {
 	s.Equal(slice.scope.Range, slice.iterators[0].Range())
 }
 
+func (s *sliceSuite) TestOldestPendingTaskTime() {
+	r := NewRandomRange()
+	slice := NewSlice(nil, s.executableInitializer, s.monitor, NewScope(r, predicates.Universal[tasks.Task]()))
+	s.True(slice.OldestPendingTaskTime().IsZero())
+
+	now := time.Now()
+	executables := s.randomExecutablesInRange(r, 3)
+	for idx, executable := range executables {
+		mockExecutable := executable.(*MockExecutable)
+		mockExecutable.EXPECT().GetNamespaceID().Return(uuid.New()).AnyTimes()
+		mockExecutable.EXPECT().GetVisibilityTime().Return(now.Add(-time.Duration(idx) * time.Minute)).AnyTimes()
+		slice.executableTracker.add(executable)
+	}
+	executables[0].(*MockExecutable).EXPECT().State().Return(ctasks.TaskStatePending).AnyTimes()
+	executables[1].(*MockExecutable).EXPECT().State().Return(ctasks.TaskStatePending).AnyTimes()
+	executables[2].(*MockExecutable).EXPECT().State().Return(ctasks.TaskStateAcked).AnyTimes()
+
+	// acked tasks are not pending even before the slice is shrunk
+	s.Equal(now.Add(-time.Minute), slice.OldestPendingTaskTime())
+}
+
 func (s *sliceSuite) newTestSlice(
 	r Range,
 	namespaceIDs []string,
@@ -26,6 +26,7 @@ package queues
 
 import (
 	"fmt"
+	"time"
 
 	"go.temporal.io/server/common/namespace"
 	ctasks "go.temporal.io/server/common/tasks"
@@ -126,6 +127,20 @@ func (t *executableTracker) shrink() tasks.Key {
 	return minPendingTaskKey
 }
 
+func (t *executableTracker) oldestPendingTaskTime() time.Time {
+	var oldest time.Time
+	for _, executable := range t.pendingExecutables {
+		if executable.State() == ctasks.TaskStateAcked {
+			continue
+		}
+
+		if visibilityTime := executable.GetVisibilityTime(); oldest.IsZero() || visibilityTime.Before(oldest) {
+			oldest = visibilityTime
+		}
+	}
+	return oldest
+}
+
 func (t *executableTracker) clear() {
 	for _, executable := range t.pendingExecutables {
 		executable.Cancel()
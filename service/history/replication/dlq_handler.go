@@ -47,6 +47,10 @@ import (
 	wcache "go.temporal.io/server/service/history/workflow/cache"
 )
 
+const (
+	dlqPurgeNamespacePageSize = 100
+)
+
 type (
 	// DLQHandler is the interface handles replication DLQ messages
 	DLQHandler interface {
@@ -60,11 +64,13 @@ type (
 		PurgeMessages(
 			ctx context.Context,
 			sourceCluster string,
+			namespaceID string,
 			lastMessageID int64,
 		) error
 		MergeMessages(
 			ctx context.Context,
 			sourceCluster string,
+			namespaceID string,
 			lastMessageID int64,
 			pageSize int,
 			pageToken []byte,
@@ -145,6 +151,7 @@ func (r *dlqHandlerImpl) GetMessages(
 	taskList, taskInfoList, _, token, err := r.readMessagesWithAckLevel(
 		ctx,
 		sourceCluster,
+		"",
 		lastMessageID,
 		pageSize,
 		pageToken,
@@ -155,9 +162,14 @@ func (r *dlqHandlerImpl) GetMessages(
 func (r *dlqHandlerImpl) PurgeMessages(
 	ctx context.Context,
 	sourceCluster string,
+	namespaceID string,
 	lastMessageID int64,
 ) error {
 
+	if namespaceID != "" {
+		return r.purgeNamespaceMessages(ctx, sourceCluster, namespaceID, lastMessageID)
+	}
+
 	ackLevel := r.shard.GetReplicatorDLQAckLevel(sourceCluster)
 	err := r.shard.GetExecutionManager().RangeDeleteReplicationTaskFromDLQ(
 		ctx,
@@ -188,14 +200,16 @@ func (r *dlqHandlerImpl) PurgeMessages(
 func (r *dlqHandlerImpl) MergeMessages(
 	ctx context.Context,
 	sourceCluster string,
+	namespaceID string,
 	lastMessageID int64,
 	pageSize int,
 	pageToken []byte,
 ) ([]byte, error) {
 
-	replicationTasks, _, ackLevel, token, err := r.readMessagesWithAckLevel(
+	replicationTasks, taskInfos, ackLevel, token, err := r.readMessagesWithAckLevel(
 		ctx,
 		sourceCluster,
+		namespaceID,
 		lastMessageID,
 		pageSize,
 		pageToken,
@@ -219,6 +233,17 @@ func (r *dlqHandlerImpl) MergeMessages(
 		}
 	}
 
+	if namespaceID != "" {
+		// Messages of other namespaces stay in the DLQ, so only the merged
+		// messages are deleted and the DLQ ack level is left untouched.
+		for _, taskInfo := range taskInfos {
+			if err := r.deleteMessage(ctx, sourceCluster, taskInfo.GetTaskId()); err != nil {
+				return nil, err
+			}
+		}
+		return token, nil
+	}
+
 	err = r.shard.GetExecutionManager().RangeDeleteReplicationTaskFromDLQ(
 		ctx,
 		&persistence.RangeDeleteReplicationTaskFromDLQRequest{
@@ -245,9 +270,71 @@ func (r *dlqHandlerImpl) MergeMessages(
 	return token, nil
 }
 
+func (r *dlqHandlerImpl) purgeNamespaceMessages(
+	ctx context.Context,
+	sourceCluster string,
+	namespaceID string,
+	lastMessageID int64,
+) error {
+
+	ackLevel := r.shard.GetReplicatorDLQAckLevel(sourceCluster)
+	var pageToken []byte
+	for {
+		resp, err := r.shard.GetExecutionManager().GetReplicationTasksFromDLQ(ctx, &persistence.GetReplicationTasksFromDLQRequest{
+			GetHistoryTasksRequest: persistence.GetHistoryTasksRequest{
+				ShardID:             r.shard.GetShardID(),
+				TaskCategory:        tasks.CategoryReplication,
+				ReaderID:            common.DefaultQueueReaderID,
+				InclusiveMinTaskKey: tasks.NewImmediateKey(ackLevel + 1),
+				ExclusiveMaxTaskKey: tasks.NewImmediateKey(lastMessageID + 1),
+				BatchSize:           dlqPurgeNamespacePageSize,
+				NextPageToken:       pageToken,
+			},
+			SourceClusterName: sourceCluster,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, task := range resp.Tasks {
+			if task.GetNamespaceID() != namespaceID {
+				continue
+			}
+			if err := r.deleteMessage(ctx, sourceCluster, task.GetTaskID()); err != nil {
+				return err
+			}
+		}
+
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+func (r *dlqHandlerImpl) deleteMessage(
+	ctx context.Context,
+	sourceCluster string,
+	messageID int64,
+) error {
+
+	return r.shard.GetExecutionManager().DeleteReplicationTaskFromDLQ(
+		ctx,
+		&persistence.DeleteReplicationTaskFromDLQRequest{
+			CompleteHistoryTaskRequest: persistence.CompleteHistoryTaskRequest{
+				ShardID:      r.shard.GetShardID(),
+				TaskCategory: tasks.CategoryReplication,
+				TaskKey:      tasks.NewImmediateKey(messageID),
+			},
+			SourceClusterName: sourceCluster,
+		},
+	)
+}
+
 func (r *dlqHandlerImpl) readMessagesWithAckLevel(
 	ctx context.Context,
 	sourceCluster string,
+	namespaceID string,
 	lastMessageID int64,
 	pageSize int,
 	pageToken []byte,
@@ -277,6 +364,9 @@ func (r *dlqHandlerImpl) readMessagesWithAckLevel(
 	}
 	taskInfo := make([]*replicationspb.ReplicationTaskInfo, 0, len(resp.Tasks))
 	for _, task := range resp.Tasks {
+		if namespaceID != "" && task.GetNamespaceID() != namespaceID {
+			continue
+		}
 		switch task := task.(type) {
 		case *tasks.SyncActivityTask:
 			taskInfo = append(taskInfo, &replicationspb.ReplicationTaskInfo{
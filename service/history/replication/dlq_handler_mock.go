@@ -77,30 +77,30 @@ func (mr *MockDLQHandlerMockRecorder) GetMessages(ctx, sourceCluster, lastMessag
 }
 
 // MergeMessages mocks base method.
-func (m *MockDLQHandler) MergeMessages(ctx context.Context, sourceCluster string, lastMessageID int64, pageSize int, pageToken []byte) ([]byte, error) {
+func (m *MockDLQHandler) MergeMessages(ctx context.Context, sourceCluster, namespaceID string, lastMessageID int64, pageSize int, pageToken []byte) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MergeMessages", ctx, sourceCluster, lastMessageID, pageSize, pageToken)
+	ret := m.ctrl.Call(m, "MergeMessages", ctx, sourceCluster, namespaceID, lastMessageID, pageSize, pageToken)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // MergeMessages indicates an expected call of MergeMessages.
-func (mr *MockDLQHandlerMockRecorder) MergeMessages(ctx, sourceCluster, lastMessageID, pageSize, pageToken interface{}) *gomock.Call {
+func (mr *MockDLQHandlerMockRecorder) MergeMessages(ctx, sourceCluster, namespaceID, lastMessageID, pageSize, pageToken interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeMessages", reflect.TypeOf((*MockDLQHandler)(nil).MergeMessages), ctx, sourceCluster, lastMessageID, pageSize, pageToken)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeMessages", reflect.TypeOf((*MockDLQHandler)(nil).MergeMessages), ctx, sourceCluster, namespaceID, lastMessageID, pageSize, pageToken)
 }
 
 // PurgeMessages mocks base method.
-func (m *MockDLQHandler) PurgeMessages(ctx context.Context, sourceCluster string, lastMessageID int64) error {
+func (m *MockDLQHandler) PurgeMessages(ctx context.Context, sourceCluster, namespaceID string, lastMessageID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PurgeMessages", ctx, sourceCluster, lastMessageID)
+	ret := m.ctrl.Call(m, "PurgeMessages", ctx, sourceCluster, namespaceID, lastMessageID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // PurgeMessages indicates an expected call of PurgeMessages.
-func (mr *MockDLQHandlerMockRecorder) PurgeMessages(ctx, sourceCluster, lastMessageID interface{}) *gomock.Call {
+func (mr *MockDLQHandlerMockRecorder) PurgeMessages(ctx, sourceCluster, namespaceID, lastMessageID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeMessages", reflect.TypeOf((*MockDLQHandler)(nil).PurgeMessages), ctx, sourceCluster, lastMessageID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeMessages", reflect.TypeOf((*MockDLQHandler)(nil).PurgeMessages), ctx, sourceCluster, namespaceID, lastMessageID)
 }
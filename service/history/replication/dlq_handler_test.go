@@ -231,9 +231,49 @@ func (s *dlqHandlerSuite) TestPurgeMessages() {
 		}).Return(nil)
 
 	s.shardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil)
-	err := s.replicationMessageHandler.PurgeMessages(context.Background(), s.sourceCluster, lastMessageID)
+	err := s.replicationMessageHandler.PurgeMessages(context.Background(), s.sourceCluster, "", lastMessageID)
 	s.NoError(err)
 }
+
+func (s *dlqHandlerSuite) TestPurgeMessages_Namespace() {
+	namespaceID := uuid.New()
+	lastMessageID := int64(1394)
+
+	s.executionManager.EXPECT().GetReplicationTasksFromDLQ(gomock.Any(), &persistence.GetReplicationTasksFromDLQRequest{
+		GetHistoryTasksRequest: persistence.GetHistoryTasksRequest{
+			ShardID:             s.mockShard.GetShardID(),
+			TaskCategory:        tasks.CategoryReplication,
+			ReaderID:            common.DefaultQueueReaderID,
+			InclusiveMinTaskKey: tasks.NewImmediateKey(persistence.EmptyQueueMessageID + 1),
+			ExclusiveMaxTaskKey: tasks.NewImmediateKey(lastMessageID + 1),
+			BatchSize:           dlqPurgeNamespacePageSize,
+		},
+		SourceClusterName: s.sourceCluster,
+	}).Return(&persistence.GetHistoryTasksResponse{
+		Tasks: []tasks.Task{
+			&tasks.HistoryReplicationTask{
+				WorkflowKey: definition.NewWorkflowKey(namespaceID, uuid.New(), uuid.New()),
+				TaskID:      1,
+			},
+			&tasks.HistoryReplicationTask{
+				WorkflowKey: definition.NewWorkflowKey(uuid.New(), uuid.New(), uuid.New()),
+				TaskID:      2,
+			},
+		},
+	}, nil)
+	s.executionManager.EXPECT().DeleteReplicationTaskFromDLQ(gomock.Any(), &persistence.DeleteReplicationTaskFromDLQRequest{
+		CompleteHistoryTaskRequest: persistence.CompleteHistoryTaskRequest{
+			ShardID:      s.mockShard.GetShardID(),
+			TaskCategory: tasks.CategoryReplication,
+			TaskKey:      tasks.NewImmediateKey(1),
+		},
+		SourceClusterName: s.sourceCluster,
+	}).Return(nil)
+
+	err := s.replicationMessageHandler.PurgeMessages(context.Background(), s.sourceCluster, namespaceID, lastMessageID)
+	s.NoError(err)
+}
+
 func (s *dlqHandlerSuite) TestMergeMessages() {
 	ctx := context.Background()
 
@@ -312,7 +352,60 @@ func (s *dlqHandlerSuite) TestMergeMessages() {
 
 	s.shardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil)
 
-	token, err := s.replicationMessageHandler.MergeMessages(ctx, s.sourceCluster, lastMessageID, pageSize, pageToken)
+	token, err := s.replicationMessageHandler.MergeMessages(ctx, s.sourceCluster, "", lastMessageID, pageSize, pageToken)
 	s.NoError(err)
 	s.Equal(pageToken, token)
 }
+
+func (s *dlqHandlerSuite) TestMergeMessages_Namespace() {
+	ctx := context.Background()
+
+	namespaceID := uuid.New()
+	workflowID := uuid.New()
+	runID := uuid.New()
+	taskID := int64(12345)
+	lastMessageID := int64(1394)
+	pageSize := 2
+
+	dbResp := &persistence.GetHistoryTasksResponse{
+		Tasks: []tasks.Task{
+			&tasks.HistoryReplicationTask{
+				WorkflowKey: definition.NewWorkflowKey(namespaceID, workflowID, runID),
+				TaskID:      taskID,
+			},
+			&tasks.HistoryReplicationTask{
+				WorkflowKey: definition.NewWorkflowKey(uuid.New(), uuid.New(), uuid.New()),
+				TaskID:      taskID + 1,
+			},
+		},
+	}
+
+	remoteTask := &replicationspb.ReplicationTask{
+		TaskType:     enumsspb.REPLICATION_TASK_TYPE_HISTORY_TASK,
+		SourceTaskId: taskID,
+	}
+
+	s.executionManager.EXPECT().GetReplicationTasksFromDLQ(gomock.Any(), gomock.Any()).Return(dbResp, nil)
+	s.mockClientBean.EXPECT().GetRemoteAdminClient(s.sourceCluster).Return(s.adminClient, nil).AnyTimes()
+	s.adminClient.EXPECT().GetDLQReplicationMessages(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *adminservice.GetDLQReplicationMessagesRequest, _ ...interface{}) (*adminservice.GetDLQReplicationMessagesResponse, error) {
+			s.Len(request.GetTaskInfos(), 1)
+			s.Equal(taskID, request.GetTaskInfos()[0].GetTaskId())
+			return &adminservice.GetDLQReplicationMessagesResponse{
+				ReplicationTasks: []*replicationspb.ReplicationTask{remoteTask},
+			}, nil
+		})
+	s.taskExecutor.EXPECT().Execute(gomock.Any(), remoteTask, true).Return(nil)
+	s.executionManager.EXPECT().DeleteReplicationTaskFromDLQ(gomock.Any(), &persistence.DeleteReplicationTaskFromDLQRequest{
+		CompleteHistoryTaskRequest: persistence.CompleteHistoryTaskRequest{
+			ShardID:      s.mockShard.GetShardID(),
+			TaskCategory: tasks.CategoryReplication,
+			TaskKey:      tasks.NewImmediateKey(taskID),
+		},
+		SourceClusterName: s.sourceCluster,
+	}).Return(nil)
+
+	token, err := s.replicationMessageHandler.MergeMessages(ctx, s.sourceCluster, namespaceID, lastMessageID, pageSize, nil)
+	s.NoError(err)
+	s.Nil(token)
+}
@@ -26,10 +26,12 @@ package replication
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/channel"
 	"go.temporal.io/server/common/cluster"
@@ -43,6 +45,8 @@ type (
 	StreamReceiverMonitor interface {
 		common.Daemon
 		RegisterInboundStream(streamSender StreamSender)
+		// DescribeInboundStreams returns the state of the senders streaming the replication tasks of a shard.
+		DescribeInboundStreams(shardID int32) []*adminservice.ReplicationStreamSenderState
 	}
 	StreamReceiverMonitorImpl struct {
 		ProcessToolBox
@@ -127,6 +131,28 @@ func (m *StreamReceiverMonitorImpl) RegisterInboundStream(
 	m.inboundStreams[streamKey] = streamSender
 }
 
+func (m *StreamReceiverMonitorImpl) DescribeInboundStreams(
+	shardID int32,
+) []*adminservice.ReplicationStreamSenderState {
+	m.Lock()
+	defer m.Unlock()
+
+	var states []*adminservice.ReplicationStreamSenderState
+	for streamKey, stream := range m.inboundStreams {
+		if streamKey.Server.ShardID != shardID || !stream.IsValid() {
+			continue
+		}
+		states = append(states, stream.Describe())
+	}
+	sort.Slice(states, func(i, j int) bool {
+		if states[i].GetClientCluster() != states[j].GetClientCluster() {
+			return states[i].GetClientCluster() < states[j].GetClientCluster()
+		}
+		return states[i].GetClientShardId() < states[j].GetClientShardId()
+	})
+	return states
+}
+
 func (m *StreamReceiverMonitorImpl) eventLoop() {
 	defer m.Stop()
 	ticker := time.NewTicker(streamReceiverMonitorInterval)
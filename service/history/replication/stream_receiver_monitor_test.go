@@ -376,6 +376,37 @@ func (s *streamReceiverMonitorSuite) TestDoReconcileInboundStreams_Reactivate()
 	s.Equal(streamSenderValid, stream)
 }
 
+func (s *streamReceiverMonitorSuite) TestDescribeInboundStreams() {
+	serverShardID := rand.Int31()
+	newStreamSender := func(serverShardID int32, valid bool) *MockStreamSender {
+		clientKey := NewClusterShardKey(int32(cluster.TestAlternativeClusterInitialFailoverVersion), rand.Int31())
+		serverKey := NewClusterShardKey(int32(cluster.TestCurrentClusterInitialFailoverVersion), serverShardID)
+		streamSender := NewMockStreamSender(s.controller)
+		streamSender.EXPECT().Key().Return(ClusterShardKeyPair{
+			Client: clientKey,
+			Server: serverKey,
+		}).AnyTimes()
+		streamSender.EXPECT().IsValid().Return(valid).AnyTimes()
+		return streamSender
+	}
+	streamSender := newStreamSender(serverShardID, true)
+	state := &adminservice.ReplicationStreamSenderState{
+		ClientCluster:          cluster.TestAlternativeClusterName,
+		ClientShardId:          streamSender.Key().Client.ShardID,
+		InclusiveLowWatermark:  10,
+		ExclusiveHighWatermark: 20,
+	}
+	streamSender.EXPECT().Describe().Return(state)
+	s.streamReceiverMonitor.RegisterInboundStream(streamSender)
+	s.streamReceiverMonitor.RegisterInboundStream(newStreamSender(serverShardID, false))
+	s.streamReceiverMonitor.RegisterInboundStream(newStreamSender(serverShardID+1, true))
+
+	s.Equal(
+		[]*adminservice.ReplicationStreamSenderState{state},
+		s.streamReceiverMonitor.DescribeInboundStreams(serverShardID),
+	)
+}
+
 func (s *streamReceiverMonitorSuite) TestDoReconcileOutboundStreams_Add() {
 	s.clusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
 
@@ -30,10 +30,14 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strconv"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	"go.temporal.io/api/serviceerror"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -64,6 +68,8 @@ type (
 		common.Daemon
 		IsValid() bool
 		Key() ClusterShardKeyPair
+		// Describe returns the watermarks of the tasks sent to and acknowledged by the receiving shard.
+		Describe() *adminservice.ReplicationStreamSenderState
 	}
 	StreamSenderImpl struct {
 		server        historyservice.HistoryService_StreamWorkflowReplicationMessagesServer
@@ -77,6 +83,11 @@ type (
 		clientShardKey ClusterShardKey
 		serverShardKey ClusterShardKey
 		shutdownChan   channel.ShutdownOnce
+
+		sync.Mutex
+		inclusiveLowWatermark     int64
+		inclusiveLowWatermarkTime *time.Time
+		exclusiveHighWatermark    int64
 	}
 )
 
@@ -146,6 +157,26 @@ func (s *StreamSenderImpl) Key() ClusterShardKeyPair {
 	}
 }
 
+func (s *StreamSenderImpl) Describe() *adminservice.ReplicationStreamSenderState {
+	clientClusterName, _, err := ClusterIDToClusterNameShardCount(
+		s.shardContext.GetClusterMetadata().GetAllClusterInfo(),
+		s.clientShardKey.ClusterID,
+	)
+	if err != nil {
+		clientClusterName = strconv.Itoa(int(s.clientShardKey.ClusterID))
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	return &adminservice.ReplicationStreamSenderState{
+		ClientCluster:             clientClusterName,
+		ClientShardId:             s.clientShardKey.ShardID,
+		InclusiveLowWatermark:     s.inclusiveLowWatermark,
+		InclusiveLowWatermarkTime: s.inclusiveLowWatermarkTime,
+		ExclusiveHighWatermark:    s.exclusiveHighWatermark,
+	}
+}
+
 func (s *StreamSenderImpl) recvEventLoop() error {
 	defer s.Stop()
 
@@ -236,6 +267,11 @@ func (s *StreamSenderImpl) recvSyncReplicationState(
 		inclusiveLowWatermark-1,
 		*inclusiveLowWatermarkTime,
 	)
+
+	s.Lock()
+	defer s.Unlock()
+	s.inclusiveLowWatermark = inclusiveLowWatermark
+	s.inclusiveLowWatermarkTime = inclusiveLowWatermarkTime
 	return nil
 }
 
@@ -303,14 +339,10 @@ func (s *StreamSenderImpl) sendTasks(
 		return err
 	}
 	if beginInclusiveWatermark == endExclusiveWatermark {
-		return s.server.Send(&historyservice.StreamWorkflowReplicationMessagesResponse{
-			Attributes: &historyservice.StreamWorkflowReplicationMessagesResponse_Messages{
-				Messages: &replicationspb.WorkflowReplicationMessages{
-					ReplicationTasks:           nil,
-					ExclusiveHighWatermark:     endExclusiveWatermark,
-					ExclusiveHighWatermarkTime: timestamp.TimeNowPtrUtc(),
-				},
-			},
+		return s.sendMessages(&replicationspb.WorkflowReplicationMessages{
+			ReplicationTasks:           nil,
+			ExclusiveHighWatermark:     endExclusiveWatermark,
+			ExclusiveHighWatermarkTime: timestamp.TimeNowPtrUtc(),
 		})
 	}
 
@@ -342,14 +374,10 @@ Loop:
 		if task == nil {
 			continue Loop
 		}
-		if err := s.server.Send(&historyservice.StreamWorkflowReplicationMessagesResponse{
-			Attributes: &historyservice.StreamWorkflowReplicationMessagesResponse_Messages{
-				Messages: &replicationspb.WorkflowReplicationMessages{
-					ReplicationTasks:           []*replicationspb.ReplicationTask{task},
-					ExclusiveHighWatermark:     task.SourceTaskId + 1,
-					ExclusiveHighWatermarkTime: task.VisibilityTime,
-				},
-			},
+		if err := s.sendMessages(&replicationspb.WorkflowReplicationMessages{
+			ReplicationTasks:           []*replicationspb.ReplicationTask{task},
+			ExclusiveHighWatermark:     task.SourceTaskId + 1,
+			ExclusiveHighWatermarkTime: task.VisibilityTime,
 		}); err != nil {
 			return err
 		}
@@ -360,15 +388,28 @@ Loop:
 			metrics.OperationTag(TaskOperationTag(task)),
 		)
 	}
-	return s.server.Send(&historyservice.StreamWorkflowReplicationMessagesResponse{
+	return s.sendMessages(&replicationspb.WorkflowReplicationMessages{
+		ReplicationTasks:           nil,
+		ExclusiveHighWatermark:     endExclusiveWatermark,
+		ExclusiveHighWatermarkTime: timestamp.TimeNowPtrUtc(),
+	})
+}
+
+func (s *StreamSenderImpl) sendMessages(
+	messages *replicationspb.WorkflowReplicationMessages,
+) error {
+	if err := s.server.Send(&historyservice.StreamWorkflowReplicationMessagesResponse{
 		Attributes: &historyservice.StreamWorkflowReplicationMessagesResponse_Messages{
-			Messages: &replicationspb.WorkflowReplicationMessages{
-				ReplicationTasks:           nil,
-				ExclusiveHighWatermark:     endExclusiveWatermark,
-				ExclusiveHighWatermarkTime: timestamp.TimeNowPtrUtc(),
-			},
+			Messages: messages,
 		},
-	})
+	}); err != nil {
+		return err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	s.exclusiveHighWatermark = messages.ExclusiveHighWatermark
+	return nil
 }
 
 func NewSourceTaskConvertor(
@@ -32,7 +32,8 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
-	v1 "go.temporal.io/server/api/replication/v1"
+	v1 "go.temporal.io/server/api/adminservice/v1"
+	v10 "go.temporal.io/server/api/replication/v1"
 	tasks "go.temporal.io/server/service/history/tasks"
 )
 
@@ -60,10 +61,10 @@ func (m *MockSourceTaskConvertor) EXPECT() *MockSourceTaskConvertorMockRecorder
 }
 
 // Convert mocks base method.
-func (m *MockSourceTaskConvertor) Convert(task tasks.Task) (*v1.ReplicationTask, error) {
+func (m *MockSourceTaskConvertor) Convert(task tasks.Task) (*v10.ReplicationTask, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Convert", task)
-	ret0, _ := ret[0].(*v1.ReplicationTask)
+	ret0, _ := ret[0].(*v10.ReplicationTask)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -97,6 +98,20 @@ func (m *MockStreamSender) EXPECT() *MockStreamSenderMockRecorder {
 	return m.recorder
 }
 
+// Describe mocks base method.
+func (m *MockStreamSender) Describe() *v1.ReplicationStreamSenderState {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*v1.ReplicationStreamSenderState)
+	return ret0
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockStreamSenderMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockStreamSender)(nil).Describe))
+}
+
 // IsValid mocks base method.
 func (m *MockStreamSender) IsValid() bool {
 	m.ctrl.T.Helper()
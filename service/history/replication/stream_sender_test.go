@@ -34,11 +34,13 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/collection"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -349,6 +351,41 @@ func (s *streamSenderSuite) TestSendTasks_WithoutTasks() {
 	s.NoError(err)
 }
 
+func (s *streamSenderSuite) TestDescribe() {
+	clusterMetadata := cluster.NewMockMetadata(s.controller)
+	clusterMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{
+		cluster.TestAlternativeClusterName: {
+			Enabled:                true,
+			InitialFailoverVersion: int64(s.clientShardKey.ClusterID),
+			ShardCount:             1,
+		},
+	})
+	s.shardContext.EXPECT().GetClusterMetadata().Return(clusterMetadata)
+
+	inclusiveLowWatermark := rand.Int63()
+	inclusiveLowWatermarkTime := timestamp.TimePtr(time.Unix(0, rand.Int63()))
+	exclusiveHighWatermark := rand.Int63()
+	s.server.EXPECT().Send(gomock.Any()).Return(nil)
+	s.shardContext.EXPECT().UpdateReplicationQueueReaderState(gomock.Any(), gomock.Any()).Return(nil)
+	s.shardContext.EXPECT().UpdateRemoteClusterInfo(gomock.Any(), gomock.Any(), gomock.Any())
+
+	s.NoError(s.streamSender.sendMessages(&replicationspb.WorkflowReplicationMessages{
+		ExclusiveHighWatermark: exclusiveHighWatermark,
+	}))
+	s.NoError(s.streamSender.recvSyncReplicationState(&replicationspb.SyncReplicationState{
+		InclusiveLowWatermark:     inclusiveLowWatermark,
+		InclusiveLowWatermarkTime: inclusiveLowWatermarkTime,
+	}))
+
+	s.Equal(&adminservice.ReplicationStreamSenderState{
+		ClientCluster:             cluster.TestAlternativeClusterName,
+		ClientShardId:             s.clientShardKey.ShardID,
+		InclusiveLowWatermark:     inclusiveLowWatermark,
+		InclusiveLowWatermarkTime: inclusiveLowWatermarkTime,
+		ExclusiveHighWatermark:    exclusiveHighWatermark,
+	}, s.streamSender.Describe())
+}
+
 func (s *streamSenderSuite) TestSendTasks_WithTasks() {
 	beginInclusiveWatermark := rand.Int63()
 	endExclusiveWatermark := beginInclusiveWatermark + 100
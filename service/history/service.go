@@ -25,8 +25,11 @@
 package history
 
 import (
+	"context"
+	"fmt"
 	"math/rand"
 	"net"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -36,16 +39,34 @@ import (
 
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/drain"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/service/history/configs"
 )
 
+const (
+	gossipPropagationDelay = 400 * time.Millisecond
+	// drainShardReleaseInterval is how often shards still owned by a draining host are released
+	drainShardReleaseInterval = time.Second
+	// readinessCheckTimeout bounds each check of whether the host is ready to own shards
+	readinessCheckTimeout = 10 * time.Second
+)
+
+var readinessRetryPolicy = backoff.NewExponentialRetryPolicy(time.Second).
+	WithMaximumInterval(30 * time.Second).
+	WithExpirationInterval(backoff.NoInterval)
+
 // Service represents the history service
 type (
 	Service struct {
@@ -61,6 +82,14 @@ type (
 		faultInjectionDataStoreFactory *client.FaultInjectionDataStoreFactory
 		metricsHandler                 metrics.Handler
 		healthServer                   *health.Server
+		namespaceRegistry              namespace.Registry
+		clusterMetadata                cluster.Metadata
+		clusterMetadataManager         persistence.ClusterMetadataManager
+
+		// readinessLock keeps the host from joining the membership ring after it started leaving it
+		readinessLock   sync.Mutex
+		readinessCtx    context.Context
+		readinessCancel context.CancelFunc
 	}
 )
 
@@ -75,7 +104,11 @@ func NewService(
 	metricsHandler metrics.Handler,
 	faultInjectionDataStoreFactory *client.FaultInjectionDataStoreFactory,
 	healthServer *health.Server,
+	namespaceRegistry namespace.Registry,
+	clusterMetadata cluster.Metadata,
+	clusterMetadataManager persistence.ClusterMetadataManager,
 ) *Service {
+	readinessCtx, readinessCancel := context.WithCancel(context.Background())
 	return &Service{
 		status:                         common.DaemonStatusInitialized,
 		server:                         grpc.NewServer(grpcServerOptions...),
@@ -88,6 +121,11 @@ func NewService(
 		metricsHandler:                 metricsHandler,
 		faultInjectionDataStoreFactory: faultInjectionDataStoreFactory,
 		healthServer:                   healthServer,
+		namespaceRegistry:              namespaceRegistry,
+		clusterMetadata:                clusterMetadata,
+		clusterMetadataManager:         clusterMetadataManager,
+		readinessCtx:                   readinessCtx,
+		readinessCancel:                readinessCancel,
 	}
 }
 
@@ -107,7 +145,9 @@ func (s *Service) Start() {
 
 	historyservice.RegisterHistoryServiceServer(s.server, s.handler)
 	healthpb.RegisterHealthServer(s.server, s.healthServer)
-	s.healthServer.SetServingStatus(serviceName, healthpb.HealthCheckResponse_SERVING)
+	// Start in NOT_SERVING state and switch to SERVING after the host joined the membership ring
+	s.healthServer.SetServingStatus(serviceName, healthpb.HealthCheckResponse_NOT_SERVING)
+	go s.joinWhenReady()
 
 	listener := s.grpcListener
 	logger.Info("Starting to serve on history listener")
@@ -134,13 +174,13 @@ func (s *Service) Stop() {
 	// 6. wait for grace period
 	// 7. force stop the whole world and return
 
-	const gossipPropagationDelay = 400 * time.Millisecond
 	const shardOwnershipTransferDelay = 5 * time.Second
 	const gracePeriod = 2 * time.Second
 
 	remainingTime := s.config.ShutdownDrainDuration()
 
 	logger.Info("ShutdownHandler: Evicting self from membership ring")
+	s.stopJoining()
 	_ = s.membershipMonitor.EvictSelf()
 	s.healthServer.SetServingStatus(serviceName, healthpb.HealthCheckResponse_NOT_SERVING)
 
@@ -164,6 +204,85 @@ func (s *Service) Stop() {
 	logger.Info("history stopped")
 }
 
+// Drain hands the shards of this host over to the other history hosts. The host leaves the membership ring so
+// that its shards are assigned to other hosts, then releases the shards it still owns until it has none left.
+func (s *Service) Drain(ctx context.Context) error {
+	s.logger.Info("DrainHandler: Evicting self from membership ring")
+	s.stopJoining()
+	if err := s.membershipMonitor.EvictSelf(); err != nil {
+		return err
+	}
+	s.healthServer.SetServingStatus(serviceName, healthpb.HealthCheckResponse_NOT_SERVING)
+
+	s.logger.Info("DrainHandler: Waiting for others to discover I am unhealthy")
+	if err := drain.Sleep(ctx, gossipPropagationDelay); err != nil {
+		return err
+	}
+
+	s.logger.Info("DrainHandler: Releasing shards")
+	return drain.WaitUntil(ctx, drainShardReleaseInterval, func() bool {
+		shardIDs := s.handler.controller.ShardIDs()
+		for _, shardID := range shardIDs {
+			s.handler.controller.CloseShardByID(shardID)
+		}
+		return len(shardIDs) == 0
+	})
+}
+
+// joinWhenReady adds the host to the membership ring once it is ready to own shards, so that shards
+// are not routed to a host that would fail their requests. Until then, the health check of the host
+// reports it as not serving.
+func (s *Service) joinWhenReady() {
+	err := backoff.ThrottleRetryContext(s.readinessCtx, func(ctx context.Context) error {
+		if err := s.checkReadiness(ctx); err != nil {
+			s.logger.Warn("History host is not ready to own shards", tag.Error(err))
+			return err
+		}
+		return nil
+	}, readinessRetryPolicy, nil)
+	if err != nil {
+		// the host started leaving the ring
+		return
+	}
+
+	s.readinessLock.Lock()
+	defer s.readinessLock.Unlock()
+	if s.readinessCtx.Err() != nil {
+		return
+	}
+	if err := s.membershipMonitor.Join(); err != nil {
+		s.logger.Fatal("Unable to join membership ring", tag.Error(err))
+	}
+	s.healthServer.SetServingStatus(serviceName, healthpb.HealthCheckResponse_SERVING)
+	s.logger.Info("History is now healthy")
+}
+
+// checkReadiness verifies that the caches of cluster and namespace metadata are loaded, and that
+// persistence is reachable.
+func (s *Service) checkReadiness(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, readinessCheckTimeout)
+	defer cancel()
+
+	currentClusterName := s.clusterMetadata.GetCurrentClusterName()
+	if _, ok := s.clusterMetadata.GetAllClusterInfo()[currentClusterName]; !ok {
+		return fmt.Errorf("metadata of current cluster %q is not loaded", currentClusterName)
+	}
+	if _, err := s.namespaceRegistry.GetNamespace(primitives.SystemLocalNamespace); err != nil {
+		return fmt.Errorf("namespace registry is not loaded: %w", err)
+	}
+	if _, err := s.clusterMetadataManager.GetCurrentClusterMetadata(ctx); err != nil {
+		return fmt.Errorf("persistence is not reachable: %w", err)
+	}
+	return nil
+}
+
+// stopJoining keeps the host from joining the membership ring once it starts leaving it.
+func (s *Service) stopJoining() {
+	s.readinessLock.Lock()
+	defer s.readinessLock.Unlock()
+	s.readinessCancel()
+}
+
 // sleep sleeps for the minimum of desired and available duration
 // returns the remaining available time duration
 func (s *Service) sleep(desired time.Duration, available time.Duration) time.Duration {
@@ -0,0 +1,137 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/service/history/tests"
+)
+
+func newReadinessTestService(ctrl *gomock.Controller) (
+	*Service,
+	*membership.MockMonitor,
+	*persistence.MockClusterMetadataManager,
+) {
+	monitor := membership.NewMockMonitor(ctrl)
+	clusterMetadataManager := persistence.NewMockClusterMetadataManager(ctrl)
+	clusterMetadata := cluster.NewMockMetadata(ctrl)
+	clusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	clusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	namespaceRegistry.EXPECT().GetNamespace(namespace.Name(primitives.SystemLocalNamespace)).
+		Return(tests.GlobalNamespaceEntry, nil).AnyTimes()
+
+	svc := NewService(
+		nil,
+		tests.NewDynamicConfig(),
+		nil,
+		nil,
+		log.NewTestLogger(),
+		nil,
+		monitor,
+		metrics.NoopMetricsHandler,
+		nil,
+		health.NewServer(),
+		namespaceRegistry,
+		clusterMetadata,
+		clusterMetadataManager,
+	)
+	return svc, monitor, clusterMetadataManager
+}
+
+func servingStatus(t *testing.T, svc *Service) healthpb.HealthCheckResponse_ServingStatus {
+	resp, err := svc.healthServer.Check(context.Background(), &healthpb.HealthCheckRequest{Service: serviceName})
+	require.NoError(t, err)
+	return resp.Status
+}
+
+func TestJoinWhenReady(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	svc, monitor, clusterMetadataManager := newReadinessTestService(ctrl)
+	svc.healthServer.SetServingStatus(serviceName, healthpb.HealthCheckResponse_NOT_SERVING)
+
+	gomock.InOrder(
+		clusterMetadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).
+			Return(nil, errors.New("connection refused")),
+		clusterMetadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).
+			Return(&persistence.GetClusterMetadataResponse{}, nil),
+	)
+	joined := make(chan struct{})
+	monitor.EXPECT().Join().DoAndReturn(func() error {
+		require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, svc))
+		close(joined)
+		return nil
+	})
+
+	go svc.joinWhenReady()
+	select {
+	case <-joined:
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "host did not join the membership ring once ready")
+	}
+	require.Eventually(t, func() bool {
+		return servingStatus(t, svc) == healthpb.HealthCheckResponse_SERVING
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestJoinWhenReady_StopJoining(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	svc, _, clusterMetadataManager := newReadinessTestService(ctrl)
+	svc.healthServer.SetServingStatus(serviceName, healthpb.HealthCheckResponse_NOT_SERVING)
+
+	clusterMetadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).
+		Return(nil, errors.New("connection refused")).MinTimes(1)
+
+	done := make(chan struct{})
+	go func() {
+		svc.joinWhenReady()
+		close(done)
+	}()
+	time.Sleep(100 * time.Millisecond)
+	svc.stopJoining()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		require.Fail(t, "host kept checking readiness after it started leaving the ring")
+	}
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, svc))
+}
@@ -111,6 +111,9 @@ type (
 		engineFactory       EngineFactory
 		engineFuture        *future.FutureImpl[Engine]
 
+		currentExecutionCache *currentExecutionCache
+		historyAppendBatcher  *historyAppendBatcher
+
 		persistenceShardManager persistence.ShardManager
 		clientBean              client.Bean
 		historyClient           historyservice.HistoryServiceClient
@@ -122,6 +125,7 @@ type (
 		clusterMetadata         cluster.Metadata
 		archivalMetadata        archiver.ArchivalMetadata
 		hostInfoProvider        membership.HostInfoProvider
+		traceContexts           *tasks.TraceContexts
 
 		// Context that lives for the lifetime of the shard context
 		lifecycleCtx    context.Context
@@ -537,6 +541,7 @@ func (s *ContextImpl) CreateWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	defer s.currentExecutionCache.invalidate(namespaceID, workflowID)
 
 	s.wLock()
 	defer s.wUnlock()
@@ -558,6 +563,7 @@ func (s *ContextImpl) CreateWorkflowExecution(
 
 	transferExclusiveMaxReadLevel := int64(0)
 	if err := s.allocateTaskIDAndTimestampLocked(
+		ctx,
 		namespaceEntry,
 		workflowID,
 		request.NewWorkflowSnapshot.Tasks,
@@ -587,6 +593,7 @@ func (s *ContextImpl) UpdateWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	defer s.currentExecutionCache.invalidate(namespaceID, workflowID)
 
 	s.wLock()
 	defer s.wUnlock()
@@ -608,6 +615,7 @@ func (s *ContextImpl) UpdateWorkflowExecution(
 
 	transferExclusiveMaxReadLevel := int64(0)
 	if err := s.allocateTaskIDAndTimestampLocked(
+		ctx,
 		namespaceEntry,
 		workflowID,
 		request.UpdateWorkflowMutation.Tasks,
@@ -618,6 +626,7 @@ func (s *ContextImpl) UpdateWorkflowExecution(
 	s.updateCloseTaskIDs(request.UpdateWorkflowMutation.ExecutionInfo, request.UpdateWorkflowMutation.Tasks)
 	if request.NewWorkflowSnapshot != nil {
 		if err := s.allocateTaskIDAndTimestampLocked(
+			ctx,
 			namespaceEntry,
 			workflowID,
 			request.NewWorkflowSnapshot.Tasks,
@@ -663,6 +672,7 @@ func (s *ContextImpl) ConflictResolveWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	defer s.currentExecutionCache.invalidate(namespaceID, workflowID)
 
 	s.wLock()
 	defer s.wUnlock()
@@ -685,6 +695,7 @@ func (s *ContextImpl) ConflictResolveWorkflowExecution(
 	transferExclusiveMaxReadLevel := int64(0)
 	if request.CurrentWorkflowMutation != nil {
 		if err := s.allocateTaskIDAndTimestampLocked(
+			ctx,
 			namespaceEntry,
 			workflowID,
 			request.CurrentWorkflowMutation.Tasks,
@@ -694,6 +705,7 @@ func (s *ContextImpl) ConflictResolveWorkflowExecution(
 		}
 	}
 	if err := s.allocateTaskIDAndTimestampLocked(
+		ctx,
 		namespaceEntry,
 		workflowID,
 		request.ResetWorkflowSnapshot.Tasks,
@@ -703,6 +715,7 @@ func (s *ContextImpl) ConflictResolveWorkflowExecution(
 	}
 	if request.NewWorkflowSnapshot != nil {
 		if err := s.allocateTaskIDAndTimestampLocked(
+			ctx,
 			namespaceEntry,
 			workflowID,
 			request.NewWorkflowSnapshot.Tasks,
@@ -732,6 +745,7 @@ func (s *ContextImpl) SetWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	defer s.currentExecutionCache.invalidate(namespaceID, workflowID)
 
 	s.wLock()
 	defer s.wUnlock()
@@ -753,6 +767,7 @@ func (s *ContextImpl) SetWorkflowExecution(
 
 	transferExclusiveMaxReadLevel := int64(0)
 	if err := s.allocateTaskIDAndTimestampLocked(
+		ctx,
 		namespaceEntry,
 		workflowID,
 		request.SetWorkflowSnapshot.Tasks,
@@ -778,7 +793,7 @@ func (s *ContextImpl) GetCurrentExecution(
 		return nil, err
 	}
 
-	resp, err := s.executionManager.GetCurrentExecution(ctx, request)
+	resp, err := s.currentExecutionCache.get(ctx, request, s.executionManager.GetCurrentExecution)
 	if err = s.handleReadError(err); err != nil {
 		return nil, err
 	}
@@ -807,6 +822,7 @@ func (s *ContextImpl) addTasksLocked(
 ) error {
 	transferExclusiveMaxReadLevel := int64(0)
 	if err := s.allocateTaskIDAndTimestampLocked(
+		ctx,
 		namespaceEntry,
 		request.WorkflowID,
 		request.Tasks,
@@ -825,6 +841,27 @@ func (s *ContextImpl) AppendHistoryEvents(
 	request *persistence.AppendHistoryNodesRequest,
 	namespaceID namespace.ID,
 	execution commonpb.WorkflowExecution,
+) (int, error) {
+	return s.appendHistoryEvents(ctx, request, namespaceID, execution, s.GetExecutionManager().AppendHistoryNodes)
+}
+
+// AppendHistoryEventsBatched appends history events which are not part of a workflow mutation.
+// The append may be written together with appends of other workflows of this shard.
+func (s *ContextImpl) AppendHistoryEventsBatched(
+	ctx context.Context,
+	request *persistence.AppendHistoryNodesRequest,
+	namespaceID namespace.ID,
+	execution commonpb.WorkflowExecution,
+) (int, error) {
+	return s.appendHistoryEvents(ctx, request, namespaceID, execution, s.historyAppendBatcher.append)
+}
+
+func (s *ContextImpl) appendHistoryEvents(
+	ctx context.Context,
+	request *persistence.AppendHistoryNodesRequest,
+	namespaceID namespace.ID,
+	execution commonpb.WorkflowExecution,
+	appendFn func(context.Context, *persistence.AppendHistoryNodesRequest) (*persistence.AppendHistoryNodesResponse, error),
 ) (int, error) {
 	if err := s.errorByState(); err != nil {
 		return 0, err
@@ -850,7 +887,7 @@ func (s *ContextImpl) AppendHistoryEvents(
 				tag.WorkflowHistorySizeBytes(size))
 		}
 	}()
-	resp, err0 := s.GetExecutionManager().AppendHistoryNodes(ctx, request)
+	resp, err0 := appendFn(ctx, request)
 	if resp != nil {
 		size = resp.Size
 	}
@@ -961,10 +998,12 @@ func (s *ContextImpl) DeleteWorkflowExecution(
 					WorkflowID:  key.WorkflowID,
 					RunID:       key.RunID,
 				}
-				if err := s.GetExecutionManager().DeleteCurrentWorkflowExecution(
+				err := s.GetExecutionManager().DeleteCurrentWorkflowExecution(
 					ctx,
 					delCurRequest,
-				); err != nil {
+				)
+				s.currentExecutionCache.invalidate(namespace.ID(key.NamespaceID), key.WorkflowID)
+				if err != nil {
 					return err
 				}
 			}
@@ -1159,6 +1198,7 @@ func (s *ContextImpl) updateShardInfoLocked() error {
 }
 
 func (s *ContextImpl) allocateTaskIDAndTimestampLocked(
+	ctx context.Context,
 	namespaceEntry *namespace.Namespace,
 	workflowID string,
 	newTasks map[tasks.Category][]tasks.Task,
@@ -1209,6 +1249,7 @@ func (s *ContextImpl) allocateTaskIDAndTimestampLocked(
 			}
 		}
 	}
+	s.traceContexts.Record(ctx, s.shardID, newTasks)
 	return nil
 }
 
@@ -1834,6 +1875,7 @@ func newContext(
 	clusterMetadata cluster.Metadata,
 	archivalMetadata archiver.ArchivalMetadata,
 	hostInfoProvider membership.HostInfoProvider,
+	traceContexts *tasks.TraceContexts,
 ) (*ContextImpl, error) {
 	hostIdentity := hostInfoProvider.HostInfo().Identity()
 	sequenceID := atomic.AddInt64(&shardContextSequenceID, 1)
@@ -1863,6 +1905,7 @@ func newContext(
 		clusterMetadata:         clusterMetadata,
 		archivalMetadata:        archivalMetadata,
 		hostInfoProvider:        hostInfoProvider,
+		traceContexts:           traceContexts,
 		handoverNamespaces:      make(map[namespace.Name]*namespaceHandOverInfo),
 		lifecycleCtx:            lifecycleCtx,
 		lifecycleCancel:         lifecycleCancel,
@@ -1878,6 +1921,19 @@ func newContext(
 		shardContext.GetLogger(),
 		shardContext.GetMetricsHandler(),
 	)
+	shardContext.currentExecutionCache = newCurrentExecutionCache(
+		shardContext.GetConfig().CurrentExecutionCacheMaxSize(),
+		shardContext.GetMetricsHandler(),
+	)
+	shardContext.historyAppendBatcher = newHistoryAppendBatcher(
+		shardID,
+		lifecycleCtx,
+		shardContext.GetExecutionManager(),
+		shardContext.GetMetricsHandler(),
+		shardContext.GetConfig().HistoryAppendBatchingEnabled,
+		shardContext.GetConfig().HistoryAppendBatchMaxSize,
+		shardContext.GetConfig().HistoryAppendBatchWindow,
+	)
 
 	return shardContext, nil
 }
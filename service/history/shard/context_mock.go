@@ -120,6 +120,21 @@ func (mr *MockContextMockRecorder) AppendHistoryEvents(ctx, request, namespaceID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendHistoryEvents", reflect.TypeOf((*MockContext)(nil).AppendHistoryEvents), ctx, request, namespaceID, execution)
 }
 
+// AppendHistoryEventsBatched mocks base method.
+func (m *MockContext) AppendHistoryEventsBatched(ctx context.Context, request *persistence.AppendHistoryNodesRequest, namespaceID namespace.ID, execution v1.WorkflowExecution) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendHistoryEventsBatched", ctx, request, namespaceID, execution)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendHistoryEventsBatched indicates an expected call of AppendHistoryEventsBatched.
+func (mr *MockContextMockRecorder) AppendHistoryEventsBatched(ctx, request, namespaceID, execution interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendHistoryEventsBatched", reflect.TypeOf((*MockContext)(nil).AppendHistoryEventsBatched), ctx, request, namespaceID, execution)
+}
+
 // AssertOwnership mocks base method.
 func (m *MockContext) AssertOwnership(ctx context.Context) error {
 	m.ctrl.T.Helper()
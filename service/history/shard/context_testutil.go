@@ -94,6 +94,18 @@ func NewTestContext(
 		lifecycleCtx:        lifecycleCtx,
 		lifecycleCancel:     lifecycleCancel,
 
+		// current execution cache is disabled so tests observe every persistence read
+		currentExecutionCache: newCurrentExecutionCache(0, resourceTest.MetricsHandler),
+		historyAppendBatcher: newHistoryAppendBatcher(
+			shardInfo.GetShardId(),
+			lifecycleCtx,
+			resourceTest.ExecutionMgr,
+			resourceTest.MetricsHandler,
+			config.HistoryAppendBatchingEnabled,
+			config.HistoryAppendBatchMaxSize,
+			config.HistoryAppendBatchWindow,
+		),
+
 		state:                              contextStateAcquired,
 		engineFuture:                       future.NewFuture[Engine](),
 		shardInfo:                          shardInfo,
@@ -54,6 +54,7 @@ import (
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/tasks"
 )
 
 const (
@@ -95,6 +96,7 @@ type (
 		archivalMetadata            archiver.ArchivalMetadata
 		hostInfoProvider            membership.HostInfoProvider
 		tracer                      trace.Tracer
+		traceContexts               *tasks.TraceContexts
 	}
 )
 
@@ -310,6 +312,7 @@ func (c *ControllerImpl) getOrCreateShardContext(shardID int32) (*ContextImpl, e
 		c.clusterMetadata,
 		c.archivalMetadata,
 		c.hostInfoProvider,
+		c.traceContexts,
 	)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,147 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	currentExecutionCacheStripes = 64
+)
+
+type (
+	currentExecutionKey struct {
+		namespaceID namespace.ID
+		workflowID  string
+	}
+
+	// currentExecutionCache is a read-through cache of current execution records owned by a shard.
+	// Writes touching a current record must call invalidate once the persistence call returns.
+	// A fill is skipped if an invalidation of the same stripe raced with the persistence read,
+	// so a stale record can never be re-inserted after the write that replaced it.
+	currentExecutionCache struct {
+		cache          cache.Cache
+		metricsHandler metrics.Handler
+
+		sync.Mutex
+		generations [currentExecutionCacheStripes]int64
+	}
+)
+
+func newCurrentExecutionCache(
+	maxSize int,
+	metricsHandler metrics.Handler,
+) *currentExecutionCache {
+	c := &currentExecutionCache{
+		metricsHandler: metricsHandler.WithTags(
+			metrics.StringTag(metrics.CacheTypeTagName, metrics.CurrentExecutionCacheTypeTagValue),
+			metrics.OperationTag(metrics.CurrentExecutionCacheGetScope),
+		),
+	}
+	if maxSize > 0 {
+		c.cache = cache.New(maxSize, &cache.Options{})
+	}
+	return c
+}
+
+func (c *currentExecutionCache) get(
+	ctx context.Context,
+	request *persistence.GetCurrentExecutionRequest,
+	loadFn func(context.Context, *persistence.GetCurrentExecutionRequest) (*persistence.GetCurrentExecutionResponse, error),
+) (*persistence.GetCurrentExecutionResponse, error) {
+	if c.cache == nil {
+		return loadFn(ctx, request)
+	}
+
+	c.metricsHandler.Counter(metrics.CacheRequests.GetMetricName()).Record(1)
+	key := currentExecutionKey{
+		namespaceID: namespace.ID(request.NamespaceID),
+		workflowID:  request.WorkflowID,
+	}
+	stripe := c.stripe(key)
+
+	c.Lock()
+	if resp, ok := c.cache.Get(key).(*persistence.GetCurrentExecutionResponse); ok {
+		c.Unlock()
+		return copyCurrentExecutionResponse(resp), nil
+	}
+	generation := c.generations[stripe]
+	c.Unlock()
+
+	c.metricsHandler.Counter(metrics.CacheMissCounter.GetMetricName()).Record(1)
+	resp, err := loadFn(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Lock()
+	if c.generations[stripe] == generation {
+		c.cache.Put(key, copyCurrentExecutionResponse(resp))
+	}
+	c.Unlock()
+	return resp, nil
+}
+
+func (c *currentExecutionCache) invalidate(
+	namespaceID namespace.ID,
+	workflowID string,
+) {
+	if c.cache == nil {
+		return
+	}
+
+	key := currentExecutionKey{
+		namespaceID: namespaceID,
+		workflowID:  workflowID,
+	}
+	stripe := c.stripe(key)
+
+	c.Lock()
+	defer c.Unlock()
+	c.generations[stripe]++
+	c.cache.Delete(key)
+}
+
+func (c *currentExecutionCache) stripe(key currentExecutionKey) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key.namespaceID))
+	_, _ = h.Write([]byte(key.workflowID))
+	return int(h.Sum32() % currentExecutionCacheStripes)
+}
+
+func copyCurrentExecutionResponse(
+	resp *persistence.GetCurrentExecutionResponse,
+) *persistence.GetCurrentExecutionResponse {
+	copied := *resp
+	return &copied
+}
@@ -0,0 +1,141 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	currentExecutionCacheSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		request *persistence.GetCurrentExecutionRequest
+		loads   int
+		runID   string
+	}
+)
+
+func TestCurrentExecutionCacheSuite(t *testing.T) {
+	s := &currentExecutionCacheSuite{}
+	suite.Run(t, s)
+}
+
+func (s *currentExecutionCacheSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.request = &persistence.GetCurrentExecutionRequest{
+		ShardID:     1,
+		NamespaceID: "namespace-id",
+		WorkflowID:  "workflow-id",
+	}
+	s.loads = 0
+	s.runID = "run-1"
+}
+
+func (s *currentExecutionCacheSuite) load(
+	_ context.Context,
+	_ *persistence.GetCurrentExecutionRequest,
+) (*persistence.GetCurrentExecutionResponse, error) {
+	s.loads++
+	return &persistence.GetCurrentExecutionResponse{RunID: s.runID}, nil
+}
+
+func (s *currentExecutionCacheSuite) TestReadThrough() {
+	c := newCurrentExecutionCache(10, metrics.NoopMetricsHandler)
+
+	for i := 0; i < 3; i++ {
+		resp, err := c.get(context.Background(), s.request, s.load)
+		s.NoError(err)
+		s.Equal("run-1", resp.RunID)
+	}
+	s.Equal(1, s.loads)
+}
+
+func (s *currentExecutionCacheSuite) TestInvalidate() {
+	c := newCurrentExecutionCache(10, metrics.NoopMetricsHandler)
+
+	_, err := c.get(context.Background(), s.request, s.load)
+	s.NoError(err)
+
+	s.runID = "run-2"
+	c.invalidate("namespace-id", "workflow-id")
+
+	resp, err := c.get(context.Background(), s.request, s.load)
+	s.NoError(err)
+	s.Equal("run-2", resp.RunID)
+	s.Equal(2, s.loads)
+}
+
+func (s *currentExecutionCacheSuite) TestInvalidateDuringLoad_NotCached() {
+	c := newCurrentExecutionCache(10, metrics.NoopMetricsHandler)
+
+	staleLoad := func(ctx context.Context, request *persistence.GetCurrentExecutionRequest) (*persistence.GetCurrentExecutionResponse, error) {
+		resp, err := s.load(ctx, request)
+		// a write replaces the current run after the read but before the fill
+		s.runID = "run-2"
+		c.invalidate("namespace-id", "workflow-id")
+		return resp, err
+	}
+	resp, err := c.get(context.Background(), s.request, staleLoad)
+	s.NoError(err)
+	s.Equal("run-1", resp.RunID)
+
+	resp, err = c.get(context.Background(), s.request, s.load)
+	s.NoError(err)
+	s.Equal("run-2", resp.RunID)
+	s.Equal(2, s.loads)
+}
+
+func (s *currentExecutionCacheSuite) TestDisabled() {
+	c := newCurrentExecutionCache(0, metrics.NoopMetricsHandler)
+
+	for i := 0; i < 3; i++ {
+		_, err := c.get(context.Background(), s.request, s.load)
+		s.NoError(err)
+	}
+	c.invalidate("namespace-id", "workflow-id")
+	s.Equal(3, s.loads)
+}
+
+func (s *currentExecutionCacheSuite) TestReturnedResponseIsACopy() {
+	c := newCurrentExecutionCache(10, metrics.NoopMetricsHandler)
+
+	resp, err := c.get(context.Background(), s.request, s.load)
+	s.NoError(err)
+	resp.RunID = "mutated"
+
+	resp, err = c.get(context.Background(), s.request, s.load)
+	s.NoError(err)
+	s.Equal("run-1", resp.RunID)
+}
@@ -89,6 +89,11 @@ type (
 		GetReplicationStatus(ctx context.Context, request *historyservice.GetReplicationStatusRequest) (*historyservice.ShardReplicationStatus, error)
 		UpdateWorkflowExecution(ctx context.Context, request *historyservice.UpdateWorkflowExecutionRequest) (*historyservice.UpdateWorkflowExecutionResponse, error)
 		PollWorkflowExecutionUpdate(ctx context.Context, request *historyservice.PollWorkflowExecutionUpdateRequest) (*historyservice.PollWorkflowExecutionUpdateResponse, error)
+		UpdateWorkflowExecutionMemo(ctx context.Context, namespaceUUID namespace.ID, execution *commonpb.WorkflowExecution, memo *commonpb.Memo, identity string, reason string) (*commonpb.Memo, error)
+		UpsertWorkflowExecutionSearchAttributes(ctx context.Context, namespaceUUID namespace.ID, execution *commonpb.WorkflowExecution, searchAttributes *commonpb.SearchAttributes, identity string, reason string) error
+		RehydrateWorkflowExecution(ctx context.Context, namespaceUUID namespace.ID, execution commonpb.WorkflowExecution, historyBatches []*historypb.History) error
+		AwaitVisibilityIngestion(ctx context.Context, request *historyservice.AwaitVisibilityIngestionRequest) (*historyservice.AwaitVisibilityIngestionResponse, error)
+		DescribeShard(ctx context.Context, request *historyservice.DescribeShardRequest) (*historyservice.DescribeShardResponse, error)
 
 		NotifyNewHistoryEvent(event *events.Notification)
 		NotifyNewTasks(tasks map[tasks.Category][]tasks.Task)
@@ -79,6 +79,21 @@ func (mr *MockEngineMockRecorder) AddSpeculativeWorkflowTaskTimeoutTask(task int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSpeculativeWorkflowTaskTimeoutTask", reflect.TypeOf((*MockEngine)(nil).AddSpeculativeWorkflowTaskTimeoutTask), task)
 }
 
+// AwaitVisibilityIngestion mocks base method.
+func (m *MockEngine) AwaitVisibilityIngestion(ctx context.Context, request *historyservice.AwaitVisibilityIngestionRequest) (*historyservice.AwaitVisibilityIngestionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AwaitVisibilityIngestion", ctx, request)
+	ret0, _ := ret[0].(*historyservice.AwaitVisibilityIngestionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AwaitVisibilityIngestion indicates an expected call of AwaitVisibilityIngestion.
+func (mr *MockEngineMockRecorder) AwaitVisibilityIngestion(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AwaitVisibilityIngestion", reflect.TypeOf((*MockEngine)(nil).AwaitVisibilityIngestion), ctx, request)
+}
+
 // ConvertReplicationTask mocks base method.
 func (m *MockEngine) ConvertReplicationTask(ctx context.Context, task tasks.Task) (*repication.ReplicationTask, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +139,21 @@ func (mr *MockEngineMockRecorder) DescribeMutableState(ctx, request interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockEngine)(nil).DescribeMutableState), ctx, request)
 }
 
+// DescribeShard mocks base method.
+func (m *MockEngine) DescribeShard(ctx context.Context, request *historyservice.DescribeShardRequest) (*historyservice.DescribeShardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeShard", ctx, request)
+	ret0, _ := ret[0].(*historyservice.DescribeShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeShard indicates an expected call of DescribeShard.
+func (mr *MockEngineMockRecorder) DescribeShard(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeShard", reflect.TypeOf((*MockEngine)(nil).DescribeShard), ctx, request)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockEngine) DescribeWorkflowExecution(ctx context.Context, request *historyservice.DescribeWorkflowExecutionRequest) (*historyservice.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -445,6 +475,20 @@ func (mr *MockEngineMockRecorder) RefreshWorkflowTasks(ctx, namespaceUUID, execu
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockEngine)(nil).RefreshWorkflowTasks), ctx, namespaceUUID, execution)
 }
 
+// RehydrateWorkflowExecution mocks base method.
+func (m *MockEngine) RehydrateWorkflowExecution(ctx context.Context, namespaceUUID namespace.ID, execution common.WorkflowExecution, historyBatches []*history.History) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RehydrateWorkflowExecution", ctx, namespaceUUID, execution, historyBatches)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RehydrateWorkflowExecution indicates an expected call of RehydrateWorkflowExecution.
+func (mr *MockEngineMockRecorder) RehydrateWorkflowExecution(ctx, namespaceUUID, execution, historyBatches interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RehydrateWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).RehydrateWorkflowExecution), ctx, namespaceUUID, execution, historyBatches)
+}
+
 // RemoveSignalMutableState mocks base method.
 func (m *MockEngine) RemoveSignalMutableState(ctx context.Context, request *historyservice.RemoveSignalMutableStateRequest) (*historyservice.RemoveSignalMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -775,6 +819,35 @@ func (mr *MockEngineMockRecorder) UpdateWorkflowExecution(ctx, request interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).UpdateWorkflowExecution), ctx, request)
 }
 
+// UpdateWorkflowExecutionMemo mocks base method.
+func (m *MockEngine) UpdateWorkflowExecutionMemo(ctx context.Context, namespaceUUID namespace.ID, execution *common.WorkflowExecution, memo *common.Memo, identity, reason string) (*common.Memo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecutionMemo", ctx, namespaceUUID, execution, memo, identity, reason)
+	ret0, _ := ret[0].(*common.Memo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecutionMemo indicates an expected call of UpdateWorkflowExecutionMemo.
+func (mr *MockEngineMockRecorder) UpdateWorkflowExecutionMemo(ctx, namespaceUUID, execution, memo, identity, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecutionMemo", reflect.TypeOf((*MockEngine)(nil).UpdateWorkflowExecutionMemo), ctx, namespaceUUID, execution, memo, identity, reason)
+}
+
+// UpsertWorkflowExecutionSearchAttributes mocks base method.
+func (m *MockEngine) UpsertWorkflowExecutionSearchAttributes(ctx context.Context, namespaceUUID namespace.ID, execution *common.WorkflowExecution, searchAttributes *common.SearchAttributes, identity, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWorkflowExecutionSearchAttributes", ctx, namespaceUUID, execution, searchAttributes, identity, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertWorkflowExecutionSearchAttributes indicates an expected call of UpsertWorkflowExecutionSearchAttributes.
+func (mr *MockEngineMockRecorder) UpsertWorkflowExecutionSearchAttributes(ctx, namespaceUUID, execution, searchAttributes, identity, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowExecutionSearchAttributes", reflect.TypeOf((*MockEngine)(nil).UpsertWorkflowExecutionSearchAttributes), ctx, namespaceUUID, execution, searchAttributes, identity, reason)
+}
+
 // VerifyChildExecutionCompletionRecorded mocks base method.
 func (m *MockEngine) VerifyChildExecutionCompletionRecorded(ctx context.Context, request *historyservice.VerifyChildExecutionCompletionRecordedRequest) (*historyservice.VerifyChildExecutionCompletionRecordedResponse, error) {
 	m.ctrl.T.Helper()
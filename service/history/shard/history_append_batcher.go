@@ -0,0 +1,204 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/rpc"
+)
+
+type (
+	historyAppend struct {
+		request  *persistence.AppendHistoryNodesRequest
+		response *persistence.AppendHistoryNodesResponse
+		err      error
+		done     chan struct{}
+	}
+
+	historyAppendBatch struct {
+		appends []*historyAppend
+		full    chan struct{}
+	}
+
+	// historyAppendBatcher groups history appends of different workflows on the same shard
+	// and writes them with a single AppendHistoryNodesBatch call.
+	// The first append of a batch waits for the batch window (or until the batch is full),
+	// then flushes the batch. Every caller blocks until the flush containing its append
+	// completes, even if its context is cancelled, so the caller always learns whether its
+	// append was written before it proceeds to generate or ack tasks.
+	// A batch is written all or nothing. Once the store reports that it cannot write
+	// multiple branches atomically, appends are no longer batched.
+	historyAppendBatcher struct {
+		shardID          int32
+		lifecycleCtx     context.Context
+		executionManager persistence.ExecutionManager
+		metricsHandler   metrics.Handler
+		enabled          dynamicconfig.BoolPropertyFn
+		maxSize          dynamicconfig.IntPropertyFn
+		window           dynamicconfig.DurationPropertyFn
+		unsupported      atomic.Bool
+
+		sync.Mutex
+		current *historyAppendBatch
+	}
+)
+
+func newHistoryAppendBatcher(
+	shardID int32,
+	lifecycleCtx context.Context,
+	executionManager persistence.ExecutionManager,
+	metricsHandler metrics.Handler,
+	enabled dynamicconfig.BoolPropertyFn,
+	maxSize dynamicconfig.IntPropertyFn,
+	window dynamicconfig.DurationPropertyFn,
+) *historyAppendBatcher {
+	return &historyAppendBatcher{
+		shardID:          shardID,
+		lifecycleCtx:     lifecycleCtx,
+		executionManager: executionManager,
+		metricsHandler:   metricsHandler.WithTags(metrics.OperationTag(metrics.HistoryAppendBatchScope)),
+		enabled:          enabled,
+		maxSize:          maxSize,
+		window:           window,
+	}
+}
+
+func (b *historyAppendBatcher) append(
+	ctx context.Context,
+	request *persistence.AppendHistoryNodesRequest,
+) (*persistence.AppendHistoryNodesResponse, error) {
+	if !b.enabled() || b.unsupported.Load() {
+		return b.executionManager.AppendHistoryNodes(ctx, request)
+	}
+
+	historyAppend := &historyAppend{
+		request: request,
+		done:    make(chan struct{}),
+	}
+
+	b.Lock()
+	batch := b.current
+	leader := batch == nil
+	if leader {
+		batch = &historyAppendBatch{
+			full: make(chan struct{}),
+		}
+		b.current = batch
+	}
+	batch.appends = append(batch.appends, historyAppend)
+	flush := len(batch.appends) >= b.maxSize()
+	if flush {
+		b.current = nil
+		close(batch.full)
+	}
+	b.Unlock()
+
+	if leader && !flush {
+		timer := time.NewTimer(b.window())
+		select {
+		case <-timer.C:
+		case <-batch.full:
+		}
+		timer.Stop()
+
+		b.Lock()
+		if b.current == batch {
+			b.current = nil
+			flush = true
+		}
+		b.Unlock()
+	}
+
+	if flush {
+		b.flush(ctx, batch)
+	}
+
+	// the flush is bounded by shardIOTimeout, wait for it so the
+	// outcome of the append is known even if ctx is already done
+	<-historyAppend.done
+	return historyAppend.response, historyAppend.err
+}
+
+func (b *historyAppendBatcher) flush(
+	ctx context.Context,
+	batch *historyAppendBatch,
+) {
+	defer func() {
+		for _, historyAppend := range batch.appends {
+			close(historyAppend.done)
+		}
+	}()
+
+	// the batch carries appends of other callers, so it must not be
+	// interrupted by cancellation of the caller which happens to flush it
+	flushCtx, cancel := context.WithTimeout(rpc.CopyContextValues(b.lifecycleCtx, ctx), shardIOTimeout)
+	defer cancel()
+
+	b.metricsHandler.Histogram(metrics.HistoryAppendBatchSize.GetMetricName(), metrics.HistoryAppendBatchSize.GetMetricUnit()).
+		Record(int64(len(batch.appends)))
+
+	if len(batch.appends) == 1 {
+		historyAppend := batch.appends[0]
+		historyAppend.response, historyAppend.err = b.executionManager.AppendHistoryNodes(flushCtx, historyAppend.request)
+		return
+	}
+
+	requests := make([]*persistence.AppendHistoryNodesRequest, 0, len(batch.appends))
+	for _, historyAppend := range batch.appends {
+		requests = append(requests, historyAppend.request)
+	}
+	resp, err := b.executionManager.AppendHistoryNodesBatch(flushCtx, &persistence.AppendHistoryNodesBatchRequest{
+		ShardID:  b.shardID,
+		Requests: requests,
+	})
+	switch err.(type) {
+	case nil:
+		for i, historyAppend := range batch.appends {
+			historyAppend.response = resp.Responses[i]
+		}
+	case *serviceerror.Unimplemented:
+		// the store cannot write the batch atomically and wrote nothing,
+		// stop batching and write this batch's appends individually
+		b.unsupported.Store(true)
+		for _, historyAppend := range batch.appends {
+			historyAppend.response, historyAppend.err = b.executionManager.AppendHistoryNodes(flushCtx, historyAppend.request)
+		}
+	default:
+		// nothing was written, including on ConditionFailedError, so
+		// every caller of the batch gets the error and may retry
+		for _, historyAppend := range batch.appends {
+			historyAppend.err = err
+		}
+	}
+}
@@ -0,0 +1,225 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	historyAppendBatcherSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller           *gomock.Controller
+		mockExecutionManager *persistence.MockExecutionManager
+	}
+)
+
+func TestHistoryAppendBatcherSuite(t *testing.T) {
+	s := &historyAppendBatcherSuite{}
+	suite.Run(t, s)
+}
+
+func (s *historyAppendBatcherSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockExecutionManager = persistence.NewMockExecutionManager(s.controller)
+}
+
+func (s *historyAppendBatcherSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *historyAppendBatcherSuite) newBatcher(
+	enabled bool,
+	maxSize int,
+	window time.Duration,
+) *historyAppendBatcher {
+	return newHistoryAppendBatcher(
+		1,
+		context.Background(),
+		s.mockExecutionManager,
+		metrics.NoopMetricsHandler,
+		dynamicconfig.GetBoolPropertyFn(enabled),
+		dynamicconfig.GetIntPropertyFn(maxSize),
+		dynamicconfig.GetDurationPropertyFn(window),
+	)
+}
+
+func (s *historyAppendBatcherSuite) appendConcurrently(
+	b *historyAppendBatcher,
+	requests ...*persistence.AppendHistoryNodesRequest,
+) ([]*persistence.AppendHistoryNodesResponse, []error) {
+	responses := make([]*persistence.AppendHistoryNodesResponse, len(requests))
+	errs := make([]error, len(requests))
+
+	var wg sync.WaitGroup
+	for i, request := range requests {
+		wg.Add(1)
+		go func(i int, request *persistence.AppendHistoryNodesRequest) {
+			defer wg.Done()
+			responses[i], errs[i] = b.append(context.Background(), request)
+		}(i, request)
+	}
+	wg.Wait()
+	return responses, errs
+}
+
+func (s *historyAppendBatcherSuite) TestDisabled() {
+	b := s.newBatcher(false, 64, time.Minute)
+	request := &persistence.AppendHistoryNodesRequest{ShardID: 1}
+
+	s.mockExecutionManager.EXPECT().AppendHistoryNodes(gomock.Any(), request).
+		Return(&persistence.AppendHistoryNodesResponse{Size: 10}, nil)
+
+	resp, err := b.append(context.Background(), request)
+	s.NoError(err)
+	s.Equal(10, resp.Size)
+}
+
+func (s *historyAppendBatcherSuite) TestFlush_Window() {
+	b := s.newBatcher(true, 64, time.Millisecond)
+	request := &persistence.AppendHistoryNodesRequest{ShardID: 1}
+
+	s.mockExecutionManager.EXPECT().AppendHistoryNodes(gomock.Any(), request).
+		Return(&persistence.AppendHistoryNodesResponse{Size: 10}, nil)
+
+	resp, err := b.append(context.Background(), request)
+	s.NoError(err)
+	s.Equal(10, resp.Size)
+}
+
+func (s *historyAppendBatcherSuite) TestFlush_MaxSize() {
+	b := s.newBatcher(true, 2, time.Minute)
+	request1 := &persistence.AppendHistoryNodesRequest{ShardID: 1, TransactionID: 1}
+	request2 := &persistence.AppendHistoryNodesRequest{ShardID: 1, TransactionID: 2}
+
+	s.mockExecutionManager.EXPECT().AppendHistoryNodesBatch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.AppendHistoryNodesBatchRequest) (*persistence.AppendHistoryNodesBatchResponse, error) {
+			s.Equal(int32(1), request.ShardID)
+			s.Len(request.Requests, 2)
+			resp := &persistence.AppendHistoryNodesBatchResponse{}
+			for _, appendRequest := range request.Requests {
+				resp.Responses = append(resp.Responses, &persistence.AppendHistoryNodesResponse{
+					Size: int(appendRequest.TransactionID) * 10,
+				})
+			}
+			return resp, nil
+		},
+	)
+
+	responses, errs := s.appendConcurrently(b, request1, request2)
+	s.NoError(errs[0])
+	s.NoError(errs[1])
+	s.Equal(10, responses[0].Size)
+	s.Equal(20, responses[1].Size)
+}
+
+func (s *historyAppendBatcherSuite) TestFlush_ConditionFailed() {
+	b := s.newBatcher(true, 2, time.Minute)
+	request1 := &persistence.AppendHistoryNodesRequest{ShardID: 1, TransactionID: 1}
+	request2 := &persistence.AppendHistoryNodesRequest{ShardID: 1, TransactionID: 2}
+
+	s.mockExecutionManager.EXPECT().AppendHistoryNodesBatch(gomock.Any(), gomock.Any()).
+		Return(nil, &persistence.ConditionFailedError{})
+
+	_, errs := s.appendConcurrently(b, request1, request2)
+	s.IsType(&persistence.ConditionFailedError{}, errs[0])
+	s.IsType(&persistence.ConditionFailedError{}, errs[1])
+}
+
+func (s *historyAppendBatcherSuite) TestFlush_Unsupported() {
+	b := s.newBatcher(true, 2, time.Minute)
+	request1 := &persistence.AppendHistoryNodesRequest{ShardID: 1, TransactionID: 1}
+	request2 := &persistence.AppendHistoryNodesRequest{ShardID: 1, TransactionID: 2}
+	request3 := &persistence.AppendHistoryNodesRequest{ShardID: 1, TransactionID: 3}
+
+	s.mockExecutionManager.EXPECT().AppendHistoryNodesBatch(gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewUnimplemented("not supported"))
+	s.mockExecutionManager.EXPECT().AppendHistoryNodes(gomock.Any(), request1).
+		Return(&persistence.AppendHistoryNodesResponse{Size: 10}, nil)
+	s.mockExecutionManager.EXPECT().AppendHistoryNodes(gomock.Any(), request2).
+		Return(&persistence.AppendHistoryNodesResponse{Size: 20}, nil)
+
+	responses, errs := s.appendConcurrently(b, request1, request2)
+	s.NoError(errs[0])
+	s.NoError(errs[1])
+	s.Equal(10, responses[0].Size)
+	s.Equal(20, responses[1].Size)
+
+	// later appends are written directly without waiting for the batch window
+	s.mockExecutionManager.EXPECT().AppendHistoryNodes(gomock.Any(), request3).
+		Return(&persistence.AppendHistoryNodesResponse{Size: 30}, nil)
+
+	resp, err := b.append(context.Background(), request3)
+	s.NoError(err)
+	s.Equal(30, resp.Size)
+}
+
+func (s *historyAppendBatcherSuite) TestAppend_CallerCancelled() {
+	b := s.newBatcher(true, 64, 10*time.Millisecond)
+	request := &persistence.AppendHistoryNodesRequest{ShardID: 1}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	s.mockExecutionManager.EXPECT().AppendHistoryNodes(gomock.Any(), request).DoAndReturn(
+		func(ctx context.Context, _ *persistence.AppendHistoryNodesRequest) (*persistence.AppendHistoryNodesResponse, error) {
+			s.NoError(ctx.Err())
+			return &persistence.AppendHistoryNodesResponse{Size: 10}, nil
+		},
+	)
+
+	resp, err := b.append(ctx, request)
+	s.NoError(err)
+	s.Equal(10, resp.Size)
+}
+
+func (s *historyAppendBatcherSuite) TestFlush_Error() {
+	b := s.newBatcher(true, 2, time.Minute)
+	request1 := &persistence.AppendHistoryNodesRequest{ShardID: 1, TransactionID: 1}
+	request2 := &persistence.AppendHistoryNodesRequest{ShardID: 1, TransactionID: 2}
+
+	s.mockExecutionManager.EXPECT().AppendHistoryNodesBatch(gomock.Any(), gomock.Any()).
+		Return(nil, &persistence.AppendHistoryTimeoutError{})
+
+	_, errs := s.appendConcurrently(b, request1, request2)
+	s.IsType(&persistence.AppendHistoryTimeoutError{}, errs[0])
+	s.IsType(&persistence.AppendHistoryTimeoutError{}, errs[1])
+}
@@ -25,6 +25,8 @@
 package tasks
 
 import (
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"strconv"
 	"sync"
@@ -183,6 +185,30 @@ func (c *Category) Type() CategoryType {
 	return c.cType
 }
 
+// GobEncode implements gob.GobEncoder, so that requests referencing categories can be sent to persistence plugins
+func (c Category) GobEncode() ([]byte, error) {
+	data := binary.AppendVarint(nil, int64(c.id))
+	data = binary.AppendVarint(data, int64(c.cType))
+	return append(data, c.name...), nil
+}
+
+// GobDecode implements gob.GobDecoder
+func (c *Category) GobDecode(data []byte) error {
+	id, n := binary.Varint(data)
+	if n <= 0 {
+		return errors.New("invalid encoded category id")
+	}
+	data = data[n:]
+	cType, n := binary.Varint(data)
+	if n <= 0 {
+		return errors.New("invalid encoded category type")
+	}
+	c.id = int32(id)
+	c.cType = CategoryType(cType)
+	c.name = string(data[n:])
+	return nil
+}
+
 func (t CategoryType) String() string {
 	switch t {
 	case CategoryTypeImmediate:
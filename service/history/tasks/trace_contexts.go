@@ -0,0 +1,132 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/service/history/consts"
+)
+
+const (
+	// QueueWaitEventName is the name of the span event recording how long a task waited to be processed
+	QueueWaitEventName = "task queue wait"
+	// QueueWaitAttributeKey is the attribute of QueueWaitEventName holding the wait time in milliseconds
+	QueueWaitAttributeKey = attribute.Key("temporal.queue_wait_ms")
+)
+
+type (
+	// TraceContexts keeps in memory the span context of the requests that created transfer tasks, so that
+	// a task processed by the host that created it is traced as part of the request. The span context is
+	// not persisted with the task, so tasks loaded after a shard movement or an eviction are not traced.
+	TraceContexts struct {
+		cache cache.Cache
+	}
+
+	traceContextKey struct {
+		shardID int32
+		taskID  int64
+	}
+
+	traceContext struct {
+		spanContext    trace.SpanContext
+		tracerProvider trace.TracerProvider
+	}
+)
+
+// NewTraceContexts creates a TraceContexts keeping the span context of at most maxSize tasks.
+func NewTraceContexts(maxSize int) *TraceContexts {
+	return &TraceContexts{
+		cache: cache.NewLRU(maxSize),
+	}
+}
+
+// Record keeps the span context of ctx for the transfer tasks in newTasks if the span is sampled.
+// Task IDs must be set.
+func (c *TraceContexts) Record(
+	ctx context.Context,
+	shardID int32,
+	newTasks map[Category][]Task,
+) {
+	if c == nil {
+		return
+	}
+	span := trace.SpanFromContext(ctx)
+	spanContext := span.SpanContext()
+	if !spanContext.IsSampled() {
+		return
+	}
+	for _, task := range newTasks[CategoryTransfer] {
+		c.cache.Put(traceContextKey{shardID: shardID, taskID: task.GetTaskID()}, traceContext{
+			spanContext:    spanContext,
+			tracerProvider: span.TracerProvider(),
+		})
+	}
+}
+
+// noopSpan is returned by StartSpan when no span is started, so that ending it never ends the span of ctx.
+var noopSpan = trace.SpanFromContext(context.Background())
+
+// StartSpan starts a span for processing the task as a child of the request that created it, with an event
+// for the time the task waited to be processed. ctx is returned unchanged with a non-recording span if the
+// span context of the request was not recorded. The returned span must be ended by the caller.
+func (c *TraceContexts) StartSpan(
+	ctx context.Context,
+	shardID int32,
+	task Task,
+	spanName string,
+	now time.Time,
+) (context.Context, trace.Span) {
+	if c == nil {
+		return ctx, noopSpan
+	}
+	key := traceContextKey{shardID: shardID, taskID: task.GetTaskID()}
+	value, ok := c.cache.Get(key).(traceContext)
+	if !ok {
+		return ctx, noopSpan
+	}
+	c.cache.Delete(key)
+
+	ctx, span := value.tracerProvider.Tracer(consts.LibraryName).Start(
+		trace.ContextWithRemoteSpanContext(ctx, value.spanContext),
+		spanName,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("temporal.namespace_id", task.GetNamespaceID()),
+			attribute.String("temporal.workflow_id", task.GetWorkflowID()),
+			attribute.String("temporal.run_id", task.GetRunID()),
+			attribute.Int64("temporal.task_id", task.GetTaskID()),
+		),
+	)
+	span.AddEvent(QueueWaitEventName, trace.WithAttributes(
+		QueueWaitAttributeKey.Int64(now.Sub(task.GetVisibilityTime()).Milliseconds()),
+	))
+	return ctx, span
+}
@@ -0,0 +1,95 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	otelsdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"go.temporal.io/server/common/definition"
+)
+
+func TestTraceContexts_StartSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := otelsdktrace.NewTracerProvider(otelsdktrace.WithSpanProcessor(recorder))
+	ctx, requestSpan := tp.Tracer("test").Start(context.Background(), "request")
+
+	now := time.Now()
+	task := &WorkflowTask{
+		WorkflowKey:         definition.NewWorkflowKey("namespace-id", "workflow-id", "run-id"),
+		VisibilityTimestamp: now.Add(-time.Second),
+		TaskID:              123,
+	}
+	timerTask := &WorkflowTaskTimeoutTask{TaskID: 124}
+	traceContexts := NewTraceContexts(10)
+	traceContexts.Record(ctx, 1, map[Category][]Task{CategoryTransfer: {task}, CategoryTimer: {timerTask}})
+
+	_, span := traceContexts.StartSpan(context.Background(), 1, timerTask, "task", now)
+	require.False(t, span.IsRecording(), "only transfer tasks are traced")
+
+	_, span = traceContexts.StartSpan(context.Background(), 2, task, "task", now)
+	require.False(t, span.IsRecording(), "span context is recorded per shard")
+
+	_, span = traceContexts.StartSpan(context.Background(), 1, task, "task", now)
+	require.True(t, span.IsRecording())
+	span.End()
+
+	ended := recorder.Ended()
+	require.Len(t, ended, 1)
+	require.Equal(t, requestSpan.SpanContext().SpanID(), ended[0].Parent().SpanID())
+	require.Equal(t, requestSpan.SpanContext().TraceID(), ended[0].SpanContext().TraceID())
+	require.Len(t, ended[0].Events(), 1)
+	require.Equal(t, QueueWaitEventName, ended[0].Events()[0].Name)
+	require.Equal(t, QueueWaitAttributeKey.Int64(1000), ended[0].Events()[0].Attributes[0])
+
+	_, span = traceContexts.StartSpan(context.Background(), 1, task, "task", now)
+	require.False(t, span.IsRecording(), "span context is removed once the span is started")
+}
+
+func TestTraceContexts_Record_NotSampled(t *testing.T) {
+	tp := otelsdktrace.NewTracerProvider(otelsdktrace.WithSampler(otelsdktrace.NeverSample()))
+	ctx, _ := tp.Tracer("test").Start(context.Background(), "request")
+
+	task := &WorkflowTask{TaskID: 123}
+	traceContexts := NewTraceContexts(10)
+	traceContexts.Record(ctx, 1, map[Category][]Task{CategoryTransfer: {task}})
+	traceContexts.Record(context.Background(), 1, map[Category][]Task{CategoryTransfer: {task}})
+
+	_, span := traceContexts.StartSpan(context.Background(), 1, task, "task", time.Now())
+	require.False(t, span.IsRecording())
+}
+
+func TestTraceContexts_Nil(t *testing.T) {
+	var traceContexts *TraceContexts
+	traceContexts.Record(context.Background(), 1, map[Category][]Task{CategoryTransfer: {&WorkflowTask{}}})
+	ctx, span := traceContexts.StartSpan(context.Background(), 1, &WorkflowTask{}, "task", time.Now())
+	require.NotNil(t, ctx)
+	require.False(t, span.IsRecording())
+}
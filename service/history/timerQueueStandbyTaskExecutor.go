@@ -115,6 +115,8 @@ func (t *timerQueueStandbyTaskExecutor) Execute(
 		err = t.executeWorkflowTimeoutTask(ctx, task)
 	case *tasks.DeleteHistoryEventTask:
 		err = t.executeDeleteHistoryEventTask(ctx, task)
+	case *tasks.DeleteVisibilityRecordTask:
+		err = t.executeDeleteVisibilityRecordTask(ctx, task)
 	default:
 		err = errUnknownTimerTask
 	}
@@ -150,6 +150,66 @@ func (t *timerQueueTaskExecutorBase) executeDeleteHistoryEventTask(
 	)
 }
 
+func (t *timerQueueTaskExecutorBase) executeDeleteVisibilityRecordTask(
+	ctx context.Context,
+	task *tasks.DeleteVisibilityRecordTask,
+) (retError error) {
+	ctx, cancel := context.WithTimeout(ctx, taskTimeout)
+	defer cancel()
+
+	weContext, release, err := t.cache.GetOrCreateWorkflowExecution(
+		ctx,
+		namespace.ID(task.GetNamespaceID()),
+		commonpb.WorkflowExecution{
+			WorkflowId: task.GetWorkflowID(),
+			RunId:      task.GetRunID(),
+		},
+		workflow.LockPriorityLow,
+	)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	var closeVisibilityTaskID int64
+	mutableState, err := loadMutableStateForTimerTask(ctx, weContext, task, t.metricHandler, t.logger)
+	switch err.(type) {
+	case nil:
+		if mutableState == nil {
+			return nil
+		}
+		if mutableState.GetExecutionState().GetState() != enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+			// Cross DC replication can resurrect workflow, whose visibility record must be kept then.
+			return nil
+		}
+		closeVisibilityTaskID = mutableState.GetExecutionInfo().GetCloseVisibilityTaskId()
+	case *serviceerror.NotFound:
+		// the visibility retention expires after the history retention, which already deleted the mutable state.
+	default:
+		return err
+	}
+
+	return t.shard.AddTasks(ctx, &persistence.AddHistoryTasksRequest{
+		ShardID: t.shard.GetShardID(),
+		// RangeID is set by shard
+		NamespaceID: task.GetNamespaceID(),
+		WorkflowID:  task.GetWorkflowID(),
+		RunID:       task.GetRunID(),
+		Tasks: map[tasks.Category][]tasks.Task{
+			tasks.CategoryVisibility: {
+				&tasks.DeleteExecutionVisibilityTask{
+					// TaskID is set by shard
+					WorkflowKey:                    task.WorkflowKey,
+					VisibilityTimestamp:            t.shard.GetTimeSource().Now(),
+					Version:                        task.Version,
+					CloseExecutionVisibilityTaskID: closeVisibilityTaskID,
+					CloseTime:                      task.CloseTime,
+				},
+			},
+		},
+	})
+}
+
 func getWorkflowExecutionContextForTask(
 	ctx context.Context,
 	workflowCache wcache.Cache,
@@ -42,6 +42,7 @@ import (
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/service/history/deletemanager"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
@@ -211,3 +212,73 @@ func (s *timerQueueTaskExecutorBaseSuite) TestArchiveHistory_DeleteFailed() {
 		})
 	}
 }
+
+func (s *timerQueueTaskExecutorBaseSuite) Test_executeDeleteVisibilityRecordTask_MutableStateDeleted() {
+	closeTime := time.Now().UTC().Add(-365 * 24 * time.Hour)
+	task := &tasks.DeleteVisibilityRecordTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			tests.NamespaceID.String(),
+			tests.WorkflowID,
+			tests.RunID,
+		),
+		Version:             123,
+		TaskID:              12345,
+		VisibilityTimestamp: time.Now().UTC(),
+		CloseTime:           &closeTime,
+	}
+	we := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+
+	mockWeCtx := workflow.NewMockContext(s.controller)
+	s.mockCache.EXPECT().GetOrCreateWorkflowExecution(gomock.Any(), tests.NamespaceID, we, workflow.LockPriorityLow).Return(mockWeCtx, wcache.NoopReleaseFn, nil)
+	mockWeCtx.EXPECT().LoadMutableState(gomock.Any()).Return(nil, serviceerror.NewNotFound("workflow execution not found"))
+
+	mockEngine := shard.NewMockEngine(s.controller)
+	s.testShardContext.SetEngineForTesting(mockEngine)
+	mockEngine.EXPECT().NotifyNewTasks(gomock.Any())
+	s.testShardContext.Resource.NamespaceCache.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.LocalNamespaceEntry, nil).AnyTimes()
+	s.testShardContext.Resource.ExecutionMgr.EXPECT().AddHistoryTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.AddHistoryTasksRequest) error {
+			s.Len(request.Tasks[tasks.CategoryVisibility], 1)
+			deleteTask := request.Tasks[tasks.CategoryVisibility][0].(*tasks.DeleteExecutionVisibilityTask)
+			s.Equal(task.WorkflowKey, deleteTask.WorkflowKey)
+			s.Equal(&closeTime, deleteTask.CloseTime)
+			s.Zero(deleteTask.CloseExecutionVisibilityTaskID)
+			return nil
+		},
+	)
+
+	err := s.timerQueueTaskExecutorBase.executeDeleteVisibilityRecordTask(context.Background(), task)
+	s.NoError(err)
+}
+
+func (s *timerQueueTaskExecutorBaseSuite) Test_executeDeleteVisibilityRecordTask_WorkflowRunning() {
+	task := &tasks.DeleteVisibilityRecordTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			tests.NamespaceID.String(),
+			tests.WorkflowID,
+			tests.RunID,
+		),
+		Version:             123,
+		TaskID:              12345,
+		VisibilityTimestamp: time.Now().UTC(),
+	}
+	we := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+
+	mockWeCtx := workflow.NewMockContext(s.controller)
+	mockMutableState := workflow.NewMockMutableState(s.controller)
+	s.mockCache.EXPECT().GetOrCreateWorkflowExecution(gomock.Any(), tests.NamespaceID, we, workflow.LockPriorityLow).Return(mockWeCtx, wcache.NoopReleaseFn, nil)
+	mockWeCtx.EXPECT().LoadMutableState(gomock.Any()).Return(mockMutableState, nil)
+	mockMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{})
+	mockMutableState.EXPECT().GetNextEventID().Return(int64(2))
+	mockMutableState.EXPECT().GetExecutionState().Return(&persistencespb.WorkflowExecutionState{State: enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING})
+
+	// The visibility record of a resurrected workflow is kept.
+	err := s.timerQueueTaskExecutorBase.executeDeleteVisibilityRecordTask(context.Background(), task)
+	s.NoError(err)
+}
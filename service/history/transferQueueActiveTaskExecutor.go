@@ -29,6 +29,7 @@ import (
 	"fmt"
 
 	"github.com/pborman/uuid"
+	"go.opentelemetry.io/otel/trace"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
@@ -73,6 +74,7 @@ type (
 
 		workflowResetter        ndc.WorkflowResetter
 		parentClosePolicyClient parentclosepolicy.Client
+		traceContexts           *tasks.TraceContexts
 	}
 )
 
@@ -86,6 +88,7 @@ func newTransferQueueActiveTaskExecutor(
 	config *configs.Config,
 	matchingClient matchingservice.MatchingServiceClient,
 	visibilityManager manager.VisibilityManager,
+	traceContexts *tasks.TraceContexts,
 ) queues.Executor {
 	return &transferQueueActiveTaskExecutor{
 		transferQueueTaskExecutorBase: newTransferQueueTaskExecutorBase(
@@ -108,6 +111,7 @@ func newTransferQueueActiveTaskExecutor(
 			sdkClientFactory,
 			config.NumParentClosePolicySystemWorkflows(),
 		),
+		traceContexts: traceContexts,
 	}
 }
 
@@ -135,6 +139,9 @@ func (t *transferQueueActiveTaskExecutor) Execute(
 		return metricsTags, true, consts.ErrNamespaceHandover
 	}
 
+	ctx, span := t.startTaskSpan(ctx, task, taskType)
+	defer span.End()
+
 	var err error
 	switch task := task.(type) {
 	case *tasks.ActivityTask:
@@ -156,10 +163,26 @@ func (t *transferQueueActiveTaskExecutor) Execute(
 	default:
 		err = errUnknownTransferTask
 	}
+	if err != nil {
+		span.RecordError(err)
+	}
 
 	return metricsTags, true, err
 }
 
+// startTaskSpan starts a span for processing the task if it was created by a traced request on this host.
+func (t *transferQueueActiveTaskExecutor) startTaskSpan(
+	ctx context.Context,
+	task tasks.Task,
+	taskType string,
+) (context.Context, trace.Span) {
+	if t.traceContexts == nil {
+		// not the span of ctx, which must not be ended by the caller
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+	return t.traceContexts.StartSpan(ctx, t.shard.GetShardID(), task, "TransferTask/"+taskType, t.shard.GetTimeSource().Now())
+}
+
 func (t *transferQueueActiveTaskExecutor) processDeleteExecutionTask(ctx context.Context,
 	task *tasks.DeleteExecutionTask) error {
 	return t.transferQueueTaskExecutorBase.processDeleteExecutionTask(ctx, task,
@@ -249,12 +272,17 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 		mutableState.GetLastWorkflowTaskStartedEventID(),
 	)
 
+	// The first workflow task of a new execution and workflow tasks delivering updates are dispatched
+	// before the backlog of the task queue, so that starting and updating workflows stay responsive.
+	priority := mutableState.GetLastWorkflowTaskStartedEventID() == common.EmptyEventID ||
+		weContext.UpdateRegistry(ctx).HasOutgoing()
+
 	// NOTE: Do not access mutableState after this lock is released.
 	// It is important to release the workflow lock here, because pushWorkflowTask will call matching,
 	// which will call history back (with RecordWorkflowTaskStarted), and it will try to get workflow lock again.
 	release(nil)
 
-	err = t.pushWorkflowTask(ctx, transferTask, taskQueue, scheduleToStartTimeout, directive)
+	err = t.pushWorkflowTask(ctx, transferTask, taskQueue, scheduleToStartTimeout, directive, priority)
 
 	if _, ok := err.(*serviceerrors.StickyWorkerUnavailable); ok {
 		// sticky worker is unavailable, switch to original normal task queue
@@ -269,7 +297,7 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 		// There is no need to reset sticky, because if this task is picked by new worker, the new worker will reset
 		// the sticky queue to a new one. However, if worker is completely down, that schedule_to_start timeout task
 		// will re-create a new non-sticky task and reset sticky.
-		err = t.pushWorkflowTask(ctx, transferTask, taskQueue, scheduleToStartTimeout, directive)
+		err = t.pushWorkflowTask(ctx, transferTask, taskQueue, scheduleToStartTimeout, directive, priority)
 	}
 	return err
 }
@@ -242,6 +242,7 @@ func (s *transferQueueActiveTaskExecutorSuite) SetupTest() {
 		config,
 		s.mockShard.Resource.MatchingClient,
 		s.mockVisibilityManager,
+		nil,
 	).(*transferQueueActiveTaskExecutor)
 	s.transferQueueActiveTaskExecutor.parentClosePolicyClient = s.mockParentClosePolicyClient
 }
@@ -2664,6 +2665,7 @@ func (s *transferQueueActiveTaskExecutorSuite) createAddWorkflowTaskRequest(
 		ScheduleToStartTimeout: timeout,
 		Clock:                  vclock.NewVectorClock(s.mockClusterMetadata.GetClusterID(), s.mockShard.GetShardID(), task.TaskID),
 		VersionDirective:       directive,
+		Priority:               mutableState.GetLastWorkflowTaskStartedEventID() == common.EmptyEventID,
 	}
 }
 
@@ -61,6 +61,7 @@ type (
 		MatchingClient    resource.MatchingClient
 		HistoryClient     historyservice.HistoryServiceClient
 		VisibilityManager manager.VisibilityManager
+		TraceContexts     *tasks.TraceContexts `optional:"true"`
 	}
 
 	transferQueueFactory struct {
@@ -129,6 +130,7 @@ func (f *transferQueueFactory) CreateQueue(
 		f.Config,
 		f.MatchingClient,
 		f.VisibilityManager,
+		f.TraceContexts,
 	)
 
 	standbyExecutor := newTransferQueueStandbyTaskExecutor(
@@ -572,6 +572,7 @@ func (t *transferQueueStandbyTaskExecutor) pushWorkflowTask(
 		&pushwtInfo.taskqueue,
 		pushwtInfo.workflowTaskScheduleToStartTimeout,
 		pushwtInfo.versionDirective,
+		false,
 	)
 }
 
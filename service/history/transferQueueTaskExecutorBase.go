@@ -45,6 +45,7 @@ import (
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/taskfailure"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/deletemanager"
@@ -140,6 +141,8 @@ func (t *transferQueueTaskExecutorBase) pushActivity(
 		// NotFound error is not expected for AddTasks calls
 		// but will be ignored by task error handling logic, so log it here
 		tasks.InitializeLogger(task, t.logger).Error("Matching returned not found error for AddActivityTask", tag.Error(err))
+	} else if err != nil {
+		t.emitDispatchFailureMetric(task, err)
 	}
 
 	return err
@@ -151,6 +154,7 @@ func (t *transferQueueTaskExecutorBase) pushWorkflowTask(
 	taskqueue *taskqueuepb.TaskQueue,
 	workflowTaskScheduleToStartTimeout *time.Duration,
 	directive *taskqueuespb.TaskVersionDirective,
+	priority bool,
 ) error {
 	_, err := t.matchingClient.AddWorkflowTask(ctx, &matchingservice.AddWorkflowTaskRequest{
 		NamespaceId: task.NamespaceID,
@@ -163,16 +167,35 @@ func (t *transferQueueTaskExecutorBase) pushWorkflowTask(
 		ScheduleToStartTimeout: workflowTaskScheduleToStartTimeout,
 		Clock:                  vclock.NewVectorClock(t.shard.GetClusterMetadata().GetClusterID(), t.shard.GetShardID(), task.TaskID),
 		VersionDirective:       directive,
+		Priority:               priority,
 	})
 	if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
 		// NotFound error is not expected for AddTasks calls
 		// but will be ignored by task error handling logic, so log it here
 		tasks.InitializeLogger(task, t.logger).Error("Matching returned not found error for AddWorkflowTask", tag.Error(err))
+	} else if err != nil {
+		t.emitDispatchFailureMetric(task, err)
 	}
 
 	return err
 }
 
+func (t *transferQueueTaskExecutorBase) emitDispatchFailureMetric(
+	task tasks.Task,
+	err error,
+) {
+	namespaceName := namespace.EmptyName
+	if namespaceEntry, err := t.registry.GetNamespaceByID(namespace.ID(task.GetNamespaceID())); err == nil {
+		namespaceName = namespaceEntry.Name()
+	}
+	t.metricHandler.Counter(metrics.TaskDispatchFailures.GetMetricName()).Record(
+		1,
+		metrics.TaskTypeTag(task.GetType().String()),
+		metrics.NamespaceTag(namespaceName.String()),
+		metrics.FailureCauseTag(taskfailure.FromDispatchError(err).String()),
+	)
+}
+
 func (t *transferQueueTaskExecutorBase) archiveVisibility(
 	ctx context.Context,
 	namespaceID namespace.ID,
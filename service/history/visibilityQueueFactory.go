@@ -47,7 +47,8 @@ type (
 
 		QueueFactoryBaseParams
 
-		VisibilityMgr manager.VisibilityManager
+		VisibilityMgr    manager.VisibilityManager
+		IngestionMonitor *VisibilityIngestionMonitor
 	}
 
 	visibilityQueueFactory struct {
@@ -139,6 +140,7 @@ func (f *visibilityQueueFactory) CreateQueue(
 			CheckpointInterval:                  f.Config.VisibilityProcessorUpdateAckInterval,
 			CheckpointIntervalJitterCoefficient: f.Config.VisibilityProcessorUpdateAckIntervalJitterCoefficient,
 			MaxReaderCount:                      f.Config.QueueMaxReaderCount,
+			LagReporter:                         f.IngestionMonitor.lagReporter(shard.GetShardID()),
 		},
 		f.HostReaderRateLimiter,
 		logger,
@@ -0,0 +1,144 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"golang.org/x/exp/slices"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/queues"
+)
+
+type (
+	// VisibilityIngestionMonitor tracks how far the visibility queues of the shards owned by this host
+	// are behind, and applies backpressure to workflow progress on shards that fall too far behind.
+	VisibilityIngestionMonitor struct {
+		config           *configs.Config
+		esProcessorStats *elasticsearch.ProcessorStats
+		metricsHandler   metrics.Handler
+
+		sync.RWMutex
+		shardLags map[int32]time.Duration
+	}
+)
+
+var errVisibilityIngestionBehind = serviceerror.NewResourceExhausted(
+	enumspb.RESOURCE_EXHAUSTED_CAUSE_SYSTEM_OVERLOADED,
+	"Visibility ingestion of the workflow's shard is falling behind.",
+)
+
+func NewVisibilityIngestionMonitor(
+	config *configs.Config,
+	esProcessorConfig *elasticsearch.ProcessorConfig,
+	metricsHandler metrics.Handler,
+) *VisibilityIngestionMonitor {
+	var esProcessorStats *elasticsearch.ProcessorStats
+	if esProcessorConfig != nil {
+		esProcessorStats = esProcessorConfig.Stats
+	}
+	return &VisibilityIngestionMonitor{
+		config:           config,
+		esProcessorStats: esProcessorStats,
+		metricsHandler:   metricsHandler.WithTags(metrics.OperationTag(metrics.OperationVisibilityQueueProcessorScope)),
+		shardLags:        make(map[int32]time.Duration),
+	}
+}
+
+// lagReporter resets the lag of a shard and returns the reporter of its visibility queue.
+// The lag of a shard previously owned by the host is outdated, and would throttle it until the first checkpoint.
+func (m *VisibilityIngestionMonitor) lagReporter(shardID int32) queues.LagReporter {
+	m.Lock()
+	delete(m.shardLags, shardID)
+	m.Unlock()
+
+	return func(lag time.Duration) {
+		m.Lock()
+		m.shardLags[shardID] = lag
+		m.Unlock()
+
+		m.metricsHandler.Timer(metrics.VisibilityQueueLag.GetMetricName()).Record(lag)
+		if lag > m.config.VisibilityProcessorLagSLO() {
+			m.metricsHandler.Counter(metrics.VisibilityQueueLagSLOViolations.GetMetricName()).Record(1)
+		}
+	}
+}
+
+func (m *VisibilityIngestionMonitor) shardLag(shardID int32) time.Duration {
+	m.RLock()
+	defer m.RUnlock()
+	return m.shardLags[shardID]
+}
+
+func (m *VisibilityIngestionMonitor) isThrottled(lag time.Duration) bool {
+	return m.config.VisibilityProcessorBackpressureEnabled() && lag > m.config.VisibilityProcessorLagHardLimit()
+}
+
+// checkBackpressure returns a ResourceExhausted error if new work must not be added to the shard
+// until its visibility queue catches up.
+func (m *VisibilityIngestionMonitor) checkBackpressure(shardID int32) error {
+	if !m.isThrottled(m.shardLag(shardID)) {
+		return nil
+	}
+	m.metricsHandler.Counter(metrics.VisibilityBackpressureRequests.GetMetricName()).Record(1)
+	return errVisibilityIngestionBehind
+}
+
+func (m *VisibilityIngestionMonitor) describe(shardIDs []int32) *historyservice.DescribeVisibilityIngestionResponse {
+	sortedShardIDs := slices.Clone(shardIDs)
+	slices.Sort(sortedShardIDs)
+
+	response := &historyservice.DescribeVisibilityIngestionResponse{
+		Shards: make([]*adminservice.ShardVisibilityIngestion, 0, len(sortedShardIDs)),
+	}
+	for _, shardID := range sortedShardIDs {
+		lag := m.shardLag(shardID)
+		response.Shards = append(response.Shards, &adminservice.ShardVisibilityIngestion{
+			ShardId:     shardID,
+			Lag:         timestamp.DurationPtr(lag),
+			SloViolated: lag > m.config.VisibilityProcessorLagSLO(),
+			Throttled:   m.isThrottled(lag),
+		})
+	}
+	if m.esProcessorStats != nil {
+		snapshot := m.esProcessorStats.Snapshot()
+		response.ElasticsearchBulkProcessor = &adminservice.ElasticsearchBulkProcessorStats{
+			InFlightBulks:    snapshot.InFlightBulks,
+			Workers:          snapshot.Workers,
+			Saturation:       snapshot.Saturation,
+			DroppedDocuments: snapshot.DroppedDocuments,
+		}
+	}
+	return response
+}
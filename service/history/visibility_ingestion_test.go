@@ -0,0 +1,81 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/tests"
+)
+
+func TestVisibilityIngestionMonitor(t *testing.T) {
+	config := tests.NewDynamicConfig()
+	config.VisibilityProcessorLagSLO = dynamicconfig.GetDurationPropertyFn(time.Minute)
+	config.VisibilityProcessorLagHardLimit = dynamicconfig.GetDurationPropertyFn(10 * time.Minute)
+	backpressureEnabled := false
+	config.VisibilityProcessorBackpressureEnabled = func() bool { return backpressureEnabled }
+
+	monitor := NewVisibilityIngestionMonitor(
+		config,
+		&elasticsearch.ProcessorConfig{Stats: elasticsearch.NewProcessorStats()},
+		metrics.NoopMetricsHandler,
+	)
+	monitor.lagReporter(1)(30 * time.Second)
+	reportShard2 := monitor.lagReporter(2)
+	reportShard2(time.Hour)
+
+	require.NoError(t, monitor.checkBackpressure(1))
+	require.NoError(t, monitor.checkBackpressure(2))
+	backpressureEnabled = true
+	require.NoError(t, monitor.checkBackpressure(1))
+	err := monitor.checkBackpressure(2)
+	require.ErrorAs(t, err, new(*serviceerror.ResourceExhausted))
+
+	response := monitor.describe([]int32{3, 2, 1})
+	require.Equal(t, []*adminservice.ShardVisibilityIngestion{
+		{ShardId: 1, Lag: timestamp.DurationPtr(30 * time.Second)},
+		{ShardId: 2, Lag: timestamp.DurationPtr(time.Hour), SloViolated: true, Throttled: true},
+		{ShardId: 3, Lag: timestamp.DurationPtr(0)},
+	}, response.Shards)
+	require.Equal(t, &adminservice.ElasticsearchBulkProcessorStats{}, response.ElasticsearchBulkProcessor)
+
+	// a queue created for a shard moving back to the host starts without lag
+	monitor.lagReporter(2)
+	require.NoError(t, monitor.checkBackpressure(2))
+}
+
+func TestVisibilityIngestionMonitor_NoElasticsearch(t *testing.T) {
+	monitor := NewVisibilityIngestionMonitor(tests.NewDynamicConfig(), nil, metrics.NoopMetricsHandler)
+	require.Nil(t, monitor.describe(nil).ElasticsearchBulkProcessor)
+}
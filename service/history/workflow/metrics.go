@@ -26,10 +26,44 @@ package workflow
 
 import (
 	enumspb "go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	historypb "go.temporal.io/api/history/v1"
 
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/consts"
+)
+
+// Values of the workflow closure tags. They are a metric dimension dashboards and alerts rely on, so they must
+// never be renamed.
+const (
+	closureTagNone = "none"
+
+	failureSourceActivity      = "activity"
+	failureSourceChildWorkflow = "child_workflow"
+	failureSourceApplication   = "application"
+	failureSourceTimeout       = "timeout"
+	failureSourceCanceled      = "canceled"
+	failureSourceTerminated    = "terminated"
+	failureSourceServer        = "server"
+	failureSourceReset         = "reset"
+	failureSourceTermination   = "termination"
+	failureSourceOther         = "other"
+
+	timeoutTypeWorkflowRun       = "workflow_run"
+	timeoutTypeWorkflowExecution = "workflow_execution"
+
+	terminateReasonUser               = "user"
+	terminateReasonSizeLimit          = "size_limit"
+	terminateReasonIDReusePolicy      = "id_reuse_policy"
+	terminateReasonDelete             = "delete"
+	terminateReasonReset              = "reset"
+	terminateReasonConflictResolution = "conflict_resolution"
+	terminateReasonSystem             = "system"
 )
 
 func emitWorkflowHistoryStats(
@@ -88,6 +122,23 @@ func emitMutableStateStatus(
 	}
 }
 
+// emitStorageBytesWritten counts the bytes a persistence write added for the namespace, so that storage
+// growth can be observed between two runs of the storage usage scanner.
+func emitStorageBytesWritten(
+	metricsHandler metrics.Handler,
+	stats *persistence.MutableStateStatistics,
+) {
+	if stats == nil {
+		return
+	}
+
+	counter := metricsHandler.Counter(metrics.NamespaceStorageBytesWritten.GetMetricName())
+	counter.Record(int64(stats.TotalSize), metrics.StorageTypeTag(namespace.StorageTypeMutableState))
+	if stats.HistoryStatistics != nil {
+		counter.Record(int64(stats.HistoryStatistics.SizeDiff), metrics.StorageTypeTag(namespace.StorageTypeHistory))
+	}
+}
+
 func emitWorkflowCompletionStats(
 	metricsHandler metrics.Handler,
 	namespace namespace.Name,
@@ -117,3 +168,143 @@ func emitWorkflowCompletionStats(
 		handler.Counter(metrics.WorkflowContinuedAsNewCount.GetMetricName()).Record(1)
 	}
 }
+
+// emitWorkflowClosureStats counts the closure of a workflow by status and cause. Unlike the completion stats, it is
+// only emitted by the transaction adding the close event, and is tagged by namespace rather than task queue.
+func emitWorkflowClosureStats(
+	metricsHandler metrics.Handler,
+	namespace namespace.Name,
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+	closeEvent *historypb.HistoryEvent,
+) {
+	metricsHandler.Counter(metrics.WorkflowClosedCount.GetMetricName()).Record(
+		1,
+		append(
+			[]metrics.Tag{
+				metrics.OperationTag(metrics.WorkflowCompletionStatsScope),
+				metrics.NamespaceTag(namespace.String()),
+			},
+			workflowClosureTags(executionInfo, closeEvent)...,
+		)...,
+	)
+}
+
+// workflowClosureTags returns the status and cause tags of a workflow closed by the event. All tags are always
+// returned, the ones which don't apply to the status set to none, as metrics backends expect the same tags for
+// all the series of a metric.
+func workflowClosureTags(
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+	closeEvent *historypb.HistoryEvent,
+) []metrics.Tag {
+	status := enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED
+	failureSource := closureTagNone
+	activityType := closureTagNone
+	timeoutType := closureTagNone
+	terminateReason := closureTagNone
+
+	switch closeEvent.GetEventType() {
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
+		status = enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:
+		status = enumspb.WORKFLOW_EXECUTION_STATUS_CANCELED
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+		status = enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:
+		status = enumspb.WORKFLOW_EXECUTION_STATUS_FAILED
+		failureSource, activityType, timeoutType = classifyWorkflowFailure(
+			closeEvent.GetWorkflowExecutionFailedEventAttributes().GetFailure(),
+		)
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT:
+		status = enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT
+		failureSource = failureSourceTimeout
+		timeoutType = classifyWorkflowTimeout(executionInfo, closeEvent)
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED:
+		status = enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED
+		failureSource = failureSourceTermination
+		terminateReason = classifyTermination(closeEvent.GetWorkflowExecutionTerminatedEventAttributes())
+	}
+
+	return []metrics.Tag{
+		metrics.WorkflowStatusTag(status),
+		metrics.FailureSourceTag(failureSource),
+		metrics.ActivityTypeTag(activityType),
+		metrics.TimeoutTypeTag(timeoutType),
+		metrics.TerminateReasonTag(terminateReason),
+	}
+}
+
+// classifyWorkflowFailure returns what failed the workflow, and the activity type and timeout type involved, if
+// any.
+func classifyWorkflowFailure(
+	failure *failurepb.Failure,
+) (failureSource string, activityType string, timeoutType string) {
+	activityType = closureTagNone
+	timeoutType = closureTagNone
+	if info := failure.GetActivityFailureInfo(); info != nil {
+		activityType = info.GetActivityType().GetName()
+		if timeoutInfo := failure.GetCause().GetTimeoutFailureInfo(); timeoutInfo != nil {
+			timeoutType = timeoutInfo.GetTimeoutType().String()
+		}
+		return failureSourceActivity, activityType, timeoutType
+	}
+
+	switch {
+	case failure.GetChildWorkflowExecutionFailureInfo() != nil:
+		return failureSourceChildWorkflow, activityType, timeoutType
+	case failure.GetApplicationFailureInfo() != nil:
+		return failureSourceApplication, activityType, timeoutType
+	case failure.GetTimeoutFailureInfo() != nil:
+		return failureSourceTimeout, activityType, failure.GetTimeoutFailureInfo().GetTimeoutType().String()
+	case failure.GetCanceledFailureInfo() != nil:
+		return failureSourceCanceled, activityType, timeoutType
+	case failure.GetTerminatedFailureInfo() != nil:
+		return failureSourceTerminated, activityType, timeoutType
+	case failure.GetServerFailureInfo() != nil:
+		return failureSourceServer, activityType, timeoutType
+	case failure.GetResetWorkflowFailureInfo() != nil:
+		return failureSourceReset, activityType, timeoutType
+	default:
+		return failureSourceOther, activityType, timeoutType
+	}
+}
+
+// classifyWorkflowTimeout returns whether the workflow timed out on its execution or on its run timeout. The
+// execution timeout is reported when both expired.
+func classifyWorkflowTimeout(
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+	closeEvent *historypb.HistoryEvent,
+) string {
+	executionExpirationTime := timestamp.TimeValue(executionInfo.GetWorkflowExecutionExpirationTime())
+	if !executionExpirationTime.IsZero() && !timestamp.TimeValue(closeEvent.GetEventTime()).Before(executionExpirationTime) {
+		return timeoutTypeWorkflowExecution
+	}
+	return timeoutTypeWorkflowRun
+}
+
+// classifyTermination returns the category of the reason a workflow was terminated for. Terminations requested
+// through the API are all reported as user terminations.
+func classifyTermination(
+	attributes *historypb.WorkflowExecutionTerminatedEventAttributes,
+) string {
+	switch attributes.GetReason() {
+	case common.FailureReasonHistorySizeExceedsLimit,
+		common.FailureReasonMutableStateSizeExceedsLimit,
+		common.FailureReasonTransactionSizeExceedsLimit:
+		return terminateReasonSizeLimit
+	case consts.TerminateReasonIDReusePolicy:
+		return terminateReasonIDReusePolicy
+	case consts.TerminateReasonDeleteWorkflow:
+		return terminateReasonDelete
+	case consts.TerminateReasonVersionConflict:
+		return terminateReasonConflictResolution
+	}
+
+	switch attributes.GetIdentity() {
+	case consts.IdentityResetter:
+		return terminateReasonReset
+	case consts.IdentityHistoryService:
+		return terminateReasonSystem
+	default:
+		return terminateReasonUser
+	}
+}
@@ -0,0 +1,209 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workflow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/consts"
+)
+
+func closureTagValues(tags []metrics.Tag) map[string]string {
+	values := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		values[tag.Key()] = tag.Value()
+	}
+	return values
+}
+
+func Test_WorkflowClosureTags(t *testing.T) {
+	now := time.Now().UTC()
+	activityFailure := &failurepb.Failure{
+		FailureInfo: &failurepb.Failure_ActivityFailureInfo{ActivityFailureInfo: &failurepb.ActivityFailureInfo{
+			ActivityType: &commonpb.ActivityType{Name: "charge-card"},
+		}},
+		Cause: &failurepb.Failure{
+			FailureInfo: &failurepb.Failure_TimeoutFailureInfo{TimeoutFailureInfo: &failurepb.TimeoutFailureInfo{
+				TimeoutType: enumspb.TIMEOUT_TYPE_START_TO_CLOSE,
+			}},
+		},
+	}
+	failedEvent := func(failure *failurepb.Failure) *historypb.HistoryEvent {
+		return &historypb.HistoryEvent{
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionFailedEventAttributes{
+				WorkflowExecutionFailedEventAttributes: &historypb.WorkflowExecutionFailedEventAttributes{Failure: failure},
+			},
+		}
+	}
+	terminatedEvent := func(reason string, identity string) *historypb.HistoryEvent {
+		return &historypb.HistoryEvent{
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionTerminatedEventAttributes{
+				WorkflowExecutionTerminatedEventAttributes: &historypb.WorkflowExecutionTerminatedEventAttributes{
+					Reason:   reason,
+					Identity: identity,
+				},
+			},
+		}
+	}
+	timedOutEvent := &historypb.HistoryEvent{
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT,
+		EventTime: timestamp.TimePtr(now),
+	}
+
+	testCases := []struct {
+		name          string
+		executionInfo *persistencespb.WorkflowExecutionInfo
+		closeEvent    *historypb.HistoryEvent
+		expected      map[string]string
+	}{
+		{
+			name:       "completed",
+			closeEvent: &historypb.HistoryEvent{EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED},
+			expected: map[string]string{
+				metrics.WorkflowStatusTagName:  "Completed",
+				metrics.FailureSourceTagName:   closureTagNone,
+				"activityType":                 closureTagNone,
+				metrics.TimeoutTypeTagName:     closureTagNone,
+				metrics.TerminateReasonTagName: closureTagNone,
+			},
+		},
+		{
+			name:       "activity failure",
+			closeEvent: failedEvent(activityFailure),
+			expected: map[string]string{
+				metrics.WorkflowStatusTagName:  "Failed",
+				metrics.FailureSourceTagName:   failureSourceActivity,
+				"activityType":                 "charge-card",
+				metrics.TimeoutTypeTagName:     "StartToClose",
+				metrics.TerminateReasonTagName: closureTagNone,
+			},
+		},
+		{
+			name: "application failure",
+			closeEvent: failedEvent(&failurepb.Failure{
+				FailureInfo: &failurepb.Failure_ApplicationFailureInfo{ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{}},
+			}),
+			expected: map[string]string{
+				metrics.WorkflowStatusTagName:  "Failed",
+				metrics.FailureSourceTagName:   failureSourceApplication,
+				"activityType":                 closureTagNone,
+				metrics.TimeoutTypeTagName:     closureTagNone,
+				metrics.TerminateReasonTagName: closureTagNone,
+			},
+		},
+		{
+			name: "run timeout",
+			executionInfo: &persistencespb.WorkflowExecutionInfo{
+				WorkflowExecutionExpirationTime: timestamp.TimePtr(now.Add(time.Hour)),
+			},
+			closeEvent: timedOutEvent,
+			expected: map[string]string{
+				metrics.WorkflowStatusTagName:  "TimedOut",
+				metrics.FailureSourceTagName:   failureSourceTimeout,
+				"activityType":                 closureTagNone,
+				metrics.TimeoutTypeTagName:     timeoutTypeWorkflowRun,
+				metrics.TerminateReasonTagName: closureTagNone,
+			},
+		},
+		{
+			name: "execution timeout",
+			executionInfo: &persistencespb.WorkflowExecutionInfo{
+				WorkflowExecutionExpirationTime: timestamp.TimePtr(now),
+			},
+			closeEvent: timedOutEvent,
+			expected: map[string]string{
+				metrics.WorkflowStatusTagName:  "TimedOut",
+				metrics.FailureSourceTagName:   failureSourceTimeout,
+				"activityType":                 closureTagNone,
+				metrics.TimeoutTypeTagName:     timeoutTypeWorkflowExecution,
+				metrics.TerminateReasonTagName: closureTagNone,
+			},
+		},
+		{
+			name:       "user termination",
+			closeEvent: terminatedEvent("bad deploy", "ops@example.com"),
+			expected: map[string]string{
+				metrics.WorkflowStatusTagName:  "Terminated",
+				metrics.FailureSourceTagName:   failureSourceTermination,
+				"activityType":                 closureTagNone,
+				metrics.TimeoutTypeTagName:     closureTagNone,
+				metrics.TerminateReasonTagName: terminateReasonUser,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, closureTagValues(workflowClosureTags(tc.executionInfo, tc.closeEvent)))
+		})
+	}
+}
+
+func Test_ClassifyTermination(t *testing.T) {
+	testCases := []struct {
+		reason   string
+		identity string
+		expected string
+	}{
+		{reason: common.FailureReasonHistorySizeExceedsLimit, identity: consts.IdentityHistoryService, expected: terminateReasonSizeLimit},
+		{reason: consts.TerminateReasonIDReusePolicy, identity: consts.IdentityHistoryService, expected: terminateReasonIDReusePolicy},
+		{reason: consts.TerminateReasonDeleteWorkflow, identity: consts.IdentityHistoryService, expected: terminateReasonDelete},
+		{reason: consts.TerminateReasonVersionConflict, identity: "worker-service", expected: terminateReasonConflictResolution},
+		{reason: "reset workflow", identity: consts.IdentityResetter, expected: terminateReasonReset},
+		{reason: "something else", identity: consts.IdentityHistoryService, expected: terminateReasonSystem},
+		{reason: "", identity: "", expected: terminateReasonUser},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, classifyTermination(&historypb.WorkflowExecutionTerminatedEventAttributes{
+			Reason:   tc.reason,
+			Identity: tc.identity,
+		}), tc.reason)
+	}
+}
+
+func Test_FindCloseEvent(t *testing.T) {
+	closeEvent := &historypb.HistoryEvent{EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED}
+	assert.Nil(t, findCloseEvent(nil))
+	assert.Nil(t, findCloseEvent([]*persistence.WorkflowEvents{{Events: []*historypb.HistoryEvent{
+		{EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED},
+	}}}))
+	assert.Equal(t, closeEvent, findCloseEvent([]*persistence.WorkflowEvents{{Events: []*historypb.HistoryEvent{
+		{EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED},
+		closeEvent,
+	}}}))
+}
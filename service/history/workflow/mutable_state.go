@@ -289,6 +289,8 @@ type (
 		UpdateUserTimer(*persistencespb.TimerInfo) error
 		UpdateCurrentVersion(version int64, forceUpdate bool) error
 		UpdateWorkflowStateStatus(state enumsspb.WorkflowExecutionState, status enumspb.WorkflowExecutionStatus) error
+		UpsertMemo(memo *commonpb.Memo) error
+		UpsertSearchAttributes(searchAttributes *commonpb.SearchAttributes) error
 
 		GetHistorySize() int64
 		AddHistorySize(size int64)
@@ -936,7 +936,7 @@ func (ms *MutableStateImpl) GetRetryBackoffDuration(
 		return backoff.NoBackoff, enumspb.RETRY_STATE_RETRY_POLICY_NOT_SET
 	}
 
-	return getBackoffInterval(
+	backoffInterval, retryState := getBackoffInterval(
 		ms.timeSource.Now(),
 		info.Attempt,
 		info.RetryMaximumAttempts,
@@ -947,6 +947,7 @@ func (ms *MutableStateImpl) GetRetryBackoffDuration(
 		failure,
 		info.RetryNonRetryableErrorTypes,
 	)
+	return ms.accelerateTimer(backoffInterval), retryState
 }
 
 func (ms *MutableStateImpl) GetCronBackoffDuration() time.Duration {
@@ -2303,6 +2304,15 @@ func (ms *MutableStateImpl) AddActivityTaskScheduledEvent(
 		return nil, nil, ms.createCallerError(opTag, "ActivityID: "+command.GetActivityId())
 	}
 
+	// accelerate how long the task may wait in matching for a worker, like timers the result is recorded
+	// in the event
+	scheduleToStartTimeout := timestamp.DurationValue(command.GetScheduleToStartTimeout())
+	if accelerated := ms.accelerateTimer(scheduleToStartTimeout); accelerated != scheduleToStartTimeout {
+		acceleratedCommand := *command
+		acceleratedCommand.ScheduleToStartTimeout = timestamp.DurationPtr(accelerated)
+		command = &acceleratedCommand
+	}
+
 	event := ms.hBuilder.AddActivityTaskScheduledEvent(workflowTaskCompletedEventID, command)
 	ai, err := ms.ReplicateActivityTaskScheduledEvent(workflowTaskCompletedEventID, event)
 	// TODO merge active & passive task generation
@@ -3212,6 +3222,39 @@ func (ms *MutableStateImpl) ReplicateWorkflowPropertiesModifiedEvent(
 	}
 }
 
+// UpsertMemo merges memo into the workflow memo without writing a history event, so it is
+// allowed on closed executions as well. Visibility is refreshed through an upsert task while
+// the workflow runs and by re-recording the closed execution afterwards.
+func (ms *MutableStateImpl) UpsertMemo(
+	memo *commonpb.Memo,
+) error {
+	ms.approximateSize -= ms.executionInfo.Size()
+	ms.executionInfo.Memo = payload.MergeMapOfPayload(ms.executionInfo.Memo, memo.GetFields())
+	ms.approximateSize += ms.executionInfo.Size()
+
+	if ms.IsWorkflowExecutionRunning() {
+		return ms.taskGenerator.GenerateUpsertVisibilityTask()
+	}
+	return ms.taskGenerator.GenerateCloseVisibilityTask()
+}
+
+// UpsertSearchAttributes merges searchAttributes into the workflow search attributes without writing
+// a history event, so it is allowed on closed executions as well. Like UpsertMemo, the visibility
+// record is refreshed through an upsert task while the workflow runs and by re-recording the
+// closed execution afterwards.
+func (ms *MutableStateImpl) UpsertSearchAttributes(
+	searchAttributes *commonpb.SearchAttributes,
+) error {
+	ms.approximateSize -= ms.executionInfo.Size()
+	ms.executionInfo.SearchAttributes = payload.MergeMapOfPayload(ms.executionInfo.SearchAttributes, searchAttributes.GetIndexedFields())
+	ms.approximateSize += ms.executionInfo.Size()
+
+	if ms.IsWorkflowExecutionRunning() {
+		return ms.taskGenerator.GenerateUpsertVisibilityTask()
+	}
+	return ms.taskGenerator.GenerateCloseVisibilityTask()
+}
+
 func (ms *MutableStateImpl) AddExternalWorkflowExecutionSignaled(
 	initiatedID int64,
 	targetNamespace namespace.Name,
@@ -3327,6 +3370,15 @@ func (ms *MutableStateImpl) AddTimerStartedEvent(
 		return nil, nil, ms.createCallerError(opTag, "TimerID: "+command.GetTimerId())
 	}
 
+	// acceleration is applied once here and recorded in the event, so that standby clusters and rebuilds
+	// fire the timer at the same time whatever their own config
+	startToFireTimeout := timestamp.DurationValue(command.GetStartToFireTimeout())
+	if accelerated := ms.accelerateTimer(startToFireTimeout); accelerated != startToFireTimeout {
+		acceleratedCommand := *command
+		acceleratedCommand.StartToFireTimeout = timestamp.DurationPtr(accelerated)
+		command = &acceleratedCommand
+	}
+
 	event := ms.hBuilder.AddTimerStartedEvent(workflowTaskCompletedEventID, command)
 	ti, err := ms.ReplicateTimerStartedEvent(event)
 	if err != nil {
@@ -4239,7 +4291,7 @@ func (ms *MutableStateImpl) RetryActivity(
 	// a retry is needed, update activity info for next retry
 	ai.Version = ms.GetCurrentVersion()
 	ai.Attempt++
-	ai.ScheduledTime = timestamp.TimePtr(now.Add(backoffInterval)) // update to next schedule time
+	ai.ScheduledTime = timestamp.TimePtr(now.Add(ms.accelerateTimer(backoffInterval))) // update to next schedule time
 	ai.StartedEventId = common.EmptyEventID
 	ai.RequestId = ""
 	ai.StartedTime = timestamp.TimePtr(time.Time{})
@@ -4259,6 +4311,19 @@ func (ms *MutableStateImpl) RetryActivity(
 	return enumspb.RETRY_STATE_IN_PROGRESS, nil
 }
 
+// accelerateTimer scales down a durable timer, retry backoff or activity schedule-to-start duration by the
+// namespace's timer acceleration factor, if one is configured. It must only be applied by the active cluster
+// to values which are then persisted, never when replicating events.
+func (ms *MutableStateImpl) accelerateTimer(
+	duration time.Duration,
+) time.Duration {
+	factor := ms.config.TimerAccelerationFactor(ms.namespaceEntry.Name().String())
+	if factor <= 1 || duration <= 0 {
+		return duration
+	}
+	return time.Duration(float64(duration) / factor)
+}
+
 func (ms *MutableStateImpl) truncateRetryableActivityFailure(
 	activityFailure *failurepb.Failure,
 ) *failurepb.Failure {
@@ -56,6 +56,7 @@ import (
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -64,6 +65,7 @@ import (
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/events"
 	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/tests"
 )
 
@@ -925,6 +927,65 @@ func (s *mutableStateSuite) TestReplicateActivityTaskStartedEvent() {
 	s.Assert().Nil(ai.LastHeartbeatDetails)
 }
 
+func (s *mutableStateSuite) TestAddTimerStartedEvent_Accelerated() {
+	s.mockConfig.TimerAccelerationFactor = func(namespace string) float64 { return 60 }
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+
+	event, ti, err := s.mutableState.AddTimerStartedEvent(
+		int64(4),
+		&commandpb.StartTimerCommandAttributes{
+			TimerId:            "timer-1",
+			StartToFireTimeout: timestamp.DurationPtr(time.Hour),
+		},
+	)
+	s.NoError(err)
+	s.Equal(time.Minute, *event.GetTimerStartedEventAttributes().GetStartToFireTimeout())
+	s.Equal(event.GetEventTime().Add(time.Minute), *ti.ExpiryTime)
+}
+
+func (s *mutableStateSuite) TestReplicateTimerStartedEvent_NotAccelerated() {
+	// the active cluster already recorded the accelerated duration in the event
+	s.mockConfig.TimerAccelerationFactor = func(namespace string) float64 { return 60 }
+
+	now := time.Now().UTC()
+	ti, err := s.mutableState.ReplicateTimerStartedEvent(&historypb.HistoryEvent{
+		EventId:   int64(5),
+		EventTime: &now,
+		Version:   int64(1),
+		Attributes: &historypb.HistoryEvent_TimerStartedEventAttributes{
+			TimerStartedEventAttributes: &historypb.TimerStartedEventAttributes{
+				TimerId:            "timer-1",
+				StartToFireTimeout: timestamp.DurationPtr(time.Hour),
+			},
+		},
+	})
+	s.NoError(err)
+	s.Equal(now.Add(time.Hour), *ti.ExpiryTime)
+}
+
+func (s *mutableStateSuite) TestAddActivityTaskScheduledEvent_Accelerated() {
+	s.mockConfig.TimerAccelerationFactor = func(namespace string) float64 { return 60 }
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+
+	event, ai, err := s.mutableState.AddActivityTaskScheduledEvent(
+		int64(4),
+		&commandpb.ScheduleActivityTaskCommandAttributes{
+			ActivityId:             "activity-1",
+			ScheduleToCloseTimeout: timestamp.DurationPtr(2 * time.Hour),
+			ScheduleToStartTimeout: timestamp.DurationPtr(time.Hour),
+			StartToCloseTimeout:    timestamp.DurationPtr(time.Hour),
+		},
+		true,
+	)
+	s.NoError(err)
+	attributes := event.GetActivityTaskScheduledEventAttributes()
+	s.Equal(time.Minute, *attributes.GetScheduleToStartTimeout())
+	s.Equal(time.Minute, *ai.ScheduleToStartTimeout)
+	// the activity keeps its full time to run
+	s.Equal(2*time.Hour, *attributes.GetScheduleToCloseTimeout())
+	s.Equal(time.Hour, *attributes.GetStartToCloseTimeout())
+}
+
 func (s *mutableStateSuite) TestTotalEntitiesCount() {
 	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
 
@@ -1067,6 +1128,62 @@ func (s *mutableStateSuite) TestSpeculativeWorkflowTaskNotPersisted() {
 	}
 }
 
+func (s *mutableStateSuite) TestUpsertMemo() {
+	testCases := []struct {
+		name         string
+		state        enumsspb.WorkflowExecutionState
+		status       enumspb.WorkflowExecutionStatus
+		expectedTask enumsspb.TaskType
+	}{
+		{
+			name:         "running",
+			state:        enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+			status:       enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			expectedTask: enumsspb.TASK_TYPE_VISIBILITY_UPSERT_EXECUTION,
+		},
+		{
+			name:         "closed",
+			state:        enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+			status:       enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			expectedTask: enumsspb.TASK_TYPE_VISIBILITY_CLOSE_EXECUTION,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			dbState := s.buildWorkflowMutableState()
+			dbState.ExecutionState.State = tc.state
+			dbState.ExecutionState.Status = tc.status
+			dbState.ExecutionInfo.Memo = map[string]*commonpb.Payload{
+				"kept":    payload.EncodeString("kept"),
+				"removed": payload.EncodeString("removed"),
+			}
+
+			var err error
+			s.mutableState, err = newMutableStateFromDB(s.mockShard, s.mockEventsCache, s.logger, tests.LocalNamespaceEntry, dbState, 123)
+			s.NoError(err)
+			nextEventID := s.mutableState.GetNextEventID()
+
+			nilPayload, err := payload.Encode(nil)
+			s.NoError(err)
+			err = s.mutableState.UpsertMemo(&commonpb.Memo{Fields: map[string]*commonpb.Payload{
+				"added":   payload.EncodeString("added"),
+				"removed": nilPayload,
+			}})
+			s.NoError(err)
+			s.Equal(map[string]*commonpb.Payload{
+				"kept":  payload.EncodeString("kept"),
+				"added": payload.EncodeString("added"),
+			}, s.mutableState.GetExecutionInfo().Memo)
+			s.Equal(nextEventID, s.mutableState.GetNextEventID())
+
+			visibilityTasks := s.mutableState.PopTasks()[tasks.CategoryVisibility]
+			s.Len(visibilityTasks, 1)
+			s.Equal(tc.expectedTask, visibilityTasks[0].GetType())
+		})
+	}
+}
+
 func (s *mutableStateSuite) TestRetryActivity_TruncateRetryableFailure() {
 	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
 
@@ -2782,6 +2782,34 @@ func (mr *MockMutableStateMockRecorder) UpdateWorkflowStateStatus(state, status
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowStateStatus", reflect.TypeOf((*MockMutableState)(nil).UpdateWorkflowStateStatus), state, status)
 }
 
+// UpsertMemo mocks base method.
+func (m *MockMutableState) UpsertMemo(memo *v10.Memo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertMemo", memo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertMemo indicates an expected call of UpsertMemo.
+func (mr *MockMutableStateMockRecorder) UpsertMemo(memo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertMemo", reflect.TypeOf((*MockMutableState)(nil).UpsertMemo), memo)
+}
+
+// UpsertSearchAttributes mocks base method.
+func (m *MockMutableState) UpsertSearchAttributes(searchAttributes *v10.SearchAttributes) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertSearchAttributes", searchAttributes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertSearchAttributes indicates an expected call of UpsertSearchAttributes.
+func (mr *MockMutableStateMockRecorder) UpsertSearchAttributes(searchAttributes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSearchAttributes", reflect.TypeOf((*MockMutableState)(nil).UpsertSearchAttributes), searchAttributes)
+}
+
 // VisitUpdates mocks base method.
 func (m *MockMutableState) VisitUpdates(visitor func(string, *v113.UpdateInfo)) {
 	m.ctrl.T.Helper()
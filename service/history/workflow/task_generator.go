@@ -63,6 +63,9 @@ type (
 		// safely delete it.
 		GenerateDeleteHistoryEventTask(closeTime time.Time, workflowDataAlreadyArchived bool) error
 		GenerateDeleteExecutionTask() (*tasks.DeleteExecutionTask, error)
+		// GenerateDeleteVisibilityRecordTask returns a tasks.DeleteVisibilityRecordTask deleting the visibility record
+		// of the closed workflow execution once visibilityRetention has passed since closeTime.
+		GenerateDeleteVisibilityRecordTask(closeTime time.Time, visibilityRetention time.Duration) (*tasks.DeleteVisibilityRecordTask, error)
 		GenerateRecordWorkflowStartedTasks(
 			startEvent *historypb.HistoryEvent,
 		) error
@@ -92,6 +95,9 @@ type (
 			event *historypb.HistoryEvent,
 		) error
 		GenerateUpsertVisibilityTask() error
+		// GenerateCloseVisibilityTask re-records the visibility of a closed workflow,
+		// used when the execution is modified after it closed.
+		GenerateCloseVisibilityTask() error
 		GenerateWorkflowResetTasks() error
 
 		// these 2 APIs should only be called when mutable state transaction is being closed
@@ -224,6 +230,20 @@ func (r *TaskGeneratorImpl) GenerateWorkflowCloseTasks(
 				return err
 			}
 		}
+		// A visibility retention longer than the retention is handled when the history is deleted, but a shorter
+		// one needs a timer of its own.
+		visibilityRetention, ok, err := r.getShorterVisibilityRetention()
+		if err != nil {
+			return err
+		}
+		if ok {
+			closeTime := timestamp.TimeValue(closeEvent.GetEventTime())
+			task, err := r.GenerateDeleteVisibilityRecordTask(closeTime, visibilityRetention)
+			if err != nil {
+				return err
+			}
+			closeTasks = append(closeTasks, task)
+		}
 	}
 
 	r.mutableState.AddTasks(closeTasks...)
@@ -250,6 +270,25 @@ func (r *TaskGeneratorImpl) getRetention() (time.Duration, error) {
 	return retention, nil
 }
 
+// getShorterVisibilityRetention returns the visibility retention of this task generator's workflow execution, and
+// true if the namespace sets one shorter than the retention period.
+func (r *TaskGeneratorImpl) getShorterVisibilityRetention() (time.Duration, bool, error) {
+	executionInfo := r.mutableState.GetExecutionInfo()
+	namespaceEntry, err := r.namespaceRegistry.GetNamespaceByID(namespace.ID(executionInfo.NamespaceId))
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NamespaceNotFound:
+		// namespace is not accessible, the visibility record is deleted with the history
+		return 0, false, nil
+	default:
+		return 0, false, err
+	}
+	if !namespaceEntry.HasSeparateVisibilityRetention() || namespaceEntry.VisibilityRetention() >= namespaceEntry.Retention() {
+		return 0, false, nil
+	}
+	return namespaceEntry.VisibilityRetention(), true, nil
+}
+
 // GenerateDeleteHistoryEventTask adds a task to delete all history events for a workflow execution.
 // This method only adds the task to the mutable state object in memory; it does not write the task to the database.
 // You must call shard.Context#AddTasks to notify the history engine of this task.
@@ -284,6 +323,20 @@ func (r *TaskGeneratorImpl) GenerateDeleteExecutionTask() (*tasks.DeleteExecutio
 	}, nil
 }
 
+func (r *TaskGeneratorImpl) GenerateDeleteVisibilityRecordTask(
+	closeTime time.Time,
+	visibilityRetention time.Duration,
+) (*tasks.DeleteVisibilityRecordTask, error) {
+	retentionJitterDuration := backoff.FullJitter(r.config.RetentionTimerJitterDuration())
+	return &tasks.DeleteVisibilityRecordTask{
+		// TaskID is set by shard
+		WorkflowKey:         r.mutableState.GetWorkflowKey(),
+		VisibilityTimestamp: closeTime.Add(visibilityRetention).Add(retentionJitterDuration),
+		Version:             r.mutableState.GetCurrentVersion(),
+		CloseTime:           &closeTime,
+	}, nil
+}
+
 func (r *TaskGeneratorImpl) GenerateDelayedWorkflowTasks(
 	startEvent *historypb.HistoryEvent,
 ) error {
@@ -567,6 +620,17 @@ func (r *TaskGeneratorImpl) GenerateUpsertVisibilityTask() error {
 	return nil
 }
 
+func (r *TaskGeneratorImpl) GenerateCloseVisibilityTask() error {
+	currentVersion := r.mutableState.GetCurrentVersion()
+
+	r.mutableState.AddTasks(&tasks.CloseExecutionVisibilityTask{
+		// TaskID, VisibilityTimestamp is set by shard
+		WorkflowKey: r.mutableState.GetWorkflowKey(),
+		Version:     currentVersion,
+	})
+	return nil
+}
+
 func (r *TaskGeneratorImpl) GenerateWorkflowResetTasks() error {
 
 	currentVersion := r.mutableState.GetCurrentVersion()
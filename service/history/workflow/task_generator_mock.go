@@ -116,6 +116,20 @@ func (mr *MockTaskGeneratorMockRecorder) GenerateChildWorkflowTasks(event interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateChildWorkflowTasks", reflect.TypeOf((*MockTaskGenerator)(nil).GenerateChildWorkflowTasks), event)
 }
 
+// GenerateCloseVisibilityTask mocks base method.
+func (m *MockTaskGenerator) GenerateCloseVisibilityTask() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateCloseVisibilityTask")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GenerateCloseVisibilityTask indicates an expected call of GenerateCloseVisibilityTask.
+func (mr *MockTaskGeneratorMockRecorder) GenerateCloseVisibilityTask() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCloseVisibilityTask", reflect.TypeOf((*MockTaskGenerator)(nil).GenerateCloseVisibilityTask))
+}
+
 // GenerateDelayedWorkflowTasks mocks base method.
 func (m *MockTaskGenerator) GenerateDelayedWorkflowTasks(startEvent *history.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -159,6 +173,21 @@ func (mr *MockTaskGeneratorMockRecorder) GenerateDeleteHistoryEventTask(closeTim
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateDeleteHistoryEventTask", reflect.TypeOf((*MockTaskGenerator)(nil).GenerateDeleteHistoryEventTask), closeTime, workflowDataAlreadyArchived)
 }
 
+// GenerateDeleteVisibilityRecordTask mocks base method.
+func (m *MockTaskGenerator) GenerateDeleteVisibilityRecordTask(closeTime time.Time, visibilityRetention time.Duration) (*tasks.DeleteVisibilityRecordTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateDeleteVisibilityRecordTask", closeTime, visibilityRetention)
+	ret0, _ := ret[0].(*tasks.DeleteVisibilityRecordTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateDeleteVisibilityRecordTask indicates an expected call of GenerateDeleteVisibilityRecordTask.
+func (mr *MockTaskGeneratorMockRecorder) GenerateDeleteVisibilityRecordTask(closeTime, visibilityRetention interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateDeleteVisibilityRecordTask", reflect.TypeOf((*MockTaskGenerator)(nil).GenerateDeleteVisibilityRecordTask), closeTime, visibilityRetention)
+}
+
 // GenerateHistoryReplicationTasks mocks base method.
 func (m *MockTaskGenerator) GenerateHistoryReplicationTasks(events []*history.HistoryEvent) error {
 	m.ctrl.T.Helper()
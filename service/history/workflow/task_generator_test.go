@@ -80,6 +80,7 @@ type testParams struct {
 	DeleteAfterClose                     bool
 	CloseEventTime                       time.Time
 	Retention                            time.Duration
+	VisibilityRetention                  time.Duration
 	Logger                               *log.MockLogger
 	ArchivalProcessorArchiveDelay        time.Duration
 	HistoryArchivalEnabledInCluster      bool
@@ -90,6 +91,7 @@ type testParams struct {
 	ExpectCloseExecutionVisibilityTask              bool
 	ExpectArchiveExecutionTask                      bool
 	ExpectDeleteHistoryEventTask                    bool
+	ExpectDeleteVisibilityRecordTask                bool
 	ExpectedArchiveExecutionTaskVisibilityTimestamp time.Time
 }
 
@@ -102,6 +104,36 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 				p.ExpectDeleteHistoryEventTask = true
 			},
 		},
+		{
+			Name: "shorter visibility retention",
+			ConfigFn: func(p *testParams) {
+				p.VisibilityRetention = 24 * time.Hour
+
+				p.ExpectCloseExecutionVisibilityTask = true
+				p.ExpectDeleteHistoryEventTask = true
+				p.ExpectDeleteVisibilityRecordTask = true
+			},
+		},
+		{
+			Name: "longer visibility retention",
+			ConfigFn: func(p *testParams) {
+				p.VisibilityRetention = 365 * 24 * time.Hour
+
+				p.ExpectCloseExecutionVisibilityTask = true
+				p.ExpectDeleteHistoryEventTask = true
+			},
+		},
+		{
+			Name: "shorter visibility retention with archival queue",
+			ConfigFn: func(p *testParams) {
+				p.DurableArchivalEnabled = true
+				p.VisibilityRetention = 24 * time.Hour
+
+				p.ExpectCloseExecutionVisibilityTask = true
+				p.ExpectArchiveExecutionTask = true
+				p.ExpectDeleteVisibilityRecordTask = true
+			},
+		},
 		{
 			Name: "use archival queue",
 			ConfigFn: func(p *testParams) {
@@ -232,6 +264,7 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 				Retention:             &p.Retention,
 				HistoryArchivalUri:    "test:///history/archival/",
 				VisibilityArchivalUri: "test:///visibility/archival",
+				VisibilityRetention:   &p.VisibilityRetention,
 			}
 			if p.HistoryArchivalEnabledInNamespace {
 				namespaceConfig.HistoryArchivalState = enums.ARCHIVAL_STATE_ENABLED
@@ -312,6 +345,7 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 				deleteHistoryEventTask       *tasks.DeleteHistoryEventTask
 				closeExecutionVisibilityTask *tasks.CloseExecutionVisibilityTask
 				archiveExecutionTask         *tasks.ArchiveExecutionTask
+				deleteVisibilityRecordTask   *tasks.DeleteVisibilityRecordTask
 			)
 			for _, task := range allTasks {
 				switch t := task.(type) {
@@ -323,6 +357,8 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 					closeExecutionVisibilityTask = t
 				case *tasks.ArchiveExecutionTask:
 					archiveExecutionTask = t
+				case *tasks.DeleteVisibilityRecordTask:
+					deleteVisibilityRecordTask = t
 				}
 			}
 			require.NotNil(t, closeExecutionTask)
@@ -359,6 +395,16 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 			} else {
 				assert.Nil(t, deleteHistoryEventTask)
 			}
+			if p.ExpectDeleteVisibilityRecordTask {
+				require.NotNil(t, deleteVisibilityRecordTask)
+				assert.Equal(t, deleteVisibilityRecordTask.WorkflowKey, mutableState.GetWorkflowKey())
+				assert.True(t, closeTime.Equal(*deleteVisibilityRecordTask.CloseTime))
+				assert.GreaterOrEqual(t, deleteVisibilityRecordTask.VisibilityTimestamp, closeTime.Add(p.VisibilityRetention))
+				assert.LessOrEqual(t, deleteVisibilityRecordTask.VisibilityTimestamp,
+					closeTime.Add(p.VisibilityRetention).Add(retentionTimerDelay*2))
+			} else {
+				assert.Nil(t, deleteVisibilityRecordTask)
+			}
 		})
 	}
 }
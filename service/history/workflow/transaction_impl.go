@@ -29,8 +29,10 @@ import (
 
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -48,6 +50,9 @@ type (
 		taskQueue      string
 		namespaceState string
 		status         enumspb.WorkflowExecutionStatus
+		executionInfo  *persistencespb.WorkflowExecutionInfo
+		// closeEvent is the event closing the workflow, nil if the transaction doesn't close it
+		closeEvent *historypb.HistoryEvent
 	}
 	TransactionImpl struct {
 		shard  shard.Context
@@ -404,6 +409,7 @@ func createWorkflowExecution(
 			snapshotToCompletionMetric(
 				namespaceState(shard.GetClusterMetadata(), &mutableStateFailoverVersion),
 				&request.NewWorkflowSnapshot,
+				request.NewWorkflowEvents,
 			),
 		)
 	}
@@ -448,14 +454,17 @@ func conflictResolveWorkflowExecution(
 			snapshotToCompletionMetric(
 				namespaceState(shard.GetClusterMetadata(), &resetWorkflowFailoverVersion),
 				&request.ResetWorkflowSnapshot,
+				request.ResetWorkflowEvents,
 			),
 			snapshotToCompletionMetric(
 				namespaceState(shard.GetClusterMetadata(), newWorkflowFailoverVersion),
 				request.NewWorkflowSnapshot,
+				request.NewWorkflowEvents,
 			),
 			mutationToCompletionMetric(
 				namespaceState(shard.GetClusterMetadata(), currentWorkflowFailoverVersion),
 				request.CurrentWorkflowMutation,
+				request.CurrentWorkflowEvents,
 			),
 		)
 	}
@@ -535,10 +544,12 @@ func updateWorkflowExecution(
 			mutationToCompletionMetric(
 				namespaceState(shard.GetClusterMetadata(), &updateWorkflowFailoverVersion),
 				&request.UpdateWorkflowMutation,
+				request.UpdateWorkflowEvents,
 			),
 			snapshotToCompletionMetric(
 				namespaceState(shard.GetClusterMetadata(), newWorkflowFailoverVersion),
 				request.NewWorkflowSnapshot,
+				request.NewWorkflowEvents,
 			),
 		)
 	}
@@ -683,10 +694,9 @@ func emitMutationMetrics(
 	metricsHandler := shard.GetMetricsHandler()
 	namespaceName := namespace.Name()
 	for _, stat := range stats {
-		emitMutableStateStatus(
-			metricsHandler.WithTags(metrics.OperationTag(metrics.SessionStatsScope), metrics.NamespaceTag(namespaceName.String())),
-			stat,
-		)
+		handler := metricsHandler.WithTags(metrics.OperationTag(metrics.SessionStatsScope), metrics.NamespaceTag(namespaceName.String()))
+		emitMutableStateStatus(handler, stat)
+		emitStorageBytesWritten(handler, stat)
 	}
 }
 
@@ -708,6 +718,7 @@ func emitGetMetrics(
 func snapshotToCompletionMetric(
 	namespaceState string,
 	workflowSnapshot *persistence.WorkflowSnapshot,
+	workflowEvents []*persistence.WorkflowEvents,
 ) completionMetric {
 	if workflowSnapshot == nil {
 		return completionMetric{initialized: false}
@@ -717,12 +728,15 @@ func snapshotToCompletionMetric(
 		taskQueue:      workflowSnapshot.ExecutionInfo.TaskQueue,
 		namespaceState: namespaceState,
 		status:         workflowSnapshot.ExecutionState.Status,
+		executionInfo:  workflowSnapshot.ExecutionInfo,
+		closeEvent:     findCloseEvent(workflowEvents),
 	}
 }
 
 func mutationToCompletionMetric(
 	namespaceState string,
 	workflowMutation *persistence.WorkflowMutation,
+	workflowEvents []*persistence.WorkflowEvents,
 ) completionMetric {
 	if workflowMutation == nil {
 		return completionMetric{initialized: false}
@@ -732,9 +746,31 @@ func mutationToCompletionMetric(
 		taskQueue:      workflowMutation.ExecutionInfo.TaskQueue,
 		namespaceState: namespaceState,
 		status:         workflowMutation.ExecutionState.Status,
+		executionInfo:  workflowMutation.ExecutionInfo,
+		closeEvent:     findCloseEvent(workflowEvents),
 	}
 }
 
+// findCloseEvent returns the event closing the workflow among the events of a transaction, nil if there is none.
+func findCloseEvent(
+	workflowEvents []*persistence.WorkflowEvents,
+) *historypb.HistoryEvent {
+	for _, events := range workflowEvents {
+		for _, event := range events.Events {
+			switch event.GetEventType() {
+			case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED,
+				enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED,
+				enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT,
+				enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED,
+				enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED,
+				enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+				return event
+			}
+		}
+	}
+	return nil
+}
+
 func emitCompletionMetrics(
 	shard shard.Context,
 	namespace *namespace.Namespace,
@@ -754,5 +790,14 @@ func emitCompletionMetrics(
 			completionMetric.taskQueue,
 			completionMetric.status,
 		)
+		// only the active cluster counts closures, so they aren't counted again by standby clusters
+		if completionMetric.closeEvent != nil && completionMetric.namespaceState == namespaceStateActive {
+			emitWorkflowClosureStats(
+				metricsHandler,
+				namespaceName,
+				completionMetric.executionInfo,
+				completionMetric.closeEvent,
+			)
+		}
 	}
 }
@@ -53,6 +53,7 @@ import (
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/common/taskfailure"
 	"go.temporal.io/server/internal/effect"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/configs"
@@ -268,7 +269,7 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskStarted(
 				taskQueue.GetKind(),
 			).Timer(metrics.TaskScheduleToStartLatency.GetMetricName()).Record(
 				workflowScheduleToStartLatency,
-				metrics.TaskQueueTypeTag(enumspb.TASK_QUEUE_TYPE_WORKFLOW),
+				metrics.WithExemplar(ctx, metrics.TaskQueueTypeTag(enumspb.TASK_QUEUE_TYPE_WORKFLOW))...,
 			)
 
 			resp, err = handler.createRecordWorkflowTaskStartedResponse(mutableState, workflowContext.GetUpdateRegistry(ctx), workflowTask, req.PollRequest.GetIdentity())
@@ -338,6 +339,13 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskFailed(
 				return nil, err
 			}
 
+			failureCause := taskfailure.FromWorkflowTaskFailedCause(request.GetCause(), nil)
+			handler.metricsHandler.Counter(metrics.FailedWorkflowTasksCounter.GetMetricName()).Record(
+				1,
+				metrics.OperationTag(metrics.HistoryRespondWorkflowTaskFailedScope),
+				metrics.NamespaceTag(mutableState.GetNamespaceEntry().Name().String()),
+				metrics.FailureCauseTag(failureCause.String()))
+
 			// TODO (alex-update): if it was speculative WT that failed, and there is nothing but pending updates,
 			//  new WT also should be create as speculative (or not?). Currently, it will be recreated as normal WT.
 			return &api.UpdateWorkflowAction{
@@ -561,9 +569,12 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskCompleted(
 	wtFailedShouldCreateNewTask := false
 	if wtFailedCause != nil {
 		effects.Cancel(ctx)
+		failureCause := taskfailure.FromWorkflowTaskFailedCause(wtFailedCause.failedCause, wtFailedCause.causeErr)
 		handler.metricsHandler.Counter(metrics.FailedWorkflowTasksCounter.GetMetricName()).Record(
 			1,
-			metrics.OperationTag(metrics.HistoryRespondWorkflowTaskCompletedScope))
+			metrics.OperationTag(metrics.HistoryRespondWorkflowTaskCompletedScope),
+			metrics.NamespaceTag(namespaceEntry.Name().String()),
+			metrics.FailureCauseTag(failureCause.String()))
 		handler.logger.Info("Failing the workflow task.",
 			tag.Value(wtFailedCause.Message()),
 			tag.WorkflowID(token.GetWorkflowId()),
@@ -25,7 +25,9 @@
 package matching
 
 import (
+	"math"
 	"sync"
+	"time"
 
 	"go.uber.org/atomic"
 	"golang.org/x/exp/maps"
@@ -38,19 +40,26 @@ import (
 // Used to convert out of order acks into ackLevel movement.
 type ackManager struct {
 	sync.RWMutex
-	outstandingTasks map[int64]bool // key->TaskID, value->(true for acked, false->for non acked)
-	readLevel        int64          // Maximum TaskID inserted into outstandingTasks
-	ackLevel         int64          // Maximum TaskID below which all tasks are acked
+	outstandingTasks map[int64]bool      // key->TaskID, value->(true for acked, false->for non acked)
+	createTimes      map[int64]time.Time // key->TaskID of non acked tasks, value->task creation time
+	readLevel        int64               // Maximum TaskID inserted into outstandingTasks
+	ackLevel         int64               // Maximum TaskID below which all tasks are acked
 	backlogCounter   atomic.Int64
 	logger           log.Logger
 }
 
 func newAckManager(logger log.Logger) ackManager {
-	return ackManager{logger: logger, outstandingTasks: make(map[int64]bool), readLevel: -1, ackLevel: -1}
+	return ackManager{
+		logger:           logger,
+		outstandingTasks: make(map[int64]bool),
+		createTimes:      make(map[int64]time.Time),
+		readLevel:        -1,
+		ackLevel:         -1,
+	}
 }
 
 // Registers task as in-flight and moves read level to it. Tasks can be added in increasing order of taskID only.
-func (m *ackManager) addTask(taskID int64) {
+func (m *ackManager) addTask(taskID int64, createTime time.Time) {
 	m.Lock()
 	defer m.Unlock()
 	if m.readLevel >= taskID {
@@ -63,6 +72,7 @@ func (m *ackManager) addTask(taskID int64) {
 		m.logger.Fatal("Already present in outstanding tasks", tag.TaskID(taskID))
 	}
 	m.outstandingTasks[taskID] = false // true is for acked
+	m.createTimes[taskID] = createTime
 	m.backlogCounter.Inc()
 }
 
@@ -117,6 +127,7 @@ func (m *ackManager) completeTask(taskID int64) (ackLevel int64) {
 	defer m.Unlock()
 	if completed, ok := m.outstandingTasks[taskID]; ok && !completed {
 		m.outstandingTasks[taskID] = true
+		delete(m.createTimes, taskID)
 		m.backlogCounter.Dec()
 	}
 
@@ -135,9 +146,29 @@ func (m *ackManager) completeTask(taskID int64) (ackLevel int64) {
 			return m.ackLevel
 		}
 	}
+	// Every task up to the read level has been read and acked, whatever is between the last acked task and the
+	// read level is a gap, e.g. expired tasks or rows already deleted by their TTL.
+	if m.readLevel > m.ackLevel {
+		m.ackLevel = m.readLevel
+	}
 	return m.ackLevel
 }
 
 func (m *ackManager) getBacklogCountHint() int64 {
 	return m.backlogCounter.Load()
 }
+
+// Returns the creation time of the oldest non acked task, zero if there is none or it isn't known.
+func (m *ackManager) getOldestTaskCreateTime() time.Time {
+	m.RLock()
+	defer m.RUnlock()
+	oldestTaskID := int64(math.MaxInt64)
+	var oldestCreateTime time.Time
+	for taskID, createTime := range m.createTimes {
+		if taskID < oldestTaskID {
+			oldestTaskID = taskID
+			oldestCreateTime = createTime
+		}
+	}
+	return oldestCreateTime
+}
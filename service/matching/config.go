@@ -25,6 +25,7 @@
 package matching
 
 import (
+	"sync/atomic"
 	"time"
 
 	"go.temporal.io/server/common/dynamicconfig"
@@ -44,6 +45,7 @@ type (
 		EnablePersistencePriorityRateLimiting dynamicconfig.BoolPropertyFn
 		PersistenceDynamicRateLimitingParams  dynamicconfig.MapPropertyFn
 		SyncMatchWaitDuration                 dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+		PriorityTaskSyncMatchWaitDuration     dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
 		TestDisableSyncMatch                  dynamicconfig.BoolPropertyFn
 		RPS                                   dynamicconfig.IntPropertyFn
 		ShutdownDrainDuration                 dynamicconfig.DurationPropertyFn
@@ -77,7 +79,7 @@ type (
 		ThrottledLogRPS dynamicconfig.IntPropertyFn
 
 		AdminNamespaceToPartitionDispatchRate          dynamicconfig.FloatPropertyFnWithNamespaceFilter
-		AdminNamespaceTaskqueueToPartitionDispatchRate dynamicconfig.FloatPropertyFnWithTaskQueueInfoFilters
+		AdminNamespaceTaskqueueToPartitionDispatchRate dynamicconfig.FloatPropertyFnWithBuildIDFilters
 
 		VisibilityPersistenceMaxReadQPS   dynamicconfig.IntPropertyFn
 		VisibilityPersistenceMaxWriteQPS  dynamicconfig.IntPropertyFn
@@ -86,6 +88,9 @@ type (
 		VisibilityEnableManualPagination  dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 		LoadUserData dynamicconfig.BoolPropertyFnWithTaskQueueInfoFilters
+
+		// SubscribeDynamicConfig calls a callback when the values of dynamic config keys change
+		SubscribeDynamicConfig func(callback func(), keys ...dynamicconfig.Key) (cancel func())
 	}
 
 	forwarderConfig struct {
@@ -99,6 +104,8 @@ type (
 		forwarderConfig
 		SyncMatchWaitDuration func() time.Duration
 		TestDisableSyncMatch  func() bool
+		// Time a priority workflow task waits for a poller while there is a backlog
+		PriorityTaskSyncMatchWaitDuration func() time.Duration
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval func() time.Duration
 		RangeSize                  int64
@@ -119,13 +126,19 @@ type (
 
 		// partition qps = AdminNamespaceToPartitionDispatchRate(namespace)
 		AdminNamespaceToPartitionDispatchRate func() float64
-		// partition qps = AdminNamespaceTaskQueueToPartitionDispatchRate(namespace, task_queue)
+		// partition qps = AdminNamespaceTaskQueueToPartitionDispatchRate(namespace, task_queue, build_id)
 		AdminNamespaceTaskQueueToPartitionDispatchRate func() float64
+		// build ID of the most recent poller, used to resolve build ID constraints for task queues that manage a
+		// specific version set
+		pollerBuildID atomic.Value
 
 		// If set to false, matching does not load user data from DB for root partitions or fetch it via RPC from the
 		// root. When disbled, features that rely on user data (e.g. worker versioning) will essentially be disabled.
 		// See the documentation for constants.MatchingLoadUserData for the implications on versioning.
 		LoadUserData func() bool
+
+		// subscribeDispatchRates calls a callback when the dispatch rates set through dynamic config change
+		subscribeDispatchRates func(callback func()) (cancel func())
 	}
 )
 
@@ -156,8 +169,10 @@ func NewConfig(
 		EnablePersistencePriorityRateLimiting: dc.GetBoolProperty(dynamicconfig.MatchingEnablePersistencePriorityRateLimiting, true),
 		PersistenceDynamicRateLimitingParams:  dc.GetMapProperty(dynamicconfig.MatchingPersistenceDynamicRateLimitingParams, dynamicconfig.DefaultDynamicRateLimitingParams),
 		SyncMatchWaitDuration:                 dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingSyncMatchWaitDuration, 200*time.Millisecond),
+		PriorityTaskSyncMatchWaitDuration:     dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPriorityTaskSyncMatchWaitDuration, 100*time.Millisecond),
 		TestDisableSyncMatch:                  dc.GetBoolProperty(dynamicconfig.TestMatchingDisableSyncMatch, false),
 		LoadUserData:                          dc.GetBoolPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingLoadUserData, true),
+		SubscribeDynamicConfig:                dc.Subscribe,
 		RPS:                                   dc.GetIntProperty(dynamicconfig.MatchingRPS, 1200),
 		RangeSize:                             100000,
 		GetTasksBatchSize:                     dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingGetTasksBatchSize, 1000),
@@ -182,7 +197,7 @@ func NewConfig(
 		GetUserDataLongPollTimeout:            dc.GetDurationProperty(dynamicconfig.MatchingGetUserDataLongPollTimeout, 5*time.Minute),
 
 		AdminNamespaceToPartitionDispatchRate:          dc.GetFloatPropertyFilteredByNamespace(dynamicconfig.AdminMatchingNamespaceToPartitionDispatchRate, 10000),
-		AdminNamespaceTaskqueueToPartitionDispatchRate: dc.GetFloatPropertyFilteredByTaskQueueInfo(dynamicconfig.AdminMatchingNamespaceTaskqueueToPartitionDispatchRate, 1000),
+		AdminNamespaceTaskqueueToPartitionDispatchRate: dc.GetFloatPropertyFilteredByBuildID(dynamicconfig.AdminMatchingNamespaceTaskqueueToPartitionDispatchRate, 1000),
 
 		VisibilityPersistenceMaxReadQPS:   visibility.GetVisibilityPersistenceMaxReadQPS(dc, enableReadFromES),
 		VisibilityPersistenceMaxWriteQPS:  visibility.GetVisibilityPersistenceMaxWriteQPS(dc, enableReadFromES),
@@ -196,7 +211,7 @@ func newTaskQueueConfig(id *taskQueueID, config *Config, namespace namespace.Nam
 	taskQueueName := id.BaseNameString()
 	taskType := id.taskType
 
-	return &taskQueueConfig{
+	tqConfig := &taskQueueConfig{
 		RangeSize: config.RangeSize,
 		GetTasksBatchSize: func() int {
 			return config.GetTasksBatchSize(namespace.String(), taskQueueName, taskType)
@@ -213,6 +228,9 @@ func newTaskQueueConfig(id *taskQueueID, config *Config, namespace namespace.Nam
 		SyncMatchWaitDuration: func() time.Duration {
 			return config.SyncMatchWaitDuration(namespace.String(), taskQueueName, taskType)
 		},
+		PriorityTaskSyncMatchWaitDuration: func() time.Duration {
+			return config.PriorityTaskSyncMatchWaitDuration(namespace.String(), taskQueueName, taskType)
+		},
 		TestDisableSyncMatch: config.TestDisableSyncMatch,
 		LoadUserData: func() bool {
 			return config.LoadUserData(namespace.String(), taskQueueName, taskType)
@@ -240,9 +258,6 @@ func newTaskQueueConfig(id *taskQueueID, config *Config, namespace namespace.Nam
 		AdminNamespaceToPartitionDispatchRate: func() float64 {
 			return config.AdminNamespaceToPartitionDispatchRate(namespace.String())
 		},
-		AdminNamespaceTaskQueueToPartitionDispatchRate: func() float64 {
-			return config.AdminNamespaceTaskqueueToPartitionDispatchRate(namespace.String(), taskQueueName, taskType)
-		},
 		forwarderConfig: forwarderConfig{
 			ForwarderMaxOutstandingPolls: func() int {
 				return config.ForwarderMaxOutstandingPolls(namespace.String(), taskQueueName, taskType)
@@ -258,4 +273,26 @@ func newTaskQueueConfig(id *taskQueueID, config *Config, namespace namespace.Nam
 			},
 		},
 	}
+	tqConfig.subscribeDispatchRates = func(callback func()) func() {
+		return config.SubscribeDynamicConfig(
+			callback,
+			dynamicconfig.AdminMatchingNamespaceToPartitionDispatchRate,
+			dynamicconfig.AdminMatchingNamespaceTaskqueueToPartitionDispatchRate,
+		)
+	}
+	tqConfig.AdminNamespaceTaskQueueToPartitionDispatchRate = func() float64 {
+		return config.AdminNamespaceTaskqueueToPartitionDispatchRate(namespace.String(), taskQueueName, taskType, tqConfig.getPollerBuildID())
+	}
+	return tqConfig
+}
+
+// setPollerBuildID records the build ID reported by the most recent poller. Last poller wins if different pollers
+// report different build IDs.
+func (c *taskQueueConfig) setPollerBuildID(buildID string) {
+	c.pollerBuildID.Store(buildID)
+}
+
+func (c *taskQueueConfig) getPollerBuildID() string {
+	buildID, _ := c.pollerBuildID.Load().(string)
+	return buildID
 }
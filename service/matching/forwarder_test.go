@@ -97,6 +97,7 @@ func (t *ForwarderTestSuite) TestForwardWorkflowTask() {
 
 	taskInfo := randomTaskInfo()
 	task := newInternalTask(taskInfo, nil, enumsspb.TASK_SOURCE_HISTORY, "", false)
+	task.priority = true
 	t.NoError(t.fwdr.ForwardTask(context.Background(), task))
 	t.NotNil(request)
 	t.Equal(mustParent(t.taskQueue.Name, 20).FullName(), request.TaskQueue.GetName())
@@ -105,6 +106,7 @@ func (t *ForwarderTestSuite) TestForwardWorkflowTask() {
 	t.Equal(taskInfo.Data.GetWorkflowId(), request.GetExecution().GetWorkflowId())
 	t.Equal(taskInfo.Data.GetRunId(), request.GetExecution().GetRunId())
 	t.Equal(taskInfo.Data.GetScheduledEventId(), request.GetScheduledEventId())
+	t.True(request.GetPriority())
 
 	schedToStart := int32(request.GetScheduleToStartTimeout().Seconds())
 	rewritten := convert.Int32Ceil(time.Until(*taskInfo.Data.ExpiryTime).Seconds())
@@ -33,12 +33,14 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/drain"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	persistenceEncryption "go.temporal.io/server/common/persistence/encryption"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
@@ -59,6 +61,7 @@ var Module = fx.Options(
 	fx.Provide(ThrottledLoggerRpsFnProvider),
 	fx.Provide(RetryableInterceptorProvider),
 	fx.Provide(TelemetryInterceptorProvider),
+	fx.Provide(SlowRequestLogInterceptorProvider),
 	fx.Provide(RateLimitInterceptorProvider),
 	fx.Provide(VisibilityManagerProvider),
 	fx.Provide(HandlerProvider),
@@ -67,6 +70,8 @@ var Module = fx.Options(
 	fx.Provide(ServiceResolverProvider),
 	fx.Provide(NewService),
 	fx.Invoke(ServiceLifetimeHooks),
+	fx.Provide(DrainerProvider),
+	fx.Invoke(resource.DrainWatcherLifetimeHooks),
 )
 
 func ConfigProvider(
@@ -96,6 +101,21 @@ func TelemetryInterceptorProvider(
 		namespaceRegistry,
 		metricsHandler,
 		logger,
+		nil, // actions are only emitted by frontend
+	)
+}
+
+// SlowRequestLogInterceptorProvider provides a disabled interceptor, slow request logging is only
+// configurable for frontend and history.
+func SlowRequestLogInterceptorProvider(
+	logger log.Logger,
+) *interceptor.SlowRequestLogInterceptor {
+	return interceptor.NewSlowRequestLogInterceptor(
+		dynamicconfig.GetMapPropertyFn(nil),
+		dynamicconfig.GetFloatPropertyFn(0),
+		dynamicconfig.GetIntPropertyFn(0),
+		nil,
+		logger,
 	)
 }
 
@@ -154,7 +174,9 @@ func VisibilityManagerProvider(
 	esClient esclient.Client,
 	persistenceServiceResolver resolver.ServiceResolver,
 	searchAttributesMapperProvider searchattribute.MapperProvider,
+	persistenceKeyProvider persistenceEncryption.KeyProvider,
 	saProvider searchattribute.Provider,
+	circuitBreakers *persistence.CircuitBreakers,
 ) (manager.VisibilityManager, error) {
 	return visibility.NewManager(
 		*persistenceConfig,
@@ -163,12 +185,15 @@ func VisibilityManagerProvider(
 		nil, // matching visibility never writes
 		saProvider,
 		searchAttributesMapperProvider,
+		persistenceKeyProvider,
 		serviceConfig.VisibilityPersistenceMaxReadQPS,
 		serviceConfig.VisibilityPersistenceMaxWriteQPS,
 		serviceConfig.EnableReadFromSecondaryVisibility,
 		dynamicconfig.GetStringPropertyFn(visibility.SecondaryVisibilityWritingModeOff), // matching visibility never writes
 		serviceConfig.VisibilityDisableOrderByClause,
 		serviceConfig.VisibilityEnableManualPagination,
+		circuitBreakers,
+		nil, // matching visibility never writes
 		metricsHandler,
 		logger,
 	)
@@ -204,6 +229,11 @@ func HandlerProvider(
 	)
 }
 
+// DrainerProvider drains the host when the drain document of the system namespace targets it
+func DrainerProvider(svc *Service) drain.Drainer {
+	return svc
+}
+
 func ServiceLifetimeHooks(
 	lc fx.Lifecycle,
 	svcStoppedCh chan struct{},
@@ -50,6 +50,10 @@ type TaskMatcher struct {
 	// are interested in queryTasks but not others. Example is when namespace is
 	// not active in a cluster
 	queryTaskC chan *internalTask
+	// synchronous task channel for priority tasks. Pollers pick up tasks from
+	// this channel before taskC, so that priority tasks are not stuck behind
+	// backlog tasks dispatched by taskReader
+	priorityTaskC chan *internalTask
 
 	// dynamicRate is the dynamic rate & burst for rate limiter
 	dynamicRateBurst quotas.MutableRateBurst
@@ -59,10 +63,13 @@ type TaskMatcher struct {
 	forceRefreshRateOnce sync.Once
 	// rateLimiter that limits the rate at which tasks can be dispatched to consumers
 	rateLimiter quotas.RateLimiter
+	// adminRateLimiters are the rate limiters of rateLimiter set through dynamic config
+	adminRateLimiters []*quotas.DynamicRateLimiterImpl
 
-	fwdr           *Forwarder
-	metricsHandler metrics.Handler // namespace metric scope
-	numPartitions  func() int      // number of task queue partitions
+	fwdr             *Forwarder
+	backlogCountHint func() int64    // number of tasks in the backlog of the task queue
+	metricsHandler   metrics.Handler // namespace metric scope
+	numPartitions    func() int      // number of task queue partitions
 }
 
 const (
@@ -78,7 +85,7 @@ var (
 // newTaskMatcher returns an task matcher instance. The returned instance can be
 // used by task producers and consumers to find a match. Both sync matches and non-sync
 // matches should use this implementation
-func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, metricsHandler metrics.Handler) *TaskMatcher {
+func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, backlogCountHint func() int64, metricsHandler metrics.Handler) *TaskMatcher {
 	dynamicRateBurst := quotas.NewMutableRateBurst(
 		defaultTaskDispatchRPS,
 		int(defaultTaskDispatchRPS),
@@ -87,24 +94,31 @@ func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, metricsHandler met
 		dynamicRateBurst,
 		defaultTaskDispatchRPSTTL,
 	)
-	limiter := quotas.NewMultiRateLimiter([]quotas.RateLimiter{
-		dynamicRateLimiter,
+	adminRateLimiters := []*quotas.DynamicRateLimiterImpl{
 		quotas.NewDefaultOutgoingRateLimiter(
 			config.AdminNamespaceTaskQueueToPartitionDispatchRate,
 		),
 		quotas.NewDefaultOutgoingRateLimiter(
 			config.AdminNamespaceToPartitionDispatchRate,
 		),
+	}
+	limiter := quotas.NewMultiRateLimiter([]quotas.RateLimiter{
+		dynamicRateLimiter,
+		adminRateLimiters[0],
+		adminRateLimiters[1],
 	})
 	return &TaskMatcher{
 		config:             config,
 		dynamicRateBurst:   dynamicRateBurst,
 		dynamicRateLimiter: dynamicRateLimiter,
 		rateLimiter:        limiter,
+		adminRateLimiters:  adminRateLimiters,
 		metricsHandler:     metricsHandler,
 		fwdr:               fwdr,
+		backlogCountHint:   backlogCountHint,
 		taskC:              make(chan *internalTask),
 		queryTaskC:         make(chan *internalTask),
+		priorityTaskC:      make(chan *internalTask),
 		numPartitions:      config.NumReadPartitions,
 	}
 }
@@ -146,6 +160,13 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *internalTask) (bool, err
 		}
 	}
 
+	// Priority tasks only need their own lane when pollers are busy with backlog tasks.
+	if task.priority && tm.backlogCountHint() > 0 {
+		if matched, err := tm.offerPriority(ctx, task); matched {
+			return true, err
+		}
+	}
+
 	select {
 	case tm.taskC <- task: // poller picked up the task
 		if task.responseC != nil {
@@ -180,6 +201,28 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *internalTask) (bool, err
 	}
 }
 
+// offerPriority blocks up to PriorityTaskSyncMatchWaitDuration trying to match
+// the task with a poller through the priority channel
+func (tm *TaskMatcher) offerPriority(ctx context.Context, task *internalTask) (bool, error) {
+	wait := tm.config.PriorityTaskSyncMatchWaitDuration()
+	if wait <= 0 {
+		return false, nil
+	}
+	childCtx, cancel := context.WithTimeout(ctx, wait)
+	defer cancel()
+
+	select {
+	case tm.priorityTaskC <- task: // poller picked up the task
+		tm.metricsHandler.Counter(metrics.PrioritySyncMatchPerTaskQueueCounter.GetMetricName()).Record(1)
+		if task.responseC != nil {
+			return true, <-task.responseC
+		}
+		return true, nil
+	case <-childCtx.Done():
+		return false, nil
+	}
+}
+
 func (tm *TaskMatcher) offerOrTimeout(ctx context.Context, task *internalTask) (bool, error) {
 	select {
 	case tm.taskC <- task: // poller picked up the task
@@ -338,24 +381,34 @@ func (tm *TaskMatcher) UpdateRatelimit(rps *float64) {
 	})
 }
 
+// refreshDispatchRates applies the dispatch rates set through dynamic config immediately, instead
+// of at the next periodic refresh of the rate limiters
+func (tm *TaskMatcher) refreshDispatchRates() {
+	for _, rateLimiter := range tm.adminRateLimiters {
+		rateLimiter.Refresh()
+	}
+}
+
 // Rate returns the current rate at which tasks are dispatched
 func (tm *TaskMatcher) Rate() float64 {
 	return tm.rateLimiter.Rate()
 }
 
 func (tm *TaskMatcher) poll(ctx context.Context, pollMetadata *pollMetadata, queryOnly bool) (*internalTask, error) {
-	taskC, queryTaskC := tm.taskC, tm.queryTaskC
+	taskC, queryTaskC, priorityTaskC := tm.taskC, tm.queryTaskC, tm.priorityTaskC
 	if queryOnly {
 		taskC = nil
+		priorityTaskC = nil
 	}
 
 	// We want to effectively do a prioritized select, but Go select is random
 	// if multiple cases are ready, so split into multiple selects.
 	// The priority order is:
 	// 1. ctx.Done
-	// 2. taskC and queryTaskC
-	// 3. forwarding
-	// 4. block looking locally for remainder of context lifetime
+	// 2. priorityTaskC
+	// 3. taskC and queryTaskC
+	// 4. forwarding
+	// 5. block looking locally for remainder of context lifetime
 	// To correctly handle priorities and allow any case to succeed, all select
 	// statements except for the last one must be non-blocking, and the last one
 	// must include all the previous cases.
@@ -368,7 +421,14 @@ func (tm *TaskMatcher) poll(ctx context.Context, pollMetadata *pollMetadata, que
 	default:
 	}
 
-	// 2. taskC and queryTaskC
+	// 2. priorityTaskC
+	select {
+	case task := <-priorityTaskC:
+		return tm.pollSucceeded(task), nil
+	default:
+	}
+
+	// 3. taskC and queryTaskC
 	select {
 	case task := <-taskC:
 		if task.responseC != nil {
@@ -383,11 +443,13 @@ func (tm *TaskMatcher) poll(ctx context.Context, pollMetadata *pollMetadata, que
 	default:
 	}
 
-	// 3. forwarding (and all other clauses repeated again)
+	// 4. forwarding (and all other clauses repeated again)
 	select {
 	case <-ctx.Done():
 		tm.metricsHandler.Counter(metrics.PollTimeoutPerTaskQueueCounter.GetMetricName()).Record(1)
 		return nil, ErrNoTasks
+	case task := <-priorityTaskC:
+		return tm.pollSucceeded(task), nil
 	case task := <-taskC:
 		if task.responseC != nil {
 			tm.metricsHandler.Counter(metrics.PollSuccessWithSyncPerTaskQueueCounter.GetMetricName()).Record(1)
@@ -406,11 +468,13 @@ func (tm *TaskMatcher) poll(ctx context.Context, pollMetadata *pollMetadata, que
 		token.release()
 	}
 
-	// 4. blocking local poll
+	// 5. blocking local poll
 	select {
 	case <-ctx.Done():
 		tm.metricsHandler.Counter(metrics.PollTimeoutPerTaskQueueCounter.GetMetricName()).Record(1)
 		return nil, ErrNoTasks
+	case task := <-priorityTaskC:
+		return tm.pollSucceeded(task), nil
 	case task := <-taskC:
 		if task.responseC != nil {
 			tm.metricsHandler.Counter(metrics.PollSuccessWithSyncPerTaskQueueCounter.GetMetricName()).Record(1)
@@ -424,6 +488,14 @@ func (tm *TaskMatcher) poll(ctx context.Context, pollMetadata *pollMetadata, que
 	}
 }
 
+func (tm *TaskMatcher) pollSucceeded(task *internalTask) *internalTask {
+	if task.responseC != nil {
+		tm.metricsHandler.Counter(metrics.PollSuccessWithSyncPerTaskQueueCounter.GetMetricName()).Record(1)
+	}
+	tm.metricsHandler.Counter(metrics.PollSuccessPerTaskQueueCounter.GetMetricName()).Record(1)
+	return task
+}
+
 func (tm *TaskMatcher) fwdrPollReqTokenC() <-chan *ForwarderReqToken {
 	if tm.fwdr == nil {
 		return nil
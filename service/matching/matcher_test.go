@@ -44,6 +44,7 @@ import (
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payloads"
@@ -60,6 +61,7 @@ type MatcherTestSuite struct {
 	taskQueue   *taskQueueID
 	matcher     *TaskMatcher // matcher for child partition
 	rootMatcher *TaskMatcher // matcher for parent partition
+	backlog     atomic.Int64 // backlog count hint of the matchers
 }
 
 func TestMatcherSuite(t *testing.T) {
@@ -68,6 +70,7 @@ func TestMatcherSuite(t *testing.T) {
 
 func (t *MatcherTestSuite) SetupTest() {
 	t.controller = gomock.NewController(t.T())
+	t.backlog.Store(0)
 	t.client = matchingservicemock.NewMockMatchingServiceClient(t.controller)
 	cfg := NewConfig(dynamicconfig.NewNoopCollection(), false, false)
 
@@ -82,17 +85,21 @@ func (t *MatcherTestSuite) SetupTest() {
 	}
 	t.cfg = tlCfg
 	t.fwdr = newForwarder(&t.cfg.forwarderConfig, t.taskQueue, enumspb.TASK_QUEUE_KIND_NORMAL, t.client)
-	t.matcher = newTaskMatcher(tlCfg, t.fwdr, metrics.NoopMetricsHandler)
+	t.matcher = newTaskMatcher(tlCfg, t.fwdr, t.backlogCountHint, metrics.NoopMetricsHandler)
 
 	rootTaskQueue := newTestTaskQueueID(t.taskQueue.namespaceID, mustParent(t.taskQueue.Name, 20).FullName(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
 	rootTaskqueueCfg := newTaskQueueConfig(rootTaskQueue, cfg, "test-namespace")
-	t.rootMatcher = newTaskMatcher(rootTaskqueueCfg, nil, metrics.NoopMetricsHandler)
+	t.rootMatcher = newTaskMatcher(rootTaskqueueCfg, nil, t.backlogCountHint, metrics.NoopMetricsHandler)
 }
 
 func (t *MatcherTestSuite) TearDownTest() {
 	t.controller.Finish()
 }
 
+func (t *MatcherTestSuite) backlogCountHint() int64 {
+	return t.backlog.Load()
+}
+
 func (t *MatcherTestSuite) TestLocalSyncMatch() {
 	// force disable remote forwarding
 	<-t.fwdr.AddReqTokenC()
@@ -120,6 +127,57 @@ func (t *MatcherTestSuite) TestLocalSyncMatch() {
 	t.True(syncMatch)
 }
 
+func (t *MatcherTestSuite) TestDispatchRateChangesApplyImmediately() {
+	dcClient := dynamicconfig.NewOverrideClient(dynamicconfig.NewNoopClient(), log.NewNoopLogger())
+	cfg := NewConfig(dynamicconfig.NewCollection(dcClient, log.NewNoopLogger()), false, false)
+	tlCfg := newTaskQueueConfig(t.taskQueue, cfg, "test-namespace")
+	matcher := newTaskMatcher(tlCfg, nil, t.backlogCountHint, metrics.NoopMetricsHandler)
+	cancel := tlCfg.subscribeDispatchRates(matcher.refreshDispatchRates)
+	defer cancel()
+
+	t.NoError(dcClient.SetOverrides(dynamicconfig.AdminMatchingNamespaceTaskqueueToPartitionDispatchRate + ":\n- value: 5\n"))
+	t.Equal(5.0, matcher.Rate())
+
+	cancel()
+	t.NoError(dcClient.SetOverrides(dynamicconfig.AdminMatchingNamespaceTaskqueueToPartitionDispatchRate + ":\n- value: 8\n"))
+	t.Equal(5.0, matcher.Rate())
+}
+
+func (t *MatcherTestSuite) TestPrioritySyncMatchBeforeBacklog() {
+	// force disable remote forwarding
+	<-t.fwdr.AddReqTokenC()
+	<-t.fwdr.PollReqTokenC()
+	t.backlog.Store(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	backlogTask := newInternalTask(randomTaskInfo(), nil, enumsspb.TASK_SOURCE_DB_BACKLOG, "", false)
+	go func() {
+		_ = t.matcher.MustOffer(ctx, backlogTask, nil)
+	}()
+
+	priorityTask := newInternalTask(randomTaskInfo(), nil, enumsspb.TASK_SOURCE_HISTORY, "", true)
+	priorityTask.priority = true
+	syncMatchC := make(chan bool, 1)
+	go func() {
+		syncMatch, err := t.matcher.Offer(ctx, priorityTask)
+		t.NoError(err)
+		syncMatchC <- syncMatch
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	task, err := t.matcher.Poll(ctx, &pollMetadata{})
+	t.NoError(err)
+	t.Equal(priorityTask, task)
+	task.finish(nil)
+	t.True(<-syncMatchC)
+
+	task, err = t.matcher.Poll(ctx, &pollMetadata{})
+	t.NoError(err)
+	t.Equal(backlogTask, task)
+}
+
 func (t *MatcherTestSuite) TestRemoteSyncMatch() {
 	t.testRemoteSyncMatch(enumsspb.TASK_SOURCE_HISTORY)
 }
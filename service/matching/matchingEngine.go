@@ -52,6 +52,7 @@ import (
 	"go.temporal.io/server/common/clock"
 	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
@@ -369,6 +370,7 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 		taskInfo:      taskInfo,
 		source:        addRequest.GetSource(),
 		forwardedFrom: addRequest.GetForwardedSource(),
+		priority:      addRequest.GetPriority(),
 	})
 }
 
@@ -548,7 +550,12 @@ pollLoop:
 			return e.createPollWorkflowTaskQueueResponse(task, resp, opMetrics), nil
 		}
 
-		resp, err := e.recordWorkflowTaskStarted(ctx, request, task)
+		dispatchCtx, span := task.startDispatchSpan(ctx, e.timeSource.Now())
+		resp, err := e.recordWorkflowTaskStarted(dispatchCtx, request, task)
+		if err != nil {
+			span.RecordError(err)
+		}
+		span.End()
 		if err != nil {
 			switch err.(type) {
 			case *serviceerror.NotFound: // mutable state not found, workflow not running or workflow task not found
@@ -635,7 +642,12 @@ pollLoop:
 			return task.pollActivityTaskQueueResponse(), nil
 		}
 
-		resp, err := e.recordActivityTaskStarted(ctx, request, task)
+		dispatchCtx, span := task.startDispatchSpan(ctx, e.timeSource.Now())
+		resp, err := e.recordActivityTaskStarted(dispatchCtx, request, task)
+		if err != nil {
+			span.RecordError(err)
+		}
+		span.End()
 		if err != nil {
 			switch err.(type) {
 			case *serviceerror.NotFound: // mutable state not found, workflow not running or activity info not found
@@ -787,10 +799,14 @@ func (e *matchingEngineImpl) DescribeTaskQueue(
 	if err != nil {
 		return nil, err
 	}
-	tlMgr, err := e.getTaskQueueManager(ctx, taskQueue, stickyInfo, true)
+	loadedOnly := headers.GetValues(ctx, headers.LoadedTaskQueueOnlyHeaderName)[0] == "true"
+	tlMgr, err := e.getTaskQueueManager(ctx, taskQueue, stickyInfo, !loadedOnly)
 	if err != nil {
 		return nil, err
 	}
+	if tlMgr == nil {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("task queue %s is not loaded", taskQueueName))
+	}
 
 	return tlMgr.DescribeTaskQueue(request.DescRequest.GetIncludeTaskQueueStatus()), nil
 }
@@ -972,10 +988,14 @@ func (e *matchingEngineImpl) GetTaskQueueUserData(
 	if err != nil {
 		return nil, err
 	}
-	tqMgr, err := e.getTaskQueueManager(ctx, taskQueue, normalStickyInfo, true)
+	loadedOnly := headers.GetValues(ctx, headers.LoadedTaskQueueOnlyHeaderName)[0] == "true"
+	tqMgr, err := e.getTaskQueueManager(ctx, taskQueue, normalStickyInfo, !loadedOnly)
 	if err != nil {
 		return nil, err
 	}
+	if tqMgr == nil {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("task queue %s is not loaded", req.GetTaskQueue()))
+	}
 	version := req.GetLastKnownUserDataVersion()
 	if version < 0 {
 		return nil, serviceerror.NewInvalidArgument("last_known_user_data_version must not be negative")
@@ -1262,6 +1282,14 @@ func (e *matchingEngineImpl) unloadTaskQueue(unloadTQM taskQueueManager) {
 	foundTQM.Stop()
 }
 
+func (e *matchingEngineImpl) UnloadAllTaskQueues() int {
+	tqms := e.getTaskQueues(math.MaxInt32)
+	for _, tqm := range tqms {
+		e.unloadTaskQueue(tqm)
+	}
+	return len(tqms)
+}
+
 func (e *matchingEngineImpl) updateTaskQueueGauge(countKey taskQueueCounterKey, taskQueueCount int) {
 	nsEntry, err := e.namespaceRegistry.GetNamespaceByID(countKey.namespaceID)
 	namespace := namespace.Name("unknown")
@@ -1453,6 +1481,10 @@ func (e *matchingEngineImpl) redirectToVersionedQueueForPoll(
 	}
 	data := userData.GetData().GetVersioningData()
 
+	if setIdx, _ := findVersion(data, workerVersionCapabilities.GetBuildId()); setIdx < 0 {
+		e.recordUnknownBuild(taskQueue, stickyInfo.kind, metrics.UnknownBuildPollsPerTaskQueueCounter.GetMetricName())
+	}
+
 	if stickyInfo.kind == enumspb.TASK_QUEUE_KIND_STICKY {
 		// In the sticky case we don't redirect, but we may kick off this worker if there's a
 		// newer one.
@@ -1526,12 +1558,42 @@ func (e *matchingEngineImpl) redirectToVersionedQueueForAdd(
 	if err == errEmptyVersioningData {
 		// default was requested for an unversioned queue
 		return taskQueue, userDataChanged, nil
+	} else if err == errUnknownBuildId {
+		e.recordUnknownBuild(taskQueue, stickyInfo.kind, metrics.UnknownBuildTasksPerTaskQueueCounter.GetMetricName())
+		return nil, nil, err
 	} else if err != nil {
 		return nil, nil, err
 	}
 	return newTaskQueueIDWithVersionSet(taskQueue, versionSet), userDataChanged, nil
 }
 
+// taskQueueMetricsHandler returns the handler for the metrics of a task queue partition.
+func (e *matchingEngineImpl) taskQueueMetricsHandler(
+	nsName namespace.Name,
+	taskQueue *taskQueueID,
+	kind enumspb.TaskQueueKind,
+) metrics.Handler {
+	return metrics.GetPerTaskQueueScope(
+		e.metricsHandler.WithTags(metrics.OperationTag(metrics.MatchingTaskQueueMgrScope), metrics.TaskQueueTypeTag(taskQueue.taskType)),
+		nsName.String(),
+		taskQueue.FullName(),
+		kind,
+	)
+}
+
+// recordUnknownBuild counts a poll or a task of the task queue with a build ID missing from its versioning data.
+func (e *matchingEngineImpl) recordUnknownBuild(
+	taskQueue *taskQueueID,
+	kind enumspb.TaskQueueKind,
+	counterName string,
+) {
+	nsName, err := e.namespaceRegistry.GetNamespaceName(taskQueue.namespaceID)
+	if err != nil {
+		return
+	}
+	e.taskQueueMetricsHandler(nsName, taskQueue, kind).Counter(counterName).Record(1)
+}
+
 func (m *lockableQueryTaskMap) put(key string, value chan *queryResult) {
 	m.Lock()
 	defer m.Unlock()
@@ -39,6 +39,7 @@ import (
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally/v4"
+	"google.golang.org/grpc/metadata"
 
 	commandpb "go.temporal.io/api/command/v1"
 	commonpb "go.temporal.io/api/common/v1"
@@ -62,6 +63,7 @@ import (
 	"go.temporal.io/server/common/clock/hybrid_logical_clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -179,11 +181,11 @@ func (s *matchingEngineSuite) TestAckManager() {
 	const t5 = 360
 	const t6 = 380
 
-	m.addTask(t1)
+	m.addTask(t1, time.Time{})
 	s.EqualValues(100, m.getAckLevel())
 	s.EqualValues(t1, m.getReadLevel())
 
-	m.addTask(t2)
+	m.addTask(t2, time.Time{})
 	s.EqualValues(100, m.getAckLevel())
 	s.EqualValues(t2, m.getReadLevel())
 
@@ -199,11 +201,11 @@ func (s *matchingEngineSuite) TestAckManager() {
 	s.EqualValues(300, m.getAckLevel())
 	s.EqualValues(300, m.getReadLevel())
 
-	m.addTask(t3)
+	m.addTask(t3, time.Time{})
 	s.EqualValues(300, m.getAckLevel())
 	s.EqualValues(t3, m.getReadLevel())
 
-	m.addTask(t4)
+	m.addTask(t4, time.Time{})
 	s.EqualValues(300, m.getAckLevel())
 	s.EqualValues(t4, m.getReadLevel())
 
@@ -236,11 +238,11 @@ func (s *matchingEngineSuite) TestAckManager_Sort() {
 	const t4 = 340
 	const t5 = 360
 
-	m.addTask(t1)
-	m.addTask(t2)
-	m.addTask(t3)
-	m.addTask(t4)
-	m.addTask(t5)
+	m.addTask(t1, time.Time{})
+	m.addTask(t2, time.Time{})
+	m.addTask(t3, time.Time{})
+	m.addTask(t4, time.Time{})
+	m.addTask(t5, time.Time{})
 
 	m.completeTask(t2)
 	s.EqualValues(t0, m.getAckLevel())
@@ -258,6 +260,53 @@ func (s *matchingEngineSuite) TestAckManager_Sort() {
 	s.EqualValues(t5, m.getAckLevel())
 }
 
+func (s *matchingEngineSuite) TestAckManager_OldestTaskCreateTime() {
+	m := newAckManager(s.logger)
+	m.setAckLevel(100)
+	s.True(m.getOldestTaskCreateTime().IsZero())
+
+	now := time.Now().UTC()
+	m.addTask(200, now.Add(-time.Minute))
+	m.addTask(220, now.Add(-time.Second))
+	m.addTask(240, now)
+	s.Equal(now.Add(-time.Minute), m.getOldestTaskCreateTime())
+
+	m.completeTask(220)
+	s.Equal(now.Add(-time.Minute), m.getOldestTaskCreateTime())
+
+	m.completeTask(200)
+	s.Equal(now, m.getOldestTaskCreateTime())
+	s.EqualValues(1, m.getBacklogCountHint())
+
+	m.completeTask(240)
+	s.True(m.getOldestTaskCreateTime().IsZero())
+}
+
+func (s *matchingEngineSuite) TestAckManager_Gap() {
+	m := newAckManager(s.logger)
+	m.setAckLevel(100)
+	const t1 = 200
+	const t2 = 220
+	const t3 = 300
+	const t4 = 400
+
+	m.addTask(t1, time.Time{})
+	m.addTask(t2, time.Time{})
+	// tasks between t2 and t3 expired or were deleted by their TTL
+	m.setReadLevelAfterGap(t3)
+	s.EqualValues(100, m.getAckLevel())
+	s.EqualValues(t3, m.getReadLevel())
+
+	m.completeTask(t2)
+	s.EqualValues(100, m.getAckLevel())
+
+	m.completeTask(t1)
+	s.EqualValues(t3, m.getAckLevel())
+
+	m.setReadLevelAfterGap(t4)
+	s.EqualValues(t4, m.getAckLevel())
+}
+
 func (s *matchingEngineSuite) TestPollActivityTaskQueuesEmptyResult() {
 	s.PollForTasksEmptyResultTest(context.Background(), enumspb.TASK_QUEUE_TYPE_ACTIVITY)
 }
@@ -320,6 +369,48 @@ func (s *matchingEngineSuite) TestOnlyUnloadMatchingInstance() {
 		"Unload call with matching incarnation should have caused unload")
 }
 
+func (s *matchingEngineSuite) TestDescribeTaskQueue_LoadedOnly() {
+	namespaceID := namespace.ID(uuid.New())
+	request := &matchingservice.DescribeTaskQueueRequest{
+		NamespaceId: namespaceID.String(),
+		DescRequest: &workflowservice.DescribeTaskQueueRequest{
+			TaskQueue:              &taskqueuepb.TaskQueue{Name: "makeToast", Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+			TaskQueueType:          enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+			IncludeTaskQueueStatus: true,
+		},
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(headers.LoadedTaskQueueOnlyHeaderName, "true"))
+
+	_, err := s.matchingEngine.DescribeTaskQueue(ctx, request)
+	s.IsType(&serviceerror.NotFound{}, err)
+	queueID := newTestTaskQueueID(namespaceID, "makeToast", enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	tqm, err := s.matchingEngine.getTaskQueueManager(context.Background(), queueID, normalStickyInfo, false)
+	s.NoError(err)
+	s.Nil(tqm, "describing a task queue which is not loaded should not load it")
+
+	_, err = s.matchingEngine.DescribeTaskQueue(context.Background(), request)
+	s.NoError(err)
+	resp, err := s.matchingEngine.DescribeTaskQueue(ctx, request)
+	s.NoError(err)
+	s.NotNil(resp.GetTaskQueueStatus())
+}
+
+func (s *matchingEngineSuite) TestUnloadAllTaskQueues() {
+	namespaceID := namespace.ID(uuid.New())
+	for _, name := range []string{"makeToast", "makeCoffee"} {
+		_, err := s.matchingEngine.getTaskQueueManager(
+			context.Background(),
+			newTestTaskQueueID(namespaceID, name, enumspb.TASK_QUEUE_TYPE_ACTIVITY),
+			normalStickyInfo,
+			true)
+		s.Require().NoError(err)
+	}
+
+	s.Equal(2, s.matchingEngine.UnloadAllTaskQueues())
+	s.Empty(s.matchingEngine.getTaskQueues(1000))
+	s.Equal(0, s.matchingEngine.UnloadAllTaskQueues())
+}
+
 func (s *matchingEngineSuite) TestPollWorkflowTaskQueues() {
 	namespaceID := namespace.ID(uuid.New())
 	tl := "makeToast"
@@ -2037,6 +2128,28 @@ func (s *matchingEngineSuite) TestGetTaskQueueUserData_NoData() {
 	s.Nil(res.UserData.GetData())
 }
 
+func (s *matchingEngineSuite) TestGetTaskQueueUserData_LoadedOnly() {
+	namespaceID := namespace.ID(uuid.New())
+	request := &matchingservice.GetTaskQueueUserDataRequest{
+		NamespaceId:   namespaceID.String(),
+		TaskQueue:     "tupac",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(headers.LoadedTaskQueueOnlyHeaderName, "true"))
+
+	_, err := s.matchingEngine.GetTaskQueueUserData(ctx, request)
+	s.IsType(&serviceerror.NotFound{}, err)
+	queueID := newTestTaskQueueID(namespaceID, "tupac", enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	tqm, err := s.matchingEngine.getTaskQueueManager(context.Background(), queueID, normalStickyInfo, false)
+	s.NoError(err)
+	s.Nil(tqm, "reading the user data of a task queue which is not loaded should not load it")
+
+	_, err = s.matchingEngine.GetTaskQueueUserData(context.Background(), request)
+	s.NoError(err)
+	_, err = s.matchingEngine.GetTaskQueueUserData(ctx, request)
+	s.NoError(err)
+}
+
 func (s *matchingEngineSuite) TestGetTaskQueueUserData_ReturnsData() {
 	namespaceID := namespace.ID(uuid.New())
 	tq := "tupac"
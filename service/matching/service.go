@@ -25,6 +25,7 @@
 package matching
 
 import (
+	"context"
 	"math/rand"
 	"net"
 	"sync/atomic"
@@ -36,6 +37,7 @@ import (
 
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/drain"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
@@ -44,6 +46,9 @@ import (
 	"go.temporal.io/server/common/persistence/visibility/manager"
 )
 
+// drainUnloadInterval is how often task queues still loaded by a draining host are unloaded
+const drainUnloadInterval = time.Second
+
 // Service represents the matching service
 type Service struct {
 	status  int32
@@ -139,6 +144,27 @@ func (s *Service) Stop() {
 	s.logger.Info("matching stopped")
 }
 
+// Drain hands the task queues of this host over to the other matching hosts. The host leaves the membership
+// ring and waits for the new owners of its task queues to take them over, which makes them load the backlog
+// from persistence, then unloads its task queues until none are loaded anymore.
+func (s *Service) Drain(ctx context.Context) error {
+	s.logger.Info("DrainHandler: Evicting self from membership ring")
+	if err := s.membershipMonitor.EvictSelf(); err != nil {
+		return err
+	}
+	s.healthServer.SetServingStatus(serviceName, healthpb.HealthCheckResponse_NOT_SERVING)
+
+	s.logger.Info("DrainHandler: Waiting for others to discover I am unhealthy")
+	if err := drain.Sleep(ctx, s.config.ShutdownDrainDuration()); err != nil {
+		return err
+	}
+
+	s.logger.Info("DrainHandler: Unloading task queues")
+	return drain.WaitUntil(ctx, drainUnloadInterval, func() bool {
+		return s.handler.engine.UnloadAllTaskQueues() == 0
+	})
+}
+
 func (s *Service) GetFaultInjection() *client.FaultInjectionDataStoreFactory {
 	return s.faultInjectionDataStoreFactory
 }
@@ -25,14 +25,32 @@
 package matching
 
 import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	commonpb "go.temporal.io/api/common/v1"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	tracerName = "go.temporal.io/service/matching"
+
+	// queueWaitEventName is the name of the span event recording how long a task waited to be dispatched
+	queueWaitEventName = "task queue wait"
+	// queueWaitAttributeKey is the attribute of queueWaitEventName holding the wait time in milliseconds
+	queueWaitAttributeKey = attribute.Key("temporal.queue_wait_ms")
 )
 
+// noopSpan is returned by startDispatchSpan when no span is started
+var noopSpan = trace.SpanFromContext(context.Background())
+
 type (
 	// genericTaskInfo contains the info for an activity or workflow task
 	genericTaskInfo struct {
@@ -62,6 +80,9 @@ type (
 		forwardedFrom    string     // name of the child partition this task is forwarded from (empty if not forwarded)
 		responseC        chan error // non-nil only where there is a caller waiting for response (sync-match)
 		backlogCountHint int64
+		priority         bool // requested by history to be dispatched before backlog tasks, kept when forwarded
+		// span context of the AddTask request, valid only for sync match tasks as it is not persisted
+		spanContext trace.SpanContext
 	}
 )
 
@@ -156,6 +177,36 @@ func (task *internalTask) pollActivityTaskQueueResponse() *matchingservice.PollA
 	return nil
 }
 
+// startDispatchSpan starts a span for dispatching the task to the poller of ctx as a child of the
+// AddTask request span, so that recording the task as started is part of the trace of the request
+// that created the task. An event is added to the span for the time the task waited to be dispatched
+// and to the poll span for the trace the task belongs to. ctx is returned unchanged with a
+// non-recording span if the task has no span context. The returned span must be ended by the caller.
+func (task *internalTask) startDispatchSpan(ctx context.Context, now time.Time) (context.Context, trace.Span) {
+	if !task.spanContext.IsValid() || task.event == nil {
+		return ctx, noopSpan
+	}
+	pollSpan := trace.SpanFromContext(ctx)
+	pollSpan.AddEvent("dispatch task", trace.WithAttributes(
+		attribute.String("temporal.task_trace_id", task.spanContext.TraceID().String()),
+	))
+	ctx, span := pollSpan.TracerProvider().Tracer(tracerName).Start(
+		trace.ContextWithRemoteSpanContext(ctx, task.spanContext),
+		"DispatchTask",
+		trace.WithLinks(trace.Link{SpanContext: pollSpan.SpanContext()}),
+		trace.WithAttributes(
+			attribute.String("temporal.namespace_id", task.event.Data.GetNamespaceId()),
+			attribute.String("temporal.workflow_id", task.event.Data.GetWorkflowId()),
+			attribute.String("temporal.run_id", task.event.Data.GetRunId()),
+			attribute.Int64("temporal.scheduled_event_id", task.event.Data.GetScheduledEventId()),
+		),
+	)
+	span.AddEvent(queueWaitEventName, trace.WithAttributes(
+		queueWaitAttributeKey.Int64(now.Sub(timestamp.TimeValue(task.event.Data.GetCreateTime())).Milliseconds()),
+	))
+	return ctx, span
+}
+
 // finish marks a task as finished. Should be called after a poller picks up a task
 // and marks it as started. If the task is unable to marked as started, then this
 // method should be called with a non-nil error argument.
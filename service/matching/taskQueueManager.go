@@ -33,6 +33,7 @@ import (
 	"time"
 
 	"github.com/jonboulle/clockwork"
+	"go.opentelemetry.io/otel/trace"
 
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -44,6 +45,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/future"
@@ -97,6 +99,7 @@ type (
 		taskInfo      *persistencespb.TaskInfo
 		source        enumsspb.TaskSource
 		forwardedFrom string
+		priority      bool
 	}
 
 	stickyInfo struct {
@@ -178,6 +181,8 @@ type (
 		// userDataInitialFetch is fulfilled once versioning data is fetched from the root partition. If this TQ is
 		// the root partition, it is fulfilled as soon as it is fetched from db.
 		userDataInitialFetch *future.FutureImpl[struct{}]
+		// cancelDispatchRateSubscription stops refreshing the dispatch rate limits on dynamic config changes
+		cancelDispatchRateSubscription func()
 	}
 )
 
@@ -224,12 +229,7 @@ func newTaskQueueManager(
 		tag.WorkflowTaskQueueName(taskQueue.FullName()),
 		tag.WorkflowTaskQueueType(taskQueue.taskType),
 		tag.WorkflowNamespace(nsName.String()))
-	taggedMetricsHandler := metrics.GetPerTaskQueueScope(
-		e.metricsHandler.WithTags(metrics.OperationTag(metrics.MatchingTaskQueueMgrScope), metrics.TaskQueueTypeTag(taskQueue.taskType)),
-		nsName.String(),
-		taskQueue.FullName(),
-		stickyInfo.kind,
-	)
+	taggedMetricsHandler := e.taskQueueMetricsHandler(nsName, taskQueue, stickyInfo.kind)
 	tlMgr := &taskQueueManagerImpl{
 		status:               common.DaemonStatusInitialized,
 		engine:               e,
@@ -269,7 +269,7 @@ func newTaskQueueManager(
 		forwardTaskQueue := newTaskQueueIDWithVersionSet(taskQueue, "")
 		fwdr = newForwarder(&taskQueueConfig.forwarderConfig, forwardTaskQueue, stickyInfo.kind, e.matchingClient)
 	}
-	tlMgr.matcher = newTaskMatcher(taskQueueConfig, fwdr, tlMgr.taggedMetricsHandler)
+	tlMgr.matcher = newTaskMatcher(taskQueueConfig, fwdr, tlMgr.taskAckManager.getBacklogCountHint, tlMgr.taggedMetricsHandler)
 	for _, opt := range opts {
 		opt(tlMgr)
 	}
@@ -309,6 +309,7 @@ func (c *taskQueueManagerImpl) Start() {
 	c.liveness.Start()
 	c.taskWriter.Start()
 	c.taskReader.Start()
+	c.cancelDispatchRateSubscription = c.config.subscribeDispatchRates(c.matcher.refreshDispatchRates)
 	if c.shouldFetchUserData() {
 		c.goroGroup.Go(c.fetchUserDataLoop)
 	} else {
@@ -342,6 +343,7 @@ func (c *taskQueueManagerImpl) Stop() {
 	c.liveness.Stop()
 	c.taskWriter.Stop()
 	c.taskReader.Stop()
+	c.cancelDispatchRateSubscription()
 	c.goroGroup.Cancel()
 	c.logger.Info("", tag.LifeCycleStopped)
 	c.taggedMetricsHandler.Counter(metrics.TaskQueueStoppedCounter.GetMetricName()).Record(1)
@@ -446,6 +448,10 @@ func (c *taskQueueManagerImpl) GetTask(
 	// we update the ratelimiter rps if it has changed from the last
 	// value. Last poller wins if different pollers provide different values
 	c.matcher.UpdateRatelimit(pollMetadata.ratePerSecond)
+	if c.managesSpecificVersionSet() {
+		// build ID constraints on the dispatch rate resolve against the build of the most recent poller
+		c.config.setPollerBuildID(pollMetadata.workerVersionCapabilities.GetBuildId())
+	}
 
 	if !namespaceEntry.ActiveInCluster(c.clusterMeta.GetCurrentClusterName()) {
 		return c.matcher.PollForQuery(ctx, pollMetadata)
@@ -458,6 +464,10 @@ func (c *taskQueueManagerImpl) GetTask(
 
 	task.namespace = c.namespace
 	task.backlogCountHint = c.taskAckManager.getBacklogCountHint()
+	if pollMetadata.workerVersionCapabilities.GetUseVersioning() {
+		c.taggedMetricsHandler.Counter(metrics.VersionedDispatchesPerTaskQueueCounter.GetMetricName()).Record(
+			1, metrics.BuildIdTag(pollMetadata.workerVersionCapabilities.GetBuildId()))
+	}
 	return task, nil
 }
 
@@ -675,6 +685,8 @@ func (c *taskQueueManagerImpl) trySyncMatch(ctx context.Context, params addTaskP
 	}
 
 	task := newInternalTask(fakeTaskIdWrapper, nil, params.source, params.forwardedFrom, true)
+	task.spanContext = trace.SpanContextFromContext(ctx)
+	task.priority = params.priority
 	return c.matcher.Offer(childCtx, task)
 }
 
@@ -751,6 +763,18 @@ func (c *taskQueueManagerImpl) userDataFetchSource() (string, error) {
 	return parent.FullName(), nil
 }
 
+// recordUserDataPropagationLatency records the time since the user data was last updated on the root
+// partition. Only data received while long polling for changes is recorded, data received on load may
+// have been updated long ago.
+func (c *taskQueueManagerImpl) recordUserDataPropagationLatency(userData *persistencespb.VersionedTaskQueueUserData) {
+	clock := userData.GetData().GetClock()
+	if clock == nil {
+		return
+	}
+	c.taggedMetricsHandler.Timer(metrics.UserDataPropagationLatency.GetMetricName()).Record(
+		c.engine.timeSource.Now().Sub(hlc.UTC(*clock)))
+}
+
 func (c *taskQueueManagerImpl) fetchUserDataLoop(ctx context.Context) error {
 	ctx = c.callerInfoContext(ctx)
 
@@ -790,6 +814,9 @@ func (c *taskQueueManagerImpl) fetchUserDataLoop(ctx context.Context) error {
 		// nil inner fields.
 		if res.GetUserData() != nil {
 			c.db.setUserDataForNonOwningPartition(res.GetUserData())
+			if !firstCall {
+				c.recordUserDataPropagationLatency(res.GetUserData())
+			}
 		}
 		if firstCall {
 			c.userDataInitialFetch.Set(struct{}{}, err)
@@ -46,9 +46,12 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/clock"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -377,7 +380,7 @@ func TestDescribeTaskQueue(t *testing.T) {
 	tlm.taskAckManager.setAckLevel(tlm.db.ackLevel)
 
 	for i := int64(0); i < taskCount; i++ {
-		tlm.taskAckManager.addTask(startTaskID + i)
+		tlm.taskAckManager.addTask(startTaskID+i, time.Time{})
 	}
 
 	includeTaskStatus := false
@@ -641,6 +644,32 @@ func TestTQMFetchesUserDataAndFetchesAgain(t *testing.T) {
 	tq.Stop()
 }
 
+func TestTQMRecordsUserDataPropagationLatency(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	tq := mustCreateTestTaskQueueManager(t, controller)
+	now := time.Now().Truncate(time.Millisecond) // resolution of the clock
+	tq.engine.timeSource = clock.NewEventTimeSource().Update(now)
+	metricsHandler := metrics.NewMockHandler(controller)
+	timer := metrics.NewMockTimerIface(controller)
+	tq.taggedMetricsHandler = metricsHandler
+
+	metricsHandler.EXPECT().Timer(metrics.UserDataPropagationLatency.GetMetricName()).Return(timer)
+	timer.EXPECT().Record(5 * time.Second)
+	tq.recordUserDataPropagationLatency(&persistencespb.VersionedTaskQueueUserData{
+		Version: 2,
+		Data: &persistencespb.TaskQueueUserData{
+			Clock: hlc.Ptr(hlc.Clock{WallClock: now.Add(-5 * time.Second).UnixMilli()}),
+		},
+	})
+
+	// no clock, no update on the root partition to measure from
+	tq.recordUserDataPropagationLatency(&persistencespb.VersionedTaskQueueUserData{
+		Version: 3,
+		Data:    &persistencespb.TaskQueueUserData{},
+	})
+}
+
 func TestTQMFetchesUserDataFailsAndTriesAgain(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -30,6 +30,8 @@ import (
 	"sync/atomic"
 	"time"
 
+	enumspb "go.temporal.io/api/enums/v1"
+
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
@@ -37,6 +39,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/internal/goro"
 	"go.temporal.io/server/service/worker/scanner/taskqueue"
 )
@@ -101,6 +104,8 @@ func (tr *taskReader) Stop() {
 	}
 
 	tr.gorogrp.Cancel()
+	// the partition may be loaded by another host now, don't leave the last backlog of this one behind
+	tr.emitBacklogMetrics(0, time.Time{})
 }
 
 func (tr *taskReader) Signal() {
@@ -281,7 +286,7 @@ func (tr *taskReader) addSingleTaskToBuffer(
 	ctx context.Context,
 	task *persistencespb.AllocatedTaskInfo,
 ) error {
-	tr.tlMgr.taskAckManager.addTask(task.GetTaskId())
+	tr.tlMgr.taskAckManager.addTask(task.GetTaskId(), timestamp.TimeValue(task.GetData().GetCreateTime()))
 	select {
 	case tr.taskBuffer <- task:
 		return nil
@@ -293,6 +298,10 @@ func (tr *taskReader) addSingleTaskToBuffer(
 func (tr *taskReader) persistAckLevel(ctx context.Context) error {
 	ackLevel := tr.tlMgr.taskAckManager.getAckLevel()
 	tr.emitTaskLagMetric(ackLevel)
+	tr.emitBacklogMetrics(
+		tr.tlMgr.taskAckManager.getBacklogCountHint(),
+		tr.tlMgr.taskAckManager.getOldestTaskCreateTime(),
+	)
 	return tr.tlMgr.db.UpdateState(ctx, ackLevel)
 }
 
@@ -311,6 +320,21 @@ func (tr *taskReader) emitTaskLagMetric(ackLevel int64) {
 	tr.taggedMetricsHandler().Gauge(metrics.TaskLagPerTaskQueueGauge.GetMetricName()).Record(float64(maxReadLevel - ackLevel))
 }
 
+// emitBacklogMetrics reports the backlog of the partition, so workers can be scaled on it. Sticky queues are
+// skipped as they all share the same task queue tag.
+func (tr *taskReader) emitBacklogMetrics(backlogCount int64, oldestCreateTime time.Time) {
+	if tr.tlMgr.kind == enumspb.TASK_QUEUE_KIND_STICKY {
+		return
+	}
+	var backlogAge time.Duration
+	if backlogCount > 0 && !oldestCreateTime.IsZero() {
+		backlogAge = tr.tlMgr.engine.timeSource.Now().Sub(oldestCreateTime)
+	}
+	handler := tr.tlMgr.taggedMetricsHandler
+	handler.Gauge(metrics.ApproximateBacklogCount.GetMetricName()).Record(float64(backlogCount))
+	handler.Gauge(metrics.ApproximateBacklogAgeSeconds.GetMetricName()).Record(backlogAge.Seconds())
+}
+
 func (tr *taskReader) backoff(duration time.Duration) {
 	tr.backoffTimerLock.Lock()
 	defer tr.backoffTimerLock.Unlock()
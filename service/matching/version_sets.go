@@ -397,7 +397,7 @@ func lookupVersionSetForPoll(data *persistencespb.VersioningData, caps *commonpb
 		// versioning data replicates, we'll redirect the poll to the correct set id.
 		// In the meantime (e.g. during an ungraceful failover) we can at least match tasks
 		// using the exact same build ID.
-		// TODO: add metric and log to make this situation visible
+		// Callers count these polls with the unknown_build_polls metric.
 		guessedSetId := hashBuildId(caps.BuildId)
 		return guessedSetId, nil
 	}
@@ -36,6 +36,7 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	sdkclient "go.temporal.io/sdk/client"
+	"go.uber.org/multierr"
 
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	carchiver "go.temporal.io/server/common/archiver"
@@ -56,6 +57,9 @@ type (
 		ArchiveRequest       *ArchiveRequest
 		CallerService        string
 		AttemptArchiveInline bool
+		// ArchiveInlineOnly returns the error of a target which fails to be archived inline, instead of handing the
+		// target to the archival workflow. It has no effect unless AttemptArchiveInline is set.
+		ArchiveInlineOnly bool
 	}
 
 	// ClientResponse is the archive response returned from the archiver client
@@ -178,13 +182,18 @@ func (c *client) Archive(ctx context.Context, request *ClientRequest) (*ClientRe
 		}
 
 		targets := []ArchivalTarget{}
+		var inlineErr error
 		for i, target := range request.ArchiveRequest.Targets {
-			if <-results[i] != nil {
+			if err := <-results[i]; err != nil {
 				targets = append(targets, target)
+				inlineErr = multierr.Append(inlineErr, err)
 			} else if target == ArchiveTargetHistory {
 				resp.HistoryArchivedInline = true
 			}
 		}
+		if request.ArchiveInlineOnly && inlineErr != nil {
+			return nil, inlineErr
+		}
 		request.ArchiveRequest.Targets = targets
 	}
 	if len(request.ArchiveRequest.Targets) != 0 {
@@ -217,6 +217,25 @@ func (s *clientSuite) TestArchiveHistoryInlineFail_SendSignalFail() {
 	s.Nil(resp)
 }
 
+func (s *clientSuite) TestArchiveHistoryInlineOnlyFail_NoSignal() {
+	s.archiverProvider.EXPECT().GetHistoryArchiver(gomock.Any(), gomock.Any()).Return(s.historyArchiver, nil)
+	s.historyArchiver.EXPECT().Archive(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some random error"))
+	s.metricsHandler.EXPECT().Counter(metrics.ArchiverClientHistoryRequestCount.GetMetricName()).Return(metrics.NoopCounterMetricFunc)
+	s.metricsHandler.EXPECT().Counter(metrics.ArchiverClientHistoryInlineArchiveAttemptCount.GetMetricName()).Return(metrics.NoopCounterMetricFunc)
+	s.metricsHandler.EXPECT().Counter(metrics.ArchiverClientHistoryInlineArchiveFailureCount.GetMetricName()).Return(metrics.NoopCounterMetricFunc)
+
+	resp, err := s.client.Archive(context.Background(), &ClientRequest{
+		ArchiveRequest: &ArchiveRequest{
+			HistoryURI: "test:///history/archival",
+			Targets:    []ArchivalTarget{ArchiveTargetHistory},
+		},
+		AttemptArchiveInline: true,
+		ArchiveInlineOnly:    true,
+	})
+	s.Error(err)
+	s.Nil(resp)
+}
+
 func (s *clientSuite) TestArchiveInline_HistoryFail_VisibilitySuccess() {
 	s.archiverProvider.EXPECT().GetHistoryArchiver(gomock.Any(), gomock.Any()).Return(s.historyArchiver, nil)
 	s.archiverProvider.EXPECT().GetVisibilityArchiver(gomock.Any(), gomock.Any()).Return(s.visibilityArchiver, nil)
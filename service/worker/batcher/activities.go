@@ -33,11 +33,14 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	updatepb "go.temporal.io/api/update/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/activity"
 	sdkclient "go.temporal.io/sdk/client"
 	"golang.org/x/time/rate"
 
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -49,6 +52,9 @@ import (
 
 var (
 	errNamespaceMismatch = errors.New("namespace mismatch")
+	// errSystemNamespaceUpsertSearchAttributes is returned for legacy batches started in the system namespace,
+	// because history identifies the target namespace by its ID, which the batcher only knows for its own namespace.
+	errSystemNamespaceUpsertSearchAttributes = errors.New("upserting search attributes is not supported for batch operations in the system namespace")
 )
 
 type activities struct {
@@ -79,6 +85,11 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 		logger.Error("Failed to run batch operation due to namespace mismatch", tag.Error(err))
 		return hbd, err
 	}
+	if batchParams.BatchType == BatchTypeUpsertSearchAttributes && batchParams.Namespace != a.namespace.String() {
+		metricsHandler.Counter(metrics.BatcherOperationFailures.GetMetricName()).Record(1)
+		logger.Error("Failed to run batch operation", tag.Error(errSystemNamespaceUpsertSearchAttributes))
+		return hbd, errSystemNamespaceUpsertSearchAttributes
+	}
 
 	sdkClient := a.ClientFactory.NewClient(sdkclient.Options{
 		Namespace:     batchParams.Namespace,
@@ -113,7 +124,7 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 	taskCh := make(chan taskDetail, pageSize)
 	respCh := make(chan error, pageSize)
 	for i := 0; i < a.getOperationConcurrency(batchParams.Concurrency); i++ {
-		go startTaskProcessor(ctx, batchParams, taskCh, respCh, rateLimiter, sdkClient, a.FrontendClient, metricsHandler, logger)
+		go startTaskProcessor(ctx, batchParams, a.namespaceID, taskCh, respCh, rateLimiter, sdkClient, a.FrontendClient, a.HistoryClient, metricsHandler, logger)
 	}
 
 	for {
@@ -212,11 +223,13 @@ func (a *activities) getOperationConcurrency(concurrency int) int {
 func startTaskProcessor(
 	ctx context.Context,
 	batchParams BatchParams,
+	namespaceID namespace.ID,
 	taskCh chan taskDetail,
 	respCh chan error,
 	limiter *rate.Limiter,
 	sdkClient sdkclient.Client,
 	frontendClient workflowservice.WorkflowServiceClient,
+	historyClient historyservice.HistoryServiceClient,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 ) {
@@ -274,10 +287,20 @@ func startTaskProcessor(
 							WorkflowId: workflowID,
 							RunId:      runID,
 						}
-						eventId, err := getResetEventIDByType(ctx, batchParams.ResetParams.ResetType, batchParams.Namespace, workflowExecution, frontendClient, logger)
+						var eventId int64
+						var err error
+						if batchParams.ResetParams.BuildID != "" {
+							eventId, err = getResetEventIDByBuildID(ctx, batchParams.ResetParams.BuildID, batchParams.Namespace, workflowExecution, frontendClient)
+						} else {
+							eventId, err = getResetEventIDByType(ctx, batchParams.ResetParams.ResetType, batchParams.Namespace, workflowExecution, frontendClient, logger)
+						}
 						if err != nil {
 							return err
 						}
+						if eventId == common.EmptyEventID {
+							// workflow was never processed by the given build ID, nothing to reset
+							return nil
+						}
 						_, err = frontendClient.ResetWorkflowExecution(ctx, &workflowservice.ResetWorkflowExecutionRequest{
 							Namespace:                 batchParams.Namespace,
 							WorkflowExecution:         workflowExecution,
@@ -288,6 +311,46 @@ func startTaskProcessor(
 						})
 						return err
 					})
+			case BatchTypeUpdate:
+				err = processTask(ctx, limiter, task,
+					func(workflowID, runID string) error {
+						_, err := frontendClient.UpdateWorkflowExecution(ctx, &workflowservice.UpdateWorkflowExecutionRequest{
+							Namespace: batchParams.Namespace,
+							WorkflowExecution: &commonpb.WorkflowExecution{
+								WorkflowId: workflowID,
+								RunId:      runID,
+							},
+							WaitPolicy: &updatepb.WaitPolicy{
+								LifecycleStage: enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_ACCEPTED,
+							},
+							Request: &updatepb.Request{
+								Meta: &updatepb.Meta{
+									// use the batch job ID so that retries of the same task are deduplicated
+									UpdateId: activity.GetInfo(ctx).WorkflowExecution.ID,
+								},
+								Input: &updatepb.Input{
+									Name: batchParams.UpdateParams.UpdateName,
+									Args: batchParams.UpdateParams.Input,
+								},
+							},
+						})
+						return err
+					})
+			case BatchTypeUpsertSearchAttributes:
+				err = processTask(ctx, limiter, task,
+					func(workflowID, runID string) error {
+						_, err := historyClient.UpsertWorkflowExecutionSearchAttributes(ctx, &historyservice.UpsertWorkflowExecutionSearchAttributesRequest{
+							NamespaceId: namespaceID.String(),
+							WorkflowExecution: &commonpb.WorkflowExecution{
+								WorkflowId: workflowID,
+								RunId:      runID,
+							},
+							SearchAttributes: batchParams.UpsertSearchAttributesParams.SearchAttributes,
+							Identity:         activity.GetInfo(ctx).WorkflowExecution.ID,
+							Reason:           batchParams.Reason,
+						})
+						return err
+					})
 			}
 			if err != nil {
 				metricsHandler.Counter(metrics.BatcherProcessorFailures.GetMetricName()).Record(1)
@@ -359,6 +422,34 @@ func getResetEventIDByType(ctx context.Context,
 	}
 }
 
+// getResetEventIDByBuildID returns the ID of the first workflow task completed event processed by the given
+// build ID on this run, so that resetting to it discards all progress made by that build. Returns
+// common.EmptyEventID if the run was never processed by the build ID.
+func getResetEventIDByBuildID(ctx context.Context,
+	buildID string,
+	namespaceStr string,
+	workflowExecution *commonpb.WorkflowExecution,
+	frontendClient workflowservice.WorkflowServiceClient) (int64, error) {
+	resp, err := frontendClient.DescribeWorkflowExecution(ctx, &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: namespaceStr,
+		Execution: workflowExecution,
+	})
+	if err != nil {
+		return 0, err
+	}
+	runID := resp.GetWorkflowExecutionInfo().GetExecution().GetRunId()
+	for _, point := range resp.GetWorkflowExecutionInfo().GetAutoResetPoints().GetPoints() {
+		if point.GetBinaryChecksum() != buildID || point.GetRunId() != runID {
+			continue
+		}
+		if !point.GetResettable() {
+			return 0, serviceerror.NewFailedPrecondition(fmt.Sprintf("reset point for build ID %v is not resettable", buildID))
+		}
+		return point.GetFirstWorkflowTaskCompletedId(), nil
+	}
+	return common.EmptyEventID, nil
+}
+
 func getLastWorkflowTaskEventID(ctx context.Context,
 	namespaceStr string,
 	workflowExecution *commonpb.WorkflowExecution,
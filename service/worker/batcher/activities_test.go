@@ -27,6 +27,7 @@ package batcher
 import (
 	"context"
 	"testing"
+	"time"
 	"unicode"
 
 	"github.com/golang/mock/gomock"
@@ -34,10 +35,25 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	history "go.temporal.io/api/history/v1"
+	updatepb "go.temporal.io/api/update/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/api/workflowservicemock/v1"
 	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/worker"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/sdk"
 )
 
 type activitiesSuite struct {
@@ -48,12 +64,16 @@ type activitiesSuite struct {
 	env        *testsuite.TestWorkflowEnvironment
 
 	mockFrontendClient *workflowservicemock.MockWorkflowServiceClient
+	mockHistoryClient  *historyservicemock.MockHistoryServiceClient
+	mockClientFactory  *sdk.MockClientFactory
 }
 
 func (s *activitiesSuite) SetupTest() {
 	s.controller = gomock.NewController(s.T())
 
 	s.mockFrontendClient = workflowservicemock.NewMockWorkflowServiceClient(s.controller)
+	s.mockHistoryClient = historyservicemock.NewMockHistoryServiceClient(s.controller)
+	s.mockClientFactory = sdk.NewMockClientFactory(s.controller)
 }
 func TestActivitiesSuite(t *testing.T) {
 	suite.Run(t, new(activitiesSuite))
@@ -205,3 +225,233 @@ func (s *activitiesSuite) TestGetFirstWorkflowTaskEventID() {
 		})
 	}
 }
+
+func (s *activitiesSuite) TestGetResetEventIDByBuildID() {
+	namespaceStr := "test-namespace"
+	workflowExecution := commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "run-2"}
+	describeResponse := &workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Execution: &workflowExecution,
+			AutoResetPoints: &workflowpb.ResetPoints{
+				Points: []*workflowpb.ResetPointInfo{
+					{BinaryChecksum: "bad-build", RunId: "run-1", FirstWorkflowTaskCompletedId: 4, Resettable: true},
+					{BinaryChecksum: "good-build", RunId: "run-2", FirstWorkflowTaskCompletedId: 4, Resettable: true},
+					{BinaryChecksum: "bad-build", RunId: "run-2", FirstWorkflowTaskCompletedId: 14, Resettable: true},
+					{BinaryChecksum: "broken-build", RunId: "run-2", FirstWorkflowTaskCompletedId: 24, Resettable: false},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name        string
+		buildID     string
+		wantEventID int64
+		wantErr     bool
+	}{
+		{
+			name:        "reset point on current run",
+			buildID:     "bad-build",
+			wantEventID: 14,
+		},
+		{
+			name:        "build never processed the run",
+			buildID:     "unknown-build",
+			wantEventID: common.EmptyEventID,
+		},
+		{
+			name:    "reset point not resettable",
+			buildID: "broken-build",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s.mockFrontendClient.EXPECT().DescribeWorkflowExecution(ctx, gomock.Any()).Return(describeResponse, nil)
+			gotEventID, err := getResetEventIDByBuildID(ctx, tt.buildID, namespaceStr, &workflowExecution, s.mockFrontendClient)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getResetEventIDByBuildID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotEventID != tt.wantEventID {
+				t.Errorf("%s: getResetEventIDByBuildID() = %v, want %v", tt.name, gotEventID, tt.wantEventID)
+			}
+		})
+	}
+}
+
+func (s *activitiesSuite) TestBatchActivity_Update() {
+	input := payloads.EncodeString("fix")
+	batchParams := BatchParams{
+		Namespace: "test-namespace",
+		Executions: []*commonpb.WorkflowExecution{
+			{WorkflowId: "wid-1", RunId: "run-1"},
+			{WorkflowId: "wid-2", RunId: "run-2"},
+		},
+		Reason:    "repair",
+		BatchType: BatchTypeUpdate,
+		UpdateParams: UpdateParams{
+			UpdateName: "repair-state",
+			Input:      input,
+		},
+	}
+
+	s.mockClientFactory.EXPECT().NewClient(gomock.Any()).Return(nil)
+	for _, execution := range batchParams.Executions {
+		s.mockFrontendClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), &workflowservice.UpdateWorkflowExecutionRequest{
+			Namespace:         "test-namespace",
+			WorkflowExecution: execution,
+			WaitPolicy: &updatepb.WaitPolicy{
+				LifecycleStage: enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_ACCEPTED,
+			},
+			Request: &updatepb.Request{
+				Meta: &updatepb.Meta{UpdateId: "default-test-workflow-id"}, // the batch job ID in the test environment
+				Input: &updatepb.Input{
+					Name: "repair-state",
+					Args: input,
+				},
+			},
+		}).Return(&workflowservice.UpdateWorkflowExecutionResponse{}, nil)
+	}
+
+	hbd, err := s.executeBatchActivity(s.newActivities("test-namespace", "test-namespace-id"), batchParams)
+	s.NoError(err)
+	s.Equal(2, hbd.SuccessCount)
+	s.Equal(0, hbd.ErrorCount)
+}
+
+func (s *activitiesSuite) TestBatchActivity_ResetToBuildID() {
+	batchParams := BatchParams{
+		Namespace: "test-namespace",
+		Executions: []*commonpb.WorkflowExecution{
+			{WorkflowId: "wid-1", RunId: "run-1"},
+			{WorkflowId: "wid-2", RunId: "run-2"},
+		},
+		Reason:    "bad build",
+		BatchType: BatchTypeReset,
+		ResetParams: ResetParams{
+			BuildID:           "bad-build",
+			ResetReapplytType: enumspb.RESET_REAPPLY_TYPE_SIGNAL,
+		},
+	}
+
+	s.mockClientFactory.EXPECT().NewClient(gomock.Any()).Return(nil)
+	// only the first workflow was processed by the bad build, the second one is skipped
+	s.mockFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: "test-namespace",
+		Execution: batchParams.Executions[0],
+	}).Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Execution: batchParams.Executions[0],
+			AutoResetPoints: &workflowpb.ResetPoints{
+				Points: []*workflowpb.ResetPointInfo{
+					{BinaryChecksum: "bad-build", RunId: "run-1", FirstWorkflowTaskCompletedId: 14, Resettable: true},
+				},
+			},
+		},
+	}, nil)
+	s.mockFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: "test-namespace",
+		Execution: batchParams.Executions[1],
+	}).Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Execution: batchParams.Executions[1],
+		},
+	}, nil)
+	s.mockFrontendClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *workflowservice.ResetWorkflowExecutionRequest, _ ...grpc.CallOption) (*workflowservice.ResetWorkflowExecutionResponse, error) {
+			s.Equal(batchParams.Executions[0], request.WorkflowExecution)
+			s.Equal(int64(14), request.WorkflowTaskFinishEventId)
+			s.Equal(enumspb.RESET_REAPPLY_TYPE_SIGNAL, request.ResetReapplyType)
+			s.Equal("bad build", request.Reason)
+			return &workflowservice.ResetWorkflowExecutionResponse{}, nil
+		},
+	)
+
+	hbd, err := s.executeBatchActivity(s.newActivities("test-namespace", "test-namespace-id"), batchParams)
+	s.NoError(err)
+	s.Equal(2, hbd.SuccessCount)
+	s.Equal(0, hbd.ErrorCount)
+}
+
+func (s *activitiesSuite) TestBatchActivity_UpsertSearchAttributes() {
+	searchAttributes := &commonpb.SearchAttributes{
+		IndexedFields: map[string]*commonpb.Payload{"CustomKeywordField": payload.EncodeString("backfilled")},
+	}
+	batchParams := BatchParams{
+		Namespace: "test-namespace",
+		Executions: []*commonpb.WorkflowExecution{
+			{WorkflowId: "wid-1", RunId: "run-1"},
+			{WorkflowId: "wid-2", RunId: "run-2"},
+		},
+		Reason:    "backfill",
+		BatchType: BatchTypeUpsertSearchAttributes,
+		UpsertSearchAttributesParams: UpsertSearchAttributesParams{
+			SearchAttributes: searchAttributes,
+		},
+	}
+
+	s.mockClientFactory.EXPECT().NewClient(gomock.Any()).Return(nil)
+	for _, execution := range batchParams.Executions {
+		s.mockHistoryClient.EXPECT().UpsertWorkflowExecutionSearchAttributes(gomock.Any(), &historyservice.UpsertWorkflowExecutionSearchAttributesRequest{
+			NamespaceId:       "test-namespace-id",
+			WorkflowExecution: execution,
+			SearchAttributes:  searchAttributes,
+			Identity:          "default-test-workflow-id", // the batch job ID in the test environment
+			Reason:            "backfill",
+		}).Return(&historyservice.UpsertWorkflowExecutionSearchAttributesResponse{}, nil)
+	}
+
+	hbd, err := s.executeBatchActivity(s.newActivities("test-namespace", "test-namespace-id"), batchParams)
+	s.NoError(err)
+	s.Equal(2, hbd.SuccessCount)
+	s.Equal(0, hbd.ErrorCount)
+}
+
+func (s *activitiesSuite) TestBatchActivity_UpsertSearchAttributes_SystemNamespace() {
+	batchParams := BatchParams{
+		Namespace:  "test-namespace",
+		Executions: []*commonpb.WorkflowExecution{{WorkflowId: "wid-1", RunId: "run-1"}},
+		Reason:     "backfill",
+		BatchType:  BatchTypeUpsertSearchAttributes,
+		UpsertSearchAttributesParams: UpsertSearchAttributesParams{
+			SearchAttributes: &commonpb.SearchAttributes{
+				IndexedFields: map[string]*commonpb.Payload{"CustomKeywordField": payload.EncodeString("backfilled")},
+			},
+		},
+	}
+
+	_, err := s.executeBatchActivity(s.newActivities(primitives.SystemLocalNamespace, primitives.SystemNamespaceID), batchParams)
+	s.Error(err)
+	s.Contains(err.Error(), errSystemNamespaceUpsertSearchAttributes.Error())
+}
+
+func (s *activitiesSuite) newActivities(name namespace.Name, id namespace.ID) *activities {
+	return &activities{
+		activityDeps: activityDeps{
+			MetricsHandler: metrics.NoopMetricsHandler,
+			Logger:         log.NewTestLogger(),
+			ClientFactory:  s.mockClientFactory,
+			FrontendClient: s.mockFrontendClient,
+			HistoryClient:  s.mockHistoryClient,
+		},
+		namespace:   name,
+		namespaceID: id,
+		rps:         dynamicconfig.GetIntPropertyFilteredByNamespace(DefaultRPS),
+		concurrency: dynamicconfig.GetIntPropertyFilteredByNamespace(DefaultConcurrency),
+	}
+}
+
+func (s *activitiesSuite) executeBatchActivity(a *activities, batchParams BatchParams) (HeartBeatDetails, error) {
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(a)
+	env.SetWorkerOptions(worker.Options{BackgroundActivityContext: context.Background()})
+	env.SetTestTimeout(10 * time.Second)
+	val, err := env.ExecuteActivity(a.BatchActivity, batchParams)
+	if err != nil {
+		return HeartBeatDetails{}, err
+	}
+	var hbd HeartBeatDetails
+	s.NoError(val.Get(&hbd))
+	return hbd, nil
+}
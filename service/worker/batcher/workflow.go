@@ -64,6 +64,10 @@ const (
 	BatchTypeDelete = "delete"
 	// BatchTypeReset is batch type for resetting workflows
 	BatchTypeReset = "reset"
+	// BatchTypeUpdate is batch type for sending an update to workflows
+	BatchTypeUpdate = "update"
+	// BatchTypeUpsertSearchAttributes is batch type for upserting search attributes of workflows
+	BatchTypeUpsertSearchAttributes = "upsert_search_attributes"
 )
 
 var (
@@ -98,6 +102,21 @@ type (
 	ResetParams struct {
 		ResetType         enumspb.ResetType
 		ResetReapplytType enumspb.ResetReapplyType
+		// BuildID, if set, takes precedence over ResetType: workflows are reset to the last workflow task
+		// before the first one completed by this worker build ID. Workflows that never ran this build are skipped.
+		BuildID string
+	}
+
+	// UpdateParams is the parameters for sending an update to workflow
+	UpdateParams struct {
+		UpdateName string
+		Input      *commonpb.Payloads
+	}
+
+	// UpsertSearchAttributesParams is the parameters for upserting search attributes of workflow
+	UpsertSearchAttributesParams struct {
+		// SearchAttributes to upsert. A field with an empty payload is removed from the workflow.
+		SearchAttributes *commonpb.SearchAttributes
 	}
 
 	// BatchParams is the parameters for batch operation workflow
@@ -110,7 +129,7 @@ type (
 		Executions []*commonpb.WorkflowExecution
 		// Reason for the operation
 		Reason string
-		// Supporting: signal,cancel,terminate,delete,reset
+		// Supporting: signal,cancel,terminate,delete,reset,update,upsert_search_attributes
 		BatchType string
 
 		// Below are all optional
@@ -124,6 +143,10 @@ type (
 		DeleteParams DeleteParams
 		// ResetParams is params only for BatchTypeReset
 		ResetParams ResetParams
+		// UpdateParams is params only for BatchTypeUpdate
+		UpdateParams UpdateParams
+		// UpsertSearchAttributesParams is params only for BatchTypeUpsertSearchAttributes
+		UpsertSearchAttributesParams UpsertSearchAttributesParams
 		// RPS of processing. Default to DefaultRPS
 		// This is moving to dynamic config.
 		// TODO: Remove it from BatchParams after 1.19+
@@ -231,6 +254,16 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide signal name")
 		}
 		return nil
+	case BatchTypeUpdate:
+		if params.UpdateParams.UpdateName == "" {
+			return fmt.Errorf("must provide update name")
+		}
+		return nil
+	case BatchTypeUpsertSearchAttributes:
+		if len(params.UpsertSearchAttributesParams.SearchAttributes.GetIndexedFields()) == 0 {
+			return fmt.Errorf("must provide search attributes")
+		}
+		return nil
 	case BatchTypeCancel, BatchTypeTerminate, BatchTypeDelete, BatchTypeReset:
 		return nil
 	default:
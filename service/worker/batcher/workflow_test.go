@@ -64,6 +64,18 @@ func (s *batcherSuite) TestBatchWorkflow_MissingParams() {
 	s.Contains(err.Error(), "must provide required parameters")
 }
 
+func (s *batcherSuite) TestBatchWorkflow_UpsertSearchAttributes_MissingSearchAttributes() {
+	s.env.ExecuteWorkflow(BatchWorkflow, BatchParams{
+		BatchType: BatchTypeUpsertSearchAttributes,
+		Reason:    "test-reason",
+		Namespace: "test-namespace",
+		Query:     "test-query",
+	})
+	err := s.env.GetWorkflowError()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "must provide search attributes")
+}
+
 func (s *batcherSuite) TestBatchWorkflow_ValidParams_Query() {
 	var ac *activities
 	s.env.OnActivity(ac.BatchActivity, mock.Anything, mock.Anything).Return(HeartBeatDetails{
@@ -0,0 +1,155 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"sync"
+
+	"go.temporal.io/sdk/temporal"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	// ErrTypeNamespaceQuotaExceeded is the type of the retryable error which an activity returns when the
+	// namespace it runs on behalf of is over its quota.
+	ErrTypeNamespaceQuotaExceeded = "NamespaceQuotaExceeded"
+)
+
+type (
+	// PerNSWorkerQuotas bound the resources the system workers take on a worker host on behalf of each namespace.
+	// The per-ns worker of a namespace applies them to options which aren't set in its worker options. Workers
+	// which are shared between namespaces apply them through a PerNSActivityLimiter.
+	PerNSWorkerQuotas struct {
+		MaxConcurrentActivities      dynamicconfig.IntPropertyFnWithNamespaceFilter
+		MaxConcurrentLocalActivities dynamicconfig.IntPropertyFnWithNamespaceFilter
+		MaxConcurrentWorkflowTasks   dynamicconfig.IntPropertyFnWithNamespaceFilter
+		ActivitiesPerSecond          dynamicconfig.FloatPropertyFnWithNamespaceFilter
+	}
+
+	// PerNSActivityLimiter applies the activity quotas of PerNSWorkerQuotas to activities which run on a worker
+	// shared between namespaces, e.g. the deletion of the executions of a namespace. Activities over the quota of
+	// their namespace fail with a retryable error, so that they don't hold a slot of the shared worker while they
+	// wait.
+	PerNSActivityLimiter struct {
+		quotas PerNSWorkerQuotas
+
+		lock       sync.Mutex
+		namespaces map[namespace.Name]*nsActivityLimiter
+	}
+
+	nsActivityLimiter struct {
+		activities      int
+		localActivities int
+		rateLimiter     quotas.RateLimiter
+	}
+)
+
+// NewPerNSWorkerQuotas reads the per-namespace worker quotas from dynamic config.
+func NewPerNSWorkerQuotas(dc *dynamicconfig.Collection) PerNSWorkerQuotas {
+	return PerNSWorkerQuotas{
+		MaxConcurrentActivities: dc.GetIntPropertyFilteredByNamespace(
+			dynamicconfig.WorkerPerNamespaceWorkerMaxConcurrentActivities,
+			100,
+		),
+		MaxConcurrentLocalActivities: dc.GetIntPropertyFilteredByNamespace(
+			dynamicconfig.WorkerPerNamespaceWorkerMaxConcurrentLocalActivities,
+			100,
+		),
+		MaxConcurrentWorkflowTasks: dc.GetIntPropertyFilteredByNamespace(
+			dynamicconfig.WorkerPerNamespaceWorkerMaxConcurrentWorkflowTasks,
+			100,
+		),
+		ActivitiesPerSecond: dc.GetFloatPropertyFilteredByNamespace(
+			dynamicconfig.WorkerPerNamespaceWorkerActivitiesPerSecond,
+			0,
+		),
+	}
+}
+
+func NewPerNSActivityLimiter(quotas PerNSWorkerQuotas) *PerNSActivityLimiter {
+	return &PerNSActivityLimiter{
+		quotas:     quotas,
+		namespaces: make(map[namespace.Name]*nsActivityLimiter),
+	}
+}
+
+// StartActivity counts an activity of the namespace against its quotas. It returns a function that must be called
+// when the activity completes, or an error if the namespace is over its quota.
+func (l *PerNSActivityLimiter) StartActivity(ns namespace.Name) (func(), error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	limiter := l.getLocked(ns)
+	if limit := l.quotas.MaxConcurrentActivities(ns.String()); limit > 0 && limiter.activities >= limit {
+		return nil, newNamespaceQuotaExceededError(ns, "concurrent activities")
+	}
+	if l.quotas.ActivitiesPerSecond(ns.String()) > 0 && !limiter.rateLimiter.Allow() {
+		return nil, newNamespaceQuotaExceededError(ns, "activities per second")
+	}
+	limiter.activities++
+	return func() {
+		l.lock.Lock()
+		defer l.lock.Unlock()
+		limiter.activities--
+	}, nil
+}
+
+// StartLocalActivity counts a local activity of the namespace against its quotas. It returns a function that must
+// be called when the activity completes, or an error if the namespace is over its quota.
+func (l *PerNSActivityLimiter) StartLocalActivity(ns namespace.Name) (func(), error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	limiter := l.getLocked(ns)
+	if limit := l.quotas.MaxConcurrentLocalActivities(ns.String()); limit > 0 && limiter.localActivities >= limit {
+		return nil, newNamespaceQuotaExceededError(ns, "concurrent local activities")
+	}
+	limiter.localActivities++
+	return func() {
+		l.lock.Lock()
+		defer l.lock.Unlock()
+		limiter.localActivities--
+	}, nil
+}
+
+func (l *PerNSActivityLimiter) getLocked(ns namespace.Name) *nsActivityLimiter {
+	limiter, ok := l.namespaces[ns]
+	if !ok {
+		limiter = &nsActivityLimiter{
+			rateLimiter: quotas.NewDefaultOutgoingRateLimiter(func() float64 {
+				return l.quotas.ActivitiesPerSecond(ns.String())
+			}),
+		}
+		l.namespaces[ns] = limiter
+	}
+	return limiter
+}
+
+func newNamespaceQuotaExceededError(ns namespace.Name, quota string) error {
+	return temporal.NewApplicationError("namespace "+ns.String()+" is over its quota of "+quota, ErrTypeNamespaceQuotaExceeded)
+}
@@ -0,0 +1,90 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/temporal"
+
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+func Test_PerNSActivityLimiter_ConcurrentActivities(t *testing.T) {
+	limiter := NewPerNSActivityLimiter(PerNSWorkerQuotas{
+		MaxConcurrentActivities: func(ns string) int {
+			return map[string]int{"ns1": 1}[ns]
+		},
+		MaxConcurrentLocalActivities: dynamicconfig.GetIntPropertyFilteredByNamespace(1),
+		ActivitiesPerSecond:          dynamicconfig.GetFloatPropertyFilteredByNamespace(0),
+	})
+
+	done, err := limiter.StartActivity("ns1")
+	require.NoError(t, err)
+
+	_, err = limiter.StartActivity("ns1")
+	var appErr *temporal.ApplicationError
+	require.True(t, errors.As(err, &appErr))
+	require.Equal(t, ErrTypeNamespaceQuotaExceeded, appErr.Type())
+	require.False(t, appErr.NonRetryable())
+
+	// other namespaces and local activities have their own quotas
+	_, err = limiter.StartActivity("ns2")
+	require.NoError(t, err)
+	_, err = limiter.StartLocalActivity("ns1")
+	require.NoError(t, err)
+	_, err = limiter.StartLocalActivity("ns1")
+	require.Error(t, err)
+
+	done()
+	_, err = limiter.StartActivity("ns1")
+	require.NoError(t, err)
+}
+
+func Test_PerNSActivityLimiter_ActivitiesPerSecond(t *testing.T) {
+	limiter := NewPerNSActivityLimiter(PerNSWorkerQuotas{
+		MaxConcurrentActivities:      dynamicconfig.GetIntPropertyFilteredByNamespace(0),
+		MaxConcurrentLocalActivities: dynamicconfig.GetIntPropertyFilteredByNamespace(0),
+		ActivitiesPerSecond: func(ns string) float64 {
+			return map[string]float64{"ns1": 1}[ns]
+		},
+	})
+
+	// the default burst of one second allows one activity
+	done, err := limiter.StartActivity("ns1")
+	require.NoError(t, err)
+	done()
+	_, err = limiter.StartActivity("ns1")
+	require.Error(t, err)
+
+	// zero means no limit
+	for i := 0; i < 10; i++ {
+		done, err := limiter.StartActivity("ns2")
+		require.NoError(t, err)
+		done()
+	}
+}
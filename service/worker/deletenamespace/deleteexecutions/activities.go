@@ -39,12 +39,14 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/quotas"
+	workercommon "go.temporal.io/server/service/worker/common"
 )
 
 type (
 	Activities struct {
 		visibilityManager manager.VisibilityManager
 		historyClient     historyservice.HistoryServiceClient
+		namespaceLimiter  *workercommon.PerNSActivityLimiter
 		metricsHandler    metrics.Handler
 		logger            log.Logger
 	}
@@ -73,12 +75,14 @@ type (
 func NewActivities(
 	visibilityManager manager.VisibilityManager,
 	historyClient historyservice.HistoryServiceClient,
+	namespaceLimiter *workercommon.PerNSActivityLimiter,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 ) *Activities {
 	return &Activities{
 		visibilityManager: visibilityManager,
 		historyClient:     historyClient,
+		namespaceLimiter:  namespaceLimiter,
 		metricsHandler:    metricsHandler.WithTags(metrics.OperationTag(metrics.DeleteExecutionsWorkflowScope)),
 		logger:            logger,
 	}
@@ -87,6 +91,12 @@ func NewActivities(
 func (a *Activities) GetNextPageTokenActivity(ctx context.Context, params GetNextPageTokenParams) ([]byte, error) {
 	ctx = headers.SetCallerName(ctx, params.Namespace.String())
 
+	done, err := a.namespaceLimiter.StartLocalActivity(params.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
 	req := &manager.ListWorkflowExecutionsRequestV2{
 		NamespaceID:   params.NamespaceID,
 		Namespace:     params.Namespace,
@@ -107,10 +117,16 @@ func (a *Activities) GetNextPageTokenActivity(ctx context.Context, params GetNex
 func (a *Activities) DeleteExecutionsActivity(ctx context.Context, params DeleteExecutionsActivityParams) (DeleteExecutionsActivityResult, error) {
 	ctx = headers.SetCallerName(ctx, params.Namespace.String())
 
-	rateLimiter := quotas.NewRateLimiter(float64(params.RPS), params.RPS)
-
 	var result DeleteExecutionsActivityResult
 
+	done, err := a.namespaceLimiter.StartActivity(params.Namespace)
+	if err != nil {
+		return result, err
+	}
+	defer done()
+
+	rateLimiter := quotas.NewRateLimiter(float64(params.RPS), params.RPS)
+
 	req := &manager.ListWorkflowExecutionsRequestV2{
 		NamespaceID:   params.NamespaceID,
 		Namespace:     params.Namespace,
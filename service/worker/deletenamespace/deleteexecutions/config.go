@@ -73,6 +73,17 @@ func (cfg *DeleteExecutionsConfig) ApplyDefaults() {
 	}
 }
 
+// ApplyRateUpdate overrides rate limits with non-zero values from update.
+func (cfg *DeleteExecutionsConfig) ApplyRateUpdate(update DeleteExecutionsRateUpdate) {
+	if update.DeleteActivityRPS > 0 {
+		cfg.DeleteActivityRPS = update.DeleteActivityRPS
+	}
+	if update.ConcurrentDeleteExecutionsActivities > 0 {
+		cfg.ConcurrentDeleteExecutionsActivities = update.ConcurrentDeleteExecutionsActivities
+	}
+	cfg.ApplyDefaults()
+}
+
 func (cfg DeleteExecutionsConfig) String() string {
 	cfgBytes, _ := json.Marshal(cfg)
 	return string(cfgBytes)
@@ -0,0 +1,95 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package deleteexecutions
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	// QueryNameProgress is the query type which returns DeleteExecutionsProgress of the workflow.
+	QueryNameProgress = "progress"
+	// SignalNameUpdateRate is the signal which adjusts deletion rate limits of the running workflow.
+	// Signal input is DeleteExecutionsRateUpdate.
+	SignalNameUpdateRate = "update-rate"
+)
+
+type (
+	DeleteExecutionsProgress struct {
+		SuccessCount int
+		ErrorCount   int
+		// RemainingEstimate is based on the number of executions counted before deletion started.
+		// It is -1 if the count is not known (i.e. standard visibility is used).
+		RemainingEstimate int
+		// Deletion rate limits currently in effect.
+		DeleteActivityRPS                    int
+		ConcurrentDeleteExecutionsActivities int
+		// CurrentRPS is the deletion rate observed since the current run started.
+		CurrentRPS         float64
+		ContinueAsNewCount int
+	}
+
+	// DeleteExecutionsRateUpdate changes deletion rate limits of the running workflow.
+	// Zero values leave corresponding limits unchanged.
+	// New limits take effect for DeleteExecutionsActivity started after the update.
+	DeleteExecutionsRateUpdate struct {
+		DeleteActivityRPS                    int
+		ConcurrentDeleteExecutionsActivities int
+	}
+)
+
+func progress(ctx workflow.Context, params DeleteExecutionsParams, result DeleteExecutionsResult, runStartTime time.Time) DeleteExecutionsProgress {
+	p := DeleteExecutionsProgress{
+		SuccessCount:                         result.SuccessCount,
+		ErrorCount:                           result.ErrorCount,
+		RemainingEstimate:                    -1,
+		DeleteActivityRPS:                    params.Config.DeleteActivityRPS,
+		ConcurrentDeleteExecutionsActivities: params.Config.ConcurrentDeleteExecutionsActivities,
+		ContinueAsNewCount:                   params.ContinueAsNewCount,
+	}
+	if params.TotalExecutionsCount > 0 {
+		p.RemainingEstimate = params.TotalExecutionsCount - result.SuccessCount
+		if p.RemainingEstimate < 0 {
+			p.RemainingEstimate = 0
+		}
+	}
+	processedInRun := result.SuccessCount + result.ErrorCount - params.PreviousSuccessCount - params.PreviousErrorCount
+	if elapsed := workflow.Now(ctx).Sub(runStartTime); elapsed > 0 {
+		p.CurrentRPS = float64(processedInRun) / elapsed.Seconds()
+	}
+	return p
+}
+
+func receiveRateUpdates(ctx workflow.Context, ch workflow.ReceiveChannel, params *DeleteExecutionsParams) {
+	var update DeleteExecutionsRateUpdate
+	for ch.ReceiveAsync(&update) {
+		params.Config.ApplyRateUpdate(update)
+		workflow.GetLogger(ctx).Info("Deletion rate limits updated.", tag.WorkflowNamespace(params.Namespace.String()), tag.Value(params.Config.String()))
+	}
+}
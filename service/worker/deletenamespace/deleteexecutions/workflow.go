@@ -51,6 +51,10 @@ type (
 		PreviousErrorCount   int
 		ContinueAsNewCount   int
 		NextPageToken        []byte
+
+		// TotalExecutionsCount is the number of executions counted before deletion started.
+		// It is used to estimate the remaining work and is 0 if not known.
+		TotalExecutionsCount int
 	}
 
 	DeleteExecutionsResult struct {
@@ -105,6 +109,14 @@ func DeleteExecutionsWorkflow(ctx workflow.Context, params DeleteExecutionsParam
 	}
 	logger.Info("Effective config.", tag.Value(params.Config.String()))
 
+	runStartTime := workflow.Now(ctx)
+	if err := workflow.SetQueryHandler(ctx, QueryNameProgress, func() (DeleteExecutionsProgress, error) {
+		return progress(ctx, params, result, runStartTime), nil
+	}); err != nil {
+		return result, err
+	}
+	rateUpdateCh := workflow.GetSignalChannel(ctx, SignalNameUpdateRate)
+
 	var a *Activities
 	nextPageToken := params.NextPageToken
 	runningDeleteExecutionsActivityCount := 0
@@ -118,6 +130,8 @@ func DeleteExecutionsWorkflow(ctx workflow.Context, params DeleteExecutionsParam
 	// DeleteExecutionsActivity which takes much longer to complete. This is why this workflow starts
 	// ConcurrentDeleteExecutionsActivities number of them and executes them concurrently on available workers.
 	for i := 0; i < params.Config.PagesPerExecution; i++ {
+		receiveRateUpdates(ctx, rateUpdateCh, &params)
+
 		ctx1 := workflow.WithActivityOptions(ctx, deleteWorkflowExecutionsActivityOptions)
 		deleteExecutionsFuture := workflow.ExecuteActivity(ctx1, a.DeleteExecutionsActivity, &DeleteExecutionsActivityParams{
 			Namespace:     params.Namespace,
@@ -151,7 +165,8 @@ func DeleteExecutionsWorkflow(ctx workflow.Context, params DeleteExecutionsParam
 			result.ErrorCount += der.ErrorCount
 		})
 
-		if runningDeleteExecutionsActivityCount >= params.Config.ConcurrentDeleteExecutionsActivities {
+		// Loop because ConcurrentDeleteExecutionsActivities might have been lowered by rate update.
+		for runningDeleteExecutionsActivityCount >= params.Config.ConcurrentDeleteExecutionsActivities {
 			// Wait for one of running activities to complete.
 			runningDeleteExecutionsSelector.Select(ctx)
 			if lastDeleteExecutionsActivityErr != nil {
@@ -185,6 +200,8 @@ func DeleteExecutionsWorkflow(ctx workflow.Context, params DeleteExecutionsParam
 	// Too many workflow executions, and ConcurrentDeleteExecutionsActivities number of activities has been completed already.
 	// Continue as new to prevent workflow history size explosion.
 
+	// Rate updates must be carried over to the new run.
+	receiveRateUpdates(ctx, rateUpdateCh, &params)
 	params.PreviousSuccessCount = result.SuccessCount
 	params.PreviousErrorCount = result.ErrorCount
 	params.ContinueAsNewCount++
@@ -28,6 +28,7 @@ import (
 	"context"
 	stderrors "errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/mock"
@@ -41,11 +42,13 @@ import (
 	"go.temporal.io/sdk/workflow"
 
 	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	workercommon "go.temporal.io/server/service/worker/common"
 )
 
 func Test_DeleteExecutionsWorkflow_Success(t *testing.T) {
@@ -104,6 +107,7 @@ func Test_DeleteExecutionsWorkflow_NoActivityMocks_NoExecutions(t *testing.T) {
 	a := &Activities{
 		visibilityManager: visibilityManager,
 		historyClient:     nil,
+		namespaceLimiter:  workercommon.NewPerNSActivityLimiter(workercommon.NewPerNSWorkerQuotas(dynamicconfig.NewNoopCollection())),
 		metricsHandler:    nil,
 		logger:            nil,
 	}
@@ -216,6 +220,60 @@ func Test_DeleteExecutionsWorkflow_ManyExecutions_ContinueAsNew(t *testing.T) {
 	require.Equal(t, []byte{3, 22, 83}, newWfParams.NextPageToken)
 }
 
+func Test_DeleteExecutionsWorkflow_ProgressAndRateUpdate(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *Activities
+
+	env.OnActivity(a.GetNextPageTokenActivity, mock.Anything, mock.MatchedBy(func(params GetNextPageTokenParams) bool {
+		return params.NextPageToken == nil
+	})).Return([]byte{3, 22, 83}, nil).Once()
+	env.OnActivity(a.GetNextPageTokenActivity, mock.Anything, mock.MatchedBy(func(params GetNextPageTokenParams) bool {
+		return params.NextPageToken != nil
+	})).Return([]byte(nil), nil).Once()
+	env.OnActivity(a.DeleteExecutionsActivity, mock.Anything, mock.MatchedBy(func(params DeleteExecutionsActivityParams) bool {
+		return params.RPS == 100
+	})).Return(DeleteExecutionsActivityResult{SuccessCount: 2}, nil).After(time.Minute).Once()
+	env.OnActivity(a.DeleteExecutionsActivity, mock.Anything, mock.MatchedBy(func(params DeleteExecutionsActivityParams) bool {
+		return params.RPS == 50
+	})).Return(DeleteExecutionsActivityResult{SuccessCount: 2, ErrorCount: 1}, nil).After(time.Minute).Once()
+
+	env.RegisterDelayedCallback(func() {
+		encodedProgress, err := env.QueryWorkflow(QueryNameProgress)
+		require.NoError(t, err)
+		var p DeleteExecutionsProgress
+		require.NoError(t, encodedProgress.Get(&p))
+		require.Equal(t, 0, p.SuccessCount)
+		require.Equal(t, 5, p.RemainingEstimate)
+		require.Equal(t, 100, p.DeleteActivityRPS)
+
+		env.SignalWorkflow(SignalNameUpdateRate, DeleteExecutionsRateUpdate{DeleteActivityRPS: 50})
+	}, 10*time.Second)
+
+	env.ExecuteWorkflow(DeleteExecutionsWorkflow, DeleteExecutionsParams{
+		NamespaceID: "namespace-id",
+		Namespace:   "namespace",
+		Config: DeleteExecutionsConfig{
+			ConcurrentDeleteExecutionsActivities: 1,
+		},
+		TotalExecutionsCount: 5,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	encodedProgress, err := env.QueryWorkflow(QueryNameProgress)
+	require.NoError(t, err)
+	var p DeleteExecutionsProgress
+	require.NoError(t, encodedProgress.Get(&p))
+	require.Equal(t, 4, p.SuccessCount)
+	require.Equal(t, 1, p.ErrorCount)
+	require.Equal(t, 1, p.RemainingEstimate)
+	require.Equal(t, 50, p.DeleteActivityRPS)
+	require.InDelta(t, 5.0/120, p.CurrentRPS, 0.001)
+}
+
 func Test_DeleteExecutionsWorkflow_ManyExecutions_ActivityError(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestWorkflowEnvironment()
@@ -316,6 +374,7 @@ func Test_DeleteExecutionsWorkflow_NoActivityMocks_ManyExecutions(t *testing.T)
 	a := &Activities{
 		visibilityManager: visibilityManager,
 		historyClient:     historyClient,
+		namespaceLimiter:  workercommon.NewPerNSActivityLimiter(workercommon.NewPerNSWorkerQuotas(dynamicconfig.NewNoopCollection())),
 		metricsHandler:    metrics.NoopMetricsHandler,
 		logger:            log.NewNoopLogger(),
 	}
@@ -404,6 +463,7 @@ func Test_DeleteExecutionsWorkflow_NoActivityMocks_HistoryClientError(t *testing
 	a := &Activities{
 		visibilityManager: visibilityManager,
 		historyClient:     historyClient,
+		namespaceLimiter:  workercommon.NewPerNSActivityLimiter(workercommon.NewPerNSWorkerQuotas(dynamicconfig.NewNoopCollection())),
 		metricsHandler:    metrics.NoopMetricsHandler,
 		logger:            log.NewNoopLogger(),
 	}
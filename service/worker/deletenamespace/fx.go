@@ -30,6 +30,7 @@ import (
 	"go.uber.org/fx"
 
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
@@ -47,6 +48,9 @@ type (
 		historyClient     historyservice.HistoryServiceClient
 		metricsHandler    metrics.Handler
 		logger            log.Logger
+		// Keeps the deletion of each namespace within the per-namespace worker quotas, since it runs on the
+		// default worker, which is shared with all namespaces.
+		namespaceLimiter *workercommon.PerNSActivityLimiter
 	}
 
 	component struct {
@@ -65,6 +69,7 @@ func newComponent(
 	historyClient historyservice.HistoryServiceClient,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
+	dc *dynamicconfig.Collection,
 ) component {
 	return component{
 		DeleteNamespaceComponent: &deleteNamespaceComponent{
@@ -73,6 +78,7 @@ func newComponent(
 			historyClient:     historyClient,
 			metricsHandler:    metricsHandler,
 			logger:            logger,
+			namespaceLimiter:  workercommon.NewPerNSActivityLimiter(workercommon.NewPerNSWorkerQuotas(dc)),
 		}}
 }
 
@@ -97,9 +103,9 @@ func (wc *deleteNamespaceComponent) deleteNamespaceActivities() *activities {
 }
 
 func (wc *deleteNamespaceComponent) reclaimResourcesActivities() *reclaimresources.Activities {
-	return reclaimresources.NewActivities(wc.visibilityManager, wc.metadataManager, wc.metricsHandler, wc.logger)
+	return reclaimresources.NewActivities(wc.visibilityManager, wc.metadataManager, wc.namespaceLimiter, wc.metricsHandler, wc.logger)
 }
 
 func (wc *deleteNamespaceComponent) deleteExecutionsActivities() *deleteexecutions.Activities {
-	return deleteexecutions.NewActivities(wc.visibilityManager, wc.historyClient, wc.metricsHandler, wc.logger)
+	return deleteexecutions.NewActivities(wc.visibilityManager, wc.historyClient, wc.namespaceLimiter, wc.metricsHandler, wc.logger)
 }
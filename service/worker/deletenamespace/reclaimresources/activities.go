@@ -40,6 +40,7 @@ import (
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	workercommon "go.temporal.io/server/service/worker/common"
 	"go.temporal.io/server/service/worker/deletenamespace/errors"
 )
 
@@ -47,6 +48,7 @@ type (
 	Activities struct {
 		visibilityManager manager.VisibilityManager
 		metadataManager   persistence.MetadataManager
+		namespaceLimiter  *workercommon.PerNSActivityLimiter
 		metricsHandler    metrics.Handler
 		logger            log.Logger
 	}
@@ -55,12 +57,14 @@ type (
 func NewActivities(
 	visibilityManager manager.VisibilityManager,
 	metadataManager persistence.MetadataManager,
+	namespaceLimiter *workercommon.PerNSActivityLimiter,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 ) *Activities {
 	return &Activities{
 		visibilityManager: visibilityManager,
 		metadataManager:   metadataManager,
+		namespaceLimiter:  namespaceLimiter,
 		metricsHandler:    metricsHandler.WithTags(metrics.OperationTag(metrics.ReclaimResourcesWorkflowScope)),
 		logger:            logger,
 	}
@@ -68,7 +72,7 @@ func NewActivities(
 
 func (a *Activities) IsAdvancedVisibilityActivity(_ context.Context, nsName namespace.Name) (bool, error) {
 	switch a.visibilityManager.GetReadStoreName(nsName) {
-	case elasticsearch.PersistenceName, mysql.PluginNameV8, postgresql.PluginNameV12, sqlite.PluginName:
+	case elasticsearch.PersistenceName, mysql.PluginNameV8, postgresql.PluginNameV12, postgresql.PluginNameCockroachDB, sqlite.PluginName:
 		return true, nil
 	default:
 		return false, nil
@@ -78,6 +82,12 @@ func (a *Activities) IsAdvancedVisibilityActivity(_ context.Context, nsName name
 func (a *Activities) CountExecutionsAdvVisibilityActivity(ctx context.Context, nsID namespace.ID, nsName namespace.Name) (int64, error) {
 	ctx = headers.SetCallerName(ctx, nsName.String())
 
+	done, err := a.namespaceLimiter.StartLocalActivity(nsName)
+	if err != nil {
+		return 0, err
+	}
+	defer done()
+
 	req := &manager.CountWorkflowExecutionsRequest{
 		NamespaceID: nsID,
 		Namespace:   nsName,
@@ -100,6 +110,12 @@ func (a *Activities) CountExecutionsAdvVisibilityActivity(ctx context.Context, n
 func (a *Activities) EnsureNoExecutionsAdvVisibilityActivity(ctx context.Context, nsID namespace.ID, nsName namespace.Name, notDeletedCount int) error {
 	ctx = headers.SetCallerName(ctx, nsName.String())
 
+	done, err := a.namespaceLimiter.StartActivity(nsName)
+	if err != nil {
+		return err
+	}
+	defer done()
+
 	req := &manager.CountWorkflowExecutionsRequest{
 		NamespaceID: nsID,
 		Namespace:   nsName,
@@ -152,6 +168,12 @@ func (a *Activities) EnsureNoExecutionsStdVisibilityActivity(ctx context.Context
 
 	ctx = headers.SetCallerName(ctx, nsName.String())
 
+	done, err := a.namespaceLimiter.StartActivity(nsName)
+	if err != nil {
+		return err
+	}
+	defer done()
+
 	req := &manager.ListWorkflowExecutionsRequestV2{
 		NamespaceID: nsID,
 		Namespace:   nsName,
@@ -34,10 +34,12 @@ import (
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/testsuite"
 
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	workercommon "go.temporal.io/server/service/worker/common"
 )
 
 func Test_EnsureNoExecutionsAdvVisibilityActivity_NoExecutions(t *testing.T) {
@@ -54,6 +56,7 @@ func Test_EnsureNoExecutionsAdvVisibilityActivity_NoExecutions(t *testing.T) {
 	a := &Activities{
 		visibilityManager: visibilityManager,
 		metadataManager:   nil,
+		namespaceLimiter:  workercommon.NewPerNSActivityLimiter(workercommon.NewPerNSWorkerQuotas(dynamicconfig.NewNoopCollection())),
 		metricsHandler:    metrics.NoopMetricsHandler,
 		logger:            log.NewNoopLogger(),
 	}
@@ -79,6 +82,7 @@ func Test_EnsureNoExecutionsAdvVisibilityActivity_ExecutionsExist(t *testing.T)
 	a := &Activities{
 		visibilityManager: visibilityManager,
 		metadataManager:   nil,
+		namespaceLimiter:  workercommon.NewPerNSActivityLimiter(workercommon.NewPerNSWorkerQuotas(dynamicconfig.NewNoopCollection())),
 		metricsHandler:    metrics.NoopMetricsHandler,
 		logger:            log.NewNoopLogger(),
 	}
@@ -108,6 +112,7 @@ func Test_EnsureNoExecutionsAdvVisibilityActivity_NotDeletedExecutionsExist(t *t
 	a := &Activities{
 		visibilityManager: visibilityManager,
 		metadataManager:   nil,
+		namespaceLimiter:  workercommon.NewPerNSActivityLimiter(workercommon.NewPerNSWorkerQuotas(dynamicconfig.NewNoopCollection())),
 		metricsHandler:    metrics.NoopMetricsHandler,
 		logger:            log.NewNoopLogger(),
 	}
@@ -135,6 +140,7 @@ func Test_EnsureNoExecutionsStdVisibilityActivity_NoExecutions(t *testing.T) {
 	a := &Activities{
 		visibilityManager: visibilityManager,
 		metadataManager:   nil,
+		namespaceLimiter:  workercommon.NewPerNSActivityLimiter(workercommon.NewPerNSWorkerQuotas(dynamicconfig.NewNoopCollection())),
 		metricsHandler:    metrics.NoopMetricsHandler,
 		logger:            log.NewNoopLogger(),
 	}
@@ -158,6 +164,7 @@ func Test_EnsureNoExecutionsStdVisibilityActivity_ExecutionsExist(t *testing.T)
 	a := &Activities{
 		visibilityManager: visibilityManager,
 		metadataManager:   nil,
+		namespaceLimiter:  workercommon.NewPerNSActivityLimiter(workercommon.NewPerNSWorkerQuotas(dynamicconfig.NewNoopCollection())),
 		metricsHandler:    metrics.NoopMetricsHandler,
 		logger:            log.NewNoopLogger(),
 	}
@@ -173,6 +173,7 @@ func deleteWorkflowExecutions(ctx workflow.Context, params ReclaimResourcesParam
 		if executionsCount == 0 {
 			return result, nil
 		}
+		params.TotalExecutionsCount = int(executionsCount)
 	}
 
 	ctx2 := workflow.WithChildOptions(ctx, deleteExecutionsWorkflowOptions)
@@ -36,11 +36,13 @@ import (
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/testsuite"
 
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	workercommon "go.temporal.io/server/service/worker/common"
 	"go.temporal.io/server/service/worker/deletenamespace/deleteexecutions"
 	"go.temporal.io/server/service/worker/deletenamespace/errors"
 )
@@ -63,6 +65,7 @@ func Test_ReclaimResourcesWorkflow_Success(t *testing.T) {
 		},
 		PreviousSuccessCount: 0,
 		PreviousErrorCount:   0,
+		TotalExecutionsCount: 10,
 	}).Return(deleteexecutions.DeleteExecutionsResult{
 		SuccessCount: 10,
 		ErrorCount:   0,
@@ -112,6 +115,7 @@ func Test_ReclaimResourcesWorkflow_EnsureNoExecutionsActivity_Error(t *testing.T
 		},
 		PreviousSuccessCount: 0,
 		PreviousErrorCount:   0,
+		TotalExecutionsCount: 10,
 	}).Return(deleteexecutions.DeleteExecutionsResult{
 		SuccessCount: 10,
 		ErrorCount:   0,
@@ -158,6 +162,7 @@ func Test_ReclaimResourcesWorkflow_EnsureNoExecutionsActivity_ExecutionsStillExi
 		},
 		PreviousSuccessCount: 0,
 		PreviousErrorCount:   0,
+		TotalExecutionsCount: 10,
 	}).Return(deleteexecutions.DeleteExecutionsResult{
 		SuccessCount: 10,
 		ErrorCount:   0,
@@ -228,6 +233,7 @@ func Test_ReclaimResourcesWorkflow_NoActivityMocks_Success(t *testing.T) {
 	a := &Activities{
 		visibilityManager: visibilityManager,
 		metadataManager:   metadataManager,
+		namespaceLimiter:  workercommon.NewPerNSActivityLimiter(workercommon.NewPerNSWorkerQuotas(dynamicconfig.NewNoopCollection())),
 		metricsHandler:    metrics.NoopMetricsHandler,
 		logger:            log.NewNoopLogger(),
 	}
@@ -249,6 +255,7 @@ func Test_ReclaimResourcesWorkflow_NoActivityMocks_Success(t *testing.T) {
 		},
 		PreviousSuccessCount: 0,
 		PreviousErrorCount:   0,
+		TotalExecutionsCount: 1,
 	}).Return(deleteexecutions.DeleteExecutionsResult{
 		SuccessCount: 10,
 		ErrorCount:   0,
@@ -300,6 +307,7 @@ func Test_ReclaimResourcesWorkflow_NoActivityMocks_NoProgressMade(t *testing.T)
 
 	a := &Activities{
 		visibilityManager: visibilityManager,
+		namespaceLimiter:  workercommon.NewPerNSActivityLimiter(workercommon.NewPerNSWorkerQuotas(dynamicconfig.NewNoopCollection())),
 		metricsHandler:    metrics.NoopMetricsHandler,
 		logger:            log.NewNoopLogger(),
 	}
@@ -320,6 +328,7 @@ func Test_ReclaimResourcesWorkflow_NoActivityMocks_NoProgressMade(t *testing.T)
 		},
 		PreviousSuccessCount: 0,
 		PreviousErrorCount:   0,
+		TotalExecutionsCount: 1,
 	}).Return(deleteexecutions.DeleteExecutionsResult{
 		SuccessCount: 10,
 		ErrorCount:   0,
@@ -34,6 +34,8 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	persistenceEncryption "go.temporal.io/server/common/persistence/encryption"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
@@ -45,6 +47,7 @@ import (
 	"go.temporal.io/server/service/worker/batcher"
 	"go.temporal.io/server/service/worker/deletenamespace"
 	"go.temporal.io/server/service/worker/migration"
+	"go.temporal.io/server/service/worker/namespaceexport"
 	"go.temporal.io/server/service/worker/scheduler"
 )
 
@@ -53,6 +56,7 @@ var Module = fx.Options(
 	addsearchattributes.Module,
 	resource.Module,
 	deletenamespace.Module,
+	namespaceexport.Module,
 	scheduler.Module,
 	batcher.Module,
 	fx.Provide(VisibilityManagerProvider),
@@ -86,10 +90,12 @@ func PersistenceRateLimitingParamsProvider(
 func ConfigProvider(
 	dc *dynamicconfig.Collection,
 	persistenceConfig *config.Persistence,
+	cfg *config.Config,
 ) *Config {
 	return NewConfig(
 		dc,
 		persistenceConfig,
+		cfg.Archival.Visibility.Provider,
 		persistenceConfig.StandardVisibilityConfigExist(),
 		persistenceConfig.AdvancedVisibilityConfigExist(),
 	)
@@ -103,7 +109,9 @@ func VisibilityManagerProvider(
 	esClient esclient.Client,
 	persistenceServiceResolver resolver.ServiceResolver,
 	searchAttributesMapperProvider searchattribute.MapperProvider,
+	persistenceKeyProvider persistenceEncryption.KeyProvider,
 	saProvider searchattribute.Provider,
+	circuitBreakers *persistence.CircuitBreakers,
 ) (manager.VisibilityManager, error) {
 	return visibility.NewManager(
 		*persistenceConfig,
@@ -112,12 +120,15 @@ func VisibilityManagerProvider(
 		nil, // worker visibility never write
 		saProvider,
 		searchAttributesMapperProvider,
+		persistenceKeyProvider,
 		serviceConfig.VisibilityPersistenceMaxReadQPS,
 		serviceConfig.VisibilityPersistenceMaxWriteQPS,
 		serviceConfig.EnableReadFromSecondaryVisibility,
 		dynamicconfig.GetStringPropertyFn(visibility.SecondaryVisibilityWritingModeOff), // worker visibility never write
 		serviceConfig.VisibilityDisableOrderByClause,
 		serviceConfig.VisibilityEnableManualPagination,
+		circuitBreakers,
+		nil, // worker visibility never write
 		metricsHandler,
 		logger,
 	)
@@ -38,6 +38,7 @@ import (
 	"go.temporal.io/sdk/activity"
 
 	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
@@ -390,6 +391,74 @@ func (a *activities) GenerateReplicationTasks(ctx context.Context, request *gene
 	return nil
 }
 
+// VerifyReplicationTasks checks that each execution exists on the target cluster. Executions that are missing are
+// re-checked until the wait time elapses to allow in-flight replication tasks to be applied.
+func (a *activities) VerifyReplicationTasks(ctx context.Context, request *verifyReplicationTasksRequest) (*verifyReplicationTasksResponse, error) {
+	ctx = headers.SetCallerInfo(ctx, headers.NewPreemptableCallerInfo(request.Namespace))
+
+	remoteAdminClient, err := a.clientBean.GetRemoteAdminClient(request.TargetClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := request.Executions
+	deadline := time.Now().Add(request.WaitTime)
+	for {
+		var missing []commonpb.WorkflowExecution
+		for i := range pending {
+			exists, err := a.verifyExecutionExists(ctx, remoteAdminClient, request.Namespace, &pending[i])
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				missing = append(missing, pending[i])
+			}
+		}
+		pending = missing
+
+		if len(pending) == 0 || !time.Now().Before(deadline) {
+			break
+		}
+		activity.RecordHeartbeat(ctx, nil)
+		time.Sleep(verifyReplicationRetryInterval)
+	}
+
+	for _, we := range pending {
+		a.logger.Warn("Force replication verification found missing workflow",
+			tag.WorkflowNamespace(request.Namespace),
+			tag.WorkflowID(we.WorkflowId),
+			tag.WorkflowRunID(we.RunId),
+			tag.ClusterName(request.TargetClusterName))
+	}
+	return &verifyReplicationTasksResponse{
+		VerifiedCount:     len(request.Executions) - len(pending),
+		MissingExecutions: pending,
+	}, nil
+}
+
+func (a *activities) verifyExecutionExists(
+	ctx context.Context,
+	remoteAdminClient adminservice.AdminServiceClient,
+	namespaceName string,
+	execution *commonpb.WorkflowExecution,
+) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	_, err := remoteAdminClient.DescribeMutableState(ctx, &adminservice.DescribeMutableStateRequest{
+		Namespace: namespaceName,
+		Execution: execution,
+	})
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case *serviceerror.NotFound:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
 func (a *activities) setCallerInfoForGenReplicationTask(
 	ctx context.Context,
 	namespaceID namespace.ID,
@@ -60,11 +60,26 @@ type (
 		PageCountPerExecution   int     // number of pages to be processed before continue as new, max is 1000.
 		NextPageToken           []byte  // used by continue as new
 
+		// EnableVerification adds a verification phase after all workflows are replicated. The verification phase
+		// scans the same workflows again and confirms each one exists on TargetClusterName.
+		EnableVerification bool
+		TargetClusterName  string
+		// VerificationWaitSeconds is how long a missing workflow is re-checked on the target cluster before it is
+		// reported as a mismatch, since replication tasks are applied asynchronously.
+		VerificationWaitSeconds int
+		// VerificationPhase is set when the workflow moves on to verification. Set it together with NextPageToken
+		// to restart verification from a checkpoint.
+		VerificationPhase bool
+
 		// Used by query handler to indicate overall progress of replication
 		LastCloseTime                      time.Time
 		LastStartTime                      time.Time
 		ContinuedAsNewCount                int
 		TaskQueueUserDataReplicationParams TaskQueueUserDataReplicationParams
+		ReplicatedWorkflowCount            int64
+		VerifiedWorkflowCount              int64
+		MissingWorkflowCount               int64
+		MissingWorkflowSamples             []commonpb.WorkflowExecution
 
 		// Carry over the replication status after continue-as-new.
 		TaskQueueUserDataReplicationStatus TaskQueueUserDataReplicationStatus
@@ -80,6 +95,17 @@ type (
 		LastStartTime                      time.Time
 		TaskQueueUserDataReplicationStatus TaskQueueUserDataReplicationStatus
 		ContinuedAsNewCount                int
+		ReplicatedWorkflowCount            int64
+
+		// PageTokenForRestart is the scan cursor the current run started from. Every workflow before it has been
+		// processed, so a new force-replication workflow started with NextPageToken (and VerificationPhase) set
+		// from this status resumes without redoing completed pages.
+		PageTokenForRestart []byte
+		VerificationPhase   bool
+
+		VerifiedWorkflowCount  int64
+		MissingWorkflowCount   int64
+		MissingWorkflowSamples []commonpb.WorkflowExecution
 	}
 
 	listWorkflowsResponse struct {
@@ -98,6 +124,18 @@ type (
 		RPS         float64
 	}
 
+	verifyReplicationTasksRequest struct {
+		Namespace         string
+		TargetClusterName string
+		Executions        []commonpb.WorkflowExecution
+		WaitTime          time.Duration
+	}
+
+	verifyReplicationTasksResponse struct {
+		VerifiedCount     int
+		MissingExecutions []commonpb.WorkflowExecution
+	}
+
 	metadataRequest struct {
 		Namespace string
 	}
@@ -122,12 +160,19 @@ const (
 )
 
 func ForceReplicationWorkflow(ctx workflow.Context, params ForceReplicationParams) error {
+	pageTokenForRestart := params.NextPageToken
 	workflow.SetQueryHandler(ctx, forceReplicationStatusQueryType, func() (ForceReplicationStatus, error) {
 		return ForceReplicationStatus{
 			LastCloseTime:                      params.LastCloseTime,
 			LastStartTime:                      params.LastStartTime,
 			ContinuedAsNewCount:                params.ContinuedAsNewCount,
 			TaskQueueUserDataReplicationStatus: params.TaskQueueUserDataReplicationStatus,
+			ReplicatedWorkflowCount:            params.ReplicatedWorkflowCount,
+			PageTokenForRestart:                pageTokenForRestart,
+			VerificationPhase:                  params.VerificationPhase,
+			VerifiedWorkflowCount:              params.VerifiedWorkflowCount,
+			MissingWorkflowCount:               params.MissingWorkflowCount,
+			MissingWorkflowSamples:             params.MissingWorkflowSamples,
 		}, nil
 	})
 
@@ -140,6 +185,10 @@ func ForceReplicationWorkflow(ctx workflow.Context, params ForceReplicationParam
 		return err
 	}
 
+	if params.VerificationPhase {
+		return verifyForceReplication(ctx, &params)
+	}
+
 	if !params.TaskQueueUserDataReplicationStatus.Done {
 		err = maybeKickoffTaskQueueUserDataReplication(ctx, params, func(failureReason string) {
 			params.TaskQueueUserDataReplicationStatus.FailureMessage = failureReason
@@ -160,7 +209,7 @@ func ForceReplicationWorkflow(ctx workflow.Context, params ForceReplicationParam
 		workflowExecutionsCh.Close()
 	})
 
-	if err := enqueueReplicationTasks(ctx, workflowExecutionsCh, metadataResp.NamespaceID, params); err != nil {
+	if err := enqueueReplicationTasks(ctx, workflowExecutionsCh, metadataResp.NamespaceID, &params); err != nil {
 		return err
 	}
 
@@ -178,6 +227,12 @@ func ForceReplicationWorkflow(ctx workflow.Context, params ForceReplicationParam
 				return fmt.Errorf("task queue user data replication failed: %v", params.TaskQueueUserDataReplicationStatus.FailureMessage)
 			}
 		}
+		if params.EnableVerification {
+			// Start verification on a new run so it scans from the beginning with a fresh history.
+			params.VerificationPhase = true
+			params.ContinuedAsNewCount++
+			return workflow.NewContinueAsNewError(ctx, ForceReplicationWorkflow, params)
+		}
 		return nil
 	}
 
@@ -268,6 +323,12 @@ func validateAndSetForceReplicationParams(params *ForceReplicationParams) error
 	if params.PageCountPerExecution > maxPageCountPerExecution {
 		params.PageCountPerExecution = maxPageCountPerExecution
 	}
+	if params.EnableVerification && len(params.TargetClusterName) == 0 {
+		return errors.New("InvalidArgument: TargetClusterName is required when verification is enabled")
+	}
+	if params.VerificationWaitSeconds <= 0 {
+		params.VerificationWaitSeconds = defaultVerificationWaitSeconds
+	}
 
 	return nil
 }
@@ -327,7 +388,7 @@ func listWorkflowsForReplication(ctx workflow.Context, workflowExecutionsCh work
 	return nil
 }
 
-func enqueueReplicationTasks(ctx workflow.Context, workflowExecutionsCh workflow.Channel, namespaceID string, params ForceReplicationParams) error {
+func enqueueReplicationTasks(ctx workflow.Context, workflowExecutionsCh workflow.Channel, namespaceID string, params *ForceReplicationParams) error {
 	selector := workflow.NewSelector(ctx)
 	pendingActivities := 0
 
@@ -340,6 +401,7 @@ func enqueueReplicationTasks(ctx workflow.Context, workflowExecutionsCh workflow
 	actx := workflow.WithActivityOptions(ctx, ao)
 	var a *activities
 	var futures []workflow.Future
+	var executionCounts []int
 	var workflowExecutions []commonpb.WorkflowExecution
 
 	for workflowExecutionsCh.Receive(ctx, &workflowExecutions) {
@@ -359,12 +421,100 @@ func enqueueReplicationTasks(ctx workflow.Context, workflowExecutionsCh workflow
 		}
 
 		futures = append(futures, replicationTaskFuture)
+		executionCounts = append(executionCounts, len(workflowExecutions))
 	}
 
-	for _, future := range futures {
+	for i, future := range futures {
 		if err := future.Get(ctx, nil); err != nil {
 			return err
 		}
+		params.ReplicatedWorkflowCount += int64(executionCounts[i])
+	}
+
+	return nil
+}
+
+func verifyForceReplication(ctx workflow.Context, params *ForceReplicationParams) error {
+	workflowExecutionsCh := workflow.NewBufferedChannel(ctx, params.PageCountPerExecution)
+	var listWorkflowsErr error
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		listWorkflowsErr = listWorkflowsForReplication(ctx, workflowExecutionsCh, params)
+		workflowExecutionsCh.Close()
+	})
+
+	if err := verifyReplicationTasks(ctx, workflowExecutionsCh, params); err != nil {
+		return err
+	}
+
+	if listWorkflowsErr != nil {
+		return listWorkflowsErr
+	}
+
+	if params.NextPageToken != nil {
+		params.ContinuedAsNewCount++
+		return workflow.NewContinueAsNewError(ctx, ForceReplicationWorkflow, *params)
+	}
+
+	if params.MissingWorkflowCount > 0 {
+		return temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("force replication verification found %d workflows missing on cluster %s", params.MissingWorkflowCount, params.TargetClusterName),
+			"VerificationFailed",
+			nil,
+			params.MissingWorkflowSamples,
+		)
+	}
+	return nil
+}
+
+func verifyReplicationTasks(ctx workflow.Context, workflowExecutionsCh workflow.Channel, params *ForceReplicationParams) error {
+	selector := workflow.NewSelector(ctx)
+	pendingActivities := 0
+
+	waitTime := time.Duration(params.VerificationWaitSeconds) * time.Second
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Hour + waitTime,
+		HeartbeatTimeout:    time.Second * 30,
+		RetryPolicy:         forceReplicationActivityRetryPolicy,
+	}
+
+	actx := workflow.WithActivityOptions(ctx, ao)
+	var a *activities
+	var futures []workflow.Future
+	var workflowExecutions []commonpb.WorkflowExecution
+
+	for workflowExecutionsCh.Receive(ctx, &workflowExecutions) {
+		verifyTaskFuture := workflow.ExecuteActivity(actx, a.VerifyReplicationTasks, &verifyReplicationTasksRequest{
+			Namespace:         params.Namespace,
+			TargetClusterName: params.TargetClusterName,
+			Executions:        workflowExecutions,
+			WaitTime:          waitTime,
+		})
+
+		pendingActivities++
+		selector.AddFuture(verifyTaskFuture, func(f workflow.Future) {
+			pendingActivities--
+		})
+
+		if pendingActivities == params.ConcurrentActivityCount {
+			selector.Select(ctx) // this will block until one of the in-flight activities completes
+		}
+
+		futures = append(futures, verifyTaskFuture)
+	}
+
+	for _, future := range futures {
+		var resp verifyReplicationTasksResponse
+		if err := future.Get(ctx, &resp); err != nil {
+			return err
+		}
+		params.VerifiedWorkflowCount += int64(resp.VerifiedCount)
+		params.MissingWorkflowCount += int64(len(resp.MissingExecutions))
+		for _, we := range resp.MissingExecutions {
+			if len(params.MissingWorkflowSamples) >= maxMissingWorkflowSamples {
+				break
+			}
+			params.MissingWorkflowSamples = append(params.MissingWorkflowSamples, we)
+		}
 	}
 
 	return nil
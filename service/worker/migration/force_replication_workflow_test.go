@@ -37,13 +37,19 @@ import (
 	"github.com/stretchr/testify/require"
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/api/workflowservicemock/v1"
+	"go.temporal.io/sdk/converter"
 	"go.temporal.io/sdk/interceptor"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/testsuite"
 	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/adminservicemock/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/client"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/persistence"
 )
@@ -300,6 +306,146 @@ func TestForceReplicationWorkflow_TaskQueueReplicationFailure(t *testing.T) {
 	assert.Contains(t, status.TaskQueueUserDataReplicationStatus.FailureMessage, "namespace is required")
 }
 
+func TestForceReplicationWorkflow_EnableVerification(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	namespaceID := uuid.New()
+
+	var a *activities
+	env.OnActivity(a.GetMetadata, mock.Anything, metadataRequest{Namespace: "test-ns"}).Return(&metadataResponse{ShardCount: 4, NamespaceID: namespaceID}, nil)
+	env.OnActivity(a.ListWorkflows, mock.Anything, mock.Anything).Return(&listWorkflowsResponse{
+		Executions:    []commonpb.WorkflowExecution{{WorkflowId: "wf-1", RunId: "run-1"}, {WorkflowId: "wf-2", RunId: "run-2"}},
+		NextPageToken: nil, // last page
+	}, nil).Once()
+	env.OnActivity(a.GenerateReplicationTasks, mock.Anything, mock.Anything).Return(nil).Once()
+	env.RegisterWorkflow(ForceTaskQueueUserDataReplicationWorkflow)
+	env.OnActivity(a.SeedReplicationQueueWithUserDataEntries, mock.Anything, mock.Anything).Return(nil)
+
+	env.ExecuteWorkflow(ForceReplicationWorkflow, ForceReplicationParams{
+		Namespace:               "test-ns",
+		ConcurrentActivityCount: 2,
+		ListWorkflowsPageSize:   2,
+		PageCountPerExecution:   4,
+		EnableVerification:      true,
+		TargetClusterName:       "target",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	var continueAsNewErr *workflow.ContinueAsNewError
+	require.True(t, errors.As(err, &continueAsNewErr))
+	env.AssertExpectations(t)
+
+	var nextParams ForceReplicationParams
+	require.NoError(t, converter.GetDefaultDataConverter().FromPayloads(continueAsNewErr.Input, &nextParams))
+	assert.True(t, nextParams.VerificationPhase)
+	assert.Nil(t, nextParams.NextPageToken)
+	assert.Equal(t, int64(2), nextParams.ReplicatedWorkflowCount)
+	assert.Equal(t, 1, nextParams.ContinuedAsNewCount)
+}
+
+func TestForceReplicationWorkflow_VerificationMismatch(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	namespaceID := uuid.New()
+
+	var a *activities
+	env.OnActivity(a.GetMetadata, mock.Anything, metadataRequest{Namespace: "test-ns"}).Return(&metadataResponse{ShardCount: 4, NamespaceID: namespaceID}, nil)
+
+	currentPageCount := 0
+	env.OnActivity(a.ListWorkflows, mock.Anything, mock.Anything).Return(func(ctx context.Context, request *workflowservice.ListWorkflowExecutionsRequest) (*listWorkflowsResponse, error) {
+		currentPageCount++
+		if currentPageCount == 1 {
+			assert.Equal(t, []byte("checkpoint"), request.NextPageToken)
+			return &listWorkflowsResponse{
+				Executions:    []commonpb.WorkflowExecution{{WorkflowId: "wf-1", RunId: "run-1"}},
+				NextPageToken: []byte("fake-page-token"),
+			}, nil
+		}
+		return &listWorkflowsResponse{
+			Executions:    []commonpb.WorkflowExecution{{WorkflowId: "wf-2", RunId: "run-2"}},
+			NextPageToken: nil, // last page
+		}, nil
+	}).Times(2)
+
+	missing := commonpb.WorkflowExecution{WorkflowId: "wf-2", RunId: "run-2"}
+	env.OnActivity(a.VerifyReplicationTasks, mock.Anything, mock.Anything).Return(func(ctx context.Context, request *verifyReplicationTasksRequest) (*verifyReplicationTasksResponse, error) {
+		assert.Equal(t, "target", request.TargetClusterName)
+		if request.Executions[0].WorkflowId == missing.WorkflowId {
+			return &verifyReplicationTasksResponse{MissingExecutions: []commonpb.WorkflowExecution{missing}}, nil
+		}
+		return &verifyReplicationTasksResponse{VerifiedCount: len(request.Executions)}, nil
+	}).Times(2)
+
+	env.ExecuteWorkflow(ForceReplicationWorkflow, ForceReplicationParams{
+		Namespace:               "test-ns",
+		ConcurrentActivityCount: 2,
+		ListWorkflowsPageSize:   1,
+		PageCountPerExecution:   4,
+		NextPageToken:           []byte("checkpoint"),
+		EnableVerification:      true,
+		TargetClusterName:       "target",
+		VerificationPhase:       true,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "1 workflows missing on cluster target")
+	env.AssertExpectations(t)
+
+	envValue, err := env.QueryWorkflow(forceReplicationStatusQueryType)
+	require.NoError(t, err)
+
+	var status ForceReplicationStatus
+	require.NoError(t, envValue.Get(&status))
+	assert.True(t, status.VerificationPhase)
+	assert.Equal(t, []byte("checkpoint"), status.PageTokenForRestart)
+	assert.Equal(t, int64(1), status.VerifiedWorkflowCount)
+	assert.Equal(t, int64(1), status.MissingWorkflowCount)
+	assert.Equal(t, []commonpb.WorkflowExecution{missing}, status.MissingWorkflowSamples)
+}
+
+func TestVerifyReplicationTasks(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	ctrl := gomock.NewController(t)
+	mockClientBean := client.NewMockBean(ctrl)
+	mockRemoteAdminClient := adminservicemock.NewMockAdminServiceClient(ctrl)
+	mockClientBean.EXPECT().GetRemoteAdminClient("target").Return(mockRemoteAdminClient, nil)
+	a := &activities{
+		clientBean: mockClientBean,
+		logger:     log.NewNoopLogger(),
+	}
+
+	mockRemoteAdminClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *adminservice.DescribeMutableStateRequest, _ ...interface{}) (*adminservice.DescribeMutableStateResponse, error) {
+			assert.Equal(t, "test-ns", request.Namespace)
+			if request.Execution.WorkflowId == "wf-2" {
+				return nil, serviceerror.NewNotFound("workflow not found")
+			}
+			return &adminservice.DescribeMutableStateResponse{}, nil
+		},
+	).Times(2)
+
+	env.RegisterActivity(a)
+	val, err := env.ExecuteActivity(a.VerifyReplicationTasks, &verifyReplicationTasksRequest{
+		Namespace:         "test-ns",
+		TargetClusterName: "target",
+		Executions:        []commonpb.WorkflowExecution{{WorkflowId: "wf-1", RunId: "run-1"}, {WorkflowId: "wf-2", RunId: "run-2"}},
+	})
+	require.NoError(t, err)
+
+	var resp verifyReplicationTasksResponse
+	require.NoError(t, val.Get(&resp))
+	assert.Equal(t, 1, resp.VerifiedCount)
+	assert.Equal(t, []commonpb.WorkflowExecution{{WorkflowId: "wf-2", RunId: "run-2"}}, resp.MissingExecutions)
+}
+
 func TestSeedReplicationQueueWithUserDataEntries_Heartbeats(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestActivityEnvironment()
@@ -31,6 +31,7 @@ import (
 	"go.uber.org/fx"
 
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/client"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -49,6 +50,7 @@ type (
 		FrontendClient            workflowservice.WorkflowServiceClient
 		NamespaceReplicationQueue persistence.NamespaceReplicationQueue
 		TaskManager               persistence.TaskManager
+		ClientBean                client.Bean
 		Logger                    log.Logger
 		MetricsHandler            metrics.Handler
 	}
@@ -97,6 +99,7 @@ func (wc *replicationWorkerComponent) activities() *activities {
 		frontendClient:            wc.FrontendClient,
 		namespaceReplicationQueue: wc.NamespaceReplicationQueue,
 		taskManager:               wc.TaskManager,
+		clientBean:                wc.ClientBean,
 		logger:                    wc.Logger,
 		metricsHandler:            wc.MetricsHandler,
 	}
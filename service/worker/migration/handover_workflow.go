@@ -34,6 +34,7 @@ import (
 	"go.temporal.io/sdk/workflow"
 
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/client"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
@@ -48,6 +49,10 @@ const (
 	defaultPageCountPerExecution = 200
 	maxPageCountPerExecution     = 1000
 
+	defaultVerificationWaitSeconds = 60
+	maxMissingWorkflowSamples      = 100
+	verifyReplicationRetryInterval = time.Second * 5
+
 	minimumAllowedLaggingSeconds  = 5
 	minimumHandoverTimeoutSeconds = 30
 
@@ -78,6 +83,7 @@ type (
 		logger                    log.Logger
 		metricsHandler            metrics.Handler
 		namespaceReplicationQueue persistence.NamespaceReplicationQueue
+		clientBean                client.Bean
 	}
 
 	replicationStatus struct {
@@ -0,0 +1,291 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespaceexport
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"path"
+
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/service/worker/scanner/visibilityexport"
+)
+
+const (
+	checkpointFileName = "checkpoint.json"
+	visibilityFileName = "visibility.json"
+	historyFileName    = "history.pb"
+
+	historyPageSize = 100
+)
+
+type (
+	Activities struct {
+		visibilityManager manager.VisibilityManager
+		metadataManager   persistence.MetadataManager
+		executionManager  persistence.ExecutionManager
+		historyClient     historyservice.HistoryServiceClient
+		numHistoryShards  int32
+		newExportStore    visibilityexport.NewExportStoreFn
+		metricsHandler    metrics.Handler
+		logger            log.Logger
+	}
+
+	ExportPageParams struct {
+		Namespace     namespace.Name
+		NamespaceID   namespace.ID
+		ExportURI     string
+		PageSize      int
+		RPS           int
+		NextPageToken []byte
+		// ExportedCount is the number of executions exported before this page.
+		ExportedCount int
+	}
+
+	ExportPageResult struct {
+		ExportedCount int
+		NextPageToken []byte
+	}
+
+	// Checkpoint is written under the export URI after every exported page.
+	Checkpoint struct {
+		// NextPageToken is the visibility page token of the first page which is not exported yet.
+		NextPageToken []byte
+		ExportedCount int
+		Completed     bool
+	}
+)
+
+func NewActivities(
+	visibilityManager manager.VisibilityManager,
+	metadataManager persistence.MetadataManager,
+	executionManager persistence.ExecutionManager,
+	historyClient historyservice.HistoryServiceClient,
+	numHistoryShards int32,
+	newExportStore visibilityexport.NewExportStoreFn,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *Activities {
+	return &Activities{
+		visibilityManager: visibilityManager,
+		metadataManager:   metadataManager,
+		executionManager:  executionManager,
+		historyClient:     historyClient,
+		numHistoryShards:  numHistoryShards,
+		newExportStore:    newExportStore,
+		metricsHandler:    metricsHandler.WithTags(metrics.OperationTag(metrics.NamespaceExportWorkflowScope)),
+		logger:            logger,
+	}
+}
+
+func (a *Activities) GetNamespaceIDActivity(ctx context.Context, nsName namespace.Name) (namespace.ID, error) {
+	ctx = headers.SetCallerName(ctx, nsName.String())
+
+	resp, err := a.metadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{
+		Name: nsName.String(),
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp.Namespace == nil || resp.Namespace.Info == nil || resp.Namespace.Info.Id == "" {
+		return "", temporal.NewNonRetryableApplicationError("namespace info is corrupted", "", nil)
+	}
+	return namespace.ID(resp.Namespace.Info.Id), nil
+}
+
+// ExportPageActivity exports one page of executions, then writes the checkpoint of the export.
+func (a *Activities) ExportPageActivity(ctx context.Context, params ExportPageParams) (ExportPageResult, error) {
+	ctx = headers.SetCallerName(ctx, params.Namespace.String())
+
+	var result ExportPageResult
+
+	uri, err := archiver.NewURI(params.ExportURI)
+	if err != nil {
+		return result, temporal.NewNonRetryableApplicationError("invalid export URI", "", err)
+	}
+	store, err := a.newExportStore(ctx, uri)
+	if err != nil {
+		return result, temporal.NewNonRetryableApplicationError("unable to create export store", "", err)
+	}
+
+	resp, err := a.visibilityManager.ListWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   params.NamespaceID,
+		Namespace:     params.Namespace,
+		PageSize:      params.PageSize,
+		NextPageToken: params.NextPageToken,
+	})
+	if err != nil {
+		a.metricsHandler.Counter(metrics.ListExecutionsFailuresCount.GetMetricName()).Record(1)
+		a.logger.Error("Unable to list workflow executions.", tag.WorkflowNamespace(params.Namespace.String()), tag.Error(err))
+		return result, err
+	}
+
+	// Exporting is idempotent, skipping executions exported by a previous attempt only saves work.
+	// The page is listed again on retry and might have changed, so the last exported execution is
+	// looked up by run ID instead of trusting its position.
+	if activity.HasHeartbeatDetails(ctx) {
+		var lastExportedRunID string
+		if err := activity.GetHeartbeatDetails(ctx, &lastExportedRunID); err == nil {
+			for i, execution := range resp.Executions {
+				if execution.GetExecution().GetRunId() == lastExportedRunID {
+					result.ExportedCount = i + 1
+					break
+				}
+			}
+		}
+	}
+
+	rateLimiter := quotas.NewRateLimiter(float64(params.RPS), params.RPS)
+	for _, execution := range resp.Executions[result.ExportedCount:] {
+		if err := rateLimiter.Wait(ctx); err != nil {
+			return result, err
+		}
+		if err := a.exportExecution(ctx, store, params, execution); err != nil {
+			a.metricsHandler.Counter(metrics.NamespaceExportFailures.GetMetricName()).Record(1)
+			a.logger.Error("Unable to export workflow execution.", tag.WorkflowNamespace(params.Namespace.String()), tag.WorkflowID(execution.Execution.GetWorkflowId()), tag.WorkflowRunID(execution.Execution.GetRunId()), tag.Error(err))
+			return result, err
+		}
+		result.ExportedCount++
+		a.metricsHandler.Counter(metrics.NamespaceExportedExecutions.GetMetricName()).Record(1)
+		activity.RecordHeartbeat(ctx, execution.GetExecution().GetRunId())
+	}
+	result.NextPageToken = resp.NextPageToken
+
+	checkpoint, err := json.Marshal(Checkpoint{
+		NextPageToken: result.NextPageToken,
+		ExportedCount: params.ExportedCount + result.ExportedCount,
+		Completed:     len(result.NextPageToken) == 0,
+	})
+	if err != nil {
+		return result, err
+	}
+	if err := store.Put(ctx, path.Join(namespacePath(params.Namespace), checkpointFileName), checkpoint); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// exportExecution writes the visibility record of the execution as JSON and its history as serialized
+// proto under "namespace=<namespace>/executions/<workflow ID>/<run ID>/".
+func (a *Activities) exportExecution(
+	ctx context.Context,
+	store visibilityexport.ExportStore,
+	params ExportPageParams,
+	info *workflowpb.WorkflowExecutionInfo,
+) error {
+	dir := executionPath(params.Namespace, info.GetExecution())
+
+	visibilityData, err := codec.NewJSONPBEncoder().Encode(info)
+	if err != nil {
+		return err
+	}
+	if err := store.Put(ctx, path.Join(dir, visibilityFileName), visibilityData); err != nil {
+		return err
+	}
+
+	history, err := a.readHistory(ctx, params.NamespaceID, info.GetExecution())
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		// The execution was deleted after it was listed, only its visibility record is exported.
+		a.logger.Info("Workflow execution is not found in history service.", tag.WorkflowNamespace(params.Namespace.String()), tag.WorkflowID(info.Execution.GetWorkflowId()), tag.WorkflowRunID(info.Execution.GetRunId()))
+		return nil
+	default:
+		return err
+	}
+	historyData, err := history.Marshal()
+	if err != nil {
+		return err
+	}
+	return store.Put(ctx, path.Join(dir, historyFileName), historyData)
+}
+
+// readHistory reads the current branch of the execution history from persistence.
+func (a *Activities) readHistory(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	execution *commonpb.WorkflowExecution,
+) (*historypb.History, error) {
+	msResp, err := a.historyClient.GetMutableState(ctx, &historyservice.GetMutableStateRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   execution,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req := &persistence.ReadHistoryBranchRequest{
+		ShardID:     common.WorkflowIDToHistoryShard(namespaceID.String(), execution.GetWorkflowId(), a.numHistoryShards),
+		BranchToken: msResp.GetCurrentBranchToken(),
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  msResp.GetNextEventId(),
+		PageSize:    historyPageSize,
+	}
+	history := &historypb.History{}
+	for {
+		events, _, nextPageToken, err := persistence.ReadFullPageEvents(ctx, a.executionManager, req)
+		if err != nil {
+			return nil, err
+		}
+		history.Events = append(history.Events, events...)
+		if len(nextPageToken) == 0 {
+			return history, nil
+		}
+		req.NextPageToken = nextPageToken
+	}
+}
+
+func namespacePath(nsName namespace.Name) string {
+	return "namespace=" + nsName.String()
+}
+
+// executionPath escapes workflow IDs because they may contain slashes.
+func executionPath(nsName namespace.Name, execution *commonpb.WorkflowExecution) string {
+	return path.Join(
+		namespacePath(nsName),
+		"executions",
+		url.PathEscape(execution.GetWorkflowId()),
+		execution.GetRunId(),
+	)
+}
@@ -0,0 +1,150 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespaceexport
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/testsuite"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/service/worker/scanner/visibilityexport"
+)
+
+type memoryExportStore struct {
+	sync.Mutex
+	files map[string][]byte
+}
+
+func (s *memoryExportStore) Put(_ context.Context, name string, data []byte) error {
+	s.Lock()
+	defer s.Unlock()
+	s.files[name] = data
+	return nil
+}
+
+func Test_ExportPageActivity(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	ctrl := gomock.NewController(t)
+	visibilityManager := manager.NewMockVisibilityManager(ctrl)
+	historyClient := historyservicemock.NewMockHistoryServiceClient(ctrl)
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+
+	exported := &commonpb.WorkflowExecution{WorkflowId: "wf/1", RunId: "run-1"}
+	deleted := &commonpb.WorkflowExecution{WorkflowId: "wf-2", RunId: "run-2"}
+	visibilityManager.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   "namespace-id",
+		Namespace:     "namespace",
+		PageSize:      2,
+		NextPageToken: []byte{1},
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{Execution: exported},
+			{Execution: deleted},
+		},
+		NextPageToken: []byte{2},
+	}, nil)
+
+	historyClient.EXPECT().GetMutableState(gomock.Any(), &historyservice.GetMutableStateRequest{
+		NamespaceId: "namespace-id",
+		Execution:   exported,
+	}).Return(&historyservice.GetMutableStateResponse{
+		CurrentBranchToken: []byte("branch"),
+		NextEventId:        3,
+	}, nil)
+	historyClient.EXPECT().GetMutableState(gomock.Any(), &historyservice.GetMutableStateRequest{
+		NamespaceId: "namespace-id",
+		Execution:   deleted,
+	}).Return(nil, serviceerror.NewNotFound("workflow not found"))
+
+	events := []*historypb.HistoryEvent{{EventId: 1}, {EventId: 2}}
+	executionManager.EXPECT().ReadHistoryBranch(gomock.Any(), &persistence.ReadHistoryBranchRequest{
+		ShardID:     1,
+		BranchToken: []byte("branch"),
+		MinEventID:  1,
+		MaxEventID:  3,
+		PageSize:    historyPageSize,
+	}).Return(&persistence.ReadHistoryBranchResponse{HistoryEvents: events}, nil)
+
+	store := &memoryExportStore{files: make(map[string][]byte)}
+	a := NewActivities(
+		visibilityManager,
+		nil,
+		executionManager,
+		historyClient,
+		1,
+		func(_ context.Context, uri archiver.URI) (visibilityexport.ExportStore, error) {
+			require.Equal(t, "file:///tmp/export", uri.String())
+			return store, nil
+		},
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	)
+	env.RegisterActivity(a.ExportPageActivity)
+
+	val, err := env.ExecuteActivity(a.ExportPageActivity, ExportPageParams{
+		Namespace:     "namespace",
+		NamespaceID:   "namespace-id",
+		ExportURI:     "file:///tmp/export",
+		PageSize:      2,
+		RPS:           100,
+		NextPageToken: []byte{1},
+		ExportedCount: 10,
+	})
+	require.NoError(t, err)
+	var result ExportPageResult
+	require.NoError(t, val.Get(&result))
+	require.Equal(t, ExportPageResult{ExportedCount: 2, NextPageToken: []byte{2}}, result)
+
+	require.Len(t, store.files, 4)
+	require.Contains(t, store.files, "namespace=namespace/executions/wf%2F1/run-1/visibility.json")
+	require.Contains(t, store.files, "namespace=namespace/executions/wf-2/run-2/visibility.json")
+	require.NotContains(t, store.files, "namespace=namespace/executions/wf-2/run-2/history.pb")
+
+	history := &historypb.History{}
+	require.NoError(t, history.Unmarshal(store.files["namespace=namespace/executions/wf%2F1/run-1/history.pb"]))
+	require.Equal(t, events, history.Events)
+
+	var checkpoint Checkpoint
+	require.NoError(t, json.Unmarshal(store.files["namespace=namespace/checkpoint.json"], &checkpoint))
+	require.Equal(t, Checkpoint{NextPageToken: []byte{2}, ExportedCount: 12}, checkpoint)
+}
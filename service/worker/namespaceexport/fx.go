@@ -0,0 +1,110 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespaceexport
+
+import (
+	sdkworker "go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/fx"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	workercommon "go.temporal.io/server/service/worker/common"
+	"go.temporal.io/server/service/worker/scanner/visibilityexport"
+)
+
+type (
+	// namespaceExportComponent represent background work needed for namespace export.
+	namespaceExportComponent struct {
+		visibilityManager manager.VisibilityManager
+		metadataManager   persistence.MetadataManager
+		executionManager  persistence.ExecutionManager
+		historyClient     historyservice.HistoryServiceClient
+		numHistoryShards  int32
+		// Exports are written with the credentials of visibility archival providers, like visibility exports.
+		provider       *config.VisibilityArchiverProvider
+		metricsHandler metrics.Handler
+		logger         log.Logger
+	}
+
+	component struct {
+		fx.Out
+		NamespaceExportComponent workercommon.WorkerComponent `group:"workerComponent"`
+	}
+)
+
+var Module = fx.Options(
+	fx.Provide(newComponent),
+)
+
+func newComponent(
+	visibilityManager manager.VisibilityManager,
+	metadataManager persistence.MetadataManager,
+	executionManager persistence.ExecutionManager,
+	historyClient historyservice.HistoryServiceClient,
+	persistenceConfig *config.Persistence,
+	cfg *config.Config,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) component {
+	return component{
+		NamespaceExportComponent: &namespaceExportComponent{
+			visibilityManager: visibilityManager,
+			metadataManager:   metadataManager,
+			executionManager:  executionManager,
+			historyClient:     historyClient,
+			numHistoryShards:  persistenceConfig.NumHistoryShards,
+			provider:          cfg.Archival.Visibility.Provider,
+			metricsHandler:    metricsHandler,
+			logger:            logger,
+		}}
+}
+
+func (wc *namespaceExportComponent) Register(worker sdkworker.Worker) {
+	worker.RegisterWorkflowWithOptions(NamespaceExportWorkflow, workflow.RegisterOptions{Name: WorkflowName})
+	worker.RegisterActivity(wc.activities())
+}
+
+func (wc *namespaceExportComponent) DedicatedWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// use default worker
+	return nil
+}
+
+func (wc *namespaceExportComponent) activities() *Activities {
+	return NewActivities(
+		wc.visibilityManager,
+		wc.metadataManager,
+		wc.executionManager,
+		wc.historyClient,
+		wc.numHistoryShards,
+		visibilityexport.NewExportStoreProvider(wc.provider),
+		wc.metricsHandler,
+		wc.logger,
+	)
+}
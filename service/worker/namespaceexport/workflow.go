@@ -0,0 +1,175 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespaceexport
+
+import (
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+)
+
+const (
+	WorkflowName = "temporal-sys-namespace-export-workflow"
+
+	defaultPageSize          = 100
+	defaultRPS               = 50
+	defaultPagesPerExecution = 100
+)
+
+type (
+	NamespaceExportConfig struct {
+		// Page size to read executions from visibility.
+		PageSize int
+		// Number of executions exported per second.
+		RPS int
+		// Number of pages before returning ContinueAsNew.
+		PagesPerExecution int
+	}
+
+	NamespaceExportParams struct {
+		Namespace namespace.Name
+		// ExportURI is where the namespace is exported to. Its scheme must be supported by visibility export.
+		ExportURI string
+		Config    NamespaceExportConfig
+
+		// To carry over progress with ContinueAsNew.
+		// A new export resumes an interrupted one when started with NextPageToken and ExportedCount
+		// of the checkpoint the interrupted export left under ExportURI.
+		NamespaceID        namespace.ID
+		NextPageToken      []byte
+		ExportedCount      int
+		ContinueAsNewCount int
+	}
+
+	NamespaceExportResult struct {
+		ExportedCount int
+	}
+)
+
+var (
+	retryPolicy = &temporal.RetryPolicy{
+		InitialInterval: 1 * time.Second,
+		MaximumInterval: 10 * time.Second,
+	}
+
+	localActivityOptions = workflow.LocalActivityOptions{
+		RetryPolicy:            retryPolicy,
+		StartToCloseTimeout:    30 * time.Second,
+		ScheduleToCloseTimeout: 5 * time.Minute,
+	}
+
+	exportPageActivityOptions = workflow.ActivityOptions{
+		RetryPolicy:         retryPolicy,
+		StartToCloseTimeout: 60 * time.Minute,
+		// Long histories are read between heartbeats.
+		HeartbeatTimeout: 5 * time.Minute,
+	}
+)
+
+func (cfg *NamespaceExportConfig) ApplyDefaults() {
+	if cfg.PageSize <= 0 {
+		cfg.PageSize = defaultPageSize
+	}
+	if cfg.RPS <= 0 {
+		cfg.RPS = defaultRPS
+	}
+	if cfg.PagesPerExecution <= 0 {
+		cfg.PagesPerExecution = defaultPagesPerExecution
+	}
+}
+
+func validateParams(params *NamespaceExportParams) error {
+	if params.Namespace.IsEmpty() {
+		return temporal.NewNonRetryableApplicationError("namespace is required", "", nil)
+	}
+
+	if params.ExportURI == "" {
+		return temporal.NewNonRetryableApplicationError("export URI is required", "", nil)
+	}
+
+	params.Config.ApplyDefaults()
+
+	return nil
+}
+
+// NamespaceExportWorkflow exports every execution of a namespace, both its visibility record and its history,
+// under the export URI. Executions are exported page by page, and a checkpoint is written after each page.
+func NamespaceExportWorkflow(ctx workflow.Context, params NamespaceExportParams) (NamespaceExportResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Workflow started.", tag.WorkflowType(WorkflowName))
+	result := NamespaceExportResult{
+		ExportedCount: params.ExportedCount,
+	}
+
+	if err := validateParams(&params); err != nil {
+		return result, err
+	}
+
+	var a *Activities
+
+	if params.NamespaceID.IsEmpty() {
+		ctx1 := workflow.WithLocalActivityOptions(ctx, localActivityOptions)
+		err := workflow.ExecuteLocalActivity(ctx1, a.GetNamespaceIDActivity, params.Namespace).Get(ctx, &params.NamespaceID)
+		if err != nil {
+			return result, temporal.NewNonRetryableApplicationError(fmt.Sprintf("namespace %s is not found", params.Namespace), "", err)
+		}
+	}
+
+	for i := 0; i < params.Config.PagesPerExecution; i++ {
+		ctx2 := workflow.WithActivityOptions(ctx, exportPageActivityOptions)
+		var pageResult ExportPageResult
+		err := workflow.ExecuteActivity(ctx2, a.ExportPageActivity, ExportPageParams{
+			Namespace:     params.Namespace,
+			NamespaceID:   params.NamespaceID,
+			ExportURI:     params.ExportURI,
+			PageSize:      params.Config.PageSize,
+			RPS:           params.Config.RPS,
+			NextPageToken: params.NextPageToken,
+			ExportedCount: result.ExportedCount,
+		}).Get(ctx, &pageResult)
+		if err != nil {
+			return result, fmt.Errorf("unable to execute activity: ExportPageActivity: %w", err)
+		}
+		result.ExportedCount += pageResult.ExportedCount
+		params.NextPageToken = pageResult.NextPageToken
+
+		if len(params.NextPageToken) == 0 {
+			logger.Info("Workflow finished successfully.", tag.WorkflowType(WorkflowName), tag.WorkflowNamespace(params.Namespace.String()), tag.Counter(result.ExportedCount))
+			return result, nil
+		}
+	}
+
+	// Continue as new to prevent workflow history size explosion.
+	params.ExportedCount = result.ExportedCount
+	params.ContinueAsNewCount++
+
+	logger.Info("There are more executions to export. Continuing workflow as new.", tag.WorkflowType(WorkflowName), tag.WorkflowNamespace(params.Namespace.String()), tag.Counter(result.ExportedCount))
+	return result, workflow.NewContinueAsNewError(ctx, NamespaceExportWorkflow, params)
+}
@@ -0,0 +1,116 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespaceexport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloads"
+)
+
+func Test_NamespaceExportWorkflow_Success(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *Activities
+
+	env.OnActivity(a.GetNamespaceIDActivity, mock.Anything, namespace.Name("namespace")).Return(namespace.ID("namespace-id"), nil).Once()
+	env.OnActivity(a.ExportPageActivity, mock.Anything, ExportPageParams{
+		Namespace:     "namespace",
+		NamespaceID:   "namespace-id",
+		ExportURI:     "file:///tmp/export",
+		PageSize:      100,
+		RPS:           50,
+		NextPageToken: nil,
+		ExportedCount: 0,
+	}).Return(ExportPageResult{ExportedCount: 100, NextPageToken: []byte{1}}, nil).Once()
+	env.OnActivity(a.ExportPageActivity, mock.Anything, ExportPageParams{
+		Namespace:     "namespace",
+		NamespaceID:   "namespace-id",
+		ExportURI:     "file:///tmp/export",
+		PageSize:      100,
+		RPS:           50,
+		NextPageToken: []byte{1},
+		ExportedCount: 100,
+	}).Return(ExportPageResult{ExportedCount: 20}, nil).Once()
+
+	env.ExecuteWorkflow(NamespaceExportWorkflow, NamespaceExportParams{
+		Namespace: "namespace",
+		ExportURI: "file:///tmp/export",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var result NamespaceExportResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	require.Equal(t, 120, result.ExportedCount)
+}
+
+func Test_NamespaceExportWorkflow_ContinueAsNew(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *Activities
+
+	env.OnActivity(a.ExportPageActivity, mock.Anything, mock.Anything).Return(ExportPageResult{ExportedCount: 10, NextPageToken: []byte{3, 22, 83}}, nil).Times(5)
+
+	env.ExecuteWorkflow(NamespaceExportWorkflow, NamespaceExportParams{
+		Namespace:     "namespace",
+		NamespaceID:   "namespace-id",
+		ExportURI:     "file:///tmp/export",
+		Config:        NamespaceExportConfig{PagesPerExecution: 5},
+		ExportedCount: 7,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	wfErr := env.GetWorkflowError()
+	var errContinueAsNew *workflow.ContinueAsNewError
+	require.ErrorAs(t, wfErr, &errContinueAsNew)
+
+	var newWfParams NamespaceExportParams
+	require.NoError(t, payloads.Decode(errContinueAsNew.Input, &newWfParams))
+	require.Equal(t, namespace.ID("namespace-id"), newWfParams.NamespaceID)
+	require.Equal(t, 57, newWfParams.ExportedCount)
+	require.Equal(t, []byte{3, 22, 83}, newWfParams.NextPageToken)
+	require.Equal(t, 1, newWfParams.ContinueAsNewCount)
+}
+
+func Test_NamespaceExportWorkflow_MissingExportURI(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(NamespaceExportWorkflow, NamespaceExportParams{
+		Namespace: "namespace",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.ErrorContains(t, env.GetWorkflowError(), "export URI is required")
+}
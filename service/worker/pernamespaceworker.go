@@ -281,12 +281,13 @@ func (wm *perNamespaceWorkerManager) getWorkerMultiplicity(ns *namespace.Namespa
 func (wm *perNamespaceWorkerManager) getWorkerOptions(ns *namespace.Namespace) sdkWorkerOptions {
 	optionsMap := wm.config.PerNamespaceWorkerOptions(ns.Name().String())
 	var options sdkWorkerOptions
-	b, err := json.Marshal(optionsMap)
-	if err != nil {
-		return options
+	if b, err := json.Marshal(optionsMap); err == nil {
+		_ = json.Unmarshal(b, &options) // ignore errors, just use the zero value anyway
 	}
-	_ = json.Unmarshal(b, &options) // ignore errors, just use the zero value anyway
+	// apply the quotas even if the options are invalid, the worker must not run without limits
+	wm.applyQuotas(ns.Name().String(), &options)
 	if len(options.StickyScheduleToStartTimeout) > 0 {
+		var err error
 		if options.StickyScheduleToStartTimeoutDuration, err = timestamp.ParseDuration(options.StickyScheduleToStartTimeout); err != nil {
 			wm.logger.Warn("invalid StickyScheduleToStartTimeout", tag.Error(err))
 		}
@@ -294,6 +295,22 @@ func (wm *perNamespaceWorkerManager) getWorkerOptions(ns *namespace.Namespace) s
 	return options
 }
 
+func (wm *perNamespaceWorkerManager) applyQuotas(nsName string, options *sdkWorkerOptions) {
+	quotas := wm.config.PerNamespaceWorkerQuotas
+	if options.MaxConcurrentActivityExecutionSize == 0 {
+		options.MaxConcurrentActivityExecutionSize = quotas.MaxConcurrentActivities(nsName)
+	}
+	if options.MaxConcurrentLocalActivityExecutionSize == 0 {
+		options.MaxConcurrentLocalActivityExecutionSize = quotas.MaxConcurrentLocalActivities(nsName)
+	}
+	if options.MaxConcurrentWorkflowTaskExecutionSize == 0 {
+		options.MaxConcurrentWorkflowTaskExecutionSize = quotas.MaxConcurrentWorkflowTasks(nsName)
+	}
+	if options.WorkerActivitiesPerSecond == 0 {
+		options.WorkerActivitiesPerSecond = quotas.ActivitiesPerSecond(nsName)
+	}
+}
+
 // called on namespace state change callback
 func (w *perNamespaceWorker) refreshWithNewNamespace(ns *namespace.Namespace, deleted bool) {
 	w.lock.Lock()
@@ -38,6 +38,7 @@ import (
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/namespace"
@@ -96,13 +97,24 @@ func (s *perNsWorkerManagerSuite) SetupTest() {
 					}
 				case "ns2":
 					return map[string]any{
-						"WorkerLocalActivitiesPerSecond": 200.0,
-						"StickyScheduleToStartTimeout":   "7.5s",
+						"WorkerLocalActivitiesPerSecond":     200.0,
+						"StickyScheduleToStartTimeout":       "7.5s",
+						"MaxConcurrentActivityExecutionSize": 5,
 					}
 				default:
 					return map[string]any{}
 				}
 			},
+			PerNamespaceWorkerQuotas: workercommon.PerNSWorkerQuotas{
+				MaxConcurrentActivities: func(ns string) int {
+					return util.Max(100, map[string]int{"ns3": 300}[ns])
+				},
+				MaxConcurrentLocalActivities: dynamicconfig.GetIntPropertyFilteredByNamespace(100),
+				MaxConcurrentWorkflowTasks:   dynamicconfig.GetIntPropertyFilteredByNamespace(100),
+				ActivitiesPerSecond: func(ns string) float64 {
+					return map[string]float64{"ns3": 10.0}[ns]
+				},
+			},
 		},
 		Components:      []workercommon.PerNSWorkerComponent{s.cmp1, s.cmp2},
 		ClusterMetadata: cluster.NewMetadataForTest(cluster.NewTestClusterMetadataConfig(false, true)),
@@ -247,16 +259,24 @@ func (s *perNsWorkerManagerSuite) TestOptions() {
 		s.Equal(100, options.MaxConcurrentWorkflowTaskPollers)
 		s.Equal(2, options.MaxConcurrentActivityTaskPollers)
 		s.Equal(0.0, options.WorkerLocalActivitiesPerSecond)
+		s.Equal(100, options.MaxConcurrentActivityExecutionSize)
+		s.Equal(100, options.MaxConcurrentWorkflowTaskExecutionSize)
+		s.Equal(0.0, options.WorkerActivitiesPerSecond)
 	}).Return(wkr)
 	s.cfactory.EXPECT().NewWorker(matchStrict{cli2}, primitives.PerNSWorkerTaskQueue, gomock.Any()).Do(func(_, _ any, options sdkworker.Options) {
 		s.Equal(4, options.MaxConcurrentWorkflowTaskPollers)
 		s.Equal(200.0, options.WorkerLocalActivitiesPerSecond)
 		s.Equal(7500*time.Millisecond, options.StickyScheduleToStartTimeout)
+		// Options set explicitly take precedence over quotas.
+		s.Equal(5, options.MaxConcurrentActivityExecutionSize)
 	}).Return(wkr)
 	s.cfactory.EXPECT().NewWorker(matchStrict{cli3}, primitives.PerNSWorkerTaskQueue, gomock.Any()).Do(func(_, _ any, options sdkworker.Options) {
 		s.Equal(6, options.MaxConcurrentWorkflowTaskPollers)
 		s.Equal(0.0, options.WorkerLocalActivitiesPerSecond)
 		s.Equal(0*time.Millisecond, options.StickyScheduleToStartTimeout)
+		s.Equal(300, options.MaxConcurrentActivityExecutionSize)
+		s.Equal(100, options.MaxConcurrentLocalActivityExecutionSize)
+		s.Equal(10.0, options.WorkerActivitiesPerSecond)
 	}).Return(wkr)
 	s.cmp1.EXPECT().Register(wkr, gomock.Any(), gomock.Any()).AnyTimes()
 	wkr.EXPECT().Start().AnyTimes()
@@ -272,6 +292,18 @@ func (s *perNsWorkerManagerSuite) TestOptions() {
 	cli3.EXPECT().Close()
 }
 
+func (s *perNsWorkerManagerSuite) TestOptionsQuotasWithInvalidOptions() {
+	ns3 := testns("ns3", enumspb.NAMESPACE_STATE_REGISTERED)
+	s.manager.config.PerNamespaceWorkerOptions = func(string) map[string]any {
+		return map[string]any{"MaxConcurrentActivityTaskPollers": make(chan int)}
+	}
+
+	options := s.manager.getWorkerOptions(ns3)
+	s.Equal(300, options.MaxConcurrentActivityExecutionSize)
+	s.Equal(100, options.MaxConcurrentWorkflowTaskExecutionSize)
+	s.Equal(10.0, options.WorkerActivitiesPerSecond)
+}
+
 func (s *perNsWorkerManagerSuite) TestTwoNamespacesTwoComponents() {
 	ns1 := testns("ns1", enumspb.NAMESPACE_STATE_REGISTERED)
 	ns2 := testns("ns2", enumspb.NAMESPACE_STATE_REGISTERED)
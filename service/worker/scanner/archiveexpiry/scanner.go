@@ -0,0 +1,219 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiveexpiry
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	ArchiveExpiryScannerWorkflowName = "archive-expiry-scanner"
+	ArchiveExpiryScannerActivityName = "expire-archived-histories"
+
+	ArchiveExpiryScannerWFID          = "temporal-sys-archive-expiry-scanner"
+	ArchiveExpiryScannerTaskQueueName = "temporal-sys-archive-expiry-scanner-taskqueue-0"
+)
+
+var (
+	ArchiveExpiryScannerWFStartOptions = client.StartWorkflowOptions{
+		ID:                    ArchiveExpiryScannerWFID,
+		TaskQueue:             ArchiveExpiryScannerTaskQueueName,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		CronSchedule:          "0 */12 * * *",
+	}
+)
+
+type (
+	ArchiveExpiryScannerInput struct {
+		PageSize              int
+		NamespaceListPageSize int
+	}
+
+	Activities struct {
+		logger             log.Logger
+		metricsHandler     metrics.Handler
+		metadataManager    persistence.MetadataManager
+		archiverProvider   provider.ArchiverProvider
+		perHostQPS         dynamicconfig.IntPropertyFn
+		currentClusterName string
+		timeSource         clock.TimeSource
+	}
+)
+
+func NewActivities(
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	metadataManager persistence.MetadataManager,
+	archiverProvider provider.ArchiverProvider,
+	perHostQPS dynamicconfig.IntPropertyFn,
+	currentClusterName string,
+) *Activities {
+	return &Activities{
+		logger:             logger,
+		metricsHandler:     metricsHandler.WithTags(metrics.OperationTag(metrics.ArchiveExpiryScannerScope)),
+		metadataManager:    metadataManager,
+		archiverProvider:   archiverProvider,
+		perHostQPS:         perHostQPS,
+		currentClusterName: currentClusterName,
+		timeSource:         clock.NewRealTimeSource(),
+	}
+}
+
+// ArchiveExpiryScannerWorkflow expires the archived histories of the namespaces with a history archive retention.
+// This workflow is a wrapper around the long running ExpireArchivedHistories activity.
+func ArchiveExpiryScannerWorkflow(ctx workflow.Context, input ArchiveExpiryScannerInput) error {
+	activityCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 12 * time.Hour,
+		HeartbeatTimeout:    5 * time.Minute,
+	})
+	return workflow.ExecuteActivity(activityCtx, ArchiveExpiryScannerActivityName, input).Get(ctx, nil)
+}
+
+func (a *Activities) setDefaults(input *ArchiveExpiryScannerInput) {
+	if input.PageSize == 0 {
+		input.PageSize = 1000
+	}
+	if input.NamespaceListPageSize == 0 {
+		input.NamespaceListPageSize = 100
+	}
+}
+
+// ExpireArchivedHistories deletes, or transitions to colder storage, the histories archived longer than the
+// history archive retention of their namespace. Namespaces archiving to a store whose archiver doesn't
+// implement archiver.HistoryExpirer, or doesn't support the expiry action of the namespace, are skipped.
+func (a *Activities) ExpireArchivedHistories(ctx context.Context, input ArchiveExpiryScannerInput) error {
+	a.setDefaults(&input)
+
+	rps := float64(a.perHostQPS())
+	rateLimiter := quotas.NewRateLimiter(rps, int(math.Ceil(rps)))
+	var nextPageToken []byte
+	for {
+		resp, err := a.metadataManager.ListNamespaces(ctx, &persistence.ListNamespacesRequest{
+			PageSize:       input.NamespaceListPageSize,
+			NextPageToken:  nextPageToken,
+			IncludeDeleted: false,
+		})
+		if err != nil {
+			return err
+		}
+		for _, ns := range resp.Namespaces {
+			if err := a.expireNamespace(ctx, rateLimiter, input, namespace.FromPersistentState(ns)); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				// Intentionally don't fail the activity on a single namespace, it is expired by the next scan.
+				a.metricsHandler.Counter(metrics.ArchiveExpiryFailures.GetMetricName()).Record(1)
+				a.logger.Error("Failed to expire namespace archived histories",
+					tag.WorkflowNamespace(ns.Namespace.Info.Name),
+					tag.Error(err))
+			}
+			activity.RecordHeartbeat(ctx)
+		}
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+func (a *Activities) expireNamespace(
+	ctx context.Context,
+	rateLimiter quotas.RateLimiter,
+	input ArchiveExpiryScannerInput,
+	ns *namespace.Namespace,
+) error {
+	retention, ok := ns.HistoryArchiveRetention()
+	// Only the active cluster for this namespace expires, clusters archiving to the same store would
+	// otherwise race on the same objects.
+	if !ok || ns.HistoryArchivalState().URI == "" || !ns.ActiveInCluster(a.currentClusterName) {
+		return nil
+	}
+	uri, err := archiver.NewURI(ns.HistoryArchivalState().URI)
+	if err != nil {
+		return err
+	}
+	historyArchiver, err := a.archiverProvider.GetHistoryArchiver(uri.Scheme(), string(primitives.WorkerService))
+	if err != nil {
+		return err
+	}
+	expirer, ok := historyArchiver.(archiver.HistoryExpirer)
+	if !ok {
+		a.logger.Warn("Archived histories of namespace cannot be expired by its archiver",
+			tag.WorkflowNamespace(ns.Name().String()),
+			tag.ArchivalURI(uri.String()))
+		return nil
+	}
+
+	request := &archiver.ExpireHistoryRequest{
+		NamespaceID:    ns.ID().String(),
+		ArchivedBefore: a.timeSource.Now().Add(-retention),
+		Transition:     ns.HistoryArchiveExpiryAction() == namespace.HistoryArchiveExpiryActionTransition,
+		PageSize:       input.PageSize,
+	}
+	for {
+		if err := rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+		resp, err := expirer.ExpireHistory(ctx, uri, request)
+		if errors.Is(err, archiver.ErrExpiryActionNotSupported) {
+			a.logger.Warn("Archived history expiry action of namespace is not supported by its archiver",
+				tag.WorkflowNamespace(ns.Name().String()),
+				tag.ArchivalURI(uri.String()))
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		a.metricsHandler.Counter(metrics.ArchiveExpiredHistoryObjects.GetMetricName()).Record(
+			int64(resp.ExpiredCount),
+			metrics.NamespaceTag(ns.Name().String()),
+		)
+		activity.RecordHeartbeat(ctx)
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
@@ -0,0 +1,151 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiveexpiry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+)
+
+const (
+	testActiveNamespaceID     = "active-namespace-id"
+	testTransitionNamespaceID = "transition-namespace-id"
+	testStandbyNamespaceID    = "standby-namespace-id"
+	testNoRetentionNamespace  = "no-retention-namespace-id"
+	testUnsupportedNamespace  = "unsupported-namespace-id"
+	testCurrentCluster        = "active-cluster"
+	testArchivalURI           = "s3://bucket/prefix"
+)
+
+type expiringHistoryArchiver struct {
+	*archiver.MockHistoryArchiver
+	*archiver.MockHistoryExpirer
+}
+
+func newTestNamespace(id string, activeCluster string, archivalURI string, data map[string]string) *persistence.GetNamespaceResponse {
+	return &persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   id,
+				Name: id,
+				Data: data,
+			},
+			Config: &persistencespb.NamespaceConfig{
+				HistoryArchivalState: enumspb.ARCHIVAL_STATE_ENABLED,
+				HistoryArchivalUri:   archivalURI,
+			},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+				ActiveClusterName: activeCluster,
+				Clusters:          []string{testCurrentCluster, "standby-cluster"},
+			},
+		},
+		IsGlobalNamespace: true,
+	}
+}
+
+func Test_ExpireArchivedHistories(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	ctrl := gomock.NewController(t)
+	metadataManager := persistence.NewMockMetadataManager(ctrl)
+	archiverProvider := provider.NewMockArchiverProvider(ctrl)
+	expirer := archiver.NewMockHistoryExpirer(ctrl)
+	historyArchiver := &expiringHistoryArchiver{
+		MockHistoryArchiver: archiver.NewMockHistoryArchiver(ctrl),
+		MockHistoryExpirer:  expirer,
+	}
+
+	now := time.Date(2023, 4, 5, 12, 30, 0, 0, time.UTC)
+	a := &Activities{
+		logger:             log.NewTestLogger(),
+		metricsHandler:     metrics.NoopMetricsHandler,
+		metadataManager:    metadataManager,
+		archiverProvider:   archiverProvider,
+		perHostQPS:         dynamicconfig.GetIntPropertyFn(1000),
+		currentClusterName: testCurrentCluster,
+		timeSource:         clock.NewEventTimeSource().Update(now),
+	}
+	env.RegisterActivityWithOptions(a.ExpireArchivedHistories, activity.RegisterOptions{Name: ArchiveExpiryScannerActivityName})
+
+	retentionData := map[string]string{namespace.HistoryArchiveRetentionDataKey: "30d"}
+	metadataManager.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).Return(&persistence.ListNamespacesResponse{
+		Namespaces: []*persistence.GetNamespaceResponse{
+			newTestNamespace(testActiveNamespaceID, testCurrentCluster, testArchivalURI, retentionData),
+			newTestNamespace(testTransitionNamespaceID, testCurrentCluster, testArchivalURI, map[string]string{
+				namespace.HistoryArchiveRetentionDataKey:    "7",
+				namespace.HistoryArchiveExpiryActionDataKey: namespace.HistoryArchiveExpiryActionTransition,
+			}),
+			newTestNamespace(testStandbyNamespaceID, "standby-cluster", testArchivalURI, retentionData),
+			newTestNamespace(testNoRetentionNamespace, testCurrentCluster, testArchivalURI, nil),
+			newTestNamespace(testUnsupportedNamespace, testCurrentCluster, "gs://bucket/prefix", retentionData),
+		},
+	}, nil)
+
+	archiverProvider.EXPECT().GetHistoryArchiver("s3", string(primitives.WorkerService)).Return(historyArchiver, nil).Times(2)
+	archiverProvider.EXPECT().GetHistoryArchiver("gs", string(primitives.WorkerService)).Return(archiver.NewMockHistoryArchiver(ctrl), nil)
+
+	uri, err := archiver.NewURI(testArchivalURI)
+	require.NoError(t, err)
+	gomock.InOrder(
+		expirer.EXPECT().ExpireHistory(gomock.Any(), uri, &archiver.ExpireHistoryRequest{
+			NamespaceID:    testActiveNamespaceID,
+			ArchivedBefore: now.Add(-30 * 24 * time.Hour),
+			PageSize:       1000,
+		}).Return(&archiver.ExpireHistoryResponse{ExpiredCount: 3, NextPageToken: []byte("token")}, nil),
+		expirer.EXPECT().ExpireHistory(gomock.Any(), uri, &archiver.ExpireHistoryRequest{
+			NamespaceID:    testActiveNamespaceID,
+			ArchivedBefore: now.Add(-30 * 24 * time.Hour),
+			PageSize:       1000,
+			NextPageToken:  []byte("token"),
+		}).Return(&archiver.ExpireHistoryResponse{ExpiredCount: 1}, nil),
+		expirer.EXPECT().ExpireHistory(gomock.Any(), uri, &archiver.ExpireHistoryRequest{
+			NamespaceID:    testTransitionNamespaceID,
+			ArchivedBefore: now.Add(-7 * 24 * time.Hour),
+			Transition:     true,
+			PageSize:       1000,
+		}).Return(nil, archiver.ErrExpiryActionNotSupported),
+	)
+
+	_, err = env.ExecuteActivity(ArchiveExpiryScannerActivityName, ArchiveExpiryScannerInput{})
+	require.NoError(t, err)
+}
@@ -0,0 +1,256 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencepb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	// CandidateReasonMutableStateNotFound is the reason of a garbage branch whose workflow doesn't exist anymore.
+	CandidateReasonMutableStateNotFound = "mutable state not found"
+	// CandidateReasonNotReferenced is the reason of a garbage branch whose workflow exists, but doesn't reference the
+	// branch in any of its version histories, e.g. a branch left behind by a failed reset.
+	CandidateReasonNotReferenced = "not referenced by mutable state"
+	// CandidateReasonCorrupted is the reason of a branch whose cleanup info can't be parsed. Such branches are only
+	// reported, since their shard can't be known.
+	CandidateReasonCorrupted = "corrupted cleanup info"
+
+	// Maximum number of garbage branches listed by a dry run. Further ones are only counted.
+	maxReportedCandidates = 1000
+	// Number of garbage branches deleted between two progress logs
+	deletionBatchSize = 100
+)
+
+type (
+	// BranchCandidate is a history branch found to be garbage by the scavenger.
+	BranchCandidate struct {
+		ShardID     int32
+		NamespaceID string
+		WorkflowID  string
+		RunID       string
+		BranchToken []byte
+		Reason      string
+		// SizeBytes is the size of the encoded events of the branch, including the ones it shares with its
+		// ancestors.
+		SizeBytes int64
+	}
+
+	// DeletionResult is the outcome of deleting confirmed garbage branches.
+	DeletionResult struct {
+		Deleted int
+		// Skipped counts the branches which were not garbage anymore, or which can't be deleted.
+		Skipped int
+		Failed  int
+	}
+
+	// GarbageCollector finds out if history branches are garbage and deletes them.
+	GarbageCollector struct {
+		numShards   int32
+		db          persistence.ExecutionManager
+		client      historyservice.HistoryServiceClient
+		rateLimiter quotas.RateLimiter
+		logger      log.Logger
+	}
+)
+
+// NewGarbageCollector returns a GarbageCollector which deletes branches at the pace of the rate limiter.
+func NewGarbageCollector(
+	numShards int32,
+	db persistence.ExecutionManager,
+	client historyservice.HistoryServiceClient,
+	rateLimiter quotas.RateLimiter,
+	logger log.Logger,
+) *GarbageCollector {
+	return &GarbageCollector{
+		numShards:   numShards,
+		db:          db,
+		client:      client,
+		rateLimiter: rateLimiter,
+		logger:      logger,
+	}
+}
+
+// check returns why the branch of the task is garbage, or an empty reason if it isn't. The mutable state of the
+// workflow is returned if it exists.
+func (g *GarbageCollector) check(
+	ctx context.Context,
+	task taskDetail,
+) (string, *persistencepb.WorkflowMutableState, error) {
+	ms, err := g.client.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId: task.namespaceID,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: task.workflowID,
+			RunId:      task.runID,
+		},
+	})
+	switch err.(type) {
+	case nil:
+		if !branchReferenced(ms.GetDatabaseMutableState(), task.branchToken) {
+			return CandidateReasonNotReferenced, ms.GetDatabaseMutableState(), nil
+		}
+		return "", ms.GetDatabaseMutableState(), nil
+	case *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
+		return CandidateReasonMutableStateNotFound, nil, nil
+	default:
+		return "", nil, err
+	}
+}
+
+// branchReferenced returns true if one of the version histories of the mutable state is the given branch. Mutable
+// states without version histories are assumed to reference it.
+func branchReferenced(
+	ms *persistencepb.WorkflowMutableState,
+	branchToken []byte,
+) bool {
+	histories := ms.GetExecutionInfo().GetVersionHistories().GetHistories()
+	if len(histories) == 0 {
+		return true
+	}
+	branch, err := serialization.HistoryBranchFromBlob(branchToken, enumspb.ENCODING_TYPE_PROTO3.String())
+	if err != nil {
+		return true
+	}
+	for _, history := range histories {
+		referenced, err := serialization.HistoryBranchFromBlob(history.GetBranchToken(), enumspb.ENCODING_TYPE_PROTO3.String())
+		if err != nil {
+			continue
+		}
+		if referenced.GetTreeId() == branch.GetTreeId() && referenced.GetBranchId() == branch.GetBranchId() {
+			return true
+		}
+	}
+	return false
+}
+
+// size returns the size of the encoded events of a branch, or 0 if it can't be read.
+func (g *GarbageCollector) size(
+	ctx context.Context,
+	task taskDetail,
+) int64 {
+	var size int64
+	req := &persistence.ReadHistoryBranchRequest{
+		ShardID:     task.shardID,
+		BranchToken: task.branchToken,
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  common.EndEventID,
+		PageSize:    pageSize,
+	}
+	for {
+		resp, err := g.db.ReadRawHistoryBranch(ctx, req)
+		if err != nil {
+			if _, isNotFound := err.(*serviceerror.NotFound); !isNotFound {
+				g.logger.Warn("unable to read the size of a garbage history branch", getTaskLoggingTags(err, task)...)
+			}
+			return size
+		}
+		for _, blob := range resp.HistoryEventBlobs {
+			size += int64(len(blob.GetData()))
+		}
+		if len(resp.NextPageToken) == 0 {
+			return size
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+}
+
+func (g *GarbageCollector) delete(
+	ctx context.Context,
+	task taskDetail,
+) error {
+	err := g.db.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
+		ShardID:     task.shardID,
+		BranchToken: task.branchToken,
+	})
+	if err != nil {
+		g.logger.Error("encountered error when deleting garbage history branch", getTaskLoggingTags(err, task)...)
+	} else {
+		g.logger.Info("deleted history garbage", getTaskLoggingTags(nil, task)...)
+	}
+	return err
+}
+
+// Delete deletes branches reported by a dry run of the scavenger, once an operator confirmed them. Each branch is
+// checked again first, and skipped if it's not garbage anymore. The shard of a branch is derived from its workflow
+// rather than taken from the candidate.
+func (g *GarbageCollector) Delete(
+	ctx context.Context,
+	candidates []BranchCandidate,
+) (DeletionResult, error) {
+	var result DeletionResult
+	for i, candidate := range candidates {
+		if i > 0 && i%deletionBatchSize == 0 {
+			g.logger.Info("deleting history garbage",
+				tag.Counter(i),
+				tag.NewInt("total", len(candidates)),
+				tag.NewInt("deleted", result.Deleted),
+			)
+		}
+		if candidate.Reason == CandidateReasonCorrupted || candidate.NamespaceID == "" || candidate.WorkflowID == "" {
+			result.Skipped++
+			continue
+		}
+		if err := g.rateLimiter.Wait(ctx); err != nil {
+			return result, err
+		}
+
+		task := taskDetail{
+			shardID:     common.WorkflowIDToHistoryShard(candidate.NamespaceID, candidate.WorkflowID, g.numShards),
+			namespaceID: candidate.NamespaceID,
+			workflowID:  candidate.WorkflowID,
+			runID:       candidate.RunID,
+			branchToken: candidate.BranchToken,
+		}
+		reason, _, err := g.check(ctx, task)
+		if err != nil {
+			g.logger.Error("encounter error when describing the mutable state", getTaskLoggingTags(err, task)...)
+			result.Failed++
+			continue
+		}
+		if reason == "" {
+			result.Skipped++
+			continue
+		}
+		if err := g.delete(ctx, task); err != nil {
+			result.Failed++
+			continue
+		}
+		result.Deleted++
+	}
+	return result, nil
+}
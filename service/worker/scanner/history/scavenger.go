@@ -58,6 +58,12 @@ type (
 		CurrentPage  int
 
 		NextPageToken []byte
+
+		// Only set by a dry run: the garbage branches found, and their count and total size. At most
+		// maxReportedCandidates branches are listed.
+		Candidates     []BranchCandidate
+		CandidateCount int
+		CandidateBytes int64
 	}
 
 	// Scavenger is the type that holds the state for history scavenger daemon
@@ -76,6 +82,9 @@ type (
 		historyDataMinAge           dynamicconfig.DurationPropertyFn
 		executionDataDurationBuffer dynamicconfig.DurationPropertyFn
 		enableRetentionVerification dynamicconfig.BoolPropertyFn
+		// only report garbage branches instead of deleting them
+		dryRun dynamicconfig.BoolPropertyFn
+		gc     *GarbageCollector
 
 		sync.WaitGroup
 		sync.Mutex
@@ -102,7 +111,9 @@ const (
 // complete iteration over all of the history branches in the system. For
 // each branch, the scavenger will attempt
 //   - describe the corresponding workflow execution
-//   - deletion of history itself, if there are no workflow execution
+//   - deletion of history itself, if there are no workflow execution or it doesn't reference the branch
+//
+// In dry-run mode, the branches which would be deleted are only reported, with their sizes.
 func NewScavenger(
 	numShards int32,
 	db persistence.ExecutionManager,
@@ -114,22 +125,26 @@ func NewScavenger(
 	historyDataMinAge dynamicconfig.DurationPropertyFn,
 	executionDataDurationBuffer dynamicconfig.DurationPropertyFn,
 	enableRetentionVerification dynamicconfig.BoolPropertyFn,
+	dryRun dynamicconfig.BoolPropertyFn,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 ) *Scavenger {
 
+	rateLimiter := quotas.NewDefaultOutgoingRateLimiter(
+		func() float64 { return float64(rps) },
+	)
 	return &Scavenger{
-		numShards:   numShards,
-		db:          db,
-		client:      client,
-		adminClient: adminClient,
-		registry:    registry,
-		rateLimiter: quotas.NewDefaultOutgoingRateLimiter(
-			func() float64 { return float64(rps) },
-		),
+		numShards:                   numShards,
+		db:                          db,
+		client:                      client,
+		adminClient:                 adminClient,
+		registry:                    registry,
+		rateLimiter:                 rateLimiter,
 		historyDataMinAge:           historyDataMinAge,
 		executionDataDurationBuffer: executionDataDurationBuffer,
 		enableRetentionVerification: enableRetentionVerification,
+		dryRun:                      dryRun,
+		gc:                          NewGarbageCollector(numShards, db, client, rateLimiter, logger),
 		metricsHandler:              metricsHandler.WithTags(metrics.OperationTag(metrics.HistoryScavengerScope)),
 		logger:                      logger,
 
@@ -246,6 +261,12 @@ func (s *Scavenger) filterTask(
 		s.Lock()
 		defer s.Unlock()
 		s.hbd.ErrorCount++
+		if s.dryRun() {
+			s.reportLocked(BranchCandidate{
+				BranchToken: branch.BranchToken,
+				Reason:      CandidateReasonCorrupted,
+			})
+		}
 		return nil
 	}
 	shardID := common.WorkflowIDToHistoryShard(namespaceID, workflowID, s.numShards)
@@ -263,39 +284,49 @@ func (s *Scavenger) handleTask(
 	ctx context.Context,
 	task taskDetail,
 ) error {
-	// this checks if the mutableState still exists
+	// this checks if the mutableState still exists and references the branch
 	// if not then the history branch is garbage, we need to delete the history branch
-	ms, err := s.client.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
-		NamespaceId: task.namespaceID,
-		Execution: &commonpb.WorkflowExecution{
-			WorkflowId: task.workflowID,
-			RunId:      task.runID,
-		},
-	})
-	switch err.(type) {
-	case nil:
-		if s.enableRetentionVerification() {
-			return s.cleanUpWorkflowPastRetention(ctx, ms.GetDatabaseMutableState())
-		}
-		return nil
-	case *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
-		// case handled below
-	default:
+	reason, ms, err := s.gc.check(ctx, task)
+	if err != nil {
 		s.logger.Error("encounter error when describing the mutable state", getTaskLoggingTags(err, task)...)
 		return err
 	}
+	if reason == "" {
+		if ms != nil && s.enableRetentionVerification() && !s.dryRun() {
+			return s.cleanUpWorkflowPastRetention(ctx, ms)
+		}
+		return nil
+	}
+
+	if s.dryRun() {
+		s.report(BranchCandidate{
+			ShardID:     task.shardID,
+			NamespaceID: task.namespaceID,
+			WorkflowID:  task.workflowID,
+			RunID:       task.runID,
+			BranchToken: task.branchToken,
+			Reason:      reason,
+			SizeBytes:   s.gc.size(ctx, task),
+		})
+		return nil
+	}
 
 	//deleting history branch
-	err = s.db.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
-		ShardID:     task.shardID,
-		BranchToken: task.branchToken,
-	})
-	if err != nil {
-		s.logger.Error("encountered error when deleting garbage history branch", getTaskLoggingTags(err, task)...)
-	} else {
-		s.logger.Info("deleted history garbage", getTaskLoggingTags(nil, task)...)
+	return s.gc.delete(ctx, task)
+}
+
+func (s *Scavenger) report(candidate BranchCandidate) {
+	s.Lock()
+	defer s.Unlock()
+	s.reportLocked(candidate)
+}
+
+func (s *Scavenger) reportLocked(candidate BranchCandidate) {
+	s.hbd.CandidateCount++
+	s.hbd.CandidateBytes += candidate.SizeBytes
+	if len(s.hbd.Candidates) < maxReportedCandidates {
+		s.hbd.Candidates = append(s.hbd.Candidates, candidate)
 	}
-	return err
 }
 
 func (s *Scavenger) handleErr(
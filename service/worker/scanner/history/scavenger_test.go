@@ -39,6 +39,7 @@ import (
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/adminservicemock/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	persistencepb "go.temporal.io/server/api/persistence/v1"
@@ -50,6 +51,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
 )
 
 type (
@@ -92,7 +94,7 @@ func (s *ScavengerTestSuite) SetupTest() {
 	s.logger = log.NewTestLogger()
 	s.metricHandler = metrics.NoopMetricsHandler
 	s.numShards = 512
-	s.createTestScavenger(100)
+	s.createTestScavenger(100, false)
 }
 
 func (s *ScavengerTestSuite) TearDownTest() {
@@ -101,6 +103,7 @@ func (s *ScavengerTestSuite) TearDownTest() {
 
 func (s *ScavengerTestSuite) createTestScavenger(
 	rps int,
+	dryRun bool,
 ) {
 	s.controller = gomock.NewController(s.T())
 	s.mockExecutionManager = persistence.NewMockExecutionManager(s.controller)
@@ -121,6 +124,7 @@ func (s *ScavengerTestSuite) createTestScavenger(
 		dataAge,
 		executionDataAge,
 		enableRetentionVerification,
+		dynamicconfig.GetBoolPropertyFn(dryRun),
 		s.metricHandler,
 		s.logger,
 	)
@@ -672,3 +676,196 @@ func (s *ScavengerTestSuite) TestDeleteWorkflowAfterRetention() {
 	s.Equal(2, hbd.CurrentPage)
 	s.Equal(0, len(hbd.NextPageToken))
 }
+
+func (s *ScavengerTestSuite) mutableStateWithBranches(branchTokens ...[]byte) *historyservice.DescribeMutableStateResponse {
+	var histories []*historyspb.VersionHistory
+	for _, branchToken := range branchTokens {
+		histories = append(histories, &historyspb.VersionHistory{BranchToken: branchToken})
+	}
+	return &historyservice.DescribeMutableStateResponse{
+		DatabaseMutableState: &persistencepb.WorkflowMutableState{
+			ExecutionInfo: &persistencepb.WorkflowExecutionInfo{
+				LastUpdateTime:   timestamp.TimePtr(time.Now()),
+				VersionHistories: &historyspb.VersionHistories{Histories: histories},
+			},
+			ExecutionState: &persistencepb.WorkflowExecutionState{
+				State: enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+			},
+		},
+	}
+}
+
+func (s *ScavengerTestSuite) TestDryRunReportsGarbage() {
+	s.createTestScavenger(100, true)
+	s.mockExecutionManager.EXPECT().GetAllHistoryTreeBranches(gomock.Any(), &persistence.GetAllHistoryTreeBranchesRequest{
+		PageSize: pageSize,
+	}).Return(&persistence.GetAllHistoryTreeBranchesResponse{
+		Branches: []persistence.HistoryBranchDetail{
+			{
+				BranchToken: s.toBranchToken(treeID1, branchID1),
+				ForkTime:    timestamp.TimeNowPtrUtcAddDuration(-s.scavenger.historyDataMinAge() * 2),
+				Info:        persistence.BuildHistoryGarbageCleanupInfo("namespaceID1", "workflowID1", "runID1"),
+			},
+			{
+				BranchToken: s.toBranchToken(treeID2, branchID2),
+				ForkTime:    timestamp.TimeNowPtrUtcAddDuration(-s.scavenger.historyDataMinAge() * 2),
+				Info:        persistence.BuildHistoryGarbageCleanupInfo("namespaceID2", "workflowID2", "runID2"),
+			},
+			{
+				BranchToken: s.toBranchToken(treeID3, branchID3),
+				ForkTime:    timestamp.TimeNowPtrUtcAddDuration(-s.scavenger.historyDataMinAge() * 2),
+				Info:        persistence.BuildHistoryGarbageCleanupInfo("namespaceID3", "workflowID3", "runID3"),
+			},
+			{
+				BranchToken: s.toBranchToken(treeID4, branchID4),
+				ForkTime:    timestamp.TimeNowPtrUtcAddDuration(-s.scavenger.historyDataMinAge() * 2),
+				Info:        "corrupted",
+			},
+		},
+	}, nil)
+
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), &historyservice.DescribeMutableStateRequest{
+		NamespaceId: "namespaceID1",
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: "workflowID1",
+			RunId:      "runID1",
+		},
+	}).Return(nil, serviceerror.NewNotFound(""))
+	// a reset left behind the branch of workflow 2
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), &historyservice.DescribeMutableStateRequest{
+		NamespaceId: "namespaceID2",
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: "workflowID2",
+			RunId:      "runID2",
+		},
+	}).Return(s.mutableStateWithBranches(s.toBranchToken(treeID2, branchID5)), nil)
+	// past retention, but a dry run doesn't delete it
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), &historyservice.DescribeMutableStateRequest{
+		NamespaceId: "namespaceID3",
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: "workflowID3",
+			RunId:      "runID3",
+		},
+	}).Return(s.mutableStateWithBranches(s.toBranchToken(treeID3, branchID3)), nil)
+
+	s.mockExecutionManager.EXPECT().ReadRawHistoryBranch(gomock.Any(), &persistence.ReadHistoryBranchRequest{
+		ShardID:     common.WorkflowIDToHistoryShard("namespaceID1", "workflowID1", s.numShards),
+		BranchToken: s.toBranchToken(treeID1, branchID1),
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  common.EndEventID,
+		PageSize:    pageSize,
+	}).Return(&persistence.ReadRawHistoryBranchResponse{
+		HistoryEventBlobs: []*commonpb.DataBlob{{Data: make([]byte, 10)}, {Data: make([]byte, 5)}},
+	}, nil)
+	s.mockExecutionManager.EXPECT().ReadRawHistoryBranch(gomock.Any(), &persistence.ReadHistoryBranchRequest{
+		ShardID:     common.WorkflowIDToHistoryShard("namespaceID2", "workflowID2", s.numShards),
+		BranchToken: s.toBranchToken(treeID2, branchID2),
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  common.EndEventID,
+		PageSize:    pageSize,
+	}).Return(&persistence.ReadRawHistoryBranchResponse{
+		HistoryEventBlobs: []*commonpb.DataBlob{{Data: make([]byte, 7)}},
+	}, nil)
+
+	hbd, err := s.scavenger.Run(context.Background())
+	s.Nil(err)
+	s.Equal(3, hbd.SuccessCount)
+	s.Equal(1, hbd.ErrorCount)
+	s.Equal(3, hbd.CandidateCount)
+	s.Equal(int64(22), hbd.CandidateBytes)
+	s.Len(hbd.Candidates, 3)
+	reasons := make(map[string]BranchCandidate)
+	for _, candidate := range hbd.Candidates {
+		reasons[candidate.Reason] = candidate
+	}
+	s.Equal("workflowID1", reasons[CandidateReasonMutableStateNotFound].WorkflowID)
+	s.Equal(int64(15), reasons[CandidateReasonMutableStateNotFound].SizeBytes)
+	s.Equal("workflowID2", reasons[CandidateReasonNotReferenced].WorkflowID)
+	s.Equal(int64(7), reasons[CandidateReasonNotReferenced].SizeBytes)
+	s.Equal(s.toBranchToken(treeID4, branchID4), reasons[CandidateReasonCorrupted].BranchToken)
+}
+
+func (s *ScavengerTestSuite) TestDeletingBranchNotReferenced() {
+	s.mockExecutionManager.EXPECT().GetAllHistoryTreeBranches(gomock.Any(), &persistence.GetAllHistoryTreeBranchesRequest{
+		PageSize: pageSize,
+	}).Return(&persistence.GetAllHistoryTreeBranchesResponse{
+		Branches: []persistence.HistoryBranchDetail{
+			{
+				BranchToken: s.toBranchToken(treeID1, branchID1),
+				ForkTime:    timestamp.TimeNowPtrUtcAddDuration(-s.scavenger.historyDataMinAge() * 2),
+				Info:        persistence.BuildHistoryGarbageCleanupInfo("namespaceID1", "workflowID1", "runID1"),
+			},
+		},
+	}, nil)
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), &historyservice.DescribeMutableStateRequest{
+		NamespaceId: "namespaceID1",
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: "workflowID1",
+			RunId:      "runID1",
+		},
+	}).Return(s.mutableStateWithBranches(s.toBranchToken(treeID1, branchID2), s.toBranchToken(treeID1, branchID3)), nil)
+	s.mockExecutionManager.EXPECT().DeleteHistoryBranch(gomock.Any(), &persistence.DeleteHistoryBranchRequest{
+		BranchToken: s.toBranchToken(treeID1, branchID1),
+		ShardID:     common.WorkflowIDToHistoryShard("namespaceID1", "workflowID1", s.numShards),
+	}).Return(nil)
+
+	hbd, err := s.scavenger.Run(context.Background())
+	s.Nil(err)
+	s.Equal(1, hbd.SuccessCount)
+	s.Equal(0, hbd.ErrorCount)
+	s.Equal(0, hbd.CandidateCount)
+}
+
+func (s *ScavengerTestSuite) TestGarbageCollectorDelete() {
+	gc := NewGarbageCollector(
+		s.numShards,
+		s.mockExecutionManager,
+		s.mockHistoryClient,
+		quotas.NewDefaultOutgoingRateLimiter(func() float64 { return 100 }),
+		s.logger,
+	)
+	candidates := []BranchCandidate{
+		{
+			NamespaceID: "namespaceID1",
+			WorkflowID:  "workflowID1",
+			RunID:       "runID1",
+			BranchToken: s.toBranchToken(treeID1, branchID1),
+			Reason:      CandidateReasonMutableStateNotFound,
+		},
+		{
+			NamespaceID: "namespaceID2",
+			WorkflowID:  "workflowID2",
+			RunID:       "runID2",
+			BranchToken: s.toBranchToken(treeID2, branchID2),
+			Reason:      CandidateReasonNotReferenced,
+		},
+		{
+			BranchToken: s.toBranchToken(treeID3, branchID3),
+			Reason:      CandidateReasonCorrupted,
+		},
+	}
+
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), &historyservice.DescribeMutableStateRequest{
+		NamespaceId: "namespaceID1",
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: "workflowID1",
+			RunId:      "runID1",
+		},
+	}).Return(nil, serviceerror.NewNotFound(""))
+	// the branch is referenced again since the dry run
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), &historyservice.DescribeMutableStateRequest{
+		NamespaceId: "namespaceID2",
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: "workflowID2",
+			RunId:      "runID2",
+		},
+	}).Return(s.mutableStateWithBranches(s.toBranchToken(treeID2, branchID2)), nil)
+	s.mockExecutionManager.EXPECT().DeleteHistoryBranch(gomock.Any(), &persistence.DeleteHistoryBranchRequest{
+		BranchToken: s.toBranchToken(treeID1, branchID1),
+		ShardID:     common.WorkflowIDToHistoryShard("namespaceID1", "workflowID1", s.numShards),
+	}).Return(nil)
+
+	result, err := gc.Delete(context.Background(), candidates)
+	s.NoError(err)
+	s.Equal(DeletionResult{Deleted: 1, Skipped: 2}, result)
+}
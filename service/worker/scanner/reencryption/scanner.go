@@ -0,0 +1,266 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reencryption
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/encryption"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	ReencryptionScannerWorkflowName = "persistence-reencryption-scanner"
+	ReencryptionScannerActivityName = "reencrypt-executions"
+
+	ReencryptionScannerWFID          = "temporal-sys-persistence-reencryption-scanner"
+	ReencryptionScannerTaskQueueName = "temporal-sys-persistence-reencryption-scanner-taskqueue-0"
+)
+
+var (
+	ReencryptionScannerWFStartOptions = client.StartWorkflowOptions{
+		ID:                    ReencryptionScannerWFID,
+		TaskQueue:             ReencryptionScannerTaskQueueName,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		CronSchedule:          "0 0 * * *",
+	}
+)
+
+type (
+	ReencryptionScannerInput struct {
+		ExecutionListPageSize int
+	}
+
+	Activities struct {
+		logger           log.Logger
+		metricsHandler   metrics.Handler
+		executionManager persistence.ExecutionManager
+		historyClient    historyservice.HistoryServiceClient
+		numShards        int32
+		perHostQPS       dynamicconfig.IntPropertyFn
+	}
+
+	heartbeatDetails struct {
+		ShardID   int32
+		PageToken []byte
+	}
+)
+
+func NewActivities(
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	executionManager persistence.ExecutionManager,
+	historyClient historyservice.HistoryServiceClient,
+	numShards int32,
+	perHostQPS dynamicconfig.IntPropertyFn,
+) *Activities {
+	return &Activities{
+		logger:           logger,
+		metricsHandler:   metricsHandler.WithTags(metrics.OperationTag(metrics.ReencryptionScannerScope)),
+		executionManager: executionManager,
+		historyClient:    historyClient,
+		numShards:        numShards,
+		perHostQPS:       perHostQPS,
+	}
+}
+
+// ReencryptionScannerWorkflow rewrites mutable state records which are not encrypted with the current
+// persistence encryption key, so that retired keys can eventually be removed.
+// This workflow is a wrapper around the long running ReencryptExecutions activity.
+func ReencryptionScannerWorkflow(ctx workflow.Context, input ReencryptionScannerInput) error {
+	activityCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		// Give the activity enough time to scan all the shards
+		StartToCloseTimeout: 24 * time.Hour,
+		HeartbeatTimeout:    5 * time.Minute,
+	})
+	return workflow.ExecuteActivity(activityCtx, ReencryptionScannerActivityName, input).Get(ctx, nil)
+}
+
+func (a *Activities) setDefaults(input *ReencryptionScannerInput) {
+	if input.ExecutionListPageSize == 0 {
+		input.ExecutionListPageSize = 100
+	}
+}
+
+// ReencryptExecutions scans all executions and rewrites the mutable state of those read with a stale key.
+// History nodes are immutable and are not rewritten, so the keys they were encrypted with must be kept
+// until the history is deleted by retention.
+func (a *Activities) ReencryptExecutions(ctx context.Context, input ReencryptionScannerInput) error {
+	a.setDefaults(&input)
+
+	var heartbeat heartbeatDetails
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &heartbeat); err != nil {
+			return temporal.NewNonRetryableApplicationError("failed to load previous heartbeat details", "TypeError", err)
+		}
+	}
+	if heartbeat.ShardID == 0 {
+		heartbeat.ShardID = 1
+	}
+
+	rps := float64(a.perHostQPS())
+	rateLimiter := quotas.NewRateLimiter(rps, int(math.Ceil(rps)))
+	var rangeID int64
+	for heartbeat.ShardID <= a.numShards {
+		if err := rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+		resp, err := a.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+			ShardID:   heartbeat.ShardID,
+			PageSize:  input.ExecutionListPageSize,
+			PageToken: heartbeat.PageToken,
+		})
+		if err != nil {
+			return err
+		}
+		for _, state := range resp.States {
+			if rangeID == 0 {
+				if rangeID, err = a.getRangeID(ctx, heartbeat.ShardID); err != nil {
+					return err
+				}
+			}
+			if err := rateLimiter.Wait(ctx); err != nil {
+				return err
+			}
+			err := a.reencryptExecution(ctx, heartbeat.ShardID, rangeID, state)
+			switch err.(type) {
+			case nil:
+			case *persistence.ShardOwnershipLostError:
+				// The shard moved, reload the range ID for the next execution. This one is picked up by the next scan.
+				rangeID = 0
+				a.metricsHandler.Counter(metrics.ExecutionsReencryptionSkipped.GetMetricName()).Record(1)
+			case *persistence.WorkflowConditionFailedError, *persistence.ConditionFailedError:
+				// The execution was updated concurrently and thus already written with the current key.
+			default:
+				return err
+			}
+		}
+		heartbeat.PageToken = resp.PageToken
+		if len(heartbeat.PageToken) == 0 {
+			heartbeat.ShardID++
+			rangeID = 0
+		}
+		activity.RecordHeartbeat(ctx, heartbeat)
+	}
+	return nil
+}
+
+func (a *Activities) getRangeID(ctx context.Context, shardID int32) (int64, error) {
+	resp, err := a.historyClient.GetShard(ctx, &historyservice.GetShardRequest{ShardId: shardID})
+	if err != nil {
+		return 0, err
+	}
+	return resp.GetShardInfo().GetRangeId(), nil
+}
+
+func (a *Activities) reencryptExecution(
+	ctx context.Context,
+	shardID int32,
+	rangeID int64,
+	state *persistencespb.WorkflowMutableState,
+) error {
+	usageCtx, keyUsage := encryption.WithKeyUsage(ctx)
+	resp, err := a.executionManager.GetWorkflowExecution(usageCtx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: state.GetExecutionInfo().GetNamespaceId(),
+		WorkflowID:  state.GetExecutionInfo().GetWorkflowId(),
+		RunID:       state.GetExecutionState().GetRunId(),
+	})
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return nil
+		}
+		return err
+	}
+	if keyUsage.StaleBlobs() == 0 {
+		return nil
+	}
+	if len(resp.State.BufferedEvents) > 0 {
+		// Buffered events can't be rewritten with a snapshot, the next scan picks this execution up once they are flushed.
+		a.metricsHandler.Counter(metrics.ExecutionsReencryptionSkipped.GetMetricName()).Record(1)
+		return nil
+	}
+
+	// The write is conditioned on the record version, so it fails if history updated the execution in the meantime,
+	// and history reloads the mutable state when its own next write fails because of this one.
+	if _, err := a.executionManager.SetWorkflowExecution(ctx, &persistence.SetWorkflowExecutionRequest{
+		ShardID:             shardID,
+		RangeID:             rangeID,
+		SetWorkflowSnapshot: snapshotFromMutableState(resp.State, resp.DBRecordVersion),
+	}); err != nil {
+		return err
+	}
+	a.metricsHandler.Counter(metrics.ExecutionsReencrypted.GetMetricName()).Record(1)
+	a.logger.Debug("Re-encrypted workflow execution",
+		tag.ShardID(shardID),
+		tag.WorkflowNamespaceID(state.GetExecutionInfo().GetNamespaceId()),
+		tag.WorkflowID(state.GetExecutionInfo().GetWorkflowId()),
+		tag.WorkflowRunID(state.GetExecutionState().GetRunId()))
+	return nil
+}
+
+func snapshotFromMutableState(
+	state *persistencespb.WorkflowMutableState,
+	dbRecordVersion int64,
+) persistence.WorkflowSnapshot {
+	signalRequestedIDs := make(map[string]struct{}, len(state.SignalRequestedIds))
+	for _, id := range state.SignalRequestedIds {
+		signalRequestedIDs[id] = struct{}{}
+	}
+	return persistence.WorkflowSnapshot{
+		ExecutionInfo:  state.ExecutionInfo,
+		ExecutionState: state.ExecutionState,
+		NextEventID:    state.NextEventId,
+
+		ActivityInfos:       state.ActivityInfos,
+		TimerInfos:          state.TimerInfos,
+		ChildExecutionInfos: state.ChildExecutionInfos,
+		RequestCancelInfos:  state.RequestCancelInfos,
+		SignalInfos:         state.SignalInfos,
+		SignalRequestedIDs:  signalRequestedIDs,
+
+		Condition:       state.NextEventId,
+		DBRecordVersion: dbRecordVersion + 1,
+		Checksum:        state.Checksum,
+	}
+}
@@ -0,0 +1,137 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reencryption
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/encryption"
+)
+
+func newTestMutableState(workflowID string, bufferedEvents int) *persistencespb.WorkflowMutableState {
+	state := &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId: "namespace-id",
+			WorkflowId:  workflowID,
+		},
+		ExecutionState:     &persistencespb.WorkflowExecutionState{RunId: workflowID + "-run"},
+		NextEventId:        10,
+		SignalRequestedIds: []string{"signal-id"},
+	}
+	for i := 0; i < bufferedEvents; i++ {
+		state.BufferedEvents = append(state.BufferedEvents, nil)
+	}
+	return state
+}
+
+func Test_ReencryptExecutions(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	ctrl := gomock.NewController(t)
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	historyClient := historyservicemock.NewMockHistoryServiceClient(ctrl)
+
+	a := NewActivities(
+		log.NewTestLogger(),
+		metrics.NoopMetricsHandler,
+		executionManager,
+		historyClient,
+		1,
+		dynamicconfig.GetIntPropertyFn(1000),
+	)
+	env.RegisterActivityWithOptions(a.ReencryptExecutions, activity.RegisterOptions{Name: ReencryptionScannerActivityName})
+
+	keyProvider, err := encryption.NewStaticKeyProvider(&config.PersistenceEncryption{
+		CurrentKeyID: "key-1",
+		Keys:         map[string]string{"key-1": base64.StdEncoding.EncodeToString(make([]byte, 32))},
+	})
+	require.NoError(t, err)
+	encryptor := encryption.NewEncryptor(keyProvider)
+
+	current := newTestMutableState("current", 0)
+	stale := newTestMutableState("stale", 0)
+	buffered := newTestMutableState("buffered", 1)
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:  1,
+		PageSize: 100,
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{current, stale, buffered},
+	}, nil)
+	historyClient.EXPECT().GetShard(gomock.Any(), &historyservice.GetShardRequest{ShardId: 1}).Return(
+		&historyservice.GetShardResponse{ShardInfo: &persistencespb.ShardInfo{RangeId: 42}}, nil,
+	)
+	executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *persistence.GetWorkflowExecutionRequest) (*persistence.GetWorkflowExecutionResponse, error) {
+			state := map[string]*persistencespb.WorkflowMutableState{
+				"current":  current,
+				"stale":    stale,
+				"buffered": buffered,
+			}[request.WorkflowID]
+			// Simulate the encryption store reading a blob, the current execution is already encrypted with the current key.
+			blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("data")}
+			if request.WorkflowID == "current" {
+				if blob, err = encryptor.Encrypt(blob); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := encryptor.Decrypt(ctx, blob); err != nil {
+				return nil, err
+			}
+			return &persistence.GetWorkflowExecutionResponse{State: state, DBRecordVersion: 5}, nil
+		}).Times(3)
+	executionManager.EXPECT().SetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.SetWorkflowExecutionRequest) (*persistence.SetWorkflowExecutionResponse, error) {
+			require.Equal(t, int32(1), request.ShardID)
+			require.Equal(t, int64(42), request.RangeID)
+			snapshot := request.SetWorkflowSnapshot
+			require.Equal(t, "stale", snapshot.ExecutionInfo.WorkflowId)
+			require.Equal(t, int64(6), snapshot.DBRecordVersion)
+			require.Equal(t, int64(10), snapshot.Condition)
+			require.Equal(t, int64(10), snapshot.NextEventID)
+			require.Equal(t, map[string]struct{}{"signal-id": {}}, snapshot.SignalRequestedIDs)
+			return &persistence.SetWorkflowExecutionResponse{}, nil
+		})
+
+	_, err = env.ExecuteActivity(ReencryptionScannerActivityName, ReencryptionScannerInput{})
+	require.NoError(t, err)
+}
@@ -39,15 +39,25 @@ import (
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/leaderelection"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/sdk"
+	"go.temporal.io/server/service/worker/scanner/archiveexpiry"
 	"go.temporal.io/server/service/worker/scanner/build_ids"
+	"go.temporal.io/server/service/worker/scanner/reencryption"
+	"go.temporal.io/server/service/worker/scanner/storageusage"
+	"go.temporal.io/server/service/worker/scanner/tablepartition"
+	"go.temporal.io/server/service/worker/scanner/visibilityconsistency"
+	"go.temporal.io/server/service/worker/scanner/visibilityexport"
 
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -74,11 +84,43 @@ type (
 		HistoryScannerEnabled dynamicconfig.BoolPropertyFn
 		// ExecutionsScannerEnabled indicates if executions scanner should be started as part of scanner
 		ExecutionsScannerEnabled dynamicconfig.BoolPropertyFn
+		// StorageUsageScannerEnabled indicates if the storage usage scanner should be started as part of scanner
+		StorageUsageScannerEnabled dynamicconfig.BoolPropertyFn
+		// StorageUsageScannerPerHostQPS the max rate of persistence calls made by the storage usage scanner
+		StorageUsageScannerPerHostQPS dynamicconfig.IntPropertyFn
+		// ReencryptionScannerEnabled indicates if the persistence re-encryption scanner should be started as part of scanner
+		ReencryptionScannerEnabled dynamicconfig.BoolPropertyFn
+		// ReencryptionScannerPerHostQPS the max rate of persistence calls made by the persistence re-encryption scanner
+		ReencryptionScannerPerHostQPS dynamicconfig.IntPropertyFn
+		// VisibilityConsistencyScannerEnabled indicates if the visibility consistency scanner should be started as part of scanner
+		VisibilityConsistencyScannerEnabled dynamicconfig.BoolPropertyFn
+		// VisibilityConsistencyScannerPerHostQPS the max rate of persistence calls made by the visibility consistency scanner
+		VisibilityConsistencyScannerPerHostQPS dynamicconfig.IntPropertyFn
+		// VisibilityConsistencyScannerRepairEnabled indicates if the visibility consistency scanner repairs inconsistencies
+		VisibilityConsistencyScannerRepairEnabled dynamicconfig.BoolPropertyFn
+		// VisibilityExportScannerEnabled indicates if the visibility export scanner should be started as part of scanner
+		VisibilityExportScannerEnabled dynamicconfig.BoolPropertyFn
+		// VisibilityExportScannerPerHostQPS the max rate of visibility calls made by the visibility export scanner
+		VisibilityExportScannerPerHostQPS dynamicconfig.IntPropertyFn
+		// VisibilityExportDelay is how long after their close visibility records are exported
+		VisibilityExportDelay dynamicconfig.DurationPropertyFn
+		// VisibilityExportProvider configures access to the object storage visibility records are exported to
+		VisibilityExportProvider *config.VisibilityArchiverProvider
+		// ArchiveExpiryScannerEnabled indicates if the archive expiry scanner should be started as part of scanner
+		ArchiveExpiryScannerEnabled dynamicconfig.BoolPropertyFn
+		// ArchiveExpiryScannerPerHostQPS the max rate of archiver calls made by the archive expiry scanner
+		ArchiveExpiryScannerPerHostQPS dynamicconfig.IntPropertyFn
+		// TablePartitionScannerEnabled indicates if the SQL table partition scanner should be started as part of scanner
+		TablePartitionScannerEnabled dynamicconfig.BoolPropertyFn
+		// TablePartitionShardsPerPartition is the number of shards held by each partition created by the table partition scanner
+		TablePartitionShardsPerPartition dynamicconfig.IntPropertyFn
 		// HistoryScannerDataMinAge indicates the cleanup threshold of history branch data
 		// Only clean up history branches that older than this threshold
 		HistoryScannerDataMinAge dynamicconfig.DurationPropertyFn
 		// HistoryScannerVerifyRetention indicates if the history scavenger to do retention verification
 		HistoryScannerVerifyRetention dynamicconfig.BoolPropertyFn
+		// HistoryScannerDryRun indicates if the history scavenger only reports garbage history branches
+		HistoryScannerDryRun dynamicconfig.BoolPropertyFn
 		// ExecutionScannerPerHostQPS the max rate of calls to scan execution data per host
 		ExecutionScannerPerHostQPS dynamicconfig.IntPropertyFn
 		// ExecutionScannerPerShardQPS the max rate of calls to scan execution data per shard
@@ -89,6 +131,12 @@ type (
 		ExecutionScannerWorkerCount dynamicconfig.IntPropertyFn
 		// ExecutionScannerHistoryEventIdValidator indicates if the execution scavenger to validate history event id.
 		ExecutionScannerHistoryEventIdValidator dynamicconfig.BoolPropertyFn
+		// LeaderElectionEnabled indicates if scanners only run on the worker host holding the scanner lease
+		LeaderElectionEnabled dynamicconfig.BoolPropertyFn
+		// LeaseDuration is how long the scanner lease stays valid without being renewed
+		LeaseDuration dynamicconfig.DurationPropertyFn
+		// LeaseRenewInterval is how often the scanner lease is renewed
+		LeaseRenewInterval dynamicconfig.DurationPropertyFn
 	}
 
 	// scannerContext is the context object that gets
@@ -107,6 +155,10 @@ type (
 		adminClient        adminservice.AdminServiceClient
 		namespaceRegistry  namespace.Registry
 		currentClusterName string
+
+		persistenceServiceResolver resolver.ServiceResolver
+		archiverProvider           provider.ArchiverProvider
+		namespaceReplicationQueue  persistence.NamespaceReplicationQueue
 	}
 
 	// Scanner is the background sub-system that does full scans
@@ -114,6 +166,7 @@ type (
 	// and emit stats for analytics
 	Scanner struct {
 		context         scannerContext
+		elector         *leaderelection.Elector
 		wg              sync.WaitGroup
 		lifecycleCancel context.CancelFunc
 	}
@@ -131,6 +184,7 @@ func New(
 	metricsHandler metrics.Handler,
 	executionManager persistence.ExecutionManager,
 	metadataManager persistence.MetadataManager,
+	namespaceReplicationQueue persistence.NamespaceReplicationQueue,
 	visibilityManager manager.VisibilityManager,
 	taskManager persistence.TaskManager,
 	historyClient historyservice.HistoryServiceClient,
@@ -138,6 +192,8 @@ func New(
 	matchingClient matchingservice.MatchingServiceClient,
 	registry namespace.Registry,
 	currentClusterName string,
+	persistenceServiceResolver resolver.ServiceResolver,
+	archiverProvider provider.ArchiverProvider,
 ) *Scanner {
 	return &Scanner{
 		context: scannerContext{
@@ -154,7 +210,21 @@ func New(
 			adminClient:        adminClient,
 			namespaceRegistry:  registry,
 			currentClusterName: currentClusterName,
+
+			persistenceServiceResolver: persistenceServiceResolver,
+			archiverProvider:           archiverProvider,
+			namespaceReplicationQueue:  namespaceReplicationQueue,
 		},
+		elector: leaderelection.NewElector(
+			scannerLeaseName,
+			&leaderelection.Config{
+				LeaseDuration: cfg.LeaseDuration,
+				RenewInterval: cfg.LeaseRenewInterval,
+			},
+			taskManager,
+			clock.NewRealTimeSource(),
+			logger,
+		),
 	}
 }
 
@@ -164,6 +234,58 @@ func (s *Scanner) Start() error {
 	ctx = headers.SetCallerInfo(ctx, headers.SystemBackgroundCallerInfo)
 	ctx, s.lifecycleCancel = context.WithCancel(ctx)
 
+	if !s.context.cfg.LeaderElectionEnabled() {
+		// without leader election there is no term to end, a failed worker stays stopped
+		_, err := s.startScanners(ctx, s.logWorkerFatalError)
+		return err
+	}
+
+	// Scanners run only while this host holds the scanner lease, so that a worker host cut off by a
+	// network partition stops scanning before another host takes over.
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.elector.Run(ctx, s.runScanners)
+	}()
+	return nil
+}
+
+func (s *Scanner) Stop() {
+	s.lifecycleCancel()
+	s.wg.Wait()
+}
+
+// runScanners runs the scanners for one leadership term. If they fail to start, or a worker fails fatally, the
+// workers are stopped and the error is returned, so that leadership is released and the scanners are retried on
+// the next term.
+func (s *Scanner) runScanners(ctx context.Context, _ int64) error {
+	fatalErrCh := make(chan error, 1)
+	workers, err := s.startScanners(ctx, func(err error) {
+		s.logWorkerFatalError(err)
+		select {
+		case fatalErrCh <- err:
+		default:
+		}
+	})
+	if err == nil {
+		select {
+		case <-ctx.Done():
+		case err = <-fatalErrCh:
+		}
+	}
+	for _, work := range workers {
+		work.Stop()
+	}
+	return err
+}
+
+func (s *Scanner) logWorkerFatalError(err error) {
+	s.context.logger.Error("Scanner worker stopped on fatal error.", tag.Error(err))
+}
+
+// startScanners starts the scanner workflows and workers. Activities run with ctx as their background
+// context, so they are canceled together with it. onFatalError is called when a worker stops on a fatal error.
+func (s *Scanner) startScanners(ctx context.Context, onFatalError func(error)) ([]worker.Worker, error) {
 	workerOpts := worker.Options{
 		MaxConcurrentActivityExecutionSize:     s.context.cfg.MaxConcurrentActivityExecutionSize(),
 		MaxConcurrentWorkflowTaskExecutionSize: s.context.cfg.MaxConcurrentWorkflowTaskExecutionSize(),
@@ -171,8 +293,10 @@ func (s *Scanner) Start() error {
 		MaxConcurrentWorkflowTaskPollers:       s.context.cfg.MaxConcurrentWorkflowTaskPollers(),
 
 		BackgroundActivityContext: ctx,
+		OnFatalError:              onFatalError,
 	}
 
+	var workers []worker.Worker
 	var workerTaskQueueNames []string
 	if s.context.cfg.ExecutionsScannerEnabled() {
 		s.wg.Add(1)
@@ -210,10 +334,156 @@ func (s *Scanner) Start() error {
 		work.RegisterWorkflowWithOptions(build_ids.BuildIdScavangerWorkflow, workflow.RegisterOptions{Name: build_ids.BuildIdScavangerWorkflowName})
 		work.RegisterActivityWithOptions(buildIdsActivities.ScavengeBuildIds, activity.RegisterOptions{Name: build_ids.BuildIdScavangerActivityName})
 
-		// TODO: Nothing is gracefully stopping these workers or listening for fatal errors.
 		if err := work.Start(); err != nil {
-			return err
+			return workers, err
+		}
+		workers = append(workers, work)
+	}
+
+	if s.context.cfg.StorageUsageScannerEnabled() {
+		s.wg.Add(1)
+		go s.startWorkflowWithRetry(ctx, storageusage.StorageUsageScannerWFStartOptions, storageusage.StorageUsageScannerWorkflowName)
+
+		storageUsageActivities := storageusage.NewActivities(
+			s.context.logger,
+			s.context.metricsHandler,
+			s.context.executionManager,
+			s.context.metadataManager,
+			namespace.NewNamespaceReplicator(s.context.namespaceReplicationQueue, s.context.logger),
+			s.context.cfg.Persistence.NumHistoryShards,
+			s.context.cfg.StorageUsageScannerPerHostQPS,
+			s.context.currentClusterName,
+		)
+
+		work := s.context.sdkClientFactory.NewWorker(s.context.sdkClientFactory.GetSystemClient(), storageusage.StorageUsageScannerTaskQueueName, workerOpts)
+		work.RegisterWorkflowWithOptions(storageusage.StorageUsageScannerWorkflow, workflow.RegisterOptions{Name: storageusage.StorageUsageScannerWorkflowName})
+		work.RegisterActivityWithOptions(storageUsageActivities.ScanStorageUsage, activity.RegisterOptions{Name: storageusage.StorageUsageScannerActivityName})
+
+		if err := work.Start(); err != nil {
+			return workers, err
+		}
+		workers = append(workers, work)
+	}
+
+	if s.context.cfg.ReencryptionScannerEnabled() {
+		s.wg.Add(1)
+		go s.startWorkflowWithRetry(ctx, reencryption.ReencryptionScannerWFStartOptions, reencryption.ReencryptionScannerWorkflowName)
+
+		reencryptionActivities := reencryption.NewActivities(
+			s.context.logger,
+			s.context.metricsHandler,
+			s.context.executionManager,
+			s.context.historyClient,
+			s.context.cfg.Persistence.NumHistoryShards,
+			s.context.cfg.ReencryptionScannerPerHostQPS,
+		)
+
+		work := s.context.sdkClientFactory.NewWorker(s.context.sdkClientFactory.GetSystemClient(), reencryption.ReencryptionScannerTaskQueueName, workerOpts)
+		work.RegisterWorkflowWithOptions(reencryption.ReencryptionScannerWorkflow, workflow.RegisterOptions{Name: reencryption.ReencryptionScannerWorkflowName})
+		work.RegisterActivityWithOptions(reencryptionActivities.ReencryptExecutions, activity.RegisterOptions{Name: reencryption.ReencryptionScannerActivityName})
+
+		if err := work.Start(); err != nil {
+			return workers, err
+		}
+		workers = append(workers, work)
+	}
+
+	if s.context.cfg.VisibilityConsistencyScannerEnabled() {
+		s.wg.Add(1)
+		go s.startWorkflowWithRetry(ctx, visibilityconsistency.VisibilityConsistencyScannerWFStartOptions, visibilityconsistency.VisibilityConsistencyScannerWorkflowName)
+
+		visibilityConsistencyActivities := visibilityconsistency.NewActivities(
+			s.context.logger,
+			s.context.metricsHandler,
+			s.context.executionManager,
+			s.context.visibilityManager,
+			s.context.metadataManager,
+			s.context.namespaceRegistry,
+			s.context.historyClient,
+			s.context.cfg.Persistence.NumHistoryShards,
+			s.context.cfg.VisibilityConsistencyScannerPerHostQPS,
+			s.context.cfg.VisibilityConsistencyScannerRepairEnabled,
+		)
+
+		work := s.context.sdkClientFactory.NewWorker(s.context.sdkClientFactory.GetSystemClient(), visibilityconsistency.VisibilityConsistencyScannerTaskQueueName, workerOpts)
+		work.RegisterWorkflowWithOptions(visibilityconsistency.VisibilityConsistencyScannerWorkflow, workflow.RegisterOptions{Name: visibilityconsistency.VisibilityConsistencyScannerWorkflowName})
+		work.RegisterActivityWithOptions(visibilityConsistencyActivities.CheckVisibilityConsistency, activity.RegisterOptions{Name: visibilityconsistency.VisibilityConsistencyScannerActivityName})
+
+		if err := work.Start(); err != nil {
+			return workers, err
 		}
+		workers = append(workers, work)
+	}
+
+	if s.context.cfg.VisibilityExportScannerEnabled() {
+		s.wg.Add(1)
+		go s.startWorkflowWithRetry(ctx, visibilityexport.VisibilityExportScannerWFStartOptions, visibilityexport.VisibilityExportScannerWorkflowName)
+
+		visibilityExportActivities := visibilityexport.NewActivities(
+			s.context.logger,
+			s.context.metricsHandler,
+			s.context.visibilityManager,
+			s.context.metadataManager,
+			s.context.cfg.VisibilityExportScannerPerHostQPS,
+			s.context.cfg.VisibilityExportDelay,
+			s.context.currentClusterName,
+			s.context.cfg.VisibilityExportProvider,
+		)
+
+		work := s.context.sdkClientFactory.NewWorker(s.context.sdkClientFactory.GetSystemClient(), visibilityexport.VisibilityExportScannerTaskQueueName, workerOpts)
+		work.RegisterWorkflowWithOptions(visibilityexport.VisibilityExportScannerWorkflow, workflow.RegisterOptions{Name: visibilityexport.VisibilityExportScannerWorkflowName})
+		work.RegisterActivityWithOptions(visibilityExportActivities.ExportVisibility, activity.RegisterOptions{Name: visibilityexport.VisibilityExportScannerActivityName})
+
+		if err := work.Start(); err != nil {
+			return workers, err
+		}
+		workers = append(workers, work)
+	}
+
+	if s.context.cfg.ArchiveExpiryScannerEnabled() {
+		s.wg.Add(1)
+		go s.startWorkflowWithRetry(ctx, archiveexpiry.ArchiveExpiryScannerWFStartOptions, archiveexpiry.ArchiveExpiryScannerWorkflowName)
+
+		archiveExpiryActivities := archiveexpiry.NewActivities(
+			s.context.logger,
+			s.context.metricsHandler,
+			s.context.metadataManager,
+			s.context.archiverProvider,
+			s.context.cfg.ArchiveExpiryScannerPerHostQPS,
+			s.context.currentClusterName,
+		)
+
+		work := s.context.sdkClientFactory.NewWorker(s.context.sdkClientFactory.GetSystemClient(), archiveexpiry.ArchiveExpiryScannerTaskQueueName, workerOpts)
+		work.RegisterWorkflowWithOptions(archiveexpiry.ArchiveExpiryScannerWorkflow, workflow.RegisterOptions{Name: archiveexpiry.ArchiveExpiryScannerWorkflowName})
+		work.RegisterActivityWithOptions(archiveExpiryActivities.ExpireArchivedHistories, activity.RegisterOptions{Name: archiveexpiry.ArchiveExpiryScannerActivityName})
+
+		if err := work.Start(); err != nil {
+			return workers, err
+		}
+		workers = append(workers, work)
+	}
+
+	if s.context.cfg.Persistence.DefaultStoreType() == config.StoreTypeSQL && s.context.cfg.TablePartitionScannerEnabled() {
+		s.wg.Add(1)
+		go s.startWorkflowWithRetry(ctx, tablepartition.TablePartitionScannerWFStartOptions, tablepartition.TablePartitionScannerWorkflowName)
+
+		tablePartitionActivities := tablepartition.NewActivities(
+			s.context.logger,
+			s.context.metricsHandler,
+			s.context.cfg.Persistence.DataStores[s.context.cfg.Persistence.DefaultStore].SQL,
+			s.context.persistenceServiceResolver,
+			s.context.cfg.Persistence.NumHistoryShards,
+			s.context.cfg.TablePartitionShardsPerPartition,
+		)
+
+		work := s.context.sdkClientFactory.NewWorker(s.context.sdkClientFactory.GetSystemClient(), tablepartition.TablePartitionScannerTaskQueueName, workerOpts)
+		work.RegisterWorkflowWithOptions(tablepartition.TablePartitionScannerWorkflow, workflow.RegisterOptions{Name: tablepartition.TablePartitionScannerWorkflowName})
+		work.RegisterActivityWithOptions(tablePartitionActivities.ManageTablePartitions, activity.RegisterOptions{Name: tablepartition.TablePartitionScannerActivityName})
+
+		if err := work.Start(); err != nil {
+			return workers, err
+		}
+		workers = append(workers, work)
 	}
 
 	// TODO: There's no reason to register all activities and workflows on every task queue.
@@ -227,18 +497,13 @@ func (s *Scanner) Start() error {
 		work.RegisterActivityWithOptions(HistoryScavengerActivity, activity.RegisterOptions{Name: historyScavengerActivityName})
 		work.RegisterActivityWithOptions(ExecutionsScavengerActivity, activity.RegisterOptions{Name: executionsScavengerActivityName})
 
-		// TODO: Nothing is gracefully stopping these workers or listening for fatal errors.
 		if err := work.Start(); err != nil {
-			return err
+			return workers, err
 		}
+		workers = append(workers, work)
 	}
 
-	return nil
-}
-
-func (s *Scanner) Stop() {
-	s.lifecycleCancel()
-	s.wg.Wait()
+	return workers, nil
 }
 
 func (s *Scanner) startWorkflowWithRetry(ctx context.Context, options sdkclient.StartWorkflowOptions, workflowType string, workflowArgs ...interface{}) {
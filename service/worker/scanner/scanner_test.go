@@ -28,10 +28,13 @@ import (
 	"context"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/client"
+	sdkworker "go.temporal.io/sdk/worker"
 
 	"go.temporal.io/server/api/adminservicemock/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
@@ -43,7 +46,13 @@ import (
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/testing/mocksdk"
+	"go.temporal.io/server/service/worker/scanner/archiveexpiry"
 	"go.temporal.io/server/service/worker/scanner/build_ids"
+	"go.temporal.io/server/service/worker/scanner/reencryption"
+	"go.temporal.io/server/service/worker/scanner/storageusage"
+	"go.temporal.io/server/service/worker/scanner/tablepartition"
+	"go.temporal.io/server/service/worker/scanner/visibilityconsistency"
+	"go.temporal.io/server/service/worker/scanner/visibilityexport"
 )
 
 type scannerTestSuite struct {
@@ -76,15 +85,45 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 		WFTypeName:    build_ids.BuildIdScavangerWorkflowName,
 		TaskQueueName: build_ids.BuildIdScavengerTaskQueueName,
 	}
+	storageUsageScanner := expectedScanner{
+		WFTypeName:    storageusage.StorageUsageScannerWorkflowName,
+		TaskQueueName: storageusage.StorageUsageScannerTaskQueueName,
+	}
+	reencryptionScanner := expectedScanner{
+		WFTypeName:    reencryption.ReencryptionScannerWorkflowName,
+		TaskQueueName: reencryption.ReencryptionScannerTaskQueueName,
+	}
+	tablePartitionScanner := expectedScanner{
+		WFTypeName:    tablepartition.TablePartitionScannerWorkflowName,
+		TaskQueueName: tablepartition.TablePartitionScannerTaskQueueName,
+	}
+	visibilityConsistencyScanner := expectedScanner{
+		WFTypeName:    visibilityconsistency.VisibilityConsistencyScannerWorkflowName,
+		TaskQueueName: visibilityconsistency.VisibilityConsistencyScannerTaskQueueName,
+	}
+	visibilityExportScanner := expectedScanner{
+		WFTypeName:    visibilityexport.VisibilityExportScannerWorkflowName,
+		TaskQueueName: visibilityexport.VisibilityExportScannerTaskQueueName,
+	}
+	archiveExpiryScanner := expectedScanner{
+		WFTypeName:    archiveexpiry.ArchiveExpiryScannerWorkflowName,
+		TaskQueueName: archiveexpiry.ArchiveExpiryScannerTaskQueueName,
+	}
 
 	type testCase struct {
-		Name                     string
-		ExecutionsScannerEnabled bool
-		TaskQueueScannerEnabled  bool
-		HistoryScannerEnabled    bool
-		BuildIdScavengerEnabled  bool
-		DefaultStore             string
-		ExpectedScanners         []expectedScanner
+		Name                                string
+		ExecutionsScannerEnabled            bool
+		TaskQueueScannerEnabled             bool
+		HistoryScannerEnabled               bool
+		BuildIdScavengerEnabled             bool
+		DefaultStore                        string
+		StorageUsageScannerEnabled          bool
+		ReencryptionScannerEnabled          bool
+		TablePartitionScannerEnabled        bool
+		VisibilityConsistencyScannerEnabled bool
+		VisibilityExportScannerEnabled      bool
+		ArchiveExpiryScannerEnabled         bool
+		ExpectedScanners                    []expectedScanner
 	}
 
 	for _, c := range []testCase{
@@ -179,13 +218,61 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 			ExpectedScanners:         []expectedScanner{buildIdScavenger},
 		},
 		{
-			Name:                     "AllScannersSQL",
-			ExecutionsScannerEnabled: true,
-			TaskQueueScannerEnabled:  true,
-			HistoryScannerEnabled:    true,
-			BuildIdScavengerEnabled:  true,
-			DefaultStore:             config.StoreTypeSQL,
-			ExpectedScanners:         []expectedScanner{historyScanner, taskQueueScanner, executionScanner, buildIdScavenger},
+			Name:                       "StorageUsageScanner",
+			DefaultStore:               config.StoreTypeNoSQL,
+			StorageUsageScannerEnabled: true,
+			ExpectedScanners:           []expectedScanner{storageUsageScanner},
+		},
+		{
+			Name:                       "ReencryptionScanner",
+			DefaultStore:               config.StoreTypeNoSQL,
+			ReencryptionScannerEnabled: true,
+			ExpectedScanners:           []expectedScanner{reencryptionScanner},
+		},
+		{
+			Name:                         "TablePartitionScannerNoSQL",
+			DefaultStore:                 config.StoreTypeNoSQL,
+			TablePartitionScannerEnabled: true,
+			ExpectedScanners:             []expectedScanner{},
+		},
+		{
+			Name:                         "TablePartitionScannerSQL",
+			DefaultStore:                 config.StoreTypeSQL,
+			TablePartitionScannerEnabled: true,
+			ExpectedScanners:             []expectedScanner{tablePartitionScanner},
+		},
+		{
+			Name:                                "VisibilityConsistencyScanner",
+			DefaultStore:                        config.StoreTypeNoSQL,
+			VisibilityConsistencyScannerEnabled: true,
+			ExpectedScanners:                    []expectedScanner{visibilityConsistencyScanner},
+		},
+		{
+			Name:                           "VisibilityExportScanner",
+			DefaultStore:                   config.StoreTypeNoSQL,
+			VisibilityExportScannerEnabled: true,
+			ExpectedScanners:               []expectedScanner{visibilityExportScanner},
+		},
+		{
+			Name:                        "ArchiveExpiryScanner",
+			DefaultStore:                config.StoreTypeNoSQL,
+			ArchiveExpiryScannerEnabled: true,
+			ExpectedScanners:            []expectedScanner{archiveExpiryScanner},
+		},
+		{
+			Name:                                "AllScannersSQL",
+			ExecutionsScannerEnabled:            true,
+			TaskQueueScannerEnabled:             true,
+			HistoryScannerEnabled:               true,
+			BuildIdScavengerEnabled:             true,
+			DefaultStore:                        config.StoreTypeSQL,
+			StorageUsageScannerEnabled:          true,
+			ReencryptionScannerEnabled:          true,
+			TablePartitionScannerEnabled:        true,
+			VisibilityConsistencyScannerEnabled: true,
+			VisibilityExportScannerEnabled:      true,
+			ArchiveExpiryScannerEnabled:         true,
+			ExpectedScanners:                    []expectedScanner{historyScanner, taskQueueScanner, executionScanner, buildIdScavenger, storageUsageScanner, reencryptionScanner, tablePartitionScanner, visibilityConsistencyScanner, visibilityExportScanner, archiveExpiryScanner},
 		},
 	} {
 		s.Run(c.Name, func() {
@@ -205,6 +292,14 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 					BuildIdScavengerEnabled:                dynamicconfig.GetBoolPropertyFn(c.BuildIdScavengerEnabled),
 					ExecutionsScannerEnabled:               dynamicconfig.GetBoolPropertyFn(c.ExecutionsScannerEnabled),
 					TaskQueueScannerEnabled:                dynamicconfig.GetBoolPropertyFn(c.TaskQueueScannerEnabled),
+					StorageUsageScannerEnabled:             dynamicconfig.GetBoolPropertyFn(c.StorageUsageScannerEnabled),
+					ReencryptionScannerEnabled:             dynamicconfig.GetBoolPropertyFn(c.ReencryptionScannerEnabled),
+					TablePartitionScannerEnabled:           dynamicconfig.GetBoolPropertyFn(c.TablePartitionScannerEnabled),
+					VisibilityConsistencyScannerEnabled:    dynamicconfig.GetBoolPropertyFn(c.VisibilityConsistencyScannerEnabled),
+					VisibilityExportScannerEnabled:         dynamicconfig.GetBoolPropertyFn(c.VisibilityExportScannerEnabled),
+					ArchiveExpiryScannerEnabled:            dynamicconfig.GetBoolPropertyFn(c.ArchiveExpiryScannerEnabled),
+					TablePartitionShardsPerPartition:       dynamicconfig.GetIntPropertyFn(64),
+					LeaderElectionEnabled:                  dynamicconfig.GetBoolPropertyFn(false),
 					Persistence: &config.Persistence{
 						DefaultStore: c.DefaultStore,
 						DataStores: map[string]config.DataStore{
@@ -221,12 +316,15 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 				// These nils are irrelevant since they're only used by the build id scavenger which is not tested here.
 				nil,
 				nil,
+				nil,
 				p.NewMockTaskManager(ctrl),
 				historyservicemock.NewMockHistoryServiceClient(ctrl),
 				mockAdminClient,
 				nil,
 				mockNamespaceRegistry,
 				"active-cluster",
+				nil,
+				nil,
 			)
 			var wg sync.WaitGroup
 			for _, sc := range c.ExpectedScanners {
@@ -283,6 +381,13 @@ func (s *scannerTestSuite) TestScannerShutdown() {
 			ExecutionsScannerEnabled:               dynamicconfig.GetBoolPropertyFn(false),
 			TaskQueueScannerEnabled:                dynamicconfig.GetBoolPropertyFn(false),
 			BuildIdScavengerEnabled:                dynamicconfig.GetBoolPropertyFn(false),
+			StorageUsageScannerEnabled:             dynamicconfig.GetBoolPropertyFn(false),
+			ReencryptionScannerEnabled:             dynamicconfig.GetBoolPropertyFn(false),
+			TablePartitionScannerEnabled:           dynamicconfig.GetBoolPropertyFn(false),
+			VisibilityConsistencyScannerEnabled:    dynamicconfig.GetBoolPropertyFn(false),
+			VisibilityExportScannerEnabled:         dynamicconfig.GetBoolPropertyFn(false),
+			ArchiveExpiryScannerEnabled:            dynamicconfig.GetBoolPropertyFn(false),
+			LeaderElectionEnabled:                  dynamicconfig.GetBoolPropertyFn(false),
 			Persistence: &config.Persistence{
 				DefaultStore: config.StoreTypeNoSQL,
 				DataStores: map[string]config.DataStore{
@@ -296,12 +401,15 @@ func (s *scannerTestSuite) TestScannerShutdown() {
 		// These nils are irrelevant since they're only used by the build id scavenger which is not tested here.
 		nil,
 		nil,
+		nil,
 		p.NewMockTaskManager(ctrl),
 		historyservicemock.NewMockHistoryServiceClient(ctrl),
 		mockAdminClient,
 		nil,
 		mockNamespaceRegistry,
 		"active-cluster",
+		nil,
+		nil,
 	)
 	mockSdkClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient).AnyTimes()
 	worker.EXPECT().RegisterActivityWithOptions(gomock.Any(), gomock.Any()).AnyTimes()
@@ -325,3 +433,158 @@ func (s *scannerTestSuite) TestScannerShutdown() {
 	wg.Wait()
 	scanner.Stop()
 }
+
+func (s *scannerTestSuite) TestScannerRunsOnlyWhileLeader() {
+	ctrl := gomock.NewController(s.T())
+
+	mockSdkClientFactory := sdk.NewMockClientFactory(ctrl)
+	mockSdkClient := mocksdk.NewMockClient(ctrl)
+	mockTaskManager := p.NewMockTaskManager(ctrl)
+	worker := mocksdk.NewMockWorker(ctrl)
+	scanner := New(
+		log.NewTestLogger(),
+		&Config{
+			MaxConcurrentActivityExecutionSize:     dynamicconfig.GetIntPropertyFn(1),
+			MaxConcurrentWorkflowTaskExecutionSize: dynamicconfig.GetIntPropertyFn(1),
+			MaxConcurrentActivityTaskPollers:       dynamicconfig.GetIntPropertyFn(1),
+			MaxConcurrentWorkflowTaskPollers:       dynamicconfig.GetIntPropertyFn(1),
+			HistoryScannerEnabled:                  dynamicconfig.GetBoolPropertyFn(true),
+			ExecutionsScannerEnabled:               dynamicconfig.GetBoolPropertyFn(false),
+			TaskQueueScannerEnabled:                dynamicconfig.GetBoolPropertyFn(false),
+			BuildIdScavengerEnabled:                dynamicconfig.GetBoolPropertyFn(false),
+			StorageUsageScannerEnabled:             dynamicconfig.GetBoolPropertyFn(false),
+			ReencryptionScannerEnabled:             dynamicconfig.GetBoolPropertyFn(false),
+			TablePartitionScannerEnabled:           dynamicconfig.GetBoolPropertyFn(false),
+			VisibilityConsistencyScannerEnabled:    dynamicconfig.GetBoolPropertyFn(false),
+			VisibilityExportScannerEnabled:         dynamicconfig.GetBoolPropertyFn(false),
+			ArchiveExpiryScannerEnabled:            dynamicconfig.GetBoolPropertyFn(false),
+			LeaderElectionEnabled:                  dynamicconfig.GetBoolPropertyFn(true),
+			LeaseDuration:                          dynamicconfig.GetDurationPropertyFn(time.Minute),
+			LeaseRenewInterval:                     dynamicconfig.GetDurationPropertyFn(time.Hour),
+			Persistence: &config.Persistence{
+				DefaultStore: config.StoreTypeNoSQL,
+				DataStores: map[string]config.DataStore{
+					config.StoreTypeNoSQL: {},
+				},
+			},
+		},
+		mockSdkClientFactory,
+		metrics.NoopMetricsHandler,
+		p.NewMockExecutionManager(ctrl),
+		nil,
+		nil,
+		nil,
+		mockTaskManager,
+		historyservicemock.NewMockHistoryServiceClient(ctrl),
+		adminservicemock.NewMockAdminServiceClient(ctrl),
+		nil,
+		namespace.NewMockRegistry(ctrl),
+		"active-cluster",
+		nil,
+		nil,
+	)
+
+	// the lease does not exist yet, so this host becomes the leader
+	mockTaskManager.EXPECT().GetTaskQueue(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound(""))
+	mockTaskManager.EXPECT().CreateTaskQueue(gomock.Any(), gomock.Any()).Return(&p.CreateTaskQueueResponse{}, nil)
+
+	mockSdkClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient).AnyTimes()
+	worker.EXPECT().RegisterActivityWithOptions(gomock.Any(), gomock.Any()).AnyTimes()
+	worker.EXPECT().RegisterWorkflowWithOptions(gomock.Any(), gomock.Any()).AnyTimes()
+	worker.EXPECT().Start()
+	mockSdkClientFactory.EXPECT().NewWorker(gomock.Any(), historyScannerTaskQueueName, gomock.Any()).Return(worker)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	mockSdkClient.EXPECT().ExecuteWorkflow(gomock.Any(), gomock.Any(), historyScannerWFTypeName).DoAndReturn(func(
+		_ context.Context,
+		_ client.StartWorkflowOptions,
+		_ string,
+		_ ...interface{},
+	) (client.WorkflowRun, error) {
+		wg.Done()
+		return nil, nil
+	})
+
+	err := scanner.Start()
+	s.NoError(err)
+	wg.Wait()
+
+	// stopping the scanner stops its workers and releases the lease
+	worker.EXPECT().Stop()
+	mockTaskManager.EXPECT().UpdateTaskQueue(gomock.Any(), gomock.Any()).Return(&p.UpdateTaskQueueResponse{}, nil)
+	scanner.Stop()
+	ctrl.Finish()
+}
+
+func (s *scannerTestSuite) TestScannerWorkerFatalErrorEndsTerm() {
+	ctrl := gomock.NewController(s.T())
+
+	mockSdkClientFactory := sdk.NewMockClientFactory(ctrl)
+	mockSdkClient := mocksdk.NewMockClient(ctrl)
+	worker := mocksdk.NewMockWorker(ctrl)
+	scanner := New(
+		log.NewTestLogger(),
+		&Config{
+			MaxConcurrentActivityExecutionSize:     dynamicconfig.GetIntPropertyFn(1),
+			MaxConcurrentWorkflowTaskExecutionSize: dynamicconfig.GetIntPropertyFn(1),
+			MaxConcurrentActivityTaskPollers:       dynamicconfig.GetIntPropertyFn(1),
+			MaxConcurrentWorkflowTaskPollers:       dynamicconfig.GetIntPropertyFn(1),
+			HistoryScannerEnabled:                  dynamicconfig.GetBoolPropertyFn(true),
+			ExecutionsScannerEnabled:               dynamicconfig.GetBoolPropertyFn(false),
+			TaskQueueScannerEnabled:                dynamicconfig.GetBoolPropertyFn(false),
+			BuildIdScavengerEnabled:                dynamicconfig.GetBoolPropertyFn(false),
+			StorageUsageScannerEnabled:             dynamicconfig.GetBoolPropertyFn(false),
+			ReencryptionScannerEnabled:             dynamicconfig.GetBoolPropertyFn(false),
+			TablePartitionScannerEnabled:           dynamicconfig.GetBoolPropertyFn(false),
+			VisibilityConsistencyScannerEnabled:    dynamicconfig.GetBoolPropertyFn(false),
+			VisibilityExportScannerEnabled:         dynamicconfig.GetBoolPropertyFn(false),
+			ArchiveExpiryScannerEnabled:            dynamicconfig.GetBoolPropertyFn(false),
+			LeaderElectionEnabled:                  dynamicconfig.GetBoolPropertyFn(true),
+			LeaseDuration:                          dynamicconfig.GetDurationPropertyFn(time.Minute),
+			LeaseRenewInterval:                     dynamicconfig.GetDurationPropertyFn(time.Hour),
+			Persistence: &config.Persistence{
+				DefaultStore: config.StoreTypeNoSQL,
+				DataStores: map[string]config.DataStore{
+					config.StoreTypeNoSQL: {},
+				},
+			},
+		},
+		mockSdkClientFactory,
+		metrics.NoopMetricsHandler,
+		p.NewMockExecutionManager(ctrl),
+		nil,
+		nil,
+		nil,
+		p.NewMockTaskManager(ctrl),
+		historyservicemock.NewMockHistoryServiceClient(ctrl),
+		adminservicemock.NewMockAdminServiceClient(ctrl),
+		nil,
+		namespace.NewMockRegistry(ctrl),
+		"active-cluster",
+		nil,
+		nil,
+	)
+
+	mockSdkClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient).AnyTimes()
+	mockSdkClient.EXPECT().ExecuteWorkflow(gomock.Any(), gomock.Any(), historyScannerWFTypeName).Return(nil, nil).AnyTimes()
+	worker.EXPECT().RegisterActivityWithOptions(gomock.Any(), gomock.Any()).AnyTimes()
+	worker.EXPECT().RegisterWorkflowWithOptions(gomock.Any(), gomock.Any()).AnyTimes()
+	fatalErr := serviceerror.NewPermissionDenied("", "")
+	mockSdkClientFactory.EXPECT().NewWorker(gomock.Any(), historyScannerTaskQueueName, gomock.Any()).DoAndReturn(
+		func(_ client.Client, _ string, options sdkworker.Options) sdkworker.Worker {
+			worker.EXPECT().Start().DoAndReturn(func() error {
+				go options.OnFatalError(fatalErr)
+				return nil
+			})
+			return worker
+		},
+	)
+	worker.EXPECT().Stop()
+
+	// the term ends with the fatal error, so that leadership is released
+	ctx, cancel := context.WithCancel(context.Background())
+	s.Equal(fatalErr, scanner.runScanners(ctx, 1))
+	cancel()
+	scanner.wg.Wait()
+	ctrl.Finish()
+}
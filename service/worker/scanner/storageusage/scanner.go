@@ -0,0 +1,276 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storageusage
+
+import (
+	"context"
+	"math"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	StorageUsageScannerWorkflowName = "storage-usage-scanner"
+	StorageUsageScannerActivityName = "scan-storage-usage"
+
+	StorageUsageScannerWFID          = "temporal-sys-storage-usage-scanner"
+	StorageUsageScannerTaskQueueName = "temporal-sys-storage-usage-scanner-taskqueue-0"
+)
+
+var (
+	StorageUsageScannerWFStartOptions = client.StartWorkflowOptions{
+		ID:                    StorageUsageScannerWFID,
+		TaskQueue:             StorageUsageScannerTaskQueueName,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		CronSchedule:          "0 */6 * * *",
+	}
+)
+
+type (
+	StorageUsageScannerInput struct {
+		ExecutionListPageSize int
+		NamespaceListPageSize int
+	}
+
+	Activities struct {
+		logger             log.Logger
+		metricsHandler     metrics.Handler
+		executionManager   persistence.ExecutionManager
+		metadataManager    persistence.MetadataManager
+		replicator         namespace.Replicator
+		numShards          int32
+		perHostQPS         dynamicconfig.IntPropertyFn
+		currentClusterName string
+	}
+
+	heartbeatDetails struct {
+		ShardID   int32
+		PageToken []byte
+		// UsageByNamespaceID is the usage accumulated over the shards scanned so far
+		UsageByNamespaceID map[string]namespace.StorageUsage
+	}
+)
+
+func NewActivities(
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	executionManager persistence.ExecutionManager,
+	metadataManager persistence.MetadataManager,
+	replicator namespace.Replicator,
+	numShards int32,
+	perHostQPS dynamicconfig.IntPropertyFn,
+	currentClusterName string,
+) *Activities {
+	return &Activities{
+		logger:             logger,
+		metricsHandler:     metricsHandler.WithTags(metrics.OperationTag(metrics.StorageUsageScannerScope)),
+		executionManager:   executionManager,
+		metadataManager:    metadataManager,
+		replicator:         replicator,
+		numShards:          numShards,
+		perHostQPS:         perHostQPS,
+		currentClusterName: currentClusterName,
+	}
+}
+
+// StorageUsageScannerWorkflow scans all executions and records the storage consumed by each namespace.
+// This workflow is a wrapper around the long running ScanStorageUsage activity.
+func StorageUsageScannerWorkflow(ctx workflow.Context, input StorageUsageScannerInput) error {
+	activityCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		// Give the activity enough time to scan all the shards
+		StartToCloseTimeout: 24 * time.Hour,
+		HeartbeatTimeout:    5 * time.Minute,
+	})
+	return workflow.ExecuteActivity(activityCtx, StorageUsageScannerActivityName, input).Get(ctx, nil)
+}
+
+func (a *Activities) setDefaults(input *StorageUsageScannerInput) {
+	if input.ExecutionListPageSize == 0 {
+		input.ExecutionListPageSize = 100
+	}
+	if input.NamespaceListPageSize == 0 {
+		input.NamespaceListPageSize = 100
+	}
+}
+
+// ScanStorageUsage sums up the history, mutable state and visibility bytes of all executions per namespace, then
+// records the result in the namespace data and emits it as a gauge. This reconciles the approximate usage with
+// what is actually stored, regardless of deletions and retention.
+func (a *Activities) ScanStorageUsage(ctx context.Context, input StorageUsageScannerInput) error {
+	a.setDefaults(&input)
+
+	var heartbeat heartbeatDetails
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &heartbeat); err != nil {
+			return temporal.NewNonRetryableApplicationError("failed to load previous heartbeat details", "TypeError", err)
+		}
+	}
+	if heartbeat.ShardID == 0 {
+		heartbeat.ShardID = 1
+	}
+	if heartbeat.UsageByNamespaceID == nil {
+		heartbeat.UsageByNamespaceID = make(map[string]namespace.StorageUsage)
+	}
+
+	rps := float64(a.perHostQPS())
+	rateLimiter := quotas.NewRateLimiter(rps, int(math.Ceil(rps)))
+	for heartbeat.ShardID <= a.numShards {
+		if err := rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+		resp, err := a.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+			ShardID:   heartbeat.ShardID,
+			PageSize:  input.ExecutionListPageSize,
+			PageToken: heartbeat.PageToken,
+		})
+		if err != nil {
+			return err
+		}
+		for _, state := range resp.States {
+			namespaceID := state.GetExecutionInfo().GetNamespaceId()
+			heartbeat.UsageByNamespaceID[namespaceID] = addExecutionUsage(heartbeat.UsageByNamespaceID[namespaceID], state)
+		}
+		heartbeat.PageToken = resp.PageToken
+		if len(heartbeat.PageToken) == 0 {
+			heartbeat.ShardID++
+		}
+		activity.RecordHeartbeat(ctx, heartbeat)
+	}
+
+	return a.recordUsage(ctx, input, heartbeat.UsageByNamespaceID)
+}
+
+func addExecutionUsage(
+	usage namespace.StorageUsage,
+	state *persistencespb.WorkflowMutableState,
+) namespace.StorageUsage {
+	executionInfo := state.GetExecutionInfo()
+	usage.HistoryBytes += executionInfo.GetExecutionStats().GetHistorySize()
+	usage.MutableStateBytes += int64(state.Size())
+	for _, payload := range executionInfo.GetMemo() {
+		usage.VisibilityBytes += int64(payload.Size())
+	}
+	for _, payload := range executionInfo.GetSearchAttributes() {
+		usage.VisibilityBytes += int64(payload.Size())
+	}
+	return usage
+}
+
+func (a *Activities) recordUsage(
+	ctx context.Context,
+	input StorageUsageScannerInput,
+	usageByNamespaceID map[string]namespace.StorageUsage,
+) error {
+	var nextPageToken []byte
+	for {
+		resp, err := a.metadataManager.ListNamespaces(ctx, &persistence.ListNamespacesRequest{
+			PageSize:       input.NamespaceListPageSize,
+			NextPageToken:  nextPageToken,
+			IncludeDeleted: false,
+		})
+		if err != nil {
+			return err
+		}
+		for _, ns := range resp.Namespaces {
+			if err := a.recordNamespaceUsage(ctx, ns, usageByNamespaceID[ns.Namespace.Info.Id]); err != nil {
+				// Intentionally don't fail the activity on a single namespace, its usage is recorded by the next scan.
+				a.logger.Error("Failed to record namespace storage usage",
+					tag.WorkflowNamespace(ns.Namespace.Info.Name),
+					tag.Error(err))
+			}
+			activity.RecordHeartbeat(ctx)
+		}
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+func (a *Activities) recordNamespaceUsage(
+	ctx context.Context,
+	ns *persistence.GetNamespaceResponse,
+	usage namespace.StorageUsage,
+) error {
+	entry := namespace.FromPersistentState(ns)
+	handler := a.metricsHandler.WithTags(metrics.NamespaceTag(entry.Name().String()))
+	for storageType, bytes := range usage.ByStorageType() {
+		handler.Gauge(metrics.NamespaceStorageUsage.GetMetricName()).Record(float64(bytes), metrics.StorageTypeTag(storageType))
+	}
+
+	// Only the active cluster for this namespace records the usage, so that clusters don't keep overwriting
+	// each other's usage of the replicated namespace data.
+	if !entry.ActiveInCluster(a.currentClusterName) || entry.StorageUsage() == usage {
+		return nil
+	}
+
+	// The namespace listed at the start of the scan may be long outdated, only the usage is written into the
+	// latest namespace. The config version is bumped like on any other namespace update, so that the standby
+	// clusters apply the replicated usage.
+	resp, err := persistence.UpdateNamespaceDetail(
+		ctx,
+		a.metadataManager,
+		&persistence.GetNamespaceRequest{ID: entry.ID().String()},
+		func(detail *persistencespb.NamespaceDetail) error {
+			if detail.Info.Data == nil {
+				detail.Info.Data = make(map[string]string)
+			}
+			usage.ToData(detail.Info.Data)
+			detail.ConfigVersion++
+			return nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+	detail := resp.Namespace
+	return a.replicator.HandleTransmissionTask(
+		ctx,
+		enumsspb.NAMESPACE_OPERATION_UPDATE,
+		detail.Info,
+		detail.Config,
+		detail.ReplicationConfig,
+		false,
+		detail.ConfigVersion,
+		detail.FailoverVersion,
+		resp.IsGlobalNamespace,
+		detail.ReplicationConfig.FailoverHistory,
+	)
+}
@@ -0,0 +1,200 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storageusage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	testActiveNamespaceID  = "active-namespace-id"
+	testStandbyNamespaceID = "standby-namespace-id"
+	testCurrentCluster     = "active-cluster"
+)
+
+func newTestMutableState(namespaceID string, historySize int64) *persistencespb.WorkflowMutableState {
+	return &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId:    namespaceID,
+			ExecutionStats: &persistencespb.ExecutionStats{HistorySize: historySize},
+			Memo:           map[string]*commonpb.Payload{"memo": {Data: []byte("memo-value")}},
+		},
+	}
+}
+
+func newTestNamespace(id string, activeCluster string, data map[string]string) *persistence.GetNamespaceResponse {
+	return &persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   id,
+				Name: id,
+				Data: data,
+			},
+			Config: &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+				ActiveClusterName: activeCluster,
+				Clusters:          []string{testCurrentCluster, "standby-cluster"},
+			},
+		},
+		IsGlobalNamespace: true,
+	}
+}
+
+func Test_ScanStorageUsage(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	ctrl := gomock.NewController(t)
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	metadataManager := persistence.NewMockMetadataManager(ctrl)
+	replicationQueue := persistence.NewMockNamespaceReplicationQueue(ctrl)
+
+	a := NewActivities(
+		log.NewTestLogger(),
+		metrics.NoopMetricsHandler,
+		executionManager,
+		metadataManager,
+		namespace.NewNamespaceReplicator(replicationQueue, log.NewTestLogger()),
+		2,
+		dynamicconfig.GetIntPropertyFn(1000),
+		testCurrentCluster,
+	)
+	env.RegisterActivityWithOptions(a.ScanStorageUsage, activity.RegisterOptions{Name: StorageUsageScannerActivityName})
+
+	shard1Page1 := []*persistencespb.WorkflowMutableState{newTestMutableState(testActiveNamespaceID, 100)}
+	shard1Page2 := []*persistencespb.WorkflowMutableState{newTestMutableState(testStandbyNamespaceID, 10)}
+	shard2Page1 := []*persistencespb.WorkflowMutableState{newTestMutableState(testActiveNamespaceID, 1000)}
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:  1,
+		PageSize: 100,
+	}).Return(&persistence.ListConcreteExecutionsResponse{States: shard1Page1, PageToken: []byte("token")}, nil)
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:   1,
+		PageSize:  100,
+		PageToken: []byte("token"),
+	}).Return(&persistence.ListConcreteExecutionsResponse{States: shard1Page2}, nil)
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:  2,
+		PageSize: 100,
+	}).Return(&persistence.ListConcreteExecutionsResponse{States: shard2Page1}, nil)
+
+	var expectedUsage namespace.StorageUsage
+	for _, state := range append(shard1Page1, shard2Page1...) {
+		expectedUsage = addExecutionUsage(expectedUsage, state)
+	}
+	require.Equal(t, int64(1100), expectedUsage.HistoryBytes)
+	require.Positive(t, expectedUsage.MutableStateBytes)
+	require.Positive(t, expectedUsage.VisibilityBytes)
+
+	metadataManager.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).Return(&persistence.ListNamespacesResponse{
+		Namespaces: []*persistence.GetNamespaceResponse{
+			newTestNamespace(testActiveNamespaceID, testCurrentCluster, map[string]string{"key": "value"}),
+			newTestNamespace(testStandbyNamespaceID, "standby-cluster", nil),
+		},
+	}, nil)
+	metadataManager.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil)
+	// the namespace was updated since it was listed, the usage must be recorded into the latest namespace
+	latest := newTestNamespace(testActiveNamespaceID, testCurrentCluster, map[string]string{"key": "updated"})
+	latest.Namespace.ConfigVersion = 3
+	metadataManager.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{ID: testActiveNamespaceID}).
+		Return(latest, nil)
+	metadataManager.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			require.Equal(t, testActiveNamespaceID, request.Namespace.Info.Id)
+			require.Equal(t, int64(7), request.NotificationVersion)
+			require.True(t, request.IsGlobalNamespace)
+			require.Equal(t, "updated", request.Namespace.Info.Data["key"])
+			require.Equal(t, int64(4), request.Namespace.ConfigVersion)
+			entry := namespace.FromPersistentState(&persistence.GetNamespaceResponse{Namespace: request.Namespace})
+			require.Equal(t, expectedUsage, entry.StorageUsage())
+			return nil
+		})
+	// the usage is replicated to the standby clusters with the updated namespace
+	replicationQueue.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, task *replicationspb.ReplicationTask) error {
+			attributes := task.GetNamespaceTaskAttributes()
+			require.Equal(t, enumsspb.NAMESPACE_OPERATION_UPDATE, attributes.GetNamespaceOperation())
+			require.Equal(t, int64(4), attributes.GetConfigVersion())
+			require.Equal(t, "updated", attributes.GetInfo().GetData()["key"])
+			require.Equal(t, "1100", attributes.GetInfo().GetData()[namespace.StorageUsageDataKey(namespace.StorageTypeHistory)])
+			return nil
+		})
+
+	_, err := env.ExecuteActivity(StorageUsageScannerActivityName, StorageUsageScannerInput{})
+	require.NoError(t, err)
+}
+
+func Test_ScanStorageUsage_UnchangedUsageNotUpdated(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	ctrl := gomock.NewController(t)
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	metadataManager := persistence.NewMockMetadataManager(ctrl)
+	replicationQueue := persistence.NewMockNamespaceReplicationQueue(ctrl)
+
+	a := NewActivities(
+		log.NewTestLogger(),
+		metrics.NoopMetricsHandler,
+		executionManager,
+		metadataManager,
+		namespace.NewNamespaceReplicator(replicationQueue, log.NewTestLogger()),
+		1,
+		dynamicconfig.GetIntPropertyFn(1000),
+		testCurrentCluster,
+	)
+	env.RegisterActivityWithOptions(a.ScanStorageUsage, activity.RegisterOptions{Name: StorageUsageScannerActivityName})
+
+	states := []*persistencespb.WorkflowMutableState{newTestMutableState(testActiveNamespaceID, 100)}
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).
+		Return(&persistence.ListConcreteExecutionsResponse{States: states}, nil)
+
+	data := make(map[string]string)
+	addExecutionUsage(namespace.StorageUsage{}, states[0]).ToData(data)
+	metadataManager.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).Return(&persistence.ListNamespacesResponse{
+		Namespaces: []*persistence.GetNamespaceResponse{
+			newTestNamespace(testActiveNamespaceID, testCurrentCluster, data),
+		},
+	}, nil)
+
+	_, err := env.ExecuteActivity(StorageUsageScannerActivityName, StorageUsageScannerInput{})
+	require.NoError(t, err)
+}
@@ -0,0 +1,189 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tablepartition
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence/sql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/resolver"
+)
+
+const (
+	TablePartitionScannerWorkflowName = "table-partition-scanner"
+	TablePartitionScannerActivityName = "manage-table-partitions"
+
+	TablePartitionScannerWFID          = "temporal-sys-table-partition-scanner"
+	TablePartitionScannerTaskQueueName = "temporal-sys-table-partition-scanner-taskqueue-0"
+)
+
+var (
+	TablePartitionScannerWFStartOptions = client.StartWorkflowOptions{
+		ID:                    TablePartitionScannerWFID,
+		TaskQueue:             TablePartitionScannerTaskQueueName,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		CronSchedule:          "0 */12 * * *",
+	}
+)
+
+type (
+	Activities struct {
+		logger             log.Logger
+		metricsHandler     metrics.Handler
+		sqlConfig          *config.SQL
+		serviceResolver    resolver.ServiceResolver
+		numShards          int32
+		shardsPerPartition dynamicconfig.IntPropertyFn
+	}
+)
+
+func NewActivities(
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	sqlConfig *config.SQL,
+	serviceResolver resolver.ServiceResolver,
+	numShards int32,
+	shardsPerPartition dynamicconfig.IntPropertyFn,
+) *Activities {
+	return &Activities{
+		logger:             logger,
+		metricsHandler:     metricsHandler.WithTags(metrics.OperationTag(metrics.TablePartitionScannerScope)),
+		sqlConfig:          sqlConfig,
+		serviceResolver:    serviceResolver,
+		numShards:          numShards,
+		shardsPerPartition: shardsPerPartition,
+	}
+}
+
+// TablePartitionScannerWorkflow maintains the shard ID range partitions of the partitioned SQL tables.
+// This workflow is a wrapper around the ManageTablePartitions activity.
+func TablePartitionScannerWorkflow(ctx workflow.Context) error {
+	activityCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		// Creating a partition moves the rows of its shards out of the overflow partition, which can take a while
+		StartToCloseTimeout: 6 * time.Hour,
+	})
+	return workflow.ExecuteActivity(activityCtx, TablePartitionScannerActivityName).Get(ctx, nil)
+}
+
+// ManageTablePartitions creates the partitions needed to hold all the shards ahead of time and drops the expired
+// partitions, i.e. the partitions of shards which no longer exist. Tables on which the optional partitioned.sql
+// schema file has not been applied, and SQL plugins which don't support partitioning, are skipped.
+func (a *Activities) ManageTablePartitions(ctx context.Context) error {
+	db, err := sql.NewSQLAdminDB(sqlplugin.DbKindMain, a.sqlConfig, a.serviceResolver)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = db.Close() }()
+
+	admin, ok := db.(sqlplugin.TablePartitionAdmin)
+	if !ok {
+		a.logger.Info("SQL plugin does not support table partitioning", tag.NewStringTag("plugin", a.sqlConfig.PluginName))
+		return nil
+	}
+	for _, table := range sqlplugin.PartitionedTableNames {
+		if err := a.manageTablePartitions(ctx, admin, table); err != nil {
+			return err
+		}
+		activity.RecordHeartbeat(ctx)
+	}
+	return nil
+}
+
+func (a *Activities) manageTablePartitions(
+	ctx context.Context,
+	admin sqlplugin.TablePartitionAdmin,
+	table string,
+) error {
+	partitions, err := admin.ListTablePartitions(ctx, table)
+	if errors.Is(err, sqlplugin.ErrTableNotPartitioned) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	toDrop, toCreate := planTablePartitions(partitions, a.numShards, int32(a.shardsPerPartition()))
+	for _, partition := range toDrop {
+		if err := admin.DropTablePartition(ctx, table, partition); err != nil {
+			return err
+		}
+		a.logger.Info("Dropped table partition", tag.NewStringTag("table", table), tag.NewStringTag("partition", partition.Name()))
+		a.metricsHandler.Counter(metrics.TablePartitionsDropped.GetMetricName()).Record(1)
+	}
+	for _, partition := range toCreate {
+		if err := admin.CreateTablePartition(ctx, table, partition); err != nil {
+			return err
+		}
+		a.logger.Info("Created table partition", tag.NewStringTag("table", table), tag.NewStringTag("partition", partition.Name()))
+		a.metricsHandler.Counter(metrics.TablePartitionsCreated.GetMetricName()).Record(1)
+	}
+	return nil
+}
+
+// planTablePartitions returns the partitions to drop, which only hold shards above numShards, and the partitions
+// to create so that every shard up to numShards has a partition. New partitions start where the last partition
+// ends, since MySQL requires range partitions to be contiguous.
+func planTablePartitions(
+	partitions []sqlplugin.TablePartition,
+	numShards int32,
+	shardsPerPartition int32,
+) (toDrop []sqlplugin.TablePartition, toCreate []sqlplugin.TablePartition) {
+	if shardsPerPartition <= 0 {
+		shardsPerPartition = 1
+	}
+
+	nextShardID := int32(1)
+	for _, partition := range partitions {
+		if partition.MinShardID > numShards {
+			toDrop = append(toDrop, partition)
+			continue
+		}
+		if partition.MaxShardID > nextShardID {
+			nextShardID = partition.MaxShardID
+		}
+	}
+	for nextShardID <= numShards {
+		partition := sqlplugin.TablePartition{
+			MinShardID: nextShardID,
+			MaxShardID: nextShardID + shardsPerPartition,
+		}
+		toCreate = append(toCreate, partition)
+		nextShardID = partition.MaxShardID
+	}
+	return toDrop, toCreate
+}
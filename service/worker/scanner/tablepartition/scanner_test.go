@@ -0,0 +1,126 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tablepartition
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+)
+
+type fakeTablePartitionAdmin struct {
+	partitions map[string][]sqlplugin.TablePartition
+	created    []sqlplugin.TablePartition
+	dropped    []sqlplugin.TablePartition
+}
+
+func (f *fakeTablePartitionAdmin) ListTablePartitions(_ context.Context, table string) ([]sqlplugin.TablePartition, error) {
+	partitions, ok := f.partitions[table]
+	if !ok {
+		return nil, sqlplugin.ErrTableNotPartitioned
+	}
+	return partitions, nil
+}
+
+func (f *fakeTablePartitionAdmin) CreateTablePartition(_ context.Context, _ string, partition sqlplugin.TablePartition) error {
+	f.created = append(f.created, partition)
+	return nil
+}
+
+func (f *fakeTablePartitionAdmin) DropTablePartition(_ context.Context, _ string, partition sqlplugin.TablePartition) error {
+	f.dropped = append(f.dropped, partition)
+	return nil
+}
+
+func newTestActivities(numShards int32, shardsPerPartition int) *Activities {
+	return NewActivities(
+		log.NewTestLogger(),
+		metrics.NoopMetricsHandler,
+		nil,
+		nil,
+		numShards,
+		dynamicconfig.GetIntPropertyFn(shardsPerPartition),
+	)
+}
+
+func TestManageTablePartitions_CreatesAhead(t *testing.T) {
+	admin := &fakeTablePartitionAdmin{
+		partitions: map[string][]sqlplugin.TablePartition{
+			sqlplugin.ExecutionsTableName: {{MinShardID: 1, MaxShardID: 5}},
+		},
+	}
+	err := newTestActivities(10, 4).manageTablePartitions(context.Background(), admin, sqlplugin.ExecutionsTableName)
+	require.NoError(t, err)
+	require.Empty(t, admin.dropped)
+	require.Equal(t, []sqlplugin.TablePartition{
+		{MinShardID: 5, MaxShardID: 9},
+		{MinShardID: 9, MaxShardID: 13},
+	}, admin.created)
+}
+
+func TestManageTablePartitions_DropsExpired(t *testing.T) {
+	admin := &fakeTablePartitionAdmin{
+		partitions: map[string][]sqlplugin.TablePartition{
+			sqlplugin.HistoryNodeTableName: {
+				{MinShardID: 1, MaxShardID: 5},
+				{MinShardID: 5, MaxShardID: 9},
+				{MinShardID: 9, MaxShardID: 13},
+			},
+		},
+	}
+	err := newTestActivities(4, 4).manageTablePartitions(context.Background(), admin, sqlplugin.HistoryNodeTableName)
+	require.NoError(t, err)
+	require.Equal(t, []sqlplugin.TablePartition{
+		{MinShardID: 5, MaxShardID: 9},
+		{MinShardID: 9, MaxShardID: 13},
+	}, admin.dropped)
+	require.Empty(t, admin.created)
+}
+
+func TestManageTablePartitions_SkipsUnpartitionedTable(t *testing.T) {
+	admin := &fakeTablePartitionAdmin{}
+	err := newTestActivities(4, 4).manageTablePartitions(context.Background(), admin, sqlplugin.ExecutionsTableName)
+	require.NoError(t, err)
+	require.Empty(t, admin.dropped)
+	require.Empty(t, admin.created)
+}
+
+func TestParseTablePartitionName(t *testing.T) {
+	partition := sqlplugin.TablePartition{MinShardID: 17, MaxShardID: 33}
+	parsed, ok := sqlplugin.ParseTablePartitionName(partition.Name())
+	require.True(t, ok)
+	require.Equal(t, partition, parsed)
+
+	for _, name := range []string{"overflow", "shards_17", "shards_17_33_1", "shards_017_33"} {
+		_, ok := sqlplugin.ParseTablePartitionName(name)
+		require.False(t, ok, name)
+	}
+}
@@ -0,0 +1,503 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityconsistency
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	// InconsistencyMissingRecord is an execution of the primary store without a visibility record.
+	InconsistencyMissingRecord InconsistencyType = "MissingRecord"
+	// InconsistencyStaleRecord is a visibility record whose status differs from the one of its execution.
+	InconsistencyStaleRecord InconsistencyType = "StaleRecord"
+	// InconsistencyGhostRecord is a visibility record of an execution which doesn't exist in the primary store.
+	InconsistencyGhostRecord InconsistencyType = "GhostRecord"
+
+	defaultPageSize                   = 100
+	defaultGracePeriod                = 10 * time.Minute
+	defaultMaxReportedInconsistencies = 1000
+)
+
+type (
+	InconsistencyType string
+
+	// Inconsistency describes a single execution on which the primary store and visibility disagree.
+	Inconsistency struct {
+		Type             InconsistencyType
+		NamespaceID      string
+		WorkflowID       string
+		RunID            string
+		PrimaryStatus    enumspb.WorkflowExecutionStatus
+		VisibilityStatus enumspb.WorkflowExecutionStatus
+		Repaired         bool
+	}
+
+	// Report accumulates the result of a consistency check.
+	Report struct {
+		ExecutionsChecked int
+		RecordsChecked    int
+		MissingRecords    int
+		StaleRecords      int
+		GhostRecords      int
+		Repaired          int
+		// ExpiredRecords is the number of closed records deleted because the visibility retention of their
+		// namespace expired.
+		ExpiredRecords int
+		// Inconsistencies lists the inconsistencies found, up to CheckOptions.MaxReportedInconsistencies.
+		Inconsistencies []Inconsistency
+	}
+
+	// CheckOptions controls a consistency check. The zero value is a dry run.
+	CheckOptions struct {
+		// Repair fixes the inconsistencies found. Missing and stale records are rewritten by regenerating the
+		// visibility tasks of the execution, ghost records are deleted.
+		Repair bool
+		// GracePeriod skips executions updated and records closed more recently than this, since visibility
+		// is written asynchronously and legitimately lags behind the primary store.
+		GracePeriod time.Duration
+		// PageSize is the number of executions or records read per page.
+		PageSize int
+		// MaxReportedInconsistencies caps the number of inconsistencies listed in the report, none are listed if negative.
+		MaxReportedInconsistencies int
+		// DeleteExpiredRecords deletes the closed records of namespaces with a separate visibility retention once
+		// it expires. History schedules their deletion on its own, this only catches the records it missed.
+		DeleteExpiredRecords bool
+	}
+
+	// CheckRequest selects what Checker.Check cross-checks.
+	CheckRequest struct {
+		CheckOptions
+		// ShardIDs are the shards whose executions are checked against visibility.
+		ShardIDs []int32
+		// Namespaces are the names of the namespaces whose visibility records are checked against the primary store.
+		Namespaces []string
+	}
+
+	// NamespaceCursor is the position of a check of the visibility records of a namespace.
+	// Open records are checked first, then closed ones.
+	NamespaceCursor struct {
+		Closed    bool
+		PageToken []byte
+	}
+
+	// Checker cross-checks the executions of the primary store against visibility and vice versa.
+	Checker struct {
+		numShards         int32
+		executionManager  persistence.ExecutionManager
+		visibilityManager manager.VisibilityManager
+		namespaceRegistry namespace.Registry
+		historyClient     historyservice.HistoryServiceClient
+		rateLimiter       quotas.RateLimiter
+		timeSource        clock.TimeSource
+		metricsHandler    metrics.Handler
+		logger            log.Logger
+	}
+)
+
+func NewChecker(
+	numShards int32,
+	executionManager persistence.ExecutionManager,
+	visibilityManager manager.VisibilityManager,
+	namespaceRegistry namespace.Registry,
+	historyClient historyservice.HistoryServiceClient,
+	rateLimiter quotas.RateLimiter,
+	timeSource clock.TimeSource,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *Checker {
+	return &Checker{
+		numShards:         numShards,
+		executionManager:  executionManager,
+		visibilityManager: visibilityManager,
+		namespaceRegistry: namespaceRegistry,
+		historyClient:     historyClient,
+		rateLimiter:       rateLimiter,
+		timeSource:        timeSource,
+		metricsHandler:    metricsHandler,
+		logger:            logger,
+	}
+}
+
+func (o *CheckOptions) setDefaults() {
+	if o.GracePeriod == 0 {
+		o.GracePeriod = defaultGracePeriod
+	}
+	if o.PageSize == 0 {
+		o.PageSize = defaultPageSize
+	}
+	if o.MaxReportedInconsistencies == 0 {
+		o.MaxReportedInconsistencies = defaultMaxReportedInconsistencies
+	}
+}
+
+// Check runs a complete consistency check of the given shards and namespaces.
+func (c *Checker) Check(ctx context.Context, request CheckRequest) (*Report, error) {
+	report := &Report{}
+	for _, shardID := range request.ShardIDs {
+		if shardID < 1 || shardID > c.numShards {
+			return nil, serviceerror.NewInvalidArgument("invalid shard ID")
+		}
+		var pageToken []byte
+		for {
+			var err error
+			if pageToken, err = c.CheckShardPage(ctx, shardID, pageToken, request.CheckOptions, report); err != nil {
+				return nil, err
+			}
+			if len(pageToken) == 0 {
+				break
+			}
+		}
+	}
+	for _, name := range request.Namespaces {
+		ns, err := c.namespaceRegistry.GetNamespace(namespace.Name(name))
+		if err != nil {
+			return nil, err
+		}
+		cursor := &NamespaceCursor{}
+		for cursor != nil {
+			if cursor, err = c.CheckNamespacePage(ctx, ns.ID(), *cursor, request.CheckOptions, report); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return report, nil
+}
+
+// CheckShardPage checks a page of the executions of a shard against visibility, and returns the token of
+// the next page, which is empty once the shard has been checked completely.
+func (c *Checker) CheckShardPage(
+	ctx context.Context,
+	shardID int32,
+	pageToken []byte,
+	options CheckOptions,
+	report *Report,
+) ([]byte, error) {
+	options.setDefaults()
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	resp, err := c.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+		ShardID:   shardID,
+		PageSize:  options.PageSize,
+		PageToken: pageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, state := range resp.States {
+		if err := c.checkExecution(ctx, state, options, report); err != nil {
+			return nil, err
+		}
+	}
+	return resp.PageToken, nil
+}
+
+// CheckNamespacePage checks a page of the visibility records of a namespace against the primary store, and
+// returns the cursor of the next page, which is nil once the namespace has been checked completely.
+func (c *Checker) CheckNamespacePage(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	cursor NamespaceCursor,
+	options CheckOptions,
+	report *Report,
+) (*NamespaceCursor, error) {
+	options.setDefaults()
+	ns, err := c.namespaceRegistry.GetNamespaceByID(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	// Plain listing of open and closed records is the only paginated read every visibility store supports.
+	request := &manager.ListWorkflowExecutionsRequest{
+		NamespaceID:     ns.ID(),
+		Namespace:       ns.Name(),
+		LatestStartTime: c.timeSource.Now(),
+		PageSize:        options.PageSize,
+		NextPageToken:   cursor.PageToken,
+	}
+	var resp *manager.ListWorkflowExecutionsResponse
+	if cursor.Closed {
+		resp, err = c.visibilityManager.ListClosedWorkflowExecutions(ctx, request)
+	} else {
+		resp, err = c.visibilityManager.ListOpenWorkflowExecutions(ctx, request)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range resp.Executions {
+		if err := c.checkRecord(ctx, ns, record.GetExecution(), record.GetStatus(), record.GetStartTime(), record.GetCloseTime(), options, report); err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case len(resp.NextPageToken) != 0:
+		return &NamespaceCursor{Closed: cursor.Closed, PageToken: resp.NextPageToken}, nil
+	case !cursor.Closed:
+		return &NamespaceCursor{Closed: true}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func (c *Checker) checkExecution(
+	ctx context.Context,
+	state *persistencespb.WorkflowMutableState,
+	options CheckOptions,
+	report *Report,
+) error {
+	executionInfo := state.GetExecutionInfo()
+	executionState := state.GetExecutionState()
+	switch executionState.GetState() {
+	case enumsspb.WORKFLOW_EXECUTION_STATE_CREATED, enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE,
+		enumsspb.WORKFLOW_EXECUTION_STATE_VOID, enumsspb.WORKFLOW_EXECUTION_STATE_CORRUPTED:
+		// These executions have no (reliable) visibility record.
+		return nil
+	}
+	if c.withinGracePeriod(executionInfo.GetLastUpdateTime(), options) {
+		return nil
+	}
+	ns, err := c.namespaceRegistry.GetNamespaceByID(namespace.ID(executionInfo.GetNamespaceId()))
+	if err != nil {
+		var notFound *serviceerror.NamespaceNotFound
+		if errors.As(err, &notFound) {
+			// The executions of deleted namespaces are removed together with their visibility records.
+			return nil
+		}
+		return err
+	}
+	if executionState.GetState() == enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED &&
+		c.visibilityRetentionExpired(ns, executionInfo.GetCloseTime()) {
+		// The visibility record is deleted before the execution if the visibility retention is the shorter one.
+		return nil
+	}
+
+	report.ExecutionsChecked++
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		return err
+	}
+	request := &manager.GetWorkflowExecutionRequest{
+		NamespaceID: ns.ID(),
+		Namespace:   ns.Name(),
+		WorkflowID:  executionInfo.GetWorkflowId(),
+		RunID:       executionState.GetRunId(),
+	}
+	// Cassandra visibility finds records by their start time if the execution is open and their close time otherwise.
+	if executionState.GetState() == enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+		request.CloseTime = executionInfo.GetCloseTime()
+	} else {
+		request.StartTime = executionInfo.GetStartTime()
+	}
+	inconsistency := Inconsistency{
+		NamespaceID:   executionInfo.GetNamespaceId(),
+		WorkflowID:    executionInfo.GetWorkflowId(),
+		RunID:         executionState.GetRunId(),
+		PrimaryStatus: executionState.GetStatus(),
+	}
+	resp, err := c.visibilityManager.GetWorkflowExecution(ctx, request)
+	switch err.(type) {
+	case nil:
+		inconsistency.VisibilityStatus = resp.Execution.GetStatus()
+		if inconsistency.VisibilityStatus == inconsistency.PrimaryStatus {
+			return nil
+		}
+		inconsistency.Type = InconsistencyStaleRecord
+	case *serviceerror.NotFound:
+		inconsistency.Type = InconsistencyMissingRecord
+	default:
+		return err
+	}
+
+	if options.Repair {
+		if _, err := c.historyClient.RefreshWorkflowTasks(ctx, &historyservice.RefreshWorkflowTasksRequest{
+			NamespaceId: inconsistency.NamespaceID,
+			Request: &adminservice.RefreshWorkflowTasksRequest{
+				NamespaceId: inconsistency.NamespaceID,
+				Execution: &commonpb.WorkflowExecution{
+					WorkflowId: inconsistency.WorkflowID,
+					RunId:      inconsistency.RunID,
+				},
+			},
+		}); err != nil {
+			return err
+		}
+		inconsistency.Repaired = true
+	}
+	c.record(inconsistency, options, report)
+	return nil
+}
+
+func (c *Checker) checkRecord(
+	ctx context.Context,
+	ns *namespace.Namespace,
+	execution *commonpb.WorkflowExecution,
+	status enumspb.WorkflowExecutionStatus,
+	startTime *time.Time,
+	closeTime *time.Time,
+	options CheckOptions,
+	report *Report,
+) error {
+	// History deletes the execution before its visibility record when the retention of a closed execution expires.
+	if closeTime != nil && c.withinGracePeriod(closeTime, options) {
+		return nil
+	}
+	namespaceID := ns.ID()
+	if closeTime != nil && c.visibilityRetentionExpired(ns, closeTime) {
+		if !options.DeleteExpiredRecords {
+			return nil
+		}
+		return c.deleteExpiredRecord(ctx, ns, execution, startTime, closeTime, report)
+	}
+
+	report.RecordsChecked++
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		return err
+	}
+	_, err := c.executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     common.WorkflowIDToHistoryShard(namespaceID.String(), execution.GetWorkflowId(), c.numShards),
+		NamespaceID: namespaceID.String(),
+		WorkflowID:  execution.GetWorkflowId(),
+		RunID:       execution.GetRunId(),
+	})
+	switch err.(type) {
+	case nil:
+		return nil
+	case *serviceerror.NotFound:
+		if closeTime != nil && ns.HasSeparateVisibilityRetention() {
+			// The history retention deleted the execution and left the record to its visibility retention timer.
+			return nil
+		}
+	default:
+		return err
+	}
+
+	inconsistency := Inconsistency{
+		Type:             InconsistencyGhostRecord,
+		NamespaceID:      namespaceID.String(),
+		WorkflowID:       execution.GetWorkflowId(),
+		RunID:            execution.GetRunId(),
+		VisibilityStatus: status,
+	}
+	if options.Repair {
+		if _, err := c.historyClient.DeleteWorkflowVisibilityRecord(ctx, &historyservice.DeleteWorkflowVisibilityRecordRequest{
+			NamespaceId:       inconsistency.NamespaceID,
+			Execution:         execution,
+			WorkflowStartTime: startTime,
+			WorkflowCloseTime: closeTime,
+		}); err != nil {
+			return err
+		}
+		inconsistency.Repaired = true
+	}
+	c.record(inconsistency, options, report)
+	return nil
+}
+
+func (c *Checker) deleteExpiredRecord(
+	ctx context.Context,
+	ns *namespace.Namespace,
+	execution *commonpb.WorkflowExecution,
+	startTime *time.Time,
+	closeTime *time.Time,
+	report *Report,
+) error {
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		return err
+	}
+	if _, err := c.historyClient.DeleteWorkflowVisibilityRecord(ctx, &historyservice.DeleteWorkflowVisibilityRecordRequest{
+		NamespaceId:       ns.ID().String(),
+		Execution:         execution,
+		WorkflowStartTime: startTime,
+		WorkflowCloseTime: closeTime,
+	}); err != nil {
+		return err
+	}
+	report.ExpiredRecords++
+	c.metricsHandler.Counter(metrics.VisibilityExpiredRecordsDeleted.GetMetricName()).Record(1)
+	return nil
+}
+
+// visibilityRetentionExpired returns true if the namespace retains visibility records separately from history
+// and the retention of a record closed at the given time expired.
+func (c *Checker) visibilityRetentionExpired(ns *namespace.Namespace, closeTime *time.Time) bool {
+	return closeTime != nil && ns.HasSeparateVisibilityRetention() &&
+		c.timeSource.Now().Sub(*closeTime) >= ns.VisibilityRetention()
+}
+
+func (c *Checker) withinGracePeriod(t *time.Time, options CheckOptions) bool {
+	return t != nil && c.timeSource.Now().Sub(*t) < options.GracePeriod
+}
+
+func (c *Checker) record(inconsistency Inconsistency, options CheckOptions, report *Report) {
+	var metricName string
+	switch inconsistency.Type {
+	case InconsistencyMissingRecord:
+		report.MissingRecords++
+		metricName = metrics.VisibilityMissingRecords.GetMetricName()
+	case InconsistencyStaleRecord:
+		report.StaleRecords++
+		metricName = metrics.VisibilityStaleRecords.GetMetricName()
+	case InconsistencyGhostRecord:
+		report.GhostRecords++
+		metricName = metrics.VisibilityGhostRecords.GetMetricName()
+	}
+	c.metricsHandler.Counter(metricName).Record(1)
+	if inconsistency.Repaired {
+		report.Repaired++
+		c.metricsHandler.Counter(metrics.VisibilityRecordsRepaired.GetMetricName()).Record(1)
+	}
+	if len(report.Inconsistencies) < options.MaxReportedInconsistencies {
+		report.Inconsistencies = append(report.Inconsistencies, inconsistency)
+	}
+	c.logger.Warn("Visibility record inconsistent with primary store",
+		tag.NewStringTag("inconsistency", string(inconsistency.Type)),
+		tag.WorkflowNamespaceID(inconsistency.NamespaceID),
+		tag.WorkflowID(inconsistency.WorkflowID),
+		tag.WorkflowRunID(inconsistency.RunID),
+		tag.NewBoolTag("repaired", inconsistency.Repaired))
+}
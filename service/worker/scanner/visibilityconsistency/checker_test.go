@@ -0,0 +1,340 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityconsistency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	testNamespaceID = "namespace-id"
+	testNamespace   = "namespace"
+)
+
+type testChecker struct {
+	*Checker
+	executionManager *persistence.MockExecutionManager
+	historyClient    *historyservicemock.MockHistoryServiceClient
+}
+
+func newTestMutableState(
+	workflowID string,
+	state enumsspb.WorkflowExecutionState,
+	status enumspb.WorkflowExecutionStatus,
+	lastUpdateTime time.Time,
+) *persistencespb.WorkflowMutableState {
+	startTime := lastUpdateTime.Add(-time.Hour)
+	return &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId:    testNamespaceID,
+			WorkflowId:     workflowID,
+			StartTime:      &startTime,
+			LastUpdateTime: &lastUpdateTime,
+			CloseTime:      &lastUpdateTime,
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			RunId:  workflowID + "-run",
+			State:  state,
+			Status: status,
+		},
+	}
+}
+
+func newTestRecord(workflowID string, closeTime *time.Time) *workflowpb.WorkflowExecutionInfo {
+	status := enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+	if closeTime != nil {
+		status = enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	}
+	return &workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: workflowID + "-run"},
+		Status:    status,
+		CloseTime: closeTime,
+	}
+}
+
+// newTestChecker returns a checker over a single shard and namespace, holding:
+// - "ok", an execution with a matching visibility record,
+// - "missing", an execution without visibility record,
+// - "stale", a completed execution whose visibility record is still running,
+// - "recent", an execution without visibility record which was just updated,
+// - "ghost", a closed visibility record without execution,
+// - "retained", a visibility record without execution which was just closed.
+func newTestChecker(t *testing.T) *testChecker {
+	ctrl := gomock.NewController(t)
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	visibilityManager := manager.NewMockVisibilityManager(ctrl)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	historyClient := historyservicemock.NewMockHistoryServiceClient(ctrl)
+
+	now := time.Now()
+	timeSource := clock.NewEventTimeSource().Update(now)
+	ns := namespace.NewNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: testNamespaceID, Name: testNamespace},
+		nil,
+		false,
+		nil,
+		0,
+	)
+	namespaceRegistry.EXPECT().GetNamespace(namespace.Name(testNamespace)).Return(ns, nil).AnyTimes()
+	namespaceRegistry.EXPECT().GetNamespaceByID(namespace.ID(testNamespaceID)).Return(ns, nil).AnyTimes()
+
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:  1,
+		PageSize: defaultPageSize,
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			newTestMutableState("ok", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, now.Add(-time.Hour)),
+			newTestMutableState("missing", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, now.Add(-time.Hour)),
+			newTestMutableState("stale", enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, now.Add(-time.Hour)),
+			newTestMutableState("recent", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, now.Add(-time.Minute)),
+		},
+	}, nil)
+	visibilityManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.GetWorkflowExecutionRequest) (*manager.GetWorkflowExecutionResponse, error) {
+			switch request.WorkflowID {
+			case "ok":
+				require.NotNil(t, request.StartTime)
+				return &manager.GetWorkflowExecutionResponse{Execution: newTestRecord("ok", nil)}, nil
+			case "stale":
+				require.NotNil(t, request.CloseTime)
+				return &manager.GetWorkflowExecutionResponse{Execution: newTestRecord("stale", nil)}, nil
+			case "missing":
+				return nil, serviceerror.NewNotFound("not found")
+			}
+			t.Fatalf("unexpected visibility read of %v", request.WorkflowID)
+			return nil, nil
+		}).Times(3)
+
+	closedLongAgo := now.Add(-24 * time.Hour)
+	closedRecently := now.Add(-time.Minute)
+	visibilityManager.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{newTestRecord("ok", nil)},
+	}, nil)
+	visibilityManager.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{newTestRecord("ghost", &closedLongAgo), newTestRecord("retained", &closedRecently)},
+	}, nil)
+	executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.GetWorkflowExecutionRequest) (*persistence.GetWorkflowExecutionResponse, error) {
+			require.Equal(t, int32(1), request.ShardID)
+			switch request.WorkflowID {
+			case "ok":
+				return &persistence.GetWorkflowExecutionResponse{}, nil
+			case "ghost":
+				return nil, serviceerror.NewNotFound("not found")
+			}
+			t.Fatalf("unexpected execution read of %v", request.WorkflowID)
+			return nil, nil
+		}).Times(2)
+
+	return &testChecker{
+		Checker: NewChecker(
+			1,
+			executionManager,
+			visibilityManager,
+			namespaceRegistry,
+			historyClient,
+			quotas.NewRateLimiter(1000, 1000),
+			timeSource,
+			metrics.NoopMetricsHandler,
+			log.NewNoopLogger(),
+		),
+		executionManager: executionManager,
+		historyClient:    historyClient,
+	}
+}
+
+func Test_Check_DryRun(t *testing.T) {
+	c := newTestChecker(t)
+
+	report, err := c.Check(context.Background(), CheckRequest{
+		ShardIDs:   []int32{1},
+		Namespaces: []string{testNamespace},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, report.ExecutionsChecked)
+	require.Equal(t, 2, report.RecordsChecked)
+	require.Equal(t, 1, report.MissingRecords)
+	require.Equal(t, 1, report.StaleRecords)
+	require.Equal(t, 1, report.GhostRecords)
+	require.Equal(t, 0, report.Repaired)
+	require.Equal(t, []Inconsistency{
+		{
+			Type:          InconsistencyMissingRecord,
+			NamespaceID:   testNamespaceID,
+			WorkflowID:    "missing",
+			RunID:         "missing-run",
+			PrimaryStatus: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+		{
+			Type:             InconsistencyStaleRecord,
+			NamespaceID:      testNamespaceID,
+			WorkflowID:       "stale",
+			RunID:            "stale-run",
+			PrimaryStatus:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			VisibilityStatus: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+		{
+			Type:             InconsistencyGhostRecord,
+			NamespaceID:      testNamespaceID,
+			WorkflowID:       "ghost",
+			RunID:            "ghost-run",
+			VisibilityStatus: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		},
+	}, report.Inconsistencies)
+}
+
+func Test_Check_Repair(t *testing.T) {
+	c := newTestChecker(t)
+
+	var refreshed []string
+	c.historyClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.RefreshWorkflowTasksRequest, _ ...interface{}) (*historyservice.RefreshWorkflowTasksResponse, error) {
+			require.Equal(t, testNamespaceID, request.NamespaceId)
+			refreshed = append(refreshed, request.Request.Execution.WorkflowId)
+			return &historyservice.RefreshWorkflowTasksResponse{}, nil
+		}).Times(2)
+	c.historyClient.EXPECT().DeleteWorkflowVisibilityRecord(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.DeleteWorkflowVisibilityRecordRequest, _ ...interface{}) (*historyservice.DeleteWorkflowVisibilityRecordResponse, error) {
+			require.Equal(t, testNamespaceID, request.NamespaceId)
+			require.Equal(t, "ghost", request.Execution.WorkflowId)
+			require.NotNil(t, request.WorkflowCloseTime)
+			return &historyservice.DeleteWorkflowVisibilityRecordResponse{}, nil
+		})
+
+	report, err := c.Check(context.Background(), CheckRequest{
+		CheckOptions: CheckOptions{Repair: true},
+		ShardIDs:     []int32{1},
+		Namespaces:   []string{testNamespace},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, report.Repaired)
+	require.Equal(t, []string{"missing", "stale"}, refreshed)
+	for _, inconsistency := range report.Inconsistencies {
+		require.True(t, inconsistency.Repaired)
+	}
+}
+
+func Test_Check_SeparateVisibilityRetention(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	visibilityManager := manager.NewMockVisibilityManager(ctrl)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	historyClient := historyservicemock.NewMockHistoryServiceClient(ctrl)
+
+	now := time.Now()
+	ns := namespace.NewNamespaceForTest(
+		&persistencespb.NamespaceInfo{
+			Id:   testNamespaceID,
+			Name: testNamespace,
+		},
+		&persistencespb.NamespaceConfig{VisibilityRetention: timestamp.DurationFromDays(2)},
+		false,
+		nil,
+		0,
+	)
+	namespaceRegistry.EXPECT().GetNamespace(namespace.Name(testNamespace)).Return(ns, nil).AnyTimes()
+	namespaceRegistry.EXPECT().GetNamespaceByID(namespace.ID(testNamespaceID)).Return(ns, nil).AnyTimes()
+
+	// The record of an execution closed before the visibility retention is expected to be deleted already.
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			newTestMutableState("expired", enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, now.Add(-72*time.Hour)),
+		},
+	}, nil)
+
+	closedBeforeRetention := now.Add(-72 * time.Hour)
+	closedWithinRetention := now.Add(-36 * time.Hour)
+	visibilityManager.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.ListWorkflowExecutionsResponse{}, nil)
+	visibilityManager.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			newTestRecord("expired", &closedBeforeRetention),
+			newTestRecord("retained", &closedWithinRetention),
+		},
+	}, nil)
+	// The history retention deleted the execution of the retained record.
+	executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.GetWorkflowExecutionRequest) (*persistence.GetWorkflowExecutionResponse, error) {
+			require.Equal(t, "retained", request.WorkflowID)
+			return nil, serviceerror.NewNotFound("not found")
+		})
+	historyClient.EXPECT().DeleteWorkflowVisibilityRecord(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.DeleteWorkflowVisibilityRecordRequest, _ ...interface{}) (*historyservice.DeleteWorkflowVisibilityRecordResponse, error) {
+			require.Equal(t, "expired", request.Execution.WorkflowId)
+			require.Equal(t, &closedBeforeRetention, request.WorkflowCloseTime)
+			return &historyservice.DeleteWorkflowVisibilityRecordResponse{}, nil
+		})
+
+	c := NewChecker(
+		1,
+		executionManager,
+		visibilityManager,
+		namespaceRegistry,
+		historyClient,
+		quotas.NewRateLimiter(1000, 1000),
+		clock.NewEventTimeSource().Update(now),
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	)
+	report, err := c.Check(context.Background(), CheckRequest{
+		CheckOptions: CheckOptions{Repair: true, DeleteExpiredRecords: true},
+		ShardIDs:     []int32{1},
+		Namespaces:   []string{testNamespace},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, report.ExecutionsChecked)
+	require.Equal(t, 1, report.RecordsChecked)
+	require.Equal(t, 0, report.GhostRecords)
+	require.Equal(t, 1, report.ExpiredRecords)
+	require.Empty(t, report.Inconsistencies)
+}
+
+func Test_Check_InvalidShard(t *testing.T) {
+	c := NewChecker(1, nil, nil, nil, nil, quotas.NewRateLimiter(1000, 1000), clock.NewRealTimeSource(), metrics.NoopMetricsHandler, log.NewNoopLogger())
+
+	_, err := c.Check(context.Background(), CheckRequest{ShardIDs: []int32{2}})
+	require.IsType(t, &serviceerror.InvalidArgument{}, err)
+}
@@ -0,0 +1,259 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityconsistency
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	VisibilityConsistencyScannerWorkflowName = "visibility-consistency-scanner"
+	VisibilityConsistencyScannerActivityName = "check-visibility-consistency"
+
+	VisibilityConsistencyScannerWFID          = "temporal-sys-visibility-consistency-scanner"
+	VisibilityConsistencyScannerTaskQueueName = "temporal-sys-visibility-consistency-scanner-taskqueue-0"
+)
+
+var (
+	VisibilityConsistencyScannerWFStartOptions = client.StartWorkflowOptions{
+		ID:                    VisibilityConsistencyScannerWFID,
+		TaskQueue:             VisibilityConsistencyScannerTaskQueueName,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		CronSchedule:          "0 */12 * * *",
+	}
+)
+
+type (
+	VisibilityConsistencyScannerInput struct {
+		PageSize              int
+		NamespaceListPageSize int
+	}
+
+	Activities struct {
+		logger            log.Logger
+		metricsHandler    metrics.Handler
+		executionManager  persistence.ExecutionManager
+		visibilityManager manager.VisibilityManager
+		metadataManager   persistence.MetadataManager
+		namespaceRegistry namespace.Registry
+		historyClient     historyservice.HistoryServiceClient
+		numShards         int32
+		perHostQPS        dynamicconfig.IntPropertyFn
+		repairEnabled     dynamicconfig.BoolPropertyFn
+	}
+
+	heartbeatDetails struct {
+		ShardID         int32
+		PageToken       []byte
+		NamespaceID     string
+		NamespaceCursor NamespaceCursor
+		Report          Report
+	}
+)
+
+func NewActivities(
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	executionManager persistence.ExecutionManager,
+	visibilityManager manager.VisibilityManager,
+	metadataManager persistence.MetadataManager,
+	namespaceRegistry namespace.Registry,
+	historyClient historyservice.HistoryServiceClient,
+	numShards int32,
+	perHostQPS dynamicconfig.IntPropertyFn,
+	repairEnabled dynamicconfig.BoolPropertyFn,
+) *Activities {
+	return &Activities{
+		logger:            logger,
+		metricsHandler:    metricsHandler.WithTags(metrics.OperationTag(metrics.VisibilityConsistencyScannerScope)),
+		executionManager:  executionManager,
+		visibilityManager: visibilityManager,
+		metadataManager:   metadataManager,
+		namespaceRegistry: namespaceRegistry,
+		historyClient:     historyClient,
+		numShards:         numShards,
+		perHostQPS:        perHostQPS,
+		repairEnabled:     repairEnabled,
+	}
+}
+
+// VisibilityConsistencyScannerWorkflow cross-checks the executions of the primary store against visibility records
+// and vice versa, and repairs the inconsistencies found if the scanner is configured to.
+// This workflow is a wrapper around the long running CheckVisibilityConsistency activity.
+func VisibilityConsistencyScannerWorkflow(ctx workflow.Context, input VisibilityConsistencyScannerInput) error {
+	activityCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		// Give the activity enough time to scan all the shards and namespaces
+		StartToCloseTimeout: 12 * time.Hour,
+		HeartbeatTimeout:    5 * time.Minute,
+	})
+	return workflow.ExecuteActivity(activityCtx, VisibilityConsistencyScannerActivityName, input).Get(ctx, nil)
+}
+
+func (a *Activities) setDefaults(input *VisibilityConsistencyScannerInput) {
+	if input.PageSize == 0 {
+		input.PageSize = 100
+	}
+	if input.NamespaceListPageSize == 0 {
+		input.NamespaceListPageSize = 100
+	}
+}
+
+// CheckVisibilityConsistency checks the executions of all the shards against visibility, then the visibility
+// records of all the namespaces against the primary store.
+func (a *Activities) CheckVisibilityConsistency(ctx context.Context, input VisibilityConsistencyScannerInput) error {
+	a.setDefaults(&input)
+
+	var heartbeat heartbeatDetails
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &heartbeat); err != nil {
+			return temporal.NewNonRetryableApplicationError("failed to load previous heartbeat details", "TypeError", err)
+		}
+	}
+	if heartbeat.ShardID == 0 {
+		heartbeat.ShardID = 1
+	}
+
+	rps := float64(a.perHostQPS())
+	checker := NewChecker(
+		a.numShards,
+		a.executionManager,
+		a.visibilityManager,
+		a.namespaceRegistry,
+		a.historyClient,
+		quotas.NewRateLimiter(rps, int(math.Ceil(rps))),
+		clock.NewRealTimeSource(),
+		a.metricsHandler,
+		a.logger,
+	)
+	options := CheckOptions{
+		Repair:   a.repairEnabled(),
+		PageSize: input.PageSize,
+		// Only the counts are kept in the report which goes into heartbeats, inconsistencies are logged.
+		MaxReportedInconsistencies: -1,
+		// Backs up the visibility retention timers of history for the records they missed.
+		DeleteExpiredRecords: true,
+	}
+
+	for heartbeat.ShardID <= a.numShards {
+		pageToken, err := checker.CheckShardPage(ctx, heartbeat.ShardID, heartbeat.PageToken, options, &heartbeat.Report)
+		if err != nil {
+			return err
+		}
+		heartbeat.PageToken = pageToken
+		if len(heartbeat.PageToken) == 0 {
+			heartbeat.ShardID++
+		}
+		activity.RecordHeartbeat(ctx, heartbeat)
+	}
+
+	namespaceIDs, err := a.listNamespaceIDs(ctx, input.NamespaceListPageSize)
+	if err != nil {
+		return err
+	}
+	for _, namespaceID := range namespaceIDs {
+		if namespaceID < heartbeat.NamespaceID {
+			continue
+		}
+		if namespaceID != heartbeat.NamespaceID {
+			heartbeat.NamespaceID = namespaceID
+			heartbeat.NamespaceCursor = NamespaceCursor{}
+		}
+		for {
+			cursor, err := checker.CheckNamespacePage(ctx, namespace.ID(namespaceID), heartbeat.NamespaceCursor, options, &heartbeat.Report)
+			if err != nil {
+				var notFound *serviceerror.NamespaceNotFound
+				if !errors.As(err, &notFound) {
+					return err
+				}
+				// The namespace was deleted since it was listed.
+				cursor = nil
+			}
+			if cursor == nil {
+				break
+			}
+			heartbeat.NamespaceCursor = *cursor
+			activity.RecordHeartbeat(ctx, heartbeat)
+		}
+	}
+
+	report := heartbeat.Report
+	a.logger.Info("Visibility consistency scan completed",
+		tag.NewInt("executions-checked", report.ExecutionsChecked),
+		tag.NewInt("records-checked", report.RecordsChecked),
+		tag.NewInt("missing-records", report.MissingRecords),
+		tag.NewInt("stale-records", report.StaleRecords),
+		tag.NewInt("ghost-records", report.GhostRecords),
+		tag.NewInt("repaired", report.Repaired),
+		tag.NewInt("expired-records", report.ExpiredRecords))
+	return nil
+}
+
+// listNamespaceIDs returns the IDs of all the namespaces in a stable order, so a retried activity can resume
+// from the namespace it was checking.
+func (a *Activities) listNamespaceIDs(ctx context.Context, pageSize int) ([]string, error) {
+	var namespaceIDs []string
+	var nextPageToken []byte
+	for {
+		resp, err := a.metadataManager.ListNamespaces(ctx, &persistence.ListNamespacesRequest{
+			PageSize:       pageSize,
+			NextPageToken:  nextPageToken,
+			IncludeDeleted: false,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, ns := range resp.Namespaces {
+			namespaceIDs = append(namespaceIDs, ns.Namespace.Info.Id)
+		}
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+	sort.Strings(namespaceIDs)
+	return namespaceIDs, nil
+}
@@ -0,0 +1,243 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityexport
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	// schemaVersion is bumped whenever a core column changes in a way readers can't handle by merging
+	// schemas, i.e. when one is renamed or changes type. Adding columns doesn't require a new version.
+	schemaVersion = "1"
+
+	// searchAttributeColumnPrefix prefixes the column of each search attribute, so they can't collide
+	// with the core columns.
+	searchAttributeColumnPrefix = "sa_"
+
+	metadataSchemaVersion        = "temporal.schema_version"
+	metadataNamespace            = "temporal.namespace"
+	metadataNamespaceID          = "temporal.namespace_id"
+	metadataSearchAttributeTypes = "temporal.search_attribute_types"
+)
+
+// coreColumns are the columns every export file starts with. The search attribute columns follow in name
+// order, and only the search attributes set on at least one record of a file have a column in it. Every
+// search attribute column is optional, so readers merging the schemas of files by column name see a
+// search attribute as null in files written before it was added, or after it was removed.
+var coreColumns = []parquetColumn{
+	{name: "namespace_id", kind: columnString},
+	{name: "namespace", kind: columnString},
+	{name: "workflow_id", kind: columnString},
+	{name: "run_id", kind: columnString},
+	{name: "workflow_type", kind: columnString},
+	{name: "task_queue", kind: columnString, optional: true},
+	{name: "status", kind: columnString},
+	{name: "start_time", kind: columnTimestamp},
+	{name: "execution_time", kind: columnTimestamp, optional: true},
+	{name: "close_time", kind: columnTimestamp},
+	{name: "history_length", kind: columnInt64},
+	{name: "history_size_bytes", kind: columnInt64},
+	{name: "state_transition_count", kind: columnInt64},
+	{name: "parent_workflow_id", kind: columnString, optional: true},
+	{name: "parent_run_id", kind: columnString, optional: true},
+	{name: "memo", kind: columnString, optional: true},
+}
+
+// encodeExportFile encodes closed workflow execution records as a Parquet file.
+func encodeExportFile(ns *namespace.Namespace, records []*workflowpb.WorkflowExecutionInfo) ([]byte, error) {
+	searchAttributes := make([]map[string]interface{}, len(records))
+	saTypes := make(map[string]enumspb.IndexedValueType)
+	for i, record := range records {
+		values, err := searchattribute.Decode(record.GetSearchAttributes(), nil, false)
+		if err != nil {
+			return nil, err
+		}
+		searchAttributes[i] = values
+		for name, saPayload := range record.GetSearchAttributes().GetIndexedFields() {
+			saType := enumspb.IndexedValueType(
+				enumspb.IndexedValueType_value[string(saPayload.GetMetadata()[searchattribute.MetadataType])],
+			)
+			if previous, ok := saTypes[name]; ok && previous != saType {
+				// The search attribute was removed and added back with another type since the first record
+				// was written, its values are exported as strings.
+				saType = enumspb.INDEXED_VALUE_TYPE_KEYWORD
+			}
+			saTypes[name] = saType
+		}
+	}
+	saNames := maps.Keys(saTypes)
+	slices.Sort(saNames)
+
+	columns := slices.Clone(coreColumns)
+	saTypeNames := make(map[string]string, len(saNames))
+	for _, name := range saNames {
+		columns = append(columns, parquetColumn{
+			name:     searchAttributeColumnPrefix + name,
+			kind:     searchAttributeColumnKind(saTypes[name]),
+			optional: true,
+		})
+		saTypeNames[name] = saTypes[name].String()
+	}
+
+	writer := newParquetWriter(columns)
+	for i, record := range records {
+		row, err := coreRow(ns, record)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range saNames {
+			value, err := searchAttributeValue(searchAttributes[i][name], saTypes[name])
+			if err != nil {
+				return nil, err
+			}
+			row = append(row, value)
+		}
+		if err := writer.AppendRow(row); err != nil {
+			return nil, err
+		}
+	}
+
+	saTypesJSON, err := json.Marshal(saTypeNames)
+	if err != nil {
+		return nil, err
+	}
+	return writer.Bytes(map[string]string{
+		metadataSchemaVersion:        schemaVersion,
+		metadataNamespace:            ns.Name().String(),
+		metadataNamespaceID:          ns.ID().String(),
+		metadataSearchAttributeTypes: string(saTypesJSON),
+	})
+}
+
+func coreRow(ns *namespace.Namespace, record *workflowpb.WorkflowExecutionInfo) ([]interface{}, error) {
+	if record.GetStartTime() == nil || record.GetCloseTime() == nil {
+		return nil, fmt.Errorf("record of workflow %s run %s is not closed",
+			record.GetExecution().GetWorkflowId(), record.GetExecution().GetRunId())
+	}
+	memo, err := memoValue(record)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{
+		ns.ID().String(),
+		ns.Name().String(),
+		record.GetExecution().GetWorkflowId(),
+		record.GetExecution().GetRunId(),
+		record.GetType().GetName(),
+		optionalString(record.GetTaskQueue()),
+		record.GetStatus().String(),
+		*record.GetStartTime(),
+		optionalTime(record.GetExecutionTime()),
+		*record.GetCloseTime(),
+		record.GetHistoryLength(),
+		record.GetHistorySizeBytes(),
+		record.GetStateTransitionCount(),
+		optionalString(record.GetParentExecution().GetWorkflowId()),
+		optionalString(record.GetParentExecution().GetRunId()),
+		memo,
+	}, nil
+}
+
+// memoValue returns the memo of a record as a JSON object, or nil if it has none.
+func memoValue(record *workflowpb.WorkflowExecutionInfo) (interface{}, error) {
+	fields := record.GetMemo().GetFields()
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	memo := make(map[string]interface{}, len(fields))
+	for name, memoPayload := range fields {
+		var value interface{}
+		if err := payload.Decode(memoPayload, &value); err != nil {
+			// Memos aren't necessarily JSON, fall back to the string representation of the payload.
+			value = payload.ToString(memoPayload)
+		}
+		memo[name] = value
+	}
+	encoded, err := json.Marshal(memo)
+	if err != nil {
+		return nil, err
+	}
+	return string(encoded), nil
+}
+
+func searchAttributeColumnKind(saType enumspb.IndexedValueType) columnKind {
+	switch saType {
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		return columnBool
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		return columnTimestamp
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		return columnDouble
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		return columnInt64
+	default:
+		return columnString
+	}
+}
+
+// searchAttributeValue converts a decoded search attribute value to the value of its column. Keyword lists,
+// and values of search attributes exported as strings, are JSON encoded.
+func searchAttributeValue(value interface{}, saType enumspb.IndexedValueType) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if searchAttributeColumnKind(saType) != columnString {
+		return value, nil
+	}
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return string(encoded), nil
+}
+
+func optionalString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+func optionalTime(t *time.Time) interface{} {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	return *t
+}
@@ -0,0 +1,411 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityexport
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// This is a minimal Parquet writer: flat schemas of required and optional columns, a single row group,
+// one uncompressed data page per column and PLAIN encoded values. It is all the export needs, and any
+// Parquet reader can read it.
+
+const (
+	parquetMagic     = "PAR1"
+	parquetCreatedBy = "temporal visibility export"
+
+	parquetTypeBoolean   int32 = 0
+	parquetTypeInt64     int32 = 2
+	parquetTypeDouble    int32 = 5
+	parquetTypeByteArray int32 = 6
+
+	parquetConvertedUTF8            int32 = 0
+	parquetConvertedTimestampMillis int32 = 9
+
+	parquetRepetitionRequired int32 = 0
+	parquetRepetitionOptional int32 = 1
+
+	parquetEncodingPlain int32 = 0
+	parquetEncodingRLE   int32 = 3
+
+	parquetCodecUncompressed int32 = 0
+	parquetPageTypeData      int32 = 0
+)
+
+const (
+	columnString columnKind = iota
+	columnInt64
+	columnDouble
+	columnBool
+	columnTimestamp
+)
+
+type (
+	columnKind int
+
+	parquetColumn struct {
+		name     string
+		kind     columnKind
+		optional bool
+	}
+
+	// parquetWriter buffers rows and encodes them as a Parquet file.
+	parquetWriter struct {
+		columns []parquetColumn
+		values  [][]interface{}
+		numRows int
+	}
+
+	// thriftStruct is a thrift struct in field ID order. Field values are int32, int64, string,
+	// thriftStruct or lists of those.
+	thriftStruct []thriftField
+	thriftField  struct {
+		id    int16
+		value interface{}
+	}
+)
+
+func newParquetWriter(columns []parquetColumn) *parquetWriter {
+	return &parquetWriter{
+		columns: columns,
+		values:  make([][]interface{}, len(columns)),
+	}
+}
+
+// AppendRow adds a row holding a value per column: a string, int64, float64, bool or time.Time
+// depending on the column kind, or nil for a null value of an optional column.
+func (w *parquetWriter) AppendRow(row []interface{}) error {
+	if len(row) != len(w.columns) {
+		return fmt.Errorf("row has %d values, expected %d", len(row), len(w.columns))
+	}
+	for i, value := range row {
+		if err := w.columns[i].validate(value); err != nil {
+			return err
+		}
+	}
+	for i, value := range row {
+		w.values[i] = append(w.values[i], value)
+	}
+	w.numRows++
+	return nil
+}
+
+// NumRows returns the number of rows appended so far.
+func (w *parquetWriter) NumRows() int {
+	return w.numRows
+}
+
+// Bytes encodes the rows appended so far as a Parquet file with the given key-value metadata.
+func (w *parquetWriter) Bytes(metadata map[string]string) ([]byte, error) {
+	ctx := context.Background()
+	var file bytes.Buffer
+	file.WriteString(parquetMagic)
+
+	var chunks []thriftStruct
+	var totalSize int64
+	for i, column := range w.columns {
+		page, err := w.encodePage(ctx, i)
+		if err != nil {
+			return nil, err
+		}
+		offset := int64(file.Len())
+		file.Write(page)
+		totalSize += int64(len(page))
+		chunks = append(chunks, thriftStruct{
+			{2, offset},
+			{3, thriftStruct{
+				{1, column.physicalType()},
+				{2, []int32{parquetEncodingPlain, parquetEncodingRLE}},
+				{3, []string{column.name}},
+				{4, parquetCodecUncompressed},
+				{5, int64(w.numRows)},
+				{6, int64(len(page))},
+				{7, int64(len(page))},
+				{9, offset},
+			}},
+		})
+	}
+
+	schema := []thriftStruct{{
+		{4, "schema"},
+		{5, int32(len(w.columns))},
+	}}
+	for _, column := range w.columns {
+		schema = append(schema, column.schemaElement())
+	}
+	keys := maps.Keys(metadata)
+	slices.Sort(keys)
+	var keyValues []thriftStruct
+	for _, key := range keys {
+		keyValues = append(keyValues, thriftStruct{{1, key}, {2, metadata[key]}})
+	}
+	footer, err := encodeThrift(ctx, thriftStruct{
+		{1, int32(1)},
+		{2, schema},
+		{3, int64(w.numRows)},
+		{4, []thriftStruct{{
+			{1, chunks},
+			{2, totalSize},
+			{3, int64(w.numRows)},
+		}}},
+		{5, keyValues},
+		{6, parquetCreatedBy},
+	})
+	if err != nil {
+		return nil, err
+	}
+	file.Write(footer)
+	if err := binary.Write(&file, binary.LittleEndian, uint32(len(footer))); err != nil {
+		return nil, err
+	}
+	file.WriteString(parquetMagic)
+	return file.Bytes(), nil
+}
+
+// encodePage encodes the values of a column as a data page, including its header.
+func (w *parquetWriter) encodePage(ctx context.Context, i int) ([]byte, error) {
+	column := w.columns[i]
+	var body bytes.Buffer
+	if column.optional {
+		defined := make([]bool, len(w.values[i]))
+		for j, value := range w.values[i] {
+			defined[j] = value != nil
+		}
+		levels := encodeDefinitionLevels(defined)
+		if err := binary.Write(&body, binary.LittleEndian, uint32(len(levels))); err != nil {
+			return nil, err
+		}
+		body.Write(levels)
+	}
+	body.Write(column.encodeValues(w.values[i]))
+
+	header, err := encodeThrift(ctx, thriftStruct{
+		{1, parquetPageTypeData},
+		{2, int32(body.Len())},
+		{3, int32(body.Len())},
+		{5, thriftStruct{
+			{1, int32(w.numRows)},
+			{2, parquetEncodingPlain},
+			{3, parquetEncodingRLE},
+			{4, parquetEncodingRLE},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(header, body.Bytes()...), nil
+}
+
+// encodeDefinitionLevels encodes definition levels of bit width 1 as a single bit-packed run of the
+// RLE/bit-packing hybrid encoding.
+func encodeDefinitionLevels(defined []bool) []byte {
+	numGroups := (len(defined) + 7) / 8
+	levels := binary.AppendUvarint(nil, uint64(numGroups)<<1|1)
+	return append(levels, packBits(defined, numGroups)...)
+}
+
+func packBits(bits []bool, numBytes int) []byte {
+	packed := make([]byte, numBytes)
+	for i, bit := range bits {
+		if bit {
+			packed[i/8] |= 1 << (i % 8)
+		}
+	}
+	return packed
+}
+
+func (c parquetColumn) validate(value interface{}) error {
+	if value == nil {
+		if !c.optional {
+			return fmt.Errorf("column %s is required", c.name)
+		}
+		return nil
+	}
+	var ok bool
+	switch c.kind {
+	case columnString:
+		_, ok = value.(string)
+	case columnInt64:
+		_, ok = value.(int64)
+	case columnDouble:
+		_, ok = value.(float64)
+	case columnBool:
+		_, ok = value.(bool)
+	case columnTimestamp:
+		_, ok = value.(time.Time)
+	}
+	if !ok {
+		return fmt.Errorf("column %s can't hold value of type %T", c.name, value)
+	}
+	return nil
+}
+
+// encodeValues PLAIN encodes the non-null values of the column.
+func (c parquetColumn) encodeValues(values []interface{}) []byte {
+	var encoded []byte
+	var bits []bool
+	for _, value := range values {
+		switch v := value.(type) {
+		case nil:
+		case string:
+			encoded = binary.LittleEndian.AppendUint32(encoded, uint32(len(v)))
+			encoded = append(encoded, v...)
+		case int64:
+			encoded = binary.LittleEndian.AppendUint64(encoded, uint64(v))
+		case float64:
+			encoded = binary.LittleEndian.AppendUint64(encoded, math.Float64bits(v))
+		case bool:
+			bits = append(bits, v)
+		case time.Time:
+			encoded = binary.LittleEndian.AppendUint64(encoded, uint64(v.UnixMilli()))
+		}
+	}
+	if c.kind == columnBool {
+		return packBits(bits, (len(bits)+7)/8)
+	}
+	return encoded
+}
+
+func (c parquetColumn) physicalType() int32 {
+	switch c.kind {
+	case columnInt64, columnTimestamp:
+		return parquetTypeInt64
+	case columnDouble:
+		return parquetTypeDouble
+	case columnBool:
+		return parquetTypeBoolean
+	default:
+		return parquetTypeByteArray
+	}
+}
+
+func (c parquetColumn) schemaElement() thriftStruct {
+	repetition := parquetRepetitionRequired
+	if c.optional {
+		repetition = parquetRepetitionOptional
+	}
+	element := thriftStruct{
+		{1, c.physicalType()},
+		{3, repetition},
+		{4, c.name},
+	}
+	switch c.kind {
+	case columnString:
+		element = append(element, thriftField{6, parquetConvertedUTF8})
+	case columnTimestamp:
+		element = append(element, thriftField{6, parquetConvertedTimestampMillis})
+	}
+	return element
+}
+
+func encodeThrift(ctx context.Context, s thriftStruct) ([]byte, error) {
+	buffer := thrift.NewTMemoryBuffer()
+	protocol := thrift.NewTCompactProtocolConf(buffer, &thrift.TConfiguration{})
+	if err := writeThriftStruct(ctx, protocol, s); err != nil {
+		return nil, err
+	}
+	if err := protocol.Flush(ctx); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func writeThriftStruct(ctx context.Context, p thrift.TProtocol, s thriftStruct) error {
+	if err := p.WriteStructBegin(ctx, ""); err != nil {
+		return err
+	}
+	for _, field := range s {
+		if err := p.WriteFieldBegin(ctx, "", thriftType(field.value), field.id); err != nil {
+			return err
+		}
+		if err := writeThriftValue(ctx, p, field.value); err != nil {
+			return err
+		}
+		if err := p.WriteFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := p.WriteFieldStop(ctx); err != nil {
+		return err
+	}
+	return p.WriteStructEnd(ctx)
+}
+
+func writeThriftValue(ctx context.Context, p thrift.TProtocol, value interface{}) error {
+	switch v := value.(type) {
+	case int32:
+		return p.WriteI32(ctx, v)
+	case int64:
+		return p.WriteI64(ctx, v)
+	case string:
+		return p.WriteString(ctx, v)
+	case thriftStruct:
+		return writeThriftStruct(ctx, p, v)
+	case []int32:
+		return writeThriftList(ctx, p, thrift.I32, len(v), func(i int) interface{} { return v[i] })
+	case []string:
+		return writeThriftList(ctx, p, thrift.STRING, len(v), func(i int) interface{} { return v[i] })
+	case []thriftStruct:
+		return writeThriftList(ctx, p, thrift.STRUCT, len(v), func(i int) interface{} { return v[i] })
+	default:
+		return fmt.Errorf("unsupported thrift value of type %T", value)
+	}
+}
+
+func writeThriftList(ctx context.Context, p thrift.TProtocol, elemType thrift.TType, size int, elem func(int) interface{}) error {
+	if err := p.WriteListBegin(ctx, elemType, size); err != nil {
+		return err
+	}
+	for i := 0; i < size; i++ {
+		if err := writeThriftValue(ctx, p, elem(i)); err != nil {
+			return err
+		}
+	}
+	return p.WriteListEnd(ctx)
+}
+
+func thriftType(value interface{}) thrift.TType {
+	switch value.(type) {
+	case int32:
+		return thrift.I32
+	case int64:
+		return thrift.I64
+	case string:
+		return thrift.STRING
+	case thriftStruct:
+		return thrift.STRUCT
+	default:
+		return thrift.LIST
+	}
+}
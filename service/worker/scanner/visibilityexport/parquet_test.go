@@ -0,0 +1,164 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityexport
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/stretchr/testify/require"
+)
+
+// thriftValues is a decoded thrift struct keyed by field ID.
+type thriftValues map[int16]interface{}
+
+func readThrift(t *testing.T, data []byte) (thriftValues, int) {
+	buffer := thrift.NewTMemoryBuffer()
+	_, err := buffer.Write(data)
+	require.NoError(t, err)
+	protocol := thrift.NewTCompactProtocolConf(buffer, &thrift.TConfiguration{})
+	values, err := readThriftValue(context.Background(), protocol, thrift.STRUCT)
+	require.NoError(t, err)
+	return values.(thriftValues), len(data) - buffer.Len()
+}
+
+func readThriftValue(ctx context.Context, p thrift.TProtocol, valueType thrift.TType) (interface{}, error) {
+	switch valueType {
+	case thrift.I32:
+		return p.ReadI32(ctx)
+	case thrift.I64:
+		return p.ReadI64(ctx)
+	case thrift.STRING:
+		return p.ReadString(ctx)
+	case thrift.LIST:
+		elemType, size, err := p.ReadListBegin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		list := make([]interface{}, size)
+		for i := range list {
+			if list[i], err = readThriftValue(ctx, p, elemType); err != nil {
+				return nil, err
+			}
+		}
+		return list, p.ReadListEnd(ctx)
+	case thrift.STRUCT:
+		if _, err := p.ReadStructBegin(ctx); err != nil {
+			return nil, err
+		}
+		values := make(thriftValues)
+		for {
+			_, fieldType, id, err := p.ReadFieldBegin(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if fieldType == thrift.STOP {
+				break
+			}
+			if values[id], err = readThriftValue(ctx, p, fieldType); err != nil {
+				return nil, err
+			}
+		}
+		return values, p.ReadStructEnd(ctx)
+	default:
+		return nil, thrift.SkipDefaultDepth(ctx, p, valueType)
+	}
+}
+
+// readParquetFooter checks the layout of a Parquet file and returns its decoded FileMetaData.
+func readParquetFooter(t *testing.T, data []byte) thriftValues {
+	require.Equal(t, parquetMagic, string(data[:4]))
+	require.Equal(t, parquetMagic, string(data[len(data)-4:]))
+	footerLength := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	footer, _ := readThrift(t, data[len(data)-8-footerLength:len(data)-8])
+	return footer
+}
+
+func parquetColumnNames(footer thriftValues) []string {
+	var names []string
+	for _, element := range footer[2].([]interface{})[1:] {
+		names = append(names, element.(thriftValues)[4].(string))
+	}
+	return names
+}
+
+func Test_ParquetWriter(t *testing.T) {
+	timestamp := time.Date(2023, 4, 5, 6, 7, 8, 0, time.UTC)
+	writer := newParquetWriter([]parquetColumn{
+		{name: "id", kind: columnInt64},
+		{name: "name", kind: columnString, optional: true},
+		{name: "done", kind: columnBool},
+		{name: "time", kind: columnTimestamp},
+		{name: "score", kind: columnDouble, optional: true},
+	})
+	require.NoError(t, writer.AppendRow([]interface{}{int64(1), "one", true, timestamp, 1.5}))
+	require.NoError(t, writer.AppendRow([]interface{}{int64(2), nil, false, timestamp, nil}))
+	require.NoError(t, writer.AppendRow([]interface{}{int64(3), "three", true, timestamp, 3.5}))
+	require.Error(t, writer.AppendRow([]interface{}{nil, "four", true, timestamp, nil}))
+	require.Error(t, writer.AppendRow([]interface{}{int64(4), 4, true, timestamp, nil}))
+	require.Error(t, writer.AppendRow([]interface{}{int64(4)}))
+	require.Equal(t, 3, writer.NumRows())
+
+	data, err := writer.Bytes(map[string]string{"key": "value"})
+	require.NoError(t, err)
+
+	footer := readParquetFooter(t, data)
+	require.Equal(t, int64(3), footer[3])
+	require.Equal(t, []string{"id", "name", "done", "time", "score"}, parquetColumnNames(footer))
+	require.Equal(t, []interface{}{thriftValues{1: "key", 2: "value"}}, footer[5])
+	rowGroups := footer[4].([]interface{})
+	require.Len(t, rowGroups, 1)
+	chunks := rowGroups[0].(thriftValues)[1].([]interface{})
+	require.Len(t, chunks, 5)
+
+	// The page of the first column holds the PLAIN encoded ids.
+	offset := chunks[0].(thriftValues)[3].(thriftValues)[9].(int64)
+	header, headerLength := readThrift(t, data[offset:])
+	require.Equal(t, parquetPageTypeData, header[1])
+	require.Equal(t, int32(24), header[2])
+	require.Equal(t, int32(3), header[5].(thriftValues)[1])
+	body := data[int(offset)+headerLength : int(offset)+headerLength+24]
+	for i := 0; i < 3; i++ {
+		require.Equal(t, uint64(i+1), binary.LittleEndian.Uint64(body[8*i:]))
+	}
+
+	// The page of the second column starts with the definition levels of its values.
+	offset = chunks[1].(thriftValues)[3].(thriftValues)[9].(int64)
+	_, headerLength = readThrift(t, data[offset:])
+	body = data[int(offset)+headerLength:]
+	require.Equal(t, uint32(2), binary.LittleEndian.Uint32(body))
+	require.Equal(t, []byte{0x03, 0x05}, body[4:6])
+	require.Equal(t, uint32(3), binary.LittleEndian.Uint32(body[6:]))
+	require.Equal(t, "one", string(body[10:13]))
+}
+
+func Test_EncodeDefinitionLevels(t *testing.T) {
+	require.Equal(t, []byte{0x03, 0x05}, encodeDefinitionLevels([]bool{true, false, true}))
+	require.Equal(t, []byte{0x05, 0xff, 0x01}, encodeDefinitionLevels([]bool{true, true, true, true, true, true, true, true, true}))
+	require.Equal(t, []byte{0x01}, encodeDefinitionLevels(nil))
+}
@@ -0,0 +1,310 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityexport
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"path"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	VisibilityExportScannerWorkflowName = "visibility-export-scanner"
+	VisibilityExportScannerActivityName = "export-visibility"
+
+	VisibilityExportScannerWFID          = "temporal-sys-visibility-export-scanner"
+	VisibilityExportScannerTaskQueueName = "temporal-sys-visibility-export-scanner-taskqueue-0"
+)
+
+var (
+	VisibilityExportScannerWFStartOptions = client.StartWorkflowOptions{
+		ID:                    VisibilityExportScannerWFID,
+		TaskQueue:             VisibilityExportScannerTaskQueueName,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		CronSchedule:          "0 * * * *",
+	}
+)
+
+type (
+	VisibilityExportScannerInput struct {
+		PageSize              int
+		NamespaceListPageSize int
+		// MaxRecordsPerFile caps the number of records written to a single export file.
+		MaxRecordsPerFile int
+	}
+
+	Activities struct {
+		logger             log.Logger
+		metricsHandler     metrics.Handler
+		visibilityManager  manager.VisibilityManager
+		metadataManager    persistence.MetadataManager
+		perHostQPS         dynamicconfig.IntPropertyFn
+		exportDelay        dynamicconfig.DurationPropertyFn
+		currentClusterName string
+		newExportStore     NewExportStoreFn
+		timeSource         clock.TimeSource
+	}
+)
+
+func NewActivities(
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	visibilityManager manager.VisibilityManager,
+	metadataManager persistence.MetadataManager,
+	perHostQPS dynamicconfig.IntPropertyFn,
+	exportDelay dynamicconfig.DurationPropertyFn,
+	currentClusterName string,
+	provider *config.VisibilityArchiverProvider,
+) *Activities {
+	return &Activities{
+		logger:             logger,
+		metricsHandler:     metricsHandler.WithTags(metrics.OperationTag(metrics.VisibilityExportScannerScope)),
+		visibilityManager:  visibilityManager,
+		metadataManager:    metadataManager,
+		perHostQPS:         perHostQPS,
+		exportDelay:        exportDelay,
+		currentClusterName: currentClusterName,
+		newExportStore:     NewExportStoreProvider(provider),
+		timeSource:         clock.NewRealTimeSource(),
+	}
+}
+
+// VisibilityExportScannerWorkflow exports the visibility records of closed workflow executions of the namespaces
+// with an export URI as Parquet files.
+// This workflow is a wrapper around the long running ExportVisibility activity.
+func VisibilityExportScannerWorkflow(ctx workflow.Context, input VisibilityExportScannerInput) error {
+	activityCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		// A first export of a namespace backfills all of its retained records
+		StartToCloseTimeout: 24 * time.Hour,
+		HeartbeatTimeout:    5 * time.Minute,
+	})
+	return workflow.ExecuteActivity(activityCtx, VisibilityExportScannerActivityName, input).Get(ctx, nil)
+}
+
+func (a *Activities) setDefaults(input *VisibilityExportScannerInput) {
+	if input.PageSize == 0 {
+		input.PageSize = 1000
+	}
+	if input.NamespaceListPageSize == 0 {
+		input.NamespaceListPageSize = 100
+	}
+	if input.MaxRecordsPerFile == 0 {
+		input.MaxRecordsPerFile = 100000
+	}
+}
+
+// ExportVisibility exports the records closed since the last export of each namespace with an export URI.
+// Records are exported in windows which don't span more than a day, with a file per window (or more if it
+// holds too many records) named after the start of the window, under
+// "namespace=<namespace>/close_date=<yyyy-mm-dd>/" of the export URI. The end of each exported window is
+// recorded as the watermark of the namespace, so an export interrupted in the middle of a window rewrites
+// the same files when resumed.
+func (a *Activities) ExportVisibility(ctx context.Context, input VisibilityExportScannerInput) error {
+	a.setDefaults(&input)
+
+	rps := float64(a.perHostQPS())
+	rateLimiter := quotas.NewRateLimiter(rps, int(math.Ceil(rps)))
+	var nextPageToken []byte
+	for {
+		resp, err := a.metadataManager.ListNamespaces(ctx, &persistence.ListNamespacesRequest{
+			PageSize:       input.NamespaceListPageSize,
+			NextPageToken:  nextPageToken,
+			IncludeDeleted: false,
+		})
+		if err != nil {
+			return err
+		}
+		for _, ns := range resp.Namespaces {
+			if err := a.exportNamespace(ctx, rateLimiter, input, namespace.FromPersistentState(ns)); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				// Intentionally don't fail the activity on a single namespace, it is exported by the next scan.
+				a.metricsHandler.Counter(metrics.VisibilityExportFailures.GetMetricName()).Record(1)
+				a.logger.Error("Failed to export namespace visibility records",
+					tag.WorkflowNamespace(ns.Namespace.Info.Name),
+					tag.Error(err))
+			}
+			activity.RecordHeartbeat(ctx)
+		}
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+func (a *Activities) exportNamespace(
+	ctx context.Context,
+	rateLimiter quotas.RateLimiter,
+	input VisibilityExportScannerInput,
+	ns *namespace.Namespace,
+) error {
+	// Only the active cluster for this namespace exports, so that clusters don't export the replicated
+	// records twice and overwrite each other's watermark.
+	if ns.VisibilityExportURI() == "" || !ns.ActiveInCluster(a.currentClusterName) {
+		return nil
+	}
+	uri, err := archiver.NewURI(ns.VisibilityExportURI())
+	if err != nil {
+		return err
+	}
+	store, err := a.newExportStore(ctx, uri)
+	if err != nil {
+		return err
+	}
+
+	// Visibility is written asynchronously, so records closed during the export delay may still be missing.
+	// Windows are aligned on seconds so that no store rounds their bounds.
+	end := a.timeSource.Now().Add(-a.exportDelay()).UTC().Truncate(time.Second)
+	start := ns.VisibilityExportWatermark()
+	if start.IsZero() {
+		start = end.Add(-ns.VisibilityRetention())
+	}
+	for start.Before(end) {
+		windowEnd := start.Truncate(24 * time.Hour).Add(24 * time.Hour)
+		if windowEnd.After(end) {
+			windowEnd = end
+		}
+		if err := a.exportWindow(ctx, rateLimiter, input, ns, store, start, windowEnd); err != nil {
+			return err
+		}
+		if err := a.recordWatermark(ctx, ns.ID(), windowEnd); err != nil {
+			return err
+		}
+		start = windowEnd
+		activity.RecordHeartbeat(ctx)
+	}
+	return nil
+}
+
+// exportWindow exports the records closed in [start, end).
+func (a *Activities) exportWindow(
+	ctx context.Context,
+	rateLimiter quotas.RateLimiter,
+	input VisibilityExportScannerInput,
+	ns *namespace.Namespace,
+	store ExportStore,
+	start time.Time,
+	end time.Time,
+) error {
+	var records []*workflowpb.WorkflowExecutionInfo
+	part := 0
+	flush := func() error {
+		if len(records) == 0 {
+			return nil
+		}
+		data, err := encodeExportFile(ns, records)
+		if err != nil {
+			return err
+		}
+		if err := store.Put(ctx, exportFileName(ns, start, part), data); err != nil {
+			return err
+		}
+		a.metricsHandler.Counter(metrics.VisibilityExportedRecords.GetMetricName()).Record(
+			int64(len(records)),
+			metrics.NamespaceTag(ns.Name().String()),
+		)
+		records = nil
+		part++
+		return nil
+	}
+
+	var pageToken []byte
+	for {
+		if err := rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+		// The close time range of the request is inclusive.
+		resp, err := a.visibilityManager.ListClosedWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequest{
+			NamespaceID:       ns.ID(),
+			Namespace:         ns.Name(),
+			EarliestStartTime: start,
+			LatestStartTime:   end,
+			PageSize:          input.PageSize,
+			NextPageToken:     pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		for _, record := range resp.Executions {
+			closeTime := record.GetCloseTime()
+			if closeTime == nil || closeTime.Before(start) || !closeTime.Before(end) {
+				continue
+			}
+			records = append(records, record)
+			if len(records) >= input.MaxRecordsPerFile {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+		activity.RecordHeartbeat(ctx)
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return flush()
+		}
+	}
+}
+
+func exportFileName(ns *namespace.Namespace, windowStart time.Time, part int) string {
+	return path.Join(
+		"namespace="+ns.Name().String(),
+		"close_date="+windowStart.UTC().Format("2006-01-02"),
+		fmt.Sprintf("%d-%d.parquet", windowStart.Unix(), part),
+	)
+}
+
+func (a *Activities) recordWatermark(ctx context.Context, namespaceID namespace.ID, watermark time.Time) error {
+	return persistence.UpdateNamespaceData(
+		ctx,
+		a.metadataManager,
+		&persistence.GetNamespaceRequest{ID: namespaceID.String()},
+		func(data map[string]string) error {
+			data[namespace.VisibilityExportWatermarkDataKey] = namespace.FormatVisibilityExportWatermark(watermark)
+			return nil
+		},
+	)
+}
@@ -0,0 +1,197 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityexport
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+)
+
+const (
+	testActiveNamespaceID  = "active-namespace-id"
+	testStandbyNamespaceID = "standby-namespace-id"
+	testNoExportNamespace  = "no-export-namespace-id"
+	testCurrentCluster     = "active-cluster"
+)
+
+func newTestNamespace(id string, activeCluster string, data map[string]string) *persistence.GetNamespaceResponse {
+	return &persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   id,
+				Name: id,
+				Data: data,
+			},
+			Config: &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+				ActiveClusterName: activeCluster,
+				Clusters:          []string{testCurrentCluster, "standby-cluster"},
+			},
+		},
+		IsGlobalNamespace: true,
+	}
+}
+
+func newTestRecord(workflowID string, closeTime time.Time) *workflowpb.WorkflowExecutionInfo {
+	startTime := closeTime.Add(-time.Minute)
+	return &workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: workflowID + "-run"},
+		Type:      &commonpb.WorkflowType{Name: "workflow-type"},
+		StartTime: &startTime,
+		CloseTime: &closeTime,
+		Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+	}
+}
+
+func Test_ExportVisibility(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	ctrl := gomock.NewController(t)
+	visibilityManager := manager.NewMockVisibilityManager(ctrl)
+	metadataManager := persistence.NewMockMetadataManager(ctrl)
+
+	exportDir := t.TempDir()
+	now := time.Date(2023, 4, 5, 12, 30, 0, 0, time.UTC)
+	a := &Activities{
+		logger:             log.NewTestLogger(),
+		metricsHandler:     metrics.NoopMetricsHandler,
+		visibilityManager:  visibilityManager,
+		metadataManager:    metadataManager,
+		perHostQPS:         dynamicconfig.GetIntPropertyFn(1000),
+		exportDelay:        dynamicconfig.GetDurationPropertyFn(10 * time.Minute),
+		currentClusterName: testCurrentCluster,
+		newExportStore:     NewExportStoreProvider(nil),
+		timeSource:         clock.NewEventTimeSource().Update(now),
+	}
+	env.RegisterActivityWithOptions(a.ExportVisibility, activity.RegisterOptions{Name: VisibilityExportScannerActivityName})
+
+	watermark := time.Date(2023, 4, 4, 23, 0, 0, 0, time.UTC)
+	midnight := time.Date(2023, 4, 5, 0, 0, 0, 0, time.UTC)
+	end := now.Add(-10 * time.Minute)
+	activeData := map[string]string{
+		"key":                                "value",
+		namespace.VisibilityExportURIDataKey: "file://" + exportDir,
+		namespace.VisibilityExportWatermarkDataKey: namespace.FormatVisibilityExportWatermark(watermark),
+	}
+	exportData := map[string]string{namespace.VisibilityExportURIDataKey: "file://" + exportDir}
+	metadataManager.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).Return(&persistence.ListNamespacesResponse{
+		Namespaces: []*persistence.GetNamespaceResponse{
+			newTestNamespace(testActiveNamespaceID, testCurrentCluster, activeData),
+			newTestNamespace(testStandbyNamespaceID, "standby-cluster", exportData),
+			newTestNamespace(testNoExportNamespace, testCurrentCluster, nil),
+		},
+	}, nil)
+
+	visibilityManager.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequest{
+		NamespaceID:       testActiveNamespaceID,
+		Namespace:         testActiveNamespaceID,
+		EarliestStartTime: watermark,
+		LatestStartTime:   midnight,
+		PageSize:          1000,
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			newTestRecord("wf-1", watermark.Add(time.Minute)),
+			// The end of a window is exported by the next window.
+			newTestRecord("wf-midnight", midnight),
+		},
+	}, nil)
+	visibilityManager.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequest{
+		NamespaceID:       testActiveNamespaceID,
+		Namespace:         testActiveNamespaceID,
+		EarliestStartTime: midnight,
+		LatestStartTime:   end,
+		PageSize:          1000,
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			newTestRecord("wf-midnight", midnight),
+			newTestRecord("wf-2", midnight.Add(time.Hour)),
+			newTestRecord("wf-end", end),
+		},
+	}, nil)
+
+	var watermarks []string
+	metadataManager.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{ID: testActiveNamespaceID}).
+		Return(newTestNamespace(testActiveNamespaceID, testCurrentCluster, activeData), nil).Times(2)
+	metadataManager.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil).Times(2)
+	metadataManager.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			require.Equal(t, testActiveNamespaceID, request.Namespace.Info.Id)
+			require.Equal(t, int64(7), request.NotificationVersion)
+			require.True(t, request.IsGlobalNamespace)
+			require.Equal(t, "value", request.Namespace.Info.Data["key"])
+			watermarks = append(watermarks, request.Namespace.Info.Data[namespace.VisibilityExportWatermarkDataKey])
+			return nil
+		}).Times(2)
+
+	_, err := env.ExecuteActivity(VisibilityExportScannerActivityName, VisibilityExportScannerInput{})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		namespace.FormatVisibilityExportWatermark(midnight),
+		namespace.FormatVisibilityExportWatermark(end),
+	}, watermarks)
+
+	for windowStart, expectedRows := range map[time.Time]int64{watermark: 1, midnight: 2} {
+		data, err := os.ReadFile(filepath.Join(exportDir, exportFileName(
+			namespace.NewLocalNamespaceForTest(&persistencespb.NamespaceInfo{Name: testActiveNamespaceID}, nil, ""),
+			windowStart,
+			0,
+		)))
+		require.NoError(t, err)
+		require.Equal(t, expectedRows, readParquetFooter(t, data)[3])
+	}
+	entries, err := os.ReadDir(exportDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+func Test_ExportFileName(t *testing.T) {
+	ns := namespace.NewLocalNamespaceForTest(&persistencespb.NamespaceInfo{Name: "test-namespace"}, nil, "")
+	windowStart := time.Date(2023, 4, 5, 6, 0, 0, 0, time.UTC)
+	require.Equal(t,
+		"namespace=test-namespace/close_date=2023-04-05/1680674400-2.parquet",
+		exportFileName(ns, windowStart, 2),
+	)
+}
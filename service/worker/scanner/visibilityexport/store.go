@@ -0,0 +1,164 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityexport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/filestore"
+	"go.temporal.io/server/common/archiver/gcloud"
+	"go.temporal.io/server/common/archiver/gcloud/connector"
+	"go.temporal.io/server/common/archiver/s3store"
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	defaultFileMode = 0666
+	defaultDirMode  = 0766
+)
+
+type (
+	// ExportStore writes the export files of a namespace under its export URI. Names are slash separated
+	// paths relative to the URI.
+	ExportStore interface {
+		Put(ctx context.Context, name string, data []byte) error
+	}
+
+	// NewExportStoreFn returns the store of an export URI.
+	NewExportStoreFn func(ctx context.Context, uri archiver.URI) (ExportStore, error)
+
+	s3ExportStore struct {
+		client s3iface.S3API
+		uri    archiver.URI
+	}
+
+	gcloudExportStore struct {
+		client connector.Client
+		uri    archiver.URI
+	}
+
+	fileExportStore struct {
+		dir      string
+		fileMode os.FileMode
+		dirMode  os.FileMode
+	}
+)
+
+// NewExportStoreProvider returns a function creating the store of an export URI. The stores are configured
+// by the providers of visibility archival, so exports go to object storage with the same credentials.
+func NewExportStoreProvider(provider *config.VisibilityArchiverProvider) NewExportStoreFn {
+	if provider == nil {
+		provider = &config.VisibilityArchiverProvider{}
+	}
+	return func(ctx context.Context, uri archiver.URI) (ExportStore, error) {
+		switch uri.Scheme() {
+		case s3store.URIScheme:
+			return newS3ExportStore(uri, provider.S3store)
+		case gcloud.URIScheme:
+			return newGcloudExportStore(ctx, uri, provider.Gstorage)
+		case filestore.URIScheme:
+			return newFileExportStore(uri, provider.Filestore)
+		default:
+			return nil, fmt.Errorf("unsupported visibility export URI scheme %q", uri.Scheme())
+		}
+	}
+}
+
+func newS3ExportStore(uri archiver.URI, cfg *config.S3Archiver) (ExportStore, error) {
+	if cfg == nil || cfg.Region == "" {
+		return nil, errors.New("visibility export to s3 requires the s3store visibility archival provider to be configured")
+	}
+	sess, err := session.NewSession(&aws.Config{
+		Endpoint:         cfg.Endpoint,
+		Region:           aws.String(cfg.Region),
+		S3ForcePathStyle: aws.Bool(cfg.S3ForcePathStyle),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &s3ExportStore{client: s3.New(sess), uri: uri}, nil
+}
+
+func (s *s3ExportStore) Put(ctx context.Context, name string, data []byte) error {
+	key := strings.TrimPrefix(path.Join(s.uri.Path(), name), "/")
+	return s3store.Upload(ctx, s.client, s.uri, key, data)
+}
+
+func newGcloudExportStore(ctx context.Context, uri archiver.URI, cfg *config.GstorageArchiver) (ExportStore, error) {
+	if cfg == nil {
+		cfg = &config.GstorageArchiver{}
+	}
+	client, err := connector.NewClient(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &gcloudExportStore{client: client, uri: uri}, nil
+}
+
+func (s *gcloudExportStore) Put(ctx context.Context, name string, data []byte) error {
+	return s.client.Upload(ctx, s.uri, name, data)
+}
+
+func newFileExportStore(uri archiver.URI, cfg *config.FilestoreArchiver) (ExportStore, error) {
+	store := &fileExportStore{
+		dir:      uri.Path(),
+		fileMode: defaultFileMode,
+		dirMode:  defaultDirMode,
+	}
+	if cfg != nil {
+		fileMode, err := strconv.ParseUint(cfg.FileMode, 0, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid file mode: %w", err)
+		}
+		dirMode, err := strconv.ParseUint(cfg.DirMode, 0, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid directory mode: %w", err)
+		}
+		store.fileMode = os.FileMode(fileMode)
+		store.dirMode = os.FileMode(dirMode)
+	}
+	return store, nil
+}
+
+func (s *fileExportStore) Put(_ context.Context, name string, data []byte) error {
+	filePath := filepath.Join(s.dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(filePath), s.dirMode); err != nil {
+		return err
+	}
+	return os.WriteFile(filePath, data, s.fileMode)
+}
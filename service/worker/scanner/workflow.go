@@ -43,6 +43,9 @@ import (
 const (
 	infiniteDuration = 20 * 365 * 24 * time.Hour
 
+	// scannerLeaseName names the lease that a worker host must hold to run the scanners
+	scannerLeaseName = "scanner"
+
 	tqScannerWFID                  = "temporal-sys-tq-scanner"
 	tqScannerWFTypeName            = "temporal-sys-tq-scanner-workflow"
 	tqScannerTaskQueueName         = "temporal-sys-tq-scanner-taskqueue-0"
@@ -109,16 +112,19 @@ func TaskQueueScannerWorkflow(
 	return future.Get(ctx, nil)
 }
 
-// HistoryScannerWorkflow is the workflow that runs the history scanner background daemon
+// HistoryScannerWorkflow is the workflow that runs the history scanner background daemon. Its result lists the
+// garbage history branches found by a dry run.
 func HistoryScannerWorkflow(
 	ctx workflow.Context,
-) error {
+) (history.ScavengerHeartbeatDetails, error) {
 
+	var result history.ScavengerHeartbeatDetails
 	future := workflow.ExecuteActivity(
 		workflow.WithActivityOptions(ctx, activityOptions),
 		historyScavengerActivityName,
 	)
-	return future.Get(ctx, nil)
+	err := future.Get(ctx, &result)
+	return result, err
 }
 
 // ExecutionsScannerWorkflow is the workflow that runs the executions scanner background daemon
@@ -156,6 +162,7 @@ func HistoryScavengerActivity(
 		ctx.cfg.HistoryScannerDataMinAge,
 		ctx.cfg.ExecutionDataDurationBuffer,
 		ctx.cfg.HistoryScannerVerifyRetention,
+		ctx.cfg.HistoryScannerDryRun,
 		ctx.metricsHandler,
 		ctx.logger,
 	)
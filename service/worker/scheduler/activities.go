@@ -25,9 +25,12 @@
 package scheduler
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"reflect"
 	"time"
 
@@ -42,6 +45,7 @@ import (
 	"go.temporal.io/server/api/historyservice/v1"
 	schedspb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -56,6 +60,20 @@ type (
 		// Rate limiter for start workflow requests. Note that the scope is all schedules in
 		// this namespace on this worker.
 		startWorkflowRateLimiter quotas.RateLimiter
+		// URL that pause notifications are posted to, if not empty
+		pauseWebhookURL dynamicconfig.StringPropertyFn
+		// Client that pause notifications are posted with
+		pauseWebhookClient *http.Client
+	}
+
+	// PauseNotification is posted to the pause webhook of the namespace when a schedule pauses itself after
+	// consecutive failures.
+	PauseNotification struct {
+		Namespace           string    `json:"namespace"`
+		ScheduleID          string    `json:"scheduleId"`
+		ConsecutiveFailures int64     `json:"consecutiveFailures"`
+		Reason              string    `json:"reason"`
+		PauseTime           time.Time `json:"pauseTime"`
 	}
 
 	errFollow string
@@ -304,6 +322,36 @@ func (a *activities) TerminateWorkflow(ctx context.Context, req *schedspb.Termin
 	return translateError(err, "TerminateWorkflowExecution")
 }
 
+func (a *activities) NotifyPaused(ctx context.Context, req *PauseNotification) error {
+	url := a.pauseWebhookURL()
+	if url == "" {
+		return nil
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError("NotifyPaused: "+err.Error(), errType(err), err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError("NotifyPaused: "+err.Error(), errType(err), err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	resp, err := a.pauseWebhookClient.Do(httpReq)
+	if err != nil {
+		return temporal.NewApplicationErrorWithCause("NotifyPaused: "+err.Error(), errType(err), err)
+	}
+	_ = resp.Body.Close()
+	message := fmt.Sprintf("NotifyPaused: webhook returned %s", resp.Status)
+	switch {
+	case resp.StatusCode < http.StatusMultipleChoices:
+		return nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError:
+		return temporal.NewApplicationError(message, "WebhookError")
+	default:
+		return temporal.NewNonRetryableApplicationError(message, "WebhookError", nil)
+	}
+}
+
 func errType(err error) string {
 	return reflect.TypeOf(err).Name()
 }
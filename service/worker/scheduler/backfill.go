@@ -0,0 +1,227 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"strconv"
+	"time"
+
+	schedpb "go.temporal.io/api/schedule/v1"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	// QueryNameDescribeBackfills returns the progress of the backfills of a schedule as a []*BackfillProgress.
+	QueryNameDescribeBackfills = "describeBackfills"
+	// SignalNameCancelBackfill cancels a backfill. Its input is the ID of the backfill.
+	SignalNameCancelBackfill = "cancelBackfill"
+	// MemoFieldBackfills holds the backfills of a schedule, so that they're carried across continue-as-new.
+	MemoFieldBackfills = "ScheduleBackfills"
+
+	// Number of finished backfills which are kept to be described
+	maxFinishedBackfills = 10
+)
+
+type (
+	// BackfillProgress is the progress of a backfill of a schedule.
+	BackfillProgress struct {
+		// ID identifies the backfill within its schedule. IDs are assigned in the order backfills are requested.
+		ID          string
+		StartTime   time.Time
+		EndTime     time.Time
+		RequestTime time.Time
+		// Total is the number of actions which were buffered for the backfill.
+		Total int
+		// Started is the number of those actions which were taken.
+		Started int
+		// Remaining is the number of actions which are still buffered. Actions which are neither started nor
+		// remaining were skipped by the overlap policy or dropped by a cancellation.
+		Remaining int
+		// CompletedUntil is the nominal time before which all actions of the backfill were processed.
+		CompletedUntil time.Time
+		Canceled       bool
+	}
+
+	backfillState struct {
+		NextID    int64
+		Backfills []*BackfillProgress
+	}
+)
+
+func (b *BackfillProgress) finished() bool {
+	return b.Canceled || b.Remaining == 0
+}
+
+func (b *BackfillProgress) contains(nominalTime time.Time) bool {
+	return !nominalTime.Before(b.StartTime) && !nominalTime.After(b.EndTime)
+}
+
+func (s *scheduler) trackBackfills() bool {
+	return s.tweakables.Version >= TrackBackfills
+}
+
+func (s *scheduler) loadBackfills() {
+	if p := workflow.GetInfo(s.ctx).Memo.GetFields()[MemoFieldBackfills]; p != nil {
+		if err := payload.Decode(p, &s.backfills); err != nil {
+			s.logger.Error("error decoding backfills from memo", "error", err)
+		}
+	}
+}
+
+// addBackfill starts tracking a backfill, for which the given number of actions were buffered.
+func (s *scheduler) addBackfill(request *schedpb.BackfillRequest, buffered int) {
+	if !s.trackBackfills() {
+		return
+	}
+	s.backfills.NextID++
+	s.backfills.Backfills = append(s.backfills.Backfills, &BackfillProgress{
+		ID:          strconv.FormatInt(s.backfills.NextID, 10),
+		StartTime:   timestamp.TimeValue(request.GetStartTime()),
+		EndTime:     timestamp.TimeValue(request.GetEndTime()),
+		RequestTime: s.now(),
+		Total:       buffered,
+		Remaining:   buffered,
+	})
+	s.backfillsChanged = true
+}
+
+// backfillOf returns the running backfill a manual start belongs to. Overlapping backfills are attributed the
+// start in the order they were requested.
+func (s *scheduler) backfillOf(nominalTime time.Time) *BackfillProgress {
+	for _, backfill := range s.backfills.Backfills {
+		if !backfill.finished() && backfill.contains(nominalTime) {
+			return backfill
+		}
+	}
+	return nil
+}
+
+func (s *scheduler) countBackfillStart(nominalTime time.Time) {
+	if backfill := s.backfillOf(nominalTime); backfill != nil {
+		backfill.Started++
+		s.backfillsChanged = true
+	}
+}
+
+// cancelBackfill drops the buffered actions of a running backfill.
+func (s *scheduler) cancelBackfill(id string) {
+	var canceled *BackfillProgress
+	for _, backfill := range s.backfills.Backfills {
+		if backfill.ID == id && !backfill.finished() {
+			canceled = backfill
+		}
+	}
+	if canceled == nil {
+		s.logger.Warn("Backfill to cancel not found or already finished", "backfill", id)
+		return
+	}
+
+	buffer := s.State.BufferedStarts[:0]
+	for _, start := range s.State.BufferedStarts {
+		if !start.Manual || s.backfillOf(timestamp.TimeValue(start.NominalTime)) != canceled {
+			buffer = append(buffer, start)
+		}
+	}
+	s.State.BufferedStarts = buffer
+	canceled.Canceled = true
+	canceled.Remaining = 0
+	s.backfillsChanged = true
+	s.logger.Info("Backfill canceled", "backfill", id, "started", canceled.Started, "total", canceled.Total)
+}
+
+// refreshBackfills updates the remaining actions of running backfills from the buffer, and drops the oldest
+// finished backfills.
+func (s *scheduler) refreshBackfills() {
+	if len(s.backfills.Backfills) == 0 {
+		return
+	}
+
+	remaining := make(map[*BackfillProgress]int)
+	earliest := make(map[*BackfillProgress]time.Time)
+	for _, start := range s.State.BufferedStarts {
+		if !start.Manual {
+			continue
+		}
+		nominalTime := timestamp.TimeValue(start.NominalTime)
+		if backfill := s.backfillOf(nominalTime); backfill != nil {
+			remaining[backfill]++
+			if e, ok := earliest[backfill]; !ok || nominalTime.Before(e) {
+				earliest[backfill] = nominalTime
+			}
+		}
+	}
+	for _, backfill := range s.backfills.Backfills {
+		if backfill.finished() {
+			continue
+		}
+		completedUntil := backfill.EndTime
+		if e, ok := earliest[backfill]; ok {
+			completedUntil = e
+		}
+		if backfill.Remaining != remaining[backfill] || !backfill.CompletedUntil.Equal(completedUntil) {
+			backfill.Remaining = remaining[backfill]
+			backfill.CompletedUntil = completedUntil
+			s.backfillsChanged = true
+		}
+	}
+
+	finished := 0
+	for _, backfill := range s.backfills.Backfills {
+		if backfill.finished() {
+			finished++
+		}
+	}
+	if finished > maxFinishedBackfills {
+		backfills := s.backfills.Backfills[:0]
+		for _, backfill := range s.backfills.Backfills {
+			if backfill.finished() && finished > maxFinishedBackfills {
+				finished--
+				continue
+			}
+			backfills = append(backfills, backfill)
+		}
+		s.backfills.Backfills = backfills
+		s.backfillsChanged = true
+	}
+}
+
+func (s *scheduler) updateBackfillsMemo() {
+	if !s.backfillsChanged {
+		return
+	}
+	s.backfillsChanged = false
+	if err := workflow.UpsertMemo(s.ctx, map[string]interface{}{
+		MemoFieldBackfills: s.backfills,
+	}); err != nil {
+		s.logger.Error("error updating memo", "error", err)
+	}
+}
+
+func (s *scheduler) handleDescribeBackfillsQuery() ([]*BackfillProgress, error) {
+	return s.backfills.Backfills, nil
+}
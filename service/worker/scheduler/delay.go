@@ -0,0 +1,119 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	// MemoFieldDelayAfterCompletion can be set in the memo of a schedule to a duration, e.g. "10m". The schedule
+	// then takes its next action that long after its previous action completed, instead of at the times of its
+	// spec. The first action is taken that long after the schedule is created. The spec still applies to
+	// backfills.
+	MemoFieldDelayAfterCompletion = "ScheduleDelayAfterCompletion"
+	// MemoFieldLastCompletionTime holds the time the previous action of a schedule with
+	// MemoFieldDelayAfterCompletion completed, so that it's carried across continue-as-new.
+	MemoFieldLastCompletionTime = "ScheduleLastCompletionTime"
+)
+
+func (s *scheduler) loadDelayAfterCompletion() {
+	fields := workflow.GetInfo(s.ctx).Memo.GetFields()
+	p := fields[MemoFieldDelayAfterCompletion]
+	if p == nil {
+		return
+	}
+	var value string
+	if err := payload.Decode(p, &value); err != nil {
+		s.logger.Error("error decoding delay after completion from memo", "error", err)
+		return
+	}
+	delay, err := timestamp.ParseDuration(value)
+	if err != nil || delay <= 0 {
+		s.logger.Error("invalid delay after completion", "delay", value, "error", err)
+		return
+	}
+	s.delayAfterCompletion = delay
+
+	if p := fields[MemoFieldLastCompletionTime]; p != nil {
+		if err := payload.Decode(p, &s.lastCompletionTime); err != nil {
+			s.logger.Error("error decoding last completion time from memo", "error", err)
+		}
+	}
+	if s.lastCompletionTime.IsZero() {
+		s.lastCompletionTime = timestamp.TimeValue(s.Info.CreateTime)
+	}
+}
+
+// processDelayAfterCompletion is used instead of the spec to find the next scheduled action of a schedule with a
+// delay after completion. It returns the time to wake up at, or zero to wait for the running action to complete.
+func (s *scheduler) processDelayAfterCompletion(now time.Time, overlapPolicy enumspb.ScheduleOverlapPolicy) time.Time {
+	if !s.canTakeScheduledAction(false, false) {
+		return time.Time{}
+	}
+	if len(s.Info.RunningWorkflows) > 0 {
+		// the watcher wakes us up when it completes
+		if s.watchingFuture == nil {
+			s.startLongPollWatcher(s.Info.RunningWorkflows[0])
+		}
+		return time.Time{}
+	}
+	// if the action fails to start, try again after another delay
+	retry := now.Add(s.delayAfterCompletion)
+	for _, start := range s.State.BufferedStarts {
+		if !start.Manual {
+			return retry
+		}
+	}
+	next := s.nextActionAfterCompletion()
+	if next.After(now) {
+		return next
+	}
+	s.addStart(next, now, overlapPolicy, false)
+	return retry
+}
+
+func (s *scheduler) nextActionAfterCompletion() time.Time {
+	return s.lastCompletionTime.Add(s.delayAfterCompletion)
+}
+
+// recordCompletion starts the delay after an action of the schedule completed.
+func (s *scheduler) recordCompletion() {
+	if s.delayAfterCompletion <= 0 {
+		return
+	}
+	s.lastCompletionTime = s.now()
+	if err := workflow.UpsertMemo(s.ctx, map[string]interface{}{
+		MemoFieldLastCompletionTime: s.lastCompletionTime,
+	}); err != nil {
+		s.logger.Error("error updating memo", "error", err)
+	}
+}
@@ -25,6 +25,9 @@
 package scheduler
 
 import (
+	"net/http"
+	"time"
+
 	"go.uber.org/fx"
 
 	"go.temporal.io/api/workflowservice/v1"
@@ -43,6 +46,9 @@ import (
 const (
 	WorkflowType      = "temporal-sys-scheduler-workflow"
 	NamespaceDivision = "TemporalScheduler"
+
+	// Timeout of a single post of a pause notification to the pause webhook
+	pauseWebhookTimeout = 10 * time.Second
 )
 
 type (
@@ -50,6 +56,7 @@ type (
 		activityDeps             activityDeps
 		enabledForNs             dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		globalNSStartWorkflowRPS dynamicconfig.FloatPropertyFnWithNamespaceFilter
+		pauseWebhookURL          dynamicconfig.StringPropertyFnWithNamespaceFilter
 	}
 
 	activityDeps struct {
@@ -81,6 +88,8 @@ func NewResult(
 				dynamicconfig.WorkerEnableScheduler, true),
 			globalNSStartWorkflowRPS: dcCollection.GetFloatPropertyFilteredByNamespace(
 				dynamicconfig.SchedulerNamespaceStartWorkflowRPS, 30.0),
+			pauseWebhookURL: dcCollection.GetStringPropertyFnWithNamespaceFilter(
+				dynamicconfig.SchedulerPauseWebhookURL, ""),
 		},
 	}
 }
@@ -105,5 +114,9 @@ func (s *workerComponent) activities(name namespace.Name, id namespace.ID, detai
 		namespace:                name,
 		namespaceID:              id,
 		startWorkflowRateLimiter: quotas.NewDefaultOutgoingRateLimiter(localRPS),
+		pauseWebhookURL: func() string {
+			return s.pauseWebhookURL(name.String())
+		},
+		pauseWebhookClient: &http.Client{Timeout: pauseWebhookTimeout},
 	}
 }
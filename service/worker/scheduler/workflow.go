@@ -60,6 +60,8 @@ const (
 	InitialVersion SchedulerWorkflowVersion = iota
 	// skip over entire time range if paused and batch and cache getNextTime queries
 	BatchAndCacheTimeQueries
+	// track the progress of backfills in the memo
+	TrackBackfills
 )
 
 const (
@@ -73,6 +75,9 @@ const (
 	SignalNameUpdate  = "update"
 	SignalNamePatch   = "patch"
 	SignalNameRefresh = "refresh"
+	// SignalNameSetPauseAfterFailures sets the number of consecutive failed or timed-out actions after which the
+	// schedule pauses itself. Its input is the number, zero turns it off.
+	SignalNameSetPauseAfterFailures = "setPauseAfterFailures"
 
 	QueryNameDescribe          = "describe"
 	QueryNameListMatchingTimes = "listMatchingTimes"
@@ -113,9 +118,19 @@ type (
 		// Signal requests
 		pendingPatch  *schedpb.SchedulePatch
 		pendingUpdate *schedspb.FullUpdateRequest
+		// New limit of consecutive failures, if one was signaled
+		pendingPauseAfterFailures *int64
 
 		uuidBatch []string
 
+		// Set if the schedule takes its actions a fixed delay after the previous one completed
+		delayAfterCompletion time.Duration
+		lastCompletionTime   time.Time
+
+		backfills              backfillState
+		backfillsChanged       bool
+		pendingBackfillCancels []string
+
 		// This cache is used to store time results after batching getNextTime queries
 		// in a single SideEffect
 		nextTimeResultCache map[time.Time]getNextTimeResult
@@ -172,7 +187,7 @@ var (
 		MaxBufferSize:                     1000,
 		AllowZeroSleep:                    true,
 		ReuseTimer:                        true,
-		Version:                           BatchAndCacheTimeQueries,
+		Version:                           TrackBackfills,
 	}
 
 	errUpdateConflict = errors.New("conflicting concurrent update")
@@ -193,6 +208,7 @@ func (s *scheduler) run() error {
 	s.updateTweakables()
 	s.ensureFields()
 	s.compileSpec()
+	s.loadBackfills()
 
 	if err := workflow.SetQueryHandler(s.ctx, QueryNameDescribe, s.handleDescribeQuery); err != nil {
 		return err
@@ -200,6 +216,9 @@ func (s *scheduler) run() error {
 	if err := workflow.SetQueryHandler(s.ctx, QueryNameListMatchingTimes, s.handleListMatchingTimesQuery); err != nil {
 		return err
 	}
+	if err := workflow.SetQueryHandler(s.ctx, QueryNameDescribeBackfills, s.handleDescribeBackfillsQuery); err != nil {
+		return err
+	}
 
 	if s.State.LastProcessedTime == nil {
 		// log these as json since it's more readable than the Go representation
@@ -213,13 +232,14 @@ func (s *scheduler) run() error {
 		s.State.ConflictToken = InitialConflictToken
 		s.Info.CreateTime = s.State.LastProcessedTime
 	}
+	s.loadDelayAfterCompletion()
 
 	// A schedule may be created with an initial Patch, e.g. start one immediately. Put that in
 	// the state so it takes effect below.
 	s.pendingPatch = s.InitialPatch
 	s.InitialPatch = nil
 
-	for iters := s.tweakables.IterationsBeforeContinueAsNew; iters > 0 || s.pendingUpdate != nil || s.pendingPatch != nil; iters-- {
+	for iters := s.tweakables.IterationsBeforeContinueAsNew; iters > 0 || s.pendingUpdate != nil || s.pendingPatch != nil || s.pendingPauseAfterFailures != nil || len(s.pendingBackfillCancels) > 0; iters-- {
 
 		t1 := timestamp.TimeValue(s.State.LastProcessedTime)
 		t2 := s.now()
@@ -245,6 +265,7 @@ func (s *scheduler) run() error {
 		//nolint:revive
 		for s.processBuffer() {
 		}
+		s.refreshBackfills()
 		s.updateMemoAndSearchAttributes()
 		// sleep returns on any of:
 		// 1. requested time elapsed
@@ -336,12 +357,14 @@ func (s *scheduler) processPatch(patch *schedpb.SchedulePatch) {
 	}
 
 	for _, bfr := range patch.BackfillRequest {
+		buffered := len(s.State.BufferedStarts)
 		s.processTimeRange(
 			timestamp.TimeValue(bfr.GetStartTime()),
 			timestamp.TimeValue(bfr.GetEndTime()),
 			bfr.GetOverlapPolicy(),
 			true,
 		)
+		s.addBackfill(bfr, len(s.State.BufferedStarts)-buffered)
 	}
 
 	if patch.Pause != "" {
@@ -352,6 +375,7 @@ func (s *scheduler) processPatch(patch *schedpb.SchedulePatch) {
 	if patch.Unpause != "" {
 		s.Schedule.State.Paused = false
 		s.Schedule.State.Notes = patch.Unpause
+		s.State.PauseReason = ""
 		s.incSeqNo()
 	}
 }
@@ -386,6 +410,10 @@ func (s *scheduler) processTimeRange(
 ) time.Time {
 	s.logger.Debug("processTimeRange", "t1", t1, "t2", t2, "overlap-policy", overlapPolicy, "manual", manual)
 
+	if s.delayAfterCompletion > 0 && !manual {
+		return s.processDelayAfterCompletion(t2, overlapPolicy)
+	}
+
 	if s.cspec == nil {
 		return time.Time{}
 	}
@@ -473,6 +501,18 @@ func (s *scheduler) sleep(nextWakeup time.Time) {
 	refreshCh := workflow.GetSignalChannel(s.ctx, SignalNameRefresh)
 	sel.AddReceive(refreshCh, s.handleRefreshSignal)
 
+	pauseAfterFailuresCh := workflow.GetSignalChannel(s.ctx, SignalNameSetPauseAfterFailures)
+	sel.AddReceive(pauseAfterFailuresCh, func(ch workflow.ReceiveChannel, _ bool) {
+		ch.Receive(s.ctx, &s.pendingPauseAfterFailures)
+	})
+
+	cancelBackfillCh := workflow.GetSignalChannel(s.ctx, SignalNameCancelBackfill)
+	sel.AddReceive(cancelBackfillCh, func(ch workflow.ReceiveChannel, _ bool) {
+		var id string
+		ch.Receive(s.ctx, &id)
+		s.pendingBackfillCancels = append(s.pendingBackfillCancels, id)
+	})
+
 	// if we're paused or out of actions, we don't need to wake up until we get an update
 	if s.tweakables.SleepWhilePaused && !s.canTakeScheduledAction(false, false) {
 		nextWakeup = time.Time{}
@@ -542,6 +582,7 @@ func (s *scheduler) processWatcherResult(id string, f workflow.Future) {
 	} else {
 		s.logger.Error("closed workflow not found in running list", "workflow", id)
 	}
+	s.recordCompletion()
 
 	// handle pause-on-failure
 	failedStatus := res.Status == enumspb.WORKFLOW_EXECUTION_STATUS_FAILED ||
@@ -561,6 +602,9 @@ func (s *scheduler) processWatcherResult(id string, f workflow.Future) {
 		s.incSeqNo()
 	}
 
+	// handle pause after consecutive failures
+	pauseAfterFailures := s.countConsecutiveFailures(id, &res, failedStatus)
+
 	// handle last completion/failure
 	if res.GetResult() != nil {
 		s.State.LastCompletionResult = res.GetResult()
@@ -570,7 +614,78 @@ func (s *scheduler) processWatcherResult(id string, f workflow.Future) {
 		s.State.ContinuedFailure = res.GetFailure()
 	}
 
-	s.logger.Debug("started workflow finished", "workflow", id, "status", res.Status, "pause-after-failure", pauseOnFailure)
+	s.logger.Debug("started workflow finished", "workflow", id, "status", res.Status, "pause-after-failure", pauseOnFailure,
+		"pause-after-consecutive-failures", pauseAfterFailures)
+}
+
+// countConsecutiveFailures counts the consecutive failed actions of a schedule which sets a limit of them, and
+// pauses the schedule once the count reaches that limit. It returns true if it paused the schedule.
+func (s *scheduler) countConsecutiveFailures(id string, res *schedspb.WatchWorkflowResponse, failed bool) bool {
+	limit := s.State.PauseAfterFailures
+	if limit <= 0 {
+		return false
+	}
+
+	if !failed {
+		s.State.ConsecutiveFailures = 0
+		return false
+	}
+	s.State.ConsecutiveFailures++
+	if s.State.ConsecutiveFailures < limit {
+		return false
+	}
+
+	count := s.State.ConsecutiveFailures
+	// start counting again once the schedule is unpaused
+	s.State.ConsecutiveFailures = 0
+	if s.Schedule.State.Paused {
+		return false
+	}
+	reason := fmt.Sprintf("%s %s", id, res.Status)
+	if message := res.GetFailure().GetMessage(); message != "" {
+		reason += ": " + message
+	}
+	s.Schedule.State.Paused = true
+	s.State.PauseReason = fmt.Sprintf("paused after %d consecutive failures, last: %s", count, reason)
+	s.incSeqNo()
+	s.logger.Debug("paused after consecutive failures", "workflow", id, "failures", count)
+	s.metrics.Counter(metrics.SchedulePausedAfterFailures.GetMetricName()).Inc(1)
+	s.notifyPaused(count, reason)
+	return true
+}
+
+// setPauseAfterFailures changes the limit of consecutive failures. The failures counted so far still count
+// against the new limit.
+func (s *scheduler) setPauseAfterFailures(limit int64) {
+	s.logger.Debug("Schedule pause after failures", "limit", limit)
+	if limit < 0 {
+		limit = 0
+	}
+	s.State.PauseAfterFailures = limit
+	if limit == 0 {
+		s.State.ConsecutiveFailures = 0
+	}
+	s.incSeqNo()
+}
+
+// notifyPaused posts a notification to the pause webhook of the namespace, if there is one. The notification is
+// best effort, the schedule doesn't wait for it.
+func (s *scheduler) notifyPaused(failures int64, reason string) {
+	ctx := workflow.WithActivityOptions(s.ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: 1 * time.Second,
+			MaximumInterval: 60 * time.Second,
+			MaximumAttempts: 10,
+		},
+	})
+	workflow.ExecuteActivity(ctx, s.a.NotifyPaused, &PauseNotification{
+		Namespace:           s.State.Namespace,
+		ScheduleID:          s.State.ScheduleId,
+		ConsecutiveFailures: failures,
+		Reason:              reason,
+		PauseTime:           s.now(),
+	})
 }
 
 func (s *scheduler) processUpdate(req *schedspb.FullUpdateRequest) {
@@ -588,6 +703,9 @@ func (s *scheduler) processUpdate(req *schedspb.FullUpdateRequest) {
 	// don't touch Info
 
 	s.ensureFields()
+	if !s.Schedule.State.Paused {
+		s.State.PauseReason = ""
+	}
 	s.compileSpec()
 
 	s.Info.UpdateTime = timestamp.TimePtr(s.now())
@@ -613,12 +731,26 @@ func (s *scheduler) processSignals() bool {
 		s.pendingUpdate = nil
 		scheduleChanged = true
 	}
+	if s.pendingPauseAfterFailures != nil {
+		s.setPauseAfterFailures(*s.pendingPauseAfterFailures)
+		s.pendingPauseAfterFailures = nil
+	}
+	for _, id := range s.pendingBackfillCancels {
+		s.cancelBackfill(id)
+	}
+	s.pendingBackfillCancels = nil
 	return scheduleChanged
 }
 
 func (s *scheduler) getFutureActionTimes(n int) []*time.Time {
 	// Note that `s` may be a fake scheduler used to compute list info at creation time.
 
+	if s.delayAfterCompletion > 0 {
+		if n == 0 || len(s.Info.RunningWorkflows) > 0 || !s.canTakeScheduledAction(false, false) {
+			return nil
+		}
+		return []*time.Time{timestamp.TimePtr(s.nextActionAfterCompletion())}
+	}
 	if s.cspec == nil {
 		return nil
 	}
@@ -645,6 +777,10 @@ func (s *scheduler) handleDescribeQuery() (*schedspb.DescribeResponse, error) {
 		Schedule:      s.Schedule,
 		Info:          &infoCopy,
 		ConflictToken: s.State.ConflictToken,
+
+		PauseAfterFailures:  s.State.PauseAfterFailures,
+		ConsecutiveFailures: s.State.ConsecutiveFailures,
+		PauseReason:         s.State.PauseReason,
 	}, nil
 }
 
@@ -693,6 +829,8 @@ func (s *scheduler) getListInfo() *schedpb.ScheduleListInfo {
 }
 
 func (s *scheduler) updateMemoAndSearchAttributes() {
+	s.updateBackfillsMemo()
+
 	newInfo := s.getListInfo()
 
 	workflowInfo := workflow.GetInfo(s.ctx)
@@ -842,6 +980,9 @@ func (s *scheduler) processBuffer() bool {
 		}
 		metricsWithTag.Counter(metrics.ScheduleActionSuccess.GetMetricName()).Inc(1)
 		s.recordAction(result)
+		if start.Manual {
+			s.countBackfillStart(timestamp.TimeValue(start.NominalTime))
+		}
 	}
 
 	// Terminate or cancel if required (terminate overrides cancel if both are present)
@@ -859,10 +1000,14 @@ func (s *scheduler) processBuffer() bool {
 	// (maybe one we just started). In order to get woken up, we need to be watching at least
 	// one of them with an activity. We only need one watcher at a time, though: after that one
 	// returns, we'll end up back here and start the next one.
-	if len(s.State.BufferedStarts) > 0 && s.watchingFuture == nil {
+	// With a delay after completion, we also need to know when the running workflow closes to
+	// arm the timer for the next action.
+	if s.watchingFuture == nil {
 		if len(s.Info.RunningWorkflows) > 0 {
-			s.startLongPollWatcher(s.Info.RunningWorkflows[0])
-		} else {
+			if len(s.State.BufferedStarts) > 0 || s.delayAfterCompletion > 0 {
+				s.startLongPollWatcher(s.Info.RunningWorkflows[0])
+			}
+		} else if len(s.State.BufferedStarts) > 0 {
 			s.logger.Error("have buffered workflows but none running")
 		}
 	}
@@ -1002,6 +1002,114 @@ func (s *workflowSuite) TestPauseOnFailure() {
 	// doesn't end properly since it sleeps forever after pausing
 }
 
+func (s *workflowSuite) TestPauseAfterConsecutiveFailures() {
+	// written using low-level mocks so we can return failures
+
+	s.expectStart(func(req *schedspb.StartWorkflowRequest) (*schedspb.StartWorkflowResponse, error) {
+		s.Equal("myid-2022-06-01T00:05:00Z", req.Request.WorkflowId)
+		return nil, nil
+	})
+	s.expectWatch(func(req *schedspb.WatchWorkflowRequest) (*schedspb.WatchWorkflowResponse, error) {
+		s.Equal("myid-2022-06-01T00:05:00Z", req.Execution.WorkflowId)
+		return &schedspb.WatchWorkflowResponse{Status: enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT}, nil
+	})
+	s.expectStart(func(req *schedspb.StartWorkflowRequest) (*schedspb.StartWorkflowResponse, error) {
+		s.Equal("myid-2022-06-01T00:10:00Z", req.Request.WorkflowId)
+		return nil, nil
+	})
+	s.expectWatch(func(req *schedspb.WatchWorkflowRequest) (*schedspb.WatchWorkflowResponse, error) {
+		s.Equal("myid-2022-06-01T00:10:00Z", req.Execution.WorkflowId)
+		return &schedspb.WatchWorkflowResponse{
+			Status: enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+			ResultFailure: &schedspb.WatchWorkflowResponse_Failure{
+				Failure: &failurepb.Failure{Message: "oops"},
+			},
+		}, nil
+	})
+	s.env.OnActivity(new(activities).NotifyPaused, mock.Anything, mock.Anything).Once().Return(
+		func(_ context.Context, req *PauseNotification) error {
+			s.Equal("myschedule", req.ScheduleID)
+			s.Equal(int64(2), req.ConsecutiveFailures)
+			s.Contains(req.Reason, "oops")
+			return nil
+		})
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameSetPauseAfterFailures, 2)
+	}, 1*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.False(desc.Schedule.State.Paused)
+		s.Equal(int64(2), desc.PauseAfterFailures)
+		s.Equal(int64(1), desc.ConsecutiveFailures)
+	}, 14*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.True(desc.Schedule.State.Paused)
+		s.Empty(desc.Schedule.State.Notes)
+		s.Contains(desc.PauseReason, "paused after 2 consecutive failures")
+		s.Contains(desc.PauseReason, "oops")
+		s.Equal(int64(0), desc.ConsecutiveFailures)
+	}, 16*time.Minute)
+
+	s.run(&schedpb.Schedule{
+		Spec: &schedpb.ScheduleSpec{
+			Interval: []*schedpb.IntervalSpec{{
+				Interval: timestamp.DurationPtr(5 * time.Minute),
+			}},
+		},
+	}, 5)
+	s.True(s.env.IsWorkflowCompleted())
+	// doesn't end properly since it sleeps forever after pausing
+}
+
+func (s *workflowSuite) TestDelayAfterCompletion() {
+	// written using low-level mocks since the memo isn't carried across continue-as-new in tests
+
+	runs := []workflowRun{
+		{
+			id:     "myid-2022-06-01T00:10:00Z",
+			start:  time.Date(2022, 6, 1, 0, 10, 0, 0, time.UTC),
+			end:    time.Date(2022, 6, 1, 0, 17, 0, 0, time.UTC),
+			result: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		},
+		{
+			id:     "myid-2022-06-01T00:27:00Z",
+			start:  time.Date(2022, 6, 1, 0, 27, 0, 0, time.UTC),
+			end:    time.Date(2022, 6, 1, 0, 30, 0, 0, time.UTC),
+			result: enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+		},
+		{
+			id:     "myid-2022-06-01T00:40:00Z",
+			start:  time.Date(2022, 6, 1, 0, 40, 0, 0, time.UTC),
+			end:    time.Date(2022, 6, 1, 1, 0, 0, 0, time.UTC),
+			result: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		},
+	}
+	started := make(map[string]time.Time)
+	s.setupMocksForWorkflows(runs, started)
+	s.env.SetMemoOnStart(map[string]interface{}{MemoFieldDelayAfterCompletion: "10m"})
+	s.env.RegisterDelayedCallback(func() {
+		s.Empty(s.describe().Info.FutureActionTimes)
+	}, 15*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.Equal([]*time.Time{timestamp.TimePtr(time.Date(2022, 6, 1, 0, 27, 0, 0, time.UTC))}, s.describe().Info.FutureActionTimes)
+	}, 20*time.Minute)
+
+	s.run(&schedpb.Schedule{
+		Spec: &schedpb.ScheduleSpec{
+			// ignored for scheduled actions
+			Interval: []*schedpb.IntervalSpec{{
+				Interval: timestamp.DurationPtr(5 * time.Minute),
+			}},
+		},
+	}, 6)
+	s.True(s.env.IsWorkflowCompleted())
+	s.Require().Equal(len(runs), len(started))
+	for _, run := range runs {
+		s.Equal(run.start, started[run.id])
+	}
+}
+
 func (s *workflowSuite) TestCompileError() {
 	// written using low-level mocks since it sleeps forever
 
@@ -1136,6 +1244,70 @@ func (s *workflowSuite) TestBackfill() {
 	)
 }
 
+func (s *workflowSuite) TestBackfillProgressAndCancel() {
+	// written using low-level mocks since the memo isn't carried across continue-as-new in tests
+
+	s.setupMocksForWorkflows([]workflowRun{
+		{
+			id:     "myid-2022-05-31T19:00:00Z",
+			start:  time.Date(2022, 6, 1, 0, 5, 0, 0, time.UTC),
+			end:    time.Date(2022, 6, 1, 0, 9, 0, 0, time.UTC),
+			result: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		},
+		{
+			id:     "myid-2022-05-31T19:17:00Z",
+			start:  time.Date(2022, 6, 1, 0, 9, 0, 0, time.UTC),
+			end:    time.Date(2022, 6, 1, 0, 13, 0, 0, time.UTC),
+			result: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		},
+	}, make(map[string]time.Time))
+	describeBackfills := func() []*BackfillProgress {
+		encoded, err := s.env.QueryWorkflow(QueryNameDescribeBackfills)
+		s.NoError(err)
+		var backfills []*BackfillProgress
+		s.NoError(encoded.Get(&backfills))
+		return backfills
+	}
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNamePatch, &schedpb.SchedulePatch{
+			BackfillRequest: []*schedpb.BackfillRequest{{
+				StartTime:     timestamp.TimePtr(time.Date(2022, 5, 31, 0, 0, 0, 0, time.UTC)),
+				EndTime:       timestamp.TimePtr(time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)),
+				OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ALL,
+			}},
+		})
+	}, 5*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		backfills := describeBackfills()
+		s.Require().Len(backfills, 1)
+		s.Equal("1", backfills[0].ID)
+		s.Equal(4, backfills[0].Total)
+		s.Equal(2, backfills[0].Started)
+		s.Equal(2, backfills[0].Remaining)
+		s.Equal(time.Date(2022, 5, 31, 19, 34, 0, 0, time.UTC), backfills[0].CompletedUntil)
+		s.False(backfills[0].Canceled)
+		s.env.SignalWorkflow(SignalNameCancelBackfill, "1")
+	}, 10*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		backfills := describeBackfills()
+		s.Require().Len(backfills, 1)
+		s.Equal(2, backfills[0].Started)
+		s.Equal(0, backfills[0].Remaining)
+		s.True(backfills[0].Canceled)
+	}, 11*time.Minute)
+
+	s.run(&schedpb.Schedule{
+		Spec: &schedpb.ScheduleSpec{
+			Calendar: []*schedpb.CalendarSpec{{
+				Minute:     "*/17",
+				Hour:       "19",
+				DayOfMonth: "31",
+			}},
+		},
+	}, 5)
+	s.True(s.env.IsWorkflowCompleted())
+}
+
 func (s *workflowSuite) TestPause() {
 	s.runAcrossContinue(
 		[]workflowRun{
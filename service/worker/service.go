@@ -52,10 +52,12 @@ import (
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/service/worker/archiver"
 	"go.temporal.io/server/service/worker/batcher"
+	workercommon "go.temporal.io/server/service/worker/common"
 	"go.temporal.io/server/service/worker/parentclosepolicy"
 	"go.temporal.io/server/service/worker/replicator"
 	"go.temporal.io/server/service/worker/scanner"
@@ -100,6 +102,8 @@ type (
 		perNamespaceWorkerManager *perNamespaceWorkerManager
 		scanner                   *scanner.Scanner
 		matchingClient            matchingservice.MatchingServiceClient
+
+		persistenceServiceResolver resolver.ServiceResolver
 	}
 
 	// Config contains all the service config for worker
@@ -120,6 +124,7 @@ type (
 		EnableParentClosePolicyWorker         dynamicconfig.BoolPropertyFn
 		PerNamespaceWorkerCount               dynamicconfig.IntPropertyFnWithNamespaceFilter
 		PerNamespaceWorkerOptions             dynamicconfig.MapPropertyFnWithNamespaceFilter
+		PerNamespaceWorkerQuotas              workercommon.PerNSWorkerQuotas
 
 		VisibilityPersistenceMaxReadQPS   dynamicconfig.IntPropertyFn
 		VisibilityPersistenceMaxWriteQPS  dynamicconfig.IntPropertyFn
@@ -152,6 +157,7 @@ func NewService(
 	perNamespaceWorkerManager *perNamespaceWorkerManager,
 	visibilityManager manager.VisibilityManager,
 	matchingClient resource.MatchingClient,
+	persistenceServiceResolver resolver.ServiceResolver,
 ) (*Service, error) {
 	workerServiceResolver, err := membershipMonitor.GetResolver(primitives.WorkerService)
 	if err != nil {
@@ -185,6 +191,8 @@ func NewService(
 		workerManager:             workerManager,
 		perNamespaceWorkerManager: perNamespaceWorkerManager,
 		matchingClient:            matchingClient,
+
+		persistenceServiceResolver: persistenceServiceResolver,
 	}
 	if err := s.initScanner(); err != nil {
 		return nil, err
@@ -196,6 +204,7 @@ func NewService(
 func NewConfig(
 	dc *dynamicconfig.Collection,
 	persistenceConfig *config.Persistence,
+	visibilityArchiverProvider *config.VisibilityArchiverProvider,
 	visibilityStoreConfigExist bool,
 	enableReadFromES bool,
 ) *Config {
@@ -293,6 +302,63 @@ func NewConfig(
 				dynamicconfig.ExecutionsScannerEnabled,
 				false,
 			),
+			StorageUsageScannerEnabled: dc.GetBoolProperty(
+				dynamicconfig.StorageUsageScannerEnabled,
+				false,
+			),
+			StorageUsageScannerPerHostQPS: dc.GetIntProperty(
+				dynamicconfig.StorageUsageScannerPerHostQPS,
+				10,
+			),
+			ReencryptionScannerEnabled: dc.GetBoolProperty(
+				dynamicconfig.ReencryptionScannerEnabled,
+				false,
+			),
+			ReencryptionScannerPerHostQPS: dc.GetIntProperty(
+				dynamicconfig.ReencryptionScannerPerHostQPS,
+				10,
+			),
+			VisibilityConsistencyScannerEnabled: dc.GetBoolProperty(
+				dynamicconfig.VisibilityConsistencyScannerEnabled,
+				false,
+			),
+			VisibilityConsistencyScannerPerHostQPS: dc.GetIntProperty(
+				dynamicconfig.VisibilityConsistencyScannerPerHostQPS,
+				10,
+			),
+			VisibilityConsistencyScannerRepairEnabled: dc.GetBoolProperty(
+				dynamicconfig.VisibilityConsistencyScannerRepairEnabled,
+				false,
+			),
+			VisibilityExportScannerEnabled: dc.GetBoolProperty(
+				dynamicconfig.VisibilityExportScannerEnabled,
+				false,
+			),
+			VisibilityExportScannerPerHostQPS: dc.GetIntProperty(
+				dynamicconfig.VisibilityExportScannerPerHostQPS,
+				10,
+			),
+			VisibilityExportDelay: dc.GetDurationProperty(
+				dynamicconfig.VisibilityExportDelay,
+				10*time.Minute,
+			),
+			VisibilityExportProvider: visibilityArchiverProvider,
+			ArchiveExpiryScannerEnabled: dc.GetBoolProperty(
+				dynamicconfig.ArchiveExpiryScannerEnabled,
+				false,
+			),
+			ArchiveExpiryScannerPerHostQPS: dc.GetIntProperty(
+				dynamicconfig.ArchiveExpiryScannerPerHostQPS,
+				10,
+			),
+			TablePartitionScannerEnabled: dc.GetBoolProperty(
+				dynamicconfig.TablePartitionScannerEnabled,
+				false,
+			),
+			TablePartitionShardsPerPartition: dc.GetIntProperty(
+				dynamicconfig.TablePartitionShardsPerPartition,
+				64,
+			),
 			HistoryScannerDataMinAge: dc.GetDurationProperty(
 				dynamicconfig.HistoryScannerDataMinAge,
 				60*24*time.Hour,
@@ -301,6 +367,10 @@ func NewConfig(
 				dynamicconfig.HistoryScannerVerifyRetention,
 				true,
 			),
+			HistoryScannerDryRun: dc.GetBoolProperty(
+				dynamicconfig.HistoryScannerDryRun,
+				false,
+			),
 			ExecutionScannerPerHostQPS: dc.GetIntProperty(
 				dynamicconfig.ExecutionScannerPerHostQPS,
 				10,
@@ -321,6 +391,18 @@ func NewConfig(
 				dynamicconfig.ExecutionScannerHistoryEventIdValidator,
 				true,
 			),
+			LeaderElectionEnabled: dc.GetBoolProperty(
+				dynamicconfig.ScannerLeaderElectionEnabled,
+				true,
+			),
+			LeaseDuration: dc.GetDurationProperty(
+				dynamicconfig.ScannerLeaseDuration,
+				time.Minute,
+			),
+			LeaseRenewInterval: dc.GetDurationProperty(
+				dynamicconfig.ScannerLeaseRenewInterval,
+				10*time.Second,
+			),
 		},
 		EnableBatcher:      dc.GetBoolProperty(dynamicconfig.EnableBatcher, true),
 		BatcherRPS:         dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BatcherRPS, batcher.DefaultRPS),
@@ -337,6 +419,7 @@ func NewConfig(
 			dynamicconfig.WorkerPerNamespaceWorkerOptions,
 			map[string]any{},
 		),
+		PerNamespaceWorkerQuotas: workercommon.NewPerNSWorkerQuotas(dc),
 		ThrottledLogRPS: dc.GetIntProperty(
 			dynamicconfig.WorkerThrottledLogRPS,
 			20,
@@ -506,6 +589,7 @@ func (s *Service) initScanner() error {
 		s.metricsHandler,
 		s.executionManager,
 		s.metadataManager,
+		s.namespaceReplicationQueue,
 		s.visibilityManager,
 		s.taskManager,
 		s.historyClient,
@@ -513,6 +597,8 @@ func (s *Service) initScanner() error {
 		s.matchingClient,
 		s.namespaceRegistry,
 		currentCluster,
+		s.persistenceServiceResolver,
+		s.archiverProvider,
 	)
 	return nil
 }
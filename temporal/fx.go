@@ -28,6 +28,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pborman/uuid"
 	"go.opentelemetry.io/otel"
@@ -43,7 +44,9 @@ import (
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/audit"
 	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/collection"
 	"go.temporal.io/server/common/config"
@@ -55,6 +58,8 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/cassandra"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	persistenceEncryption "go.temporal.io/server/common/persistence/encryption"
+	"go.temporal.io/server/common/persistence/schema"
 	"go.temporal.io/server/common/persistence/sql"
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/pprof"
@@ -66,6 +71,7 @@ import (
 	"go.temporal.io/server/common/telemetry"
 	"go.temporal.io/server/service/frontend"
 	"go.temporal.io/server/service/history"
+	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/replication"
 	"go.temporal.io/server/service/history/workflow"
 	"go.temporal.io/server/service/matching"
@@ -90,6 +96,19 @@ type (
 		stopChan    chan struct{}
 	}
 
+	SchemaMigrationParams struct {
+		fx.In
+
+		Lifecycle           fx.Lifecycle
+		Config              *config.Config
+		ServiceNames        resource.ServiceNames
+		ServiceResolver     resolver.ServiceResolver
+		DynamicConfigClient dynamicconfig.Client
+		MetricsHandler      metrics.Handler
+		Logger              log.Logger
+		Backfills           []schema.Backfill `group:"schemaBackfills"`
+	}
+
 	ServerFx struct {
 		app                        *fx.App
 		startupSynchronizationMode synchronizationModeParams
@@ -111,9 +130,11 @@ type (
 
 		ServiceResolver        resolver.ServiceResolver
 		CustomDataStoreFactory persistenceClient.AbstractDataStoreFactory
+		PersistenceKeyProvider persistenceEncryption.KeyProvider
 
 		SearchAttributesMapper searchattribute.Mapper
 		CustomInterceptors     []grpc.UnaryServerInterceptor
+		AuditSinks             []audit.Sink
 		Authorizer             authorization.Authorizer
 		ClaimMapper            authorization.ClaimMapper
 		AudienceGetter         authorization.JWTAudienceMapper
@@ -145,6 +166,7 @@ var (
 
 		fx.Provide(ApplyClusterMetadataConfigProvider),
 		fx.Invoke(ServerLifetimeHooks),
+		fx.Invoke(SchemaMigrationLifetimeHooks),
 		FxLogAdapter,
 	)
 )
@@ -198,20 +220,50 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 	}
 
 	// DynamicConfigClient
+	// schemas are registered first, so that the values loaded by the client can be checked against them
+	registerDynamicConfigSchemas(so.config)
 	dcClient := so.dynamicConfigClient
 	if dcClient == nil {
 		dcConfig := so.config.DynamicConfigClient
+		kvConfig := so.config.DynamicConfigKVClient
 		if dcConfig != nil {
 			dcClient, err = dynamicconfig.NewFileBasedClient(dcConfig, logger, stopChan)
 			if err != nil {
 				return serverOptionsProvider{}, fmt.Errorf("unable to create dynamic config client: %w", err)
 			}
-		} else {
+		}
+		if kvConfig != nil {
+			var clusterName string
+			if so.config.ClusterMetadata != nil {
+				clusterName = so.config.ClusterMetadata.CurrentClusterName
+			}
+			// the file based client, if any, serves the keys which are not set in the KV store
+			dcClient, err = dynamicconfig.NewKVClient(kvConfig, clusterName, dcClient, logger, stopChan)
+			if err != nil {
+				return serverOptionsProvider{}, fmt.Errorf("unable to create dynamic config client: %w", err)
+			}
+		}
+		if dcClient == nil {
 			// noop client
 			logger.Info("Dynamic config client is not configured. Using default values.")
 			dcClient = dynamicconfig.NewNoopClient()
 		}
 	}
+	// values set at runtime through the operator API take precedence over those of the client
+	dcClient = dynamicconfig.NewOverrideClient(dcClient, logger)
+
+	dc := dynamicconfig.NewCollection(dcClient, logger)
+	// namespaces with debug telemetry log their debug messages and are not limited below
+	debugMode := telemetry.NewDebugMode(dc, clock.NewRealTimeSource())
+	logger = log.NewNamespaceDebugLogger(logger, debugMode.Enabled)
+
+	// namespaces creating task queues with random names must not blow up the number of metric series
+	cardinalityConfig := metrics.NewCardinalityConfig(dc)
+	cardinalityConfig.Unlimited = debugMode.Enabled
+	metricHandler = metrics.NewCardinalityLimitingHandler(
+		metricHandler,
+		metrics.NewCardinalityLimiter(cardinalityConfig, clock.NewRealTimeSource()),
+	)
 
 	// TLSConfigProvider
 	tlsConfigProvider := so.tlsConfigProvider
@@ -222,6 +274,15 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 		}
 	}
 
+	// PersistenceKeyProvider
+	persistenceKeyProvider := so.persistenceKeyProvider
+	if persistenceKeyProvider == nil {
+		persistenceKeyProvider, err = persistenceEncryption.NewStaticKeyProvider(persistenceConfig.Encryption)
+		if err != nil {
+			return serverOptionsProvider{}, err
+		}
+	}
+
 	// EsConfig / EsClient
 	var esConfig *esclient.Config
 	var esClient esclient.Client
@@ -250,6 +311,13 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 		if err != nil {
 			return serverOptionsProvider{}, fmt.Errorf("unable to create Elasticsearch client: %w", err)
 		}
+
+		// Index state management policies are attached on a best effort basis, the indices are usable without them.
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		if err := esclient.AttachISMPolicies(ctx, esClient, esConfig); err != nil {
+			logger.Warn("Unable to attach index state management policy to OpenSearch visibility indices.", tag.Error(err))
+		}
+		cancel()
 	}
 
 	return serverOptionsProvider{
@@ -266,9 +334,11 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 
 		ServiceResolver:        so.persistenceServiceResolver,
 		CustomDataStoreFactory: so.customDataStoreFactory,
+		PersistenceKeyProvider: persistenceKeyProvider,
 
 		SearchAttributesMapper: so.searchAttributesMapper,
 		CustomInterceptors:     so.customInterceptors,
+		AuditSinks:             so.auditSinks,
 		Authorizer:             so.authorizer,
 		ClaimMapper:            so.claimMapper,
 		AudienceGetter:         so.audienceGetter,
@@ -343,9 +413,11 @@ type (
 		PersistenceFactoryProvider persistenceClient.FactoryProviderFn
 		SearchAttributesMapper     searchattribute.Mapper
 		CustomInterceptors         []grpc.UnaryServerInterceptor
+		AuditSinks                 []audit.Sink
 		Authorizer                 authorization.Authorizer
 		ClaimMapper                authorization.ClaimMapper
 		DataStoreFactory           persistenceClient.AbstractDataStoreFactory
+		PersistenceKeyProvider     persistenceEncryption.KeyProvider
 		SpanExporters              []otelsdktrace.SpanExporter
 		InstanceID                 resource.InstanceID `optional:"true"`
 	}
@@ -383,6 +455,7 @@ func HistoryServiceProvider(
 			serviceName,
 		),
 		fx.Provide(func() persistenceClient.AbstractDataStoreFactory { return params.DataStoreFactory }),
+		fx.Provide(func() persistenceEncryption.KeyProvider { return params.PersistenceKeyProvider }),
 		fx.Provide(func() client.FactoryProvider { return params.ClientFactoryProvider }),
 		fx.Provide(func() authorization.JWTAudienceMapper { return params.AudienceGetter }),
 		fx.Provide(func() resolver.ServiceResolver { return params.PersistenceServiceResolver }),
@@ -433,6 +506,7 @@ func MatchingServiceProvider(
 			serviceName,
 		),
 		fx.Provide(func() persistenceClient.AbstractDataStoreFactory { return params.DataStoreFactory }),
+		fx.Provide(func() persistenceEncryption.KeyProvider { return params.PersistenceKeyProvider }),
 		fx.Provide(func() client.FactoryProvider { return params.ClientFactoryProvider }),
 		fx.Provide(func() authorization.JWTAudienceMapper { return params.AudienceGetter }),
 		fx.Provide(func() resolver.ServiceResolver { return params.PersistenceServiceResolver }),
@@ -491,11 +565,13 @@ func genericFrontendServiceProvider(
 			serviceName,
 		),
 		fx.Provide(func() persistenceClient.AbstractDataStoreFactory { return params.DataStoreFactory }),
+		fx.Provide(func() persistenceEncryption.KeyProvider { return params.PersistenceKeyProvider }),
 		fx.Provide(func() client.FactoryProvider { return params.ClientFactoryProvider }),
 		fx.Provide(func() authorization.JWTAudienceMapper { return params.AudienceGetter }),
 		fx.Provide(func() resolver.ServiceResolver { return params.PersistenceServiceResolver }),
 		fx.Provide(func() searchattribute.Mapper { return params.SearchAttributesMapper }),
 		fx.Provide(func() []grpc.UnaryServerInterceptor { return params.CustomInterceptors }),
+		fx.Provide(func() []audit.Sink { return params.AuditSinks }),
 		fx.Provide(func() authorization.Authorizer { return params.Authorizer }),
 		fx.Provide(func() authorization.ClaimMapper {
 			switch serviceName {
@@ -557,6 +633,7 @@ func WorkerServiceProvider(
 			serviceName,
 		),
 		fx.Provide(func() persistenceClient.AbstractDataStoreFactory { return params.DataStoreFactory }),
+		fx.Provide(func() persistenceEncryption.KeyProvider { return params.PersistenceKeyProvider }),
 		fx.Provide(func() client.FactoryProvider { return params.ClientFactoryProvider }),
 		fx.Provide(func() authorization.JWTAudienceMapper { return params.AudienceGetter }),
 		fx.Provide(func() resolver.ServiceResolver { return params.PersistenceServiceResolver }),
@@ -603,6 +680,8 @@ func ApplyClusterMetadataConfigProvider(
 		persistenceServiceResolver,
 		&config.Persistence,
 		customDataStoreFactory,
+		nil, // cluster and namespace metadata are never encrypted
+		nil,
 		logger,
 		nil,
 	)
@@ -864,6 +943,79 @@ func verifyPersistenceCompatibleVersion(config config.Persistence, persistenceSe
 	return nil
 }
 
+// SchemaMigrationLifetimeHooks keeps verifying the persistence schema version while the server runs,
+// and runs the schema backfills registered in the schemaBackfills value group on hosts of the worker service.
+func SchemaMigrationLifetimeHooks(params SchemaMigrationParams) {
+	dc := dynamicconfig.NewCollection(params.DynamicConfigClient, params.Logger)
+	persistenceConfig := params.Config.Persistence
+
+	monitor := schema.NewCompatibilityMonitor(
+		func() error {
+			return verifyPersistenceCompatibleVersion(persistenceConfig, params.ServiceResolver)
+		},
+		func(err error) {
+			params.Logger.Fatal("Persistence schema has been contracted past the version of this server.", tag.Error(err))
+		},
+		dc.GetDurationProperty(dynamicconfig.PersistenceSchemaCompatibilityCheckInterval, 5*time.Minute),
+		params.MetricsHandler,
+		params.Logger,
+	)
+	params.Lifecycle.Append(fx.StartStopHook(monitor.Start, monitor.Stop))
+
+	if _, ok := params.ServiceNames[primitives.WorkerService]; !ok || len(params.Backfills) == 0 {
+		return
+	}
+	if !dc.GetBoolProperty(dynamicconfig.PersistenceSchemaBackfillEnabled, true)() {
+		params.Logger.Info("Schema backfills are disabled.")
+		return
+	}
+
+	var store schema.BackfillStore
+	var runner *schema.BackfillRunner
+	params.Lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			var err error
+			store, err = newSchemaBackfillStore(persistenceConfig, params.ServiceResolver, params.Logger)
+			if err != nil || store == nil {
+				return err
+			}
+			runner = schema.NewBackfillRunner(
+				params.Backfills,
+				store,
+				dc.GetDurationProperty(dynamicconfig.PersistenceSchemaBackfillStepInterval, 100*time.Millisecond),
+				params.MetricsHandler,
+				params.Logger,
+			)
+			runner.Start()
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			if runner != nil {
+				runner.Stop()
+				store.Close()
+			}
+			return nil
+		},
+	})
+}
+
+func newSchemaBackfillStore(
+	config config.Persistence,
+	persistenceServiceResolver resolver.ServiceResolver,
+	logger log.Logger,
+) (schema.BackfillStore, error) {
+	ds := config.DataStores[config.DefaultStore]
+	switch {
+	case ds.SQL != nil:
+		return sql.NewSchemaBackfillStore(ds.SQL, persistenceServiceResolver)
+	case ds.Cassandra != nil:
+		return cassandra.NewSchemaBackfillStore(*ds.Cassandra, persistenceServiceResolver, logger)
+	default:
+		logger.Warn("Schema backfills are not supported by the default store, skipping them.")
+		return nil, nil
+	}
+}
+
 // TraceExportModule holds process-global telemetry fx state defining the set of
 // OTEL trace/span exporters used by tracing instrumentation. The following
 // types can be overriden/augmented with fx.Replace/fx.Decorate:
@@ -901,7 +1053,9 @@ var TraceExportModule = fx.Options(
 //   - *go.opentelemetry.io/otel/sdk/resource.Resource
 //     default: resource.Default() augmented with the supplied serviceName
 //   - []go.opentelemetry.io/otel/sdk/trace.TracerProviderOption
-//     default: the provided resource.Resource and each of the otelsdktrace.SpanExporter
+//     default: the provided resource.Resource, a telemetry.NewNamespaceSampler using the
+//     system.traceSampleRate dynamic config, or sampling everything for namespaces with
+//     debug telemetry, and each of the otelsdktrace.SpanExporter
 //   - go.opentelemetry.io/otel/trace.TracerProvider
 //     default: otelsdktrace.NewTracerProvider with each of the otelsdktrace.TracerProviderOption
 //   - go.opentelemetry.io/otel/ppropagation.TextMapPropagator
@@ -952,9 +1106,18 @@ var ServiceTracingModule = fx.Options(
 		),
 	),
 	fx.Provide(
-		func(r *otelresource.Resource, sps []otelsdktrace.SpanProcessor) []otelsdktrace.TracerProviderOption {
-			opts := make([]otelsdktrace.TracerProviderOption, 0, len(sps)+1)
+		func(r *otelresource.Resource, sps []otelsdktrace.SpanProcessor, dc *dynamicconfig.Collection) []otelsdktrace.TracerProviderOption {
+			sampleRate := dc.GetFloatPropertyFilteredByNamespace(dynamicconfig.TraceSampleRate, 1.0)
+			debugMode := telemetry.NewDebugMode(dc, clock.NewRealTimeSource())
+			namespaceSampleRate := func(namespace string) float64 {
+				if debugMode.Enabled(namespace) {
+					return 1.0
+				}
+				return sampleRate(namespace)
+			}
+			opts := make([]otelsdktrace.TracerProviderOption, 0, len(sps)+2)
 			opts = append(opts, otelsdktrace.WithResource(r))
+			opts = append(opts, otelsdktrace.WithSampler(telemetry.NewNamespaceSampler(namespaceSampleRate)))
 			for _, sp := range sps {
 				opts = append(opts, otelsdktrace.WithSpanProcessor(sp))
 			}
@@ -1136,3 +1299,17 @@ func (l *fxLogAdapter) LogEvent(e fxevent.Event) {
 		)
 	}
 }
+
+// registerDynamicConfigSchemas registers the schema of the dynamic config keys of all services, so that
+// runtime overrides can be validated by frontends which do not run in the same process as the other services,
+// and so that the values loaded by the dynamic config client can be checked at startup.
+func registerDynamicConfigSchemas(cfg *config.Config) {
+	dc := dynamicconfig.NewNoopCollection()
+	numShards := cfg.Persistence.NumHistoryShards
+	frontend.NewConfig(dc, numShards, false, false)
+	configs.NewConfig(dc, numShards, false, false)
+	matching.NewConfig(dc, false, false)
+	worker.NewConfig(dc, &cfg.Persistence, &config.VisibilityArchiverProvider{}, false, false)
+	metrics.NewCardinalityConfig(dc)
+	telemetry.NewDebugMode(dc, clock.NewRealTimeSource())
+}
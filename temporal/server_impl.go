@@ -172,6 +172,8 @@ func initSystemNamespaces(
 		persistenceServiceResolver,
 		cfg,
 		customDataStoreFactory,
+		nil, // cluster and namespace metadata are never encrypted
+		nil,
 		logger,
 		nil,
 	)
@@ -30,12 +30,14 @@ import (
 	"google.golang.org/grpc"
 
 	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/audit"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	persistenceclient "go.temporal.io/server/common/persistence/client"
+	persistenceencryption "go.temporal.io/server/common/persistence/encryption"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/rpc/encryption"
@@ -155,6 +157,27 @@ func WithCustomDataStoreFactory(customFactory persistenceclient.AbstractDataStor
 	})
 }
 
+// WithPersistenceKeyProvider sets a custom KeyProvider for the keys used to encrypt persisted data,
+// overriding the static keys from the persistence config.
+// NOTE: this option is experimental and may be changed or removed in future release.
+func WithPersistenceKeyProvider(keyProvider persistenceencryption.KeyProvider) ServerOption {
+	return applyFunc(func(s *serverOptions) {
+		s.persistenceKeyProvider = keyProvider
+	})
+}
+
+// WithSecretProvider registers a SecretProvider for references to secrets with the given URL scheme,
+// e.g. "vault" for vault://secret/data/temporal#password. Secret references in the static config are
+// resolved before the config is validated. Datastore and Elasticsearch passwords are resolved again
+// when their clients reconnect after a rotation, so providers must be safe for concurrent use.
+// Providers for the "env", "file", "vault" and "awssm" schemes are registered by default and can be overridden.
+// NOTE: this option is experimental and may be changed or removed in future release.
+func WithSecretProvider(scheme string, secretProvider config.SecretProvider) ServerOption {
+	return applyFunc(func(s *serverOptions) {
+		s.secretProviders[scheme] = secretProvider
+	})
+}
+
 // WithClientFactoryProvider sets a custom ClientFactoryProvider
 // NOTE: this option is experimental and may be changed or removed in future release.
 func WithClientFactoryProvider(clientFactoryProvider client.FactoryProvider) ServerOption {
@@ -182,6 +205,14 @@ func WithChainedFrontendGrpcInterceptors(
 	})
 }
 
+// WithAuditSinks sets additional sinks that frontend audit records are written to, besides the ones in the
+// audit config.
+func WithAuditSinks(sinks ...audit.Sink) ServerOption {
+	return applyFunc(func(s *serverOptions) {
+		s.auditSinks = sinks
+	})
+}
+
 // WithCustomerMetricsProvider sets a custom implementation of the metrics.MetricsHandler interface
 // metrics.MetricsHandler is the base interface for publishing metric events
 func WithCustomMetricsHandler(provider metrics.Handler) ServerOption {
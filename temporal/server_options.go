@@ -25,6 +25,7 @@
 package temporal
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -32,12 +33,14 @@ import (
 	"google.golang.org/grpc"
 
 	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/audit"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	persistenceEncryption "go.temporal.io/server/common/persistence/encryption"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/rpc/encryption"
@@ -70,9 +73,12 @@ type (
 		elasticsearchHttpClient    *http.Client
 		dynamicConfigClient        dynamicconfig.Client
 		customDataStoreFactory     persistenceClient.AbstractDataStoreFactory
+		persistenceKeyProvider     persistenceEncryption.KeyProvider
+		secretProviders            map[string]config.SecretProvider
 		clientFactoryProvider      client.FactoryProvider
 		searchAttributesMapper     searchattribute.Mapper
 		customInterceptors         []grpc.UnaryServerInterceptor
+		auditSinks                 []audit.Sink
 		metricHandler              metrics.Handler
 	}
 )
@@ -81,6 +87,7 @@ func newServerOptions(opts []ServerOption) *serverOptions {
 	so := &serverOptions{
 		// Set defaults here.
 		persistenceServiceResolver: resolver.NewNoopResolver(),
+		secretProviders:            config.DefaultSecretProviders(),
 	}
 	for _, opt := range opts {
 		opt.apply(so)
@@ -103,7 +110,12 @@ func (so *serverOptions) loadAndValidate() error {
 		}
 	}
 
-	err := so.validateConfig()
+	err := so.config.ResolveSecrets(context.Background(), so.secretProviders)
+	if err != nil {
+		return fmt.Errorf("unable to resolve config secrets: %w", err)
+	}
+
+	err = so.validateConfig()
 	if err != nil {
 		return fmt.Errorf("config validation error: %w", err)
 	}
@@ -49,6 +49,7 @@ import (
 	"go.temporal.io/server/common"
 	carchiver "go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/audit"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
@@ -60,6 +61,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	persistenceEncryption "go.temporal.io/server/common/persistence/encryption"
 	"go.temporal.io/server/common/persistence/visibility"
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/primitives"
@@ -392,6 +394,8 @@ func (c *temporalImpl) startFrontend(hosts map[primitives.ServiceName][]string,
 		fx.Provide(sdkClientFactoryProvider),
 		fx.Provide(func() metrics.Handler { return metrics.NoopMetricsHandler }),
 		fx.Provide(func() []grpc.UnaryServerInterceptor { return nil }),
+		fx.Provide(func() []audit.Sink { return nil }),
+		fx.Provide(func() *config.Config { return &config.Config{} }),
 		fx.Provide(func() authorization.Authorizer { return nil }),
 		fx.Provide(func() authorization.ClaimMapper { return nil }),
 		fx.Provide(func() authorization.JWTAudienceMapper { return nil }),
@@ -402,6 +406,7 @@ func (c *temporalImpl) startFrontend(hosts map[primitives.ServiceName][]string,
 		fx.Provide(func() resolver.ServiceResolver { return resolver.NewNoopResolver() }),
 		fx.Provide(persistenceClient.FactoryProvider),
 		fx.Provide(func() persistenceClient.AbstractDataStoreFactory { return nil }),
+		fx.Provide(func() persistenceEncryption.KeyProvider { return nil }),
 		fx.Provide(func() dynamicconfig.Client { return c.dcClient }),
 		fx.Provide(func() log.Logger { return c.logger }),
 		fx.Provide(resource.DefaultSnTaggedLoggerProvider),
@@ -490,6 +495,7 @@ func (c *temporalImpl) startHistory(
 			fx.Provide(func() resolver.ServiceResolver { return resolver.NewNoopResolver() }),
 			fx.Provide(persistenceClient.FactoryProvider),
 			fx.Provide(func() persistenceClient.AbstractDataStoreFactory { return nil }),
+			fx.Provide(func() persistenceEncryption.KeyProvider { return nil }),
 			fx.Provide(func() dynamicconfig.Client { return c.dcClient }),
 			fx.Provide(func() log.Logger { return c.logger }),
 			fx.Provide(resource.DefaultSnTaggedLoggerProvider),
@@ -581,6 +587,7 @@ func (c *temporalImpl) startMatching(hosts map[primitives.ServiceName][]string,
 		fx.Provide(func() resolver.ServiceResolver { return resolver.NewNoopResolver() }),
 		fx.Provide(persistenceClient.FactoryProvider),
 		fx.Provide(func() persistenceClient.AbstractDataStoreFactory { return nil }),
+		fx.Provide(func() persistenceEncryption.KeyProvider { return nil }),
 		fx.Provide(func() dynamicconfig.Client { return c.dcClient }),
 		fx.Provide(func() *esclient.Config { return c.esConfig }),
 		fx.Provide(func() esclient.Client { return c.esClient }),
@@ -652,6 +659,7 @@ func (c *temporalImpl) startWorker(hosts map[primitives.ServiceName][]string, st
 	var clientBean client.Bean
 	var namespaceRegistry namespace.Registry
 	app := fx.New(
+		fx.Provide(func() *config.Config { return &config.Config{} }),
 		fx.Supply(
 			stoppedCh,
 			persistenceConfig,
@@ -674,6 +682,7 @@ func (c *temporalImpl) startWorker(hosts map[primitives.ServiceName][]string, st
 		fx.Provide(func() resolver.ServiceResolver { return resolver.NewNoopResolver() }),
 		fx.Provide(persistenceClient.FactoryProvider),
 		fx.Provide(func() persistenceClient.AbstractDataStoreFactory { return nil }),
+		fx.Provide(func() persistenceEncryption.KeyProvider { return nil }),
 		fx.Provide(func() dynamicconfig.Client { return c.dcClient }),
 		fx.Provide(func() log.Logger { return c.logger }),
 		fx.Provide(resource.DefaultSnTaggedLoggerProvider),
@@ -57,6 +57,10 @@ func (s *simpleMonitor) EvictSelf() error {
 	return nil
 }
 
+func (s *simpleMonitor) Join() error {
+	return nil
+}
+
 func (s *simpleMonitor) WhoAmI() (membership.HostInfo, error) {
 	return s.hostInfo, nil
 }
@@ -92,6 +92,17 @@ const (
 		`old_version text, ` +
 		`PRIMARY KEY ((year, month), update_time));`
 
+	readSchemaMinCompatibleVersionCQL = `SELECT min_compatible_version from schema_version where keyspace_name=?`
+	readSchemaBackfillCompletedCQL    = `SELECT completed from schema_backfill where keyspace_name=? and name=?`
+
+	createSchemaBackfillTableCQL = `CREATE TABLE IF NOT EXISTS schema_backfill(` +
+		`keyspace_name text, ` +
+		`name text, ` +
+		`backfill_cursor blob, ` +
+		`completed boolean, ` +
+		`update_time timestamp, ` +
+		`PRIMARY KEY (keyspace_name, name));`
+
 	createKeyspaceCQL = `CREATE KEYSPACE IF NOT EXISTS %v ` +
 		`WITH replication = { 'class' : 'SimpleStrategy', 'replication_factor' : %v};`
 
@@ -218,6 +229,41 @@ func (client *cqlClient) WriteSchemaUpdateLog(oldVersion string, newVersion stri
 	return query.Exec()
 }
 
+// ReadSchemaMinCompatibleVersion returns the min compatible schema version for the Keyspace
+func (client *cqlClient) ReadSchemaMinCompatibleVersion() (string, error) {
+	query := client.session.Query(readSchemaMinCompatibleVersionCQL, client.keyspace)
+
+	iter := query.Iter()
+	var version string
+	success := iter.Scan(&version)
+	err := iter.Close()
+	if err == nil && !success {
+		err = fmt.Errorf("no schema version found for keyspace %q", client.keyspace)
+	}
+	if err != nil {
+		return "", fmt.Errorf("unable to get min compatible schema version from Cassandra: %w", err)
+	}
+	return version, nil
+}
+
+// CreateSchemaBackfillTable sets up the table tracking the progress of schema backfills
+func (client *cqlClient) CreateSchemaBackfillTable() error {
+	return client.Exec(createSchemaBackfillTableCQL)
+}
+
+// IsSchemaBackfillCompleted returns whether the named schema backfill has completed
+func (client *cqlClient) IsSchemaBackfillCompleted(name string) (bool, error) {
+	query := client.session.Query(readSchemaBackfillCompletedCQL, client.keyspace, name)
+
+	iter := query.Iter()
+	var completed bool
+	iter.Scan(&completed)
+	if err := iter.Close(); err != nil {
+		return false, fmt.Errorf("unable to get schema backfill progress from Cassandra: %w", err)
+	}
+	return completed, nil
+}
+
 // Exec executes a cql statement
 func (client *cqlClient) Exec(stmt string, args ...interface{}) error {
 	if err := client.session.Query(stmt, args...).Exec(); err != nil {
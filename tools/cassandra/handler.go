@@ -91,6 +91,28 @@ func updateSchema(cli *cli.Context, logger log.Logger) error {
 	return nil
 }
 
+// contractSchema runs the contract phase of the applied schema versions
+func contractSchema(cli *cli.Context, logger log.Logger) error {
+	config, err := newCQLClientConfig(cli)
+	if err != nil {
+		logger.Error("Unable to read config.", tag.Error(schema.NewConfigError(err.Error())))
+		return err
+	}
+	logger.Debug("CQL client config", tag.Value(config))
+	client, err := newCQLClient(config, logger)
+	if err != nil {
+		logger.Error("Unable to establish CQL session.", tag.Error(err))
+		return err
+	}
+	defer client.Close()
+	logger.Debug("CQL client", tag.Value(client))
+	if err := schema.Contract(cli, client, logger); err != nil {
+		logger.Error("Unable to contract CQL schema.", tag.Error(err))
+		return err
+	}
+	return nil
+}
+
 func createKeyspace(cli *cli.Context, logger log.Logger) error {
 	config, err := newCQLClientConfig(cli)
 	if err != nil {
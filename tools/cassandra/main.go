@@ -202,6 +202,24 @@ func buildCLIOptions() *cli.App {
 				cliHandler(c, updateSchema, logger)
 			},
 		},
+		{
+			Name:    "contract-schema",
+			Aliases: []string{"contract"},
+			Usage:   "run the contract phase of the applied cassandra schema versions, once their backfills have completed",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagTargetVersion,
+					Usage: "version up to which to contract the schema, defaults to the current version",
+				},
+				cli.StringFlag{
+					Name:  schema.CLIFlagSchemaDir,
+					Usage: "path to directory containing versioned schema",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, contractSchema, logger)
+			},
+		},
 		{
 			Name:    "create-keyspace",
 			Aliases: []string{"create", "create-Keyspace"},
@@ -0,0 +1,186 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"fmt"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// ContractTask represents a task that executes the contract phase
+	// of the schema versions already applied by an UpdateTask. A schema
+	// change is rolled out in three phases: the expand phase adds to the
+	// schema without breaking servers of older versions, the migrate phase
+	// runs the backfills listed by the manifest in the background of the
+	// upgraded servers, and the contract phase removes what only servers of
+	// older versions depend on, after which they are refused to start.
+	ContractTask struct {
+		db     DB
+		config *ContractConfig
+		logger log.Logger
+	}
+)
+
+// newContractSchemaTask returns a new instance of ContractTask
+func newContractSchemaTask(db DB, config *ContractConfig, logger log.Logger) *ContractTask {
+	return &ContractTask{
+		db:     db,
+		config: config,
+		logger: logger,
+	}
+}
+
+// Run executes the task
+func (task *ContractTask) Run() error {
+	config := task.config
+
+	task.logger.Info("ContractSchemaTask started", tag.NewAnyTag("config", config))
+
+	if err := task.db.CreateSchemaBackfillTable(); err != nil {
+		return fmt.Errorf("error creating schema backfill table:%v", err.Error())
+	}
+
+	currVer, err := task.db.ReadSchemaVersion()
+	if err != nil {
+		return fmt.Errorf("error reading current schema version:%v", err.Error())
+	}
+	minVer, err := task.db.ReadSchemaMinCompatibleVersion()
+	if err != nil {
+		return fmt.Errorf("error reading current min compatible schema version:%v", err.Error())
+	}
+	if len(minVer) == 0 {
+		minVer = "0.0"
+	}
+
+	targetVer := currVer
+	if len(config.TargetVersion) > 0 {
+		cmp, err := compareVersions(config.TargetVersion, currVer)
+		if err != nil {
+			return err
+		}
+		if cmp > 0 {
+			return fmt.Errorf("target version %v is greater than current schema version %v, run the update first", config.TargetVersion, currVer)
+		}
+		targetVer = config.TargetVersion
+	}
+
+	contracts, err := task.buildChangeSet(minVer, targetVer)
+	if err != nil {
+		return err
+	}
+
+	for _, cs := range contracts {
+		if err := task.verifyBackfills(cs.manifest); err != nil {
+			return err
+		}
+		if err := task.execStmts(cs.version, cs.cqlStmts); err != nil {
+			return err
+		}
+
+		task.logger.Debug(fmt.Sprintf("updating min compatible schema version to %v", cs.version))
+		if err := task.db.UpdateSchemaVersion(currVer, cs.version); err != nil {
+			return fmt.Errorf("failed to update schema_version table, err=%v", err.Error())
+		}
+		if err := task.db.WriteSchemaUpdateLog(currVer, currVer, cs.manifest.md5, "contract "+cs.version+": "+cs.manifest.Description); err != nil {
+			return fmt.Errorf("failed to add entry to schema_update_history, err=%v", err.Error())
+		}
+		task.logger.Info(fmt.Sprintf("Schema contracted to min compatible version %v", cs.version))
+	}
+
+	task.logger.Info("ContractSchemaTask done")
+
+	return nil
+}
+
+// buildChangeSet returns the contract phases of the versions in the range minVer < ver <= targetVer
+func (task *ContractTask) buildChangeSet(minVer string, targetVer string) ([]changeSet, error) {
+	verDirs, err := readSchemaDir(task.config.SchemaDir, minVer, "", task.logger)
+	if err != nil {
+		return nil, fmt.Errorf("error listing schema dir:%v", err.Error())
+	}
+
+	var result []changeSet
+	for _, vd := range verDirs {
+		cmp, err := compareVersions(dirToVersion(vd), targetVer)
+		if err != nil {
+			return nil, err
+		}
+		if cmp > 0 {
+			break
+		}
+
+		dirPath := task.config.SchemaDir + "/" + vd
+		m, err := readManifest(dirPath)
+		if err != nil {
+			return nil, fmt.Errorf("error processing manifest for version %v:%v", vd, err.Error())
+		}
+		if len(m.SchemaContractCqlFiles) == 0 {
+			continue
+		}
+
+		stmts, err := parseSQLFiles(dirPath, m.SchemaContractCqlFiles, task.logger)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateCQLStmts(stmts); err != nil {
+			return nil, fmt.Errorf("error processing version %v:%v", vd, err.Error())
+		}
+
+		result = append(result, changeSet{
+			version:  m.CurrVersion,
+			manifest: m,
+			cqlStmts: stmts,
+		})
+	}
+	return result, nil
+}
+
+func (task *ContractTask) verifyBackfills(m *manifest) error {
+	for _, name := range m.Backfills {
+		completed, err := task.db.IsSchemaBackfillCompleted(name)
+		if err != nil {
+			return fmt.Errorf("error reading progress of backfill %v:%v", name, err.Error())
+		}
+		if !completed {
+			return fmt.Errorf("contract of version %v requires backfill %v to complete first", m.CurrVersion, name)
+		}
+	}
+	return nil
+}
+
+func (task *ContractTask) execStmts(ver string, stmts []string) error {
+	task.logger.Debug(fmt.Sprintf("---- Executing contract for version %v ----", ver))
+	for _, stmt := range stmts {
+		task.logger.Debug(rmspaceRegex.ReplaceAllString(stmt, " "))
+		if err := task.db.Exec(stmt); err != nil {
+			return fmt.Errorf("error executing statement:%v", err)
+		}
+	}
+	task.logger.Debug("---- Done ----")
+	return nil
+}
@@ -0,0 +1,177 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap/zaptest"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/tests/testutils"
+)
+
+type (
+	ContractTaskTestSuite struct {
+		*require.Assertions
+		suite.Suite
+		versionsDir string
+		logger      log.Logger
+		db          *contractTestDB
+	}
+
+	contractTestDB struct {
+		currVersion          string
+		minCompatibleVersion string
+		completedBackfills   map[string]bool
+		stmts                []string
+	}
+)
+
+func TestContractTaskTestSuite(t *testing.T) {
+	suite.Run(t, new(ContractTaskTestSuite))
+}
+
+func (s *ContractTaskTestSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.logger = log.NewZapLogger(zaptest.NewLogger(s.T()))
+	s.versionsDir = testutils.MkdirTemp(s.T(), "", "contract_schema_test")
+	s.db = &contractTestDB{
+		currVersion:          "1.2",
+		minCompatibleVersion: "1.0",
+		completedBackfills:   make(map[string]bool),
+	}
+
+	s.writeVersion("v1.1", `{
+		"CurrVersion": "1.1",
+		"MinCompatibleVersion": "1.0",
+		"Description": "add new column",
+		"SchemaUpdateCqlFiles": ["expand.cql"],
+		"Backfills": ["fill_new_column"],
+		"SchemaContractCqlFiles": ["contract.cql"]
+	}`)
+	s.writeVersion("v1.2", `{
+		"CurrVersion": "1.2",
+		"MinCompatibleVersion": "1.0",
+		"Description": "add new table",
+		"SchemaUpdateCqlFiles": ["expand.cql"]
+	}`)
+	s.writeVersion("v1.3", `{
+		"CurrVersion": "1.3",
+		"MinCompatibleVersion": "1.0",
+		"Description": "not yet applied",
+		"SchemaUpdateCqlFiles": ["expand.cql"],
+		"SchemaContractCqlFiles": ["contract.cql"]
+	}`)
+}
+
+func (s *ContractTaskTestSuite) writeVersion(dir string, manifest string) {
+	path := s.versionsDir + "/" + dir
+	s.NoError(os.Mkdir(path, os.FileMode(0755)))
+	s.NoError(os.WriteFile(path+"/"+manifestFileName, []byte(manifest), os.FileMode(0644)))
+	s.NoError(os.WriteFile(path+"/expand.cql", []byte("CREATE TABLE "+dir+"_expand (id int);"), os.FileMode(0644)))
+	s.NoError(os.WriteFile(path+"/contract.cql", []byte("DROP TABLE "+dir+"_contract;"), os.FileMode(0644)))
+}
+
+func (s *ContractTaskTestSuite) runContract(targetVersion string) error {
+	config := &ContractConfig{SchemaDir: s.versionsDir, TargetVersion: targetVersion}
+	return newContractSchemaTask(s.db, config, s.logger).Run()
+}
+
+func (s *ContractTaskTestSuite) TestContract_WaitsForBackfills() {
+	err := s.runContract("")
+	s.Error(err)
+	s.Contains(err.Error(), "fill_new_column")
+	s.Empty(s.db.stmts)
+	s.Equal("1.0", s.db.minCompatibleVersion)
+
+	s.db.completedBackfills["fill_new_column"] = true
+	s.NoError(s.runContract(""))
+	s.Equal([]string{"DROP TABLE v1.1_contract;"}, s.db.stmts)
+	s.Equal("1.2", s.db.currVersion)
+	s.Equal("1.1", s.db.minCompatibleVersion)
+
+	// contracting again is a noop
+	s.NoError(s.runContract(""))
+	s.Len(s.db.stmts, 1)
+}
+
+func (s *ContractTaskTestSuite) TestContract_TargetVersion() {
+	s.db.completedBackfills["fill_new_column"] = true
+
+	err := s.runContract("1.3")
+	s.Error(err)
+	s.Empty(s.db.stmts)
+
+	s.NoError(s.runContract("1.0"))
+	s.Empty(s.db.stmts)
+
+	s.NoError(s.runContract("1.1"))
+	s.Equal("1.1", s.db.minCompatibleVersion)
+}
+
+func (db *contractTestDB) Exec(stmt string, _ ...interface{}) error {
+	db.stmts = append(db.stmts, stmt)
+	return nil
+}
+
+func (db *contractTestDB) DropAllTables() error {
+	return nil
+}
+
+func (db *contractTestDB) CreateSchemaVersionTables() error {
+	return nil
+}
+
+func (db *contractTestDB) ReadSchemaVersion() (string, error) {
+	return db.currVersion, nil
+}
+
+func (db *contractTestDB) UpdateSchemaVersion(newVersion string, minCompatibleVersion string) error {
+	db.currVersion = newVersion
+	db.minCompatibleVersion = minCompatibleVersion
+	return nil
+}
+
+func (db *contractTestDB) WriteSchemaUpdateLog(string, string, string, string) error {
+	return nil
+}
+
+func (db *contractTestDB) ReadSchemaMinCompatibleVersion() (string, error) {
+	return db.minCompatibleVersion, nil
+}
+
+func (db *contractTestDB) CreateSchemaBackfillTable() error {
+	return nil
+}
+
+func (db *contractTestDB) IsSchemaBackfillCompleted(name string) (bool, error) {
+	return db.completedBackfills[name], nil
+}
+
+func (db *contractTestDB) Close() {}
@@ -48,6 +48,15 @@ func Update(cli *cli.Context, db DB, logger log.Logger) error {
 	return newUpdateSchemaTask(db, cfg, logger).Run()
 }
 
+// Contract runs the contract phase of the applied schema versions for the specified database
+func Contract(cli *cli.Context, db DB, logger log.Logger) error {
+	cfg, err := newContractConfig(cli)
+	if err != nil {
+		return err
+	}
+	return newContractSchemaTask(db, cfg, logger).Run()
+}
+
 func newUpdateConfig(cli *cli.Context) (*UpdateConfig, error) {
 	config := new(UpdateConfig)
 	config.SchemaDir = cli.String(CLIOptSchemaDir)
@@ -59,6 +68,17 @@ func newUpdateConfig(cli *cli.Context) (*UpdateConfig, error) {
 	return config, nil
 }
 
+func newContractConfig(cli *cli.Context) (*ContractConfig, error) {
+	config := new(ContractConfig)
+	config.SchemaDir = cli.String(CLIOptSchemaDir)
+	config.TargetVersion = cli.String(CLIOptTargetVersion)
+
+	if err := validateContractConfig(config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
 func newSetupConfig(cli *cli.Context) (*SetupConfig, error) {
 	config := new(SetupConfig)
 	config.SchemaFilePath = cli.String(CLIOptSchemaFile)
@@ -105,6 +125,20 @@ func validateUpdateConfig(config *UpdateConfig) error {
 	return nil
 }
 
+func validateContractConfig(config *ContractConfig) error {
+	if len(config.SchemaDir) == 0 {
+		return NewConfigError("missing " + flag(CLIOptSchemaDir) + " argument ")
+	}
+	if len(config.TargetVersion) > 0 {
+		ver, err := normalizeVersionString(config.TargetVersion)
+		if err != nil {
+			return NewConfigError("invalid " + flag(CLIOptTargetVersion) + " argument:" + err.Error())
+		}
+		config.TargetVersion = ver
+	}
+	return nil
+}
+
 func flag(opt string) string {
 	return "(-" + opt + ")"
 }
@@ -69,6 +69,9 @@ func (task *SetupTask) Run() error {
 		if err := task.db.CreateSchemaVersionTables(); err != nil {
 			return err
 		}
+		if err := task.db.CreateSchemaBackfillTable(); err != nil {
+			return err
+		}
 	}
 
 	if len(config.SchemaFilePath) > 0 {
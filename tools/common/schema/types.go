@@ -43,6 +43,12 @@ type (
 		SchemaDir     string
 		IsDryRun      bool
 	}
+	// ContractConfig holds the config
+	// params for executing a ContractTask
+	ContractConfig struct {
+		TargetVersion string
+		SchemaDir     string
+	}
 	// SetupConfig holds the config
 	// params need by the SetupTask
 	SetupConfig struct {
@@ -67,6 +73,12 @@ type (
 		UpdateSchemaVersion(newVersion string, minCompatibleVersion string) error
 		// WriteSchemaUpdateLog adds an entry to the schema update history table
 		WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error
+		// ReadSchemaMinCompatibleVersion returns the min compatible schema version for the keyspace
+		ReadSchemaMinCompatibleVersion() (string, error)
+		// CreateSchemaBackfillTable sets up the table tracking the progress of schema backfills
+		CreateSchemaBackfillTable() error
+		// IsSchemaBackfillCompleted returns whether the named schema backfill has completed
+		IsSchemaBackfillCompleted(name string) (bool, error)
 		// Close gracefully closes the client object
 		Close()
 	}
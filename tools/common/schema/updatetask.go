@@ -61,7 +61,14 @@ type (
 		MinCompatibleVersion string
 		Description          string
 		SchemaUpdateCqlFiles []string
-		md5                  string
+		// Backfills lists the backfills which must have completed
+		// before SchemaContractCqlFiles can be applied
+		Backfills []string
+		// SchemaContractCqlFiles remove what servers older than CurrVersion
+		// depend on, they are applied by the ContractTask which then raises
+		// the min compatible version of the schema to CurrVersion
+		SchemaContractCqlFiles []string
+		md5                    string
 	}
 
 	// changeSet represents all the changes
@@ -103,6 +110,10 @@ func (task *UpdateTask) Run() error {
 		}
 	}
 
+	if err := task.db.CreateSchemaBackfillTable(); err != nil {
+		return fmt.Errorf("error creating schema backfill table:%v", err.Error())
+	}
+
 	currVer, err := task.db.ReadSchemaVersion()
 	if err != nil {
 		return fmt.Errorf("error reading current schema version:%v", err.Error())
@@ -163,7 +174,11 @@ func (task *UpdateTask) execStmts(ver string, stmts []string) error {
 
 func (task *UpdateTask) updateSchemaVersion(oldVer string, cs *changeSet) error {
 	task.logger.Debug(fmt.Sprintf("updating schema version to %v", cs.version))
-	err := task.db.UpdateSchemaVersion(cs.version, cs.manifest.MinCompatibleVersion)
+	minCompatibleVersion, err := task.minCompatibleVersion(cs.manifest.MinCompatibleVersion)
+	if err != nil {
+		return err
+	}
+	err = task.db.UpdateSchemaVersion(cs.version, minCompatibleVersion)
 	if err != nil {
 		return fmt.Errorf("failed to update schema_version table, err=%v", err.Error())
 	}
@@ -184,6 +199,26 @@ func (task *UpdateTask) updateSchemaVersion(oldVer string, cs *changeSet) error
 	return nil
 }
 
+// minCompatibleVersion returns the min compatible version to record for a
+// new schema version, which never goes below the one raised by a contract
+func (task *UpdateTask) minCompatibleVersion(manifestMinVer string) (string, error) {
+	currMinVer, err := task.db.ReadSchemaMinCompatibleVersion()
+	if err != nil {
+		return "", fmt.Errorf("error reading current min compatible schema version: %v", err.Error())
+	}
+	if len(currMinVer) == 0 {
+		return manifestMinVer, nil
+	}
+	cmp, err := compareVersions(currMinVer, manifestMinVer)
+	if err != nil {
+		return "", err
+	}
+	if cmp > 0 {
+		return currMinVer, nil
+	}
+	return manifestMinVer, nil
+}
+
 func (task *UpdateTask) buildChangeSet(currVer string) ([]changeSet, error) {
 
 	config := task.config
@@ -234,12 +269,16 @@ func (task *UpdateTask) buildChangeSet(currVer string) ([]changeSet, error) {
 }
 
 func (task *UpdateTask) parseSQLStmts(dir string, manifest *manifest) ([]string, error) {
+	return parseSQLFiles(dir, manifest.SchemaUpdateCqlFiles, task.logger)
+}
+
+func parseSQLFiles(dir string, files []string, logger log.Logger) ([]string, error) {
 
 	result := make([]string, 0, 4)
 
-	for _, file := range manifest.SchemaUpdateCqlFiles {
+	for _, file := range files {
 		path := dir + "/" + file
-		task.logger.Info("Processing schema file: " + path)
+		logger.Info("Processing schema file: " + path)
 		stmts, err := persistence.LoadAndSplitQuery([]string{path})
 		if err != nil {
 			return nil, fmt.Errorf("error parsing file %v, err=%v", path, err)
@@ -432,6 +471,20 @@ func (task *UpdateTask) setupDryRunDatabase() error {
 	return setupTask.Run()
 }
 
+// compareVersions returns -1, 0 or 1 depending on whether version a
+// is lower than, equal to or greater than version b
+func compareVersions(a string, b string) (int, error) {
+	verA, err := semver.ParseTolerant(a)
+	if err != nil {
+		return 0, err
+	}
+	verB, err := semver.ParseTolerant(b)
+	if err != nil {
+		return 0, err
+	}
+	return verA.Compare(verB), nil
+}
+
 func dirToVersion(dir string) string {
 	return dir[1:]
 }
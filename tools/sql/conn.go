@@ -25,6 +25,8 @@
 package sql
 
 import (
+	gosql "database/sql"
+
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
@@ -75,6 +77,25 @@ func (c *Connection) WriteSchemaUpdateLog(oldVersion string, newVersion string,
 	return c.adminDb.WriteSchemaUpdateLog(oldVersion, newVersion, manifestMD5, desc)
 }
 
+// ReadSchemaMinCompatibleVersion returns the min compatible schema version for the keyspace
+func (c *Connection) ReadSchemaMinCompatibleVersion() (string, error) {
+	return c.adminDb.ReadSchemaMinCompatibleVersion(c.dbName)
+}
+
+// CreateSchemaBackfillTable sets up the table tracking the progress of schema backfills
+func (c *Connection) CreateSchemaBackfillTable() error {
+	return c.adminDb.CreateSchemaBackfillTable()
+}
+
+// IsSchemaBackfillCompleted returns whether the named schema backfill has completed
+func (c *Connection) IsSchemaBackfillCompleted(name string) (bool, error) {
+	_, completed, err := c.adminDb.ReadSchemaBackfill(c.dbName, name)
+	if err == gosql.ErrNoRows {
+		return false, nil
+	}
+	return completed, err
+}
+
 // Exec executes a sql statement
 func (c *Connection) Exec(stmt string, args ...interface{}) error {
 	return c.adminDb.Exec(stmt, args...)
@@ -81,6 +81,26 @@ func updateSchema(cli *cli.Context, logger log.Logger) error {
 	return nil
 }
 
+// contractSchema runs the contract phase of the applied schema versions
+func contractSchema(cli *cli.Context, logger log.Logger) error {
+	cfg, err := parseConnectConfig(cli)
+	if err != nil {
+		logger.Error("Unable to read config.", tag.Error(schema.NewConfigError(err.Error())))
+		return err
+	}
+	conn, err := NewConnection(cfg)
+	if err != nil {
+		logger.Error("Unable to connect to SQL database.", tag.Error(err))
+		return err
+	}
+	defer conn.Close()
+	if err := schema.Contract(cli, conn, logger); err != nil {
+		logger.Error("Unable to contract SQL schema.", tag.Error(err))
+		return err
+	}
+	return nil
+}
+
 // createDatabase creates a sql database
 func createDatabase(cli *cli.Context, logger log.Logger) error {
 	cfg, err := parseConnectConfig(cli)
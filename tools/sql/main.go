@@ -185,6 +185,24 @@ func BuildCLIOptions() *cli.App {
 				cliHandler(c, updateSchema, logger)
 			},
 		},
+		{
+			Name:    "contract-schema",
+			Aliases: []string{"contract"},
+			Usage:   "run the contract phase of the applied sql schema versions, once their backfills have completed",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagTargetVersion,
+					Usage: "version up to which to contract the schema, defaults to the current version",
+				},
+				cli.StringFlag{
+					Name:  schema.CLIFlagSchemaDir,
+					Usage: "path to directory containing versioned schema",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, contractSchema, logger)
+			},
+		},
 		{
 			Name:    "create-database",
 			Aliases: []string{"create"},
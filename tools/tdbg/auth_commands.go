@@ -0,0 +1,260 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"go.temporal.io/server/api/adminservice/v1"
+)
+
+// roleBits maps role names accepted by tdbg to the bits of the role bitmasks of the admin API
+var roleBits = map[string]int32{
+	"worker": 1,
+	"reader": 2,
+	"writer": 4,
+	"admin":  8,
+}
+
+// AdminCreateAPIKey creates an API key and prints its token
+func AdminCreateAPIKey(c *cli.Context) error {
+	systemRole, err := parseRole(c.String(FlagSystemRole))
+	if err != nil {
+		return err
+	}
+	namespaceRoles, err := parseNamespaceRoles(c.StringSlice(FlagNamespaceRole))
+	if err != nil {
+		return err
+	}
+
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := client.CreateAPIKey(ctx, &adminservice.CreateAPIKeyRequest{
+		Name:           c.String(FlagName),
+		SystemRole:     systemRole,
+		NamespaceRoles: namespaceRoles,
+		Rps:            c.Float64(FlagRPS),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create API key: %v", err)
+	}
+	prettyPrintJSONObject(resp.GetApiKey())
+	fmt.Printf("Token (it cannot be retrieved later): %s\n", resp.GetToken())
+	return nil
+}
+
+// AdminRotateAPIKey replaces the secret of an API key and prints its new token
+func AdminRotateAPIKey(c *cli.Context) error {
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := client.RotateAPIKey(ctx, &adminservice.RotateAPIKeyRequest{
+		Id: c.String(FlagAPIKeyID),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to rotate API key: %v", err)
+	}
+	fmt.Printf("Token (it cannot be retrieved later): %s\n", resp.GetToken())
+	return nil
+}
+
+// AdminRevokeAPIKey deletes an API key
+func AdminRevokeAPIKey(c *cli.Context) error {
+	id := c.String(FlagAPIKeyID)
+	prompt(fmt.Sprintf("Revoke API key %s[Yes/No]?", id), c.Bool(FlagYes))
+
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err := client.RevokeAPIKey(ctx, &adminservice.RevokeAPIKeyRequest{
+		Id: id,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to revoke API key: %v", err)
+	}
+	fmt.Println("API key has been revoked successfully.")
+	return nil
+}
+
+// AdminListAPIKeys lists all API keys
+func AdminListAPIKeys(c *cli.Context) error {
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := client.ListAPIKeys(ctx, &adminservice.ListAPIKeysRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to list API keys: %v", err)
+	}
+	prettyPrintJSONObject(resp.GetApiKeys())
+	return nil
+}
+
+// parseRole parses a comma separated list of role names into a role bitmask
+func parseRole(value string) (int32, error) {
+	var role int32
+	if value == "" {
+		return role, nil
+	}
+	for _, name := range strings.Split(value, ",") {
+		bit, ok := roleBits[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return 0, fmt.Errorf("invalid role %q, expected worker, reader, writer or admin", name)
+		}
+		role |= bit
+	}
+	return role, nil
+}
+
+// parseNamespaceRoles parses namespace=roles values into role bitmasks by namespace
+func parseNamespaceRoles(values []string) (map[string]int32, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	namespaceRoles := make(map[string]int32, len(values))
+	for _, value := range values {
+		ns, roles, ok := strings.Cut(value, "=")
+		if !ok || ns == "" {
+			return nil, fmt.Errorf("invalid namespace role %q, expected namespace=roles", value)
+		}
+		role, err := parseRole(roles)
+		if err != nil {
+			return nil, err
+		}
+		namespaceRoles[ns] = role
+	}
+	return namespaceRoles, nil
+}
+
+// AdminPutRole creates or replaces a named role
+func AdminPutRole(c *cli.Context) error {
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err := client.PutRole(ctx, &adminservice.PutRoleRequest{
+		Role: &adminservice.NamedRole{
+			Name:        c.String(FlagName),
+			Permissions: c.StringSlice(FlagPermission),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to put role: %v", err)
+	}
+	fmt.Println("Role has been put successfully.")
+	return nil
+}
+
+// AdminDeleteRole deletes a named role
+func AdminDeleteRole(c *cli.Context) error {
+	name := c.String(FlagName)
+	prompt(fmt.Sprintf("Delete role %s[Yes/No]?", name), c.Bool(FlagYes))
+
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err := client.DeleteRole(ctx, &adminservice.DeleteRoleRequest{
+		Name: name,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to delete role: %v", err)
+	}
+	fmt.Println("Role has been deleted successfully.")
+	return nil
+}
+
+// AdminListRoles lists all named roles
+func AdminListRoles(c *cli.Context) error {
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := client.ListRoles(ctx, &adminservice.ListRolesRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to list roles: %v", err)
+	}
+	prettyPrintJSONObject(resp.GetRoles())
+	return nil
+}
+
+// AdminSetGroupRoles assigns named roles to an identity group in a namespace, or cluster-wide
+func AdminSetGroupRoles(c *cli.Context) error {
+	nsName, err := getGroupRolesNamespace(c)
+	if err != nil {
+		return err
+	}
+
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = client.SetGroupRoles(ctx, &adminservice.SetGroupRolesRequest{
+		Namespace: nsName,
+		Group:     c.String(FlagGroup),
+		Roles:     c.StringSlice(FlagNamedRole),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to set group roles: %v", err)
+	}
+	fmt.Println("Group roles have been set successfully.")
+	return nil
+}
+
+// AdminGetGroupRoles prints the named roles assigned to each identity group in a namespace, or cluster-wide
+func AdminGetGroupRoles(c *cli.Context) error {
+	nsName, err := getGroupRolesNamespace(c)
+	if err != nil {
+		return err
+	}
+
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := client.GetGroupRoles(ctx, &adminservice.GetGroupRolesRequest{
+		Namespace: nsName,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to get group roles: %v", err)
+	}
+	prettyPrintJSONObject(resp.GetGroups())
+	return nil
+}
+
+// getGroupRolesNamespace returns the namespace of the group roles, empty for the roles assigned cluster-wide
+func getGroupRolesNamespace(c *cli.Context) (string, error) {
+	if c.Bool(FlagClusterWide) {
+		return "", nil
+	}
+	return getRequiredOption(c, FlagNamespace)
+}
@@ -0,0 +1,341 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+// AdminBackupDatabase writes an online backup of the persistence store to a file
+func AdminBackupDatabase(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	// The backup is streamed for as long as the database takes to copy
+	ctx, cancel := newContextWithTimeout(c, time.Hour)
+	defer cancel()
+
+	stream, err := adminClient.StreamDatabaseBackup(ctx, &adminservice.StreamDatabaseBackupRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to back up database: %s", err)
+	}
+	f, err := os.Create(c.String(FlagOutputFilename))
+	if err != nil {
+		return fmt.Errorf("unable to create backup file: %s", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("unable to back up database: %s", err)
+		}
+		if _, err := f.Write(resp.GetData()); err != nil {
+			return fmt.Errorf("unable to write backup file: %s", err)
+		}
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("unable to write backup file: %s", err)
+	}
+	fmt.Println("Database backed up.")
+	return nil
+}
+
+// AdminDiagnosticsBundle writes the diagnostics bundle of the frontend host serving the request to a file
+func AdminDiagnosticsBundle(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	stream, err := adminClient.StreamDiagnosticsBundle(ctx, &adminservice.StreamDiagnosticsBundleRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to collect diagnostics bundle: %s", err)
+	}
+	f, err := os.Create(c.String(FlagOutputFilename))
+	if err != nil {
+		return fmt.Errorf("unable to create diagnostics bundle file: %s", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("unable to collect diagnostics bundle: %s", err)
+		}
+		if _, err := f.Write(resp.GetData()); err != nil {
+			return fmt.Errorf("unable to write diagnostics bundle file: %s", err)
+		}
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("unable to write diagnostics bundle file: %s", err)
+	}
+	fmt.Println("Diagnostics bundle collected.")
+	return nil
+}
+
+// AdminDescribePersistenceCircuitBreakers displays the state of the persistence circuit breakers
+func AdminDescribePersistenceCircuitBreakers(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribePersistenceCircuitBreakers(ctx, &adminservice.DescribePersistenceCircuitBreakersRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to describe persistence circuit breakers: %s", err)
+	}
+	prettyPrintJSONObject(resp.GetStates())
+	return nil
+}
+
+// AdminGetComponentHealth describes the health of the components the cluster depends on
+func AdminGetComponentHealth(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.GetComponentHealth(ctx, &adminservice.GetComponentHealthRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to get component health: %s", err)
+	}
+	prettyPrintJSONObject(resp)
+	return nil
+}
+
+// AdminListMetrics lists the metrics the server can emit
+func AdminListMetrics(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ListMetrics(ctx, &adminservice.ListMetricsRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to list metrics: %s", err)
+	}
+	prettyPrintJSONObject(resp.GetMetrics())
+	return nil
+}
+
+// AdminGetSDKUsage lists the requests counted by the frontend host by API method, SDK and namespace
+func AdminGetSDKUsage(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	request := &adminservice.GetSDKUsageRequest{}
+	if c.IsSet(FlagNamespace) {
+		request.Namespace = c.String(FlagNamespace)
+	}
+	resp, err := adminClient.GetSDKUsage(ctx, request)
+	if err != nil {
+		return fmt.Errorf("unable to get SDK usage: %s", err)
+	}
+	prettyPrintJSONObject(resp)
+	return nil
+}
+
+// AdminCreateClusterSnapshot takes a cluster snapshot
+func AdminCreateClusterSnapshot(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	// Every shard of the cluster is read
+	ctx, cancel := newContextWithTimeout(c, time.Hour)
+	defer cancel()
+
+	resp, err := adminClient.CreateClusterSnapshot(ctx, &adminservice.CreateClusterSnapshotRequest{
+		StoreUri:   c.String(FlagStoreURI),
+		SnapshotId: c.String(FlagSnapshotID),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create cluster snapshot: %s", err)
+	}
+	prettyPrintJSONObject(resp.GetManifest())
+	return nil
+}
+
+// AdminRestoreClusterSnapshot restores a cluster snapshot
+func AdminRestoreClusterSnapshot(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	snapshotID := c.String(FlagSnapshotID)
+	prompt(fmt.Sprintf("Restore snapshot %s into this cluster[Yes/No]?", snapshotID), c.Bool(FlagYes))
+
+	// Every shard of the cluster is written
+	ctx, cancel := newContextWithTimeout(c, time.Hour)
+	defer cancel()
+
+	resp, err := adminClient.RestoreClusterSnapshot(ctx, &adminservice.RestoreClusterSnapshotRequest{
+		StoreUri:   c.String(FlagStoreURI),
+		SnapshotId: snapshotID,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to restore cluster snapshot: %s", err)
+	}
+	prettyPrintJSONObject(resp.GetManifest())
+	return nil
+}
+
+// AdminCheckVisibilityConsistency cross-checks executions and visibility records
+func AdminCheckVisibilityConsistency(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	var shardIDs []int32
+	for _, shardID := range c.IntSlice(FlagShardID) {
+		shardIDs = append(shardIDs, int32(shardID))
+	}
+	request := &adminservice.CheckVisibilityConsistencyRequest{
+		ShardIds:   shardIDs,
+		Namespaces: c.StringSlice(FlagNamespaces),
+		Repair:     c.Bool(FlagRepair),
+	}
+	if c.IsSet(FlagGracePeriod) {
+		request.GracePeriod = timestamp.DurationPtr(c.Duration(FlagGracePeriod))
+	}
+
+	// Every execution or record of the requested shards and namespaces is read
+	ctx, cancel := newContextWithTimeout(c, time.Hour)
+	defer cancel()
+
+	resp, err := adminClient.CheckVisibilityConsistency(ctx, request)
+	if err != nil {
+		return fmt.Errorf("unable to check visibility consistency: %s", err)
+	}
+	prettyPrintJSONObject(resp)
+	return nil
+}
+
+// AdminListTopPersistenceNamespaces lists the namespaces making the most persistence requests
+func AdminListTopPersistenceNamespaces(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ListTopPersistenceNamespaces(ctx, &adminservice.ListTopPersistenceNamespacesRequest{
+		Count:  int32(c.Int(FlagCount)),
+		Window: timestamp.DurationPtr(c.Duration(FlagWindow)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list top persistence namespaces: %s", err)
+	}
+	prettyPrintJSONObject(resp.GetNamespaces())
+	return nil
+}
+
+// AdminDescribeVisibilityIngestion describes how far visibility ingestion is behind on each history host
+func AdminDescribeVisibilityIngestion(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	var shardIDs []int32
+	for _, shardID := range c.IntSlice(FlagShardID) {
+		shardIDs = append(shardIDs, int32(shardID))
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeVisibilityIngestion(ctx, &adminservice.DescribeVisibilityIngestionRequest{
+		ShardIds: shardIDs,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe visibility ingestion: %s", err)
+	}
+	prettyPrintJSONObject(resp.GetHosts())
+	return nil
+}
+
+// historyScannerDryRunResult is the part of the result of a history scanner dry run which lists the garbage
+// branches it found.
+type historyScannerDryRunResult struct {
+	Candidates []struct {
+		ShardID     int32
+		NamespaceID string
+		WorkflowID  string
+		RunID       string
+		BranchToken []byte
+		Reason      string
+		SizeBytes   int64
+	}
+}
+
+// AdminDeleteHistoryBranchGarbage deletes the garbage history branches reported by a history scanner dry run
+func AdminDeleteHistoryBranchGarbage(c *cli.Context) error {
+	data, err := os.ReadFile(c.String(FlagInputFilename))
+	if err != nil {
+		return fmt.Errorf("unable to read dry run result: %s", err)
+	}
+	var result historyScannerDryRunResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return fmt.Errorf("unable to parse dry run result: %s", err)
+	}
+	if len(result.Candidates) == 0 {
+		return errors.New("the dry run result lists no garbage history branches")
+	}
+
+	request := &adminservice.DeleteHistoryBranchGarbageRequest{}
+	for _, candidate := range result.Candidates {
+		request.Candidates = append(request.Candidates, &adminservice.HistoryBranchCandidate{
+			ShardId:     candidate.ShardID,
+			NamespaceId: candidate.NamespaceID,
+			WorkflowId:  candidate.WorkflowID,
+			RunId:       candidate.RunID,
+			BranchToken: candidate.BranchToken,
+			Reason:      candidate.Reason,
+			SizeBytes:   candidate.SizeBytes,
+		})
+	}
+	prompt(fmt.Sprintf("Delete %d history branches[Yes/No]?", len(request.Candidates)), c.Bool(FlagYes))
+
+	adminClient := cFactory.AdminClient(c)
+	// Deletions are rate limited by the frontend
+	ctx, cancel := newContextWithTimeout(c, time.Hour)
+	defer cancel()
+
+	resp, err := adminClient.DeleteHistoryBranchGarbage(ctx, request)
+	if err != nil {
+		return fmt.Errorf("unable to delete history branch garbage: %s", err)
+	}
+	prettyPrintJSONObject(resp)
+	return nil
+}
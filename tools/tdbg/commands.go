@@ -25,15 +25,18 @@
 package tdbg
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/temporalio/tctl-kit/pkg/color"
 	"github.com/urfave/cli/v2"
 	commonpb "go.temporal.io/api/common/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/sdk/converter"
 
 	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
@@ -127,6 +130,27 @@ func AdminShowWorkflow(c *cli.Context) error {
 	return nil
 }
 
+// AdminImportWorkflow imports a part of the history of a run exported from another cluster
+func AdminImportWorkflow(c *cli.Context) error {
+	data, err := os.ReadFile(c.String(FlagInputFilename))
+	if err != nil {
+		return fmt.Errorf("unable to read import file: %s", err)
+	}
+	request := &adminservice.ImportWorkflowExecutionRequest{}
+	if err := codec.NewJSONPBEncoder().Decode(data, request); err != nil {
+		return fmt.Errorf("unable to decode import file: %s", err)
+	}
+
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	if _, err := client.ImportWorkflowExecution(ctx, request); err != nil {
+		return fmt.Errorf("unable to import workflow execution: %s", err)
+	}
+	return nil
+}
+
 // AdminDescribeWorkflow describe a new workflow execution for admin
 func AdminDescribeWorkflow(c *cli.Context) error {
 	resp, err := describeMutableState(c)
@@ -241,6 +265,138 @@ func AdminDeleteWorkflow(c *cli.Context) error {
 	return nil
 }
 
+// AdminUpdateWorkflowMemo upserts memo fields of a workflow execution
+func AdminUpdateWorkflowMemo(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid := c.String(FlagRunID)
+
+	fields, err := parseJSONPayloadFields(c.StringSlice(FlagMemo), "memo field")
+	if err != nil {
+		return err
+	}
+	memo := &commonpb.Memo{Fields: fields}
+	hostname, _ := os.Hostname()
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.UpdateWorkflowExecutionMemo(ctx, &adminservice.UpdateWorkflowExecutionMemoRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		Memo:     memo,
+		Identity: "tdbg@" + hostname,
+		Reason:   c.String(FlagReason),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update workflow memo: %s", err)
+	}
+	prettyPrintJSONObject(resp.GetMemo())
+	return nil
+}
+
+// AdminUpsertWorkflowSearchAttributes upserts search attributes of a workflow execution
+func AdminUpsertWorkflowSearchAttributes(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid := c.String(FlagRunID)
+
+	fields, err := parseJSONPayloadFields(c.StringSlice(FlagSearchAttribute), "search attribute")
+	if err != nil {
+		return err
+	}
+	hostname, _ := os.Hostname()
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.UpsertWorkflowExecutionSearchAttributes(ctx, &adminservice.UpsertWorkflowExecutionSearchAttributesRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		SearchAttributes: &commonpb.SearchAttributes{IndexedFields: fields},
+		Identity:         "tdbg@" + hostname,
+		Reason:           c.String(FlagReason),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to upsert workflow search attributes: %s", err)
+	}
+	fmt.Println("Workflow search attributes updated.")
+	return nil
+}
+
+// parseJSONPayloadFields parses key=json-value pairs into JSON payloads. A pair with an empty value
+// maps to an empty payload, which removes the field on upsert.
+func parseJSONPayloadFields(pairs []string, kind string) (map[string]*commonpb.Payload, error) {
+	fields := make(map[string]*commonpb.Payload, len(pairs))
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid %s %q, expected key=json-value", kind, pair)
+		}
+		if value == "" {
+			fields[key] = &commonpb.Payload{}
+			continue
+		}
+		if !json.Valid([]byte(value)) {
+			return nil, fmt.Errorf("%s %q is not valid JSON", kind, key)
+		}
+		fields[key] = &commonpb.Payload{
+			Metadata: map[string][]byte{converter.MetadataEncoding: []byte(converter.MetadataEncodingJSON)},
+			Data:     []byte(value),
+		}
+	}
+	return fields, nil
+}
+
+// AdminRehydrateWorkflow imports the archived history of a closed workflow execution
+func AdminRehydrateWorkflow(c *cli.Context) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContextWithTimeout(c, time.Hour)
+	defer cancel()
+
+	resp, err := adminClient.RehydrateWorkflowExecution(ctx, &adminservice.RehydrateWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: c.String(FlagWorkflowID),
+			RunId:      c.String(FlagRunID),
+		},
+		NewRunId: c.String(FlagNewRunID),
+		Uri:      c.String(FlagArchivalURI),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to rehydrate workflow: %s", err)
+	}
+	fmt.Printf("Workflow has been rehydrated with run ID %s.\n", resp.GetRunId())
+	return nil
+}
+
 // AdminGetShardID get shardID
 func AdminGetShardID(c *cli.Context) error {
 	namespaceID := c.String(FlagNamespaceID)
@@ -362,22 +518,6 @@ func AdminRemoveTask(c *cli.Context) error {
 	return nil
 }
 
-// AdminDescribeShard describes shard by shard id
-func AdminDescribeShard(c *cli.Context) error {
-	sid := c.Int(FlagShardID)
-	adminClient := cFactory.AdminClient(c)
-	ctx, cancel := newContext(c)
-	defer cancel()
-	response, err := adminClient.GetShard(ctx, &adminservice.GetShardRequest{ShardId: int32(sid)})
-
-	if err != nil {
-		return fmt.Errorf("unable to initialize Shard Manager: %s", err)
-	}
-
-	prettyPrintJSONObject(response.ShardInfo)
-	return nil
-}
-
 // AdminShardManagement describes history host
 func AdminShardManagement(c *cli.Context) error {
 	adminClient := cFactory.AdminClient(c)
@@ -572,3 +712,51 @@ func AdminRebuildMutableState(c *cli.Context) error {
 	}
 	return nil
 }
+
+// AdminStartDrain puts a host of a service role, or all hosts of the role, into drain mode
+func AdminStartDrain(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err := adminClient.StartDrain(ctx, &adminservice.StartDrainRequest{
+		Role: c.String(FlagClusterMembershipRole),
+		Host: c.String(FlagAddress),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to start drain: %v", err)
+	}
+	fmt.Println("Drain has been requested successfully.")
+	return nil
+}
+
+// AdminCancelDrain removes a drain request
+func AdminCancelDrain(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err := adminClient.CancelDrain(ctx, &adminservice.CancelDrainRequest{
+		Role: c.String(FlagClusterMembershipRole),
+		Host: c.String(FlagAddress),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to cancel drain: %v", err)
+	}
+	fmt.Println("Drain request has been removed successfully.")
+	return nil
+}
+
+// AdminDescribeDrain describes the progress of each drain request
+func AdminDescribeDrain(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeDrain(ctx, &adminservice.DescribeDrainRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to describe drain: %v", err)
+	}
+	prettyPrintJSONObject(resp.GetTargets())
+	return nil
+}
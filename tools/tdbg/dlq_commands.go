@@ -25,10 +25,13 @@
 package tdbg
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 
 	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
 	"go.uber.org/multierr"
 
 	"go.temporal.io/server/api/adminservice/v1"
@@ -38,6 +41,7 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/collection"
+	"go.temporal.io/server/common/persistence/serialization"
 )
 
 const (
@@ -53,6 +57,7 @@ func AdminGetDLQMessages(c *cli.Context) (err error) {
 	dlqType := c.String(FlagDLQType)
 	sourceCluster := c.String(FlagCluster)
 	shardID := c.Int(FlagShardID)
+	namespaceID := c.String(FlagNamespaceID)
 	outputFile, err := getOutputFile(c.String(FlagOutputFilename))
 	if err != nil {
 		return err
@@ -103,13 +108,16 @@ func AdminGetDLQMessages(c *cli.Context) (err error) {
 		}
 
 		task := item.(*replicationspb.ReplicationTask)
-		encoder := codec.NewJSONPBIndentEncoder(" ")
-		taskStr, err := encoder.Encode(task)
+		lastReadMessageID = int(task.SourceTaskId)
+		if namespaceID != "" && replicationTaskNamespaceID(task) != namespaceID {
+			continue
+		}
+
+		taskStr, err := encodeDLQMessage(task)
 		if err != nil {
-			return fmt.Errorf("unable to encode dlq message. Last read message id: %v", lastReadMessageID)
+			return fmt.Errorf("unable to encode dlq message. Last read message id: %v: %s", lastReadMessageID, err)
 		}
 
-		lastReadMessageID = int(task.SourceTaskId)
 		remainingMessageCount--
 		_, err = outputFile.WriteString(fmt.Sprintf("%v\n", string(taskStr)))
 		if err != nil {
@@ -119,6 +127,58 @@ func AdminGetDLQMessages(c *cli.Context) (err error) {
 	return nil
 }
 
+// AdminShowDLQMessage shows a single DLQ message, with its history events decoded
+func AdminShowDLQMessage(c *cli.Context) error {
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	adminClient := cFactory.AdminClient(c)
+	t, err := toQueueType(c.String(FlagDLQType))
+	if err != nil {
+		return err
+	}
+	messageID := c.Int64(FlagMessageID)
+
+	paginationFunc := func(paginationToken []byte) ([]interface{}, []byte, error) {
+		resp, err := adminClient.GetDLQMessages(ctx, &adminservice.GetDLQMessagesRequest{
+			Type:                  t,
+			SourceCluster:         c.String(FlagCluster),
+			ShardId:               int32(c.Int(FlagShardID)),
+			InclusiveEndMessageId: messageID,
+			MaximumPageSize:       defaultPageSize,
+			NextPageToken:         paginationToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		var paginateItems []interface{}
+		for _, item := range resp.GetReplicationTasks() {
+			paginateItems = append(paginateItems, item)
+		}
+		return paginateItems, resp.GetNextPageToken(), err
+	}
+
+	iterator := collection.NewPagingIterator(paginationFunc)
+	for iterator.HasNext() {
+		item, err := iterator.Next()
+		if err != nil {
+			return fmt.Errorf("unable to read dlq message: %s", err)
+		}
+
+		task := item.(*replicationspb.ReplicationTask)
+		if task.GetSourceTaskId() != messageID {
+			continue
+		}
+		taskStr, err := encodeDLQMessage(task)
+		if err != nil {
+			return fmt.Errorf("unable to encode dlq message: %s", err)
+		}
+		fmt.Println(string(taskStr))
+		return nil
+	}
+	return fmt.Errorf("dlq message %v not found", messageID)
+}
+
 // AdminPurgeDLQMessages deletes messages from DLQ
 func AdminPurgeDLQMessages(c *cli.Context) error {
 	ctx, cancel := newContext(c)
@@ -127,6 +187,7 @@ func AdminPurgeDLQMessages(c *cli.Context) error {
 	dlqType := c.String(FlagDLQType)
 	sourceCluster := c.String(FlagCluster)
 	shardID := c.Int(FlagShardID)
+	namespaceID := c.String(FlagNamespaceID)
 
 	var lastMessageID int64
 	if c.IsSet(FlagLastMessageID) {
@@ -145,8 +206,9 @@ func AdminPurgeDLQMessages(c *cli.Context) error {
 		SourceCluster:         sourceCluster,
 		ShardId:               int32(shardID),
 		InclusiveEndMessageId: lastMessageID,
+		NamespaceId:           namespaceID,
 	}); err != nil {
-		return fmt.Errorf("failed to purge DLQ")
+		return fmt.Errorf("failed to purge DLQ: %s", err)
 	}
 	fmt.Println("Successfully purged DLQ Messages.")
 	return nil
@@ -160,6 +222,7 @@ func AdminMergeDLQMessages(c *cli.Context) error {
 	dlqType := c.String(FlagDLQType)
 	sourceCluster := c.String(FlagCluster)
 	shardID := c.Int(FlagShardID)
+	namespaceID := c.String(FlagNamespaceID)
 
 	var lastMessageID int64
 	if c.IsSet(FlagLastMessageID) {
@@ -181,6 +244,7 @@ func AdminMergeDLQMessages(c *cli.Context) error {
 		ShardId:               int32(shardID),
 		InclusiveEndMessageId: lastMessageID,
 		MaximumPageSize:       defaultPageSize,
+		NamespaceId:           namespaceID,
 	}
 
 	var response *adminservice.MergeDLQMessagesResponse
@@ -197,6 +261,115 @@ func AdminMergeDLQMessages(c *cli.Context) error {
 	return nil
 }
 
+// AdminListArchivalDLQTasks lists the archival tasks which exhausted their retries
+func AdminListArchivalDLQTasks(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+	pageSize := c.Int(FlagPageSize)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	paginationFunc := func(paginationToken []byte) ([]interface{}, []byte, error) {
+		response, err := adminClient.ListArchivalDLQTasks(ctx, &adminservice.ListArchivalDLQTasksRequest{
+			PageSize:      int32(pageSize),
+			NextPageToken: paginationToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var items []interface{}
+		for _, task := range response.Tasks {
+			items = append(items, task)
+		}
+		return items, response.NextPageToken, nil
+	}
+
+	if err := paginate(c, paginationFunc, pageSize); err != nil {
+		return fmt.Errorf("unable to list archival DLQ tasks: %v", err)
+	}
+	return nil
+}
+
+// AdminRetryArchivalDLQTask retries an archival DLQ task
+func AdminRetryArchivalDLQTask(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err := adminClient.RetryArchivalDLQTask(ctx, &adminservice.RetryArchivalDLQTaskRequest{
+		MessageId: c.Int64(FlagMessageID),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to retry archival DLQ task: %v", err)
+	}
+	fmt.Println("Archival DLQ task has been retried successfully.")
+	return nil
+}
+
+// encodeDLQMessage encodes a DLQ message to JSON, with the history events carried by history replication
+// tasks decoded instead of dumped as encoded blobs.
+func encodeDLQMessage(task *replicationspb.ReplicationTask) ([]byte, error) {
+	encoder := codec.NewJSONPBIndentEncoder(" ")
+	taskJSON, err := encoder.Encode(task)
+	if err != nil {
+		return nil, err
+	}
+	attributes := task.GetHistoryTaskAttributes()
+	if attributes == nil {
+		return taskJSON, nil
+	}
+
+	var message, attributesJSON map[string]json.RawMessage
+	if err := json.Unmarshal(taskJSON, &message); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(message["historyTaskAttributes"], &attributesJSON); err != nil {
+		return nil, err
+	}
+	serializer := serialization.NewSerializer()
+	for field, blob := range map[string]*commonpb.DataBlob{
+		"events":       attributes.GetEvents(),
+		"newRunEvents": attributes.GetNewRunEvents(),
+	} {
+		if blob == nil {
+			continue
+		}
+		events, err := serializer.DeserializeEvents(blob)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode %s: %w", field, err)
+		}
+		eventsJSON, err := encoder.Encode(&historypb.History{Events: events})
+		if err != nil {
+			return nil, err
+		}
+		attributesJSON[field] = eventsJSON
+	}
+	if message["historyTaskAttributes"], err = json.Marshal(attributesJSON); err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(message, "", " ")
+}
+
+// replicationTaskNamespaceID returns the ID of the namespace a replication task belongs to, or an empty string
+// for the tasks that don't belong to a namespace.
+func replicationTaskNamespaceID(task *replicationspb.ReplicationTask) string {
+	switch {
+	case task.GetHistoryTaskAttributes() != nil:
+		return task.GetHistoryTaskAttributes().GetNamespaceId()
+	case task.GetSyncActivityTaskAttributes() != nil:
+		return task.GetSyncActivityTaskAttributes().GetNamespaceId()
+	case task.GetSyncWorkflowStateTaskAttributes() != nil:
+		return task.GetSyncWorkflowStateTaskAttributes().GetWorkflowState().GetExecutionInfo().GetNamespaceId()
+	case task.GetTaskQueueUserDataAttributes() != nil:
+		return task.GetTaskQueueUserDataAttributes().GetNamespaceId()
+	case task.GetNamespaceTaskAttributes() != nil:
+		return task.GetNamespaceTaskAttributes().GetId()
+	default:
+		return ""
+	}
+}
+
 func toQueueType(dlqType string) (enumsspb.DeadLetterQueueType, error) {
 	switch dlqType {
 	case "namespace":
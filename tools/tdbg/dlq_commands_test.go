@@ -0,0 +1,85 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"encoding/json"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+func (s *utilSuite) TestEncodeDLQMessage_DecodesHistoryEvents() {
+	events, err := serialization.NewSerializer().SerializeEvents([]*historypb.HistoryEvent{{
+		EventId:   1,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+	}}, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	task := &replicationspb.ReplicationTask{
+		SourceTaskId: 5,
+		Attributes: &replicationspb.ReplicationTask_HistoryTaskAttributes{
+			HistoryTaskAttributes: &replicationspb.HistoryTaskAttributes{
+				NamespaceId: "namespace-id",
+				Events:      events,
+			},
+		},
+	}
+
+	encoded, err := encodeDLQMessage(task)
+	s.NoError(err)
+	var message struct {
+		SourceTaskID          string `json:"sourceTaskId"`
+		HistoryTaskAttributes struct {
+			Events struct {
+				Events []struct {
+					EventID   string `json:"eventId"`
+					EventType string `json:"eventType"`
+				} `json:"events"`
+			} `json:"events"`
+		} `json:"historyTaskAttributes"`
+	}
+	s.NoError(json.Unmarshal(encoded, &message))
+	s.Equal("5", message.SourceTaskID)
+	s.Len(message.HistoryTaskAttributes.Events.Events, 1)
+	s.Equal("1", message.HistoryTaskAttributes.Events.Events[0].EventID)
+	s.Equal("WorkflowExecutionStarted", message.HistoryTaskAttributes.Events.Events[0].EventType)
+	s.Equal("namespace-id", replicationTaskNamespaceID(task))
+}
+
+func (s *utilSuite) TestReplicationTaskNamespaceID() {
+	s.Equal("namespace-id", replicationTaskNamespaceID(&replicationspb.ReplicationTask{
+		Attributes: &replicationspb.ReplicationTask_SyncActivityTaskAttributes{
+			SyncActivityTaskAttributes: &replicationspb.SyncActivityTaskAttributes{NamespaceId: "namespace-id"},
+		},
+	}))
+	s.Equal("", replicationTaskNamespaceID(&replicationspb.ReplicationTask{
+		Attributes: &replicationspb.ReplicationTask_SyncShardStatusTaskAttributes{
+			SyncShardStatusTaskAttributes: &replicationspb.SyncShardStatusTaskAttributes{},
+		},
+	}))
+}
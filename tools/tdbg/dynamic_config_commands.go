@@ -0,0 +1,155 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"go.temporal.io/server/api/adminservice/v1"
+)
+
+// AdminGetDynamicConfigOverrides prints the dynamic config values set at runtime
+func AdminGetDynamicConfigOverrides(c *cli.Context) error {
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := client.GetDynamicConfigOverrides(ctx, &adminservice.GetDynamicConfigOverridesRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to get dynamic config overrides: %v", err)
+	}
+	prettyPrintJSONObject(resp.GetOverrides())
+	return nil
+}
+
+// AdminSetDynamicConfigOverride sets the value of a dynamic config key at runtime
+func AdminSetDynamicConfigOverride(c *cli.Context) error {
+	value := &adminservice.DynamicConfigValue{
+		Constraints: c.String(FlagConstraints),
+		Value:       c.String(FlagValue),
+	}
+	if c.IsSet(FlagRollout) {
+		value.Rollout = &adminservice.DynamicConfigRollout{Percentage: int32(c.Int(FlagRollout))}
+	}
+	client := cFactory.AdminClient(c)
+	hostname, _ := os.Hostname()
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err := client.SetDynamicConfigOverride(ctx, &adminservice.SetDynamicConfigOverrideRequest{
+		Key:      c.String(FlagKey),
+		Value:    value,
+		Identity: "tdbg@" + hostname,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to set dynamic config override: %v", err)
+	}
+	fmt.Println("Dynamic config override has been set successfully.")
+	return nil
+}
+
+// AdminDeleteDynamicConfigOverride deletes the value of a dynamic config key set at runtime
+func AdminDeleteDynamicConfigOverride(c *cli.Context) error {
+	client := cFactory.AdminClient(c)
+	hostname, _ := os.Hostname()
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err := client.DeleteDynamicConfigOverride(ctx, &adminservice.DeleteDynamicConfigOverrideRequest{
+		Key:         c.String(FlagKey),
+		Constraints: c.String(FlagConstraints),
+		Identity:    "tdbg@" + hostname,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to delete dynamic config override: %v", err)
+	}
+	fmt.Println("Dynamic config override has been deleted successfully.")
+	return nil
+}
+
+// AdminListDynamicConfigChanges prints the most recent changes to the dynamic config values set at runtime
+func AdminListDynamicConfigChanges(c *cli.Context) error {
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := client.ListDynamicConfigChanges(ctx, &adminservice.ListDynamicConfigChangesRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to list dynamic config changes: %v", err)
+	}
+	prettyPrintJSONObject(resp.GetChanges())
+	return nil
+}
+
+// AdminGetEffectiveDynamicConfig prints dynamic config keys with their values and where they come from
+func AdminGetEffectiveDynamicConfig(c *cli.Context) error {
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := client.GetEffectiveDynamicConfig(ctx, &adminservice.GetEffectiveDynamicConfigRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to get effective dynamic config: %v", err)
+	}
+	keys := resp.GetKeys()
+	if c.IsSet(FlagKey) {
+		keys = nil
+		for _, key := range resp.GetKeys() {
+			if strings.EqualFold(key.GetKey(), c.String(FlagKey)) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	prettyPrintJSONObject(keys)
+	return nil
+}
+
+// AdminSetDynamicConfigRollout changes the percentage of the namespaces, task queues or shards which the value of
+// a dynamic config key set at runtime applies to
+func AdminSetDynamicConfigRollout(c *cli.Context) error {
+	client := cFactory.AdminClient(c)
+	hostname, _ := os.Hostname()
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err := client.SetDynamicConfigRollout(ctx, &adminservice.SetDynamicConfigRolloutRequest{
+		Key:         c.String(FlagKey),
+		Constraints: c.String(FlagConstraints),
+		Percentage:  int32(c.Int(FlagRollout)),
+		Identity:    "tdbg@" + hostname,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to set dynamic config rollout: %v", err)
+	}
+	fmt.Println("Dynamic config rollout has been set successfully.")
+	return nil
+}
@@ -72,10 +72,46 @@ var (
 	FlagMaxTaskID                  = "max-task-id"
 	FlagDLQType                    = "dlq-type"
 	FlagMaxMessageCount            = "max-message-count"
+	FlagMaxPendingTasks            = "max-pending-tasks"
 	FlagProtoType                  = "type"
 	FlagHexData                    = "hex-data"
 	FlagHexFile                    = "hex-file"
 	FlagBinaryFile                 = "binary-file"
 	FlagBase64Data                 = "base64-data"
 	FlagBase64File                 = "base64-file"
+	FlagMemo                       = "memo"
+	FlagSearchAttribute            = "search-attribute"
+	FlagReason                     = "reason"
+	FlagStoreURI                   = "store-uri"
+	FlagSnapshotID                 = "snapshot-id"
+	FlagNamespaces                 = "namespaces"
+	FlagRepair                     = "repair"
+	FlagGracePeriod                = "grace-period"
+	FlagCount                      = "count"
+	FlagWindow                     = "window"
+	FlagAlias                      = "alias"
+	FlagMessageID                  = "message-id"
+	FlagNewRunID                   = "new-run-id"
+	FlagArchivalURI                = "archival-uri"
+	FlagScheduleID                 = "schedule-id"
+	FlagBackfillID                 = "backfill-id"
+	FlagPauseAfterFailures         = "pause-after-failures"
+	FlagInputFilename              = "input-filename"
+	FlagDeleteActivityRPS          = "delete-activity-rps"
+	FlagConcurrentDeletions        = "concurrent-deletions"
+	FlagExportURI                  = "export-uri"
+	FlagName                       = "name"
+	FlagSystemRole                 = "system-role"
+	FlagNamespaceRole              = "namespace-role"
+	FlagRPS                        = "rps"
+	FlagAPIKeyID                   = "api-key-id"
+	FlagPermission                 = "permission"
+	FlagGroup                      = "group"
+	FlagNamedRole                  = "named-role"
+	FlagClusterWide                = "cluster-wide"
+	FlagKey                        = "key"
+	FlagValue                      = "value"
+	FlagConstraints                = "constraints"
+	FlagRollout                    = "rollout"
+	FlagVisibilityRetention        = "visibility-retention"
 )
@@ -0,0 +1,269 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	// namespaceExportCheckpoint is the checkpoint a namespace export writes under the export URI after every
+	// exported page.
+	namespaceExportCheckpoint struct {
+		NextPageToken []byte
+		ExportedCount int
+		Completed     bool
+	}
+)
+
+// AdminDescribeNamespaceStats prints the workflow counts, action rate and storage estimate of a namespace
+func AdminDescribeNamespaceStats(c *cli.Context) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeNamespaceStats(ctx, &adminservice.DescribeNamespaceStatsRequest{
+		Namespace: nsName,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe namespace stats: %v", err)
+	}
+	prettyPrintJSONObject(resp)
+	return nil
+}
+
+// AdminDescribeNamespaceReplicationStatus prints the replication lag, handover progress and last failover of a namespace
+func AdminDescribeNamespaceReplicationStatus(c *cli.Context) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeNamespaceReplicationStatus(ctx, &adminservice.DescribeNamespaceReplicationStatusRequest{
+		Namespace: nsName,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe namespace replication status: %v", err)
+	}
+	prettyPrintJSONObject(resp)
+	return nil
+}
+
+// AdminAddSearchAttributeAliases defines namespace aliases for custom search attributes
+func AdminAddSearchAttributeAliases(c *cli.Context) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	aliases := make(map[string]string)
+	for _, alias := range c.StringSlice(FlagAlias) {
+		name, fieldName, ok := strings.Cut(alias, "=")
+		if !ok || name == "" || fieldName == "" {
+			return fmt.Errorf("invalid alias %q, expected alias=field-name", alias)
+		}
+		aliases[name] = fieldName
+	}
+
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = client.AddSearchAttributeAliases(ctx, &adminservice.AddSearchAttributeAliasesRequest{
+		Namespace: nsName,
+		Aliases:   aliases,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to add search attribute aliases: %v", err)
+	}
+	fmt.Println("Search attribute aliases have been added successfully.")
+	return nil
+}
+
+// AdminDescribeNamespaceDeletion describes the progress of executions deletion of a namespace being deleted.
+// The namespace is the name it was renamed to when its deletion started.
+func AdminDescribeNamespaceDeletion(c *cli.Context) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := client.DescribeNamespaceDeletion(ctx, &adminservice.DescribeNamespaceDeletionRequest{
+		DeletedNamespace: nsName,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe namespace deletion: %v", err)
+	}
+	prettyPrintJSONObject(resp)
+	return nil
+}
+
+// AdminUpdateNamespaceDeletionRate adjusts rate limits of executions deletion of a namespace being deleted
+func AdminUpdateNamespaceDeletionRate(c *cli.Context) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = client.UpdateNamespaceDeletionRate(ctx, &adminservice.UpdateNamespaceDeletionRateRequest{
+		DeletedNamespace:                     nsName,
+		DeleteActivityRps:                    int32(c.Int(FlagDeleteActivityRPS)),
+		ConcurrentDeleteExecutionsActivities: int32(c.Int(FlagConcurrentDeletions)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update namespace deletion rate: %v", err)
+	}
+	fmt.Println("Namespace deletion rate has been updated successfully.")
+	return nil
+}
+
+// AdminStartNamespaceExport starts exporting every execution of a namespace
+func AdminStartNamespaceExport(c *cli.Context) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	request := &adminservice.StartNamespaceExportRequest{
+		Namespace: nsName,
+		ExportUri: c.String(FlagExportURI),
+	}
+	if c.IsSet(FlagInputFilename) {
+		data, err := os.ReadFile(c.String(FlagInputFilename))
+		if err != nil {
+			return fmt.Errorf("unable to read checkpoint file: %v", err)
+		}
+		var checkpoint namespaceExportCheckpoint
+		if err := json.Unmarshal(data, &checkpoint); err != nil {
+			return fmt.Errorf("unable to parse checkpoint file: %v", err)
+		}
+		if checkpoint.Completed {
+			return fmt.Errorf("export of namespace %s is already completed", nsName)
+		}
+		request.NextPageToken = checkpoint.NextPageToken
+		request.ExportedCount = int64(checkpoint.ExportedCount)
+	}
+
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := client.StartNamespaceExport(ctx, request)
+	if err != nil {
+		return fmt.Errorf("unable to start namespace export: %v", err)
+	}
+	fmt.Printf("Namespace export has been started, run ID: %s.\n", resp.GetRunId())
+	return nil
+}
+
+// AdminGetNamespaceFeatureFlags prints the state of every experimental feature flag for a namespace
+func AdminGetNamespaceFeatureFlags(c *cli.Context) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := client.GetNamespaceFeatureFlags(ctx, &adminservice.GetNamespaceFeatureFlagsRequest{
+		Namespace: nsName,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to get namespace feature flags: %v", err)
+	}
+	prettyPrintJSONObject(resp.GetFlags())
+	return nil
+}
+
+// AdminGetNamespaceVisibilityRetention prints how long the visibility records of closed workflow executions of a
+// namespace are kept
+func AdminGetNamespaceVisibilityRetention(c *cli.Context) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := client.GetNamespaceVisibilityRetention(ctx, &adminservice.GetNamespaceVisibilityRetentionRequest{
+		Namespace: nsName,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to get namespace visibility retention: %v", err)
+	}
+	prettyPrintJSONObject(resp)
+	return nil
+}
+
+// AdminUpdateNamespaceVisibilityRetention sets how long the visibility records of closed workflow executions of a
+// namespace are kept
+func AdminUpdateNamespaceVisibilityRetention(c *cli.Context) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = client.UpdateNamespaceVisibilityRetention(ctx, &adminservice.UpdateNamespaceVisibilityRetentionRequest{
+		Namespace:           nsName,
+		VisibilityRetention: timestamp.DurationPtr(c.Duration(FlagVisibilityRetention)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update namespace visibility retention: %v", err)
+	}
+	fmt.Println("Namespace visibility retention has been updated.")
+	return nil
+}
@@ -0,0 +1,128 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli/v2"
+
+	"go.temporal.io/server/api/adminservice/v1"
+)
+
+// AdminDescribeScheduleBackfills describes the backfills of a schedule
+func AdminDescribeScheduleBackfills(c *cli.Context) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeScheduleBackfills(ctx, &adminservice.DescribeScheduleBackfillsRequest{
+		Namespace:  namespace,
+		ScheduleId: c.String(FlagScheduleID),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe schedule backfills: %s", err)
+	}
+	prettyPrintJSONObject(resp.GetBackfills())
+	return nil
+}
+
+// AdminCancelScheduleBackfill cancels a running backfill of a schedule
+func AdminCancelScheduleBackfill(c *cli.Context) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	adminClient := cFactory.AdminClient(c)
+	hostname, _ := os.Hostname()
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.CancelScheduleBackfill(ctx, &adminservice.CancelScheduleBackfillRequest{
+		Namespace:  namespace,
+		ScheduleId: c.String(FlagScheduleID),
+		BackfillId: c.String(FlagBackfillID),
+		Identity:   "tdbg@" + hostname,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to cancel schedule backfill: %s", err)
+	}
+	fmt.Println("Schedule backfill has been canceled successfully.")
+	return nil
+}
+
+// AdminDescribeSchedulePauseAfterFailures describes the consecutive failure limit of a schedule
+func AdminDescribeSchedulePauseAfterFailures(c *cli.Context) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeSchedulePauseAfterFailures(ctx, &adminservice.DescribeSchedulePauseAfterFailuresRequest{
+		Namespace:  namespace,
+		ScheduleId: c.String(FlagScheduleID),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe schedule pause after failures: %s", err)
+	}
+	prettyPrintJSONObject(resp)
+	return nil
+}
+
+// AdminUpdateSchedulePauseAfterFailures sets the consecutive failure limit of a schedule
+func AdminUpdateSchedulePauseAfterFailures(c *cli.Context) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	adminClient := cFactory.AdminClient(c)
+	hostname, _ := os.Hostname()
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.UpdateSchedulePauseAfterFailures(ctx, &adminservice.UpdateSchedulePauseAfterFailuresRequest{
+		Namespace:          namespace,
+		ScheduleId:         c.String(FlagScheduleID),
+		PauseAfterFailures: c.Int64(FlagPauseAfterFailures),
+		Identity:           "tdbg@" + hostname,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update schedule pause after failures: %s", err)
+	}
+	fmt.Println("Schedule pause after failures has been updated successfully.")
+	return nil
+}
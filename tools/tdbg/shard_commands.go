@@ -0,0 +1,468 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/util"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+const (
+	defaultMaxPendingTasks = 1000
+)
+
+type (
+	shardDescription struct {
+		ShardID          int32     `json:"shardId"`
+		Owner            string    `json:"owner"`
+		RangeID          int64     `json:"rangeId"`
+		StolenSinceRenew int32     `json:"stolenSinceRenew"`
+		UpdateTime       time.Time `json:"updateTime"`
+		// Queues are built from the persisted queue states, so they trail the owner's in-memory progress
+		// by up to one shard info update interval. Only their in-memory counts come from the owner.
+		Queues                  []shardQueueStatus        `json:"queues"`
+		ReplicationSenders      []replicationSenderStatus `json:"replicationSenders"`
+		ReplicationDLQAckLevels map[string]int64          `json:"replicationDlqAckLevels,omitempty"`
+		// LiveStateError is set when the live state of the shard couldn't be read from its owner.
+		LiveStateError string `json:"liveStateError,omitempty"`
+	}
+
+	shardQueueStatus struct {
+		Category         string    `json:"category"`
+		AckLevelTaskID   int64     `json:"ackLevelTaskId"`
+		AckLevelFireTime time.Time `json:"ackLevelFireTime"`
+		// Lag is only set for scheduled queues, the backlog of immediate queues is given by PendingTasks.
+		Lag          string `json:"lag,omitempty"`
+		PendingTasks int    `json:"pendingTasks"`
+		// PendingTasksCapped is set when counting stopped at the --max-pending-tasks limit.
+		PendingTasksCapped bool `json:"pendingTasksCapped,omitempty"`
+		// InMemoryTasks are the tasks the owner has loaded and not completed yet, tracked in InMemorySlices.
+		InMemoryTasks  int `json:"inMemoryTasks"`
+		InMemorySlices int `json:"inMemorySlices"`
+	}
+
+	replicationSenderStatus struct {
+		Cluster            string `json:"cluster"`
+		ShardID            int32  `json:"shardId"`
+		AckLevelTaskID     int64  `json:"ackLevelTaskId"`
+		PendingTasks       int    `json:"pendingTasks"`
+		PendingTasksCapped bool   `json:"pendingTasksCapped,omitempty"`
+		// Streaming is set when the owner has a stream sender to the cluster shard, the watermarks below are
+		// reported by that sender.
+		Streaming      bool      `json:"streaming"`
+		SentWatermark  int64     `json:"sentWatermark,omitempty"`
+		AckedWatermark int64     `json:"ackedWatermark,omitempty"`
+		AckedTime      time.Time `json:"ackedTime,omitempty"`
+	}
+
+	shardQueueRow struct {
+		Category       string
+		AckLevel       string
+		Lag            string
+		PendingTasks   string
+		InMemoryTasks  int
+		InMemorySlices int
+	}
+
+	replicationSenderRow struct {
+		Cluster        string
+		ShardID        int32
+		AckLevel       int64
+		PendingTasks   string
+		Streaming      bool
+		SentWatermark  int64
+		AckedWatermark int64
+	}
+
+	replicationDLQRow struct {
+		SourceCluster string
+		AckLevel      int64
+	}
+)
+
+// AdminDescribeShard describes shard by shard id
+func AdminDescribeShard(c *cli.Context) error {
+	sid := c.Int(FlagShardID)
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.GetShard(ctx, &adminservice.GetShardRequest{ShardId: int32(sid)})
+
+	if err != nil {
+		return fmt.Errorf("unable to initialize Shard Manager: %s", err)
+	}
+
+	description, err := describeShard(ctx, adminClient, response.ShardInfo, c.Int(FlagMaxPendingTasks), time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("unable to describe Shard: %s", err)
+	}
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(description)
+		return nil
+	}
+	return printShardDescription(description)
+}
+
+// AdminDescribeShardDistribution describes how the history shards are distributed across history hosts
+func AdminDescribeShardDistribution(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeShardDistribution(ctx, &adminservice.DescribeShardDistributionRequest{
+		MaxPendingTasks: int32(c.Int(FlagMaxPendingTasks)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe shard distribution: %s", err)
+	}
+	prettyPrintJSONObject(resp)
+	return nil
+}
+
+func describeShard(
+	ctx context.Context,
+	adminClient adminservice.AdminServiceClient,
+	shardInfo *persistencespb.ShardInfo,
+	maxPendingTasks int,
+	now time.Time,
+) (*shardDescription, error) {
+	description := &shardDescription{
+		ShardID:                 shardInfo.GetShardId(),
+		Owner:                   shardInfo.GetOwner(),
+		RangeID:                 shardInfo.GetRangeId(),
+		StolenSinceRenew:        shardInfo.GetStolenSinceRenew(),
+		UpdateTime:              timestamp.TimeValue(shardInfo.GetUpdateTime()),
+		ReplicationDLQAckLevels: shardInfo.GetReplicationDlqAckLevel(),
+	}
+
+	liveState, err := adminClient.DescribeShard(ctx, &adminservice.DescribeShardRequest{ShardId: description.ShardID})
+	if err != nil {
+		// the persisted state is still worth reporting when the owner can't be reached
+		description.LiveStateError = err.Error()
+	}
+	liveQueues := make(map[string]*adminservice.ShardQueueState)
+	for _, queue := range liveState.GetQueues() {
+		liveQueues[queue.GetCategory()] = queue
+	}
+
+	categoryIDs := make([]int32, 0, len(shardInfo.GetQueueStates()))
+	for categoryID := range shardInfo.GetQueueStates() {
+		categoryIDs = append(categoryIDs, categoryID)
+	}
+	sort.Slice(categoryIDs, func(i, j int) bool { return categoryIDs[i] < categoryIDs[j] })
+
+	for _, categoryID := range categoryIDs {
+		queueState := shardInfo.QueueStates[categoryID]
+		ackLevel := queueAckLevel(queueState)
+		status := shardQueueStatus{
+			Category:         strconv.Itoa(int(categoryID)),
+			AckLevelTaskID:   ackLevel.TaskID,
+			AckLevelFireTime: ackLevel.FireTime,
+		}
+		categoryType := tasks.CategoryTypeImmediate
+		if category, ok := tasks.GetCategoryByID(categoryID); ok {
+			status.Category = category.Name()
+			categoryType = category.Type()
+		}
+
+		minKey, maxKey := immediateTaskRange(ackLevel.TaskID)
+		if categoryType == tasks.CategoryTypeScheduled {
+			// only tasks which are already due are backlog for a scheduled queue
+			maxFireTime := now
+			if ackLevel.FireTime.After(now) {
+				maxFireTime = ackLevel.FireTime
+			}
+			minKey = &history.TaskKey{FireTime: timestamp.TimePtr(ackLevel.FireTime)}
+			maxKey = &history.TaskKey{FireTime: timestamp.TimePtr(maxFireTime)}
+			status.Lag = util.Max(now.Sub(ackLevel.FireTime), 0).Truncate(time.Second).String()
+		}
+		status.PendingTasks, status.PendingTasksCapped, err = countPendingTasks(
+			ctx, adminClient, description.ShardID, enumsspb.TaskCategory(categoryID), minKey, maxKey, maxPendingTasks,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if liveQueue, ok := liveQueues[status.Category]; ok {
+			status.InMemoryTasks = int(liveQueue.GetPendingTaskCount())
+			status.InMemorySlices = int(liveQueue.GetSliceCount())
+		}
+		description.Queues = append(description.Queues, status)
+	}
+
+	if err := describeReplicationSenders(ctx, adminClient, description, shardInfo, liveState, maxPendingTasks); err != nil {
+		return nil, err
+	}
+	return description, nil
+}
+
+func describeReplicationSenders(
+	ctx context.Context,
+	adminClient adminservice.AdminServiceClient,
+	description *shardDescription,
+	shardInfo *persistencespb.ShardInfo,
+	liveState *adminservice.DescribeShardResponse,
+	maxPendingTasks int,
+) error {
+	replicationState := shardInfo.GetQueueStates()[tasks.CategoryIDReplication]
+	var clusterNames map[int64]string
+	if len(replicationState.GetReaderStates()) != 0 {
+		var err error
+		if clusterNames, err = listClusterNames(ctx, adminClient); err != nil {
+			return err
+		}
+	}
+	for readerID, readerState := range replicationState.GetReaderStates() {
+		if len(readerState.GetScopes()) == 0 {
+			continue
+		}
+		clusterID, shardID := shard.ReplicationReaderIDToClusterShardID(readerID)
+		clusterName, ok := clusterNames[clusterID]
+		if !ok {
+			clusterName = strconv.FormatInt(clusterID, 10)
+		}
+		ackLevel := shard.ConvertFromPersistenceTaskKey(readerState.Scopes[0].Range.InclusiveMin)
+		status := replicationSenderStatus{
+			Cluster:        clusterName,
+			ShardID:        shardID,
+			AckLevelTaskID: ackLevel.TaskID,
+		}
+		minKey, maxKey := immediateTaskRange(ackLevel.TaskID)
+		var err error
+		status.PendingTasks, status.PendingTasksCapped, err = countPendingTasks(
+			ctx, adminClient, description.ShardID, enumsspb.TASK_CATEGORY_REPLICATION, minKey, maxKey, maxPendingTasks,
+		)
+		if err != nil {
+			return err
+		}
+		description.ReplicationSenders = append(description.ReplicationSenders, status)
+	}
+
+LiveLoop:
+	for _, sender := range liveState.GetReplicationStreamSenders() {
+		for i := range description.ReplicationSenders {
+			status := &description.ReplicationSenders[i]
+			if status.Cluster == sender.GetClientCluster() && status.ShardID == sender.GetClientShardId() {
+				setLiveReplicationSenderState(status, sender)
+				continue LiveLoop
+			}
+		}
+		// the receiving shard hasn't acknowledged any task yet, so there is no persisted reader state
+		status := replicationSenderStatus{
+			Cluster: sender.GetClientCluster(),
+			ShardID: sender.GetClientShardId(),
+		}
+		setLiveReplicationSenderState(&status, sender)
+		description.ReplicationSenders = append(description.ReplicationSenders, status)
+	}
+
+	sort.Slice(description.ReplicationSenders, func(i, j int) bool {
+		left, right := description.ReplicationSenders[i], description.ReplicationSenders[j]
+		if left.Cluster != right.Cluster {
+			return left.Cluster < right.Cluster
+		}
+		return left.ShardID < right.ShardID
+	})
+	return nil
+}
+
+func setLiveReplicationSenderState(status *replicationSenderStatus, sender *adminservice.ReplicationStreamSenderState) {
+	status.Streaming = true
+	status.SentWatermark = sender.GetExclusiveHighWatermark()
+	status.AckedWatermark = sender.GetInclusiveLowWatermark()
+	status.AckedTime = timestamp.TimeValue(sender.GetInclusiveLowWatermarkTime())
+}
+
+// queueAckLevel returns the key of the oldest task of the queue which may not be processed yet.
+func queueAckLevel(queueState *persistencespb.QueueState) tasks.Key {
+	ackLevel := tasks.MaximumKey
+	found := false
+	if watermark := queueState.GetExclusiveReaderHighWatermark(); watermark != nil {
+		ackLevel = shard.ConvertFromPersistenceTaskKey(watermark)
+		found = true
+	}
+	for _, readerState := range queueState.GetReaderStates() {
+		if len(readerState.GetScopes()) != 0 {
+			ackLevel = tasks.MinKey(ackLevel, shard.ConvertFromPersistenceTaskKey(readerState.Scopes[0].Range.InclusiveMin))
+			found = true
+		}
+	}
+	if !found {
+		return tasks.MinimumKey
+	}
+	return ackLevel
+}
+
+func immediateTaskRange(ackLevelTaskID int64) (*history.TaskKey, *history.TaskKey) {
+	return &history.TaskKey{FireTime: timestamp.TimePtr(tasks.DefaultFireTime), TaskId: ackLevelTaskID},
+		&history.TaskKey{FireTime: timestamp.TimePtr(tasks.DefaultFireTime), TaskId: math.MaxInt64}
+}
+
+func countPendingTasks(
+	ctx context.Context,
+	adminClient adminservice.AdminServiceClient,
+	shardID int32,
+	category enumsspb.TaskCategory,
+	minKey *history.TaskKey,
+	maxKey *history.TaskKey,
+	maxCount int,
+) (int, bool, error) {
+	count := 0
+	var pageToken []byte
+	for {
+		resp, err := adminClient.ListHistoryTasks(ctx, &adminservice.ListHistoryTasksRequest{
+			ShardId:  shardID,
+			Category: category,
+			TaskRange: &history.TaskRange{
+				InclusiveMinTaskKey: minKey,
+				ExclusiveMaxTaskKey: maxKey,
+			},
+			BatchSize:     int32(util.Min(defaultPageSize, maxCount-count+1)),
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return 0, false, fmt.Errorf("unable to list %s tasks: %s", category, err)
+		}
+		count += len(resp.Tasks)
+		if count > maxCount {
+			return maxCount, true, nil
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return count, false, nil
+		}
+	}
+}
+
+func listClusterNames(
+	ctx context.Context,
+	adminClient adminservice.AdminServiceClient,
+) (map[int64]string, error) {
+	clusterNames := make(map[int64]string)
+	var pageToken []byte
+	for {
+		resp, err := adminClient.ListClusters(ctx, &adminservice.ListClustersRequest{
+			PageSize:      defaultPageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to list clusters: %s", err)
+		}
+		for _, cluster := range resp.Clusters {
+			// replication readers are keyed by the initial failover version of the remote cluster
+			clusterNames[cluster.GetInitialFailoverVersion()] = cluster.GetClusterName()
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return clusterNames, nil
+		}
+	}
+}
+
+func printShardDescription(description *shardDescription) error {
+	fmt.Printf("Shard ID: %v\n", description.ShardID)
+	fmt.Printf("Owner: %v\n", description.Owner)
+	fmt.Printf("Range ID: %v\n", description.RangeID)
+	fmt.Printf("Stolen since renew: %v\n", description.StolenSinceRenew)
+	fmt.Printf("Update time: %v\n", description.UpdateTime.Format(time.RFC3339))
+	if description.LiveStateError != "" {
+		fmt.Printf("Live state unavailable, in-memory counts are not reported: %v\n", description.LiveStateError)
+	}
+
+	fmt.Println("\nQueues:")
+	var queues []interface{}
+	for _, queue := range description.Queues {
+		ackLevel := strconv.FormatInt(queue.AckLevelTaskID, 10)
+		if queue.Lag != "" {
+			ackLevel = queue.AckLevelFireTime.Format(time.RFC3339)
+		}
+		queues = append(queues, shardQueueRow{
+			Category:       queue.Category,
+			AckLevel:       ackLevel,
+			Lag:            queue.Lag,
+			PendingTasks:   formatPendingTasks(queue.PendingTasks, queue.PendingTasksCapped),
+			InMemoryTasks:  queue.InMemoryTasks,
+			InMemorySlices: queue.InMemorySlices,
+		})
+	}
+	if err := printTable(queues); err != nil {
+		return err
+	}
+
+	if len(description.ReplicationSenders) != 0 {
+		fmt.Println("\nReplication senders:")
+		var senders []interface{}
+		for _, sender := range description.ReplicationSenders {
+			senders = append(senders, replicationSenderRow{
+				Cluster:        sender.Cluster,
+				ShardID:        sender.ShardID,
+				AckLevel:       sender.AckLevelTaskID,
+				PendingTasks:   formatPendingTasks(sender.PendingTasks, sender.PendingTasksCapped),
+				Streaming:      sender.Streaming,
+				SentWatermark:  sender.SentWatermark,
+				AckedWatermark: sender.AckedWatermark,
+			})
+		}
+		if err := printTable(senders); err != nil {
+			return err
+		}
+	}
+
+	if len(description.ReplicationDLQAckLevels) != 0 {
+		fmt.Println("\nReplication DLQ ack levels:")
+		var levels []interface{}
+		for cluster, ackLevel := range description.ReplicationDLQAckLevels {
+			levels = append(levels, replicationDLQRow{SourceCluster: cluster, AckLevel: ackLevel})
+		}
+		sort.Slice(levels, func(i, j int) bool {
+			return levels[i].(replicationDLQRow).SourceCluster < levels[j].(replicationDLQRow).SourceCluster
+		})
+		if err := printTable(levels); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatPendingTasks(count int, capped bool) string {
+	if capped {
+		return fmt.Sprintf("%d+", count)
+	}
+	return strconv.Itoa(count)
+}
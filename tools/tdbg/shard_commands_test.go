@@ -0,0 +1,189 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/adminservicemock/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+func (s *utilSuite) TestDescribeShard() {
+	controller := gomock.NewController(s.T())
+	defer controller.Finish()
+	adminClient := adminservicemock.NewMockAdminServiceClient(controller)
+
+	now := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	timerAckLevel := now.Add(-90 * time.Second)
+	readerState := func(taskID int64, fireTime time.Time) *persistencespb.QueueReaderState {
+		return &persistencespb.QueueReaderState{
+			Scopes: []*persistencespb.QueueSliceScope{{
+				Range: &persistencespb.QueueSliceRange{
+					InclusiveMin: &persistencespb.TaskKey{TaskId: taskID, FireTime: timestamp.TimePtr(fireTime)},
+				},
+			}},
+		}
+	}
+	shardInfo := &persistencespb.ShardInfo{
+		ShardId: 3,
+		Owner:   "history-host",
+		RangeId: 7,
+		QueueStates: map[int32]*persistencespb.QueueState{
+			tasks.CategoryIDTransfer: {
+				ReaderStates: map[int64]*persistencespb.QueueReaderState{
+					0: readerState(100, tasks.DefaultFireTime),
+				},
+				ExclusiveReaderHighWatermark: &persistencespb.TaskKey{TaskId: 120, FireTime: timestamp.TimePtr(tasks.DefaultFireTime)},
+			},
+			tasks.CategoryIDTimer: {
+				ExclusiveReaderHighWatermark: &persistencespb.TaskKey{FireTime: timestamp.TimePtr(timerAckLevel)},
+			},
+			tasks.CategoryIDReplication: {
+				ReaderStates: map[int64]*persistencespb.QueueReaderState{
+					shard.ReplicationReaderIDFromClusterShardID(2, 3): readerState(50, tasks.DefaultFireTime),
+				},
+			},
+		},
+	}
+
+	adminClient.EXPECT().ListHistoryTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *adminservice.ListHistoryTasksRequest, _ ...interface{}) (*adminservice.ListHistoryTasksResponse, error) {
+			s.Equal(int32(3), request.ShardId)
+			switch request.Category {
+			case enumsspb.TASK_CATEGORY_TRANSFER:
+				s.Equal(int64(100), request.TaskRange.InclusiveMinTaskKey.TaskId)
+				return &adminservice.ListHistoryTasksResponse{Tasks: make([]*adminservice.Task, 4)}, nil
+			case enumsspb.TASK_CATEGORY_TIMER:
+				s.Equal(timerAckLevel, *request.TaskRange.InclusiveMinTaskKey.FireTime)
+				s.Equal(now, *request.TaskRange.ExclusiveMaxTaskKey.FireTime)
+				return &adminservice.ListHistoryTasksResponse{Tasks: make([]*adminservice.Task, 6)}, nil
+			default:
+				s.Equal(enumsspb.TASK_CATEGORY_REPLICATION, request.Category)
+				s.Equal(int64(50), request.TaskRange.InclusiveMinTaskKey.TaskId)
+				return &adminservice.ListHistoryTasksResponse{Tasks: make([]*adminservice.Task, 2)}, nil
+			}
+		},
+	).Times(4)
+	ackedTime := now.Add(-time.Second)
+	adminClient.EXPECT().DescribeShard(gomock.Any(), &adminservice.DescribeShardRequest{ShardId: 3}).Return(&adminservice.DescribeShardResponse{
+		Owner: "history-host",
+		Queues: []*adminservice.ShardQueueState{
+			{Category: "transfer", PendingTaskCount: 3, SliceCount: 1},
+			{Category: "timer", PendingTaskCount: 8, SliceCount: 2},
+		},
+		ReplicationStreamSenders: []*adminservice.ReplicationStreamSenderState{
+			{
+				ClientCluster:             "standby",
+				ClientShardId:             3,
+				InclusiveLowWatermark:     50,
+				InclusiveLowWatermarkTime: timestamp.TimePtr(ackedTime),
+				ExclusiveHighWatermark:    60,
+			},
+			{ClientCluster: "standby", ClientShardId: 4, ExclusiveHighWatermark: 60},
+		},
+	}, nil)
+	adminClient.EXPECT().ListClusters(gomock.Any(), gomock.Any()).Return(&adminservice.ListClustersResponse{
+		Clusters: []*persistencespb.ClusterMetadata{
+			{ClusterName: "active", InitialFailoverVersion: 1},
+			{ClusterName: "standby", InitialFailoverVersion: 2},
+		},
+	}, nil)
+
+	description, err := describeShard(context.Background(), adminClient, shardInfo, 5, now)
+	s.NoError(err)
+	s.Equal(int32(3), description.ShardID)
+	s.Equal("history-host", description.Owner)
+	s.Equal([]shardQueueStatus{
+		{
+			Category:         "transfer",
+			AckLevelTaskID:   100,
+			AckLevelFireTime: tasks.DefaultFireTime,
+			PendingTasks:     4,
+			InMemoryTasks:    3,
+			InMemorySlices:   1,
+		},
+		{
+			Category:           "timer",
+			AckLevelFireTime:   timerAckLevel,
+			Lag:                "1m30s",
+			PendingTasks:       5,
+			PendingTasksCapped: true,
+			InMemoryTasks:      8,
+			InMemorySlices:     2,
+		},
+		{
+			Category:         "replication",
+			AckLevelTaskID:   50,
+			AckLevelFireTime: tasks.DefaultFireTime,
+			PendingTasks:     2,
+		},
+	}, description.Queues)
+	s.Equal([]replicationSenderStatus{
+		{
+			Cluster:        "standby",
+			ShardID:        3,
+			AckLevelTaskID: 50,
+			PendingTasks:   2,
+			Streaming:      true,
+			SentWatermark:  60,
+			AckedWatermark: 50,
+			AckedTime:      ackedTime,
+		},
+		{
+			Cluster:       "standby",
+			ShardID:       4,
+			Streaming:     true,
+			SentWatermark: 60,
+		},
+	}, description.ReplicationSenders)
+	s.Empty(description.LiveStateError)
+}
+
+func (s *utilSuite) TestDescribeShard_OwnerUnavailable() {
+	controller := gomock.NewController(s.T())
+	defer controller.Finish()
+	adminClient := adminservicemock.NewMockAdminServiceClient(controller)
+
+	adminClient.EXPECT().DescribeShard(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewUnavailable("owner unavailable"))
+
+	description, err := describeShard(context.Background(), adminClient, &persistencespb.ShardInfo{ShardId: 3}, 5, time.Now().UTC())
+	s.NoError(err)
+	s.Equal("owner unavailable", description.LiveStateError)
+	s.Empty(description.ReplicationSenders)
+}
+
+func (s *utilSuite) TestQueueAckLevel_NoReaders() {
+	s.Equal(tasks.MinimumKey, queueAckLevel(&persistencespb.QueueState{}))
+}
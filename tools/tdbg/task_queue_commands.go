@@ -105,3 +105,33 @@ func AdminListTaskQueueTasks(c *cli.Context) error {
 	}
 	return nil
 }
+
+// AdminDescribeTaskQueueTopology displays the partitions of a task queue
+func AdminDescribeTaskQueueTopology(c *cli.Context) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqType := enumspb.TASK_QUEUE_TYPE_UNSPECIFIED
+	if c.IsSet(FlagTaskQueueType) {
+		tlTypeInt, err := stringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+		if err != nil {
+			return fmt.Errorf("invalid task queue type: %v", err)
+		}
+		tqType = enumspb.TaskQueueType(tlTypeInt)
+	}
+
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.DescribeTaskQueueTopology(ctx, &adminservice.DescribeTaskQueueTopologyRequest{
+		Namespace:     namespace,
+		TaskQueue:     c.String(FlagTaskQueue),
+		TaskQueueType: tqType,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe task queue topology: %v", err)
+	}
+	prettyPrintJSONObject(response)
+	return nil
+}
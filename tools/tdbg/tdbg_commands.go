@@ -25,6 +25,8 @@
 package tdbg
 
 import (
+	"time"
+
 	"github.com/urfave/cli/v2"
 )
 
@@ -47,18 +49,48 @@ var commands = []*cli.Command{
 		Usage:       "Run admin operation on history host",
 		Subcommands: newAdminHistoryHostCommands(),
 	},
+	{
+		Name:        "namespace",
+		Aliases:     []string{"ns"},
+		Usage:       "Run admin operation on namespace",
+		Subcommands: newAdminNamespaceCommands(),
+	},
 	{
 		Name:        "taskqueue",
 		Aliases:     []string{"tq"},
 		Usage:       "Run admin operation on taskQueue",
 		Subcommands: newAdminTaskQueueCommands(),
 	},
+	{
+		Name:        "schedule",
+		Aliases:     []string{"sc"},
+		Usage:       "Run admin operation on schedule",
+		Subcommands: newAdminScheduleCommands(),
+	},
 	{
 		Name:        "membership",
 		Aliases:     []string{"m"},
 		Usage:       "Run admin operation on membership",
 		Subcommands: newAdminMembershipCommands(),
 	},
+	{
+		Name:        "cluster",
+		Aliases:     []string{"c"},
+		Usage:       "Run admin operation on cluster",
+		Subcommands: newAdminClusterCommands(),
+	},
+	{
+		Name:        "auth",
+		Aliases:     []string{"a"},
+		Usage:       "Run admin operation on authorization",
+		Subcommands: newAdminAuthCommands(),
+	},
+	{
+		Name:        "dynamic-config",
+		Aliases:     []string{"dc"},
+		Usage:       "Run admin operation on dynamic config set at runtime",
+		Subcommands: newAdminDynamicConfigCommands(),
+	},
 	{
 		Name:        "dlq",
 		Usage:       "Run admin operation on DLQ",
@@ -112,6 +144,20 @@ func newAdminWorkflowCommands() []*cli.Command {
 				return AdminShowWorkflow(c)
 			},
 		},
+		{
+			Name:  "import",
+			Usage: "Import a part of the history of a run exported from another cluster",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagInputFilename,
+					Usage:    "ImportWorkflowExecution request in JSON format, with the raw history batches of the run",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminImportWorkflow(c)
+			},
+		},
 		{
 			Name:    "describe",
 			Aliases: []string{"d"},
@@ -192,6 +238,91 @@ func newAdminWorkflowCommands() []*cli.Command {
 				return AdminDeleteWorkflow(c)
 			},
 		},
+		{
+			Name:  "update-memo",
+			Usage: "Upsert memo fields of a workflow execution without scheduling a workflow task",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.StringSliceFlag{
+					Name:     FlagMemo,
+					Usage:    "Memo field to upsert, in the key=json-value format. A field with an empty value is removed",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason of the update, written to the history service log",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpdateWorkflowMemo(c)
+			},
+		},
+		{
+			Name:  "upsert-search-attributes",
+			Usage: "Upsert search attributes of a workflow execution without scheduling a workflow task",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.StringSliceFlag{
+					Name:     FlagSearchAttribute,
+					Usage:    "Search attribute to upsert, in the key=json-value format. A search attribute with an empty value is removed",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason of the update, written to the history service log",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpsertWorkflowSearchAttributes(c)
+			},
+		},
+		{
+			Name:  "rehydrate",
+			Usage: "Import the archived history of a closed workflow execution back into the persistence store",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagWorkflowID,
+					Aliases:  FlagWorkflowIDAlias,
+					Usage:    "Workflow ID",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagRunID,
+					Aliases:  FlagRunIDAlias,
+					Usage:    "Run ID of the archived execution",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagNewRunID,
+					Usage: "Run ID the execution is rehydrated under, defaults to the archived run ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagArchivalURI,
+					Usage: "Archival URI the history is read from, defaults to the history archival URI of the namespace",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRehydrateWorkflow(c)
+			},
+		},
 	}
 }
 
@@ -200,17 +331,39 @@ func newAdminShardManagementCommands() []*cli.Command {
 		{
 			Name:    "describe",
 			Aliases: []string{"d"},
-			Usage:   "Describe shard by ID",
+			Usage:   "Describe shard by ID, including the ack level and backlog of its queues",
 			Flags: []cli.Flag{
 				&cli.IntFlag{
 					Name:  FlagShardID,
 					Usage: "The ID of the shard to describe",
 				},
+				&cli.IntFlag{
+					Name:  FlagMaxPendingTasks,
+					Value: defaultMaxPendingTasks,
+					Usage: "Stop counting the pending tasks of a queue after this many",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Usage: "Print in raw json format",
+				},
 			},
 			Action: func(c *cli.Context) error {
 				return AdminDescribeShard(c)
 			},
 		},
+		{
+			Name:  "distribution",
+			Usage: "Describe every shard with the history host it is assigned to, and the number of shards of each host",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:  FlagMaxPendingTasks,
+					Usage: "Stop counting the pending tasks of a queue after this many, zero skips counting",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeShardDistribution(c)
+			},
+		},
 		{
 			Name:  "list-tasks",
 			Usage: "List tasks for given shard ID and task type",
@@ -345,6 +498,49 @@ func newAdminMembershipCommands() []*cli.Command {
 				return AdminListClusterMembers(c)
 			},
 		},
+		{
+			Name:  "start-drain",
+			Usage: "Put a host, or all hosts of a service role, into drain mode until they restart",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagClusterMembershipRole,
+					Usage:    "Service role: frontend, internal-frontend, history, matching",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagAddress,
+					Usage: "Address of the host, all hosts of the role if not set",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminStartDrain(c)
+			},
+		},
+		{
+			Name:  "cancel-drain",
+			Usage: "Remove a drain request, hosts which started draining stay drained until they restart",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagClusterMembershipRole,
+					Usage:    "Service role: frontend, internal-frontend, history, matching",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagAddress,
+					Usage: "Address of the host, all hosts of the role if not set",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminCancelDrain(c)
+			},
+		},
+		{
+			Name:  "describe-drain",
+			Usage: "Describe the progress of each drain request",
+			Action: func(c *cli.Context) error {
+				return AdminDescribeDrain(c)
+			},
+		},
 	}
 }
 
@@ -402,6 +598,109 @@ func newAdminHistoryHostCommands() []*cli.Command {
 	}
 }
 
+func newAdminNamespaceCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "stats",
+			Usage: "Describe workflow counts, action rate and storage estimate of a namespace",
+			Action: func(c *cli.Context) error {
+				return AdminDescribeNamespaceStats(c)
+			},
+		},
+		{
+			Name:  "replication-status",
+			Usage: "Describe replication lag, pending handover and last failover of a namespace",
+			Action: func(c *cli.Context) error {
+				return AdminDescribeNamespaceReplicationStatus(c)
+			},
+		},
+		{
+			Name:  "add-search-attribute-aliases",
+			Usage: "Define namespace aliases for custom search attributes registered in the cluster",
+			Flags: []cli.Flag{
+				&cli.StringSliceFlag{
+					Name:     FlagAlias,
+					Usage:    "Alias to define, in the alias=field-name format, can be repeated",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminAddSearchAttributeAliases(c)
+			},
+		},
+		{
+			Name:  "describe-deletion",
+			Usage: "Describe the progress of executions deletion of a namespace being deleted",
+			Action: func(c *cli.Context) error {
+				return AdminDescribeNamespaceDeletion(c)
+			},
+		},
+		{
+			Name:  "update-deletion-rate",
+			Usage: "Adjust rate limits of executions deletion of a namespace being deleted",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:  FlagDeleteActivityRPS,
+					Usage: "Executions deleted per second, unchanged if not set",
+				},
+				&cli.IntFlag{
+					Name:  FlagConcurrentDeletions,
+					Usage: "Number of concurrent deletion activities, unchanged if not set",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpdateNamespaceDeletionRate(c)
+			},
+		},
+		{
+			Name:  "export",
+			Usage: "Export every execution of a namespace",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagExportURI,
+					Usage:    "URI to export the namespace to",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagInputFilename,
+					Usage: "Checkpoint file left under the export URI by an interrupted export, to resume it",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminStartNamespaceExport(c)
+			},
+		},
+		{
+			Name:  "feature-flags",
+			Usage: "Show the state of every experimental feature flag for a namespace",
+			Action: func(c *cli.Context) error {
+				return AdminGetNamespaceFeatureFlags(c)
+			},
+		},
+		{
+			Name:  "describe-visibility-retention",
+			Usage: "Describe how long the visibility records of closed workflow executions of a namespace are kept",
+			Action: func(c *cli.Context) error {
+				return AdminGetNamespaceVisibilityRetention(c)
+			},
+		},
+		{
+			Name:  "update-visibility-retention",
+			Usage: "Set how long the visibility records of closed workflow executions of a namespace are kept",
+			Flags: []cli.Flag{
+				&cli.DurationFlag{
+					Name:     FlagVisibilityRetention,
+					Usage:    "Visibility retention, e.g. 8760h; 0 keeps visibility records for the workflow execution retention",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpdateNamespaceVisibilityRetention(c)
+			},
+		},
+	}
+}
+
 func newAdminTaskQueueCommands() []*cli.Command {
 	return []*cli.Command{
 		{
@@ -444,108 +743,450 @@ func newAdminTaskQueueCommands() []*cli.Command {
 				return AdminListTaskQueueTasks(c)
 			},
 		},
-	}
-}
-
-func newAdminDLQCommands() []*cli.Command {
-	return []*cli.Command{
 		{
-			Name:    "read",
-			Aliases: []string{"r"},
-			Usage:   "Read DLQ Messages",
+			Name:  "topology",
+			Usage: "Describe the partitions of a task queue and the matching hosts owning them",
 			Flags: []cli.Flag{
 				&cli.StringFlag{
-					Name:  FlagDLQType,
-					Usage: "Type of DLQ to manage. (Options: namespace, history)",
-				},
-				&cli.StringFlag{
-					Name:  FlagCluster,
-					Usage: "Source cluster",
-				},
-				&cli.IntFlag{
-					Name:  FlagShardID,
-					Usage: "ShardId",
-				},
-				&cli.IntFlag{
-					Name:  FlagMaxMessageCount,
-					Usage: "Max message size to fetch",
-				},
-				&cli.IntFlag{
-					Name:  FlagLastMessageID,
-					Usage: "The upper boundary of the read message",
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
 				},
 				&cli.StringFlag{
-					Name:  FlagOutputFilename,
-					Usage: "Output file to write to, if not provided output is written to stdout",
+					Name:  FlagTaskQueueType,
+					Usage: "Task Queue type: activity, workflow. Both types are described if not set",
 				},
 			},
 			Action: func(c *cli.Context) error {
-				return AdminGetDLQMessages(c)
+				return AdminDescribeTaskQueueTopology(c)
 			},
 		},
+	}
+}
+
+func newAdminScheduleCommands() []*cli.Command {
+	return []*cli.Command{
 		{
-			Name:    "purge",
-			Aliases: []string{"p"},
-			Usage:   "Delete DLQ messages with equal or smaller ids than the provided task id",
+			Name:  "describe-backfills",
+			Usage: "Describe the progress of the running and recently finished backfills of a schedule",
 			Flags: []cli.Flag{
 				&cli.StringFlag{
-					Name:  FlagDLQType,
-					Usage: "Type of DLQ to manage. (Options: namespace, history)",
-				},
-				&cli.StringFlag{
-					Name:  FlagCluster,
-					Usage: "Source cluster",
-				},
-				&cli.IntFlag{
-					Name:  FlagShardID,
-					Usage: "ShardId",
-				},
-				&cli.IntFlag{
-					Name:  FlagLastMessageID,
-					Usage: "The upper boundary of the read message",
+					Name:     FlagScheduleID,
+					Usage:    "Schedule ID",
+					Required: true,
 				},
 			},
 			Action: func(c *cli.Context) error {
-				return AdminPurgeDLQMessages(c)
+				return AdminDescribeScheduleBackfills(c)
 			},
 		},
 		{
-			Name:    "merge",
-			Aliases: []string{"m"},
-			Usage:   "Merge DLQ messages with equal or smaller ids than the provided task id",
+			Name:  "cancel-backfill",
+			Usage: "Drop the actions of a running backfill of a schedule which were not taken yet",
 			Flags: []cli.Flag{
 				&cli.StringFlag{
-					Name:  FlagDLQType,
-					Usage: "Type of DLQ to manage. (Options: namespace, history)",
+					Name:     FlagScheduleID,
+					Usage:    "Schedule ID",
+					Required: true,
 				},
 				&cli.StringFlag{
-					Name:  FlagCluster,
-					Usage: "Source cluster",
-				},
-				&cli.IntFlag{
-					Name:  FlagShardID,
-					Usage: "ShardId",
-				},
-				&cli.IntFlag{
-					Name:  FlagLastMessageID,
-					Usage: "The upper boundary of the read message",
+					Name:     FlagBackfillID,
+					Usage:    "Backfill ID",
+					Required: true,
 				},
 			},
 			Action: func(c *cli.Context) error {
-				return AdminMergeDLQMessages(c)
+				return AdminCancelScheduleBackfill(c)
 			},
 		},
-	}
-}
-
-func newDecodeCommands() []*cli.Command {
-	return []*cli.Command{
 		{
-			Name:  "proto",
-			Usage: "Decode proto payload",
+			Name:  "describe-pause-after-failures",
+			Usage: "Describe the consecutive failure limit of a schedule and why it paused itself, if it did",
 			Flags: []cli.Flag{
 				&cli.StringFlag{
-					Name:  FlagProtoType,
+					Name:     FlagScheduleID,
+					Usage:    "Schedule ID",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeSchedulePauseAfterFailures(c)
+			},
+		},
+		{
+			Name:  "update-pause-after-failures",
+			Usage: "Set the number of consecutive failed or timed-out actions after which a schedule pauses itself",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagScheduleID,
+					Usage:    "Schedule ID",
+					Required: true,
+				},
+				&cli.Int64Flag{
+					Name:     FlagPauseAfterFailures,
+					Usage:    "Number of consecutive failures, 0 to never pause",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpdateSchedulePauseAfterFailures(c)
+			},
+		},
+	}
+}
+
+func newAdminClusterCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "backup-database",
+			Usage: "Take an online backup of the persistence store, only supported by SQLite",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagOutputFilename,
+					Usage:    "Backup file",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminBackupDatabase(c)
+			},
+		},
+		{
+			Name:  "diagnostics-bundle",
+			Usage: "Collect a diagnostics bundle of the frontend host serving the request",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagOutputFilename,
+					Usage:    "Bundle file, a gzipped tar archive",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDiagnosticsBundle(c)
+			},
+		},
+		{
+			Name:  "circuit-breakers",
+			Usage: "Describe the persistence circuit breakers of the frontend host serving the request",
+			Action: func(c *cli.Context) error {
+				return AdminDescribePersistenceCircuitBreakers(c)
+			},
+		},
+		{
+			Name:  "health",
+			Usage: "Probe the components the cluster depends on from the frontend host serving the request",
+			Action: func(c *cli.Context) error {
+				return AdminGetComponentHealth(c)
+			},
+		},
+		{
+			Name:  "list-metrics",
+			Usage: "List the metrics the server can emit",
+			Action: func(c *cli.Context) error {
+				return AdminListMetrics(c)
+			},
+		},
+		{
+			Name: "sdk-usage",
+			Usage: "List the requests the frontend host received since it started by API method, SDK name and version, " +
+				"and namespace. Only the namespace given with --namespace is listed if it is set",
+			Action: func(c *cli.Context) error {
+				return AdminGetSDKUsage(c)
+			},
+		},
+		{
+			Name:  "create-snapshot",
+			Usage: "Take a logical snapshot of the namespaces and workflow executions of the cluster",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagStoreURI,
+					Usage:    "Object store the snapshot is written to, e.g. file:///var/temporal/snapshots or s3://bucket/prefix",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagSnapshotID,
+					Usage:    "Snapshot ID",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminCreateClusterSnapshot(c)
+			},
+		},
+		{
+			Name:  "restore-snapshot",
+			Usage: "Restore a cluster snapshot into this cluster",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagStoreURI,
+					Usage:    "Object store the snapshot is read from, e.g. file:///var/temporal/snapshots or s3://bucket/prefix",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagSnapshotID,
+					Usage:    "Snapshot ID",
+					Required: true,
+				},
+				&cli.BoolFlag{
+					Name:  FlagYes,
+					Usage: "Confirm all prompts",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRestoreClusterSnapshot(c)
+			},
+		},
+		{
+			Name:  "check-visibility",
+			Usage: "Cross-check the executions of shards against visibility, and the visibility records of namespaces against the executions",
+			Flags: []cli.Flag{
+				&cli.IntSliceFlag{
+					Name:  FlagShardID,
+					Usage: "Shard whose executions are checked against visibility, can be repeated",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagNamespaces,
+					Usage: "Namespace whose visibility records are checked against the executions, can be repeated",
+				},
+				&cli.BoolFlag{
+					Name:  FlagRepair,
+					Usage: "Repair the inconsistencies found instead of only reporting them",
+				},
+				&cli.DurationFlag{
+					Name:  FlagGracePeriod,
+					Usage: "Skip executions updated and records closed more recently than this, default is 10m",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminCheckVisibilityConsistency(c)
+			},
+		},
+		{
+			Name:  "top-persistence-namespaces",
+			Usage: "List the namespaces which made the most persistence requests from the frontend host serving the request",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:  FlagCount,
+					Value: 10,
+					Usage: "Number of namespaces to list",
+				},
+				&cli.DurationFlag{
+					Name:  FlagWindow,
+					Value: 10 * time.Minute,
+					Usage: "Window the requests are counted over, at most an hour",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminListTopPersistenceNamespaces(c)
+			},
+		},
+		{
+			Name:  "visibility-ingestion",
+			Usage: "Describe the visibility queue lag of the shards, and the Elasticsearch bulk processors of each history host",
+			Flags: []cli.Flag{
+				&cli.IntSliceFlag{
+					Name:  FlagShardID,
+					Usage: "Shard to describe, can be repeated. All shards are described if not set",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeVisibilityIngestion(c)
+			},
+		},
+		{
+			Name:  "delete-history-garbage",
+			Usage: "Delete the garbage history branches reported by a dry run of the history scanner",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagInputFilename,
+					Usage:    "JSON result of the history scanner dry run listing the garbage branches",
+					Required: true,
+				},
+				&cli.BoolFlag{
+					Name:  FlagYes,
+					Usage: "Confirm all prompts",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDeleteHistoryBranchGarbage(c)
+			},
+		},
+	}
+}
+
+func newAdminDLQCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:    "read",
+			Aliases: []string{"r", "list"},
+			Usage:   "Read DLQ Messages",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagDLQType,
+					Usage: "Type of DLQ to manage. (Options: namespace, history)",
+				},
+				&cli.StringFlag{
+					Name:  FlagCluster,
+					Usage: "Source cluster",
+				},
+				&cli.IntFlag{
+					Name:  FlagShardID,
+					Usage: "ShardId",
+				},
+				&cli.StringFlag{
+					Name:  FlagNamespaceID,
+					Usage: "Only read the messages of the namespace with this ID",
+				},
+				&cli.IntFlag{
+					Name:  FlagMaxMessageCount,
+					Usage: "Max message size to fetch",
+				},
+				&cli.IntFlag{
+					Name:  FlagLastMessageID,
+					Usage: "The upper boundary of the read message",
+				},
+				&cli.StringFlag{
+					Name:  FlagOutputFilename,
+					Usage: "Output file to write to, if not provided output is written to stdout",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminGetDLQMessages(c)
+			},
+		},
+		{
+			Name:  "show",
+			Usage: "Show a single DLQ message, with its history events decoded",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagDLQType,
+					Usage: "Type of DLQ to manage. (Options: namespace, history)",
+				},
+				&cli.StringFlag{
+					Name:  FlagCluster,
+					Usage: "Source cluster",
+				},
+				&cli.IntFlag{
+					Name:  FlagShardID,
+					Usage: "ShardId",
+				},
+				&cli.Int64Flag{
+					Name:     FlagMessageID,
+					Usage:    "ID of the DLQ message",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminShowDLQMessage(c)
+			},
+		},
+		{
+			Name:    "purge",
+			Aliases: []string{"p"},
+			Usage:   "Delete DLQ messages with equal or smaller ids than the provided task id",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagDLQType,
+					Usage: "Type of DLQ to manage. (Options: namespace, history)",
+				},
+				&cli.StringFlag{
+					Name:  FlagCluster,
+					Usage: "Source cluster",
+				},
+				&cli.IntFlag{
+					Name:  FlagShardID,
+					Usage: "ShardId",
+				},
+				&cli.StringFlag{
+					Name:  FlagNamespaceID,
+					Usage: "Only purge the messages of the namespace with this ID. Only supported by the history DLQ",
+				},
+				&cli.IntFlag{
+					Name:  FlagLastMessageID,
+					Usage: "The upper boundary of the read message",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminPurgeDLQMessages(c)
+			},
+		},
+		{
+			Name:    "merge",
+			Aliases: []string{"m"},
+			Usage:   "Merge DLQ messages with equal or smaller ids than the provided task id",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagDLQType,
+					Usage: "Type of DLQ to manage. (Options: namespace, history)",
+				},
+				&cli.StringFlag{
+					Name:  FlagCluster,
+					Usage: "Source cluster",
+				},
+				&cli.IntFlag{
+					Name:  FlagShardID,
+					Usage: "ShardId",
+				},
+				&cli.StringFlag{
+					Name:  FlagNamespaceID,
+					Usage: "Only merge the messages of the namespace with this ID. Only supported by the history DLQ",
+				},
+				&cli.IntFlag{
+					Name:  FlagLastMessageID,
+					Usage: "The upper boundary of the read message",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminMergeDLQMessages(c)
+			},
+		},
+		{
+			Name:  "list-archival",
+			Usage: "List the archival tasks which exhausted their retries, oldest first",
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  FlagMore,
+					Usage: "List more pages, default is to list one page of default page size 10",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 10,
+					Usage: "Result page size",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminListArchivalDLQTasks(c)
+			},
+		},
+		{
+			Name:  "retry-archival",
+			Usage: "Schedule the archival of the workflow of an archival DLQ task again, and remove the task from the DLQ",
+			Flags: []cli.Flag{
+				&cli.Int64Flag{
+					Name:     FlagMessageID,
+					Usage:    "DLQ message ID of the archival task",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRetryArchivalDLQTask(c)
+			},
+		},
+	}
+}
+
+func newDecodeCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "proto",
+			Usage: "Decode proto payload",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagProtoType,
 					Usage: "full name of proto type to decode to (i.e. temporal.server.api.persistence.v1.WorkflowExecutionInfo).",
 				},
 				&cli.StringFlag{
@@ -584,3 +1225,244 @@ func newDecodeCommands() []*cli.Command {
 		},
 	}
 }
+
+func newAdminAuthCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "create-api-key",
+			Usage: "Create an API key",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagName,
+					Usage:    "Name of the API key",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagSystemRole,
+					Usage: "Comma separated system roles of the API key: worker, reader, writer, admin",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagNamespaceRole,
+					Usage: "Roles of the API key in a namespace, in the namespace=roles format, can be repeated",
+				},
+				&cli.Float64Flag{
+					Name:  FlagRPS,
+					Usage: "Requests per second made with the API key, no limit if not set",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminCreateAPIKey(c)
+			},
+		},
+		{
+			Name:  "rotate-api-key",
+			Usage: "Replace the secret of an API key",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagAPIKeyID,
+					Usage:    "API key ID",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRotateAPIKey(c)
+			},
+		},
+		{
+			Name:  "revoke-api-key",
+			Usage: "Revoke an API key",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagAPIKeyID,
+					Usage:    "API key ID",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRevokeAPIKey(c)
+			},
+		},
+		{
+			Name:  "list-api-keys",
+			Usage: "List API keys",
+			Action: func(c *cli.Context) error {
+				return AdminListAPIKeys(c)
+			},
+		},
+		{
+			Name:  "put-role",
+			Usage: "Create or replace a named role",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagName,
+					Usage:    "Name of the role",
+					Required: true,
+				},
+				&cli.StringSliceFlag{
+					Name:     FlagPermission,
+					Usage:    "Permission granted by the role: start, signal, query, admin, operator, can be repeated",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminPutRole(c)
+			},
+		},
+		{
+			Name:  "delete-role",
+			Usage: "Delete a named role which is not assigned to any group",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagName,
+					Usage:    "Name of the role",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDeleteRole(c)
+			},
+		},
+		{
+			Name:  "list-roles",
+			Usage: "List named roles",
+			Action: func(c *cli.Context) error {
+				return AdminListRoles(c)
+			},
+		},
+		{
+			Name:  "set-group-roles",
+			Usage: "Assign named roles to an identity group in a namespace, replacing its previous roles",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagGroup,
+					Usage:    "Identity group",
+					Required: true,
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagNamedRole,
+					Usage: "Name of a role assigned to the group, can be repeated, removes the group from the namespace if not set",
+				},
+				&cli.BoolFlag{
+					Name:  FlagClusterWide,
+					Usage: "Assign the roles cluster-wide instead of in the namespace, they then apply to every namespace and to calls that don't target a namespace",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminSetGroupRoles(c)
+			},
+		},
+		{
+			Name:  "get-group-roles",
+			Usage: "Show the named roles assigned to each identity group in a namespace",
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  FlagClusterWide,
+					Usage: "Show the roles assigned cluster-wide instead of in the namespace",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminGetGroupRoles(c)
+			},
+		},
+	}
+}
+
+func newAdminDynamicConfigCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "get-overrides",
+			Usage: "Show the dynamic config values set at runtime",
+			Action: func(c *cli.Context) error {
+				return AdminGetDynamicConfigOverrides(c)
+			},
+		},
+		{
+			Name:  "set-override",
+			Usage: "Set the value of a dynamic config key for some constraints at runtime",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagKey,
+					Usage:    "Dynamic config key",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagValue,
+					Usage:    "JSON encoded value",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagConstraints,
+					Usage: `JSON encoded constraints, e.g. {"namespace":"ns"}, no constraints if not set`,
+				},
+				&cli.IntFlag{
+					Name:  FlagRollout,
+					Usage: "Percentage of the namespaces, task queues or shards the value applies to, all if not set",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminSetDynamicConfigOverride(c)
+			},
+		},
+		{
+			Name:  "delete-override",
+			Usage: "Delete the value of a dynamic config key set at runtime for some constraints",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagKey,
+					Usage:    "Dynamic config key",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagConstraints,
+					Usage: "JSON encoded constraints, no constraints if not set",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDeleteDynamicConfigOverride(c)
+			},
+		},
+		{
+			Name:  "set-rollout",
+			Usage: "Change the percentage of the namespaces, task queues or shards a value set at runtime applies to",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagKey,
+					Usage:    "Dynamic config key",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagConstraints,
+					Usage: "JSON encoded constraints of the value, no constraints if not set",
+				},
+				&cli.IntFlag{
+					Name:     FlagRollout,
+					Usage:    "Percentage, 100 applies the value to all, 0 rolls it back while keeping it set",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminSetDynamicConfigRollout(c)
+			},
+		},
+		{
+			Name:  "list-changes",
+			Usage: "List the most recent changes to the dynamic config values set at runtime",
+			Action: func(c *cli.Context) error {
+				return AdminListDynamicConfigChanges(c)
+			},
+		},
+		{
+			Name:  "effective",
+			Usage: "Show dynamic config keys with their values and where they come from, as seen by a frontend",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagKey,
+					Usage: "Dynamic config key, all keys if not set",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminGetEffectiveDynamicConfig(c)
+			},
+		},
+	}
+}
@@ -70,3 +70,14 @@ func (s *utilSuite) TestStringToEnum_MapEmptyEnum() {
 	s.Error(err)
 	s.Equal(result, int32(0))
 }
+
+func (s *utilSuite) TestParseNamespaceRoles() {
+	roles, err := parseNamespaceRoles([]string{"ns1=reader,Writer", "ns2=admin"})
+	s.NoError(err)
+	s.Equal(map[string]int32{"ns1": 6, "ns2": 8}, roles)
+
+	_, err = parseNamespaceRoles([]string{"ns1=owner"})
+	s.Error(err)
+	_, err = parseNamespaceRoles([]string{"reader"})
+	s.Error(err)
+}